@@ -8,10 +8,25 @@ import (
 	_ "github.com/ubccr/grendel/cmd"
 	_ "github.com/ubccr/grendel/cmd/auth"
 	_ "github.com/ubccr/grendel/cmd/bmc"
+	_ "github.com/ubccr/grendel/cmd/certs"
+	_ "github.com/ubccr/grendel/cmd/config"
 	_ "github.com/ubccr/grendel/cmd/db"
+	_ "github.com/ubccr/grendel/cmd/debug"
 	_ "github.com/ubccr/grendel/cmd/discover"
+	_ "github.com/ubccr/grendel/cmd/dns"
+	_ "github.com/ubccr/grendel/cmd/firmware"
 	_ "github.com/ubccr/grendel/cmd/image"
+	_ "github.com/ubccr/grendel/cmd/maintenance"
 	_ "github.com/ubccr/grendel/cmd/node"
+	_ "github.com/ubccr/grendel/cmd/nodeset"
+	_ "github.com/ubccr/grendel/cmd/replay"
+	_ "github.com/ubccr/grendel/cmd/schedule"
+	_ "github.com/ubccr/grendel/cmd/secret"
 	_ "github.com/ubccr/grendel/cmd/serve"
+	_ "github.com/ubccr/grendel/cmd/stats"
 	_ "github.com/ubccr/grendel/cmd/status"
+	_ "github.com/ubccr/grendel/cmd/switch"
+	_ "github.com/ubccr/grendel/cmd/sync"
+	_ "github.com/ubccr/grendel/cmd/token"
+	_ "github.com/ubccr/grendel/cmd/validate"
 )
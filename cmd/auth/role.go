@@ -42,6 +42,10 @@ var (
 			if err != nil {
 				return cmd.NewApiError(err)
 			}
+			if cmd.JSONOutput() {
+				return cmd.Output(res.Roles)
+			}
+
 			for _, role := range res.Roles {
 				fmt.Println(role.Name.Value)
 				if showPermissions {
@@ -34,12 +34,20 @@ var (
 				Role:     client.NewOptString(args[1]),
 				Expire:   client.NewOptString(args[2]),
 			}
+			namespaces, _ := command.Flags().GetStringSlice("namespaces")
+			if len(namespaces) > 0 {
+				req.Namespaces = client.NewOptNilStringArray(namespaces)
+			}
 			params := client.POSTV1AuthTokenParams{}
 			res, err := gc.POSTV1AuthToken(context.Background(), req, params)
 			if err != nil {
 				return cmd.NewApiError(err)
 			}
 
+			if cmd.JSONOutput() {
+				return cmd.Output(res)
+			}
+
 			fmt.Println(res.Token.Value)
 
 			return nil
@@ -48,5 +56,6 @@ var (
 )
 
 func init() {
+	tokemCmd.Flags().StringSlice("namespaces", nil, "limit the token to these namespaces")
 	authCmd.AddCommand(tokemCmd)
 }
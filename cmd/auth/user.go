@@ -46,6 +46,10 @@ var (
 				return cmd.NewApiError(err)
 			}
 
+			if cmd.JSONOutput() {
+				return cmd.Output(res)
+			}
+
 			w := tabwriter.NewWriter(os.Stdout, 0, 0, 4, ' ', 0)
 
 			fmt.Fprintln(w, "Username\tRole\tEnabled\tModified\tCreated\t")
@@ -146,6 +150,10 @@ var (
 					return cmd.NewApiError(err)
 				}
 
+				if cmd.JSONOutput() {
+					return cmd.Output(res)
+				}
+
 				fmt.Printf("Successfully created user %s with role %s\n", res.Username.Value, res.Role.Value)
 				return nil
 			}
@@ -0,0 +1,307 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package bmc
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/ubccr/grendel/cmd"
+	"github.com/ubccr/grendel/pkg/client"
+	"github.com/ubccr/grendel/pkg/nodeset"
+)
+
+var (
+	biosAttributes []string
+	biosReboot     bool
+	biosFanout     int
+	biosTimeout    time.Duration
+	biosCmd        = &cobra.Command{
+		Use:   "bios",
+		Short: "Manage BIOS attributes of nodes",
+		Long: `Get, compare and apply BIOS attributes of nodes using Redfish.
+
+BIOS profiles are named sets of attribute values configured on the server in
+bmc.bios_profiles, for example:
+
+  [[bmc.bios_profiles]]
+  name = "compute"
+  attributes = ["SriovGlobalEnable=Enabled", "ProcCStates=Disabled", "BootMode=Uefi"]`,
+	}
+	biosGetCmd = &cobra.Command{
+		Use:   "get {nodeset | all}",
+		Short: "Show the current BIOS attributes of nodes",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(command *cobra.Command, args []string) error {
+			gc, err := cmd.NewOgenClient()
+			if err != nil {
+				return err
+			}
+
+			ns, err := cmd.NodesetArg(args[0])
+			if err != nil {
+				return err
+			}
+
+			params := client.GETV1BmcBiosParams{
+				Nodeset: client.NewOptString(ns),
+				Tags:    client.NewOptString(strings.Join(tags, ",")),
+			}
+			if biosFanout > 0 {
+				params.Fanout = client.NewOptInt(biosFanout)
+			}
+			if biosTimeout > 0 {
+				params.Timeout = client.NewOptInt(timeoutSeconds(biosTimeout))
+			}
+			res, err := gc.GETV1BmcBios(context.Background(), params)
+			if err != nil {
+				return cmd.NewApiError(err)
+			}
+
+			if len(biosAttributes) > 0 {
+				for _, r := range res {
+					attrs, ok := r.Attributes.Get()
+					if !ok {
+						continue
+					}
+					for k := range attrs {
+						if !slices.Contains(biosAttributes, k) {
+							delete(attrs, k)
+						}
+					}
+				}
+			}
+
+			if cmd.JSONOutput() {
+				if oerr := cmd.Output(res); oerr != nil {
+					return oerr
+				}
+				return biosFailures(res)
+			}
+
+			w := tabwriter.NewWriter(os.Stdout, 0, 0, 4, ' ', 0)
+			fmt.Fprintln(w, "HOST\tATTRIBUTE\tVALUE")
+			for _, r := range res {
+				attrs, _ := r.Attributes.Get()
+				names := make([]string, 0, len(attrs))
+				for k := range attrs {
+					names = append(names, k)
+				}
+				slices.Sort(names)
+				for _, k := range names {
+					fmt.Fprintf(w, "%s\t%s\t%s\n", r.Host.Value, k, attrs[k].Value)
+				}
+			}
+			if werr := w.Flush(); werr != nil {
+				return werr
+			}
+
+			return printBiosFailures(res)
+		},
+	}
+	biosDiffCmd = &cobra.Command{
+		Use:   "diff {profile} {nodeset | all}",
+		Short: "Show the BIOS attributes of nodes which differ from a profile",
+		Long: `Show the BIOS attributes of nodes which differ from a profile.
+
+Attributes already set to the profile value on the next reboot are shown as
+pending. Attributes the node does not support have an empty current value.`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(command *cobra.Command, args []string) error {
+			gc, err := cmd.NewOgenClient()
+			if err != nil {
+				return err
+			}
+
+			ns, err := cmd.NodesetArg(args[1])
+			if err != nil {
+				return err
+			}
+
+			params := client.GETV1BmcBiosDiffParams{
+				Nodeset: client.NewOptString(ns),
+				Tags:    client.NewOptString(strings.Join(tags, ",")),
+				Profile: args[0],
+			}
+			if biosFanout > 0 {
+				params.Fanout = client.NewOptInt(biosFanout)
+			}
+			if biosTimeout > 0 {
+				params.Timeout = client.NewOptInt(timeoutSeconds(biosTimeout))
+			}
+			res, err := gc.GETV1BmcBiosDiff(context.Background(), params)
+			if err != nil {
+				return cmd.NewApiError(err)
+			}
+
+			if cmd.JSONOutput() {
+				if oerr := cmd.Output(res); oerr != nil {
+					return oerr
+				}
+				return biosFailures(res)
+			}
+
+			matching := nodeset.EmptyNodeSet()
+			deviating := nodeset.EmptyNodeSet()
+			w := tabwriter.NewWriter(os.Stdout, 0, 0, 4, ' ', 0)
+			fmt.Fprintln(w, "HOST\tATTRIBUTE\tCURRENT\tPROFILE\tPENDING")
+			for _, r := range res {
+				if r.Status.Value != "success" {
+					continue
+				}
+				if len(r.Deviations) == 0 {
+					matching.Add(r.Host.Value)
+					continue
+				}
+				deviating.Add(r.Host.Value)
+				for _, d := range r.Deviations {
+					fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%t\n", r.Host.Value, d.Attribute.Value, d.Current.Value, d.Profile.Value, d.Pending.Value)
+				}
+			}
+			if werr := w.Flush(); werr != nil {
+				return werr
+			}
+
+			fmt.Println()
+			if matching.Len() > 0 {
+				fmt.Printf("Matching (%d): %s\n", matching.Len(), matching.String())
+			}
+			if deviating.Len() > 0 {
+				fmt.Printf("Deviating (%d): %s\n", deviating.Len(), deviating.String())
+			}
+
+			return printBiosFailures(res)
+		},
+	}
+	biosApplyCmd = &cobra.Command{
+		Use:   "apply {profile} {nodeset | all}",
+		Short: "Apply a BIOS profile to nodes",
+		Long: `Apply a BIOS profile to nodes.
+
+The attributes which differ from the profile are set in the Redfish BIOS
+settings resource and take effect on the next reboot. The pending settings job
+created by the BMC is shown, along with the nodes which require a reboot. With
+--reboot those nodes are power cycled. Nodes which do not support an attribute
+of the profile are left unchanged and reported as failed.`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(command *cobra.Command, args []string) error {
+			gc, err := cmd.NewOgenClient()
+			if err != nil {
+				return err
+			}
+
+			req := &client.BmcBiosApplyBody{
+				Profile: client.NewOptString(args[0]),
+				Reboot:  client.NewOptBool(biosReboot),
+			}
+			if biosFanout > 0 {
+				req.Fanout = client.NewOptNilInt(biosFanout)
+			}
+			if biosTimeout > 0 {
+				req.Timeout = client.NewOptNilInt(timeoutSeconds(biosTimeout))
+			}
+
+			ns, err := cmd.NodesetArg(args[1])
+			if err != nil {
+				return err
+			}
+
+			params := client.POSTV1BmcBiosParams{
+				Nodeset: client.NewOptString(ns),
+				Tags:    client.NewOptString(strings.Join(tags, ",")),
+			}
+			res, err := gc.POSTV1BmcBios(context.Background(), req, params)
+			if err != nil {
+				return cmd.NewApiError(err)
+			}
+
+			if cmd.JSONOutput() {
+				if oerr := cmd.Output(res); oerr != nil {
+					return oerr
+				}
+				return biosFailures(res)
+			}
+
+			reboot := nodeset.EmptyNodeSet()
+			w := tabwriter.NewWriter(os.Stdout, 0, 0, 4, ' ', 0)
+			fmt.Fprintln(w, "HOST\tREBOOT REQUIRED\tJOB\tMESSAGE")
+			for _, r := range res {
+				if r.Status.Value != "success" {
+					continue
+				}
+				if r.RebootRequired.Value {
+					reboot.Add(r.Host.Value)
+				}
+				fmt.Fprintf(w, "%s\t%t\t%s\t%s\n", r.Host.Value, r.RebootRequired.Value, r.JobID.Value, r.Msg.Value)
+			}
+			if werr := w.Flush(); werr != nil {
+				return werr
+			}
+
+			if reboot.Len() > 0 {
+				fmt.Printf("\nReboot required (%d): %s\n", reboot.Len(), reboot.String())
+			}
+
+			return printBiosFailures(res)
+		},
+	}
+)
+
+func init() {
+	biosGetCmd.Flags().StringSliceVar(&biosAttributes, "attribute", []string{}, "Only show the given attributes")
+	biosApplyCmd.Flags().BoolVar(&biosReboot, "reboot", false, "Power cycle the nodes requiring a reboot for the settings to take effect")
+
+	for _, c := range []*cobra.Command{biosGetCmd, biosDiffCmd, biosApplyCmd} {
+		c.Flags().IntVar(&biosFanout, "fanout", 0, "Number of BMCs contacted at once (default bmc.fanout on the server)")
+		c.Flags().DurationVar(&biosTimeout, "timeout", 0, "Time allowed for each BMC (default bmc.timeout on the server)")
+		biosCmd.AddCommand(c)
+	}
+	bmcCmd.AddCommand(biosCmd)
+}
+
+// biosFailures returns a PartialFailureError if the BIOS job failed on any
+// of the nodes
+func biosFailures(res []client.BiosReport) error {
+	failed := 0
+	for _, r := range res {
+		if r.Status.Value != "success" {
+			failed++
+		}
+	}
+	if failed == 0 {
+		return nil
+	}
+
+	return &cmd.PartialFailureError{Failed: failed, Total: len(res)}
+}
+
+// printBiosFailures prints the nodes the BIOS job failed on with their errors
+func printBiosFailures(res []client.BiosReport) error {
+	failed := nodeset.EmptyNodeSet()
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 4, ' ', 0)
+	for _, r := range res {
+		if r.Status.Value == "success" {
+			continue
+		}
+		failed.Add(r.Host.Value)
+		fmt.Fprintf(w, "%s\t%s\n", r.Host.Value, r.Msg.Value)
+	}
+	if failed.Len() == 0 {
+		return nil
+	}
+
+	fmt.Printf("\nFailed (%d): %s\n", failed.Len(), failed.String())
+	if werr := w.Flush(); werr != nil {
+		return werr
+	}
+
+	return biosFailures(res)
+}
@@ -0,0 +1,89 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package bmc
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/ubccr/grendel/cmd"
+	"github.com/ubccr/grendel/pkg/client"
+)
+
+var (
+	bootPersistent bool
+	bootFanout     int
+	bootTimeout    time.Duration
+	bootorderCmd   = &cobra.Command{
+		Use:   "bootorder {pxe | disk | bios-setup} {nodeset | all}",
+		Short: "Set the boot device of nodes",
+		Long: `Set the Redfish boot source override of nodes to PXE, disk or BIOS setup in UEFI mode.
+
+The override applies to the next boot only unless --persistent is set. The
+override is read back from each BMC to check it was applied.`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(command *cobra.Command, args []string) error {
+			target, err := bootTarget(args[0])
+			if err != nil {
+				return err
+			}
+
+			gc, err := cmd.NewOgenClient()
+			if err != nil {
+				return err
+			}
+
+			nodeset, err := cmd.NodesetArg(args[1])
+			if err != nil {
+				return err
+			}
+			req := &client.BmcBootOverrideBody{
+				Target:     client.NewOptString(target),
+				Persistent: client.NewOptBool(bootPersistent),
+			}
+			if bootFanout > 0 {
+				req.Fanout = client.NewOptNilInt(bootFanout)
+			}
+			if bootTimeout > 0 {
+				req.Timeout = client.NewOptNilInt(timeoutSeconds(bootTimeout))
+			}
+
+			params := client.POSTV1BmcBootParams{
+				Nodeset: client.NewOptString(nodeset),
+				Tags:    client.NewOptString(strings.Join(tags, ",")),
+			}
+			res, err := gc.POSTV1BmcBoot(context.Background(), req, params)
+			if err != nil {
+				return cmd.NewApiError(err)
+			}
+
+			return cmd.NewJobTableResponse(res)
+		},
+	}
+)
+
+func init() {
+	bootorderCmd.Flags().BoolVar(&bootPersistent, "persistent", false, "Boot from the device on every boot instead of only the next boot")
+	bootorderCmd.Flags().IntVar(&bootFanout, "fanout", 0, "Number of BMCs contacted at once (default bmc.fanout on the server)")
+	bootorderCmd.Flags().DurationVar(&bootTimeout, "timeout", 0, "Time allowed for each BMC to respond (default bmc.timeout on the server)")
+	bmcCmd.AddCommand(bootorderCmd)
+}
+
+// bootTarget returns the redfish boot source for a bootorder device
+func bootTarget(device string) (string, error) {
+	switch device {
+	case "pxe":
+		return "Pxe", nil
+	case "disk":
+		return "Hdd", nil
+	case "bios-setup":
+		return "BiosSetup", nil
+	}
+
+	return "", fmt.Errorf("invalid boot device: %s. Valid options: pxe, disk, bios-setup", device)
+}
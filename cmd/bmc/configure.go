@@ -6,7 +6,6 @@ package bmc
 
 import (
 	"context"
-	"fmt"
 	"strings"
 
 	"github.com/spf13/cobra"
@@ -29,9 +28,9 @@ var (
 				return err
 			}
 
-			nodeset := args[0]
-			if args[0] == "all" {
-				nodeset = ""
+			nodeset, err := cmd.NodesetArg(args[0])
+			if err != nil {
+				return err
 			}
 			params := client.POSTV1BmcConfigureAutoParams{
 				Nodeset: client.NewOptString(nodeset),
@@ -42,11 +41,7 @@ var (
 				return cmd.NewApiError(err)
 			}
 
-			for _, jobMessage := range res {
-				fmt.Printf("%s\t %s\t %s\n", jobMessage.Host.Value, jobMessage.Status.Value, jobMessage.Msg.Value)
-			}
-
-			return nil
+			return cmd.NewJobMessageResponse(res)
 		},
 	}
 	configureImportCmd = &cobra.Command{
@@ -63,9 +58,9 @@ var (
 				return err
 			}
 
-			nodeset := args[0]
-			if args[0] == "all" {
-				nodeset = ""
+			nodeset, err := cmd.NodesetArg(args[0])
+			if err != nil {
+				return err
 			}
 			req := &client.BmcImportConfigurationRequest{
 				ShutdownType: client.NewOptString(args[1]),
@@ -80,11 +75,7 @@ var (
 				return cmd.NewApiError(err)
 			}
 
-			for _, jobMessage := range res {
-				fmt.Printf("%s\t %s\t %s\n", jobMessage.Host.Value, jobMessage.Status.Value, jobMessage.Msg.Value)
-			}
-
-			return nil
+			return cmd.NewJobMessageResponse(res)
 		},
 	}
 )
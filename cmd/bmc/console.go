@@ -0,0 +1,135 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package bmc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/user"
+
+	"github.com/spf13/cobra"
+	"github.com/ubccr/grendel/cmd"
+	"github.com/ubccr/grendel/pkg/client"
+	"golang.org/x/term"
+)
+
+var (
+	errDetached = errors.New("detached")
+
+	consoleCmd = &cobra.Command{
+		Use:   "console {host}",
+		Short: "Attach to the serial console of a node",
+		Long: `Attach to the serial over LAN console of a node.
+
+The console is proxied by the Grendel server, which connects to the BMC over
+IPMI with the stored BMC credentials. Type ~. at the start of a line to detach
+and ~~ to send a single ~.
+
+Only one session per node is allowed, the user holding the console is shown
+when it is in use. Sessions are logged on the server when bmc.console_log_dir
+is set.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(command *cobra.Command, args []string) error {
+			// Names the session holder on the unauthenticated unix socket
+			username := ""
+			if u, err := user.Current(); err == nil {
+				username = u.Username
+			}
+
+			conn, err := client.Console(context.Background(), cmd.ClientConfig(), args[0], username)
+			if err != nil {
+				return err
+			}
+			defer conn.Close()
+
+			fd := int(os.Stdin.Fd())
+			if term.IsTerminal(fd) {
+				state, err := term.MakeRaw(fd)
+				if err != nil {
+					return err
+				}
+				defer term.Restore(fd, state)
+			}
+
+			fmt.Fprintf(os.Stderr, "[connected to %s, type ~. to detach]\r\n", args[0])
+
+			done := make(chan error, 2)
+			go func() {
+				_, err := io.Copy(os.Stdout, conn)
+				done <- err
+			}()
+			go func() {
+				_, err := io.Copy(conn, &escapeReader{r: os.Stdin})
+				done <- err
+			}()
+
+			err = <-done
+			fmt.Fprintf(os.Stderr, "\r\n[detached from %s]\r\n", args[0])
+			if errors.Is(err, errDetached) {
+				return nil
+			}
+
+			return err
+		},
+	}
+)
+
+func init() {
+	bmcCmd.AddCommand(consoleCmd)
+}
+
+// escapeReader passes console input through until ~. is typed at the start
+// of a line, when it fails with errDetached. ~~ sends a single ~
+type escapeReader struct {
+	r       io.Reader
+	midLine bool
+	tilde   bool
+	pending []byte
+}
+
+func (e *escapeReader) Read(p []byte) (int, error) {
+	if len(e.pending) > 0 {
+		n := copy(p, e.pending)
+		e.pending = e.pending[n:]
+		return n, nil
+	}
+
+	buf := make([]byte, len(p))
+	n, err := e.r.Read(buf)
+
+	out := make([]byte, 0, n+1)
+	for _, c := range buf[:n] {
+		if e.tilde {
+			e.tilde = false
+			switch c {
+			case '.':
+				return copy(p, out), errDetached
+			case '~':
+				out = append(out, '~')
+				e.midLine = true
+				continue
+			default:
+				out = append(out, '~')
+			}
+		}
+
+		if c == '~' && !e.midLine {
+			e.tilde = true
+			continue
+		}
+
+		out = append(out, c)
+		e.midLine = c != '\r' && c != '\n'
+	}
+
+	// A ~ held from the previous read may not fit
+	copied := copy(p, out)
+	e.pending = out[copied:]
+
+	return copied, err
+}
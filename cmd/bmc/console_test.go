@@ -0,0 +1,43 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package bmc
+
+import (
+	"io"
+	"strings"
+	"testing"
+	"testing/iotest"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEscapeReader(t *testing.T) {
+	tests := []struct {
+		input    string
+		want     string
+		detached bool
+	}{
+		{"ls -l\r", "ls -l\r", false},
+		{"ls\r~.reboot\r", "ls\r", true},
+		{"~.", "", true},
+		{"echo ~.\r", "echo ~.\r", false},
+		{"~~.\r", "~.\r", false},
+		{"~x\r\n~.", "~x\r\n", true},
+	}
+
+	for _, tt := range tests {
+		// Reading a byte at a time splits the escape sequence across reads
+		for _, r := range []io.Reader{strings.NewReader(tt.input), iotest.OneByteReader(strings.NewReader(tt.input))} {
+			var out strings.Builder
+			_, err := io.Copy(&out, &escapeReader{r: r})
+			assert.Equal(t, tt.want, out.String(), tt.input)
+			if tt.detached {
+				assert.ErrorIs(t, err, errDetached, tt.input)
+			} else {
+				assert.NoError(t, err, tt.input)
+			}
+		}
+	}
+}
@@ -0,0 +1,258 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package bmc
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/ubccr/grendel/cmd"
+	"github.com/ubccr/grendel/pkg/client"
+	"github.com/ubccr/grendel/pkg/nodeset"
+)
+
+var (
+	fwupdateFile           string
+	fwupdateVersion        string
+	fwupdateComponent      string
+	fwupdateStaged         string
+	fwupdateMaxFailureRate int
+	fwupdateFanout         int
+	fwupdateTimeout        time.Duration
+	fwupdateCmd            = &cobra.Command{
+		Use:   "fwupdate {nodeset | all}",
+		Short: "Update the firmware of nodes in batches",
+		Long: `Update the firmware of nodes with the Redfish UpdateService.
+
+A local --file is uploaded to the Grendel server once and pushed to each BMC,
+a URL is fetched by the BMCs themselves. Each update task is monitored to
+completion and the new version of the --component firmware is read back from
+the BMC, waiting for it to reset if needed.
+
+With --staged the nodes are updated in batches of a number of nodes or a
+percentage of them, such as 10%. The rollout halts once the nodes failing in a
+batch exceed --max-failure-rate percent. Nodes already on --version are
+skipped, so an interrupted rollout can be run again.`,
+		Example: `  grendel bmc fwupdate cpn-[01-64] --file iDRAC_7.10.50.00.d9 --version 7.10.50.00 --staged 10%`,
+		Args:    cobra.ExactArgs(1),
+		RunE: func(command *cobra.Command, args []string) error {
+			if fwupdateMaxFailureRate < 0 || fwupdateMaxFailureRate > 100 {
+				return fmt.Errorf("invalid --max-failure-rate %d, expected a percentage", fwupdateMaxFailureRate)
+			}
+
+			// Each batch lasts as long as the updates of its nodes
+			cfg := cmd.ClientConfig()
+			cfg.Timeout = 24 * time.Hour
+			gc, err := client.New(cfg)
+			if err != nil {
+				return err
+			}
+
+			ns, err := cmd.NodesetArg(args[0])
+			if err != nil {
+				return err
+			}
+			hosts, err := gc.HostList(context.Background(), client.HostFilter{Nodeset: ns, Tags: tags})
+			if err != nil {
+				return err
+			}
+			names := nodeset.EmptyNodeSet()
+			for _, h := range hosts {
+				names.Add(h.Name.Value)
+			}
+			if names.Len() == 0 {
+				return fmt.Errorf("no nodes found matching %s", args[0])
+			}
+
+			size, err := batchSize(fwupdateStaged, names.Len())
+			if err != nil {
+				return err
+			}
+
+			image := fwupdateFile
+			if !strings.Contains(image, "://") {
+				image, err = client.UploadFirmware(context.Background(), cfg, fwupdateFile)
+				if err != nil {
+					return err
+				}
+				if !cmd.JSONOutput() {
+					fmt.Printf("Uploaded %s as %s\n", fwupdateFile, image)
+				}
+			}
+
+			batches := fwupdateBatches(names, size)
+			res := make([]client.FirmwareUpdateReport, 0, names.Len())
+			var halted *nodeset.NodeSet
+			for i, batch := range batches {
+				if !cmd.JSONOutput() {
+					fmt.Printf("\nBatch %d/%d (%d): %s\n", i+1, len(batches), batch.Len(), batch.String())
+				}
+
+				req := &client.BmcFirmwareUpdateBody{
+					Image:     client.NewOptString(image),
+					Component: client.NewOptString(fwupdateComponent),
+					Version:   client.NewOptString(fwupdateVersion),
+				}
+				if fwupdateFanout > 0 {
+					req.Fanout = client.NewOptNilInt(fwupdateFanout)
+				}
+				if fwupdateTimeout > 0 {
+					req.Timeout = client.NewOptNilInt(timeoutSeconds(fwupdateTimeout))
+				}
+				params := client.POSTV1BmcFwupdateParams{
+					Nodeset: client.NewOptString(batch.String()),
+				}
+				out, err := gc.POSTV1BmcFwupdate(context.Background(), req, params)
+				if err != nil {
+					return cmd.NewApiError(err)
+				}
+				res = append(res, out...)
+
+				if !cmd.JSONOutput() {
+					if err := printFwupdate(out); err != nil {
+						return err
+					}
+				}
+
+				failed := fwupdateFailures(out)
+				if failed*100 > fwupdateMaxFailureRate*batch.Len() {
+					halted = nodeset.EmptyNodeSet()
+					for _, b := range batches[i+1:] {
+						halted.Add(b.String())
+					}
+					if !cmd.JSONOutput() {
+						fmt.Printf("\nHalted: %d of %d nodes failed in batch %d, exceeding the maximum failure rate of %d%%\n", failed, batch.Len(), i+1, fwupdateMaxFailureRate)
+					}
+					break
+				}
+			}
+
+			if cmd.JSONOutput() {
+				if err := cmd.Output(res); err != nil {
+					return err
+				}
+			} else {
+				printFwupdateSummary(res, halted)
+			}
+
+			failed := fwupdateFailures(res)
+			if halted != nil {
+				failed += halted.Len()
+			}
+			if failed > 0 {
+				return &cmd.PartialFailureError{Failed: failed, Total: names.Len()}
+			}
+
+			return nil
+		},
+	}
+)
+
+func init() {
+	fwupdateCmd.Flags().StringVar(&fwupdateFile, "file", "", "Firmware image to upload, or a URL the BMCs fetch it from")
+	fwupdateCmd.MarkFlagRequired("file")
+	fwupdateCmd.Flags().StringVar(&fwupdateVersion, "version", "", "Firmware version the image installs, nodes already on it are skipped")
+	fwupdateCmd.MarkFlagRequired("version")
+	fwupdateCmd.Flags().StringVar(&fwupdateComponent, "component", "bmc", "Firmware verified after the update: bmc, bios or nic")
+	fwupdateCmd.Flags().StringVar(&fwupdateStaged, "staged", "", "Update nodes in batches of a number of nodes or a percentage of them, such as 10% (default all at once)")
+	fwupdateCmd.Flags().IntVar(&fwupdateMaxFailureRate, "max-failure-rate", 0, "Percentage of nodes of a batch allowed to fail before halting the rollout")
+	fwupdateCmd.Flags().IntVar(&fwupdateFanout, "fanout", 0, "Number of BMCs updated at once (default bmc.fanout on the server)")
+	fwupdateCmd.Flags().DurationVar(&fwupdateTimeout, "timeout", 0, "Time allowed to update each BMC (default bmc.fwupdate_timeout on the server)")
+	bmcCmd.AddCommand(fwupdateCmd)
+}
+
+// batchSize parses a batch size of a number of nodes or a percentage of
+// total, rounded up. An empty size is a single batch
+func batchSize(s string, total int) (int, error) {
+	if s == "" {
+		return total, nil
+	}
+
+	pct, isPct := strings.CutSuffix(s, "%")
+	n, err := strconv.Atoi(pct)
+	if err != nil || n <= 0 || (isPct && n > 100) {
+		return 0, fmt.Errorf("invalid batch size %q, expected a number of nodes or a percentage", s)
+	}
+	if isPct {
+		n = (total*n + 99) / 100
+	}
+
+	return min(n, total), nil
+}
+
+// fwupdateBatches splits ns into batches of size nodes in nodeset order
+func fwupdateBatches(ns *nodeset.NodeSet, size int) []*nodeset.NodeSet {
+	batches := make([]*nodeset.NodeSet, 0)
+	it := ns.Iterator()
+	for it.Next() {
+		if len(batches) == 0 || batches[len(batches)-1].Len() == size {
+			batches = append(batches, nodeset.EmptyNodeSet())
+		}
+		batches[len(batches)-1].Add(it.Value())
+	}
+
+	return batches
+}
+
+func fwupdateFailures(res []client.FirmwareUpdateReport) int {
+	failed := 0
+	for _, r := range res {
+		if r.Status.Value != "success" {
+			failed++
+		}
+	}
+
+	return failed
+}
+
+func printFwupdate(res []client.FirmwareUpdateReport) error {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 4, ' ', 0)
+	fmt.Fprintln(w, "HOST\tSTATUS\tPREVIOUS\tVERSION\tMESSAGE")
+	for _, r := range res {
+		status := "updated"
+		switch {
+		case r.Status.Value != "success":
+			status = "failed"
+		case r.Skipped.Value:
+			status = "skipped"
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", r.Host.Value, status, r.Previous.Value, r.Version.Value, r.Msg.Value)
+	}
+
+	return w.Flush()
+}
+
+func printFwupdateSummary(res []client.FirmwareUpdateReport, halted *nodeset.NodeSet) {
+	updated := nodeset.EmptyNodeSet()
+	skipped := nodeset.EmptyNodeSet()
+	failed := nodeset.EmptyNodeSet()
+	for _, r := range res {
+		switch {
+		case r.Status.Value != "success":
+			failed.Add(r.Host.Value)
+		case r.Skipped.Value:
+			skipped.Add(r.Host.Value)
+		default:
+			updated.Add(r.Host.Value)
+		}
+	}
+
+	fmt.Println()
+	for _, s := range []struct {
+		name string
+		ns   *nodeset.NodeSet
+	}{{"Updated", updated}, {"Skipped", skipped}, {"Failed", failed}, {"Not attempted", halted}} {
+		if s.ns == nil || s.ns.Len() == 0 {
+			continue
+		}
+		fmt.Printf("%s (%d): %s\n", s.name, s.ns.Len(), s.ns.String())
+	}
+}
@@ -0,0 +1,52 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package bmc
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/ubccr/grendel/pkg/nodeset"
+)
+
+func TestBatchSize(t *testing.T) {
+	tests := []struct {
+		size  string
+		total int
+		want  int
+	}{
+		{"", 64, 64},
+		{"8", 64, 8},
+		{"100", 64, 64},
+		{"10%", 64, 7},
+		{"10%", 5, 1},
+		{"100%", 64, 64},
+	}
+
+	for _, tt := range tests {
+		n, err := batchSize(tt.size, tt.total)
+		if assert.NoError(t, err, tt.size) {
+			assert.Equal(t, tt.want, n, tt.size)
+		}
+	}
+
+	for _, size := range []string{"0", "-1", "0%", "110%", "ten", "%"} {
+		_, err := batchSize(size, 64)
+		assert.Error(t, err, size)
+	}
+}
+
+func TestFwupdateBatches(t *testing.T) {
+	ns, err := nodeset.NewNodeSet("cpn-[01-07]")
+	require.NoError(t, err)
+
+	batches := fwupdateBatches(ns, 3)
+	if assert.Len(t, batches, 3) {
+		assert.Equal(t, "cpn-[01-03]", batches[0].String())
+		assert.Equal(t, "cpn-[04-06]", batches[1].String())
+		assert.Equal(t, "cpn-07", batches[2].String())
+	}
+}
@@ -0,0 +1,116 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package bmc
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/ubccr/grendel/cmd"
+	"github.com/ubccr/grendel/pkg/client"
+	"github.com/ubccr/grendel/pkg/nodeset"
+)
+
+var (
+	inventoryFanout  int
+	inventoryTimeout time.Duration
+	inventoryCmd     = &cobra.Command{
+		Use:   "inventory {nodeset | all}",
+		Short: "Collect hardware inventory of nodes",
+		Long: `Collect the CPU, memory, disk and NIC inventory of nodes from their BMCs using Redfish.
+
+The hardware is stored on each node and shown by node show. Differences from
+the previous collection, such as a memory DIMM disappearing or the disk count
+changing, are listed per node. Resources a BMC does not report are recorded as
+missing and not compared.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(command *cobra.Command, args []string) error {
+			gc, err := cmd.NewOgenClient()
+			if err != nil {
+				return err
+			}
+
+			ns, err := cmd.NodesetArg(args[0])
+			if err != nil {
+				return err
+			}
+			params := client.POSTV1BmcInventoryParams{
+				Nodeset: client.NewOptString(ns),
+				Tags:    client.NewOptString(strings.Join(tags, ",")),
+			}
+			if inventoryFanout > 0 {
+				params.Fanout = client.NewOptInt(inventoryFanout)
+			}
+			if inventoryTimeout > 0 {
+				params.Timeout = client.NewOptInt(timeoutSeconds(inventoryTimeout))
+			}
+			res, err := gc.POSTV1BmcInventory(context.Background(), params)
+			if err != nil {
+				return cmd.NewApiError(err)
+			}
+
+			failed := nodeset.EmptyNodeSet()
+			for _, r := range res {
+				if r.Status.Value != "success" {
+					failed.Add(r.Host.Value)
+				}
+			}
+			err = nil
+			if failed.Len() > 0 {
+				err = &cmd.PartialFailureError{Failed: failed.Len(), Total: len(res)}
+			}
+
+			if cmd.JSONOutput() {
+				if oerr := cmd.Output(res); oerr != nil {
+					return oerr
+				}
+				return err
+			}
+
+			w := tabwriter.NewWriter(os.Stdout, 0, 0, 4, ' ', 0)
+			fmt.Fprintln(w, "HOST\tSTATUS\tCPU\tCORES\tMEMORY\tDISKS\tNICS\tMESSAGE")
+			for _, r := range res {
+				hw, ok := r.Hardware.Get()
+				if !ok {
+					fmt.Fprintf(w, "%s\t%s\t\t\t\t\t\t%s\n", r.Host.Value, r.Status.Value, r.Msg.Value)
+					continue
+				}
+				fmt.Fprintf(w, "%s\t%s\t%s\t%d\t%d GiB\t%d\t%d\t%s\n", r.Host.Value, r.Status.Value,
+					hw.CPUModel.Value, hw.CoreCount.Value, hw.MemoryMib.Value/1024,
+					len(hw.Disks.Value), len(hw.Nics.Value), r.Msg.Value)
+			}
+			if werr := w.Flush(); werr != nil {
+				return werr
+			}
+
+			for _, r := range res {
+				if len(r.Changes.Value) == 0 {
+					continue
+				}
+				fmt.Printf("\n%s changed:\n", r.Host.Value)
+				for _, c := range r.Changes.Value {
+					fmt.Printf("  %s\n", c)
+				}
+			}
+
+			if failed.Len() > 0 {
+				fmt.Printf("\nFailed (%d): %s\n", failed.Len(), failed.String())
+			}
+
+			return err
+		},
+	}
+)
+
+func init() {
+	inventoryCmd.Flags().IntVar(&inventoryFanout, "fanout", 0, "Number of BMCs queried at once (default bmc.fanout on the server)")
+	inventoryCmd.Flags().DurationVar(&inventoryTimeout, "timeout", 0, "Time allowed for each BMC to respond (default bmc.timeout on the server)")
+	bmcCmd.AddCommand(inventoryCmd)
+}
@@ -33,9 +33,9 @@ var (
 				return err
 			}
 
-			nodeset := args[0]
-			if args[0] == "all" {
-				nodeset = ""
+			nodeset, err := cmd.NodesetArg(args[0])
+			if err != nil {
+				return err
 			}
 			params := client.GETV1BmcJobsParams{
 				Nodeset: client.NewOptString(nodeset),
@@ -46,6 +46,10 @@ var (
 				return cmd.NewApiError(err)
 			}
 
+			if cmd.JSONOutput() {
+				return cmd.Output(res)
+			}
+
 			t := table.NewWriter()
 			t.SetOutputMirror(os.Stdout)
 			t.AppendHeader(table.Row{"Host", "Job Name", "State", "Progress", "Messages"})
@@ -103,9 +107,9 @@ var (
 				return err
 			}
 
-			nodeset := args[0]
-			if args[0] == "all" {
-				nodeset = ""
+			nodeset, err := cmd.NodesetArg(args[0])
+			if err != nil {
+				return err
 			}
 			params := client.DELETEV1BmcJobsJidsParams{
 				Nodeset: client.NewOptString(nodeset),
@@ -117,11 +121,7 @@ var (
 				return cmd.NewApiError(err)
 			}
 
-			for _, jobMessage := range res {
-				fmt.Printf("%s\t %s\t %s\n", jobMessage.Host.Value, jobMessage.Status.Value, jobMessage.Msg.Value)
-			}
-
-			return nil
+			return cmd.NewJobMessageResponse(res)
 		},
 	}
 )
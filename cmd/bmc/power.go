@@ -7,20 +7,34 @@ package bmc
 import (
 	"context"
 	"fmt"
+	"math"
+	"os"
 	"strings"
+	"text/tabwriter"
+	"time"
 
 	"github.com/spf13/cobra"
 	"github.com/ubccr/grendel/cmd"
 	"github.com/ubccr/grendel/pkg/client"
+	"github.com/ubccr/grendel/pkg/model"
 )
 
 var (
-	override string
-	powerCmd = &cobra.Command{
-		Use:   "power {cycle | off | on | redfish.ResetType} {nodeset | all}",
-		Short: "Change power state of nodes",
-		Long:  "Valid redfish.ResetType options: On, ForceOn, ForceOff, ForceRestart, GracefulRestart, GracefulShutdown, PowerCycle",
-		Args:  cobra.ExactArgs(2),
+	override     string
+	powerFanout  int
+	powerTimeout time.Duration
+	powerCmd     = &cobra.Command{
+		Use:   "power {cycle | off | on | status | redfish.ResetType} {nodeset | all}",
+		Short: "Change or query power state of nodes",
+		Long: `Change or query the power state of nodes using Redfish against each node's BMC.
+
+The BMCs are contacted --fanout at a time and each one is given --timeout to
+respond. Once all nodes have finished a table of the results is printed with
+the nodesets that succeeded and failed, ready to be retried. The status action
+reports the power state of each node as on, off or unknown.
+
+Valid redfish.ResetType options: On, ForceOn, ForceOff, ForceRestart, GracefulRestart, GracefulShutdown, PowerCycle`,
+		Args: cobra.ExactArgs(2),
 		RunE: func(command *cobra.Command, args []string) error {
 			var err error
 			gc, err := cmd.NewOgenClient()
@@ -28,6 +42,30 @@ var (
 				return err
 			}
 
+			nodeset, err := cmd.NodesetArg(args[1])
+			if err != nil {
+				return err
+			}
+
+			if args[0] == "status" {
+				params := client.GETV1BmcPowerParams{
+					Nodeset: client.NewOptString(nodeset),
+					Tags:    client.NewOptString(strings.Join(tags, ",")),
+				}
+				if powerFanout > 0 {
+					params.Fanout = client.NewOptInt(powerFanout)
+				}
+				if powerTimeout > 0 {
+					params.Timeout = client.NewOptInt(timeoutSeconds(powerTimeout))
+				}
+				res, err := gc.GETV1BmcPower(context.Background(), params)
+				if err != nil {
+					return cmd.NewApiError(err)
+				}
+
+				return powerStatusResponse(res)
+			}
+
 			// shorthand option syntax
 			powerOption := ""
 			switch args[0] {
@@ -41,14 +79,16 @@ var (
 				powerOption = args[0]
 			}
 
-			nodeset := args[1]
-			if args[1] == "all" {
-				nodeset = ""
-			}
 			req := &client.BmcOsPowerBody{
 				PowerOption: client.NewOptString(powerOption),
 				BootOption:  client.NewOptString(override),
 			}
+			if powerFanout > 0 {
+				req.Fanout = client.NewOptNilInt(powerFanout)
+			}
+			if powerTimeout > 0 {
+				req.Timeout = client.NewOptNilInt(timeoutSeconds(powerTimeout))
+			}
 
 			params := client.POSTV1BmcPowerOsParams{
 				Nodeset: client.NewOptString(nodeset),
@@ -59,15 +99,101 @@ var (
 				return cmd.NewApiError(err)
 			}
 
-			for _, jobMessage := range res {
-				fmt.Printf("%s\t %s\t %s\n", jobMessage.Host.Value, jobMessage.Status.Value, jobMessage.Msg.Value)
-			}
-			return nil
+			return cmd.NewJobTableResponse(res)
 		},
 	}
 )
 
 func init() {
 	powerCmd.PersistentFlags().StringVarP(&override, "override", "o", "None", "Set redfish boot override. Valid options: None, Pxe, BiosSetup, Utilities, Diags")
+	powerCmd.PersistentFlags().IntVar(&powerFanout, "fanout", 0, "Number of BMCs contacted at once (default bmc.fanout on the server)")
+	powerCmd.PersistentFlags().DurationVar(&powerTimeout, "timeout", 0, "Time allowed for each BMC to respond (default bmc.timeout on the server)")
 	bmcCmd.AddCommand(powerCmd)
 }
+
+// timeoutSeconds rounds d up to whole seconds
+func timeoutSeconds(d time.Duration) int {
+	return int(math.Ceil(d.Seconds()))
+}
+
+// PowerStatusHost is the power state of a single node
+type PowerStatusHost struct {
+	Name  string `json:"name"`
+	State string `json:"state"`
+	Error string `json:"error,omitempty"`
+}
+
+// PowerStatusResult is the JSON document emitted by bmc power status. On, Off
+// and Unknown are the nodesets in each power state, nodes that could not be
+// queried are unknown.
+type PowerStatusResult struct {
+	Hosts   []PowerStatusHost `json:"hosts"`
+	On      string            `json:"on"`
+	Off     string            `json:"off"`
+	Unknown string            `json:"unknown"`
+}
+
+func powerStatusResponse(res []client.JobMessage) error {
+	result := PowerStatusResult{Hosts: []PowerStatusHost{}}
+	failed := 0
+	for _, jm := range res {
+		h := PowerStatusHost{Name: jm.Host.Value, State: powerState(jm)}
+		if jm.Status.Value != "success" {
+			h.Error = jm.Msg.Value
+			failed++
+		}
+		result.Hosts = append(result.Hosts, h)
+	}
+
+	groups := cmd.GroupJobMessages(res, powerState)
+	result.On = groups[model.PowerStateOn]
+	result.Off = groups[model.PowerStateOff]
+	result.Unknown = groups[model.PowerStateUnknown]
+
+	var err error
+	if failed > 0 {
+		err = &cmd.PartialFailureError{Failed: failed, Total: len(res)}
+	}
+
+	if cmd.JSONOutput() {
+		if oerr := cmd.Output(result); oerr != nil {
+			return oerr
+		}
+		return err
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 4, ' ', 0)
+	fmt.Fprintln(w, "HOST\tPOWER\tMESSAGE")
+	for i, h := range result.Hosts {
+		msg := h.Error
+		if msg == "" {
+			msg = res[i].Msg.Value
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\n", h.Name, h.State, msg)
+	}
+	if werr := w.Flush(); werr != nil {
+		return werr
+	}
+
+	fmt.Println()
+	for _, state := range []string{model.PowerStateOn, model.PowerStateOff, model.PowerStateUnknown} {
+		n := 0
+		for _, h := range result.Hosts {
+			if h.State == state {
+				n++
+			}
+		}
+		fmt.Printf("%s (%d): %s\n", state, n, groups[state])
+	}
+
+	return err
+}
+
+// powerState returns the on, off or unknown power state of a node
+func powerState(jm client.JobMessage) string {
+	if jm.Status.Value != "success" || jm.Data.Value == "" {
+		return model.PowerStateUnknown
+	}
+
+	return jm.Data.Value
+}
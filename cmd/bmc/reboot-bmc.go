@@ -6,7 +6,6 @@ package bmc
 
 import (
 	"context"
-	"fmt"
 	"strings"
 
 	"github.com/spf13/cobra"
@@ -27,9 +26,9 @@ var (
 				return err
 			}
 
-			nodeset := args[0]
-			if args[0] == "all" {
-				nodeset = ""
+			nodeset, err := cmd.NodesetArg(args[0])
+			if err != nil {
+				return err
 			}
 			params := client.POSTV1BmcPowerBmcParams{
 				Nodeset: client.NewOptString(nodeset),
@@ -40,10 +39,7 @@ var (
 				return cmd.NewApiError(err)
 			}
 
-			for _, jobMessage := range res {
-				fmt.Printf("%s\t %s\t %s\n", jobMessage.Host.Value, jobMessage.Status.Value, jobMessage.Msg.Value)
-			}
-			return nil
+			return cmd.NewJobMessageResponse(res)
 		},
 	}
 )
@@ -0,0 +1,138 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package bmc
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/ubccr/grendel/cmd"
+	"github.com/ubccr/grendel/pkg/client"
+	"github.com/ubccr/grendel/pkg/nodeset"
+)
+
+var (
+	rotateLength  int
+	rotateFanout  int
+	rotateTimeout time.Duration
+	rotateCmd     = &cobra.Command{
+		Use:   "rotate-password {nodeset | all}",
+		Short: "Set a new random BMC password per node",
+		Long: `Set a new random password on the BMC of each node and store it as the BMC
+credentials of the node, encrypted with the credentials key of the server.
+
+The password of the account in the current credentials of the node, or
+bmc.user when it has none, is changed through the Redfish AccountService. The
+new password is only stored once a login with it succeeds. If the login or
+storing the password fails the previous password is restored on the BMC.
+
+Nodes whose previous password could not be restored are listed as needing
+manual attention: their BMC may no longer accept the stored credentials.`,
+		Example: `  grendel bmc rotate-password cpn-d13-[01-64] --length 20`,
+		Args:    cobra.ExactArgs(1),
+		RunE: func(command *cobra.Command, args []string) error {
+			gc, err := cmd.NewOgenClient()
+			if err != nil {
+				return err
+			}
+
+			ns, err := cmd.NodesetArg(args[0])
+			if err != nil {
+				return err
+			}
+
+			req := &client.BmcRotatePasswordBody{
+				Length: client.NewOptNilInt(rotateLength),
+			}
+			if rotateFanout > 0 {
+				req.Fanout = client.NewOptNilInt(rotateFanout)
+			}
+			if rotateTimeout > 0 {
+				req.Timeout = client.NewOptNilInt(timeoutSeconds(rotateTimeout))
+			}
+			params := client.POSTV1BmcRotatePasswordParams{
+				Nodeset: client.NewOptString(ns),
+				Tags:    client.NewOptString(strings.Join(tags, ",")),
+			}
+			res, err := gc.POSTV1BmcRotatePassword(context.Background(), req, params)
+			if err != nil {
+				return cmd.NewApiError(err)
+			}
+
+			if cmd.JSONOutput() {
+				if err := cmd.Output(res); err != nil {
+					return err
+				}
+			} else {
+				if err := printRotatePassword(res); err != nil {
+					return err
+				}
+			}
+
+			failed := 0
+			for _, r := range res {
+				if r.Status.Value != "success" {
+					failed++
+				}
+			}
+			if failed > 0 {
+				return &cmd.PartialFailureError{Failed: failed, Total: len(res)}
+			}
+
+			return nil
+		},
+	}
+)
+
+func init() {
+	rotateCmd.Flags().IntVar(&rotateLength, "length", 20, "Length of the generated passwords")
+	rotateCmd.Flags().IntVar(&rotateFanout, "fanout", 0, "Number of BMCs contacted at once (default bmc.fanout on the server)")
+	rotateCmd.Flags().DurationVar(&rotateTimeout, "timeout", 0, "Time allowed for each BMC login (default bmc.timeout on the server)")
+	bmcCmd.AddCommand(rotateCmd)
+}
+
+func printRotatePassword(res []client.PasswordRotationReport) error {
+	rotated := nodeset.EmptyNodeSet()
+	failed := nodeset.EmptyNodeSet()
+	attention := nodeset.EmptyNodeSet()
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 4, ' ', 0)
+	fmt.Fprintln(w, "HOST\tUSER\tSTATUS\tMESSAGE")
+	for _, r := range res {
+		status := "rotated"
+		switch {
+		case r.Attention.Value:
+			status = "attention"
+			attention.Add(r.Host.Value)
+		case r.Status.Value != "success":
+			status = "failed"
+			failed.Add(r.Host.Value)
+		default:
+			rotated.Add(r.Host.Value)
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", r.Host.Value, r.Username.Value, status, r.Msg.Value)
+	}
+	if err := w.Flush(); err != nil {
+		return err
+	}
+
+	fmt.Println()
+	for _, s := range []struct {
+		name string
+		ns   *nodeset.NodeSet
+	}{{"Rotated", rotated}, {"Failed, previous password kept", failed}, {"Needs manual attention", attention}} {
+		if s.ns.Len() == 0 {
+			continue
+		}
+		fmt.Printf("%s (%d): %s\n", s.name, s.ns.Len(), s.ns.String())
+	}
+
+	return nil
+}
@@ -6,9 +6,7 @@ package bmc
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
-	"os"
 	"strings"
 
 	"github.com/spf13/cobra"
@@ -31,9 +29,9 @@ var (
 				return err
 			}
 
-			nodeset := args[0]
-			if args[0] == "all" {
-				nodeset = ""
+			nodeset, err := cmd.NodesetArg(args[0])
+			if err != nil {
+				return err
 			}
 			params := client.GETV1BmcParams{
 				Nodeset: client.NewOptString(nodeset),
@@ -52,14 +50,8 @@ var (
 				output[i] = v
 			}
 
-			if statusLong {
-				enc := json.NewEncoder(os.Stdout)
-				enc.SetIndent("", "    ")
-
-				err := enc.Encode(output)
-				if err != nil {
-					return err
-				}
+			if statusLong || cmd.JSONOutput() {
+				return cmd.Output(output)
 			} else {
 				for _, o := range output {
 
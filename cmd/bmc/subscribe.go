@@ -0,0 +1,176 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package bmc
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/ubccr/grendel/cmd"
+	"github.com/ubccr/grendel/pkg/client"
+	"github.com/ubccr/grendel/pkg/model"
+	"github.com/ubccr/grendel/pkg/nodeset"
+)
+
+var (
+	subscribeFanout  int
+	subscribeTimeout time.Duration
+	subscribeCmd     = &cobra.Command{
+		Use:   "subscribe {nodeset | all}",
+		Short: "Subscribe BMCs to Redfish events",
+		Long: `Create a Redfish event subscription on the BMC of each node pushing events to
+the event receiver of the API server under bmc.event_url. Subscribed nodes are
+tagged bmc-events and their subscriptions are checked every
+bmc.subscription_interval, re-creating the ones lost by the BMC such as after
+a firmware update.
+
+Received events are added to the event stream, critical events are also
+added to the log of the node shown by "node log".`,
+		Example: `  grendel bmc subscribe cpn-d13-[01-64]`,
+		Args:    cobra.ExactArgs(1),
+		RunE: func(command *cobra.Command, args []string) error {
+			gc, err := cmd.NewOgenClient()
+			if err != nil {
+				return err
+			}
+
+			ns, err := cmd.NodesetArg(args[0])
+			if err != nil {
+				return err
+			}
+
+			params := client.POSTV1BmcSubscriptionsParams{
+				Nodeset: client.NewOptString(ns),
+				Tags:    client.NewOptString(strings.Join(tags, ",")),
+			}
+			if subscribeFanout > 0 {
+				params.Fanout = client.NewOptInt(subscribeFanout)
+			}
+			if subscribeTimeout > 0 {
+				params.Timeout = client.NewOptInt(timeoutSeconds(subscribeTimeout))
+			}
+			res, err := gc.POSTV1BmcSubscriptions(context.Background(), params)
+			if err != nil {
+				return cmd.NewApiError(err)
+			}
+
+			return outputSubscriptions(res)
+		},
+	}
+	subscriptionsCmd = &cobra.Command{
+		Use:   "subscriptions {nodeset | all}",
+		Short: "Check BMC event subscriptions",
+		Long: `Check the BMC of each node has an event subscription to the event receiver
+of the API server. Nodes without a subscription are listed as missing, use
+"bmc subscribe" to create it.`,
+		Example: `  grendel bmc subscriptions cpn-d13-[01-64]`,
+		Args:    cobra.ExactArgs(1),
+		RunE: func(command *cobra.Command, args []string) error {
+			gc, err := cmd.NewOgenClient()
+			if err != nil {
+				return err
+			}
+
+			ns, err := cmd.NodesetArg(args[0])
+			if err != nil {
+				return err
+			}
+
+			params := client.GETV1BmcSubscriptionsParams{
+				Nodeset: client.NewOptString(ns),
+				Tags:    client.NewOptString(strings.Join(tags, ",")),
+			}
+			if subscribeFanout > 0 {
+				params.Fanout = client.NewOptInt(subscribeFanout)
+			}
+			if subscribeTimeout > 0 {
+				params.Timeout = client.NewOptInt(timeoutSeconds(subscribeTimeout))
+			}
+			res, err := gc.GETV1BmcSubscriptions(context.Background(), params)
+			if err != nil {
+				return cmd.NewApiError(err)
+			}
+
+			return outputSubscriptions(res)
+		},
+	}
+)
+
+func init() {
+	for _, c := range []*cobra.Command{subscribeCmd, subscriptionsCmd} {
+		c.Flags().IntVar(&subscribeFanout, "fanout", 0, "Number of BMCs queried at once (default bmc.fanout on the server)")
+		c.Flags().DurationVar(&subscribeTimeout, "timeout", 0, "Time allowed for each BMC to respond (default bmc.timeout on the server)")
+		bmcCmd.AddCommand(c)
+	}
+}
+
+// outputSubscriptions prints the subscriptions and fails unless all the
+// nodes are subscribed
+func outputSubscriptions(res []client.EventSubscription) error {
+	if cmd.JSONOutput() {
+		if err := cmd.Output(res); err != nil {
+			return err
+		}
+	} else {
+		if err := printSubscriptions(res); err != nil {
+			return err
+		}
+	}
+
+	unhealthy := 0
+	for _, s := range res {
+		if s.Status.Value != "success" || s.State.Value == model.SubscriptionMissing {
+			unhealthy++
+		}
+	}
+	if unhealthy > 0 {
+		return &cmd.PartialFailureError{Failed: unhealthy, Total: len(res)}
+	}
+
+	return nil
+}
+
+func printSubscriptions(res []client.EventSubscription) error {
+	subscribed := nodeset.EmptyNodeSet()
+	missing := nodeset.EmptyNodeSet()
+	failed := nodeset.EmptyNodeSet()
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 4, ' ', 0)
+	fmt.Fprintln(w, "HOST\tSTATE\tMESSAGE")
+	for _, s := range res {
+		state := s.State.Value
+		switch {
+		case s.Status.Value != "success":
+			state = "failed"
+			failed.Add(s.Host.Value)
+		case state == model.SubscriptionMissing:
+			missing.Add(s.Host.Value)
+		default:
+			subscribed.Add(s.Host.Value)
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\n", s.Host.Value, state, s.Msg.Value)
+	}
+	if err := w.Flush(); err != nil {
+		return err
+	}
+
+	fmt.Println()
+	for _, s := range []struct {
+		name string
+		ns   *nodeset.NodeSet
+	}{{"Subscribed", subscribed}, {"Missing", missing}, {"Failed", failed}} {
+		if s.ns.Len() == 0 {
+			continue
+		}
+		fmt.Printf("%s (%d): %s\n", s.name, s.ns.Len(), s.ns.String())
+	}
+
+	return nil
+}
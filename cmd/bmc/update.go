@@ -36,10 +36,9 @@ Must run bmc upgrade <nodeset> to populate firmware data`,
 				return err
 			}
 
-			nodeset := args[0]
-
-			if nodeset == "all" {
-				nodeset = ""
+			nodeset, err := cmd.NodesetArg(args[0])
+			if err != nil {
+				return err
 			}
 
 			params := client.GETV1BmcUpgradeDellRepoParams{
@@ -51,6 +50,24 @@ Must run bmc upgrade <nodeset> to populate firmware data`,
 				return cmd.NewApiError(err)
 			}
 
+			failed := 0
+			for _, host := range res {
+				if host.Status.Value != "success" {
+					failed++
+				}
+			}
+			var partialErr error
+			if failed > 0 {
+				partialErr = &cmd.PartialFailureError{Failed: failed, Total: len(res)}
+			}
+
+			if cmd.JSONOutput() {
+				if err := cmd.Output(res); err != nil {
+					return err
+				}
+				return partialErr
+			}
+
 			t := table.NewWriter()
 			t.SetOutputMirror(os.Stdout)
 			t.AppendHeader(table.Row{"Host", "Component", "Current Version", "Latest Version", "Reboot Required"})
@@ -63,7 +80,7 @@ Must run bmc upgrade <nodeset> to populate firmware data`,
 
 			for _, host := range res {
 				if host.Status.Value != "success" {
-					fmt.Printf("%s\t%s\n", host.Name.Value, host.Message.Value)
+					fmt.Fprintf(os.Stderr, "%s\t%s\n", host.Name.Value, host.Message.Value)
 				}
 				for _, fw := range host.UpdateList {
 					t.AppendRow(table.Row{
@@ -82,7 +99,7 @@ Must run bmc upgrade <nodeset> to populate firmware data`,
 			t.SetStyle(table.StyleLight)
 			t.Render()
 
-			return nil
+			return partialErr
 		},
 	}
 
@@ -123,11 +140,7 @@ Must run bmc upgrade <nodeset> to populate firmware data`,
 				return cmd.NewApiError(err)
 			}
 
-			for _, jobMessage := range res {
-				fmt.Printf("%s\t %s\t %s\n", jobMessage.Host.Value, jobMessage.Status.Value, jobMessage.Msg.Value)
-			}
-
-			return nil
+			return cmd.NewJobMessageResponse(res)
 		},
 	}
 )
@@ -0,0 +1,156 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package bmc
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/ubccr/grendel/cmd"
+	"github.com/ubccr/grendel/pkg/client"
+)
+
+var (
+	vmediaISO     string
+	vmediaBoot    bool
+	vmediaReboot  bool
+	vmediaFanout  int
+	vmediaTimeout time.Duration
+	vmediaCmd     = &cobra.Command{
+		Use:   "vmedia",
+		Short: "Manage the virtual CD of nodes",
+		Long: `Insert, eject and show ISO images in the Redfish virtual CD of nodes.
+
+Useful for installing nodes without PXE, such as appliances or nodes on
+networks without DHCP.`,
+	}
+	vmediaMountCmd = &cobra.Command{
+		Use:   "mount {nodeset | all}",
+		Short: "Insert an ISO image in the virtual CD of nodes",
+		Long: `Insert an ISO image in the virtual CD of nodes.
+
+Media already inserted is ejected first. Each BMC is checked until it reports
+the image inserted or --timeout is reached. With --boot the nodes boot from the
+virtual CD on the next boot and with --reboot they are power cycled once the
+image is inserted. Errors reported by the BMC are shown verbatim.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(command *cobra.Command, args []string) error {
+			gc, err := cmd.NewOgenClient()
+			if err != nil {
+				return err
+			}
+
+			req := &client.BmcVirtualMediaBody{
+				Image:  client.NewOptString(vmediaISO),
+				Boot:   client.NewOptBool(vmediaBoot),
+				Reboot: client.NewOptBool(vmediaReboot),
+			}
+			if vmediaFanout > 0 {
+				req.Fanout = client.NewOptNilInt(vmediaFanout)
+			}
+			if vmediaTimeout > 0 {
+				req.Timeout = client.NewOptNilInt(timeoutSeconds(vmediaTimeout))
+			}
+
+			nodeset, err := cmd.NodesetArg(args[0])
+			if err != nil {
+				return err
+			}
+
+			params := client.POSTV1BmcVmediaParams{
+				Nodeset: client.NewOptString(nodeset),
+				Tags:    client.NewOptString(strings.Join(tags, ",")),
+			}
+			res, err := gc.POSTV1BmcVmedia(context.Background(), req, params)
+			if err != nil {
+				return cmd.NewApiError(err)
+			}
+
+			return cmd.NewJobTableResponse(res)
+		},
+	}
+	vmediaUnmountCmd = &cobra.Command{
+		Use:   "unmount {nodeset | all}",
+		Short: "Eject the virtual CD of nodes",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(command *cobra.Command, args []string) error {
+			gc, err := cmd.NewOgenClient()
+			if err != nil {
+				return err
+			}
+
+			nodeset, err := cmd.NodesetArg(args[0])
+			if err != nil {
+				return err
+			}
+
+			params := client.DELETEV1BmcVmediaParams{
+				Nodeset: client.NewOptString(nodeset),
+				Tags:    client.NewOptString(strings.Join(tags, ",")),
+			}
+			if vmediaFanout > 0 {
+				params.Fanout = client.NewOptInt(vmediaFanout)
+			}
+			if vmediaTimeout > 0 {
+				params.Timeout = client.NewOptInt(timeoutSeconds(vmediaTimeout))
+			}
+			res, err := gc.DELETEV1BmcVmedia(context.Background(), params)
+			if err != nil {
+				return cmd.NewApiError(err)
+			}
+
+			return cmd.NewJobTableResponse(res)
+		},
+	}
+	vmediaStatusCmd = &cobra.Command{
+		Use:   "status {nodeset | all}",
+		Short: "Show the image in the virtual CD of nodes",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(command *cobra.Command, args []string) error {
+			gc, err := cmd.NewOgenClient()
+			if err != nil {
+				return err
+			}
+
+			nodeset, err := cmd.NodesetArg(args[0])
+			if err != nil {
+				return err
+			}
+
+			params := client.GETV1BmcVmediaParams{
+				Nodeset: client.NewOptString(nodeset),
+				Tags:    client.NewOptString(strings.Join(tags, ",")),
+			}
+			if vmediaFanout > 0 {
+				params.Fanout = client.NewOptInt(vmediaFanout)
+			}
+			if vmediaTimeout > 0 {
+				params.Timeout = client.NewOptInt(timeoutSeconds(vmediaTimeout))
+			}
+			res, err := gc.GETV1BmcVmedia(context.Background(), params)
+			if err != nil {
+				return cmd.NewApiError(err)
+			}
+
+			return cmd.NewJobTableResponse(res)
+		},
+	}
+)
+
+func init() {
+	vmediaMountCmd.Flags().StringVar(&vmediaISO, "iso", "", "URL of the ISO image to insert")
+	vmediaMountCmd.MarkFlagRequired("iso")
+	vmediaMountCmd.Flags().BoolVar(&vmediaBoot, "boot", false, "Boot from the virtual CD on the next boot")
+	vmediaMountCmd.Flags().BoolVar(&vmediaReboot, "reboot", false, "Power cycle the nodes once the image is inserted")
+
+	for _, c := range []*cobra.Command{vmediaMountCmd, vmediaUnmountCmd, vmediaStatusCmd} {
+		c.Flags().IntVar(&vmediaFanout, "fanout", 0, "Number of BMCs contacted at once (default bmc.fanout on the server)")
+		c.Flags().DurationVar(&vmediaTimeout, "timeout", 0, "Time allowed for each BMC (default bmc.timeout on the server)")
+		vmediaCmd.AddCommand(c)
+	}
+	bmcCmd.AddCommand(vmediaCmd)
+}
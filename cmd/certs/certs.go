@@ -0,0 +1,144 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package certs
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/ubccr/grendel/cmd"
+	"github.com/ubccr/grendel/internal/certs"
+)
+
+const (
+	// defaultCAName is the common name of the CA created by certs
+	defaultCAName = "Grendel CA"
+
+	// defaultCADays is the lifetime of the CA created by certs
+	defaultCADays = 3650
+)
+
+var (
+	caCertFile string
+	caKeyFile  string
+	certFile   string
+	keyFile    string
+	commonName string
+	dnsSANs    []string
+	ipSANs     []net.IP
+	days       int
+	keyType    string
+	force      bool
+
+	certsCmd = &cobra.Command{
+		Use:   "certs",
+		Short: "Generate TLS certificates",
+		Long: `Generate a TLS certificate and key for the api or provision service signed by
+the CA in --ca-cert and --ca-key. A new CA is created when neither file
+exists.
+
+The common name is always a DNS subject alternative name. Add the other names
+and IPs clients connect to, such as a service VIP, the short hostname or the
+provisioning interface IP, with --dns-san and --ip-san. Existing files are
+only replaced with --force, renew certificates with "grendel certs renew".`,
+		Args: cobra.NoArgs,
+		RunE: func(command *cobra.Command, args []string) error {
+			if !force {
+				for _, file := range []string{certFile, keyFile} {
+					if _, err := os.Stat(file); err == nil {
+						return fmt.Errorf("%s exists, use --force to replace it", file)
+					}
+				}
+			}
+
+			result := cmd.NewMutationList()
+			ca, created, err := loadOrCreateCA()
+			if err != nil {
+				return err
+			}
+			if created {
+				result.Changed = append(result.Changed, caCertFile, caKeyFile)
+			}
+
+			cert, key, err := ca.Issue(certs.Request{
+				CommonName:  commonName,
+				DNSNames:    dnsSANs,
+				IPAddresses: ipSANs,
+				Days:        days,
+				KeyType:     keyType,
+			})
+			if err != nil {
+				return err
+			}
+
+			if err := certs.WriteFiles(certFile, keyFile, cert, key, ca.Bundle()...); err != nil {
+				return err
+			}
+
+			result.Changed = append(result.Changed, certFile, keyFile)
+			result.Count = len(result.Changed)
+			result.Detail = fmt.Sprintf("wrote certificate %s and key %s, expires %s", certFile, keyFile, cert.NotAfter.Local().Format(time.RFC3339))
+
+			return printResult(result)
+		},
+	}
+)
+
+func init() {
+	hostname, _ := os.Hostname()
+
+	certsCmd.PersistentFlags().StringVar(&caCertFile, "ca-cert", "ca.crt", "CA certificate file")
+	certsCmd.PersistentFlags().StringVar(&caKeyFile, "ca-key", "ca.key", "CA key file")
+	certsCmd.PersistentFlags().StringVar(&certFile, "cert", "grendel.crt", "certificate file")
+	certsCmd.PersistentFlags().StringVar(&keyFile, "key", "grendel.key", "key file")
+
+	certsCmd.Flags().StringVar(&commonName, "cn", hostname, "common name")
+	certsCmd.Flags().StringSliceVar(&dnsSANs, "dns-san", []string{}, "DNS subject alternative name, repeatable")
+	certsCmd.Flags().IPSliceVar(&ipSANs, "ip-san", []net.IP{}, "IP subject alternative name, repeatable")
+	certsCmd.Flags().IntVar(&days, "days", 365, "lifetime of the certificate in days")
+	certsCmd.Flags().StringVar(&keyType, "key-type", certs.KeyTypeECDSA, "key type of the certificate and of a new CA. Valid options: rsa, ecdsa")
+	certsCmd.Flags().BoolVar(&force, "force", false, "replace an existing certificate and key")
+
+	cmd.Root.AddCommand(certsCmd)
+}
+
+// loadOrCreateCA loads the CA, or creates it when neither of its files
+// exist and reports whether it did
+func loadOrCreateCA() (*certs.CA, bool, error) {
+	_, certErr := os.Stat(caCertFile)
+	_, keyErr := os.Stat(caKeyFile)
+	if !errors.Is(certErr, os.ErrNotExist) || !errors.Is(keyErr, os.ErrNotExist) {
+		ca, err := certs.LoadCA(caCertFile, caKeyFile)
+		return ca, false, err
+	}
+
+	ca, err := certs.NewCA(defaultCAName, defaultCADays, keyType)
+	if err != nil {
+		return nil, false, err
+	}
+	if err := certs.WriteFiles(caCertFile, caKeyFile, ca.Cert, ca.Key); err != nil {
+		return nil, false, err
+	}
+	if !cmd.JSONOutput() {
+		fmt.Printf("created CA %s and key %s\n", caCertFile, caKeyFile)
+	}
+
+	return ca, true, nil
+}
+
+// printResult prints the files written by a command, as JSON with --output
+// json
+func printResult(result cmd.MutationResult) error {
+	if cmd.JSONOutput() {
+		return cmd.Output(result)
+	}
+
+	fmt.Println(result.Detail)
+	return nil
+}
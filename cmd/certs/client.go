@@ -0,0 +1,203 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package certs
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/ubccr/grendel/cmd"
+	"github.com/ubccr/grendel/internal/certs"
+	"github.com/ubccr/grendel/pkg/client"
+	"github.com/ubccr/grendel/pkg/model"
+)
+
+var (
+	clientOut   string
+	clientServe bool
+	clientTTL   string
+	clientCmd   = &cobra.Command{
+		Use:   "client {nodeset | all}",
+		Short: "Issue node client certificates",
+		Long: `Issue a client certificate for each node signed by the CA in --ca-cert and
+--ca-key. The common name is the FQDN of the boot interface, the subject
+alternative names are the FQDNs and IPs of all interfaces and bonds except the
+BMC. The fingerprint of the certificate is stored on the node.
+
+With --out the key and certificate of each node are written to <node>.key and
+<node>.crt in the directory. With --serve the keys are sent to the server and
+fetched once by the nodes from the client-cert provision endpoint, available
+in kickstart templates as {{ .endpoints.ClientCertURL }}, until --ttl. Keys
+are held in memory only and never stored.
+
+When provision.client_ca is set the provision server asks for client
+certificates and rejects a node presenting one that does not match its stored
+fingerprint.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(command *cobra.Command, args []string) error {
+			if clientOut == "" && !clientServe {
+				return errors.New("one of --out or --serve is required")
+			}
+
+			ca, err := certs.LoadCA(caCertFile, caKeyFile)
+			if err != nil {
+				return err
+			}
+
+			gc, err := cmd.NewOgenClient()
+			if err != nil {
+				return err
+			}
+
+			nodeset, err := cmd.NodesetArg(args[0])
+			if err != nil {
+				return err
+			}
+			res, err := gc.HostList(context.Background(), client.HostFilter{Nodeset: nodeset})
+			if err != nil {
+				return err
+			}
+			hosts, err := modelHosts(res)
+			if err != nil {
+				return err
+			}
+			if len(hosts) == 0 {
+				return errors.New("no nodes found")
+			}
+
+			if clientOut != "" {
+				if err := os.MkdirAll(clientOut, 0700); err != nil {
+					return err
+				}
+			}
+
+			req := &client.NodeClientCertRequest{TTL: client.NewOptString(clientTTL)}
+			for _, host := range hosts {
+				cert, key, err := ca.Issue(clientRequest(host, days, keyType))
+				if err != nil {
+					return fmt.Errorf("failed issuing certificate of %s: %w", host.Name, err)
+				}
+				certPEM, keyPEM, err := certs.EncodePEM(cert, key, ca.Bundle()...)
+				if err != nil {
+					return err
+				}
+
+				if clientOut != "" {
+					err := certs.WriteFiles(filepath.Join(clientOut, host.Name+".crt"), filepath.Join(clientOut, host.Name+".key"), cert, key, ca.Bundle()...)
+					if err != nil {
+						return err
+					}
+				}
+
+				item := client.NodeClientCertRequestCertsItem{
+					Name: client.NewOptString(host.Name),
+					Cert: client.NewOptString(string(certPEM)),
+				}
+				if clientServe {
+					item.Key = client.NewOptString(string(keyPEM))
+				}
+				req.Certs = append(req.Certs, item)
+
+				if !cmd.JSONOutput() {
+					fmt.Printf("%s\t%s\texpires %s\n", host.Name, certs.Fingerprint(cert), cert.NotAfter.Local().Format(time.RFC3339))
+				}
+			}
+
+			resp, err := gc.PUTV1NodesClientCert(context.Background(), req, client.PUTV1NodesClientCertParams{})
+			if err != nil {
+				return cmd.NewApiError(err)
+			}
+
+			return cmd.NewApiResponse(resp)
+		},
+	}
+)
+
+func init() {
+	clientCmd.Flags().StringVar(&clientOut, "out", "", "directory the keys and certificates are written to")
+	clientCmd.Flags().BoolVar(&clientServe, "serve", false, "serve the keys once from the provision server")
+	clientCmd.Flags().StringVar(&clientTTL, "ttl", "1h", "how long served keys are held for pickup")
+	clientCmd.Flags().IntVar(&days, "days", 365, "lifetime of the certificates in days")
+	clientCmd.Flags().StringVar(&keyType, "key-type", certs.KeyTypeECDSA, "key type of the certificates. Valid options: rsa, ecdsa")
+
+	certsCmd.AddCommand(clientCmd)
+}
+
+// modelHosts returns hosts as model hosts
+func modelHosts(hosts []client.Host) (model.HostList, error) {
+	data, err := json.Marshal(hosts)
+	if err != nil {
+		return nil, err
+	}
+
+	var list model.HostList
+	if err := json.Unmarshal(data, &list); err != nil {
+		return nil, err
+	}
+
+	return list, nil
+}
+
+// clientRequest returns the client certificate request of host. The common
+// name is the FQDN of the boot interface, or the host name without one
+func clientRequest(host *model.Host, days int, keyType string) certs.Request {
+	req := certs.Request{
+		CommonName: host.Name,
+		DNSNames:   []string{},
+		Days:       days,
+		KeyType:    keyType,
+		Client:     true,
+	}
+	if boot := host.BootInterface(); boot != nil && boot.HostName() != "" {
+		req.CommonName = boot.HostName()
+	}
+
+	nics := make([]*model.NetInterface, 0, len(host.Interfaces)+len(host.Bonds))
+	nics = append(nics, host.Interfaces...)
+	for _, b := range host.Bonds {
+		nics = append(nics, &b.NetInterface)
+	}
+
+	addName := func(fqdn string) {
+		for _, name := range strings.Split(fqdn, ",") {
+			name = strings.TrimSpace(name)
+			if name != "" && !slices.Contains(req.DNSNames, name) {
+				req.DNSNames = append(req.DNSNames, name)
+			}
+		}
+	}
+	addIP := func(ip net.IP) {
+		if ip != nil && !slices.ContainsFunc(req.IPAddresses, ip.Equal) {
+			req.IPAddresses = append(req.IPAddresses, ip)
+		}
+	}
+
+	for _, nic := range nics {
+		if nic.BMC {
+			continue
+		}
+		addName(nic.FQDN)
+		if nic.IP.IsValid() {
+			addIP(net.IP(nic.IP.Addr().AsSlice()))
+		}
+		for _, a := range nic.Addresses {
+			addName(a.FQDN)
+			if a.IP.IsValid() {
+				addIP(net.IP(a.IP.Addr().AsSlice()))
+			}
+		}
+	}
+
+	return req
+}
@@ -0,0 +1,49 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package certs
+
+import (
+	"net"
+	"net/netip"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/ubccr/grendel/internal/certs"
+	"github.com/ubccr/grendel/pkg/model"
+)
+
+func TestClientRequest(t *testing.T) {
+	host := &model.Host{
+		Name: "cpn-01",
+		Interfaces: []*model.NetInterface{
+			{FQDN: "cpn-01-bmc.example.com", IP: netip.MustParsePrefix("10.1.0.1/24"), BMC: true},
+			{
+				FQDN: "cpn-01.example.com,cpn-01",
+				IP:   netip.MustParsePrefix("10.0.0.1/24"),
+				Addresses: []model.NetAddress{
+					{IP: netip.MustParsePrefix("10.2.0.1/24"), FQDN: "cpn-01-ib.example.com"},
+				},
+			},
+		},
+		Bonds: []*model.Bond{
+			{NetInterface: model.NetInterface{FQDN: "cpn-01.example.com", IP: netip.MustParsePrefix("10.3.0.1/24")}},
+		},
+	}
+
+	req := clientRequest(host, 30, certs.KeyTypeRSA)
+	assert.Equal(t, "cpn-01.example.com", req.CommonName)
+	assert.Equal(t, []string{"cpn-01.example.com", "cpn-01", "cpn-01-ib.example.com"}, req.DNSNames)
+	assert.Equal(t, []net.IP{
+		net.ParseIP("10.0.0.1").To4(),
+		net.ParseIP("10.2.0.1").To4(),
+		net.ParseIP("10.3.0.1").To4(),
+	}, req.IPAddresses)
+	assert.Equal(t, 30, req.Days)
+	assert.True(t, req.Client)
+
+	// Without interfaces the common name is the host name
+	req = clientRequest(&model.Host{Name: "cpn-02"}, 30, certs.KeyTypeRSA)
+	assert.Equal(t, "cpn-02", req.CommonName)
+}
@@ -0,0 +1,103 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package certs
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/ubccr/grendel/cmd"
+	"github.com/ubccr/grendel/internal/certs"
+	"github.com/ubccr/grendel/pkg/client"
+	"github.com/ubccr/grendel/pkg/model"
+)
+
+// crlResult describes the revocation list written by certs crl
+type crlResult struct {
+	Path       string    `json:"path"`
+	Revoked    int       `json:"revoked"`
+	ThisUpdate time.Time `json:"this_update"`
+	NextUpdate time.Time `json:"next_update"`
+}
+
+var (
+	crlOut  string
+	crlDays int
+	crlCmd  = &cobra.Command{
+		Use:   "crl",
+		Short: "Generate a certificate revocation list",
+		Long: `Generate a certificate revocation list of the certificates revoked with
+"grendel certs revoke", signed by the CA in --ca-cert and --ca-key. The list is
+valid for --days, regenerate it from cron before it expires. --out is replaced
+atomically.`,
+		Args: cobra.NoArgs,
+		RunE: func(command *cobra.Command, args []string) error {
+			ca, err := certs.LoadCA(caCertFile, caKeyFile)
+			if err != nil {
+				return err
+			}
+
+			gc, err := cmd.NewOgenClient()
+			if err != nil {
+				return err
+			}
+
+			res, err := gc.GETV1CertsRevoked(context.Background(), client.GETV1CertsRevokedParams{})
+			if err != nil {
+				return cmd.NewApiError(err)
+			}
+
+			revoked := modelRevokedCerts(res)
+			now := time.Now()
+			crl, err := ca.CRL(revoked, crlDays, now)
+			if err != nil {
+				return err
+			}
+
+			tmp := crlOut + ".tmp"
+			if err := os.WriteFile(tmp, crl, 0644); err != nil {
+				return err
+			}
+			if err := os.Rename(tmp, crlOut); err != nil {
+				return err
+			}
+
+			if cmd.JSONOutput() {
+				return cmd.Output(crlResult{
+					Path:       crlOut,
+					Revoked:    len(revoked),
+					ThisUpdate: now.UTC(),
+					NextUpdate: now.AddDate(0, 0, crlDays).UTC(),
+				})
+			}
+
+			fmt.Printf("wrote revocation list %s of %d certificates, valid for %d days\n", crlOut, len(revoked), crlDays)
+			return nil
+		},
+	}
+)
+
+func init() {
+	crlCmd.Flags().StringVar(&crlOut, "out", "crl.pem", "revocation list file")
+	crlCmd.Flags().IntVar(&crlDays, "days", 7, "lifetime of the revocation list in days")
+	certsCmd.AddCommand(crlCmd)
+}
+
+// modelRevokedCerts returns revoked as model revoked certificates
+func modelRevokedCerts(revoked []client.RevokedCert) model.RevokedCertList {
+	list := make(model.RevokedCertList, 0, len(revoked))
+	for _, r := range revoked {
+		list = append(list, &model.RevokedCert{
+			Serial:    r.Serial.Value,
+			Host:      r.Host.Value,
+			RevokedAt: r.RevokedAt.Value,
+		})
+	}
+
+	return list
+}
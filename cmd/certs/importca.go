@@ -0,0 +1,118 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package certs
+
+import (
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/ubccr/grendel/cmd"
+	"github.com/ubccr/grendel/internal/certs"
+)
+
+var (
+	importChain string
+	importForce bool
+	importCACmd = &cobra.Command{
+		Use:   "import-ca <cert> <key>",
+		Short: "Import an existing CA",
+		Long: `Import an existing CA certificate and key, such as an intermediate CA issued
+by an organization PKI, as the CA in --ca-cert and --ca-key used by all other
+certs commands. The certificate must have the CA basic constraint and match
+the key.
+
+The chain of an intermediate CA up to the root is read from the certificate
+file after the CA certificate, or from --chain. Certificates issued by an
+intermediate CA are written followed by the chain without the root.
+
+Replacing a different CA requires --force. Certificates issued by the previous
+CA, such as --cert and the client certificates of nodes, stop validating and
+must be issued again.`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(command *cobra.Command, args []string) error {
+			ca, err := loadImportCA(args[0], args[1], importChain)
+			if err != nil {
+				return err
+			}
+
+			if current, err := certs.LoadCertificate(caCertFile); err == nil && !current.Equal(ca.Cert) {
+				cmd.Log.Warnf("Replacing the CA %s in %s", current.Subject, caCertFile)
+				for _, file := range staleCerts(ca, certFile) {
+					cmd.Log.Warnf("%s was issued by the previous CA and will stop validating, issue it again with grendel certs --force", file)
+				}
+				cmd.Log.Warn("Client certificates of nodes issued by the previous CA will stop validating, issue them again with grendel certs client")
+				if !importForce {
+					return errors.New("a different CA exists, use --force to replace it")
+				}
+			} else if err != nil && !errors.Is(err, os.ErrNotExist) {
+				return err
+			}
+
+			if err := certs.WriteFiles(caCertFile, caKeyFile, ca.Cert, ca.Key, ca.Chain...); err != nil {
+				return err
+			}
+
+			result := cmd.NewMutationList()
+			result.Changed = append(result.Changed, caCertFile, caKeyFile)
+			result.Count = len(result.Changed)
+			result.Detail = fmt.Sprintf("imported CA %s with a chain of %d certificates to %s and key %s", ca.Cert.Subject, len(ca.Chain), caCertFile, caKeyFile)
+
+			return printResult(result)
+		},
+	}
+)
+
+func init() {
+	importCACmd.Flags().StringVar(&importChain, "chain", "", "PEM file with the chain of the CA up to the root")
+	importCACmd.Flags().BoolVar(&importForce, "force", false, "replace a different existing CA")
+	certsCmd.AddCommand(importCACmd)
+}
+
+// loadImportCA loads and validates the CA in certFile and keyFile with the
+// chain in certFile after the CA certificate, then the one in chainFile
+func loadImportCA(certFile, keyFile, chainFile string) (*certs.CA, error) {
+	chain, err := certs.LoadCertificates(certFile)
+	if err != nil {
+		return nil, err
+	}
+	if chainFile != "" {
+		rest, err := certs.LoadCertificates(chainFile)
+		if err != nil {
+			return nil, err
+		}
+		chain = append(chain, rest...)
+	}
+
+	key, err := certs.LoadKey(keyFile)
+	if err != nil {
+		return nil, err
+	}
+
+	ca := &certs.CA{Cert: chain[0], Key: key, Chain: chain[1:]}
+	if err := ca.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid CA %s: %w", certFile, err)
+	}
+
+	return ca, nil
+}
+
+// staleCerts returns the existing files of files holding a certificate not
+// issued by ca
+func staleCerts(ca *certs.CA, files ...string) []string {
+	stale := make([]string, 0)
+	for _, file := range files {
+		cert, err := certs.LoadCertificate(file)
+		if err != nil {
+			continue
+		}
+		if cert.CheckSignatureFrom(ca.Cert) != nil {
+			stale = append(stale, file)
+		}
+	}
+
+	return stale
+}
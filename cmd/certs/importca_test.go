@@ -0,0 +1,45 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package certs
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/ubccr/grendel/internal/certs"
+)
+
+func TestImportCA(t *testing.T) {
+	dir := t.TempDir()
+	previous, err := certs.NewCA("Grendel CA", 30, certs.KeyTypeECDSA)
+	require.NoError(t, err)
+	corporate, err := certs.NewCA("Corporate CA", 30, certs.KeyTypeECDSA)
+	require.NoError(t, err)
+
+	corpCert, corpKey := filepath.Join(dir, "corp.crt"), filepath.Join(dir, "corp.key")
+	require.NoError(t, certs.WriteFiles(corpCert, corpKey, corporate.Cert, corporate.Key))
+	prevCert, prevKey := filepath.Join(dir, "prev.crt"), filepath.Join(dir, "prev.key")
+	require.NoError(t, certs.WriteFiles(prevCert, prevKey, previous.Cert, previous.Key))
+
+	ca, err := loadImportCA(corpCert, corpKey, "")
+	require.NoError(t, err)
+	assert.True(t, ca.Cert.Equal(corporate.Cert))
+	assert.Empty(t, ca.Chain)
+
+	_, err = loadImportCA(corpCert, prevKey, "")
+	assert.ErrorContains(t, err, "does not match")
+	_, err = loadImportCA(corpCert, corpKey, prevCert)
+	assert.ErrorContains(t, err, "is not issued by")
+
+	cert, key, err := previous.Issue(certs.Request{CommonName: "grendel", Days: 1})
+	require.NoError(t, err)
+	serverCert := filepath.Join(dir, "grendel.crt")
+	require.NoError(t, certs.WriteFiles(serverCert, filepath.Join(dir, "grendel.key"), cert, key))
+
+	assert.Equal(t, []string{serverCert}, staleCerts(ca, serverCert, filepath.Join(dir, "missing.crt")))
+	assert.Empty(t, staleCerts(previous, serverCert))
+}
@@ -0,0 +1,139 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package certs
+
+import (
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/ubccr/grendel/cmd"
+	"github.com/ubccr/grendel/internal/certs"
+)
+
+// Info is the summary of a certificate printed by certs info
+type Info struct {
+	Subject     string    `json:"subject"`
+	Issuer      string    `json:"issuer"`
+	DNSNames    []string  `json:"dns_names"`
+	IPAddresses []string  `json:"ip_addresses"`
+	KeyType     string    `json:"key_type"`
+	Serial      string    `json:"serial"`
+	CA          bool      `json:"ca"`
+	NotBefore   time.Time `json:"not_before"`
+	NotAfter    time.Time `json:"not_after"`
+	DaysLeft    int       `json:"days_left"`
+}
+
+var (
+	infoCA  bool
+	infoCmd = &cobra.Command{
+		Use:   "info {<cert> | --ca}",
+		Short: "Show the subject, SANs and expiry of a certificate",
+		Long: `Show the subject, subject alternative names and expiry of the first certificate
+of a PEM file. With --ca show every certificate of the chain of the CA in
+--ca-cert.`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(command *cobra.Command, args []string) error {
+			if infoCA {
+				return showChain(time.Now())
+			}
+			if len(args) != 1 {
+				return errors.New("a certificate file or --ca is required")
+			}
+
+			cert, err := certs.LoadCertificate(args[0])
+			if err != nil {
+				return err
+			}
+
+			info := newInfo(cert, time.Now())
+			if cmd.JSONOutput() {
+				return cmd.Output(info)
+			}
+
+			writeInfo(os.Stdout, info)
+			return nil
+		},
+	}
+)
+
+func init() {
+	infoCmd.Flags().BoolVar(&infoCA, "ca", false, "show the chain of the CA")
+	certsCmd.AddCommand(infoCmd)
+}
+
+// showChain prints the CA certificate and its chain up to the root
+func showChain(now time.Time) error {
+	chain, err := certs.LoadCertificates(caCertFile)
+	if err != nil {
+		return err
+	}
+
+	infos := make([]Info, 0, len(chain))
+	for _, cert := range chain {
+		infos = append(infos, newInfo(cert, now))
+	}
+	if cmd.JSONOutput() {
+		return cmd.Output(infos)
+	}
+
+	for i, info := range infos {
+		if i > 0 {
+			fmt.Println()
+		}
+		writeInfo(os.Stdout, info)
+	}
+
+	return nil
+}
+
+func newInfo(cert *x509.Certificate, now time.Time) Info {
+	info := Info{
+		Subject:     cert.Subject.String(),
+		Issuer:      cert.Issuer.String(),
+		DNSNames:    make([]string, 0, len(cert.DNSNames)),
+		IPAddresses: make([]string, 0, len(cert.IPAddresses)),
+		KeyType:     certs.KeyType(cert),
+		Serial:      certs.Serial(cert),
+		CA:          cert.IsCA,
+		NotBefore:   cert.NotBefore,
+		NotAfter:    cert.NotAfter,
+		DaysLeft:    int(cert.NotAfter.Sub(now).Hours() / 24),
+	}
+	info.DNSNames = append(info.DNSNames, cert.DNSNames...)
+	for _, ip := range cert.IPAddresses {
+		info.IPAddresses = append(info.IPAddresses, ip.String())
+	}
+
+	return info
+}
+
+func writeInfo(out io.Writer, info Info) {
+	w := tabwriter.NewWriter(out, 0, 0, 4, ' ', 0)
+	fmt.Fprintf(w, "Subject\t%s\n", info.Subject)
+	fmt.Fprintf(w, "Issuer\t%s\n", info.Issuer)
+	fmt.Fprintf(w, "DNS SANs\t%s\n", strings.Join(info.DNSNames, ", "))
+	fmt.Fprintf(w, "IP SANs\t%s\n", strings.Join(info.IPAddresses, ", "))
+	fmt.Fprintf(w, "Key type\t%s\n", info.KeyType)
+	fmt.Fprintf(w, "Serial\t%s\n", info.Serial)
+	if info.CA {
+		fmt.Fprintf(w, "CA\t%t\n", info.CA)
+	}
+	fmt.Fprintf(w, "Not before\t%s\n", info.NotBefore.Local().Format(time.RFC3339))
+
+	expiry := fmt.Sprintf("in %d days", info.DaysLeft)
+	if info.NotAfter.Before(time.Now()) {
+		expiry = "EXPIRED"
+	}
+	fmt.Fprintf(w, "Not after\t%s (%s)\n", info.NotAfter.Local().Format(time.RFC3339), expiry)
+	w.Flush()
+}
@@ -0,0 +1,95 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package certs
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/ubccr/grendel/cmd"
+	"github.com/ubccr/grendel/internal/certs"
+	"github.com/ubccr/grendel/internal/util"
+)
+
+var (
+	renewWithin string
+	renewDays   int
+	renewForce  bool
+
+	renewCmd = &cobra.Command{
+		Use:   "renew",
+		Short: "Renew a certificate issued by the CA",
+		Long: `Re-issue the certificate in --cert from the CA in --ca-cert and --ca-key with a
+new key, keeping its common name, subject alternative names, key type and
+lifetime. Nothing is written unless the certificate expires within --within,
+so renew is safe to run from cron. The key and certificate files are each
+replaced atomically and services reload them on their own.`,
+		Args: cobra.NoArgs,
+		RunE: func(command *cobra.Command, args []string) error {
+			window, err := util.ParseDuration(renewWithin)
+			if err != nil {
+				return fmt.Errorf("invalid --within: %w", err)
+			}
+
+			renewed, err := renew(window, renewDays, renewForce, time.Now())
+			if err != nil {
+				return err
+			}
+			result := cmd.NewMutationList()
+			if !renewed {
+				if cmd.JSONOutput() {
+					return cmd.Output(result)
+				}
+				return nil
+			}
+			result.Changed = append(result.Changed, certFile, keyFile)
+			result.Count = len(result.Changed)
+			result.Detail = fmt.Sprintf("renewed certificate %s", certFile)
+
+			return printResult(result)
+		},
+	}
+)
+
+func init() {
+	renewCmd.Flags().StringVar(&renewWithin, "within", "30d", "renew when the certificate expires within the duration")
+	renewCmd.Flags().IntVar(&renewDays, "days", 0, "lifetime of the renewed certificate in days, the lifetime of the current certificate by default")
+	renewCmd.Flags().BoolVar(&renewForce, "force", false, "renew regardless of the expiry")
+	certsCmd.AddCommand(renewCmd)
+}
+
+// renew re-issues the certificate in certFile when it expires within window
+// of now and reports whether it did
+func renew(window time.Duration, days int, force bool, now time.Time) (bool, error) {
+	current, err := certs.LoadCertificate(certFile)
+	if err != nil {
+		return false, err
+	}
+
+	if !force && !certs.Due(current, window, now) {
+		cmd.Log.Infof("Certificate %s expires %s, not due for renewal", certFile, current.NotAfter.Local().Format(time.RFC3339))
+		return false, nil
+	}
+
+	ca, err := certs.LoadCA(caCertFile, caKeyFile)
+	if err != nil {
+		return false, err
+	}
+	if err := current.CheckSignatureFrom(ca.Cert); err != nil {
+		return false, fmt.Errorf("%s was not issued by the CA %s: %w", certFile, caCertFile, err)
+	}
+
+	req := certs.RenewRequest(current)
+	if days > 0 {
+		req.Days = days
+	}
+	cert, key, err := ca.Issue(req)
+	if err != nil {
+		return false, err
+	}
+
+	return true, certs.WriteFiles(certFile, keyFile, cert, key, ca.Bundle()...)
+}
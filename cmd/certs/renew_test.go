@@ -0,0 +1,82 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package certs
+
+import (
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/ubccr/grendel/internal/certs"
+)
+
+func TestRenew(t *testing.T) {
+	dir := t.TempDir()
+	caCertFile, caKeyFile = filepath.Join(dir, "ca.crt"), filepath.Join(dir, "ca.key")
+	certFile, keyFile = filepath.Join(dir, "grendel.crt"), filepath.Join(dir, "grendel.key")
+	keyType = certs.KeyTypeECDSA
+
+	ca, _, err := loadOrCreateCA()
+	require.NoError(t, err)
+	cert, key, err := ca.Issue(certs.Request{
+		CommonName:  "grendel",
+		DNSNames:    []string{"grendel-vip"},
+		IPAddresses: []net.IP{net.ParseIP("10.0.0.254")},
+		Days:        90,
+	})
+	require.NoError(t, err)
+	require.NoError(t, certs.WriteFiles(certFile, keyFile, cert, key))
+	before, err := os.ReadFile(certFile)
+	require.NoError(t, err)
+
+	// Not due, the files are left alone
+	renewed, err := renew(30*24*time.Hour, 0, false, time.Now())
+	require.NoError(t, err)
+	assert.False(t, renewed)
+	after, err := os.ReadFile(certFile)
+	require.NoError(t, err)
+	assert.Equal(t, before, after)
+
+	renewed, err = renew(30*24*time.Hour, 0, false, time.Now().AddDate(0, 0, 70))
+	require.NoError(t, err)
+	assert.True(t, renewed)
+
+	renewedCert, err := certs.LoadCertificate(certFile)
+	require.NoError(t, err)
+	assert.NotEqual(t, cert.SerialNumber, renewedCert.SerialNumber)
+	assert.Equal(t, cert.DNSNames, renewedCert.DNSNames)
+	assert.Equal(t, cert.IPAddresses, renewedCert.IPAddresses)
+	assert.Equal(t, 90, certs.RenewRequest(renewedCert).Days)
+
+	// A certificate of another CA is not renewed
+	other, err := certs.NewCA("Other CA", 30, certs.KeyTypeECDSA)
+	require.NoError(t, err)
+	cert, key, err = other.Issue(certs.Request{CommonName: "grendel", Days: 1})
+	require.NoError(t, err)
+	require.NoError(t, certs.WriteFiles(certFile, keyFile, cert, key))
+	_, err = renew(30*24*time.Hour, 0, false, time.Now())
+	assert.Error(t, err)
+}
+
+func TestInfo(t *testing.T) {
+	ca, err := certs.NewCA("Test CA", 30, certs.KeyTypeRSA)
+	require.NoError(t, err)
+	cert, _, err := ca.Issue(certs.Request{CommonName: "grendel", IPAddresses: []net.IP{net.ParseIP("10.0.0.254")}, Days: 10})
+	require.NoError(t, err)
+
+	info := newInfo(cert, time.Now())
+	assert.Equal(t, "CN=grendel", info.Subject)
+	assert.Equal(t, "CN=Test CA", info.Issuer)
+	assert.Equal(t, []string{"grendel"}, info.DNSNames)
+	assert.Equal(t, []string{"10.0.0.254"}, info.IPAddresses)
+	assert.Equal(t, "ecdsa", info.KeyType)
+	assert.Equal(t, 9, info.DaysLeft)
+
+	assert.Equal(t, -1, newInfo(cert, time.Now().AddDate(0, 0, 11)).DaysLeft)
+}
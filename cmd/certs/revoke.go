@@ -0,0 +1,69 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package certs
+
+import (
+	"context"
+	"errors"
+
+	"github.com/spf13/cobra"
+	"github.com/ubccr/grendel/cmd"
+	"github.com/ubccr/grendel/internal/certs"
+	"github.com/ubccr/grendel/pkg/client"
+)
+
+var (
+	revokeHost string
+	revokeCmd  = &cobra.Command{
+		Use:   "revoke {<serial>... | --host <nodeset>}",
+		Short: "Revoke certificates",
+		Long: `Revoke certificates issued by the CA by serial number in hex, as shown by
+"grendel certs info" or openssl, or revoke the client certificates of nodes
+with --host. Revocations are recorded by the server, the provision server
+rejects revoked client certificates right away and "grendel certs crl" lists
+them in a certificate revocation list.`,
+		RunE: func(command *cobra.Command, args []string) error {
+			if len(args) == 0 && revokeHost == "" {
+				return errors.New("a serial number or --host is required")
+			}
+
+			serials := make([]string, 0, len(args))
+			for _, arg := range args {
+				serial, err := certs.ParseSerial(arg)
+				if err != nil {
+					return err
+				}
+				serials = append(serials, serial)
+			}
+
+			gc, err := cmd.NewOgenClient()
+			if err != nil {
+				return err
+			}
+
+			return revokeCerts(gc, serials, revokeHost)
+		},
+	}
+)
+
+func init() {
+	revokeCmd.Flags().StringVar(&revokeHost, "host", "", "revoke the client certificates of the nodes in the nodeset")
+	certsCmd.AddCommand(revokeCmd)
+}
+
+// revokeCerts revokes the certificates with the given serial numbers and
+// the client certificates of the nodes in nodeset
+func revokeCerts(gc *client.Client, serials []string, nodeset string) error {
+	req := &client.CertRevokeRequest{
+		Serials: serials,
+		Nodeset: client.NewOptString(nodeset),
+	}
+	res, err := gc.POSTV1CertsRevoke(context.Background(), req, client.POSTV1CertsRevokeParams{})
+	if err != nil {
+		return cmd.NewApiError(err)
+	}
+
+	return cmd.NewApiResponse(res)
+}
@@ -0,0 +1,160 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package config
+
+import (
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"github.com/ubccr/grendel/cmd"
+)
+
+const (
+	SourceDefault = "default"
+	SourceFile    = "file"
+	SourceEnv     = "env"
+	SourceFlag    = "flag"
+
+	redacted = "********"
+)
+
+var (
+	// flagKeys maps config keys to the global flags bound to them. Flags of
+	// other commands are never set when running config commands
+	flagKeys = map[string]string{
+		"client.api_endpoint": "endpoint",
+		"output":              "output",
+	}
+
+	configCmd = &cobra.Command{
+		Use:   "config",
+		Short: "Configuration commands",
+		Long:  `Configuration commands`,
+	}
+)
+
+// Setting is a resolved configuration key with the source of its value
+type Setting struct {
+	Key    string `json:"key"`
+	Value  any    `json:"value"`
+	Source string `json:"source"`
+}
+
+func init() {
+	cmd.Root.AddCommand(configCmd)
+}
+
+// resolve returns the effective value and source of key. Values of secret
+// keys are redacted.
+func resolve(command *cobra.Command, key string) Setting {
+	s := Setting{Key: key, Value: viper.Get(key), Source: SourceDefault}
+
+	if name, ok := flagKeys[key]; ok && command.Flags().Changed(name) {
+		s.Source = SourceFlag
+	} else if _, ok := os.LookupEnv(envName(key)); ok {
+		s.Source = SourceEnv
+	} else if viper.InConfig(key) {
+		s.Source = SourceFile
+	}
+
+	if v, ok := s.Value.(fmt.Stringer); ok {
+		s.Value = v.String()
+	}
+
+	s.Value = redact(key, s.Value)
+
+	return s
+}
+
+// redact returns a copy of the value of key with every non-empty value of a
+// secret key redacted, including the items of lists and the values nested in
+// tables and arrays of tables
+func redact(key string, value any) any {
+	if isSecret(key) {
+		switch v := value.(type) {
+		case nil:
+			return nil
+		case string:
+			if v == "" {
+				return v
+			}
+		case []string:
+			list := make([]string, len(v))
+			for i, item := range v {
+				list[i] = redact(key, item).(string)
+			}
+			return list
+		case []any:
+			list := make([]any, len(v))
+			for i, item := range v {
+				list[i] = redact(key, item)
+			}
+			return list
+		}
+		return redacted
+	}
+
+	switch v := value.(type) {
+	case map[string]any:
+		table := make(map[string]any, len(v))
+		for k, item := range v {
+			table[k] = redact(key+"."+k, item)
+		}
+		return table
+	case []map[string]any:
+		list := make([]map[string]any, len(v))
+		for i, item := range v {
+			list[i] = redact(key, item).(map[string]any)
+		}
+		return list
+	case []any:
+		list := make([]any, len(v))
+		for i, item := range v {
+			list[i] = redact(key, item)
+		}
+		return list
+	}
+
+	return value
+}
+
+// settings returns all config keys sorted by name
+func settings(command *cobra.Command, includeDefaults bool) []Setting {
+	keys := viper.AllKeys()
+	slices.Sort(keys)
+
+	list := make([]Setting, 0, len(keys))
+	for _, k := range keys {
+		s := resolve(command, k)
+		if !includeDefaults && s.Source == SourceDefault {
+			continue
+		}
+		list = append(list, s)
+	}
+
+	return list
+}
+
+func envName(key string) string {
+	return "GRENDEL_" + strings.ToUpper(strings.ReplaceAll(key, ".", "_"))
+}
+
+// isSecret returns true if the last segment of key names a secret, password,
+// token, API key or credentials key. Settings of these, such as token_ttl, are
+// not secrets
+func isSecret(key string) bool {
+	name := key[strings.LastIndex(key, ".")+1:]
+	for _, s := range []string{"secret", "password", "token", "api_key", "credentials_key"} {
+		if strings.HasSuffix(name, s) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,103 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package config
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIsSecret(t *testing.T) {
+	assert.True(t, isSecret("api.secret"))
+	assert.True(t, isSecret("bmc.password"))
+	assert.True(t, isSecret("credentials_key"))
+	assert.True(t, isSecret("provision.netbox_token"))
+	assert.True(t, isSecret("client.api_key"))
+	assert.False(t, isSecret("secret.listen"))
+	assert.False(t, isSecret("dhcp.lease_time"))
+	assert.False(t, isSecret("provision.token_ttl"))
+	assert.False(t, isSecret("provision.token_rate_limit_burst"))
+}
+
+func TestSettings(t *testing.T) {
+	viper.Reset()
+	defer viper.Reset()
+
+	viper.SetConfigType("toml")
+	err := viper.ReadConfig(strings.NewReader("[bmc]\npassword = \"hunter2\"\n[provision]\ntoken_ttl = 60\n"))
+	if !assert.NoError(t, err) {
+		return
+	}
+	viper.SetDefault("dhcp.lease_time", 24*time.Hour)
+	viper.SetDefault("dhcp.listen", "0.0.0.0:67")
+	viper.AutomaticEnv()
+	viper.SetEnvPrefix("grendel")
+	viper.SetEnvKeyReplacer(strings.NewReplacer(".", "_"))
+	t.Setenv("GRENDEL_DHCP_LISTEN", "127.0.0.1:67")
+
+	command := &cobra.Command{}
+	list := settings(command, true)
+	if assert.Len(t, list, 4) {
+		assert.Equal(t, Setting{Key: "bmc.password", Value: redacted, Source: SourceFile}, list[0])
+		assert.Equal(t, Setting{Key: "dhcp.lease_time", Value: "24h0m0s", Source: SourceDefault}, list[1])
+		assert.Equal(t, Setting{Key: "dhcp.listen", Value: "127.0.0.1:67", Source: SourceEnv}, list[2])
+		assert.Equal(t, int64(60), list[3].Value)
+	}
+
+	list = settings(command, false)
+	assert.Len(t, list, 3)
+
+	var buf bytes.Buffer
+	err = writeYAML(&buf, list)
+	if assert.NoError(t, err) {
+		assert.Equal(t, "bmc:\n  password: '********' # file\ndhcp:\n  listen: 127.0.0.1:67 # env\nprovision:\n  token_ttl: 60 # file\n", buf.String())
+	}
+}
+
+func TestRedactNested(t *testing.T) {
+	viper.Reset()
+	defer viper.Reset()
+
+	viper.SetConfigType("toml")
+	err := viper.ReadConfig(strings.NewReader(`
+[api]
+secret = ["old-secret", "new-secret"]
+[provision]
+secret = ""
+[[provision.hooks]]
+name = "notify"
+url = "https://hooks.example.com"
+secret = "hunter2"
+[[provision.hooks]]
+name = "run"
+command = ["/usr/local/bin/hook"]
+`))
+	require.NoError(t, err)
+
+	command := &cobra.Command{}
+	assert.Equal(t, []any{redacted, redacted}, resolve(command, "api.secret").Value)
+	assert.Equal(t, "", resolve(command, "provision.secret").Value)
+
+	hooks := resolve(command, "provision.hooks").Value
+	assert.Equal(t, []any{
+		map[string]any{"name": "notify", "url": "https://hooks.example.com", "secret": redacted},
+		map[string]any{"name": "run", "command": []any{"/usr/local/bin/hook"}},
+	}, hooks)
+
+	// The config itself is left as is
+	assert.Equal(t, "hunter2", viper.Get("provision.hooks").([]any)[0].(map[string]any)["secret"])
+
+	var buf bytes.Buffer
+	require.NoError(t, writeYAML(&buf, settings(command, false)))
+	assert.NotContains(t, buf.String(), "hunter2")
+	assert.NotContains(t, buf.String(), "old-secret")
+}
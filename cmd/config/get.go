@@ -0,0 +1,41 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package config
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"github.com/ubccr/grendel/cmd"
+)
+
+var (
+	getCmd = &cobra.Command{
+		Use:   "get <key>",
+		Short: "Print the effective value of a config key",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(command *cobra.Command, args []string) error {
+			key := strings.ToLower(args[0])
+			if !slices.Contains(viper.AllKeys(), key) {
+				return fmt.Errorf("config key not set: %s", key)
+			}
+
+			s := resolve(command, key)
+			if cmd.JSONOutput() {
+				return cmd.Output(s)
+			}
+
+			fmt.Println(s.Value)
+			return nil
+		},
+	}
+)
+
+func init() {
+	configCmd.AddCommand(getCmd)
+}
@@ -0,0 +1,58 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package config
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/ubccr/grendel/cmd"
+	"github.com/ubccr/grendel/pkg/client"
+)
+
+var (
+	reloadCmd = &cobra.Command{
+		Use:   "reload",
+		Short: "Reload the configuration file of the server",
+		Long: `Reload the configuration file of the running grendel server, like sending
+it SIGHUP. Subnets, DNS servers, lease time, BMC discovery, BIOS profiles,
+provisioning templates and the --hosts and --images files are applied
+immediately. Changes to settings read once at startup, such as listen
+addresses, are reported and only take effect after a restart.
+
+The configuration is checked in full before applying any of it, an invalid
+configuration is rejected and the server keeps running with the current one.`,
+		Args: cobra.NoArgs,
+		RunE: func(command *cobra.Command, args []string) error {
+			gc, err := cmd.NewOgenClient()
+			if err != nil {
+				return err
+			}
+
+			res, err := gc.POSTV1GrendelReload(context.Background(), client.POSTV1GrendelReloadParams{})
+			if err != nil {
+				return cmd.NewApiError(err)
+			}
+
+			if cmd.JSONOutput() {
+				return cmd.Output(res)
+			}
+
+			fmt.Printf("%s: %s\n", res.GetTitle().Value, res.GetDetail().Value)
+			fmt.Printf("changed: %s\n", strings.Join(res.Changed, ", "))
+			if len(res.RestartRequired) > 0 {
+				fmt.Printf("restart required: %s\n", strings.Join(res.RestartRequired, ", "))
+			}
+
+			return nil
+		},
+	}
+)
+
+func init() {
+	configCmd.AddCommand(reloadCmd)
+}
@@ -0,0 +1,82 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package config
+
+import (
+	"io"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/ubccr/grendel/cmd"
+	"gopkg.in/yaml.v3"
+)
+
+var (
+	includeDefaults bool
+	showCmd         = &cobra.Command{
+		Use:   "show",
+		Short: "Show the effective configuration",
+		Long: `Show the effective configuration.
+
+Prints every config key with its resolved value and the source of the value:
+default, file, env or flag. Secrets, passwords, tokens and API keys are
+redacted. Use --output json for a list of key, value and source.`,
+		Args: cobra.NoArgs,
+		RunE: func(command *cobra.Command, args []string) error {
+			list := settings(command, includeDefaults)
+			if cmd.JSONOutput() {
+				return cmd.Output(list)
+			}
+
+			return writeYAML(os.Stdout, list)
+		},
+	}
+)
+
+func init() {
+	showCmd.Flags().BoolVar(&includeDefaults, "include-defaults", true, "include keys set from defaults")
+	configCmd.AddCommand(showCmd)
+}
+
+// writeYAML writes the settings as nested YAML with the source of each value
+// as a line comment
+func writeYAML(w io.Writer, list []Setting) error {
+	root := &yaml.Node{Kind: yaml.MappingNode}
+	for _, s := range list {
+		parent := root
+		parts := strings.Split(s.Key, ".")
+		for _, p := range parts[:len(parts)-1] {
+			parent = mappingChild(parent, p)
+		}
+
+		value := &yaml.Node{}
+		if err := value.Encode(s.Value); err != nil {
+			return err
+		}
+		value.LineComment = s.Source
+		parent.Content = append(parent.Content, &yaml.Node{Kind: yaml.ScalarNode, Value: parts[len(parts)-1]}, value)
+	}
+
+	enc := yaml.NewEncoder(w)
+	enc.SetIndent(2)
+	defer enc.Close()
+
+	return enc.Encode(&yaml.Node{Kind: yaml.DocumentNode, Content: []*yaml.Node{root}})
+}
+
+// mappingChild returns the mapping node for key in parent, creating it if needed
+func mappingChild(parent *yaml.Node, key string) *yaml.Node {
+	for i := 0; i+1 < len(parent.Content); i += 2 {
+		if parent.Content[i].Value == key && parent.Content[i+1].Kind == yaml.MappingNode {
+			return parent.Content[i+1]
+		}
+	}
+
+	child := &yaml.Node{Kind: yaml.MappingNode}
+	parent.Content = append(parent.Content, &yaml.Node{Kind: yaml.ScalarNode, Value: key}, child)
+
+	return child
+}
@@ -0,0 +1,143 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package db
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"github.com/ubccr/grendel/cmd"
+	"github.com/ubccr/grendel/internal/api"
+	"github.com/ubccr/grendel/internal/store/backup"
+	"github.com/ubccr/grendel/internal/store/sqlstore"
+	"github.com/ubccr/grendel/pkg/client"
+)
+
+var (
+	backupLocal bool
+	backupCmd   = &cobra.Command{
+		Use:   "backup <filename>",
+		Short: "Backup database",
+		Long: `Write a consistent point in time backup of the database to a zstd
+compressed tar archive, named .tar.zst by convention. The archive holds a
+manifest with the Grendel version, schema version, entry counts and checksums
+and a snapshot of the database.
+
+By default the backup is streamed from the API server. Use --local to read
+the database file set by dbpath directly, which is safe while grendel serve
+is running.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(command *cobra.Command, args []string) error {
+			filename := args[0]
+
+			// Write to a temporary file so a failed backup never leaves a
+			// truncated archive behind
+			tmp, err := os.CreateTemp(filepath.Dir(filename), ".grendel-backup-")
+			if err != nil {
+				return err
+			}
+			defer os.Remove(tmp.Name())
+			defer tmp.Close()
+
+			if backupLocal {
+				err = localBackup(tmp)
+			} else {
+				err = remoteBackup(tmp)
+			}
+			if err != nil {
+				return err
+			}
+
+			if err := tmp.Close(); err != nil {
+				return err
+			}
+
+			// Verify the archive before replacing filename
+			manifest, err := verifyBackup(tmp.Name())
+			if err != nil {
+				return err
+			}
+
+			if err := os.Rename(tmp.Name(), filename); err != nil {
+				return err
+			}
+
+			cmd.Log.Infof("Backup written to %s: version=%s schema=%d hosts=%d images=%d users=%d dns_records=%d",
+				filename, manifest.Version, manifest.SchemaVersion, manifest.Counts.Hosts,
+				manifest.Counts.Images, manifest.Counts.Users, manifest.Counts.DNSRecords)
+
+			return nil
+		},
+	}
+)
+
+func init() {
+	backupCmd.Flags().BoolVar(&backupLocal, "local", false, "read the local database file instead of the API server")
+	dbCmd.AddCommand(backupCmd)
+}
+
+func localBackup(w io.Writer) error {
+	filename := dbFilename()
+	if filename == ":memory:" {
+		return fmt.Errorf("--local requires a database file, set dbpath")
+	}
+	if _, err := os.Stat(filename); err != nil {
+		return err
+	}
+
+	db, err := sqlstore.New(filename)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	_, err = backup.Write(w, db, api.Version)
+	return err
+}
+
+func remoteBackup(w io.Writer) error {
+	gc, err := cmd.NewOgenClient()
+	if err != nil {
+		return err
+	}
+
+	res, err := gc.GETV1DbBackup(context.Background(), client.GETV1DbBackupParams{})
+	if err != nil {
+		return cmd.NewApiError(err)
+	}
+
+	_, err = io.Copy(w, res)
+	return err
+}
+
+func verifyBackup(filename string) (*backup.Manifest, error) {
+	file, err := os.Open(filename)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	dir, err := os.MkdirTemp("", "grendel-verify-")
+	if err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(dir)
+
+	return backup.Extract(file, dir)
+}
+
+// dbFilename returns the local sqlite database file
+func dbFilename() string {
+	if dsn := viper.GetString("dsn"); dsn != "" {
+		return dsn
+	}
+
+	return viper.GetString("dbpath")
+}
@@ -0,0 +1,126 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package db
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/ubccr/grendel/cmd"
+	"github.com/ubccr/grendel/internal/store/sqlstore"
+	"github.com/ubccr/grendel/pkg/model"
+)
+
+// problemMissingFile is a boot image referencing a file that does not exist
+const problemMissingFile = "missing"
+
+var (
+	checkRepair bool
+	checkCmd    = &cobra.Command{
+		Use:   "check",
+		Short: "Check the database for problems",
+		Long: `Check the database file set by dbpath for corruption, orphaned rows, stale
+index entries, hosts with unparseable MAC or IP addresses and boot images
+referencing missing files.
+
+Use --repair to delete orphaned rows and rebuild the indexes. The other
+problems are only reported and need to be fixed by hand. Refuses to run
+while grendel serve is using the database.`,
+		Args: cobra.NoArgs,
+		RunE: func(command *cobra.Command, args []string) error {
+			problems, err := localCheck(checkRepair)
+			if err != nil {
+				return err
+			}
+
+			if cmd.JSONOutput() {
+				if err := cmd.Output(problems); err != nil {
+					return err
+				}
+			} else {
+				for _, p := range problems {
+					status := ""
+					if p.Repaired {
+						status = " (repaired)"
+					}
+					fmt.Printf("%-10s%-40s%s%s\n", p.Kind, p.Name, p.Detail, status)
+				}
+			}
+
+			unrepaired := 0
+			for _, p := range problems {
+				if !p.Repaired {
+					unrepaired++
+				}
+			}
+			if unrepaired > 0 {
+				return fmt.Errorf("found %d problems that were not repaired", unrepaired)
+			}
+
+			cmd.Log.Infof("Checked %s: %d problems repaired", dbFilename(), len(problems))
+
+			return nil
+		},
+	}
+)
+
+func init() {
+	checkCmd.Flags().BoolVar(&checkRepair, "repair", false, "delete orphaned rows and rebuild indexes")
+	dbCmd.AddCommand(checkCmd)
+}
+
+func localCheck(repair bool) ([]sqlstore.Problem, error) {
+	filename := dbFilename()
+	if filename == ":memory:" {
+		return nil, fmt.Errorf("check requires a database file, set dbpath")
+	}
+	if _, err := os.Stat(filename); err != nil {
+		return nil, err
+	}
+
+	lock, err := sqlstore.Lock(filename)
+	if err != nil {
+		return nil, fmt.Errorf("stop grendel serve before checking the database: %w", err)
+	}
+	defer lock.Unlock()
+
+	db, err := sqlstore.New(filename)
+	if err != nil {
+		return nil, err
+	}
+	defer db.Close()
+
+	problems, err := db.Check(repair)
+	if err != nil {
+		return nil, err
+	}
+
+	images, err := db.BootImages()
+	if err != nil {
+		return nil, err
+	}
+
+	return append(problems, imageProblems(images)...), nil
+}
+
+// imageProblems returns the kernel, initrd and live image files of the boot
+// images that do not exist
+func imageProblems(images model.BootImageList) []sqlstore.Problem {
+	problems := make([]sqlstore.Problem, 0)
+	for _, image := range images {
+		for _, f := range image.Files() {
+			if _, err := os.Stat(f); err != nil {
+				problems = append(problems, sqlstore.Problem{
+					Kind:   problemMissingFile,
+					Name:   image.Name,
+					Detail: err.Error(),
+				})
+			}
+		}
+	}
+
+	return problems
+}
@@ -5,8 +5,8 @@
 package db
 
 import (
+	"bytes"
 	"context"
-	"encoding/json"
 	"os"
 
 	"github.com/spf13/cobra"
@@ -15,35 +15,50 @@ import (
 )
 
 var (
-	dumpCmd = &cobra.Command{
+	dumpOut            string
+	dumpIncludeSecrets bool
+	dumpCmd            = &cobra.Command{
 		Use:   "dump",
 		Short: "Dump database",
-		Long:  `Dump database`,
-		Args:  cobra.ExactArgs(0),
+		Long: `Dump the hosts, images, users and DNS records in the database as JSON.
+
+Entries are sorted by name so repeated dumps of the same data are identical
+and can be kept in git. The dump can be applied with db load or db restore.
+
+Node credentials are only included with --include-secrets. They stay
+encrypted with the credentials key of the server and are applied by db
+restore on a server with the same key.`,
+		Args: cobra.ExactArgs(0),
 		RunE: func(command *cobra.Command, args []string) error {
 			gc, err := cmd.NewOgenClient()
 			if err != nil {
 				return err
 			}
 
-			params := client.GETV1DbDumpParams{}
+			params := client.GETV1DbDumpParams{
+				IncludeSecrets: client.NewOptBool(dumpIncludeSecrets),
+			}
 			res, err := gc.GETV1DbDump(context.Background(), params)
 			if err != nil {
 				return cmd.NewApiError(err)
 			}
 
-			enc := json.NewEncoder(os.Stdout)
-			enc.SetIndent("", "    ")
-			if err := enc.Encode(res); err != nil {
-				return err
+			if dumpOut == "" {
+				return cmd.Output(res)
 			}
 
-			return nil
+			var buf bytes.Buffer
+			if err := cmd.WriteJSON(&buf, res); err != nil {
+				return err
+			}
 
+			return os.WriteFile(dumpOut, buf.Bytes(), 0600)
 		},
 	}
 )
 
 func init() {
+	dumpCmd.Flags().StringVar(&dumpOut, "out", "", "write the dump to a file instead of stdout")
+	dumpCmd.Flags().BoolVar(&dumpIncludeSecrets, "include-secrets", false, "include the encrypted node credentials")
 	dbCmd.AddCommand(dumpCmd)
 }
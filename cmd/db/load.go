@@ -0,0 +1,113 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package db
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/ubccr/grendel/cmd"
+	"github.com/ubccr/grendel/pkg/client"
+)
+
+var (
+	loadPrune  bool
+	loadDryRun bool
+	loadCmd    = &cobra.Command{
+		Use:   "load <filename>",
+		Short: "Load database state from a dump",
+		Long: `Make the hosts, images and DNS records in the database match a JSON
+dump written by db dump. Entries are matched by name. All changes are
+applied in a single transaction and a summary of the added (+), updated (~)
+and removed (-) entries is printed.
+
+Entries missing from the dump are kept unless --prune is set. Users and
+credentials in the dump are ignored. Use --dry-run to print the changes
+without applying them.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(command *cobra.Command, args []string) error {
+			gc, err := cmd.NewOgenClient()
+			if err != nil {
+				return err
+			}
+
+			dump, err := os.ReadFile(args[0])
+			if err != nil {
+				return fmt.Errorf("failed to open file. name=%s err=%w", args[0], err)
+			}
+
+			// The dump and the request share the same schema
+			data, err := json.Marshal(map[string]any{
+				"dump":    json.RawMessage(dump),
+				"prune":   loadPrune,
+				"dry_run": loadDryRun,
+			})
+			if err != nil {
+				return fmt.Errorf("failed to decode json: %w", err)
+			}
+
+			req := &client.DataLoadRequest{}
+			if err := req.UnmarshalJSON(data); err != nil {
+				return fmt.Errorf("failed to decode json: %w", err)
+			}
+
+			res, err := gc.POSTV1DbLoad(context.Background(), req, client.POSTV1DbLoadParams{})
+			if err != nil {
+				return cmd.NewApiError(err)
+			}
+
+			if cmd.JSONOutput() {
+				return cmd.Output(res)
+			}
+
+			writeLoadDiff(os.Stdout, res.Diff.Value)
+			cmd.Log.Info(res.Detail.Value)
+
+			return nil
+		},
+	}
+)
+
+func init() {
+	loadCmd.Flags().BoolVar(&loadPrune, "prune", false, "remove hosts, images and DNS records missing from the dump")
+	loadCmd.Flags().BoolVar(&loadDryRun, "dry-run", false, "print the changes without applying them")
+	dbCmd.AddCommand(loadCmd)
+}
+
+func writeLoadDiff(w io.Writer, diff client.DataLoadResponseDiff) {
+	kinds := []struct {
+		name                    string
+		added, updated, removed []string
+	}{
+		{"host", diff.Hosts.Value.Added, diff.Hosts.Value.Updated, diff.Hosts.Value.Removed},
+		{"image", diff.Images.Value.Added, diff.Images.Value.Updated, diff.Images.Value.Removed},
+		{"dns record", diff.DNSRecords.Value.Added, diff.DNSRecords.Value.Updated, diff.DNSRecords.Value.Removed},
+	}
+
+	changed := 0
+	for _, k := range kinds {
+		changed += len(k.added) + len(k.updated) + len(k.removed)
+	}
+	if changed == 0 {
+		fmt.Fprintln(w, "No changes")
+		return
+	}
+
+	for _, k := range kinds {
+		for _, name := range k.added {
+			fmt.Fprintf(w, "+ %s %s\n", k.name, name)
+		}
+		for _, name := range k.updated {
+			fmt.Fprintf(w, "~ %s %s\n", k.name, name)
+		}
+		for _, name := range k.removed {
+			fmt.Fprintf(w, "- %s %s\n", k.name, name)
+		}
+	}
+}
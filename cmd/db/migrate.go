@@ -0,0 +1,53 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package db
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/ubccr/grendel/cmd"
+	"github.com/ubccr/grendel/internal/store/backup"
+	"github.com/ubccr/grendel/internal/store/sqlstore"
+)
+
+var (
+	migrateFrom string
+	migrateTo   string
+	migrateCmd  = &cobra.Command{
+		Use:   "migrate --from <filename> --to <filename>",
+		Short: "Migrate a database to the current format",
+		Long: `Migrate a database to a new database file in the current format.
+
+The source can be a database file of any earlier schema version, a backup
+archive written by db backup or a JSON dump written by db dump, including
+dumps of the old schema with the ksuid in "id". The source is never modified
+and the target must not exist. Refuses to migrate a database file in use by
+grendel serve.`,
+		Args: cobra.NoArgs,
+		RunE: func(command *cobra.Command, args []string) error {
+			counts, err := backup.Migrate(migrateFrom, migrateTo)
+			if errors.Is(err, sqlstore.ErrLocked) {
+				return fmt.Errorf("stop grendel serve before migrating the database: %w", err)
+			} else if err != nil {
+				return err
+			}
+
+			cmd.Log.Infof("Migrated %s to %s: hosts=%d images=%d users=%d dns_records=%d",
+				migrateFrom, migrateTo, counts.Hosts, counts.Images, counts.Users, counts.DNSRecords)
+
+			return nil
+		},
+	}
+)
+
+func init() {
+	migrateCmd.Flags().StringVar(&migrateFrom, "from", "", "database file, backup archive or JSON dump to migrate")
+	migrateCmd.Flags().StringVar(&migrateTo, "to", "", "database file to create")
+	migrateCmd.MarkFlagRequired("from")
+	migrateCmd.MarkFlagRequired("to")
+	dbCmd.AddCommand(migrateCmd)
+}
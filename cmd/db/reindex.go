@@ -0,0 +1,80 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package db
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/ubccr/grendel/cmd"
+	"github.com/ubccr/grendel/internal/store/sqlstore"
+	"github.com/ubccr/grendel/pkg/client"
+)
+
+var (
+	reindexLocal bool
+	reindexCmd   = &cobra.Command{
+		Use:   "reindex",
+		Short: "Rebuild database indexes",
+		Long: `Rebuild the indexes used to look up hosts by IP, FQDN and MAC address.
+The indexes are kept up to date on every change and rebuilt automatically
+when a new version of Grendel changes them, reindex is only needed to
+repair a database edited outside of Grendel.
+
+By default the API server rebuilds the indexes. Use --local to rebuild the
+indexes of the database file set by dbpath directly.`,
+		Args: cobra.NoArgs,
+		RunE: func(command *cobra.Command, args []string) error {
+			if reindexLocal {
+				return localReindex()
+			}
+
+			gc, err := cmd.NewOgenClient()
+			if err != nil {
+				return err
+			}
+
+			res, err := gc.POSTV1DbReindex(context.Background(), client.POSTV1DbReindexParams{})
+			if err != nil {
+				return cmd.NewApiError(err)
+			}
+
+			return cmd.NewApiResponse(res)
+		},
+	}
+)
+
+func init() {
+	reindexCmd.Flags().BoolVar(&reindexLocal, "local", false, "rebuild the indexes of the local database file instead of using the API server")
+	dbCmd.AddCommand(reindexCmd)
+}
+
+func localReindex() error {
+	filename := dbFilename()
+	if filename == ":memory:" {
+		return fmt.Errorf("--local requires a database file, set dbpath")
+	}
+	if _, err := os.Stat(filename); err != nil {
+		return err
+	}
+
+	// Opening the store rebuilds outdated indexes, Reindex rebuilds them again
+	// unconditionally
+	db, err := sqlstore.New(filename)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	if err := db.Reindex(); err != nil {
+		return err
+	}
+
+	cmd.Log.Infof("Rebuilt indexes of %s", filename)
+
+	return nil
+}
@@ -5,6 +5,7 @@
 package db
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 	"os"
@@ -13,26 +14,51 @@ import (
 	"github.com/spf13/cobra"
 	"github.com/tidwall/gjson"
 	"github.com/ubccr/grendel/cmd"
+	"github.com/ubccr/grendel/internal/store/backup"
 	"github.com/ubccr/grendel/pkg/client"
 )
 
 var (
-	confirm    bool
-	restoreCmd = &cobra.Command{
+	confirm      bool
+	restoreForce bool
+	restoreCmd   = &cobra.Command{
 		Use:   "restore <filename>...",
 		Short: "Restore database",
-		Long:  `Restore database`,
-		Args:  cobra.MinimumNArgs(1),
+		Long: `Restore database from a JSON dump or a backup archive.
+
+JSON dumps are sent to the API server. Backup archives written by db backup
+replace the local database file set by dbpath after their checksums are
+verified. Stop grendel serve before restoring a backup archive. A database
+with hosts or images is only overwritten with --force.`,
+		Args: cobra.MinimumNArgs(1),
 		RunE: func(command *cobra.Command, args []string) error {
 			gc, err := cmd.NewOgenClient()
 			if err != nil {
 				return err
 			}
 
+			result := cmd.NewMutationList()
 			for _, name := range args {
 				file, err := os.ReadFile(name)
 				if err != nil {
-					fmt.Printf("failed to open file. name=%s err=%s", name, err)
+					return fmt.Errorf("failed to open file. name=%s err=%w", name, err)
+				}
+
+				if backup.IsArchive(file) {
+					if !confirm && !confirmRestore() {
+						fmt.Fprintln(os.Stderr, "Restore cancelled.")
+						return cmd.NewMutationResponse(result)
+					}
+
+					manifest, err := backup.Restore(bytes.NewReader(file), dbFilename(), restoreForce)
+					if err != nil {
+						return fmt.Errorf("failed to restore backup. name=%s err=%w", name, err)
+					}
+
+					cmd.Log.Infof("Restored backup %s to %s: version=%s created=%s hosts=%d images=%d users=%d dns_records=%d",
+						name, dbFilename(), manifest.Version, manifest.Created, manifest.Counts.Hosts,
+						manifest.Counts.Images, manifest.Counts.Users, manifest.Counts.DNSRecords)
+					continue
 				}
 
 				// old data check
@@ -54,31 +80,23 @@ var (
 					return fmt.Errorf("failed to decode json: %w", err)
 				}
 
-				prompt := promptui.Prompt{
-					Label:     "WARNING: database will be restored. Are you sure?",
-					IsConfirm: true,
-				}
-
-				if !confirm {
-					_, err = prompt.Run()
-					if err != nil {
-						fmt.Println("Restore cancelled.")
-						return nil
-					}
+				if !confirm && !confirmRestore() {
+					fmt.Fprintln(os.Stderr, "Restore cancelled.")
+					return cmd.NewMutationResponse(result)
 				}
 
 				params := client.POSTV1DbRestoreParams{}
 				res, err := gc.POSTV1DbRestore(context.Background(), &dump, params)
 				if err != nil {
-					return cmd.NewApiError(err)
-				}
-
-				err = cmd.NewApiResponse(res)
-				if err != nil {
-					fmt.Println(err.Error())
+					err = cmd.NewApiError(err)
+					cmd.Log.Errorf("failed to restore file. name=%s err=%s", name, err)
+				} else if !cmd.JSONOutput() {
+					cmd.NewApiResponse(res)
 				}
+				result.AddResponse(name, res, err)
 			}
-			return nil
+
+			return cmd.NewMutationResponse(result)
 		},
 	}
 )
@@ -86,4 +104,16 @@ var (
 func init() {
 	dbCmd.AddCommand(restoreCmd)
 	restoreCmd.PersistentFlags().BoolVarP(&confirm, "yes-i-really-mean-it", "y", false, "override yes prompt")
+	restoreCmd.PersistentFlags().BoolVar(&restoreForce, "force", false, "overwrite a database which has hosts or images when restoring a backup archive")
+}
+
+func confirmRestore() bool {
+	prompt := promptui.Prompt{
+		Label:     "WARNING: database will be restored. Are you sure?",
+		IsConfirm: true,
+		Stdout:    os.Stderr,
+	}
+
+	_, err := prompt.Run()
+	return err == nil
 }
@@ -0,0 +1,25 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package debug
+
+import (
+	"github.com/spf13/cobra"
+	"github.com/ubccr/grendel/cmd"
+)
+
+var (
+	debugCmd = &cobra.Command{
+		Use:   "debug",
+		Short: "Debug commands",
+		Long: `Debug commands.
+
+The profiles are fetched from the debug server of grendel serve, started with
+debug.enabled (--debug-server) on debug.listen, 127.0.0.1:6060 by default.`,
+	}
+)
+
+func init() {
+	cmd.Root.AddCommand(debugCmd)
+}
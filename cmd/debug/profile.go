@@ -0,0 +1,121 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package debug
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"github.com/ubccr/grendel/cmd"
+	"github.com/ubccr/grendel/internal/debug"
+)
+
+var (
+	profileType    string
+	profileSeconds int
+	profileOut     string
+	profileAddress string
+	profileCmd     = &cobra.Command{
+		Use:   "profile",
+		Short: "Fetch a profile from the debug server",
+		Long: fmt.Sprintf(`Fetch a profile from the debug server of grendel serve and write it to
+--out, <type>.pb.gz by default. Open it with go tool pprof, or go tool trace
+for traces. The cpu profile and the trace are sampled for --seconds.
+
+Profile types: %s`, strings.Join(debug.Profiles, ", ")),
+		Example: `  grendel debug profile --type cpu --seconds 30 --out cpu.pb.gz
+  grendel debug profile --type goroutine`,
+		Args: cobra.NoArgs,
+		RunE: func(command *cobra.Command, args []string) error {
+			path, sampled, err := debug.ProfilePath(profileType)
+			if err != nil {
+				return err
+			}
+
+			address := profileAddress
+			if address == "" {
+				address = viper.GetString("debug.listen")
+			}
+			if address == "" {
+				address = debug.DefaultListen
+			}
+
+			query := url.Values{}
+			timeout := 30 * time.Second
+			if sampled {
+				if profileSeconds <= 0 {
+					return fmt.Errorf("--seconds must be positive")
+				}
+				query.Set("seconds", strconv.Itoa(profileSeconds))
+				timeout += time.Duration(profileSeconds) * time.Second
+			}
+			u := url.URL{Scheme: "http", Host: address, Path: path, RawQuery: query.Encode()}
+
+			out := profileOut
+			if out == "" {
+				out = profileType + ".pb.gz"
+				if profileType == "trace" {
+					out = "trace.out"
+				}
+			}
+
+			if sampled {
+				cmd.Log.Infof("Sampling %s profile for %ds from %s", profileType, profileSeconds, address)
+			}
+
+			client := &http.Client{Timeout: timeout}
+			res, err := client.Get(u.String())
+			if err != nil {
+				return fmt.Errorf("failed to fetch profile, is the debug server of grendel serve enabled? %w", err)
+			}
+			defer res.Body.Close()
+
+			if res.StatusCode != http.StatusOK {
+				body, _ := io.ReadAll(io.LimitReader(res.Body, 4096))
+				return fmt.Errorf("failed to fetch profile: %s: %s", res.Status, strings.TrimSpace(string(body)))
+			}
+
+			// Write to a temporary file so a failed download never leaves a
+			// truncated profile behind
+			tmp, err := os.CreateTemp(filepath.Dir(out), ".grendel-profile-")
+			if err != nil {
+				return err
+			}
+			defer os.Remove(tmp.Name())
+			defer tmp.Close()
+
+			n, err := io.Copy(tmp, res.Body)
+			if err != nil {
+				return err
+			}
+			if err := tmp.Close(); err != nil {
+				return err
+			}
+			if err := os.Rename(tmp.Name(), out); err != nil {
+				return err
+			}
+
+			cmd.Log.Infof("Wrote %s profile to %s (%d bytes)", profileType, out, n)
+			return nil
+		},
+	}
+)
+
+func init() {
+	profileCmd.Flags().StringVar(&profileType, "type", "cpu", "profile type: "+strings.Join(debug.Profiles, ", "))
+	profileCmd.Flags().IntVar(&profileSeconds, "seconds", 30, "duration of the cpu profile and trace")
+	profileCmd.Flags().StringVar(&profileOut, "out", "", "file to write the profile to, <type>.pb.gz by default")
+	profileCmd.Flags().StringVar(&profileAddress, "address", "", "address of the debug server, debug.listen by default")
+	debugCmd.AddCommand(profileCmd)
+}
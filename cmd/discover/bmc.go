@@ -0,0 +1,136 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package discover
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/ubccr/grendel/cmd"
+	"github.com/ubccr/grendel/pkg/client"
+)
+
+var (
+	adoptHost   string
+	adoptIP     string
+	adoptFQDN   string
+	adoptIfname string
+
+	listCmd = &cobra.Command{
+		Use:   "list",
+		Short: "List pending BMCs discovered from DHCP",
+		Long: `List the BMCs seen on DHCP from MAC addresses not registered to any node.
+
+BMCs are recorded by the DHCP server when dhcp.bmc_discovery is enabled and
+the vendor class or the OUI of the request matches dhcp.bmc_vendor_classes or
+dhcp.bmc_ouis. RELAY is the DHCP relay the request was forwarded by, empty for
+BMCs on a segment local to the server.`,
+		Args: cobra.NoArgs,
+		RunE: func(command *cobra.Command, args []string) error {
+			gc, err := cmd.NewOgenClient()
+			if err != nil {
+				return err
+			}
+
+			res, err := gc.GETV1DiscoverBmc(context.Background(), client.GETV1DiscoverBmcParams{})
+			if err != nil {
+				return cmd.NewApiError(err)
+			}
+
+			if cmd.JSONOutput() {
+				return cmd.Output(res)
+			}
+
+			w := tabwriter.NewWriter(os.Stdout, 0, 0, 4, ' ', 0)
+			fmt.Fprintln(w, "MAC\tVENDOR CLASS\tRELAY\tSERVER IP\tFIRST SEEN\tLAST SEEN")
+			for _, b := range res {
+				fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\n",
+					b.MAC.Value,
+					b.VendorClass.Value,
+					b.Relay.Value,
+					b.ServerIP.Value,
+					b.FirstSeen.Value.Local().Format(time.DateTime),
+					b.LastSeen.Value.Local().Format(time.DateTime),
+				)
+			}
+
+			return w.Flush()
+		},
+	}
+
+	adoptCmd = &cobra.Command{
+		Use:   "adopt <mac>",
+		Short: "Attach a pending BMC to a node",
+		Long: `Attach a pending BMC as the BMC interface of a node and remove it from the
+pending BMCs. The BMC is handed its address on its next DHCP request.
+
+When --ip has no prefix length the prefix length of the dhcp.subnets
+containing it is used.`,
+		Example: `  grendel discover adopt d0:94:66:12:34:56 --host cpn-d13-01 --ip 10.65.8.21`,
+		Args:    cobra.ExactArgs(1),
+		RunE: func(command *cobra.Command, args []string) error {
+			gc, err := cmd.NewOgenClient()
+			if err != nil {
+				return err
+			}
+
+			req := &client.DiscoverBMCAdoptRequest{
+				MAC:  args[0],
+				Host: adoptHost,
+				IP:   adoptIP,
+			}
+			if adoptFQDN != "" {
+				req.Fqdn = client.NewOptString(adoptFQDN)
+			}
+			if adoptIfname != "" {
+				req.Ifname = client.NewOptString(adoptIfname)
+			}
+
+			res, err := gc.POSTV1DiscoverBmcAdopt(context.Background(), req, client.POSTV1DiscoverBmcAdoptParams{})
+			if err != nil {
+				return cmd.NewApiError(err)
+			}
+
+			return cmd.NewApiResponse(res)
+		},
+	}
+
+	forgetCmd = &cobra.Command{
+		Use:   "forget <mac>",
+		Short: "Delete a pending BMC",
+		Long:  `Delete a pending BMC. It is recorded again if it keeps requesting an address.`,
+		Args:  cobra.ExactArgs(1),
+		RunE: func(command *cobra.Command, args []string) error {
+			gc, err := cmd.NewOgenClient()
+			if err != nil {
+				return err
+			}
+
+			res, err := gc.DELETEV1DiscoverBmc(context.Background(), client.DELETEV1DiscoverBmcParams{MAC: args[0]})
+			if err != nil {
+				return cmd.NewApiError(err)
+			}
+
+			return cmd.NewApiResponse(res)
+		},
+	}
+)
+
+func init() {
+	adoptCmd.Flags().StringVar(&adoptHost, "host", "", "name of the node the BMC belongs to")
+	adoptCmd.MarkFlagRequired("host")
+	adoptCmd.Flags().StringVar(&adoptIP, "ip", "", "IP address of the BMC interface")
+	adoptCmd.MarkFlagRequired("ip")
+	adoptCmd.Flags().StringVar(&adoptFQDN, "fqdn", "", "FQDN of the BMC interface")
+	adoptCmd.Flags().StringVar(&adoptIfname, "ifname", "", "name of the BMC interface")
+
+	discoverCmd.AddCommand(listCmd)
+	discoverCmd.AddCommand(adoptCmd)
+	discoverCmd.AddCommand(forgetCmd)
+}
@@ -21,6 +21,7 @@ import (
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 	"github.com/ubccr/grendel/cmd"
+	"github.com/ubccr/grendel/internal/config"
 	"github.com/ubccr/grendel/internal/dhcp"
 	"github.com/ubccr/grendel/pkg/nodeset"
 )
@@ -107,7 +108,7 @@ var (
 
 func init() {
 	dhcpCmd.Flags().StringP("listen", "l", "0.0.0.0:67", "address to run discovery DHCP server")
-	viper.BindPFlag("discovery.listen", dhcpCmd.Flags().Lookup("listen"))
+	config.BindPFlag("discovery.listen", dhcpCmd.Flags().Lookup("listen"))
 
 	dhcpCmd.Flags().BoolVar(&trace, "trace", false, "Trace DHCP packets only")
 	dhcpCmd.Flags().BoolVar(&snoop, "snoop", false, "Snoop DHCP packets only")
@@ -15,6 +15,7 @@ import (
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 	"github.com/ubccr/grendel/cmd"
+	"github.com/ubccr/grendel/internal/config"
 	"github.com/ubccr/grendel/internal/firmware"
 	"github.com/ubccr/grendel/internal/logger"
 	"github.com/ubccr/grendel/pkg/model"
@@ -37,9 +38,9 @@ var (
 
 func init() {
 	discoverCmd.PersistentFlags().StringP("domain", "d", "", "domain name")
-	viper.BindPFlag("discovery.domain", discoverCmd.PersistentFlags().Lookup("domain"))
+	config.BindPFlag("discovery.domain", discoverCmd.PersistentFlags().Lookup("domain"))
 	discoverCmd.PersistentFlags().String("firmware", "", "firmware")
-	viper.BindPFlag("discovery.firmware", discoverCmd.PersistentFlags().Lookup("firmware"))
+	config.BindPFlag("discovery.firmware", discoverCmd.PersistentFlags().Lookup("firmware"))
 
 	discoverCmd.PersistentFlags().StringVar(&hostFile, "hosts", "", "existing hosts file to add to")
 	discoverCmd.PersistentFlags().BoolVar(&noProvision, "disable-provision", false, "don't set host to provision")
@@ -79,9 +80,9 @@ func init() {
 
 		firmwareStr := viper.GetString("discovery.firmware")
 		if firmwareStr != "" {
-			firmwareBuild = firmware.NewFromString(firmwareStr)
-			if firmwareBuild.IsNil() {
-				return fmt.Errorf("Invalid firmware build: %s", firmwareStr)
+			firmwareBuild, err = firmware.Parse(firmwareStr)
+			if err != nil {
+				return err
 			}
 		}
 
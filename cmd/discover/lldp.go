@@ -36,6 +36,10 @@ var (
 			if err != nil {
 				return cmd.NewApiError(err)
 			}
+			if cmd.JSONOutput() {
+				return cmd.Output(res)
+			}
+
 			header := "Port\tMAC Address\tSystem Name"
 			headerLong := "Port\tMAC Address\tSystem Name\tSystem Port ID\tManagement Address\tSystem Port Description\tSystem Description"
 
@@ -14,6 +14,7 @@ import (
 
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
+	"github.com/ubccr/grendel/internal/config"
 	"github.com/ubccr/grendel/internal/tors"
 )
 
@@ -61,11 +62,11 @@ var (
 
 func init() {
 	switchCmd.Flags().StringP("user", "u", "", "switch api username")
-	viper.BindPFlag("discovery.user", switchCmd.Flags().Lookup("user"))
+	config.BindPFlag("discovery.user", switchCmd.Flags().Lookup("user"))
 	switchCmd.Flags().StringP("password", "p", "", "switch api password")
-	viper.BindPFlag("discovery.password", switchCmd.Flags().Lookup("password"))
+	config.BindPFlag("discovery.password", switchCmd.Flags().Lookup("password"))
 	switchCmd.Flags().StringP("endpoint", "e", "", "switch api endpoint")
-	viper.BindPFlag("discovery.endpoint", switchCmd.Flags().Lookup("endpoint"))
+	config.BindPFlag("discovery.endpoint", switchCmd.Flags().Lookup("endpoint"))
 
 	switchCmd.Flags().StringVarP(&mappingFile, "mapping", "m", "", "hostname to portnumber mapping file")
 	switchCmd.Flags().StringVarP(&bmcSubnetStr, "bmc-subnet", "b", "", "subnet for bmc")
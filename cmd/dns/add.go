@@ -0,0 +1,61 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package dns
+
+import (
+	"context"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/ubccr/grendel/cmd"
+	"github.com/ubccr/grendel/pkg/client"
+)
+
+var (
+	addTTL   int64
+	addNoPTR bool
+	addCmd   = &cobra.Command{
+		Use:   "add <name> {A | CNAME} <value>",
+		Short: "Add a DNS only record",
+		Long: `Add a DNS only record.
+
+A records also answer reverse lookups for their address unless --no-ptr is
+set. Records conflicting with node interfaces or other records are rejected.`,
+		Example: `  grendel dns record add vip.example.com A 10.1.1.200
+  grendel dns record add www.example.com CNAME vip.example.com --ttl 300`,
+		Args: cobra.ExactArgs(3),
+		RunE: func(command *cobra.Command, args []string) error {
+			gc, err := cmd.NewOgenClient()
+			if err != nil {
+				return err
+			}
+
+			recordType := strings.ToUpper(args[1])
+			record := client.NewNilDNSRecordAddRequestRecordsItem(client.DNSRecordAddRequestRecordsItem{
+				Name:  client.NewOptString(args[0]),
+				Type:  client.NewOptString(recordType),
+				Value: client.NewOptString(args[2]),
+				TTL:   client.NewOptInt64(addTTL),
+				Ptr:   client.NewOptBool(recordType == "A" && !addNoPTR),
+			})
+
+			req := &client.DNSRecordAddRequest{
+				Records: []client.NilDNSRecordAddRequestRecordsItem{record},
+			}
+			res, err := gc.POSTV1DNSRecords(context.Background(), req, client.POSTV1DNSRecordsParams{})
+			if err != nil {
+				return cmd.NewApiError(err)
+			}
+
+			return cmd.NewApiResponse(res)
+		},
+	}
+)
+
+func init() {
+	addCmd.Flags().Int64Var(&addTTL, "ttl", 0, "Record TTL in seconds. Defaults to dns.ttl")
+	addCmd.Flags().BoolVar(&addNoPTR, "no-ptr", false, "Do not answer reverse lookups for A records")
+	recordCmd.AddCommand(addCmd)
+}
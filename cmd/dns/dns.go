@@ -0,0 +1,32 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package dns
+
+import (
+	"github.com/spf13/cobra"
+	"github.com/ubccr/grendel/cmd"
+)
+
+var (
+	dnsCmd = &cobra.Command{
+		Use:   "dns",
+		Short: "DNS commands",
+		Long:  `DNS commands`,
+	}
+	recordCmd = &cobra.Command{
+		Use:   "record",
+		Short: "DNS only record commands",
+		Long: `DNS only record commands.
+
+DNS only records are served by the DNS server alongside the records generated
+from node interfaces. Use them for names which are not provisionable nodes such
+as VIPs, printers or appliances.`,
+	}
+)
+
+func init() {
+	dnsCmd.AddCommand(recordCmd)
+	cmd.Root.AddCommand(dnsCmd)
+}
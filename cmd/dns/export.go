@@ -0,0 +1,182 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package dns
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"github.com/ubccr/grendel/cmd"
+	"github.com/ubccr/grendel/internal/config"
+	grendeldns "github.com/ubccr/grendel/internal/dns"
+	"github.com/ubccr/grendel/pkg/client"
+	"github.com/ubccr/grendel/pkg/model"
+)
+
+var (
+	exportOut string
+	exportCmd = &cobra.Command{
+		Use:   "export [zones...]",
+		Short: "Export zones",
+		Long: `Export the reverse zones computed from dhcp.subnets and the forward zones of
+dns.zones as zone files, the records served by the DNS server and sent in zone
+transfers.
+
+Zones fall on octet boundaries, a /22 subnet has four /24 zones. Subnets longer
+than /24 have an RFC 2317 classless zone named with dns.classless_naming. The
+CNAME records the parent zone needs to delegate the addresses of a classless
+zone are written as a comment at the end of its file. The child zones of
+dns.delegations are written as NS and glue records in their parent zone.
+
+Zones are written to stdout, or to one file per zone in --out named after the
+zone.`,
+		RunE: func(command *cobra.Command, args []string) error {
+			zones, all, err := localZones(args)
+			if err != nil {
+				return err
+			}
+
+			gc, err := cmd.NewOgenClient()
+			if err != nil {
+				return err
+			}
+
+			nodes, err := gc.GETV1Nodes(context.Background(), client.GETV1NodesParams{})
+			if err != nil {
+				return cmd.NewApiError(err)
+			}
+			res, err := gc.GETV1DNSRecords(context.Background(), client.GETV1DNSRecordsParams{})
+			if err != nil {
+				return cmd.NewApiError(err)
+			}
+
+			var hosts model.HostList
+			if err := convert(nodes, &hosts); err != nil {
+				return err
+			}
+			var records model.RecordList
+			if err := convert(res, &records); err != nil {
+				return err
+			}
+
+			delegations, err := grendeldns.DelegationsFromConfig(viper.GetViper())
+			if err != nil {
+				return err
+			}
+
+			return writeZones(os.Stdout, zones, all, delegations, hosts, records, exportOut)
+		},
+	}
+)
+
+func init() {
+	exportCmd.Flags().StringVar(&exportOut, "out", "", "directory to write the zone files to")
+	dnsCmd.AddCommand(exportCmd)
+}
+
+// localZones returns the reverse zones of the configured subnets and the
+// forward zones of dns.zones, only the ones named in names when set, and all
+// the zones
+func localZones(names []string) ([]grendeldns.Zone, []grendeldns.Zone, error) {
+	zones, err := grendeldns.ZonesFromConfig(viper.GetViper(), config.Current().Subnets)
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(zones) == 0 {
+		return nil, nil, fmt.Errorf("no zones, set dhcp.subnets or dns.zones")
+	}
+	if len(names) == 0 {
+		return zones, zones, nil
+	}
+
+	selected := make([]grendeldns.Zone, 0, len(names))
+	for _, name := range names {
+		i := slices.IndexFunc(zones, func(z grendeldns.Zone) bool {
+			return strings.EqualFold(z.Name, name) || strings.EqualFold(z.Name, name+".")
+		})
+		if i < 0 {
+			return nil, nil, fmt.Errorf("zone %s is not a reverse zone of dhcp.subnets nor a zone of dns.zones", name)
+		}
+		selected = append(selected, zones[i])
+	}
+
+	return selected, zones, nil
+}
+
+// convert converts API values to model values of the same JSON form
+func convert(from, to any) error {
+	data, err := json.Marshal(from)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(data, to)
+}
+
+// writeZones writes the zone files of zones, some of all, with the
+// delegations of their child zones, to w, or to out when set
+func writeZones(w io.Writer, zones, all []grendeldns.Zone, delegations grendeldns.Delegations, hosts model.HostList, records model.RecordList, out string) error {
+	nameserver := grendeldns.Nameserver()
+	// dns.ttl defaults to the --dns-ttl flag of grendel serve
+	ttl := uint32(300)
+	if viper.IsSet("dns.ttl") {
+		ttl = uint32(viper.GetInt("dns.ttl"))
+	}
+	serial := grendeldns.Serial(time.Now())
+
+	if out != "" {
+		if err := os.MkdirAll(out, 0755); err != nil {
+			return err
+		}
+	}
+
+	for i, z := range zones {
+		var buf bytes.Buffer
+		if z.Forward() {
+			fmt.Fprintf(&buf, "; %s zone\n", z.Name)
+		} else {
+			fmt.Fprintf(&buf, "; %s reverse zone of %s\n", z.Name, z.Prefix)
+		}
+		fmt.Fprintf(&buf, "$ORIGIN %s\n", z.Name)
+		rrs := delegations.InZone(z, all, z.Records(nameserver, ttl, serial, hosts, records), ttl)
+		for _, rr := range rrs {
+			fmt.Fprintln(&buf, rr.String())
+		}
+		if z.Classless() {
+			fmt.Fprintf(&buf, "\n; CNAME records delegating %s in the parent zone\n", z.Prefix)
+			for _, rr := range z.Delegation(ttl) {
+				fmt.Fprintf(&buf, "; %s\n", rr.String())
+			}
+		}
+
+		if out == "" {
+			if i > 0 {
+				fmt.Fprintln(w)
+			}
+			if _, err := w.Write(buf.Bytes()); err != nil {
+				return err
+			}
+			continue
+		}
+
+		file := filepath.Join(out, strings.ReplaceAll(strings.TrimSuffix(z.Name, "."), "/", "-")+".zone")
+		if err := os.WriteFile(file, buf.Bytes(), 0644); err != nil {
+			return err
+		}
+		cmd.Log.Infof("Wrote zone %s to %s", z.Name, file)
+	}
+
+	return nil
+}
@@ -0,0 +1,61 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package dns
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/ubccr/grendel/cmd"
+	"github.com/ubccr/grendel/pkg/client"
+)
+
+var (
+	listCmd = &cobra.Command{
+		Use:   "list [names...]",
+		Short: "List DNS only records",
+		Long:  `List DNS only records`,
+		RunE: func(command *cobra.Command, args []string) error {
+			gc, err := cmd.NewOgenClient()
+			if err != nil {
+				return err
+			}
+
+			params := client.GETV1DNSRecordsParams{}
+			if len(args) > 0 {
+				params.Names = client.NewOptString(strings.Join(args, ","))
+			}
+
+			res, err := gc.GETV1DNSRecords(context.Background(), params)
+			if err != nil {
+				return cmd.NewApiError(err)
+			}
+
+			if cmd.JSONOutput() {
+				return cmd.Output(res)
+			}
+
+			for _, r := range res {
+				ttl := "default"
+				if r.TTL.Value > 0 {
+					ttl = fmt.Sprintf("%d", r.TTL.Value)
+				}
+				ptr := ""
+				if r.Ptr.Value {
+					ptr = "ptr"
+				}
+				fmt.Printf("%-40s%-8s%-8s%-40s%s\n", r.Name, ttl, r.Type, r.Value, ptr)
+			}
+
+			return nil
+		},
+	}
+)
+
+func init() {
+	recordCmd.AddCommand(listCmd)
+}
@@ -0,0 +1,115 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package firmwares
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/insomniacslk/dhcp/iana"
+	"github.com/spf13/cobra"
+	"github.com/ubccr/grendel/cmd"
+	"github.com/ubccr/grendel/internal/firmware"
+)
+
+var (
+	archs       []string
+	userClass   string
+	vendorClass string
+	override    string
+	firmwareCmd = &cobra.Command{
+		Use:   "firmware",
+		Short: "Firmware commands",
+	}
+	detectCmd = &cobra.Command{
+		Use:   "detect --arch <arch> [--user-class <class>]",
+		Short: "Show the firmware sent to a client",
+		Long: `Show the firmware the DHCP server sends to a client without capturing its
+packets. --arch is the client system architecture of DHCP option 93 in hex, as
+printed by tcpdump or the NIC vendor, such as 0000 for BIOS, 0007 for EFI
+x86_64 and 000b for EFI arm64. Give --arch more than once for clients sending
+more than one, the first is used. --user-class is the user class of option 77,
+iPXE or grendel once the firmware runs, --vendor-class the vendor class
+identifier of option 60.
+
+--firmware is the firmware set on the node, its tags or boot image, which
+replaces the firmware of EFI clients. Valid firmware: ` + strings.Join(firmware.Names(), ", "),
+		Example: `  grendel firmware detect --arch 0007
+  grendel firmware detect --arch 0000 --user-class iPXE
+  grendel firmware detect --arch 0007 --firmware ipxe-x86_64.efi`,
+		Args: cobra.NoArgs,
+		RunE: func(command *cobra.Command, args []string) error {
+			req := firmware.Request{
+				UserClass:   userClass,
+				VendorClass: vendorClass,
+			}
+			for _, a := range archs {
+				arch, err := parseArch(a)
+				if err != nil {
+					return err
+				}
+				req.Archs = append(req.Archs, arch)
+			}
+			if override != "" {
+				fw, err := firmware.Parse(override)
+				if err != nil {
+					return err
+				}
+				req.Override = fw
+			}
+
+			sel, err := firmware.Select(req)
+			if err != nil {
+				return err
+			}
+
+			if cmd.JSONOutput() {
+				return cmd.Output(map[string]any{
+					"arch":       req.Archs[0].String(),
+					"detected":   buildName(sel.Detected),
+					"firmware":   buildName(sel.Build),
+					"overridden": sel.Overridden(),
+				})
+			}
+
+			fmt.Printf("%-10s%s\n", "Arch:", req.Archs[0])
+			fmt.Printf("%-10s%s\n", "Detected:", buildName(sel.Detected))
+			fmt.Printf("%-10s%s\n", "Firmware:", buildName(sel.Build))
+
+			return nil
+		},
+	}
+)
+
+// parseArch parses an architecture in hex, as 0007 or 0x7
+func parseArch(s string) (iana.Arch, error) {
+	n, err := strconv.ParseUint(strings.TrimPrefix(strings.ToLower(s), "0x"), 16, 16)
+	if err != nil {
+		return 0, fmt.Errorf("invalid arch %q: expected a hex number such as 0007", s)
+	}
+
+	return iana.Arch(n), nil
+}
+
+// buildName returns the name of b. The grendel build is the boot script
+// chainloaded by iPXE over HTTP rather than a binary
+func buildName(b firmware.Build) string {
+	if b == firmware.GRENDEL {
+		return "grendel (iPXE boot script over HTTP)"
+	}
+
+	return b.String()
+}
+
+func init() {
+	detectCmd.Flags().StringSliceVar(&archs, "arch", []string{}, "client system architecture of DHCP option 93 in hex")
+	detectCmd.Flags().StringVar(&userClass, "user-class", "", "user class of DHCP option 77")
+	detectCmd.Flags().StringVar(&vendorClass, "vendor-class", "", "vendor class identifier of DHCP option 60")
+	detectCmd.Flags().StringVar(&override, "firmware", "", "firmware set on the node, its tags or boot image")
+	detectCmd.MarkFlagRequired("arch")
+	firmwareCmd.AddCommand(detectCmd)
+	cmd.Root.AddCommand(firmwareCmd)
+}
@@ -0,0 +1,201 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package image
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/manifoldco/promptui"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"github.com/ubccr/grendel/cmd"
+	"github.com/ubccr/grendel/pkg/client"
+	"github.com/ubccr/grendel/pkg/nodeset"
+)
+
+var (
+	assignNodeset       string
+	assignTags          []string
+	assignOnlyIfCurrent string
+	assignYes           bool
+	assignCmd           = &cobra.Command{
+		Use:   "assign <image> --nodeset <nodeset>",
+		Short: "Assign a boot image to nodes",
+		Long: `Assign a boot image to nodes.
+
+Shows how many nodes will change and from which images and asks for
+confirmation before applying the change one node at a time. Use
+--only-if-current to only change nodes currently assigned a given image, the
+server refuses to change a node assigned another image since.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(command *cobra.Command, args []string) error {
+			if assignNodeset == "" && len(assignTags) == 0 {
+				return errors.New("--nodeset or --tags is required")
+			}
+
+			gc, err := cmd.NewOgenClient()
+			if err != nil {
+				return err
+			}
+
+			image := args[0]
+			imgParams := client.GETV1ImagesFindParams{
+				Names: client.NewOptString(image),
+			}
+			images, err := gc.GETV1ImagesFind(context.Background(), imgParams)
+			if err != nil {
+				return cmd.NewApiError(err)
+			}
+			if len(images) == 0 {
+				return fmt.Errorf("boot image not found: %s", image)
+			}
+
+			filter, err := cmd.NodesetArg(assignNodeset)
+			if err != nil {
+				return err
+			}
+			params := client.GETV1NodesFindParams{
+				Nodeset: client.NewOptString(filter),
+				Tags:    client.NewOptString(strings.Join(assignTags, ",")),
+			}
+			hostList, err := gc.GETV1NodesFind(context.Background(), params)
+			if err != nil {
+				return cmd.NewApiError(err)
+			}
+
+			defaultImage := viper.GetString("provision.default_image")
+			hosts, current := assignFilterHosts(hostList, image, assignOnlyIfCurrent, defaultImage)
+			if len(hosts) == 0 {
+				cmd.Log.Warn("no nodes to change")
+				return cmd.NewMutationResponse(cmd.NewMutationList())
+			}
+
+			assignSummary(len(hosts), image, current)
+
+			if !assignYes {
+				prompt := promptui.Prompt{
+					Label:     fmt.Sprintf("Assign boot image %s to %d node(s)", image, len(hosts)),
+					IsConfirm: true,
+					Stdout:    os.Stderr,
+				}
+
+				if _, err := prompt.Run(); err != nil {
+					return errors.New("assign cancelled")
+				}
+			}
+
+			result := cmd.NewMutationList()
+			for _, name := range hosts {
+				req := &client.NodeBootImageRequest{
+					Image:         client.NewOptString(image),
+					OnlyIfCurrent: assignCurrentImages(assignOnlyIfCurrent, defaultImage),
+				}
+				params := client.PATCHV1NodesImageParams{
+					Nodeset: client.NewOptString(name),
+				}
+				res, err := gc.PATCHV1NodesImage(context.Background(), req, params)
+				if err != nil {
+					err = cmd.NewApiError(err)
+				}
+				result.AddResponse(name, res, err)
+			}
+
+			if !cmd.JSONOutput() {
+				assignPrintResult(result)
+			}
+
+			return cmd.NewMutationResponse(result)
+		},
+	}
+)
+
+func init() {
+	assignCmd.Flags().StringVarP(&assignNodeset, "nodeset", "n", "", "Nodes to assign the image to. Use 'all' for every node")
+	assignCmd.Flags().StringSliceVarP(&assignTags, "tags", "t", []string{}, "Filter by tags")
+	assignCmd.Flags().StringVar(&assignOnlyIfCurrent, "only-if-current", "", "Only change nodes currently assigned this boot image")
+	assignCmd.Flags().BoolVarP(&assignYes, "yes", "y", false, "Skip confirmation prompt")
+	imageCmd.AddCommand(assignCmd)
+}
+
+// assignFilterHosts returns the names of hosts which need to change to image,
+// only those assigned onlyIfCurrent when set, along with a count of hosts by
+// their current boot image. Hosts without one use defaultImage
+func assignFilterHosts(hostList []client.Host, image, onlyIfCurrent, defaultImage string) ([]string, map[string]int) {
+	hosts := make([]string, 0)
+	current := make(map[string]int)
+	for _, host := range hostList {
+		bi := host.BootImage.Value
+		if bi == "" {
+			bi = defaultImage
+		}
+
+		if bi == image {
+			continue
+		}
+
+		if onlyIfCurrent != "" && bi != onlyIfCurrent {
+			continue
+		}
+
+		hosts = append(hosts, host.Name.Value)
+		current[bi]++
+	}
+
+	return hosts, current
+}
+
+// assignCurrentImages returns the only_if_current images of the requests, the
+// server refusing to change a host assigned another image since it was
+// listed. Hosts without an image match the default image
+func assignCurrentImages(onlyIfCurrent, defaultImage string) []string {
+	if onlyIfCurrent == "" {
+		return nil
+	}
+	if onlyIfCurrent == defaultImage {
+		return []string{onlyIfCurrent, ""}
+	}
+
+	return []string{onlyIfCurrent}
+}
+
+func assignSummary(total int, image string, current map[string]int) {
+	names := make([]string, 0, len(current))
+	for name := range current {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	fmt.Fprintf(os.Stderr, "%d node(s) will change to boot image %s:\n", total, image)
+	for _, name := range names {
+		from := name
+		if from == "" {
+			from = "(none)"
+		}
+		fmt.Fprintf(os.Stderr, "  %-30s%10d\n", from, current[name])
+	}
+}
+
+func assignPrintResult(result cmd.MutationResult) {
+	success := nodeset.EmptyNodeSet()
+	for _, name := range result.Changed {
+		success.Add(name)
+	}
+
+	failed := nodeset.EmptyNodeSet()
+	for _, e := range result.Errors {
+		failed.Add(e.Name)
+		cmd.Log.Errorf("failed to assign boot image. node=%s err=%s", e.Name, e.Error)
+	}
+
+	fmt.Printf("Success (%d): %s\n", success.Len(), success.String())
+	if failed.Len() > 0 {
+		fmt.Printf("Failed (%d): %s\n", failed.Len(), failed.String())
+	}
+}
@@ -0,0 +1,46 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package image
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/ubccr/grendel/pkg/client"
+)
+
+func TestAssignFilterHosts(t *testing.T) {
+	host := func(name, image string) client.Host {
+		h := client.Host{Name: client.NewOptString(name)}
+		if image != "" {
+			h.BootImage = client.NewOptString(image)
+		}
+		return h
+	}
+	hostList := []client.Host{
+		host("cpn-01", "rocky-9"),
+		host("cpn-02", "rocky-8"),
+		host("cpn-03", ""),
+		host("cpn-04", "rocky-10"),
+	}
+
+	hosts, current := assignFilterHosts(hostList, "rocky-10", "", "rocky-8")
+	assert.Equal(t, []string{"cpn-01", "cpn-02", "cpn-03"}, hosts)
+	assert.Equal(t, map[string]int{"rocky-9": 1, "rocky-8": 2}, current)
+
+	// Hosts without an image are assigned the default image
+	hosts, current = assignFilterHosts(hostList, "rocky-10", "rocky-8", "rocky-8")
+	assert.Equal(t, []string{"cpn-02", "cpn-03"}, hosts)
+	assert.Equal(t, map[string]int{"rocky-8": 2}, current)
+
+	hosts, _ = assignFilterHosts(hostList, "rocky-10", "rocky-9", "rocky-8")
+	assert.Equal(t, []string{"cpn-01"}, hosts)
+}
+
+func TestAssignCurrentImages(t *testing.T) {
+	assert.Nil(t, assignCurrentImages("", "rocky-8"))
+	assert.Equal(t, []string{"rocky-9"}, assignCurrentImages("rocky-9", "rocky-8"))
+	assert.Equal(t, []string{"rocky-8", ""}, assignCurrentImages("rocky-8", "rocky-8"))
+}
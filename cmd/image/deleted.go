@@ -0,0 +1,61 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package image
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/ubccr/grendel/cmd"
+	"github.com/ubccr/grendel/pkg/client"
+)
+
+var (
+	deletedSince string
+	deletedCmd   = &cobra.Command{
+		Use:   "deleted",
+		Short: "List the names of deleted images",
+		Long: `List the names of deleted images. Names are kept for tombstone_retention,
+30 days by default. Use with "image show all --since" to sync changes.`,
+		Args: cobra.NoArgs,
+		RunE: func(command *cobra.Command, args []string) error {
+			since, err := cmd.ParseSince(deletedSince)
+			if err != nil {
+				return err
+			}
+
+			gc, err := cmd.NewOgenClient()
+			if err != nil {
+				return err
+			}
+
+			params := client.GETV1ImagesDeletedParams{}
+			if !since.IsZero() {
+				params.Since = client.NewOptString(since.Format(time.RFC3339))
+			}
+			res, err := gc.GETV1ImagesDeleted(context.Background(), params)
+			if err != nil {
+				return cmd.NewApiError(err)
+			}
+
+			if cmd.JSONOutput() {
+				return cmd.Output(res)
+			}
+
+			for _, t := range res {
+				fmt.Printf("%-40s%s\n", t.Name.Value, t.DeletedAt.Value.Local().Format(time.DateTime))
+			}
+
+			return nil
+		},
+	}
+)
+
+func init() {
+	deletedCmd.Flags().StringVar(&deletedSince, "since", "", "Only show images deleted since an RFC3339 time or a duration ago, ex: 24h")
+	imageCmd.AddCommand(deletedCmd)
+}
@@ -0,0 +1,78 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package image
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/dustin/go-humanize"
+	"github.com/spf13/cobra"
+	"github.com/ubccr/grendel/cmd"
+	"github.com/ubccr/grendel/pkg/client"
+)
+
+var (
+	duCmd = &cobra.Command{
+		Use:   "du [names...]",
+		Short: "Show the disk space used by images",
+		Long: `Show the disk space used by the kernel, initrds and signatures of images on
+the Grendel server. Files listed twice, or reached through symlinks and hard
+links, are counted once. SHARED is the space also used by other images, the
+total counts it once. Files which do not exist are listed as missing.`,
+		RunE: func(command *cobra.Command, args []string) error {
+			gc, err := cmd.NewOgenClient()
+			if err != nil {
+				return err
+			}
+
+			res, err := gc.GETV1ImagesDu(context.Background(), client.GETV1ImagesDuParams{})
+			if err != nil {
+				return cmd.NewApiError(err)
+			}
+
+			if len(args) > 0 {
+				res.Images = slices.DeleteFunc(res.Images, func(i client.NilImageDiskUsageImagesItem) bool {
+					return !slices.Contains(args, i.Value.Name.Value)
+				})
+			}
+
+			if cmd.JSONOutput() {
+				return cmd.Output(res)
+			}
+
+			writeDiskUsage(os.Stdout, res, len(args) == 0)
+			return nil
+		},
+	}
+)
+
+func init() {
+	imageCmd.AddCommand(duCmd)
+}
+
+// writeDiskUsage writes the disk usage of each image as a table followed by
+// the total when all images are listed
+func writeDiskUsage(out io.Writer, du *client.ImageDiskUsage, total bool) {
+	w := tabwriter.NewWriter(out, 0, 0, 4, ' ', 0)
+	fmt.Fprintln(w, "IMAGE\tFILES\tSIZE\tSHARED\tMISSING")
+	for _, i := range du.Images {
+		missing := strings.Join(i.Value.Missing, ",")
+		if missing == "" {
+			missing = "-"
+		}
+		fmt.Fprintf(w, "%s\t%d\t%s\t%s\t%s\n", i.Value.Name.Value, i.Value.Files.Value,
+			humanize.IBytes(uint64(i.Value.Size.Value)), humanize.IBytes(uint64(i.Value.Shared.Value)), missing)
+	}
+	if total {
+		fmt.Fprintf(w, "Total\t\t%s\t\t\n", humanize.IBytes(uint64(du.Total.Value)))
+	}
+	w.Flush()
+}
@@ -7,6 +7,7 @@ package image
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"strings"
 
@@ -17,7 +18,8 @@ import (
 )
 
 var (
-	editCmd = &cobra.Command{
+	editForce bool
+	editCmd   = &cobra.Command{
 		Use:   "edit <name>...",
 		Short: "edit images",
 		Long:  `edit images`,
@@ -46,19 +48,9 @@ var (
 				return err
 			}
 
-			var check []client.NilBootImageAddRequestBootImagesItem
-			err = json.Unmarshal(newData, &check)
+			storeRes, err := saveEdits(gc, params, data, newData)
 			if err != nil {
-				return fmt.Errorf("Invalid JSON. Not saving changes: %w", err)
-			}
-
-			storeReq := &client.BootImageAddRequest{
-				BootImages: check,
-			}
-			storeParams := client.POSTV1ImagesParams{}
-			storeRes, err := gc.POSTV1Images(context.Background(), storeReq, storeParams)
-			if err != nil {
-				return cmd.NewApiError(err)
+				return cmd.NewConflictError(err)
 			}
 
 			return cmd.NewApiResponse(storeRes)
@@ -67,5 +59,52 @@ var (
 )
 
 func init() {
+	editCmd.Flags().BoolVar(&editForce, "force", false, "overwrite images changed while editing")
 	imageCmd.AddCommand(editCmd)
 }
+
+// maxMergeAttempts is the number of times edits are merged and saved again
+// after a revision conflict
+const maxMergeAttempts = 3
+
+// saveEdits saves the images edited from data. If an image was changed while
+// editing the edits are merged into the current version and saved again.
+func saveEdits(gc *client.Client, params client.GETV1ImagesFindParams, data, edited []byte) (*client.GenericResponse, error) {
+	for i := 0; ; i++ {
+		var images []client.NilBootImageAddRequestBootImagesItem
+		if err := json.Unmarshal(edited, &images); err != nil {
+			return nil, fmt.Errorf("Invalid JSON. Not saving changes: %w", err)
+		}
+
+		if editForce {
+			for i := range images {
+				images[i].Value.Revision = client.OptNilInt64{}
+			}
+		}
+
+		storeReq := &client.BootImageAddRequest{
+			BootImages: images,
+		}
+		res, err := gc.POSTV1Images(context.Background(), storeReq, client.POSTV1ImagesParams{})
+		err = cmd.NewApiError(err)
+		if !errors.Is(err, client.ErrConflict) || editForce || i == maxMergeAttempts {
+			return res, err
+		}
+
+		cmd.Log.Warnf("images were changed while editing, merging changes: %s", err)
+		current, err := gc.GETV1ImagesFind(context.Background(), params)
+		if err != nil {
+			return nil, cmd.NewApiError(err)
+		}
+		currentData, err := json.Marshal(current)
+		if err != nil {
+			return nil, err
+		}
+
+		merged, err := cmd.MergeEdits(data, edited, currentData)
+		if err != nil {
+			return nil, fmt.Errorf("%w. Edit again or use --force to overwrite", err)
+		}
+		data, edited = currentData, merged
+	}
+}
@@ -0,0 +1,71 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package image
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/dustin/go-humanize"
+	"github.com/spf13/cobra"
+	"github.com/ubccr/grendel/cmd"
+	"github.com/ubccr/grendel/pkg/client"
+)
+
+var (
+	gcDryRun bool
+	gcDelete bool
+	gcCmd    = &cobra.Command{
+		Use:   "gc {--dry-run | --delete}",
+		Short: "Remove image files no image uses",
+		Long: `List the files of the image_dirs of the Grendel server used by no image, nor
+by a previous version of an image replaced or deleted within image_retention,
+30 days by default. Signatures of used files are kept. With --delete the files
+are removed, which is refused while the provision or TFTP server is sending a
+file. Files outside image_dirs are never touched.`,
+		Example: `  grendel image gc --dry-run
+  grendel image gc --delete`,
+		Args: cobra.NoArgs,
+		RunE: func(command *cobra.Command, args []string) error {
+			gc, err := cmd.NewOgenClient()
+			if err != nil {
+				return err
+			}
+
+			params := client.POSTV1ImagesGcParams{}
+			if gcDelete {
+				params.Delete = client.NewOptBool(true)
+			}
+			res, err := gc.POSTV1ImagesGc(context.Background(), params)
+			if err != nil {
+				return cmd.NewApiError(err)
+			}
+
+			if cmd.JSONOutput() {
+				return cmd.Output(res)
+			}
+
+			for _, f := range res.Files {
+				fmt.Printf("%-10s%s\n", humanize.IBytes(uint64(f.Value.Size.Value)), f.Value.Path.Value)
+			}
+
+			verb := "Would remove"
+			if res.Deleted.Value {
+				verb = "Removed"
+			}
+			fmt.Printf("%s %d file(s), %s\n", verb, len(res.Files), humanize.IBytes(uint64(res.Size.Value)))
+
+			return nil
+		},
+	}
+)
+
+func init() {
+	gcCmd.Flags().BoolVar(&gcDryRun, "dry-run", false, "list the files which would be removed")
+	gcCmd.Flags().BoolVar(&gcDelete, "delete", false, "remove the files")
+	gcCmd.MarkFlagsMutuallyExclusive("dry-run", "delete")
+	gcCmd.MarkFlagsOneRequired("dry-run", "delete")
+	imageCmd.AddCommand(gcCmd)
+}
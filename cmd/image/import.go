@@ -7,7 +7,6 @@ package image
 import (
 	"context"
 	"encoding/json"
-	"fmt"
 	"os"
 
 	"github.com/spf13/cobra"
@@ -16,7 +15,8 @@ import (
 )
 
 var (
-	importCmd = &cobra.Command{
+	importForce bool
+	importCmd   = &cobra.Command{
 		Use:   "import <filenames>...",
 		Short: "import images",
 		Long:  `import images`,
@@ -27,37 +27,56 @@ var (
 				return err
 			}
 
+			result := cmd.NewMutationList()
 			for _, name := range args {
-				file, err := os.Open(name)
-				if err != nil {
-					fmt.Printf("failed to open file. name=%s err=%s", name, err)
-				}
-				defer file.Close()
-
 				cmd.Log.Infof("Processing file: %s", name)
 
-				var images []client.NilBootImageAddRequestBootImagesItem
-				if err := json.NewDecoder(file).Decode(&images); err != nil {
-					return err
-				}
-
-				req := &client.BootImageAddRequest{
-					BootImages: images,
-				}
-				params := client.POSTV1ImagesParams{}
-				res, err := gc.POSTV1Images(context.Background(), req, params)
+				res, err := importImages(gc, name)
 				if err != nil {
-					return cmd.NewApiError(err)
+					cmd.Log.Errorf("failed to import file. name=%s err=%s", name, err)
+				} else if !cmd.JSONOutput() {
+					cmd.NewApiResponse(res)
 				}
-
-				return cmd.NewApiResponse(res)
+				result.AddResponse(name, res, err)
 			}
-			return nil
 
+			return cmd.NewMutationResponse(result)
 		},
 	}
 )
 
 func init() {
+	importCmd.Flags().BoolVar(&importForce, "force", false, "overwrite images even if their revision does not match")
 	imageCmd.AddCommand(importCmd)
 }
+
+func importImages(gc *client.Client, name string) (*client.GenericResponse, error) {
+	file, err := os.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	var images []client.NilBootImageAddRequestBootImagesItem
+	if err := json.NewDecoder(file).Decode(&images); err != nil {
+		return nil, err
+	}
+
+	// Without a revision the images are saved unconditionally
+	if importForce {
+		for i := range images {
+			images[i].Value.Revision = client.OptNilInt64{}
+		}
+	}
+
+	req := &client.BootImageAddRequest{
+		BootImages: images,
+	}
+	params := client.POSTV1ImagesParams{}
+	res, err := gc.POSTV1Images(context.Background(), req, params)
+	if err != nil {
+		return nil, cmd.NewConflictError(cmd.NewApiError(err))
+	}
+
+	return res, nil
+}
@@ -6,9 +6,8 @@ package image
 
 import (
 	"context"
-	"encoding/json"
-	"os"
 	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
 	"github.com/ubccr/grendel/cmd"
@@ -16,45 +15,52 @@ import (
 )
 
 var (
-	showCmd = &cobra.Command{
+	showSince    string
+	showResolved bool
+	showCmd      = &cobra.Command{
 		Use:   "show {names... | all}",
 		Short: "Show images",
 		Long:  `Show images`,
 		Args:  cobra.MinimumNArgs(1),
 		RunE: func(command *cobra.Command, args []string) error {
+			t, err := cmd.ParseSince(showSince)
+			if err != nil {
+				return err
+			}
+			var since client.OptString
+			if !t.IsZero() {
+				since = client.NewOptString(t.Format(time.RFC3339))
+			}
+
 			gc, err := cmd.NewOgenClient()
 			if err != nil {
 				return err
 			}
 
 			if strings.ToLower(args[0]) == "all" {
-				params := client.GETV1ImagesParams{}
-				res, err := gc.GETV1Images(context.Background(), params)
+				res, err := gc.GETV1Images(context.Background(), client.GETV1ImagesParams{Since: since, Resolved: client.NewOptBool(showResolved)})
 				if err != nil {
 					return cmd.NewApiError(err)
 				}
-				return output(res)
+				return cmd.Output(res)
 			} else {
 				params := client.GETV1ImagesFindParams{
-					Names: client.NewOptString(strings.Join(args, ",")),
+					Names:    client.NewOptString(strings.Join(args, ",")),
+					Since:    since,
+					Resolved: client.NewOptBool(showResolved),
 				}
 				res, err := gc.GETV1ImagesFind(context.Background(), params)
 				if err != nil {
 					return cmd.NewApiError(err)
 				}
-				return output(res)
+				return cmd.Output(res)
 			}
 		},
 	}
 )
 
 func init() {
+	showCmd.Flags().StringVar(&showSince, "since", "", "Only show images added or updated since an RFC3339 time or a duration ago, ex: 24h")
+	showCmd.Flags().BoolVar(&showResolved, "resolved", false, "Show the images with the kernel, initrds, command line and templates inherited from their parents")
 	imageCmd.AddCommand(showCmd)
 }
-
-func output(data any) error {
-	enc := json.NewEncoder(os.Stdout)
-	enc.SetIndent("", "    ")
-
-	return enc.Encode(data)
-}
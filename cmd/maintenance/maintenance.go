@@ -0,0 +1,94 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package maintenance
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/dustin/go-humanize"
+	"github.com/spf13/cobra"
+	"github.com/ubccr/grendel/cmd"
+	"github.com/ubccr/grendel/pkg/client"
+)
+
+var (
+	maintenanceCmd = &cobra.Command{
+		Use:   "maintenance",
+		Short: "Show or change maintenance mode",
+		Long: `Show whether maintenance mode is on. While on, DHCP leaves out the boot file
+and zero touch provisioning options and the provision endpoints return 503
+with provision.maintenance_page, so hosts do not boot or reinstall from
+grendel. Static address assignment and DNS continue normally.
+
+Maintenance mode is stored in the database, it stays on across restarts of
+grendel serve and applies to every instance sharing the database.`,
+		Args: cobra.NoArgs,
+		RunE: func(command *cobra.Command, args []string) error {
+			gc, err := cmd.NewOgenClient()
+			if err != nil {
+				return err
+			}
+
+			res, err := gc.GETV1GrendelMaintenance(context.Background(), client.GETV1GrendelMaintenanceParams{})
+			if err != nil {
+				return cmd.NewApiError(err)
+			}
+
+			return output(res)
+		},
+	}
+)
+
+func init() {
+	cmd.Root.AddCommand(maintenanceCmd)
+}
+
+// set turns maintenance mode on or off through the API
+func set(enabled bool, reason string) error {
+	gc, err := cmd.NewOgenClient()
+	if err != nil {
+		return err
+	}
+
+	req := &client.MaintenanceRequest{Enabled: client.NewOptBool(enabled)}
+	if reason != "" {
+		req.Reason = client.NewOptNilString(reason)
+	}
+
+	res, err := gc.PUTV1GrendelMaintenance(context.Background(), req, client.PUTV1GrendelMaintenanceParams{})
+	if err != nil {
+		return cmd.NewApiError(err)
+	}
+
+	return output(res)
+}
+
+func output(m *client.Maintenance) error {
+	if cmd.JSONOutput() {
+		return cmd.Output(m)
+	}
+
+	if !m.Enabled.Value {
+		fmt.Println("maintenance mode: off")
+	} else {
+		fmt.Println("maintenance mode: on, provisioning is paused")
+	}
+
+	if m.Reason.Value != "" {
+		fmt.Printf("reason: %s\n", m.Reason.Value)
+	}
+	if m.Changed.Value.IsZero() {
+		return nil
+	}
+
+	changed := humanize.Time(m.Changed.Value)
+	if m.ChangedBy.Value != "" {
+		changed += " by " + m.ChangedBy.Value
+	}
+	fmt.Printf("changed: %s\n", changed)
+
+	return nil
+}
@@ -0,0 +1,25 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package maintenance
+
+import (
+	"github.com/spf13/cobra"
+)
+
+var (
+	offCmd = &cobra.Command{
+		Use:   "off",
+		Short: "Turn maintenance mode off, resuming provisioning",
+		Long:  `Turn maintenance mode off. DHCP sends boot files and the provision endpoints answer again.`,
+		Args:  cobra.NoArgs,
+		RunE: func(command *cobra.Command, args []string) error {
+			return set(false, "")
+		},
+	}
+)
+
+func init() {
+	maintenanceCmd.AddCommand(offCmd)
+}
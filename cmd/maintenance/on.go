@@ -0,0 +1,29 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package maintenance
+
+import (
+	"github.com/spf13/cobra"
+)
+
+var (
+	reason string
+	onCmd  = &cobra.Command{
+		Use:   "on",
+		Short: "Turn maintenance mode on, pausing provisioning",
+		Long: `Turn maintenance mode on. Hosts booting keep their static address and DNS
+but are not sent a boot file, and provisioning requests get 503. Every
+suppressed request is logged by grendel serve with the host name.`,
+		Args: cobra.NoArgs,
+		RunE: func(command *cobra.Command, args []string) error {
+			return set(true, reason)
+		},
+	}
+)
+
+func init() {
+	onCmd.Flags().StringVar(&reason, "reason", "", "reason shown by grendel status and logged with suppressed requests")
+	maintenanceCmd.AddCommand(onCmd)
+}
@@ -0,0 +1,166 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/ubccr/grendel/pkg/client"
+)
+
+// MergeEdits applies the changes made to each object in the JSON array base,
+// as found in edited, on top of current. Objects are matched by id so renames
+// are merged too. Merged objects take the revision of current so they can be
+// saved again. Objects without an id in edited are new and left unchanged. An
+// error listing the fields is returned when current changed the same field
+// differently or an edited object no longer exists.
+func MergeEdits(base, edited, current []byte) ([]byte, error) {
+	baseObjs, err := objectsByID(base)
+	if err != nil {
+		return nil, err
+	}
+	currentObjs, err := objectsByID(current)
+	if err != nil {
+		return nil, err
+	}
+
+	var editedObjs []map[string]json.RawMessage
+	if err := json.Unmarshal(edited, &editedObjs); err != nil {
+		return nil, err
+	}
+
+	conflicts := make([]string, 0)
+	merged := make([]map[string]json.RawMessage, 0, len(editedObjs))
+	for _, obj := range editedObjs {
+		id := objectID(obj)
+		orig, ok := baseObjs[id]
+		if id == "" || !ok {
+			merged = append(merged, obj)
+			continue
+		}
+
+		cur, ok := currentObjs[id]
+		if !ok {
+			conflicts = append(conflicts, fmt.Sprintf("%s: deleted", objectName(orig)))
+			continue
+		}
+
+		result := make(map[string]json.RawMessage, len(cur))
+		for k, v := range cur {
+			result[k] = v
+		}
+
+		for _, k := range fieldNames(orig, obj, cur) {
+			if k == "revision" || equalRaw(obj[k], orig[k]) {
+				continue
+			}
+			if !equalRaw(cur[k], orig[k]) && !equalRaw(cur[k], obj[k]) {
+				conflicts = append(conflicts, fmt.Sprintf("%s: %s", objectName(orig), k))
+				continue
+			}
+			if v, ok := obj[k]; ok {
+				result[k] = v
+			} else {
+				delete(result, k)
+			}
+		}
+
+		merged = append(merged, result)
+	}
+
+	if len(conflicts) > 0 {
+		return nil, fmt.Errorf("changes conflict with the current version: %s", strings.Join(conflicts, ", "))
+	}
+
+	return json.Marshal(merged)
+}
+
+// NewConflictError adds a hint on how to resolve a revision conflict to API
+// errors with status 409. Other errors are returned unchanged.
+func NewConflictError(err error) error {
+	if !errors.Is(err, client.ErrConflict) {
+		return err
+	}
+
+	return fmt.Errorf("%w. Fetch the current version and try again or use --force to overwrite", err)
+}
+
+func objectsByID(data []byte) (map[string]map[string]json.RawMessage, error) {
+	var objs []map[string]json.RawMessage
+	if err := json.Unmarshal(data, &objs); err != nil {
+		return nil, err
+	}
+
+	byID := make(map[string]map[string]json.RawMessage, len(objs))
+	for _, obj := range objs {
+		if id := objectID(obj); id != "" {
+			byID[id] = obj
+		}
+	}
+
+	return byID, nil
+}
+
+func objectID(obj map[string]json.RawMessage) string {
+	id := string(bytes.TrimSpace(obj["id"]))
+	if id == "null" || id == "0" {
+		return ""
+	}
+
+	return id
+}
+
+func objectName(obj map[string]json.RawMessage) string {
+	var name string
+	if err := json.Unmarshal(obj["name"], &name); err != nil || name == "" {
+		return objectID(obj)
+	}
+
+	return name
+}
+
+// fieldNames returns the sorted union of the keys of objs
+func fieldNames(objs ...map[string]json.RawMessage) []string {
+	seen := make(map[string]bool)
+	for _, obj := range objs {
+		for k := range obj {
+			seen[k] = true
+		}
+	}
+
+	names := make([]string, 0, len(seen))
+	for k := range seen {
+		names = append(names, k)
+	}
+	sort.Strings(names)
+
+	return names
+}
+
+// equalRaw compares two JSON values ignoring formatting and key order. A
+// missing value equals null.
+func equalRaw(a, b json.RawMessage) bool {
+	var va, vb any
+	if len(a) > 0 {
+		if err := json.Unmarshal(a, &va); err != nil {
+			return false
+		}
+	}
+	if len(b) > 0 {
+		if err := json.Unmarshal(b, &vb); err != nil {
+			return false
+		}
+	}
+
+	ja, _ := json.Marshal(va)
+	jb, _ := json.Marshal(vb)
+
+	return bytes.Equal(ja, jb)
+}
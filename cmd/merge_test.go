@@ -0,0 +1,46 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package cmd
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMergeEdits(t *testing.T) {
+	base := []byte(`[
+		{"id": 1, "name": "cpn-01", "provision": false, "boot_image": "rocky", "revision": 1},
+		{"id": 2, "name": "cpn-02", "provision": false, "boot_image": "rocky", "revision": 1}
+	]`)
+	edited := []byte(`[
+		{"id": 1, "name": "cpn-01", "provision": true, "boot_image": "rocky", "revision": 1},
+		{"id": 2, "name": "cpn-02", "provision": false, "boot_image": "rocky", "revision": 1},
+		{"name": "cpn-03", "provision": false}
+	]`)
+	current := []byte(`[
+		{"id": 1, "name": "cpn-01", "provision": false, "boot_image": "ubuntu", "revision": 2},
+		{"id": 2, "name": "cpn-02", "provision": false, "boot_image": "rocky", "revision": 1}
+	]`)
+
+	merged, err := MergeEdits(base, edited, current)
+	if assert.NoError(t, err) {
+		assert.JSONEq(t, `[
+			{"id": 1, "name": "cpn-01", "provision": true, "boot_image": "ubuntu", "revision": 2},
+			{"id": 2, "name": "cpn-02", "provision": false, "boot_image": "rocky", "revision": 1},
+			{"name": "cpn-03", "provision": false}
+		]`, string(merged))
+	}
+
+	// Both changed the boot image of cpn-01
+	edited = []byte(`[{"id": 1, "name": "cpn-01", "provision": false, "boot_image": "alma", "revision": 1}]`)
+	_, err = MergeEdits(base, edited, current)
+	assert.ErrorContains(t, err, "cpn-01: boot_image")
+
+	// cpn-02 was deleted
+	edited = []byte(`[{"id": 2, "name": "cpn-02", "provision": true, "boot_image": "rocky", "revision": 1}]`)
+	_, err = MergeEdits(base, edited, []byte(`[]`))
+	assert.ErrorContains(t, err, "cpn-02: deleted")
+}
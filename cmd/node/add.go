@@ -0,0 +1,541 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package node
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/netip"
+	"os"
+	"slices"
+	"sort"
+	"strings"
+	"text/template"
+
+	"github.com/manifoldco/promptui"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"github.com/ubccr/grendel/cmd"
+	"github.com/ubccr/grendel/internal/config"
+	"github.com/ubccr/grendel/internal/ipam"
+	"github.com/ubccr/grendel/pkg/client"
+)
+
+const (
+	defaultFQDNTemplate    = "{{.Name}}.{{.Domain}}"
+	defaultBMCFQDNTemplate = "bmc-{{.Name}}.{{.Domain}}"
+	defaultImageLabel      = "(default)"
+)
+
+// addOptions are the values used to build a single new node. They are set
+// either from flags or from the interactive prompts.
+type addOptions struct {
+	Name      string
+	Ifname    string
+	MAC       string
+	IP        string
+	FQDN      string
+	BMCMAC    string
+	BMCIP     string
+	BMCFQDN   string
+	Tags      []string
+	Image     string
+	Provision bool
+}
+
+var (
+	addOpts        addOptions
+	addInteractive bool
+	addYes         bool
+	addCmd         = &cobra.Command{
+		Use:   "add --name <name> --mac <mac> --ip <ip>",
+		Short: "Add a single node",
+		Long: `Add a single node.
+
+Use --interactive to be prompted for each value. Tags set with --tags are
+assigned to the new node. MAC and IP addresses are validated and IPs must fall
+inside one of the configured dhcp.subnets. Omitting the prefix length from an
+IP uses the prefix length of the matching subnet. An IP of auto:<subnet>
+assigns the next free address of the subnet, see node nextip.`,
+		Args: cobra.NoArgs,
+		RunE: func(command *cobra.Command, args []string) error {
+			gc, err := cmd.NewOgenClient()
+			if err != nil {
+				return err
+			}
+
+			existing, err := gc.HostList(context.Background(), client.HostFilter{})
+			if err != nil {
+				return err
+			}
+
+			images, err := gc.ImageList(context.Background())
+			if err != nil {
+				return err
+			}
+
+			opts := addOpts
+			opts.Tags = tags
+			if addInteractive {
+				if err := addPrompt(&opts, existing, images); err != nil {
+					return err
+				}
+			}
+
+			if err := resolveAutoIPs(gc, []*string{&opts.IP, &opts.BMCIP}); err != nil {
+				return err
+			}
+
+			node, err := newAddNode(opts, existing, images)
+			if err != nil {
+				return err
+			}
+
+			if addInteractive && !addYes {
+				data, err := json.MarshalIndent(&node, "", "    ")
+				if err != nil {
+					return err
+				}
+				fmt.Fprintln(os.Stderr, string(data))
+
+				prompt := promptui.Prompt{
+					Label:     fmt.Sprintf("Add node %s", opts.Name),
+					IsConfirm: true,
+					Stdout:    os.Stderr,
+				}
+				if _, err := prompt.Run(); err != nil {
+					return errors.New("add cancelled")
+				}
+			}
+
+			req := &client.NodeAddRequest{
+				NodeList: []client.NilNodeAddRequestNodeListItem{client.NewNilNodeAddRequestNodeListItem(node)},
+			}
+			res, err := gc.POSTV1Nodes(context.Background(), req, client.POSTV1NodesParams{})
+			if err != nil {
+				return cmd.NewApiError(err)
+			}
+
+			return cmd.NewApiResponse(res)
+		},
+	}
+)
+
+func init() {
+	addCmd.Flags().BoolVarP(&addInteractive, "interactive", "i", false, "Prompt for each value")
+	addCmd.Flags().BoolVarP(&addYes, "yes", "y", false, "Skip confirmation prompt")
+	addCmd.Flags().StringVar(&addOpts.Name, "name", "", "Node name")
+	addCmd.Flags().StringVar(&addOpts.Ifname, "ifname", "", "Boot interface name")
+	addCmd.Flags().StringVar(&addOpts.MAC, "mac", "", "Boot interface MAC address")
+	addCmd.Flags().StringVar(&addOpts.IP, "ip", "", "Boot interface IP address")
+	addCmd.Flags().StringVar(&addOpts.FQDN, "fqdn", "", "Boot interface FQDN. Defaults to client.fqdn_template")
+	addCmd.Flags().StringVar(&addOpts.BMCMAC, "bmc-mac", "", "BMC interface MAC address")
+	addCmd.Flags().StringVar(&addOpts.BMCIP, "bmc-ip", "", "BMC interface IP address")
+	addCmd.Flags().StringVar(&addOpts.BMCFQDN, "bmc-fqdn", "", "BMC interface FQDN. Defaults to client.bmc_fqdn_template")
+	addCmd.Flags().StringVar(&addOpts.Image, "image", "", "Boot image")
+	addCmd.Flags().BoolVar(&addOpts.Provision, "provision", false, "Set node to provision")
+
+	viper.SetDefault("client.fqdn_template", defaultFQDNTemplate)
+	viper.SetDefault("client.bmc_fqdn_template", defaultBMCFQDNTemplate)
+
+	nodeCmd.AddCommand(addCmd)
+}
+
+// newAddNode validates opts and returns the node to add. Both the interactive
+// and flag based paths use it.
+func newAddNode(opts addOptions, existing []client.Host, images []client.BootImage) (client.NodeAddRequestNodeListItem, error) {
+	node := client.NodeAddRequestNodeListItem{}
+
+	if err := validateName(opts.Name, existing); err != nil {
+		return node, err
+	}
+
+	if opts.MAC == "" && opts.IP == "" {
+		return node, errors.New("--mac or --ip is required")
+	}
+
+	if err := validateImage(opts.Image, images); err != nil {
+		return node, err
+	}
+
+	boot, err := newAddInterface(opts.Ifname, opts.MAC, opts.IP, opts.FQDN, fqdnTemplate("client.fqdn_template", opts.Name), false, existing)
+	if err != nil {
+		return node, err
+	}
+
+	node.Name = client.NewOptString(opts.Name)
+	node.Provision = client.NewOptBool(opts.Provision)
+	node.Tags = client.NewOptNilStringArray(opts.Tags)
+	node.Bonds = []client.NilNodeAddRequestNodeListItemBondsItem{}
+	node.Interfaces = []client.NilNodeAddRequestNodeListItemInterfacesItem{
+		client.NewNilNodeAddRequestNodeListItemInterfacesItem(boot),
+	}
+	if opts.Image != "" {
+		node.BootImage = client.NewOptString(opts.Image)
+	}
+
+	if opts.BMCMAC != "" || opts.BMCIP != "" {
+		bmc, err := newAddInterface("", opts.BMCMAC, opts.BMCIP, opts.BMCFQDN, fqdnTemplate("client.bmc_fqdn_template", opts.Name), true, existing)
+		if err != nil {
+			return node, fmt.Errorf("bmc interface: %w", err)
+		}
+		node.Interfaces = append(node.Interfaces, client.NewNilNodeAddRequestNodeListItemInterfacesItem(bmc))
+	}
+
+	return node, nil
+}
+
+func newAddInterface(ifname, mac, ip, fqdn, defaultFQDN string, isBMC bool, existing []client.Host) (client.NodeAddRequestNodeListItemInterfacesItem, error) {
+	nic := client.NodeAddRequestNodeListItemInterfacesItem{
+		Bmc: client.NewOptBool(isBMC),
+	}
+
+	if mac != "" {
+		hwaddr, err := validateMAC(mac, existing)
+		if err != nil {
+			return nic, err
+		}
+		nic.MAC = client.NewOptString(hwaddr.String())
+	}
+
+	if ip != "" {
+		prefix, err := validateIP(ip, existing)
+		if err != nil {
+			return nic, err
+		}
+		nic.IP = client.NewOptString(prefix.String())
+	}
+
+	if fqdn == "" {
+		fqdn = defaultFQDN
+	}
+
+	nic.Fqdn = client.NewOptString(fqdn)
+	if ifname != "" {
+		nic.Ifname = client.NewOptString(ifname)
+	}
+
+	return nic, nil
+}
+
+func validateName(name string, existing []client.Host) error {
+	if name == "" {
+		return errors.New("node name is required")
+	}
+
+	if strings.ContainsAny(name, " \t,[]") {
+		return fmt.Errorf("invalid node name %q: must not contain whitespace, commas or brackets", name)
+	}
+
+	for _, host := range existing {
+		if host.Name.Value == name {
+			return fmt.Errorf("node %s already exists. Use node edit to change existing nodes", name)
+		}
+	}
+
+	return nil
+}
+
+func validateMAC(mac string, existing []client.Host) (net.HardwareAddr, error) {
+	hwaddr, err := net.ParseMAC(mac)
+	if err != nil {
+		return nil, fmt.Errorf("invalid MAC address %q: %w", mac, err)
+	}
+
+	for _, host := range existing {
+		for _, nic := range host.Interfaces {
+			if strings.EqualFold(nic.Value.MAC.Value, hwaddr.String()) {
+				return nil, fmt.Errorf("MAC address %s is already assigned to node %s", hwaddr, host.Name.Value)
+			}
+		}
+	}
+
+	return hwaddr, nil
+}
+
+// validateIP parses ip and checks it is contained in one of the configured
+// subnets. If ip does not include a prefix length the prefix length of the
+// matching subnet is used.
+func validateIP(ip string, existing []client.Host) (netip.Prefix, error) {
+	var prefix netip.Prefix
+	addr, err := netip.ParseAddr(ip)
+	if err == nil {
+		prefix = netip.PrefixFrom(addr, -1)
+	} else {
+		prefix, err = netip.ParsePrefix(ip)
+		if err != nil {
+			return prefix, fmt.Errorf("invalid IP address %q: expected an address or CIDR", ip)
+		}
+		addr = prefix.Addr()
+	}
+
+	if subnets := config.Current().Subnets; len(subnets) > 0 {
+		var subnet *config.Subnet
+		for i := range subnets {
+			if subnets[i].Gateway.Masked().Contains(addr) {
+				subnet = &subnets[i]
+				break
+			}
+		}
+
+		if subnet == nil {
+			return prefix, fmt.Errorf("IP address %s is not inside any configured dhcp.subnets", addr)
+		}
+
+		if !prefix.IsValid() {
+			prefix = netip.PrefixFrom(addr, subnet.Gateway.Bits())
+		}
+	}
+
+	if !prefix.IsValid() {
+		return prefix, fmt.Errorf("IP address %s must include a prefix length when no dhcp.subnets are configured, e.g. %s/24", addr, addr)
+	}
+
+	for _, host := range existing {
+		for _, nic := range host.Interfaces {
+			p, err := netip.ParsePrefix(nic.Value.IP.Value)
+			if err == nil && p.Addr() == addr {
+				return prefix, fmt.Errorf("IP address %s is already assigned to node %s", addr, host.Name.Value)
+			}
+		}
+	}
+
+	return prefix, nil
+}
+
+// validatePromptIP validates an IP entered at a prompt, allocated later when
+// it is auto:<subnet>
+func validatePromptIP(ip string, existing []client.Host) error {
+	if _, ok, err := ipam.ParseAuto(ip); ok {
+		return err
+	}
+
+	_, err := validateIP(ip, existing)
+	return err
+}
+
+func validateImage(image string, images []client.BootImage) error {
+	if image == "" {
+		return nil
+	}
+
+	for _, img := range images {
+		if img.Name == image {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("boot image not found: %s", image)
+}
+
+// fqdnTemplate renders the FQDN template stored in the given config key for
+// name. The domain is taken from discovery.domain or the first
+// dhcp.domain_search entry.
+func fqdnTemplate(key, name string) string {
+	if name == "" {
+		return ""
+	}
+
+	domain := viper.GetString("discovery.domain")
+	if search := config.Current().DefaultDomainSearch; domain == "" && len(search) > 0 {
+		domain = search[0]
+	}
+
+	tmpl, err := template.New("fqdn").Parse(viper.GetString(key))
+	if err != nil {
+		cmd.Log.Warnf("invalid %s: %s", key, err)
+		return ""
+	}
+
+	var buf bytes.Buffer
+	err = tmpl.Execute(&buf, struct{ Name, Domain string }{Name: name, Domain: domain})
+	if err != nil {
+		cmd.Log.Warnf("invalid %s: %s", key, err)
+		return ""
+	}
+
+	return strings.Trim(buf.String(), ".")
+}
+
+// existingTags returns a sorted list of the tags assigned to existing nodes
+func existingTags(existing []client.Host) []string {
+	list := make([]string, 0)
+	for _, host := range existing {
+		for _, t := range host.Tags.Value {
+			if !slices.Contains(list, t) {
+				list = append(list, t)
+			}
+		}
+	}
+	sort.Strings(list)
+
+	return list
+}
+
+func addPrompt(opts *addOptions, existing []client.Host, images []client.BootImage) error {
+	var err error
+
+	opts.Name, err = promptString("Node name", opts.Name, func(s string) error {
+		return validateName(s, existing)
+	})
+	if err != nil {
+		return err
+	}
+
+	opts.Ifname, err = promptString("Boot interface name (optional)", opts.Ifname, nil)
+	if err != nil {
+		return err
+	}
+
+	opts.MAC, err = promptString("Boot interface MAC", opts.MAC, func(s string) error {
+		_, err := validateMAC(s, existing)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	opts.IP, err = promptString("Boot interface IP", opts.IP, func(s string) error {
+		return validatePromptIP(s, existing)
+	})
+	if err != nil {
+		return err
+	}
+
+	opts.FQDN, err = promptString("Boot interface FQDN", firstNonEmpty(opts.FQDN, fqdnTemplate("client.fqdn_template", opts.Name)), nil)
+	if err != nil {
+		return err
+	}
+
+	opts.BMCMAC, err = promptString("BMC MAC (optional)", opts.BMCMAC, func(s string) error {
+		if s == "" {
+			return nil
+		}
+		_, err := validateMAC(s, existing)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	if opts.BMCMAC != "" {
+		opts.BMCIP, err = promptString("BMC IP", opts.BMCIP, func(s string) error {
+			return validatePromptIP(s, existing)
+		})
+		if err != nil {
+			return err
+		}
+
+		opts.BMCFQDN, err = promptString("BMC FQDN", firstNonEmpty(opts.BMCFQDN, fqdnTemplate("client.bmc_fqdn_template", opts.Name)), nil)
+		if err != nil {
+			return err
+		}
+	}
+
+	known := existingTags(existing)
+	if len(known) > 0 {
+		fmt.Fprintf(os.Stderr, "Existing tags: %s\n", strings.Join(known, ","))
+	}
+	tagStr, err := promptString("Tags (comma separated, a trailing * completes an existing tag)", strings.Join(opts.Tags, ","), nil)
+	if err != nil {
+		return err
+	}
+	opts.Tags = completeTags(tagStr, known)
+
+	opts.Image, err = promptImage(opts.Image, images)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// completeTags splits a comma separated list of tags. Tags ending in * are
+// expanded to the existing tags with the given prefix.
+func completeTags(tagStr string, known []string) []string {
+	list := make([]string, 0)
+	for _, t := range strings.Split(tagStr, ",") {
+		t = strings.TrimSpace(t)
+		if t == "" {
+			continue
+		}
+
+		if prefix, ok := strings.CutSuffix(t, "*"); ok {
+			for _, k := range known {
+				if strings.HasPrefix(k, prefix) && !slices.Contains(list, k) {
+					list = append(list, k)
+				}
+			}
+			continue
+		}
+
+		if !slices.Contains(list, t) {
+			list = append(list, t)
+		}
+	}
+
+	return list
+}
+
+func promptString(label, def string, validate func(string) error) (string, error) {
+	prompt := promptui.Prompt{
+		Label:     label,
+		Default:   def,
+		AllowEdit: true,
+		Validate:  validate,
+		Stdout:    os.Stderr,
+	}
+
+	value, err := prompt.Run()
+	if err != nil {
+		return "", errors.New("add cancelled")
+	}
+
+	return strings.TrimSpace(value), nil
+}
+
+func promptImage(def string, images []client.BootImage) (string, error) {
+	items := []string{defaultImageLabel}
+	for _, img := range images {
+		items = append(items, img.Name)
+	}
+
+	cursor := 0
+	if i := slices.Index(items, def); i > 0 {
+		cursor = i
+	}
+
+	prompt := promptui.Select{
+		Label:     "Boot image",
+		Items:     items,
+		CursorPos: cursor,
+		Stdout:    os.Stderr,
+	}
+
+	_, value, err := prompt.Run()
+	if err != nil {
+		return "", errors.New("add cancelled")
+	}
+
+	if value == defaultImageLabel {
+		return "", nil
+	}
+
+	return value, nil
+}
+
+func firstNonEmpty(values ...string) string {
+	for _, v := range values {
+		if v != "" {
+			return v
+		}
+	}
+
+	return ""
+}
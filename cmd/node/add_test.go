@@ -0,0 +1,98 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package node
+
+import (
+	"net/netip"
+	"testing"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"github.com/ubccr/grendel/internal/config"
+	"github.com/ubccr/grendel/pkg/client"
+)
+
+func testExisting() []client.Host {
+	return []client.Host{
+		{
+			Name: client.NewOptString("cpn-01"),
+			Tags: client.NewOptNilStringArray([]string{"ib", "gpu"}),
+			Interfaces: []client.NilHostInterfacesItem{
+				client.NewNilHostInterfacesItem(client.HostInterfacesItem{
+					MAC: client.NewOptString("de:ad:be:ef:00:01"),
+					IP:  client.NewOptString("10.0.0.1/24"),
+				}),
+			},
+		},
+	}
+}
+
+func TestAddNode(t *testing.T) {
+	cfg := *config.Current()
+	cfg.Subnets = []config.Subnet{{Gateway: netip.MustParsePrefix("10.0.0.254/24")}}
+	defer config.Set(config.Set(&cfg))
+	viper.Set("discovery.domain", "example.com")
+	defer viper.Set("discovery.domain", "")
+
+	images := []client.BootImage{{Name: "compute"}}
+
+	opts := addOptions{
+		Name:   "cpn-02",
+		MAC:    "DE:AD:BE:EF:00:02",
+		IP:     "10.0.0.2",
+		BMCMAC: "de:ad:be:ef:01:02",
+		BMCIP:  "10.0.0.102/24",
+		Image:  "compute",
+		Tags:   []string{"ib"},
+	}
+
+	node, err := newAddNode(opts, testExisting(), images)
+	if assert.NoError(t, err) && assert.Len(t, node.Interfaces, 2) {
+		boot := node.Interfaces[0].Value
+		assert.Equal(t, "de:ad:be:ef:00:02", boot.MAC.Value)
+		assert.Equal(t, "10.0.0.2/24", boot.IP.Value)
+		assert.Equal(t, "cpn-02.example.com", boot.Fqdn.Value)
+		assert.False(t, boot.Bmc.Value)
+
+		bmc := node.Interfaces[1].Value
+		assert.Equal(t, "bmc-cpn-02.example.com", bmc.Fqdn.Value)
+		assert.True(t, bmc.Bmc.Value)
+		assert.Equal(t, "compute", node.BootImage.Value)
+	}
+
+	type badOpts struct {
+		name string
+		opts addOptions
+	}
+	for _, tc := range []badOpts{
+		{"duplicate name", addOptions{Name: "cpn-01", MAC: "de:ad:be:ef:00:03"}},
+		{"invalid name", addOptions{Name: "cpn-[01-02]", MAC: "de:ad:be:ef:00:03"}},
+		{"invalid mac", addOptions{Name: "cpn-03", MAC: "de:ad:be:ef"}},
+		{"duplicate mac", addOptions{Name: "cpn-03", MAC: "de:ad:be:ef:00:01"}},
+		{"outside subnet", addOptions{Name: "cpn-03", IP: "10.1.0.3"}},
+		{"duplicate ip", addOptions{Name: "cpn-03", IP: "10.0.0.1"}},
+		{"unknown image", addOptions{Name: "cpn-03", IP: "10.0.0.3", Image: "missing"}},
+		{"no interface", addOptions{Name: "cpn-03"}},
+	} {
+		_, err := newAddNode(tc.opts, testExisting(), images)
+		assert.Error(t, err, tc.name)
+	}
+}
+
+func TestAddNodeNoSubnets(t *testing.T) {
+	_, err := newAddNode(addOptions{Name: "cpn-02", IP: "10.0.0.2"}, nil, nil)
+	assert.Error(t, err)
+
+	node, err := newAddNode(addOptions{Name: "cpn-02", IP: "10.0.0.2/16"}, nil, nil)
+	if assert.NoError(t, err) {
+		assert.Equal(t, "10.0.0.2/16", node.Interfaces[0].Value.IP.Value)
+	}
+}
+
+func TestCompleteTags(t *testing.T) {
+	known := existingTags(testExisting())
+	assert.Equal(t, []string{"gpu", "ib"}, known)
+	assert.Equal(t, []string{"gpu", "rack=a01"}, completeTags("g*, rack=a01,,gpu", known))
+}
@@ -0,0 +1,316 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package node
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/netip"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/ubccr/grendel/cmd"
+	"github.com/ubccr/grendel/internal/ipam"
+	"github.com/ubccr/grendel/pkg/client"
+	"github.com/ubccr/grendel/pkg/model"
+)
+
+type cloneOptions struct {
+	Name    string
+	Count   int
+	MACs    []string
+	BMCMACs []string
+	IP      string
+	BMCIP   string
+}
+
+var (
+	cloneOpts cloneOptions
+	cloneCmd  = &cobra.Command{
+		Use:   "clone <source> <new>",
+		Short: "Add nodes copied from an existing node",
+		Long: `Add nodes copied from an existing node.
+
+The tags, boot image, firmware, provision state and interfaces of the source
+node are copied. The source name is replaced in the first label of each
+interface FQDN. MAC addresses and switch ports are not copied, set them with
+--mac and --bmc-mac.
+
+--ip and --bmc-ip accept an address, which is assigned to the first
+interface and incremented for each node in a batch, +N which adds N (times
+the position in the batch) to the addresses of every copied interface, or
+auto:<subnet> which assigns the next free addresses of the subnet to the
+first interface, see node nextip. Copied interfaces without a new address are
+left blank.
+
+With --count the trailing number of the new name is incremented for each
+node, e.g. "clone cpn-d13-32 cpn-d13-33 --count 4" adds cpn-d13-33 to
+cpn-d13-36. --mac and --bmc-mac then take a comma separated list with one MAC
+per node.`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(command *cobra.Command, args []string) error {
+			gc, err := cmd.NewOgenClient()
+			if err != nil {
+				return err
+			}
+
+			existing, err := gc.HostList(context.Background(), client.HostFilter{})
+			if err != nil {
+				return err
+			}
+
+			var source *client.Host
+			for i := range existing {
+				if existing[i].Name.Value == args[0] {
+					source = &existing[i]
+					break
+				}
+			}
+			if source == nil {
+				return fmt.Errorf("node not found: %s", args[0])
+			}
+
+			opts := cloneOpts
+			opts.Name = args[1]
+			hosts, err := newCloneNodes(*source, opts, existing)
+			if err != nil {
+				return err
+			}
+			if err := resolveAutoIPs(gc, hostIPs(hosts)); err != nil {
+				return err
+			}
+
+			res, err := gc.HostSave(context.Background(), hosts)
+			if err != nil {
+				return err
+			}
+
+			return cmd.NewApiResponse(res)
+		},
+	}
+)
+
+func init() {
+	cloneCmd.Flags().IntVar(&cloneOpts.Count, "count", 1, "number of sequential nodes to add")
+	cloneCmd.Flags().StringSliceVar(&cloneOpts.MACs, "mac", []string{}, "boot interface MAC, one per node with --count")
+	cloneCmd.Flags().StringSliceVar(&cloneOpts.BMCMACs, "bmc-mac", []string{}, "BMC interface MAC, one per node with --count")
+	cloneCmd.Flags().StringVar(&cloneOpts.IP, "ip", "", "IP address or +N offset for the copied interfaces")
+	cloneCmd.Flags().StringVar(&cloneOpts.BMCIP, "bmc-ip", "", "IP address or +N offset for the copied BMC interface")
+	nodeCmd.AddCommand(cloneCmd)
+}
+
+// newCloneNodes returns opts.Count copies of source. Each copy is validated
+// against existing and the copies before it.
+func newCloneNodes(source client.Host, opts cloneOptions, existing []client.Host) ([]client.Host, error) {
+	if opts.Count < 1 {
+		return nil, errors.New("--count must be at least 1")
+	}
+	if len(opts.MACs) > 0 && len(opts.MACs) != opts.Count {
+		return nil, fmt.Errorf("--mac has %d addresses, expected one per node (%d)", len(opts.MACs), opts.Count)
+	}
+	if len(opts.BMCMACs) > 0 && len(opts.BMCMACs) != opts.Count {
+		return nil, fmt.Errorf("--bmc-mac has %d addresses, expected one per node (%d)", len(opts.BMCMACs), opts.Count)
+	}
+
+	names, err := cloneNames(opts.Name, opts.Count)
+	if err != nil {
+		return nil, err
+	}
+
+	existing = slices.Clone(existing)
+	hosts := make([]client.Host, 0, opts.Count)
+	for i, name := range names {
+		if err := validateName(name, existing); err != nil {
+			return nil, err
+		}
+
+		host := source
+		host.ID = client.OptNilInt64{}
+		host.UID = client.OptNilString{}
+		host.Revision = client.OptNilInt64{}
+		host.Name = client.NewOptString(name)
+		host.Tags = client.NewOptNilStringArray(slices.Clone(source.Tags.Value))
+		host.Interfaces = slices.Clone(source.Interfaces)
+		host.Bonds = slices.Clone(source.Bonds)
+
+		seenBoot, seenBMC := false, false
+		for j := range host.Interfaces {
+			nic := &host.Interfaces[j].Value
+			isBMC := nic.Bmc.Value
+
+			mac, spec, first := "", opts.IP, !seenBoot
+			if isBMC {
+				spec, first = opts.BMCIP, !seenBMC
+				seenBMC = true
+				if first && len(opts.BMCMACs) > 0 {
+					mac = opts.BMCMACs[i]
+				}
+			} else {
+				seenBoot = true
+				if first && len(opts.MACs) > 0 {
+					mac = opts.MACs[i]
+				}
+			}
+
+			nic.ID = client.OptNilInt64{}
+			nic.Switch = client.OptString{}
+			nic.Port = client.OptInt{}
+			nic.MAC = client.OptString{}
+			if mac != "" {
+				hwaddr, err := validateMAC(mac, existing)
+				if err != nil {
+					return nil, fmt.Errorf("node %s: %w", name, err)
+				}
+				nic.MAC = client.NewOptString(hwaddr.String())
+			}
+
+			ip, err := cloneIP(nic.IP.Value, spec, i, first, existing)
+			if err != nil {
+				return nil, fmt.Errorf("node %s: %w", name, err)
+			}
+			nic.IP = client.NewOptString(ip)
+			nic.Fqdn = client.NewOptString(model.RenameFQDN(nic.Fqdn.Value, source.Name.Value, name))
+		}
+
+		for j := range host.Bonds {
+			bond := &host.Bonds[j].Value
+			bond.ID = client.OptNilInt64{}
+			bond.MAC = client.OptString{}
+			bond.Switch = client.OptString{}
+			bond.Port = client.OptInt{}
+
+			ip, err := cloneIP(bond.IP.Value, opts.IP, i, false, existing)
+			if err != nil {
+				return nil, fmt.Errorf("node %s: %w", name, err)
+			}
+			bond.IP = client.NewOptString(ip)
+			bond.Fqdn = client.NewOptString(model.RenameFQDN(bond.Fqdn.Value, source.Name.Value, name))
+		}
+
+		hosts = append(hosts, host)
+		existing = append(existing, host)
+	}
+
+	return hosts, nil
+}
+
+// cloneNames returns count names starting at name. The trailing number of
+// name is incremented keeping its zero padding.
+func cloneNames(name string, count int) ([]string, error) {
+	if count == 1 {
+		return []string{name}, nil
+	}
+
+	prefix := strings.TrimRightFunc(name, func(r rune) bool { return r >= '0' && r <= '9' })
+	digits := name[len(prefix):]
+	if digits == "" {
+		return nil, fmt.Errorf("node name %q must end with a number to use --count", name)
+	}
+
+	start, err := strconv.Atoi(digits)
+	if err != nil {
+		return nil, err
+	}
+
+	names := make([]string, 0, count)
+	for i := range count {
+		names = append(names, fmt.Sprintf("%s%0*d", prefix, len(digits), start+i))
+	}
+
+	return names, nil
+}
+
+// cloneIP returns the address of the n-th copy of an interface with address
+// ip. spec is either an address, which is only applied to the first interface
+// of its kind, or +N which is added n+1 times to ip.
+func cloneIP(ip, spec string, n int, first bool, existing []client.Host) (string, error) {
+	if spec == "" {
+		return "", nil
+	}
+
+	// Allocated once all copies are built
+	if _, ok, err := ipam.ParseAuto(spec); ok {
+		if err != nil || !first {
+			return "", err
+		}
+		return spec, nil
+	}
+
+	var addr netip.Addr
+	bits := -1
+	if offset, ok := strings.CutPrefix(spec, "+"); ok {
+		step, err := strconv.Atoi(offset)
+		if err != nil || step < 1 {
+			return "", fmt.Errorf("invalid IP offset %q: expected +N", spec)
+		}
+
+		if ip == "" {
+			return "", nil
+		}
+		prefix, err := netip.ParsePrefix(ip)
+		if err != nil {
+			return "", fmt.Errorf("invalid source IP address %q: %w", ip, err)
+		}
+		addr, bits = prefix.Addr(), prefix.Bits()
+		addr, err = addrAdd(addr, step*(n+1))
+		if err != nil {
+			return "", err
+		}
+	} else {
+		if !first {
+			return "", nil
+		}
+
+		start, cidr, _ := strings.Cut(spec, "/")
+		a, err := netip.ParseAddr(start)
+		if err != nil {
+			return "", fmt.Errorf("invalid IP address %q", spec)
+		}
+		if cidr != "" {
+			bits, err = strconv.Atoi(cidr)
+			if err != nil {
+				return "", fmt.Errorf("invalid IP address %q", spec)
+			}
+		} else if p, err := netip.ParsePrefix(ip); err == nil {
+			bits = p.Bits()
+		}
+		addr, err = addrAdd(a, n)
+		if err != nil {
+			return "", err
+		}
+	}
+
+	value := addr.String()
+	if bits >= 0 {
+		value = netip.PrefixFrom(addr, bits).String()
+	}
+
+	prefix, err := validateIP(value, existing)
+	if err != nil {
+		return "", err
+	}
+
+	return prefix.String(), nil
+}
+
+// addrAdd returns addr incremented by n
+func addrAdd(addr netip.Addr, n int) (netip.Addr, error) {
+	b := addr.AsSlice()
+	carry := n
+	for i := len(b) - 1; i >= 0 && carry > 0; i-- {
+		sum := int(b[i]) + carry
+		b[i] = byte(sum)
+		carry = sum >> 8
+	}
+	if carry > 0 {
+		return addr, fmt.Errorf("IP address %s + %d overflows", addr, n)
+	}
+
+	next, _ := netip.AddrFromSlice(b)
+	return next, nil
+}
@@ -0,0 +1,126 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package node
+
+import (
+	"net/netip"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/ubccr/grendel/internal/config"
+	"github.com/ubccr/grendel/pkg/client"
+)
+
+func testCloneSource() client.Host {
+	return client.Host{
+		ID:        client.NewOptNilInt64(1),
+		UID:       client.NewOptNilString("2bKjxXq1JgtnKcvRYrLsaOJkCdk"),
+		Name:      client.NewOptString("cpn-d13-32"),
+		BootImage: client.NewOptString("compute"),
+		Tags:      client.NewOptNilStringArray([]string{"ib", "rack=d13"}),
+		Interfaces: []client.NilHostInterfacesItem{
+			client.NewNilHostInterfacesItem(client.HostInterfacesItem{
+				ID:     client.NewOptNilInt64(1),
+				MAC:    client.NewOptString("de:ad:be:ef:00:32"),
+				IP:     client.NewOptString("10.0.0.32/24"),
+				Fqdn:   client.NewOptString("cpn-d13-32.example.com,cpn-d13-32.ib.example.com"),
+				Switch: client.NewOptString("swd13"),
+				Port:   client.NewOptInt(32),
+			}),
+			client.NewNilHostInterfacesItem(client.HostInterfacesItem{
+				MAC:  client.NewOptString("de:ad:be:ef:01:32"),
+				IP:   client.NewOptString("10.0.1.32/24"),
+				Fqdn: client.NewOptString("bmc-cpn-d13-32.example.com"),
+				Bmc:  client.NewOptBool(true),
+			}),
+		},
+	}
+}
+
+func TestCloneNodes(t *testing.T) {
+	cfg := *config.Current()
+	cfg.Subnets = []config.Subnet{}
+	defer config.Set(config.Set(&cfg))
+
+	source := testCloneSource()
+	existing := []client.Host{source}
+
+	opts := cloneOptions{
+		Name:    "cpn-d13-33",
+		Count:   2,
+		MACs:    []string{"de:ad:be:ef:00:33", "de:ad:be:ef:00:34"},
+		BMCMACs: []string{"de:ad:be:ef:01:33", "de:ad:be:ef:01:34"},
+		IP:      "+1",
+		BMCIP:   "10.0.1.33",
+	}
+
+	hosts, err := newCloneNodes(source, opts, existing)
+	if assert.NoError(t, err) && assert.Len(t, hosts, 2) {
+		h := hosts[1]
+		assert.Equal(t, "cpn-d13-34", h.Name.Value)
+		assert.False(t, h.ID.Set)
+		assert.False(t, h.UID.Set)
+		assert.Equal(t, []string{"ib", "rack=d13"}, h.Tags.Value)
+		assert.Equal(t, "compute", h.BootImage.Value)
+
+		boot := h.Interfaces[0].Value
+		assert.Equal(t, "de:ad:be:ef:00:34", boot.MAC.Value)
+		assert.Equal(t, "10.0.0.34/24", boot.IP.Value)
+		assert.Equal(t, "cpn-d13-34.example.com,cpn-d13-34.ib.example.com", boot.Fqdn.Value)
+		assert.False(t, boot.ID.Set)
+		assert.Equal(t, "", boot.Switch.Value)
+
+		bmc := h.Interfaces[1].Value
+		assert.Equal(t, "de:ad:be:ef:01:34", bmc.MAC.Value)
+		assert.Equal(t, "10.0.1.34/24", bmc.IP.Value)
+		assert.Equal(t, "bmc-cpn-d13-34.example.com", bmc.Fqdn.Value)
+	}
+
+	// the source is not modified
+	assert.Equal(t, "de:ad:be:ef:00:32", source.Interfaces[0].Value.MAC.Value)
+	assert.Equal(t, int64(1), source.ID.Value)
+
+	// auto addresses are allocated once the copies are built
+	hosts, err = newCloneNodes(source, cloneOptions{Name: "cpn-d13-33", Count: 2, IP: "auto:10.0.0.0/24"}, existing)
+	if assert.NoError(t, err) && assert.Len(t, hosts, 2) {
+		assert.Equal(t, "auto:10.0.0.0/24", hosts[1].Interfaces[0].Value.IP.Value)
+		assert.Equal(t, "", hosts[1].Interfaces[1].Value.IP.Value)
+		assert.Len(t, hostIPs(hosts), 4)
+	}
+
+	type badOpts struct {
+		name string
+		opts cloneOptions
+	}
+	for _, tc := range []badOpts{
+		{"duplicate name", cloneOptions{Name: "cpn-d13-32", Count: 1}},
+		{"duplicate mac", cloneOptions{Name: "cpn-d13-33", Count: 1, MACs: []string{"de:ad:be:ef:01:32"}}},
+		{"duplicate ip", cloneOptions{Name: "cpn-d13-33", Count: 1, IP: "10.0.0.32"}},
+		{"mac count", cloneOptions{Name: "cpn-d13-33", Count: 2, MACs: []string{"de:ad:be:ef:00:33"}}},
+		{"no number", cloneOptions{Name: "cpn-new", Count: 2}},
+		{"bad offset", cloneOptions{Name: "cpn-d13-33", Count: 1, IP: "+x"}},
+		{"bad auto", cloneOptions{Name: "cpn-d13-33", Count: 1, IP: "auto:10.0.0.1"}},
+	} {
+		_, err := newCloneNodes(source, tc.opts, existing)
+		assert.Error(t, err, tc.name)
+	}
+}
+
+func TestCloneNames(t *testing.T) {
+	names, err := cloneNames("cpn-d13-08", 3)
+	if assert.NoError(t, err) {
+		assert.Equal(t, []string{"cpn-d13-08", "cpn-d13-09", "cpn-d13-10"}, names)
+	}
+}
+
+func TestAddrAdd(t *testing.T) {
+	addr, err := addrAdd(netip.MustParseAddr("10.0.0.255"), 2)
+	if assert.NoError(t, err) {
+		assert.Equal(t, "10.0.1.1", addr.String())
+	}
+
+	_, err = addrAdd(netip.MustParseAddr("255.255.255.255"), 1)
+	assert.Error(t, err)
+}
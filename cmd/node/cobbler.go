@@ -0,0 +1,342 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package node
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/ubccr/grendel/pkg/model"
+)
+
+// cobblerInherit is the value of a setting taken from the profile
+const cobblerInherit = "<<inherit>>"
+
+// cobblerInterface is an interface of a Cobbler system
+type cobblerInterface struct {
+	MACAddress      string `json:"mac_address"`
+	IPAddress       string `json:"ip_address"`
+	Netmask         string `json:"netmask"`
+	DNSName         string `json:"dns_name"`
+	InterfaceType   string `json:"interface_type"`
+	InterfaceMaster string `json:"interface_master"`
+	BondingOpts     string `json:"bonding_opts"`
+	MTU             any    `json:"mtu"`
+}
+
+// cobblerItem is a system or profile of the Cobbler collections. Kernel
+// options and metadata are a dict, a "key=value flag" string or <<inherit>>
+type cobblerItem struct {
+	Name            string                      `json:"name"`
+	Hostname        string                      `json:"hostname"`
+	Profile         string                      `json:"profile"`
+	Parent          string                      `json:"parent"`
+	NetbootEnabled  any                         `json:"netboot_enabled"`
+	KernelOptions   any                         `json:"kernel_options"`
+	AutoinstallMeta any                         `json:"autoinstall_meta"`
+	KSMeta          any                         `json:"ks_meta"`
+	PowerAddress    string                      `json:"power_address"`
+	MgmtClasses     any                         `json:"mgmt_classes"`
+	Interfaces      map[string]cobblerInterface `json:"interfaces"`
+}
+
+// cobblerOptions are kernel options or metadata, a key without values is a
+// flag
+type cobblerOptions map[string][]string
+
+// convertCobbler converts the systems of the Cobbler collections in dir, the
+// systems/*.json and profiles/*.json files of /var/lib/cobbler/collections
+// or a directory of system files
+func convertCobbler(dir string, images imageMap, r *migrateReport) (model.HostList, error) {
+	systemDir := filepath.Join(dir, "systems")
+	if _, err := os.Stat(systemDir); errors.Is(err, os.ErrNotExist) {
+		systemDir = dir
+	}
+
+	systems, err := readCobblerItems(systemDir)
+	if err != nil {
+		return nil, err
+	}
+	if len(systems) == 0 {
+		return nil, fmt.Errorf("no Cobbler systems found in %s", systemDir)
+	}
+
+	profiles := make(map[string]cobblerItem)
+	profileDir := filepath.Join(dir, "profiles")
+	if _, err := os.Stat(profileDir); err == nil {
+		items, err := readCobblerItems(profileDir)
+		if err != nil {
+			return nil, err
+		}
+		for _, p := range items {
+			profiles[p.Name] = p
+		}
+	}
+
+	hosts := make(model.HostList, 0, len(systems))
+	for _, sys := range systems {
+		hosts = append(hosts, cobblerHost(sys, profiles, images, r))
+	}
+
+	return hosts, nil
+}
+
+func readCobblerItems(dir string) ([]cobblerItem, error) {
+	files, err := filepath.Glob(filepath.Join(dir, "*.json"))
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]cobblerItem, 0, len(files))
+	for _, name := range files {
+		data, err := os.ReadFile(name)
+		if err != nil {
+			return nil, err
+		}
+
+		var item cobblerItem
+		if err := json.Unmarshal(data, &item); err != nil {
+			return nil, fmt.Errorf("invalid Cobbler item %s: %w", name, err)
+		}
+		if item.Name == "" {
+			item.Name = strings.TrimSuffix(filepath.Base(name), ".json")
+		}
+		items = append(items, item)
+	}
+
+	return items, nil
+}
+
+func cobblerHost(sys cobblerItem, profiles map[string]cobblerItem, images imageMap, r *migrateReport) *model.Host {
+	host := &model.Host{Name: sys.Name, Provision: cobblerBool(sys.NetbootEnabled)}
+
+	// Settings are blended from the profile and its parents, the system
+	// overriding them
+	chain := []cobblerItem{sys}
+	for name, seen := sys.Profile, map[string]bool{}; name != "" && !seen[name]; {
+		seen[name] = true
+		p, ok := profiles[name]
+		if !ok {
+			r.add(host.Name, "profile %q not found, kernel options and metadata inherited from it are not imported", name)
+			break
+		}
+		chain = append(chain, p)
+		name = p.Parent
+	}
+
+	kernelOpts, meta := cobblerOptions{}, cobblerOptions{}
+	for i := len(chain) - 1; i >= 0; i-- {
+		kernelOpts.merge(parseCobblerOptions(chain[i].KernelOptions))
+		meta.merge(parseCobblerOptions(chain[i].KSMeta))
+		meta.merge(parseCobblerOptions(chain[i].AutoinstallMeta))
+	}
+
+	if args := kernelOpts.args(); args != "" {
+		addTag(host, kernelArgsTag+"="+args)
+	}
+	addTag(host, varTags(meta.vars())...)
+	if classes, ok := sys.MgmtClasses.([]any); ok {
+		for _, c := range classes {
+			if s, ok := c.(string); ok {
+				addTag(host, s)
+			}
+		}
+	}
+
+	images.bootImage(host, r, "profile", sys.Profile)
+
+	names := make([]string, 0, len(sys.Interfaces))
+	for name := range sys.Interfaces {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	bonds := make(map[string]*model.Bond)
+	for _, name := range names {
+		i := sys.Interfaces[name]
+		switch i.InterfaceType {
+		case "bond", "bridge", "bonded_bridge":
+			nic := migrateInterface(host.Name, r, name, i.MACAddress, i.IPAddress, i.Netmask, i.DNSName, cobblerString(i.MTU))
+			if nic == nil {
+				nic = &model.NetInterface{Name: name}
+			}
+			bond := &model.Bond{NetInterface: *nic, Type: strings.TrimPrefix(i.InterfaceType, "bonded_"), Peers: []string{}}
+			bond.Mode, bond.Options = cobblerBondingOpts(i.BondingOpts)
+			bonds[name] = bond
+			host.Bonds = append(host.Bonds, bond)
+			continue
+		}
+
+		nic := migrateInterface(host.Name, r, name, i.MACAddress, i.IPAddress, i.Netmask, i.DNSName, cobblerString(i.MTU))
+		if nic == nil {
+			continue
+		}
+		nic.BMC = i.InterfaceType == "bmc"
+		host.Interfaces = append(host.Interfaces, nic)
+	}
+
+	for _, name := range names {
+		i := sys.Interfaces[name]
+		if i.InterfaceMaster == "" {
+			continue
+		}
+		if bond, ok := bonds[i.InterfaceMaster]; ok {
+			bond.Peers = append(bond.Peers, name)
+		} else {
+			r.add(host.Name, "interface %s: master %s not found", name, i.InterfaceMaster)
+		}
+	}
+
+	setFQDN(host, sys.Hostname)
+
+	hasBMC := false
+	for _, nic := range host.Interfaces {
+		hasBMC = hasBMC || nic.BMC
+	}
+	if !hasBMC {
+		if bmc := migrateBMC(host.Name, r, sys.PowerAddress, ""); bmc != nil {
+			host.Interfaces = append(host.Interfaces, bmc)
+		}
+	}
+
+	return host
+}
+
+// parseCobblerOptions returns the options of a dict or a "key=value flag"
+// string
+func parseCobblerOptions(v any) cobblerOptions {
+	opts := cobblerOptions{}
+	switch v := v.(type) {
+	case string:
+		if v == cobblerInherit {
+			return opts
+		}
+		for _, f := range strings.Fields(v) {
+			key, value, ok := strings.Cut(f, "=")
+			if ok {
+				opts[key] = append(opts[key], value)
+			} else if _, exists := opts[key]; !exists {
+				opts[key] = nil
+			}
+		}
+	case map[string]any:
+		for key, value := range v {
+			switch value := value.(type) {
+			case []any:
+				for _, item := range value {
+					opts[key] = append(opts[key], cobblerString(item))
+				}
+			case nil:
+				opts[key] = nil
+			default:
+				// ~ is a flag without a value
+				if s := cobblerString(value); s == "~" || s == "" {
+					opts[key] = nil
+				} else {
+					opts[key] = []string{s}
+				}
+			}
+		}
+	}
+
+	return opts
+}
+
+// merge overrides the options of o with those of other. A key starting with
+// ! removes the key
+func (o cobblerOptions) merge(other cobblerOptions) {
+	for key, values := range other {
+		if strings.HasPrefix(key, "!") {
+			delete(o, key[1:])
+			continue
+		}
+		o[key] = values
+	}
+}
+
+// args returns the options as a kernel command line, sorted by key
+func (o cobblerOptions) args() string {
+	keys := make([]string, 0, len(o))
+	for key := range o {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	args := make([]string, 0, len(keys))
+	for _, key := range keys {
+		if len(o[key]) == 0 {
+			args = append(args, key)
+		}
+		for _, value := range o[key] {
+			args = append(args, key+"="+value)
+		}
+	}
+
+	return strings.Join(args, " ")
+}
+
+// vars returns the options as variables, repeated values joined with a comma
+func (o cobblerOptions) vars() map[string]string {
+	vars := make(map[string]string, len(o))
+	for key, values := range o {
+		vars[key] = strings.Join(values, ",")
+	}
+
+	return vars
+}
+
+// cobblerBondingOpts splits bonding options into the mode and the other
+// options
+func cobblerBondingOpts(s string) (string, map[string]string) {
+	mode := ""
+	opts := make(map[string]string)
+	for _, f := range strings.Fields(s) {
+		key, value, _ := strings.Cut(f, "=")
+		if key == "mode" {
+			mode = value
+		} else {
+			opts[key] = value
+		}
+	}
+	if len(opts) == 0 {
+		opts = nil
+	}
+
+	return mode, opts
+}
+
+func cobblerString(v any) string {
+	switch v := v.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case float64:
+		return fmt.Sprintf("%g", v)
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+func cobblerBool(v any) bool {
+	switch v := v.(type) {
+	case bool:
+		return v
+	case string:
+		switch strings.ToLower(v) {
+		case "true", "yes", "y", "1", "on":
+			return true
+		}
+	case float64:
+		return v != 0
+	}
+
+	return false
+}
@@ -0,0 +1,134 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package node
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/netip"
+	"slices"
+	"strings"
+
+	"github.com/ubccr/grendel/pkg/client"
+)
+
+const (
+	// defaultConmanScript is the expect script shipped with conman for
+	// ipmitool consoles
+	defaultConmanScript = "/usr/share/conman/exec/ipmitool.exp"
+
+	// defaultConsoleExcludeTag marks hosts left out of the console config
+	defaultConsoleExcludeTag = "noconsole"
+)
+
+// consoleOptions are the settings of the conman export format
+type consoleOptions struct {
+	// Dialect is conman for conman.conf console lines or freeipmi for
+	// ipmiconsole command lines
+	Dialect string
+
+	// Credential selects how the BMC credentials are referenced: literal
+	// writes User and Password, env writes ${UserEnv} and ${PasswordEnv}
+	// and script leaves them to Script
+	Credential string
+
+	User        string
+	Password    string
+	UserEnv     string
+	PasswordEnv string
+	Script      string
+
+	// ExcludeTag leaves out the hosts having it
+	ExcludeTag string
+}
+
+// writeConsole writes a console entry for every host with a BMC interface
+func writeConsole(w io.Writer, hosts []client.Host, opts consoleOptions) error {
+	if opts.Dialect != "conman" && opts.Dialect != "freeipmi" {
+		return fmt.Errorf("invalid dialect %q. Valid dialects: conman, freeipmi", opts.Dialect)
+	}
+
+	var user, password string
+	switch opts.Credential {
+	case "literal":
+		if opts.User == "" || opts.Password == "" {
+			return errors.New("no BMC credentials, set --console-user and --console-password or bmc.user and bmc.password")
+		}
+		user, password = opts.User, opts.Password
+	case "env":
+		if opts.UserEnv == "" || opts.PasswordEnv == "" {
+			return errors.New("--user-env and --password-env are required with --credential env")
+		}
+		user, password = "${"+opts.UserEnv+"}", "${"+opts.PasswordEnv+"}"
+	case "script":
+		if opts.Dialect != "conman" {
+			return errors.New("--credential script requires --dialect conman")
+		}
+	default:
+		return fmt.Errorf("invalid credential %q. Valid credentials: literal, env, script", opts.Credential)
+	}
+
+	for _, host := range hosts {
+		if opts.ExcludeTag != "" && slices.Contains(host.Tags.Value, opts.ExcludeTag) {
+			continue
+		}
+
+		address := bmcAddress(host)
+		if address == "" {
+			continue
+		}
+		name := host.Name.Value
+
+		switch {
+		case opts.Dialect == "freeipmi":
+			fmt.Fprintf(w, "# %s\nipmiconsole -h %s -u %s -p %s\n", name, address, shellQuote(user, opts.Credential), shellQuote(password, opts.Credential))
+		case opts.Credential == "script":
+			fmt.Fprintf(w, "console name=%s dev=%s\n", conmanQuote(name), conmanQuote(opts.Script+" "+address))
+		default:
+			fmt.Fprintf(w, "console name=%s dev=%s ipmiopts=%s\n", conmanQuote(name), conmanQuote("ipmi:"+address),
+				conmanQuote("U:"+user+",P:"+password))
+		}
+	}
+
+	return nil
+}
+
+// bmcAddress returns the IP address of the BMC interface of host, or its
+// FQDN when it has no address
+func bmcAddress(host client.Host) string {
+	for _, n := range host.Interfaces {
+		if n.Null || !n.Value.Bmc.Value {
+			continue
+		}
+
+		if prefix, err := netip.ParsePrefix(n.Value.IP.Value); err == nil {
+			return prefix.Addr().String()
+		}
+		if addr, err := netip.ParseAddr(n.Value.IP.Value); err == nil {
+			return addr.String()
+		}
+		if fqdn, _, _ := strings.Cut(n.Value.Fqdn.Value, ","); fqdn != "" {
+			return fqdn
+		}
+	}
+
+	return ""
+}
+
+// conmanQuote returns s as a double quoted conman.conf string
+func conmanQuote(s string) string {
+	return `"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(s) + `"`
+}
+
+// shellQuote returns s quoted for sh. Environment variable references are
+// double quoted so the shell expands them
+func shellQuote(s, credential string) string {
+	if credential == "env" {
+		return `"` + s + `"`
+	}
+
+	return "'" + strings.ReplaceAll(s, "'", `'\''`) + "'"
+}
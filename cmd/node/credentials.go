@@ -0,0 +1,196 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package node
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/ubccr/grendel/cmd"
+	"github.com/ubccr/grendel/pkg/client"
+	"golang.org/x/term"
+)
+
+var (
+	credentialsKind          string
+	credentialsUsername      string
+	credentialsPasswordStdin bool
+	credentialsCmd           = &cobra.Command{
+		Use:   "credentials",
+		Short: "Manage node credentials",
+		Long: `Manage the credentials of nodes, such as the username and password of
+their BMC. Credentials are encrypted by the server with a key derived from
+credentials_key, or api.secret if it is not set, and are never returned by
+the API. Nodes without BMC credentials use bmc.user and bmc.password.`,
+	}
+	credentialsSetCmd = &cobra.Command{
+		Use:   "set {nodeset | all} --username <username>",
+		Short: "Set node credentials",
+		Long: `Set the credentials of nodes. The password is read from the terminal or
+with --password-stdin from the first line of stdin.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(command *cobra.Command, args []string) error {
+			if credentialsPasswordStdin && args[0] == "-" {
+				return errors.New("--password-stdin and a nodeset of - both read stdin")
+			}
+
+			password, err := readPassword(os.Stdin, credentialsPasswordStdin)
+			if err != nil {
+				return err
+			}
+
+			gc, err := cmd.NewOgenClient()
+			if err != nil {
+				return err
+			}
+
+			req := &client.NodeCredentialsRequest{
+				Kind:     client.NewOptString(credentialsKind),
+				Username: credentialsUsername,
+				Password: password,
+			}
+
+			nodeset, err := cmd.NodesetArg(args[0])
+			if err != nil {
+				return err
+			}
+
+			params := client.PUTV1NodesCredentialsParams{
+				Nodeset: client.NewOptString(nodeset),
+				Tags:    client.NewOptString(strings.Join(tags, ",")),
+			}
+			res, err := gc.PUTV1NodesCredentials(context.Background(), req, params)
+			if err != nil {
+				return cmd.NewApiError(err)
+			}
+
+			return cmd.NewApiResponse(res)
+		},
+	}
+	credentialsListCmd = &cobra.Command{
+		Use:   "list {nodeset | all}",
+		Short: "List nodes with credentials",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(command *cobra.Command, args []string) error {
+			gc, err := cmd.NewOgenClient()
+			if err != nil {
+				return err
+			}
+
+			nodeset, err := cmd.NodesetArg(args[0])
+			if err != nil {
+				return err
+			}
+
+			params := client.GETV1NodesCredentialsParams{
+				Nodeset: client.NewOptString(nodeset),
+				Tags:    client.NewOptString(strings.Join(tags, ",")),
+			}
+			res, err := gc.GETV1NodesCredentials(context.Background(), params)
+			if err != nil {
+				return cmd.NewApiError(err)
+			}
+
+			if cmd.JSONOutput() {
+				return cmd.Output(res)
+			}
+
+			for _, c := range res {
+				fmt.Printf("%-40s%s\n", c.Name, c.Kind)
+			}
+
+			return nil
+		},
+	}
+	credentialsDeleteCmd = &cobra.Command{
+		Use:   "delete {nodeset | all}",
+		Short: "Delete node credentials",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(command *cobra.Command, args []string) error {
+			gc, err := cmd.NewOgenClient()
+			if err != nil {
+				return err
+			}
+
+			nodeset, err := cmd.NodesetArg(args[0])
+			if err != nil {
+				return err
+			}
+
+			params := client.DELETEV1NodesCredentialsParams{
+				Nodeset: client.NewOptString(nodeset),
+				Tags:    client.NewOptString(strings.Join(tags, ",")),
+				Kind:    client.NewOptString(credentialsKind),
+			}
+			res, err := gc.DELETEV1NodesCredentials(context.Background(), params)
+			if err != nil {
+				return cmd.NewApiError(err)
+			}
+
+			return cmd.NewApiResponse(res)
+		},
+	}
+)
+
+func init() {
+	credentialsCmd.PersistentFlags().StringVar(&credentialsKind, "kind", "bmc", "kind of credentials")
+	credentialsSetCmd.Flags().StringVar(&credentialsUsername, "username", "", "username")
+	credentialsSetCmd.Flags().BoolVar(&credentialsPasswordStdin, "password-stdin", false, "read the password from stdin")
+	credentialsSetCmd.MarkFlagRequired("username")
+
+	credentialsCmd.AddCommand(credentialsSetCmd)
+	credentialsCmd.AddCommand(credentialsListCmd)
+	credentialsCmd.AddCommand(credentialsDeleteCmd)
+	nodeCmd.AddCommand(credentialsCmd)
+}
+
+// readPassword reads a password from the first line of r if fromStdin is
+// true. Otherwise it prompts for the password twice on the terminal
+func readPassword(r io.Reader, fromStdin bool) (string, error) {
+	if fromStdin {
+		line, err := bufio.NewReader(r).ReadString('\n')
+		if err != nil && !errors.Is(err, io.EOF) {
+			return "", err
+		}
+		password := strings.TrimRight(line, "\r\n")
+		if password == "" {
+			return "", errors.New("empty password on stdin")
+		}
+		return password, nil
+	}
+
+	fd := int(os.Stdin.Fd())
+	if !term.IsTerminal(fd) {
+		return "", errors.New("stdin is not a terminal, use --password-stdin")
+	}
+
+	fmt.Fprint(os.Stderr, "Password: ")
+	password, err := term.ReadPassword(fd)
+	fmt.Fprintln(os.Stderr)
+	if err != nil {
+		return "", err
+	}
+	fmt.Fprint(os.Stderr, "Confirm Password: ")
+	confirm, err := term.ReadPassword(fd)
+	fmt.Fprintln(os.Stderr)
+	if err != nil {
+		return "", err
+	}
+
+	if string(password) != string(confirm) {
+		return "", errors.New("passwords do not match")
+	}
+	if len(password) == 0 {
+		return "", errors.New("empty password")
+	}
+
+	return string(password), nil
+}
@@ -7,6 +7,7 @@ package node
 
 import (
 	"context"
+	"fmt"
 	"strings"
 
 	"github.com/spf13/cobra"
@@ -15,17 +16,26 @@ import (
 )
 
 var (
-	deleteCmd = &cobra.Command{
+	deleteRevokeCerts bool
+	deleteCmd         = &cobra.Command{
 		Use:   "delete <nodeset>",
 		Short: "Delete nodes",
-		Long:  `Delete nodes`,
-		Args:  cobra.ExactArgs(1),
+		Long: `Delete nodes. Deleted nodes are moved to the trash and can be restored
+with "grendel node restore" until they are purged. --revoke-certs revokes the
+client certificates of the nodes first.`,
+		Args: cobra.ExactArgs(1),
 		RunE: func(command *cobra.Command, args []string) error {
 			gc, err := cmd.NewOgenClient()
 			if err != nil {
 				return err
 			}
 
+			if deleteRevokeCerts {
+				if err := revokeClientCerts(gc, args[0]); err != nil {
+					return err
+				}
+			}
+
 			params := client.DELETEV1NodesParams{
 				Nodeset: client.NewOptString(args[0]),
 				Tags:    client.NewOptString(strings.Join(tags, ",")),
@@ -41,5 +51,36 @@ var (
 )
 
 func init() {
+	deleteCmd.Flags().BoolVar(&deleteRevokeCerts, "revoke-certs", false, "revoke the client certificates of the nodes")
 	nodeCmd.AddCommand(deleteCmd)
 }
+
+// revokeClientCerts revokes the client certificates of the nodes in nodeset
+// matching the tags filter
+func revokeClientCerts(gc *client.Client, nodeset string) error {
+	hosts, err := gc.HostList(context.Background(), client.HostFilter{Nodeset: nodeset, Tags: tags})
+	if err != nil {
+		return err
+	}
+
+	names := make([]string, 0, len(hosts))
+	for _, h := range hosts {
+		if h.ClientCertSerial.Value != "" {
+			names = append(names, h.Name.Value)
+		}
+	}
+	if len(names) == 0 {
+		return nil
+	}
+
+	req := &client.CertRevokeRequest{Nodeset: client.NewOptString(strings.Join(names, ","))}
+	res, err := gc.POSTV1CertsRevoke(context.Background(), req, client.POSTV1CertsRevokeParams{})
+	if err != nil {
+		return cmd.NewApiError(err)
+	}
+	if !cmd.JSONOutput() {
+		fmt.Println(res.Detail.Value)
+	}
+
+	return nil
+}
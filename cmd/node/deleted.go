@@ -0,0 +1,62 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package node
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/ubccr/grendel/cmd"
+	"github.com/ubccr/grendel/pkg/client"
+)
+
+var (
+	deletedSince string
+	deletedCmd   = &cobra.Command{
+		Use:   "deleted",
+		Short: "List the names of deleted nodes",
+		Long: `List the names of deleted nodes, including the old names of renamed nodes.
+Names are kept for tombstone_retention, 30 days by default. Use with
+"node show all --since" to sync changes.`,
+		Args: cobra.NoArgs,
+		RunE: func(command *cobra.Command, args []string) error {
+			since, err := cmd.ParseSince(deletedSince)
+			if err != nil {
+				return err
+			}
+
+			gc, err := cmd.NewOgenClient()
+			if err != nil {
+				return err
+			}
+
+			params := client.GETV1NodesDeletedParams{}
+			if !since.IsZero() {
+				params.Since = client.NewOptString(since.Format(time.RFC3339))
+			}
+			res, err := gc.GETV1NodesDeleted(context.Background(), params)
+			if err != nil {
+				return cmd.NewApiError(err)
+			}
+
+			if cmd.JSONOutput() {
+				return cmd.Output(res)
+			}
+
+			for _, t := range res {
+				fmt.Printf("%-40s%s\n", t.Name.Value, t.DeletedAt.Value.Local().Format(time.DateTime))
+			}
+
+			return nil
+		},
+	}
+)
+
+func init() {
+	deletedCmd.Flags().StringVar(&deletedSince, "since", "", "Only show nodes deleted since an RFC3339 time or a duration ago, ex: 24h")
+	nodeCmd.AddCommand(deletedCmd)
+}
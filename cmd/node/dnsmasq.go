@@ -0,0 +1,520 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package node
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/netip"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/miekg/dns"
+	"github.com/ubccr/grendel/cmd"
+	"github.com/ubccr/grendel/internal/firmware"
+	"github.com/ubccr/grendel/pkg/client"
+	"github.com/ubccr/grendel/pkg/model"
+)
+
+const (
+	// dnsmasq include files written by the dnsmasq export format
+	dnsmasqDHCPFile = "grendel-dhcp-hosts.conf"
+	dnsmasqDNSFile  = "grendel-dns.conf"
+	dnsmasqBootFile = "grendel-boot.conf"
+
+	// dnsmasqHostMarker starts the dhcp-host lines of a host, node import
+	// groups the lines following it into that host
+	dnsmasqHostMarker = "# host "
+
+	// dnsmasq tags set on dhcp-host lines
+	dnsmasqTagProvision = "grendel-provision"
+	dnsmasqTagBMC       = "grendel-bmc"
+	dnsmasqTagFirmware  = "grendel-fw"
+)
+
+// dnsmasqOptions are the settings of the dnsmasq export format
+type dnsmasqOptions struct {
+	// Out is the directory the include files are written to, stdout when
+	// empty
+	Out string
+
+	// TFTPServer is the address of the TFTP server of the dhcp-boot
+	// options, dnsmasq itself when empty
+	TFTPServer string
+
+	LeaseTime time.Duration
+}
+
+// dnsmasqFirmwareTag returns the tag of the hosts overriding their firmware
+// with fw, such as grendel-fw-snponly-x86_64
+func dnsmasqFirmwareTag(fw firmware.Build) string {
+	return dnsmasqTagFirmware + "-" + strings.TrimSuffix(fw.String(), filepath.Ext(fw.String()))
+}
+
+// dnsmasqLease returns d as a dnsmasq lease time
+func dnsmasqLease(d time.Duration) string {
+	switch {
+	case d <= 0:
+		return "infinite"
+	case d%time.Hour == 0:
+		return fmt.Sprintf("%dh", d/time.Hour)
+	case d%time.Minute == 0:
+		return fmt.Sprintf("%dm", d/time.Minute)
+	default:
+		return strconv.Itoa(int(d.Seconds()))
+	}
+}
+
+// modelHosts converts the API hosts to model hosts, sorted by name
+func modelHosts(hosts []client.Host) (model.HostList, error) {
+	data, err := json.Marshal(sortedHosts(hosts))
+	if err != nil {
+		return nil, err
+	}
+
+	var list model.HostList
+	if err := json.Unmarshal(data, &list); err != nil {
+		return nil, err
+	}
+
+	return list, nil
+}
+
+// dnsmasqInterfaces returns the interfaces and bonds of host
+func dnsmasqInterfaces(host *model.Host) []*model.NetInterface {
+	nics := make([]*model.NetInterface, 0, len(host.Interfaces)+len(host.Bonds))
+	nics = append(nics, host.Interfaces...)
+	for _, b := range host.Bonds {
+		nics = append(nics, &b.NetInterface)
+	}
+
+	return nics
+}
+
+// writeDnsmasq writes the dnsmasq include files of hosts to opts.Out. Hosts
+// are sorted by name, the output only changes with the hosts
+func writeDnsmasq(w io.Writer, hosts []client.Host, opts dnsmasqOptions) error {
+	list, err := modelHosts(hosts)
+	if err != nil {
+		return err
+	}
+
+	files := []struct {
+		name  string
+		write func(io.Writer, model.HostList, dnsmasqOptions)
+	}{
+		{dnsmasqDHCPFile, writeDnsmasqDHCP},
+		{dnsmasqDNSFile, writeDnsmasqDNS},
+		{dnsmasqBootFile, writeDnsmasqBoot},
+	}
+
+	if opts.Out == "" {
+		for i, f := range files {
+			if i > 0 {
+				fmt.Fprintln(w)
+			}
+			f.write(w, list, opts)
+		}
+		return nil
+	}
+
+	if err := os.MkdirAll(opts.Out, 0755); err != nil {
+		return err
+	}
+	for _, f := range files {
+		var buf bytes.Buffer
+		f.write(&buf, list, opts)
+		if err := os.WriteFile(filepath.Join(opts.Out, f.name), buf.Bytes(), 0644); err != nil {
+			return err
+		}
+	}
+
+	cmd.Log.Infof("Wrote %d hosts to %s", len(list), opts.Out)
+	return nil
+}
+
+// writeDnsmasqDHCP writes a dhcp-host line for each interface with a MAC and
+// an IPv4 address, and a static dhcp-range for each of their subnets
+func writeDnsmasqDHCP(w io.Writer, hosts model.HostList, opts dnsmasqOptions) {
+	lease := dnsmasqLease(opts.LeaseTime)
+	fmt.Fprintf(w, "# %s generated by grendel node export --format dnsmasq\n", dnsmasqDHCPFile)
+
+	subnets := make(map[netip.Prefix]bool)
+	for _, host := range hosts {
+		lines := make([]string, 0)
+		for _, nic := range dnsmasqInterfaces(host) {
+			if nic.MAC == nil || !nic.IP.IsValid() || !nic.IP.Addr().Is4() {
+				continue
+			}
+			subnets[nic.IP.Masked()] = true
+
+			fields := []string{nic.MAC.String()}
+			if nic.BMC {
+				fields = append(fields, "set:"+dnsmasqTagBMC)
+			} else {
+				if host.Provision {
+					fields = append(fields, "set:"+dnsmasqTagProvision)
+				}
+				if !host.Firmware.IsNil() {
+					fields = append(fields, "set:"+dnsmasqTagFirmware, "set:"+dnsmasqFirmwareTag(host.Firmware))
+				}
+			}
+			fields = append(fields, nic.AddrString())
+			if nic.FQDN != "" {
+				fields = append(fields, nic.ShortName())
+			}
+			fields = append(fields, lease)
+
+			lines = append(lines, "dhcp-host="+strings.Join(fields, ","))
+		}
+		if len(lines) == 0 {
+			continue
+		}
+
+		fmt.Fprintf(w, "\n%s%s\n%s\n", dnsmasqHostMarker, host.Name, strings.Join(lines, "\n"))
+	}
+
+	prefixes := make([]netip.Prefix, 0, len(subnets))
+	for p := range subnets {
+		prefixes = append(prefixes, p)
+	}
+	sort.Slice(prefixes, func(i, j int) bool { return prefixes[i].Addr().Less(prefixes[j].Addr()) })
+
+	if len(prefixes) > 0 {
+		fmt.Fprintln(w)
+	}
+	for _, p := range prefixes {
+		mask := net.CIDRMask(p.Bits(), 32)
+		fmt.Fprintf(w, "dhcp-range=%s,static,%s,%s\n", p.Addr(), net.IP(mask), lease)
+	}
+}
+
+// writeDnsmasqDNS writes a host-record line with the names of each address
+// and a ptr-record line for its first name, the one grendel answers PTR
+// queries with
+func writeDnsmasqDNS(w io.Writer, hosts model.HostList, opts dnsmasqOptions) {
+	fmt.Fprintf(w, "# %s generated by grendel node export --format dnsmasq\n", dnsmasqDNSFile)
+
+	for _, host := range hosts {
+		lines := make([]string, 0)
+		for _, nic := range dnsmasqInterfaces(host) {
+			for _, a := range nic.AllAddresses() {
+				names := make([]string, 0)
+				for _, name := range strings.Split(a.FQDN, ",") {
+					if name = strings.TrimSpace(name); name != "" {
+						names = append(names, name)
+					}
+				}
+				if len(names) == 0 {
+					continue
+				}
+
+				addr := a.IP.Addr().String()
+				reverse, err := dns.ReverseAddr(addr)
+				if err != nil {
+					continue
+				}
+				lines = append(lines,
+					fmt.Sprintf("host-record=%s,%s", strings.Join(names, ","), addr),
+					fmt.Sprintf("ptr-record=%s,%s", strings.TrimSuffix(reverse, "."), names[0]))
+			}
+		}
+		if len(lines) == 0 {
+			continue
+		}
+
+		fmt.Fprintf(w, "\n%s%s\n%s\n", dnsmasqHostMarker, host.Name, strings.Join(lines, "\n"))
+	}
+}
+
+// writeDnsmasqBoot writes the dhcp-boot options of the hosts set to
+// provision, mirroring the firmware grendel selects for the client
+// architecture or the firmware of the host. The chainloaded iPXE asks again
+// with the grendel user class, which is left to a grendel ProxyDHCP
+func writeDnsmasqBoot(w io.Writer, hosts model.HostList, opts dnsmasqOptions) {
+	fmt.Fprintf(w, "# %s generated by grendel node export --format dnsmasq\n", dnsmasqBootFile)
+	fmt.Fprintln(w, "# The iPXE loaded by the firmware asks again with user class grendel, which")
+	fmt.Fprintln(w, "# a grendel server with dhcp.proxy_only answers with the boot script")
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "dhcp-match=set:grendel-bios,option:client-arch,0")
+	fmt.Fprintln(w, "dhcp-match=set:grendel-efi-ia32,option:client-arch,6")
+	fmt.Fprintln(w, "dhcp-match=set:grendel-efi-x86_64,option:client-arch,7")
+	fmt.Fprintln(w, "dhcp-match=set:grendel-efi-x86_64,option:client-arch,9")
+	fmt.Fprintln(w, "dhcp-match=set:grendel-efi-arm64,option:client-arch,11")
+	fmt.Fprintln(w, "dhcp-userclass=set:grendel-ipxe,iPXE")
+	fmt.Fprintln(w, "dhcp-userclass=set:grendel-chain,grendel")
+	fmt.Fprintln(w)
+
+	server := ""
+	if opts.TFTPServer != "" {
+		server = ",," + opts.TFTPServer
+	}
+	boot := func(fw firmware.Build, tags ...string) {
+		tags = append([]string{dnsmasqTagProvision, "!grendel-chain"}, tags...)
+		fmt.Fprintf(w, "dhcp-boot=tag:%s,%s%s\n", strings.Join(tags, ",tag:"), fw, server)
+	}
+
+	boot(firmware.UNDI, "grendel-bios", "!grendel-ipxe")
+	boot(firmware.IPXE, "grendel-bios", "grendel-ipxe")
+	boot(firmware.EFI386, "grendel-efi-ia32", "!"+dnsmasqTagFirmware)
+	boot(firmware.SNPONLYx86_64, "grendel-efi-x86_64", "!"+dnsmasqTagFirmware)
+	boot(firmware.SNPONLYarm64, "grendel-efi-arm64", "!"+dnsmasqTagFirmware)
+
+	// The firmware of a host only overrides the firmware of EFI clients
+	used := make(map[firmware.Build]bool)
+	for _, host := range hosts {
+		if !host.Firmware.IsNil() {
+			used[host.Firmware] = true
+		}
+	}
+	builds := make([]firmware.Build, 0, len(used))
+	for fw := range used {
+		builds = append(builds, fw)
+	}
+	sort.Slice(builds, func(i, j int) bool { return builds[i].String() < builds[j].String() })
+	for _, fw := range builds {
+		boot(fw, "!grendel-bios", dnsmasqFirmwareTag(fw))
+	}
+}
+
+// convertDnsmasq converts the dhcp-host lines of the dnsmasq configuration
+// at path, a file or a directory of *.conf files. Lines following a
+// "# host <name>" comment, as written by node export --format dnsmasq, are
+// interfaces of that host, other lines are grouped by hostname. FQDNs come
+// from host-record lines and netmasks from static dhcp-range lines
+func convertDnsmasq(path string, r *migrateReport) (model.HostList, error) {
+	files := []string{path}
+	if info, err := os.Stat(path); err != nil {
+		return nil, err
+	} else if info.IsDir() {
+		files, err = filepath.Glob(filepath.Join(path, "*.conf"))
+		if err != nil {
+			return nil, err
+		}
+		sort.Strings(files)
+	}
+
+	type dhcpHost struct {
+		file, host string
+		line       int
+		fields     []string
+	}
+
+	dhcpHosts := make([]dhcpHost, 0)
+	names := make(map[netip.Addr][]string)
+	ranges := make([]netip.Prefix, 0)
+	for _, name := range files {
+		file, err := os.Open(name)
+		if err != nil {
+			return nil, err
+		}
+
+		marker := ""
+		scanner := bufio.NewScanner(file)
+		for n := 1; scanner.Scan(); n++ {
+			line := strings.TrimSpace(scanner.Text())
+			if line == "" {
+				marker = ""
+				continue
+			}
+			if strings.HasPrefix(line, dnsmasqHostMarker) {
+				marker = strings.TrimSpace(strings.TrimPrefix(line, dnsmasqHostMarker))
+				continue
+			}
+			if strings.HasPrefix(line, "#") {
+				continue
+			}
+
+			key, value, _ := strings.Cut(line, "=")
+			fields := strings.Split(value, ",")
+			for i := range fields {
+				fields[i] = strings.TrimSpace(fields[i])
+			}
+
+			switch strings.TrimSpace(key) {
+			case "dhcp-host":
+				dhcpHosts = append(dhcpHosts, dhcpHost{file: filepath.Base(name), host: marker, line: n, fields: fields})
+			case "host-record":
+				hostnames := make([]string, 0)
+				for _, f := range fields {
+					if addr, err := netip.ParseAddr(strings.Trim(f, "[]")); err == nil {
+						names[addr] = append(names[addr], hostnames...)
+					} else if f != "" && !isNumber(f) {
+						hostnames = append(hostnames, f)
+					}
+				}
+			case "dhcp-range":
+				if prefix, ok := dnsmasqRange(fields); ok {
+					ranges = append(ranges, prefix)
+				}
+			}
+		}
+		err = scanner.Err()
+		file.Close()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	hosts := make(map[string]*model.Host)
+	list := make(model.HostList, 0)
+	for _, dh := range dhcpHosts {
+		where := fmt.Sprintf("%s:%d", dh.file, dh.line)
+		var macs []net.HardwareAddr
+		var ip netip.Addr
+		var hostname string
+		tags := make(map[string]bool)
+		ignore := false
+		for _, f := range dh.fields {
+			switch {
+			case f == "":
+			case f == "ignore":
+				ignore = true
+			case strings.HasPrefix(f, "set:") || strings.HasPrefix(f, "tag:"):
+				tags[f[4:]] = true
+			case strings.HasPrefix(f, "id:"):
+				r.add(where, "client identifier %s not supported, only MAC addresses are imported", f)
+			case strings.HasPrefix(f, "["):
+				r.add(where, "IPv6 address %s not imported, grendel hands out IPv4 addresses", f)
+			case f == "infinite" || dnsmasqLeaseTime(f):
+			default:
+				if mac, err := net.ParseMAC(f); err == nil {
+					macs = append(macs, mac)
+				} else if addr, err := netip.ParseAddr(f); err == nil {
+					ip = addr
+				} else if strings.Contains(f, "*") {
+					r.add(where, "wildcard MAC address %s not supported", f)
+				} else {
+					hostname = f
+				}
+			}
+		}
+		if ignore {
+			continue
+		}
+
+		name := dh.host
+		if name == "" {
+			name, _, _ = strings.Cut(hostname, ".")
+		}
+		if name == "" {
+			r.add(where, "dhcp-host without a hostname, skipped")
+			continue
+		}
+		if len(macs) == 0 {
+			r.add(name, "%s: dhcp-host without a MAC address, skipped", where)
+			continue
+		}
+
+		host, ok := hosts[name]
+		if !ok {
+			host = &model.Host{Name: name}
+			hosts[name] = host
+			list = append(list, host)
+		}
+		if !tags[dnsmasqTagBMC] {
+			host.Provision = host.Provision || tags[dnsmasqTagProvision]
+			for tag := range tags {
+				if !strings.HasPrefix(tag, dnsmasqTagFirmware+"-") {
+					continue
+				}
+				found := false
+				for b := range firmware.BuildToStringMap {
+					if dnsmasqFirmwareTag(b) == tag {
+						host.Firmware, found = b, true
+					}
+				}
+				if !found {
+					r.add(name, "%s: unknown firmware tag %s", where, tag)
+				}
+			}
+		}
+
+		fqdn := ""
+		if ip.IsValid() {
+			fqdn = strings.Join(names[ip], ",")
+		}
+		if fqdn == "" && strings.Contains(hostname, ".") {
+			fqdn = hostname
+		}
+
+		netmask := ""
+		for _, p := range ranges {
+			if ip.IsValid() && p.Contains(ip) {
+				netmask = strconv.Itoa(p.Bits())
+				break
+			}
+		}
+
+		// dnsmasq hands the address to any of the MACs of a line, one
+		// interface each
+		for _, mac := range macs {
+			addr := ""
+			if ip.IsValid() {
+				addr = ip.String()
+			}
+			nic := migrateInterface(name, r, "", mac.String(), addr, netmask, fqdn, "")
+			if nic == nil {
+				continue
+			}
+			nic.BMC = tags[dnsmasqTagBMC]
+			host.Interfaces = append(host.Interfaces, nic)
+		}
+	}
+
+	if len(list) == 0 {
+		return nil, fmt.Errorf("no dhcp-host lines found in %s", path)
+	}
+
+	return list, nil
+}
+
+// dnsmasqRange returns the subnet of a static dhcp-range, such as
+// dhcp-range=10.1.0.0,static,255.255.255.0,24h
+func dnsmasqRange(fields []string) (netip.Prefix, bool) {
+	args := make([]string, 0, len(fields))
+	for _, f := range fields {
+		if !strings.HasPrefix(f, "set:") && !strings.HasPrefix(f, "tag:") {
+			args = append(args, f)
+		}
+	}
+	if len(args) < 3 || args[1] != "static" {
+		return netip.Prefix{}, false
+	}
+
+	prefix, err := migratePrefix(args[0], args[2])
+	if err != nil {
+		return netip.Prefix{}, false
+	}
+
+	return prefix.Masked(), true
+}
+
+// dnsmasqLeaseTime returns true if s is a dnsmasq lease time, such as 24h or
+// 3600
+func dnsmasqLeaseTime(s string) bool {
+	if s == "" {
+		return false
+	}
+	switch s[len(s)-1] {
+	case 's', 'm', 'h', 'd', 'w':
+		s = s[:len(s)-1]
+	}
+
+	return isNumber(s)
+}
+
+func isNumber(s string) bool {
+	_, err := strconv.Atoi(s)
+	return err == nil
+}
@@ -0,0 +1,153 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package node
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/ubccr/grendel/internal/firmware"
+	"github.com/ubccr/grendel/pkg/client"
+)
+
+func testDnsmasqHosts() []client.Host {
+	hosts := testExportHosts()
+	hosts[0].Interfaces[0].Value.Fqdn = client.NewOptString("cpn-01.example.com,compute-01.example.com")
+	hosts[0].Provision = client.NewOptBool(true)
+	hosts[0].Firmware = client.NewOptString("ipxe-x86_64.efi")
+
+	return append([]client.Host{{
+		Name: client.NewOptString("cpn-02"),
+		Interfaces: []client.NilHostInterfacesItem{
+			client.NewNilHostInterfacesItem(client.HostInterfacesItem{
+				MAC:  client.NewOptString("de:ad:be:ef:00:02"),
+				IP:   client.NewOptString("10.0.0.2/24"),
+				Fqdn: client.NewOptString("cpn-02.example.com"),
+			}),
+		},
+	}}, hosts...)
+}
+
+func TestExportDnsmasq(t *testing.T) {
+	var buf bytes.Buffer
+	err := writeDnsmasq(&buf, testDnsmasqHosts(), dnsmasqOptions{LeaseTime: 24 * time.Hour, TFTPServer: "10.0.0.254"})
+	require.NoError(t, err)
+
+	assert.Equal(t, `# grendel-dhcp-hosts.conf generated by grendel node export --format dnsmasq
+
+# host cpn-01
+dhcp-host=de:ad:be:ef:00:01,set:grendel-provision,set:grendel-fw,set:grendel-fw-ipxe-x86_64,10.0.0.1,cpn-01,24h
+dhcp-host=de:ad:be:ef:01:01,set:grendel-bmc,10.0.1.1,bmc-cpn-01,24h
+
+# host cpn-02
+dhcp-host=de:ad:be:ef:00:02,10.0.0.2,cpn-02,24h
+
+dhcp-range=10.0.0.0,static,255.255.255.0,24h
+dhcp-range=10.0.1.0,static,255.255.255.0,24h
+
+# grendel-dns.conf generated by grendel node export --format dnsmasq
+
+# host cpn-01
+host-record=cpn-01.example.com,compute-01.example.com,10.0.0.1
+ptr-record=1.0.0.10.in-addr.arpa,cpn-01.example.com
+host-record=bmc-cpn-01.example.com,10.0.1.1
+ptr-record=1.1.0.10.in-addr.arpa,bmc-cpn-01.example.com
+
+# host cpn-02
+host-record=cpn-02.example.com,10.0.0.2
+ptr-record=2.0.0.10.in-addr.arpa,cpn-02.example.com
+
+# grendel-boot.conf generated by grendel node export --format dnsmasq
+# The iPXE loaded by the firmware asks again with user class grendel, which
+# a grendel server with dhcp.proxy_only answers with the boot script
+
+dhcp-match=set:grendel-bios,option:client-arch,0
+dhcp-match=set:grendel-efi-ia32,option:client-arch,6
+dhcp-match=set:grendel-efi-x86_64,option:client-arch,7
+dhcp-match=set:grendel-efi-x86_64,option:client-arch,9
+dhcp-match=set:grendel-efi-arm64,option:client-arch,11
+dhcp-userclass=set:grendel-ipxe,iPXE
+dhcp-userclass=set:grendel-chain,grendel
+
+dhcp-boot=tag:grendel-provision,tag:!grendel-chain,tag:grendel-bios,tag:!grendel-ipxe,undionly.kpxe,,10.0.0.254
+dhcp-boot=tag:grendel-provision,tag:!grendel-chain,tag:grendel-bios,tag:grendel-ipxe,ipxe.pxe,,10.0.0.254
+dhcp-boot=tag:grendel-provision,tag:!grendel-chain,tag:grendel-efi-ia32,tag:!grendel-fw,ipxe-i386.efi,,10.0.0.254
+dhcp-boot=tag:grendel-provision,tag:!grendel-chain,tag:grendel-efi-x86_64,tag:!grendel-fw,snponly-x86_64.efi,,10.0.0.254
+dhcp-boot=tag:grendel-provision,tag:!grendel-chain,tag:grendel-efi-arm64,tag:!grendel-fw,snponly-arm64.efi,,10.0.0.254
+dhcp-boot=tag:grendel-provision,tag:!grendel-chain,tag:!grendel-bios,tag:grendel-fw-ipxe-x86_64,ipxe-x86_64.efi,,10.0.0.254
+`, buf.String())
+}
+
+func TestDnsmasqRoundTrip(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "dnsmasq.d")
+	err := writeDnsmasq(nil, testDnsmasqHosts(), dnsmasqOptions{Out: dir, LeaseTime: 90 * time.Minute})
+	require.NoError(t, err)
+
+	first, err := os.ReadFile(filepath.Join(dir, dnsmasqDHCPFile))
+	require.NoError(t, err)
+	assert.Contains(t, string(first), "dhcp-host=de:ad:be:ef:00:02,10.0.0.2,cpn-02,90m\n")
+
+	hosts, r, err := convertInventory("dnsmasq", dir, imageMap{})
+	require.NoError(t, err)
+	assert.Empty(t, r.Notes)
+	require.Len(t, hosts, 2)
+
+	cpn := hosts[0]
+	assert.Equal(t, "cpn-01", cpn.Name)
+	assert.True(t, cpn.Provision)
+	assert.Equal(t, firmware.EFI64, cpn.Firmware)
+	assert.Equal(t, []string{
+		" de:ad:be:ef:00:01 10.0.0.1/24 cpn-01.example.com,compute-01.example.com",
+		" de:ad:be:ef:01:01 10.0.1.1/24 bmc-cpn-01.example.com bmc",
+	}, nicSummary(cpn.Interfaces))
+	assert.False(t, hosts[1].Provision)
+	assert.True(t, hosts[1].Firmware.IsNil())
+
+	// Exporting the imported hosts gives the same file
+	var second bytes.Buffer
+	writeDnsmasqDHCP(&second, hosts, dnsmasqOptions{LeaseTime: 90 * time.Minute})
+	assert.Equal(t, string(first), second.String())
+}
+
+func TestImportDnsmasq(t *testing.T) {
+	dir := writeFiles(t, map[string]string{
+		"dnsmasq.conf": `domain=example.com
+dhcp-range=set:mgmt,10.1.0.0,static,16,12h
+host-record=n2.example.com,10.1.0.2
+# nodes
+dhcp-host=d0:94:66:00:00:01,d0:94:66:00:00:11,10.1.0.1,n1,infinite
+dhcp-host=D0:94:66:00:00:02,n2,10.1.0.2,set:gpu
+dhcp-host=d0:94:66:00:00:03,10.1.0.3
+dhcp-host=d0:94:66:*:*:*,n4
+dhcp-host=id:01:02:03,n5,10.1.0.5
+dhcp-host=d0:94:66:00:00:06,ignore
+dhcp-host=d0:94:66:00:00:07,n7,10.2.0.7
+`,
+	})
+
+	hosts, r, err := convertInventory("dnsmasq", filepath.Join(dir, "dnsmasq.conf"), imageMap{})
+	require.NoError(t, err)
+	require.Len(t, hosts, 3)
+
+	assert.Equal(t, "n1", hosts[0].Name)
+	assert.Equal(t, []string{" d0:94:66:00:00:01 10.1.0.1/16 ", " d0:94:66:00:00:11 10.1.0.1/16 "}, nicSummary(hosts[0].Interfaces))
+	assert.Equal(t, []string{" d0:94:66:00:00:02 10.1.0.2/16 n2.example.com"}, nicSummary(hosts[1].Interfaces))
+	assert.Equal(t, "n7", hosts[2].Name)
+	assert.Equal(t, []string{" d0:94:66:00:00:07  "}, nicSummary(hosts[2].Interfaces))
+
+	assert.Equal(t, []string{
+		"dnsmasq.conf:7: dhcp-host without a hostname, skipped",
+		"dnsmasq.conf:8: wildcard MAC address d0:94:66:*:*:* not supported",
+		"n4: dnsmasq.conf:8: dhcp-host without a MAC address, skipped",
+		"dnsmasq.conf:9: client identifier id:01:02:03 not supported, only MAC addresses are imported",
+		"n5: dnsmasq.conf:9: dhcp-host without a MAC address, skipped",
+		"n7: interface d0:94:66:00:00:07: no netmask for 10.2.0.7 and no dhcp.subnets entry contains it, address not imported",
+	}, notes(r))
+}
@@ -7,8 +7,8 @@ package node
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
-	"strings"
 
 	"github.com/spf13/cobra"
 	"github.com/ubccr/grendel/cmd"
@@ -17,7 +17,8 @@ import (
 )
 
 var (
-	editCmd = &cobra.Command{
+	editForce bool
+	editCmd   = &cobra.Command{
 		Use:   "edit {nodeset | all}",
 		Short: "edit nodes",
 		Args:  cobra.ExactArgs(1),
@@ -27,18 +28,17 @@ var (
 				return err
 			}
 
-			nodeset := args[0]
-			if args[0] == "all" {
-				nodeset = ""
+			nodeset, err := cmd.NodesetArg(args[0])
+			if err != nil {
+				return err
 			}
-			req := client.GETV1NodesFindParams{
-				Nodeset: client.NewOptString(nodeset),
-				Tags:    client.NewOptString(strings.Join(tags, ",")),
+			filter := client.HostFilter{
+				Nodeset: nodeset,
+				Tags:    tags,
 			}
-
-			res, err := gc.GETV1NodesFind(context.Background(), req)
+			res, err := gc.HostList(context.Background(), filter)
 			if err != nil {
-				return cmd.NewApiError(err)
+				return err
 			}
 
 			data, err := json.MarshalIndent(res, "", "    ")
@@ -51,19 +51,9 @@ var (
 				return err
 			}
 
-			var check []client.NilNodeAddRequestNodeListItem
-			err = json.Unmarshal(newData, &check)
+			storeRes, err := saveEdits(gc, filter, data, newData)
 			if err != nil {
-				return fmt.Errorf("Invalid JSON. Not saving changes: %w", err)
-			}
-
-			storeReq := &client.NodeAddRequest{
-				NodeList: check,
-			}
-			params := client.POSTV1NodesParams{}
-			storeRes, err := gc.POSTV1Nodes(context.Background(), storeReq, params)
-			if err != nil {
-				return cmd.NewApiError(err)
+				return cmd.NewConflictError(err)
 			}
 
 			return cmd.NewApiResponse(storeRes)
@@ -72,5 +62,48 @@ var (
 )
 
 func init() {
+	editCmd.Flags().BoolVar(&editForce, "force", false, "overwrite nodes changed while editing")
 	nodeCmd.AddCommand(editCmd)
 }
+
+// maxMergeAttempts is the number of times edits are merged and saved again
+// after a revision conflict
+const maxMergeAttempts = 3
+
+// saveEdits saves the hosts edited from data. If a host was changed while
+// editing the edits are merged into the current version and saved again.
+func saveEdits(gc *client.Client, filter client.HostFilter, data, edited []byte) (*client.GenericResponse, error) {
+	for i := 0; ; i++ {
+		var hosts []client.Host
+		if err := json.Unmarshal(edited, &hosts); err != nil {
+			return nil, fmt.Errorf("Invalid JSON. Not saving changes: %w", err)
+		}
+
+		if editForce {
+			for i := range hosts {
+				hosts[i].Revision = client.OptNilInt64{}
+			}
+		}
+
+		res, err := gc.HostSave(context.Background(), hosts)
+		if !errors.Is(err, client.ErrConflict) || editForce || i == maxMergeAttempts {
+			return res, err
+		}
+
+		cmd.Log.Warnf("nodes were changed while editing, merging changes: %s", err)
+		current, err := gc.HostList(context.Background(), filter)
+		if err != nil {
+			return nil, err
+		}
+		currentData, err := json.Marshal(current)
+		if err != nil {
+			return nil, err
+		}
+
+		merged, err := cmd.MergeEdits(data, edited, currentData)
+		if err != nil {
+			return nil, fmt.Errorf("%w. Edit again or use --force to overwrite", err)
+		}
+		data, edited = currentData, merged
+	}
+}
@@ -0,0 +1,438 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package node
+
+import (
+	"context"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"github.com/ubccr/grendel/cmd"
+	"github.com/ubccr/grendel/pkg/client"
+	"github.com/ubccr/grendel/pkg/model"
+)
+
+var (
+	exportColumnNames   = []string{"name", "ifname", "ip", "mac", "fqdn", "bmc", "vlan", "parent", "switch", "port", "bootimage", "provision", "state", "tags", "rack", "smbios_uuid", "bios_version", "bmc_firmware", "nic_firmware", "inventory_at"}
+	exportFormat        string
+	exportColumns       []string
+	exportExpand        bool
+	exportSwitch        string
+	exportPort          int
+	exportBIOSVersion   string
+	exportBMCFirmware   string
+	exportNICFirmware   string
+	exportSlurm         slurmOptions
+	exportConsole       consoleOptions
+	exportPrometheus    prometheusOptions
+	exportGroupSource   string
+	exportDnsmasq       dnsmasqOptions
+	exportMonitor       monitorOptions
+	exportSSH           sshOptions
+	exportInterfaceName string
+	exportCmd           = &cobra.Command{
+		Use:   "export {nodeset | all}",
+		Short: "Export nodes as a table or as configuration of other tools",
+		Long: `Export nodes as a CSV or Markdown table, or as slurm.conf, conman.conf,
+Prometheus targets, genders, ClusterShell groups, dnsmasq, Icinga 2, Nagios
+or ssh configuration.
+
+Interface columns (ifname, ip, mac, fqdn, bmc, vlan, parent, switch, port)
+list every interface of a node in a single multi-valued cell. Use --expand to
+write one row per interface instead. The rack column is the value of a rack=<name> or
+rack:<name> tag, or the first tag starting with rack.
+
+Available columns: ` + strings.Join(exportColumnNames, ", ") + `
+
+--format slurm writes slurm.conf NodeName lines instead, nodes with the same
+configuration share a line with their names folded into a nodeset such as
+cpn-[01-32]. Sockets, CoresPerSocket and RealMemory come from the hardware
+inventory collected with bmc inventory, less --reserved-memory. Tags of the
+form key=value or key:value fill in the values missing from the inventory:
+sockets, cores_per_socket, threads_per_core, cpus and real_memory. Gres and
+Feature are read from gres= and feature= tags, which may be repeated.
+--nodeset-tags and --partition-tags add NodeSet= and PartitionName= lines
+with the nodes having each tag.
+
+--template replaces the NodeName line with a Go template executed for each
+group of nodes, with the fields .NodeName, .Hosts, .CPUs, .Sockets,
+.CoresPerSocket, .ThreadsPerCore, .RealMemory, .Gres and .Features. The
+default template is:
+
+  ` + defaultSlurmTemplate + `
+
+--format conman writes a conman.conf console line for every node with a BMC
+interface, connecting with ipmiconsole to the BMC address. Nodes tagged with
+--exclude-tag are left out. --credential selects how the BMC credentials are
+written: literal writes --console-user and --console-password, which default
+to bmc.user and bmc.password. env writes ${IPMI_USER} and ${IPMI_PASSWORD}
+references, named with --user-env and --password-env, to be expanded with
+envsubst. script writes dev="<--script> <address>" lines for an expect script
+handling the credentials. --dialect freeipmi writes an ipmiconsole command for
+each node instead of conman.conf lines.
+
+--format prometheus-sd writes Prometheus file_sd targets to --out, or stdout,
+scraping --port, 9100 by default, of the FQDN or address of the boot interface of each node.
+Targets are labeled with node, bootimage and tags, the other tags joined as
+,tag1,tag2, and a label for each key=value or key:value tag such as rack=a01
+or row=3. --exclude-provision leaves out nodes set to provision, which are
+being reinstalled. Deleted nodes in the trash are never included. The API
+serves the same document for http_sd at /v1/nodes/prometheus-sd, with the
+nodeset, tags, port and exclude_provision query parameters. The --switch and
+firmware filters do not apply.
+
+--format genders writes a genders line for each node with its tags, its
+key=value and key:value tags as attr=value, its rack and its boot image as
+attributes. --format clush-groups writes a ClusterShell groups.d YAML file
+with a group for each tag and an all group, holding folded nodesets, under the
+group source --group-source. Set it as the default in groups.conf to use
+clush -g gpu. Names are sanitized by replacing the characters other than
+letters, digits, _, ., + and - with _, so rack=a01 is the group rack_a01.
+Nodes and attributes are sorted so the files diff cleanly.
+
+--format dnsmasq writes dnsmasq include files to the --out directory, or
+stdout, to run dnsmasq alongside or instead of grendel: ` + dnsmasqDHCPFile + `
+with a dhcp-host line for each interface with a MAC and IPv4 address, its
+hostname and the dhcp.lease_time lease, and a static dhcp-range for each
+subnet. ` + dnsmasqDNSFile + ` with host-record and ptr-record lines for every
+address with an FQDN, and ` + dnsmasqBootFile + ` with the dhcp-boot options
+giving hosts set to provision the firmware grendel would, selected by client
+architecture or by the firmware of the host. The firmware is fetched from
+--tftp-server, which can be a grendel server as grendel also serves its
+firmware by file name, or else from the tftp-root of dnsmasq. The iPXE
+firmware then asks for its boot script with user class grendel, answered by
+a grendel server with dhcp.proxy_only. Hosts are sorted by name and the lines
+of each host follow a "# host <name>" comment, so the output only changes
+with the nodes and node import --from dnsmasq reads it back.
+
+--format icinga2 writes an Icinga 2 Host object for every node, importing
+--host-template, and one named <node>-bmc in the bmc host group for its BMC.
+The address is the IP address, else the FQDN, of the boot interface or of the
+interface named --interface. Plain tags are host groups, with a HostGroup
+object for each, and key=value and key:value tags and the boot image are
+custom variables such as vars.rack. --format nagios writes the same hosts as
+Nagios define host and define hostgroup blocks, the variables as _RACK custom
+variables. --service-template is a Go template file executed for each host
+object and appended to the output, for service apply rules or definitions,
+with the fields .Name, .Address, .DisplayName, .Node, .BMC, .Groups and .Vars
+and a join function. Nodes tagged with --exclude-tag, nomonitor by default,
+are left out. Objects are sorted by name so the config diffs cleanly.
+
+--format ssh-config writes an ssh_config Host entry for every node, with the
+HostName of the same interface as --format icinga2, and one for its BMC named
+by the host name of the BMC interface, or <node>-bmc with --bmc-suffix.
+--jump adds a ProxyJump line to every entry. The User is --ssh-user for nodes
+and --bmc-user, bmc.user by default, for BMCs. --tag-user tag=user and
+--bmc-tag-user tag=user override them for the hosts having the tag, the first
+tag of a host with an override wins. Include the output from ~/.ssh/config,
+such as with Include ~/.ssh/grendel.conf.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(command *cobra.Command, args []string) error {
+			if !slices.Contains([]string{"csv", "markdown", "slurm", "conman", "prometheus-sd", "genders", "clush-groups", "dnsmasq", "icinga2", "nagios", "ssh-config"}, exportFormat) {
+				return fmt.Errorf("invalid format %q. Valid formats: csv, markdown, slurm, conman, prometheus-sd, genders, clush-groups, dnsmasq, icinga2, nagios, ssh-config", exportFormat)
+			}
+
+			gc, err := cmd.NewOgenClient()
+			if err != nil {
+				return err
+			}
+
+			nodeset, err := cmd.NodesetArg(args[0])
+			if err != nil {
+				return err
+			}
+
+			if exportFormat == "prometheus-sd" {
+				exportPrometheus.Port = exportPort
+				if exportPrometheus.Port == 0 {
+					exportPrometheus.Port = model.DefaultPrometheusPort
+				}
+				return exportPrometheusSD(gc, nodeset, exportPrometheus)
+			}
+			res, err := gc.HostList(context.Background(), client.HostFilter{
+				Nodeset:     nodeset,
+				Tags:        tags,
+				Switch:      exportSwitch,
+				Port:        exportPort,
+				BIOSVersion: exportBIOSVersion,
+				BMCFirmware: exportBMCFirmware,
+				NICFirmware: exportNICFirmware,
+			})
+			if err != nil {
+				return err
+			}
+
+			switch exportFormat {
+			case "markdown":
+				return writeMarkdown(os.Stdout, exportColumns, res, exportExpand)
+			case "slurm":
+				return writeSlurm(os.Stdout, res, exportSlurm)
+			case "conman":
+				if exportConsole.User == "" {
+					exportConsole.User = viper.GetString("bmc.user")
+				}
+				if exportConsole.Password == "" {
+					exportConsole.Password = viper.GetString("bmc.password")
+				}
+				return writeConsole(os.Stdout, res, exportConsole)
+			case "genders":
+				return writeGenders(os.Stdout, res)
+			case "clush-groups":
+				return writeClushGroups(os.Stdout, res, exportGroupSource)
+			case "dnsmasq":
+				exportDnsmasq.Out = exportPrometheus.Out
+				exportDnsmasq.LeaseTime, err = time.ParseDuration(viper.GetString("dhcp.lease_time"))
+				if err != nil {
+					return fmt.Errorf("failed parsing dhcp.lease_time: %w", err)
+				}
+				return writeDnsmasq(os.Stdout, res, exportDnsmasq)
+			case "icinga2", "nagios":
+				exportMonitor.Dialect = exportFormat
+				exportMonitor.Interface = exportInterfaceName
+				exportMonitor.ExcludeTag = exportConsole.ExcludeTag
+				if !command.Flags().Changed("exclude-tag") {
+					exportMonitor.ExcludeTag = defaultMonitorExcludeTag
+				}
+				return writeMonitor(os.Stdout, res, exportMonitor)
+			case "ssh-config":
+				exportSSH.Interface = exportInterfaceName
+				if exportSSH.BMCUser == "" {
+					exportSSH.BMCUser = viper.GetString("bmc.user")
+				}
+				return writeSSHConfig(os.Stdout, res, exportSSH)
+			}
+
+			return writeCSV(os.Stdout, exportColumns, res, exportExpand)
+		},
+	}
+)
+
+func init() {
+	exportCmd.Flags().StringVar(&exportFormat, "format", "csv", "output format: csv, markdown, slurm, conman, prometheus-sd, genders, clush-groups, dnsmasq, icinga2, nagios or ssh-config")
+	exportCmd.Flags().StringSliceVar(&exportColumns, "columns", []string{"name", "ip", "mac", "fqdn", "bootimage", "tags"}, "columns to export")
+	exportCmd.Flags().BoolVar(&exportExpand, "expand", false, "write one row per interface instead of multi-valued cells")
+	exportCmd.Flags().StringVar(&exportSwitch, "switch", "", "Filter by switch the node is connected to")
+	exportCmd.Flags().IntVar(&exportPort, "port", 0, "Filter by switch port the node is connected to, or the port of the prometheus-sd targets")
+	exportCmd.Flags().StringVar(&exportBIOSVersion, "bios-version", "", "Filter by BIOS version in the firmware inventory")
+	exportCmd.Flags().StringVar(&exportBMCFirmware, "bmc-firmware", "", "Filter by BMC firmware version in the firmware inventory")
+	exportCmd.Flags().StringVar(&exportNICFirmware, "nic-firmware", "", "Filter by network adapter firmware version in the firmware inventory")
+	exportCmd.Flags().StringVar(&exportSlurm.Template, "template", "", "Go template of the slurm NodeName lines")
+	exportCmd.Flags().IntVar(&exportSlurm.ReservedMemory, "reserved-memory", 0, "MiB subtracted from the inventory memory for the slurm RealMemory")
+	exportCmd.Flags().StringSliceVar(&exportSlurm.NodeSetTags, "nodeset-tags", []string{}, "tags written as slurm NodeSet= lines")
+	exportCmd.Flags().StringSliceVar(&exportSlurm.PartitionTags, "partition-tags", []string{}, "tags written as slurm PartitionName= lines")
+	exportCmd.Flags().StringVar(&exportConsole.Dialect, "dialect", "conman", "console format: conman or freeipmi")
+	exportCmd.Flags().StringVar(&exportConsole.Credential, "credential", "literal", "BMC credentials of the console format: literal, env or script")
+	exportCmd.Flags().StringVar(&exportConsole.User, "console-user", "", "BMC user of the console format, defaults to bmc.user")
+	exportCmd.Flags().StringVar(&exportConsole.Password, "console-password", "", "BMC password of the console format, defaults to bmc.password")
+	exportCmd.Flags().StringVar(&exportConsole.UserEnv, "user-env", "IPMI_USER", "environment variable of the BMC user with --credential env")
+	exportCmd.Flags().StringVar(&exportConsole.PasswordEnv, "password-env", "IPMI_PASSWORD", "environment variable of the BMC password with --credential env")
+	exportCmd.Flags().StringVar(&exportConsole.Script, "script", defaultConmanScript, "expect script of the consoles with --credential script")
+	exportCmd.Flags().StringVar(&exportConsole.ExcludeTag, "exclude-tag", defaultConsoleExcludeTag, "tag of the nodes left out of the console format, or of the icinga2 and nagios formats where it defaults to "+defaultMonitorExcludeTag)
+	exportCmd.Flags().BoolVar(&exportPrometheus.ExcludeProvision, "exclude-provision", false, "leave nodes set to provision out of the prometheus-sd targets")
+	exportCmd.Flags().StringVar(&exportPrometheus.Out, "out", "", "file the prometheus-sd targets are written to, or directory of the dnsmasq files")
+	exportCmd.Flags().StringVar(&exportDnsmasq.TFTPServer, "tftp-server", "", "TFTP server address of the dnsmasq boot options, dnsmasq itself by default")
+	exportCmd.Flags().StringVar(&exportInterfaceName, "interface", "", "interface giving the address of the icinga2, nagios and ssh-config hosts, the boot interface by default")
+	exportCmd.Flags().StringVar(&exportMonitor.HostTemplate, "host-template", defaultHostTemplate, "host template imported by the icinga2 and nagios hosts")
+	exportCmd.Flags().StringVar(&exportMonitor.ServiceTemplate, "service-template", "", "Go template file executed for each icinga2 or nagios host object")
+	exportCmd.Flags().StringVar(&exportSSH.Jump, "jump", "", "ProxyJump host of the ssh-config entries")
+	exportCmd.Flags().StringVar(&exportSSH.User, "ssh-user", "", "user of the ssh-config node entries")
+	exportCmd.Flags().StringVar(&exportSSH.BMCUser, "bmc-user", "", "user of the ssh-config BMC entries, defaults to bmc.user")
+	exportCmd.Flags().StringToStringVar(&exportSSH.TagUsers, "tag-user", map[string]string{}, "tag=user overriding --ssh-user for the nodes having the tag")
+	exportCmd.Flags().StringToStringVar(&exportSSH.BMCTagUsers, "bmc-tag-user", map[string]string{}, "tag=user overriding --bmc-user for the BMCs of the nodes having the tag")
+	exportCmd.Flags().BoolVar(&exportSSH.BMCSuffix, "bmc-suffix", false, "name the ssh-config BMC entries <node>-bmc instead of the host name of the BMC interface")
+	exportCmd.Flags().StringVar(&exportGroupSource, "group-source", defaultGroupSource, "group source of the clush-groups format")
+	exportCmd.RegisterFlagCompletionFunc("columns", func(command *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		return exportColumnNames, cobra.ShellCompDirectiveNoFileComp
+	})
+	nodeCmd.AddCommand(exportCmd)
+}
+
+func writeCSV(w io.Writer, columns []string, hosts []client.Host, expand bool) error {
+	rows, err := exportRows(columns, hosts, expand, "\n")
+	if err != nil {
+		return err
+	}
+
+	cw := csv.NewWriter(w)
+	cw.Write(columns)
+	cw.WriteAll(rows)
+
+	return cw.Error()
+}
+
+func writeMarkdown(w io.Writer, columns []string, hosts []client.Host, expand bool) error {
+	rows, err := exportRows(columns, hosts, expand, "<br>")
+	if err != nil {
+		return err
+	}
+
+	escaper := strings.NewReplacer("|", "\\|", "\r", "", "\n", " ")
+	line := func(cells []string) {
+		escaped := make([]string, len(cells))
+		for i, c := range cells {
+			escaped[i] = escaper.Replace(c)
+		}
+		fmt.Fprintf(w, "| %s |\n", strings.Join(escaped, " | "))
+	}
+
+	line(columns)
+	sep := make([]string, len(columns))
+	for i := range sep {
+		sep[i] = "---"
+	}
+	line(sep)
+	for _, r := range rows {
+		line(r)
+	}
+
+	return nil
+}
+
+// exportRows returns the table rows for hosts with the given columns. If
+// expand is true each interface is written on its own row, otherwise the
+// interface values are joined with sep into a single cell.
+func exportRows(columns []string, hosts []client.Host, expand bool, sep string) ([][]string, error) {
+	for _, c := range columns {
+		if !slices.Contains(exportColumnNames, c) {
+			return nil, fmt.Errorf("invalid column %q. Valid columns: %s", c, strings.Join(exportColumnNames, ", "))
+		}
+	}
+
+	rows := make([][]string, 0, len(hosts))
+	for _, host := range hosts {
+		nics := make([]client.HostInterfacesItem, 0, len(host.Interfaces))
+		for _, n := range host.Interfaces {
+			if !n.Null {
+				nics = append(nics, n.Value)
+			}
+		}
+
+		if !expand {
+			rows = append(rows, exportRow(columns, host, nics, sep))
+			continue
+		}
+
+		if len(nics) == 0 {
+			rows = append(rows, exportRow(columns, host, nil, sep))
+		}
+		for _, nic := range nics {
+			rows = append(rows, exportRow(columns, host, []client.HostInterfacesItem{nic}, sep))
+		}
+	}
+
+	return rows, nil
+}
+
+func exportRow(columns []string, host client.Host, nics []client.HostInterfacesItem, sep string) []string {
+	nicValues := func(f func(client.HostInterfacesItem) string) string {
+		values := make([]string, 0, len(nics))
+		for _, nic := range nics {
+			values = append(values, f(nic))
+		}
+		return strings.Join(values, sep)
+	}
+
+	row := make([]string, 0, len(columns))
+	for _, c := range columns {
+		var v string
+		switch c {
+		case "name":
+			v = host.Name.Value
+		case "ifname":
+			v = nicValues(func(n client.HostInterfacesItem) string { return n.Ifname.Value })
+		case "ip":
+			v = nicValues(func(n client.HostInterfacesItem) string { return n.IP.Value })
+		case "mac":
+			v = nicValues(func(n client.HostInterfacesItem) string { return n.MAC.Value })
+		case "fqdn":
+			v = nicValues(func(n client.HostInterfacesItem) string { return n.Fqdn.Value })
+		case "bmc":
+			v = nicValues(func(n client.HostInterfacesItem) string { return strconv.FormatBool(n.Bmc.Value) })
+		case "vlan":
+			v = nicValues(func(n client.HostInterfacesItem) string {
+				if n.Vlan.Value == 0 {
+					return ""
+				}
+				return strconv.Itoa(n.Vlan.Value)
+			})
+		case "parent":
+			v = nicValues(func(n client.HostInterfacesItem) string { return n.Parent.Value })
+		case "switch":
+			v = nicValues(func(n client.HostInterfacesItem) string { return n.Switch.Value })
+		case "port":
+			v = nicValues(func(n client.HostInterfacesItem) string {
+				if n.Port.Value == 0 {
+					return ""
+				}
+				return strconv.Itoa(n.Port.Value)
+			})
+		case "bootimage":
+			v = host.BootImage.Value
+		case "provision":
+			v = strconv.FormatBool(host.Provision.Value)
+		case "state":
+			v = host.State.Value
+		case "tags":
+			v = strings.Join(host.Tags.Value, ",")
+		case "rack":
+			v = model.RackTag(host.Tags.Value)
+		case "smbios_uuid":
+			v = host.SmbiosUUID.Value
+		case "bios_version":
+			v = hostInventory(host).BIOSVersion
+		case "bmc_firmware":
+			v = hostInventory(host).BMCFirmware
+		case "nic_firmware":
+			versions, _ := hostInventory(host).Versions(model.InventoryFieldNIC)
+			v = strings.Join(versions, sep)
+		case "inventory_at":
+			if at := hostInventory(host).CapturedAt; !at.IsZero() {
+				v = at.Format(time.RFC3339)
+			}
+		}
+		row = append(row, v)
+	}
+
+	return row
+}
+
+// exportInterface returns the interface of host named name, its boot
+// interface when name is empty
+func exportInterface(host *model.Host, name string) *model.NetInterface {
+	if name == "" {
+		return host.BootInterface()
+	}
+
+	for _, nic := range host.Interfaces {
+		if nic.Name == name {
+			return nic
+		}
+	}
+
+	return nil
+}
+
+// interfaceAddress returns the IP address of nic, else its FQDN, else
+// fallback
+func interfaceAddress(nic *model.NetInterface, fallback string) string {
+	switch {
+	case nic == nil:
+		return fallback
+	case nic.IP.IsValid():
+		return nic.AddrString()
+	case nic.HostName() != "":
+		return nic.HostName()
+	}
+
+	return fallback
+}
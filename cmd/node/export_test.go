@@ -0,0 +1,228 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package node
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/ubccr/grendel/pkg/client"
+)
+
+func testExportHosts() []client.Host {
+	hosts := testExisting()
+	hosts[0].Tags = client.NewOptNilStringArray([]string{"ib", "rack=a01"})
+	hosts[0].Interfaces = append(hosts[0].Interfaces, client.NewNilHostInterfacesItem(client.HostInterfacesItem{
+		MAC:  client.NewOptString("de:ad:be:ef:01:01"),
+		IP:   client.NewOptString("10.0.1.1/24"),
+		Fqdn: client.NewOptString("bmc-cpn-01.example.com"),
+		Bmc:  client.NewOptBool(true),
+	}))
+
+	return hosts
+}
+
+func TestExportCSV(t *testing.T) {
+	var buf bytes.Buffer
+	err := writeCSV(&buf, []string{"name", "ip", "tags", "rack"}, testExportHosts(), false)
+	if assert.NoError(t, err) {
+		assert.Equal(t, "name,ip,tags,rack\ncpn-01,\"10.0.0.1/24\n10.0.1.1/24\",\"ib,rack=a01\",a01\n", buf.String())
+	}
+
+	buf.Reset()
+	err = writeCSV(&buf, []string{"name", "mac", "bmc"}, testExportHosts(), true)
+	if assert.NoError(t, err) {
+		assert.Equal(t, "name,mac,bmc\ncpn-01,de:ad:be:ef:00:01,false\ncpn-01,de:ad:be:ef:01:01,true\n", buf.String())
+	}
+
+	hosts := testExportHosts()
+	hosts[0].Interfaces[1].Value.Vlan = client.NewOptInt(2010)
+	hosts[0].Interfaces[1].Value.Parent = client.NewOptString("ens1f0")
+	buf.Reset()
+	err = writeCSV(&buf, []string{"name", "vlan", "parent"}, hosts, true)
+	if assert.NoError(t, err) {
+		assert.Equal(t, "name,vlan,parent\ncpn-01,,\ncpn-01,2010,ens1f0\n", buf.String())
+	}
+
+	err = writeCSV(&buf, []string{"name", "missing"}, testExportHosts(), false)
+	assert.Error(t, err)
+}
+
+func TestExportMarkdown(t *testing.T) {
+	hosts := testExportHosts()
+	hosts[0].BootImage = client.NewOptString("compute|gpu")
+
+	var buf bytes.Buffer
+	err := writeMarkdown(&buf, []string{"name", "ip", "bootimage"}, hosts, false)
+	if assert.NoError(t, err) {
+		expected := "| name | ip | bootimage |\n" +
+			"| --- | --- | --- |\n" +
+			"| cpn-01 | 10.0.0.1/24<br>10.0.1.1/24 | compute\\|gpu |\n"
+		assert.Equal(t, expected, buf.String())
+	}
+}
+
+func TestExportSlurm(t *testing.T) {
+	hw := client.NewOptNilHostHardware(client.HostHardware{
+		CPUCount:  client.NewOptInt(2),
+		CoreCount: client.NewOptInt(64),
+		MemoryMib: client.NewOptInt(262144),
+	})
+
+	hosts := make([]client.Host, 0)
+	for _, name := range []string{"cpn-01", "cpn-02", "cpn-03", "cpn-05"} {
+		hosts = append(hosts, client.Host{
+			Name:     client.NewOptString(name),
+			Tags:     client.NewOptNilStringArray([]string{"compute", "threads_per_core=2"}),
+			Hardware: hw,
+		})
+	}
+	// No inventory, the configuration is read from the tags
+	hosts = append(hosts, client.Host{
+		Name: client.NewOptString("gpu-01"),
+		Tags: client.NewOptNilStringArray([]string{"gpu", "sockets=2", "cores_per_socket=16", "real_memory=500000", "gres=gpu:a100:4", "feature=a100", "feature=nvlink"}),
+	})
+
+	var buf bytes.Buffer
+	err := writeSlurm(&buf, hosts, slurmOptions{
+		ReservedMemory: 4096,
+		NodeSetTags:    []string{"gpu"},
+		PartitionTags:  []string{"compute", "missing"},
+	})
+	if assert.NoError(t, err) {
+		assert.Equal(t, `NodeName=cpn-[01-03,05] CPUs=128 Sockets=2 CoresPerSocket=32 ThreadsPerCore=2 RealMemory=258048
+NodeName=gpu-01 CPUs=32 Sockets=2 CoresPerSocket=16 RealMemory=500000 Gres=gpu:a100:4 Feature=a100,nvlink
+NodeSet=gpu Nodes=gpu-01
+PartitionName=compute Nodes=cpn-[01-03,05]
+`, buf.String())
+	}
+
+	buf.Reset()
+	err = writeSlurm(&buf, hosts[:2], slurmOptions{Template: "NodeName={{.NodeName}} Weight={{len .Hosts}} CPUs={{.CPUs}}"})
+	if assert.NoError(t, err) {
+		assert.Equal(t, "NodeName=cpn-[01-02] Weight=2 CPUs=128\n", buf.String())
+	}
+
+	err = writeSlurm(&buf, hosts, slurmOptions{Template: "{{.Missing"})
+	assert.ErrorContains(t, err, "invalid template")
+}
+
+func TestExportConman(t *testing.T) {
+	hosts := testExportHosts()
+	hosts = append(hosts, client.Host{
+		Name: client.NewOptString("cpn-02"),
+		Tags: client.NewOptNilStringArray([]string{"noconsole"}),
+		Interfaces: []client.NilHostInterfacesItem{client.NewNilHostInterfacesItem(client.HostInterfacesItem{
+			IP:  client.NewOptString("10.0.1.2/24"),
+			Bmc: client.NewOptBool(true),
+		})},
+	}, client.Host{
+		Name: client.NewOptString("cpn-03"),
+		Interfaces: []client.NilHostInterfacesItem{client.NewNilHostInterfacesItem(client.HostInterfacesItem{
+			Fqdn: client.NewOptString("bmc-cpn-03.example.com"),
+			Bmc:  client.NewOptBool(true),
+		})},
+	})
+
+	opts := consoleOptions{
+		Dialect:     "conman",
+		Credential:  "literal",
+		User:        "admin",
+		Password:    `pa"ss`,
+		UserEnv:     "IPMI_USER",
+		PasswordEnv: "IPMI_PASSWORD",
+		Script:      defaultConmanScript,
+		ExcludeTag:  defaultConsoleExcludeTag,
+	}
+
+	var buf bytes.Buffer
+	if assert.NoError(t, writeConsole(&buf, hosts, opts)) {
+		assert.Equal(t, `console name="cpn-01" dev="ipmi:10.0.1.1" ipmiopts="U:admin,P:pa\"ss"
+console name="cpn-03" dev="ipmi:bmc-cpn-03.example.com" ipmiopts="U:admin,P:pa\"ss"
+`, buf.String())
+	}
+
+	opts.Credential = "env"
+	buf.Reset()
+	if assert.NoError(t, writeConsole(&buf, hosts[:1], opts)) {
+		assert.Equal(t, `console name="cpn-01" dev="ipmi:10.0.1.1" ipmiopts="U:${IPMI_USER},P:${IPMI_PASSWORD}"`+"\n", buf.String())
+	}
+
+	opts.Credential = "script"
+	buf.Reset()
+	if assert.NoError(t, writeConsole(&buf, hosts[:1], opts)) {
+		assert.Equal(t, `console name="cpn-01" dev="/usr/share/conman/exec/ipmitool.exp 10.0.1.1"`+"\n", buf.String())
+	}
+
+	opts.Dialect = "freeipmi"
+	assert.Error(t, writeConsole(&buf, hosts, opts))
+
+	opts.Credential = "literal"
+	opts.Password = "it's"
+	buf.Reset()
+	if assert.NoError(t, writeConsole(&buf, hosts[:1], opts)) {
+		assert.Equal(t, "# cpn-01\nipmiconsole -h 10.0.1.1 -u 'admin' -p 'it'\\''s'\n", buf.String())
+	}
+
+	opts.Credential = "env"
+	buf.Reset()
+	if assert.NoError(t, writeConsole(&buf, hosts[:1], opts)) {
+		assert.Equal(t, "# cpn-01\nipmiconsole -h 10.0.1.1 -u \"${IPMI_USER}\" -p \"${IPMI_PASSWORD}\"\n", buf.String())
+	}
+
+	opts.Password = ""
+	opts.Credential = "literal"
+	assert.ErrorContains(t, writeConsole(&buf, hosts, opts), "no BMC credentials")
+}
+
+func testGendersHosts() []client.Host {
+	return []client.Host{
+		{
+			Name:      client.NewOptString("gpu-01"),
+			BootImage: client.NewOptString("rocky9-gpu"),
+			Tags:      client.NewOptNilStringArray([]string{"gpu", "rack:b02", "gres=gpu:a100:4", "gres=gpu:a100:8", "owner=chem lab", "yes"}),
+		},
+		{
+			Name: client.NewOptString("cpn-02"),
+			Tags: client.NewOptNilStringArray([]string{"compute", "rack=a01", "ib"}),
+		},
+		{
+			Name:      client.NewOptString("cpn-01"),
+			BootImage: client.NewOptString("rocky9"),
+			Tags:      client.NewOptNilStringArray([]string{"ib", "compute", "rack=a01"}),
+		},
+		{Name: client.NewOptString("srv-01")},
+	}
+}
+
+func TestExportGenders(t *testing.T) {
+	var buf bytes.Buffer
+	assert.NoError(t, writeGenders(&buf, testGendersHosts()))
+	assert.Equal(t, `cpn-01 bootimage=rocky9,compute,ib,rack=a01
+cpn-02 compute,ib,rack=a01
+gpu-01 bootimage=rocky9-gpu,gpu,gres=gpu:a100:4,owner=chem_lab,rack=b02,yes
+srv-01
+`, buf.String())
+}
+
+func TestExportClushGroups(t *testing.T) {
+	var buf bytes.Buffer
+	err := writeClushGroups(&buf, testGendersHosts(), defaultGroupSource)
+	if assert.NoError(t, err) {
+		assert.Equal(t, `grendel:
+    all: 'cpn-[01-02],gpu-01,srv-01'
+    compute: 'cpn-[01-02]'
+    gpu: 'gpu-01'
+    gres_gpu_a100_4: 'gpu-01'
+    gres_gpu_a100_8: 'gpu-01'
+    ib: 'cpn-[01-02]'
+    owner_chem_lab: 'gpu-01'
+    rack_a01: 'cpn-[01-02]'
+    rack_b02: 'gpu-01'
+    'yes': 'gpu-01'
+`, buf.String())
+	}
+}
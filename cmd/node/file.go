@@ -0,0 +1,148 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package node
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/ubccr/grendel/cmd"
+	"github.com/ubccr/grendel/pkg/client"
+	"github.com/ubccr/grendel/pkg/model"
+)
+
+var (
+	fileCmd = &cobra.Command{
+		Use:   "file",
+		Short: "Manage node files",
+		Long: `Manage the files attached to nodes, such as an Infiniband partition config,
+a license file or a pre-generated machine-id. Files are stored in the database,
+limited to 512 KiB, and are served to the node while provisioning at
+/boot/<token>/extra/<name>. /boot/<token>/extra/ lists their names, one per
+line, and templates enumerate them with .extra.List:
+
+  {{ range .extra.List }}
+  curl -o /etc/{{ .Name }} {{ $.endpoints.ExtraURL .Name }}
+  {{ end }}
+
+Files are deleted with their node.`,
+	}
+	filePutCmd = &cobra.Command{
+		Use:     "put {nodeset | all} <name> <path>",
+		Short:   "Attach a file to nodes",
+		Long:    `Attach the file at path to nodes as name, replacing the file with the same name.`,
+		Example: `  grendel node file put cpn-d13-01 opa.conf ./opa.conf`,
+		Args:    cobra.ExactArgs(3),
+		RunE: func(command *cobra.Command, args []string) error {
+			data, err := os.ReadFile(args[2])
+			if err != nil {
+				return err
+			}
+			if len(data) > model.HostFileMaxSize {
+				return fmt.Errorf("%s is %d bytes, files are limited to %d bytes", args[2], len(data), model.HostFileMaxSize)
+			}
+
+			gc, err := cmd.NewOgenClient()
+			if err != nil {
+				return err
+			}
+
+			req := &client.NodeFileRequest{
+				Name:    args[1],
+				Content: client.NewOptString(base64.StdEncoding.EncodeToString(data)),
+			}
+			nodeset, err := cmd.NodesetArg(args[0])
+			if err != nil {
+				return err
+			}
+
+			params := client.PUTV1NodesFilesParams{
+				Nodeset: client.NewOptString(nodeset),
+				Tags:    client.NewOptString(strings.Join(tags, ",")),
+			}
+			res, err := gc.PUTV1NodesFiles(context.Background(), req, params)
+			if err != nil {
+				return cmd.NewApiError(err)
+			}
+
+			return cmd.NewApiResponse(res)
+		},
+	}
+	fileListCmd = &cobra.Command{
+		Use:   "list {nodeset | all}",
+		Short: "List node files",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(command *cobra.Command, args []string) error {
+			gc, err := cmd.NewOgenClient()
+			if err != nil {
+				return err
+			}
+
+			nodeset, err := cmd.NodesetArg(args[0])
+			if err != nil {
+				return err
+			}
+
+			params := client.GETV1NodesFilesParams{
+				Nodeset: client.NewOptString(nodeset),
+				Tags:    client.NewOptString(strings.Join(tags, ",")),
+			}
+			res, err := gc.GETV1NodesFiles(context.Background(), params)
+			if err != nil {
+				return cmd.NewApiError(err)
+			}
+
+			if cmd.JSONOutput() {
+				return cmd.Output(res)
+			}
+
+			for _, f := range res {
+				fmt.Printf("%-30s%-30s%10d  %s\n", f.Host.Value, f.Name.Value, f.Size.Value, f.UpdatedAt.Value.Local().Format(time.DateTime))
+			}
+
+			return nil
+		},
+	}
+	fileDeleteCmd = &cobra.Command{
+		Use:   "delete {nodeset | all} <name>",
+		Short: "Delete node files",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(command *cobra.Command, args []string) error {
+			gc, err := cmd.NewOgenClient()
+			if err != nil {
+				return err
+			}
+
+			nodeset, err := cmd.NodesetArg(args[0])
+			if err != nil {
+				return err
+			}
+
+			params := client.DELETEV1NodesFilesParams{
+				Nodeset: client.NewOptString(nodeset),
+				Tags:    client.NewOptString(strings.Join(tags, ",")),
+				Name:    args[1],
+			}
+			res, err := gc.DELETEV1NodesFiles(context.Background(), params)
+			if err != nil {
+				return cmd.NewApiError(err)
+			}
+
+			return cmd.NewApiResponse(res)
+		},
+	}
+)
+
+func init() {
+	fileCmd.AddCommand(filePutCmd)
+	fileCmd.AddCommand(fileListCmd)
+	fileCmd.AddCommand(fileDeleteCmd)
+	nodeCmd.AddCommand(fileCmd)
+}
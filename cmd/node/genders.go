@@ -0,0 +1,97 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package node
+
+import (
+	"fmt"
+	"io"
+	"regexp"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/ubccr/grendel/pkg/client"
+	"github.com/ubccr/grendel/pkg/model"
+)
+
+// defaultGroupSource is the clush group source of the clush-groups format
+const defaultGroupSource = "grendel"
+
+var plainYAMLKey = regexp.MustCompile(`^[a-zA-Z_][a-zA-Z0-9_.+-]*$`)
+
+// writeGenders writes a genders line for each host, sorted by name, with
+// the attributes of model.Host.GendersLine
+func writeGenders(w io.Writer, hosts []client.Host) error {
+	list, err := modelHosts(hosts)
+	if err != nil {
+		return err
+	}
+
+	for _, host := range list {
+		fmt.Fprintln(w, host.GendersLine())
+	}
+
+	return nil
+}
+
+// writeClushGroups writes a ClusterShell groups.d YAML file with a group for
+// each tag, holding the folded nodeset of the hosts having it, and an all
+// group. Tags are sanitized into group names, rack=a01 is the group rack_a01
+func writeClushGroups(w io.Writer, hosts []client.Host, source string) error {
+	members := make(map[string][]string)
+	all := make([]string, 0, len(hosts))
+	for _, host := range sortedHosts(hosts) {
+		name := host.Name.Value
+		all = append(all, name)
+		for _, t := range host.Tags.Value {
+			group := model.GendersName(t)
+			if group != "" && !slices.Contains(members[group], name) {
+				members[group] = append(members[group], name)
+			}
+		}
+	}
+	if _, ok := members["all"]; !ok && len(all) > 0 {
+		members["all"] = all
+	}
+
+	groups := make([]string, 0, len(members))
+	for g := range members {
+		groups = append(groups, g)
+	}
+	sort.Strings(groups)
+
+	fmt.Fprintf(w, "%s:\n", yamlKey(source))
+	for _, g := range groups {
+		ns, err := foldNames(members[g])
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(w, "    %s: '%s'\n", yamlKey(g), ns)
+	}
+
+	return nil
+}
+
+// sortedHosts returns a copy of hosts sorted by name
+func sortedHosts(hosts []client.Host) []client.Host {
+	sorted := slices.Clone(hosts)
+	sort.SliceStable(sorted, func(i, j int) bool { return sorted[i].Name.Value < sorted[j].Name.Value })
+
+	return sorted
+}
+
+// yamlKey returns key quoted when YAML would not read it as a plain string,
+// such as yes or 01
+func yamlKey(key string) string {
+	switch strings.ToLower(key) {
+	case "true", "false", "yes", "no", "on", "off", "y", "n", "null":
+		return "'" + key + "'"
+	}
+	if !plainYAMLKey.MatchString(key) {
+		return "'" + strings.ReplaceAll(key, "'", "''") + "'"
+	}
+
+	return key
+}
@@ -0,0 +1,267 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package node
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"regexp"
+	"slices"
+	"sort"
+	"strings"
+	"text/template"
+
+	"github.com/ubccr/grendel/pkg/client"
+	"github.com/ubccr/grendel/pkg/model"
+)
+
+const (
+	// defaultMonitorExcludeTag marks hosts left out of the monitoring config
+	defaultMonitorExcludeTag = "nomonitor"
+
+	// defaultHostTemplate is the host template imported by every host object,
+	// shipped in the sample config of both Icinga 2 and Nagios
+	defaultHostTemplate = "generic-host"
+
+	// monitorBMCGroup is the host group of the BMC host objects
+	monitorBMCGroup = "bmc"
+)
+
+// invalidVarChars are replaced in custom variable names, which Icinga 2
+// reads as identifiers and Nagios as upper case macro names
+var invalidVarChars = regexp.MustCompile(`[^a-zA-Z0-9_]`)
+
+// monitorOptions are the settings of the icinga2 and nagios export formats
+type monitorOptions struct {
+	// Dialect is icinga2 for Icinga 2 objects or nagios for Nagios object
+	// definitions
+	Dialect string
+
+	// Interface is the name of the interface giving the address of a host,
+	// the boot interface when empty
+	Interface string
+
+	// HostTemplate is imported by every host object
+	HostTemplate string
+
+	// ServiceTemplate is a Go template file executed for each host object
+	// after the objects, for service apply rules or definitions
+	ServiceTemplate string
+
+	// ExcludeTag leaves out the hosts having it
+	ExcludeTag string
+}
+
+// monitorHost is a host object of a node or of its BMC, and the context of
+// the service template
+type monitorHost struct {
+	Name        string
+	Address     string
+	DisplayName string
+
+	// Node is the name of the node, which differs from Name for a BMC
+	Node   string
+	BMC    bool
+	Groups []string
+	Vars   map[string]string
+}
+
+// monitorHosts returns the host objects of hosts sorted by name, a host for
+// each node and one for its BMC. Plain tags are host groups, key=value and
+// key:value tags and the boot image custom variables
+func monitorHosts(hosts []client.Host, opts monitorOptions) ([]monitorHost, error) {
+	list, err := modelHosts(hosts)
+	if err != nil {
+		return nil, err
+	}
+
+	objects := make([]monitorHost, 0, len(list))
+	for _, host := range list {
+		if opts.ExcludeTag != "" && host.HasTags(opts.ExcludeTag) {
+			continue
+		}
+
+		groups := make([]string, 0)
+		vars := make(map[string]string)
+		for _, t := range host.Tags {
+			if key, value, ok := model.SplitTag(t); ok {
+				key = invalidVarChars.ReplaceAllString(strings.TrimSpace(key), "_")
+				if _, exists := vars[key]; !exists && key != "" {
+					vars[key] = value
+				}
+				continue
+			}
+			if group := model.GendersName(t); group != "" && !slices.Contains(groups, group) {
+				groups = append(groups, group)
+			}
+		}
+		if _, exists := vars["bootimage"]; !exists && host.BootImage != "" {
+			vars["bootimage"] = host.BootImage
+		}
+		sort.Strings(groups)
+
+		objects = append(objects, newMonitorHost(host.Name, host.Name, exportInterface(host, opts.Interface), groups, vars))
+
+		if bmc := host.InterfaceBMC(); bmc != nil {
+			bmcVars := make(map[string]string, len(vars)+1)
+			for key, value := range vars {
+				bmcVars[key] = value
+			}
+			bmcVars["node"] = host.Name
+			objects = append(objects, newMonitorHost(host.Name+"-bmc", host.Name, bmc, []string{monitorBMCGroup}, bmcVars))
+		}
+	}
+
+	return objects, nil
+}
+
+// newMonitorHost returns the host object name with the address of nic
+func newMonitorHost(name, node string, nic *model.NetInterface, groups []string, vars map[string]string) monitorHost {
+	m := monitorHost{Name: name, Address: interfaceAddress(nic, name), DisplayName: name, Node: node, BMC: name != node, Groups: groups, Vars: vars}
+	if nic != nil && nic.HostName() != "" {
+		m.DisplayName = nic.HostName()
+	}
+
+	return m
+}
+
+// sortedVars returns the names of vars sorted
+func sortedVars(vars map[string]string) []string {
+	names := make([]string, 0, len(vars))
+	for name := range vars {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return names
+}
+
+// writeMonitor writes the host groups and host objects of hosts in the
+// dialect of opts, then the service template executed for each host object,
+// skipping empty output. Objects are sorted by name so the config diffs
+// cleanly
+func writeMonitor(w io.Writer, hosts []client.Host, opts monitorOptions) error {
+	if opts.Dialect != "icinga2" && opts.Dialect != "nagios" {
+		return fmt.Errorf("invalid dialect %q. Valid dialects: icinga2, nagios", opts.Dialect)
+	}
+
+	var services *template.Template
+	if opts.ServiceTemplate != "" {
+		data, err := os.ReadFile(opts.ServiceTemplate)
+		if err != nil {
+			return err
+		}
+		services, err = template.New(opts.ServiceTemplate).Funcs(template.FuncMap{"join": strings.Join}).Parse(string(data))
+		if err != nil {
+			return fmt.Errorf("invalid service template: %w", err)
+		}
+	}
+
+	objects, err := monitorHosts(hosts, opts)
+	if err != nil {
+		return err
+	}
+
+	groups := make([]string, 0)
+	for _, m := range objects {
+		for _, g := range m.Groups {
+			if !slices.Contains(groups, g) {
+				groups = append(groups, g)
+			}
+		}
+	}
+	sort.Strings(groups)
+
+	if opts.Dialect == "nagios" {
+		fmt.Fprintln(w, "# generated by grendel node export --format nagios")
+		for _, g := range groups {
+			fmt.Fprintf(w, "\ndefine hostgroup {\n    hostgroup_name  %s\n    alias           %s\n}\n", g, g)
+		}
+		for _, m := range objects {
+			writeNagiosHost(w, m, opts.HostTemplate)
+		}
+	} else {
+		fmt.Fprintln(w, "// generated by grendel node export --format icinga2")
+		for _, g := range groups {
+			fmt.Fprintf(w, "\nobject HostGroup %s {\n}\n", icingaQuote(g))
+		}
+		for _, m := range objects {
+			writeIcingaHost(w, m, opts.HostTemplate)
+		}
+	}
+
+	if services == nil {
+		return nil
+	}
+	for _, m := range objects {
+		var buf bytes.Buffer
+		if err := services.Execute(&buf, m); err != nil {
+			return fmt.Errorf("failed executing service template for %s: %w", m.Name, err)
+		}
+		if out := strings.TrimSpace(buf.String()); out != "" {
+			fmt.Fprintf(w, "\n%s\n", out)
+		}
+	}
+
+	return nil
+}
+
+func writeIcingaHost(w io.Writer, m monitorHost, hostTemplate string) {
+	fmt.Fprintf(w, "\nobject Host %s {\n", icingaQuote(m.Name))
+	if hostTemplate != "" {
+		fmt.Fprintf(w, "  import %s\n", icingaQuote(hostTemplate))
+	}
+
+	attr := "address"
+	if strings.Contains(m.Address, ":") {
+		attr = "address6"
+	}
+	fmt.Fprintf(w, "  %s = %s\n", attr, icingaQuote(m.Address))
+	if m.DisplayName != m.Name {
+		fmt.Fprintf(w, "  display_name = %s\n", icingaQuote(m.DisplayName))
+	}
+
+	if len(m.Groups) > 0 {
+		quoted := make([]string, len(m.Groups))
+		for i, g := range m.Groups {
+			quoted[i] = icingaQuote(g)
+		}
+		fmt.Fprintf(w, "  groups = [ %s ]\n", strings.Join(quoted, ", "))
+	}
+	for _, name := range sortedVars(m.Vars) {
+		fmt.Fprintf(w, "  vars.%s = %s\n", name, icingaQuote(m.Vars[name]))
+	}
+	fmt.Fprintln(w, "}")
+}
+
+func writeNagiosHost(w io.Writer, m monitorHost, hostTemplate string) {
+	line := func(directive, value string) {
+		fmt.Fprintf(w, "    %-15s %s\n", directive, value)
+	}
+
+	fmt.Fprintln(w, "\ndefine host {")
+	if hostTemplate != "" {
+		line("use", hostTemplate)
+	}
+	line("host_name", m.Name)
+	line("alias", m.DisplayName)
+	line("address", m.Address)
+	if len(m.Groups) > 0 {
+		line("hostgroups", strings.Join(m.Groups, ","))
+	}
+	for _, name := range sortedVars(m.Vars) {
+		// Nagios ends a directive at a newline, values are single lines
+		line("_"+strings.ToUpper(name), strings.Join(strings.Fields(m.Vars[name]), " "))
+	}
+	fmt.Fprintln(w, "}")
+}
+
+// icingaQuote returns s as an Icinga 2 string literal
+func icingaQuote(s string) string {
+	r := strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`, "\t", `\t`, "\r", `\r`)
+	return `"` + r.Replace(s) + `"`
+}
@@ -0,0 +1,188 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package node
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/ubccr/grendel/pkg/client"
+)
+
+func testMonitorHosts() []client.Host {
+	hosts := testExportHosts()
+	hosts[0].BootImage = client.NewOptString("rocky9")
+	hosts[0].Interfaces[0].Value.Fqdn = client.NewOptString("cpn-01.example.com")
+
+	return append([]client.Host{
+		{
+			Name: client.NewOptString("cpn-03"),
+			Tags: client.NewOptNilStringArray([]string{"nomonitor"}),
+		},
+		{
+			Name: client.NewOptString("cpn-02"),
+			Tags: client.NewOptNilStringArray([]string{"gpu", "cpu-vendor=amd", `owner:chem "lab"`}),
+			Interfaces: []client.NilHostInterfacesItem{
+				client.NewNilHostInterfacesItem(client.HostInterfacesItem{
+					Ifname: client.NewOptString("eno1"),
+					IP:     client.NewOptString("fd00::2/64"),
+				}),
+				client.NewNilHostInterfacesItem(client.HostInterfacesItem{
+					Ifname: client.NewOptString("ib0"),
+					IP:     client.NewOptString("10.2.0.2/16"),
+					Fqdn:   client.NewOptString("cpn-02-ib.example.com"),
+				}),
+			},
+		},
+	}, hosts...)
+}
+
+func TestExportIcinga2(t *testing.T) {
+	var buf bytes.Buffer
+	err := writeMonitor(&buf, testMonitorHosts(), monitorOptions{Dialect: "icinga2", HostTemplate: defaultHostTemplate, ExcludeTag: defaultMonitorExcludeTag})
+	require.NoError(t, err)
+
+	assert.Equal(t, `// generated by grendel node export --format icinga2
+
+object HostGroup "bmc" {
+}
+
+object HostGroup "gpu" {
+}
+
+object HostGroup "ib" {
+}
+
+object Host "cpn-01" {
+  import "generic-host"
+  address = "10.0.0.1"
+  display_name = "cpn-01.example.com"
+  groups = [ "ib" ]
+  vars.bootimage = "rocky9"
+  vars.rack = "a01"
+}
+
+object Host "cpn-01-bmc" {
+  import "generic-host"
+  address = "10.0.1.1"
+  display_name = "bmc-cpn-01.example.com"
+  groups = [ "bmc" ]
+  vars.bootimage = "rocky9"
+  vars.node = "cpn-01"
+  vars.rack = "a01"
+}
+
+object Host "cpn-02" {
+  import "generic-host"
+  address6 = "fd00::2"
+  groups = [ "gpu" ]
+  vars.cpu_vendor = "amd"
+  vars.owner = "chem \"lab\""
+}
+`, buf.String())
+}
+
+func TestExportNagios(t *testing.T) {
+	dir := t.TempDir()
+	services := filepath.Join(dir, "services.tmpl")
+	err := os.WriteFile(services, []byte(`{{if not .BMC}}define service {
+    use                 generic-service
+    host_name           {{.Name}}
+    service_description ssh {{join .Groups ","}}{{with .Vars.rack}} rack {{.}}{{end}}
+    check_command       check_ssh
+}
+{{end}}`), 0644)
+	require.NoError(t, err)
+
+	var buf bytes.Buffer
+	err = writeMonitor(&buf, testMonitorHosts(), monitorOptions{Dialect: "nagios", Interface: "ib0", ServiceTemplate: services})
+	require.NoError(t, err)
+
+	assert.Equal(t, `# generated by grendel node export --format nagios
+
+define hostgroup {
+    hostgroup_name  bmc
+    alias           bmc
+}
+
+define hostgroup {
+    hostgroup_name  gpu
+    alias           gpu
+}
+
+define hostgroup {
+    hostgroup_name  ib
+    alias           ib
+}
+
+define hostgroup {
+    hostgroup_name  nomonitor
+    alias           nomonitor
+}
+
+define host {
+    host_name       cpn-01
+    alias           cpn-01
+    address         cpn-01
+    hostgroups      ib
+    _BOOTIMAGE      rocky9
+    _RACK           a01
+}
+
+define host {
+    host_name       cpn-01-bmc
+    alias           bmc-cpn-01.example.com
+    address         10.0.1.1
+    hostgroups      bmc
+    _BOOTIMAGE      rocky9
+    _NODE           cpn-01
+    _RACK           a01
+}
+
+define host {
+    host_name       cpn-02
+    alias           cpn-02-ib.example.com
+    address         10.2.0.2
+    hostgroups      gpu
+    _CPU_VENDOR     amd
+    _OWNER          chem "lab"
+}
+
+define host {
+    host_name       cpn-03
+    alias           cpn-03
+    address         cpn-03
+    hostgroups      nomonitor
+}
+
+define service {
+    use                 generic-service
+    host_name           cpn-01
+    service_description ssh ib rack a01
+    check_command       check_ssh
+}
+
+define service {
+    use                 generic-service
+    host_name           cpn-02
+    service_description ssh gpu
+    check_command       check_ssh
+}
+
+define service {
+    use                 generic-service
+    host_name           cpn-03
+    service_description ssh nomonitor
+    check_command       check_ssh
+}
+`, buf.String())
+
+	err = writeMonitor(&buf, testMonitorHosts(), monitorOptions{Dialect: "checkmk"})
+	assert.Error(t, err)
+}
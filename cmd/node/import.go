@@ -5,59 +5,218 @@
 package node
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
-	"fmt"
 	"os"
+	"strings"
 
 	"github.com/spf13/cobra"
 	"github.com/ubccr/grendel/cmd"
 	"github.com/ubccr/grendel/pkg/client"
+	"github.com/ubccr/grendel/pkg/model"
 )
 
 var (
-	importCmd = &cobra.Command{
-		Use:   "import <filenames>...",
+	importForce    bool
+	importFrom     string
+	importDir      string
+	importImageMap string
+	importDryRun   bool
+	importReport   string
+	importCmd      = &cobra.Command{
+		Use:   "import {<filenames>... | --from <format> --dir <path>}",
 		Short: "import nodes",
-		Args:  cobra.MinimumNArgs(1),
+		Long: `Import nodes from JSON files as written by node show, or convert the
+systems of a Cobbler, xCAT or Warewulf inventory, or the dhcp-host lines of
+a dnsmasq configuration, with --from. Interfaces in the JSON files with an IP
+of auto:<subnet> get the next free address of the subnet, see node nextip.
+
+--from cobbler reads the systems/*.json and profiles/*.json files of --dir,
+such as /var/lib/cobbler/collections. --from xcat reads the tabdump output of
+the nodelist, mac, hosts, nodetype, bootparams, ipmi, noderes and networks
+tables saved as <table>.csv in --dir. --from warewulf reads nodes.conf, or
+the output of wwctl node export, at --dir. --from dnsmasq reads the
+dhcp-host lines of a dnsmasq configuration file, or of the *.conf files of
+--dir. Lines are grouped into hosts by the "# host <name>" comments written
+by node export --format dnsmasq, or else by hostname. FQDNs come from
+host-record lines and netmasks from static dhcp-range lines. The
+grendel-provision, grendel-bmc and grendel-fw-<firmware> tags written by the
+export set provision, BMC interfaces and the firmware, so exported hosts
+round-trip.
+
+Interfaces get their MAC, IP address and FQDN, the BMC address becomes a BMC
+interface. Profiles, osimages and Warewulf images are mapped to boot images
+by --image-map, a YAML or JSON file of <profile>: <image> entries. Hosts have
+no kernel arguments or variables of their own: kernel options are kept in a
+kernel_args=<options> tag and ks_meta, autoinstall_meta and Warewulf tags
+become <key>=<value> tags. Groups and mgmt classes become tags.
+
+Everything that could not be mapped is listed in the conversion report,
+logged and written to --report. --dry-run prints the converted nodes as JSON
+without importing them, they can be edited and imported as a file.
+Nodes that already exist are not imported.`,
+		Example: `  grendel node import --from cobbler --dir /var/lib/cobbler/collections --image-map images.yaml --dry-run > nodes.json
+  grendel node import --from xcat --dir ./tabdump --report report.txt
+  grendel node import --from warewulf --dir /etc/warewulf/nodes.conf
+  grendel node import --from dnsmasq --dir /etc/dnsmasq.d --dry-run`,
+		Args: func(command *cobra.Command, args []string) error {
+			if importFrom != "" {
+				return cobra.NoArgs(command, args)
+			}
+			return cobra.MinimumNArgs(1)(command, args)
+		},
 		RunE: func(command *cobra.Command, args []string) error {
+			if importFrom != "" {
+				return importInventory()
+			}
+
 			gc, err := cmd.NewOgenClient()
 			if err != nil {
 				return err
 			}
 
+			result := cmd.NewMutationList()
 			for _, name := range args {
-				file, err := os.Open(name)
-				if err != nil {
-					fmt.Printf("failed to open file. name=%s err=%s", name, err)
-				}
-				defer file.Close()
-
-				var nodes []client.NilNodeAddRequestNodeListItem
-				if err := json.NewDecoder(file).Decode(&nodes); err != nil {
-					return err
-				}
-
-				req := &client.NodeAddRequest{
-					NodeList: nodes,
-				}
-				params := client.POSTV1NodesParams{}
-				res, err := gc.POSTV1Nodes(context.Background(), req, params)
+				res, err := importNodes(gc, name)
 				if err != nil {
-					return cmd.NewApiError(err)
-				}
-
-				err = cmd.NewApiResponse(res)
-				if err != nil {
-					return err
+					cmd.Log.Errorf("failed to import file. name=%s err=%s", name, err)
+				} else if !cmd.JSONOutput() {
+					cmd.NewApiResponse(res)
 				}
+				result.AddResponse(name, res, err)
 			}
-			return nil
 
+			return cmd.NewMutationResponse(result)
 		},
 	}
 )
 
 func init() {
+	importCmd.Flags().BoolVar(&importForce, "force", false, "overwrite nodes even if their revision does not match")
+	importCmd.Flags().StringVar(&importFrom, "from", "", "convert an inventory: "+strings.Join(migrateFormats, ", "))
+	importCmd.Flags().StringVar(&importDir, "dir", "", "inventory directory, or nodes.conf with --from warewulf or a configuration file with --from dnsmasq")
+	importCmd.Flags().StringVar(&importImageMap, "image-map", "", "YAML or JSON file mapping profiles to boot images")
+	importCmd.Flags().BoolVar(&importDryRun, "dry-run", false, "print the converted nodes as JSON without importing them")
+	importCmd.Flags().StringVar(&importReport, "report", "", "write the conversion report to a file")
+	importCmd.MarkFlagsRequiredTogether("from", "dir")
+	importCmd.RegisterFlagCompletionFunc("from", cobra.FixedCompletions(migrateFormats, cobra.ShellCompDirectiveNoFileComp))
 	nodeCmd.AddCommand(importCmd)
 }
+
+// importInventory converts the inventory of --from and imports the nodes not
+// in grendel yet
+func importInventory() error {
+	images, err := readImageMap(importImageMap)
+	if err != nil {
+		return err
+	}
+
+	hosts, report, err := convertInventory(importFrom, importDir, images)
+	if err != nil {
+		return err
+	}
+
+	var gc *client.Client
+	if !importDryRun {
+		gc, err = cmd.NewOgenClient()
+		if err != nil {
+			return err
+		}
+
+		existing, err := gc.HostList(context.Background(), client.HostFilter{})
+		if err != nil {
+			return err
+		}
+		names := make(map[string]bool, len(existing))
+		for _, h := range existing {
+			names[h.Name.Value] = true
+		}
+
+		filtered := make(model.HostList, 0, len(hosts))
+		for _, h := range hosts {
+			if names[h.Name] {
+				report.add(h.Name, "already exists in grendel, not imported")
+				continue
+			}
+			filtered = append(filtered, h)
+		}
+		hosts = filtered
+	}
+
+	if importReport != "" {
+		var buf bytes.Buffer
+		report.write(&buf)
+		if err := os.WriteFile(importReport, buf.Bytes(), 0644); err != nil {
+			return err
+		}
+	}
+	for _, n := range report.Notes {
+		cmd.Log.Warnf("%s: %s", n.Host, n.Message)
+	}
+	cmd.Log.Infof("Converted %d node(s) from %s, %d item(s) in the conversion report", len(hosts), importFrom, len(report.Notes))
+
+	if importDryRun {
+		return cmd.WriteJSON(os.Stdout, hosts)
+	}
+
+	result := cmd.NewMutationList()
+	if len(hosts) == 0 {
+		return cmd.NewMutationResponse(result)
+	}
+
+	data, err := json.Marshal(hosts)
+	if err != nil {
+		return err
+	}
+	var nodes []client.NilNodeAddRequestNodeListItem
+	if err := json.Unmarshal(data, &nodes); err != nil {
+		return err
+	}
+
+	res, err := gc.POSTV1Nodes(context.Background(), &client.NodeAddRequest{NodeList: nodes}, client.POSTV1NodesParams{})
+	if err != nil {
+		err = cmd.NewApiError(err)
+		cmd.Log.Errorf("failed to import nodes. err=%s", err)
+	} else if !cmd.JSONOutput() {
+		cmd.NewApiResponse(res)
+	}
+	result.AddResponse(importFrom, res, err)
+
+	return cmd.NewMutationResponse(result)
+}
+
+func importNodes(gc *client.Client, name string) (*client.GenericResponse, error) {
+	file, err := os.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	var nodes []client.NilNodeAddRequestNodeListItem
+	if err := json.NewDecoder(file).Decode(&nodes); err != nil {
+		return nil, err
+	}
+
+	if err := resolveAutoIPs(gc, nodeIPs(nodes)); err != nil {
+		return nil, err
+	}
+
+	// Without a revision the nodes are saved unconditionally
+	if importForce {
+		for i := range nodes {
+			nodes[i].Value.Revision = client.OptNilInt64{}
+		}
+	}
+
+	req := &client.NodeAddRequest{
+		NodeList: nodes,
+	}
+	params := client.POSTV1NodesParams{}
+	res, err := gc.POSTV1Nodes(context.Background(), req, params)
+	if err != nil {
+		return nil, cmd.NewConflictError(cmd.NewApiError(err))
+	}
+
+	return res, nil
+}
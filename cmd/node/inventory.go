@@ -0,0 +1,135 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package node
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/ubccr/grendel/cmd"
+	"github.com/ubccr/grendel/pkg/client"
+	"github.com/ubccr/grendel/pkg/model"
+	"github.com/ubccr/grendel/pkg/nodeset"
+)
+
+var (
+	inventoryField string
+	inventoryWant  string
+	inventoryCmd   = &cobra.Command{
+		Use:   "inventory",
+		Short: "Node firmware inventory",
+		Long: `Report on the firmware inventory of nodes. The inventory is collected by
+"bmc status" and by nodes posting to the inventory provision endpoint.`,
+	}
+	inventoryReportCmd = &cobra.Command{
+		Use:   "report {nodeset | all} --field <field> --want <version>",
+		Short: "Report nodes with stale firmware",
+		Long: `Report the nodes with and without the wanted firmware version. Fields are
+bios, bmc and nic. Nodes with several network adapters comply if all of them
+have the wanted version. Nodes without a version for the field are reported
+as unknown.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(command *cobra.Command, args []string) error {
+			if !slices.Contains(model.InventoryFields, inventoryField) {
+				return fmt.Errorf("invalid field %q. Valid fields: %s", inventoryField, strings.Join(model.InventoryFields, ", "))
+			}
+
+			gc, err := cmd.NewOgenClient()
+			if err != nil {
+				return err
+			}
+
+			ns, err := cmd.NodesetArg(args[0])
+			if err != nil {
+				return err
+			}
+
+			res, err := gc.HostList(context.Background(), client.HostFilter{
+				Nodeset: ns,
+				Tags:    tags,
+			})
+			if err != nil {
+				return err
+			}
+
+			report := newInventoryReport(res, inventoryField, inventoryWant)
+			if cmd.JSONOutput() {
+				return cmd.Output(map[string]string{
+					"compliant":     report.Compliant.String(),
+					"non_compliant": report.NonCompliant.String(),
+					"unknown":       report.Unknown.String(),
+				})
+			}
+
+			fmt.Printf("Compliant (%d): %s\n", report.Compliant.Len(), report.Compliant.String())
+			fmt.Printf("Non-compliant (%d): %s\n", report.NonCompliant.Len(), report.NonCompliant.String())
+			if report.Unknown.Len() > 0 {
+				fmt.Printf("Unknown (%d): %s\n", report.Unknown.Len(), report.Unknown.String())
+			}
+
+			return nil
+		},
+	}
+)
+
+// inventoryReport groups nodes by whether their firmware is the wanted version
+type inventoryReport struct {
+	Compliant    *nodeset.NodeSet
+	NonCompliant *nodeset.NodeSet
+	Unknown      *nodeset.NodeSet
+}
+
+func newInventoryReport(hosts []client.Host, field, want string) inventoryReport {
+	report := inventoryReport{
+		Compliant:    nodeset.EmptyNodeSet(),
+		NonCompliant: nodeset.EmptyNodeSet(),
+		Unknown:      nodeset.EmptyNodeSet(),
+	}
+
+	for _, host := range hosts {
+		inv := hostInventory(host)
+		versions, _ := inv.Versions(field)
+		if len(versions) == 0 {
+			report.Unknown.Add(host.Name.Value)
+			continue
+		}
+
+		if ok, _ := inv.Complies(field, want); ok {
+			report.Compliant.Add(host.Name.Value)
+		} else {
+			report.NonCompliant.Add(host.Name.Value)
+		}
+	}
+
+	return report
+}
+
+// hostInventory returns the firmware inventory of a host, which is empty if
+// the host has none
+func hostInventory(host client.Host) *model.Inventory {
+	if !host.Inventory.Set || host.Inventory.Null {
+		return &model.Inventory{}
+	}
+
+	inv := host.Inventory.Value
+	return &model.Inventory{
+		BIOSVersion: inv.BiosVersion.Value,
+		BMCFirmware: inv.BmcFirmware.Value,
+		NICFirmware: inv.NicFirmware.Value,
+		CapturedAt:  inv.CapturedAt.Value,
+	}
+}
+
+func init() {
+	inventoryReportCmd.Flags().StringVar(&inventoryField, "field", model.InventoryFieldBIOS, "firmware field: "+strings.Join(model.InventoryFields, ", "))
+	inventoryReportCmd.Flags().StringVar(&inventoryWant, "want", "", "wanted firmware version")
+	inventoryReportCmd.MarkFlagRequired("want")
+
+	inventoryCmd.AddCommand(inventoryReportCmd)
+	nodeCmd.AddCommand(inventoryCmd)
+}
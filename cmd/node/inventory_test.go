@@ -0,0 +1,36 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package node
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/ubccr/grendel/pkg/client"
+	"github.com/ubccr/grendel/pkg/model"
+)
+
+func TestInventoryReport(t *testing.T) {
+	assert := assert.New(t)
+
+	hosts := make([]client.Host, 0)
+	for i, bios := range []string{"2.19.0", "2.19.0", "2.18.1", "", "2.19.0"} {
+		host := client.Host{Name: client.NewOptString(fmt.Sprintf("cpn-%02d", i+1))}
+		if bios != "" {
+			host.Inventory = client.NewOptNilHostInventory(client.HostInventory{BiosVersion: client.NewOptString(bios)})
+		}
+		hosts = append(hosts, host)
+	}
+
+	report := newInventoryReport(hosts, model.InventoryFieldBIOS, "2.19.0")
+	assert.Equal("cpn-[01-02,05]", report.Compliant.String())
+	assert.Equal("cpn-03", report.NonCompliant.String())
+	assert.Equal("cpn-04", report.Unknown.String())
+
+	report = newInventoryReport(hosts, model.InventoryFieldBMC, "7.00.00.171")
+	assert.Equal(0, report.Compliant.Len())
+	assert.Equal(5, report.Unknown.Len())
+}
@@ -0,0 +1,61 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package node
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/ubccr/grendel/cmd"
+	"github.com/ubccr/grendel/pkg/client"
+)
+
+var (
+	logCmd = &cobra.Command{
+		Use:   "log <nodeset>",
+		Short: "Show the log of nodes",
+		Long: `Show the log of nodes, oldest first. The log holds the critical Redfish
+events pushed by the BMCs subscribed with "bmc subscribe", up to 200 entries
+per node.`,
+		Example: `  grendel node log cpn-d13-[01-64]`,
+		Args:    cobra.ExactArgs(1),
+		RunE: func(command *cobra.Command, args []string) error {
+			gc, err := cmd.NewOgenClient()
+			if err != nil {
+				return err
+			}
+
+			res, err := gc.GETV1NodesLog(context.Background(), client.GETV1NodesLogParams{
+				Nodeset: client.NewOptString(args[0]),
+				Tags:    client.NewOptString(strings.Join(tags, ",")),
+			})
+			if err != nil {
+				return cmd.NewApiError(err)
+			}
+
+			if cmd.JSONOutput() {
+				return cmd.Output(res)
+			}
+
+			w := tabwriter.NewWriter(os.Stdout, 0, 0, 4, ' ', 0)
+			fmt.Fprintln(w, "HOST\tTIME\tSEVERITY\tMESSAGE ID\tMESSAGE")
+			for _, e := range res {
+				fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", e.Host.Value, e.Time.Value.Local().Format(time.DateTime),
+					e.Severity.Value, e.MessageID.Value, e.Message.Value)
+			}
+
+			return w.Flush()
+		},
+	}
+)
+
+func init() {
+	nodeCmd.AddCommand(logCmd)
+}
@@ -0,0 +1,273 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package node
+
+import (
+	"fmt"
+	"io"
+	"net"
+	"net/netip"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/ubccr/grendel/internal/config"
+	"github.com/ubccr/grendel/pkg/model"
+	"gopkg.in/yaml.v3"
+)
+
+// kernelArgsTag is the tag holding the kernel options of an imported host.
+// Hosts have no kernel arguments of their own, boot image cmdline templates
+// can read the tag from .host.Tags
+const kernelArgsTag = "kernel_args"
+
+// migrateFormats are the inventories read by node import --from
+var migrateFormats = []string{"cobbler", "xcat", "warewulf", "dnsmasq"}
+
+// migrateNote is a line of the conversion report
+type migrateNote struct {
+	Host    string `json:"host"`
+	Message string `json:"message"`
+}
+
+// migrateReport lists what could not be mapped while converting an inventory
+type migrateReport struct {
+	Notes []migrateNote
+}
+
+func (r *migrateReport) add(host, format string, args ...any) {
+	r.Notes = append(r.Notes, migrateNote{Host: host, Message: fmt.Sprintf(format, args...)})
+}
+
+func (r *migrateReport) write(w io.Writer) {
+	for _, n := range r.Notes {
+		fmt.Fprintf(w, "%s: %s\n", n.Host, n.Message)
+	}
+}
+
+// imageMap maps the profiles, osimages and container names of an inventory
+// to boot images
+type imageMap map[string]string
+
+// readImageMap reads a YAML or JSON file of profile: image entries
+func readImageMap(name string) (imageMap, error) {
+	images := make(imageMap)
+	if name == "" {
+		return images, nil
+	}
+
+	data, err := os.ReadFile(name)
+	if err != nil {
+		return nil, err
+	}
+	if err := yaml.Unmarshal(data, &images); err != nil {
+		return nil, fmt.Errorf("invalid image map %s: %w", name, err)
+	}
+
+	return images, nil
+}
+
+// bootImage sets the boot image of host from the first of keys in the map
+func (m imageMap) bootImage(host *model.Host, r *migrateReport, kind string, keys ...string) {
+	wanted := make([]string, 0, len(keys))
+	for _, key := range keys {
+		if key == "" {
+			continue
+		}
+		if image, ok := m[key]; ok {
+			host.BootImage = image
+			return
+		}
+		wanted = append(wanted, strconv.Quote(key))
+	}
+
+	if len(wanted) > 0 {
+		r.add(host.Name, "%s %s has no boot image in the image map", kind, strings.Join(wanted, ", "))
+	}
+}
+
+// migratePrefix returns ip with the prefix length of netmask, a dotted mask
+// or a length. Without a netmask the length of the dhcp.subnets entry
+// containing ip is used
+func migratePrefix(ip, netmask string) (netip.Prefix, error) {
+	addr, err := netip.ParseAddr(strings.TrimSpace(ip))
+	if err != nil {
+		if prefix, perr := netip.ParsePrefix(strings.TrimSpace(ip)); perr == nil {
+			return prefix, nil
+		}
+		return netip.Prefix{}, fmt.Errorf("invalid IP address %q", ip)
+	}
+
+	netmask = strings.TrimSpace(netmask)
+	if netmask != "" {
+		bits, err := strconv.Atoi(strings.TrimPrefix(netmask, "/"))
+		if err != nil {
+			mask := net.ParseIP(netmask).To4()
+			if mask == nil {
+				return netip.Prefix{}, fmt.Errorf("invalid netmask %q of %s", netmask, ip)
+			}
+			ones, size := net.IPMask(mask).Size()
+			if size == 0 {
+				return netip.Prefix{}, fmt.Errorf("invalid netmask %q of %s", netmask, ip)
+			}
+			bits = ones
+		}
+
+		if bits < 0 || bits > addr.BitLen() {
+			return netip.Prefix{}, fmt.Errorf("invalid netmask %q of %s", netmask, ip)
+		}
+
+		return netip.PrefixFrom(addr, bits), nil
+	}
+
+	for _, s := range config.Current().Subnets {
+		if s.Gateway.Masked().Contains(addr) {
+			return netip.PrefixFrom(addr, s.Gateway.Bits()), nil
+		}
+	}
+
+	return netip.Prefix{}, fmt.Errorf("no netmask for %s and no dhcp.subnets entry contains it", ip)
+}
+
+// migrateInterface returns an interface of host, nil when it has neither a
+// valid MAC nor a valid address
+func migrateInterface(host string, r *migrateReport, name, mac, ip, netmask, fqdn, mtu string) *model.NetInterface {
+	nic := &model.NetInterface{Name: name, FQDN: strings.TrimSpace(fqdn)}
+	mac, ip = strings.TrimSpace(mac), strings.TrimSpace(ip)
+
+	// Interfaces without a name are reported by their MAC or address
+	label := name
+	if label == "" {
+		label = mac
+	}
+	if label == "" {
+		label = ip
+	}
+
+	if mac != "" {
+		hwaddr, err := net.ParseMAC(mac)
+		if err != nil {
+			r.add(host, "interface %s: invalid MAC address %q", label, mac)
+		} else {
+			nic.MAC = hwaddr
+		}
+	}
+
+	if ip != "" {
+		prefix, err := migratePrefix(ip, netmask)
+		if err != nil {
+			r.add(host, "interface %s: %s, address not imported", label, err)
+		} else {
+			nic.IP = prefix
+		}
+	}
+
+	if mtu = strings.TrimSpace(mtu); mtu != "" {
+		n, err := strconv.ParseUint(mtu, 10, 16)
+		if err != nil {
+			r.add(host, "interface %s: invalid MTU %q", label, mtu)
+		} else {
+			nic.MTU = uint16(n)
+		}
+	}
+
+	if nic.MAC == nil && !nic.IP.IsValid() {
+		r.add(host, "interface %s has no MAC or IP address, skipped", label)
+		return nil
+	}
+
+	return nic
+}
+
+// migrateBMC returns a BMC interface for address, an IP address or a host
+// name. Inventories only know the BMC address, the MAC is learned later
+func migrateBMC(host string, r *migrateReport, address, netmask string) *model.NetInterface {
+	address = strings.TrimSpace(address)
+	if address == "" {
+		return nil
+	}
+
+	if _, err := netip.ParseAddr(address); err != nil {
+		return &model.NetInterface{BMC: true, FQDN: address}
+	}
+
+	nic := migrateInterface(host, r, "bmc", "", address, netmask, "", "")
+	if nic != nil {
+		nic.BMC = true
+	}
+
+	return nic
+}
+
+// varTags returns key=value tags for vars, sorted by key
+func varTags(vars map[string]string) []string {
+	tags := make([]string, 0, len(vars))
+	for key, value := range vars {
+		tags = append(tags, key+"="+value)
+	}
+	sort.Strings(tags)
+
+	return tags
+}
+
+// addTag adds tag to host unless it already has it
+func addTag(host *model.Host, tags ...string) {
+	for _, tag := range tags {
+		if tag != "" && !host.HasTags(tag) {
+			host.Tags = append(host.Tags, tag)
+		}
+	}
+}
+
+// setFQDN gives the first interface with a MAC address the FQDN name, unless
+// it has one
+func setFQDN(host *model.Host, name string) {
+	if name == "" {
+		return
+	}
+
+	for _, nic := range host.Interfaces {
+		if nic.MAC == nil || nic.BMC {
+			continue
+		}
+		if nic.FQDN == "" {
+			nic.FQDN = name
+		}
+		return
+	}
+}
+
+// convertInventory reads the inventory of format at path
+func convertInventory(format, path string, images imageMap) (model.HostList, *migrateReport, error) {
+	r := &migrateReport{}
+
+	var hosts model.HostList
+	var err error
+	switch format {
+	case "cobbler":
+		hosts, err = convertCobbler(path, images, r)
+	case "xcat":
+		hosts, err = convertXCAT(path, images, r)
+	case "warewulf":
+		hosts, err = convertWarewulf(path, images, r)
+	case "dnsmasq":
+		hosts, err = convertDnsmasq(path, r)
+	default:
+		return nil, nil, fmt.Errorf("invalid format %q. Valid formats: %s", format, strings.Join(migrateFormats, ", "))
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+
+	sort.SliceStable(hosts, func(i, j int) bool { return hosts[i].Name < hosts[j].Name })
+	for _, host := range hosts {
+		if len(host.Interfaces) == 0 {
+			r.add(host.Name, "no interfaces, the host cannot boot from grendel")
+		}
+	}
+
+	return hosts, r, nil
+}
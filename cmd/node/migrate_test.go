@@ -0,0 +1,286 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package node
+
+import (
+	"net/netip"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/ubccr/grendel/internal/config"
+	"github.com/ubccr/grendel/pkg/model"
+)
+
+func writeFiles(t *testing.T, files map[string]string) string {
+	dir := t.TempDir()
+	for name, data := range files {
+		path := filepath.Join(dir, name)
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0755))
+		require.NoError(t, os.WriteFile(path, []byte(data), 0644))
+	}
+
+	return dir
+}
+
+func notes(r *migrateReport) []string {
+	lines := make([]string, 0, len(r.Notes))
+	for _, n := range r.Notes {
+		lines = append(lines, n.Host+": "+n.Message)
+	}
+
+	return lines
+}
+
+func nicSummary(nics []*model.NetInterface) []string {
+	out := make([]string, 0, len(nics))
+	for _, n := range nics {
+		ip := ""
+		if n.IP.IsValid() {
+			ip = n.IP.String()
+		}
+		s := n.Name + " " + n.MAC.String() + " " + ip + " " + n.FQDN
+		if n.BMC {
+			s += " bmc"
+		}
+		out = append(out, s)
+	}
+
+	return out
+}
+
+func TestConvertCobbler(t *testing.T) {
+	// power_address has no netmask, it comes from dhcp.subnets
+	cfg := *config.Current()
+	cfg.Subnets = []config.Subnet{{Gateway: netip.MustParsePrefix("10.2.0.254/24")}}
+	prev := config.Set(&cfg)
+	t.Cleanup(func() { config.Set(prev) })
+
+	dir := writeFiles(t, map[string]string{
+		"profiles/compute.json": `{"name": "compute", "parent": "base", "kernel_options": {"console": "ttyS0,115200"}, "autoinstall_meta": {"tree": "http://repo/rhel9"}}`,
+		"profiles/base.json":    `{"name": "base", "kernel_options": "quiet nomodeset"}`,
+		"systems/cpn-01.json": `{
+			"name": "cpn-01", "hostname": "cpn-01.hpc.example.com", "profile": "compute", "netboot_enabled": true,
+			"kernel_options": {"console": ["tty0", "ttyS0"], "!quiet": "~", "rd.break": "~"},
+			"ks_meta": "owner=lab",
+			"power_address": "10.2.0.1",
+			"interfaces": {
+				"eth0": {"mac_address": "D0:94:66:00:00:01", "ip_address": "10.1.0.1", "netmask": "255.255.255.0", "mtu": "9000"},
+				"eth1": {"mac_address": "", "ip_address": ""},
+				"ib0": {"mac_address": "zz", "ip_address": "10.3.0.1", "netmask": "16"}
+			}
+		}`,
+		"systems/gpu-01.json": `{
+			"name": "gpu-01", "profile": "gpu", "kernel_options": "<<inherit>>", "mgmt_classes": ["gpu"],
+			"interfaces": {
+				"bond0": {"interface_type": "bond", "ip_address": "10.1.0.2", "netmask": "24", "bonding_opts": "mode=802.3ad miimon=100"},
+				"eth0": {"mac_address": "d0:94:66:00:00:02", "interface_type": "bond_slave", "interface_master": "bond0"},
+				"idrac": {"mac_address": "d0:94:66:00:01:02", "interface_type": "bmc", "ip_address": "10.2.0.2", "netmask": "24"}
+			}
+		}`,
+	})
+
+	hosts, r, err := convertInventory("cobbler", dir, imageMap{"compute": "rocky-9"})
+	require.NoError(t, err)
+	require.Len(t, hosts, 2)
+
+	cpn := hosts[0]
+	assert.Equal(t, "cpn-01", cpn.Name)
+	assert.True(t, cpn.Provision)
+	assert.Equal(t, "rocky-9", cpn.BootImage)
+	assert.Equal(t, []string{"kernel_args=console=tty0 console=ttyS0 nomodeset rd.break", "owner=lab", "tree=http://repo/rhel9"}, cpn.Tags)
+	assert.Equal(t, []string{
+		"eth0 d0:94:66:00:00:01 10.1.0.1/24 cpn-01.hpc.example.com",
+		"ib0  10.3.0.1/16 ",
+		"bmc  10.2.0.1/24  bmc",
+	}, nicSummary(cpn.Interfaces))
+	assert.Equal(t, uint16(9000), cpn.Interfaces[0].MTU)
+
+	gpu := hosts[1]
+	assert.Empty(t, gpu.BootImage)
+	assert.Equal(t, []string{"gpu"}, gpu.Tags)
+	assert.Equal(t, []string{
+		"eth0 d0:94:66:00:00:02  ",
+		"idrac d0:94:66:00:01:02 10.2.0.2/24  bmc",
+	}, nicSummary(gpu.Interfaces))
+	if assert.Len(t, gpu.Bonds, 1) {
+		assert.Equal(t, "bond0", gpu.Bonds[0].Name)
+		assert.Equal(t, "10.1.0.2/24", gpu.Bonds[0].IP.String())
+		assert.Equal(t, []string{"eth0"}, gpu.Bonds[0].Peers)
+		assert.Equal(t, "802.3ad", gpu.Bonds[0].Mode)
+		assert.Equal(t, map[string]string{"miimon": "100"}, gpu.Bonds[0].Options)
+	}
+
+	assert.Equal(t, []string{
+		"cpn-01: interface eth1 has no MAC or IP address, skipped",
+		`cpn-01: interface ib0: invalid MAC address "zz"`,
+		`gpu-01: profile "gpu" not found, kernel options and metadata inherited from it are not imported`,
+		`gpu-01: profile "gpu" has no boot image in the image map`,
+	}, notes(r))
+}
+
+func TestConvertXCAT(t *testing.T) {
+	dir := writeFiles(t, map[string]string{
+		"nodelist.csv": `#node,groups,status,statustime,appstatus,appstatustime,primarysn,hidden,updatestatus,updatestatustime,zonename,comments,disable
+"cn01","compute,all",,,,,,,,,,,
+"cn02","compute,all",,,,,,,,,,,
+"old01","all",,,,,,,,,,,"1"
+`,
+		"mac.csv": `#node,interface,mac,comments,disable
+"cn01","eth0","d0:94:66:00:00:01|d0:94:66:00:00:11!cn01-data",,
+"cn02",,"d0:94:66:00:00:02",,
+`,
+		"hosts.csv": `#node,ip,hostnames,otherinterfaces,comments,disable
+"cn01","10.1.0.1","cn01.hpc.example.com","-ib0:10.3.0.1",,
+"cn02","10.1.0.2",,,,
+"|cn(\d+)|10.1.0.($1+0)|",,,,,
+`,
+		"nodetype.csv": `#node,os,arch,profile,provmethod,supportedarchs,nodetype,comments,disable
+"compute","rhels9","x86_64","compute","rhels9-x86_64-netboot-compute",,,,
+`,
+		"bootparams.csv": `#node,kernel,initrd,kcmdline,addkcmdline,dhcpstatements,adddhcpstatements,comments,disable
+"cn02",,,,"console=ttyS0",,,,
+`,
+		"ipmi.csv": `#node,bmc,bmcport,taggedvlan,bmcid,username,password,comments,disable
+"compute","",,,,,,,
+"cn01","10.2.0.1",,,,,,,
+`,
+		"networks.csv": `#netname,net,mask,mgtifname,gateway,dhcpserver,tftpserver,nameservers,ntpservers,logservers,dynamicrange,staticrange,staticrangeincrement,nodehostname,ddnsdomain,vlanid,domain,mtu,comments,disable
+"mgmt","10.1.0.0","255.255.255.0",,,,,,,,,,,,,,,,,
+"bmc","10.2.0.0","255.255.0.0",,,,,,,,,,,,,,,,,
+"ib","10.3.0.0","255.255.0.0",,,,,,,,,,,,,,,,,
+`,
+	})
+
+	hosts, r, err := convertInventory("xcat", dir, imageMap{"rhels9-x86_64-netboot-compute": "rhel-9"})
+	require.NoError(t, err)
+	require.Len(t, hosts, 2)
+
+	cn01 := hosts[0]
+	assert.Equal(t, "rhel-9", cn01.BootImage)
+	assert.Equal(t, []string{"compute", "arch=x86_64"}, cn01.Tags)
+	assert.Equal(t, []string{
+		"eth0 d0:94:66:00:00:01 10.1.0.1/24 cn01.hpc.example.com",
+		" d0:94:66:00:00:11  cn01-data",
+		"ib0  10.3.0.1/16 cn01-ib0",
+		"bmc  10.2.0.1/16  bmc",
+	}, nicSummary(cn01.Interfaces))
+
+	cn02 := hosts[1]
+	assert.Equal(t, []string{"compute", "kernel_args=console=ttyS0", "arch=x86_64"}, cn02.Tags)
+	assert.Equal(t, []string{" d0:94:66:00:00:02 10.1.0.2/24 "}, nicSummary(cn02.Interfaces))
+
+	assert.Equal(t, []string{
+		`hosts: row |cn(\d+)|10.1.0.($1+0)| uses a regular expression, not supported`,
+		"cn02: ipmi row without a bmc address",
+	}, notes(r))
+}
+
+func TestConvertWarewulf(t *testing.T) {
+	dir := writeFiles(t, map[string]string{
+		"nodes.conf": `WW_INTERNAL: 45
+nodeprofiles:
+  default:
+    container name: rocky-9
+    kernel:
+      args: quiet crashkernel=no
+    ipmi:
+      netmask: 255.255.255.0
+    network devices:
+      default:
+        device: eth0
+        netmask: 255.255.0.0
+    tags:
+      site: hpc
+  gpu:
+    kernel:
+      args:
+      - nouveau.modeset=0
+      - quiet
+nodes:
+  n001:
+    profiles:
+    - default
+    ipmi:
+      ipaddr: 10.2.0.1
+    network devices:
+      default:
+        hwaddr: d0:94:66:00:00:01
+        ipaddr: 10.1.0.1
+      ib:
+        device: ib0
+        ipaddr: 10.3.0.1
+        netmask: "16"
+        mtu: 65520
+    tags:
+      rack: a01
+  g001:
+    profiles:
+    - default
+    - gpu
+    - missing
+    image name: rocky-9-cuda
+    network devices:
+      default:
+        hwaddr: d0:94:66:00:00:02
+        ipaddr: 10.1.0.2
+`,
+	})
+
+	hosts, r, err := convertInventory("warewulf", dir, imageMap{"rocky-9": "rocky-9", "gpu": "rocky-9-gpu"})
+	require.NoError(t, err)
+	require.Len(t, hosts, 2)
+
+	g001 := hosts[0]
+	assert.Equal(t, "rocky-9-gpu", g001.BootImage)
+	assert.Equal(t, []string{"kernel_args=nouveau.modeset=0 quiet", "site=hpc"}, g001.Tags)
+
+	n001 := hosts[1]
+	assert.Equal(t, "rocky-9", n001.BootImage)
+	assert.Equal(t, []string{"kernel_args=quiet crashkernel=no", "rack=a01", "site=hpc"}, n001.Tags)
+	assert.Equal(t, []string{
+		"eth0 d0:94:66:00:00:01 10.1.0.1/16 ",
+		"ib0  10.3.0.1/16 ",
+		"bmc  10.2.0.1/24  bmc",
+	}, nicSummary(n001.Interfaces))
+	assert.Equal(t, uint16(65520), n001.Interfaces[1].MTU)
+
+	assert.Equal(t, []string{`g001: profile "missing" not found, settings inherited from it are not imported`}, notes(r))
+
+	// wwctl node export prints the nodes without the nodes key
+	dir = writeFiles(t, map[string]string{
+		"export.yaml": `n002:
+  network devices:
+    default:
+      hwaddr: d0:94:66:00:00:03
+      ipaddr: 10.1.0.3/24
+`,
+	})
+	hosts, r, err = convertInventory("warewulf", filepath.Join(dir, "export.yaml"), imageMap{})
+	require.NoError(t, err)
+	require.Len(t, hosts, 1)
+	assert.Equal(t, []string{" d0:94:66:00:00:03 10.1.0.3/24 "}, nicSummary(hosts[0].Interfaces))
+	assert.Empty(t, r.Notes)
+}
+
+func TestMigratePrefix(t *testing.T) {
+	for _, tc := range []struct{ ip, netmask, want string }{
+		{"10.1.0.1", "255.255.255.0", "10.1.0.1/24"},
+		{"10.1.0.1", "16", "10.1.0.1/16"},
+		{"10.1.0.1", "/20", "10.1.0.1/20"},
+		{"10.1.0.1/22", "", "10.1.0.1/22"},
+	} {
+		prefix, err := migratePrefix(tc.ip, tc.netmask)
+		require.NoError(t, err)
+		assert.Equal(t, tc.want, prefix.String())
+	}
+
+	_, err := migratePrefix("10.1.0.1", "")
+	assert.EqualError(t, err, "no netmask for 10.1.0.1 and no dhcp.subnets entry contains it")
+	_, err = migratePrefix("10.1.0.1", "255.0.255.0")
+	assert.Error(t, err)
+}
@@ -0,0 +1,157 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package node
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/ubccr/grendel/cmd"
+	"github.com/ubccr/grendel/internal/ipam"
+	"github.com/ubccr/grendel/pkg/client"
+)
+
+var (
+	nextIPSubnet  string
+	nextIPCount   int
+	nextIPReserve string
+	nextIPCmd     = &cobra.Command{
+		Use:   "nextip --subnet <cidr>",
+		Short: "Print the next free addresses of a subnet",
+		Long: `Print the lowest free addresses of a subnet, one per line.
+
+Addresses of nodes, nodes in the trash and DNS records are used, as are the
+network, broadcast and gateway addresses, addresses reserved by another
+nextip --reserve until the reservation expires and addresses declined by a
+node over DHCP as in use on the network, for 24 hours.
+
+--reserve holds the addresses for 1h, or the duration given as
+--reserve=30m, so operators adding nodes at the same time get different
+addresses. Reservations not used by a node when they expire are reported by
+grendel validate --runtime.
+
+node add, clone and import allocate an address the same way for an interface
+IP of auto:<subnet>, such as --ip auto:10.64.8.0/22.`,
+		Example: `  grendel node nextip --subnet 10.64.8.0/22 --count 32 --reserve
+  grendel node add --name cpn-01 --mac d0:94:66:12:34:56 --ip auto:10.64.8.0/22`,
+		Args: cobra.NoArgs,
+		RunE: func(command *cobra.Command, args []string) error {
+			gc, err := cmd.NewOgenClient()
+			if err != nil {
+				return err
+			}
+
+			req := &client.NodeNextIPRequest{
+				Subnet: nextIPSubnet,
+				Count:  client.NewOptInt(nextIPCount),
+			}
+			if nextIPReserve != "" {
+				req.Reserve = client.NewOptString(nextIPReserve)
+			}
+			res, err := gc.POSTV1NodesNextip(context.Background(), req, client.POSTV1NodesNextipParams{})
+			if err != nil {
+				return cmd.NewApiError(err)
+			}
+
+			if cmd.JSONOutput() {
+				return cmd.Output(res)
+			}
+
+			for _, ip := range res.Ips {
+				fmt.Println(ip)
+			}
+			if res.ReservedUntil.IsSet() && !res.ReservedUntil.IsNull() {
+				cmd.Log.Infof("Reserved %d address(es) until %s", len(res.Ips), res.ReservedUntil.Value.Local().Format(time.RFC3339))
+			}
+
+			return nil
+		},
+	}
+)
+
+func init() {
+	nextIPCmd.Flags().StringVar(&nextIPSubnet, "subnet", "", "subnet to allocate from, e.g. 10.64.8.0/22")
+	nextIPCmd.Flags().IntVar(&nextIPCount, "count", 1, "number of addresses")
+	nextIPCmd.Flags().StringVar(&nextIPReserve, "reserve", "", "reserve the addresses for the duration")
+	nextIPCmd.Flags().Lookup("reserve").NoOptDefVal = ipam.DefaultReserve.String()
+	nextIPCmd.MarkFlagRequired("subnet")
+	nodeCmd.AddCommand(nextIPCmd)
+}
+
+// resolveAutoIPs replaces the auto:<subnet> addresses of ips with free
+// addresses of the subnet, in order, with the prefix length of the subnet.
+// The addresses are reserved until the nodes are stored
+func resolveAutoIPs(gc *client.Client, ips []*string) error {
+	subnets := make([]netip.Prefix, 0)
+	pending := make(map[netip.Prefix][]*string)
+	for _, ip := range ips {
+		prefix, ok, err := ipam.ParseAuto(*ip)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			continue
+		}
+		if _, seen := pending[prefix]; !seen {
+			subnets = append(subnets, prefix)
+		}
+		pending[prefix] = append(pending[prefix], ip)
+	}
+
+	for _, prefix := range subnets {
+		req := &client.NodeNextIPRequest{
+			Subnet:  prefix.String(),
+			Count:   client.NewOptInt(len(pending[prefix])),
+			Reserve: client.NewOptString(ipam.DefaultReserve.String()),
+		}
+		res, err := gc.POSTV1NodesNextip(context.Background(), req, client.POSTV1NodesNextipParams{})
+		if err != nil {
+			return cmd.NewApiError(err)
+		}
+		if len(res.Ips) != len(pending[prefix]) {
+			return fmt.Errorf("subnet %s: expected %d addresses, got %d", prefix, len(pending[prefix]), len(res.Ips))
+		}
+
+		for i, ip := range pending[prefix] {
+			*ip = fmt.Sprintf("%s/%d", res.Ips[i], prefix.Bits())
+		}
+		cmd.Log.Debugf("Allocated %d address(es) of subnet %s", len(res.Ips), prefix)
+	}
+
+	return nil
+}
+
+// hostIPs returns the interface and bond addresses of hosts
+func hostIPs(hosts []client.Host) []*string {
+	ips := make([]*string, 0)
+	for i := range hosts {
+		for j := range hosts[i].Interfaces {
+			ips = append(ips, &hosts[i].Interfaces[j].Value.IP.Value)
+		}
+		for j := range hosts[i].Bonds {
+			ips = append(ips, &hosts[i].Bonds[j].Value.IP.Value)
+		}
+	}
+
+	return ips
+}
+
+// nodeIPs returns the interface and bond addresses of nodes
+func nodeIPs(nodes []client.NilNodeAddRequestNodeListItem) []*string {
+	ips := make([]*string, 0)
+	for i := range nodes {
+		for j := range nodes[i].Value.Interfaces {
+			ips = append(ips, &nodes[i].Value.Interfaces[j].Value.IP.Value)
+		}
+		for j := range nodes[i].Value.Bonds {
+			ips = append(ips, &nodes[i].Value.Bonds[j].Value.IP.Value)
+		}
+	}
+
+	return ips
+}
@@ -5,22 +5,54 @@
 package node
 
 import (
+	"context"
+	"strings"
+
 	"github.com/spf13/cobra"
 	"github.com/ubccr/grendel/cmd"
 	"github.com/ubccr/grendel/internal/logger"
+	"github.com/ubccr/grendel/pkg/client"
 )
 
 var (
 	tags    []string
 	log     = logger.GetLogger("NODE")
 	nodeCmd = &cobra.Command{
-		Use:   "node",
-		Short: "Node commands",
-		Long:  `Node commands`,
+		Use:     "node",
+		Aliases: []string{"host"},
+		Short:   "Node commands",
+		Long:    `Node commands`,
 	}
 )
 
 func init() {
 	nodeCmd.PersistentFlags().StringSliceVarP(&tags, "tags", "t", []string{}, "filter by tags")
+	nodeCmd.RegisterFlagCompletionFunc("tags", completeTagsFlag)
 	cmd.Root.AddCommand(nodeCmd)
 }
+
+// completeTagsFlag completes the --tags flag from the tags of existing nodes
+func completeTagsFlag(command *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	gc, err := cmd.NewOgenClient()
+	if err != nil {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+
+	existing, err := gc.GETV1Nodes(context.Background(), client.GETV1NodesParams{})
+	if err != nil {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+
+	// complete the last entry of a comma separated list
+	prefix := ""
+	if i := strings.LastIndex(toComplete, ","); i >= 0 {
+		prefix = toComplete[:i+1]
+	}
+
+	list := make([]string, 0)
+	for _, t := range existingTags(existing) {
+		list = append(list, prefix+t)
+	}
+
+	return list, cobra.ShellCompDirectiveNoFileComp
+}
@@ -0,0 +1,67 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package node
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/ubccr/grendel/cmd"
+	"github.com/ubccr/grendel/pkg/client"
+)
+
+// prometheusOptions are the settings of the prometheus-sd export format
+type prometheusOptions struct {
+	Port             int
+	ExcludeProvision bool
+
+	// Out is the file_sd file written, stdout when empty
+	Out string
+}
+
+// exportPrometheusSD writes the Prometheus targets of the nodes served by the
+// API at /v1/nodes/prometheus-sd
+func exportPrometheusSD(gc *client.Client, nodeset string, opts prometheusOptions) error {
+	params := client.GETV1NodesPrometheusSdParams{
+		Nodeset:          client.NewOptString(nodeset),
+		Tags:             client.NewOptString(strings.Join(tags, ",")),
+		Port:             client.NewOptInt(opts.Port),
+		ExcludeProvision: client.NewOptBool(opts.ExcludeProvision),
+	}
+	groups, err := gc.GETV1NodesPrometheusSd(context.Background(), params)
+	if err != nil {
+		return cmd.NewApiError(err)
+	}
+
+	if opts.Out == "" {
+		return cmd.WriteJSON(os.Stdout, groups)
+	}
+
+	// Prometheus watches the file, write it whole with a rename
+	tmp, err := os.CreateTemp(filepath.Dir(opts.Out), ".grendel-targets-")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	defer tmp.Close()
+
+	if err := cmd.WriteJSON(tmp, groups); err != nil {
+		return err
+	}
+	if err := tmp.Chmod(0644); err != nil {
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	if err := os.Rename(tmp.Name(), opts.Out); err != nil {
+		return err
+	}
+
+	cmd.Log.Infof("Wrote %d targets to %s", len(groups), opts.Out)
+	return nil
+}
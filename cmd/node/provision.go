@@ -6,6 +6,7 @@ package node
 
 import (
 	"context"
+	"fmt"
 	"strings"
 
 	"github.com/spf13/cobra"
@@ -14,20 +15,43 @@ import (
 )
 
 var (
-	provisionCmd = &cobra.Command{
+	provisionImage  string
+	provisionReboot bool
+	provisionCmd    = &cobra.Command{
 		Use:   "provision {nodeset | all}",
 		Short: "Change nodes provision status",
-		Args:  cobra.ExactArgs(1),
+		Long: `Change nodes provision status.
+
+With --image the boot image of the nodes is set first. With --reboot each node's
+BMC is set to PXE boot on the next boot and the nodes that accepted it are
+power cycled, so setting the image, forcing PXE and power cycling is one
+command. The results are printed with the nodesets that succeeded and failed.`,
+		Args: cobra.ExactArgs(1),
 		RunE: func(command *cobra.Command, args []string) error {
 			gc, err := cmd.NewOgenClient()
 			if err != nil {
 				return err
 			}
 
-			nodeset := args[0]
-			if args[0] == "all" {
-				nodeset = ""
+			nodeset, err := cmd.NodesetArg(args[0])
+			if err != nil {
+				return err
+			}
+
+			if provisionImage != "" {
+				req := &client.NodeBootImageRequest{
+					Image: client.NewOptString(provisionImage),
+				}
+				params := client.PATCHV1NodesImageParams{
+					Nodeset: client.NewOptString(nodeset),
+					Tags:    client.NewOptString(strings.Join(tags, ",")),
+				}
+				_, err := gc.PATCHV1NodesImage(context.Background(), req, params)
+				if err != nil {
+					return cmd.NewApiError(err)
+				}
 			}
+
 			req := &client.NodeProvisionRequest{
 				Provision: client.NewOptBool(true),
 			}
@@ -40,11 +64,62 @@ var (
 				return cmd.NewApiError(err)
 			}
 
-			return cmd.NewApiResponse(res)
+			if !provisionReboot {
+				return cmd.NewApiResponse(res)
+			}
+
+			if !cmd.JSONOutput() {
+				fmt.Printf("%s: %s\n\n", res.GetTitle().Value, res.GetDetail().Value)
+			}
+
+			return reboot(gc, nodeset)
 		},
 	}
 )
 
 func init() {
+	provisionCmd.Flags().StringVar(&provisionImage, "image", "", "Set the boot image of the nodes before provisioning")
+	provisionCmd.Flags().BoolVar(&provisionReboot, "reboot", false, "PXE boot the nodes on the next boot and power cycle them")
 	nodeCmd.AddCommand(provisionCmd)
 }
+
+// reboot sets the nodes to PXE boot on the next boot and power cycles the
+// nodes where the boot override was applied
+func reboot(gc *client.Client, nodeset string) error {
+	bootReq := &client.BmcBootOverrideBody{
+		Target: client.NewOptString("Pxe"),
+	}
+	bootParams := client.POSTV1BmcBootParams{
+		Nodeset: client.NewOptString(nodeset),
+		Tags:    client.NewOptString(strings.Join(tags, ",")),
+	}
+	bootRes, err := gc.POSTV1BmcBoot(context.Background(), bootReq, bootParams)
+	if err != nil {
+		return cmd.NewApiError(err)
+	}
+
+	results := make([]client.JobMessage, 0, len(bootRes))
+	for _, jm := range bootRes {
+		if jm.Status.Value != "success" {
+			results = append(results, jm)
+		}
+	}
+
+	ready := cmd.GroupJobMessages(bootRes, func(jm client.JobMessage) string { return jm.Status.Value })["success"]
+	if ready != "" {
+		powerReq := &client.BmcOsPowerBody{
+			PowerOption: client.NewOptString("ForceRestart"),
+			BootOption:  client.NewOptString("None"),
+		}
+		powerParams := client.POSTV1BmcPowerOsParams{
+			Nodeset: client.NewOptString(ready),
+		}
+		powerRes, err := gc.POSTV1BmcPowerOs(context.Background(), powerReq, powerParams)
+		if err != nil {
+			return cmd.NewApiError(err)
+		}
+		results = append(results, powerRes...)
+	}
+
+	return cmd.NewJobTableResponse(results)
+}
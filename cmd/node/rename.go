@@ -0,0 +1,51 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package node
+
+import (
+	"context"
+
+	"github.com/spf13/cobra"
+	"github.com/ubccr/grendel/cmd"
+	"github.com/ubccr/grendel/pkg/client"
+)
+
+var (
+	renameFQDNPattern string
+	renameCmd         = &cobra.Command{
+		Use:   "rename <old> <new>",
+		Short: "Rename a node",
+		Long: `Rename a node keeping its ID, interfaces and history. The old name is replaced in
+the first label of each interface FQDN. If --fqdn-pattern is set the boot
+interface FQDN is set to the pattern with {name} replaced by the new name.`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(command *cobra.Command, args []string) error {
+			gc, err := cmd.NewOgenClient()
+			if err != nil {
+				return err
+			}
+
+			req := &client.NodeRenameRequest{
+				Name:    args[0],
+				NewName: args[1],
+			}
+			if renameFQDNPattern != "" {
+				req.FqdnPattern = client.NewOptString(renameFQDNPattern)
+			}
+
+			res, err := gc.PATCHV1NodesRename(context.Background(), req, client.PATCHV1NodesRenameParams{})
+			if err != nil {
+				return cmd.NewApiError(err)
+			}
+
+			return cmd.NewApiResponse(res)
+		},
+	}
+)
+
+func init() {
+	renameCmd.Flags().StringVar(&renameFQDNPattern, "fqdn-pattern", "", "pattern for the boot interface FQDN, {name} is replaced with the new name")
+	nodeCmd.AddCommand(renameCmd)
+}
@@ -6,9 +6,6 @@ package node
 
 import (
 	"context"
-	"encoding/json"
-	"os"
-	"strings"
 
 	"github.com/spf13/cobra"
 	"github.com/ubccr/grendel/cmd"
@@ -16,41 +13,50 @@ import (
 )
 
 var (
-	showCmd = &cobra.Command{
+	showSwitch string
+	showPort   int
+	showSince  string
+	showStates []string
+	showCmd    = &cobra.Command{
 		Use:   "show {nodeset | all]",
 		Short: "Show nodes",
 		Args:  cobra.ExactArgs(1),
 		RunE: func(command *cobra.Command, args []string) error {
-			gc, err := cmd.NewOgenClient()
+			since, err := cmd.ParseSince(showSince)
 			if err != nil {
 				return err
 			}
 
-			nodeset := args[0]
-			if args[0] == "all" {
-				nodeset = ""
+			gc, err := cmd.NewOgenClient()
+			if err != nil {
+				return err
 			}
-			req := client.GETV1NodesFindParams{
-				Nodeset: client.NewOptString(nodeset),
-				Tags:    client.NewOptString(strings.Join(tags, ",")),
+
+			nodeset, err := cmd.NodesetArg(args[0])
+			if err != nil {
+				return err
 			}
-			res, err := gc.GETV1NodesFind(context.Background(), req)
+			res, err := gc.HostList(context.Background(), client.HostFilter{
+				Nodeset: nodeset,
+				Tags:    tags,
+				Switch:  showSwitch,
+				Port:    showPort,
+				Since:   since,
+				States:  showStates,
+			})
 			if err != nil {
-				return cmd.NewApiError(err)
+				return err
 			}
 
-			return output(res)
+			return cmd.Output(res)
 		},
 	}
 )
 
 func init() {
+	showCmd.Flags().StringVar(&showSwitch, "switch", "", "Filter by switch the node is connected to")
+	showCmd.Flags().IntVar(&showPort, "port", 0, "Filter by switch port the node is connected to")
+	showCmd.Flags().StringVar(&showSince, "since", "", "Only show nodes added or updated since an RFC3339 time or a duration ago, ex: 24h")
+	showCmd.Flags().StringSliceVar(&showStates, "state", []string{}, "Filter by lifecycle state, ex: production,drained")
 	nodeCmd.AddCommand(showCmd)
 }
-
-func output(data any) error {
-	enc := json.NewEncoder(os.Stdout)
-	enc.SetIndent("", "    ")
-
-	return enc.Encode(data)
-}
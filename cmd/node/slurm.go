@@ -0,0 +1,203 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package node
+
+import (
+	"fmt"
+	"io"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"text/template"
+
+	"github.com/ubccr/grendel/pkg/client"
+	"github.com/ubccr/grendel/pkg/nodeset"
+)
+
+// defaultSlurmTemplate writes a slurm.conf NodeName line, leaving out the
+// values which are not known
+const defaultSlurmTemplate = `NodeName={{.NodeName}}` +
+	`{{with .CPUs}} CPUs={{.}}{{end}}` +
+	`{{with .Sockets}} Sockets={{.}}{{end}}` +
+	`{{with .CoresPerSocket}} CoresPerSocket={{.}}{{end}}` +
+	`{{with .ThreadsPerCore}} ThreadsPerCore={{.}}{{end}}` +
+	`{{with .RealMemory}} RealMemory={{.}}{{end}}` +
+	`{{with .Gres}} Gres={{.}}{{end}}` +
+	`{{with .Features}} Feature={{.}}{{end}}`
+
+// slurmNode is a group of nodes sharing a Slurm configuration, the data of
+// --template
+type slurmNode struct {
+	slurmConfig
+
+	// NodeName is the folded nodeset of the group, such as cpn-[01-04]
+	NodeName string
+	Hosts    []string
+}
+
+// slurmConfig is the Slurm configuration of a node
+type slurmConfig struct {
+	CPUs           int
+	Sockets        int
+	CoresPerSocket int
+	ThreadsPerCore int
+	RealMemory     int
+	Gres           string
+	Features       string
+}
+
+// slurmOptions are the settings of the slurm export format
+type slurmOptions struct {
+	// Template replaces the NodeName line
+	Template string
+
+	// ReservedMemory in MiB is subtracted from the memory in the hardware
+	// inventory, slurmd refuses to register a node with more RealMemory
+	// than the OS reports
+	ReservedMemory int
+
+	// NodeSetTags and PartitionTags are the tags written as NodeSet= and
+	// PartitionName= lines with the nodes having them
+	NodeSetTags   []string
+	PartitionTags []string
+}
+
+// writeSlurm writes slurm.conf lines for hosts. Nodes with the same
+// configuration share a NodeName line with their names folded into a nodeset
+func writeSlurm(w io.Writer, hosts []client.Host, opts slurmOptions) error {
+	text := opts.Template
+	if text == "" {
+		text = defaultSlurmTemplate
+	}
+	tmpl, err := template.New("slurm").Parse(text)
+	if err != nil {
+		return fmt.Errorf("invalid template: %w", err)
+	}
+
+	groups := make(map[slurmConfig][]string)
+	for _, host := range hosts {
+		config := newSlurmConfig(host, opts.ReservedMemory)
+		groups[config] = append(groups[config], host.Name.Value)
+	}
+
+	nodes := make([]slurmNode, 0, len(groups))
+	for config, names := range groups {
+		ns, err := foldNames(names)
+		if err != nil {
+			return err
+		}
+		nodes = append(nodes, slurmNode{slurmConfig: config, NodeName: ns, Hosts: names})
+	}
+	sort.Slice(nodes, func(i, j int) bool { return nodes[i].NodeName < nodes[j].NodeName })
+
+	for _, node := range nodes {
+		var line strings.Builder
+		if err := tmpl.Execute(&line, node); err != nil {
+			return fmt.Errorf("failed to execute template for %s: %w", node.NodeName, err)
+		}
+		fmt.Fprintln(w, strings.TrimRight(line.String(), "\n"))
+	}
+
+	stanza := func(kind string, tags []string) error {
+		for _, tag := range tags {
+			names := make([]string, 0)
+			for _, host := range hosts {
+				if slices.Contains(host.Tags.Value, tag) {
+					names = append(names, host.Name.Value)
+				}
+			}
+			if len(names) == 0 {
+				continue
+			}
+
+			ns, err := foldNames(names)
+			if err != nil {
+				return err
+			}
+			fmt.Fprintf(w, "%s=%s Nodes=%s\n", kind, tag, ns)
+		}
+		return nil
+	}
+
+	if err := stanza("NodeSet", opts.NodeSetTags); err != nil {
+		return err
+	}
+
+	return stanza("PartitionName", opts.PartitionTags)
+}
+
+// newSlurmConfig returns the Slurm configuration of host. Sockets, cores and
+// memory come from the hardware inventory collected from the BMC, tags of
+// the form key=value or key:value fill in the values it lacks: sockets,
+// cores_per_socket, threads_per_core, cpus and real_memory. The gres and
+// feature tags may be repeated
+func newSlurmConfig(host client.Host, reservedMemory int) slurmConfig {
+	tags := host.Tags.Value
+	config := slurmConfig{
+		Sockets:        tagInt(tags, "sockets"),
+		CoresPerSocket: tagInt(tags, "cores_per_socket"),
+		ThreadsPerCore: tagInt(tags, "threads_per_core"),
+		CPUs:           tagInt(tags, "cpus"),
+		RealMemory:     tagInt(tags, "real_memory"),
+		Gres:           strings.Join(tagValues(tags, "gres"), ","),
+		Features:       strings.Join(tagValues(tags, "feature"), ","),
+	}
+
+	if host.Hardware.Set && !host.Hardware.Null {
+		hw := host.Hardware.Value
+		if hw.CPUCount.Value > 0 {
+			config.Sockets = hw.CPUCount.Value
+			if hw.CoreCount.Value > 0 {
+				config.CoresPerSocket = hw.CoreCount.Value / hw.CPUCount.Value
+			}
+		}
+		if hw.MemoryMib.Value > reservedMemory {
+			config.RealMemory = hw.MemoryMib.Value - reservedMemory
+		}
+	}
+
+	if config.Sockets > 0 && config.CoresPerSocket > 0 {
+		config.CPUs = config.Sockets * config.CoresPerSocket * max(config.ThreadsPerCore, 1)
+	}
+
+	return config
+}
+
+// foldNames returns names folded into a nodeset
+func foldNames(names []string) (string, error) {
+	ns, err := nodeset.NewNodeSet(strings.Join(names, ","))
+	if err != nil {
+		return "", fmt.Errorf("failed to fold node names: %w", err)
+	}
+
+	return ns.String(), nil
+}
+
+// tagValues returns the values of the key=value and key:value tags
+func tagValues(tags []string, key string) []string {
+	values := make([]string, 0)
+	for _, t := range tags {
+		for _, prefix := range []string{key + "=", key + ":"} {
+			if v, ok := strings.CutPrefix(t, prefix); ok {
+				values = append(values, v)
+			}
+		}
+	}
+
+	return values
+}
+
+// tagInt returns the integer value of the first key=value or key:value tag,
+// 0 when there is none or it is not an integer
+func tagInt(tags []string, key string) int {
+	values := tagValues(tags, key)
+	if len(values) == 0 {
+		return 0
+	}
+
+	v, _ := strconv.Atoi(values[0])
+	return v
+}
@@ -0,0 +1,86 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package node
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/ubccr/grendel/pkg/client"
+	"github.com/ubccr/grendel/pkg/model"
+)
+
+// sshOptions are the settings of the ssh-config export format
+type sshOptions struct {
+	// Interface is the name of the interface giving the HostName of a node,
+	// the boot interface when empty
+	Interface string
+
+	// Jump is the ProxyJump host of every entry
+	Jump string
+
+	// User and BMCUser are the users of the node and BMC entries
+	User    string
+	BMCUser string
+
+	// TagUsers and BMCTagUsers map a tag to the user of the node and BMC
+	// entries of the hosts having it, overriding User and BMCUser
+	TagUsers    map[string]string
+	BMCTagUsers map[string]string
+
+	// BMCSuffix names the BMC entries <node>-bmc instead of the host name of
+	// the BMC interface
+	BMCSuffix bool
+}
+
+// writeSSHConfig writes an ssh_config Host entry for every node and its BMC,
+// sorted by name
+func writeSSHConfig(w io.Writer, hosts []client.Host, opts sshOptions) error {
+	list, err := modelHosts(hosts)
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintln(w, "# generated by grendel node export --format ssh-config")
+	for _, host := range list {
+		user := sshUser(host, opts.User, opts.TagUsers)
+		writeSSHHost(w, host.Name, interfaceAddress(exportInterface(host, opts.Interface), host.Name), user, opts.Jump)
+
+		bmc := host.InterfaceBMC()
+		if bmc == nil {
+			continue
+		}
+		name := host.Name + "-bmc"
+		if short, _, _ := strings.Cut(bmc.HostName(), "."); short != "" && !opts.BMCSuffix {
+			name = short
+		}
+		user = sshUser(host, opts.BMCUser, opts.BMCTagUsers)
+		writeSSHHost(w, name, interfaceAddress(bmc, name), user, opts.Jump)
+	}
+
+	return nil
+}
+
+// sshUser returns the user of the first tag of host in tagUsers, else user
+func sshUser(host *model.Host, user string, tagUsers map[string]string) string {
+	for _, t := range host.Tags {
+		if u, ok := tagUsers[t]; ok {
+			return u
+		}
+	}
+
+	return user
+}
+
+func writeSSHHost(w io.Writer, name, address, user, jump string) {
+	fmt.Fprintf(w, "\nHost %s\n    HostName %s\n", name, address)
+	if user != "" {
+		fmt.Fprintf(w, "    User %s\n", user)
+	}
+	if jump != "" {
+		fmt.Fprintf(w, "    ProxyJump %s\n", jump)
+	}
+}
@@ -0,0 +1,61 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package node
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExportSSHConfig(t *testing.T) {
+	var buf bytes.Buffer
+	err := writeSSHConfig(&buf, testMonitorHosts(), sshOptions{
+		Jump:        "bastion.mgmt",
+		User:        "root",
+		BMCUser:     "admin",
+		TagUsers:    map[string]string{"gpu": "gpuadmin"},
+		BMCTagUsers: map[string]string{"ib": "ADMIN"},
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, `# generated by grendel node export --format ssh-config
+
+Host cpn-01
+    HostName 10.0.0.1
+    User root
+    ProxyJump bastion.mgmt
+
+Host bmc-cpn-01
+    HostName 10.0.1.1
+    User ADMIN
+    ProxyJump bastion.mgmt
+
+Host cpn-02
+    HostName fd00::2
+    User gpuadmin
+    ProxyJump bastion.mgmt
+
+Host cpn-03
+    HostName cpn-03
+    User root
+    ProxyJump bastion.mgmt
+`, buf.String())
+
+	buf.Reset()
+	err = writeSSHConfig(&buf, testMonitorHosts()[2:], sshOptions{Interface: "ib0", BMCSuffix: true})
+	require.NoError(t, err)
+
+	assert.Equal(t, `# generated by grendel node export --format ssh-config
+
+Host cpn-01
+    HostName cpn-01
+
+Host cpn-01-bmc
+    HostName 10.0.1.1
+`, buf.String())
+}
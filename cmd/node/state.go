@@ -0,0 +1,72 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package node
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/ubccr/grendel/cmd"
+	"github.com/ubccr/grendel/pkg/client"
+	"github.com/ubccr/grendel/pkg/model"
+)
+
+var (
+	stateCmd = &cobra.Command{
+		Use:   "state",
+		Short: "Manage the lifecycle state of nodes",
+		Long: fmt.Sprintf(`Manage the lifecycle state of nodes.
+
+Nodes are in one of the states %s.
+New nodes move to ready-to-provision, which sets them to provision, then to
+installing once they fetch their boot script and to production when the install
+completes. Nodes out of service are drained and decommissioned nodes retired,
+which stops DHCP from answering them. A retired node goes back to new before
+it is provisioned again. The services handling each state are set with
+dhcp.states, dhcp.boot_states and provision.states.`, strings.Join(model.HostStates, ", ")),
+	}
+	stateSetCmd = &cobra.Command{
+		Use:       "set {nodeset | all} <state>",
+		Short:     "Set the lifecycle state of nodes",
+		Args:      cobra.ExactArgs(2),
+		ValidArgs: model.HostStates,
+		RunE: func(command *cobra.Command, args []string) error {
+			if err := model.ValidateHostState(args[1]); err != nil {
+				return err
+			}
+
+			gc, err := cmd.NewOgenClient()
+			if err != nil {
+				return err
+			}
+
+			nodeset, err := cmd.NodesetArg(args[0])
+			if err != nil {
+				return err
+			}
+
+			req := &client.NodeStateRequest{
+				State: args[1],
+			}
+			params := client.PATCHV1NodesStateParams{
+				Nodeset: client.NewOptString(nodeset),
+				Tags:    client.NewOptString(strings.Join(tags, ",")),
+			}
+			res, err := gc.PATCHV1NodesState(context.Background(), req, params)
+			if err != nil {
+				return cmd.NewApiError(err)
+			}
+
+			return cmd.NewApiResponse(res)
+		},
+	}
+)
+
+func init() {
+	stateCmd.AddCommand(stateSetCmd)
+	nodeCmd.AddCommand(stateCmd)
+}
@@ -26,9 +26,9 @@ var (
 				return err
 			}
 
-			nodeset := args[0]
-			if args[0] == "all" {
-				nodeset = ""
+			nodeset, err := cmd.NodesetArg(args[0])
+			if err != nil {
+				return err
 			}
 			params := client.GETV1NodesTokenInterfaceParams{
 				Interface: args[0],
@@ -40,6 +40,10 @@ var (
 				return cmd.NewApiError(err)
 			}
 
+			if cmd.JSONOutput() {
+				return cmd.Output(res.Nodes)
+			}
+
 			for _, node := range res.Nodes {
 				fmt.Printf("%s: %s\n", node.Name.Value, node.Token.Value)
 			}
@@ -0,0 +1,117 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package node
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/ubccr/grendel/cmd"
+	"github.com/ubccr/grendel/internal/util"
+	"github.com/ubccr/grendel/pkg/client"
+)
+
+var (
+	purgeAll       bool
+	purgeOlderThan string
+	trashCmd       = &cobra.Command{
+		Use:   "trash",
+		Short: "Manage deleted nodes",
+		Long: `Deleted nodes are moved to the trash. They are no longer served by DHCP,
+DNS or the provision server and can be restored until they are purged after
+trash_retention, 7 days by default.`,
+	}
+	trashListCmd = &cobra.Command{
+		Use:   "list",
+		Short: "List deleted nodes",
+		Args:  cobra.NoArgs,
+		RunE: func(command *cobra.Command, args []string) error {
+			gc, err := cmd.NewOgenClient()
+			if err != nil {
+				return err
+			}
+
+			res, err := gc.GETV1NodesTrash(context.Background(), client.GETV1NodesTrashParams{})
+			if err != nil {
+				return cmd.NewApiError(err)
+			}
+
+			if cmd.JSONOutput() {
+				return cmd.Output(res)
+			}
+
+			for _, t := range res {
+				fmt.Printf("%-40s%s\n", t.Name.Value, t.DeletedAt.Value.Local().Format(time.DateTime))
+			}
+
+			return nil
+		},
+	}
+	restoreCmd = &cobra.Command{
+		Use:   "restore <nodeset>",
+		Short: "Restore deleted nodes from the trash",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(command *cobra.Command, args []string) error {
+			gc, err := cmd.NewOgenClient()
+			if err != nil {
+				return err
+			}
+
+			params := client.POSTV1NodesTrashRestoreParams{
+				Nodeset: args[0],
+			}
+			res, err := gc.POSTV1NodesTrashRestore(context.Background(), params)
+			if err != nil {
+				return cmd.NewApiError(err)
+			}
+
+			return cmd.NewApiResponse(res)
+		},
+	}
+	purgeCmd = &cobra.Command{
+		Use:   "purge {--all | --older-than <duration>}",
+		Short: "Permanently delete nodes from the trash",
+		Long: `Permanently delete nodes from the trash. --older-than accepts a duration
+such as 30d, 36h or 1d12h.`,
+		Args: cobra.NoArgs,
+		RunE: func(command *cobra.Command, args []string) error {
+			params := client.DELETEV1NodesTrashParams{
+				All: client.NewOptBool(purgeAll),
+			}
+			if !purgeAll {
+				if _, err := util.ParseDuration(purgeOlderThan); err != nil {
+					return err
+				}
+				params.OlderThan = client.NewOptString(purgeOlderThan)
+			}
+
+			gc, err := cmd.NewOgenClient()
+			if err != nil {
+				return err
+			}
+
+			res, err := gc.DELETEV1NodesTrash(context.Background(), params)
+			if err != nil {
+				return cmd.NewApiError(err)
+			}
+
+			return cmd.NewApiResponse(res)
+		},
+	}
+)
+
+func init() {
+	purgeCmd.Flags().BoolVar(&purgeAll, "all", false, "purge all nodes in the trash")
+	purgeCmd.Flags().StringVar(&purgeOlderThan, "older-than", "", "purge nodes deleted longer ago than the duration")
+	purgeCmd.MarkFlagsMutuallyExclusive("all", "older-than")
+	purgeCmd.MarkFlagsOneRequired("all", "older-than")
+
+	trashCmd.AddCommand(trashListCmd)
+	nodeCmd.AddCommand(trashCmd)
+	nodeCmd.AddCommand(restoreCmd)
+	nodeCmd.AddCommand(purgeCmd)
+}
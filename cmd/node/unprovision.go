@@ -24,9 +24,9 @@ var (
 				return err
 			}
 
-			nodeset := args[0]
-			if args[0] == "all" {
-				nodeset = ""
+			nodeset, err := cmd.NodesetArg(args[0])
+			if err != nil {
+				return err
 			}
 			req := &client.NodeProvisionRequest{
 				Provision: client.NewOptBool(false),
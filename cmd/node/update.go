@@ -0,0 +1,67 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package node
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/ubccr/grendel/cmd"
+	"github.com/ubccr/grendel/internal/firmware"
+	"github.com/ubccr/grendel/pkg/client"
+)
+
+var (
+	updateFirmware string
+	updateCmd      = &cobra.Command{
+		Use:   "update {nodeset | all} --firmware <firmware>",
+		Short: "Update nodes settings",
+		Long: `Update the settings of nodes.
+
+--firmware sets the firmware sent to nodes instead of the firmware selected by
+client architecture, for network adapters whose drivers need another build.
+The firmware of a node takes precedence over the firmware of its tags and boot
+image in the firmware.tags and firmware.images config. An empty firmware
+clears it. Valid firmware: ` + strings.Join(firmware.Names(), ", "),
+		Example: `  grendel node update cpn-[01-04] --firmware snponly-x86_64.efi
+  grendel node update cpn-01 --firmware ""`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(command *cobra.Command, args []string) error {
+			if !command.Flags().Changed("firmware") {
+				return errors.New("nothing to update, set --firmware")
+			}
+
+			gc, err := cmd.NewOgenClient()
+			if err != nil {
+				return err
+			}
+
+			nodeset, err := cmd.NodesetArg(args[0])
+			if err != nil {
+				return err
+			}
+			req := &client.NodeFirmwareRequest{
+				Firmware: client.NewOptString(updateFirmware),
+			}
+			params := client.PATCHV1NodesFirmwareParams{
+				Nodeset: client.NewOptString(nodeset),
+				Tags:    client.NewOptString(strings.Join(tags, ",")),
+			}
+			res, err := gc.PATCHV1NodesFirmware(context.Background(), req, params)
+			if err != nil {
+				return cmd.NewApiError(err)
+			}
+
+			return cmd.NewApiResponse(res)
+		},
+	}
+)
+
+func init() {
+	updateCmd.Flags().StringVar(&updateFirmware, "firmware", "", "firmware overriding the firmware of the client architecture")
+	nodeCmd.AddCommand(updateCmd)
+}
@@ -0,0 +1,215 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package node
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/ubccr/grendel/pkg/model"
+	"gopkg.in/yaml.v3"
+)
+
+// warewulfNetDev is a network device of a Warewulf node or profile
+type warewulfNetDev struct {
+	Device  string `yaml:"device"`
+	HWAddr  string `yaml:"hwaddr"`
+	IPAddr  string `yaml:"ipaddr"`
+	Netmask string `yaml:"netmask"`
+	MTU     string `yaml:"mtu"`
+}
+
+// warewulfNode is a node or profile of nodes.conf. The image is "image name"
+// since Warewulf 4.6 and "container name" before, kernel args are a string
+// or a list
+type warewulfNode struct {
+	Profiles      []string `yaml:"profiles"`
+	ImageName     string   `yaml:"image name"`
+	ContainerName string   `yaml:"container name"`
+	Kernel        struct {
+		Args any `yaml:"args"`
+	} `yaml:"kernel"`
+	IPMI struct {
+		IPAddr  string `yaml:"ipaddr"`
+		Netmask string `yaml:"netmask"`
+	} `yaml:"ipmi"`
+	NetDevs map[string]*warewulfNetDev `yaml:"network devices"`
+	Tags    map[string]string          `yaml:"tags"`
+}
+
+type warewulfConfig struct {
+	NodeProfiles map[string]*warewulfNode `yaml:"nodeprofiles"`
+	Nodes        map[string]*warewulfNode `yaml:"nodes"`
+}
+
+// convertWarewulf converts the nodes of a Warewulf 4 nodes.conf, or of the
+// YAML printed by wwctl node export, at path. path can also be the directory
+// holding nodes.conf
+func convertWarewulf(path string, images imageMap, r *migrateReport) (model.HostList, error) {
+	if info, err := os.Stat(path); err == nil && info.IsDir() {
+		path = filepath.Join(path, "nodes.conf")
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var cfg warewulfConfig
+	if err := yaml.Unmarshal(data, &cfg); err != nil {
+		return nil, fmt.Errorf("invalid Warewulf nodes %s: %w", path, err)
+	}
+
+	// wwctl node export prints the nodes without the nodes key
+	if len(cfg.Nodes) == 0 {
+		var nodes map[string]yaml.Node
+		if err := yaml.Unmarshal(data, &nodes); err == nil {
+			cfg.Nodes = make(map[string]*warewulfNode)
+			for name, n := range nodes {
+				if name == "nodeprofiles" || name == "nodes" || n.Kind != yaml.MappingNode {
+					continue
+				}
+				node := &warewulfNode{}
+				if err := n.Decode(node); err != nil {
+					return nil, fmt.Errorf("invalid Warewulf node %s: %w", name, err)
+				}
+				cfg.Nodes[name] = node
+			}
+		}
+	}
+	if len(cfg.Nodes) == 0 {
+		return nil, fmt.Errorf("no Warewulf nodes found in %s", path)
+	}
+
+	hosts := make(model.HostList, 0, len(cfg.Nodes))
+	for name, node := range cfg.Nodes {
+		hosts = append(hosts, warewulfHost(name, node, cfg.NodeProfiles, images, r))
+	}
+
+	return hosts, nil
+}
+
+func warewulfHost(name string, node *warewulfNode, profiles map[string]*warewulfNode, images imageMap, r *migrateReport) *model.Host {
+	host := &model.Host{Name: name}
+
+	// Profiles apply in order, the node overriding them
+	resolved := &warewulfNode{NetDevs: map[string]*warewulfNetDev{}, Tags: map[string]string{}}
+	var apply func(n *warewulfNode, seen map[string]bool)
+	apply = func(n *warewulfNode, seen map[string]bool) {
+		for _, p := range n.Profiles {
+			if seen[p] {
+				continue
+			}
+			seen[p] = true
+			profile, ok := profiles[p]
+			if !ok {
+				r.add(name, "profile %q not found, settings inherited from it are not imported", p)
+				continue
+			}
+			apply(profile, seen)
+		}
+		resolved.merge(n)
+	}
+	apply(node, map[string]bool{})
+
+	if args := warewulfArgs(resolved.Kernel.Args); args != "" {
+		addTag(host, kernelArgsTag+"="+args)
+	}
+	addTag(host, varTags(resolved.Tags)...)
+
+	image := resolved.ImageName
+	if image == "" {
+		image = resolved.ContainerName
+	}
+	images.bootImage(host, r, "image or profile", append([]string{image}, node.Profiles...)...)
+
+	devs := make([]string, 0, len(resolved.NetDevs))
+	for dev := range resolved.NetDevs {
+		devs = append(devs, dev)
+	}
+	sort.Strings(devs)
+	// The default device is the boot interface
+	sort.SliceStable(devs, func(i, j int) bool { return devs[i] == "default" && devs[j] != "default" })
+
+	for _, dev := range devs {
+		d := resolved.NetDevs[dev]
+		ifname := d.Device
+		if ifname == "" && dev != "default" {
+			ifname = dev
+		}
+		if nic := migrateInterface(name, r, ifname, d.HWAddr, d.IPAddr, d.Netmask, "", d.MTU); nic != nil {
+			host.Interfaces = append(host.Interfaces, nic)
+		}
+	}
+
+	if nic := migrateBMC(name, r, resolved.IPMI.IPAddr, resolved.IPMI.Netmask); nic != nil {
+		host.Interfaces = append(host.Interfaces, nic)
+	}
+
+	return host
+}
+
+// merge overrides the settings of w with those set in n
+func (w *warewulfNode) merge(n *warewulfNode) {
+	if n.ImageName != "" {
+		w.ImageName = n.ImageName
+	}
+	if n.ContainerName != "" {
+		w.ContainerName = n.ContainerName
+	}
+	if warewulfArgs(n.Kernel.Args) != "" {
+		w.Kernel.Args = n.Kernel.Args
+	}
+	if n.IPMI.IPAddr != "" {
+		w.IPMI.IPAddr = n.IPMI.IPAddr
+	}
+	if n.IPMI.Netmask != "" {
+		w.IPMI.Netmask = n.IPMI.Netmask
+	}
+	for key, value := range n.Tags {
+		w.Tags[key] = value
+	}
+
+	for name, d := range n.NetDevs {
+		if d == nil {
+			continue
+		}
+		cur, ok := w.NetDevs[name]
+		if !ok {
+			cur = &warewulfNetDev{}
+			w.NetDevs[name] = cur
+		}
+		for _, f := range []struct{ dst, src *string }{
+			{&cur.Device, &d.Device},
+			{&cur.HWAddr, &d.HWAddr},
+			{&cur.IPAddr, &d.IPAddr},
+			{&cur.Netmask, &d.Netmask},
+			{&cur.MTU, &d.MTU},
+		} {
+			if *f.src != "" {
+				*f.dst = *f.src
+			}
+		}
+	}
+}
+
+// warewulfArgs returns kernel args given as a string or a list
+func warewulfArgs(v any) string {
+	switch v := v.(type) {
+	case string:
+		return strings.TrimSpace(v)
+	case []any:
+		args := make([]string, 0, len(v))
+		for _, a := range v {
+			args = append(args, fmt.Sprint(a))
+		}
+		return strings.Join(args, " ")
+	}
+
+	return ""
+}
@@ -0,0 +1,292 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package node
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"net/netip"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/ubccr/grendel/pkg/model"
+)
+
+// xcatTable is a table dumped by tabdump. Rows are keyed by node or group
+type xcatTable struct {
+	rows map[string]map[string]string
+}
+
+// xcatInventory are the tables read from a directory of tabdump files
+type xcatInventory struct {
+	nodelist, mac, hosts, nodetype, bootparams, ipmi, noderes *xcatTable
+	networks                                                  []xcatNetwork
+}
+
+type xcatNetwork struct {
+	prefix netip.Prefix
+	mask   string
+}
+
+// convertXCAT converts the nodes of the tabdump CSV files in dir, named after
+// their table: nodelist.csv, mac.csv, hosts.csv, nodetype.csv,
+// bootparams.csv, ipmi.csv, noderes.csv and networks.csv. Only nodelist is
+// required
+func convertXCAT(dir string, images imageMap, r *migrateReport) (model.HostList, error) {
+	inv := &xcatInventory{}
+	var err error
+	for _, t := range []struct {
+		table **xcatTable
+		name  string
+	}{
+		{&inv.nodelist, "nodelist"},
+		{&inv.mac, "mac"},
+		{&inv.hosts, "hosts"},
+		{&inv.nodetype, "nodetype"},
+		{&inv.bootparams, "bootparams"},
+		{&inv.ipmi, "ipmi"},
+		{&inv.noderes, "noderes"},
+	} {
+		*t.table, err = readXCATTable(dir, t.name, "node", r)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if len(inv.nodelist.rows) == 0 {
+		return nil, fmt.Errorf("no xCAT nodes found, %s is missing or empty", filepath.Join(dir, "nodelist.csv"))
+	}
+
+	networks, err := readXCATTable(dir, "networks", "net", r)
+	if err != nil {
+		return nil, err
+	}
+	for net, row := range networks.rows {
+		prefix, err := migratePrefix(net, row["mask"])
+		if err != nil || row["mask"] == "" {
+			r.add("networks", "invalid network %s/%s, not used for netmasks", net, row["mask"])
+			continue
+		}
+		inv.networks = append(inv.networks, xcatNetwork{prefix: prefix.Masked(), mask: row["mask"]})
+	}
+
+	hosts := make(model.HostList, 0, len(inv.nodelist.rows))
+	for name, row := range inv.nodelist.rows {
+		hosts = append(hosts, inv.host(name, row, images, r))
+	}
+
+	return hosts, nil
+}
+
+// readXCATTable reads the tabdump file of table, the header line lists the
+// columns after a #. Disabled rows are left out. An empty table is returned
+// when the file does not exist
+func readXCATTable(dir, table, key string, r *migrateReport) (*xcatTable, error) {
+	t := &xcatTable{rows: make(map[string]map[string]string)}
+
+	file, err := os.Open(filepath.Join(dir, table+".csv"))
+	if errors.Is(err, os.ErrNotExist) {
+		file, err = os.Open(filepath.Join(dir, table))
+	}
+	if errors.Is(err, os.ErrNotExist) {
+		return t, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	cr := csv.NewReader(file)
+	cr.FieldsPerRecord = -1
+	header, err := cr.Read()
+	if errors.Is(err, io.EOF) {
+		return t, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("invalid xCAT table %s: %w", table, err)
+	}
+	if len(header) > 0 {
+		header[0] = strings.TrimPrefix(header[0], "#")
+	}
+
+	for {
+		record, err := cr.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("invalid xCAT table %s: %w", table, err)
+		}
+
+		row := make(map[string]string, len(header))
+		for i, col := range header {
+			if i < len(record) {
+				row[col] = record[i]
+			}
+		}
+		switch strings.ToLower(row["disable"]) {
+		case "1", "yes":
+			continue
+		}
+
+		name := row[key]
+		if strings.HasPrefix(name, "|") || strings.HasPrefix(name, "/") {
+			r.add(table, "row %s uses a regular expression, not supported", name)
+			continue
+		}
+		t.rows[name] = row
+	}
+
+	return t, nil
+}
+
+// lookup returns the row of node, or of the first of its groups having one
+func (t *xcatTable) lookup(node string, groups []string) map[string]string {
+	if row, ok := t.rows[node]; ok {
+		return row
+	}
+	for _, g := range groups {
+		if row, ok := t.rows[g]; ok {
+			return row
+		}
+	}
+
+	return nil
+}
+
+// netmask returns the mask of the networks entry containing ip
+func (inv *xcatInventory) netmask(ip string) string {
+	addr, err := netip.ParseAddr(strings.TrimSpace(ip))
+	if err != nil {
+		return ""
+	}
+	for _, n := range inv.networks {
+		if n.prefix.Contains(addr) {
+			return n.mask
+		}
+	}
+
+	return ""
+}
+
+func (inv *xcatInventory) host(name string, node map[string]string, images imageMap, r *migrateReport) *model.Host {
+	host := &model.Host{Name: name}
+
+	groups := make([]string, 0)
+	for _, g := range strings.Split(node["groups"], ",") {
+		if g = strings.TrimSpace(g); g != "" {
+			groups = append(groups, g)
+		}
+	}
+	for _, g := range groups {
+		if g != "all" {
+			addTag(host, g)
+		}
+	}
+
+	ip, fqdn, other := "", "", ""
+	if row := inv.hosts.lookup(name, groups); row != nil {
+		ip, other = row["ip"], row["otherinterfaces"]
+		for _, alias := range strings.FieldsFunc(row["hostnames"], func(c rune) bool { return c == ',' || c == ' ' }) {
+			if strings.Contains(alias, ".") {
+				fqdn = alias
+				break
+			}
+		}
+	}
+
+	ifname := ""
+	if row := inv.noderes.lookup(name, groups); row != nil {
+		ifname = row["installnic"]
+		if ifname == "" || ifname == "mac" {
+			ifname = row["primarynic"]
+		}
+		if ifname == "mac" {
+			ifname = ""
+		}
+	}
+
+	macs := []string{}
+	if row := inv.mac.lookup(name, groups); row != nil {
+		if row["interface"] != "" {
+			ifname = row["interface"]
+		}
+		macs = strings.Split(row["mac"], "|")
+	}
+
+	bootSet := false
+	for i, m := range macs {
+		mac, alias, _ := strings.Cut(strings.TrimSpace(m), "!")
+		if mac == "" {
+			continue
+		}
+		if i == 0 {
+			nic := migrateInterface(name, r, ifname, mac, ip, inv.netmask(ip), fqdn, "")
+			if nic != nil {
+				host.Interfaces = append(host.Interfaces, nic)
+				bootSet = true
+			}
+			continue
+		}
+		if nic := migrateInterface(name, r, "", mac, "", "", alias, ""); nic != nil {
+			host.Interfaces = append(host.Interfaces, nic)
+		}
+	}
+	if !bootSet && ip != "" {
+		if nic := migrateInterface(name, r, ifname, "", ip, inv.netmask(ip), fqdn, ""); nic != nil {
+			host.Interfaces = append(host.Interfaces, nic)
+		}
+	}
+
+	// otherinterfaces are name:ip entries, a name starting with - is a
+	// suffix of the node name
+	for _, entry := range strings.Split(other, ",") {
+		alias, addr, ok := strings.Cut(strings.TrimSpace(entry), ":")
+		if !ok {
+			if entry != "" {
+				r.add(name, "otherinterfaces entry %q not understood", entry)
+			}
+			continue
+		}
+		ifname := strings.TrimPrefix(alias, "-")
+		if strings.HasPrefix(alias, "-") {
+			alias = name + alias
+		}
+		if nic := migrateInterface(name, r, ifname, "", addr, inv.netmask(addr), alias, ""); nic != nil {
+			host.Interfaces = append(host.Interfaces, nic)
+		}
+	}
+
+	if row := inv.ipmi.lookup(name, groups); row != nil {
+		bmc := row["bmc"]
+		if bmc == "" {
+			r.add(name, "ipmi row without a bmc address")
+		} else if nic := migrateBMC(name, r, bmc, inv.netmask(bmc)); nic != nil {
+			host.Interfaces = append(host.Interfaces, nic)
+		}
+	}
+
+	if row := inv.bootparams.lookup(name, groups); row != nil {
+		if args := strings.TrimSpace(row["addkcmdline"]); args != "" {
+			addTag(host, kernelArgsTag+"="+args)
+		}
+	}
+
+	if row := inv.nodetype.lookup(name, groups); row != nil {
+		osimage := row["provmethod"]
+		switch osimage {
+		case "install", "netboot", "statelite":
+			osimage = ""
+		}
+		if row["arch"] != "" {
+			addTag(host, "arch="+row["arch"])
+		}
+		images.bootImage(host, r, "osimage or profile", osimage, row["profile"])
+	}
+
+	return host
+}
@@ -0,0 +1,67 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package cmd
+
+import (
+	"bufio"
+	"errors"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/ubccr/grendel/pkg/nodeset"
+)
+
+// Stdin is read by NodesetArg for a nodeset of -
+var Stdin io.Reader = os.Stdin
+
+// NodesetArg returns the nodeset filter of a nodeset argument or flag: all is
+// every node, returned as empty, and - reads the nodesets from stdin, one per
+// line, so commands compose in pipelines
+func NodesetArg(arg string) (string, error) {
+	switch arg {
+	case "all":
+		return "", nil
+	case "-":
+		lines, err := ReadNodesets(Stdin)
+		if err != nil {
+			return "", err
+		}
+
+		ns := nodeset.EmptyNodeSet()
+		for _, line := range lines {
+			if err := ns.Add(line); err != nil {
+				return "", err
+			}
+		}
+		if ns.Len() == 0 {
+			return "", errors.New("no nodes on stdin")
+		}
+
+		return ns.String(), nil
+	}
+
+	return arg, nil
+}
+
+// ReadNodesets returns the nodesets of r, one per line. Empty lines and lines
+// starting with # are skipped
+func ReadNodesets(r io.Reader) ([]string, error) {
+	lines := make([]string, 0)
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), 16*1024*1024)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		lines = append(lines, line)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return lines, nil
+}
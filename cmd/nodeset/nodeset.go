@@ -0,0 +1,200 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package nodesets
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/ubccr/grendel/cmd"
+	"github.com/ubccr/grendel/pkg/nodeset"
+)
+
+var (
+	nodesetCmd = &cobra.Command{
+		Use:   "nodeset",
+		Short: "Nodeset utilities",
+		Long: `Expand, fold, count and combine nodesets without the API.
+
+The nodesets are read from the arguments, or from stdin, one per line, without
+arguments. An argument of - is the nodesets of stdin combined. Nodes match by
+name, cpn-1 and cpn-01 are different nodes. Commands taking a nodeset accept -
+to read it from stdin as well, so they compose in pipelines:
+
+  grendel nodeset difference cpn-[01-64] - < down.txt | grendel node provision -`,
+	}
+	expandCmd = &cobra.Command{
+		Use:     "expand [nodeset...]",
+		Short:   "Print the nodes of nodesets, one per line",
+		Example: `  grendel nodeset expand cpn-d13-[01-04]`,
+		RunE: func(command *cobra.Command, args []string) error {
+			ns, err := union(args)
+			if err != nil {
+				return err
+			}
+
+			it := ns.Iterator()
+			for it.Next() {
+				fmt.Println(it.Value())
+			}
+
+			return nil
+		},
+	}
+	foldCmd = &cobra.Command{
+		Use:     "fold [nodeset...]",
+		Short:   "Print nodesets collapsed into one",
+		Example: `  grendel nodeset fold cpn-d13-01 cpn-d13-02 cpn-d13-03`,
+		RunE: func(command *cobra.Command, args []string) error {
+			ns, err := union(args)
+			if err != nil {
+				return err
+			}
+
+			return printNodeset(ns)
+		},
+	}
+	countCmd = &cobra.Command{
+		Use:     "count [nodeset...]",
+		Short:   "Print the number of nodes of nodesets",
+		Example: `  grendel nodeset count cpn-k[08-09]-[02-24/2]-[01-02]`,
+		RunE: func(command *cobra.Command, args []string) error {
+			ns, err := union(args)
+			if err != nil {
+				return err
+			}
+
+			fmt.Println(ns.Len())
+			return nil
+		},
+	}
+	unionCmd = &cobra.Command{
+		Use:     "union [nodeset...]",
+		Short:   "Print the nodes in any of the nodesets",
+		Example: `  grendel nodeset union cpn-[01-04] cpn-[03-08]`,
+		RunE: func(command *cobra.Command, args []string) error {
+			ns, err := union(args)
+			if err != nil {
+				return err
+			}
+
+			return printNodeset(ns)
+		},
+	}
+	intersectCmd = &cobra.Command{
+		Use:     "intersect [nodeset...]",
+		Short:   "Print the nodes in all of the nodesets",
+		Example: `  grendel nodeset intersect - cpn-[01-32] < gpu.txt`,
+		RunE: func(command *cobra.Command, args []string) error {
+			sets, err := operands(args, 2)
+			if err != nil {
+				return err
+			}
+
+			ns := sets[0]
+			for _, other := range sets[1:] {
+				ns = ns.Intersection(other)
+			}
+
+			return printNodeset(ns)
+		},
+	}
+	differenceCmd = &cobra.Command{
+		Use:     "difference [nodeset...]",
+		Short:   "Print the nodes of the first nodeset not in the others",
+		Example: `  grendel nodeset difference cpn-[01-64] cpn-[07,12]`,
+		RunE: func(command *cobra.Command, args []string) error {
+			sets, err := operands(args, 2)
+			if err != nil {
+				return err
+			}
+
+			ns := sets[0]
+			for _, other := range sets[1:] {
+				ns = ns.Difference(other)
+			}
+
+			return printNodeset(ns)
+		},
+	}
+)
+
+func init() {
+	nodesetCmd.AddCommand(expandCmd)
+	nodesetCmd.AddCommand(foldCmd)
+	nodesetCmd.AddCommand(countCmd)
+	nodesetCmd.AddCommand(unionCmd)
+	nodesetCmd.AddCommand(intersectCmd)
+	nodesetCmd.AddCommand(differenceCmd)
+	cmd.Root.AddCommand(nodesetCmd)
+}
+
+// operands returns the nodesets of args, or of the lines of stdin without
+// args. An argument of - is the nodesets of stdin combined
+func operands(args []string, min int) ([]*nodeset.NodeSet, error) {
+	exprs := args
+	if len(args) == 0 {
+		lines, err := cmd.ReadNodesets(cmd.Stdin)
+		if err != nil {
+			return nil, err
+		}
+		exprs = lines
+	}
+
+	stdin := false
+	sets := make([]*nodeset.NodeSet, 0, len(exprs))
+	for _, expr := range exprs {
+		if expr == "-" {
+			if stdin {
+				return nil, errors.New("stdin can only be read once")
+			}
+			stdin = true
+
+			lines, err := cmd.ReadNodesets(cmd.Stdin)
+			if err != nil {
+				return nil, err
+			}
+			expr = strings.Join(lines, ",")
+		}
+
+		ns, err := nodeset.NewNodeSet(expr)
+		if err != nil {
+			return nil, fmt.Errorf("invalid nodeset %q: %w", expr, err)
+		}
+		sets = append(sets, ns)
+	}
+
+	if len(sets) < min {
+		return nil, fmt.Errorf("%d nodesets are required, got %d", min, len(sets))
+	}
+
+	return sets, nil
+}
+
+// union returns the nodes of all nodesets of args
+func union(args []string) (*nodeset.NodeSet, error) {
+	sets, err := operands(args, 1)
+	if err != nil {
+		return nil, err
+	}
+
+	ns := sets[0]
+	for _, other := range sets[1:] {
+		ns = ns.Union(other)
+	}
+
+	return ns, nil
+}
+
+// printNodeset prints ns folded, or nothing when it is empty
+func printNodeset(ns *nodeset.NodeSet) error {
+	if ns.Len() > 0 {
+		fmt.Println(ns.String())
+	}
+
+	return nil
+}
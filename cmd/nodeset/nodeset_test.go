@@ -0,0 +1,46 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package nodesets
+
+import (
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/ubccr/grendel/cmd"
+)
+
+func TestOperands(t *testing.T) {
+	defer func(r io.Reader) { cmd.Stdin = r }(cmd.Stdin)
+
+	sets, err := operands([]string{"cpn-[01-04]", "cpn-[03-06]"}, 2)
+	if assert.NoError(t, err) && assert.Len(t, sets, 2) {
+		assert.Equal(t, "cpn-[03-04]", sets[0].Intersection(sets[1]).String())
+	}
+
+	// Without args every line of stdin is a nodeset
+	cmd.Stdin = strings.NewReader("cpn-[01-64]\ncpn-[07,12]\n")
+	sets, err = operands(nil, 2)
+	if assert.NoError(t, err) && assert.Len(t, sets, 2) {
+		assert.Equal(t, 62, sets[0].Difference(sets[1]).Len())
+	}
+
+	// - is the lines of stdin combined
+	cmd.Stdin = strings.NewReader("cpn-07\ncpn-12\n")
+	sets, err = operands([]string{"cpn-[01-64]", "-"}, 2)
+	if assert.NoError(t, err) && assert.Len(t, sets, 2) {
+		assert.Equal(t, "cpn-[07,12]", sets[1].String())
+	}
+
+	_, err = operands([]string{"-", "-"}, 2)
+	assert.Error(t, err)
+
+	_, err = operands([]string{"cpn-01"}, 2)
+	assert.Error(t, err)
+
+	_, err = operands([]string{"cpn-[01"}, 1)
+	assert.Error(t, err)
+}
@@ -0,0 +1,38 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package cmd
+
+import (
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNodesetArg(t *testing.T) {
+	defer func(r io.Reader) { Stdin = r }(Stdin)
+
+	ns, err := NodesetArg("all")
+	assert.NoError(t, err)
+	assert.Equal(t, "", ns)
+
+	ns, err = NodesetArg("cpn-[01-04]")
+	assert.NoError(t, err)
+	assert.Equal(t, "cpn-[01-04]", ns)
+
+	Stdin = strings.NewReader("# down nodes\ncpn-01\n\ncpn-02\n  cpn-[03-04]  \nlogin\n")
+	ns, err = NodesetArg("-")
+	assert.NoError(t, err)
+	assert.Equal(t, "cpn-[01-04],login", ns)
+
+	Stdin = strings.NewReader("\n")
+	_, err = NodesetArg("-")
+	assert.Error(t, err)
+
+	Stdin = strings.NewReader("cpn-[01-02\n")
+	_, err = NodesetArg("-")
+	assert.Error(t, err)
+}
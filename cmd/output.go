@@ -0,0 +1,240 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package cmd
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"text/tabwriter"
+
+	"github.com/spf13/viper"
+	"github.com/ubccr/grendel/pkg/client"
+	"github.com/ubccr/grendel/pkg/nodeset"
+)
+
+const (
+	OutputText = "text"
+	OutputJSON = "json"
+
+	// ExitError is the exit code used when a command fails outright
+	ExitError = 1
+	// ExitPartialFailure is the exit code used when a command succeeded for
+	// some hosts but failed for others
+	ExitPartialFailure = 2
+)
+
+// MutationError describes a single failed target of a mutation
+type MutationError struct {
+	Name  string `json:"name"`
+	Error string `json:"error"`
+}
+
+// MutationResult is the JSON document emitted by commands that change state.
+// Changed lists the targets (hosts, files) reported as changed, Count is the
+// number of records changed and Errors lists any targets that failed.
+type MutationResult struct {
+	Changed []string        `json:"changed"`
+	Count   int             `json:"count"`
+	Detail  string          `json:"detail,omitempty"`
+	Errors  []MutationError `json:"errors"`
+}
+
+// ErrorResult is the JSON document emitted when a command fails outright
+type ErrorResult struct {
+	Error string `json:"error"`
+}
+
+// PartialFailureError is returned by commands where one or more of the
+// targets failed. Execute exits with ExitPartialFailure when only some of the
+// targets failed and ExitError when all of them did.
+type PartialFailureError struct {
+	Failed int
+	Total  int
+}
+
+func (e *PartialFailureError) Error() string {
+	return fmt.Sprintf("%d of %d operations failed", e.Failed, e.Total)
+}
+
+// ExitCode returns the process exit code for an error returned by a command
+func ExitCode(err error) int {
+	if err == nil {
+		return 0
+	}
+
+	var pf *PartialFailureError
+	if errors.As(err, &pf) && pf.Failed < pf.Total {
+		return ExitPartialFailure
+	}
+
+	return ExitError
+}
+
+// JSONOutput returns true if the user requested JSON output via --output or
+// GRENDEL_OUTPUT
+func JSONOutput() bool {
+	return viper.GetString("output") == OutputJSON
+}
+
+func validateOutput() error {
+	switch viper.GetString("output") {
+	case OutputText, OutputJSON:
+		return nil
+	}
+
+	return fmt.Errorf("invalid output format: %s. Valid options: %s, %s", viper.GetString("output"), OutputText, OutputJSON)
+}
+
+// WriteJSON encodes data to w as indented JSON
+func WriteJSON(w io.Writer, data any) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "    ")
+
+	return enc.Encode(data)
+}
+
+// Output writes data to stdout as indented JSON
+func Output(data any) error {
+	return WriteJSON(os.Stdout, data)
+}
+
+// NewMutationList returns an empty MutationResult for collecting the results
+// of several mutations
+func NewMutationList() MutationResult {
+	return MutationResult{
+		Changed: []string{},
+		Errors:  []MutationError{},
+	}
+}
+
+// AddResponse records the result of a mutation against a single named target
+func (m *MutationResult) AddResponse(name string, res *client.GenericResponse, err error) {
+	if err != nil {
+		m.Errors = append(m.Errors, MutationError{Name: name, Error: err.Error()})
+		return
+	}
+
+	m.Changed = append(m.Changed, name)
+	m.Count += res.GetChanged().Value
+}
+
+// NewMutationResult converts a generic API response into a MutationResult
+func NewMutationResult(res *client.GenericResponse) MutationResult {
+	return MutationResult{
+		Changed: []string{},
+		Count:   res.GetChanged().Value,
+		Detail:  res.GetDetail().Value,
+		Errors:  []MutationError{},
+	}
+}
+
+// NewJobMessageResult converts a list of per host job messages into a
+// MutationResult
+func NewJobMessageResult(res []client.JobMessage) MutationResult {
+	m := NewMutationList()
+	for _, jm := range res {
+		if jm.Status.Value == "success" {
+			m.Changed = append(m.Changed, jm.Host.Value)
+			continue
+		}
+
+		m.Errors = append(m.Errors, MutationError{Name: jm.Host.Value, Error: jm.Msg.Value})
+	}
+	m.Count = len(m.Changed)
+
+	return m
+}
+
+// GroupJobMessages groups the hosts of a list of job messages by key and
+// collapses each group to a nodeset string, e.g. for retrying failed hosts
+func GroupJobMessages(res []client.JobMessage, key func(client.JobMessage) string) map[string]string {
+	groups := make(map[string]*nodeset.NodeSet)
+	for _, jm := range res {
+		k := key(jm)
+		if _, ok := groups[k]; !ok {
+			groups[k] = nodeset.EmptyNodeSet()
+		}
+		groups[k].Add(jm.Host.Value)
+	}
+
+	nodesets := make(map[string]string, len(groups))
+	for k, ns := range groups {
+		nodesets[k] = ns.String()
+	}
+
+	return nodesets
+}
+
+// Err returns a PartialFailureError if any target of the mutation failed
+func (m MutationResult) Err() error {
+	if len(m.Errors) == 0 {
+		return nil
+	}
+
+	return &PartialFailureError{Failed: len(m.Errors), Total: len(m.Errors) + len(m.Changed)}
+}
+
+// NewMutationResponse prints m in JSON output mode and returns a
+// PartialFailureError if any of the targets failed. In text mode the caller
+// is expected to have already printed the results.
+func NewMutationResponse(m MutationResult) error {
+	if JSONOutput() {
+		if err := Output(m); err != nil {
+			return err
+		}
+	}
+
+	return m.Err()
+}
+
+// NewJobMessageResponse prints a list of per host job messages and returns a
+// PartialFailureError if any of the hosts failed
+func NewJobMessageResponse(res []client.JobMessage) error {
+	m := NewJobMessageResult(res)
+	if JSONOutput() {
+		return NewMutationResponse(m)
+	}
+
+	for _, jobMessage := range res {
+		fmt.Printf("%s\t %s\t %s\n", jobMessage.Host.Value, jobMessage.Status.Value, jobMessage.Msg.Value)
+	}
+
+	return m.Err()
+}
+
+// NewJobTableResponse prints a table of per host job messages followed by the
+// nodesets that succeeded and failed, ready to be retried. In JSON output mode
+// the messages are printed as a MutationResult. A PartialFailureError is
+// returned if any of the hosts failed
+func NewJobTableResponse(res []client.JobMessage) error {
+	m := NewJobMessageResult(res)
+	if JSONOutput() {
+		return NewMutationResponse(m)
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 4, ' ', 0)
+	fmt.Fprintln(w, "HOST\tSTATUS\tMESSAGE")
+	for _, jm := range res {
+		fmt.Fprintf(w, "%s\t%s\t%s\n", jm.Host.Value, jm.Status.Value, jm.Msg.Value)
+	}
+	if err := w.Flush(); err != nil {
+		return err
+	}
+
+	groups := GroupJobMessages(res, func(jm client.JobMessage) string {
+		if jm.Status.Value == "success" {
+			return "success"
+		}
+		return "error"
+	})
+	fmt.Println()
+	fmt.Printf("Succeeded (%d): %s\n", len(m.Changed), groups["success"])
+	fmt.Printf("Failed (%d): %s\n", len(m.Errors), groups["error"])
+
+	return m.Err()
+}
@@ -0,0 +1,109 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package cmd
+
+import (
+	"bytes"
+	"errors"
+	"flag"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/ubccr/grendel/pkg/client"
+)
+
+var updateGolden = flag.Bool("update", false, "update golden files")
+
+func assertGolden(t *testing.T, name string, data any) {
+	var buf bytes.Buffer
+	err := WriteJSON(&buf, data)
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	path := filepath.Join("testdata", name+".golden")
+	if *updateGolden {
+		err := os.WriteFile(path, buf.Bytes(), 0644)
+		assert.NoError(t, err)
+	}
+
+	golden, err := os.ReadFile(path)
+	if assert.NoError(t, err) {
+		assert.Equal(t, string(golden), buf.String())
+	}
+}
+
+func TestOutputMutation(t *testing.T) {
+	res := &client.GenericResponse{
+		Title:   client.NewOptString("Success"),
+		Detail:  client.NewOptString("successfully tagged nodes"),
+		Changed: client.NewOptInt(3),
+	}
+
+	m := NewMutationResult(res)
+	assert.NoError(t, m.Err())
+	assertGolden(t, "mutation", m)
+}
+
+func TestOutputMutationList(t *testing.T) {
+	m := NewMutationList()
+	m.AddResponse("nodes1.json", &client.GenericResponse{Changed: client.NewOptInt(2)}, nil)
+	m.AddResponse("nodes2.json", nil, errors.New("open nodes2.json: no such file or directory"))
+
+	assertGolden(t, "mutation_list", m)
+
+	err := m.Err()
+	assert.Error(t, err)
+	assert.Equal(t, ExitPartialFailure, ExitCode(err))
+}
+
+func TestOutputJobMessages(t *testing.T) {
+	res := []client.JobMessage{
+		{Host: client.NewOptString("cpn-01"), Status: client.NewOptString("success"), Msg: client.NewOptString("Sent power command")},
+		{Host: client.NewOptString("cpn-02"), Status: client.NewOptString("success"), Msg: client.NewOptString("Sent power command")},
+		{Host: client.NewOptString("cpn-03"), Status: client.NewOptString("error"), Msg: client.NewOptString("failed to find bmc interface to query")},
+	}
+
+	m := NewJobMessageResult(res)
+	assertGolden(t, "job_messages", m)
+
+	err := m.Err()
+	var pf *PartialFailureError
+	if assert.ErrorAs(t, err, &pf) {
+		assert.Equal(t, 1, pf.Failed)
+		assert.Equal(t, 3, pf.Total)
+	}
+	assert.Equal(t, ExitPartialFailure, ExitCode(err))
+}
+
+func TestOutputJobMessagesAllFailed(t *testing.T) {
+	res := []client.JobMessage{
+		{Host: client.NewOptString("cpn-01"), Status: client.NewOptString("error"), Msg: client.NewOptString("401 unauthorized")},
+	}
+
+	m := NewJobMessageResult(res)
+	assert.Equal(t, ExitError, ExitCode(m.Err()))
+}
+
+func TestGroupJobMessages(t *testing.T) {
+	res := []client.JobMessage{
+		{Host: client.NewOptString("cpn-02"), Status: client.NewOptString("success")},
+		{Host: client.NewOptString("cpn-01"), Status: client.NewOptString("success")},
+		{Host: client.NewOptString("cpn-03"), Status: client.NewOptString("success")},
+		{Host: client.NewOptString("cpn-05"), Status: client.NewOptString("error")},
+	}
+
+	groups := GroupJobMessages(res, func(jm client.JobMessage) string { return jm.Status.Value })
+	assert.Equal(t, map[string]string{"success": "cpn-[01-03]", "error": "cpn-05"}, groups)
+}
+
+func TestOutputError(t *testing.T) {
+	assertGolden(t, "error", ErrorResult{Error: "API Error: status=404 title=Error detail=failed to find nodes"})
+
+	assert.Equal(t, 0, ExitCode(nil))
+	assert.Equal(t, ExitError, ExitCode(errors.New("failed")))
+}
@@ -0,0 +1,237 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package replay
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"net/netip"
+	"os"
+	"path/filepath"
+
+	"github.com/insomniacslk/dhcp/dhcpv4"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"github.com/ubccr/grendel/cmd"
+	"github.com/ubccr/grendel/cmd/serve"
+	"github.com/ubccr/grendel/internal/dhcp"
+	"github.com/ubccr/grendel/internal/dns"
+	"github.com/ubccr/grendel/internal/replay"
+	"github.com/ubccr/grendel/internal/store/sqlstore"
+)
+
+var (
+	pcapFile       string
+	replayDHCP     bool
+	replayDNS      bool
+	serverIP       string
+	dnsServers     []string
+	ignore         []string
+	examples       int
+	maxDiffs       int
+	maxDiffPercent float64
+	replayCmd      = &cobra.Command{
+		Use:   "replay --pcap <file>",
+		Short: "Compare the DHCP and DNS answers of grendel with a packet capture",
+		Long: `Answer the DHCP and DNS queries of a packet capture, in pcap or pcapng format,
+with the handlers of grendel and report where the answers differ from the
+responses captured, such as those of the server grendel replaces. No packets
+are sent. Differences are grouped by field, such as yiaddr, an option
+missing, extra or with another value, the DNS rcode or the answers, with
+their count and examples.
+
+Queries are answered from a snapshot of the database file set by dbpath, so
+the changes grendel serve makes while answering, such as recording pending
+BMCs, are discarded. The configuration of grendel serve applies, with
+dhcp.states, the DNS ACLs and zones. DNS queries grendel would forward to
+dns.forward are counted as skipped.
+
+DHCP requests are answered as if received by the server which replied in
+the capture. Set --server-ip to answer them with another address. Set
+--dns-server to replay only the DNS queries sent to these addresses, such as
+to skip the queries the captured server forwarded.
+
+Exits with an error when more than --max-diffs queries differ, or more than
+--max-diff-percent percent of them when set.`,
+		Example: `  tcpdump -i eth0 -w capture.pcap 'udp port 67 or udp port 68 or udp port 53'
+  grendel replay --pcap capture.pcap
+  grendel replay --pcap capture.pcap --dhcp --ignore siaddr --ignore "option 51"
+  grendel replay --pcap capture.pcap --dns --max-diff-percent 1`,
+		Args: cobra.NoArgs,
+		RunE: func(command *cobra.Command, args []string) error {
+			if !replayDHCP && !replayDNS {
+				replayDHCP, replayDNS = true, true
+			}
+
+			file, err := os.Open(pcapFile)
+			if err != nil {
+				return err
+			}
+			packets, err := replay.ReadPackets(file)
+			file.Close()
+			if err != nil {
+				return fmt.Errorf("failed to read %s: %w", pcapFile, err)
+			}
+
+			r := &replay.Replayer{Ignore: ignore, MaxExamples: examples}
+			if serverIP != "" {
+				r.ServerIP = net.ParseIP(serverIP).To4()
+				if r.ServerIP == nil {
+					return fmt.Errorf("invalid --server-ip %q", serverIP)
+				}
+			}
+			for _, s := range dnsServers {
+				addr, err := netip.ParseAddr(s)
+				if err != nil {
+					return fmt.Errorf("invalid --dns-server %q", s)
+				}
+				r.DNSServers = append(r.DNSServers, addr.Unmap())
+			}
+
+			db, cleanup, err := snapshot()
+			if err != nil {
+				return err
+			}
+			defer cleanup()
+
+			if replayDHCP {
+				r.DHCP, err = dhcpReplier(db)
+				if err != nil {
+					return err
+				}
+			}
+			if replayDNS {
+				acl, err := dns.ACLFromConfig(viper.GetViper())
+				if err != nil {
+					return err
+				}
+				delegations, err := dns.DelegationsFromConfig(viper.GetViper())
+				if err != nil {
+					return err
+				}
+				replayer, err := dns.NewReplayer(db, uint32(viper.GetInt("dns.ttl")), acl, delegations)
+				if err != nil {
+					return err
+				}
+				r.DNS = replayer.Reply
+			}
+
+			report := r.Run(packets)
+			if cmd.JSONOutput() {
+				if err := cmd.Output(report); err != nil {
+					return err
+				}
+			} else {
+				printReport(report, replayDHCP, replayDNS)
+			}
+
+			return report.Exceeds(maxDiffs, maxDiffPercent)
+		},
+	}
+)
+
+func init() {
+	replayCmd.Flags().StringVar(&pcapFile, "pcap", "", "packet capture in pcap or pcapng format")
+	replayCmd.MarkFlagRequired("pcap")
+	replayCmd.Flags().BoolVar(&replayDHCP, "dhcp", false, "replay the DHCP requests, both DHCP and DNS when neither is set")
+	replayCmd.Flags().BoolVar(&replayDNS, "dns", false, "replay the DNS queries, both DHCP and DNS when neither is set")
+	replayCmd.Flags().StringVar(&serverIP, "server-ip", "", "address of the server answering the DHCP requests, the server of the captured reply by default")
+	replayCmd.Flags().StringSliceVar(&dnsServers, "dns-server", nil, "replay only the DNS queries sent to these addresses")
+	replayCmd.Flags().StringArrayVar(&ignore, "ignore", nil, "field not compared, such as siaddr or \"option 51\"")
+	replayCmd.Flags().IntVar(&examples, "examples", replay.DefaultMaxExamples, "examples printed for each difference")
+	replayCmd.Flags().IntVar(&maxDiffs, "max-diffs", 0, "number of queries allowed to differ")
+	replayCmd.Flags().Float64Var(&maxDiffPercent, "max-diff-percent", 0, "percentage of the queries allowed to differ, replaces --max-diffs when set")
+	cmd.Root.AddCommand(replayCmd)
+}
+
+// snapshot opens a copy of the database file set by dbpath, removed by
+// cleanup, so the writes of the handlers do not reach the database
+func snapshot() (*sqlstore.SqlStore, func(), error) {
+	filename := viper.GetString("dsn")
+	if filename == "" {
+		filename = viper.GetString("dbpath")
+	}
+	if filename == ":memory:" {
+		return nil, nil, errors.New("replay requires a database file, set dbpath")
+	}
+	if _, err := os.Stat(filename); err != nil {
+		return nil, nil, err
+	}
+
+	src, err := sqlstore.New(filename)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer src.Close()
+
+	dir, err := os.MkdirTemp("", "grendel-replay-")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	file := filepath.Join(dir, "grendel.db")
+	if err := src.Snapshot(file); err != nil {
+		os.RemoveAll(dir)
+		return nil, nil, fmt.Errorf("failed to snapshot the database: %w", err)
+	}
+
+	db, err := sqlstore.New(file)
+	if err != nil {
+		os.RemoveAll(dir)
+		return nil, nil, err
+	}
+
+	return db, func() {
+		db.Close()
+		os.RemoveAll(dir)
+	}, nil
+}
+
+// dhcpReplier returns a replier answering with a DHCP server for each server
+// address of the capture
+func dhcpReplier(db *sqlstore.SqlStore) (replay.DHCPReplier, error) {
+	settings, err := serve.DHCPSettings(viper.GetViper())
+	if err != nil {
+		return nil, err
+	}
+
+	servers := make(map[string]*dhcp.Server)
+	return func(req *dhcpv4.DHCPv4, serverIP net.IP) *dhcpv4.DHCPv4 {
+		srv, ok := servers[serverIP.String()]
+		if !ok {
+			var err error
+			srv, err = dhcp.NewServer(db, net.JoinHostPort(serverIP.String(), "67"))
+			if err != nil {
+				cmd.Log.Warnf("Skipping DHCP server %s: %s", serverIP, err)
+				return nil
+			}
+			srv.Reload(settings)
+			srv.ProxyOnly = viper.GetBool("dhcp.proxy_only")
+			servers[serverIP.String()] = srv
+		}
+
+		return srv.Replay(req)
+	}, nil
+}
+
+func printReport(report *replay.Report, showDHCP, showDNS bool) {
+	stats := func(name string, s replay.Stats) {
+		fmt.Printf("%s: %d queries, %d matched, %d different, %d skipped\n", name, s.Queries, s.Matched, s.Different, s.Skipped)
+	}
+	if showDHCP {
+		stats("DHCP", report.DHCP)
+	}
+	if showDNS {
+		stats("DNS", report.DNS)
+	}
+
+	for _, d := range report.Differences {
+		fmt.Printf("\n%s %s: %d\n", d.Protocol, d.Field, d.Count)
+		for _, e := range d.Examples {
+			fmt.Printf("  %s\n", e)
+		}
+	}
+}
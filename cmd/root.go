@@ -5,18 +5,11 @@
 package cmd
 
 import (
-	"context"
-	"crypto/tls"
-	"crypto/x509"
 	"errors"
 	"fmt"
 	"io/ioutil"
 	golog "log"
-	"net"
-	"net/http"
 	"os"
-	"strings"
-	"time"
 
 	homedir "github.com/mitchellh/go-homedir"
 	"github.com/sirupsen/logrus"
@@ -45,9 +38,18 @@ var (
 )
 
 func Execute() {
-	if err := Root.Execute(); err != nil {
-		Log.Fatal(err)
+	err := Root.Execute()
+	if err == nil {
+		return
+	}
+
+	var pf *PartialFailureError
+	if !errors.As(err, &pf) && JSONOutput() {
+		Output(ErrorResult{Error: err.Error()})
 	}
+
+	Log.Error(err)
+	os.Exit(ExitCode(err))
 }
 
 func init() {
@@ -57,78 +59,53 @@ func init() {
 	Root.PersistentFlags().BoolVar(&debug, "debug", false, "Enable debug messages")
 	Root.PersistentFlags().BoolVar(&verbose, "verbose", false, "Enable verbose messages")
 	Root.PersistentFlags().String("endpoint", "grendel-api.socket", "Grendel API endpoint")
-	viper.BindPFlag("client.api_endpoint", Root.PersistentFlags().Lookup("endpoint"))
+	config.BindPFlag("client.api_endpoint", Root.PersistentFlags().Lookup("endpoint"))
+	Root.PersistentFlags().String("namespace", "", "Grendel API namespace")
+	config.BindPFlag("client.namespace", Root.PersistentFlags().Lookup("namespace"))
+	Root.PersistentFlags().String("output", OutputText, "Output format. Valid options: text, json")
+	config.BindPFlag("output", Root.PersistentFlags().Lookup("output"))
+	viper.SetDefault("client.retries", 2)
+	viper.SetDefault("logging.format", "text")
+	viper.SetDefault("logging.max_size", 100)
+	viper.SetDefault("logging.max_backups", 5)
 
 	Root.PersistentPreRunE = func(command *cobra.Command, args []string) error {
 		return SetupLogging()
 	}
 }
 
-type ogenAuth struct{}
-
-func newAuthHandler() ogenAuth {
-	return ogenAuth{}
-}
-
-func (o ogenAuth) HeaderAuth(ctx context.Context, operationName string, c *client.Client) (client.HeaderAuth, error) {
-	auth := client.HeaderAuth{Token: viper.GetString("client.api_key")}
-	return auth, nil
-}
-
-func (o ogenAuth) CookieAuth(ctx context.Context, operationName string, c *client.Client) (client.CookieAuth, error) {
-	auth := client.CookieAuth{Token: viper.GetString("client.api_key")}
-	return auth, nil
+func NewOgenClient() (*client.Client, error) {
+	return client.New(ClientConfig())
 }
 
-func NewOgenClient() (*client.Client, error) {
-	tr := &http.Transport{TLSClientConfig: &tls.Config{InsecureSkipVerify: viper.GetBool("client.insecure")}}
+// ClientConfig returns the API client settings of the client config section
+func ClientConfig() client.Config {
+	cfg := client.Config{
+		Endpoint:   viper.GetString("client.api_endpoint"),
+		APIKey:     viper.GetString("client.api_key"),
+		Namespace:  viper.GetString("client.namespace"),
+		Insecure:   viper.GetBool("client.insecure"),
+		MaxRetries: viper.GetInt("client.retries"),
+	}
 
+	// A missing cacert is ignored
 	cacert := viper.GetString("client.cacert")
-	pem, err := os.ReadFile(cacert)
-	if err == nil {
-		certPool := x509.NewCertPool()
-		if !certPool.AppendCertsFromPEM(pem) {
-			return nil, fmt.Errorf("Failed to read cacert: %s", cacert)
-		}
-
-		tr = &http.Transport{TLSClientConfig: &tls.Config{RootCAs: certPool, InsecureSkipVerify: false}}
-	}
-	endpoint := viper.GetString("client.api_endpoint")
-	if !strings.HasPrefix(endpoint, "http") {
-		tr = &http.Transport{
-			DialContext: func(ctx context.Context, _, addr string) (net.Conn, error) {
-				dialer := net.Dialer{}
-				return dialer.DialContext(ctx, "unix", viper.GetString("client.api_endpoint"))
-			},
-		}
-		endpoint = "http://localhost"
+	if _, err := os.Stat(cacert); err == nil {
+		cfg.CACert = cacert
 	}
 
-	httpClient := &http.Client{Timeout: time.Second * 3600, Transport: tr}
-	// rclient needs some error handler to work properly with ogen convenient errors
-	// rclient := retryablehttp.NewClient()
-	// rclient.RetryMax = 1
-	// rclient.HTTPClient = &http.Client{Timeout: time.Second * 3600, Transport: tr}
-	// rclient.Logger = Log
-	// httpClient := rclient.StandardClient()
-	client, err := client.NewClient(endpoint, newAuthHandler(), client.WithClient(httpClient))
-	if err != nil {
-		return nil, err
-	}
-	return client, nil
+	return cfg
 }
 
 func NewApiError(apiError error) error {
-	var t *client.HTTPErrorStatusCode
-	if !errors.As(apiError, &t) {
-		return apiError
-	}
-
-	httpError := t.GetResponse()
-
-	return fmt.Errorf("API Error: status=%d title=%s detail=%s", t.StatusCode, httpError.GetTitle().Value, httpError.GetDetail().Value)
+	return client.NewAPIError(apiError)
 }
+
 func NewApiResponse(res *client.GenericResponse) error {
+	if JSONOutput() {
+		return Output(NewMutationResult(res))
+	}
+
 	fmt.Printf("%s: %s \nchanged: %d \n", res.GetTitle().Value, res.GetDetail().Value, res.GetChanged().Value)
 	return nil
 }
@@ -143,6 +120,14 @@ func SetupLogging() error {
 	}
 	golog.SetOutput(ioutil.Discard)
 
+	// Only the server logs to logging.file, client commands report to the
+	// terminal
+	logCfg := LoggingConfig()
+	logCfg.File = ""
+	if err := logger.Setup(logCfg); err != nil {
+		return err
+	}
+
 	if cfgFileUsed != "" {
 		Log.Infof("Using config file: %s", cfgFileUsed)
 	}
@@ -150,7 +135,19 @@ func SetupLogging() error {
 	Root.SilenceUsage = true
 	Root.SilenceErrors = true
 
-	return nil
+	return validateOutput()
+}
+
+// LoggingConfig returns the settings of the logging config section
+func LoggingConfig() logger.Config {
+	return logger.Config{
+		Format:     viper.GetString("logging.format"),
+		File:       viper.GetString("logging.file"),
+		MaxSize:    viper.GetInt("logging.max_size"),
+		MaxBackups: viper.GetInt("logging.max_backups"),
+		MaxAge:     viper.GetInt("logging.max_age"),
+		Compress:   viper.GetBool("logging.compress"),
+	}
 }
 
 func initConfig() {
@@ -174,9 +171,8 @@ func initConfig() {
 		viper.SetConfigType("toml")
 	}
 
-	viper.AutomaticEnv()
-	viper.SetEnvPrefix("grendel")
-	viper.SetEnvKeyReplacer(strings.NewReplacer(".", "_"))
+	config.SetEnv(viper.GetViper())
+	config.SaveDefaults(viper.GetViper())
 
 	if err := viper.ReadInConfig(); err == nil {
 		cfgFileUsed = viper.ConfigFileUsed()
@@ -0,0 +1,83 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package schedule
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/ubccr/grendel/cmd"
+	"github.com/ubccr/grendel/pkg/client"
+)
+
+var (
+	at      string
+	addTags []string
+	addCmd  = &cobra.Command{
+		Use:   "add {nodeset | all} {image <name> | provision [true|false] | power-cycle [override] | webhook <message>}",
+		Short: "Schedule an action on nodes",
+		Long: `Schedule an action on nodes at the time given by --at:
+
+  image <name>            set the boot image
+  provision [true|false]  set the nodes to provision, default true
+  power-cycle [override]  restart the nodes through their BMC with the redfish
+                          boot override: None (default), Pxe, BiosSetup,
+                          Utilities or Diags
+  webhook <message>       send the schedule.run webhook event
+
+Nodes matched by all or --tags are resolved when the action is added.`,
+		Example: `  grendel schedule add cpn-[001-128] image rocky9 --at "2026-10-24 02:00"
+  grendel schedule add cpn-[001-128] power-cycle Pxe --at "2026-10-24 02:05"`,
+		Args: cobra.RangeArgs(2, 3),
+		RunE: func(command *cobra.Command, args []string) error {
+			if at == "" {
+				return errors.New("--at is required")
+			}
+			runAt, err := parseAt(at)
+			if err != nil {
+				return err
+			}
+
+			nodeset, err := cmd.NodesetArg(args[0])
+			if err != nil {
+				return err
+			}
+
+			arg := ""
+			if len(args) == 3 {
+				arg = args[2]
+			} else if args[1] == "provision" {
+				arg = "true"
+			}
+
+			gc, err := cmd.NewOgenClient()
+			if err != nil {
+				return err
+			}
+
+			req := &client.ScheduleRequest{
+				Action:  args[1],
+				Nodeset: client.NewOptString(nodeset),
+				Tags:    client.NewOptString(strings.Join(addTags, ",")),
+				Arg:     client.NewOptString(arg),
+				RunAt:   runAt,
+			}
+			res, err := gc.POSTV1Schedule(context.Background(), req, client.POSTV1ScheduleParams{})
+			if err != nil {
+				return cmd.NewApiError(err)
+			}
+
+			return listOutput([]client.ScheduledAction{*res})
+		},
+	}
+)
+
+func init() {
+	addCmd.Flags().StringVar(&at, "at", "", "time to run the action: an RFC3339 time, a local time such as \"2006-01-02 15:04\" or a duration such as 2h from now")
+	addCmd.Flags().StringSliceVarP(&addTags, "tags", "t", []string{}, "run on the nodes with these tags")
+	scheduleCmd.AddCommand(addCmd)
+}
@@ -0,0 +1,36 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package schedule
+
+import (
+	"context"
+
+	"github.com/spf13/cobra"
+	"github.com/ubccr/grendel/cmd"
+	"github.com/ubccr/grendel/pkg/client"
+)
+
+var cancelCmd = &cobra.Command{
+	Use:   "cancel <id>",
+	Short: "Cancel a pending scheduled action",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(command *cobra.Command, args []string) error {
+		gc, err := cmd.NewOgenClient()
+		if err != nil {
+			return err
+		}
+
+		res, err := gc.DELETEV1ScheduleID(context.Background(), client.DELETEV1ScheduleIDParams{ID: args[0]})
+		if err != nil {
+			return cmd.NewApiError(err)
+		}
+
+		return cmd.NewApiResponse(res)
+	},
+}
+
+func init() {
+	scheduleCmd.AddCommand(cancelCmd)
+}
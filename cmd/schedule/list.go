@@ -0,0 +1,43 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package schedule
+
+import (
+	"context"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/ubccr/grendel/cmd"
+	"github.com/ubccr/grendel/pkg/client"
+)
+
+var (
+	statuses []string
+	listCmd  = &cobra.Command{
+		Use:   "list",
+		Short: "List scheduled actions",
+		Args:  cobra.NoArgs,
+		RunE: func(command *cobra.Command, args []string) error {
+			gc, err := cmd.NewOgenClient()
+			if err != nil {
+				return err
+			}
+
+			res, err := gc.GETV1Schedule(context.Background(), client.GETV1ScheduleParams{
+				Status: client.NewOptString(strings.Join(statuses, ",")),
+			})
+			if err != nil {
+				return cmd.NewApiError(err)
+			}
+
+			return listOutput(res)
+		},
+	}
+)
+
+func init() {
+	listCmd.Flags().StringSliceVar(&statuses, "status", []string{}, "filter by status: pending, running, done, partial, failed, cancelled or missed")
+	scheduleCmd.AddCommand(listCmd)
+}
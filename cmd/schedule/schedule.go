@@ -0,0 +1,86 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package schedule
+
+import (
+	"fmt"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/ubccr/grendel/cmd"
+	"github.com/ubccr/grendel/internal/util"
+	"github.com/ubccr/grendel/pkg/client"
+)
+
+var (
+	scheduleCmd = &cobra.Command{
+		Use:   "schedule",
+		Short: "Schedule actions on nodes at a later time",
+		Long: `Schedule actions run by grendel serve on a nodeset at a later time, such as
+setting the boot image and power cycling the nodes at 02:00 Saturday.
+
+Actions are stored in the database. Actions due while grendel serve was down
+run on startup unless they are due for longer than schedule.grace_period, in
+which case they are marked missed. Once an action ran, show lists the result
+on each node.`,
+	}
+)
+
+func init() {
+	cmd.Root.AddCommand(scheduleCmd)
+}
+
+// parseAt parses the value of the --at flag. It is either an RFC3339 time, a
+// local time such as "2026-10-24 02:00" or a duration such as 2h from now
+func parseAt(at string) (time.Time, error) {
+	if t, err := time.Parse(time.RFC3339, at); err == nil {
+		return t, nil
+	}
+
+	for _, layout := range []string{time.DateTime, "2006-01-02 15:04"} {
+		if t, err := time.ParseInLocation(layout, at, time.Local); err == nil {
+			return t, nil
+		}
+	}
+
+	d, err := util.ParseDuration(at)
+	if err != nil || d < 0 {
+		return time.Time{}, fmt.Errorf("invalid --at %q, use an RFC3339 time, a local time such as \"2006-01-02 15:04\" or a duration such as 2h", at)
+	}
+
+	return time.Now().Add(d), nil
+}
+
+func formatTime(t client.OptNilDateTime) string {
+	if t.Value.IsZero() {
+		return "-"
+	}
+
+	return t.Value.Local().Format(time.DateTime)
+}
+
+func listOutput(actions []client.ScheduledAction) error {
+	if cmd.JSONOutput() {
+		return cmd.Output(actions)
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "ID\tRUN AT\tACTION\tARG\tNODESET\tSTATUS\tCREATED BY")
+	for _, a := range actions {
+		fmt.Fprintf(w, "%d\t%s\t%s\t%s\t%s\t%s\t%s\n",
+			a.ID.Value,
+			a.RunAt.Local().Format(time.DateTime),
+			a.Action,
+			a.Arg.Value,
+			a.Nodeset,
+			a.Status.Value,
+			a.CreatedBy.Value,
+		)
+	}
+
+	return w.Flush()
+}
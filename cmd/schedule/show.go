@@ -0,0 +1,86 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package schedule
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+	"github.com/ubccr/grendel/cmd"
+	"github.com/ubccr/grendel/pkg/client"
+	"github.com/ubccr/grendel/pkg/nodeset"
+)
+
+var showCmd = &cobra.Command{
+	Use:   "show <id>",
+	Short: "Show a scheduled action and the result on each node",
+	Long: `Show a scheduled action and, once it ran, the result on each node followed by
+the nodesets that succeeded and failed, ready to be retried.`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(command *cobra.Command, args []string) error {
+		gc, err := cmd.NewOgenClient()
+		if err != nil {
+			return err
+		}
+
+		res, err := gc.GETV1ScheduleID(context.Background(), client.GETV1ScheduleIDParams{ID: args[0]})
+		if err != nil {
+			return cmd.NewApiError(err)
+		}
+
+		if cmd.JSONOutput() {
+			return cmd.Output(res)
+		}
+
+		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+		fmt.Fprintf(w, "ID:\t%d\n", res.ID.Value)
+		fmt.Fprintf(w, "Action:\t%s %s\n", res.Action, res.Arg.Value)
+		fmt.Fprintf(w, "Nodeset:\t%s\n", res.Nodeset)
+		fmt.Fprintf(w, "Run at:\t%s\n", formatTime(client.NewOptNilDateTime(res.RunAt)))
+		fmt.Fprintf(w, "Created by:\t%s\n", res.CreatedBy.Value)
+		fmt.Fprintf(w, "Status:\t%s\n", res.Status.Value)
+		fmt.Fprintf(w, "Started:\t%s\n", formatTime(res.StartedAt))
+		fmt.Fprintf(w, "Finished:\t%s\n", formatTime(res.FinishedAt))
+		if res.Error.Value != "" {
+			fmt.Fprintf(w, "Error:\t%s\n", res.Error.Value)
+		}
+		if err := w.Flush(); err != nil {
+			return err
+		}
+
+		if len(res.Results.Value) == 0 {
+			return nil
+		}
+
+		succeeded := nodeset.EmptyNodeSet()
+		failed := nodeset.EmptyNodeSet()
+		fmt.Println()
+		w = tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+		fmt.Fprintln(w, "NODE\tSTATUS\tMESSAGE")
+		for _, item := range res.Results.Value {
+			r := item.Value
+			fmt.Fprintf(w, "%s\t%s\t%s\n", r.Host.Value, r.Status.Value, r.Msg.Value)
+			if r.Status.Value == "success" {
+				succeeded.Add(r.Host.Value)
+			} else {
+				failed.Add(r.Host.Value)
+			}
+		}
+		if err := w.Flush(); err != nil {
+			return err
+		}
+
+		fmt.Printf("\nSucceeded: %s\nFailed: %s\n", succeeded.String(), failed.String())
+
+		return nil
+	},
+}
+
+func init() {
+	scheduleCmd.AddCommand(showCmd)
+}
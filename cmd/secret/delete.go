@@ -0,0 +1,36 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package secret
+
+import (
+	"context"
+
+	"github.com/spf13/cobra"
+	"github.com/ubccr/grendel/cmd"
+	"github.com/ubccr/grendel/pkg/client"
+)
+
+var deleteCmd = &cobra.Command{
+	Use:   "delete <name>",
+	Short: "Delete a secret read by provision templates",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(command *cobra.Command, args []string) error {
+		gc, err := cmd.NewOgenClient()
+		if err != nil {
+			return err
+		}
+
+		res, err := gc.DELETEV1SecretsName(context.Background(), client.DELETEV1SecretsNameParams{Name: args[0]})
+		if err != nil {
+			return cmd.NewApiError(err)
+		}
+
+		return cmd.NewApiResponse(res)
+	},
+}
+
+func init() {
+	secretCmd.AddCommand(deleteCmd)
+}
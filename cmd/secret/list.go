@@ -0,0 +1,50 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package secret
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/ubccr/grendel/cmd"
+	"github.com/ubccr/grendel/pkg/client"
+)
+
+var listCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List the secrets read by provision templates",
+	Args:  cobra.NoArgs,
+	RunE: func(command *cobra.Command, args []string) error {
+		gc, err := cmd.NewOgenClient()
+		if err != nil {
+			return err
+		}
+
+		res, err := gc.GETV1Secrets(context.Background(), client.GETV1SecretsParams{})
+		if err != nil {
+			return cmd.NewApiError(err)
+		}
+
+		if cmd.JSONOutput() {
+			return cmd.Output(res)
+		}
+
+		for _, s := range res {
+			once := ""
+			if s.OneTime.Value {
+				once = "one-time"
+			}
+			fmt.Printf("%-40s%-10s%s\n", s.Name, once, s.UpdatedAt.Value.Local().Format(time.DateTime))
+		}
+
+		return nil
+	},
+}
+
+func init() {
+	secretCmd.AddCommand(listCmd)
+}
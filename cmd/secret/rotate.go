@@ -0,0 +1,59 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package secret
+
+import (
+	"context"
+
+	"github.com/spf13/cobra"
+	"github.com/ubccr/grendel/cmd"
+	"github.com/ubccr/grendel/pkg/client"
+)
+
+var (
+	kind      string
+	grace     string
+	rotateCmd = &cobra.Command{
+		Use:   "rotate",
+		Short: "Generate new primary keys signing tokens",
+		Long: `Generate a new primary key signing boot tokens and API tokens, or only the
+kind in --kind. The new keys are stored in the database and picked up by every
+instance sharing it, credentials_key or api.secret must be set to seal them.
+
+The previous keys keep verifying tokens for --grace, so hosts in the middle of
+an install keep their boot tokens, then retire. The grace period defaults to
+provision.token_ttl for provision keys and 8h, the lifetime of the tokens of
+signed in users, for api keys. API tokens created with a longer expiry must
+be created again within the grace period.`,
+		Args: cobra.NoArgs,
+		RunE: func(command *cobra.Command, args []string) error {
+			gc, err := cmd.NewOgenClient()
+			if err != nil {
+				return err
+			}
+
+			req := &client.SigningKeyRotateRequest{}
+			if kind != "" {
+				req.Kind = client.NewOptString(kind)
+			}
+			if grace != "" {
+				req.Grace = client.NewOptString(grace)
+			}
+
+			res, err := gc.POSTV1GrendelKeysRotate(context.Background(), req, client.POSTV1GrendelKeysRotateParams{})
+			if err != nil {
+				return cmd.NewApiError(err)
+			}
+
+			return output(res)
+		},
+	}
+)
+
+func init() {
+	rotateCmd.Flags().StringVar(&kind, "kind", "", "kind of keys to rotate, both when empty. Valid options: provision, api")
+	rotateCmd.Flags().StringVar(&grace, "grace", "", "how long the previous keys keep verifying tokens, e.g. 2h")
+	secretCmd.AddCommand(rotateCmd)
+}
@@ -0,0 +1,71 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package secret
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/dustin/go-humanize"
+	"github.com/spf13/cobra"
+	"github.com/ubccr/grendel/cmd"
+	"github.com/ubccr/grendel/pkg/client"
+)
+
+var (
+	secretCmd = &cobra.Command{
+		Use:   "secret",
+		Short: "Show or rotate the keys signing tokens and manage template secrets",
+		Long: `Show the keys verifying boot tokens (provision) and API tokens (api). The
+primary key of each kind signs new tokens, the others verify tokens signed
+before a rotation until they retire.
+
+Before the first rotation the keys are the provision.secret and api.secret
+settings, a single secret or a list whose first secret is the primary key.
+Rotated keys are stored in the database, sealed with the credentials key, and
+replace the secrets of the config.
+
+The set, list and delete commands manage the secrets read by provision
+templates with {{ secret "name" }}.`,
+		Args: cobra.NoArgs,
+		RunE: func(command *cobra.Command, args []string) error {
+			gc, err := cmd.NewOgenClient()
+			if err != nil {
+				return err
+			}
+
+			res, err := gc.GETV1GrendelKeys(context.Background(), client.GETV1GrendelKeysParams{})
+			if err != nil {
+				return cmd.NewApiError(err)
+			}
+
+			return output(res)
+		},
+	}
+)
+
+func init() {
+	cmd.Root.AddCommand(secretCmd)
+}
+
+func output(keys []client.SigningKey) error {
+	if cmd.JSONOutput() {
+		return cmd.Output(keys)
+	}
+
+	fmt.Printf("%-12s%-12s%s\n", "KIND", "ID", "STATE")
+	for _, k := range keys {
+		state := "primary"
+		switch {
+		case !k.Primary.Value && k.RetireAt.Value.IsZero():
+			state = "verifying"
+		case !k.Primary.Value:
+			state = "retires " + humanize.Time(k.RetireAt.Value)
+		}
+		fmt.Printf("%-12s%-12s%s\n", k.Kind.Value, k.ID.Value, state)
+	}
+
+	return nil
+}
@@ -0,0 +1,113 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package secret
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/ubccr/grendel/cmd"
+	"github.com/ubccr/grendel/pkg/client"
+	"golang.org/x/term"
+)
+
+var (
+	oneTime bool
+	setCmd  = &cobra.Command{
+		Use:   "set <name> [-]",
+		Short: "Set a secret read by provision templates",
+		Long: `Set a secret read by provision templates with {{ secret "name" }}, such as a
+LUKS passphrase or a cluster join token. The value is read from stdin with -,
+without its trailing newline, or else from the terminal. It is encrypted by the
+server with the credentials key and is never returned by the API.
+
+With --one-time the secret is rendered only for the first request made with a
+boot token, later requests with the token render REDACTED and are logged.
+Setting the secret again renders it once more for every token.`,
+		Example: `  grendel secret set luks-passphrase - < passphrase
+  grendel secret set --one-time k3s-token -`,
+		Args: cobra.RangeArgs(1, 2),
+		RunE: func(command *cobra.Command, args []string) error {
+			if len(args) == 2 && args[1] != "-" {
+				return fmt.Errorf("invalid argument %q, use - to read the value from stdin", args[1])
+			}
+
+			value, err := readSecret(os.Stdin, len(args) == 2)
+			if err != nil {
+				return err
+			}
+
+			gc, err := cmd.NewOgenClient()
+			if err != nil {
+				return err
+			}
+
+			req := &client.SecretRequest{
+				Value:   value,
+				OneTime: client.NewOptBool(oneTime),
+			}
+			res, err := gc.PUTV1SecretsName(context.Background(), req, client.PUTV1SecretsNameParams{Name: args[0]})
+			if err != nil {
+				return cmd.NewApiError(err)
+			}
+
+			return cmd.NewApiResponse(res)
+		},
+	}
+)
+
+func init() {
+	setCmd.Flags().BoolVar(&oneTime, "one-time", false, "render the secret once per boot token")
+	secretCmd.AddCommand(setCmd)
+}
+
+// readSecret reads the value of a secret from r without its trailing newline
+// if fromStdin is true. Otherwise it prompts for the value twice on the
+// terminal
+func readSecret(r io.Reader, fromStdin bool) (string, error) {
+	if fromStdin {
+		data, err := io.ReadAll(r)
+		if err != nil {
+			return "", err
+		}
+		value := strings.TrimSuffix(strings.TrimSuffix(string(data), "\n"), "\r")
+		if value == "" {
+			return "", errors.New("empty secret on stdin")
+		}
+		return value, nil
+	}
+
+	fd := int(os.Stdin.Fd())
+	if !term.IsTerminal(fd) {
+		return "", errors.New("stdin is not a terminal, use - to read the value from stdin")
+	}
+
+	fmt.Fprint(os.Stderr, "Value: ")
+	value, err := term.ReadPassword(fd)
+	fmt.Fprintln(os.Stderr)
+	if err != nil {
+		return "", err
+	}
+	fmt.Fprint(os.Stderr, "Confirm Value: ")
+	confirm, err := term.ReadPassword(fd)
+	fmt.Fprintln(os.Stderr)
+	if err != nil {
+		return "", err
+	}
+
+	if string(value) != string(confirm) {
+		return "", errors.New("values do not match")
+	}
+	if len(value) == 0 {
+		return "", errors.New("empty secret")
+	}
+
+	return string(value), nil
+}
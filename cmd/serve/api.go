@@ -5,26 +5,44 @@
 package serve
 
 import (
-	"context"
 	"fmt"
 	"time"
 
+	"github.com/prometheus/client_golang/prometheus"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 	"github.com/ubccr/grendel/cmd"
 	"github.com/ubccr/grendel/internal/api"
+	"github.com/ubccr/grendel/internal/artifact"
+	"github.com/ubccr/grendel/internal/bmc"
+	"github.com/ubccr/grendel/internal/config"
+	"github.com/ubccr/grendel/internal/health"
+	"github.com/ubccr/grendel/internal/listeners"
+	"github.com/ubccr/grendel/internal/schedule"
+	"github.com/ubccr/grendel/internal/store"
+	"github.com/ubccr/grendel/internal/util"
 	"gopkg.in/tomb.v2"
 )
 
 func init() {
 	apiCmd.PersistentFlags().String("api-listen", fmt.Sprintf("127.0.0.1:%d", api.DefaultPort), "address to listen on")
-	viper.BindPFlag("api.listen", apiCmd.PersistentFlags().Lookup("api-listen"))
+	config.BindPFlag("api.listen", apiCmd.PersistentFlags().Lookup("api-listen"))
 	apiCmd.PersistentFlags().String("api-socket", "", "path to unix socket")
-	viper.BindPFlag("api.socket_path", apiCmd.PersistentFlags().Lookup("api-socket"))
+	config.BindPFlag("api.socket_path", apiCmd.PersistentFlags().Lookup("api-socket"))
 	apiCmd.PersistentFlags().String("api-cert", "", "path to ssl cert")
-	viper.BindPFlag("api.cert", apiCmd.PersistentFlags().Lookup("api-cert"))
+	config.BindPFlag("api.cert", apiCmd.PersistentFlags().Lookup("api-cert"))
 	apiCmd.PersistentFlags().String("api-key", "", "path to ssl key")
-	viper.BindPFlag("api.key", apiCmd.PersistentFlags().Lookup("api-key"))
+	config.BindPFlag("api.key", apiCmd.PersistentFlags().Lookup("api-key"))
+	apiCmd.PersistentFlags().Duration("api-store-timeout", api.DefaultStoreTimeout, "deadline of the datastore operations of a request, 0 disables")
+	config.BindPFlag("api.store_timeout", apiCmd.PersistentFlags().Lookup("api-store-timeout"))
+	viper.SetDefault("trash_retention", "7d")
+	viper.SetDefault("tombstone_retention", "30d")
+	viper.SetDefault("change_retention", "7d")
+	viper.SetDefault("image_retention", "30d")
+	viper.SetDefault("secret_claim_retention", "30d")
+	viper.SetDefault("schedule.grace_period", "1h")
+	viper.SetDefault("schedule.concurrency", schedule.DefaultConcurrency)
+	viper.SetDefault("schedule.retention", "30d")
 
 	serveCmd.AddCommand(apiCmd)
 }
@@ -35,47 +53,183 @@ var (
 		Short: "Run API server",
 		Long:  `Run API server`,
 		RunE: func(command *cobra.Command, args []string) error {
-			t := NewInterruptTomb()
-			t.Go(func() error { return serveAPI(t) })
-			return t.Wait()
+			return run(service{"api", startAPI})
 		},
 	}
 )
 
-func serveAPI(t *tomb.Tomb) error {
+func startAPI(t *tomb.Tomb) (func() error, error) {
 	apiListen, err := GetListenAddress(viper.GetString("api.listen"))
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	apiServer, err := api.NewServer(DB, viper.GetString("api.socket_path"), apiListen)
+	apiServer, err := api.NewServer(APIDB, viper.GetString("api.socket_path"), apiListen)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	apiServer.KeyFile = viper.GetString("api.key")
 	apiServer.CertFile = viper.GetString("api.cert")
 	apiServer.CORS = viper.GetBool("api.cors")
 	apiServer.SwaggerUI = viper.GetBool("api.swagger_ui")
+	apiServer.StoreTimeout = viper.GetDuration("api.store_timeout")
+	apiServer.Namespaces = APINamespaces
+
+	apiServer.Limiter, err = newLimiter("api")
+	if err != nil {
+		return nil, err
+	}
+
+	apiServer.AccessLog, err = newAccessLog("api")
+	if err != nil {
+		return nil, err
+	}
 
 	if viper.IsSet("api.listen") && !viper.IsSet("client.api_key") {
 		cmd.Log.Warn("client.api_key is not set, CLI authentication will not work. Either bind the API to a unix socket or signup for an account in the web ui and create a token")
 	}
 
+	artifacts, err := artifact.LoadConfig(viper.GetViper())
+	if err != nil {
+		return nil, err
+	}
+	apiServer.Artifacts = artifact.NewRegistry(artifacts)
+
+	config.OnReload(func(v *viper.Viper) (func(), error) {
+		artifacts, err := artifact.LoadConfig(v)
+		if err != nil {
+			return nil, err
+		}
+
+		return func() { apiServer.Artifacts.Configure(artifacts) }, nil
+	})
+
+	config.OnReload(func(v *viper.Viper) (func(), error) {
+		if err := bmc.CheckBiosProfiles(v); err != nil {
+			return nil, err
+		}
+
+		// BIOS profiles are read from the global configuration when used
+		return func() {}, nil
+	})
+
+	apiSocket := apiListen
+	if path := viper.GetString("api.socket_path"); path != "" {
+		apiSocket = path
+	}
+	apiServer.Listener, err = activatedListener("api", apiSocket)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := apiServer.Listen(); err != nil {
+		return nil, err
+	}
+	health.Register("api", apiServer.Check)
+	listeners.Add("api", apiServer.Addr())
+
 	t.Go(func() error {
 		time.Sleep(1 * time.Second)
 		<-t.Dying()
 		cmd.Log.Info("Shutting down API server...")
-		ctxShutdown, cancel := context.WithTimeout(context.Background(), 10*time.Second)
-		defer cancel()
+		return shutdown(cmd.Log, "API server", apiServer.Shutdown)
+	})
 
-		if err := apiServer.Shutdown(ctxShutdown); err != nil {
-			cmd.Log.Errorf("Failed shutting down API server: %s", err)
-			return err
-		}
+	return func() error {
+		serveBackground(t)
+		return apiServer.Serve()
+	}, nil
+}
 
-		return nil
+// serveBackground starts the purging, scheduled action and BMC monitoring
+// tasks run along with the API server
+func serveBackground(t *tomb.Tomb) {
+	if viper.GetBool("readonly") {
+		cmd.Log.Info("Read-only mode: purging and scheduled actions are left to the primary")
+	} else {
+		serveWrites(t)
+	}
+
+	monitor := bmc.NewMonitor(DB)
+	if monitor.Interval() > 0 {
+		prometheus.MustRegister(monitor)
+		cmd.Log.Infof("Polling BMC sensors of nodes tagged %s every %s", bmc.MonitorTag, monitor.Interval())
+		t.Go(func() error {
+			monitor.Run(t.Dying())
+			return nil
+		})
+	}
+
+	interval := time.Duration(viper.GetInt("bmc.subscription_interval")) * time.Second
+	if viper.GetString("bmc.event_url") != "" && interval > 0 {
+		cmd.Log.Infof("Checking BMC event subscriptions of nodes tagged %s every %s", bmc.EventsTag, interval)
+		t.Go(func() error {
+			bmc.WatchSubscriptions(DB, interval, t.Dying())
+			return nil
+		})
+	}
+}
+
+// serveWrites starts the purging and scheduled action tasks, which write to
+// the database
+func serveWrites(t *tomb.Tomb) {
+	t.Go(func() error {
+		return purgeExpired(t, "trash_retention", "node(s) from the trash", eachNamespace(store.Store.PurgeTrash))
+	})
+	t.Go(func() error {
+		return purgeExpired(t, "tombstone_retention", "deleted node and image name(s)", eachNamespace(store.Store.PurgeTombstones))
+	})
+	t.Go(func() error {
+		return purgeExpired(t, "change_retention", "change journal entries", eachNamespace(store.Store.PurgeChanges))
+	})
+	t.Go(func() error {
+		return purgeExpired(t, "image_retention", "previous image file path(s)", eachNamespace(store.Store.PurgeImageFiles))
+	})
+	t.Go(func() error {
+		return purgeExpired(t, "secret_claim_retention", "one-time secret claim(s)", eachNamespace(store.Store.PurgeSecretClaims))
+	})
+	t.Go(func() error {
+		return purgeExpired(t, "schedule.retention", "finished scheduled action(s)", DB.PurgeScheduledActions)
 	})
 
-	return apiServer.Serve()
+	scheduler, err := schedule.New(DB)
+	if err != nil {
+		cmd.Log.Errorf("Failed to start the scheduler: %s", err)
+	} else {
+		t.Go(func() error {
+			scheduler.Run(t.Dying())
+			return nil
+		})
+	}
+}
+
+// purgeExpired calls purge every hour with the time the retention set by the
+// given config key ago. A retention of 0 disables purging
+func purgeExpired(t *tomb.Tomb, key, what string, purge func(time.Time) (int, error)) error {
+	retention, err := util.ParseDuration(viper.GetString(key))
+	if err != nil {
+		return fmt.Errorf("invalid %s: %w", key, err)
+	}
+	if retention == 0 {
+		return nil
+	}
+
+	ticker := time.NewTicker(time.Hour)
+	defer ticker.Stop()
+
+	for {
+		n, err := purge(time.Now().Add(-retention))
+		if err != nil {
+			cmd.Log.Errorf("Failed to purge %s: %s", what, err)
+		} else if n > 0 {
+			cmd.Log.Infof("Purged %d %s older than %s", n, what, retention)
+		}
+
+		select {
+		case <-t.Dying():
+			return nil
+		case <-ticker.C:
+		}
+	}
 }
@@ -0,0 +1,73 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package serve
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/spf13/viper"
+	"github.com/ubccr/grendel/cmd"
+	"github.com/ubccr/grendel/internal/config"
+	"github.com/ubccr/grendel/internal/debug"
+	"github.com/ubccr/grendel/internal/health"
+	"github.com/ubccr/grendel/internal/listeners"
+	"gopkg.in/tomb.v2"
+)
+
+func init() {
+	serveCmd.PersistentFlags().Bool("debug-server", false, "serve pprof profiles and expvar variables on debug.listen")
+	config.BindPFlag("debug.enabled", serveCmd.PersistentFlags().Lookup("debug-server"))
+	serveCmd.PersistentFlags().String("debug-listen", debug.DefaultListen, "address of the debug server, a loopback address unless --debug-allow-remote")
+	config.BindPFlag("debug.listen", serveCmd.PersistentFlags().Lookup("debug-listen"))
+	serveCmd.PersistentFlags().Bool("debug-allow-remote", false, "allow the debug server on addresses other than loopback")
+	config.BindPFlag("debug.allow_remote", serveCmd.PersistentFlags().Lookup("debug-allow-remote"))
+}
+
+// startDebug serves the pprof profiles and expvar variables on debug.listen
+// when debug.enabled is set. The address is not changed by --listen and must
+// be a loopback address unless debug.allow_remote is set
+func startDebug(t *tomb.Tomb) (func() error, error) {
+	debugListen := viper.GetString("debug.listen")
+	if err := debug.CheckListen(debugListen, viper.GetBool("debug.allow_remote")); err != nil {
+		return nil, err
+	}
+
+	srv := &http.Server{
+		Addr:              debugListen,
+		Handler:           debug.NewHandler(),
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+
+	listener, err := net.Listen("tcp", debugListen)
+	if err != nil {
+		return nil, err
+	}
+	health.Register("debug", func(ctx context.Context) error {
+		return health.SocketOpen(listener)
+	})
+	listeners.Add("debug", listener.Addr())
+
+	t.Go(func() error {
+		time.Sleep(1 * time.Second)
+		<-t.Dying()
+		cmd.Log.Info("Shutting down debug server...")
+		// Profiles and traces run for their whole duration, do not wait
+		// for them
+		return srv.Close()
+	})
+
+	return func() error {
+		cmd.Log.Warnf("Debug server listening on: %s", debugListen)
+		err := srv.Serve(listener)
+		if errors.Is(err, http.ErrServerClosed) {
+			return nil
+		}
+		return err
+	}, nil
+}
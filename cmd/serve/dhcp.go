@@ -5,35 +5,54 @@
 package serve
 
 import (
-	"context"
+	"fmt"
 	"time"
 
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
+	"github.com/ubccr/grendel/internal/config"
 	"github.com/ubccr/grendel/internal/dhcp"
+	"github.com/ubccr/grendel/internal/ha"
+	"github.com/ubccr/grendel/internal/health"
+	"github.com/ubccr/grendel/internal/listeners"
 	"github.com/ubccr/grendel/internal/logger"
+	"github.com/ubccr/grendel/pkg/model"
 	"gopkg.in/tomb.v2"
 )
 
 func init() {
 	dhcpCmd.PersistentFlags().String("dhcp-listen", "0.0.0.0:67", "address to listen on")
-	viper.BindPFlag("dhcp.listen", dhcpCmd.PersistentFlags().Lookup("dhcp-listen"))
+	config.BindPFlag("dhcp.listen", dhcpCmd.PersistentFlags().Lookup("dhcp-listen"))
 	dhcpCmd.PersistentFlags().String("dhcp-lease-time", "24h", "default lease time")
-	viper.BindPFlag("dhcp.lease_time", dhcpCmd.PersistentFlags().Lookup("dhcp-lease-time"))
+	config.BindPFlag("dhcp.lease_time", dhcpCmd.PersistentFlags().Lookup("dhcp-lease-time"))
 	dhcpCmd.PersistentFlags().StringSlice("dhcp-dns-servers", []string{}, "dns servers list")
-	viper.BindPFlag("dhcp.dns_servers", dhcpCmd.PersistentFlags().Lookup("dhcp-dns-servers"))
+	config.BindPFlag("dhcp.dns_servers", dhcpCmd.PersistentFlags().Lookup("dhcp-dns-servers"))
 	dhcpCmd.PersistentFlags().StringSlice("dhcp-domain-search", []string{}, "domain name search list")
-	viper.BindPFlag("dhcp.domain_search", dhcpCmd.PersistentFlags().Lookup("dhcp-domain-search"))
+	config.BindPFlag("dhcp.domain_search", dhcpCmd.PersistentFlags().Lookup("dhcp-domain-search"))
 	dhcpCmd.PersistentFlags().Int("dhcp-mtu", 1500, "default mtu")
-	viper.BindPFlag("dhcp.mtu", dhcpCmd.PersistentFlags().Lookup("dhcp-mtu"))
+	config.BindPFlag("dhcp.mtu", dhcpCmd.PersistentFlags().Lookup("dhcp-mtu"))
 	dhcpCmd.PersistentFlags().Bool("dhcp-proxy-only", false, "only run boot proxy")
-	viper.BindPFlag("dhcp.proxy_only", dhcpCmd.PersistentFlags().Lookup("dhcp-proxy-only"))
+	config.BindPFlag("dhcp.proxy_only", dhcpCmd.PersistentFlags().Lookup("dhcp-proxy-only"))
+	dhcpCmd.PersistentFlags().Bool("dhcp-update-mac", false, "update the MAC address of hosts matched by SMBIOS UUID")
+	config.BindPFlag("dhcp.update_mac", dhcpCmd.PersistentFlags().Lookup("dhcp-update-mac"))
+	dhcpCmd.PersistentFlags().Bool("dhcp-bmc-discovery", false, "record DHCP requests from unknown BMCs as pending BMCs")
+	config.BindPFlag("dhcp.bmc_discovery", dhcpCmd.PersistentFlags().Lookup("dhcp-bmc-discovery"))
+	dhcpCmd.PersistentFlags().String("dhcp-reply-cache-ttl", dhcp.DefaultReplyCacheTTL.String(), "how long replies are reused for retransmitted requests, 0 disables")
+	config.BindPFlag("dhcp.reply_cache_ttl", dhcpCmd.PersistentFlags().Lookup("dhcp-reply-cache-ttl"))
+	dhcpCmd.PersistentFlags().String("dhcp-store-timeout", dhcp.DefaultStoreTimeout.String(), "deadline of the datastore lookups of a request, 0 disables")
+	config.BindPFlag("dhcp.store_timeout", dhcpCmd.PersistentFlags().Lookup("dhcp-store-timeout"))
+	viper.SetDefault("dhcp.bmc_vendor_classes", dhcp.DefaultBMCVendorClasses)
+	viper.SetDefault("dhcp.bmc_ouis", []string{})
+	viper.SetDefault("dhcp.states", dhcp.DefaultStates)
+	viper.SetDefault("dhcp.boot_states", dhcp.DefaultBootStates)
 	dhcpCmd.PersistentFlags().Int("dhcp-router-octet4", 0, "automatic router configuration")
-	viper.BindPFlag("dhcp.router_octet4", dhcpCmd.PersistentFlags().Lookup("dhcp-router-octet4"))
+	config.BindPFlag("dhcp.router_octet4", dhcpCmd.PersistentFlags().Lookup("dhcp-router-octet4"))
 	dhcpCmd.PersistentFlags().String("dhcp-gateway", "", "static gateway address")
-	viper.BindPFlag("dhcp.gateway", dhcpCmd.PersistentFlags().Lookup("dhcp-gateway"))
+	config.BindPFlag("dhcp.gateway", dhcpCmd.PersistentFlags().Lookup("dhcp-gateway"))
 	dhcpCmd.PersistentFlags().Int("dhcp-netmask", 0, "subnet mask")
-	viper.BindPFlag("dhcp.netmask", dhcpCmd.PersistentFlags().Lookup("dhcp-netmask"))
+	config.BindPFlag("dhcp.netmask", dhcpCmd.PersistentFlags().Lookup("dhcp-netmask"))
+	viper.SetDefault("ha.heartbeat_interval", ha.DefaultHeartbeatInterval.String())
+	viper.SetDefault("ha.failover_timeout", ha.DefaultFailoverTimeout.String())
 
 	serveCmd.AddCommand(dhcpCmd)
 }
@@ -45,52 +64,149 @@ var (
 		Short: "Run DHCP server",
 		Long:  `Run DHCP server`,
 		RunE: func(command *cobra.Command, args []string) error {
-			t := NewInterruptTomb()
-			t.Go(func() error { return serveDHCP(t) })
-			return t.Wait()
+			return run(service{"dhcp", startDHCP})
 		},
 	}
 )
 
-func serveDHCP(t *tomb.Tomb) error {
+func startDHCP(t *tomb.Tomb) (func() error, error) {
 	dhcpListen, err := GetListenAddress(viper.GetString("dhcp.listen"))
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	srv, err := dhcp.NewServer(DB, dhcpListen)
 	if err != nil {
-		return err
+		return nil, err
 	}
+	srv.Namespaces = Namespaces
 
-	leaseTime, err := time.ParseDuration(viper.GetString("dhcp.lease_time"))
+	settings, err := DHCPSettings(viper.GetViper())
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	srv.LeaseTime = leaseTime
-	dhcpLog.Infof("Default lease time: %s", srv.LeaseTime)
+	srv.Reload(settings)
+	dhcpLog.Infof("Default lease time: %s", settings.LeaseTime)
 
 	srv.ProxyOnly = viper.GetBool("dhcp.proxy_only")
 	if srv.ProxyOnly {
 		dhcpLog.Infof("Running in ProxyOnly mode")
 	}
 
-	t.Go(srv.Serve)
+	if settings.UpdateMAC {
+		dhcpLog.Infof("Updating MAC addresses of hosts matched by SMBIOS UUID")
+	}
+
+	if settings.BMCDiscovery != nil {
+		dhcpLog.Infof("Recording DHCP requests from unknown BMCs as pending BMCs")
+	}
+
+	config.OnReload(func(v *viper.Viper) (func(), error) {
+		settings, err := DHCPSettings(v)
+		if err != nil {
+			return nil, err
+		}
+
+		return func() { srv.Reload(settings) }, nil
+	})
+
+	if role := viper.GetString("ha.role"); role != "" {
+		srv.HA, err = ha.New(DB, ha.Config{
+			Name:              viper.GetString("ha.name"),
+			Role:              role,
+			HeartbeatInterval: viper.GetDuration("ha.heartbeat_interval"),
+			FailoverTimeout:   viper.GetDuration("ha.failover_timeout"),
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	srv.PacketConn, err = activatedPacketConn("dhcp", dhcpListen)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := srv.Listen(); err != nil {
+		return nil, err
+	}
+	health.Register("dhcp", srv.Check)
+	listeners.Add("dhcp", srv.Addr())
+
 	t.Go(func() error {
 		time.Sleep(1 * time.Second)
 		<-t.Dying()
 		dhcpLog.Info("Shutting down DHCP server...")
-		ctxShutdown, cancel := context.WithTimeout(context.Background(), 10*time.Second)
-		defer cancel()
+		return shutdown(dhcpLog, "DHCP server", srv.Shutdown)
+	})
 
-		if err := srv.Shutdown(ctxShutdown); err != nil {
-			dhcpLog.Errorf("Failed shutting down DHCP server: %s", err)
-			return err
+	return func() error {
+		if srv.HA != nil {
+			t.Go(func() error {
+				srv.HA.Run(t.Dying())
+				return nil
+			})
 		}
+		return srv.Serve()
+	}, nil
+}
 
-		return nil
-	})
+// DHCPSettings returns the settings of the DHCP server which may change
+// while it is running
+func DHCPSettings(v *viper.Viper) (*dhcp.Settings, error) {
+	leaseTime, err := time.ParseDuration(v.GetString("dhcp.lease_time"))
+	if err != nil {
+		return nil, fmt.Errorf("failed parsing dhcp.lease_time: %w", err)
+	}
+
+	replyCacheTTL, err := time.ParseDuration(v.GetString("dhcp.reply_cache_ttl"))
+	if err != nil {
+		return nil, fmt.Errorf("failed parsing dhcp.reply_cache_ttl: %w", err)
+	}
+
+	storeTimeout, err := time.ParseDuration(v.GetString("dhcp.store_timeout"))
+	if err != nil {
+		return nil, fmt.Errorf("failed parsing dhcp.store_timeout: %w", err)
+	}
+
+	states, err := hostStates(v, "dhcp.states")
+	if err != nil {
+		return nil, err
+	}
+
+	bootStates, err := hostStates(v, "dhcp.boot_states")
+	if err != nil {
+		return nil, err
+	}
+
+	settings := &dhcp.Settings{
+		LeaseTime:     leaseTime,
+		UpdateMAC:     v.GetBool("dhcp.update_mac"),
+		ReplyCacheTTL: replyCacheTTL,
+		StoreTimeout:  storeTimeout,
+		States:        states,
+		BootStates:    bootStates,
+		StrictSubnets: v.GetBool("dhcp.strict_subnets"),
+	}
+	if v.GetBool("dhcp.bmc_discovery") {
+		settings.BMCDiscovery, err = dhcp.NewBMCDiscovery(v.GetStringSlice("dhcp.bmc_vendor_classes"), v.GetStringSlice("dhcp.bmc_ouis"))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return settings, nil
+}
+
+// hostStates returns the host states of the config key
+func hostStates(v *viper.Viper, key string) ([]string, error) {
+	states := v.GetStringSlice(key)
+	for _, state := range states {
+		if err := model.ValidateHostState(state); err != nil {
+			return nil, fmt.Errorf("failed parsing %s: %w", key, err)
+		}
+	}
 
-	return nil
+	return states, nil
 }
@@ -5,13 +5,16 @@
 package serve
 
 import (
-	"context"
+	"strings"
 	"time"
 
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 	"github.com/ubccr/grendel/cmd"
+	"github.com/ubccr/grendel/internal/config"
 	"github.com/ubccr/grendel/internal/dns"
+	"github.com/ubccr/grendel/internal/health"
+	"github.com/ubccr/grendel/internal/listeners"
 	"gopkg.in/tomb.v2"
 )
 
@@ -19,9 +22,12 @@ func init() {
 	dnsCmd.PersistentFlags().String("dns-listen", "0.0.0.0:53", "address to listen on")
 	dnsCmd.PersistentFlags().Int("dns-ttl", 300, "ttl for dns records")
 	dnsCmd.PersistentFlags().String("dns-forward", "", "address to forward dns queries not resolved by grendel. ex: 1.1.1.1:53")
-	viper.BindPFlag("dns.listen", dnsCmd.PersistentFlags().Lookup("dns-listen"))
-	viper.BindPFlag("dns.ttl", dnsCmd.PersistentFlags().Lookup("dns-ttl"))
-	viper.BindPFlag("dns.forward", dnsCmd.PersistentFlags().Lookup("dns-forward"))
+	config.BindPFlag("dns.listen", dnsCmd.PersistentFlags().Lookup("dns-listen"))
+	config.BindPFlag("dns.ttl", dnsCmd.PersistentFlags().Lookup("dns-ttl"))
+	config.BindPFlag("dns.forward", dnsCmd.PersistentFlags().Lookup("dns-forward"))
+	dnsCmd.PersistentFlags().Duration("dns-store-timeout", dns.DefaultStoreTimeout, "deadline of the datastore lookups of a query, 0 disables")
+	config.BindPFlag("dns.store_timeout", dnsCmd.PersistentFlags().Lookup("dns-store-timeout"))
+	viper.SetDefault("dns.states", dns.DefaultStates)
 
 	serveCmd.AddCommand(dnsCmd)
 }
@@ -32,43 +38,115 @@ var (
 		Short: "Run DNS server",
 		Long:  `Run DNS server`,
 		RunE: func(command *cobra.Command, args []string) error {
-			t := NewInterruptTomb()
-			t.Go(func() error { return serveDNS(t) })
-			return t.Wait()
+			return run(service{"dns", startDNS})
 		},
 	}
 )
 
-func serveDNS(t *tomb.Tomb) error {
+func startDNS(t *tomb.Tomb) (func() error, error) {
 	dnsListen, err := GetListenAddress(viper.GetString("dns.listen"))
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	dnsServer, err := dns.NewServer(DB, dnsListen, viper.GetInt("dns.ttl"))
 	if err != nil {
-		return err
+		return nil, err
 	}
+	dnsServer.SetNamespaces(Namespaces)
+
+	dnsServer.PacketConn, err = activatedPacketConn("dns", dnsListen)
+	if err != nil {
+		return nil, err
+	}
+
+	// Zone transfers of the reverse zones are answered over TCP only when
+	// clients are allowed
+	if len(viper.GetStringSlice("dns.allow_transfer")) > 0 {
+		dnsServer.Transfers = true
+		dnsServer.Listener, err = activatedListener("dns", dnsListen)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	acl, err := dns.ACLFromConfig(viper.GetViper())
+	if err != nil {
+		return nil, err
+	}
+	dnsServer.SetACL(acl)
+	delegations, err := dns.DelegationsFromConfig(viper.GetViper())
+	if err != nil {
+		return nil, err
+	}
+	dnsServer.SetDelegations(delegations)
+	states, err := hostStates(viper.GetViper(), "dns.states")
+	if err != nil {
+		return nil, err
+	}
+	dnsServer.SetStates(states)
+	config.OnReload(func(v *viper.Viper) (func(), error) {
+		acl, err := dns.ACLFromConfig(v)
+		if err != nil {
+			return nil, err
+		}
+		delegations, err := dns.DelegationsFromConfig(v)
+		if err != nil {
+			return nil, err
+		}
+		states, err := hostStates(v, "dns.states")
+		if err != nil {
+			return nil, err
+		}
+		if _, err := dns.ZonesFromConfig(v, nil); err != nil {
+			return nil, err
+		}
+
+		return func() {
+			dnsServer.SetACL(acl)
+			dnsServer.SetDelegations(delegations)
+			dnsServer.SetStates(states)
+			// The settings of the reloaded dhcp.subnets are current once
+			// applied, the zones were checked above
+			zones, _ := dns.ZonesFromConfig(v, config.Current().Subnets)
+			dnsServer.SetZones(zones)
+		}, nil
+	})
+
+	if err := dnsServer.Listen(); err != nil {
+		return nil, err
+	}
+	health.Register("dns", dnsServer.Check)
+	listeners.Add("dns", dnsServer.Addr())
+	listeners.Add("dns", dnsServer.TCPAddr())
 
 	fwAddr := viper.GetString("dns.forward")
 	if fwAddr != "" {
 		cmd.Log.Debugf("dns.forward address set, using: %s", fwAddr)
 	}
 
+	zones, err := dns.ZonesFromConfig(viper.GetViper(), config.Current().Subnets)
+	if err != nil {
+		return nil, err
+	}
+	dnsServer.SetZones(zones)
+	for _, z := range zones {
+		if z.Forward() {
+			cmd.Log.Infof("Answering authoritatively for zone %s", z.Name)
+			continue
+		}
+		cmd.Log.Infof("Answering authoritatively for reverse zone %s (%s)", z.Name, z.Prefix)
+	}
+	for _, d := range delegations {
+		cmd.Log.Infof("Delegating zone %s to %s", d.Zone, strings.Join(d.Nameservers, ", "))
+	}
+
 	t.Go(func() error {
 		time.Sleep(1 * time.Second)
 		<-t.Dying()
 		cmd.Log.Info("Shutting down DNS server...")
-		ctxShutdown, cancel := context.WithTimeout(context.Background(), 10*time.Second)
-		defer cancel()
-
-		if err := dnsServer.Shutdown(ctxShutdown); err != nil {
-			cmd.Log.Errorf("Failed shutting down DNS server: %s", err)
-			return err
-		}
-
-		return nil
+		return shutdown(cmd.Log, "DNS server", dnsServer.Shutdown)
 	})
 
-	return dnsServer.Serve()
+	return dnsServer.Serve, nil
 }
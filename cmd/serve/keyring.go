@@ -0,0 +1,28 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package serve
+
+import (
+	"fmt"
+
+	"github.com/ubccr/grendel/internal/keyring"
+	"gopkg.in/tomb.v2"
+)
+
+// watchSigningKeys reads the keys created by grendel secret rotate from the
+// data store and keeps reading them while the services run to pick up
+// rotations made through other instances
+func watchSigningKeys(t *tomb.Tomb) error {
+	if err := keyring.Load(APIDB); err != nil {
+		return fmt.Errorf("failed to read signing keys: %w", err)
+	}
+
+	t.Go(func() error {
+		keyring.Watch(APIDB, keyring.DefaultWatchInterval, t.Dying())
+		return nil
+	})
+
+	return nil
+}
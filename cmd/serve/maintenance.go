@@ -0,0 +1,28 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package serve
+
+import (
+	"fmt"
+
+	"github.com/ubccr/grendel/internal/maintenance"
+	"gopkg.in/tomb.v2"
+)
+
+// watchMaintenance reads maintenance mode from the data store, so it survives
+// restarts, and keeps reading it while the services run to pick up changes
+// made through other instances
+func watchMaintenance(t *tomb.Tomb) error {
+	if err := maintenance.Load(APIDB); err != nil {
+		return fmt.Errorf("failed to read maintenance mode: %w", err)
+	}
+
+	t.Go(func() error {
+		maintenance.Watch(APIDB, maintenance.DefaultWatchInterval, t.Dying())
+		return nil
+	})
+
+	return nil
+}
@@ -0,0 +1,114 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package serve
+
+import (
+	"errors"
+	"net"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/spf13/viper"
+	"github.com/ubccr/grendel/cmd"
+	"github.com/ubccr/grendel/internal/api"
+	"github.com/ubccr/grendel/internal/config"
+	"github.com/ubccr/grendel/internal/health"
+	"github.com/ubccr/grendel/internal/listeners"
+	"github.com/ubccr/grendel/internal/mdns"
+	"gopkg.in/tomb.v2"
+)
+
+func init() {
+	serveCmd.PersistentFlags().Bool("mdns", false, "announce the api and provision services over mDNS")
+	config.BindPFlag("mdns.enabled", serveCmd.PersistentFlags().Lookup("mdns"))
+	serveCmd.PersistentFlags().StringSlice("mdns-interfaces", []string{}, "interfaces the services are announced on, all multicast interfaces by default")
+	config.BindPFlag("mdns.interfaces", serveCmd.PersistentFlags().Lookup("mdns-interfaces"))
+	serveCmd.PersistentFlags().String("mdns-name", "", "mDNS instance and host name, grendel-<hostname> by default")
+	config.BindPFlag("mdns.name", serveCmd.PersistentFlags().Lookup("mdns-name"))
+}
+
+// mdnsServices returns the DNS-SD services of the enabled api and provision
+// services. An API bound to a unix socket is not announced
+func mdnsServices(enabled []string) ([]mdns.Service, error) {
+	services := make([]mdns.Service, 0, 2)
+	version := "version=" + api.Version
+
+	if slices.Contains(enabled, "api") && viper.GetString("api.socket_path") == "" {
+		apiListen, err := GetListenAddress(viper.GetString("api.listen"))
+		if err != nil {
+			return nil, err
+		}
+		_, port, err := net.SplitHostPort(apiListen)
+		if err != nil {
+			return nil, err
+		}
+		p, err := strconv.Atoi(port)
+		if err != nil {
+			return nil, err
+		}
+
+		scheme := "http"
+		if viper.GetString("api.cert") != "" && viper.GetString("api.key") != "" {
+			scheme = "https"
+		}
+		services = append(services, mdns.Service{Type: mdns.ServiceAPI, Port: p, TXT: []string{version, "scheme=" + scheme}})
+	}
+
+	if slices.Contains(enabled, "provision") {
+		services = append(services, mdns.Service{
+			Type: mdns.ServiceProvision,
+			Port: int(config.Current().ProvisionAddr.Port()),
+			TXT:  []string{version, "scheme=" + config.Current().ProvisionScheme},
+		})
+	}
+
+	if len(services) == 0 {
+		return nil, errors.New("mdns is enabled but neither the api service on a TCP address nor the provision service is started")
+	}
+
+	return services, nil
+}
+
+// startMDNS announces the api and provision services of grendel serve over
+// mDNS when mdns.enabled is set. It binds the mDNS port 5353, shared with
+// other responders, and never the unicast DNS port
+func startMDNS(t *tomb.Tomb, enabled []string) (func() error, error) {
+	services, err := mdnsServices(enabled)
+	if err != nil {
+		return nil, err
+	}
+
+	name := viper.GetString("mdns.name")
+	if name == "" {
+		hostname, err := os.Hostname()
+		if err != nil {
+			return nil, err
+		}
+		short, _, _ := strings.Cut(hostname, ".")
+		name = "grendel-" + short
+	}
+
+	responder, err := mdns.NewResponder(name, viper.GetStringSlice("mdns.interfaces"), services)
+	if err != nil {
+		return nil, err
+	}
+	if err := responder.Listen(); err != nil {
+		return nil, err
+	}
+	health.Register("mdns", responder.Check)
+	listeners.Add("mdns", responder.Addr())
+
+	t.Go(func() error {
+		time.Sleep(1 * time.Second)
+		<-t.Dying()
+		cmd.Log.Info("Withdrawing mDNS records...")
+		return shutdown(cmd.Log, "mDNS responder", responder.Shutdown)
+	})
+
+	return responder.Serve, nil
+}
@@ -0,0 +1,88 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package serve
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"github.com/ubccr/grendel/cmd"
+	"github.com/ubccr/grendel/internal/config"
+	"github.com/ubccr/grendel/internal/health"
+	"github.com/ubccr/grendel/internal/listeners"
+	"gopkg.in/tomb.v2"
+)
+
+func init() {
+	metricsCmd.PersistentFlags().String("metrics-listen", "0.0.0.0:9680", "address to listen on")
+	config.BindPFlag("metrics.listen", metricsCmd.PersistentFlags().Lookup("metrics-listen"))
+
+	serveCmd.AddCommand(metricsCmd)
+}
+
+var (
+	metricsCmd = &cobra.Command{
+		Use:   "metrics",
+		Short: "Run prometheus metrics server",
+		Long:  `Run prometheus metrics server`,
+		RunE: func(command *cobra.Command, args []string) error {
+			return run(service{"metrics", startMetrics})
+		},
+	}
+)
+
+// startMetrics serves the prometheus metrics of all the services on GET
+// /metrics, on a listener separate from the user facing services
+func startMetrics(t *tomb.Tomb) (func() error, error) {
+	metricsListen, err := GetListenAddress(viper.GetString("metrics.listen"))
+	if err != nil {
+		return nil, err
+	}
+
+	mux := http.NewServeMux()
+	mux.Handle("GET /metrics", promhttp.Handler())
+	srv := &http.Server{
+		Addr:              metricsListen,
+		Handler:           mux,
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+
+	listener, err := activatedListener("metrics", metricsListen)
+	if err != nil {
+		return nil, err
+	}
+	if listener == nil {
+		listener, err = net.Listen("tcp", metricsListen)
+		if err != nil {
+			return nil, err
+		}
+	}
+	health.Register("metrics", func(ctx context.Context) error {
+		return health.SocketOpen(listener)
+	})
+	listeners.Add("metrics", listener.Addr())
+
+	t.Go(func() error {
+		time.Sleep(1 * time.Second)
+		<-t.Dying()
+		cmd.Log.Info("Shutting down metrics server...")
+		return shutdown(cmd.Log, "metrics server", srv.Shutdown)
+	})
+
+	return func() error {
+		cmd.Log.Infof("Metrics server listening on: %s", metricsListen)
+		err := srv.Serve(listener)
+		if errors.Is(err, http.ErrServerClosed) {
+			return nil
+		}
+		return err
+	}, nil
+}
@@ -0,0 +1,106 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package serve
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/spf13/viper"
+	"github.com/ubccr/grendel/cmd"
+	"github.com/ubccr/grendel/internal/store"
+	"github.com/ubccr/grendel/internal/store/cachestore"
+	"github.com/ubccr/grendel/internal/store/namespace"
+	"github.com/ubccr/grendel/internal/store/readonly"
+	"github.com/ubccr/grendel/internal/store/sqlstore"
+)
+
+var (
+	// Namespaces are the stores of the namespaces used by the serving
+	// paths and APINamespaces the stores read by the API, nil without
+	// namespaces
+	Namespaces    *namespace.Registry
+	APINamespaces *namespace.Registry
+
+	namespaceList  []*namespace.Namespace
+	namespaceLocks []*sqlstore.FileLock
+)
+
+// openNamespaces opens the databases of the namespaces the way the database
+// of dsn is opened: read-only with readonly set and cached with cache set
+func openNamespaces(dsn string) error {
+	var err error
+	namespaceList, err = namespace.LoadConfig(viper.GetViper(), dsn)
+	if err != nil || len(namespaceList) == 0 {
+		return err
+	}
+
+	stores := make(map[string]store.Store, len(namespaceList))
+	apiStores := make(map[string]store.Store, len(namespaceList))
+	for _, ns := range namespaceList {
+		sqlDB, lock, err := openDatabase(ns.DBPath, viper.GetBool("readonly"))
+		if err != nil {
+			return fmt.Errorf("namespace %s: %w", ns.Name, err)
+		}
+		namespaceLocks = append(namespaceLocks, lock)
+
+		var db store.Store = sqlDB
+		if viper.GetBool("readonly") {
+			db = readonly.New(db, viper.GetString("readonly_primary"))
+		}
+		stores[ns.Name], apiStores[ns.Name] = db, db
+		if viper.GetBool("cache") {
+			cache := cachestore.New(db, viper.GetDuration("cache_ttl"))
+			stores[ns.Name], apiStores[ns.Name] = cache, cache.Bypass()
+		}
+
+		cmd.Log.Infof("Using database %s for namespace %s", ns.DBPath, ns.Name)
+	}
+
+	Namespaces = namespace.NewRegistry(DB, namespaceList, stores)
+	APINamespaces = namespace.NewRegistry(APIDB, namespaceList, apiStores)
+
+	return nil
+}
+
+// closeNamespaces closes the databases of the namespaces
+func closeNamespaces() error {
+	if Namespaces != nil {
+		cmd.Log.Info("Closing namespace databases")
+		if err := Namespaces.Close(); err != nil {
+			return err
+		}
+	}
+
+	for _, lock := range namespaceLocks {
+		if err := lock.Unlock(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// eachNamespace returns a purge of the store of every namespace, the number
+// purged is the sum of them
+func eachNamespace(purge func(store.Store, time.Time) (int, error)) func(time.Time) (int, error) {
+	return func(before time.Time) (int, error) {
+		stores := []store.Store{DB}
+		if Namespaces != nil {
+			stores = Namespaces.Stores()
+		}
+
+		total := 0
+		for _, db := range stores {
+			n, err := purge(db, before)
+			if err != nil {
+				return total, err
+			}
+			total += n
+		}
+
+		return total, nil
+	}
+}
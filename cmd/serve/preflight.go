@@ -0,0 +1,72 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package serve
+
+import (
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"github.com/ubccr/grendel/cmd"
+	"github.com/ubccr/grendel/internal/preflight"
+)
+
+var skipPreflight bool
+
+func init() {
+	serveCmd.PersistentFlags().BoolVar(&skipPreflight, "skip-preflight", false, "start without checking the datastore, listen ports, templates, certificates, boot image files and subnets first")
+}
+
+// Preflight runs the preflight checks of the services enabled in the
+// configuration or listed in --services. runtime adds the checks of the state
+// of the host, see preflight.Options
+func Preflight(runtime bool) ([]*preflight.Problem, error) {
+	enabled, err := enabledServices()
+	if err != nil {
+		return nil, err
+	}
+
+	names := make([]string, 0, len(enabled))
+	for _, s := range enabled {
+		names = append(names, s.name)
+	}
+
+	return runPreflight(names, runtime), nil
+}
+
+func runPreflight(names []string, runtime bool) []*preflight.Problem {
+	if viper.GetBool("debug.enabled") {
+		names = append(names, "debug")
+	}
+
+	return preflight.Run(preflight.Options{
+		Services:  names,
+		Runtime:   runtime,
+		Listen:    GetListenAddress,
+		Activated: activatedPort,
+	})
+}
+
+// checkPreflight runs every preflight check of the services started by
+// command before the database is opened, logging warnings and returning an
+// error listing all failures
+func checkPreflight(command *cobra.Command) error {
+	var problems []*preflight.Problem
+	if command == serveCmd {
+		var err error
+		problems, err = Preflight(true)
+		if err != nil {
+			return err
+		}
+	} else {
+		problems = runPreflight([]string{command.Name()}, true)
+	}
+
+	for _, p := range problems {
+		if p.Warning {
+			cmd.Log.Warn(p.String())
+		}
+	}
+
+	return preflight.Error(problems)
+}
@@ -0,0 +1,253 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package serve
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"os/user"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"syscall"
+
+	"github.com/spf13/viper"
+	"github.com/ubccr/grendel/cmd"
+	"github.com/ubccr/grendel/internal/provision"
+	"github.com/ubccr/grendel/internal/store/sqlstore"
+	"golang.org/x/sys/unix"
+)
+
+// credentials are the user and groups set by --user and --group
+type credentials struct {
+	name   string
+	uid    int
+	gid    int
+	groups []int
+}
+
+// lookupCredentials returns the credentials of the user and group, which
+// are either names or numeric IDs. The group defaults to the primary group
+// of the user
+func lookupCredentials(name, group string) (*credentials, error) {
+	u, err := user.Lookup(name)
+	if err != nil {
+		var unknown user.UnknownUserError
+		if !errors.As(err, &unknown) {
+			return nil, err
+		}
+		if u, err = user.LookupId(name); err != nil {
+			return nil, fmt.Errorf("unknown user %q", name)
+		}
+	}
+
+	c := &credentials{name: u.Username}
+	if c.uid, err = strconv.Atoi(u.Uid); err != nil {
+		return nil, fmt.Errorf("invalid uid of user %s: %s", u.Username, u.Uid)
+	}
+	if c.gid, err = strconv.Atoi(u.Gid); err != nil {
+		return nil, fmt.Errorf("invalid gid of user %s: %s", u.Username, u.Gid)
+	}
+
+	if group != "" {
+		g, err := user.LookupGroup(group)
+		if err != nil {
+			var unknown user.UnknownGroupError
+			if !errors.As(err, &unknown) {
+				return nil, err
+			}
+			if g, err = user.LookupGroupId(group); err != nil {
+				return nil, fmt.Errorf("unknown group %q", group)
+			}
+		}
+		if c.gid, err = strconv.Atoi(g.Gid); err != nil {
+			return nil, fmt.Errorf("invalid gid of group %s: %s", g.Name, g.Gid)
+		}
+	}
+
+	c.groups = []int{c.gid}
+	gids, err := u.GroupIds()
+	if err != nil {
+		return nil, fmt.Errorf("failed to list the groups of user %s: %w", u.Username, err)
+	}
+	for _, id := range gids {
+		gid, err := strconv.Atoi(id)
+		if err != nil || gid == c.gid {
+			continue
+		}
+		c.groups = append(c.groups, gid)
+	}
+
+	return c, nil
+}
+
+// dropPrivileges switches to the user and group set by --user and --group.
+// It is called once the services bound their sockets and loaded their
+// certificates, and checks the user can still access the files grendel uses
+func dropPrivileges() error {
+	name := viper.GetString("user")
+	group := viper.GetString("group")
+	if name == "" {
+		if group != "" {
+			return errors.New("--group requires --user")
+		}
+		return nil
+	}
+
+	c, err := lookupCredentials(name, group)
+	if err != nil {
+		return err
+	}
+
+	if os.Geteuid() != 0 {
+		if os.Geteuid() == c.uid && os.Getegid() == c.gid {
+			return nil
+		}
+		return fmt.Errorf("running as uid %d, start grendel serve as root to switch to user %s", os.Geteuid(), c.name)
+	}
+
+	// Listed before switching, the database may not be readable by the user
+	paths := requiredAccess()
+
+	// The API socket is created by root, hand it over so members of the group
+	// can connect and the socket is removed on shutdown
+	if socket := viper.GetString("api.socket_path"); socket != "" {
+		if err := os.Chown(socket, c.uid, c.gid); err != nil && !errors.Is(err, os.ErrNotExist) {
+			return fmt.Errorf("failed to change owner of %s: %w", socket, err)
+		}
+	}
+
+	// Go applies these to all threads of the process
+	if err := syscall.Setgroups(c.groups); err != nil {
+		return fmt.Errorf("failed to set supplementary groups: %w", err)
+	}
+	if err := syscall.Setgid(c.gid); err != nil {
+		return fmt.Errorf("failed to switch to gid %d: %w", c.gid, err)
+	}
+	if err := syscall.Setuid(c.uid); err != nil {
+		return fmt.Errorf("failed to switch to user %s: %w", c.name, err)
+	}
+
+	cmd.Log.Infof("Switched to user %s (uid %d, gid %d)", c.name, c.uid, c.gid)
+
+	return checkAccess(c.name, paths)
+}
+
+// access is a path which must remain accessible once privileges are dropped
+type access struct {
+	path string
+	mode uint32
+	what string
+}
+
+// requiredAccess returns the files read and written by the enabled services
+// after startup
+func requiredAccess() []access {
+	paths := make([]access, 0)
+
+	dsn := viper.GetString("dsn")
+	if dsn == "" {
+		dsn = viper.GetString("dbpath")
+	}
+	if dsn != ":memory:" && viper.GetString("dbtype") != "postgres" && !sqlstore.IsPostgresDSN(dsn) {
+		// SQLite creates its journal files next to the database
+		paths = append(paths,
+			access{dsn, unix.R_OK | unix.W_OK, "database"},
+			access{dsn + "-wal", unix.R_OK | unix.W_OK, "database"},
+			access{dsn + "-shm", unix.R_OK | unix.W_OK, "database"},
+			access{filepath.Dir(dsn), unix.R_OK | unix.W_OK | unix.X_OK, "database directory"},
+		)
+	}
+	for _, ns := range namespaceList {
+		if ns.DBPath == ":memory:" {
+			continue
+		}
+		what := "database of namespace " + ns.Name
+		paths = append(paths,
+			access{ns.DBPath, unix.R_OK | unix.W_OK, what},
+			access{ns.DBPath + "-wal", unix.R_OK | unix.W_OK, what},
+			access{ns.DBPath + "-shm", unix.R_OK | unix.W_OK, what},
+			access{filepath.Dir(ns.DBPath), unix.R_OK | unix.W_OK | unix.X_OK, what + " directory"},
+		)
+	}
+
+	if file := viper.GetString("logging.file"); file != "" {
+		// Rotating creates a new file
+		paths = append(paths, access{filepath.Dir(file), unix.W_OK | unix.X_OK, "log directory"})
+	}
+
+	if repo := viper.GetString("provision.repo_dir"); repo != "" {
+		paths = append(paths, access{repo, unix.R_OK | unix.X_OK, "provision.repo_dir"})
+	}
+	paths = append(paths, access{provision.TemplateDir, unix.R_OK | unix.X_OK, "template directory"})
+	for _, ns := range namespaceList {
+		paths = append(paths, access{provision.NamespaceTemplateDir(ns), unix.R_OK | unix.X_OK, "template directory of namespace " + ns.Name})
+	}
+
+	// Certificates obtained with ACME are renewed by the user
+	if viper.GetBool("provision.acme.enabled") {
+		paths = append(paths, access{viper.GetString("provision.acme.cache_dir"), unix.R_OK | unix.W_OK | unix.X_OK, "provision.acme.cache_dir"})
+	}
+
+	images, err := DB.BootImages()
+	if err != nil {
+		cmd.Log.Warnf("Failed to check boot image files: %s", err)
+		return paths
+	}
+	for _, image := range images {
+		what := "boot image " + image.Name
+		for _, file := range image.Files() {
+			paths = append(paths, access{file, unix.R_OK, what})
+		}
+	}
+
+	return paths
+}
+
+// checkAccess returns an error listing the files the user cannot access.
+// Missing files are left to the services to report
+func checkAccess(name string, paths []access) error {
+	denied := make([]string, 0)
+	for _, a := range paths {
+		err := unix.Access(a.path, a.mode)
+		if err == nil || errors.Is(err, unix.ENOENT) {
+			continue
+		}
+		denied = append(denied, fmt.Sprintf("%s %s (%s)", a.what, a.path, modeString(a.mode)))
+	}
+
+	if file := viper.ConfigFileUsed(); file != "" && unix.Access(file, unix.R_OK) != nil {
+		cmd.Log.Warnf("User %s cannot read %s, reloading the configuration will fail", name, file)
+	}
+	if !viper.GetBool("provision.acme.enabled") {
+		for _, key := range []string{"provision.cert", "provision.key"} {
+			if file := viper.GetString(key); file != "" && unix.Access(file, unix.R_OK) != nil {
+				cmd.Log.Warnf("User %s cannot read %s %s, changes to the certificate will not be reloaded", name, key, file)
+			}
+		}
+	}
+
+	if len(denied) > 0 {
+		return fmt.Errorf("user %s cannot access: %s", name, strings.Join(denied, ", "))
+	}
+
+	return nil
+}
+
+func modeString(mode uint32) string {
+	perms := make([]string, 0, 3)
+	if mode&unix.R_OK != 0 {
+		perms = append(perms, "read")
+	}
+	if mode&unix.W_OK != 0 {
+		perms = append(perms, "write")
+	}
+	if mode&unix.X_OK != 0 {
+		perms = append(perms, "search")
+	}
+
+	return strings.Join(perms, ", ")
+}
@@ -0,0 +1,86 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package serve
+
+import (
+	"os"
+	"os/user"
+	"strconv"
+	"testing"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/sys/unix"
+)
+
+func TestLookupCredentials(t *testing.T) {
+	current, err := user.Current()
+	require.NoError(t, err)
+
+	c, err := lookupCredentials(current.Username, "")
+	require.NoError(t, err)
+	assert.Equal(t, current.Username, c.name)
+	assert.Equal(t, current.Uid, strconv.Itoa(c.uid))
+	assert.Equal(t, current.Gid, strconv.Itoa(c.gid))
+	assert.Equal(t, c.gid, c.groups[0])
+
+	// Numeric IDs work too
+	c, err = lookupCredentials(current.Uid, current.Gid)
+	require.NoError(t, err)
+	assert.Equal(t, current.Username, c.name)
+	assert.Equal(t, current.Gid, strconv.Itoa(c.gid))
+
+	_, err = lookupCredentials("grendel-no-such-user", "")
+	assert.ErrorContains(t, err, `unknown user "grendel-no-such-user"`)
+
+	_, err = lookupCredentials(current.Username, "grendel-no-such-group")
+	assert.ErrorContains(t, err, `unknown group "grendel-no-such-group"`)
+}
+
+func TestDropPrivileges(t *testing.T) {
+	defer viper.Set("user", nil)
+	defer viper.Set("group", nil)
+
+	// Nothing to do without --user
+	assert.NoError(t, dropPrivileges())
+
+	viper.Set("group", "nogroup")
+	assert.ErrorContains(t, dropPrivileges(), "--group requires --user")
+
+	if os.Geteuid() == 0 {
+		t.Skip("switching users from root would affect the other tests")
+	}
+
+	// Running as the user already is fine, switching to another is not
+	current, err := user.Current()
+	require.NoError(t, err)
+	viper.Set("user", current.Username)
+	viper.Set("group", nil)
+	assert.NoError(t, dropPrivileges())
+
+	viper.Set("user", "root")
+	assert.ErrorContains(t, dropPrivileges(), "start grendel serve as root to switch to user root")
+}
+
+func TestCheckAccess(t *testing.T) {
+	dir := t.TempDir()
+
+	assert.NoError(t, checkAccess("grendel", []access{
+		{dir, unix.R_OK | unix.W_OK | unix.X_OK, "database directory"},
+		// Missing files are reported by the services using them
+		{dir + "/missing.db", unix.R_OK, "database"},
+	}))
+
+	if os.Geteuid() == 0 {
+		t.Skip("root can access any file")
+	}
+
+	require.NoError(t, os.Chmod(dir, 0o500))
+	defer os.Chmod(dir, 0o700)
+	assert.EqualError(t, checkAccess("grendel", []access{
+		{dir, unix.R_OK | unix.W_OK | unix.X_OK, "database directory"},
+	}), "user grendel cannot access: database directory "+dir+" (read, write, search)")
+}
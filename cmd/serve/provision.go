@@ -5,73 +5,210 @@
 package serve
 
 import (
-	"context"
+	"errors"
+	"fmt"
+	"os"
 	"time"
 
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 	"github.com/ubccr/grendel/cmd"
+	"github.com/ubccr/grendel/internal/boothook"
+	"github.com/ubccr/grendel/internal/certs"
+	"github.com/ubccr/grendel/internal/config"
+	"github.com/ubccr/grendel/internal/dns"
+	"github.com/ubccr/grendel/internal/health"
+	"github.com/ubccr/grendel/internal/listeners"
 	"github.com/ubccr/grendel/internal/provision"
 	"gopkg.in/tomb.v2"
 )
 
 func init() {
 	provisionCmd.Flags().String("provision-listen", "0.0.0.0:80", "address to listen on")
-	viper.BindPFlag("provision.listen", provisionCmd.Flags().Lookup("provision-listen"))
+	config.BindPFlag("provision.listen", provisionCmd.Flags().Lookup("provision-listen"))
 	provisionCmd.Flags().String("provision-cert", "", "path to ssl cert")
-	viper.BindPFlag("provision.cert", provisionCmd.Flags().Lookup("provision-cert"))
+	config.BindPFlag("provision.cert", provisionCmd.Flags().Lookup("provision-cert"))
 	provisionCmd.Flags().String("provision-key", "", "path to ssl key")
-	viper.BindPFlag("provision.key", provisionCmd.Flags().Lookup("provision-key"))
+	config.BindPFlag("provision.key", provisionCmd.Flags().Lookup("provision-key"))
 	provisionCmd.Flags().String("default-image", "", "default image name")
-	viper.BindPFlag("provision.default_image", provisionCmd.Flags().Lookup("default-image"))
+	config.BindPFlag("provision.default_image", provisionCmd.Flags().Lookup("default-image"))
 	provisionCmd.Flags().String("repo-dir", "", "path to repo dir")
-	viper.BindPFlag("provision.repo_dir", provisionCmd.Flags().Lookup("repo-dir"))
+	config.BindPFlag("provision.repo_dir", provisionCmd.Flags().Lookup("repo-dir"))
+	provisionCmd.Flags().Duration("provision-store-timeout", provision.DefaultStoreTimeout, "deadline of the datastore operations of a request, 0 disables")
+	config.BindPFlag("provision.store_timeout", provisionCmd.Flags().Lookup("provision-store-timeout"))
+
+	viper.SetDefault("provision.acme.challenge", certs.ChallengeHTTP01)
+	viper.SetDefault("provision.acme.cache_dir", "/var/lib/grendel/acme")
 
 	serveCmd.AddCommand(provisionCmd)
 }
 
+// certWatchInterval is how often the provision.cert and provision.key files
+// are checked for changes
+const certWatchInterval = 30 * time.Second
+
 var (
 	provisionCmd = &cobra.Command{
 		Use:   "provision",
 		Short: "Run Provision server",
 		Long:  `Run Provision server`,
 		RunE: func(command *cobra.Command, args []string) error {
-			t := NewInterruptTomb()
-			t.Go(func() error { return serveProvision(t) })
-			return t.Wait()
+			return run(service{"provision", startProvision})
 		},
 	}
 )
 
-func serveProvision(t *tomb.Tomb) error {
+func startProvision(t *tomb.Tomb) (func() error, error) {
 	pListen, err := GetListenAddress(viper.GetString("provision.listen"))
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	srv, err := provision.NewServer(DB, pListen)
 	if err != nil {
-		return err
+		return nil, err
 	}
+	srv.Namespaces = Namespaces
 
 	srv.KeyFile = viper.GetString("provision.key")
 	srv.CertFile = viper.GetString("provision.cert")
+	srv.ClientCAFile = viper.GetString("provision.client_ca")
 	srv.RepoDir = viper.GetString("provision.repo_dir")
+	srv.StoreTimeout = viper.GetDuration("provision.store_timeout")
+
+	srv.Limiter, err = newLimiter("provision")
+	if err != nil {
+		return nil, err
+	}
+
+	srv.AccessLog, err = newAccessLog("provision")
+	if err != nil {
+		return nil, err
+	}
+
+	hooks, err := boothook.LoadConfig(viper.GetViper())
+	if err != nil {
+		return nil, err
+	}
+	srv.Hooks = boothook.New(DB)
+	srv.Hooks.Configure(hooks)
+
+	if page := viper.GetString("provision.maintenance_page"); page != "" {
+		srv.MaintenancePage, err = os.ReadFile(page)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read provision.maintenance_page: %w", err)
+		}
+	}
+
+	acme, err := provisionCertificate(t, srv)
+	if err != nil {
+		return nil, err
+	}
+
+	srv.Listener, err = activatedListener("provision", pListen)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := srv.Listen(); err != nil {
+		return nil, err
+	}
+	health.Register("provision", srv.Check)
+	listeners.Add("provision", srv.Addr())
+
+	config.OnReload(func(v *viper.Viper) (func(), error) {
+		return srv.ReloadTemplates()
+	})
+	config.OnReload(func(v *viper.Viper) (func(), error) {
+		hooks, err := boothook.LoadConfig(v)
+		if err != nil {
+			return nil, err
+		}
+
+		return func() { srv.Hooks.Configure(hooks) }, nil
+	})
 
 	t.Go(func() error {
 		time.Sleep(1 * time.Second)
 		<-t.Dying()
 		cmd.Log.Info("Shutting down Provision server...")
-		ctxShutdown, cancel := context.WithTimeout(context.Background(), 10*time.Second)
-		defer cancel()
+		err := shutdown(cmd.Log, "Provision server", srv.Shutdown)
+		srv.Hooks.Wait()
+		return err
+	})
+
+	return func() error {
+		if acme != nil {
+			// Run once privileges are dropped so the cached certificate is
+			// written by the service user
+			t.Go(func() error {
+				acme.Run(t.Dying())
+				return nil
+			})
+		}
+		return srv.Serve(viper.GetString("provision.default_image"))
+	}, nil
+}
+
+// provisionCertificate sets the certificate of the provision server, either
+// obtained with ACME or loaded from provision.cert and provision.key and
+// reloaded when the files change or on reload. The ACME certificate is
+// returned to be run once the server serves
+func provisionCertificate(t *tomb.Tomb, srv *provision.Server) (*certs.ACME, error) {
+	if viper.GetBool("provision.acme.enabled") {
+		if srv.CertFile != "" || srv.KeyFile != "" {
+			return nil, errors.New("provision.cert and provision.key cannot be set along with provision.acme.enabled")
+		}
+
+		domains := viper.GetStringSlice("provision.acme.domains")
+		if hostname := config.Current().ProvisionHostname; len(domains) == 0 && hostname != "" {
+			domains = []string{hostname}
+		}
+		if len(domains) == 0 {
+			return nil, errors.New("set provision.acme.domains or provision.hostname to request a certificate with ACME")
+		}
 
-		if err := srv.Shutdown(ctxShutdown); err != nil {
-			cmd.Log.Errorf("Failed shutting down Provision server: %s", err)
-			return err
+		acme, err := certs.NewACME("provision", certs.ACMEConfig{
+			DirectoryURL: viper.GetString("provision.acme.directory_url"),
+			Email:        viper.GetString("provision.acme.email"),
+			Domains:      domains,
+			Challenge:    viper.GetString("provision.acme.challenge"),
+			CacheDir:     viper.GetString("provision.acme.cache_dir"),
+			CACert:       viper.GetString("provision.acme.ca_cert"),
+			PresentTXT:   dns.PresentTXT,
+			CleanUpTXT:   dns.CleanUpTXT,
+		})
+		if err != nil {
+			return nil, err
 		}
 
+		srv.Certificate = acme
+		if viper.GetString("provision.acme.challenge") == certs.ChallengeHTTP01 {
+			srv.ACMEChallenges = acme.HTTPHandler()
+			srv.ACMEListen = viper.GetString("provision.acme.http_listen")
+		}
+
+		return acme, nil
+	}
+
+	if srv.CertFile == "" || srv.KeyFile == "" {
+		return nil, nil
+	}
+
+	cert, err := certs.LoadFile("provision", srv.CertFile, srv.KeyFile)
+	if err != nil {
+		return nil, err
+	}
+	srv.Certificate = cert
+
+	t.Go(func() error {
+		cert.Watch(certWatchInterval, t.Dying())
 		return nil
 	})
 
-	return srv.Serve(viper.GetString("provision.default_image"))
+	config.OnReload(func(v *viper.Viper) (func(), error) {
+		return cert.Reload, nil
+	})
+
+	return nil, nil
 }
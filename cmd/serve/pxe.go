@@ -5,19 +5,21 @@
 package serve
 
 import (
-	"context"
 	"time"
 
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 	"github.com/ubccr/grendel/cmd"
+	"github.com/ubccr/grendel/internal/config"
 	"github.com/ubccr/grendel/internal/dhcp"
+	"github.com/ubccr/grendel/internal/health"
+	"github.com/ubccr/grendel/internal/listeners"
 	"gopkg.in/tomb.v2"
 )
 
 func init() {
 	pxeCmd.PersistentFlags().String("pxe-listen", "0.0.0.0:4011", "address to listen on")
-	viper.BindPFlag("pxe.listen", pxeCmd.PersistentFlags().Lookup("pxe-listen"))
+	config.BindPFlag("pxe.listen", pxeCmd.PersistentFlags().Lookup("pxe-listen"))
 
 	serveCmd.AddCommand(pxeCmd)
 }
@@ -28,38 +30,55 @@ var (
 		Short: "Run DHCP PXE Boot server",
 		Long:  `Run DHCP PXE Boot server`,
 		RunE: func(command *cobra.Command, args []string) error {
-			t := NewInterruptTomb()
-			t.Go(func() error { return servePXE(t) })
-			return t.Wait()
+			return run(service{"pxe", startPXE})
 		},
 	}
 )
 
-func servePXE(t *tomb.Tomb) error {
+func startPXE(t *tomb.Tomb) (func() error, error) {
 	pxeListen, err := GetListenAddress(viper.GetString("pxe.listen"))
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	srv, err := dhcp.NewPXEServer(DB, pxeListen)
 	if err != nil {
-		return err
+		return nil, err
 	}
+	srv.Namespaces = Namespaces
+
+	bootStates, err := hostStates(viper.GetViper(), "dhcp.boot_states")
+	if err != nil {
+		return nil, err
+	}
+	srv.SetBootStates(bootStates)
+
+	config.OnReload(func(v *viper.Viper) (func(), error) {
+		bootStates, err := hostStates(v, "dhcp.boot_states")
+		if err != nil {
+			return nil, err
+		}
+
+		return func() { srv.SetBootStates(bootStates) }, nil
+	})
+
+	srv.PacketConn, err = activatedPacketConn("pxe", pxeListen)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := srv.Listen(); err != nil {
+		return nil, err
+	}
+	health.Register("pxe", srv.Check)
+	listeners.Add("pxe", srv.Addr())
 
 	t.Go(func() error {
 		time.Sleep(1 * time.Second)
 		<-t.Dying()
 		cmd.Log.Info("Shutting down PXE server...")
-		ctxShutdown, cancel := context.WithTimeout(context.Background(), 10*time.Second)
-		defer cancel()
-
-		if err := srv.Shutdown(ctxShutdown); err != nil {
-			cmd.Log.Errorf("Failed shutting down PXE server: %s", err)
-			return err
-		}
-
-		return nil
+		return shutdown(cmd.Log, "PXE server", srv.Shutdown)
 	})
 
-	return srv.Serve()
+	return srv.Serve, nil
 }
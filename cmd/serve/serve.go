@@ -7,16 +7,28 @@ package serve
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io/ioutil"
 	"net"
+	"net/url"
 	"os"
 	"os/signal"
+	"strings"
+	"syscall"
+	"time"
 
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 	"github.com/ubccr/grendel/cmd"
+	"github.com/ubccr/grendel/internal/config"
+	"github.com/ubccr/grendel/internal/health"
+	"github.com/ubccr/grendel/internal/logger"
 	"github.com/ubccr/grendel/internal/store"
+	"github.com/ubccr/grendel/internal/store/cachestore"
+	"github.com/ubccr/grendel/internal/store/readonly"
 	"github.com/ubccr/grendel/internal/store/sqlstore"
 	"github.com/ubccr/grendel/pkg/model"
 	"gopkg.in/tomb.v2"
@@ -24,13 +36,52 @@ import (
 
 var (
 	DB            store.Store
+	APIDB         store.Store
+	dbLock        *sqlstore.FileLock
 	hostsFile     string
 	imagesFile    string
 	listenAddress string
 	serveCmd      = &cobra.Command{
 		Use:   "serve",
 		Short: "Run services",
-		Long:  `Run grendel services`,
+		Long: `Run grendel services.
+
+On SIGTERM or SIGINT the services stop accepting new DHCP, DNS, TFTP and HTTP
+requests and wait up to --shutdown-grace-period (shutdown_grace_period, 30s
+by default) for in-flight HTTP requests, such as kickstart and image
+downloads, and TFTP transfers to finish. The database is then flushed and
+closed and grendel exits 0. A second signal exits immediately.
+
+systemd sends SIGTERM on stop and SIGKILL once TimeoutStopSec (90s by
+default) expires, keep the grace period below TimeoutStopSec or raise it in
+the unit file so transfers are not cut off.
+
+SIGHUP reloads the configuration file, see grendel config reload.
+
+Started by a Type=notify systemd unit, grendel serve sends READY=1 once the
+readiness checks of every service pass, RELOADING=1 and READY=1 around
+SIGHUP reloads, WATCHDOG=1 every half WatchdogSec and STOPPING=1 on shutdown.
+Sockets passed by systemd socket activation are used instead of binding the
+listen address of a service. A socket is matched to the service named by its
+FileDescriptorName= (dhcp, dns, tftp, pxe, api, provision or metrics), or else
+by its address. The DHCP and PXE sockets are not bound to the interface of
+dhcp.listen, set BindToDevice= in the socket unit instead.
+
+Before opening the database and binding any socket, grendel serve checks the
+database is writable and not locked, the listen ports are free, the templates
+parse, the certificates are valid, the boot image files are readable and the
+dhcp.subnets are consistent. All failures are reported together with a
+suggested fix, see grendel validate --runtime. --skip-preflight skips the
+checks.
+
+Started as root with --user (user), grendel binds the privileged DHCP, TFTP
+and DNS ports, opens the database and loads the certificates and secret as
+root, then switches to the user and --group (group) before serving any
+request. It exits with an error when the user cannot access the database,
+its directory, the templates, the repo directory or the boot image files.
+Alternatively, run grendel as the user with the CAP_NET_BIND_SERVICE and
+CAP_NET_RAW capabilities, for example with AmbientCapabilities in the
+systemd unit.`,
 		RunE: func(command *cobra.Command, args []string) error {
 			if imagesFile != "" {
 				err := loadImageJSON()
@@ -44,6 +95,9 @@ var (
 					return err
 				}
 			}
+			if imagesFile != "" || hostsFile != "" {
+				config.OnReload(reloadJSON)
+			}
 
 			return runServices()
 		},
@@ -51,39 +105,107 @@ var (
 )
 
 func init() {
-	serveCmd.PersistentFlags().String("dbtype", "sqlite", "database backend to use")
-	viper.BindPFlag("dbtype", serveCmd.PersistentFlags().Lookup("dbtype"))
+	serveCmd.PersistentFlags().String("dbtype", "sqlite", "database backend to use: sqlite or postgres, selected by a postgres:// dsn")
+	config.BindPFlag("dbtype", serveCmd.PersistentFlags().Lookup("dbtype"))
 	serveCmd.PersistentFlags().String("dbpath", ":memory:", "path to database file")
-	viper.BindPFlag("dbpath", serveCmd.PersistentFlags().Lookup("dbpath"))
+	config.BindPFlag("dbpath", serveCmd.PersistentFlags().Lookup("dbpath"))
+	serveCmd.PersistentFlags().String("dsn", "", "database connection string, overrides dbpath")
+	config.BindPFlag("dsn", serveCmd.PersistentFlags().Lookup("dsn"))
 	serveCmd.PersistentFlags().StringVar(&hostsFile, "hosts", "", "path to hosts file")
 	serveCmd.PersistentFlags().StringVar(&imagesFile, "images", "", "path to boot images file")
-	serveCmd.PersistentFlags().StringSlice("services", []string{}, "enabled services")
-	serveCmd.PersistentFlags().StringVar(&listenAddress, "listen", "", "listen address")
-	viper.BindPFlag("services", serveCmd.PersistentFlags().Lookup("services"))
+	serveCmd.PersistentFlags().StringSlice("services", []string{}, "services to start, overriding <service>.enabled: tftp, dns, dhcp, pxe, api, provision, metrics")
+	serveCmd.PersistentFlags().StringVar(&listenAddress, "listen", "", "address all services listen on, keeping their port. :: listens on IPv4 and IPv6, DHCP and PXE require an IPv4 address")
+	config.BindPFlag("services", serveCmd.PersistentFlags().Lookup("services"))
+	serveCmd.PersistentFlags().Bool("readonly", false, "serve from a database shared with a primary instance without writing to it")
+	config.BindPFlag("readonly", serveCmd.PersistentFlags().Lookup("readonly"))
+	serveCmd.PersistentFlags().Bool("cache", true, "cache lookups made by the dhcp, dns, tftp, pxe and provision services")
+	config.BindPFlag("cache", serveCmd.PersistentFlags().Lookup("cache"))
+	serveCmd.PersistentFlags().Duration("cache-ttl", cachestore.DefaultTTL, "how long cached lookups are used")
+	config.BindPFlag("cache_ttl", serveCmd.PersistentFlags().Lookup("cache-ttl"))
+	serveCmd.PersistentFlags().Duration("shutdown-grace-period", 30*time.Second, "how long to wait for in-flight requests and transfers on shutdown")
+	config.BindPFlag("shutdown_grace_period", serveCmd.PersistentFlags().Lookup("shutdown-grace-period"))
+	serveCmd.PersistentFlags().String("user", "", "user to switch to once the services bound their sockets, requires starting as root")
+	config.BindPFlag("user", serveCmd.PersistentFlags().Lookup("user"))
+	serveCmd.PersistentFlags().String("group", "", "group to switch to with --user, defaults to the primary group of the user")
+	config.BindPFlag("group", serveCmd.PersistentFlags().Lookup("group"))
 
 	serveCmd.PersistentPreRunE = func(command *cobra.Command, args []string) error {
 		err := cmd.SetupLogging()
 		if err != nil {
 			return err
 		}
+		if err := logger.Setup(cmd.LoggingConfig()); err != nil {
+			return err
+		}
+
+		if !skipPreflight {
+			if err := checkPreflight(command); err != nil {
+				return err
+			}
+		}
 
 		dbType := viper.GetString("dbtype")
+		dsn := viper.GetString("dsn")
+		if dsn == "" {
+			dsn = viper.GetString("dbpath")
+		}
+		if sqlstore.IsPostgresDSN(dsn) {
+			dbType = "postgres"
+		}
 
 		switch dbType {
 		case "sqlite":
-			DB, err = sqlstore.New(viper.GetString("dbpath"))
+			if err := checkSqliteDSN(dsn); err != nil {
+				return err
+			}
+			var sqlDB *sqlstore.SqlStore
+			sqlDB, dbLock, err = openDatabase(dsn, viper.GetBool("readonly"))
+			if err != nil {
+				return err
+			}
+			prometheus.MustRegister(sqlstore.NewCollector(sqlDB))
+			DB = sqlDB
+		case "postgres":
+			sqlDB, err := sqlstore.New(dsn, sqlstore.Config{Driver: sqlstore.PostgresDriver, ReadOnly: viper.GetBool("readonly")})
 			if err != nil {
 				return err
 			}
+			prometheus.MustRegister(sqlstore.NewCollector(sqlDB))
+			DB = sqlDB
 		default:
-			cmd.Log.Fatalf("unsupported dbtype: %s", dbType)
+			return fmt.Errorf("unsupported dbtype: %s. Supported types: sqlite, postgres", dbType)
 		}
 
-		cmd.Log.Infof("Using %s database path: %s", dbType, viper.GetString("dbpath"))
-		return nil
+		cmd.Log.Infof("Using %s database: %s", dbType, redactDSN(dsn))
+
+		// Below the cache so writes are refused on every path
+		if viper.GetBool("readonly") {
+			primary := viper.GetString("readonly_primary")
+			DB = readonly.New(DB, primary)
+			if primary != "" {
+				cmd.Log.Warnf("Read-only mode: changes are refused, send them to the primary %s", primary)
+			} else {
+				cmd.Log.Warn("Read-only mode: changes are refused")
+			}
+		}
+
+		// The serving paths use the cache, the API reads through it so users
+		// always see the current data
+		APIDB = DB
+		if viper.GetBool("cache") {
+			cache := cachestore.New(DB, viper.GetDuration("cache_ttl"))
+			DB = cache
+			APIDB = cache.Bypass()
+			cmd.Log.Infof("Caching lookups for %s", viper.GetDuration("cache_ttl"))
+		}
+
+		return openNamespaces(dsn)
 	}
 
 	serveCmd.PersistentPostRunE = func(command *cobra.Command, args []string) error {
+		if err := closeNamespaces(); err != nil {
+			return err
+		}
 		if DB != nil {
 			cmd.Log.Info("Closing Database")
 			err := DB.Close()
@@ -92,31 +214,49 @@ func init() {
 			}
 		}
 
-		return nil
+		return dbLock.Unlock()
 	}
 
 	cmd.Root.AddCommand(serveCmd)
 }
 
 func loadHostJSON() error {
-	file, err := os.Open(hostsFile)
+	hostList, err := readHostJSON()
 	if err != nil {
 		return err
 	}
+
+	return storeHosts(hostList)
+}
+
+func readHostJSON() (model.HostList, error) {
+	file, err := os.Open(hostsFile)
+	if err != nil {
+		return nil, err
+	}
 	defer file.Close()
 
 	jsonBlob, err := ioutil.ReadAll(file)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	var hostList model.HostList
 	err = json.Unmarshal(jsonBlob, &hostList)
 	if err != nil {
-		return err
+		return nil, fmt.Errorf("failed parsing hosts file %s: %w", hostsFile, err)
 	}
 
-	err = DB.StoreHosts(hostList)
+	// Hosts loaded from the file always overwrite
+	for _, h := range hostList {
+		h.Revision = 0
+	}
+
+	return hostList, nil
+}
+
+func storeHosts(hostList model.HostList) error {
+	err := DB.StoreHosts(hostList)
 	if err != nil {
 		return err
 	}
@@ -126,31 +266,45 @@ func loadHostJSON() error {
 }
 
 func loadImageJSON() error {
-	file, err := os.Open(imagesFile)
+	imageList, err := readImageJSON()
 	if err != nil {
 		return err
 	}
+
+	return storeImages(imageList)
+}
+
+func readImageJSON() (model.BootImageList, error) {
+	file, err := os.Open(imagesFile)
+	if err != nil {
+		return nil, err
+	}
 	defer file.Close()
 
 	jsonBlob, err := ioutil.ReadAll(file)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	var imageList model.BootImageList
 	err = json.Unmarshal(jsonBlob, &imageList)
 	if err != nil {
-		return err
+		return nil, fmt.Errorf("failed parsing images file %s: %w", imagesFile, err)
 	}
 
 	for _, i := range imageList {
 		err = i.CheckPathsExist()
 		if err != nil {
-			return err
+			return nil, err
 		}
+		i.Revision = 0
 	}
 
-	err = DB.StoreBootImages(imageList)
+	return imageList, nil
+}
+
+func storeImages(imageList model.BootImageList) error {
+	err := DB.StoreBootImages(imageList)
 	if err != nil {
 		return err
 	}
@@ -159,36 +313,178 @@ func loadImageJSON() error {
 	return nil
 }
 
+// reloadJSON reads the --images and --hosts files again on reload. Both are
+// parsed before either is stored
+func reloadJSON(v *viper.Viper) (func(), error) {
+	var imageList model.BootImageList
+	var hostList model.HostList
+	var err error
+	if imagesFile != "" {
+		imageList, err = readImageJSON()
+		if err != nil {
+			return nil, err
+		}
+	}
+	if hostsFile != "" {
+		hostList, err = readHostJSON()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return func() {
+		if imagesFile != "" {
+			if err := storeImages(imageList); err != nil {
+				cmd.Log.Errorf("Failed reloading boot images from %s: %s", imagesFile, err)
+			}
+		}
+		if hostsFile != "" {
+			if err := storeHosts(hostList); err != nil {
+				cmd.Log.Errorf("Failed reloading hosts from %s: %s", hostsFile, err)
+			}
+		}
+	}, nil
+}
+
 func runServices() error {
+	enabled, err := enabledServices()
+	if err != nil {
+		return err
+	}
+	logServices(enabled)
+
+	return run(enabled...)
+}
+
+// run binds the sockets of the services as the user starting grendel, drops
+// privileges to --user and --group and serves the services until interrupted
+func run(services ...service) error {
+	// The debug server is not selected by --services, it only runs when
+	// debug.enabled is set
+	if viper.GetBool("debug.enabled") {
+		services = append(services, service{"debug", startDebug})
+	}
+	// The mDNS responder announces the api and provision services started,
+	// it only runs when mdns.enabled is set
+	if viper.GetBool("mdns.enabled") {
+		names := make([]string, 0, len(services))
+		for _, s := range services {
+			names = append(names, s.name)
+		}
+		services = append(services, service{"mdns", func(t *tomb.Tomb) (func() error, error) {
+			return startMDNS(t, names)
+		}})
+	}
+
+	// Readiness fails until every service registered its check once bound
+	for _, s := range services {
+		health.Expect(s.name)
+	}
+	health.Register("datastore", func(ctx context.Context) error {
+		return DB.Ping()
+	})
+
 	t := NewInterruptTomb()
 	t.Go(func() error {
-		t.Go(func() error { return serveTFTP(t) })
-		t.Go(func() error { return serveDNS(t) })
-		t.Go(func() error { return serveDHCP(t) })
-		t.Go(func() error { return servePXE(t) })
-		t.Go(func() error { return serveAPI(t) })
-		t.Go(func() error { return serveProvision(t) })
+		if err := watchMaintenance(t); err != nil {
+			return err
+		}
+		if err := watchSigningKeys(t); err != nil {
+			return err
+		}
+		if err := startWebhooks(t); err != nil {
+			return err
+		}
+
+		serves := make([]func() error, 0, len(services))
+		for _, s := range services {
+			serve, err := s.start(t)
+			if err != nil {
+				return fmt.Errorf("failed to start %s server: %w", s.name, err)
+			}
+			serves = append(serves, serve)
+		}
+
+		closeUnusedSockets()
+
+		if err := dropPrivileges(); err != nil {
+			return err
+		}
+
+		for _, serve := range serves {
+			t.Go(serve)
+		}
+
+		names := make([]string, 0, len(services))
+		for _, s := range services {
+			names = append(names, s.name)
+		}
+		notifySystemd(t, names)
 		return nil
 	})
 	return t.Wait()
 }
 
+// NewInterruptTomb returns a tomb killed on SIGINT or SIGTERM, shutting the
+// services down gracefully. A second signal exits immediately. SIGHUP
+// reloads the configuration file
 func NewInterruptTomb() *tomb.Tomb {
 	t := &tomb.Tomb{}
 	go func() {
 		sigint := make(chan os.Signal, 1)
-		signal.Notify(sigint, os.Interrupt)
-		select {
-		case <-t.Dying():
-		case <-sigint:
-			cmd.Log.Debug("Caught interrupt signal")
-			t.Kill(nil)
+		signal.Notify(sigint, os.Interrupt, syscall.SIGTERM)
+		sighup := make(chan os.Signal, 1)
+		signal.Notify(sighup, syscall.SIGHUP)
+		defer signal.Stop(sighup)
+
+		for {
+			select {
+			case <-t.Dead():
+				return
+			case sig := <-sigint:
+				if !t.Alive() {
+					cmd.Log.Warnf("Caught %s while shutting down, exiting immediately", sig)
+					os.Exit(1)
+				}
+				cmd.Log.Infof("Caught %s, shutting down. Waiting up to %s for in-flight requests, signal again to exit immediately", sig, gracePeriod())
+				t.Kill(nil)
+			case <-sighup:
+				cmd.Log.Info("Caught hangup signal, reloading configuration")
+				notifyReload(func() {
+					if _, err := config.Reload(); err != nil {
+						cmd.Log.Errorf("Failed reloading configuration, keeping the current configuration: %s", err)
+					}
+				})
+			}
 		}
 	}()
 
 	return t
 }
 
+func gracePeriod() time.Duration {
+	return viper.GetDuration("shutdown_grace_period")
+}
+
+// shutdown stops a server, waiting up to the shutdown grace period for its
+// in-flight requests. Requests still running when it expires are dropped
+func shutdown(log *logrus.Entry, name string, stop func(ctx context.Context) error) error {
+	ctx, cancel := context.WithTimeout(context.Background(), gracePeriod())
+	defer cancel()
+
+	err := stop(ctx)
+	if errors.Is(err, context.DeadlineExceeded) {
+		log.Warnf("%s did not finish in-flight requests within %s, dropping them", name, gracePeriod())
+		return nil
+	}
+	if err != nil {
+		log.Errorf("Failed shutting down %s: %s", name, err)
+		return err
+	}
+
+	return nil
+}
+
 func GetListenAddress(address string) (string, error) {
 	if listenAddress == "" {
 		return address, nil
@@ -199,7 +495,8 @@ func GetListenAddress(address string) (string, error) {
 		return "", err
 	}
 
-	return fmt.Sprintf("%s:%s", listenAddress, port), nil
+	// IPv6 addresses may be given with or without brackets
+	return net.JoinHostPort(strings.Trim(listenAddress, "[]"), port), nil
 }
 
 func NewInterruptContext() (context.Context, context.CancelFunc) {
@@ -216,3 +513,49 @@ func NewInterruptContext() (context.Context, context.CancelFunc) {
 
 	return ctx, cancel
 }
+
+// openDatabase opens the sqlite database dsn with a shared lock held until
+// exit, so db check and db migrate refuse to run while other serve instances,
+// such as a read-only or high availability secondary, share the database
+func openDatabase(dsn string, readOnly bool) (*sqlstore.SqlStore, *sqlstore.FileLock, error) {
+	lock, err := sqlstore.LockShared(dsn)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	db, err := sqlstore.New(dsn, sqlstore.Config{ReadOnly: readOnly})
+	if err != nil {
+		lock.Unlock()
+		return nil, nil, err
+	}
+
+	return db, lock, nil
+}
+
+// checkSqliteDSN returns an error when dsn is the URL of a database server,
+// sqlite only opens files. A postgres URL selects the postgres dbtype instead
+func checkSqliteDSN(dsn string) error {
+	u, err := url.Parse(dsn)
+	if err != nil || u.Scheme == "" || u.Scheme == "file" || u.Opaque != "" {
+		return nil
+	}
+
+	return fmt.Errorf("dsn %s is neither a sqlite database file nor a postgres URL", redactDSN(dsn))
+}
+
+// redactDSN returns dsn with the password of a URL or of a password=
+// keyword replaced, so it can be logged
+func redactDSN(dsn string) string {
+	if u, err := url.Parse(dsn); err == nil && u.User != nil {
+		return u.Redacted()
+	}
+
+	fields := strings.Fields(dsn)
+	for i, f := range fields {
+		if k, _, ok := strings.Cut(f, "="); ok && strings.EqualFold(k, "password") {
+			fields[i] = k + "=xxxxx"
+		}
+	}
+
+	return strings.Join(fields, " ")
+}
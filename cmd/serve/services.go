@@ -0,0 +1,182 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package serve
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/spf13/viper"
+	"github.com/ubccr/grendel/cmd"
+	"github.com/ubccr/grendel/internal/accesslog"
+	"github.com/ubccr/grendel/internal/certs"
+	"github.com/ubccr/grendel/internal/config"
+	"github.com/ubccr/grendel/internal/provision"
+	"github.com/ubccr/grendel/internal/ratelimit"
+	"gopkg.in/tomb.v2"
+)
+
+// service is a server started by grendel serve. It runs when <name>.enabled
+// is true, or when listed in --services. start binds the sockets of the
+// server and returns the function serving requests, called once privileges
+// are dropped
+type service struct {
+	name  string
+	start func(t *tomb.Tomb) (serve func() error, err error)
+}
+
+var services = []service{
+	{"tftp", startTFTP},
+	{"dns", startDNS},
+	{"dhcp", startDHCP},
+	{"pxe", startPXE},
+	{"api", startAPI},
+	{"provision", startProvision},
+	{"metrics", startMetrics},
+}
+
+func init() {
+	for _, s := range services {
+		viper.SetDefault(s.name+".enabled", true)
+	}
+}
+
+// enabledServices returns the services to start. The --services list
+// overrides the <name>.enabled settings
+func enabledServices() ([]service, error) {
+	names := viper.GetStringSlice("services")
+	for _, name := range names {
+		if !slices.ContainsFunc(services, func(s service) bool { return s.name == name }) {
+			return nil, fmt.Errorf("unknown service %q in --services", name)
+		}
+	}
+
+	enabled := make([]service, 0, len(services))
+	for _, s := range services {
+		on := viper.GetBool(s.name + ".enabled")
+		if len(names) > 0 {
+			on = slices.Contains(names, s.name)
+		}
+		if on {
+			enabled = append(enabled, s)
+		}
+	}
+
+	if len(enabled) == 0 {
+		return nil, errors.New("no services enabled, set <service>.enabled or --services")
+	}
+
+	return enabled, nil
+}
+
+// binding returns the address a service listens on
+func binding(name string) string {
+	if name == "api" && viper.GetString("api.socket_path") != "" {
+		return "unix:" + viper.GetString("api.socket_path")
+	}
+
+	addr, err := GetListenAddress(viper.GetString(name + ".listen"))
+	if err != nil {
+		return viper.GetString(name + ".listen")
+	}
+
+	return addr
+}
+
+// logServices logs the services started and their bindings, and warns about
+// enabled services relying on disabled ones
+func logServices(enabled []service) {
+	started := make([]string, 0, len(enabled))
+	disabled := make([]string, 0)
+	on := make(map[string]bool, len(enabled))
+	for _, s := range enabled {
+		on[s.name] = true
+		started = append(started, fmt.Sprintf("%s on %s", s.name, binding(s.name)))
+	}
+	for _, s := range services {
+		if !on[s.name] {
+			disabled = append(disabled, s.name)
+		}
+	}
+
+	cmd.Log.Infof("Starting services: %s", strings.Join(started, ", "))
+	if len(disabled) > 0 {
+		cmd.Log.Infof("Disabled services: %s", strings.Join(disabled, ", "))
+	}
+
+	for _, warning := range serviceWarnings(on) {
+		cmd.Log.Warn(warning)
+	}
+}
+
+// serviceWarnings returns a warning for each enabled service pointing
+// clients at a disabled local service or relying on it
+func serviceWarnings(on map[string]bool) []string {
+	warnings := make([]string, 0)
+	if on["provision"] && !on["dns"] && viper.GetBool("provision.acme.enabled") && viper.GetString("provision.acme.challenge") == certs.ChallengeDNS01 {
+		warnings = append(warnings, "DNS server is disabled: ACME dns-01 challenges are answered by the DNS server of grendel serve and will fail")
+	}
+
+	if !on["dhcp"] && !on["pxe"] {
+		return warnings
+	}
+
+	if !on["provision"] {
+		if config.Current().ProvisionHostname == "" {
+			warnings = append(warnings, "Provision server is disabled and provision.hostname is not set: boot URLs sent to clients point at the provision server of this host")
+		} else {
+			warnings = append(warnings, fmt.Sprintf("Provision server is disabled: boot URLs sent to clients use %s", provision.NewEndpoints("", "").BaseURL()))
+		}
+	}
+	if !on["tftp"] {
+		warnings = append(warnings, "TFTP server is disabled: clients booting over PXE are sent to the TFTP server of this host")
+	}
+
+	return warnings
+}
+
+// newLimiter returns the rate limiter of a service, updated with the limits
+// of its configuration section on reload
+func newLimiter(name string) (*ratelimit.Limiter, error) {
+	c, err := ratelimit.FromConfig(viper.GetViper(), name)
+	if err != nil {
+		return nil, err
+	}
+
+	limiter := ratelimit.New(name, c)
+	config.OnReload(func(v *viper.Viper) (func(), error) {
+		c, err := ratelimit.FromConfig(v, name)
+		if err != nil {
+			return nil, err
+		}
+
+		return func() { limiter.Update(c) }, nil
+	})
+
+	return limiter, nil
+}
+
+// newAccessLog returns the access log of a service, updated with the settings
+// of its configuration section on reload
+func newAccessLog(name string) (*accesslog.Logger, error) {
+	c, err := accesslog.FromConfig(viper.GetViper(), name)
+	if err != nil {
+		return nil, err
+	}
+
+	l := accesslog.New(name, c)
+	config.OnReload(func(v *viper.Viper) (func(), error) {
+		c, err := accesslog.FromConfig(v, name)
+		if err != nil {
+			return nil, err
+		}
+
+		return func() { l.Update(c) }, nil
+	})
+
+	return l, nil
+}
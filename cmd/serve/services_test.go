@@ -0,0 +1,100 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package serve
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/ubccr/grendel/internal/config"
+)
+
+func serviceNames(list []service) []string {
+	names := make([]string, 0, len(list))
+	for _, s := range list {
+		names = append(names, s.name)
+	}
+
+	return names
+}
+
+func TestEnabledServices(t *testing.T) {
+	defer viper.Set("dns.enabled", nil)
+	defer viper.Set("provision.enabled", nil)
+	defer viper.Set("services", nil)
+
+	enabled, err := enabledServices()
+	require.NoError(t, err)
+	assert.Equal(t, []string{"tftp", "dns", "dhcp", "pxe", "api", "provision", "metrics"}, serviceNames(enabled))
+
+	viper.Set("dns.enabled", false)
+	viper.Set("provision.enabled", false)
+	enabled, err = enabledServices()
+	require.NoError(t, err)
+	assert.Equal(t, []string{"tftp", "dhcp", "pxe", "api", "metrics"}, serviceNames(enabled))
+
+	viper.Set("services", []string{"dns", "tftp"})
+	enabled, err = enabledServices()
+	require.NoError(t, err)
+	assert.Equal(t, []string{"tftp", "dns"}, serviceNames(enabled))
+
+	viper.Set("services", []string{"ntp"})
+	_, err = enabledServices()
+	assert.ErrorContains(t, err, `unknown service "ntp"`)
+}
+
+func TestServiceWarnings(t *testing.T) {
+	assert.Empty(t, serviceWarnings(map[string]bool{"dns": true}))
+	assert.Empty(t, serviceWarnings(map[string]bool{"dhcp": true, "tftp": true, "provision": true}))
+
+	warnings := serviceWarnings(map[string]bool{"dhcp": true, "tftp": true})
+	require.Len(t, warnings, 1)
+	assert.Contains(t, warnings[0], "provision.hostname is not set")
+
+	cfg := *config.Current()
+	cfg.ProvisionHostname = "boot.example.com"
+	defer config.Set(config.Set(&cfg))
+	warnings = serviceWarnings(map[string]bool{"pxe": true})
+	require.Len(t, warnings, 2)
+	assert.Contains(t, warnings[0], "use http://boot.example.com")
+	assert.Contains(t, warnings[1], "TFTP server is disabled")
+
+	viper.Set("provision.acme.enabled", true)
+	viper.Set("provision.acme.challenge", "dns-01")
+	defer viper.Set("provision.acme.enabled", nil)
+	defer viper.Set("provision.acme.challenge", nil)
+	assert.Empty(t, serviceWarnings(map[string]bool{"provision": true, "dns": true}))
+	warnings = serviceWarnings(map[string]bool{"provision": true})
+	require.Len(t, warnings, 1)
+	assert.Contains(t, warnings[0], "dns-01")
+}
+
+func TestShutdown(t *testing.T) {
+	viper.Set("shutdown_grace_period", "10ms")
+	defer viper.Set("shutdown_grace_period", nil)
+
+	log := logrus.NewEntry(logrus.New())
+	drained := shutdown(log, "test server", func(ctx context.Context) error {
+		return nil
+	})
+	assert.NoError(t, drained)
+
+	// In-flight requests outliving the grace period are dropped
+	expired := shutdown(log, "test server", func(ctx context.Context) error {
+		<-ctx.Done()
+		return ctx.Err()
+	})
+	assert.NoError(t, expired)
+
+	failed := shutdown(log, "test server", func(ctx context.Context) error {
+		return errors.New("listener closed")
+	})
+	assert.ErrorContains(t, failed, "listener closed")
+}
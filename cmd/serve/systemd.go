@@ -0,0 +1,202 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package serve
+
+import (
+	"context"
+	"net"
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/ubccr/grendel/cmd"
+	"github.com/ubccr/grendel/internal/health"
+	"github.com/ubccr/grendel/internal/systemd"
+	"gopkg.in/tomb.v2"
+)
+
+var (
+	activatedOnce sync.Once
+	activated     *systemd.Sockets
+	activatedErr  error
+
+	// serving is the status reported to systemd once the services are
+	// ready, notified is set once it was sent
+	serving  string
+	notified atomic.Bool
+)
+
+// activatedSockets returns the sockets passed by systemd socket activation.
+// They are read once, the environment variables are unset after
+func activatedSockets() (*systemd.Sockets, error) {
+	activatedOnce.Do(func() {
+		activated, activatedErr = systemd.Activated()
+		if activated.Len() > 0 {
+			cmd.Log.Infof("Received %d sockets from systemd", activated.Len())
+		}
+	})
+
+	return activated, activatedErr
+}
+
+// activatedListener returns the stream socket passed by systemd for service
+// or bound to address, nil when there is none
+func activatedListener(service, address string) (net.Listener, error) {
+	sockets, err := activatedSockets()
+	if err != nil {
+		return nil, err
+	}
+
+	l, err := sockets.Listener(service, address)
+	if l != nil {
+		cmd.Log.Infof("Using socket passed by systemd for %s: %s", service, l.Addr())
+	}
+
+	return l, err
+}
+
+// activatedPacketConn returns the datagram socket passed by systemd for
+// service or bound to address, nil when there is none
+func activatedPacketConn(service, address string) (net.PacketConn, error) {
+	sockets, err := activatedSockets()
+	if err != nil {
+		return nil, err
+	}
+
+	c, err := sockets.PacketConn(service, address)
+	if c != nil {
+		cmd.Log.Infof("Using socket passed by systemd for %s: %s", service, c.LocalAddr())
+	}
+
+	return c, err
+}
+
+// activatedPort returns true when systemd passed the socket of service, used
+// to skip the port preflight check. Errors are reported when the services
+// start
+func activatedPort(service, network, address string) bool {
+	sockets, err := activatedSockets()
+	if err != nil {
+		return false
+	}
+
+	return sockets.Provides(service, network, address)
+}
+
+// closeUnusedSockets warns about and closes the sockets passed by systemd
+// which no service took
+func closeUnusedSockets() {
+	sockets, err := activatedSockets()
+	if err != nil {
+		return
+	}
+
+	if unused := sockets.Unused(); len(unused) > 0 {
+		cmd.Log.Warnf("Closing sockets passed by systemd not matching any service: %s", strings.Join(unused, ", "))
+		sockets.Close()
+	}
+}
+
+// notifySystemd tells systemd the services are ready once all of their
+// readiness checks pass, resets the watchdog timer every half WatchdogSec
+// and tells systemd when the services are stopping. It does nothing when not
+// started by a Type=notify service
+func notifySystemd(t *tomb.Tomb, names []string) {
+	if !systemd.Enabled() {
+		return
+	}
+
+	sorted := append([]string(nil), names...)
+	sort.Strings(sorted)
+	serving = "Serving " + strings.Join(sorted, ", ")
+
+	t.Go(func() error {
+		ctx := t.Context(context.Background())
+		ticker := time.NewTicker(time.Second)
+		defer ticker.Stop()
+
+		for attempt := 1; ; attempt++ {
+			report := health.Default.Check(ctx)
+			if report.Status == health.StatusOK {
+				break
+			}
+
+			if attempt%10 == 0 {
+				cmd.Log.Warnf("Waiting for services to be ready before notifying systemd: %s", failingServices(report))
+			}
+
+			select {
+			case <-t.Dying():
+				return nil
+			case <-ticker.C:
+			}
+		}
+
+		notify("ready", func() (bool, error) { return systemd.Ready(serving) })
+		notified.Store(true)
+		return nil
+	})
+
+	interval, err := systemd.WatchdogInterval()
+	if err != nil {
+		cmd.Log.Warnf("Ignoring invalid systemd watchdog settings: %s", err)
+	}
+
+	t.Go(func() error {
+		var tick <-chan time.Time
+		if interval > 0 {
+			cmd.Log.Debugf("Resetting the systemd watchdog every %s", interval)
+			ticker := time.NewTicker(interval)
+			defer ticker.Stop()
+			tick = ticker.C
+		}
+
+		for {
+			select {
+			case <-t.Dying():
+				notify("stopping", systemd.Stopping)
+				return nil
+			case <-tick:
+				notify("watchdog", systemd.Watchdog)
+			}
+		}
+	})
+}
+
+// notifyReload wraps a configuration reload in the RELOADING=1 and READY=1
+// notifications, once systemd was told the services are ready
+func notifyReload(reload func()) {
+	if !systemd.Enabled() || !notified.Load() {
+		reload()
+		return
+	}
+
+	notify("reloading", systemd.Reloading)
+	reload()
+	notify("ready", func() (bool, error) { return systemd.Ready(serving) })
+}
+
+func notify(state string, send func() (bool, error)) {
+	if _, err := send(); err != nil {
+		cmd.Log.Warnf("Failed notifying systemd %s: %s", state, err)
+		return
+	}
+
+	cmd.Log.Debugf("Notified systemd %s", state)
+}
+
+func failingServices(report *health.Report) string {
+	failing := make([]string, 0)
+	for name, result := range report.Services {
+		if result.Status != health.StatusOK {
+			failing = append(failing, name+": "+result.Error)
+		}
+	}
+	sort.Strings(failing)
+
+	return strings.Join(failing, ", ")
+}
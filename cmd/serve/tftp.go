@@ -5,19 +5,21 @@
 package serve
 
 import (
-	"context"
 	"time"
 
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 	"github.com/ubccr/grendel/cmd"
+	"github.com/ubccr/grendel/internal/config"
+	"github.com/ubccr/grendel/internal/health"
+	"github.com/ubccr/grendel/internal/listeners"
 	"github.com/ubccr/grendel/internal/tftp"
 	"gopkg.in/tomb.v2"
 )
 
 func init() {
 	tftpCmd.PersistentFlags().String("tftp-listen", "0.0.0.0:69", "address to listen on")
-	viper.BindPFlag("tftp.listen", tftpCmd.PersistentFlags().Lookup("tftp-listen"))
+	config.BindPFlag("tftp.listen", tftpCmd.PersistentFlags().Lookup("tftp-listen"))
 
 	serveCmd.AddCommand(tftpCmd)
 }
@@ -28,40 +30,40 @@ var (
 		Short: "Run TFTP server",
 		Long:  `Run TFTP server`,
 		RunE: func(command *cobra.Command, args []string) error {
-			t := NewInterruptTomb()
-			t.Go(func() error { return serveTFTP(t) })
-			return t.Wait()
+			return run(service{"tftp", startTFTP})
 		},
 	}
 )
 
-func serveTFTP(t *tomb.Tomb) error {
+func startTFTP(t *tomb.Tomb) (func() error, error) {
 	tftpListen, err := GetListenAddress(viper.GetString("tftp.listen"))
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	tftpServer, err := tftp.NewServer(DB, tftpListen)
 	if err != nil {
-		return err
+		return nil, err
 	}
+	tftpServer.Namespaces = Namespaces
 
-	t.Go(tftpServer.Serve)
+	tftpServer.PacketConn, err = activatedPacketConn("tftp", tftpListen)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tftpServer.Listen(); err != nil {
+		return nil, err
+	}
+	health.Register("tftp", tftpServer.Check)
+	listeners.Add("tftp", tftpServer.Addr())
 
 	t.Go(func() error {
 		time.Sleep(1 * time.Second)
 		<-t.Dying()
 		cmd.Log.Info("Shutting down TFTP server...")
-		ctxShutdown, cancel := context.WithTimeout(context.Background(), 10*time.Second)
-		defer cancel()
-
-		if err := tftpServer.Shutdown(ctxShutdown); err != nil {
-			cmd.Log.Errorf("Failed shutting down TFTP server: %s", err)
-			return err
-		}
-
-		return nil
+		return shutdown(cmd.Log, "TFTP server", tftpServer.Shutdown)
 	})
 
-	return nil
+	return tftpServer.Serve, nil
 }
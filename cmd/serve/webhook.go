@@ -0,0 +1,38 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package serve
+
+import (
+	"github.com/spf13/viper"
+	"github.com/ubccr/grendel/internal/config"
+	"github.com/ubccr/grendel/internal/webhook"
+	"gopkg.in/tomb.v2"
+)
+
+// startWebhooks delivers the events of the services to the webhooks in
+// webhook.hooks. The hooks are re-read on reload
+func startWebhooks(t *tomb.Tomb) error {
+	c, err := webhook.LoadConfig(viper.GetViper())
+	if err != nil {
+		return err
+	}
+	webhook.Default.Configure(c)
+
+	config.OnReload(func(v *viper.Viper) (func(), error) {
+		c, err := webhook.LoadConfig(v)
+		if err != nil {
+			return nil, err
+		}
+
+		return func() { webhook.Default.Configure(c) }, nil
+	})
+
+	t.Go(func() error {
+		webhook.Default.Run(t.Dying())
+		return nil
+	})
+
+	return nil
+}
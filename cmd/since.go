@@ -0,0 +1,32 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package cmd
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/ubccr/grendel/internal/util"
+)
+
+// ParseSince parses the value of a --since flag. It is either an RFC3339
+// time or a duration such as 12h or 7d before now. An empty value returns the
+// zero time
+func ParseSince(since string) (time.Time, error) {
+	if since == "" {
+		return time.Time{}, nil
+	}
+
+	if t, err := time.Parse(time.RFC3339, since); err == nil {
+		return t, nil
+	}
+
+	d, err := util.ParseDuration(since)
+	if err != nil || d < 0 {
+		return time.Time{}, fmt.Errorf("invalid --since %q, use an RFC3339 time or a duration such as 12h or 7d", since)
+	}
+
+	return time.Now().Add(-d), nil
+}
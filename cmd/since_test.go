@@ -0,0 +1,32 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package cmd
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseSince(t *testing.T) {
+	since, err := ParseSince("")
+	if assert.NoError(t, err) {
+		assert.True(t, since.IsZero())
+	}
+
+	since, err = ParseSince("2026-10-01T12:00:00Z")
+	if assert.NoError(t, err) {
+		assert.Equal(t, time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC), since)
+	}
+
+	since, err = ParseSince("7d")
+	if assert.NoError(t, err) {
+		assert.WithinDuration(t, time.Now().Add(-7*24*time.Hour), since, time.Minute)
+	}
+
+	_, err = ParseSince("yesterday")
+	assert.Error(t, err)
+}
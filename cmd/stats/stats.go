@@ -0,0 +1,85 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package stats
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	"github.com/dustin/go-humanize"
+	"github.com/spf13/cobra"
+	"github.com/ubccr/grendel/cmd"
+	"github.com/ubccr/grendel/pkg/client"
+)
+
+var (
+	statsCmd = &cobra.Command{
+		Use:   "stats",
+		Short: "Show summary statistics",
+		Long: `Show the number of hosts by provision state, boot image and tag, and the
+DHCP acks sent in the last hour, hosts which finished provisioning today and DNS
+queries per second over the last 5 minutes of the grendel serve process running
+the API. Boot images and tags without hosts are included.
+
+The same document is served by GET /v1/grendel/stats, for dashboards such as
+Grafana. It is cached by the API for 10 seconds.`,
+		Args: cobra.NoArgs,
+		RunE: func(command *cobra.Command, args []string) error {
+			gc, err := cmd.NewOgenClient()
+			if err != nil {
+				return err
+			}
+
+			res, err := gc.GETV1GrendelStats(context.Background(), client.GETV1GrendelStatsParams{})
+			if err != nil {
+				return cmd.NewApiError(err)
+			}
+
+			if cmd.JSONOutput() {
+				return cmd.Output(res)
+			}
+
+			writeStats(os.Stdout, res)
+			return nil
+		},
+	}
+)
+
+func init() {
+	cmd.Root.AddCommand(statsCmd)
+}
+
+// writeStats writes s as tables of the host counts and the service activity
+func writeStats(out io.Writer, s *client.Stats) {
+	w := tabwriter.NewWriter(out, 0, 0, 4, ' ', 0)
+	hosts := s.Hosts.Value
+
+	fmt.Fprintln(w, "HOSTS\tCOUNT")
+	fmt.Fprintf(w, "Total\t%s\n", humanize.Comma(int64(hosts.Total.Value)))
+	fmt.Fprintf(w, "Provisioning\t%s\n", humanize.Comma(int64(hosts.Provisioning.Value)))
+	fmt.Fprintf(w, "Unprovisioned\t%s\n", humanize.Comma(int64(hosts.Unprovisioned.Value)))
+
+	fmt.Fprintln(w, "\nBOOT IMAGE\tHOSTS")
+	for _, b := range hosts.BootImages {
+		fmt.Fprintf(w, "%s\t%s\n", b.Name.Value, humanize.Comma(int64(b.Hosts.Value)))
+	}
+	fmt.Fprintf(w, "(none)\t%s\n", humanize.Comma(int64(hosts.WithoutBootImage.Value)))
+
+	fmt.Fprintln(w, "\nTAG\tHOSTS")
+	for _, t := range hosts.Tags {
+		fmt.Fprintf(w, "%s\t%s\n", t.Name.Value, humanize.Comma(int64(t.Hosts.Value)))
+	}
+
+	fmt.Fprintln(w, "\nACTIVITY\tVALUE")
+	fmt.Fprintf(w, "DHCP acks last hour\t%s\n", humanize.Comma(int64(s.DhcpAcksLastHour.Value)))
+	fmt.Fprintf(w, "Provision completions today\t%s\n", humanize.Comma(int64(s.ProvisionCompletionsToday.Value)))
+	fmt.Fprintf(w, "DNS queries/s\t%.2f\n", s.DNSQueriesPerSecond.Value)
+	fmt.Fprintf(w, "Counting since\t%s\n", s.Since.Value.Local().Format(time.RFC3339))
+	w.Flush()
+}
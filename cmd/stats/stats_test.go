@@ -0,0 +1,44 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package stats
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/ubccr/grendel/pkg/client"
+)
+
+func TestWriteStats(t *testing.T) {
+	named := func(name string, hosts int) client.StatsHostsBootImagesItem {
+		return client.StatsHostsBootImagesItem{Name: client.NewOptString(name), Hosts: client.NewOptInt(hosts)}
+	}
+
+	var buf bytes.Buffer
+	writeStats(&buf, &client.Stats{
+		Hosts: client.NewOptStatsHosts(client.StatsHosts{
+			Total:            client.NewOptInt(1200),
+			Provisioning:     client.NewOptInt(12),
+			Unprovisioned:    client.NewOptInt(1188),
+			BootImages:       []client.StatsHostsBootImagesItem{named("rocky9", 1199), named("ubuntu", 0)},
+			WithoutBootImage: client.NewOptInt(1),
+			Tags:             []client.StatsHostsTagsItem{{Name: client.NewOptString("gpu"), Hosts: client.NewOptInt(0)}},
+		}),
+		DhcpAcksLastHour:          client.NewOptInt(42),
+		ProvisionCompletionsToday: client.NewOptInt(3),
+		DNSQueriesPerSecond:       client.NewOptFloat64(1.5),
+		Since:                     client.NewOptDateTime(time.Now()),
+	})
+
+	out := buf.String()
+	assert.Contains(t, out, "Total            1,200\n")
+	assert.Contains(t, out, "ubuntu        0\n")
+	assert.Contains(t, out, "(none)        1\n")
+	assert.Contains(t, out, "gpu    0\n")
+	assert.Contains(t, out, "DHCP acks last hour            42\n")
+	assert.Contains(t, out, "DNS queries/s                  1.50\n")
+}
@@ -17,6 +17,13 @@ import (
 	"go4.org/netipx"
 )
 
+type netsegEntry struct {
+	IP   string   `json:"ip"`
+	Host string   `json:"host,omitempty"`
+	FQDN string   `json:"fqdn,omitempty"`
+	Tags []string `json:"tags,omitempty"`
+}
+
 var (
 	ipmap      map[netip.Addr]client.Host
 	prefixes   []netip.Prefix
@@ -85,7 +92,9 @@ var (
 
 			iset, _ = builder.IPSet()
 
+			entries := make([]netsegEntry, 0)
 			if netsegNext {
+			next:
 				for _, p := range iset.Prefixes() {
 					i := p.Addr()
 					last := netipx.PrefixLastIP(p)
@@ -94,18 +103,17 @@ var (
 							continue
 						}
 
-						fmt.Printf("%s\n", i)
-						return nil
+						entries = append(entries, netsegEntry{IP: i.String()})
+						break next
 					}
 				}
-				return nil
 			} else if netsegLong {
 				for _, p := range prefixes {
 					i := p.Addr()
 					last := netipx.PrefixLastIP(p)
 					for ; i.Compare(last) <= 0; i = i.Next() {
 						if host, ok := ipmap[i]; ok {
-							fmt.Printf("%-20s%-20s\n", i, host.Name.Value)
+							entries = append(entries, netsegEntry{IP: i.String(), Host: host.Name.Value})
 							continue
 						}
 
@@ -113,41 +121,54 @@ var (
 							continue
 						}
 
-						fmt.Printf("%-20s%-20s\n", i, "")
+						entries = append(entries, netsegEntry{IP: i.String()})
 					}
 				}
-				return nil
-
-			}
-
-			keys := make([]netip.Addr, 0, len(ipmap))
-			for k := range ipmap {
-				keys = append(keys, k)
-			}
+			} else {
+				keys := make([]netip.Addr, 0, len(ipmap))
+				for k := range ipmap {
+					keys = append(keys, k)
+				}
 
-			sort.Slice(keys, func(i, j int) bool {
-				return keys[i].Less(keys[j])
-			})
+				sort.Slice(keys, func(i, j int) bool {
+					return keys[i].Less(keys[j])
+				})
 
-			for _, k := range keys {
-				host := ipmap[k]
-				name := ""
-				for _, i := range host.Interfaces {
-					ipp, err := netip.ParsePrefix(i.Value.IP.Value)
-					if err != nil {
-						continue
+				for _, k := range keys {
+					host := ipmap[k]
+					name := ""
+					for _, i := range host.Interfaces {
+						ipp, err := netip.ParsePrefix(i.Value.IP.Value)
+						if err != nil {
+							continue
+						}
+						if ipp.Addr() == k && i.Value.Fqdn.Value != "" {
+							name = strings.Split(i.Value.Fqdn.Value, ",")[0]
+						}
 					}
-					if ipp.Addr() == k && i.Value.Fqdn.Value != "" {
-						name = strings.Split(i.Value.Fqdn.Value, ",")[0]
+
+					tags := make([]string, 0)
+					for _, t := range host.Tags.Value {
+						tags = append(tags, t)
 					}
-				}
 
-				tags := make([]string, 0)
-				for _, t := range host.Tags.Value {
-					tags = append(tags, t)
+					entries = append(entries, netsegEntry{IP: k.String(), Host: host.Name.Value, FQDN: name, Tags: tags})
 				}
+			}
 
-				fmt.Printf("%-20s%-20s%-40s%-45s\n", k, host.Name.Value, name, strings.Join(tags, ","))
+			if cmd.JSONOutput() {
+				return cmd.Output(entries)
+			}
+
+			for _, e := range entries {
+				switch {
+				case netsegNext:
+					fmt.Printf("%s\n", e.IP)
+				case netsegLong:
+					fmt.Printf("%-20s%-20s\n", e.IP, e.Host)
+				default:
+					fmt.Printf("%-20s%-20s%-40s%-45s\n", e.IP, e.Host, e.FQDN, strings.Join(e.Tags, ","))
+				}
 			}
 
 			return nil
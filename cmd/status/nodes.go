@@ -7,6 +7,7 @@ package status
 import (
 	"context"
 	"fmt"
+	"sort"
 	"strings"
 
 	"github.com/dustin/go-humanize"
@@ -23,6 +24,12 @@ type StatTag struct {
 	unprovision *nodeset.NodeSet
 }
 
+type StatTagOutput struct {
+	Tag         string `json:"tag"`
+	Provision   string `json:"provision"`
+	Unprovision string `json:"unprovision"`
+}
+
 var (
 	nodeLong bool
 	nodesCmd = &cobra.Command{
@@ -39,13 +46,12 @@ var (
 			defaultImage := viper.GetString("provision.default_image")
 			inputTags := strings.Join(args, ",")
 
-			req := client.GETV1NodesFindParams{
-				Nodeset: client.NewOptString(strings.Join(nodes, ",")),
-				Tags:    client.NewOptString(strings.Join(tags, ",")),
-			}
-			hostList, err := gc.GETV1NodesFind(context.Background(), req)
+			hostList, err := gc.HostList(context.Background(), client.HostFilter{
+				Nodeset: strings.Join(nodes, ","),
+				Tags:    tags,
+			})
 			if err != nil {
-				return cmd.NewApiError(err)
+				return err
 			}
 
 			stats := make(map[string]*StatTag)
@@ -83,6 +89,20 @@ var (
 				nodes++
 			}
 
+			if cmd.JSONOutput() {
+				if nodeLong {
+					return cmd.Output(hostList)
+				}
+
+				out := make([]StatTagOutput, 0, len(stats))
+				for tag, stat := range stats {
+					out = append(out, StatTagOutput{Tag: tag, Provision: stat.provision.String(), Unprovision: stat.unprovision.String()})
+				}
+				sort.Slice(out, func(i, j int) bool { return out[i].Tag < out[j].Tag })
+
+				return cmd.Output(out)
+			}
+
 			fmt.Printf("Grendel version %s\n\n", api.Version)
 			fmt.Printf("Nodes: %s\n\n", humanize.Comma(int64(nodes)))
 
@@ -7,7 +7,9 @@ package status
 import (
 	"context"
 	"fmt"
+	"sort"
 	"strings"
+	"time"
 
 	"github.com/dustin/go-humanize"
 	"github.com/fatih/color"
@@ -17,6 +19,7 @@ import (
 	"github.com/ubccr/grendel/internal/api"
 	"github.com/ubccr/grendel/internal/logger"
 	"github.com/ubccr/grendel/pkg/client"
+	"github.com/ubccr/grendel/pkg/model"
 )
 
 type StatProvision struct {
@@ -29,6 +32,70 @@ type Stats struct {
 	tags   map[string]*StatProvision
 }
 
+type StatusOutput struct {
+	Version     string             `json:"version"`
+	Maintenance *MaintenanceStatus `json:"maintenance,omitempty"`
+	ReadOnly    *ReadOnlyStatus    `json:"readonly,omitempty"`
+	Nodes       int                `json:"nodes"`
+	Images      []StatsCount       `json:"images,omitempty"`
+	Tags        []StatsCount       `json:"tags,omitempty"`
+	HA          []HAStatus         `json:"ha,omitempty"`
+	Listeners   []ListenerStatus   `json:"listeners,omitempty"`
+	Keys        []KeyStatus        `json:"keys,omitempty"`
+}
+
+type MaintenanceStatus struct {
+	Enabled   bool      `json:"enabled"`
+	Reason    string    `json:"reason,omitempty"`
+	ChangedBy string    `json:"changed_by,omitempty"`
+	Changed   time.Time `json:"changed"`
+}
+
+type ReadOnlyStatus struct {
+	Enabled bool   `json:"enabled"`
+	Primary string `json:"primary,omitempty"`
+}
+
+type HAStatus struct {
+	Name      string    `json:"name"`
+	Role      string    `json:"role"`
+	State     string    `json:"state"`
+	Heartbeat time.Time `json:"heartbeat"`
+	Changed   time.Time `json:"changed"`
+	Alive     bool      `json:"alive"`
+}
+
+type ListenerStatus struct {
+	Service string `json:"service"`
+	Network string `json:"network"`
+	Address string `json:"address"`
+	Family  string `json:"family"`
+}
+
+// KeyStatus is the primary key signing tokens of a kind and the number of
+// previous keys verifying tokens until they retire
+type KeyStatus struct {
+	Kind      string `json:"kind"`
+	ID        string `json:"id"`
+	Verifying int    `json:"verifying"`
+}
+
+type StatsCount struct {
+	Name        string `json:"name"`
+	Provision   int    `json:"provision"`
+	Unprovision int    `json:"unprovision"`
+	Total       int    `json:"total"`
+}
+
+func newStatsCount(name string, stat *StatProvision) StatsCount {
+	return StatsCount{
+		Name:        name,
+		Provision:   stat.provision,
+		Unprovision: stat.unprovision,
+		Total:       stat.provision + stat.unprovision,
+	}
+}
+
 var (
 	tags      []string
 	nodes     []string
@@ -36,6 +103,7 @@ var (
 	cyan      = color.New(color.FgCyan)
 	green     = color.New(color.FgGreen)
 	red       = color.New(color.FgRed)
+	boldRed   = color.New(color.FgRed, color.Bold)
 	yellow    = color.New(color.FgYellow)
 	blue      = color.New(color.FgBlue)
 	statusCmd = &cobra.Command{
@@ -52,10 +120,9 @@ var (
 			defaultImage := viper.GetString("provision.default_image")
 			inputTags := strings.Join(args, ",")
 
-			params := client.GETV1ImagesParams{}
-			imageList, err := gc.GETV1Images(context.Background(), params)
+			imageList, err := gc.ImageList(context.Background())
 			if err != nil {
-				return cmd.NewApiError(err)
+				return err
 			}
 
 			stats := &Stats{images: make(map[string]*StatProvision), tags: make(map[string]*StatProvision)}
@@ -64,13 +131,12 @@ var (
 				stats.images[img.Name] = &StatProvision{}
 			}
 
-			req := client.GETV1NodesFindParams{
-				Nodeset: client.NewOptString(strings.Join(nodes, ",")),
-				Tags:    client.NewOptString(strings.Join(tags, ",")),
-			}
-			hostList, err := gc.GETV1NodesFind(context.Background(), req)
+			hostList, err := gc.HostList(context.Background(), client.HostFilter{
+				Nodeset: strings.Join(nodes, ","),
+				Tags:    tags,
+			})
 			if err != nil {
-				return cmd.NewApiError(err)
+				return err
 			}
 
 			nodes := 0
@@ -122,9 +188,149 @@ var (
 				nodes++
 			}
 
+			haList := make([]HAStatus, 0)
+			instances, err := gc.GETV1GrendelHa(context.Background(), client.GETV1GrendelHaParams{})
+			if err != nil {
+				log.Warnf("failed to fetch high availability status: %s", cmd.NewApiError(err))
+			}
+			for _, i := range instances {
+				haList = append(haList, HAStatus{
+					Name:      i.Name.Value,
+					Role:      i.Role.Value,
+					State:     i.State.Value,
+					Heartbeat: i.Heartbeat.Value,
+					Changed:   i.Changed.Value,
+					Alive:     i.Alive.Value,
+				})
+			}
+
+			var maint *MaintenanceStatus
+			m, err := gc.GETV1GrendelMaintenance(context.Background(), client.GETV1GrendelMaintenanceParams{})
+			if err != nil {
+				log.Warnf("failed to fetch maintenance mode: %s", cmd.NewApiError(err))
+			} else {
+				maint = &MaintenanceStatus{
+					Enabled:   m.Enabled.Value,
+					Reason:    m.Reason.Value,
+					ChangedBy: m.ChangedBy.Value,
+					Changed:   m.Changed.Value,
+				}
+			}
+
+			var readOnly *ReadOnlyStatus
+			ro, err := gc.GETV1GrendelReadonly(context.Background(), client.GETV1GrendelReadonlyParams{})
+			if err != nil {
+				log.Warnf("failed to fetch read-only mode: %s", cmd.NewApiError(err))
+			} else {
+				readOnly = &ReadOnlyStatus{Enabled: ro.Enabled.Value, Primary: ro.Primary.Value}
+			}
+
+			listenerList := make([]ListenerStatus, 0)
+			bound, err := gc.GETV1GrendelListeners(context.Background(), client.GETV1GrendelListenersParams{})
+			if err != nil {
+				log.Warnf("failed to fetch listeners: %s", cmd.NewApiError(err))
+			}
+			for _, l := range bound {
+				listenerList = append(listenerList, ListenerStatus{
+					Service: l.Service.Value,
+					Network: l.Network.Value,
+					Address: l.Address.Value,
+					Family:  l.Family.Value,
+				})
+			}
+
+			keyList := make([]KeyStatus, 0)
+			keys, err := gc.GETV1GrendelKeys(context.Background(), client.GETV1GrendelKeysParams{})
+			if err != nil {
+				log.Warnf("failed to fetch signing keys: %s", cmd.NewApiError(err))
+			}
+			for _, k := range keys {
+				if k.Primary.Value {
+					keyList = append(keyList, KeyStatus{Kind: k.Kind.Value, ID: k.ID.Value})
+				} else if len(keyList) > 0 && keyList[len(keyList)-1].Kind == k.Kind.Value {
+					keyList[len(keyList)-1].Verifying++
+				}
+			}
+
+			if cmd.JSONOutput() {
+				out := StatusOutput{Version: api.Version, Maintenance: maint, ReadOnly: readOnly, Nodes: nodes, HA: haList, Listeners: listenerList, Keys: keyList}
+				if inputTags == "" {
+					out.Images = make([]StatsCount, 0, len(stats.images))
+					for img, stat := range stats.images {
+						out.Images = append(out.Images, newStatsCount(img, stat))
+					}
+					sort.Slice(out.Images, func(i, j int) bool { return out.Images[i].Name < out.Images[j].Name })
+				} else {
+					out.Tags = make([]StatsCount, 0)
+					for _, tag := range strings.Split(inputTags, ",") {
+						if stat, ok := stats.tags[tag]; ok {
+							out.Tags = append(out.Tags, newStatsCount(tag, stat))
+						}
+					}
+				}
+
+				return cmd.Output(out)
+			}
+
 			fmt.Printf("Grendel version %s\n\n", api.Version)
+
+			if maint != nil && maint.Enabled {
+				since := humanize.Time(maint.Changed)
+				if maint.ChangedBy != "" {
+					since += " by " + maint.ChangedBy
+				}
+				boldRed.Printf("MAINTENANCE MODE ON: provisioning is paused since %s\n", since)
+				if maint.Reason != "" {
+					red.Printf("Reason: %s\n", maint.Reason)
+				}
+				fmt.Println("DHCP and DNS answer normally. Turn it off with: grendel maintenance off")
+				fmt.Println()
+			}
+			if readOnly != nil && readOnly.Enabled {
+				boldRed.Println("READ-ONLY MODE: changes to the data store are refused")
+				if readOnly.Primary != "" {
+					fmt.Printf("Send changes to the primary: %s\n", readOnly.Primary)
+				}
+				fmt.Println("DHCP, DNS and provisioning are served from the shared data store")
+				fmt.Println()
+			}
 			yellow.Printf("Nodes: %s\n\n", humanize.Comma(int64(nodes)))
 
+			if len(keyList) > 0 {
+				active := make([]string, 0, len(keyList))
+				for _, k := range keyList {
+					key := fmt.Sprintf("%s %s", k.Kind, k.ID)
+					if k.Verifying > 0 {
+						key += fmt.Sprintf(" (%d previous verifying)", k.Verifying)
+					}
+					active = append(active, key)
+				}
+				fmt.Printf("Signing keys: %s\n\n", strings.Join(active, ", "))
+			}
+
+			if len(haList) > 0 {
+				fmt.Printf("%-30s%15s%15s%20s%20s\n", fmt.Sprintf("HA Instances (%d)", len(haList)), "Role", "State", "Heartbeat", "Changed")
+				for _, i := range haList {
+					c := cyan
+					switch {
+					case !i.Alive:
+						c = red
+					case i.State == model.HAStateServing:
+						c = green
+					}
+					c.Printf("%-30s%15s%15s%20s%20s\n", i.Name, i.Role, i.State, humanize.Time(i.Heartbeat), humanize.Time(i.Changed))
+				}
+				fmt.Println()
+			}
+
+			if len(listenerList) > 0 {
+				fmt.Printf("%-30s%15s%30s%15s\n", fmt.Sprintf("Listeners (%d)", len(listenerList)), "Network", "Address", "Family")
+				for _, l := range listenerList {
+					cyan.Printf("%-30s%15s%30s%15s\n", l.Service, l.Network, l.Address, l.Family)
+				}
+				fmt.Println()
+			}
+
 			if inputTags == "" {
 				fmt.Printf("%-30s%15s%15s%15s\n", fmt.Sprintf("Boot Images (%d)", len(imageList)), "Provision", "Unprovision", "Total")
 				for img, stat := range stats.images {
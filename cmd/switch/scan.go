@@ -0,0 +1,68 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package switches
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+	"github.com/ubccr/grendel/cmd"
+	"github.com/ubccr/grendel/pkg/client"
+)
+
+var (
+	scanUnmatched bool
+	scanCmd       = &cobra.Command{
+		Use:   "scan <switch-nodeset>",
+		Short: "Map node interfaces to switch ports",
+		Long: `Pull the MAC address tables from the given switches, match the MACs
+against node interfaces and store the switch and port on each matching
+interface`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(command *cobra.Command, args []string) error {
+			gc, err := cmd.NewOgenClient()
+			if err != nil {
+				return err
+			}
+
+			params := client.POSTV1SwitchNodesetScanParams{
+				Nodeset: args[0],
+			}
+			res, err := gc.POSTV1SwitchNodesetScan(context.Background(), params)
+			if err != nil {
+				return cmd.NewApiError(err)
+			}
+
+			if cmd.JSONOutput() {
+				return cmd.Output(res)
+			}
+
+			w := tabwriter.NewWriter(os.Stdout, 0, 0, 4, ' ', 0)
+			fmt.Fprintln(w, "Switch\tPort\tMAC Address\tHost\tInterface")
+			for _, m := range res.Matched {
+				fmt.Fprintf(w, "%s\t%d\t%s\t%s\t%s\n", m.Switch.Value, m.Port.Value, m.MAC.Value, m.Host.Value, m.Interface.Value)
+			}
+			if scanUnmatched {
+				for _, m := range res.Unmatched {
+					fmt.Fprintf(w, "%s\t%d\t%s\t%s\t%s\n", m.Switch.Value, m.Port.Value, m.MAC.Value, "(unmatched)", "")
+				}
+			}
+			if err := w.Flush(); err != nil {
+				return err
+			}
+
+			fmt.Printf("\nmatched: %d unmatched: %d changed: %d\n", len(res.Matched), len(res.Unmatched), res.Changed.Value)
+			return nil
+		},
+	}
+)
+
+func init() {
+	scanCmd.Flags().BoolVar(&scanUnmatched, "unmatched", false, "List MAC addresses that did not match any node")
+	switchCmd.AddCommand(scanCmd)
+}
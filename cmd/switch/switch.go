@@ -0,0 +1,22 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package switches
+
+import (
+	"github.com/spf13/cobra"
+	"github.com/ubccr/grendel/cmd"
+)
+
+var (
+	switchCmd = &cobra.Command{
+		Use:   "switch",
+		Short: "Network switch commands",
+		Long:  `Network switch commands`,
+	}
+)
+
+func init() {
+	cmd.Root.AddCommand(switchCmd)
+}
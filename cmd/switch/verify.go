@@ -0,0 +1,151 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package switches
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"text/tabwriter"
+
+	"github.com/manifoldco/promptui"
+	"github.com/spf13/cobra"
+	"github.com/ubccr/grendel/cmd"
+	"github.com/ubccr/grendel/pkg/client"
+)
+
+var (
+	verifyFormat string
+	verifyUpdate bool
+	verifyYes    bool
+	verifyCmd    = &cobra.Command{
+		Use:   "verify <switch-nodeset>",
+		Short: "Verify cabling against the stored switch ports",
+		Long: `Pull the LLDP neighbors from the given switches and compare them with the
+switch and port stored on node interfaces. Neighbors are matched to node
+interfaces by the MAC address in their port or chassis ID, or by their
+system name.
+
+Reported are interfaces seen on another port than the stored one
+(mismatched), interfaces mapped to the switches but not seen (missing) and
+neighbors that are not a node or have no stored switch port (unknown).
+
+The command exits non-zero when any interface is mismatched. Missing and
+unknown neighbors are reported but do not fail the check, nodes may be
+powered off and switches have uplinks. With --update the observed switch
+and port of mismatched and unmapped interfaces are stored after
+confirmation.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(command *cobra.Command, args []string) error {
+			if verifyFormat != "text" && verifyFormat != "json" {
+				return fmt.Errorf("invalid format %q. Valid formats: text, json", verifyFormat)
+			}
+
+			gc, err := cmd.NewOgenClient()
+			if err != nil {
+				return err
+			}
+
+			res, err := gc.GETV1SwitchNodesetVerify(context.Background(), client.GETV1SwitchNodesetVerifyParams{
+				Nodeset: args[0],
+			})
+			if err != nil {
+				return cmd.NewApiError(err)
+			}
+
+			jsonOutput := verifyFormat == "json" || cmd.JSONOutput()
+			updatable := len(res.Mismatched) + countUnmapped(res)
+			if verifyUpdate && updatable > 0 {
+				if !jsonOutput {
+					writeVerify(os.Stdout, res)
+				}
+
+				if !verifyYes {
+					prompt := promptui.Prompt{
+						Label:     fmt.Sprintf("Store the observed switch port of %d interface(s)", updatable),
+						IsConfirm: true,
+						Stdout:    os.Stderr,
+					}
+					if _, err := prompt.Run(); err != nil {
+						return errors.New("update cancelled")
+					}
+				}
+
+				res, err = gc.POSTV1SwitchNodesetVerify(context.Background(), client.POSTV1SwitchNodesetVerifyParams{
+					Nodeset: args[0],
+				})
+				if err != nil {
+					return cmd.NewApiError(err)
+				}
+
+				if jsonOutput {
+					return cmd.Output(res)
+				}
+				fmt.Printf("changed: %d\n", res.Changed.Value)
+				return nil
+			}
+
+			if jsonOutput {
+				if err := cmd.Output(res); err != nil {
+					return err
+				}
+			} else {
+				writeVerify(os.Stdout, res)
+			}
+
+			if len(res.Mismatched) > 0 {
+				return fmt.Errorf("%d interface(s) cabled to another port than the stored one", len(res.Mismatched))
+			}
+
+			return nil
+		},
+	}
+)
+
+// countUnmapped returns the number of unknown neighbors identified as a node
+// interface without a stored switch port
+func countUnmapped(res *client.SwitchVerifyResponse) int {
+	n := 0
+	for _, u := range res.Unknown {
+		if u.Interface.Value != "" {
+			n++
+		}
+	}
+
+	return n
+}
+
+func writeVerify(out io.Writer, res *client.SwitchVerifyResponse) {
+	w := tabwriter.NewWriter(out, 0, 0, 4, ' ', 0)
+	fmt.Fprintln(w, "Status\tSwitch\tPort\tHost\tInterface\tMAC Address\tExpected")
+	for _, v := range res.Verified {
+		fmt.Fprintf(w, "ok\t%s\t%s\t%s\t%s\t%s\t\n", v.Switch.Value, v.PortName.Value, v.Host.Value, v.Interface.Value, v.MAC.Value)
+	}
+	for _, m := range res.Mismatched {
+		fmt.Fprintf(w, "mismatched\t%s\t%s\t%s\t%s\t%s\t%s port %d\n", m.Switch.Value, m.PortName.Value, m.Host.Value, m.Interface.Value, m.MAC.Value, m.ExpectedSwitch.Value, m.ExpectedPort.Value)
+	}
+	for _, m := range res.Missing {
+		fmt.Fprintf(w, "missing\t%s\t%d\t%s\t%s\t%s\t\n", m.Switch.Value, m.Port.Value, m.Host.Value, m.Interface.Value, m.MAC.Value)
+	}
+	for _, u := range res.Unknown {
+		host := u.Host.Value
+		if host == "" {
+			host = "(" + u.Neighbor.Value + ")"
+		}
+		fmt.Fprintf(w, "unknown\t%s\t%s\t%s\t%s\t%s\t\n", u.Switch.Value, u.PortName.Value, host, u.Interface.Value, u.MAC.Value)
+	}
+	w.Flush()
+
+	fmt.Fprintf(out, "\nverified: %d mismatched: %d missing: %d unknown: %d\n", len(res.Verified), len(res.Mismatched), len(res.Missing), len(res.Unknown))
+}
+
+func init() {
+	verifyCmd.Flags().StringVar(&verifyFormat, "format", "text", "output format: text or json")
+	verifyCmd.Flags().BoolVar(&verifyUpdate, "update", false, "Store the observed switch port of mismatched and unmapped interfaces")
+	verifyCmd.Flags().BoolVarP(&verifyYes, "yes", "y", false, "Skip confirmation prompt")
+	switchCmd.AddCommand(verifyCmd)
+}
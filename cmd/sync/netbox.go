@@ -0,0 +1,283 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package synchronize
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"github.com/ubccr/grendel/cmd"
+	"github.com/ubccr/grendel/pkg/client"
+	"github.com/ubccr/grendel/pkg/netbox"
+	"github.com/ubccr/grendel/pkg/nodeset"
+)
+
+var (
+	netboxURL          string
+	netboxToken        string
+	netboxInsecure     bool
+	netboxPull         bool
+	netboxPush         bool
+	netboxDryRun       bool
+	netboxForce        bool
+	netboxSince        string
+	netboxFilter       string
+	netboxCustomFields []string
+	netboxBMCSerial    bool
+	netboxCmd          = &cobra.Command{
+		Use:   "netbox",
+		Short: "Synchronize hosts with NetBox",
+		Long: `Synchronize hosts with the devices of NetBox, matched by name.
+
+--pull adds or updates hosts from the devices: an interface for each device
+interface with a MAC address, with the IP address assigned to it in IPAM and
+its DNS name as fqdn. Management only interfaces are BMC interfaces. The
+host gets the NetBox tags, a rack=<rack> tag and a <field>=<value> tag for
+each custom field of --custom-field. Devices without a MAC address are not
+added.
+
+--push updates the devices with what grendel learned: the MAC address of the
+interfaces, the serial number from the serial=<serial> tag or the BMC with
+--bmc-serial, and the bios_version, bmc_firmware, cpu_model, cpu_count,
+core_count and memory_mib custom fields from the hardware and firmware
+inventory, when the device has them.
+
+An object changed on both sides is reported as a conflict and left alone
+unless --force. With --since, the time of the previous sync, both sides must
+have changed after it. Without it the side being updated must have changed
+after the other.`,
+		Example: `  grendel sync netbox --pull --filter "site=hpc&role=compute" --dry-run
+  grendel sync netbox --push --since 2026-10-01T00:00:00Z`,
+		Args: cobra.NoArgs,
+		RunE: func(command *cobra.Command, args []string) error {
+			since, err := cmd.ParseSince(netboxSince)
+			if err != nil {
+				return err
+			}
+
+			query, err := url.ParseQuery(netboxFilter)
+			if err != nil {
+				return fmt.Errorf("invalid --filter: %w", err)
+			}
+
+			if netboxURL == "" {
+				netboxURL = viper.GetString("provision.netbox_url")
+			}
+			if netboxToken == "" {
+				netboxToken = viper.GetString("provision.netbox_token")
+			}
+			if netboxURL == "" || netboxToken == "" {
+				return errors.New("--url and --token or provision.netbox_url and provision.netbox_token are required")
+			}
+
+			gc, err := cmd.NewOgenClient()
+			if err != nil {
+				return err
+			}
+
+			ctx := context.Background()
+			nc := netbox.NewRESTClient(netboxURL, netboxToken, netboxInsecure)
+			devices, err := fetchDevices(ctx, nc, query)
+			if err != nil {
+				return err
+			}
+
+			hosts, err := gc.HostList(ctx, client.HostFilter{})
+			if err != nil {
+				return err
+			}
+
+			opts := syncOptions{Since: since, Force: netboxForce, CustomFields: netboxCustomFields}
+			var changes []change
+			if netboxPull {
+				changes, err = pull(ctx, gc, devices, hosts, opts, netboxDryRun)
+			} else {
+				if netboxBMCSerial {
+					opts.Serials, err = bmcSerials(ctx, gc, devices, hosts)
+					if err != nil {
+						return err
+					}
+				}
+				changes, err = push(ctx, nc, devices, hosts, opts, netboxDryRun)
+			}
+			if err != nil {
+				return err
+			}
+
+			if cmd.JSONOutput() {
+				return cmd.Output(changes)
+			}
+
+			writeChanges(os.Stdout, changes)
+			return nil
+		},
+	}
+)
+
+func init() {
+	netboxCmd.Flags().StringVar(&netboxURL, "url", "", "URL of NetBox, defaults to provision.netbox_url")
+	netboxCmd.Flags().StringVar(&netboxToken, "token", "", "NetBox API token, defaults to provision.netbox_token")
+	netboxCmd.Flags().BoolVar(&netboxInsecure, "insecure", false, "skip verification of the NetBox certificate")
+	netboxCmd.Flags().BoolVar(&netboxPull, "pull", false, "add and update hosts from NetBox devices")
+	netboxCmd.Flags().BoolVar(&netboxPush, "push", false, "update NetBox devices from hosts")
+	netboxCmd.Flags().BoolVar(&netboxDryRun, "dry-run", false, "print the changes without applying them")
+	netboxCmd.Flags().BoolVar(&netboxForce, "force", false, "apply changes to objects changed on both sides")
+	netboxCmd.Flags().StringVar(&netboxSince, "since", "", "time of the previous sync used to detect conflicts, RFC3339 or a duration such as 24h")
+	netboxCmd.Flags().StringVar(&netboxFilter, "filter", "", "NetBox device filters as a query string such as site=hpc&role=compute")
+	netboxCmd.Flags().StringSliceVar(&netboxCustomFields, "custom-field", []string{}, "custom fields pulled as <field>=<value> tags")
+	netboxCmd.Flags().BoolVar(&netboxBMCSerial, "bmc-serial", false, "push the serial numbers reported by the BMCs")
+	netboxCmd.MarkFlagsMutuallyExclusive("pull", "push")
+	netboxCmd.MarkFlagsOneRequired("pull", "push")
+	syncCmd.AddCommand(netboxCmd)
+}
+
+// syncOptions are the settings of a NetBox sync
+type syncOptions struct {
+	// Since is the time of the previous sync, zero when unknown
+	Since time.Time
+
+	// Force applies changes to objects changed on both sides
+	Force bool
+
+	// CustomFields are the custom fields pulled as tags
+	CustomFields []string
+
+	// Serials are the serial numbers reported by the BMCs by host name
+	Serials map[string]string
+}
+
+// device is a NetBox device with its interfaces
+type device struct {
+	netbox.Device
+	Interfaces []deviceInterface
+}
+
+// deviceInterface is a NetBox interface with the first IP address assigned
+// to it
+type deviceInterface struct {
+	netbox.Interface
+	IP      string
+	DNSName string
+}
+
+// lastUpdated returns when the device, its interfaces or their addresses
+// were last changed
+func (d device) lastUpdated() time.Time {
+	last := d.LastUpdated
+	for _, i := range d.Interfaces {
+		if i.LastUpdated.After(last) {
+			last = i.LastUpdated
+		}
+	}
+
+	return last
+}
+
+// fetchDevices returns the devices matching query with their interfaces and
+// IP addresses
+func fetchDevices(ctx context.Context, nc *netbox.RESTClient, query url.Values) ([]device, error) {
+	devices, err := nc.Devices(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list NetBox devices: %w", err)
+	}
+
+	ids := make([]int, 0, len(devices))
+	for _, d := range devices {
+		ids = append(ids, d.ID)
+	}
+
+	interfaces, err := nc.Interfaces(ctx, ids)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list NetBox interfaces: %w", err)
+	}
+
+	addresses, err := nc.IPAddresses(ctx, ids)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list NetBox IP addresses: %w", err)
+	}
+
+	return assemble(devices, interfaces, addresses), nil
+}
+
+// assemble attaches the interfaces to their device and the addresses to
+// their interface. The address changing last counts as a change of the
+// interface
+func assemble(devices []netbox.Device, interfaces []netbox.Interface, addresses []netbox.IPAddress) []device {
+	byInterface := make(map[int]netbox.IPAddress)
+	for _, a := range addresses {
+		if a.AssignedObjectType != "dcim.interface" {
+			continue
+		}
+		if _, ok := byInterface[a.AssignedObjectID]; !ok {
+			byInterface[a.AssignedObjectID] = a
+		}
+	}
+
+	byDevice := make(map[int][]deviceInterface)
+	for _, i := range interfaces {
+		di := deviceInterface{Interface: i}
+		if a, ok := byInterface[i.ID]; ok {
+			di.IP = a.Address
+			di.DNSName = a.DNSName
+			if a.LastUpdated.After(di.LastUpdated) {
+				di.LastUpdated = a.LastUpdated
+			}
+		}
+		byDevice[i.Device.ID] = append(byDevice[i.Device.ID], di)
+	}
+
+	assembled := make([]device, 0, len(devices))
+	for _, d := range devices {
+		assembled = append(assembled, device{Device: d, Interfaces: byDevice[d.ID]})
+	}
+
+	return assembled
+}
+
+// bmcSerials returns the serial numbers reported by the BMCs of the hosts
+// matching a device, by host name
+func bmcSerials(ctx context.Context, gc *client.Client, devices []device, hosts []client.Host) (map[string]string, error) {
+	matched := make(map[string]bool, len(devices))
+	for _, d := range devices {
+		matched[d.Name] = true
+	}
+
+	names := make([]string, 0)
+	for _, h := range hosts {
+		if matched[h.Name.Value] {
+			names = append(names, h.Name.Value)
+		}
+	}
+
+	serials := make(map[string]string)
+	if len(names) == 0 {
+		return serials, nil
+	}
+
+	ns, err := nodeset.NewNodeSet(strings.Join(names, ","))
+	if err != nil {
+		return nil, err
+	}
+
+	systems, err := gc.GETV1Bmc(ctx, client.GETV1BmcParams{Nodeset: client.NewOptString(ns.String())})
+	if err != nil {
+		return nil, cmd.NewApiError(err)
+	}
+
+	for _, s := range systems {
+		if s.SerialNumber.Value != "" {
+			serials[s.Name.Value] = s.SerialNumber.Value
+		}
+	}
+
+	return serials, nil
+}
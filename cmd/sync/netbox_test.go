@@ -0,0 +1,216 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package synchronize
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/ubccr/grendel/pkg/client"
+	"github.com/ubccr/grendel/pkg/netbox"
+)
+
+func testDevice() device {
+	updated := time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)
+	devices := assemble(
+		[]netbox.Device{{
+			ID:           1,
+			Name:         "cpn-01",
+			Serial:       "OLD",
+			Rack:         &netbox.NestedObject{ID: 1, Name: "a01"},
+			Tags:         []netbox.Tag{{Name: "IB", Slug: "ib"}},
+			CustomFields: map[string]any{"slot": float64(4), "bios_version": nil, "owner": nil},
+			LastUpdated:  updated,
+		}},
+		[]netbox.Interface{
+			{ID: 10, Device: netbox.NestedObject{ID: 1}, Name: "eno1", MACAddress: "DE:AD:BE:EF:00:01", LastUpdated: updated},
+			{ID: 11, Device: netbox.NestedObject{ID: 1}, Name: "idrac", MACAddress: "DE:AD:BE:EF:01:01", MgmtOnly: true, LastUpdated: updated},
+			{ID: 12, Device: netbox.NestedObject{ID: 1}, Name: "eno2", LastUpdated: updated},
+		},
+		[]netbox.IPAddress{
+			{ID: 100, Address: "10.0.0.1/24", DNSName: "cpn-01.example.com", AssignedObjectType: "dcim.interface", AssignedObjectID: 10, LastUpdated: updated.Add(time.Hour)},
+			{ID: 101, Address: "10.0.1.1/24", AssignedObjectType: "dcim.interface", AssignedObjectID: 11},
+			{ID: 102, Address: "10.0.2.1/24", AssignedObjectType: "virtualization.vminterface", AssignedObjectID: 12},
+		},
+	)
+
+	return devices[0]
+}
+
+func TestAssemble(t *testing.T) {
+	d := testDevice()
+	if assert.Len(t, d.Interfaces, 3) {
+		assert.Equal(t, "10.0.0.1/24", d.Interfaces[0].IP)
+		assert.Equal(t, "cpn-01.example.com", d.Interfaces[0].DNSName)
+		assert.Equal(t, "10.0.1.1/24", d.Interfaces[1].IP)
+		assert.Empty(t, d.Interfaces[2].IP)
+	}
+	assert.Equal(t, time.Date(2026, 10, 1, 1, 0, 0, 0, time.UTC), d.lastUpdated())
+}
+
+func TestPullHost(t *testing.T) {
+	d := testDevice()
+
+	host := pullHost(d, nil, syncOptions{CustomFields: []string{"slot", "owner"}})
+	assert.Equal(t, map[string]string{
+		"name":                 "cpn-01",
+		"interfaces[0].ifname": "eno1",
+		"interfaces[0].mac":    "de:ad:be:ef:00:01",
+		"interfaces[0].ip":     "10.0.0.1/24",
+		"interfaces[0].fqdn":   "cpn-01.example.com",
+		"interfaces[1].ifname": "idrac",
+		"interfaces[1].mac":    "de:ad:be:ef:01:01",
+		"interfaces[1].ip":     "10.0.1.1/24",
+		"interfaces[1].bmc":    "true",
+		"tags":                 "ib,rack=a01,slot=4",
+	}, hostFields(host))
+
+	existing := client.Host{
+		Name:      client.NewOptString("cpn-01"),
+		BootImage: client.NewOptString("compute"),
+		Interfaces: []client.NilHostInterfacesItem{
+			client.NewNilHostInterfacesItem(client.HostInterfacesItem{
+				MAC: client.NewOptString("de:ad:be:ef:00:01"),
+				IP:  client.NewOptString("10.0.0.9/24"),
+				Mtu: client.NewOptInt(9000),
+			}),
+			client.NewNilHostInterfacesItem(client.HostInterfacesItem{
+				Ifname: client.NewOptString("ib0"),
+				IP:     client.NewOptString("10.1.0.1/24"),
+			}),
+		},
+		Tags: client.NewOptNilStringArray([]string{"rack:b02", "gpu", "owner=lab"}),
+	}
+
+	host = pullHost(d, &existing, syncOptions{CustomFields: []string{"owner"}})
+	assert.Equal(t, "compute", host.BootImage.Value)
+	assert.Equal(t, "10.0.0.9/24", existing.Interfaces[0].Value.IP.Value)
+	if assert.Len(t, host.Interfaces, 3) {
+		assert.Equal(t, "10.0.0.1/24", host.Interfaces[0].Value.IP.Value)
+		assert.Equal(t, 9000, host.Interfaces[0].Value.Mtu.Value)
+		assert.Equal(t, "ib0", host.Interfaces[1].Value.Ifname.Value)
+		assert.Equal(t, "idrac", host.Interfaces[2].Value.Ifname.Value)
+	}
+	assert.Equal(t, []string{"rack=a01", "gpu", "ib"}, host.Tags.Value)
+
+	fields := diffFields(hostFields(existing), hostFields(host))
+	assert.Equal(t, []fieldChange{
+		{Field: "interfaces[0].fqdn", New: "cpn-01.example.com"},
+		{Field: "interfaces[0].ifname", New: "eno1"},
+		{Field: "interfaces[0].ip", Old: "10.0.0.9/24", New: "10.0.0.1/24"},
+		{Field: "interfaces[2].bmc", New: "true"},
+		{Field: "interfaces[2].ifname", New: "idrac"},
+		{Field: "interfaces[2].ip", New: "10.0.1.1/24"},
+		{Field: "interfaces[2].mac", New: "de:ad:be:ef:01:01"},
+		{Field: "tags", Old: "rack:b02,gpu,owner=lab", New: "rack=a01,gpu,ib"},
+	}, fields)
+}
+
+func TestPushDevice(t *testing.T) {
+	d := testDevice()
+	host := client.Host{
+		Name: client.NewOptString("cpn-01"),
+		Interfaces: []client.NilHostInterfacesItem{
+			client.NewNilHostInterfacesItem(client.HostInterfacesItem{
+				Ifname: client.NewOptString("eno1"),
+				MAC:    client.NewOptString("de:ad:be:ef:00:01"),
+			}),
+			client.NewNilHostInterfacesItem(client.HostInterfacesItem{
+				MAC: client.NewOptString("de:ad:be:ef:01:02"),
+				Bmc: client.NewOptBool(true),
+			}),
+			client.NewNilHostInterfacesItem(client.HostInterfacesItem{
+				Ifname: client.NewOptString("eno2"),
+				MAC:    client.NewOptString("de:ad:be:ef:00:02"),
+			}),
+		},
+		Inventory: client.NewOptNilHostInventory(client.HostInventory{BiosVersion: client.NewOptString("2.1.0")}),
+		Hardware:  client.NewOptNilHostHardware(client.HostHardware{MemoryMib: client.NewOptInt(262144)}),
+		Tags:      client.NewOptNilStringArray([]string{"serial=TAG"}),
+	}
+
+	patch := pushDevice(d, host, syncOptions{})
+	assert.Equal(t, map[string]any{
+		"serial":        "TAG",
+		"custom_fields": map[string]any{"bios_version": "2.1.0"},
+	}, patch.device)
+	assert.Equal(t, map[int]map[string]any{
+		11: {"mac_address": "de:ad:be:ef:01:02"},
+		12: {"mac_address": "de:ad:be:ef:00:02"},
+	}, patch.interfaces)
+	assert.Equal(t, []fieldChange{
+		{Field: "custom_fields.bios_version", New: "2.1.0"},
+		{Field: "interfaces[eno2].mac_address", New: "de:ad:be:ef:00:02"},
+		{Field: "interfaces[idrac].mac_address", Old: "DE:AD:BE:EF:01:01", New: "de:ad:be:ef:01:02"},
+		{Field: "serial", Old: "OLD", New: "TAG"},
+	}, patch.fields)
+
+	patch = pushDevice(d, host, syncOptions{Serials: map[string]string{"cpn-01": "BMC"}})
+	assert.Equal(t, "BMC", patch.device["serial"])
+}
+
+func TestPushConflict(t *testing.T) {
+	d := testDevice()
+	host := client.Host{
+		Name:      client.NewOptString("cpn-01"),
+		Tags:      client.NewOptNilStringArray([]string{"serial=TAG"}),
+		UpdatedAt: client.NewOptNilDateTime(time.Date(2026, 9, 1, 0, 0, 0, 0, time.UTC)),
+	}
+
+	// NetBox changed after grendel, the device is left alone
+	changes, err := push(context.Background(), nil, []device{d}, []client.Host{host}, syncOptions{}, false)
+	require.NoError(t, err)
+	if assert.Len(t, changes, 1) {
+		assert.Equal(t, actionConflict, changes[0].Action)
+		assert.Equal(t, "changed in grendel at 2026-09-01T00:00:00Z and in NetBox at 2026-10-01T01:00:00Z", changes[0].Detail)
+	}
+
+	// Only NetBox changed since the previous sync
+	changes, err = push(context.Background(), nil, []device{d}, []client.Host{host}, syncOptions{Since: time.Date(2026, 9, 15, 0, 0, 0, 0, time.UTC)}, true)
+	require.NoError(t, err)
+	if assert.Len(t, changes, 1) {
+		assert.Equal(t, actionUpdate, changes[0].Action)
+	}
+
+	changes, err = push(context.Background(), nil, []device{d}, []client.Host{host}, syncOptions{Force: true}, true)
+	require.NoError(t, err)
+	if assert.Len(t, changes, 1) {
+		assert.Equal(t, actionUpdate, changes[0].Action)
+	}
+}
+
+func TestConflicted(t *testing.T) {
+	t1 := time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)
+	t2 := t1.Add(time.Hour)
+	since := t1.Add(-time.Hour)
+
+	assert.False(t, conflicted(t2, t1, time.Time{}))
+	assert.True(t, conflicted(t1, t2, time.Time{}))
+	assert.True(t, conflicted(t2, t1, since))
+	assert.False(t, conflicted(t2, since.Add(-time.Hour), since))
+}
+
+func TestWriteChanges(t *testing.T) {
+	var buf bytes.Buffer
+	writeChanges(&buf, nil)
+	assert.Equal(t, "No changes\n", buf.String())
+
+	buf.Reset()
+	writeChanges(&buf, []change{
+		{Kind: "host", Name: "cpn-02", Action: actionAdd, Fields: []fieldChange{{Field: "name", New: "cpn-02"}}},
+		{Kind: "host", Name: "cpn-01", Action: actionUpdate, Fields: []fieldChange{{Field: "tags", Old: "gpu", New: "gpu,rack=a01"}}},
+		{Kind: "host", Name: "cpn-03", Action: actionConflict, Detail: "changed in NetBox at 2026-10-01T00:00:00Z and in grendel at 2026-10-02T00:00:00Z"},
+	})
+	assert.Equal(t, `+ host cpn-02
+    name: "" -> "cpn-02"
+~ host cpn-01
+    tags: "gpu" -> "gpu,rack=a01"
+! host cpn-03: conflict, changed in NetBox at 2026-10-01T00:00:00Z and in grendel at 2026-10-02T00:00:00Z
+`, buf.String())
+}
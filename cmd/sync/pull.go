@@ -0,0 +1,221 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package synchronize
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ubccr/grendel/cmd"
+	"github.com/ubccr/grendel/pkg/client"
+)
+
+// pull adds and updates the hosts of the devices. Hosts changed on both sides
+// are reported as conflicts and not saved unless opts.Force
+func pull(ctx context.Context, gc *client.Client, devices []device, hosts []client.Host, opts syncOptions, dryRun bool) ([]change, error) {
+	byName := make(map[string]client.Host, len(hosts))
+	for _, h := range hosts {
+		byName[h.Name.Value] = h
+	}
+
+	changes := make([]change, 0)
+	save := make([]client.Host, 0)
+	for _, d := range devices {
+		if d.Name == "" {
+			continue
+		}
+
+		existing, ok := byName[d.Name]
+		if !ok && !slices.ContainsFunc(d.Interfaces, func(i deviceInterface) bool { return i.MACAddress != "" }) {
+			cmd.Log.Debugf("Skipping NetBox device %s without a MAC address", d.Name)
+			continue
+		}
+
+		c := change{Kind: "host", Name: d.Name, Action: actionAdd}
+		before := make(map[string]string)
+		var host client.Host
+		if ok {
+			c.Action = actionUpdate
+			before = hostFields(existing)
+			host = pullHost(d, &existing, opts)
+		} else {
+			host = pullHost(d, nil, opts)
+		}
+
+		c.Fields = diffFields(before, hostFields(host))
+		if len(c.Fields) == 0 {
+			continue
+		}
+
+		if ok && !opts.Force && conflicted(d.lastUpdated(), existing.UpdatedAt.Value, opts.Since) {
+			c.Action = actionConflict
+			c.Detail = fmt.Sprintf("changed in NetBox at %s and in grendel at %s",
+				d.lastUpdated().Format(time.RFC3339), existing.UpdatedAt.Value.Format(time.RFC3339))
+			changes = append(changes, c)
+			continue
+		}
+
+		changes = append(changes, c)
+		save = append(save, host)
+	}
+
+	if dryRun || len(save) == 0 {
+		return changes, nil
+	}
+
+	// The revision of updated hosts makes the save fail if they changed
+	// since they were listed
+	if _, err := gc.HostSave(ctx, save); err != nil {
+		return nil, err
+	}
+
+	return changes, nil
+}
+
+// pullHost returns existing, or a new host when nil, updated from the
+// device. Interfaces are matched by MAC address or name, the interfaces
+// NetBox does not know are kept
+func pullHost(d device, existing *client.Host, opts syncOptions) client.Host {
+	host := client.Host{Name: client.NewOptString(d.Name)}
+	if existing != nil {
+		host = *existing
+		host.Interfaces = slices.Clone(existing.Interfaces)
+	}
+
+	for _, ni := range d.Interfaces {
+		if ni.MACAddress == "" {
+			continue
+		}
+
+		idx := slices.IndexFunc(host.Interfaces, func(i client.NilHostInterfacesItem) bool {
+			return strings.EqualFold(i.Value.MAC.Value, ni.MACAddress) ||
+				(i.Value.Ifname.Value != "" && i.Value.Ifname.Value == ni.Name)
+		})
+
+		var iface client.HostInterfacesItem
+		if idx >= 0 {
+			iface = host.Interfaces[idx].Value
+		}
+		iface.Ifname = client.NewOptString(ni.Name)
+		iface.MAC = client.NewOptString(strings.ToLower(ni.MACAddress))
+		iface.Bmc = client.NewOptBool(ni.MgmtOnly)
+		if ni.IP != "" {
+			iface.IP = client.NewOptString(ni.IP)
+		}
+		if ni.DNSName != "" {
+			iface.Fqdn = client.NewOptString(ni.DNSName)
+		}
+
+		if idx >= 0 {
+			host.Interfaces[idx] = client.NewNilHostInterfacesItem(iface)
+		} else {
+			host.Interfaces = append(host.Interfaces, client.NewNilHostInterfacesItem(iface))
+		}
+	}
+
+	host.Tags = client.NewOptNilStringArray(pullTags(d, host.Tags.Value, opts.CustomFields))
+
+	return host
+}
+
+// pullTags returns tags with the NetBox tags of the device added and the
+// rack and custom field tags set to the values of the device
+func pullTags(d device, tags []string, customFields []string) []string {
+	tags = slices.Clone(tags)
+	for _, t := range d.Tags {
+		if !slices.Contains(tags, t.Slug) {
+			tags = append(tags, t.Slug)
+		}
+	}
+
+	rack := ""
+	if d.Rack != nil {
+		rack = d.Rack.Name
+	}
+	tags = setTag(tags, "rack", rack)
+
+	for _, f := range customFields {
+		tags = setTag(tags, f, customFieldValue(d.CustomFields[f]))
+	}
+
+	return tags
+}
+
+// setTag replaces the key=value or key:value tags of key with key=value, in
+// place of the first one. An empty value removes them
+func setTag(tags []string, key, value string) []string {
+	first := -1
+	set := make([]string, 0, len(tags))
+	for _, t := range tags {
+		if !strings.HasPrefix(t, key+"=") && !strings.HasPrefix(t, key+":") {
+			set = append(set, t)
+			continue
+		}
+		if first < 0 && value != "" {
+			first = len(set)
+			set = append(set, key+"="+value)
+		}
+	}
+
+	if first < 0 && value != "" {
+		set = append(set, key+"="+value)
+	}
+
+	return set
+}
+
+// customFieldValue returns the value of a NetBox custom field as a string.
+// Objects are represented by their name, lists are not supported
+func customFieldValue(v any) string {
+	switch v := v.(type) {
+	case string:
+		return v
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case bool:
+		return strconv.FormatBool(v)
+	case map[string]any:
+		if name, ok := v["name"].(string); ok {
+			return name
+		}
+		if display, ok := v["display"].(string); ok {
+			return display
+		}
+	}
+
+	return ""
+}
+
+// hostFields returns the fields of host pull changes, by name
+func hostFields(host client.Host) map[string]string {
+	fields := map[string]string{"name": host.Name.Value}
+	for i, item := range host.Interfaces {
+		iface := item.Value
+		prefix := fmt.Sprintf("interfaces[%d].", i)
+		for name, value := range map[string]string{
+			"ifname": iface.Ifname.Value,
+			"mac":    iface.MAC.Value,
+			"ip":     iface.IP.Value,
+			"fqdn":   iface.Fqdn.Value,
+		} {
+			if value != "" {
+				fields[prefix+name] = value
+			}
+		}
+		if iface.Bmc.Value {
+			fields[prefix+"bmc"] = "true"
+		}
+	}
+
+	if len(host.Tags.Value) > 0 {
+		fields["tags"] = strings.Join(host.Tags.Value, ",")
+	}
+
+	return fields
+}
@@ -0,0 +1,191 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package synchronize
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/ubccr/grendel/pkg/client"
+	"github.com/ubccr/grendel/pkg/netbox"
+)
+
+// devicePatch are the fields push changes on a device and its interfaces
+type devicePatch struct {
+	device     map[string]any
+	interfaces map[int]map[string]any
+	fields     []fieldChange
+}
+
+// push updates the devices from their hosts. Devices changed on both sides
+// are reported as conflicts and not updated unless opts.Force
+func push(ctx context.Context, nc *netbox.RESTClient, devices []device, hosts []client.Host, opts syncOptions, dryRun bool) ([]change, error) {
+	byName := make(map[string]client.Host, len(hosts))
+	for _, h := range hosts {
+		byName[h.Name.Value] = h
+	}
+
+	changes := make([]change, 0)
+	for _, d := range devices {
+		host, ok := byName[d.Name]
+		if !ok {
+			continue
+		}
+
+		patch := pushDevice(d, host, opts)
+		if len(patch.fields) == 0 {
+			continue
+		}
+
+		c := change{Kind: "device", Name: d.Name, Action: actionUpdate, Fields: patch.fields}
+		if !opts.Force && conflicted(host.UpdatedAt.Value, d.lastUpdated(), opts.Since) {
+			c.Action = actionConflict
+			c.Detail = fmt.Sprintf("changed in grendel at %s and in NetBox at %s",
+				host.UpdatedAt.Value.Format(time.RFC3339), d.lastUpdated().Format(time.RFC3339))
+			changes = append(changes, c)
+			continue
+		}
+
+		changes = append(changes, c)
+		if dryRun {
+			continue
+		}
+
+		if len(patch.device) > 0 {
+			if err := nc.UpdateDevice(ctx, d.ID, patch.device); err != nil {
+				return nil, fmt.Errorf("failed to update NetBox device %s: %w", d.Name, err)
+			}
+		}
+		for id, fields := range patch.interfaces {
+			if err := nc.UpdateInterface(ctx, id, fields); err != nil {
+				return nil, fmt.Errorf("failed to update NetBox interface of %s: %w", d.Name, err)
+			}
+		}
+	}
+
+	return changes, nil
+}
+
+// pushDevice returns the changes of the device from host: its serial number,
+// the inventory custom fields the device has and the MAC address of the
+// interfaces matched by name. A BMC interface without a name matches the
+// only management interface of the device
+func pushDevice(d device, host client.Host, opts syncOptions) devicePatch {
+	patch := devicePatch{
+		device:     make(map[string]any),
+		interfaces: make(map[int]map[string]any),
+		fields:     make([]fieldChange, 0),
+	}
+
+	serial := opts.Serials[host.Name.Value]
+	if serial == "" {
+		serial = tagValue(host.Tags.Value, "serial")
+	}
+	if serial != "" && serial != d.Serial {
+		patch.device["serial"] = serial
+		patch.fields = append(patch.fields, fieldChange{Field: "serial", Old: d.Serial, New: serial})
+	}
+
+	custom := make(map[string]any)
+	for name, value := range inventoryFields(host) {
+		current, ok := d.CustomFields[name]
+		if !ok || customFieldValue(current) == fmt.Sprint(value) {
+			continue
+		}
+		custom[name] = value
+		patch.fields = append(patch.fields, fieldChange{Field: "custom_fields." + name, Old: customFieldValue(current), New: fmt.Sprint(value)})
+	}
+	if len(custom) > 0 {
+		patch.device["custom_fields"] = custom
+	}
+
+	mgmt := make([]deviceInterface, 0)
+	for _, ni := range d.Interfaces {
+		if ni.MgmtOnly {
+			mgmt = append(mgmt, ni)
+		}
+	}
+
+	for _, item := range host.Interfaces {
+		iface := item.Value
+		if iface.MAC.Value == "" {
+			continue
+		}
+
+		var match *deviceInterface
+		for i := range d.Interfaces {
+			if iface.Ifname.Value != "" && d.Interfaces[i].Name == iface.Ifname.Value {
+				match = &d.Interfaces[i]
+				break
+			}
+		}
+		if match == nil && iface.Ifname.Value == "" && iface.Bmc.Value && len(mgmt) == 1 {
+			match = &mgmt[0]
+		}
+		if match == nil || strings.EqualFold(match.MACAddress, iface.MAC.Value) {
+			continue
+		}
+
+		patch.interfaces[match.ID] = map[string]any{"mac_address": iface.MAC.Value}
+		patch.fields = append(patch.fields, fieldChange{
+			Field: fmt.Sprintf("interfaces[%s].mac_address", match.Name),
+			Old:   match.MACAddress,
+			New:   iface.MAC.Value,
+		})
+	}
+
+	sort.Slice(patch.fields, func(i, j int) bool { return patch.fields[i].Field < patch.fields[j].Field })
+
+	return patch
+}
+
+// inventoryFields returns the custom fields pushed from the hardware and
+// firmware inventory of host, leaving out the values it does not have
+func inventoryFields(host client.Host) map[string]any {
+	fields := make(map[string]any)
+	if host.Inventory.Set && !host.Inventory.Null {
+		inv := host.Inventory.Value
+		if inv.BiosVersion.Value != "" {
+			fields["bios_version"] = inv.BiosVersion.Value
+		}
+		if inv.BmcFirmware.Value != "" {
+			fields["bmc_firmware"] = inv.BmcFirmware.Value
+		}
+	}
+
+	if host.Hardware.Set && !host.Hardware.Null {
+		hw := host.Hardware.Value
+		if hw.CPUModel.Value != "" {
+			fields["cpu_model"] = hw.CPUModel.Value
+		}
+		for name, value := range map[string]int{
+			"cpu_count":  hw.CPUCount.Value,
+			"core_count": hw.CoreCount.Value,
+			"memory_mib": hw.MemoryMib.Value,
+		} {
+			if value > 0 {
+				fields[name] = value
+			}
+		}
+	}
+
+	return fields
+}
+
+// tagValue returns the value of the first key=value or key:value tag
+func tagValue(tags []string, key string) string {
+	for _, t := range tags {
+		for _, prefix := range []string{key + "=", key + ":"} {
+			if v, ok := strings.CutPrefix(t, prefix); ok {
+				return v
+			}
+		}
+	}
+
+	return ""
+}
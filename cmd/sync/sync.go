@@ -0,0 +1,103 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package synchronize
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/ubccr/grendel/cmd"
+)
+
+var (
+	syncCmd = &cobra.Command{
+		Use:   "sync",
+		Short: "Synchronize hosts with external inventories",
+		Long:  `Synchronize hosts with external inventories`,
+	}
+)
+
+func init() {
+	cmd.Root.AddCommand(syncCmd)
+}
+
+const (
+	actionAdd      = "add"
+	actionUpdate   = "update"
+	actionConflict = "conflict"
+)
+
+// change is an object added, updated or left alone because of a conflict
+type change struct {
+	Kind   string        `json:"kind"`
+	Name   string        `json:"name"`
+	Action string        `json:"action"`
+	Fields []fieldChange `json:"fields"`
+	Detail string        `json:"detail,omitempty"`
+}
+
+// fieldChange is the old and new value of a field
+type fieldChange struct {
+	Field string `json:"field"`
+	Old   string `json:"old"`
+	New   string `json:"new"`
+}
+
+// diffFields returns the fields with a different value in before and after,
+// sorted by name
+func diffFields(before, after map[string]string) []fieldChange {
+	fields := make([]fieldChange, 0)
+	for f, v := range after {
+		if before[f] != v {
+			fields = append(fields, fieldChange{Field: f, Old: before[f], New: v})
+		}
+	}
+	for f, v := range before {
+		if _, ok := after[f]; !ok {
+			fields = append(fields, fieldChange{Field: f, Old: v})
+		}
+	}
+	sort.Slice(fields, func(i, j int) bool { return fields[i].Field < fields[j].Field })
+
+	return fields
+}
+
+// conflicted returns true when the source and target of a change were both
+// modified. With since, the time of the previous sync, both must have changed
+// after it. Without it the target changing after the source means it was
+// edited since the source was last synced to it
+func conflicted(source, target, since time.Time) bool {
+	if since.IsZero() {
+		return target.After(source)
+	}
+
+	return source.After(since) && target.After(since)
+}
+
+// writeChanges writes changes as a field level diff
+func writeChanges(w io.Writer, changes []change) {
+	if len(changes) == 0 {
+		fmt.Fprintln(w, "No changes")
+		return
+	}
+
+	for _, c := range changes {
+		switch c.Action {
+		case actionAdd:
+			fmt.Fprintf(w, "+ %s %s\n", c.Kind, c.Name)
+		case actionUpdate:
+			fmt.Fprintf(w, "~ %s %s\n", c.Kind, c.Name)
+		case actionConflict:
+			fmt.Fprintf(w, "! %s %s: conflict, %s\n", c.Kind, c.Name, c.Detail)
+		}
+
+		for _, f := range c.Fields {
+			fmt.Fprintf(w, "    %s: %q -> %q\n", f.Field, f.Old, f.New)
+		}
+	}
+}
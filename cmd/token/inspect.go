@@ -0,0 +1,65 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package token
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/ubccr/grendel/cmd"
+	"github.com/ubccr/grendel/pkg/client"
+)
+
+var (
+	inspectCmd = &cobra.Command{
+		Use:   "inspect <boot-token>",
+		Short: "Show the host, MAC and expiry of a boot token",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(command *cobra.Command, args []string) error {
+			gc, err := cmd.NewOgenClient()
+			if err != nil {
+				return err
+			}
+
+			req := &client.NodeTokenRequest{Token: args[0]}
+			res, err := gc.POSTV1NodesTokenInspect(context.Background(), req, client.POSTV1NodesTokenInspectParams{})
+			if err != nil {
+				return cmd.NewApiError(err)
+			}
+
+			if cmd.JSONOutput() {
+				return cmd.Output(res)
+			}
+
+			expires := "never"
+			if v, ok := res.ExpiresAt.Get(); ok && !v.IsZero() {
+				expires = v.Local().Format(time.RFC3339)
+			}
+			status := "valid"
+			switch {
+			case res.Revoked.Value:
+				status = "revoked"
+			case res.Expired.Value:
+				status = "expired"
+			}
+
+			fmt.Printf("%-10s%s\n", "ID:", res.ID.Value)
+			fmt.Printf("%-10s%s\n", "Host:", res.HostName.Value)
+			fmt.Printf("%-10s%s\n", "Host ID:", res.HostID.Value)
+			fmt.Printf("%-10s%s\n", "MAC:", res.MAC.Value)
+			fmt.Printf("%-10s%s\n", "Issued:", res.IssuedAt.Value.Local().Format(time.RFC3339))
+			fmt.Printf("%-10s%s\n", "Expires:", expires)
+			fmt.Printf("%-10s%s\n", "Status:", status)
+
+			return nil
+		},
+	}
+)
+
+func init() {
+	tokenCmd.AddCommand(inspectCmd)
+}
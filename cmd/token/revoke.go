@@ -0,0 +1,40 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package token
+
+import (
+	"context"
+
+	"github.com/spf13/cobra"
+	"github.com/ubccr/grendel/cmd"
+	"github.com/ubccr/grendel/pkg/client"
+)
+
+var (
+	revokeCmd = &cobra.Command{
+		Use:   "revoke <boot-token>",
+		Short: "Revoke a boot token",
+		Long:  `Revoke a boot token. The provision server rejects revoked tokens until they would have expired`,
+		Args:  cobra.ExactArgs(1),
+		RunE: func(command *cobra.Command, args []string) error {
+			gc, err := cmd.NewOgenClient()
+			if err != nil {
+				return err
+			}
+
+			req := &client.NodeTokenRequest{Token: args[0]}
+			res, err := gc.POSTV1NodesTokenRevoke(context.Background(), req, client.POSTV1NodesTokenRevokeParams{})
+			if err != nil {
+				return cmd.NewApiError(err)
+			}
+
+			return cmd.NewApiResponse(res)
+		},
+	}
+)
+
+func init() {
+	tokenCmd.AddCommand(revokeCmd)
+}
@@ -0,0 +1,25 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package token
+
+import (
+	"github.com/spf13/cobra"
+	"github.com/ubccr/grendel/cmd"
+)
+
+var (
+	tokenCmd = &cobra.Command{
+		Use:   "token",
+		Short: "Boot token commands",
+		Long: `Boot token commands.
+
+Boot tokens are embedded in the provision URLs (/boot/<token>/...) handed to
+nodes. Generate them with "grendel node token".`,
+	}
+)
+
+func init() {
+	cmd.Root.AddCommand(tokenCmd)
+}
@@ -0,0 +1,89 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package validate
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"github.com/ubccr/grendel/cmd"
+	"github.com/ubccr/grendel/cmd/serve"
+)
+
+var (
+	runtime     bool
+	services    []string
+	validateCmd = &cobra.Command{
+		Use:   "validate",
+		Short: "Check the configuration for problems",
+		Long: `Check the configuration of the services enabled in the configuration file, or
+listed in --services, for problems preventing grendel serve from starting:
+overlapping dhcp.subnets or a gateway which is not a usable address of its
+subnet, and templates which fail to parse. Hosts in the datastore with an
+address in none of dhcp.subnets are reported as warnings.
+
+--runtime also runs the checks of the state of this host done by grendel
+serve before starting: the database directory is writable and the database is
+not locked by another grendel serve, the listen ports are free, the
+provision and API certificates are valid and the files of the boot images are
+readable. Run it as the user starting grendel serve, while it is stopped.
+Addresses reserved by node nextip --reserve which expired without being
+assigned to a node are reported as warnings.
+
+Each problem is printed with a suggested fix. Exits with an error when any
+problem other than a warning is found.`,
+		Args: cobra.NoArgs,
+		RunE: func(command *cobra.Command, args []string) error {
+			// Not bound to the services setting, which is bound to the flag
+			// of grendel serve
+			if command.Flags().Changed("services") {
+				viper.Set("services", services)
+			}
+
+			problems, err := serve.Preflight(runtime)
+			if err != nil {
+				return err
+			}
+
+			if cmd.JSONOutput() {
+				if err := cmd.Output(problems); err != nil {
+					return err
+				}
+			} else {
+				for _, p := range problems {
+					level := "error"
+					if p.Warning {
+						level = "warning"
+					}
+					fmt.Printf("%-10s%-14s%s\n", level, p.Check, p.Message)
+					if p.Fix != "" {
+						fmt.Printf("%-24s%s\n", "", "fix: "+p.Fix)
+					}
+				}
+			}
+
+			failed := 0
+			for _, p := range problems {
+				if !p.Warning {
+					failed++
+				}
+			}
+			if failed > 0 {
+				return fmt.Errorf("found %d problem(s)", failed)
+			}
+
+			cmd.Log.Info("No problems found")
+
+			return nil
+		},
+	}
+)
+
+func init() {
+	validateCmd.Flags().BoolVar(&runtime, "runtime", false, "also check the database, listen ports, certificates and boot image files")
+	validateCmd.Flags().StringSliceVar(&services, "services", []string{}, "services to check, overriding <service>.enabled")
+	cmd.Root.AddCommand(validateCmd)
+}
@@ -0,0 +1,227 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+// Package accesslog writes one structured line per request of the HTTP
+// services. Failed and slow requests are always logged, successful requests
+// are sampled
+package accesslog
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/viper"
+	"github.com/ubccr/grendel/internal/logger"
+)
+
+// Reasons a request is logged
+const (
+	ReasonError   = "error"
+	ReasonSlow    = "slow"
+	ReasonSampled = "sampled"
+)
+
+// Redacted replaces tokens in logged paths
+const Redacted = "REDACTED"
+
+// Defaults of the access log settings
+const (
+	DefaultSampleRate = 100
+	DefaultSlow       = 2 * time.Second
+)
+
+var (
+	linesTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "grendel_access_log_lines_total",
+		Help: "HTTP requests written to the access log by service and reason: error, slow or sampled",
+	}, []string{"service", "reason"})
+	skippedTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "grendel_access_log_skipped_total",
+		Help: "Successful HTTP requests left out of the access log by sampling, by service",
+	}, []string{"service"})
+	slowTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "grendel_slow_requests_total",
+		Help: "HTTP requests taking longer than access_log_slow by service",
+	}, []string{"service"})
+)
+
+func init() {
+	prometheus.MustRegister(linesTotal, skippedTotal, slowTotal)
+}
+
+// Config is the access log of a service
+type Config struct {
+	Enabled bool
+
+	// SampleRate logs 1 in SampleRate successful requests. 1 logs every
+	// request and 0 none
+	SampleRate int
+
+	// Slow requests are always logged. Zero disables it
+	Slow time.Duration
+}
+
+// FromConfig returns the access log settings of section of the
+// configuration. The access log is enabled by default, logging 1 in
+// DefaultSampleRate successful requests and requests slower than DefaultSlow
+func FromConfig(v *viper.Viper, section string) (Config, error) {
+	c := Config{
+		Enabled:    true,
+		SampleRate: DefaultSampleRate,
+		Slow:       DefaultSlow,
+	}
+	if v.IsSet(section + ".access_log") {
+		c.Enabled = v.GetBool(section + ".access_log")
+	}
+	if v.IsSet(section + ".access_log_sample_rate") {
+		c.SampleRate = v.GetInt(section + ".access_log_sample_rate")
+	}
+	if v.IsSet(section + ".access_log_slow") {
+		c.Slow = v.GetDuration(section + ".access_log_slow")
+	}
+
+	if c.SampleRate < 0 || c.Slow < 0 {
+		return c, fmt.Errorf("invalid %s access log settings: values must not be negative", section)
+	}
+
+	return c, nil
+}
+
+// Entry is a request written to the access log
+type Entry struct {
+	Method   string
+	Path     string
+	Status   int
+	Bytes    int64
+	Duration time.Duration
+	IP       string
+
+	// Host and MAC are the host and interface a boot token maps to
+	Host string
+	MAC  string
+}
+
+// Logger writes the access log of a service. A nil Logger logs nothing
+type Logger struct {
+	service string
+	log     *logrus.Entry
+	mu      sync.RWMutex
+	config  Config
+	count   atomic.Uint64
+}
+
+// New returns the access log of service, written with the logger of the
+// service
+func New(service string, c Config) *Logger {
+	return &Logger{
+		service: service,
+		log:     logger.GetLogger(strings.ToUpper(service)),
+		config:  c,
+	}
+}
+
+// Update replaces the settings
+func (l *Logger) Update(c Config) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.config = c
+}
+
+// Log writes e when the request failed, was slow or is sampled
+func (l *Logger) Log(e *Entry) {
+	if l == nil {
+		return
+	}
+
+	l.mu.RLock()
+	c := l.config
+	l.mu.RUnlock()
+
+	slow := c.Slow > 0 && e.Duration >= c.Slow
+	if slow {
+		slowTotal.WithLabelValues(l.service).Inc()
+	}
+
+	if !c.Enabled {
+		return
+	}
+
+	var reason string
+	switch {
+	case e.Status >= http.StatusBadRequest:
+		reason = ReasonError
+	case slow:
+		reason = ReasonSlow
+	case c.SampleRate > 0 && l.count.Add(1)%uint64(c.SampleRate) == 0:
+		reason = ReasonSampled
+	default:
+		skippedTotal.WithLabelValues(l.service).Inc()
+		return
+	}
+	linesTotal.WithLabelValues(l.service, reason).Inc()
+
+	fields := logrus.Fields{
+		"method":       e.Method,
+		"path":         e.Path,
+		"status":       e.Status,
+		"bytes":        e.Bytes,
+		"duration_ms":  float64(e.Duration.Microseconds()) / 1000,
+		"reason":       reason,
+		logger.FieldIP: e.IP,
+	}
+	if e.Host != "" {
+		fields[logger.FieldHost] = e.Host
+	}
+	if e.MAC != "" {
+		fields[logger.FieldMAC] = e.MAC
+	}
+
+	entry := l.log.WithFields(fields)
+	switch {
+	case e.Status >= http.StatusInternalServerError:
+		entry.Error("request")
+	case e.Status >= http.StatusBadRequest:
+		entry.Warn("request")
+	default:
+		entry.Info("request")
+	}
+}
+
+// RedactPath replaces the path segment following any of prefixes, such as the
+// token of /boot/<token>/ipxe, with Redacted
+func RedactPath(path string, prefixes ...string) string {
+	for _, prefix := range prefixes {
+		rest, ok := strings.CutPrefix(path, prefix)
+		if !ok || rest == "" {
+			continue
+		}
+
+		_, after, found := strings.Cut(rest, "/")
+		if !found {
+			return prefix + Redacted
+		}
+		return prefix + Redacted + "/" + after
+	}
+
+	return path
+}
+
+// ClientIP returns the IP address of a remote address, the address itself
+// when it has no port such as for unix sockets
+func ClientIP(remoteAddr string) string {
+	host, _, err := net.SplitHostPort(remoteAddr)
+	if err != nil {
+		return remoteAddr
+	}
+
+	return host
+}
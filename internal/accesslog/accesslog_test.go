@@ -0,0 +1,113 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package accesslog
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/sirupsen/logrus"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFromConfig(t *testing.T) {
+	c, err := FromConfig(viper.New(), "provision")
+	require.NoError(t, err)
+	assert.Equal(t, Config{Enabled: true, SampleRate: DefaultSampleRate, Slow: DefaultSlow}, c)
+
+	v := viper.New()
+	v.SetConfigType("toml")
+	require.NoError(t, v.ReadConfig(strings.NewReader(`
+[api]
+access_log = false
+access_log_sample_rate = 1
+access_log_slow = "500ms"
+`)))
+
+	c, err = FromConfig(v, "api")
+	require.NoError(t, err)
+	assert.Equal(t, Config{SampleRate: 1, Slow: 500 * time.Millisecond}, c)
+
+	v.Set("api.access_log_sample_rate", -1)
+	_, err = FromConfig(v, "api")
+	assert.ErrorContains(t, err, "invalid api access log settings")
+}
+
+func newTestLogger(c Config) (*Logger, *test.Hook) {
+	l := New("test", c)
+	nl, hook := test.NewNullLogger()
+	nl.SetLevel(logrus.DebugLevel)
+	l.log = logrus.NewEntry(nl)
+
+	return l, hook
+}
+
+func TestLog(t *testing.T) {
+	l, hook := newTestLogger(Config{Enabled: true, SampleRate: 10, Slow: time.Second})
+
+	skipped := testutil.ToFloat64(skippedTotal.WithLabelValues("test"))
+	for i := 0; i < 20; i++ {
+		l.Log(&Entry{Method: http.MethodGet, Path: "/", Status: http.StatusOK, Duration: time.Millisecond})
+	}
+	require.Len(t, hook.AllEntries(), 2)
+	assert.Equal(t, ReasonSampled, hook.LastEntry().Data["reason"])
+	assert.Equal(t, skipped+18, testutil.ToFloat64(skippedTotal.WithLabelValues("test")))
+
+	hook.Reset()
+	l.Log(&Entry{Method: http.MethodGet, Path: "/boot/REDACTED/ipxe", Status: http.StatusNotFound, Host: "cpn-01", MAC: "aa:bb:cc:dd:ee:ff"})
+	require.Len(t, hook.AllEntries(), 1)
+	assert.Equal(t, logrus.WarnLevel, hook.LastEntry().Level)
+	assert.Equal(t, ReasonError, hook.LastEntry().Data["reason"])
+	assert.Equal(t, "cpn-01", hook.LastEntry().Data["host"])
+	assert.Equal(t, "aa:bb:cc:dd:ee:ff", hook.LastEntry().Data["mac"])
+
+	l.Log(&Entry{Method: http.MethodGet, Path: "/", Status: http.StatusInternalServerError})
+	assert.Equal(t, logrus.ErrorLevel, hook.LastEntry().Level)
+
+	slow := testutil.ToFloat64(slowTotal.WithLabelValues("test"))
+	hook.Reset()
+	l.Log(&Entry{Method: http.MethodGet, Path: "/", Status: http.StatusOK, Duration: 2 * time.Second})
+	require.Len(t, hook.AllEntries(), 1)
+	assert.Equal(t, ReasonSlow, hook.LastEntry().Data["reason"])
+	assert.Equal(t, slow+1, testutil.ToFloat64(slowTotal.WithLabelValues("test")))
+
+	l.Update(Config{Enabled: false, Slow: time.Second})
+	hook.Reset()
+	l.Log(&Entry{Method: http.MethodGet, Path: "/", Status: http.StatusInternalServerError, Duration: 2 * time.Second})
+	assert.Empty(t, hook.AllEntries())
+	assert.Equal(t, slow+2, testutil.ToFloat64(slowTotal.WithLabelValues("test")))
+
+	var nl *Logger
+	nl.Log(&Entry{Status: http.StatusOK})
+}
+
+func TestRedactPath(t *testing.T) {
+	tests := []struct {
+		path string
+		want string
+	}{
+		{"/boot/abc.def.ghi/ipxe", "/boot/REDACTED/ipxe"},
+		{"/boot/abc.def.ghi", "/boot/REDACTED"},
+		{"/boot/", "/boot/"},
+		{"/repo/boot/abc", "/repo/boot/abc"},
+		{"/v1/bmc/events/receive/secret", "/v1/bmc/events/receive/REDACTED"},
+	}
+
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, RedactPath(tt.path, "/boot/", "/v1/bmc/events/receive/"), tt.path)
+	}
+}
+
+func TestClientIP(t *testing.T) {
+	assert.Equal(t, "10.0.0.1", ClientIP("10.0.0.1:4312"))
+	assert.Equal(t, "2001:db8::1", ClientIP("[2001:db8::1]:4312"))
+	assert.Equal(t, "@", ClientIP("@"))
+}
@@ -0,0 +1,221 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package api
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/go-fuego/fuego"
+	"github.com/ubccr/grendel/internal/artifact"
+	"github.com/ubccr/grendel/pkg/model"
+	"github.com/ubccr/grendel/pkg/nodeset"
+)
+
+// RevisionHeader is the change journal sequence number an artifact was
+// rendered at, passed back as since_rev to get the following changes
+const RevisionHeader = "X-Grendel-Revision"
+
+type ArtifactResponse struct {
+	Name        string   `json:"name"`
+	Format      string   `json:"format" description:"export format: hosts, genders or known-hosts"`
+	Nodeset     string   `json:"nodeset,omitempty"`
+	Tags        []string `json:"tags,omitempty"`
+	ExcludeTags []string `json:"exclude_tags,omitempty"`
+	Delta       bool     `json:"delta" description:"whether the artifact is served as the changes since a revision with since_rev"`
+}
+
+// ArtifactList returns the artifacts of api.artifacts and the builtin ones
+func (h *Handler) ArtifactList(c fuego.ContextNoBody) ([]ArtifactResponse, error) {
+	list := make([]ArtifactResponse, 0)
+	for _, a := range h.Artifacts.List() {
+		list = append(list, ArtifactResponse{
+			Name:        a.Name,
+			Format:      a.Format,
+			Nodeset:     a.Nodeset,
+			Tags:        a.Tags,
+			ExcludeTags: a.ExcludeTags,
+			Delta:       a.Delta(),
+		})
+	}
+
+	return list, nil
+}
+
+// ArtifactGet writes an artifact as text. The ETag is the namespace and latest
+// change journal sequence number, read before the hosts so a change made while
+// rendering is never hidden behind an older tag, and If-None-Match is answered
+// with 304 without loading the hosts. With since_rev only the blocks of the
+// hosts changed since the revision are written
+func (h *Handler) ArtifactGet(w http.ResponseWriter, r *http.Request) {
+	name := r.PathValue("name")
+	a, ok := h.Artifacts.Get(name)
+	if !ok {
+		ErrorSerializer(w, r, fuego.HTTPError{
+			Err:    fmt.Errorf("artifact %s not found", name),
+			Status: http.StatusNotFound,
+			Title:  "Error",
+			Detail: fmt.Sprintf("artifact %s is not defined in api.artifacts", name),
+		})
+		return
+	}
+
+	if since := r.URL.Query().Get("since_rev"); since != "" {
+		h.artifactDelta(w, r, a, since)
+		return
+	}
+
+	db := h.db(r.Context())
+	rev, err := db.LatestChangeSeq()
+	if err != nil {
+		ErrorSerializer(w, r, fuego.HTTPError{
+			Err:    err,
+			Status: http.StatusInternalServerError,
+			Title:  "Error",
+			Detail: "failed to get the change journal revision",
+		})
+		return
+	}
+
+	etag := a.ETag(namespaceOf(r.Context()), rev)
+	w.Header().Set("ETag", etag)
+	w.Header().Set(RevisionHeader, strconv.FormatInt(rev, 10))
+	if etagMatch(r.Header.Get("If-None-Match"), etag) {
+		w.WriteHeader(http.StatusNotModified)
+		return
+	}
+
+	hosts, err := db.Hosts()
+	if err != nil {
+		ErrorSerializer(w, r, fuego.HTTPError{
+			Err:    err,
+			Status: http.StatusInternalServerError,
+			Title:  "Error",
+			Detail: "failed to find nodes",
+		})
+		return
+	}
+
+	writeArtifact(w, r, func(b *bytes.Buffer) error { return a.Write(b, hosts) })
+}
+
+// artifactDelta writes the blocks of the hosts changed after revision since.
+// 304 is returned when nothing changed and 410 when the changes were purged
+// from the journal, the agent then fetches the whole artifact
+func (h *Handler) artifactDelta(w http.ResponseWriter, r *http.Request, a *artifact.Artifact, since string) {
+	rev, err := strconv.ParseInt(since, 10, 64)
+	if err != nil || rev < 0 || !a.Delta() {
+		detail := "since_rev must be a revision returned in " + RevisionHeader
+		if !a.Delta() {
+			detail = fmt.Sprintf("artifact %s of format %s has no delta form", a.Name, a.Format)
+		}
+		ErrorSerializer(w, r, fuego.HTTPError{
+			Err:    errors.New("invalid since_rev"),
+			Status: http.StatusBadRequest,
+			Title:  "Error",
+			Detail: detail,
+		})
+		return
+	}
+
+	db := h.db(r.Context())
+	changed := make([]string, 0)
+	latest := rev
+	for {
+		feed, err := db.Changes(latest, changesLimit)
+		if err != nil {
+			ErrorSerializer(w, r, fuego.HTTPError{
+				Err:    err,
+				Status: http.StatusInternalServerError,
+				Title:  "Error",
+				Detail: "failed to get changes",
+			})
+			return
+		}
+		if feed.Truncated {
+			ErrorSerializer(w, r, fuego.HTTPError{
+				Err:    fmt.Errorf("revision %d purged from the change journal", rev),
+				Status: http.StatusGone,
+				Title:  "Gone",
+				Detail: fmt.Sprintf("changes following revision %d were purged, fetch the whole artifact", rev),
+			})
+			return
+		}
+
+		for _, c := range feed.Changes {
+			latest = c.Seq
+			if c.Kind != model.ChangeKindHost {
+				continue
+			}
+			changed = append(changed, c.Name)
+			// A renamed host leaves the block of its previous name
+			if d, ok := c.Diff["name"]; ok && d.Old != "" {
+				changed = append(changed, d.Old)
+			}
+		}
+		if len(feed.Changes) < changesLimit {
+			latest = max(latest, feed.LatestSeq)
+			break
+		}
+	}
+
+	w.Header().Set(RevisionHeader, strconv.FormatInt(latest, 10))
+	if len(changed) == 0 {
+		w.WriteHeader(http.StatusNotModified)
+		return
+	}
+
+	var hosts model.HostList
+	ns, err := nodeset.NewNodeSet(strings.Join(changed, ","))
+	if err == nil {
+		hosts, err = db.FindHosts(ns)
+	}
+	if err != nil {
+		ErrorSerializer(w, r, fuego.HTTPError{
+			Err:    err,
+			Status: http.StatusInternalServerError,
+			Title:  "Error",
+			Detail: "failed to find changed nodes",
+		})
+		return
+	}
+
+	writeArtifact(w, r, func(b *bytes.Buffer) error { return a.WriteDelta(b, changed, hosts) })
+}
+
+// writeArtifact renders an artifact in a buffer so an error is returned
+// instead of a truncated file
+func writeArtifact(w http.ResponseWriter, r *http.Request, render func(*bytes.Buffer) error) {
+	var b bytes.Buffer
+	if err := render(&b); err != nil {
+		ErrorSerializer(w, r, fuego.HTTPError{
+			Err:    err,
+			Status: http.StatusInternalServerError,
+			Title:  "Error",
+			Detail: "failed to render artifact",
+		})
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+	w.Header().Set("Content-Length", strconv.Itoa(b.Len()))
+	w.Write(b.Bytes())
+}
+
+// etagMatch returns whether the If-None-Match header lists etag or is *
+func etagMatch(header, etag string) bool {
+	for _, t := range strings.Split(header, ",") {
+		t = strings.TrimSpace(t)
+		if t == "*" || strings.TrimPrefix(t, "W/") == etag {
+			return true
+		}
+	}
+
+	return false
+}
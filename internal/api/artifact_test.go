@@ -0,0 +1,87 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package api
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/netip"
+	"testing"
+
+	"github.com/go-fuego/fuego"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/ubccr/grendel/internal/store/sqlstore"
+	"github.com/ubccr/grendel/pkg/model"
+)
+
+func TestArtifactGet(t *testing.T) {
+	viper.Set("api.socket_path", "/tmp/grendel-test.sock")
+	defer viper.Set("api.socket_path", nil)
+
+	db, err := sqlstore.New(":memory:")
+	require.NoError(t, err)
+	defer db.Close()
+
+	h, err := NewHandler(db)
+	require.NoError(t, err)
+	s := &Server{}
+	fs := s.newFuegoServer(fuego.WithAddr("127.0.0.1:0"))
+	h.SetupRoutes(fs)
+
+	get := func(target string, header ...string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, target, nil)
+		for i := 0; i+1 < len(header); i += 2 {
+			req.Header.Set(header[i], header[i+1])
+		}
+		rec := httptest.NewRecorder()
+		fs.Mux.ServeHTTP(rec, req)
+		return rec
+	}
+
+	host := func(name, ip string) *model.Host {
+		return &model.Host{Name: name, Interfaces: []*model.NetInterface{{Name: "eno1", IP: netip.MustParsePrefix(ip), FQDN: name + ".example.com"}}}
+	}
+	require.NoError(t, db.StoreHosts(model.HostList{host("cpn-01", "10.0.0.1/24"), host("cpn-02", "10.0.0.2/24")}))
+
+	rec := get("/v1/artifacts/hosts")
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	assert.Equal(t, "# host cpn-01\n10.0.0.1 cpn-01.example.com cpn-01\n# host cpn-02\n10.0.0.2 cpn-02.example.com cpn-02\n", rec.Body.String())
+	etag := rec.Header().Get("ETag")
+	rev := rec.Header().Get(RevisionHeader)
+	assert.NotEmpty(t, etag)
+
+	// Unchanged, nothing is sent
+	rec = get("/v1/artifacts/hosts", "If-None-Match", etag)
+	assert.Equal(t, http.StatusNotModified, rec.Code)
+	assert.Empty(t, rec.Body.String())
+	rec = get("/v1/artifacts/hosts?since_rev=" + rev)
+	assert.Equal(t, http.StatusNotModified, rec.Code)
+
+	updated, err := db.LoadHostFromName("cpn-02")
+	require.NoError(t, err)
+	updated.Interfaces[0].IP = netip.MustParsePrefix("10.0.0.12/24")
+	require.NoError(t, db.StoreHost(updated))
+	require.NoError(t, db.StoreHost(host("cpn-03", "10.0.0.3/24")))
+
+	rec = get("/v1/artifacts/hosts", "If-None-Match", etag)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.NotEqual(t, etag, rec.Header().Get("ETag"))
+
+	rec = get("/v1/artifacts/hosts?since_rev=" + rev)
+	assert.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	assert.Equal(t, "# host cpn-02\n10.0.0.12 cpn-02.example.com cpn-02\n# host cpn-03\n10.0.0.3 cpn-03.example.com cpn-03\n", rec.Body.String())
+	assert.NotEqual(t, rev, rec.Header().Get(RevisionHeader))
+
+	rec = get("/v1/artifacts/genders?since_rev=" + rev)
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+	rec = get("/v1/artifacts/missing")
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+
+	rec = get("/v1/artifacts")
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.JSONEq(t, `[{"name": "hosts", "format": "hosts", "delta": true}, {"name": "genders", "format": "genders", "delta": false}]`, rec.Body.String())
+}
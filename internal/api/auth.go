@@ -5,15 +5,17 @@
 package api
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"net/http"
+	"slices"
 	"strings"
 	"time"
 
 	"github.com/go-fuego/fuego"
 	"github.com/golang-jwt/jwt/v5"
-	"github.com/spf13/viper"
+	"github.com/ubccr/grendel/internal/config"
 	"github.com/ubccr/grendel/pkg/model"
 )
 
@@ -38,6 +40,8 @@ type AuthTokenRequest struct {
 	Username string `json:"username" description:"username shown in logs, does not need to be a valid user in the DB" example:"user1:CLI"`
 	Role     string `json:"role" description:"type of model.Role, valid options: disabled, user, admin" example:"admin"`
 	Expire   string `json:"expire" description:"string parsed by time.ParseDuration, examples include: infinite, 8h, 30m, 20s" example:"infinite"`
+
+	Namespaces []string `json:"namespaces,omitempty" description:"namespaces the token is scoped to, every namespace when empty. Tokens scoped to namespaces only create tokens scoped to some of them" example:"cluster-a"`
 }
 
 type AuthTokenReponse struct {
@@ -63,7 +67,7 @@ func (h *Handler) AuthSignin(c fuego.ContextWithBody[AuthRequest]) (*AuthRespons
 		}
 	}
 
-	authenticated, role, err := h.DB.VerifyUser(body.Username, body.Password)
+	authenticated, role, err := h.db(c.Context()).VerifyUser(body.Username, body.Password)
 	if err != nil {
 		return nil, fuego.HTTPError{
 			Err:    err,
@@ -87,7 +91,7 @@ func (h *Handler) AuthSignin(c fuego.ContextWithBody[AuthRequest]) (*AuthRespons
 		TokenExpire:   exp.Unix(),
 	}
 
-	token, err := NewToken(claims, viper.GetString("api.secret"))
+	token, err := NewToken(claims, model.PrimarySigningKey(model.SigningKeyAPI))
 	if err != nil {
 		return nil, fuego.HTTPError{
 			Err:    err,
@@ -123,7 +127,7 @@ func (h *Handler) AuthSignup(c fuego.ContextWithBody[AuthSignupRequest]) (*AuthR
 		}
 	}
 
-	role, err := h.DB.StoreUser(body.Username, body.Password)
+	role, err := h.db(c.Context()).StoreUser(body.Username, body.Password)
 	if err != nil {
 		return nil, fuego.HTTPError{
 			Err:    err,
@@ -140,7 +144,7 @@ func (h *Handler) AuthSignup(c fuego.ContextWithBody[AuthSignupRequest]) (*AuthR
 		TokenExpire:   exp.Unix(),
 	}
 
-	token, err := NewToken(claims, viper.GetString("api.secret"))
+	token, err := NewToken(claims, model.PrimarySigningKey(model.SigningKeyAPI))
 	if err != nil {
 		return nil, fuego.HTTPError{
 			Err:    err,
@@ -200,8 +204,8 @@ func (h *Handler) AuthToken(c fuego.ContextWithBody[AuthTokenRequest]) (*AuthTok
 	}
 
 	// skip access control if running on a unix socket
-	if !viper.IsSet("api.socket_path") {
-		tokenRole, err := h.DB.GetRolesByName(body.Role)
+	if !config.Viper().IsSet("api.socket_path") {
+		tokenRole, err := h.db(c.Context()).GetRolesByName(body.Role)
 		if err != nil {
 			return nil, fuego.HTTPError{
 				Err:    err,
@@ -226,7 +230,7 @@ func (h *Handler) AuthToken(c fuego.ContextWithBody[AuthTokenRequest]) (*AuthTok
 			}
 		}
 
-		requestUser, err := h.DB.GetUserByName(body.Username)
+		requestUser, err := h.db(c.Context()).GetUserByName(body.Username)
 		if err != nil {
 			return nil, fuego.HTTPError{
 				Err:    err,
@@ -234,7 +238,7 @@ func (h *Handler) AuthToken(c fuego.ContextWithBody[AuthTokenRequest]) (*AuthTok
 				Detail: "failed to get token username",
 			}
 		}
-		requestedRole, err := h.DB.GetRolesByName(requestUser.Role)
+		requestedRole, err := h.db(c.Context()).GetRolesByName(requestUser.Role)
 		if err != nil {
 			return nil, fuego.HTTPError{
 				Err:    err,
@@ -273,6 +277,13 @@ func (h *Handler) AuthToken(c fuego.ContextWithBody[AuthTokenRequest]) (*AuthTok
 		}
 	}
 
+	if err := h.checkTokenScope(c.Context(), body.Namespaces); err != nil {
+		return nil, err
+	}
+	if len(body.Namespaces) > 0 {
+		claims[TokenNamespaces] = body.Namespaces
+	}
+
 	if body.Expire != "infinite" {
 		exp, err := time.ParseDuration(body.Expire)
 		if err != nil {
@@ -285,7 +296,7 @@ func (h *Handler) AuthToken(c fuego.ContextWithBody[AuthTokenRequest]) (*AuthTok
 		claims[TokenExpire] = time.Now().Add(exp).Unix()
 	}
 
-	token, err := NewToken(claims, viper.GetString("api.secret"))
+	token, err := NewToken(claims, model.PrimarySigningKey(model.SigningKeyAPI))
 	if err != nil {
 		return nil, fuego.HTTPError{
 			Err:    err,
@@ -299,6 +310,46 @@ func (h *Handler) AuthToken(c fuego.ContextWithBody[AuthTokenRequest]) (*AuthTok
 	}, nil
 }
 
+// checkTokenScope returns an error when a namespace of a new token is not
+// defined, or is outside of the scope of the token of the request of ctx
+func (h *Handler) checkTokenScope(ctx context.Context, namespaces []string) error {
+	for _, name := range namespaces {
+		if _, ok := h.namespaceStore(name); !ok {
+			return fuego.HTTPError{
+				Err:    fmt.Errorf("namespace %s not found", name),
+				Status: http.StatusBadRequest,
+				Title:  "Error",
+				Detail: fmt.Sprintf("namespace %s is not defined in namespaces", name),
+			}
+		}
+	}
+
+	scope, scoped := ctx.Value(ContextKeyScope).([]string)
+	if !scoped {
+		return nil
+	}
+	if len(namespaces) == 0 {
+		return fuego.HTTPError{
+			Err:    errors.New("token scoped to namespaces creating an unscoped token"),
+			Status: http.StatusForbidden,
+			Title:  "Error",
+			Detail: fmt.Sprintf("Failed to create token, tokens scoped to namespaces %s only create tokens scoped to some of them", strings.Join(scope, ", ")),
+		}
+	}
+	for _, name := range namespaces {
+		if !slices.Contains(scope, name) {
+			return fuego.HTTPError{
+				Err:    fmt.Errorf("token scoped to namespaces %s creating a token for namespace %s", strings.Join(scope, ","), name),
+				Status: http.StatusForbidden,
+				Title:  "Error",
+				Detail: fmt.Sprintf("Failed to create token, the token is not scoped to namespace %s", name),
+			}
+		}
+	}
+
+	return nil
+}
+
 func (h *Handler) AuthReset(c fuego.ContextWithBody[AuthResetRequest]) (*GenericResponse, error) {
 	body, err := c.Body()
 	if err != nil {
@@ -314,7 +365,7 @@ func (h *Handler) AuthReset(c fuego.ContextWithBody[AuthResetRequest]) (*Generic
 		return nil, errors.New("failed to parse username from context")
 	}
 
-	authenticated, _, err := h.DB.VerifyUser(username, body.CurrentPassword)
+	authenticated, _, err := h.db(c.Context()).VerifyUser(username, body.CurrentPassword)
 	if err != nil {
 		return nil, fuego.HTTPError{
 			Err:    err,
@@ -331,7 +382,7 @@ func (h *Handler) AuthReset(c fuego.ContextWithBody[AuthResetRequest]) (*Generic
 		}
 	}
 
-	_, err = h.DB.StoreUser(username, body.NewPassword)
+	_, err = h.db(c.Context()).StoreUser(username, body.NewPassword)
 	if err != nil {
 		return nil, fuego.HTTPError{
 			Err:    err,
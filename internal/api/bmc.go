@@ -5,15 +5,26 @@
 package api
 
 import (
+	"context"
+	"crypto/sha256"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
 	"slices"
 	"strings"
+	"time"
 
 	"github.com/go-fuego/fuego"
 	"github.com/stmcginnis/gofish/oem/dell"
 	"github.com/stmcginnis/gofish/schemas"
 	"github.com/ubccr/grendel/internal/bmc"
+	"github.com/ubccr/grendel/internal/config"
+	"github.com/ubccr/grendel/internal/secret"
+	"github.com/ubccr/grendel/internal/webhook"
 	"github.com/ubccr/grendel/pkg/model"
 	"github.com/ubccr/grendel/pkg/nodeset"
 )
@@ -21,6 +32,39 @@ import (
 type BmcOsPowerBody struct {
 	BootOption  schemas.BootSource `json:"boot_option" description:"string of type schemas.BootSourceOverrideTarget. Common options include: None, Pxe, BiosSetup, Utilities, Diags" example:"Pxe"`
 	PowerOption schemas.ResetType  `json:"power_option" description:"string of type schemas.ResetType. Common options include: On, ForceOn, ForceOff, ForceRestart, GracefulRestart, GracefulShutdown, PowerCycle" example:"PowerCycle"`
+	Fanout      int                `json:"fanout,omitempty" description:"number of BMCs contacted at once, defaults to bmc.fanout" example:"20"`
+	Timeout     int                `json:"timeout,omitempty" description:"seconds allowed for each BMC, defaults to bmc.timeout" example:"60"`
+}
+type BmcBootOverrideBody struct {
+	Target     schemas.BootSource `json:"target" description:"string of type schemas.BootSource. Common options include: Pxe, Hdd, BiosSetup" example:"Pxe"`
+	Persistent bool               `json:"persistent" description:"boot from target on every boot instead of only the next boot"`
+	Fanout     int                `json:"fanout,omitempty" description:"number of BMCs contacted at once, defaults to bmc.fanout" example:"20"`
+	Timeout    int                `json:"timeout,omitempty" description:"seconds allowed for each BMC, defaults to bmc.timeout" example:"60"`
+}
+type BmcVirtualMediaBody struct {
+	Image   string `json:"image" description:"URI of the ISO image to insert" example:"http://grendel:8080/repo/installer.iso"`
+	Boot    bool   `json:"boot" description:"boot from the virtual CD on the next boot"`
+	Reboot  bool   `json:"reboot" description:"power cycle the node once the image is inserted"`
+	Fanout  int    `json:"fanout,omitempty" description:"number of BMCs contacted at once, defaults to bmc.fanout" example:"20"`
+	Timeout int    `json:"timeout,omitempty" description:"seconds allowed for each BMC, defaults to bmc.timeout" example:"60"`
+}
+type BmcBiosApplyBody struct {
+	Profile string `json:"profile" description:"name of the BIOS profile in bmc.bios_profiles" example:"compute"`
+	Reboot  bool   `json:"reboot" description:"power cycle the nodes requiring a reboot for the settings to take effect"`
+	Fanout  int    `json:"fanout,omitempty" description:"number of BMCs contacted at once, defaults to bmc.fanout" example:"20"`
+	Timeout int    `json:"timeout,omitempty" description:"seconds allowed for each BMC, defaults to bmc.timeout" example:"60"`
+}
+type BmcFirmwareUpdateBody struct {
+	Image     string `json:"image" description:"URL of the firmware image fetched by the BMCs, or the name of an image uploaded to POST /v1/bmc/fwupdate/image" example:"http://grendel:8080/repo/iDRAC-with-Lifecycle-Controller_Firmware_7.10.50.00.EXE"`
+	Component string `json:"component" description:"inventory field of the firmware verified after the update: bmc, bios or nic. Defaults to bmc" example:"bmc"`
+	Version   string `json:"version" description:"firmware version the nodes are updated to. Nodes already on it are skipped" example:"7.10.50.00"`
+	Fanout    int    `json:"fanout,omitempty" description:"number of BMCs updated at once, defaults to bmc.fanout" example:"20"`
+	Timeout   int    `json:"timeout,omitempty" description:"seconds allowed to update each BMC, defaults to bmc.fwupdate_timeout" example:"1800"`
+}
+type BmcRotatePasswordBody struct {
+	Length  int `json:"length,omitempty" description:"length of the generated passwords, defaults to 20" example:"20"`
+	Fanout  int `json:"fanout,omitempty" description:"number of BMCs contacted at once, defaults to bmc.fanout" example:"20"`
+	Timeout int `json:"timeout,omitempty" description:"seconds allowed for each BMC login, defaults to bmc.timeout" example:"60"`
 }
 type BmcImportConfigurationRequest struct {
 	ShutdownType string `json:"shutdown_type" description:"options include: NoReboot, Graceful, Forced" example:"Graceful"`
@@ -28,7 +72,7 @@ type BmcImportConfigurationRequest struct {
 }
 
 func (h *Handler) BmcOsPower(c fuego.ContextWithBody[BmcOsPowerBody]) (model.JobMessageList, error) {
-	ns, err := h.filterByNodesetAndTags(c.QueryParam("nodeset"), c.QueryParam("tags"))
+	ns, err := h.filterByNodesetAndTags(c.Context(), c.QueryParam("nodeset"), c.QueryParam("tags"))
 	if err != nil {
 		return nil, fuego.HTTPError{
 			Err:    err,
@@ -46,7 +90,7 @@ func (h *Handler) BmcOsPower(c fuego.ContextWithBody[BmcOsPowerBody]) (model.Job
 		}
 	}
 
-	hostList, err := h.DB.FindHosts(ns)
+	hostList, err := h.namespaceDB(c.Context()).FindHosts(ns)
 	if err != nil {
 		return nil, fuego.HTTPError{
 			Err:    err,
@@ -55,7 +99,9 @@ func (h *Handler) BmcOsPower(c fuego.ContextWithBody[BmcOsPowerBody]) (model.Job
 		}
 	}
 
-	job := bmc.NewJob()
+	job := bmc.NewJob(h.namespaceDB(c.Context()))
+	job.SetFanout(body.Fanout)
+	job.SetTimeout(time.Duration(body.Timeout) * time.Second)
 
 	output, err := job.PowerControl(hostList, body.BootOption, body.PowerOption)
 	if err != nil {
@@ -66,12 +112,712 @@ func (h *Handler) BmcOsPower(c fuego.ContextWithBody[BmcOsPowerBody]) (model.Job
 		}
 	}
 
-	h.writeEvent(c.Context(), "Success", "Successfully sent OS power command to node(s)", output...)
+	h.writeHostEvent(c.Context(), webhook.BMCPower, ns.Iterator().StringSlice(), "Success", "Successfully sent OS power command to node(s)", output...)
+	return output, nil
+}
+
+func (h *Handler) BmcPowerStatus(c fuego.ContextNoBody) (model.JobMessageList, error) {
+	ns, err := h.filterByNodesetAndTags(c.Context(), c.QueryParam("nodeset"), c.QueryParam("tags"))
+	if err != nil {
+		return nil, fuego.HTTPError{
+			Err:    err,
+			Title:  "Error",
+			Detail: "failed to filter nodes",
+		}
+	}
+
+	hostList, err := h.namespaceDB(c.Context()).FindHosts(ns)
+	if err != nil {
+		return nil, fuego.HTTPError{
+			Err:    err,
+			Title:  "Error",
+			Detail: "failed to find nodes",
+		}
+	}
+
+	job := bmc.NewJob(h.namespaceDB(c.Context()))
+	job.SetFanout(c.QueryParamInt("fanout"))
+	job.SetTimeout(time.Duration(c.QueryParamInt("timeout")) * time.Second)
+
+	output, err := job.PowerStatus(hostList)
+	if err != nil {
+		return nil, fuego.HTTPError{
+			Err:    err,
+			Title:  "Error",
+			Detail: "failed to query power status",
+		}
+	}
+
+	return output, nil
+}
+
+func (h *Handler) BmcBootOverride(c fuego.ContextWithBody[BmcBootOverrideBody]) (model.JobMessageList, error) {
+	ns, err := h.filterByNodesetAndTags(c.Context(), c.QueryParam("nodeset"), c.QueryParam("tags"))
+	if err != nil {
+		return nil, fuego.HTTPError{
+			Err:    err,
+			Title:  "Error",
+			Detail: "failed to filter nodes",
+		}
+	}
+
+	body, err := c.Body()
+	if err != nil {
+		return nil, fuego.HTTPError{
+			Err:    err,
+			Title:  "Error",
+			Detail: "failed to parse boot override body",
+		}
+	}
+	if body.Target == "" || body.Target == schemas.NoneBootSource {
+		return nil, fuego.HTTPError{
+			Err:    errors.New("missing boot override target"),
+			Status: http.StatusBadRequest,
+			Title:  "Error",
+			Detail: "target is required",
+		}
+	}
+
+	hostList, err := h.namespaceDB(c.Context()).FindHosts(ns)
+	if err != nil {
+		return nil, fuego.HTTPError{
+			Err:    err,
+			Title:  "Error",
+			Detail: "failed to find nodes",
+		}
+	}
+
+	job := bmc.NewJob(h.namespaceDB(c.Context()))
+	job.SetFanout(body.Fanout)
+	job.SetTimeout(time.Duration(body.Timeout) * time.Second)
+
+	output, err := job.BootOverride(hostList, body.Target, body.Persistent)
+	if err != nil {
+		return nil, fuego.HTTPError{
+			Err:    err,
+			Title:  "Error",
+			Detail: "failed to submit redfish job",
+		}
+	}
+
+	h.writeEvent(c.Context(), "Success", fmt.Sprintf("Successfully set boot override to %s on node(s)", body.Target), output...)
+	return output, nil
+}
+
+// BmcHardware collects the hardware of hosts from their BMCs, stores it on
+// each host and reports the changes from the previous collection. Hosts with
+// hardware changes are recorded in the event log
+func (h *Handler) BmcHardware(c fuego.ContextNoBody) (model.HardwareReportList, error) {
+	ns, err := h.filterByNodesetAndTags(c.Context(), c.QueryParam("nodeset"), c.QueryParam("tags"))
+	if err != nil {
+		return nil, fuego.HTTPError{
+			Err:    err,
+			Title:  "Error",
+			Detail: "failed to filter nodes",
+		}
+	}
+
+	hostList, err := h.namespaceDB(c.Context()).FindHosts(ns)
+	if err != nil {
+		return nil, fuego.HTTPError{
+			Err:    err,
+			Title:  "Error",
+			Detail: "failed to find nodes",
+		}
+	}
+
+	job := bmc.NewJob(h.namespaceDB(c.Context()))
+	job.SetFanout(c.QueryParamInt("fanout"))
+	job.SetTimeout(time.Duration(c.QueryParamInt("timeout")) * time.Second)
+
+	output, err := job.Hardware(hostList)
+	if err != nil {
+		return nil, fuego.HTTPError{
+			Err:    err,
+			Title:  "Error",
+			Detail: "failed to submit redfish job",
+		}
+	}
+
+	prev := make(map[string]*model.Hardware, len(hostList))
+	for _, host := range hostList {
+		prev[host.Name] = host.Hardware
+	}
+
+	now := time.Now().UTC().Truncate(time.Second)
+	changed := make([]model.JobMessage, 0)
+	for i := range output {
+		report := &output[i]
+		if report.Hardware == nil {
+			continue
+		}
+
+		report.Hardware.CollectedAt = now
+		report.Changes = report.Hardware.Changes(prev[report.Host])
+		if err := h.namespaceDB(c.Context()).StoreHostHardware(report.Host, report.Hardware); err != nil {
+			report.Status = "error"
+			report.Msg = fmt.Sprintf("failed to save hardware: %s", err)
+			continue
+		}
+
+		report.Msg = fmt.Sprintf("collected hardware, %d changes", len(report.Changes))
+		if len(report.Hardware.Missing) > 0 {
+			report.Msg += fmt.Sprintf(", bmc did not report: %s", strings.Join(report.Hardware.Missing, ", "))
+		}
+		if len(report.Changes) > 0 {
+			changed = append(changed, model.JobMessage{Status: "success", Host: report.Host, Msg: strings.Join(report.Changes, "; ")})
+		}
+	}
+	slices.SortFunc(output, func(a, b model.HardwareReport) int { return strings.Compare(a.Host, b.Host) })
+
+	if len(changed) > 0 {
+		h.writeEvent(c.Context(), "Warning", "Hardware changed on node(s)", changed...)
+	}
+
+	return output, nil
+}
+
+func (h *Handler) BmcBiosGet(c fuego.ContextNoBody) (model.BiosReportList, error) {
+	return h.bmcBios(c.Context(), c.QueryParam("nodeset"), c.QueryParam("tags"), c.QueryParamInt("fanout"), c.QueryParamInt("timeout"), (*bmc.Job).GetBios)
+}
+
+func (h *Handler) BmcBiosDiff(c fuego.ContextNoBody) (model.BiosReportList, error) {
+	profile, err := bmc.LoadBiosProfile(c.QueryParam("profile"))
+	if err != nil {
+		return nil, fuego.HTTPError{
+			Err:    err,
+			Status: http.StatusBadRequest,
+			Title:  "Error",
+			Detail: err.Error(),
+		}
+	}
+
+	return h.bmcBios(c.Context(), c.QueryParam("nodeset"), c.QueryParam("tags"), c.QueryParamInt("fanout"), c.QueryParamInt("timeout"), func(j *bmc.Job, hostList model.HostList) (model.BiosReportList, error) {
+		return j.DiffBios(hostList, profile)
+	})
+}
+
+func (h *Handler) BmcBiosApply(c fuego.ContextWithBody[BmcBiosApplyBody]) (model.BiosReportList, error) {
+	body, err := c.Body()
+	if err != nil {
+		return nil, fuego.HTTPError{
+			Err:    err,
+			Title:  "Error",
+			Detail: "failed to parse BIOS apply body",
+		}
+	}
+
+	profile, err := bmc.LoadBiosProfile(body.Profile)
+	if err != nil {
+		return nil, fuego.HTTPError{
+			Err:    err,
+			Status: http.StatusBadRequest,
+			Title:  "Error",
+			Detail: err.Error(),
+		}
+	}
+
+	output, err := h.bmcBios(c.Context(), c.QueryParam("nodeset"), c.QueryParam("tags"), body.Fanout, body.Timeout, func(j *bmc.Job, hostList model.HostList) (model.BiosReportList, error) {
+		return j.ApplyBios(hostList, profile, body.Reboot)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	msgs := make([]model.JobMessage, 0, len(output))
+	for _, r := range output {
+		msgs = append(msgs, model.JobMessage{Status: r.Status, Host: r.Host, Msg: r.Msg})
+	}
+	h.writeEvent(c.Context(), "Success", fmt.Sprintf("Successfully sent BIOS profile %s to node(s)", body.Profile), msgs...)
+
+	return output, nil
+}
+
+// bmcBios runs a BIOS job against the filtered nodes and returns the reports
+// sorted by host
+func (h *Handler) bmcBios(ctx context.Context, nodeset, tags string, fanout, timeout int, run func(*bmc.Job, model.HostList) (model.BiosReportList, error)) (model.BiosReportList, error) {
+	ns, err := h.filterByNodesetAndTags(ctx, nodeset, tags)
+	if err != nil {
+		return nil, fuego.HTTPError{
+			Err:    err,
+			Title:  "Error",
+			Detail: "failed to filter nodes",
+		}
+	}
+
+	hostList, err := h.namespaceDB(ctx).FindHosts(ns)
+	if err != nil {
+		return nil, fuego.HTTPError{
+			Err:    err,
+			Title:  "Error",
+			Detail: "failed to find nodes",
+		}
+	}
+
+	job := bmc.NewJob(h.namespaceDB(ctx))
+	job.SetFanout(fanout)
+	job.SetTimeout(time.Duration(timeout) * time.Second)
+
+	output, err := run(job, hostList)
+	if err != nil {
+		return nil, fuego.HTTPError{
+			Err:    err,
+			Title:  "Error",
+			Detail: "failed to submit redfish job",
+		}
+	}
+	slices.SortFunc(output, func(a, b model.BiosReport) int { return strings.Compare(a.Host, b.Host) })
+
+	return output, nil
+}
+
+// BmcFirmwareUpdate updates the firmware of the filtered nodes and verifies
+// the new version, storing the firmware inventory of the nodes updated. The
+// request lasts as long as the update, so the server write timeout is lifted
+func (h *Handler) BmcFirmwareUpdate(c fuego.ContextWithBody[BmcFirmwareUpdateBody]) (model.FirmwareUpdateReportList, error) {
+	body, err := c.Body()
+	if err != nil {
+		return nil, fuego.HTTPError{
+			Err:    err,
+			Title:  "Error",
+			Detail: "failed to parse firmware update body",
+		}
+	}
+
+	if body.Component == "" {
+		body.Component = model.InventoryFieldBMC
+	}
+	if !slices.Contains(model.InventoryFields, body.Component) || body.Version == "" {
+		return nil, fuego.HTTPError{
+			Err:    fmt.Errorf("invalid firmware component %q or version %q", body.Component, body.Version),
+			Status: http.StatusBadRequest,
+			Title:  "Error",
+			Detail: fmt.Sprintf("a version and a component of %s are required", strings.Join(model.InventoryFields, ", ")),
+		}
+	}
+
+	image, err := firmwareImage(body.Image)
+	if err != nil {
+		return nil, fuego.HTTPError{
+			Err:    err,
+			Status: http.StatusBadRequest,
+			Title:  "Error",
+			Detail: fmt.Sprintf("invalid firmware image %q, expected a URL or an uploaded image", body.Image),
+		}
+	}
+
+	ns, err := h.filterByNodesetAndTags(c.Context(), c.QueryParam("nodeset"), c.QueryParam("tags"))
+	if err != nil {
+		return nil, fuego.HTTPError{
+			Err:    err,
+			Title:  "Error",
+			Detail: "failed to filter nodes",
+		}
+	}
+
+	hostList, err := h.namespaceDB(c.Context()).FindHosts(ns)
+	if err != nil {
+		return nil, fuego.HTTPError{
+			Err:    err,
+			Title:  "Error",
+			Detail: "failed to find nodes",
+		}
+	}
+
+	timeout := body.Timeout
+	if timeout <= 0 {
+		timeout = config.Viper().GetInt("bmc.fwupdate_timeout")
+	}
+	_ = http.NewResponseController(c.Response()).SetWriteDeadline(time.Time{})
+
+	job := bmc.NewJob(h.namespaceDB(c.Context()))
+	job.SetFanout(body.Fanout)
+	job.SetTimeout(time.Duration(timeout) * time.Second)
+
+	output, err := job.UpdateFirmware(hostList, image, body.Component, body.Version)
+	if err != nil {
+		return nil, fuego.HTTPError{
+			Err:    err,
+			Title:  "Error",
+			Detail: "failed to submit redfish job",
+		}
+	}
+	slices.SortFunc(output, func(a, b model.FirmwareUpdateReport) int { return strings.Compare(a.Host, b.Host) })
+
+	msgs := make([]model.JobMessage, 0, len(output))
+	for _, r := range output {
+		msgs = append(msgs, model.JobMessage{Status: r.Status, Host: r.Host, Msg: r.Msg})
+		if r.Status != "success" || r.Inventory.IsEmpty() {
+			continue
+		}
+		if err := h.namespaceDB(c.Context()).StoreHostInventory(r.Host, r.Inventory); err != nil {
+			log.Warn("failed to save firmware inventory for node: ", r.Host)
+		}
+	}
+	h.writeEvent(c.Context(), "Success", fmt.Sprintf("Updated %s firmware of node(s) to %s", body.Component, body.Version), msgs...)
+
+	return output, nil
+}
+
+// BmcRotatePassword sets a new random password on the BMC of the filtered
+// nodes and stores it as their BMC credentials once the BMC accepts it. The
+// credentials key is checked first so no BMC is changed when the new
+// passwords can't be stored
+func (h *Handler) BmcRotatePassword(c fuego.ContextWithBody[BmcRotatePasswordBody]) (model.PasswordRotationReportList, error) {
+	body, err := c.Body()
+	if err != nil {
+		return nil, fuego.HTTPError{
+			Err:    err,
+			Title:  "Error",
+			Detail: "failed to parse rotate password body",
+		}
+	}
+
+	if body.Length == 0 {
+		body.Length = bmc.DefaultPasswordLength
+	}
+	if body.Length < bmc.MinPasswordLength || body.Length > bmc.MaxPasswordLength {
+		return nil, fuego.HTTPError{
+			Err:    fmt.Errorf("invalid password length %d", body.Length),
+			Status: http.StatusBadRequest,
+			Title:  "Error",
+			Detail: fmt.Sprintf("password length must be between %d and %d", bmc.MinPasswordLength, bmc.MaxPasswordLength),
+		}
+	}
+
+	if _, err := secret.Key(); err != nil {
+		return nil, fuego.HTTPError{
+			Err:    err,
+			Status: http.StatusBadRequest,
+			Title:  "Error",
+			Detail: err.Error(),
+		}
+	}
+
+	ns, err := h.filterByNodesetAndTags(c.Context(), c.QueryParam("nodeset"), c.QueryParam("tags"))
+	if err != nil {
+		return nil, fuego.HTTPError{
+			Err:    err,
+			Title:  "Error",
+			Detail: "failed to filter nodes",
+		}
+	}
+
+	hostList, err := h.namespaceDB(c.Context()).FindHosts(ns)
+	if err != nil {
+		return nil, fuego.HTTPError{
+			Err:    err,
+			Title:  "Error",
+			Detail: "failed to find nodes",
+		}
+	}
+
+	commit := func(host string, login secret.Login) error {
+		sealed, err := secret.Seal(login)
+		if err != nil {
+			return err
+		}
+
+		return h.namespaceDB(c.Context()).StoreCredentials(model.CredentialList{{Name: host, Kind: model.CredentialKindBMC, Secret: sealed}})
+	}
+
+	_ = http.NewResponseController(c.Response()).SetWriteDeadline(time.Time{})
+
+	job := bmc.NewJob(h.namespaceDB(c.Context()))
+	job.SetFanout(body.Fanout)
+	job.SetTimeout(time.Duration(body.Timeout) * time.Second)
+
+	output, err := job.RotatePassword(hostList, body.Length, commit)
+	if err != nil {
+		return nil, fuego.HTTPError{
+			Err:    err,
+			Title:  "Error",
+			Detail: "failed to submit redfish job",
+		}
+	}
+	slices.SortFunc(output, func(a, b model.PasswordRotationReport) int { return strings.Compare(a.Host, b.Host) })
+
+	msgs := make([]model.JobMessage, 0, len(output))
+	for _, r := range output {
+		msgs = append(msgs, model.JobMessage{Status: r.Status, Host: r.Host, Msg: r.Msg})
+	}
+	h.writeEvent(c.Context(), "Success", "Rotated BMC passwords of node(s)", msgs...)
+
+	return output, nil
+}
+
+// BmcSubscriptionsCreate subscribes the BMC of node(s) to Redfish events
+// pushed to the event receiver and tags them bmc-events so lost subscriptions
+// are re-created
+func (h *Handler) BmcSubscriptionsCreate(c fuego.ContextNoBody) (model.EventSubscriptionList, error) {
+	output, err := h.bmcSubscriptions(c, true)
+	if err != nil {
+		return nil, err
+	}
+
+	ns := nodeset.EmptyNodeSet()
+	msgs := make([]model.JobMessage, 0, len(output))
+	for _, s := range output {
+		msgs = append(msgs, model.JobMessage{Status: s.Status, Host: s.Host, Msg: s.Msg})
+		if s.Status == "success" {
+			ns.Add(s.Host)
+		}
+	}
+
+	if ns.Len() > 0 {
+		err = h.namespaceDB(c.Context()).TagHosts(ns, []string{bmc.EventsTag})
+		if err != nil {
+			return nil, fuego.HTTPError{
+				Err:    err,
+				Title:  "Error",
+				Detail: fmt.Sprintf("failed to tag subscribed nodes %s", bmc.EventsTag),
+			}
+		}
+	}
+	h.writeEvent(c.Context(), "Success", "Subscribed node(s) to BMC events", msgs...)
+
+	return output, nil
+}
+
+// BmcSubscriptionsStatus checks the BMC of node(s) has an event subscription
+// to the event receiver
+func (h *Handler) BmcSubscriptionsStatus(c fuego.ContextNoBody) (model.EventSubscriptionList, error) {
+	return h.bmcSubscriptions(c, false)
+}
+
+func (h *Handler) bmcSubscriptions(c fuego.ContextNoBody, create bool) (model.EventSubscriptionList, error) {
+	if config.Viper().GetString("bmc.event_url") == "" {
+		return nil, fuego.HTTPError{
+			Err:    errors.New("bmc.event_url is not set"),
+			Status: http.StatusBadRequest,
+			Title:  "Error",
+			Detail: "bmc.event_url is required to subscribe to BMC events",
+		}
+	}
+	if _, err := secret.Key(); err != nil {
+		return nil, fuego.HTTPError{
+			Err:    err,
+			Status: http.StatusBadRequest,
+			Title:  "Error",
+			Detail: err.Error(),
+		}
+	}
+
+	ns, err := h.filterByNodesetAndTags(c.Context(), c.QueryParam("nodeset"), c.QueryParam("tags"))
+	if err != nil {
+		return nil, fuego.HTTPError{
+			Err:    err,
+			Title:  "Error",
+			Detail: "failed to filter nodes",
+		}
+	}
+
+	hostList, err := h.namespaceDB(c.Context()).FindHosts(ns)
+	if err != nil {
+		return nil, fuego.HTTPError{
+			Err:    err,
+			Title:  "Error",
+			Detail: "failed to find nodes",
+		}
+	}
+
+	job := bmc.NewJob(h.namespaceDB(c.Context()))
+	job.SetFanout(c.QueryParamInt("fanout"))
+	job.SetTimeout(time.Duration(c.QueryParamInt("timeout")) * time.Second)
+
+	output, err := job.Subscribe(hostList, create)
+	if err != nil {
+		return nil, fuego.HTTPError{
+			Err:    err,
+			Title:  "Error",
+			Detail: "failed to submit redfish job",
+		}
+	}
+	slices.SortFunc(output, func(a, b model.EventSubscription) int { return strings.Compare(a.Host, b.Host) })
+
+	return output, nil
+}
+
+// BmcFirmwareUpload stores the firmware image in the request body in
+// bmc.firmware_dir for BmcFirmwareUpdate. The stored name is prefixed with
+// the checksum of the image, so uploading an image again returns the same
+// name.
+func (h *Handler) BmcFirmwareUpload(w http.ResponseWriter, r *http.Request) {
+	name := filepath.Base(r.URL.Query().Get("name"))
+	if name == "." || name == "/" {
+		ErrorSerializer(w, r, fuego.HTTPError{
+			Err:    errors.New("missing image name"),
+			Status: http.StatusBadRequest,
+			Title:  "Error",
+			Detail: "name is required",
+		})
+		return
+	}
+
+	// Images take longer to upload than the server read timeout
+	_ = http.NewResponseController(w).SetReadDeadline(time.Time{})
+
+	image, err := saveFirmwareImage(config.Viper().GetString("bmc.firmware_dir"), name, r.Body)
+	if err != nil {
+		ErrorSerializer(w, r, fuego.HTTPError{
+			Err:    err,
+			Title:  "Error",
+			Detail: "failed to store firmware image",
+		})
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(map[string]string{"image": image})
+}
+
+// saveFirmwareImage writes the image read from r to dir and returns its name
+func saveFirmwareImage(dir, name string, r io.Reader) (string, error) {
+	err := os.MkdirAll(dir, 0700)
+	if err != nil {
+		return "", err
+	}
+
+	tmp, err := os.CreateTemp(dir, ".upload-*")
+	if err != nil {
+		return "", err
+	}
+	defer os.Remove(tmp.Name())
+
+	sum := sha256.New()
+	_, err = io.Copy(io.MultiWriter(tmp, sum), r)
+	if cerr := tmp.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		return "", err
+	}
+
+	image := fmt.Sprintf("%x-%s", sum.Sum(nil)[:8], name)
+	err = os.Rename(tmp.Name(), filepath.Join(dir, image))
+	if err != nil {
+		return "", err
+	}
+
+	return image, nil
+}
+
+// firmwareImage returns the firmware image of a BmcFirmwareUpdateBody, either
+// a URL fetched by the BMCs or the name of an image in bmc.firmware_dir
+func firmwareImage(image string) (*bmc.FirmwareImage, error) {
+	if strings.Contains(image, "://") {
+		return &bmc.FirmwareImage{URI: image}, nil
+	}
+	if image == "" || filepath.Base(image) != image {
+		return nil, fmt.Errorf("invalid firmware image name: %q", image)
+	}
+
+	return bmc.LoadFirmwareImage(filepath.Join(config.Viper().GetString("bmc.firmware_dir"), image))
+}
+
+func (h *Handler) BmcVirtualMediaMount(c fuego.ContextWithBody[BmcVirtualMediaBody]) (model.JobMessageList, error) {
+	ns, err := h.filterByNodesetAndTags(c.Context(), c.QueryParam("nodeset"), c.QueryParam("tags"))
+	if err != nil {
+		return nil, fuego.HTTPError{
+			Err:    err,
+			Title:  "Error",
+			Detail: "failed to filter nodes",
+		}
+	}
+
+	body, err := c.Body()
+	if err != nil {
+		return nil, fuego.HTTPError{
+			Err:    err,
+			Title:  "Error",
+			Detail: "failed to parse virtual media body",
+		}
+	}
+	if body.Image == "" {
+		return nil, fuego.HTTPError{
+			Err:    errors.New("missing virtual media image"),
+			Status: http.StatusBadRequest,
+			Title:  "Error",
+			Detail: "image is required",
+		}
+	}
+
+	hostList, err := h.namespaceDB(c.Context()).FindHosts(ns)
+	if err != nil {
+		return nil, fuego.HTTPError{
+			Err:    err,
+			Title:  "Error",
+			Detail: "failed to find nodes",
+		}
+	}
+
+	job := bmc.NewJob(h.namespaceDB(c.Context()))
+	job.SetFanout(body.Fanout)
+	job.SetTimeout(time.Duration(body.Timeout) * time.Second)
+
+	output, err := job.VirtualMediaMount(hostList, body.Image, body.Boot, body.Reboot)
+	if err != nil {
+		return nil, fuego.HTTPError{
+			Err:    err,
+			Title:  "Error",
+			Detail: "failed to submit redfish job",
+		}
+	}
+
+	h.writeEvent(c.Context(), "Success", fmt.Sprintf("Successfully sent virtual media insert of %s to node(s)", body.Image), output...)
+	return output, nil
+}
+
+func (h *Handler) BmcVirtualMediaUnmount(c fuego.ContextNoBody) (model.JobMessageList, error) {
+	return h.bmcVirtualMedia(c, true, (*bmc.Job).VirtualMediaUnmount)
+}
+
+func (h *Handler) BmcVirtualMediaStatus(c fuego.ContextNoBody) (model.JobMessageList, error) {
+	return h.bmcVirtualMedia(c, false, (*bmc.Job).VirtualMediaStatus)
+}
+
+// bmcVirtualMedia runs a virtual media job without parameters against the
+// filtered nodes. Jobs which change the nodes are recorded in the event log
+func (h *Handler) bmcVirtualMedia(c fuego.ContextNoBody, event bool, run func(*bmc.Job, model.HostList) (model.JobMessageList, error)) (model.JobMessageList, error) {
+	ns, err := h.filterByNodesetAndTags(c.Context(), c.QueryParam("nodeset"), c.QueryParam("tags"))
+	if err != nil {
+		return nil, fuego.HTTPError{
+			Err:    err,
+			Title:  "Error",
+			Detail: "failed to filter nodes",
+		}
+	}
+
+	hostList, err := h.namespaceDB(c.Context()).FindHosts(ns)
+	if err != nil {
+		return nil, fuego.HTTPError{
+			Err:    err,
+			Title:  "Error",
+			Detail: "failed to find nodes",
+		}
+	}
+
+	job := bmc.NewJob(h.namespaceDB(c.Context()))
+	job.SetFanout(c.QueryParamInt("fanout"))
+	job.SetTimeout(time.Duration(c.QueryParamInt("timeout")) * time.Second)
+
+	output, err := run(job, hostList)
+	if err != nil {
+		return nil, fuego.HTTPError{
+			Err:    err,
+			Title:  "Error",
+			Detail: "failed to submit redfish job",
+		}
+	}
+
+	if event {
+		h.writeEvent(c.Context(), "Success", "Successfully sent virtual media eject to node(s)", output...)
+	}
 	return output, nil
 }
 
 func (h *Handler) BmcPower(c fuego.ContextNoBody) (model.JobMessageList, error) {
-	ns, err := h.filterByNodesetAndTags(c.QueryParam("nodeset"), c.QueryParam("tags"))
+	ns, err := h.filterByNodesetAndTags(c.Context(), c.QueryParam("nodeset"), c.QueryParam("tags"))
 	if err != nil {
 		return nil, fuego.HTTPError{
 			Err:    err,
@@ -80,7 +826,7 @@ func (h *Handler) BmcPower(c fuego.ContextNoBody) (model.JobMessageList, error)
 		}
 	}
 
-	hostList, err := h.DB.FindHosts(ns)
+	hostList, err := h.namespaceDB(c.Context()).FindHosts(ns)
 	if err != nil {
 		return nil, fuego.HTTPError{
 			Err:    err,
@@ -89,7 +835,7 @@ func (h *Handler) BmcPower(c fuego.ContextNoBody) (model.JobMessageList, error)
 		}
 	}
 
-	job := bmc.NewJob()
+	job := bmc.NewJob(h.namespaceDB(c.Context()))
 
 	output, err := job.PowerCycleBmc(hostList)
 	if err != nil {
@@ -100,12 +846,12 @@ func (h *Handler) BmcPower(c fuego.ContextNoBody) (model.JobMessageList, error)
 		}
 	}
 
-	h.writeEvent(c.Context(), "Success", "Successfully sent BMC power command to node(s)", output...)
+	h.writeHostEvent(c.Context(), webhook.BMCPower, ns.Iterator().StringSlice(), "Success", "Successfully sent BMC power command to node(s)", output...)
 	return output, nil
 }
 
 func (h *Handler) BmcSelClear(c fuego.ContextNoBody) (model.JobMessageList, error) {
-	ns, err := h.filterByNodesetAndTags(c.QueryParam("nodeset"), c.QueryParam("tags"))
+	ns, err := h.filterByNodesetAndTags(c.Context(), c.QueryParam("nodeset"), c.QueryParam("tags"))
 	if err != nil {
 		return nil, fuego.HTTPError{
 			Err:    err,
@@ -114,7 +860,7 @@ func (h *Handler) BmcSelClear(c fuego.ContextNoBody) (model.JobMessageList, erro
 		}
 	}
 
-	hostList, err := h.DB.FindHosts(ns)
+	hostList, err := h.namespaceDB(c.Context()).FindHosts(ns)
 	if err != nil {
 		return nil, fuego.HTTPError{
 			Err:    err,
@@ -123,7 +869,7 @@ func (h *Handler) BmcSelClear(c fuego.ContextNoBody) (model.JobMessageList, erro
 		}
 	}
 
-	job := bmc.NewJob()
+	job := bmc.NewJob(h.namespaceDB(c.Context()))
 
 	output, err := job.ClearSel(hostList)
 	if err != nil {
@@ -139,7 +885,7 @@ func (h *Handler) BmcSelClear(c fuego.ContextNoBody) (model.JobMessageList, erro
 }
 
 func (h *Handler) BmcJobList(c fuego.ContextNoBody) (model.RedfishJobList, error) {
-	ns, err := h.filterByNodesetAndTags(c.QueryParam("nodeset"), c.QueryParam("tags"))
+	ns, err := h.filterByNodesetAndTags(c.Context(), c.QueryParam("nodeset"), c.QueryParam("tags"))
 	if err != nil {
 		return nil, fuego.HTTPError{
 			Err:    err,
@@ -148,7 +894,7 @@ func (h *Handler) BmcJobList(c fuego.ContextNoBody) (model.RedfishJobList, error
 		}
 	}
 
-	hostList, err := h.DB.FindHosts(ns)
+	hostList, err := h.namespaceDB(c.Context()).FindHosts(ns)
 	if err != nil {
 		return nil, fuego.HTTPError{
 			Err:    err,
@@ -157,7 +903,7 @@ func (h *Handler) BmcJobList(c fuego.ContextNoBody) (model.RedfishJobList, error
 		}
 	}
 
-	job := bmc.NewJob()
+	job := bmc.NewJob(h.namespaceDB(c.Context()))
 
 	output, err := job.GetJobs(hostList)
 	if err != nil {
@@ -172,7 +918,7 @@ func (h *Handler) BmcJobList(c fuego.ContextNoBody) (model.RedfishJobList, error
 }
 
 func (h *Handler) BmcJobDelete(c fuego.ContextNoBody) (model.JobMessageList, error) {
-	ns, err := h.filterByNodesetAndTags(c.QueryParam("nodeset"), c.QueryParam("tags"))
+	ns, err := h.filterByNodesetAndTags(c.Context(), c.QueryParam("nodeset"), c.QueryParam("tags"))
 	if err != nil {
 		return nil, fuego.HTTPError{
 			Err:    err,
@@ -181,7 +927,7 @@ func (h *Handler) BmcJobDelete(c fuego.ContextNoBody) (model.JobMessageList, err
 		}
 	}
 
-	hostList, err := h.DB.FindHosts(ns)
+	hostList, err := h.namespaceDB(c.Context()).FindHosts(ns)
 	if err != nil {
 		return nil, fuego.HTTPError{
 			Err:    err,
@@ -190,7 +936,7 @@ func (h *Handler) BmcJobDelete(c fuego.ContextNoBody) (model.JobMessageList, err
 		}
 	}
 
-	job := bmc.NewJob()
+	job := bmc.NewJob(h.namespaceDB(c.Context()))
 
 	jids := strings.Split(c.PathParam("jids"), ",")
 	output, err := job.ClearJobs(hostList, jids)
@@ -231,7 +977,7 @@ func (h *Handler) BmcJobDeleteMany(c fuego.ContextWithBody[BmcJobDeleteRequest])
 				Detail: "failed to filter nodes",
 			}
 		}
-		hostList, err := h.DB.FindHosts(ns)
+		hostList, err := h.namespaceDB(c.Context()).FindHosts(ns)
 		if err != nil {
 			return nil, fuego.HTTPError{
 				Err:    err,
@@ -250,7 +996,7 @@ func (h *Handler) BmcJobDeleteMany(c fuego.ContextWithBody[BmcJobDeleteRequest])
 		nodeJobList[hostList[0]] = jids
 	}
 
-	job := bmc.NewJob()
+	job := bmc.NewJob(h.namespaceDB(c.Context()))
 
 	output, err := job.ClearManyJobs(nodeJobList)
 	if err != nil {
@@ -266,7 +1012,7 @@ func (h *Handler) BmcJobDeleteMany(c fuego.ContextWithBody[BmcJobDeleteRequest])
 }
 
 func (h *Handler) BmcQuery(c fuego.ContextNoBody) (model.RedfishSystemList, error) {
-	ns, err := h.filterByNodesetAndTags(c.QueryParam("nodeset"), c.QueryParam("tags"))
+	ns, err := h.filterByNodesetAndTags(c.Context(), c.QueryParam("nodeset"), c.QueryParam("tags"))
 	if err != nil {
 		return nil, fuego.HTTPError{
 			Err:    err,
@@ -275,7 +1021,7 @@ func (h *Handler) BmcQuery(c fuego.ContextNoBody) (model.RedfishSystemList, erro
 		}
 	}
 
-	hostList, err := h.DB.FindHosts(ns)
+	hostList, err := h.namespaceDB(c.Context()).FindHosts(ns)
 	if err != nil {
 		return nil, fuego.HTTPError{
 			Err:    err,
@@ -284,7 +1030,7 @@ func (h *Handler) BmcQuery(c fuego.ContextNoBody) (model.RedfishSystemList, erro
 		}
 	}
 
-	job := bmc.NewJob()
+	job := bmc.NewJob(h.namespaceDB(c.Context()))
 
 	output, err := job.BmcStatus(hostList)
 	if err != nil {
@@ -325,18 +1071,34 @@ func (h *Handler) BmcQuery(c fuego.ContextNoBody) (model.RedfishSystemList, erro
 			log.Warn("failed to get serial number for node: ", it.Value())
 			continue
 		}
-		err = h.DB.TagHosts(ns, []string{fmt.Sprintf("grendel:serial=%s", job.SerialNumber)})
+		err = h.namespaceDB(c.Context()).TagHosts(ns, []string{fmt.Sprintf("grendel:serial=%s", job.SerialNumber)})
 		if err != nil {
 			log.Warn("failed to save updated serial number for node:", it.Value())
 			continue
 		}
 	}
 
+	now := time.Now().UTC().Truncate(time.Second)
+	for _, sys := range output {
+		inv := &model.Inventory{
+			BIOSVersion: sys.BIOSVersion,
+			BMCFirmware: sys.BMCFirmware,
+			NICFirmware: sys.NICFirmware,
+			CapturedAt:  now,
+		}
+		if inv.IsEmpty() {
+			continue
+		}
+		if err := h.namespaceDB(c.Context()).StoreHostInventory(sys.Name, inv); err != nil {
+			log.Warn("failed to save firmware inventory for node: ", sys.Name)
+		}
+	}
+
 	return output, nil
 }
 
 func (h *Handler) BmcAutoConfigure(c fuego.ContextNoBody) (model.JobMessageList, error) {
-	ns, err := h.filterByNodesetAndTags(c.QueryParam("nodeset"), c.QueryParam("tags"))
+	ns, err := h.filterByNodesetAndTags(c.Context(), c.QueryParam("nodeset"), c.QueryParam("tags"))
 	if err != nil {
 		return nil, fuego.HTTPError{
 			Err:    err,
@@ -345,7 +1107,7 @@ func (h *Handler) BmcAutoConfigure(c fuego.ContextNoBody) (model.JobMessageList,
 		}
 	}
 
-	hostList, err := h.DB.FindHosts(ns)
+	hostList, err := h.namespaceDB(c.Context()).FindHosts(ns)
 	if err != nil {
 		return nil, fuego.HTTPError{
 			Err:    err,
@@ -354,7 +1116,7 @@ func (h *Handler) BmcAutoConfigure(c fuego.ContextNoBody) (model.JobMessageList,
 		}
 	}
 
-	job := bmc.NewJob()
+	job := bmc.NewJob(h.namespaceDB(c.Context()))
 
 	output, err := job.BmcAutoConfigure(hostList)
 	if err != nil {
@@ -370,7 +1132,7 @@ func (h *Handler) BmcAutoConfigure(c fuego.ContextNoBody) (model.JobMessageList,
 }
 
 func (h *Handler) BmcImportConfiguration(c fuego.ContextWithBody[BmcImportConfigurationRequest]) (model.JobMessageList, error) {
-	ns, err := h.filterByNodesetAndTags(c.QueryParam("nodeset"), c.QueryParam("tags"))
+	ns, err := h.filterByNodesetAndTags(c.Context(), c.QueryParam("nodeset"), c.QueryParam("tags"))
 	if err != nil {
 		return nil, fuego.HTTPError{
 			Err:    err,
@@ -388,7 +1150,7 @@ func (h *Handler) BmcImportConfiguration(c fuego.ContextWithBody[BmcImportConfig
 		}
 	}
 
-	hostList, err := h.DB.FindHosts(ns)
+	hostList, err := h.namespaceDB(c.Context()).FindHosts(ns)
 	if err != nil {
 		return nil, fuego.HTTPError{
 			Err:    err,
@@ -397,7 +1159,7 @@ func (h *Handler) BmcImportConfiguration(c fuego.ContextWithBody[BmcImportConfig
 		}
 	}
 
-	job := bmc.NewJob()
+	job := bmc.NewJob(h.namespaceDB(c.Context()))
 
 	output, err := job.BmcImportConfiguration(hostList, body.ShutdownType, body.File)
 	if err != nil {
@@ -413,7 +1175,7 @@ func (h *Handler) BmcImportConfiguration(c fuego.ContextWithBody[BmcImportConfig
 }
 
 func (h *Handler) BmcMetricReports(c fuego.ContextNoBody) (model.RedfishMetricReportList, error) {
-	ns, err := h.filterByNodesetAndTags(c.QueryParam("nodeset"), c.QueryParam("tags"))
+	ns, err := h.filterByNodesetAndTags(c.Context(), c.QueryParam("nodeset"), c.QueryParam("tags"))
 	if err != nil {
 		return nil, fuego.HTTPError{
 			Err:    err,
@@ -422,7 +1184,7 @@ func (h *Handler) BmcMetricReports(c fuego.ContextNoBody) (model.RedfishMetricRe
 		}
 	}
 
-	hostList, err := h.DB.FindHosts(ns)
+	hostList, err := h.namespaceDB(c.Context()).FindHosts(ns)
 	if err != nil {
 		return nil, fuego.HTTPError{
 			Err:    err,
@@ -431,7 +1193,7 @@ func (h *Handler) BmcMetricReports(c fuego.ContextNoBody) (model.RedfishMetricRe
 		}
 	}
 
-	job := bmc.NewJob()
+	job := bmc.NewJob(h.namespaceDB(c.Context()))
 
 	output, err := job.BmcGetMetricReports(hostList)
 	if err != nil {
@@ -485,7 +1247,7 @@ func (h *Handler) BmcDellInstallFromRepo(c fuego.ContextWithBody[BmcDellInstallF
 		RebootNeeded:      body.RebootNeeded,
 	}
 
-	ns, err := h.filterByNodesetAndTags(c.QueryParam("nodeset"), c.QueryParam("tags"))
+	ns, err := h.filterByNodesetAndTags(c.Context(), c.QueryParam("nodeset"), c.QueryParam("tags"))
 	if err != nil {
 		return nil, fuego.HTTPError{
 			Err:    err,
@@ -494,7 +1256,7 @@ func (h *Handler) BmcDellInstallFromRepo(c fuego.ContextWithBody[BmcDellInstallF
 		}
 	}
 
-	hostList, err := h.DB.FindHosts(ns)
+	hostList, err := h.namespaceDB(c.Context()).FindHosts(ns)
 	if err != nil {
 		return nil, fuego.HTTPError{
 			Err:    err,
@@ -503,7 +1265,7 @@ func (h *Handler) BmcDellInstallFromRepo(c fuego.ContextWithBody[BmcDellInstallF
 		}
 	}
 
-	job := bmc.NewJob()
+	job := bmc.NewJob(h.namespaceDB(c.Context()))
 
 	if body.ClearJobQueue && body.ApplyUpdate {
 		jl, err := job.ClearJobs(hostList, []string{"JID_CLEARALL"})
@@ -533,7 +1295,7 @@ func (h *Handler) BmcDellInstallFromRepo(c fuego.ContextWithBody[BmcDellInstallF
 }
 
 func (h *Handler) BmcDellGetRepoUpdateList(c fuego.ContextNoBody) (model.RedfishDellUpgradeFirmwareList, error) {
-	ns, err := h.filterByNodesetAndTags(c.QueryParam("nodeset"), c.QueryParam("tags"))
+	ns, err := h.filterByNodesetAndTags(c.Context(), c.QueryParam("nodeset"), c.QueryParam("tags"))
 	if err != nil {
 		return nil, fuego.HTTPError{
 			Err:    err,
@@ -542,7 +1304,7 @@ func (h *Handler) BmcDellGetRepoUpdateList(c fuego.ContextNoBody) (model.Redfish
 		}
 	}
 
-	hostList, err := h.DB.FindHosts(ns)
+	hostList, err := h.namespaceDB(c.Context()).FindHosts(ns)
 	if err != nil {
 		return nil, fuego.HTTPError{
 			Err:    err,
@@ -551,7 +1313,7 @@ func (h *Handler) BmcDellGetRepoUpdateList(c fuego.ContextNoBody) (model.Redfish
 		}
 	}
 
-	job := bmc.NewJob()
+	job := bmc.NewJob(h.namespaceDB(c.Context()))
 
 	output, err := job.DellGetRepoUpdateList(hostList)
 	if err != nil {
@@ -0,0 +1,350 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package api
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/ubccr/grendel/internal/bmc"
+	"github.com/ubccr/grendel/pkg/model"
+)
+
+func TestBmcBootOverride(t *testing.T) {
+	viper.Set("api.socket_path", "/tmp/grendel-test.sock")
+	defer viper.Set("api.socket_path", nil)
+
+	fs := newTestServer(t)
+
+	req := httptest.NewRequest(http.MethodPost, "/v1/nodes", strings.NewReader(`{"node_list": [{"name": "cpn-01"}]}`))
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+	fs.Mux.ServeHTTP(rec, req)
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+
+	// A target is required
+	req = httptest.NewRequest(http.MethodPost, "/v1/bmc/boot?nodeset=cpn-01", strings.NewReader(`{}`))
+	req.Header.Set("Content-Type", "application/json")
+	rec = httptest.NewRecorder()
+	fs.Mux.ServeHTTP(rec, req)
+	assert.Equal(t, http.StatusBadRequest, rec.Code, rec.Body.String())
+
+	// Hosts without a BMC interface are reported as failed
+	req = httptest.NewRequest(http.MethodPost, "/v1/bmc/boot?nodeset=cpn-01", strings.NewReader(`{"target": "Pxe"}`))
+	req.Header.Set("Content-Type", "application/json")
+	rec = httptest.NewRecorder()
+	fs.Mux.ServeHTTP(rec, req)
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+
+	var res model.JobMessageList
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &res))
+	if assert.Len(t, res, 1) {
+		assert.Equal(t, "cpn-01", res[0].Host)
+		assert.Equal(t, "error", res[0].Status)
+	}
+}
+
+func TestBmcVirtualMedia(t *testing.T) {
+	viper.Set("api.socket_path", "/tmp/grendel-test.sock")
+	defer viper.Set("api.socket_path", nil)
+
+	fs := newTestServer(t)
+
+	req := httptest.NewRequest(http.MethodPost, "/v1/nodes", strings.NewReader(`{"node_list": [{"name": "cpn-01"}]}`))
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+	fs.Mux.ServeHTTP(rec, req)
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+
+	// An image is required
+	req = httptest.NewRequest(http.MethodPost, "/v1/bmc/vmedia?nodeset=cpn-01", strings.NewReader(`{"boot": true}`))
+	req.Header.Set("Content-Type", "application/json")
+	rec = httptest.NewRecorder()
+	fs.Mux.ServeHTTP(rec, req)
+	assert.Equal(t, http.StatusBadRequest, rec.Code, rec.Body.String())
+
+	// Hosts without a BMC interface are reported as failed
+	for _, method := range []string{http.MethodGet, http.MethodDelete} {
+		req = httptest.NewRequest(method, "/v1/bmc/vmedia?nodeset=cpn-01", nil)
+		rec = httptest.NewRecorder()
+		fs.Mux.ServeHTTP(rec, req)
+		require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+
+		var res model.JobMessageList
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &res))
+		if assert.Len(t, res, 1) {
+			assert.Equal(t, "error", res[0].Status)
+		}
+	}
+}
+
+func TestBmcBiosProfile(t *testing.T) {
+	viper.Set("api.socket_path", "/tmp/grendel-test.sock")
+	defer viper.Set("api.socket_path", nil)
+
+	fs := newTestServer(t)
+
+	// Unknown profiles are rejected before contacting any BMC
+	req := httptest.NewRequest(http.MethodGet, "/v1/bmc/bios/diff?nodeset=cpn-01&profile=gpu", nil)
+	rec := httptest.NewRecorder()
+	fs.Mux.ServeHTTP(rec, req)
+	assert.Equal(t, http.StatusBadRequest, rec.Code, rec.Body.String())
+
+	req = httptest.NewRequest(http.MethodPost, "/v1/bmc/bios?nodeset=cpn-01", strings.NewReader(`{"profile": "gpu"}`))
+	req.Header.Set("Content-Type", "application/json")
+	rec = httptest.NewRecorder()
+	fs.Mux.ServeHTTP(rec, req)
+	assert.Equal(t, http.StatusBadRequest, rec.Code, rec.Body.String())
+}
+
+func TestBmcFirmwareUpdate(t *testing.T) {
+	viper.Set("api.socket_path", "/tmp/grendel-test.sock")
+	defer viper.Set("api.socket_path", nil)
+	viper.Set("bmc.firmware_dir", t.TempDir())
+	defer viper.Set("bmc.firmware_dir", nil)
+
+	fs := newTestServer(t)
+
+	req := httptest.NewRequest(http.MethodPost, "/v1/nodes", strings.NewReader(`{"node_list": [{"name": "cpn-01"}]}`))
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+	fs.Mux.ServeHTTP(rec, req)
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+
+	req = httptest.NewRequest(http.MethodPost, "/v1/bmc/fwupdate/image?name=../firmware.d9", strings.NewReader("firmware"))
+	rec = httptest.NewRecorder()
+	fs.Mux.ServeHTTP(rec, req)
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+
+	var upload struct {
+		Image string `json:"image"`
+	}
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &upload))
+	assert.Regexp(t, `^[0-9a-f]{16}-firmware\.d9$`, upload.Image)
+
+	for _, body := range []string{
+		// A version is required
+		`{"image": "` + upload.Image + `"}`,
+		`{"image": "` + upload.Image + `", "version": "7.10", "component": "disk"}`,
+		`{"image": "missing.d9", "version": "7.10"}`,
+		`{"image": "../` + upload.Image + `", "version": "7.10"}`,
+	} {
+		req = httptest.NewRequest(http.MethodPost, "/v1/bmc/fwupdate?nodeset=cpn-01", strings.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		rec = httptest.NewRecorder()
+		fs.Mux.ServeHTTP(rec, req)
+		assert.Equal(t, http.StatusBadRequest, rec.Code, body)
+	}
+
+	// Hosts without a BMC interface are reported as failed
+	for _, image := range []string{upload.Image, "http://10.0.0.1/firmware.d9"} {
+		req = httptest.NewRequest(http.MethodPost, "/v1/bmc/fwupdate?nodeset=cpn-01", strings.NewReader(`{"image": "`+image+`", "version": "7.10"}`))
+		req.Header.Set("Content-Type", "application/json")
+		rec = httptest.NewRecorder()
+		fs.Mux.ServeHTTP(rec, req)
+		require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+
+		var res model.FirmwareUpdateReportList
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &res))
+		if assert.Len(t, res, 1) {
+			assert.Equal(t, "cpn-01", res[0].Host)
+			assert.Equal(t, "error", res[0].Status)
+		}
+	}
+}
+
+func TestBmcRotatePassword(t *testing.T) {
+	viper.Set("api.socket_path", "/tmp/grendel-test.sock")
+	defer viper.Set("api.socket_path", nil)
+
+	fs := newTestServer(t)
+
+	req := httptest.NewRequest(http.MethodPost, "/v1/nodes", strings.NewReader(`{"node_list": [{"name": "cpn-01"}]}`))
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+	fs.Mux.ServeHTTP(rec, req)
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+
+	// Without a credentials key no BMC is changed
+	req = httptest.NewRequest(http.MethodPost, "/v1/bmc/rotate-password?nodeset=cpn-01", strings.NewReader(`{}`))
+	req.Header.Set("Content-Type", "application/json")
+	rec = httptest.NewRecorder()
+	fs.Mux.ServeHTTP(rec, req)
+	assert.Equal(t, http.StatusBadRequest, rec.Code, rec.Body.String())
+
+	viper.Set("credentials_key", "test")
+	defer viper.Set("credentials_key", nil)
+
+	req = httptest.NewRequest(http.MethodPost, "/v1/bmc/rotate-password?nodeset=cpn-01", strings.NewReader(`{"length": 8}`))
+	req.Header.Set("Content-Type", "application/json")
+	rec = httptest.NewRecorder()
+	fs.Mux.ServeHTTP(rec, req)
+	assert.Equal(t, http.StatusBadRequest, rec.Code, rec.Body.String())
+
+	// Hosts without a BMC interface are reported as failed
+	req = httptest.NewRequest(http.MethodPost, "/v1/bmc/rotate-password?nodeset=cpn-01", strings.NewReader(`{}`))
+	req.Header.Set("Content-Type", "application/json")
+	rec = httptest.NewRecorder()
+	fs.Mux.ServeHTTP(rec, req)
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+
+	var res model.PasswordRotationReportList
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &res))
+	if assert.Len(t, res, 1) {
+		assert.Equal(t, "cpn-01", res[0].Host)
+		assert.Equal(t, "error", res[0].Status)
+		assert.False(t, res[0].Attention)
+	}
+}
+
+func TestBmcMetrics(t *testing.T) {
+	viper.Set("api.socket_path", "/tmp/grendel-test.sock")
+	defer viper.Set("api.socket_path", nil)
+
+	fs := newTestServer(t)
+
+	req := httptest.NewRequest(http.MethodGet, "/v1/bmc/prometheus", nil)
+	rec := httptest.NewRecorder()
+	fs.Mux.ServeHTTP(rec, req)
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	assert.Contains(t, rec.Body.String(), "go_goroutines")
+}
+
+func TestBmcConsole(t *testing.T) {
+	viper.Set("api.socket_path", "/tmp/grendel-test.sock")
+	defer viper.Set("api.socket_path", nil)
+
+	fs := newTestServer(t)
+
+	req := httptest.NewRequest(http.MethodPost, "/v1/nodes", strings.NewReader(`{"node_list": [{"name": "cpn-01"}]}`))
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+	fs.Mux.ServeHTTP(rec, req)
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+
+	console := func(host string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, "/v1/bmc/console?host="+host, nil)
+		req.Header.Set("Connection", "Upgrade")
+		req.Header.Set("Upgrade", ConsoleProtocol)
+		rec := httptest.NewRecorder()
+		fs.Mux.ServeHTTP(rec, req)
+		return rec
+	}
+
+	rec = console("cpn-02")
+	assert.Equal(t, http.StatusNotFound, rec.Code, rec.Body.String())
+
+	// Failed sessions release the console
+	for range 2 {
+		rec = console("cpn-01")
+		assert.Equal(t, http.StatusBadGateway, rec.Code, rec.Body.String())
+		assert.Contains(t, rec.Body.String(), "failed to find bmc interface")
+	}
+
+	req = httptest.NewRequest(http.MethodGet, "/v1/bmc/console?host=cpn-01", nil)
+	rec = httptest.NewRecorder()
+	fs.Mux.ServeHTTP(rec, req)
+	assert.Equal(t, http.StatusBadRequest, rec.Code, rec.Body.String())
+}
+
+func TestConsoleSessions(t *testing.T) {
+	c := newConsoleSessions()
+
+	_, ok := c.acquire("cpn-01", "alice")
+	assert.True(t, ok)
+
+	cur, ok := c.acquire("cpn-01", "bob")
+	assert.False(t, ok)
+	assert.Equal(t, "alice", cur.user)
+
+	_, ok = c.acquire("cpn-02", "bob")
+	assert.True(t, ok)
+
+	c.release("cpn-01")
+	_, ok = c.acquire("cpn-01", "bob")
+	assert.True(t, ok)
+}
+
+func TestBmcEvents(t *testing.T) {
+	viper.Set("api.socket_path", "/tmp/grendel-test.sock")
+	defer viper.Set("api.socket_path", nil)
+
+	fs := newTestServer(t)
+
+	do := func(method, url, body string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(method, url, strings.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		rec := httptest.NewRecorder()
+		fs.Mux.ServeHTTP(rec, req)
+		return rec
+	}
+
+	rec := do(http.MethodPost, "/v1/nodes", `{"node_list": [{"name": "cpn-01"}]}`)
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+
+	// Subscriptions require bmc.event_url
+	rec = do(http.MethodPost, "/v1/bmc/subscriptions?nodeset=cpn-01", "")
+	assert.Equal(t, http.StatusBadRequest, rec.Code, rec.Body.String())
+
+	viper.Set("credentials_key", "test")
+	defer viper.Set("credentials_key", nil)
+	viper.Set("bmc.event_url", "https://grendel.example.com:6667")
+	defer viper.Set("bmc.event_url", nil)
+
+	// Hosts without a BMC interface are reported as failed and not tagged
+	rec = do(http.MethodPost, "/v1/bmc/subscriptions?nodeset=cpn-01", "")
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	var subs model.EventSubscriptionList
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &subs))
+	if assert.Len(t, subs, 1) {
+		assert.Equal(t, "error", subs[0].Status)
+		assert.Equal(t, model.SubscriptionMissing, subs[0].State)
+	}
+
+	rec = do(http.MethodGet, "/v1/nodes/find?nodeset=cpn-01", "")
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	var hosts model.HostList
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &hosts))
+	require.Len(t, hosts, 1)
+	assert.NotContains(t, hosts[0].Tags, bmc.EventsTag)
+
+	event := `{"Events": [
+		{"EventType": "Alert", "MessageId": "PSU0003", "Message": "The power input for power supply 1 is lost.", "MessageSeverity": "Critical"},
+		{"EventType": "Alert", "MessageId": "USR0030", "Message": "Successfully logged in.", "MessageSeverity": "OK"}
+	]}`
+
+	rec = do(http.MethodPost, "/v1/bmc/events/receive/"+hosts[0].UID.String()+".bad", event)
+	assert.Equal(t, http.StatusUnauthorized, rec.Code, rec.Body.String())
+
+	token, err := bmc.EventToken(hosts[0])
+	require.NoError(t, err)
+
+	rec = do(http.MethodPost, "/v1/bmc/events/receive/"+token, "not json")
+	assert.Equal(t, http.StatusBadRequest, rec.Code, rec.Body.String())
+
+	rec = do(http.MethodPost, "/v1/bmc/events/receive/"+token, event)
+	require.Equal(t, http.StatusNoContent, rec.Code, rec.Body.String())
+
+	rec = do(http.MethodGet, "/v1/grendel/events", "")
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	assert.Contains(t, rec.Body.String(), "cpn-01: The power input for power supply 1 is lost.")
+	assert.Contains(t, rec.Body.String(), "cpn-01: Successfully logged in.")
+
+	// Only critical events are added to the log of the host
+	rec = do(http.MethodGet, "/v1/nodes/log?nodeset=cpn-01", "")
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	var entries model.HostLogList
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &entries))
+	if assert.Len(t, entries, 1) {
+		assert.Equal(t, "PSU0003", entries[0].MessageID)
+		assert.Equal(t, "Critical", entries[0].Severity)
+	}
+}
@@ -5,11 +5,18 @@
 package api
 
 import (
+	"errors"
 	"fmt"
+	"net/http"
 	"slices"
 	"strings"
+	"time"
 
 	"github.com/go-fuego/fuego"
+	"github.com/ubccr/grendel/internal/config"
+	"github.com/ubccr/grendel/internal/imagegc"
+	"github.com/ubccr/grendel/internal/store"
+	"github.com/ubccr/grendel/internal/util"
 	"github.com/ubccr/grendel/pkg/model"
 )
 
@@ -27,13 +34,9 @@ func (h *Handler) BootImageAdd(c fuego.ContextWithBody[BootImageAddRequest]) (*G
 		}
 	}
 
-	err = h.DB.StoreBootImages(images.BootImages)
+	err = h.db(c.Context()).StoreBootImages(images.BootImages)
 	if err != nil {
-		return nil, fuego.HTTPError{
-			Err:    err,
-			Title:  "Error",
-			Detail: "failed to add image(s)",
-		}
+		return nil, h.storeError(c.Context(), err, "failed to add image(s)")
 	}
 
 	var names []string
@@ -51,7 +54,12 @@ func (h *Handler) BootImageAdd(c fuego.ContextWithBody[BootImageAddRequest]) (*G
 }
 
 func (h *Handler) BootImageList(c fuego.ContextNoBody) (model.BootImageList, error) {
-	imageList, err := h.DB.BootImages()
+	since, err := parseSince(c.QueryParam("since"))
+	if err != nil {
+		return nil, err
+	}
+
+	imageList, err := h.db(c.Context()).BootImages()
 	if err != nil {
 		return nil, fuego.HTTPError{
 			Err:    err,
@@ -60,14 +68,23 @@ func (h *Handler) BootImageList(c fuego.ContextNoBody) (model.BootImageList, err
 		}
 	}
 
-	return imageList, nil
+	if c.QueryParamBool("resolved") {
+		return resolveImages(imageList, imageList.UpdatedSince(since))
+	}
+
+	return imageList.UpdatedSince(since), nil
 }
 
 func (h *Handler) BootImageFind(c fuego.ContextNoBody) (model.BootImageList, error) {
+	since, err := parseSince(c.QueryParam("since"))
+	if err != nil {
+		return nil, err
+	}
+
 	// TODO: this should be handled in the DB
 	names := strings.Split(c.QueryParam("names"), ",")
 
-	images, err := h.DB.BootImages()
+	images, err := h.db(c.Context()).BootImages()
 	if err != nil {
 		return nil, fuego.HTTPError{
 			Err:    err,
@@ -83,19 +100,42 @@ func (h *Handler) BootImageFind(c fuego.ContextNoBody) (model.BootImageList, err
 		}
 	}
 
-	return imageList, nil
+	if c.QueryParamBool("resolved") {
+		return resolveImages(images, imageList.UpdatedSince(since))
+	}
+
+	return imageList.UpdatedSince(since), nil
+}
+
+// resolveImages returns the selected images with the fields they inherit from
+// their parents in images
+func resolveImages(images, selected model.BootImageList) (model.BootImageList, error) {
+	resolved := make(model.BootImageList, 0, len(selected))
+	for _, image := range selected {
+		r, err := images.Resolve(image.Name)
+		if err != nil {
+			return nil, fuego.HTTPError{
+				Err:    err,
+				Title:  "Error",
+				Detail: fmt.Sprintf("failed to resolve image %s: %s", image.Name, err),
+			}
+		}
+		resolved = append(resolved, r)
+	}
+
+	return resolved, nil
+}
+
+func (h *Handler) BootImageDeleted(c fuego.ContextNoBody) (model.TombstoneList, error) {
+	return h.tombstones(c.Context(), model.TombstoneKindImage, c.QueryParam("since"))
 }
 
 func (h *Handler) BootImageDelete(c fuego.ContextNoBody) (*GenericResponse, error) {
-	names := strings.Split(c.QueryParam("name"), ",")
+	names := strings.Split(c.QueryParam("names"), ",")
 
-	err := h.DB.DeleteBootImages(names)
+	err := h.db(c.Context()).DeleteBootImages(names)
 	if err != nil {
-		return nil, fuego.HTTPError{
-			Err:    err,
-			Title:  "Error",
-			Detail: "failed to delete images",
-		}
+		return nil, h.storeError(c.Context(), err, "failed to delete images")
 	}
 
 	h.writeEvent(c.Context(), "Success", fmt.Sprintf("Successfully deleted image(s): %s", names))
@@ -106,3 +146,83 @@ func (h *Handler) BootImageDelete(c fuego.ContextNoBody) (*GenericResponse, erro
 		Changed: len(names),
 	}, err
 }
+
+func (h *Handler) BootImageDiskUsage(c fuego.ContextNoBody) (*model.ImageDiskUsage, error) {
+	images, err := h.db(c.Context()).BootImages()
+	if err != nil {
+		return nil, fuego.HTTPError{
+			Err:    err,
+			Title:  "Error",
+			Detail: "failed to get images",
+		}
+	}
+
+	return imagegc.Usage(images), nil
+}
+
+func (h *Handler) BootImageGC(c fuego.ContextNoBody) (*model.ImageGCResult, error) {
+	retention, err := util.ParseDuration(config.Viper().GetString("image_retention"))
+	if err != nil {
+		return nil, fuego.HTTPError{
+			Err:    err,
+			Title:  "Error",
+			Detail: fmt.Sprintf("invalid image_retention: %s", err),
+		}
+	}
+
+	// A retention of 0 keeps the files of every previous image version
+	since := time.Time{}
+	if retention > 0 {
+		since = time.Now().Add(-retention)
+	}
+
+	// The image directories are shared by the namespaces, a file is kept
+	// while an image of any of them uses it
+	stores := []store.Store{h.db(c.Context())}
+	if h.Namespaces != nil {
+		stores = h.Namespaces.Stores()
+	}
+	referenced := make([]string, 0)
+	for _, db := range stores {
+		files, err := db.ImageFiles(since)
+		if err != nil {
+			return nil, fuego.HTTPError{
+				Err:    err,
+				Title:  "Error",
+				Detail: "failed to get image files",
+			}
+		}
+		referenced = append(referenced, files...)
+	}
+
+	remove := c.QueryParamBool("delete")
+	res, err := imagegc.Collect(config.Viper().GetStringSlice("image_dirs"), referenced, remove)
+	switch {
+	case errors.Is(err, imagegc.ErrNoDirs):
+		return nil, fuego.HTTPError{
+			Err:    err,
+			Status: http.StatusBadRequest,
+			Title:  "Error",
+			Detail: err.Error(),
+		}
+	case errors.Is(err, imagegc.ErrInFlight):
+		return nil, fuego.HTTPError{
+			Err:    err,
+			Status: http.StatusConflict,
+			Title:  "Conflict",
+			Detail: fmt.Sprintf("refusing to remove image files while %s, retry once the transfers are done", err),
+		}
+	case err != nil:
+		return nil, fuego.HTTPError{
+			Err:    err,
+			Title:  "Error",
+			Detail: fmt.Sprintf("failed to collect image files: %s", err),
+		}
+	}
+
+	if remove && len(res.Files) > 0 {
+		h.writeEvent(c.Context(), "Success", fmt.Sprintf("Removed %d unused image file(s), %d bytes", len(res.Files), res.Size))
+	}
+
+	return res, nil
+}
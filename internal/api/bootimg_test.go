@@ -0,0 +1,67 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package api
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-fuego/fuego"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/ubccr/grendel/internal/store/sqlstore"
+	"github.com/ubccr/grendel/pkg/model"
+)
+
+func TestBootImageInherits(t *testing.T) {
+	viper.Set("api.socket_path", "/tmp/grendel-test.sock")
+	defer viper.Set("api.socket_path", nil)
+
+	db, err := sqlstore.New(":memory:")
+	require.NoError(t, err)
+	defer db.Close()
+
+	require.NoError(t, db.StoreBootImages(model.BootImageList{
+		{Name: "rocky9", KernelPath: "/images/vmlinuz", InitrdPaths: []string{"/images/initrd.img"}, CommandLine: "console=ttyS0"},
+		{Name: "rocky9-gpu", Inherits: "rocky9", InitrdPaths: []string{"+/images/nvidia.img"}},
+	}))
+
+	h, err := NewHandler(db)
+	require.NoError(t, err)
+	s := &Server{}
+	fs := s.newFuegoServer(fuego.WithAddr("127.0.0.1:0"))
+	h.SetupRoutes(fs)
+
+	find := func(query string) model.BootImageList {
+		req := httptest.NewRequest(http.MethodGet, "/v1/images/find?names=rocky9-gpu"+query, nil)
+		rec := httptest.NewRecorder()
+		fs.Mux.ServeHTTP(rec, req)
+		require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+
+		var images model.BootImageList
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &images))
+		require.Len(t, images, 1)
+		return images
+	}
+
+	images := find("")
+	assert.Equal(t, "rocky9", images[0].Inherits)
+	assert.Equal(t, "", images[0].KernelPath)
+
+	images = find("&resolved=true")
+	assert.Equal(t, "", images[0].Inherits)
+	assert.Equal(t, "/images/vmlinuz", images[0].KernelPath)
+	assert.Equal(t, []string{"/images/initrd.img", "/images/nvidia.img"}, images[0].InitrdPaths)
+	assert.Equal(t, "console=ttyS0", images[0].CommandLine)
+
+	req := httptest.NewRequest(http.MethodDelete, "/v1/images?names=rocky9", nil)
+	rec := httptest.NewRecorder()
+	fs.Mux.ServeHTTP(rec, req)
+	assert.Equal(t, http.StatusConflict, rec.Code, rec.Body.String())
+	assert.Contains(t, rec.Body.String(), "rocky9 (inherited by rocky9-gpu)")
+}
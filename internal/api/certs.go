@@ -0,0 +1,110 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package api
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/go-fuego/fuego"
+	"github.com/ubccr/grendel/internal/certs"
+	"github.com/ubccr/grendel/pkg/model"
+	"github.com/ubccr/grendel/pkg/nodeset"
+)
+
+type CertRevokeRequest struct {
+	Serials []string `json:"serials" description:"serial numbers in hex of the certificates to revoke" example:"3f9a0c21"`
+	Nodeset string   `json:"nodeset" description:"revoke the client certificates of the nodes, nodes without one are skipped" example:"cpn-d13-[01-100]"`
+}
+
+// CertRevoke adds certificates to the revoked certificate list by serial
+// number or by the nodes they were issued to
+func (h *Handler) CertRevoke(c fuego.ContextWithBody[CertRevokeRequest]) (*GenericResponse, error) {
+	body, err := c.Body()
+	if err != nil {
+		return nil, fuego.HTTPError{
+			Err:    err,
+			Title:  "Error",
+			Detail: "failed to parse body",
+		}
+	}
+
+	if len(body.Serials) == 0 && body.Nodeset == "" {
+		return nil, fuego.HTTPError{
+			Err:    errors.New("missing serials or nodeset"),
+			Status: http.StatusBadRequest,
+			Title:  "Error",
+			Detail: "serials or nodeset is required",
+		}
+	}
+
+	revoked := make(model.RevokedCertList, 0, len(body.Serials))
+	for _, s := range body.Serials {
+		serial, err := certs.ParseSerial(s)
+		if err != nil {
+			return nil, fuego.HTTPError{
+				Err:    err,
+				Status: http.StatusBadRequest,
+				Title:  "Error",
+				Detail: err.Error(),
+			}
+		}
+		revoked = append(revoked, &model.RevokedCert{Serial: serial})
+	}
+
+	if body.Nodeset != "" {
+		ns, err := nodeset.NewNodeSet(body.Nodeset)
+		if err != nil {
+			return nil, fuego.HTTPError{
+				Err:    err,
+				Status: http.StatusBadRequest,
+				Title:  "Error",
+				Detail: "invalid nodeset",
+			}
+		}
+		hosts, err := h.db(c.Context()).FindHosts(ns)
+		if err != nil {
+			return nil, h.storeError(c.Context(), err, "failed to find nodes")
+		}
+		for _, host := range hosts {
+			if host.ClientCertSerial != "" {
+				revoked = append(revoked, &model.RevokedCert{Serial: host.ClientCertSerial, Host: host.Name})
+			}
+		}
+	}
+
+	n, err := h.db(c.Context()).RevokeCerts(revoked)
+	if err != nil {
+		return nil, h.storeError(c.Context(), err, "failed to revoke certificates")
+	}
+
+	// The provision server of this process rejects the certificates right
+	// away
+	list, err := h.db(c.Context()).RevokedCerts()
+	if err != nil {
+		return nil, h.storeError(c.Context(), err, "failed to load revoked certificates")
+	}
+	certs.Revocations.Set(list)
+
+	msg := fmt.Sprintf("Successfully revoked %d certificate(s)", n)
+	h.writeEvent(c.Context(), "Success", msg)
+
+	return &GenericResponse{
+		Title:   "Success",
+		Detail:  msg,
+		Changed: n,
+	}, nil
+}
+
+// CertRevokedList returns the revoked certificate list
+func (h *Handler) CertRevokedList(c fuego.ContextNoBody) (model.RevokedCertList, error) {
+	revoked, err := h.db(c.Context()).RevokedCerts()
+	if err != nil {
+		return nil, h.storeError(c.Context(), err, "failed to load revoked certificates")
+	}
+
+	return revoked, nil
+}
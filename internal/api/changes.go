@@ -0,0 +1,71 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package api
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/go-fuego/fuego"
+	"github.com/ubccr/grendel/pkg/model"
+)
+
+const (
+	// changesLimit is the default and maximum number of changes returned
+	changesLimit = 1000
+
+	// changesMaxWait is the longest a request waits for new changes
+	changesMaxWait = 60 * time.Second
+
+	// changesPollInterval is how often the journal is checked while waiting
+	changesPollInterval = 500 * time.Millisecond
+)
+
+// ChangeList returns the change journal entries following since_seq. If there
+// are none and wait is set the request is held until a change is made or wait
+// seconds pass.
+func (h *Handler) ChangeList(c fuego.ContextNoBody) (*model.ChangeFeed, error) {
+	since := int64(c.QueryParamInt("since_seq"))
+	limit := c.QueryParamInt("limit")
+	wait := time.Duration(c.QueryParamInt("wait")) * time.Second
+	if since < 0 || limit < 0 || wait < 0 {
+		return nil, fuego.HTTPError{
+			Err:    errors.New("negative query parameter"),
+			Status: http.StatusBadRequest,
+			Title:  "Error",
+			Detail: "since_seq, limit and wait must not be negative",
+		}
+	}
+	if limit == 0 || limit > changesLimit {
+		limit = changesLimit
+	}
+	wait = min(wait, changesMaxWait)
+
+	ctx := c.Context()
+	timeout := time.After(wait)
+	for {
+		feed, err := h.db(c.Context()).Changes(since, limit)
+		if err != nil {
+			return nil, fuego.HTTPError{
+				Err:    err,
+				Title:  "Error",
+				Detail: "failed to get changes",
+			}
+		}
+
+		if len(feed.Changes) > 0 || feed.Truncated || wait == 0 {
+			return feed, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return feed, nil
+		case <-timeout:
+			return feed, nil
+		case <-time.After(changesPollInterval):
+		}
+	}
+}
@@ -0,0 +1,67 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package api
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/ubccr/grendel/pkg/model"
+)
+
+func TestChangeListWait(t *testing.T) {
+	viper.Set("api.socket_path", "/tmp/grendel-test.sock")
+	defer viper.Set("api.socket_path", nil)
+
+	fs := newTestServer(t)
+
+	type result struct {
+		code int
+		feed model.ChangeFeed
+		took time.Duration
+	}
+	done := make(chan result)
+	go func() {
+		start := time.Now()
+		req := httptest.NewRequest(http.MethodGet, "/v1/changes?since_seq=0&wait=10", nil)
+		rec := httptest.NewRecorder()
+		fs.Mux.ServeHTTP(rec, req)
+
+		res := result{code: rec.Code, took: time.Since(start)}
+		json.Unmarshal(rec.Body.Bytes(), &res.feed)
+		done <- res
+	}()
+
+	// The waiting request returns as soon as a change is made
+	time.Sleep(2 * changesPollInterval)
+	req := httptest.NewRequest(http.MethodPost, "/v1/nodes", strings.NewReader(`{"node_list": [{"name": "cpn-01"}]}`))
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+	fs.Mux.ServeHTTP(rec, req)
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+
+	res := <-done
+	assert.Equal(t, http.StatusOK, res.code)
+	assert.Less(t, res.took, 5*time.Second)
+	if assert.Len(t, res.feed.Changes, 1) {
+		assert.Equal(t, "cpn-01", res.feed.Changes[0].Name)
+		assert.Equal(t, model.ChangeOpCreate, res.feed.Changes[0].Op)
+		assert.Equal(t, res.feed.Changes[0].Seq, res.feed.LatestSeq)
+	}
+
+	// Without changes the request returns empty once wait passes
+	req = httptest.NewRequest(http.MethodGet, "/v1/changes?since_seq=1&wait=1", nil)
+	rec = httptest.NewRecorder()
+	fs.Mux.ServeHTTP(rec, req)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Contains(t, rec.Body.String(), `"changes":[]`)
+}
@@ -0,0 +1,109 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package api
+
+import (
+	"crypto/x509"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/go-fuego/fuego"
+	"github.com/ubccr/grendel/internal/certs"
+	"github.com/ubccr/grendel/internal/config"
+	"github.com/ubccr/grendel/internal/util"
+)
+
+// defaultPickupTTL is how long client keys are held for pickup by default
+const defaultPickupTTL = time.Hour
+
+type NodeClientCertRequest struct {
+	Certs []NodeClientCert `json:"certs" validate:"required"`
+	TTL   string           `json:"ttl" description:"how long the keys are held for pickup, defaults to 1h" example:"1h"`
+}
+
+type NodeClientCert struct {
+	Name string `json:"name" validate:"required"`
+	Cert string `json:"cert" validate:"required" description:"PEM client certificate of the node, its fingerprint and serial number are stored on the node"`
+	Key  string `json:"key" description:"PEM key of the certificate, held in memory until the node fetches it once from the client-cert provision endpoint. Keys are never stored"`
+}
+
+// NodeClientCertSet stores the fingerprints of the client certificates of
+// nodes and holds their keys for pickup by the provision server
+func (h *Handler) NodeClientCertSet(c fuego.ContextWithBody[NodeClientCertRequest]) (*GenericResponse, error) {
+	body, err := c.Body()
+	if err != nil {
+		return nil, fuego.HTTPError{
+			Err:    err,
+			Title:  "Error",
+			Detail: "failed to parse body",
+		}
+	}
+
+	ttl := defaultPickupTTL
+	if body.TTL != "" {
+		ttl, err = util.ParseDuration(body.TTL)
+		if err != nil || ttl <= 0 {
+			return nil, fuego.HTTPError{
+				Err:    err,
+				Status: http.StatusBadRequest,
+				Title:  "Error",
+				Detail: fmt.Sprintf("invalid ttl: %s", body.TTL),
+			}
+		}
+	}
+
+	var ca *x509.Certificate
+	if file := config.Viper().GetString("provision.client_ca"); file != "" {
+		ca, err = certs.LoadCertificate(file)
+		if err != nil {
+			return nil, fuego.HTTPError{
+				Err:    err,
+				Title:  "Error",
+				Detail: fmt.Sprintf("failed to load provision.client_ca: %s", err),
+			}
+		}
+	}
+
+	fingerprints := make([]string, len(body.Certs))
+	serials := make([]string, len(body.Certs))
+	for i, cc := range body.Certs {
+		cert, err := certs.ParseCertificate([]byte(cc.Cert))
+		if err == nil && ca != nil {
+			err = cert.CheckSignatureFrom(ca)
+		}
+		if err != nil {
+			return nil, fuego.HTTPError{
+				Err:    err,
+				Status: http.StatusBadRequest,
+				Title:  "Error",
+				Detail: fmt.Sprintf("invalid client certificate of node %s: %s", cc.Name, err),
+			}
+		}
+		fingerprints[i] = certs.Fingerprint(cert)
+		serials[i] = certs.Serial(cert)
+	}
+
+	names := make([]string, 0, len(body.Certs))
+	expires := time.Now().Add(ttl)
+	for i, cc := range body.Certs {
+		if err := h.db(c.Context()).StoreHostClientCert(cc.Name, fingerprints[i], serials[i]); err != nil {
+			return nil, h.storeError(c.Context(), err, "failed to store client certificate fingerprint")
+		}
+		if cc.Key != "" {
+			certs.Pickups.Put(cc.Name, []byte(strings.TrimSpace(cc.Key)+"\n"+strings.TrimSpace(cc.Cert)+"\n"), expires)
+		}
+		names = append(names, cc.Name)
+	}
+
+	h.writeEvent(c.Context(), "Success", fmt.Sprintf("Successfully set client certificates of node(s): %s", strings.Join(names, ",")))
+
+	return &GenericResponse{
+		Title:   "Success",
+		Detail:  "successfully set client certificates of node(s)",
+		Changed: len(names),
+	}, nil
+}
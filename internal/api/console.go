@@ -0,0 +1,228 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package api
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-fuego/fuego"
+	"github.com/ubccr/grendel/internal/bmc"
+	"github.com/ubccr/grendel/internal/config"
+	"github.com/ubccr/grendel/internal/store"
+)
+
+// ConsoleProtocol is the Upgrade protocol of GET /v1/bmc/console. Once
+// switched the connection carries the raw serial console in both directions
+const ConsoleProtocol = "grendel-console"
+
+// consoleSession is the user attached to the console of a host
+type consoleSession struct {
+	user  string
+	since time.Time
+}
+
+// consoleSessions tracks the open console sessions, allowing one per host
+type consoleSessions struct {
+	mu       sync.Mutex
+	sessions map[string]consoleSession
+}
+
+func newConsoleSessions() *consoleSessions {
+	return &consoleSessions{sessions: make(map[string]consoleSession)}
+}
+
+// acquire attaches user to the console of host, failing with the current
+// session if it is in use
+func (c *consoleSessions) acquire(host, user string) (consoleSession, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if cur, ok := c.sessions[host]; ok {
+		return cur, false
+	}
+	c.sessions[host] = consoleSession{user: user, since: time.Now()}
+
+	return c.sessions[host], true
+}
+
+func (c *consoleSessions) release(host string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	delete(c.sessions, host)
+}
+
+// BmcConsole proxies the serial over LAN console of a host, so clients never
+// need the BMC credentials. The request is upgraded to ConsoleProtocol and
+// the console output is appended to bmc.console_log_dir/<host>.log when set.
+func (h *Handler) BmcConsole(w http.ResponseWriter, r *http.Request) {
+	name := r.URL.Query().Get("host")
+	if name == "" {
+		ErrorSerializer(w, r, fuego.HTTPError{
+			Err:    errors.New("missing host"),
+			Status: http.StatusBadRequest,
+			Title:  "Error",
+			Detail: "host is required",
+		})
+		return
+	}
+
+	if !strings.EqualFold(r.Header.Get("Upgrade"), ConsoleProtocol) {
+		ErrorSerializer(w, r, fuego.HTTPError{
+			Err:    fmt.Errorf("invalid upgrade protocol: %q", r.Header.Get("Upgrade")),
+			Status: http.StatusBadRequest,
+			Title:  "Error",
+			Detail: fmt.Sprintf("console requires Upgrade: %s", ConsoleProtocol),
+		})
+		return
+	}
+
+	host, err := h.namespaceDB(r.Context()).LoadHostFromName(name)
+	if err != nil {
+		status := http.StatusInternalServerError
+		if errors.Is(err, store.ErrNotFound) {
+			status = http.StatusNotFound
+		}
+		ErrorSerializer(w, r, fuego.HTTPError{
+			Err:    err,
+			Status: status,
+			Title:  "Error",
+			Detail: fmt.Sprintf("failed to find node %s", name),
+		})
+		return
+	}
+
+	// Requests on the unix socket are not authenticated
+	user, ok := r.Context().Value(ContextKeyUsername).(string)
+	if !ok {
+		user = r.URL.Query().Get("user")
+	}
+	if user == "" {
+		user = "unknown"
+	}
+
+	// Hosts of different namespaces may share a name
+	key := namespaceOf(r.Context()) + "/" + host.Name
+	session, ok := h.consoles.acquire(key, user)
+	if !ok {
+		ErrorSerializer(w, r, fuego.HTTPError{
+			Err:    fmt.Errorf("console of %s in use", host.Name),
+			Status: http.StatusConflict,
+			Title:  "Error",
+			Detail: fmt.Sprintf("console of %s is in use by %s since %s", host.Name, session.user, session.since.Format(time.RFC3339)),
+		})
+		return
+	}
+	defer h.consoles.release(key)
+
+	logFile, err := openConsoleLog(host.Name, user)
+	if err != nil {
+		ErrorSerializer(w, r, fuego.HTTPError{
+			Err:    err,
+			Title:  "Error",
+			Detail: fmt.Sprintf("failed to open console log of %s", host.Name),
+		})
+		return
+	}
+	if logFile != nil {
+		defer logFile.Close()
+	}
+
+	sol, err := bmc.NewJob(h.namespaceDB(r.Context())).Console(host)
+	if err != nil {
+		ErrorSerializer(w, r, fuego.HTTPError{
+			Err:    err,
+			Status: http.StatusBadGateway,
+			Title:  "Error",
+			Detail: fmt.Sprintf("failed to connect to console of %s: %s", host.Name, err),
+		})
+		return
+	}
+	defer sol.Close()
+
+	conn, rw, err := http.NewResponseController(w).Hijack()
+	if err != nil {
+		ErrorSerializer(w, r, fuego.HTTPError{
+			Err:    err,
+			Title:  "Error",
+			Detail: "failed to upgrade connection",
+		})
+		return
+	}
+	defer conn.Close()
+
+	// Sessions outlive the server read and write timeouts
+	_ = conn.SetDeadline(time.Time{})
+
+	_, err = fmt.Fprintf(rw, "HTTP/1.1 101 Switching Protocols\r\nConnection: Upgrade\r\nUpgrade: %s\r\n\r\n", ConsoleProtocol)
+	if err == nil {
+		err = rw.Flush()
+	}
+	if err != nil {
+		log.Errorf("failed to upgrade console connection of %s: %s", host.Name, err)
+		return
+	}
+
+	h.writeEvent(r.Context(), "Success", fmt.Sprintf("Opened console of %s", host.Name))
+	log.Infof("console of %s opened by %s", host.Name, user)
+
+	var out io.Writer = conn
+	if logFile != nil {
+		out = io.MultiWriter(conn, logFile)
+	}
+
+	done := make(chan struct{}, 2)
+	go func() {
+		_, _ = io.Copy(out, sol)
+		done <- struct{}{}
+	}()
+	go func() {
+		_, _ = io.Copy(sol, rw)
+		done <- struct{}{}
+	}()
+
+	// Either side closing ends the session
+	<-done
+	conn.Close()
+	sol.Close()
+	<-done
+
+	log.Infof("console of %s closed by %s", host.Name, user)
+}
+
+// openConsoleLog opens the session log of host in bmc.console_log_dir for
+// appending. Returns nil if console logging is disabled
+func openConsoleLog(host, user string) (*os.File, error) {
+	dir := config.Viper().GetString("bmc.console_log_dir")
+	if dir == "" {
+		return nil, nil
+	}
+
+	err := os.MkdirAll(dir, 0700)
+	if err != nil {
+		return nil, err
+	}
+
+	f, err := os.OpenFile(filepath.Join(dir, filepath.Base(host)+".log"), os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0600)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = fmt.Fprintf(f, "\n--- console opened by %s at %s ---\n", user, time.Now().Format(time.RFC3339))
+	if err != nil {
+		f.Close()
+		return nil, err
+	}
+
+	return f, nil
+}
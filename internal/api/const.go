@@ -4,11 +4,37 @@
 
 package api
 
+import "time"
+
 const (
 	DefaultPort = 8080
 
+	// DefaultStoreTimeout is the deadline of the datastore operations of a
+	// request unless api.store_timeout is set
+	DefaultStoreTimeout = 30 * time.Second
+
 	ContextKeyUsername GrendelAuthContext = "username"
 	ContextKeyRole     GrendelAuthContext = "role"
+
+	// ContextKeyStore holds the context of the datastore operations of a
+	// request, canceled at its deadline
+	ContextKeyStore GrendelAuthContext = "store"
+
+	// ContextKeyNamespace holds the namespace of a request, selected with
+	// NamespaceHeader
+	ContextKeyNamespace GrendelAuthContext = "namespace"
+
+	// ContextKeyScope holds the namespaces of the token of a request, any
+	// namespace when unset
+	ContextKeyScope GrendelAuthContext = "scope"
+
+	// NamespaceHeader names the namespace of a request, the default
+	// namespace or the only namespace of its token when unset
+	NamespaceHeader = "X-Grendel-Namespace"
+
+	// tokenPathPrefix is followed by the token of the BMC event receiver in
+	// the path, redacted from logs
+	tokenPathPrefix = "/v1/bmc/events/receive/"
 )
 
 type GrendelAuthContext string
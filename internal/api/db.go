@@ -5,12 +5,30 @@
 package api
 
 import (
+	"errors"
 	"fmt"
+	"net/http"
+	"time"
 
 	"github.com/go-fuego/fuego"
+	"github.com/ubccr/grendel/internal/store"
+	"github.com/ubccr/grendel/internal/store/backup"
 	"github.com/ubccr/grendel/pkg/model"
 )
 
+type DataLoadRequest struct {
+	Dump   model.DataDump `json:"dump"`
+	Prune  bool           `json:"prune"`
+	DryRun bool           `json:"dry_run"`
+}
+
+type DataLoadResponse struct {
+	Title   string             `json:"title"`
+	Detail  string             `json:"detail"`
+	Changed int                `json:"changed"`
+	Diff    model.DataDumpDiff `json:"diff"`
+}
+
 func (h *Handler) Restore(c fuego.ContextWithBody[model.DataDump]) (*GenericResponse, error) {
 	body, err := c.Body()
 	if err != nil {
@@ -21,7 +39,7 @@ func (h *Handler) Restore(c fuego.ContextWithBody[model.DataDump]) (*GenericResp
 		}
 	}
 
-	err = h.DB.RestoreFrom(body)
+	err = h.namespaceDB(c.Context()).RestoreFrom(body)
 	if err != nil {
 		return nil, fuego.HTTPError{
 			Err:    err,
@@ -40,7 +58,7 @@ func (h *Handler) Restore(c fuego.ContextWithBody[model.DataDump]) (*GenericResp
 }
 
 func (h *Handler) Dump(c fuego.ContextNoBody) (*model.DataDump, error) {
-	nodeList, err := h.DB.Hosts()
+	nodeList, err := h.namespaceDB(c.Context()).Hosts()
 	if err != nil {
 		return nil, fuego.HTTPError{
 			Err:    err,
@@ -48,7 +66,7 @@ func (h *Handler) Dump(c fuego.ContextNoBody) (*model.DataDump, error) {
 			Detail: "failed to restore db",
 		}
 	}
-	imageList, err := h.DB.BootImages()
+	imageList, err := h.namespaceDB(c.Context()).BootImages()
 	if err != nil {
 		return nil, fuego.HTTPError{
 			Err:    err,
@@ -56,7 +74,16 @@ func (h *Handler) Dump(c fuego.ContextNoBody) (*model.DataDump, error) {
 			Detail: "failed to restore db",
 		}
 	}
-	userList, err := h.DB.GetUsers()
+	userList, err := h.namespaceDB(c.Context()).GetUsers()
+	if err != nil {
+		return nil, fuego.HTTPError{
+			Err:    err,
+			Title:  "Error",
+			Detail: "failed to restore db",
+		}
+	}
+
+	recordList, err := h.namespaceDB(c.Context()).DNSRecords()
 	if err != nil {
 		return nil, fuego.HTTPError{
 			Err:    err,
@@ -66,10 +93,128 @@ func (h *Handler) Dump(c fuego.ContextNoBody) (*model.DataDump, error) {
 	}
 
 	dump := &model.DataDump{
-		Hosts:  nodeList,
-		Images: imageList,
-		Users:  userList,
+		Hosts:      nodeList,
+		Images:     imageList,
+		Users:      userList,
+		DNSRecords: recordList,
+	}
+
+	// Secrets stay encrypted with the credentials key of this server
+	if c.QueryParamBool("include_secrets") {
+		dump.Credentials, err = h.namespaceDB(c.Context()).Credentials()
+		if err != nil {
+			return nil, fuego.HTTPError{
+				Err:    err,
+				Title:  "Error",
+				Detail: "failed to get credentials",
+			}
+		}
 	}
+	dump.Sort()
 
 	return dump, nil
 }
+
+func (h *Handler) Backup(c fuego.ContextNoBody) (any, error) {
+	snap, err := backup.NewSnapshot(h.namespaceDB(c.Context()), Version)
+	if err != nil {
+		return nil, fuego.HTTPError{
+			Err:    err,
+			Title:  "Error",
+			Detail: "failed to snapshot db",
+		}
+	}
+	defer snap.Close()
+
+	w := c.Response()
+	w.Header().Set("Content-Type", "application/octet-stream")
+	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=grendel-backup-%s.tar.zst", snap.Manifest.Created.Format("20060102T150405Z")))
+	w.WriteHeader(http.StatusOK)
+
+	// The response has started so errors can only be logged, the client
+	// detects the truncated archive
+	if err := snap.WriteArchive(w); err != nil {
+		log.Errorf("failed to write db backup: %s", err)
+		return nil, nil
+	}
+
+	log.Infof("Database backup sent: hosts=%d images=%d users=%d", snap.Manifest.Counts.Hosts, snap.Manifest.Counts.Images, snap.Manifest.Counts.Users)
+	h.writeEvent(c.Context(), "Success", fmt.Sprintf("Backup of DB created at %s", snap.Manifest.Created.Format(time.RFC3339)))
+
+	return nil, nil
+}
+
+func (h *Handler) Load(c fuego.ContextWithBody[DataLoadRequest]) (*DataLoadResponse, error) {
+	body, err := c.Body()
+	if err != nil {
+		return nil, fuego.HTTPError{
+			Err:    err,
+			Title:  "Error",
+			Detail: fmt.Sprintf("failed to parse body: %s", err.Error()),
+		}
+	}
+
+	diff, err := h.namespaceDB(c.Context()).LoadFrom(body.Dump, body.Prune, body.DryRun)
+	if err != nil {
+		status := http.StatusInternalServerError
+		detail := "failed to load db"
+		switch {
+		case errors.Is(err, store.ErrConflict):
+			status = http.StatusConflict
+			detail = err.Error()
+		case errors.Is(err, store.ErrInvalidData):
+			status = http.StatusBadRequest
+			detail = err.Error()
+		}
+
+		return nil, fuego.HTTPError{
+			Err:    err,
+			Status: status,
+			Title:  "Error",
+			Detail: detail,
+		}
+	}
+
+	summary := fmt.Sprintf("hosts=+%d~%d-%d images=+%d~%d-%d dns_records=+%d~%d-%d",
+		len(diff.Hosts.Added), len(diff.Hosts.Updated), len(diff.Hosts.Removed),
+		len(diff.Images.Added), len(diff.Images.Updated), len(diff.Images.Removed),
+		len(diff.DNSRecords.Added), len(diff.DNSRecords.Updated), len(diff.DNSRecords.Removed))
+
+	if body.DryRun {
+		return &DataLoadResponse{
+			Title:  "Success",
+			Detail: "dry run, no changes applied: " + summary,
+			Diff:   *diff,
+		}, nil
+	}
+
+	if diff.Changed() > 0 {
+		log.Infof("Database loaded: %s", summary)
+		h.writeEvent(c.Context(), "Success", fmt.Sprintf("Successfully loaded DB: %s", summary))
+	}
+
+	return &DataLoadResponse{
+		Title:   "Success",
+		Detail:  "loaded db: " + summary,
+		Changed: diff.Changed(),
+		Diff:    *diff,
+	}, nil
+}
+
+func (h *Handler) Reindex(c fuego.ContextNoBody) (*GenericResponse, error) {
+	if err := h.namespaceDB(c.Context()).Reindex(); err != nil {
+		return nil, fuego.HTTPError{
+			Err:    err,
+			Title:  "Error",
+			Detail: "failed to rebuild db indexes",
+		}
+	}
+
+	log.Info("Database indexes rebuilt")
+	h.writeEvent(c.Context(), "Success", "Successfully rebuilt DB indexes")
+
+	return &GenericResponse{
+		Title:  "Success",
+		Detail: "rebuilt db indexes",
+	}, nil
+}
@@ -0,0 +1,186 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package api
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/netip"
+
+	"github.com/go-fuego/fuego"
+	"github.com/ubccr/grendel/internal/config"
+	"github.com/ubccr/grendel/internal/store"
+	"github.com/ubccr/grendel/pkg/model"
+)
+
+type DiscoverBMCAdoptRequest struct {
+	MAC  string `json:"mac" validate:"required"`
+	Host string `json:"host" validate:"required"`
+	IP   string `json:"ip" validate:"required" description:"Address of the BMC interface. The prefix length of the matching dhcp.subnets is used when omitted"`
+	FQDN string `json:"fqdn"`
+	Name string `json:"ifname"`
+}
+
+func (h *Handler) DiscoverBMCList(c fuego.ContextNoBody) (model.PendingBMCList, error) {
+	bmcs, err := h.db(c.Context()).PendingBMCs()
+	if err != nil {
+		return nil, fuego.HTTPError{
+			Err:    err,
+			Title:  "Error",
+			Detail: "failed to get pending bmcs",
+		}
+	}
+
+	return bmcs, nil
+}
+
+// DiscoverBMCAdopt attaches a pending BMC as the BMC interface of a host and
+// removes it from the pending BMCs
+func (h *Handler) DiscoverBMCAdopt(c fuego.ContextWithBody[DiscoverBMCAdoptRequest]) (*GenericResponse, error) {
+	body, err := c.Body()
+	if err != nil {
+		return nil, fuego.HTTPError{
+			Err:    err,
+			Title:  "Error",
+			Detail: fmt.Sprintf("failed to parse body: %s", err.Error()),
+		}
+	}
+
+	pending, err := h.db(c.Context()).LoadPendingBMC(body.MAC)
+	if err != nil {
+		return nil, h.storeError(c.Context(), err, fmt.Sprintf("failed to find pending bmc %s", body.MAC))
+	}
+
+	host, err := h.db(c.Context()).LoadHostFromName(body.Host)
+	if err != nil {
+		return nil, h.storeError(c.Context(), err, fmt.Sprintf("failed to find node %s", body.Host))
+	}
+
+	mac, err := net.ParseMAC(pending.MAC)
+	if err != nil {
+		return nil, fuego.HTTPError{
+			Err:    err,
+			Title:  "Error",
+			Detail: fmt.Sprintf("invalid MAC address of pending bmc %s", pending.MAC),
+		}
+	}
+
+	prefix, err := adoptPrefix(body.IP)
+	if err != nil {
+		return nil, fuego.HTTPError{
+			Err:    err,
+			Status: http.StatusBadRequest,
+			Title:  "Error",
+			Detail: err.Error(),
+		}
+	}
+
+	hosts, err := h.db(c.Context()).Hosts()
+	if err != nil {
+		return nil, fuego.HTTPError{
+			Err:    err,
+			Title:  "Error",
+			Detail: "failed to check addresses of nodes",
+		}
+	}
+	for _, other := range hosts {
+		for _, nic := range other.Interfaces {
+			conflict := ""
+			switch {
+			case nic.MAC.String() == mac.String():
+				conflict = fmt.Sprintf("MAC address %s is already assigned to node %s", mac, other.Name)
+			case nic.IP.IsValid() && nic.IP.Addr() == prefix.Addr():
+				conflict = fmt.Sprintf("IP address %s is already assigned to node %s", prefix.Addr(), other.Name)
+			}
+			if conflict != "" {
+				return nil, fuego.HTTPError{
+					Err:    errors.New(conflict),
+					Status: http.StatusConflict,
+					Title:  "Error",
+					Detail: conflict,
+				}
+			}
+		}
+	}
+
+	host.Interfaces = append(host.Interfaces, &model.NetInterface{
+		MAC:  mac,
+		Name: body.Name,
+		IP:   prefix,
+		FQDN: body.FQDN,
+		BMC:  true,
+	})
+
+	err = h.db(c.Context()).StoreHost(host)
+	if err != nil {
+		return nil, h.storeError(c.Context(), err, fmt.Sprintf("failed to add bmc interface to node %s", host.Name))
+	}
+
+	err = h.db(c.Context()).DeletePendingBMC(pending.MAC)
+	if err != nil && !errors.Is(err, store.ErrNotFound) {
+		return nil, fuego.HTTPError{
+			Err:    err,
+			Title:  "Error",
+			Detail: fmt.Sprintf("added bmc interface to node %s but failed to remove pending bmc %s", host.Name, pending.MAC),
+		}
+	}
+
+	h.writeEvent(c.Context(), "Success", fmt.Sprintf("Adopted pending BMC %s as interface %s of node %s", pending.MAC, prefix, host.Name))
+
+	return &GenericResponse{
+		Title:   "Success",
+		Detail:  fmt.Sprintf("successfully adopted bmc %s by node %s", pending.MAC, host.Name),
+		Changed: 1,
+	}, nil
+}
+
+func (h *Handler) DiscoverBMCDelete(c fuego.ContextNoBody) (*GenericResponse, error) {
+	mac := c.QueryParam("mac")
+	if mac == "" {
+		return nil, fuego.HTTPError{
+			Err:    errors.New("mac is required"),
+			Status: http.StatusBadRequest,
+			Title:  "Error",
+			Detail: "failed to delete pending bmc: mac is required",
+		}
+	}
+
+	err := h.db(c.Context()).DeletePendingBMC(mac)
+	if err != nil {
+		return nil, h.storeError(c.Context(), err, fmt.Sprintf("failed to delete pending bmc %s", mac))
+	}
+
+	h.writeEvent(c.Context(), "Success", fmt.Sprintf("Successfully deleted pending BMC %s", mac))
+
+	return &GenericResponse{
+		Title:   "Success",
+		Detail:  "successfully deleted pending bmc",
+		Changed: 1,
+	}, nil
+}
+
+// adoptPrefix parses the address of an adopted BMC. Addresses without a
+// prefix length take the prefix length of the dhcp.subnets containing them
+func adoptPrefix(ip string) (netip.Prefix, error) {
+	prefix, err := netip.ParsePrefix(ip)
+	if err == nil {
+		return prefix, nil
+	}
+
+	addr, err := netip.ParseAddr(ip)
+	if err != nil {
+		return netip.Prefix{}, fmt.Errorf("invalid IP address %q: expected an address or CIDR", ip)
+	}
+
+	for _, subnet := range config.Current().Subnets {
+		if subnet.Gateway.Masked().Contains(addr) {
+			return netip.PrefixFrom(addr, subnet.Gateway.Bits()), nil
+		}
+	}
+
+	return netip.Prefix{}, fmt.Errorf("IP address %s must include a prefix length when it is not inside any configured dhcp.subnets, e.g. %s/24", addr, addr)
+}
@@ -0,0 +1,95 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package api
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/go-fuego/fuego"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/ubccr/grendel/internal/store/sqlstore"
+	"github.com/ubccr/grendel/pkg/model"
+)
+
+func TestDiscoverBMC(t *testing.T) {
+	viper.Set("api.socket_path", "/tmp/grendel-test.sock")
+	defer viper.Set("api.socket_path", nil)
+
+	db, err := sqlstore.New(":memory:")
+	require.NoError(t, err)
+	h, err := NewHandler(db)
+	require.NoError(t, err)
+	fs := (&Server{}).newFuegoServer(fuego.WithAddr("127.0.0.1:0"))
+	h.SetupRoutes(fs)
+
+	do := func(method, url, body string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(method, url, strings.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		rec := httptest.NewRecorder()
+		fs.Mux.ServeHTTP(rec, req)
+		return rec
+	}
+
+	rec := do(http.MethodPost, "/v1/nodes", `{"node_list": [{"name": "cpn-01"}, {"name": "cpn-02", "interfaces": [{"mac": "d0:94:66:00:00:01", "ip": "10.65.8.21/24"}]}]}`)
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+
+	rec = do(http.MethodGet, "/v1/discover/bmc", "")
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	assert.JSONEq(t, `[]`, rec.Body.String())
+
+	rec = do(http.MethodPost, "/v1/discover/bmc/adopt", `{"mac": "d0:94:66:12:34:56", "host": "cpn-01", "ip": "10.65.8.22/24"}`)
+	assert.Equal(t, http.StatusNotFound, rec.Code, rec.Body.String())
+
+	for _, mac := range []string{"d0:94:66:12:34:56", "d0:94:66:00:00:01"} {
+		require.NoError(t, db.StorePendingBMC(&model.PendingBMC{MAC: mac, VendorClass: "iDRAC"}))
+	}
+
+	rec = do(http.MethodGet, "/v1/discover/bmc", "")
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	var bmcs model.PendingBMCList
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &bmcs))
+	assert.Len(t, bmcs, 2)
+
+	rec = do(http.MethodPost, "/v1/discover/bmc/adopt", `{"mac": "d0:94:66:12:34:56", "host": "cpn-03", "ip": "10.65.8.22/24"}`)
+	assert.Equal(t, http.StatusNotFound, rec.Code, rec.Body.String())
+
+	// Addresses assigned to another node conflict
+	for _, body := range []string{
+		`{"mac": "d0:94:66:12:34:56", "host": "cpn-01", "ip": "10.65.8.21/24"}`,
+		`{"mac": "d0:94:66:00:00:01", "host": "cpn-01", "ip": "10.65.8.22/24"}`,
+	} {
+		rec = do(http.MethodPost, "/v1/discover/bmc/adopt", body)
+		assert.Equal(t, http.StatusConflict, rec.Code, body)
+	}
+
+	// Addresses outside dhcp.subnets need a prefix length
+	rec = do(http.MethodPost, "/v1/discover/bmc/adopt", `{"mac": "d0:94:66:12:34:56", "host": "cpn-01", "ip": "10.65.8.22"}`)
+	assert.Equal(t, http.StatusBadRequest, rec.Code, rec.Body.String())
+
+	rec = do(http.MethodPost, "/v1/discover/bmc/adopt", `{"mac": "d0:94:66:12:34:56", "host": "cpn-01", "ip": "10.65.8.22/24", "fqdn": "bmc-cpn-01.example.com"}`)
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+
+	host, err := db.LoadHostFromName("cpn-01")
+	require.NoError(t, err)
+	if bmc := host.InterfaceBMC(); assert.NotNil(t, bmc) {
+		assert.Equal(t, "d0:94:66:12:34:56", bmc.MAC.String())
+		assert.Equal(t, "10.65.8.22/24", bmc.IP.String())
+	}
+
+	rec = do(http.MethodDelete, "/v1/discover/bmc?mac=d0:94:66:00:00:01", "")
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	rec = do(http.MethodDelete, "/v1/discover/bmc?mac=d0:94:66:00:00:01", "")
+	assert.Equal(t, http.StatusNotFound, rec.Code, rec.Body.String())
+
+	rec = do(http.MethodGet, "/v1/discover/bmc", "")
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	assert.JSONEq(t, `[]`, rec.Body.String())
+}
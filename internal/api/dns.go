@@ -0,0 +1,120 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package api
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/go-fuego/fuego"
+	"github.com/ubccr/grendel/internal/store"
+	"github.com/ubccr/grendel/pkg/model"
+)
+
+type DNSRecordAddRequest struct {
+	Records model.RecordList `json:"records"`
+}
+
+func (h *Handler) DNSRecordList(c fuego.ContextNoBody) (model.RecordList, error) {
+	records, err := h.db(c.Context()).DNSRecords()
+	if err != nil {
+		return nil, fuego.HTTPError{
+			Err:    err,
+			Title:  "Error",
+			Detail: "failed to get dns records",
+		}
+	}
+
+	if c.QueryParam("names") == "" {
+		return records, nil
+	}
+
+	names := strings.Split(strings.ToLower(c.QueryParam("names")), ",")
+	recordList := make(model.RecordList, 0)
+	for _, r := range records {
+		if slices.Contains(names, r.Name) {
+			recordList = append(recordList, r)
+		}
+	}
+
+	return recordList, nil
+}
+
+func (h *Handler) DNSRecordAdd(c fuego.ContextWithBody[DNSRecordAddRequest]) (*GenericResponse, error) {
+	body, err := c.Body()
+	if err != nil {
+		return nil, fuego.HTTPError{
+			Err:    err,
+			Title:  "Error",
+			Detail: fmt.Sprintf("failed to parse body: %s", err.Error()),
+		}
+	}
+
+	err = h.db(c.Context()).StoreDNSRecords(body.Records)
+	if err != nil {
+		status := http.StatusInternalServerError
+		detail := "failed to add dns record(s)"
+		switch {
+		case errors.Is(err, store.ErrConflict):
+			status = http.StatusConflict
+			detail = err.Error()
+		case errors.Is(err, store.ErrInvalidData):
+			status = http.StatusBadRequest
+			detail = err.Error()
+		}
+
+		return nil, fuego.HTTPError{
+			Err:    err,
+			Status: status,
+			Title:  "Error",
+			Detail: detail,
+		}
+	}
+
+	var names []string
+	for _, r := range body.Records {
+		names = append(names, r.Name)
+	}
+
+	h.writeEvent(c.Context(), "Success", fmt.Sprintf("Successfully saved dns record(s): %s", strings.Join(names, ",")))
+
+	return &GenericResponse{
+		Title:   "Success",
+		Detail:  "successfully added dns record(s)",
+		Changed: len(body.Records),
+	}, nil
+}
+
+func (h *Handler) DNSRecordDelete(c fuego.ContextNoBody) (*GenericResponse, error) {
+	if c.QueryParam("names") == "" {
+		return nil, fuego.HTTPError{
+			Err:    errors.New("names is required"),
+			Status: http.StatusBadRequest,
+			Title:  "Error",
+			Detail: "failed to delete dns records: names is required",
+		}
+	}
+	names := strings.Split(c.QueryParam("names"), ",")
+
+	err := h.db(c.Context()).DeleteDNSRecords(names)
+	if err != nil {
+		return nil, fuego.HTTPError{
+			Err:    err,
+			Title:  "Error",
+			Detail: "failed to delete dns records",
+		}
+	}
+
+	h.writeEvent(c.Context(), "Success", fmt.Sprintf("Successfully deleted dns record(s): %s", strings.Join(names, ",")))
+
+	return &GenericResponse{
+		Title:   "Success",
+		Detail:  "successfully deleted dns record(s)",
+		Changed: len(names),
+	}, nil
+}
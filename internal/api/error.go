@@ -5,13 +5,38 @@
 package api
 
 import (
+	"context"
 	"errors"
+	"fmt"
 	"net/http"
 
 	"github.com/go-fuego/fuego"
+	"github.com/ubccr/grendel/internal/store"
 )
 
 func ErrorHandler(err error) error {
+	// The deadline of the datastore set by storeDeadlineMiddleware was
+	// exceeded, the client may retry
+	if errors.Is(err, context.DeadlineExceeded) {
+		httpErr := fuego.HTTPError{Err: err}
+		errors.As(err, &httpErr)
+		httpErr.Status = http.StatusServiceUnavailable
+		httpErr.Title = http.StatusText(http.StatusServiceUnavailable)
+		return handleHTTPError(httpErr)
+	}
+
+	// A write refused by a read-only instance, the detail points to the
+	// primary accepting it
+	var roErr *store.ReadOnlyError
+	if errors.As(err, &roErr) {
+		httpErr := fuego.HTTPError{Err: err}
+		errors.As(err, &httpErr)
+		httpErr.Status = http.StatusForbidden
+		httpErr.Title = http.StatusText(http.StatusForbidden)
+		httpErr.Detail = roErr.Error()
+		return handleHTTPError(httpErr)
+	}
+
 	var errorStatus fuego.ErrorWithStatus
 	switch {
 	case errors.As(err, &fuego.HTTPError{}),
@@ -71,3 +96,53 @@ func ErrorSerializer(w http.ResponseWriter, r *http.Request, err error) {
 	w.WriteHeader(status)
 	_ = fuego.SendJSON(w, nil, err)
 }
+
+// storeError returns the HTTP error for an error returned when saving hosts
+// or images. Revision conflicts include the current record
+// so clients can merge their changes and retry.
+func (h *Handler) storeError(ctx context.Context, err error, detail string) fuego.HTTPError {
+	httpErr := fuego.HTTPError{
+		Err:    err,
+		Status: http.StatusInternalServerError,
+		Title:  "Error",
+		Detail: fmt.Sprintf("%s: %s", detail, err),
+	}
+
+	switch {
+	case errors.Is(err, store.ErrConflict):
+		httpErr.Status = http.StatusConflict
+	case errors.Is(err, store.ErrNotFound):
+		httpErr.Status = http.StatusNotFound
+	case errors.Is(err, store.ErrInvalidData):
+		httpErr.Status = http.StatusBadRequest
+	case errors.Is(err, store.ErrReadOnly):
+		httpErr.Status = http.StatusForbidden
+	}
+
+	var revErr *store.RevisionError
+	if !errors.As(err, &revErr) {
+		return httpErr
+	}
+
+	item := fuego.ErrorItem{
+		Name:   revErr.Name,
+		Reason: revErr.Error(),
+		More: map[string]any{
+			"expected": revErr.Expected,
+			"revision": revErr.Current,
+		},
+	}
+	switch revErr.Kind {
+	case "host":
+		if host, err := h.namespaceDB(ctx).LoadHostFromName(revErr.Name); err == nil {
+			item.More["current"] = host
+		}
+	case "image":
+		if image, err := h.namespaceDB(ctx).LoadBootImage(revErr.Name); err == nil {
+			item.More["current"] = image
+		}
+	}
+	httpErr.Errors = []fuego.ErrorItem{item}
+
+	return httpErr
+}
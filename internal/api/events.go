@@ -6,30 +6,199 @@ package api
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
 	"time"
 
 	"github.com/go-fuego/fuego"
+	"github.com/stmcginnis/gofish/schemas"
+	"github.com/ubccr/grendel/internal/bmc"
+	"github.com/ubccr/grendel/internal/store"
+	"github.com/ubccr/grendel/internal/store/namespace"
+	"github.com/ubccr/grendel/internal/webhook"
 	"github.com/ubccr/grendel/pkg/model"
 )
 
+// maxEventSize caps the size of the Redfish event payloads pushed by BMCs
+const maxEventSize = 1 << 20
+
+// GetEvents returns the events of the namespaces the token of the request is
+// scoped to
 func (h *Handler) GetEvents(c fuego.ContextNoBody) (model.EventList, error) {
-	return h.Events.GetEvents(), nil
+	events := h.Events.GetEvents()
+	if _, scoped := c.Context().Value(ContextKeyScope).([]string); !scoped {
+		return events, nil
+	}
+
+	list := make(model.EventList, 0, len(events))
+	for _, e := range events {
+		if inScope(c.Context(), e.Namespace) {
+			list = append(list, e)
+		}
+	}
+
+	return list, nil
 }
 
 func (h *Handler) writeEvent(ctx context.Context, severity, msg string, jobMessages ...model.JobMessage) {
-	username, ok := ctx.Value(ContextKeyUsername).(string)
+	newEvent, ok := eventFromContext(ctx, severity, msg, jobMessages...)
 	if !ok {
 		log.Warn("failed to get username from http context, ignoring writeEvent")
 		return
 	}
 
-	newEvent := model.Event{
+	h.Events.StoreEvents(newEvent)
+}
+
+// writeHostEvent writes an event about hosts and sends it to the webhooks
+// subscribed to eventType. Webhooks get the event even when the request has
+// no user, such as over the unix socket
+func (h *Handler) writeHostEvent(ctx context.Context, eventType string, hosts []string, severity, msg string, jobMessages ...model.JobMessage) {
+	newEvent, ok := eventFromContext(ctx, severity, msg, jobMessages...)
+	if ok {
+		h.Events.StoreEvents(newEvent)
+	} else {
+		log.Warn("failed to get username from http context, ignoring writeEvent")
+	}
+
+	webhook.Notify(eventType, hosts, newEvent)
+}
+
+// eventFromContext returns an event by the user of ctx, false when ctx has
+// no user
+func eventFromContext(ctx context.Context, severity, msg string, jobMessages ...model.JobMessage) (model.Event, bool) {
+	username, ok := ctx.Value(ContextKeyUsername).(string)
+
+	event := model.Event{
 		Severity:    severity,
 		User:        username,
 		Time:        time.Now().UTC(),
 		Message:     msg,
 		JobMessages: jobMessages,
 	}
+	if name := namespaceOf(ctx); name != namespace.Default {
+		event.Namespace = name
+	}
 
-	h.Events.StoreEvents(newEvent)
+	return event, ok
+}
+
+// BmcEventReceive receives the Redfish events pushed by the BMCs subscribed
+// with bmc subscribe. The path token identifies the host and is signed with
+// the credentials key, so BMCs need no API token. Events are added to the
+// event stream and critical events to the log of the host
+func (h *Handler) BmcEventReceive(w http.ResponseWriter, r *http.Request) {
+	uid, err := bmc.ParseEventToken(r.PathValue("token"))
+	if err != nil {
+		ErrorSerializer(w, r, fuego.HTTPError{
+			Err:    err,
+			Status: http.StatusUnauthorized,
+			Title:  "Unauthorized",
+			Detail: err.Error(),
+		})
+		return
+	}
+
+	ns, db, host, err := h.hostFromID(uid)
+	if err != nil {
+		status := http.StatusInternalServerError
+		if errors.Is(err, store.ErrNotFound) {
+			status = http.StatusNotFound
+		}
+		ErrorSerializer(w, r, fuego.HTTPError{
+			Err:    err,
+			Status: status,
+			Title:  "Error",
+			Detail: "failed to find node of event token",
+		})
+		return
+	}
+
+	data, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxEventSize))
+	if err != nil {
+		ErrorSerializer(w, r, fuego.HTTPError{
+			Err:    err,
+			Status: http.StatusBadRequest,
+			Title:  "Error",
+			Detail: "failed to read event",
+		})
+		return
+	}
+
+	entries, err := bmc.TranslateEvents(host.Name, data)
+	if err != nil {
+		ErrorSerializer(w, r, fuego.HTTPError{
+			Err:    err,
+			Status: http.StatusBadRequest,
+			Title:  "Error",
+			Detail: err.Error(),
+		})
+		return
+	}
+
+	critical := make(model.HostLogList, 0, len(entries))
+	for _, e := range entries {
+		h.Events.StoreEvents(model.Event{
+			Severity:  eventSeverity(e.Severity).String(),
+			User:      "bmc",
+			Time:      e.Time.UTC(),
+			Message:   fmt.Sprintf("%s: %s", host.Name, e.Message),
+			Namespace: ns,
+		})
+		if e.Severity == string(schemas.CriticalHealth) {
+			critical = append(critical, e)
+		}
+	}
+
+	if len(critical) > 0 {
+		err = db.StoreHostLog(critical)
+		if err != nil {
+			ErrorSerializer(w, r, fuego.HTTPError{
+				Err:    err,
+				Title:  "Error",
+				Detail: fmt.Sprintf("failed to store log of node %s", host.Name),
+			})
+			return
+		}
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// eventSeverity maps the severity of a Redfish event to the event stream
+func eventSeverity(severity string) model.Severity {
+	switch schemas.Health(severity) {
+	case schemas.CriticalHealth:
+		return model.SeverityError
+	case schemas.WarningHealth:
+		return model.SeverityWarning
+	}
+
+	return model.SeverityInfo
+}
+
+// hostFromID returns the host with the ID uid in any namespace, with the
+// name of its namespace, empty for the default namespace, and its datastore.
+// Host IDs are unique across the namespaces
+func (h *Handler) hostFromID(uid string) (string, store.Store, *model.Host, error) {
+	if h.Namespaces == nil {
+		host, err := h.DB.LoadHostFromID(uid)
+		return "", h.DB, host, err
+	}
+
+	for _, name := range h.Namespaces.Names() {
+		db, _ := h.Namespaces.Get(name)
+		host, err := db.LoadHostFromID(uid)
+		if errors.Is(err, store.ErrNotFound) {
+			continue
+		}
+		if name == namespace.Default {
+			name = ""
+		}
+		return name, db, host, err
+	}
+
+	return "", nil, nil, store.ErrNotFound
 }
@@ -0,0 +1,40 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package api
+
+import (
+	"time"
+
+	"github.com/go-fuego/fuego"
+	"github.com/ubccr/grendel/internal/config"
+	"github.com/ubccr/grendel/internal/ha"
+	"github.com/ubccr/grendel/pkg/model"
+)
+
+// GrendelHA lists the instances running in high availability mode. An
+// instance is alive when it has not stopped and its heartbeat is more recent
+// than ha.failover_timeout
+func (h *Handler) GrendelHA(c fuego.ContextNoBody) (model.HAInstanceList, error) {
+	instances, err := h.db(c.Context()).HAInstances()
+	if err != nil {
+		return nil, fuego.HTTPError{
+			Err:    err,
+			Title:  "Error",
+			Detail: "failed to list high availability instances",
+		}
+	}
+
+	timeout := config.Viper().GetDuration("ha.failover_timeout")
+	if timeout <= 0 {
+		timeout = ha.DefaultFailoverTimeout
+	}
+
+	now := time.Now()
+	for _, i := range instances {
+		i.Alive = i.State != model.HAStateStopped && now.Sub(i.Heartbeat) < timeout
+	}
+
+	return instances, nil
+}
@@ -11,19 +11,32 @@ import (
 	"github.com/go-fuego/fuego"
 	"github.com/go-fuego/fuego/option"
 	"github.com/go-fuego/fuego/param"
-	"github.com/spf13/viper"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"github.com/ubccr/grendel/internal/artifact"
+	"github.com/ubccr/grendel/internal/config"
+	"github.com/ubccr/grendel/internal/health"
 	"github.com/ubccr/grendel/internal/store"
 	"github.com/ubccr/grendel/internal/store/eventstore"
+	"github.com/ubccr/grendel/internal/store/namespace"
 )
 
 type Handler struct {
-	DB     store.Store
-	Events eventstore.Store
+	DB         store.Store
+	Events     *eventstore.Store
+	Artifacts  *artifact.Registry
+	Namespaces *namespace.Registry
+
+	consoles *consoleSessions
+	stats    *statsCache
 }
 
 func NewHandler(db store.Store) (*Handler, error) {
 	h := &Handler{
-		DB: db,
+		DB:        db,
+		Events:    eventstore.Default,
+		Artifacts: artifact.NewRegistry(artifact.Builtin()),
+		consoles:  newConsoleSessions(),
+		stats:     &statsCache{},
 	}
 
 	return h, nil
@@ -32,11 +45,21 @@ func NewHandler(db store.Store) (*Handler, error) {
 func (h *Handler) SetupRoutes(s *fuego.Server) {
 
 	// enable frontend if api is listening on tcp socket
-	if viper.IsSet("api.listen") {
+	if config.Viper().IsSet("api.listen") {
 		fuego.Handle(s, "/ui/", setupFrontend())
 		fuego.Handle(s, "/{$}", http.RedirectHandler("/ui", http.StatusMovedPermanently))
 	}
 
+	// Probes for load balancers and monitoring, without authentication
+	fuego.GetStd(s, "/healthz", health.Live,
+		option.Description("Liveness probe, always 200 while the process is up"),
+		option.Hide(),
+	)
+	fuego.GetStd(s, "/readyz", health.Ready,
+		option.Description("Readiness probe, 503 when the datastore or any enabled service fails its check"),
+		option.Hide(),
+	)
+
 	// Examples
 	nsExample := param.Example("nodeset", "cpn-i10-[04-05],cpn-h22-33")
 	usernamesExample := option.Path("usernames", "target usernames", param.Example("usernames", "user1,user2"))
@@ -44,9 +67,13 @@ func (h *Handler) SetupRoutes(s *fuego.Server) {
 	// Params
 	filterNodes := fuego.GroupOptions(option.Query("nodeset", "Filter by nodeset. Minimum of one query parameter is required", nsExample), option.Query("tags", "Filter by tags. Minimum of one query parameter is required", param.Example("tags", "a01,ib,test")))
 	filterNames := fuego.GroupOptions(option.Query("names", "Filter by name", param.Example("names", "image1,image2")))
+	filterSince := option.Query("since", "Only return entries added or updated at or after the RFC3339 time", param.Example("since", "2026-10-01T00:00:00Z"))
+	deletedSince := option.Query("since", "Only return entries deleted at or after the RFC3339 time", param.Example("since", "2026-10-01T00:00:00Z"))
+	filterResolved := option.QueryBool("resolved", "Return the images with the fields inherited from their parents")
 
 	globalOptions := fuego.GroupOptions(
 		option.RequestContentType("application/json"),
+		option.Middleware(validateMiddleware(s.OpenAPI.Description())),
 		fuego.OptionRemoveResponse(400),
 		fuego.OptionRemoveResponse(500),
 		fuego.OptionAddDefaultResponse("Default Error", fuego.Response{Type: fuego.HTTPError{}, ContentTypes: []string{"application/json"}}),
@@ -68,12 +95,46 @@ func (h *Handler) SetupRoutes(s *fuego.Server) {
 	bmc := fuego.Group(v1, "/bmc", option.Middleware(h.authMiddleware), globalOptions)
 	roles := fuego.Group(v1, "/roles", option.Middleware(h.authMiddleware), globalOptions)
 	sw := fuego.Group(v1, "/switch", option.Middleware(h.authMiddleware), globalOptions)
+	dnsRecords := fuego.Group(v1, "/dns/records", option.Middleware(h.authMiddleware), globalOptions)
+	changes := fuego.Group(v1, "/changes", option.Middleware(h.authMiddleware), globalOptions)
+	artifacts := fuego.Group(v1, "/artifacts", option.Middleware(h.authMiddleware), globalOptions)
+	discover := fuego.Group(v1, "/discover", option.Middleware(h.authMiddleware), globalOptions)
+	certificates := fuego.Group(v1, "/certs", option.Middleware(h.authMiddleware), globalOptions)
+	secrets := fuego.Group(v1, "/secrets", option.Middleware(h.authMiddleware), globalOptions)
+	schedule := fuego.Group(v1, "/schedule", option.Middleware(h.authMiddleware), globalOptions)
 
 	// Routes
 	fuego.Get(grendel, "/events", h.GetEvents)
+	fuego.Get(grendel, "/ha", h.GrendelHA,
+		option.Description("List the grendel serve instances running in high availability mode and their DHCP state"),
+	)
+	fuego.Get(grendel, "/keys", h.GrendelKeys,
+		option.Description("List the keys verifying boot and API tokens, the primary key of each kind signs new tokens. Secrets are never returned"),
+	)
+	fuego.Post(grendel, "/keys/rotate", h.GrendelKeysRotate,
+		option.Description("Generate new primary keys signing boot and API tokens. The previous keys keep verifying tokens for the grace period, then retire"),
+	)
+	fuego.Get(grendel, "/listeners", h.GrendelListeners,
+		option.Description("List the sockets bound by the services of the grendel serve process running the API and their address family"),
+	)
+	fuego.Get(grendel, "/maintenance", h.GrendelMaintenance,
+		option.Description("Get the maintenance mode pausing provisioning"),
+	)
+	fuego.Put(grendel, "/maintenance", h.GrendelMaintenanceSet,
+		option.Description("Turn maintenance mode on or off. While on DHCP leaves out the boot file and zero touch provisioning options and the provision endpoints return 503, static address assignment and DNS continue normally"),
+	)
+	fuego.Get(grendel, "/readonly", h.GrendelReadOnly,
+		option.Description("Get whether the grendel serve process running the API is in read-only mode. Writes to the data store are then refused with 403 naming the primary instance"),
+	)
+	fuego.Post(grendel, "/reload", h.GrendelReload,
+		option.Description("Reload the configuration file. Settings such as listen addresses only change after a restart"),
+	)
+	fuego.Get(grendel, "/stats", h.GrendelStats,
+		option.Description("Summary of the hosts by provision state, boot image and tag and of the recent DHCP, provision and DNS activity of the grendel serve process running the API. Cached for 10 seconds"),
+	)
 
 	fuego.Post(nodes, "", h.NodeAdd, option.Description("Add nodes"))
-	fuego.Get(nodes, "", h.NodeList, option.Description("List all nodes"))
+	fuego.Get(nodes, "", h.NodeList, option.Description("List all nodes"), filterSince)
 	fuego.Delete(nodes, "", h.NodeDelete,
 		option.Description("Delete nodes by nodeset and/or tags"),
 		filterNodes,
@@ -81,11 +142,36 @@ func (h *Handler) SetupRoutes(s *fuego.Server) {
 	fuego.Get(nodes, "/find", h.NodeFind,
 		option.Description("Find nodes by nodeset and/or tags"),
 		filterNodes,
+		option.Query("switch", "Filter by switch the node interfaces are connected to", param.Example("switch", "swd13")),
+		option.QueryInt("port", "Filter by switch port the node interfaces are connected to", param.Example("port", 12)),
+		option.Query("bios_version", "Filter by BIOS version in the firmware inventory", param.Example("bios_version", "2.19.0")),
+		option.Query("bmc_firmware", "Filter by BMC firmware version in the firmware inventory", param.Example("bmc_firmware", "7.00.00.171")),
+		option.Query("nic_firmware", "Filter by firmware version of any network adapter in the firmware inventory", param.Example("nic_firmware", "22.31.6")),
+		option.Query("state", "Filter by comma separated lifecycle states", param.Example("state", "production,drained")),
+		filterSince,
+	)
+	fuego.Get(nodes, "/deleted", h.NodeDeleted,
+		option.Description("List the names of deleted nodes, including the old names of renamed nodes. Entries are kept for tombstone_retention"),
+		deletedSince,
+	)
+	fuego.Get(nodes, "/log", h.NodeLog,
+		option.Description("List the log of node(s), oldest first. The log holds the critical events pushed by the BMCs, up to 200 entries per node"),
+		filterNodes,
+	)
+	fuego.Get(nodes, "/prometheus-sd", h.NodePrometheusSD,
+		option.Description("List nodes as Prometheus http_sd targets labeled with their name, boot image and tags. Key value tags such as rack=a01 become labels"),
+		filterNodes,
+		option.QueryInt("port", "Port of the targets, defaults to 9100", param.Example("port", 9100)),
+		option.QueryBool("exclude_provision", "Leave out nodes set to provision, which are being reinstalled"),
 	)
 	fuego.Patch(nodes, "/provision", h.NodeProvision,
 		option.Description("Provision / Unprovision nodes by nodeset and/or tags"),
 		filterNodes,
 	)
+	fuego.Patch(nodes, "/state", h.NodeState,
+		option.Description("Set the lifecycle state of nodes by nodeset and/or tags. Returns 409 if a node may not move to the state"),
+		filterNodes,
+	)
 	fuego.Patch(nodes, "/tags/{action}", h.NodeTags,
 		option.Description("Update nodes tags by nodeset and/or tags"),
 		option.Path("action", "option to add or remove tags", param.Example("action", "add | remove")),
@@ -96,15 +182,88 @@ func (h *Handler) SetupRoutes(s *fuego.Server) {
 		option.Path("interface", "interface token will be created for", param.Example("interface", "boot | bmc")),
 		filterNodes,
 	)
+	fuego.Post(nodes, "/token/inspect", h.NodeTokenInspect,
+		option.Description("Decode a boot token showing the host, MAC, issue and expiry times"),
+	)
+	fuego.Post(nodes, "/token/revoke", h.NodeTokenRevoke,
+		option.Description("Revoke a boot token. Revoked tokens are rejected by the provision server until they expire"),
+	)
 	fuego.Patch(nodes, "/image", h.NodeBootImage,
-		option.Description("Update nodes boot image by nodeset and/or tags"),
+		option.Description("Update nodes boot image by nodeset and/or tags. With only_if_current the nodes are changed only if each is assigned one of the images, else 409 Conflict is answered"),
 		filterNodes,
 	)
 
+	fuego.Patch(nodes, "/firmware", h.NodeFirmware,
+		option.Description("Update nodes firmware by nodeset and/or tags. The firmware overrides the firmware of the client architecture, the firmware.tags and the firmware.images config. An empty firmware clears it"),
+		filterNodes,
+	)
+
+	fuego.Patch(nodes, "/rename", h.NodeRename,
+		option.Description("Rename a node and optionally rewrite its interface FQDNs"),
+	)
+	fuego.Get(nodes, "/trash", h.NodeTrashList,
+		option.Description("List deleted nodes in the trash"),
+	)
+	fuego.Post(nodes, "/trash/restore", h.NodeTrashRestore,
+		option.Description("Restore deleted nodes from the trash by nodeset"),
+		option.Query("nodeset", "Nodes to restore", nsExample, param.Required()),
+	)
+	fuego.Delete(nodes, "/trash", h.NodeTrashPurge,
+		option.Description("Permanently delete nodes from the trash"),
+		option.Query("older_than", "Purge nodes deleted longer ago than the duration", param.Example("older_than", "30d")),
+		option.QueryBool("all", "Purge all nodes in the trash"),
+	)
+	fuego.Get(nodes, "/credentials", h.NodeCredentialList,
+		option.Description("List the kinds of credentials stored for nodes by nodeset and/or tags. Secrets are never returned"),
+		filterNodes,
+	)
+	fuego.Put(nodes, "/credentials", h.NodeCredentialSet,
+		option.Description("Set the encrypted credentials of nodes by nodeset and/or tags"),
+		filterNodes,
+	)
+	fuego.Delete(nodes, "/credentials", h.NodeCredentialDelete,
+		option.Description("Delete the credentials of nodes by nodeset and/or tags"),
+		filterNodes,
+		option.Query("kind", "Kind of credentials, defaults to bmc", param.Example("kind", "bmc")),
+	)
+	fuego.Get(nodes, "/files", h.NodeFileList,
+		option.Description("List the files attached to nodes by nodeset and/or tags, without their content"),
+		filterNodes,
+	)
+	fuego.Put(nodes, "/files", h.NodeFileSet,
+		option.Description("Attach a file to nodes by nodeset and/or tags, served to the nodes while provisioning at /boot/<token>/extra/<name>. Files are limited to 512 KiB"),
+		filterNodes,
+	)
+	fuego.Delete(nodes, "/files", h.NodeFileDelete,
+		option.Description("Delete the files with the given name of nodes by nodeset and/or tags"),
+		filterNodes,
+		option.Query("name", "Name of the file", param.Required(), param.Example("name", "opa.conf")),
+	)
+	fuego.Post(nodes, "/nextip", h.NodeNextIP,
+		option.Description("Return the lowest free addresses of a subnet, skipping the addresses of nodes, nodes in the trash and DNS records, the network, broadcast and gateway addresses, unexpired reservations and DHCP conflicts. With reserve the addresses are reserved for the duration"),
+	)
+	fuego.Get(nodes, "/reservations", h.NodeReservationList,
+		option.Description("List the address reservations and DHCP conflicts, expired ones included"),
+	)
+	fuego.Put(nodes, "/client-cert", h.NodeClientCertSet,
+		option.Description("Store the SHA-256 fingerprints of the client certificates of nodes, checked by the provision server against the client certificates nodes present. Keys sent along are held in memory until the node fetches them once from /boot/{token}/client-cert of the provision server or the ttl passes"),
+	)
+
 	fuego.Post(images, "", h.BootImageAdd, option.Description("Add images"))
-	fuego.Get(images, "", h.BootImageList, option.Description("List all images"))
+	fuego.Get(images, "", h.BootImageList, option.Description("List all images"), filterSince, filterResolved)
 	fuego.Delete(images, "", h.BootImageDelete, option.Description("Delete images by name"), filterNames)
-	fuego.Get(images, "/find", h.BootImageFind, option.Description("Find images by name"), filterNames)
+	fuego.Get(images, "/find", h.BootImageFind, option.Description("Find images by name"), filterNames, filterSince, filterResolved)
+	fuego.Get(images, "/deleted", h.BootImageDeleted,
+		option.Description("List the names of deleted images. Entries are kept for tombstone_retention"),
+		deletedSince,
+	)
+	fuego.Get(images, "/du", h.BootImageDiskUsage,
+		option.Description("Report the disk space used by the kernel, initrds and signatures of each image. Files shared by images, through symlinks or hard links, are counted once in the total"),
+	)
+	fuego.Post(images, "/gc", h.BootImageGC,
+		option.Description("List the files of image_dirs used neither by an image nor by a version of one replaced within image_retention. With delete the files are removed, refused with 409 while the provision or TFTP server is sending a file"),
+		option.QueryBool("delete", "Remove the files instead of listing them"),
+	)
 
 	fuego.Post(users, "", h.UserStore, option.Description("Add new user"))
 	fuego.Get(users, "", h.UserList, option.Description("List all users"), option.Query("usernames", "Filter by usernames", param.Example("username", "admin,user")))
@@ -143,16 +302,112 @@ func (h *Handler) SetupRoutes(s *fuego.Server) {
 	)
 
 	fuego.Post(db, "/restore", h.Restore, option.Description("Restore a backup of the DB"))
-	fuego.Get(db, "/dump", h.Dump, option.Description("Get a backup of the DB"))
+	fuego.Get(db, "/dump", h.Dump,
+		option.Description("Get a backup of the DB"),
+		option.QueryBool("include_secrets", "Include the encrypted credentials of nodes"),
+	)
+	fuego.Post(db, "/load", h.Load, option.Description("Make the hosts, images and DNS records in the DB match a dump"))
+	fuego.Get(db, "/backup", h.Backup,
+		option.Description("Stream a consistent point in time backup archive of the DB"),
+		binaryResponse("Backup archive", "application/octet-stream"),
+	)
+	fuego.Post(db, "/reindex", h.Reindex, option.Description("Rebuild the DB indexes used for host lookups"))
 
 	fuego.Get(bmc, "", h.BmcQuery,
 		option.Description("Get redfish info from node(s)"),
 		filterNodes,
 	)
+	fuego.Get(bmc, "/power", h.BmcPowerStatus,
+		option.Description("Get the power state of node(s) as on, off or unknown in data and the redfish power state in msg"),
+		filterNodes,
+		option.QueryInt("fanout", "Number of BMCs queried at once, defaults to bmc.fanout", param.Example("fanout", 20)),
+		option.QueryInt("timeout", "Seconds allowed for each BMC, defaults to bmc.timeout", param.Example("timeout", 60)),
+	)
 	fuego.Post(bmc, "/power/os", h.BmcOsPower,
 		option.Description("Change power status of node(s)"),
 		filterNodes,
 	)
+	fuego.Post(bmc, "/boot", h.BmcBootOverride,
+		option.Description("Set the redfish boot source override of node(s) for the next boot, or every boot if persistent, and verify it was applied"),
+		filterNodes,
+	)
+	fuego.Post(bmc, "/inventory", h.BmcHardware,
+		option.Description("Collect the hardware of node(s) from their BMCs, store it on each node and report the changes from the previous collection"),
+		filterNodes,
+		option.QueryInt("fanout", "Number of BMCs queried at once, defaults to bmc.fanout", param.Example("fanout", 20)),
+		option.QueryInt("timeout", "Seconds allowed for each BMC, defaults to bmc.timeout", param.Example("timeout", 60)),
+	)
+	fuego.Get(bmc, "/bios", h.BmcBiosGet,
+		option.Description("Get the current BIOS attributes of node(s)"),
+		filterNodes,
+		option.QueryInt("fanout", "Number of BMCs queried at once, defaults to bmc.fanout", param.Example("fanout", 20)),
+		option.QueryInt("timeout", "Seconds allowed for each BMC, defaults to bmc.timeout", param.Example("timeout", 60)),
+	)
+	fuego.Get(bmc, "/bios/diff", h.BmcBiosDiff,
+		option.Description("List the BIOS attributes of node(s) which differ from a BIOS profile in bmc.bios_profiles"),
+		filterNodes,
+		option.Query("profile", "Name of the BIOS profile", param.Required(), param.Example("profile", "compute")),
+		option.QueryInt("fanout", "Number of BMCs queried at once, defaults to bmc.fanout", param.Example("fanout", 20)),
+		option.QueryInt("timeout", "Seconds allowed for each BMC, defaults to bmc.timeout", param.Example("timeout", 60)),
+	)
+	fuego.Post(bmc, "/bios", h.BmcBiosApply,
+		option.Description("Set the BIOS attributes of node(s) which differ from a BIOS profile to apply on the next reboot and report the nodes requiring a reboot"),
+		filterNodes,
+	)
+	fuego.Post(bmc, "/fwupdate", h.BmcFirmwareUpdate,
+		option.Description("Update the firmware of node(s) with the Redfish UpdateService, wait for the update task and verify the new version. Nodes already on the version are skipped"),
+		filterNodes,
+	)
+	fuego.PostStd(bmc, "/fwupdate/image", h.BmcFirmwareUpload,
+		option.Description("Upload a firmware image for POST /v1/bmc/fwupdate. The request body is the image"),
+		option.Query("name", "File name of the image", param.Required()),
+		option.Hide(),
+	)
+	fuego.Post(bmc, "/rotate-password", h.BmcRotatePassword,
+		option.Description("Set a new random password on the BMC of node(s), verify the BMC accepts it and store it as the BMC credentials of the node(s). The previous password is restored on failure and nodes flagged attention may no longer match their stored credentials"),
+		filterNodes,
+	)
+	fuego.Post(bmc, "/subscriptions", h.BmcSubscriptionsCreate,
+		option.Description("Subscribe the BMC of node(s) to Redfish events pushed to the event receiver under bmc.event_url and tag them bmc-events. Stale subscriptions to the receiver are replaced"),
+		filterNodes,
+		option.QueryInt("fanout", "Number of BMCs queried at once, defaults to bmc.fanout", param.Example("fanout", 20)),
+		option.QueryInt("timeout", "Seconds allowed for each BMC, defaults to bmc.timeout", param.Example("timeout", 60)),
+	)
+	fuego.Get(bmc, "/subscriptions", h.BmcSubscriptionsStatus,
+		option.Description("Check the BMC of node(s) has an event subscription to the event receiver"),
+		filterNodes,
+		option.QueryInt("fanout", "Number of BMCs queried at once, defaults to bmc.fanout", param.Example("fanout", 20)),
+		option.QueryInt("timeout", "Seconds allowed for each BMC, defaults to bmc.timeout", param.Example("timeout", 60)),
+	)
+	fuego.PostStd(v1, "/bmc/events/receive/{token}", h.BmcEventReceive,
+		option.Description("Receive the Redfish events pushed by subscribed BMCs. The token is issued by bmc subscribe"),
+		option.Hide(),
+	)
+	fuego.Get(bmc, "/vmedia", h.BmcVirtualMediaStatus,
+		option.Description("Get the image inserted in the virtual CD of node(s) in msg and inserted or ejected in data"),
+		filterNodes,
+		option.QueryInt("fanout", "Number of BMCs queried at once, defaults to bmc.fanout", param.Example("fanout", 20)),
+		option.QueryInt("timeout", "Seconds allowed for each BMC, defaults to bmc.timeout", param.Example("timeout", 60)),
+	)
+	fuego.Post(bmc, "/vmedia", h.BmcVirtualMediaMount,
+		option.Description("Insert an ISO image in the virtual CD of node(s), optionally boot from it and power cycle"),
+		filterNodes,
+	)
+	fuego.Delete(bmc, "/vmedia", h.BmcVirtualMediaUnmount,
+		option.Description("Eject the virtual CD of node(s)"),
+		filterNodes,
+		option.QueryInt("fanout", "Number of BMCs queried at once, defaults to bmc.fanout", param.Example("fanout", 20)),
+		option.QueryInt("timeout", "Seconds allowed for each BMC, defaults to bmc.timeout", param.Example("timeout", 60)),
+	)
+	fuego.GetStd(bmc, "/console", h.BmcConsole,
+		option.Description("Attach to the serial over LAN console of a node. The connection is upgraded to the raw console stream"),
+		option.Query("host", "Name of the node", param.Required()),
+		option.Hide(),
+	)
+	fuego.GetStd(bmc, "/prometheus", promhttp.Handler().ServeHTTP,
+		option.Description("Prometheus metrics of the server, including the sensors polled from the BMCs of nodes tagged bmc-monitor"),
+		option.Hide(),
+	)
 	fuego.Post(bmc, "/power/bmc", h.BmcPower,
 		option.Description("Reboot node(s) BMC"),
 		filterNodes,
@@ -204,6 +459,91 @@ func (h *Handler) SetupRoutes(s *fuego.Server) {
 		option.Query("ports", "Filter by port name", param.Example("ports", "Et1,Et2")),
 		// filterNodes,
 	)
+	fuego.Post(sw, "/{nodeset}/scan", h.SwitchScan,
+		option.Description("Scan switch MAC address tables and store the switch and port of matching node interfaces"),
+	)
+	fuego.Get(sw, "/{nodeset}/verify", h.SwitchVerify,
+		option.Description("Compare switch LLDP neighbors with the switch and port stored on node interfaces"),
+	)
+	fuego.Post(sw, "/{nodeset}/verify", h.SwitchVerifyUpdate,
+		option.Description("Compare switch LLDP neighbors with the switch and port stored on node interfaces and store the observed switch and port of mismatched or unmapped interfaces"),
+	)
+
+	filterRecords := option.Query("names", "Filter by record name", param.Example("names", "vip.example.com,printer.example.com"))
+	fuego.Get(dnsRecords, "", h.DNSRecordList,
+		option.Description("List DNS only records"),
+		filterRecords,
+	)
+	fuego.Post(dnsRecords, "", h.DNSRecordAdd,
+		option.Description("Add DNS only records. Records conflicting with node interfaces or other records are rejected"),
+	)
+	fuego.Delete(dnsRecords, "", h.DNSRecordDelete,
+		option.Description("Delete DNS only records by name"),
+		filterRecords,
+	)
+
+	fuego.Get(changes, "", h.ChangeList,
+		option.Description("List the changes made to nodes, images and DNS records following a sequence number. Consumers store the seq of the last change processed and pass it as since_seq, a change may be received again if the consumer fails before storing it. Entries are kept for change_retention, truncated is set if changes following since_seq were purged and the consumer must resync from a full dump"),
+		option.QueryInt("since_seq", "Only return changes with a greater sequence number", param.Example("since_seq", 12345)),
+		option.QueryInt("limit", "Maximum number of changes to return, defaults to and is capped at 1000", param.Example("limit", 100)),
+		option.QueryInt("wait", "Seconds to wait for a change if there are none, capped at 60", param.Example("wait", 30)),
+	)
+
+	fuego.Get(artifacts, "", h.ArtifactList,
+		option.Description("List the artifacts served at /v1/artifacts/{name}, files such as /etc/hosts or genders derived from the nodes, defined in api.artifacts"),
+	)
+	fuego.GetStd(artifacts, "/{name}", h.ArtifactGet,
+		option.Description("Get an artifact as text. The ETag changes with the change journal and If-None-Match is answered with 304. With since_rev, the X-Grendel-Revision of a previous response, hosts artifacts only return the blocks of the nodes changed since"),
+		option.Path("name", "Name of the artifact", param.Example("name", "hosts")),
+		option.Query("since_rev", "Only return the blocks of the nodes changed after this revision", param.Example("since_rev", "12345")),
+		option.Hide(),
+	)
+
+	fuego.Get(discover, "/bmc", h.DiscoverBMCList,
+		option.Description("List the BMCs seen on DHCP from MAC addresses not registered to any node, recorded when dhcp.bmc_discovery is enabled"),
+	)
+	fuego.Post(discover, "/bmc/adopt", h.DiscoverBMCAdopt,
+		option.Description("Attach a pending BMC as the BMC interface of a node"),
+	)
+	fuego.Delete(discover, "/bmc", h.DiscoverBMCDelete,
+		option.Description("Delete a pending BMC. It is recorded again if it keeps requesting an address"),
+		option.Query("mac", "MAC address of the pending BMC", param.Required(), param.Example("mac", "d0:94:66:12:34:56")),
+	)
+
+	fuego.Post(certificates, "/revoke", h.CertRevoke,
+		option.Description("Revoke certificates issued by the Grendel CA by serial number or the client certificates of nodes by nodeset. Revoked client certificates are rejected by the provision server and listed in the CRL generated by grendel certs crl"),
+	)
+	fuego.Get(certificates, "/revoked", h.CertRevokedList,
+		option.Description("List the revoked certificates"),
+	)
+
+	fuego.Get(secrets, "", h.SecretList,
+		option.Description("List the secrets read by provision templates with {{ secret \"name\" }}. Values are never returned"),
+	)
+	fuego.Put(secrets, "/{name}", h.SecretSet,
+		option.Description("Set a secret, encrypted with the credentials key. A one-time secret is rendered once per boot token, later requests made with the token get a placeholder"),
+		option.Path("name", "Name of the secret", param.Example("name", "luks-passphrase")),
+	)
+	fuego.Delete(secrets, "/{name}", h.SecretDelete,
+		option.Description("Delete a secret"),
+		option.Path("name", "Name of the secret", param.Example("name", "luks-passphrase")),
+	)
+
+	fuego.Get(schedule, "", h.ScheduleList,
+		option.Description("List the actions scheduled to run on nodes at a later time"),
+		option.Query("status", "Filter by comma separated statuses: pending, running, done, partial, failed, cancelled or missed", param.Example("status", "pending")),
+	)
+	fuego.Get(schedule, "/{id}", h.ScheduleGet,
+		option.Description("Get a scheduled action and, once it ran, the result of each node"),
+		option.Path("id", "ID of the scheduled action", param.Example("id", "1")),
+	)
+	fuego.Post(schedule, "", h.ScheduleAdd,
+		option.Description("Schedule an action to run on nodes at a later time: set the boot image, set provision, power cycle through the BMC or send the schedule.run webhook event. Actions due while grendel serve was down run on startup unless due for longer than schedule.grace_period"),
+	)
+	fuego.Delete(schedule, "/{id}", h.ScheduleCancel,
+		option.Description("Cancel a pending scheduled action"),
+		option.Path("id", "ID of the scheduled action", param.Example("id", "1")),
+	)
 
 	fuego.Get(roles, "", h.GetRoles,
 		option.Description("Get roles and permissions"),
@@ -8,28 +8,48 @@ import (
 	"errors"
 
 	"github.com/golang-jwt/jwt/v5"
+	"github.com/ubccr/grendel/pkg/model"
 )
 
 const (
 	TokenUsername = "username"
 	TokenRole     = "role"
 	TokenExpire   = "exp"
+
+	// TokenNamespaces are the namespaces a token is scoped to, any
+	// namespace when unset
+	TokenNamespaces = "namespaces"
 )
 
 type claims struct {
-	username string
-	role     string
+	username   string
+	role       string
+	namespaces []string
 }
 
-func NewToken(claims jwt.MapClaims, signingKey string) (string, error) {
-	return jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString([]byte(signingKey))
+// NewToken returns a token signed with key, its ID in the kid header
+func NewToken(claims jwt.MapClaims, key *model.SigningKey) (string, error) {
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
+	token.Header["kid"] = key.ID
+
+	return token.SignedString([]byte(key.Secret))
 }
 
-func ParseToken(tokenString, signingKey string) (*claims, error) {
+// ParseToken verifies a token with the key named by its kid header, or any
+// of keys for tokens signed before a rotation or without the header
+func ParseToken(tokenString string, keys model.SigningKeyList) (*claims, error) {
 	parser := jwt.NewParser(jwt.WithValidMethods([]string{"HS256"}))
 
 	token, err := parser.Parse(tokenString, func(t *jwt.Token) (interface{}, error) {
-		return []byte(signingKey), nil
+		set := jwt.VerificationKeySet{}
+		for _, key := range keys {
+			if kid, _ := t.Header["kid"].(string); kid != "" && kid == key.ID {
+				return []byte(key.Secret), nil
+			}
+			set.Keys = append(set.Keys, []byte(key.Secret))
+		}
+
+		return set, nil
 	})
 	if err != nil {
 		return nil, err
@@ -52,8 +72,24 @@ func ParseToken(tokenString, signingKey string) (*claims, error) {
 		return nil, errors.New("failed to parse token claims")
 	}
 
+	var namespaces []string
+	if raw, ok := rawClaims[TokenNamespaces]; ok {
+		list, ok := raw.([]interface{})
+		if !ok || len(list) == 0 {
+			return nil, errors.New("failed to parse token namespaces")
+		}
+		for _, n := range list {
+			name, ok := n.(string)
+			if !ok || name == "" {
+				return nil, errors.New("failed to parse token namespaces")
+			}
+			namespaces = append(namespaces, name)
+		}
+	}
+
 	return &claims{
-		username: username,
-		role:     role,
+		username:   username,
+		role:       role,
+		namespaces: namespaces,
 	}, nil
 }
@@ -13,8 +13,9 @@ import (
 	"github.com/ubccr/grendel/pkg/model"
 )
 
-const (
-	signingKey = "secret-signing-key"
+var (
+	signingKey = &model.SigningKey{ID: model.SigningKeyID("secret-signing-key"), Secret: "secret-signing-key"}
+	keys       = model.SigningKeyList{signingKey}
 )
 
 func TestJwtClaims(t *testing.T) {
@@ -27,7 +28,7 @@ func TestJwtClaims(t *testing.T) {
 	token, err := NewToken(claims, signingKey)
 	assert.Nil(err)
 
-	tokenClaims, err := ParseToken(token, signingKey)
+	tokenClaims, err := ParseToken(token, keys)
 	assert.Nil(err)
 
 	assert.Equal(tokenClaims.username, "test-user")
@@ -45,12 +46,12 @@ func TestJwtExpire(t *testing.T) {
 	token, err := NewToken(claims, signingKey)
 	assert.Nil(err)
 
-	_, err = ParseToken(token, signingKey)
+	_, err = ParseToken(token, keys)
 	assert.Nil(err)
 
 	time.Sleep(time.Second)
 
-	_, err = ParseToken(token, signingKey)
+	_, err = ParseToken(token, keys)
 	assert.ErrorIs(err, jwt.ErrTokenExpired)
 }
 
@@ -64,7 +65,7 @@ func TestJwtChangeSecret(t *testing.T) {
 	token, err := NewToken(claims, signingKey)
 	assert.Nil(err)
 
-	_, err = ParseToken(token, "different-key")
+	_, err = ParseToken(token, model.SigningKeyList{{ID: model.SigningKeyID("different-key"), Secret: "different-key"}})
 	assert.ErrorIs(err, jwt.ErrSignatureInvalid)
 }
 
@@ -72,6 +73,53 @@ func TestJwtInvalidAlg(t *testing.T) {
 	assert := assert.New(t)
 
 	noneToken := "eyJhbGciOiJub25lIn0.eyJ1c2VybmFtZSI6InRlc3QiLCJyb2xlIjoiZGlzYWJsZWQifQ."
-	_, err := ParseToken(noneToken, signingKey)
+	_, err := ParseToken(noneToken, keys)
 	assert.ErrorIs(err, jwt.ErrTokenSignatureInvalid)
 }
+
+func TestJwtRotatedSecret(t *testing.T) {
+	assert := assert.New(t)
+
+	claims := jwt.MapClaims{
+		TokenUsername: "test-user",
+		TokenRole:     model.RoleAdmin.String(),
+	}
+	token, err := NewToken(claims, signingKey)
+	assert.Nil(err)
+
+	primary := &model.SigningKey{ID: model.SigningKeyID("new-key"), Secret: "new-key"}
+	_, err = ParseToken(token, model.SigningKeyList{primary, signingKey})
+	assert.Nil(err)
+
+	// Tokens without the kid header are verified with every key
+	legacy, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString([]byte(signingKey.Secret))
+	assert.Nil(err)
+	_, err = ParseToken(legacy, model.SigningKeyList{primary, signingKey})
+	assert.Nil(err)
+
+	_, err = ParseToken(token, model.SigningKeyList{primary})
+	assert.ErrorIs(err, jwt.ErrSignatureInvalid)
+}
+
+func TestJwtNamespaces(t *testing.T) {
+	assert := assert.New(t)
+
+	claims := jwt.MapClaims{
+		TokenUsername:   "test-user",
+		TokenRole:       model.RoleAdmin.String(),
+		TokenNamespaces: []string{"cluster-a", "cluster-b"},
+	}
+	token, err := NewToken(claims, signingKey)
+	assert.Nil(err)
+
+	tokenClaims, err := ParseToken(token, keys)
+	assert.Nil(err)
+	assert.Equal([]string{"cluster-a", "cluster-b"}, tokenClaims.namespaces)
+
+	claims[TokenNamespaces] = []int{1}
+	token, err = NewToken(claims, signingKey)
+	assert.Nil(err)
+
+	_, err = ParseToken(token, keys)
+	assert.Error(err)
+}
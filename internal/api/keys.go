@@ -0,0 +1,105 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package api
+
+import (
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/go-fuego/fuego"
+	"github.com/ubccr/grendel/internal/keyring"
+	"github.com/ubccr/grendel/internal/util"
+	"github.com/ubccr/grendel/pkg/model"
+)
+
+type SigningKeyRotateRequest struct {
+	Kind  string `json:"kind" description:"kind of keys to rotate: provision for boot tokens, api for API tokens, both when empty" example:"provision"`
+	Grace string `json:"grace" description:"how long the previous keys keep verifying tokens, defaults to provision.token_ttl for provision keys and 8h for api keys" example:"2h"`
+}
+
+// GrendelKeys returns the keys verifying boot and API tokens, the primary
+// key of each kind first
+func (h *Handler) GrendelKeys(c fuego.ContextNoBody) (model.SigningKeyList, error) {
+	return signingKeys(), nil
+}
+
+// GrendelKeysRotate generates new primary signing keys. Other instances
+// using the data store pick them up within keyring.DefaultWatchInterval
+func (h *Handler) GrendelKeysRotate(c fuego.ContextWithBody[SigningKeyRotateRequest]) (model.SigningKeyList, error) {
+	body, err := c.Body()
+	if err != nil {
+		return nil, fuego.HTTPError{
+			Err:    err,
+			Title:  "Error",
+			Detail: "failed to parse body",
+		}
+	}
+
+	kinds := keyring.Kinds
+	if body.Kind != "" {
+		if !slices.Contains(keyring.Kinds, body.Kind) {
+			return nil, fuego.HTTPError{
+				Status: http.StatusBadRequest,
+				Title:  "Error",
+				Detail: fmt.Sprintf("invalid kind: %s. Valid kinds: provision, api", body.Kind),
+			}
+		}
+		kinds = []string{body.Kind}
+	}
+
+	grace := make(map[string]time.Duration, len(kinds))
+	for _, kind := range kinds {
+		grace[kind] = keyring.DefaultGrace(kind)
+		if body.Grace != "" {
+			grace[kind], err = util.ParseDuration(body.Grace)
+		}
+		if err != nil || grace[kind] <= 0 {
+			detail := fmt.Sprintf("invalid grace: %s", body.Grace)
+			if body.Grace == "" {
+				detail = fmt.Sprintf("boot tokens never expire with provision.token_ttl 0, a grace period is required to retire the %s keys", kind)
+			}
+			return nil, fuego.HTTPError{
+				Err:    err,
+				Status: http.StatusBadRequest,
+				Title:  "Error",
+				Detail: detail,
+			}
+		}
+	}
+
+	ids := make([]string, 0, len(kinds))
+	for _, kind := range kinds {
+		key, err := keyring.Rotate(h.db(c.Context()), kind, grace[kind])
+		if err != nil {
+			return nil, fuego.HTTPError{
+				Err:    err,
+				Title:  "Error",
+				Detail: fmt.Sprintf("failed to rotate the %s keys: %s", kind, err),
+			}
+		}
+		ids = append(ids, fmt.Sprintf("%s %s", kind, key.ID))
+	}
+
+	h.writeEvent(c.Context(), "Success", fmt.Sprintf("Rotated signing keys: %s", strings.Join(ids, ", ")))
+
+	return signingKeys(), nil
+}
+
+// signingKeys returns the keys of all kinds with the primary key marked
+func signingKeys() model.SigningKeyList {
+	list := make(model.SigningKeyList, 0)
+	for _, kind := range keyring.Kinds {
+		for i, k := range model.SigningKeys(kind) {
+			copied := *k
+			copied.Primary = i == 0
+			list = append(list, &copied)
+		}
+	}
+
+	return list
+}
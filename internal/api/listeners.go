@@ -0,0 +1,17 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package api
+
+import (
+	"github.com/go-fuego/fuego"
+	"github.com/ubccr/grendel/internal/listeners"
+	"github.com/ubccr/grendel/pkg/model"
+)
+
+// GrendelListeners lists the sockets bound by the services running in the
+// grendel serve process of the API server
+func (h *Handler) GrendelListeners(c fuego.ContextNoBody) (model.ListenerList, error) {
+	return listeners.List(), nil
+}
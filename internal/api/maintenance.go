@@ -0,0 +1,73 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package api
+
+import (
+	"time"
+
+	"github.com/go-fuego/fuego"
+	"github.com/ubccr/grendel/internal/maintenance"
+	"github.com/ubccr/grendel/pkg/model"
+)
+
+type MaintenanceRequest struct {
+	Enabled bool   `json:"enabled"`
+	Reason  string `json:"reason,omitempty" description:"shown by grendel status and logged with every suppressed provisioning request" example:"firmware upgrade"`
+}
+
+// GrendelMaintenance returns the maintenance mode stored in the data store,
+// shared by all instances using it
+func (h *Handler) GrendelMaintenance(c fuego.ContextNoBody) (*model.Maintenance, error) {
+	m, err := h.db(c.Context()).LoadMaintenance()
+	if err != nil {
+		return nil, fuego.HTTPError{
+			Err:    err,
+			Title:  "Error",
+			Detail: "failed to load maintenance mode",
+		}
+	}
+
+	return m, nil
+}
+
+// GrendelMaintenanceSet turns maintenance mode on or off. Other instances
+// using the data store pick the change up within maintenance.DefaultWatchInterval
+func (h *Handler) GrendelMaintenanceSet(c fuego.ContextWithBody[MaintenanceRequest]) (*model.Maintenance, error) {
+	body, err := c.Body()
+	if err != nil {
+		return nil, fuego.HTTPError{
+			Err:    err,
+			Title:  "Error",
+			Detail: "failed to parse maintenance body",
+		}
+	}
+
+	username, _ := c.Context().Value(ContextKeyUsername).(string)
+	m := &model.Maintenance{
+		Enabled:   body.Enabled,
+		Reason:    body.Reason,
+		ChangedBy: username,
+		Changed:   time.Now(),
+	}
+
+	if err := maintenance.Set(h.db(c.Context()), m); err != nil {
+		return nil, fuego.HTTPError{
+			Err:    err,
+			Title:  "Error",
+			Detail: "failed to store maintenance mode",
+		}
+	}
+
+	msg := "Maintenance mode off, provisioning resumed"
+	if m.Enabled {
+		msg = "Maintenance mode on, provisioning paused"
+		if m.Reason != "" {
+			msg += ": " + m.Reason
+		}
+	}
+	h.writeEvent(c.Context(), "Success", msg)
+
+	return m, nil
+}
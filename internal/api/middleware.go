@@ -10,17 +10,25 @@ import (
 	"net/http"
 	"slices"
 	"strings"
+	"time"
 
 	"github.com/go-fuego/fuego"
 	"github.com/rs/cors"
-	"github.com/spf13/viper"
+	"github.com/ubccr/grendel/internal/accesslog"
+	"github.com/ubccr/grendel/internal/config"
+	"github.com/ubccr/grendel/internal/ratelimit"
+	"github.com/ubccr/grendel/internal/store"
+	"github.com/ubccr/grendel/internal/store/namespace"
+	"github.com/ubccr/grendel/pkg/model"
 )
 
 func (h *Handler) authMiddleware(next http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		// skip auth if bound to unix socket
-		if viper.IsSet("api.socket_path") {
-			next.ServeHTTP(w, r)
+		if config.Viper().IsSet("api.socket_path") {
+			if r, ok := h.withNamespace(w, r, nil); ok {
+				next.ServeHTTP(w, r)
+			}
 			return
 		}
 
@@ -47,7 +55,7 @@ func (h *Handler) authMiddleware(next http.Handler) http.Handler {
 
 		token := strings.TrimPrefix(rawToken, "Bearer ")
 
-		claims, err := ParseToken(token, viper.GetString("api.secret"))
+		claims, err := ParseToken(token, model.SigningKeys(model.SigningKeyAPI))
 		if err != nil {
 			err := fuego.HTTPError{
 				Status: http.StatusBadRequest,
@@ -60,7 +68,7 @@ func (h *Handler) authMiddleware(next http.Handler) http.Handler {
 			return
 		}
 
-		user, err := h.DB.GetUserByName(claims.username)
+		user, err := h.db(r.Context()).GetUserByName(claims.username)
 		if err != nil {
 			err := fuego.HTTPError{
 				Status: http.StatusBadRequest,
@@ -85,7 +93,7 @@ func (h *Handler) authMiddleware(next http.Handler) http.Handler {
 			return
 		}
 
-		validRoles, err := h.DB.GetRolesByRoute(r.Method, r.URL.Path)
+		validRoles, err := h.db(r.Context()).GetRolesByRoute(r.Method, r.URL.Path)
 		if err != nil {
 			err := fuego.HTTPError{
 				Status: http.StatusInternalServerError,
@@ -98,7 +106,7 @@ func (h *Handler) authMiddleware(next http.Handler) http.Handler {
 			return
 		}
 
-		if (!slices.Contains(*validRoles, claims.role) || !slices.Contains(*validRoles, user.Role)) && !viper.GetBool("api.dev") {
+		if (!slices.Contains(*validRoles, claims.role) || !slices.Contains(*validRoles, user.Role)) && !config.Viper().GetBool("api.dev") {
 
 			err := fuego.HTTPError{
 				Status: http.StatusForbidden,
@@ -114,19 +122,222 @@ func (h *Handler) authMiddleware(next http.Handler) http.Handler {
 		ctx := r.Context()
 		ctx = context.WithValue(ctx, ContextKeyUsername, claims.username)
 		ctx = context.WithValue(ctx, ContextKeyRole, claims.role)
+		if claims.namespaces != nil {
+			ctx = context.WithValue(ctx, ContextKeyScope, claims.namespaces)
+		}
 		r = r.WithContext(ctx)
 
-		next.ServeHTTP(w, r)
+		if r, ok := h.withNamespace(w, r, claims.namespaces); ok {
+			next.ServeHTTP(w, r)
+		}
 	})
 }
 
+// withNamespace returns r with the namespace named by its NamespaceHeader,
+// which must be one of scope when scope is set. Without the header the only
+// namespace of scope is used, or else the default namespace. Requests naming
+// an unknown namespace or one outside of scope are answered with an error
+func (h *Handler) withNamespace(w http.ResponseWriter, r *http.Request, scope []string) (*http.Request, bool) {
+	name := r.Header.Get(NamespaceHeader)
+	if name == "" {
+		switch {
+		case len(scope) == 1:
+			name = scope[0]
+		case len(scope) > 1:
+			ErrorSerializer(w, r, fuego.HTTPError{
+				Err:    fmt.Errorf("no namespace selected with a token scoped to %s", strings.Join(scope, ",")),
+				Status: http.StatusBadRequest,
+				Title:  "Error",
+				Detail: fmt.Sprintf("the token is scoped to namespaces %s, select one with the %s header", strings.Join(scope, ", "), NamespaceHeader),
+			})
+			return r, false
+		default:
+			name = namespace.Default
+		}
+	}
+
+	if scope != nil && !slices.Contains(scope, name) {
+		err := fuego.HTTPError{
+			Err:    fmt.Errorf("token scoped to namespaces %s used for namespace %s", strings.Join(scope, ","), name),
+			Status: http.StatusForbidden,
+			Title:  "Error",
+			Detail: fmt.Sprintf("the token is not scoped to namespace %s", name),
+		}
+		ErrorSerializer(w, r, err)
+		log.Error(err.Unwrap().Error())
+		return r, false
+	}
+
+	if _, ok := h.namespaceStore(name); !ok {
+		ErrorSerializer(w, r, fuego.HTTPError{
+			Err:    fmt.Errorf("namespace %s not found", name),
+			Status: http.StatusNotFound,
+			Title:  "Error",
+			Detail: fmt.Sprintf("namespace %s is not defined in namespaces", name),
+		})
+		return r, false
+	}
+
+	return r.WithContext(context.WithValue(r.Context(), ContextKeyNamespace, name)), true
+}
+
+// namespaceStore returns the datastore of the namespace named name
+func (h *Handler) namespaceStore(name string) (store.Store, bool) {
+	if h.Namespaces == nil {
+		return h.DB, name == "" || name == namespace.Default
+	}
+
+	return h.Namespaces.Get(name)
+}
+
+// namespaceDB returns the datastore of the namespace of the request of ctx
+// without the deadline of Handler.db, for the jobs outliving the request
+func (h *Handler) namespaceDB(ctx context.Context) store.Store {
+	name, _ := ctx.Value(ContextKeyNamespace).(string)
+	if db, ok := h.namespaceStore(name); ok {
+		return db
+	}
+
+	return h.DB
+}
+
+// namespaceOf returns the namespace of the request of ctx
+func namespaceOf(ctx context.Context) string {
+	if name, ok := ctx.Value(ContextKeyNamespace).(string); ok {
+		return name
+	}
+
+	return namespace.Default
+}
+
+// inScope returns whether the token of the request of ctx is scoped to the
+// namespace named name
+func inScope(ctx context.Context, name string) bool {
+	scope, ok := ctx.Value(ContextKeyScope).([]string)
+	if name == "" {
+		name = namespace.Default
+	}
+
+	return !ok || slices.Contains(scope, name)
+}
+
+// storeDeadlineMiddleware sets the deadline of the datastore operations of a
+// request made with Handler.db. Requests failing once the deadline is exceeded
+// are answered with 503 Service Unavailable by ErrorHandler. A timeout of 0
+// sets no deadline
+func storeDeadlineMiddleware(timeout time.Duration) func(h http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			// The deadline only applies to the datastore, the request
+			// context is left as is for streams and long running jobs
+			ctx, cancel := store.WithTimeout(r.Context(), timeout)
+			defer cancel()
+
+			rec := &statusRecorder{ResponseWriter: w}
+			next.ServeHTTP(rec, r.WithContext(context.WithValue(r.Context(), ContextKeyStore, ctx)))
+			if rec.status == http.StatusServiceUnavailable {
+				store.DeadlineExceeded(ctx, "api", ctx.Err())
+			}
+		})
+	}
+}
+
+// db returns the datastore of the namespace of the request of ctx, with the
+// deadline set by storeDeadlineMiddleware
+func (h *Handler) db(ctx context.Context) store.Store {
+	db := h.namespaceDB(ctx)
+	if storeCtx, ok := ctx.Value(ContextKeyStore).(context.Context); ok {
+		return db.WithContext(storeCtx)
+	}
+
+	return db
+}
+
 func logMiddleware(next http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		log.Debugf("api request: method=%s route=%s ip=%s", r.Method, r.URL, r.RemoteAddr)
+		log.Debugf("api request: method=%s route=%s ip=%s", r.Method, accesslog.RedactPath(r.URL.Path, tokenPathPrefix), r.RemoteAddr)
 		next.ServeHTTP(w, r)
 	})
 }
 
+// statusRecorder records the status and size of a response. Unwrap lets
+// http.ResponseController reach the hijacker of the console websocket
+type statusRecorder struct {
+	http.ResponseWriter
+	status int
+	bytes  int64
+}
+
+func (w *statusRecorder) WriteHeader(status int) {
+	if w.status == 0 {
+		w.status = status
+	}
+	w.ResponseWriter.WriteHeader(status)
+}
+
+func (w *statusRecorder) Write(b []byte) (int, error) {
+	if w.status == 0 {
+		w.status = http.StatusOK
+	}
+	n, err := w.ResponseWriter.Write(b)
+	w.bytes += int64(n)
+	return n, err
+}
+
+func (w *statusRecorder) Unwrap() http.ResponseWriter {
+	return w.ResponseWriter
+}
+
+// accessLogMiddleware writes requests to the access log, with the token of
+// the BMC event receiver redacted from the path
+func accessLogMiddleware(l *accesslog.Logger) func(h http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			start := time.Now()
+			rec := &statusRecorder{ResponseWriter: w}
+			next.ServeHTTP(rec, r)
+
+			status := rec.status
+			if status == 0 {
+				status = http.StatusOK
+			}
+			l.Log(&accesslog.Entry{
+				Method:   r.Method,
+				Path:     accesslog.RedactPath(r.URL.Path, tokenPathPrefix),
+				Status:   status,
+				Bytes:    rec.bytes,
+				Duration: time.Since(start),
+				IP:       accesslog.ClientIP(r.RemoteAddr),
+			})
+		})
+	}
+}
+
+// rateLimitMiddleware rejects requests over the limits of the client IP with
+// 429 Too Many Requests, or with 503 Service Unavailable when too many requests
+// are served at once. Requests over the unix socket are not limited
+func rateLimitMiddleware(l *ratelimit.Limiter) func(h http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			release, err := l.Acquire(r.RemoteAddr, "")
+			if err != nil {
+				rerr := err.(*ratelimit.Error)
+				w.Header().Set("Retry-After", rerr.RetryAfterSeconds())
+				ErrorSerializer(w, r, fuego.HTTPError{
+					Status: rerr.Status,
+					Err:    err,
+					Title:  "Error",
+					Detail: rerr.Error(),
+				})
+				return
+			}
+			defer release()
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
 func corsMiddleware(enabled bool) func(h http.Handler) http.Handler {
 	return func(next http.Handler) http.Handler {
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
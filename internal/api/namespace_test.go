@@ -0,0 +1,112 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package api
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/netip"
+	"strings"
+	"testing"
+
+	"github.com/go-fuego/fuego"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/ubccr/grendel/internal/store"
+	"github.com/ubccr/grendel/internal/store/namespace"
+	"github.com/ubccr/grendel/internal/store/sqlstore"
+	"github.com/ubccr/grendel/pkg/model"
+)
+
+func TestNamespaces(t *testing.T) {
+	viper.Set("api.secret", "namespace-test-secret")
+	defer viper.Set("api.secret", nil)
+
+	def, err := sqlstore.New(":memory:")
+	require.NoError(t, err)
+	defer def.Close()
+	_, err = def.StoreUser("admin", "secret123")
+	require.NoError(t, err)
+	require.NoError(t, def.UpdateUserRole("admin", model.RoleAdmin.String()))
+	require.NoError(t, def.UpdateUserEnabled("admin", true))
+
+	stores := make(map[string]store.Store)
+	for name, ip := range map[string]string{"a": "10.1.0.1/16", "b": "10.2.0.1/16"} {
+		db, err := sqlstore.New(":memory:")
+		require.NoError(t, err)
+		require.NoError(t, db.StoreHost(&model.Host{
+			Name:       "cpn-01",
+			Interfaces: []*model.NetInterface{{IP: netip.MustParsePrefix(ip)}},
+		}))
+		stores[name] = db
+	}
+	registry := namespace.NewRegistry(def, []*namespace.Namespace{{Name: "a"}, {Name: "b"}}, stores)
+	defer registry.Close()
+
+	h, err := NewHandler(def)
+	require.NoError(t, err)
+	h.Namespaces = registry
+	fs := (&Server{}).newFuegoServer(fuego.WithAddr("127.0.0.1:0"))
+	h.SetupRoutes(fs)
+
+	token := func(namespaces ...string) string {
+		claims := jwt.MapClaims{TokenUsername: "admin", TokenRole: model.RoleAdmin.String()}
+		if len(namespaces) > 0 {
+			claims[TokenNamespaces] = namespaces
+		}
+		token, err := NewToken(claims, model.PrimarySigningKey(model.SigningKeyAPI))
+		require.NoError(t, err)
+		return token
+	}
+	do := func(method, path, body, token, ns string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(method, path, strings.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("Authorization", "Bearer "+token)
+		if ns != "" {
+			req.Header.Set(NamespaceHeader, ns)
+		}
+		rec := httptest.NewRecorder()
+		fs.Mux.ServeHTTP(rec, req)
+		return rec
+	}
+
+	// A token scoped to one namespace uses it without the header
+	rec := do(http.MethodGet, "/v1/nodes", "", token("a"), "")
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	assert.Contains(t, rec.Body.String(), "10.1.0.1")
+	assert.NotContains(t, rec.Body.String(), "10.2.0.1")
+
+	rec = do(http.MethodGet, "/v1/nodes", "", token("a"), "b")
+	assert.Equal(t, http.StatusForbidden, rec.Code, rec.Body.String())
+	rec = do(http.MethodGet, "/v1/nodes", "", token("a"), "c")
+	assert.Equal(t, http.StatusForbidden, rec.Code, rec.Body.String())
+
+	// A token scoped to several namespaces selects one
+	rec = do(http.MethodGet, "/v1/nodes", "", token("a", "b"), "")
+	assert.Equal(t, http.StatusBadRequest, rec.Code, rec.Body.String())
+	rec = do(http.MethodGet, "/v1/nodes", "", token("a", "b"), "b")
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	assert.Contains(t, rec.Body.String(), "10.2.0.1")
+	assert.NotContains(t, rec.Body.String(), "10.1.0.1")
+
+	// Unscoped tokens use the default namespace without the header
+	rec = do(http.MethodGet, "/v1/nodes", "", token(), "")
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	assert.NotContains(t, rec.Body.String(), "cpn-01")
+	rec = do(http.MethodGet, "/v1/nodes", "", token(), "c")
+	assert.Equal(t, http.StatusNotFound, rec.Code, rec.Body.String())
+
+	// Scoped tokens only create tokens scoped to their namespaces
+	rec = do(http.MethodPost, "/v1/auth/token", `{"username": "admin", "role": "admin", "expire": "1h", "namespaces": ["b"]}`, token("a"), "")
+	assert.Equal(t, http.StatusForbidden, rec.Code, rec.Body.String())
+	rec = do(http.MethodPost, "/v1/auth/token", `{"username": "admin", "role": "admin", "expire": "1h"}`, token("a"), "")
+	assert.Equal(t, http.StatusForbidden, rec.Code, rec.Body.String())
+	rec = do(http.MethodPost, "/v1/auth/token", `{"username": "admin", "role": "admin", "expire": "1h", "namespaces": ["a"]}`, token("a"), "")
+	assert.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	rec = do(http.MethodPost, "/v1/auth/token", `{"username": "admin", "role": "admin", "expire": "1h", "namespaces": ["c"]}`, token(), "")
+	assert.Equal(t, http.StatusBadRequest, rec.Code, rec.Body.String())
+}
@@ -0,0 +1,112 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package api
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/netip"
+	"strings"
+	"time"
+
+	"github.com/go-fuego/fuego"
+	"github.com/ubccr/grendel/internal/ipam"
+	"github.com/ubccr/grendel/internal/util"
+	"github.com/ubccr/grendel/pkg/model"
+)
+
+type NodeNextIPRequest struct {
+	Subnet  string `json:"subnet" validate:"required" example:"10.64.8.0/22"`
+	Count   int    `json:"count" description:"number of addresses, defaults to 1" example:"32"`
+	Reserve string `json:"reserve" description:"reserve the addresses for this long so other allocations skip them, not reserved when empty" example:"1h"`
+}
+
+type NodeNextIPResponse struct {
+	Subnet        string     `json:"subnet"`
+	IPs           []string   `json:"ips"`
+	ReservedUntil *time.Time `json:"reserved_until,omitempty"`
+}
+
+// NodeNextIP returns the lowest free addresses of a subnet, optionally
+// reserving them
+func (h *Handler) NodeNextIP(c fuego.ContextWithBody[NodeNextIPRequest]) (*NodeNextIPResponse, error) {
+	body, err := c.Body()
+	if err != nil {
+		return nil, fuego.HTTPError{
+			Err:    err,
+			Title:  "Error",
+			Detail: "failed to parse body",
+		}
+	}
+
+	prefix, err := netip.ParsePrefix(body.Subnet)
+	if err != nil {
+		return nil, fuego.HTTPError{
+			Err:    err,
+			Status: http.StatusBadRequest,
+			Title:  "Error",
+			Detail: fmt.Sprintf("invalid subnet: %s", body.Subnet),
+		}
+	}
+
+	count := body.Count
+	if count == 0 {
+		count = 1
+	}
+
+	var reserve time.Duration
+	if body.Reserve != "" {
+		reserve, err = util.ParseDuration(body.Reserve)
+		if err != nil || reserve <= 0 {
+			return nil, fuego.HTTPError{
+				Err:    err,
+				Status: http.StatusBadRequest,
+				Title:  "Error",
+				Detail: fmt.Sprintf("invalid reserve: %s", body.Reserve),
+			}
+		}
+	}
+
+	username, _ := c.Context().Value(ContextKeyUsername).(string)
+	addrs, err := ipam.Next(h.db(c.Context()), prefix, count, reserve, username)
+	if errors.Is(err, ipam.ErrExhausted) {
+		return nil, fuego.HTTPError{
+			Err:    err,
+			Status: http.StatusConflict,
+			Title:  "Error",
+			Detail: err.Error(),
+		}
+	} else if err != nil {
+		return nil, h.storeError(c.Context(), err, "failed to allocate addresses")
+	}
+
+	res := &NodeNextIPResponse{
+		Subnet: prefix.Masked().String(),
+		IPs:    make([]string, 0, len(addrs)),
+	}
+	for _, a := range addrs {
+		res.IPs = append(res.IPs, a.String())
+	}
+
+	if reserve > 0 {
+		until := time.Now().Add(reserve)
+		res.ReservedUntil = &until
+		h.writeEvent(c.Context(), "Success", fmt.Sprintf("Reserved address(es) %s of subnet %s until %s", strings.Join(res.IPs, ","), res.Subnet, until.Format(time.RFC3339)))
+	}
+
+	return res, nil
+}
+
+// NodeReservationList returns the address reservations and DHCP conflicts,
+// expired ones included
+func (h *Handler) NodeReservationList(c fuego.ContextNoBody) (model.IPReservationList, error) {
+	reservations, err := h.db(c.Context()).IPReservations()
+	if err != nil {
+		return nil, h.storeError(c.Context(), err, "failed to load address reservations")
+	}
+
+	return reservations, nil
+}
@@ -5,12 +5,21 @@
 package api
 
 import (
+	"context"
+	"encoding/base64"
 	"errors"
 	"fmt"
+	"net/http"
 	"slices"
 	"strings"
+	"time"
 
 	"github.com/go-fuego/fuego"
+	"github.com/ubccr/grendel/internal/firmware"
+	"github.com/ubccr/grendel/internal/secret"
+	"github.com/ubccr/grendel/internal/store"
+	"github.com/ubccr/grendel/internal/util"
+	"github.com/ubccr/grendel/internal/webhook"
 	"github.com/ubccr/grendel/pkg/model"
 	"github.com/ubccr/grendel/pkg/nodeset"
 )
@@ -19,6 +28,10 @@ type NodeProvisionRequest struct {
 	Provision bool `json:"provision"`
 }
 
+type NodeStateRequest struct {
+	State string `json:"state" validate:"required" description:"new, ready-to-provision, installing, production, drained or retired"`
+}
+
 type NodeTagsRequest struct {
 	Tags string `json:"tags" description:"comma separated list of tags" example:"a01,test"`
 }
@@ -34,7 +47,33 @@ type NodeBootTokenResponse struct {
 	} `json:"nodes"`
 }
 type NodeBootImageRequest struct {
-	Image string `json:"image"`
+	Image         string   `json:"image"`
+	OnlyIfCurrent []string `json:"only_if_current" description:"only change the nodes if each is assigned one of these boot images, an empty name matching nodes without one. Otherwise 409 Conflict is answered and no node is changed"`
+}
+
+type NodeFirmwareRequest struct {
+	Firmware string `json:"firmware" description:"firmware overriding the firmware of the client architecture, cleared when empty" example:"snponly-x86_64.efi"`
+}
+
+type NodeTokenRequest struct {
+	Token string `json:"token" validate:"required"`
+}
+
+type NodeCredentialsRequest struct {
+	Kind     string `json:"kind" description:"kind of credentials, defaults to bmc" example:"bmc"`
+	Username string `json:"username" validate:"required"`
+	Password string `json:"password" validate:"required"`
+}
+
+type NodeFileRequest struct {
+	Name    string `json:"name" validate:"required" example:"opa.conf"`
+	Content string `json:"content" description:"base64 encoded content of the file"`
+}
+
+type NodeRenameRequest struct {
+	Name        string `json:"name" validate:"required"`
+	NewName     string `json:"new_name" validate:"required"`
+	FQDNPattern string `json:"fqdn_pattern" description:"pattern for the boot interface FQDN. {name} is replaced with the new name" example:"{name}.mgmt.cluster"`
 }
 
 func (h *Handler) NodeAdd(c fuego.ContextWithBody[NodeAddRequest]) (*GenericResponse, error) {
@@ -48,29 +87,84 @@ func (h *Handler) NodeAdd(c fuego.ContextWithBody[NodeAddRequest]) (*GenericResp
 		}
 	}
 
-	err = h.DB.StoreHosts(body.NodeList)
+	for _, host := range body.NodeList {
+		if err := host.ValidateGateways(); err != nil {
+			return nil, h.storeError(c.Context(), fmt.Errorf("%w: host %s: %w", store.ErrInvalidData, host.Name, err), "failed to store node(s)")
+		}
+	}
+
+	// Existing hosts are looked up first to tell created and updated
+	// hosts apart in the webhook events
+	existing := make(map[string]bool)
+	if ns, err := body.NodeList.ToNodeSet(); err == nil {
+		if found, err := h.db(c.Context()).FindHosts(ns); err == nil {
+			for _, host := range found {
+				existing[host.Name] = true
+			}
+		}
+	}
+
+	err = h.db(c.Context()).StoreHosts(body.NodeList)
 	if err != nil {
-		return nil, fuego.HTTPError{
-			Err:    err,
-			Title:  "Error",
-			Detail: fmt.Sprintf("failed to store node(s): %s", err.Error()),
+		return nil, h.storeError(c.Context(), err, "failed to store node(s)")
+	}
+
+	var created, updated model.HostList
+	for _, host := range body.NodeList {
+		if existing[host.Name] {
+			updated = append(updated, host)
+		} else {
+			created = append(created, host)
 		}
 	}
+	if ns, err := created.ToNodeSet(); err == nil && ns.Len() > 0 {
+		h.writeHostEvent(c.Context(), webhook.HostCreated, ns.Iterator().StringSlice(), "Success", fmt.Sprintf("Successfully added node(s): %s", ns.String()))
+	}
+	if ns, err := updated.ToNodeSet(); err == nil && ns.Len() > 0 {
+		h.writeHostEvent(c.Context(), webhook.HostUpdated, ns.Iterator().StringSlice(), "Success", fmt.Sprintf("Successfully saved node(s): %s", ns.String()))
+	}
 
-	ns, err := body.NodeList.ToNodeSet()
-	if err == nil {
-		h.writeEvent(c.Context(), "Success", fmt.Sprintf("Successfully saved node(s): %s", ns.String()))
+	detail := "successfully added node(s)"
+	if warnings := h.trashCollisions(c.Context(), body.NodeList); len(warnings) > 0 {
+		detail = fmt.Sprintf("%s. warning, node(s) collide with deleted nodes in the trash: %s", detail, strings.Join(warnings, "; "))
 	}
 
 	return &GenericResponse{
 		Title:   "Success",
-		Detail:  "successfully added node(s)",
+		Detail:  detail,
 		Changed: len(body.NodeList),
 	}, nil
 }
 
+// trashCollisions returns a warning for every name, MAC or IP address of the
+// hosts that is also used by a host in the trash
+func (h *Handler) trashCollisions(ctx context.Context, hosts model.HostList) []string {
+	trash, err := h.db(ctx).TrashedHosts()
+	if err != nil {
+		log.Warnf("failed to check nodes against the trash: %s", err)
+		return nil
+	}
+
+	warnings := make([]string, 0)
+	for _, host := range hosts {
+		for _, c := range trash.Collisions(host) {
+			warnings = append(warnings, fmt.Sprintf("%s uses %s", host.Name, c))
+		}
+	}
+	if len(warnings) > 0 {
+		log.Warnf("Node(s) collide with deleted nodes in the trash: %s", strings.Join(warnings, "; "))
+	}
+
+	return warnings
+}
+
 func (h *Handler) NodeList(c fuego.ContextNoBody) (model.HostList, error) {
-	NodeList, err := h.DB.Hosts()
+	since, err := parseSince(c.QueryParam("since"))
+	if err != nil {
+		return nil, err
+	}
+
+	NodeList, err := h.db(c.Context()).Hosts()
 	if err != nil {
 		return nil, fuego.HTTPError{
 			Err:    err,
@@ -79,12 +173,17 @@ func (h *Handler) NodeList(c fuego.ContextNoBody) (model.HostList, error) {
 		}
 	}
 
-	return NodeList, nil
+	return NodeList.UpdatedSince(since), nil
 }
 
 func (h *Handler) NodeFind(c fuego.ContextNoBody) (model.HostList, error) {
+	since, err := parseSince(c.QueryParam("since"))
+	if err != nil {
+		return nil, err
+	}
+
 	// TODO: implement a native DB func to handle this?
-	ns, err := h.filterByNodesetAndTags(c.QueryParam("nodeset"), c.QueryParam("tags"))
+	ns, err := h.filterByNodesetAndTags(c.Context(), c.QueryParam("nodeset"), c.QueryParam("tags"))
 	if err != nil {
 		return nil, fuego.HTTPError{
 			Err:    err,
@@ -95,9 +194,114 @@ func (h *Handler) NodeFind(c fuego.ContextNoBody) (model.HostList, error) {
 
 	var NodeList model.HostList
 	if ns.Len() == 0 {
-		NodeList, err = h.DB.Hosts()
+		NodeList, err = h.db(c.Context()).Hosts()
 	} else {
-		NodeList, err = h.DB.FindHosts(ns)
+		NodeList, err = h.db(c.Context()).FindHosts(ns)
+	}
+	if err != nil {
+		return nil, fuego.HTTPError{
+			Err:    err,
+			Title:  "Error",
+			Detail: "failed to find nodes",
+		}
+	}
+
+	var states []string
+	if c.QueryParam("state") != "" {
+		states = strings.Split(c.QueryParam("state"), ",")
+		for _, state := range states {
+			if err := model.ValidateHostState(state); err != nil {
+				return nil, fuego.HTTPError{
+					Err:    err,
+					Status: http.StatusBadRequest,
+					Title:  "Error",
+					Detail: err.Error(),
+				}
+			}
+		}
+	}
+
+	NodeList = NodeList.UpdatedSince(since).
+		WithFirmware(model.InventoryFieldBIOS, c.QueryParam("bios_version")).
+		WithFirmware(model.InventoryFieldBMC, c.QueryParam("bmc_firmware")).
+		WithFirmware(model.InventoryFieldNIC, c.QueryParam("nic_firmware")).
+		InStates(states...)
+
+	sw := c.QueryParam("switch")
+	port := c.QueryParamInt("port")
+	if sw == "" && port == 0 {
+		return NodeList, nil
+	}
+
+	filtered := make(model.HostList, 0)
+	for _, host := range NodeList {
+		for _, nic := range host.Interfaces {
+			if (sw == "" || nic.Switch == sw) && (port == 0 || nic.Port == port) {
+				filtered = append(filtered, host)
+				break
+			}
+		}
+	}
+
+	return filtered, nil
+}
+
+func (h *Handler) NodeDeleted(c fuego.ContextNoBody) (model.TombstoneList, error) {
+	return h.tombstones(c.Context(), model.TombstoneKindHost, c.QueryParam("since"))
+}
+
+// NodeLog returns the log of node(s), holding the critical events pushed by
+// their BMCs
+func (h *Handler) NodeLog(c fuego.ContextNoBody) (model.HostLogList, error) {
+	ns, err := h.filterByNodesetAndTags(c.Context(), c.QueryParam("nodeset"), c.QueryParam("tags"))
+	if err != nil {
+		return nil, fuego.HTTPError{
+			Err:    err,
+			Title:  "Error",
+			Detail: "failed to filter nodes",
+		}
+	}
+
+	entries, err := h.db(c.Context()).FindHostLog(ns)
+	if err != nil {
+		return nil, fuego.HTTPError{
+			Err:    err,
+			Title:  "Error",
+			Detail: "failed to find node log",
+		}
+	}
+
+	return entries, nil
+}
+
+// NodePrometheusSD returns the nodes as Prometheus http_sd targets, the same
+// document as the file_sd targets written by node export. Without a nodeset
+// or tags all nodes are returned
+func (h *Handler) NodePrometheusSD(c fuego.ContextNoBody) (model.PrometheusTargetGroupList, error) {
+	port := c.QueryParamInt("port")
+	if port == 0 {
+		port = model.DefaultPrometheusPort
+	}
+
+	// No node matching the tags is an empty list of targets
+	ns, err := h.filterByNodesetAndTags(c.Context(), c.QueryParam("nodeset"), c.QueryParam("tags"))
+	if errors.Is(err, store.ErrNotFound) {
+		return model.PrometheusTargetGroupList{}, nil
+	}
+	if err != nil {
+		return nil, fuego.HTTPError{
+			Err:    err,
+			Title:  "Error",
+			Detail: "failed to filter nodes",
+		}
+	}
+
+	var hosts model.HostList
+	switch {
+	case ns.Len() > 0:
+		hosts, err = h.db(c.Context()).FindHosts(ns)
+	case c.QueryParam("nodeset") == "" && c.QueryParam("tags") == "":
+		hosts, err = h.db(c.Context()).Hosts()
 	}
 	if err != nil {
 		return nil, fuego.HTTPError{
@@ -107,12 +311,12 @@ func (h *Handler) NodeFind(c fuego.ContextNoBody) (model.HostList, error) {
 		}
 	}
 
-	return NodeList, nil
+	return hosts.PrometheusTargets(port, c.QueryParamBool("exclude_provision")), nil
 }
 
 func (h *Handler) NodeDelete(c fuego.ContextNoBody) (*GenericResponse, error) {
 	// TODO: implement a native DB func to handle this?
-	ns, err := h.filterByNodesetAndTags(c.QueryParam("nodeset"), c.QueryParam("tags"))
+	ns, err := h.filterByNodesetAndTags(c.Context(), c.QueryParam("nodeset"), c.QueryParam("tags"))
 	if err != nil {
 		return nil, fuego.HTTPError{
 			Err:    err,
@@ -121,7 +325,7 @@ func (h *Handler) NodeDelete(c fuego.ContextNoBody) (*GenericResponse, error) {
 		}
 	}
 
-	err = h.DB.DeleteHosts(ns)
+	err = h.db(c.Context()).DeleteHosts(ns)
 	if err != nil {
 		return nil, fuego.HTTPError{
 			Err:    err,
@@ -130,17 +334,17 @@ func (h *Handler) NodeDelete(c fuego.ContextNoBody) (*GenericResponse, error) {
 		}
 	}
 
-	h.writeEvent(c.Context(), "Success", fmt.Sprintf("Successfully deleted node(s): %s", ns.String()))
+	h.writeHostEvent(c.Context(), webhook.HostDeleted, ns.Iterator().StringSlice(), "Success", fmt.Sprintf("Successfully moved node(s) to the trash: %s", ns.String()))
 
 	return &GenericResponse{
 		Title:   "Success",
-		Detail:  "successfully deleted node(s)",
+		Detail:  "successfully moved node(s) to the trash",
 		Changed: ns.Len(),
 	}, nil
 }
 
 func (h *Handler) NodeProvision(c fuego.ContextWithBody[NodeProvisionRequest]) (*GenericResponse, error) {
-	ns, err := h.filterByNodesetAndTags(c.QueryParam("nodeset"), c.QueryParam("tags"))
+	ns, err := h.filterByNodesetAndTags(c.Context(), c.QueryParam("nodeset"), c.QueryParam("tags"))
 	if err != nil {
 		return nil, fuego.HTTPError{
 			Err:    err,
@@ -156,23 +360,52 @@ func (h *Handler) NodeProvision(c fuego.ContextWithBody[NodeProvisionRequest]) (
 			Detail: "failed to parse body",
 		}
 	}
-	err = h.DB.ProvisionHosts(ns, body.Provision)
+	err = h.db(c.Context()).ProvisionHosts(ns, body.Provision)
+	if err != nil {
+		return nil, h.storeError(c.Context(), err, "failed to change provision on node(s)")
+	}
+	h.writeHostEvent(c.Context(), webhook.HostUpdated, ns.Iterator().StringSlice(), "Success", fmt.Sprintf("Successfully changed provision to %t on node(s): %s", body.Provision, ns.String()))
+
+	return &GenericResponse{
+		Title:   "Success",
+		Detail:  fmt.Sprintf("successfully changed node(s) provision to %t", body.Provision),
+		Changed: ns.Len(),
+	}, nil
+}
+
+// NodeState sets the lifecycle state of nodes
+func (h *Handler) NodeState(c fuego.ContextWithBody[NodeStateRequest]) (*GenericResponse, error) {
+	ns, err := h.filterByNodesetAndTags(c.Context(), c.QueryParam("nodeset"), c.QueryParam("tags"))
+	if err != nil {
+		return nil, fuego.HTTPError{
+			Err:    err,
+			Title:  "Error",
+			Detail: "failed to filter nodes",
+		}
+	}
+	body, err := c.Body()
 	if err != nil {
 		return nil, fuego.HTTPError{
 			Err:    err,
 			Title:  "Error",
-			Detail: "failed to change provision on node(s)",
+			Detail: "failed to parse body",
 		}
 	}
+	err = h.db(c.Context()).SetHostsState(ns, body.State)
+	if err != nil {
+		return nil, h.storeError(c.Context(), err, "failed to change state of node(s)")
+	}
+	h.writeHostEvent(c.Context(), webhook.HostUpdated, ns.Iterator().StringSlice(), "Success", fmt.Sprintf("Successfully changed state to %s on node(s): %s", body.State, ns.String()))
+
 	return &GenericResponse{
 		Title:   "Success",
-		Detail:  fmt.Sprintf("successfully changed node(s) provision to %t", body.Provision),
+		Detail:  fmt.Sprintf("successfully changed node(s) state to %s", body.State),
 		Changed: ns.Len(),
 	}, nil
 }
 
 func (h *Handler) NodeTags(c fuego.ContextWithBody[NodeTagsRequest]) (*GenericResponse, error) {
-	ns, err := h.filterByNodesetAndTags(c.QueryParam("nodeset"), c.QueryParam("tags"))
+	ns, err := h.filterByNodesetAndTags(c.Context(), c.QueryParam("nodeset"), c.QueryParam("tags"))
 	if err != nil {
 		return nil, fuego.HTTPError{
 			Err:    err,
@@ -199,10 +432,10 @@ func (h *Handler) NodeTags(c fuego.ContextWithBody[NodeTagsRequest]) (*GenericRe
 
 	if c.PathParam("action") == "add" {
 		msg = "successfully added tag(s) to node(s)"
-		err = h.DB.TagHosts(ns, tags)
+		err = h.db(c.Context()).TagHosts(ns, tags)
 	} else if c.PathParam("action") == "remove" {
 		msg = "successfully removed tag(s) from node(s)"
-		err = h.DB.UntagHosts(ns, tags)
+		err = h.db(c.Context()).UntagHosts(ns, tags)
 	}
 
 	if err != nil {
@@ -212,6 +445,7 @@ func (h *Handler) NodeTags(c fuego.ContextWithBody[NodeTagsRequest]) (*GenericRe
 			Detail: "failed to update node(s) tags",
 		}
 	}
+	h.writeHostEvent(c.Context(), webhook.HostUpdated, ns.Iterator().StringSlice(), "Success", fmt.Sprintf("Successfully updated tags of node(s): %s", ns.String()))
 
 	return &GenericResponse{
 		Title:   "Success",
@@ -223,7 +457,7 @@ func (h *Handler) NodeTags(c fuego.ContextWithBody[NodeTagsRequest]) (*GenericRe
 func (h *Handler) NodeBootToken(c fuego.ContextNoBody) (*NodeBootTokenResponse, error) {
 	iface := c.PathParam("interface")
 
-	ns, err := h.filterByNodesetAndTags(c.QueryParam("nodeset"), c.QueryParam("tags"))
+	ns, err := h.filterByNodesetAndTags(c.Context(), c.QueryParam("nodeset"), c.QueryParam("tags"))
 	if err != nil {
 		return nil, fuego.HTTPError{
 			Err:    err,
@@ -232,7 +466,7 @@ func (h *Handler) NodeBootToken(c fuego.ContextNoBody) (*NodeBootTokenResponse,
 		}
 	}
 
-	nodeList, err := h.DB.FindHosts(ns)
+	nodeList, err := h.db(c.Context()).FindHosts(ns)
 	if err != nil {
 		return nil, fuego.HTTPError{
 			Err:    err,
@@ -283,16 +517,44 @@ func (h *Handler) NodeBootToken(c fuego.ContextNoBody) (*NodeBootTokenResponse,
 	return &output, nil
 }
 
-func (h *Handler) NodeBootImage(c fuego.ContextWithBody[NodeBootImageRequest]) (*GenericResponse, error) {
-	ns, err := h.filterByNodesetAndTags(c.QueryParam("nodeset"), c.QueryParam("tags"))
+func (h *Handler) NodeTokenInspect(c fuego.ContextWithBody[NodeTokenRequest]) (*model.BootTokenInfo, error) {
+	body, err := c.Body()
 	if err != nil {
 		return nil, fuego.HTTPError{
 			Err:    err,
 			Title:  "Error",
-			Detail: "failed to filter nodes",
+			Detail: "failed to parse body",
+		}
+	}
+
+	info, err := model.InspectBootToken(body.Token)
+	if err != nil {
+		return nil, fuego.HTTPError{
+			Err:    err,
+			Status: http.StatusBadRequest,
+			Title:  "Error",
+			Detail: "invalid boot token",
+		}
+	}
+
+	info.Revoked, err = h.db(c.Context()).BootTokenRevoked(info.ID)
+	if err != nil {
+		return nil, fuego.HTTPError{
+			Err:    err,
+			Title:  "Error",
+			Detail: "failed to check if boot token is revoked",
 		}
 	}
 
+	host, err := h.db(c.Context()).LoadHostFromID(info.HostID)
+	if err == nil {
+		info.HostName = host.Name
+	}
+
+	return info, nil
+}
+
+func (h *Handler) NodeTokenRevoke(c fuego.ContextWithBody[NodeTokenRequest]) (*GenericResponse, error) {
 	body, err := c.Body()
 	if err != nil {
 		return nil, fuego.HTTPError{
@@ -302,15 +564,71 @@ func (h *Handler) NodeBootImage(c fuego.ContextWithBody[NodeBootImageRequest]) (
 		}
 	}
 
-	err = h.DB.SetBootImage(ns, body.Image)
+	info, err := model.InspectBootToken(body.Token)
+	if err != nil {
+		return nil, fuego.HTTPError{
+			Err:    err,
+			Status: http.StatusBadRequest,
+			Title:  "Error",
+			Detail: "invalid boot token",
+		}
+	}
+
+	if info.Expired {
+		return &GenericResponse{
+			Title:   "Success",
+			Detail:  fmt.Sprintf("boot token %s has already expired", info.ID),
+			Changed: 0,
+		}, nil
+	}
+
+	err = h.db(c.Context()).RevokeBootToken(info)
+	if err != nil {
+		return nil, fuego.HTTPError{
+			Err:    err,
+			Title:  "Error",
+			Detail: "failed to revoke boot token",
+		}
+	}
+
+	msg := fmt.Sprintf("Successfully revoked boot token %s for host %s mac %s", info.ID, info.HostID, info.MAC)
+	h.writeEvent(c.Context(), "Success", msg)
+
+	return &GenericResponse{
+		Title:   "Success",
+		Detail:  msg,
+		Changed: 1,
+	}, nil
+}
+
+func (h *Handler) NodeBootImage(c fuego.ContextWithBody[NodeBootImageRequest]) (*GenericResponse, error) {
+	ns, err := h.filterByNodesetAndTags(c.Context(), c.QueryParam("nodeset"), c.QueryParam("tags"))
+	if err != nil {
+		return nil, fuego.HTTPError{
+			Err:    err,
+			Title:  "Error",
+			Detail: "failed to filter nodes",
+		}
+	}
+
+	body, err := c.Body()
 	if err != nil {
 		return nil, fuego.HTTPError{
 			Err:    err,
 			Title:  "Error",
-			Detail: "failed to update boot images",
+			Detail: "failed to parse body",
 		}
 	}
 
+	if len(body.OnlyIfCurrent) > 0 {
+		err = h.db(c.Context()).SetBootImageIfCurrent(ns, body.Image, body.OnlyIfCurrent...)
+	} else {
+		err = h.db(c.Context()).SetBootImage(ns, body.Image)
+	}
+	if err != nil {
+		return nil, h.storeError(c.Context(), err, "failed to update boot images")
+	}
+
 	return &GenericResponse{
 		Title:   "Success",
 		Detail:  "successfully updated node(s) boot image",
@@ -318,36 +636,572 @@ func (h *Handler) NodeBootImage(c fuego.ContextWithBody[NodeBootImageRequest]) (
 	}, nil
 }
 
-func (h *Handler) filterByNodesetAndTags(f1, f2 string) (*nodeset.NodeSet, error) {
-	queryNs, err := nodeset.NewNodeSet(f1)
+// NodeFirmware sets the firmware overriding the firmware detected from the
+// client architecture of nodes
+func (h *Handler) NodeFirmware(c fuego.ContextWithBody[NodeFirmwareRequest]) (*GenericResponse, error) {
+	ns, err := h.filterByNodesetAndTags(c.Context(), c.QueryParam("nodeset"), c.QueryParam("tags"))
 	if err != nil {
-		return nil, err
+		return nil, fuego.HTTPError{
+			Err:    err,
+			Title:  "Error",
+			Detail: "failed to filter nodes",
+		}
 	}
-	tagsNs, _ := nodeset.NewNodeSet("")
-	if f2 != "" {
-		tags := strings.Split(f2, ",")
-		tagsNs, err = h.DB.FindTags(tags)
+
+	body, err := c.Body()
+	if err != nil {
+		return nil, fuego.HTTPError{
+			Err:    err,
+			Title:  "Error",
+			Detail: "failed to parse body",
+		}
+	}
+
+	fw := firmware.Build(0)
+	if body.Firmware != "" {
+		fw, err = firmware.Parse(body.Firmware)
 		if err != nil {
-			return nil, err
+			return nil, fuego.HTTPError{
+				Err:    err,
+				Status: http.StatusBadRequest,
+				Title:  "Error",
+				Detail: err.Error(),
+			}
 		}
 	}
 
-	compare1 := queryNs.Iterator().StringSlice()
-	compare2 := tagsNs.Iterator().StringSlice()
+	err = h.db(c.Context()).SetFirmware(ns, fw)
+	if err != nil {
+		return nil, h.storeError(c.Context(), err, "failed to update firmware")
+	}
 
-	combined := []string{}
-	for _, node := range compare1 {
-		if slices.Contains(compare2, node) {
-			combined = append(combined, node)
+	return &GenericResponse{
+		Title:   "Success",
+		Detail:  "successfully updated node(s) firmware",
+		Changed: ns.Len(),
+	}, nil
+}
+
+func (h *Handler) NodeRename(c fuego.ContextWithBody[NodeRenameRequest]) (*GenericResponse, error) {
+	body, err := c.Body()
+	if err != nil {
+		return nil, fuego.HTTPError{
+			Err:    err,
+			Title:  "Error",
+			Detail: "failed to parse body",
 		}
 	}
 
-	if len(compare1) == 0 {
-		combined = compare2
+	if body.NewName == "" || strings.ContainsAny(body.NewName, " \t,[]") {
+		return nil, fuego.HTTPError{
+			Err:    fmt.Errorf("invalid node name: %q", body.NewName),
+			Status: http.StatusBadRequest,
+			Title:  "Error",
+			Detail: fmt.Sprintf("invalid node name %q: must not be empty or contain whitespace, commas or brackets", body.NewName),
+		}
 	}
-	if len(compare2) == 0 {
-		combined = compare1
+
+	host, err := h.db(c.Context()).LoadHostFromName(body.Name)
+	if err != nil {
+		status := http.StatusInternalServerError
+		if errors.Is(err, store.ErrNotFound) {
+			status = http.StatusNotFound
+		}
+		return nil, fuego.HTTPError{
+			Err:    err,
+			Status: status,
+			Title:  "Error",
+			Detail: fmt.Sprintf("failed to find node %s", body.Name),
+		}
 	}
 
-	return nodeset.NewNodeSet(strings.Join(combined, ","))
+	_, err = h.db(c.Context()).LoadHostFromName(body.NewName)
+	if err == nil {
+		return nil, fuego.HTTPError{
+			Err:    fmt.Errorf("node %s already exists", body.NewName),
+			Status: http.StatusConflict,
+			Title:  "Error",
+			Detail: fmt.Sprintf("node %s already exists", body.NewName),
+		}
+	} else if !errors.Is(err, store.ErrNotFound) {
+		return nil, fuego.HTTPError{
+			Err:    err,
+			Title:  "Error",
+			Detail: "failed to rename node",
+		}
+	}
+
+	host.Rename(body.NewName, body.FQDNPattern)
+
+	hosts, err := h.db(c.Context()).Hosts()
+	if err != nil {
+		return nil, fuego.HTTPError{
+			Err:    err,
+			Title:  "Error",
+			Detail: "failed to rename node",
+		}
+	}
+
+	if other, fqdn, ok := fqdnCollision(host, hosts); ok {
+		return nil, fuego.HTTPError{
+			Err:    fmt.Errorf("fqdn %s of node %s is used by node %s", fqdn, body.NewName, other),
+			Status: http.StatusConflict,
+			Title:  "Error",
+			Detail: fmt.Sprintf("failed to rename node: fqdn %s is used by node %s", fqdn, other),
+		}
+	}
+
+	records, err := h.db(c.Context()).DNSRecords()
+	if err != nil {
+		return nil, fuego.HTTPError{
+			Err:    err,
+			Title:  "Error",
+			Detail: "failed to rename node",
+		}
+	}
+
+	if err := records.CheckConflicts(model.HostList{host}, nil); err != nil {
+		return nil, fuego.HTTPError{
+			Err:    err,
+			Status: http.StatusConflict,
+			Title:  "Error",
+			Detail: fmt.Sprintf("failed to rename node: %s", err),
+		}
+	}
+
+	// The host keeps its ID so the node and its interfaces are updated in
+	// place in a single transaction
+	err = h.db(c.Context()).StoreHost(host)
+	if err != nil {
+		return nil, h.storeError(c.Context(), err, "failed to rename node")
+	}
+
+	h.writeHostEvent(c.Context(), webhook.HostUpdated, []string{body.NewName}, "Success", fmt.Sprintf("Successfully renamed node %s to %s", body.Name, body.NewName))
+
+	return &GenericResponse{
+		Title:   "Success",
+		Detail:  fmt.Sprintf("successfully renamed node %s to %s", body.Name, body.NewName),
+		Changed: 1,
+	}, nil
+}
+
+// fqdnCollision returns the name of a host of hosts other than host using one
+// of its FQDNs, and the FQDN
+func fqdnCollision(host *model.Host, hosts model.HostList) (string, string, bool) {
+	fqdns := make(map[string]bool)
+	for _, fqdn := range host.FQDNs() {
+		fqdns[fqdn] = true
+	}
+
+	for _, other := range hosts {
+		if other.UID == host.UID {
+			continue
+		}
+		for _, fqdn := range other.FQDNs() {
+			if fqdns[fqdn] {
+				return other.Name, fqdn, true
+			}
+		}
+	}
+
+	return "", "", false
+}
+
+func (h *Handler) NodeCredentialList(c fuego.ContextNoBody) (model.CredentialList, error) {
+	ns, err := h.filterByNodesetAndTags(c.Context(), c.QueryParam("nodeset"), c.QueryParam("tags"))
+	if err != nil {
+		return nil, fuego.HTTPError{
+			Err:    err,
+			Title:  "Error",
+			Detail: "failed to filter nodes",
+		}
+	}
+
+	var creds model.CredentialList
+	if ns.Len() == 0 {
+		creds, err = h.db(c.Context()).Credentials()
+	} else {
+		creds, err = h.db(c.Context()).FindCredentials(ns)
+	}
+	if err != nil {
+		return nil, fuego.HTTPError{
+			Err:    err,
+			Title:  "Error",
+			Detail: "failed to get credentials",
+		}
+	}
+
+	return creds.Redacted(), nil
+}
+
+func (h *Handler) NodeCredentialSet(c fuego.ContextWithBody[NodeCredentialsRequest]) (*GenericResponse, error) {
+	ns, err := h.filterByNodesetAndTags(c.Context(), c.QueryParam("nodeset"), c.QueryParam("tags"))
+	if err != nil {
+		return nil, fuego.HTTPError{
+			Err:    err,
+			Title:  "Error",
+			Detail: "failed to filter nodes",
+		}
+	}
+	body, err := c.Body()
+	if err != nil {
+		return nil, fuego.HTTPError{
+			Err:    err,
+			Title:  "Error",
+			Detail: "failed to parse body",
+		}
+	}
+	if body.Kind == "" {
+		body.Kind = model.CredentialKindBMC
+	}
+
+	hostList, err := h.db(c.Context()).FindHosts(ns)
+	if err != nil {
+		return nil, fuego.HTTPError{
+			Err:    err,
+			Title:  "Error",
+			Detail: "failed to find nodes",
+		}
+	}
+	if len(hostList) == 0 {
+		return nil, fuego.HTTPError{
+			Status: http.StatusNotFound,
+			Title:  "Error",
+			Detail: "no nodes found",
+		}
+	}
+
+	creds := make(model.CredentialList, 0, len(hostList))
+	for _, host := range hostList {
+		sealed, err := secret.Seal(secret.Login{Username: body.Username, Password: body.Password})
+		if err != nil {
+			return nil, fuego.HTTPError{
+				Err:    err,
+				Title:  "Error",
+				Detail: fmt.Sprintf("failed to encrypt credentials: %s", err),
+			}
+		}
+		creds = append(creds, &model.Credential{Name: host.Name, Kind: body.Kind, Secret: sealed})
+	}
+
+	err = h.db(c.Context()).StoreCredentials(creds)
+	if err != nil {
+		return nil, h.storeError(c.Context(), err, "failed to store credentials")
+	}
+
+	changed, err := hostList.ToNodeSet()
+	if err == nil {
+		h.writeEvent(c.Context(), "Success", fmt.Sprintf("Successfully set %s credentials of node(s): %s", body.Kind, changed.String()))
+	}
+
+	return &GenericResponse{
+		Title:   "Success",
+		Detail:  fmt.Sprintf("successfully set %s credentials of node(s)", body.Kind),
+		Changed: len(creds),
+	}, nil
+}
+
+func (h *Handler) NodeCredentialDelete(c fuego.ContextNoBody) (*GenericResponse, error) {
+	ns, err := h.filterByNodesetAndTags(c.Context(), c.QueryParam("nodeset"), c.QueryParam("tags"))
+	if err != nil {
+		return nil, fuego.HTTPError{
+			Err:    err,
+			Title:  "Error",
+			Detail: "failed to filter nodes",
+		}
+	}
+	kind := c.QueryParam("kind")
+	if kind == "" {
+		kind = model.CredentialKindBMC
+	}
+
+	changed, err := h.db(c.Context()).DeleteCredentials(ns, kind)
+	if err != nil {
+		return nil, fuego.HTTPError{
+			Err:    err,
+			Title:  "Error",
+			Detail: "failed to delete credentials",
+		}
+	}
+
+	h.writeEvent(c.Context(), "Success", fmt.Sprintf("Successfully deleted %s credentials of node(s): %s", kind, ns.String()))
+
+	return &GenericResponse{
+		Title:   "Success",
+		Detail:  fmt.Sprintf("successfully deleted %s credentials of node(s)", kind),
+		Changed: changed,
+	}, nil
+}
+
+func (h *Handler) NodeTrashList(c fuego.ContextNoBody) (model.TrashedHostList, error) {
+	trash, err := h.db(c.Context()).TrashedHosts()
+	if err != nil {
+		return nil, fuego.HTTPError{
+			Err:    err,
+			Title:  "Error",
+			Detail: "failed to get trash",
+		}
+	}
+
+	return trash, nil
+}
+
+func (h *Handler) NodeTrashRestore(c fuego.ContextNoBody) (*GenericResponse, error) {
+	ns, err := nodeset.NewNodeSet(c.QueryParam("nodeset"))
+	if err != nil || ns.Len() == 0 {
+		return nil, fuego.HTTPError{
+			Err:    err,
+			Status: http.StatusBadRequest,
+			Title:  "Error",
+			Detail: "a nodeset is required",
+		}
+	}
+
+	changed, err := h.db(c.Context()).RestoreHosts(ns)
+	if err != nil {
+		return nil, h.storeError(c.Context(), err, "failed to restore node(s)")
+	}
+
+	h.writeHostEvent(c.Context(), webhook.HostCreated, ns.Iterator().StringSlice(), "Success", fmt.Sprintf("Successfully restored node(s) from the trash: %s", ns.String()))
+
+	return &GenericResponse{
+		Title:   "Success",
+		Detail:  "successfully restored node(s)",
+		Changed: changed,
+	}, nil
+}
+
+func (h *Handler) NodeTrashPurge(c fuego.ContextNoBody) (*GenericResponse, error) {
+	before := time.Now()
+	if !c.QueryParamBool("all") {
+		olderThan, err := util.ParseDuration(c.QueryParam("older_than"))
+		if err != nil {
+			return nil, fuego.HTTPError{
+				Err:    err,
+				Status: http.StatusBadRequest,
+				Title:  "Error",
+				Detail: "older_than must be a duration such as 30d or 12h, or set all",
+			}
+		}
+		before = before.Add(-olderThan)
+	}
+
+	changed, err := h.db(c.Context()).PurgeTrash(before)
+	if err != nil {
+		return nil, fuego.HTTPError{
+			Err:    err,
+			Title:  "Error",
+			Detail: "failed to purge trash",
+		}
+	}
+
+	h.writeEvent(c.Context(), "Success", fmt.Sprintf("Successfully purged %d node(s) deleted before %s from the trash", changed, before.Format(time.RFC3339)))
+
+	return &GenericResponse{
+		Title:   "Success",
+		Detail:  "successfully purged node(s) from the trash",
+		Changed: changed,
+	}, nil
+}
+
+// tombstones returns the tombstones of the given kind deleted since the
+// RFC3339 time
+func (h *Handler) tombstones(ctx context.Context, kind, since string) (model.TombstoneList, error) {
+	t, err := parseSince(since)
+	if err != nil {
+		return nil, err
+	}
+
+	tombstones, err := h.db(ctx).Tombstones(kind, t)
+	if err != nil {
+		return nil, fuego.HTTPError{
+			Err:    err,
+			Title:  "Error",
+			Detail: fmt.Sprintf("failed to get deleted %ss", kind),
+		}
+	}
+
+	return tombstones, nil
+}
+
+// parseSince parses the RFC3339 time of a since query parameter. An empty
+// string returns the zero time which matches everything
+func parseSince(since string) (time.Time, error) {
+	if since == "" {
+		return time.Time{}, nil
+	}
+
+	t, err := time.Parse(time.RFC3339, since)
+	if err != nil {
+		return time.Time{}, fuego.HTTPError{
+			Err:    err,
+			Status: http.StatusBadRequest,
+			Title:  "Error",
+			Detail: fmt.Sprintf("since must be an RFC3339 time such as 2026-10-01T00:00:00Z: %s", since),
+		}
+	}
+
+	return t, nil
+}
+
+func (h *Handler) filterByNodesetAndTags(ctx context.Context, f1, f2 string) (*nodeset.NodeSet, error) {
+	queryNs, err := nodeset.NewNodeSet(f1)
+	if err != nil {
+		return nil, err
+	}
+	tagsNs, _ := nodeset.NewNodeSet("")
+	if f2 != "" {
+		tags := strings.Split(f2, ",")
+		tagsNs, err = h.db(ctx).FindTags(tags)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	compare1 := queryNs.Iterator().StringSlice()
+	compare2 := tagsNs.Iterator().StringSlice()
+
+	combined := []string{}
+	for _, node := range compare1 {
+		if slices.Contains(compare2, node) {
+			combined = append(combined, node)
+		}
+	}
+
+	if len(compare1) == 0 {
+		combined = compare2
+	}
+	if len(compare2) == 0 {
+		combined = compare1
+	}
+
+	return nodeset.NewNodeSet(strings.Join(combined, ","))
+}
+
+func (h *Handler) NodeFileList(c fuego.ContextNoBody) (model.HostFileList, error) {
+	ns, err := h.filterByNodesetAndTags(c.Context(), c.QueryParam("nodeset"), c.QueryParam("tags"))
+	if err != nil {
+		return nil, fuego.HTTPError{
+			Err:    err,
+			Title:  "Error",
+			Detail: "failed to filter nodes",
+		}
+	}
+
+	var files model.HostFileList
+	if ns.Len() == 0 {
+		files, err = h.db(c.Context()).HostFiles()
+	} else {
+		files, err = h.db(c.Context()).FindHostFiles(ns)
+	}
+	if err != nil {
+		return nil, fuego.HTTPError{
+			Err:    err,
+			Title:  "Error",
+			Detail: "failed to get files",
+		}
+	}
+
+	return files, nil
+}
+
+func (h *Handler) NodeFileSet(c fuego.ContextWithBody[NodeFileRequest]) (*GenericResponse, error) {
+	ns, err := h.filterByNodesetAndTags(c.Context(), c.QueryParam("nodeset"), c.QueryParam("tags"))
+	if err != nil {
+		return nil, fuego.HTTPError{
+			Err:    err,
+			Title:  "Error",
+			Detail: "failed to filter nodes",
+		}
+	}
+	body, err := c.Body()
+	if err != nil {
+		return nil, fuego.HTTPError{
+			Err:    err,
+			Title:  "Error",
+			Detail: "failed to parse body",
+		}
+	}
+	data, err := base64.StdEncoding.DecodeString(body.Content)
+	if err != nil {
+		return nil, fuego.HTTPError{
+			Err:    err,
+			Status: http.StatusBadRequest,
+			Title:  "Error",
+			Detail: "content is not base64 encoded",
+		}
+	}
+
+	hostList, err := h.db(c.Context()).FindHosts(ns)
+	if err != nil {
+		return nil, fuego.HTTPError{
+			Err:    err,
+			Title:  "Error",
+			Detail: "failed to find nodes",
+		}
+	}
+	if len(hostList) == 0 {
+		return nil, fuego.HTTPError{
+			Status: http.StatusNotFound,
+			Title:  "Error",
+			Detail: "no nodes found",
+		}
+	}
+
+	files := make(model.HostFileList, 0, len(hostList))
+	for _, host := range hostList {
+		files = append(files, &model.HostFile{Host: host.Name, Name: body.Name, Data: data})
+	}
+
+	err = h.db(c.Context()).StoreHostFiles(files)
+	if err != nil {
+		return nil, h.storeError(c.Context(), err, "failed to store files")
+	}
+
+	changed, err := hostList.ToNodeSet()
+	if err == nil {
+		h.writeEvent(c.Context(), "Success", fmt.Sprintf("Successfully set file %s of node(s): %s", body.Name, changed.String()))
+	}
+
+	return &GenericResponse{
+		Title:   "Success",
+		Detail:  fmt.Sprintf("successfully set file %s of node(s)", body.Name),
+		Changed: len(files),
+	}, nil
+}
+
+func (h *Handler) NodeFileDelete(c fuego.ContextNoBody) (*GenericResponse, error) {
+	ns, err := h.filterByNodesetAndTags(c.Context(), c.QueryParam("nodeset"), c.QueryParam("tags"))
+	if err != nil {
+		return nil, fuego.HTTPError{
+			Err:    err,
+			Title:  "Error",
+			Detail: "failed to filter nodes",
+		}
+	}
+	name := c.QueryParam("name")
+	if name == "" {
+		return nil, fuego.HTTPError{
+			Status: http.StatusBadRequest,
+			Title:  "Error",
+			Detail: "name is required",
+		}
+	}
+
+	changed, err := h.db(c.Context()).DeleteHostFiles(ns, name)
+	if err != nil {
+		return nil, fuego.HTTPError{
+			Err:    err,
+			Title:  "Error",
+			Detail: "failed to delete files",
+		}
+	}
+
+	h.writeEvent(c.Context(), "Success", fmt.Sprintf("Successfully deleted file %s of node(s): %s", name, ns.String()))
+
+	return &GenericResponse{
+		Title:   "Success",
+		Detail:  fmt.Sprintf("successfully deleted file %s of node(s)", name),
+		Changed: changed,
+	}, nil
 }
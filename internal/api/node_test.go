@@ -0,0 +1,185 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package api
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/netip"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"github.com/ubccr/grendel/internal/config"
+	"github.com/ubccr/grendel/internal/webhook"
+)
+
+func TestNodeAddRouterOutsideNetwork(t *testing.T) {
+	viper.Set("api.socket_path", "/tmp/grendel-test.sock")
+	defer viper.Set("api.socket_path", nil)
+	cfg := *config.Current()
+	cfg.DefaultGateway = netip.MustParseAddr("10.64.8.1")
+	defer config.Set(config.Set(&cfg))
+
+	fs := newTestServer(t)
+
+	req := httptest.NewRequest(http.MethodPost, "/v1/nodes", strings.NewReader(`{"node_list": [{"name": "cpn-01", "interfaces": [{"ifname": "eno1", "mac": "d0:93:ae:e1:b5:2e", "ip": "10.64.9.21/24"}]}]}`))
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+	fs.Mux.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+	assert.Contains(t, rec.Body.String(), "router 10.64.8.1 is not in network 10.64.9.0/24 of interface eno1")
+
+	req = httptest.NewRequest(http.MethodPost, "/v1/nodes", strings.NewReader(`{"node_list": [{"name": "cpn-01", "interfaces": [{"ifname": "eno1", "mac": "d0:93:ae:e1:b5:2e", "ip": "10.64.9.21/22"}]}]}`))
+	req.Header.Set("Content-Type", "application/json")
+	rec = httptest.NewRecorder()
+	fs.Mux.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+}
+
+func TestNodeWebhook(t *testing.T) {
+	viper.Set("api.socket_path", "/tmp/grendel-test.sock")
+	defer viper.Set("api.socket_path", nil)
+
+	types := make(chan string, 10)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		types <- r.Header.Get(webhook.EventHeader)
+	}))
+	defer srv.Close()
+
+	webhook.Default.Configure(webhook.Config{Hooks: []*webhook.Hook{{URL: srv.URL, Events: []string{"host.*"}}}})
+	defer webhook.Default.Configure(webhook.Config{})
+	stop := make(chan struct{})
+	defer close(stop)
+	go webhook.Default.Run(stop)
+
+	fs := newTestServer(t)
+
+	send := func(method, target, body string) {
+		req := httptest.NewRequest(method, target, strings.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		req = req.WithContext(context.WithValue(req.Context(), ContextKeyUsername, "admin"))
+		rec := httptest.NewRecorder()
+		fs.Mux.ServeHTTP(rec, req)
+		assert.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	}
+
+	send(http.MethodPost, "/v1/nodes", `{"node_list": [{"name": "cpn-01", "interfaces": [{"ifname": "eno1", "mac": "d0:93:ae:e1:b5:2e", "ip": "10.64.9.21/24"}]}]}`)
+	send(http.MethodPatch, "/v1/nodes/tags/add?nodeset=cpn-01", `{"tags": "gpu"}`)
+	send(http.MethodDelete, "/v1/nodes?nodeset=cpn-01", "")
+
+	// Workers deliver concurrently, in any order
+	got := make([]string, 0, 3)
+	for range 3 {
+		select {
+		case typ := <-types:
+			got = append(got, typ)
+		case <-time.After(5 * time.Second):
+			t.Fatalf("webhooks not delivered, got %v", got)
+		}
+	}
+	assert.ElementsMatch(t, []string{webhook.HostCreated, webhook.HostUpdated, webhook.HostDeleted}, got)
+}
+
+func TestNodePrometheusSD(t *testing.T) {
+	viper.Set("api.socket_path", "/tmp/grendel-test.sock")
+	defer viper.Set("api.socket_path", nil)
+
+	fs := newTestServer(t)
+
+	req := httptest.NewRequest(http.MethodPost, "/v1/nodes", strings.NewReader(`{"node_list": [
+		{"name": "cpn-01", "tags": ["compute", "rack=a01"], "interfaces": [{"ifname": "eno1", "mac": "d0:93:ae:e1:b5:2e", "ip": "10.64.9.21/24", "fqdn": "cpn-01.example.com"}]},
+		{"name": "cpn-02", "provision": true, "tags": ["compute"], "interfaces": [{"ifname": "eno1", "mac": "d0:93:ae:e1:b5:2f", "ip": "10.64.9.22/24"}]},
+		{"name": "srv-01", "interfaces": [{"ifname": "eno1", "mac": "d0:93:ae:e1:b5:30", "ip": "10.64.9.23/24"}]}
+	]}`))
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+	fs.Mux.ServeHTTP(rec, req)
+	assert.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+
+	get := func(query string) string {
+		req := httptest.NewRequest(http.MethodGet, "/v1/nodes/prometheus-sd?"+query, nil)
+		rec := httptest.NewRecorder()
+		fs.Mux.ServeHTTP(rec, req)
+		assert.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+		return rec.Body.String()
+	}
+
+	assert.JSONEq(t, `[
+		{"targets": ["cpn-01.example.com:9100"], "labels": {"node": "cpn-01", "rack": "a01", "tags": ",compute,"}},
+		{"targets": ["10.64.9.22:9100"], "labels": {"node": "cpn-02", "tags": ",compute,"}},
+		{"targets": ["10.64.9.23:9100"], "labels": {"node": "srv-01"}}
+	]`, get(""))
+	assert.JSONEq(t, `[{"targets": ["cpn-01.example.com:9256"], "labels": {"node": "cpn-01", "rack": "a01", "tags": ",compute,"}}]`,
+		get("tags=compute&port=9256&exclude_provision=true"))
+	assert.JSONEq(t, `[]`, get("tags=missing"))
+}
+
+func TestNodeBootImageOnlyIfCurrent(t *testing.T) {
+	viper.Set("api.socket_path", "/tmp/grendel-test.sock")
+	defer viper.Set("api.socket_path", nil)
+
+	fs := newTestServer(t)
+
+	send := func(method, target, body string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(method, target, strings.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		rec := httptest.NewRecorder()
+		fs.Mux.ServeHTTP(rec, req)
+		return rec
+	}
+
+	rec := send(http.MethodPost, "/v1/images", `{"boot_images": [{"name": "rocky-8", "kernel": "/vmlinuz"}, {"name": "rocky-9", "kernel": "/vmlinuz"}]}`)
+	assert.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	rec = send(http.MethodPost, "/v1/nodes", `{"node_list": [{"name": "cpn-01", "boot_image": "rocky-8"}, {"name": "cpn-02"}]}`)
+	assert.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+
+	// A node assigned another image is refused without changing any
+	rec = send(http.MethodPatch, "/v1/nodes/image?nodeset=cpn-[01-02]", `{"image": "rocky-9", "only_if_current": ["rocky-8"]}`)
+	assert.Equal(t, http.StatusConflict, rec.Code, rec.Body.String())
+	rec = send(http.MethodGet, "/v1/nodes?nodeset=cpn-01", "")
+	assert.Contains(t, rec.Body.String(), `"boot_image":"rocky-8"`)
+
+	rec = send(http.MethodPatch, "/v1/nodes/image?nodeset=cpn-[01-02]", `{"image": "rocky-9", "only_if_current": ["rocky-8", ""]}`)
+	assert.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	rec = send(http.MethodPatch, "/v1/nodes/image?nodeset=cpn-01", `{"image": "rocky-8", "only_if_current": ["rocky-8"]}`)
+	assert.Equal(t, http.StatusConflict, rec.Code, rec.Body.String())
+}
+
+func TestNodeRenameFQDNConflict(t *testing.T) {
+	viper.Set("api.socket_path", "/tmp/grendel-test.sock")
+	defer viper.Set("api.socket_path", nil)
+
+	fs := newTestServer(t)
+
+	send := func(method, target, body string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(method, target, strings.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		rec := httptest.NewRecorder()
+		fs.Mux.ServeHTTP(rec, req)
+		return rec
+	}
+
+	rec := send(http.MethodPost, "/v1/nodes", `{"node_list": [
+		{"name": "cpn-01", "interfaces": [{"ifname": "eno1", "ip": "10.64.9.21/24", "fqdn": "cpn-01.example.com"}]},
+		{"name": "svc-01", "interfaces": [{"ifname": "eno1", "ip": "10.64.9.22/24", "fqdn": "svc-01.example.com,cpn-03.example.com"}]}
+	]}`)
+	assert.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+
+	// The renamed FQDN is used by another node
+	rec = send(http.MethodPatch, "/v1/nodes/rename", `{"name": "cpn-01", "new_name": "cpn-03"}`)
+	assert.Equal(t, http.StatusConflict, rec.Code, rec.Body.String())
+	assert.Contains(t, rec.Body.String(), "svc-01")
+
+	rec = send(http.MethodPatch, "/v1/nodes/rename", `{"name": "cpn-01", "new_name": "cpn-04"}`)
+	assert.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	rec = send(http.MethodGet, "/v1/nodes?nodeset=cpn-04", "")
+	assert.Contains(t, rec.Body.String(), `"fqdn":"cpn-04.example.com"`)
+}
@@ -0,0 +1,122 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package api
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/go-fuego/fuego"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"github.com/ubccr/grendel/internal/store/sqlstore"
+)
+
+func newTestServer(t *testing.T) *fuego.Server {
+	db, err := sqlstore.New(":memory:")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	h, err := NewHandler(db)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	s := &Server{}
+	fs := s.newFuegoServer(fuego.WithAddr("127.0.0.1:0"))
+	h.SetupRoutes(fs)
+
+	return fs
+}
+
+func operations(doc *openapi3.T) []string {
+	ops := make([]string, 0)
+	for path, item := range doc.Paths.Map() {
+		for method := range item.Operations() {
+			ops = append(ops, method+" "+path)
+		}
+	}
+
+	return ops
+}
+
+// TestOpenAPIRoutes ensures every route registered by SetupRoutes is present
+// in the committed OpenAPI spec and vice versa. If this test fails regenerate
+// api/openapi.json and the client in pkg/client.
+func TestOpenAPIRoutes(t *testing.T) {
+	fs := newTestServer(t)
+
+	loader := openapi3.NewLoader()
+	doc, err := loader.LoadFromFile("../../api/openapi.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	registered := operations(fs.OpenAPI.Description())
+	spec := operations(doc)
+
+	assert.NotEmpty(t, registered)
+	for _, op := range registered {
+		assert.Contains(t, spec, op, "route missing from api/openapi.json")
+	}
+	for _, op := range spec {
+		assert.Contains(t, registered, op, "route in api/openapi.json is not registered")
+	}
+}
+
+func TestValidateRequestBody(t *testing.T) {
+	viper.Set("api.socket_path", "/tmp/grendel-test.sock")
+	defer viper.Set("api.socket_path", nil)
+
+	fs := newTestServer(t)
+
+	req := httptest.NewRequest(http.MethodPost, "/v1/images", strings.NewReader(`{"boot_images": [{"name": "compute", "kernel": 5}]}`))
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+	fs.Mux.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+
+	var res fuego.HTTPError
+	err := json.Unmarshal(rec.Body.Bytes(), &res)
+	if assert.NoError(t, err) && assert.Len(t, res.Errors, 1) {
+		assert.Equal(t, "/boot_images/0/kernel", res.Errors[0].Name)
+		assert.Contains(t, res.Detail, "/boot_images/0/kernel")
+	}
+
+	req = httptest.NewRequest(http.MethodPost, "/v1/images", strings.NewReader(`{"boot_images": [{"name": "compute", "kernel": "/vmlinuz"}]}`))
+	req.Header.Set("Content-Type", "application/json")
+	rec = httptest.NewRecorder()
+	fs.Mux.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+
+	req = httptest.NewRequest(http.MethodPost, "/v1/images", strings.NewReader(`{"boot_images": `))
+	req.Header.Set("Content-Type", "application/json")
+	rec = httptest.NewRecorder()
+	fs.Mux.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+}
+
+func TestServeOpenAPISpec(t *testing.T) {
+	fs := newTestServer(t)
+	fs.SpecHandler(fs.Engine)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/openapi.json", nil)
+	rec := httptest.NewRecorder()
+	fs.Mux.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+	doc, err := openapi3.NewLoader().LoadFromData(rec.Body.Bytes())
+	if assert.NoError(t, err) {
+		assert.NotNil(t, doc.Paths.Value("/v1/nodes"))
+	}
+}
@@ -18,6 +18,7 @@ func setupOpenapiConfig(swaggerUI bool) fuego.OpenAPIConfig {
 	return fuego.OpenAPIConfig{
 		DisableSwaggerUI: !swaggerUI,
 		JSONFilePath:     "./api/openapi.json",
+		SpecURL:          "/api/openapi.json",
 		PrettyFormatJSON: true,
 	}
 }
@@ -67,3 +68,15 @@ func schemaCustomizer() openapi3gen.SchemaCustomizerFn {
 		return nil
 	}
 }
+
+// binaryResponse documents a 200 response with a binary body of contentType
+func binaryResponse(description, contentType string) func(*fuego.BaseRoute) {
+	return func(r *fuego.BaseRoute) {
+		schema := openapi3.NewStringSchema().WithFormat("binary")
+		r.Operation.Responses.Set("200", &openapi3.ResponseRef{
+			Value: openapi3.NewResponse().
+				WithDescription(description).
+				WithContent(openapi3.NewContentWithSchema(schema, []string{contentType})),
+		})
+	}
+}
@@ -0,0 +1,24 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package api
+
+import (
+	"github.com/go-fuego/fuego"
+	"github.com/ubccr/grendel/internal/config"
+)
+
+type ReadOnlyResponse struct {
+	Enabled bool   `json:"enabled"`
+	Primary string `json:"primary,omitempty" description:"grendel instance accepting the writes refused by this one" example:"https://grendel1.example.com:6667"`
+}
+
+// GrendelReadOnly returns whether the grendel serve process running the API
+// refuses writes to the data store
+func (h *Handler) GrendelReadOnly(c fuego.ContextNoBody) (*ReadOnlyResponse, error) {
+	return &ReadOnlyResponse{
+		Enabled: config.Viper().GetBool("readonly"),
+		Primary: config.Viper().GetString("readonly_primary"),
+	}, nil
+}
@@ -0,0 +1,59 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package api
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/go-fuego/fuego"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/ubccr/grendel/internal/store/readonly"
+	"github.com/ubccr/grendel/internal/store/sqlstore"
+)
+
+func TestReadOnly(t *testing.T) {
+	viper.Set("api.socket_path", "/tmp/grendel-test.sock")
+	viper.Set("readonly", true)
+	viper.Set("readonly_primary", "https://grendel1.example.com:6667")
+	defer func() {
+		viper.Set("api.socket_path", nil)
+		viper.Set("readonly", nil)
+		viper.Set("readonly_primary", nil)
+	}()
+
+	db, err := sqlstore.New(":memory:")
+	require.NoError(t, err)
+	defer db.Close()
+
+	h, err := NewHandler(readonly.New(db, viper.GetString("readonly_primary")))
+	require.NoError(t, err)
+	s := &Server{}
+	fs := s.newFuegoServer(fuego.WithAddr("127.0.0.1:0"))
+	h.SetupRoutes(fs)
+
+	req := httptest.NewRequest(http.MethodPost, "/v1/nodes", strings.NewReader(`{"node_list": [{"name": "cpn-01", "interfaces": [{"ifname": "eno1", "mac": "d0:93:ae:e1:b5:2e", "ip": "10.64.9.21/24"}]}]}`))
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+	fs.Mux.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusForbidden, rec.Code, rec.Body.String())
+	assert.Contains(t, rec.Body.String(), "datastore is read-only, send changes to the primary https://grendel1.example.com:6667")
+
+	req = httptest.NewRequest(http.MethodGet, "/v1/nodes", nil)
+	rec = httptest.NewRecorder()
+	fs.Mux.ServeHTTP(rec, req)
+	assert.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+
+	req = httptest.NewRequest(http.MethodGet, "/v1/grendel/readonly", nil)
+	rec = httptest.NewRecorder()
+	fs.Mux.ServeHTTP(rec, req)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.JSONEq(t, `{"enabled": true, "primary": "https://grendel1.example.com:6667"}`, rec.Body.String())
+}
@@ -0,0 +1,48 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package api
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/go-fuego/fuego"
+	"github.com/ubccr/grendel/internal/config"
+)
+
+type ReloadResponse struct {
+	Title           string   `json:"title"`
+	Detail          string   `json:"detail"`
+	Changed         []string `json:"changed" description:"Settings changed by the reload"`
+	RestartRequired []string `json:"restart_required" description:"Changed settings which only take effect after a restart"`
+}
+
+// GrendelReload reloads the configuration file like SIGHUP. An invalid
+// configuration is rejected as a whole and the running configuration kept
+func (h *Handler) GrendelReload(c fuego.ContextNoBody) (*ReloadResponse, error) {
+	result, err := config.Reload()
+	if err != nil {
+		return nil, fuego.HTTPError{
+			Err:    err,
+			Status: http.StatusBadRequest,
+			Title:  "Error",
+			Detail: fmt.Sprintf("failed to reload configuration, keeping the current configuration: %s", err),
+		}
+	}
+
+	msg := fmt.Sprintf("Reloaded configuration, %d settings changed", len(result.Changed))
+	if len(result.RestartRequired) > 0 {
+		msg += fmt.Sprintf(", restart required to apply: %s", strings.Join(result.RestartRequired, ", "))
+	}
+	h.writeEvent(c.Context(), "Success", msg)
+
+	return &ReloadResponse{
+		Title:           "Success",
+		Detail:          "successfully reloaded configuration",
+		Changed:         result.Changed,
+		RestartRequired: result.RestartRequired,
+	}, nil
+}
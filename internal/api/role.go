@@ -27,7 +27,7 @@ type PatchRolesRequest struct {
 func (h *Handler) GetRoles(ctx fuego.ContextNoBody) (*GetRolesResponse, error) {
 	filter := ctx.QueryParam("name")
 
-	roles, err := h.DB.GetRoles()
+	roles, err := h.db(ctx.Context()).GetRoles()
 	if err != nil {
 		return nil, fuego.HTTPError{
 			Err:    err,
@@ -69,7 +69,7 @@ func (h *Handler) PostRoles(ctx fuego.ContextWithBody[PostRolesRequest]) (*Gener
 		}
 	}
 
-	err = h.DB.AddRole(body.Role, body.InheritedRole)
+	err = h.db(ctx.Context()).AddRole(body.Role, body.InheritedRole)
 	if err != nil {
 		return nil, fuego.HTTPError{
 			Err:    err,
@@ -107,7 +107,7 @@ func (h *Handler) PatchRoles(ctx fuego.ContextWithBody[PatchRolesRequest]) (*Gen
 		}
 	}
 
-	err = h.DB.UpdateRolePermissions(body.Role, body.Permissions)
+	err = h.db(ctx.Context()).UpdateRolePermissions(body.Role, body.Permissions)
 	if err != nil {
 		return nil, fuego.HTTPError{
 			Err:    err,
@@ -139,7 +139,7 @@ func (h *Handler) DeleteRoles(ctx fuego.ContextNoBody) (*GenericResponse, error)
 		}
 	}
 
-	err := h.DB.DeleteRole(roles)
+	err := h.db(ctx.Context()).DeleteRole(roles)
 	if err != nil {
 		return nil, fuego.HTTPError{
 			Err:    err,
@@ -0,0 +1,174 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package api
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-fuego/fuego"
+	"github.com/ubccr/grendel/pkg/model"
+)
+
+type ScheduleRequest struct {
+	Action  string    `json:"action" validate:"required" description:"image, provision, power-cycle or webhook"`
+	Nodeset string    `json:"nodeset" description:"nodes to run the action on, every node when empty with no tags"`
+	Tags    string    `json:"tags" description:"comma separated tags of the nodes to run the action on"`
+	Arg     string    `json:"arg" description:"boot image name, provision true or false, redfish boot override of the power cycle or webhook message"`
+	RunAt   time.Time `json:"run_at" validate:"required"`
+}
+
+// ScheduleList returns the scheduled actions, optionally those with the
+// given statuses
+func (h *Handler) ScheduleList(c fuego.ContextNoBody) (model.ScheduledActionList, error) {
+	actions, err := h.db(c.Context()).ScheduledActions()
+	if err != nil {
+		return nil, fuego.HTTPError{
+			Err:    err,
+			Title:  "Error",
+			Detail: "failed to get scheduled actions",
+		}
+	}
+
+	if c.QueryParam("status") == "" {
+		return actions, nil
+	}
+
+	statuses := strings.Split(c.QueryParam("status"), ",")
+	filtered := make(model.ScheduledActionList, 0, len(actions))
+	for _, a := range actions {
+		if slices.Contains(statuses, a.Status) {
+			filtered = append(filtered, a)
+		}
+	}
+
+	return filtered, nil
+}
+
+// ScheduleGet returns a scheduled action with the report of each host once it ran
+func (h *Handler) ScheduleGet(c fuego.ContextNoBody) (*model.ScheduledAction, error) {
+	id, err := scheduleID(c.PathParam("id"))
+	if err != nil {
+		return nil, err
+	}
+
+	action, err := h.db(c.Context()).LoadScheduledAction(id)
+	if err != nil {
+		return nil, h.storeError(c.Context(), err, "failed to get scheduled action")
+	}
+
+	return action, nil
+}
+
+// ScheduleAdd queues an action to run on a nodeset at a later time. The
+// nodes matched by tags, or every node, are resolved when the action is
+// added
+func (h *Handler) ScheduleAdd(c fuego.ContextWithBody[ScheduleRequest]) (*model.ScheduledAction, error) {
+	body, err := c.Body()
+	if err != nil {
+		return nil, fuego.HTTPError{
+			Err:    err,
+			Title:  "Error",
+			Detail: "failed to parse body",
+		}
+	}
+
+	nodes := body.Nodeset
+	if body.Tags != "" || body.Nodeset == "" {
+		ns, err := h.filterByNodesetAndTags(c.Context(), body.Nodeset, body.Tags)
+		if err != nil {
+			return nil, fuego.HTTPError{
+				Err:    err,
+				Title:  "Error",
+				Detail: "failed to filter nodes",
+			}
+		}
+
+		if ns.Len() == 0 && body.Tags == "" {
+			hosts, err := h.db(c.Context()).Hosts()
+			if err != nil {
+				return nil, fuego.HTTPError{
+					Err:    err,
+					Title:  "Error",
+					Detail: "failed to find nodes",
+				}
+			}
+			if ns, err = hosts.ToNodeSet(); err != nil {
+				return nil, err
+			}
+		}
+
+		if ns.Len() == 0 {
+			return nil, fuego.HTTPError{
+				Err:    errors.New("no nodes found"),
+				Status: http.StatusBadRequest,
+				Title:  "Error",
+				Detail: "no nodes found",
+			}
+		}
+		nodes = ns.String()
+	}
+
+	if body.Action == model.ScheduleActionImage && body.Arg != "" {
+		if _, err := h.db(c.Context()).LoadBootImage(body.Arg); err != nil {
+			return nil, h.storeError(c.Context(), err, fmt.Sprintf("failed to find boot image %s", body.Arg))
+		}
+	}
+
+	username, _ := c.Context().Value(ContextKeyUsername).(string)
+	action := &model.ScheduledAction{
+		Action:    body.Action,
+		Nodeset:   nodes,
+		Arg:       body.Arg,
+		RunAt:     body.RunAt,
+		CreatedBy: username,
+	}
+	if err := h.db(c.Context()).StoreScheduledAction(action); err != nil {
+		return nil, h.storeError(c.Context(), err, "failed to schedule action")
+	}
+
+	h.writeEvent(c.Context(), "Success", fmt.Sprintf("Scheduled action %d: %s on %s at %s", action.ID, body.Action, nodes, body.RunAt.Format(time.RFC3339)))
+
+	return action, nil
+}
+
+// ScheduleCancel cancels a pending scheduled action
+func (h *Handler) ScheduleCancel(c fuego.ContextNoBody) (*GenericResponse, error) {
+	id, err := scheduleID(c.PathParam("id"))
+	if err != nil {
+		return nil, err
+	}
+
+	if err := h.db(c.Context()).CancelScheduledAction(id); err != nil {
+		return nil, h.storeError(c.Context(), err, "failed to cancel scheduled action")
+	}
+
+	h.writeEvent(c.Context(), "Success", fmt.Sprintf("Cancelled scheduled action %d", id))
+
+	return &GenericResponse{
+		Title:   "Success",
+		Detail:  fmt.Sprintf("successfully cancelled scheduled action %d", id),
+		Changed: 1,
+	}, nil
+}
+
+func scheduleID(s string) (int64, error) {
+	id, err := strconv.ParseInt(s, 10, 64)
+	if err != nil {
+		return 0, fuego.HTTPError{
+			Err:    err,
+			Status: http.StatusBadRequest,
+			Title:  "Error",
+			Detail: fmt.Sprintf("invalid scheduled action id: %q", s),
+		}
+	}
+
+	return id, nil
+}
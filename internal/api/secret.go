@@ -0,0 +1,83 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package api
+
+import (
+	"fmt"
+
+	"github.com/go-fuego/fuego"
+	"github.com/ubccr/grendel/internal/secret"
+	"github.com/ubccr/grendel/pkg/model"
+)
+
+type SecretRequest struct {
+	Value   string `json:"value" validate:"required"`
+	OneTime bool   `json:"one_time" description:"render the secret once per boot token, later requests with the token get a placeholder"`
+}
+
+// SecretList returns the names of the secrets. Values are never returned
+func (h *Handler) SecretList(c fuego.ContextNoBody) (model.SecretList, error) {
+	secrets, err := h.db(c.Context()).Secrets()
+	if err != nil {
+		return nil, fuego.HTTPError{
+			Err:    err,
+			Title:  "Error",
+			Detail: "failed to get secrets",
+		}
+	}
+
+	return secrets, nil
+}
+
+// SecretSet stores a secret encrypted with the credentials key
+func (h *Handler) SecretSet(c fuego.ContextWithBody[SecretRequest]) (*GenericResponse, error) {
+	name := c.PathParam("name")
+	body, err := c.Body()
+	if err != nil {
+		return nil, fuego.HTTPError{
+			Err:    err,
+			Title:  "Error",
+			Detail: "failed to parse body",
+		}
+	}
+
+	sealed, err := secret.SealString(body.Value)
+	if err != nil {
+		return nil, fuego.HTTPError{
+			Err:    err,
+			Title:  "Error",
+			Detail: fmt.Sprintf("failed to encrypt secret: %s", err),
+		}
+	}
+
+	err = h.db(c.Context()).StoreSecret(&model.Secret{Name: name, Value: sealed, OneTime: body.OneTime})
+	if err != nil {
+		return nil, h.storeError(c.Context(), err, "failed to store secret")
+	}
+
+	h.writeEvent(c.Context(), "Success", fmt.Sprintf("Successfully set secret %s", name))
+
+	return &GenericResponse{
+		Title:   "Success",
+		Detail:  fmt.Sprintf("successfully set secret %s", name),
+		Changed: 1,
+	}, nil
+}
+
+// SecretDelete deletes a secret
+func (h *Handler) SecretDelete(c fuego.ContextNoBody) (*GenericResponse, error) {
+	name := c.PathParam("name")
+	if err := h.db(c.Context()).DeleteSecret(name); err != nil {
+		return nil, h.storeError(c.Context(), err, "failed to delete secret")
+	}
+
+	h.writeEvent(c.Context(), "Success", fmt.Sprintf("Successfully deleted secret %s", name))
+
+	return &GenericResponse{
+		Title:   "Success",
+		Detail:  fmt.Sprintf("successfully deleted secret %s", name),
+		Changed: 1,
+	}, nil
+}
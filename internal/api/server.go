@@ -6,17 +6,23 @@ package api
 
 import (
 	"context"
+	"crypto/tls"
 	"errors"
-	"fmt"
 	"net"
+	"net/http"
 	"os"
 	"strconv"
 	"time"
 
 	"github.com/getkin/kin-openapi/openapi3gen"
 	"github.com/go-fuego/fuego"
+	"github.com/ubccr/grendel/internal/accesslog"
+	"github.com/ubccr/grendel/internal/artifact"
+	"github.com/ubccr/grendel/internal/health"
 	"github.com/ubccr/grendel/internal/logger"
+	"github.com/ubccr/grendel/internal/ratelimit"
 	"github.com/ubccr/grendel/internal/store"
+	"github.com/ubccr/grendel/internal/store/namespace"
 	"github.com/ubccr/grendel/internal/util"
 )
 
@@ -32,9 +38,25 @@ type Server struct {
 	CertFile      string
 	Hostname      string
 	DB            store.Store
+	Limiter       *ratelimit.Limiter
+	AccessLog     *accesslog.Logger
+	Listener      net.Listener // socket passed by systemd, used instead of binding SocketPath or the address
 	server        *fuego.Server
+	listener      net.Listener
+	certificate   *tls.Certificate
 	SwaggerUI     bool
 	CORS          bool
+
+	// StoreTimeout is the deadline of the datastore operations of a
+	// request, 0 sets no deadline
+	StoreTimeout time.Duration
+
+	// Artifacts are served at /v1/artifacts, the builtin artifacts when nil
+	Artifacts *artifact.Registry
+
+	// Namespaces are selected with NamespaceHeader, only the default
+	// namespace of DB is served when nil
+	Namespaces *namespace.Registry
 }
 
 func NewServer(db store.Store, socket, address string) (*Server, error) {
@@ -44,34 +66,16 @@ func NewServer(db store.Store, socket, address string) (*Server, error) {
 		return s, nil
 	}
 
-	shost, sport, err := net.SplitHostPort(address)
+	ip, port, err := util.ParseListenAddress(address, DefaultPort)
 	if err != nil {
 		return nil, err
 	}
 
-	if shost == "" {
-		shost = net.IPv4zero.String()
-	}
-
-	port := DefaultPort
-	if sport != "" {
-		var err error
-		port, err = strconv.Atoi(sport)
-		if err != nil {
-			return nil, err
-		}
-	}
-
 	s.Port = port
 
-	ip := net.ParseIP(shost)
-	if ip == nil || ip.To4() == nil {
-		return nil, fmt.Errorf("Invalid IPv4 address: %s", shost)
-	}
-
 	s.ListenAddress = ip
 
-	if !ip.To4().Equal(net.IPv4zero) {
+	if !ip.IsUnspecified() {
 		s.ServerAddress = ip
 		return s, nil
 	}
@@ -86,9 +90,27 @@ func NewServer(db store.Store, socket, address string) (*Server, error) {
 	return s, nil
 }
 
-func (s *Server) Serve() error {
+// address returns the host and port the server listens on, with IPv6
+// addresses in brackets
+func (s *Server) address() string {
+	return net.JoinHostPort(s.ListenAddress.String(), strconv.Itoa(s.Port))
+}
 
-	var listen func(*fuego.Server)
+// Listen binds the socket of the server and loads its certificate. Serve
+// binds it when Listen was not called
+func (s *Server) Listen() error {
+	if s.CertFile != "" && s.KeyFile != "" {
+		cert, err := tls.LoadX509KeyPair(s.CertFile, s.KeyFile)
+		if err != nil {
+			return err
+		}
+		s.certificate = &cert
+	}
+
+	if s.Listener != nil {
+		s.listener = s.Listener
+		return nil
+	}
 
 	if s.SocketPath != "" {
 		os.Remove(s.SocketPath)
@@ -98,17 +120,73 @@ func (s *Server) Serve() error {
 		}
 
 		if err := os.Chmod(s.SocketPath, 0770); err != nil {
+			unixListener.Close()
+			return err
+		}
+		s.listener = unixListener
+		return nil
+	}
+
+	listener, err := net.Listen("tcp", s.address())
+	if err != nil {
+		return err
+	}
+	s.listener = listener
+
+	return nil
+}
+
+func (s *Server) Serve() error {
+	if s.listener == nil {
+		if err := s.Listen(); err != nil {
 			return err
 		}
+	}
+
+	if s.SocketPath != "" {
 		log.Printf("Listening on unix domain socket: %s", s.SocketPath)
-		listen = fuego.WithListener(unixListener)
+	}
+
+	s.server = s.newFuegoServer(fuego.WithListener(s.listener))
+
+	h, err := NewHandler(s.DB)
+	if err != nil {
+		return err
+	}
+	if s.Artifacts != nil {
+		h.Artifacts = s.Artifacts
+	}
+	h.Namespaces = s.Namespaces
+
+	h.SetupRoutes(s.server)
+	if s.certificate != nil {
+		s.Scheme = "https"
+		s.server.Server.TLSConfig = &tls.Config{Certificates: []tls.Certificate{*s.certificate}}
+		log.Infof("Listening on %s://%s", s.Scheme, s.address())
+		// The certificate is loaded by Listen
+		err = s.server.RunTLS("", "")
 	} else {
-		addr := fmt.Sprintf("%s:%d", s.ListenAddress, s.Port)
+		// Fix >30s handlers from returning an empty body
+		s.server.Server.WriteTimeout = time.Minute * 5
+
+		if s.SocketPath == "" {
+			log.Infof("Listening on %s://%s", s.Scheme, s.address())
+		}
+		err = s.server.Run()
+	}
 
-		listen = fuego.WithAddr(addr)
+	// Closed by Shutdown
+	if errors.Is(err, http.ErrServerClosed) {
+		return nil
 	}
 
-	s.server = fuego.NewServer(
+	return err
+}
+
+// newFuegoServer returns the fuego server used to serve the API along with
+// the OpenAPI spec at /api/openapi.json
+func (s *Server) newFuegoServer(listen func(*fuego.Server)) *fuego.Server {
+	server := fuego.NewServer(
 		listen,
 		fuego.WithEngineOptions(
 			fuego.WithOpenAPIGeneratorOptions(
@@ -121,39 +199,38 @@ func (s *Server) Serve() error {
 		fuego.WithErrorSerializer(ErrorSerializer),
 		fuego.WithGlobalMiddlewares(
 			corsMiddleware(s.CORS),
+			rateLimitMiddleware(s.Limiter),
 			logMiddleware,
+			accessLogMiddleware(s.AccessLog),
+			storeDeadlineMiddleware(s.StoreTimeout),
 		),
 		fuego.WithSecurity(setupSecurity()),
 	)
 
-	s.server.OpenAPI.Description().Info.Title = "Grendel API"
-	s.server.OpenAPI.Description().Info.Description = "OpenAPI spec for the Grendel API"
-	s.server.OpenAPI.Description().Info.Version = "0.2.0"
+	server.OpenAPI.Description().Info.Title = "Grendel API"
+	server.OpenAPI.Description().Info.Description = "OpenAPI spec for the Grendel API"
+	server.OpenAPI.Description().Info.Version = "0.2.0"
 
-	h, err := NewHandler(s.DB)
-	if err != nil {
-		return err
-	}
+	return server
+}
 
-	h.SetupRoutes(s.server)
-	if s.CertFile != "" && s.KeyFile != "" {
-		s.Scheme = "https"
-		log.Infof("Listening on %s://%s:%d", s.Scheme, s.ListenAddress, s.Port)
-		return s.server.RunTLS(s.CertFile, s.KeyFile)
+// Addr returns the address the server is bound to, nil until Listen is
+// called
+func (s *Server) Addr() net.Addr {
+	if s.listener == nil {
+		return nil
 	}
+	return s.listener.Addr()
+}
 
-	// Fix >30s handlers from returning an empty body
-	s.server.Server.WriteTimeout = time.Minute * 5
-
-	if s.SocketPath == "" {
-		log.Infof("Listening on %s://%s:%d", s.Scheme, s.ListenAddress, s.Port)
-	}
-	return s.server.Run()
+// Check returns an error unless the listener of the server is open
+func (s *Server) Check(ctx context.Context) error {
+	return health.SocketOpen(s.listener)
 }
 
 func (s *Server) Shutdown(ctx context.Context) error {
 	if s.server != nil {
-		return s.server.Shutdown(context.TODO())
+		return s.server.Shutdown(ctx)
 
 	}
 	return errors.New("failed to create api server")
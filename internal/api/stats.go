@@ -0,0 +1,76 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package api
+
+import (
+	"sync"
+	"time"
+
+	"github.com/go-fuego/fuego"
+	"github.com/ubccr/grendel/internal/stats"
+	"github.com/ubccr/grendel/internal/store"
+	"github.com/ubccr/grendel/pkg/model"
+)
+
+const (
+	// statsTTL is how long the summary statistics are cached, so dashboards
+	// polling them do not query the datastore on every request
+	statsTTL = 10 * time.Second
+
+	// dnsRateWindow is the window of the DNS queries per second
+	dnsRateWindow = 5 * time.Minute
+)
+
+// statsCache holds the last summary statistics computed for each namespace
+type statsCache struct {
+	mu    sync.Mutex
+	stats map[string]*model.Stats
+}
+
+// get returns the cached statistics of namespace, computing them with db
+// when older than statsTTL
+func (s *statsCache) get(namespace string, db store.Store, now time.Time) (*model.Stats, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if cached := s.stats[namespace]; cached != nil && now.Sub(cached.Generated) < statsTTL {
+		return cached, nil
+	}
+
+	hosts, err := db.HostStats()
+	if err != nil {
+		return nil, err
+	}
+
+	midnight := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+	if s.stats == nil {
+		s.stats = make(map[string]*model.Stats)
+	}
+	s.stats[namespace] = &model.Stats{
+		Hosts:                     *hosts,
+		DHCPAcksLastHour:          int(stats.DHCPAcks.Since(now.Add(-time.Hour))),
+		ProvisionCompletionsToday: int(stats.ProvisionCompletions.Since(midnight)),
+		DNSQueriesPerSecond:       stats.DNSQueries.Rate(dnsRateWindow),
+		Since:                     stats.Started.UTC(),
+		Generated:                 now.UTC(),
+	}
+
+	return s.stats[namespace], nil
+}
+
+// GrendelStats returns a summary of the hosts and of the recent activity of
+// the services running in the grendel serve process of the API server
+func (h *Handler) GrendelStats(c fuego.ContextNoBody) (*model.Stats, error) {
+	s, err := h.stats.get(namespaceOf(c.Context()), h.db(c.Context()), time.Now())
+	if err != nil {
+		return nil, fuego.HTTPError{
+			Err:    err,
+			Title:  "Error",
+			Detail: "failed to compute statistics",
+		}
+	}
+
+	return s, nil
+}
@@ -0,0 +1,39 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package api
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/ubccr/grendel/internal/store/sqlstore"
+	"github.com/ubccr/grendel/internal/tests"
+	"github.com/ubccr/grendel/pkg/model"
+)
+
+func TestStatsCache(t *testing.T) {
+	db, err := sqlstore.New(":memory:")
+	require.NoError(t, err)
+	defer db.Close()
+
+	cache := &statsCache{}
+	now := time.Now()
+	s, err := cache.get("default", db, now)
+	require.NoError(t, err)
+	assert.Zero(t, s.Hosts.Total)
+
+	require.NoError(t, db.StoreHost(tests.HostFactory.MustCreate().(*model.Host)))
+
+	s, err = cache.get("default", db, now.Add(statsTTL-time.Second))
+	require.NoError(t, err)
+	assert.Zero(t, s.Hosts.Total, "statistics are cached")
+
+	s, err = cache.get("default", db, now.Add(statsTTL))
+	require.NoError(t, err)
+	assert.Equal(t, 1, s.Hosts.Total)
+	assert.Equal(t, now.Add(statsTTL).UTC(), s.Generated)
+}
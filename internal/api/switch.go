@@ -1,8 +1,10 @@
 package api
 
 import (
+	"context"
 	"errors"
 	"fmt"
+	"net"
 	"slices"
 	"strings"
 
@@ -38,7 +40,7 @@ func (h *Handler) SwitchGetLLDP(c fuego.ContextNoBody) (*model.SwitchLLDPList, e
 		}
 	}
 
-	nodeList, err := h.DB.FindHosts(ns)
+	nodeList, err := h.db(c.Context()).FindHosts(ns)
 	if err != nil {
 		return nil, fuego.HTTPError{
 			Err:    err,
@@ -98,3 +100,492 @@ func (h *Handler) SwitchGetLLDP(c fuego.ContextNoBody) (*model.SwitchLLDPList, e
 
 	return &resSlice, nil
 }
+
+type SwitchScanEntry struct {
+	Switch    string `json:"switch"`
+	Port      int    `json:"port"`
+	MAC       string `json:"mac"`
+	VLAN      string `json:"vlan,omitempty"`
+	Host      string `json:"host,omitempty"`
+	Interface string `json:"interface,omitempty"`
+}
+
+type SwitchScanResponse struct {
+	Matched   []SwitchScanEntry `json:"matched"`
+	Unmatched []SwitchScanEntry `json:"unmatched"`
+	Changed   int               `json:"changed"`
+}
+
+func (h *Handler) SwitchScan(c fuego.ContextNoBody) (*SwitchScanResponse, error) {
+	ns, err := nodeset.NewNodeSet(c.PathParam("nodeset"))
+	if err != nil {
+		return nil, fuego.HTTPError{
+			Err:    err,
+			Title:  "Error",
+			Detail: "failed to create nodeset",
+		}
+	}
+
+	switchList, err := h.db(c.Context()).FindHosts(ns)
+	if err != nil {
+		return nil, fuego.HTTPError{
+			Err:    err,
+			Title:  "Error",
+			Detail: "failed to find switches",
+		}
+	}
+	if len(switchList) == 0 {
+		return nil, fuego.HTTPError{
+			Err:    errors.New("no switches found"),
+			Title:  "Error",
+			Detail: "failed to find switches",
+		}
+	}
+
+	// MAC addresses of hosts are also learned on uplink ports of upstream
+	// switches. When a MAC is seen on multiple ports we keep the port with the
+	// fewest learned MACs which should be the edge port the host is cabled to.
+	entries := make(map[string]SwitchScanEntry)
+	entryPortCount := make(map[string]int)
+	for _, swHost := range switchList {
+		netSwitch, err := tors.NewNetworkSwitch(swHost)
+		if err != nil {
+			return nil, fuego.HTTPError{
+				Err:    err,
+				Title:  "Error",
+				Detail: fmt.Sprintf("failed to connect to switch %s: %s", swHost.Name, err),
+			}
+		}
+
+		macTable, err := netSwitch.GetMACTable()
+		if err != nil {
+			return nil, fuego.HTTPError{
+				Err:    err,
+				Title:  "Error",
+				Detail: fmt.Sprintf("failed to retrieve mac address table from switch %s", swHost.Name),
+			}
+		}
+
+		portCount := make(map[int]int)
+		for _, entry := range macTable {
+			portCount[entry.Port]++
+		}
+
+		for _, entry := range macTable {
+			mac := entry.MAC.String()
+			if count, ok := entryPortCount[mac]; ok && portCount[entry.Port] >= count {
+				continue
+			}
+
+			entryPortCount[mac] = portCount[entry.Port]
+			entries[mac] = SwitchScanEntry{
+				Switch: swHost.Name,
+				Port:   entry.Port,
+				MAC:    mac,
+				VLAN:   entry.VLAN,
+			}
+		}
+	}
+
+	hostList, err := h.db(c.Context()).Hosts()
+	if err != nil {
+		return nil, fuego.HTTPError{
+			Err:    err,
+			Title:  "Error",
+			Detail: "failed to find nodes",
+		}
+	}
+
+	res := &SwitchScanResponse{
+		Matched:   make([]SwitchScanEntry, 0),
+		Unmatched: make([]SwitchScanEntry, 0),
+	}
+	changed := make(model.HostList, 0)
+	for _, host := range hostList {
+		hostChanged := false
+		for _, nic := range host.Interfaces {
+			if nic.MAC == nil {
+				continue
+			}
+			entry, ok := entries[nic.MAC.String()]
+			if !ok {
+				continue
+			}
+			delete(entries, nic.MAC.String())
+
+			entry.Host = host.Name
+			entry.Interface = nic.Name
+			res.Matched = append(res.Matched, entry)
+
+			if nic.Switch != entry.Switch || nic.Port != entry.Port {
+				nic.Switch = entry.Switch
+				nic.Port = entry.Port
+				hostChanged = true
+			}
+		}
+
+		if hostChanged {
+			changed = append(changed, host)
+		}
+	}
+
+	for _, entry := range entries {
+		res.Unmatched = append(res.Unmatched, entry)
+	}
+
+	sortScan := func(a, b SwitchScanEntry) int {
+		if a.Switch != b.Switch {
+			return strings.Compare(a.Switch, b.Switch)
+		}
+		if a.Port != b.Port {
+			return a.Port - b.Port
+		}
+		return strings.Compare(a.MAC, b.MAC)
+	}
+	slices.SortFunc(res.Matched, sortScan)
+	slices.SortFunc(res.Unmatched, sortScan)
+
+	if len(changed) > 0 {
+		err = h.db(c.Context()).StoreHosts(changed)
+		if err != nil {
+			return nil, fuego.HTTPError{
+				Err:    err,
+				Title:  "Error",
+				Detail: "failed to store switch port mapping",
+			}
+		}
+		h.writeEvent(c.Context(), "Success", fmt.Sprintf("Successfully updated switch port mapping on %d node(s)", len(changed)))
+	}
+	res.Changed = len(changed)
+
+	return res, nil
+}
+
+type SwitchVerifyEntry struct {
+	Switch    string `json:"switch"`
+	Port      int    `json:"port,omitempty"`
+	PortName  string `json:"port_name,omitempty"`
+	Host      string `json:"host,omitempty"`
+	Interface string `json:"interface,omitempty"`
+	MAC       string `json:"mac,omitempty"`
+	// Neighbor is the system name, or chassis ID, of the LLDP neighbor
+	Neighbor string `json:"neighbor,omitempty"`
+	// ExpectedSwitch and ExpectedPort are the stored mapping of an interface
+	// seen on another port
+	ExpectedSwitch string `json:"expected_switch,omitempty"`
+	ExpectedPort   int    `json:"expected_port,omitempty"`
+}
+
+type SwitchVerifyResponse struct {
+	Verified   []SwitchVerifyEntry `json:"verified"`
+	Mismatched []SwitchVerifyEntry `json:"mismatched"`
+	Missing    []SwitchVerifyEntry `json:"missing"`
+	Unknown    []SwitchVerifyEntry `json:"unknown"`
+	Changed    int                 `json:"changed"`
+}
+
+// SwitchVerify compares the LLDP neighbors of the switches with the switch
+// and port stored on node interfaces
+func (h *Handler) SwitchVerify(c fuego.ContextNoBody) (*SwitchVerifyResponse, error) {
+	res, _, err := h.switchVerify(c.Context(), c.PathParam("nodeset"))
+	return res, err
+}
+
+// SwitchVerifyUpdate compares the LLDP neighbors of the switches with the
+// switch and port stored on node interfaces, and stores the observed switch
+// and port of the interfaces seen on another port or without a mapping
+func (h *Handler) SwitchVerifyUpdate(c fuego.ContextNoBody) (*SwitchVerifyResponse, error) {
+	res, hostList, err := h.switchVerify(c.Context(), c.PathParam("nodeset"))
+	if err != nil {
+		return nil, err
+	}
+
+	hosts := make(map[string]*model.Host, len(hostList))
+	for _, host := range hostList {
+		hosts[host.Name] = host
+	}
+
+	changed := make(map[string]*model.Host)
+	for _, entries := range [][]SwitchVerifyEntry{res.Mismatched, res.Unknown} {
+		for _, entry := range entries {
+			host, ok := hosts[entry.Host]
+			if !ok || entry.Interface == "" || entry.Port == 0 {
+				continue
+			}
+			for _, nic := range host.Interfaces {
+				if verifyNicName(nic) != entry.Interface {
+					continue
+				}
+				nic.Switch = entry.Switch
+				nic.Port = entry.Port
+				changed[host.Name] = host
+			}
+		}
+	}
+
+	if len(changed) > 0 {
+		list := make(model.HostList, 0, len(changed))
+		for _, host := range changed {
+			list = append(list, host)
+		}
+		if err := h.db(c.Context()).StoreHosts(list); err != nil {
+			return nil, fuego.HTTPError{
+				Err:    err,
+				Title:  "Error",
+				Detail: "failed to store switch port mapping",
+			}
+		}
+		h.writeEvent(c.Context(), "Success", fmt.Sprintf("Successfully updated switch port mapping from LLDP on %d node(s)", len(changed)))
+	}
+	res.Changed = len(changed)
+
+	return res, nil
+}
+
+func (h *Handler) switchVerify(ctx context.Context, switchNodeset string) (*SwitchVerifyResponse, model.HostList, error) {
+	ns, err := nodeset.NewNodeSet(switchNodeset)
+	if err != nil {
+		return nil, nil, fuego.HTTPError{
+			Err:    err,
+			Title:  "Error",
+			Detail: "failed to create nodeset",
+		}
+	}
+
+	switchList, err := h.db(ctx).FindHosts(ns)
+	if err != nil {
+		return nil, nil, fuego.HTTPError{
+			Err:    err,
+			Title:  "Error",
+			Detail: "failed to find switches",
+		}
+	}
+	if len(switchList) == 0 {
+		return nil, nil, fuego.HTTPError{
+			Err:    errors.New("no switches found"),
+			Title:  "Error",
+			Detail: "failed to find switches",
+		}
+	}
+
+	neighbors := make(map[string]model.LLDPNeighbors, len(switchList))
+	for _, swHost := range switchList {
+		netSwitch, err := tors.NewNetworkSwitch(swHost)
+		if err != nil {
+			return nil, nil, fuego.HTTPError{
+				Err:    err,
+				Title:  "Error",
+				Detail: fmt.Sprintf("failed to connect to switch %s: %s", swHost.Name, err),
+			}
+		}
+
+		lldp, err := netSwitch.GetLLDPNeighbors()
+		if err != nil {
+			return nil, nil, fuego.HTTPError{
+				Err:    err,
+				Title:  "Error",
+				Detail: fmt.Sprintf("failed to retrieve lldp neighbors from switch %s", swHost.Name),
+			}
+		}
+		neighbors[swHost.Name] = lldp
+	}
+
+	hostList, err := h.db(ctx).Hosts()
+	if err != nil {
+		return nil, nil, fuego.HTTPError{
+			Err:    err,
+			Title:  "Error",
+			Detail: "failed to find nodes",
+		}
+	}
+
+	return verifyCabling(neighbors, hostList), hostList, nil
+}
+
+// verifyNicName returns the name of nic in verify results, its MAC address
+// when it has no name
+func verifyNicName(nic *model.NetInterface) string {
+	if nic.Name != "" {
+		return nic.Name
+	}
+
+	return nic.MAC.String()
+}
+
+type verifyNic struct {
+	host *model.Host
+	nic  *model.NetInterface
+}
+
+// verifyCabling compares the LLDP neighbors of each switch with the switch
+// and port stored on node interfaces. A neighbor is matched to an interface
+// by the MAC address in its port ID or chassis ID, or to a host by its
+// system name and then to the interface named by its port ID or description
+func verifyCabling(neighbors map[string]model.LLDPNeighbors, hostList model.HostList) *SwitchVerifyResponse {
+	res := &SwitchVerifyResponse{
+		Verified:   make([]SwitchVerifyEntry, 0),
+		Mismatched: make([]SwitchVerifyEntry, 0),
+		Missing:    make([]SwitchVerifyEntry, 0),
+		Unknown:    make([]SwitchVerifyEntry, 0),
+	}
+
+	byMAC := make(map[string]verifyNic)
+	byName := make(map[string]*model.Host)
+	for _, host := range hostList {
+		byName[strings.ToLower(host.Name)] = host
+		for _, nic := range host.Interfaces {
+			if nic.MAC != nil {
+				byMAC[nic.MAC.String()] = verifyNic{host, nic}
+			}
+			if nic.FQDN != "" {
+				if _, ok := byName[strings.ToLower(nic.HostName())]; !ok {
+					byName[strings.ToLower(nic.HostName())] = host
+				}
+			}
+		}
+	}
+
+	lookupMAC := func(s string) (verifyNic, bool) {
+		mac, err := net.ParseMAC(strings.TrimSpace(s))
+		if err != nil {
+			return verifyNic{}, false
+		}
+		v, ok := byMAC[mac.String()]
+		return v, ok
+	}
+
+	seen := make(map[*model.NetInterface]bool)
+	for sw, lldp := range neighbors {
+		for portName, n := range lldp {
+			if n == nil {
+				continue
+			}
+			if n.PortName != "" {
+				portName = n.PortName
+			}
+			port, _ := tors.PortNumber(portName)
+
+			entry := SwitchVerifyEntry{
+				Switch:   sw,
+				Port:     port,
+				PortName: portName,
+				Neighbor: n.SystemName,
+			}
+			if entry.Neighbor == "" {
+				entry.Neighbor = n.ChassisId
+			}
+
+			// The port ID is the MAC of the interface on most hosts, the
+			// chassis ID the MAC of one of its interfaces
+			match, ok := lookupMAC(n.PortId)
+			if !ok {
+				if match, ok = lookupMAC(n.ChassisId); ok {
+					match.nic = verifyNamedNic(match.host, match.nic, n)
+				}
+			}
+			if !ok && n.SystemName != "" {
+				name := strings.ToLower(n.SystemName)
+				host, found := byName[name]
+				if !found {
+					short, _, _ := strings.Cut(name, ".")
+					host, found = byName[short]
+				}
+				if found {
+					match, ok = verifyNic{host: host, nic: verifyNamedNic(host, nil, n)}, true
+				}
+			}
+
+			if !ok {
+				res.Unknown = append(res.Unknown, entry)
+				continue
+			}
+
+			entry.Host = match.host.Name
+			if match.nic == nil {
+				// The host is known but not which of its interfaces, it is
+				// cabled right if one of them is mapped to this port
+				for _, nic := range match.host.Interfaces {
+					if port != 0 && nic.Switch == sw && nic.Port == port {
+						match.nic = nic
+					}
+				}
+			}
+			if match.nic == nil {
+				res.Unknown = append(res.Unknown, entry)
+				continue
+			}
+
+			nic := match.nic
+			seen[nic] = true
+			entry.Interface = verifyNicName(nic)
+			if nic.MAC != nil {
+				entry.MAC = nic.MAC.String()
+			}
+
+			switch {
+			case nic.Switch == "" && nic.Port == 0:
+				res.Unknown = append(res.Unknown, entry)
+			case port != 0 && nic.Switch == sw && nic.Port == port:
+				res.Verified = append(res.Verified, entry)
+			default:
+				entry.ExpectedSwitch = nic.Switch
+				entry.ExpectedPort = nic.Port
+				res.Mismatched = append(res.Mismatched, entry)
+			}
+		}
+	}
+
+	// Interfaces mapped to the switches but not seen on any of them
+	for _, host := range hostList {
+		for _, nic := range host.Interfaces {
+			if _, ok := neighbors[nic.Switch]; !ok || nic.Port == 0 || seen[nic] {
+				continue
+			}
+			entry := SwitchVerifyEntry{
+				Switch:    nic.Switch,
+				Port:      nic.Port,
+				Host:      host.Name,
+				Interface: verifyNicName(nic),
+			}
+			if nic.MAC != nil {
+				entry.MAC = nic.MAC.String()
+			}
+			res.Missing = append(res.Missing, entry)
+		}
+	}
+
+	sortVerify := func(a, b SwitchVerifyEntry) int {
+		if a.Switch != b.Switch {
+			return strings.Compare(a.Switch, b.Switch)
+		}
+		if a.Port != b.Port {
+			return a.Port - b.Port
+		}
+		if a.PortName != b.PortName {
+			return strings.Compare(a.PortName, b.PortName)
+		}
+		return strings.Compare(a.Host+a.Interface, b.Host+b.Interface)
+	}
+	for _, list := range [][]SwitchVerifyEntry{res.Verified, res.Mismatched, res.Missing, res.Unknown} {
+		slices.SortFunc(list, sortVerify)
+	}
+
+	return res
+}
+
+// verifyNamedNic returns the interface of host named by the port ID or port
+// description of the neighbor, or nic
+func verifyNamedNic(host *model.Host, nic *model.NetInterface, n *model.LLDP) *model.NetInterface {
+	for _, name := range []string{n.PortId, n.PortDescription} {
+		if name == "" {
+			continue
+		}
+		for _, i := range host.Interfaces {
+			if i.Name == name {
+				return i
+			}
+		}
+	}
+
+	return nic
+}
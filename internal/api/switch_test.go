@@ -0,0 +1,72 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package api
+
+import (
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/ubccr/grendel/pkg/model"
+)
+
+func TestVerifyCabling(t *testing.T) {
+	nic := func(name, mac, sw string, port int) *model.NetInterface {
+		hwaddr, _ := net.ParseMAC(mac)
+		return &model.NetInterface{Name: name, MAC: hwaddr, Switch: sw, Port: port}
+	}
+
+	hosts := model.HostList{
+		{Name: "cpn-01", Interfaces: []*model.NetInterface{nic("eno1", "d0:94:66:00:00:01", "swe-01", 1)}},
+		{Name: "cpn-02", Interfaces: []*model.NetInterface{nic("eno1", "d0:94:66:00:00:02", "swe-01", 3)}},
+		{Name: "cpn-03", Interfaces: []*model.NetInterface{nic("eno1", "d0:94:66:00:00:03", "swe-01", 4)}},
+		{Name: "cpn-04", Interfaces: []*model.NetInterface{
+			nic("eno1", "d0:94:66:00:00:04", "", 0),
+			nic("eno2", "d0:94:66:00:00:14", "swe-01", 5),
+		}},
+		{Name: "cpn-05", Interfaces: []*model.NetInterface{nic("eno1", "d0:94:66:00:00:05", "", 0)}},
+		{Name: "cpn-06", Interfaces: []*model.NetInterface{nic("eno1", "d0:94:66:00:00:06", "swe-02", 1)}},
+	}
+
+	neighbors := map[string]model.LLDPNeighbors{
+		"swe-01": {
+			// Port ID is the MAC of the interface
+			"Ethernet1": {PortName: "Ethernet1", PortId: "d0:94:66:00:00:01", PortIdType: "mac"},
+			// cpn-02 and cpn-03 swapped
+			"Ethernet3": {PortName: "Ethernet3", PortId: "D0-94-66-00-00-03"},
+			"Ethernet4": {PortName: "Ethernet4", ChassisId: "d0:94:66:00:00:02", PortId: "eno1"},
+			// Identified by system name and the port description
+			"Ethernet5": {PortName: "Ethernet5", SystemName: "cpn-04.example.com", PortDescription: "eno2"},
+			// Not mapped yet
+			"Ethernet6": {PortName: "Ethernet6", PortId: "d0:94:66:00:00:05"},
+			// Another switch
+			"Ethernet48": {PortName: "Ethernet48", SystemName: "spine-01", ChassisId: "aa:bb:cc:00:00:01"},
+		},
+	}
+
+	res := verifyCabling(neighbors, hosts)
+
+	assert.Equal(t, []SwitchVerifyEntry{
+		{Switch: "swe-01", Port: 1, PortName: "Ethernet1", Host: "cpn-01", Interface: "eno1", MAC: "d0:94:66:00:00:01"},
+		{Switch: "swe-01", Port: 5, PortName: "Ethernet5", Host: "cpn-04", Interface: "eno2", MAC: "d0:94:66:00:00:14", Neighbor: "cpn-04.example.com"},
+	}, res.Verified)
+	assert.Equal(t, []SwitchVerifyEntry{
+		{Switch: "swe-01", Port: 3, PortName: "Ethernet3", Host: "cpn-03", Interface: "eno1", MAC: "d0:94:66:00:00:03", ExpectedSwitch: "swe-01", ExpectedPort: 4},
+		{Switch: "swe-01", Port: 4, PortName: "Ethernet4", Host: "cpn-02", Interface: "eno1", MAC: "d0:94:66:00:00:02", Neighbor: "d0:94:66:00:00:02", ExpectedSwitch: "swe-01", ExpectedPort: 3},
+	}, res.Mismatched)
+	assert.Equal(t, []SwitchVerifyEntry{
+		{Switch: "swe-01", Port: 6, PortName: "Ethernet6", Host: "cpn-05", Interface: "eno1", MAC: "d0:94:66:00:00:05"},
+		{Switch: "swe-01", Port: 48, PortName: "Ethernet48", Neighbor: "spine-01"},
+	}, res.Unknown)
+	// cpn-06 is mapped to a switch that was not verified
+	assert.Empty(t, res.Missing)
+
+	// An interface mapped to the switch but not seen
+	delete(neighbors["swe-01"], "Ethernet1")
+	res = verifyCabling(neighbors, hosts)
+	assert.Equal(t, []SwitchVerifyEntry{
+		{Switch: "swe-01", Port: 1, Host: "cpn-01", Interface: "eno1", MAC: "d0:94:66:00:00:01"},
+	}, res.Missing)
+}
@@ -32,7 +32,7 @@ type UserEnableRequest struct {
 }
 
 func (h *Handler) UserList(c fuego.ContextNoBody) ([]model.User, error) {
-	users, err := h.DB.GetUsers()
+	users, err := h.db(c.Context()).GetUsers()
 	if err != nil {
 		return nil, fuego.HTTPError{
 			Err:    err,
@@ -59,7 +59,7 @@ func (h *Handler) UserDelete(c fuego.ContextNoBody) (*GenericResponse, error) {
 	users := strings.Split(c.PathParam("usernames"), ",")
 
 	for _, user := range users {
-		err := h.DB.DeleteUser(user)
+		err := h.db(c.Context()).DeleteUser(user)
 		if err != nil {
 			return nil, fuego.HTTPError{
 				Err:    err,
@@ -91,7 +91,7 @@ func (h *Handler) UserRole(c fuego.ContextWithBody[UserRoleRequest]) (*GenericRe
 	users := strings.Split(c.PathParam("usernames"), ",")
 
 	for _, user := range users {
-		err := h.DB.UpdateUserRole(user, body.Role)
+		err := h.db(c.Context()).UpdateUserRole(user, body.Role)
 		if err != nil {
 			return nil, fuego.HTTPError{
 				Err:    err,
@@ -123,7 +123,7 @@ func (h *Handler) UserEnable(c fuego.ContextWithBody[UserEnableRequest]) (*Gener
 	users := strings.Split(c.PathParam("usernames"), ",")
 
 	for _, user := range users {
-		err := h.DB.UpdateUserEnabled(user, body.Enabled)
+		err := h.db(c.Context()).UpdateUserEnabled(user, body.Enabled)
 		if err != nil {
 			return nil, fuego.HTTPError{
 				Err:    err,
@@ -152,7 +152,7 @@ func (h *Handler) UserStore(c fuego.ContextWithBody[UserStoreRequest]) (*UserSto
 		}
 	}
 
-	role, err := h.DB.StoreUser(body.Username, body.Password)
+	role, err := h.db(c.Context()).StoreUser(body.Username, body.Password)
 	if err != nil {
 		return nil, fuego.HTTPError{
 			Err:    err,
@@ -0,0 +1,146 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/go-fuego/fuego"
+)
+
+var jsonPointerEscaper = strings.NewReplacer("~", "~0", "/", "~1")
+
+// validateMiddleware validates JSON request bodies against the request body
+// schema of the matching operation in doc. Invalid requests are rejected with
+// a 400 listing the JSON pointer of each offending field.
+func validateMiddleware(doc *openapi3.T) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			schema := requestBodySchema(doc, r)
+			if schema == nil || r.Body == nil || r.Body == http.NoBody {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			data, err := io.ReadAll(r.Body)
+			r.Body.Close()
+			if err != nil {
+				ErrorSerializer(w, r, fuego.HTTPError{
+					Status: http.StatusBadRequest,
+					Err:    err,
+					Title:  "Error",
+					Detail: "failed to read request body",
+				})
+				return
+			}
+			r.Body = io.NopCloser(bytes.NewReader(data))
+
+			if len(bytes.TrimSpace(data)) == 0 {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			var value any
+			if err := json.Unmarshal(data, &value); err != nil {
+				ErrorSerializer(w, r, fuego.HTTPError{
+					Status: http.StatusBadRequest,
+					Err:    err,
+					Title:  "Error",
+					Detail: fmt.Sprintf("failed to parse request body: %s", err),
+				})
+				return
+			}
+
+			err = schema.VisitJSON(value, openapi3.VisitAsRequest(), openapi3.MultiErrors())
+			if err != nil {
+				items := schemaErrorItems(err)
+				detail := "request body failed validation"
+				if len(items) > 0 && items[0].Name != "" {
+					detail = fmt.Sprintf("invalid request body at %s: %s", items[0].Name, items[0].Reason)
+				} else if len(items) > 0 {
+					detail = fmt.Sprintf("invalid request body: %s", items[0].Reason)
+				}
+				log.Debugf("request validation failed method=%s path=%s err=%s", r.Method, r.URL.Path, err)
+				ErrorSerializer(w, r, fuego.HTTPError{
+					Status: http.StatusBadRequest,
+					Err:    err,
+					Title:  "Error",
+					Detail: detail,
+					Errors: items,
+				})
+				return
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// requestBodySchema returns the JSON request body schema of the operation
+// matching the route pattern of r or nil if the operation does not accept a
+// JSON body
+func requestBodySchema(doc *openapi3.T, r *http.Request) *openapi3.Schema {
+	_, path, found := strings.Cut(r.Pattern, " ")
+	if !found || doc == nil || doc.Paths == nil {
+		return nil
+	}
+
+	item := doc.Paths.Value(path)
+	if item == nil {
+		return nil
+	}
+
+	op := item.GetOperation(r.Method)
+	if op == nil || op.RequestBody == nil || op.RequestBody.Value == nil {
+		return nil
+	}
+
+	content := op.RequestBody.Value.Content.Get("application/json")
+	if content == nil || content.Schema == nil {
+		return nil
+	}
+
+	return content.Schema.Value
+}
+
+// schemaErrorItems flattens the errors returned from validating a schema into
+// a list of errors named by the JSON pointer of the offending field
+func schemaErrorItems(err error) []fuego.ErrorItem {
+	var multi openapi3.MultiError
+	if errors.As(err, &multi) {
+		items := make([]fuego.ErrorItem, 0, len(multi))
+		for _, e := range multi {
+			items = append(items, schemaErrorItems(e)...)
+		}
+		return items
+	}
+
+	var schemaErr *openapi3.SchemaError
+	if errors.As(err, &schemaErr) {
+		return []fuego.ErrorItem{{
+			Name:   jsonPointer(schemaErr.JSONPointer()),
+			Reason: schemaErr.Reason,
+		}}
+	}
+
+	return []fuego.ErrorItem{{Name: "", Reason: err.Error()}}
+}
+
+func jsonPointer(tokens []string) string {
+	var sb strings.Builder
+	for _, t := range tokens {
+		sb.WriteString("/")
+		sb.WriteString(jsonPointerEscaper.Replace(t))
+	}
+
+	return sb.String()
+}
@@ -0,0 +1,331 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+// Package artifact renders the files derived from the hosts served by the API
+// at /v1/artifacts/{name}, such as /etc/hosts or genders, for the agents on
+// the nodes keeping them in sync. Artifacts are defined in api.artifacts with
+// an export format and filters. The ETag of an artifact is the latest change
+// journal sequence number, so an agent polling with If-None-Match gets a 304
+// until a change is made
+package artifact
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/spf13/viper"
+	"github.com/ubccr/grendel/pkg/model"
+	"github.com/ubccr/grendel/pkg/nodeset"
+)
+
+// Export formats of the artifacts
+const (
+	// FormatHosts is an /etc/hosts file with a "# host <name>" comment
+	// before the lines of each host, the blocks replaced by a delta
+	FormatHosts = "hosts"
+
+	// FormatGenders is a genders file, a line per host
+	FormatGenders = "genders"
+
+	// FormatKnownHosts is an ssh_known_hosts file trusting cert_authority
+	// for the names and addresses of each host
+	FormatKnownHosts = "known-hosts"
+)
+
+// Formats are the export formats of the artifacts
+var Formats = []string{FormatHosts, FormatGenders, FormatKnownHosts}
+
+// Config is an artifact of the api.artifacts config
+type Config struct {
+	Name   string `mapstructure:"name"`
+	Format string `mapstructure:"format"`
+
+	// Nodeset and Tags restrict the artifact to these hosts and to hosts
+	// with any of these tags, hosts with any of ExcludeTags are left out
+	Nodeset     string   `mapstructure:"nodeset"`
+	Tags        []string `mapstructure:"tags"`
+	ExcludeTags []string `mapstructure:"exclude_tags"`
+
+	// CertAuthority is the public key of the SSH certificate authority
+	// signing the host keys, trusted by the known-hosts format
+	CertAuthority string `mapstructure:"cert_authority"`
+}
+
+// Artifact is a compiled artifact
+type Artifact struct {
+	Name          string
+	Format        string
+	Nodeset       string
+	Tags          []string
+	ExcludeTags   []string
+	CertAuthority string
+
+	nodes map[string]bool
+
+	// version changes with the definition of the artifact, so a reload
+	// changing it changes the ETag
+	version string
+}
+
+// Builtin returns the artifacts served without configuration, hosts and
+// genders with every host
+func Builtin() []*Artifact {
+	artifacts := make([]*Artifact, 0, 2)
+	for _, format := range []string{FormatHosts, FormatGenders} {
+		a, _ := compile(Config{Name: format, Format: format})
+		artifacts = append(artifacts, a)
+	}
+
+	return artifacts
+}
+
+// LoadConfig compiles the artifacts of api.artifacts, following the builtin
+// artifacts not redefined
+func LoadConfig(v *viper.Viper) ([]*Artifact, error) {
+	var configs []Config
+	if err := v.UnmarshalKey("api.artifacts", &configs); err != nil {
+		return nil, fmt.Errorf("failed parsing api.artifacts: %w", err)
+	}
+
+	artifacts := make([]*Artifact, 0, len(configs)+2)
+	for _, c := range configs {
+		if slices.ContainsFunc(artifacts, func(a *Artifact) bool { return a.Name == c.Name }) {
+			return nil, fmt.Errorf("failed parsing api.artifacts: artifact %s defined twice", c.Name)
+		}
+
+		a, err := compile(c)
+		if err != nil {
+			return nil, fmt.Errorf("failed parsing api.artifacts: %w", err)
+		}
+		artifacts = append(artifacts, a)
+	}
+
+	for _, a := range Builtin() {
+		if !slices.ContainsFunc(artifacts, func(b *Artifact) bool { return b.Name == a.Name }) {
+			artifacts = append(artifacts, a)
+		}
+	}
+
+	return artifacts, nil
+}
+
+func compile(c Config) (*Artifact, error) {
+	if c.Name == "" {
+		return nil, errors.New("artifact without a name")
+	}
+	if strings.ContainsAny(c.Name, "/?#% ") {
+		return nil, fmt.Errorf("%s: invalid name, it is part of the URL", c.Name)
+	}
+	if !slices.Contains(Formats, c.Format) {
+		return nil, fmt.Errorf("%s: invalid format %q, one of %s", c.Name, c.Format, strings.Join(Formats, ", "))
+	}
+	if (c.Format == FormatKnownHosts) != (c.CertAuthority != "") {
+		return nil, fmt.Errorf("%s: cert_authority is required by and only used with the %s format", c.Name, FormatKnownHosts)
+	}
+
+	a := &Artifact{
+		Name:          c.Name,
+		Format:        c.Format,
+		Nodeset:       c.Nodeset,
+		Tags:          c.Tags,
+		ExcludeTags:   c.ExcludeTags,
+		CertAuthority: strings.TrimSpace(c.CertAuthority),
+	}
+
+	if c.Nodeset != "" {
+		ns, err := nodeset.NewNodeSet(c.Nodeset)
+		if err != nil {
+			return nil, fmt.Errorf("%s: invalid nodeset %q: %w", c.Name, c.Nodeset, err)
+		}
+		a.nodes = make(map[string]bool, ns.Len())
+		for _, name := range ns.Iterator().StringSlice() {
+			a.nodes[name] = true
+		}
+	}
+
+	sum := sha256.Sum256(fmt.Appendf(nil, "%q %q %q %q %q %q", a.Name, a.Format, a.Nodeset, a.Tags, a.ExcludeTags, a.CertAuthority))
+	a.version = hex.EncodeToString(sum[:4])
+
+	return a, nil
+}
+
+// Match returns whether host is included in the artifact
+func (a *Artifact) Match(host *model.Host) bool {
+	if a.nodes != nil && !a.nodes[host.Name] {
+		return false
+	}
+	if len(a.Tags) > 0 && !host.HasAnyTags(a.Tags...) {
+		return false
+	}
+	if len(a.ExcludeTags) > 0 && host.HasAnyTags(a.ExcludeTags...) {
+		return false
+	}
+
+	return true
+}
+
+// Delta returns whether the artifact is served as the changes since a
+// revision
+func (a *Artifact) Delta() bool {
+	return a.Format == FormatHosts
+}
+
+// ETag returns the strong entity tag of the artifact at revision rev of the
+// change journal of namespace ns. The namespaces have journals of their own,
+// so the tag includes it
+func (a *Artifact) ETag(ns string, rev int64) string {
+	return `"` + ns + "-" + strconv.FormatInt(rev, 10) + "-" + a.version + `"`
+}
+
+// Write writes the artifact with the hosts it includes, sorted by name
+func (a *Artifact) Write(w io.Writer, hosts model.HostList) error {
+	for _, host := range a.sorted(hosts) {
+		if !a.Match(host) {
+			continue
+		}
+
+		lines := a.lines(host)
+		if len(lines) == 0 {
+			continue
+		}
+		if a.Format == FormatHosts {
+			lines = slices.Insert(lines, 0, "# host "+host.Name)
+		}
+		if _, err := io.WriteString(w, strings.Join(lines, "\n")+"\n"); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// WriteDelta writes the "# host <name>" block of each of the hosts named
+// changed, with its lines in hosts. The block of a host deleted, renamed or no
+// longer included is empty, the agent removes its lines
+func (a *Artifact) WriteDelta(w io.Writer, changed []string, hosts model.HostList) error {
+	if !a.Delta() {
+		return fmt.Errorf("artifact %s of format %s has no delta form", a.Name, a.Format)
+	}
+
+	byName := make(map[string]*model.Host, len(hosts))
+	for _, host := range hosts {
+		byName[host.Name] = host
+	}
+
+	names := slices.Clone(changed)
+	sort.Strings(names)
+	for _, name := range slices.Compact(names) {
+		lines := []string{"# host " + name}
+		if host, ok := byName[name]; ok && a.Match(host) {
+			lines = append(lines, a.lines(host)...)
+		}
+		if _, err := io.WriteString(w, strings.Join(lines, "\n")+"\n"); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (a *Artifact) sorted(hosts model.HostList) model.HostList {
+	sorted := slices.Clone(hosts)
+	sort.SliceStable(sorted, func(i, j int) bool { return sorted[i].Name < sorted[j].Name })
+
+	return sorted
+}
+
+// lines returns the lines of host in the artifact
+func (a *Artifact) lines(host *model.Host) []string {
+	switch a.Format {
+	case FormatHosts:
+		return host.HostsFileLines()
+	case FormatGenders:
+		return []string{host.GendersLine()}
+	case FormatKnownHosts:
+		if patterns := knownHostsPatterns(host); len(patterns) > 0 {
+			return []string{"@cert-authority " + strings.Join(patterns, ",") + " " + a.CertAuthority}
+		}
+	}
+
+	return nil
+}
+
+// knownHostsPatterns returns the names and addresses of the interfaces and
+// bonds of host other than its BMC
+func knownHostsPatterns(host *model.Host) []string {
+	nics := make([]*model.NetInterface, 0, len(host.Interfaces)+len(host.Bonds))
+	nics = append(nics, host.Interfaces...)
+	for _, bond := range host.Bonds {
+		nics = append(nics, &bond.NetInterface)
+	}
+
+	patterns := []string{host.Name}
+	add := func(p string) {
+		if p != "" && !slices.Contains(patterns, p) {
+			patterns = append(patterns, p)
+		}
+	}
+	for _, nic := range nics {
+		if nic.BMC {
+			continue
+		}
+		for _, name := range strings.Split(nic.FQDN, ",") {
+			add(name)
+		}
+		if nic.IP.IsValid() {
+			add(nic.AddrString())
+		}
+	}
+
+	return patterns
+}
+
+// Registry holds the artifacts served, replaced on reload
+type Registry struct {
+	mu        sync.RWMutex
+	artifacts []*Artifact
+}
+
+// NewRegistry returns a registry serving artifacts
+func NewRegistry(artifacts []*Artifact) *Registry {
+	return &Registry{artifacts: artifacts}
+}
+
+// Configure replaces the artifacts of r
+func (r *Registry) Configure(artifacts []*Artifact) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.artifacts = artifacts
+}
+
+// Get returns the artifact named name
+func (r *Registry) Get(name string) (*Artifact, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	for _, a := range r.artifacts {
+		if a.Name == name {
+			return a, true
+		}
+	}
+
+	return nil, false
+}
+
+// List returns the artifacts of r
+func (r *Registry) List() []*Artifact {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return slices.Clone(r.artifacts)
+}
@@ -0,0 +1,140 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package artifact
+
+import (
+	"bytes"
+	"net/netip"
+	"testing"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/ubccr/grendel/pkg/model"
+)
+
+func loadConfig(t *testing.T, toml string) ([]*Artifact, error) {
+	v := viper.New()
+	v.SetConfigType("toml")
+	require.NoError(t, v.ReadConfig(bytes.NewBufferString(toml)))
+
+	return LoadConfig(v)
+}
+
+func testHosts() model.HostList {
+	return model.HostList{
+		{
+			Name:      "cpn-02",
+			BootImage: "rocky9",
+			Tags:      []string{"compute", "rack=a01"},
+			Interfaces: []*model.NetInterface{
+				{Name: "eno1", IP: netip.MustParsePrefix("10.0.0.2/24"), FQDN: "cpn-02.example.com"},
+				{Name: "idrac", IP: netip.MustParsePrefix("10.0.1.2/24"), FQDN: "bmc-cpn-02.example.com", BMC: true},
+			},
+		},
+		{
+			Name:       "cpn-01",
+			Tags:       []string{"compute", "noagent"},
+			Interfaces: []*model.NetInterface{{Name: "eno1", IP: netip.MustParsePrefix("10.0.0.1/24"), FQDN: "cpn-01.example.com"}},
+		},
+		{Name: "srv-01"},
+	}
+}
+
+func TestLoadConfig(t *testing.T) {
+	artifacts, err := loadConfig(t, `
+[[api.artifacts]]
+name = "hosts"
+format = "hosts"
+tags = ["compute"]
+
+[[api.artifacts]]
+name = "ssh_known_hosts"
+format = "known-hosts"
+nodeset = "cpn-[01-02]"
+exclude_tags = ["noagent"]
+cert_authority = "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIGrendel ca@example.com"
+`)
+	require.NoError(t, err)
+	require.Len(t, artifacts, 3)
+
+	// The builtin genders follows
+	assert.Equal(t, "hosts", artifacts[0].Name)
+	assert.Equal(t, []string{"compute"}, artifacts[0].Tags)
+	assert.Equal(t, "genders", artifacts[2].Name)
+
+	hosts := testHosts()
+	assert.True(t, artifacts[1].Match(hosts[0]))
+	assert.False(t, artifacts[1].Match(hosts[1]))
+	assert.False(t, artifacts[1].Match(hosts[2]))
+
+	// The ETag changes with the revision and the definition
+	assert.Equal(t, artifacts[0].ETag("default", 12), artifacts[0].ETag("default", 12))
+	assert.NotEqual(t, artifacts[0].ETag("default", 12), artifacts[0].ETag("default", 13))
+	assert.NotEqual(t, artifacts[0].ETag("default", 12), artifacts[0].ETag("a", 12))
+	assert.NotEqual(t, artifacts[0].ETag("default", 12), Builtin()[0].ETag("default", 12))
+
+	for name, toml := range map[string]string{
+		"no name":       `[[api.artifacts]]` + "\n" + `format = "hosts"`,
+		"bad name":      `[[api.artifacts]]` + "\n" + `name = "a/b"` + "\n" + `format = "hosts"`,
+		"bad format":    `[[api.artifacts]]` + "\n" + `name = "a"` + "\n" + `format = "csv"`,
+		"no ca":         `[[api.artifacts]]` + "\n" + `name = "a"` + "\n" + `format = "known-hosts"`,
+		"ca not used":   `[[api.artifacts]]` + "\n" + `name = "a"` + "\n" + `format = "hosts"` + "\n" + `cert_authority = "ssh-ed25519 AAAA"`,
+		"bad nodeset":   `[[api.artifacts]]` + "\n" + `name = "a"` + "\n" + `format = "hosts"` + "\n" + `nodeset = "cpn-[01"`,
+		"defined twice": `[[api.artifacts]]` + "\n" + `name = "a"` + "\n" + `format = "hosts"` + "\n" + `[[api.artifacts]]` + "\n" + `name = "a"` + "\n" + `format = "genders"`,
+	} {
+		_, err := loadConfig(t, toml)
+		assert.Error(t, err, name)
+	}
+}
+
+func TestWrite(t *testing.T) {
+	artifacts, err := loadConfig(t, `
+[[api.artifacts]]
+name = "ssh_known_hosts"
+format = "known-hosts"
+cert_authority = "ssh-ed25519 AAAA ca"
+`)
+	require.NoError(t, err)
+	a := map[string]*Artifact{}
+	for _, art := range artifacts {
+		a[art.Name] = art
+	}
+
+	var b bytes.Buffer
+	require.NoError(t, a["hosts"].Write(&b, testHosts()))
+	assert.Equal(t, `# host cpn-01
+10.0.0.1 cpn-01.example.com cpn-01
+# host cpn-02
+10.0.0.2 cpn-02.example.com cpn-02
+10.0.1.2 bmc-cpn-02.example.com bmc-cpn-02
+`, b.String())
+
+	b.Reset()
+	require.NoError(t, a["genders"].Write(&b, testHosts()))
+	assert.Equal(t, `cpn-01 compute,noagent
+cpn-02 bootimage=rocky9,compute,rack=a01
+srv-01
+`, b.String())
+
+	b.Reset()
+	require.NoError(t, a["ssh_known_hosts"].Write(&b, testHosts()))
+	assert.Equal(t, `@cert-authority cpn-01,cpn-01.example.com,10.0.0.1 ssh-ed25519 AAAA ca
+@cert-authority cpn-02,cpn-02.example.com,10.0.0.2 ssh-ed25519 AAAA ca
+@cert-authority srv-01 ssh-ed25519 AAAA ca
+`, b.String())
+
+	// cpn-03 was deleted, srv-01 has no address
+	b.Reset()
+	require.NoError(t, a["hosts"].WriteDelta(&b, []string{"cpn-03", "cpn-02", "srv-01", "cpn-02"}, testHosts()[0:1]))
+	assert.Equal(t, `# host cpn-02
+10.0.0.2 cpn-02.example.com cpn-02
+10.0.1.2 bmc-cpn-02.example.com bmc-cpn-02
+# host cpn-03
+# host srv-01
+`, b.String())
+
+	assert.Error(t, a["genders"].WriteDelta(&b, nil, nil))
+}
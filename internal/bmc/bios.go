@@ -0,0 +1,333 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package bmc
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"path"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/viper"
+	"github.com/stmcginnis/gofish/schemas"
+	"github.com/ubccr/grendel/internal/config"
+	"github.com/ubccr/grendel/pkg/model"
+)
+
+var ErrUnknownBiosProfile = errors.New("unknown BIOS profile")
+
+// biosProfile is a named set of BIOS attributes in the bmc.bios_profiles
+// config
+type biosProfile struct {
+	Name       string
+	Attributes []string
+}
+
+// attributes parses the "Name=Value" attributes of the profile
+func (p biosProfile) attributes() (map[string]string, error) {
+	attrs := make(map[string]string, len(p.Attributes))
+	for _, a := range p.Attributes {
+		k, v, ok := strings.Cut(a, "=")
+		k = strings.TrimSpace(k)
+		if !ok || k == "" {
+			return nil, fmt.Errorf("invalid attribute %q in BIOS profile %s, expected Name=Value", a, p.Name)
+		}
+		attrs[k] = strings.TrimSpace(v)
+	}
+
+	return attrs, nil
+}
+
+// LoadBiosProfile returns the attributes of the BIOS profile name in the
+// bmc.bios_profiles config. Attributes are listed as "Name=Value" strings
+// since config keys are not case sensitive but BIOS attribute names are.
+func LoadBiosProfile(name string) (map[string]string, error) {
+	var profiles []biosProfile
+	err := config.Viper().UnmarshalKey("bmc.bios_profiles", &profiles)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, p := range profiles {
+		if p.Name == name {
+			return p.attributes()
+		}
+	}
+
+	return nil, fmt.Errorf("%w: %s", ErrUnknownBiosProfile, name)
+}
+
+// CheckBiosProfiles returns an error if any BIOS profile in the
+// bmc.bios_profiles config of v is invalid
+func CheckBiosProfiles(v *viper.Viper) error {
+	var profiles []biosProfile
+	err := v.UnmarshalKey("bmc.bios_profiles", &profiles)
+	if err != nil {
+		return fmt.Errorf("failed parsing bmc.bios_profiles: %w", err)
+	}
+
+	for _, p := range profiles {
+		if p.Name == "" {
+			return errors.New("failed parsing bmc.bios_profiles: profile without a name")
+		}
+		if _, err := p.attributes(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// biosState is the current BIOS attributes of a system and the attributes
+// set in its settings resource, which apply on the next reboot
+type biosState struct {
+	current     schemas.SettingsAttributes
+	pending     schemas.SettingsAttributes
+	settingsURI string
+	applyTimes  []schemas.SettingsApplyTime
+}
+
+func (r *Redfish) biosState() (*biosState, error) {
+	ss, err := r.service.Systems()
+	if err != nil {
+		return nil, err
+	}
+
+	if len(ss) == 0 {
+		return nil, errors.New("failed to find system")
+	}
+
+	bios, err := ss[0].Bios()
+	if err != nil {
+		return nil, err
+	}
+
+	// gofish does not export the settings of the Bios resource
+	var settings struct {
+		Settings schemas.Settings `json:"@Redfish.Settings"`
+	}
+	err = r.getJSON(bios.ODataID, &settings)
+	if err != nil {
+		return nil, err
+	}
+
+	state := &biosState{
+		current:     bios.Attributes,
+		pending:     schemas.SettingsAttributes{},
+		settingsURI: settings.Settings.SettingsObject,
+		applyTimes:  settings.Settings.SupportedApplyTimes,
+	}
+	if state.settingsURI == "" {
+		state.settingsURI = bios.ODataID
+		return state, nil
+	}
+
+	var pending struct {
+		Attributes schemas.SettingsAttributes
+	}
+	err = r.getJSON(state.settingsURI, &pending)
+	if err != nil {
+		return nil, err
+	}
+	if pending.Attributes != nil {
+		state.pending = pending.Attributes
+	}
+
+	return state, nil
+}
+
+func (r *Redfish) getJSON(uri string, v any) error {
+	resp, err := r.client.Get(uri)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	return json.NewDecoder(resp.Body).Decode(v)
+}
+
+// GetBios returns the current BIOS attributes of the system
+func (r *Redfish) GetBios() (map[string]string, error) {
+	state, err := r.biosState()
+	if err != nil {
+		return nil, err
+	}
+
+	attrs := make(map[string]string, len(state.current))
+	for k, v := range state.current {
+		attrs[k] = biosString(v)
+	}
+
+	return attrs, nil
+}
+
+// DiffBios compares the current BIOS attributes of the system with profile.
+// A reboot is required when profile values are pending.
+func (r *Redfish) DiffBios(profile map[string]string) (*model.BiosReport, error) {
+	state, err := r.biosState()
+	if err != nil {
+		return nil, err
+	}
+
+	report := &model.BiosReport{
+		Deviations: biosDeviations(profile, state.current, state.pending),
+	}
+	pending := 0
+	for _, d := range report.Deviations {
+		if d.Pending {
+			pending++
+		}
+	}
+	report.RebootRequired = pending > 0
+
+	switch {
+	case len(report.Deviations) == 0:
+		report.Msg = "BIOS matches profile"
+	case pending > 0:
+		report.Msg = fmt.Sprintf("%d attribute(s) differ from profile, %d pending reboot", len(report.Deviations), pending)
+	default:
+		report.Msg = fmt.Sprintf("%d attribute(s) differ from profile", len(report.Deviations))
+	}
+
+	return report, nil
+}
+
+// ApplyBios sets the BIOS attributes of the system which differ from profile
+// in the BIOS settings resource. The settings apply on the next reboot, which
+// is requested with the OnReset apply time where supported so BMCs such as
+// the iDRAC create a pending settings job. Attributes not supported by the
+// system fail the whole profile.
+func (r *Redfish) ApplyBios(profile map[string]string) (*model.BiosReport, error) {
+	state, err := r.biosState()
+	if err != nil {
+		return nil, err
+	}
+
+	report := &model.BiosReport{
+		Deviations: biosDeviations(profile, state.current, state.pending),
+	}
+	report.RebootRequired = len(report.Deviations) > 0
+
+	attrs := schemas.SettingsAttributes{}
+	for _, d := range report.Deviations {
+		cur, ok := state.current[d.Attribute]
+		if !ok {
+			return nil, fmt.Errorf("BIOS attribute %s is not supported by the system", d.Attribute)
+		}
+		if d.Pending {
+			continue
+		}
+
+		v, err := biosValue(d.Profile, cur)
+		if err != nil {
+			return nil, fmt.Errorf("invalid value for BIOS attribute %s: %w", d.Attribute, err)
+		}
+		attrs[d.Attribute] = v
+	}
+
+	if len(attrs) == 0 {
+		report.Msg = "BIOS matches profile"
+		if report.RebootRequired {
+			report.Msg = fmt.Sprintf("%d attribute(s) already pending reboot", len(report.Deviations))
+		}
+		return report, nil
+	}
+
+	payload := map[string]any{"Attributes": attrs}
+	if slices.Contains(state.applyTimes, schemas.OnResetSettingsApplyTime) {
+		payload["@Redfish.SettingsApplyTime"] = map[string]string{"ApplyTime": string(schemas.OnResetSettingsApplyTime)}
+	}
+
+	resp, err := r.client.Patch(state.settingsURI, payload)
+	if err != nil {
+		return nil, err
+	}
+	resp.Body.Close()
+
+	for i := range report.Deviations {
+		report.Deviations[i].Pending = true
+	}
+	report.Msg = fmt.Sprintf("Set %d attribute(s) to apply on reboot", len(attrs))
+
+	// The iDRAC returns the pending settings job in the location header
+	loc := resp.Header.Get("Location")
+	if loc == "" {
+		return report, nil
+	}
+
+	report.JobID = path.Base(loc)
+	job, err := r.GetJobInfo(report.JobID)
+	if err != nil {
+		// Not every BMC lists settings jobs in the job service
+		return report, nil
+	}
+
+	switch job.JobState {
+	case schemas.ExceptionJobState, schemas.CancelledJobState, schemas.InterruptedJobState:
+		msgs := make([]string, 0, len(job.Messages))
+		for _, m := range job.Messages {
+			msgs = append(msgs, m.Message)
+		}
+		return nil, fmt.Errorf("job %s %s: %s", job.ID, job.JobState, strings.Join(msgs, "; "))
+	}
+	report.Msg += fmt.Sprintf(", job %s %s", job.ID, job.JobState)
+
+	return report, nil
+}
+
+// biosDeviations returns the attributes of profile whose current value
+// differs, sorted by attribute. Attributes missing from the system have an
+// empty current value.
+func biosDeviations(profile map[string]string, current, pending schemas.SettingsAttributes) []model.BiosDeviation {
+	deviations := make([]model.BiosDeviation, 0)
+	for attr, want := range profile {
+		cur, ok := current[attr]
+		if ok && biosString(cur) == want {
+			continue
+		}
+
+		d := model.BiosDeviation{Attribute: attr, Profile: want}
+		if ok {
+			d.Current = biosString(cur)
+		}
+		if p, ok := pending[attr]; ok && biosString(p) == want {
+			d.Pending = true
+		}
+		deviations = append(deviations, d)
+	}
+
+	slices.SortFunc(deviations, func(a, b model.BiosDeviation) int {
+		return strings.Compare(a.Attribute, b.Attribute)
+	})
+
+	return deviations
+}
+
+// biosValue converts the profile value v to the JSON type of the current
+// value of the attribute
+func biosValue(v string, current any) (any, error) {
+	switch current.(type) {
+	case float64:
+		return strconv.ParseFloat(v, 64)
+	case bool:
+		return strconv.ParseBool(v)
+	}
+
+	return v, nil
+}
+
+func biosString(v any) string {
+	switch t := v.(type) {
+	case nil:
+		return ""
+	case float64:
+		return strconv.FormatFloat(t, 'f', -1, 64)
+	}
+
+	return fmt.Sprint(v)
+}
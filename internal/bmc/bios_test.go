@@ -0,0 +1,86 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package bmc
+
+import (
+	"testing"
+
+	"github.com/spf13/viper"
+	"github.com/stmcginnis/gofish/schemas"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/ubccr/grendel/pkg/model"
+)
+
+func TestLoadBiosProfile(t *testing.T) {
+	viper.Set("bmc.bios_profiles", []map[string]any{
+		{"name": "compute", "attributes": []string{"SriovGlobalEnable=Enabled", " ProcCStates = Disabled"}},
+		{"name": "broken", "attributes": []string{"BootMode"}},
+	})
+	defer viper.Set("bmc.bios_profiles", nil)
+
+	profile, err := LoadBiosProfile("compute")
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"SriovGlobalEnable": "Enabled", "ProcCStates": "Disabled"}, profile)
+
+	_, err = LoadBiosProfile("broken")
+	assert.ErrorContains(t, err, "expected Name=Value")
+
+	_, err = LoadBiosProfile("gpu")
+	assert.ErrorIs(t, err, ErrUnknownBiosProfile)
+
+	assert.ErrorContains(t, CheckBiosProfiles(viper.GetViper()), "BIOS profile broken")
+
+	v := viper.New()
+	v.Set("bmc.bios_profiles", []map[string]any{
+		{"name": "compute", "attributes": []string{"SriovGlobalEnable=Enabled"}},
+	})
+	assert.NoError(t, CheckBiosProfiles(v))
+}
+
+func TestBiosDeviations(t *testing.T) {
+	profile := map[string]string{
+		"BootMode":          "Uefi",
+		"ProcCStates":       "Disabled",
+		"SriovGlobalEnable": "Enabled",
+		"MemFrequency":      "3200",
+		"NotSupported":      "On",
+	}
+	current := schemas.SettingsAttributes{
+		"BootMode":          "Uefi",
+		"ProcCStates":       "Enabled",
+		"SriovGlobalEnable": "Disabled",
+		"MemFrequency":      float64(2933),
+	}
+	pending := schemas.SettingsAttributes{
+		"SriovGlobalEnable": "Enabled",
+	}
+
+	assert.Equal(t, []model.BiosDeviation{
+		{Attribute: "MemFrequency", Current: "2933", Profile: "3200"},
+		{Attribute: "NotSupported", Current: "", Profile: "On"},
+		{Attribute: "ProcCStates", Current: "Enabled", Profile: "Disabled"},
+		{Attribute: "SriovGlobalEnable", Current: "Disabled", Profile: "Enabled", Pending: true},
+	}, biosDeviations(profile, current, pending))
+
+	assert.Empty(t, biosDeviations(map[string]string{"BootMode": "Uefi"}, current, pending))
+}
+
+func TestBiosValue(t *testing.T) {
+	v, err := biosValue("3200", float64(2933))
+	require.NoError(t, err)
+	assert.Equal(t, float64(3200), v)
+
+	v, err = biosValue("true", false)
+	require.NoError(t, err)
+	assert.Equal(t, true, v)
+
+	v, err = biosValue("Enabled", "Disabled")
+	require.NoError(t, err)
+	assert.Equal(t, "Enabled", v)
+
+	_, err = biosValue("fast", float64(2933))
+	assert.Error(t, err)
+}
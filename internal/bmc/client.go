@@ -5,14 +5,29 @@
 package bmc
 
 import (
+	"context"
+	"time"
+
 	"github.com/stmcginnis/gofish"
 	"github.com/stmcginnis/gofish/schemas"
 )
 
+// PowerClient controls the power and boot device of a host. It is
+// implemented by Redfish and by IPMI for BMCs without a functional Redfish
+// service
+type PowerClient interface {
+	PowerControl(resetType schemas.ResetType, bootOverride schemas.BootSource) error
+	PowerState() (schemas.PowerState, error)
+	SetBootOverride(target schemas.BootSource, persistent bool) error
+	Logout()
+}
+
 type Redfish struct {
 	config  gofish.ClientConfig
 	client  *gofish.APIClient
 	service *gofish.Service
+	ctx     context.Context
+	cancel  context.CancelFunc
 }
 
 type Firmware struct {
@@ -35,6 +50,43 @@ type FirmwareUpdate struct {
 }
 
 func NewRedfishClient(ip, user, pass string, insecure bool) (*Redfish, error) {
+	return NewRedfishClientTimeout(ip, user, pass, insecure, 0)
+}
+
+// NewRedfishClientTimeout returns a client whose requests fail once timeout
+// has passed since connecting. A timeout of 0 never expires
+func NewRedfishClientTimeout(ip, user, pass string, insecure bool, timeout time.Duration) (*Redfish, error) {
+	return dialRedfish(ip, user, pass, insecure, timeout, true)
+}
+
+// dialRedfish connects to the BMC at ip. With fallback a login rejected by
+// the BMC is retried with the factory default credentials
+func dialRedfish(ip, user, pass string, insecure bool, timeout time.Duration, fallback bool) (*Redfish, error) {
+	ctx, cancel := context.Background(), context.CancelFunc(func() {})
+	if timeout > 0 {
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+	}
+
+	r, err := connectContext(ctx, ip, user, pass, insecure, fallback)
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+	r.ctx = ctx
+	r.cancel = cancel
+
+	return r, nil
+}
+
+// Logout ends the redfish session
+func (r *Redfish) Logout() {
+	r.client.Logout()
+	if r.cancel != nil {
+		r.cancel()
+	}
+}
+
+func connectContext(ctx context.Context, ip, user, pass string, insecure, fallback bool) (*Redfish, error) {
 	endpoint := "https://" + ip
 
 	config := gofish.ClientConfig{
@@ -44,14 +96,14 @@ func NewRedfishClient(ip, user, pass string, insecure bool) (*Redfish, error) {
 		Insecure: insecure,
 	}
 
-	client, err := gofish.Connect(config)
+	client, err := gofish.ConnectContext(ctx, config)
 	if err != nil {
 		e := ParseRedfishError(err)
 		// Try with default credentials
-		if e.Code == "401" {
+		if e.Code == "401" && fallback {
 			config.Username = "root"
 			config.Password = "calvin"
-			client, err = gofish.Connect(config)
+			client, err = gofish.ConnectContext(ctx, config)
 			if err != nil {
 				log.Debug("default credentials failed")
 				return nil, err
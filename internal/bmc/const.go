@@ -4,14 +4,45 @@
 
 package bmc
 
-import "github.com/spf13/viper"
+import (
+	"os"
+	"path/filepath"
+
+	"github.com/spf13/viper"
+)
+
+const (
+	ProtocolAuto    = "auto"
+	ProtocolRedfish = "redfish"
+	ProtocolIPMI    = "ipmi"
+)
 
 const (
-	delay  = 1
-	fanout = 5
+	delay   = 1
+	fanout  = 5
+	timeout = 60
+
+	// fwupdateTimeout is the default time allowed to update the firmware of
+	// one host, which includes the BMC resetting
+	fwupdateTimeout = 1800
+
+	// monitorFanout is the default number of BMCs polled at once by Monitor
+	monitorFanout = 10
+
+	// subscriptionInterval is the default number of seconds between checks
+	// of the event subscriptions of the hosts tagged EventsTag
+	subscriptionInterval = 900
 )
 
 func init() {
 	viper.SetDefault("bmc.delay", delay)
 	viper.SetDefault("bmc.fanout", fanout)
+	viper.SetDefault("bmc.timeout", timeout)
+	viper.SetDefault("bmc.protocol", ProtocolAuto)
+	viper.SetDefault("bmc.fwupdate_timeout", fwupdateTimeout)
+	viper.SetDefault("bmc.firmware_dir", filepath.Join(os.TempDir(), "grendel-firmware"))
+	viper.SetDefault("bmc.monitor_interval", 0)
+	viper.SetDefault("bmc.monitor_fanout", monitorFanout)
+	viper.SetDefault("bmc.event_url", "")
+	viper.SetDefault("bmc.subscription_interval", subscriptionInterval)
 }
@@ -0,0 +1,283 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package bmc
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/stmcginnis/gofish/schemas"
+	"github.com/ubccr/grendel/internal/config"
+	"github.com/ubccr/grendel/internal/secret"
+	"github.com/ubccr/grendel/internal/store"
+	"github.com/ubccr/grendel/pkg/model"
+)
+
+// EventsTag marks the hosts subscribed to Redfish events, whose subscriptions
+// are checked and re-created by WatchSubscriptions
+const EventsTag = "bmc-events"
+
+// EventPath is the path of the event receiver of the API server, followed by
+// the event token of the host
+const EventPath = "/v1/bmc/events/receive/"
+
+// eventContext is sent back by the BMCs with each event and marks the
+// subscriptions created by Grendel
+const eventContext = "grendel"
+
+const eventTokenPurpose = "bmc events"
+
+// ErrInvalidEventToken is returned for event tokens which were not issued by
+// EventToken with the current credentials key
+var ErrInvalidEventToken = errors.New("invalid event token")
+
+// EventToken returns the token authenticating the events pushed by the BMC
+// of host. It is made of the UID of the host, so it survives renames, signed
+// with the credentials key
+func EventToken(host *model.Host) (string, error) {
+	uid := host.UID.String()
+	sig, err := secret.Sign(eventTokenPurpose, uid)
+	if err != nil {
+		return "", err
+	}
+
+	return uid + "." + sig, nil
+}
+
+// ParseEventToken returns the UID of the host of an event token
+func ParseEventToken(token string) (string, error) {
+	uid, sig, ok := strings.Cut(token, ".")
+	if !ok || !secret.Verify(eventTokenPurpose, uid, sig) {
+		return "", ErrInvalidEventToken
+	}
+
+	return uid, nil
+}
+
+// EventDestination returns the URL the BMC of host pushes its events to,
+// under bmc.event_url
+func EventDestination(host *model.Host) (string, error) {
+	base := config.Viper().GetString("bmc.event_url")
+	if base == "" {
+		return "", errors.New("bmc.event_url is not set")
+	}
+
+	token, err := EventToken(host)
+	if err != nil {
+		return "", err
+	}
+
+	return strings.TrimSuffix(base, "/") + EventPath + token, nil
+}
+
+// TranslateEvents returns the events of a Redfish event payload as entries of
+// the log of host. Severity is the Redfish message severity: OK, Warning or
+// Critical
+func TranslateEvents(host string, data []byte) (model.HostLogList, error) {
+	var ev schemas.Event
+	if err := json.Unmarshal(data, &ev); err != nil {
+		return nil, fmt.Errorf("invalid redfish event: %w", err)
+	}
+
+	entries := make(model.HostLogList, 0, len(ev.Events))
+	for _, r := range ev.Events {
+		severity := string(r.MessageSeverity)
+		if severity == "" {
+			severity = r.Severity
+		}
+		if severity == "" {
+			severity = string(schemas.OKHealth)
+		}
+
+		msg := r.Message
+		if msg == "" {
+			msg = strings.TrimSpace(fmt.Sprintf("%s %s", r.EventType, r.MessageID))
+		}
+
+		logged, err := time.Parse(time.RFC3339, r.EventTimestamp)
+		if err != nil {
+			logged = time.Now()
+		}
+
+		entries = append(entries, &model.HostLogEntry{
+			Host:      host,
+			Time:      logged,
+			Severity:  severity,
+			MessageID: r.MessageID,
+			Message:   msg,
+		})
+	}
+
+	return entries, nil
+}
+
+// EventSubscriptions returns the event subscriptions of the BMC created by
+// Grendel
+func (r *Redfish) EventSubscriptions() ([]*schemas.EventDestination, error) {
+	es, err := r.service.EventService()
+	if err != nil {
+		return nil, err
+	}
+
+	subs, err := es.Subscriptions()
+	if err != nil {
+		return nil, err
+	}
+
+	ours := make([]*schemas.EventDestination, 0, len(subs))
+	for _, s := range subs {
+		if s.Context == eventContext {
+			ours = append(ours, s)
+		}
+	}
+
+	return ours, nil
+}
+
+// Subscribe subscribes destination to all the events of the BMC and returns
+// the URI of the subscription
+func (r *Redfish) Subscribe(destination string) (string, error) {
+	es, err := r.service.EventService()
+	if err != nil {
+		return "", err
+	}
+
+	return es.CreateEventSubscriptionInstance(destination, nil, nil, nil,
+		schemas.RedfishEventDestinationProtocol, eventContext, "", nil)
+}
+
+// Unsubscribe deletes the event subscription with the given URI
+func (r *Redfish) Unsubscribe(id string) error {
+	es, err := r.service.EventService()
+	if err != nil {
+		return err
+	}
+
+	return es.DeleteEventSubscription(id)
+}
+
+// RunSubscribe checks the BMC of host has an event subscription pointing at
+// its event destination. With create a missing subscription is created,
+// replacing the subscriptions to older destinations under bmc.event_url
+func (r *jobRunner) RunSubscribe(host *model.Host, ch chan model.EventSubscription, create bool) {
+	r.limit.Execute(func() {
+		m := model.EventSubscription{Status: "error", Host: host.Name, State: model.SubscriptionMissing}
+		defer func() { ch <- m }()
+
+		err := r.subscribe(host, create, &m)
+		if err != nil {
+			m.Msg = fmt.Sprintf("%s", err)
+			return
+		}
+
+		m.Status = "success"
+	})
+}
+
+func (r *jobRunner) subscribe(host *model.Host, create bool, m *model.EventSubscription) error {
+	dest, err := EventDestination(host)
+	if err != nil {
+		return err
+	}
+
+	bmc := host.InterfaceBMC()
+	if bmc == nil {
+		return errors.New("failed to find bmc interface to query")
+	}
+
+	c, err := r.connect(host, bmc.AddrString())
+	if err != nil {
+		return err
+	}
+	defer c.Logout()
+
+	subs, err := c.EventSubscriptions()
+	if err != nil {
+		return err
+	}
+
+	prefix := strings.TrimSuffix(config.Viper().GetString("bmc.event_url"), "/") + EventPath
+	stale := make([]string, 0)
+	for _, s := range subs {
+		if s.Destination == dest {
+			m.State = model.SubscriptionActive
+			m.ID = s.ODataID
+			m.Msg = "Subscribed to events"
+			return nil
+		}
+		if strings.HasPrefix(s.Destination, prefix) {
+			stale = append(stale, s.ODataID)
+		}
+	}
+
+	if !create {
+		m.Msg = "No event subscription to the event receiver"
+		return nil
+	}
+
+	// The credentials key changed or the host was recreated
+	for _, id := range stale {
+		if err := c.Unsubscribe(id); err != nil {
+			log.Debugf("failed to delete stale event subscription %s of %s: %s", id, host.Name, err)
+		}
+	}
+
+	id, err := c.Subscribe(dest)
+	if err != nil {
+		return err
+	}
+
+	m.State = model.SubscriptionCreated
+	m.ID = id
+	m.Msg = "Created event subscription"
+
+	return nil
+}
+
+// WatchSubscriptions checks the event subscriptions of the hosts tagged
+// EventsTag every interval until stop is closed, re-creating the ones lost by
+// the BMCs, such as after a firmware update
+func WatchSubscriptions(db MonitorStore, interval time.Duration, stop <-chan struct{}) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-stop:
+			return
+		case <-ticker.C:
+		}
+
+		hosts := model.HostList{}
+		ns, err := db.FindTags([]string{EventsTag})
+		if err == nil {
+			hosts, err = db.FindHosts(ns)
+		}
+		if err != nil && !errors.Is(err, store.ErrNotFound) {
+			log.Errorf("failed to find hosts tagged %s: %s", EventsTag, err)
+			continue
+		}
+		if len(hosts) == 0 {
+			continue
+		}
+
+		res, err := NewJob(db).Subscribe(hosts, true)
+		if err != nil {
+			log.Errorf("failed to check event subscriptions: %s", err)
+			continue
+		}
+		for _, s := range res {
+			switch {
+			case s.Status != "success":
+				log.Warnf("failed to check event subscription of %s: %s", s.Host, s.Msg)
+			case s.State == model.SubscriptionCreated:
+				log.Infof("Re-created lost event subscription of %s", s.Host)
+			}
+		}
+	}
+}
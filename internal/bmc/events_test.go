@@ -0,0 +1,94 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package bmc
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/segmentio/ksuid"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/ubccr/grendel/pkg/model"
+)
+
+func TestEventToken(t *testing.T) {
+	viper.Set("credentials_key", "key1")
+	viper.Set("bmc.event_url", "https://grendel.example.com:6667/")
+	defer viper.Set("credentials_key", nil)
+	defer viper.Set("bmc.event_url", nil)
+
+	host := &model.Host{Name: "cpn-01", UID: ksuid.New()}
+	token, err := EventToken(host)
+	require.NoError(t, err)
+
+	uid, err := ParseEventToken(token)
+	require.NoError(t, err)
+	assert.Equal(t, host.UID.String(), uid)
+
+	dest, err := EventDestination(host)
+	require.NoError(t, err)
+	assert.Equal(t, "https://grendel.example.com:6667/v1/bmc/events/receive/"+token, dest)
+
+	_, err = ParseEventToken(ksuid.New().String() + token[strings.Index(token, "."):])
+	assert.ErrorIs(t, err, ErrInvalidEventToken)
+	_, err = ParseEventToken(host.UID.String())
+	assert.ErrorIs(t, err, ErrInvalidEventToken)
+
+	// Tokens are invalidated by changing the credentials key
+	viper.Set("credentials_key", "key2")
+	_, err = ParseEventToken(token)
+	assert.ErrorIs(t, err, ErrInvalidEventToken)
+
+	viper.Set("bmc.event_url", "")
+	_, err = EventDestination(host)
+	assert.Error(t, err)
+}
+
+func TestTranslateEvents(t *testing.T) {
+	data := []byte(`{
+  "@odata.type": "#Event.v1_4_0.Event",
+  "Id": "1",
+  "Name": "Event Array",
+  "Context": "grendel",
+  "Events": [
+    {
+      "EventType": "Alert",
+      "EventId": "8575",
+      "EventTimestamp": "2026-10-15T14:02:11-05:00",
+      "MemberId": "0",
+      "Message": "The power input for power supply 1 is lost.",
+      "MessageId": "PSU0003",
+      "MessageSeverity": "Critical",
+      "OriginOfCondition": {"@odata.id": "/redfish/v1/Chassis/System.Embedded.1/Power/PowerSupplies/PSU.Slot.1"}
+    },
+    {
+      "EventType": "Alert",
+      "MessageId": "TMP0118",
+      "Severity": "Warning"
+    }
+  ]
+}`)
+
+	entries, err := TranslateEvents("cpn-01", data)
+	require.NoError(t, err)
+	require.Len(t, entries, 2)
+
+	assert.Equal(t, "cpn-01", entries[0].Host)
+	assert.Equal(t, "Critical", entries[0].Severity)
+	assert.Equal(t, "PSU0003", entries[0].MessageID)
+	assert.Equal(t, "The power input for power supply 1 is lost.", entries[0].Message)
+	assert.True(t, time.Date(2026, 10, 15, 19, 2, 11, 0, time.UTC).Equal(entries[0].Time))
+
+	// Older BMCs only send the deprecated Severity and no message
+	assert.Equal(t, "Warning", entries[1].Severity)
+	assert.Equal(t, "Alert TMP0118", entries[1].Message)
+	assert.False(t, entries[1].Time.IsZero())
+
+	_, err = TranslateEvents("cpn-01", []byte("not json"))
+	assert.Error(t, err)
+}
@@ -0,0 +1,159 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package bmc
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/textproto"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/stmcginnis/gofish/schemas"
+	"github.com/ubccr/grendel/pkg/model"
+)
+
+// fwupdatePollInterval is how often a BMC is checked for the new firmware
+// version once the update task is done
+const fwupdatePollInterval = 30 * time.Second
+
+// FirmwareImage is a firmware update for BMCs. Images with a URI are fetched
+// by the BMCs with SimpleUpdate, others are pushed to them with a multipart
+// request built once and shared by all BMCs.
+type FirmwareImage struct {
+	URI string
+
+	body        []byte
+	contentType string
+}
+
+// LoadFirmwareImage reads the firmware image at path for a multipart push.
+// The update is applied immediately
+func LoadFirmwareImage(path string) (*FirmwareImage, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var body bytes.Buffer
+	w := multipart.NewWriter(&body)
+
+	h := make(textproto.MIMEHeader)
+	h.Set("Content-Disposition", `form-data; name="UpdateParameters"`)
+	h.Set("Content-Type", "application/json")
+	part, err := w.CreatePart(h)
+	if err != nil {
+		return nil, err
+	}
+	_, err = part.Write([]byte(`{"Targets": [], "@Redfish.OperationApplyTime": "Immediate"}`))
+	if err != nil {
+		return nil, err
+	}
+
+	part, err = w.CreateFormFile("UpdateFile", filepath.Base(path))
+	if err != nil {
+		return nil, err
+	}
+	if _, err := io.Copy(part, f); err != nil {
+		return nil, err
+	}
+	if err := w.Close(); err != nil {
+		return nil, err
+	}
+
+	return &FirmwareImage{body: body.Bytes(), contentType: w.FormDataContentType()}, nil
+}
+
+// UpdateFirmware installs image with the Redfish UpdateService and waits for
+// the update task to finish. Losing the connection while waiting is not an
+// error, as BMCs reset to activate their own firmware.
+func (r *Redfish) UpdateFirmware(image *FirmwareImage) error {
+	us, err := r.service.UpdateService()
+	if err != nil {
+		return err
+	}
+
+	var info *schemas.TaskMonitorInfo
+	if image.URI != "" {
+		info, err = us.SimpleUpdate(&schemas.UpdateServiceSimpleUpdateParameters{ImageURI: image.URI})
+		if err != nil {
+			return err
+		}
+	} else {
+		if us.MultipartHTTPPushURI == "" {
+			return errors.New("BMC does not support multipart firmware push, use an image URL")
+		}
+
+		resp, err := r.client.RunRawRequestWithHeaders(http.MethodPost, us.MultipartHTTPPushURI, bytes.NewReader(image.body), image.contentType, nil)
+		if err != nil {
+			return err
+		}
+		defer resp.Body.Close()
+
+		if resp.Header.Get("Location") != "" {
+			info = schemas.ParseTaskMonitorInfo(r.client, resp)
+		}
+	}
+
+	err = r.waitTask(info)
+	var ue *url.Error
+	if err != nil && r.context().Err() == nil && errors.As(err, &ue) {
+		log.Debugf("lost connection to BMC waiting for firmware update task: %s", err)
+		return nil
+	}
+
+	return err
+}
+
+// Inventory returns the firmware versions of the system
+func (r *Redfish) Inventory() (*model.Inventory, error) {
+	system, err := r.GetSystem()
+	if err != nil {
+		return nil, err
+	}
+
+	return &model.Inventory{
+		BIOSVersion: system.BIOSVersion,
+		BMCFirmware: system.BMCFirmware,
+		NICFirmware: system.NICFirmware,
+		CapturedAt:  time.Now().UTC().Truncate(time.Second),
+	}, nil
+}
+
+// waitFirmwareVersion reconnects to the BMC at ip until the firmware of
+// component is version or the deadline passes
+func (r *jobRunner) waitFirmwareVersion(ip, user, pass, component, version string, deadline time.Time) (*model.Inventory, error) {
+	current := ""
+	for {
+		c, err := NewRedfishClientTimeout(ip, user, pass, r.insecure, time.Until(deadline))
+		if err == nil {
+			inv, ierr := c.Inventory()
+			c.Logout()
+			if ierr == nil {
+				if ok, _ := inv.Complies(component, version); ok {
+					return inv, nil
+				}
+				versions, _ := inv.Versions(component)
+				current = strings.Join(versions, ", ")
+			}
+		}
+
+		if time.Until(deadline) < fwupdatePollInterval {
+			if current == "" {
+				return nil, fmt.Errorf("timed out waiting for %s firmware %s", component, version)
+			}
+			return nil, fmt.Errorf("timed out waiting for %s firmware %s, current version %s", component, version, current)
+		}
+		time.Sleep(fwupdatePollInterval)
+	}
+}
@@ -0,0 +1,612 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package bmc
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha1"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"net"
+	"time"
+
+	"github.com/stmcginnis/gofish/schemas"
+)
+
+const (
+	ipmiPort = "623"
+
+	// ipmiRetryInterval is how long to wait for a response before resending
+	// a request. Requests are sent at most ipmiRetries times
+	ipmiRetryInterval = 2 * time.Second
+	ipmiRetries       = 5
+
+	ipmiPayloadIPMI                = 0x00
+	ipmiPayloadOpenSessionRequest  = 0x10
+	ipmiPayloadOpenSessionResponse = 0x11
+	ipmiPayloadRAKP1               = 0x12
+	ipmiPayloadRAKP2               = 0x13
+	ipmiPayloadRAKP3               = 0x14
+	ipmiPayloadRAKP4               = 0x15
+
+	ipmiNetFnChassis = 0x00
+	ipmiNetFnApp     = 0x06
+
+	ipmiCmdChassisStatus     = 0x01
+	ipmiCmdChassisControl    = 0x02
+	ipmiCmdSetBootOptions    = 0x08
+	ipmiCmdGetBootOptions    = 0x09
+	ipmiCmdSetPrivilegeLevel = 0x3b
+	ipmiCmdCloseSession      = 0x3c
+
+	ipmiPrivAdmin    = 0x04
+	ipmiBootFlagsArg = 0x05
+
+	ipmiBMCAddr     = 0x20
+	ipmiConsoleAddr = 0x81
+)
+
+// rmcpHeader is the RMCP header of IPMI messages, which are not acknowledged
+var rmcpHeader = []byte{0x06, 0x00, 0xff, 0x07}
+
+// ipmiCompletionCodes describes the common completion codes of failed IPMI
+// commands
+var ipmiCompletionCodes = map[byte]string{
+	0xc0: "node busy",
+	0xc1: "invalid command",
+	0xc3: "timeout",
+	0xc7: "request data length invalid",
+	0xc9: "parameter out of range",
+	0xcc: "invalid data field in request",
+	0xd4: "insufficient privilege level",
+	0xd5: "command not supported in present state",
+	0xff: "unspecified error",
+}
+
+// ipmiStatusCodes describes the RMCP+ status codes of failed session setup
+var ipmiStatusCodes = map[byte]string{
+	0x01: "insufficient resources to create a session",
+	0x02: "invalid session ID",
+	0x04: "invalid authentication algorithm",
+	0x05: "invalid integrity algorithm",
+	0x09: "invalid role",
+	0x0a: "unauthorized role or privilege level requested",
+	0x0c: "invalid name length",
+	0x0d: "unauthorized name",
+	0x0f: "invalid integrity check value",
+	0x10: "invalid confidentiality algorithm",
+	0x11: "no cipher suite match with proposed security algorithms",
+}
+
+// IPMI is a client for BMCs without a functional Redfish service. It uses an
+// IPMI v2.0 RMCP+ session with cipher suite 3: RAKP-HMAC-SHA1 authentication,
+// HMAC-SHA1-96 integrity and AES-CBC-128 confidentiality. Only power control,
+// boot override, chassis status and serial over LAN are supported.
+type IPMI struct {
+	conn   net.Conn
+	ctx    context.Context
+	cancel context.CancelFunc
+
+	user []byte
+	pass []byte
+
+	// consoleID is our session ID and sessionID the ID assigned by the BMC
+	consoleID uint32
+	sessionID uint32
+	seq       uint32
+	rqSeq     byte
+	k1        []byte
+	k2        []byte
+}
+
+// NewIPMIClientTimeout opens an IPMI session to the BMC at ip. Requests fail
+// once timeout has passed since connecting. A timeout of 0 never expires
+func NewIPMIClientTimeout(ip, user, pass string, timeout time.Duration) (*IPMI, error) {
+	return dialIPMI(net.JoinHostPort(ip, ipmiPort), user, pass, timeout)
+}
+
+func dialIPMI(addr, user, pass string, timeout time.Duration) (*IPMI, error) {
+	if len(user) > 16 {
+		return nil, fmt.Errorf("IPMI user names are limited to 16 characters: %s", user)
+	}
+
+	ctx, cancel := context.Background(), context.CancelFunc(func() {})
+	if timeout > 0 {
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+	}
+
+	conn, err := net.Dial("udp", addr)
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+
+	i := &IPMI{
+		conn:   conn,
+		ctx:    ctx,
+		cancel: cancel,
+		user:   []byte(user),
+		pass:   []byte(pass),
+	}
+
+	err = i.openSession()
+	if err != nil {
+		conn.Close()
+		cancel()
+		return nil, err
+	}
+
+	return i, nil
+}
+
+// Logout closes the IPMI session
+func (i *IPMI) Logout() {
+	if i.k1 != nil {
+		_, _ = i.command(ipmiNetFnApp, ipmiCmdCloseSession, binary.LittleEndian.AppendUint32(nil, i.sessionID)...)
+	}
+	i.conn.Close()
+	i.cancel()
+}
+
+// PowerControl changes the chassis power state, setting the boot device for
+// the next boot first unless bootOverride is None. Hosts which are off are
+// powered on by ForceRestart, as with Redfish
+func (i *IPMI) PowerControl(resetType schemas.ResetType, bootOverride schemas.BootSource) error {
+	if bootOverride != schemas.NoneBootSource {
+		err := i.setBootFlags(bootOverride, false, false)
+		if err != nil {
+			return err
+		}
+	}
+
+	var ctl byte
+	switch resetType {
+	case schemas.ForceOffResetType:
+		ctl = 0x00
+	case schemas.OnResetType:
+		ctl = 0x01
+	case schemas.PowerCycleResetType:
+		ctl = 0x02
+	case schemas.ForceRestartResetType:
+		ctl = 0x03
+		state, err := i.PowerState()
+		if err != nil {
+			return err
+		}
+		if state == schemas.OffPowerState {
+			ctl = 0x01
+		}
+	case schemas.NmiResetType:
+		ctl = 0x04
+	case schemas.GracefulShutdownResetType:
+		ctl = 0x05
+	default:
+		return fmt.Errorf("power option %s is not supported over IPMI", resetType)
+	}
+
+	_, err := i.command(ipmiNetFnChassis, ipmiCmdChassisControl, ctl)
+	return err
+}
+
+// PowerState returns the chassis power state
+func (i *IPMI) PowerState() (schemas.PowerState, error) {
+	resp, err := i.command(ipmiNetFnChassis, ipmiCmdChassisStatus)
+	if err != nil {
+		return "", err
+	}
+
+	if len(resp) < 1 {
+		return "", errors.New("invalid chassis status response")
+	}
+
+	if resp[0]&0x01 != 0 {
+		return schemas.OnPowerState, nil
+	}
+
+	return schemas.OffPowerState, nil
+}
+
+// SetBootOverride sets the boot device in UEFI mode for the next boot, or
+// every boot if persistent is set. The boot flags are read back to check the
+// BMC applied them
+func (i *IPMI) SetBootOverride(target schemas.BootSource, persistent bool) error {
+	err := i.setBootFlags(target, persistent, true)
+	if err != nil {
+		return err
+	}
+
+	resp, err := i.command(ipmiNetFnChassis, ipmiCmdGetBootOptions, ipmiBootFlagsArg, 0x00, 0x00)
+	if err != nil {
+		return err
+	}
+
+	// Parameter version and selector followed by the boot flags
+	if len(resp) < 4 {
+		return errors.New("invalid boot options response")
+	}
+
+	want1, want2, _ := ipmiBootFlags(target, persistent, true)
+	if resp[2]&0xc0 != want1&0xc0 || resp[3]&0x3c != want2 {
+		return fmt.Errorf("boot override not applied: boot flags are 0x%02x 0x%02x, wanted 0x%02x 0x%02x", resp[2], resp[3], want1, want2)
+	}
+
+	return nil
+}
+
+func (i *IPMI) setBootFlags(target schemas.BootSource, persistent, efi bool) error {
+	flags1, flags2, err := ipmiBootFlags(target, persistent, efi)
+	if err != nil {
+		return err
+	}
+
+	_, err = i.command(ipmiNetFnChassis, ipmiCmdSetBootOptions, ipmiBootFlagsArg, flags1, flags2, 0x00, 0x00, 0x00)
+	return err
+}
+
+// ipmiBootFlags returns the first two bytes of the boot flags boot option
+// for the redfish boot source target
+func ipmiBootFlags(target schemas.BootSource, persistent, efi bool) (byte, byte, error) {
+	var device byte
+	switch target {
+	case schemas.PxeBootSource:
+		device = 0x01
+	case schemas.HddBootSource:
+		device = 0x02
+	case schemas.CdBootSource:
+		device = 0x05
+	case schemas.BiosSetupBootSource:
+		device = 0x06
+	default:
+		return 0, 0, fmt.Errorf("boot source %s is not supported over IPMI", target)
+	}
+
+	flags := byte(0x80)
+	if persistent {
+		flags |= 0x40
+	}
+	if efi {
+		flags |= 0x20
+	}
+
+	return flags, device << 2, nil
+}
+
+// openSession establishes an authenticated and encrypted session with the
+// RAKP handshake and raises it to administrator privilege
+func (i *IPMI) openSession() error {
+	var id [4]byte
+	if _, err := rand.Read(id[:]); err != nil {
+		return err
+	}
+	i.consoleID = binary.LittleEndian.Uint32(id[:]) | 1
+
+	req := []byte{0x00, ipmiPrivAdmin, 0x00, 0x00}
+	req = binary.LittleEndian.AppendUint32(req, i.consoleID)
+	req = append(req,
+		0x00, 0x00, 0x00, 0x08, 0x01, 0x00, 0x00, 0x00, // RAKP-HMAC-SHA1
+		0x01, 0x00, 0x00, 0x08, 0x01, 0x00, 0x00, 0x00, // HMAC-SHA1-96
+		0x02, 0x00, 0x00, 0x08, 0x01, 0x00, 0x00, 0x00, // AES-CBC-128
+	)
+	resp, err := i.handshake(ipmiPayloadOpenSessionRequest, req, ipmiPayloadOpenSessionResponse, 12)
+	if err != nil {
+		return fmt.Errorf("open session: %w", err)
+	}
+	managedID := binary.LittleEndian.Uint32(resp[8:12])
+
+	rm := make([]byte, 16)
+	if _, err := rand.Read(rm); err != nil {
+		return err
+	}
+	// Administrator privilege with name only lookup
+	role := byte(ipmiPrivAdmin | 0x10)
+	consoleID := binary.LittleEndian.AppendUint32(nil, i.consoleID)
+	bmcID := binary.LittleEndian.AppendUint32(nil, managedID)
+	login := append([]byte{role, byte(len(i.user))}, i.user...)
+
+	req = append([]byte{0x00, 0x00, 0x00, 0x00}, bmcID...)
+	req = append(req, rm...)
+	req = append(req, role, 0x00, 0x00, byte(len(i.user)))
+	req = append(req, i.user...)
+	resp, err = i.handshake(ipmiPayloadRAKP1, req, ipmiPayloadRAKP2, 60)
+	if err != nil {
+		return fmt.Errorf("RAKP 1: %w", err)
+	}
+	rc, guid := resp[8:24], resp[24:40]
+
+	if !hmac.Equal(resp[40:60], hmacSHA1(i.pass, consoleID, bmcID, rm, rc, guid, login)) {
+		return errors.New("RAKP 2: authentication failed, check the BMC password")
+	}
+
+	req = append([]byte{0x00, 0x00, 0x00, 0x00}, bmcID...)
+	req = append(req, hmacSHA1(i.pass, rc, consoleID, login)...)
+	resp, err = i.handshake(ipmiPayloadRAKP3, req, ipmiPayloadRAKP4, 20)
+	if err != nil {
+		return fmt.Errorf("RAKP 3: %w", err)
+	}
+
+	sik := hmacSHA1(i.pass, rm, rc, login)
+	if !hmac.Equal(resp[8:20], hmacSHA1(sik, rm, bmcID, guid)[:12]) {
+		return errors.New("RAKP 4: integrity check failed")
+	}
+
+	i.k1 = hmacSHA1(sik, bytesOf(0x01, sha1.Size))
+	i.k2 = hmacSHA1(sik, bytesOf(0x02, sha1.Size))
+	i.sessionID = managedID
+
+	// Sessions start at user privilege
+	_, err = i.command(ipmiNetFnApp, ipmiCmdSetPrivilegeLevel, ipmiPrivAdmin)
+	return err
+}
+
+// handshake sends a session setup payload and returns the response of type
+// want, checking its status code and that it is at least size bytes
+func (i *IPMI) handshake(ptype byte, payload []byte, want byte, size int) ([]byte, error) {
+	resp, err := i.exchange(ptype, payload, want, func(p []byte) bool {
+		return len(p) >= 2
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if status := resp[1]; status != 0 {
+		if msg, ok := ipmiStatusCodes[status]; ok {
+			return nil, fmt.Errorf("%s (0x%02x)", msg, status)
+		}
+		return nil, fmt.Errorf("status 0x%02x", status)
+	}
+
+	if len(resp) < size {
+		return nil, errors.New("short response")
+	}
+
+	if binary.LittleEndian.Uint32(resp[4:8]) != i.consoleID {
+		return nil, errors.New("response for another session")
+	}
+
+	return resp, nil
+}
+
+// command sends an IPMI request to the BMC and returns the response data
+// following the completion code
+func (i *IPMI) command(netFn, cmd byte, data ...byte) ([]byte, error) {
+	i.rqSeq = (i.rqSeq + 1) & 0x3f
+	seq := i.rqSeq
+
+	msg := []byte{ipmiBMCAddr, netFn << 2}
+	msg = append(msg, ipmiChecksum(msg))
+	msg = append(msg, ipmiConsoleAddr, seq<<2, cmd)
+	msg = append(msg, data...)
+	msg = append(msg, ipmiChecksum(msg[3:]))
+
+	resp, err := i.exchange(ipmiPayloadIPMI, msg, ipmiPayloadIPMI, func(p []byte) bool {
+		return len(p) >= 8 && p[1]>>2 == netFn+1 && p[4]>>2 == seq && p[5] == cmd
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if cc := resp[6]; cc != 0 {
+		return nil, &ipmiCommandError{cmd: cmd, code: cc}
+	}
+
+	return resp[7 : len(resp)-1], nil
+}
+
+// ipmiCommandError is returned for IPMI requests which failed with a
+// completion code
+type ipmiCommandError struct {
+	cmd  byte
+	code byte
+}
+
+func (e *ipmiCommandError) Error() string {
+	if msg, ok := ipmiCompletionCodes[e.code]; ok {
+		return fmt.Sprintf("IPMI command 0x%02x failed: %s (0x%02x)", e.cmd, msg, e.code)
+	}
+	return fmt.Sprintf("IPMI command 0x%02x failed with completion code 0x%02x", e.cmd, e.code)
+}
+
+// exchange sends payload and returns the first response of type want
+// accepted by match, resending the request while there is no response
+func (i *IPMI) exchange(ptype byte, payload []byte, want byte, match func([]byte) bool) ([]byte, error) {
+	buf := make([]byte, 1024)
+	for range ipmiRetries {
+		pkt, err := i.packet(ptype, payload)
+		if err != nil {
+			return nil, err
+		}
+
+		if _, err := i.conn.Write(pkt); err != nil {
+			return nil, err
+		}
+
+		deadline := time.Now().Add(ipmiRetryInterval)
+		if d, ok := i.ctx.Deadline(); ok && d.Before(deadline) {
+			deadline = d
+		}
+		if err := i.conn.SetReadDeadline(deadline); err != nil {
+			return nil, err
+		}
+
+		for {
+			n, err := i.conn.Read(buf)
+			var ne net.Error
+			if errors.As(err, &ne) && ne.Timeout() {
+				break
+			}
+			if err != nil {
+				return nil, err
+			}
+
+			rtype, resp, err := i.parse(buf[:n])
+			if err != nil || rtype != want || !match(resp) {
+				continue
+			}
+
+			return resp, nil
+		}
+
+		if i.ctx.Err() != nil {
+			break
+		}
+	}
+
+	return nil, errors.New("timed out waiting for IPMI response")
+}
+
+// packet returns payload in an RMCP+ packet. Once the session is established
+// payloads are encrypted and the packet signed
+func (i *IPMI) packet(ptype byte, payload []byte) ([]byte, error) {
+	secure := i.k1 != nil
+	seq := uint32(0)
+	if secure {
+		var err error
+		payload, err = i.encrypt(payload)
+		if err != nil {
+			return nil, err
+		}
+		ptype |= 0xc0
+		i.seq++
+		seq = i.seq
+	}
+
+	b := append([]byte{}, rmcpHeader...)
+	b = append(b, 0x06, ptype)
+	b = binary.LittleEndian.AppendUint32(b, i.sessionID)
+	b = binary.LittleEndian.AppendUint32(b, seq)
+	b = binary.LittleEndian.AppendUint16(b, uint16(len(payload)))
+	b = append(b, payload...)
+
+	if secure {
+		b = append(b, ipmiIntegrityPad(len(b)-len(rmcpHeader))...)
+		b = append(b, hmacSHA1(i.k1, b[len(rmcpHeader):])[:12]...)
+	}
+
+	return b, nil
+}
+
+// parse returns the payload type and payload of an RMCP+ packet, checking
+// the integrity and decrypting the payload of session packets
+func (i *IPMI) parse(b []byte) (byte, []byte, error) {
+	if len(b) < 16 || b[3] != rmcpHeader[3] || b[4] != 0x06 {
+		return 0, nil, errors.New("not an RMCP+ packet")
+	}
+
+	ptype := b[5]
+	n := int(binary.LittleEndian.Uint16(b[14:16]))
+	if len(b) < 16+n {
+		return 0, nil, errors.New("truncated packet")
+	}
+	payload := b[16 : 16+n]
+
+	if ptype&0x40 != 0 {
+		if i.k1 == nil || len(b) < 16+n+2+12 {
+			return 0, nil, errors.New("unexpected authenticated packet")
+		}
+		if binary.LittleEndian.Uint32(b[6:10]) != i.consoleID {
+			return 0, nil, errors.New("packet for another session")
+		}
+		code := len(b) - 12
+		if !hmac.Equal(b[code:], hmacSHA1(i.k1, b[len(rmcpHeader):code])[:12]) {
+			return 0, nil, errors.New("integrity check failed")
+		}
+	}
+
+	if ptype&0x80 != 0 {
+		var err error
+		payload, err = i.decrypt(payload)
+		if err != nil {
+			return 0, nil, err
+		}
+	}
+
+	return ptype & 0x3f, payload, nil
+}
+
+// encrypt returns data encrypted with AES-CBC-128 preceded by the random IV
+func (i *IPMI) encrypt(data []byte) ([]byte, error) {
+	block, err := aes.NewCipher(i.k2[:16])
+	if err != nil {
+		return nil, err
+	}
+
+	pad := (aes.BlockSize - (len(data)+1)%aes.BlockSize) % aes.BlockSize
+	plain := append([]byte{}, data...)
+	for n := 1; n <= pad; n++ {
+		plain = append(plain, byte(n))
+	}
+	plain = append(plain, byte(pad))
+
+	out := make([]byte, aes.BlockSize+len(plain))
+	if _, err := rand.Read(out[:aes.BlockSize]); err != nil {
+		return nil, err
+	}
+	cipher.NewCBCEncrypter(block, out[:aes.BlockSize]).CryptBlocks(out[aes.BlockSize:], plain)
+
+	return out, nil
+}
+
+func (i *IPMI) decrypt(data []byte) ([]byte, error) {
+	if len(data) < 2*aes.BlockSize || len(data)%aes.BlockSize != 0 {
+		return nil, errors.New("invalid encrypted payload length")
+	}
+
+	block, err := aes.NewCipher(i.k2[:16])
+	if err != nil {
+		return nil, err
+	}
+
+	plain := make([]byte, len(data)-aes.BlockSize)
+	cipher.NewCBCDecrypter(block, data[:aes.BlockSize]).CryptBlocks(plain, data[aes.BlockSize:])
+
+	pad := int(plain[len(plain)-1])
+	if pad >= len(plain) {
+		return nil, errors.New("invalid encrypted payload padding")
+	}
+
+	return plain[:len(plain)-1-pad], nil
+}
+
+// ipmiIntegrityPad returns the session trailer preceding the authentication
+// code of a packet with n bytes of session header and payload, which pads
+// them to a multiple of 4 bytes
+func ipmiIntegrityPad(n int) []byte {
+	pad := (4 - (n+2)%4) % 4
+	trailer := bytesOf(0xff, pad)
+
+	return append(trailer, byte(pad), 0x07)
+}
+
+func ipmiChecksum(b []byte) byte {
+	var sum byte
+	for _, c := range b {
+		sum += c
+	}
+
+	return -sum
+}
+
+func hmacSHA1(key []byte, data ...[]byte) []byte {
+	mac := hmac.New(sha1.New, key)
+	for _, d := range data {
+		mac.Write(d)
+	}
+
+	return mac.Sum(nil)
+}
+
+func bytesOf(b byte, n int) []byte {
+	out := make([]byte, n)
+	for i := range out {
+		out[i] = b
+	}
+
+	return out
+}
@@ -0,0 +1,291 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package bmc
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+	"net"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stmcginnis/gofish/schemas"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeBMC answers the RMCP+ session setup and chassis commands of the IPMI
+// client on a local UDP socket. The session keys are held in an IPMI with
+// the session IDs swapped to sign and encrypt the responses
+type fakeBMC struct {
+	conn net.PacketConn
+	user []byte
+	pass []byte
+
+	mu        sync.Mutex
+	session   *IPMI
+	power     bool
+	bootFlags []byte
+	controls  []byte
+	solActive bool
+	console   []byte
+	outSeq    byte
+
+	rm, rc, guid, login []byte
+}
+
+func newFakeBMC(t *testing.T, user, pass string) *fakeBMC {
+	conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() { conn.Close() })
+
+	f := &fakeBMC{
+		conn:      conn,
+		user:      []byte(user),
+		pass:      []byte(pass),
+		session:   &IPMI{consoleID: 0x0a0b0c0d},
+		bootFlags: make([]byte, 5),
+		guid:      bytes.Repeat([]byte{0x42}, 16),
+		rc:        bytes.Repeat([]byte{0x24}, 16),
+	}
+	go f.serve()
+
+	return f
+}
+
+func (f *fakeBMC) serve() {
+	buf := make([]byte, 1024)
+	for {
+		n, addr, err := f.conn.ReadFrom(buf)
+		if err != nil {
+			return
+		}
+
+		f.mu.Lock()
+		s := f.session
+		ptype, req, err := s.parse(buf[:n])
+		if err != nil {
+			f.mu.Unlock()
+			continue
+		}
+
+		managedID := binary.LittleEndian.AppendUint32(nil, s.consoleID)
+		var rtype byte
+		var resp []byte
+		var keys bool
+		switch ptype {
+		case ipmiPayloadOpenSessionRequest:
+			s.sessionID = binary.LittleEndian.Uint32(req[4:8])
+			rtype = ipmiPayloadOpenSessionResponse
+			resp = append([]byte{req[0], 0x00, ipmiPrivAdmin, 0x00}, req[4:8]...)
+			resp = append(resp, managedID...)
+			resp = append(resp, req[8:32]...)
+		case ipmiPayloadRAKP1:
+			rtype = ipmiPayloadRAKP2
+			consoleID := binary.LittleEndian.AppendUint32(nil, s.sessionID)
+			f.rm = append([]byte{}, req[8:24]...)
+			f.login = append([]byte{req[24], req[27]}, req[28:28+int(req[27])]...)
+			if !bytes.Equal(req[28:28+int(req[27])], f.user) {
+				resp = append([]byte{req[0], 0x0d, 0x00, 0x00}, consoleID...)
+				break
+			}
+			resp = append([]byte{req[0], 0x00, 0x00, 0x00}, consoleID...)
+			resp = append(resp, f.rc...)
+			resp = append(resp, f.guid...)
+			resp = append(resp, hmacSHA1(f.pass, consoleID, managedID, f.rm, f.rc, f.guid, f.login)...)
+		case ipmiPayloadRAKP3:
+			rtype = ipmiPayloadRAKP4
+			consoleID := binary.LittleEndian.AppendUint32(nil, s.sessionID)
+			if !bytes.Equal(req[8:28], hmacSHA1(f.pass, f.rc, consoleID, f.login)) {
+				resp = append([]byte{req[0], 0x0f, 0x00, 0x00}, consoleID...)
+				break
+			}
+			sik := hmacSHA1(f.pass, f.rm, f.rc, f.login)
+			resp = append([]byte{req[0], 0x00, 0x00, 0x00}, consoleID...)
+			resp = append(resp, hmacSHA1(sik, f.rm, managedID, f.guid)[:12]...)
+			s.k1 = hmacSHA1(sik, bytesOf(0x01, 20))
+			s.k2 = hmacSHA1(sik, bytesOf(0x02, 20))
+			keys = true
+		case ipmiPayloadIPMI:
+			rtype = ipmiPayloadIPMI
+			resp = f.command(req)
+		case ipmiPayloadSOL:
+			pkts := f.sol(req)
+			f.mu.Unlock()
+			for _, pkt := range pkts {
+				_, _ = f.conn.WriteTo(pkt, addr)
+			}
+			continue
+		}
+
+		// The RAKP 4 response is sent before the session is established
+		k1, k2 := s.k1, s.k2
+		if keys {
+			s.k1, s.k2 = nil, nil
+		}
+		pkt, _ := s.packet(rtype, resp)
+		s.k1, s.k2 = k1, k2
+		f.mu.Unlock()
+
+		_, _ = f.conn.WriteTo(pkt, addr)
+	}
+}
+
+func (f *fakeBMC) command(req []byte) []byte {
+	netFn, cmd, data := req[1]>>2, req[5], req[6:len(req)-1]
+
+	cc := byte(0x00)
+	var out []byte
+	switch {
+	case netFn == ipmiNetFnApp && cmd == ipmiCmdSetPrivilegeLevel:
+		out = []byte{data[0]}
+	case netFn == ipmiNetFnApp && cmd == ipmiCmdCloseSession:
+	case netFn == ipmiNetFnChassis && cmd == ipmiCmdChassisStatus:
+		state := byte(0)
+		if f.power {
+			state = 0x01
+		}
+		out = []byte{state, 0x00, 0x00, 0x00}
+	case netFn == ipmiNetFnChassis && cmd == ipmiCmdChassisControl:
+		f.controls = append(f.controls, data[0])
+		f.power = data[0] != 0x00 && data[0] != 0x05
+	case netFn == ipmiNetFnChassis && cmd == ipmiCmdSetBootOptions:
+		copy(f.bootFlags, data[1:])
+	case netFn == ipmiNetFnChassis && cmd == ipmiCmdGetBootOptions:
+		out = append([]byte{0x01, ipmiBootFlagsArg}, f.bootFlags...)
+	case netFn == ipmiNetFnApp && cmd == ipmiCmdActivatePayload:
+		if f.solActive {
+			cc = ipmiPayloadActive
+			break
+		}
+		f.solActive = true
+		// Payloads of 20 bytes inbound and outbound on port 623
+		out = []byte{0x00, 0x00, 0x00, 0x00, 0x14, 0x00, 0x14, 0x00, 0x6f, 0x02, 0xff, 0xff}
+	case netFn == ipmiNetFnApp && cmd == ipmiCmdDeactivatePayload:
+		f.solActive = false
+	default:
+		cc = 0xc1
+	}
+
+	msg := []byte{ipmiConsoleAddr, (netFn + 1) << 2}
+	msg = append(msg, ipmiChecksum(msg))
+	msg = append(msg, ipmiBMCAddr, req[4], cmd, cc)
+	msg = append(msg, out...)
+
+	return append(msg, ipmiChecksum(msg[3:]))
+}
+
+// sol acknowledges console input and echoes it back as console output
+func (f *fakeBMC) sol(req []byte) [][]byte {
+	seq, data := req[0], req[4:]
+	if seq == 0 {
+		return nil
+	}
+	f.console = append(f.console, data...)
+	f.outSeq = f.outSeq%15 + 1
+
+	ack, _ := f.session.packet(ipmiPayloadSOL, []byte{0x00, seq, byte(len(data)), 0x00})
+	echo, _ := f.session.packet(ipmiPayloadSOL, append([]byte{f.outSeq, 0x00, 0x00, 0x00}, data...))
+
+	return [][]byte{ack, echo}
+}
+
+func TestIPMI(t *testing.T) {
+	f := newFakeBMC(t, "admin", "secret")
+
+	c, err := dialIPMI(f.conn.LocalAddr().String(), "admin", "secret", 10*time.Second)
+	require.NoError(t, err)
+	defer c.Logout()
+
+	state, err := c.PowerState()
+	require.NoError(t, err)
+	assert.Equal(t, schemas.OffPowerState, state)
+
+	// Hosts which are off are powered on by ForceRestart
+	require.NoError(t, c.PowerControl(schemas.ForceRestartResetType, schemas.PxeBootSource))
+	require.NoError(t, c.PowerControl(schemas.ForceRestartResetType, schemas.NoneBootSource))
+	f.mu.Lock()
+	assert.Equal(t, []byte{0x01, 0x03}, f.controls)
+	assert.Equal(t, []byte{0x80, 0x04}, f.bootFlags[:2])
+	f.mu.Unlock()
+
+	state, err = c.PowerState()
+	require.NoError(t, err)
+	assert.Equal(t, schemas.OnPowerState, state)
+
+	require.NoError(t, c.SetBootOverride(schemas.HddBootSource, true))
+	f.mu.Lock()
+	assert.Equal(t, []byte{0xe0, 0x08}, f.bootFlags[:2])
+	f.mu.Unlock()
+
+	assert.ErrorContains(t, c.SetBootOverride(schemas.UsbBootSource, false), "not supported over IPMI")
+	assert.ErrorContains(t, c.PowerControl(schemas.PushPowerButtonResetType, schemas.NoneBootSource), "not supported over IPMI")
+}
+
+func TestIPMIAuthentication(t *testing.T) {
+	f := newFakeBMC(t, "admin", "secret")
+
+	_, err := dialIPMI(f.conn.LocalAddr().String(), "admin", "wrong", 10*time.Second)
+	assert.ErrorContains(t, err, "RAKP 2")
+
+	_, err = dialIPMI(f.conn.LocalAddr().String(), "nobody", "secret", 10*time.Second)
+	assert.ErrorContains(t, err, "unauthorized name")
+}
+
+func TestIPMIPacket(t *testing.T) {
+	i := &IPMI{consoleID: 1, sessionID: 2, k1: bytes.Repeat([]byte{1}, 20), k2: bytes.Repeat([]byte{2}, 20)}
+	bmc := &IPMI{consoleID: 2, sessionID: 1, k1: i.k1, k2: i.k2}
+
+	for _, payload := range [][]byte{{}, []byte("chassis"), bytes.Repeat([]byte{0xaa}, 16)} {
+		pkt, err := i.packet(ipmiPayloadIPMI, payload)
+		require.NoError(t, err)
+		assert.Zero(t, (len(pkt)-len(rmcpHeader)-12)%4, "integrity pad")
+
+		ptype, got, err := bmc.parse(pkt)
+		require.NoError(t, err)
+		assert.Equal(t, byte(ipmiPayloadIPMI), ptype)
+		assert.Equal(t, payload, got)
+
+		pkt[len(pkt)-1] ^= 0xff
+		_, _, err = bmc.parse(pkt)
+		assert.ErrorContains(t, err, "integrity check failed")
+	}
+}
+
+func TestSOL(t *testing.T) {
+	f := newFakeBMC(t, "admin", "secret")
+	f.mu.Lock()
+	f.solActive = true
+	f.mu.Unlock()
+
+	c, err := dialIPMI(f.conn.LocalAddr().String(), "admin", "secret", 0)
+	require.NoError(t, err)
+
+	// SOL left active by another session is deactivated first
+	s, err := c.activateSOL()
+	require.NoError(t, err)
+	assert.Equal(t, 16, s.maxData)
+
+	input := []byte("the quick brown fox jumps over the lazy dog")
+	n, err := s.Write(input)
+	require.NoError(t, err)
+	assert.Equal(t, len(input), n)
+
+	output := make([]byte, len(input))
+	_, err = io.ReadFull(s, output)
+	require.NoError(t, err)
+	assert.Equal(t, input, output)
+
+	require.NoError(t, s.Close())
+	f.mu.Lock()
+	assert.Equal(t, input, f.console)
+	assert.False(t, f.solActive)
+	f.mu.Unlock()
+
+	_, err = s.Read(output)
+	assert.Error(t, err)
+}
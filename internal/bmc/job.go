@@ -7,24 +7,53 @@ package bmc
 import (
 	"encoding/json"
 	"errors"
+	"fmt"
 	"sort"
 	"time"
 
-	"github.com/spf13/viper"
 	"github.com/stmcginnis/gofish/oem/dell"
 	"github.com/stmcginnis/gofish/schemas"
+	"github.com/ubccr/grendel/internal/config"
 	"github.com/ubccr/grendel/pkg/model"
 )
 
+// CredentialSource looks up the encrypted credentials of a host
+type CredentialSource interface {
+	LoadCredential(name, kind string) (*model.Credential, error)
+}
+
 type Job struct {
-	delay  time.Duration
-	fanout int
+	delay   time.Duration
+	fanout  int
+	timeout time.Duration
+	creds   CredentialSource
 }
 
-func NewJob() *Job {
+// NewJob returns a job logging in to each BMC with the credentials of the
+// host in creds, or bmc.user and bmc.password if it has none. creds may be
+// nil
+func NewJob(creds CredentialSource) *Job {
 	return &Job{
-		delay:  time.Duration(viper.GetInt("bmc.delay")) * time.Second,
-		fanout: viper.GetInt("bmc.fanout"),
+		delay:   time.Duration(config.Viper().GetInt("bmc.delay")) * time.Second,
+		fanout:  config.Viper().GetInt("bmc.fanout"),
+		timeout: time.Duration(config.Viper().GetInt("bmc.timeout")) * time.Second,
+		creds:   creds,
+	}
+}
+
+// SetFanout overrides bmc.fanout, the number of BMCs queried at once. Values
+// less than 1 are ignored
+func (j *Job) SetFanout(fanout int) {
+	if fanout > 0 {
+		j.fanout = fanout
+	}
+}
+
+// SetTimeout overrides bmc.timeout, the time allowed for all the requests
+// made to one BMC. Values less than 1 are ignored
+func (j *Job) SetTimeout(timeout time.Duration) {
+	if timeout > 0 {
+		j.timeout = timeout
 	}
 }
 
@@ -70,6 +99,55 @@ func (j *Job) PowerControl(hostList model.HostList, bootOption schemas.BootSourc
 
 }
 
+// PowerStatus returns the redfish power state of each host in the message
+// and the state mapped by SimplePowerState in the data. Hosts which could not
+// be queried have an error status and model.PowerStateUnknown
+func (j *Job) PowerStatus(hostList model.HostList) (model.JobMessageList, error) {
+	runner := newJobRunner(j)
+
+	ch := make(chan model.JobMessage, len(hostList))
+	for i, host := range hostList {
+		if host.HostType() != "server" {
+			continue
+		}
+		runner.RunPowerStatus(host, ch)
+
+		if (i+1)%j.fanout == 0 {
+			time.Sleep(j.delay)
+			continue
+		}
+	}
+
+	runner.Wait()
+	close(ch)
+
+	return FormatOutput(ch)
+}
+
+// BootOverride sets the boot source override target of each host for the
+// next boot, or every boot if persistent is set
+func (j *Job) BootOverride(hostList model.HostList, target schemas.BootSource, persistent bool) (model.JobMessageList, error) {
+	runner := newJobRunner(j)
+
+	ch := make(chan model.JobMessage, len(hostList))
+	for i, host := range hostList {
+		if host.HostType() != "server" {
+			continue
+		}
+		runner.RunBootOverride(host, ch, target, persistent)
+
+		if (i+1)%j.fanout == 0 {
+			time.Sleep(j.delay)
+			continue
+		}
+	}
+
+	runner.Wait()
+	close(ch)
+
+	return FormatOutput(ch)
+}
+
 func (j *Job) BmcStatus(hostList model.HostList) ([]model.RedfishSystem, error) {
 	runner := newJobRunner(j)
 
@@ -107,6 +185,334 @@ func (j *Job) BmcStatus(hostList model.HostList) ([]model.RedfishSystem, error)
 	return arr, nil
 }
 
+// Hardware collects the hardware of each host from its BMC. Hosts which could
+// not be queried have an error status and no hardware
+func (j *Job) Hardware(hostList model.HostList) (model.HardwareReportList, error) {
+	runner := newJobRunner(j)
+
+	ch := make(chan model.JobMessage, len(hostList))
+	for i, host := range hostList {
+		if host.HostType() != "server" {
+			continue
+		}
+		runner.RunHardware(host, ch)
+
+		if (i+1)%j.fanout == 0 {
+			time.Sleep(j.delay)
+			continue
+		}
+	}
+
+	runner.Wait()
+	close(ch)
+
+	arr := model.HardwareReportList{}
+	for m := range ch {
+		report := model.HardwareReport{Host: m.Host, Status: m.Status, Changes: []string{}}
+		if m.Status != "success" {
+			report.Msg = m.Msg
+			arr = append(arr, report)
+			continue
+		}
+
+		hw := &model.Hardware{}
+		err := json.Unmarshal([]byte(m.Msg), hw)
+		if err != nil {
+			return nil, err
+		}
+		report.Hardware = hw
+		arr = append(arr, report)
+	}
+
+	return arr, nil
+}
+
+// VirtualMediaMount inserts image in the CD virtual media device of each host
+// and waits for the BMC to report it inserted. With boot set the host boots
+// from the CD on the next boot and with reboot set it is power cycled.
+func (j *Job) VirtualMediaMount(hostList model.HostList, image string, boot, reboot bool) (model.JobMessageList, error) {
+	return j.virtualMedia(hostList, func(r *Redfish, m *model.JobMessage) error {
+		err := r.MountVirtualMedia(image)
+		if err != nil {
+			return err
+		}
+		m.Msg = fmt.Sprintf("Inserted %s", image)
+		m.Data = model.VirtualMediaInserted
+
+		if boot {
+			err := r.SetBootOverride(schemas.CdBootSource, false)
+			if err != nil {
+				return err
+			}
+			m.Msg += ", set boot override to Cd"
+		}
+
+		if reboot {
+			err := r.PowerControl(schemas.ForceRestartResetType, schemas.NoneBootSource)
+			if err != nil {
+				return err
+			}
+			m.Msg += ", sent power cycle"
+		}
+
+		return nil
+	})
+}
+
+// VirtualMediaUnmount ejects the media of the CD virtual media device of each
+// host
+func (j *Job) VirtualMediaUnmount(hostList model.HostList) (model.JobMessageList, error) {
+	return j.virtualMedia(hostList, func(r *Redfish, m *model.JobMessage) error {
+		err := r.UnmountVirtualMedia()
+		if err != nil {
+			return err
+		}
+		m.Msg = "Ejected virtual media"
+		m.Data = model.VirtualMediaEjected
+
+		return nil
+	})
+}
+
+// VirtualMediaStatus returns the image inserted in the CD virtual media
+// device of each host in the message and model.VirtualMediaInserted or
+// VirtualMediaEjected in the data
+func (j *Job) VirtualMediaStatus(hostList model.HostList) (model.JobMessageList, error) {
+	return j.virtualMedia(hostList, func(r *Redfish, m *model.JobMessage) error {
+		image, inserted, err := r.VirtualMediaStatus()
+		if err != nil {
+			return err
+		}
+		m.Msg = image
+		m.Data = model.VirtualMediaEjected
+		if inserted {
+			m.Data = model.VirtualMediaInserted
+		}
+
+		return nil
+	})
+}
+
+func (j *Job) virtualMedia(hostList model.HostList, action func(*Redfish, *model.JobMessage) error) (model.JobMessageList, error) {
+	runner := newJobRunner(j)
+
+	ch := make(chan model.JobMessage, len(hostList))
+	for i, host := range hostList {
+		if host.HostType() != "server" {
+			continue
+		}
+		runner.RunVirtualMedia(host, ch, action)
+
+		if (i+1)%j.fanout == 0 {
+			time.Sleep(j.delay)
+			continue
+		}
+	}
+
+	runner.Wait()
+	close(ch)
+
+	return FormatOutput(ch)
+}
+
+// GetBios returns the current BIOS attributes of each host
+func (j *Job) GetBios(hostList model.HostList) (model.BiosReportList, error) {
+	return j.bios(hostList, func(r *Redfish) (*model.BiosReport, error) {
+		attrs, err := r.GetBios()
+		if err != nil {
+			return nil, err
+		}
+
+		return &model.BiosReport{
+			Msg:        fmt.Sprintf("%d attribute(s)", len(attrs)),
+			Attributes: attrs,
+			Deviations: []model.BiosDeviation{},
+		}, nil
+	})
+}
+
+// DiffBios compares the BIOS attributes of each host with profile
+func (j *Job) DiffBios(hostList model.HostList, profile map[string]string) (model.BiosReportList, error) {
+	return j.bios(hostList, func(r *Redfish) (*model.BiosReport, error) {
+		return r.DiffBios(profile)
+	})
+}
+
+// ApplyBios sets the BIOS attributes of each host which differ from profile
+// to apply on the next reboot. With reboot set hosts requiring a reboot are
+// power cycled.
+func (j *Job) ApplyBios(hostList model.HostList, profile map[string]string, reboot bool) (model.BiosReportList, error) {
+	return j.bios(hostList, func(r *Redfish) (*model.BiosReport, error) {
+		report, err := r.ApplyBios(profile)
+		if err != nil {
+			return nil, err
+		}
+
+		if reboot && report.RebootRequired {
+			err := r.PowerControl(schemas.ForceRestartResetType, schemas.NoneBootSource)
+			if err != nil {
+				return nil, err
+			}
+			report.RebootRequired = false
+			report.Msg += ", sent power cycle"
+		}
+
+		return report, nil
+	})
+}
+
+func (j *Job) bios(hostList model.HostList, action func(*Redfish) (*model.BiosReport, error)) (model.BiosReportList, error) {
+	runner := newJobRunner(j)
+
+	ch := make(chan model.JobMessage, len(hostList))
+	for i, host := range hostList {
+		if host.HostType() != "server" {
+			continue
+		}
+		runner.RunBios(host, ch, action)
+
+		if (i+1)%j.fanout == 0 {
+			time.Sleep(j.delay)
+			continue
+		}
+	}
+
+	runner.Wait()
+	close(ch)
+
+	arr := model.BiosReportList{}
+	for m := range ch {
+		report := model.BiosReport{Deviations: []model.BiosDeviation{}}
+		if m.Status == "success" {
+			err := json.Unmarshal([]byte(m.Msg), &report)
+			if err != nil {
+				return nil, err
+			}
+		} else {
+			report.Msg = m.Msg
+		}
+		report.Host = m.Host
+		report.Status = m.Status
+		arr = append(arr, report)
+	}
+
+	return arr, nil
+}
+
+// UpdateFirmware updates the firmware of component, an inventory field, to
+// version on each host using image. Hosts already on version are skipped so
+// an interrupted rollout can be run again
+func (j *Job) UpdateFirmware(hostList model.HostList, image *FirmwareImage, component, version string) (model.FirmwareUpdateReportList, error) {
+	runner := newJobRunner(j)
+
+	ch := make(chan model.JobMessage, len(hostList))
+	for i, host := range hostList {
+		if host.HostType() != "server" {
+			continue
+		}
+		runner.RunFirmwareUpdate(host, ch, image, component, version)
+
+		if (i+1)%j.fanout == 0 {
+			time.Sleep(j.delay)
+			continue
+		}
+	}
+
+	runner.Wait()
+	close(ch)
+
+	arr := model.FirmwareUpdateReportList{}
+	for m := range ch {
+		report := model.FirmwareUpdateReport{Component: component, Version: version}
+		if m.Status == "success" {
+			err := json.Unmarshal([]byte(m.Msg), &report)
+			if err != nil {
+				return nil, err
+			}
+		} else {
+			report.Msg = m.Msg
+		}
+		report.Host = m.Host
+		report.Status = m.Status
+		arr = append(arr, report)
+	}
+
+	return arr, nil
+}
+
+// RotatePassword sets a new random password of length characters on the BMC
+// of each host and calls commit to store it once the BMC accepted it
+func (j *Job) RotatePassword(hostList model.HostList, length int, commit CommitLogin) (model.PasswordRotationReportList, error) {
+	runner := newJobRunner(j)
+
+	ch := make(chan model.PasswordRotationReport, len(hostList))
+	for i, host := range hostList {
+		if host.HostType() != "server" {
+			continue
+		}
+		runner.RunRotatePassword(host, ch, length, commit)
+
+		if (i+1)%j.fanout == 0 {
+			time.Sleep(j.delay)
+			continue
+		}
+	}
+
+	runner.Wait()
+	close(ch)
+
+	arr := model.PasswordRotationReportList{}
+	for m := range ch {
+		arr = append(arr, m)
+	}
+
+	return arr, nil
+}
+
+// Subscribe checks the Redfish event subscription of each host, creating the
+// missing ones when create is set
+func (j *Job) Subscribe(hostList model.HostList, create bool) (model.EventSubscriptionList, error) {
+	runner := newJobRunner(j)
+
+	ch := make(chan model.EventSubscription, len(hostList))
+	for i, host := range hostList {
+		if host.HostType() != "server" {
+			continue
+		}
+		runner.RunSubscribe(host, ch, create)
+
+		if (i+1)%j.fanout == 0 {
+			time.Sleep(j.delay)
+			continue
+		}
+	}
+
+	runner.Wait()
+	close(ch)
+
+	arr := model.EventSubscriptionList{}
+	for m := range ch {
+		arr = append(arr, m)
+	}
+
+	return arr, nil
+}
+
+// Console opens an IPMI serial over LAN session to the console of host
+func (j *Job) Console(host *model.Host) (*SOL, error) {
+	bmc := host.InterfaceBMC()
+	if bmc == nil {
+		return nil, errors.New("failed to find bmc interface to query")
+	}
+
+	user, pass, err := newJobRunner(j).login(host)
+	if err != nil {
+		return nil, err
+	}
+
+	return ConnectSOL(bmc.AddrString(), user, pass)
+}
+
 func (j *Job) GetJobs(hostList model.HostList) (model.RedfishJobList, error) {
 	runner := newJobRunner(j)
 
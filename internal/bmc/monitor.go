@@ -0,0 +1,288 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package bmc
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/stmcginnis/gofish/schemas"
+	"github.com/ubccr/grendel/internal/config"
+	"github.com/ubccr/grendel/internal/store"
+	"github.com/ubccr/grendel/pkg/model"
+	"github.com/ubccr/grendel/pkg/nodeset"
+)
+
+// MonitorTag marks the hosts polled by Monitor
+const MonitorTag = "bmc-monitor"
+
+// monitorMaxBackoff caps the time between polls of an unreachable BMC, unless
+// the poll interval is longer
+const monitorMaxBackoff = time.Hour
+
+// MonitorStore is the store of the hosts polled by Monitor
+type MonitorStore interface {
+	CredentialSource
+	FindTags(tags []string) (*nodeset.NodeSet, error)
+	FindHosts(ns *nodeset.NodeSet) (model.HostList, error)
+}
+
+// monitorHost is the latest state of a polled host
+type monitorHost struct {
+	up       bool
+	readings []SensorReading
+	polled   time.Time
+	failures int
+	next     time.Time
+}
+
+// Monitor polls the chassis sensors of the hosts tagged MonitorTag and keeps
+// the latest readings of each, exported as prometheus gauges. Hosts failing
+// to respond are polled exponentially less often until they recover
+type Monitor struct {
+	db       MonitorStore
+	job      *Job
+	interval time.Duration
+
+	mu    sync.Mutex
+	hosts map[string]*monitorHost
+
+	// read returns the sensors of a host
+	read func(r *jobRunner, host *model.Host) ([]SensorReading, error)
+
+	up       *prometheus.Desc
+	polled   *prometheus.Desc
+	failures *prometheus.Desc
+	temp     *prometheus.Desc
+	fan      *prometheus.Desc
+	power    *prometheus.Desc
+	psu      *prometheus.Desc
+	health   *prometheus.Desc
+}
+
+// NewMonitor returns a monitor polling every bmc.monitor_interval seconds,
+// querying up to bmc.monitor_fanout BMCs at once
+func NewMonitor(db MonitorStore) *Monitor {
+	job := NewJob(db)
+	job.SetFanout(config.Viper().GetInt("bmc.monitor_fanout"))
+
+	sensor := []string{"host", "chassis", "sensor"}
+	return &Monitor{
+		db:       db,
+		job:      job,
+		interval: time.Duration(config.Viper().GetInt("bmc.monitor_interval")) * time.Second,
+		hosts:    make(map[string]*monitorHost),
+		read:     readSensors,
+		up: prometheus.NewDesc("grendel_bmc_up",
+			"Whether the last poll of the BMC succeeded", []string{"host"}, nil),
+		polled: prometheus.NewDesc("grendel_bmc_last_poll_timestamp_seconds",
+			"Time of the last successful poll of the BMC", []string{"host"}, nil),
+		failures: prometheus.NewDesc("grendel_bmc_poll_failures",
+			"Number of consecutive failed polls of the BMC", []string{"host"}, nil),
+		temp: prometheus.NewDesc("grendel_bmc_temperature_celsius",
+			"Temperature sensor reading", sensor, nil),
+		fan: prometheus.NewDesc("grendel_bmc_fan_speed",
+			"Fan speed reading in RPM or percent", append(sensor, "units"), nil),
+		power: prometheus.NewDesc("grendel_bmc_power_consumed_watts",
+			"Power consumed by the chassis", sensor, nil),
+		psu: prometheus.NewDesc("grendel_bmc_power_supply_input_watts",
+			"Input power of the power supply", sensor, nil),
+		health: prometheus.NewDesc("grendel_bmc_sensor_health",
+			"Health of the sensor: 0 OK, 1 Warning, 2 Critical", append(sensor, "type"), nil),
+	}
+}
+
+// Interval is the time between polls, 0 when monitoring is disabled
+func (m *Monitor) Interval() time.Duration {
+	return m.interval
+}
+
+// Run polls the BMCs every interval until stop is closed
+func (m *Monitor) Run(stop <-chan struct{}) {
+	ticker := time.NewTicker(m.interval)
+	defer ticker.Stop()
+
+	for {
+		m.pollAll(time.Now())
+
+		select {
+		case <-stop:
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// pollAll polls the tagged hosts which are not backing off and waits for
+// the polls to finish
+func (m *Monitor) pollAll(now time.Time) {
+	hosts := model.HostList{}
+	ns, err := m.db.FindTags([]string{MonitorTag})
+	if err == nil {
+		hosts, err = m.db.FindHosts(ns)
+	}
+	if err != nil && !errors.Is(err, store.ErrNotFound) {
+		log.Errorf("failed to find hosts tagged %s: %s", MonitorTag, err)
+		return
+	}
+
+	m.mu.Lock()
+	tagged := make(map[string]*monitorHost, len(hosts))
+	due := make(model.HostList, 0, len(hosts))
+	for _, host := range hosts {
+		state, ok := m.hosts[host.Name]
+		if !ok {
+			state = &monitorHost{}
+		}
+		tagged[host.Name] = state
+		if !now.Before(state.next) {
+			due = append(due, host)
+		}
+	}
+	// Drop hosts no longer tagged
+	m.hosts = tagged
+	m.mu.Unlock()
+
+	runner := newJobRunner(m.job)
+	for _, host := range due {
+		runner.limit.Execute(func() {
+			readings, err := m.read(runner, host)
+			m.record(host.Name, readings, err, now)
+		})
+	}
+	runner.Wait()
+}
+
+// record stores the result of polling host at now. Failures back off
+// exponentially, only the first is logged as a warning
+func (m *Monitor) record(host string, readings []SensorReading, err error, now time.Time) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	state, ok := m.hosts[host]
+	if !ok {
+		return
+	}
+
+	if err == nil {
+		if state.failures > 0 {
+			log.Infof("BMC of %s responded after %d failed polls", host, state.failures)
+		}
+		state.up = true
+		state.readings = readings
+		state.polled = now
+		state.failures = 0
+		state.next = time.Time{}
+		return
+	}
+
+	state.up = false
+	state.failures++
+	state.next = now.Add(m.backoff(state.failures))
+	if state.failures == 1 {
+		log.Warnf("failed to poll BMC of %s, backing off: %s", host, err)
+	} else {
+		log.Debugf("failed to poll BMC of %s %d times: %s", host, state.failures, err)
+	}
+}
+
+// backoff returns the time to wait before polling a host again after
+// failures consecutive failed polls
+func (m *Monitor) backoff(failures int) time.Duration {
+	limit := max(m.interval, monitorMaxBackoff)
+	backoff := m.interval
+	for i := 1; i < failures && backoff < limit; i++ {
+		backoff *= 2
+	}
+
+	return min(backoff, limit)
+}
+
+func readSensors(r *jobRunner, host *model.Host) ([]SensorReading, error) {
+	bmc := host.InterfaceBMC()
+	if bmc == nil {
+		return nil, errors.New("failed to find bmc interface to query")
+	}
+
+	c, err := r.connect(host, bmc.AddrString())
+	if err != nil {
+		return nil, err
+	}
+	defer c.Logout()
+
+	return c.Sensors()
+}
+
+// Describe implements prometheus.Collector
+func (m *Monitor) Describe(ch chan<- *prometheus.Desc) {
+	for _, d := range []*prometheus.Desc{m.up, m.polled, m.failures, m.temp, m.fan, m.power, m.psu, m.health} {
+		ch <- d
+	}
+}
+
+// Collect implements prometheus.Collector. Sensors are only reported for
+// hosts whose last poll succeeded
+func (m *Monitor) Collect(ch chan<- prometheus.Metric) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for host, state := range m.hosts {
+		up := 0.0
+		if state.up {
+			up = 1
+		}
+		ch <- prometheus.MustNewConstMetric(m.up, prometheus.GaugeValue, up, host)
+		ch <- prometheus.MustNewConstMetric(m.failures, prometheus.GaugeValue, float64(state.failures), host)
+		if !state.polled.IsZero() {
+			ch <- prometheus.MustNewConstMetric(m.polled, prometheus.GaugeValue, float64(state.polled.Unix()), host)
+		}
+		if !state.up {
+			continue
+		}
+
+		// BMCs may name several sensors alike, which prometheus rejects
+		seen := make(map[[3]string]bool, len(state.readings))
+		for _, s := range state.readings {
+			key := [3]string{s.Type, s.Chassis, s.Name}
+			if seen[key] {
+				continue
+			}
+			seen[key] = true
+
+			if health, ok := sensorHealth(s.Health); ok {
+				ch <- prometheus.MustNewConstMetric(m.health, prometheus.GaugeValue, health, host, s.Chassis, s.Name, s.Type)
+			}
+			if s.Value == nil {
+				continue
+			}
+
+			switch s.Type {
+			case SensorTemperature:
+				ch <- prometheus.MustNewConstMetric(m.temp, prometheus.GaugeValue, *s.Value, host, s.Chassis, s.Name)
+			case SensorFan:
+				ch <- prometheus.MustNewConstMetric(m.fan, prometheus.GaugeValue, *s.Value, host, s.Chassis, s.Name, s.Units)
+			case SensorPower:
+				ch <- prometheus.MustNewConstMetric(m.power, prometheus.GaugeValue, *s.Value, host, s.Chassis, s.Name)
+			case SensorPowerSupply:
+				ch <- prometheus.MustNewConstMetric(m.psu, prometheus.GaugeValue, *s.Value, host, s.Chassis, s.Name)
+			}
+		}
+	}
+}
+
+func sensorHealth(h schemas.Health) (float64, bool) {
+	switch h {
+	case schemas.OKHealth:
+		return 0, true
+	case schemas.WarningHealth:
+		return 1, true
+	case schemas.CriticalHealth:
+		return 2, true
+	}
+
+	return 0, false
+}
@@ -0,0 +1,117 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package bmc
+
+import (
+	"errors"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/spf13/viper"
+	"github.com/stmcginnis/gofish/schemas"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/ubccr/grendel/internal/store"
+	"github.com/ubccr/grendel/pkg/model"
+	"github.com/ubccr/grendel/pkg/nodeset"
+)
+
+type testMonitorStore struct {
+	testCredentials
+	hosts model.HostList
+}
+
+func (s testMonitorStore) FindTags(tags []string) (*nodeset.NodeSet, error) {
+	if len(s.hosts) == 0 {
+		return nil, store.ErrNotFound
+	}
+
+	ns := nodeset.EmptyNodeSet()
+	for _, h := range s.hosts {
+		ns.Add(h.Name)
+	}
+
+	return ns, nil
+}
+
+func (s testMonitorStore) FindHosts(ns *nodeset.NodeSet) (model.HostList, error) {
+	return s.hosts, nil
+}
+
+func TestMonitor(t *testing.T) {
+	viper.Set("bmc.monitor_interval", 60)
+	defer viper.Set("bmc.monitor_interval", nil)
+
+	db := testMonitorStore{hosts: model.HostList{{Name: "cpn-01"}, {Name: "cpn-02"}}}
+	m := NewMonitor(db)
+
+	temp := 24.0
+	rpm := 6240.0
+	var mu sync.Mutex
+	polls := make(map[string]int)
+	m.read = func(r *jobRunner, host *model.Host) ([]SensorReading, error) {
+		mu.Lock()
+		polls[host.Name]++
+		mu.Unlock()
+
+		if host.Name == "cpn-02" {
+			return nil, errors.New("timeout")
+		}
+		return []SensorReading{
+			{Chassis: "System.Embedded.1", Name: "Inlet Temp", Type: SensorTemperature, Units: "celsius", Value: &temp, Health: schemas.OKHealth},
+			{Chassis: "System.Embedded.1", Name: "Fan1", Type: SensorFan, Units: "rpm", Value: &rpm, Health: schemas.CriticalHealth},
+			{Chassis: "System.Embedded.1", Name: "Fan1", Type: SensorFan, Units: "rpm", Value: &rpm, Health: schemas.OKHealth},
+		}, nil
+	}
+
+	now := time.Now()
+	m.pollAll(now)
+
+	err := testutil.CollectAndCompare(m, strings.NewReader(`
+# HELP grendel_bmc_up Whether the last poll of the BMC succeeded
+# TYPE grendel_bmc_up gauge
+grendel_bmc_up{host="cpn-01"} 1
+grendel_bmc_up{host="cpn-02"} 0
+# HELP grendel_bmc_temperature_celsius Temperature sensor reading
+# TYPE grendel_bmc_temperature_celsius gauge
+grendel_bmc_temperature_celsius{chassis="System.Embedded.1",host="cpn-01",sensor="Inlet Temp"} 24
+# HELP grendel_bmc_fan_speed Fan speed reading in RPM or percent
+# TYPE grendel_bmc_fan_speed gauge
+grendel_bmc_fan_speed{chassis="System.Embedded.1",host="cpn-01",sensor="Fan1",units="rpm"} 6240
+# HELP grendel_bmc_sensor_health Health of the sensor: 0 OK, 1 Warning, 2 Critical
+# TYPE grendel_bmc_sensor_health gauge
+grendel_bmc_sensor_health{chassis="System.Embedded.1",host="cpn-01",sensor="Fan1",type="fan"} 2
+grendel_bmc_sensor_health{chassis="System.Embedded.1",host="cpn-01",sensor="Inlet Temp",type="temperature"} 0
+`), "grendel_bmc_up", "grendel_bmc_temperature_celsius", "grendel_bmc_fan_speed", "grendel_bmc_sensor_health")
+	assert.NoError(t, err)
+
+	// Failing hosts back off, doubling the interval
+	m.pollAll(now.Add(time.Minute))
+	assert.Equal(t, 2, polls["cpn-01"])
+	assert.Equal(t, 2, polls["cpn-02"])
+	m.pollAll(now.Add(2 * time.Minute))
+	assert.Equal(t, 3, polls["cpn-01"])
+	assert.Equal(t, 2, polls["cpn-02"])
+
+	// Untagged hosts are dropped
+	m.db = testMonitorStore{}
+	m.pollAll(now.Add(3 * time.Minute))
+	require.NoError(t, testutil.CollectAndCompare(m, strings.NewReader(""), "grendel_bmc_up"))
+}
+
+func TestMonitorBackoff(t *testing.T) {
+	m := &Monitor{interval: time.Minute}
+	assert.Equal(t, time.Minute, m.backoff(1))
+	assert.Equal(t, 2*time.Minute, m.backoff(2))
+	assert.Equal(t, 32*time.Minute, m.backoff(6))
+	assert.Equal(t, time.Hour, m.backoff(7))
+	assert.Equal(t, time.Hour, m.backoff(1000))
+
+	m = &Monitor{interval: 2 * time.Hour}
+	assert.Equal(t, 2*time.Hour, m.backoff(3))
+}
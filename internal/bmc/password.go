@@ -0,0 +1,194 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package bmc
+
+import (
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ubccr/grendel/internal/secret"
+	"github.com/ubccr/grendel/pkg/model"
+)
+
+// Bounds of the length of generated BMC passwords. Most BMCs reject
+// passwords longer than 20 characters before iDRAC9
+const (
+	DefaultPasswordLength = 20
+	MinPasswordLength     = 12
+	MaxPasswordLength     = 40
+)
+
+// Characters of generated passwords. Look-alike characters are left out and
+// the symbols are accepted by iDRAC, iLO and OpenBMC
+var passwordClasses = []string{
+	"abcdefghijkmnopqrstuvwxyz",
+	"ABCDEFGHJKLMNPQRSTUVWXYZ",
+	"23456789",
+	"-_.",
+}
+
+// accountClient changes the passwords of the accounts of a BMC
+type accountClient interface {
+	ChangePassword(username, password string) error
+	Logout()
+}
+
+// CommitLogin stores the new BMC login of host once the BMC accepted it
+type CommitLogin func(host string, login secret.Login) error
+
+// GeneratePassword returns a random password of length characters with at
+// least one lower case letter, upper case letter, digit and symbol, which
+// satisfies the password policies of common BMCs
+func GeneratePassword(length int) (string, error) {
+	if length < MinPasswordLength || length > MaxPasswordLength {
+		return "", fmt.Errorf("invalid password length %d, expected %d to %d", length, MinPasswordLength, MaxPasswordLength)
+	}
+
+	all := ""
+	for _, c := range passwordClasses {
+		all += c
+	}
+
+	pick := func(chars string) (byte, error) {
+		n, err := rand.Int(rand.Reader, big.NewInt(int64(len(chars))))
+		if err != nil {
+			return 0, err
+		}
+		return chars[n.Int64()], nil
+	}
+
+	password := make([]byte, length)
+	for i := range password {
+		chars := all
+		if i < len(passwordClasses) {
+			chars = passwordClasses[i]
+		}
+		c, err := pick(chars)
+		if err != nil {
+			return "", err
+		}
+		password[i] = c
+	}
+
+	// Move the required characters away from the start
+	for i := len(password) - 1; i > 0; i-- {
+		j, err := rand.Int(rand.Reader, big.NewInt(int64(i+1)))
+		if err != nil {
+			return "", err
+		}
+		password[i], password[j.Int64()] = password[j.Int64()], password[i]
+	}
+
+	return string(password), nil
+}
+
+// ChangePassword sets the password of the BMC account username
+func (r *Redfish) ChangePassword(username, password string) error {
+	as, err := r.service.AccountService()
+	if err != nil {
+		return err
+	}
+
+	accounts, err := as.Accounts()
+	if err != nil {
+		return err
+	}
+
+	for _, a := range accounts {
+		if a.UserName != username {
+			continue
+		}
+
+		a.Password = password
+		return a.Update()
+	}
+
+	return fmt.Errorf("failed to find BMC account %s", username)
+}
+
+// dialAccountRedfish logs in to the BMC at ip without falling back to the
+// factory default credentials, so a rejected password is never mistaken for
+// a working one
+func (r *jobRunner) dialAccountRedfish(ip, user, pass string) (accountClient, error) {
+	c, err := dialRedfish(ip, user, pass, r.insecure, r.timeout, false)
+	if err != nil {
+		return nil, err
+	}
+
+	return c, nil
+}
+
+func (r *jobRunner) RunRotatePassword(host *model.Host, ch chan model.PasswordRotationReport, length int, commit CommitLogin) {
+	r.limit.Execute(func() {
+		m := model.PasswordRotationReport{Status: "error", Host: host.Name}
+		defer func() { ch <- m }()
+
+		err := r.rotatePassword(host, length, commit, &m)
+		if err != nil {
+			m.Msg = fmt.Sprintf("%s", err)
+			return
+		}
+
+		m.Status = "success"
+		m.Msg = "Rotated BMC password"
+	})
+}
+
+// rotatePassword sets a new random password on the BMC of host for the
+// account of its current credentials, checks the BMC accepts it and only
+// then commits it. The previous password is restored if either step fails,
+// and the host is flagged for attention if that fails too
+func (r *jobRunner) rotatePassword(host *model.Host, length int, commit CommitLogin, m *model.PasswordRotationReport) error {
+	bmc := host.InterfaceBMC()
+	if bmc == nil {
+		return errors.New("failed to find bmc interface to query")
+	}
+	ip := bmc.AddrString()
+
+	user, pass, err := r.login(host)
+	if err != nil {
+		return err
+	}
+	m.Username = user
+
+	password, err := GeneratePassword(length)
+	if err != nil {
+		return err
+	}
+
+	c, err := r.dialAccount(ip, user, pass)
+	if err != nil {
+		return fmt.Errorf("failed to log in with the current credentials: %w", err)
+	}
+	defer c.Logout()
+
+	err = c.ChangePassword(user, password)
+	if err != nil {
+		return fmt.Errorf("failed to set new password: %w", err)
+	}
+
+	restore := func(rc accountClient, cause error) error {
+		if err := rc.ChangePassword(user, pass); err != nil {
+			m.Attention = true
+			return fmt.Errorf("%w, restoring the previous password failed: %w", cause, err)
+		}
+		return fmt.Errorf("%w, restored the previous password", cause)
+	}
+
+	nc, err := r.dialAccount(ip, user, password)
+	if err != nil {
+		return restore(c, fmt.Errorf("failed to log in with the new password: %w", err))
+	}
+	defer nc.Logout()
+
+	err = commit(host.Name, secret.Login{Username: user, Password: password})
+	if err != nil {
+		return restore(nc, fmt.Errorf("failed to store the new password: %w", err))
+	}
+
+	return nil
+}
@@ -0,0 +1,144 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package bmc
+
+import (
+	"errors"
+	"net/netip"
+	"strings"
+	"testing"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/ubccr/grendel/internal/secret"
+	"github.com/ubccr/grendel/pkg/model"
+)
+
+// testAccount is a BMC with a single account
+type testAccount struct {
+	user     string
+	password string
+	// changes is the number of password changes the BMC accepts
+	changes int
+	// reject makes the BMC reject logins with new passwords
+	reject bool
+	orig   string
+}
+
+type testAccountClient struct {
+	bmc *testAccount
+}
+
+func (c testAccountClient) ChangePassword(username, password string) error {
+	if username != c.bmc.user {
+		return errors.New("no such account")
+	}
+	if c.bmc.changes == 0 {
+		return errors.New("password change failed")
+	}
+	c.bmc.changes--
+	c.bmc.password = password
+
+	return nil
+}
+
+func (c testAccountClient) Logout() {}
+
+func (a *testAccount) dial(ip, user, pass string) (accountClient, error) {
+	if user != a.user || pass != a.password || (a.reject && pass != a.orig) {
+		return nil, errors.New("401 unauthorized")
+	}
+
+	return testAccountClient{bmc: a}, nil
+}
+
+func TestGeneratePassword(t *testing.T) {
+	for _, length := range []int{MinPasswordLength, DefaultPasswordLength, MaxPasswordLength} {
+		password, err := GeneratePassword(length)
+		require.NoError(t, err)
+		assert.Len(t, password, length)
+		for _, class := range passwordClasses {
+			assert.True(t, strings.ContainsAny(password, class), "%s missing one of %s", password, class)
+		}
+	}
+
+	a, _ := GeneratePassword(DefaultPasswordLength)
+	b, _ := GeneratePassword(DefaultPasswordLength)
+	assert.NotEqual(t, a, b)
+
+	_, err := GeneratePassword(MinPasswordLength - 1)
+	assert.Error(t, err)
+	_, err = GeneratePassword(MaxPasswordLength + 1)
+	assert.Error(t, err)
+}
+
+func TestRotatePassword(t *testing.T) {
+	viper.Reset()
+	defer viper.Reset()
+	viper.Set("bmc.user", "root")
+	viper.Set("bmc.password", "calvin")
+	viper.Set("bmc.fanout", 1)
+
+	host := &model.Host{
+		Name:       "cpn-01",
+		Interfaces: []*model.NetInterface{{BMC: true, IP: netip.MustParsePrefix("10.0.0.1/24")}},
+	}
+
+	rotate := func(bmc *testAccount, commit CommitLogin) model.PasswordRotationReport {
+		bmc.orig = bmc.password
+		r := newJobRunner(NewJob(nil))
+		r.dialAccount = bmc.dial
+		ch := make(chan model.PasswordRotationReport, 1)
+		r.RunRotatePassword(host, ch, DefaultPasswordLength, commit)
+		r.Wait()
+		return <-ch
+	}
+
+	var stored secret.Login
+	commit := func(name string, login secret.Login) error {
+		stored = login
+		return nil
+	}
+	failCommit := func(name string, login secret.Login) error {
+		return errors.New("database is locked")
+	}
+
+	bmc := &testAccount{user: "root", password: "calvin", changes: 1}
+	res := rotate(bmc, commit)
+	assert.Equal(t, "success", res.Status, res.Msg)
+	assert.Equal(t, "root", res.Username)
+	assert.Equal(t, bmc.password, stored.Password)
+	assert.NotEqual(t, "calvin", stored.Password)
+
+	// Login with the current credentials fails, nothing changed
+	bmc = &testAccount{user: "root", password: "other", changes: 1}
+	res = rotate(bmc, commit)
+	assert.Equal(t, "error", res.Status)
+	assert.False(t, res.Attention)
+	assert.Equal(t, "other", bmc.password)
+
+	// The BMC rejects the new password, the previous one is restored
+	bmc = &testAccount{user: "root", password: "calvin", changes: 2, reject: true}
+	res = rotate(bmc, commit)
+	assert.Equal(t, "error", res.Status)
+	assert.False(t, res.Attention)
+	assert.Contains(t, res.Msg, "restored the previous password")
+	assert.Equal(t, "calvin", bmc.password)
+
+	// The commit fails, the previous password is restored
+	bmc = &testAccount{user: "root", password: "calvin", changes: 2}
+	res = rotate(bmc, failCommit)
+	assert.Equal(t, "error", res.Status)
+	assert.False(t, res.Attention)
+	assert.Equal(t, "calvin", bmc.password)
+
+	// The commit and the rollback fail
+	bmc = &testAccount{user: "root", password: "calvin", changes: 1}
+	res = rotate(bmc, failCommit)
+	assert.Equal(t, "error", res.Status)
+	assert.True(t, res.Attention)
+	assert.NotEqual(t, "calvin", bmc.password)
+}
@@ -8,12 +8,15 @@ import (
 	"errors"
 	"fmt"
 	"net"
+	"net/netip"
+	"slices"
+	"strings"
 	"time"
 
-	"github.com/spf13/viper"
 	"github.com/stmcginnis/gofish"
 	"github.com/stmcginnis/gofish/oem/dell"
 	"github.com/stmcginnis/gofish/schemas"
+	"github.com/ubccr/grendel/internal/config"
 	"github.com/ubccr/grendel/internal/util"
 	"github.com/ubccr/grendel/pkg/model"
 )
@@ -43,6 +46,34 @@ func (r *Redfish) PowerControl(resetType schemas.ResetType, bootOverride schemas
 	return nil
 }
 
+// PowerState returns the power state of the first system
+func (r *Redfish) PowerState() (schemas.PowerState, error) {
+	ss, err := r.service.Systems()
+	if err != nil {
+		return "", err
+	}
+
+	if len(ss) == 0 {
+		return "", errors.New("failed to find system")
+	}
+
+	return ss[0].PowerState, nil
+}
+
+// SimplePowerState maps a redfish power state to model.PowerStateOn, PowerStateOff
+// or PowerStateUnknown. Systems powering on or off are reported in the state
+// they are moving to
+func SimplePowerState(state schemas.PowerState) string {
+	switch state {
+	case schemas.OnPowerState, schemas.PoweringOnPowerState, schemas.PausedPowerState:
+		return model.PowerStateOn
+	case schemas.OffPowerState, schemas.PoweringOffPowerState:
+		return model.PowerStateOff
+	}
+
+	return model.PowerStateUnknown
+}
+
 // bootOverride will set the boot override target
 func (r *Redfish) bootOverride(bootOption schemas.BootSource) error {
 	if bootOption == schemas.NoneBootSource {
@@ -68,6 +99,61 @@ func (r *Redfish) bootOverride(bootOption schemas.BootSource) error {
 	return nil
 }
 
+// SetBootOverride sets the boot source override target of every system in
+// UEFI mode for the next boot, or every boot if persistent is set. The systems
+// are read back to check the BMC applied the override
+func (r *Redfish) SetBootOverride(target schemas.BootSource, persistent bool) error {
+	boot := schemas.Boot{
+		BootSourceOverrideTarget:  target,
+		BootSourceOverrideEnabled: schemas.OnceBootSourceOverrideEnabled,
+		BootSourceOverrideMode:    schemas.UEFIBootSourceOverrideMode,
+	}
+	if persistent {
+		boot.BootSourceOverrideEnabled = schemas.ContinuousBootSourceOverrideEnabled
+	}
+
+	ss, err := r.service.Systems()
+	if err != nil {
+		return err
+	}
+
+	if len(ss) == 0 {
+		return errors.New("failed to find system")
+	}
+
+	for _, s := range ss {
+		err := s.SetBoot(&boot)
+		if err != nil {
+			return err
+		}
+	}
+
+	ss, err = r.service.Systems()
+	if err != nil {
+		return err
+	}
+
+	for _, s := range ss {
+		err := checkBootOverride(s.Boot, boot)
+		if err != nil {
+			return fmt.Errorf("system %s: %w", s.ID, err)
+		}
+	}
+
+	return nil
+}
+
+// checkBootOverride returns an error if the boot override target or enabled
+// setting of got differs from want
+func checkBootOverride(got, want schemas.Boot) error {
+	if got.BootSourceOverrideTarget != want.BootSourceOverrideTarget || got.BootSourceOverrideEnabled != want.BootSourceOverrideEnabled {
+		return fmt.Errorf("boot override not applied: target is %q enabled %q, wanted %q enabled %q",
+			got.BootSourceOverrideTarget, got.BootSourceOverrideEnabled, want.BootSourceOverrideTarget, want.BootSourceOverrideEnabled)
+	}
+
+	return nil
+}
+
 func (r *Redfish) GetSystem() (*model.RedfishSystem, error) {
 	ss, err := r.service.Systems()
 	if err != nil {
@@ -100,9 +186,141 @@ func (r *Redfish) GetSystem() (*model.RedfishSystem, error) {
 		OEMDell:        dcs.OEMSystem,
 	}
 
+	system.BMCFirmware, system.NICFirmware = r.firmwareVersions()
+
 	return system, nil
 }
 
+// GetHardware walks the processors, memory, ethernet interfaces and storage
+// of the first system. Resources the BMC fails to report are recorded in
+// Missing so hosts with partial redfish implementations keep what was found
+func (r *Redfish) GetHardware() (*model.Hardware, error) {
+	ss, err := r.service.Systems()
+	if err != nil {
+		return nil, err
+	}
+
+	if len(ss) == 0 {
+		return nil, errors.New("failed to find system")
+	}
+
+	sys := ss[0]
+	hw := &model.Hardware{}
+
+	processors, err := sys.Processors()
+	if err != nil {
+		hw.Missing = append(hw.Missing, model.HardwareResourceProcessors)
+	}
+	for _, p := range processors {
+		if p.Status.State == schemas.AbsentState || (p.ProcessorType != "" && p.ProcessorType != schemas.CPUProcessorType) {
+			continue
+		}
+		if hw.CPUModel == "" {
+			hw.CPUModel = p.Model
+		}
+		hw.CPUCount++
+		hw.CoreCount += gofish.Deref(p.TotalCores)
+	}
+
+	memory, err := sys.Memory()
+	if err != nil {
+		hw.Missing = append(hw.Missing, model.HardwareResourceMemory)
+	}
+	for _, m := range memory {
+		size := gofish.Deref(m.CapacityMiB)
+		if m.Status.State == schemas.AbsentState || size == 0 {
+			continue
+		}
+		slot := m.DeviceLocator
+		if slot == "" {
+			slot = m.ID
+		}
+		hw.DIMMs = append(hw.DIMMs, model.HardwareDIMM{Slot: slot, SizeMiB: size})
+		hw.MemoryMiB += size
+	}
+	if hw.MemoryMiB == 0 {
+		hw.MemoryMiB = int(gofish.Deref(sys.MemorySummary.TotalSystemMemoryGiB) * 1024)
+	}
+
+	nics, err := sys.EthernetInterfaces()
+	if err != nil {
+		hw.Missing = append(hw.Missing, model.HardwareResourceNICs)
+	}
+	for _, n := range nics {
+		mac := n.PermanentMACAddress
+		if mac == "" {
+			mac = n.MACAddress
+		}
+		if mac == "" {
+			continue
+		}
+		hw.NICs = append(hw.NICs, model.HardwareNIC{Name: n.ID, MAC: strings.ToLower(mac)})
+	}
+
+	storage, err := sys.Storage()
+	if err != nil {
+		hw.Missing = append(hw.Missing, model.HardwareResourceStorage)
+	}
+	for _, st := range storage {
+		drives, err := st.Drives()
+		if err != nil {
+			if !slices.Contains(hw.Missing, model.HardwareResourceStorage) {
+				hw.Missing = append(hw.Missing, model.HardwareResourceStorage)
+			}
+			continue
+		}
+		for _, d := range drives {
+			if d.Status.State == schemas.AbsentState {
+				continue
+			}
+			hw.Disks = append(hw.Disks, model.HardwareDisk{
+				Name:      d.ID,
+				Model:     strings.TrimSpace(d.Model),
+				Serial:    strings.TrimSpace(d.SerialNumber),
+				SizeBytes: int64(gofish.Deref(d.CapacityBytes)),
+			})
+		}
+	}
+
+	return hw, nil
+}
+
+// firmwareVersions returns the firmware version of the BMC and of the network
+// adapters keyed by adapter ID. Versions the BMC fails to report are left
+// empty as not all BMCs implement the managers and network adapters
+func (r *Redfish) firmwareVersions() (string, map[string]string) {
+	bmcFirmware := ""
+	ms, err := r.service.Managers()
+	if err == nil && len(ms) > 0 {
+		bmcFirmware = ms[0].FirmwareVersion
+	}
+
+	var nicFirmware map[string]string
+	cs, err := r.service.Chassis()
+	if err != nil {
+		return bmcFirmware, nil
+	}
+	for _, c := range cs {
+		adapters, err := c.NetworkAdapters()
+		if err != nil {
+			continue
+		}
+		for _, a := range adapters {
+			for _, ctrl := range a.Controllers {
+				if ctrl.FirmwarePackageVersion == "" {
+					continue
+				}
+				if nicFirmware == nil {
+					nicFirmware = make(map[string]string)
+				}
+				nicFirmware[a.ID] = ctrl.FirmwarePackageVersion
+			}
+		}
+	}
+
+	return bmcFirmware, nicFirmware
+}
+
 func (r *Redfish) GetJobInfo(jid string) (*schemas.Job, error) {
 	js, err := r.service.JobService()
 	if err != nil {
@@ -249,7 +467,7 @@ func (r *Redfish) BmcAutoConfigure() error {
 func (r *Redfish) BmcImportConfiguration(st, path, file string) (string, error) {
 	shareType := dell.HTTPISCShareType
 
-	if viper.IsSet("provision.cert") {
+	if config.Viper().IsSet("provision.cert") {
 		shareType = dell.HTTPSISCShareType
 	}
 
@@ -270,22 +488,22 @@ func (r *Redfish) BmcImportConfiguration(st, path, file string) (string, error)
 	}
 
 	ip := rawip.String()
-	lip, port, err := net.SplitHostPort(viper.GetString("provision.listen"))
+	lip, port, err := net.SplitHostPort(config.Viper().GetString("provision.listen"))
 	if err != nil {
 		return "", err
 	}
 
-	if lip != "0.0.0.0" {
+	if addr, err := netip.ParseAddr(lip); err == nil && !addr.IsUnspecified() {
 		ip = lip
 	}
 
-	cip := viper.GetString("bmc.config_share_ip")
+	cip := config.Viper().GetString("bmc.config_share_ip")
 	if cip != "" {
 		ip = cip
 	}
 
 	icw := dell.DisabledISCIgnoreCertificateWarning
-	if viper.GetString("bmc.config_ignore_certificate_warning") == "Enabled" {
+	if config.Viper().GetString("bmc.config_ignore_certificate_warning") == "Enabled" {
 		icw = dell.EnabledISCIgnoreCertificateWarning
 	}
 
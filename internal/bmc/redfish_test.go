@@ -22,7 +22,7 @@ func TestRedfish(t *testing.T) {
 
 	r, err := NewRedfishClient(endpoint, user, pass, true)
 	assert.Nil(t, err)
-	defer r.client.Logout()
+	defer r.Logout()
 
 	system, err := r.GetSystem()
 	assert.Nil(t, err)
@@ -6,39 +6,151 @@ package bmc
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
+	"strings"
+	"sync"
+	"time"
 
 	"github.com/korovkin/limiter"
-	"github.com/spf13/viper"
 	"github.com/stmcginnis/gofish/oem/dell"
 	"github.com/stmcginnis/gofish/schemas"
+	"github.com/ubccr/grendel/internal/config"
+	"github.com/ubccr/grendel/internal/secret"
+	"github.com/ubccr/grendel/internal/store"
 	"github.com/ubccr/grendel/pkg/model"
 )
 
 type jobRunner struct {
 	limit    *limiter.ConcurrencyLimiter
+	creds    CredentialSource
 	user     string
 	pass     string
 	insecure bool
+	timeout  time.Duration
+	protocol string
+
+	// dialAccount logs in to a BMC to change account passwords
+	dialAccount func(ip, user, pass string) (accountClient, error)
 }
 
+// protocolCache holds the protocol which last served the BMC at an address,
+// which is tried first by connectPower
+var protocolCache sync.Map
+
 func newJobRunner(j *Job) *jobRunner {
-	user := viper.GetString("bmc.user")
-	pass := viper.GetString("bmc.password")
-	insecure := viper.GetBool("bmc.insecure")
+	user := config.Viper().GetString("bmc.user")
+	pass := config.Viper().GetString("bmc.password")
+	insecure := config.Viper().GetBool("bmc.insecure")
 
-	return &jobRunner{
+	r := &jobRunner{
 		limit:    limiter.NewConcurrencyLimiter(j.fanout),
+		creds:    j.creds,
 		user:     user,
 		pass:     pass,
 		insecure: insecure,
+		timeout:  j.timeout,
+		protocol: config.Viper().GetString("bmc.protocol"),
 	}
+	r.dialAccount = r.dialAccountRedfish
+
+	return r
 }
 
 func (r *jobRunner) Wait() {
 	r.limit.Wait()
 }
 
+// login returns the BMC username and password of host. Hosts without stored
+// credentials use bmc.user and bmc.password
+func (r *jobRunner) login(host *model.Host) (string, string, error) {
+	if r.creds == nil {
+		return r.user, r.pass, nil
+	}
+
+	cred, err := r.creds.LoadCredential(host.Name, model.CredentialKindBMC)
+	if errors.Is(err, store.ErrNotFound) {
+		return r.user, r.pass, nil
+	}
+	if err != nil {
+		return "", "", err
+	}
+
+	login, err := secret.Open(cred.Secret)
+	if err != nil {
+		return "", "", err
+	}
+
+	return login.Username, login.Password, nil
+}
+
+// connect opens a redfish session to the BMC of host at ip. Requests fail
+// once the job timeout has passed
+func (r *jobRunner) connect(host *model.Host, ip string) (*Redfish, error) {
+	user, pass, err := r.login(host)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewRedfishClientTimeout(ip, user, pass, r.insecure, r.timeout)
+}
+
+// connectPower opens a session to the BMC of host at ip for power and boot
+// control. With bmc.protocol set to auto Redfish is tried first and IPMI
+// second, unless IPMI served the BMC last. Both share the job timeout
+func (r *jobRunner) connectPower(host *model.Host, ip string) (PowerClient, error) {
+	user, pass, err := r.login(host)
+	if err != nil {
+		return nil, err
+	}
+
+	var protocols []string
+	switch r.protocol {
+	case ProtocolRedfish, ProtocolIPMI:
+		protocols = []string{r.protocol}
+	case ProtocolAuto, "":
+		protocols = []string{ProtocolRedfish, ProtocolIPMI}
+		if p, ok := protocolCache.Load(ip); ok && p == ProtocolIPMI {
+			protocols = []string{ProtocolIPMI, ProtocolRedfish}
+		}
+	default:
+		return nil, fmt.Errorf("invalid bmc.protocol %q, expected auto, redfish or ipmi", r.protocol)
+	}
+
+	var deadline time.Time
+	if r.timeout > 0 {
+		deadline = time.Now().Add(r.timeout)
+	}
+
+	errs := make([]string, 0, len(protocols))
+	for _, p := range protocols {
+		timeout := time.Duration(0)
+		if !deadline.IsZero() {
+			timeout = time.Until(deadline)
+			if timeout <= 0 {
+				break
+			}
+		}
+
+		var c PowerClient
+		switch p {
+		case ProtocolRedfish:
+			c, err = NewRedfishClientTimeout(ip, user, pass, r.insecure, timeout)
+		case ProtocolIPMI:
+			c, err = NewIPMIClientTimeout(ip, user, pass, timeout)
+		}
+		if err == nil {
+			protocolCache.Store(ip, p)
+			return c, nil
+		}
+
+		log.Debugf("failed to connect to %s using %s: %s", host.Name, p, err)
+		errs = append(errs, fmt.Sprintf("%s: %s", p, err))
+	}
+
+	return nil, errors.New(strings.Join(errs, "; "))
+}
+
 func (r *jobRunner) RunPowerControl(host *model.Host, ch chan model.JobMessage, bootOverride schemas.BootSource, powerOption schemas.ResetType) {
 	r.limit.Execute(func() {
 		m := model.JobMessage{Status: "error", Host: host.Name}
@@ -52,13 +164,13 @@ func (r *jobRunner) RunPowerControl(host *model.Host, ch chan model.JobMessage,
 			m.Msg = "failed to find bmc interface to query"
 			return
 		}
-		r, err := NewRedfishClient(ip, r.user, r.pass, r.insecure)
+		r, err := r.connectPower(host, ip)
 		if err != nil {
 			m.Msg = fmt.Sprintf("%s", err)
 			return
 		}
 
-		defer r.client.Logout()
+		defer r.Logout()
 
 		err = r.PowerControl(powerOption, bootOverride)
 		if err != nil {
@@ -71,6 +183,71 @@ func (r *jobRunner) RunPowerControl(host *model.Host, ch chan model.JobMessage,
 	})
 }
 
+func (r *jobRunner) RunPowerStatus(host *model.Host, ch chan model.JobMessage) {
+	r.limit.Execute(func() {
+		m := model.JobMessage{Status: "error", Host: host.Name, Data: model.PowerStateUnknown}
+		defer func() { ch <- m }()
+
+		bmc := host.InterfaceBMC()
+		ip := ""
+		if bmc != nil {
+			ip = bmc.AddrString()
+		} else {
+			m.Msg = "failed to find bmc interface to query"
+			return
+		}
+		r, err := r.connectPower(host, ip)
+		if err != nil {
+			m.Msg = fmt.Sprintf("%s", err)
+			return
+		}
+
+		defer r.Logout()
+
+		state, err := r.PowerState()
+		if err != nil {
+			m.Msg = fmt.Sprintf("%s", err)
+			return
+		}
+
+		m.Status = "success"
+		m.Msg = string(state)
+		m.Data = SimplePowerState(state)
+	})
+}
+
+func (r *jobRunner) RunBootOverride(host *model.Host, ch chan model.JobMessage, target schemas.BootSource, persistent bool) {
+	r.limit.Execute(func() {
+		m := model.JobMessage{Status: "error", Host: host.Name}
+		defer func() { ch <- m }()
+
+		bmc := host.InterfaceBMC()
+		ip := ""
+		if bmc != nil {
+			ip = bmc.AddrString()
+		} else {
+			m.Msg = "failed to find bmc interface to query"
+			return
+		}
+		r, err := r.connectPower(host, ip)
+		if err != nil {
+			m.Msg = fmt.Sprintf("%s", err)
+			return
+		}
+
+		defer r.Logout()
+
+		err = r.SetBootOverride(target, persistent)
+		if err != nil {
+			m.Msg = fmt.Sprintf("%s", err)
+			return
+		}
+
+		m.Status = "success"
+		m.Msg = fmt.Sprintf("Set boot override to %s", target)
+	})
+}
+
 func (r *jobRunner) RunBmcStatus(host *model.Host, ch chan model.JobMessage) {
 	r.limit.Execute(func() {
 		m := model.JobMessage{Status: "error", Host: host.Name}
@@ -85,13 +262,13 @@ func (r *jobRunner) RunBmcStatus(host *model.Host, ch chan model.JobMessage) {
 			m.Msg = "failed to find bmc interface to query"
 			return
 		}
-		r, err := NewRedfishClient(ip, r.user, r.pass, r.insecure)
+		r, err := r.connect(host, ip)
 		if err != nil {
 			m.Msg = fmt.Sprintf("%s", err)
 			return
 		}
 
-		defer r.client.Logout()
+		defer r.Logout()
 
 		data, err = r.GetSystem()
 		if err != nil {
@@ -111,6 +288,196 @@ func (r *jobRunner) RunBmcStatus(host *model.Host, ch chan model.JobMessage) {
 	})
 }
 
+func (r *jobRunner) RunHardware(host *model.Host, ch chan model.JobMessage) {
+	r.limit.Execute(func() {
+		m := model.JobMessage{Status: "error", Host: host.Name}
+		defer func() { ch <- m }()
+
+		bmc := host.InterfaceBMC()
+		ip := ""
+		if bmc != nil {
+			ip = bmc.AddrString()
+		} else {
+			m.Msg = "failed to find bmc interface to query"
+			return
+		}
+		r, err := r.connect(host, ip)
+		if err != nil {
+			m.Msg = fmt.Sprintf("%s", err)
+			return
+		}
+
+		defer r.Logout()
+
+		hw, err := r.GetHardware()
+		if err != nil {
+			m.Msg = fmt.Sprintf("%s", err)
+			return
+		}
+
+		output, err := json.Marshal(hw)
+		if err != nil {
+			m.Msg = fmt.Sprintf("%s", err)
+			return
+		}
+
+		m.Status = "success"
+		m.Msg = string(output)
+	})
+}
+
+// RunVirtualMedia connects to the BMC of host and calls action, which sets
+// the message of a successful run
+func (r *jobRunner) RunVirtualMedia(host *model.Host, ch chan model.JobMessage, action func(*Redfish, *model.JobMessage) error) {
+	r.limit.Execute(func() {
+		m := model.JobMessage{Status: "error", Host: host.Name}
+		defer func() { ch <- m }()
+
+		bmc := host.InterfaceBMC()
+		ip := ""
+		if bmc != nil {
+			ip = bmc.AddrString()
+		} else {
+			m.Msg = "failed to find bmc interface to query"
+			return
+		}
+		r, err := r.connect(host, ip)
+		if err != nil {
+			m.Msg = fmt.Sprintf("%s", err)
+			return
+		}
+
+		defer r.Logout()
+
+		err = action(r, &m)
+		if err != nil {
+			m.Msg = fmt.Sprintf("%s", err)
+			return
+		}
+
+		m.Status = "success"
+	})
+}
+
+// RunBios connects to the BMC of host and calls action, returning the BIOS
+// report as JSON in the message of a successful run
+func (r *jobRunner) RunBios(host *model.Host, ch chan model.JobMessage, action func(*Redfish) (*model.BiosReport, error)) {
+	r.limit.Execute(func() {
+		m := model.JobMessage{Status: "error", Host: host.Name}
+		defer func() { ch <- m }()
+
+		bmc := host.InterfaceBMC()
+		ip := ""
+		if bmc != nil {
+			ip = bmc.AddrString()
+		} else {
+			m.Msg = "failed to find bmc interface to query"
+			return
+		}
+		r, err := r.connect(host, ip)
+		if err != nil {
+			m.Msg = fmt.Sprintf("%s", err)
+			return
+		}
+
+		defer r.Logout()
+
+		report, err := action(r)
+		if err != nil {
+			m.Msg = fmt.Sprintf("%s", err)
+			return
+		}
+
+		output, err := json.Marshal(report)
+		if err != nil {
+			m.Msg = fmt.Sprintf("%s", err)
+			return
+		}
+
+		m.Status = "success"
+		m.Msg = string(output)
+	})
+}
+
+// RunFirmwareUpdate updates the firmware of component on host to version
+// unless it is already on it. The new version is read back from the BMC,
+// which is reconnected to as it may reset. The whole update must finish
+// within the job timeout
+func (r *jobRunner) RunFirmwareUpdate(host *model.Host, ch chan model.JobMessage, image *FirmwareImage, component, version string) {
+	r.limit.Execute(func() {
+		m := model.JobMessage{Status: "error", Host: host.Name}
+		defer func() { ch <- m }()
+
+		bmc := host.InterfaceBMC()
+		ip := ""
+		if bmc != nil {
+			ip = bmc.AddrString()
+		} else {
+			m.Msg = "failed to find bmc interface to query"
+			return
+		}
+
+		deadline := time.Now().Add(r.timeout)
+		user, pass, err := r.login(host)
+		if err != nil {
+			m.Msg = fmt.Sprintf("%s", err)
+			return
+		}
+
+		c, err := NewRedfishClientTimeout(ip, user, pass, r.insecure, r.timeout)
+		if err != nil {
+			m.Msg = fmt.Sprintf("%s", err)
+			return
+		}
+
+		report, err := func() (*model.FirmwareUpdateReport, error) {
+			defer c.Logout()
+
+			inv, err := c.Inventory()
+			if err != nil {
+				return nil, err
+			}
+
+			versions, _ := inv.Versions(component)
+			report := &model.FirmwareUpdateReport{
+				Component: component,
+				Previous:  strings.Join(versions, ", "),
+				Version:   version,
+				Inventory: inv,
+			}
+			if ok, _ := inv.Complies(component, version); ok {
+				report.Skipped = true
+				report.Msg = fmt.Sprintf("%s firmware already at version %s", component, version)
+				return report, nil
+			}
+
+			return report, c.UpdateFirmware(image)
+		}()
+		if err != nil {
+			m.Msg = fmt.Sprintf("%s", err)
+			return
+		}
+
+		if !report.Skipped {
+			report.Inventory, err = r.waitFirmwareVersion(ip, user, pass, component, version, deadline)
+			if err != nil {
+				m.Msg = fmt.Sprintf("%s", err)
+				return
+			}
+			report.Msg = fmt.Sprintf("updated %s firmware from %s to %s", component, report.Previous, version)
+		}
+
+		output, err := json.Marshal(report)
+		if err != nil {
+			m.Msg = fmt.Sprintf("%s", err)
+			return
+		}
+
+		m.Status = "success"
+		m.Msg = string(output)
+	})
+}
+
 func (r *jobRunner) RunGetJobs(host *model.Host, ch chan model.JobMessage) {
 	r.limit.Execute(func() {
 		m := model.JobMessage{Status: "error", Host: host.Name}
@@ -124,13 +491,13 @@ func (r *jobRunner) RunGetJobs(host *model.Host, ch chan model.JobMessage) {
 			m.Msg = "failed to find bmc interface to query"
 			return
 		}
-		r, err := NewRedfishClient(ip, r.user, r.pass, r.insecure)
+		r, err := r.connect(host, ip)
 		if err != nil {
 			m.Msg = fmt.Sprintf("%s", err)
 			return
 		}
 
-		defer r.client.Logout()
+		defer r.Logout()
 
 		data := model.RedfishJob{}
 		data.Jobs, err = r.GetJobs()
@@ -165,13 +532,13 @@ func (r *jobRunner) RunClearJobs(host *model.Host, ch chan model.JobMessage, ids
 			m.Msg = "failed to find bmc interface to query"
 			return
 		}
-		r, err := NewRedfishClient(ip, r.user, r.pass, r.insecure)
+		r, err := r.connect(host, ip)
 		if err != nil {
 			m.Msg = fmt.Sprintf("%s", err)
 			return
 		}
 
-		defer r.client.Logout()
+		defer r.Logout()
 
 		err = r.ClearJobs(ids)
 		if err != nil {
@@ -197,13 +564,13 @@ func (r *jobRunner) RunPowerCycleBmc(host *model.Host, ch chan model.JobMessage)
 			m.Msg = "failed to find bmc interface to query"
 			return
 		}
-		r, err := NewRedfishClient(ip, r.user, r.pass, r.insecure)
+		r, err := r.connect(host, ip)
 		if err != nil {
 			m.Msg = fmt.Sprintf("%s", err)
 			return
 		}
 
-		defer r.client.Logout()
+		defer r.Logout()
 
 		err = r.PowerCycleBmc()
 		if err != nil {
@@ -228,13 +595,13 @@ func (r *jobRunner) RunClearSel(host *model.Host, ch chan model.JobMessage) {
 			m.Msg = "failed to find bmc interface to query"
 			return
 		}
-		r, err := NewRedfishClient(ip, r.user, r.pass, r.insecure)
+		r, err := r.connect(host, ip)
 		if err != nil {
 			m.Msg = fmt.Sprintf("%s", err)
 			return
 		}
 
-		defer r.client.Logout()
+		defer r.Logout()
 
 		err = r.ClearSel()
 		if err != nil {
@@ -259,13 +626,13 @@ func (r *jobRunner) RunBmcAutoConfigure(host *model.Host, ch chan model.JobMessa
 			m.Msg = "failed to find bmc interface to query"
 			return
 		}
-		r, err := NewRedfishClient(ip, r.user, r.pass, r.insecure)
+		r, err := r.connect(host, ip)
 		if err != nil {
 			m.Msg = fmt.Sprintf("%s", err)
 			return
 		}
 
-		defer r.client.Logout()
+		defer r.Logout()
 
 		err = r.BmcAutoConfigure()
 		if err != nil {
@@ -298,13 +665,13 @@ func (r *jobRunner) RunBmcImportConfiguration(host *model.Host, ch chan model.Jo
 		}
 		path := fmt.Sprintf("/boot/%s/bmc", token)
 
-		r, err := NewRedfishClient(ip, r.user, r.pass, r.insecure)
+		r, err := r.connect(host, ip)
 		if err != nil {
 			m.Msg = fmt.Sprintf("%s", err)
 			return
 		}
 
-		defer r.client.Logout()
+		defer r.Logout()
 
 		jid, err := r.BmcImportConfiguration(shutdownType, path, file)
 		if err != nil {
@@ -331,13 +698,13 @@ func (r *jobRunner) RunBmcGetMetricReports(host *model.Host, ch chan model.JobMe
 			return
 		}
 
-		r, err := NewRedfishClient(ip, r.user, r.pass, r.insecure)
+		r, err := r.connect(host, ip)
 		if err != nil {
 			m.Msg = err.Error()
 			return
 		}
 
-		defer r.client.Logout()
+		defer r.Logout()
 
 		reports, err := r.BmcGetMetricReports()
 		if err != nil {
@@ -369,13 +736,13 @@ func (jr *jobRunner) RunDellInstallFromRepo(host *model.Host, ch chan model.JobM
 			m.Msg = "failed to find bmc interface to query"
 			return
 		}
-		r, err := NewRedfishClient(ip, jr.user, jr.pass, jr.insecure)
+		r, err := jr.connect(host, ip)
 		if err != nil {
 			m.Msg = fmt.Sprintf("%s", err)
 			return
 		}
 
-		defer r.client.Logout()
+		defer r.Logout()
 
 		res, err := r.DellInstallFromRepo(installBody)
 		if err != nil {
@@ -407,13 +774,13 @@ func (jr *jobRunner) RunDellGetRepoUpdateList(host *model.Host, ch chan model.Jo
 			m.Msg = "failed to find bmc interface to query"
 			return
 		}
-		r, err := NewRedfishClient(ip, jr.user, jr.pass, jr.insecure)
+		r, err := jr.connect(host, ip)
 		if err != nil {
 			m.Msg = fmt.Sprintf("%s", err)
 			return
 		}
 
-		defer r.client.Logout()
+		defer r.Logout()
 
 		ul, err := r.DellGetRepoUpdateList()
 		if err != nil {
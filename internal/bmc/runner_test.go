@@ -0,0 +1,126 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package bmc
+
+import (
+	"testing"
+	"time"
+
+	"github.com/spf13/viper"
+	"github.com/stmcginnis/gofish/schemas"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/ubccr/grendel/internal/secret"
+	"github.com/ubccr/grendel/internal/store"
+	"github.com/ubccr/grendel/pkg/model"
+)
+
+type testCredentials map[string]*model.Credential
+
+func (tc testCredentials) LoadCredential(name, kind string) (*model.Credential, error) {
+	if c, ok := tc[name]; ok && c.Kind == kind {
+		return c, nil
+	}
+
+	return nil, store.ErrNotFound
+}
+
+func TestRunnerLogin(t *testing.T) {
+	viper.Reset()
+	defer viper.Reset()
+	viper.Set("credentials_key", "test")
+	viper.Set("bmc.user", "default")
+	viper.Set("bmc.password", "defaultpw")
+	viper.Set("bmc.fanout", 1)
+
+	sealed, err := secret.Seal(secret.Login{Username: "admin", Password: "hunter2"})
+	require.NoError(t, err)
+
+	creds := testCredentials{
+		"cpn-01": {Name: "cpn-01", Kind: model.CredentialKindBMC, Secret: sealed},
+		"cpn-03": {Name: "cpn-03", Kind: model.CredentialKindBMC, Secret: "v1:bad"},
+	}
+	r := newJobRunner(NewJob(creds))
+
+	user, pass, err := r.login(&model.Host{Name: "cpn-01"})
+	if assert.NoError(t, err) {
+		assert.Equal(t, "admin", user)
+		assert.Equal(t, "hunter2", pass)
+	}
+
+	user, pass, err = r.login(&model.Host{Name: "cpn-02"})
+	if assert.NoError(t, err) {
+		assert.Equal(t, "default", user)
+		assert.Equal(t, "defaultpw", pass)
+	}
+
+	_, _, err = r.login(&model.Host{Name: "cpn-03"})
+	assert.ErrorIs(t, err, secret.ErrDecrypt)
+
+	// Without a credential source the defaults are used
+	user, _, err = newJobRunner(NewJob(nil)).login(&model.Host{Name: "cpn-01"})
+	if assert.NoError(t, err) {
+		assert.Equal(t, "default", user)
+	}
+}
+
+func TestPowerStatus(t *testing.T) {
+	viper.Reset()
+	defer viper.Reset()
+
+	assert.Equal(t, model.PowerStateOn, SimplePowerState(schemas.OnPowerState))
+	assert.Equal(t, model.PowerStateOn, SimplePowerState(schemas.PoweringOnPowerState))
+	assert.Equal(t, model.PowerStateOff, SimplePowerState(schemas.OffPowerState))
+	assert.Equal(t, model.PowerStateOff, SimplePowerState(schemas.PoweringOffPowerState))
+	assert.Equal(t, model.PowerStateUnknown, SimplePowerState(""))
+
+	viper.Set("bmc.fanout", 5)
+	viper.Set("bmc.timeout", 60)
+	job := NewJob(nil)
+	job.SetFanout(0)
+	job.SetTimeout(-time.Second)
+	assert.Equal(t, 5, job.fanout)
+	assert.Equal(t, time.Minute, job.timeout)
+
+	// Hosts without a BMC interface are reported as unknown
+	res, err := job.PowerStatus(model.HostList{{Name: "cpn-01"}})
+	require.NoError(t, err)
+	if assert.Len(t, res, 1) {
+		assert.Equal(t, "error", res[0].Status)
+		assert.Equal(t, model.PowerStateUnknown, res[0].Data)
+	}
+}
+
+func TestCheckBootOverride(t *testing.T) {
+	want := schemas.Boot{
+		BootSourceOverrideTarget:  schemas.PxeBootSource,
+		BootSourceOverrideEnabled: schemas.OnceBootSourceOverrideEnabled,
+	}
+
+	assert.NoError(t, checkBootOverride(want, want))
+
+	got := want
+	got.BootSourceOverrideTarget = schemas.HddBootSource
+	assert.ErrorContains(t, checkBootOverride(got, want), `target is "Hdd"`)
+
+	got = want
+	got.BootSourceOverrideEnabled = schemas.DisabledBootSourceOverrideEnabled
+	assert.Error(t, checkBootOverride(got, want))
+}
+
+func TestTaskError(t *testing.T) {
+	task := &schemas.Task{
+		TaskState: schemas.CompletedTaskState,
+	}
+	assert.NoError(t, taskError(task))
+
+	task.ID = "JID_123"
+	task.TaskState = schemas.ExceptionTaskState
+	task.Messages = []schemas.Message{
+		{Message: "Unable to mount remote share"},
+		{Message: "Check the ISO URL"},
+	}
+	assert.EqualError(t, taskError(task), "task JID_123 Exception: Unable to mount remote share; Check the ISO URL")
+}
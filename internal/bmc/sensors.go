@@ -0,0 +1,144 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package bmc
+
+import (
+	"errors"
+
+	"github.com/stmcginnis/gofish/schemas"
+)
+
+const (
+	SensorTemperature = "temperature"
+	SensorFan         = "fan"
+	SensorPower       = "power"
+	SensorPowerSupply = "power_supply"
+)
+
+// SensorReading is the last value of a chassis sensor. Value is in Units,
+// celsius for temperatures, RPM or percent for fans and watts for power.
+// Sensors which report no value only carry their health
+type SensorReading struct {
+	Chassis string
+	Name    string
+	Type    string
+	Units   string
+	Value   *float64
+	Health  schemas.Health
+}
+
+// Sensors walks the Thermal and Power resources of each chassis. Readings of
+// absent components are skipped. Chassis without either resource are ignored
+// unless no chassis reported any sensors
+func (r *Redfish) Sensors() ([]SensorReading, error) {
+	cs, err := r.service.Chassis()
+	if err != nil {
+		return nil, err
+	}
+
+	var readings []SensorReading
+	var errs []error
+	for _, c := range cs {
+		thermal, err := c.Thermal()
+		if err != nil {
+			errs = append(errs, err)
+		}
+		if thermal != nil {
+			for _, t := range thermal.Temperatures {
+				if t.Status.State == schemas.AbsentState {
+					continue
+				}
+				readings = append(readings, SensorReading{
+					Chassis: c.ID,
+					Name:    sensorName(t.Name, t.MemberID),
+					Type:    SensorTemperature,
+					Units:   "celsius",
+					Value:   t.ReadingCelsius,
+					Health:  t.Status.Health,
+				})
+			}
+			for _, f := range thermal.Fans {
+				if f.Status.State == schemas.AbsentState {
+					continue
+				}
+				name := f.Name
+				if name == "" {
+					name = f.FanName
+				}
+				var value *float64
+				if f.Reading != nil {
+					v := float64(*f.Reading)
+					value = &v
+				}
+				units := "rpm"
+				if f.ReadingUnits == schemas.PercentReadingUnits {
+					units = "percent"
+				}
+				readings = append(readings, SensorReading{
+					Chassis: c.ID,
+					Name:    sensorName(name, f.MemberID),
+					Type:    SensorFan,
+					Units:   units,
+					Value:   value,
+					Health:  f.Status.Health,
+				})
+			}
+		}
+
+		power, err := c.Power()
+		if err != nil {
+			errs = append(errs, err)
+		}
+		if power != nil {
+			for _, p := range power.PowerControl {
+				var value *float64
+				if p.PowerConsumedWatts != nil {
+					v := float64(*p.PowerConsumedWatts)
+					value = &v
+				}
+				readings = append(readings, SensorReading{
+					Chassis: c.ID,
+					Name:    sensorName(p.Name, p.MemberID),
+					Type:    SensorPower,
+					Units:   "watts",
+					Value:   value,
+					Health:  p.Status.Health,
+				})
+			}
+			for _, p := range power.PowerSupplies {
+				if p.Status.State == schemas.AbsentState {
+					continue
+				}
+				var value *float64
+				if p.PowerInputWatts != nil {
+					v := float64(*p.PowerInputWatts)
+					value = &v
+				}
+				readings = append(readings, SensorReading{
+					Chassis: c.ID,
+					Name:    sensorName(p.Name, p.MemberID),
+					Type:    SensorPowerSupply,
+					Units:   "watts",
+					Value:   value,
+					Health:  p.Status.Health,
+				})
+			}
+		}
+	}
+
+	if len(readings) == 0 && len(errs) > 0 {
+		return nil, errors.Join(errs...)
+	}
+
+	return readings, nil
+}
+
+func sensorName(name, memberID string) string {
+	if name != "" {
+		return name
+	}
+
+	return memberID
+}
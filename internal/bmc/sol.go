@@ -0,0 +1,340 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package bmc
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"io"
+	"net"
+	"sync"
+	"time"
+)
+
+const (
+	ipmiPayloadSOL = 0x01
+
+	ipmiCmdActivatePayload   = 0x48
+	ipmiCmdDeactivatePayload = 0x49
+
+	// ipmiPayloadActive is the completion code of Activate Payload when SOL
+	// is already active on another session
+	ipmiPayloadActive = 0x80
+
+	// solAuxData requests encrypted and authenticated SOL packets
+	solAuxData = 0xc0
+
+	solStatusNack        = 0x40
+	solStatusDeactivated = 0x10
+
+	// solKeepalive is how often an empty packet is sent while the console is
+	// idle, as BMCs close SOL sessions without traffic
+	solKeepalive = 30 * time.Second
+
+	// solRetryInterval is how long to wait for the BMC to acknowledge
+	// console input before resending it
+	solRetryInterval = time.Second
+)
+
+var ErrSOLDeactivated = errors.New("serial over LAN deactivated by the BMC")
+
+// SOL is a serial over LAN session to the console of a host. Read returns
+// the console output and Write sends console input.
+type SOL struct {
+	ipmi *IPMI
+
+	// mu guards packets sent on the session
+	mu sync.Mutex
+	// wmu serializes Write, which waits for each packet to be acknowledged
+	wmu     sync.Mutex
+	seq     byte
+	maxData int
+
+	// out buffers console output so acknowledgements are received while it
+	// is not read
+	outMu   sync.Mutex
+	outCond *sync.Cond
+	out     bytes.Buffer
+	outErr  error
+
+	acks chan solAck
+	done chan struct{}
+	wg   sync.WaitGroup
+	once sync.Once
+}
+
+type solAck struct {
+	seq      byte
+	accepted byte
+	nack     bool
+}
+
+// ConnectSOL opens an IPMI session to the BMC at ip and activates serial
+// over LAN. SOL active on another session is deactivated first. The session
+// stays open until closed.
+func ConnectSOL(ip, user, pass string) (*SOL, error) {
+	i, err := NewIPMIClientTimeout(ip, user, pass, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	s, err := i.activateSOL()
+	if err != nil {
+		i.Logout()
+		return nil, err
+	}
+
+	return s, nil
+}
+
+func (i *IPMI) activateSOL() (*SOL, error) {
+	resp, err := i.command(ipmiNetFnApp, ipmiCmdActivatePayload, ipmiPayloadSOL, 0x01, solAuxData, 0x00, 0x00, 0x00)
+	var ce *ipmiCommandError
+	if errors.As(err, &ce) && ce.code == ipmiPayloadActive {
+		_, err = i.command(ipmiNetFnApp, ipmiCmdDeactivatePayload, ipmiPayloadSOL, 0x01, 0x00, 0x00, 0x00, 0x00)
+		if err == nil {
+			resp, err = i.command(ipmiNetFnApp, ipmiCmdActivatePayload, ipmiPayloadSOL, 0x01, solAuxData, 0x00, 0x00, 0x00)
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// exchange leaves the read deadline of the last request
+	if err := i.conn.SetReadDeadline(time.Time{}); err != nil {
+		return nil, err
+	}
+
+	s := &SOL{
+		ipmi:    i,
+		maxData: 64,
+		acks:    make(chan solAck, 4),
+		done:    make(chan struct{}),
+	}
+	s.outCond = sync.NewCond(&s.outMu)
+
+	// The response holds the largest payload accepted by the BMC
+	if len(resp) >= 6 {
+		if n := int(binary.LittleEndian.Uint16(resp[4:6])) - 4; n > 0 {
+			s.maxData = n
+		}
+	}
+
+	s.wg.Add(2)
+	go s.receive()
+	go s.keepalive()
+
+	return s, nil
+}
+
+// Read returns console output of the host
+func (s *SOL) Read(p []byte) (int, error) {
+	s.outMu.Lock()
+	defer s.outMu.Unlock()
+
+	for s.out.Len() == 0 && s.outErr == nil {
+		s.outCond.Wait()
+	}
+	if s.out.Len() > 0 {
+		return s.out.Read(p)
+	}
+
+	return 0, s.outErr
+}
+
+// output passes console output to Read, or closes it with err once read
+func (s *SOL) output(data []byte, err error) {
+	s.outMu.Lock()
+	defer s.outMu.Unlock()
+
+	s.out.Write(data)
+	if err != nil && s.outErr == nil {
+		s.outErr = err
+	}
+	s.outCond.Broadcast()
+}
+
+// Write sends p to the console of the host, waiting for the BMC to
+// acknowledge each packet
+func (s *SOL) Write(p []byte) (int, error) {
+	s.wmu.Lock()
+	defer s.wmu.Unlock()
+
+	written := 0
+	for len(p) > 0 {
+		s.seq = s.seq%15 + 1
+		n, err := s.sendData(s.seq, p[:min(len(p), s.maxData)])
+		if err != nil {
+			return written, err
+		}
+		written += n
+		p = p[n:]
+	}
+
+	return written, nil
+}
+
+// Close deactivates serial over LAN and closes the IPMI session
+func (s *SOL) Close() error {
+	s.once.Do(func() {
+		close(s.done)
+		s.output(nil, io.EOF)
+		_ = s.ipmi.conn.SetReadDeadline(time.Now())
+		s.wg.Wait()
+
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		_, _ = s.ipmi.command(ipmiNetFnApp, ipmiCmdDeactivatePayload, ipmiPayloadSOL, 0x01, 0x00, 0x00, 0x00, 0x00)
+		s.ipmi.Logout()
+	})
+
+	return nil
+}
+
+// sendData sends a packet of console input and returns the number of
+// characters accepted by the BMC, resending it until acknowledged
+func (s *SOL) sendData(seq byte, data []byte) (int, error) {
+	for range ipmiRetries {
+		if err := s.send(seq, 0, 0, 0, data); err != nil {
+			return 0, err
+		}
+
+		timer := time.NewTimer(solRetryInterval)
+		ack, ok := s.waitAck(seq, timer.C)
+		timer.Stop()
+		switch {
+		case !ok:
+			continue
+		case ack.nack:
+			// The BMC buffer is full, wait for it to drain
+			time.Sleep(solRetryInterval)
+			continue
+		case ack.accepted == 0 || int(ack.accepted) > len(data):
+			return len(data), nil
+		}
+
+		return int(ack.accepted), nil
+	}
+
+	select {
+	case <-s.done:
+		return 0, io.ErrClosedPipe
+	default:
+	}
+
+	return 0, errors.New("timed out waiting for serial over LAN acknowledgement")
+}
+
+func (s *SOL) waitAck(seq byte, timeout <-chan time.Time) (solAck, bool) {
+	for {
+		select {
+		case ack := <-s.acks:
+			if ack.seq == seq {
+				return ack, true
+			}
+		case <-timeout:
+			return solAck{}, false
+		case <-s.done:
+			return solAck{}, false
+		}
+	}
+}
+
+// send writes a SOL packet. Packets carrying data have a sequence number
+// from 1 to 15 and packets acknowledging BMC data the sequence number of the
+// acknowledged packet in ack
+func (s *SOL) send(seq, ack, accepted, status byte, data []byte) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	select {
+	case <-s.done:
+		return io.ErrClosedPipe
+	default:
+	}
+
+	payload := append([]byte{seq, ack, accepted, status}, data...)
+	pkt, err := s.ipmi.packet(ipmiPayloadSOL, payload)
+	if err != nil {
+		return err
+	}
+
+	_, err = s.ipmi.conn.Write(pkt)
+	return err
+}
+
+// receive reads SOL packets from the BMC, passing console output to Read and
+// acknowledgements of console input to Write
+func (s *SOL) receive() {
+	defer s.wg.Done()
+
+	buf := make([]byte, 1024)
+	var last byte
+	for {
+		n, err := s.ipmi.conn.Read(buf)
+		if err != nil {
+			var ne net.Error
+			select {
+			case <-s.done:
+				return
+			default:
+			}
+			if errors.As(err, &ne) && ne.Timeout() {
+				continue
+			}
+			s.output(nil, err)
+			return
+		}
+
+		ptype, p, err := s.ipmi.parse(buf[:n])
+		if err != nil || ptype != ipmiPayloadSOL || len(p) < 4 {
+			continue
+		}
+
+		seq, ack, accepted, status := p[0], p[1], p[2], p[3]
+		if ack != 0 {
+			select {
+			case s.acks <- solAck{seq: ack, accepted: accepted, nack: status&solStatusNack != 0}:
+			default:
+			}
+		}
+		if status&solStatusDeactivated != 0 {
+			s.output(nil, ErrSOLDeactivated)
+			return
+		}
+		if seq == 0 {
+			continue
+		}
+
+		data := p[4:]
+		_ = s.send(0, seq, byte(len(data)), 0, nil)
+
+		// Packets are resent by the BMC until acknowledged
+		if seq == last {
+			continue
+		}
+		last = seq
+
+		if len(data) > 0 {
+			s.output(data, nil)
+		}
+	}
+}
+
+func (s *SOL) keepalive() {
+	defer s.wg.Done()
+
+	t := time.NewTicker(solKeepalive)
+	defer t.Stop()
+	for {
+		select {
+		case <-s.done:
+			return
+		case <-t.C:
+			_ = s.send(0, 0, 0, 0, nil)
+		}
+	}
+}
@@ -0,0 +1,231 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package bmc
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/stmcginnis/gofish"
+	"github.com/stmcginnis/gofish/schemas"
+)
+
+// vmediaPollInterval is how often the virtual media and BMC tasks are checked
+// while waiting for an insert or eject to finish
+const vmediaPollInterval = 2 * time.Second
+
+// virtualMedia returns the CD or DVD virtual media device of the first
+// system. BMCs attaching virtual media to the manager instead of the system,
+// such as the iDRAC, are checked second
+func (r *Redfish) virtualMedia() (*schemas.VirtualMedia, error) {
+	var devices []*schemas.VirtualMedia
+	ss, err := r.service.Systems()
+	if err == nil && len(ss) > 0 {
+		devices, _ = ss[0].VirtualMedia()
+	}
+
+	if len(devices) == 0 {
+		ms, err := r.service.Managers()
+		if err != nil {
+			return nil, err
+		}
+		for _, m := range ms {
+			vms, err := m.VirtualMedia()
+			if err != nil {
+				continue
+			}
+			devices = append(devices, vms...)
+		}
+	}
+
+	for _, vm := range devices {
+		if slices.Contains(vm.MediaTypes, schemas.CDVirtualMediaType) || slices.Contains(vm.MediaTypes, schemas.DVDVirtualMediaType) {
+			return vm, nil
+		}
+	}
+
+	return nil, errors.New("failed to find a CD or DVD virtual media device")
+}
+
+// VirtualMediaStatus returns the image inserted in the CD virtual media
+// device, an empty string if none is inserted
+func (r *Redfish) VirtualMediaStatus() (string, bool, error) {
+	vm, err := r.virtualMedia()
+	if err != nil {
+		return "", false, err
+	}
+
+	return vm.Image, gofish.Deref(vm.Inserted), nil
+}
+
+// MountVirtualMedia inserts image in the CD virtual media device and waits
+// for the BMC to report it inserted. Media already inserted is ejected first.
+// BMCs without the InsertMedia action have the image set with a PATCH.
+func (r *Redfish) MountVirtualMedia(image string) error {
+	vm, err := r.virtualMedia()
+	if err != nil {
+		return err
+	}
+
+	if gofish.Deref(vm.Inserted) && vm.Image == image {
+		return nil
+	}
+
+	if gofish.Deref(vm.Inserted) || vm.Image != "" {
+		if err := r.ejectMedia(vm); err != nil {
+			return fmt.Errorf("failed to eject %s: %w", vm.Image, err)
+		}
+	}
+
+	if vm.SupportsMediaInsert {
+		task, err := vm.InsertMedia(&schemas.VirtualMediaInsertMediaParameters{
+			Image:          image,
+			Inserted:       gofish.ToRef(true),
+			WriteProtected: gofish.ToRef(true),
+		})
+		if err != nil {
+			return err
+		}
+		if err := r.waitTask(task); err != nil {
+			return err
+		}
+	} else {
+		vm.Image = image
+		vm.Inserted = gofish.ToRef(true)
+		if err := vm.Update(); err != nil {
+			return err
+		}
+	}
+
+	return r.waitMedia(vm.ODataID, func(vm *schemas.VirtualMedia) bool {
+		return gofish.Deref(vm.Inserted) && vm.Image != ""
+	})
+}
+
+// UnmountVirtualMedia ejects the media in the CD virtual media device and
+// waits for the BMC to report it ejected
+func (r *Redfish) UnmountVirtualMedia() error {
+	vm, err := r.virtualMedia()
+	if err != nil {
+		return err
+	}
+
+	if !gofish.Deref(vm.Inserted) && vm.Image == "" {
+		return nil
+	}
+
+	return r.ejectMedia(vm)
+}
+
+func (r *Redfish) ejectMedia(vm *schemas.VirtualMedia) error {
+	if vm.SupportsMediaEject {
+		task, err := vm.EjectMedia()
+		if err != nil {
+			return err
+		}
+		if err := r.waitTask(task); err != nil {
+			return err
+		}
+	} else {
+		vm.Image = ""
+		vm.Inserted = gofish.ToRef(false)
+		if err := vm.Update(); err != nil {
+			return err
+		}
+	}
+
+	return r.waitMedia(vm.ODataID, func(vm *schemas.VirtualMedia) bool {
+		return !gofish.Deref(vm.Inserted)
+	})
+}
+
+// waitMedia polls the virtual media at uri until done returns true or the
+// client times out
+func (r *Redfish) waitMedia(uri string, done func(*schemas.VirtualMedia) bool) error {
+	for {
+		vm, err := schemas.GetVirtualMedia(r.client, uri)
+		if err != nil {
+			return err
+		}
+		if done(vm) {
+			return nil
+		}
+
+		select {
+		case <-r.context().Done():
+			return fmt.Errorf("timed out waiting for virtual media, inserted: %t image: %q", gofish.Deref(vm.Inserted), vm.Image)
+		case <-time.After(vmediaPollInterval):
+		}
+	}
+}
+
+// waitTask waits for a BMC task started by an action to finish. Failed tasks
+// and error responses are returned verbatim
+func (r *Redfish) waitTask(info *schemas.TaskMonitorInfo) error {
+	if info == nil || info.TaskMonitor == "" {
+		return nil
+	}
+
+	resp, err := schemas.WaitForTaskMonitor(r.context(), r.client, vmediaPollInterval, info, nil)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	body, _ := io.ReadAll(resp.Body)
+	if resp.StatusCode >= http.StatusBadRequest {
+		return fmt.Errorf("%d:%s", resp.StatusCode, body)
+	}
+
+	// The task monitor returns the finished task or the result of the action
+	task := &schemas.Task{}
+	if json.Unmarshal(body, task) == nil && task.TaskState != "" {
+		return taskError(task)
+	}
+
+	if info.Task == nil || info.Task.ODataID == "" {
+		return nil
+	}
+
+	task, err = schemas.GetTask(r.client, info.Task.ODataID)
+	if err != nil {
+		// Some BMCs remove tasks once they complete
+		return nil
+	}
+
+	return taskError(task)
+}
+
+// taskError returns an error with the messages of a task that did not
+// complete successfully
+func taskError(task *schemas.Task) error {
+	switch task.TaskState {
+	case schemas.ExceptionTaskState, schemas.KilledTaskState, schemas.CancelledTaskState:
+	default:
+		return nil
+	}
+
+	msgs := make([]string, 0, len(task.Messages))
+	for _, m := range task.Messages {
+		msgs = append(msgs, m.Message)
+	}
+
+	return fmt.Errorf("task %s %s: %s", task.ID, task.TaskState, strings.Join(msgs, "; "))
+}
+
+func (r *Redfish) context() context.Context {
+	if r.ctx == nil {
+		return context.Background()
+	}
+
+	return r.ctx
+}
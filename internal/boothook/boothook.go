@@ -0,0 +1,455 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+// Package boothook runs the commands and HTTP callbacks of provision.hooks
+// when a host fetches its iPXE script or kickstart, or completes its install.
+// Hooks run in the background with a timeout, so a slow or failing hook never
+// fails or delays the boot request. Each run is recorded in the log of the
+// host, and a hook runs at most once per min_interval for an event of a host
+// so a node crash looping does not flood the external system
+package boothook
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"os/exec"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/viper"
+	"github.com/ubccr/grendel/internal/logger"
+	"github.com/ubccr/grendel/internal/store"
+	"github.com/ubccr/grendel/internal/util"
+	"github.com/ubccr/grendel/internal/webhook"
+	"github.com/ubccr/grendel/pkg/model"
+	"github.com/ubccr/grendel/pkg/nodeset"
+)
+
+// Boot events triggering hooks
+const (
+	IpxeFetched      = "ipxe-fetched"
+	KickstartFetched = "kickstart-fetched"
+	Complete         = "complete"
+)
+
+// Results of a run, counted by grendel_boot_hook_runs_total
+const (
+	ResultOK        = "ok"
+	ResultFailed    = "failed"
+	ResultThrottled = "throttled"
+	ResultDropped   = "dropped"
+)
+
+const (
+	DefaultTimeout       = 10 * time.Second
+	DefaultMinInterval   = time.Minute
+	DefaultMaxConcurrent = 4
+
+	// MessageID of the host log entries of hook runs
+	MessageID = "Grendel.BootHook"
+
+	// maxOutput is the tail of the output of a failed command kept in the
+	// host log
+	maxOutput = 512
+)
+
+// Events are the boot events hooks subscribe to
+var Events = []string{IpxeFetched, KickstartFetched, Complete}
+
+var (
+	log = logger.GetLogger("BOOTHOOK")
+
+	runsTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "grendel_boot_hook_runs_total",
+		Help: "Boot hook runs by hook and result: ok, failed, throttled by min_interval or dropped over max_concurrent",
+	}, []string{"hook", "result"})
+)
+
+func init() {
+	prometheus.MustRegister(runsTotal)
+}
+
+// HookConfig is a hook of the provision.hooks config
+type HookConfig struct {
+	Name string `mapstructure:"name"`
+
+	// Events are the boot events running the hook, all of them when empty
+	Events []string `mapstructure:"events"`
+
+	// Nodeset and Tags restrict the hook to these hosts and to hosts with
+	// any of these tags
+	Nodeset string   `mapstructure:"nodeset"`
+	Tags    []string `mapstructure:"tags"`
+
+	// Command is run with its arguments, or URL is posted the payload
+	Command []string `mapstructure:"command"`
+	URL     string   `mapstructure:"url"`
+
+	// Secret signs the payloads posted to URL in the X-Grendel-Signature
+	// header
+	Secret string `mapstructure:"secret"`
+
+	Timeout       string `mapstructure:"timeout"`
+	MinInterval   string `mapstructure:"min_interval"`
+	MaxConcurrent int    `mapstructure:"max_concurrent"`
+}
+
+// Hook is a compiled hook
+type Hook struct {
+	Name    string
+	Events  []string
+	Tags    []string
+	Command []string
+	URL     string
+	Secret  string
+
+	// Timeout kills the command or aborts the request
+	Timeout time.Duration
+
+	// MinInterval is the time between two runs of the hook for the same
+	// event of a host, 0 runs the hook on every event
+	MinInterval time.Duration
+
+	// MaxConcurrent caps the runs of the hook at once, events over it are
+	// dropped
+	MaxConcurrent int
+
+	nodes   map[string]bool
+	running chan struct{}
+}
+
+// Payload is the body posted to the URL of a hook and written to the
+// standard input of its command
+type Payload struct {
+	Hook  string    `json:"hook"`
+	Event string    `json:"event"`
+	Host  string    `json:"host"`
+	MAC   string    `json:"mac"`
+	IP    string    `json:"ip"`
+	Image string    `json:"image"`
+	Time  time.Time `json:"time"`
+}
+
+// env returns the environment of the command of a hook
+func (p Payload) env() []string {
+	return append(os.Environ(),
+		"GRENDEL_HOOK="+p.Hook,
+		"GRENDEL_EVENT="+p.Event,
+		"GRENDEL_HOST="+p.Host,
+		"GRENDEL_MAC="+p.MAC,
+		"GRENDEL_IP="+p.IP,
+		"GRENDEL_IMAGE="+p.Image,
+	)
+}
+
+// LoadConfig compiles the hooks of provision.hooks
+func LoadConfig(v *viper.Viper) ([]*Hook, error) {
+	var configs []HookConfig
+	if err := v.UnmarshalKey("provision.hooks", &configs); err != nil {
+		return nil, fmt.Errorf("failed parsing provision.hooks: %w", err)
+	}
+
+	hooks := make([]*Hook, 0, len(configs))
+	for _, c := range configs {
+		if c.Name == "" {
+			return nil, errors.New("failed parsing provision.hooks: hook without a name")
+		}
+		if slices.ContainsFunc(hooks, func(h *Hook) bool { return h.Name == c.Name }) {
+			return nil, fmt.Errorf("failed parsing provision.hooks: hook %s defined twice", c.Name)
+		}
+		if (len(c.Command) == 0) == (c.URL == "") {
+			return nil, fmt.Errorf("failed parsing provision.hooks: %s: set either command or url", c.Name)
+		}
+
+		h := &Hook{
+			Name:          c.Name,
+			Events:        c.Events,
+			Tags:          c.Tags,
+			Command:       c.Command,
+			URL:           c.URL,
+			Secret:        c.Secret,
+			Timeout:       DefaultTimeout,
+			MinInterval:   DefaultMinInterval,
+			MaxConcurrent: c.MaxConcurrent,
+		}
+		for _, e := range h.Events {
+			if !slices.Contains(Events, e) {
+				return nil, fmt.Errorf("failed parsing provision.hooks: %s: invalid event %q, one of %s", c.Name, e, strings.Join(Events, ", "))
+			}
+		}
+
+		var err error
+		if c.Timeout != "" {
+			h.Timeout, err = util.ParseDuration(c.Timeout)
+			if err != nil || h.Timeout <= 0 {
+				return nil, fmt.Errorf("failed parsing provision.hooks: %s: invalid timeout %q", c.Name, c.Timeout)
+			}
+		}
+		if c.MinInterval != "" {
+			h.MinInterval, err = util.ParseDuration(c.MinInterval)
+			if err != nil || h.MinInterval < 0 {
+				return nil, fmt.Errorf("failed parsing provision.hooks: %s: invalid min_interval %q", c.Name, c.MinInterval)
+			}
+		}
+		if h.MaxConcurrent < 0 {
+			return nil, fmt.Errorf("failed parsing provision.hooks: %s: invalid max_concurrent %d", c.Name, h.MaxConcurrent)
+		}
+		if h.MaxConcurrent == 0 {
+			h.MaxConcurrent = DefaultMaxConcurrent
+		}
+		h.running = make(chan struct{}, h.MaxConcurrent)
+
+		if c.Nodeset != "" {
+			ns, err := nodeset.NewNodeSet(c.Nodeset)
+			if err != nil {
+				return nil, fmt.Errorf("failed parsing provision.hooks: %s: invalid nodeset %q: %w", c.Name, c.Nodeset, err)
+			}
+			h.nodes = make(map[string]bool, ns.Len())
+			for _, name := range ns.Iterator().StringSlice() {
+				h.nodes[name] = true
+			}
+		}
+
+		hooks = append(hooks, h)
+	}
+
+	return hooks, nil
+}
+
+// match returns whether the hook runs on event for host
+func (h *Hook) match(event string, host *model.Host) bool {
+	if len(h.Events) > 0 && !slices.Contains(h.Events, event) {
+		return false
+	}
+	if h.nodes != nil && !h.nodes[host.Name] {
+		return false
+	}
+	if len(h.Tags) > 0 && !host.HasAnyTags(h.Tags...) {
+		return false
+	}
+
+	return true
+}
+
+// Runner runs the hooks matching the boot events of hosts and records the
+// results in the log of the hosts. A nil Runner runs no hooks
+type Runner struct {
+	db store.Store
+
+	mu      sync.Mutex
+	hooks   []*Hook
+	lastRun map[string]time.Time
+
+	wg sync.WaitGroup
+}
+
+// New returns a runner without hooks storing the results in db
+func New(db store.Store) *Runner {
+	return &Runner{db: db, lastRun: make(map[string]time.Time)}
+}
+
+// Configure replaces the hooks of r. Runs in progress finish with the
+// previous hooks, the time of the last runs of the hooks is kept
+func (r *Runner) Configure(hooks []*Hook) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.hooks = hooks
+}
+
+// Fire starts the hooks subscribed to event for host, booting from nic with
+// image. It never blocks: hooks run in the background
+func (r *Runner) Fire(event string, host *model.Host, nic *model.NetInterface, image string) {
+	if r == nil {
+		return
+	}
+
+	now := time.Now()
+	p := Payload{
+		Event: event,
+		Host:  host.Name,
+		Image: image,
+		Time:  now.UTC(),
+	}
+	if nic != nil {
+		p.MAC = nic.MAC.String()
+		p.IP = nic.AddrString()
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.prune(now)
+	for _, h := range r.hooks {
+		if !h.match(event, host) {
+			continue
+		}
+
+		fields := logrus.Fields{"hook": h.Name, "event": event, logger.FieldHost: host.Name}
+		key := h.Name + "/" + host.Name + "/" + event
+		if last, ok := r.lastRun[key]; ok && now.Sub(last) < h.MinInterval {
+			runsTotal.WithLabelValues(h.Name, ResultThrottled).Inc()
+			log.WithFields(fields).Debugf("Skipping boot hook, last run %s ago", now.Sub(last).Round(time.Second))
+			continue
+		}
+
+		select {
+		case h.running <- struct{}{}:
+		default:
+			runsTotal.WithLabelValues(h.Name, ResultDropped).Inc()
+			log.WithFields(fields).Warnf("Dropping boot hook, %d runs in progress", h.MaxConcurrent)
+			continue
+		}
+		r.lastRun[key] = now
+
+		p.Hook = h.Name
+		r.wg.Add(1)
+		go func(h *Hook, p Payload) {
+			defer r.wg.Done()
+			defer func() { <-h.running }()
+			r.run(h, p)
+		}(h, p)
+	}
+}
+
+// prune forgets the last runs older than the min interval of every hook
+func (r *Runner) prune(now time.Time) {
+	var keep time.Duration
+	for _, h := range r.hooks {
+		keep = max(keep, h.MinInterval)
+	}
+	for key, last := range r.lastRun {
+		if now.Sub(last) >= keep {
+			delete(r.lastRun, key)
+		}
+	}
+}
+
+// Wait waits for the hooks running to finish
+func (r *Runner) Wait() {
+	if r == nil {
+		return
+	}
+
+	r.wg.Wait()
+}
+
+// run runs hook h and records the result in the log of the host
+func (r *Runner) run(h *Hook, p Payload) {
+	ctx, cancel := context.WithTimeout(context.Background(), h.Timeout)
+	defer cancel()
+
+	start := time.Now()
+	var err error
+	if h.URL != "" {
+		err = post(ctx, h, p)
+	} else {
+		err = execute(ctx, h, p)
+	}
+	if ctx.Err() == context.DeadlineExceeded {
+		err = fmt.Errorf("timed out after %s", h.Timeout)
+	}
+	elapsed := time.Since(start).Round(time.Millisecond)
+
+	fields := logrus.Fields{"hook": h.Name, "event": p.Event, logger.FieldHost: p.Host, "duration": elapsed}
+	entry := &model.HostLogEntry{
+		Host:      p.Host,
+		Time:      time.Now().UTC(),
+		MessageID: MessageID,
+	}
+	if err != nil {
+		runsTotal.WithLabelValues(h.Name, ResultFailed).Inc()
+		log.WithFields(fields).WithField("err", err).Warn("Boot hook failed")
+		entry.Severity = "Warning"
+		entry.Message = fmt.Sprintf("Boot hook %s on %s failed after %s: %s", h.Name, p.Event, elapsed, err)
+	} else {
+		runsTotal.WithLabelValues(h.Name, ResultOK).Inc()
+		log.WithFields(fields).Info("Boot hook succeeded")
+		entry.Severity = "OK"
+		entry.Message = fmt.Sprintf("Boot hook %s on %s succeeded in %s", h.Name, p.Event, elapsed)
+	}
+
+	if err := r.db.StoreHostLog(model.HostLogList{entry}); errors.Is(err, store.ErrReadOnly) {
+		log.WithFields(fields).Debug("Not recording boot hook in host log in read-only mode")
+	} else if err != nil {
+		log.WithFields(fields).WithField("err", err).Error("Failed to record boot hook in host log")
+	}
+}
+
+// execute runs the command of h with the payload on its standard input and
+// in its environment
+func execute(ctx context.Context, h *Hook, p Payload) error {
+	body, err := json.Marshal(p)
+	if err != nil {
+		return err
+	}
+
+	cmd := exec.CommandContext(ctx, h.Command[0], h.Command[1:]...)
+	cmd.Env = p.env()
+	cmd.Stdin = bytes.NewReader(body)
+	// Output of children left running after the command is killed must not
+	// hold the run past its timeout
+	cmd.WaitDelay = time.Second
+
+	out, err := cmd.CombinedOutput()
+	if err != nil {
+		if tail := outputTail(out); tail != "" {
+			return fmt.Errorf("%w: %s", err, tail)
+		}
+		return err
+	}
+
+	return nil
+}
+
+// outputTail returns the end of the output of a command on one line
+func outputTail(out []byte) string {
+	s := strings.Join(strings.Fields(string(out)), " ")
+	if len(s) > maxOutput {
+		s = "..." + s[len(s)-maxOutput:]
+	}
+
+	return s
+}
+
+// post posts the payload to the URL of h
+func post(ctx context.Context, h *Hook, p Payload) error {
+	body, err := json.Marshal(p)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, h.URL, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "grendel-boot-hook")
+	if h.Secret != "" {
+		req.Header.Set(webhook.SignatureHeader, webhook.Sign(h.Secret, body))
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	io.Copy(io.Discard, io.LimitReader(resp.Body, 1<<16))
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("unexpected status %s", resp.Status)
+	}
+
+	return nil
+}
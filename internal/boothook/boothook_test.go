@@ -0,0 +1,196 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package boothook
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/netip"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/ubccr/grendel/internal/store/sqlstore"
+	"github.com/ubccr/grendel/internal/webhook"
+	"github.com/ubccr/grendel/pkg/model"
+	"github.com/ubccr/grendel/pkg/nodeset"
+)
+
+func loadConfig(t *testing.T, toml string) ([]*Hook, error) {
+	v := viper.New()
+	v.SetConfigType("toml")
+	require.NoError(t, v.ReadConfig(bytes.NewBufferString(toml)))
+
+	return LoadConfig(v)
+}
+
+func testHost(name string, tags ...string) (*model.Host, *model.NetInterface) {
+	mac, _ := net.ParseMAC("de:ad:be:ef:00:01")
+	nic := &model.NetInterface{MAC: mac, IP: netip.MustParsePrefix("10.0.0.1/24")}
+	return &model.Host{Name: name, Tags: tags, Interfaces: []*model.NetInterface{nic}}, nic
+}
+
+func TestLoadConfig(t *testing.T) {
+	hooks, err := loadConfig(t, `
+[[provision.hooks]]
+name = "ipam"
+events = ["ipxe-fetched"]
+nodeset = "cpn-[01-02]"
+url = "https://ipam.example.com/hook"
+
+[[provision.hooks]]
+name = "burn-in"
+tags = ["burn-in"]
+command = ["/bin/true"]
+timeout = "1m"
+min_interval = "0s"
+`)
+	require.NoError(t, err)
+	require.Len(t, hooks, 2)
+
+	assert.Equal(t, DefaultTimeout, hooks[0].Timeout)
+	assert.Equal(t, DefaultMinInterval, hooks[0].MinInterval)
+	assert.Equal(t, DefaultMaxConcurrent, hooks[0].MaxConcurrent)
+	assert.Equal(t, time.Minute, hooks[1].Timeout)
+	assert.Equal(t, time.Duration(0), hooks[1].MinInterval)
+
+	cpn01, _ := testHost("cpn-01")
+	cpn03, _ := testHost("cpn-03", "burn-in")
+	assert.True(t, hooks[0].match(IpxeFetched, cpn01))
+	assert.False(t, hooks[0].match(Complete, cpn01))
+	assert.False(t, hooks[0].match(IpxeFetched, cpn03))
+	assert.True(t, hooks[1].match(Complete, cpn03))
+	assert.False(t, hooks[1].match(Complete, cpn01))
+
+	for name, toml := range map[string]string{
+		"no name":       `[[provision.hooks]]` + "\n" + `url = "https://a"`,
+		"no action":     `[[provision.hooks]]` + "\n" + `name = "a"`,
+		"both actions":  `[[provision.hooks]]` + "\n" + `name = "a"` + "\n" + `url = "https://a"` + "\n" + `command = ["/bin/true"]`,
+		"bad event":     `[[provision.hooks]]` + "\n" + `name = "a"` + "\n" + `url = "https://a"` + "\n" + `events = ["boot"]`,
+		"bad timeout":   `[[provision.hooks]]` + "\n" + `name = "a"` + "\n" + `url = "https://a"` + "\n" + `timeout = "0s"`,
+		"bad interval":  `[[provision.hooks]]` + "\n" + `name = "a"` + "\n" + `url = "https://a"` + "\n" + `min_interval = "soon"`,
+		"bad nodeset":   `[[provision.hooks]]` + "\n" + `name = "a"` + "\n" + `url = "https://a"` + "\n" + `nodeset = "cpn-[01"`,
+		"defined twice": `[[provision.hooks]]` + "\n" + `name = "a"` + "\n" + `url = "https://a"` + "\n" + `[[provision.hooks]]` + "\n" + `name = "a"` + "\n" + `url = "https://b"`,
+	} {
+		_, err := loadConfig(t, toml)
+		assert.Error(t, err, name)
+	}
+}
+
+func TestRunner(t *testing.T) {
+	db, err := sqlstore.New(":memory:")
+	require.NoError(t, err)
+	defer db.Close()
+
+	host, nic := testHost("cpn-01", "burn-in")
+	require.NoError(t, db.StoreHost(host))
+
+	var mu sync.Mutex
+	posted := make(map[string]Payload)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		assert.Equal(t, webhook.Sign("s3cret", body), r.Header.Get(webhook.SignatureHeader))
+		var p Payload
+		assert.NoError(t, json.Unmarshal(body, &p))
+		mu.Lock()
+		posted[p.Event] = p
+		mu.Unlock()
+	}))
+	defer srv.Close()
+
+	out := filepath.Join(t.TempDir(), "env")
+	hooks, err := loadConfig(t, `
+[[provision.hooks]]
+name = "ipam"
+url = "`+srv.URL+`"
+secret = "s3cret"
+
+[[provision.hooks]]
+name = "burn-in"
+events = ["complete"]
+tags = ["burn-in"]
+command = ["sh", "-c", "echo $GRENDEL_EVENT $GRENDEL_HOST $GRENDEL_MAC $GRENDEL_IP $GRENDEL_IMAGE > `+out+`"]
+
+[[provision.hooks]]
+name = "broken"
+events = ["complete"]
+command = ["sh", "-c", "echo no route to ipam >&2; exit 3"]
+min_interval = "0s"
+
+[[provision.hooks]]
+name = "slow"
+events = ["kickstart-fetched"]
+command = ["sleep", "10"]
+timeout = "100ms"
+`)
+	require.NoError(t, err)
+
+	r := New(db)
+	r.Configure(hooks)
+
+	r.Fire(IpxeFetched, host, nic, "rocky9")
+	// Throttled by min_interval
+	r.Fire(IpxeFetched, host, nic, "rocky9")
+	r.Fire(KickstartFetched, host, nic, "rocky9")
+	r.Fire(Complete, host, nic, "rocky9")
+	r.Wait()
+	r.Fire(Complete, host, nic, "rocky9")
+	r.Wait()
+
+	assert.Len(t, posted, 3)
+	p := posted[IpxeFetched]
+	assert.Equal(t, Payload{Hook: "ipam", Event: IpxeFetched, Host: "cpn-01", MAC: "de:ad:be:ef:00:01", IP: "10.0.0.1", Image: "rocky9", Time: p.Time}, p)
+
+	env, err := os.ReadFile(out)
+	require.NoError(t, err)
+	assert.Equal(t, "complete cpn-01 de:ad:be:ef:00:01 10.0.0.1 rocky9\n", string(env))
+
+	ns, err := nodeset.NewNodeSet("cpn-01")
+	require.NoError(t, err)
+	entries, err := db.FindHostLog(ns)
+	require.NoError(t, err)
+
+	assert.Len(t, entries, 7)
+	for _, e := range entries {
+		assert.Equal(t, MessageID, e.MessageID)
+	}
+
+	count := func(severity, prefix string) int {
+		n := 0
+		for _, e := range entries {
+			if e.Severity == severity && strings.HasPrefix(e.Message, prefix) {
+				n++
+			}
+		}
+		return n
+	}
+	assert.Equal(t, 3, count("OK", "Boot hook ipam "))
+	assert.Equal(t, 1, count("OK", "Boot hook burn-in on complete succeeded"))
+	assert.Equal(t, 2, count("Warning", "Boot hook broken on complete failed"))
+	assert.Equal(t, 1, count("Warning", "Boot hook slow on kickstart-fetched failed"))
+
+	for _, e := range entries {
+		switch {
+		case strings.HasPrefix(e.Message, "Boot hook broken"):
+			assert.Contains(t, e.Message, "exit status 3: no route to ipam")
+		case strings.HasPrefix(e.Message, "Boot hook slow"):
+			assert.Contains(t, e.Message, "timed out after 100ms")
+		}
+	}
+
+	var nilRunner *Runner
+	nilRunner.Fire(Complete, host, nic, "rocky9")
+	nilRunner.Wait()
+}
@@ -0,0 +1,439 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package certs
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/ubccr/grendel/internal/logger"
+	"golang.org/x/crypto/acme"
+)
+
+const (
+	ChallengeHTTP01 = "http-01"
+	ChallengeDNS01  = "dns-01"
+
+	// ChallengePath is the path of the http-01 challenge responses
+	ChallengePath = "/.well-known/acme-challenge/"
+
+	accountKeyFile = "account.key"
+	certFile       = "cert.pem"
+	keyFile        = "key.pem"
+
+	// obtainTimeout bounds obtaining a certificate, including waiting for the
+	// ACME server to validate the challenges
+	obtainTimeout = 5 * time.Minute
+
+	// checkInterval is the longest wait between renewal checks, so renewals
+	// are not delayed by clock changes during long waits
+	checkInterval = time.Hour
+
+	minRetry = time.Minute
+	maxRetry = time.Hour
+)
+
+// ACMEConfig configures obtaining a certificate from an ACME server
+type ACMEConfig struct {
+	// DirectoryURL is the directory of the ACME server, Let's Encrypt when
+	// empty
+	DirectoryURL string
+
+	// Email is the contact of the ACME account
+	Email string
+
+	// Domains are the names of the certificate
+	Domains []string
+
+	// Challenge is the challenge type solved, http-01 or dns-01
+	Challenge string
+
+	// CacheDir stores the account key and the certificate
+	CacheDir string
+
+	// CACert is a PEM file of the CA certificates trusted to connect to the
+	// ACME server in addition to the system ones
+	CACert string
+
+	// PresentTXT and CleanUpTXT publish and remove the TXT records of dns-01
+	// challenges
+	PresentTXT func(name, value string)
+	CleanUpTXT func(name, value string)
+}
+
+// ACME is a certificate obtained from an ACME server and renewed once two
+// thirds of its lifetime passed
+type ACME struct {
+	*holder
+	config    ACMEConfig
+	client    *acme.Client
+	responses sync.Map
+}
+
+// NewACME returns the ACME certificate of service, starting with the
+// certificate cached by a previous run when it covers the domains
+func NewACME(service string, c ACMEConfig) (*ACME, error) {
+	if len(c.Domains) == 0 {
+		return nil, errors.New("no domains to request a certificate for")
+	}
+	if c.CacheDir == "" {
+		return nil, errors.New("no ACME cache directory")
+	}
+
+	switch c.Challenge {
+	case ChallengeHTTP01:
+	case ChallengeDNS01:
+		if c.PresentTXT == nil || c.CleanUpTXT == nil {
+			return nil, errors.New("the dns-01 challenge requires the DNS server")
+		}
+	default:
+		return nil, fmt.Errorf("invalid ACME challenge %q. Valid challenges: %s, %s", c.Challenge, ChallengeHTTP01, ChallengeDNS01)
+	}
+
+	if c.DirectoryURL == "" {
+		c.DirectoryURL = acme.LetsEncryptURL
+	}
+
+	httpClient := http.DefaultClient
+	if c.CACert != "" {
+		pemCerts, err := os.ReadFile(c.CACert)
+		if err != nil {
+			return nil, err
+		}
+
+		pool, err := x509.SystemCertPool()
+		if err != nil {
+			pool = x509.NewCertPool()
+		}
+		if !pool.AppendCertsFromPEM(pemCerts) {
+			return nil, fmt.Errorf("no certificates found in %s", c.CACert)
+		}
+
+		transport := http.DefaultTransport.(*http.Transport).Clone()
+		transport.TLSClientConfig = &tls.Config{RootCAs: pool}
+		httpClient = &http.Client{Transport: transport}
+	}
+
+	a := &ACME{
+		holder: newHolder(service),
+		config: c,
+		client: &acme.Client{
+			DirectoryURL: c.DirectoryURL,
+			HTTPClient:   httpClient,
+			UserAgent:    "grendel",
+		},
+	}
+
+	cert, err := tls.LoadX509KeyPair(filepath.Join(c.CacheDir, certFile), filepath.Join(c.CacheDir, keyFile))
+	switch {
+	case errors.Is(err, os.ErrNotExist):
+	case err != nil:
+		a.log().WithField("err", err).Warn("Ignoring invalid cached certificate")
+	case !covers(cert.Leaf, c.Domains):
+		a.log().Info("Ignoring cached certificate issued for other domains")
+	default:
+		a.cert.Store(&cert)
+	}
+
+	return a, nil
+}
+
+func (a *ACME) log() *logrus.Entry {
+	return log.WithFields(logrus.Fields{
+		logger.FieldService: a.service,
+		"domains":           strings.Join(a.config.Domains, ","),
+	})
+}
+
+// covers returns whether cert is valid for all domains. Wildcard domains are
+// not valid host names and are looked up in the names of the certificate
+func covers(cert *x509.Certificate, domains []string) bool {
+	for _, d := range domains {
+		if cert.VerifyHostname(d) != nil && !slices.Contains(cert.DNSNames, d) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// RenewAt returns when the certificate is renewed, once two thirds of its
+// lifetime passed. Certificates from ACME servers issuing short lived
+// certificates are renewed well before they expire too
+func (a *ACME) RenewAt() time.Time {
+	cert := a.cert.Load()
+	if cert == nil || cert.Leaf == nil {
+		return time.Time{}
+	}
+
+	lifetime := cert.Leaf.NotAfter.Sub(cert.Leaf.NotBefore)
+	return cert.Leaf.NotAfter.Add(-lifetime / 3)
+}
+
+// HTTPHandler answers the http-01 challenges under ChallengePath
+func (a *ACME) HTTPHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		token := strings.TrimPrefix(r.URL.Path, ChallengePath)
+		response, ok := a.responses.Load(token)
+		if !ok {
+			http.NotFound(w, r)
+			return
+		}
+
+		w.Header().Set("Content-Type", "text/plain")
+		w.Write([]byte(response.(string)))
+	})
+}
+
+// Run obtains the certificate when there is none and renews it until stop
+// is closed. Failures are logged and retried with an increasing delay
+func (a *ACME) Run(stop <-chan struct{}) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go func() {
+		select {
+		case <-stop:
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+
+	retry := minRetry
+	for {
+		wait := time.Until(a.RenewAt())
+		if wait <= 0 {
+			err := a.obtain(ctx)
+			if ctx.Err() != nil {
+				return
+			}
+			if err == nil {
+				retry = minRetry
+				continue
+			}
+
+			renewalsTotal.WithLabelValues(a.service, "failure").Inc()
+			a.log().WithField("err", err).Errorf("Failed to obtain certificate, retrying in %s", retry)
+			wait = retry
+			retry = min(retry*2, maxRetry)
+		}
+
+		timer := time.NewTimer(min(wait, checkInterval))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return
+		case <-timer.C:
+		}
+	}
+}
+
+// obtain orders a new certificate and stores it in the cache directory
+func (a *ACME) obtain(ctx context.Context) error {
+	ctx, cancel := context.WithTimeout(ctx, obtainTimeout)
+	defer cancel()
+
+	a.log().Infof("Requesting certificate from %s using %s", a.client.DirectoryURL, a.config.Challenge)
+
+	if err := os.MkdirAll(a.config.CacheDir, 0o700); err != nil {
+		return err
+	}
+
+	if a.client.Key == nil {
+		key, err := loadOrCreateKey(filepath.Join(a.config.CacheDir, accountKeyFile))
+		if err != nil {
+			return fmt.Errorf("failed to load account key: %w", err)
+		}
+		a.client.Key = key
+
+		account := &acme.Account{}
+		if a.config.Email != "" {
+			account.Contact = []string{"mailto:" + a.config.Email}
+		}
+		if _, err := a.client.Register(ctx, account, acme.AcceptTOS); err != nil && !errors.Is(err, acme.ErrAccountAlreadyExists) {
+			a.client.Key = nil
+			return fmt.Errorf("failed to register account: %w", err)
+		}
+	}
+
+	order, err := a.client.AuthorizeOrder(ctx, acme.DomainIDs(a.config.Domains...))
+	if err != nil {
+		return fmt.Errorf("failed to create order: %w", err)
+	}
+
+	for _, u := range order.AuthzURLs {
+		if err := a.authorize(ctx, u); err != nil {
+			return err
+		}
+	}
+
+	order, err = a.client.WaitOrder(ctx, order.URI)
+	if err != nil {
+		return fmt.Errorf("order not ready: %w", err)
+	}
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return err
+	}
+
+	csr, err := x509.CreateCertificateRequest(rand.Reader, &x509.CertificateRequest{
+		Subject:  pkix.Name{CommonName: a.config.Domains[0]},
+		DNSNames: a.config.Domains,
+	}, key)
+	if err != nil {
+		return err
+	}
+
+	chain, _, err := a.client.CreateOrderCert(ctx, order.FinalizeURL, csr, true)
+	if err != nil {
+		return fmt.Errorf("failed to finalize order: %w", err)
+	}
+
+	leaf, err := x509.ParseCertificate(chain[0])
+	if err != nil {
+		return err
+	}
+
+	if err := a.save(chain, key); err != nil {
+		return err
+	}
+
+	a.cert.Store(&tls.Certificate{Certificate: chain, PrivateKey: key, Leaf: leaf})
+	renewalsTotal.WithLabelValues(a.service, "success").Inc()
+	a.log().WithFields(logrus.Fields{
+		"expires": leaf.NotAfter.Format(time.RFC3339),
+		"renew":   a.RenewAt().Format(time.RFC3339),
+	}).Info("Obtained certificate")
+
+	return nil
+}
+
+// authorize solves the challenge of an authorization of the order
+func (a *ACME) authorize(ctx context.Context, url string) error {
+	authz, err := a.client.GetAuthorization(ctx, url)
+	if err != nil {
+		return err
+	}
+	if authz.Status == acme.StatusValid {
+		return nil
+	}
+
+	var chal *acme.Challenge
+	for _, c := range authz.Challenges {
+		if c.Type == a.config.Challenge {
+			chal = c
+			break
+		}
+	}
+	if chal == nil {
+		return fmt.Errorf("ACME server offers no %s challenge for %s", a.config.Challenge, authz.Identifier.Value)
+	}
+
+	switch chal.Type {
+	case ChallengeHTTP01:
+		response, err := a.client.HTTP01ChallengeResponse(chal.Token)
+		if err != nil {
+			return err
+		}
+		a.responses.Store(chal.Token, response)
+		defer a.responses.Delete(chal.Token)
+	case ChallengeDNS01:
+		value, err := a.client.DNS01ChallengeRecord(chal.Token)
+		if err != nil {
+			return err
+		}
+		name := "_acme-challenge." + authz.Identifier.Value
+		a.config.PresentTXT(name, value)
+		defer a.config.CleanUpTXT(name, value)
+	}
+
+	if _, err := a.client.Accept(ctx, chal); err != nil {
+		return fmt.Errorf("failed to accept %s challenge for %s: %w", chal.Type, authz.Identifier.Value, err)
+	}
+	if _, err := a.client.WaitAuthorization(ctx, authz.URI); err != nil {
+		return fmt.Errorf("failed to validate %s challenge for %s: %w", chal.Type, authz.Identifier.Value, err)
+	}
+
+	return nil
+}
+
+// save writes the certificate chain and key to the cache directory
+func (a *ACME) save(chain [][]byte, key *ecdsa.PrivateKey) error {
+	certPEM := make([]byte, 0)
+	for _, der := range chain {
+		certPEM = append(certPEM, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})...)
+	}
+
+	der, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		return err
+	}
+	keyPEM := pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: der})
+
+	if err := writeFile(filepath.Join(a.config.CacheDir, keyFile), keyPEM); err != nil {
+		return err
+	}
+
+	return writeFile(filepath.Join(a.config.CacheDir, certFile), certPEM)
+}
+
+// loadOrCreateKey loads the EC private key in file, or generates it when
+// the file does not exist
+func loadOrCreateKey(file string) (crypto.Signer, error) {
+	data, err := os.ReadFile(file)
+	if err == nil {
+		block, _ := pem.Decode(data)
+		if block == nil {
+			return nil, fmt.Errorf("no PEM key found in %s", file)
+		}
+		return x509.ParseECPrivateKey(block.Bytes)
+	}
+	if !errors.Is(err, os.ErrNotExist) {
+		return nil, err
+	}
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, err
+	}
+
+	der, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := writeFile(file, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: der})); err != nil {
+		return nil, err
+	}
+
+	return key, nil
+}
+
+// writeFile replaces file with data, readable only by the owner
+func writeFile(file string, data []byte) error {
+	tmp := file + ".tmp"
+	if err := os.WriteFile(tmp, data, 0o600); err != nil {
+		return err
+	}
+
+	return os.Rename(tmp, file)
+}
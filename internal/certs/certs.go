@@ -0,0 +1,114 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+// Package certs provides the TLS certificates of the HTTPS services, loaded
+// from files reloaded when they change or obtained from an ACME server and
+// renewed before they expire
+package certs
+
+import (
+	"crypto/tls"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/ubccr/grendel/internal/logger"
+)
+
+var (
+	log = logger.GetLogger("CERTS")
+
+	renewalsTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "grendel_certificate_renewals_total",
+		Help: "TLS certificates obtained from ACME or reloaded from files by service and result",
+	}, []string{"service", "result"})
+
+	expiryDesc = prometheus.NewDesc(
+		"grendel_certificate_expiry_days",
+		"Days until the TLS certificate served expires by service",
+		[]string{"service"}, nil,
+	)
+
+	served = &collector{holders: make(map[string]*holder)}
+)
+
+func init() {
+	prometheus.MustRegister(renewalsTotal, served)
+}
+
+// Source provides the certificate served by a TLS listener. Its
+// GetCertificate is called on every handshake, so a new certificate is used
+// for new connections while existing ones are left alone
+type Source interface {
+	GetCertificate(*tls.ClientHelloInfo) (*tls.Certificate, error)
+}
+
+// holder is the current certificate of a service
+type holder struct {
+	service string
+	cert    atomic.Pointer[tls.Certificate]
+}
+
+// newHolder returns the certificate holder of service, reported by the
+// expiry metric
+func newHolder(service string) *holder {
+	h := &holder{service: service}
+	served.add(h)
+
+	return h
+}
+
+func (h *holder) GetCertificate(*tls.ClientHelloInfo) (*tls.Certificate, error) {
+	cert := h.cert.Load()
+	if cert == nil {
+		return nil, fmt.Errorf("no certificate for %s yet", h.service)
+	}
+
+	return cert, nil
+}
+
+// NotAfter returns when the current certificate expires, the zero time when
+// there is none
+func (h *holder) NotAfter() time.Time {
+	cert := h.cert.Load()
+	if cert == nil || cert.Leaf == nil {
+		return time.Time{}
+	}
+
+	return cert.Leaf.NotAfter
+}
+
+// collector reports the days until the certificate of each service expires
+// at the time of the scrape
+type collector struct {
+	mu      sync.Mutex
+	holders map[string]*holder
+}
+
+func (c *collector) add(h *holder) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.holders[h.service] = h
+}
+
+func (c *collector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- expiryDesc
+}
+
+func (c *collector) Collect(ch chan<- prometheus.Metric) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for service, h := range c.holders {
+		notAfter := h.NotAfter()
+		if notAfter.IsZero() {
+			continue
+		}
+		days := time.Until(notAfter).Hours() / 24
+		ch <- prometheus.MustNewConstMetric(expiryDesc, prometheus.GaugeValue, days, service)
+	}
+}
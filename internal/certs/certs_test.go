@@ -0,0 +1,131 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package certs
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// writeCert writes a self signed certificate for name valid from notBefore
+// for lifetime
+func writeCert(t *testing.T, certFile, keyFile, name string, notBefore time.Time, lifetime time.Duration) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	der, err := x509.CreateCertificate(rand.Reader, &x509.Certificate{
+		SerialNumber: big.NewInt(time.Now().UnixNano()),
+		Subject:      pkix.Name{CommonName: name},
+		DNSNames:     []string{name},
+		NotBefore:    notBefore,
+		NotAfter:     notBefore.Add(lifetime),
+	}, &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: name},
+	}, &key.PublicKey, key)
+	require.NoError(t, err)
+
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+
+	require.NoError(t, os.WriteFile(certFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0o600))
+	require.NoError(t, os.WriteFile(keyFile, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}), 0o600))
+}
+
+func TestFile(t *testing.T) {
+	dir := t.TempDir()
+	certFile := filepath.Join(dir, "cert.pem")
+	keyFile := filepath.Join(dir, "key.pem")
+	writeCert(t, certFile, keyFile, "old.example.com", time.Now(), 24*time.Hour)
+
+	f, err := LoadFile("test-file", certFile, keyFile)
+	require.NoError(t, err)
+
+	success := testutil.ToFloat64(renewalsTotal.WithLabelValues("test-file", "success"))
+	failure := testutil.ToFloat64(renewalsTotal.WithLabelValues("test-file", "failure"))
+
+	cert, err := f.GetCertificate(&tls.ClientHelloInfo{})
+	require.NoError(t, err)
+	assert.Equal(t, "old.example.com", cert.Leaf.Subject.CommonName)
+
+	// Unchanged files are not loaded again
+	f.Reload()
+	assert.Equal(t, success, testutil.ToFloat64(renewalsTotal.WithLabelValues("test-file", "success")))
+
+	// A key not matching the certificate keeps the current certificate
+	require.NoError(t, os.WriteFile(keyFile, []byte("invalid"), 0o600))
+	f.Reload()
+	assert.Equal(t, failure+1, testutil.ToFloat64(renewalsTotal.WithLabelValues("test-file", "failure")))
+	cert, err = f.GetCertificate(&tls.ClientHelloInfo{})
+	require.NoError(t, err)
+	assert.Equal(t, "old.example.com", cert.Leaf.Subject.CommonName)
+
+	writeCert(t, certFile, keyFile, "new.example.com", time.Now(), 48*time.Hour)
+	f.Reload()
+	assert.Equal(t, success+1, testutil.ToFloat64(renewalsTotal.WithLabelValues("test-file", "success")))
+	cert, err = f.GetCertificate(&tls.ClientHelloInfo{})
+	require.NoError(t, err)
+	assert.Equal(t, "new.example.com", cert.Leaf.Subject.CommonName)
+
+	assert.InDelta(t, 48.0, time.Until(f.NotAfter()).Hours(), 0.1)
+}
+
+func TestACME(t *testing.T) {
+	dir := t.TempDir()
+
+	_, err := NewACME("test-acme", ACMEConfig{Domains: []string{"boot.example.com"}, CacheDir: dir, Challenge: "tls-alpn-01"})
+	assert.ErrorContains(t, err, `invalid ACME challenge "tls-alpn-01"`)
+
+	_, err = NewACME("test-acme", ACMEConfig{Domains: []string{"boot.example.com"}, CacheDir: dir, Challenge: ChallengeDNS01})
+	assert.ErrorContains(t, err, "requires the DNS server")
+
+	// Without a cached certificate one is requested right away
+	a, err := NewACME("test-acme", ACMEConfig{Domains: []string{"boot.example.com"}, CacheDir: dir, Challenge: ChallengeHTTP01})
+	require.NoError(t, err)
+	assert.True(t, a.RenewAt().IsZero())
+	_, err = a.GetCertificate(&tls.ClientHelloInfo{})
+	assert.Error(t, err)
+
+	// The cached certificate is used and renewed once two thirds of its
+	// lifetime passed
+	notBefore := time.Now().Add(-time.Hour).Truncate(time.Second)
+	writeCert(t, filepath.Join(dir, certFile), filepath.Join(dir, keyFile), "boot.example.com", notBefore, 90*time.Hour)
+	a, err = NewACME("test-acme", ACMEConfig{Domains: []string{"boot.example.com"}, CacheDir: dir, Challenge: ChallengeHTTP01})
+	require.NoError(t, err)
+	assert.True(t, notBefore.Add(60*time.Hour).Equal(a.RenewAt()))
+	_, err = a.GetCertificate(&tls.ClientHelloInfo{})
+	assert.NoError(t, err)
+
+	// Unless it was issued for other domains
+	a, err = NewACME("test-acme", ACMEConfig{Domains: []string{"boot.example.com", "repo.example.com"}, CacheDir: dir, Challenge: ChallengeHTTP01})
+	require.NoError(t, err)
+	assert.True(t, a.RenewAt().IsZero())
+
+	a.responses.Store("token", "token.thumbprint")
+	rec := httptest.NewRecorder()
+	a.HTTPHandler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, ChallengePath+"token", nil))
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "token.thumbprint", rec.Body.String())
+
+	rec = httptest.NewRecorder()
+	a.HTTPHandler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, ChallengePath+"other", nil))
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+}
@@ -0,0 +1,106 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package certs
+
+import (
+	"crypto/tls"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/ubccr/grendel/internal/logger"
+)
+
+// File is a certificate and key loaded from PEM files, reloaded when either
+// file changes
+type File struct {
+	*holder
+	certFile string
+	keyFile  string
+
+	mu    sync.Mutex
+	stamp string
+}
+
+// LoadFile loads the certificate of service from certFile and keyFile
+func LoadFile(service, certFile, keyFile string) (*File, error) {
+	f := &File{certFile: certFile, keyFile: keyFile}
+
+	f.stamp = f.fileStamp()
+	cert, err := tls.LoadX509KeyPair(certFile, keyFile)
+	if err != nil {
+		return nil, err
+	}
+
+	f.holder = newHolder(service)
+	f.cert.Store(&cert)
+
+	return f, nil
+}
+
+// fileStamp identifies the contents of the files. Stat follows symlinks, so
+// swapping the target of a link is seen as a change
+func (f *File) fileStamp() string {
+	stamp := ""
+	for _, name := range []string{f.certFile, f.keyFile} {
+		fi, err := os.Stat(name)
+		if err != nil {
+			stamp += "missing;"
+			continue
+		}
+		stamp += fmt.Sprintf("%d:%d;", fi.ModTime().UnixNano(), fi.Size())
+	}
+
+	return stamp
+}
+
+// Reload loads the files again when they changed since they were last
+// loaded. An invalid certificate or key is logged and the current
+// certificate kept, files written one after the other are loaded once both
+// were written
+func (f *File) Reload() {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	stamp := f.fileStamp()
+	if stamp == f.stamp {
+		return
+	}
+	f.stamp = stamp
+
+	entry := log.WithFields(logrus.Fields{
+		logger.FieldService: f.service,
+		"cert":              f.certFile,
+		"key":               f.keyFile,
+	})
+
+	cert, err := tls.LoadX509KeyPair(f.certFile, f.keyFile)
+	if err != nil {
+		renewalsTotal.WithLabelValues(f.service, "failure").Inc()
+		entry.WithField("err", err).Error("Failed to reload certificate, keeping the current certificate")
+		return
+	}
+
+	f.cert.Store(&cert)
+	renewalsTotal.WithLabelValues(f.service, "success").Inc()
+	entry.WithField("expires", cert.Leaf.NotAfter.Format(time.RFC3339)).Info("Reloaded certificate")
+}
+
+// Watch reloads the files every interval until stop is closed
+func (f *File) Watch(interval time.Duration, stop <-chan struct{}) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-stop:
+			return
+		case <-ticker.C:
+			f.Reload()
+		}
+	}
+}
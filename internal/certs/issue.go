@@ -0,0 +1,386 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package certs
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/hex"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"math/big"
+	"net"
+	"os"
+	"slices"
+	"time"
+)
+
+const (
+	KeyTypeRSA   = "rsa"
+	KeyTypeECDSA = "ecdsa"
+
+	// rsaBits is the size of generated RSA keys
+	rsaBits = 2048
+
+	// backdate is subtracted from the start of the validity of issued
+	// certificates, for clients with clocks slightly behind
+	backdate = 5 * time.Minute
+)
+
+// CA is a certificate authority issuing the certificates of the services
+type CA struct {
+	Cert *x509.Certificate
+	Key  crypto.Signer
+
+	// Chain are the issuers of an intermediate CA up to the root, empty for
+	// a self-signed CA
+	Chain []*x509.Certificate
+}
+
+// Request are the names, lifetime and key type of a certificate to issue
+type Request struct {
+	CommonName  string
+	DNSNames    []string
+	IPAddresses []net.IP
+
+	// Days is the lifetime of the certificate
+	Days int
+
+	// KeyType is rsa or ecdsa, ecdsa when empty
+	KeyType string
+
+	// Client issues a client certificate instead of a server certificate
+	Client bool
+}
+
+// GenerateKey returns a new RSA 2048 or ECDSA P-256 private key
+func GenerateKey(keyType string) (crypto.Signer, error) {
+	switch keyType {
+	case KeyTypeECDSA, "":
+		return ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	case KeyTypeRSA:
+		return rsa.GenerateKey(rand.Reader, rsaBits)
+	}
+
+	return nil, fmt.Errorf("invalid key type %q. Valid key types: rsa, ecdsa", keyType)
+}
+
+// KeyType returns the key type of the public key of cert
+func KeyType(cert *x509.Certificate) string {
+	switch cert.PublicKeyAlgorithm {
+	case x509.RSA:
+		return KeyTypeRSA
+	case x509.ECDSA:
+		return KeyTypeECDSA
+	}
+
+	return cert.PublicKeyAlgorithm.String()
+}
+
+func serialNumber() (*big.Int, error) {
+	return rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 128))
+}
+
+// NewCA returns a self-signed certificate authority valid for days
+func NewCA(commonName string, days int, keyType string) (*CA, error) {
+	key, err := GenerateKey(keyType)
+	if err != nil {
+		return nil, err
+	}
+	serial, err := serialNumber()
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	template := &x509.Certificate{
+		SerialNumber:          serial,
+		Subject:               pkix.Name{CommonName: commonName},
+		NotBefore:             now.Add(-backdate),
+		NotAfter:              now.AddDate(0, 0, days),
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageCRLSign | x509.KeyUsageDigitalSignature,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+		MaxPathLenZero:        true,
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, template, key.Public(), key)
+	if err != nil {
+		return nil, err
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		return nil, err
+	}
+
+	return &CA{Cert: cert, Key: key}, nil
+}
+
+// LoadCA loads a certificate authority from PEM files. The certificate file
+// holds the CA certificate followed by the chain of an intermediate CA
+func LoadCA(certFile, keyFile string) (*CA, error) {
+	chain, err := LoadCertificates(certFile)
+	if err != nil {
+		return nil, err
+	}
+	key, err := LoadKey(keyFile)
+	if err != nil {
+		return nil, err
+	}
+
+	ca := &CA{Cert: chain[0], Key: key, Chain: chain[1:]}
+	if err := ca.Validate(); err != nil {
+		return nil, fmt.Errorf("%s: %w", certFile, err)
+	}
+
+	return ca, nil
+}
+
+// Validate checks the CA certificate has the CA basic constraint, matches
+// the key and that each certificate of the chain is signed by the next
+func (ca *CA) Validate() error {
+	if !ca.Cert.BasicConstraintsValid || !ca.Cert.IsCA {
+		return errors.New("not a CA certificate, the CA basic constraint is missing")
+	}
+
+	pub, ok := ca.Key.Public().(interface{ Equal(crypto.PublicKey) bool })
+	if !ok || !pub.Equal(ca.Cert.PublicKey) {
+		return errors.New("the key does not match the CA certificate")
+	}
+
+	issued := ca.Cert
+	for _, issuer := range ca.Chain {
+		if err := issued.CheckSignatureFrom(issuer); err != nil {
+			return fmt.Errorf("%s is not issued by %s in the chain: %w", issued.Subject, issuer.Subject, err)
+		}
+		issued = issuer
+	}
+
+	return nil
+}
+
+// Bundle returns the certificates following an issued certificate in its
+// file: the CA certificate and chain of an intermediate CA, without the
+// root, which clients already trust. A self-signed CA has none
+func (ca *CA) Bundle() []*x509.Certificate {
+	bundle := make([]*x509.Certificate, 0, len(ca.Chain)+1)
+	for _, cert := range append([]*x509.Certificate{ca.Cert}, ca.Chain...) {
+		if isSelfSigned(cert) {
+			break
+		}
+		bundle = append(bundle, cert)
+	}
+
+	return bundle
+}
+
+func isSelfSigned(cert *x509.Certificate) bool {
+	return bytes.Equal(cert.RawSubject, cert.RawIssuer) && cert.CheckSignatureFrom(cert) == nil
+}
+
+// Issue returns a new server or client certificate and its key signed by
+// the CA
+func (ca *CA) Issue(req Request) (*x509.Certificate, crypto.Signer, error) {
+	if req.Days <= 0 {
+		return nil, nil, errors.New("certificate lifetime must be at least a day")
+	}
+	if req.CommonName == "" && len(req.DNSNames) == 0 && len(req.IPAddresses) == 0 {
+		return nil, nil, errors.New("a common name or subject alternative name is required")
+	}
+
+	key, err := GenerateKey(req.KeyType)
+	if err != nil {
+		return nil, nil, err
+	}
+	serial, err := serialNumber()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Clients only check the SANs, the common name is always one of them
+	dnsNames := slices.Clone(req.DNSNames)
+	if req.CommonName != "" && net.ParseIP(req.CommonName) == nil && !slices.Contains(dnsNames, req.CommonName) {
+		dnsNames = append([]string{req.CommonName}, dnsNames...)
+	}
+
+	now := time.Now()
+	template := &x509.Certificate{
+		SerialNumber: serial,
+		Subject:      pkix.Name{CommonName: req.CommonName},
+		DNSNames:     dnsNames,
+		IPAddresses:  req.IPAddresses,
+		NotBefore:    now.Add(-backdate),
+		NotAfter:     now.AddDate(0, 0, req.Days),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+	}
+	if req.Client {
+		template.ExtKeyUsage = []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth}
+	}
+	if req.KeyType == KeyTypeRSA {
+		template.KeyUsage |= x509.KeyUsageKeyEncipherment
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, ca.Cert, key.Public(), ca.Key)
+	if err != nil {
+		return nil, nil, err
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return cert, key, nil
+}
+
+// RenewRequest returns the request re-issuing cert with the same names, key
+// type and lifetime
+func RenewRequest(cert *x509.Certificate) Request {
+	return Request{
+		CommonName:  cert.Subject.CommonName,
+		DNSNames:    cert.DNSNames,
+		IPAddresses: cert.IPAddresses,
+		Days:        int(cert.NotAfter.Sub(cert.NotBefore.Add(backdate)).Round(24*time.Hour).Hours() / 24),
+		KeyType:     KeyType(cert),
+	}
+}
+
+// Fingerprint returns the SHA-256 fingerprint of cert in lower case hex
+func Fingerprint(cert *x509.Certificate) string {
+	sum := sha256.Sum256(cert.Raw)
+	return hex.EncodeToString(sum[:])
+}
+
+// Due returns whether cert expires within window of now
+func Due(cert *x509.Certificate, window time.Duration, now time.Time) bool {
+	return !now.Add(window).Before(cert.NotAfter)
+}
+
+// LoadCertificate loads the first certificate of a PEM file
+func LoadCertificate(file string) (*x509.Certificate, error) {
+	data, err := os.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+
+	cert, err := ParseCertificate(data)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", file, err)
+	}
+
+	return cert, nil
+}
+
+// ParseCertificate parses the first certificate of PEM data
+func ParseCertificate(data []byte) (*x509.Certificate, error) {
+	for {
+		var block *pem.Block
+		block, data = pem.Decode(data)
+		if block == nil {
+			return nil, errors.New("no PEM certificate found")
+		}
+		if block.Type == "CERTIFICATE" {
+			return x509.ParseCertificate(block.Bytes)
+		}
+	}
+}
+
+// LoadCertificates loads all certificates of a PEM file in order
+func LoadCertificates(file string) ([]*x509.Certificate, error) {
+	data, err := os.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+
+	certs := make([]*x509.Certificate, 0, 1)
+	for {
+		var block *pem.Block
+		block, data = pem.Decode(data)
+		if block == nil {
+			break
+		}
+		if block.Type != "CERTIFICATE" {
+			continue
+		}
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", file, err)
+		}
+		certs = append(certs, cert)
+	}
+	if len(certs) == 0 {
+		return nil, fmt.Errorf("no PEM certificate found in %s", file)
+	}
+
+	return certs, nil
+}
+
+// LoadKey loads a PKCS #8, EC or PKCS #1 RSA private key from a PEM file
+func LoadKey(file string) (crypto.Signer, error) {
+	data, err := os.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, fmt.Errorf("no PEM key found in %s", file)
+	}
+
+	switch block.Type {
+	case "EC PRIVATE KEY":
+		return x509.ParseECPrivateKey(block.Bytes)
+	case "RSA PRIVATE KEY":
+		return x509.ParsePKCS1PrivateKey(block.Bytes)
+	}
+
+	key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, err
+	}
+	signer, ok := key.(crypto.Signer)
+	if !ok {
+		return nil, fmt.Errorf("unsupported key type in %s", file)
+	}
+
+	return signer, nil
+}
+
+// EncodePEM returns cert followed by chain and key PEM encoded
+func EncodePEM(cert *x509.Certificate, key crypto.Signer, chain ...*x509.Certificate) (certPEM []byte, keyPEM []byte, err error) {
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	for _, c := range append([]*x509.Certificate{cert}, chain...) {
+		certPEM = append(certPEM, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: c.Raw})...)
+	}
+
+	return certPEM, pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der}), nil
+}
+
+// WriteFiles replaces certFile and keyFile with cert followed by chain and
+// key, each file atomically. A service reloading the files in between keeps
+// its current certificate until both are written
+func WriteFiles(certFile, keyFile string, cert *x509.Certificate, key crypto.Signer, chain ...*x509.Certificate) error {
+	certPEM, keyPEM, err := EncodePEM(cert, key, chain...)
+	if err != nil {
+		return err
+	}
+
+	if err := writeFile(keyFile, keyPEM); err != nil {
+		return err
+	}
+
+	return writeFile(certFile, certPEM)
+}
@@ -0,0 +1,174 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package certs
+
+import (
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"net"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIssue(t *testing.T) {
+	for _, keyType := range []string{KeyTypeECDSA, KeyTypeRSA} {
+		t.Run(keyType, func(t *testing.T) {
+			ca, err := NewCA("Test CA", 30, keyType)
+			require.NoError(t, err)
+
+			cert, key, err := ca.Issue(Request{
+				CommonName:  "grendel.example.com",
+				DNSNames:    []string{"grendel", "grendel-vip.example.com"},
+				IPAddresses: []net.IP{net.ParseIP("10.0.0.254")},
+				Days:        90,
+				KeyType:     keyType,
+			})
+			require.NoError(t, err)
+			assert.Equal(t, keyType, KeyType(cert))
+			assert.Equal(t, []string{"grendel.example.com", "grendel", "grendel-vip.example.com"}, cert.DNSNames)
+			assert.NoError(t, cert.CheckSignatureFrom(ca.Cert))
+			assert.NoError(t, cert.VerifyHostname("grendel"))
+			assert.NoError(t, cert.VerifyHostname("10.0.0.254"))
+
+			dir := t.TempDir()
+			certFile, keyFile := filepath.Join(dir, "cert.pem"), filepath.Join(dir, "key.pem")
+			require.NoError(t, WriteFiles(certFile, keyFile, cert, key))
+			_, err = tls.LoadX509KeyPair(certFile, keyFile)
+			require.NoError(t, err)
+
+			loaded, err := LoadKey(keyFile)
+			require.NoError(t, err)
+			assert.Equal(t, key.Public(), loaded.Public())
+
+			req := RenewRequest(cert)
+			assert.Equal(t, 90, req.Days)
+			assert.Equal(t, keyType, req.KeyType)
+			assert.Equal(t, "grendel.example.com", req.CommonName)
+			assert.Equal(t, cert.DNSNames, req.DNSNames)
+			assert.True(t, req.IPAddresses[0].Equal(net.ParseIP("10.0.0.254")))
+		})
+	}
+
+	ca, err := NewCA("Test CA", 30, KeyTypeECDSA)
+	require.NoError(t, err)
+	_, _, err = ca.Issue(Request{CommonName: "grendel", Days: 0})
+	assert.Error(t, err)
+	_, _, err = ca.Issue(Request{CommonName: "grendel", Days: 1, KeyType: "dsa"})
+	assert.Error(t, err)
+
+	client, key, err := ca.Issue(Request{CommonName: "cpn-01.example.com", Days: 1, Client: true})
+	require.NoError(t, err)
+	assert.Equal(t, []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth}, client.ExtKeyUsage)
+	assert.Len(t, Fingerprint(client), 64)
+
+	certPEM, _, err := EncodePEM(client, key)
+	require.NoError(t, err)
+	parsed, err := ParseCertificate(certPEM)
+	require.NoError(t, err)
+	assert.Equal(t, Fingerprint(client), Fingerprint(parsed))
+}
+
+func TestDue(t *testing.T) {
+	ca, err := NewCA("Test CA", 30, KeyTypeECDSA)
+	require.NoError(t, err)
+	cert, _, err := ca.Issue(Request{CommonName: "grendel", Days: 60})
+	require.NoError(t, err)
+
+	now := time.Now()
+	assert.False(t, Due(cert, 30*24*time.Hour, now))
+	assert.True(t, Due(cert, 30*24*time.Hour, now.AddDate(0, 0, 31)))
+	assert.True(t, Due(cert, 0, now.AddDate(0, 0, 61)))
+}
+
+// newIntermediate returns an intermediate CA issued by parent, or a root
+// able to issue intermediates when parent is nil
+func newIntermediate(t *testing.T, parent *CA, commonName string) *CA {
+	key, err := GenerateKey(KeyTypeECDSA)
+	require.NoError(t, err)
+	serial, err := serialNumber()
+	require.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber:          serial,
+		Subject:               pkix.Name{CommonName: commonName},
+		NotBefore:             time.Now().Add(-backdate),
+		NotAfter:              time.Now().AddDate(0, 0, 30),
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageCRLSign,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	signer := parent
+	if parent == nil {
+		signer = &CA{Cert: template, Key: key}
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, signer.Cert, key.Public(), signer.Key)
+	require.NoError(t, err)
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+
+	if parent == nil {
+		return &CA{Cert: cert, Key: key}
+	}
+	return &CA{Cert: cert, Key: key, Chain: append([]*x509.Certificate{parent.Cert}, parent.Chain...)}
+}
+
+func TestIntermediateCA(t *testing.T) {
+	root := newIntermediate(t, nil, "Corporate Root")
+	assert.NoError(t, root.Validate())
+	assert.Empty(t, root.Bundle())
+
+	ca := newIntermediate(t, root, "Grendel Intermediate")
+	assert.NoError(t, ca.Validate())
+	assert.Equal(t, []*x509.Certificate{ca.Cert}, ca.Bundle(), "the root is left out of the bundle")
+
+	dir := t.TempDir()
+	caCertFile, caKeyFile := filepath.Join(dir, "ca.crt"), filepath.Join(dir, "ca.key")
+	require.NoError(t, WriteFiles(caCertFile, caKeyFile, ca.Cert, ca.Key, ca.Chain...))
+	loaded, err := LoadCA(caCertFile, caKeyFile)
+	require.NoError(t, err)
+	assert.True(t, loaded.Cert.Equal(ca.Cert))
+	require.Len(t, loaded.Chain, 1)
+	assert.True(t, loaded.Chain[0].Equal(root.Cert))
+
+	// Issued certificates are written with the intermediate and verify
+	// against the root alone
+	cert, key, err := loaded.Issue(Request{CommonName: "grendel.example.com", Days: 1})
+	require.NoError(t, err)
+	certFile, keyFile := filepath.Join(dir, "grendel.crt"), filepath.Join(dir, "grendel.key")
+	require.NoError(t, WriteFiles(certFile, keyFile, cert, key, loaded.Bundle()...))
+	pair, err := tls.LoadX509KeyPair(certFile, keyFile)
+	require.NoError(t, err)
+	require.Len(t, pair.Certificate, 2)
+
+	roots, intermediates := x509.NewCertPool(), x509.NewCertPool()
+	roots.AddCert(root.Cert)
+	issuer, err := x509.ParseCertificate(pair.Certificate[1])
+	require.NoError(t, err)
+	intermediates.AddCert(issuer)
+	_, err = cert.Verify(x509.VerifyOptions{DNSName: "grendel.example.com", Roots: roots, Intermediates: intermediates})
+	assert.NoError(t, err)
+}
+
+func TestValidateCA(t *testing.T) {
+	root := newIntermediate(t, nil, "Corporate Root")
+	other, err := NewCA("Other Root", 30, KeyTypeECDSA)
+	require.NoError(t, err)
+
+	assert.ErrorContains(t, (&CA{Cert: root.Cert, Key: other.Key}).Validate(), "does not match")
+
+	leaf, key, err := root.Issue(Request{CommonName: "grendel", Days: 1})
+	require.NoError(t, err)
+	assert.ErrorContains(t, (&CA{Cert: leaf, Key: key}).Validate(), "basic constraint")
+
+	ca := newIntermediate(t, root, "Grendel Intermediate")
+	ca.Chain = []*x509.Certificate{other.Cert}
+	assert.ErrorContains(t, ca.Validate(), "is not issued by")
+}
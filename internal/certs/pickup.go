@@ -0,0 +1,65 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package certs
+
+import (
+	"sync"
+	"time"
+)
+
+// Pickups are the client certificates and keys of hosts waiting to be
+// fetched from the provision server
+var Pickups = NewPickupStore()
+
+type pickup struct {
+	bundle  []byte
+	expires time.Time
+}
+
+// PickupStore holds a PEM bundle per host until it is fetched once or
+// expires. Bundles are kept in memory only, the keys are never stored
+type PickupStore struct {
+	mu      sync.Mutex
+	bundles map[string]pickup
+}
+
+// NewPickupStore returns an empty PickupStore
+func NewPickupStore() *PickupStore {
+	return &PickupStore{bundles: make(map[string]pickup)}
+}
+
+// Put holds bundle for host until it expires at expires, replacing a bundle
+// not yet fetched
+func (p *PickupStore) Put(host string, bundle []byte, expires time.Time) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.prune(time.Now())
+	p.bundles[host] = pickup{bundle: bundle, expires: expires}
+}
+
+// Take returns and removes the bundle of host, false when there is none or
+// it expired
+func (p *PickupStore) Take(host string, now time.Time) ([]byte, bool) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.prune(now)
+	b, ok := p.bundles[host]
+	if !ok {
+		return nil, false
+	}
+	delete(p.bundles, host)
+
+	return b.bundle, true
+}
+
+func (p *PickupStore) prune(now time.Time) {
+	for host, b := range p.bundles {
+		if !now.Before(b.expires) {
+			delete(p.bundles, host)
+		}
+	}
+}
@@ -0,0 +1,31 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package certs
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPickupStore(t *testing.T) {
+	p := NewPickupStore()
+	now := time.Now()
+
+	p.Put("cpn-01", []byte("bundle"), now.Add(time.Hour))
+	p.Put("cpn-02", []byte("bundle"), now.Add(time.Hour))
+
+	b, ok := p.Take("cpn-01", now)
+	assert.True(t, ok)
+	assert.Equal(t, []byte("bundle"), b)
+
+	// Bundles are fetched once
+	_, ok = p.Take("cpn-01", now)
+	assert.False(t, ok)
+
+	_, ok = p.Take("cpn-02", now.Add(time.Hour))
+	assert.False(t, ok, "expired bundles are not returned")
+}
@@ -0,0 +1,112 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package certs
+
+import (
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ubccr/grendel/pkg/model"
+)
+
+// Revocations are the serial numbers of the revoked certificates rejected by
+// the provision server
+var Revocations = NewRevocationList()
+
+// RevocationList is an in memory set of revoked serial numbers, replaced
+// whenever the revoked certificates in the data store change
+type RevocationList struct {
+	mu      sync.RWMutex
+	serials map[string]struct{}
+}
+
+// NewRevocationList returns an empty RevocationList
+func NewRevocationList() *RevocationList {
+	return &RevocationList{serials: make(map[string]struct{})}
+}
+
+// Set replaces the revoked serial numbers with those of revoked
+func (r *RevocationList) Set(revoked model.RevokedCertList) {
+	serials := make(map[string]struct{}, len(revoked))
+	for _, rc := range revoked {
+		serials[rc.Serial] = struct{}{}
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.serials = serials
+}
+
+// Revoked returns true if cert has been revoked
+func (r *RevocationList) Revoked(cert *x509.Certificate) bool {
+	return r.RevokedSerial(Serial(cert))
+}
+
+// RevokedSerial returns true if the certificate with the serial number in
+// the lower case hex of Serial has been revoked
+func (r *RevocationList) RevokedSerial(serial string) bool {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	_, ok := r.serials[serial]
+	return ok
+}
+
+// Serial returns the serial number of cert in lower case hex
+func Serial(cert *x509.Certificate) string {
+	return cert.SerialNumber.Text(16)
+}
+
+// ParseSerial returns a hex serial number, optionally colon separated as
+// printed by openssl, in the lower case hex of Serial
+func ParseSerial(s string) (string, error) {
+	hex := strings.ReplaceAll(strings.TrimSpace(s), ":", "")
+	n, ok := new(big.Int).SetString(hex, 16)
+	if !ok || n.Sign() <= 0 {
+		return "", fmt.Errorf("invalid serial number %q", s)
+	}
+
+	return n.Text(16), nil
+}
+
+// CRL returns a PEM encoded certificate revocation list of revoked signed by
+// the CA, valid for days from now
+func (ca *CA) CRL(revoked model.RevokedCertList, days int, now time.Time) ([]byte, error) {
+	if days <= 0 {
+		return nil, errors.New("revocation list lifetime must be at least a day")
+	}
+
+	entries := make([]x509.RevocationListEntry, 0, len(revoked))
+	for _, rc := range revoked {
+		serial, ok := new(big.Int).SetString(rc.Serial, 16)
+		if !ok {
+			return nil, fmt.Errorf("invalid serial number %q", rc.Serial)
+		}
+		entries = append(entries, x509.RevocationListEntry{
+			SerialNumber:   serial,
+			RevocationTime: rc.RevokedAt,
+		})
+	}
+
+	template := &x509.RevocationList{
+		RevokedCertificateEntries: entries,
+		Number:                    big.NewInt(now.Unix()),
+		ThisUpdate:                now,
+		NextUpdate:                now.AddDate(0, 0, days),
+	}
+	der, err := x509.CreateRevocationList(rand.Reader, template, ca.Cert, ca.Key)
+	if err != nil {
+		return nil, err
+	}
+
+	return pem.EncodeToMemory(&pem.Block{Type: "X509 CRL", Bytes: der}), nil
+}
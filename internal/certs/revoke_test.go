@@ -0,0 +1,70 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package certs
+
+import (
+	"crypto/x509"
+	"encoding/pem"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/ubccr/grendel/pkg/model"
+)
+
+func TestParseSerial(t *testing.T) {
+	for in, want := range map[string]string{
+		"3f9a0c21":     "3f9a0c21",
+		"3F:9A:0C:21":  "3f9a0c21",
+		" 00:0a:ff ":   "aff",
+		"7B56F4C450D7": "7b56f4c450d7",
+	} {
+		got, err := ParseSerial(in)
+		if assert.NoError(t, err, in) {
+			assert.Equal(t, want, got, in)
+		}
+	}
+
+	for _, in := range []string{"", "xyz", "00", "-1f"} {
+		_, err := ParseSerial(in)
+		assert.Error(t, err, in)
+	}
+}
+
+func TestRevocations(t *testing.T) {
+	ca, err := NewCA("Test CA", 30, KeyTypeECDSA)
+	require.NoError(t, err)
+	cert, _, err := ca.Issue(Request{CommonName: "cpn-01", Days: 1, Client: true})
+	require.NoError(t, err)
+	other, _, err := ca.Issue(Request{CommonName: "cpn-02", Days: 1, Client: true})
+	require.NoError(t, err)
+
+	r := NewRevocationList()
+	assert.False(t, r.Revoked(cert))
+
+	now := time.Now().Truncate(time.Second)
+	revoked := model.RevokedCertList{{Serial: Serial(cert), Host: "cpn-01", RevokedAt: now}}
+	r.Set(revoked)
+	assert.True(t, r.Revoked(cert))
+	assert.False(t, r.Revoked(other))
+	assert.True(t, r.RevokedSerial(Serial(cert)))
+
+	data, err := ca.CRL(revoked, 7, now)
+	require.NoError(t, err)
+	block, _ := pem.Decode(data)
+	require.NotNil(t, block)
+	assert.Equal(t, "X509 CRL", block.Type)
+
+	crl, err := x509.ParseRevocationList(block.Bytes)
+	require.NoError(t, err)
+	assert.NoError(t, crl.CheckSignatureFrom(ca.Cert))
+	require.Len(t, crl.RevokedCertificateEntries, 1)
+	assert.Equal(t, cert.SerialNumber, crl.RevokedCertificateEntries[0].SerialNumber)
+	assert.True(t, now.AddDate(0, 0, 7).Equal(crl.NextUpdate))
+
+	_, err = ca.CRL(revoked, 0, now)
+	assert.Error(t, err)
+}
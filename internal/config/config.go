@@ -9,47 +9,143 @@ import (
 	"net"
 	"net/netip"
 	"strings"
+	"sync/atomic"
 
 	"github.com/spf13/viper"
+	"github.com/ubccr/grendel/internal/firmware"
+	"github.com/ubccr/grendel/internal/logger"
 )
 
-var (
-	ProvisionAddr       netip.AddrPort = netip.MustParseAddrPort("0.0.0.0:80")
-	ProvisionScheme     string         = "http"
-	ProvisionHostname   string         = ""
-	Subnets             []Subnet       = []Subnet{}
-	DefaultDNS          []net.IP       = []net.IP{}
-	DefaultDomainSearch []string       = []string{}
-	DefaultMTU          uint16         = 1500
+// Settings are the values parsed from the configuration shared by the
+// services. A reload swaps in new Settings as a whole, they must not be
+// modified once passed to Set
+type Settings struct {
+	ProvisionAddr       netip.AddrPort
+	ProvisionScheme     string
+	ProvisionHostname   string
+	Subnets             []Subnet
+	DefaultDNS          []net.IP
+	DefaultDomainSearch []string
+	DefaultMTU          uint16
 	DefaultGateway      netip.Addr
-)
+
+	// RouterOctet4 replaces the last octet of the last address of an IPv4
+	// subnet not in Subnets to give its gateway, unless negative
+	RouterOctet4 int
+
+	// FirmwareTags and FirmwareImages map host tags and boot image names to
+	// the firmware overriding the firmware detected from the client
+	// architecture
+	FirmwareTags   map[string]firmware.Build
+	FirmwareImages map[string]firmware.Build
+}
 
 type Subnet struct {
 	Gateway      netip.Prefix
 	DNS          []net.IP
 	DomainSearch []string
 	MTU          uint16
+
+	// AdvertiseIP is the server address sent to clients in the subnet for
+	// TFTP and the provision URLs, when set
+	AdvertiseIP netip.Addr
+}
+
+var current atomic.Pointer[Settings]
+
+func init() {
+	current.Store(&Settings{
+		ProvisionAddr:       netip.MustParseAddrPort("0.0.0.0:80"),
+		ProvisionScheme:     "http",
+		Subnets:             []Subnet{},
+		DefaultDNS:          []net.IP{},
+		DefaultDomainSearch: []string{},
+		DefaultMTU:          1500,
+		RouterOctet4:        -1,
+		FirmwareTags:        map[string]firmware.Build{},
+		FirmwareImages:      map[string]firmware.Build{},
+	})
+}
+
+// Current returns the settings in effect. The returned settings never change,
+// a reload swaps in new ones
+func Current() *Settings {
+	return current.Load()
+}
+
+// live is the configuration in effect once reloaded. Until then it is the
+// global viper configuration read at startup
+var live atomic.Pointer[viper.Viper]
+
+// Viper returns the configuration in effect. A reload swaps in a new
+// configuration rather than changing the returned one, which may be read
+// while reloading
+func Viper() *viper.Viper {
+	if v := live.Load(); v != nil {
+		return v
+	}
+
+	return viper.GetViper()
+}
+
+// ResetViper drops the reloaded configuration, Viper returns the global
+// viper configuration again. Tests replacing the global configuration call it
+func ResetViper() {
+	live.Store(nil)
+}
+
+// SetEnv reads the settings of v from GRENDEL_ environment variables, which
+// override the configuration file
+func SetEnv(v *viper.Viper) {
+	v.AutomaticEnv()
+	v.SetEnvPrefix("grendel")
+	v.SetEnvKeyReplacer(strings.NewReplacer(".", "_"))
 }
 
+// Set replaces the settings in effect and returns the previous settings
+func Set(s *Settings) *Settings {
+	return current.Swap(s)
+}
+
+// ParseConfigs sets the settings from the global viper configuration.
+// Nothing is changed when the configuration is invalid
 func ParseConfigs() error {
+	s, err := parse(viper.GetViper())
+	if err != nil {
+		return err
+	}
+
+	Set(s)
+	logger.SetLoggers(viper.GetStringMapString("loggers"))
+
+	return nil
+}
+
+func parse(v *viper.Viper) (*Settings, error) {
 	type SubnetConfig struct {
 		Gateway      string
 		DNS          string
 		DomainSearch string
 		MTU          uint16
+		AdvertiseIP  string `mapstructure:"advertise_ip"`
 	}
 	var subnetConfigs []SubnetConfig
 
-	err := viper.UnmarshalKey("dhcp.subnets", &subnetConfigs)
+	err := v.UnmarshalKey("dhcp.subnets", &subnetConfigs)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	Subnets = make([]Subnet, 0)
+	s := &Settings{
+		ProvisionScheme: "http",
+		Subnets:         make([]Subnet, 0),
+		DefaultDNS:      make([]net.IP, 0),
+		RouterOctet4:    -1,
+	}
 	for _, sc := range subnetConfigs {
 		gw, err := netip.ParsePrefix(sc.Gateway)
 		if err != nil {
-			return fmt.Errorf("Failed parsing dhcp.subnets config. Invalid gateway: %s", sc.Gateway)
+			return nil, fmt.Errorf("Failed parsing dhcp.subnets config. Invalid gateway: %s", sc.Gateway)
 		}
 		dnsServers := make([]net.IP, 0)
 		for _, dnsIP := range strings.Split(sc.DNS, ",") {
@@ -59,7 +155,7 @@ func ParseConfigs() error {
 
 			d, err := netip.ParseAddr(dnsIP)
 			if err != nil {
-				return fmt.Errorf("Failed parsing dhcp.subnets config. Invalid dns: %s", dnsIP)
+				return nil, fmt.Errorf("Failed parsing dhcp.subnets config. Invalid dns: %s", dnsIP)
 			}
 			dnsServers = append(dnsServers, net.IP(d.AsSlice()))
 		}
@@ -72,39 +168,76 @@ func ParseConfigs() error {
 			domainSearch = append(domainSearch, domain)
 		}
 
-		Subnets = append(Subnets, Subnet{Gateway: gw, DNS: dnsServers, DomainSearch: domainSearch, MTU: sc.MTU})
+		var advertiseIP netip.Addr
+		if sc.AdvertiseIP != "" {
+			advertiseIP, err = netip.ParseAddr(sc.AdvertiseIP)
+			if err != nil || !advertiseIP.Is4() {
+				return nil, fmt.Errorf("Failed parsing dhcp.subnets config. Invalid advertise_ip: %s", sc.AdvertiseIP)
+			}
+		}
+
+		s.Subnets = append(s.Subnets, Subnet{Gateway: gw, DNS: dnsServers, DomainSearch: domainSearch, MTU: sc.MTU, AdvertiseIP: advertiseIP})
 	}
 
-	DefaultDNS = make([]net.IP, 0)
-	for _, dnsIP := range viper.GetStringSlice("dhcp.dns_servers") {
+	for _, dnsIP := range v.GetStringSlice("dhcp.dns_servers") {
 		d, err := netip.ParseAddr(dnsIP)
 		if err != nil {
-			return fmt.Errorf("Failed parsing dhcp.dns_servers config. Invalid dns: %s", dnsIP)
+			return nil, fmt.Errorf("Failed parsing dhcp.dns_servers config. Invalid dns: %s", dnsIP)
 		}
-		DefaultDNS = append(DefaultDNS, net.IP(d.AsSlice()))
+		s.DefaultDNS = append(s.DefaultDNS, net.IP(d.AsSlice()))
 	}
 
-	DefaultDomainSearch = viper.GetStringSlice("dhcp.domain_search")
-	DefaultMTU = uint16(viper.GetInt("dhcp.mtu"))
+	s.DefaultDomainSearch = v.GetStringSlice("dhcp.domain_search")
+	s.DefaultMTU = uint16(v.GetInt("dhcp.mtu"))
 
-	addrPort, err := netip.ParseAddrPort(viper.GetString("provision.listen"))
+	s.ProvisionAddr, err = netip.ParseAddrPort(v.GetString("provision.listen"))
 	if err != nil {
-		return fmt.Errorf("Failed parsing provision.listen address %s: %w", viper.GetString("provision.listen"), err)
+		return nil, fmt.Errorf("Failed parsing provision.listen address %s: %w", v.GetString("provision.listen"), err)
 	}
 
-	if viper.IsSet("dhcp.gateway") {
-		DefaultGateway, err = netip.ParseAddr(viper.GetString("dhcp.gateway"))
+	if v.IsSet("dhcp.gateway") {
+		s.DefaultGateway, err = netip.ParseAddr(v.GetString("dhcp.gateway"))
 		if err != nil {
-			return fmt.Errorf("Failed parsing dhcp.gateway %s: %w", viper.GetString("dhcp.gateway"), err)
+			return nil, fmt.Errorf("Failed parsing dhcp.gateway %s: %w", v.GetString("dhcp.gateway"), err)
 		}
 	}
 
-	ProvisionHostname = viper.GetString("provision.hostname")
-	ProvisionAddr = addrPort
+	s.ProvisionHostname = v.GetString("provision.hostname")
 
-	if viper.IsSet("provision.cert") && viper.IsSet("provision.key") {
-		ProvisionScheme = "https"
+	if v.IsSet("dhcp.router_octet4") {
+		s.RouterOctet4 = v.GetInt("dhcp.router_octet4")
+		if s.RouterOctet4 < 0 || s.RouterOctet4 > 255 {
+			return nil, fmt.Errorf("Failed parsing dhcp.router_octet4 %d: must be between 0 and 255", s.RouterOctet4)
+		}
 	}
 
-	return nil
+	s.FirmwareTags, err = parseFirmwareMap(v, "firmware.tags")
+	if err != nil {
+		return nil, err
+	}
+	s.FirmwareImages, err = parseFirmwareMap(v, "firmware.images")
+	if err != nil {
+		return nil, err
+	}
+
+	if v.IsSet("provision.cert") && v.IsSet("provision.key") {
+		s.ProvisionScheme = "https"
+	}
+
+	return s, nil
+}
+
+// parseFirmwareMap returns the firmware builds of the name to firmware map
+// of key
+func parseFirmwareMap(v *viper.Viper, key string) (map[string]firmware.Build, error) {
+	builds := make(map[string]firmware.Build)
+	for name, fw := range v.GetStringMapString(key) {
+		b, err := firmware.Parse(fw)
+		if err != nil {
+			return nil, fmt.Errorf("Failed parsing %s config for %s: %w", key, name, err)
+		}
+		builds[name] = b
+	}
+
+	return builds, nil
 }
@@ -0,0 +1,71 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package config
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/ubccr/grendel/internal/firmware"
+)
+
+func TestParseSubnets(t *testing.T) {
+	read := func(data string) (*Settings, error) {
+		v := viper.New()
+		v.SetConfigType("toml")
+		require.NoError(t, v.ReadConfig(strings.NewReader(data)))
+		v.SetDefault("provision.listen", "0.0.0.0:80")
+		return parse(v)
+	}
+
+	s, err := read(`
+[dhcp]
+subnets = [
+    {gateway = "10.2.0.254/24", dns = "10.2.0.1", advertise_ip = "10.0.0.2"},
+    {gateway = "10.3.0.254/24", mtu = 9000},
+]
+`)
+	require.NoError(t, err)
+	if assert.Len(t, s.Subnets, 2) {
+		assert.Equal(t, "10.0.0.2", s.Subnets[0].AdvertiseIP.String())
+		assert.Equal(t, "10.2.0.1", s.Subnets[0].DNS[0].String())
+		assert.False(t, s.Subnets[1].AdvertiseIP.IsValid())
+		assert.Equal(t, uint16(9000), s.Subnets[1].MTU)
+	}
+
+	_, err = read(`
+[dhcp]
+subnets = [{gateway = "10.2.0.254/24", advertise_ip = "fd00::1"}]
+`)
+	assert.ErrorContains(t, err, "Invalid advertise_ip: fd00::1")
+}
+
+func TestParseFirmware(t *testing.T) {
+	read := func(data string) (*Settings, error) {
+		v := viper.New()
+		v.SetConfigType("toml")
+		require.NoError(t, v.ReadConfig(strings.NewReader(data)))
+		v.SetDefault("provision.listen", "0.0.0.0:80")
+		return parse(v)
+	}
+
+	s, err := read(`
+[firmware]
+tags = {r650 = "snponly-x86_64.efi"}
+images = {rocky9 = "ipxe-x86_64.efi"}
+`)
+	require.NoError(t, err)
+	assert.Equal(t, firmware.SNPONLYx86_64, s.FirmwareTags["r650"])
+	assert.Equal(t, firmware.EFI64, s.FirmwareImages["rocky9"])
+
+	_, err = read(`
+[firmware]
+tags = {r650 = "snponly.efi"}
+`)
+	assert.ErrorContains(t, err, `invalid firmware "snponly.efi", valid names: ipxe-i386.efi, ipxe-x86_64.efi, ipxe.pxe, snponly-arm64.efi, snponly-x86_64.efi, undionly.kpxe`)
+}
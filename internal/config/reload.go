@@ -0,0 +1,267 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package config
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"reflect"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/spf13/pflag"
+	"github.com/spf13/viper"
+	"github.com/ubccr/grendel/internal/logger"
+)
+
+var log = logger.GetLogger("CONFIG")
+
+// RestartRequired are the settings only read when a service starts. Changes
+// to them are logged on reload and take effect on the next restart
+var RestartRequired = []string{
+	"api.cert",
+	"api.cors",
+	"api.enabled",
+	"api.key",
+	"api.listen",
+	"api.socket_path",
+	"api.store_timeout",
+	"api.swagger_ui",
+	"bmc.monitor_fanout",
+	"bmc.monitor_interval",
+	"bmc.subscription_interval",
+	"cache",
+	"cache_ttl",
+	"dbpath",
+	"dbtype",
+	"debug.allow_remote",
+	"debug.enabled",
+	"debug.listen",
+	"dhcp.enabled",
+	"dhcp.listen",
+	"dhcp.proxy_only",
+	"dns.enabled",
+	"dns.listen",
+	"dns.ttl",
+	"dsn",
+	"group",
+	"ha.failover_timeout",
+	"ha.heartbeat_interval",
+	"ha.name",
+	"ha.role",
+	"logging.compress",
+	"logging.file",
+	"logging.format",
+	"logging.max_age",
+	"logging.max_backups",
+	"logging.max_size",
+	"metrics.enabled",
+	"metrics.listen",
+	"namespaces",
+	"provision.acme.ca_cert",
+	"provision.acme.cache_dir",
+	"provision.acme.challenge",
+	"provision.acme.directory_url",
+	"provision.acme.domains",
+	"provision.acme.email",
+	"provision.acme.enabled",
+	"provision.acme.http_listen",
+	"provision.cert",
+	"provision.enabled",
+	"provision.key",
+	"provision.listen",
+	"provision.repo_dir",
+	"provision.store_timeout",
+	"pxe.enabled",
+	"pxe.listen",
+	"readonly",
+	"readonly_primary",
+	"schedule.concurrency",
+	"schedule.grace_period",
+	"services",
+	"tftp.enabled",
+	"tftp.listen",
+	"user",
+	"webhook.queue_size",
+	"webhook.workers",
+}
+
+// Reloader checks the settings of a running service in a candidate
+// configuration and returns a function applying them. Reloaders must not
+// change anything until apply is called, which happens once every reloader
+// accepted the configuration
+type Reloader func(v *viper.Viper) (apply func(), err error)
+
+// ReloadResult lists the settings changed by a reload
+type ReloadResult struct {
+	Changed         []string
+	RestartRequired []string
+}
+
+var (
+	reloadMu  sync.Mutex
+	reloaders []Reloader
+	defaults  map[string]any
+	flags     = make(map[string]*pflag.Flag)
+)
+
+// BindPFlag binds key of the global viper configuration to flag, like
+// viper.BindPFlag. The flag is bound again on reload so it keeps overriding
+// the configuration file
+func BindPFlag(key string, flag *pflag.Flag) error {
+	reloadMu.Lock()
+	defer reloadMu.Unlock()
+
+	if err := viper.BindPFlag(key, flag); err != nil {
+		return err
+	}
+	flags[key] = flag
+
+	return nil
+}
+
+// SaveDefaults records the settings of v before the configuration file is
+// read: the defaults, flags and environment. A reload falls back to them for
+// the settings removed from the file
+func SaveDefaults(v *viper.Viper) {
+	reloadMu.Lock()
+	defer reloadMu.Unlock()
+
+	defaults = v.AllSettings()
+}
+
+// OnReload registers r to be called on every Reload
+func OnReload(r Reloader) {
+	reloadMu.Lock()
+	defer reloadMu.Unlock()
+
+	reloaders = append(reloaders, r)
+}
+
+// Reload re-reads the configuration file into a new configuration and swaps
+// it in for the package variables, the registered reloaders and Viper. The
+// configuration is checked in full first: when the file or any setting is
+// invalid nothing is changed and the error is returned
+func Reload() (*ReloadResult, error) {
+	reloadMu.Lock()
+	defer reloadMu.Unlock()
+
+	current := Viper()
+	file := current.ConfigFileUsed()
+	if file == "" {
+		return nil, errors.New("no configuration file to reload")
+	}
+
+	data, err := os.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+
+	candidate, err := newCandidate(current, file, data)
+	if err != nil {
+		return nil, err
+	}
+
+	s, err := parse(candidate)
+	if err != nil {
+		return nil, err
+	}
+
+	applies := make([]func(), 0, len(reloaders))
+	for _, r := range reloaders {
+		apply, err := r(candidate)
+		if err != nil {
+			return nil, err
+		}
+		applies = append(applies, apply)
+	}
+
+	result := diff(current, candidate)
+
+	live.Store(candidate)
+	Set(s)
+	logger.SetLoggers(candidate.GetStringMapString("loggers"))
+	for _, apply := range applies {
+		apply()
+	}
+
+	log.Infof("Reloaded %s, %d settings changed", file, len(result.Changed))
+	for _, key := range result.RestartRequired {
+		log.Warnf("Setting %s changed, restart grendel to apply it", key)
+	}
+
+	return result, nil
+}
+
+// newCandidate returns a configuration of the file data. The settings the
+// current configuration did not read from its file, like defaults,
+// environment and overrides, are kept and the flags bound with BindPFlag
+// still override the file. Settings removed from the file fall back to the
+// value saved by SaveDefaults
+func newCandidate(current *viper.Viper, file string, data []byte) (*viper.Viper, error) {
+	candidate := viper.New()
+	candidate.SetConfigFile(file)
+	SetEnv(candidate)
+	for key, flag := range flags {
+		if err := candidate.BindPFlag(key, flag); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, key := range current.AllKeys() {
+		if !current.InConfig(key) {
+			candidate.SetDefault(key, current.Get(key))
+			continue
+		}
+		if value, ok := lookup(defaults, key); ok {
+			candidate.SetDefault(key, value)
+		}
+	}
+
+	if err := candidate.ReadConfig(bytes.NewReader(data)); err != nil {
+		return nil, fmt.Errorf("failed parsing %s: %w", file, err)
+	}
+
+	return candidate, nil
+}
+
+// lookup returns the value of the dotted key in the nested settings
+func lookup(settings map[string]any, key string) (any, bool) {
+	path := strings.Split(key, ".")
+	for _, name := range path[:len(path)-1] {
+		next, ok := settings[name].(map[string]any)
+		if !ok {
+			return nil, false
+		}
+		settings = next
+	}
+
+	value, ok := settings[path[len(path)-1]]
+	return value, ok
+}
+
+// diff returns the keys whose values differ between old and new
+func diff(old, new *viper.Viper) *ReloadResult {
+	keys := append(old.AllKeys(), new.AllKeys()...)
+	slices.Sort(keys)
+	keys = slices.Compact(keys)
+
+	result := &ReloadResult{Changed: []string{}, RestartRequired: []string{}}
+	for _, key := range keys {
+		if reflect.DeepEqual(old.Get(key), new.Get(key)) {
+			continue
+		}
+
+		result.Changed = append(result.Changed, key)
+		if slices.Contains(RestartRequired, key) {
+			result.RestartRequired = append(result.RestartRequired, key)
+		}
+	}
+
+	return result
+}
@@ -0,0 +1,153 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package config
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/spf13/pflag"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReload(t *testing.T) {
+	viper.Reset()
+	defer viper.Reset()
+	defer ResetViper()
+	defer func() { reloaders = nil }()
+
+	file := filepath.Join(t.TempDir(), "grendel.toml")
+	write := func(data string) {
+		require.NoError(t, os.WriteFile(file, []byte(data), 0o600))
+	}
+
+	write(`
+[dhcp]
+listen = "0.0.0.0:67"
+lease_time = "24h"
+dns_servers = ["10.0.0.1"]
+mtu = 9000
+
+[provision]
+listen = "0.0.0.0:80"
+`)
+	viper.SetConfigFile(file)
+	viper.SetDefault("dhcp.mtu", 1500)
+	SaveDefaults(viper.GetViper())
+	require.NoError(t, viper.ReadInConfig())
+	viper.Set("api.secret", "generated")
+	require.NoError(t, ParseConfigs())
+	assert.Equal(t, "10.0.0.1", Current().DefaultDNS[0].String())
+	assert.Equal(t, uint16(9000), Current().DefaultMTU)
+
+	leaseTime := ""
+	OnReload(func(v *viper.Viper) (func(), error) {
+		lt := v.GetString("dhcp.lease_time")
+		if lt == "never" {
+			return nil, errors.New("invalid lease time")
+		}
+		return func() { leaseTime = lt }, nil
+	})
+
+	// A setting rejected by a reloader leaves everything as it was
+	write(`
+[dhcp]
+listen = "0.0.0.0:67"
+lease_time = "never"
+dns_servers = ["10.0.0.2"]
+
+[provision]
+listen = "0.0.0.0:80"
+`)
+	_, err := Reload()
+	assert.ErrorContains(t, err, "invalid lease time")
+	assert.Equal(t, "24h", Viper().GetString("dhcp.lease_time"))
+	assert.Equal(t, "10.0.0.1", Current().DefaultDNS[0].String())
+	assert.Equal(t, "", leaseTime)
+
+	// So does a setting rejected by the package
+	write(`
+[dhcp]
+listen = "0.0.0.0:67"
+lease_time = "12h"
+dns_servers = ["10.0.0.x"]
+
+[provision]
+listen = "0.0.0.0:80"
+`)
+	_, err = Reload()
+	assert.ErrorContains(t, err, "Invalid dns: 10.0.0.x")
+	assert.Equal(t, "24h", Viper().GetString("dhcp.lease_time"))
+	assert.Equal(t, "", leaseTime)
+
+	write(`
+[dhcp]
+listen = "0.0.0.0:1067"
+lease_time = "12h"
+dns_servers = ["10.0.0.2"]
+
+[provision]
+listen = "0.0.0.0:80"
+`)
+	result, err := Reload()
+	require.NoError(t, err)
+	assert.Equal(t, []string{"dhcp.dns_servers", "dhcp.lease_time", "dhcp.listen", "dhcp.mtu"}, result.Changed)
+	assert.Equal(t, []string{"dhcp.listen"}, result.RestartRequired)
+	assert.Equal(t, "12h", leaseTime)
+	assert.Equal(t, "12h", Viper().GetString("dhcp.lease_time"))
+	assert.Equal(t, "10.0.0.2", Current().DefaultDNS[0].String())
+	// Settings removed from the file fall back to their default, overrides
+	// are kept and the startup configuration is left alone
+	assert.Equal(t, uint16(1500), Current().DefaultMTU)
+	assert.Equal(t, "generated", Viper().GetString("api.secret"))
+	assert.Equal(t, "24h", viper.GetString("dhcp.lease_time"))
+}
+
+func TestReloadFlags(t *testing.T) {
+	viper.Reset()
+	defer viper.Reset()
+	defer ResetViper()
+	defer func() { flags = make(map[string]*pflag.Flag) }()
+
+	file := filepath.Join(t.TempDir(), "grendel.toml")
+	write := func(data string) {
+		require.NoError(t, os.WriteFile(file, []byte(data), 0o600))
+	}
+
+	write(`
+[dhcp]
+lease_time = "24h"
+
+[provision]
+listen = "0.0.0.0:80"
+`)
+	fs := pflag.NewFlagSet("serve", pflag.ContinueOnError)
+	fs.String("dhcp-lease-time", "1h", "")
+	require.NoError(t, fs.Parse([]string{"--dhcp-lease-time", "48h"}))
+	require.NoError(t, BindPFlag("dhcp.lease_time", fs.Lookup("dhcp-lease-time")))
+
+	viper.SetConfigFile(file)
+	SaveDefaults(viper.GetViper())
+	require.NoError(t, viper.ReadInConfig())
+	require.NoError(t, ParseConfigs())
+	assert.Equal(t, "48h", Viper().GetString("dhcp.lease_time"))
+
+	// The flag still overrides the file, which changed nothing
+	write(`
+[dhcp]
+lease_time = "12h"
+
+[provision]
+listen = "0.0.0.0:80"
+`)
+	result, err := Reload()
+	require.NoError(t, err)
+	assert.Empty(t, result.Changed)
+	assert.Equal(t, "48h", Viper().GetString("dhcp.lease_time"))
+}
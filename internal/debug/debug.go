@@ -0,0 +1,85 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+// Package debug serves the pprof profiles and expvar variables of grendel
+// serve on a separate listener, meant for localhost only
+package debug
+
+import (
+	"expvar"
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/pprof"
+	"net/netip"
+	"runtime"
+	"strings"
+)
+
+const (
+	DefaultListen = "127.0.0.1:6060"
+
+	// PprofPath and VarsPath are the paths of the profiles and variables
+	PprofPath = "/debug/pprof/"
+	VarsPath  = "/debug/vars"
+
+	// mutexProfileFraction samples 1 in mutexProfileFraction contention
+	// events for the mutex profile
+	mutexProfileFraction = 5
+)
+
+// Profiles are the profile types served under PprofPath
+var Profiles = []string{"cpu", "trace", "heap", "allocs", "goroutine", "mutex", "threadcreate"}
+
+// CheckListen returns an error when address is not a loopback address,
+// unless allowRemote is set
+func CheckListen(address string, allowRemote bool) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return fmt.Errorf("invalid debug.listen address %s: %w", address, err)
+	}
+
+	if allowRemote || host == "localhost" {
+		return nil
+	}
+
+	ip, err := netip.ParseAddr(host)
+	if err != nil || !ip.IsLoopback() {
+		return fmt.Errorf("debug.listen address %s is not a loopback address, set debug.allow_remote to expose the debug endpoints on other addresses", address)
+	}
+
+	return nil
+}
+
+// NewHandler returns the handler serving the pprof profiles and expvar
+// variables. Mutex contention sampling is turned on
+func NewHandler() http.Handler {
+	runtime.SetMutexProfileFraction(mutexProfileFraction)
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET "+PprofPath, pprof.Index)
+	mux.HandleFunc("GET "+PprofPath+"cmdline", pprof.Cmdline)
+	mux.HandleFunc("GET "+PprofPath+"profile", pprof.Profile)
+	mux.HandleFunc("GET "+PprofPath+"symbol", pprof.Symbol)
+	mux.HandleFunc("POST "+PprofPath+"symbol", pprof.Symbol)
+	mux.HandleFunc("GET "+PprofPath+"trace", pprof.Trace)
+	mux.Handle("GET "+VarsPath, expvar.Handler())
+
+	return mux
+}
+
+// ProfilePath returns the path of a profile type and whether it is sampled
+// over a duration
+func ProfilePath(profile string) (string, bool, error) {
+	switch profile {
+	case "cpu":
+		return PprofPath + "profile", true, nil
+	case "trace":
+		return PprofPath + "trace", true, nil
+	case "heap", "allocs", "goroutine", "mutex", "threadcreate":
+		return PprofPath + profile, false, nil
+	}
+
+	return "", false, fmt.Errorf("unknown profile type %q, valid types: %s", profile, strings.Join(Profiles, ", "))
+}
@@ -0,0 +1,62 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package debug
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCheckListen(t *testing.T) {
+	assert.NoError(t, CheckListen("127.0.0.1:6060", false))
+	assert.NoError(t, CheckListen("[::1]:6060", false))
+	assert.NoError(t, CheckListen("localhost:6060", false))
+	assert.ErrorContains(t, CheckListen("0.0.0.0:6060", false), "not a loopback address")
+	assert.ErrorContains(t, CheckListen(":6060", false), "not a loopback address")
+	assert.ErrorContains(t, CheckListen("10.0.0.1:6060", false), "not a loopback address")
+	assert.ErrorContains(t, CheckListen("grendel.example.com:6060", false), "not a loopback address")
+	assert.NoError(t, CheckListen("0.0.0.0:6060", true))
+	assert.Error(t, CheckListen("127.0.0.1", false))
+}
+
+func TestHandler(t *testing.T) {
+	srv := httptest.NewServer(NewHandler())
+	defer srv.Close()
+
+	for _, profile := range []string{"heap", "goroutine", "mutex", "allocs", "threadcreate"} {
+		path, sampled, err := ProfilePath(profile)
+		assert.NoError(t, err)
+		assert.False(t, sampled)
+
+		res, err := http.Get(srv.URL + path)
+		if assert.NoError(t, err) {
+			res.Body.Close()
+			assert.Equal(t, http.StatusOK, res.StatusCode, profile)
+		}
+	}
+
+	path, sampled, err := ProfilePath("cpu")
+	assert.NoError(t, err)
+	assert.True(t, sampled)
+	assert.Equal(t, "/debug/pprof/profile", path)
+
+	_, _, err = ProfilePath("bogus")
+	assert.ErrorContains(t, err, "unknown profile type")
+
+	res, err := http.Get(srv.URL + VarsPath)
+	if assert.NoError(t, err) {
+		res.Body.Close()
+		assert.Equal(t, http.StatusOK, res.StatusCode)
+	}
+
+	res, err = http.Post(srv.URL+"/debug/pprof/heap", "text/plain", nil)
+	if assert.NoError(t, err) {
+		res.Body.Close()
+		assert.Equal(t, http.StatusMethodNotAllowed, res.StatusCode)
+	}
+}
@@ -0,0 +1,59 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package dhcp
+
+import (
+	"net"
+	"net/netip"
+
+	"github.com/insomniacslk/dhcp/dhcpv4"
+	"github.com/ubccr/grendel/internal/config"
+	"github.com/ubccr/grendel/pkg/model"
+)
+
+// advertisedIP returns the server address sent to a client in siaddr, the
+// server identifier, option 66 and the boot and provision URLs. The
+// advertise_ip of the subnet of the client comes first, then the address of
+// a local interface on the subnet of the client. Otherwise fallback, the
+// address of the interface the request came in on, is used
+func advertisedIP(local []netip.Prefix, client netip.Addr, fallback net.IP) net.IP {
+	if !client.IsValid() {
+		return fallback
+	}
+
+	for _, subnet := range config.Current().Subnets {
+		if subnet.AdvertiseIP.IsValid() && subnet.Gateway.Contains(client) {
+			return net.IP(subnet.AdvertiseIP.AsSlice())
+		}
+	}
+
+	for _, prefix := range local {
+		if prefix.Contains(client) {
+			return net.IP(prefix.Addr().AsSlice())
+		}
+	}
+
+	return fallback
+}
+
+// clientAddr returns the address of the client of a request: the address of
+// its interface when known, else the relay address of a relayed request or
+// the client address of a renewal
+func clientAddr(nic *model.NetInterface, req *dhcpv4.DHCPv4) netip.Addr {
+	if nic != nil && nic.IP.Addr().Is4() {
+		return nic.IP.Addr()
+	}
+
+	for _, ip := range []net.IP{req.GatewayIPAddr, req.ClientIPAddr} {
+		if ip == nil || ip.IsUnspecified() {
+			continue
+		}
+		if addr, ok := netip.AddrFromSlice(ip.To4()); ok {
+			return addr
+		}
+	}
+
+	return netip.Addr{}
+}
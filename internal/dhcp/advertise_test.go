@@ -0,0 +1,74 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package dhcp
+
+import (
+	"net"
+	"net/netip"
+	"testing"
+
+	"github.com/insomniacslk/dhcp/dhcpv4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/ubccr/grendel/internal/config"
+	"github.com/ubccr/grendel/pkg/model"
+)
+
+func TestAdvertisedIP(t *testing.T) {
+	cfg := *config.Current()
+	cfg.Subnets = []config.Subnet{
+		{Gateway: netip.MustParsePrefix("10.2.0.254/24"), AdvertiseIP: netip.MustParseAddr("10.0.0.2")},
+		{Gateway: netip.MustParsePrefix("10.3.0.254/24")},
+	}
+	defer config.Set(config.Set(&cfg))
+
+	// The server has interfaces on 10.0.0.0/24 and 10.1.0.0/24
+	local := []netip.Prefix{
+		netip.MustParsePrefix("10.0.0.1/24"),
+		netip.MustParsePrefix("10.1.0.1/24"),
+	}
+	fallback := net.ParseIP("10.0.0.1")
+
+	hw, err := net.ParseMAC("00:11:22:33:44:55")
+	require.NoError(t, err)
+	request := func(giaddr string) *dhcpv4.DHCPv4 {
+		req, err := dhcpv4.NewDiscovery(hw)
+		require.NoError(t, err)
+		if giaddr != "" {
+			req.GatewayIPAddr = net.ParseIP(giaddr)
+		}
+		return req
+	}
+	nic := func(ip string) *model.NetInterface {
+		return &model.NetInterface{MAC: hw, IP: netip.MustParsePrefix(ip)}
+	}
+
+	// Local client on the second interface, whatever interface the request
+	// came in on
+	ip := advertisedIP(local, clientAddr(nic("10.1.0.10/24"), request("")), fallback)
+	assert.Equal(t, "10.1.0.1", ip.String())
+
+	// Relayed client in a subnet with advertise_ip
+	ip = advertisedIP(local, clientAddr(nic("10.2.0.10/24"), request("10.2.0.254")), fallback)
+	assert.Equal(t, "10.0.0.2", ip.String())
+
+	// Relayed client in a subnet without advertise_ip or local interface
+	ip = advertisedIP(local, clientAddr(nic("10.3.0.10/24"), request("10.3.0.254")), fallback)
+	assert.Equal(t, "10.0.0.1", ip.String())
+
+	// Unknown relayed client, the relay address is in the client subnet
+	ip = advertisedIP(local, clientAddr(nil, request("10.2.0.254")), fallback)
+	assert.Equal(t, "10.0.0.2", ip.String())
+
+	// Unknown local client
+	ip = advertisedIP(local, clientAddr(nil, request("")), net.ParseIP("10.1.0.1"))
+	assert.Equal(t, "10.1.0.1", ip.String())
+
+	// advertise_ip is used even when the server has an interface in the
+	// subnet
+	local = append(local, netip.MustParsePrefix("10.2.0.1/24"))
+	ip = advertisedIP(local, clientAddr(nic("10.2.0.10/24"), request("")), fallback)
+	assert.Equal(t, "10.0.0.2", ip.String())
+}
@@ -0,0 +1,73 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package dhcp
+
+import (
+	"sync"
+	"time"
+
+	"github.com/insomniacslk/dhcp/dhcpv4"
+	"github.com/ubccr/grendel/internal/util"
+)
+
+// bootIDTTL is how long a boot is continued by the next stage of the boot
+// chain, such as iPXE chainloading the grendel boot script
+const bootIDTTL = 5 * time.Minute
+
+// bootIDs tracks the boot ID of hosts booting, shared by the DHCP and PXE
+// servers
+var bootIDs = newBootTracker()
+
+type bootSession struct {
+	id   string
+	xid  dhcpv4.TransactionID
+	seen time.Time
+}
+
+// bootTracker assigns a boot ID to every boot of a host. The boot ID is
+// carried in the firmware and boot tokens so the DHCP, TFTP and provision
+// logs of a boot can be correlated
+type bootTracker struct {
+	mu       sync.Mutex
+	sessions map[string]*bootSession
+}
+
+func newBootTracker() *bootTracker {
+	return &bootTracker{sessions: make(map[string]*bootSession)}
+}
+
+// id returns the boot ID of the DHCP transaction xid of mac. A transaction
+// from the firmware starts a new boot, a chained transaction continues the
+// recent boot of mac
+func (b *bootTracker) id(mac string, xid dhcpv4.TransactionID, chained bool, now time.Time) string {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	s, ok := b.sessions[mac]
+	if ok && now.Sub(s.seen) < bootIDTTL && (chained || s.xid == xid) {
+		s.xid = xid
+		s.seen = now
+		return s.id
+	}
+
+	id, err := util.GenerateSecret(8)
+	if err != nil {
+		log.Errorf("Failed to generate boot ID: %s", err)
+		return ""
+	}
+
+	b.prune(now)
+	b.sessions[mac] = &bootSession{id: id, xid: xid, seen: now}
+
+	return id
+}
+
+func (b *bootTracker) prune(now time.Time) {
+	for mac, s := range b.sessions {
+		if now.Sub(s.seen) >= bootIDTTL {
+			delete(b.sessions, mac)
+		}
+	}
+}
@@ -0,0 +1,41 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package dhcp
+
+import (
+	"testing"
+	"time"
+
+	"github.com/insomniacslk/dhcp/dhcpv4"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBootTracker(t *testing.T) {
+	assert := assert.New(t)
+
+	b := newBootTracker()
+	now := time.Now()
+	mac := "00:11:22:33:44:55"
+
+	// The DISCOVER and REQUEST of the firmware share a transaction
+	id := b.id(mac, dhcpv4.TransactionID{1}, false, now)
+	assert.Len(id, 16)
+	assert.Equal(id, b.id(mac, dhcpv4.TransactionID{1}, false, now.Add(time.Second)))
+
+	// iPXE chainloaded by the firmware continues the boot
+	assert.Equal(id, b.id(mac, dhcpv4.TransactionID{2}, true, now.Add(time.Minute)))
+	assert.Equal(id, b.id(mac, dhcpv4.TransactionID{2}, false, now.Add(time.Minute)))
+
+	// Other hosts boot independently
+	assert.NotEqual(id, b.id("00:11:22:33:44:66", dhcpv4.TransactionID{2}, true, now))
+
+	// The firmware starts a new boot
+	next := b.id(mac, dhcpv4.TransactionID{3}, false, now.Add(2*time.Minute))
+	assert.NotEqual(id, next)
+
+	// As does a chained request long after the last one
+	assert.NotEqual(next, b.id(mac, dhcpv4.TransactionID{4}, true, now.Add(2*time.Minute+bootIDTTL)))
+	assert.Len(b.sessions, 1)
+}
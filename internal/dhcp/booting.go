@@ -7,16 +7,19 @@ package dhcp
 import (
 	"fmt"
 	"net"
+	"time"
 
 	"github.com/insomniacslk/dhcp/dhcpv4"
 	"github.com/sirupsen/logrus"
 	"github.com/ubccr/grendel/internal/firmware"
+	"github.com/ubccr/grendel/internal/logger"
+	"github.com/ubccr/grendel/internal/maintenance"
 	"github.com/ubccr/grendel/internal/provision"
 	"github.com/ubccr/grendel/pkg/model"
 )
 
 func (s *Server) bootingHandler4(host *model.Host, serverIP net.IP, req, resp *dhcpv4.DHCPv4) error {
-	if !host.Provision {
+	if !s.Settings().boots(host) {
 		log.Infof("Host not set to provision: %s", req.ClientHWAddr.String())
 		return nil
 	}
@@ -26,21 +29,40 @@ func (s *Server) bootingHandler4(host *model.Host, serverIP net.IP, req, resp *d
 		return nil
 	}
 
+	if maintenance.Enabled() {
+		// The static address is still assigned by staticHandler4
+		maintenance.Suppressed("dhcp", host.Name, logrus.Fields{logger.FieldMAC: req.ClientHWAddr.String()})
+		return nil
+	}
+
 	userClass := ""
 	if req.Options.Has(dhcpv4.OptionUserClassInformation) {
 		userClass = string(req.Options.Get(dhcpv4.OptionUserClassInformation))
 	}
 
-	fwtype, err := firmware.DetectBuild(req.ClientArch(), userClass)
+	override, from := host.FirmwareOverride()
+	sel, err := firmware.Select(firmware.Request{
+		Archs:       req.ClientArch(),
+		UserClass:   userClass,
+		VendorClass: req.ClassIdentifier(),
+		Override:    override,
+	})
+	fwtype := sel.Detected
 	log.Debugf("iPXE Firmware type detected: %s", fwtype.String())
 	if err != nil {
 		return fmt.Errorf("Failed to get PXE firmware from DHCP: %s", err)
 	}
 
+	// Only the firmware starts a boot, later stages chainloaded by it
+	// continue the boot
+	chained := fwtype == firmware.IPXE || fwtype == firmware.GRENDEL
+	bootID := bootIDs.id(req.ClientHWAddr.String(), req.TransactionID, chained, time.Now())
+
 	log.WithFields(logrus.Fields{
-		"mac":      req.ClientHWAddr.String(),
-		"name":     host.Name,
-		"firmware": fwtype.String(),
+		logger.FieldMAC:    req.ClientHWAddr.String(),
+		logger.FieldHost:   host.Name,
+		logger.FieldBootID: bootID,
+		"firmware":         fwtype.String(),
 	}).Info("Got valid PXE boot request")
 	log.Debugln(req.Summary())
 
@@ -63,7 +85,7 @@ func (s *Server) bootingHandler4(host *model.Host, serverIP net.IP, req, resp *d
 
 		resp.UpdateOption(dhcpv4.OptTFTPServerName(serverIP.String()))
 
-		token, err := model.NewFirmwareToken(req.ClientHWAddr.String(), fwtype)
+		token, err := model.NewFirmwareToken(req.ClientHWAddr.String(), fwtype, bootID)
 		if err != nil {
 			return fmt.Errorf("UNDI failed to generated signed Firmware token")
 		}
@@ -76,7 +98,7 @@ func (s *Server) bootingHandler4(host *model.Host, serverIP net.IP, req, resp *d
 
 		resp.UpdateOption(dhcpv4.OptTFTPServerName(serverIP.String()))
 
-		token, err := model.NewFirmwareToken(req.ClientHWAddr.String(), fwtype)
+		token, err := model.NewFirmwareToken(req.ClientHWAddr.String(), fwtype, bootID)
 		if err != nil {
 			return fmt.Errorf("iPXE firmware - failed to generated signed Firmware token")
 		}
@@ -85,13 +107,13 @@ func (s *Server) bootingHandler4(host *model.Host, serverIP net.IP, req, resp *d
 
 	case firmware.EFI386, firmware.EFI64, firmware.SNPONLYx86_64, firmware.SNPONLYarm64:
 		log.Printf("EFI boot PXE client")
-		if host.Firmware != 0 {
-			log.Infof("Overriding firmware for host: %s", req.ClientHWAddr.String())
-			fwtype = host.Firmware
+		if sel.Overridden() {
+			log.Infof("Overriding firmware for host %s with %s of %s", req.ClientHWAddr.String(), sel.Build, from)
 		}
+		fwtype = sel.Build
 		resp.UpdateOption(dhcpv4.OptTFTPServerName(serverIP.String()))
 
-		token, err := model.NewFirmwareToken(req.ClientHWAddr.String(), fwtype)
+		token, err := model.NewFirmwareToken(req.ClientHWAddr.String(), fwtype, bootID)
 		if err != nil {
 			return fmt.Errorf("EFI failed to generated signed Firmware token")
 		}
@@ -99,7 +121,7 @@ func (s *Server) bootingHandler4(host *model.Host, serverIP net.IP, req, resp *d
 
 	case firmware.GRENDEL:
 		// Chainload to HTTP
-		token, err := model.NewBootToken(host.UID.String(), req.ClientHWAddr.String())
+		token, err := model.NewTracedBootToken(host.UID.String(), req.ClientHWAddr.String(), bootID)
 		if err != nil {
 			return fmt.Errorf("Failed to generate signed boot token: %s", err)
 		}
@@ -0,0 +1,56 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package dhcp
+
+import (
+	"errors"
+	"fmt"
+	"net/netip"
+	"time"
+
+	"github.com/insomniacslk/dhcp/dhcpv4"
+	"github.com/sirupsen/logrus"
+	"github.com/ubccr/grendel/internal/ipam"
+	"github.com/ubccr/grendel/internal/logger"
+	"github.com/ubccr/grendel/internal/store"
+	"github.com/ubccr/grendel/internal/store/namespace"
+	"github.com/ubccr/grendel/internal/webhook"
+	"github.com/ubccr/grendel/pkg/model"
+)
+
+// declineHandler4 reports a DHCPDECLINE: the client found the address it was
+// assigned already in use on the network, usually by a device with a
+// hand-configured address or a host entered twice. The address is recorded as
+// a conflict node nextip does not hand out. Nothing is sent back
+func (s *Server) declineHandler4(db store.Store, host *model.Host, req *dhcpv4.DHCPv4) {
+	ip := req.RequestedIPAddress()
+
+	msg := fmt.Sprintf("DHCP conflict: host %s declined address %s, it is already in use on the network", host.Name, ip)
+	log.WithFields(logrus.Fields{
+		logger.FieldHost: host.Name,
+		logger.FieldMAC:  req.ClientHWAddr.String(),
+		logger.FieldIP:   ip.String(),
+		"message":        req.Message(),
+	}).Warn(msg)
+
+	event := model.Event{
+		Severity:  model.SeverityWarning.String(),
+		Time:      time.Now().UTC(),
+		User:      "dhcp",
+		Message:   msg,
+		Namespace: namespace.Of(db),
+	}
+	s.Events.StoreEvents(event)
+
+	if addr, ok := netip.AddrFromSlice(ip); ok && db != nil {
+		if err := ipam.Conflict(db, host.Name, addr); errors.Is(err, store.ErrReadOnly) {
+			log.Info("Not recording DHCP conflict in read-only mode")
+		} else if err != nil {
+			log.WithField("err", err).Error("Failed to record DHCP conflict")
+		}
+	}
+
+	webhook.Notify(webhook.DHCPConflict, []string{host.Name}, event)
+}
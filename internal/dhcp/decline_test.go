@@ -0,0 +1,47 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package dhcp
+
+import (
+	"net"
+	"testing"
+
+	"github.com/insomniacslk/dhcp/dhcpv4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/ubccr/grendel/internal/store/eventstore"
+	"github.com/ubccr/grendel/internal/store/sqlstore"
+	"github.com/ubccr/grendel/pkg/model"
+)
+
+func TestDecline(t *testing.T) {
+	mac := net.HardwareAddr{0xd0, 0x94, 0x66, 0x12, 0x34, 0x56}
+	req, err := dhcpv4.New(
+		dhcpv4.WithHwAddr(mac),
+		dhcpv4.WithMessageType(dhcpv4.MessageTypeDecline),
+		dhcpv4.WithOption(dhcpv4.OptRequestedIPAddress(net.IPv4(10, 1, 0, 2))),
+	)
+	require.NoError(t, err)
+
+	db, err := sqlstore.New(":memory:")
+	require.NoError(t, err)
+
+	s := &Server{DB: db, Events: &eventstore.Store{}}
+	s.declineHandler4(db, &model.Host{Name: "cpn-01"}, req)
+
+	events := s.Events.GetEvents()
+	if assert.Len(t, events, 1) {
+		assert.Equal(t, model.SeverityWarning.String(), events[0].Severity)
+		assert.Equal(t, "DHCP conflict: host cpn-01 declined address 10.1.0.2, it is already in use on the network", events[0].Message)
+	}
+
+	reservations, err := db.IPReservations()
+	require.NoError(t, err)
+	if assert.Len(t, reservations, 1) {
+		assert.Equal(t, "10.1.0.2", reservations[0].IP)
+		assert.Equal(t, model.IPReservationConflict, reservations[0].Kind)
+		assert.Equal(t, "cpn-01", reservations[0].Host)
+	}
+}
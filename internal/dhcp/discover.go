@@ -0,0 +1,145 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package dhcp
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/insomniacslk/dhcp/dhcpv4"
+	"github.com/sirupsen/logrus"
+	"github.com/ubccr/grendel/internal/logger"
+	"github.com/ubccr/grendel/internal/store"
+	"github.com/ubccr/grendel/pkg/model"
+)
+
+// discoverInterval is how often a pending BMC retrying DHCP has its last
+// seen time updated
+const discoverInterval = time.Minute
+
+var (
+	// DefaultBMCVendorClasses match the DHCP vendor class identifier sent by
+	// Dell iDRAC, HPE iLO and OpenBMC
+	DefaultBMCVendorClasses = []string{"^iDRAC", "^CPQRIB", "(?i)openbmc"}
+)
+
+// BMCDiscovery recognizes BMCs among DHCP requests from unknown MAC
+// addresses by their vendor class identifier (option 60) or the OUI of their
+// MAC address, and records them as pending BMCs
+type BMCDiscovery struct {
+	vendorClasses []*regexp.Regexp
+	ouis          map[string]bool
+
+	mu   sync.Mutex
+	seen map[string]time.Time
+}
+
+// NewBMCDiscovery returns a BMCDiscovery matching the vendor class regular
+// expressions or MAC address OUIs, such as "d0:94:66"
+func NewBMCDiscovery(vendorClasses, ouis []string) (*BMCDiscovery, error) {
+	d := &BMCDiscovery{
+		ouis: make(map[string]bool, len(ouis)),
+		seen: make(map[string]time.Time),
+	}
+
+	for _, vc := range vendorClasses {
+		re, err := regexp.Compile(vc)
+		if err != nil {
+			return nil, fmt.Errorf("invalid bmc vendor class %q: %w", vc, err)
+		}
+		d.vendorClasses = append(d.vendorClasses, re)
+	}
+
+	for _, oui := range ouis {
+		mac, err := net.ParseMAC(oui + ":00:00:00")
+		if err != nil || len(mac) != 6 {
+			return nil, fmt.Errorf("invalid bmc oui %q, expected 3 octets such as d0:94:66", oui)
+		}
+		d.ouis[mac.String()[:8]] = true
+	}
+
+	return d, nil
+}
+
+// Match returns true if req comes from a BMC
+func (d *BMCDiscovery) Match(req *dhcpv4.DHCPv4) bool {
+	if len(req.ClientHWAddr) == 6 && d.ouis[req.ClientHWAddr.String()[:8]] {
+		return true
+	}
+
+	vc := req.ClassIdentifier()
+	if vc == "" {
+		return false
+	}
+	for _, re := range d.vendorClasses {
+		if re.MatchString(vc) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// due returns true if the BMC with mac was not recorded in the last
+// discoverInterval, limiting writes while it retries DHCP
+func (d *BMCDiscovery) due(mac string, now time.Time) bool {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if last, ok := d.seen[mac]; ok && now.Sub(last) < discoverInterval {
+		return false
+	}
+	d.seen[mac] = now
+
+	return true
+}
+
+// discoverBMC records a request from an unknown MAC address as a pending BMC
+// if BMC discovery is enabled and the request comes from a BMC. No address is
+// offered to pending BMCs
+func (s *Server) discoverBMC(db store.Store, req *dhcpv4.DHCPv4, serverIP net.IP) {
+	discovery := s.Settings().BMCDiscovery
+
+	if discovery == nil || !discovery.Match(req) {
+		return
+	}
+
+	mac := req.ClientHWAddr.String()
+	now := time.Now()
+	if !discovery.due(mac, now) {
+		return
+	}
+
+	bmc := &model.PendingBMC{
+		MAC:         mac,
+		VendorClass: strings.TrimSpace(req.ClassIdentifier()),
+		ServerIP:    serverIP.String(),
+		LastSeen:    now,
+	}
+	if !req.GatewayIPAddr.IsUnspecified() {
+		bmc.Relay = req.GatewayIPAddr.String()
+	}
+
+	fields := logrus.Fields{
+		logger.FieldMAC: mac,
+		"vendor_class":  bmc.VendorClass,
+		"relay":         bmc.Relay,
+	}
+
+	if err := db.StorePendingBMC(bmc); errors.Is(err, store.ErrReadOnly) {
+		log.WithFields(fields).Info("Discovered pending BMC, not stored in read-only mode")
+		return
+	} else if err != nil {
+		log.WithFields(fields).Errorf("Failed to store pending BMC: %s", err)
+		return
+	}
+
+	log.WithFields(fields).Info("Discovered pending BMC")
+}
@@ -0,0 +1,54 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package dhcp
+
+import (
+	"net"
+	"testing"
+	"time"
+
+	"github.com/insomniacslk/dhcp/dhcpv4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBMCDiscoveryMatch(t *testing.T) {
+	d, err := NewBMCDiscovery(DefaultBMCVendorClasses, []string{"D0:94:66"})
+	require.NoError(t, err)
+
+	request := func(mac string, vendorClass string) *dhcpv4.DHCPv4 {
+		hw, err := net.ParseMAC(mac)
+		require.NoError(t, err)
+		req, err := dhcpv4.NewDiscovery(hw)
+		require.NoError(t, err)
+		if vendorClass != "" {
+			req.UpdateOption(dhcpv4.OptClassIdentifier(vendorClass))
+		}
+		return req
+	}
+
+	assert.True(t, d.Match(request("00:11:22:33:44:55", "iDRAC")))
+	assert.True(t, d.Match(request("00:11:22:33:44:55", "CPQRIB3")))
+	assert.True(t, d.Match(request("00:11:22:33:44:55", "OpenBMC")))
+	assert.True(t, d.Match(request("d0:94:66:12:34:56", "")))
+	assert.False(t, d.Match(request("00:11:22:33:44:55", "PXEClient:Arch:00007:UNDI:003016")))
+	assert.False(t, d.Match(request("00:11:22:33:44:55", "")))
+
+	_, err = NewBMCDiscovery([]string{"("}, nil)
+	assert.Error(t, err)
+	_, err = NewBMCDiscovery(nil, []string{"d0:94"})
+	assert.Error(t, err)
+}
+
+func TestBMCDiscoveryDue(t *testing.T) {
+	d, err := NewBMCDiscovery(nil, nil)
+	require.NoError(t, err)
+
+	now := time.Now()
+	assert.True(t, d.due("d0:94:66:12:34:56", now))
+	assert.False(t, d.due("d0:94:66:12:34:56", now.Add(time.Second)))
+	assert.True(t, d.due("d0:94:66:12:34:57", now.Add(time.Second)))
+	assert.True(t, d.due("d0:94:66:12:34:56", now.Add(discoverInterval)))
+}
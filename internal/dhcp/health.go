@@ -0,0 +1,122 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package dhcp
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"sync"
+
+	"github.com/insomniacslk/dhcp/dhcpv4"
+	"golang.org/x/net/ipv4"
+)
+
+// probes are the loopback requests sent by Check, answered by the read loop
+// of the server instead of the DHCP handler
+type probes struct {
+	mu      sync.Mutex
+	pending map[dhcpv4.TransactionID]chan struct{}
+}
+
+func (p *probes) add(xid dhcpv4.TransactionID) chan struct{} {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.pending == nil {
+		p.pending = make(map[dhcpv4.TransactionID]chan struct{})
+	}
+	done := make(chan struct{})
+	p.pending[xid] = done
+
+	return done
+}
+
+func (p *probes) remove(xid dhcpv4.TransactionID) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	delete(p.pending, xid)
+}
+
+// answer returns true when req is a probe sent from peer, marking it received
+func (p *probes) answer(peer net.Addr, req *dhcpv4.DHCPv4) bool {
+	upeer, ok := peer.(*net.UDPAddr)
+	if !ok || !upeer.IP.IsLoopback() {
+		return false
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	done, ok := p.pending[req.TransactionID]
+	if !ok {
+		return false
+	}
+	close(done)
+	delete(p.pending, req.TransactionID)
+
+	return true
+}
+
+// Check returns an error unless the socket of the server is open and its
+// read loop receives a request sent over loopback. A server bound to the
+// interface of ListenAddress does not receive loopback packets, only its
+// socket is checked
+func (s *Server) Check(ctx context.Context) error {
+	if err := socketOpen(s.conn); err != nil {
+		return err
+	}
+
+	if !s.ListenAddress.To4().Equal(net.IPv4zero) {
+		return nil
+	}
+
+	req, err := dhcpv4.New(dhcpv4.WithMessageType(dhcpv4.MessageTypeInform))
+	if err != nil {
+		return err
+	}
+
+	done := s.probes.add(req.TransactionID)
+	defer s.probes.remove(req.TransactionID)
+
+	var d net.Dialer
+	conn, err := d.DialContext(ctx, "udp4", fmt.Sprintf("127.0.0.1:%d", s.Port))
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	if _, err := conn.Write(req.ToBytes()); err != nil {
+		return err
+	}
+
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return errors.New("loopback probe not received")
+	}
+}
+
+// Check returns an error unless the socket of the PXE server is open
+func (s *PXEServer) Check(ctx context.Context) error {
+	return socketOpen(s.conn)
+}
+
+// socketOpen returns an error when conn is not bound or closed. Reading a
+// socket option fails once the socket is closed
+func socketOpen(conn *ipv4.PacketConn) error {
+	if conn == nil {
+		return errors.New("socket not bound")
+	}
+
+	if _, err := conn.TTL(); err != nil {
+		return fmt.Errorf("socket closed: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,36 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package dhcp
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/ubccr/grendel/internal/store/sqlstore"
+)
+
+func TestCheck(t *testing.T) {
+	db, err := sqlstore.New(":memory:")
+	require.NoError(t, err)
+	defer db.Close()
+
+	s, err := NewServer(db, "0.0.0.0:10067")
+	require.NoError(t, err)
+
+	assert.ErrorContains(t, s.Check(context.Background()), "socket not bound")
+
+	require.NoError(t, s.Listen())
+	go s.Serve()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+	assert.NoError(t, s.Check(ctx))
+
+	require.NoError(t, s.Shutdown(context.Background()))
+	assert.ErrorContains(t, s.Check(context.Background()), "socket closed")
+}
@@ -0,0 +1,56 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package dhcp
+
+import (
+	"net"
+	"net/netip"
+	"testing"
+	"time"
+
+	"github.com/insomniacslk/dhcp/dhcpv4"
+	"github.com/insomniacslk/dhcp/iana"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/ubccr/grendel/internal/maintenance"
+	"github.com/ubccr/grendel/internal/store/sqlstore"
+	"github.com/ubccr/grendel/pkg/model"
+)
+
+func TestMaintenance(t *testing.T) {
+	db, err := sqlstore.New(":memory:")
+	require.NoError(t, err)
+	defer db.Close()
+
+	require.NoError(t, maintenance.Set(db, &model.Maintenance{Enabled: true, Changed: time.Now()}))
+	defer maintenance.Set(db, &model.Maintenance{Changed: time.Now()})
+
+	mac := net.HardwareAddr{0xd0, 0x94, 0x66, 0x12, 0x34, 0x56}
+	host := &model.Host{
+		Name:      "sw-01",
+		Provision: true,
+		Tags:      []string{"dellztd"},
+		Interfaces: []*model.NetInterface{
+			{MAC: mac, IP: netip.MustParsePrefix("10.1.0.2/24")},
+		},
+	}
+
+	req, err := dhcpv4.NewDiscovery(mac, dhcpv4.WithOption(dhcpv4.OptClientArch(iana.EFI_X86_64)))
+	require.NoError(t, err)
+	resp, err := dhcpv4.NewReplyFromRequest(req)
+	require.NoError(t, err)
+
+	s := &Server{}
+	s.Reload(&Settings{LeaseTime: time.Hour})
+	serverIP := net.IPv4(10, 1, 0, 254)
+	require.NoError(t, s.bootingHandler4(host, serverIP, req, resp))
+	require.NoError(t, s.staticHandler4(host, serverIP, req, resp))
+
+	// The static address is assigned without the boot file or the ZTD
+	// provision URL
+	assert.Equal(t, "10.1.0.2", resp.YourIPAddr.String())
+	assert.Empty(t, resp.BootFileNameOption())
+	assert.False(t, resp.Options.Has(dhcpv4.GenericOptionCode(240)))
+}
@@ -0,0 +1,47 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package dhcp
+
+import (
+	"github.com/insomniacslk/dhcp/dhcpv4"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/ubccr/grendel/internal/stats"
+)
+
+var (
+	requestsTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "grendel_dhcp_requests_total",
+		Help: "DHCP requests received by server and message type",
+	}, []string{"server", "type"})
+	repliesTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "grendel_dhcp_replies_total",
+		Help: "DHCP replies sent by server and message type",
+	}, []string{"server", "type"})
+	replyCacheHits = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "grendel_dhcp_reply_cache_hits_total",
+		Help: "DHCP retransmitted requests answered with a cached reply by message type",
+	}, []string{"type"})
+	outsideSubnetTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "grendel_dhcp_outside_subnet_total",
+		Help: "DHCP requests of hosts with an address in none of dhcp.subnets by action: served, or withheld with dhcp.strict_subnets",
+	}, []string{"action"})
+)
+
+func init() {
+	prometheus.MustRegister(requestsTotal, repliesTotal, replyCacheHits, outsideSubnetTotal)
+}
+
+// observeRequest counts a request received by server, dhcp or pxe
+func observeRequest(server string, req *dhcpv4.DHCPv4) {
+	requestsTotal.WithLabelValues(server, req.MessageType().String()).Inc()
+}
+
+// observeReply counts a reply sent by server, dhcp or pxe
+func observeReply(server string, resp *dhcpv4.DHCPv4) {
+	repliesTotal.WithLabelValues(server, resp.MessageType().String()).Inc()
+	if server == "dhcp" && resp.MessageType() == dhcpv4.MessageTypeAck {
+		stats.DHCPAcks.Inc()
+	}
+}
@@ -0,0 +1,42 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package dhcp
+
+import (
+	"net"
+	"testing"
+
+	"github.com/insomniacslk/dhcp/dhcpv4"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/ubccr/grendel/internal/store/sqlstore"
+	"golang.org/x/net/ipv4"
+)
+
+func TestRequestMetrics(t *testing.T) {
+	db, err := sqlstore.New(":memory:")
+	require.NoError(t, err)
+
+	s := &Server{DB: db, ServerAddress: net.ParseIP("10.1.0.254")}
+
+	mac, err := net.ParseMAC("d0:94:66:12:34:56")
+	require.NoError(t, err)
+	req, err := dhcpv4.NewDiscovery(mac)
+	require.NoError(t, err)
+
+	discover := testutil.ToFloat64(requestsTotal.WithLabelValues("dhcp", "DISCOVER"))
+	offers := testutil.ToFloat64(repliesTotal.WithLabelValues("dhcp", "OFFER"))
+
+	// Requests from unknown clients are counted but not answered
+	s.mainHandler4(&net.UDPAddr{IP: net.IPv4bcast}, req, &ipv4.ControlMessage{})
+	assert.Equal(t, discover+1, testutil.ToFloat64(requestsTotal.WithLabelValues("dhcp", "DISCOVER")))
+	assert.Equal(t, offers, testutil.ToFloat64(repliesTotal.WithLabelValues("dhcp", "OFFER")))
+
+	resp, err := dhcpv4.NewReplyFromRequest(req, dhcpv4.WithMessageType(dhcpv4.MessageTypeOffer))
+	require.NoError(t, err)
+	observeReply("pxe", resp)
+	assert.Equal(t, 1.0, testutil.ToFloat64(repliesTotal.WithLabelValues("pxe", "OFFER")))
+}
@@ -9,15 +9,20 @@ import (
 	"errors"
 	"fmt"
 	"net"
+	"net/netip"
 	"strconv"
 	"sync"
+	"sync/atomic"
+	"time"
 
 	"github.com/insomniacslk/dhcp/dhcpv4"
 	"github.com/insomniacslk/dhcp/dhcpv4/server4"
 	"github.com/sirupsen/logrus"
 	"github.com/ubccr/grendel/internal/firmware"
 	"github.com/ubccr/grendel/internal/logger"
+	"github.com/ubccr/grendel/internal/maintenance"
 	"github.com/ubccr/grendel/internal/store"
+	"github.com/ubccr/grendel/internal/store/namespace"
 	"github.com/ubccr/grendel/internal/util"
 	"github.com/ubccr/grendel/pkg/model"
 	"golang.org/x/net/ipv4"
@@ -29,10 +34,14 @@ const (
 
 type PXEServer struct {
 	DB             store.Store
+	Namespaces     *namespace.Registry // selected by subnet, only DB is used when nil
 	ListenAddress  net.IP
 	ServerAddress  net.IP
 	InterfaceIPMap map[int]net.IP
+	LocalPrefixes  []netip.Prefix
 	Port           int
+	PacketConn     net.PacketConn // socket passed by systemd, used instead of binding ListenAddress
+	bootStates     atomic.Pointer[[]string]
 	srv            *server4.Server
 	log            *logrus.Entry
 	conn           *ipv4.PacketConn
@@ -85,11 +94,40 @@ func NewPXEServer(db store.Store, address string) (*PXEServer, error) {
 
 	s.InterfaceIPMap = intfMap
 
+	s.LocalPrefixes, err = util.GetInterfacePrefixes()
+	if err != nil {
+		return nil, err
+	}
+
 	return s, nil
 }
 
+// SetBootStates sets the host states given boot options, DefaultBootStates
+// when empty
+func (s *PXEServer) SetBootStates(states []string) {
+	s.bootStates.Store(&states)
+}
+
+// BootStates returns the host states given boot options
+func (s *PXEServer) BootStates() []string {
+	if states := s.bootStates.Load(); states != nil {
+		return *states
+	}
+
+	return nil
+}
+
 func (s *PXEServer) pxeHandler4(peer *net.UDPAddr, req *dhcpv4.DHCPv4, oob *ipv4.ControlMessage) {
-	host, err := s.DB.LoadHostFromMAC(req.ClientHWAddr.String())
+	observeRequest("pxe", req)
+
+	db := s.DB
+	if s.Namespaces != nil {
+		// Clients send PXE requests from their leased address
+		addr, _ := netip.AddrFromSlice(peer.IP)
+		_, db = s.Namespaces.ForAddr(addr.Unmap())
+	}
+
+	host, err := db.LoadHostFromMAC(req.ClientHWAddr.String())
 	if err != nil {
 		if !errors.Is(err, store.ErrNotFound) {
 			s.log.Errorf("failed to find host: %s", err)
@@ -97,7 +135,7 @@ func (s *PXEServer) pxeHandler4(peer *net.UDPAddr, req *dhcpv4.DHCPv4, oob *ipv4
 		return
 	}
 
-	if !host.Provision {
+	if !bootable(host, s.BootStates()) {
 		s.log.Infof("Host %s not set to provision: %s", host.Name, req.ClientHWAddr.String())
 		return
 	}
@@ -115,15 +153,25 @@ func (s *PXEServer) pxeHandler4(peer *net.UDPAddr, req *dhcpv4.DHCPv4, oob *ipv4
 		return
 	}
 
-	fwtype, err := firmware.DetectBuild(req.ClientArch(), "")
+	if maintenance.Enabled() {
+		maintenance.Suppressed("pxe", host.Name, logrus.Fields{logger.FieldMAC: req.ClientHWAddr.String()})
+		return
+	}
+
+	override, from := host.FirmwareOverride()
+	sel, err := firmware.Select(firmware.Request{
+		Archs:       req.ClientArch(),
+		VendorClass: req.ClassIdentifier(),
+		Override:    override,
+	})
 	if err != nil {
 		s.log.Errorf("failed to get firmware: %s", err)
 		return
 	}
-	if host.Firmware != 0 {
-		s.log.Infof("Overriding firmware for host: %s", req.ClientHWAddr.String())
-		fwtype = host.Firmware
+	if sel.Overridden() {
+		s.log.Infof("Overriding firmware for host %s with %s of %s", req.ClientHWAddr.String(), sel.Build, from)
 	}
+	fwtype := sel.Build
 
 	serverIP := s.ServerAddress
 	// Use the IP address of the interface the request came in on for the
@@ -131,8 +179,17 @@ func (s *PXEServer) pxeHandler4(peer *net.UDPAddr, req *dhcpv4.DHCPv4, oob *ipv4
 	if intfIP, ok := s.InterfaceIPMap[oob.IfIndex]; ok {
 		serverIP = intfIP
 	}
+	serverIP = advertisedIP(s.LocalPrefixes, clientAddr(host.DHCPInterface(req.ClientHWAddr), req), serverIP)
 
-	s.log.Infof("Received valid request %s - %d", req.ClientHWAddr, fwtype)
+	// The PXE request follows the DHCP transaction of the firmware
+	bootID := bootIDs.id(req.ClientHWAddr.String(), req.TransactionID, true, time.Now())
+
+	s.log.WithFields(logrus.Fields{
+		logger.FieldMAC:    req.ClientHWAddr.String(),
+		logger.FieldHost:   host.Name,
+		logger.FieldBootID: bootID,
+		"firmware":         fwtype.String(),
+	}).Info("Received valid PXE request")
 
 	resp, err := dhcpv4.NewReplyFromRequest(req,
 		dhcpv4.WithBroadcast(false),
@@ -151,7 +208,7 @@ func (s *PXEServer) pxeHandler4(peer *net.UDPAddr, req *dhcpv4.DHCPv4, oob *ipv4
 		resp.UpdateOption(dhcpv4.OptGeneric(dhcpv4.OptionClientMachineIdentifier, req.Options.Get(dhcpv4.OptionClientMachineIdentifier)))
 	}
 
-	token, err := model.NewFirmwareToken(req.ClientHWAddr.String(), fwtype)
+	token, err := model.NewFirmwareToken(req.ClientHWAddr.String(), fwtype, bootID)
 	if err != nil {
 		s.log.Errorf("Failed to generated signed firmware token: %v", err)
 		return
@@ -173,36 +230,47 @@ func (s *PXEServer) pxeHandler4(peer *net.UDPAddr, req *dhcpv4.DHCPv4, oob *ipv4
 
 	if _, err := s.conn.WriteTo(resp.ToBytes(), woob, peer); err != nil {
 		s.log.Errorf("UDP write to %v failed: %v", peer, err)
+		return
 	}
+	observeReply("pxe", resp)
 }
 
-func (s *PXEServer) Serve() error {
-	listener := &net.UDPAddr{
-		IP:   s.ListenAddress,
-		Port: s.Port,
-	}
-
-	intf := ""
-	if !s.ListenAddress.To4().Equal(net.IPv4zero) {
-		var err error
-		intf, _, err = util.GetInterfaceFromIP(s.ListenAddress)
+// Listen binds the UDP socket of the server. Serve binds it when Listen was
+// not called
+func (s *PXEServer) Listen() error {
+	if s.PacketConn != nil {
+		conn, err := adoptUDP4(s.PacketConn)
 		if err != nil {
 			return err
 		}
 
-		listener = &net.UDPAddr{Port: s.Port}
-		log.Printf("Binding to interface: %s", intf)
+		s.conn = conn
+		return nil
 	}
 
-	udpConn, err := server4.NewIPv4UDPConn(intf, listener)
+	conn, err := listenUDP4(s.ListenAddress, s.Port)
 	if err != nil {
 		return err
 	}
 
-	s.conn = ipv4.NewPacketConn(udpConn)
-	err = s.conn.SetControlMessage(ipv4.FlagInterface, true)
-	if err != nil {
-		return err
+	s.conn = conn
+	return nil
+}
+
+// Addr returns the address the PXE server is bound to, nil until Listen is
+// called
+func (s *PXEServer) Addr() net.Addr {
+	if s.conn == nil {
+		return nil
+	}
+	return s.conn.LocalAddr()
+}
+
+func (s *PXEServer) Serve() error {
+	if s.conn == nil {
+		if err := s.Listen(); err != nil {
+			return err
+		}
 	}
 
 	s.log.Infof("Server listening on: %s:%d", s.ListenAddress, s.Port)
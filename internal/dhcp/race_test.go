@@ -0,0 +1,131 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package dhcp
+
+import (
+	"net"
+	"net/netip"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/insomniacslk/dhcp/dhcpv4"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/ubccr/grendel/internal/config"
+	"github.com/ubccr/grendel/pkg/model"
+)
+
+// TestHandlerReload hammers the static handler while the configuration and
+// the server settings are reloaded. Run with -race to check handlers and
+// reloads never race, every reply has to carry the options of one of the
+// configurations
+func TestHandlerReload(t *testing.T) {
+	viper.Reset()
+	defer viper.Reset()
+	defer config.ResetViper()
+	defer config.Set(config.Current())
+
+	configs := []string{`
+[dhcp]
+subnets = [{gateway = "10.1.0.254/24", dns = "10.1.0.1,10.1.0.2", domainsearch = "a.example", mtu = 1500}]
+
+[provision]
+listen = "0.0.0.0:80"
+`, `
+[dhcp]
+subnets = [{gateway = "10.1.0.1/24", dns = "10.1.0.3,10.1.0.4,10.1.0.5", domainsearch = "b.example", mtu = 9000}]
+
+[provision]
+listen = "0.0.0.0:80"
+`}
+	file := filepath.Join(t.TempDir(), "grendel.toml")
+	write := func(i int) {
+		require.NoError(t, os.WriteFile(file, []byte(configs[i%2]), 0o600))
+	}
+	write(0)
+	viper.SetConfigFile(file)
+	require.NoError(t, viper.ReadInConfig())
+	require.NoError(t, config.ParseConfigs())
+
+	mac := net.HardwareAddr{0xd0, 0x94, 0x66, 0x12, 0x34, 0x56}
+	host := &model.Host{
+		Name: "cpn-01",
+		Interfaces: []*model.NetInterface{
+			{MAC: mac, IP: netip.MustParsePrefix("10.1.0.2/24"), FQDN: "cpn-01.example"},
+		},
+	}
+
+	s := &Server{}
+	s.Reload(&Settings{LeaseTime: time.Hour})
+	serverIP := net.IPv4(10, 1, 0, 254)
+
+	done := make(chan struct{})
+	reloaded := make(chan struct{})
+	go func() {
+		defer close(reloaded)
+		for i := 1; ; i++ {
+			select {
+			case <-done:
+				return
+			default:
+			}
+
+			write(i)
+			if _, err := config.Reload(); err != nil {
+				t.Error(err)
+				return
+			}
+			s.Reload(&Settings{LeaseTime: time.Duration(i%2+1) * time.Hour})
+		}
+	}()
+
+	var wg sync.WaitGroup
+	for range 8 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for range 200 {
+				req, err := dhcpv4.NewDiscovery(mac, dhcpv4.WithRequestedOptions(
+					dhcpv4.OptionDomainNameServer,
+					dhcpv4.OptionInterfaceMTU,
+				))
+				if !assert.NoError(t, err) {
+					return
+				}
+				resp, err := dhcpv4.NewReplyFromRequest(req)
+				if !assert.NoError(t, err) {
+					return
+				}
+				if !assert.NoError(t, s.staticHandler4(host, serverIP, req, resp)) {
+					return
+				}
+
+				dns := make([]string, 0)
+				for _, ip := range resp.DNS() {
+					dns = append(dns, ip.String())
+				}
+				slices.Sort(dns)
+				assert.Contains(t, []string{"10.1.0.1,10.1.0.2", "10.1.0.3,10.1.0.4,10.1.0.5"}, strings.Join(dns, ","))
+				if assert.Len(t, resp.Router(), 1) {
+					assert.Contains(t, []string{"10.1.0.254", "10.1.0.1"}, resp.Router()[0].String())
+				}
+				assert.Contains(t, []time.Duration{time.Hour, 2 * time.Hour}, resp.IPAddressLeaseTime(0))
+				if assert.NotNil(t, resp.DomainSearch()) {
+					assert.Contains(t, [][]string{{"a.example"}, {"b.example"}}, resp.DomainSearch().Labels)
+				}
+			}
+		}()
+	}
+
+	wg.Wait()
+	close(done)
+	<-reloaded
+}
@@ -0,0 +1,37 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package dhcp
+
+import (
+	"context"
+
+	"github.com/insomniacslk/dhcp/dhcpv4"
+	"github.com/ubccr/grendel/internal/store"
+	"golang.org/x/net/ipv4"
+)
+
+// Replay returns the reply of the server to req without sending it, nil when
+// req is not answered. It is used by grendel replay to compare the answers
+// of grendel with a capture of another server. The reply cache and the
+// standby state of high availability are bypassed
+func (s *Server) Replay(req *dhcpv4.DHCPv4) *dhcpv4.DHCPv4 {
+	if req.OpCode != dhcpv4.OpcodeBootRequest {
+		return nil
+	}
+
+	ctx, cancel := store.WithTimeout(context.Background(), s.Settings().StoreTimeout)
+	defer cancel()
+
+	resp := s.reply4(ctx, req, &ipv4.ControlMessage{})
+	if resp == nil {
+		return nil
+	}
+
+	if !req.GatewayIPAddr.IsUnspecified() {
+		resp.SetBroadcast()
+	}
+
+	return resp
+}
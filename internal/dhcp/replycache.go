@@ -0,0 +1,192 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package dhcp
+
+import (
+	"container/list"
+	"context"
+	"sync"
+	"time"
+
+	"github.com/insomniacslk/dhcp/dhcpv4"
+	"github.com/ubccr/grendel/internal/maintenance"
+	"golang.org/x/net/ipv4"
+)
+
+const (
+	// DefaultReplyCacheTTL is how long replies are reused for retransmitted
+	// requests unless dhcp.reply_cache_ttl is set
+	DefaultReplyCacheTTL = 5 * time.Second
+
+	// ReplyCacheSize is the most replies cached, the least recently used
+	// replies are dropped first
+	ReplyCacheSize = 4096
+)
+
+// generationer is implemented by stores which count their writes, such as
+// the cachestore. Cached replies are only used while the generation of the
+// store they were built from is unchanged
+type generationer interface {
+	Generation() uint64
+}
+
+type replyKey struct {
+	mac     string
+	xid     dhcpv4.TransactionID
+	msgType dhcpv4.MessageType
+	ifIndex int
+	giaddr  string
+}
+
+type cachedReply struct {
+	key        replyKey
+	packet     []byte
+	generation uint64
+	expires    time.Time
+}
+
+// replyCache holds the replies to the DISCOVER and REQUEST transactions of
+// clients. Clients retransmit requests until they get a reply, during a
+// cluster power-on the retransmissions are answered from the cache without
+// looking up the host again
+type replyCache struct {
+	mu      sync.Mutex
+	size    int
+	lru     *list.List
+	entries map[replyKey]*list.Element
+}
+
+func newReplyCache(size int) *replyCache {
+	return &replyCache{
+		size:    size,
+		lru:     list.New(),
+		entries: make(map[replyKey]*list.Element),
+	}
+}
+
+func newReplyKey(req *dhcpv4.DHCPv4, ifIndex int) replyKey {
+	return replyKey{
+		mac:     req.ClientHWAddr.String(),
+		xid:     req.TransactionID,
+		msgType: req.MessageType(),
+		ifIndex: ifIndex,
+		giaddr:  req.GatewayIPAddr.String(),
+	}
+}
+
+// get returns a copy of the reply cached for key if it was built from
+// generation and has not expired
+func (c *replyCache) get(key replyKey, generation uint64, now time.Time) *dhcpv4.DHCPv4 {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	elem, ok := c.entries[key]
+	if !ok {
+		return nil
+	}
+
+	e := elem.Value.(*cachedReply)
+	if e.generation != generation || !now.Before(e.expires) {
+		c.lru.Remove(elem)
+		delete(c.entries, key)
+		return nil
+	}
+
+	// The reply is decoded again as the handler changes the flags of
+	// the reply it sends
+	resp, err := dhcpv4.FromBytes(e.packet)
+	if err != nil {
+		return nil
+	}
+	c.lru.MoveToFront(elem)
+
+	return resp
+}
+
+func (c *replyCache) put(key replyKey, resp *dhcpv4.DHCPv4, generation uint64, expires time.Time) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	e := &cachedReply{key: key, packet: resp.ToBytes(), generation: generation, expires: expires}
+	if elem, ok := c.entries[key]; ok {
+		elem.Value = e
+		c.lru.MoveToFront(elem)
+		return
+	}
+
+	c.entries[key] = c.lru.PushFront(e)
+	for c.lru.Len() > c.size {
+		oldest := c.lru.Back()
+		c.lru.Remove(oldest)
+		delete(c.entries, oldest.Value.(*cachedReply).key)
+	}
+}
+
+// Len returns the number of cached replies, including expired replies not
+// yet dropped
+func (c *replyCache) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.lru.Len()
+}
+
+func (c *replyCache) reset() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.lru.Init()
+	clear(c.entries)
+}
+
+// generation returns the number of writes to the stores the replies are
+// built from, false when a store does not count its writes
+func (s *Server) generation() (uint64, bool) {
+	if s.Namespaces != nil {
+		return s.Namespaces.Generation()
+	}
+
+	gen, ok := s.DB.(generationer)
+	if !ok {
+		return 0, false
+	}
+
+	return gen.Generation(), true
+}
+
+// cachedReply4 returns the reply to req, reusing the reply to a
+// retransmission of req. Replies are only cached when the data store counts
+// its writes so a reply built before a host was saved is never sent, and not
+// while maintenance mode is enabled
+func (s *Server) cachedReply4(ctx context.Context, req *dhcpv4.DHCPv4, oob *ipv4.ControlMessage) *dhcpv4.DHCPv4 {
+	ttl := s.Settings().ReplyCacheTTL
+	// The generation is read before the host is looked up, a host saved
+	// while the reply is built changes it and the reply is not used again
+	generation, ok := s.generation()
+	mt := req.MessageType()
+	if s.replies == nil || ttl <= 0 || !ok || maintenance.Enabled() ||
+		(mt != dhcpv4.MessageTypeDiscover && mt != dhcpv4.MessageTypeRequest) {
+		return s.reply4(ctx, req, oob)
+	}
+
+	ifIndex := 0
+	if oob != nil {
+		ifIndex = oob.IfIndex
+	}
+	key := newReplyKey(req, ifIndex)
+
+	now := time.Now()
+	if resp := s.replies.get(key, generation, now); resp != nil {
+		replyCacheHits.WithLabelValues(mt.String()).Inc()
+		return resp
+	}
+
+	resp := s.reply4(ctx, req, oob)
+	if resp != nil {
+		s.replies.put(key, resp, generation, now.Add(ttl))
+	}
+
+	return resp
+}
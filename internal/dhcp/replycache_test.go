@@ -0,0 +1,129 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package dhcp
+
+import (
+	"context"
+	"net"
+	"net/netip"
+	"testing"
+	"time"
+
+	"github.com/insomniacslk/dhcp/dhcpv4"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/ubccr/grendel/internal/store"
+	"github.com/ubccr/grendel/internal/store/cachestore"
+	"github.com/ubccr/grendel/internal/store/sqlstore"
+	"github.com/ubccr/grendel/pkg/model"
+	"golang.org/x/net/ipv4"
+)
+
+// countingStore counts the host lookups by MAC address made past the reply
+// cache
+type countingStore struct {
+	*cachestore.Store
+	loads int
+}
+
+// WithContext returns s so the lookups of requests are counted, the test
+// sets no deadline
+func (s *countingStore) WithContext(ctx context.Context) store.Store {
+	return s
+}
+
+func (s *countingStore) LoadHostFromMAC(mac string) (*model.Host, error) {
+	s.loads++
+	return s.Store.LoadHostFromMAC(mac)
+}
+
+func TestReplyCache(t *testing.T) {
+	db, err := sqlstore.New(":memory:")
+	require.NoError(t, err)
+	defer db.Close()
+
+	mac := net.HardwareAddr{0xd0, 0x94, 0x66, 0x12, 0x34, 0x56}
+	host := &model.Host{
+		Name: "cpn-01",
+		Interfaces: []*model.NetInterface{
+			{MAC: mac, IP: netip.MustParsePrefix("10.1.0.2/24"), FQDN: "cpn-01.example"},
+		},
+	}
+	require.NoError(t, db.StoreHost(host))
+
+	cache := cachestore.New(db, time.Hour)
+	counting := &countingStore{Store: cache}
+	s := &Server{DB: counting, ServerAddress: net.IPv4(10, 1, 0, 254), replies: newReplyCache(ReplyCacheSize)}
+	s.Reload(&Settings{LeaseTime: time.Hour, ReplyCacheTTL: time.Minute})
+	oob := &ipv4.ControlMessage{IfIndex: 2}
+	ctx := context.Background()
+
+	req, err := dhcpv4.NewDiscovery(mac)
+	require.NoError(t, err)
+
+	hits := testutil.ToFloat64(replyCacheHits.WithLabelValues("DISCOVER"))
+	resp := s.cachedReply4(ctx, req, oob)
+	require.NotNil(t, resp)
+	assert.Equal(t, "10.1.0.2", resp.YourIPAddr.String())
+	assert.Equal(t, 1, counting.loads)
+
+	// A retransmission is answered from the cache
+	resp = s.cachedReply4(ctx, req, oob)
+	require.NotNil(t, resp)
+	assert.Equal(t, "10.1.0.2", resp.YourIPAddr.String())
+	assert.Equal(t, 1, counting.loads)
+	assert.Equal(t, hits+1, testutil.ToFloat64(replyCacheHits.WithLabelValues("DISCOVER")))
+
+	// A new transaction looks up the host
+	other, err := dhcpv4.NewDiscovery(mac)
+	require.NoError(t, err)
+	require.NotNil(t, s.cachedReply4(ctx, other, oob))
+	assert.Equal(t, 2, counting.loads)
+
+	// Saving the host drops the cached replies
+	host.Interfaces[0].IP = netip.MustParsePrefix("10.1.0.3/24")
+	require.NoError(t, cache.Bypass().StoreHost(host))
+	resp = s.cachedReply4(ctx, req, oob)
+	require.NotNil(t, resp)
+	assert.Equal(t, "10.1.0.3", resp.YourIPAddr.String())
+	assert.Equal(t, 3, counting.loads)
+
+	// So does a reload
+	s.Reload(&Settings{LeaseTime: time.Hour, ReplyCacheTTL: time.Minute})
+	assert.Equal(t, 0, s.replies.Len())
+
+	// Without a TTL nothing is cached
+	s.Reload(&Settings{LeaseTime: time.Hour})
+	s.cachedReply4(ctx, req, oob)
+	s.cachedReply4(ctx, req, oob)
+	assert.Equal(t, 5, counting.loads)
+	assert.Equal(t, 0, s.replies.Len())
+}
+
+func TestReplyCacheEvict(t *testing.T) {
+	c := newReplyCache(2)
+	now := time.Now()
+
+	reqs := make([]*dhcpv4.DHCPv4, 3)
+	for i := range reqs {
+		req, err := dhcpv4.NewDiscovery(net.HardwareAddr{0xd0, 0x94, 0x66, 0x12, 0x34, byte(i)})
+		require.NoError(t, err)
+		reqs[i] = req
+		resp, err := dhcpv4.NewReplyFromRequest(req)
+		require.NoError(t, err)
+		c.put(newReplyKey(req, 0), resp, 1, now.Add(time.Minute))
+	}
+
+	// The least recently used reply is dropped first
+	assert.Equal(t, 2, c.Len())
+	assert.Nil(t, c.get(newReplyKey(reqs[0], 0), 1, now))
+	assert.NotNil(t, c.get(newReplyKey(reqs[2], 0), 1, now))
+
+	// Expired replies and replies of another generation are not used
+	assert.Nil(t, c.get(newReplyKey(reqs[1], 0), 2, now))
+	assert.Nil(t, c.get(newReplyKey(reqs[2], 0), 1, now.Add(time.Hour)))
+	assert.Equal(t, 0, c.Len())
+}
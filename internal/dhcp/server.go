@@ -9,36 +9,82 @@ import (
 	"errors"
 	"fmt"
 	"net"
+	"net/netip"
 	"strconv"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/insomniacslk/dhcp/dhcpv4"
 	"github.com/insomniacslk/dhcp/dhcpv4/server4"
 	"github.com/sirupsen/logrus"
+	"github.com/ubccr/grendel/internal/ha"
 	"github.com/ubccr/grendel/internal/logger"
 	"github.com/ubccr/grendel/internal/store"
+	"github.com/ubccr/grendel/internal/store/eventstore"
+	"github.com/ubccr/grendel/internal/store/namespace"
 	"github.com/ubccr/grendel/internal/util"
 	"golang.org/x/net/ipv4"
+	"golang.org/x/sys/unix"
 )
 
 var log = logger.GetLogger("DHCP")
 
+// DefaultStoreTimeout is the deadline of the datastore operations of a
+// request unless dhcp.store_timeout is set. Clients retransmit after about 4
+// seconds
+const DefaultStoreTimeout = 2 * time.Second
+
+// Settings are the settings of a running server which change on reload. The
+// settings are replaced as a whole, handlers load them once per request
+type Settings struct {
+	LeaseTime    time.Duration
+	UpdateMAC    bool
+	BMCDiscovery *BMCDiscovery
+
+	// ReplyCacheTTL is how long replies are reused for retransmitted
+	// requests, 0 disables the reply cache
+	ReplyCacheTTL time.Duration
+
+	// StoreTimeout is the deadline of the datastore operations of a
+	// request, a request not answered in time is ignored. 0 sets no deadline
+	StoreTimeout time.Duration
+
+	// States are the host states answered, DefaultStates when empty
+	States []string
+
+	// BootStates are the host states given boot options, DefaultBootStates
+	// when empty
+	BootStates []string
+
+	// StrictSubnets withholds the replies to hosts with an address in none
+	// of dhcp.subnets instead of answering without the settings of a subnet
+	StrictSubnets bool
+}
+
 type Server struct {
 	ListenAddress  net.IP
 	ServerAddress  net.IP
 	InterfaceIPMap map[int]net.IP
+	LocalPrefixes  []netip.Prefix
 	Port           int
 	ProxyOnly      bool
 	DB             store.Store
-	LeaseTime      time.Duration
+	Namespaces     *namespace.Registry // selected by subnet, only DB is used when nil
+	Events         *eventstore.Store
+	HA             *ha.Node
+	PacketConn     net.PacketConn // socket passed by systemd, used instead of binding ListenAddress
+	settings       atomic.Pointer[Settings]
+	replies        *replyCache
+	probes         probes
+	smbios         smbiosCaptures
 	conn           *ipv4.PacketConn
 	quit           chan interface{}
 	wg             sync.WaitGroup
 }
 
 func NewServer(db store.Store, address string) (*Server, error) {
-	s := &Server{DB: db, quit: make(chan interface{})}
+	s := &Server{DB: db, Events: eventstore.Default, replies: newReplyCache(ReplyCacheSize), quit: make(chan interface{})}
 
 	if address == "" {
 		address = fmt.Sprintf("%s:%d", net.IPv4zero.String(), dhcpv4.ServerPort)
@@ -83,6 +129,11 @@ func NewServer(db store.Store, address string) (*Server, error) {
 
 	s.InterfaceIPMap = intfMap
 
+	s.LocalPrefixes, err = util.GetInterfacePrefixes()
+	if err != nil {
+		return nil, err
+	}
+
 	return s, nil
 }
 
@@ -91,17 +142,85 @@ func (s *Server) mainHandler4(peer *net.UDPAddr, req *dhcpv4.DHCPv4, oob *ipv4.C
 		log.Debugf("Ignoring not a BootRequest")
 		return
 	}
+	observeRequest("dhcp", req)
 
-	host, err := s.DB.LoadHostFromMAC(req.ClientHWAddr.String())
-	if err != nil {
-		if errors.Is(err, store.ErrNotFound) {
-			log.Debugf("Ignoring unknown client mac address: %s", req.ClientHWAddr)
-		} else {
-			log.Errorf("Failed to find host from database: %s", err)
+	if !s.HA.Serving() {
+		log.Debugf("Standby in high availability mode, ignoring request from %s", req.ClientHWAddr)
+		return
+	}
+
+	ctx, cancel := store.WithTimeout(context.Background(), s.Settings().StoreTimeout)
+	defer cancel()
+
+	resp := s.cachedReply4(ctx, req, oob)
+	if resp == nil {
+		return
+	}
+
+	peer = &net.UDPAddr{IP: net.IPv4bcast, Port: dhcpv4.ClientPort}
+	if !req.GatewayIPAddr.IsUnspecified() {
+		peer = &net.UDPAddr{IP: req.GatewayIPAddr, Port: dhcpv4.ServerPort}
+		resp.SetBroadcast()
+	} else if req.ClientIPAddr != nil && !req.ClientIPAddr.Equal(net.IPv4zero) {
+		peer = &net.UDPAddr{IP: req.ClientIPAddr, Port: dhcpv4.ClientPort}
+		resp.SetUnicast()
+	}
+
+	var woob *ipv4.ControlMessage
+	if peer.IP.Equal(net.IPv4bcast) || peer.IP.IsLinkLocalUnicast() {
+		switch {
+		case oob != nil && oob.IfIndex != 0:
+			woob = &ipv4.ControlMessage{IfIndex: oob.IfIndex}
+		default:
+			log.Errorf("mainHandler4: Did not receive interface information")
 		}
+	}
+
+	log.Debugf("Sending DHCPv4 packet response")
+	log.Debugln(resp.Summary())
+
+	if _, err := s.conn.WriteTo(resp.ToBytes(), woob, peer); err != nil {
+		log.Printf("DHCP write to %v failed: %v", peer, err)
 		return
 	}
+	observeReply("dhcp", resp)
+
+	if s.HA != nil {
+		entry := log.WithFields(s.HA.Fields()).WithFields(logrus.Fields{
+			logger.FieldMAC: req.ClientHWAddr.String(),
+			logger.FieldIP:  resp.YourIPAddr.String(),
+			"type":          resp.MessageType().String(),
+		})
+		// Replies are tagged with the instance name to find the clients
+		// answered by more than one instance
+		if s.HA.Overlap() {
+			entry.Warn("Sent DHCP reply while other instances answer DHCP")
+		} else {
+			entry.Debug("Sent DHCP reply")
+		}
+	}
+}
+
+// store returns the store of the namespace of the subnet of the relay agent
+// of req, or else of serverIP, the address of the interface req came in on
+func (s *Server) store(req *dhcpv4.DHCPv4, serverIP net.IP) store.Store {
+	if s.Namespaces == nil {
+		return s.DB
+	}
+
+	addr := serverIP
+	if req.GatewayIPAddr != nil && !req.GatewayIPAddr.IsUnspecified() {
+		addr = req.GatewayIPAddr
+	}
+	ip, _ := netip.AddrFromSlice(addr)
+	_, db := s.Namespaces.ForAddr(ip.Unmap())
 
+	return db
+}
+
+// reply4 looks up the host of req and builds the reply. It returns nil when
+// req is not answered
+func (s *Server) reply4(ctx context.Context, req *dhcpv4.DHCPv4, oob *ipv4.ControlMessage) *dhcpv4.DHCPv4 {
 	serverIP := s.ServerAddress
 	// Use the IP address of the interface the request came in on for the
 	// ServerIP if available.
@@ -109,6 +228,32 @@ func (s *Server) mainHandler4(peer *net.UDPAddr, req *dhcpv4.DHCPv4, oob *ipv4.C
 		serverIP = intfIP
 	}
 
+	db := s.store(req, serverIP).WithContext(ctx)
+	host, err := db.LoadHostFromMAC(req.ClientHWAddr.String())
+	if errors.Is(err, store.ErrNotFound) {
+		host, err = s.hostFromSMBIOSUUID(db, req)
+	} else if err == nil {
+		s.captureSMBIOSUUID(db, host, req)
+	}
+	if err != nil {
+		if errors.Is(err, store.ErrNotFound) {
+			log.Debugf("Ignoring unknown client mac address: %s", req.ClientHWAddr)
+			s.discoverBMC(db, req, advertisedIP(s.LocalPrefixes, clientAddr(nil, req), serverIP))
+		} else if store.DeadlineExceeded(ctx, "dhcp", err) {
+			log.Warnf("Ignoring request from %s, datastore did not answer in time: %s", req.ClientHWAddr, err)
+		} else {
+			log.Errorf("Failed to find host from database: %s", err)
+		}
+		return nil
+	}
+
+	if !s.Settings().serves(host) {
+		log.Infof("Ignoring host %s in state %s: %s", host.Name, host.State, req.ClientHWAddr)
+		return nil
+	}
+
+	serverIP = advertisedIP(s.LocalPrefixes, clientAddr(host.DHCPInterface(req.ClientHWAddr), req), serverIP)
+
 	resp, err := dhcpv4.NewReplyFromRequest(req,
 		dhcpv4.WithServerIP(serverIP),
 		dhcpv4.WithMessageType(dhcpv4.MessageTypeOffer),
@@ -117,7 +262,7 @@ func (s *Server) mainHandler4(peer *net.UDPAddr, req *dhcpv4.DHCPv4, oob *ipv4.C
 	)
 	if err != nil {
 		log.Printf("DHCP failed to build reply: %v", err)
-		return
+		return nil
 	}
 
 	// Copy hop count? is this needed?
@@ -132,98 +277,168 @@ func (s *Server) mainHandler4(peer *net.UDPAddr, req *dhcpv4.DHCPv4, oob *ipv4.C
 		err := s.bootingHandler4(host, serverIP, req, resp)
 		if err != nil {
 			log.WithFields(logrus.Fields{
-				"mac":      req.ClientHWAddr.String(),
-				"host_uid": host.UID.String(),
-				"err":      err,
+				logger.FieldMAC:  req.ClientHWAddr.String(),
+				logger.FieldHost: host.Name,
+				"host_uid":       host.UID.String(),
+				"err":            err,
 			}).Error("Failed to add boot options to DHCP request")
 			if s.ProxyOnly {
-				return
+				return nil
 			}
 		}
 
 		if !s.ProxyOnly {
 			err := s.staticHandler4(host, serverIP, req, resp)
+			if errors.Is(err, errOutsideSubnets) {
+				return nil
+			}
 			if err != nil {
 				log.Errorf("Failed to add client ip to DHCP DISCOVER: %s", err)
-				return
+				return nil
 			}
 		}
 	case dhcpv4.MessageTypeRequest, dhcpv4.MessageTypeInform:
 		if s.ProxyOnly {
-			return
+			return nil
 		}
 
 		err := s.staticAckHandler4(host, serverIP, req, resp)
+		if errors.Is(err, errOutsideSubnets) {
+			return nil
+		}
 		if err != nil {
 			log.Errorf("Failed to ack DHCP REQUEST: %s", err)
-			return
+			return nil
+		}
+	case dhcpv4.MessageTypeDecline:
+		if !s.ProxyOnly {
+			s.declineHandler4(db, host, req)
 		}
+		return nil
 	default:
 		log.Warnf("DHCP Unhandled message type: %v", mt)
 		log.Debugln(resp.Summary())
-		return
+		return nil
 	}
 
-	peer = &net.UDPAddr{IP: net.IPv4bcast, Port: dhcpv4.ClientPort}
-	if !req.GatewayIPAddr.IsUnspecified() {
-		peer = &net.UDPAddr{IP: req.GatewayIPAddr, Port: dhcpv4.ServerPort}
-		resp.SetBroadcast()
-	} else if req.ClientIPAddr != nil && !req.ClientIPAddr.Equal(net.IPv4zero) {
-		peer = &net.UDPAddr{IP: req.ClientIPAddr, Port: dhcpv4.ClientPort}
-		resp.SetUnicast()
-	}
+	return resp
+}
 
-	var woob *ipv4.ControlMessage
-	if peer.IP.Equal(net.IPv4bcast) || peer.IP.IsLinkLocalUnicast() {
-		switch {
-		case oob != nil && oob.IfIndex != 0:
-			woob = &ipv4.ControlMessage{IfIndex: oob.IfIndex}
-		default:
-			log.Errorf("mainHandler4: Did not receive interface information")
+// Listen binds the UDP socket of the server. Serve binds it when Listen was
+// not called
+func (s *Server) Listen() error {
+	if s.PacketConn != nil {
+		conn, err := adoptUDP4(s.PacketConn)
+		if err != nil {
+			return err
 		}
+
+		s.conn = conn
+		return nil
 	}
 
-	log.Debugf("Sending DHCPv4 packet response")
-	log.Debugln(resp.Summary())
+	conn, err := listenUDP4(s.ListenAddress, s.Port)
+	if err != nil {
+		return err
+	}
 
-	if _, err := s.conn.WriteTo(resp.ToBytes(), woob, peer); err != nil {
-		log.Printf("DHCP write to %v failed: %v", peer, err)
+	s.conn = conn
+	return nil
+}
+
+// Addr returns the address the server is bound to, nil until Listen is
+// called
+func (s *Server) Addr() net.Addr {
+	if s.conn == nil {
+		return nil
 	}
+	return s.conn.LocalAddr()
 }
 
 func (s *Server) Serve() error {
+	if s.conn == nil {
+		if err := s.Listen(); err != nil {
+			return err
+		}
+	}
+
+	log.Infof("Server listening on: %s:%d", s.ListenAddress, s.Port)
+	return s.serve()
+}
+
+// listenUDP4 binds a UDP socket to address and port. A socket bound to a
+// specific address is bound to the interface of the address to receive
+// broadcasts
+func listenUDP4(address net.IP, port int) (*ipv4.PacketConn, error) {
 	listener := &net.UDPAddr{
-		IP:   s.ListenAddress,
-		Port: s.Port,
+		IP:   address,
+		Port: port,
 	}
 
 	intf := ""
-	if !s.ListenAddress.To4().Equal(net.IPv4zero) {
-		iface, _, err := util.GetInterfaceFromIP(s.ListenAddress)
+	if !address.To4().Equal(net.IPv4zero) {
+		iface, _, err := util.GetInterfaceFromIP(address)
 		if err != nil {
-			return err
+			return nil, err
 		}
 		intf = iface
-		listener = &net.UDPAddr{Port: s.Port}
+		listener = &net.UDPAddr{Port: port}
 		log.Printf("Binding to interface: %s", intf)
 	}
 
 	udpConn, err := server4.NewIPv4UDPConn(intf, listener)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	s.conn = ipv4.NewPacketConn(udpConn)
-	err = s.conn.SetControlMessage(ipv4.FlagInterface, true)
+	conn := ipv4.NewPacketConn(udpConn)
+	if err := conn.SetControlMessage(ipv4.FlagInterface, true); err != nil {
+		conn.Close()
+		return nil, err
+	}
+
+	return conn, nil
+}
+
+// adoptUDP4 sets up a UDP socket bound by systemd like listenUDP4, enabling
+// broadcasts. Binding to an interface is left to BindToDevice= of the socket
+// unit
+func adoptUDP4(pc net.PacketConn) (*ipv4.PacketConn, error) {
+	udpConn, ok := pc.(*net.UDPConn)
+	if !ok {
+		return nil, fmt.Errorf("socket %s is not a UDP socket", pc.LocalAddr())
+	}
+
+	rc, err := udpConn.SyscallConn()
 	if err != nil {
-		return err
+		return nil, err
+	}
+	var serr error
+	err = rc.Control(func(fd uintptr) {
+		serr = unix.SetsockoptInt(int(fd), unix.SOL_SOCKET, unix.SO_BROADCAST, 1)
+	})
+	if err == nil {
+		err = serr
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to enable broadcasts on %s: %w", pc.LocalAddr(), err)
 	}
 
-	log.Infof("Server listening on: %s:%d", s.ListenAddress, s.Port)
-	return s.serve()
+	conn := ipv4.NewPacketConn(udpConn)
+	if err := conn.SetControlMessage(ipv4.FlagInterface, true); err != nil {
+		return nil, err
+	}
+
+	return conn, nil
 }
 
 func (s *Server) serve() error {
+	s.wg.Add(1)
+	go func() {
+		s.storeSMBIOSUUIDs()
+		s.wg.Done()
+	}()
+
 	var buf [1500]byte
 	for {
 		n, oob, peer, err := s.conn.ReadFrom(buf[:])
@@ -243,6 +458,10 @@ func (s *Server) serve() error {
 				continue
 			}
 
+			if s.probes.answer(peer, m) {
+				continue
+			}
+
 			upeer, ok := peer.(*net.UDPAddr)
 			if !ok {
 				log.Printf("Not a UDP connection? Peer is %s", peer)
@@ -267,6 +486,25 @@ func (s *Server) serve() error {
 	return nil
 }
 
+// Reload replaces the settings of a running server. Requests being handled
+// keep the settings they started with. Cached replies are dropped as they may
+// have been built with the previous configuration
+func (s *Server) Reload(settings *Settings) {
+	s.settings.Store(settings)
+	if s.replies != nil {
+		s.replies.reset()
+	}
+}
+
+// Settings returns the settings in effect, zero before the first Reload
+func (s *Server) Settings() *Settings {
+	if settings := s.settings.Load(); settings != nil {
+		return settings
+	}
+
+	return &Settings{}
+}
+
 func (s *Server) Shutdown(ctx context.Context) error {
 	close(s.quit)
 	if s.conn == nil {
@@ -0,0 +1,239 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package dhcp
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/insomniacslk/dhcp/dhcpv4"
+	"github.com/sirupsen/logrus"
+	"github.com/ubccr/grendel/internal/logger"
+	"github.com/ubccr/grendel/internal/store"
+	"github.com/ubccr/grendel/internal/store/namespace"
+	"github.com/ubccr/grendel/pkg/model"
+)
+
+// SMBIOSFlushInterval is how often the SMBIOS UUIDs captured from requests
+// are stored
+const SMBIOSFlushInterval = 5 * time.Second
+
+// clientSMBIOSUUID returns the SMBIOS UUID of the client machine identifier
+// option 97 sent by PXE clients or an empty string if the request has none
+func clientSMBIOSUUID(req *dhcpv4.DHCPv4) string {
+	opt := req.Options.Get(dhcpv4.OptionClientMachineIdentifier)
+
+	// The first byte is the identifier type, 0 for a 16 byte GUID
+	if len(opt) != 17 || opt[0] != 0 {
+		return ""
+	}
+
+	id, err := model.SMBIOSUUIDFromGUID(opt[1:])
+	if err != nil {
+		return ""
+	}
+
+	return id
+}
+
+// hostFromSMBIOSUUID returns the host with the SMBIOS UUID of a request from
+// an unknown MAC address, such as after a NIC was replaced. If UpdateMAC is
+// set the MAC address of the boot interface of the host is updated to the MAC
+// address of the request and recorded in the event log. Otherwise a warning
+// is logged and ErrNotFound is returned.
+func (s *Server) hostFromSMBIOSUUID(db store.Store, req *dhcpv4.DHCPv4) (*model.Host, error) {
+	id := clientSMBIOSUUID(req)
+	if id == "" {
+		return nil, store.ErrNotFound
+	}
+
+	host, err := db.LoadHostFromSMBIOSUUID(id)
+	if err != nil {
+		return nil, err
+	}
+
+	fields := logrus.Fields{
+		logger.FieldHost: host.Name,
+		logger.FieldMAC:  req.ClientHWAddr.String(),
+		"smbios_uuid":    id,
+	}
+
+	updateMAC := s.Settings().UpdateMAC
+
+	nic := host.BootInterface()
+	if nic == nil || !updateMAC {
+		log.WithFields(fields).Warn("MAC changed: request from unknown MAC address matches the SMBIOS UUID of a known host. Ignoring")
+		return nil, store.ErrNotFound
+	}
+
+	oldMAC := nic.MAC.String()
+	nic.MAC = req.ClientHWAddr
+	if err := db.StoreHost(host); errors.Is(err, store.ErrReadOnly) {
+		log.WithFields(fields).Warnf("MAC changed: request from unknown MAC address matches the SMBIOS UUID of a known host, not updating %s in read-only mode. Ignoring", oldMAC)
+		return nil, store.ErrNotFound
+	} else if err != nil {
+		return nil, fmt.Errorf("failed to update MAC address of host %s: %w", host.Name, err)
+	}
+
+	msg := fmt.Sprintf("MAC changed: updated interface %s of host %s from %s to %s matching SMBIOS UUID %s", nic.Name, host.Name, oldMAC, nic.MAC, id)
+	log.WithFields(fields).Warn(msg)
+	s.Events.StoreEvents(model.Event{
+		Severity:  model.SeverityWarning.String(),
+		Time:      time.Now().UTC(),
+		User:      "dhcp",
+		Message:   msg,
+		Namespace: namespace.Of(db),
+	})
+
+	return host, nil
+}
+
+// smbiosCapture is an SMBIOS UUID seen in a request of a host without one
+type smbiosCapture struct {
+	db   store.Store
+	host string
+	mac  string
+	id   string
+}
+
+func (c smbiosCapture) log() *logrus.Entry {
+	return log.WithFields(logrus.Fields{
+		logger.FieldHost: c.host,
+		logger.FieldMAC:  c.mac,
+		"smbios_uuid":    c.id,
+	})
+}
+
+// smbiosCaptures holds the SMBIOS UUIDs captured from requests until they are
+// stored in a batch, so the packet handlers never write to the store
+type smbiosCaptures struct {
+	mu      sync.Mutex
+	pending map[string]smbiosCapture
+}
+
+// add queues c, keeping the first UUID captured for a host until it is stored
+func (p *smbiosCaptures) add(c smbiosCapture) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.pending == nil {
+		p.pending = make(map[string]smbiosCapture)
+	}
+	key := namespace.Of(c.db) + "/" + c.host
+	if _, ok := p.pending[key]; ok {
+		return
+	}
+	p.pending[key] = c
+}
+
+// take removes and returns the queued captures grouped by namespace
+func (p *smbiosCaptures) take() map[string][]smbiosCapture {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	batches := make(map[string][]smbiosCapture)
+	for _, c := range p.pending {
+		ns := namespace.Of(c.db)
+		batches[ns] = append(batches[ns], c)
+	}
+	p.pending = nil
+
+	return batches
+}
+
+// captureSMBIOSUUID queues the SMBIOS UUID of the request to be stored on a
+// host that does not have one yet
+func (s *Server) captureSMBIOSUUID(db store.Store, host *model.Host, req *dhcpv4.DHCPv4) {
+	id := clientSMBIOSUUID(req)
+	if id == "" || id == host.SMBIOSUUID {
+		return
+	}
+
+	if host.SMBIOSUUID != "" {
+		log.WithFields(logrus.Fields{
+			logger.FieldHost: host.Name,
+			logger.FieldMAC:  req.ClientHWAddr.String(),
+			"smbios_uuid":    id,
+		}).Debugf("Ignoring SMBIOS UUID different from %s", host.SMBIOSUUID)
+		return
+	}
+
+	s.smbios.add(smbiosCapture{db: db, host: host.Name, mac: req.ClientHWAddr.String(), id: id})
+}
+
+// storeSMBIOSUUIDs stores the captured SMBIOS UUIDs every SMBIOSFlushInterval
+// until the server is shut down
+func (s *Server) storeSMBIOSUUIDs() {
+	ticker := time.NewTicker(SMBIOSFlushInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-s.quit:
+			s.flushSMBIOSUUIDs()
+			return
+		case <-ticker.C:
+			s.flushSMBIOSUUIDs()
+		}
+	}
+}
+
+// flushSMBIOSUUIDs stores the captured SMBIOS UUIDs with one write per
+// namespace. Hosts are reloaded first and skipped if they got a UUID since
+// the capture. If the batch fails the hosts are stored one at a time so a
+// single conflict does not drop the others.
+func (s *Server) flushSMBIOSUUIDs() {
+	for _, captures := range s.smbios.take() {
+		db := captures[0].db.WithContext(context.Background())
+
+		hosts := make(model.HostList, 0, len(captures))
+		stored := make([]smbiosCapture, 0, len(captures))
+		for _, c := range captures {
+			host, err := db.LoadHostFromName(c.host)
+			if err != nil {
+				c.log().Warnf("Failed to load host to store SMBIOS UUID: %s", err)
+				continue
+			}
+			if host.SMBIOSUUID != "" {
+				continue
+			}
+			host.SMBIOSUUID = c.id
+			hosts = append(hosts, host)
+			stored = append(stored, c)
+		}
+
+		if len(hosts) == 0 {
+			continue
+		}
+
+		err := db.StoreHosts(hosts)
+		if errors.Is(err, store.ErrReadOnly) {
+			log.Infof("Not storing SMBIOS UUID of %d hosts in read-only mode", len(hosts))
+			continue
+		}
+		if err == nil {
+			for _, c := range stored {
+				c.log().Info("Stored SMBIOS UUID of host")
+			}
+			continue
+		}
+
+		for i, host := range hosts {
+			c := stored[i]
+			if err := db.StoreHost(host); err != nil {
+				if errors.Is(err, store.ErrConflict) {
+					c.log().Warnf("Failed to store SMBIOS UUID: %s", err)
+					continue
+				}
+				c.log().Errorf("Failed to store SMBIOS UUID: %s", err)
+				continue
+			}
+			c.log().Info("Stored SMBIOS UUID of host")
+		}
+	}
+}
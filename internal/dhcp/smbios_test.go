@@ -0,0 +1,93 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package dhcp
+
+import (
+	"net"
+	"testing"
+
+	"github.com/insomniacslk/dhcp/dhcpv4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/ubccr/grendel/internal/store/eventstore"
+	"github.com/ubccr/grendel/internal/store/sqlstore"
+	"github.com/ubccr/grendel/pkg/model"
+)
+
+func smbiosRequest(t *testing.T, mac net.HardwareAddr, guid byte) (*dhcpv4.DHCPv4, string) {
+	id := make([]byte, 17)
+	for i := range id[1:] {
+		id[i+1] = guid
+	}
+
+	req, err := dhcpv4.New(
+		dhcpv4.WithHwAddr(mac),
+		dhcpv4.WithMessageType(dhcpv4.MessageTypeDiscover),
+		dhcpv4.WithOption(dhcpv4.OptGeneric(dhcpv4.OptionClientMachineIdentifier, id)),
+	)
+	require.NoError(t, err)
+
+	uuid, err := model.SMBIOSUUIDFromGUID(id[1:])
+	require.NoError(t, err)
+
+	return req, uuid
+}
+
+func TestCaptureSMBIOSUUID(t *testing.T) {
+	db, err := sqlstore.New(":memory:")
+	require.NoError(t, err)
+
+	macA := net.HardwareAddr{0xd0, 0x94, 0x66, 0x12, 0x34, 0x01}
+	macB := net.HardwareAddr{0xd0, 0x94, 0x66, 0x12, 0x34, 0x02}
+	macC := net.HardwareAddr{0xd0, 0x94, 0x66, 0x12, 0x34, 0x03}
+	require.NoError(t, db.StoreHosts(model.HostList{
+		{Name: "cpn-01", Interfaces: []*model.NetInterface{{MAC: macA}}},
+		{Name: "cpn-02", Interfaces: []*model.NetInterface{{MAC: macB}}},
+		{Name: "cpn-03", Interfaces: []*model.NetInterface{{MAC: macC}}, SMBIOSUUID: "4c4c4544-0042-3510-8052-b4c04f4b4b32"},
+	}))
+
+	s := &Server{DB: db, Events: &eventstore.Store{}}
+
+	capture := func(mac net.HardwareAddr, guid byte) string {
+		host, err := db.LoadHostFromMAC(mac.String())
+		require.NoError(t, err)
+		req, id := smbiosRequest(t, mac, guid)
+		s.captureSMBIOSUUID(db, host, req)
+		return id
+	}
+
+	idA := capture(macA, 0x11)
+	capture(macA, 0x12)
+	idB := capture(macB, 0x21)
+	capture(macC, 0x31)
+
+	// Captures are only stored when flushed
+	host, err := db.LoadHostFromName("cpn-01")
+	require.NoError(t, err)
+	assert.Empty(t, host.SMBIOSUUID)
+
+	// A host given a UUID since the capture keeps it
+	host, err = db.LoadHostFromName("cpn-02")
+	require.NoError(t, err)
+	host.SMBIOSUUID = "4c4c4544-0042-3510-8052-b4c04f4b4b33"
+	require.NoError(t, db.StoreHost(host))
+
+	s.flushSMBIOSUUIDs()
+
+	host, err = db.LoadHostFromName("cpn-01")
+	require.NoError(t, err)
+	assert.Equal(t, idA, host.SMBIOSUUID)
+
+	host, err = db.LoadHostFromName("cpn-02")
+	require.NoError(t, err)
+	assert.NotEqual(t, idB, host.SMBIOSUUID)
+	assert.Equal(t, "4c4c4544-0042-3510-8052-b4c04f4b4b33", host.SMBIOSUUID)
+
+	host, err = db.LoadHostFromName("cpn-03")
+	require.NoError(t, err)
+	assert.Equal(t, "4c4c4544-0042-3510-8052-b4c04f4b4b32", host.SMBIOSUUID)
+
+	assert.Empty(t, s.smbios.take())
+}
@@ -0,0 +1,41 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package dhcp
+
+import (
+	"github.com/ubccr/grendel/pkg/model"
+)
+
+var (
+	// DefaultStates are the host states given addresses unless dhcp.states
+	// is set
+	DefaultStates = []string{model.HostStateNew, model.HostStateReady, model.HostStateInstalling, model.HostStateProduction, model.HostStateDrained}
+
+	// DefaultBootStates are the host states given boot options unless
+	// dhcp.boot_states is set
+	DefaultBootStates = []string{model.HostStateReady, model.HostStateInstalling}
+)
+
+// serves returns whether the host is answered in its state
+func (s *Settings) serves(host *model.Host) bool {
+	if len(s.States) == 0 {
+		return host.InState(DefaultStates)
+	}
+
+	return host.InState(s.States)
+}
+
+// boots returns whether the host is given boot options in its state
+func (s *Settings) boots(host *model.Host) bool {
+	return bootable(host, s.BootStates)
+}
+
+func bootable(host *model.Host, states []string) bool {
+	if len(states) == 0 {
+		return host.InState(DefaultBootStates)
+	}
+
+	return host.InState(states)
+}
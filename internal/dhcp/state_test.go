@@ -0,0 +1,87 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package dhcp
+
+import (
+	"context"
+	"net"
+	"net/netip"
+	"testing"
+	"time"
+
+	"github.com/insomniacslk/dhcp/dhcpv4"
+	"github.com/insomniacslk/dhcp/iana"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/ubccr/grendel/internal/store/sqlstore"
+	"github.com/ubccr/grendel/pkg/model"
+	"golang.org/x/net/ipv4"
+)
+
+func TestHostStates(t *testing.T) {
+	viper.Reset()
+	defer viper.Reset()
+	viper.Set("provision.secret", "0123456789abcdef0123456789abcdef")
+
+	db, err := sqlstore.New(":memory:")
+	require.NoError(t, err)
+	defer db.Close()
+
+	mac := net.HardwareAddr{0xd0, 0x94, 0x66, 0x12, 0x34, 0x56}
+	host := &model.Host{
+		Name:      "cpn-01",
+		Provision: true,
+		Interfaces: []*model.NetInterface{
+			{MAC: mac, IP: netip.MustParsePrefix("10.1.0.2/24"), FQDN: "cpn-01.example"},
+		},
+	}
+	require.NoError(t, db.StoreHost(host))
+
+	s := &Server{DB: db, ServerAddress: net.IPv4(10, 1, 0, 254)}
+	s.Reload(&Settings{LeaseTime: time.Hour})
+	oob := &ipv4.ControlMessage{IfIndex: 2}
+
+	discover := func() *dhcpv4.DHCPv4 {
+		req, err := dhcpv4.NewDiscovery(mac, dhcpv4.WithOption(dhcpv4.OptClientArch(iana.EFI_X86_64)))
+		require.NoError(t, err)
+		return s.reply4(context.Background(), req, oob)
+	}
+	setState := func(state string) {
+		host, err := db.LoadHostFromName("cpn-01")
+		require.NoError(t, err)
+		host.State = state
+		require.NoError(t, db.StoreHost(host))
+	}
+
+	resp := discover()
+	require.NotNil(t, resp)
+	assert.Equal(t, "10.1.0.2", resp.YourIPAddr.String())
+	assert.NotEmpty(t, resp.BootFileNameOption())
+
+	// Drained hosts get their address without boot options
+	setState(model.HostStateDrained)
+	resp = discover()
+	require.NotNil(t, resp)
+	assert.Equal(t, "10.1.0.2", resp.YourIPAddr.String())
+	assert.Empty(t, resp.BootFileNameOption())
+
+	// Retired hosts are not answered
+	setState(model.HostStateRetired)
+	assert.Nil(t, discover())
+
+	// Boot options only while installing
+	setState(model.HostStateNew)
+	setState(model.HostStateReady)
+	s.Reload(&Settings{LeaseTime: time.Hour, BootStates: []string{model.HostStateInstalling}})
+	resp = discover()
+	require.NotNil(t, resp)
+	assert.Empty(t, resp.BootFileNameOption())
+
+	setState(model.HostStateInstalling)
+	resp = discover()
+	require.NotNil(t, resp)
+	assert.NotEmpty(t, resp.BootFileNameOption())
+}
@@ -9,30 +9,45 @@ import (
 	"net"
 	"slices"
 	"strings"
+	"time"
 
 	"github.com/insomniacslk/dhcp/dhcpv4"
 	"github.com/insomniacslk/dhcp/rfc1035label"
 	"github.com/sirupsen/logrus"
+	"github.com/ubccr/grendel/internal/logger"
+	"github.com/ubccr/grendel/internal/maintenance"
 	"github.com/ubccr/grendel/internal/provision"
 	"github.com/ubccr/grendel/pkg/model"
 )
 
-func (s *Server) setZTD(host *model.Host, nic *model.NetInterface, serverIP net.IP, req, resp *dhcpv4.DHCPv4) {
-	if !host.Provision {
+func (s *Server) setZTD(host *model.Host, nic *model.NetInterface, serverIP net.IP, bootID string, req, resp *dhcpv4.DHCPv4) {
+	if !s.Settings().boots(host) {
 		// Skip if host not set to provision
 		return
 	}
 
+	if maintenance.Enabled() {
+		if ztdRequested(host, req) {
+			maintenance.Suppressed("dhcp", host.Name, logrus.Fields{
+				logger.FieldMAC:    nic.MAC.String(),
+				logger.FieldBootID: bootID,
+			})
+		}
+		return
+	}
+
 	if host.HasTags("arista") {
 		// Arista ZTP
 		// See: https://www.arista.com/en/cg-cv/cv-dhcp-service-for-zero-touch-provisioning-ztp-setup
 
 		log.WithFields(logrus.Fields{
-			"ip":   nic.AddrString(),
-			"name": host.Name,
+			logger.FieldIP:     nic.AddrString(),
+			logger.FieldMAC:    nic.MAC.String(),
+			logger.FieldHost:   host.Name,
+			logger.FieldBootID: bootID,
 		}).Info("Host tagged with Arista ZTP. Setting bootfile URL and config dhcp options")
 
-		token, _ := model.NewBootToken(host.UID.String(), nic.MAC.String())
+		token, _ := model.NewTracedBootToken(host.UID.String(), nic.MAC.String(), bootID)
 		endpoints := provision.NewEndpoints(serverIP.String(), token)
 
 		configURL := endpoints.KickstartURL()
@@ -46,11 +61,13 @@ func (s *Server) setZTD(host *model.Host, nic *model.NetInterface, serverIP net.
 		// See: https://www.dell.com/support/manuals/en-in/networking-mx7116n/smartfabric-os-user-guide-10-5-0/dell-emc-smartfabric-os10-zero-touch-deployment?guid=guid-95ca07a2-2bcb-4ea2-84ef-ef9d11a4fa0e&lang=en-us
 
 		log.WithFields(logrus.Fields{
-			"ip":   nic.AddrString(),
-			"name": host.Name,
+			logger.FieldIP:     nic.AddrString(),
+			logger.FieldMAC:    nic.MAC.String(),
+			logger.FieldHost:   host.Name,
+			logger.FieldBootID: bootID,
 		}).Info("Host tagged with Dell ZTD. Setting ZTD provision URL dhcp option")
 
-		token, _ := model.NewBootToken(host.UID.String(), nic.MAC.String())
+		token, _ := model.NewTracedBootToken(host.UID.String(), nic.MAC.String(), bootID)
 		endpoints := provision.NewEndpoints(serverIP.String(), token)
 
 		provisionURL := endpoints.KickstartURL()
@@ -63,11 +80,13 @@ func (s *Server) setZTD(host *model.Host, nic *model.NetInterface, serverIP net.
 		// See: https://pve.proxmox.com/wiki/Automated_Installation
 
 		log.WithFields(logrus.Fields{
-			"ip":   nic.AddrString(),
-			"name": host.Name,
+			logger.FieldIP:     nic.AddrString(),
+			logger.FieldMAC:    nic.MAC.String(),
+			logger.FieldHost:   host.Name,
+			logger.FieldBootID: bootID,
 		}).Info("Host tagged with proxmox. Setting automated install answer file url")
 
-		token, _ := model.NewBootToken(host.UID.String(), nic.MAC.String())
+		token, _ := model.NewTracedBootToken(host.UID.String(), nic.MAC.String(), bootID)
 		endpoints := provision.NewEndpoints(serverIP.String(), token)
 
 		proxmoxURL := endpoints.ProxmoxURL()
@@ -80,11 +99,13 @@ func (s *Server) setZTD(host *model.Host, nic *model.NetInterface, serverIP net.
 		// See: https://docs.nvidia.com/networking/display/MLNXOSv3103100/Getting+Started#heading-Zero-touchProvisioning
 
 		log.WithFields(logrus.Fields{
-			"ip":   nic.AddrString(),
-			"name": host.Name,
+			logger.FieldIP:     nic.AddrString(),
+			logger.FieldMAC:    nic.MAC.String(),
+			logger.FieldHost:   host.Name,
+			logger.FieldBootID: bootID,
 		}).Info("Host is Mellanox ZTP. Setting bootfile URL and config dhcp options")
 
-		token, _ := model.NewBootToken(host.UID.String(), nic.MAC.String())
+		token, _ := model.NewTracedBootToken(host.UID.String(), nic.MAC.String(), bootID)
 		endpoints := provision.NewEndpoints(serverIP.String(), token)
 
 		configURL, configFilename := endpoints.KickstartURLParts()
@@ -107,11 +128,13 @@ func (s *Server) setZTD(host *model.Host, nic *model.NetInterface, serverIP net.
 		// See: https://github.com/sonic-net/SONiC/blob/master/doc/ztp/ztp.md
 
 		log.WithFields(logrus.Fields{
-			"ip":   nic.AddrString(),
-			"name": host.Name,
+			logger.FieldIP:     nic.AddrString(),
+			logger.FieldMAC:    nic.MAC.String(),
+			logger.FieldHost:   host.Name,
+			logger.FieldBootID: bootID,
 		}).Info("Host tagged with Dell ZTP. Setting ZTP provision URL dhcp option")
 
-		token, _ := model.NewBootToken(host.UID.String(), nic.MAC.String())
+		token, _ := model.NewTracedBootToken(host.UID.String(), nic.MAC.String(), bootID)
 		endpoints := provision.NewEndpoints(serverIP.String(), token)
 
 		provisionURL := endpoints.KickstartURL()
@@ -124,36 +147,57 @@ func (s *Server) setZTD(host *model.Host, nic *model.NetInterface, serverIP net.
 		// Eaton ZTP
 		// See: https://www.eaton.com/content/dam/eaton/products/backup-power-ups-surge-it-power-distribution/power-management-software-connectivity/eaton-gigabit-network-card/network-m3/resources/eaton-zero-touch-provisioning-with-gigabit-network-interfaces-wp158004en.pdf
 
-		token, _ := model.NewBootToken(host.UID.String(), nic.MAC.String())
+		token, _ := model.NewTracedBootToken(host.UID.String(), nic.MAC.String(), bootID)
 		endpoints := provision.NewEndpoints(serverIP.String(), token)
 		configURL := endpoints.KickstartURL()
 		resp.UpdateOption(dhcpv4.Option{Code: dhcpv4.OptionVendorSpecificInformation, Value: dhcpv4.String(configURL)})
 
 		log.WithFields(logrus.Fields{
-			"ip":   nic.AddrString(),
-			"name": host.Name,
+			logger.FieldIP:     nic.AddrString(),
+			logger.FieldMAC:    nic.MAC.String(),
+			logger.FieldHost:   host.Name,
+			logger.FieldBootID: bootID,
 		}).Info("Host is Eaton ZTP. Setting bootfile URL and config dhcp options")
 	}
 }
 
+// ztdRequested returns true when setZTD would add zero touch provisioning
+// options to the reply to req
+func ztdRequested(host *model.Host, req *dhcpv4.DHCPv4) bool {
+	class := req.ClassIdentifier()
+
+	return host.HasTags("arista") || host.HasTags("dellztd") || host.HasTags("proxmox") ||
+		class == "NVIDIA" || class == "Mellanox" || strings.HasPrefix(class, "Eaton") ||
+		slices.Contains(req.UserClass(), "SONiC-ZTP")
+}
+
 func (s *Server) staticHandler4(host *model.Host, serverIP net.IP, req, resp *dhcpv4.DHCPv4) error {
-	nic := host.Interface(req.ClientHWAddr)
+	nic := host.DHCPInterface(req.ClientHWAddr)
 	if nic == nil {
 		log.Warnf("invalid mac address for host: %s", req.ClientHWAddr)
 		return nil
 	}
 
+	bootID := bootIDs.id(req.ClientHWAddr.String(), req.TransactionID, false, time.Now())
 	log.WithFields(logrus.Fields{
-		"ip":           nic.AddrString(),
-		"mac":          req.ClientHWAddr.String(),
-		"name":         host.Name,
-		"dhcp_message": req.MessageType().String(),
+		logger.FieldIP:     nic.AddrString(),
+		logger.FieldMAC:    req.ClientHWAddr.String(),
+		logger.FieldHost:   host.Name,
+		logger.FieldBootID: bootID,
+		"dhcp_message":     req.MessageType().String(),
 	}).Info("Found host")
 	log.Debugln(req.Summary())
 
+	if err := s.checkSubnet(host, nic, req, time.Now()); err != nil {
+		return err
+	}
+
 	resp.YourIPAddr = nic.ToStdAddr()
 	resp.UpdateOption(dhcpv4.OptSubnetMask(nic.Netmask()))
-	resp.UpdateOption(dhcpv4.OptIPAddressLeaseTime(s.LeaseTime))
+	if req.IsOptionRequested(dhcpv4.OptionBroadcastAddress) {
+		resp.UpdateOption(dhcpv4.OptBroadcastAddress(net.IP(nic.Broadcast().AsSlice())))
+	}
+	resp.UpdateOption(dhcpv4.OptIPAddressLeaseTime(s.Settings().LeaseTime))
 
 	if req.IsOptionRequested(dhcpv4.OptionInterfaceMTU) {
 		resp.UpdateOption(dhcpv4.OptGeneric(dhcpv4.OptionInterfaceMTU, dhcpv4.Uint16(nic.InterfaceMTU()).ToBytes()))
@@ -181,17 +225,25 @@ func (s *Server) staticHandler4(host *model.Host, serverIP net.IP, req, resp *dh
 		}))
 	}
 
-	s.setZTD(host, nic, serverIP, req, resp)
+	s.setZTD(host, nic, serverIP, bootID, req, resp)
 
-	if req.ClassIdentifier() == "iDRAC" && host.Provision {
-		token, _ := model.NewBootToken(host.UID.String(), nic.MAC.String())
+	idrac := req.ClassIdentifier() == "iDRAC" && s.Settings().boots(host)
+	if idrac && maintenance.Enabled() {
+		maintenance.Suppressed("dhcp", host.Name, logrus.Fields{
+			logger.FieldMAC:    nic.MAC.String(),
+			logger.FieldBootID: bootID,
+		})
+	} else if idrac {
+		token, _ := model.NewTracedBootToken(host.UID.String(), nic.MAC.String(), bootID)
 		scpFileLocation := fmt.Sprintf("-f idrac-config.json -i %s -s 5 -n boot/%s/provision", serverIP.String(), token)
 		log.Debugf("Dell iDRAC Auto Config SCP location: %s", scpFileLocation)
 		resp.UpdateOption(dhcpv4.Option{Code: dhcpv4.OptionVendorSpecificInformation, Value: dhcpv4.String(scpFileLocation)})
 
 		log.WithFields(logrus.Fields{
-			"ip":   nic.AddrString(),
-			"name": host.Name,
+			logger.FieldIP:     nic.AddrString(),
+			logger.FieldMAC:    nic.MAC.String(),
+			logger.FieldHost:   host.Name,
+			logger.FieldBootID: bootID,
 		}).Info("Host iDRAC Auto Config requested. Sending VendorSpecificInfo SCP file location")
 	}
 
@@ -216,7 +268,7 @@ func (s *Server) staticAckHandler4(host *model.Host, serverIP net.IP, req, resp
 		requestedIP = req.ClientIPAddr
 	}
 
-	nic := host.Interface(req.ClientHWAddr)
+	nic := host.DHCPInterface(req.ClientHWAddr)
 	if !nic.ToStdAddr().Equal(requestedIP) {
 		// Need to return NACK here. The client is asking for a different IP
 		// address than what's configured in Grendel.
@@ -231,7 +283,8 @@ func (s *Server) staticAckHandler4(host *model.Host, serverIP net.IP, req, resp
 		resp.ClientIPAddr = req.ClientIPAddr
 	}
 
-	s.setZTD(host, nic, serverIP, req, resp)
+	bootID := bootIDs.id(req.ClientHWAddr.String(), req.TransactionID, false, time.Now())
+	s.setZTD(host, nic, serverIP, bootID, req, resp)
 	resp.UpdateOption(dhcpv4.OptMessageType(dhcpv4.MessageTypeAck))
 	return s.staticHandler4(host, serverIP, req, resp)
 }
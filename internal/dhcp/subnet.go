@@ -0,0 +1,94 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package dhcp
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/insomniacslk/dhcp/dhcpv4"
+	"github.com/sirupsen/logrus"
+	"github.com/ubccr/grendel/internal/logger"
+	"github.com/ubccr/grendel/pkg/model"
+)
+
+// subnetWarningInterval is how often the address of a host outside of
+// dhcp.subnets is logged
+const subnetWarningInterval = 10 * time.Minute
+
+// errOutsideSubnets withholds the reply to a host outside of dhcp.subnets
+// with dhcp.strict_subnets set
+var errOutsideSubnets = errors.New("address outside of dhcp.subnets")
+
+// subnetWarnings limits the warnings of hosts outside of dhcp.subnets, shared
+// by the DHCP servers
+var subnetWarnings = newWarnLimiter(subnetWarningInterval)
+
+// warnLimiter allows a warning per key once per interval
+type warnLimiter struct {
+	mu       sync.Mutex
+	interval time.Duration
+	last     map[string]time.Time
+}
+
+func newWarnLimiter(interval time.Duration) *warnLimiter {
+	return &warnLimiter{interval: interval, last: make(map[string]time.Time)}
+}
+
+// allow returns true when no warning of key was allowed in the last interval
+func (w *warnLimiter) allow(key string, now time.Time) bool {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if last, ok := w.last[key]; ok && now.Sub(last) < w.interval {
+		return false
+	}
+
+	for k, last := range w.last {
+		if now.Sub(last) >= w.interval {
+			delete(w.last, k)
+		}
+	}
+	w.last[key] = now
+
+	return true
+}
+
+// checkSubnet reports a host whose address is in none of dhcp.subnets. It
+// is counted and logged at most once per subnetWarningInterval, and
+// errOutsideSubnets is returned to withhold the reply when
+// dhcp.strict_subnets is set
+func (s *Server) checkSubnet(host *model.Host, nic *model.NetInterface, req *dhcpv4.DHCPv4, now time.Time) error {
+	if !nic.OutsideSubnets() {
+		return nil
+	}
+
+	strict := s.Settings().StrictSubnets
+	action := "served"
+	if strict {
+		action = "withheld"
+	}
+	outsideSubnetTotal.WithLabelValues(action).Inc()
+
+	if subnetWarnings.allow(host.Name+" "+nic.AddrString(), now) {
+		entry := log.WithFields(logrus.Fields{
+			logger.FieldIP:   nic.AddrString(),
+			logger.FieldMAC:  req.ClientHWAddr.String(),
+			logger.FieldHost: host.Name,
+		})
+		if strict {
+			entry.Warnf("Address %s of host %s is in none of dhcp.subnets, not answering as dhcp.strict_subnets is set. Fix the address of the host or add its subnet to dhcp.subnets", nic.AddrString(), host.Name)
+		} else {
+			entry.Warnf("Address %s of host %s is in none of dhcp.subnets, the reply lacks the router, DNS servers and MTU of a subnet. Fix the address of the host or add its subnet to dhcp.subnets", nic.AddrString(), host.Name)
+		}
+	}
+
+	if strict {
+		return errOutsideSubnets
+	}
+
+	return nil
+}
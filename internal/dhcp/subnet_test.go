@@ -0,0 +1,130 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package dhcp
+
+import (
+	"context"
+	"net"
+	"net/netip"
+	"testing"
+	"time"
+
+	"github.com/insomniacslk/dhcp/dhcpv4"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/ubccr/grendel/internal/config"
+	"github.com/ubccr/grendel/internal/store"
+	"github.com/ubccr/grendel/internal/store/namespace"
+	"github.com/ubccr/grendel/internal/store/sqlstore"
+	"github.com/ubccr/grendel/pkg/model"
+	"golang.org/x/net/ipv4"
+)
+
+func TestOutsideSubnets(t *testing.T) {
+	viper.Reset()
+	defer viper.Reset()
+	viper.Set("provision.secret", "0123456789abcdef0123456789abcdef")
+
+	cfg := *config.Current()
+	cfg.Subnets = []config.Subnet{{Gateway: netip.MustParsePrefix("10.2.0.254/24")}}
+	defer config.Set(config.Set(&cfg))
+
+	db, err := sqlstore.New(":memory:")
+	require.NoError(t, err)
+	defer db.Close()
+
+	mac := net.HardwareAddr{0xd0, 0x94, 0x66, 0x12, 0x34, 0x57}
+	require.NoError(t, db.StoreHost(&model.Host{
+		Name: "cpn-02",
+		Interfaces: []*model.NetInterface{
+			{MAC: mac, IP: netip.MustParsePrefix("10.1.0.2/24")},
+		},
+	}))
+
+	s := &Server{DB: db, ServerAddress: net.IPv4(10, 1, 0, 254)}
+	s.Reload(&Settings{LeaseTime: time.Hour})
+	discover := func() *dhcpv4.DHCPv4 {
+		req, err := dhcpv4.NewDiscovery(mac)
+		require.NoError(t, err)
+		return s.reply4(context.Background(), req, &ipv4.ControlMessage{IfIndex: 2})
+	}
+
+	served := testutil.ToFloat64(outsideSubnetTotal.WithLabelValues("served"))
+	withheld := testutil.ToFloat64(outsideSubnetTotal.WithLabelValues("withheld"))
+
+	// Answered without a router
+	resp := discover()
+	require.NotNil(t, resp)
+	assert.Equal(t, "10.1.0.2", resp.YourIPAddr.String())
+	assert.Empty(t, resp.Router())
+	assert.Equal(t, served+1, testutil.ToFloat64(outsideSubnetTotal.WithLabelValues("served")))
+
+	s.Reload(&Settings{LeaseTime: time.Hour, StrictSubnets: true})
+	assert.Nil(t, discover())
+	assert.Equal(t, withheld+1, testutil.ToFloat64(outsideSubnetTotal.WithLabelValues("withheld")))
+
+	// Hosts in a subnet are answered with its router
+	both := cfg
+	both.Subnets = append(both.Subnets, config.Subnet{Gateway: netip.MustParsePrefix("10.1.0.254/24")})
+	config.Set(&both)
+	resp = discover()
+	require.NotNil(t, resp)
+	assert.Equal(t, "10.1.0.254", resp.Router()[0].String())
+	assert.Equal(t, withheld+1, testutil.ToFloat64(outsideSubnetTotal.WithLabelValues("withheld")))
+}
+
+func TestWarnLimiter(t *testing.T) {
+	w := newWarnLimiter(time.Minute)
+	now := time.Now()
+
+	assert.True(t, w.allow("cpn-01 10.1.0.2", now))
+	assert.False(t, w.allow("cpn-01 10.1.0.2", now.Add(30*time.Second)))
+	assert.True(t, w.allow("cpn-02 10.1.0.3", now.Add(30*time.Second)))
+	assert.True(t, w.allow("cpn-01 10.1.0.2", now.Add(time.Minute)))
+}
+
+func TestNamespaceSelectedByRelay(t *testing.T) {
+	viper.Reset()
+	defer viper.Reset()
+	viper.Set("provision.secret", "0123456789abcdef0123456789abcdef")
+
+	def, err := sqlstore.New(":memory:")
+	require.NoError(t, err)
+	defer def.Close()
+	other, err := sqlstore.New(":memory:")
+	require.NoError(t, err)
+
+	// The same MAC in both namespaces
+	mac := net.HardwareAddr{0xd0, 0x94, 0x66, 0x12, 0x34, 0x58}
+	for db, ip := range map[*sqlstore.SqlStore]string{def: "10.1.0.3/24", other: "10.3.0.3/24"} {
+		require.NoError(t, db.StoreHost(&model.Host{
+			Name:       "cpn-03",
+			Interfaces: []*model.NetInterface{{MAC: mac, IP: netip.MustParsePrefix(ip)}},
+		}))
+	}
+	registry := namespace.NewRegistry(def, []*namespace.Namespace{
+		{Name: "other", Subnets: []netip.Prefix{netip.MustParsePrefix("10.3.0.0/16")}},
+	}, map[string]store.Store{"other": other})
+	defer registry.Close()
+
+	s := &Server{DB: def, ServerAddress: net.IPv4(10, 1, 0, 254), Namespaces: registry}
+	s.Reload(&Settings{LeaseTime: time.Hour})
+	discover := func(giaddr net.IP) *dhcpv4.DHCPv4 {
+		req, err := dhcpv4.NewDiscovery(mac)
+		require.NoError(t, err)
+		req.GatewayIPAddr = giaddr
+		return s.reply4(context.Background(), req, &ipv4.ControlMessage{IfIndex: 2})
+	}
+
+	resp := discover(nil)
+	require.NotNil(t, resp)
+	assert.Equal(t, "10.1.0.3", resp.YourIPAddr.String())
+
+	resp = discover(net.IPv4(10, 3, 0, 1))
+	require.NotNil(t, resp)
+	assert.Equal(t, "10.3.0.3", resp.YourIPAddr.String())
+}
@@ -0,0 +1,228 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package dns
+
+import (
+	"fmt"
+	"net"
+	"net/netip"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/miekg/dns"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/viper"
+	"go4.org/netipx"
+	"golang.org/x/time/rate"
+)
+
+// Actions taken on the queries of clients denied by an ACL
+const (
+	ACLRefuse = "refuse"
+	ACLDrop   = "drop"
+)
+
+// Verdicts counted by grendel_dns_acl_total
+const (
+	verdictAllow  = "allow"
+	verdictRefuse = "refuse"
+	verdictDrop   = "drop"
+)
+
+var (
+	aclTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "grendel_dns_acl_total",
+		Help: "DNS queries checked against the query and recursion ACLs by verdict",
+	}, []string{"acl", "verdict"})
+
+	// aclLogLimit bounds the denied queries logged, a scan of the server
+	// would flood the log
+	aclLogLimit = rate.NewLimiter(rate.Every(time.Second), 10)
+)
+
+func init() {
+	prometheus.MustRegister(aclTotal)
+}
+
+// ZoneACL restricts the queries for the names of a zone
+type ZoneACL struct {
+	Zone       string   `mapstructure:"zone"`
+	AllowQuery []string `mapstructure:"allow_query"`
+}
+
+type zoneACL struct {
+	zone  string
+	query *netipx.IPSet
+}
+
+// ACL is the set of clients allowed to query the server and to have their
+// queries forwarded. A nil set allows every client. Loopback is not allowed
+// unless listed, the readiness probe queries the server over loopback
+type ACL struct {
+	query     *netipx.IPSet
+	recursion *netipx.IPSet
+	zones     []zoneACL
+	action    string
+}
+
+// ACLFromConfig compiles the ACL set by dns.allow_query, dns.allow_recursion,
+// dns.zone_acls and dns.deny_action. Without dns.allow_recursion the clients
+// allowed to query may have their queries forwarded
+func ACLFromConfig(v *viper.Viper) (*ACL, error) {
+	acl := &ACL{action: strings.ToLower(v.GetString("dns.deny_action"))}
+	switch acl.action {
+	case "":
+		acl.action = ACLRefuse
+	case ACLRefuse, ACLDrop:
+	default:
+		return nil, fmt.Errorf("invalid dns.deny_action %q: expected %s or %s", acl.action, ACLRefuse, ACLDrop)
+	}
+
+	var err error
+	if acl.query, err = prefixSet("dns.allow_query", v.GetStringSlice("dns.allow_query")); err != nil {
+		return nil, err
+	}
+	if acl.recursion, err = prefixSet("dns.allow_recursion", v.GetStringSlice("dns.allow_recursion")); err != nil {
+		return nil, err
+	}
+
+	var zones []ZoneACL
+	if err := v.UnmarshalKey("dns.zone_acls", &zones); err != nil {
+		return nil, fmt.Errorf("failed parsing dns.zone_acls: %w", err)
+	}
+	for _, z := range zones {
+		if z.Zone == "" {
+			return nil, fmt.Errorf("invalid dns.zone_acls: zone required")
+		}
+		set, err := prefixSet("dns.zone_acls allow_query of "+z.Zone, z.AllowQuery)
+		if err != nil {
+			return nil, err
+		}
+		acl.zones = append(acl.zones, zoneACL{zone: strings.ToLower(dns.Fqdn(z.Zone)), query: set})
+	}
+
+	// The most specific zone of a name is checked first
+	slices.SortStableFunc(acl.zones, func(a, b zoneACL) int {
+		return dns.CountLabel(b.zone) - dns.CountLabel(a.zone)
+	})
+
+	return acl, nil
+}
+
+// prefixSet compiles a list of addresses and prefixes, nil when empty.
+// IPv4-mapped prefixes are unmapped as the client addresses are
+func prefixSet(key string, list []string) (*netipx.IPSet, error) {
+	if len(list) == 0 {
+		return nil, nil
+	}
+
+	var b netipx.IPSetBuilder
+	for _, s := range list {
+		prefix, err := netip.ParsePrefix(s)
+		if err != nil {
+			addr, aerr := netip.ParseAddr(s)
+			if aerr != nil {
+				return nil, fmt.Errorf("invalid %s address: %s", key, s)
+			}
+			prefix = netip.PrefixFrom(addr.Unmap(), addr.Unmap().BitLen())
+		} else if prefix.Addr().Is4In6() && prefix.Bits() >= 96 {
+			prefix = netip.PrefixFrom(prefix.Addr().Unmap(), prefix.Bits()-96)
+		}
+		b.AddPrefix(prefix.Masked())
+	}
+
+	return b.IPSet()
+}
+
+func allowed(set *netipx.IPSet, addr netip.Addr) bool {
+	return set == nil || set.Contains(addr)
+}
+
+// AllowQuery returns whether addr may query qname. The ACL of the most
+// specific zone of qname applies, else dns.allow_query
+func (a *ACL) AllowQuery(addr netip.Addr, qname string) bool {
+	if a == nil {
+		return true
+	}
+
+	for _, z := range a.zones {
+		if dns.IsSubDomain(z.zone, qname) {
+			return allowed(z.query, addr)
+		}
+	}
+
+	return allowed(a.query, addr)
+}
+
+// AllowRecursion returns whether the queries of addr may be forwarded
+func (a *ACL) AllowRecursion(addr netip.Addr) bool {
+	if a == nil {
+		return true
+	}
+	if a.recursion == nil {
+		return allowed(a.query, addr)
+	}
+
+	return allowed(a.recursion, addr)
+}
+
+// Action returns what is done with the queries of denied clients
+func (a *ACL) Action() string {
+	if a == nil {
+		return ACLRefuse
+	}
+
+	return a.action
+}
+
+// clientAddr returns the unmapped address of the client of w
+func clientAddr(w dns.ResponseWriter) netip.Addr {
+	var ip net.IP
+	switch addr := w.RemoteAddr().(type) {
+	case *net.UDPAddr:
+		ip = addr.IP
+	case *net.TCPAddr:
+		ip = addr.IP
+	}
+
+	addr, _ := netip.AddrFromSlice(ip)
+	return addr.Unmap()
+}
+
+// checkACL returns whether the query of client for qname passes allow, else
+// answers it with REFUSED or drops it
+func (h *handler) checkACL(w dns.ResponseWriter, r *dns.Msg, kind string, client netip.Addr, qname string, allow bool) bool {
+	if allow {
+		aclTotal.WithLabelValues(kind, verdictAllow).Inc()
+		return true
+	}
+
+	acl := h.acl.Load()
+	verdict := verdictRefuse
+	if acl.Action() == ACLDrop {
+		verdict = verdictDrop
+	}
+	aclTotal.WithLabelValues(kind, verdict).Inc()
+
+	if log.Logger.IsLevelEnabled(logrus.DebugLevel) && aclLogLimit.Allow() {
+		log.WithFields(logrus.Fields{
+			"client":  client.String(),
+			"qname":   qname,
+			"acl":     kind,
+			"verdict": verdict,
+		}).Debug("Denied DNS query")
+	}
+
+	if verdict == verdictRefuse {
+		m := new(dns.Msg)
+		m.SetRcode(r, dns.RcodeRefused)
+		observeQuery(h.QType(r), m.Rcode)
+		w.WriteMsg(m)
+	}
+
+	return false
+}
@@ -0,0 +1,148 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package dns
+
+import (
+	"net"
+	"net/netip"
+	"testing"
+
+	"github.com/miekg/dns"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/ubccr/grendel/internal/store/sqlstore"
+	"github.com/ubccr/grendel/pkg/model"
+)
+
+// recorder is a dns.ResponseWriter recording the reply to a client
+type recorder struct {
+	dns.ResponseWriter
+	client net.Addr
+	msg    *dns.Msg
+}
+
+func (r *recorder) RemoteAddr() net.Addr        { return r.client }
+func (r *recorder) WriteMsg(m *dns.Msg) error   { r.msg = m; return nil }
+func (r *recorder) Write(b []byte) (int, error) { return len(b), nil }
+
+func TestACLFromConfig(t *testing.T) {
+	v := viper.New()
+	acl, err := ACLFromConfig(v)
+	require.NoError(t, err)
+	assert.True(t, acl.AllowQuery(netip.MustParseAddr("192.0.2.1"), "cpn-01.example.local."))
+	assert.True(t, acl.AllowRecursion(netip.MustParseAddr("192.0.2.1")))
+	assert.Equal(t, ACLRefuse, acl.Action())
+
+	v.Set("dns.allow_query", []string{"10.0.0.0/8", "192.0.2.1", "::ffff:172.16.0.0/108"})
+	v.Set("dns.allow_recursion", []string{"10.1.0.0/24"})
+	v.Set("dns.deny_action", "DROP")
+	v.Set("dns.zone_acls", []map[string]any{
+		{"zone": "example.local", "allow_query": []string{"10.2.0.0/16"}},
+		{"zone": "mgmt.example.local.", "allow_query": []string{"10.2.3.0/24"}},
+	})
+	acl, err = ACLFromConfig(v)
+	require.NoError(t, err)
+	assert.Equal(t, ACLDrop, acl.Action())
+
+	tests := []struct {
+		addr  string
+		qname string
+		query bool
+	}{
+		{"10.9.0.1", "www.example.org.", true},
+		{"192.0.2.1", "www.example.org.", true},
+		{"192.0.2.2", "www.example.org.", false},
+		{"::ffff:10.9.0.1", "www.example.org.", true},
+		{"172.16.0.1", "www.example.org.", true},
+		{"::ffff:172.16.0.1", "www.example.org.", true},
+		{"172.32.0.1", "www.example.org.", false},
+		{"127.0.0.1", "www.example.org.", false},
+		{"::1", "www.example.org.", false},
+		{"10.2.0.1", "cpn-01.example.local.", true},
+		{"10.9.0.1", "cpn-01.example.local.", false},
+		{"10.9.0.1", "example.local.", false},
+		{"10.2.0.1", "bmc-01.mgmt.example.local.", false},
+		{"10.2.3.4", "bmc-01.mgmt.example.local.", true},
+		{"10.2.3.4", "cpn-01.example.local.", true},
+		{"10.9.0.1", "notexample.local.", true},
+	}
+	for _, test := range tests {
+		assert.Equal(t, test.query, acl.AllowQuery(netip.MustParseAddr(test.addr).Unmap(), test.qname), "%s %s", test.addr, test.qname)
+	}
+
+	assert.True(t, acl.AllowRecursion(netip.MustParseAddr("10.1.0.9")))
+	assert.False(t, acl.AllowRecursion(netip.MustParseAddr("10.9.0.1")))
+	assert.False(t, acl.AllowRecursion(netip.MustParseAddr("127.0.0.1")))
+
+	// Loopback is allowed once listed
+	v.Set("dns.allow_query", []string{"127.0.0.0/8", "::1"})
+	acl, err = ACLFromConfig(v)
+	require.NoError(t, err)
+	assert.True(t, acl.AllowQuery(netip.MustParseAddr("127.0.0.1"), "www.example.org."))
+	assert.True(t, acl.AllowQuery(netip.MustParseAddr("::1"), "www.example.org."))
+	assert.False(t, acl.AllowRecursion(netip.MustParseAddr("127.0.0.1")))
+
+	v.Set("dns.allow_query", []string{"10.0.0.0/33"})
+	_, err = ACLFromConfig(v)
+	assert.Error(t, err)
+	v.Set("dns.allow_query", []string{})
+	v.Set("dns.deny_action", "ignore")
+	_, err = ACLFromConfig(v)
+	assert.Error(t, err)
+}
+
+func TestACLQuery(t *testing.T) {
+	db, err := sqlstore.New(":memory:")
+	require.NoError(t, err)
+	require.NoError(t, db.StoreHost(&model.Host{
+		Name:       "test-01",
+		Interfaces: []*model.NetInterface{{FQDN: clientFQDN, IP: clientIP}},
+	}))
+
+	h, err := NewHandler(db, 5)
+	require.NoError(t, err)
+
+	v := viper.New()
+	v.Set("dns.allow_query", []string{"10.0.0.0/8"})
+	acl, err := ACLFromConfig(v)
+	require.NoError(t, err)
+	h.acl.Store(acl)
+
+	query := func(client string) *dns.Msg {
+		m := new(dns.Msg)
+		m.SetQuestion(clientFQDN+".", dns.TypeA)
+		w := &recorder{client: &net.UDPAddr{IP: net.ParseIP(client), Port: 5353}}
+		h.ServeDNS(w, m)
+		return w.msg
+	}
+
+	allowed := testutil.ToFloat64(aclTotal.WithLabelValues("query", verdictAllow))
+	refused := testutil.ToFloat64(aclTotal.WithLabelValues("query", verdictRefuse))
+	dropped := testutil.ToFloat64(aclTotal.WithLabelValues("query", verdictDrop))
+
+	r := query("10.1.0.9")
+	if assert.NotNil(t, r) {
+		assert.Equal(t, dns.RcodeSuccess, r.Rcode)
+		assert.Len(t, r.Answer, 1)
+	}
+
+	r = query("192.0.2.1")
+	if assert.NotNil(t, r) {
+		assert.Equal(t, dns.RcodeRefused, r.Rcode)
+		assert.Empty(t, r.Answer)
+	}
+
+	v.Set("dns.deny_action", ACLDrop)
+	acl, err = ACLFromConfig(v)
+	require.NoError(t, err)
+	h.acl.Store(acl)
+	assert.Nil(t, query("192.0.2.1"))
+
+	assert.Equal(t, allowed+1, testutil.ToFloat64(aclTotal.WithLabelValues("query", verdictAllow)))
+	assert.Equal(t, refused+1, testutil.ToFloat64(aclTotal.WithLabelValues("query", verdictRefuse)))
+	assert.Equal(t, dropped+1, testutil.ToFloat64(aclTotal.WithLabelValues("query", verdictDrop)))
+}
@@ -0,0 +1,70 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package dns
+
+import (
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/miekg/dns"
+)
+
+// challengeTTL is the TTL of challenge records, kept short so resolvers do
+// not cache the records of a previous challenge
+const challengeTTL = 10
+
+var challenges = &txtRecords{records: make(map[string][]string)}
+
+// txtRecords are the TXT records answered by the server in addition to the
+// records in the database, used to solve ACME dns-01 challenges
+type txtRecords struct {
+	mu      sync.RWMutex
+	records map[string][]string
+}
+
+// PresentTXT answers TXT queries for name with value until CleanUpTXT is
+// called
+func PresentTXT(name, value string) {
+	name = strings.ToLower(dns.Fqdn(name))
+
+	challenges.mu.Lock()
+	defer challenges.mu.Unlock()
+
+	if !slices.Contains(challenges.records[name], value) {
+		challenges.records[name] = append(challenges.records[name], value)
+	}
+}
+
+// CleanUpTXT stops answering TXT queries for name with value
+func CleanUpTXT(name, value string) {
+	name = strings.ToLower(dns.Fqdn(name))
+
+	challenges.mu.Lock()
+	defer challenges.mu.Unlock()
+
+	values := slices.DeleteFunc(challenges.records[name], func(v string) bool { return v == value })
+	if len(values) == 0 {
+		delete(challenges.records, name)
+		return
+	}
+	challenges.records[name] = values
+}
+
+// txt returns the TXT records of qname
+func txt(qname string) []dns.RR {
+	challenges.mu.RLock()
+	defer challenges.mu.RUnlock()
+
+	answers := make([]dns.RR, 0, len(challenges.records[qname]))
+	for _, value := range challenges.records[qname] {
+		r := new(dns.TXT)
+		r.Hdr = dns.RR_Header{Name: qname, Rrtype: dns.TypeTXT, Class: dns.ClassINET, Ttl: challengeTTL}
+		r.Txt = []string{value}
+		answers = append(answers, r)
+	}
+
+	return answers
+}
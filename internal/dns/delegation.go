@@ -0,0 +1,213 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package dns
+
+import (
+	"fmt"
+	"net/netip"
+	"slices"
+	"strings"
+
+	"github.com/miekg/dns"
+	"github.com/spf13/viper"
+)
+
+// DelegationConfig is a child zone served by other name servers, set in
+// dns.delegations
+type DelegationConfig struct {
+	Zone        string              `mapstructure:"zone"`
+	Nameservers []string            `mapstructure:"nameservers"`
+	Glue        map[string][]string `mapstructure:"glue"`
+	TTL         uint32              `mapstructure:"ttl"`
+}
+
+// Delegation is a child zone answered with a referral to its name servers
+type Delegation struct {
+	// Zone is the fully qualified name of the child zone
+	Zone        string
+	Nameservers []string
+
+	// Glue are the addresses of the name servers in the child zone, which
+	// resolvers cannot look up without them
+	Glue map[string][]netip.Addr
+
+	// TTL of the NS and glue records, dns.ttl when 0
+	TTL uint32
+}
+
+// Delegations are the child zones of dns.delegations, the most specific
+// zone first. A nil list delegates nothing
+type Delegations []Delegation
+
+// DelegationsFromConfig compiles dns.delegations. Name servers in their
+// child zone need glue addresses
+func DelegationsFromConfig(v *viper.Viper) (Delegations, error) {
+	var configs []DelegationConfig
+	if err := v.UnmarshalKey("dns.delegations", &configs); err != nil {
+		return nil, fmt.Errorf("failed parsing dns.delegations: %w", err)
+	}
+
+	delegations := make(Delegations, 0, len(configs))
+	for _, c := range configs {
+		if c.Zone == "" {
+			return nil, fmt.Errorf("invalid dns.delegations: zone required")
+		}
+		d := Delegation{
+			Zone: strings.ToLower(dns.Fqdn(c.Zone)),
+			Glue: make(map[string][]netip.Addr, len(c.Glue)),
+			TTL:  c.TTL,
+		}
+		if _, ok := dns.IsDomainName(d.Zone); !ok || d.Zone == "." {
+			return nil, fmt.Errorf("invalid dns.delegations zone %q", c.Zone)
+		}
+		if slices.ContainsFunc(delegations, func(e Delegation) bool { return e.Zone == d.Zone }) {
+			return nil, fmt.Errorf("invalid dns.delegations: zone %s delegated twice", d.Zone)
+		}
+		if len(c.Nameservers) == 0 {
+			return nil, fmt.Errorf("invalid dns.delegations of %s: nameservers required", d.Zone)
+		}
+
+		for _, ns := range c.Nameservers {
+			ns = strings.ToLower(dns.Fqdn(ns))
+			if _, ok := dns.IsDomainName(ns); !ok {
+				return nil, fmt.Errorf("invalid dns.delegations nameserver %q of %s", ns, d.Zone)
+			}
+			d.Nameservers = append(d.Nameservers, ns)
+		}
+
+		for name, addrs := range c.Glue {
+			name = strings.ToLower(dns.Fqdn(name))
+			if !slices.Contains(d.Nameservers, name) {
+				return nil, fmt.Errorf("invalid dns.delegations glue of %s: %s is not a nameserver of the zone", d.Zone, name)
+			}
+			for _, a := range addrs {
+				addr, err := netip.ParseAddr(a)
+				if err != nil {
+					return nil, fmt.Errorf("invalid dns.delegations glue address %q of %s", a, name)
+				}
+				d.Glue[name] = append(d.Glue[name], addr.Unmap())
+			}
+		}
+
+		for _, ns := range d.Nameservers {
+			if dns.IsSubDomain(d.Zone, ns) && len(d.Glue[ns]) == 0 {
+				return nil, fmt.Errorf("invalid dns.delegations of %s: nameserver %s is in the zone and needs glue", d.Zone, ns)
+			}
+		}
+
+		delegations = append(delegations, d)
+	}
+
+	// The most specific zone of a name is found first
+	slices.SortStableFunc(delegations, func(a, b Delegation) int {
+		return dns.CountLabel(b.Zone) - dns.CountLabel(a.Zone)
+	})
+
+	return delegations, nil
+}
+
+// Find returns the delegation of the zone holding qname
+func (d Delegations) Find(qname string) (Delegation, bool) {
+	for _, del := range d {
+		if dns.IsSubDomain(del.Zone, qname) {
+			return del, true
+		}
+	}
+
+	return Delegation{}, false
+}
+
+// ttl returns the TTL of the records of the delegation, def when unset
+func (d Delegation) ttl(def uint32) uint32 {
+	if d.TTL > 0 {
+		return d.TTL
+	}
+
+	return def
+}
+
+// NS returns the NS records of the child zone
+func (d Delegation) NS(ttl uint32) []dns.RR {
+	rrs := make([]dns.RR, 0, len(d.Nameservers))
+	for _, ns := range d.Nameservers {
+		rrs = append(rrs, &dns.NS{
+			Hdr: dns.RR_Header{Name: d.Zone, Rrtype: dns.TypeNS, Class: dns.ClassINET, Ttl: d.ttl(ttl)},
+			Ns:  ns,
+		})
+	}
+
+	return rrs
+}
+
+// GlueRecords returns the A and AAAA records of the glue addresses, in the
+// order of the name servers
+func (d Delegation) GlueRecords(ttl uint32) []dns.RR {
+	var rrs []dns.RR
+	for _, ns := range d.Nameservers {
+		for _, addr := range d.Glue[ns] {
+			hdr := dns.RR_Header{Name: ns, Class: dns.ClassINET, Ttl: d.ttl(ttl)}
+			if addr.Is4() {
+				hdr.Rrtype = dns.TypeA
+				rrs = append(rrs, &dns.A{Hdr: hdr, A: addr.AsSlice()})
+			} else {
+				hdr.Rrtype = dns.TypeAAAA
+				rrs = append(rrs, &dns.AAAA{Hdr: hdr, AAAA: addr.AsSlice()})
+			}
+		}
+	}
+
+	return rrs
+}
+
+// Referral sets m to the referral to the name servers of the child zone:
+// not authoritative, the NS records in the authority section and the glue in
+// the additional section
+func (d Delegation) Referral(m *dns.Msg, ttl uint32) {
+	m.Authoritative = false
+	m.Rcode = dns.RcodeSuccess
+	m.Answer = nil
+	m.Ns = d.NS(ttl)
+	m.Extra = d.GlueRecords(ttl)
+}
+
+// InZone returns the records of zone, one of zones, with the delegations of
+// its child zones: records below a delegation point are replaced by the NS
+// and glue records of the delegation. The SOA opening rrs stays first
+func (d Delegations) InZone(zone Zone, zones []Zone, rrs []dns.RR, ttl uint32) []dns.RR {
+	var child Delegations
+	// From the least specific zone, so delegations below another one are
+	// left to the child zone
+	for i := len(d) - 1; i >= 0; i-- {
+		del := d[i]
+		if parent, ok := FindZone(zones, del.Zone); !ok || parent.Name != zone.Name || del.Zone == zone.Name {
+			continue
+		}
+		if _, ok := child.Find(del.Zone); ok {
+			continue
+		}
+		child = append(child, del)
+	}
+	if len(child) == 0 {
+		return rrs
+	}
+
+	out := make([]dns.RR, 0, len(rrs))
+	for _, rr := range rrs {
+		if _, ok := child.Find(strings.ToLower(rr.Header().Name)); !ok {
+			out = append(out, rr)
+		}
+	}
+	for _, del := range child {
+		out = append(out, del.NS(ttl)...)
+		// Addresses of name servers outside the zone are not zone data
+		for _, rr := range del.GlueRecords(ttl) {
+			if zone.Contains(rr.Header().Name) {
+				out = append(out, rr)
+			}
+		}
+	}
+
+	return out
+}
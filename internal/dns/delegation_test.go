@@ -0,0 +1,185 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package dns
+
+import (
+	"net"
+	"net/netip"
+	"strings"
+	"testing"
+
+	"github.com/miekg/dns"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/ubccr/grendel/internal/store/sqlstore"
+	"github.com/ubccr/grendel/pkg/model"
+)
+
+const delegationConfig = `
+[dns]
+delegations = [
+  {zone = "k8s.cluster.example.org", nameservers = ["ns1.k8s.cluster.example.org", "ns.example.net"], glue = {"ns1.k8s.cluster.example.org" = ["10.1.5.1", "fd00::5:1"]}},
+  {zone = "Lab.K8S.cluster.example.org.", nameservers = ["ns.example.net"], ttl = 60},
+]
+`
+
+func delegationsFromTOML(t *testing.T, config string) (Delegations, error) {
+	v := viper.New()
+	v.SetConfigType("toml")
+	require.NoError(t, v.ReadConfig(strings.NewReader(config)))
+	return DelegationsFromConfig(v)
+}
+
+func TestDelegationsFromConfig(t *testing.T) {
+	d, err := delegationsFromTOML(t, delegationConfig)
+	require.NoError(t, err)
+	require.Len(t, d, 2)
+
+	// The most specific zone first
+	assert.Equal(t, "lab.k8s.cluster.example.org.", d[0].Zone)
+	assert.Equal(t, uint32(60), d[0].TTL)
+	assert.Equal(t, "k8s.cluster.example.org.", d[1].Zone)
+	assert.Equal(t, []string{"ns1.k8s.cluster.example.org.", "ns.example.net."}, d[1].Nameservers)
+	assert.Equal(t, []netip.Addr{netip.MustParseAddr("10.1.5.1"), netip.MustParseAddr("fd00::5:1")}, d[1].Glue["ns1.k8s.cluster.example.org."])
+
+	found, ok := d.Find("node.lab.k8s.cluster.example.org.")
+	assert.True(t, ok)
+	assert.Equal(t, "lab.k8s.cluster.example.org.", found.Zone)
+	found, ok = d.Find("k8s.cluster.example.org.")
+	assert.True(t, ok)
+	assert.Equal(t, "k8s.cluster.example.org.", found.Zone)
+	_, ok = d.Find("cluster.example.org.")
+	assert.False(t, ok)
+
+	empty, err := DelegationsFromConfig(viper.New())
+	require.NoError(t, err)
+	assert.Empty(t, empty)
+
+	for name, config := range map[string]string{
+		"no glue":      `[dns]` + "\n" + `delegations = [{zone = "k8s.example.org", nameservers = ["ns1.k8s.example.org"]}]`,
+		"glue not ns":  `[dns]` + "\n" + `delegations = [{zone = "k8s.example.org", nameservers = ["ns.example.net"], glue = {"ns2.example.net" = ["10.0.0.1"]}}]`,
+		"bad glue":     `[dns]` + "\n" + `delegations = [{zone = "k8s.example.org", nameservers = ["ns1.k8s.example.org"], glue = {"ns1.k8s.example.org" = ["10.0.0"]}}]`,
+		"no ns":        `[dns]` + "\n" + `delegations = [{zone = "k8s.example.org"}]`,
+		"no zone":      `[dns]` + "\n" + `delegations = [{nameservers = ["ns.example.net"]}]`,
+		"twice":        `[dns]` + "\n" + `delegations = [{zone = "k8s.example.org", nameservers = ["ns.example.net"]}, {zone = "K8S.example.org.", nameservers = ["ns.example.net"]}]`,
+		"invalid zone": `[dns]` + "\n" + `delegations = [{zone = ".", nameservers = ["ns.example.net"]}]`,
+	} {
+		_, err := delegationsFromTOML(t, config)
+		assert.Error(t, err, name)
+	}
+}
+
+func TestDelegationQuery(t *testing.T) {
+	viper.Set("dns.zones", []string{"cluster.example.org"})
+	viper.Set("dns.hostname", "ns.cluster.example.org")
+	defer viper.Reset()
+
+	db, err := sqlstore.New(":memory:")
+	require.NoError(t, err)
+	defer db.Close()
+	require.NoError(t, db.StoreHosts(model.HostList{
+		{Name: "cpn-01", Interfaces: []*model.NetInterface{{FQDN: "cpn-01.cluster.example.org", IP: netip.MustParsePrefix("10.1.0.1/24")}}},
+		{Name: "ns1", Interfaces: []*model.NetInterface{{FQDN: "ns1.k8s.cluster.example.org", IP: netip.MustParsePrefix("10.1.0.5/24")}}},
+	}))
+
+	h, err := NewHandler(db, 300)
+	require.NoError(t, err)
+	d, err := delegationsFromTOML(t, delegationConfig)
+	require.NoError(t, err)
+	h.delegations.Store(&d)
+
+	query := func(qname string, qtype uint16) *dns.Msg {
+		m := new(dns.Msg)
+		m.SetQuestion(qname, qtype)
+		w := &recorder{client: &net.UDPAddr{IP: net.ParseIP("10.1.0.9"), Port: 5353}}
+		h.ServeDNS(w, m)
+		require.NotNil(t, w.msg)
+		return w.msg
+	}
+
+	// Names at and below the delegation point, even of hosts, get a
+	// referral rather than NXDOMAIN
+	for _, qname := range []string{"k8s.cluster.example.org.", "api.k8s.cluster.example.org.", "ns1.k8s.cluster.example.org."} {
+		r := query(qname, dns.TypeA)
+		assert.Equal(t, dns.RcodeSuccess, r.Rcode, qname)
+		assert.False(t, r.Authoritative, qname)
+		assert.Empty(t, r.Answer, qname)
+		if assert.Len(t, r.Ns, 2, qname) {
+			assert.Equal(t, "k8s.cluster.example.org.\t300\tIN\tNS\tns1.k8s.cluster.example.org.", r.Ns[0].String())
+			assert.Equal(t, "k8s.cluster.example.org.\t300\tIN\tNS\tns.example.net.", r.Ns[1].String())
+		}
+		if assert.Len(t, r.Extra, 2, qname) {
+			assert.Equal(t, "ns1.k8s.cluster.example.org.\t300\tIN\tA\t10.1.5.1", r.Extra[0].String())
+			assert.Equal(t, "ns1.k8s.cluster.example.org.\t300\tIN\tAAAA\tfd00::5:1", r.Extra[1].String())
+		}
+	}
+
+	r := query("www.lab.k8s.cluster.example.org.", dns.TypeAAAA)
+	if assert.Len(t, r.Ns, 1) {
+		assert.Equal(t, "lab.k8s.cluster.example.org.\t60\tIN\tNS\tns.example.net.", r.Ns[0].String())
+	}
+	assert.Empty(t, r.Extra)
+
+	// The forward zone answers its names authoritatively
+	r = query("cpn-01.cluster.example.org.", dns.TypeA)
+	assert.True(t, r.Authoritative)
+	assert.Len(t, r.Answer, 1)
+
+	r = query("cpn-01.cluster.example.org.", dns.TypeMX)
+	assert.True(t, r.Authoritative)
+	assert.Equal(t, dns.RcodeSuccess, r.Rcode)
+	assert.Empty(t, r.Answer)
+
+	r = query("missing.cluster.example.org.", dns.TypeA)
+	assert.True(t, r.Authoritative)
+	assert.Equal(t, dns.RcodeNameError, r.Rcode)
+	if assert.Len(t, r.Ns, 1) {
+		assert.Equal(t, dns.TypeSOA, r.Ns[0].Header().Rrtype)
+	}
+}
+
+func TestDelegationInZone(t *testing.T) {
+	zones, err := ForwardZones([]string{"cluster.example.org", "lab.k8s.cluster.example.org"})
+	require.NoError(t, err)
+	d, err := delegationsFromTOML(t, delegationConfig)
+	require.NoError(t, err)
+
+	hosts := model.HostList{
+		{Name: "cpn-01", Interfaces: []*model.NetInterface{{FQDN: "cpn-01.cluster.example.org,cpn-01", IP: netip.MustParsePrefix("10.1.0.1/24")}}},
+		{Name: "ns1", Interfaces: []*model.NetInterface{{FQDN: "ns1.k8s.cluster.example.org", IP: netip.MustParsePrefix("10.1.0.5/24")}}},
+		{Name: "other", Interfaces: []*model.NetInterface{{FQDN: "other.example.net", IP: netip.MustParsePrefix("10.1.0.6/24")}}},
+	}
+	records := model.RecordList{
+		{Name: "www.cluster.example.org", Type: model.RecordTypeCNAME, Value: "cpn-01.cluster.example.org", TTL: 60},
+	}
+
+	rrs := d.InZone(zones[0], zones, zones[0].Records("ns.cluster.example.org", 300, 1, hosts, records), 300)
+	var lines []string
+	for _, rr := range rrs[1:] {
+		lines = append(lines, rr.String())
+	}
+
+	// The host in the delegated zone is replaced by the glue, the name
+	// server outside of the zone has no glue and the delegation of the
+	// served child zone is left to it
+	assert.Equal(t, dns.TypeSOA, rrs[0].Header().Rrtype)
+	assert.Equal(t, []string{
+		"cluster.example.org.\t300\tIN\tNS\tns.cluster.example.org.",
+		"cpn-01.cluster.example.org.\t300\tIN\tA\t10.1.0.1",
+		"www.cluster.example.org.\t60\tIN\tCNAME\tcpn-01.cluster.example.org.",
+		"k8s.cluster.example.org.\t300\tIN\tNS\tns1.k8s.cluster.example.org.",
+		"k8s.cluster.example.org.\t300\tIN\tNS\tns.example.net.",
+		"ns1.k8s.cluster.example.org.\t300\tIN\tA\t10.1.5.1",
+		"ns1.k8s.cluster.example.org.\t300\tIN\tAAAA\tfd00::5:1",
+	}, lines)
+
+	// The served child zone is itself delegated
+	rrs = d.InZone(zones[1], zones, zones[1].Records("ns.cluster.example.org", 300, 1, hosts, records), 300)
+	assert.Len(t, rrs, 2)
+
+	_, err = ForwardZones([]string{"2.0.192.in-addr.arpa"})
+	assert.Error(t, err)
+}
@@ -5,30 +5,92 @@
 package dns
 
 import (
+	"context"
 	"net"
+	"net/netip"
+	"os"
 	"strings"
+	"sync/atomic"
+	"time"
 
 	"github.com/miekg/dns"
 	"github.com/sirupsen/logrus"
-	"github.com/spf13/viper"
+	"github.com/ubccr/grendel/internal/config"
 	"github.com/ubccr/grendel/internal/store"
+	"github.com/ubccr/grendel/internal/store/namespace"
 	"github.com/ubccr/grendel/internal/util"
+	"github.com/ubccr/grendel/pkg/model"
 )
 
 type handler struct {
-	db  store.Store
-	ttl uint32
+	db          store.Store
+	ttl         uint32
+	acl         *atomic.Pointer[ACL]
+	delegations *atomic.Pointer[Delegations]
+	states      *atomic.Pointer[[]string]
+	zones       *atomic.Pointer[[]Zone]
+
+	// namespaces are selected by the zone of the query name, only db is
+	// used when nil
+	namespaces *namespace.Registry
 }
 
 func NewHandler(db store.Store, ttl uint32) (*handler, error) {
 	h := &handler{
-		db:  db,
-		ttl: ttl,
+		db:          db,
+		ttl:         ttl,
+		acl:         new(atomic.Pointer[ACL]),
+		delegations: new(atomic.Pointer[Delegations]),
+		states:      new(atomic.Pointer[[]string]),
+		zones:       new(atomic.Pointer[[]Zone]),
+	}
+
+	zones, err := ZonesFromConfig(config.Viper(), config.Current().Subnets)
+	if err != nil {
+		return nil, err
 	}
+	h.zones.Store(&zones)
 
 	return h, nil
 }
 
+// hostStates returns the states of the hosts answered, DefaultStates unless
+// set
+func (h *handler) hostStates() []string {
+	if states := h.states.Load(); states != nil && len(*states) > 0 {
+		return *states
+	}
+
+	return DefaultStates
+}
+
+// withContext returns a copy of h looking up records with ctx
+func (h *handler) withContext(ctx context.Context) *handler {
+	c := *h
+	c.db = h.db.WithContext(ctx)
+	return &c
+}
+
+// forQuery returns a copy of h looking up the records of the namespace of
+// qname: the namespace of the zone of a forward name, and the namespace of
+// the subnet of the address of a reverse name, or else of its reverse zone
+func (h *handler) forQuery(qname string, zone Zone, inZone bool) *handler {
+	if h.namespaces == nil {
+		return h
+	}
+
+	c := *h
+	if util.IsReverse(qname) == 0 {
+		_, c.db = h.namespaces.ForName(qname)
+	} else if addr, err := netip.ParseAddr(util.ExtractAddressFromReverse(qname)); err == nil {
+		_, c.db = h.namespaces.ForAddr(addr)
+	} else if inZone && !zone.Forward() {
+		_, c.db = h.namespaces.ForAddr(zone.Prefix.Addr())
+	}
+
+	return &c
+}
+
 func (h *handler) ServeDNS(w dns.ResponseWriter, r *dns.Msg) {
 	m := new(dns.Msg)
 	m.SetReply(r)
@@ -36,34 +98,92 @@ func (h *handler) ServeDNS(w dns.ResponseWriter, r *dns.Msg) {
 	qname := h.Name(r)
 	answers := []dns.RR{}
 
+	zones := h.served()
+	zone, inZone := FindZone(zones, qname)
+	h = h.forQuery(qname, zone, inZone)
+
 	log.Debugf("Got query %s", qname)
+	if h.QType(r) == dns.TypeAXFR {
+		// Zone transfers read every host and have no deadline
+		h.transfer(w, r, zones, zone, inZone && zone.Name == qname)
+		return
+	}
+
+	client := clientAddr(w)
+	acl := h.acl.Load()
+	if !h.checkACL(w, r, "query", client, qname, acl.AllowQuery(client, qname)) {
+		return
+	}
+
+	// Names in a delegated child zone are answered by its name servers,
+	// even when a host has the name
+	if d, ok := h.delegated().Find(qname); ok {
+		d.Referral(m, h.ttl)
+		observeQuery(h.QType(r), m.Rcode)
+		w.WriteMsg(m)
+		return
+	}
+
+	ctx, cancel := store.WithTimeout(context.Background(), config.Viper().GetDuration("dns.store_timeout"))
+	defer cancel()
+	h = h.withContext(ctx)
+
 	switch h.QType(r) {
 	case dns.TypePTR:
-		names, err := h.db.ReverseResolve(util.ExtractAddressFromReverse(qname))
-		if err != nil {
-			log.WithFields(logrus.Fields{
-				"qname": qname,
-				"err":   err,
-			}).Error("Failed to reverse resolve IP")
+		answers = h.resolvePTR(qname, zones)
+	case dns.TypeSOA:
+		if inZone && zone.Name == qname {
+			answers = append(answers, zone.SOA(Nameserver(), h.ttl, Serial(time.Now())))
 		}
-		answers = h.ptr(qname, h.ttl, names)
-	case dns.TypeA:
-		ips, err := h.db.ResolveIPv4(qname)
-		if err != nil {
-			log.WithFields(logrus.Fields{
-				"qname": qname,
-				"err":   err,
-			}).Error("Failed to resolve FQDN")
+	case dns.TypeNS:
+		if inZone && zone.Name == qname {
+			answers = append(answers, zone.NS(Nameserver(), h.ttl))
 		}
-		answers = a(qname, h.ttl, ips)
+	case dns.TypeA:
+		answers = h.resolveA(qname)
+	case dns.TypeAAAA:
+		answers = h.resolveAAAA(qname)
+	case dns.TypeCNAME:
+		answers = h.cname(qname)
+	case dns.TypeTXT:
+		answers = txt(qname)
+	}
+
+	// Answering without the records the datastore did not return in time
+	// would tell the client the name does not exist
+	if store.DeadlineExceeded(ctx, "dns", ctx.Err()) {
+		log.WithField("qname", qname).Warn("Datastore did not answer in time")
+		m.SetRcode(r, dns.RcodeServerFailure)
+		observeQuery(h.QType(r), m.Rcode)
+		w.WriteMsg(m)
+		return
 	}
 
-	fwAddr := viper.GetString("dns.forward")
+	fwAddr := config.Viper().GetString("dns.forward")
 	if len(answers) != 0 {
-		m.Authoritative = true
+		// Reverse names outside the zones computed from the subnets, such
+		// as the parent of a classless zone, are answered but not
+		// authoritatively
+		m.Authoritative = inZone || len(zones) == 0 || util.IsReverse(qname) == 0
 		m.Answer = answers
 		m.SetRcode(r, dns.RcodeSuccess)
+	} else if inZone {
+		// Names in the zones are never forwarded, the SOA gives the
+		// negative caching TTL
+		m.Authoritative = true
+		m.Ns = []dns.RR{zone.SOA(Nameserver(), h.ttl, Serial(time.Now()))}
+		m.SetRcode(r, dns.RcodeNameError)
+		if zone.Name == qname || h.exists(qname, zone, zones) {
+			m.SetRcode(r, dns.RcodeSuccess)
+		}
 	} else if len(answers) == 0 && fwAddr != "" {
+		if !h.checkACL(w, r, "recursion", client, qname, acl.AllowRecursion(client)) {
+			return
+		}
+		if f, ok := w.(forwardRecorder); ok {
+			f.Forwarded()
+			return
+		}
 		fwm, err := dns.Exchange(r, fwAddr)
 		if err != nil {
 			log.WithFields(logrus.Fields{
@@ -79,9 +199,287 @@ func (h *handler) ServeDNS(w dns.ResponseWriter, r *dns.Msg) {
 		m.SetRcode(r, dns.RcodeNameError)
 	}
 
+	observeQuery(h.QType(r), m.Rcode)
 	w.WriteMsg(m)
 }
 
+// delegated returns the delegations of dns.delegations
+func (h *handler) delegated() Delegations {
+	if d := h.delegations.Load(); d != nil {
+		return *d
+	}
+
+	return nil
+}
+
+// exists returns whether qname of zone has records of another type than the
+// one queried
+func (h *handler) exists(qname string, zone Zone, zones []Zone) bool {
+	if !zone.Forward() {
+		return len(h.resolvePTR(qname, zones)) > 0
+	}
+
+	return len(h.resolveA(qname)) > 0 || len(h.resolveAAAA(qname)) > 0
+}
+
+// served returns the zones answered authoritatively
+func (h *handler) served() []Zone {
+	if z := h.zones.Load(); z != nil {
+		return *z
+	}
+
+	return nil
+}
+
+// Nameserver returns dns.hostname, or the host name of the server, named
+// in the SOA and NS records of the zones
+func Nameserver() string {
+	if name := config.Viper().GetString("dns.hostname"); name != "" {
+		return dns.Fqdn(name)
+	}
+
+	name, err := os.Hostname()
+	if err != nil || name == "" {
+		return "localhost."
+	}
+
+	return dns.Fqdn(name)
+}
+
+// resolvePTR returns the PTR records of qname. A query for an address of a
+// classless zone in its parent zone is answered with the CNAME the parent
+// delegates it with and the PTR records of the target
+func (h *handler) resolvePTR(qname string, zones []Zone) []dns.RR {
+	if zone, ok := FindZone(zones, qname); ok && zone.Classless() {
+		addr, ok := zone.Addr(qname)
+		if !ok {
+			return nil
+		}
+		return h.ptrs(qname, addr.String())
+	}
+
+	ip := util.ExtractAddressFromReverse(qname)
+	if addr, err := netip.ParseAddr(ip); err == nil {
+		if zone, ok := FindClassless(zones, addr); ok {
+			target := zone.PTRName(addr)
+			answers := h.ptrs(target, ip)
+			if len(answers) == 0 {
+				return nil
+			}
+			return append([]dns.RR{cname(qname, h.ttl, target)}, answers...)
+		}
+	}
+
+	return h.ptrs(qname, ip)
+}
+
+// ptrs returns the PTR records named qname of the interfaces of the hosts in
+// the states answered and DNS only records with the address ip
+func (h *handler) ptrs(qname, ip string) []dns.RR {
+	names, err := h.db.ReverseResolve(ip, h.hostStates()...)
+	if err != nil {
+		log.WithFields(logrus.Fields{
+			"qname": qname,
+			"err":   err,
+		}).Error("Failed to reverse resolve IP")
+	}
+	answers := h.ptr(qname, h.ttl, names)
+
+	records, err := h.db.ReverseResolveDNSRecords(ip)
+	if err != nil {
+		log.WithFields(logrus.Fields{
+			"qname": qname,
+			"err":   err,
+		}).Error("Failed to reverse resolve DNS records")
+	}
+	for _, r := range records {
+		answers = append(answers, h.ptr(qname, h.recordTTL(r), []string{r.Name})...)
+	}
+
+	return answers
+}
+
+// transfer answers an AXFR of zone, one of zones, over TCP from the addresses
+// in dns.allow_transfer, others are refused. The delegations of the child
+// zones of zone are included, the hosts in states not answered are not
+func (h *handler) transfer(w dns.ResponseWriter, r *dns.Msg, zones []Zone, zone Zone, ok bool) {
+	if !ok || !transferAllowed(w.RemoteAddr()) {
+		m := new(dns.Msg)
+		m.SetRcode(r, dns.RcodeRefused)
+		observeQuery(dns.TypeAXFR, m.Rcode)
+		w.WriteMsg(m)
+		return
+	}
+
+	hosts, err := h.db.Hosts()
+	if err == nil {
+		var records model.RecordList
+		records, err = h.db.DNSRecords()
+		if err == nil {
+			rrs := zone.Records(Nameserver(), h.ttl, Serial(time.Now()), hosts.InStates(h.hostStates()...), records)
+			rrs = h.delegated().InZone(zone, zones, rrs, h.ttl)
+			err = sendTransfer(w, r, append(rrs, rrs[0]))
+		}
+	}
+	if err != nil {
+		log.WithFields(logrus.Fields{
+			"zone": zone.Name,
+			"err":  err,
+		}).Error("Failed zone transfer")
+		m := new(dns.Msg)
+		m.SetRcode(r, dns.RcodeServerFailure)
+		observeQuery(dns.TypeAXFR, m.Rcode)
+		w.WriteMsg(m)
+		return
+	}
+
+	log.WithFields(logrus.Fields{
+		"zone":   zone.Name,
+		"client": w.RemoteAddr().String(),
+	}).Info("Zone transfer")
+	observeQuery(dns.TypeAXFR, dns.RcodeSuccess)
+}
+
+// transferEnvelope is the number of records sent in each message of a zone
+// transfer
+const transferEnvelope = 100
+
+func sendTransfer(w dns.ResponseWriter, r *dns.Msg, rrs []dns.RR) error {
+	ch := make(chan *dns.Envelope, len(rrs)/transferEnvelope+1)
+	for len(rrs) > 0 {
+		n := min(len(rrs), transferEnvelope)
+		ch <- &dns.Envelope{RR: rrs[:n]}
+		rrs = rrs[n:]
+	}
+	close(ch)
+
+	return new(dns.Transfer).Out(w, r, ch)
+}
+
+// transferAllowed returns whether addr is a TCP client in dns.allow_transfer,
+// addresses or prefixes
+func transferAllowed(addr net.Addr) bool {
+	tcp, ok := addr.(*net.TCPAddr)
+	if !ok {
+		return false
+	}
+	ip, ok := netip.AddrFromSlice(tcp.IP)
+	if !ok {
+		return false
+	}
+	ip = ip.Unmap()
+
+	for _, allowed := range config.Viper().GetStringSlice("dns.allow_transfer") {
+		if p, err := netip.ParsePrefix(allowed); err == nil && p.Contains(ip) {
+			return true
+		}
+		if a, err := netip.ParseAddr(allowed); err == nil && a.Unmap() == ip {
+			return true
+		}
+	}
+
+	return false
+}
+
+// resolveA returns the A records for qname from the interfaces of the hosts in
+// the states answered and DNS only records. If qname is a CNAME record the CNAME is returned along with the A
+// records of its target.
+func (h *handler) resolveA(qname string) []dns.RR {
+	ips, err := h.db.ResolveIPv4(qname, h.hostStates()...)
+	if err != nil {
+		log.WithFields(logrus.Fields{
+			"qname": qname,
+			"err":   err,
+		}).Error("Failed to resolve FQDN")
+	}
+	answers := a(qname, h.ttl, ips)
+
+	records, err := h.db.FindDNSRecords(qname)
+	if err != nil {
+		log.WithFields(logrus.Fields{
+			"qname": qname,
+			"err":   err,
+		}).Error("Failed to find DNS records")
+	}
+
+	for _, r := range records {
+		switch r.Type {
+		case model.RecordTypeA:
+			answers = append(answers, a(qname, h.recordTTL(r), []net.IP{net.ParseIP(r.Value)})...)
+		case model.RecordTypeCNAME:
+			target := dns.Fqdn(r.Value)
+			answers = append(answers, cname(qname, h.recordTTL(r), target))
+
+			// only follow a single level of CNAMEs to avoid loops
+			ips, err := h.db.ResolveIPv4(target, h.hostStates()...)
+			if err != nil {
+				log.WithFields(logrus.Fields{
+					"qname": target,
+					"err":   err,
+				}).Error("Failed to resolve CNAME target")
+			}
+			answers = append(answers, a(target, h.ttl, ips)...)
+
+			targets, _ := h.db.FindDNSRecords(target)
+			for _, t := range targets {
+				if t.Type == model.RecordTypeA {
+					answers = append(answers, a(target, h.recordTTL(t), []net.IP{net.ParseIP(t.Value)})...)
+				}
+			}
+		}
+	}
+
+	return answers
+}
+
+// resolveAAAA returns the AAAA records for qname from the interfaces of the
+// hosts in the states answered
+func (h *handler) resolveAAAA(qname string) []dns.RR {
+	ips, err := h.db.ResolveIPv6(qname, h.hostStates()...)
+	if err != nil {
+		log.WithFields(logrus.Fields{
+			"qname": qname,
+			"err":   err,
+		}).Error("Failed to resolve FQDN")
+	}
+
+	return aaaa(qname, h.ttl, ips)
+}
+
+func (h *handler) cname(qname string) []dns.RR {
+	records, err := h.db.FindDNSRecords(qname)
+	if err != nil {
+		log.WithFields(logrus.Fields{
+			"qname": qname,
+			"err":   err,
+		}).Error("Failed to find DNS records")
+	}
+
+	answers := []dns.RR{}
+	for _, r := range records {
+		if r.Type == model.RecordTypeCNAME {
+			answers = append(answers, cname(qname, h.recordTTL(r), dns.Fqdn(r.Value)))
+		}
+	}
+
+	return answers
+}
+
+func (h *handler) recordTTL(r *model.Record) uint32 {
+	if r.TTL > 0 {
+		return uint32(r.TTL)
+	}
+
+	return h.ttl
+}
+
+func cname(zone string, ttl uint32, target string) dns.RR {
+	r := new(dns.CNAME)
+	r.Hdr = dns.RR_Header{Name: zone, Rrtype: dns.TypeCNAME, Class: dns.ClassINET, Ttl: ttl}
+	r.Target = target
+	return r
+}
+
 // The code below was adopted from the hosts plugin from coredns
 // https://github.com/coredns/coredns/tree/master/plugin/hosts
 // Copyright coredns authors Apache License
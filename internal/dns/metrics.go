@@ -0,0 +1,26 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package dns
+
+import (
+	"github.com/miekg/dns"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/ubccr/grendel/internal/stats"
+)
+
+var queriesTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+	Name: "grendel_dns_queries_total",
+	Help: "DNS queries answered by query type and response code",
+}, []string{"qtype", "rcode"})
+
+func init() {
+	prometheus.MustRegister(queriesTotal)
+}
+
+// observeQuery counts a query of qtype answered with rcode
+func observeQuery(qtype uint16, rcode int) {
+	queriesTotal.WithLabelValues(dns.Type(qtype).String(), dns.RcodeToString[rcode]).Inc()
+	stats.DNSQueries.Inc()
+}
@@ -0,0 +1,66 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package dns
+
+import (
+	"net"
+	"net/netip"
+
+	"github.com/miekg/dns"
+	"github.com/ubccr/grendel/internal/store"
+)
+
+// Replayer answers queries with the handler of the server without sending
+// packets. It is used by grendel replay to compare the answers of grendel
+// with a capture of another server
+type Replayer struct {
+	h *handler
+}
+
+// NewReplayer returns a replayer answering from db with the acl, nil allows
+// every client, and the delegations
+func NewReplayer(db store.Store, ttl uint32, acl *ACL, delegations Delegations) (*Replayer, error) {
+	h, err := NewHandler(db, ttl)
+	if err != nil {
+		return nil, err
+	}
+	h.acl.Store(acl)
+	h.delegations.Store(&delegations)
+
+	return &Replayer{h: h}, nil
+}
+
+// Reply returns the reply to the query of client, nil when it is dropped.
+// Queries the server would forward to dns.forward are not sent, forwarded
+// is true instead
+func (r *Replayer) Reply(client netip.AddrPort, req *dns.Msg) (reply *dns.Msg, forwarded bool) {
+	w := &replayWriter{client: net.UDPAddrFromAddrPort(client)}
+	r.h.ServeDNS(w, req)
+
+	return w.msg, w.forwarded
+}
+
+// forwardRecorder is implemented by the writers of replayed queries, which
+// record the queries the handler forwards instead of sending them
+type forwardRecorder interface {
+	Forwarded()
+}
+
+// replayWriter records the reply to a replayed query
+type replayWriter struct {
+	client    net.Addr
+	msg       *dns.Msg
+	forwarded bool
+}
+
+func (w *replayWriter) LocalAddr() net.Addr         { return &net.UDPAddr{} }
+func (w *replayWriter) RemoteAddr() net.Addr        { return w.client }
+func (w *replayWriter) WriteMsg(m *dns.Msg) error   { w.msg = m; return nil }
+func (w *replayWriter) Write(b []byte) (int, error) { return len(b), nil }
+func (w *replayWriter) Close() error                { return nil }
+func (w *replayWriter) TsigStatus() error           { return nil }
+func (w *replayWriter) TsigTimersOnly(bool)         {}
+func (w *replayWriter) Hijack()                     {}
+func (w *replayWriter) Forwarded()                  { w.forwarded = true }
@@ -0,0 +1,63 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package dns
+
+import (
+	"net/netip"
+	"testing"
+
+	"github.com/miekg/dns"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/ubccr/grendel/internal/store/sqlstore"
+	"github.com/ubccr/grendel/pkg/model"
+)
+
+func TestReplayer(t *testing.T) {
+	viper.Set("dns.forward", "192.0.2.53:53")
+	defer viper.Reset()
+
+	db, err := sqlstore.New(":memory:")
+	require.NoError(t, err)
+	defer db.Close()
+	require.NoError(t, db.StoreHost(&model.Host{
+		Name:       "test-01",
+		Interfaces: []*model.NetInterface{{FQDN: clientFQDN, IP: clientIP}},
+	}))
+
+	v := viper.New()
+	v.Set("dns.allow_recursion", []string{"10.1.0.0/24"})
+	acl, err := ACLFromConfig(v)
+	require.NoError(t, err)
+
+	r, err := NewReplayer(db, 60, acl, nil)
+	require.NoError(t, err)
+
+	query := func(client, qname string) (*dns.Msg, bool) {
+		m := new(dns.Msg)
+		m.SetQuestion(qname, dns.TypeA)
+		return r.Reply(netip.MustParseAddrPort(client), m)
+	}
+
+	reply, forwarded := query("10.1.0.9:5353", clientFQDN+".")
+	assert.False(t, forwarded)
+	if assert.NotNil(t, reply) && assert.Len(t, reply.Answer, 1) {
+		assert.Equal(t, "10.1.0.1", reply.Answer[0].(*dns.A).A.String())
+		assert.Equal(t, uint32(60), reply.Answer[0].Header().Ttl)
+	}
+
+	// Forwarded queries are recorded, not sent
+	reply, forwarded = query("10.1.0.9:5353", "www.example.com.")
+	assert.True(t, forwarded)
+	assert.Nil(t, reply)
+
+	// Recursion denied by the ACL is refused
+	reply, forwarded = query("10.2.0.9:5353", "www.example.com.")
+	assert.False(t, forwarded)
+	if assert.NotNil(t, reply) {
+		assert.Equal(t, dns.RcodeRefused, reply.Rcode)
+	}
+}
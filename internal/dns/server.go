@@ -6,39 +6,206 @@ package dns
 
 import (
 	"context"
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"net"
+	"slices"
+	"time"
 
 	"github.com/miekg/dns"
+	"github.com/ubccr/grendel/internal/health"
 	"github.com/ubccr/grendel/internal/logger"
 	"github.com/ubccr/grendel/internal/store"
+	"github.com/ubccr/grendel/internal/store/namespace"
+	"github.com/ubccr/grendel/pkg/model"
 )
 
 var log = logger.GetLogger("DNS")
 
+// DefaultStoreTimeout is the deadline of the datastore lookups of a query
+// unless dns.store_timeout is set, queries not answered in time get SERVFAIL.
+// Resolvers usually retry after 5 seconds
+const DefaultStoreTimeout = 2 * time.Second
+
+// DefaultStates are the host states answered unless dns.states is set,
+// retired hosts are left out
+var DefaultStates = []string{model.HostStateNew, model.HostStateReady, model.HostStateInstalling, model.HostStateProduction, model.HostStateDrained}
+
 type Server struct {
 	Address string
 
+	// PacketConn is a socket passed by systemd, used by Listen instead of
+	// binding Address
+	PacketConn net.PacketConn
+
+	// Transfers binds a TCP socket on Address answering zone transfers of
+	// the reverse zones. Listener is a socket passed by systemd used instead
+	Transfers bool
+	Listener  net.Listener
+
+	h   *handler
 	srv *dns.Server
+	tcp *dns.Server
 }
 
 func NewServer(db store.Store, address string, ttl int) (*Server, error) {
 	s := &Server{Address: address}
 
 	s.srv = &dns.Server{Addr: address, Net: "udp"}
+	s.tcp = &dns.Server{Addr: address, Net: "tcp"}
 	h, err := NewHandler(db, uint32(ttl))
 	if err != nil {
 		return nil, err
 	}
 
+	s.h = h
 	s.srv.Handler = h
+	s.tcp.Handler = h
 
 	return s, nil
 }
 
+// SetACL replaces the ACL of the queries answered by the server, nil allows
+// every client
+func (s *Server) SetACL(acl *ACL) {
+	s.h.acl.Store(acl)
+}
+
+// SetStates replaces the states of the hosts answered, DefaultStates when
+// empty
+func (s *Server) SetStates(states []string) {
+	s.h.states.Store(&states)
+}
+
+// SetNamespaces answers the queries of the names in the zones of the
+// namespaces from their stores, set before serving
+func (s *Server) SetNamespaces(r *namespace.Registry) {
+	s.h.namespaces = r
+}
+
+// SetZones replaces the zones answered authoritatively
+func (s *Server) SetZones(zones []Zone) {
+	s.h.zones.Store(&zones)
+}
+
+// SetDelegations replaces the child zones answered with a referral
+func (s *Server) SetDelegations(d Delegations) {
+	s.h.delegations.Store(&d)
+}
+
+// Listen binds the UDP socket of the server and the TCP socket with
+// Transfers. Serve binds them when Listen was not called
+func (s *Server) Listen() error {
+	if s.PacketConn != nil {
+		s.srv.PacketConn = s.PacketConn
+	} else {
+		conn, err := net.ListenPacket("udp", s.Address)
+		if err != nil {
+			return err
+		}
+		s.srv.PacketConn = conn
+	}
+
+	if !s.Transfers {
+		return nil
+	}
+	if s.Listener != nil {
+		s.tcp.Listener = s.Listener
+		return nil
+	}
+
+	l, err := net.Listen("tcp", s.Address)
+	if err != nil {
+		s.srv.PacketConn.Close()
+		return err
+	}
+	s.tcp.Listener = l
+
+	return nil
+}
+
 func (s *Server) Serve() error {
+	if s.srv.PacketConn == nil {
+		if err := s.Listen(); err != nil {
+			return err
+		}
+	}
+
+	if s.tcp.Listener != nil {
+		go func() {
+			if err := s.tcp.ActivateAndServe(); err != nil {
+				log.WithField("err", err).Error("Zone transfer server stopped")
+			}
+		}()
+	}
+
 	log.Infof("Server listening on: %s", s.Address)
-	return s.srv.ListenAndServe()
+	return s.srv.ActivateAndServe()
 }
 
 func (s *Server) Shutdown(ctx context.Context) error {
+	if s.tcp.Listener != nil {
+		if err := s.tcp.ShutdownContext(ctx); err != nil {
+			return err
+		}
+	}
+
 	return s.srv.ShutdownContext(ctx)
 }
+
+// Addr returns the address the server is bound to, nil until Listen is
+// called
+func (s *Server) Addr() net.Addr {
+	if s.srv.PacketConn == nil {
+		return nil
+	}
+	return s.srv.PacketConn.LocalAddr()
+}
+
+// TCPAddr returns the address of the zone transfer socket, nil without
+// Transfers or until Listen is called
+func (s *Server) TCPAddr() net.Addr {
+	if s.tcp.Listener == nil {
+		return nil
+	}
+	return s.tcp.Listener.Addr()
+}
+
+// Check queries the server over loopback for a TXT record presented for the
+// probe and returns an error unless it is answered
+func (s *Server) Check(ctx context.Context) error {
+	if err := health.SocketOpen(s.srv.PacketConn); err != nil {
+		return err
+	}
+
+	addr, ok := s.srv.PacketConn.LocalAddr().(*net.UDPAddr)
+	if !ok {
+		return fmt.Errorf("unexpected socket address %s", s.srv.PacketConn.LocalAddr())
+	}
+	if addr.IP.IsUnspecified() {
+		addr = &net.UDPAddr{IP: net.IPv4(127, 0, 0, 1), Port: addr.Port}
+	}
+
+	value := rand.Text()
+	name := fmt.Sprintf("_grendel-health-%s.", value)
+	PresentTXT(name, value)
+	defer CleanUpTXT(name, value)
+
+	m := new(dns.Msg)
+	m.SetQuestion(name, dns.TypeTXT)
+
+	c := &dns.Client{Net: "udp"}
+	r, _, err := c.ExchangeContext(ctx, m, addr.String())
+	if err != nil {
+		return err
+	}
+
+	for _, rr := range r.Answer {
+		if txt, ok := rr.(*dns.TXT); ok && slices.Contains(txt.Txt, value) {
+			return nil
+		}
+	}
+
+	return errors.New("loopback probe not answered")
+}
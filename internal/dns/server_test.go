@@ -10,8 +10,10 @@ import (
 	"time"
 
 	"github.com/miekg/dns"
+	"github.com/prometheus/client_golang/prometheus/testutil"
 	"github.com/spf13/viper"
 	"github.com/stretchr/testify/assert"
+	"github.com/ubccr/grendel/internal/config"
 	"github.com/ubccr/grendel/internal/store/sqlstore"
 	"github.com/ubccr/grendel/pkg/model"
 )
@@ -142,3 +144,169 @@ func TestDns(t *testing.T) {
 	assert.Equal(p5[3], "A")
 	assert.Equal(p5[4], "128.205.11.109")
 }
+
+func TestDnsRecords(t *testing.T) {
+	assert := assert.New(t)
+
+	store, err := sqlstore.New(":memory:")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = store.StoreHost(&model.Host{
+		Name: "test-01",
+		Interfaces: []*model.NetInterface{
+			{
+				FQDN: clientFQDN,
+				IP:   clientIP,
+			},
+		},
+	})
+	assert.NoError(err)
+
+	err = store.StoreDNSRecords(model.RecordList{
+		{Name: "vip.example.local", Type: model.RecordTypeA, Value: "10.1.0.200", PTR: true, TTL: 60},
+		{Name: "www.example.local", Type: model.RecordTypeCNAME, Value: clientFQDN},
+	})
+	assert.NoError(err)
+
+	recordAddr := "127.0.0.1:8054"
+	s, err := NewServer(store, recordAddr, 5)
+	if err != nil {
+		t.Fatal(err)
+	}
+	go s.Serve()
+	defer s.Shutdown(context.Background())
+
+	time.Sleep(time.Second * 1)
+
+	query := func(name string, qtype uint16) []dns.RR {
+		m := new(dns.Msg)
+		m.SetQuestion(name, qtype)
+		r, err := dns.Exchange(m, recordAddr)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return r.Answer
+	}
+
+	answered := testutil.ToFloat64(queriesTotal.WithLabelValues("A", "NOERROR"))
+	missing := testutil.ToFloat64(queriesTotal.WithLabelValues("AAAA", "NXDOMAIN"))
+
+	answers := query("vip.example.local.", dns.TypeA)
+	if assert.Len(answers, 1) {
+		assert.Equal("vip.example.local.\t60\tIN\tA\t10.1.0.200", answers[0].String())
+	}
+
+	answers = query("200.0.1.10.in-addr.arpa.", dns.TypePTR)
+	if assert.Len(answers, 1) {
+		assert.Equal("200.0.1.10.in-addr.arpa.\t60\tIN\tPTR\tvip.example.local.", answers[0].String())
+	}
+
+	answers = query("www.example.local.", dns.TypeA)
+	if assert.Len(answers, 2) {
+		assert.Equal("www.example.local.\t5\tIN\tCNAME\t"+clientFQDN+".", answers[0].String())
+		assert.Equal(clientFQDN+".\t5\tIN\tA\t10.1.0.1", answers[1].String())
+	}
+
+	answers = query("www.example.local.", dns.TypeCNAME)
+	assert.Len(answers, 1)
+
+	answers = query("missing.example.local.", dns.TypeAAAA)
+	assert.Len(answers, 0)
+
+	PresentTXT("_acme-challenge.WWW.example.local", "challenge")
+	answers = query("_acme-challenge.www.example.local.", dns.TypeTXT)
+	if assert.Len(answers, 1) {
+		assert.Equal("_acme-challenge.www.example.local.\t10\tIN\tTXT\t\"challenge\"", answers[0].String())
+	}
+	CleanUpTXT("_acme-challenge.www.example.local.", "challenge")
+	answers = query("_acme-challenge.www.example.local.", dns.TypeTXT)
+	assert.Len(answers, 0)
+
+	assert.NoError(s.Check(context.Background()))
+
+	assert.Equal(answered+2, testutil.ToFloat64(queriesTotal.WithLabelValues("A", "NOERROR")))
+	assert.Equal(missing+1, testutil.ToFloat64(queriesTotal.WithLabelValues("AAAA", "NXDOMAIN")))
+}
+
+func TestDnsHostStates(t *testing.T) {
+	assert := assert.New(t)
+
+	store, err := sqlstore.New(":memory:")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, h := range []struct{ name, ip, state string }{
+		{"cpn-01", "192.0.2.70/26", model.HostStateProduction},
+		{"cpn-02", "192.0.2.71/26", model.HostStateRetired},
+	} {
+		err = store.StoreHost(&model.Host{
+			Name:  h.name,
+			State: h.state,
+			Interfaces: []*model.NetInterface{
+				{FQDN: h.name + ".example.local", IP: netip.MustParsePrefix(h.ip)},
+			},
+		})
+		assert.NoError(err)
+	}
+
+	cfg := *config.Current()
+	cfg.Subnets = []config.Subnet{{Gateway: netip.MustParsePrefix("192.0.2.65/26")}}
+	defer config.Set(config.Set(&cfg))
+	viper.Set("dns.allow_transfer", []string{"127.0.0.1"})
+	defer viper.Set("dns.allow_transfer", []string{})
+
+	statesAddr := "127.0.0.1:8056"
+	s, err := NewServer(store, statesAddr, 5)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s.Transfers = true
+	go s.Serve()
+	defer s.Shutdown(context.Background())
+
+	time.Sleep(time.Second * 1)
+
+	query := func(name string, qtype uint16) []dns.RR {
+		m := new(dns.Msg)
+		m.SetQuestion(name, qtype)
+		r, err := dns.Exchange(m, statesAddr)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return r.Answer
+	}
+	transfer := func() string {
+		m := new(dns.Msg)
+		m.SetAxfr("64/26.2.0.192.in-addr.arpa.")
+		env, err := new(dns.Transfer).In(m, statesAddr)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var b strings.Builder
+		for e := range env {
+			assert.NoError(e.Error)
+			for _, rr := range e.RR {
+				b.WriteString(rr.String() + "\n")
+			}
+		}
+		return b.String()
+	}
+
+	// Retired hosts are left out by default
+	assert.Len(query("cpn-01.example.local.", dns.TypeA), 1)
+	assert.Len(query("cpn-02.example.local.", dns.TypeA), 0)
+	assert.Len(query("71.64/26.2.0.192.in-addr.arpa.", dns.TypePTR), 0)
+	axfr := transfer()
+	assert.Contains(axfr, "cpn-01.example.local.")
+	assert.NotContains(axfr, "cpn-02.example.local.")
+
+	s.SetStates([]string{model.HostStateRetired})
+	assert.Len(query("cpn-01.example.local.", dns.TypeA), 0)
+	assert.Len(query("cpn-02.example.local.", dns.TypeA), 1)
+	assert.Len(query("71.64/26.2.0.192.in-addr.arpa.", dns.TypePTR), 1)
+	axfr = transfer()
+	assert.NotContains(axfr, "cpn-01.example.local.")
+	assert.Contains(axfr, "cpn-02.example.local.")
+}
@@ -0,0 +1,399 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package dns
+
+import (
+	"cmp"
+	"fmt"
+	"net"
+	"net/netip"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/miekg/dns"
+	"github.com/spf13/viper"
+	"github.com/ubccr/grendel/internal/config"
+	"github.com/ubccr/grendel/internal/util"
+	"github.com/ubccr/grendel/pkg/model"
+)
+
+const (
+	// ClasslessSlash names RFC 2317 zones <first>/<bits>.<c>.<b>.<a>.in-addr.arpa,
+	// the form of the RFC examples
+	ClasslessSlash = "slash"
+
+	// ClasslessDash names RFC 2317 zones <first>-<bits>.<c>.<b>.<a>.in-addr.arpa
+	ClasslessDash = "dash"
+)
+
+// Zone is a zone answered authoritatively: a reverse zone holding the PTR
+// records of the addresses in Prefix, or a forward zone of dns.zones holding
+// the address records of the names in the zone, without Prefix
+type Zone struct {
+	// Name is the fully qualified name of the zone
+	Name   string
+	Prefix netip.Prefix
+}
+
+// Forward returns whether the zone is a forward zone of dns.zones
+func (z Zone) Forward() bool {
+	return !z.Prefix.IsValid()
+}
+
+// Classless returns whether the zone is an RFC 2317 classless zone of an
+// IPv4 prefix longer than /24. The parent zone delegates each of its
+// addresses with a CNAME to the name in the classless zone
+func (z Zone) Classless() bool {
+	return z.Prefix.Addr().Is4() && z.Prefix.Bits() > 24
+}
+
+// Contains returns whether name is the zone or in the zone
+func (z Zone) Contains(name string) bool {
+	return dns.IsSubDomain(z.Name, name)
+}
+
+// PTRName returns the name of the PTR record of addr in the zone
+func (z Zone) PTRName(addr netip.Addr) string {
+	if z.Classless() {
+		return fmt.Sprintf("%d.%s", addr.As4()[3], z.Name)
+	}
+
+	name, _ := dns.ReverseAddr(addr.String())
+	return name
+}
+
+// Addr returns the address of the PTR record name in the zone
+func (z Zone) Addr(name string) (netip.Addr, bool) {
+	if !z.Classless() {
+		addr, err := netip.ParseAddr(util.ExtractAddressFromReverse(name))
+		return addr, err == nil && z.Prefix.Contains(addr)
+	}
+
+	label, rest, _ := strings.Cut(name, ".")
+	last, err := strconv.ParseUint(label, 10, 8)
+	if err != nil || rest != z.Name {
+		return netip.Addr{}, false
+	}
+	b := z.Prefix.Addr().As4()
+	b[3] = byte(last)
+	addr := netip.AddrFrom4(b)
+
+	return addr, z.Prefix.Contains(addr)
+}
+
+// ReverseZones returns the reverse zones of prefixes. IPv4 zones fall on
+// octet and IPv6 zones on nibble boundaries, a /22 has four /24 zones. IPv4
+// prefixes longer than /24 have an RFC 2317 classless zone named in style,
+// ClasslessSlash when empty. Zones of overlapping prefixes are returned once
+func ReverseZones(prefixes []netip.Prefix, style string) ([]Zone, error) {
+	sep := "/"
+	switch style {
+	case ClasslessSlash, "":
+	case ClasslessDash:
+		sep = "-"
+	default:
+		return nil, fmt.Errorf("invalid classless zone naming %q. Valid options: slash, dash", style)
+	}
+
+	zones := make([]Zone, 0, len(prefixes))
+	add := func(z Zone) {
+		if !slices.ContainsFunc(zones, func(e Zone) bool { return e.Name == z.Name }) {
+			zones = append(zones, z)
+		}
+	}
+
+	for _, p := range prefixes {
+		p = p.Masked()
+		if !p.IsValid() {
+			continue
+		}
+
+		if p.Addr().Is4() {
+			o := p.Addr().As4()
+			if p.Bits() > 24 {
+				add(Zone{
+					Name:   fmt.Sprintf("%d%s%d.%d.%d.%d.in-addr.arpa.", o[3], sep, p.Bits(), o[2], o[1], o[0]),
+					Prefix: p,
+				})
+				continue
+			}
+
+			octets := max(1, (p.Bits()+7)/8)
+			for i := range 1 << (octets*8 - p.Bits()) {
+				b := o
+				b[octets-1] += byte(i)
+				labels := make([]string, 0, octets+2)
+				for j := octets - 1; j >= 0; j-- {
+					labels = append(labels, strconv.Itoa(int(b[j])))
+				}
+				add(Zone{
+					Name:   strings.Join(append(labels, "in-addr", "arpa."), "."),
+					Prefix: netip.PrefixFrom(netip.AddrFrom4(b), octets*8),
+				})
+			}
+			continue
+		}
+
+		nibbles := max(1, (p.Bits()+3)/4)
+		for i := range 1 << (nibbles*4 - p.Bits()) {
+			b := p.Addr().As16()
+			// Nibbles are the high then low half of each byte
+			shift := 4
+			if nibbles%2 == 0 {
+				shift = 0
+			}
+			b[(nibbles-1)/2] += byte(i) << shift
+			labels := make([]string, 0, nibbles+2)
+			for j := nibbles - 1; j >= 0; j-- {
+				n := b[j/2] >> 4
+				if j%2 == 1 {
+					n = b[j/2] & 0xf
+				}
+				labels = append(labels, strconv.FormatUint(uint64(n), 16))
+			}
+			add(Zone{
+				Name:   strings.Join(append(labels, "ip6", "arpa."), "."),
+				Prefix: netip.PrefixFrom(netip.AddrFrom16(b), nibbles*4),
+			})
+		}
+	}
+
+	return zones, nil
+}
+
+// ZonesFromConfig returns the reverse zones of subnets, named in
+// dns.classless_naming, and the forward zones of dns.zones
+func ZonesFromConfig(v *viper.Viper, subnets []config.Subnet) ([]Zone, error) {
+	prefixes := make([]netip.Prefix, 0, len(subnets))
+	for _, s := range subnets {
+		prefixes = append(prefixes, s.Gateway)
+	}
+
+	zones, err := ReverseZones(prefixes, v.GetString("dns.classless_naming"))
+	if err != nil {
+		return nil, err
+	}
+	forward, err := ForwardZones(v.GetStringSlice("dns.zones"))
+	if err != nil {
+		return nil, err
+	}
+
+	return append(zones, forward...), nil
+}
+
+// ForwardZones returns the forward zones named in names
+func ForwardZones(names []string) ([]Zone, error) {
+	zones := make([]Zone, 0, len(names))
+	for _, n := range names {
+		name := strings.ToLower(dns.Fqdn(n))
+		if _, ok := dns.IsDomainName(name); !ok || name == "." || util.IsReverse(name) > 0 {
+			return nil, fmt.Errorf("invalid dns.zones zone %q", n)
+		}
+		if !slices.ContainsFunc(zones, func(e Zone) bool { return e.Name == name }) {
+			zones = append(zones, Zone{Name: name})
+		}
+	}
+
+	return zones, nil
+}
+
+// FindZone returns the most specific zone of zones containing name
+func FindZone(zones []Zone, name string) (Zone, bool) {
+	found := Zone{}
+	for _, z := range zones {
+		if z.Contains(name) && len(z.Name) > len(found.Name) {
+			found = z
+		}
+	}
+
+	return found, found.Name != ""
+}
+
+// FindClassless returns the classless zone of zones holding addr
+func FindClassless(zones []Zone, addr netip.Addr) (Zone, bool) {
+	for _, z := range zones {
+		if z.Classless() && z.Prefix.Contains(addr) {
+			return z, true
+		}
+	}
+
+	return Zone{}, false
+}
+
+// SOA returns the SOA record of the zone served by nameserver
+func (z Zone) SOA(nameserver string, ttl uint32, serial uint32) *dns.SOA {
+	return &dns.SOA{
+		Hdr:     dns.RR_Header{Name: z.Name, Rrtype: dns.TypeSOA, Class: dns.ClassINET, Ttl: ttl},
+		Ns:      dns.Fqdn(nameserver),
+		Mbox:    dns.Fqdn("hostmaster." + nameserver),
+		Serial:  serial,
+		Refresh: 3600,
+		Retry:   600,
+		Expire:  7 * 24 * 3600,
+		Minttl:  ttl,
+	}
+}
+
+// NS returns the NS record of the zone served by nameserver
+func (z Zone) NS(nameserver string, ttl uint32) *dns.NS {
+	return &dns.NS{
+		Hdr: dns.RR_Header{Name: z.Name, Rrtype: dns.TypeNS, Class: dns.ClassINET, Ttl: ttl},
+		Ns:  dns.Fqdn(nameserver),
+	}
+}
+
+// Serial returns the SOA serial of zones changing at t, the unix time
+func Serial(t time.Time) uint32 {
+	return uint32(t.Unix())
+}
+
+// Records returns the SOA, NS and PTR records of the zone for the addresses
+// of the host interfaces, bonds and secondary addresses and the A records
+// with a PTR in the zone, ordered by address as in a zone transfer without
+// the closing SOA. Forward zones have the address records of their names
+func (z Zone) Records(nameserver string, ttl uint32, serial uint32, hosts model.HostList, records model.RecordList) []dns.RR {
+	if z.Forward() {
+		return z.forwardRecords(nameserver, ttl, serial, hosts, records)
+	}
+
+	type ptr struct {
+		addr netip.Addr
+		name string
+		ttl  uint32
+	}
+	ptrs := make([]ptr, 0)
+	add := func(ip netip.Addr, fqdn string, ttl uint32) {
+		// Only the first name of an interface gets a PTR record
+		name, _, _ := strings.Cut(fqdn, ",")
+		name = strings.TrimSpace(name)
+		if !ip.IsValid() || name == "" || !z.Prefix.Contains(ip.Unmap()) {
+			return
+		}
+		ptrs = append(ptrs, ptr{addr: ip.Unmap(), name: name, ttl: ttl})
+	}
+
+	for _, host := range hosts {
+		nics := make([]*model.NetInterface, 0, len(host.Interfaces)+len(host.Bonds))
+		nics = append(nics, host.Interfaces...)
+		for _, b := range host.Bonds {
+			nics = append(nics, &b.NetInterface)
+		}
+		for _, nic := range nics {
+			add(nic.IP.Addr(), nic.FQDN, ttl)
+			for _, a := range nic.Addresses {
+				add(a.IP.Addr(), a.FQDN, ttl)
+			}
+		}
+	}
+	for _, r := range records {
+		if r.Type != model.RecordTypeA || !r.PTR {
+			continue
+		}
+		addr, err := netip.ParseAddr(r.Value)
+		if err != nil {
+			continue
+		}
+		rttl := ttl
+		if r.TTL > 0 {
+			rttl = uint32(r.TTL)
+		}
+		add(addr, r.Name, rttl)
+	}
+
+	slices.SortStableFunc(ptrs, func(a, b ptr) int { return a.addr.Compare(b.addr) })
+
+	rrs := []dns.RR{z.SOA(nameserver, ttl, serial), z.NS(nameserver, ttl)}
+	for _, p := range ptrs {
+		rrs = append(rrs, &dns.PTR{
+			Hdr: dns.RR_Header{Name: z.PTRName(p.addr), Rrtype: dns.TypePTR, Class: dns.ClassINET, Ttl: p.ttl},
+			Ptr: dns.Fqdn(p.name),
+		})
+	}
+
+	return rrs
+}
+
+// forwardRecords returns the SOA and NS records of a forward zone, the A and
+// AAAA records of each name in the zone of the host interfaces, bonds and
+// secondary addresses and the A and CNAME records in the zone, ordered by
+// name
+func (z Zone) forwardRecords(nameserver string, ttl uint32, serial uint32, hosts model.HostList, records model.RecordList) []dns.RR {
+	var rrs []dns.RR
+	add := func(ip netip.Addr, fqdns string) {
+		if !ip.IsValid() {
+			return
+		}
+		for _, name := range strings.Split(fqdns, ",") {
+			name = strings.ToLower(dns.Fqdn(strings.TrimSpace(name)))
+			if name == "." || !z.Contains(name) {
+				continue
+			}
+			if ip.Unmap().Is4() {
+				rrs = append(rrs, a(name, ttl, []net.IP{ip.Unmap().AsSlice()})...)
+			} else {
+				rrs = append(rrs, aaaa(name, ttl, []net.IP{ip.AsSlice()})...)
+			}
+		}
+	}
+
+	for _, host := range hosts {
+		nics := make([]*model.NetInterface, 0, len(host.Interfaces)+len(host.Bonds))
+		nics = append(nics, host.Interfaces...)
+		for _, b := range host.Bonds {
+			nics = append(nics, &b.NetInterface)
+		}
+		for _, nic := range nics {
+			add(nic.IP.Addr(), nic.FQDN)
+			for _, addr := range nic.Addresses {
+				add(addr.IP.Addr(), addr.FQDN)
+			}
+		}
+	}
+	for _, r := range records {
+		name := strings.ToLower(dns.Fqdn(r.Name))
+		if !z.Contains(name) {
+			continue
+		}
+		rttl := ttl
+		if r.TTL > 0 {
+			rttl = uint32(r.TTL)
+		}
+		switch r.Type {
+		case model.RecordTypeA:
+			if ip := net.ParseIP(r.Value); ip != nil {
+				rrs = append(rrs, a(name, rttl, []net.IP{ip})...)
+			}
+		case model.RecordTypeCNAME:
+			rrs = append(rrs, cname(name, rttl, dns.Fqdn(r.Value)))
+		}
+	}
+
+	slices.SortStableFunc(rrs, func(x, y dns.RR) int {
+		return cmp.Or(cmp.Compare(x.Header().Name, y.Header().Name), cmp.Compare(x.Header().Rrtype, y.Header().Rrtype))
+	})
+
+	return append([]dns.RR{z.SOA(nameserver, ttl, serial), z.NS(nameserver, ttl)}, rrs...)
+}
+
+// Delegation returns the CNAME records of a classless zone the parent zone
+// adds for each address, pointing at the PTR records in the zone
+func (z Zone) Delegation(ttl uint32) []dns.RR {
+	if !z.Classless() {
+		return nil
+	}
+
+	rrs := make([]dns.RR, 0, 1<<(32-z.Prefix.Bits()))
+	for addr := z.Prefix.Addr(); z.Prefix.Contains(addr); addr = addr.Next() {
+		name, _ := dns.ReverseAddr(addr.String())
+		rrs = append(rrs, &dns.CNAME{
+			Hdr:    dns.RR_Header{Name: name, Rrtype: dns.TypeCNAME, Class: dns.ClassINET, Ttl: ttl},
+			Target: z.PTRName(addr),
+		})
+	}
+
+	return rrs
+}
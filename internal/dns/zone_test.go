@@ -0,0 +1,233 @@
+package dns
+
+import (
+	"context"
+	"net/netip"
+	"testing"
+	"time"
+
+	"github.com/miekg/dns"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"github.com/ubccr/grendel/internal/config"
+	"github.com/ubccr/grendel/internal/store/sqlstore"
+	"github.com/ubccr/grendel/pkg/model"
+)
+
+func zoneNames(zones []Zone) []string {
+	names := make([]string, 0, len(zones))
+	for _, z := range zones {
+		names = append(names, z.Name)
+	}
+	return names
+}
+
+func TestReverseZones(t *testing.T) {
+	assert := assert.New(t)
+
+	zones, err := ReverseZones([]netip.Prefix{
+		netip.MustParsePrefix("10.4.0.1/22"),
+		netip.MustParsePrefix("10.4.1.0/24"),
+		netip.MustParsePrefix("192.0.2.70/26"),
+		netip.MustParsePrefix("172.16.0.0/12"),
+		netip.MustParsePrefix("2001:db8:1234::/46"),
+	}, "")
+	assert.NoError(err)
+	assert.Equal([]string{
+		"0.4.10.in-addr.arpa.",
+		"1.4.10.in-addr.arpa.",
+		"2.4.10.in-addr.arpa.",
+		"3.4.10.in-addr.arpa.",
+		"64/26.2.0.192.in-addr.arpa.",
+		"16.172.in-addr.arpa.",
+		"17.172.in-addr.arpa.",
+		"18.172.in-addr.arpa.",
+		"19.172.in-addr.arpa.",
+		"20.172.in-addr.arpa.",
+		"21.172.in-addr.arpa.",
+		"22.172.in-addr.arpa.",
+		"23.172.in-addr.arpa.",
+		"24.172.in-addr.arpa.",
+		"25.172.in-addr.arpa.",
+		"26.172.in-addr.arpa.",
+		"27.172.in-addr.arpa.",
+		"28.172.in-addr.arpa.",
+		"29.172.in-addr.arpa.",
+		"30.172.in-addr.arpa.",
+		"31.172.in-addr.arpa.",
+		"4.3.2.1.8.b.d.0.1.0.0.2.ip6.arpa.",
+		"5.3.2.1.8.b.d.0.1.0.0.2.ip6.arpa.",
+		"6.3.2.1.8.b.d.0.1.0.0.2.ip6.arpa.",
+		"7.3.2.1.8.b.d.0.1.0.0.2.ip6.arpa.",
+	}, zoneNames(zones))
+
+	zones, err = ReverseZones([]netip.Prefix{netip.MustParsePrefix("192.0.2.70/26")}, ClasslessDash)
+	assert.NoError(err)
+	assert.Equal([]string{"64-26.2.0.192.in-addr.arpa."}, zoneNames(zones))
+
+	_, err = ReverseZones(nil, "dot")
+	assert.Error(err)
+}
+
+func TestZoneNames(t *testing.T) {
+	assert := assert.New(t)
+
+	zones, err := ReverseZones([]netip.Prefix{
+		netip.MustParsePrefix("10.4.0.0/22"),
+		netip.MustParsePrefix("192.0.2.64/26"),
+		netip.MustParsePrefix("192.0.2.0/24"),
+	}, ClasslessSlash)
+	assert.NoError(err)
+
+	for _, addr := range []string{"10.4.3.7", "192.0.2.100"} {
+		a := netip.MustParseAddr(addr)
+		z, ok := FindZone(zones, mustReverse(a))
+		if !assert.True(ok) {
+			continue
+		}
+		if a.String() == "192.0.2.100" {
+			// The /24 holds the CNAME, the classless zone the PTR
+			assert.Equal("2.0.192.in-addr.arpa.", z.Name)
+			z, ok = FindClassless(zones, a)
+			assert.True(ok)
+			assert.Equal("100.64/26.2.0.192.in-addr.arpa.", z.PTRName(a))
+		}
+		back, ok := z.Addr(z.PTRName(a))
+		assert.True(ok)
+		assert.Equal(a, back)
+	}
+
+	z, ok := FindZone(zones, "5.64/26.2.0.192.in-addr.arpa.")
+	assert.True(ok)
+	assert.True(z.Classless())
+	_, ok = z.Addr("200.64/26.2.0.192.in-addr.arpa.")
+	assert.False(ok)
+
+	_, ok = FindZone(zones, "1.1.10.in-addr.arpa.")
+	assert.False(ok)
+}
+
+func mustReverse(addr netip.Addr) string {
+	name, _ := dns.ReverseAddr(addr.String())
+	return name
+}
+
+func TestZoneRecords(t *testing.T) {
+	assert := assert.New(t)
+
+	zones, err := ReverseZones([]netip.Prefix{netip.MustParsePrefix("192.0.2.64/30")}, ClasslessDash)
+	assert.NoError(err)
+	z := zones[0]
+
+	hosts := model.HostList{
+		{
+			Name: "cpn-02",
+			Interfaces: []*model.NetInterface{
+				{FQDN: "cpn-02.example.local,cpn-02", IP: netip.MustParsePrefix("192.0.2.66/30")},
+				{FQDN: "cpn-02.other.local", IP: netip.MustParsePrefix("10.0.0.2/24")},
+			},
+		},
+	}
+	records := model.RecordList{
+		{Name: "vip.example.local", Type: model.RecordTypeA, Value: "192.0.2.65", PTR: true, TTL: 60},
+		{Name: "nop.example.local", Type: model.RecordTypeA, Value: "192.0.2.67"},
+	}
+
+	rrs := z.Records("ns.example.local", 300, 1, hosts, records)
+	if assert.Len(rrs, 4) {
+		assert.Equal(dns.TypeSOA, rrs[0].Header().Rrtype)
+		assert.Equal("64-30.2.0.192.in-addr.arpa.\t300\tIN\tNS\tns.example.local.", rrs[1].String())
+		assert.Equal("65.64-30.2.0.192.in-addr.arpa.\t60\tIN\tPTR\tvip.example.local.", rrs[2].String())
+		assert.Equal("66.64-30.2.0.192.in-addr.arpa.\t300\tIN\tPTR\tcpn-02.example.local.", rrs[3].String())
+	}
+
+	delegation := z.Delegation(300)
+	if assert.Len(delegation, 4) {
+		assert.Equal("64.2.0.192.in-addr.arpa.\t300\tIN\tCNAME\t64.64-30.2.0.192.in-addr.arpa.", delegation[0].String())
+	}
+}
+
+func TestDnsReverseZones(t *testing.T) {
+	assert := assert.New(t)
+
+	store, err := sqlstore.New(":memory:")
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = store.StoreHost(&model.Host{
+		Name: "cpn-01",
+		Interfaces: []*model.NetInterface{
+			{FQDN: "cpn-01.example.local", IP: netip.MustParsePrefix("192.0.2.70/26")},
+		},
+	})
+	assert.NoError(err)
+
+	cfg := *config.Current()
+	cfg.Subnets = []config.Subnet{{Gateway: netip.MustParsePrefix("192.0.2.65/26")}}
+	defer config.Set(config.Set(&cfg))
+	viper.Set("dns.hostname", "ns.example.local")
+	viper.Set("dns.allow_transfer", []string{"127.0.0.1"})
+	defer viper.Set("dns.hostname", "")
+	defer viper.Set("dns.allow_transfer", []string{})
+
+	zoneAddr := "127.0.0.1:8055"
+	s, err := NewServer(store, zoneAddr, 5)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s.Transfers = true
+	go s.Serve()
+	defer s.Shutdown(context.Background())
+
+	time.Sleep(time.Second * 1)
+
+	query := func(name string, qtype uint16) *dns.Msg {
+		m := new(dns.Msg)
+		m.SetQuestion(name, qtype)
+		r, err := dns.Exchange(m, zoneAddr)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return r
+	}
+
+	r := query("70.2.0.192.in-addr.arpa.", dns.TypePTR)
+	if assert.Len(r.Answer, 2) {
+		assert.Equal("70.2.0.192.in-addr.arpa.\t5\tIN\tCNAME\t70.64/26.2.0.192.in-addr.arpa.", r.Answer[0].String())
+		assert.Equal("70.64/26.2.0.192.in-addr.arpa.\t5\tIN\tPTR\tcpn-01.example.local.", r.Answer[1].String())
+	}
+
+	r = query("71.64/26.2.0.192.in-addr.arpa.", dns.TypePTR)
+	assert.True(r.Authoritative)
+	assert.Equal(dns.RcodeNameError, r.Rcode)
+	assert.Len(r.Ns, 1)
+
+	r = query("64/26.2.0.192.in-addr.arpa.", dns.TypeSOA)
+	assert.True(r.Authoritative)
+	if assert.Len(r.Answer, 1) {
+		assert.Equal("ns.example.local.", r.Answer[0].(*dns.SOA).Ns)
+	}
+
+	tr := new(dns.Transfer)
+	m := new(dns.Msg)
+	m.SetAxfr("64/26.2.0.192.in-addr.arpa.")
+	env, err := tr.In(m, zoneAddr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rrs := make([]dns.RR, 0)
+	for e := range env {
+		assert.NoError(e.Error)
+		rrs = append(rrs, e.RR...)
+	}
+	if assert.Len(rrs, 4) {
+		assert.Equal(dns.TypeSOA, rrs[0].Header().Rrtype)
+		assert.Equal("70.64/26.2.0.192.in-addr.arpa.\t5\tIN\tPTR\tcpn-01.example.local.", rrs[2].String())
+		assert.Equal(dns.TypeSOA, rrs[3].Header().Rrtype)
+	}
+	// The zones are compiled when the server is built and replaced on reload
+	s.SetZones(nil)
+	r = query("64/26.2.0.192.in-addr.arpa.", dns.TypeSOA)
+	assert.False(r.Authoritative)
+	assert.Empty(r.Answer)
+}
@@ -7,6 +7,8 @@ package firmware
 import (
 	_ "embed"
 	"fmt"
+	"slices"
+	"strings"
 
 	"github.com/insomniacslk/dhcp/iana"
 )
@@ -61,6 +63,28 @@ func NewFromString(b string) Build {
 	return Build(0)
 }
 
+// Names returns the sorted names of the builds
+func Names() []string {
+	names := make([]string, 0, len(BuildToStringMap))
+	for _, v := range BuildToStringMap {
+		names = append(names, v)
+	}
+	slices.Sort(names)
+
+	return names
+}
+
+// Parse returns the build with the given name, or an error listing the valid
+// names
+func Parse(name string) (Build, error) {
+	b := NewFromString(name)
+	if b.IsNil() {
+		return b, fmt.Errorf("invalid firmware %q, valid names: %s", name, strings.Join(Names(), ", "))
+	}
+
+	return b, nil
+}
+
 // String returns a name for a given build.
 func (b Build) String() string {
 	if bt, ok := BuildToStringMap[b]; ok {
@@ -92,37 +116,90 @@ func (b Build) ToBytes() []byte {
 	return nil
 }
 
-func DetectBuild(archs iana.Archs, userClass string) (Build, error) {
-	var build Build
+// archBuilds maps the client system architectures of DHCP option 93 to the
+// build they boot
+var archBuilds = map[iana.Arch]Build{
+	iana.INTEL_X86PC: UNDI,          // BIOS Boot
+	iana.EFI_IA32:    EFI386,        // unverified
+	iana.EFI_BC:      SNPONLYx86_64, // UEFI x86_64 Boot
+	iana.EFI_X86_64:  SNPONLYx86_64,
+	iana.EFI_ARM64:   SNPONLYarm64, // UEFI ARM64
+}
+
+// Request is what a client sends to select its firmware and the override of
+// the host, if any
+type Request struct {
+	// Archs is the client system architecture list of option 93
+	Archs iana.Archs
+
+	// UserClass is the user class of option 77, iPXE or grendel once the
+	// firmware runs
+	UserClass string
+
+	// VendorClass is the vendor class identifier of option 60
+	VendorClass string
 
-	if len(archs) == 0 {
-		return build, fmt.Errorf("no client system architecture types provided")
+	// Override is the firmware set on the host, its tags or boot image.
+	// It replaces the build of EFI clients only
+	Override Build
+}
+
+// Selection is the firmware selected for a client
+type Selection struct {
+	// Detected is the build matching the client
+	Detected Build
+
+	// Build is the build sent to the client, Detected or the override
+	Build Build
+}
+
+// Overridden returns whether the detected build was replaced by the override
+func (s Selection) Overridden() bool {
+	return s.Build != s.Detected
+}
+
+// EFI returns whether b is a build booted by EFI firmware
+func (b Build) EFI() bool {
+	switch b {
+	case EFI386, EFI64, SNPONLYx86_64, SNPONLYarm64:
+		return true
+	}
+
+	return false
+}
+
+// Select returns the firmware of the client making req. It depends on req
+// alone so the selection can be checked without a client
+func Select(req Request) (Selection, error) {
+	var sel Selection
+
+	if strings.HasPrefix(req.VendorClass, "HTTPClient") {
+		return sel, fmt.Errorf("unsupported HTTP boot client: %s", req.VendorClass)
+	}
+	if len(req.Archs) == 0 {
+		return sel, fmt.Errorf("no client system architecture types provided")
 	}
 
 	//XXX TODO use first arch? what to do if there's more than one??
-	arch := archs[0]
-
-	switch arch {
-	case iana.INTEL_X86PC: // BIOS Boot
-		build = UNDI
-	case iana.EFI_IA32: // unverified
-		build = EFI386
-	case iana.EFI_BC, iana.EFI_X86_64: // UEFI x86_64 Boot
-		build = SNPONLYx86_64
-	case iana.EFI_ARM64: // UEFI ARM64
-		build = SNPONLYarm64
-	default:
-		return build, fmt.Errorf("unsupported client system architecture type: %d", arch)
+	arch := req.Archs[0]
+
+	build, ok := archBuilds[arch]
+	if !ok {
+		return sel, fmt.Errorf("unsupported client system architecture type: %d", arch)
 	}
 
-	if userClass != "" {
-		if userClass == "iPXE" && arch == iana.INTEL_X86PC {
-			build = IPXE
-		}
-		if userClass == "grendel" {
-			build = GRENDEL
-		}
+	switch {
+	case req.UserClass == "grendel":
+		build = GRENDEL
+	case req.UserClass == "iPXE" && arch == iana.INTEL_X86PC:
+		build = IPXE
+	}
+
+	sel.Detected = build
+	sel.Build = build
+	if build.EFI() && !req.Override.IsNil() {
+		sel.Build = req.Override
 	}
 
-	return build, nil
+	return sel, nil
 }
@@ -0,0 +1,60 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package firmware
+
+import (
+	"testing"
+
+	"github.com/insomniacslk/dhcp/iana"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSelect(t *testing.T) {
+	tests := []struct {
+		name     string
+		req      Request
+		detected Build
+		build    Build
+		err      bool
+	}{
+		{"bios pxe", Request{Archs: iana.Archs{iana.INTEL_X86PC}, VendorClass: "PXEClient:Arch:00000:UNDI:002001"}, UNDI, UNDI, false},
+		{"bios ipxe", Request{Archs: iana.Archs{iana.INTEL_X86PC}, UserClass: "iPXE"}, IPXE, IPXE, false},
+		{"bios grendel", Request{Archs: iana.Archs{iana.INTEL_X86PC}, UserClass: "grendel"}, GRENDEL, GRENDEL, false},
+		{"efi ia32", Request{Archs: iana.Archs{iana.EFI_IA32}}, EFI386, EFI386, false},
+		{"efi x86_64", Request{Archs: iana.Archs{iana.EFI_X86_64}, VendorClass: "PXEClient:Arch:00007:UNDI:003016"}, SNPONLYx86_64, SNPONLYx86_64, false},
+		{"efi bc", Request{Archs: iana.Archs{iana.EFI_BC}}, SNPONLYx86_64, SNPONLYx86_64, false},
+		{"efi arm64", Request{Archs: iana.Archs{iana.EFI_ARM64}}, SNPONLYarm64, SNPONLYarm64, false},
+		{"efi x86_64 ipxe", Request{Archs: iana.Archs{iana.EFI_X86_64}, UserClass: "iPXE"}, SNPONLYx86_64, SNPONLYx86_64, false},
+		{"efi x86_64 grendel", Request{Archs: iana.Archs{iana.EFI_X86_64}, UserClass: "grendel"}, GRENDEL, GRENDEL, false},
+		{"efi arm64 grendel", Request{Archs: iana.Archs{iana.EFI_ARM64}, UserClass: "grendel"}, GRENDEL, GRENDEL, false},
+		{"first arch", Request{Archs: iana.Archs{iana.EFI_ARM64, iana.EFI_X86_64}}, SNPONLYarm64, SNPONLYarm64, false},
+		{"efi override", Request{Archs: iana.Archs{iana.EFI_X86_64}, Override: EFI64}, SNPONLYx86_64, EFI64, false},
+		{"efi ia32 override", Request{Archs: iana.Archs{iana.EFI_IA32}, Override: SNPONLYx86_64}, EFI386, SNPONLYx86_64, false},
+		{"bios override", Request{Archs: iana.Archs{iana.INTEL_X86PC}, Override: EFI64}, UNDI, UNDI, false},
+		{"ipxe override", Request{Archs: iana.Archs{iana.INTEL_X86PC}, UserClass: "iPXE", Override: EFI64}, IPXE, IPXE, false},
+		{"grendel override", Request{Archs: iana.Archs{iana.EFI_X86_64}, UserClass: "grendel", Override: EFI64}, GRENDEL, GRENDEL, false},
+		{"http client", Request{Archs: iana.Archs{iana.EFI_X86_64_HTTP}, VendorClass: "HTTPClient:Arch:00016:UNDI:003001"}, 0, 0, true},
+		{"http arch", Request{Archs: iana.Archs{iana.EFI_X86_64_HTTP}}, 0, 0, true},
+		{"unknown arch", Request{Archs: iana.Archs{iana.EFI_ITANIUM}}, 0, 0, true},
+		{"riscv64", Request{Archs: iana.Archs{iana.EFI_RISCV64}}, 0, 0, true},
+		{"no arch", Request{UserClass: "iPXE"}, 0, 0, true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			sel, err := Select(test.req)
+			if test.err {
+				assert.Error(t, err)
+				assert.True(t, sel.Build.IsNil())
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, test.detected, sel.Detected)
+			assert.Equal(t, test.build, sel.Build)
+			assert.Equal(t, test.detected != test.build, sel.Overridden())
+		})
+	}
+}
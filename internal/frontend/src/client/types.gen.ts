@@ -177,20 +177,60 @@ export type BootImageAddRequest = {
 export type DataDump = {
     Hosts?: Array<{
         bonds?: Array<{
+            addresses?: Array<{
+                fqdn?: string;
+                ip?: string;
+                primary?: boolean;
+            }>;
             bmc?: boolean;
             fqdn?: string;
             id?: number;
             ifname?: string;
             ip?: string;
             mac?: string;
+            mode?: string;
             mtu?: number;
+            options?: {
+                [key: string]: string;
+            };
+            parent?: string;
             peers?: Array<string>;
-            vlan?: string;
+            type?: string;
+            vlan?: number;
         }>;
         boot_image?: string;
+        client_cert_fingerprint?: string;
+        client_cert_serial?: string;
         firmware?: string;
+        hardware?: {
+            collected_at?: string;
+            core_count?: number;
+            cpu_count?: number;
+            cpu_model?: string;
+            dimms?: Array<{
+                size_mib?: number;
+                slot?: string;
+            }>;
+            disks?: Array<{
+                model?: string;
+                name?: string;
+                serial?: string;
+                size_bytes?: number;
+            }>;
+            memory_mib?: number;
+            missing?: Array<string>;
+            nics?: Array<{
+                mac?: string;
+                name?: string;
+            }>;
+        };
         id?: number;
         interfaces?: Array<{
+            addresses?: Array<{
+                fqdn?: string;
+                ip?: string;
+                primary?: boolean;
+            }>;
             bmc?: boolean;
             fqdn?: string;
             id?: number;
@@ -198,10 +238,20 @@ export type DataDump = {
             ip?: string;
             mac?: string;
             mtu?: number;
-            vlan?: string;
+            parent?: string;
+            vlan?: number;
         }>;
+        inventory?: {
+            bios_version?: string;
+            bmc_firmware?: string;
+            captured_at?: string;
+            nic_firmware?: {
+                [key: string]: string;
+            };
+        };
         name?: string;
         provision?: boolean;
+        smbios_uuid?: string;
         tags?: Array<string>;
         uid?: string;
     }>;
@@ -321,20 +371,60 @@ export type HttpError = {
  */
 export type Host = {
     bonds?: Array<{
+        addresses?: Array<{
+            fqdn?: string;
+            ip?: string;
+            primary?: boolean;
+        }>;
         bmc?: boolean;
         fqdn?: string;
         id?: number;
         ifname?: string;
         ip?: string;
         mac?: string;
+        mode?: string;
         mtu?: number;
+        options?: {
+            [key: string]: string;
+        };
+        parent?: string;
         peers?: Array<string>;
-        vlan?: string;
+        type?: string;
+        vlan?: number;
     }>;
     boot_image?: string;
+    client_cert_fingerprint?: string;
+    client_cert_serial?: string;
     firmware?: string;
+    hardware?: {
+        collected_at?: string;
+        core_count?: number;
+        cpu_count?: number;
+        cpu_model?: string;
+        dimms?: Array<{
+            size_mib?: number;
+            slot?: string;
+        }>;
+        disks?: Array<{
+            model?: string;
+            name?: string;
+            serial?: string;
+            size_bytes?: number;
+        }>;
+        memory_mib?: number;
+        missing?: Array<string>;
+        nics?: Array<{
+            mac?: string;
+            name?: string;
+        }>;
+    };
     id?: number;
     interfaces?: Array<{
+        addresses?: Array<{
+            fqdn?: string;
+            ip?: string;
+            primary?: boolean;
+        }>;
         bmc?: boolean;
         fqdn?: string;
         id?: number;
@@ -342,10 +432,20 @@ export type Host = {
         ip?: string;
         mac?: string;
         mtu?: number;
-        vlan?: string;
+        parent?: string;
+        vlan?: number;
     }>;
+    inventory?: {
+        bios_version?: string;
+        bmc_firmware?: string;
+        captured_at?: string;
+        nic_firmware?: {
+            [key: string]: string;
+        };
+    };
     name?: string;
     provision?: boolean;
+    smbios_uuid?: string;
     tags?: Array<string>;
     uid?: string;
 };
@@ -396,20 +496,60 @@ export type Lldp = {
 export type NodeAddRequest = {
     node_list?: Array<{
         bonds?: Array<{
+            addresses?: Array<{
+                fqdn?: string;
+                ip?: string;
+                primary?: boolean;
+            }>;
             bmc?: boolean;
             fqdn?: string;
             id?: number;
             ifname?: string;
             ip?: string;
             mac?: string;
+            mode?: string;
             mtu?: number;
+            options?: {
+                [key: string]: string;
+            };
+            parent?: string;
             peers?: Array<string>;
-            vlan?: string;
+            type?: string;
+            vlan?: number;
         }>;
         boot_image?: string;
+        client_cert_fingerprint?: string;
+        client_cert_serial?: string;
         firmware?: string;
+        hardware?: {
+            collected_at?: string;
+            core_count?: number;
+            cpu_count?: number;
+            cpu_model?: string;
+            dimms?: Array<{
+                size_mib?: number;
+                slot?: string;
+            }>;
+            disks?: Array<{
+                model?: string;
+                name?: string;
+                serial?: string;
+                size_bytes?: number;
+            }>;
+            memory_mib?: number;
+            missing?: Array<string>;
+            nics?: Array<{
+                mac?: string;
+                name?: string;
+            }>;
+        };
         id?: number;
         interfaces?: Array<{
+            addresses?: Array<{
+                fqdn?: string;
+                ip?: string;
+                primary?: boolean;
+            }>;
             bmc?: boolean;
             fqdn?: string;
             id?: number;
@@ -417,10 +557,20 @@ export type NodeAddRequest = {
             ip?: string;
             mac?: string;
             mtu?: number;
-            vlan?: string;
+            parent?: string;
+            vlan?: number;
         }>;
+        inventory?: {
+            bios_version?: string;
+            bmc_firmware?: string;
+            captured_at?: string;
+            nic_firmware?: {
+                [key: string]: string;
+            };
+        };
         name?: string;
         provision?: boolean;
+        smbios_uuid?: string;
         tags?: Array<string>;
         uid?: string;
     }>;
@@ -702,6 +852,7 @@ export type RedfishMetricReport = {
  */
 export type RedfishSystem = {
     bios_version?: string;
+    bmc_firmware?: string;
     boot_next?: string;
     boot_order?: Array<string>;
     health?: string;
@@ -709,6 +860,9 @@ export type RedfishSystem = {
     manufacturer?: string;
     model?: string;
     name?: string;
+    nic_firmware?: {
+        [key: string]: string;
+    };
     oem_dell?: {
         '@Message.ExtendedInfo'?: Array<{
             Message?: string;
@@ -260,6 +260,18 @@ export default function NodeForm({ data, reset }: { data?: Host; reset?: boolean
                             {(subField) => (
                               <div>
                                 <Label>VLAN:</Label>
+                                <Input
+                                  type="number"
+                                  value={subField.state.value ?? ""}
+                                  onChange={(e) => subField.handleChange(+e.target.value)}
+                                />
+                              </div>
+                            )}
+                          </form.Field>
+                          <form.Field name={`interfaces[${i}].parent`}>
+                            {(subField) => (
+                              <div>
+                                <Label>Parent:</Label>
                                 <Input
                                   value={subField.state.value ?? ""}
                                   onChange={(e) => subField.handleChange(e.target.value)}
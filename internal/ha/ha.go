@@ -0,0 +1,365 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+// Package ha runs grendel serve instances sharing a data store in a primary
+// and secondary pair. Both instances write a heartbeat to the data store and
+// answer DNS, the secondary answers DHCP only while the primary is silent
+package ha
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/sirupsen/logrus"
+	"github.com/ubccr/grendel/internal/logger"
+	"github.com/ubccr/grendel/internal/store"
+	"github.com/ubccr/grendel/internal/store/eventstore"
+	"github.com/ubccr/grendel/pkg/model"
+)
+
+const (
+	DefaultHeartbeatInterval = 5 * time.Second
+	DefaultFailoverTimeout   = 30 * time.Second
+
+	// FieldInstance and FieldRole tag the DHCP replies logged by instances in
+	// high availability mode
+	FieldInstance = "ha_instance"
+	FieldRole     = "ha_role"
+)
+
+var (
+	log = logger.GetLogger("HA")
+
+	servingGauge = prometheus.NewGauge(prometheus.GaugeOpts{
+		Name: "grendel_ha_serving",
+		Help: "1 when this instance answers DHCP in high availability mode",
+	})
+	overlapGauge = prometheus.NewGauge(prometheus.GaugeOpts{
+		Name: "grendel_ha_overlap",
+		Help: "1 when another instance answers DHCP along with this one",
+	})
+	transitionsTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "grendel_ha_transitions_total",
+		Help: "Failovers and failbacks of this instance in high availability mode",
+	}, []string{"event"})
+)
+
+func init() {
+	prometheus.MustRegister(servingGauge, overlapGauge, transitionsTotal)
+}
+
+// Config are the high availability settings of an instance
+type Config struct {
+	// Name identifies the instance, the host name when empty
+	Name string
+
+	// Role is primary or secondary
+	Role string
+
+	// HeartbeatInterval is how often the heartbeat is written and the
+	// heartbeats of the other instances are checked
+	HeartbeatInterval time.Duration
+
+	// FailoverTimeout is how long the primary must be silent before the
+	// secondary answers DHCP
+	FailoverTimeout time.Duration
+}
+
+// peer is the last heartbeat of another instance and when this instance saw
+// it change. Heartbeats are compared with the local clock of this instance
+// when they change, so clock differences between hosts do not matter
+type peer struct {
+	instance *model.HAInstance
+	seen     time.Time
+}
+
+// Node is the high availability state of this instance. A nil Node answers
+// DHCP
+type Node struct {
+	config Config
+	db     store.Store
+
+	mu      sync.RWMutex
+	serving bool
+	overlap []string
+	changed time.Time
+	started time.Time
+	peers   map[string]*peer
+	dbErr   bool
+}
+
+// New returns the high availability state of this instance. The primary
+// answers DHCP right away, the secondary once the primary is silent for the
+// failover timeout
+func New(db store.Store, c Config) (*Node, error) {
+	if c.Role != model.HARolePrimary && c.Role != model.HARoleSecondary {
+		return nil, fmt.Errorf("invalid ha.role %q. Valid roles: %s, %s", c.Role, model.HARolePrimary, model.HARoleSecondary)
+	}
+
+	if c.Name == "" {
+		hostname, err := os.Hostname()
+		if err != nil {
+			return nil, fmt.Errorf("failed to get host name, set ha.name: %w", err)
+		}
+		c.Name = hostname
+	}
+
+	if c.HeartbeatInterval <= 0 {
+		c.HeartbeatInterval = DefaultHeartbeatInterval
+	}
+	if c.FailoverTimeout <= 0 {
+		c.FailoverTimeout = DefaultFailoverTimeout
+	}
+	if c.FailoverTimeout <= c.HeartbeatInterval {
+		return nil, errors.New("ha.failover_timeout must be longer than ha.heartbeat_interval")
+	}
+
+	now := time.Now()
+	n := &Node{
+		config:  c,
+		db:      db,
+		serving: c.Role == model.HARolePrimary,
+		changed: now,
+		started: now,
+		peers:   make(map[string]*peer),
+	}
+	n.setGauges()
+
+	return n, nil
+}
+
+// Name returns the name of the instance
+func (n *Node) Name() string {
+	return n.config.Name
+}
+
+// Serving returns whether the instance answers DHCP
+func (n *Node) Serving() bool {
+	if n == nil {
+		return true
+	}
+
+	n.mu.RLock()
+	defer n.mu.RUnlock()
+
+	return n.serving
+}
+
+// Overlap returns whether another instance answers DHCP along with this one
+func (n *Node) Overlap() bool {
+	if n == nil {
+		return false
+	}
+
+	n.mu.RLock()
+	defer n.mu.RUnlock()
+
+	return len(n.overlap) > 0
+}
+
+// Fields returns the log fields tagging the DHCP replies of the instance
+func (n *Node) Fields() logrus.Fields {
+	if n == nil {
+		return logrus.Fields{}
+	}
+
+	return logrus.Fields{
+		FieldInstance: n.config.Name,
+		FieldRole:     n.config.Role,
+	}
+}
+
+func (n *Node) log() *logrus.Entry {
+	return log.WithFields(n.Fields())
+}
+
+// Run writes the heartbeat of the instance and checks the heartbeats of the
+// others every heartbeat interval until stop is closed. The instance is
+// recorded as stopped on return, so the secondary takes over right away when
+// the primary is stopped
+func (n *Node) Run(stop <-chan struct{}) {
+	n.log().Infof("Running as %s, heartbeat every %s, failover after %s", n.config.Role, n.config.HeartbeatInterval, n.config.FailoverTimeout)
+	n.check(time.Now())
+
+	ticker := time.NewTicker(n.config.HeartbeatInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-stop:
+			n.stop()
+			return
+		case <-ticker.C:
+			n.check(time.Now())
+		}
+	}
+}
+
+// check updates the state of the instance from the heartbeats of the others
+// and writes its own heartbeat
+func (n *Node) check(now time.Time) {
+	instances, err := n.db.HAInstances()
+
+	n.mu.Lock()
+	if err != nil {
+		// The peers keep their last heartbeat, a secondary which cannot read
+		// the heartbeat of the primary takes over once it times out
+		if !n.dbErr {
+			n.log().WithField("err", err).Error("Failed to read heartbeats")
+		}
+		n.dbErr = true
+	} else {
+		if n.dbErr {
+			n.log().Info("Reading heartbeats again")
+		}
+		n.dbErr = false
+		n.updatePeers(instances, now)
+	}
+
+	if n.config.Role == model.HARoleSecondary {
+		n.failover(now)
+	}
+	n.checkOverlap(now)
+
+	self := n.instance(now, model.HAStateServing)
+	if !n.serving {
+		self.State = model.HAStateStandby
+	}
+	n.setGauges()
+	n.mu.Unlock()
+
+	if err := n.db.StoreHAInstance(self); err != nil {
+		n.log().WithField("err", err).Warn("Failed to write heartbeat")
+	}
+}
+
+// updatePeers records the heartbeats of the other instances which changed
+func (n *Node) updatePeers(instances model.HAInstanceList, now time.Time) {
+	for _, i := range instances {
+		if i.Name == n.config.Name {
+			continue
+		}
+
+		p, ok := n.peers[i.Name]
+		if !ok {
+			// A heartbeat may be left over from a previous run, the peer is
+			// silent once it did not change for the failover timeout
+			n.peers[i.Name] = &peer{instance: i, seen: now}
+			continue
+		}
+
+		if !i.Heartbeat.Equal(p.instance.Heartbeat) || i.State != p.instance.State {
+			p.seen = now
+		}
+		p.instance = i
+	}
+}
+
+// alive returns whether the peer wrote a heartbeat within the failover
+// timeout and is not stopped
+func (n *Node) alive(p *peer, now time.Time) bool {
+	return p.instance.State != model.HAStateStopped && now.Sub(p.seen) < n.config.FailoverTimeout
+}
+
+// failover starts answering DHCP when no primary is alive and stops when one
+// is back. A secondary starting without a primary waits for the failover
+// timeout first
+func (n *Node) failover(now time.Time) {
+	primaries := make([]string, 0)
+	for name, p := range n.peers {
+		if p.instance.Role == model.HARolePrimary && n.alive(p, now) {
+			primaries = append(primaries, name)
+		}
+	}
+
+	switch {
+	case len(primaries) == 0 && !n.serving:
+		// Without any heartbeat yet the primary may be starting too
+		if len(n.peers) == 0 && now.Sub(n.started) < n.config.FailoverTimeout {
+			return
+		}
+		n.serving = true
+		n.changed = now
+		transitionsTotal.WithLabelValues("failover").Inc()
+		n.log().Warnf("Primary silent for %s or stopped, answering DHCP", n.config.FailoverTimeout)
+		n.event(model.SeverityWarning, fmt.Sprintf("Failover: secondary %s answering DHCP, primary silent or stopped", n.config.Name))
+	case len(primaries) > 0 && n.serving:
+		n.serving = false
+		n.changed = now
+		transitionsTotal.WithLabelValues("failback").Inc()
+		n.log().WithField("primary", primaries[0]).Info("Primary is back, no longer answering DHCP")
+		n.event(model.SeverityInfo, fmt.Sprintf("Failback: primary %s answering DHCP again, secondary %s on standby", primaries[0], n.config.Name))
+	}
+}
+
+// checkOverlap logs when other instances answer DHCP along with this one.
+// Both keep answering, DHCP replies are logged with the instance name to find
+// the clients answered by both
+func (n *Node) checkOverlap(now time.Time) {
+	overlap := make([]string, 0)
+	if n.serving {
+		for name, p := range n.peers {
+			if p.instance.State == model.HAStateServing && n.alive(p, now) {
+				overlap = append(overlap, name)
+			}
+		}
+	}
+
+	switch {
+	case len(overlap) > 0 && len(n.overlap) == 0:
+		n.log().WithField("peers", overlap).Warn("Split brain: other instances answer DHCP too, DHCP replies are logged with ha_instance until resolved")
+		n.event(model.SeverityWarning, fmt.Sprintf("Split brain: %s and %v answer DHCP", n.config.Name, overlap))
+	case len(overlap) == 0 && len(n.overlap) > 0:
+		n.log().Info("Split brain resolved, no other instance answers DHCP")
+	}
+	n.overlap = overlap
+}
+
+func (n *Node) instance(now time.Time, state string) *model.HAInstance {
+	return &model.HAInstance{
+		Name:      n.config.Name,
+		Role:      n.config.Role,
+		State:     state,
+		Heartbeat: now,
+		Changed:   n.changed,
+	}
+}
+
+// stop records the instance as stopped
+func (n *Node) stop() {
+	n.mu.Lock()
+	n.serving = false
+	n.changed = time.Now()
+	self := n.instance(n.changed, model.HAStateStopped)
+	n.mu.Unlock()
+
+	if err := n.db.StoreHAInstance(self); err != nil {
+		n.log().WithField("err", err).Warn("Failed to record instance as stopped")
+	}
+}
+
+func (n *Node) setGauges() {
+	servingGauge.Set(boolGauge(n.serving))
+	overlapGauge.Set(boolGauge(len(n.overlap) > 0))
+}
+
+func (n *Node) event(severity model.Severity, msg string) {
+	eventstore.Default.StoreEvents(model.Event{
+		Severity: severity.String(),
+		User:     "ha",
+		Time:     time.Now().UTC(),
+		Message:  msg,
+	})
+}
+
+func boolGauge(b bool) float64 {
+	if b {
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,145 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package ha
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/ubccr/grendel/internal/store/sqlstore"
+	"github.com/ubccr/grendel/pkg/model"
+)
+
+func newTestNode(t *testing.T, db *sqlstore.SqlStore, name, role string) *Node {
+	n, err := New(db, Config{
+		Name:              name,
+		Role:              role,
+		HeartbeatInterval: time.Second,
+		FailoverTimeout:   3 * time.Second,
+	})
+	require.NoError(t, err)
+
+	return n
+}
+
+func TestFailover(t *testing.T) {
+	db, err := sqlstore.New(":memory:")
+	require.NoError(t, err)
+	defer db.Close()
+
+	primary := newTestNode(t, db, "grendel-a", model.HARolePrimary)
+	secondary := newTestNode(t, db, "grendel-b", model.HARoleSecondary)
+	now := time.Now()
+	at := func(s int) time.Time { return now.Add(time.Duration(s) * time.Second) }
+
+	secondary.check(at(0))
+	primary.check(at(0))
+	assert.True(t, primary.Serving())
+	assert.False(t, secondary.Serving())
+
+	secondary.check(at(1))
+	assert.False(t, secondary.Serving())
+
+	// The primary is silent, the secondary takes over after the timeout
+	secondary.check(at(2))
+	assert.False(t, secondary.Serving())
+	secondary.check(at(5))
+	assert.True(t, secondary.Serving())
+
+	// Both answer DHCP until the secondary sees the primary is back
+	primary.check(at(6))
+	assert.True(t, primary.Overlap())
+	assert.Equal(t, "grendel-a", primary.Fields()[FieldInstance])
+
+	secondary.check(at(7))
+	assert.False(t, secondary.Serving())
+
+	primary.check(at(8))
+	assert.False(t, primary.Overlap())
+
+	// A stopped primary is replaced right away
+	primary.stop()
+	secondary.check(at(9))
+	assert.True(t, secondary.Serving())
+
+	instances, err := db.HAInstances()
+	require.NoError(t, err)
+	require.Len(t, instances, 2)
+	assert.Equal(t, model.HAStateStopped, instances[0].State)
+	assert.Equal(t, model.HAStateServing, instances[1].State)
+}
+
+func TestSecondaryAlone(t *testing.T) {
+	db, err := sqlstore.New(":memory:")
+	require.NoError(t, err)
+	defer db.Close()
+
+	secondary := newTestNode(t, db, "grendel-b", model.HARoleSecondary)
+	now := time.Now()
+
+	// Without a primary heartbeat the secondary waits for the timeout
+	secondary.check(now)
+	assert.False(t, secondary.Serving())
+	secondary.check(now.Add(4 * time.Second))
+	assert.True(t, secondary.Serving())
+
+	_, err = New(db, Config{Name: "grendel-c", Role: "tertiary"})
+	assert.ErrorContains(t, err, `invalid ha.role "tertiary"`)
+
+	// A nil node always answers
+	var n *Node
+	assert.True(t, n.Serving())
+	assert.False(t, n.Overlap())
+}
+
+func TestSharedDatabaseFile(t *testing.T) {
+	filename := filepath.Join(t.TempDir(), "grendel.db")
+
+	// Each instance opens the database with the lock of grendel serve
+	open := func() *sqlstore.SqlStore {
+		lock, err := sqlstore.LockShared(filename)
+		require.NoError(t, err)
+		t.Cleanup(func() { lock.Unlock() })
+		db, err := sqlstore.New(filename)
+		require.NoError(t, err)
+		t.Cleanup(func() { db.Close() })
+		return db
+	}
+
+	primary := newTestNode(t, open(), "grendel-a", model.HARolePrimary)
+	secondary := newTestNode(t, open(), "grendel-b", model.HARoleSecondary)
+	now := time.Now()
+	at := func(s int) time.Time { return now.Add(time.Duration(s) * time.Second) }
+
+	primary.check(at(0))
+	secondary.check(at(0))
+	assert.True(t, primary.Serving())
+	assert.False(t, secondary.Serving())
+
+	// The heartbeats of the primary are seen through the other connection
+	primary.check(at(2))
+	secondary.check(at(2))
+	primary.check(at(4))
+	secondary.check(at(4))
+	assert.False(t, secondary.Serving())
+
+	// The primary is silent
+	secondary.check(at(8))
+	assert.True(t, secondary.Serving())
+
+	primary.check(at(9))
+	secondary.check(at(10))
+	assert.False(t, secondary.Serving())
+
+	primary.stop()
+	secondary.check(at(11))
+	assert.True(t, secondary.Serving())
+
+	_, err := sqlstore.Lock(filename)
+	assert.ErrorIs(t, err, sqlstore.ErrLocked)
+}
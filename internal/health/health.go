@@ -0,0 +1,228 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+// Package health reports the liveness and readiness of grendel serve to load
+// balancers and monitoring. Services register a check once their sockets are
+// bound, readiness fails while any expected check is missing or failing
+package health
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/ubccr/grendel/internal/logger"
+)
+
+const (
+	StatusOK   = "ok"
+	StatusFail = "fail"
+
+	// CheckTimeout bounds the time a check may take, a check still running
+	// when it expires fails
+	CheckTimeout = 2 * time.Second
+)
+
+var (
+	log = logger.GetLogger("HEALTH")
+
+	readyGauge = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "grendel_ready",
+		Help: "1 when the last readiness check of a service passed",
+	}, []string{"service"})
+
+	// Default holds the checks of the services run by grendel serve
+	Default = NewRegistry()
+)
+
+func init() {
+	prometheus.MustRegister(readyGauge)
+}
+
+// Check returns nil when a service is ready to serve requests
+type Check func(ctx context.Context) error
+
+// Result is the outcome of the check of a service
+type Result struct {
+	Status string `json:"status"`
+	Error  string `json:"error,omitempty"`
+}
+
+// Report is the readiness of grendel serve and of each of its services
+type Report struct {
+	Status   string             `json:"status"`
+	Services map[string]*Result `json:"services,omitempty"`
+}
+
+// Registry holds the checks run for readiness
+type Registry struct {
+	mu     sync.RWMutex
+	checks map[string]Check
+}
+
+func NewRegistry() *Registry {
+	return &Registry{checks: make(map[string]Check)}
+}
+
+// Register sets the check of a service, replacing any previous check
+func (r *Registry) Register(name string, check Check) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.checks[name] = check
+}
+
+// Expect marks services as not ready until their checks are registered
+func (r *Registry) Expect(names ...string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, name := range names {
+		if _, ok := r.checks[name]; !ok {
+			r.checks[name] = notStarted
+		}
+	}
+}
+
+// Check runs every check concurrently and returns the report. The report
+// fails when any check fails or no check is registered
+func (r *Registry) Check(ctx context.Context) *Report {
+	r.mu.RLock()
+	names := make([]string, 0, len(r.checks))
+	checks := make([]Check, 0, len(r.checks))
+	for name, check := range r.checks {
+		names = append(names, name)
+		checks = append(checks, check)
+	}
+	r.mu.RUnlock()
+
+	report := &Report{Status: StatusOK, Services: make(map[string]*Result, len(names))}
+	if len(names) == 0 {
+		report.Status = StatusFail
+		return report
+	}
+
+	results := make([]*Result, len(names))
+	var wg sync.WaitGroup
+	for i := range checks {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			results[i] = run(ctx, checks[i])
+		}()
+	}
+	wg.Wait()
+
+	for i, name := range names {
+		report.Services[name] = results[i]
+		if results[i].Status != StatusOK {
+			report.Status = StatusFail
+			readyGauge.WithLabelValues(name).Set(0)
+			continue
+		}
+		readyGauge.WithLabelValues(name).Set(1)
+	}
+
+	return report
+}
+
+// run runs a check with CheckTimeout. A check ignoring its context is left
+// running and reported as timed out
+func run(ctx context.Context, check Check) *Result {
+	ctx, cancel := context.WithTimeout(ctx, CheckTimeout)
+	defer cancel()
+
+	done := make(chan error, 1)
+	go func() {
+		done <- check(ctx)
+	}()
+
+	var err error
+	select {
+	case err = <-done:
+	case <-ctx.Done():
+		err = ctx.Err()
+	}
+
+	if err != nil {
+		return &Result{Status: StatusFail, Error: err.Error()}
+	}
+
+	return &Result{Status: StatusOK}
+}
+
+// Ready serves the readiness report, with status 200 when every service is
+// ready and 503 otherwise
+func (r *Registry) Ready(w http.ResponseWriter, req *http.Request) {
+	report := r.Check(req.Context())
+
+	status := http.StatusOK
+	if report.Status != StatusOK {
+		status = http.StatusServiceUnavailable
+		for name, result := range report.Services {
+			if result.Status != StatusOK {
+				log.WithField("service", name).Debugf("Not ready: %s", result.Error)
+			}
+		}
+	}
+
+	writeJSON(w, status, report)
+}
+
+// Register sets the check of a service in the default registry
+func Register(name string, check Check) {
+	Default.Register(name, check)
+}
+
+// Expect marks services of the default registry as not ready until their
+// checks are registered
+func Expect(names ...string) {
+	Default.Expect(names...)
+}
+
+// Ready serves the readiness report of the default registry
+func Ready(w http.ResponseWriter, r *http.Request) {
+	Default.Ready(w, r)
+}
+
+// Live reports the process is up. It always answers 200, failing services
+// only affect readiness
+func Live(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, http.StatusOK, &Report{Status: StatusOK})
+}
+
+func writeJSON(w http.ResponseWriter, status int, report *Report) {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+	w.WriteHeader(status)
+	if err := json.NewEncoder(w).Encode(report); err != nil {
+		log.Debugf("Failed writing health report: %s", err)
+	}
+}
+
+func notStarted(ctx context.Context) error {
+	return errors.New("not started")
+}
+
+// SocketOpen returns an error when a listener or packet connection is not
+// bound or has been closed
+func SocketOpen(conn any) error {
+	sc, ok := conn.(syscall.Conn)
+	if conn == nil || !ok {
+		return errors.New("socket not bound")
+	}
+
+	raw, err := sc.SyscallConn()
+	if err != nil {
+		return err
+	}
+
+	// Control fails once the socket is closed
+	return raw.Control(func(uintptr) {})
+}
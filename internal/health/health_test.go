@@ -0,0 +1,86 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package health
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReady(t *testing.T) {
+	r := NewRegistry()
+
+	ready := func() (int, *Report) {
+		rec := httptest.NewRecorder()
+		r.Ready(rec, httptest.NewRequest(http.MethodGet, "/readyz", nil))
+
+		report := &Report{}
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), report))
+		return rec.Code, report
+	}
+
+	code, _ := ready()
+	assert.Equal(t, http.StatusServiceUnavailable, code)
+
+	r.Expect("dns", "dhcp")
+	r.Register("dns", func(ctx context.Context) error { return nil })
+	code, report := ready()
+	assert.Equal(t, http.StatusServiceUnavailable, code)
+	assert.Equal(t, StatusFail, report.Status)
+	assert.Equal(t, &Result{Status: StatusOK}, report.Services["dns"])
+	assert.Equal(t, &Result{Status: StatusFail, Error: "not started"}, report.Services["dhcp"])
+
+	failing := errors.New("no answer")
+	r.Register("dhcp", func(ctx context.Context) error { return failing })
+	code, report = ready()
+	assert.Equal(t, http.StatusServiceUnavailable, code)
+	assert.Equal(t, "no answer", report.Services["dhcp"].Error)
+
+	// A check ignoring its context times out
+	failing = nil
+	hang := make(chan struct{})
+	defer close(hang)
+	r.Register("dhcp", func(ctx context.Context) error { <-hang; return nil })
+	code, report = ready()
+	assert.Equal(t, http.StatusServiceUnavailable, code)
+	assert.Equal(t, context.DeadlineExceeded.Error(), report.Services["dhcp"].Error)
+
+	r.Register("dhcp", func(ctx context.Context) error { return failing })
+	code, report = ready()
+	assert.Equal(t, http.StatusOK, code)
+	assert.Equal(t, StatusOK, report.Status)
+	assert.Len(t, report.Services, 2)
+}
+
+func TestLive(t *testing.T) {
+	rec := httptest.NewRecorder()
+	Live(rec, httptest.NewRequest(http.MethodGet, "/healthz", nil))
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.JSONEq(t, `{"status":"ok"}`, rec.Body.String())
+}
+
+func TestSocketOpen(t *testing.T) {
+	assert.ErrorContains(t, SocketOpen(nil), "socket not bound")
+
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	assert.NoError(t, SocketOpen(l))
+	l.Close()
+	assert.Error(t, SocketOpen(l))
+
+	conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+	require.NoError(t, err)
+	assert.NoError(t, SocketOpen(conn))
+	conn.Close()
+	assert.Error(t, SocketOpen(conn))
+}
@@ -0,0 +1,223 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+// Package imagegc reports the disk space used by boot images and removes the
+// files of the image directories no boot image uses. The provision and TFTP
+// servers record the files they are sending so files are never removed while
+// being served. Transfers are tracked per process, the API must run in the
+// same `grendel serve` as the services serving images
+package imagegc
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+	"syscall"
+
+	"github.com/ubccr/grendel/internal/logger"
+	"github.com/ubccr/grendel/pkg/model"
+)
+
+var (
+	log = logger.GetLogger("IMAGEGC")
+
+	// ErrNoDirs is returned by Collect when no image directory is configured
+	ErrNoDirs = errors.New("no image directories configured, set image_dirs")
+
+	// ErrInFlight is returned by Collect when files are being served
+	ErrInFlight = errors.New("files are being served")
+
+	mu       sync.Mutex
+	inflight = make(map[string]int)
+)
+
+// Serve records that the file at path is being sent to a client until done
+// is called. Collect refuses to remove files while any file is being sent
+func Serve(path string) (done func()) {
+	path = filepath.Clean(path)
+
+	mu.Lock()
+	inflight[path]++
+	mu.Unlock()
+
+	return func() {
+		mu.Lock()
+		defer mu.Unlock()
+
+		if inflight[path]--; inflight[path] <= 0 {
+			delete(inflight, path)
+		}
+	}
+}
+
+// InFlight returns the files being sent to clients
+func InFlight() []string {
+	mu.Lock()
+	defer mu.Unlock()
+
+	return inFlight()
+}
+
+func inFlight() []string {
+	paths := make([]string, 0, len(inflight))
+	for path := range inflight {
+		paths = append(paths, path)
+	}
+	slices.Sort(paths)
+
+	return paths
+}
+
+// fileID identifies a file by device and inode so hard links and symlinks to
+// the same file are counted once
+type fileID struct {
+	dev uint64
+	ino uint64
+}
+
+func idOf(fi fs.FileInfo) (fileID, bool) {
+	st, ok := fi.Sys().(*syscall.Stat_t)
+	if !ok {
+		return fileID{}, false
+	}
+
+	return fileID{dev: uint64(st.Dev), ino: uint64(st.Ino)}, true
+}
+
+// imageFiles returns the paths of the files of a boot image. Signatures are
+// optional and only listed when they exist
+func imageFiles(paths ...string) []string {
+	files := make([]string, 0, len(paths)*2)
+	for _, p := range paths {
+		if p == "" {
+			continue
+		}
+		files = append(files, p)
+		if _, err := os.Stat(p + ".sig"); err == nil {
+			files = append(files, p+".sig")
+		}
+	}
+
+	return files
+}
+
+// Collect lists the files in dirs which are not in referenced, the kernel and
+// initrd paths of the boot images, nor their signatures. Files are matched by
+// path and by device and inode, a symlink or hard link to a referenced file is
+// kept. Symlinks are not followed out of dirs and nothing outside dirs is
+// touched. When remove is set the files are deleted, refusing with
+// ErrInFlight while files are being served. New transfers wait until the
+// files are deleted
+func Collect(dirs, referenced []string, remove bool) (*model.ImageGCResult, error) {
+	if len(dirs) == 0 {
+		return nil, ErrNoDirs
+	}
+
+	roots := make([]string, 0, len(dirs))
+	for _, dir := range dirs {
+		if !filepath.IsAbs(dir) {
+			return nil, fmt.Errorf("image directory %q is not an absolute path", dir)
+		}
+		dir = filepath.Clean(dir)
+		if dir == "/" {
+			return nil, fmt.Errorf("image directory %q is the root directory", dir)
+		}
+		fi, err := os.Stat(dir)
+		if err != nil {
+			return nil, err
+		}
+		if !fi.IsDir() {
+			return nil, fmt.Errorf("image directory %q is not a directory", dir)
+		}
+		roots = append(roots, dir)
+	}
+
+	keepPaths := make(map[string]bool)
+	keepIDs := make(map[fileID]bool)
+	for _, p := range referenced {
+		for _, f := range []string{p, p + ".sig"} {
+			f = filepath.Clean(f)
+			keepPaths[f] = true
+			if fi, err := os.Stat(f); err == nil {
+				if id, ok := idOf(fi); ok {
+					keepIDs[id] = true
+				}
+			}
+		}
+	}
+
+	if remove {
+		mu.Lock()
+		defer mu.Unlock()
+
+		if paths := inFlight(); len(paths) > 0 {
+			return nil, fmt.Errorf("%w: %s", ErrInFlight, strings.Join(paths, ", "))
+		}
+	}
+
+	res := &model.ImageGCResult{
+		Dirs:  roots,
+		Files: make([]*model.ImageGCFile, 0),
+	}
+	seen := make(map[string]bool)
+	for _, root := range roots {
+		err := filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+			if err != nil {
+				return err
+			}
+			if d.IsDir() || seen[path] || keepPaths[path] {
+				return nil
+			}
+			seen[path] = true
+
+			// Only regular files and symlinks are collected
+			if !d.Type().IsRegular() && d.Type()&fs.ModeSymlink == 0 {
+				return nil
+			}
+
+			if rel, err := filepath.Rel(root, path); err != nil || rel == "." || strings.HasPrefix(rel, "..") {
+				return nil
+			}
+
+			if fi, err := os.Stat(path); err == nil {
+				if id, ok := idOf(fi); ok && keepIDs[id] {
+					return nil
+				}
+			}
+
+			fi, err := d.Info()
+			if err != nil {
+				return err
+			}
+			res.Files = append(res.Files, &model.ImageGCFile{Path: path, Size: fi.Size()})
+			res.Size += fi.Size()
+
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if !remove {
+		return res, nil
+	}
+
+	var errs []error
+	for _, f := range res.Files {
+		if err := os.Remove(f.Path); err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		log.Infof("Removed unused image file %s", f.Path)
+	}
+	res.Deleted = len(errs) == 0
+
+	return res, errors.Join(errs...)
+}
@@ -0,0 +1,113 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package imagegc
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/ubccr/grendel/pkg/model"
+)
+
+func writeFile(t *testing.T, path string, size int) string {
+	require.NoError(t, os.MkdirAll(filepath.Dir(path), 0755))
+	require.NoError(t, os.WriteFile(path, make([]byte, size), 0644))
+	return path
+}
+
+func collected(res *model.ImageGCResult) []string {
+	paths := make([]string, 0, len(res.Files))
+	for _, f := range res.Files {
+		paths = append(paths, f.Path)
+	}
+	return paths
+}
+
+func TestCollect(t *testing.T) {
+	root := t.TempDir()
+	dir := filepath.Join(root, "images")
+	outside := writeFile(t, filepath.Join(root, "outside", "vmlinuz"), 10)
+
+	kernel := writeFile(t, filepath.Join(dir, "rocky9", "vmlinuz"), 10)
+	writeFile(t, kernel+".sig", 1)
+	initrd := writeFile(t, filepath.Join(dir, "rocky9", "initrd.img"), 20)
+	old := writeFile(t, filepath.Join(dir, "rocky8", "vmlinuz"), 30)
+	stale := writeFile(t, filepath.Join(dir, "rocky8", "initrd.img"), 40)
+	require.NoError(t, os.Symlink(outside, filepath.Join(dir, "outside-link")))
+	require.NoError(t, os.Symlink(filepath.Join(root, "outside"), filepath.Join(dir, "outside-dir")))
+	require.NoError(t, os.Symlink(initrd, filepath.Join(dir, "current")))
+
+	referenced := []string{kernel, initrd}
+	res, err := Collect([]string{dir}, referenced, false)
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{old, stale, filepath.Join(dir, "outside-link"), filepath.Join(dir, "outside-dir")}, collected(res))
+	assert.False(t, res.Deleted)
+	assert.FileExists(t, old)
+
+	// A transfer in progress refuses the removal
+	done := Serve(kernel)
+	_, err = Collect([]string{dir}, referenced, true)
+	assert.ErrorIs(t, err, ErrInFlight)
+	assert.Equal(t, []string{kernel}, InFlight())
+	done()
+	assert.Empty(t, InFlight())
+
+	res, err = Collect([]string{dir}, referenced, true)
+	require.NoError(t, err)
+	assert.True(t, res.Deleted)
+	size := int64(0)
+	for _, f := range res.Files {
+		size += f.Size
+	}
+	assert.Equal(t, size, res.Size)
+	assert.NoFileExists(t, old)
+	assert.NoFileExists(t, stale)
+	assert.FileExists(t, kernel)
+	assert.FileExists(t, kernel+".sig")
+	assert.FileExists(t, initrd)
+	assert.FileExists(t, filepath.Join(dir, "current"))
+
+	// Symlinks are removed, never what they point to
+	assert.FileExists(t, outside)
+	assert.DirExists(t, filepath.Join(root, "outside"))
+
+	_, err = Collect(nil, referenced, false)
+	assert.ErrorIs(t, err, ErrNoDirs)
+	_, err = Collect([]string{"images"}, referenced, false)
+	assert.Error(t, err)
+	_, err = Collect([]string{"/"}, referenced, false)
+	assert.Error(t, err)
+}
+
+func TestUsage(t *testing.T) {
+	dir := t.TempDir()
+	kernel := writeFile(t, filepath.Join(dir, "vmlinuz"), 100)
+	writeFile(t, kernel+".sig", 1)
+	initrd := writeFile(t, filepath.Join(dir, "initrd.img"), 200)
+	other := writeFile(t, filepath.Join(dir, "initrd-gpu.img"), 400)
+	require.NoError(t, os.Symlink(kernel, filepath.Join(dir, "vmlinuz-link")))
+
+	du := Usage(model.BootImageList{
+		{Name: "compute", KernelPath: kernel, InitrdPaths: []string{initrd, initrd}},
+		{Name: "gpu", KernelPath: filepath.Join(dir, "vmlinuz-link"), InitrdPaths: []string{other, filepath.Join(dir, "missing.img")}},
+	})
+
+	require.Len(t, du.Images, 2)
+	compute, gpu := du.Images[0], du.Images[1]
+	assert.Equal(t, 3, compute.Files)
+	assert.EqualValues(t, 301, compute.Size)
+	assert.EqualValues(t, 100, compute.Shared)
+	assert.Empty(t, compute.Missing)
+
+	assert.Equal(t, 2, gpu.Files)
+	assert.EqualValues(t, 500, gpu.Size)
+	assert.EqualValues(t, 100, gpu.Shared)
+	assert.Equal(t, []string{filepath.Join(dir, "missing.img")}, gpu.Missing)
+
+	assert.EqualValues(t, 701, du.Total)
+}
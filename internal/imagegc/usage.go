@@ -0,0 +1,66 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package imagegc
+
+import (
+	"os"
+
+	"github.com/ubccr/grendel/pkg/model"
+)
+
+// Usage returns the disk space used by the kernel, initrds, live image and
+// signatures of each boot image. Files are followed through symlinks and
+// counted once per image, and once in the total when shared by images
+func Usage(images model.BootImageList) *model.ImageDiskUsage {
+	type file struct {
+		key  any
+		size int64
+	}
+
+	users := make(map[any]int)
+	perImage := make([][]file, len(images))
+	du := &model.ImageDiskUsage{Images: make(model.ImageUsageList, 0, len(images))}
+	for i, image := range images {
+		usage := &model.ImageUsage{Name: image.Name, Missing: make([]string, 0)}
+		du.Images = append(du.Images, usage)
+
+		seen := make(map[any]bool)
+		for _, path := range imageFiles(image.Files()...) {
+			fi, err := os.Stat(path)
+			if err != nil {
+				usage.Missing = append(usage.Missing, path)
+				continue
+			}
+
+			var key any = path
+			if id, ok := idOf(fi); ok {
+				key = id
+			}
+			if seen[key] {
+				continue
+			}
+			seen[key] = true
+			users[key]++
+			perImage[i] = append(perImage[i], file{key: key, size: fi.Size()})
+		}
+	}
+
+	counted := make(map[any]bool)
+	for i, usage := range du.Images {
+		for _, f := range perImage[i] {
+			usage.Files++
+			usage.Size += f.size
+			if users[f.key] > 1 {
+				usage.Shared += f.size
+			}
+			if !counted[f.key] {
+				counted[f.key] = true
+				du.Total += f.size
+			}
+		}
+	}
+
+	return du
+}
@@ -0,0 +1,270 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+// Package ipam hands out the free addresses of a subnet to new hosts. An
+// address is used when it is assigned to a host interface, bond or secondary
+// address, a host in the trash or a DNS only record, when it is the network,
+// broadcast or gateway address of the subnet, or when it is held by an
+// unexpired reservation: addresses reserved by an operator about to add
+// hosts and addresses declined over DHCP as in use on the network.
+package ipam
+
+import (
+	"errors"
+	"fmt"
+	"net/netip"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ubccr/grendel/internal/config"
+	"github.com/ubccr/grendel/internal/store"
+	"github.com/ubccr/grendel/pkg/model"
+)
+
+const (
+	// DefaultReserve is how long addresses are reserved when no period is
+	// given
+	DefaultReserve = time.Hour
+
+	// ConflictHold is how long an address declined over DHCP is not handed
+	// out
+	ConflictHold = 24 * time.Hour
+
+	// MaxCount is the most addresses returned at once
+	MaxCount = 4096
+
+	// AutoPrefix is the prefix of an interface address allocated from the
+	// subnet after it, as in auto:10.64.8.0/22
+	AutoPrefix = "auto:"
+)
+
+// ErrExhausted is returned when a subnet has fewer free addresses than
+// requested
+var ErrExhausted = errors.New("not enough free addresses")
+
+// mu serializes the allocations of this instance, ReserveIPs those of
+// instances sharing the data store
+var mu sync.Mutex
+
+// Used returns the addresses of prefix used at now, with what uses them
+func Used(db store.Store, prefix netip.Prefix, now time.Time) (map[netip.Addr]string, error) {
+	prefix = prefix.Masked()
+	used := make(map[netip.Addr]string)
+	add := func(addr netip.Addr, by string) {
+		addr = addr.Unmap()
+		if _, ok := used[addr]; !ok && prefix.Contains(addr) {
+			used[addr] = by
+		}
+	}
+
+	hosts, err := db.Hosts()
+	if err != nil {
+		return nil, err
+	}
+	for _, host := range hosts {
+		addHost(host, "host "+host.Name, add)
+	}
+
+	trash, err := db.TrashedHosts()
+	if err != nil {
+		return nil, err
+	}
+	for _, t := range trash {
+		if t.Host == nil {
+			continue
+		}
+		addHost(t.Host, "host "+t.Host.Name+" in the trash", add)
+	}
+
+	records, err := db.DNSRecords()
+	if err != nil {
+		return nil, err
+	}
+	for _, r := range records {
+		if r.Type != model.RecordTypeA {
+			continue
+		}
+		if addr, err := netip.ParseAddr(r.Value); err == nil {
+			add(addr, "DNS record "+r.Name)
+		}
+	}
+
+	reservations, err := db.IPReservations()
+	if err != nil {
+		return nil, err
+	}
+	for _, r := range reservations {
+		if r.Expired(now) {
+			continue
+		}
+		addr, err := netip.ParseAddr(r.IP)
+		if err != nil {
+			continue
+		}
+		if r.Kind == model.IPReservationConflict {
+			add(addr, fmt.Sprintf("DHCP conflict of host %s until %s", r.Host, r.ExpiresAt.Format(time.RFC3339)))
+		} else if r.User != "" {
+			add(addr, fmt.Sprintf("reserved by %s until %s", r.User, r.ExpiresAt.Format(time.RFC3339)))
+		} else {
+			add(addr, fmt.Sprintf("reserved until %s", r.ExpiresAt.Format(time.RFC3339)))
+		}
+	}
+
+	for _, s := range config.Current().Subnets {
+		add(s.Gateway.Addr(), "gateway")
+	}
+	if gw := config.Current().DefaultGateway; gw.IsValid() {
+		add(gw, "gateway")
+	}
+
+	// The subnet-router anycast address of IPv6 subnets is the network
+	// address as well
+	add(prefix.Addr(), "network address")
+	if prefix.Addr().Is4() && prefix.Bits() < 31 {
+		add(lastAddr(prefix), "broadcast address")
+	}
+
+	return used, nil
+}
+
+// addHost adds the addresses of the interfaces, bonds and secondary addresses
+// of host
+func addHost(host *model.Host, by string, add func(netip.Addr, string)) {
+	nics := make([]*model.NetInterface, 0, len(host.Interfaces)+len(host.Bonds))
+	nics = append(nics, host.Interfaces...)
+	for _, b := range host.Bonds {
+		nics = append(nics, &b.NetInterface)
+	}
+
+	for _, nic := range nics {
+		if nic.IP.IsValid() {
+			add(nic.IP.Addr(), by)
+		}
+		for _, a := range nic.Addresses {
+			if a.IP.IsValid() {
+				add(a.IP.Addr(), by)
+			}
+		}
+	}
+}
+
+// lastAddr returns the last address of prefix
+func lastAddr(prefix netip.Prefix) netip.Addr {
+	b := prefix.Masked().Addr().As4()
+	host := uint32(1)<<(32-prefix.Bits()) - 1
+	b[0] |= byte(host >> 24)
+	b[1] |= byte(host >> 16)
+	b[2] |= byte(host >> 8)
+	b[3] |= byte(host)
+
+	return netip.AddrFrom4(b)
+}
+
+// Next returns the count lowest free addresses of prefix. With reserve above
+// zero the addresses are reserved for user until reserve passed, so other
+// allocations skip them until they are assigned to hosts
+func Next(db store.Store, prefix netip.Prefix, count int, reserve time.Duration, user string) ([]netip.Addr, error) {
+	if !prefix.IsValid() {
+		return nil, errors.New("invalid subnet")
+	}
+	if count < 1 || count > MaxCount {
+		return nil, fmt.Errorf("count must be between 1 and %d", MaxCount)
+	}
+	prefix = prefix.Masked()
+
+	mu.Lock()
+	defer mu.Unlock()
+
+	// Another instance may reserve the same addresses between the scan and
+	// the reservation, the scan is retried
+	for attempt := 0; ; attempt++ {
+		now := time.Now()
+		used, err := Used(db, prefix, now)
+		if err != nil {
+			return nil, err
+		}
+
+		free := make([]netip.Addr, 0, count)
+		for addr := prefix.Addr(); prefix.Contains(addr) && len(free) < count; addr = addr.Next() {
+			if _, ok := used[addr]; !ok {
+				free = append(free, addr)
+			}
+		}
+		if len(free) < count {
+			return nil, fmt.Errorf("%w: subnet %s has %d free address(es), %d requested", ErrExhausted, prefix, len(free), count)
+		}
+		if reserve <= 0 {
+			return free, nil
+		}
+
+		reservations := make(model.IPReservationList, 0, len(free))
+		for _, addr := range free {
+			reservations = append(reservations, &model.IPReservation{
+				IP:        addr.String(),
+				Kind:      model.IPReservationReserved,
+				User:      user,
+				CreatedAt: now,
+				ExpiresAt: now.Add(reserve),
+			})
+		}
+
+		err = db.ReserveIPs(reservations)
+		if err == nil {
+			return free, nil
+		}
+		if !errors.Is(err, store.ErrConflict) || attempt == 2 {
+			return nil, err
+		}
+	}
+}
+
+// Conflict holds addr, declined by host over DHCP, for ConflictHold
+func Conflict(db store.Store, host string, addr netip.Addr) error {
+	now := time.Now()
+	return db.StoreIPReservations(model.IPReservationList{{
+		IP:        addr.Unmap().String(),
+		Kind:      model.IPReservationConflict,
+		Host:      host,
+		CreatedAt: now,
+		ExpiresAt: now.Add(ConflictHold),
+	}})
+}
+
+// Unused returns the reservations made by Next which expired at now without
+// their address being assigned to one of hosts
+func Unused(reservations model.IPReservationList, hosts model.HostList, now time.Time) model.IPReservationList {
+	assigned := make(map[netip.Addr]bool)
+	for _, host := range hosts {
+		addHost(host, "", func(addr netip.Addr, _ string) { assigned[addr.Unmap()] = true })
+	}
+
+	unused := make(model.IPReservationList, 0)
+	for _, r := range reservations {
+		if r.Kind != model.IPReservationReserved || !r.Expired(now) {
+			continue
+		}
+		if addr, err := netip.ParseAddr(r.IP); err == nil && assigned[addr] {
+			continue
+		}
+		unused = append(unused, r)
+	}
+
+	return unused
+}
+
+// ParseAuto returns the subnet of an auto:<subnet> address
+func ParseAuto(ip string) (netip.Prefix, bool, error) {
+	s, ok := strings.CutPrefix(ip, AutoPrefix)
+	if !ok {
+		return netip.Prefix{}, false, nil
+	}
+
+	prefix, err := netip.ParsePrefix(s)
+	if err != nil {
+		return netip.Prefix{}, true, fmt.Errorf("invalid subnet of %s: expected a CIDR such as auto:10.64.8.0/22", ip)
+	}
+
+	return prefix.Masked(), true, nil
+}
@@ -0,0 +1,130 @@
+package ipam
+
+import (
+	"net/netip"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/ubccr/grendel/internal/config"
+	"github.com/ubccr/grendel/internal/store/sqlstore"
+	"github.com/ubccr/grendel/pkg/model"
+)
+
+func addrs(list ...string) []netip.Addr {
+	out := make([]netip.Addr, 0, len(list))
+	for _, a := range list {
+		out = append(out, netip.MustParseAddr(a))
+	}
+	return out
+}
+
+func TestNext(t *testing.T) {
+	db, err := sqlstore.New(":memory:")
+	require.NoError(t, err)
+
+	cfg := *config.Current()
+	cfg.Subnets = []config.Subnet{{Gateway: netip.MustParsePrefix("10.64.8.1/22")}}
+	defer config.Set(config.Set(&cfg))
+
+	require.NoError(t, db.StoreHost(&model.Host{
+		Name: "cpn-01",
+		Interfaces: []*model.NetInterface{
+			{FQDN: "cpn-01.example.local", IP: netip.MustParsePrefix("10.64.8.2/22")},
+			{FQDN: "bmc-cpn-01.example.local", IP: netip.MustParsePrefix("10.64.8.4/22"), BMC: true},
+		},
+	}))
+	require.NoError(t, db.StoreDNSRecords(model.RecordList{
+		{Name: "vip.example.local", Type: model.RecordTypeA, Value: "10.64.8.5"},
+	}))
+	require.NoError(t, Conflict(db, "cpn-02", netip.MustParseAddr("10.64.8.6")))
+
+	prefix := netip.MustParsePrefix("10.64.8.0/22")
+	ips, err := Next(db, prefix, 3, 0, "")
+	require.NoError(t, err)
+	assert.Equal(t, addrs("10.64.8.3", "10.64.8.7", "10.64.8.8"), ips)
+
+	// Without a reservation the same addresses are returned again
+	ips, err = Next(db, prefix, 2, time.Hour, "alice")
+	require.NoError(t, err)
+	assert.Equal(t, addrs("10.64.8.3", "10.64.8.7"), ips)
+
+	ips, err = Next(db, prefix, 2, time.Hour, "bob")
+	require.NoError(t, err)
+	assert.Equal(t, addrs("10.64.8.8", "10.64.8.9"), ips)
+
+	used, err := Used(db, prefix, time.Now())
+	require.NoError(t, err)
+	assert.Equal(t, "gateway", used[netip.MustParseAddr("10.64.8.1")])
+	assert.Equal(t, "host cpn-01", used[netip.MustParseAddr("10.64.8.4")])
+	assert.Equal(t, "broadcast address", used[netip.MustParseAddr("10.64.11.255")])
+	assert.Contains(t, used[netip.MustParseAddr("10.64.8.3")], "reserved by alice")
+
+	// Reservations no longer hold addresses once expired
+	used, err = Used(db, prefix, time.Now().Add(2*time.Hour))
+	require.NoError(t, err)
+	assert.NotContains(t, used, netip.MustParseAddr("10.64.8.3"))
+	assert.Contains(t, used, netip.MustParseAddr("10.64.8.6"))
+
+	_, err = Next(db, netip.MustParsePrefix("10.64.12.0/30"), 3, 0, "")
+	assert.ErrorIs(t, err, ErrExhausted)
+
+	_, err = Next(db, prefix, 0, 0, "")
+	assert.Error(t, err)
+}
+
+func TestReserveConflict(t *testing.T) {
+	db, err := sqlstore.New(":memory:")
+	require.NoError(t, err)
+
+	now := time.Now()
+	reserve := func(ip string, expires time.Time) error {
+		return db.ReserveIPs(model.IPReservationList{{
+			IP:        ip,
+			Kind:      model.IPReservationReserved,
+			User:      "alice",
+			CreatedAt: now,
+			ExpiresAt: expires,
+		}})
+	}
+
+	require.NoError(t, reserve("10.0.0.1", now.Add(time.Hour)))
+	assert.Error(t, reserve("10.0.0.1", now.Add(time.Hour)))
+
+	require.NoError(t, reserve("10.0.0.2", now))
+	assert.NoError(t, reserve("10.0.0.2", now.Add(time.Hour)))
+}
+
+func TestUnused(t *testing.T) {
+	now := time.Now()
+	reservations := model.IPReservationList{
+		{IP: "10.0.0.1", Kind: model.IPReservationReserved, ExpiresAt: now.Add(-time.Minute)},
+		{IP: "10.0.0.2", Kind: model.IPReservationReserved, ExpiresAt: now.Add(-time.Minute)},
+		{IP: "10.0.0.3", Kind: model.IPReservationReserved, ExpiresAt: now.Add(time.Minute)},
+		{IP: "10.0.0.4", Kind: model.IPReservationConflict, ExpiresAt: now.Add(-time.Minute)},
+	}
+	hosts := model.HostList{
+		{Name: "cpn-01", Interfaces: []*model.NetInterface{{IP: netip.MustParsePrefix("10.0.0.2/24")}}},
+	}
+
+	unused := Unused(reservations, hosts, now)
+	if assert.Len(t, unused, 1) {
+		assert.Equal(t, "10.0.0.1", unused[0].IP)
+	}
+}
+
+func TestParseAuto(t *testing.T) {
+	prefix, ok, err := ParseAuto("auto:10.64.9.7/22")
+	assert.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, netip.MustParsePrefix("10.64.8.0/22"), prefix)
+
+	_, ok, err = ParseAuto("10.64.9.7/22")
+	assert.NoError(t, err)
+	assert.False(t, ok)
+
+	_, ok, err = ParseAuto("auto:10.64.9.7")
+	assert.Error(t, err)
+	assert.True(t, ok)
+}
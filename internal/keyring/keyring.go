@@ -0,0 +1,170 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+// Package keyring rotates the keys signing boot and API tokens. A rotation
+// adds a new primary key signing new tokens and retires the previous keys
+// once the tokens they signed expired, so nodes keep installing with the
+// boot tokens they were given. The keys are stored in the data store, sealed
+// with the credentials key, and shared by the instances using it.
+package keyring
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/ubccr/grendel/internal/config"
+	"github.com/ubccr/grendel/internal/logger"
+	"github.com/ubccr/grendel/internal/secret"
+	"github.com/ubccr/grendel/internal/store"
+	"github.com/ubccr/grendel/internal/util"
+	"github.com/ubccr/grendel/pkg/model"
+)
+
+const (
+	// DefaultWatchInterval is how often Watch reads the keys from the data
+	// store
+	DefaultWatchInterval = 10 * time.Second
+
+	// APITokenGrace is how long retired API keys verify tokens by default,
+	// the lifetime of the tokens of signed in users. API tokens created with
+	// a longer expiry must be created again
+	APITokenGrace = 8 * time.Hour
+)
+
+var log = logger.GetLogger("KEYRING")
+
+// Kinds are the kinds of signing keys
+var Kinds = []string{model.SigningKeyProvision, model.SigningKeyAPI}
+
+// DefaultGrace returns how long the retired keys of kind verify tokens after
+// a rotation: provision.token_ttl for boot tokens and APITokenGrace for API
+// tokens. It is 0 when boot tokens never expire
+func DefaultGrace(kind string) time.Duration {
+	if kind == model.SigningKeyProvision {
+		return time.Duration(config.Viper().GetUint32("provision.token_ttl")) * time.Second
+	}
+
+	return APITokenGrace
+}
+
+// Load reads the rotated keys from the data store
+func Load(db store.Store) error {
+	keys, err := db.SigningKeys()
+	if err != nil {
+		return err
+	}
+
+	for _, k := range keys {
+		k.Secret, err = secret.OpenString(k.Secret)
+		if err != nil {
+			return fmt.Errorf("signing key %s: %w", k.ID, err)
+		}
+	}
+
+	model.SetSigningKeys(keys)
+	return nil
+}
+
+// Watch reads the keys from the data store every interval until stop is
+// closed, picking up rotations made through another instance
+func Watch(db store.Store, interval time.Duration, stop <-chan struct{}) {
+	if interval <= 0 {
+		interval = DefaultWatchInterval
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	failing := false
+	for {
+		select {
+		case <-stop:
+			return
+		case <-ticker.C:
+		}
+
+		err := Load(db)
+		if err != nil && !failing {
+			log.WithField("err", err).Error("Failed to read signing keys, keeping the current keys")
+		} else if err == nil && failing {
+			log.Info("Reading signing keys again")
+		}
+		failing = err != nil
+	}
+}
+
+// Rotate generates a new primary key of kind and retires the current keys
+// after grace, unless they retire earlier. The first rotation of a kind moves
+// the secrets of the config to the data store, from then on the config
+// secrets are not used
+func Rotate(db store.Store, kind string, grace time.Duration) (*model.SigningKey, error) {
+	if kind != model.SigningKeyProvision && kind != model.SigningKeyAPI {
+		return nil, fmt.Errorf("invalid signing key kind %q. Valid kinds: provision, api", kind)
+	}
+	if grace <= 0 {
+		return nil, fmt.Errorf("a grace period is required for the %s keys", kind)
+	}
+
+	// Boot tokens take a key of exactly 32 bytes, 16 bytes hex encoded
+	size := 32
+	if kind == model.SigningKeyProvision {
+		size = 16
+	}
+	s, err := util.GenerateSecret(size)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	primary := &model.SigningKey{
+		ID:        model.SigningKeyID(s),
+		Kind:      kind,
+		Secret:    s,
+		CreatedAt: now,
+	}
+
+	retire := now.Add(grace)
+	keys := model.SigningKeyList{primary}
+	for i, k := range model.SigningKeys(kind) {
+		copied := *k
+		if copied.RetireAt.IsZero() || copied.RetireAt.After(retire) {
+			copied.RetireAt = retire
+		}
+		// Config secrets have no creation time, keep their order
+		if copied.CreatedAt.IsZero() {
+			copied.CreatedAt = now.Add(-time.Duration(i+1) * time.Millisecond)
+		}
+		keys = append(keys, &copied)
+	}
+
+	sealed := make(model.SigningKeyList, 0, len(keys))
+	for _, k := range keys {
+		copied := *k
+		copied.Secret, err = secret.SealString(k.Secret)
+		if err != nil {
+			return nil, err
+		}
+		sealed = append(sealed, &copied)
+	}
+
+	if err := db.StoreSigningKeys(sealed); err != nil {
+		return nil, err
+	}
+	if _, err := db.PurgeSigningKeys(now); err != nil {
+		return nil, err
+	}
+
+	if err := Load(db); err != nil {
+		return nil, err
+	}
+
+	log.WithFields(logrus.Fields{
+		"kind":      kind,
+		"id":        primary.ID,
+		"retire_at": retire,
+	}).Info("Rotated signing key")
+
+	return primary, nil
+}
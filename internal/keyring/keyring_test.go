@@ -0,0 +1,102 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package keyring
+
+import (
+	"testing"
+	"time"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/ubccr/grendel/internal/store/sqlstore"
+	"github.com/ubccr/grendel/pkg/model"
+)
+
+func TestRotate(t *testing.T) {
+	db, err := sqlstore.New(":memory:")
+	require.NoError(t, err)
+	defer db.Close()
+	defer model.SetSigningKeys(nil)
+
+	secret := viper.GetString("provision.secret")
+	viper.Set("credentials_key", "test-key")
+	defer viper.Set("credentials_key", "")
+	viper.Set("api.secret", []string{"api-secret"})
+	defer viper.Set("api.secret", "")
+
+	require.NoError(t, Load(db))
+	keys := model.SigningKeys(model.SigningKeyProvision)
+	require.Len(t, keys, 1)
+	assert.Equal(t, model.SigningKeyID(secret), keys[0].ID)
+
+	token, err := model.NewBootToken("1", "00:00:00:00:00:01")
+	require.NoError(t, err)
+
+	_, err = Rotate(db, model.SigningKeyProvision, 0)
+	assert.Error(t, err)
+
+	primary, err := Rotate(db, model.SigningKeyProvision, time.Hour)
+	require.NoError(t, err)
+	assert.Len(t, primary.Secret, 32)
+
+	// The config secret moved to the data store and verifies until it retires
+	keys = model.SigningKeys(model.SigningKeyProvision)
+	require.Len(t, keys, 2)
+	assert.Equal(t, primary.ID, keys[0].ID)
+	assert.True(t, keys[0].RetireAt.IsZero())
+	assert.Equal(t, model.SigningKeyID(secret), keys[1].ID)
+	assert.WithinDuration(t, time.Now().Add(time.Hour), keys[1].RetireAt, time.Minute)
+
+	claims, err := model.ParseBootToken(token)
+	require.NoError(t, err)
+	assert.Equal(t, "1", claims.ID)
+
+	// New tokens are signed with the primary key
+	token, err = model.NewBootToken("2", "00:00:00:00:00:02")
+	require.NoError(t, err)
+	model.SetSigningKeys(model.SigningKeyList{keys[1]})
+	_, err = model.ParseBootToken(token)
+	assert.Error(t, err)
+
+	// Keys are read back from the data store, as after a restart. Stored
+	// secrets are sealed
+	stored, err := db.SigningKeys()
+	require.NoError(t, err)
+	for _, k := range stored {
+		assert.NotEqual(t, primary.Secret, k.Secret)
+	}
+	require.NoError(t, Load(db))
+	_, err = model.ParseBootToken(token)
+	assert.NoError(t, err)
+
+	// Retired keys stop verifying tokens and are purged by the next rotation
+	stored[1].RetireAt = time.Now().Add(-time.Second)
+	require.NoError(t, db.StoreSigningKeys(stored))
+	require.NoError(t, Load(db))
+	assert.Len(t, model.SigningKeys(model.SigningKeyProvision), 1)
+
+	_, err = Rotate(db, model.SigningKeyAPI, DefaultGrace(model.SigningKeyAPI))
+	require.NoError(t, err)
+	stored, err = db.SigningKeys()
+	require.NoError(t, err)
+	assert.Len(t, stored, 3)
+	assert.Len(t, model.SigningKeys(model.SigningKeyAPI), 2)
+	assert.Len(t, model.SigningKeys(model.SigningKeyProvision), 1)
+
+	// Rotations through another instance are picked up by Watch
+	model.SetSigningKeys(nil)
+	stop := make(chan struct{})
+	done := make(chan struct{})
+	go func() {
+		Watch(db, 10*time.Millisecond, stop)
+		close(done)
+	}()
+	assert.Eventually(t, func() bool {
+		return model.PrimarySigningKey(model.SigningKeyProvision).ID == primary.ID
+	}, time.Second, 10*time.Millisecond)
+	close(stop)
+	<-done
+}
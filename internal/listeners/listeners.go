@@ -0,0 +1,59 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+// Package listeners records the sockets bound by the services of grendel
+// serve, reported by the API along with their address family
+package listeners
+
+import (
+	"net"
+	"sort"
+	"sync"
+
+	"github.com/ubccr/grendel/internal/util"
+	"github.com/ubccr/grendel/pkg/model"
+)
+
+var (
+	mu    sync.Mutex
+	bound = make(map[string]*model.Listener)
+)
+
+// Add records the socket a service is bound to. Adding the same service and
+// network again replaces the address, a nil address is ignored
+func Add(service string, addr net.Addr) {
+	if addr == nil {
+		return
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+
+	bound[service+"/"+addr.Network()] = &model.Listener{
+		Service: service,
+		Network: addr.Network(),
+		Address: addr.String(),
+		Family:  util.AddressFamily(addr),
+	}
+}
+
+// List returns the recorded sockets sorted by service
+func List() model.ListenerList {
+	mu.Lock()
+	defer mu.Unlock()
+
+	list := make(model.ListenerList, 0, len(bound))
+	for _, l := range bound {
+		list = append(list, l)
+	}
+
+	sort.Slice(list, func(i, j int) bool {
+		if list[i].Service != list[j].Service {
+			return list[i].Service < list[j].Service
+		}
+		return list[i].Network < list[j].Network
+	})
+
+	return list
+}
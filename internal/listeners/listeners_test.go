@@ -0,0 +1,27 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package listeners
+
+import (
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/ubccr/grendel/pkg/model"
+)
+
+func TestList(t *testing.T) {
+	Add("provision", &net.TCPAddr{IP: net.IPv6unspecified, Port: 80})
+	Add("dns", &net.UDPAddr{IP: net.IPv4zero, Port: 53})
+	Add("api", &net.UnixAddr{Name: "/run/grendel/api.sock", Net: "unix"})
+	Add("dns", &net.UDPAddr{IP: net.ParseIP("2001:db8::1"), Port: 53})
+	Add("tftp", nil)
+
+	assert.Equal(t, model.ListenerList{
+		{Service: "api", Network: "unix", Address: "/run/grendel/api.sock", Family: "unix"},
+		{Service: "dns", Network: "udp", Address: "[2001:db8::1]:53", Family: "ipv6"},
+		{Service: "provision", Network: "tcp", Address: "[::]:80", Family: "ipv4+ipv6"},
+	}, List())
+}
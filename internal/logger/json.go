@@ -0,0 +1,36 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package logger
+
+import (
+	"strings"
+
+	"github.com/sirupsen/logrus"
+)
+
+// JSONFormatter logs one JSON object per entry. The logger prefix is written
+// as the lower case service field
+type JSONFormatter struct {
+	logrus.JSONFormatter
+}
+
+func (f *JSONFormatter) Format(entry *logrus.Entry) ([]byte, error) {
+	prefix, _ := entry.Data["prefix"].(string)
+	if disabled(prefix) {
+		return nil, nil
+	}
+
+	e := entry.Dup()
+	e.Level = entry.Level
+	e.Message = entry.Message
+	e.Buffer = entry.Buffer
+	e.Caller = entry.Caller
+	delete(e.Data, "prefix")
+	if prefix != "" {
+		e.Data[FieldService] = strings.ToLower(prefix)
+	}
+
+	return f.JSONFormatter.Format(e)
+}
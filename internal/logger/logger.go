@@ -6,10 +6,26 @@
 package logger
 
 import (
+	"fmt"
+	"os"
+	"strings"
 	"sync"
+	"sync/atomic"
 
 	"github.com/rifflock/lfshook"
 	"github.com/sirupsen/logrus"
+	"github.com/spf13/viper"
+	"gopkg.in/natefinch/lumberjack.v2"
+)
+
+// Field names used by every service, so all log entries of a node booting
+// can be found with a single query
+const (
+	FieldService = "service"
+	FieldHost    = "host"
+	FieldMAC     = "mac"
+	FieldIP      = "ip"
+	FieldBootID  = "boot_id"
 )
 
 var (
@@ -17,6 +33,21 @@ var (
 	getLoggerMutex sync.Mutex
 )
 
+// Config is the log output of all loggers
+type Config struct {
+	// Format is either text or json
+	Format string
+
+	// File logs to the file instead of stderr when set. The file is rotated
+	// once it reaches MaxSize megabytes, keeping MaxBackups rotated files for
+	// at most MaxAge days
+	File       string
+	MaxSize    int
+	MaxBackups int
+	MaxAge     int
+	Compress   bool
+}
+
 // GetLogger returns a configured logger instance
 func GetLogger(prefix string) *logrus.Entry {
 	if prefix == "" {
@@ -34,7 +65,60 @@ func GetLogger(prefix string) *logrus.Entry {
 	return globalLogger.WithField("prefix", prefix)
 }
 
+// Setup sets the format and output of all loggers
+func Setup(c Config) error {
+	log := GetLogger("")
+
+	switch strings.ToLower(c.Format) {
+	case "", "text":
+		log.Logger.SetFormatter(&TextFormatter{
+			FullTimestamp: true,
+		})
+	case "json":
+		log.Logger.SetFormatter(&JSONFormatter{})
+	default:
+		return fmt.Errorf("invalid logging format %q, must be text or json", c.Format)
+	}
+
+	if c.File == "" {
+		log.Logger.SetOutput(os.Stderr)
+		return nil
+	}
+
+	log.Logger.SetOutput(&lumberjack.Logger{
+		Filename:   c.File,
+		MaxSize:    c.MaxSize,
+		MaxBackups: c.MaxBackups,
+		MaxAge:     c.MaxAge,
+		Compress:   c.Compress,
+	})
+
+	return nil
+}
+
 // WithFile logs to the specified file in addition to the existing output.
 func WithFile(log *logrus.Entry, logfile string) {
 	log.Logger.AddHook(lfshook.NewHook(logfile, &logrus.TextFormatter{}))
 }
+
+// loggers is the loggers config section once set by SetLoggers, so log
+// entries written while the configuration is reloaded do not read it
+var loggers atomic.Pointer[map[string]string]
+
+// SetLoggers replaces the loggers config section
+func SetLoggers(section map[string]string) {
+	loggers.Store(&section)
+}
+
+// disabled returns true when the logger with prefix is turned off in the
+// loggers config section
+func disabled(prefix string) bool {
+	var section map[string]string
+	if l := loggers.Load(); l != nil {
+		section = *l
+	} else {
+		section = viper.GetStringMapString("loggers")
+	}
+	status, ok := section[strings.ToLower(prefix)]
+	return ok && strings.ToLower(status) == "off"
+}
@@ -0,0 +1,56 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package logger
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestJSONFormatter(t *testing.T) {
+	defer viper.Reset()
+
+	var buf bytes.Buffer
+	l := logrus.New()
+	l.SetOutput(&buf)
+	l.SetFormatter(&JSONFormatter{})
+
+	l.WithFields(logrus.Fields{
+		"prefix":     "DHCP",
+		FieldMAC:     "00:11:22:33:44:55",
+		FieldBootID:  "0123456789abcdef",
+		FieldHost:    "cpn-01",
+		"dhcp_state": "offer",
+	}).Info("Found host")
+
+	var entry map[string]string
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &entry))
+	assert.Equal(t, "dhcp", entry[FieldService])
+	assert.Equal(t, "cpn-01", entry[FieldHost])
+	assert.Equal(t, "00:11:22:33:44:55", entry[FieldMAC])
+	assert.Equal(t, "0123456789abcdef", entry[FieldBootID])
+	assert.Equal(t, "Found host", entry["msg"])
+	assert.Equal(t, "info", entry["level"])
+	assert.NotContains(t, entry, "prefix")
+
+	buf.Reset()
+	viper.Set("loggers", map[string]string{"dhcp": "off"})
+	l.WithField("prefix", "DHCP").Info("Found host")
+	assert.Empty(t, buf.String())
+}
+
+func TestSetup(t *testing.T) {
+	defer Setup(Config{})
+
+	assert.Error(t, Setup(Config{Format: "xml"}))
+	assert.NoError(t, Setup(Config{Format: "json"}))
+	assert.IsType(t, &JSONFormatter{}, GetLogger("TEST").Logger.Formatter)
+}
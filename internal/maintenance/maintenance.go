@@ -0,0 +1,148 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+// Package maintenance holds the global maintenance mode. While it is on DHCP
+// leaves out the PXE and zero touch provisioning answers and the provision
+// endpoints return 503, static address assignment and DNS continue normally.
+// The mode is persisted in the data store so it survives restarts and is
+// shared by the instances using the same data store
+package maintenance
+
+import (
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/sirupsen/logrus"
+	"github.com/ubccr/grendel/internal/logger"
+	"github.com/ubccr/grendel/internal/store"
+	"github.com/ubccr/grendel/pkg/model"
+)
+
+// DefaultWatchInterval is how often Watch reads the mode from the data store
+const DefaultWatchInterval = 10 * time.Second
+
+var (
+	log = logger.GetLogger("MAINTENANCE")
+
+	mu      sync.RWMutex
+	current = &model.Maintenance{}
+
+	enabledGauge = prometheus.NewGauge(prometheus.GaugeOpts{
+		Name: "grendel_maintenance_enabled",
+		Help: "1 while maintenance mode pauses provisioning",
+	})
+	suppressedTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "grendel_maintenance_suppressed_total",
+		Help: "Provisioning attempts not answered in maintenance mode",
+	}, []string{"service"})
+)
+
+func init() {
+	prometheus.MustRegister(enabledGauge, suppressedTotal)
+}
+
+// Enabled returns whether maintenance mode is on
+func Enabled() bool {
+	mu.RLock()
+	defer mu.RUnlock()
+
+	return current.Enabled
+}
+
+// State returns a copy of the maintenance mode
+func State() *model.Maintenance {
+	mu.RLock()
+	defer mu.RUnlock()
+
+	m := *current
+	return &m
+}
+
+// Set turns maintenance mode on or off, writing it to the data store first
+func Set(db store.Store, m *model.Maintenance) error {
+	if err := db.StoreMaintenance(m); err != nil {
+		return err
+	}
+
+	update(m)
+	return nil
+}
+
+// Load reads maintenance mode from the data store
+func Load(db store.Store) error {
+	m, err := db.LoadMaintenance()
+	if err != nil {
+		return err
+	}
+
+	update(m)
+	return nil
+}
+
+// Watch reads maintenance mode from the data store every interval until stop
+// is closed, picking up changes made through another instance
+func Watch(db store.Store, interval time.Duration, stop <-chan struct{}) {
+	if interval <= 0 {
+		interval = DefaultWatchInterval
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	failing := false
+	for {
+		select {
+		case <-stop:
+			return
+		case <-ticker.C:
+		}
+
+		err := Load(db)
+		if err != nil && !failing {
+			log.WithField("err", err).Error("Failed to read maintenance mode, keeping the current mode")
+		} else if err == nil && failing {
+			log.Info("Reading maintenance mode again")
+		}
+		failing = err != nil
+	}
+}
+
+// Suppressed logs a provisioning attempt of host not answered by service in
+// maintenance mode
+func Suppressed(service, host string, fields logrus.Fields) {
+	suppressedTotal.WithLabelValues(service).Inc()
+
+	m := State()
+	log.WithFields(fields).WithFields(logrus.Fields{
+		logger.FieldHost: host,
+		"service":        service,
+		"reason":         m.Reason,
+	}).Warn("Maintenance mode on, not answering provisioning request")
+}
+
+func update(m *model.Maintenance) {
+	mu.Lock()
+	changed := current.Enabled != m.Enabled
+	copied := *m
+	current = &copied
+	mu.Unlock()
+
+	if m.Enabled {
+		enabledGauge.Set(1)
+	} else {
+		enabledGauge.Set(0)
+	}
+
+	if !changed {
+		return
+	}
+
+	fields := logrus.Fields{"changed_by": m.ChangedBy, "reason": m.Reason}
+	if m.Enabled {
+		log.WithFields(fields).Warn("Maintenance mode on, provisioning is paused")
+	} else {
+		log.WithFields(fields).Info("Maintenance mode off, provisioning resumed")
+	}
+}
@@ -0,0 +1,53 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package maintenance
+
+import (
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/ubccr/grendel/internal/store/sqlstore"
+	"github.com/ubccr/grendel/pkg/model"
+)
+
+func TestMaintenance(t *testing.T) {
+	db, err := sqlstore.New(":memory:")
+	require.NoError(t, err)
+	defer db.Close()
+	defer update(&model.Maintenance{})
+
+	require.NoError(t, Load(db))
+	assert.False(t, Enabled())
+
+	err = Set(db, &model.Maintenance{Enabled: true, Reason: "rack move", ChangedBy: "admin", Changed: time.Now()})
+	require.NoError(t, err)
+	assert.True(t, Enabled())
+	assert.Equal(t, 1.0, testutil.ToFloat64(enabledGauge))
+
+	// The mode is read back from the data store, as after a restart
+	update(&model.Maintenance{})
+	require.NoError(t, Load(db))
+	assert.True(t, Enabled())
+	assert.Equal(t, "rack move", State().Reason)
+
+	// Changes through another instance are picked up by Watch
+	require.NoError(t, db.StoreMaintenance(&model.Maintenance{Enabled: false, ChangedBy: "admin", Changed: time.Now()}))
+	stop := make(chan struct{})
+	done := make(chan struct{})
+	go func() {
+		Watch(db, 10*time.Millisecond, stop)
+		close(done)
+	}()
+	assert.Eventually(t, func() bool { return !Enabled() }, time.Second, 10*time.Millisecond)
+	close(stop)
+	<-done
+
+	suppressed := testutil.ToFloat64(suppressedTotal.WithLabelValues("dhcp"))
+	Suppressed("dhcp", "cpn-01", nil)
+	assert.Equal(t, suppressed+1, testutil.ToFloat64(suppressedTotal.WithLabelValues("dhcp")))
+}
@@ -0,0 +1,507 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+// Package mdns announces the services of grendel serve over multicast DNS
+// with DNS-SD records, so tools on the provisioning network can find grendel
+// by browsing for _grendel-api._tcp and _grendel-provision._tcp
+package mdns
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/miekg/dns"
+	"github.com/ubccr/grendel/internal/health"
+	"github.com/ubccr/grendel/internal/logger"
+	"golang.org/x/net/ipv4"
+	"golang.org/x/sys/unix"
+)
+
+const (
+	Port = 5353
+
+	// ServiceAPI and ServiceProvision are the DNS-SD service types
+	ServiceAPI       = "_grendel-api._tcp"
+	ServiceProvision = "_grendel-provision._tcp"
+
+	// TTL of the records, the 120 seconds of RFC 6762 for records holding a
+	// host name
+	TTL = 120
+
+	// legacyTTL caps the TTL of replies to one-shot queries not sent from
+	// port 5353
+	legacyTTL = 10
+
+	// cacheFlush is the class bit of records owned by a single responder
+	cacheFlush = 1 << 15
+
+	// unicastResponse is the class bit of questions asking for a unicast reply
+	unicastResponse = 1 << 15
+
+	domain = "local."
+
+	servicesName = "_services._dns-sd._udp." + domain
+)
+
+var (
+	log = logger.GetLogger("MDNS")
+
+	// group is the IPv4 mDNS multicast group
+	group = &net.UDPAddr{IP: net.IPv4(224, 0, 0, 251), Port: Port}
+)
+
+// Service is a service announced with PTR, SRV and TXT records
+type Service struct {
+	// Type is the service type, such as _grendel-api._tcp
+	Type string
+	Port int
+
+	// TXT are the key=value strings of the TXT record
+	TXT []string
+}
+
+// Responder answers mDNS queries for the services and the host name of the
+// responder on the selected interfaces. It only answers for names under
+// .local it owns and never binds the unicast DNS port
+type Responder struct {
+	// Name is the instance name of the services and the host name of the SRV
+	// targets, <Name>.local
+	Name     string
+	Services []Service
+
+	ifaces []net.Interface
+	conn   *ipv4.PacketConn
+	udp    *net.UDPConn
+
+	mu     sync.Mutex
+	closed bool
+}
+
+// NewResponder returns a responder for services on the interfaces named in
+// ifaceNames, every up multicast interface with an IPv4 address when empty
+func NewResponder(name string, ifaceNames []string, services []Service) (*Responder, error) {
+	if name == "" {
+		return nil, errors.New("mdns name is required")
+	}
+	if strings.ContainsAny(name, ". ") {
+		return nil, fmt.Errorf("invalid mdns name %q, it must be a single label", name)
+	}
+
+	ifaces, err := selectInterfaces(ifaceNames)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Responder{Name: name, Services: services, ifaces: ifaces}, nil
+}
+
+func selectInterfaces(names []string) ([]net.Interface, error) {
+	all, err := net.Interfaces()
+	if err != nil {
+		return nil, err
+	}
+
+	if len(names) > 0 {
+		ifaces := make([]net.Interface, 0, len(names))
+		for _, name := range names {
+			iface, err := net.InterfaceByName(name)
+			if err != nil {
+				return nil, fmt.Errorf("mdns interface %s: %w", name, err)
+			}
+			if iface.Flags&net.FlagMulticast == 0 {
+				return nil, fmt.Errorf("mdns interface %s does not support multicast", name)
+			}
+			ifaces = append(ifaces, *iface)
+		}
+		return ifaces, nil
+	}
+
+	ifaces := make([]net.Interface, 0, len(all))
+	for _, iface := range all {
+		if iface.Flags&net.FlagUp == 0 || iface.Flags&net.FlagMulticast == 0 || iface.Flags&net.FlagLoopback != 0 {
+			continue
+		}
+		if len(interfaceAddrs(&iface)) > 0 {
+			ifaces = append(ifaces, iface)
+		}
+	}
+	if len(ifaces) == 0 {
+		return nil, errors.New("no multicast interface with an IPv4 address found, set mdns.interfaces")
+	}
+
+	return ifaces, nil
+}
+
+// interfaceAddrs returns the IPv4 addresses of iface
+func interfaceAddrs(iface *net.Interface) []net.IP {
+	addrs, err := iface.Addrs()
+	if err != nil {
+		return nil
+	}
+
+	ips := make([]net.IP, 0, len(addrs))
+	for _, addr := range addrs {
+		if ipnet, ok := addr.(*net.IPNet); ok && ipnet.IP.To4() != nil {
+			ips = append(ips, ipnet.IP.To4())
+		}
+	}
+
+	return ips
+}
+
+// Listen binds the mDNS port and joins the multicast group on the
+// interfaces. The port is shared with other mDNS responders such as avahi
+func (r *Responder) Listen() error {
+	lc := net.ListenConfig{
+		Control: func(network, address string, c syscall.RawConn) error {
+			var serr error
+			err := c.Control(func(fd uintptr) {
+				if serr = unix.SetsockoptInt(int(fd), unix.SOL_SOCKET, unix.SO_REUSEADDR, 1); serr != nil {
+					return
+				}
+				serr = unix.SetsockoptInt(int(fd), unix.SOL_SOCKET, unix.SO_REUSEPORT, 1)
+			})
+			if err != nil {
+				return err
+			}
+			return serr
+		},
+	}
+
+	pc, err := lc.ListenPacket(context.Background(), "udp4", fmt.Sprintf("0.0.0.0:%d", Port))
+	if err != nil {
+		return err
+	}
+	r.udp = pc.(*net.UDPConn)
+	r.conn = ipv4.NewPacketConn(r.udp)
+
+	if err := r.setup(); err != nil {
+		r.udp.Close()
+		return err
+	}
+
+	return nil
+}
+
+func (r *Responder) setup() error {
+	for i := range r.ifaces {
+		if err := r.conn.JoinGroup(&r.ifaces[i], group); err != nil {
+			return fmt.Errorf("failed joining mdns group on %s: %w", r.ifaces[i].Name, err)
+		}
+	}
+	if err := r.conn.SetControlMessage(ipv4.FlagInterface, true); err != nil {
+		return err
+	}
+	if err := r.conn.SetMulticastTTL(255); err != nil {
+		return err
+	}
+
+	return r.conn.SetMulticastLoopback(true)
+}
+
+// Addr returns the address the responder is bound to, nil until Listen is
+// called
+func (r *Responder) Addr() net.Addr {
+	if r.udp == nil {
+		return nil
+	}
+
+	return r.udp.LocalAddr()
+}
+
+// Interfaces returns the names of the interfaces the services are announced
+// on
+func (r *Responder) Interfaces() []string {
+	names := make([]string, 0, len(r.ifaces))
+	for _, iface := range r.ifaces {
+		names = append(names, iface.Name)
+	}
+
+	return names
+}
+
+// Check returns an error when the socket of the responder is closed
+func (r *Responder) Check(ctx context.Context) error {
+	return health.SocketOpen(r.udp)
+}
+
+// Serve announces the services and answers queries until Shutdown
+func (r *Responder) Serve() error {
+	if r.conn == nil {
+		if err := r.Listen(); err != nil {
+			return err
+		}
+	}
+
+	log.Infof("Announcing %s on %s", r.Name+"."+domain, strings.Join(r.Interfaces(), ", "))
+	go r.announce()
+
+	buf := make([]byte, 9000)
+	for {
+		n, cm, src, err := r.conn.ReadFrom(buf)
+		if err != nil {
+			if r.isClosed() {
+				return nil
+			}
+			return err
+		}
+
+		iface := r.lookupInterface(cm)
+		if iface == nil {
+			continue
+		}
+
+		req := new(dns.Msg)
+		if err := req.Unpack(buf[:n]); err != nil {
+			log.Debugf("Invalid mdns packet from %s: %s", src, err)
+			continue
+		}
+
+		udpSrc, ok := src.(*net.UDPAddr)
+		if !ok {
+			continue
+		}
+		r.reply(iface, req, udpSrc)
+	}
+}
+
+// lookupInterface returns the selected interface a packet was received on.
+// The socket receives the packets of the groups joined by any socket of the
+// host, the others are ignored
+func (r *Responder) lookupInterface(cm *ipv4.ControlMessage) *net.Interface {
+	if cm == nil {
+		return nil
+	}
+
+	for i := range r.ifaces {
+		if r.ifaces[i].Index == cm.IfIndex {
+			return &r.ifaces[i]
+		}
+	}
+
+	return nil
+}
+
+func (r *Responder) reply(iface *net.Interface, req *dns.Msg, src *net.UDPAddr) {
+	legacy := src.Port != Port
+	res := r.Answer(req, interfaceAddrs(iface), legacy)
+	if res == nil {
+		return
+	}
+
+	// One-shot queries and questions asking for a unicast response are
+	// answered to the sender
+	dst := group
+	if legacy || unicastOnly(req) {
+		dst = src
+	}
+
+	if err := r.send(iface, res, dst); err != nil {
+		log.Debugf("Failed sending mdns response to %s on %s: %s", dst, iface.Name, err)
+	}
+}
+
+func unicastOnly(req *dns.Msg) bool {
+	for _, q := range req.Question {
+		if q.Qclass&unicastResponse == 0 {
+			return false
+		}
+	}
+
+	return len(req.Question) > 0
+}
+
+func (r *Responder) send(iface *net.Interface, msg *dns.Msg, dst *net.UDPAddr) error {
+	data, err := msg.Pack()
+	if err != nil {
+		return err
+	}
+
+	_, err = r.conn.WriteTo(data, &ipv4.ControlMessage{IfIndex: iface.Index}, dst)
+	return err
+}
+
+// announce sends the records unsolicited twice, a second apart, as a
+// responder starting up does
+func (r *Responder) announce() {
+	for i := 0; i < 2; i++ {
+		if i > 0 {
+			time.Sleep(time.Second)
+		}
+		if r.isClosed() {
+			return
+		}
+		for j := range r.ifaces {
+			msg := r.unsolicited(interfaceAddrs(&r.ifaces[j]), TTL)
+			if err := r.send(&r.ifaces[j], msg, group); err != nil {
+				log.Warnf("Failed announcing on %s: %s", r.ifaces[j].Name, err)
+			}
+		}
+	}
+}
+
+func (r *Responder) unsolicited(addrs []net.IP, ttl uint32) *dns.Msg {
+	msg := new(dns.Msg)
+	msg.Response = true
+	msg.Authoritative = true
+	for _, rr := range r.records(addrs) {
+		rr.Header().Ttl = ttl
+		if rr.Header().Rrtype != dns.TypePTR {
+			rr.Header().Class |= cacheFlush
+		}
+		msg.Answer = append(msg.Answer, rr)
+	}
+
+	return msg
+}
+
+// Shutdown withdraws the records with a goodbye packet, records with a TTL
+// of 0, and closes the socket
+func (r *Responder) Shutdown(ctx context.Context) error {
+	r.mu.Lock()
+	if r.closed || r.conn == nil {
+		r.mu.Unlock()
+		return nil
+	}
+	r.closed = true
+	r.mu.Unlock()
+
+	for i := range r.ifaces {
+		msg := r.unsolicited(interfaceAddrs(&r.ifaces[i]), 0)
+		if err := r.send(&r.ifaces[i], msg, group); err != nil {
+			log.Warnf("Failed withdrawing records on %s: %s", r.ifaces[i].Name, err)
+		}
+	}
+
+	return r.conn.Close()
+}
+
+func (r *Responder) isClosed() bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.closed
+}
+
+func (r *Responder) hostName() string {
+	return r.Name + "." + domain
+}
+
+func (s Service) typeName() string {
+	return s.Type + "." + domain
+}
+
+func (r *Responder) instanceName(s Service) string {
+	return r.Name + "." + s.typeName()
+}
+
+// records returns the records of the responder with addrs, the addresses of
+// the interface they are sent on
+func (r *Responder) records(addrs []net.IP) []dns.RR {
+	hdr := func(name string, rrtype uint16) dns.RR_Header {
+		return dns.RR_Header{Name: name, Rrtype: rrtype, Class: dns.ClassINET, Ttl: TTL}
+	}
+
+	rrs := make([]dns.RR, 0)
+	for _, s := range r.Services {
+		rrs = append(rrs,
+			&dns.PTR{Hdr: hdr(servicesName, dns.TypePTR), Ptr: s.typeName()},
+			&dns.PTR{Hdr: hdr(s.typeName(), dns.TypePTR), Ptr: r.instanceName(s)},
+			&dns.SRV{Hdr: hdr(r.instanceName(s), dns.TypeSRV), Port: uint16(s.Port), Target: r.hostName()},
+			&dns.TXT{Hdr: hdr(r.instanceName(s), dns.TypeTXT), Txt: s.TXT},
+		)
+	}
+	for _, ip := range addrs {
+		rrs = append(rrs, &dns.A{Hdr: hdr(r.hostName(), dns.TypeA), A: ip})
+	}
+
+	return rrs
+}
+
+// Answer returns the response to the questions of req owned by the
+// responder, nil when it owns none of them. Records the querier listed as
+// known answers with at least half their TTL left are left out. legacy
+// answers a one-shot query, echoing its ID and questions with short TTLs
+func (r *Responder) Answer(req *dns.Msg, addrs []net.IP, legacy bool) *dns.Msg {
+	if req.Response || req.Opcode != dns.OpcodeQuery || len(req.Question) == 0 {
+		return nil
+	}
+
+	all := r.records(addrs)
+	known := func(rr dns.RR) bool {
+		for _, k := range req.Answer {
+			if dns.IsDuplicate(k, rr) && k.Header().Ttl >= TTL/2 {
+				return true
+			}
+		}
+		return false
+	}
+	lookup := func(name string, qtype uint16) []dns.RR {
+		found := make([]dns.RR, 0)
+		for _, rr := range all {
+			h := rr.Header()
+			if strings.EqualFold(h.Name, name) && (qtype == dns.TypeANY || qtype == h.Rrtype) {
+				found = append(found, rr)
+			}
+		}
+		return found
+	}
+
+	res := new(dns.Msg)
+	res.Response = true
+	res.Authoritative = true
+	seen := make(map[dns.RR]bool)
+	add := func(section *[]dns.RR, rrs []dns.RR) {
+		for _, rr := range rrs {
+			if !seen[rr] && !known(rr) {
+				seen[rr] = true
+				*section = append(*section, rr)
+			}
+		}
+	}
+
+	for _, q := range req.Question {
+		if q.Qclass&^unicastResponse != dns.ClassINET && q.Qclass&^unicastResponse != dns.ClassANY {
+			continue
+		}
+		add(&res.Answer, lookup(q.Name, q.Qtype))
+	}
+	if len(res.Answer) == 0 {
+		return nil
+	}
+
+	// The records a browser resolves next are sent along, RFC 6763 12
+	for _, rr := range res.Answer {
+		switch rr := rr.(type) {
+		case *dns.PTR:
+			add(&res.Extra, lookup(rr.Ptr, dns.TypeSRV))
+			add(&res.Extra, lookup(rr.Ptr, dns.TypeTXT))
+			add(&res.Extra, lookup(r.hostName(), dns.TypeA))
+		case *dns.SRV:
+			add(&res.Extra, lookup(rr.Target, dns.TypeA))
+		}
+	}
+
+	for _, rr := range append(append([]dns.RR{}, res.Answer...), res.Extra...) {
+		h := rr.Header()
+		switch {
+		case legacy:
+			h.Ttl = min(h.Ttl, legacyTTL)
+		case h.Rrtype != dns.TypePTR:
+			h.Class |= cacheFlush
+		}
+	}
+	if legacy {
+		res.Id = req.Id
+		res.Question = req.Question
+	}
+
+	return res
+}
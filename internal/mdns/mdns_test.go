@@ -0,0 +1,131 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package mdns
+
+import (
+	"net"
+	"testing"
+
+	"github.com/miekg/dns"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testResponder() *Responder {
+	return &Responder{
+		Name: "grendel-head",
+		Services: []Service{
+			{Type: ServiceAPI, Port: 6667, TXT: []string{"version=v1.0.0", "scheme=https"}},
+			{Type: ServiceProvision, Port: 80, TXT: []string{"version=v1.0.0", "scheme=http"}},
+		},
+	}
+}
+
+func query(name string, qtype uint16) *dns.Msg {
+	m := new(dns.Msg)
+	m.SetQuestion(name, qtype)
+	m.RecursionDesired = false
+	return m
+}
+
+func rrStrings(rrs []dns.RR) []string {
+	s := make([]string, 0, len(rrs))
+	for _, rr := range rrs {
+		s = append(s, rr.String())
+	}
+	return s
+}
+
+func TestAnswerBrowse(t *testing.T) {
+	r := testResponder()
+	addrs := []net.IP{net.IPv4(10, 0, 0, 254).To4()}
+
+	res := r.Answer(query("_grendel-api._tcp.local.", dns.TypePTR), addrs, false)
+	require.NotNil(t, res)
+	assert.True(t, res.Response)
+	assert.Zero(t, res.Id)
+	assert.Empty(t, res.Question)
+	assert.Equal(t, []string{
+		"_grendel-api._tcp.local.\t120\tIN\tPTR\tgrendel-head._grendel-api._tcp.local.",
+	}, rrStrings(res.Answer))
+	assert.Equal(t, []string{
+		"grendel-head._grendel-api._tcp.local.\t120\tCLASS32769\tSRV\t0 0 6667 grendel-head.local.",
+		"grendel-head._grendel-api._tcp.local.\t120\tCLASS32769\tTXT\t\"version=v1.0.0\" \"scheme=https\"",
+		"grendel-head.local.\t120\tCLASS32769\tA\t10.0.0.254",
+	}, rrStrings(res.Extra))
+
+	res = r.Answer(query("_services._dns-sd._udp.local.", dns.TypePTR), addrs, false)
+	require.NotNil(t, res)
+	assert.Equal(t, []string{
+		"_services._dns-sd._udp.local.\t120\tIN\tPTR\t_grendel-api._tcp.local.",
+		"_services._dns-sd._udp.local.\t120\tIN\tPTR\t_grendel-provision._tcp.local.",
+	}, rrStrings(res.Answer))
+
+	// Names are case insensitive
+	res = r.Answer(query("GRENDEL-HEAD.local.", dns.TypeA), addrs, false)
+	require.NotNil(t, res)
+	assert.Equal(t, []string{"grendel-head.local.\t120\tCLASS32769\tA\t10.0.0.254"}, rrStrings(res.Answer))
+}
+
+func TestAnswerNotOwned(t *testing.T) {
+	r := testResponder()
+	addrs := []net.IP{net.IPv4(10, 0, 0, 254).To4()}
+
+	assert.Nil(t, r.Answer(query("cpn-01.local.", dns.TypeA), addrs, false))
+	assert.Nil(t, r.Answer(query("_ssh._tcp.local.", dns.TypePTR), addrs, false))
+	assert.Nil(t, r.Answer(query("grendel-head.local.", dns.TypeAAAA), addrs, false))
+
+	res := r.Answer(query("grendel-head.local.", dns.TypeA), addrs, false)
+	res.Response = true
+	assert.Nil(t, r.Answer(res, addrs, false), "responses are not answered")
+}
+
+func TestAnswerKnownAnswer(t *testing.T) {
+	r := testResponder()
+	addrs := []net.IP{net.IPv4(10, 0, 0, 254).To4()}
+
+	req := query("_grendel-provision._tcp.local.", dns.TypePTR)
+	ptr, err := dns.NewRR("_grendel-provision._tcp.local. 100 IN PTR grendel-head._grendel-provision._tcp.local.")
+	require.NoError(t, err)
+	req.Answer = []dns.RR{ptr}
+	assert.Nil(t, r.Answer(req, addrs, false))
+
+	// A known answer about to expire is refreshed
+	ptr.Header().Ttl = 30
+	assert.NotNil(t, r.Answer(req, addrs, false))
+}
+
+func TestAnswerLegacy(t *testing.T) {
+	r := testResponder()
+	addrs := []net.IP{net.IPv4(10, 0, 0, 254).To4()}
+
+	req := query("grendel-head._grendel-provision._tcp.local.", dns.TypeSRV)
+	res := r.Answer(req, addrs, true)
+	require.NotNil(t, res)
+	assert.Equal(t, req.Id, res.Id)
+	assert.Equal(t, req.Question, res.Question)
+	assert.Equal(t, []string{"grendel-head._grendel-provision._tcp.local.\t10\tIN\tSRV\t0 0 80 grendel-head.local."}, rrStrings(res.Answer))
+	assert.Equal(t, []string{"grendel-head.local.\t10\tIN\tA\t10.0.0.254"}, rrStrings(res.Extra))
+}
+
+func TestUnsolicited(t *testing.T) {
+	r := testResponder()
+	msg := r.unsolicited([]net.IP{net.IPv4(10, 0, 0, 254).To4()}, 0)
+
+	assert.True(t, msg.Response)
+	assert.Len(t, msg.Answer, 9)
+	for _, rr := range msg.Answer {
+		assert.Zero(t, rr.Header().Ttl, "goodbye records have a TTL of 0")
+	}
+}
+
+func TestNewResponder(t *testing.T) {
+	_, err := NewResponder("", nil, nil)
+	assert.Error(t, err)
+	_, err = NewResponder("grendel.example", nil, nil)
+	assert.Error(t, err)
+	_, err = NewResponder("grendel", []string{"does-not-exist0"}, nil)
+	assert.Error(t, err)
+}
@@ -0,0 +1,533 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+// Package preflight checks the configuration and the state of the host
+// grendel serve depends on before any socket is bound, so every reason
+// grendel would fail to start is reported at once along with a fix
+package preflight
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net"
+	"net/netip"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/ubccr/grendel/internal/config"
+	"github.com/ubccr/grendel/internal/ipam"
+	"github.com/ubccr/grendel/internal/provision"
+	"github.com/ubccr/grendel/internal/store/sqlstore"
+	"github.com/ubccr/grendel/pkg/model"
+	"golang.org/x/sys/unix"
+)
+
+const (
+	CheckDatastore   = "datastore"
+	CheckPorts       = "ports"
+	CheckTemplates   = "templates"
+	CheckCertificate = "certificate"
+	CheckImages      = "images"
+	CheckSubnets     = "subnets"
+	CheckAddresses   = "addresses"
+)
+
+// ExpiryWarning is how long before a certificate expires a warning is
+// reported
+const ExpiryWarning = 14 * 24 * time.Hour
+
+// Problem is a failed check. Warnings do not prevent grendel from starting
+type Problem struct {
+	Check   string `json:"check"`
+	Message string `json:"message"`
+	Fix     string `json:"fix,omitempty"`
+	Warning bool   `json:"warning,omitempty"`
+}
+
+func (p *Problem) String() string {
+	if p.Fix == "" {
+		return fmt.Sprintf("%s: %s", p.Check, p.Message)
+	}
+	return fmt.Sprintf("%s: %s. Fix: %s", p.Check, p.Message, p.Fix)
+}
+
+// Options selects the checks run
+type Options struct {
+	// Services are the services grendel serve starts
+	Services []string
+
+	// Runtime runs the checks of the host state: the datastore, the listen
+	// ports, the certificates and the boot image files. Otherwise only the
+	// configuration and the templates are checked
+	Runtime bool
+
+	// Listen returns the address bound for the <service>.listen address,
+	// such as with the --listen override of grendel serve. The address is
+	// used as is when nil
+	Listen func(address string) (string, error)
+
+	// Activated returns true when systemd passed the socket of a service
+	// bound to address on network, its port is not checked
+	Activated func(service, network, address string) bool
+}
+
+// network is the socket type bound by each service
+var network = map[string]string{
+	"provision": "tcp",
+	"api":       "tcp",
+	"metrics":   "tcp",
+	"debug":     "tcp",
+	"dns":       "udp",
+	"tftp":      "udp",
+	"dhcp":      "udp",
+	"pxe":       "udp",
+}
+
+// Run runs the checks and returns the problems found, failures first
+func Run(opts Options) []*Problem {
+	problems := make([]*Problem, 0)
+	problems = append(problems, checkSubnets()...)
+	if slices.Contains(opts.Services, "dhcp") && !config.Viper().GetBool("dhcp.proxy_only") {
+		problems = append(problems, checkHostSubnets()...)
+	}
+	if slices.Contains(opts.Services, "provision") {
+		problems = append(problems, checkTemplates()...)
+	}
+
+	if opts.Runtime {
+		problems = append(problems, checkDatastore()...)
+		problems = append(problems, checkReservations()...)
+		problems = append(problems, checkPorts(opts)...)
+		problems = append(problems, checkCertificates(opts.Services)...)
+	}
+
+	slices.SortStableFunc(problems, func(a, b *Problem) int {
+		switch {
+		case a.Warning == b.Warning:
+			return 0
+		case b.Warning:
+			return -1
+		}
+		return 1
+	})
+
+	return problems
+}
+
+// Error returns an error listing every problem when any of them is not a
+// warning
+func Error(problems []*Problem) error {
+	failures := make([]string, 0, len(problems))
+	for _, p := range problems {
+		if !p.Warning {
+			failures = append(failures, "  - "+p.String())
+		}
+	}
+
+	if len(failures) == 0 {
+		return nil
+	}
+
+	return fmt.Errorf("%d preflight check(s) failed, use --skip-preflight to start anyway:\n%s", len(failures), strings.Join(failures, "\n"))
+}
+
+func dsn() string {
+	if dsn := config.Viper().GetString("dsn"); dsn != "" {
+		return dsn
+	}
+	return config.Viper().GetString("dbpath")
+}
+
+// checkDatastore checks the database directory is writable and the database
+// is not locked by another grendel serve, then checks the files of the boot
+// images stored in it
+func checkDatastore() []*Problem {
+	filename := dsn()
+	if config.Viper().GetString("dbtype") != "sqlite" || filename == ":memory:" || sqlstore.IsPostgresDSN(filename) {
+		return nil
+	}
+
+	dir := filepath.Dir(filename)
+	if err := unix.Access(dir, unix.W_OK|unix.X_OK); err != nil {
+		fix := fmt.Sprintf("create %s and make it writable by uid %d, or set dbpath to a writable location", dir, os.Geteuid())
+		if errors.Is(err, unix.ENOENT) {
+			return []*Problem{{Check: CheckDatastore, Message: fmt.Sprintf("database directory %s does not exist", dir), Fix: fix}}
+		}
+		return []*Problem{{Check: CheckDatastore, Message: fmt.Sprintf("database directory %s is not writable: %s", dir, err), Fix: fix}}
+	}
+
+	_, err := os.Stat(filename)
+	exists := err == nil
+	if exists {
+		if err := unix.Access(filename, unix.R_OK|unix.W_OK); err != nil {
+			return []*Problem{{
+				Check:   CheckDatastore,
+				Message: fmt.Sprintf("database %s is not writable: %s", filename, err),
+				Fix:     fmt.Sprintf("chown the database to the user running grendel (uid %d)", os.Geteuid()),
+			}}
+		}
+	}
+
+	// The lock taken by grendel serve, held by other serve instances sharing
+	// the database
+	lock, err := sqlstore.LockShared(filename)
+	if err != nil {
+		p := &Problem{Check: CheckDatastore, Message: fmt.Sprintf("failed to lock database %s: %s", filename, err)}
+		if errors.Is(err, sqlstore.ErrLocked) {
+			p.Message = fmt.Sprintf("database %s is locked by another process", filename)
+			if owner := lockOwner(filename + ".lock"); owner != "" {
+				p.Message = fmt.Sprintf("database %s is locked by %s", filename, owner)
+			}
+			p.Fix = "wait for the grendel db command using the database to finish, or set dbpath to another database"
+		}
+		return []*Problem{p}
+	}
+	defer lock.Unlock()
+
+	if !exists {
+		return nil
+	}
+
+	images, err := sqlstore.ReadBootImages(filename)
+	if err != nil {
+		return []*Problem{{
+			Check:   CheckImages,
+			Message: fmt.Sprintf("failed to read the boot images of %s: %s", filename, err),
+			Fix:     "run grendel db check to look for corruption",
+			Warning: true,
+		}}
+	}
+
+	problems := make([]*Problem, 0)
+	for _, image := range images {
+		for _, file := range image.Files() {
+			f, err := os.Open(file)
+			if err != nil {
+				problems = append(problems, &Problem{
+					Check:   CheckImages,
+					Message: fmt.Sprintf("boot image %s: %s", image.Name, err),
+					Fix:     fmt.Sprintf("restore the file or fix the path with grendel image edit %s", image.Name),
+				})
+				continue
+			}
+			f.Close()
+		}
+	}
+
+	return problems
+}
+
+// checkReservations reports the addresses reserved by node nextip which
+// expired without being assigned to a host
+func checkReservations() []*Problem {
+	filename := dsn()
+	if config.Viper().GetString("dbtype") != "sqlite" || filename == ":memory:" || sqlstore.IsPostgresDSN(filename) {
+		return nil
+	}
+	if _, err := os.Stat(filename); err != nil {
+		return nil
+	}
+
+	// Databases not migrated yet have no reservations
+	reservations, hosts, err := sqlstore.ReadIPReservations(filename)
+	if err != nil {
+		return nil
+	}
+
+	problems := make([]*Problem, 0)
+	for _, r := range ipam.Unused(reservations, hosts, time.Now()) {
+		by := ""
+		if r.User != "" {
+			by = " by " + r.User
+		}
+		problems = append(problems, &Problem{
+			Check:   CheckAddresses,
+			Message: fmt.Sprintf("address %s reserved%s expired on %s without being assigned to a node", r.IP, by, r.ExpiresAt.Local().Format(time.RFC3339)),
+			Fix:     "add the node the address was reserved for, the address is handed out again by node nextip",
+			Warning: true,
+		})
+	}
+
+	return problems
+}
+
+// checkPorts binds the listen address of each service and closes it again,
+// naming the process already bound to the port when known
+func checkPorts(opts Options) []*Problem {
+	problems := make([]*Problem, 0)
+	for _, service := range opts.Services {
+		netw, ok := network[service]
+		if !ok || (service == "api" && config.Viper().GetString("api.socket_path") != "") {
+			continue
+		}
+
+		address := config.Viper().GetString(service + ".listen")
+		// The debug server is not moved by --listen
+		if opts.Listen != nil && service != "debug" {
+			bound, err := opts.Listen(address)
+			if err != nil {
+				problems = append(problems, &Problem{
+					Check:   CheckPorts,
+					Message: fmt.Sprintf("%s: invalid listen address %s: %s", service, address, err),
+					Fix:     fmt.Sprintf("set %s.listen to an address such as 0.0.0.0:<port>", service),
+				})
+				continue
+			}
+			address = bound
+		}
+
+		if opts.Activated != nil && opts.Activated(service, netw, address) {
+			continue
+		}
+
+		if err := bind(netw, address); err != nil {
+			problems = append(problems, portProblem(service, netw, address, err))
+		}
+	}
+
+	return problems
+}
+
+func bind(netw, address string) error {
+	if netw == "tcp" {
+		l, err := net.Listen(netw, address)
+		if err != nil {
+			return err
+		}
+		return l.Close()
+	}
+
+	c, err := net.ListenPacket(netw, address)
+	if err != nil {
+		return err
+	}
+	return c.Close()
+}
+
+func portProblem(service, netw, address string, err error) *Problem {
+	p := &Problem{
+		Check:   CheckPorts,
+		Message: fmt.Sprintf("%s cannot listen on %s/%s: %s", service, address, netw, err),
+	}
+
+	_, port, _ := net.SplitHostPort(address)
+	switch {
+	case errors.Is(err, syscall.EADDRINUSE):
+		p.Message = fmt.Sprintf("%s cannot listen on %s/%s, the port is in use", service, address, netw)
+		if owner := portOwner(netw, port); owner != "" {
+			p.Message = fmt.Sprintf("%s cannot listen on %s/%s, the port is in use by %s", service, address, netw, owner)
+		}
+		p.Fix = fmt.Sprintf("stop the other process (see ss -lnp 'sport = :%s'), change %s.listen or disable %s", port, service, service)
+	case errors.Is(err, syscall.EACCES):
+		p.Fix = "start grendel serve as root with --user, or grant the CAP_NET_BIND_SERVICE capability"
+	case errors.Is(err, syscall.EADDRNOTAVAIL):
+		p.Fix = fmt.Sprintf("set %s.listen to an address of an interface of this host or 0.0.0.0", service)
+	}
+
+	return p
+}
+
+// checkCertificates loads the certificates of the HTTPS services and checks
+// they are valid now and not about to expire
+func checkCertificates(services []string) []*Problem {
+	problems := make([]*Problem, 0)
+	for _, service := range []string{"provision", "api"} {
+		if !slices.Contains(services, service) {
+			continue
+		}
+		if service == "provision" && config.Viper().GetBool("provision.acme.enabled") {
+			continue
+		}
+
+		certFile := config.Viper().GetString(service + ".cert")
+		keyFile := config.Viper().GetString(service + ".key")
+		if certFile == "" || keyFile == "" {
+			continue
+		}
+
+		pair, err := tls.LoadX509KeyPair(certFile, keyFile)
+		if err != nil {
+			problems = append(problems, &Problem{
+				Check:   CheckCertificate,
+				Message: fmt.Sprintf("%s.cert %s: %s", service, certFile, err),
+				Fix:     fmt.Sprintf("check %s.cert and %s.key are a readable PEM certificate and matching key", service, service),
+			})
+			continue
+		}
+
+		leaf, err := x509.ParseCertificate(pair.Certificate[0])
+		if err != nil {
+			problems = append(problems, &Problem{Check: CheckCertificate, Message: fmt.Sprintf("%s.cert %s: %s", service, certFile, err)})
+			continue
+		}
+
+		if p := certificateProblem(service, certFile, leaf, time.Now()); p != nil {
+			problems = append(problems, p)
+		}
+	}
+
+	return problems
+}
+
+func certificateProblem(service, certFile string, leaf *x509.Certificate, now time.Time) *Problem {
+	fix := fmt.Sprintf("renew the certificate in %s", certFile)
+	if service == "provision" {
+		fix += " or enable provision.acme"
+	}
+
+	switch {
+	case now.After(leaf.NotAfter):
+		return &Problem{Check: CheckCertificate, Message: fmt.Sprintf("%s.cert %s expired on %s", service, certFile, leaf.NotAfter.Format(time.RFC3339)), Fix: fix}
+	case now.Before(leaf.NotBefore):
+		return &Problem{Check: CheckCertificate, Message: fmt.Sprintf("%s.cert %s is not valid before %s", service, certFile, leaf.NotBefore.Format(time.RFC3339)), Fix: "check the clock of this host"}
+	case leaf.NotAfter.Sub(now) < ExpiryWarning:
+		return &Problem{Check: CheckCertificate, Message: fmt.Sprintf("%s.cert %s expires on %s", service, certFile, leaf.NotAfter.Format(time.RFC3339)), Fix: fix, Warning: true}
+	}
+
+	return nil
+}
+
+// checkTemplates parses the embedded templates and the templates in
+// provision.TemplateDir
+func checkTemplates() []*Problem {
+	templates, err := provision.NewTemplateRenderer()
+	if err == nil {
+		err = templates.Check()
+	}
+	if err != nil {
+		return []*Problem{{
+			Check:   CheckTemplates,
+			Message: err.Error(),
+			Fix:     fmt.Sprintf("fix or remove the template in %s", provision.TemplateDir),
+		}}
+	}
+
+	return nil
+}
+
+// checkSubnets checks the router of each dhcp.subnets entry is a usable
+// address of its subnet and no two subnets overlap
+func checkSubnets() []*Problem {
+	return subnetProblems(config.Current().Subnets)
+}
+
+// checkHostSubnets reports the interfaces of the hosts in the datastore with
+// an address in none of dhcp.subnets, answered without the settings of a
+// subnet or not at all with dhcp.strict_subnets
+func checkHostSubnets() []*Problem {
+	filename := dsn()
+	if len(config.Current().Subnets) == 0 || config.Viper().GetString("dbtype") != "sqlite" || filename == ":memory:" {
+		return nil
+	}
+	if _, err := os.Stat(filename); err != nil {
+		return nil
+	}
+
+	hosts, err := sqlstore.ReadHosts(filename)
+	if err != nil {
+		return nil
+	}
+
+	return hostSubnetProblems(hosts)
+}
+
+func hostSubnetProblems(hosts model.HostList) []*Problem {
+	answer := "answered without the router, DNS servers and MTU of a subnet"
+	if config.Viper().GetBool("dhcp.strict_subnets") {
+		answer = "not answered as dhcp.strict_subnets is set"
+	}
+
+	problems := make([]*Problem, 0)
+	for _, host := range hosts {
+		for _, iface := range host.Interfaces {
+			if len(iface.MAC) == 0 {
+				continue
+			}
+			// The address answered is the one of the bond of the interface
+			nic := host.DHCPInterface(iface.MAC)
+			if !nic.IP.Addr().Is4() || !nic.OutsideSubnets() {
+				continue
+			}
+			problems = append(problems, &Problem{
+				Check:   CheckSubnets,
+				Message: fmt.Sprintf("host %s: address %s of %s is in none of dhcp.subnets, DHCP requests are %s", host.Name, nic.AddrString(), nic.MAC, answer),
+				Fix:     "fix the address of the host or add its subnet to dhcp.subnets",
+				Warning: true,
+			})
+		}
+	}
+
+	return problems
+}
+
+func subnetProblems(subnets []config.Subnet) []*Problem {
+	problems := make([]*Problem, 0)
+	for i, s := range subnets {
+		router := s.Gateway.Addr()
+		network := s.Gateway.Masked()
+		switch {
+		case !router.Is4():
+			problems = append(problems, &Problem{
+				Check:   CheckSubnets,
+				Message: fmt.Sprintf("subnet %s: gateway must be an IPv4 address", s.Gateway),
+				Fix:     "set gateway to the router address and prefix length, such as 10.0.0.1/24",
+			})
+			continue
+		case s.Gateway.Bits() > 30:
+			problems = append(problems, &Problem{
+				Check:   CheckSubnets,
+				Message: fmt.Sprintf("subnet %s: a /%d prefix leaves no addresses for clients", s.Gateway, s.Gateway.Bits()),
+				Fix:     "set the prefix length of the subnet, such as 10.0.0.1/24",
+			})
+			continue
+		case router == network.Addr():
+			problems = append(problems, &Problem{
+				Check:   CheckSubnets,
+				Message: fmt.Sprintf("subnet %s: gateway %s is the network address", s.Gateway, router),
+				Fix:     fmt.Sprintf("set gateway to the router of %s with the prefix length, such as %s/%d", network, network.Addr().Next(), network.Bits()),
+			})
+		case router == broadcast(network):
+			problems = append(problems, &Problem{
+				Check:   CheckSubnets,
+				Message: fmt.Sprintf("subnet %s: gateway %s is the broadcast address", s.Gateway, router),
+				Fix:     fmt.Sprintf("set gateway to the router of %s with the prefix length", network),
+			})
+		}
+
+		if s.AdvertiseIP.IsValid() && s.AdvertiseIP == router {
+			problems = append(problems, &Problem{
+				Check:   CheckSubnets,
+				Message: fmt.Sprintf("subnet %s: advertise_ip %s is the gateway", s.Gateway, s.AdvertiseIP),
+				Fix:     "set advertise_ip to the address of grendel reachable from the subnet",
+				Warning: true,
+			})
+		}
+
+		for _, other := range subnets[:i] {
+			if other.Gateway.Addr().Is4() && network.Overlaps(other.Gateway.Masked()) {
+				problems = append(problems, &Problem{
+					Check:   CheckSubnets,
+					Message: fmt.Sprintf("subnet %s overlaps subnet %s, only the first one is used", s.Gateway, other.Gateway),
+					Fix:     "remove or fix the prefix length of one of the subnets",
+				})
+			}
+		}
+	}
+
+	return problems
+}
+
+func broadcast(prefix netip.Prefix) netip.Addr {
+	a := prefix.Addr().As4()
+	for i := prefix.Bits(); i < 32; i++ {
+		a[i/8] |= 1 << (7 - i%8)
+	}
+	return netip.AddrFrom4(a)
+}
@@ -0,0 +1,194 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package preflight
+
+import (
+	"crypto/x509"
+	"net"
+	"net/netip"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/ubccr/grendel/internal/config"
+	"github.com/ubccr/grendel/internal/store/sqlstore"
+	"github.com/ubccr/grendel/pkg/model"
+)
+
+func TestSubnetProblems(t *testing.T) {
+	subnet := func(gateway string) config.Subnet {
+		return config.Subnet{Gateway: netip.MustParsePrefix(gateway)}
+	}
+
+	assert.Empty(t, subnetProblems([]config.Subnet{subnet("10.0.0.1/24"), subnet("10.1.0.254/16")}))
+
+	problems := subnetProblems([]config.Subnet{
+		subnet("10.0.0.0/24"),
+		subnet("10.0.1.255/24"),
+		subnet("10.0.0.1/16"),
+		subnet("10.2.0.1/32"),
+	})
+	if assert.Len(t, problems, 5) {
+		assert.Contains(t, problems[0].Message, "10.0.0.0 is the network address")
+		assert.Contains(t, problems[0].Fix, "10.0.0.1/24")
+		assert.Contains(t, problems[1].Message, "10.0.1.255 is the broadcast address")
+		assert.Contains(t, problems[2].Message, "10.0.0.1/16 overlaps subnet 10.0.0.0/24")
+		assert.Contains(t, problems[3].Message, "10.0.0.1/16 overlaps subnet 10.0.1.255/24")
+		assert.Contains(t, problems[4].Message, "a /32 prefix leaves no addresses")
+	}
+
+	s := subnet("10.3.0.1/24")
+	s.AdvertiseIP = netip.MustParseAddr("10.3.0.1")
+	problems = subnetProblems([]config.Subnet{s})
+	if assert.Len(t, problems, 1) {
+		assert.True(t, problems[0].Warning)
+	}
+}
+
+func TestHostSubnetProblems(t *testing.T) {
+	cfg := *config.Current()
+	cfg.Subnets = []config.Subnet{{Gateway: netip.MustParsePrefix("10.1.0.254/24")}}
+	defer config.Set(config.Set(&cfg))
+
+	mac := func(s string) net.HardwareAddr {
+		hw, err := net.ParseMAC(s)
+		require.NoError(t, err)
+		return hw
+	}
+	hosts := model.HostList{
+		{Name: "cpn-01", Interfaces: []*model.NetInterface{{MAC: mac("d0:94:66:00:00:01"), IP: netip.MustParsePrefix("10.1.0.1/24")}}},
+		{Name: "cpn-02", Interfaces: []*model.NetInterface{
+			{MAC: mac("d0:94:66:00:00:02"), IP: netip.MustParsePrefix("10.11.0.2/24")},
+			// Not answered by DHCP
+			{IP: netip.MustParsePrefix("10.12.0.2/24")},
+		}},
+	}
+
+	problems := hostSubnetProblems(hosts)
+	if assert.Len(t, problems, 1) {
+		assert.True(t, problems[0].Warning)
+		assert.Equal(t, "host cpn-02: address 10.11.0.2 of d0:94:66:00:00:02 is in none of dhcp.subnets, DHCP requests are answered without the router, DNS servers and MTU of a subnet", problems[0].Message)
+	}
+
+	viper.Set("dhcp.strict_subnets", true)
+	defer viper.Set("dhcp.strict_subnets", nil)
+	problems = hostSubnetProblems(hosts)
+	if assert.Len(t, problems, 1) {
+		assert.Contains(t, problems[0].Message, "not answered as dhcp.strict_subnets is set")
+	}
+}
+
+func TestCertificateProblem(t *testing.T) {
+	now := time.Now()
+	leaf := &x509.Certificate{NotBefore: now.Add(-time.Hour), NotAfter: now.Add(90 * 24 * time.Hour)}
+	assert.Nil(t, certificateProblem("api", "api.pem", leaf, now))
+
+	p := certificateProblem("provision", "provision.pem", leaf, now.Add(80*24*time.Hour))
+	if assert.NotNil(t, p) {
+		assert.True(t, p.Warning)
+		assert.Contains(t, p.Fix, "provision.acme")
+	}
+
+	p = certificateProblem("api", "api.pem", leaf, now.Add(91*24*time.Hour))
+	if assert.NotNil(t, p) {
+		assert.False(t, p.Warning)
+		assert.Contains(t, p.Message, "api.cert api.pem expired on")
+	}
+
+	p = certificateProblem("api", "api.pem", leaf, now.Add(-2*time.Hour))
+	if assert.NotNil(t, p) {
+		assert.Contains(t, p.Message, "is not valid before")
+	}
+}
+
+func TestCheckPorts(t *testing.T) {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer l.Close()
+
+	viper.Set("provision.listen", l.Addr().String())
+	viper.Set("metrics.listen", "127.0.0.1:0")
+	defer viper.Set("provision.listen", nil)
+	defer viper.Set("metrics.listen", nil)
+
+	problems := checkPorts(Options{Services: []string{"provision", "metrics"}})
+	if assert.Len(t, problems, 1) {
+		assert.Equal(t, CheckPorts, problems[0].Check)
+		assert.Contains(t, problems[0].Message, "the port is in use by pid")
+		assert.Contains(t, problems[0].Fix, "provision.listen")
+	}
+
+	// The port of a socket passed by systemd is held by systemd
+	problems = checkPorts(Options{
+		Services: []string{"provision"},
+		Activated: func(service, network, address string) bool {
+			return service == "provision" && network == "tcp" && address == l.Addr().String()
+		},
+	})
+	assert.Empty(t, problems)
+}
+
+func TestCheckDatastore(t *testing.T) {
+	dir := t.TempDir()
+	filename := filepath.Join(dir, "grendel.db")
+	viper.Set("dbtype", "sqlite")
+	viper.Set("dbpath", filename)
+	defer viper.Set("dbtype", nil)
+	defer viper.Set("dbpath", nil)
+
+	// Created on start
+	assert.Empty(t, checkDatastore())
+
+	kernel := filepath.Join(dir, "vmlinuz")
+	require.NoError(t, os.WriteFile(kernel, []byte("kernel"), 0644))
+
+	db, err := sqlstore.New(filename)
+	require.NoError(t, err)
+	require.NoError(t, db.StoreBootImages(model.BootImageList{
+		{Name: "ok", KernelPath: kernel},
+		{Name: "missing", KernelPath: kernel, InitrdPaths: []string{filepath.Join(dir, "initrd.img")}},
+	}))
+	require.NoError(t, db.Close())
+
+	problems := checkDatastore()
+	if assert.Len(t, problems, 1) {
+		assert.Equal(t, CheckImages, problems[0].Check)
+		assert.Contains(t, problems[0].Message, "boot image missing")
+		assert.Contains(t, problems[0].Fix, "grendel image edit missing")
+	}
+
+	lock, err := sqlstore.Lock(filename)
+	require.NoError(t, err)
+	problems = checkDatastore()
+	require.NoError(t, lock.Unlock())
+	if assert.Len(t, problems, 1) {
+		assert.Equal(t, CheckDatastore, problems[0].Check)
+		assert.Contains(t, problems[0].Message, "is locked by pid")
+	}
+
+	viper.Set("dbpath", filepath.Join(dir, "missing", "grendel.db"))
+	problems = checkDatastore()
+	if assert.Len(t, problems, 1) {
+		assert.Contains(t, problems[0].Message, "does not exist")
+	}
+}
+
+func TestError(t *testing.T) {
+	assert.NoError(t, Error(nil))
+	assert.NoError(t, Error([]*Problem{{Check: CheckCertificate, Message: "expires soon", Warning: true}}))
+
+	err := Error([]*Problem{
+		{Check: CheckPorts, Message: "dns cannot listen", Fix: "stop it"},
+		{Check: CheckCertificate, Message: "expires soon", Warning: true},
+		{Check: CheckTemplates, Message: "template ipxe.tmpl not parsed"},
+	})
+	assert.EqualError(t, err, `2 preflight check(s) failed, use --skip-preflight to start anyway:
+  - ports: dns cannot listen. Fix: stop it
+  - templates: template ipxe.tmpl not parsed`)
+}
@@ -0,0 +1,122 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package preflight
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"syscall"
+)
+
+// lockOwner returns the process holding the flock of a file, empty when
+// unknown
+func lockOwner(filename string) string {
+	var st syscall.Stat_t
+	if err := syscall.Stat(filename, &st); err != nil {
+		return ""
+	}
+
+	f, err := os.Open("/proc/locks")
+	if err != nil {
+		return ""
+	}
+	defer f.Close()
+
+	// 1: FLOCK  ADVISORY  WRITE 4242 08:01:1234567 0 EOF
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) < 6 || fields[1] != "FLOCK" {
+			continue
+		}
+
+		dev := strings.Split(fields[5], ":")
+		if len(dev) != 3 || dev[2] != strconv.FormatUint(st.Ino, 10) {
+			continue
+		}
+
+		return process(fields[4])
+	}
+
+	return ""
+}
+
+// portOwner returns the process bound to a local port, empty when unknown.
+// Sockets of other users are only found when running as root
+func portOwner(network, port string) string {
+	p, err := strconv.ParseUint(port, 10, 16)
+	if err != nil {
+		return ""
+	}
+
+	inodes := make(map[string]bool)
+	for _, table := range []string{network, network + "6"} {
+		for _, inode := range socketInodes("/proc/net/"+table, network == "tcp", uint16(p)) {
+			inodes["socket:["+inode+"]"] = true
+		}
+	}
+	if len(inodes) == 0 {
+		return ""
+	}
+
+	fds, _ := filepath.Glob("/proc/[0-9]*/fd/*")
+	for _, fd := range fds {
+		link, err := os.Readlink(fd)
+		if err != nil || !inodes[link] {
+			continue
+		}
+
+		return process(strings.Split(fd, "/")[2])
+	}
+
+	return ""
+}
+
+// socketInodes returns the inodes of the sockets bound to port in a
+// /proc/net table, only listening sockets for tcp
+func socketInodes(table string, listening bool, port uint16) []string {
+	f, err := os.Open(table)
+	if err != nil {
+		return nil
+	}
+	defer f.Close()
+
+	// sl local_address rem_address st tx_queue:rx_queue tr:tm->when retrnsmt uid timeout inode
+	inodes := make([]string, 0)
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) < 10 || fields[0] == "sl" {
+			continue
+		}
+
+		_, hexPort, ok := strings.Cut(fields[1], ":")
+		if !ok || hexPort != fmt.Sprintf("%04X", port) {
+			continue
+		}
+		// 0A is TCP_LISTEN
+		if listening && fields[3] != "0A" {
+			continue
+		}
+
+		inodes = append(inodes, fields[9])
+	}
+
+	return inodes
+}
+
+// process returns the pid and command name of a process
+func process(pid string) string {
+	comm, err := os.ReadFile(filepath.Join("/proc", pid, "comm"))
+	if err != nil {
+		return "pid " + pid
+	}
+
+	return fmt.Sprintf("pid %s (%s)", pid, strings.TrimSpace(string(comm)))
+}
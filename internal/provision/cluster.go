@@ -0,0 +1,115 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package provision
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/ubccr/grendel/internal/store"
+	"github.com/ubccr/grendel/pkg/model"
+	"github.com/ubccr/grendel/pkg/nodeset"
+)
+
+// DefaultTemplateMaxHosts is the most hosts templates load with .cluster
+// unless provision.template_max_hosts is set
+const DefaultTemplateMaxHosts = 10000
+
+// Cluster gives templates the hosts of the data store as .cluster, such as
+// {{ range .cluster.HostsByTag "compute" }}. The hosts are loaded on first use
+// and kept for the request, so the templates and includes rendered for a
+// request read the data store once and see the same hosts. Every request
+// loads them again. Loading fails when there are more than max hosts, unless
+// max is 0
+type Cluster struct {
+	db    store.Store
+	max   int
+	once  sync.Once
+	hosts model.HostList
+	err   error
+}
+
+func newCluster(db store.Store, max int) *Cluster {
+	return &Cluster{db: db, max: max}
+}
+
+func (c *Cluster) load() (model.HostList, error) {
+	c.once.Do(func() {
+		if c.max > 0 {
+			stats, err := c.db.HostStats()
+			if err != nil {
+				c.err = err
+				return
+			}
+			if stats.Total > c.max {
+				c.err = fmt.Errorf("cluster has %d hosts, more than provision.template_max_hosts %d", stats.Total, c.max)
+				return
+			}
+		}
+
+		hosts, err := c.db.Hosts()
+		if err != nil {
+			c.err = err
+			return
+		}
+		slices.SortFunc(hosts, func(a, b *model.Host) int {
+			return strings.Compare(a.Name, b.Name)
+		})
+		c.hosts = hosts
+	})
+
+	return c.hosts, c.err
+}
+
+// AllHosts returns every host sorted by name
+func (c *Cluster) AllHosts() (model.HostList, error) {
+	return c.load()
+}
+
+// HostsByTag returns the hosts with tag sorted by name
+func (c *Cluster) HostsByTag(tag string) (model.HostList, error) {
+	hosts, err := c.load()
+	if err != nil {
+		return nil, err
+	}
+
+	tagged := make(model.HostList, 0)
+	for _, host := range hosts {
+		if host.HasTags(tag) {
+			tagged = append(tagged, host)
+		}
+	}
+
+	return tagged, nil
+}
+
+// HostsFileLines returns the /etc/hosts lines of the interfaces and bonds of
+// hosts with an address and FQDN: the address, the FQDNs and the short name
+func HostsFileLines(hosts model.HostList) string {
+	var b strings.Builder
+	for _, host := range hosts {
+		for _, line := range host.HostsFileLines() {
+			b.WriteString(line)
+			b.WriteString("\n")
+		}
+	}
+
+	return b.String()
+}
+
+// Nodeset returns the names of hosts collapsed into a nodeset, such as
+// cpn-[01-04]
+func Nodeset(hosts model.HostList) (string, error) {
+	ns := nodeset.EmptyNodeSet()
+	for _, host := range hosts {
+		if err := ns.Add(host.Name); err != nil {
+			return "", err
+		}
+	}
+
+	return ns.String(), nil
+}
@@ -4,9 +4,28 @@
 
 package provision
 
+import "time"
+
 const (
 	ContextKeyToken     = "token"
 	ContextKeyBootImage = "bootimage"
 	ContextKeyHost      = "host"
 	ContextKeyNIC       = "nic"
+	ContextKeyLog       = "log"
+	ContextKeyStore     = "store"
+
+	// tokenPathPrefix is followed by the boot token in the path of the boot
+	// routes, redacted from logs
+	tokenPathPrefix = "/boot/"
+
+	// DefaultMaintenancePage is the body of the responses to provisioning
+	// requests in maintenance mode when provision.maintenance_page is not set
+	DefaultMaintenancePage = "Grendel is in maintenance mode and provisioning is paused, try again later\n"
+
+	// DefaultStoreTimeout is the deadline of the datastore operations of a
+	// request unless provision.store_timeout is set
+	DefaultStoreTimeout = 10 * time.Second
+
+	// HeaderBootID echoes the boot ID of the boot token in responses
+	HeaderBootID = "X-Grendel-Boot-Id"
 )
@@ -14,6 +14,8 @@ const (
 	endpointPrefix             string = "boot"
 	endpointRepo                      = "repo"
 	endpointComplete                  = "complete"
+	endpointInventory                 = "inventory"
+	endpointClientCert                = "client-cert"
 	endpointIPXE                      = "ipxe"
 	endpointKickstart                 = "kickstart"
 	endpointKernel                    = "file/kernel"
@@ -26,6 +28,7 @@ const (
 	endpointVendorData                = "cloud-init/vendor-data"
 	endpointIgnition                  = "pxe-config.ign"
 	endpointProvision                 = "provision/"
+	endpointExtra                     = "extra/"
 	endpointProxmox                   = "proxmox"
 	endpointNetBoxRenderConfig        = "netbox/render-config"
 )
@@ -48,14 +51,15 @@ func (e *Endpoints) RepoURL() string {
 }
 
 func (e *Endpoints) BaseURL() string {
+	cfg := config.Current()
 	host := e.host
-	if config.ProvisionHostname != "" {
-		host = config.ProvisionHostname
+	if cfg.ProvisionHostname != "" {
+		host = cfg.ProvisionHostname
 	}
 
-	baseURL := fmt.Sprintf("%s://%s", config.ProvisionScheme, host)
-	if config.ProvisionAddr.Port() != 80 && config.ProvisionAddr.Port() != 443 {
-		baseURL += fmt.Sprintf(":%d", config.ProvisionAddr.Port())
+	baseURL := fmt.Sprintf("%s://%s", cfg.ProvisionScheme, host)
+	if cfg.ProvisionAddr.Port() != 80 && cfg.ProvisionAddr.Port() != 443 {
+		baseURL += fmt.Sprintf(":%d", cfg.ProvisionAddr.Port())
 	}
 
 	return baseURL
@@ -69,6 +73,14 @@ func (e *Endpoints) CompleteURL() string {
 	return e.provisionURL(endpointComplete)
 }
 
+func (e *Endpoints) InventoryURL() string {
+	return e.provisionURL(endpointInventory)
+}
+
+func (e *Endpoints) ClientCertURL() string {
+	return e.provisionURL(endpointClientCert)
+}
+
 func (e *Endpoints) IpxeURL() string {
 	return e.provisionURL(endpointIPXE)
 }
@@ -121,6 +133,14 @@ func (e *Endpoints) ProvisionURL(name string) string {
 	return e.provisionURL(endpointProvision + name)
 }
 
+func (e *Endpoints) ExtraListURL() string {
+	return e.provisionURL(endpointExtra)
+}
+
+func (e *Endpoints) ExtraURL(name string) string {
+	return e.provisionURL(endpointExtra + name)
+}
+
 func (e *Endpoints) ProxmoxURL() string {
 	return e.provisionURL(endpointProxmox)
 }
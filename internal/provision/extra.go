@@ -0,0 +1,104 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package provision
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+	"sync"
+
+	"github.com/labstack/echo/v4"
+	"github.com/ubccr/grendel/internal/store"
+	"github.com/ubccr/grendel/pkg/model"
+	"github.com/ubccr/grendel/pkg/nodeset"
+)
+
+// ExtraFiles gives templates the files attached to the host as .extra, such as
+// {{ range .extra.List }}curl -o /etc/{{ .Name }} {{ $.endpoints.ExtraURL .Name }}{{ end }}.
+// The files are listed without their data on first use and kept for the
+// request
+type ExtraFiles struct {
+	db    store.Store
+	host  string
+	once  sync.Once
+	files model.HostFileList
+	err   error
+}
+
+func newExtraFiles(db store.Store, host string) *ExtraFiles {
+	return &ExtraFiles{db: db, host: host}
+}
+
+// List returns the files attached to the host sorted by name
+func (e *ExtraFiles) List() (model.HostFileList, error) {
+	e.once.Do(func() {
+		ns, err := nodeset.NewNodeSet(e.host)
+		if err != nil {
+			e.err = err
+			return
+		}
+		e.files, e.err = e.db.FindHostFiles(ns)
+	})
+
+	return e.files, e.err
+}
+
+// Names returns the names of the files attached to the host
+func (e *ExtraFiles) Names() ([]string, error) {
+	files, err := e.List()
+	if err != nil {
+		return nil, err
+	}
+
+	names := make([]string, 0, len(files))
+	for _, f := range files {
+		names = append(names, f.Name)
+	}
+
+	return names, nil
+}
+
+// ExtraList sends the names of the files attached to the host, one per line
+func (h *Handler) ExtraList(c echo.Context) error {
+	_, host, _, data, err := h.verifyClaims(c)
+	if err != nil {
+		return err
+	}
+
+	names, err := data["extra"].(*ExtraFiles).Names()
+	if err != nil {
+		return err
+	}
+
+	requestLog(c).Infof("Sending list of %d extra files to host %s", len(names), host.Name)
+
+	var b strings.Builder
+	for _, name := range names {
+		b.WriteString(name + "\n")
+	}
+
+	return c.String(http.StatusOK, b.String())
+}
+
+// Extra sends the file attached to the host with the name of the request
+func (h *Handler) Extra(c echo.Context) error {
+	_, host, _, _, err := h.verifyClaims(c)
+	if err != nil {
+		return err
+	}
+
+	file, err := h.db(c).LoadHostFile(host.Name, c.Param("name"))
+	if errors.Is(err, store.ErrNotFound) {
+		return echo.NewHTTPError(http.StatusNotFound, "")
+	}
+	if err != nil {
+		return err
+	}
+
+	requestLog(c).Infof("Sending extra file %s to host %s", file.Name, host.Name)
+	c.Response().Header().Set(echo.HeaderLastModified, file.UpdatedAt.UTC().Format(http.TimeFormat))
+	return c.Blob(http.StatusOK, echo.MIMEOctetStream, file.Data)
+}
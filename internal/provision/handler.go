@@ -19,7 +19,17 @@ import (
 	"github.com/labstack/echo/v4"
 	"github.com/sirupsen/logrus"
 	"github.com/spf13/viper"
+	"github.com/ubccr/grendel/internal/boothook"
+	"github.com/ubccr/grendel/internal/certs"
+	"github.com/ubccr/grendel/internal/config"
+	"github.com/ubccr/grendel/internal/imagegc"
+	"github.com/ubccr/grendel/internal/logger"
+	"github.com/ubccr/grendel/internal/maintenance"
+	"github.com/ubccr/grendel/internal/stats"
 	"github.com/ubccr/grendel/internal/store"
+	"github.com/ubccr/grendel/internal/store/eventstore"
+	"github.com/ubccr/grendel/internal/store/namespace"
+	"github.com/ubccr/grendel/internal/webhook"
 	"github.com/ubccr/grendel/pkg/model"
 	"github.com/ubccr/grendel/pkg/netbox"
 )
@@ -29,11 +39,24 @@ var netBoxClient *netbox.Client
 type Handler struct {
 	DB               store.Store
 	DefaultImageName string
+
+	// Namespaces are selected by the subnet of the client, only DB is
+	// used when nil
+	Namespaces *namespace.Registry
+
+	// MaintenancePage is the body of the 503 responses in maintenance mode,
+	// DefaultMaintenancePage when empty
+	MaintenancePage []byte
+
+	// Hooks run on the boot events of hosts, none when nil
+	Hooks *boothook.Runner
 }
 
 func init() {
 	viper.SetDefault("provision.enable_prometheus_sd", false)
 	viper.SetDefault("provision.prometheus_sd_refresh_interval", "3600")
+	viper.SetDefault("provision.template_max_hosts", DefaultTemplateMaxHosts)
+	viper.SetDefault("provision.states", DefaultStates)
 	netBoxClient = netbox.NewClient()
 }
 
@@ -44,7 +67,7 @@ func NewHandler(db store.Store, defaultImageName string) (*Handler, error) {
 	}
 
 	if defaultImageName != "" {
-		_, err := h.DB.LoadBootImage(defaultImageName)
+		_, err := model.ResolveBootImage(defaultImageName, h.DB.LoadBootImage)
 		if err != nil {
 			return nil, err
 
@@ -54,28 +77,34 @@ func NewHandler(db store.Store, defaultImageName string) (*Handler, error) {
 	return h, nil
 }
 
-func (h *Handler) LoadBootImageWithDefault(name string) (*model.BootImage, error) {
+// LoadBootImageWithDefault returns the boot image name of db, or the
+// provision.default_image when name is empty
+func (h *Handler) LoadBootImageWithDefault(db store.Store, name string) (*model.BootImage, error) {
 	if name == "" && h.DefaultImageName == "" {
 		log.Warn("Cannot find boot image! Please either set a boot_image on the node or a default in the config file under provision.default_image")
 	} else if name == "" {
 		name = h.DefaultImageName
 	}
 
-	return h.DB.LoadBootImage(name)
+	// Images are resolved at each request so a change to a parent image
+	// is served at once by the images inheriting it
+	return model.ResolveBootImage(name, db.LoadBootImage)
 }
 
 func (h *Handler) SetupRoutes(e *echo.Echo) {
 	e.GET("/", h.Index).Name = "index"
 	e.GET("/onie-installer*", h.Onie).Name = "onie"
 	e.GET("/onie-updater*", h.Onie).Name = "onie"
-	if viper.GetBool("provision.enable_prometheus_sd") {
+	if config.Viper().GetBool("provision.enable_prometheus_sd") {
 		e.GET("/service-discovery/:tag/:port", h.ServiceDiscovery).Name = "sd"
 		e.GET("/pdu-service-discovery/:tag/:port", h.PDUServiceDiscovery).Name = "psd"
 	}
 
 	boot := e.Group("/boot/:token/")
-	boot.Use(TokenRequired)
+	boot.Use(TokenRequired, h.TokenNotRevoked, h.InMaintenance)
 	boot.POST("complete", h.Complete)
+	boot.POST("inventory", h.Inventory)
+	boot.GET("client-cert", h.ClientCert)
 	boot.GET("ipxe", h.Ipxe)
 	boot.GET("kickstart", h.Kickstart)
 	boot.GET("file/kernel*", h.File)
@@ -88,9 +117,11 @@ func (h *Handler) SetupRoutes(e *echo.Echo) {
 	boot.GET("cloud-init/vendor-data", h.VendorData)
 	boot.GET("pxe-config.ign", h.Ignition)
 	boot.GET("provision/:name", h.ProvisionTemplate)
+	boot.GET("extra/", h.ExtraList)
+	boot.GET("extra/:name", h.Extra)
 	boot.GET("bmc/:name", h.BmcTemplate)
 	boot.POST("proxmox", h.Proxmox)
-	if viper.IsSet("provision.netbox_token") && viper.IsSet("provision.netbox_url") {
+	if config.Viper().IsSet("provision.netbox_token") && config.Viper().IsSet("provision.netbox_url") {
 		boot.GET("netbox/render-config", h.NetBoxRenderConfig)
 	}
 }
@@ -103,84 +134,95 @@ func (h *Handler) Index(c echo.Context) error {
 }
 
 func (h *Handler) verifyClaims(c echo.Context) (*model.BootImage, *model.Host, *model.NetInterface, map[string]interface{}, error) {
+	return h.verifyClaimsCert(c, false)
+}
+
+// verifyClaimsCert verifies the boot claims of the request. A host with a
+// client certificate must present it unless certOptional is set
+func (h *Handler) verifyClaimsCert(c echo.Context, certOptional bool) (*model.BootImage, *model.Host, *model.NetInterface, map[string]interface{}, error) {
 	claims := c.Get(ContextKeyToken).(*model.BootClaims)
 
+	log := requestLog(c)
 	log.Debugf("Got valid boot claims: %v", claims)
 
-	host, err := h.DB.LoadHostFromID(claims.ID)
+	host, err := h.db(c).LoadHostFromID(claims.ID)
 	if err != nil {
-		log.WithFields(logrus.Fields{
-			"host_id": claims.ID,
-			"mac":     claims.MAC,
-		}).Error("failed to find host")
+		log.WithField("host_id", claims.ID).Error("failed to find host")
 		return nil, nil, nil, nil, echo.NewHTTPError(http.StatusBadRequest, "invalid host").SetInternal(err)
 	}
 
-	if !host.Provision {
-		log.WithFields(logrus.Fields{
-			"host_id": claims.ID,
-			"mac":     claims.MAC,
-		}).Error("host is not set to provision")
+	log = log.WithField(logger.FieldHost, host.Name)
+	c.Set(ContextKeyLog, log)
+	c.Set(ContextKeyHost, host.Name)
+
+	if host.ClientCertSerial != "" && certs.Revocations.RevokedSerial(host.ClientCertSerial) {
+		log.WithField("serial", host.ClientCertSerial).Warn("Rejected host with a revoked client certificate")
+		return nil, nil, nil, nil, echo.NewHTTPError(http.StatusForbidden, "client certificate of host has been revoked")
+	}
+
+	if err := verifyClientCert(c.Request(), host, certOptional); err != nil {
+		log.WithField("err", err).Error("client certificate does not match host")
+		return nil, nil, nil, nil, echo.NewHTTPError(http.StatusForbidden, "client certificate does not match host").SetInternal(err)
+	}
+
+	if !provisioning(host) {
+		log.WithField("host_id", claims.ID).Error("host is not set to provision")
 		return nil, nil, nil, nil, echo.NewHTTPError(http.StatusBadRequest, "host not set to provision")
 	}
 
 	mac, err := net.ParseMAC(claims.MAC)
 	if err != nil {
-		log.WithFields(logrus.Fields{
-			"host_id": claims.ID,
-			"mac":     claims.MAC,
-		}).Error("got invalid mac address")
+		log.WithField("host_id", claims.ID).Error("got invalid mac address")
 		return nil, nil, nil, nil, echo.NewHTTPError(http.StatusBadRequest, "invalid mac address").SetInternal(err)
 	}
 
 	nic := host.Interface(mac)
 	if nic == nil {
-		log.WithFields(logrus.Fields{
-			"host_id": claims.ID,
-			"mac":     claims.MAC,
-		}).Error("got invalid boot interface for host")
+		log.WithField("host_id", claims.ID).Error("got invalid boot interface for host")
 		return nil, nil, nil, nil, echo.NewHTTPError(http.StatusBadRequest, "invalid boot interface").SetInternal(err)
 	}
 
-	bootImage, err := h.LoadBootImageWithDefault(host.BootImage)
+	bootImage, err := h.LoadBootImageWithDefault(h.db(c), host.BootImage)
 	if err != nil {
-		log.WithFields(logrus.Fields{
-			"host_id": claims.ID,
-			"mac":     claims.MAC,
-		}).Error("failed to find boot image for host")
+		log.WithField("host_id", claims.ID).Error("failed to find boot image for host")
 		return nil, nil, nil, nil, echo.NewHTTPError(http.StatusBadRequest, "invalid boot image").SetInternal(err)
 	}
 
 	token := c.Param("token")
 	serverHost := c.Request().Host
 	endpoints := NewEndpoints(serverHost, token)
+	tokenID, _ := model.BootTokenID(token)
 
-	log.WithFields(logrus.Fields{
-		"host":    host.Name,
-		"headers": c.Request().Header,
-	}).Debug("HTTP request headers")
+	log.WithField("headers", c.Request().Header).Debug("HTTP request headers")
 
 	data := map[string]interface{}{
 		"token":           c.Param("token"),
+		"bootID":          claims.BootID,
 		"endpoints":       endpoints,
 		"bootimage":       bootImage,
 		"nic":             nic,
 		"host":            host,
+		"Host":            newHostVars(host, nic),
 		"headers":         c.Request().Header,
-		"rootpw":          viper.GetString("provision.root_password"),
-		"adminSSHPubKeys": viper.GetStringSlice("admin_ssh_pubkeys"),
+		"rootpw":          config.Viper().GetString("provision.root_password"),
+		"adminSSHPubKeys": config.Viper().GetStringSlice("admin_ssh_pubkeys"),
+		"cluster":         newCluster(h.db(c), config.Viper().GetInt("provision.template_max_hosts")),
+		"extra":           newExtraFiles(h.db(c), host.Name),
+		"secrets":         newSecretResolver(h.db(c), tokenID, host.Name, log),
 	}
 
 	return bootImage, host, nic, data, nil
 }
 
 func (h *Handler) Ipxe(c echo.Context) error {
-	bootImage, host, _, data, err := h.verifyClaims(c)
+	bootImage, host, nic, data, err := h.verifyClaims(c)
 	if err != nil {
 		return err
 	}
 
-	log.Infof("Sending iPXE script to boot host %s with image %s", host.Name, bootImage.Name)
+	requestLog(c).Infof("Sending iPXE script to boot host %s with image %s", host.Name, bootImage.Name)
+	h.startInstall(c, host)
+	h.Hooks.Fire(boothook.IpxeFetched, host, nic, bootImage.Name)
 
 	commandLine := bootImage.CommandLine
 
@@ -211,16 +253,16 @@ func (h *Handler) File(c echo.Context) error {
 
 	_, fileType := path.Split(c.Request().URL.Path)
 
-	log.Infof("Got request for file %q from host %s %s", fileType, host.Name, c.RealIP())
+	requestLog(c).Infof("Got request for file %q from host %s %s", fileType, host.Name, c.RealIP())
 
 	switch {
 	case fileType == "kernel":
-		return c.File(bootImage.KernelPath)
+		return serveFile(c, bootImage.KernelPath)
 	case fileType == "kernel.sig":
-		return c.File(bootImage.KernelPath + ".sig")
+		return serveFile(c, bootImage.KernelPath+".sig")
 
 	case fileType == "liveimg":
-		return c.File(bootImage.LiveImage)
+		return serveFile(c, bootImage.LiveImage)
 
 	case strings.HasPrefix(fileType, "initrd-"):
 		initrdBaseName := strings.TrimSuffix(fileType, ".sig")
@@ -232,19 +274,27 @@ func (h *Handler) File(c echo.Context) error {
 		if strings.HasSuffix(fileType, ".sig") {
 			initrd += ".sig"
 		}
-		return c.File(initrd)
+		return serveFile(c, initrd)
 	}
 
 	return echo.NewHTTPError(http.StatusNotFound, "")
 }
 
+// serveFile sends the boot image file at path, recording the transfer so the
+// file is not removed by image gc while being sent
+func serveFile(c echo.Context, path string) error {
+	defer imagegc.Serve(path)()
+
+	return c.File(path)
+}
+
 func (h *Handler) serveBlob(c echo.Context, name string, data []byte) error {
 	http.ServeContent(c.Response(), c.Request(), name, time.Time{}, bytes.NewReader(data))
 	return nil
 }
 
 func (h *Handler) Kickstart(c echo.Context) error {
-	bootImage, _, _, data, err := h.verifyClaims(c)
+	bootImage, host, nic, data, err := h.verifyClaims(c)
 	if err != nil {
 		return err
 	}
@@ -253,35 +303,132 @@ func (h *Handler) Kickstart(c echo.Context) error {
 	if !ok {
 		tmplName = "kickstart.tmpl"
 	}
+	h.Hooks.Fire(boothook.KickstartFetched, host, nic, bootImage.Name)
 
 	return c.Render(http.StatusOK, tmplName, data)
 }
 
 func (h *Handler) Complete(c echo.Context) error {
-	_, host, _, _, err := h.verifyClaims(c)
+	bootImage, host, nic, _, err := h.verifyClaims(c)
 	if err != nil {
 		return err
 	}
 
-	log.Infof("Unprovisioning host %s", host.Name)
+	requestLog(c).Infof("Unprovisioning host %s", host.Name)
 
+	host.State = model.HostStateProduction
 	host.Provision = false
 
-	err = h.DB.StoreHost(host)
+	err = h.db(c).StoreHost(host)
 	if err != nil {
-		log.WithFields(logrus.Fields{
-			"uid":  host.UID,
-			"name": host.Name,
-		}).Error("failed to unprovision host")
-		return echo.NewHTTPError(http.StatusInternalServerError, "failed to unprovision host").SetInternal(err)
+		requestLog(c).WithField("uid", host.UID).WithField("err", err).Error("failed to unprovision host")
+		return storeError(err, "failed to unprovision host")
+	}
+
+	event := model.Event{
+		Severity:  model.SeveritySuccess.String(),
+		Time:      time.Now().UTC(),
+		User:      "provision",
+		Message:   fmt.Sprintf("Host %s finished provisioning", host.Name),
+		Namespace: namespace.Of(h.namespaceDB(c)),
+	}
+	eventstore.Default.StoreEvents(event)
+	webhook.Notify(webhook.ProvisionComplete, []string{host.Name}, event)
+	h.Hooks.Fire(boothook.Complete, host, nic, bootImage.Name)
+	stats.ProvisionCompletions.Inc()
+
+	resp := map[string]interface{}{
+		"status": "ok",
+	}
+	return c.JSON(http.StatusOK, resp)
+}
+
+// storeError returns the error answered when the write of a request fails:
+// 403 Forbidden pointing to the primary when the datastore is read-only, 500
+// with msg otherwise
+func storeError(err error, msg string) *echo.HTTPError {
+	if errors.Is(err, store.ErrReadOnly) {
+		return echo.NewHTTPError(http.StatusForbidden, err.Error()).SetInternal(err)
+	}
+
+	return echo.NewHTTPError(http.StatusInternalServerError, msg).SetInternal(err)
+}
+
+// Inventory stores the firmware inventory reported by a host at provision
+// time, such as from a kickstart %post script
+func (h *Handler) Inventory(c echo.Context) error {
+	_, host, _, _, err := h.verifyClaims(c)
+	if err != nil {
+		return err
+	}
+
+	inv := &model.Inventory{}
+	if err := c.Bind(inv); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid inventory").SetInternal(err)
+	}
+	if inv.IsEmpty() {
+		return echo.NewHTTPError(http.StatusBadRequest, "inventory has no firmware versions")
+	}
+	if inv.CapturedAt.IsZero() {
+		inv.CapturedAt = time.Now().UTC().Truncate(time.Second)
 	}
 
+	err = h.db(c).StoreHostInventory(host.Name, inv)
+	if err != nil {
+		requestLog(c).WithField("uid", host.UID).WithField("err", err).Error("failed to store firmware inventory")
+		return storeError(err, "failed to store firmware inventory")
+	}
+
+	requestLog(c).Infof("Stored firmware inventory of host %s", host.Name)
+
 	resp := map[string]interface{}{
 		"status": "ok",
 	}
 	return c.JSON(http.StatusOK, resp)
 }
 
+// ClientCert serves the key and client certificate of a host once, as a PEM
+// bundle held by grendel certs client --serve until fetched or expired. The
+// host has no certificate to present before fetching it
+func (h *Handler) ClientCert(c echo.Context) error {
+	_, host, _, _, err := h.verifyClaimsCert(c, true)
+	if err != nil {
+		return err
+	}
+
+	bundle, ok := certs.Pickups.Take(host.Name, time.Now())
+	if !ok {
+		return echo.NewHTTPError(http.StatusNotFound, "no client certificate to fetch")
+	}
+
+	requestLog(c).Infof("Served client certificate of host %s", host.Name)
+
+	return c.Blob(http.StatusOK, "application/x-pem-file", bundle)
+}
+
+// verifyClientCert returns an error when the request presents a client
+// certificate whose fingerprint is not the one stored on host, or presents
+// none and host has one. With optional set requests without a client
+// certificate are not checked
+func verifyClientCert(r *http.Request, host *model.Host, optional bool) error {
+	if r.TLS == nil || len(r.TLS.PeerCertificates) == 0 {
+		if host.ClientCertFingerprint == "" || optional {
+			return nil
+		}
+		return fmt.Errorf("host has client certificate %s, got none", host.ClientCertFingerprint)
+	}
+
+	fingerprint := certs.Fingerprint(r.TLS.PeerCertificates[0])
+	if host.ClientCertFingerprint == "" {
+		return fmt.Errorf("host has no client certificate, got %s", fingerprint)
+	}
+	if !strings.EqualFold(fingerprint, host.ClientCertFingerprint) {
+		return fmt.Errorf("got client certificate %s, want %s", fingerprint, host.ClientCertFingerprint)
+	}
+
+	return nil
+}
+
 func (h *Handler) UserData(c echo.Context) error {
 	bootImage, host, _, data, err := h.verifyClaims(c)
 	if err != nil {
@@ -293,7 +440,7 @@ func (h *Handler) UserData(c echo.Context) error {
 		tmplName = "user-data.tmpl"
 	}
 
-	log.Infof("Sending cloud-init user-data to host %s", host.Name)
+	requestLog(c).Infof("Sending cloud-init user-data to host %s", host.Name)
 	c.Response().Header().Set(echo.HeaderContentType, "application/yaml; charset=utf-8")
 	return c.Render(http.StatusOK, tmplName, data)
 }
@@ -304,7 +451,7 @@ func (h *Handler) MetaData(c echo.Context) error {
 		return err
 	}
 
-	log.Infof("Sending cloud-init meta-data to host %s", host.Name)
+	requestLog(c).Infof("Sending cloud-init meta-data to host %s", host.Name)
 	c.Response().Header().Set(echo.HeaderContentType, "application/yaml; charset=utf-8")
 	return c.Render(http.StatusOK, "meta-data.tmpl", data)
 }
@@ -325,7 +472,7 @@ func (h *Handler) Ignition(c echo.Context) error {
 		tmplName = "butane.tmpl"
 	}
 
-	log.Infof("Sending ignition config to host %s", host.Name)
+	requestLog(c).Infof("Sending ignition config to host %s", host.Name)
 	renderer := c.Echo().Renderer.(*TemplateRenderer)
 	return renderer.RenderIgnition(http.StatusOK, tmplName, data, c)
 }
@@ -345,7 +492,7 @@ func (h *Handler) ProvisionTemplate(c echo.Context) error {
 		return echo.NewHTTPError(http.StatusNotFound, "")
 	}
 
-	log.Infof("Sending provision template %s to host %s", c.Param("name"), host.Name)
+	requestLog(c).Infof("Sending provision template %s to host %s", c.Param("name"), host.Name)
 	return c.Render(http.StatusOK, tmplName, data)
 }
 
@@ -360,7 +507,7 @@ func (h *Handler) BmcTemplate(c echo.Context) error {
 		return echo.NewHTTPError(http.StatusNotFound, "")
 	}
 
-	log.Infof("Sending bmc template %s to host %s", c.Param("name"), host.Name)
+	requestLog(c).Infof("Sending bmc template %s to host %s", c.Param("name"), host.Name)
 	return c.Render(http.StatusOK, tmplName, data)
 }
 
@@ -375,7 +522,7 @@ func (h *Handler) Proxmox(c echo.Context) error {
 		tmplName = "proxmox.tmpl"
 	}
 
-	log.Infof("Sending automated install answer file to host %s", host.Name)
+	requestLog(c).Infof("Sending automated install answer file to host %s", host.Name)
 	c.Response().Header().Set(echo.HeaderContentType, "application/yaml; charset=utf-8")
 	return c.Render(http.StatusOK, tmplName, data)
 }
@@ -390,7 +537,7 @@ func (h *Handler) Onie(c echo.Context) error {
 		return echo.NewHTTPError(http.StatusNotFound, "")
 	}
 
-	host, err := h.DB.LoadHostFromMAC(onie.MAC.String())
+	host, err := h.db(c).LoadHostFromMAC(onie.MAC.String())
 	if err != nil {
 		if errors.Is(err, store.ErrNotFound) {
 			log.Debugf("Ignoring unknown host mac address: %s", onie.MAC)
@@ -400,22 +547,31 @@ func (h *Handler) Onie(c echo.Context) error {
 		return echo.NewHTTPError(http.StatusNotFound, "")
 	}
 
-	if !host.Provision {
+	if !provisioning(host) {
 		return echo.NewHTTPError(http.StatusNotFound, "ONIE install requested but host not set to provision")
 	}
 
-	bootImage, err := h.LoadBootImageWithDefault(host.BootImage)
+	if maintenance.Enabled() {
+		return h.maintenanceResponse(c, host.Name, logrus.Fields{
+			logger.FieldMAC: onie.MAC.String(),
+			logger.FieldIP:  c.RealIP(),
+		})
+	}
+
+	bootImage, err := h.LoadBootImageWithDefault(h.db(c), host.BootImage)
 	if err != nil {
 		log.WithFields(logrus.Fields{
-			"host": host.Name,
-			"mac":  onie.MAC.String(),
+			logger.FieldHost: host.Name,
+			logger.FieldMAC:  onie.MAC.String(),
+			logger.FieldIP:   c.RealIP(),
 		}).Error("failed to find boot image for host")
 		return echo.NewHTTPError(http.StatusNotFound, "")
 	}
 
 	log.WithFields(logrus.Fields{
-		"host":                host.Name,
-		"mac":                 onie.MAC.String(),
+		logger.FieldHost:      host.Name,
+		logger.FieldMAC:       onie.MAC.String(),
+		logger.FieldIP:        c.RealIP(),
 		"bootimage":           bootImage.Name,
 		"onieOp":              onie.Operation,
 		"onieVendor":          onie.VendorID,
@@ -429,7 +585,7 @@ func (h *Handler) Onie(c echo.Context) error {
 	case OnieUpdate:
 		return c.File(onie.UpdaterFilePath())
 	case OnieInstall:
-		return c.File(bootImage.KernelPath)
+		return serveFile(c, bootImage.KernelPath)
 	}
 
 	return echo.NewHTTPError(http.StatusBadRequest, "Invalid ONIE operation")
@@ -452,10 +608,7 @@ func (h *Handler) NetBoxRenderConfig(c echo.Context) error {
 	defer res.Body.Close()
 
 	if res.StatusCode != 200 {
-		log.WithFields(logrus.Fields{
-			"name": node.Name,
-			"code": res.StatusCode,
-		}).Error("failed to fetch netbox render-config wrong http code")
+		requestLog(c).WithField("code", res.StatusCode).Error("failed to fetch netbox render-config wrong http code")
 		return echo.NewHTTPError(http.StatusInternalServerError, "failed to render config").SetInternal(err)
 	}
 
@@ -5,18 +5,41 @@
 package provision
 
 import (
+	"bytes"
+	"crypto/tls"
+	"crypto/x509"
 	"fmt"
+	"io"
+	"net"
 	"net/http"
 	"net/http/httptest"
+	"net/netip"
+	"os"
+	"path/filepath"
+	"strings"
 	"testing"
+	"text/template"
+	"time"
 
 	"github.com/labstack/echo/v4"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/sirupsen/logrus"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/spf13/viper"
 	"github.com/stretchr/testify/assert"
 	"github.com/tidwall/gjson"
+	"github.com/ubccr/grendel/internal/accesslog"
+	"github.com/ubccr/grendel/internal/boothook"
+	"github.com/ubccr/grendel/internal/certs"
+	"github.com/ubccr/grendel/internal/maintenance"
+	"github.com/ubccr/grendel/internal/ratelimit"
+	"github.com/ubccr/grendel/internal/secret"
 	"github.com/ubccr/grendel/internal/store"
+	"github.com/ubccr/grendel/internal/store/namespace"
 	"github.com/ubccr/grendel/internal/store/sqlstore"
 	"github.com/ubccr/grendel/internal/tests"
 	"github.com/ubccr/grendel/pkg/model"
+	"gopkg.in/yaml.v3"
 )
 
 func newTestDB(t *testing.T) store.Store {
@@ -31,12 +54,54 @@ func newTestDB(t *testing.T) store.Store {
 }
 
 func newTestEcho(t *testing.T) *echo.Echo {
-	e, err := newEcho()
+	renderer, err := NewTemplateRenderer()
 	if err != nil {
 		assert.Fail(t, err.Error())
 	}
 
-	return e
+	return newEcho(renderer)
+}
+
+func TestNamespaceTemplates(t *testing.T) {
+	assert := assert.New(t)
+
+	dir := t.TempDir()
+	assert.NoError(os.WriteFile(filepath.Join(dir, "ipxe.tmpl"), []byte("#!ipxe\necho cluster a\n"), 0644))
+
+	def := newTestDB(t)
+	registry := namespace.NewRegistry(def, []*namespace.Namespace{
+		{Name: "a", Subnets: []netip.Prefix{netip.MustParsePrefix("10.1.0.0/16")}, TemplateDir: dir},
+		{Name: "b", Subnets: []netip.Prefix{netip.MustParsePrefix("10.2.0.0/16")}},
+	}, map[string]store.Store{"a": newTestDB(t), "b": newTestDB(t)})
+	defer registry.Close()
+
+	renderer, err := NewTemplateRenderer()
+	assert.NoError(err)
+	assert.NoError(renderer.SetNamespaces(registry))
+	assert.NoError(renderer.Check())
+
+	render := func(remoteAddr string) string {
+		e := newEcho(renderer)
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		req.RemoteAddr = remoteAddr
+		rec := httptest.NewRecorder()
+		var buf bytes.Buffer
+		assert.NoError(renderer.Render(&buf, "ipxe.tmpl", map[string]interface{}{}, e.NewContext(req, rec)))
+		return buf.String()
+	}
+
+	// The templates of a namespace replace those of the same name for its hosts
+	assert.Contains(render("10.1.0.5:4000"), "echo cluster a")
+	assert.NotContains(render("10.2.0.5:4000"), "echo cluster a")
+	assert.NotContains(render("192.168.0.5:4000"), "echo cluster a")
+
+	assert.Equal(dir, NamespaceTemplateDir(registry.Namespaces()[0]))
+	assert.Equal(filepath.Join(TemplateDir, "b"), NamespaceTemplateDir(registry.Namespaces()[1]))
+
+	// Invalid templates of a namespace fail the reload
+	assert.NoError(os.WriteFile(filepath.Join(dir, "ipxe.tmpl"), []byte("{{ .Host"), 0644))
+	_, err = renderer.Reload()
+	assert.ErrorContains(err, "namespace a")
 }
 
 func TestStatus(t *testing.T) {
@@ -122,6 +187,145 @@ func TestIpxe(t *testing.T) {
 	if assert.NoError(TokenRequired(h.Ipxe)(c)) {
 		assert.Equal(http.StatusOK, rec.Code)
 		assert.Contains(rec.Body.String(), "#!ipxe")
+		assert.Empty(rec.Header().Get(HeaderBootID))
+	}
+
+	// Fetching the boot script starts the install
+	hostTest, err := h.DB.LoadHostFromID(host.UID.String())
+	if assert.NoError(err) {
+		assert.Equal(model.HostStateInstalling, hostTest.State)
+		assert.True(hostTest.Provision)
+	}
+}
+
+func TestIpxeBootID(t *testing.T) {
+	assert := assert.New(t)
+
+	h := &Handler{DB: newTestDB(t)}
+
+	image := tests.BootImageFactory.MustCreate().(*model.BootImage)
+	err := h.DB.StoreBootImage(image)
+	assert.NoError(err)
+
+	host := tests.HostFactory.MustCreate().(*model.Host)
+	host.BootImage = image.Name
+	host.Provision = true
+	err = h.DB.StoreHost(host)
+	assert.NoError(err)
+
+	token, err := model.NewTracedBootToken(host.UID.String(), host.Interfaces[0].MAC.String(), "0123456789abcdef")
+	assert.NoError(err)
+
+	e := newTestEcho(t)
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.SetPath("/boot/:token/ipxe")
+	c.SetParamNames("token")
+	c.SetParamValues(token)
+
+	if assert.NoError(TokenRequired(h.Ipxe)(c)) {
+		assert.Equal(http.StatusOK, rec.Code)
+		assert.Equal("0123456789abcdef", rec.Header().Get(HeaderBootID))
+		// The iPXE script chains with the same token, keeping the boot ID
+		assert.Contains(rec.Body.String(), token)
+	}
+}
+
+func TestRateLimit(t *testing.T) {
+	assert := assert.New(t)
+
+	e := newTestEcho(t)
+	e.Use(RateLimit(ratelimit.New("provision", ratelimit.Config{TokenRate: 1})))
+	e.GET("/boot/:token/ipxe", func(c echo.Context) error {
+		return c.String(http.StatusOK, "ok")
+	})
+
+	get := func(token string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, "/boot/"+token+"/ipxe", nil)
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, req)
+		return rec
+	}
+
+	assert.Equal(http.StatusOK, get("a").Code)
+	rec := get("a")
+	assert.Equal(http.StatusTooManyRequests, rec.Code)
+	assert.Equal("1", rec.Header().Get("Retry-After"))
+	assert.Equal(http.StatusOK, get("b").Code)
+}
+
+func TestStoreDeadline(t *testing.T) {
+	assert := assert.New(t)
+
+	h := &Handler{DB: newTestDB(t)}
+	e := newTestEcho(t)
+	e.Use(StoreDeadline(time.Nanosecond))
+	e.GET("/hosts", func(c echo.Context) error {
+		time.Sleep(time.Millisecond)
+		_, err := h.db(c).Hosts()
+		if err != nil {
+			return err
+		}
+		return c.String(http.StatusOK, "ok")
+	})
+
+	req := httptest.NewRequest(http.MethodGet, "/hosts", nil)
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+	assert.Equal(http.StatusServiceUnavailable, rec.Code)
+}
+
+func TestRevokedBootToken(t *testing.T) {
+	assert := assert.New(t)
+
+	h := &Handler{DB: newTestDB(t)}
+
+	image := tests.BootImageFactory.MustCreate().(*model.BootImage)
+	err := h.DB.StoreBootImage(image)
+	assert.NoError(err)
+
+	host := tests.HostFactory.MustCreate().(*model.Host)
+	host.BootImage = image.Name
+	host.Provision = true
+	err = h.DB.StoreHost(host)
+	assert.NoError(err)
+
+	token, err := model.NewBootToken(host.UID.String(), host.Interfaces[0].MAC.String())
+	assert.NoError(err)
+
+	info, err := model.InspectBootToken(token)
+	assert.NoError(err)
+	err = h.DB.RevokeBootToken(info)
+	assert.NoError(err)
+
+	e := newTestEcho(t)
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.SetPath("/boot/:token/ipxe")
+	c.SetParamNames("token")
+	c.SetParamValues(token)
+
+	err = TokenRequired(h.TokenNotRevoked(h.Ipxe))(c)
+	if assert.Error(err) {
+		he, ok := err.(*echo.HTTPError)
+		if assert.True(ok) {
+			assert.Equal(http.StatusForbidden, he.Code)
+		}
+	}
+
+	other, err := model.NewBootToken(host.UID.String(), host.Interfaces[0].MAC.String())
+	assert.NoError(err)
+
+	rec = httptest.NewRecorder()
+	c = e.NewContext(req, rec)
+	c.SetPath("/boot/:token/ipxe")
+	c.SetParamNames("token")
+	c.SetParamValues(other)
+
+	if assert.NoError(TokenRequired(h.TokenNotRevoked(h.Ipxe))(c)) {
+		assert.Equal(http.StatusOK, rec.Code)
 	}
 }
 
@@ -243,6 +447,553 @@ func TestKickstart(t *testing.T) {
 	}
 }
 
+// countingStore counts the loads of all hosts
+type countingStore struct {
+	store.Store
+	loads int
+}
+
+func (s *countingStore) Hosts() (model.HostList, error) {
+	s.loads++
+	return s.Store.Hosts()
+}
+
+func TestClusterHosts(t *testing.T) {
+	assert := assert.New(t)
+
+	db := &countingStore{Store: newTestDB(t)}
+	h := &Handler{DB: db}
+
+	image := tests.BootImageFactory.MustCreate().(*model.BootImage)
+	assert.NoError(h.DB.StoreBootImage(image))
+
+	for i, tags := range [][]string{{"compute"}, {"compute", "gpu"}, {"login"}} {
+		name := fmt.Sprintf("cpn-%02d", i+1)
+		host := &model.Host{
+			Name:      name,
+			BootImage: image.Name,
+			Provision: true,
+			Tags:      tags,
+			Interfaces: []*model.NetInterface{{
+				MAC:  net.HardwareAddr{0xd0, 0x94, 0x66, 0, 0, byte(i + 1)},
+				IP:   netip.MustParsePrefix(fmt.Sprintf("10.0.0.%d/24", i+1)),
+				FQDN: name + ".example.com",
+			}},
+		}
+		assert.NoError(h.DB.StoreHost(host))
+	}
+
+	host, err := h.DB.LoadHostFromName("cpn-01")
+	assert.NoError(err)
+	token, err := model.NewBootToken(host.UID.String(), host.Interfaces[0].MAC.String())
+	assert.NoError(err)
+
+	e := newTestEcho(t)
+	c := e.NewContext(httptest.NewRequest(http.MethodGet, "/", nil), httptest.NewRecorder())
+	claims, err := model.ParseBootToken(token)
+	assert.NoError(err)
+	c.Set(ContextKeyToken, claims)
+
+	_, _, _, data, err := h.verifyClaims(c)
+	if !assert.NoError(err) {
+		return
+	}
+
+	tmpl := template.Must(template.New("hosts").Funcs(funcMap).Parse(
+		`{{ $compute := .cluster.HostsByTag "compute" }}{{ Nodeset $compute }}
+{{ HostsFileLines $compute }}{{ len .cluster.AllHosts }}`))
+	var buf bytes.Buffer
+	assert.NoError(tmpl.Execute(&buf, data))
+	assert.Equal("cpn-[01-02]\n10.0.0.1 cpn-01.example.com cpn-01\n10.0.0.2 cpn-02.example.com cpn-02\n3", buf.String())
+	assert.Equal(1, db.loads)
+
+	_, err = newCluster(db, 2).AllHosts()
+	assert.ErrorContains(err, "cluster has 3 hosts, more than provision.template_max_hosts 2")
+}
+
+func TestHostVars(t *testing.T) {
+	assert := assert.New(t)
+
+	h := &Handler{DB: newTestDB(t)}
+
+	image := tests.BootImageFactory.MustCreate().(*model.BootImage)
+	assert.NoError(h.DB.StoreBootImage(image))
+
+	tmpl := template.Must(template.New("host").Funcs(funcMap).Parse(
+		`{{ .Host.Name }} bmc={{ .Host.BMC.Address }} {{ .Host.BMC.FQDN }} {{ .Host.BMC.MAC }} has={{ .Host.HasBMC }}
+lldp={{ .Host.Switch }}:{{ .Host.Port }}{{ range .Host.Links }} {{ .Name }}={{ .Switch }}:{{ .Port }}{{ end }}
+rack={{ .Host.Rack }} row={{ .Host.Row }} pdu={{ .Host.Var "pdu" "none" }} groups={{ Join .Host.Groups "," }} gpu={{ .Host.InGroup "gpu" }}`))
+
+	render := func(host *model.Host) string {
+		host.BootImage = image.Name
+		host.Provision = true
+		assert.NoError(h.DB.StoreHost(host))
+
+		token, err := model.NewBootToken(host.UID.String(), host.Interfaces[0].MAC.String())
+		assert.NoError(err)
+		claims, err := model.ParseBootToken(token)
+		assert.NoError(err)
+
+		c := newTestEcho(t).NewContext(httptest.NewRequest(http.MethodGet, "/", nil), httptest.NewRecorder())
+		c.Set(ContextKeyToken, claims)
+		_, _, _, data, err := h.verifyClaims(c)
+		if !assert.NoError(err) {
+			return ""
+		}
+
+		var buf bytes.Buffer
+		assert.NoError(tmpl.Execute(&buf, data))
+		return buf.String()
+	}
+
+	out := render(&model.Host{
+		Name: "cpn-01",
+		Tags: []string{"compute", "rack=a01", "row:3", "gpu"},
+		Interfaces: []*model.NetInterface{
+			{
+				Name:   "eno1",
+				MAC:    net.HardwareAddr{0xd0, 0x94, 0x66, 0, 0, 1},
+				IP:     netip.MustParsePrefix("10.0.0.1/24"),
+				FQDN:   "cpn-01.example.com",
+				Switch: "swe-a01",
+				Port:   12,
+			},
+			{
+				MAC:  net.HardwareAddr{0xd0, 0x94, 0x66, 0, 1, 1},
+				IP:   netip.MustParsePrefix("10.1.0.1/24"),
+				FQDN: "bmc-cpn-01.example.com",
+				BMC:  true,
+			},
+		},
+	})
+	assert.Equal(`cpn-01 bmc=10.1.0.1 bmc-cpn-01.example.com d0:94:66:00:01:01 has=true
+lldp=swe-a01:12 eno1=swe-a01:12
+rack=a01 row=3 pdu=none groups=compute,gpu gpu=true`, out)
+
+	// A host without a BMC, switch or tags renders empty values
+	out = render(&model.Host{
+		Name: "cpn-02",
+		Interfaces: []*model.NetInterface{{
+			MAC:  net.HardwareAddr{0xd0, 0x94, 0x66, 0, 0, 2},
+			IP:   netip.MustParsePrefix("10.0.0.2/24"),
+			FQDN: "cpn-02.example.com",
+		}},
+	})
+	assert.Equal(`cpn-02 bmc=   has=false
+lldp=:0
+rack= row= pdu=none groups= gpu=false`, out)
+}
+
+func TestSecret(t *testing.T) {
+	assert := assert.New(t)
+
+	viper.Set("credentials_key", "test")
+	defer viper.Set("credentials_key", nil)
+
+	h := &Handler{DB: newTestDB(t)}
+
+	image := tests.BootImageFactory.MustCreate().(*model.BootImage)
+	assert.NoError(h.DB.StoreBootImage(image))
+	host := tests.HostFactory.MustCreate().(*model.Host)
+	host.BootImage = image.Name
+	host.Provision = true
+	assert.NoError(h.DB.StoreHost(host))
+
+	for _, s := range []struct {
+		name, value string
+		oneTime     bool
+	}{{"root-hash", "$6$hash", false}, {"luks-passphrase", "hunter2", true}} {
+		sealed, err := secret.SealString(s.value)
+		assert.NoError(err)
+		assert.NoError(h.DB.StoreSecret(&model.Secret{Name: s.name, Value: sealed, OneTime: s.oneTime}))
+	}
+
+	renderer, err := NewTemplateRenderer()
+	if !assert.NoError(err) {
+		return
+	}
+	template.Must(renderer.templates.New("secret.tmpl").Parse(
+		`{{ secret "root-hash" }} {{ secret "luks-passphrase" }} {{ secret "luks-passphrase" }}`))
+	e := newEcho(renderer)
+
+	hook := test.NewLocal(log.Logger)
+	defer log.Logger.ReplaceHooks(make(logrus.LevelHooks))
+
+	render := func(token string) (string, error) {
+		c := e.NewContext(httptest.NewRequest(http.MethodGet, "/", nil), httptest.NewRecorder())
+		c.SetParamNames("token")
+		c.SetParamValues(token)
+		claims, err := model.ParseBootToken(token)
+		assert.NoError(err)
+		c.Set(ContextKeyToken, claims)
+
+		_, _, _, data, err := h.verifyClaims(c)
+		if err != nil {
+			return "", err
+		}
+
+		var buf bytes.Buffer
+		err = renderer.Render(&buf, "secret.tmpl", data, c)
+		return buf.String(), err
+	}
+
+	token, err := model.NewBootToken(host.UID.String(), host.Interfaces[0].MAC.String())
+	assert.NoError(err)
+
+	// A one-time secret is rendered for the first request made with a token
+	out, err := render(token)
+	assert.NoError(err)
+	assert.Equal("$6$hash hunter2 hunter2", out)
+	out, err = render(token)
+	assert.NoError(err)
+	assert.Equal("$6$hash REDACTED REDACTED", out)
+
+	warned := false
+	for _, entry := range hook.AllEntries() {
+		if entry.Level == logrus.WarnLevel && entry.Data["secret"] == "luks-passphrase" {
+			warned = true
+		}
+		assert.NotContains(entry.Message, "hunter2")
+	}
+	assert.True(warned)
+
+	token, err = model.NewTracedBootToken(host.UID.String(), host.Interfaces[0].MAC.String(), "boot-2")
+	assert.NoError(err)
+	out, err = render(token)
+	assert.NoError(err)
+	assert.Equal("$6$hash hunter2 hunter2", out)
+
+	// Secrets are only available to provision requests
+	tmpl := template.Must(template.New("secret").Funcs(funcMap).Parse(`{{ secret "root-hash" }}`))
+	assert.ErrorContains(tmpl.Execute(io.Discard, nil), "only available to provision templates")
+}
+
+func TestExtra(t *testing.T) {
+	assert := assert.New(t)
+
+	h := &Handler{DB: newTestDB(t)}
+
+	image := tests.BootImageFactory.MustCreate().(*model.BootImage)
+	assert.NoError(h.DB.StoreBootImage(image))
+
+	host := tests.HostFactory.MustCreate().(*model.Host)
+	host.BootImage = image.Name
+	host.Provision = true
+	assert.NoError(h.DB.StoreHost(host))
+	assert.NoError(h.DB.StoreHostFiles(model.HostFileList{
+		{Host: host.Name, Name: "opa.conf", Data: []byte("Default=0x7fff,ipoib,mtu=4")},
+		{Host: host.Name, Name: "machine-id", Data: []byte("0123456789abcdef")},
+	}))
+
+	token, err := model.NewBootToken(host.UID.String(), host.Interfaces[0].MAC.String())
+	assert.NoError(err)
+
+	e := newTestEcho(t)
+	get := func(name string, handler echo.HandlerFunc) *httptest.ResponseRecorder {
+		rec := httptest.NewRecorder()
+		c := e.NewContext(httptest.NewRequest(http.MethodGet, "/", nil), rec)
+		c.SetPath("/boot/:token/extra/:name")
+		c.SetParamNames("token", "name")
+		c.SetParamValues(token, name)
+		err := TokenRequired(handler)(c)
+		if he, ok := err.(*echo.HTTPError); ok {
+			rec.Code = he.Code
+		} else {
+			assert.NoError(err)
+		}
+		return rec
+	}
+
+	rec := get("", h.ExtraList)
+	assert.Equal(http.StatusOK, rec.Code)
+	assert.Equal("machine-id\nopa.conf\n", rec.Body.String())
+
+	rec = get("opa.conf", h.Extra)
+	assert.Equal(http.StatusOK, rec.Code)
+	assert.Equal("Default=0x7fff,ipoib,mtu=4", rec.Body.String())
+	assert.Equal(echo.MIMEOctetStream, rec.Header().Get(echo.HeaderContentType))
+
+	rec = get("license.dat", h.Extra)
+	assert.Equal(http.StatusNotFound, rec.Code)
+
+	// Files of other hosts are not served
+	other := tests.HostFactory.MustCreate().(*model.Host)
+	assert.NoError(h.DB.StoreHost(other))
+	assert.NoError(h.DB.StoreHostFiles(model.HostFileList{{Host: other.Name, Name: "license.dat", Data: []byte("x")}}))
+	rec = get("license.dat", h.Extra)
+	assert.Equal(http.StatusNotFound, rec.Code)
+
+	extra := newExtraFiles(h.DB, host.Name)
+	tmpl := template.Must(template.New("extra").Parse(`{{ range .List }}{{ .Name }}:{{ .Size }} {{ end }}`))
+	var buf bytes.Buffer
+	assert.NoError(tmpl.Execute(&buf, extra))
+	assert.Equal("machine-id:16 opa.conf:26 ", buf.String())
+}
+
+func newTestVLANHost() *model.Host {
+	host := tests.HostFactory.MustCreate().(*model.Host)
+	host.Provision = true
+	host.Interfaces = append(host.Interfaces, &model.NetInterface{
+		Name:   "ens1f0.2010",
+		Parent: "ens1f0",
+		VLAN:   2010,
+		IP:     netip.MustParsePrefix("10.20.10.5/24"),
+		FQDN:   host.Name + "-data.example.com",
+	})
+
+	return host
+}
+
+func TestKickstartVLAN(t *testing.T) {
+	assert := assert.New(t)
+
+	h := &Handler{DB: newTestDB(t)}
+
+	image := tests.BootImageFactory.MustCreate().(*model.BootImage)
+	err := h.DB.StoreBootImage(image)
+	assert.NoError(err)
+
+	host := newTestVLANHost()
+	host.BootImage = image.Name
+	err = h.DB.StoreHost(host)
+	assert.NoError(err)
+
+	token, err := model.NewBootToken(host.UID.String(), host.Interfaces[0].MAC.String())
+	assert.NoError(err)
+
+	e := newTestEcho(t)
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.SetPath("/boot/:token/kickstart")
+	c.SetParamNames("token")
+	c.SetParamValues(token)
+
+	if assert.NoError(TokenRequired(h.Kickstart)(c)) {
+		assert.Equal(http.StatusOK, rec.Code)
+		assert.Contains(rec.Body.String(), "--device=ens1f0 --vlanid=2010 --interfacename=ens1f0.2010 --ip=10.20.10.5 --netmask=255.255.255.0")
+	}
+}
+
+func TestUbuntuAutoinstallVLAN(t *testing.T) {
+	assert := assert.New(t)
+
+	tmpl, err := template.New("ubuntu-autoinstall.tmpl").Funcs(funcMap).ParseFiles("templates/ubuntu-autoinstall.tmpl")
+	if !assert.NoError(err) {
+		return
+	}
+
+	host := newTestVLANHost()
+	host.Interfaces[0].Name = "eno1"
+	host.Interfaces = append(host.Interfaces, &model.NetInterface{
+		Name:   "eno1.30",
+		Parent: "eno1",
+		VLAN:   30,
+		IP:     netip.MustParsePrefix("10.30.0.5/24"),
+	})
+
+	var buf bytes.Buffer
+	err = tmpl.Execute(&buf, map[string]interface{}{
+		"host":      host,
+		"nic":       host.Interfaces[0],
+		"endpoints": NewEndpoints("localhost", "token"),
+	})
+	if !assert.NoError(err) {
+		return
+	}
+
+	var config struct {
+		Autoinstall struct {
+			Network struct {
+				Network struct {
+					Ethernets map[string]map[string]interface{} `yaml:"ethernets"`
+					VLANs     map[string]struct {
+						ID        int      `yaml:"id"`
+						Link      string   `yaml:"link"`
+						Addresses []string `yaml:"addresses"`
+					} `yaml:"vlans"`
+				} `yaml:"network"`
+			} `yaml:"network"`
+		} `yaml:"autoinstall"`
+	}
+	if !assert.NoError(yaml.Unmarshal(buf.Bytes(), &config)) {
+		return
+	}
+
+	network := config.Autoinstall.Network.Network
+	assert.Len(network.Ethernets, 2)
+	assert.Contains(network.Ethernets, "eno1")
+	assert.Contains(network.Ethernets, "ens1f0")
+	if assert.Len(network.VLANs, 2) {
+		assert.Equal(2010, network.VLANs["ens1f0.2010"].ID)
+		assert.Equal("ens1f0", network.VLANs["ens1f0.2010"].Link)
+		assert.Equal([]string{"10.20.10.5/24"}, network.VLANs["ens1f0.2010"].Addresses)
+		assert.Equal(30, network.VLANs["eno1.30"].ID)
+		assert.Equal("eno1", network.VLANs["eno1.30"].Link)
+	}
+}
+
+func TestUbuntuAutoinstallAddresses(t *testing.T) {
+	assert := assert.New(t)
+
+	tmpl, err := template.New("ubuntu-autoinstall.tmpl").Funcs(funcMap).ParseFiles("templates/ubuntu-autoinstall.tmpl")
+	if !assert.NoError(err) {
+		return
+	}
+
+	host := tests.HostFactory.MustCreate().(*model.Host)
+	host.Interfaces[0].Name = "eno1"
+	host.Interfaces[0].IP = netip.MustParsePrefix("10.10.0.5/24")
+	host.Interfaces[0].Addresses = []model.NetAddress{
+		{IP: netip.MustParsePrefix("10.10.0.100/24"), FQDN: "vip.example.com"},
+		{IP: netip.MustParsePrefix("fd00::5/64")},
+	}
+
+	var buf bytes.Buffer
+	err = tmpl.Execute(&buf, map[string]interface{}{
+		"host":      host,
+		"nic":       host.Interfaces[0],
+		"endpoints": NewEndpoints("localhost", "token"),
+	})
+	if !assert.NoError(err) {
+		return
+	}
+
+	var config struct {
+		Autoinstall struct {
+			Network struct {
+				Network struct {
+					Ethernets map[string]struct {
+						Addresses []string `yaml:"addresses"`
+					} `yaml:"ethernets"`
+				} `yaml:"network"`
+			} `yaml:"network"`
+		} `yaml:"autoinstall"`
+	}
+	if !assert.NoError(yaml.Unmarshal(buf.Bytes(), &config)) {
+		return
+	}
+
+	assert.Equal([]string{"10.10.0.5/24", "10.10.0.100/24", "fd00::5/64"}, config.Autoinstall.Network.Network.Ethernets["eno1"].Addresses)
+}
+
+func newTestBondHost() *model.Host {
+	host := tests.HostFactory.MustCreate().(*model.Host)
+	host.Provision = true
+	host.Interfaces[0].Name = "ens1f0"
+	host.Interfaces[0].IP = netip.Prefix{}
+	host.Interfaces = append(host.Interfaces, &model.NetInterface{
+		Name: "ens1f1",
+		MAC:  net.HardwareAddr{0xd0, 0x93, 0xae, 0xe1, 0xb5, 0x2f},
+	})
+	host.Bonds[0].IP = netip.MustParsePrefix("10.64.8.21/22")
+	host.Bonds[0].Peers = []string{"ens1f0", "ens1f1"}
+	host.Bonds[0].Mode = "802.3ad"
+	host.Bonds[0].Options = map[string]string{"miimon": "100"}
+
+	return host
+}
+
+func TestKickstartBond(t *testing.T) {
+	assert := assert.New(t)
+
+	h := &Handler{DB: newTestDB(t)}
+
+	image := tests.BootImageFactory.MustCreate().(*model.BootImage)
+	err := h.DB.StoreBootImage(image)
+	assert.NoError(err)
+
+	host := newTestBondHost()
+	host.BootImage = image.Name
+	err = h.DB.StoreHost(host)
+	assert.NoError(err)
+
+	token, err := model.NewBootToken(host.UID.String(), host.Interfaces[0].MAC.String())
+	assert.NoError(err)
+
+	e := newTestEcho(t)
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.SetPath("/boot/:token/kickstart")
+	c.SetParamNames("token")
+	c.SetParamValues(token)
+
+	if assert.NoError(TokenRequired(h.Kickstart)(c)) {
+		assert.Equal(http.StatusOK, rec.Code)
+		assert.Contains(rec.Body.String(), "--device=bond0 --bondslaves=ens1f0,ens1f1 --bondopts=mode=802.3ad,miimon=100 --bootproto static --ip=10.64.8.21 --netmask=255.255.252.0")
+	}
+}
+
+func TestUbuntuAutoinstallBond(t *testing.T) {
+	assert := assert.New(t)
+
+	tmpl, err := template.New("ubuntu-autoinstall.tmpl").Funcs(funcMap).ParseFiles("templates/ubuntu-autoinstall.tmpl")
+	if !assert.NoError(err) {
+		return
+	}
+
+	host := newTestBondHost()
+	host.Bonds[0].Peers[1] = host.Interfaces[2].MAC.String()
+	host.Bonds = append(host.Bonds, &model.Bond{
+		NetInterface: model.NetInterface{Name: "br0", IP: netip.MustParsePrefix("10.65.0.5/24")},
+		Type:         "bridge",
+		Peers:        []string{"bond0"},
+		Options:      map[string]string{"stp": "false"},
+	})
+	assert.NoError(host.ValidateBonds())
+
+	var buf bytes.Buffer
+	err = tmpl.Execute(&buf, map[string]interface{}{
+		"host":      host,
+		"nic":       host.Interfaces[0],
+		"endpoints": NewEndpoints("localhost", "token"),
+	})
+	if !assert.NoError(err) {
+		return
+	}
+
+	type logical struct {
+		Interfaces []string          `yaml:"interfaces"`
+		Addresses  []string          `yaml:"addresses"`
+		Routes     []map[string]any  `yaml:"routes"`
+		Parameters map[string]string `yaml:"parameters"`
+	}
+	var config struct {
+		Autoinstall struct {
+			Network struct {
+				Network struct {
+					Ethernets map[string]map[string]interface{} `yaml:"ethernets"`
+					Bonds     map[string]logical                `yaml:"bonds"`
+					Bridges   map[string]logical                `yaml:"bridges"`
+				} `yaml:"network"`
+			} `yaml:"network"`
+		} `yaml:"autoinstall"`
+	}
+	if !assert.NoError(yaml.Unmarshal(buf.Bytes(), &config), buf.String()) {
+		return
+	}
+
+	network := config.Autoinstall.Network.Network
+	assert.Len(network.Ethernets, 2)
+	assert.NotContains(network.Ethernets["ens1f0"], "addresses")
+	assert.Contains(network.Ethernets, "ens1f1")
+	if assert.Contains(network.Bonds, "bond0") {
+		bond := network.Bonds["bond0"]
+		assert.Equal([]string{"ens1f0", "ens1f1"}, bond.Interfaces)
+		assert.Equal([]string{"10.64.8.21/22"}, bond.Addresses)
+		assert.Len(bond.Routes, 1)
+		assert.Equal(map[string]string{"mode": "802.3ad", "miimon": "100"}, bond.Parameters)
+	}
+	if assert.Contains(network.Bridges, "br0") {
+		bridge := network.Bridges["br0"]
+		assert.Equal([]string{"bond0"}, bridge.Interfaces)
+		assert.Equal([]string{"10.65.0.5/24"}, bridge.Addresses)
+		assert.Empty(bridge.Routes)
+		assert.Equal(map[string]string{"stp": "false"}, bridge.Parameters)
+	}
+}
+
 func TestComplete(t *testing.T) {
 	assert := assert.New(t)
 
@@ -277,6 +1028,262 @@ func TestComplete(t *testing.T) {
 	hostTest, err := h.DB.LoadHostFromID(host.UID.String())
 	if assert.NoError(err) {
 		assert.False(hostTest.Provision)
+		assert.Equal(model.HostStateProduction, hostTest.State)
+	}
+}
+
+func TestBootHooks(t *testing.T) {
+	assert := assert.New(t)
+
+	db := newTestDB(t)
+	out := filepath.Join(t.TempDir(), "events")
+	v := viper.New()
+	v.Set("provision.hooks", []map[string]any{
+		{"name": "log", "command": []string{"sh", "-c", "echo $GRENDEL_EVENT $GRENDEL_HOST $GRENDEL_IMAGE >> " + out}},
+	})
+	hooks, err := boothook.LoadConfig(v)
+	assert.NoError(err)
+	h := &Handler{DB: db, Hooks: boothook.New(db)}
+	h.Hooks.Configure(hooks)
+
+	image := tests.BootImageFactory.MustCreate().(*model.BootImage)
+	err = h.DB.StoreBootImage(image)
+	assert.NoError(err)
+
+	host := tests.HostFactory.MustCreate().(*model.Host)
+	host.BootImage = image.Name
+	host.Provision = true
+	err = h.DB.StoreHost(host)
+	assert.NoError(err)
+
+	token, err := model.NewBootToken(host.UID.String(), host.Interfaces[0].MAC.String())
+	assert.NoError(err)
+
+	e := newTestEcho(t)
+	for _, endpoint := range []struct {
+		path    string
+		handler echo.HandlerFunc
+	}{{"ipxe", h.Ipxe}, {"complete", h.Complete}} {
+		rec := httptest.NewRecorder()
+		c := e.NewContext(httptest.NewRequest(http.MethodGet, "/", nil), rec)
+		c.SetPath("/boot/:token/" + endpoint.path)
+		c.SetParamNames("token")
+		c.SetParamValues(token)
+		if assert.NoError(TokenRequired(endpoint.handler)(c)) {
+			assert.Equal(http.StatusOK, rec.Code)
+		}
+		h.Hooks.Wait()
+	}
+
+	events, err := os.ReadFile(out)
+	if assert.NoError(err) {
+		assert.Equal(fmt.Sprintf("ipxe-fetched %[1]s %[2]s\ncomplete %[1]s %[2]s\n", host.Name, image.Name), string(events))
+	}
+}
+
+func TestInventory(t *testing.T) {
+	assert := assert.New(t)
+
+	h := &Handler{DB: newTestDB(t)}
+
+	image := tests.BootImageFactory.MustCreate().(*model.BootImage)
+	err := h.DB.StoreBootImage(image)
+	assert.NoError(err)
+
+	host := tests.HostFactory.MustCreate().(*model.Host)
+	host.BootImage = image.Name
+	host.Provision = true
+	err = h.DB.StoreHost(host)
+	assert.NoError(err)
+
+	token, err := model.NewBootToken(host.UID.String(), host.Interfaces[0].MAC.String())
+	assert.NoError(err)
+
+	e := newTestEcho(t)
+	body := `{"bios_version": "2.19.0", "nic_firmware": {"NIC.Slot.1": "22.31.6"}}`
+	req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(body))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.SetPath("/boot/:token/inventory")
+	c.SetParamNames("token")
+	c.SetParamValues(token)
+
+	if assert.NoError(TokenRequired(h.Inventory)(c)) {
+		assert.Equal(http.StatusOK, rec.Code)
+	}
+
+	hostTest, err := h.DB.LoadHostFromID(host.UID.String())
+	if assert.NoError(err) && assert.NotNil(hostTest.Inventory) {
+		assert.Equal("2.19.0", hostTest.Inventory.BIOSVersion)
+		assert.Equal(map[string]string{"NIC.Slot.1": "22.31.6"}, hostTest.Inventory.NICFirmware)
+		assert.False(hostTest.Inventory.CapturedAt.IsZero())
+		assert.True(hostTest.Provision)
+	}
+}
+
+func TestClientCert(t *testing.T) {
+	assert := assert.New(t)
+
+	h := &Handler{DB: newTestDB(t)}
+
+	image := tests.BootImageFactory.MustCreate().(*model.BootImage)
+	err := h.DB.StoreBootImage(image)
+	assert.NoError(err)
+
+	host := tests.HostFactory.MustCreate().(*model.Host)
+	host.BootImage = image.Name
+	host.Provision = true
+	err = h.DB.StoreHost(host)
+	assert.NoError(err)
+
+	ca, err := certs.NewCA("Test CA", 1, certs.KeyTypeECDSA)
+	assert.NoError(err)
+	cert, _, err := ca.Issue(certs.Request{CommonName: host.Name, Days: 1, Client: true})
+	assert.NoError(err)
+	other, _, err := ca.Issue(certs.Request{CommonName: "other", Days: 1, Client: true})
+	assert.NoError(err)
+	assert.NoError(h.DB.StoreHostClientCert(host.Name, certs.Fingerprint(cert), certs.Serial(cert)))
+	certs.Pickups.Put(host.Name, []byte("bundle"), time.Now().Add(time.Minute))
+
+	token, err := model.NewBootToken(host.UID.String(), host.Interfaces[0].MAC.String())
+	assert.NoError(err)
+
+	e := newTestEcho(t)
+	fetch := func(peer *x509.Certificate) (*httptest.ResponseRecorder, error) {
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		if peer != nil {
+			req.TLS = &tls.ConnectionState{PeerCertificates: []*x509.Certificate{peer}}
+		}
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetPath("/boot/:token/client-cert")
+		c.SetParamNames("token")
+		c.SetParamValues(token)
+
+		return rec, TokenRequired(h.ClientCert)(c)
+	}
+
+	_, err = fetch(other)
+	assertHTTPError(t, err, http.StatusForbidden)
+
+	rec, err := fetch(cert)
+	if assert.NoError(err) {
+		assert.Equal(http.StatusOK, rec.Code)
+		assert.Equal("bundle", rec.Body.String())
+	}
+
+	// Served once
+	_, err = fetch(nil)
+	assertHTTPError(t, err, http.StatusNotFound)
+}
+
+func TestClientCertRequired(t *testing.T) {
+	assert := assert.New(t)
+
+	h := &Handler{DB: newTestDB(t)}
+
+	image := tests.BootImageFactory.MustCreate().(*model.BootImage)
+	assert.NoError(h.DB.StoreBootImage(image))
+
+	host := tests.HostFactory.MustCreate().(*model.Host)
+	host.BootImage = image.Name
+	host.Provision = true
+	assert.NoError(h.DB.StoreHost(host))
+
+	ca, err := certs.NewCA("Test CA", 1, certs.KeyTypeECDSA)
+	assert.NoError(err)
+	cert, _, err := ca.Issue(certs.Request{CommonName: host.Name, Days: 1, Client: true})
+	assert.NoError(err)
+
+	token, err := model.NewBootToken(host.UID.String(), host.Interfaces[0].MAC.String())
+	assert.NoError(err)
+
+	e := newTestEcho(t)
+	fetch := func(peer *x509.Certificate) error {
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		req.TLS = &tls.ConnectionState{}
+		if peer != nil {
+			req.TLS.PeerCertificates = []*x509.Certificate{peer}
+		}
+		c := e.NewContext(req, httptest.NewRecorder())
+		c.SetPath("/boot/:token/ipxe")
+		c.SetParamNames("token")
+		c.SetParamValues(token)
+
+		return TokenRequired(h.Ipxe)(c)
+	}
+
+	// Hosts without a client certificate are not checked
+	assert.NoError(fetch(nil))
+
+	// A pinned host must present its certificate
+	assert.NoError(h.DB.StoreHostClientCert(host.Name, certs.Fingerprint(cert), certs.Serial(cert)))
+	assertHTTPError(t, fetch(nil), http.StatusForbidden)
+	assert.NoError(fetch(cert))
+
+}
+
+func TestRevokedHostWithoutCert(t *testing.T) {
+	assert := assert.New(t)
+
+	h := &Handler{DB: newTestDB(t)}
+
+	image := tests.BootImageFactory.MustCreate().(*model.BootImage)
+	assert.NoError(h.DB.StoreBootImage(image))
+
+	host := tests.HostFactory.MustCreate().(*model.Host)
+	host.BootImage = image.Name
+	host.Provision = true
+	assert.NoError(h.DB.StoreHost(host))
+
+	ca, err := certs.NewCA("Test CA", 1, certs.KeyTypeECDSA)
+	assert.NoError(err)
+	cert, _, err := ca.Issue(certs.Request{CommonName: host.Name, Days: 1, Client: true})
+	assert.NoError(err)
+	assert.NoError(h.DB.StoreHostClientCert(host.Name, certs.Fingerprint(cert), certs.Serial(cert)))
+	certs.Pickups.Put(host.Name, []byte("bundle"), time.Now().Add(time.Minute))
+	defer certs.Pickups.Take(host.Name, time.Now())
+
+	defer certs.Revocations.Set(nil)
+	certs.Revocations.Set(model.RevokedCertList{{Serial: certs.Serial(cert), Host: host.Name}})
+
+	token, err := model.NewBootToken(host.UID.String(), host.Interfaces[0].MAC.String())
+	assert.NoError(err)
+
+	// The revoked certificate is refused in the TLS handshake, leaving it
+	// out is refused by the handlers, the client-cert endpoint included
+	assert.Error(verifyNotRevoked(tls.ConnectionState{PeerCertificates: []*x509.Certificate{cert}}))
+
+	e := newTestEcho(t)
+	for path, handler := range map[string]echo.HandlerFunc{"/boot/:token/ipxe": h.Ipxe, "/boot/:token/client-cert": h.ClientCert} {
+		c := e.NewContext(httptest.NewRequest(http.MethodGet, "/", nil), httptest.NewRecorder())
+		c.SetPath(path)
+		c.SetParamNames("token")
+		c.SetParamValues(token)
+
+		assertHTTPError(t, TokenRequired(handler)(c), http.StatusForbidden)
+	}
+}
+
+func TestVerifyNotRevoked(t *testing.T) {
+	ca, err := certs.NewCA("Test CA", 1, certs.KeyTypeECDSA)
+	assert.NoError(t, err)
+	cert, _, err := ca.Issue(certs.Request{CommonName: "cpn-01", Days: 1, Client: true})
+	assert.NoError(t, err)
+	defer certs.Revocations.Set(nil)
+
+	assert.NoError(t, verifyNotRevoked(tls.ConnectionState{}))
+	assert.NoError(t, verifyNotRevoked(tls.ConnectionState{PeerCertificates: []*x509.Certificate{cert}}))
+
+	certs.Revocations.Set(model.RevokedCertList{{Serial: certs.Serial(cert)}})
+	assert.Error(t, verifyNotRevoked(tls.ConnectionState{PeerCertificates: []*x509.Certificate{cert}}))
+}
+
+func assertHTTPError(t *testing.T, err error, code int) {
+	var he *echo.HTTPError
+	if assert.ErrorAs(t, err, &he) {
+		assert.Equal(t, code, he.Code)
 	}
 }
 
@@ -345,3 +1352,124 @@ func TestMetaData(t *testing.T) {
 		assert.Contains(rec.Body.String(), host.UID.String())
 	}
 }
+
+func TestRequestMetrics(t *testing.T) {
+	assert := assert.New(t)
+
+	h := &Handler{DB: newTestDB(t)}
+	e := newTestEcho(t)
+	h.SetupRoutes(e)
+
+	index := testutil.ToFloat64(requestsTotal.WithLabelValues("/", http.MethodGet, "200"))
+	invalid := testutil.ToFloat64(requestsTotal.WithLabelValues("/boot/:token/ipxe", http.MethodGet, "400"))
+	unmatched := testutil.ToFloat64(requestsTotal.WithLabelValues("unmatched", http.MethodGet, "404"))
+
+	for _, path := range []string{"/", "/boot/bad-token/ipxe", "/missing"} {
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, path, nil))
+	}
+
+	// Tokens in the path are not exposed as labels
+	assert.Equal(index+1, testutil.ToFloat64(requestsTotal.WithLabelValues("/", http.MethodGet, "200")))
+	assert.Equal(invalid+1, testutil.ToFloat64(requestsTotal.WithLabelValues("/boot/:token/ipxe", http.MethodGet, "400")))
+	assert.Equal(unmatched+1, testutil.ToFloat64(requestsTotal.WithLabelValues("unmatched", http.MethodGet, "404")))
+}
+
+func TestAccessLog(t *testing.T) {
+	assert := assert.New(t)
+
+	h := &Handler{DB: newTestDB(t)}
+
+	image := tests.BootImageFactory.MustCreate().(*model.BootImage)
+	assert.NoError(h.DB.StoreBootImage(image))
+
+	host := tests.HostFactory.MustCreate().(*model.Host)
+	host.BootImage = image.Name
+	host.Provision = true
+	assert.NoError(h.DB.StoreHost(host))
+
+	token, err := model.NewBootToken(host.UID.String(), host.Interfaces[0].MAC.String())
+	assert.NoError(err)
+
+	hook := test.NewLocal(log.Logger)
+	defer log.Logger.ReplaceHooks(make(logrus.LevelHooks))
+
+	e := newTestEcho(t)
+	e.Use(AccessLog(accesslog.New("provision", accesslog.Config{Enabled: true, SampleRate: 1})))
+	h.SetupRoutes(e)
+
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/boot/"+token+"/ipxe", nil))
+	assert.Equal(http.StatusOK, rec.Code)
+
+	entry := hook.LastEntry()
+	if assert.NotNil(entry) {
+		assert.Equal("/boot/"+accesslog.Redacted+"/ipxe", entry.Data["path"])
+		assert.Equal(http.StatusOK, entry.Data["status"])
+		assert.Equal(int64(rec.Body.Len()), entry.Data["bytes"])
+		assert.Equal(host.Name, entry.Data["host"])
+		assert.Equal(host.Interfaces[0].MAC.String(), entry.Data["mac"])
+	}
+
+	// Errors are logged with the status of the error response
+	rec = httptest.NewRecorder()
+	e.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/boot/"+token+"x/ipxe", nil))
+	assert.Equal(http.StatusBadRequest, rec.Code)
+	assert.Equal(http.StatusBadRequest, hook.LastEntry().Data["status"])
+
+	for _, entry := range hook.AllEntries() {
+		line, err := entry.String()
+		assert.NoError(err)
+		assert.NotContains(line, token)
+	}
+}
+
+func TestMaintenance(t *testing.T) {
+	assert := assert.New(t)
+
+	h := &Handler{DB: newTestDB(t)}
+
+	image := tests.BootImageFactory.MustCreate().(*model.BootImage)
+	assert.NoError(h.DB.StoreBootImage(image))
+
+	host := tests.HostFactory.MustCreate().(*model.Host)
+	host.BootImage = image.Name
+	host.Provision = true
+	assert.NoError(h.DB.StoreHost(host))
+
+	token, err := model.NewBootToken(host.UID.String(), host.Interfaces[0].MAC.String())
+	assert.NoError(err)
+
+	assert.NoError(maintenance.Set(h.DB, &model.Maintenance{Enabled: true, Reason: "upgrade", Changed: time.Now()}))
+	defer maintenance.Set(h.DB, &model.Maintenance{Changed: time.Now()})
+
+	hook := test.NewLocal(log.Logger)
+	defer log.Logger.ReplaceHooks(make(logrus.LevelHooks))
+
+	e := newTestEcho(t)
+	h.SetupRoutes(e)
+
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/boot/"+token+"/ipxe", nil))
+	assert.Equal(http.StatusServiceUnavailable, rec.Code)
+	assert.Equal(DefaultMaintenancePage, rec.Body.String())
+
+	// Suppressed requests are logged with the host name
+	entry := hook.LastEntry()
+	if assert.NotNil(entry) {
+		assert.Equal(host.Name, entry.Data["host"])
+		assert.Equal("upgrade", entry.Data["reason"])
+	}
+
+	h.MaintenancePage = []byte("<html><body>Back soon</body></html>")
+	rec = httptest.NewRecorder()
+	e.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/boot/"+token+"/kickstart", nil))
+	assert.Equal(http.StatusServiceUnavailable, rec.Code)
+	assert.Contains(rec.Body.String(), "Back soon")
+	assert.Contains(rec.Header().Get(echo.HeaderContentType), "text/html")
+
+	assert.NoError(maintenance.Set(h.DB, &model.Maintenance{Changed: time.Now()}))
+	rec = httptest.NewRecorder()
+	e.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/boot/"+token+"/ipxe", nil))
+	assert.Equal(http.StatusOK, rec.Code)
+}
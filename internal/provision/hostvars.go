@@ -0,0 +1,140 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package provision
+
+import (
+	"strings"
+
+	"github.com/ubccr/grendel/pkg/model"
+)
+
+// HostVars gives templates the host being provisioned as .Host, with its BMC,
+// the switch port of the booting interface, its rack and row and its groups
+// as plain values. Fields are empty when the host lacks them so templates
+// such as {{ .Host.BMC.Address }} render without nested conditionals. The
+// fields and methods of model.Host are available too, such as .Host.Name
+type HostVars struct {
+	*model.Host
+
+	// BMC is the BMC interface of the host, HasBMC is false without one
+	BMC    NICVars
+	HasBMC bool
+
+	// NIC is the interface the host boots from, Switch and Port are its
+	// switch and switch port
+	NIC    NICVars
+	Switch string
+	Port   int
+
+	// Links are the interfaces with a switch port, for LLDP checks
+	Links []NICVars
+
+	// Rack and Row are the values of the rack and row tags, such as rack=a01
+	// or row:3
+	Rack string
+	Row  string
+
+	// Groups are the tags without a value, Vars the values of the key=value
+	// and key:value tags
+	Groups []string
+	Vars   map[string]string
+}
+
+// NICVars is a network interface of a host for templates
+type NICVars struct {
+	Name    string
+	MAC     string
+	Address string
+	CIDR    string
+	FQDN    string
+	Switch  string
+	Port    int
+}
+
+func newNICVars(nic *model.NetInterface) NICVars {
+	if nic == nil {
+		return NICVars{}
+	}
+
+	vars := NICVars{
+		Name:    nic.Name,
+		Address: nic.AddrString(),
+		CIDR:    nic.CIDR(),
+		FQDN:    nic.HostName(),
+		Switch:  nic.Switch,
+		Port:    nic.Port,
+	}
+	if len(nic.MAC) > 0 {
+		vars.MAC = nic.MAC.String()
+	}
+
+	return vars
+}
+
+// newHostVars returns the template vars of host booting from nic, or from
+// its boot interface when nic is nil
+func newHostVars(host *model.Host, nic *model.NetInterface) *HostVars {
+	if nic == nil {
+		nic = host.BootInterface()
+	}
+
+	vars := &HostVars{
+		Host:   host,
+		NIC:    newNICVars(nic),
+		Links:  make([]NICVars, 0),
+		Groups: make([]string, 0),
+		Vars:   make(map[string]string),
+	}
+	vars.Switch, vars.Port = vars.NIC.Switch, vars.NIC.Port
+
+	if bmc := host.InterfaceBMC(); bmc != nil {
+		vars.BMC = newNICVars(bmc)
+		vars.HasBMC = true
+	}
+
+	for _, n := range host.Interfaces {
+		if n.Switch != "" {
+			vars.Links = append(vars.Links, newNICVars(n))
+		}
+	}
+
+	for _, tag := range host.Tags {
+		key, value, ok := strings.Cut(tag, "=")
+		if !ok {
+			key, value, ok = strings.Cut(tag, ":")
+		}
+		if !ok {
+			vars.Groups = append(vars.Groups, tag)
+			continue
+		}
+		if _, exists := vars.Vars[key]; !exists {
+			vars.Vars[key] = value
+		}
+	}
+	vars.Rack = vars.Vars["rack"]
+	vars.Row = vars.Vars["row"]
+
+	return vars
+}
+
+// Var returns the value of the key=value or key:value tag, else def
+func (h *HostVars) Var(key, def string) string {
+	if value, ok := h.Vars[key]; ok {
+		return value
+	}
+
+	return def
+}
+
+// InGroup returns whether the host has the tag group
+func (h *HostVars) InGroup(group string) bool {
+	for _, g := range h.Groups {
+		if g == group {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,58 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package provision
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+var (
+	requestsTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "grendel_provision_requests_total",
+		Help: "Provision HTTP requests by endpoint, method and status code",
+	}, []string{"endpoint", "method", "code"})
+	requestDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "grendel_provision_request_duration_seconds",
+		Help:    "Time taken to answer provision HTTP requests by endpoint",
+		Buckets: prometheus.DefBuckets,
+	}, []string{"endpoint"})
+)
+
+func init() {
+	prometheus.MustRegister(requestsTotal, requestDuration)
+}
+
+// Metrics counts requests by route, so tokens in the path are not exposed as
+// labels. Requests matching no route are counted as unmatched
+func Metrics(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		start := time.Now()
+		err := next(c)
+
+		endpoint := c.Path()
+		if endpoint == "" || endpoint == "/*" {
+			endpoint = "unmatched"
+		}
+
+		code := c.Response().Status
+		var he *echo.HTTPError
+		if errors.As(err, &he) {
+			code = he.Code
+		} else if err != nil {
+			code = http.StatusInternalServerError
+		}
+
+		requestsTotal.WithLabelValues(endpoint, c.Request().Method, strconv.Itoa(code)).Inc()
+		requestDuration.WithLabelValues(endpoint).Observe(time.Since(start).Seconds())
+
+		return err
+	}
+}
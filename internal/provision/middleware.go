@@ -5,9 +5,19 @@
 package provision
 
 import (
+	"context"
 	"net/http"
+	"net/netip"
+	"time"
 
 	"github.com/labstack/echo/v4"
+	"github.com/sirupsen/logrus"
+	"github.com/ubccr/grendel/internal/accesslog"
+	"github.com/ubccr/grendel/internal/logger"
+	"github.com/ubccr/grendel/internal/maintenance"
+	"github.com/ubccr/grendel/internal/ratelimit"
+	"github.com/ubccr/grendel/internal/store"
+	"github.com/ubccr/grendel/internal/store/namespace"
 	"github.com/ubccr/grendel/pkg/model"
 )
 
@@ -24,7 +34,193 @@ func TokenRequired(next echo.HandlerFunc) echo.HandlerFunc {
 		}
 
 		c.Set(ContextKeyToken, claims)
+		if claims.BootID != "" {
+			c.Response().Header().Set(HeaderBootID, claims.BootID)
+		}
+
+		return next(c)
+	}
+}
+
+// RateLimit rejects requests over the limits of the client IP or boot token
+// with 429 Too Many Requests, or with 503 Service Unavailable when too many
+// requests are served at once. A nil limiter allows all requests
+func RateLimit(l *ratelimit.Limiter) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			release, err := l.Acquire(c.Request().RemoteAddr, c.Param("token"))
+			if err != nil {
+				rerr := err.(*ratelimit.Error)
+				c.Response().Header().Set("Retry-After", rerr.RetryAfterSeconds())
+				return echo.NewHTTPError(rerr.Status, rerr.Error())
+			}
+			defer release()
+
+			return next(c)
+		}
+	}
+}
+
+// AccessLog writes requests to the access log. The boot token in the path is
+// redacted, the host and interface it maps to are logged instead. Errors are
+// handled here so the status and size of the error response are logged
+func AccessLog(l *accesslog.Logger) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			start := time.Now()
+			if err := next(c); err != nil {
+				c.Error(err)
+			}
+
+			req := c.Request()
+			entry := &accesslog.Entry{
+				Method:   req.Method,
+				Path:     accesslog.RedactPath(req.URL.Path, tokenPathPrefix),
+				Status:   c.Response().Status,
+				Bytes:    c.Response().Size,
+				Duration: time.Since(start),
+				IP:       c.RealIP(),
+			}
+			if claims, ok := c.Get(ContextKeyToken).(*model.BootClaims); ok {
+				entry.MAC = claims.MAC
+			}
+			if host, ok := c.Get(ContextKeyHost).(string); ok {
+				entry.Host = host
+			}
+			l.Log(entry)
+
+			return nil
+		}
+	}
+}
+
+// StoreDeadline sets the deadline of the datastore operations of a request
+// made with Handler.db. Requests failing once the deadline is exceeded are
+// answered with 503 Service Unavailable. A timeout of 0 sets no deadline
+func StoreDeadline(timeout time.Duration) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			ctx, cancel := store.WithTimeout(c.Request().Context(), timeout)
+			defer cancel()
+			c.Set(ContextKeyStore, ctx)
+
+			err := next(c)
+			if store.DeadlineExceeded(ctx, "provision", err) {
+				return echo.NewHTTPError(http.StatusServiceUnavailable, "datastore did not answer in time").SetInternal(err)
+			}
+
+			return err
+		}
+	}
+}
+
+// db returns the datastore of the namespace of the request, with the
+// deadline set by StoreDeadline
+func (h *Handler) db(c echo.Context) store.Store {
+	db := h.namespaceDB(c)
+	if ctx, ok := c.Get(ContextKeyStore).(context.Context); ok {
+		return db.WithContext(ctx)
+	}
+
+	return db
+}
+
+// namespaceDB returns the datastore of the namespace of the subnet of the
+// address the request comes from
+func (h *Handler) namespaceDB(c echo.Context) store.Store {
+	if h.Namespaces == nil {
+		return h.DB
+	}
+	_, db := namespaceFor(h.Namespaces, c)
+
+	return db
+}
+
+// namespaceFor returns the namespace of the subnet of the address the request
+// comes from. Forwarded headers are not trusted so a client cannot select
+// another namespace
+func namespaceFor(r *namespace.Registry, c echo.Context) (string, store.Store) {
+	addr, err := netip.ParseAddrPort(c.Request().RemoteAddr)
+	if err != nil {
+		return r.ForAddr(netip.Addr{})
+	}
+
+	return r.ForAddr(addr.Addr().Unmap())
+}
+
+// InMaintenance answers requests with 503 Service Unavailable while
+// maintenance mode is on. It must be used after TokenRequired
+func (h *Handler) InMaintenance(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		if !maintenance.Enabled() {
+			return next(c)
+		}
+
+		claims := c.Get(ContextKeyToken).(*model.BootClaims)
+		name := claims.ID
+		if host, err := h.db(c).LoadHostFromID(claims.ID); err == nil {
+			name = host.Name
+		}
+		c.Set(ContextKeyHost, name)
+
+		return h.maintenanceResponse(c, name, requestLog(c).Data)
+	}
+}
+
+// maintenanceResponse logs the provisioning request of host as suppressed and
+// answers it with the maintenance page
+func (h *Handler) maintenanceResponse(c echo.Context, host string, fields logrus.Fields) error {
+	maintenance.Suppressed("provision", host, fields)
+
+	page := h.MaintenancePage
+	if len(page) == 0 {
+		page = []byte(DefaultMaintenancePage)
+	}
+
+	return c.Blob(http.StatusServiceUnavailable, http.DetectContentType(page), page)
+}
+
+// TokenNotRevoked rejects boot tokens which have been revoked. It must be used
+// after TokenRequired
+func (h *Handler) TokenNotRevoked(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		id, err := model.BootTokenID(c.Param("token"))
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, "invalid token").SetInternal(err)
+		}
+
+		revoked, err := h.db(c).BootTokenRevoked(id)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusInternalServerError, "failed to check token").SetInternal(err)
+		}
+
+		if revoked {
+			claims := c.Get(ContextKeyToken).(*model.BootClaims)
+			requestLog(c).WithFields(logrus.Fields{
+				"host_id":  claims.ID,
+				"token_id": id,
+			}).Warn("rejected revoked boot token")
+			return echo.NewHTTPError(http.StatusForbidden, "token revoked")
+		}
 
 		return next(c)
 	}
 }
+
+// requestLog returns the logger of a request made with a boot token, logging
+// the client IP along with the MAC address, boot ID and host name of the token
+func requestLog(c echo.Context) *logrus.Entry {
+	if l, ok := c.Get(ContextKeyLog).(*logrus.Entry); ok {
+		return l
+	}
+
+	fields := logrus.Fields{logger.FieldIP: c.RealIP()}
+	if claims, ok := c.Get(ContextKeyToken).(*model.BootClaims); ok {
+		fields[logger.FieldMAC] = claims.MAC
+		if claims.BootID != "" {
+			fields[logger.FieldBootID] = claims.BootID
+		}
+	}
+
+	return log.WithFields(fields)
+}
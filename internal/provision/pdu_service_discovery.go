@@ -11,7 +11,7 @@ import (
 	"strings"
 
 	"github.com/labstack/echo/v4"
-	"github.com/spf13/viper"
+	"github.com/ubccr/grendel/internal/config"
 )
 
 func (h *Handler) PDUServiceDiscovery(c echo.Context) error {
@@ -28,7 +28,7 @@ func (h *Handler) PDUServiceDiscovery(c echo.Context) error {
 
 	sd := make([]*promServiceDiscovery, 0)
 
-	hosts, err := h.DB.Hosts()
+	hosts, err := h.db(c).Hosts()
 	if err != nil {
 		log.Error("failed to fetch all hosts for service discovery")
 		return echo.NewHTTPError(http.StatusInternalServerError, "failed to find hosts").SetInternal(err)
@@ -72,6 +72,6 @@ func (h *Handler) PDUServiceDiscovery(c echo.Context) error {
 		sd = append(sd, nodeExporter)
 	}
 
-	c.Response().Header().Set("X-Prometheus-Refresh-Interval-Seconds", viper.GetString("provision.prometheus_sd_refresh_interval"))
+	c.Response().Header().Set("X-Prometheus-Refresh-Interval-Seconds", config.Viper().GetString("provision.prometheus_sd_refresh_interval"))
 	return c.JSON(http.StatusOK, sd)
 }
@@ -0,0 +1,85 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package provision
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/sirupsen/logrus"
+	"github.com/ubccr/grendel/internal/secret"
+	"github.com/ubccr/grendel/internal/store"
+)
+
+// SecretPlaceholder is rendered instead of a one-time secret already rendered
+// for the boot token
+const SecretPlaceholder = "REDACTED"
+
+// secretResolver renders {{ secret "name" }} for the templates of a request.
+// A one-time secret is decrypted for the first request made with a boot
+// token, later requests get SecretPlaceholder. Values are kept for the
+// request so a template may use a secret more than once
+type secretResolver struct {
+	db      store.Store
+	tokenID string
+	host    string
+	log     *logrus.Entry
+
+	mu     sync.Mutex
+	values map[string]string
+}
+
+func newSecretResolver(db store.Store, tokenID, host string, log *logrus.Entry) *secretResolver {
+	return &secretResolver{db: db, tokenID: tokenID, host: host, log: log, values: make(map[string]string)}
+}
+
+// Secret returns the value of the secret with the given name
+func (r *secretResolver) Secret(name string) (string, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if value, ok := r.values[name]; ok {
+		return value, nil
+	}
+
+	s, err := r.db.LoadSecret(name)
+	if err != nil {
+		if errors.Is(err, store.ErrNotFound) {
+			return "", fmt.Errorf("secret %s not found", name)
+		}
+		return "", err
+	}
+
+	// Decrypted first so a wrong credentials key does not use up the secret
+	value, err := secret.OpenString(s.Value)
+	if err != nil {
+		return "", fmt.Errorf("failed to decrypt secret %s: %w", name, err)
+	}
+
+	if s.OneTime {
+		if r.tokenID == "" {
+			return "", fmt.Errorf("one-time secret %s requires a boot token", name)
+		}
+
+		claimed, err := r.db.ClaimSecret(name, r.tokenID, r.host)
+		if err != nil {
+			return "", err
+		}
+		if !claimed {
+			r.log.WithField("secret", name).Warn("One-time secret already sent for this boot token, sending a placeholder")
+			value = SecretPlaceholder
+		}
+	}
+
+	r.values[name] = value
+
+	return value, nil
+}
+
+// noSecret is the secret template func outside of provision requests
+func noSecret(name string) (string, error) {
+	return "", fmt.Errorf("secret %s is only available to provision templates", name)
+}
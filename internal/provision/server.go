@@ -7,23 +7,35 @@ package provision
 import (
 	"context"
 	"crypto/tls"
+	"crypto/x509"
 	"encoding/json"
 	"fmt"
 	"net"
 	"net/http"
+	"os"
 	"strconv"
 	"time"
 
 	"github.com/labstack/echo/v4"
 	"github.com/labstack/echo/v4/middleware"
 	"github.com/sirupsen/logrus"
+	"github.com/ubccr/grendel/internal/accesslog"
+	"github.com/ubccr/grendel/internal/boothook"
+	"github.com/ubccr/grendel/internal/certs"
+	"github.com/ubccr/grendel/internal/health"
 	"github.com/ubccr/grendel/internal/logger"
+	"github.com/ubccr/grendel/internal/ratelimit"
 	"github.com/ubccr/grendel/internal/store"
+	"github.com/ubccr/grendel/internal/store/namespace"
 	"github.com/ubccr/grendel/internal/util"
 )
 
 const (
 	DefaultPort = 80
+
+	// revocationRefresh is how often the revoked certificates are reloaded,
+	// for revocations made through the API of another grendel instance
+	revocationRefresh = time.Minute
 )
 
 var log = logger.GetLogger("PROVISION")
@@ -37,40 +49,66 @@ type Server struct {
 	CertFile      string
 	RepoDir       string
 	DB            store.Store
-	httpServer    *http.Server
+	Namespaces    *namespace.Registry // selected by subnet, only DB is used when nil
+	Limiter       *ratelimit.Limiter
+	AccessLog     *accesslog.Logger
+
+	// MaintenancePage is the body of the 503 responses to provisioning
+	// requests in maintenance mode
+	MaintenancePage []byte
+
+	// Listener is a socket passed by systemd, used by Listen instead of
+	// binding the address
+	Listener net.Listener
+
+	// Certificate is served over HTTPS instead of CertFile and KeyFile
+	Certificate certs.Source
+
+	// ClientCAFile is the CA verifying the client certificates presented
+	// over HTTPS. Presenting one is optional, a presented certificate must
+	// not be revoked and must match the fingerprint stored on the host of
+	// the boot token
+	ClientCAFile string
+
+	// ACMEChallenges answers ACME http-01 challenges, served on the provision
+	// listener and on ACMEListen when set
+	ACMEChallenges http.Handler
+	ACMEListen     string
+
+	// StoreTimeout is the deadline of the datastore operations of a
+	// request, 0 sets no deadline
+	StoreTimeout time.Duration
+
+	// Hooks run on the boot events of hosts, none when nil
+	Hooks *boothook.Runner
+
+	httpServer   *http.Server
+	listener     net.Listener
+	tlsConfig    *tls.Config
+	acmeServer   *http.Server
+	acmeListener net.Listener
+	templates    *TemplateRenderer
+	stopRefresh  chan struct{}
 }
 
 func NewServer(db store.Store, address string) (*Server, error) {
 	s := &Server{DB: db}
 
-	shost, sport, err := net.SplitHostPort(address)
+	ip, port, err := util.ParseListenAddress(address, DefaultPort)
 	if err != nil {
 		return nil, err
 	}
 
-	if shost == "" {
-		shost = net.IPv4zero.String()
-	}
-
-	port := DefaultPort
-	if sport != "" {
-		var err error
-		port, err = strconv.Atoi(sport)
-		if err != nil {
-			return nil, err
-		}
-	}
-
 	s.Port = port
 
-	ip := net.ParseIP(shost)
-	if ip == nil || ip.To4() == nil {
-		return nil, fmt.Errorf("Invalid IPv4 address: %s", shost)
+	s.templates, err = NewTemplateRenderer()
+	if err != nil {
+		return nil, err
 	}
 
 	s.ListenAddress = ip
 
-	if !ip.To4().Equal(net.IPv4zero) {
+	if !ip.IsUnspecified() {
 		s.ServerAddress = ip
 		return s, nil
 	}
@@ -85,56 +123,147 @@ func NewServer(db store.Store, address string) (*Server, error) {
 	return s, nil
 }
 
-func newEcho() (*echo.Echo, error) {
+func newEcho(renderer *TemplateRenderer) *echo.Echo {
 	e := echo.New()
 	e.HTTPErrorHandler = HTTPErrorHandler
 	e.HideBanner = true
 	e.Use(middleware.Recover())
+	e.Use(Metrics)
 	e.Logger = EchoLogger()
 
-	renderer, err := NewTemplateRenderer()
-	if err != nil {
-		return nil, err
-	}
-
 	e.Renderer = renderer
 
-	return e, nil
+	return e
 }
 
 func HTTPErrorHandler(err error, c echo.Context) {
-	path := c.Request().URL.Path
+	path := accesslog.RedactPath(c.Request().URL.Path, tokenPathPrefix)
 	if he, ok := err.(*echo.HTTPError); ok {
-		if he.Code == http.StatusNotFound {
-			log.WithFields(logrus.Fields{
-				"path": path,
-				"ip":   c.RealIP(),
-			}).Warn("Requested path not found")
-		} else {
-			log.WithFields(logrus.Fields{
+		switch he.Code {
+		case http.StatusNotFound:
+			requestLog(c).WithField("path", path).Warn("Requested path not found")
+		case http.StatusTooManyRequests, http.StatusServiceUnavailable:
+			// Logged by the limiter
+		default:
+			requestLog(c).WithFields(logrus.Fields{
 				"code": he.Code,
 				"err":  he.Internal,
 				"path": path,
-				"ip":   c.RealIP(),
 			}).Error(he.Message)
 		}
 	} else {
-		log.WithFields(logrus.Fields{
+		requestLog(c).WithFields(logrus.Fields{
 			"err":  err,
 			"path": path,
-			"ip":   c.RealIP(),
 		}).Error("HTTP Error")
 	}
 
 	c.Echo().DefaultHTTPErrorHandler(err, c)
 }
 
+// address returns the host and port the server listens on, with IPv6
+// addresses in brackets
+func (s *Server) address() string {
+	return net.JoinHostPort(s.ListenAddress.String(), strconv.Itoa(s.Port))
+}
+
+// Listen binds the socket of the server and loads its certificate. Serve
+// binds it when Listen was not called
+func (s *Server) Listen() error {
+	if s.Certificate == nil && s.CertFile != "" && s.KeyFile != "" {
+		cert, err := certs.LoadFile("provision", s.CertFile, s.KeyFile)
+		if err != nil {
+			return err
+		}
+		s.Certificate = cert
+	}
+
+	if s.Certificate != nil {
+		cfg := &tls.Config{
+			MinVersion: tls.VersionTLS12,
+			/* TODO need to figure out compataible ciphers with iPXE
+
+			CurvePreferences: []tls.CurveID{
+				tls.CurveP256,
+				tls.X25519,
+			},
+			PreferServerCipherSuites: true,
+			CipherSuites: []uint16{
+				tls.TLS_ECDHE_ECDSA_WITH_AES_256_GCM_SHA384,
+				tls.TLS_ECDHE_RSA_WITH_AES_256_GCM_SHA384,
+				tls.TLS_ECDHE_ECDSA_WITH_CHACHA20_POLY1305,
+				tls.TLS_ECDHE_RSA_WITH_CHACHA20_POLY1305,
+				tls.TLS_ECDHE_ECDSA_WITH_AES_128_GCM_SHA256,
+				tls.TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256,
+				tls.TLS_RSA_WITH_AES_256_CBC_SHA256,
+			},
+			*/
+		}
+
+		cfg.GetCertificate = s.Certificate.GetCertificate
+
+		if s.ClientCAFile != "" {
+			data, err := os.ReadFile(s.ClientCAFile)
+			if err != nil {
+				return err
+			}
+			pool := x509.NewCertPool()
+			if !pool.AppendCertsFromPEM(data) {
+				return fmt.Errorf("no PEM certificate found in %s", s.ClientCAFile)
+			}
+			cfg.ClientCAs = pool
+			cfg.ClientAuth = tls.VerifyClientCertIfGiven
+			cfg.VerifyConnection = verifyNotRevoked
+
+			revoked, err := s.DB.RevokedCerts()
+			if err != nil {
+				return err
+			}
+			certs.Revocations.Set(revoked)
+		}
+		s.tlsConfig = cfg
+	}
+
+	listener := s.Listener
+	if listener == nil {
+		var err error
+		listener, err = net.Listen("tcp", s.address())
+		if err != nil {
+			return err
+		}
+	}
+	s.listener = listener
+
+	if s.ACMEChallenges != nil && s.ACMEListen != "" {
+		acmeListener, err := net.Listen("tcp", s.ACMEListen)
+		if err != nil {
+			listener.Close()
+			return err
+		}
+		s.acmeListener = acmeListener
+	}
+
+	return nil
+}
+
 func (s *Server) Serve(defaultImageName string) error {
-	e, err := newEcho()
-	if err != nil {
-		return err
+	if s.listener == nil {
+		if err := s.Listen(); err != nil {
+			return err
+		}
 	}
 
+	if s.Namespaces != nil {
+		if err := s.templates.SetNamespaces(s.Namespaces); err != nil {
+			return err
+		}
+	}
+
+	e := newEcho(s.templates)
+	e.Use(AccessLog(s.AccessLog))
+	e.Use(RateLimit(s.Limiter))
+	e.Use(StoreDeadline(s.StoreTimeout))
+
 	routeList, err := json.MarshalIndent(e.Routes(), "", "  ")
 	if err != nil {
 		return err
@@ -154,53 +283,56 @@ func (s *Server) Serve(defaultImageName string) error {
 	if err != nil {
 		return err
 	}
+	h.MaintenancePage = s.MaintenancePage
+	h.Hooks = s.Hooks
+	h.Namespaces = s.Namespaces
 
 	h.SetupRoutes(e)
 
+	e.GET("/healthz", echo.WrapHandler(http.HandlerFunc(health.Live)))
+	e.GET("/readyz", echo.WrapHandler(http.HandlerFunc(health.Ready)))
+
+	if s.ACMEChallenges != nil {
+		e.GET(certs.ChallengePath+"*", echo.WrapHandler(s.ACMEChallenges))
+	}
+
+	if s.acmeListener != nil {
+		s.acmeServer = &http.Server{
+			Handler:      acmeHandler(s.ACMEChallenges),
+			ReadTimeout:  10 * time.Second,
+			WriteTimeout: 10 * time.Second,
+		}
+		log.Infof("Answering ACME http-01 challenges on http://%s", s.acmeListener.Addr())
+		go func() {
+			if err := s.acmeServer.Serve(s.acmeListener); err != nil && err != http.ErrServerClosed {
+				log.WithField("err", err).Error("ACME challenge server failed")
+			}
+		}()
+	}
+
 	httpServer := &http.Server{
-		Addr:         fmt.Sprintf("%s:%d", s.ListenAddress, s.Port),
+		Addr:         s.address(),
 		ReadTimeout:  60 * time.Minute,
 		WriteTimeout: 60 * time.Minute,
 		IdleTimeout:  120 * time.Second,
+		TLSConfig:    s.tlsConfig,
 	}
 
-	if s.CertFile != "" && s.KeyFile != "" {
-		cfg := &tls.Config{
-			MinVersion: tls.VersionTLS12,
-			/* TODO need to figure out compataible ciphers with iPXE
-
-			CurvePreferences: []tls.CurveID{
-				tls.CurveP256,
-				tls.X25519,
-			},
-			PreferServerCipherSuites: true,
-			CipherSuites: []uint16{
-				tls.TLS_ECDHE_ECDSA_WITH_AES_256_GCM_SHA384,
-				tls.TLS_ECDHE_RSA_WITH_AES_256_GCM_SHA384,
-				tls.TLS_ECDHE_ECDSA_WITH_CHACHA20_POLY1305,
-				tls.TLS_ECDHE_RSA_WITH_CHACHA20_POLY1305,
-				tls.TLS_ECDHE_ECDSA_WITH_AES_128_GCM_SHA256,
-				tls.TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256,
-				tls.TLS_RSA_WITH_AES_256_CBC_SHA256,
-			},
-			*/
-		}
-
-		httpServer.TLSConfig = cfg
-		httpServer.TLSConfig.Certificates = make([]tls.Certificate, 1)
-		httpServer.TLSConfig.Certificates[0], err = tls.LoadX509KeyPair(s.CertFile, s.KeyFile)
-		if err != nil {
-			return err
-		}
+	if s.tlsConfig != nil && s.tlsConfig.ClientCAs != nil {
+		s.stopRefresh = make(chan struct{})
+		go s.refreshRevocations(s.stopRefresh)
+	}
 
+	if s.tlsConfig != nil {
 		s.Scheme = "https"
-		httpServer.Addr = fmt.Sprintf("%s:%d", s.ListenAddress, s.Port)
+		e.TLSListener = tls.NewListener(s.listener, s.tlsConfig)
 	} else {
 		s.Scheme = "http"
+		e.Listener = s.listener
 	}
 
 	s.httpServer = httpServer
-	log.Infof("Listening on %s://%s:%d", s.Scheme, s.ListenAddress, s.Port)
+	log.Infof("Listening on %s://%s", s.Scheme, s.address())
 	if err := e.StartServer(httpServer); err != nil && err != http.ErrServerClosed {
 		return err
 	}
@@ -208,7 +340,93 @@ func (s *Server) Serve(defaultImageName string) error {
 	return nil
 }
 
+// ReloadTemplates parses the provisioning templates again, returning a
+// function replacing the templates served with them
+func (s *Server) ReloadTemplates() (func(), error) {
+	return s.templates.Reload()
+}
+
+// Addr returns the address the server is bound to, nil until Listen is
+// called
+func (s *Server) Addr() net.Addr {
+	if s.listener == nil {
+		return nil
+	}
+	return s.listener.Addr()
+}
+
+// Check returns an error unless the listeners of the server are open and
+// the provisioning templates are parsed
+func (s *Server) Check(ctx context.Context) error {
+	if err := health.SocketOpen(s.listener); err != nil {
+		return err
+	}
+
+	if s.acmeListener != nil {
+		if err := health.SocketOpen(s.acmeListener); err != nil {
+			return fmt.Errorf("ACME challenge listener: %w", err)
+		}
+	}
+
+	return s.templates.Check()
+}
+
+// refreshRevocations reloads the revoked certificates every
+// revocationRefresh until stop is closed. Revocations made through the API
+// of this process are applied right away
+func (s *Server) refreshRevocations(stop chan struct{}) {
+	ticker := time.NewTicker(revocationRefresh)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-stop:
+			return
+		case <-ticker.C:
+			revoked, err := s.DB.RevokedCerts()
+			if err != nil {
+				log.WithField("err", err).Error("Failed to reload the revoked certificates")
+				continue
+			}
+			certs.Revocations.Set(revoked)
+		}
+	}
+}
+
+// verifyNotRevoked rejects TLS connections presenting a revoked client
+// certificate
+func verifyNotRevoked(cs tls.ConnectionState) error {
+	if len(cs.PeerCertificates) == 0 || !certs.Revocations.Revoked(cs.PeerCertificates[0]) {
+		return nil
+	}
+
+	cert := cs.PeerCertificates[0]
+	log.WithFields(logrus.Fields{
+		"serial":  certs.Serial(cert),
+		"subject": cert.Subject.String(),
+	}).Warn("Rejected revoked client certificate")
+
+	return fmt.Errorf("client certificate %s has been revoked", certs.Serial(cert))
+}
+
+// acmeHandler serves only the ACME http-01 challenges
+func acmeHandler(challenges http.Handler) http.Handler {
+	mux := http.NewServeMux()
+	mux.Handle(certs.ChallengePath, challenges)
+
+	return mux
+}
+
 func (s *Server) Shutdown(ctx context.Context) error {
+	if s.stopRefresh != nil {
+		close(s.stopRefresh)
+		s.stopRefresh = nil
+	}
+
+	if s.acmeServer != nil {
+		s.acmeServer.Shutdown(ctx)
+	}
+
 	if s.httpServer == nil {
 		return nil
 	}
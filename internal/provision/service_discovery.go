@@ -9,7 +9,7 @@ import (
 	"net/http"
 
 	"github.com/labstack/echo/v4"
-	"github.com/spf13/viper"
+	"github.com/ubccr/grendel/internal/config"
 )
 
 type promServiceDiscovery struct {
@@ -40,7 +40,7 @@ func (h *Handler) ServiceDiscovery(c echo.Context) error {
 		Labels:  labels,
 	}
 
-	hosts, err := h.DB.Hosts()
+	hosts, err := h.db(c).Hosts()
 	if err != nil {
 		log.Error("failed to fetch all hosts for service discovery")
 		return echo.NewHTTPError(http.StatusInternalServerError, "failed to find hosts").SetInternal(err)
@@ -58,6 +58,6 @@ func (h *Handler) ServiceDiscovery(c echo.Context) error {
 	}
 
 	sd = append(sd, nodeExporter)
-	c.Response().Header().Set("X-Prometheus-Refresh-Interval-Seconds", viper.GetString("provision.prometheus_sd_refresh_interval"))
+	c.Response().Header().Set("X-Prometheus-Refresh-Interval-Seconds", config.Viper().GetString("provision.prometheus_sd_refresh_interval"))
 	return c.JSON(http.StatusOK, sd)
 }
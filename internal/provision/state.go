@@ -0,0 +1,47 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package provision
+
+import (
+	"errors"
+
+	"github.com/labstack/echo/v4"
+	"github.com/ubccr/grendel/internal/config"
+	"github.com/ubccr/grendel/internal/store"
+	"github.com/ubccr/grendel/pkg/model"
+)
+
+// DefaultStates are the host states served boot scripts and templates unless
+// provision.states is set
+var DefaultStates = []string{model.HostStateReady, model.HostStateInstalling}
+
+// provisioning returns whether the host is served boot scripts and templates
+// in its state
+func provisioning(host *model.Host) bool {
+	states := config.Viper().GetStringSlice("provision.states")
+	if len(states) == 0 {
+		states = DefaultStates
+	}
+
+	return host.InState(states)
+}
+
+// startInstall moves a host ready to provision to installing once it fetched
+// its boot script. The install goes on when the state can not be saved, such
+// as in read-only mode
+func (h *Handler) startInstall(c echo.Context, host *model.Host) {
+	if host.State != model.HostStateReady {
+		return
+	}
+
+	host.State = model.HostStateInstalling
+	err := h.db(c).StoreHost(host)
+	switch {
+	case errors.Is(err, store.ErrReadOnly):
+		requestLog(c).Info("Read-only mode, host state stays ready-to-provision")
+	case err != nil:
+		requestLog(c).WithField("err", err).Warn("failed to set host state to installing")
+	}
+}
@@ -8,25 +8,31 @@ import (
 	"bytes"
 	"crypto/sha256"
 	_ "embed"
+	"errors"
 	"fmt"
 	"io"
 	"io/ioutil"
 	"path/filepath"
 	"strings"
+	"sync"
 	"text/template"
 
 	"github.com/GehirnInc/crypt"
 	_ "github.com/GehirnInc/crypt/sha256_crypt"
 	_ "github.com/GehirnInc/crypt/sha512_crypt"
-	"github.com/coreos/butane/config"
+	butane "github.com/coreos/butane/config"
 	"github.com/coreos/butane/config/common"
 	"github.com/labstack/echo/v4"
 	"github.com/sirupsen/logrus"
-	"github.com/spf13/viper"
+	"github.com/ubccr/grendel/internal/config"
+	"github.com/ubccr/grendel/internal/store/namespace"
 	"github.com/ubccr/grendel/pkg/model"
 )
 
-const defaultTemplateGlob = "/var/lib/grendel/templates/*.tmpl"
+// TemplateDir holds templates replacing or adding to the embedded templates
+const TemplateDir = "/var/lib/grendel/templates"
+
+const defaultTemplateGlob = TemplateDir + "/*.tmpl"
 
 //go:embed templates/ipxe.tmpl
 var ipxeTmpl string
@@ -58,13 +64,110 @@ var funcMap = template.FuncMap{
 	"CryptSHA256":            CryptSHA256,
 	"DellSHA256Password":     DellSHA256Password,
 	"NetBoxRenderConfig":     NetBoxRenderConfig,
+	"HostsFileLines":         HostsFileLines,
+	"Nodeset":                Nodeset,
+	"secret":                 noSecret,
 }
 
 type TemplateRenderer struct {
-	templates *template.Template
+	mu          sync.RWMutex
+	templates   *template.Template
+	namespaces  *namespace.Registry
+	byNamespace map[string]*template.Template
 }
 
 func NewTemplateRenderer() (*TemplateRenderer, error) {
+	tmpl, err := parseTemplates()
+	if err != nil {
+		return nil, err
+	}
+
+	t := &TemplateRenderer{
+		templates: tmpl,
+	}
+
+	return t, nil
+}
+
+// NamespaceTemplateDir returns the template directory of the namespace
+func NamespaceTemplateDir(ns *namespace.Namespace) string {
+	if ns.TemplateDir != "" {
+		return ns.TemplateDir
+	}
+
+	return filepath.Join(TemplateDir, ns.Name)
+}
+
+// SetNamespaces parses the templates of the namespaces of r, which are
+// rendered for the hosts in their subnets
+func (t *TemplateRenderer) SetNamespaces(r *namespace.Registry) error {
+	byNamespace, err := parseNamespaceTemplates(r)
+	if err != nil {
+		return err
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.namespaces = r
+	t.byNamespace = byNamespace
+
+	return nil
+}
+
+// Reload parses the templates again, returning a function replacing the
+// templates of t with them
+func (t *TemplateRenderer) Reload() (func(), error) {
+	tmpl, err := parseTemplates()
+	if err != nil {
+		return nil, err
+	}
+
+	t.mu.RLock()
+	r := t.namespaces
+	t.mu.RUnlock()
+
+	byNamespace, err := parseNamespaceTemplates(r)
+	if err != nil {
+		return nil, err
+	}
+
+	return func() {
+		t.mu.Lock()
+		defer t.mu.Unlock()
+		t.templates = tmpl
+		t.byNamespace = byNamespace
+	}, nil
+}
+
+// Check returns an error unless the templates served include every
+// embedded template
+func (t *TemplateRenderer) Check() error {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	if t.templates == nil {
+		return errors.New("templates not parsed")
+	}
+
+	all := map[string]*template.Template{namespace.Default: t.templates}
+	for name, tmpl := range t.byNamespace {
+		all[name] = tmpl
+	}
+	for ns, tmpl := range all {
+		for _, name := range []string{"ipxe.tmpl", "kickstart.tmpl", "user-data.tmpl", "meta-data.tmpl", "butane.tmpl"} {
+			if tmpl.Lookup(name) == nil {
+				return fmt.Errorf("template %s of namespace %s not parsed", name, ns)
+			}
+		}
+	}
+
+	return nil
+}
+
+// parseTemplates parses the embedded templates and the templates in
+// /var/lib/grendel/templates, which replace embedded templates of the same
+// name. The templates in dirs are parsed next, in order
+func parseTemplates(dirs ...string) (*template.Template, error) {
 	tmpl, err := template.New("ipxe.tmpl").Funcs(funcMap).Parse(ipxeTmpl)
 	if err != nil {
 		return nil, err
@@ -90,28 +193,80 @@ func NewTemplateRenderer() (*TemplateRenderer, error) {
 		return nil, err
 	}
 
-	matches, err := filepath.Glob(defaultTemplateGlob)
-	if err != nil {
-		return nil, err
+	for _, glob := range append([]string{defaultTemplateGlob}, globs(dirs)...) {
+		matches, err := filepath.Glob(glob)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(matches) > 0 {
+			tmpl, err = tmpl.Funcs(funcMap).ParseGlob(glob)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return tmpl, nil
+}
+
+func globs(dirs []string) []string {
+	globs := make([]string, 0, len(dirs))
+	for _, dir := range dirs {
+		globs = append(globs, filepath.Join(dir, "*.tmpl"))
 	}
 
-	if len(matches) > 0 {
-		tmpl, err = tmpl.Funcs(funcMap).ParseGlob(defaultTemplateGlob)
+	return globs
+}
+
+// parseNamespaceTemplates parses the templates of each namespace of r
+func parseNamespaceTemplates(r *namespace.Registry) (map[string]*template.Template, error) {
+	byNamespace := make(map[string]*template.Template)
+	if r == nil {
+		return byNamespace, nil
+	}
+
+	for _, ns := range r.Namespaces() {
+		tmpl, err := parseTemplates(NamespaceTemplateDir(ns))
 		if err != nil {
-			return nil, err
+			return nil, fmt.Errorf("failed to parse templates of namespace %s: %w", ns.Name, err)
 		}
+		byNamespace[ns.Name] = tmpl
 	}
 
-	t := &TemplateRenderer{
-		templates: tmpl,
+	return byNamespace, nil
+}
+
+// lookup returns the templates of the namespace of the request
+func (t *TemplateRenderer) lookup(c echo.Context) *template.Template {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	if t.namespaces == nil {
+		return t.templates
+	}
+	name, _ := namespaceFor(t.namespaces, c)
+	if tmpl, ok := t.byNamespace[name]; ok {
+		return tmpl
 	}
 
-	return t, nil
+	return t.templates
 }
 
 func (t *TemplateRenderer) Render(w io.Writer, name string, data interface{}, c echo.Context) error {
+	tmpl := t.lookup(c)
+
 	if viewContext, isMap := data.(map[string]interface{}); isMap {
 		viewContext["reverse"] = c.Echo().Reverse
+
+		// The secret func reads the secrets of the request being rendered
+		if secrets, ok := viewContext["secrets"].(*secretResolver); ok {
+			clone, err := tmpl.Clone()
+			if err != nil {
+				return err
+			}
+			tmpl = clone.Funcs(template.FuncMap{"secret": secrets.Secret})
+		}
 	}
 
 	ct := c.Response().Header().Get(echo.HeaderContentType)
@@ -119,7 +274,7 @@ func (t *TemplateRenderer) Render(w io.Writer, name string, data interface{}, c
 		c.Response().Header().Set(echo.HeaderContentType, echo.MIMETextPlainCharsetUTF8)
 	}
 
-	return t.templates.ExecuteTemplate(w, name, data)
+	return tmpl.ExecuteTemplate(w, name, data)
 }
 
 func (t *TemplateRenderer) RenderIgnition(code int, name string, data interface{}, c echo.Context) error {
@@ -134,7 +289,7 @@ func (t *TemplateRenderer) RenderIgnition(code int, name string, data interface{
 	}
 
 	// TODO: how should we handle warnings in the translation?
-	dataOut, _, err := config.TranslateBytes(buf.Bytes(), options)
+	dataOut, _, err := butane.TranslateBytes(buf.Bytes(), options)
 	if err != nil {
 		return err
 	}
@@ -167,15 +322,15 @@ func Mul(a, b int) int {
 }
 
 func ConfigValueString(key string) string {
-	return viper.GetString(key)
+	return config.Viper().GetString(key)
 }
 
 func ConfigValueStringSlice(key string) []string {
-	return viper.GetStringSlice(key)
+	return config.Viper().GetStringSlice(key)
 }
 
 func ConfigValueBool(key string) bool {
-	return viper.GetBool(key)
+	return config.Viper().GetBool(key)
 }
 
 func CryptSHA512(pass, salt string) string {
@@ -0,0 +1,303 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+// Package ratelimit limits the request rate of clients and boot tokens and
+// the number of concurrent requests of the HTTP services
+package ratelimit
+
+import (
+	"fmt"
+	"math"
+	"net"
+	"net/http"
+	"net/netip"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/viper"
+	"github.com/ubccr/grendel/internal/logger"
+	"golang.org/x/time/rate"
+)
+
+// Reasons a request is rejected
+const (
+	ReasonClient      = "client"
+	ReasonToken       = "token"
+	ReasonConcurrency = "concurrency"
+)
+
+// idleTimeout is how long the bucket of a client or token is kept after its
+// last request
+const idleTimeout = 10 * time.Minute
+
+var (
+	log = logger.GetLogger("RATELIMIT")
+
+	throttledTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "grendel_throttled_requests_total",
+		Help: "HTTP requests rejected by rate limits or the concurrent request cap by service and reason",
+	}, []string{"service", "reason"})
+)
+
+func init() {
+	prometheus.MustRegister(throttledTotal)
+}
+
+// Config are the limits of a service. Zero disables a limit
+type Config struct {
+	// Rate is the requests per second allowed per client IP, with bursts of
+	// up to Burst requests
+	Rate  float64
+	Burst int
+
+	// TokenRate is the requests per second allowed per boot token, with
+	// bursts of up to TokenBurst requests
+	TokenRate  float64
+	TokenBurst int
+
+	// MaxConcurrent caps the requests served at once
+	MaxConcurrent int
+
+	// Exempt clients are not limited
+	Exempt []netip.Prefix
+}
+
+// FromConfig returns the limits set in section of the configuration
+func FromConfig(v *viper.Viper, section string) (Config, error) {
+	c := Config{
+		Rate:          v.GetFloat64(section + ".rate_limit"),
+		Burst:         v.GetInt(section + ".rate_limit_burst"),
+		TokenRate:     v.GetFloat64(section + ".token_rate_limit"),
+		TokenBurst:    v.GetInt(section + ".token_rate_limit_burst"),
+		MaxConcurrent: v.GetInt(section + ".max_concurrent"),
+	}
+
+	if c.Rate < 0 || c.Burst < 0 || c.TokenRate < 0 || c.TokenBurst < 0 || c.MaxConcurrent < 0 {
+		return c, fmt.Errorf("invalid %s rate limits: values must not be negative", section)
+	}
+
+	for _, s := range v.GetStringSlice(section + ".rate_limit_exempt") {
+		prefix, err := netip.ParsePrefix(s)
+		if err != nil {
+			addr, aerr := netip.ParseAddr(s)
+			if aerr != nil {
+				return c, fmt.Errorf("invalid %s.rate_limit_exempt address: %s", section, s)
+			}
+			prefix = netip.PrefixFrom(addr, addr.BitLen())
+		}
+		c.Exempt = append(c.Exempt, unmapPrefix(prefix).Masked())
+	}
+
+	return c, nil
+}
+
+// unmapPrefix returns the IPv4 prefix of an IPv4-mapped IPv6 prefix such as
+// ::ffff:10.0.0.0/104, client addresses are compared unmapped
+func unmapPrefix(prefix netip.Prefix) netip.Prefix {
+	if !prefix.Addr().Is4In6() || prefix.Bits() < 96 {
+		return prefix
+	}
+
+	return netip.PrefixFrom(prefix.Addr().Unmap(), prefix.Bits()-96)
+}
+
+// Error rejects a request
+type Error struct {
+	Status     int
+	RetryAfter time.Duration
+	Reason     string
+}
+
+func (e *Error) Error() string {
+	if e.Reason == ReasonConcurrency {
+		return "too many concurrent requests"
+	}
+	return fmt.Sprintf("%s rate limit exceeded", e.Reason)
+}
+
+// RetryAfterSeconds returns the value of the Retry-After header, at least
+// one second
+func (e *Error) RetryAfterSeconds() string {
+	return strconv.Itoa(max(1, int(math.Ceil(e.RetryAfter.Seconds()))))
+}
+
+// Limiter limits the requests of a service. A nil Limiter allows all
+// requests
+type Limiter struct {
+	service  string
+	mu       sync.Mutex
+	config   Config
+	clients  *buckets
+	tokens   *buckets
+	inflight atomic.Int64
+}
+
+// New returns a limiter of the requests of service
+func New(service string, c Config) *Limiter {
+	l := &Limiter{service: service}
+	l.Update(c)
+
+	return l
+}
+
+// Update replaces the limits. Clients and tokens start with a full bucket
+func (l *Limiter) Update(c Config) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.config = c
+	l.clients = newBuckets(c.Rate, c.Burst)
+	l.tokens = newBuckets(c.TokenRate, c.TokenBurst)
+}
+
+// Acquire checks the limits for a request from remoteAddr made with token,
+// which may be empty. On success the returned function must be called once
+// the request is done. Requests over a unix socket are not limited
+func (l *Limiter) Acquire(remoteAddr, token string) (func(), error) {
+	if l == nil {
+		return func() {}, nil
+	}
+
+	addr, ok := clientAddr(remoteAddr)
+	if !ok {
+		return func() {}, nil
+	}
+
+	l.mu.Lock()
+	c := l.config
+	for _, prefix := range c.Exempt {
+		if prefix.Contains(addr) {
+			l.mu.Unlock()
+			return func() {}, nil
+		}
+	}
+
+	now := time.Now()
+	client := addr.String()
+	if delay, first := l.clients.take(client, now); delay > 0 {
+		l.mu.Unlock()
+		return nil, l.reject(&Error{Status: http.StatusTooManyRequests, RetryAfter: delay, Reason: ReasonClient}, client, first)
+	}
+	if token != "" {
+		if delay, first := l.tokens.take(token, now); delay > 0 {
+			l.mu.Unlock()
+			return nil, l.reject(&Error{Status: http.StatusTooManyRequests, RetryAfter: delay, Reason: ReasonToken}, client, first)
+		}
+	}
+	l.mu.Unlock()
+
+	if c.MaxConcurrent > 0 {
+		if l.inflight.Add(1) > int64(c.MaxConcurrent) {
+			l.inflight.Add(-1)
+			return nil, l.reject(&Error{Status: http.StatusServiceUnavailable, RetryAfter: time.Second, Reason: ReasonConcurrency}, client, false)
+		}
+		return func() { l.inflight.Add(-1) }, nil
+	}
+
+	return func() {}, nil
+}
+
+// reject counts a rejected request. Clients are logged when they start being
+// throttled rather than on every request
+func (l *Limiter) reject(err *Error, client string, first bool) error {
+	throttledTotal.WithLabelValues(l.service, err.Reason).Inc()
+
+	entry := log.WithFields(logrus.Fields{
+		logger.FieldService: l.service,
+		logger.FieldIP:      client,
+		"reason":            err.Reason,
+	})
+	if first {
+		entry.Warnf("Throttling requests, retry after %s", err.RetryAfter.Round(time.Millisecond))
+	} else {
+		entry.Debug(err.Error())
+	}
+
+	return err
+}
+
+// clientAddr returns the IP address of remoteAddr, false for addresses
+// without one such as unix sockets
+func clientAddr(remoteAddr string) (netip.Addr, bool) {
+	host, _, err := net.SplitHostPort(remoteAddr)
+	if err != nil {
+		host = remoteAddr
+	}
+
+	addr, err := netip.ParseAddr(host)
+	if err != nil {
+		return netip.Addr{}, false
+	}
+
+	return addr.Unmap(), true
+}
+
+// buckets are the token buckets of clients or boot tokens
+type buckets struct {
+	limit   rate.Limit
+	burst   int
+	entries map[string]*bucket
+	pruned  time.Time
+}
+
+type bucket struct {
+	limiter   *rate.Limiter
+	seen      time.Time
+	throttled bool
+}
+
+func newBuckets(r float64, burst int) *buckets {
+	if burst <= 0 {
+		burst = max(1, int(math.Ceil(r)))
+	}
+
+	return &buckets{
+		limit:   rate.Limit(r),
+		burst:   burst,
+		entries: make(map[string]*bucket),
+		pruned:  time.Now(),
+	}
+}
+
+// take takes a token from the bucket of key and returns zero, or returns how
+// long until a token is available when the bucket is empty along with
+// whether the previous request of key was allowed
+func (b *buckets) take(key string, now time.Time) (time.Duration, bool) {
+	if b.limit == 0 {
+		return 0, false
+	}
+
+	if now.Sub(b.pruned) > idleTimeout {
+		for k, e := range b.entries {
+			if now.Sub(e.seen) > idleTimeout {
+				delete(b.entries, k)
+			}
+		}
+		b.pruned = now
+	}
+
+	e, ok := b.entries[key]
+	if !ok {
+		e = &bucket{limiter: rate.NewLimiter(b.limit, b.burst)}
+		b.entries[key] = e
+	}
+	e.seen = now
+
+	r := e.limiter.ReserveN(now, 1)
+	delay := r.DelayFrom(now)
+	if delay == 0 {
+		e.throttled = false
+		return 0, false
+	}
+	r.CancelAt(now)
+
+	first := !e.throttled
+	e.throttled = true
+
+	return delay, first
+}
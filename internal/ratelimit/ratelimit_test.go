@@ -0,0 +1,148 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package ratelimit
+
+import (
+	"net/http"
+	"net/netip"
+	"strings"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFromConfig(t *testing.T) {
+	v := viper.New()
+	v.SetConfigType("toml")
+	require.NoError(t, v.ReadConfig(strings.NewReader(`
+[provision]
+rate_limit = 2.5
+token_rate_limit = 1
+token_rate_limit_burst = 4
+max_concurrent = 100
+rate_limit_exempt = ["10.0.0.0/8", "192.168.1.5", "2001:db8::/32", "::ffff:172.16.0.0/108", "::ffff:192.168.2.1"]
+`)))
+
+	c, err := FromConfig(v, "provision")
+	require.NoError(t, err)
+	assert.Equal(t, 2.5, c.Rate)
+	assert.Equal(t, 4, c.TokenBurst)
+	assert.Equal(t, 100, c.MaxConcurrent)
+	assert.Equal(t, []netip.Prefix{
+		netip.MustParsePrefix("10.0.0.0/8"),
+		netip.MustParsePrefix("192.168.1.5/32"),
+		netip.MustParsePrefix("2001:db8::/32"),
+		netip.MustParsePrefix("172.16.0.0/12"),
+		netip.MustParsePrefix("192.168.2.1/32"),
+	}, c.Exempt)
+
+	v.Set("provision.rate_limit_exempt", []string{"10.0.0.x"})
+	_, err = FromConfig(v, "provision")
+	assert.ErrorContains(t, err, "invalid provision.rate_limit_exempt address: 10.0.0.x")
+
+	v.Set("provision.rate_limit_exempt", nil)
+	v.Set("provision.max_concurrent", -1)
+	_, err = FromConfig(v, "provision")
+	assert.Error(t, err)
+}
+
+func TestLimiter(t *testing.T) {
+	l := New("test", Config{
+		Rate:   1,
+		Burst:  2,
+		Exempt: []netip.Prefix{netip.MustParsePrefix("10.1.0.0/16"), netip.MustParsePrefix("2001:db8:1::/48")},
+	})
+
+	before := testutil.ToFloat64(throttledTotal.WithLabelValues("test", ReasonClient))
+
+	for i := 0; i < 2; i++ {
+		release, err := l.Acquire("10.0.0.1:1234", "")
+		require.NoError(t, err)
+		release()
+	}
+
+	_, err := l.Acquire("10.0.0.1:1234", "")
+	require.Error(t, err)
+	rerr := err.(*Error)
+	assert.Equal(t, http.StatusTooManyRequests, rerr.Status)
+	assert.Equal(t, ReasonClient, rerr.Reason)
+	assert.Equal(t, "1", rerr.RetryAfterSeconds())
+	assert.Equal(t, before+1, testutil.ToFloat64(throttledTotal.WithLabelValues("test", ReasonClient)))
+
+	// Other clients have their own bucket
+	_, err = l.Acquire("10.0.0.2:1234", "")
+	assert.NoError(t, err)
+
+	// IPv4 clients of a dual-stack socket share the bucket of their IPv4
+	// address
+	_, err = l.Acquire("[::ffff:10.0.0.2]:1234", "")
+	assert.NoError(t, err)
+	_, err = l.Acquire("10.0.0.2:1234", "")
+	require.Error(t, err)
+
+	for i := 0; i < 2; i++ {
+		_, err = l.Acquire("[2001:db8:2::1]:1234", "")
+		assert.NoError(t, err)
+	}
+	_, err = l.Acquire("[2001:db8:2::1]:1234", "")
+	assert.Error(t, err)
+
+	// Exempt clients and unix sockets are not limited
+	for i := 0; i < 5; i++ {
+		_, err = l.Acquire("10.1.2.3:1234", "")
+		assert.NoError(t, err)
+		_, err = l.Acquire("[::ffff:10.1.2.3]:1234", "")
+		assert.NoError(t, err)
+		_, err = l.Acquire("[2001:db8:1::5]:1234", "")
+		assert.NoError(t, err)
+		_, err = l.Acquire("@", "")
+		assert.NoError(t, err)
+	}
+
+	// Updating the limits starts clients with a full bucket
+	l.Update(Config{Rate: 1, Burst: 1})
+	_, err = l.Acquire("10.0.0.1:1234", "")
+	assert.NoError(t, err)
+}
+
+func TestLimiterToken(t *testing.T) {
+	l := New("test", Config{TokenRate: 0.1})
+
+	_, err := l.Acquire("10.0.0.1:1234", "token")
+	require.NoError(t, err)
+
+	// The token is limited across clients
+	_, err = l.Acquire("10.0.0.2:1234", "token")
+	require.Error(t, err)
+	assert.Equal(t, ReasonToken, err.(*Error).Reason)
+	assert.Equal(t, "10", err.(*Error).RetryAfterSeconds())
+
+	_, err = l.Acquire("10.0.0.2:1234", "")
+	assert.NoError(t, err)
+}
+
+func TestLimiterConcurrency(t *testing.T) {
+	l := New("test", Config{MaxConcurrent: 1})
+
+	release, err := l.Acquire("10.0.0.1:1234", "")
+	require.NoError(t, err)
+
+	_, err = l.Acquire("10.0.0.2:1234", "")
+	require.Error(t, err)
+	assert.Equal(t, http.StatusServiceUnavailable, err.(*Error).Status)
+	assert.Equal(t, ReasonConcurrency, err.(*Error).Reason)
+
+	release()
+	_, err = l.Acquire("10.0.0.2:1234", "")
+	assert.NoError(t, err)
+
+	// A nil limiter allows everything
+	var nl *Limiter
+	_, err = nl.Acquire("10.0.0.1:1234", "token")
+	assert.NoError(t, err)
+}
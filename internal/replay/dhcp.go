@@ -0,0 +1,192 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package replay
+
+import (
+	"bytes"
+	"fmt"
+	"net"
+	"slices"
+	"strings"
+
+	"github.com/insomniacslk/dhcp/dhcpv4"
+)
+
+// dhcpExchange is a request of the capture and the reply captured, nil when
+// the captured server did not answer
+type dhcpExchange struct {
+	req     *dhcpv4.DHCPv4
+	reply   *dhcpv4.DHCPv4
+	replyIP net.IP
+}
+
+// dhcpExchanges pairs each request of packets with the next reply with the
+// same transaction id and client hardware address
+func dhcpExchanges(packets []Packet) []*dhcpExchange {
+	var exchanges []*dhcpExchange
+	pending := make(map[string][]*dhcpExchange)
+
+	for _, p := range packets {
+		if p.Src.Port() != dhcpv4.ClientPort && p.Src.Port() != dhcpv4.ServerPort {
+			continue
+		}
+		if p.Dst.Port() != dhcpv4.ClientPort && p.Dst.Port() != dhcpv4.ServerPort {
+			continue
+		}
+
+		msg, err := dhcpv4.FromBytes(p.Payload)
+		if err != nil {
+			continue
+		}
+
+		key := fmt.Sprintf("%s/%s", msg.TransactionID, msg.ClientHWAddr)
+		switch msg.OpCode {
+		case dhcpv4.OpcodeBootRequest:
+			// A capture on the relay has the request of the client and its
+			// relayed copy, the server only receives the latter
+			if n := len(pending[key]); n > 0 {
+				last := pending[key][n-1]
+				if last.req.MessageType() == msg.MessageType() && last.req.GatewayIPAddr.IsUnspecified() && !msg.GatewayIPAddr.IsUnspecified() {
+					last.req = msg
+					continue
+				}
+			}
+			x := &dhcpExchange{req: msg}
+			exchanges = append(exchanges, x)
+			pending[key] = append(pending[key], x)
+		case dhcpv4.OpcodeBootReply:
+			waiting := pending[key]
+			if len(waiting) == 0 {
+				continue
+			}
+			waiting[0].reply = msg
+			waiting[0].replyIP = net.IP(p.Src.Addr().AsSlice())
+			pending[key] = waiting[1:]
+		}
+	}
+
+	return exchanges
+}
+
+// serverIP returns the address grendel answers x with: the ServerIP of the
+// replayer, else the server identifier or source address of the captured
+// reply, else the server identifier of the request
+func (r *Replayer) serverIP(x *dhcpExchange) net.IP {
+	switch {
+	case r.ServerIP != nil:
+		return r.ServerIP
+	case x.reply != nil && x.reply.ServerIdentifier() != nil:
+		return x.reply.ServerIdentifier()
+	case x.replyIP != nil && !x.replyIP.IsUnspecified():
+		return x.replyIP
+	default:
+		return x.req.ServerIdentifier()
+	}
+}
+
+func (r *Replayer) replayDHCP(packets []Packet, report *Report) {
+	for _, x := range dhcpExchanges(packets) {
+		serverIP := r.serverIP(x)
+		if serverIP == nil {
+			report.DHCP.Queries++
+			report.DHCP.Skipped++
+			continue
+		}
+
+		query := fmt.Sprintf("%s %s xid %s", x.req.ClientHWAddr, x.req.MessageType(), x.req.TransactionID)
+		r.record(report, &report.DHCP, ProtocolDHCP, query, diffDHCP(x.reply, r.DHCP(x.req, serverIP)))
+	}
+}
+
+// diffDHCP returns the differences between the captured reply and the reply
+// of grendel
+func diffDHCP(captured, got *dhcpv4.DHCPv4) *diffs {
+	d := &diffs{}
+	switch {
+	case captured == nil && got == nil:
+		return d
+	case captured == nil:
+		d.add("reply", "captured no reply, grendel replies %s", got.MessageType())
+		return d
+	case got == nil:
+		d.add("reply", "captured %s, grendel does not reply", captured.MessageType())
+		return d
+	}
+
+	if captured.MessageType() != got.MessageType() {
+		d.add("message type", "captured %s, grendel %s", captured.MessageType(), got.MessageType())
+	}
+	if !captured.YourIPAddr.Equal(got.YourIPAddr) {
+		d.add("yiaddr", "captured %s, grendel %s", captured.YourIPAddr, got.YourIPAddr)
+	}
+	if !captured.ServerIPAddr.Equal(got.ServerIPAddr) {
+		d.add("siaddr", "captured %s, grendel %s", captured.ServerIPAddr, got.ServerIPAddr)
+	}
+	if captured.BootFileName != got.BootFileName {
+		d.add("file", "captured %q, grendel %q", captured.BootFileName, got.BootFileName)
+	}
+	if captured.ServerHostName != got.ServerHostName {
+		d.add("sname", "captured %q, grendel %q", captured.ServerHostName, got.ServerHostName)
+	}
+
+	codes := make([]uint8, 0, len(captured.Options)+len(got.Options))
+	for code := range captured.Options {
+		codes = append(codes, code)
+	}
+	for code := range got.Options {
+		if _, ok := captured.Options[code]; !ok {
+			codes = append(codes, code)
+		}
+	}
+	slices.Sort(codes)
+
+	for _, code := range codes {
+		if code == dhcpv4.OptionDHCPMessageType.Code() {
+			continue
+		}
+
+		field := optionField(code, captured.Options, got.Options)
+		want, inCaptured := captured.Options[code]
+		have, inGot := got.Options[code]
+		switch {
+		case !inGot:
+			d.add(field+" missing", "captured %s", optionValue(code, want))
+		case !inCaptured:
+			d.add(field+" extra", "grendel %s", optionValue(code, have))
+		case !bytes.Equal(want, have):
+			d.add(field+" value", "captured %s, grendel %s", optionValue(code, want), optionValue(code, have))
+		}
+	}
+
+	return d
+}
+
+// optionField returns the field of the differences of an option, with the
+// name of the option when known
+func optionField(code uint8, opts ...dhcpv4.Options) string {
+	for _, o := range opts {
+		if data, ok := o[code]; ok {
+			name, _ := option(code, data)
+			if !strings.HasPrefix(name, "unknown") {
+				return fmt.Sprintf("option %d (%s)", code, name)
+			}
+			break
+		}
+	}
+
+	return fmt.Sprintf("option %d", code)
+}
+
+// optionValue returns the value of an option as printed by dhcpv4
+func optionValue(code uint8, data []byte) string {
+	_, value := option(code, data)
+	return value
+}
+
+func option(code uint8, data []byte) (name, value string) {
+	s := strings.TrimSpace(dhcpv4.Options{code: data}.String())
+	name, value, _ = strings.Cut(s, ": ")
+	return name, value
+}
@@ -0,0 +1,155 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package replay
+
+import (
+	"fmt"
+	"maps"
+	"net/netip"
+	"slices"
+
+	"github.com/miekg/dns"
+)
+
+const dnsPort = 53
+
+// dnsExchange is a query of the capture and the response captured, nil when
+// the captured server did not answer
+type dnsExchange struct {
+	client netip.AddrPort
+	query  *dns.Msg
+	reply  *dns.Msg
+}
+
+// dnsExchanges pairs each query of packets sent to servers, any server when
+// empty, with the next response to the same client with the same id and
+// question
+func dnsExchanges(packets []Packet, servers []netip.Addr) []*dnsExchange {
+	var exchanges []*dnsExchange
+	pending := make(map[string][]*dnsExchange)
+
+	key := func(client, server netip.AddrPort, m *dns.Msg) string {
+		q := ""
+		if len(m.Question) > 0 {
+			q = m.Question[0].String()
+		}
+		return fmt.Sprintf("%s/%s/%d/%s", client, server, m.Id, q)
+	}
+
+	for _, p := range packets {
+		if p.Src.Port() != dnsPort && p.Dst.Port() != dnsPort {
+			continue
+		}
+
+		m := new(dns.Msg)
+		if err := m.Unpack(p.Payload); err != nil {
+			continue
+		}
+
+		switch {
+		case !m.Response && p.Dst.Port() == dnsPort:
+			if len(servers) > 0 && !slices.Contains(servers, p.Dst.Addr().Unmap()) {
+				continue
+			}
+			x := &dnsExchange{client: p.Src, query: m}
+			exchanges = append(exchanges, x)
+			k := key(p.Src, p.Dst, m)
+			pending[k] = append(pending[k], x)
+		case m.Response && p.Src.Port() == dnsPort:
+			k := key(p.Dst, p.Src, m)
+			waiting := pending[k]
+			if len(waiting) == 0 {
+				continue
+			}
+			waiting[0].reply = m
+			pending[k] = waiting[1:]
+		}
+	}
+
+	return exchanges
+}
+
+func (r *Replayer) replayDNS(packets []Packet, report *Report) {
+	for _, x := range dnsExchanges(packets, r.DNSServers) {
+		if len(x.query.Question) != 1 {
+			report.DNS.Queries++
+			report.DNS.Skipped++
+			continue
+		}
+
+		q := x.query.Question[0]
+		if q.Qtype == dns.TypeAXFR || q.Qtype == dns.TypeIXFR {
+			// Zone transfers span several messages
+			report.DNS.Queries++
+			report.DNS.Skipped++
+			continue
+		}
+
+		reply, forwarded := r.DNS(x.client, x.query)
+		if forwarded {
+			report.DNS.Queries++
+			report.DNS.Skipped++
+			continue
+		}
+
+		query := fmt.Sprintf("%s %s from %s", q.Name, dns.TypeToString[q.Qtype], x.client.Addr())
+		r.record(report, &report.DNS, ProtocolDNS, query, diffDNS(x.reply, reply))
+	}
+}
+
+// diffDNS returns the differences between the captured response and the
+// reply of grendel
+func diffDNS(captured, got *dns.Msg) *diffs {
+	d := &diffs{}
+	switch {
+	case captured == nil && got == nil:
+		return d
+	case captured == nil:
+		d.add("reply", "captured no reply, grendel replies %s", dns.RcodeToString[got.Rcode])
+		return d
+	case got == nil:
+		d.add("reply", "captured %s, grendel does not reply", dns.RcodeToString[captured.Rcode])
+		return d
+	}
+
+	if captured.Rcode != got.Rcode {
+		d.add("rcode", "captured %s, grendel %s", dns.RcodeToString[captured.Rcode], dns.RcodeToString[got.Rcode])
+	}
+	if captured.Authoritative != got.Authoritative {
+		d.add("authoritative", "captured %t, grendel %t", captured.Authoritative, got.Authoritative)
+	}
+
+	want := answers(captured.Answer)
+	have := answers(got.Answer)
+	for _, rr := range slices.Sorted(maps.Keys(want)) {
+		ttl := want[rr]
+		haveTTL, ok := have[rr]
+		switch {
+		case !ok:
+			d.add("answer missing", "captured %s", rr)
+		case haveTTL != ttl:
+			d.add("ttl", "%s captured %d, grendel %d", rr, ttl, haveTTL)
+		}
+	}
+	for _, rr := range slices.Sorted(maps.Keys(have)) {
+		if _, ok := want[rr]; !ok {
+			d.add("answer extra", "grendel %s", rr)
+		}
+	}
+
+	return d
+}
+
+// answers returns the TTL of each record of rrs by the record without TTL
+func answers(rrs []dns.RR) map[string]uint32 {
+	m := make(map[string]uint32, len(rrs))
+	for _, rr := range rrs {
+		c := dns.Copy(rr)
+		c.Header().Ttl = 0
+		m[c.String()] = rr.Header().Ttl
+	}
+
+	return m
+}
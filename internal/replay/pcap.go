@@ -0,0 +1,328 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package replay
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"net/netip"
+	"time"
+)
+
+// Link types of the captures read
+const (
+	linkNull     = 0
+	linkEthernet = 1
+	linkRaw      = 101
+	linkLoop     = 108
+	linkSLL      = 113
+	linkIPv4     = 228
+	linkIPv6     = 229
+	linkSLL2     = 276
+)
+
+const (
+	pcapMagicMicro        = 0xa1b2c3d4
+	pcapMagicNano         = 0xa1b23c4d
+	pcapngSectionHeader   = 0x0a0d0d0a
+	pcapngByteOrderMagic  = 0x1a2b3c4d
+	pcapngInterface       = 0x00000001
+	pcapngSimplePacket    = 0x00000003
+	pcapngEnhancedPacket  = 0x00000006
+	pcapngOptionTSResol   = 9
+	pcapngOptionEnd       = 0
+	maxCaptureRecordBytes = 1 << 20
+)
+
+// Packet is the payload of a UDP datagram read from a capture
+type Packet struct {
+	Time    time.Time
+	Src     netip.AddrPort
+	Dst     netip.AddrPort
+	Payload []byte
+}
+
+// pcapngInterfaceDesc is an interface of a pcapng section
+type pcapngInterfaceDesc struct {
+	link int
+	unit time.Duration
+}
+
+// ReadPackets returns the UDP datagrams of a capture in libpcap or pcapng
+// format, in capture order. Other packets, and fragments of IP datagrams,
+// are skipped
+func ReadPackets(r io.Reader) ([]Packet, error) {
+	br := bufio.NewReader(r)
+	magic, err := br.Peek(4)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read capture header: %w", err)
+	}
+
+	if binary.LittleEndian.Uint32(magic) == pcapngSectionHeader {
+		return readPcapng(br)
+	}
+
+	return readPcap(br)
+}
+
+func readPcap(r io.Reader) ([]Packet, error) {
+	hdr := make([]byte, 24)
+	if _, err := io.ReadFull(r, hdr); err != nil {
+		return nil, fmt.Errorf("failed to read capture header: %w", err)
+	}
+
+	var order binary.ByteOrder
+	unit := time.Microsecond
+	switch {
+	case binary.LittleEndian.Uint32(hdr) == pcapMagicMicro:
+		order = binary.LittleEndian
+	case binary.BigEndian.Uint32(hdr) == pcapMagicMicro:
+		order = binary.BigEndian
+	case binary.LittleEndian.Uint32(hdr) == pcapMagicNano:
+		order, unit = binary.LittleEndian, time.Nanosecond
+	case binary.BigEndian.Uint32(hdr) == pcapMagicNano:
+		order, unit = binary.BigEndian, time.Nanosecond
+	default:
+		return nil, errors.New("not a pcap or pcapng capture")
+	}
+	link := int(order.Uint32(hdr[20:]) & 0x0fffffff)
+
+	var packets []Packet
+	rec := make([]byte, 16)
+	for {
+		if _, err := io.ReadFull(r, rec); err != nil {
+			if errors.Is(err, io.EOF) {
+				return packets, nil
+			}
+			return nil, fmt.Errorf("truncated capture: %w", err)
+		}
+
+		caplen := order.Uint32(rec[8:])
+		if caplen > maxCaptureRecordBytes {
+			return nil, fmt.Errorf("invalid capture record length %d", caplen)
+		}
+		data := make([]byte, caplen)
+		if _, err := io.ReadFull(r, data); err != nil {
+			return nil, fmt.Errorf("truncated capture: %w", err)
+		}
+
+		ts := time.Unix(int64(order.Uint32(rec)), int64(order.Uint32(rec[4:]))*int64(unit))
+		if p, ok := decode(link, data); ok {
+			p.Time = ts
+			packets = append(packets, p)
+		}
+	}
+}
+
+func readPcapng(r io.Reader) ([]Packet, error) {
+	var (
+		order      binary.ByteOrder = binary.LittleEndian
+		interfaces []pcapngInterfaceDesc
+		packets    []Packet
+	)
+
+	hdr := make([]byte, 8)
+	for {
+		if _, err := io.ReadFull(r, hdr); err != nil {
+			if errors.Is(err, io.EOF) {
+				return packets, nil
+			}
+			return nil, fmt.Errorf("truncated capture: %w", err)
+		}
+
+		if binary.LittleEndian.Uint32(hdr) == pcapngSectionHeader {
+			bom := make([]byte, 4)
+			if _, err := io.ReadFull(r, bom); err != nil {
+				return nil, fmt.Errorf("truncated capture: %w", err)
+			}
+			order = binary.LittleEndian
+			if binary.BigEndian.Uint32(bom) == pcapngByteOrderMagic {
+				order = binary.BigEndian
+			}
+			interfaces = nil
+
+			length := order.Uint32(hdr[4:])
+			if length < 16 || length > maxCaptureRecordBytes {
+				return nil, fmt.Errorf("invalid pcapng block length %d", length)
+			}
+			if _, err := io.CopyN(io.Discard, r, int64(length-12)); err != nil {
+				return nil, fmt.Errorf("truncated capture: %w", err)
+			}
+			continue
+		}
+
+		kind := order.Uint32(hdr)
+		length := order.Uint32(hdr[4:])
+		if length < 12 || length > maxCaptureRecordBytes {
+			return nil, fmt.Errorf("invalid pcapng block length %d", length)
+		}
+		body := make([]byte, length-8)
+		if _, err := io.ReadFull(r, body); err != nil {
+			return nil, fmt.Errorf("truncated capture: %w", err)
+		}
+		body = body[:len(body)-4]
+
+		switch kind {
+		case pcapngInterface:
+			if len(body) < 8 {
+				return nil, errors.New("invalid pcapng interface block")
+			}
+			interfaces = append(interfaces, pcapngInterfaceDesc{
+				link: int(order.Uint16(body)),
+				unit: tsResolution(order, body[8:]),
+			})
+		case pcapngEnhancedPacket:
+			if len(body) < 20 {
+				return nil, errors.New("invalid pcapng packet block")
+			}
+			id := int(order.Uint32(body))
+			if id >= len(interfaces) {
+				return nil, fmt.Errorf("pcapng packet of unknown interface %d", id)
+			}
+			caplen := int(order.Uint32(body[12:]))
+			if 20+caplen > len(body) {
+				return nil, errors.New("invalid pcapng packet block")
+			}
+
+			ts := uint64(order.Uint32(body[4:]))<<32 | uint64(order.Uint32(body[8:]))
+			if p, ok := decode(interfaces[id].link, body[20:20+caplen]); ok {
+				p.Time = time.Unix(0, 0).Add(time.Duration(ts) * interfaces[id].unit)
+				packets = append(packets, p)
+			}
+		case pcapngSimplePacket:
+			if len(interfaces) == 0 || len(body) < 4 {
+				return nil, errors.New("invalid pcapng simple packet block")
+			}
+			if p, ok := decode(interfaces[0].link, body[4:]); ok {
+				packets = append(packets, p)
+			}
+		}
+	}
+}
+
+// tsResolution returns the timestamp unit of the options of a pcapng
+// interface block, microseconds by default
+func tsResolution(order binary.ByteOrder, opts []byte) time.Duration {
+	for len(opts) >= 4 {
+		code := order.Uint16(opts)
+		length := int(order.Uint16(opts[2:]))
+		if code == pcapngOptionEnd || 4+length > len(opts) {
+			break
+		}
+		if code == pcapngOptionTSResol && length == 1 {
+			v := opts[4]
+			if v&0x80 != 0 {
+				// Powers of two are not used in practice
+				break
+			}
+			unit := time.Second
+			for range v {
+				unit /= 10
+			}
+			if unit > 0 {
+				return unit
+			}
+			break
+		}
+		opts = opts[4+(length+3)&^3:]
+	}
+
+	return time.Microsecond
+}
+
+// decode returns the UDP datagram of a frame of the given link type
+func decode(link int, data []byte) (Packet, bool) {
+	var proto uint16
+	switch link {
+	case linkEthernet:
+		if len(data) < 14 {
+			return Packet{}, false
+		}
+		proto, data = binary.BigEndian.Uint16(data[12:]), data[14:]
+		// 802.1Q and 802.1ad VLAN tags
+		for (proto == 0x8100 || proto == 0x88a8) && len(data) >= 4 {
+			proto, data = binary.BigEndian.Uint16(data[2:]), data[4:]
+		}
+	case linkSLL:
+		if len(data) < 16 {
+			return Packet{}, false
+		}
+		proto, data = binary.BigEndian.Uint16(data[14:]), data[16:]
+	case linkSLL2:
+		if len(data) < 20 {
+			return Packet{}, false
+		}
+		proto, data = binary.BigEndian.Uint16(data), data[20:]
+	case linkNull, linkLoop:
+		if len(data) < 4 {
+			return Packet{}, false
+		}
+		data = data[4:]
+	case linkRaw, linkIPv4, linkIPv6:
+	default:
+		return Packet{}, false
+	}
+
+	if len(data) == 0 {
+		return Packet{}, false
+	}
+	switch {
+	case proto == 0x0800 || (proto == 0 && data[0]>>4 == 4):
+		return decodeIPv4(data)
+	case proto == 0x86dd || (proto == 0 && data[0]>>4 == 6):
+		return decodeIPv6(data)
+	}
+
+	return Packet{}, false
+}
+
+func decodeIPv4(data []byte) (Packet, bool) {
+	if len(data) < 20 {
+		return Packet{}, false
+	}
+	ihl := int(data[0]&0x0f) * 4
+	total := int(binary.BigEndian.Uint16(data[2:]))
+	frag := binary.BigEndian.Uint16(data[6:])
+	if ihl < 20 || total < ihl || total > len(data) || data[9] != 17 || frag&0x3fff != 0 {
+		return Packet{}, false
+	}
+
+	src := netip.AddrFrom4([4]byte(data[12:16]))
+	dst := netip.AddrFrom4([4]byte(data[16:20]))
+	return decodeUDP(src, dst, data[ihl:total])
+}
+
+func decodeIPv6(data []byte) (Packet, bool) {
+	if len(data) < 40 || data[6] != 17 {
+		return Packet{}, false
+	}
+	length := int(binary.BigEndian.Uint16(data[4:]))
+	if 40+length > len(data) {
+		return Packet{}, false
+	}
+
+	src := netip.AddrFrom16([16]byte(data[8:24]))
+	dst := netip.AddrFrom16([16]byte(data[24:40]))
+	return decodeUDP(src, dst, data[40:40+length])
+}
+
+func decodeUDP(src, dst netip.Addr, data []byte) (Packet, bool) {
+	if len(data) < 8 {
+		return Packet{}, false
+	}
+	length := int(binary.BigEndian.Uint16(data[4:]))
+	if length < 8 || length > len(data) {
+		return Packet{}, false
+	}
+
+	return Packet{
+		Src:     netip.AddrPortFrom(src, binary.BigEndian.Uint16(data)),
+		Dst:     netip.AddrPortFrom(dst, binary.BigEndian.Uint16(data[2:])),
+		Payload: data[8:length],
+	}, true
+}
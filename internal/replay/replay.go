@@ -0,0 +1,204 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+// Package replay answers the DHCP and DNS queries of a packet capture with
+// the handlers of grendel, without sending packets, and reports where the
+// answers differ from the responses of the capture. It validates a migration
+// from another DHCP or DNS server before the cutover
+package replay
+
+import (
+	"cmp"
+	"fmt"
+	"net"
+	"net/netip"
+	"slices"
+	"strings"
+
+	"github.com/insomniacslk/dhcp/dhcpv4"
+	"github.com/miekg/dns"
+)
+
+// DefaultMaxExamples is the number of examples kept for each difference
+const DefaultMaxExamples = 3
+
+// Protocols of the report
+const (
+	ProtocolDHCP = "dhcp"
+	ProtocolDNS  = "dns"
+)
+
+// DHCPReplier returns the reply of grendel to a DHCP request received by the
+// server with address serverIP, nil when it is not answered
+type DHCPReplier func(req *dhcpv4.DHCPv4, serverIP net.IP) *dhcpv4.DHCPv4
+
+// DNSReplier returns the reply of grendel to a DNS query of client, nil
+// when it is dropped. forwarded is true when the query would be forwarded to
+// dns.forward, which is not done in a replay
+type DNSReplier func(client netip.AddrPort, req *dns.Msg) (reply *dns.Msg, forwarded bool)
+
+// Replayer replays the queries of a capture. Protocols without a replier
+// are skipped
+type Replayer struct {
+	DHCP DHCPReplier
+	DNS  DNSReplier
+
+	// ServerIP is the address of the DHCP server used for every request.
+	// When unset each request uses the server identifier of the captured
+	// reply, as if grendel took over the address of the server captured
+	ServerIP net.IP
+
+	// DNSServers restricts the DNS queries replayed to those sent to these
+	// addresses, such as to skip the queries the captured server forwarded
+	DNSServers []netip.Addr
+
+	// Ignore are the fields not compared, such as "siaddr" or "option 51".
+	// A field ignores its sub fields, "option 51" ignores "option 51 (IP
+	// Addresses Lease Time) value"
+	Ignore []string
+
+	// MaxExamples is the number of examples kept for each difference
+	MaxExamples int
+}
+
+// Stats counts the queries of a protocol replayed
+type Stats struct {
+	// Queries is the number of queries replayed
+	Queries int `json:"queries"`
+
+	// Matched is the number of queries answered as in the capture
+	Matched int `json:"matched"`
+
+	// Different is the number of queries with at least one difference
+	Different int `json:"different"`
+
+	// Skipped is the number of queries not compared, such as the DNS queries
+	// grendel would forward
+	Skipped int `json:"skipped"`
+}
+
+// Difference is a field answered differently than in the capture
+type Difference struct {
+	Protocol string   `json:"protocol"`
+	Field    string   `json:"field"`
+	Count    int      `json:"count"`
+	Examples []string `json:"examples"`
+}
+
+// Report is the outcome of a replay. Differences are ordered by protocol
+// and decreasing count
+type Report struct {
+	DHCP        Stats         `json:"dhcp"`
+	DNS         Stats         `json:"dns"`
+	Differences []*Difference `json:"differences"`
+}
+
+// Different returns the number of queries with at least one difference
+func (r *Report) Different() int {
+	return r.DHCP.Different + r.DNS.Different
+}
+
+// Compared returns the number of queries compared
+func (r *Report) Compared() int {
+	return r.DHCP.Matched + r.DHCP.Different + r.DNS.Matched + r.DNS.Different
+}
+
+// DifferentPercent returns the percentage of the compared queries with at
+// least one difference
+func (r *Report) DifferentPercent() float64 {
+	if r.Compared() == 0 {
+		return 0
+	}
+
+	return 100 * float64(r.Different()) / float64(r.Compared())
+}
+
+// Exceeds returns an error when more than maxDiffs queries differ, or more
+// than maxPercent percent of them when maxPercent is positive
+func (r *Report) Exceeds(maxDiffs int, maxPercent float64) error {
+	if maxPercent > 0 {
+		if r.DifferentPercent() > maxPercent {
+			return fmt.Errorf("%.2f%% of the queries differ, over the threshold of %.2f%%", r.DifferentPercent(), maxPercent)
+		}
+		return nil
+	}
+
+	if r.Different() > maxDiffs {
+		return fmt.Errorf("%d queries differ, over the threshold of %d", r.Different(), maxDiffs)
+	}
+
+	return nil
+}
+
+// Run replays the queries of packets and returns the differences
+func (r *Replayer) Run(packets []Packet) *Report {
+	report := &Report{Differences: []*Difference{}}
+	if r.DHCP != nil {
+		r.replayDHCP(packets, report)
+	}
+	if r.DNS != nil {
+		r.replayDNS(packets, report)
+	}
+
+	slices.SortStableFunc(report.Differences, func(a, b *Difference) int {
+		return cmp.Or(cmp.Compare(a.Protocol, b.Protocol), cmp.Compare(b.Count, a.Count), cmp.Compare(a.Field, b.Field))
+	})
+
+	return report
+}
+
+// diffs collects the differences of one query
+type diffs struct {
+	fields   []string
+	examples []string
+}
+
+func (d *diffs) add(field, format string, args ...any) {
+	d.fields = append(d.fields, field)
+	d.examples = append(d.examples, fmt.Sprintf(format, args...))
+}
+
+// record adds the differences of a query to the report, leaving out the
+// ignored fields, and counts the query
+func (r *Replayer) record(report *Report, stats *Stats, protocol, query string, d *diffs) {
+	stats.Queries++
+
+	different := false
+	for i, field := range d.fields {
+		if r.ignored(field) {
+			continue
+		}
+		different = true
+
+		idx := slices.IndexFunc(report.Differences, func(diff *Difference) bool {
+			return diff.Protocol == protocol && diff.Field == field
+		})
+		if idx < 0 {
+			report.Differences = append(report.Differences, &Difference{Protocol: protocol, Field: field, Examples: []string{}})
+			idx = len(report.Differences) - 1
+		}
+
+		diff := report.Differences[idx]
+		diff.Count++
+		if len(diff.Examples) < cmp.Or(r.MaxExamples, DefaultMaxExamples) {
+			diff.Examples = append(diff.Examples, query+": "+d.examples[i])
+		}
+	}
+
+	if different {
+		stats.Different++
+	} else {
+		stats.Matched++
+	}
+}
+
+func (r *Replayer) ignored(field string) bool {
+	for _, ignore := range r.Ignore {
+		if field == ignore || strings.HasPrefix(field, ignore+" ") {
+			return true
+		}
+	}
+
+	return false
+}
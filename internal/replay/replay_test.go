@@ -0,0 +1,285 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package replay
+
+import (
+	"bytes"
+	"encoding/binary"
+	"net"
+	"net/netip"
+	"testing"
+	"time"
+
+	"github.com/insomniacslk/dhcp/dhcpv4"
+	"github.com/miekg/dns"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	clientMAC  = net.HardwareAddr{0xd0, 0x94, 0x66, 0x12, 0x34, 0x56}
+	serverAddr = netip.MustParseAddr("10.1.0.254")
+)
+
+// frame returns an ethernet frame with a UDP datagram from src to dst
+func frame(src, dst netip.AddrPort, payload []byte) []byte {
+	udp := make([]byte, 8, 8+len(payload))
+	binary.BigEndian.PutUint16(udp, src.Port())
+	binary.BigEndian.PutUint16(udp[2:], dst.Port())
+	binary.BigEndian.PutUint16(udp[4:], uint16(8+len(payload)))
+	udp = append(udp, payload...)
+
+	ip := make([]byte, 20, 20+len(udp))
+	ip[0] = 0x45
+	binary.BigEndian.PutUint16(ip[2:], uint16(20+len(udp)))
+	ip[8] = 64
+	ip[9] = 17
+	copy(ip[12:], src.Addr().AsSlice())
+	copy(ip[16:], dst.Addr().AsSlice())
+	ip = append(ip, udp...)
+
+	eth := make([]byte, 14, 14+len(ip))
+	binary.BigEndian.PutUint16(eth[12:], 0x0800)
+	return append(eth, ip...)
+}
+
+// pcap returns a libpcap capture of frames
+func pcap(frames ...[]byte) []byte {
+	var b bytes.Buffer
+	hdr := make([]byte, 24)
+	binary.LittleEndian.PutUint32(hdr, pcapMagicMicro)
+	binary.LittleEndian.PutUint16(hdr[4:], 2)
+	binary.LittleEndian.PutUint16(hdr[6:], 4)
+	binary.LittleEndian.PutUint32(hdr[16:], 65535)
+	binary.LittleEndian.PutUint32(hdr[20:], linkEthernet)
+	b.Write(hdr)
+
+	for i, f := range frames {
+		rec := make([]byte, 16)
+		binary.LittleEndian.PutUint32(rec, 1700000000)
+		binary.LittleEndian.PutUint32(rec[4:], uint32(i))
+		binary.LittleEndian.PutUint32(rec[8:], uint32(len(f)))
+		binary.LittleEndian.PutUint32(rec[12:], uint32(len(f)))
+		b.Write(rec)
+		b.Write(f)
+	}
+
+	return b.Bytes()
+}
+
+// pcapng returns a pcapng capture of frames with nanosecond timestamps
+func pcapng(frames ...[]byte) []byte {
+	var b bytes.Buffer
+	block := func(kind uint32, body []byte) {
+		for len(body)%4 != 0 {
+			body = append(body, 0)
+		}
+		length := uint32(12 + len(body))
+		binary.Write(&b, binary.LittleEndian, kind)
+		binary.Write(&b, binary.LittleEndian, length)
+		b.Write(body)
+		binary.Write(&b, binary.LittleEndian, length)
+	}
+
+	shb := make([]byte, 16)
+	binary.LittleEndian.PutUint32(shb, pcapngByteOrderMagic)
+	binary.LittleEndian.PutUint16(shb[4:], 1)
+	binary.LittleEndian.PutUint64(shb[8:], ^uint64(0))
+	block(pcapngSectionHeader, shb)
+
+	idb := make([]byte, 8, 20)
+	binary.LittleEndian.PutUint16(idb, linkEthernet)
+	idb = append(idb, pcapngOptionTSResol, 0, 1, 0, 9, 0, 0, 0, 0, 0, 0, 0)
+	block(pcapngInterface, idb)
+
+	for _, f := range frames {
+		epb := make([]byte, 20, 20+len(f))
+		ts := uint64(time.Unix(1700000000, 5).UnixNano())
+		binary.LittleEndian.PutUint32(epb[4:], uint32(ts>>32))
+		binary.LittleEndian.PutUint32(epb[8:], uint32(ts))
+		binary.LittleEndian.PutUint32(epb[12:], uint32(len(f)))
+		binary.LittleEndian.PutUint32(epb[16:], uint32(len(f)))
+		block(pcapngEnhancedPacket, append(epb, f...))
+	}
+
+	return b.Bytes()
+}
+
+func TestReadPackets(t *testing.T) {
+	src := netip.MustParseAddrPort("10.1.0.2:5353")
+	dst := netip.AddrPortFrom(serverAddr, 53)
+	f := frame(src, dst, []byte("query"))
+
+	for name, capture := range map[string][]byte{"pcap": pcap(f, f), "pcapng": pcapng(f)} {
+		packets, err := ReadPackets(bytes.NewReader(capture))
+		require.NoError(t, err, name)
+		require.NotEmpty(t, packets, name)
+		assert.Equal(t, src, packets[0].Src, name)
+		assert.Equal(t, dst, packets[0].Dst, name)
+		assert.Equal(t, []byte("query"), packets[0].Payload, name)
+	}
+
+	packets, err := ReadPackets(bytes.NewReader(pcapng(f)))
+	require.NoError(t, err)
+	assert.Equal(t, time.Unix(1700000000, 5).UTC(), packets[0].Time.UTC())
+
+	_, err = ReadPackets(bytes.NewReader([]byte("not a capture at all....")))
+	assert.Error(t, err)
+}
+
+func dhcpPackets(t *testing.T, yiaddr net.IP) ([]Packet, *dhcpv4.DHCPv4) {
+	req, err := dhcpv4.NewDiscovery(clientMAC)
+	require.NoError(t, err)
+
+	offer, err := dhcpv4.NewReplyFromRequest(req,
+		dhcpv4.WithMessageType(dhcpv4.MessageTypeOffer),
+		dhcpv4.WithYourIP(yiaddr),
+		dhcpv4.WithServerIP(serverAddr.AsSlice()),
+		dhcpv4.WithOption(dhcpv4.OptServerIdentifier(serverAddr.AsSlice())),
+		dhcpv4.WithOption(dhcpv4.OptIPAddressLeaseTime(time.Hour)),
+	)
+	require.NoError(t, err)
+
+	client := netip.AddrPortFrom(netip.IPv4Unspecified(), dhcpv4.ClientPort)
+	bcast := netip.AddrPortFrom(netip.MustParseAddr("255.255.255.255"), dhcpv4.ServerPort)
+	server := netip.AddrPortFrom(serverAddr, dhcpv4.ServerPort)
+	return []Packet{
+		{Src: client, Dst: bcast, Payload: req.ToBytes()},
+		{Src: server, Dst: netip.AddrPortFrom(bcast.Addr(), dhcpv4.ClientPort), Payload: offer.ToBytes()},
+	}, offer
+}
+
+func TestReplayDHCP(t *testing.T) {
+	packets, offer := dhcpPackets(t, net.IPv4(10, 1, 0, 2))
+
+	var gotServer net.IP
+	r := &Replayer{DHCP: func(req *dhcpv4.DHCPv4, serverIP net.IP) *dhcpv4.DHCPv4 {
+		gotServer = serverIP
+		resp, err := dhcpv4.NewReplyFromRequest(req,
+			dhcpv4.WithMessageType(dhcpv4.MessageTypeOffer),
+			dhcpv4.WithYourIP(net.IPv4(10, 1, 0, 3)),
+			dhcpv4.WithServerIP(serverIP),
+			dhcpv4.WithOption(dhcpv4.OptServerIdentifier(serverIP)),
+			dhcpv4.WithOption(dhcpv4.OptIPAddressLeaseTime(2*time.Hour)),
+			dhcpv4.WithOption(dhcpv4.OptClassIdentifier("PXEClient")),
+		)
+		require.NoError(t, err)
+		return resp
+	}}
+
+	report := r.Run(packets)
+	assert.True(t, gotServer.Equal(offer.ServerIdentifier()))
+	assert.Equal(t, Stats{Queries: 1, Different: 1}, report.DHCP)
+	assert.Equal(t, Stats{}, report.DNS)
+
+	fields := make(map[string]*Difference)
+	for _, d := range report.Differences {
+		fields[d.Field] = d
+	}
+	require.Len(t, fields, 3)
+	if assert.Contains(t, fields, "yiaddr") {
+		assert.Equal(t, 1, fields["yiaddr"].Count)
+		assert.Equal(t, []string{"d0:94:66:12:34:56 DISCOVER xid " + offer.TransactionID.String() + ": captured 10.1.0.2, grendel 10.1.0.3"}, fields["yiaddr"].Examples)
+	}
+	assert.Contains(t, fields, "option 51 (IP Addresses Lease Time) value")
+	assert.Contains(t, fields, "option 60 (Class Identifier) extra")
+
+	assert.Error(t, report.Exceeds(0, 0))
+	assert.NoError(t, report.Exceeds(1, 0))
+	assert.Error(t, report.Exceeds(5, 50))
+
+	// Ignoring the fields leaves the request matching
+	r.Ignore = []string{"yiaddr", "option 51", "option 60"}
+	report = r.Run(packets)
+	assert.Equal(t, Stats{Queries: 1, Matched: 1}, report.DHCP)
+	assert.Empty(t, report.Differences)
+	assert.NoError(t, report.Exceeds(0, 0))
+
+	// Not answering a request the captured server answered
+	r.DHCP = func(req *dhcpv4.DHCPv4, serverIP net.IP) *dhcpv4.DHCPv4 { return nil }
+	report = r.Run(packets)
+	require.Len(t, report.Differences, 1)
+	assert.Equal(t, "reply", report.Differences[0].Field)
+	assert.Contains(t, report.Differences[0].Examples[0], "captured OFFER, grendel does not reply")
+}
+
+func dnsPacket(t *testing.T, src, dst netip.AddrPort, m *dns.Msg) Packet {
+	b, err := m.Pack()
+	require.NoError(t, err)
+	return Packet{Src: src, Dst: dst, Payload: b}
+}
+
+func TestReplayDNS(t *testing.T) {
+	client := netip.MustParseAddrPort("10.1.0.2:5353")
+	server := netip.AddrPortFrom(serverAddr, 53)
+
+	a := new(dns.Msg)
+	a.SetQuestion("cpn-01.example.local.", dns.TypeA)
+	aReply := new(dns.Msg)
+	aReply.SetReply(a)
+	aReply.Authoritative = true
+	aReply.Answer = []dns.RR{
+		&dns.A{Hdr: dns.RR_Header{Name: a.Question[0].Name, Rrtype: dns.TypeA, Class: dns.ClassINET, Ttl: 300}, A: net.IPv4(10, 1, 0, 2)},
+	}
+
+	fw := new(dns.Msg)
+	fw.SetQuestion("www.example.com.", dns.TypeA)
+	fwReply := new(dns.Msg)
+	fwReply.SetReply(fw)
+
+	nx := new(dns.Msg)
+	nx.SetQuestion("missing.example.local.", dns.TypeA)
+	nxReply := new(dns.Msg)
+	nxReply.SetRcode(nx, dns.RcodeNameError)
+
+	upstream := netip.MustParseAddrPort("192.0.2.53:53")
+	packets := []Packet{
+		dnsPacket(t, client, server, a),
+		dnsPacket(t, client, server, fw),
+		dnsPacket(t, netip.AddrPortFrom(serverAddr, 40000), upstream, fw),
+		dnsPacket(t, upstream, netip.AddrPortFrom(serverAddr, 40000), fwReply),
+		dnsPacket(t, server, client, fwReply),
+		dnsPacket(t, client, server, nx),
+		dnsPacket(t, server, client, aReply),
+		dnsPacket(t, server, client, nxReply),
+	}
+
+	r := &Replayer{
+		DNSServers: []netip.Addr{serverAddr},
+		DNS: func(c netip.AddrPort, req *dns.Msg) (*dns.Msg, bool) {
+			assert.Equal(t, client, c)
+			m := new(dns.Msg)
+			m.SetReply(req)
+			switch req.Question[0].Name {
+			case "www.example.com.":
+				return nil, true
+			case "cpn-01.example.local.":
+				m.Authoritative = true
+				m.Answer = []dns.RR{
+					&dns.A{Hdr: dns.RR_Header{Name: req.Question[0].Name, Rrtype: dns.TypeA, Class: dns.ClassINET, Ttl: 60}, A: net.IPv4(10, 1, 0, 2)},
+					&dns.A{Hdr: dns.RR_Header{Name: req.Question[0].Name, Rrtype: dns.TypeA, Class: dns.ClassINET, Ttl: 60}, A: net.IPv4(10, 2, 0, 2)},
+				}
+			default:
+				m.SetRcode(req, dns.RcodeNameError)
+			}
+			return m, false
+		},
+	}
+
+	report := r.Run(packets)
+	assert.Equal(t, Stats{Queries: 3, Matched: 1, Different: 1, Skipped: 1}, report.DNS)
+
+	fields := make(map[string]*Difference)
+	for _, d := range report.Differences {
+		fields[d.Field] = d
+	}
+	require.Len(t, fields, 2)
+	if assert.Contains(t, fields, "ttl") {
+		assert.Equal(t, []string{"cpn-01.example.local. A from 10.1.0.2: cpn-01.example.local.\t0\tIN\tA\t10.1.0.2 captured 300, grendel 60"}, fields["ttl"].Examples)
+	}
+	if assert.Contains(t, fields, "answer extra") {
+		assert.Contains(t, fields["answer extra"].Examples[0], "10.2.0.2")
+	}
+}
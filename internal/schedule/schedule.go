@@ -0,0 +1,336 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+// Package schedule runs the actions queued with grendel schedule add, such as
+// setting the boot image of a nodeset and power cycling it at 02:00 Saturday.
+// Actions are stored in the data store, so they survive restarts: actions due
+// while grendel serve was down run on startup unless they are older than the
+// grace period, in which case they are marked missed
+package schedule
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/korovkin/limiter"
+	"github.com/stmcginnis/gofish/schemas"
+	"github.com/ubccr/grendel/internal/bmc"
+	"github.com/ubccr/grendel/internal/config"
+	"github.com/ubccr/grendel/internal/logger"
+	"github.com/ubccr/grendel/internal/store"
+	"github.com/ubccr/grendel/internal/store/eventstore"
+	"github.com/ubccr/grendel/internal/util"
+	"github.com/ubccr/grendel/internal/webhook"
+	"github.com/ubccr/grendel/pkg/model"
+	"github.com/ubccr/grendel/pkg/nodeset"
+)
+
+const (
+	// DefaultInterval is the time between checks for due actions
+	DefaultInterval = time.Minute
+
+	DefaultConcurrency = 10
+)
+
+var log = logger.GetLogger("SCHEDULE")
+
+// Scheduler runs the pending actions once due, in order of their run time.
+// Each action is applied to up to concurrency hosts at once
+type Scheduler struct {
+	db          store.Store
+	interval    time.Duration
+	grace       time.Duration
+	concurrency int
+
+	// powerCycle restarts hosts with the boot override
+	powerCycle func(hosts model.HostList, boot schemas.BootSource) (model.JobMessageList, error)
+}
+
+// New returns a scheduler running actions missed by less than
+// schedule.grace_period and applying them to schedule.concurrency hosts at
+// once
+func New(db store.Store) (*Scheduler, error) {
+	grace, err := util.ParseDuration(config.Viper().GetString("schedule.grace_period"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid schedule.grace_period: %w", err)
+	}
+
+	s := &Scheduler{
+		db:          db,
+		interval:    DefaultInterval,
+		grace:       grace,
+		concurrency: config.Viper().GetInt("schedule.concurrency"),
+	}
+	if s.concurrency <= 0 {
+		s.concurrency = DefaultConcurrency
+	}
+	s.powerCycle = s.bmcPowerCycle
+
+	return s, nil
+}
+
+// Run runs the due actions every interval until stop is closed
+func (s *Scheduler) Run(stop <-chan struct{}) {
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	for {
+		s.RunDue(time.Now())
+
+		select {
+		case <-stop:
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// RunDue runs the actions due at now one after another, so actions queued
+// for the same time run in the order they were added. Actions due for longer
+// than the grace period are marked missed
+func (s *Scheduler) RunDue(now time.Time) {
+	actions, err := s.db.DueScheduledActions(now)
+	if err != nil {
+		log.Errorf("Failed to fetch due scheduled actions: %s", err)
+		return
+	}
+
+	for _, a := range actions {
+		if s.grace > 0 && now.Sub(a.RunAt) > s.grace {
+			s.miss(a, now)
+			continue
+		}
+
+		ok, err := s.db.StartScheduledAction(a.ID, model.ScheduleStatusRunning)
+		if err != nil {
+			log.Errorf("Failed to start scheduled action %d: %s", a.ID, err)
+			continue
+		}
+		if !ok {
+			// Cancelled or run by another instance sharing the data store
+			continue
+		}
+
+		a.Status = model.ScheduleStatusRunning
+		a.StartedAt = time.Now()
+		s.run(a)
+	}
+}
+
+// miss marks an action missed by more than the grace period
+func (s *Scheduler) miss(a *model.ScheduledAction, now time.Time) {
+	ok, err := s.db.StartScheduledAction(a.ID, model.ScheduleStatusMissed)
+	if err != nil || !ok {
+		return
+	}
+
+	a.Status = model.ScheduleStatusMissed
+	a.FinishedAt = now
+	a.Error = fmt.Sprintf("not run, due %s ago which is longer than the grace period of %s", now.Sub(a.RunAt).Round(time.Second), s.grace)
+	s.finish(a)
+}
+
+// run applies the action to its hosts and stores the report of each host
+func (s *Scheduler) run(a *model.ScheduledAction) {
+	log.Infof("Running scheduled action %d: %s", a.ID, describe(a))
+
+	results, err := s.apply(a)
+	a.Results = results
+	a.FinishedAt = time.Now()
+	a.Status = status(results)
+	if err != nil {
+		a.Status = model.ScheduleStatusFailed
+		a.Error = err.Error()
+	}
+
+	s.finish(a)
+}
+
+// apply runs the action on every host of its nodeset. Hosts which do not
+// exist are reported as errors
+func (s *Scheduler) apply(a *model.ScheduledAction) (model.JobMessageList, error) {
+	ns, err := nodeset.NewNodeSet(a.Nodeset)
+	if err != nil {
+		return nil, err
+	}
+
+	hosts, err := s.db.FindHosts(ns)
+	if err != nil {
+		return nil, err
+	}
+
+	found := make(map[string]bool, len(hosts))
+	for _, h := range hosts {
+		found[h.Name] = true
+	}
+
+	var results model.JobMessageList
+	for _, name := range ns.Iterator().StringSlice() {
+		if !found[name] {
+			results = append(results, model.JobMessage{Status: "error", Host: name, Msg: "host not found"})
+		}
+	}
+
+	if len(hosts) == 0 {
+		return results, errors.New("no hosts found")
+	}
+
+	switch a.Action {
+	case model.ScheduleActionImage:
+		results = append(results, s.each(hosts, func(hns *nodeset.NodeSet) error {
+			return s.db.SetBootImage(hns, a.Arg)
+		}, "boot image set to "+a.Arg)...)
+	case model.ScheduleActionProvision:
+		provision, err := strconv.ParseBool(a.Arg)
+		if err != nil {
+			return results, err
+		}
+		results = append(results, s.each(hosts, func(hns *nodeset.NodeSet) error {
+			return s.db.ProvisionHosts(hns, provision)
+		}, "provision set to "+strconv.FormatBool(provision))...)
+	case model.ScheduleActionPowerCycle:
+		boot := schemas.BootSource(a.Arg)
+		if boot == "" {
+			boot = schemas.NoneBootSource
+		}
+		output, err := s.powerCycle(hosts, boot)
+		if err != nil {
+			return results, err
+		}
+		results = append(results, powerResults(hosts, output)...)
+	case model.ScheduleActionWebhook:
+		names := make([]string, 0, len(hosts))
+		for _, h := range hosts {
+			names = append(names, h.Name)
+		}
+		webhook.Notify(webhook.ScheduleRun, names, model.Event{
+			Severity: model.SeverityInfo.String(),
+			Time:     time.Now().UTC(),
+			User:     a.CreatedBy,
+			Message:  a.Arg,
+		})
+		for _, h := range hosts {
+			results = append(results, model.JobMessage{Status: "success", Host: h.Name, Msg: "webhook event queued"})
+		}
+	default:
+		return results, fmt.Errorf("invalid action %q", a.Action)
+	}
+
+	return results, nil
+}
+
+// each runs apply with a nodeset of each host, concurrency hosts at once,
+// and reports msg for the hosts it succeeded on
+func (s *Scheduler) each(hosts model.HostList, apply func(*nodeset.NodeSet) error, msg string) model.JobMessageList {
+	var mu sync.Mutex
+	results := make(model.JobMessageList, 0, len(hosts))
+
+	limit := limiter.NewConcurrencyLimiter(s.concurrency)
+	for _, h := range hosts {
+		limit.Execute(func() {
+			jm := model.JobMessage{Status: "success", Host: h.Name, Msg: msg}
+			hns, err := nodeset.NewNodeSet(h.Name)
+			if err == nil {
+				err = apply(hns)
+			}
+			if err != nil {
+				jm.Status = "error"
+				jm.Msg = err.Error()
+			}
+
+			mu.Lock()
+			results = append(results, jm)
+			mu.Unlock()
+		})
+	}
+	limit.Wait()
+
+	return results
+}
+
+// bmcPowerCycle restarts the hosts through their BMC
+func (s *Scheduler) bmcPowerCycle(hosts model.HostList, boot schemas.BootSource) (model.JobMessageList, error) {
+	job := bmc.NewJob(s.db)
+	job.SetFanout(s.concurrency)
+
+	return job.PowerControl(hosts, boot, schemas.ForceRestartResetType)
+}
+
+// powerResults adds an error for the hosts skipped by the power job, such as
+// switches and PDUs
+func powerResults(hosts model.HostList, output model.JobMessageList) model.JobMessageList {
+	reported := make(map[string]bool, len(output))
+	for _, jm := range output {
+		reported[jm.Host] = true
+	}
+
+	for _, h := range hosts {
+		if !reported[h.Name] {
+			output = append(output, model.JobMessage{Status: "error", Host: h.Name, Msg: "skipped, only servers are power cycled"})
+		}
+	}
+
+	return output
+}
+
+// status returns done when the action succeeded on every host, failed when
+// it succeeded on none and partial otherwise
+func status(results model.JobMessageList) string {
+	failed := 0
+	for _, jm := range results {
+		if jm.Status != "success" {
+			failed++
+		}
+	}
+
+	switch {
+	case failed == 0:
+		return model.ScheduleStatusDone
+	case failed == len(results):
+		return model.ScheduleStatusFailed
+	default:
+		return model.ScheduleStatusPartial
+	}
+}
+
+// finish stores the outcome of the action and adds it to the event stream
+func (s *Scheduler) finish(a *model.ScheduledAction) {
+	if err := s.db.FinishScheduledAction(a); err != nil {
+		log.Errorf("Failed to store the results of scheduled action %d: %s", a.ID, err)
+	}
+
+	severity := model.SeveritySuccess
+	switch a.Status {
+	case model.ScheduleStatusPartial, model.ScheduleStatusMissed:
+		severity = model.SeverityWarning
+	case model.ScheduleStatusFailed:
+		severity = model.SeverityError
+	}
+
+	msg := fmt.Sprintf("Scheduled action %d (%s) %s", a.ID, describe(a), a.Status)
+	if a.Error != "" {
+		msg += ": " + a.Error
+	}
+	log.Info(msg)
+
+	eventstore.Default.StoreEvents(model.Event{
+		Severity:    severity.String(),
+		Time:        time.Now().UTC(),
+		User:        a.CreatedBy,
+		Message:     msg,
+		JobMessages: a.Results,
+	})
+}
+
+// describe returns the action, its argument and nodeset
+func describe(a *model.ScheduledAction) string {
+	if a.Arg == "" {
+		return fmt.Sprintf("%s on %s", a.Action, a.Nodeset)
+	}
+
+	return fmt.Sprintf("%s %s on %s", a.Action, a.Arg, a.Nodeset)
+}
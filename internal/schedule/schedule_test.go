@@ -0,0 +1,126 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package schedule
+
+import (
+	"testing"
+	"time"
+
+	"github.com/spf13/viper"
+	"github.com/stmcginnis/gofish/schemas"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/ubccr/grendel/internal/store/sqlstore"
+	"github.com/ubccr/grendel/internal/tests"
+	"github.com/ubccr/grendel/pkg/model"
+	"github.com/ubccr/grendel/pkg/nodeset"
+)
+
+func newTestScheduler(t *testing.T) (*Scheduler, *sqlstore.SqlStore) {
+	db, err := sqlstore.New(":memory:")
+	require.NoError(t, err)
+	t.Cleanup(func() { db.Close() })
+
+	viper.Set("schedule.grace_period", "1h")
+	t.Cleanup(viper.Reset)
+
+	s, err := New(db)
+	require.NoError(t, err)
+
+	return s, db
+}
+
+func TestRunDue(t *testing.T) {
+	s, db := newTestScheduler(t)
+
+	image := tests.BootImageFactory.MustCreate().(*model.BootImage)
+	require.NoError(t, db.StoreBootImage(image))
+	host := tests.HostFactory.MustCreate().(*model.Host)
+	require.NoError(t, db.StoreHost(host))
+
+	ns, err := nodeset.NewNodeSet(host.Name)
+	require.NoError(t, err)
+	ns.Add("missing-01")
+
+	now := time.Now()
+	set := &model.ScheduledAction{Action: model.ScheduleActionImage, Arg: image.Name, Nodeset: ns.String(), RunAt: now.Add(-time.Minute)}
+	require.NoError(t, db.StoreScheduledAction(set))
+	missed := &model.ScheduledAction{Action: model.ScheduleActionProvision, Arg: "true", Nodeset: host.Name, RunAt: now.Add(-2 * time.Hour)}
+	require.NoError(t, db.StoreScheduledAction(missed))
+	cancelled := &model.ScheduledAction{Action: model.ScheduleActionProvision, Arg: "true", Nodeset: host.Name, RunAt: now.Add(-time.Minute)}
+	require.NoError(t, db.StoreScheduledAction(cancelled))
+	require.NoError(t, db.CancelScheduledAction(cancelled.ID))
+	future := &model.ScheduledAction{Action: model.ScheduleActionWebhook, Nodeset: host.Name, RunAt: now.Add(time.Hour)}
+	require.NoError(t, db.StoreScheduledAction(future))
+
+	s.RunDue(now)
+
+	// The existing host has the image set, the missing one is reported
+	a, err := db.LoadScheduledAction(set.ID)
+	require.NoError(t, err)
+	assert.Equal(t, model.ScheduleStatusPartial, a.Status)
+	assert.ElementsMatch(t, model.JobMessageList{
+		{Status: "error", Host: "missing-01", Msg: "host not found"},
+		{Status: "success", Host: host.Name, Msg: "boot image set to " + image.Name},
+	}, a.Results)
+
+	stored, err := db.LoadHostFromName(host.Name)
+	require.NoError(t, err)
+	assert.Equal(t, image.Name, stored.BootImage)
+	assert.False(t, stored.Provision)
+
+	a, err = db.LoadScheduledAction(missed.ID)
+	require.NoError(t, err)
+	assert.Equal(t, model.ScheduleStatusMissed, a.Status)
+	assert.Contains(t, a.Error, "grace period")
+	assert.True(t, a.StartedAt.IsZero())
+
+	a, err = db.LoadScheduledAction(cancelled.ID)
+	require.NoError(t, err)
+	assert.Equal(t, model.ScheduleStatusCancelled, a.Status)
+
+	a, err = db.LoadScheduledAction(future.ID)
+	require.NoError(t, err)
+	assert.Equal(t, model.ScheduleStatusPending, a.Status)
+}
+
+func TestRunPowerCycle(t *testing.T) {
+	s, db := newTestScheduler(t)
+
+	host := tests.HostFactory.MustCreate().(*model.Host)
+	other := tests.HostFactory.MustCreate().(*model.Host)
+	require.NoError(t, db.StoreHosts(model.HostList{host, other}))
+
+	var boot schemas.BootSource
+	s.powerCycle = func(hl model.HostList, b schemas.BootSource) (model.JobMessageList, error) {
+		boot = b
+		return model.JobMessageList{{Status: "error", Host: other.Name, Msg: "connection refused"}}, nil
+	}
+
+	ns, err := model.HostList{host, other}.ToNodeSet()
+	require.NoError(t, err)
+	action := &model.ScheduledAction{Action: model.ScheduleActionPowerCycle, Arg: "Pxe", Nodeset: ns.String(), RunAt: time.Now()}
+	require.NoError(t, db.StoreScheduledAction(action))
+
+	s.RunDue(time.Now())
+
+	a, err := db.LoadScheduledAction(action.ID)
+	require.NoError(t, err)
+	assert.Equal(t, schemas.PxeBootSource, boot)
+	assert.Equal(t, model.ScheduleStatusFailed, a.Status)
+	assert.ElementsMatch(t, model.JobMessageList{
+		{Status: "error", Host: other.Name, Msg: "connection refused"},
+		{Status: "error", Host: host.Name, Msg: "skipped, only servers are power cycled"},
+	}, a.Results)
+}
+
+func TestStatus(t *testing.T) {
+	ok := model.JobMessage{Status: "success"}
+	failed := model.JobMessage{Status: "error"}
+
+	assert.Equal(t, model.ScheduleStatusDone, status(model.JobMessageList{ok, ok}))
+	assert.Equal(t, model.ScheduleStatusPartial, status(model.JobMessageList{ok, failed}))
+	assert.Equal(t, model.ScheduleStatusFailed, status(model.JobMessageList{failed}))
+}
@@ -0,0 +1,231 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+// Package secret encrypts the credentials and rotated signing keys kept in the
+// Grendel data store. They are sealed with AES-256-GCM using a key derived
+// from the credentials_key setting, or api.secret when it is not set.
+package secret
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hkdf"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/ubccr/grendel/internal/config"
+)
+
+const (
+	// version prefixes sealed secrets so the format can change later
+	version = "v1:"
+
+	redacted = "********"
+)
+
+var (
+	// ErrNoKey is returned when neither credentials_key nor api.secret is
+	// configured. A generated api.secret changes on every restart and can't
+	// be used to encrypt stored credentials
+	ErrNoKey = errors.New("no credentials key configured, set credentials_key or api.secret")
+
+	// ErrKeyRequired is returned when api.secret is a list and
+	// credentials_key is not set. Rotating the secrets of the list would
+	// change the key and make the stored credentials unreadable
+	ErrKeyRequired = errors.New("api.secret is a list, set credentials_key. Credentials stored before were encrypted with the last secret of the list")
+
+	// ErrDecrypt is returned when a sealed secret is corrupt or was
+	// encrypted with a different key
+	ErrDecrypt = errors.New("failed to decrypt credentials, check credentials_key")
+)
+
+// Login is a username and password in plaintext. It is only held in memory
+// while in use and is redacted when printed.
+type Login struct {
+	Username string
+	Password string
+}
+
+func (l Login) String() string {
+	return fmt.Sprintf("{username:%s password:%s}", redacted, redacted)
+}
+
+func (l Login) GoString() string {
+	return l.String()
+}
+
+// MarshalJSON redacts the login so it never ends up in API responses or logs
+func (l Login) MarshalJSON() ([]byte, error) {
+	return json.Marshal(map[string]string{"username": redacted, "password": redacted})
+}
+
+// sealedLogin is the plaintext encrypted by Seal
+type sealedLogin struct {
+	Username string `json:"username"`
+	Password string `json:"password"`
+}
+
+// Key returns the credentials encryption key derived from credentials_key,
+// or api.secret if it is a single secret set in the config file or
+// environment
+func Key() ([]byte, error) {
+	return deriveKey("grendel credentials")
+}
+
+// Sign returns the HMAC-SHA256 of msg, hex encoded, with a key derived from
+// the credentials key for purpose. Signatures stay valid across restarts as
+// long as the credentials key is unchanged
+func Sign(purpose, msg string) (string, error) {
+	key, err := deriveKey("grendel " + purpose)
+	if err != nil {
+		return "", err
+	}
+
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(msg))
+
+	return hex.EncodeToString(mac.Sum(nil)), nil
+}
+
+// Verify checks sig is the signature of msg returned by Sign for purpose
+func Verify(purpose, msg, sig string) bool {
+	expected, err := Sign(purpose, msg)
+	if err != nil {
+		return false
+	}
+
+	return hmac.Equal([]byte(expected), []byte(sig))
+}
+
+func deriveKey(info string) ([]byte, error) {
+	secret := config.Viper().GetString("credentials_key")
+	if secret == "" {
+		_, env := os.LookupEnv("GRENDEL_API_SECRET")
+		if config.Viper().InConfig("api.secret") || env {
+			// A list is rotated, no secret of it stays the same
+			secrets := config.Viper().GetStringSlice("api.secret")
+			switch config.Viper().Get("api.secret").(type) {
+			case []any, []string:
+				return nil, ErrKeyRequired
+			}
+			if len(secrets) > 1 {
+				return nil, ErrKeyRequired
+			}
+			if len(secrets) == 1 {
+				secret = secrets[0]
+			}
+		}
+	}
+	if secret == "" {
+		return nil, ErrNoKey
+	}
+
+	return hkdf.Key(sha256.New, []byte(secret), nil, info, 32)
+}
+
+// Seal encrypts l with the credentials key
+func Seal(l Login) (string, error) {
+	plaintext, err := json.Marshal(sealedLogin(l))
+	if err != nil {
+		return "", err
+	}
+
+	return seal(plaintext)
+}
+
+// Open decrypts a secret returned by Seal
+func Open(sealed string) (Login, error) {
+	plaintext, err := open(sealed)
+	if err != nil {
+		return Login{}, err
+	}
+
+	var l sealedLogin
+	if err := json.Unmarshal(plaintext, &l); err != nil {
+		return Login{}, fmt.Errorf("%w: %w", ErrDecrypt, err)
+	}
+
+	return Login(l), nil
+}
+
+// SealString encrypts s with the credentials key
+func SealString(s string) (string, error) {
+	return seal([]byte(s))
+}
+
+// OpenString decrypts a secret returned by SealString
+func OpenString(sealed string) (string, error) {
+	plaintext, err := open(sealed)
+	if err != nil {
+		return "", err
+	}
+
+	return string(plaintext), nil
+}
+
+func seal(plaintext []byte) (string, error) {
+	aead, err := newAEAD()
+	if err != nil {
+		return "", err
+	}
+
+	nonce := make([]byte, aead.NonceSize(), aead.NonceSize()+len(plaintext)+aead.Overhead())
+	if _, err := rand.Read(nonce); err != nil {
+		return "", err
+	}
+
+	sealed := aead.Seal(nonce, nonce, plaintext, nil)
+
+	return version + base64.RawStdEncoding.EncodeToString(sealed), nil
+}
+
+func open(sealed string) ([]byte, error) {
+	data, ok := strings.CutPrefix(sealed, version)
+	if !ok {
+		return nil, fmt.Errorf("%w: unsupported format", ErrDecrypt)
+	}
+
+	raw, err := base64.RawStdEncoding.DecodeString(data)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrDecrypt, err)
+	}
+
+	aead, err := newAEAD()
+	if err != nil {
+		return nil, err
+	}
+
+	if len(raw) < aead.NonceSize() {
+		return nil, fmt.Errorf("%w: too short", ErrDecrypt)
+	}
+
+	plaintext, err := aead.Open(nil, raw[:aead.NonceSize()], raw[aead.NonceSize():], nil)
+	if err != nil {
+		return nil, ErrDecrypt
+	}
+
+	return plaintext, nil
+}
+
+func newAEAD() (cipher.AEAD, error) {
+	key, err := Key()
+	if err != nil {
+		return nil, err
+	}
+
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+
+	return cipher.NewGCM(block)
+}
@@ -0,0 +1,116 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package secret
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSealOpen(t *testing.T) {
+	viper.Reset()
+	defer viper.Reset()
+
+	login := Login{Username: "root", Password: "hunter2"}
+
+	// A generated api.secret is not a credentials key
+	viper.Set("api.secret", "generated")
+	_, err := Seal(login)
+	assert.ErrorIs(t, err, ErrNoKey)
+
+	viper.Set("credentials_key", "key1")
+	sealed, err := Seal(login)
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(sealed, version))
+	assert.NotContains(t, sealed, "hunter2")
+
+	// Every seal uses a new nonce
+	again, err := Seal(login)
+	require.NoError(t, err)
+	assert.NotEqual(t, sealed, again)
+
+	opened, err := Open(sealed)
+	if assert.NoError(t, err) {
+		assert.Equal(t, login, opened)
+	}
+
+	viper.Set("credentials_key", "key2")
+	_, err = Open(sealed)
+	assert.ErrorIs(t, err, ErrDecrypt)
+
+	_, err = Open("v1:bm90IGEgc2VjcmV0")
+	assert.ErrorIs(t, err, ErrDecrypt)
+}
+
+func TestSealString(t *testing.T) {
+	viper.Reset()
+	defer viper.Reset()
+
+	viper.Set("credentials_key", "key1")
+	sealed, err := SealString("signing-key")
+	require.NoError(t, err)
+	assert.NotContains(t, sealed, "signing-key")
+
+	opened, err := OpenString(sealed)
+	if assert.NoError(t, err) {
+		assert.Equal(t, "signing-key", opened)
+	}
+
+	// The credentials key falls back to a single api.secret. A list is
+	// rotated and requires credentials_key
+	viper.Reset()
+	t.Setenv("GRENDEL_API_SECRET", "set")
+	viper.Set("api.secret", "old")
+	old, err := Key()
+	require.NoError(t, err)
+	for _, secrets := range [][]string{{"new", "old"}, {"new"}} {
+		viper.Set("api.secret", secrets)
+		_, err = Key()
+		assert.ErrorIs(t, err, ErrKeyRequired)
+	}
+	viper.Set("credentials_key", "old")
+	key, err := Key()
+	require.NoError(t, err)
+	assert.Equal(t, old, key)
+}
+
+func TestSignVerify(t *testing.T) {
+	viper.Reset()
+	defer viper.Reset()
+
+	_, err := Sign("events", "cpn-01")
+	assert.ErrorIs(t, err, ErrNoKey)
+	assert.False(t, Verify("events", "cpn-01", ""))
+
+	viper.Set("credentials_key", "key1")
+	sig, err := Sign("events", "cpn-01")
+	require.NoError(t, err)
+	assert.True(t, Verify("events", "cpn-01", sig))
+	assert.False(t, Verify("events", "cpn-02", sig))
+	assert.False(t, Verify("other", "cpn-01", sig))
+
+	viper.Set("credentials_key", "key2")
+	assert.False(t, Verify("events", "cpn-01", sig))
+}
+
+func TestLoginRedacted(t *testing.T) {
+	login := Login{Username: "root", Password: "hunter2"}
+
+	for _, s := range []string{fmt.Sprint(login), fmt.Sprintf("%v %+v %#v", login, login, login)} {
+		assert.NotContains(t, s, "root")
+		assert.NotContains(t, s, "hunter2")
+	}
+
+	data, err := json.Marshal(login)
+	if assert.NoError(t, err) {
+		assert.NotContains(t, string(data), "hunter2")
+	}
+}
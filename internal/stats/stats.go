@@ -0,0 +1,105 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+// Package stats keeps recent counts of service activity, such as DHCP acks
+// and provision completions, for the summary statistics of the API
+package stats
+
+import (
+	"sync"
+	"time"
+)
+
+// slots is the number of minutes a Counter keeps, a day and the current
+// minute
+const slots = 24*60 + 1
+
+var (
+	// Started is when the process started counting
+	Started = time.Now()
+
+	// DHCPAcks counts the DHCPACK replies of the DHCP server
+	DHCPAcks = NewCounter()
+
+	// ProvisionCompletions counts the hosts which finished provisioning
+	ProvisionCompletions = NewCounter()
+
+	// DNSQueries counts the queries answered by the DNS server
+	DNSQueries = NewCounter()
+)
+
+type slot struct {
+	minute int64
+	count  uint64
+}
+
+// Counter counts events per minute over the last day. Adding and summing are
+// constant time regardless of the event rate
+type Counter struct {
+	mu      sync.Mutex
+	slots   [slots]slot
+	created time.Time
+	now     func() time.Time
+}
+
+// NewCounter returns an empty Counter
+func NewCounter() *Counter {
+	return &Counter{created: time.Now(), now: time.Now}
+}
+
+// Inc counts an event now
+func (c *Counter) Inc() {
+	c.Add(1)
+}
+
+// Add counts n events now
+func (c *Counter) Add(n uint64) {
+	minute := c.now().Unix() / 60
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	s := &c.slots[minute%slots]
+	if s.minute != minute {
+		s.minute = minute
+		s.count = 0
+	}
+	s.count += n
+}
+
+// Since returns the number of events counted since t, to the minute. Counts
+// older than a day are dropped
+func (c *Counter) Since(t time.Time) uint64 {
+	now := c.now().Unix() / 60
+	from := t.Unix() / 60
+	if oldest := now - slots + 1; from < oldest {
+		from = oldest
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	var total uint64
+	for _, s := range c.slots {
+		if s.minute >= from && s.minute <= now {
+			total += s.count
+		}
+	}
+
+	return total
+}
+
+// Rate returns the events per second over the last window, or over the
+// lifetime of the counter when shorter
+func (c *Counter) Rate(window time.Duration) float64 {
+	now := c.now()
+	if age := now.Sub(c.created); age < window {
+		window = age
+	}
+	if window < time.Second {
+		return 0
+	}
+
+	return float64(c.Since(now.Add(-window))) / window.Seconds()
+}
@@ -0,0 +1,52 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package stats
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCounter(t *testing.T) {
+	now := time.Date(2026, 10, 15, 12, 0, 30, 0, time.UTC)
+	c := NewCounter()
+	c.created = now
+	c.now = func() time.Time { return now }
+
+	c.Inc()
+	c.Add(2)
+	assert.Equal(t, uint64(3), c.Since(now.Add(-time.Minute)))
+
+	now = now.Add(30 * time.Minute)
+	c.Add(4)
+	assert.Equal(t, uint64(7), c.Since(now.Add(-time.Hour)))
+	assert.Equal(t, uint64(4), c.Since(now.Add(-10*time.Minute)))
+	assert.Zero(t, c.Since(now.Add(time.Minute)))
+
+	// Counts older than a day are dropped and their slots reused
+	now = now.Add(24*time.Hour + time.Minute)
+	assert.Zero(t, c.Since(now.Add(-48*time.Hour)))
+	c.Inc()
+	assert.Equal(t, uint64(1), c.Since(now.Add(-48*time.Hour)))
+}
+
+func TestCounterRate(t *testing.T) {
+	now := time.Date(2026, 10, 15, 12, 0, 0, 0, time.UTC)
+	c := NewCounter()
+	c.created = now
+	c.now = func() time.Time { return now }
+
+	assert.Zero(t, c.Rate(5*time.Minute))
+
+	c.Add(120)
+	now = now.Add(time.Minute)
+	assert.Equal(t, 2.0, c.Rate(5*time.Minute), "rate over the lifetime of a young counter")
+
+	now = now.Add(9 * time.Minute)
+	c.Add(600)
+	assert.Equal(t, 2.0, c.Rate(5*time.Minute))
+}
@@ -0,0 +1,395 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+// Package backup reads and writes point in time backups of the Grendel data
+// store. A backup is a zstd compressed tar archive holding a manifest and a
+// snapshot of the sqlite database. Archives written by earlier versions are
+// gzip compressed and still read.
+package backup
+
+import (
+	"archive/tar"
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/klauspost/compress/zstd"
+	"github.com/ubccr/grendel/internal/store"
+	"github.com/ubccr/grendel/internal/store/migrations"
+	"github.com/ubccr/grendel/internal/store/sqlstore"
+)
+
+const (
+	// ManifestFile is the name of the manifest in the archive
+	ManifestFile = "manifest.json"
+
+	// DatabaseFile is the name of the database snapshot in the archive
+	DatabaseFile = "grendel.db"
+)
+
+var (
+	zstdMagic = []byte{0x28, 0xb5, 0x2f, 0xfd}
+	gzipMagic = []byte{0x1f, 0x8b}
+)
+
+var (
+	// ErrNotEmpty is returned by Restore when the target database has hosts
+	// or images and force is not set
+	ErrNotEmpty = errors.New("database is not empty")
+
+	// ErrChecksum is returned when a file in the archive does not match the
+	// checksum in the manifest
+	ErrChecksum = errors.New("checksum mismatch")
+)
+
+// Manifest describes the contents of a backup
+type Manifest struct {
+	Version       string            `json:"version"`
+	SchemaVersion uint              `json:"schema_version"`
+	DBType        string            `json:"dbtype"`
+	Created       time.Time         `json:"created"`
+	Counts        Counts            `json:"counts"`
+	Checksums     map[string]string `json:"checksums"`
+}
+
+// Counts are the number of entries of each kind in a backup
+type Counts struct {
+	Hosts      int `json:"hosts"`
+	Images     int `json:"images"`
+	Users      int `json:"users"`
+	DNSRecords int `json:"dns_records"`
+}
+
+// Snapshot is a point in time copy of a data store waiting to be written to
+// an archive. Close removes the copy.
+type Snapshot struct {
+	Manifest Manifest
+	dir      string
+}
+
+// NewSnapshot takes a consistent snapshot of db. version is the Grendel
+// version recorded in the manifest.
+func NewSnapshot(db store.Store, version string) (*Snapshot, error) {
+	dir, err := os.MkdirTemp("", "grendel-backup-")
+	if err != nil {
+		return nil, err
+	}
+
+	snap := &Snapshot{dir: dir}
+	file := filepath.Join(dir, DatabaseFile)
+	if err := db.Snapshot(file); err != nil {
+		snap.Close()
+		return nil, fmt.Errorf("failed to snapshot database: %w", err)
+	}
+
+	counts, err := count(file)
+	if err != nil {
+		snap.Close()
+		return nil, err
+	}
+
+	sum, err := checksum(file)
+	if err != nil {
+		snap.Close()
+		return nil, err
+	}
+
+	snap.Manifest = Manifest{
+		Version:       version,
+		SchemaVersion: migrations.SchemaVersion,
+		DBType:        "sqlite",
+		Created:       time.Now().UTC(),
+		Counts:        *counts,
+		Checksums:     map[string]string{DatabaseFile: sum},
+	}
+
+	return snap, nil
+}
+
+// WriteArchive writes the snapshot as a backup archive to w
+func (s *Snapshot) WriteArchive(w io.Writer) error {
+	manifest, err := json.MarshalIndent(s.Manifest, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	file, err := os.Open(filepath.Join(s.dir, DatabaseFile))
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	info, err := file.Stat()
+	if err != nil {
+		return err
+	}
+
+	zw, err := zstd.NewWriter(w)
+	if err != nil {
+		return err
+	}
+	tw := tar.NewWriter(zw)
+
+	err = tw.WriteHeader(&tar.Header{
+		Name:    ManifestFile,
+		Mode:    0600,
+		Size:    int64(len(manifest)),
+		ModTime: s.Manifest.Created,
+	})
+	if err != nil {
+		return err
+	}
+	if _, err := tw.Write(manifest); err != nil {
+		return err
+	}
+
+	err = tw.WriteHeader(&tar.Header{
+		Name:    DatabaseFile,
+		Mode:    0600,
+		Size:    info.Size(),
+		ModTime: s.Manifest.Created,
+	})
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(tw, file); err != nil {
+		return err
+	}
+
+	if err := tw.Close(); err != nil {
+		return err
+	}
+
+	return zw.Close()
+}
+
+// Close removes the snapshot
+func (s *Snapshot) Close() error {
+	return os.RemoveAll(s.dir)
+}
+
+// Write takes a snapshot of db and writes it as a backup archive to w
+func Write(w io.Writer, db store.Store, version string) (*Manifest, error) {
+	snap, err := NewSnapshot(db, version)
+	if err != nil {
+		return nil, err
+	}
+	defer snap.Close()
+
+	return &snap.Manifest, snap.WriteArchive(w)
+}
+
+// Extract reads a backup archive from r into dir and verifies the checksum of
+// each file against the manifest
+func Extract(r io.Reader, dir string) (*Manifest, error) {
+	dr, err := decompress(r)
+	if err != nil {
+		return nil, fmt.Errorf("invalid backup archive: %w", err)
+	}
+	defer dr.Close()
+
+	var manifest *Manifest
+	sums := make(map[string]string)
+	tr := tar.NewReader(dr)
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("invalid backup archive: %w", err)
+		}
+
+		switch hdr.Name {
+		case ManifestFile:
+			manifest = &Manifest{}
+			if err := json.NewDecoder(tr).Decode(manifest); err != nil {
+				return nil, fmt.Errorf("invalid backup manifest: %w", err)
+			}
+		case DatabaseFile:
+			sum, err := extractFile(tr, filepath.Join(dir, DatabaseFile))
+			if err != nil {
+				return nil, err
+			}
+			sums[hdr.Name] = sum
+		default:
+			return nil, fmt.Errorf("invalid backup archive: unexpected file %s", hdr.Name)
+		}
+	}
+
+	if manifest == nil {
+		return nil, fmt.Errorf("invalid backup archive: missing %s", ManifestFile)
+	}
+
+	if _, ok := manifest.Checksums[DatabaseFile]; !ok {
+		return nil, fmt.Errorf("invalid backup manifest: missing checksum for %s", DatabaseFile)
+	}
+
+	for name, want := range manifest.Checksums {
+		got, ok := sums[name]
+		if !ok {
+			return nil, fmt.Errorf("invalid backup archive: missing %s", name)
+		}
+		if got != want {
+			return nil, fmt.Errorf("%w: %s", ErrChecksum, name)
+		}
+	}
+
+	if manifest.SchemaVersion > migrations.SchemaVersion {
+		return nil, fmt.Errorf("backup schema version %d is newer than the supported version %d", manifest.SchemaVersion, migrations.SchemaVersion)
+	}
+
+	return manifest, nil
+}
+
+// IsArchive returns true if data starts with the magic number of a zstd or
+// gzip compressed backup archive
+func IsArchive(data []byte) bool {
+	return bytes.HasPrefix(data, zstdMagic) || bytes.HasPrefix(data, gzipMagic)
+}
+
+// decompress returns a reader of the archive r, picking the compression from
+// its magic number
+func decompress(r io.Reader) (io.ReadCloser, error) {
+	br := bufio.NewReader(r)
+	magic, err := br.Peek(len(zstdMagic))
+	if err != nil && !errors.Is(err, io.EOF) {
+		return nil, err
+	}
+
+	switch {
+	case bytes.HasPrefix(magic, zstdMagic):
+		zr, err := zstd.NewReader(br)
+		if err != nil {
+			return nil, err
+		}
+		return zr.IOReadCloser(), nil
+	case bytes.HasPrefix(magic, gzipMagic):
+		return gzip.NewReader(br)
+	default:
+		return nil, errors.New("unknown compression")
+	}
+}
+
+// Restore replaces the sqlite database filename with the backup archive read
+// from r. The archive is verified before filename is touched. Restore returns
+// ErrNotEmpty if filename has hosts or images unless force is true. The
+// database must not be in use by a running Grendel server.
+func Restore(r io.Reader, filename string, force bool) (*Manifest, error) {
+	if filename == "" || filename == ":memory:" {
+		return nil, errors.New("restoring a backup requires a database file, set dbpath")
+	}
+
+	// Extract next to the target so the final rename does not cross file systems
+	dir, err := os.MkdirTemp(filepath.Dir(filename), ".grendel-restore-")
+	if err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(dir)
+
+	manifest, err := Extract(r, dir)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := os.Stat(filename); err == nil && !force {
+		counts, err := count(filename)
+		if err != nil {
+			return nil, err
+		}
+		if counts.Hosts > 0 || counts.Images > 0 {
+			return nil, fmt.Errorf("%w: %s has %d hosts and %d images, use --force to overwrite", ErrNotEmpty, filename, counts.Hosts, counts.Images)
+		}
+	}
+
+	// Stale write ahead log files would be applied to the restored database
+	for _, suffix := range []string{"-wal", "-shm"} {
+		if err := os.Remove(filename + suffix); err != nil && !errors.Is(err, os.ErrNotExist) {
+			return nil, err
+		}
+	}
+
+	if err := os.Rename(filepath.Join(dir, DatabaseFile), filename); err != nil {
+		return nil, err
+	}
+
+	return manifest, nil
+}
+
+// count returns the number of entries in the sqlite database filename
+func count(filename string) (*Counts, error) {
+	db, err := sqlstore.New(filename)
+	if err != nil {
+		return nil, err
+	}
+	defer db.Close()
+
+	hosts, err := db.Hosts()
+	if err != nil {
+		return nil, err
+	}
+	images, err := db.BootImages()
+	if err != nil {
+		return nil, err
+	}
+	users, err := db.GetUsers()
+	if err != nil {
+		return nil, err
+	}
+	records, err := db.DNSRecords()
+	if err != nil {
+		return nil, err
+	}
+
+	return &Counts{
+		Hosts:      len(hosts),
+		Images:     len(images),
+		Users:      len(users),
+		DNSRecords: len(records),
+	}, nil
+}
+
+func checksum(filename string) (string, error) {
+	file, err := os.Open(filename)
+	if err != nil {
+		return "", err
+	}
+	defer file.Close()
+
+	h := sha256.New()
+	if _, err := io.Copy(h, file); err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// extractFile writes r to filename and returns its sha256 checksum
+func extractFile(r io.Reader, filename string) (string, error) {
+	file, err := os.OpenFile(filename, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0600)
+	if err != nil {
+		return "", err
+	}
+	defer file.Close()
+
+	h := sha256.New()
+	if _, err := io.Copy(io.MultiWriter(file, h), r); err != nil {
+		return "", err
+	}
+
+	if err := file.Close(); err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
@@ -0,0 +1,132 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package backup
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"io"
+	"path/filepath"
+	"testing"
+
+	"github.com/klauspost/compress/zstd"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/ubccr/grendel/internal/store"
+	"github.com/ubccr/grendel/internal/store/sqlstore"
+	"github.com/ubccr/grendel/internal/tests"
+	"github.com/ubccr/grendel/pkg/model"
+)
+
+func newTestBackup(t *testing.T) []byte {
+	store.Log.Logger.SetLevel(logrus.ErrorLevel)
+
+	db, err := sqlstore.New(":memory:")
+	require.NoError(t, err)
+	defer db.Close()
+
+	hosts := model.HostList{}
+	for range 3 {
+		hosts = append(hosts, tests.HostFactory.MustCreate().(*model.Host))
+	}
+	require.NoError(t, db.StoreHosts(hosts))
+	require.NoError(t, db.StoreBootImage(tests.BootImageFactory.MustCreate().(*model.BootImage)))
+
+	var buf bytes.Buffer
+	manifest, err := Write(&buf, db, "vTEST")
+	require.NoError(t, err)
+	assert.Equal(t, "vTEST", manifest.Version)
+	assert.Equal(t, Counts{Hosts: 3, Images: 1}, manifest.Counts)
+
+	return buf.Bytes()
+}
+
+func TestBackupRestore(t *testing.T) {
+	archive := newTestBackup(t)
+	assert.Equal(t, zstdMagic, archive[:4])
+	filename := filepath.Join(t.TempDir(), "grendel.db")
+
+	manifest, err := Restore(bytes.NewReader(archive), filename, false)
+	require.NoError(t, err)
+	assert.Equal(t, 3, manifest.Counts.Hosts)
+
+	db, err := sqlstore.New(filename)
+	require.NoError(t, err)
+	hosts, err := db.Hosts()
+	assert.NoError(t, err)
+	assert.Len(t, hosts, 3)
+	db.Close()
+
+	// Restoring over a database with hosts requires force
+	_, err = Restore(bytes.NewReader(archive), filename, false)
+	assert.ErrorIs(t, err, ErrNotEmpty)
+
+	_, err = Restore(bytes.NewReader(archive), filename, true)
+	assert.NoError(t, err)
+}
+
+func TestBackupChecksum(t *testing.T) {
+	archive := newTestBackup(t)
+
+	// Rewrite the archive with one byte of the database changed
+	zr, err := zstd.NewReader(bytes.NewReader(archive))
+	require.NoError(t, err)
+	defer zr.Close()
+	tr := tar.NewReader(zr)
+
+	var buf bytes.Buffer
+	zw, err := zstd.NewWriter(&buf)
+	require.NoError(t, err)
+	tw := tar.NewWriter(zw)
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		require.NoError(t, err)
+		data, err := io.ReadAll(tr)
+		require.NoError(t, err)
+		if hdr.Name == DatabaseFile {
+			data[len(data)-1] ^= 0xff
+		}
+		require.NoError(t, tw.WriteHeader(hdr))
+		_, err = tw.Write(data)
+		require.NoError(t, err)
+	}
+	require.NoError(t, tw.Close())
+	require.NoError(t, zw.Close())
+
+	filename := filepath.Join(t.TempDir(), "grendel.db")
+	_, err = Restore(&buf, filename, false)
+	assert.ErrorIs(t, err, ErrChecksum)
+	assert.NoFileExists(t, filename)
+}
+
+func TestRestoreGzip(t *testing.T) {
+	archive := newTestBackup(t)
+
+	// Archives written by earlier versions are gzip compressed
+	zr, err := zstd.NewReader(bytes.NewReader(archive))
+	require.NoError(t, err)
+	defer zr.Close()
+	data, err := io.ReadAll(zr)
+	require.NoError(t, err)
+	var buf bytes.Buffer
+	gw := gzip.NewWriter(&buf)
+	_, err = gw.Write(data)
+	require.NoError(t, err)
+	require.NoError(t, gw.Close())
+	assert.True(t, IsArchive(buf.Bytes()))
+
+	filename := filepath.Join(t.TempDir(), "grendel.db")
+	manifest, err := Restore(&buf, filename, false)
+	require.NoError(t, err)
+	assert.Equal(t, 3, manifest.Counts.Hosts)
+
+	_, err = Restore(bytes.NewReader([]byte("not an archive")), filename, true)
+	assert.Error(t, err)
+}
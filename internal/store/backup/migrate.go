@@ -0,0 +1,174 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package backup
+
+import (
+	"bytes"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/tidwall/gjson"
+	"github.com/tidwall/sjson"
+	"github.com/ubccr/grendel/internal/store/migrations"
+	"github.com/ubccr/grendel/internal/store/sqlstore"
+	"github.com/ubccr/grendel/pkg/model"
+)
+
+// sqliteHeader is the magic string at the start of every sqlite database file
+var sqliteHeader = []byte("SQLite format 3\x00")
+
+// Migrate converts the data store from into a new sqlite database to with the
+// current schema version. from is a sqlite database of any earlier schema
+// version, a backup archive or a JSON dump, including dumps of the schema
+// before uid was added. from is never modified and to must not exist. Returns
+// sqlstore.ErrLocked if from is in use by a running Grendel server.
+func Migrate(from, to string) (*Counts, error) {
+	if to == "" || to == ":memory:" {
+		return nil, errors.New("migrating requires a target database file")
+	}
+	if _, err := os.Stat(to); err == nil {
+		return nil, fmt.Errorf("target database %s already exists", to)
+	}
+
+	data, err := os.ReadFile(from)
+	if err != nil {
+		return nil, err
+	}
+
+	// Build next to the target so the final rename does not cross file systems
+	dir, err := os.MkdirTemp(filepath.Dir(to), ".grendel-migrate-")
+	if err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(dir)
+	filename := filepath.Join(dir, DatabaseFile)
+
+	switch {
+	case bytes.HasPrefix(data, sqliteHeader):
+		lock, err := sqlstore.Lock(from)
+		if err != nil {
+			return nil, err
+		}
+		defer lock.Unlock()
+
+		if err := copySqlite(from, filename); err != nil {
+			return nil, err
+		}
+	case IsArchive(data):
+		if _, err := Extract(bytes.NewReader(data), dir); err != nil {
+			return nil, err
+		}
+	default:
+		if err := loadDump(data, filename); err != nil {
+			return nil, err
+		}
+	}
+
+	// Opening the store runs the schema migrations and rebuilds the indexes
+	db, err := sqlstore.New(filename)
+	if err != nil {
+		return nil, err
+	}
+	if err := db.Close(); err != nil {
+		return nil, err
+	}
+
+	counts, err := count(filename)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := os.Rename(filename, to); err != nil {
+		return nil, err
+	}
+
+	return counts, nil
+}
+
+// copySqlite writes a consistent copy of the sqlite database from to filename
+// without migrating or otherwise writing to from
+func copySqlite(from, filename string) error {
+	src, err := sql.Open(sqlstore.ConfigDefault.Driver, sqlstore.ConfigDefault.DataSourceName(from, false))
+	if err != nil {
+		return err
+	}
+	defer src.Close()
+
+	// Read the version directly as the migrator needs write access
+	var version uint
+	var dirty bool
+	err = src.QueryRow("select version, dirty from schema_migrations").Scan(&version, &dirty)
+	if err != nil {
+		return fmt.Errorf("unsupported database %s: %w", from, err)
+	}
+	if dirty {
+		return fmt.Errorf("database %s has a failed migration to schema version %d", from, version)
+	}
+	if version > migrations.SchemaVersion {
+		return fmt.Errorf("database schema version %d is newer than the supported version %d", version, migrations.SchemaVersion)
+	}
+
+	_, err = src.Exec("VACUUM INTO ?", filename)
+	return err
+}
+
+// loadDump stores a JSON dump in a new sqlite database filename
+func loadDump(data []byte, filename string) error {
+	data, err := upgradeDump(data)
+	if err != nil {
+		return err
+	}
+
+	var dump model.DataDump
+	if err := json.Unmarshal(data, &dump); err != nil {
+		return fmt.Errorf("invalid JSON dump: %w", err)
+	}
+
+	db, err := sqlstore.New(filename)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	return db.RestoreFrom(dump)
+}
+
+// upgradeDump converts the hosts and images of a JSON dump from the schema
+// with the ksuid in "id" and the database id in "_id" to the current schema
+// with the ksuid in "uid". Database ids are dropped and assigned on restore
+func upgradeDump(data []byte) ([]byte, error) {
+	if !gjson.ValidBytes(data) {
+		return nil, errors.New("unsupported data store format: not a sqlite database, backup archive or JSON dump")
+	}
+
+	var err error
+	for _, key := range []string{"Hosts", "hosts", "Images", "images"} {
+		for i, entry := range gjson.GetBytes(data, key).Array() {
+			if entry.Get("id").Type != gjson.String {
+				continue
+			}
+
+			path := fmt.Sprintf("%s.%d", key, i)
+			data, err = sjson.SetBytes(data, path+".uid", entry.Get("id").String())
+			if err != nil {
+				return nil, err
+			}
+			data, err = sjson.DeleteBytes(data, path+".id")
+			if err != nil {
+				return nil, err
+			}
+			data, err = sjson.DeleteBytes(data, path+"._id")
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return data, nil
+}
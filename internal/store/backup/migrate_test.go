@@ -0,0 +1,80 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package backup
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/ubccr/grendel/internal/store/sqlstore"
+)
+
+func TestMigrate(t *testing.T) {
+	dir := t.TempDir()
+	archive := filepath.Join(dir, "grendel.tar.zst")
+	require.NoError(t, os.WriteFile(archive, newTestBackup(t), 0600))
+
+	// Backup archive
+	first := filepath.Join(dir, "first.db")
+	counts, err := Migrate(archive, first)
+	require.NoError(t, err)
+	assert.Equal(t, Counts{Hosts: 3, Images: 1}, *counts)
+
+	// Database file
+	second := filepath.Join(dir, "second.db")
+	counts, err = Migrate(first, second)
+	require.NoError(t, err)
+	assert.Equal(t, 3, counts.Hosts)
+
+	_, err = Migrate(first, second)
+	assert.ErrorContains(t, err, "already exists")
+
+	// A database in use by a server is not migrated
+	lock, err := sqlstore.Lock(first)
+	require.NoError(t, err)
+	_, err = Migrate(first, filepath.Join(dir, "third.db"))
+	assert.ErrorIs(t, err, sqlstore.ErrLocked)
+	require.NoError(t, lock.Unlock())
+
+	_, err = Migrate(filepath.Join(dir, "missing.db"), filepath.Join(dir, "third.db"))
+	assert.Error(t, err)
+}
+
+func TestMigrateDump(t *testing.T) {
+	dir := t.TempDir()
+	dump := filepath.Join(dir, "dump.json")
+	old := `{
+  "hosts": [
+    {
+      "id": "2TfJcVLhjDZkIrLpyFsbv68yzKF",
+      "_id": 42,
+      "name": "cpn-01",
+      "interfaces": [{"mac": "de:ad:be:ef:00:01", "ip": "10.0.0.1/24", "fqdn": "cpn-01.example.com"}]
+    }
+  ],
+  "images": []
+}`
+	require.NoError(t, os.WriteFile(dump, []byte(old), 0600))
+
+	filename := filepath.Join(dir, "grendel.db")
+	counts, err := Migrate(dump, filename)
+	require.NoError(t, err)
+	assert.Equal(t, 1, counts.Hosts)
+
+	db, err := sqlstore.New(filename)
+	require.NoError(t, err)
+	defer db.Close()
+	host, err := db.LoadHostFromName("cpn-01")
+	if assert.NoError(t, err) {
+		assert.Equal(t, "2TfJcVLhjDZkIrLpyFsbv68yzKF", host.UID.String())
+	}
+
+	require.NoError(t, os.WriteFile(dump, []byte("not a data store"), 0600))
+	_, err = Migrate(dump, filepath.Join(dir, "other.db"))
+	assert.ErrorContains(t, err, "unsupported data store format")
+}
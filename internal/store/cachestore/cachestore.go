@@ -0,0 +1,402 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+// Package cachestore implements a read through cache over a Grendel Store for
+// the lookups made by the DHCP, DNS, TFTP and provision services on every
+// request. Cached entries expire after a TTL and the whole cache is
+// invalidated by any write made through it.
+package cachestore
+
+import (
+	"context"
+	"errors"
+	"maps"
+	"net"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ubccr/grendel/internal/firmware"
+	"github.com/ubccr/grendel/internal/store"
+	"github.com/ubccr/grendel/pkg/model"
+	"github.com/ubccr/grendel/pkg/nodeset"
+)
+
+// DefaultTTL is the time entries are cached if no TTL is given
+const DefaultTTL = 30 * time.Second
+
+// Store is a store.Store caching hosts by MAC address, name and SMBIOS UUID, the results of
+// forward and reverse DNS lookups and boot images by name. Not found results
+// are cached too so unknown MAC addresses do not hit the data store on every
+// request. All other methods are passed through to the underlying store.
+type Store struct {
+	store.Store
+	*cache
+}
+
+// cache holds the entries shared by a Store and the stores returned by its
+// WithContext
+type cache struct {
+	ttl time.Duration
+
+	mu         sync.RWMutex
+	generation uint64
+	entries    map[string]entry
+}
+
+type entry struct {
+	value   any
+	err     error
+	expires time.Time
+}
+
+// New returns a Store caching db for ttl. A ttl of 0 uses DefaultTTL
+func New(db store.Store, ttl time.Duration) *Store {
+	if ttl <= 0 {
+		ttl = DefaultTTL
+	}
+
+	return &Store{
+		Store: db,
+		cache: &cache{
+			ttl:     ttl,
+			entries: make(map[string]entry),
+		},
+	}
+}
+
+// WithContext returns a store sharing the cache of s which loads the entries
+// missing from the cache with ctx
+func (s *Store) WithContext(ctx context.Context) store.Store {
+	return s.withContext(ctx)
+}
+
+func (s *Store) withContext(ctx context.Context) *Store {
+	return &Store{Store: s.Store.WithContext(ctx), cache: s.cache}
+}
+
+// Bypass returns a store which reads from the underlying store without the
+// cache and invalidates the cache on writes. It is used by the API so users
+// always see the current data.
+func (s *Store) Bypass() store.Store {
+	return &bypass{Store: s}
+}
+
+// Invalidate removes all cached entries
+func (s *Store) Invalidate() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.generation++
+	clear(s.entries)
+}
+
+// Generation returns the number of times the cache was invalidated. It
+// changes on every write made through the store
+func (s *Store) Generation() uint64 {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.generation
+}
+
+// Len returns the number of cached entries, including expired entries not yet
+// replaced
+func (s *Store) Len() int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return len(s.entries)
+}
+
+// get returns the cached value for key or calls load and caches its result.
+// Only successful and not found results are cached. Results loaded while the
+// cache was invalidated are not cached as they may predate the write.
+func (s *Store) get(key string, load func() (any, error)) (any, error) {
+	now := time.Now()
+
+	s.mu.RLock()
+	e, ok := s.entries[key]
+	generation := s.generation
+	s.mu.RUnlock()
+	if ok && now.Before(e.expires) {
+		return e.value, e.err
+	}
+
+	value, err := load()
+	if err != nil && !errors.Is(err, store.ErrNotFound) {
+		return value, err
+	}
+
+	s.mu.Lock()
+	if s.generation == generation {
+		s.entries[key] = entry{value: value, err: err, expires: now.Add(s.ttl)}
+	}
+	s.mu.Unlock()
+
+	return value, err
+}
+
+// loadHost caches hosts as JSON so callers can not modify the cached copy
+func (s *Store) loadHost(key string, load func() (*model.Host, error)) (*model.Host, error) {
+	value, err := s.get(key, func() (any, error) {
+		host, err := load()
+		if err != nil {
+			return "", err
+		}
+		return host.ToJSON(), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	host := &model.Host{}
+	host.FromJSON(value.(string))
+	return host, nil
+}
+
+// LoadHostFromMAC returns the Host that has a network interface with the give MAC address
+func (s *Store) LoadHostFromMAC(mac string) (*model.Host, error) {
+	return s.loadHost("mac:"+mac, func() (*model.Host, error) { return s.Store.LoadHostFromMAC(mac) })
+}
+
+// LoadHostFromName returns the Host with the given name
+func (s *Store) LoadHostFromName(name string) (*model.Host, error) {
+	return s.loadHost("name:"+name, func() (*model.Host, error) { return s.Store.LoadHostFromName(name) })
+}
+
+// LoadHostFromSMBIOSUUID returns the Host with the given SMBIOS system UUID
+func (s *Store) LoadHostFromSMBIOSUUID(uuid string) (*model.Host, error) {
+	return s.loadHost("uuid:"+uuid, func() (*model.Host, error) { return s.Store.LoadHostFromSMBIOSUUID(uuid) })
+}
+
+// LoadBootImage returns a BootImage with the given name
+func (s *Store) LoadBootImage(name string) (*model.BootImage, error) {
+	value, err := s.get("image:"+name, func() (any, error) { return s.Store.LoadBootImage(name) })
+	if err != nil {
+		return nil, err
+	}
+
+	image := *value.(*model.BootImage)
+	image.InitrdPaths = slices.Clone(image.InitrdPaths)
+	image.ProvisionTemplates = maps.Clone(image.ProvisionTemplates)
+	return &image, nil
+}
+
+// ResolveIPv4 returns the list of IPv4 addresses with the given FQDN
+func (s *Store) ResolveIPv4(fqdn string, states ...string) ([]net.IP, error) {
+	value, err := s.get("a:"+strings.Join(states, ",")+":"+fqdn, func() (any, error) { return s.Store.ResolveIPv4(fqdn, states...) })
+	if err != nil {
+		return nil, err
+	}
+
+	return slices.Clone(value.([]net.IP)), nil
+}
+
+// ResolveIPv6 returns the list of IPv6 addresses with the given FQDN
+func (s *Store) ResolveIPv6(fqdn string, states ...string) ([]net.IP, error) {
+	value, err := s.get("aaaa:"+strings.Join(states, ",")+":"+fqdn, func() (any, error) { return s.Store.ResolveIPv6(fqdn, states...) })
+	if err != nil {
+		return nil, err
+	}
+
+	return slices.Clone(value.([]net.IP)), nil
+}
+
+// ReverseResolve returns the list of FQDNs for the given IP
+func (s *Store) ReverseResolve(ip string, states ...string) ([]string, error) {
+	value, err := s.get("ptr:"+strings.Join(states, ",")+":"+ip, func() (any, error) { return s.Store.ReverseResolve(ip, states...) })
+	if err != nil {
+		return nil, err
+	}
+
+	return slices.Clone(value.([]string)), nil
+}
+
+// FindDNSRecords returns the DNS only records with the given name
+func (s *Store) FindDNSRecords(name string) (model.RecordList, error) {
+	value, err := s.get("record:"+name, func() (any, error) { return s.Store.FindDNSRecords(name) })
+	if err != nil {
+		return nil, err
+	}
+
+	return cloneRecords(value.(model.RecordList)), nil
+}
+
+// ReverseResolveDNSRecords returns the DNS only A records with PTR set for the given IP
+func (s *Store) ReverseResolveDNSRecords(ip string) (model.RecordList, error) {
+	value, err := s.get("record-ptr:"+ip, func() (any, error) { return s.Store.ReverseResolveDNSRecords(ip) })
+	if err != nil {
+		return nil, err
+	}
+
+	return cloneRecords(value.(model.RecordList)), nil
+}
+
+func cloneRecords(records model.RecordList) model.RecordList {
+	if records == nil {
+		return nil
+	}
+
+	list := make(model.RecordList, len(records))
+	for i, r := range records {
+		record := *r
+		list[i] = &record
+	}
+
+	return list
+}
+
+// invalidate removes all cached entries after a write, err is returned
+// unchanged. The cache is invalidated even if the write failed as it may
+// have been partially applied
+func (s *Store) invalidate(err error) error {
+	s.Invalidate()
+	return err
+}
+
+func (s *Store) StoreBootImage(image *model.BootImage) error {
+	return s.invalidate(s.Store.StoreBootImage(image))
+}
+
+func (s *Store) StoreBootImages(images model.BootImageList) error {
+	return s.invalidate(s.Store.StoreBootImages(images))
+}
+
+func (s *Store) DeleteBootImages(names []string) error {
+	return s.invalidate(s.Store.DeleteBootImages(names))
+}
+
+func (s *Store) SetBootImage(ns *nodeset.NodeSet, name string) error {
+	return s.invalidate(s.Store.SetBootImage(ns, name))
+}
+
+func (s *Store) SetBootImageIfCurrent(ns *nodeset.NodeSet, name string, current ...string) error {
+	return s.invalidate(s.Store.SetBootImageIfCurrent(ns, name, current...))
+}
+
+func (s *Store) SetFirmware(ns *nodeset.NodeSet, fw firmware.Build) error {
+	return s.invalidate(s.Store.SetFirmware(ns, fw))
+}
+
+func (s *Store) ProvisionHosts(ns *nodeset.NodeSet, provision bool) error {
+	return s.invalidate(s.Store.ProvisionHosts(ns, provision))
+}
+
+func (s *Store) SetHostsState(ns *nodeset.NodeSet, state string) error {
+	return s.invalidate(s.Store.SetHostsState(ns, state))
+}
+
+func (s *Store) TagHosts(ns *nodeset.NodeSet, tags []string) error {
+	return s.invalidate(s.Store.TagHosts(ns, tags))
+}
+
+func (s *Store) UntagHosts(ns *nodeset.NodeSet, tags []string) error {
+	return s.invalidate(s.Store.UntagHosts(ns, tags))
+}
+
+func (s *Store) StoreHost(host *model.Host) error {
+	return s.invalidate(s.Store.StoreHost(host))
+}
+
+func (s *Store) StoreHosts(hosts model.HostList) error {
+	return s.invalidate(s.Store.StoreHosts(hosts))
+}
+
+func (s *Store) StoreHostInventory(name string, inv *model.Inventory) error {
+	return s.invalidate(s.Store.StoreHostInventory(name, inv))
+}
+
+func (s *Store) StoreHostHardware(name string, hw *model.Hardware) error {
+	return s.invalidate(s.Store.StoreHostHardware(name, hw))
+}
+
+func (s *Store) StoreHostClientCert(name, fingerprint, serial string) error {
+	return s.invalidate(s.Store.StoreHostClientCert(name, fingerprint, serial))
+}
+
+func (s *Store) DeleteHosts(ns *nodeset.NodeSet) error {
+	return s.invalidate(s.Store.DeleteHosts(ns))
+}
+
+func (s *Store) RestoreHosts(ns *nodeset.NodeSet) (int, error) {
+	n, err := s.Store.RestoreHosts(ns)
+	return n, s.invalidate(err)
+}
+
+func (s *Store) PurgeTrash(before time.Time) (int, error) {
+	n, err := s.Store.PurgeTrash(before)
+	return n, s.invalidate(err)
+}
+
+func (s *Store) StoreDNSRecords(records model.RecordList) error {
+	return s.invalidate(s.Store.StoreDNSRecords(records))
+}
+
+func (s *Store) DeleteDNSRecords(names []string) error {
+	return s.invalidate(s.Store.DeleteDNSRecords(names))
+}
+
+func (s *Store) RestoreFrom(data model.DataDump) error {
+	return s.invalidate(s.Store.RestoreFrom(data))
+}
+
+func (s *Store) LoadFrom(data model.DataDump, prune, dryRun bool) (*model.DataDumpDiff, error) {
+	diff, err := s.Store.LoadFrom(data, prune, dryRun)
+	if dryRun {
+		return diff, err
+	}
+	return diff, s.invalidate(err)
+}
+
+func (s *Store) Reindex() error {
+	return s.invalidate(s.Store.Reindex())
+}
+
+// bypass reads from the underlying store of a cache and invalidates the
+// cache on writes
+type bypass struct {
+	*Store
+}
+
+func (b *bypass) WithContext(ctx context.Context) store.Store {
+	return &bypass{Store: b.Store.withContext(ctx)}
+}
+
+func (b *bypass) LoadHostFromMAC(mac string) (*model.Host, error) {
+	return b.Store.Store.LoadHostFromMAC(mac)
+}
+
+func (b *bypass) LoadHostFromName(name string) (*model.Host, error) {
+	return b.Store.Store.LoadHostFromName(name)
+}
+
+func (b *bypass) LoadHostFromSMBIOSUUID(uuid string) (*model.Host, error) {
+	return b.Store.Store.LoadHostFromSMBIOSUUID(uuid)
+}
+
+func (b *bypass) LoadBootImage(name string) (*model.BootImage, error) {
+	return b.Store.Store.LoadBootImage(name)
+}
+
+func (b *bypass) ResolveIPv4(fqdn string, states ...string) ([]net.IP, error) {
+	return b.Store.Store.ResolveIPv4(fqdn, states...)
+}
+
+func (b *bypass) ResolveIPv6(fqdn string, states ...string) ([]net.IP, error) {
+	return b.Store.Store.ResolveIPv6(fqdn, states...)
+}
+
+func (b *bypass) ReverseResolve(ip string, states ...string) ([]string, error) {
+	return b.Store.Store.ReverseResolve(ip, states...)
+}
+
+func (b *bypass) FindDNSRecords(name string) (model.RecordList, error) {
+	return b.Store.Store.FindDNSRecords(name)
+}
+
+func (b *bypass) ReverseResolveDNSRecords(ip string) (model.RecordList, error) {
+	return b.Store.Store.ReverseResolveDNSRecords(ip)
+}
@@ -0,0 +1,271 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package cachestore_test
+
+import (
+	"fmt"
+	"net"
+	"net/netip"
+	"slices"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/ubccr/grendel/internal/store"
+	"github.com/ubccr/grendel/internal/store/cachestore"
+	"github.com/ubccr/grendel/internal/store/sqlstore"
+	"github.com/ubccr/grendel/internal/tests"
+	"github.com/ubccr/grendel/pkg/model"
+	"github.com/ubccr/grendel/pkg/nodeset"
+)
+
+func newTestStore(t testing.TB) *sqlstore.SqlStore {
+	store.Log.Logger.SetLevel(logrus.ErrorLevel)
+
+	db, err := sqlstore.New(":memory:")
+	require.NoError(t, err)
+	t.Cleanup(func() { db.Close() })
+
+	return db
+}
+
+func TestCache(t *testing.T) {
+	db := newTestStore(t)
+	cache := cachestore.New(db, time.Hour)
+
+	host := tests.HostFactory.MustCreate().(*model.Host)
+	require.NoError(t, cache.StoreHost(host))
+	mac := host.Interfaces[0].MAC.String()
+	ns, err := nodeset.NewNodeSet(host.Name)
+	require.NoError(t, err)
+
+	found, err := cache.LoadHostFromMAC(mac)
+	require.NoError(t, err)
+	assert.Equal(t, host.Name, found.Name)
+
+	// Callers can not modify the cached host
+	found.Tags = append(found.Tags, "modified")
+	found, err = cache.LoadHostFromMAC(mac)
+	require.NoError(t, err)
+	assert.NotContains(t, found.Tags, "modified")
+
+	// Writes made to the data store directly are not seen until invalidated
+	require.NoError(t, db.TagHosts(ns, []string{"ib"}))
+	found, err = cache.LoadHostFromMAC(mac)
+	require.NoError(t, err)
+	assert.NotContains(t, found.Tags, "ib")
+
+	// Writes made through the cache are seen immediately
+	require.NoError(t, cache.TagHosts(ns, []string{"gpu"}))
+	found, err = cache.LoadHostFromMAC(mac)
+	require.NoError(t, err)
+	assert.Subset(t, found.Tags, []string{"ib", "gpu"})
+
+	found, err = cache.LoadHostFromName(host.Name)
+	require.NoError(t, err)
+	assert.Equal(t, mac, found.Interfaces[0].MAC.String())
+
+	ips, err := cache.ResolveIPv4(host.Interfaces[0].FQDN)
+	require.NoError(t, err)
+	assert.Len(t, ips, 1)
+
+	names, err := cache.ReverseResolve(host.Interfaces[0].AddrString())
+	require.NoError(t, err)
+	assert.Contains(t, names, host.Interfaces[0].FQDN)
+
+	// Deleted hosts are not found
+	require.NoError(t, cache.DeleteHosts(ns))
+	_, err = cache.LoadHostFromMAC(mac)
+	assert.ErrorIs(t, err, store.ErrNotFound)
+	_, err = cache.LoadHostFromName(host.Name)
+	assert.ErrorIs(t, err, store.ErrNotFound)
+}
+
+func TestCacheNotFound(t *testing.T) {
+	db := newTestStore(t)
+	cache := cachestore.New(db, time.Hour)
+
+	host := tests.HostFactory.MustCreate().(*model.Host)
+	mac := host.Interfaces[0].MAC.String()
+
+	_, err := cache.LoadHostFromMAC(mac)
+	assert.ErrorIs(t, err, store.ErrNotFound)
+
+	// Not found results are cached too
+	require.NoError(t, db.StoreHost(host))
+	_, err = cache.LoadHostFromMAC(mac)
+	assert.ErrorIs(t, err, store.ErrNotFound)
+
+	cache.Invalidate()
+	found, err := cache.LoadHostFromMAC(mac)
+	require.NoError(t, err)
+	assert.Equal(t, host.Name, found.Name)
+}
+
+func TestCacheTTL(t *testing.T) {
+	db := newTestStore(t)
+	cache := cachestore.New(db, 50*time.Millisecond)
+
+	image := tests.BootImageFactory.MustCreate().(*model.BootImage)
+	require.NoError(t, cache.StoreBootImage(image))
+
+	found, err := cache.LoadBootImage(image.Name)
+	require.NoError(t, err)
+	assert.Equal(t, image.KernelPath, found.KernelPath)
+
+	// Callers can not modify the cached image
+	found.InitrdPaths[0] = "modified"
+	found, err = cache.LoadBootImage(image.Name)
+	require.NoError(t, err)
+	assert.Equal(t, image.InitrdPaths, found.InitrdPaths)
+
+	image.KernelPath = "/var/grendel/images/vmlinuz"
+	image.Revision = 0
+	require.NoError(t, db.StoreBootImage(image))
+
+	found, err = cache.LoadBootImage(image.Name)
+	require.NoError(t, err)
+	assert.NotEqual(t, image.KernelPath, found.KernelPath)
+
+	assert.Eventually(t, func() bool {
+		found, err := cache.LoadBootImage(image.Name)
+		return err == nil && found.KernelPath == image.KernelPath
+	}, time.Second, 10*time.Millisecond)
+}
+
+func TestCacheBypass(t *testing.T) {
+	db := newTestStore(t)
+	cache := cachestore.New(db, time.Hour)
+	api := cache.Bypass()
+
+	host := tests.HostFactory.MustCreate().(*model.Host)
+	require.NoError(t, api.StoreHost(host))
+	mac := host.Interfaces[0].MAC.String()
+	ns, err := nodeset.NewNodeSet(host.Name)
+	require.NoError(t, err)
+
+	_, err = cache.LoadHostFromMAC(mac)
+	require.NoError(t, err)
+	assert.Equal(t, 1, cache.Len())
+
+	// Reads bypass the cache
+	_, err = api.LoadHostFromName(host.Name)
+	require.NoError(t, err)
+	assert.Equal(t, 1, cache.Len())
+
+	// Writes invalidate it
+	require.NoError(t, api.ProvisionHosts(ns, true))
+	assert.Equal(t, 0, cache.Len())
+
+	found, err := cache.LoadHostFromMAC(mac)
+	require.NoError(t, err)
+	assert.True(t, found.Provision)
+}
+
+// bootStormHosts is the number of nodes booting at once in BenchmarkBootStorm
+const bootStormHosts = 5000
+
+// BenchmarkBootStorm simulates every node of a cluster booting at once. Each
+// op is one node booting, which makes the lookups of the DHCP, PXE, TFTP,
+// provision and DNS services in bootLookups. The p99 latency of a boot is
+// reported as p99-ns/boot. Run with:
+//
+//	go test ./internal/store/cachestore -run '^$' -bench BootStorm -benchtime 5000x
+func BenchmarkBootStorm(b *testing.B) {
+	db := newTestStore(b)
+
+	image := tests.BootImageFactory.MustCreate().(*model.BootImage)
+	image.Name = "compute"
+	require.NoError(b, db.StoreBootImage(image))
+
+	hosts := make(model.HostList, 0, bootStormHosts)
+	for i := range bootStormHosts {
+		mac := net.HardwareAddr{0x02, 0, 0, 0, byte(i >> 8), byte(i)}
+		hosts = append(hosts, &model.Host{
+			Name:      fmt.Sprintf("cpn-%05d", i),
+			BootImage: "compute",
+			Interfaces: []*model.NetInterface{
+				{
+					MAC:  mac,
+					Name: "eth0",
+					IP:   netip.PrefixFrom(netip.AddrFrom4([4]byte{10, 10, byte(i >> 8), byte(i)}), 16),
+					FQDN: fmt.Sprintf("cpn-%05d.compute.local", i),
+				},
+			},
+		})
+	}
+	require.NoError(b, db.StoreHosts(hosts))
+
+	b.Run("uncached", func(b *testing.B) {
+		bootStorm(b, db, hosts)
+	})
+	b.Run("cached", func(b *testing.B) {
+		bootStorm(b, cachestore.New(db, cachestore.DefaultTTL), hosts)
+	})
+}
+
+// bootLookups makes the data store lookups of one node booting: DHCP
+// discover and request for the firmware and again for iPXE, the PXE boot
+// server, the iPXE script and kickstart from the provision server and
+// resolving the node name and address in DNS
+func bootLookups(db store.Store, host *model.Host) error {
+	mac := host.Interfaces[0].MAC.String()
+	for range 5 {
+		if _, err := db.LoadHostFromMAC(mac); err != nil {
+			return err
+		}
+	}
+	for range 2 {
+		h, err := db.LoadHostFromName(host.Name)
+		if err != nil {
+			return err
+		}
+		if _, err := db.LoadBootImage(h.BootImage); err != nil {
+			return err
+		}
+	}
+	if _, err := db.ResolveIPv4(host.Interfaces[0].FQDN); err != nil {
+		return err
+	}
+	_, err := db.ReverseResolve(host.Interfaces[0].AddrString())
+	return err
+}
+
+func bootStorm(b *testing.B, db store.Store, hosts model.HostList) {
+	var mu sync.Mutex
+	var next atomic.Int64
+	latencies := make([]time.Duration, 0, b.N)
+
+	b.ResetTimer()
+	b.RunParallel(func(pb *testing.PB) {
+		local := make([]time.Duration, 0, 1024)
+		for pb.Next() {
+			host := hosts[int(next.Add(1)-1)%len(hosts)]
+
+			start := time.Now()
+			if err := bootLookups(db, host); err != nil {
+				b.Error(err)
+				return
+			}
+			local = append(local, time.Since(start))
+		}
+
+		mu.Lock()
+		latencies = append(latencies, local...)
+		mu.Unlock()
+	})
+	b.StopTimer()
+
+	if len(latencies) == 0 {
+		return
+	}
+	slices.Sort(latencies)
+	p99 := latencies[(len(latencies)*99)/100]
+	b.ReportMetric(float64(p99.Nanoseconds()), "p99-ns/boot")
+}
@@ -0,0 +1,44 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package store
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+var deadlineExceeded = prometheus.NewCounterVec(prometheus.CounterOpts{
+	Name: "grendel_store_deadline_exceeded_total",
+	Help: "Requests abandoned because the datastore did not answer before their deadline by service",
+}, []string{"service"})
+
+func init() {
+	prometheus.MustRegister(deadlineExceeded)
+}
+
+// WithTimeout returns a context for the datastore operations of a request,
+// canceled after timeout. A timeout of 0 sets no deadline
+func WithTimeout(parent context.Context, timeout time.Duration) (context.Context, context.CancelFunc) {
+	if timeout <= 0 {
+		return context.WithCancel(parent)
+	}
+
+	return context.WithTimeout(parent, timeout)
+}
+
+// DeadlineExceeded returns whether err was returned because the deadline of
+// ctx was exceeded, counting it for service. The driver may return its own
+// error when interrupted so ctx is checked rather than err
+func DeadlineExceeded(ctx context.Context, service string, err error) bool {
+	if err == nil || !errors.Is(ctx.Err(), context.DeadlineExceeded) {
+		return false
+	}
+
+	deadlineExceeded.WithLabelValues(service).Inc()
+	return true
+}
@@ -4,7 +4,10 @@
 
 package store
 
-import "errors"
+import (
+	"errors"
+	"fmt"
+)
 
 var (
 	// ErrNotFound is returned when a model is not found in the store
@@ -15,4 +18,49 @@ var (
 
 	// ErrDuplicateEntry is returned when attempting to store a model with the same ID or Name
 	ErrDuplicateEntry = errors.New("duplicate entry")
+
+	// ErrConflict is returned when a model conflicts with existing data in the store
+	ErrConflict = errors.New("conflict")
+
+	// ErrReadOnly is returned when writing to a store opened read-only
+	ErrReadOnly = errors.New("read-only")
 )
+
+// ReadOnlyError is returned by the writes to a store opened read-only, such
+// as by a grendel serve instance with readonly set. Primary is the instance
+// accepting the writes, if known. It matches ErrReadOnly.
+type ReadOnlyError struct {
+	Op      string
+	Primary string
+}
+
+func (e *ReadOnlyError) Error() string {
+	if e.Primary == "" {
+		return fmt.Sprintf("%s: datastore is read-only", e.Op)
+	}
+	return fmt.Sprintf("%s: datastore is read-only, send changes to the primary %s", e.Op, e.Primary)
+}
+
+func (e *ReadOnlyError) Is(target error) bool {
+	return target == ErrReadOnly
+}
+
+// RevisionError is returned when a model is saved with a revision that does
+// not match the current revision in the store. It matches ErrConflict.
+type RevisionError struct {
+	Kind     string
+	Name     string
+	Expected int64
+	Current  int64
+}
+
+func (e *RevisionError) Error() string {
+	if e.Current == 0 {
+		return fmt.Sprintf("%s %s was deleted, expected revision %d", e.Kind, e.Name, e.Expected)
+	}
+	return fmt.Sprintf("%s %s was modified, expected revision %d but current revision is %d", e.Kind, e.Name, e.Expected, e.Current)
+}
+
+func (e *RevisionError) Is(target error) bool {
+	return target == ErrConflict
+}
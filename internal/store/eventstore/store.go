@@ -7,6 +7,9 @@ import (
 	"github.com/ubccr/grendel/pkg/model"
 )
 
+// Default is the event store shared by the services of a grendel process
+var Default = &Store{}
+
 type Store struct {
 	model.EventList
 	Mu sync.RWMutex
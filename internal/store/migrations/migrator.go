@@ -5,11 +5,14 @@
 package migrations
 
 import (
+	"context"
 	"database/sql"
 	"embed"
 	"fmt"
 
 	"github.com/golang-migrate/migrate/v4"
+	"github.com/golang-migrate/migrate/v4/database"
+	"github.com/golang-migrate/migrate/v4/database/postgres"
 	"github.com/golang-migrate/migrate/v4/database/sqlite"
 	"github.com/golang-migrate/migrate/v4/source"
 	"github.com/golang-migrate/migrate/v4/source/iofs"
@@ -18,23 +21,59 @@ import (
 //go:embed sql/*.sql
 var sqlMigrations embed.FS
 
+//go:embed postgres/*.sql
+var postgresMigrations embed.FS
+
 type Migrator struct {
 	mg     *migrate.Migrate
 	source source.Driver
+
+	// conn is the connection the postgres migrations hold until Close
+	conn *sql.Conn
 }
 
 func New(db *sql.DB) (*Migrator, error) {
-	source, err := iofs.New(sqlMigrations, "sql")
+	dbi, err := sqlite.WithInstance(db, new(sqlite.Config))
 	if err != nil {
-		return nil, fmt.Errorf("Failed to find sql migration sources: %w", err)
+		return nil, fmt.Errorf("Invalid sqlite db instance: %w", err)
 	}
 
-	dbi, err := sqlite.WithInstance(db, new(sqlite.Config))
+	return newMigrator(sqlMigrations, "sql", "sqlite", dbi)
+}
+
+// NewPostgres returns a Migrator applying the migrations of the postgres
+// tree, which has a migration for every version of the sqlite tree. It
+// holds a connection of db until Close
+func NewPostgres(db *sql.DB) (*Migrator, error) {
+	ctx := context.Background()
+	conn, err := db.Conn(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("Invalid sqlite db instance: %w", err)
+		return nil, err
+	}
+
+	dbi, err := postgres.WithConnection(ctx, conn, new(postgres.Config))
+	if err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("Invalid postgres db instance: %w", err)
 	}
 
-	mg, err := migrate.NewWithInstance("iofs", source, "sqlite", dbi)
+	m, err := newMigrator(postgresMigrations, "postgres", "postgres", dbi)
+	if err != nil {
+		conn.Close()
+		return nil, err
+	}
+	m.conn = conn
+
+	return m, nil
+}
+
+func newMigrator(fsys embed.FS, path, dbName string, dbi database.Driver) (*Migrator, error) {
+	source, err := iofs.New(fsys, path)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to find %s migration sources: %w", dbName, err)
+	}
+
+	mg, err := migrate.NewWithInstance("iofs", source, dbName, dbi)
 	if err != nil {
 		return nil, fmt.Errorf("Failed to create migrate instance: %w", err)
 	}
@@ -61,5 +100,9 @@ func (m *Migrator) Migrate() error {
 }
 
 func (m *Migrator) Close() error {
+	if m.conn != nil {
+		m.conn.Close()
+	}
+
 	return m.source.Close()
 }
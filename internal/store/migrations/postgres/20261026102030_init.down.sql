@@ -0,0 +1,55 @@
+-- SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+--
+-- SPDX-License-Identifier: GPL-3.0-or-later
+
+drop view if exists kernel_view;
+drop view if exists node_view;
+drop view if exists user_view;
+drop view if exists role_view;
+drop table if exists scheduled_action cascade;
+drop table if exists secret_fetch cascade;
+drop table if exists secret cascade;
+drop table if exists image_file cascade;
+drop table if exists node_file cascade;
+drop table if exists ip_reservation cascade;
+drop table if exists signing_key cascade;
+drop table if exists revoked_cert cascade;
+drop table if exists maintenance cascade;
+drop table if exists ha_instance cascade;
+drop table if exists node_log cascade;
+drop table if exists pending_bmc cascade;
+drop table if exists change_journal cascade;
+drop table if exists tombstone cascade;
+drop table if exists node_trash cascade;
+drop table if exists node_credential cascade;
+drop table if exists revoked_token cascade;
+drop table if exists dns_record cascade;
+drop table if exists "user" cascade;
+drop table if exists role_permission cascade;
+drop table if exists permission cascade;
+drop table if exists role cascade;
+drop table if exists store_index cascade;
+drop table if exists nic_address cascade;
+drop table if exists nic_fqdn cascade;
+drop table if exists nic cascade;
+drop table if exists node_tag cascade;
+drop table if exists node cascade;
+drop table if exists kernel_template cascade;
+drop table if exists template cascade;
+drop table if exists initrd cascade;
+drop table if exists kernel cascade;
+drop table if exists tag cascade;
+drop table if exists arch cascade;
+drop table if exists template_type cascade;
+drop table if exists node_type cascade;
+drop function if exists secret_fetch_reset();
+drop function if exists release_image_file();
+drop function if exists scheduled_action_change();
+drop function if exists dns_record_change();
+drop function if exists kernel_change();
+drop function if exists node_change();
+drop function if exists change_diff(text[], text[], text[]);
+drop function if exists record_tombstone();
+drop function if exists nic_index();
+drop function if exists set_updated_at();
+drop function if exists sync_id_sequence();
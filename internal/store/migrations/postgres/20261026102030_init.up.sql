@@ -0,0 +1,907 @@
+-- SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+--
+-- SPDX-License-Identifier: GPL-3.0-or-later
+
+-- Schema of a PostgreSQL datastore at the version of the sqlite migrations it
+-- was written from. Later changes to the schema add a migration to both trees
+-- with the same version. Unix times are seconds unless noted otherwise
+
+create table node_type (
+  id         bigserial primary key,
+  name       text      not null
+);
+
+create table template_type (
+  id         bigserial primary key,
+  name       text      not null unique,
+  uri_name   text      not null unique
+);
+
+create table arch (
+  id         bigserial primary key,
+  name       text      not null
+);
+
+create table tag (
+  id         bigserial primary key,
+  key        text      not null unique
+);
+
+create table kernel (
+  id            bigserial   primary key,
+  uid           text        not null unique,
+  name          text        not null unique,
+  version       text        not null,
+  path          text        not null,
+  arch_id       bigint,
+  command_line  text,
+  verify        boolean     not null default false,
+  created_at    timestamptz not null default current_timestamp,
+  updated_at    timestamptz not null default current_timestamp,
+  revision      bigint      not null default 1,
+  parent_id     bigint
+);
+
+create table initrd (
+  id            bigserial   primary key,
+  kernel_id     bigint      not null references kernel(id) on delete cascade,
+  path          text        not null,
+  created_at    timestamptz not null default current_timestamp,
+  updated_at    timestamptz not null default current_timestamp,
+  unique (kernel_id, path)
+);
+
+create index initrd_kernel_id_idx on initrd(kernel_id);
+
+create table template (
+  id                bigserial   primary key,
+  template_type_id  bigint      not null references template_type(id),
+  name              text        not null unique,
+  created_at        timestamptz not null default current_timestamp,
+  updated_at        timestamptz not null default current_timestamp
+);
+
+create table kernel_template (
+  kernel_id    bigint not null references kernel(id) on delete cascade,
+  template_id  bigint not null references template(id) on delete cascade,
+  primary key (kernel_id, template_id)
+);
+
+create table node (
+  id                      bigserial   primary key,
+  uid                     text        not null unique,
+  name                    text        not null unique,
+  provision               boolean     not null default false,
+  arch_id                 bigint      references arch(id),
+  kernel_id               bigint      references kernel(id),
+  node_type_id            bigint      references node_type(id),
+  firmware                text,
+  created_at              timestamptz not null default current_timestamp,
+  updated_at              timestamptz not null default current_timestamp,
+  revision                bigint      not null default 1,
+  smbios_uuid             text,
+  inventory               text,
+  hardware                text,
+  client_cert_fingerprint text,
+  client_cert_serial      text,
+  state                   text        not null default 'new'
+);
+
+create index node_kernel_id_idx on node(kernel_id);
+create index node_smbios_uuid_idx on node(smbios_uuid);
+
+create table node_tag (
+  id         bigserial primary key,
+  tag_id     bigint    not null references tag(id) on delete cascade,
+  node_id    bigint    not null references node(id) on delete cascade,
+  value      text      not null,
+  unique (tag_id, node_id, value)
+);
+
+create index node_tag_node_id_idx on node_tag(node_id);
+
+create table nic (
+  id         bigserial primary key,
+  node_id    bigint    not null references node(id) on delete cascade,
+  nic_type   text      not null,
+  name       text,
+  vlan       text,
+  fqdn       text,
+  mac        text,
+  ip         text,
+  peers      text,
+  mtu        bigint,
+  switch     text,
+  port       bigint,
+  parent     text,
+  mode       text,
+  options    text,
+  addresses  text
+);
+
+create index nic_node_id_idx on nic(node_id);
+create index nic_mac_idx on nic(mac);
+create index nic_addr_idx on nic(split_part(ip, '/', 1));
+
+-- FQDNs of the fqdn column of nic, which holds a comma separated list
+create table nic_fqdn (
+  nic_id     bigint not null references nic(id) on delete cascade,
+  fqdn       text   not null,
+  primary key (nic_id, fqdn)
+);
+
+create index nic_fqdn_fqdn_idx on nic_fqdn(fqdn);
+
+-- Names and addresses of the secondary addresses of the addresses column of
+-- nic, a JSON list of objects with an ip and fqdn
+create table nic_address (
+  nic_id     bigint not null references nic(id) on delete cascade,
+  ip         text   not null,
+  addr       text   not null,
+  fqdn       text,
+  name       text   not null,
+  primary key (nic_id, addr, name)
+);
+
+create index nic_address_addr_idx on nic_address(addr);
+create index nic_address_name_idx on nic_address(name);
+
+create table store_index (
+  name       text   primary key,
+  version    bigint not null
+);
+
+create table role (
+  id         bigserial primary key,
+  name       text      not null unique
+);
+
+create table permission (
+  id      bigserial primary key,
+  method  text      not null,
+  path    text      not null
+);
+
+create table role_permission (
+  role_id       bigint not null references role(id) on delete cascade,
+  permission_id bigint not null references permission(id) on delete cascade,
+  unique (role_id, permission_id)
+);
+
+create table "user" (
+  id            bigserial   primary key,
+  username      text        not null unique,
+  role_id       bigint      not null references role(id) on delete restrict,
+  password_hash text        not null,
+  enabled       boolean     not null default false,
+  created_at    timestamptz not null default current_timestamp,
+  updated_at    timestamptz not null default current_timestamp
+);
+
+create table dns_record (
+  id         bigserial   primary key,
+  name       text        not null,
+  type       text        not null,
+  value      text        not null,
+  ttl        bigint      not null default 0,
+  ptr        boolean     not null default false,
+  created_at timestamptz not null default current_timestamp,
+  updated_at timestamptz not null default current_timestamp,
+  unique (name, type, value)
+);
+
+create index dns_record_name_idx on dns_record(name);
+create index dns_record_value_idx on dns_record(value);
+
+create table revoked_token (
+  id         text        primary key,
+  host_uid   text        not null,
+  mac        text        not null,
+  expires    bigint      not null default 0,
+  created_at timestamptz not null default current_timestamp
+);
+
+create index revoked_token_expires_idx on revoked_token(expires);
+
+create table node_credential (
+  id         bigserial   primary key,
+  node_id    bigint      not null references node(id) on delete cascade,
+  kind       text        not null,
+  secret     text        not null,
+  created_at timestamptz not null default current_timestamp,
+  updated_at timestamptz not null default current_timestamp,
+  unique (node_id, kind)
+);
+
+create table node_trash (
+  id               bigserial primary key,
+  name             text      not null unique,
+  host_json        text      not null,
+  credentials_json text      not null default '[]',
+  deleted_at       bigint    not null
+);
+
+create index node_trash_deleted_at_idx on node_trash(deleted_at);
+
+create table tombstone (
+  id         bigserial primary key,
+  kind       text      not null,
+  name       text      not null,
+  deleted_at bigint    not null default extract(epoch from current_timestamp)::bigint,
+  unique (kind, name)
+);
+
+create index tombstone_deleted_at_idx on tombstone(deleted_at);
+
+create table change_journal (
+  seq        bigserial primary key,
+  changed_at bigint    not null default extract(epoch from current_timestamp)::bigint,
+  kind       text      not null,
+  name       text      not null,
+  op         text      not null,
+  diff       text
+);
+
+create index change_journal_changed_at_idx on change_journal(changed_at);
+
+create table pending_bmc (
+  mac          text   primary key,
+  vendor_class text   not null default '',
+  relay        text   not null default '',
+  server_ip    text   not null default '',
+  first_seen   bigint not null,
+  last_seen    bigint not null
+);
+
+create table node_log (
+  id         bigserial primary key,
+  node_id    bigint    not null references node(id) on delete cascade,
+  logged_at  bigint    not null,
+  severity   text      not null,
+  message_id text      not null default '',
+  message    text      not null
+);
+
+create index node_log_node_id_idx on node_log(node_id, logged_at);
+
+create table ha_instance (
+  name      text   primary key,
+  role      text   not null,
+  state     text   not null,
+  heartbeat bigint not null,
+  changed   bigint not null
+);
+
+-- Times are unix milliseconds
+create table maintenance (
+  id         bigint  primary key check (id = 1),
+  enabled    boolean not null,
+  reason     text    not null default '',
+  changed_by text    not null default '',
+  changed    bigint  not null
+);
+
+create table revoked_cert (
+  serial     text        primary key,
+  host       text        not null default '',
+  created_at timestamptz not null default current_timestamp
+);
+
+create table signing_key (
+  id        text   primary key,
+  kind      text   not null,
+  secret    text   not null,
+  created   bigint not null,
+  retire_at bigint not null default 0
+);
+
+create table ip_reservation (
+  ip       text   primary key,
+  kind     text   not null,
+  host     text   not null default '',
+  username text   not null default '',
+  created  bigint not null,
+  expires  bigint not null
+);
+
+create table node_file (
+  id         bigserial   primary key,
+  node_id    bigint      not null references node(id) on delete cascade,
+  name       text        not null,
+  data       bytea       not null,
+  created_at timestamptz not null default current_timestamp,
+  updated_at timestamptz not null default current_timestamp,
+  unique (node_id, name)
+);
+
+create table image_file (
+  path        text   primary key,
+  image       text   not null,
+  released_at bigint not null default extract(epoch from current_timestamp)::bigint
+);
+
+create index image_file_released_at_idx on image_file(released_at);
+
+create table secret (
+  name       text        primary key,
+  value      text        not null,
+  one_time   boolean     not null default false,
+  created_at timestamptz not null default current_timestamp,
+  updated_at timestamptz not null default current_timestamp
+);
+
+create table secret_fetch (
+  name       text        not null references secret(name) on delete cascade,
+  token_id   text        not null,
+  host       text        not null,
+  fetched_at timestamptz not null default current_timestamp,
+  primary key (name, token_id)
+);
+
+create table scheduled_action (
+  id          bigserial primary key,
+  action      text      not null,
+  nodeset     text      not null,
+  arg         text      not null default '',
+  run_at      bigint    not null,
+  created_by  text      not null default '',
+  created_at  bigint    not null,
+  status      text      not null default 'pending',
+  started_at  bigint    not null default 0,
+  finished_at bigint    not null default 0,
+  results     text      not null default '[]',
+  error       text      not null default ''
+);
+
+create index scheduled_action_status_run_at_idx on scheduled_action(status, run_at);
+
+create view role_view as
+select
+  role.name,
+  json_build_object(
+    'name', role.name,
+    'permission_list', coalesce((
+      select json_agg(json_build_object(
+        'method', permission.method,
+        'path', permission.path
+      ) order by permission.id)
+      from role_permission
+      join permission
+        on role_permission.permission_id = permission.id
+      where role_permission.role_id = role.id
+    ), '[]'),
+    'unassigned_permission_list', coalesce((
+      select json_agg(json_build_object(
+        'method', permission.method,
+        'path', permission.path
+      ) order by permission.id)
+      from permission
+      where not exists (
+        select 1 from role_permission
+        where role_permission.role_id = role.id
+          and role_permission.permission_id = permission.id
+      )
+    ), '[]')
+  )::text as permission_json
+from role;
+
+create view user_view as
+select
+  u.username,
+  u.id,
+  u.password_hash,
+  role.name as role,
+  u.enabled,
+  u.created_at,
+  u.updated_at
+from "user" as u
+join role
+  on role.id = u.role_id;
+
+create view node_view as
+select
+  n.id,
+  n.name,
+  n.uid,
+  json_build_object(
+    'id', n.id,
+    'uid', n.uid,
+    'revision', n.revision,
+    'created_at', to_char(n.created_at at time zone 'UTC', 'YYYY-MM-DD"T"HH24:MI:SS"Z"'),
+    'updated_at', to_char(n.updated_at at time zone 'UTC', 'YYYY-MM-DD"T"HH24:MI:SS"Z"'),
+    'name', n.name,
+    'provision', n.provision,
+    'state', n.state,
+    'boot_image', k.name,
+    'firmware', n.firmware,
+    'smbios_uuid', n.smbios_uuid,
+    'inventory', n.inventory::json,
+    'hardware', n.hardware::json,
+    'client_cert_fingerprint', n.client_cert_fingerprint,
+    'client_cert_serial', n.client_cert_serial,
+    'tags', coalesce((
+      select json_agg(concat_ws(':', t.key, nt.value) order by nt.id)
+      from node_tag as nt
+      join tag as t
+        on nt.tag_id = t.id
+      where nt.node_id = n.id
+    ), '[]'),
+    'interfaces', coalesce((
+      select json_agg(json_build_object(
+        'id', nc.id,
+        'ifname', nc.name,
+        'fqdn', nc.fqdn,
+        'vlan', nullif(nc.vlan, '')::integer,
+        'parent', nc.parent,
+        'mac', nc.mac,
+        'mtu', nc.mtu,
+        'bmc', nc.nic_type = 'bmc',
+        'ip', nc.ip,
+        'switch', nc.switch,
+        'port', nc.port,
+        'addresses', nc.addresses::json
+      ) order by nc.id)
+      from nic as nc
+      where nc.node_id = n.id and nc.nic_type not in ('bond', 'bridge')
+    ), '[]'),
+    'bonds', coalesce((
+      select json_agg(json_build_object(
+        'id', nc.id,
+        'ifname', nc.name,
+        'fqdn', nc.fqdn,
+        'vlan', nullif(nc.vlan, '')::integer,
+        'parent', nc.parent,
+        'mac', nc.mac,
+        'type', nc.nic_type,
+        'peers', nc.peers::json,
+        'mode', nc.mode,
+        'options', nc.options::json,
+        'mtu', nc.mtu,
+        'bmc', nc.nic_type = 'bmc',
+        'ip', nc.ip,
+        'addresses', nc.addresses::json
+      ) order by nc.id)
+      from nic as nc
+      where nc.node_id = n.id and nc.nic_type in ('bond', 'bridge')
+    ), '[]')
+  )::text as host_json
+from node as n
+left join kernel as k
+  on n.kernel_id = k.id;
+
+create view kernel_view as
+select
+  k.id,
+  k.name,
+  json_build_object(
+    'id', k.id,
+    'uid', k.uid,
+    'revision', k.revision,
+    'created_at', to_char(k.created_at at time zone 'UTC', 'YYYY-MM-DD"T"HH24:MI:SS"Z"'),
+    'updated_at', to_char(k.updated_at at time zone 'UTC', 'YYYY-MM-DD"T"HH24:MI:SS"Z"'),
+    'name', k.name,
+    'kernel', k.path,
+    'inherits', (select p.name from kernel as p where p.id = k.parent_id),
+    'cmdline', k.command_line,
+    'verify', k.verify,
+    'initrd', coalesce((
+      select json_agg(rd.path order by rd.id)
+      from initrd as rd
+      where rd.kernel_id = k.id
+    ), '[]'),
+    'provision_templates', coalesce((
+      select json_object_agg(tt.uri_name, t.name)
+      from kernel_template as kt
+      join template as t
+        on kt.template_id = t.id
+      join template_type as tt
+        on t.template_type_id = tt.id
+      where kt.kernel_id = k.id
+    ), '{}')
+  )::text as image_json
+from kernel as k;
+
+-- Rows stored with an explicit id, such as hosts imported with their ids,
+-- move the id sequence past them so later rows get a free id
+create function sync_id_sequence() returns trigger as $$
+declare
+  seq regclass := pg_get_serial_sequence(tg_table_name, 'id')::regclass;
+begin
+  if new.id > coalesce(pg_sequence_last_value(seq), 0) then
+    perform setval(seq, new.id);
+  end if;
+  return null;
+end;
+$$ language plpgsql;
+
+create trigger node_sync_id_sequence after insert on node
+  for each row execute function sync_id_sequence();
+create trigger kernel_sync_id_sequence after insert on kernel
+  for each row execute function sync_id_sequence();
+create trigger nic_sync_id_sequence after insert on nic
+  for each row execute function sync_id_sequence();
+
+create function set_updated_at() returns trigger as $$
+begin
+  new.updated_at := current_timestamp;
+  return new;
+end;
+$$ language plpgsql;
+
+create trigger update_kernel_timestamp before update on kernel
+  for each row execute function set_updated_at();
+create trigger update_node_timestamp before update on node
+  for each row execute function set_updated_at();
+create trigger update_dns_record_timestamp before update on dns_record
+  for each row execute function set_updated_at();
+create trigger update_node_credential_timestamp before update on node_credential
+  for each row execute function set_updated_at();
+create trigger update_node_file_timestamp before update on node_file
+  for each row execute function set_updated_at();
+create trigger update_secret_timestamp before update on secret
+  for each row execute function set_updated_at();
+
+create function nic_index() returns trigger as $$
+declare
+  fqdn_changed boolean := true;
+  addresses_changed boolean := true;
+begin
+  if tg_op = 'UPDATE' then
+    fqdn_changed := new.fqdn is distinct from old.fqdn;
+    addresses_changed := new.addresses is distinct from old.addresses;
+  end if;
+
+  if fqdn_changed then
+    delete from nic_fqdn where nic_id = new.id;
+    insert into nic_fqdn (nic_id, fqdn)
+    select new.id, lower(rtrim(trim(f), '.'))
+    from regexp_split_to_table(coalesce(new.fqdn, ''), ',') as f
+    where trim(f) != ''
+    on conflict do nothing;
+  end if;
+
+  if addresses_changed then
+    delete from nic_address where nic_id = new.id;
+    insert into nic_address (nic_id, ip, addr, fqdn, name)
+    select new.id, a->>'ip', split_part(a->>'ip', '/', 1), a->>'fqdn', lower(rtrim(trim(f), '.'))
+    from json_array_elements(coalesce(new.addresses, '[]')::json) as a,
+         regexp_split_to_table(coalesce(a->>'fqdn', ''), ',') as f
+    where coalesce(a->>'ip', '') != ''
+    on conflict do nothing;
+  end if;
+
+  return null;
+end;
+$$ language plpgsql;
+
+create trigger nic_index after insert or update of fqdn, addresses on nic
+  for each row execute function nic_index();
+
+-- tg_argv[0] is the kind of the tombstone, host or image
+create function record_tombstone() returns trigger as $$
+begin
+  if tg_op in ('UPDATE', 'DELETE') then
+    insert into tombstone (kind, name) values (tg_argv[0], old.name)
+    on conflict (kind, name) do update set deleted_at = excluded.deleted_at;
+  end if;
+  if tg_op in ('INSERT', 'UPDATE') then
+    delete from tombstone where kind = tg_argv[0] and name = new.name;
+  end if;
+
+  return null;
+end;
+$$ language plpgsql;
+
+create trigger node_tombstone_insert after insert on node
+  for each row execute function record_tombstone('host');
+create trigger node_tombstone_rename after update of name on node
+  for each row when (old.name is distinct from new.name) execute function record_tombstone('host');
+create trigger node_tombstone_delete after delete on node
+  for each row execute function record_tombstone('host');
+create trigger kernel_tombstone_insert after insert on kernel
+  for each row execute function record_tombstone('image');
+create trigger kernel_tombstone_rename after update of name on kernel
+  for each row when (old.name is distinct from new.name) execute function record_tombstone('image');
+create trigger kernel_tombstone_delete after delete on kernel
+  for each row execute function record_tombstone('image');
+
+-- diff returns the changed fields of a change journal update as a JSON object
+-- of the old and new values, null if none changed
+create function change_diff(fields text[], old_values text[], new_values text[]) returns text as $$
+  select json_object_agg(f.field, json_build_object('old', f.old_value, 'new', f.new_value))::text
+  from unnest(fields, old_values, new_values) as f(field, old_value, new_value)
+  where f.old_value is distinct from f.new_value;
+$$ language sql immutable;
+
+create function node_change() returns trigger as $$
+begin
+  if tg_op = 'INSERT' then
+    insert into change_journal (kind, name, op) values ('host', new.name, 'create');
+  elsif tg_op = 'DELETE' then
+    insert into change_journal (kind, name, op) values ('host', old.name, 'delete');
+  else
+    insert into change_journal (kind, name, op, diff)
+    values ('host', new.name, 'update', change_diff(
+      array['name', 'provision', 'state', 'boot_image', 'firmware', 'smbios_uuid'],
+      array[old.name, old.provision::text, old.state,
+            (select name from kernel where id = old.kernel_id), old.firmware, old.smbios_uuid],
+      array[new.name, new.provision::text, new.state,
+            (select name from kernel where id = new.kernel_id), new.firmware, new.smbios_uuid]
+    ));
+  end if;
+
+  return null;
+end;
+$$ language plpgsql;
+
+create trigger node_change_insert after insert on node
+  for each row execute function node_change();
+create trigger node_change_update after update of revision, inventory, hardware on node
+  for each row execute function node_change();
+create trigger node_change_delete after delete on node
+  for each row execute function node_change();
+
+create function kernel_change() returns trigger as $$
+begin
+  if tg_op = 'INSERT' then
+    insert into change_journal (kind, name, op) values ('image', new.name, 'create');
+  elsif tg_op = 'DELETE' then
+    insert into change_journal (kind, name, op) values ('image', old.name, 'delete');
+  else
+    insert into change_journal (kind, name, op, diff)
+    values ('image', new.name, 'update', change_diff(
+      array['name', 'kernel', 'cmdline', 'verify'],
+      array[old.name, old.path, old.command_line, old.verify::text],
+      array[new.name, new.path, new.command_line, new.verify::text]
+    ));
+  end if;
+
+  return null;
+end;
+$$ language plpgsql;
+
+create trigger kernel_change_insert after insert on kernel
+  for each row execute function kernel_change();
+create trigger kernel_change_update after update of revision on kernel
+  for each row execute function kernel_change();
+create trigger kernel_change_delete after delete on kernel
+  for each row execute function kernel_change();
+
+create function dns_record_change() returns trigger as $$
+begin
+  if tg_op = 'INSERT' then
+    insert into change_journal (kind, name, op)
+    values ('dns_record', concat_ws('/', new.name, new.type, new.value), 'create');
+  elsif tg_op = 'DELETE' then
+    insert into change_journal (kind, name, op)
+    values ('dns_record', concat_ws('/', old.name, old.type, old.value), 'delete');
+  else
+    insert into change_journal (kind, name, op, diff)
+    values ('dns_record', concat_ws('/', new.name, new.type, new.value), 'update', change_diff(
+      array['ttl', 'ptr'],
+      array[old.ttl::text, old.ptr::text],
+      array[new.ttl::text, new.ptr::text]
+    ));
+  end if;
+
+  return null;
+end;
+$$ language plpgsql;
+
+create trigger dns_record_change_insert after insert on dns_record
+  for each row execute function dns_record_change();
+create trigger dns_record_change_update after update of ttl, ptr on dns_record
+  for each row when (old.ttl is distinct from new.ttl or old.ptr is distinct from new.ptr)
+  execute function dns_record_change();
+create trigger dns_record_change_delete after delete on dns_record
+  for each row execute function dns_record_change();
+
+create function scheduled_action_change() returns trigger as $$
+begin
+  if tg_op = 'INSERT' then
+    insert into change_journal (kind, name, op, diff)
+    values ('schedule', new.id::text, 'create', json_build_object(
+      'action', json_build_object('old', '', 'new', new.action),
+      'nodeset', json_build_object('old', '', 'new', new.nodeset),
+      'arg', json_build_object('old', '', 'new', new.arg),
+      'created_by', json_build_object('old', '', 'new', new.created_by)
+    )::text);
+  elsif tg_op = 'DELETE' then
+    insert into change_journal (kind, name, op) values ('schedule', old.id::text, 'delete');
+  else
+    insert into change_journal (kind, name, op, diff)
+    values ('schedule', new.id::text, 'update', json_build_object(
+      'status', json_build_object('old', old.status, 'new', new.status)
+    )::text);
+  end if;
+
+  return null;
+end;
+$$ language plpgsql;
+
+create trigger scheduled_action_change_insert after insert on scheduled_action
+  for each row execute function scheduled_action_change();
+create trigger scheduled_action_change_update after update of status on scheduled_action
+  for each row when (old.status is distinct from new.status) execute function scheduled_action_change();
+create trigger scheduled_action_change_delete after delete on scheduled_action
+  for each row execute function scheduled_action_change();
+
+-- Files of boot images which were replaced or deleted, kept until purged so
+-- hosts still booting them are served
+create function release_image_file() returns trigger as $$
+begin
+  if tg_table_name = 'initrd' then
+    insert into image_file (path, image)
+    values (old.path, coalesce((select name from kernel where id = old.kernel_id), ''))
+    on conflict (path) do update set image = excluded.image, released_at = excluded.released_at;
+  else
+    insert into image_file (path, image) values (old.path, old.name)
+    on conflict (path) do update set image = excluded.image, released_at = excluded.released_at;
+  end if;
+
+  return null;
+end;
+$$ language plpgsql;
+
+create trigger image_file_kernel_update after update of path on kernel
+  for each row when (old.path is distinct from new.path) execute function release_image_file();
+create trigger image_file_kernel_delete after delete on kernel
+  for each row execute function release_image_file();
+create trigger image_file_initrd_delete after delete on initrd
+  for each row execute function release_image_file();
+
+create function secret_fetch_reset() returns trigger as $$
+begin
+  delete from secret_fetch where name = old.name;
+  return null;
+end;
+$$ language plpgsql;
+
+create trigger secret_fetch_reset after update of value on secret
+  for each row execute function secret_fetch_reset();
+
+insert into arch (id, name) values
+  (1, 'x86_64'),
+  (2, 'aarch64');
+
+insert into role (id, name) values
+  (1, 'admin'),
+  (2, 'user'),
+  (3, 'read-only');
+
+insert into permission (id, method, path) values
+  (1, 'GET', '/v1/db/dump'),
+  (2, 'GET', '/v1/users'),
+  (3, 'GET', '/v1/grendel/events'),
+  (4, 'GET', '/v1/bmc'),
+  (5, 'GET', '/v1/nodes/token/%'),
+  (6, 'GET', '/v1/nodes/find'),
+  (7, 'GET', '/v1/bmc/jobs'),
+  (8, 'GET', '/v1/bmc/metrics'),
+  (9, 'GET', '/v1/nodes'),
+  (10, 'GET', '/v1/images'),
+  (11, 'GET', '/v1/images/find'),
+  (12, 'GET', '/v1/roles'),
+  (13, 'PATCH', '/v1/nodes/provision'),
+  (14, 'PATCH', '/v1/nodes/tags/%'),
+  (15, 'PATCH', '/v1/nodes/image'),
+  (16, 'PATCH', '/v1/users/%/role'),
+  (17, 'PATCH', '/v1/users/%/enable'),
+  (18, 'PATCH', '/v1/roles'),
+  (19, 'PATCH', '/v1/auth/reset'),
+  (20, 'POST', '/v1/users'),
+  (21, 'POST', '/v1/bmc/power/os'),
+  (22, 'POST', '/v1/bmc/power/bmc'),
+  (23, 'POST', '/v1/bmc/configure/auto'),
+  (24, 'POST', '/v1/db/restore'),
+  (25, 'POST', '/v1/nodes'),
+  (26, 'POST', '/v1/images'),
+  (27, 'POST', '/v1/auth/token'),
+  (28, 'POST', '/v1/bmc/configure/import'),
+  (29, 'POST', '/v1/roles'),
+  (30, 'DELETE', '/v1/bmc/jobs/%'),
+  (31, 'DELETE', '/v1/users/%'),
+  (32, 'DELETE', '/v1/bmc/sel'),
+  (33, 'DELETE', '/v1/nodes'),
+  (34, 'DELETE', '/v1/images'),
+  (35, 'DELETE', '/v1/roles/%'),
+  (36, 'GET', '/v1/switch/%/hosttree'),
+  (37, 'GET', '/v1/switch/%/lldp'),
+  (38, 'GET', '/v1/bmc/upgrade/dell/repo'),
+  (39, 'POST', '/v1/bmc/upgrade/dell/installfromrepo'),
+  (40, 'DELETE', '/v1/bmc/jobs'),
+  (41, 'POST', '/v1/switch/%/scan'),
+  (42, 'GET', '/v1/dns/records'),
+  (43, 'POST', '/v1/dns/records'),
+  (44, 'DELETE', '/v1/dns/records'),
+  (45, 'PATCH', '/v1/nodes/rename'),
+  (46, 'POST', '/v1/nodes/token/inspect'),
+  (47, 'POST', '/v1/nodes/token/revoke'),
+  (48, 'GET', '/v1/db/backup'),
+  (49, 'POST', '/v1/db/load'),
+  (50, 'POST', '/v1/db/reindex'),
+  (51, 'GET', '/v1/nodes/credentials'),
+  (52, 'PUT', '/v1/nodes/credentials'),
+  (53, 'DELETE', '/v1/nodes/credentials'),
+  (54, 'GET', '/v1/nodes/trash'),
+  (55, 'POST', '/v1/nodes/trash/restore'),
+  (56, 'DELETE', '/v1/nodes/trash'),
+  (57, 'GET', '/v1/nodes/deleted'),
+  (58, 'GET', '/v1/images/deleted'),
+  (59, 'GET', '/v1/changes'),
+  (60, 'GET', '/v1/bmc/power'),
+  (61, 'POST', '/v1/bmc/boot'),
+  (62, 'POST', '/v1/bmc/inventory'),
+  (63, 'GET', '/v1/bmc/vmedia'),
+  (64, 'POST', '/v1/bmc/vmedia'),
+  (65, 'DELETE', '/v1/bmc/vmedia'),
+  (66, 'GET', '/v1/bmc/bios'),
+  (67, 'GET', '/v1/bmc/bios/diff'),
+  (68, 'POST', '/v1/bmc/bios'),
+  (69, 'GET', '/v1/bmc/console'),
+  (70, 'POST', '/v1/bmc/fwupdate'),
+  (71, 'POST', '/v1/bmc/fwupdate/image'),
+  (72, 'GET', '/v1/bmc/prometheus'),
+  (73, 'GET', '/v1/discover/bmc'),
+  (74, 'POST', '/v1/discover/bmc/adopt'),
+  (75, 'DELETE', '/v1/discover/bmc'),
+  (76, 'POST', '/v1/bmc/rotate-password'),
+  (77, 'GET', '/v1/nodes/log'),
+  (78, 'GET', '/v1/bmc/subscriptions'),
+  (79, 'POST', '/v1/bmc/subscriptions'),
+  (80, 'POST', '/v1/grendel/reload'),
+  (81, 'GET', '/v1/grendel/ha'),
+  (82, 'GET', '/v1/grendel/listeners'),
+  (83, 'GET', '/v1/grendel/maintenance'),
+  (84, 'PUT', '/v1/grendel/maintenance'),
+  (85, 'GET', '/v1/nodes/prometheus-sd'),
+  (86, 'GET', '/v1/switch/%/verify'),
+  (87, 'POST', '/v1/switch/%/verify'),
+  (88, 'GET', '/v1/grendel/stats'),
+  (89, 'PUT', '/v1/nodes/client-cert'),
+  (90, 'POST', '/v1/certs/revoke'),
+  (91, 'GET', '/v1/certs/revoked'),
+  (92, 'GET', '/v1/grendel/keys'),
+  (93, 'POST', '/v1/grendel/keys/rotate'),
+  (94, 'GET', '/v1/nodes/reservations'),
+  (95, 'POST', '/v1/nodes/nextip'),
+  (96, 'PATCH', '/v1/nodes/firmware'),
+  (97, 'GET', '/v1/nodes/files'),
+  (98, 'PUT', '/v1/nodes/files'),
+  (99, 'DELETE', '/v1/nodes/files'),
+  (100, 'GET', '/v1/images/du'),
+  (101, 'POST', '/v1/images/gc'),
+  (102, 'GET', '/v1/secrets'),
+  (103, 'PUT', '/v1/secrets/%'),
+  (104, 'DELETE', '/v1/secrets/%'),
+  (105, 'PATCH', '/v1/nodes/state'),
+  (106, 'GET', '/v1/schedule'),
+  (107, 'GET', '/v1/schedule/%'),
+  (108, 'POST', '/v1/schedule'),
+  (109, 'DELETE', '/v1/schedule/%'),
+  (110, 'GET', '/v1/grendel/readonly'),
+  (111, 'GET', '/v1/artifacts'),
+  (112, 'GET', '/v1/artifacts/%')
+;
+
+select setval(pg_get_serial_sequence('arch', 'id'), (select max(id) from arch));
+select setval(pg_get_serial_sequence('role', 'id'), (select max(id) from role));
+select setval(pg_get_serial_sequence('permission', 'id'), (select max(id) from permission));
+
+insert into role_permission (role_id, permission_id)
+select 1, id from permission;
+
+insert into role_permission (role_id, permission_id)
+select 2, id from permission where id in (
+  3, 4, 6, 7, 8, 9, 10, 11, 13, 15, 19, 20, 21, 22, 23, 25, 26, 27, 28, 32,
+  33, 34, 36, 37, 38, 39, 40, 41, 42, 43, 44, 45, 46, 47, 57, 58, 59, 60,
+  61, 62, 63, 64, 65, 66, 67, 68, 69, 70, 71, 72, 73, 74, 75, 77, 78, 79,
+  81, 82, 83, 85, 86, 87, 88, 89, 90, 91, 92, 94, 95, 96, 97, 98, 99, 100,
+  105, 106, 107, 108, 109, 110, 111, 112
+);
+
+insert into role_permission (role_id, permission_id)
+select 3, id from permission where id in (
+  3, 4, 6, 7, 8, 9, 10, 11, 19, 27, 42, 57, 58, 59, 60, 63, 66, 67, 72, 73,
+  77, 78, 81, 82, 83, 85, 88, 91, 92, 94, 100, 106, 107, 110, 111, 112
+);
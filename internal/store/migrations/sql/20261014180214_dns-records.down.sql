@@ -0,0 +1,14 @@
+-- SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+--
+-- SPDX-License-Identifier: GPL-3.0-or-later
+
+delete from permission where (method, path) in
+  (
+    ('GET', '/v1/dns/records'),
+    ('POST', '/v1/dns/records'),
+    ('DELETE', '/v1/dns/records')
+  )
+;
+
+drop trigger if exists update_dns_record_timestamp;
+drop table dns_record;
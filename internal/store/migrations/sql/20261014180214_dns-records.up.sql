@@ -0,0 +1,87 @@
+-- SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+--
+-- SPDX-License-Identifier: GPL-3.0-or-later
+
+create table dns_record (
+  id         integer primary key,
+  name       text    not null,
+  type       text    not null,
+  value      text    not null,
+  ttl        integer default 0 not null,
+  ptr        integer default false not null,
+  created_at timestamp default current_timestamp not null,
+  updated_at timestamp default current_timestamp not null,
+  unique(name, type, value)
+);
+
+create index dns_record_name_idx on dns_record(name);
+create index dns_record_value_idx on dns_record(value);
+
+create trigger if not exists update_dns_record_timestamp after update on dns_record
+    begin
+        update dns_record set updated_at = current_timestamp where id = old.id;
+    end;
+
+insert into permission(method, path) values
+  ('GET', '/v1/dns/records'),
+  ('POST', '/v1/dns/records'),
+  ('DELETE', '/v1/dns/records')
+;
+
+insert into role_permission(role_id, permission_id)
+select role.id, permission.id
+from
+  (
+    select id
+    from role
+    where name = 'admin'
+  ) role,
+  (
+    select id
+    from permission
+    where (method, path) in
+      (
+        ('GET', '/v1/dns/records'),
+        ('POST', '/v1/dns/records'),
+        ('DELETE', '/v1/dns/records')
+      )
+  ) permission
+;
+
+insert into role_permission(role_id, permission_id)
+select role.id, permission.id
+from
+  (
+    select id
+    from role
+    where name = 'user'
+  ) role,
+  (
+    select id
+    from permission
+    where (method, path) in
+      (
+        ('GET', '/v1/dns/records'),
+        ('POST', '/v1/dns/records'),
+        ('DELETE', '/v1/dns/records')
+      )
+  ) permission
+;
+
+insert into role_permission(role_id, permission_id)
+select role.id, permission.id
+from
+  (
+    select id
+    from role
+    where name = 'read-only'
+  ) role,
+  (
+    select id
+    from permission
+    where (method, path) in
+      (
+        ('GET', '/v1/dns/records')
+      )
+  ) permission
+;
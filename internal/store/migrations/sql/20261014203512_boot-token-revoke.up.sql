@@ -0,0 +1,56 @@
+-- SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+--
+-- SPDX-License-Identifier: GPL-3.0-or-later
+
+create table revoked_token (
+  id         text    primary key not null,
+  host_uid   text    not null,
+  mac        text    not null,
+  expires    integer default 0 not null,
+  created_at timestamp default current_timestamp not null
+);
+
+create index revoked_token_expires_idx on revoked_token(expires);
+
+insert into permission(method, path) values
+  ('POST', '/v1/nodes/token/inspect'),
+  ('POST', '/v1/nodes/token/revoke')
+;
+
+insert into role_permission(role_id, permission_id)
+select role.id, permission.id
+from
+  (
+    select id
+    from role
+    where name = 'admin'
+  ) role,
+  (
+    select id
+    from permission
+    where (method, path) in
+      (
+        ('POST', '/v1/nodes/token/inspect'),
+        ('POST', '/v1/nodes/token/revoke')
+      )
+  ) permission
+;
+
+insert into role_permission(role_id, permission_id)
+select role.id, permission.id
+from
+  (
+    select id
+    from role
+    where name = 'user'
+  ) role,
+  (
+    select id
+    from permission
+    where (method, path) in
+      (
+        ('POST', '/v1/nodes/token/inspect'),
+        ('POST', '/v1/nodes/token/revoke')
+      )
+  ) permission
+;
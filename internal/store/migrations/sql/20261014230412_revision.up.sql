@@ -0,0 +1,103 @@
+-- SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+--
+-- SPDX-License-Identifier: GPL-3.0-or-later
+
+alter table node add column revision integer not null default 1;
+alter table kernel add column revision integer not null default 1;
+
+drop view node_view;
+
+create view node_view as
+select
+  n.id,
+  n.name,
+  n.uid,
+  json_object(
+    'id', n.id,
+    'uid', n.uid,
+    'revision', n.revision,
+    'name', n.name,
+    'provision', n.provision,
+    'boot_image', k.name,
+    'firmware', n.firmware,
+    'tags',
+      (select json_group_array(concat_ws(':',t.key,nt.value))
+       from node_tag as nt
+       join tag as t
+         on nt.tag_id = t.id
+       where nt.node_id = n.id
+      ),
+    'interfaces', (
+      select json_group_array(
+         json_object(
+           'id', nc.id,
+           'ifname', nc.name,
+           'fqdn', nc.fqdn,
+           'vlan', nc.vlan,
+           'mac', nc.mac,
+           'mtu', nc.mtu,
+           'bmc', iif(nc.nic_type == 'bmc', true, false),
+           'ip', nc.ip,
+           'switch', nc.switch,
+           'port', nc.port
+         ))
+       from nic as nc
+       where nc.node_id = n.id and nc.nic_type != 'bond'
+    ),
+    'bonds', (
+      select json_group_array(
+         json_object(
+           'id', nc.id,
+           'ifname', nc.name,
+           'fqdn', nc.fqdn,
+           'vlan', nc.vlan,
+           'mac', nc.mac,
+           'peers', json_extract(nc.peers, '$'),
+           'mtu', nc.mtu,
+           'bmc', iif(nc.nic_type == 'bmc', true, false),
+           'ip', nc.ip
+         ))
+       from nic as nc
+       where nc.node_id = n.id and nc.nic_type = 'bond'
+    )
+  ) as host_json
+from
+    node as n
+left join kernel as k
+on n.kernel_id = k.id
+;
+
+drop view kernel_view;
+
+create view kernel_view as
+select
+  k.id,
+  k.name,
+  json_object(
+    'id', k.id,
+    'uid', k.uid,
+    'revision', k.revision,
+    'name', k.name,
+    'kernel', k.path,
+    'cmdline', k.command_line,
+    'verify', iif(k.verify == 0, json('false'), json('true')),
+    'initrd', (
+      select json_group_array(
+         rd.path
+       )
+       from initrd as rd
+       where rd.kernel_id = k.id
+    ),
+    'provision_templates', (
+      select json_group_object(tt.uri_name, t.name)
+      from kernel_template as kt
+      join template t
+         on kt.template_id = t.id
+      join template_type tt
+         on t.template_type_id = tt.id
+      where kt.kernel_id = k.id
+    )
+  ) as image_json
+from
+    kernel as k
+;
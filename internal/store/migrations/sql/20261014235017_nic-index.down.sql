@@ -0,0 +1,42 @@
+-- SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+--
+-- SPDX-License-Identifier: GPL-3.0-or-later
+
+delete from role_permission where (role_id, permission_id) in
+(
+    select role.id, permission.id
+    from
+    (
+        select id
+        from role
+        where name = 'admin'
+    ) role,
+    (
+        select id
+        from permission
+        where (method, path) in
+        (
+            ('POST', '/v1/db/reindex')
+        )
+    ) permission
+)
+;
+
+delete from permission where id in
+(
+  select id
+  from permission
+  where (method, path) in
+  (
+    ('POST', '/v1/db/reindex')
+  )
+)
+;
+
+drop table store_index;
+drop trigger nic_fqdn_update;
+drop trigger nic_fqdn_insert;
+drop index nic_fqdn_fqdn_idx;
+drop table nic_fqdn;
+drop index nic_addr_idx;
+drop index nic_mac_idx;
@@ -0,0 +1,65 @@
+-- SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+--
+-- SPDX-License-Identifier: GPL-3.0-or-later
+
+create index nic_mac_idx on nic(mac);
+
+-- Matches the address part of the CIDR used for reverse lookups. Queries must
+-- use the same expression, including the cast, for sqlite to use it
+create index nic_addr_idx on nic(cast(substring(ip, 0, instr(ip, '/')) as text));
+
+-- One row per comma separated FQDN of a nic, lower case without the trailing
+-- dot. Kept up to date by the triggers below and rebuilt by the store when
+-- the index version changes
+create table nic_fqdn (
+  nic_id     integer not null,
+  fqdn       text    not null,
+  foreign key (nic_id) references nic(id) on delete cascade,
+  primary key (nic_id, fqdn)
+);
+
+create index nic_fqdn_fqdn_idx on nic_fqdn(fqdn);
+
+create trigger nic_fqdn_insert after insert on nic
+begin
+  insert or ignore into nic_fqdn (nic_id, fqdn)
+  select new.id, lower(rtrim(trim(j.value), '.'))
+  from json_each('[' || replace(json_quote(coalesce(new.fqdn, '')), ',', '","') || ']') as j
+  where trim(j.value) != '';
+end;
+
+create trigger nic_fqdn_update after update of fqdn on nic
+begin
+  delete from nic_fqdn where nic_id = new.id;
+  insert or ignore into nic_fqdn (nic_id, fqdn)
+  select new.id, lower(rtrim(trim(j.value), '.'))
+  from json_each('[' || replace(json_quote(coalesce(new.fqdn, '')), ',', '","') || ']') as j
+  where trim(j.value) != '';
+end;
+
+create table store_index (
+  name       text    primary key,
+  version    integer not null
+);
+
+insert into permission(method, path) values
+  ('POST', '/v1/db/reindex')
+;
+
+insert into role_permission(role_id, permission_id)
+select role.id, permission.id
+from
+  (
+    select id
+    from role
+    where name = 'admin'
+  ) role,
+  (
+    select id
+    from permission
+    where (method, path) in
+      (
+        ('POST', '/v1/db/reindex')
+      )
+  ) permission
+;
@@ -0,0 +1,14 @@
+-- SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+--
+-- SPDX-License-Identifier: GPL-3.0-or-later
+
+delete from permission where (method, path) in
+  (
+    ('GET', '/v1/nodes/credentials'),
+    ('PUT', '/v1/nodes/credentials'),
+    ('DELETE', '/v1/nodes/credentials')
+  )
+;
+
+drop trigger if exists update_node_credential_timestamp;
+drop table node_credential;
@@ -0,0 +1,47 @@
+-- SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+--
+-- SPDX-License-Identifier: GPL-3.0-or-later
+
+-- Credentials of a node, such as the BMC username and password. secret is
+-- encrypted with the credentials key and never stored in plaintext
+create table node_credential (
+  id         integer primary key,
+  node_id    integer not null,
+  kind       text    not null,
+  secret     text    not null,
+  created_at timestamp default current_timestamp not null,
+  updated_at timestamp default current_timestamp not null,
+  foreign key (node_id) references node(id) on delete cascade,
+  unique(node_id, kind)
+);
+
+create trigger if not exists update_node_credential_timestamp after update on node_credential
+    begin
+        update node_credential set updated_at = current_timestamp where id = old.id;
+    end;
+
+insert into permission(method, path) values
+  ('GET', '/v1/nodes/credentials'),
+  ('PUT', '/v1/nodes/credentials'),
+  ('DELETE', '/v1/nodes/credentials')
+;
+
+insert into role_permission(role_id, permission_id)
+select role.id, permission.id
+from
+  (
+    select id
+    from role
+    where name = 'admin'
+  ) role,
+  (
+    select id
+    from permission
+    where (method, path) in
+      (
+        ('GET', '/v1/nodes/credentials'),
+        ('PUT', '/v1/nodes/credentials'),
+        ('DELETE', '/v1/nodes/credentials')
+      )
+  ) permission
+;
@@ -0,0 +1,14 @@
+-- SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+--
+-- SPDX-License-Identifier: GPL-3.0-or-later
+
+delete from permission where (method, path) in
+  (
+    ('GET', '/v1/nodes/trash'),
+    ('POST', '/v1/nodes/trash/restore'),
+    ('DELETE', '/v1/nodes/trash')
+  )
+;
+
+drop index if exists node_trash_deleted_at_idx;
+drop table node_trash;
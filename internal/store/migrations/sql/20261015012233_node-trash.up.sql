@@ -0,0 +1,42 @@
+-- SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+--
+-- SPDX-License-Identifier: GPL-3.0-or-later
+
+-- Deleted nodes are kept here until they are restored or purged. host_json is
+-- the node_view entry of the node and credentials_json its sealed
+-- credentials at the time it was deleted
+create table node_trash (
+  id               integer primary key,
+  name             text    not null unique,
+  host_json        text    not null,
+  credentials_json text    not null default '[]',
+  deleted_at       integer not null
+);
+
+create index node_trash_deleted_at_idx on node_trash(deleted_at);
+
+insert into permission(method, path) values
+  ('GET', '/v1/nodes/trash'),
+  ('POST', '/v1/nodes/trash/restore'),
+  ('DELETE', '/v1/nodes/trash')
+;
+
+insert into role_permission(role_id, permission_id)
+select role.id, permission.id
+from
+  (
+    select id
+    from role
+    where name = 'admin'
+  ) role,
+  (
+    select id
+    from permission
+    where (method, path) in
+      (
+        ('GET', '/v1/nodes/trash'),
+        ('POST', '/v1/nodes/trash/restore'),
+        ('DELETE', '/v1/nodes/trash')
+      )
+  ) permission
+;
@@ -0,0 +1,221 @@
+-- SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+--
+-- SPDX-License-Identifier: GPL-3.0-or-later
+
+create trigger if not exists update_node_timestamp after update on node
+    begin
+        update node set updated_at = current_timestamp where id = old.id;
+    end;
+
+create trigger if not exists update_kernel_timestamp after update on kernel
+    begin
+        update kernel set updated_at = current_timestamp where id = old.id;
+    end;
+
+-- Names of deleted nodes and images so sync clients can find out what was
+-- removed since they last checked. Entries are removed when an entry with the
+-- same name is added again and purged after tombstone_retention
+create table tombstone (
+  id         integer primary key,
+  kind       text    not null,
+  name       text    not null,
+  deleted_at integer not null default (cast(strftime('%s', 'now') as integer)),
+  unique(kind, name)
+);
+
+create index tombstone_deleted_at_idx on tombstone(deleted_at);
+
+create trigger node_tombstone_delete after delete on node
+    begin
+        insert or replace into tombstone (kind, name) values ('host', old.name);
+    end;
+
+create trigger node_tombstone_rename after update of name on node when old.name != new.name
+    begin
+        insert or replace into tombstone (kind, name) values ('host', old.name);
+        delete from tombstone where kind = 'host' and name = new.name;
+    end;
+
+create trigger node_tombstone_insert after insert on node
+    begin
+        delete from tombstone where kind = 'host' and name = new.name;
+    end;
+
+create trigger kernel_tombstone_delete after delete on kernel
+    begin
+        insert or replace into tombstone (kind, name) values ('image', old.name);
+    end;
+
+create trigger kernel_tombstone_rename after update of name on kernel when old.name != new.name
+    begin
+        insert or replace into tombstone (kind, name) values ('image', old.name);
+        delete from tombstone where kind = 'image' and name = new.name;
+    end;
+
+create trigger kernel_tombstone_insert after insert on kernel
+    begin
+        delete from tombstone where kind = 'image' and name = new.name;
+    end;
+
+drop view node_view;
+
+create view node_view as
+select
+  n.id,
+  n.name,
+  n.uid,
+  json_object(
+    'id', n.id,
+    'uid', n.uid,
+    'revision', n.revision,
+    'created_at', strftime('%Y-%m-%dT%H:%M:%SZ', n.created_at),
+    'updated_at', strftime('%Y-%m-%dT%H:%M:%SZ', n.updated_at),
+    'name', n.name,
+    'provision', n.provision,
+    'boot_image', k.name,
+    'firmware', n.firmware,
+    'tags',
+      (select json_group_array(concat_ws(':',t.key,nt.value))
+       from node_tag as nt
+       join tag as t
+         on nt.tag_id = t.id
+       where nt.node_id = n.id
+      ),
+    'interfaces', (
+      select json_group_array(
+         json_object(
+           'id', nc.id,
+           'ifname', nc.name,
+           'fqdn', nc.fqdn,
+           'vlan', nc.vlan,
+           'mac', nc.mac,
+           'mtu', nc.mtu,
+           'bmc', iif(nc.nic_type == 'bmc', true, false),
+           'ip', nc.ip,
+           'switch', nc.switch,
+           'port', nc.port
+         ))
+       from nic as nc
+       where nc.node_id = n.id and nc.nic_type != 'bond'
+    ),
+    'bonds', (
+      select json_group_array(
+         json_object(
+           'id', nc.id,
+           'ifname', nc.name,
+           'fqdn', nc.fqdn,
+           'vlan', nc.vlan,
+           'mac', nc.mac,
+           'peers', json_extract(nc.peers, '$'),
+           'mtu', nc.mtu,
+           'bmc', iif(nc.nic_type == 'bmc', true, false),
+           'ip', nc.ip
+         ))
+       from nic as nc
+       where nc.node_id = n.id and nc.nic_type = 'bond'
+    )
+  ) as host_json
+from
+    node as n
+left join kernel as k
+on n.kernel_id = k.id
+;
+
+drop view kernel_view;
+
+create view kernel_view as
+select
+  k.id,
+  k.name,
+  json_object(
+    'id', k.id,
+    'uid', k.uid,
+    'revision', k.revision,
+    'created_at', strftime('%Y-%m-%dT%H:%M:%SZ', k.created_at),
+    'updated_at', strftime('%Y-%m-%dT%H:%M:%SZ', k.updated_at),
+    'name', k.name,
+    'kernel', k.path,
+    'cmdline', k.command_line,
+    'verify', iif(k.verify == 0, json('false'), json('true')),
+    'initrd', (
+      select json_group_array(
+         rd.path
+       )
+       from initrd as rd
+       where rd.kernel_id = k.id
+    ),
+    'provision_templates', (
+      select json_group_object(tt.uri_name, t.name)
+      from kernel_template as kt
+      join template t
+         on kt.template_id = t.id
+      join template_type tt
+         on t.template_type_id = tt.id
+      where kt.kernel_id = k.id
+    )
+  ) as image_json
+from
+    kernel as k
+;
+
+insert into permission(method, path) values
+  ('GET', '/v1/nodes/deleted'),
+  ('GET', '/v1/images/deleted')
+;
+
+insert into role_permission(role_id, permission_id)
+select role.id, permission.id
+from
+  (
+    select id
+    from role
+    where name = 'admin'
+  ) role,
+  (
+    select id
+    from permission
+    where (method, path) in
+      (
+        ('GET', '/v1/nodes/deleted'),
+        ('GET', '/v1/images/deleted')
+      )
+  ) permission
+;
+
+insert into role_permission(role_id, permission_id)
+select role.id, permission.id
+from
+  (
+    select id
+    from role
+    where name = 'user'
+  ) role,
+  (
+    select id
+    from permission
+    where (method, path) in
+      (
+        ('GET', '/v1/nodes/deleted'),
+        ('GET', '/v1/images/deleted')
+      )
+  ) permission
+;
+
+insert into role_permission(role_id, permission_id)
+select role.id, permission.id
+from
+  (
+    select id
+    from role
+    where name = 'read-only'
+  ) role,
+  (
+    select id
+    from permission
+    where (method, path) in
+      (
+        ('GET', '/v1/nodes/deleted'),
+        ('GET', '/v1/images/deleted')
+      )
+  ) permission
+;
@@ -0,0 +1,117 @@
+-- SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+--
+-- SPDX-License-Identifier: GPL-3.0-or-later
+
+-- Secondary addresses of a nic as a JSON list of ip and fqdn objects. The
+-- primary address stays in the ip and fqdn columns
+alter table nic add column addresses text;
+
+-- One row per secondary address and comma separated FQDN of a nic. Addresses
+-- without an FQDN have an empty name. Kept up to date by the triggers below
+-- and rebuilt by the store when the index version changes
+create table nic_address (
+  nic_id     integer not null,
+  ip         text    not null,
+  addr       text    not null,
+  fqdn       text,
+  name       text    not null,
+  foreign key (nic_id) references nic(id) on delete cascade,
+  primary key (nic_id, addr, name)
+);
+
+create index nic_address_addr_idx on nic_address(addr);
+create index nic_address_name_idx on nic_address(name);
+
+create trigger nic_address_insert after insert on nic
+begin
+  insert or ignore into nic_address (nic_id, ip, addr, fqdn, name)
+  select new.id, json_extract(a.value, '$.ip'),
+         substring(json_extract(a.value, '$.ip'), 0, instr(json_extract(a.value, '$.ip'), '/')),
+         json_extract(a.value, '$.fqdn'), lower(rtrim(trim(j.value), '.'))
+  from json_each(coalesce(new.addresses, '[]')) as a,
+       json_each('[' || replace(json_quote(coalesce(json_extract(a.value, '$.fqdn'), '')), ',', '","') || ']') as j
+  where coalesce(json_extract(a.value, '$.ip'), '') != '';
+end;
+
+create trigger nic_address_update after update of addresses on nic
+begin
+  delete from nic_address where nic_id = new.id;
+  insert or ignore into nic_address (nic_id, ip, addr, fqdn, name)
+  select new.id, json_extract(a.value, '$.ip'),
+         substring(json_extract(a.value, '$.ip'), 0, instr(json_extract(a.value, '$.ip'), '/')),
+         json_extract(a.value, '$.fqdn'), lower(rtrim(trim(j.value), '.'))
+  from json_each(coalesce(new.addresses, '[]')) as a,
+       json_each('[' || replace(json_quote(coalesce(json_extract(a.value, '$.fqdn'), '')), ',', '","') || ']') as j
+  where coalesce(json_extract(a.value, '$.ip'), '') != '';
+end;
+
+drop view node_view;
+
+create view node_view as
+select
+  n.id,
+  n.name,
+  n.uid,
+  json_object(
+    'id', n.id,
+    'uid', n.uid,
+    'revision', n.revision,
+    'created_at', strftime('%Y-%m-%dT%H:%M:%SZ', n.created_at),
+    'updated_at', strftime('%Y-%m-%dT%H:%M:%SZ', n.updated_at),
+    'name', n.name,
+    'provision', n.provision,
+    'boot_image', k.name,
+    'firmware', n.firmware,
+    'tags',
+      (select json_group_array(concat_ws(':',t.key,nt.value))
+       from node_tag as nt
+       join tag as t
+         on nt.tag_id = t.id
+       where nt.node_id = n.id
+      ),
+    'interfaces', (
+      select json_group_array(
+         json_object(
+           'id', nc.id,
+           'ifname', nc.name,
+           'fqdn', nc.fqdn,
+           'vlan', cast(nc.vlan as integer),
+           'parent', nc.parent,
+           'mac', nc.mac,
+           'mtu', nc.mtu,
+           'bmc', iif(nc.nic_type == 'bmc', true, false),
+           'ip', nc.ip,
+           'switch', nc.switch,
+           'port', nc.port,
+           'addresses', json_extract(nc.addresses, '$')
+         ))
+       from nic as nc
+       where nc.node_id = n.id and nc.nic_type not in ('bond', 'bridge')
+    ),
+    'bonds', (
+      select json_group_array(
+         json_object(
+           'id', nc.id,
+           'ifname', nc.name,
+           'fqdn', nc.fqdn,
+           'vlan', cast(nc.vlan as integer),
+           'parent', nc.parent,
+           'mac', nc.mac,
+           'type', nc.nic_type,
+           'peers', json_extract(nc.peers, '$'),
+           'mode', nc.mode,
+           'options', json_extract(nc.options, '$'),
+           'mtu', nc.mtu,
+           'bmc', iif(nc.nic_type == 'bmc', true, false),
+           'ip', nc.ip,
+           'addresses', json_extract(nc.addresses, '$')
+         ))
+       from nic as nc
+       where nc.node_id = n.id and nc.nic_type in ('bond', 'bridge')
+    )
+  ) as host_json
+from
+    node as n
+left join kernel as k
+on n.kernel_id = k.id
+;
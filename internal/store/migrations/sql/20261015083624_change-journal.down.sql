@@ -0,0 +1,17 @@
+-- SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+--
+-- SPDX-License-Identifier: GPL-3.0-or-later
+
+delete from permission where method = 'GET' and path = '/v1/changes';
+
+drop trigger if exists dns_record_change_delete;
+drop trigger if exists dns_record_change_update;
+drop trigger if exists dns_record_change_insert;
+drop trigger if exists kernel_change_delete;
+drop trigger if exists kernel_change_update;
+drop trigger if exists kernel_change_insert;
+drop trigger if exists node_change_delete;
+drop trigger if exists node_change_update;
+drop trigger if exists node_change_insert;
+drop index if exists change_journal_changed_at_idx;
+drop table change_journal;
@@ -0,0 +1,110 @@
+-- SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+--
+-- SPDX-License-Identifier: GPL-3.0-or-later
+
+-- Journal of every change made to nodes, images and DNS records so external
+-- systems can follow changes by sequence number instead of diffing dumps.
+-- Entries are written by triggers in the transaction making the change and
+-- purged after change_retention. autoincrement guarantees sequence numbers
+-- are never reused, even after the newest entries are purged
+create table change_journal (
+  seq        integer primary key autoincrement,
+  changed_at integer not null default (cast(strftime('%s', 'now') as integer)),
+  kind       text    not null,
+  name       text    not null,
+  op         text    not null,
+  diff       text
+);
+
+create index change_journal_changed_at_idx on change_journal(changed_at);
+
+-- Every change to a node bumps its revision. The diff lists the changed
+-- columns, changes to tags and interfaces have no diff
+create trigger node_change_insert after insert on node
+    begin
+        insert into change_journal (kind, name, op) values ('host', new.name, 'create');
+    end;
+
+create trigger node_change_update after update of revision, inventory on node
+    begin
+        insert into change_journal (kind, name, op, diff)
+        select 'host', new.name, 'update', nullif(json_group_object(field, json_object('old', old_value, 'new', new_value)), '{}')
+        from (
+          select 'name' as field, old.name as old_value, new.name as new_value
+          union all select 'provision', iif(old.provision, 'true', 'false'), iif(new.provision, 'true', 'false')
+          union all select 'boot_image', (select name from kernel where id = old.kernel_id), (select name from kernel where id = new.kernel_id)
+          union all select 'firmware', old.firmware, new.firmware
+          union all select 'smbios_uuid', old.smbios_uuid, new.smbios_uuid
+        )
+        where old_value is not new_value;
+    end;
+
+create trigger node_change_delete after delete on node
+    begin
+        insert into change_journal (kind, name, op) values ('host', old.name, 'delete');
+    end;
+
+create trigger kernel_change_insert after insert on kernel
+    begin
+        insert into change_journal (kind, name, op) values ('image', new.name, 'create');
+    end;
+
+create trigger kernel_change_update after update of revision on kernel
+    begin
+        insert into change_journal (kind, name, op, diff)
+        select 'image', new.name, 'update', nullif(json_group_object(field, json_object('old', old_value, 'new', new_value)), '{}')
+        from (
+          select 'name' as field, old.name as old_value, new.name as new_value
+          union all select 'kernel', old.path, new.path
+          union all select 'cmdline', old.command_line, new.command_line
+          union all select 'verify', iif(old.verify, 'true', 'false'), iif(new.verify, 'true', 'false')
+        )
+        where old_value is not new_value;
+    end;
+
+create trigger kernel_change_delete after delete on kernel
+    begin
+        insert into change_journal (kind, name, op) values ('image', old.name, 'delete');
+    end;
+
+-- DNS records are named by name/type/value like the DataDumpDiff
+create trigger dns_record_change_insert after insert on dns_record
+    begin
+        insert into change_journal (kind, name, op) values ('dns_record', concat_ws('/', new.name, new.type, new.value), 'create');
+    end;
+
+create trigger dns_record_change_update after update of ttl, ptr on dns_record
+    when old.ttl is not new.ttl or old.ptr is not new.ptr
+    begin
+        insert into change_journal (kind, name, op, diff)
+        select 'dns_record', concat_ws('/', new.name, new.type, new.value), 'update', json_group_object(field, json_object('old', old_value, 'new', new_value))
+        from (
+          select 'ttl' as field, cast(old.ttl as text) as old_value, cast(new.ttl as text) as new_value
+          union all select 'ptr', iif(old.ptr, 'true', 'false'), iif(new.ptr, 'true', 'false')
+        )
+        where old_value is not new_value;
+    end;
+
+create trigger dns_record_change_delete after delete on dns_record
+    begin
+        insert into change_journal (kind, name, op) values ('dns_record', concat_ws('/', old.name, old.type, old.value), 'delete');
+    end;
+
+insert into permission(method, path) values
+  ('GET', '/v1/changes')
+;
+
+insert into role_permission(role_id, permission_id)
+select role.id, permission.id
+from
+  (
+    select id
+    from role
+    where name in ('admin', 'user', 'read-only')
+  ) role,
+  (
+    select id
+    from permission
+    where method = 'GET' and path = '/v1/changes'
+  ) permission
+;
@@ -0,0 +1,39 @@
+-- SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+--
+-- SPDX-License-Identifier: GPL-3.0-or-later
+
+insert into permission(method, path) values
+  ('GET', '/v1/bmc/vmedia'),
+  ('POST', '/v1/bmc/vmedia'),
+  ('DELETE', '/v1/bmc/vmedia')
+;
+
+insert into role_permission(role_id, permission_id)
+select role.id, permission.id
+from
+  (
+    select id
+    from role
+    where name in ('admin', 'user')
+  ) role,
+  (
+    select id
+    from permission
+    where method in ('POST', 'DELETE') and path = '/v1/bmc/vmedia'
+  ) permission
+;
+
+insert into role_permission(role_id, permission_id)
+select role.id, permission.id
+from
+  (
+    select id
+    from role
+    where name in ('admin', 'user', 'read-only')
+  ) role,
+  (
+    select id
+    from permission
+    where method = 'GET' and path = '/v1/bmc/vmedia'
+  ) permission
+;
@@ -0,0 +1,5 @@
+-- SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+--
+-- SPDX-License-Identifier: GPL-3.0-or-later
+
+delete from permission where path in ('/v1/bmc/bios', '/v1/bmc/bios/diff');
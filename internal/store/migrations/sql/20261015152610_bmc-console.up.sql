@@ -0,0 +1,23 @@
+-- SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+--
+-- SPDX-License-Identifier: GPL-3.0-or-later
+
+-- Console input controls the node, so read-only users are not allowed
+insert into permission(method, path) values
+  ('GET', '/v1/bmc/console')
+;
+
+insert into role_permission(role_id, permission_id)
+select role.id, permission.id
+from
+  (
+    select id
+    from role
+    where name in ('admin', 'user')
+  ) role,
+  (
+    select id
+    from permission
+    where method = 'GET' and path = '/v1/bmc/console'
+  ) permission
+;
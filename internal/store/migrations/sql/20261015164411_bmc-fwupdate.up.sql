@@ -0,0 +1,23 @@
+-- SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+--
+-- SPDX-License-Identifier: GPL-3.0-or-later
+
+insert into permission(method, path) values
+  ('POST', '/v1/bmc/fwupdate'),
+  ('POST', '/v1/bmc/fwupdate/image')
+;
+
+insert into role_permission(role_id, permission_id)
+select role.id, permission.id
+from
+  (
+    select id
+    from role
+    where name in ('admin', 'user')
+  ) role,
+  (
+    select id
+    from permission
+    where method = 'POST' and path in ('/v1/bmc/fwupdate', '/v1/bmc/fwupdate/image')
+  ) permission
+;
@@ -0,0 +1,7 @@
+-- SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+--
+-- SPDX-License-Identifier: GPL-3.0-or-later
+
+delete from permission where path in ('/v1/discover/bmc', '/v1/discover/bmc/adopt');
+
+drop table if exists pending_bmc;
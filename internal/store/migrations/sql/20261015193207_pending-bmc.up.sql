@@ -0,0 +1,54 @@
+-- SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+--
+-- SPDX-License-Identifier: GPL-3.0-or-later
+
+-- BMCs seen on DHCP from a MAC address not registered to any node, waiting
+-- to be adopted as the BMC interface of a node. Times are unix seconds
+create table pending_bmc (
+  mac          text    primary key,
+  vendor_class text    not null default '',
+  relay        text    not null default '',
+  server_ip    text    not null default '',
+  first_seen   integer not null,
+  last_seen    integer not null
+);
+
+insert into permission(method, path) values
+  ('GET', '/v1/discover/bmc'),
+  ('POST', '/v1/discover/bmc/adopt'),
+  ('DELETE', '/v1/discover/bmc')
+;
+
+insert into role_permission(role_id, permission_id)
+select role.id, permission.id
+from
+  (
+    select id
+    from role
+    where name in ('admin', 'user')
+  ) role,
+  (
+    select id
+    from permission
+    where (method, path) in
+      (
+        ('POST', '/v1/discover/bmc/adopt'),
+        ('DELETE', '/v1/discover/bmc')
+      )
+  ) permission
+;
+
+insert into role_permission(role_id, permission_id)
+select role.id, permission.id
+from
+  (
+    select id
+    from role
+    where name in ('admin', 'user', 'read-only')
+  ) role,
+  (
+    select id
+    from permission
+    where method = 'GET' and path = '/v1/discover/bmc'
+  ) permission
+;
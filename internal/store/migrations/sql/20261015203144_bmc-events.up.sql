@@ -0,0 +1,57 @@
+-- SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+--
+-- SPDX-License-Identifier: GPL-3.0-or-later
+
+-- Log of a node, such as the critical events pushed by its BMC. logged_at is
+-- in unix seconds
+create table node_log (
+  id         integer primary key,
+  node_id    integer not null,
+  logged_at  integer not null,
+  severity   text    not null,
+  message_id text    not null default '',
+  message    text    not null,
+  foreign key (node_id) references node(id) on delete cascade
+);
+
+create index node_log_node_id_idx on node_log(node_id, logged_at);
+
+insert into permission(method, path) values
+  ('GET', '/v1/nodes/log'),
+  ('GET', '/v1/bmc/subscriptions'),
+  ('POST', '/v1/bmc/subscriptions')
+;
+
+insert into role_permission(role_id, permission_id)
+select role.id, permission.id
+from
+  (
+    select id
+    from role
+    where name in ('admin', 'user')
+  ) role,
+  (
+    select id
+    from permission
+    where method = 'POST' and path = '/v1/bmc/subscriptions'
+  ) permission
+;
+
+insert into role_permission(role_id, permission_id)
+select role.id, permission.id
+from
+  (
+    select id
+    from role
+    where name in ('admin', 'user', 'read-only')
+  ) role,
+  (
+    select id
+    from permission
+    where (method, path) in
+      (
+        ('GET', '/v1/nodes/log'),
+        ('GET', '/v1/bmc/subscriptions')
+      )
+  ) permission
+;
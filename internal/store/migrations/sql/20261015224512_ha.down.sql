@@ -0,0 +1,7 @@
+-- SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+--
+-- SPDX-License-Identifier: GPL-3.0-or-later
+
+delete from permission where method = 'GET' and path = '/v1/grendel/ha';
+
+drop table ha_instance;
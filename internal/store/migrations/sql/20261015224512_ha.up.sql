@@ -0,0 +1,33 @@
+-- SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+--
+-- SPDX-License-Identifier: GPL-3.0-or-later
+
+-- Heartbeats of the grendel serve instances in high availability mode. Each
+-- instance updates its row every ha.heartbeat_interval. Times are unix
+-- milliseconds
+create table ha_instance (
+  name      text    primary key not null,
+  role      text    not null,
+  state     text    not null,
+  heartbeat integer not null,
+  changed   integer not null
+);
+
+insert into permission(method, path) values
+  ('GET', '/v1/grendel/ha')
+;
+
+insert into role_permission(role_id, permission_id)
+select role.id, permission.id
+from
+  (
+    select id
+    from role
+    where name in ('admin', 'user', 'read-only')
+  ) role,
+  (
+    select id
+    from permission
+    where method = 'GET' and path = '/v1/grendel/ha'
+  ) permission
+;
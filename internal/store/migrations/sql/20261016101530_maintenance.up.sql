@@ -0,0 +1,48 @@
+-- SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+--
+-- SPDX-License-Identifier: GPL-3.0-or-later
+
+-- Global maintenance mode pausing provisioning. The table holds at most one
+-- row. Times are unix milliseconds
+create table maintenance (
+  id         integer primary key not null check (id = 1),
+  enabled    integer not null,
+  reason     text    not null default '',
+  changed_by text    not null default '',
+  changed    integer not null
+);
+
+insert into permission(method, path) values
+  ('GET', '/v1/grendel/maintenance'),
+  ('PUT', '/v1/grendel/maintenance')
+;
+
+insert into role_permission(role_id, permission_id)
+select role.id, permission.id
+from
+  (
+    select id
+    from role
+    where name in ('admin', 'user', 'read-only')
+  ) role,
+  (
+    select id
+    from permission
+    where method = 'GET' and path = '/v1/grendel/maintenance'
+  ) permission
+;
+
+insert into role_permission(role_id, permission_id)
+select role.id, permission.id
+from
+  (
+    select id
+    from role
+    where name = 'admin'
+  ) role,
+  (
+    select id
+    from permission
+    where method = 'PUT' and path = '/v1/grendel/maintenance'
+  ) permission
+;
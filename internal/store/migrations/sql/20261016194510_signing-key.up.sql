@@ -0,0 +1,49 @@
+-- SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+--
+-- SPDX-License-Identifier: GPL-3.0-or-later
+
+-- Keys signing the boot and API tokens created by grendel secret rotate. The
+-- secret is sealed with the credentials key. Times are unix milliseconds,
+-- retire_at is 0 for the primary key of a kind
+create table signing_key (
+  id        text    primary key not null,
+  kind      text    not null,
+  secret    text    not null,
+  created   integer not null,
+  retire_at integer not null default 0
+);
+
+insert into permission(method, path) values
+  ('GET', '/v1/grendel/keys'),
+  ('POST', '/v1/grendel/keys/rotate')
+;
+
+insert into role_permission(role_id, permission_id)
+select role.id, permission.id
+from
+  (
+    select id
+    from role
+    where name in ('admin', 'user', 'read-only')
+  ) role,
+  (
+    select id
+    from permission
+    where method = 'GET' and path = '/v1/grendel/keys'
+  ) permission
+;
+
+insert into role_permission(role_id, permission_id)
+select role.id, permission.id
+from
+  (
+    select id
+    from role
+    where name = 'admin'
+  ) role,
+  (
+    select id
+    from permission
+    where method = 'POST' and path = '/v1/grendel/keys/rotate'
+  ) permission
+;
@@ -0,0 +1,50 @@
+-- SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+--
+-- SPDX-License-Identifier: GPL-3.0-or-later
+
+-- Addresses held back from node nextip: reservations made by nextip
+-- --reserve and addresses declined over DHCP as in use. Times are unix
+-- milliseconds
+create table ip_reservation (
+  ip       text    primary key not null,
+  kind     text    not null,
+  host     text    not null default '',
+  username text    not null default '',
+  created  integer not null,
+  expires  integer not null
+);
+
+insert into permission(method, path) values
+  ('GET', '/v1/nodes/reservations'),
+  ('POST', '/v1/nodes/nextip')
+;
+
+insert into role_permission(role_id, permission_id)
+select role.id, permission.id
+from
+  (
+    select id
+    from role
+    where name in ('admin', 'user', 'read-only')
+  ) role,
+  (
+    select id
+    from permission
+    where method = 'GET' and path = '/v1/nodes/reservations'
+  ) permission
+;
+
+insert into role_permission(role_id, permission_id)
+select role.id, permission.id
+from
+  (
+    select id
+    from role
+    where name in ('admin', 'user')
+  ) role,
+  (
+    select id
+    from permission
+    where method = 'POST' and path = '/v1/nodes/nextip'
+  ) permission
+;
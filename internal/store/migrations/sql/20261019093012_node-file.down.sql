@@ -0,0 +1,14 @@
+-- SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+--
+-- SPDX-License-Identifier: GPL-3.0-or-later
+
+delete from permission where (method, path) in
+  (
+    ('GET', '/v1/nodes/files'),
+    ('PUT', '/v1/nodes/files'),
+    ('DELETE', '/v1/nodes/files')
+  )
+;
+
+drop trigger if exists update_node_file_timestamp;
+drop table node_file;
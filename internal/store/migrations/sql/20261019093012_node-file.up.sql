@@ -0,0 +1,47 @@
+-- SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+--
+-- SPDX-License-Identifier: GPL-3.0-or-later
+
+-- Small files attached to a node, such as licenses, served to the node while
+-- provisioning. Files are deleted with their node
+create table node_file (
+  id         integer primary key,
+  node_id    integer not null,
+  name       text    not null,
+  data       blob    not null,
+  created_at timestamp default current_timestamp not null,
+  updated_at timestamp default current_timestamp not null,
+  foreign key (node_id) references node(id) on delete cascade,
+  unique(node_id, name)
+);
+
+create trigger if not exists update_node_file_timestamp after update on node_file
+    begin
+        update node_file set updated_at = current_timestamp where id = old.id;
+    end;
+
+insert into permission(method, path) values
+  ('GET', '/v1/nodes/files'),
+  ('PUT', '/v1/nodes/files'),
+  ('DELETE', '/v1/nodes/files')
+;
+
+insert into role_permission(role_id, permission_id)
+select role.id, permission.id
+from
+  (
+    select id
+    from role
+    where name in ('admin', 'user')
+  ) role,
+  (
+    select id
+    from permission
+    where (method, path) in
+      (
+        ('GET', '/v1/nodes/files'),
+        ('PUT', '/v1/nodes/files'),
+        ('DELETE', '/v1/nodes/files')
+      )
+  ) permission
+;
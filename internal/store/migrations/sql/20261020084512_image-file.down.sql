@@ -0,0 +1,15 @@
+-- SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+--
+-- SPDX-License-Identifier: GPL-3.0-or-later
+
+delete from permission where (method, path) in
+  (
+    ('GET', '/v1/images/du'),
+    ('POST', '/v1/images/gc')
+  )
+;
+
+drop trigger if exists image_file_initrd_delete;
+drop trigger if exists image_file_kernel_delete;
+drop trigger if exists image_file_kernel_update;
+drop table image_file;
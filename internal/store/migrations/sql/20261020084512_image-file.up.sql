@@ -0,0 +1,69 @@
+-- SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+--
+-- SPDX-License-Identifier: GPL-3.0-or-later
+
+-- Kernel and initrd paths of previous versions of boot images, recorded when
+-- an image is updated or deleted so image gc keeps the files of images which
+-- may be rolled back. Purged after image_retention
+create table image_file (
+  path        text    primary key,
+  image       text    not null,
+  released_at integer not null default (cast(strftime('%s', 'now') as integer))
+);
+
+create index image_file_released_at_idx on image_file(released_at);
+
+create trigger image_file_kernel_update after update of path on kernel
+    when old.path is not new.path
+    begin
+        insert into image_file (path, image) values (old.path, old.name)
+        on conflict (path) do update set image = excluded.image, released_at = excluded.released_at;
+    end;
+
+create trigger image_file_kernel_delete after delete on kernel
+    begin
+        insert into image_file (path, image) values (old.path, old.name)
+        on conflict (path) do update set image = excluded.image, released_at = excluded.released_at;
+    end;
+
+-- Initrds deleted with their image may no longer find the image name
+create trigger image_file_initrd_delete after delete on initrd
+    begin
+        insert into image_file (path, image) values (old.path, coalesce((select name from kernel where id = old.kernel_id), ''))
+        on conflict (path) do update set image = excluded.image, released_at = excluded.released_at;
+    end;
+
+insert into permission(method, path) values
+  ('GET', '/v1/images/du'),
+  ('POST', '/v1/images/gc')
+;
+
+insert into role_permission(role_id, permission_id)
+select role.id, permission.id
+from
+  (
+    select id
+    from role
+    where name in ('admin', 'user', 'read-only')
+  ) role,
+  (
+    select id
+    from permission
+    where method = 'GET' and path = '/v1/images/du'
+  ) permission
+;
+
+insert into role_permission(role_id, permission_id)
+select role.id, permission.id
+from
+  (
+    select id
+    from role
+    where name = 'admin'
+  ) role,
+  (
+    select id
+    from permission
+    where method = 'POST' and path = '/v1/images/gc'
+  ) permission
+;
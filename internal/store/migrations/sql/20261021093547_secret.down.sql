@@ -0,0 +1,16 @@
+-- SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+--
+-- SPDX-License-Identifier: GPL-3.0-or-later
+
+delete from permission where (method, path) in
+  (
+    ('GET', '/v1/secrets'),
+    ('PUT', '/v1/secrets/%'),
+    ('DELETE', '/v1/secrets/%')
+  )
+;
+
+drop trigger if exists secret_fetch_reset;
+drop table secret_fetch;
+drop trigger if exists update_secret_timestamp;
+drop table secret;
@@ -0,0 +1,60 @@
+-- SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+--
+-- SPDX-License-Identifier: GPL-3.0-or-later
+
+-- Named secrets read by provision templates. value is encrypted with the
+-- credentials key and never stored in plaintext
+create table secret (
+  name       text    primary key,
+  value      text    not null,
+  one_time   integer default false not null,
+  created_at timestamp default current_timestamp not null,
+  updated_at timestamp default current_timestamp not null
+);
+
+create trigger if not exists update_secret_timestamp after update on secret
+    begin
+        update secret set updated_at = current_timestamp where name = old.name;
+    end;
+
+-- Boot tokens a one-time secret was rendered for. Setting the secret again
+-- renders it once more for every token
+create table secret_fetch (
+  name       text not null,
+  token_id   text not null,
+  host       text not null,
+  fetched_at timestamp default current_timestamp not null,
+  primary key (name, token_id),
+  foreign key (name) references secret(name) on delete cascade
+);
+
+create trigger if not exists secret_fetch_reset after update of value on secret
+    begin
+        delete from secret_fetch where name = old.name;
+    end;
+
+insert into permission(method, path) values
+  ('GET', '/v1/secrets'),
+  ('PUT', '/v1/secrets/%'), -- :name
+  ('DELETE', '/v1/secrets/%') -- :name
+;
+
+insert into role_permission(role_id, permission_id)
+select role.id, permission.id
+from
+  (
+    select id
+    from role
+    where name = 'admin'
+  ) role,
+  (
+    select id
+    from permission
+    where (method, path) in
+      (
+        ('GET', '/v1/secrets'),
+        ('PUT', '/v1/secrets/%'),
+        ('DELETE', '/v1/secrets/%')
+      )
+  ) permission
+;
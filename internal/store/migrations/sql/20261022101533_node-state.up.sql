@@ -0,0 +1,122 @@
+-- SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+--
+-- SPDX-License-Identifier: GPL-3.0-or-later
+
+-- Lifecycle state of the node. The provision flag follows the state, nodes
+-- set to provision are ready-to-provision and the others are in production
+alter table node add column state text default 'new' not null;
+
+update node set state = iif(provision, 'ready-to-provision', 'production');
+
+drop view node_view;
+
+create view node_view as
+select
+  n.id,
+  n.name,
+  n.uid,
+  json_object(
+    'id', n.id,
+    'uid', n.uid,
+    'revision', n.revision,
+    'created_at', strftime('%Y-%m-%dT%H:%M:%SZ', n.created_at),
+    'updated_at', strftime('%Y-%m-%dT%H:%M:%SZ', n.updated_at),
+    'name', n.name,
+    'provision', n.provision,
+    'state', n.state,
+    'boot_image', k.name,
+    'firmware', n.firmware,
+    'smbios_uuid', n.smbios_uuid,
+    'inventory', json(n.inventory),
+    'hardware', json(n.hardware),
+    'client_cert_fingerprint', n.client_cert_fingerprint,
+    'client_cert_serial', n.client_cert_serial,
+    'tags',
+      (select json_group_array(concat_ws(':',t.key,nt.value))
+       from node_tag as nt
+       join tag as t
+         on nt.tag_id = t.id
+       where nt.node_id = n.id
+      ),
+    'interfaces', (
+      select json_group_array(
+         json_object(
+           'id', nc.id,
+           'ifname', nc.name,
+           'fqdn', nc.fqdn,
+           'vlan', cast(nc.vlan as integer),
+           'parent', nc.parent,
+           'mac', nc.mac,
+           'mtu', nc.mtu,
+           'bmc', iif(nc.nic_type == 'bmc', true, false),
+           'ip', nc.ip,
+           'switch', nc.switch,
+           'port', nc.port,
+           'addresses', json_extract(nc.addresses, '$')
+         ))
+       from nic as nc
+       where nc.node_id = n.id and nc.nic_type not in ('bond', 'bridge')
+    ),
+    'bonds', (
+      select json_group_array(
+         json_object(
+           'id', nc.id,
+           'ifname', nc.name,
+           'fqdn', nc.fqdn,
+           'vlan', cast(nc.vlan as integer),
+           'parent', nc.parent,
+           'mac', nc.mac,
+           'type', nc.nic_type,
+           'peers', json_extract(nc.peers, '$'),
+           'mode', nc.mode,
+           'options', json_extract(nc.options, '$'),
+           'mtu', nc.mtu,
+           'bmc', iif(nc.nic_type == 'bmc', true, false),
+           'ip', nc.ip,
+           'addresses', json_extract(nc.addresses, '$')
+         ))
+       from nic as nc
+       where nc.node_id = n.id and nc.nic_type in ('bond', 'bridge')
+    )
+  ) as host_json
+from
+    node as n
+left join kernel as k
+on n.kernel_id = k.id
+;
+
+drop trigger node_change_update;
+
+create trigger node_change_update after update of revision, inventory, hardware on node
+    begin
+        insert into change_journal (kind, name, op, diff)
+        select 'host', new.name, 'update', nullif(json_group_object(field, json_object('old', old_value, 'new', new_value)), '{}')
+        from (
+          select 'name' as field, old.name as old_value, new.name as new_value
+          union all select 'provision', iif(old.provision, 'true', 'false'), iif(new.provision, 'true', 'false')
+          union all select 'state', old.state, new.state
+          union all select 'boot_image', (select name from kernel where id = old.kernel_id), (select name from kernel where id = new.kernel_id)
+          union all select 'firmware', old.firmware, new.firmware
+          union all select 'smbios_uuid', old.smbios_uuid, new.smbios_uuid
+        )
+        where old_value is not new_value;
+    end;
+
+insert into permission(method, path) values
+  ('PATCH', '/v1/nodes/state')
+;
+
+insert into role_permission(role_id, permission_id)
+select role.id, permission.id
+from
+  (
+    select id
+    from role
+    where name in ('admin', 'user')
+  ) role,
+  (
+    select id
+    from permission
+    where method = 'PATCH' and path = '/v1/nodes/state'
+  ) permission
+;
@@ -0,0 +1,18 @@
+-- SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+--
+-- SPDX-License-Identifier: GPL-3.0-or-later
+
+delete from permission where (method, path) in
+  (
+    ('GET', '/v1/schedule'),
+    ('GET', '/v1/schedule/%'),
+    ('POST', '/v1/schedule'),
+    ('DELETE', '/v1/schedule/%')
+  )
+;
+
+drop trigger if exists scheduled_action_change_delete;
+drop trigger if exists scheduled_action_change_update;
+drop trigger if exists scheduled_action_change_insert;
+drop index if exists scheduled_action_status_run_at_idx;
+drop table scheduled_action;
@@ -0,0 +1,92 @@
+-- SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+--
+-- SPDX-License-Identifier: GPL-3.0-or-later
+
+-- Actions run by grendel serve on a nodeset at a later time. results is the
+-- JSON per host report of the run. Times are unix milliseconds, 0 when unset
+create table scheduled_action (
+  id          integer primary key autoincrement,
+  action      text    not null,
+  nodeset     text    not null,
+  arg         text    not null default '',
+  run_at      integer not null,
+  created_by  text    not null default '',
+  created_at  integer not null,
+  status      text    not null default 'pending',
+  started_at  integer not null default 0,
+  finished_at integer not null default 0,
+  results     text    not null default '[]',
+  error       text    not null default ''
+);
+
+create index scheduled_action_status_run_at_idx on scheduled_action(status, run_at);
+
+-- Scheduled actions are journaled by id so the audit log records who queued
+-- what and how each run ended
+create trigger scheduled_action_change_insert after insert on scheduled_action
+    begin
+        insert into change_journal (kind, name, op, diff)
+        values ('schedule', new.id, 'create', json_object(
+          'action', json_object('old', '', 'new', new.action),
+          'nodeset', json_object('old', '', 'new', new.nodeset),
+          'arg', json_object('old', '', 'new', new.arg),
+          'created_by', json_object('old', '', 'new', new.created_by)
+        ));
+    end;
+
+create trigger scheduled_action_change_update after update of status on scheduled_action
+    when old.status is not new.status
+    begin
+        insert into change_journal (kind, name, op, diff)
+        values ('schedule', new.id, 'update', json_object('status', json_object('old', old.status, 'new', new.status)));
+    end;
+
+create trigger scheduled_action_change_delete after delete on scheduled_action
+    begin
+        insert into change_journal (kind, name, op) values ('schedule', old.id, 'delete');
+    end;
+
+insert into permission(method, path) values
+  ('GET', '/v1/schedule'),
+  ('GET', '/v1/schedule/%'), -- :id
+  ('POST', '/v1/schedule'),
+  ('DELETE', '/v1/schedule/%') -- :id
+;
+
+insert into role_permission(role_id, permission_id)
+select role.id, permission.id
+from
+  (
+    select id
+    from role
+    where name in ('admin', 'user', 'read-only')
+  ) role,
+  (
+    select id
+    from permission
+    where (method, path) in
+      (
+        ('GET', '/v1/schedule'),
+        ('GET', '/v1/schedule/%')
+      )
+  ) permission
+;
+
+insert into role_permission(role_id, permission_id)
+select role.id, permission.id
+from
+  (
+    select id
+    from role
+    where name in ('admin', 'user')
+  ) role,
+  (
+    select id
+    from permission
+    where (method, path) in
+      (
+        ('POST', '/v1/schedule'),
+        ('DELETE', '/v1/schedule/%')
+      )
+  ) permission
+;
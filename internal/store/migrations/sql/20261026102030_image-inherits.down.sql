@@ -0,0 +1,42 @@
+-- SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+--
+-- SPDX-License-Identifier: GPL-3.0-or-later
+
+drop view kernel_view;
+
+alter table kernel drop column parent_id;
+
+create view kernel_view as
+select
+  k.id,
+  k.name,
+  json_object(
+    'id', k.id,
+    'uid', k.uid,
+    'revision', k.revision,
+    'created_at', strftime('%Y-%m-%dT%H:%M:%SZ', k.created_at),
+    'updated_at', strftime('%Y-%m-%dT%H:%M:%SZ', k.updated_at),
+    'name', k.name,
+    'kernel', k.path,
+    'cmdline', k.command_line,
+    'verify', iif(k.verify == 0, json('false'), json('true')),
+    'initrd', (
+      select json_group_array(
+         rd.path
+       )
+       from initrd as rd
+       where rd.kernel_id = k.id
+    ),
+    'provision_templates', (
+      select json_group_object(tt.uri_name, t.name)
+      from kernel_template as kt
+      join template t
+         on kt.template_id = t.id
+      join template_type tt
+         on t.template_type_id = tt.id
+      where kt.kernel_id = k.id
+    )
+  ) as image_json
+from
+    kernel as k
+;
@@ -0,0 +1,45 @@
+-- SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+--
+-- SPDX-License-Identifier: GPL-3.0-or-later
+
+-- Parent image a boot image inherits the unset fields from, resolved when the
+-- image is served. Images with children can not be deleted
+alter table kernel add column parent_id integer;
+
+drop view kernel_view;
+
+create view kernel_view as
+select
+  k.id,
+  k.name,
+  json_object(
+    'id', k.id,
+    'uid', k.uid,
+    'revision', k.revision,
+    'created_at', strftime('%Y-%m-%dT%H:%M:%SZ', k.created_at),
+    'updated_at', strftime('%Y-%m-%dT%H:%M:%SZ', k.updated_at),
+    'name', k.name,
+    'kernel', k.path,
+    'inherits', (select p.name from kernel as p where p.id = k.parent_id),
+    'cmdline', k.command_line,
+    'verify', iif(k.verify == 0, json('false'), json('true')),
+    'initrd', (
+      select json_group_array(
+         rd.path
+       )
+       from initrd as rd
+       where rd.kernel_id = k.id
+    ),
+    'provision_templates', (
+      select json_group_object(tt.uri_name, t.name)
+      from kernel_template as kt
+      join template t
+         on kt.template_id = t.id
+      join template_type tt
+         on t.template_type_id = tt.id
+      where kt.kernel_id = k.id
+    )
+  ) as image_json
+from
+    kernel as k
+;
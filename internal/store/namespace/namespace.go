@@ -0,0 +1,189 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+// Package namespace serves several clusters from one grendel instance. Each
+// namespace of the namespaces config has a database of its own, so the
+// hosts, boot images, templates and DNS records of a cluster may reuse the
+// names of another. The users, roles, signing keys, revocations, maintenance
+// mode and high availability heartbeats are shared by the namespaces and kept
+// in the database of the default namespace.
+//
+// Provision templates in the template directory of a namespace replace the
+// templates of the same name for its hosts.
+//
+// The API selects the namespace of a request with the X-Grendel-Namespace
+// header, limited to the namespaces of its token. The DHCP, PXE, TFTP and
+// provision services select it by the subnet of the client or relay agent,
+// and the DNS server by the zone of the query name. Requests matching no
+// namespace use the default one.
+package namespace
+
+import (
+	"fmt"
+	"net/netip"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/spf13/viper"
+)
+
+// Default is the namespace of the database of dbpath, used by requests
+// naming no namespace
+const Default = "default"
+
+var validName = regexp.MustCompile(`^[a-z0-9][a-z0-9_.-]*$`)
+
+// Config is a namespace of the namespaces config
+type Config struct {
+	Name string `mapstructure:"name"`
+
+	// DBPath is the database of the namespace, <name>.db next to dbpath
+	// when empty
+	DBPath string `mapstructure:"dbpath"`
+
+	// Subnets select the namespace of the DHCP, PXE, TFTP and provision
+	// requests from the addresses in them
+	Subnets []string `mapstructure:"subnets"`
+
+	// Zones select the namespace of the DNS queries of the names in them
+	Zones []string `mapstructure:"zones"`
+
+	// TemplateDir holds the provision templates of the namespace, replacing
+	// the templates of the same name. <name> in the template directory when
+	// empty
+	TemplateDir string `mapstructure:"template_dir"`
+}
+
+// Namespace is a parsed namespace
+type Namespace struct {
+	Name        string
+	DBPath      string
+	Subnets     []netip.Prefix
+	Zones       []string
+	TemplateDir string
+}
+
+// LoadConfig parses the namespaces config. The database of a namespace
+// defaults to <name>.db in the directory of dbpath, or an in-memory database
+// when dbpath is :memory:
+func LoadConfig(v *viper.Viper, dbpath string) ([]*Namespace, error) {
+	var configs []Config
+	if err := v.UnmarshalKey("namespaces", &configs); err != nil {
+		return nil, fmt.Errorf("failed parsing namespaces: %w", err)
+	}
+
+	namespaces := make([]*Namespace, 0, len(configs))
+	for _, c := range configs {
+		ns, err := parse(c, dbpath)
+		if err != nil {
+			return nil, fmt.Errorf("failed parsing namespaces: %w", err)
+		}
+		if err := conflicts(ns, namespaces, dbpath); err != nil {
+			return nil, fmt.Errorf("failed parsing namespaces: %w", err)
+		}
+		namespaces = append(namespaces, ns)
+	}
+
+	return namespaces, nil
+}
+
+func parse(c Config, dbpath string) (*Namespace, error) {
+	if !validName.MatchString(c.Name) {
+		return nil, fmt.Errorf("invalid name %q, use lowercase letters, digits, '_', '.' and '-'", c.Name)
+	}
+	if c.Name == Default {
+		return nil, fmt.Errorf("%s: the default namespace is the database of dbpath and cannot be redefined", c.Name)
+	}
+
+	ns := &Namespace{Name: c.Name, DBPath: c.DBPath, TemplateDir: c.TemplateDir}
+	if ns.DBPath == "" {
+		ns.DBPath = ":memory:"
+		if dbpath != ":memory:" {
+			ns.DBPath = filepath.Join(filepath.Dir(dbpath), c.Name+".db")
+		}
+	}
+
+	for _, s := range c.Subnets {
+		prefix, err := netip.ParsePrefix(s)
+		if err != nil {
+			return nil, fmt.Errorf("%s: invalid subnet %q: %w", c.Name, s, err)
+		}
+		ns.Subnets = append(ns.Subnets, prefix.Masked())
+	}
+
+	for _, z := range c.Zones {
+		zone := canonicalName(z)
+		if zone == "" {
+			return nil, fmt.Errorf("%s: empty zone", c.Name)
+		}
+		ns.Zones = append(ns.Zones, zone)
+	}
+
+	return ns, nil
+}
+
+// conflicts returns an error when ns shares its name, database, a subnet or
+// a zone with one of namespaces, a request would match both
+func conflicts(ns *Namespace, namespaces []*Namespace, dbpath string) error {
+	if ns.DBPath != ":memory:" && ns.DBPath == dbpath {
+		return fmt.Errorf("%s: dbpath %s is the database of the default namespace", ns.Name, ns.DBPath)
+	}
+
+	for _, o := range namespaces {
+		if o.Name == ns.Name {
+			return fmt.Errorf("%s: namespace defined twice", ns.Name)
+		}
+		if ns.DBPath != ":memory:" && o.DBPath == ns.DBPath {
+			return fmt.Errorf("%s: dbpath %s is the database of namespace %s", ns.Name, ns.DBPath, o.Name)
+		}
+		for _, s := range ns.Subnets {
+			for _, t := range o.Subnets {
+				if s.Overlaps(t) {
+					return fmt.Errorf("%s: subnet %s overlaps subnet %s of namespace %s", ns.Name, s, t, o.Name)
+				}
+			}
+		}
+		for _, z := range ns.Zones {
+			if slices.Contains(o.Zones, z) {
+				return fmt.Errorf("%s: zone %s is a zone of namespace %s", ns.Name, z, o.Name)
+			}
+		}
+	}
+
+	return nil
+}
+
+// canonicalName returns name in lowercase without the trailing dot
+func canonicalName(name string) string {
+	return strings.TrimSuffix(strings.ToLower(strings.TrimSpace(name)), ".")
+}
+
+// ContainsAddr returns whether addr is in a subnet of ns, with the length of
+// the longest prefix containing it
+func (ns *Namespace) ContainsAddr(addr netip.Addr) (int, bool) {
+	bits, ok := -1, false
+	for _, s := range ns.Subnets {
+		if s.Contains(addr.Unmap()) && s.Bits() > bits {
+			bits, ok = s.Bits(), true
+		}
+	}
+
+	return bits, ok
+}
+
+// ContainsName returns whether the DNS name is in a zone of ns, with the
+// length of the longest zone containing it
+func (ns *Namespace) ContainsName(name string) (int, bool) {
+	name = canonicalName(name)
+	length, ok := -1, false
+	for _, z := range ns.Zones {
+		if (name == z || strings.HasSuffix(name, "."+z)) && len(z) > length {
+			length, ok = len(z), true
+		}
+	}
+
+	return length, ok
+}
@@ -0,0 +1,115 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package namespace
+
+import (
+	"net/netip"
+	"testing"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/ubccr/grendel/internal/store"
+	"github.com/ubccr/grendel/internal/store/sqlstore"
+	"github.com/ubccr/grendel/pkg/model"
+)
+
+func loadConfig(t *testing.T, namespaces []map[string]any, dbpath string) ([]*Namespace, error) {
+	t.Helper()
+	v := viper.New()
+	v.Set("namespaces", namespaces)
+	return LoadConfig(v, dbpath)
+}
+
+func TestLoadConfig(t *testing.T) {
+	namespaces, err := loadConfig(t, []map[string]any{
+		{"name": "cluster-a", "subnets": []string{"10.1.0.0/16"}, "zones": []string{"A.example.com."}},
+		{"name": "cluster-b", "dbpath": "/srv/b.db", "subnets": []string{"10.2.0.1/16"}},
+	}, "/var/lib/grendel/grendel.db")
+	require.NoError(t, err)
+	require.Len(t, namespaces, 2)
+
+	assert.Equal(t, "/var/lib/grendel/cluster-a.db", namespaces[0].DBPath)
+	assert.Equal(t, []string{"a.example.com"}, namespaces[0].Zones)
+	assert.Equal(t, "/srv/b.db", namespaces[1].DBPath)
+	assert.Equal(t, []netip.Prefix{netip.MustParsePrefix("10.2.0.0/16")}, namespaces[1].Subnets)
+
+	namespaces, err = loadConfig(t, []map[string]any{{"name": "cluster-a"}}, ":memory:")
+	require.NoError(t, err)
+	assert.Equal(t, ":memory:", namespaces[0].DBPath)
+
+	for name, config := range map[string][]map[string]any{
+		"invalid name":    {{"name": "Cluster A"}},
+		"default":         {{"name": "default"}},
+		"invalid subnet":  {{"name": "a", "subnets": []string{"10.1.0.0"}}},
+		"defined twice":   {{"name": "a"}, {"name": "a"}},
+		"main database":   {{"name": "a", "dbpath": "/var/lib/grendel/grendel.db"}},
+		"shared database": {{"name": "a", "dbpath": "/srv/a.db"}, {"name": "b", "dbpath": "/srv/a.db"}},
+		"overlap":         {{"name": "a", "subnets": []string{"10.1.0.0/16"}}, {"name": "b", "subnets": []string{"10.1.2.0/24"}}},
+		"shared zone":     {{"name": "a", "zones": []string{"example.com"}}, {"name": "b", "zones": []string{"example.com."}}},
+	} {
+		_, err := loadConfig(t, config, "/var/lib/grendel/grendel.db")
+		assert.Error(t, err, name)
+	}
+}
+
+func TestRegistry(t *testing.T) {
+	def, err := sqlstore.New(":memory:")
+	require.NoError(t, err)
+	defer def.Close()
+	a, err := sqlstore.New(":memory:")
+	require.NoError(t, err)
+	b, err := sqlstore.New(":memory:")
+	require.NoError(t, err)
+
+	r := NewRegistry(def, []*Namespace{
+		{Name: "a", Subnets: []netip.Prefix{netip.MustParsePrefix("10.0.0.0/8")}, Zones: []string{"example.com"}},
+		{Name: "b", Subnets: []netip.Prefix{netip.MustParsePrefix("10.2.0.0/16")}, Zones: []string{"b.example.com"}},
+	}, map[string]store.Store{"a": a, "b": b})
+	defer r.Close()
+
+	assert.Equal(t, []string{"default", "a", "b"}, r.Names())
+
+	// The longest subnet and zone win
+	for addr, want := range map[string]string{"10.1.0.1": "a", "10.2.0.1": "b", "192.168.0.1": "default"} {
+		name, db := r.ForAddr(netip.MustParseAddr(addr))
+		assert.Equal(t, want, name, addr)
+		wantDB, _ := r.Get(want)
+		assert.Same(t, wantDB, db, addr)
+	}
+	for qname, want := range map[string]string{"cpn-01.example.com.": "a", "cpn-01.B.example.com.": "b", "b.example.com": "b", "example.org.": "default"} {
+		name, _ := r.ForName(qname)
+		assert.Equal(t, want, name, qname)
+	}
+
+	_, ok := r.Get("c")
+	assert.False(t, ok)
+
+	// Hosts may share names across namespaces
+	dbA, _ := r.Get("a")
+	dbB, _ := r.Get("b")
+	host := func(ip string) *model.Host {
+		return &model.Host{Name: "cpn-01", Interfaces: []*model.NetInterface{{IP: netip.MustParsePrefix(ip + "/16")}}}
+	}
+	require.NoError(t, dbA.StoreHost(host("10.1.0.1")))
+	require.NoError(t, dbB.StoreHost(host("10.2.0.1")))
+
+	hostA, err := dbA.LoadHostFromName("cpn-01")
+	require.NoError(t, err)
+	assert.Equal(t, "10.1.0.1", hostA.Interfaces[0].AddrString())
+	hosts, err := def.Hosts()
+	require.NoError(t, err)
+	assert.Empty(t, hosts)
+
+	// Users are shared with the default namespace
+	_, err = dbA.StoreUser("admin", "secret123")
+	require.NoError(t, err)
+	ok, _, err = def.VerifyUser("admin", "secret123")
+	require.NoError(t, err)
+	assert.True(t, ok)
+	users, err := b.GetUsers()
+	require.NoError(t, err)
+	assert.Empty(t, users)
+}
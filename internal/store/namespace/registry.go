@@ -0,0 +1,145 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package namespace
+
+import (
+	"errors"
+	"net/netip"
+
+	"github.com/ubccr/grendel/internal/store"
+)
+
+// Registry holds the data stores of the namespaces
+type Registry struct {
+	def        store.Store
+	namespaces []*Namespace
+	stores     map[string]store.Store
+
+	// raw are the stores of the namespaces without the shared data of the
+	// default namespace, closed by Close
+	raw map[string]store.Store
+}
+
+// NewRegistry returns a registry of the namespaces with the data stores in
+// stores by name, def is the store of the default namespace. The stores of
+// the namespaces read and write the shared data in def
+func NewRegistry(def store.Store, namespaces []*Namespace, stores map[string]store.Store) *Registry {
+	r := &Registry{
+		def:        def,
+		namespaces: namespaces,
+		stores:     make(map[string]store.Store, len(namespaces)),
+		raw:        stores,
+	}
+	for _, ns := range namespaces {
+		r.stores[ns.Name] = &Store{Store: stores[ns.Name], Name: ns.Name, Shared: def}
+	}
+
+	return r
+}
+
+// Names returns the names of the namespaces, the default namespace first
+func (r *Registry) Names() []string {
+	names := make([]string, 0, len(r.namespaces)+1)
+	names = append(names, Default)
+	for _, ns := range r.namespaces {
+		names = append(names, ns.Name)
+	}
+
+	return names
+}
+
+// Namespaces returns the namespaces, without the default namespace
+func (r *Registry) Namespaces() []*Namespace {
+	return r.namespaces
+}
+
+// Get returns the store of the namespace named name, the default namespace
+// when name is empty
+func (r *Registry) Get(name string) (store.Store, bool) {
+	if name == "" || name == Default {
+		return r.def, true
+	}
+
+	db, ok := r.stores[name]
+	return db, ok
+}
+
+// Stores returns the store of each namespace in the order of Names
+func (r *Registry) Stores() []store.Store {
+	stores := make([]store.Store, 0, len(r.namespaces)+1)
+	for _, name := range r.Names() {
+		db, _ := r.Get(name)
+		stores = append(stores, db)
+	}
+
+	return stores
+}
+
+// ForAddr returns the namespace with the longest subnet containing addr,
+// the default namespace when none does
+func (r *Registry) ForAddr(addr netip.Addr) (string, store.Store) {
+	name, bits := Default, -1
+	for _, ns := range r.namespaces {
+		if b, ok := ns.ContainsAddr(addr); ok && b > bits {
+			name, bits = ns.Name, b
+		}
+	}
+
+	db, _ := r.Get(name)
+	return name, db
+}
+
+// ForName returns the namespace with the longest zone containing the DNS
+// name, the default namespace when none does
+func (r *Registry) ForName(name string) (string, store.Store) {
+	match, length := Default, -1
+	for _, ns := range r.namespaces {
+		if l, ok := ns.ContainsName(name); ok && l > length {
+			match, length = ns.Name, l
+		}
+	}
+
+	db, _ := r.Get(match)
+	return match, db
+}
+
+// Generation returns the sum of the generations of the stores counting their
+// writes, false when a store does not count them
+func (r *Registry) Generation() (uint64, bool) {
+	type generationer interface {
+		Generation() uint64
+	}
+
+	var sum uint64
+	for _, db := range append([]store.Store{r.def}, r.rawStores()...) {
+		gen, ok := db.(generationer)
+		if !ok {
+			return 0, false
+		}
+		sum += gen.Generation()
+	}
+
+	return sum, true
+}
+
+func (r *Registry) rawStores() []store.Store {
+	stores := make([]store.Store, 0, len(r.namespaces))
+	for _, ns := range r.namespaces {
+		stores = append(stores, r.raw[ns.Name])
+	}
+
+	return stores
+}
+
+// Close closes the stores of the namespaces, the store of the default
+// namespace is left open
+func (r *Registry) Close() error {
+	var errs []error
+	for _, db := range r.rawStores() {
+		errs = append(errs, db.Close())
+	}
+
+	return errors.Join(errs...)
+}
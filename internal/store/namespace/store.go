@@ -0,0 +1,141 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package namespace
+
+import (
+	"context"
+	"time"
+
+	"github.com/ubccr/grendel/internal/store"
+	"github.com/ubccr/grendel/pkg/model"
+)
+
+// Store is the store.Store of a namespace, passing the users, roles, signing
+// keys, revocations, maintenance mode and high availability heartbeats to
+// the store of the default namespace
+type Store struct {
+	store.Store
+
+	// Name is the name of the namespace
+	Name string
+
+	// Shared is the store of the default namespace
+	Shared store.Store
+}
+
+// Of returns the name of the namespace of db, empty for the default
+// namespace
+func Of(db store.Store) string {
+	if s, ok := db.(*Store); ok {
+		return s.Name
+	}
+
+	return ""
+}
+
+// WithContext returns the store of the namespace with ctx
+func (s *Store) WithContext(ctx context.Context) store.Store {
+	return &Store{Store: s.Store.WithContext(ctx), Name: s.Name, Shared: s.Shared.WithContext(ctx)}
+}
+
+func (s *Store) StoreUser(username, password string) (string, error) {
+	return s.Shared.StoreUser(username, password)
+}
+
+func (s *Store) VerifyUser(username, password string) (bool, string, error) {
+	return s.Shared.VerifyUser(username, password)
+}
+
+func (s *Store) GetUsers() ([]model.User, error) {
+	return s.Shared.GetUsers()
+}
+
+func (s *Store) GetUserByName(name string) (*model.User, error) {
+	return s.Shared.GetUserByName(name)
+}
+
+func (s *Store) UpdateUserRole(username, role string) error {
+	return s.Shared.UpdateUserRole(username, role)
+}
+
+func (s *Store) UpdateUserEnabled(username string, enabled bool) error {
+	return s.Shared.UpdateUserEnabled(username, enabled)
+}
+
+func (s *Store) DeleteUser(username string) error {
+	return s.Shared.DeleteUser(username)
+}
+
+func (s *Store) RevokeBootToken(info *model.BootTokenInfo) error {
+	return s.Shared.RevokeBootToken(info)
+}
+
+func (s *Store) BootTokenRevoked(id string) (bool, error) {
+	return s.Shared.BootTokenRevoked(id)
+}
+
+func (s *Store) RevokeCerts(revoked model.RevokedCertList) (int, error) {
+	return s.Shared.RevokeCerts(revoked)
+}
+
+func (s *Store) RevokedCerts() (model.RevokedCertList, error) {
+	return s.Shared.RevokedCerts()
+}
+
+func (s *Store) StoreHAInstance(instance *model.HAInstance) error {
+	return s.Shared.StoreHAInstance(instance)
+}
+
+func (s *Store) HAInstances() (model.HAInstanceList, error) {
+	return s.Shared.HAInstances()
+}
+
+func (s *Store) StoreMaintenance(m *model.Maintenance) error {
+	return s.Shared.StoreMaintenance(m)
+}
+
+func (s *Store) LoadMaintenance() (*model.Maintenance, error) {
+	return s.Shared.LoadMaintenance()
+}
+
+func (s *Store) StoreSigningKeys(keys model.SigningKeyList) error {
+	return s.Shared.StoreSigningKeys(keys)
+}
+
+func (s *Store) SigningKeys() (model.SigningKeyList, error) {
+	return s.Shared.SigningKeys()
+}
+
+func (s *Store) PurgeSigningKeys(before time.Time) (int, error) {
+	return s.Shared.PurgeSigningKeys(before)
+}
+
+func (s *Store) GetRolesByRoute(method, path string) (*[]string, error) {
+	return s.Shared.GetRolesByRoute(method, path)
+}
+
+func (s *Store) GetRoles() (model.RoleViewList, error) {
+	return s.Shared.GetRoles()
+}
+
+func (s *Store) GetRolesByName(name string) (*model.RoleView, error) {
+	return s.Shared.GetRolesByName(name)
+}
+
+func (s *Store) GetPermissions() (model.PermissionList, error) {
+	return s.Shared.GetPermissions()
+}
+
+func (s *Store) AddRole(role, inheritedRole string) error {
+	return s.Shared.AddRole(role, inheritedRole)
+}
+
+func (s *Store) DeleteRole(roles []string) error {
+	return s.Shared.DeleteRole(roles)
+}
+
+func (s *Store) UpdateRolePermissions(role string, permissions model.PermissionList) error {
+	return s.Shared.UpdateRolePermissions(role, permissions)
+}
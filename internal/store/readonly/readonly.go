@@ -0,0 +1,279 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+// Package readonly implements a Grendel Store refusing writes, used by a
+// grendel serve instance with readonly set to serve DHCP, DNS and
+// provisioning from a data store shared with a primary instance without
+// changing it. Every write returns a *store.ReadOnlyError naming the primary.
+// The heartbeat of high availability mode is the only write passed through so
+// the instances sharing the data store keep seeing each other.
+package readonly
+
+import (
+	"context"
+	"time"
+
+	"github.com/ubccr/grendel/internal/firmware"
+	"github.com/ubccr/grendel/internal/store"
+	"github.com/ubccr/grendel/pkg/model"
+	"github.com/ubccr/grendel/pkg/nodeset"
+)
+
+// Store is a store.Store passing reads through to the underlying store and
+// refusing writes
+type Store struct {
+	store.Store
+
+	// Primary is the grendel instance accepting writes, shown in the errors
+	Primary string
+}
+
+// New returns a Store refusing the writes to db, pointing to primary
+func New(db store.Store, primary string) *Store {
+	return &Store{Store: db, Primary: primary}
+}
+
+// WithContext returns a read-only store over the underlying store with ctx
+func (s *Store) WithContext(ctx context.Context) store.Store {
+	return &Store{Store: s.Store.WithContext(ctx), Primary: s.Primary}
+}
+
+func (s *Store) refuse(op string) error {
+	return &store.ReadOnlyError{Op: op, Primary: s.Primary}
+}
+
+func (s *Store) StoreUser(username, password string) (string, error) {
+	return "", s.refuse("store user")
+}
+
+func (s *Store) UpdateUserRole(username, role string) error {
+	return s.refuse("update user role")
+}
+
+func (s *Store) UpdateUserEnabled(username string, enabled bool) error {
+	return s.refuse("update user")
+}
+
+func (s *Store) DeleteUser(username string) error {
+	return s.refuse("delete user")
+}
+
+func (s *Store) StoreBootImage(image *model.BootImage) error {
+	return s.refuse("store image")
+}
+
+func (s *Store) StoreBootImages(images model.BootImageList) error {
+	return s.refuse("store images")
+}
+
+func (s *Store) DeleteBootImages(names []string) error {
+	return s.refuse("delete images")
+}
+
+func (s *Store) SetBootImage(ns *nodeset.NodeSet, name string) error {
+	return s.refuse("set boot image")
+}
+
+func (s *Store) SetBootImageIfCurrent(ns *nodeset.NodeSet, name string, current ...string) error {
+	return s.refuse("set boot image")
+}
+
+func (s *Store) SetFirmware(ns *nodeset.NodeSet, fw firmware.Build) error {
+	return s.refuse("set firmware")
+}
+
+func (s *Store) ProvisionHosts(ns *nodeset.NodeSet, provision bool) error {
+	return s.refuse("set provision")
+}
+
+func (s *Store) SetHostsState(ns *nodeset.NodeSet, state string) error {
+	return s.refuse("set state")
+}
+
+func (s *Store) TagHosts(ns *nodeset.NodeSet, tags []string) error {
+	return s.refuse("tag hosts")
+}
+
+func (s *Store) UntagHosts(ns *nodeset.NodeSet, tags []string) error {
+	return s.refuse("untag hosts")
+}
+
+func (s *Store) StoreHost(host *model.Host) error {
+	return s.refuse("store host")
+}
+
+func (s *Store) StoreHosts(hosts model.HostList) error {
+	return s.refuse("store hosts")
+}
+
+func (s *Store) StoreHostInventory(name string, inv *model.Inventory) error {
+	return s.refuse("store inventory")
+}
+
+func (s *Store) StoreHostHardware(name string, hw *model.Hardware) error {
+	return s.refuse("store hardware")
+}
+
+func (s *Store) StoreHostClientCert(name, fingerprint, serial string) error {
+	return s.refuse("store client certificate")
+}
+
+func (s *Store) DeleteHosts(ns *nodeset.NodeSet) error {
+	return s.refuse("delete hosts")
+}
+
+func (s *Store) RestoreHosts(ns *nodeset.NodeSet) (int, error) {
+	return 0, s.refuse("restore hosts")
+}
+
+func (s *Store) PurgeTrash(before time.Time) (int, error) {
+	return 0, s.refuse("purge trash")
+}
+
+func (s *Store) StoreDNSRecords(records model.RecordList) error {
+	return s.refuse("store dns records")
+}
+
+func (s *Store) DeleteDNSRecords(names []string) error {
+	return s.refuse("delete dns records")
+}
+
+func (s *Store) StoreCredentials(creds model.CredentialList) error {
+	return s.refuse("store credentials")
+}
+
+func (s *Store) DeleteCredentials(ns *nodeset.NodeSet, kind string) (int, error) {
+	return 0, s.refuse("delete credentials")
+}
+
+func (s *Store) StoreSecret(secret *model.Secret) error {
+	return s.refuse("store secret")
+}
+
+func (s *Store) DeleteSecret(name string) error {
+	return s.refuse("delete secret")
+}
+
+// ClaimSecret is refused as the claim of a one-time secret can not be
+// recorded, so the secret is not rendered
+func (s *Store) ClaimSecret(name, tokenID, host string) (bool, error) {
+	return false, s.refuse("claim secret")
+}
+
+func (s *Store) PurgeSecretClaims(before time.Time) (int, error) {
+	return 0, s.refuse("purge secret claims")
+}
+
+func (s *Store) StoreHostFiles(files model.HostFileList) error {
+	return s.refuse("store host files")
+}
+
+func (s *Store) DeleteHostFiles(ns *nodeset.NodeSet, name string) (int, error) {
+	return 0, s.refuse("delete host files")
+}
+
+func (s *Store) StoreHostLog(entries model.HostLogList) error {
+	return s.refuse("store host log")
+}
+
+func (s *Store) StorePendingBMC(bmc *model.PendingBMC) error {
+	return s.refuse("store pending bmc")
+}
+
+func (s *Store) DeletePendingBMC(mac string) error {
+	return s.refuse("delete pending bmc")
+}
+
+func (s *Store) PurgeTombstones(before time.Time) (int, error) {
+	return 0, s.refuse("purge tombstones")
+}
+
+func (s *Store) PurgeImageFiles(before time.Time) (int, error) {
+	return 0, s.refuse("purge image files")
+}
+
+func (s *Store) PurgeChanges(before time.Time) (int, error) {
+	return 0, s.refuse("purge changes")
+}
+
+func (s *Store) RevokeBootToken(info *model.BootTokenInfo) error {
+	return s.refuse("revoke boot token")
+}
+
+func (s *Store) RevokeCerts(revoked model.RevokedCertList) (int, error) {
+	return 0, s.refuse("revoke certificates")
+}
+
+func (s *Store) StoreMaintenance(m *model.Maintenance) error {
+	return s.refuse("store maintenance mode")
+}
+
+func (s *Store) StoreSigningKeys(keys model.SigningKeyList) error {
+	return s.refuse("store signing keys")
+}
+
+func (s *Store) PurgeSigningKeys(before time.Time) (int, error) {
+	return 0, s.refuse("purge signing keys")
+}
+
+func (s *Store) ReserveIPs(reservations model.IPReservationList) error {
+	return s.refuse("reserve addresses")
+}
+
+func (s *Store) StoreIPReservations(reservations model.IPReservationList) error {
+	return s.refuse("store address reservations")
+}
+
+func (s *Store) DeleteIPReservations(ips []string) (int, error) {
+	return 0, s.refuse("delete address reservations")
+}
+
+func (s *Store) StoreScheduledAction(action *model.ScheduledAction) error {
+	return s.refuse("store scheduled action")
+}
+
+func (s *Store) CancelScheduledAction(id int64) error {
+	return s.refuse("cancel scheduled action")
+}
+
+func (s *Store) StartScheduledAction(id int64, status string) (bool, error) {
+	return false, s.refuse("start scheduled action")
+}
+
+func (s *Store) FinishScheduledAction(action *model.ScheduledAction) error {
+	return s.refuse("finish scheduled action")
+}
+
+func (s *Store) PurgeScheduledActions(before time.Time) (int, error) {
+	return 0, s.refuse("purge scheduled actions")
+}
+
+func (s *Store) RestoreFrom(data model.DataDump) error {
+	return s.refuse("restore")
+}
+
+// LoadFrom only compares data with the store on a dry run
+func (s *Store) LoadFrom(data model.DataDump, prune, dryRun bool) (*model.DataDumpDiff, error) {
+	if !dryRun {
+		return nil, s.refuse("load")
+	}
+
+	return s.Store.LoadFrom(data, prune, dryRun)
+}
+
+func (s *Store) Reindex() error {
+	return s.refuse("reindex")
+}
+
+func (s *Store) AddRole(role, inheritedRole string) error {
+	return s.refuse("add role")
+}
+
+func (s *Store) DeleteRole(roles []string) error {
+	return s.refuse("delete role")
+}
+
+func (s *Store) UpdateRolePermissions(role string, permissions model.PermissionList) error {
+	return s.refuse("update role permissions")
+}
@@ -0,0 +1,106 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package readonly
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/ubccr/grendel/internal/store"
+	"github.com/ubccr/grendel/internal/store/sqlstore"
+	"github.com/ubccr/grendel/internal/tests"
+	"github.com/ubccr/grendel/pkg/model"
+)
+
+// writePrefixes are the prefixes of the names of the methods of store.Store
+// writing to the data store
+var writePrefixes = []string{
+	"Add", "Cancel", "Claim", "Delete", "Finish", "Load", "Provision", "Purge",
+	"Reindex", "Reserve", "Restore", "Revoke", "Set", "Start", "Store", "Tag",
+	"Untag", "Update",
+}
+
+func TestWritesRefused(t *testing.T) {
+	// The underlying store is nil, a write passed through panics
+	ro := New(nil, "https://grendel1.example.com")
+
+	iface := reflect.TypeOf((*store.Store)(nil)).Elem()
+	value := reflect.ValueOf(ro)
+	for i := range iface.NumMethod() {
+		m := iface.Method(i)
+		if !isWrite(m.Name) {
+			continue
+		}
+
+		args := make([]reflect.Value, m.Type.NumIn())
+		for j := range args {
+			args[j] = reflect.Zero(m.Type.In(j))
+		}
+
+		var out []reflect.Value
+		call := value.MethodByName(m.Name).Call
+		if m.Type.IsVariadic() {
+			call = value.MethodByName(m.Name).CallSlice
+		}
+		assert.NotPanics(t, func() { out = call(args) }, m.Name)
+		if len(out) == 0 {
+			continue
+		}
+
+		err, _ := out[len(out)-1].Interface().(error)
+		assert.ErrorIs(t, err, store.ErrReadOnly, m.Name)
+
+		var roErr *store.ReadOnlyError
+		if assert.True(t, errors.As(err, &roErr), m.Name) {
+			assert.Equal(t, "https://grendel1.example.com", roErr.Primary)
+		}
+	}
+}
+
+// isWrite returns whether the method of store.Store name writes, except for
+// the heartbeat written in read-only mode
+func isWrite(name string) bool {
+	switch {
+	case name == "StoreHAInstance" || name == "RevokedCerts":
+		return false
+	case strings.HasPrefix(name, "Load"):
+		return name == "LoadFrom"
+	}
+
+	for _, p := range writePrefixes {
+		if strings.HasPrefix(name, p) {
+			return true
+		}
+	}
+	return false
+}
+
+func TestReads(t *testing.T) {
+	db, err := sqlstore.New(":memory:")
+	require.NoError(t, err)
+	defer db.Close()
+
+	host := tests.HostFactory.MustCreate().(*model.Host)
+	require.NoError(t, db.StoreHost(host))
+
+	ro := New(db, "").WithContext(context.Background())
+	found, err := ro.LoadHostFromName(host.Name)
+	require.NoError(t, err)
+	assert.Equal(t, host.UID, found.UID)
+
+	err = ro.StoreHost(found)
+	assert.EqualError(t, err, "store host: datastore is read-only")
+
+	_, err = ro.LoadFrom(model.DataDump{Hosts: model.HostList{host}}, false, true)
+	assert.NoError(t, err)
+
+	// The heartbeat of high availability mode is written
+	assert.NoError(t, ro.StoreHAInstance(&model.HAInstance{Name: "grendel2", Role: model.HARoleSecondary}))
+}
@@ -0,0 +1,209 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package sqlstore
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"net"
+	"net/netip"
+	"strings"
+)
+
+const (
+	// ProblemCorrupt is a page or index of the database file failing the
+	// sqlite integrity check. It can not be repaired by Check
+	ProblemCorrupt = "corrupt"
+
+	// ProblemOrphan is a row referencing a deleted row, such as the tags of a
+	// deleted host
+	ProblemOrphan = "orphan"
+
+	// ProblemIndex is a stale or missing entry of the FQDN and address
+	// indexes of network interfaces
+	ProblemIndex = "index"
+
+	// ProblemInvalid is a host with a MAC or IP address that can not be
+	// parsed. It can not be repaired by Check
+	ProblemInvalid = "invalid"
+)
+
+// Problem is an inconsistency in the database found by Check
+type Problem struct {
+	Kind     string `json:"kind"`
+	Name     string `json:"name"`
+	Detail   string `json:"detail"`
+	Repaired bool   `json:"repaired"`
+}
+
+// Check scans the database for corruption, orphaned rows, stale index
+// entries and hosts with unparseable MAC or IP addresses. If repair is true
+// orphaned rows are deleted and the indexes are rebuilt, the other problems
+// are only reported.
+func (s *SqlStore) Check(repair bool) ([]Problem, error) {
+	ctx := s.context()
+	problems := make([]Problem, 0)
+
+	corrupt, err := s.checkIntegrity(ctx)
+	if err != nil {
+		return nil, err
+	}
+	problems = append(problems, corrupt...)
+	if len(corrupt) > 0 {
+		// Nothing else can be trusted or repaired in a corrupt database
+		return problems, nil
+	}
+
+	orphans, err := s.checkOrphans(ctx, repair)
+	if err != nil {
+		return nil, err
+	}
+	problems = append(problems, orphans...)
+
+	fqdn, err := s.q.CheckNicFQDNIndex(ctx, s.rw)
+	if err != nil {
+		return nil, err
+	}
+	addrs, err := s.q.CheckNicAddressIndex(ctx, s.rw)
+	if err != nil {
+		return nil, err
+	}
+	indexes := make([]Problem, 0)
+	for _, idx := range []struct {
+		name           string
+		stale, missing int64
+	}{
+		{"nic_fqdn", fqdn.Stale, fqdn.Missing},
+		{"nic_address", addrs.Stale, addrs.Missing},
+	} {
+		if idx.stale == 0 && idx.missing == 0 {
+			continue
+		}
+		indexes = append(indexes, Problem{
+			Kind:   ProblemIndex,
+			Name:   idx.name,
+			Detail: fmt.Sprintf("%d stale and %d missing entries", idx.stale, idx.missing),
+		})
+	}
+	if len(indexes) > 0 && repair {
+		if err := s.Reindex(); err != nil {
+			return nil, err
+		}
+		for i := range indexes {
+			indexes[i].Repaired = true
+		}
+	}
+	problems = append(problems, indexes...)
+
+	nics, err := s.q.CheckNics(ctx, s.rw)
+	if err != nil {
+		return nil, err
+	}
+	for _, nic := range nics {
+		name := nic.NodeName
+		if nic.Name.Valid && nic.Name.String != "" {
+			name += ":" + nic.Name.String
+		}
+		if nic.MAC.Valid && nic.MAC.String != "" {
+			if _, err := net.ParseMAC(nic.MAC.String); err != nil {
+				problems = append(problems, Problem{Kind: ProblemInvalid, Name: name, Detail: fmt.Sprintf("invalid mac address %q", nic.MAC.String)})
+			}
+		}
+		if nic.IP.Valid && nic.IP.String != "" {
+			if _, err := netip.ParsePrefix(nic.IP.String); err != nil {
+				problems = append(problems, Problem{Kind: ProblemInvalid, Name: name, Detail: fmt.Sprintf("invalid ip address %q", nic.IP.String)})
+			}
+		}
+	}
+
+	return problems, nil
+}
+
+// checkIntegrity returns the problems reported by the sqlite integrity check.
+// Postgres has no equivalent check, its corruption is reported by its server
+func (s *SqlStore) checkIntegrity(ctx context.Context) ([]Problem, error) {
+	if s.postgres {
+		return nil, nil
+	}
+
+	rows, err := s.rw.QueryContext(ctx, "pragma integrity_check")
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	problems := make([]Problem, 0)
+	for rows.Next() {
+		var msg string
+		if err := rows.Scan(&msg); err != nil {
+			return nil, err
+		}
+		if msg == "ok" {
+			continue
+		}
+		problems = append(problems, Problem{Kind: ProblemCorrupt, Name: "database", Detail: msg})
+	}
+
+	return problems, rows.Err()
+}
+
+// checkOrphans returns the rows failing a foreign key constraint. If repair
+// is true the rows are deleted. Postgres always enforces the constraints
+func (s *SqlStore) checkOrphans(ctx context.Context, repair bool) ([]Problem, error) {
+	if s.postgres {
+		return nil, nil
+	}
+
+	type orphan struct {
+		table  string
+		rowid  sql.NullInt64
+		parent string
+	}
+
+	rows, err := s.rw.QueryContext(ctx, "pragma foreign_key_check")
+	if err != nil {
+		return nil, err
+	}
+	orphans := make([]orphan, 0)
+	for rows.Next() {
+		var o orphan
+		var fkid int64
+		if err := rows.Scan(&o.table, &o.rowid, &o.parent, &fkid); err != nil {
+			rows.Close()
+			return nil, err
+		}
+		orphans = append(orphans, o)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	tx, err := s.rw.BeginTx(s.context(), nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	problems := make([]Problem, 0, len(orphans))
+	for _, o := range orphans {
+		p := Problem{
+			Kind:   ProblemOrphan,
+			Name:   fmt.Sprintf("%s:%d", o.table, o.rowid.Int64),
+			Detail: fmt.Sprintf("references missing row in %s", o.parent),
+		}
+		if repair && o.rowid.Valid {
+			query := fmt.Sprintf(`delete from "%s" where rowid = ?`, strings.ReplaceAll(o.table, `"`, `""`))
+			if _, err := tx.ExecContext(ctx, query, o.rowid.Int64); err != nil {
+				return nil, err
+			}
+			p.Repaired = true
+		}
+		problems = append(problems, p)
+	}
+
+	return problems, tx.Commit()
+}
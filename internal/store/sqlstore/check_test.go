@@ -0,0 +1,59 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package sqlstore
+
+import (
+	"testing"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/ubccr/grendel/internal/store"
+	"github.com/ubccr/grendel/internal/tests"
+	"github.com/ubccr/grendel/pkg/model"
+)
+
+func TestCheck(t *testing.T) {
+	store.Log.Logger.SetLevel(logrus.ErrorLevel)
+
+	db, err := New(":memory:")
+	require.NoError(t, err)
+	defer db.Close()
+
+	host := tests.HostFactory.MustCreate().(*model.Host)
+	host.Tags = []string{"ib"}
+	require.NoError(t, db.StoreHost(host))
+
+	problems, err := db.Check(false)
+	require.NoError(t, err)
+	assert.Empty(t, problems)
+
+	// Orphaned tag, stale index and a MAC address edited outside of Grendel
+	for _, q := range []string{
+		"pragma foreign_keys = off",
+		"insert into node_tag (tag_id, node_id, value) select id, 9999, '' from tag",
+		"pragma foreign_keys = on",
+		"delete from nic_fqdn",
+		"update nic set mac = 'bogus' where id = (select min(id) from nic)",
+	} {
+		_, err := db.rw.Exec(q)
+		require.NoError(t, err, q)
+	}
+
+	problems, err = db.Check(true)
+	require.NoError(t, err)
+	kinds := make(map[string]bool)
+	for _, p := range problems {
+		kinds[p.Kind] = true
+		assert.Equal(t, p.Kind != ProblemInvalid, p.Repaired, p)
+	}
+	assert.Equal(t, map[string]bool{ProblemOrphan: true, ProblemIndex: true, ProblemInvalid: true}, kinds)
+
+	problems, err = db.Check(false)
+	require.NoError(t, err)
+	if assert.Len(t, problems, 1) {
+		assert.Equal(t, ProblemInvalid, problems[0].Kind)
+	}
+}
@@ -0,0 +1,75 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package sqlstore
+
+import (
+	"testing"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/ubccr/grendel/internal/store"
+	"github.com/ubccr/grendel/internal/tests"
+	"github.com/ubccr/grendel/pkg/model"
+)
+
+func TestStoreHostClientCert(t *testing.T) {
+	store.Log.Logger.SetLevel(logrus.ErrorLevel)
+
+	db, err := New(":memory:")
+	require.NoError(t, err)
+	defer db.Close()
+
+	host := tests.HostFactory.MustCreate().(*model.Host)
+	require.NoError(t, db.StoreHost(host))
+
+	assert.ErrorIs(t, db.StoreHostClientCert("does-not-exist", "ab12", "1f"), store.ErrNotFound)
+	require.NoError(t, db.StoreHostClientCert(host.Name, "ab12", "1f"))
+
+	res, err := db.LoadHostFromName(host.Name)
+	require.NoError(t, err)
+	assert.Equal(t, "ab12", res.ClientCertFingerprint)
+	assert.Equal(t, "1f", res.ClientCertSerial)
+
+	// Updating the host keeps the fingerprint
+	res.Provision = !res.Provision
+	res.ClientCertFingerprint = ""
+	res.ClientCertSerial = ""
+	require.NoError(t, db.StoreHost(res))
+
+	res, err = db.LoadHostFromName(host.Name)
+	require.NoError(t, err)
+	assert.Equal(t, "ab12", res.ClientCertFingerprint)
+	assert.Equal(t, "1f", res.ClientCertSerial)
+}
+
+func TestRevokeCerts(t *testing.T) {
+	store.Log.Logger.SetLevel(logrus.ErrorLevel)
+
+	db, err := New(":memory:")
+	require.NoError(t, err)
+	defer db.Close()
+
+	revoked, err := db.RevokedCerts()
+	require.NoError(t, err)
+	assert.Empty(t, revoked)
+
+	n, err := db.RevokeCerts(model.RevokedCertList{{Serial: "1f", Host: "cpn-01"}, {Serial: "2a"}})
+	require.NoError(t, err)
+	assert.Equal(t, 2, n)
+
+	// Revoking again is a no-op
+	n, err = db.RevokeCerts(model.RevokedCertList{{Serial: "1f"}})
+	require.NoError(t, err)
+	assert.Equal(t, 0, n)
+
+	revoked, err = db.RevokedCerts()
+	require.NoError(t, err)
+	require.Len(t, revoked, 2)
+	assert.Equal(t, "1f", revoked[0].Serial)
+	assert.Equal(t, "cpn-01", revoked[0].Host)
+	assert.False(t, revoked[0].RevokedAt.IsZero())
+	assert.Equal(t, "2a", revoked[1].Serial)
+}
@@ -5,18 +5,39 @@ import "net/url"
 // Config the config for Sqlstore.
 type Config struct {
 	Driver string
+
+	// ReadOnly opens a database shared with another grendel instance without
+	// migrating it or rebuilding its indexes, both must be current
+	ReadOnly bool
 }
 
+// PostgresDriver is the Driver of a PostgreSQL database, shared by the
+// grendel instances of a high availability setup
+const PostgresDriver = "postgres"
+
 // ConfigDefault is the default config
 var ConfigDefault = Config{
 	Driver: "sqlite3",
 }
 
 func configDefault(config ...Config) Config {
-	return ConfigDefault
+	if len(config) == 0 {
+		return ConfigDefault
+	}
+
+	cfg := config[0]
+	if cfg.Driver == "" {
+		cfg.Driver = ConfigDefault.Driver
+	}
+
+	return cfg
 }
 
 func (c Config) DataSourceName(filename string, rw bool) string {
+	if c.Driver == PostgresDriver {
+		return filename
+	}
+
 	p := c.connectionParams()
 	if rw {
 		p.Set("mode", "rwc")
@@ -34,3 +55,9 @@ func (c Config) connectionParams() url.Values {
 
 	return p
 }
+
+// IsPostgresDSN returns true when dsn is the URL of a PostgreSQL database
+func IsPostgresDSN(dsn string) bool {
+	u, err := url.Parse(dsn)
+	return err == nil && (u.Scheme == "postgres" || u.Scheme == "postgresql")
+}
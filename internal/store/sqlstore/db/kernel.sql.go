@@ -91,6 +91,64 @@ func (q *Queries) KernelAll(ctx context.Context, db DBTX) ([]KernelView, error)
 	return items, nil
 }
 
+const kernelChildren = `-- name: KernelChildren :many
+select c.name, p.name as parent
+from kernel as c
+join kernel as p
+  on c.parent_id = p.id
+where p.name in (/*SLICE:names*/?)
+order by c.name
+`
+
+type KernelChildrenRow struct {
+	Name   string `json:"name"`
+	Parent string `json:"parent"`
+}
+
+func (q *Queries) KernelChildren(ctx context.Context, db DBTX, names []string) ([]KernelChildrenRow, error) {
+	query := kernelChildren
+	var queryParams []interface{}
+	if len(names) > 0 {
+		for _, v := range names {
+			queryParams = append(queryParams, v)
+		}
+		query = strings.Replace(query, "/*SLICE:names*/?", strings.Repeat(",?", len(names))[1:], 1)
+	} else {
+		query = strings.Replace(query, "/*SLICE:names*/?", "NULL", 1)
+	}
+	rows, err := db.QueryContext(ctx, query, queryParams...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []KernelChildrenRow
+	for rows.Next() {
+		var i KernelChildrenRow
+		if err := rows.Scan(&i.Name, &i.Parent); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const kernelCount = `-- name: KernelCount :one
+select count(*) from kernel
+`
+
+func (q *Queries) KernelCount(ctx context.Context, db DBTX) (int64, error) {
+	row := db.QueryRowContext(ctx, kernelCount)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
 const kernelDelete = `-- name: KernelDelete :exec
 delete from kernel where name in (/*SLICE:name*/?)
 `
@@ -127,6 +185,90 @@ func (q *Queries) KernelFetch(ctx context.Context, db DBTX, name string) (Kernel
 	return i, err
 }
 
+const kernelID = `-- name: KernelID :one
+select id from kernel where name = ?1
+`
+
+func (q *Queries) KernelID(ctx context.Context, db DBTX, name string) (int64, error) {
+	row := db.QueryRowContext(ctx, kernelID, name)
+	var id int64
+	err := row.Scan(&id)
+	return id, err
+}
+
+const kernelNodeCount = `-- name: KernelNodeCount :many
+select k.name, count(n.id) as nodes
+from kernel as k
+left join node as n
+  on n.kernel_id = k.id
+group by k.id
+order by k.name
+`
+
+type KernelNodeCountRow struct {
+	Name  string `json:"name"`
+	Nodes int64  `json:"nodes"`
+}
+
+func (q *Queries) KernelNodeCount(ctx context.Context, db DBTX) ([]KernelNodeCountRow, error) {
+	rows, err := db.QueryContext(ctx, kernelNodeCount)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []KernelNodeCountRow
+	for rows.Next() {
+		var i KernelNodeCountRow
+		if err := rows.Scan(&i.Name, &i.Nodes); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const kernelParent = `-- name: KernelParent :one
+select parent_id from kernel where id = ?1
+`
+
+func (q *Queries) KernelParent(ctx context.Context, db DBTX, id int64) (null.Int64, error) {
+	row := db.QueryRowContext(ctx, kernelParent, id)
+	var parent_id null.Int64
+	err := row.Scan(&parent_id)
+	return parent_id, err
+}
+
+const kernelRevision = `-- name: KernelRevision :one
+select revision from kernel where id = ?1
+`
+
+func (q *Queries) KernelRevision(ctx context.Context, db DBTX, id int64) (int64, error) {
+	row := db.QueryRowContext(ctx, kernelRevision, id)
+	var revision int64
+	err := row.Scan(&revision)
+	return revision, err
+}
+
+const kernelSetParent = `-- name: KernelSetParent :exec
+update kernel set parent_id = ?1 where id = ?2
+`
+
+type KernelSetParentParams struct {
+	ParentID null.Int64 `json:"parent_id"`
+	ID       int64      `json:"id"`
+}
+
+func (q *Queries) KernelSetParent(ctx context.Context, db DBTX, arg KernelSetParentParams) error {
+	_, err := db.ExecContext(ctx, kernelSetParent, arg.ParentID, arg.ID)
+	return err
+}
+
 const kernelTemplateUpsert = `-- name: KernelTemplateUpsert :exec
 insert into kernel_template (kernel_id, template_id)
 values (?1, ?2)
@@ -173,8 +315,8 @@ const kernelUpsert = `-- name: KernelUpsert :one
 insert into kernel (id, uid, name, version, path, arch_id, command_line, verify)
 values (?1, ?2, ?3, ?4, ?5, ?6, ?7, ?8)
 on conflict (id)
-do update set uid = ?2, name = ?3, version = ?4, path = ?5, arch_id = ?6, command_line = ?7, verify = ?8
-returning id, uid, name, version, path, arch_id, command_line, verify, created_at, updated_at
+do update set uid = ?2, name = ?3, version = ?4, path = ?5, arch_id = ?6, command_line = ?7, verify = ?8, revision = kernel.revision + 1
+returning id, uid, name, version, path, arch_id, command_line, verify, created_at, updated_at, revision, parent_id
 `
 
 type KernelUpsertParams struct {
@@ -211,6 +353,8 @@ func (q *Queries) KernelUpsert(ctx context.Context, db DBTX, arg KernelUpsertPar
 		&i.Verify,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.Revision,
+		&i.ParentID,
 	)
 	return i, err
 }
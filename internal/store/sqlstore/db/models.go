@@ -17,6 +17,40 @@ type Arch struct {
 	Name string `json:"name"`
 }
 
+type ChangeJournal struct {
+	Seq       int64       `json:"seq"`
+	ChangedAt int64       `json:"changed_at"`
+	Kind      string      `json:"kind"`
+	Name      string      `json:"name"`
+	Op        string      `json:"op"`
+	Diff      null.String `json:"diff"`
+}
+
+type DnsRecord struct {
+	ID        int64     `json:"id"`
+	Name      string    `json:"name"`
+	Type      string    `json:"type"`
+	Value     string    `json:"value"`
+	TTL       int64     `json:"ttl"`
+	PTR       bool      `json:"ptr"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+type HaInstance struct {
+	Name      string `json:"name"`
+	Role      string `json:"role"`
+	State     string `json:"state"`
+	Heartbeat int64  `json:"heartbeat"`
+	Changed   int64  `json:"changed"`
+}
+
+type ImageFile struct {
+	Path       string `json:"path"`
+	Image      string `json:"image"`
+	ReleasedAt int64  `json:"released_at"`
+}
+
 type Initrd struct {
 	ID        int64     `json:"id"`
 	KernelID  int64     `json:"kernel_id"`
@@ -25,6 +59,15 @@ type Initrd struct {
 	UpdatedAt time.Time `json:"updated_at"`
 }
 
+type IPReservation struct {
+	IP       string `json:"ip"`
+	Kind     string `json:"kind"`
+	Host     string `json:"host"`
+	Username string `json:"username"`
+	Created  int64  `json:"created"`
+	Expires  int64  `json:"expires"`
+}
+
 type Kernel struct {
 	ID          int64       `json:"id"`
 	UID         ksuid.KSUID `json:"uid"`
@@ -36,6 +79,8 @@ type Kernel struct {
 	Verify      bool        `json:"verify"`
 	CreatedAt   time.Time   `json:"created_at"`
 	UpdatedAt   time.Time   `json:"updated_at"`
+	Revision    int64       `json:"revision"`
+	ParentID    null.Int64  `json:"parent_id"`
 }
 
 type KernelTemplate struct {
@@ -49,30 +94,91 @@ type KernelView struct {
 	Image model.BootImage `json:"image_json"`
 }
 
+type Maintenance struct {
+	ID        int64  `json:"id"`
+	Enabled   bool   `json:"enabled"`
+	Reason    string `json:"reason"`
+	ChangedBy string `json:"changed_by"`
+	Changed   int64  `json:"changed"`
+}
+
 type Nic struct {
-	ID      int64       `json:"id"`
-	NodeID  int64       `json:"node_id"`
-	NicType string      `json:"nic_type"`
-	Name    null.String `json:"name"`
-	VLAN    null.String `json:"vlan"`
-	FQDN    null.String `json:"fqdn"`
-	MAC     null.String `json:"mac"`
-	IP      null.String `json:"ip"`
-	Peers   null.String `json:"peers"`
-	MTU     null.Int64  `json:"mtu"`
+	ID        int64       `json:"id"`
+	NodeID    int64       `json:"node_id"`
+	NicType   string      `json:"nic_type"`
+	Name      null.String `json:"name"`
+	VLAN      null.String `json:"vlan"`
+	FQDN      null.String `json:"fqdn"`
+	MAC       null.String `json:"mac"`
+	IP        null.String `json:"ip"`
+	Peers     null.String `json:"peers"`
+	MTU       null.Int64  `json:"mtu"`
+	Switch    null.String `json:"switch"`
+	Port      null.Int64  `json:"port"`
+	Parent    null.String `json:"parent"`
+	Mode      null.String `json:"mode"`
+	Options   null.String `json:"options"`
+	Addresses null.String `json:"addresses"`
+}
+
+type NicAddress struct {
+	NicID int64       `json:"nic_id"`
+	IP    string      `json:"ip"`
+	Addr  string      `json:"addr"`
+	FQDN  null.String `json:"fqdn"`
+	Name  string      `json:"name"`
+}
+
+type NicFQDN struct {
+	NicID int64  `json:"nic_id"`
+	FQDN  string `json:"fqdn"`
 }
 
 type Node struct {
-	ID         int64       `json:"id"`
-	UID        ksuid.KSUID `json:"uid"`
-	Name       string      `json:"name"`
-	Provision  bool        `json:"provision"`
-	ArchID     null.Int64  `json:"arch_id"`
-	KernelID   null.Int64  `json:"kernel_id"`
-	NodeTypeID null.Int64  `json:"node_type_id"`
-	Firmware   null.String `json:"firmware"`
-	CreatedAt  time.Time   `json:"created_at"`
-	UpdatedAt  time.Time   `json:"updated_at"`
+	ID                    int64       `json:"id"`
+	UID                   ksuid.KSUID `json:"uid"`
+	Name                  string      `json:"name"`
+	Provision             bool        `json:"provision"`
+	ArchID                null.Int64  `json:"arch_id"`
+	KernelID              null.Int64  `json:"kernel_id"`
+	NodeTypeID            null.Int64  `json:"node_type_id"`
+	Firmware              null.String `json:"firmware"`
+	CreatedAt             time.Time   `json:"created_at"`
+	UpdatedAt             time.Time   `json:"updated_at"`
+	Revision              int64       `json:"revision"`
+	SMBIOSUUID            null.String `json:"smbios_uuid"`
+	Inventory             null.String `json:"inventory"`
+	Hardware              null.String `json:"hardware"`
+	ClientCertFingerprint null.String `json:"client_cert_fingerprint"`
+	ClientCertSerial      null.String `json:"client_cert_serial"`
+	State                 string      `json:"state"`
+}
+
+type NodeCredential struct {
+	ID        int64     `json:"id"`
+	NodeID    int64     `json:"node_id"`
+	Kind      string    `json:"kind"`
+	Secret    string    `json:"secret"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+type NodeFile struct {
+	ID        int64     `json:"id"`
+	NodeID    int64     `json:"node_id"`
+	Name      string    `json:"name"`
+	Data      []byte    `json:"data"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+type NodeLog struct {
+	ID        int64  `json:"id"`
+	NodeID    int64  `json:"node_id"`
+	LoggedAt  int64  `json:"logged_at"`
+	Severity  string `json:"severity"`
+	MessageID string `json:"message_id"`
+	Message   string `json:"message"`
 }
 
 type NodeTag struct {
@@ -82,6 +188,14 @@ type NodeTag struct {
 	Value  string `json:"value"`
 }
 
+type NodeTrash struct {
+	ID              int64      `json:"id"`
+	Name            string     `json:"name"`
+	Host            model.Host `json:"host_json"`
+	CredentialsJson string     `json:"credentials_json"`
+	DeletedAt       int64      `json:"deleted_at"`
+}
+
 type NodeType struct {
 	ID   int64  `json:"id"`
 	Name string `json:"name"`
@@ -94,12 +208,35 @@ type NodeView struct {
 	Host model.Host `json:"host_json"`
 }
 
+type PendingBmc struct {
+	MAC         string `json:"mac"`
+	VendorClass string `json:"vendor_class"`
+	Relay       string `json:"relay"`
+	ServerIP    string `json:"server_ip"`
+	FirstSeen   int64  `json:"first_seen"`
+	LastSeen    int64  `json:"last_seen"`
+}
+
 type Permission struct {
 	ID     int64  `json:"id"`
 	Method string `json:"method"`
 	Path   string `json:"path"`
 }
 
+type RevokedCert struct {
+	Serial    string    `json:"serial"`
+	Host      string    `json:"host"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type RevokedToken struct {
+	ID        string    `json:"id"`
+	HostUID   string    `json:"host_uid"`
+	MAC       string    `json:"mac"`
+	Expires   int64     `json:"expires"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
 type Role struct {
 	ID   int64  `json:"id"`
 	Name string `json:"name"`
@@ -115,6 +252,49 @@ type RoleView struct {
 	PermissionJson model.RoleView `json:"permission_json"`
 }
 
+type ScheduledAction struct {
+	ID         int64  `json:"id"`
+	Action     string `json:"action"`
+	Nodeset    string `json:"nodeset"`
+	Arg        string `json:"arg"`
+	RunAt      int64  `json:"run_at"`
+	CreatedBy  string `json:"created_by"`
+	CreatedAt  int64  `json:"created_at"`
+	Status     string `json:"status"`
+	StartedAt  int64  `json:"started_at"`
+	FinishedAt int64  `json:"finished_at"`
+	Results    string `json:"results"`
+	Error      string `json:"error"`
+}
+
+type Secret struct {
+	Name      string    `json:"name"`
+	Value     string    `json:"value"`
+	OneTime   bool      `json:"one_time"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+type SecretFetch struct {
+	Name      string    `json:"name"`
+	TokenID   string    `json:"token_id"`
+	Host      string    `json:"host"`
+	FetchedAt time.Time `json:"fetched_at"`
+}
+
+type SigningKey struct {
+	ID       string `json:"id"`
+	Kind     string `json:"kind"`
+	Secret   string `json:"secret"`
+	Created  int64  `json:"created"`
+	RetireAt int64  `json:"retire_at"`
+}
+
+type StoreIndex struct {
+	Name    string `json:"name"`
+	Version int64  `json:"version"`
+}
+
 type Tag struct {
 	ID  int64  `json:"id"`
 	Key string `json:"key"`
@@ -134,6 +314,13 @@ type TemplateType struct {
 	UriName string `json:"uri_name"`
 }
 
+type Tombstone struct {
+	ID        int64  `json:"id"`
+	Kind      string `json:"kind"`
+	Name      string `json:"name"`
+	DeletedAt int64  `json:"deleted_at"`
+}
+
 type User struct {
 	ID           int64     `json:"id"`
 	Username     string    `json:"username"`
@@ -19,25 +19,32 @@ const nicUpsert = `-- name: NicUpsert :one
  * SPDX-License-Identifier: GPL-3.0-or-later
  */
 
-insert into nic (id, node_id, nic_type, name, vlan, fqdn, mac, ip, peers, mtu)
-values (?1, ?2, ?3, ?4, ?5, ?6, ?7, ?8, ?9, ?10)
+insert into nic (id, node_id, nic_type, name, vlan, fqdn, mac, ip, peers, mtu, switch, port, parent, mode, options, addresses)
+values (?1, ?2, ?3, ?4, ?5, ?6, ?7, ?8, ?9, ?10, ?11, ?12, ?13, ?14, ?15, ?16)
 on conflict (id)
 do update set nic_type = ?3, name = ?4, vlan = ?5, fqdn = ?6, mac = ?7, ip = ?8,
-              peers = ?9, mtu = ?10
-returning id, node_id, nic_type, name, vlan, fqdn, mac, ip, peers, mtu
+              peers = ?9, mtu = ?10, switch = ?11, port = ?12, parent = ?13,
+              mode = ?14, options = ?15, addresses = ?16
+returning id, node_id, nic_type, name, vlan, fqdn, mac, ip, peers, mtu, switch, port, parent, mode, options, addresses
 `
 
 type NicUpsertParams struct {
-	ID      null.Int64  `json:"id"`
-	NodeID  int64       `json:"node_id"`
-	NicType string      `json:"nic_type"`
-	Name    null.String `json:"name"`
-	VLAN    null.String `json:"vlan"`
-	FQDN    null.String `json:"fqdn"`
-	MAC     null.String `json:"mac"`
-	IP      null.String `json:"ip"`
-	Peers   null.String `json:"peers"`
-	MTU     null.Int64  `json:"mtu"`
+	ID        null.Int64  `json:"id"`
+	NodeID    int64       `json:"node_id"`
+	NicType   string      `json:"nic_type"`
+	Name      null.String `json:"name"`
+	VLAN      null.String `json:"vlan"`
+	FQDN      null.String `json:"fqdn"`
+	MAC       null.String `json:"mac"`
+	IP        null.String `json:"ip"`
+	Peers     null.String `json:"peers"`
+	MTU       null.Int64  `json:"mtu"`
+	Switch    null.String `json:"switch"`
+	Port      null.Int64  `json:"port"`
+	Parent    null.String `json:"parent"`
+	Mode      null.String `json:"mode"`
+	Options   null.String `json:"options"`
+	Addresses null.String `json:"addresses"`
 }
 
 func (q *Queries) NicUpsert(ctx context.Context, db DBTX, arg NicUpsertParams) (Nic, error) {
@@ -52,6 +59,12 @@ func (q *Queries) NicUpsert(ctx context.Context, db DBTX, arg NicUpsertParams) (
 		arg.IP,
 		arg.Peers,
 		arg.MTU,
+		arg.Switch,
+		arg.Port,
+		arg.Parent,
+		arg.Mode,
+		arg.Options,
+		arg.Addresses,
 	)
 	var i Nic
 	err := row.Scan(
@@ -65,6 +78,12 @@ func (q *Queries) NicUpsert(ctx context.Context, db DBTX, arg NicUpsertParams) (
 		&i.IP,
 		&i.Peers,
 		&i.MTU,
+		&i.Switch,
+		&i.Port,
+		&i.Parent,
+		&i.Mode,
+		&i.Options,
+		&i.Addresses,
 	)
 	return i, err
 }
@@ -46,7 +46,7 @@ func (q *Queries) NodeAll(ctx context.Context, db DBTX) ([]NodeView, error) {
 }
 
 const nodeBootKernel = `-- name: NodeBootKernel :exec
-update node set kernel_id = ?1
+update node set kernel_id = ?1, revision = revision + 1
 where id in (/*SLICE:nodes*/?)
 `
 
@@ -71,6 +71,64 @@ func (q *Queries) NodeBootKernel(ctx context.Context, db DBTX, arg NodeBootKerne
 	return err
 }
 
+const nodeBootKernelIfCurrent = `-- name: NodeBootKernelIfCurrent :execrows
+update node set kernel_id = ?1, revision = revision + 1
+where id in (/*SLICE:nodes*/?)
+  and coalesce((select k.name from kernel as k where k.id = node.kernel_id), '') in (/*SLICE:current*/?)
+`
+
+type NodeBootKernelIfCurrentParams struct {
+	KernelID null.Int64 `json:"kernel_id"`
+	Nodes    []int64    `json:"nodes"`
+	Current  []string   `json:"current"`
+}
+
+func (q *Queries) NodeBootKernelIfCurrent(ctx context.Context, db DBTX, arg NodeBootKernelIfCurrentParams) (int64, error) {
+	query := nodeBootKernelIfCurrent
+	var queryParams []interface{}
+	queryParams = append(queryParams, arg.KernelID)
+	if len(arg.Nodes) > 0 {
+		for _, v := range arg.Nodes {
+			queryParams = append(queryParams, v)
+		}
+		query = strings.Replace(query, "/*SLICE:nodes*/?", strings.Repeat(",?", len(arg.Nodes))[1:], 1)
+	} else {
+		query = strings.Replace(query, "/*SLICE:nodes*/?", "NULL", 1)
+	}
+	if len(arg.Current) > 0 {
+		for _, v := range arg.Current {
+			queryParams = append(queryParams, v)
+		}
+		query = strings.Replace(query, "/*SLICE:current*/?", strings.Repeat(",?", len(arg.Current))[1:], 1)
+	} else {
+		query = strings.Replace(query, "/*SLICE:current*/?", "NULL", 1)
+	}
+	result, err := db.ExecContext(ctx, query, queryParams...)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const nodeClientCertSet = `-- name: NodeClientCertSet :execrows
+update node set client_cert_fingerprint = ?1, client_cert_serial = ?2
+where name = ?3
+`
+
+type NodeClientCertSetParams struct {
+	ClientCertFingerprint null.String `json:"client_cert_fingerprint"`
+	ClientCertSerial      null.String `json:"client_cert_serial"`
+	Name                  string      `json:"name"`
+}
+
+func (q *Queries) NodeClientCertSet(ctx context.Context, db DBTX, arg NodeClientCertSetParams) (int64, error) {
+	result, err := db.ExecContext(ctx, nodeClientCertSet, arg.ClientCertFingerprint, arg.ClientCertSerial, arg.Name)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
 const nodeCount = `-- name: NodeCount :one
 /*
  * SPDX-FileCopyrightText: (C) 2019 Grendel Authors
@@ -155,34 +213,104 @@ func (q *Queries) NodeFetchByUID(ctx context.Context, db DBTX, uid string) (Node
 	return i, err
 }
 
-const nodeFind = `-- name: NodeFind :one
-select n.id, n.name, n.uid, n.host_json 
+const nodeFetchBySMBIOSUUID = `-- name: NodeFetchBySMBIOSUUID :one
+select n.id, n.name, n.uid, n.host_json
 from node_view as n
-join nic as nc
-on nc.node_id = n.id
-where 
-  case 
-    when cast(?1 as integer) then nc.mac = ?2
-    when cast(?3 as integer) then nc.ip = ?4
-    else 0
-  end
+join node as nd
+  on nd.id = n.id
+where nd.smbios_uuid = ?1
 limit 1
 `
 
-type NodeFindParams struct {
-	FilterMAC int64       `json:"filter_mac"`
-	MAC       null.String `json:"mac"`
-	FilterIP  int64       `json:"filter_ip"`
-	IP        null.String `json:"ip"`
+func (q *Queries) NodeFetchBySMBIOSUUID(ctx context.Context, db DBTX, smbiosUUID null.String) (NodeView, error) {
+	row := db.QueryRowContext(ctx, nodeFetchBySMBIOSUUID, smbiosUUID)
+	var i NodeView
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.UID,
+		&i.Host,
+	)
+	return i, err
 }
 
-func (q *Queries) NodeFind(ctx context.Context, db DBTX, arg NodeFindParams) (NodeView, error) {
-	row := db.QueryRowContext(ctx, nodeFind,
-		arg.FilterMAC,
-		arg.MAC,
-		arg.FilterIP,
-		arg.IP,
-	)
+const nodeFindByFQDNOrIP = `-- name: NodeFindByFQDNOrIP :many
+select id, name, uid, host_json from node_view
+where id in (
+  select nc.node_id
+  from nic_fqdn as f
+  join nic as nc
+    on nc.id = f.nic_id
+  where f.fqdn in (/*SLICE:names*/?)
+  union
+  select nc.node_id
+  from nic as nc
+  where cast(substring(nc.ip, 0, instr(nc.ip, '/')) as text) in (/*SLICE:addrs*/?)
+)
+`
+
+type NodeFindByFQDNOrIPParams struct {
+	Names []string `json:"names"`
+	Addrs []string `json:"addrs"`
+}
+
+func (q *Queries) NodeFindByFQDNOrIP(ctx context.Context, db DBTX, arg NodeFindByFQDNOrIPParams) ([]NodeView, error) {
+	query := nodeFindByFQDNOrIP
+	var queryParams []interface{}
+	if len(arg.Names) > 0 {
+		for _, v := range arg.Names {
+			queryParams = append(queryParams, v)
+		}
+		query = strings.Replace(query, "/*SLICE:names*/?", strings.Repeat(",?", len(arg.Names))[1:], 1)
+	} else {
+		query = strings.Replace(query, "/*SLICE:names*/?", "NULL", 1)
+	}
+	if len(arg.Addrs) > 0 {
+		for _, v := range arg.Addrs {
+			queryParams = append(queryParams, v)
+		}
+		query = strings.Replace(query, "/*SLICE:addrs*/?", strings.Repeat(",?", len(arg.Addrs))[1:], 1)
+	} else {
+		query = strings.Replace(query, "/*SLICE:addrs*/?", "NULL", 1)
+	}
+	rows, err := db.QueryContext(ctx, query, queryParams...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []NodeView
+	for rows.Next() {
+		var i NodeView
+		if err := rows.Scan(
+			&i.ID,
+			&i.Name,
+			&i.UID,
+			&i.Host,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const nodeFindByMAC = `-- name: NodeFindByMAC :one
+select n.id, n.name, n.uid, n.host_json
+from node_view as n
+join nic as nc
+on nc.node_id = n.id
+where nc.mac = ?1
+limit 1
+`
+
+func (q *Queries) NodeFindByMAC(ctx context.Context, db DBTX, mac null.String) (NodeView, error) {
+	row := db.QueryRowContext(ctx, nodeFindByMAC, mac)
 	var i NodeView
 	err := row.Scan(
 		&i.ID,
@@ -193,6 +321,68 @@ func (q *Queries) NodeFind(ctx context.Context, db DBTX, arg NodeFindParams) (No
 	return i, err
 }
 
+const nodeFindBySecondaryAddress = `-- name: NodeFindBySecondaryAddress :many
+select id, name, uid, host_json from node_view
+where id in (
+  select nc.node_id
+  from nic_address as a
+  join nic as nc
+    on nc.id = a.nic_id
+  where a.name in (/*SLICE:names*/?) or a.addr in (/*SLICE:addrs*/?)
+)
+`
+
+type NodeFindBySecondaryAddressParams struct {
+	Names []string `json:"names"`
+	Addrs []string `json:"addrs"`
+}
+
+func (q *Queries) NodeFindBySecondaryAddress(ctx context.Context, db DBTX, arg NodeFindBySecondaryAddressParams) ([]NodeView, error) {
+	query := nodeFindBySecondaryAddress
+	var queryParams []interface{}
+	if len(arg.Names) > 0 {
+		for _, v := range arg.Names {
+			queryParams = append(queryParams, v)
+		}
+		query = strings.Replace(query, "/*SLICE:names*/?", strings.Repeat(",?", len(arg.Names))[1:], 1)
+	} else {
+		query = strings.Replace(query, "/*SLICE:names*/?", "NULL", 1)
+	}
+	if len(arg.Addrs) > 0 {
+		for _, v := range arg.Addrs {
+			queryParams = append(queryParams, v)
+		}
+		query = strings.Replace(query, "/*SLICE:addrs*/?", strings.Repeat(",?", len(arg.Addrs))[1:], 1)
+	} else {
+		query = strings.Replace(query, "/*SLICE:addrs*/?", "NULL", 1)
+	}
+	rows, err := db.QueryContext(ctx, query, queryParams...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []NodeView
+	for rows.Next() {
+		var i NodeView
+		if err := rows.Scan(
+			&i.ID,
+			&i.Name,
+			&i.UID,
+			&i.Host,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const nodeFindNodeset = `-- name: NodeFindNodeset :many
 select id, name, uid, host_json from node_view 
 where name in (/*SLICE:nodeset*/?)
@@ -289,6 +479,50 @@ func (q *Queries) NodeFindTags(ctx context.Context, db DBTX, tags []string) ([]N
 	return items, nil
 }
 
+const nodeFirmware = `-- name: NodeFirmware :exec
+update node set firmware = ?1, revision = revision + 1
+where id in (/*SLICE:nodes*/?)
+`
+
+type NodeFirmwareParams struct {
+	Firmware null.String `json:"firmware"`
+	Nodes    []int64     `json:"nodes"`
+}
+
+func (q *Queries) NodeFirmware(ctx context.Context, db DBTX, arg NodeFirmwareParams) error {
+	query := nodeFirmware
+	var queryParams []interface{}
+	queryParams = append(queryParams, arg.Firmware)
+	if len(arg.Nodes) > 0 {
+		for _, v := range arg.Nodes {
+			queryParams = append(queryParams, v)
+		}
+		query = strings.Replace(query, "/*SLICE:nodes*/?", strings.Repeat(",?", len(arg.Nodes))[1:], 1)
+	} else {
+		query = strings.Replace(query, "/*SLICE:nodes*/?", "NULL", 1)
+	}
+	_, err := db.ExecContext(ctx, query, queryParams...)
+	return err
+}
+
+const nodeHardwareSet = `-- name: NodeHardwareSet :execrows
+update node set hardware = ?1
+where name = ?2
+`
+
+type NodeHardwareSetParams struct {
+	Hardware null.String `json:"hardware"`
+	Name     string      `json:"name"`
+}
+
+func (q *Queries) NodeHardwareSet(ctx context.Context, db DBTX, arg NodeHardwareSetParams) (int64, error) {
+	result, err := db.ExecContext(ctx, nodeHardwareSet, arg.Hardware, arg.Name)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
 const nodeID = `-- name: NodeID :many
 select id from node
 where name in (/*SLICE:nodeset*/?)
@@ -327,13 +561,32 @@ func (q *Queries) NodeID(ctx context.Context, db DBTX, nodeset []string) ([]int6
 	return items, nil
 }
 
+const nodeInventorySet = `-- name: NodeInventorySet :execrows
+update node set inventory = ?1
+where name = ?2
+`
+
+type NodeInventorySetParams struct {
+	Inventory null.String `json:"inventory"`
+	Name      string      `json:"name"`
+}
+
+func (q *Queries) NodeInventorySet(ctx context.Context, db DBTX, arg NodeInventorySetParams) (int64, error) {
+	result, err := db.ExecContext(ctx, nodeInventorySet, arg.Inventory, arg.Name)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
 const nodeProvision = `-- name: NodeProvision :exec
-update node set provision = ?1
+update node set provision = ?1, state = ?2, revision = revision + 1
 where id in (/*SLICE:nodes*/?)
 `
 
 type NodeProvisionParams struct {
 	Provision bool    `json:"provision"`
+	State     string  `json:"state"`
 	Nodes     []int64 `json:"nodes"`
 }
 
@@ -341,6 +594,7 @@ func (q *Queries) NodeProvision(ctx context.Context, db DBTX, arg NodeProvisionP
 	query := nodeProvision
 	var queryParams []interface{}
 	queryParams = append(queryParams, arg.Provision)
+	queryParams = append(queryParams, arg.State)
 	if len(arg.Nodes) > 0 {
 		for _, v := range arg.Nodes {
 			queryParams = append(queryParams, v)
@@ -353,44 +607,85 @@ func (q *Queries) NodeProvision(ctx context.Context, db DBTX, arg NodeProvisionP
 	return err
 }
 
-const nodeResolve = `-- name: NodeResolve :many
-select nc.fqdn, nc.ip 
-from nic as nc
-where 
-  case 
-    when cast(?1 as integer) then lower(nc.fqdn) like concat('%', cast(?2 as text), '%')
-    when cast(?3 as integer) then substring(nc.ip, 0, instr(nc.ip, '/')) = cast(?4 as text)
-    else 0
-  end
+const nodeResolveFQDN = `-- name: NodeResolveFQDN :many
+select nc.fqdn, nc.ip, n.state
+from nic_fqdn as f
+join nic as nc
+  on nc.id = f.nic_id
+join node as n
+  on n.id = nc.node_id
+where f.fqdn = ?1
+union all
+select a.fqdn, a.ip, n.state
+from nic_address as a
+join nic as nc
+  on nc.id = a.nic_id
+join node as n
+  on n.id = nc.node_id
+where a.name = ?1
 `
 
-type NodeResolveParams struct {
-	FilterFQDN int64  `json:"filter_fqdn"`
-	FQDN       string `json:"fqdn"`
-	FilterIP   int64  `json:"filter_ip"`
-	IP         string `json:"ip"`
+type NodeResolveFQDNRow struct {
+	FQDN  null.String `json:"fqdn"`
+	IP    null.String `json:"ip"`
+	State string      `json:"state"`
+}
+
+func (q *Queries) NodeResolveFQDN(ctx context.Context, db DBTX, fqdn string) ([]NodeResolveFQDNRow, error) {
+	rows, err := db.QueryContext(ctx, nodeResolveFQDN, fqdn)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []NodeResolveFQDNRow
+	for rows.Next() {
+		var i NodeResolveFQDNRow
+		if err := rows.Scan(&i.FQDN, &i.IP, &i.State); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
 }
 
-type NodeResolveRow struct {
-	FQDN null.String `json:"fqdn"`
-	IP   null.String `json:"ip"`
+const nodeResolveIP = `-- name: NodeResolveIP :many
+select nc.fqdn, nc.ip, n.state
+from nic as nc
+join node as n
+  on n.id = nc.node_id
+where cast(substring(nc.ip, 0, instr(nc.ip, '/')) as text) = cast(?1 as text)
+union all
+select distinct a.fqdn, a.ip, n.state
+from nic_address as a
+join nic as nc
+  on nc.id = a.nic_id
+join node as n
+  on n.id = nc.node_id
+where a.addr = cast(?1 as text)
+`
+
+type NodeResolveIPRow struct {
+	FQDN  null.String `json:"fqdn"`
+	IP    null.String `json:"ip"`
+	State string      `json:"state"`
 }
 
-func (q *Queries) NodeResolve(ctx context.Context, db DBTX, arg NodeResolveParams) ([]NodeResolveRow, error) {
-	rows, err := db.QueryContext(ctx, nodeResolve,
-		arg.FilterFQDN,
-		arg.FQDN,
-		arg.FilterIP,
-		arg.IP,
-	)
+func (q *Queries) NodeResolveIP(ctx context.Context, db DBTX, ip string) ([]NodeResolveIPRow, error) {
+	rows, err := db.QueryContext(ctx, nodeResolveIP, ip)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []NodeResolveRow
+	var items []NodeResolveIPRow
 	for rows.Next() {
-		var i NodeResolveRow
-		if err := rows.Scan(&i.FQDN, &i.IP); err != nil {
+		var i NodeResolveIPRow
+		if err := rows.Scan(&i.FQDN, &i.IP, &i.State); err != nil {
 			return nil, err
 		}
 		items = append(items, i)
@@ -404,6 +699,74 @@ func (q *Queries) NodeResolve(ctx context.Context, db DBTX, arg NodeResolveParam
 	return items, nil
 }
 
+const nodeRevision = `-- name: NodeRevision :one
+select revision from node where id = ?1
+`
+
+func (q *Queries) NodeRevision(ctx context.Context, db DBTX, id int64) (int64, error) {
+	row := db.QueryRowContext(ctx, nodeRevision, id)
+	var revision int64
+	err := row.Scan(&revision)
+	return revision, err
+}
+
+const nodeRevisionIncrement = `-- name: NodeRevisionIncrement :exec
+update node set revision = revision + 1
+where id in (/*SLICE:nodes*/?)
+`
+
+func (q *Queries) NodeRevisionIncrement(ctx context.Context, db DBTX, nodes []int64) error {
+	query := nodeRevisionIncrement
+	var queryParams []interface{}
+	if len(nodes) > 0 {
+		for _, v := range nodes {
+			queryParams = append(queryParams, v)
+		}
+		query = strings.Replace(query, "/*SLICE:nodes*/?", strings.Repeat(",?", len(nodes))[1:], 1)
+	} else {
+		query = strings.Replace(query, "/*SLICE:nodes*/?", "NULL", 1)
+	}
+	_, err := db.ExecContext(ctx, query, queryParams...)
+	return err
+}
+
+const nodeState = `-- name: NodeState :one
+select name, state from node where id = ?1
+`
+
+type NodeStateRow struct {
+	Name  string `json:"name"`
+	State string `json:"state"`
+}
+
+func (q *Queries) NodeState(ctx context.Context, db DBTX, id int64) (NodeStateRow, error) {
+	row := db.QueryRowContext(ctx, nodeState, id)
+	var i NodeStateRow
+	err := row.Scan(&i.Name, &i.State)
+	return i, err
+}
+
+const nodeStats = `-- name: NodeStats :one
+select
+  count(*) as total,
+  cast(coalesce(sum(provision), 0) as integer) as provisioning,
+  count(*) - count(kernel_id) as without_kernel
+from node
+`
+
+type NodeStatsRow struct {
+	Total         int64 `json:"total"`
+	Provisioning  int64 `json:"provisioning"`
+	WithoutKernel int64 `json:"without_kernel"`
+}
+
+func (q *Queries) NodeStats(ctx context.Context, db DBTX) (NodeStatsRow, error) {
+	row := db.QueryRowContext(ctx, nodeStats)
+	var i NodeStatsRow
+	err := row.Scan(&i.Total, &i.Provisioning, &i.WithoutKernel)
+	return i, err
+}
+
 const nodeTagDelete = `-- name: NodeTagDelete :exec
 delete from node_tag where node_id in (/*SLICE:nodes*/?) and tag_id in (/*SLICE:tags*/?)
 `
@@ -487,22 +850,28 @@ func (q *Queries) NodeTagUpsertDelete(ctx context.Context, db DBTX, arg NodeTagU
 }
 
 const nodeUpsert = `-- name: NodeUpsert :one
-insert into node (id, uid, name, provision, arch_id, kernel_id, node_type_id, firmware)
-values (?1, ?2, ?3, ?4, ?5, ?6, ?7, ?8)
+insert into node (id, uid, name, provision, arch_id, kernel_id, node_type_id, firmware, smbios_uuid, inventory, hardware, client_cert_fingerprint, client_cert_serial, state)
+values (?1, ?2, ?3, ?4, ?5, ?6, ?7, ?8, ?9, ?10, ?11, ?12, ?13, ?14)
 on conflict (id)
-do update set name = ?3, provision = ?4, arch_id = ?5, kernel_id = ?6, node_type_id = ?7, firmware = ?8
-returning id, uid, name, provision, arch_id, kernel_id, node_type_id, firmware, created_at, updated_at
+do update set name = ?3, provision = ?4, arch_id = ?5, kernel_id = ?6, node_type_id = ?7, firmware = ?8, smbios_uuid = ?9, inventory = coalesce(?10, node.inventory), hardware = coalesce(?11, node.hardware), client_cert_fingerprint = coalesce(?12, node.client_cert_fingerprint), client_cert_serial = coalesce(?13, node.client_cert_serial), state = ?14, revision = node.revision + 1
+returning id, uid, name, provision, arch_id, kernel_id, node_type_id, firmware, created_at, updated_at, revision, smbios_uuid, inventory, hardware, client_cert_fingerprint, client_cert_serial, state
 `
 
 type NodeUpsertParams struct {
-	ID         null.Int64  `json:"id"`
-	UID        ksuid.KSUID `json:"uid"`
-	Name       string      `json:"name"`
-	Provision  bool        `json:"provision"`
-	ArchID     null.Int64  `json:"arch_id"`
-	KernelID   null.Int64  `json:"kernel_id"`
-	NodeTypeID null.Int64  `json:"node_type_id"`
-	Firmware   null.String `json:"firmware"`
+	ID                    null.Int64  `json:"id"`
+	UID                   ksuid.KSUID `json:"uid"`
+	Name                  string      `json:"name"`
+	Provision             bool        `json:"provision"`
+	ArchID                null.Int64  `json:"arch_id"`
+	KernelID              null.Int64  `json:"kernel_id"`
+	NodeTypeID            null.Int64  `json:"node_type_id"`
+	Firmware              null.String `json:"firmware"`
+	SMBIOSUUID            null.String `json:"smbios_uuid"`
+	Inventory             null.String `json:"inventory"`
+	Hardware              null.String `json:"hardware"`
+	ClientCertFingerprint null.String `json:"client_cert_fingerprint"`
+	ClientCertSerial      null.String `json:"client_cert_serial"`
+	State                 string      `json:"state"`
 }
 
 func (q *Queries) NodeUpsert(ctx context.Context, db DBTX, arg NodeUpsertParams) (Node, error) {
@@ -515,6 +884,12 @@ func (q *Queries) NodeUpsert(ctx context.Context, db DBTX, arg NodeUpsertParams)
 		arg.KernelID,
 		arg.NodeTypeID,
 		arg.Firmware,
+		arg.SMBIOSUUID,
+		arg.Inventory,
+		arg.Hardware,
+		arg.ClientCertFingerprint,
+		arg.ClientCertSerial,
+		arg.State,
 	)
 	var i Node
 	err := row.Scan(
@@ -528,6 +903,13 @@ func (q *Queries) NodeUpsert(ctx context.Context, db DBTX, arg NodeUpsertParams)
 		&i.Firmware,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.Revision,
+		&i.SMBIOSUUID,
+		&i.Inventory,
+		&i.Hardware,
+		&i.ClientCertFingerprint,
+		&i.ClientCertSerial,
+		&i.State,
 	)
 	return i, err
 }
@@ -570,6 +952,43 @@ func (q *Queries) TagID(ctx context.Context, db DBTX, tags []string) ([]int64, e
 	return items, nil
 }
 
+const tagNodeCount = `-- name: TagNodeCount :many
+select t.key, count(distinct nt.node_id) as nodes
+from tag as t
+left join node_tag as nt
+  on nt.tag_id = t.id
+group by t.id
+order by t.key
+`
+
+type TagNodeCountRow struct {
+	Key   string `json:"key"`
+	Nodes int64  `json:"nodes"`
+}
+
+func (q *Queries) TagNodeCount(ctx context.Context, db DBTX) ([]TagNodeCountRow, error) {
+	rows, err := db.QueryContext(ctx, tagNodeCount)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []TagNodeCountRow
+	for rows.Next() {
+		var i TagNodeCountRow
+		if err := rows.Scan(&i.Key, &i.Nodes); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const tagUpsert = `-- name: TagUpsert :one
 insert into tag (key)
 values (?1)
@@ -0,0 +1,67 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package sqlstore
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"syscall"
+)
+
+// ErrLocked is returned by Lock when the database is locked by another
+// process, such as a running Grendel server
+var ErrLocked = errors.New("database is locked by another process")
+
+// FileLock is an advisory lock on a database file
+type FileLock struct {
+	file *os.File
+}
+
+// Lock takes an exclusive lock on the database filename using a lock file
+// next to it. The lock is released by Unlock or when the process exits.
+// Returns ErrLocked without waiting if another process holds the lock.
+// Memory only databases are never locked
+func Lock(filename string) (*FileLock, error) {
+	return lock(filename, syscall.LOCK_EX)
+}
+
+// LockShared takes a shared lock on the database filename, held by the
+// grendel serve instances using it. Any number of processes hold the shared
+// lock at once, Lock fails while one does. Returns ErrLocked without waiting
+// if another process holds the exclusive lock
+func LockShared(filename string) (*FileLock, error) {
+	return lock(filename, syscall.LOCK_SH)
+}
+
+func lock(filename string, how int) (*FileLock, error) {
+	if filename == ":memory:" {
+		return &FileLock{}, nil
+	}
+
+	file, err := os.OpenFile(filename+".lock", os.O_CREATE|os.O_RDWR, 0600)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := syscall.Flock(int(file.Fd()), how|syscall.LOCK_NB); err != nil {
+		file.Close()
+		if errors.Is(err, syscall.EWOULDBLOCK) {
+			return nil, fmt.Errorf("%w: %s", ErrLocked, filename)
+		}
+		return nil, err
+	}
+
+	return &FileLock{file: file}, nil
+}
+
+// Unlock releases the lock
+func (l *FileLock) Unlock() error {
+	if l == nil || l.file == nil {
+		return nil
+	}
+
+	return l.file.Close()
+}
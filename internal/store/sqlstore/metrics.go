@@ -0,0 +1,201 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package sqlstore
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"strings"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/ubccr/grendel/internal/store/sqlstore/db"
+)
+
+var queryDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+	Name:    "grendel_store_query_duration_seconds",
+	Help:    "Time taken by datastore queries by query name, including reading the rows",
+	Buckets: []float64{.0001, .0005, .001, .005, .01, .05, .1, .5, 1, 5},
+}, []string{"query"})
+
+func init() {
+	prometheus.MustRegister(queryDuration)
+}
+
+// queryName returns the name of a sqlc query from its leading "-- name:"
+// comment. Other statements, such as migrations, are named other
+func queryName(query string) string {
+	rest, ok := strings.CutPrefix(query, "-- name: ")
+	if !ok {
+		return "other"
+	}
+
+	name, _, _ := strings.Cut(rest, " ")
+	return name
+}
+
+// openDB opens dsn with driverName, timing every query made on the
+// connections. Queries made on a postgres database are replaced by their
+// postgres versions
+func openDB(driverName, dsn string) (*sql.DB, error) {
+	// sql.Open does not connect, it only looks up the registered driver
+	probe, err := sql.Open(driverName, dsn)
+	if err != nil {
+		return nil, err
+	}
+	d := probe.Driver()
+	probe.Close()
+
+	return sql.OpenDB(&timedConnector{driver: d, dsn: dsn, postgres: driverName == PostgresDriver}), nil
+}
+
+type timedConnector struct {
+	driver   driver.Driver
+	dsn      string
+	postgres bool
+}
+
+func (c *timedConnector) Connect(ctx context.Context) (driver.Conn, error) {
+	conn, err := c.driver.Open(c.dsn)
+	if err != nil {
+		return nil, err
+	}
+
+	return &timedConn{Conn: conn, postgres: c.postgres}, nil
+}
+
+func (c *timedConnector) Driver() driver.Driver {
+	return c.driver
+}
+
+// timedConn observes the duration of the queries and statements executed on
+// a connection, including those made in transactions
+type timedConn struct {
+	driver.Conn
+	postgres bool
+}
+
+func (c *timedConn) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	execer, ok := c.Conn.(driver.ExecerContext)
+	if !ok {
+		return nil, driver.ErrSkip
+	}
+
+	name := queryName(query)
+	if c.postgres {
+		var err error
+		if query, args, err = postgresQuery(query, args); err != nil {
+			return nil, err
+		}
+	}
+
+	start := time.Now()
+	res, err := execer.ExecContext(ctx, query, args)
+	queryDuration.WithLabelValues(name).Observe(time.Since(start).Seconds())
+
+	return res, err
+}
+
+func (c *timedConn) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	queryer, ok := c.Conn.(driver.QueryerContext)
+	if !ok {
+		return nil, driver.ErrSkip
+	}
+
+	name := queryName(query)
+	if c.postgres {
+		var err error
+		if query, args, err = postgresQuery(query, args); err != nil {
+			return nil, err
+		}
+	}
+
+	start := time.Now()
+	rows, err := queryer.QueryContext(ctx, query, args)
+	if err != nil {
+		queryDuration.WithLabelValues(name).Observe(time.Since(start).Seconds())
+		return nil, err
+	}
+
+	return &timedRows{Rows: rows, name: name, start: start}, nil
+}
+
+func (c *timedConn) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	if p, ok := c.Conn.(driver.ConnPrepareContext); ok {
+		return p.PrepareContext(ctx, query)
+	}
+
+	return c.Conn.Prepare(query)
+}
+
+func (c *timedConn) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	if b, ok := c.Conn.(driver.ConnBeginTx); ok {
+		return b.BeginTx(ctx, opts)
+	}
+
+	return c.Conn.Begin()
+}
+
+func (c *timedConn) Ping(ctx context.Context) error {
+	if p, ok := c.Conn.(driver.Pinger); ok {
+		return p.Ping(ctx)
+	}
+
+	return nil
+}
+
+// timedRows observes the duration of a query once its rows are read
+type timedRows struct {
+	driver.Rows
+	name  string
+	start time.Time
+}
+
+func (r *timedRows) Close() error {
+	queryDuration.WithLabelValues(r.name).Observe(time.Since(r.start).Seconds())
+	return r.Rows.Close()
+}
+
+// Collector exports the number of hosts and boot images in a store
+type Collector struct {
+	s      *SqlStore
+	hosts  *prometheus.Desc
+	images *prometheus.Desc
+}
+
+// NewCollector returns a prometheus.Collector counting the hosts and boot
+// images of s on each scrape
+func NewCollector(s *SqlStore) *Collector {
+	return &Collector{
+		s: s,
+		hosts: prometheus.NewDesc("grendel_hosts",
+			"Number of hosts in the datastore", nil, nil),
+		images: prometheus.NewDesc("grendel_boot_images",
+			"Number of boot images in the datastore", nil, nil),
+	}
+}
+
+// Describe implements prometheus.Collector
+func (c *Collector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.hosts
+	ch <- c.images
+}
+
+// Collect implements prometheus.Collector
+func (c *Collector) Collect(ch chan<- prometheus.Metric) {
+	ctx := context.Background()
+	for _, m := range []struct {
+		desc  *prometheus.Desc
+		count func(context.Context, db.DBTX) (int64, error)
+	}{{c.hosts, c.s.q.NodeCount}, {c.images, c.s.q.KernelCount}} {
+		n, err := m.count(ctx, c.s.ro)
+		if err != nil {
+			ch <- prometheus.NewInvalidMetric(m.desc, err)
+			continue
+		}
+		ch <- prometheus.MustNewConstMetric(m.desc, prometheus.GaugeValue, float64(n))
+	}
+}
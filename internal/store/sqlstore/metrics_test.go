@@ -0,0 +1,67 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package sqlstore
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	dto "github.com/prometheus/client_model/go"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/ubccr/grendel/internal/store"
+	"github.com/ubccr/grendel/internal/tests"
+	"github.com/ubccr/grendel/pkg/model"
+)
+
+func TestMetrics(t *testing.T) {
+	store.Log.Logger.SetLevel(logrus.ErrorLevel)
+
+	db, err := New(":memory:")
+	require.NoError(t, err)
+	defer db.Close()
+
+	require.NoError(t, db.StoreHosts(model.HostList{
+		tests.HostFactory.MustCreate().(*model.Host),
+		tests.HostFactory.MustCreate().(*model.Host),
+	}))
+	require.NoError(t, db.StoreBootImage(tests.BootImageFactory.MustCreate().(*model.BootImage)))
+
+	err = testutil.CollectAndCompare(NewCollector(db), strings.NewReader(`
+# HELP grendel_hosts Number of hosts in the datastore
+# TYPE grendel_hosts gauge
+grendel_hosts 2
+# HELP grendel_boot_images Number of boot images in the datastore
+# TYPE grendel_boot_images gauge
+grendel_boot_images 1
+`))
+	assert.NoError(t, err)
+
+	// Queries are timed by their sqlc name
+	_, err = db.LoadHostFromName("missing")
+	assert.ErrorIs(t, err, store.ErrNotFound)
+	assert.Contains(t, histogramLabels(t), "NodeFetchByName")
+	assert.Contains(t, histogramLabels(t), "NodeCount")
+
+	assert.Equal(t, "KernelAll", queryName("-- name: KernelAll :many\nselect * from kernel_view"))
+	assert.Equal(t, "other", queryName("PRAGMA foreign_keys"))
+}
+
+func histogramLabels(t *testing.T) []string {
+	names := []string{}
+	ch := make(chan prometheus.Metric, 1024)
+	queryDuration.Collect(ch)
+	close(ch)
+	for m := range ch {
+		var pb dto.Metric
+		require.NoError(t, m.Write(&pb))
+		names = append(names, pb.GetLabel()[0].GetValue())
+	}
+
+	return names
+}
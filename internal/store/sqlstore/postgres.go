@@ -0,0 +1,137 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package sqlstore
+
+import (
+	"bufio"
+	"database/sql/driver"
+	"embed"
+	"fmt"
+	"io/fs"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/lib/pq"
+)
+
+// The queries of query/postgres are the postgres versions of the sqlite
+// queries sqlc generates the db package from, with the same names. The
+// numbered parameters of a sqlite query keep their numbers and each
+// sqlc.slice is one array parameter numbered after them, in order
+//
+//go:embed query/postgres/*.sql
+var postgresQueryFiles embed.FS
+
+var (
+	numberedParam = regexp.MustCompile(`\?(\d+)`)
+
+	// sliceParam matches a sqlc.slice once sqlc expanded it to a list of
+	// parameters, or to NULL when empty
+	sliceParam = regexp.MustCompile(`in \((NULL|\?(?:,\?)*)\)`)
+)
+
+// postgresQueries returns the queries of query/postgres by name
+var postgresQueries = sync.OnceValues(func() (map[string]string, error) {
+	files, err := fs.Glob(postgresQueryFiles, "query/postgres/*.sql")
+	if err != nil {
+		return nil, err
+	}
+
+	queries := make(map[string]string)
+	for _, file := range files {
+		data, err := postgresQueryFiles.ReadFile(file)
+		if err != nil {
+			return nil, err
+		}
+
+		var name string
+		var query strings.Builder
+		add := func() {
+			if name != "" {
+				queries[name] = strings.TrimSpace(query.String())
+			}
+		}
+		scanner := bufio.NewScanner(strings.NewReader(string(data)))
+		for scanner.Scan() {
+			line := scanner.Text()
+			if strings.HasPrefix(line, "-- name: ") {
+				add()
+				name = queryName(line)
+				query.Reset()
+			}
+			if name != "" {
+				query.WriteString(line + "\n")
+			}
+		}
+		add()
+	}
+
+	return queries, nil
+})
+
+// postgresQuery returns the postgres version of a sqlite query generated by
+// sqlc and its arguments. Statements not generated by sqlc, such as those of
+// the migrations, are returned unchanged
+func postgresQuery(query string, args []driver.NamedValue) (string, []driver.NamedValue, error) {
+	name := queryName(query)
+	if name == "other" {
+		return query, args, nil
+	}
+
+	queries, err := postgresQueries()
+	if err != nil {
+		return "", nil, err
+	}
+	pgQuery, ok := queries[name]
+	if !ok {
+		return "", nil, fmt.Errorf("query %s has no postgres version", name)
+	}
+
+	numbered := 0
+	for _, m := range numberedParam.FindAllStringSubmatch(query, -1) {
+		n, _ := strconv.Atoi(m[1])
+		numbered = max(numbered, n)
+	}
+	if numbered > len(args) {
+		return "", nil, fmt.Errorf("query %s: got %d arguments, want at least %d", name, len(args), numbered)
+	}
+
+	pgArgs := make([]driver.NamedValue, 0, numbered)
+	pgArgs = append(pgArgs, args[:numbered]...)
+	rest := args[numbered:]
+	for _, m := range sliceParam.FindAllStringSubmatch(query, -1) {
+		// An empty slice is NULL in both databases, matching no rows
+		var value driver.Value
+		if m[1] != "NULL" {
+			n := strings.Count(m[1], "?")
+			if n > len(rest) {
+				return "", nil, fmt.Errorf("query %s: missing arguments of a slice", name)
+			}
+
+			values := make([]any, n)
+			for i, arg := range rest[:n] {
+				values[i] = arg.Value
+			}
+			rest = rest[n:]
+
+			if value, err = pq.Array(values).Value(); err != nil {
+				return "", nil, fmt.Errorf("query %s: %w", name, err)
+			}
+		}
+		pgArgs = append(pgArgs, driver.NamedValue{Value: value})
+	}
+	if len(rest) > 0 {
+		return "", nil, fmt.Errorf("query %s: got %d unused arguments", name, len(rest))
+	}
+
+	for i := range pgArgs {
+		pgArgs[i].Name = ""
+		pgArgs[i].Ordinal = i + 1
+	}
+
+	return pgQuery, pgArgs, nil
+}
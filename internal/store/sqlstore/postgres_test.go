@@ -0,0 +1,122 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package sqlstore
+
+import (
+	"database/sql/driver"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPostgresQueries(t *testing.T) {
+	queries, err := postgresQueries()
+	require.NoError(t, err)
+
+	files, err := filepath.Glob("db/*.sql.go")
+	require.NoError(t, err)
+
+	generated := regexp.MustCompile("(?s)const \\w+ = `(-- name: .*?)`")
+	pgParam := regexp.MustCompile(`\$(\d+)`)
+	count := 0
+	for _, file := range files {
+		data, err := os.ReadFile(file)
+		require.NoError(t, err)
+
+		for _, m := range generated.FindAllStringSubmatch(string(data), -1) {
+			name := queryName(m[1])
+			count++
+
+			pgQuery, ok := queries[name]
+			if !assert.True(t, ok, "query %s has no postgres version", name) {
+				continue
+			}
+
+			// Every sqlite parameter and slice has a postgres parameter
+			numbered := 0
+			for _, p := range numberedParam.FindAllStringSubmatch(m[1], -1) {
+				n, _ := strconv.Atoi(p[1])
+				numbered = max(numbered, n)
+			}
+			want := numbered + len(regexp.MustCompile(`/\*SLICE:\w+\*/\?`).FindAllString(m[1], -1))
+
+			used := make(map[int]bool)
+			for _, p := range pgParam.FindAllStringSubmatch(pgQuery, -1) {
+				n, _ := strconv.Atoi(p[1])
+				used[n] = true
+			}
+			assert.Len(t, used, want, name)
+			for n := 1; n <= want; n++ {
+				assert.True(t, used[n], "query %s does not use $%d", name, n)
+			}
+		}
+	}
+
+	assert.NotZero(t, count)
+	assert.Len(t, queries, count)
+}
+
+func TestPostgresQuery(t *testing.T) {
+	args := func(values ...driver.Value) []driver.NamedValue {
+		named := make([]driver.NamedValue, len(values))
+		for i, v := range values {
+			named[i] = driver.NamedValue{Ordinal: i + 1, Value: v}
+		}
+		return named
+	}
+
+	query := `-- name: NodeBootKernelIfCurrent :execrows
+update node set kernel_id = ?1, revision = revision + 1
+where id in (?,?,?)
+  and coalesce((select k.name from kernel as k where k.id = node.kernel_id), '') in (?,?)
+`
+	pgQuery, pgArgs, err := postgresQuery(query, args(int64(7), int64(1), int64(2), int64(3), "", "a,\"b\""))
+	require.NoError(t, err)
+	assert.Contains(t, pgQuery, "-- name: NodeBootKernelIfCurrent :execrows")
+	assert.Contains(t, pgQuery, "id = any($2::bigint[])")
+	assert.Equal(t, args(int64(7), "{1,2,3}", `{"","a,\"b\""}`), pgArgs)
+
+	// An empty slice matches nothing
+	query = `-- name: NodeTagUpsertDelete :exec
+delete from node_tag where node_id in (?) and tag_id not in (NULL)
+`
+	_, pgArgs, err = postgresQuery(query, args(int64(1)))
+	require.NoError(t, err)
+	assert.Equal(t, args("{1}", nil), pgArgs)
+
+	// A parameter used twice is passed once
+	query = `-- name: NodeResolveFQDN :many
+select nc.fqdn from nic_fqdn as f where f.fqdn = ?1 union all select a.fqdn from nic_address as a where a.name = ?1
+`
+	_, pgArgs, err = postgresQuery(query, args("cpn-01"))
+	require.NoError(t, err)
+	assert.Equal(t, args("cpn-01"), pgArgs)
+
+	// Migrations are not sqlc queries
+	pgQuery, pgArgs, err = postgresQuery("SELECT version, dirty FROM schema_migrations LIMIT 1", nil)
+	require.NoError(t, err)
+	assert.Equal(t, "SELECT version, dirty FROM schema_migrations LIMIT 1", pgQuery)
+	assert.Empty(t, pgArgs)
+
+	_, _, err = postgresQuery("-- name: NoSuchQuery :exec\nselect 1", nil)
+	assert.Error(t, err)
+
+	_, _, err = postgresQuery(query, nil)
+	assert.Error(t, err)
+}
+
+func TestIsPostgresDSN(t *testing.T) {
+	for _, dsn := range []string{"postgres://grendel@db.example.com/grendel", "postgresql:///grendel?host=/run/postgresql"} {
+		assert.True(t, IsPostgresDSN(dsn), dsn)
+	}
+	for _, dsn := range []string{":memory:", "/var/lib/grendel/grendel.db", "file:/var/lib/grendel/grendel.db?mode=rw", "mysql://db.example.com/grendel"} {
+		assert.False(t, IsPostgresDSN(dsn), dsn)
+	}
+}
@@ -0,0 +1,19 @@
+/*
+ * SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+ *
+ * SPDX-License-Identifier: GPL-3.0-or-later
+ */
+
+-- name: ChangeFind :many
+select * from change_journal
+where seq > @since
+order by seq
+limit @limit;
+
+-- name: ChangeSeq :one
+select
+  cast(coalesce((select min(seq) from change_journal), 0) as integer) as oldest,
+  cast(coalesce((select seq from sqlite_sequence where name = 'change_journal'), 0) as integer) as latest;
+
+-- name: ChangePurge :execrows
+delete from change_journal where changed_at <= @before;
@@ -0,0 +1,54 @@
+/*
+ * SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+ *
+ * SPDX-License-Identifier: GPL-3.0-or-later
+ */
+
+-- name: CheckNicFQDNIndex :one
+select
+  (select count(*) from (
+    select nic_id, fqdn from nic_fqdn
+    except
+    select nc.id, lower(rtrim(trim(j.value), '.'))
+    from nic as nc, json_each('[' || replace(json_quote(coalesce(nc.fqdn, '')), ',', '","') || ']') as j
+    where trim(j.value) != ''
+  )) as stale,
+  (select count(*) from (
+    select nc.id, lower(rtrim(trim(j.value), '.'))
+    from nic as nc, json_each('[' || replace(json_quote(coalesce(nc.fqdn, '')), ',', '","') || ']') as j
+    where trim(j.value) != ''
+    except
+    select nic_id, fqdn from nic_fqdn
+  )) as missing;
+
+-- name: CheckNicAddressIndex :one
+select
+  (select count(*) from (
+    select nic_id, ip, addr, fqdn, name from nic_address
+    except
+    select nc.id, json_extract(a.value, '$.ip'),
+           substring(json_extract(a.value, '$.ip'), 0, instr(json_extract(a.value, '$.ip'), '/')),
+           json_extract(a.value, '$.fqdn'), lower(rtrim(trim(j.value), '.'))
+    from nic as nc,
+         json_each(coalesce(nc.addresses, '[]')) as a,
+         json_each('[' || replace(json_quote(coalesce(json_extract(a.value, '$.fqdn'), '')), ',', '","') || ']') as j
+    where coalesce(json_extract(a.value, '$.ip'), '') != ''
+  )) as stale,
+  (select count(*) from (
+    select nc.id, json_extract(a.value, '$.ip'),
+           substring(json_extract(a.value, '$.ip'), 0, instr(json_extract(a.value, '$.ip'), '/')),
+           json_extract(a.value, '$.fqdn'), lower(rtrim(trim(j.value), '.'))
+    from nic as nc,
+         json_each(coalesce(nc.addresses, '[]')) as a,
+         json_each('[' || replace(json_quote(coalesce(json_extract(a.value, '$.fqdn'), '')), ',', '","') || ']') as j
+    where coalesce(json_extract(a.value, '$.ip'), '') != ''
+    except
+    select nic_id, ip, addr, fqdn, name from nic_address
+  )) as missing;
+
+-- name: CheckNics :many
+select n.name as node_name, nc.name, nc.mac, nc.ip
+from nic as nc
+join node as n
+  on n.id = nc.node_id
+order by n.name, nc.id;
@@ -0,0 +1,34 @@
+/*
+ * SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+ *
+ * SPDX-License-Identifier: GPL-3.0-or-later
+ */
+
+-- name: NodeCredentialAll :many
+select n.name, c.kind, c.secret
+from node_credential as c
+join node as n on n.id = c.node_id
+order by n.name, c.kind;
+
+-- name: NodeCredentialFind :many
+select n.name, c.kind, c.secret
+from node_credential as c
+join node as n on n.id = c.node_id
+where n.name in (sqlc.slice(nodeset))
+order by n.name, c.kind;
+
+-- name: NodeCredentialFetch :one
+select n.name, c.kind, c.secret
+from node_credential as c
+join node as n on n.id = c.node_id
+where n.name = @name and c.kind = @kind;
+
+-- name: NodeCredentialUpsert :execrows
+insert into node_credential (node_id, kind, secret)
+select id, @kind, @secret from node where name = @name
+on conflict (node_id, kind)
+do update set secret = ?2;
+
+-- name: NodeCredentialDelete :execrows
+delete from node_credential
+where kind = @kind and node_id in (select id from node where name in (sqlc.slice(nodeset)));
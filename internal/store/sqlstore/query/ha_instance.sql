@@ -0,0 +1,17 @@
+/*
+ * SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+ *
+ * SPDX-License-Identifier: GPL-3.0-or-later
+ */
+
+-- name: HAInstanceUpsert :exec
+insert into ha_instance (name, role, state, heartbeat, changed)
+values (@name, @role, @state, @heartbeat, @changed)
+on conflict (name) do update set
+  role = excluded.role,
+  state = excluded.state,
+  heartbeat = excluded.heartbeat,
+  changed = excluded.changed;
+
+-- name: HAInstanceList :many
+select * from ha_instance order by name;
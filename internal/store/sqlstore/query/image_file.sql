@@ -0,0 +1,16 @@
+/*
+ * SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+ *
+ * SPDX-License-Identifier: GPL-3.0-or-later
+ */
+
+-- name: ImageFileFind :many
+select path from kernel
+union
+select path from initrd
+union
+select path from image_file where released_at >= @since
+order by path;
+
+-- name: ImageFilePurge :execrows
+delete from image_file where released_at <= @before;
@@ -0,0 +1,36 @@
+/*
+ * SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+ *
+ * SPDX-License-Identifier: GPL-3.0-or-later
+ */
+
+-- name: IndexVersion :one
+select version from store_index where name = @name;
+
+-- name: IndexVersionSet :exec
+insert into store_index (name, version)
+values (@name, @version)
+on conflict (name)
+do update set version = ?2;
+
+-- name: NicFQDNDeleteAll :exec
+delete from nic_fqdn;
+
+-- name: NicFQDNRebuild :exec
+insert or ignore into nic_fqdn (nic_id, fqdn)
+select nc.id, lower(rtrim(trim(j.value), '.'))
+from nic as nc, json_each('[' || replace(json_quote(coalesce(nc.fqdn, '')), ',', '","') || ']') as j
+where trim(j.value) != '';
+
+-- name: NicAddressDeleteAll :exec
+delete from nic_address;
+
+-- name: NicAddressRebuild :exec
+insert or ignore into nic_address (nic_id, ip, addr, fqdn, name)
+select nc.id, json_extract(a.value, '$.ip'),
+       substring(json_extract(a.value, '$.ip'), 0, instr(json_extract(a.value, '$.ip'), '/')),
+       json_extract(a.value, '$.fqdn'), lower(rtrim(trim(j.value), '.'))
+from nic as nc,
+     json_each(coalesce(nc.addresses, '[]')) as a,
+     json_each('[' || replace(json_quote(coalesce(json_extract(a.value, '$.fqdn'), '')), ',', '","') || ']') as j
+where coalesce(json_extract(a.value, '$.ip'), '') != '';
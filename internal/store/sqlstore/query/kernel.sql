@@ -10,13 +10,44 @@ select * from kernel_view where name = @name;
 -- name: KernelAll :many
 select * from kernel_view;
 
+-- name: KernelCount :one
+select count(*) from kernel;
+
+-- name: KernelNodeCount :many
+select k.name, count(n.id) as nodes
+from kernel as k
+left join node as n
+  on n.kernel_id = k.id
+group by k.id
+order by k.name;
+
+-- name: KernelRevision :one
+select revision from kernel where id = @id;
+
 -- name: KernelUpsert :one
 insert into kernel (id, uid, name, version, path, arch_id, command_line, verify)
 values (sqlc.narg(id), @uid, @name, @version, @path, @arch_id, @command_line, @verify)
 on conflict (id)
-do update set uid = ?2, name = ?3, version = ?4, path = ?5, arch_id = ?6, command_line = ?7, verify = ?8
+do update set uid = ?2, name = ?3, version = ?4, path = ?5, arch_id = ?6, command_line = ?7, verify = ?8, revision = kernel.revision + 1
 returning *;
 
+-- name: KernelID :one
+select id from kernel where name = @name;
+
+-- name: KernelParent :one
+select parent_id from kernel where id = @id;
+
+-- name: KernelSetParent :exec
+update kernel set parent_id = sqlc.narg(parent_id) where id = @id;
+
+-- name: KernelChildren :many
+select c.name, p.name as parent
+from kernel as c
+join kernel as p
+  on c.parent_id = p.id
+where p.name in (sqlc.slice(names))
+order by c.name;
+
 -- name: InitrdUpsert :one
 insert into initrd (kernel_id, path)
 values (@kernel_id, @path)
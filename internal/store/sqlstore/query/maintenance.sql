@@ -0,0 +1,17 @@
+/*
+ * SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+ *
+ * SPDX-License-Identifier: GPL-3.0-or-later
+ */
+
+-- name: MaintenanceUpsert :exec
+insert into maintenance (id, enabled, reason, changed_by, changed)
+values (1, @enabled, @reason, @changed_by, @changed)
+on conflict (id) do update set
+  enabled = excluded.enabled,
+  reason = excluded.reason,
+  changed_by = excluded.changed_by,
+  changed = excluded.changed;
+
+-- name: MaintenanceGet :one
+select * from maintenance where id = 1;
@@ -5,11 +5,12 @@
  */
 
 -- name: NicUpsert :one
-insert into nic (id, node_id, nic_type, name, vlan, fqdn, mac, ip, peers, mtu)
-values (sqlc.narg(id), @node_id, @nic_type, @name, @vlan, @fqdn, @mac, @ip, @peers, @mtu)
+insert into nic (id, node_id, nic_type, name, vlan, fqdn, mac, ip, peers, mtu, switch, port, parent, mode, options, addresses)
+values (sqlc.narg(id), @node_id, @nic_type, @name, @vlan, @fqdn, @mac, @ip, @peers, @mtu, @switch, @port, @parent, @mode, @options, @addresses)
 on conflict (id)
 do update set nic_type = ?3, name = ?4, vlan = ?5, fqdn = ?6, mac = ?7, ip = ?8,
-              peers = ?9, mtu = ?10
+              peers = ?9, mtu = ?10, switch = ?11, port = ?12, parent = ?13,
+              mode = ?14, options = ?15, addresses = ?16
 returning *;
 
 -- name: NicUpsertDelete :exec
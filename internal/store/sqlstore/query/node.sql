@@ -7,6 +7,21 @@
 -- name: NodeCount :one
 select count(*) from node;
 
+-- name: NodeStats :one
+select
+  count(*) as total,
+  cast(coalesce(sum(provision), 0) as integer) as provisioning,
+  count(*) - count(kernel_id) as without_kernel
+from node;
+
+-- name: TagNodeCount :many
+select t.key, count(distinct nt.node_id) as nodes
+from tag as t
+left join node_tag as nt
+  on nt.tag_id = t.id
+group by t.id
+order by t.key;
+
 -- name: NodeFetchByID :one
 select * from node_view where id = @id;
 
@@ -16,28 +31,77 @@ select * from node_view where uid = @uid;
 -- name: NodeFetchByName :one
 select * from node_view where name = @name;
 
--- name: NodeFind :one
-select n.* 
+-- name: NodeFetchBySMBIOSUUID :one
+select n.*
+from node_view as n
+join node as nd
+  on nd.id = n.id
+where nd.smbios_uuid = @smbios_uuid
+limit 1;
+
+-- name: NodeFindByMAC :one
+select n.*
 from node_view as n
 join nic as nc
 on nc.node_id = n.id
-where 
-  case 
-    when cast(@filter_mac as integer) then nc.mac = @mac
-    when cast(@filter_ip as integer) then nc.ip = @ip
-    else 0
-  end
+where nc.mac = @mac
 limit 1;
 
--- name: NodeResolve :many
-select nc.fqdn, nc.ip 
+-- name: NodeFindByFQDNOrIP :many
+select * from node_view
+where id in (
+  select nc.node_id
+  from nic_fqdn as f
+  join nic as nc
+    on nc.id = f.nic_id
+  where f.fqdn in (sqlc.slice(names))
+  union
+  select nc.node_id
+  from nic as nc
+  where cast(substring(nc.ip, 0, instr(nc.ip, '/')) as text) in (sqlc.slice(addrs))
+);
+
+-- name: NodeFindBySecondaryAddress :many
+select * from node_view
+where id in (
+  select nc.node_id
+  from nic_address as a
+  join nic as nc
+    on nc.id = a.nic_id
+  where a.name in (sqlc.slice(names)) or a.addr in (sqlc.slice(addrs))
+);
+
+-- name: NodeResolveFQDN :many
+select nc.fqdn, nc.ip, n.state
+from nic_fqdn as f
+join nic as nc
+  on nc.id = f.nic_id
+join node as n
+  on n.id = nc.node_id
+where f.fqdn = @fqdn
+union all
+select a.fqdn, a.ip, n.state
+from nic_address as a
+join nic as nc
+  on nc.id = a.nic_id
+join node as n
+  on n.id = nc.node_id
+where a.name = @fqdn;
+
+-- name: NodeResolveIP :many
+select nc.fqdn, nc.ip, n.state
 from nic as nc
-where 
-  case 
-    when cast(@filter_fqdn as integer) then lower(nc.fqdn) like concat('%', cast(@fqdn as text), '%')
-    when cast(@filter_ip as integer) then substring(nc.ip, 0, instr(nc.ip, '/')) = cast(@ip as text)
-    else 0
-  end;
+join node as n
+  on n.id = nc.node_id
+where cast(substring(nc.ip, 0, instr(nc.ip, '/')) as text) = cast(@ip as text)
+union all
+select distinct a.fqdn, a.ip, n.state
+from nic_address as a
+join nic as nc
+  on nc.id = a.nic_id
+join node as n
+  on n.id = nc.node_id
+where a.addr = cast(@ip as text);
 
 -- name: NodeAll :many
 select * from node_view;
@@ -69,20 +133,51 @@ where
 group by name;
 
 -- name: NodeProvision :exec
-update node set provision = @provision
+update node set provision = @provision, state = @state, revision = revision + 1
 where id in (sqlc.slice(nodes));
 
+-- name: NodeState :one
+select name, state from node where id = @id;
+
 -- name: NodeBootKernel :exec
-update node set kernel_id = @kernel_id
+update node set kernel_id = @kernel_id, revision = revision + 1
+where id in (sqlc.slice(nodes));
+
+-- name: NodeBootKernelIfCurrent :execrows
+update node set kernel_id = @kernel_id, revision = revision + 1
+where id in (sqlc.slice(nodes))
+  and coalesce((select k.name from kernel as k where k.id = node.kernel_id), '') in (sqlc.slice(current));
+
+-- name: NodeFirmware :exec
+update node set firmware = @firmware, revision = revision + 1
+where id in (sqlc.slice(nodes));
+
+-- name: NodeRevision :one
+select revision from node where id = @id;
+
+-- name: NodeRevisionIncrement :exec
+update node set revision = revision + 1
 where id in (sqlc.slice(nodes));
 
 -- name: NodeUpsert :one
-insert into node (id, uid, name, provision, arch_id, kernel_id, node_type_id, firmware)
-values (sqlc.narg('id'), @uid, @name, @provision, @arch_id, @kernel_id, @node_type_id, @firmware)
+insert into node (id, uid, name, provision, arch_id, kernel_id, node_type_id, firmware, smbios_uuid, inventory, hardware, client_cert_fingerprint, client_cert_serial, state)
+values (sqlc.narg('id'), @uid, @name, @provision, @arch_id, @kernel_id, @node_type_id, @firmware, @smbios_uuid, @inventory, @hardware, @client_cert_fingerprint, @client_cert_serial, @state)
 on conflict (id)
-do update set name = ?3, provision = ?4, arch_id = ?5, kernel_id = ?6, node_type_id = ?7, firmware = ?8
+do update set name = ?3, provision = ?4, arch_id = ?5, kernel_id = ?6, node_type_id = ?7, firmware = ?8, smbios_uuid = ?9, inventory = coalesce(?10, node.inventory), hardware = coalesce(?11, node.hardware), client_cert_fingerprint = coalesce(?12, node.client_cert_fingerprint), client_cert_serial = coalesce(?13, node.client_cert_serial), state = ?14, revision = node.revision + 1
 returning *;
 
+-- name: NodeClientCertSet :execrows
+update node set client_cert_fingerprint = @client_cert_fingerprint, client_cert_serial = @client_cert_serial
+where name = @name;
+
+-- name: NodeHardwareSet :execrows
+update node set hardware = @hardware
+where name = @name;
+
+-- name: NodeInventorySet :execrows
+update node set inventory = @inventory
+where name = @name;
+
 -- name: NodeDelete :exec
 delete from node where name in (sqlc.slice(nodeset));
 
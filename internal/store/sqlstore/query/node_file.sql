@@ -0,0 +1,34 @@
+/*
+ * SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+ *
+ * SPDX-License-Identifier: GPL-3.0-or-later
+ */
+
+-- name: NodeFileAll :many
+select n.name as host, f.name, length(f.data) as size, f.updated_at
+from node_file as f
+join node as n on n.id = f.node_id
+order by n.name, f.name;
+
+-- name: NodeFileFind :many
+select n.name as host, f.name, length(f.data) as size, f.updated_at
+from node_file as f
+join node as n on n.id = f.node_id
+where n.name in (sqlc.slice(nodeset))
+order by n.name, f.name;
+
+-- name: NodeFileFetch :one
+select n.name as host, f.name, f.data, f.updated_at
+from node_file as f
+join node as n on n.id = f.node_id
+where n.name = @host and f.name = @name;
+
+-- name: NodeFileUpsert :execrows
+insert into node_file (node_id, name, data)
+select id, @name, @data from node where name = @host
+on conflict (node_id, name)
+do update set data = ?2;
+
+-- name: NodeFileDelete :execrows
+delete from node_file
+where name = @name and node_id in (select id from node where name in (sqlc.slice(nodeset)));
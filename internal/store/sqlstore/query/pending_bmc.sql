@@ -0,0 +1,20 @@
+/*
+ * SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+ *
+ * SPDX-License-Identifier: GPL-3.0-or-later
+ */
+
+-- name: PendingBMCAll :many
+select * from pending_bmc order by first_seen, mac;
+
+-- name: PendingBMCFetch :one
+select * from pending_bmc where mac = @mac;
+
+-- name: PendingBMCUpsert :exec
+insert into pending_bmc (mac, vendor_class, relay, server_ip, first_seen, last_seen)
+values (@mac, @vendor_class, @relay, @server_ip, @seen, @seen)
+on conflict (mac)
+do update set vendor_class = excluded.vendor_class, relay = excluded.relay, server_ip = excluded.server_ip, last_seen = excluded.last_seen;
+
+-- name: PendingBMCDelete :execrows
+delete from pending_bmc where mac = @mac;
@@ -0,0 +1,19 @@
+/*
+ * SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+ *
+ * SPDX-License-Identifier: GPL-3.0-or-later
+ */
+
+-- name: ChangeFind :many
+select * from change_journal
+where seq > $1
+order by seq
+limit $2;
+
+-- name: ChangeSeq :one
+select
+  coalesce((select min(seq) from change_journal), 0)::bigint as oldest,
+  (select case when is_called then last_value else 0 end from change_journal_seq_seq)::bigint as latest;
+
+-- name: ChangePurge :execrows
+delete from change_journal where changed_at <= $1;
@@ -0,0 +1,50 @@
+/*
+ * SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+ *
+ * SPDX-License-Identifier: GPL-3.0-or-later
+ */
+
+-- name: CheckNicFQDNIndex :one
+select
+  (select count(*) from (
+    select nic_id, fqdn from nic_fqdn
+    except
+    select nc.id, lower(rtrim(trim(f), '.'))
+    from nic as nc, regexp_split_to_table(coalesce(nc.fqdn, ''), ',') as f
+    where trim(f) != ''
+  ) as s) as stale,
+  (select count(*) from (
+    select nc.id, lower(rtrim(trim(f), '.'))
+    from nic as nc, regexp_split_to_table(coalesce(nc.fqdn, ''), ',') as f
+    where trim(f) != ''
+    except
+    select nic_id, fqdn from nic_fqdn
+  ) as m) as missing;
+
+-- name: CheckNicAddressIndex :one
+select
+  (select count(*) from (
+    select nic_id, ip, addr, fqdn, name from nic_address
+    except
+    select nc.id, a->>'ip', split_part(a->>'ip', '/', 1), a->>'fqdn', lower(rtrim(trim(f), '.'))
+    from nic as nc,
+         json_array_elements(coalesce(nc.addresses, '[]')::json) as a,
+         regexp_split_to_table(coalesce(a->>'fqdn', ''), ',') as f
+    where coalesce(a->>'ip', '') != ''
+  ) as s) as stale,
+  (select count(*) from (
+    select nc.id, a->>'ip', split_part(a->>'ip', '/', 1), a->>'fqdn', lower(rtrim(trim(f), '.'))
+    from nic as nc,
+         json_array_elements(coalesce(nc.addresses, '[]')::json) as a,
+         regexp_split_to_table(coalesce(a->>'fqdn', ''), ',') as f
+    where coalesce(a->>'ip', '') != ''
+    except
+    select nic_id, ip, addr, fqdn, name from nic_address
+  ) as m) as missing;
+
+-- name: CheckNics :many
+select n.name as node_name, nc.name, nc.mac, nc.ip
+from nic as nc
+join node as n
+  on n.id = nc.node_id
+order by n.name, nc.id;
@@ -0,0 +1,34 @@
+/*
+ * SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+ *
+ * SPDX-License-Identifier: GPL-3.0-or-later
+ */
+
+-- name: NodeCredentialAll :many
+select n.name, c.kind, c.secret
+from node_credential as c
+join node as n on n.id = c.node_id
+order by n.name, c.kind;
+
+-- name: NodeCredentialFind :many
+select n.name, c.kind, c.secret
+from node_credential as c
+join node as n on n.id = c.node_id
+where n.name = any($1::text[])
+order by n.name, c.kind;
+
+-- name: NodeCredentialFetch :one
+select n.name, c.kind, c.secret
+from node_credential as c
+join node as n on n.id = c.node_id
+where n.name = $1 and c.kind = $2;
+
+-- name: NodeCredentialUpsert :execrows
+insert into node_credential (node_id, kind, secret)
+select id, $1::text, $2::text from node where name = $3
+on conflict (node_id, kind)
+do update set secret = $2;
+
+-- name: NodeCredentialDelete :execrows
+delete from node_credential
+where kind = $1 and node_id in (select id from node where name = any($2::text[]));
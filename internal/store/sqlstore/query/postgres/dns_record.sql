@@ -0,0 +1,27 @@
+/*
+ * SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+ *
+ * SPDX-License-Identifier: GPL-3.0-or-later
+ */
+
+-- name: DNSRecordAll :many
+select * from dns_record order by name, type, value;
+
+-- name: DNSRecordFetchName :many
+select * from dns_record where name = $1;
+
+-- name: DNSRecordFetchPTR :many
+select * from dns_record where type = 'A' and ptr and value = $1;
+
+-- name: DNSRecordUpsert :one
+insert into dns_record (name, type, value, ttl, ptr)
+values ($1, $2, $3, $4, $5)
+on conflict (name, type, value)
+do update set ttl = $4, ptr = $5
+returning *;
+
+-- name: DNSRecordDelete :exec
+delete from dns_record where name = any($1::text[]);
+
+-- name: DNSRecordDeleteID :exec
+delete from dns_record where id = any($1::bigint[]);
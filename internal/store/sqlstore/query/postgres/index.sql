@@ -0,0 +1,36 @@
+/*
+ * SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+ *
+ * SPDX-License-Identifier: GPL-3.0-or-later
+ */
+
+-- name: IndexVersion :one
+select version from store_index where name = $1;
+
+-- name: IndexVersionSet :exec
+insert into store_index (name, version)
+values ($1, $2)
+on conflict (name)
+do update set version = $2;
+
+-- name: NicFQDNDeleteAll :exec
+delete from nic_fqdn;
+
+-- name: NicFQDNRebuild :exec
+insert into nic_fqdn (nic_id, fqdn)
+select nc.id, lower(rtrim(trim(f), '.'))
+from nic as nc, regexp_split_to_table(coalesce(nc.fqdn, ''), ',') as f
+where trim(f) != ''
+on conflict do nothing;
+
+-- name: NicAddressDeleteAll :exec
+delete from nic_address;
+
+-- name: NicAddressRebuild :exec
+insert into nic_address (nic_id, ip, addr, fqdn, name)
+select nc.id, a->>'ip', split_part(a->>'ip', '/', 1), a->>'fqdn', lower(rtrim(trim(f), '.'))
+from nic as nc,
+     json_array_elements(coalesce(nc.addresses, '[]')::json) as a,
+     regexp_split_to_table(coalesce(a->>'fqdn', ''), ',') as f
+where coalesce(a->>'ip', '') != ''
+on conflict do nothing;
@@ -0,0 +1,34 @@
+/*
+ * SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+ *
+ * SPDX-License-Identifier: GPL-3.0-or-later
+ */
+
+-- name: IPReservationAdd :execrows
+insert into ip_reservation (ip, kind, host, username, created, expires)
+values ($1, $2, $3, $4, $5, $6)
+on conflict (ip) do update set
+  kind = excluded.kind,
+  host = excluded.host,
+  username = excluded.username,
+  created = excluded.created,
+  expires = excluded.expires
+where ip_reservation.expires <= excluded.created;
+
+-- name: IPReservationUpsert :exec
+insert into ip_reservation (ip, kind, host, username, created, expires)
+values ($1, $2, $3, $4, $5, $6)
+on conflict (ip) do update set
+  kind = excluded.kind,
+  host = excluded.host,
+  username = excluded.username,
+  created = excluded.created,
+  expires = excluded.expires;
+
+-- name: IPReservationList :many
+select * from ip_reservation
+order by created;
+
+-- name: IPReservationDelete :execrows
+delete from ip_reservation
+where ip = $1;
@@ -0,0 +1,85 @@
+/*
+ * SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+ *
+ * SPDX-License-Identifier: GPL-3.0-or-later
+ */
+
+-- name: KernelFetch :one
+select * from kernel_view where name = $1;
+
+-- name: KernelAll :many
+select * from kernel_view;
+
+-- name: KernelCount :one
+select count(*) from kernel;
+
+-- name: KernelNodeCount :many
+select k.name, count(n.id) as nodes
+from kernel as k
+left join node as n
+  on n.kernel_id = k.id
+group by k.id
+order by k.name;
+
+-- name: KernelRevision :one
+select revision from kernel where id = $1;
+
+-- name: KernelUpsert :one
+insert into kernel (id, uid, name, version, path, arch_id, command_line, verify)
+values (coalesce($1, nextval('kernel_id_seq')), $2, $3, $4, $5, $6, $7, $8)
+on conflict (id)
+do update set uid = $2, name = $3, version = $4, path = $5, arch_id = $6, command_line = $7, verify = $8, revision = kernel.revision + 1
+returning *;
+
+-- name: KernelID :one
+select id from kernel where name = $1;
+
+-- name: KernelParent :one
+select parent_id from kernel where id = $1;
+
+-- name: KernelSetParent :exec
+update kernel set parent_id = $1 where id = $2;
+
+-- name: KernelChildren :many
+select c.name, p.name as parent
+from kernel as c
+join kernel as p
+  on c.parent_id = p.id
+where p.name = any($1::text[])
+order by c.name;
+
+-- name: InitrdUpsert :one
+insert into initrd (kernel_id, path)
+values ($1, $2)
+on conflict (path, kernel_id)
+do update set path = $2
+returning *;
+
+-- name: InitrdUpsertDelete :exec
+delete from initrd where kernel_id = $1 and id <> all($2::bigint[]);
+
+-- name: TemplateTypeUpsert :one
+insert into template_type (name, uri_name)
+values ($1, $2)
+on conflict (name)
+do update set uri_name = $2
+returning *;
+
+-- name: TemplateUpsert :one
+insert into template (name, template_type_id)
+values ($1, $2)
+on conflict (name)
+do update set name = $1
+returning *;
+
+-- name: KernelTemplateUpsert :exec
+insert into kernel_template (kernel_id, template_id)
+values ($1, $2)
+on conflict (kernel_id, template_id)
+do nothing;
+
+-- name: KernelTemplateUpsertDelete :exec
+delete from kernel_template where kernel_id = $1 and template_id <> all($2::bigint[]);
+
+-- name: KernelDelete :exec
+delete from kernel where name = any($1::text[]);
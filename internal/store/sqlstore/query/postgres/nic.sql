@@ -0,0 +1,17 @@
+/*
+ * SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+ *
+ * SPDX-License-Identifier: GPL-3.0-or-later
+ */
+
+-- name: NicUpsert :one
+insert into nic (id, node_id, nic_type, name, vlan, fqdn, mac, ip, peers, mtu, switch, port, parent, mode, options, addresses)
+values (coalesce($1, nextval('nic_id_seq')), $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16)
+on conflict (id)
+do update set nic_type = $3, name = $4, vlan = $5, fqdn = $6, mac = $7, ip = $8,
+              peers = $9, mtu = $10, switch = $11, port = $12, parent = $13,
+              mode = $14, options = $15, addresses = $16
+returning *;
+
+-- name: NicUpsertDelete :exec
+delete from nic where node_id = $1 and id <> all($2::bigint[]);
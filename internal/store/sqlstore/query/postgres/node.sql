@@ -0,0 +1,201 @@
+/*
+ * SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+ *
+ * SPDX-License-Identifier: GPL-3.0-or-later
+ */
+
+-- name: NodeCount :one
+select count(*) from node;
+
+-- name: NodeStats :one
+select
+  count(*) as total,
+  coalesce(sum(provision::integer), 0)::bigint as provisioning,
+  count(*) - count(kernel_id) as without_kernel
+from node;
+
+-- name: TagNodeCount :many
+select t.key, count(distinct nt.node_id) as nodes
+from tag as t
+left join node_tag as nt
+  on nt.tag_id = t.id
+group by t.id
+order by t.key;
+
+-- name: NodeFetchByID :one
+select * from node_view where id = $1;
+
+-- name: NodeFetchByUID :one
+select * from node_view where uid = $1;
+
+-- name: NodeFetchByName :one
+select * from node_view where name = $1;
+
+-- name: NodeFetchBySMBIOSUUID :one
+select n.*
+from node_view as n
+join node as nd
+  on nd.id = n.id
+where nd.smbios_uuid = $1
+limit 1;
+
+-- name: NodeFindByMAC :one
+select n.*
+from node_view as n
+join nic as nc
+on nc.node_id = n.id
+where nc.mac = $1
+limit 1;
+
+-- name: NodeFindByFQDNOrIP :many
+select * from node_view
+where id in (
+  select nc.node_id
+  from nic_fqdn as f
+  join nic as nc
+    on nc.id = f.nic_id
+  where f.fqdn = any($1::text[])
+  union
+  select nc.node_id
+  from nic as nc
+  where split_part(nc.ip, '/', 1) = any($2::text[])
+);
+
+-- name: NodeFindBySecondaryAddress :many
+select * from node_view
+where id in (
+  select nc.node_id
+  from nic_address as a
+  join nic as nc
+    on nc.id = a.nic_id
+  where a.name = any($1::text[]) or a.addr = any($2::text[])
+);
+
+-- name: NodeResolveFQDN :many
+select nc.fqdn, nc.ip, n.state
+from nic_fqdn as f
+join nic as nc
+  on nc.id = f.nic_id
+join node as n
+  on n.id = nc.node_id
+where f.fqdn = $1
+union all
+select a.fqdn, a.ip, n.state
+from nic_address as a
+join nic as nc
+  on nc.id = a.nic_id
+join node as n
+  on n.id = nc.node_id
+where a.name = $1;
+
+-- name: NodeResolveIP :many
+select nc.fqdn, nc.ip, n.state
+from nic as nc
+join node as n
+  on n.id = nc.node_id
+where split_part(nc.ip, '/', 1) = $1::text
+union all
+select distinct a.fqdn, a.ip, n.state
+from nic_address as a
+join nic as nc
+  on nc.id = a.nic_id
+join node as n
+  on n.id = nc.node_id
+where a.addr = $1::text;
+
+-- name: NodeAll :many
+select * from node_view;
+
+-- name: NodeID :many
+select id from node
+where name = any($1::text[]);
+
+-- name: TagID :many
+select id from tag
+where key = any($1::text[]);
+
+-- name: NodeFindNodeset :many
+select * from node_view
+where name = any($1::text[]);
+
+-- name: NodeFindTags :many
+select
+  n.name as name,
+  count(distinct(t.key)) as cnt
+from
+  node as n
+join node_tag as nt
+  on nt.node_id = n.id
+join tag as t
+  on nt.tag_id = t.id
+where
+  t.key = any($1::text[])
+group by n.name;
+
+-- name: NodeProvision :exec
+update node set provision = $1, state = $2, revision = revision + 1
+where id = any($3::bigint[]);
+
+-- name: NodeState :one
+select name, state from node where id = $1;
+
+-- name: NodeBootKernel :exec
+update node set kernel_id = $1, revision = revision + 1
+where id = any($2::bigint[]);
+
+-- name: NodeBootKernelIfCurrent :execrows
+update node set kernel_id = $1, revision = revision + 1
+where id = any($2::bigint[])
+  and coalesce((select k.name from kernel as k where k.id = node.kernel_id), '') = any($3::text[]);
+
+-- name: NodeFirmware :exec
+update node set firmware = $1, revision = revision + 1
+where id = any($2::bigint[]);
+
+-- name: NodeRevision :one
+select revision from node where id = $1;
+
+-- name: NodeRevisionIncrement :exec
+update node set revision = revision + 1
+where id = any($1::bigint[]);
+
+-- name: NodeUpsert :one
+insert into node (id, uid, name, provision, arch_id, kernel_id, node_type_id, firmware, smbios_uuid, inventory, hardware, client_cert_fingerprint, client_cert_serial, state)
+values (coalesce($1, nextval('node_id_seq')), $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14)
+on conflict (id)
+do update set name = $3, provision = $4, arch_id = $5, kernel_id = $6, node_type_id = $7, firmware = $8, smbios_uuid = $9, inventory = coalesce($10, node.inventory), hardware = coalesce($11, node.hardware), client_cert_fingerprint = coalesce($12, node.client_cert_fingerprint), client_cert_serial = coalesce($13, node.client_cert_serial), state = $14, revision = node.revision + 1
+returning *;
+
+-- name: NodeClientCertSet :execrows
+update node set client_cert_fingerprint = $1, client_cert_serial = $2
+where name = $3;
+
+-- name: NodeHardwareSet :execrows
+update node set hardware = $1
+where name = $2;
+
+-- name: NodeInventorySet :execrows
+update node set inventory = $1
+where name = $2;
+
+-- name: NodeDelete :exec
+delete from node where name = any($1::text[]);
+
+-- name: TagUpsert :one
+insert into tag (key)
+values ($1)
+on conflict (key)
+do update set key = $1
+returning *;
+
+-- name: NodeTagUpsert :exec
+insert into node_tag (tag_id, node_id, value)
+values ($1, $2, $3)
+on conflict (tag_id, node_id, value)
+do nothing;
+
+-- name: NodeTagUpsertDelete :exec
+delete from node_tag where node_id = any($1::bigint[]) and tag_id <> all($2::bigint[]);
+
+-- name: NodeTagDelete :exec
+delete from node_tag where node_id = any($1::bigint[]) and tag_id = any($2::bigint[]);
@@ -0,0 +1,26 @@
+/*
+ * SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+ *
+ * SPDX-License-Identifier: GPL-3.0-or-later
+ */
+
+-- name: NodeLogInsert :execrows
+insert into node_log (node_id, logged_at, severity, message_id, message)
+select id, $1::bigint, $2::text, $3::text, $4::text from node where name = $5;
+
+-- name: NodeLogTrim :exec
+delete from node_log
+where node_id = (select id from node where name = $1)
+and id not in (
+  select l.id from node_log as l
+  where l.node_id = (select id from node where name = $1)
+  order by l.logged_at desc, l.id desc
+  limit $2
+);
+
+-- name: NodeLogFind :many
+select n.name, l.logged_at, l.severity, l.message_id, l.message
+from node_log as l
+join node as n on n.id = l.node_id
+where n.name = any($1::text[])
+order by n.name, l.logged_at, l.id;
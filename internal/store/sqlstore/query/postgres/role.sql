@@ -0,0 +1,46 @@
+/*
+ * SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+ *
+ * SPDX-License-Identifier: GPL-3.0-or-later
+ */
+
+-- name: RoleFetchByMethodAndPath :many
+select role.name
+from permission
+inner join role_permission on role_permission.permission_id = permission.id
+inner join role on role_permission.role_id = role.id
+where permission.method = $1 and $2 ilike permission.path;
+
+-- name: RoleFetchView :many
+select permission_json from role_view;
+
+-- name: RoleFetchViewByName :one
+select permission_json from role_view where name = $1;
+
+-- name: RoleAdd :one
+insert into role (name)
+values ($1)
+returning id;
+
+-- name: RoleDelete :exec
+delete from role where name = $1;
+
+-- name: RoleFetchPermissions :many
+select method, path from permission;
+
+-- name: RoleFetchPermissionsByRole :many
+select permission_id from role_permission where role_id = $1;
+
+-- name: RoleFetchId :one
+select id from role where name = $1;
+
+-- name: RoleFetchPermissionId :one
+select id from permission where method = $1 and path = $2;
+
+-- name: RoleUpsertPermission :exec
+insert into role_permission (role_id, permission_id)
+values ($1, $2)
+on conflict do nothing;
+
+-- name: RoleUpsertDelete :exec
+delete from role_permission where role_id = $1 and permission_id <> all($2::bigint[]);
@@ -0,0 +1,39 @@
+/*
+ * SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+ *
+ * SPDX-License-Identifier: GPL-3.0-or-later
+ */
+
+-- name: ScheduledActionList :many
+select * from scheduled_action
+order by run_at, id;
+
+-- name: ScheduledActionGet :one
+select * from scheduled_action
+where id = $1;
+
+-- name: ScheduledActionAdd :one
+insert into scheduled_action (action, nodeset, arg, run_at, created_by, created_at)
+values ($1, $2, $3, $4, $5, $6)
+returning id;
+
+-- name: ScheduledActionCancel :execrows
+update scheduled_action set status = 'cancelled', finished_at = $1
+where id = $2 and status = 'pending';
+
+-- name: ScheduledActionDue :many
+select * from scheduled_action
+where status = 'pending' and run_at <= $1
+order by run_at, id;
+
+-- name: ScheduledActionStart :execrows
+update scheduled_action set status = $1, started_at = $2
+where id = $3 and status = 'pending';
+
+-- name: ScheduledActionFinish :exec
+update scheduled_action set status = $1, finished_at = $2, results = $3, error = $4
+where id = $5;
+
+-- name: ScheduledActionPurge :execrows
+delete from scheduled_action
+where status not in ('pending', 'running') and finished_at < $1;
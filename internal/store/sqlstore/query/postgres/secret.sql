@@ -0,0 +1,33 @@
+/*
+ * SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+ *
+ * SPDX-License-Identifier: GPL-3.0-or-later
+ */
+
+-- name: SecretAll :many
+select name, one_time, updated_at
+from secret
+order by name;
+
+-- name: SecretFetch :one
+select name, value, one_time, updated_at
+from secret
+where name = $1;
+
+-- name: SecretUpsert :exec
+insert into secret (name, value, one_time)
+values ($1, $2, $3)
+on conflict (name)
+do update set value = $2, one_time = $3;
+
+-- name: SecretDelete :execrows
+delete from secret
+where name = $1;
+
+-- name: SecretFetchClaim :execrows
+insert into secret_fetch (name, token_id, host)
+values ($1, $2, $3)
+on conflict (name, token_id) do nothing;
+
+-- name: SecretFetchPurge :execrows
+delete from secret_fetch where extract(epoch from fetched_at) <= $1;
@@ -0,0 +1,19 @@
+/*
+ * SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+ *
+ * SPDX-License-Identifier: GPL-3.0-or-later
+ */
+
+-- name: SigningKeyUpsert :exec
+insert into signing_key (id, kind, secret, created, retire_at)
+values ($1, $2, $3, $4, $5)
+on conflict (id) do update set
+  retire_at = excluded.retire_at;
+
+-- name: SigningKeyList :many
+select * from signing_key
+order by kind, created desc;
+
+-- name: SigningKeyPurge :execrows
+delete from signing_key
+where retire_at != 0 and retire_at <= $1;
@@ -0,0 +1,13 @@
+/*
+ * SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+ *
+ * SPDX-License-Identifier: GPL-3.0-or-later
+ */
+
+-- name: TombstoneFind :many
+select name, deleted_at from tombstone
+where kind = $1 and deleted_at >= $2
+order by name;
+
+-- name: TombstonePurge :execrows
+delete from tombstone where deleted_at <= $1;
@@ -0,0 +1,45 @@
+/*
+ * SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+ *
+ * SPDX-License-Identifier: GPL-3.0-or-later
+ */
+
+-- name: NodeTrashInsert :exec
+insert into node_trash (name, host_json, credentials_json, deleted_at)
+select
+  nv.name,
+  nv.host_json,
+  coalesce((select json_agg(json_build_object('kind', c.kind, 'secret', c.secret) order by c.id)
+   from node_credential as c
+   where c.node_id = nv.id
+  ), '[]')::text,
+  $1::bigint
+from node_view as nv
+where nv.name = any($2::text[])
+on conflict (name)
+do update set
+  host_json = excluded.host_json,
+  credentials_json = excluded.credentials_json,
+  deleted_at = excluded.deleted_at;
+
+-- name: NodeTrashAll :many
+select name, host_json, deleted_at from node_trash order by name;
+
+-- name: NodeTrashFind :many
+select name, host_json, deleted_at from node_trash
+where name = any($1::text[])
+order by name;
+
+-- name: NodeTrashRestoreCredentials :exec
+insert into node_credential (node_id, kind, secret)
+select n.id, j->>'kind', j->>'secret'
+from node_trash as t
+cross join json_array_elements(t.credentials_json::json) as j
+join node as n on n.name = t.name
+where t.name = $1;
+
+-- name: NodeTrashDelete :exec
+delete from node_trash where name = any($1::text[]);
+
+-- name: NodeTrashPurge :execrows
+delete from node_trash where deleted_at <= $1;
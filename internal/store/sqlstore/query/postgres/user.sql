@@ -0,0 +1,39 @@
+/*
+ * SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+ *
+ * SPDX-License-Identifier: GPL-3.0-or-later
+ */
+
+-- name: UserCount :one
+select count(*) from "user";
+
+-- name: UserFetch :one
+select * from user_view where username = $1;
+
+-- name: UserList :many
+select * from user_view;
+
+-- name: UserCreate :one
+insert into "user" (username, password_hash, role_id, enabled)
+select $1::text, $2::text, role.id, $3::boolean
+from role
+where role.name = $4
+on conflict (username)
+do update set password_hash = $2
+returning *;
+
+-- name: UserUpdateRole :exec
+update "user" set role_id = (
+  select role.id
+  from role
+  where role.name = $1
+)
+where "user".username = $2
+returning *;
+
+-- name: UserUpdateEnable :exec
+update "user" set enabled = $1 where username = $2
+returning *;
+
+-- name: UserDelete :exec
+delete from "user" where username = $1;
@@ -0,0 +1,14 @@
+/*
+ * SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+ *
+ * SPDX-License-Identifier: GPL-3.0-or-later
+ */
+
+-- name: RevokedCertInsert :execrows
+insert into revoked_cert (serial, host)
+values (@serial, @host)
+on conflict (serial) do nothing;
+
+-- name: RevokedCertList :many
+select * from revoked_cert
+order by created_at, serial;
@@ -0,0 +1,16 @@
+/*
+ * SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+ *
+ * SPDX-License-Identifier: GPL-3.0-or-later
+ */
+
+-- name: RevokedTokenInsert :exec
+insert into revoked_token (id, host_uid, mac, expires)
+values (@id, @host_uid, @mac, @expires)
+on conflict (id) do nothing;
+
+-- name: RevokedTokenExists :one
+select count(*) from revoked_token where id = @id and (expires = 0 or expires > @now);
+
+-- name: RevokedTokenPrune :exec
+delete from revoked_token where expires != 0 and expires <= @now;
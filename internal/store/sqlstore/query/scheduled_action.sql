@@ -0,0 +1,39 @@
+/*
+ * SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+ *
+ * SPDX-License-Identifier: GPL-3.0-or-later
+ */
+
+-- name: ScheduledActionList :many
+select * from scheduled_action
+order by run_at, id;
+
+-- name: ScheduledActionGet :one
+select * from scheduled_action
+where id = @id;
+
+-- name: ScheduledActionAdd :one
+insert into scheduled_action (action, nodeset, arg, run_at, created_by, created_at)
+values (@action, @nodeset, @arg, @run_at, @created_by, @created_at)
+returning id;
+
+-- name: ScheduledActionCancel :execrows
+update scheduled_action set status = 'cancelled', finished_at = @finished_at
+where id = @id and status = 'pending';
+
+-- name: ScheduledActionDue :many
+select * from scheduled_action
+where status = 'pending' and run_at <= @run_at
+order by run_at, id;
+
+-- name: ScheduledActionStart :execrows
+update scheduled_action set status = @status, started_at = @started_at
+where id = @id and status = 'pending';
+
+-- name: ScheduledActionFinish :exec
+update scheduled_action set status = @status, finished_at = @finished_at, results = @results, error = @error
+where id = @id;
+
+-- name: ScheduledActionPurge :execrows
+delete from scheduled_action
+where status not in ('pending', 'running') and finished_at < @finished_at;
@@ -0,0 +1,13 @@
+/*
+ * SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+ *
+ * SPDX-License-Identifier: GPL-3.0-or-later
+ */
+
+-- name: TombstoneFind :many
+select name, deleted_at from tombstone
+where kind = @kind and deleted_at >= @since
+order by name;
+
+-- name: TombstonePurge :execrows
+delete from tombstone where deleted_at <= @before;
@@ -0,0 +1,44 @@
+/*
+ * SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+ *
+ * SPDX-License-Identifier: GPL-3.0-or-later
+ */
+
+-- name: NodeTrashInsert :exec
+insert into node_trash (name, host_json, credentials_json, deleted_at)
+select
+  nv.name,
+  nv.host_json,
+  (select json_group_array(json_object('kind', c.kind, 'secret', c.secret))
+   from node_credential as c
+   where c.node_id = nv.id
+  ),
+  @deleted_at
+from node_view as nv
+where nv.name in (sqlc.slice('nodeset'))
+on conflict (name)
+do update set
+  host_json = excluded.host_json,
+  credentials_json = excluded.credentials_json,
+  deleted_at = excluded.deleted_at;
+
+-- name: NodeTrashAll :many
+select name, host_json, deleted_at from node_trash order by name;
+
+-- name: NodeTrashFind :many
+select name, host_json, deleted_at from node_trash
+where name in (sqlc.slice('nodeset'))
+order by name;
+
+-- name: NodeTrashRestoreCredentials :exec
+insert into node_credential (node_id, kind, secret)
+select n.id, json_extract(j.value, '$.kind'), json_extract(j.value, '$.secret')
+from node_trash as t, json_each(t.credentials_json) as j
+join node as n on n.name = t.name
+where t.name = @name;
+
+-- name: NodeTrashDelete :exec
+delete from node_trash where name in (sqlc.slice('nodeset'));
+
+-- name: NodeTrashPurge :execrows
+delete from node_trash where deleted_at <= @before;
@@ -12,12 +12,16 @@ import (
 	"fmt"
 	"net"
 	"net/netip"
+	"slices"
+	"strconv"
 	"strings"
+	"time"
 
 	null "github.com/guregu/null/v5"
 	_ "github.com/mattn/go-sqlite3"
 	"github.com/segmentio/ksuid"
 	"github.com/sirupsen/logrus"
+	"github.com/ubccr/grendel/internal/firmware"
 	"github.com/ubccr/grendel/internal/store"
 	"github.com/ubccr/grendel/internal/store/migrations"
 	"github.com/ubccr/grendel/internal/store/sqlstore/db"
@@ -29,24 +33,36 @@ import (
 
 // SqlStore implements a Grendel Store using sqlc
 type SqlStore struct {
-	q  *db.Queries
-	rw *sql.DB
-	ro *sql.DB
+	q   *db.Queries
+	rw  *sql.DB
+	ro  *sql.DB
+	ctx context.Context
+
+	// postgres is set when the database is a PostgreSQL database instead of
+	// a sqlite file
+	postgres bool
 }
 
-// New returns a new SqlStore using the given database filename. For memory only you can provide `:memory:`
+// New returns a new SqlStore using the given database filename. For memory only you can provide `:memory:`.
+// With the postgres driver filename is the connection string of the database
 func New(filename string, config ...Config) (*SqlStore, error) {
 	cfg := configDefault(config...)
 
-	rw, err := sql.Open(cfg.Driver, cfg.DataSourceName(filename, true))
+	rw, err := openDB(cfg.Driver, cfg.DataSourceName(filename, true))
 	if err != nil {
 		return nil, err
 	}
 
-	migrator, err := migrations.New(rw)
+	var migrator *migrations.Migrator
+	if cfg.Driver == PostgresDriver {
+		migrator, err = migrations.NewPostgres(rw)
+	} else {
+		migrator, err = migrations.New(rw)
+	}
 	if err != nil {
 		return nil, err
 	}
+	defer migrator.Close()
 
 	cur, dirty, err := migrator.Version()
 	if err != nil && err != migrations.ErrNilVersion {
@@ -58,23 +74,29 @@ func New(filename string, config ...Config) (*SqlStore, error) {
 		"dirty":   dirty,
 	}).Info("Current version")
 
-	err = migrator.Migrate()
-	if err != nil && err != migrations.ErrNoChange {
-		return nil, err
-	}
-
-	if err == migrations.ErrNoChange {
-		store.Log.Info("Database up to date, no new migrations")
+	if cfg.ReadOnly {
+		if cur != migrations.SchemaVersion || dirty {
+			return nil, fmt.Errorf("%w: database version %d differs from %d, migrate it from the primary instance", store.ErrReadOnly, cur, migrations.SchemaVersion)
+		}
 	} else {
-		store.Log.WithFields(logrus.Fields{
-			"version": migrations.SchemaVersion,
-		}).Info("Database migrated")
+		err = migrator.Migrate()
+		if err != nil && err != migrations.ErrNoChange {
+			return nil, err
+		}
+
+		if err == migrations.ErrNoChange {
+			store.Log.Info("Database up to date, no new migrations")
+		} else {
+			store.Log.WithFields(logrus.Fields{
+				"version": migrations.SchemaVersion,
+			}).Info("Database migrated")
+		}
 	}
 
 	var ro *sql.DB
 	if filename != ":memory:" {
 		var err error
-		ro, err = sql.Open(cfg.Driver, cfg.DataSourceName(filename, false))
+		ro, err = openDB(cfg.Driver, cfg.DataSourceName(filename, false))
 		if err != nil {
 			return nil, err
 		}
@@ -84,12 +106,140 @@ func New(filename string, config ...Config) (*SqlStore, error) {
 
 	rw.SetMaxOpenConns(1)
 
-	return &SqlStore{rw: rw, ro: ro, q: db.New()}, nil
+	s := &SqlStore{rw: rw, ro: ro, q: db.New(), postgres: cfg.Driver == PostgresDriver}
+
+	version, err := s.q.IndexVersion(s.context(), s.rw, nicFQDNIndex)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return nil, err
+	}
+	if version != IndexVersion && cfg.ReadOnly {
+		return nil, fmt.Errorf("%w: index version %d differs from %d, start the primary instance to rebuild the indexes", store.ErrReadOnly, version, IndexVersion)
+	}
+	if version != IndexVersion {
+		store.Log.WithFields(logrus.Fields{
+			"version": IndexVersion,
+		}).Info("Index version changed, rebuilding indexes")
+		if err := s.Reindex(); err != nil {
+			return nil, fmt.Errorf("failed to rebuild indexes: %w", err)
+		}
+	}
+
+	return s, nil
+}
+
+// WithContext returns a store sharing the database of s whose queries and
+// transactions are canceled when ctx is done
+func (s *SqlStore) WithContext(ctx context.Context) store.Store {
+	c := *s
+	c.ctx = ctx
+	return &c
+}
+
+// context returns the context of the queries of s, set by WithContext
+func (s *SqlStore) context() context.Context {
+	if s.ctx != nil {
+		return s.ctx
+	}
+
+	return context.Background()
+}
+
+// IndexVersion is the version of the secondary indexes maintained by the
+// store. Bump it when the way index entries are derived changes so existing
+// databases are rebuilt on startup.
+const IndexVersion = 2
+
+// nicFQDNIndex is the name of the FQDN index in the store_index table
+const nicFQDNIndex = "nic_fqdn"
+
+// addressesJSON returns the secondary addresses of a network interface
+// encoded for the addresses column
+func addressesJSON(n *model.NetInterface) (null.String, error) {
+	if len(n.Addresses) == 0 {
+		return null.NewString("", false), nil
+	}
+
+	aj, err := json.Marshal(&n.Addresses)
+	if err != nil {
+		return null.NewString("", false), err
+	}
+
+	return null.StringFrom(string(aj)), nil
+}
+
+// inventoryJSON returns the firmware inventory of a host for the inventory
+// column of the node table or null if the host has none
+func inventoryJSON(inv *model.Inventory) (null.String, error) {
+	if inv.IsEmpty() {
+		return null.NewString("", false), nil
+	}
+
+	ij, err := json.Marshal(inv)
+	if err != nil {
+		return null.NewString("", false), err
+	}
+
+	return null.StringFrom(string(ij)), nil
+}
+
+// hardwareJSON returns the hardware of a host for the hardware column of the
+// node table or null if the host has none
+func hardwareJSON(hw *model.Hardware) (null.String, error) {
+	if hw.IsEmpty() {
+		return null.NewString("", false), nil
+	}
+
+	hj, err := json.Marshal(hw)
+	if err != nil {
+		return null.NewString("", false), err
+	}
+
+	return null.StringFrom(string(hj)), nil
+}
+
+// Reindex rebuilds the FQDN and address indexes of network interfaces and
+// all sqlite indexes in a single transaction. Postgres keeps its own indexes
+// current
+func (s *SqlStore) Reindex() error {
+	ctx := s.context()
+	tx, err := s.rw.BeginTx(s.context(), nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if err := s.q.NicFQDNDeleteAll(ctx, tx); err != nil {
+		return err
+	}
+	if err := s.q.NicFQDNRebuild(ctx, tx); err != nil {
+		return err
+	}
+	if err := s.q.NicAddressDeleteAll(ctx, tx); err != nil {
+		return err
+	}
+	if err := s.q.NicAddressRebuild(ctx, tx); err != nil {
+		return err
+	}
+	if !s.postgres {
+		if _, err := tx.ExecContext(ctx, "reindex"); err != nil {
+			return err
+		}
+	}
+
+	err = s.q.IndexVersionSet(ctx, tx, db.IndexVersionSetParams{
+		Name:    nicFQDNIndex,
+		Version: IndexVersion,
+	})
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
 }
 
 // StoreUser stores the User in the data store
 func (s *SqlStore) StoreUser(username, password string) (string, error) {
-	ctx := context.Background()
+	ctx := s.context()
 
 	role := model.RoleUser
 	enabled := false
@@ -124,7 +274,7 @@ func (s *SqlStore) StoreUser(username, password string) (string, error) {
 
 // VerifyUser checks if the given username exists in the data store
 func (s *SqlStore) VerifyUser(username, password string) (bool, string, error) {
-	ctx := context.Background()
+	ctx := s.context()
 
 	user, err := s.q.UserFetch(ctx, s.ro, username)
 	if err != nil {
@@ -141,7 +291,7 @@ func (s *SqlStore) VerifyUser(username, password string) (bool, string, error) {
 
 // GetUsers returns a list of all the usernames
 func (s *SqlStore) GetUsers() ([]model.User, error) {
-	ctx := context.Background()
+	ctx := s.context()
 
 	userList, err := s.q.UserList(ctx, s.ro)
 	if err != nil {
@@ -166,7 +316,7 @@ func (s *SqlStore) GetUsers() ([]model.User, error) {
 
 // GetUsers returns a list of all the usernames
 func (s *SqlStore) GetUserByName(name string) (*model.User, error) {
-	ctx := context.Background()
+	ctx := s.context()
 
 	user, err := s.q.UserFetch(ctx, s.ro, name)
 	if err != nil {
@@ -187,7 +337,7 @@ func (s *SqlStore) GetUserByName(name string) (*model.User, error) {
 
 // UpdateUser updates the role of the given users
 func (s *SqlStore) UpdateUserRole(username, role string) error {
-	ctx := context.Background()
+	ctx := s.context()
 
 	err := s.q.UserUpdateRole(ctx, s.rw, db.UserUpdateRoleParams{
 		Username: username,
@@ -199,7 +349,7 @@ func (s *SqlStore) UpdateUserRole(username, role string) error {
 
 // UpdateUser updates the role of the given users
 func (s *SqlStore) UpdateUserEnabled(username string, enabled bool) error {
-	ctx := context.Background()
+	ctx := s.context()
 
 	err := s.q.UserUpdateEnable(ctx, s.rw, db.UserUpdateEnableParams{
 		Username: username,
@@ -214,7 +364,7 @@ func (s *SqlStore) UpdateUserEnabled(username string, enabled bool) error {
 
 // DeleteUser deletes the given user
 func (s *SqlStore) DeleteUser(username string) error {
-	ctx := context.Background()
+	ctx := s.context()
 
 	err := s.q.UserDelete(ctx, s.rw, username)
 	if err != nil {
@@ -230,12 +380,42 @@ func (s *SqlStore) StoreHost(host *model.Host) error {
 
 // StoreHosts stores a list of host in the data store. If the host exists it is overwritten
 func (s *SqlStore) StoreHosts(hosts model.HostList) error {
-	ctx := context.Background()
-	tx, err := s.rw.Begin()
+	ctx := s.context()
+	tx, err := s.rw.BeginTx(s.context(), nil)
 	if err != nil {
 		return err
 	}
 	defer tx.Rollback()
+
+	if err := s.checkRecordConflicts(ctx, tx, hosts); err != nil {
+		return err
+	}
+	if err := s.storeHosts(ctx, tx, hosts, false); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// checkRecordConflicts returns ErrConflict when an interface of the hosts uses
+// the name of a DNS only record or the address of an A record with PTR set
+func (s *SqlStore) checkRecordConflicts(ctx context.Context, tx *sql.Tx, hosts model.HostList) error {
+	rows, err := s.q.DNSRecordAll(ctx, tx)
+	if err != nil {
+		return err
+	}
+
+	if err := newRecordList(rows).CheckConflicts(hosts, nil); err != nil {
+		return fmt.Errorf("%w: %w", store.ErrConflict, err)
+	}
+
+	return nil
+}
+
+// storeHosts upserts the hosts. The state of existing hosts may only move
+// along the allowed transitions unless force is set
+func (s *SqlStore) storeHosts(ctx context.Context, tx *sql.Tx, hosts model.HostList, force bool) error {
+	var err error
 	for idx, h := range hosts {
 		if h.Name == "" {
 			return fmt.Errorf("host name required for host %d: %w", idx, store.ErrInvalidData)
@@ -260,14 +440,68 @@ func (s *SqlStore) StoreHosts(hosts model.HostList) error {
 			}
 		}
 
+		if err := s.checkRevision(ctx, tx, "host", h.Name, h.ID, h.Revision, s.q.NodeRevision); err != nil {
+			return err
+		}
+
+		if h.SMBIOSUUID != "" {
+			h.SMBIOSUUID, err = model.NormalizeSMBIOSUUID(h.SMBIOSUUID)
+			if err != nil {
+				return fmt.Errorf("%w: host %s: %w", store.ErrInvalidData, h.Name, err)
+			}
+
+			other, err := s.q.NodeFetchBySMBIOSUUID(ctx, tx, null.StringFrom(h.SMBIOSUUID))
+			if err == nil && other.Name != h.Name {
+				return fmt.Errorf("%w: host %s: smbios uuid %s is used by host %s", store.ErrConflict, h.Name, h.SMBIOSUUID, other.Name)
+			} else if err != nil && !errors.Is(err, sql.ErrNoRows) {
+				return err
+			}
+		}
+
+		current := ""
+		if h.ID != 0 {
+			row, err := s.q.NodeState(ctx, tx, h.ID)
+			if err != nil && !errors.Is(err, sql.ErrNoRows) {
+				return err
+			}
+			current = row.State
+		}
+
+		state, err := h.ResolveState(current)
+		if err != nil {
+			return fmt.Errorf("%w: host %s: %w", store.ErrInvalidData, h.Name, err)
+		}
+		if current != "" && !force && !model.HostStateTransitionAllowed(current, state) {
+			return fmt.Errorf("%w: host %s may not move from state %s to %s", store.ErrConflict, h.Name, current, state)
+		}
+		h.State = state
+		h.Provision = model.HostStateProvisions(state)
+
+		inventory, err := inventoryJSON(h.Inventory)
+		if err != nil {
+			return err
+		}
+
+		hardware, err := hardwareJSON(h.Hardware)
+		if err != nil {
+			return err
+		}
+
 		// Upsert node
 		node, err := s.q.NodeUpsert(ctx, tx, db.NodeUpsertParams{
-			ID:        null.NewInt(h.ID, h.ID != 0),
-			UID:       h.UID,
-			KernelID:  kernelID,
-			Name:      h.Name,
-			Provision: h.Provision,
-			Firmware:  null.NewString(h.Firmware.String(), !h.Firmware.IsNil()),
+			ID:         null.NewInt(h.ID, h.ID != 0),
+			UID:        h.UID,
+			KernelID:   kernelID,
+			Name:       h.Name,
+			Provision:  h.Provision,
+			State:      h.State,
+			Firmware:   null.NewString(h.Firmware.String(), !h.Firmware.IsNil()),
+			SMBIOSUUID: null.NewString(h.SMBIOSUUID, h.SMBIOSUUID != ""),
+			Inventory:  inventory,
+			Hardware:   hardware,
+
+			ClientCertFingerprint: null.NewString(h.ClientCertFingerprint, h.ClientCertFingerprint != ""),
+			ClientCertSerial:      null.NewString(h.ClientCertSerial, h.ClientCertSerial != ""),
 		})
 		if err != nil {
 			return err
@@ -308,20 +542,32 @@ func (s *SqlStore) StoreHosts(hosts model.HostList) error {
 		// Upsert network interfaces
 		nicIDs := make([]int64, 0)
 		for _, n := range h.Interfaces {
+			if err := n.Validate(); err != nil {
+				return fmt.Errorf("%w: host %s: %w", store.ErrInvalidData, h.Name, err)
+			}
+
 			nt := model.NicTypeEthernet
 			if n.BMC {
 				nt = model.NicTypeBMC
 			}
+			addrs, err := addressesJSON(n)
+			if err != nil {
+				return err
+			}
 			nc, err := s.q.NicUpsert(ctx, tx, db.NicUpsertParams{
-				ID:      null.NewInt(n.ID, n.ID != 0),
-				NodeID:  node.ID,
-				NicType: nt.String(),
-				Name:    null.NewString(n.Name, len(n.Name) != 0),
-				IP:      null.NewString(n.IP.String(), n.IP.IsValid()),
-				MAC:     null.NewString(n.MAC.String(), n.MAC != nil),
-				FQDN:    null.NewString(n.FQDN, len(n.FQDN) != 0),
-				VLAN:    null.NewString(n.VLAN, len(n.VLAN) != 0),
-				MTU:     null.NewInt(int64(n.MTU), n.MTU != 0),
+				ID:        null.NewInt(n.ID, n.ID != 0),
+				NodeID:    node.ID,
+				NicType:   nt.String(),
+				Name:      null.NewString(n.Name, len(n.Name) != 0),
+				IP:        null.NewString(n.IP.String(), n.IP.IsValid()),
+				MAC:       null.NewString(n.MAC.String(), n.MAC != nil),
+				FQDN:      null.NewString(n.FQDN, len(n.FQDN) != 0),
+				VLAN:      null.NewString(strconv.Itoa(int(n.VLAN)), n.VLAN != 0),
+				Parent:    null.NewString(n.Parent, len(n.Parent) != 0),
+				MTU:       null.NewInt(int64(n.MTU), n.MTU != 0),
+				Switch:    null.NewString(n.Switch, len(n.Switch) != 0),
+				Port:      null.NewInt(int64(n.Port), len(n.Switch) != 0),
+				Addresses: addrs,
 			})
 			if err != nil {
 				return err
@@ -329,7 +575,10 @@ func (s *SqlStore) StoreHosts(hosts model.HostList) error {
 			nicIDs = append(nicIDs, nc.ID)
 		}
 
-		// Upsert bond interfaces
+		// Upsert bond and bridge interfaces
+		if err := h.ValidateBonds(); err != nil {
+			return fmt.Errorf("%w: host %s: %w", store.ErrInvalidData, h.Name, err)
+		}
 		for _, n := range h.Bonds {
 			peers := null.NewString("", false)
 			if len(n.Peers) > 0 {
@@ -339,17 +588,33 @@ func (s *SqlStore) StoreHosts(hosts model.HostList) error {
 				}
 				peers.SetValid(string(pj))
 			}
+			options := null.NewString("", false)
+			if len(n.Options) > 0 {
+				oj, err := json.Marshal(&n.Options)
+				if err != nil {
+					return err
+				}
+				options.SetValid(string(oj))
+			}
+			addrs, err := addressesJSON(&n.NetInterface)
+			if err != nil {
+				return err
+			}
 			bi, err := s.q.NicUpsert(ctx, tx, db.NicUpsertParams{
-				ID:      null.NewInt(n.ID, n.ID != 0),
-				NodeID:  node.ID,
-				NicType: model.NicTypeBond.String(),
-				Name:    null.NewString(n.Name, len(n.Name) != 0),
-				IP:      null.NewString(n.IP.String(), n.IP.IsValid()),
-				FQDN:    null.NewString(n.FQDN, len(n.FQDN) != 0),
-				VLAN:    null.NewString(n.VLAN, len(n.VLAN) != 0),
-				MTU:     null.NewInt(int64(n.MTU), n.MTU != 0),
-				MAC:     null.NewString(n.MAC.String(), n.MAC != nil),
-				Peers:   peers,
+				ID:        null.NewInt(n.ID, n.ID != 0),
+				NodeID:    node.ID,
+				NicType:   n.NicType().String(),
+				Name:      null.NewString(n.Name, len(n.Name) != 0),
+				IP:        null.NewString(n.IP.String(), n.IP.IsValid()),
+				FQDN:      null.NewString(n.FQDN, len(n.FQDN) != 0),
+				VLAN:      null.NewString(strconv.Itoa(int(n.VLAN)), n.VLAN != 0),
+				Parent:    null.NewString(n.Parent, len(n.Parent) != 0),
+				MTU:       null.NewInt(int64(n.MTU), n.MTU != 0),
+				MAC:       null.NewString(n.MAC.String(), n.MAC != nil),
+				Peers:     peers,
+				Mode:      null.NewString(n.Mode, len(n.Mode) != 0),
+				Options:   options,
+				Addresses: addrs,
 			})
 			if err != nil {
 				return err
@@ -367,30 +632,148 @@ func (s *SqlStore) StoreHosts(hosts model.HostList) error {
 		}
 
 		h.ID = node.ID
+		h.Revision = node.Revision
 	}
-	return tx.Commit()
+	return nil
+}
+
+// checkRevision returns a store.RevisionError if expected is set and does not
+// match the current revision of the entry with the given id. A zero expected
+// revision always matches.
+func (s *SqlStore) checkRevision(ctx context.Context, tx *sql.Tx, kind, name string, id, expected int64, revision func(context.Context, db.DBTX, int64) (int64, error)) error {
+	if expected == 0 {
+		return nil
+	}
+
+	current, err := revision(ctx, tx, id)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return err
+	}
+
+	if current != expected {
+		return &store.RevisionError{Kind: kind, Name: name, Expected: expected, Current: current}
+	}
+
+	return nil
 }
 
-// DeleteHosts deletes all hosts in the given nodeset.NodeSet from the data store.
+// DeleteHosts moves all hosts in the given nodeset.NodeSet to the trash. The
+// hosts are deleted from the node tables so they stop being served
+// immediately and their names can be reused.
 func (s *SqlStore) DeleteHosts(ns *nodeset.NodeSet) error {
-	return s.q.NodeDelete(context.Background(), s.rw, ns.Iterator().StringSlice())
+	ctx := s.context()
+	tx, err := s.rw.BeginTx(s.context(), nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	names := ns.Iterator().StringSlice()
+	err = s.q.NodeTrashInsert(ctx, tx, db.NodeTrashInsertParams{
+		DeletedAt: time.Now().Unix(),
+		Nodeset:   names,
+	})
+	if err != nil {
+		return err
+	}
+
+	if err := s.q.NodeDelete(ctx, tx, names); err != nil {
+		return err
+	}
+
+	return tx.Commit()
 }
 
-func (s *SqlStore) findNodeFromParams(params db.NodeFindParams) (*model.Host, error) {
-	nodeView, err := s.q.NodeFind(context.Background(), s.ro, params)
+// TrashedHosts returns a list of all the hosts in the trash
+func (s *SqlStore) TrashedHosts() (model.TrashedHostList, error) {
+	rows, err := s.q.NodeTrashAll(s.context(), s.ro)
 	if err != nil {
-		if errors.Is(err, sql.ErrNoRows) {
-			return nil, store.ErrNotFound
-		}
 		return nil, err
 	}
 
-	return &nodeView.Host, nil
+	trash := make(model.TrashedHostList, 0, len(rows))
+	for _, r := range rows {
+		trash = append(trash, &model.TrashedHost{
+			Name:      r.Name,
+			DeletedAt: time.Unix(r.DeletedAt, 0),
+			Host:      &r.Host,
+		})
+	}
+
+	return trash, nil
+}
+
+// RestoreHosts restores all hosts in the given nodeset.NodeSet from the
+// trash along with their credentials and returns the number restored
+func (s *SqlStore) RestoreHosts(ns *nodeset.NodeSet) (int, error) {
+	ctx := s.context()
+	tx, err := s.rw.BeginTx(s.context(), nil)
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback()
+
+	rows, err := s.q.NodeTrashFind(ctx, tx, ns.Iterator().StringSlice())
+	if err != nil {
+		return 0, err
+	}
+
+	hosts := make(model.HostList, 0, len(rows))
+	names := make([]string, 0, len(rows))
+	for _, r := range rows {
+		_, err := s.q.NodeFetchByName(ctx, tx, r.Name)
+		if err == nil {
+			return 0, fmt.Errorf("%w: host %s exists", store.ErrConflict, r.Name)
+		} else if !errors.Is(err, sql.ErrNoRows) {
+			return 0, err
+		}
+
+		// The node and interface rows were deleted, restore them as new
+		// entries keeping the UID
+		h := r.Host
+		h.ID = 0
+		h.Revision = 0
+		for _, nic := range h.Interfaces {
+			nic.ID = 0
+		}
+		for _, b := range h.Bonds {
+			b.ID = 0
+		}
+		hosts = append(hosts, &h)
+		names = append(names, r.Name)
+	}
+
+	if err := s.checkRecordConflicts(ctx, tx, hosts); err != nil {
+		return 0, err
+	}
+	if err := s.storeHosts(ctx, tx, hosts, false); err != nil {
+		return 0, err
+	}
+
+	for _, name := range names {
+		if err := s.q.NodeTrashRestoreCredentials(ctx, tx, name); err != nil {
+			return 0, err
+		}
+	}
+
+	if err := s.q.NodeTrashDelete(ctx, tx, names); err != nil {
+		return 0, err
+	}
+
+	return len(hosts), tx.Commit()
+}
+
+// PurgeTrash permanently deletes the hosts moved to the trash before the
+// given time and returns the number deleted
+func (s *SqlStore) PurgeTrash(before time.Time) (int, error) {
+	n, err := s.q.NodeTrashPurge(s.context(), s.rw, before.Unix())
+
+	return int(n), err
 }
 
 // LoadHostFromName returns the Host with the given name
 func (s *SqlStore) LoadHostFromName(name string) (*model.Host, error) {
-	nodeView, err := s.q.NodeFetchByName(context.Background(), s.ro, name)
+	nodeView, err := s.q.NodeFetchByName(s.context(), s.ro, name)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return nil, store.ErrNotFound
@@ -403,7 +786,25 @@ func (s *SqlStore) LoadHostFromName(name string) (*model.Host, error) {
 
 // LoadHostFromID returns the Host with the given ID
 func (s *SqlStore) LoadHostFromID(uid string) (*model.Host, error) {
-	nodeView, err := s.q.NodeFetchByUID(context.Background(), s.ro, uid)
+	nodeView, err := s.q.NodeFetchByUID(s.context(), s.ro, uid)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, store.ErrNotFound
+		}
+		return nil, err
+	}
+
+	return &nodeView.Host, nil
+}
+
+// LoadHostFromSMBIOSUUID returns the Host with the given SMBIOS UUID
+func (s *SqlStore) LoadHostFromSMBIOSUUID(id string) (*model.Host, error) {
+	smbiosUUID, err := model.NormalizeSMBIOSUUID(id)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", store.ErrInvalidData, err)
+	}
+
+	nodeView, err := s.q.NodeFetchBySMBIOSUUID(s.context(), s.ro, null.StringFrom(smbiosUUID))
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return nil, store.ErrNotFound
@@ -414,36 +815,39 @@ func (s *SqlStore) LoadHostFromID(uid string) (*model.Host, error) {
 	return &nodeView.Host, nil
 }
 
-// ResolveIPv4 returns the list of IPv4 addresses with the given FQDN
-func (s *SqlStore) ResolveIPv4(fqdn string) ([]net.IP, error) {
+// ResolveIPv4 returns the list of IPv4 addresses with the given FQDN of the
+// hosts in states, every host without states
+func (s *SqlStore) ResolveIPv4(fqdn string, states ...string) ([]net.IP, error) {
+	return s.resolve(fqdn, netip.Addr.Is4, states)
+}
+
+// ResolveIPv6 returns the list of IPv6 addresses with the given FQDN of the
+// hosts in states, every host without states
+func (s *SqlStore) ResolveIPv6(fqdn string, states ...string) ([]net.IP, error) {
+	return s.resolve(fqdn, netip.Addr.Is6, states)
+}
+
+// resolve returns the primary and secondary addresses of network interfaces
+// with the given FQDN in the address family matched by family, of the hosts
+// in states
+func (s *SqlStore) resolve(fqdn string, family func(netip.Addr) bool, states []string) ([]net.IP, error) {
 	if len(fqdn) == 0 {
 		return nil, errors.New("invalid fqdn")
 	}
 	fqdnString := strings.TrimSuffix(util.Normalize(fqdn), ".")
 	ips := make([]net.IP, 0)
 
-	rows, err := s.q.NodeResolve(context.Background(), s.ro, db.NodeResolveParams{FilterFQDN: 1, FQDN: fqdnString})
+	rows, err := s.q.NodeResolveFQDN(s.context(), s.ro, fqdnString)
 	if err != nil {
 		return nil, err
 	}
 
 	for _, row := range rows {
-		// TODO: consider refactor data model to store fqdn as an array? for
-		// now this code ensures only exact fqdn exact matches are returned, as
-		// the sql fetches rows with like %% because of the comma separated string
-		exatcMatch := false
-		for _, name := range strings.Split(row.FQDN.String, ",") {
-			if strings.ToLower(name) == fqdnString {
-				exatcMatch = true
-				break
-			}
-		}
-		if !exatcMatch {
+		if len(states) > 0 && !slices.Contains(states, row.State) {
 			continue
 		}
-
 		ip, _ := netip.ParsePrefix(row.IP.String)
-		if ip.IsValid() {
+		if ip.IsValid() && family(ip.Addr()) {
 			ips = append(ips, net.IP(ip.Addr().AsSlice()))
 		}
 	}
@@ -451,19 +855,23 @@ func (s *SqlStore) ResolveIPv4(fqdn string) ([]net.IP, error) {
 	return ips, nil
 }
 
-// ReverseResolve returns the list of FQDNs for the given IP
-func (s *SqlStore) ReverseResolve(ip string) ([]string, error) {
+// ReverseResolve returns the list of FQDNs for the given IP of the hosts in
+// states, every host without states
+func (s *SqlStore) ReverseResolve(ip string, states ...string) ([]string, error) {
 	if len(ip) == 0 {
 		return nil, errors.New("invalid ip")
 	}
 	fqdn := make([]string, 0)
 
-	rows, err := s.q.NodeResolve(context.Background(), s.ro, db.NodeResolveParams{FilterIP: 1, IP: ip})
+	rows, err := s.q.NodeResolveIP(s.context(), s.ro, ip)
 	if err != nil {
 		return nil, err
 	}
 
 	for _, row := range rows {
+		if len(states) > 0 && !slices.Contains(states, row.State) {
+			continue
+		}
 		names := strings.Split(row.FQDN.String, ",")
 		for _, name := range names {
 			fqdn = append(fqdn, name)
@@ -479,13 +887,21 @@ func (s *SqlStore) LoadHostFromMAC(mac string) (*model.Host, error) {
 	if len(mac) == 0 {
 		return nil, errors.New("invalid mac")
 	}
-	return s.findNodeFromParams(db.NodeFindParams{FilterMAC: 1, MAC: null.StringFrom(mac)})
+	nodeView, err := s.q.NodeFindByMAC(s.context(), s.ro, null.StringFrom(mac))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, store.ErrNotFound
+		}
+		return nil, err
+	}
+
+	return &nodeView.Host, nil
 }
 
 // Hosts returns a list of all the hosts
 func (s *SqlStore) Hosts() (model.HostList, error) {
 	hostList := make(model.HostList, 0)
-	nodes, err := s.q.NodeAll(context.Background(), s.ro)
+	nodes, err := s.q.NodeAll(s.context(), s.ro)
 	if err != nil {
 		return nil, err
 	}
@@ -496,10 +912,48 @@ func (s *SqlStore) Hosts() (model.HostList, error) {
 	return hostList, nil
 }
 
+// HostStats returns the number of hosts in total, set to provision and of
+// every boot image and tag. The counts are aggregated by the database, hosts
+// are not loaded
+func (s *SqlStore) HostStats() (*model.HostStats, error) {
+	ctx := s.context()
+	counts, err := s.q.NodeStats(ctx, s.ro)
+	if err != nil {
+		return nil, err
+	}
+
+	stats := &model.HostStats{
+		Total:            int(counts.Total),
+		Provisioning:     int(counts.Provisioning),
+		Unprovisioned:    int(counts.Total - counts.Provisioning),
+		WithoutBootImage: int(counts.WithoutKernel),
+		BootImages:       make([]model.NamedCount, 0),
+		Tags:             make([]model.NamedCount, 0),
+	}
+
+	kernels, err := s.q.KernelNodeCount(ctx, s.ro)
+	if err != nil {
+		return nil, err
+	}
+	for _, k := range kernels {
+		stats.BootImages = append(stats.BootImages, model.NamedCount{Name: k.Name, Hosts: int(k.Nodes)})
+	}
+
+	tags, err := s.q.TagNodeCount(ctx, s.ro)
+	if err != nil {
+		return nil, err
+	}
+	for _, t := range tags {
+		stats.Tags = append(stats.Tags, model.NamedCount{Name: t.Key, Hosts: int(t.Nodes)})
+	}
+
+	return stats, nil
+}
+
 // FindHosts returns a list of all the hosts in the given NodeSet
 func (s *SqlStore) FindHosts(ns *nodeset.NodeSet) (model.HostList, error) {
 	hostList := make(model.HostList, 0)
-	nodes, err := s.q.NodeFindNodeset(context.Background(), s.ro, ns.Iterator().StringSlice())
+	nodes, err := s.q.NodeFindNodeset(s.context(), s.ro, ns.Iterator().StringSlice())
 	if err != nil {
 		return nil, err
 	}
@@ -513,7 +967,7 @@ func (s *SqlStore) FindHosts(ns *nodeset.NodeSet) (model.HostList, error) {
 // FindTags returns a nodeset.NodeSet of all the hosts with the given tags
 func (s *SqlStore) FindTags(tags []string) (*nodeset.NodeSet, error) {
 	nodes := make([]string, 0)
-	names, err := s.q.NodeFindTags(context.Background(), s.ro, tags)
+	names, err := s.q.NodeFindTags(s.context(), s.ro, tags)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return nil, store.ErrNotFound
@@ -535,7 +989,7 @@ func (s *SqlStore) FindTags(tags []string) (*nodeset.NodeSet, error) {
 // MatchTags returns a nodeset.NodeSet of all the hosts with the all given tags
 func (s *SqlStore) MatchTags(tags []string) (*nodeset.NodeSet, error) {
 	nodes := make([]string, 0)
-	names, err := s.q.NodeFindTags(context.Background(), s.ro, tags)
+	names, err := s.q.NodeFindTags(s.context(), s.ro, tags)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return nil, store.ErrNotFound
@@ -557,28 +1011,87 @@ func (s *SqlStore) MatchTags(tags []string) (*nodeset.NodeSet, error) {
 	return nodeset.NewNodeSet(strings.Join(nodes, ","))
 }
 
-// ProvisionHosts sets all hosts in the given NodeSet to provision (true) or unprovision (false)
+// ProvisionHosts sets all hosts in the given NodeSet to provision (true) or
+// unprovision (false). Hosts set to provision move to ready-to-provision and
+// unprovisioned hosts being provisioned move to production
 func (s *SqlStore) ProvisionHosts(ns *nodeset.NodeSet, provision bool) error {
-	nodeID, err := s.q.NodeID(context.Background(), s.ro, ns.Iterator().StringSlice())
-	if err != nil {
-		if errors.Is(err, sql.ErrNoRows) {
-			return fmt.Errorf("no nodes found with nodeset %s:  %w", ns.String(), store.ErrNotFound)
+	return s.setHostsState(ns, func(current string) string {
+		switch {
+		case provision:
+			return model.HostStateReady
+		case model.HostStateProvisions(current):
+			return model.HostStateProduction
 		}
-		return err
+		return current
+	})
+}
+
+// SetHostsState sets the lifecycle state of all hosts in the given NodeSet.
+// Returns ErrConflict if a host may not move to the state
+func (s *SqlStore) SetHostsState(ns *nodeset.NodeSet, state string) error {
+	if err := model.ValidateHostState(state); err != nil {
+		return fmt.Errorf("%w: %w", store.ErrInvalidData, err)
 	}
 
-	return s.q.NodeProvision(context.Background(), s.rw, db.NodeProvisionParams{
-		Nodes:     nodeID,
-		Provision: provision,
-	})
+	return s.setHostsState(ns, func(string) string { return state })
 }
 
-// TagHosts adds tags to all hosts in the given NodeSet
-func (s *SqlStore) TagHosts(ns *nodeset.NodeSet, tags []string) error {
-	nodeID, err := s.q.NodeID(context.Background(), s.ro, ns.Iterator().StringSlice())
+// setHostsState moves each host in the NodeSet to the state returned by next
+// for its current state along with the provision flag
+func (s *SqlStore) setHostsState(ns *nodeset.NodeSet, next func(current string) string) error {
+	ctx := s.context()
+	tx, err := s.rw.BeginTx(s.context(), nil)
 	if err != nil {
-		if errors.Is(err, sql.ErrNoRows) {
-			return fmt.Errorf("no nodes found with nodeset %s:  %w", ns.String(), store.ErrNotFound)
+		return err
+	}
+	defer tx.Rollback()
+
+	nodeID, err := s.q.NodeID(ctx, tx, ns.Iterator().StringSlice())
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return fmt.Errorf("no nodes found with nodeset %s:  %w", ns.String(), store.ErrNotFound)
+		}
+		return err
+	}
+
+	if len(nodeID) == 0 {
+		return fmt.Errorf("no nodes found with nodeset %s:  %w", ns.String(), store.ErrNotFound)
+	}
+
+	states := make(map[string][]int64)
+	for _, id := range nodeID {
+		current, err := s.q.NodeState(ctx, tx, id)
+		if err != nil {
+			return err
+		}
+
+		state := next(current.State)
+		if !model.HostStateTransitionAllowed(current.State, state) {
+			return fmt.Errorf("%w: host %s may not move from state %s to %s", store.ErrConflict, current.Name, current.State, state)
+		}
+		states[state] = append(states[state], id)
+	}
+
+	for state, ids := range states {
+		err := s.q.NodeProvision(ctx, tx, db.NodeProvisionParams{
+			Nodes:     ids,
+			Provision: model.HostStateProvisions(state),
+			State:     state,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+// TagHosts adds tags to all hosts in the given NodeSet
+func (s *SqlStore) TagHosts(ns *nodeset.NodeSet, tags []string) error {
+	nodeID, err := s.q.NodeID(s.context(), s.ro, ns.Iterator().StringSlice())
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return fmt.Errorf("no nodes found with nodeset %s:  %w", ns.String(), store.ErrNotFound)
 		}
 		return err
 	}
@@ -587,8 +1100,8 @@ func (s *SqlStore) TagHosts(ns *nodeset.NodeSet, tags []string) error {
 		return fmt.Errorf("no nodes found with nodeset %s:  %w", ns.String(), store.ErrNotFound)
 	}
 
-	ctx := context.Background()
-	tx, err := s.rw.Begin()
+	ctx := s.context()
+	tx, err := s.rw.BeginTx(s.context(), nil)
 	if err != nil {
 		return err
 	}
@@ -612,12 +1125,16 @@ func (s *SqlStore) TagHosts(ns *nodeset.NodeSet, tags []string) error {
 		}
 	}
 
+	if err := s.q.NodeRevisionIncrement(ctx, tx, nodeID); err != nil {
+		return err
+	}
+
 	return tx.Commit()
 }
 
 // UntagHosts removes tags from all hosts in the given NodeSet
 func (s *SqlStore) UntagHosts(ns *nodeset.NodeSet, tags []string) error {
-	nodeID, err := s.q.NodeID(context.Background(), s.ro, ns.Iterator().StringSlice())
+	nodeID, err := s.q.NodeID(s.context(), s.ro, ns.Iterator().StringSlice())
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return fmt.Errorf("no nodes found with nodeset %s:  %w", ns.String(), store.ErrNotFound)
@@ -625,7 +1142,7 @@ func (s *SqlStore) UntagHosts(ns *nodeset.NodeSet, tags []string) error {
 		return err
 	}
 
-	tagID, err := s.q.TagID(context.Background(), s.ro, tags)
+	tagID, err := s.q.TagID(s.context(), s.ro, tags)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return fmt.Errorf("no tags found %v: %s", tags, store.ErrNotFound)
@@ -633,29 +1150,103 @@ func (s *SqlStore) UntagHosts(ns *nodeset.NodeSet, tags []string) error {
 		return err
 	}
 
-	return s.q.NodeTagDelete(context.Background(), s.rw, db.NodeTagDeleteParams{
+	ctx := s.context()
+	tx, err := s.rw.BeginTx(s.context(), nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	err = s.q.NodeTagDelete(ctx, tx, db.NodeTagDeleteParams{
 		Nodes: nodeID,
 		Tags:  tagID,
 	})
+	if err != nil {
+		return err
+	}
+
+	if err := s.q.NodeRevisionIncrement(ctx, tx, nodeID); err != nil {
+		return err
+	}
+
+	return tx.Commit()
 }
 
 // SetBootImage sets all hosts to use the BootImage with the given name
 func (s *SqlStore) SetBootImage(ns *nodeset.NodeSet, name string) error {
-	ctx := context.Background()
+	kernelID, nodeID, err := s.bootImageIDs(ns, name)
+	if err != nil {
+		return err
+	}
+
+	return s.q.NodeBootKernel(s.context(), s.rw, db.NodeBootKernelParams{
+		Nodes:    nodeID,
+		KernelID: kernelID,
+	})
+}
+
+// SetBootImageIfCurrent sets all hosts to use the BootImage with the given
+// name if they are all assigned one of the current images, "" for none.
+// ErrConflict is returned and no host changed otherwise
+func (s *SqlStore) SetBootImageIfCurrent(ns *nodeset.NodeSet, name string, current ...string) error {
+	kernelID, nodeID, err := s.bootImageIDs(ns, name)
+	if err != nil {
+		return err
+	}
+
+	ctx := s.context()
+	tx, err := s.rw.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	n, err := s.q.NodeBootKernelIfCurrent(ctx, tx, db.NodeBootKernelIfCurrentParams{
+		KernelID: kernelID,
+		Nodes:    nodeID,
+		Current:  current,
+	})
+	if err != nil {
+		return err
+	}
+	if n != int64(len(nodeID)) {
+		return fmt.Errorf("%w: %d of nodes %s not assigned boot image %s", store.ErrConflict, int64(len(nodeID))-n, ns.String(), strings.Join(current, " or "))
+	}
+
+	return tx.Commit()
+}
+
+// bootImageIDs returns the ID of the boot image with the given name, null
+// when empty, and the IDs of the hosts in ns
+func (s *SqlStore) bootImageIDs(ns *nodeset.NodeSet, name string) (null.Int64, []int64, error) {
+	ctx := s.context()
 
 	kernelID := null.NewInt(0, false)
 	if name != "" {
 		kernel, err := s.q.KernelFetch(ctx, s.ro, name)
 		if err != nil {
 			if errors.Is(err, sql.ErrNoRows) {
-				return fmt.Errorf("no boot kernel found %s:  %w", name, store.ErrNotFound)
+				return kernelID, nil, fmt.Errorf("no boot kernel found %s:  %w", name, store.ErrNotFound)
 			}
-			return err
+			return kernelID, nil, err
 		}
 		kernelID.SetValid(kernel.ID)
 	}
 
-	nodeID, err := s.q.NodeID(context.Background(), s.ro, ns.Iterator().StringSlice())
+	nodeID, err := s.q.NodeID(ctx, s.ro, ns.Iterator().StringSlice())
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return kernelID, nil, fmt.Errorf("no nodes found with nodeset %s:  %w", ns.String(), store.ErrNotFound)
+		}
+		return kernelID, nil, err
+	}
+
+	return kernelID, nodeID, nil
+}
+
+// SetFirmware sets the firmware of all hosts, clearing it with a nil build
+func (s *SqlStore) SetFirmware(ns *nodeset.NodeSet, fw firmware.Build) error {
+	nodeID, err := s.q.NodeID(s.context(), s.ro, ns.Iterator().StringSlice())
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return fmt.Errorf("no nodes found with nodeset %s:  %w", ns.String(), store.ErrNotFound)
@@ -663,9 +1254,9 @@ func (s *SqlStore) SetBootImage(ns *nodeset.NodeSet, name string) error {
 		return err
 	}
 
-	return s.q.NodeBootKernel(context.Background(), s.rw, db.NodeBootKernelParams{
+	return s.q.NodeFirmware(s.context(), s.rw, db.NodeFirmwareParams{
+		Firmware: null.NewString(fw.String(), !fw.IsNil()),
 		Nodes:    nodeID,
-		KernelID: kernelID,
 	})
 }
 
@@ -676,17 +1267,29 @@ func (s *SqlStore) StoreBootImage(image *model.BootImage) error {
 
 // StoreBootImages stores a list of boot images in the data store. If the boot image exists it is overwritten
 func (s *SqlStore) StoreBootImages(images model.BootImageList) error {
-	ctx := context.Background()
-	tx, err := s.rw.Begin()
+	ctx := s.context()
+	tx, err := s.rw.BeginTx(s.context(), nil)
 	if err != nil {
 		return err
 	}
 	defer tx.Rollback()
 
+	if err := s.storeBootImages(ctx, tx, images); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (s *SqlStore) storeBootImages(ctx context.Context, tx *sql.Tx, images model.BootImageList) error {
+	var err error
 	for idx, image := range images {
 		if image.Name == "" {
 			return fmt.Errorf("name required for kernel %d: %w", idx, store.ErrInvalidData)
 		}
+		if image.KernelPath == "" && image.Inherits == "" {
+			return fmt.Errorf("kernel required for image %s not inheriting one: %w", image.Name, store.ErrInvalidData)
+		}
 
 		if image.UID.IsNil() {
 			image.UID, err = ksuid.NewRandom()
@@ -694,6 +1297,11 @@ func (s *SqlStore) StoreBootImages(images model.BootImageList) error {
 				return err
 			}
 		}
+
+		if err := s.checkRevision(ctx, tx, "image", image.Name, image.ID, image.Revision, s.q.KernelRevision); err != nil {
+			return err
+		}
+
 		// Upsert kernel
 		kernel, err := s.q.KernelUpsert(ctx, tx, db.KernelUpsertParams{
 			ID:          null.NewInt(image.ID, image.ID != 0),
@@ -756,12 +1364,57 @@ func (s *SqlStore) StoreBootImages(images model.BootImageList) error {
 		}
 
 		image.ID = kernel.ID
+		image.Revision = kernel.Revision
 	}
-	return tx.Commit()
+
+	// Parents are set once all images are stored so they may be stored
+	// together in any order
+	for _, image := range images {
+		if err := s.storeBootImageParent(ctx, tx, image); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// storeBootImageParent sets the parent of image, refusing a parent which is
+// not found or inherits from image
+func (s *SqlStore) storeBootImageParent(ctx context.Context, tx *sql.Tx, image *model.BootImage) error {
+	var parent null.Int64
+	if image.Inherits != "" {
+		id, err := s.q.KernelID(ctx, tx, image.Inherits)
+		if errors.Is(err, sql.ErrNoRows) {
+			return fmt.Errorf("image %s inherits image %s which is not found: %w", image.Name, image.Inherits, store.ErrInvalidData)
+		}
+		if err != nil {
+			return err
+		}
+		parent = null.IntFrom(id)
+	}
+
+	err := s.q.KernelSetParent(ctx, tx, db.KernelSetParentParams{ParentID: parent, ID: image.ID})
+	if err != nil {
+		return err
+	}
+
+	for depth, id := 1, parent; id.Valid; depth++ {
+		if id.Int64 == image.ID {
+			return fmt.Errorf("image %s inherits image %s: %w: %w", image.Name, image.Inherits, model.ErrBootImageCycle, store.ErrInvalidData)
+		}
+		if depth > model.MaxInheritDepth {
+			return fmt.Errorf("image %s has more than %d parents: %w", image.Name, model.MaxInheritDepth, store.ErrInvalidData)
+		}
+		id, err = s.q.KernelParent(ctx, tx, id.Int64)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
 }
 
 func (s *SqlStore) storeTemplate(tx *sql.Tx, kid int64, ttype, name string) (int64, error) {
-	ctx := context.Background()
+	ctx := s.context()
 	tt, err := s.q.TemplateTypeUpsert(ctx, tx, db.TemplateTypeUpsertParams{
 		Name:    ttype,
 		UriName: ttype,
@@ -789,14 +1442,31 @@ func (s *SqlStore) storeTemplate(tx *sql.Tx, kid int64, ttype, name string) (int
 	return t.ID, nil
 }
 
-// DeleteBootImages deletes boot images from the data store.
+// DeleteBootImages deletes boot images from the data store. Images inherited
+// by images not deleted with them are refused with ErrConflict
 func (s *SqlStore) DeleteBootImages(names []string) error {
-	return s.q.KernelDelete(context.Background(), s.rw, names)
+	ctx := s.context()
+	children, err := s.q.KernelChildren(ctx, s.ro, names)
+	if err != nil {
+		return err
+	}
+
+	inherited := make([]string, 0)
+	for _, c := range children {
+		if !slices.Contains(names, c.Name) {
+			inherited = append(inherited, fmt.Sprintf("%s (inherited by %s)", c.Parent, c.Name))
+		}
+	}
+	if len(inherited) > 0 {
+		return fmt.Errorf("%w: delete or change the images inheriting %s first", store.ErrConflict, strings.Join(inherited, ", "))
+	}
+
+	return s.q.KernelDelete(ctx, s.rw, names)
 }
 
 // LoadBootImage returns a BootImage with the given name
 func (s *SqlStore) LoadBootImage(name string) (*model.BootImage, error) {
-	kernel, err := s.q.KernelFetch(context.Background(), s.ro, name)
+	kernel, err := s.q.KernelFetch(s.context(), s.ro, name)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return nil, store.ErrNotFound
@@ -810,7 +1480,7 @@ func (s *SqlStore) LoadBootImage(name string) (*model.BootImage, error) {
 // BootImages returns a list of all boot images
 func (s *SqlStore) BootImages() (model.BootImageList, error) {
 	imageList := make(model.BootImageList, 0)
-	kernels, err := s.q.KernelAll(context.Background(), s.ro)
+	kernels, err := s.q.KernelAll(s.context(), s.ro)
 	if err != nil {
 		return nil, err
 	}
@@ -821,141 +1491,1624 @@ func (s *SqlStore) BootImages() (model.BootImageList, error) {
 	return imageList, nil
 }
 
-// RestoreFrom restores the database using the provided data dump
-func (s *SqlStore) RestoreFrom(data model.DataDump) error {
-	ctx := context.Background()
-	for _, user := range data.Users {
-		_, err := s.q.UserCreate(ctx, s.rw, db.UserCreateParams{
-			Username:     user.Username,
-			Role:         user.Role,
-			PasswordHash: string(user.PasswordHash),
-		})
-		if err != nil {
-			return err
-		}
+// ReadBootImages returns the boot images of a database file without taking
+// its lock or migrating it, for checks run before the database is opened
+func ReadBootImages(filename string) (model.BootImageList, error) {
+	ro, err := openDB(ConfigDefault.Driver, ConfigDefault.DataSourceName(filename, false))
+	if err != nil {
+		return nil, err
 	}
+	defer ro.Close()
 
-	err := s.StoreBootImages(data.Images)
+	s := &SqlStore{rw: ro, ro: ro, q: db.New()}
+	return s.BootImages()
+}
+
+// ReadHosts returns the hosts of a database file without taking its lock or
+// migrating it
+func ReadHosts(filename string) (model.HostList, error) {
+	ro, err := openDB(ConfigDefault.Driver, ConfigDefault.DataSourceName(filename, false))
 	if err != nil {
-		return err
+		return nil, err
 	}
+	defer ro.Close()
 
-	return s.StoreHosts(data.Hosts)
+	s := &SqlStore{rw: ro, ro: ro, q: db.New()}
+	return s.Hosts()
 }
 
-func (s *SqlStore) GetRolesByRoute(method, path string) (*[]string, error) {
-	ctx := context.Background()
-	roles, err := s.q.RoleFetchByMethodAndPath(ctx, s.ro, db.RoleFetchByMethodAndPathParams{
-		Method: method,
-		Path:   path,
-	})
+// ReadIPReservations returns the address reservations and the hosts of a
+// database file without taking its lock or migrating it
+func ReadIPReservations(filename string) (model.IPReservationList, model.HostList, error) {
+	ro, err := openDB(ConfigDefault.Driver, ConfigDefault.DataSourceName(filename, false))
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
+	defer ro.Close()
 
-	return &roles, nil
+	s := &SqlStore{rw: ro, ro: ro, q: db.New()}
+	reservations, err := s.IPReservations()
+	if err != nil {
+		return nil, nil, err
+	}
+	hosts, err := s.Hosts()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return reservations, hosts, nil
 }
 
-func (s *SqlStore) GetRoles() (model.RoleViewList, error) {
-	ctx := context.Background()
-	res, err := s.q.RoleFetchView(ctx, s.ro)
+func newRecord(r db.DnsRecord) *model.Record {
+	return &model.Record{
+		ID:    r.ID,
+		Name:  r.Name,
+		Type:  r.Type,
+		Value: r.Value,
+		TTL:   r.TTL,
+		PTR:   r.PTR,
+	}
+}
+
+func newRecordList(rows []db.DnsRecord) model.RecordList {
+	records := make(model.RecordList, 0, len(rows))
+	for _, r := range rows {
+		records = append(records, newRecord(r))
+	}
+
+	return records
+}
+
+// DNSRecords returns a list of all the DNS only records
+func (s *SqlStore) DNSRecords() (model.RecordList, error) {
+	rows, err := s.q.DNSRecordAll(s.context(), s.ro)
 	if err != nil {
 		return nil, err
 	}
 
-	return res, nil
+	return newRecordList(rows), nil
 }
 
-func (s *SqlStore) GetRolesByName(name string) (*model.RoleView, error) {
-	ctx := context.Background()
-	res, err := s.q.RoleFetchViewByName(ctx, s.ro, name)
+// FindDNSRecords returns the DNS only records with the given name
+func (s *SqlStore) FindDNSRecords(name string) (model.RecordList, error) {
+	if len(name) == 0 {
+		return nil, errors.New("invalid name")
+	}
+
+	rows, err := s.q.DNSRecordFetchName(s.context(), s.ro, strings.TrimSuffix(util.Normalize(name), "."))
 	if err != nil {
 		return nil, err
 	}
 
-	return &res, nil
+	return newRecordList(rows), nil
 }
 
-func (s *SqlStore) GetPermissions() (model.PermissionList, error) {
-	ctx := context.Background()
-	res, err := s.q.RoleFetchPermissions(ctx, s.ro)
+// ReverseResolveDNSRecords returns the DNS only A records with PTR set for the given IP
+func (s *SqlStore) ReverseResolveDNSRecords(ip string) (model.RecordList, error) {
+	if len(ip) == 0 {
+		return nil, errors.New("invalid ip")
+	}
+
+	rows, err := s.q.DNSRecordFetchPTR(s.context(), s.ro, ip)
 	if err != nil {
 		return nil, err
 	}
-	var output model.PermissionList
-	for _, p := range res {
-		output = append(output, model.Permission{
-			Method: p.Method,
-			Path:   p.Path,
-		})
-	}
 
-	return output, nil
+	return newRecordList(rows), nil
 }
 
-func (s *SqlStore) AddRole(role, inheritedRole string) error {
-	ctx := context.Background()
+// StoreDNSRecords stores a list of DNS only records. Records conflicting with
+// host interfaces or other records return ErrConflict
+func (s *SqlStore) StoreDNSRecords(records model.RecordList) error {
+	for _, r := range records {
+		r.Normalize()
+		if err := r.Validate(); err != nil {
+			return fmt.Errorf("%w: %w", store.ErrInvalidData, err)
+		}
+	}
 
-	roleId, err := s.q.RoleAdd(ctx, s.rw, role)
+	// Only hosts with an interface using the name or address of a record can
+	// conflict
+	params := db.NodeFindByFQDNOrIPParams{}
+	for _, r := range records {
+		params.Names = append(params.Names, r.Name)
+		if r.Type == model.RecordTypeA && r.PTR {
+			params.Addrs = append(params.Addrs, r.Value)
+		}
+	}
+	nodes, err := s.q.NodeFindByFQDNOrIP(s.context(), s.ro, params)
+	if err != nil {
+		return err
+	}
+	secondary, err := s.q.NodeFindBySecondaryAddress(s.context(), s.ro, db.NodeFindBySecondaryAddressParams(params))
 	if err != nil {
 		return err
 	}
+	hosts := make(model.HostList, 0, len(nodes)+len(secondary))
+	seen := make(map[int64]bool, len(nodes)+len(secondary))
+	for _, n := range append(nodes, secondary...) {
+		if seen[n.ID] {
+			continue
+		}
+		seen[n.ID] = true
+		hosts = append(hosts, &n.Host)
+	}
 
-	if inheritedRole == "" {
+	existing, err := s.DNSRecords()
+	if err != nil {
 		return err
 	}
 
-	inheritedRoleId, err := s.q.RoleFetchId(ctx, s.ro, inheritedRole)
+	if err := records.CheckConflicts(hosts, existing); err != nil {
+		return fmt.Errorf("%w: %w", store.ErrConflict, err)
+	}
+
+	ctx := s.context()
+	tx, err := s.rw.BeginTx(s.context(), nil)
 	if err != nil {
 		return err
 	}
-	permissionIds, err := s.q.RoleFetchPermissionsByRole(ctx, s.ro, inheritedRoleId)
+	defer tx.Rollback()
 
-	for _, id := range permissionIds {
-		err := s.q.RoleUpsertPermission(ctx, s.rw, db.RoleUpsertPermissionParams{
-			RoleID:       roleId,
-			PermissionID: id,
+	if err := s.storeDNSRecords(ctx, tx, records); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (s *SqlStore) storeDNSRecords(ctx context.Context, tx *sql.Tx, records model.RecordList) error {
+	for _, r := range records {
+		row, err := s.q.DNSRecordUpsert(ctx, tx, db.DNSRecordUpsertParams{
+			Name:  r.Name,
+			Type:  r.Type,
+			Value: r.Value,
+			TTL:   r.TTL,
+			PTR:   r.PTR,
 		})
 		if err != nil {
 			return err
 		}
+		r.ID = row.ID
 	}
 
-	return err
+	return nil
 }
 
-func (s *SqlStore) DeleteRole(roles []string) error {
-	ctx := context.Background()
+// DeleteDNSRecords deletes all DNS only records with the given names
+func (s *SqlStore) DeleteDNSRecords(names []string) error {
+	for i, name := range names {
+		names[i] = strings.TrimSuffix(util.Normalize(name), ".")
+	}
 
-	for _, r := range roles {
-		err := s.q.RoleDelete(ctx, s.rw, r)
-		if err != nil {
-			return err
-		}
+	return s.q.DNSRecordDelete(s.context(), s.rw, names)
+}
+
+// Credentials returns the encrypted credentials of all hosts
+func (s *SqlStore) Credentials() (model.CredentialList, error) {
+	rows, err := s.q.NodeCredentialAll(s.context(), s.ro)
+	if err != nil {
+		return nil, err
 	}
 
-	return nil
+	creds := make(model.CredentialList, 0, len(rows))
+	for _, r := range rows {
+		creds = append(creds, &model.Credential{Name: r.Name, Kind: r.Kind, Secret: r.Secret})
+	}
+
+	return creds, nil
 }
 
-func (s *SqlStore) UpdateRolePermissions(role string, permissions model.PermissionList) error {
-	ctx := context.Background()
-	roleId, err := s.q.RoleFetchId(ctx, s.ro, role)
+// FindCredentials returns the encrypted credentials of all hosts in the given NodeSet
+func (s *SqlStore) FindCredentials(ns *nodeset.NodeSet) (model.CredentialList, error) {
+	rows, err := s.q.NodeCredentialFind(s.context(), s.ro, ns.Iterator().StringSlice())
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	var upsertedIds []int64
-	for _, p := range permissions {
-		permissionId, err := s.q.RoleFetchPermissionId(ctx, s.ro, db.RoleFetchPermissionIdParams{
-			Method: p.Method,
-			Path:   p.Path,
-		})
-		if err != nil {
-			return err
-		}
+	creds := make(model.CredentialList, 0, len(rows))
+	for _, r := range rows {
+		creds = append(creds, &model.Credential{Name: r.Name, Kind: r.Kind, Secret: r.Secret})
+	}
 
-		err = s.q.RoleUpsertPermission(ctx, s.rw, db.RoleUpsertPermissionParams{
-			RoleID:       roleId,
+	return creds, nil
+}
+
+// LoadCredential returns the encrypted credential of the given kind for the host with the given name
+func (s *SqlStore) LoadCredential(name, kind string) (*model.Credential, error) {
+	row, err := s.q.NodeCredentialFetch(s.context(), s.ro, db.NodeCredentialFetchParams{
+		Name: name,
+		Kind: kind,
+	})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, store.ErrNotFound
+		}
+		return nil, err
+	}
+
+	return &model.Credential{Name: row.Name, Kind: row.Kind, Secret: row.Secret}, nil
+}
+
+// StoreHostInventory sets the firmware inventory of the host with the given
+// name. The revision of the host is unchanged as the inventory is reported by
+// the host and not edited
+func (s *SqlStore) StoreHostInventory(name string, inv *model.Inventory) error {
+	inventory, err := inventoryJSON(inv)
+	if err != nil {
+		return err
+	}
+
+	n, err := s.q.NodeInventorySet(s.context(), s.rw, db.NodeInventorySetParams{
+		Inventory: inventory,
+		Name:      name,
+	})
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return fmt.Errorf("%w: node %s", store.ErrNotFound, name)
+	}
+
+	return nil
+}
+
+// StoreHostHardware sets the hardware of the host with the given name. The
+// revision of the host is unchanged as the hardware is collected from the BMC
+// and not edited
+func (s *SqlStore) StoreHostHardware(name string, hw *model.Hardware) error {
+	hardware, err := hardwareJSON(hw)
+	if err != nil {
+		return err
+	}
+
+	n, err := s.q.NodeHardwareSet(s.context(), s.rw, db.NodeHardwareSetParams{
+		Hardware: hardware,
+		Name:     name,
+	})
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return fmt.Errorf("%w: node %s", store.ErrNotFound, name)
+	}
+
+	return nil
+}
+
+// StoreHostClientCert sets the client certificate fingerprint and serial
+// number of the host with the given name, clearing them when empty. The
+// revision of the host is unchanged
+func (s *SqlStore) StoreHostClientCert(name, fingerprint, serial string) error {
+	n, err := s.q.NodeClientCertSet(s.context(), s.rw, db.NodeClientCertSetParams{
+		ClientCertFingerprint: null.NewString(fingerprint, fingerprint != ""),
+		ClientCertSerial:      null.NewString(serial, serial != ""),
+		Name:                  name,
+	})
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return fmt.Errorf("%w: node %s", store.ErrNotFound, name)
+	}
+
+	return nil
+}
+
+// StoreCredentials stores a list of encrypted credentials. Existing
+// credentials of the same host and kind are overwritten
+func (s *SqlStore) StoreCredentials(creds model.CredentialList) error {
+	for _, c := range creds {
+		if err := c.Validate(); err != nil {
+			return fmt.Errorf("%w: %w", store.ErrInvalidData, err)
+		}
+	}
+
+	ctx := s.context()
+	tx, err := s.rw.BeginTx(s.context(), nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	for _, c := range creds {
+		n, err := s.q.NodeCredentialUpsert(ctx, tx, db.NodeCredentialUpsertParams{
+			Kind:   c.Kind,
+			Secret: c.Secret,
+			Name:   c.Name,
+		})
+		if err != nil {
+			return err
+		}
+		if n == 0 {
+			return fmt.Errorf("%w: node %s", store.ErrNotFound, c.Name)
+		}
+	}
+
+	return tx.Commit()
+}
+
+// DeleteCredentials deletes the credentials of the given kind of all hosts in
+// the given NodeSet and returns the number deleted
+func (s *SqlStore) DeleteCredentials(ns *nodeset.NodeSet, kind string) (int, error) {
+	n, err := s.q.NodeCredentialDelete(s.context(), s.rw, db.NodeCredentialDeleteParams{
+		Kind:    kind,
+		Nodeset: ns.Iterator().StringSlice(),
+	})
+
+	return int(n), err
+}
+
+// Secrets returns all secrets without their values
+func (s *SqlStore) Secrets() (model.SecretList, error) {
+	rows, err := s.q.SecretAll(s.context(), s.ro)
+	if err != nil {
+		return nil, err
+	}
+
+	secrets := make(model.SecretList, 0, len(rows))
+	for _, r := range rows {
+		secrets = append(secrets, &model.Secret{Name: r.Name, OneTime: r.OneTime, UpdatedAt: r.UpdatedAt})
+	}
+
+	return secrets, nil
+}
+
+// LoadSecret returns the secret with the given name and its encrypted value
+func (s *SqlStore) LoadSecret(name string) (*model.Secret, error) {
+	row, err := s.q.SecretFetch(s.context(), s.ro, name)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, store.ErrNotFound
+		}
+		return nil, err
+	}
+
+	return &model.Secret{Name: row.Name, Value: row.Value, OneTime: row.OneTime, UpdatedAt: row.UpdatedAt}, nil
+}
+
+// StoreSecret stores a secret with an encrypted value, replacing the secret
+// with the same name
+func (s *SqlStore) StoreSecret(secret *model.Secret) error {
+	if err := secret.Validate(); err != nil {
+		return fmt.Errorf("%w: %w", store.ErrInvalidData, err)
+	}
+
+	return s.q.SecretUpsert(s.context(), s.rw, db.SecretUpsertParams{
+		Name:    secret.Name,
+		Value:   secret.Value,
+		OneTime: secret.OneTime,
+	})
+}
+
+// DeleteSecret deletes the secret with the given name
+func (s *SqlStore) DeleteSecret(name string) error {
+	n, err := s.q.SecretDelete(s.context(), s.rw, name)
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return store.ErrNotFound
+	}
+
+	return nil
+}
+
+// ClaimSecret records the secret with the given name was rendered for host
+// with the boot token with the given ID. Returns false if it was already
+// rendered for the token
+func (s *SqlStore) ClaimSecret(name, tokenID, host string) (bool, error) {
+	n, err := s.q.SecretFetchClaim(s.context(), s.rw, db.SecretFetchClaimParams{
+		Name:    name,
+		TokenID: tokenID,
+		Host:    host,
+	})
+
+	return n == 1, err
+}
+
+// PurgeSecretClaims deletes the claims of one-time secrets made before the
+// given time and returns the number deleted
+func (s *SqlStore) PurgeSecretClaims(before time.Time) (int, error) {
+	n, err := s.q.SecretFetchPurge(s.context(), s.rw, before.Unix())
+
+	return int(n), err
+}
+
+// HostFiles returns the files attached to all hosts without their data
+func (s *SqlStore) HostFiles() (model.HostFileList, error) {
+	rows, err := s.q.NodeFileAll(s.context(), s.ro)
+	if err != nil {
+		return nil, err
+	}
+
+	files := make(model.HostFileList, 0, len(rows))
+	for _, r := range rows {
+		files = append(files, &model.HostFile{Host: r.Host, Name: r.Name, Size: r.Size, UpdatedAt: r.UpdatedAt})
+	}
+
+	return files, nil
+}
+
+// FindHostFiles returns the files attached to all hosts in the given NodeSet
+// without their data
+func (s *SqlStore) FindHostFiles(ns *nodeset.NodeSet) (model.HostFileList, error) {
+	rows, err := s.q.NodeFileFind(s.context(), s.ro, ns.Iterator().StringSlice())
+	if err != nil {
+		return nil, err
+	}
+
+	files := make(model.HostFileList, 0, len(rows))
+	for _, r := range rows {
+		files = append(files, &model.HostFile{Host: r.Host, Name: r.Name, Size: r.Size, UpdatedAt: r.UpdatedAt})
+	}
+
+	return files, nil
+}
+
+// LoadHostFile returns the file with the given name attached to the host with
+// the given name, with its data
+func (s *SqlStore) LoadHostFile(host, name string) (*model.HostFile, error) {
+	row, err := s.q.NodeFileFetch(s.context(), s.ro, db.NodeFileFetchParams{
+		Host: host,
+		Name: name,
+	})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, store.ErrNotFound
+		}
+		return nil, err
+	}
+
+	return &model.HostFile{
+		Host:      row.Host,
+		Name:      row.Name,
+		Size:      int64(len(row.Data)),
+		UpdatedAt: row.UpdatedAt,
+		Data:      row.Data,
+	}, nil
+}
+
+// StoreHostFiles stores a list of files attached to hosts. Existing files of
+// the same host and name are overwritten
+func (s *SqlStore) StoreHostFiles(files model.HostFileList) error {
+	for _, f := range files {
+		if err := f.Validate(); err != nil {
+			return fmt.Errorf("%w: %w", store.ErrInvalidData, err)
+		}
+	}
+
+	ctx := s.context()
+	tx, err := s.rw.BeginTx(s.context(), nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	for _, f := range files {
+		data := f.Data
+		if data == nil {
+			data = []byte{}
+		}
+		n, err := s.q.NodeFileUpsert(ctx, tx, db.NodeFileUpsertParams{
+			Name: f.Name,
+			Data: data,
+			Host: f.Host,
+		})
+		if err != nil {
+			return err
+		}
+		if n == 0 {
+			return fmt.Errorf("%w: node %s", store.ErrNotFound, f.Host)
+		}
+	}
+
+	return tx.Commit()
+}
+
+// DeleteHostFiles deletes the files with the given name of all hosts in the
+// given NodeSet and returns the number deleted
+func (s *SqlStore) DeleteHostFiles(ns *nodeset.NodeSet, name string) (int, error) {
+	n, err := s.q.NodeFileDelete(s.context(), s.rw, db.NodeFileDeleteParams{
+		Name:    name,
+		Nodeset: ns.Iterator().StringSlice(),
+	})
+
+	return int(n), err
+}
+
+// FindHostLog returns the log entries of all hosts in the given NodeSet,
+// oldest first
+func (s *SqlStore) FindHostLog(ns *nodeset.NodeSet) (model.HostLogList, error) {
+	rows, err := s.q.NodeLogFind(s.context(), s.ro, ns.Iterator().StringSlice())
+	if err != nil {
+		return nil, err
+	}
+
+	entries := make(model.HostLogList, 0, len(rows))
+	for _, r := range rows {
+		entries = append(entries, &model.HostLogEntry{
+			Host:      r.Name,
+			Time:      time.Unix(r.LoggedAt, 0),
+			Severity:  r.Severity,
+			MessageID: r.MessageID,
+			Message:   r.Message,
+		})
+	}
+
+	return entries, nil
+}
+
+// StoreHostLog appends entries to the log of their host and drops the
+// entries beyond model.HostLogMaxEntries
+func (s *SqlStore) StoreHostLog(entries model.HostLogList) error {
+	ctx := s.context()
+	tx, err := s.rw.BeginTx(s.context(), nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	hosts := make(map[string]bool)
+	for _, e := range entries {
+		logged := e.Time
+		if logged.IsZero() {
+			logged = time.Now()
+		}
+
+		n, err := s.q.NodeLogInsert(ctx, tx, db.NodeLogInsertParams{
+			LoggedAt:  logged.Unix(),
+			Severity:  e.Severity,
+			MessageID: e.MessageID,
+			Message:   e.Message,
+			Name:      e.Host,
+		})
+		if err != nil {
+			return err
+		}
+		if n == 0 {
+			return fmt.Errorf("%w: node %s", store.ErrNotFound, e.Host)
+		}
+		hosts[e.Host] = true
+	}
+
+	for name := range hosts {
+		err := s.q.NodeLogTrim(ctx, tx, db.NodeLogTrimParams{
+			Name: name,
+			Keep: model.HostLogMaxEntries,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+func newPendingBMC(row db.PendingBmc) *model.PendingBMC {
+	return &model.PendingBMC{
+		MAC:         row.MAC,
+		VendorClass: row.VendorClass,
+		Relay:       row.Relay,
+		ServerIP:    row.ServerIP,
+		FirstSeen:   time.Unix(row.FirstSeen, 0),
+		LastSeen:    time.Unix(row.LastSeen, 0),
+	}
+}
+
+// PendingBMCs returns the BMCs seen on DHCP which are not registered to any
+// host, oldest first
+func (s *SqlStore) PendingBMCs() (model.PendingBMCList, error) {
+	rows, err := s.q.PendingBMCAll(s.context(), s.ro)
+	if err != nil {
+		return nil, err
+	}
+
+	bmcs := make(model.PendingBMCList, 0, len(rows))
+	for _, r := range rows {
+		bmcs = append(bmcs, newPendingBMC(r))
+	}
+
+	return bmcs, nil
+}
+
+// LoadPendingBMC returns the pending BMC with the given MAC address
+func (s *SqlStore) LoadPendingBMC(mac string) (*model.PendingBMC, error) {
+	hwaddr, err := net.ParseMAC(mac)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", store.ErrInvalidData, err)
+	}
+
+	row, err := s.q.PendingBMCFetch(s.context(), s.ro, hwaddr.String())
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf("%w: pending bmc %s", store.ErrNotFound, hwaddr)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return newPendingBMC(row), nil
+}
+
+// StorePendingBMC records a BMC seen on DHCP at bmc.LastSeen. A BMC already
+// pending keeps its first seen time
+func (s *SqlStore) StorePendingBMC(bmc *model.PendingBMC) error {
+	hwaddr, err := net.ParseMAC(bmc.MAC)
+	if err != nil {
+		return fmt.Errorf("%w: %w", store.ErrInvalidData, err)
+	}
+
+	seen := bmc.LastSeen
+	if seen.IsZero() {
+		seen = time.Now()
+	}
+
+	return s.q.PendingBMCUpsert(s.context(), s.rw, db.PendingBMCUpsertParams{
+		MAC:         hwaddr.String(),
+		VendorClass: bmc.VendorClass,
+		Relay:       bmc.Relay,
+		ServerIP:    bmc.ServerIP,
+		Seen:        seen.Unix(),
+	})
+}
+
+// DeletePendingBMC deletes the pending BMC with the given MAC address
+func (s *SqlStore) DeletePendingBMC(mac string) error {
+	hwaddr, err := net.ParseMAC(mac)
+	if err != nil {
+		return fmt.Errorf("%w: %w", store.ErrInvalidData, err)
+	}
+
+	n, err := s.q.PendingBMCDelete(s.context(), s.rw, hwaddr.String())
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return fmt.Errorf("%w: pending bmc %s", store.ErrNotFound, hwaddr)
+	}
+
+	return nil
+}
+
+// Tombstones returns the names of the hosts or boot images, depending on
+// kind, deleted at or after since
+func (s *SqlStore) Tombstones(kind string, since time.Time) (model.TombstoneList, error) {
+	rows, err := s.q.TombstoneFind(s.context(), s.ro, db.TombstoneFindParams{
+		Kind:  kind,
+		Since: since.Unix(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	tombstones := make(model.TombstoneList, 0, len(rows))
+	for _, r := range rows {
+		tombstones = append(tombstones, &model.Tombstone{
+			Name:      r.Name,
+			DeletedAt: time.Unix(r.DeletedAt, 0),
+		})
+	}
+
+	return tombstones, nil
+}
+
+// PurgeTombstones deletes the tombstones of entries deleted before the given
+// time and returns the number deleted
+func (s *SqlStore) PurgeTombstones(before time.Time) (int, error) {
+	n, err := s.q.TombstonePurge(s.context(), s.rw, before.Unix())
+
+	return int(n), err
+}
+
+// ImageFiles returns the kernel and initrd paths of all boot images and of
+// the previous versions of boot images replaced or deleted at or after since
+func (s *SqlStore) ImageFiles(since time.Time) ([]string, error) {
+	found, err := s.q.ImageFileFind(s.context(), s.ro, since.Unix())
+	if err != nil {
+		return nil, err
+	}
+
+	// Initrds appended to the ones of a parent image are stored with the
+	// prefix and the kernel of an image inheriting it is empty
+	paths := make([]string, 0, len(found))
+	for _, p := range found {
+		if p = strings.TrimPrefix(p, model.AppendPrefix); p != "" {
+			paths = append(paths, p)
+		}
+	}
+
+	return paths, nil
+}
+
+// PurgeImageFiles deletes the paths of previous versions of boot images
+// replaced or deleted before the given time and returns the number deleted
+func (s *SqlStore) PurgeImageFiles(before time.Time) (int, error) {
+	n, err := s.q.ImageFilePurge(s.context(), s.rw, before.Unix())
+
+	return int(n), err
+}
+
+// LatestChangeSeq returns the sequence number of the latest change journal
+// entry, kept by the sequence of the journal when older entries are purged
+func (s *SqlStore) LatestChangeSeq() (int64, error) {
+	seq, err := s.q.ChangeSeq(s.context(), s.ro)
+	if err != nil {
+		return 0, err
+	}
+
+	return seq.Latest, nil
+}
+
+// Changes returns up to limit entries of the change journal following the
+// sequence number since
+func (s *SqlStore) Changes(since int64, limit int) (*model.ChangeFeed, error) {
+	ctx := s.context()
+	tx, err := s.ro.BeginTx(s.context(), nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	seq, err := s.q.ChangeSeq(ctx, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := s.q.ChangeFind(ctx, tx, db.ChangeFindParams{
+		Since: since,
+		Limit: int64(limit),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	feed := &model.ChangeFeed{
+		Changes:   make(model.ChangeList, 0, len(rows)),
+		OldestSeq: seq.Oldest,
+		LatestSeq: seq.Latest,
+	}
+	if seq.Oldest == 0 {
+		// All entries were purged, the next entry follows the latest
+		feed.OldestSeq = seq.Latest + 1
+	}
+	feed.Truncated = since+1 < feed.OldestSeq
+
+	for _, r := range rows {
+		c := &model.Change{
+			Seq:       r.Seq,
+			ChangedAt: time.Unix(r.ChangedAt, 0),
+			Kind:      r.Kind,
+			Name:      r.Name,
+			Op:        r.Op,
+		}
+		if r.Diff.Valid {
+			if err := json.Unmarshal([]byte(r.Diff.String), &c.Diff); err != nil {
+				return nil, err
+			}
+		}
+		feed.Changes = append(feed.Changes, c)
+	}
+
+	return feed, nil
+}
+
+// PurgeChanges deletes the change journal entries recorded before the given
+// time and returns the number deleted
+func (s *SqlStore) PurgeChanges(before time.Time) (int, error) {
+	n, err := s.q.ChangePurge(s.context(), s.rw, before.Unix())
+
+	return int(n), err
+}
+
+// RevokeBootToken adds the boot token to the revoked token list. Expired
+// entries are pruned on each call
+func (s *SqlStore) RevokeBootToken(info *model.BootTokenInfo) error {
+	ctx := s.context()
+	tx, err := s.rw.BeginTx(s.context(), nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	err = s.q.RevokedTokenPrune(ctx, tx, time.Now().Unix())
+	if err != nil {
+		return err
+	}
+
+	var expires int64
+	if !info.ExpiresAt.IsZero() {
+		expires = info.ExpiresAt.Unix()
+	}
+
+	err = s.q.RevokedTokenInsert(ctx, tx, db.RevokedTokenInsertParams{
+		ID:      info.ID,
+		HostUID: info.HostID,
+		MAC:     info.MAC,
+		Expires: expires,
+	})
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// BootTokenRevoked returns true if the boot token with the given ID has been revoked
+func (s *SqlStore) BootTokenRevoked(id string) (bool, error) {
+	count, err := s.q.RevokedTokenExists(s.context(), s.ro, db.RevokedTokenExistsParams{
+		ID:  id,
+		Now: time.Now().Unix(),
+	})
+	if err != nil {
+		return false, err
+	}
+
+	return count > 0, nil
+}
+
+// RevokeCerts adds the certificates to the revoked certificate list and
+// returns the number not already revoked
+func (s *SqlStore) RevokeCerts(revoked model.RevokedCertList) (int, error) {
+	ctx := s.context()
+	tx, err := s.rw.BeginTx(s.context(), nil)
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback()
+
+	count := 0
+	for _, r := range revoked {
+		n, err := s.q.RevokedCertInsert(ctx, tx, db.RevokedCertInsertParams{
+			Serial: r.Serial,
+			Host:   r.Host,
+		})
+		if err != nil {
+			return 0, err
+		}
+		count += int(n)
+	}
+
+	return count, tx.Commit()
+}
+
+// RevokedCerts returns the revoked certificate list, oldest first
+func (s *SqlStore) RevokedCerts() (model.RevokedCertList, error) {
+	rows, err := s.q.RevokedCertList(s.context(), s.ro)
+	if err != nil {
+		return nil, err
+	}
+
+	revoked := make(model.RevokedCertList, 0, len(rows))
+	for _, r := range rows {
+		revoked = append(revoked, &model.RevokedCert{
+			Serial:    r.Serial,
+			Host:      r.Host,
+			RevokedAt: r.CreatedAt,
+		})
+	}
+
+	return revoked, nil
+}
+
+// StoreHAInstance writes the heartbeat and state of a grendel instance in
+// high availability mode
+func (s *SqlStore) StoreHAInstance(instance *model.HAInstance) error {
+	return s.q.HAInstanceUpsert(s.context(), s.rw, db.HAInstanceUpsertParams{
+		Name:      instance.Name,
+		Role:      instance.Role,
+		State:     instance.State,
+		Heartbeat: instance.Heartbeat.UnixMilli(),
+		Changed:   instance.Changed.UnixMilli(),
+	})
+}
+
+// HAInstances returns the last heartbeat and state of every grendel instance
+// in high availability mode
+func (s *SqlStore) HAInstances() (model.HAInstanceList, error) {
+	rows, err := s.q.HAInstanceList(s.context(), s.ro)
+	if err != nil {
+		return nil, err
+	}
+
+	instances := make(model.HAInstanceList, 0, len(rows))
+	for _, r := range rows {
+		instances = append(instances, &model.HAInstance{
+			Name:      r.Name,
+			Role:      r.Role,
+			State:     r.State,
+			Heartbeat: time.UnixMilli(r.Heartbeat),
+			Changed:   time.UnixMilli(r.Changed),
+		})
+	}
+
+	return instances, nil
+}
+
+// StoreMaintenance writes the global maintenance mode
+func (s *SqlStore) StoreMaintenance(m *model.Maintenance) error {
+	return s.q.MaintenanceUpsert(s.context(), s.rw, db.MaintenanceUpsertParams{
+		Enabled:   m.Enabled,
+		Reason:    m.Reason,
+		ChangedBy: m.ChangedBy,
+		Changed:   m.Changed.UnixMilli(),
+	})
+}
+
+// LoadMaintenance returns the global maintenance mode, disabled when it was
+// never set
+func (s *SqlStore) LoadMaintenance() (*model.Maintenance, error) {
+	r, err := s.q.MaintenanceGet(s.context(), s.ro)
+	if errors.Is(err, sql.ErrNoRows) {
+		return &model.Maintenance{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &model.Maintenance{
+		Enabled:   r.Enabled,
+		Reason:    r.Reason,
+		ChangedBy: r.ChangedBy,
+		Changed:   time.UnixMilli(r.Changed),
+	}, nil
+}
+
+// StoreSigningKeys adds the signing keys and updates when the existing ones
+// retire
+func (s *SqlStore) StoreSigningKeys(keys model.SigningKeyList) error {
+	ctx := s.context()
+	tx, err := s.rw.BeginTx(s.context(), nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	for _, k := range keys {
+		var retireAt int64
+		if !k.RetireAt.IsZero() {
+			retireAt = k.RetireAt.UnixMilli()
+		}
+		err := s.q.SigningKeyUpsert(ctx, tx, db.SigningKeyUpsertParams{
+			ID:       k.ID,
+			Kind:     k.Kind,
+			Secret:   k.Secret,
+			Created:  k.CreatedAt.UnixMilli(),
+			RetireAt: retireAt,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+// SigningKeys returns the signing keys created by rotations, the newest of
+// each kind first. Their secrets are sealed
+func (s *SqlStore) SigningKeys() (model.SigningKeyList, error) {
+	rows, err := s.q.SigningKeyList(s.context(), s.ro)
+	if err != nil {
+		return nil, err
+	}
+
+	keys := make(model.SigningKeyList, 0, len(rows))
+	for _, r := range rows {
+		k := &model.SigningKey{
+			ID:        r.ID,
+			Kind:      r.Kind,
+			Secret:    r.Secret,
+			CreatedAt: time.UnixMilli(r.Created),
+		}
+		if r.RetireAt != 0 {
+			k.RetireAt = time.UnixMilli(r.RetireAt)
+		}
+		keys = append(keys, k)
+	}
+
+	return keys, nil
+}
+
+// PurgeSigningKeys deletes the signing keys retired before the given time and
+// returns the number deleted
+func (s *SqlStore) PurgeSigningKeys(before time.Time) (int, error) {
+	n, err := s.q.SigningKeyPurge(s.context(), s.rw, before.UnixMilli())
+	return int(n), err
+}
+
+// ReserveIPs adds the address reservations. Returns ErrConflict and adds none
+// when an address is held by a reservation not expired
+func (s *SqlStore) ReserveIPs(reservations model.IPReservationList) error {
+	ctx := s.context()
+	tx, err := s.rw.BeginTx(s.context(), nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	for _, r := range reservations {
+		n, err := s.q.IPReservationAdd(ctx, tx, db.IPReservationAddParams{
+			IP:       r.IP,
+			Kind:     r.Kind,
+			Host:     r.Host,
+			Username: r.User,
+			Created:  r.CreatedAt.UnixMilli(),
+			Expires:  r.ExpiresAt.UnixMilli(),
+		})
+		if err != nil {
+			return err
+		}
+		if n == 0 {
+			return fmt.Errorf("%w: address %s is already reserved", store.ErrConflict, r.IP)
+		}
+	}
+
+	return tx.Commit()
+}
+
+// StoreIPReservations adds the address reservations, replacing the existing
+// reservations of the addresses
+func (s *SqlStore) StoreIPReservations(reservations model.IPReservationList) error {
+	ctx := s.context()
+	tx, err := s.rw.BeginTx(s.context(), nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	for _, r := range reservations {
+		err := s.q.IPReservationUpsert(ctx, tx, db.IPReservationUpsertParams{
+			IP:       r.IP,
+			Kind:     r.Kind,
+			Host:     r.Host,
+			Username: r.User,
+			Created:  r.CreatedAt.UnixMilli(),
+			Expires:  r.ExpiresAt.UnixMilli(),
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+// IPReservations returns the address reservations, expired ones included
+func (s *SqlStore) IPReservations() (model.IPReservationList, error) {
+	rows, err := s.q.IPReservationList(s.context(), s.ro)
+	if err != nil {
+		return nil, err
+	}
+
+	reservations := make(model.IPReservationList, 0, len(rows))
+	for _, r := range rows {
+		reservations = append(reservations, &model.IPReservation{
+			IP:        r.IP,
+			Kind:      r.Kind,
+			Host:      r.Host,
+			User:      r.Username,
+			CreatedAt: time.UnixMilli(r.Created),
+			ExpiresAt: time.UnixMilli(r.Expires),
+		})
+	}
+
+	return reservations, nil
+}
+
+// DeleteIPReservations deletes the reservations of the addresses and returns
+// the number deleted
+func (s *SqlStore) DeleteIPReservations(ips []string) (int, error) {
+	ctx := s.context()
+	tx, err := s.rw.BeginTx(s.context(), nil)
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback()
+
+	deleted := 0
+	for _, ip := range ips {
+		n, err := s.q.IPReservationDelete(ctx, tx, ip)
+		if err != nil {
+			return 0, err
+		}
+		deleted += int(n)
+	}
+
+	return deleted, tx.Commit()
+}
+
+// ScheduledActions returns all scheduled actions ordered by run time
+func (s *SqlStore) ScheduledActions() (model.ScheduledActionList, error) {
+	rows, err := s.q.ScheduledActionList(s.context(), s.ro)
+	if err != nil {
+		return nil, err
+	}
+
+	return scheduledActions(rows)
+}
+
+// LoadScheduledAction returns the scheduled action with the given ID
+func (s *SqlStore) LoadScheduledAction(id int64) (*model.ScheduledAction, error) {
+	row, err := s.q.ScheduledActionGet(s.context(), s.ro, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, store.ErrNotFound
+		}
+		return nil, err
+	}
+
+	return scheduledAction(row)
+}
+
+// StoreScheduledAction adds a pending scheduled action and sets its ID,
+// creation time and status
+func (s *SqlStore) StoreScheduledAction(action *model.ScheduledAction) error {
+	if err := action.Validate(); err != nil {
+		return fmt.Errorf("%w: %w", store.ErrInvalidData, err)
+	}
+
+	createdAt := time.Now()
+	id, err := s.q.ScheduledActionAdd(s.context(), s.rw, db.ScheduledActionAddParams{
+		Action:    action.Action,
+		Nodeset:   action.Nodeset,
+		Arg:       action.Arg,
+		RunAt:     action.RunAt.UnixMilli(),
+		CreatedBy: action.CreatedBy,
+		CreatedAt: createdAt.UnixMilli(),
+	})
+	if err != nil {
+		return err
+	}
+
+	action.ID = id
+	action.CreatedAt = time.UnixMilli(createdAt.UnixMilli())
+	action.Status = model.ScheduleStatusPending
+
+	return nil
+}
+
+// CancelScheduledAction cancels the scheduled action with the given ID
+func (s *SqlStore) CancelScheduledAction(id int64) error {
+	n, err := s.q.ScheduledActionCancel(s.context(), s.rw, db.ScheduledActionCancelParams{
+		FinishedAt: time.Now().UnixMilli(),
+		ID:         id,
+	})
+	if err != nil {
+		return err
+	}
+	if n == 1 {
+		return nil
+	}
+
+	action, err := s.LoadScheduledAction(id)
+	if err != nil {
+		return err
+	}
+
+	return fmt.Errorf("%w: scheduled action %d is %s", store.ErrConflict, id, action.Status)
+}
+
+// DueScheduledActions returns the pending scheduled actions due to run at or
+// before now
+func (s *SqlStore) DueScheduledActions(now time.Time) (model.ScheduledActionList, error) {
+	rows, err := s.q.ScheduledActionDue(s.context(), s.ro, now.UnixMilli())
+	if err != nil {
+		return nil, err
+	}
+
+	return scheduledActions(rows)
+}
+
+// StartScheduledAction moves the pending scheduled action with the given ID
+// to status. Returns false if it is no longer pending. Missed actions have no
+// start time
+func (s *SqlStore) StartScheduledAction(id int64, status string) (bool, error) {
+	var startedAt int64
+	if status == model.ScheduleStatusRunning {
+		startedAt = time.Now().UnixMilli()
+	}
+
+	n, err := s.q.ScheduledActionStart(s.context(), s.rw, db.ScheduledActionStartParams{
+		Status:    status,
+		StartedAt: startedAt,
+		ID:        id,
+	})
+
+	return n == 1, err
+}
+
+// FinishScheduledAction stores the status, results and error of a scheduled
+// action which ran
+func (s *SqlStore) FinishScheduledAction(action *model.ScheduledAction) error {
+	rj, err := json.Marshal(action.Results)
+	if err != nil {
+		return err
+	}
+
+	return s.q.ScheduledActionFinish(s.context(), s.rw, db.ScheduledActionFinishParams{
+		Status:     action.Status,
+		FinishedAt: action.FinishedAt.UnixMilli(),
+		Results:    string(rj),
+		Error:      action.Error,
+		ID:         action.ID,
+	})
+}
+
+// PurgeScheduledActions deletes the scheduled actions which finished before
+// the given time
+func (s *SqlStore) PurgeScheduledActions(before time.Time) (int, error) {
+	n, err := s.q.ScheduledActionPurge(s.context(), s.rw, before.UnixMilli())
+
+	return int(n), err
+}
+
+func scheduledActions(rows []db.ScheduledAction) (model.ScheduledActionList, error) {
+	actions := make(model.ScheduledActionList, 0, len(rows))
+	for _, r := range rows {
+		a, err := scheduledAction(r)
+		if err != nil {
+			return nil, err
+		}
+		actions = append(actions, a)
+	}
+
+	return actions, nil
+}
+
+func scheduledAction(r db.ScheduledAction) (*model.ScheduledAction, error) {
+	a := &model.ScheduledAction{
+		ID:        r.ID,
+		Action:    r.Action,
+		Nodeset:   r.Nodeset,
+		Arg:       r.Arg,
+		RunAt:     time.UnixMilli(r.RunAt),
+		CreatedBy: r.CreatedBy,
+		CreatedAt: time.UnixMilli(r.CreatedAt),
+		Status:    r.Status,
+		Error:     r.Error,
+	}
+	if r.StartedAt > 0 {
+		a.StartedAt = time.UnixMilli(r.StartedAt)
+	}
+	if r.FinishedAt > 0 {
+		a.FinishedAt = time.UnixMilli(r.FinishedAt)
+	}
+	if err := json.Unmarshal([]byte(r.Results), &a.Results); err != nil {
+		return nil, fmt.Errorf("invalid results of scheduled action %d: %w", r.ID, err)
+	}
+
+	return a, nil
+}
+
+// RestoreFrom restores the database using the provided data dump
+func (s *SqlStore) RestoreFrom(data model.DataDump) error {
+	ctx := s.context()
+	for _, user := range data.Users {
+		_, err := s.q.UserCreate(ctx, s.rw, db.UserCreateParams{
+			Username:     user.Username,
+			Role:         user.Role,
+			PasswordHash: string(user.PasswordHash),
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	// A restore always overwrites, revisions in the dump are not checked
+	for _, i := range data.Images {
+		i.Revision = 0
+	}
+	for _, h := range data.Hosts {
+		h.Revision = 0
+	}
+
+	err := s.StoreBootImages(data.Images)
+	if err != nil {
+		return err
+	}
+
+	err = s.StoreHosts(data.Hosts)
+	if err != nil {
+		return err
+	}
+
+	if len(data.DNSRecords) > 0 {
+		err = s.StoreDNSRecords(data.DNSRecords)
+		if err != nil {
+			return err
+		}
+	}
+
+	if len(data.Credentials) == 0 {
+		return nil
+	}
+
+	return s.StoreCredentials(data.Credentials)
+}
+
+// LoadFrom makes the hosts, boot images and DNS records in the data store
+// match data in a single transaction. Entries missing from data are removed
+// if prune is true. If dryRun is true the data store is not changed.
+func (s *SqlStore) LoadFrom(data model.DataDump, prune, dryRun bool) (*model.DataDumpDiff, error) {
+	current := &model.DataDump{}
+	var err error
+	if current.Hosts, err = s.Hosts(); err != nil {
+		return nil, err
+	}
+	if current.Images, err = s.BootImages(); err != nil {
+		return nil, err
+	}
+	if current.DNSRecords, err = s.DNSRecords(); err != nil {
+		return nil, err
+	}
+
+	for _, r := range data.DNSRecords {
+		r.Normalize()
+		if err := r.Validate(); err != nil {
+			return nil, fmt.Errorf("%w: %w", store.ErrInvalidData, err)
+		}
+	}
+
+	diff := data.Diff(current, prune)
+
+	// Check the resulting hosts, images and records are consistent before
+	// changing anything
+	hosts := slices.Clone(data.Hosts)
+	images := make(map[string]*model.BootImage)
+	for _, i := range data.Images {
+		images[i.Name] = i
+	}
+	records := model.RecordList{}
+	if !prune {
+		loaded := make(map[string]bool)
+		for _, h := range data.Hosts {
+			loaded[h.Name] = true
+		}
+		for _, h := range current.Hosts {
+			if !loaded[h.Name] {
+				hosts = append(hosts, h)
+			}
+		}
+		for _, i := range current.Images {
+			if _, ok := images[i.Name]; !ok {
+				images[i.Name] = i
+			}
+		}
+		records = current.DNSRecords
+	}
+	for _, h := range hosts {
+		if h.BootImage != "" && images[h.BootImage] == nil {
+			return nil, fmt.Errorf("%w: boot image does not exist. image=%s node=%s", store.ErrInvalidData, h.BootImage, h.Name)
+		}
+	}
+	if err := data.DNSRecords.CheckConflicts(hosts, records); err != nil {
+		return nil, fmt.Errorf("%w: %w", store.ErrConflict, err)
+	}
+
+	if dryRun || diff.Changed() == 0 {
+		return diff, nil
+	}
+
+	ctx := s.context()
+	tx, err := s.rw.BeginTx(s.context(), nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	currentImages := make(map[string]*model.BootImage)
+	for _, i := range current.Images {
+		currentImages[i.Name] = i
+	}
+	changedImages := model.BootImageList{}
+	for _, i := range data.Images {
+		if slices.Contains(diff.Images.Added, i.Name) || slices.Contains(diff.Images.Updated, i.Name) {
+			i.ID, i.Revision = 0, 0
+			if old, ok := currentImages[i.Name]; ok {
+				i.ID = old.ID
+				i.UID = old.UID
+			}
+			changedImages = append(changedImages, i)
+		}
+	}
+	if err := s.storeBootImages(ctx, tx, changedImages); err != nil {
+		return nil, err
+	}
+
+	if len(diff.Hosts.Removed) > 0 {
+		if err := s.q.NodeDelete(ctx, tx, diff.Hosts.Removed); err != nil {
+			return nil, err
+		}
+	}
+
+	currentHosts := make(map[string]*model.Host)
+	for _, h := range current.Hosts {
+		currentHosts[h.Name] = h
+	}
+	changedHosts := model.HostList{}
+	for _, h := range data.Hosts {
+		if !slices.Contains(diff.Hosts.Added, h.Name) && !slices.Contains(diff.Hosts.Updated, h.Name) {
+			continue
+		}
+
+		// IDs in data may belong to other entries in this data store, only
+		// keep the IDs of the existing host and its interfaces. UIDs of
+		// existing hosts never change and a load always overwrites
+		nicIDs := make(map[int64]bool)
+		h.ID, h.Revision = 0, 0
+		if old, ok := currentHosts[h.Name]; ok {
+			h.ID = old.ID
+			h.UID = old.UID
+			for _, n := range old.Interfaces {
+				nicIDs[n.ID] = true
+			}
+			for _, n := range old.Bonds {
+				nicIDs[n.ID] = true
+			}
+		}
+		for _, n := range h.Interfaces {
+			if !nicIDs[n.ID] {
+				n.ID = 0
+			}
+		}
+		for _, n := range h.Bonds {
+			if !nicIDs[n.ID] {
+				n.ID = 0
+			}
+		}
+		changedHosts = append(changedHosts, h)
+	}
+	if err := s.storeHosts(ctx, tx, changedHosts, true); err != nil {
+		return nil, err
+	}
+
+	if len(diff.Images.Removed) > 0 {
+		if err := s.q.KernelDelete(ctx, tx, diff.Images.Removed); err != nil {
+			return nil, err
+		}
+	}
+
+	changedRecords := model.RecordList{}
+	for _, r := range data.DNSRecords {
+		if slices.Contains(diff.DNSRecords.Added, r.Key()) || slices.Contains(diff.DNSRecords.Updated, r.Key()) {
+			changedRecords = append(changedRecords, r)
+		}
+	}
+	if err := s.storeDNSRecords(ctx, tx, changedRecords); err != nil {
+		return nil, err
+	}
+
+	removedIDs := make([]int64, 0)
+	for _, r := range current.DNSRecords {
+		if slices.Contains(diff.DNSRecords.Removed, r.Key()) {
+			removedIDs = append(removedIDs, r.ID)
+		}
+	}
+	if len(removedIDs) > 0 {
+		if err := s.q.DNSRecordDeleteID(ctx, tx, removedIDs); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return diff, nil
+}
+
+func (s *SqlStore) GetRolesByRoute(method, path string) (*[]string, error) {
+	ctx := s.context()
+	roles, err := s.q.RoleFetchByMethodAndPath(ctx, s.ro, db.RoleFetchByMethodAndPathParams{
+		Method: method,
+		Path:   path,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &roles, nil
+}
+
+func (s *SqlStore) GetRoles() (model.RoleViewList, error) {
+	ctx := s.context()
+	res, err := s.q.RoleFetchView(ctx, s.ro)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (s *SqlStore) GetRolesByName(name string) (*model.RoleView, error) {
+	ctx := s.context()
+	res, err := s.q.RoleFetchViewByName(ctx, s.ro, name)
+	if err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+func (s *SqlStore) GetPermissions() (model.PermissionList, error) {
+	ctx := s.context()
+	res, err := s.q.RoleFetchPermissions(ctx, s.ro)
+	if err != nil {
+		return nil, err
+	}
+	var output model.PermissionList
+	for _, p := range res {
+		output = append(output, model.Permission{
+			Method: p.Method,
+			Path:   p.Path,
+		})
+	}
+
+	return output, nil
+}
+
+func (s *SqlStore) AddRole(role, inheritedRole string) error {
+	ctx := s.context()
+
+	roleId, err := s.q.RoleAdd(ctx, s.rw, role)
+	if err != nil {
+		return err
+	}
+
+	if inheritedRole == "" {
+		return err
+	}
+
+	inheritedRoleId, err := s.q.RoleFetchId(ctx, s.ro, inheritedRole)
+	if err != nil {
+		return err
+	}
+	permissionIds, err := s.q.RoleFetchPermissionsByRole(ctx, s.ro, inheritedRoleId)
+
+	for _, id := range permissionIds {
+		err := s.q.RoleUpsertPermission(ctx, s.rw, db.RoleUpsertPermissionParams{
+			RoleID:       roleId,
+			PermissionID: id,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return err
+}
+
+func (s *SqlStore) DeleteRole(roles []string) error {
+	ctx := s.context()
+
+	for _, r := range roles {
+		err := s.q.RoleDelete(ctx, s.rw, r)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (s *SqlStore) UpdateRolePermissions(role string, permissions model.PermissionList) error {
+	ctx := s.context()
+	roleId, err := s.q.RoleFetchId(ctx, s.ro, role)
+	if err != nil {
+		return err
+	}
+
+	var upsertedIds []int64
+	for _, p := range permissions {
+		permissionId, err := s.q.RoleFetchPermissionId(ctx, s.ro, db.RoleFetchPermissionIdParams{
+			Method: p.Method,
+			Path:   p.Path,
+		})
+		if err != nil {
+			return err
+		}
+
+		err = s.q.RoleUpsertPermission(ctx, s.rw, db.RoleUpsertPermissionParams{
+			RoleID:       roleId,
 			PermissionID: permissionId,
 		})
 		if err != nil {
@@ -975,8 +3128,46 @@ func (s *SqlStore) UpdateRolePermissions(role string, permissions model.Permissi
 	return err
 }
 
-// Close closes the SqlStore database
+// Snapshot writes a consistent point in time copy of the database to filename
+// using VACUUM INTO, which does not block concurrent readers or writers.
+// Postgres databases are backed up with pg_dump instead
+func (s *SqlStore) Snapshot(filename string) error {
+	if s.postgres {
+		return errors.New("snapshots of a postgres database are not supported, back it up with pg_dump")
+	}
+
+	_, err := s.rw.ExecContext(s.context(), "VACUUM INTO ?", filename)
+	return err
+}
+
+// Ping reads the schema of the database through the read-write and the
+// read-only connections
+func (s *SqlStore) Ping() error {
+	query := "SELECT count(*) FROM sqlite_master"
+	if s.postgres {
+		query = "SELECT count(*) FROM information_schema.tables"
+	}
+
+	for _, conn := range []*sql.DB{s.rw, s.ro} {
+		var n int
+		if err := conn.QueryRowContext(s.context(), query).Scan(&n); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Close checkpoints the write-ahead log into the database file and closes
+// the SqlStore database
 func (s *SqlStore) Close() error {
+	if !s.postgres {
+		_, err := s.rw.ExecContext(s.context(), "PRAGMA wal_checkpoint(TRUNCATE)")
+		if err != nil {
+			store.Log.Warnf("Failed to checkpoint the write-ahead log: %s", err)
+		}
+	}
+
 	s.ro.Close()
 	return s.rw.Close()
 }
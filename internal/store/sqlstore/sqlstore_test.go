@@ -0,0 +1,47 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package sqlstore
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/ubccr/grendel/internal/store"
+	"github.com/ubccr/grendel/internal/tests"
+	"github.com/ubccr/grendel/pkg/model"
+)
+
+func TestWithContext(t *testing.T) {
+	store.Log.Logger.SetLevel(logrus.ErrorLevel)
+
+	db, err := New(":memory:")
+	require.NoError(t, err)
+	defer db.Close()
+
+	require.NoError(t, db.StoreHost(tests.HostFactory.MustCreate().(*model.Host)))
+
+	ctx, cancel := store.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+	hosts, err := db.WithContext(ctx).Hosts()
+	require.NoError(t, err)
+	assert.Len(t, hosts, 1)
+	assert.False(t, store.DeadlineExceeded(ctx, "test", err))
+
+	expired, cancel := store.WithTimeout(context.Background(), time.Nanosecond)
+	defer cancel()
+	<-expired.Done()
+	_, err = db.WithContext(expired).Hosts()
+	assert.Error(t, err)
+	assert.True(t, store.DeadlineExceeded(expired, "test", err))
+
+	// The store the context was set on is unchanged
+	hosts, err = db.Hosts()
+	require.NoError(t, err)
+	assert.Len(t, hosts, 1)
+}
@@ -0,0 +1,62 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package sqlstore
+
+import (
+	"testing"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/ubccr/grendel/internal/store"
+	"github.com/ubccr/grendel/internal/tests"
+	"github.com/ubccr/grendel/pkg/model"
+)
+
+func TestHostStats(t *testing.T) {
+	store.Log.Logger.SetLevel(logrus.ErrorLevel)
+
+	db, err := New(":memory:")
+	require.NoError(t, err)
+	defer db.Close()
+
+	stats, err := db.HostStats()
+	require.NoError(t, err)
+	assert.Equal(t, &model.HostStats{BootImages: []model.NamedCount{}, Tags: []model.NamedCount{}}, stats)
+
+	for _, name := range []string{"rocky", "ubuntu"} {
+		image := tests.BootImageFactory.MustCreate().(*model.BootImage)
+		image.Name = name
+		require.NoError(t, db.StoreBootImage(image))
+	}
+
+	hosts := make(model.HostList, 3)
+	for i := range hosts {
+		hosts[i] = tests.HostFactory.MustCreate().(*model.Host)
+		hosts[i].Tags = []string{}
+	}
+	hosts[0].BootImage = "rocky"
+	hosts[0].Provision = true
+	hosts[0].Tags = []string{"gpu", "a01"}
+	hosts[1].BootImage = "rocky"
+	hosts[1].Provision = false
+	hosts[1].Tags = []string{"a01"}
+	hosts[2].BootImage = ""
+	hosts[2].Provision = false
+	require.NoError(t, db.StoreHosts(hosts))
+
+	// A tag left without hosts
+	hosts[0].Tags = []string{"a01"}
+	require.NoError(t, db.StoreHost(hosts[0]))
+
+	stats, err = db.HostStats()
+	require.NoError(t, err)
+	assert.Equal(t, 3, stats.Total)
+	assert.Equal(t, 1, stats.Provisioning)
+	assert.Equal(t, 2, stats.Unprovisioned)
+	assert.Equal(t, 1, stats.WithoutBootImage)
+	assert.Equal(t, []model.NamedCount{{Name: "rocky", Hosts: 2}, {Name: "ubuntu", Hosts: 0}}, stats.BootImages)
+	assert.Equal(t, []model.NamedCount{{Name: "a01", Hosts: 2}, {Name: "gpu", Hosts: 0}}, stats.Tags)
+}
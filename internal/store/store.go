@@ -6,8 +6,11 @@
 package store
 
 import (
+	"context"
 	"net"
+	"time"
 
+	"github.com/ubccr/grendel/internal/firmware"
 	"github.com/ubccr/grendel/internal/logger"
 	"github.com/ubccr/grendel/pkg/model"
 	"github.com/ubccr/grendel/pkg/nodeset"
@@ -19,6 +22,11 @@ var (
 )
 
 type Store interface {
+	// WithContext returns a store sharing the data store whose operations
+	// are canceled when ctx is done, such as when the deadline of a request
+	// is exceeded
+	WithContext(ctx context.Context) Store
+
 	// StoreUser stores the User in the data store
 	StoreUser(username, password string) (string, error)
 
@@ -58,9 +66,22 @@ type Store interface {
 	// SetBootImage sets all hosts to use the BootImage with the given name
 	SetBootImage(ns *nodeset.NodeSet, name string) error
 
+	// SetBootImageIfCurrent sets all hosts to use the BootImage with the
+	// given name if they are all assigned one of the current images, "" for
+	// none. ErrConflict is returned otherwise
+	SetBootImageIfCurrent(ns *nodeset.NodeSet, name string, current ...string) error
+
+	// SetFirmware sets the firmware of all hosts, clearing it with a nil
+	// build
+	SetFirmware(ns *nodeset.NodeSet, fw firmware.Build) error
+
 	// Hosts returns a list of all the hosts
 	Hosts() (model.HostList, error)
 
+	// HostStats returns the number of hosts in total, set to provision and
+	// of every boot image and tag, counted by the datastore
+	HostStats() (*model.HostStats, error)
+
 	// FindHosts returns a list of all the hosts in the given NodeSet
 	FindHosts(ns *nodeset.NodeSet) (model.HostList, error)
 
@@ -73,6 +94,10 @@ type Store interface {
 	// ProvisionHosts sets all hosts in the given NodeSet to provision (true) or unprovision (false)
 	ProvisionHosts(ns *nodeset.NodeSet, provision bool) error
 
+	// SetHostsState sets the lifecycle state of all hosts in the given
+	// NodeSet. Returns ErrConflict if a host may not move to the state
+	SetHostsState(ns *nodeset.NodeSet, state string) error
+
 	// TagHosts adds tags to all hosts in the given NodeSet
 	TagHosts(ns *nodeset.NodeSet, tags []string) error
 
@@ -85,9 +110,38 @@ type Store interface {
 	// StoreHosts stores a list of hosts in the data store. If the host exists it is overwritten
 	StoreHosts(hosts model.HostList) error
 
-	// DeleteHosts deletes all hosts in the given nodeset.NodeSet from the data store.
+	// StoreHostInventory sets the firmware inventory of the host with the
+	// given name without changing its revision. Returns ErrNotFound if the
+	// host does not exist
+	StoreHostInventory(name string, inv *model.Inventory) error
+
+	// StoreHostHardware sets the hardware collected from the BMC of the host
+	// with the given name without changing its revision. Returns ErrNotFound
+	// if the host does not exist
+	StoreHostHardware(name string, hw *model.Hardware) error
+
+	// StoreHostClientCert sets the SHA-256 fingerprint and serial number of
+	// the client certificate of the host with the given name without
+	// changing its revision, clearing them when empty. Returns ErrNotFound if
+	// the host does not exist
+	StoreHostClientCert(name, fingerprint, serial string) error
+
+	// DeleteHosts moves all hosts in the given nodeset.NodeSet to the trash.
+	// Trashed hosts are no longer served and can be restored until purged
 	DeleteHosts(ns *nodeset.NodeSet) error
 
+	// TrashedHosts returns a list of all the hosts in the trash
+	TrashedHosts() (model.TrashedHostList, error)
+
+	// RestoreHosts restores all hosts in the given nodeset.NodeSet from the
+	// trash and returns the number restored. Returns ErrConflict if a host
+	// with the same name has been added since it was deleted
+	RestoreHosts(ns *nodeset.NodeSet) (int, error)
+
+	// PurgeTrash permanently deletes the hosts moved to the trash before the
+	// given time and returns the number deleted
+	PurgeTrash(before time.Time) (int, error)
+
 	// LoadHostFromID returns the Host with the given ID
 	LoadHostFromID(id string) (*model.Host, error)
 
@@ -97,15 +151,256 @@ type Store interface {
 	// LoadHostFromMAC returns the Host that has a network interface with the give MAC address
 	LoadHostFromMAC(mac string) (*model.Host, error)
 
-	// ResolveIPv4 returns the list of IPv4 addresses with the given FQDN
-	ResolveIPv4(fqdn string) ([]net.IP, error)
+	// LoadHostFromSMBIOSUUID returns the Host with the given SMBIOS UUID
+	LoadHostFromSMBIOSUUID(uuid string) (*model.Host, error)
+
+	// ResolveIPv4 returns the list of IPv4 addresses with the given FQDN of
+	// the hosts in states, every host without states
+	ResolveIPv4(fqdn string, states ...string) ([]net.IP, error)
+
+	// ResolveIPv6 returns the list of IPv6 addresses with the given FQDN of
+	// the hosts in states, every host without states
+	ResolveIPv6(fqdn string, states ...string) ([]net.IP, error)
+
+	// ReverseResolve returns the list of FQDNs for the given IP of the hosts
+	// in states, every host without states
+	ReverseResolve(ip string, states ...string) ([]string, error)
+
+	// DNSRecords returns a list of all the DNS only records
+	DNSRecords() (model.RecordList, error)
+
+	// FindDNSRecords returns the DNS only records with the given name
+	FindDNSRecords(name string) (model.RecordList, error)
+
+	// ReverseResolveDNSRecords returns the DNS only A records with PTR set for the given IP
+	ReverseResolveDNSRecords(ip string) (model.RecordList, error)
+
+	// StoreDNSRecords stores a list of DNS only records. Records conflicting
+	// with host interfaces or other records return ErrConflict
+	StoreDNSRecords(records model.RecordList) error
+
+	// DeleteDNSRecords deletes all DNS only records with the given names
+	DeleteDNSRecords(names []string) error
+
+	// Credentials returns the encrypted credentials of all hosts
+	Credentials() (model.CredentialList, error)
+
+	// FindCredentials returns the encrypted credentials of all hosts in the given NodeSet
+	FindCredentials(ns *nodeset.NodeSet) (model.CredentialList, error)
+
+	// LoadCredential returns the encrypted credential of the given kind for the host with the given name
+	LoadCredential(name, kind string) (*model.Credential, error)
+
+	// StoreCredentials stores a list of encrypted credentials. Existing
+	// credentials of the same host and kind are overwritten. Returns
+	// ErrNotFound if a host does not exist
+	StoreCredentials(creds model.CredentialList) error
+
+	// DeleteCredentials deletes the credentials of the given kind of all
+	// hosts in the given NodeSet and returns the number deleted
+	DeleteCredentials(ns *nodeset.NodeSet, kind string) (int, error)
+
+	// Secrets returns all secrets without their values
+	Secrets() (model.SecretList, error)
+
+	// LoadSecret returns the secret with the given name and its encrypted value
+	LoadSecret(name string) (*model.Secret, error)
+
+	// StoreSecret stores a secret with an encrypted value, replacing the
+	// secret with the same name
+	StoreSecret(secret *model.Secret) error
+
+	// DeleteSecret deletes the secret with the given name. Returns
+	// ErrNotFound if it does not exist
+	DeleteSecret(name string) error
+
+	// ClaimSecret records the secret with the given name was rendered for
+	// host with the boot token with the given ID. Returns false if it was
+	// already rendered for the token
+	ClaimSecret(name, tokenID, host string) (bool, error)
+
+	// PurgeSecretClaims deletes the claims of one-time secrets made before the
+	// given time and returns the number deleted
+	PurgeSecretClaims(before time.Time) (int, error)
+
+	// HostFiles returns the files attached to all hosts without their data
+	HostFiles() (model.HostFileList, error)
+
+	// FindHostFiles returns the files attached to all hosts in the given
+	// NodeSet without their data
+	FindHostFiles(ns *nodeset.NodeSet) (model.HostFileList, error)
+
+	// LoadHostFile returns the file with the given name attached to the host
+	// with the given name, with its data
+	LoadHostFile(host, name string) (*model.HostFile, error)
+
+	// StoreHostFiles stores a list of files attached to hosts. Existing files
+	// of the same host and name are overwritten. Returns ErrNotFound if a
+	// host does not exist
+	StoreHostFiles(files model.HostFileList) error
+
+	// DeleteHostFiles deletes the files with the given name of all hosts in
+	// the given NodeSet and returns the number deleted
+	DeleteHostFiles(ns *nodeset.NodeSet, name string) (int, error)
+
+	// FindHostLog returns the log entries of all hosts in the given NodeSet,
+	// oldest first
+	FindHostLog(ns *nodeset.NodeSet) (model.HostLogList, error)
+
+	// StoreHostLog appends entries to the log of their host. Only the latest
+	// model.HostLogMaxEntries of each host are kept. Returns ErrNotFound if
+	// a host does not exist
+	StoreHostLog(entries model.HostLogList) error
 
-	// ReverseResolve returns the list of FQDNs for the given IP
-	ReverseResolve(ip string) ([]string, error)
+	// PendingBMCs returns the BMCs seen on DHCP which are not registered to any host
+	PendingBMCs() (model.PendingBMCList, error)
+
+	// LoadPendingBMC returns the pending BMC with the given MAC address
+	LoadPendingBMC(mac string) (*model.PendingBMC, error)
+
+	// StorePendingBMC records a BMC seen on DHCP. A BMC already pending keeps
+	// its first seen time and has the rest of its fields updated
+	StorePendingBMC(bmc *model.PendingBMC) error
+
+	// DeletePendingBMC deletes the pending BMC with the given MAC address.
+	// Returns ErrNotFound if it does not exist
+	DeletePendingBMC(mac string) error
+
+	// Tombstones returns the names of the hosts or boot images, depending on
+	// kind, deleted at or after since
+	Tombstones(kind string, since time.Time) (model.TombstoneList, error)
+
+	// PurgeTombstones deletes the tombstones of entries deleted before the
+	// given time and returns the number deleted
+	PurgeTombstones(before time.Time) (int, error)
+
+	// ImageFiles returns the kernel and initrd paths of all boot images and
+	// of the previous versions of boot images replaced or deleted at or
+	// after since
+	ImageFiles(since time.Time) ([]string, error)
+
+	// PurgeImageFiles deletes the paths of previous versions of boot images
+	// replaced or deleted before the given time and returns the number
+	// deleted
+	PurgeImageFiles(before time.Time) (int, error)
+
+	// Changes returns up to limit entries of the change journal with a
+	// sequence number greater than since
+	Changes(since int64, limit int) (*model.ChangeFeed, error)
+
+	// LatestChangeSeq returns the sequence number of the latest change
+	// journal entry, 0 before the first change
+	LatestChangeSeq() (int64, error)
+
+	// PurgeChanges deletes the change journal entries recorded before the
+	// given time and returns the number deleted
+	PurgeChanges(before time.Time) (int, error)
+
+	// RevokeBootToken adds the boot token to the revoked token list. Entries
+	// are removed once the token would have expired
+	RevokeBootToken(info *model.BootTokenInfo) error
+
+	// BootTokenRevoked returns true if the boot token with the given ID has been revoked
+	BootTokenRevoked(id string) (bool, error)
+
+	// RevokeCerts adds the certificates to the revoked certificate list and
+	// returns the number not already revoked
+	RevokeCerts(revoked model.RevokedCertList) (int, error)
+
+	// RevokedCerts returns the revoked certificate list, oldest first
+	RevokedCerts() (model.RevokedCertList, error)
+
+	// StoreHAInstance writes the heartbeat and state of a grendel instance in
+	// high availability mode
+	StoreHAInstance(instance *model.HAInstance) error
+
+	// HAInstances returns the last heartbeat and state of every grendel
+	// instance in high availability mode
+	HAInstances() (model.HAInstanceList, error)
+
+	// StoreMaintenance writes the global maintenance mode
+	StoreMaintenance(m *model.Maintenance) error
+
+	// LoadMaintenance returns the global maintenance mode, disabled when it
+	// was never set
+	LoadMaintenance() (*model.Maintenance, error)
+
+	// StoreSigningKeys adds the signing keys and updates when the existing
+	// ones retire
+	StoreSigningKeys(keys model.SigningKeyList) error
+
+	// SigningKeys returns the signing keys created by rotations, the newest
+	// of each kind first. Their secrets are sealed
+	SigningKeys() (model.SigningKeyList, error)
+
+	// PurgeSigningKeys deletes the signing keys retired before the given time
+	// and returns the number deleted
+	PurgeSigningKeys(before time.Time) (int, error)
+
+	// ReserveIPs adds the address reservations. Returns ErrConflict and adds
+	// none when an address is held by a reservation not expired
+	ReserveIPs(reservations model.IPReservationList) error
+
+	// StoreIPReservations adds the address reservations, replacing the
+	// existing reservations of the addresses
+	StoreIPReservations(reservations model.IPReservationList) error
+
+	// IPReservations returns the address reservations, expired ones included
+	IPReservations() (model.IPReservationList, error)
+
+	// DeleteIPReservations deletes the reservations of the addresses and
+	// returns the number deleted
+	DeleteIPReservations(ips []string) (int, error)
+
+	// ScheduledActions returns all scheduled actions ordered by run time
+	ScheduledActions() (model.ScheduledActionList, error)
+
+	// LoadScheduledAction returns the scheduled action with the given ID
+	LoadScheduledAction(id int64) (*model.ScheduledAction, error)
+
+	// StoreScheduledAction adds a pending scheduled action and sets its ID,
+	// creation time and status
+	StoreScheduledAction(action *model.ScheduledAction) error
+
+	// CancelScheduledAction cancels the scheduled action with the given ID.
+	// Returns ErrNotFound if it does not exist and ErrConflict if it is no
+	// longer pending
+	CancelScheduledAction(id int64) error
+
+	// DueScheduledActions returns the pending scheduled actions due to run
+	// at or before now
+	DueScheduledActions(now time.Time) (model.ScheduledActionList, error)
+
+	// StartScheduledAction moves the pending scheduled action with the given
+	// ID to status, running or missed. Returns false if it is no longer
+	// pending, such as when cancelled or started by another instance
+	StartScheduledAction(id int64, status string) (bool, error)
+
+	// FinishScheduledAction stores the status, results and error of a
+	// scheduled action which ran
+	FinishScheduledAction(action *model.ScheduledAction) error
+
+	// PurgeScheduledActions deletes the scheduled actions which finished
+	// before the given time and returns the number deleted
+	PurgeScheduledActions(before time.Time) (int, error)
 
 	// RestoreFrom restores the database using the provided data dump
 	RestoreFrom(data model.DataDump) error
 
+	// LoadFrom makes the hosts, boot images and DNS records in the data store
+	// match data in a single transaction and returns the changes. Entries
+	// missing from data are removed if prune is true. If dryRun is true only
+	// the changes are returned
+	LoadFrom(data model.DataDump, prune, dryRun bool) (*model.DataDumpDiff, error)
+
+	// Snapshot writes a consistent point in time copy of the data store to
+	// filename, which must not exist
+	Snapshot(filename string) error
+
+	// Reindex rebuilds the secondary indexes used to look up hosts by IP
+	// address, FQDN and tag
+	Reindex() error
+
 	// GetRolesByRoute returns all roles that have access to the provided method and path
 	GetRolesByRoute(method, path string) (*[]string, error)
 
@@ -127,5 +422,8 @@ type Store interface {
 	// UpdateRolePermissions sets the permissions for the given role
 	UpdateRolePermissions(role string, permissions model.PermissionList) error
 
+	// Ping returns an error when the data store cannot be read
+	Ping() error
+
 	Close() error
 }
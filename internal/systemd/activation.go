@@ -0,0 +1,238 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+// Package systemd supports socket activation and the notify protocol of
+// systemd. Without systemd no sockets are passed and notifications are not
+// sent
+package systemd
+
+import (
+	"fmt"
+	"net"
+	"os"
+	"strconv"
+	"sync"
+
+	"github.com/coreos/go-systemd/v22/activation"
+)
+
+type socket struct {
+	name     string
+	listener net.Listener
+	conn     net.PacketConn
+	used     bool
+}
+
+func (s *socket) addr() net.Addr {
+	if s.listener != nil {
+		return s.listener.Addr()
+	}
+	return s.conn.LocalAddr()
+}
+
+// Sockets are the sockets passed by systemd socket activation. A nil Sockets
+// holds no sockets
+type Sockets struct {
+	mu      sync.Mutex
+	sockets []*socket
+}
+
+// Activated returns the sockets passed to the process by systemd, none when
+// it was not started by a socket unit. The LISTEN_* environment variables are
+// unset so child processes do not inherit them
+func Activated() (*Sockets, error) {
+	return fromFiles(activation.Files(true))
+}
+
+func fromFiles(files []*os.File) (*Sockets, error) {
+	s := &Sockets{}
+	for _, f := range files {
+		sock := &socket{name: f.Name()}
+		if l, err := net.FileListener(f); err == nil {
+			sock.listener = l
+		} else if c, err := net.FilePacketConn(f); err == nil {
+			sock.conn = c
+		} else {
+			f.Close()
+			s.Close()
+			return nil, fmt.Errorf("unsupported socket %s passed by systemd: %w", f.Name(), err)
+		}
+
+		// The listener and connection hold a duplicate of the descriptor
+		f.Close()
+		s.sockets = append(s.sockets, sock)
+	}
+
+	return s, nil
+}
+
+// Len returns the number of sockets passed by systemd
+func (s *Sockets) Len() int {
+	if s == nil {
+		return 0
+	}
+	return len(s.sockets)
+}
+
+// Listener returns the stream socket of service: the socket named service
+// with FileDescriptorName= in the socket unit, or else the socket bound to
+// address. It returns nil when systemd passed no such socket
+func (s *Sockets) Listener(service, address string) (net.Listener, error) {
+	sock, err := s.take(service, address, true)
+	if sock == nil || err != nil {
+		return nil, err
+	}
+
+	return sock.listener, nil
+}
+
+// PacketConn returns the datagram socket of service, matched like Listener
+func (s *Sockets) PacketConn(service, address string) (net.PacketConn, error) {
+	sock, err := s.take(service, address, false)
+	if sock == nil || err != nil {
+		return nil, err
+	}
+
+	return sock.conn, nil
+}
+
+// take returns the unused socket named service, or else the unused socket of
+// the same type bound to address, and marks it used
+func (s *Sockets) take(service, address string, stream bool) (*socket, error) {
+	if s == nil {
+		return nil, nil
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, sock := range s.sockets {
+		if sock.used || sock.name != service {
+			continue
+		}
+
+		if (sock.listener != nil) != stream {
+			return nil, fmt.Errorf("socket %s passed by systemd for %s is a %s socket", sock.name, service, sock.addr().Network())
+		}
+		sock.used = true
+		return sock, nil
+	}
+
+	for _, sock := range s.sockets {
+		if sock.used || (sock.listener != nil) != stream || !matchAddress(sock.addr(), address) {
+			continue
+		}
+
+		sock.used = true
+		return sock, nil
+	}
+
+	return nil, nil
+}
+
+// Provides returns true when an unused socket is named service or is bound
+// to address on network, tcp, udp or unix
+func (s *Sockets) Provides(service, network, address string) bool {
+	if s == nil {
+		return false
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	stream := network != "udp"
+	for _, sock := range s.sockets {
+		if sock.used {
+			continue
+		}
+		if sock.name == service || ((sock.listener != nil) == stream && matchAddress(sock.addr(), address)) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Unused returns the names and addresses of the sockets not taken by any
+// service
+func (s *Sockets) Unused() []string {
+	if s == nil {
+		return nil
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	unused := make([]string, 0)
+	for _, sock := range s.sockets {
+		if !sock.used {
+			unused = append(unused, fmt.Sprintf("%s (%s/%s)", sock.name, sock.addr(), sock.addr().Network()))
+		}
+	}
+
+	return unused
+}
+
+// Close closes the sockets not taken by any service
+func (s *Sockets) Close() {
+	if s == nil {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, sock := range s.sockets {
+		if sock.used {
+			continue
+		}
+		if sock.listener != nil {
+			sock.listener.Close()
+		} else {
+			sock.conn.Close()
+		}
+	}
+}
+
+// matchAddress returns true when addr is the address a service would bind.
+// Unspecified addresses of either family match each other, as systemd binds
+// [::] for ListenStream=<port>
+func matchAddress(addr net.Addr, address string) bool {
+	if ua, ok := addr.(*net.UnixAddr); ok {
+		return ua.Name == address
+	}
+
+	host, port, err := net.SplitHostPort(address)
+	if err != nil {
+		return false
+	}
+
+	var ip net.IP
+	switch a := addr.(type) {
+	case *net.TCPAddr:
+		ip = a.IP
+		if strconv.Itoa(a.Port) != port {
+			return false
+		}
+	case *net.UDPAddr:
+		ip = a.IP
+		if strconv.Itoa(a.Port) != port {
+			return false
+		}
+	default:
+		return false
+	}
+
+	want := net.ParseIP(host)
+	if host != "" && want == nil {
+		return false
+	}
+
+	wantUnspecified := host == "" || want.IsUnspecified()
+	if wantUnspecified || ip.IsUnspecified() {
+		return wantUnspecified && ip.IsUnspecified()
+	}
+
+	return ip.Equal(want)
+}
@@ -0,0 +1,67 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package systemd
+
+import (
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/coreos/go-systemd/v22/daemon"
+	"golang.org/x/sys/unix"
+)
+
+// Enabled returns true when the process was started by a Type=notify service
+// and should notify systemd
+func Enabled() bool {
+	return os.Getenv("NOTIFY_SOCKET") != ""
+}
+
+// Notify sends states to systemd. It returns false without an error when the
+// process was not started by a Type=notify service
+func Notify(states ...string) (bool, error) {
+	return daemon.SdNotify(false, strings.Join(states, "\n"))
+}
+
+// Ready tells systemd that the services are ready, with status shown by
+// systemctl status
+func Ready(status string) (bool, error) {
+	return Notify(daemon.SdNotifyReady, "STATUS="+status)
+}
+
+// Reloading tells systemd that the configuration is being reloaded, Ready
+// must be sent once done. The monotonic time is required by Type=notify-reload
+func Reloading() (bool, error) {
+	var ts unix.Timespec
+	if err := unix.ClockGettime(unix.CLOCK_MONOTONIC, &ts); err != nil {
+		return false, err
+	}
+	usec := ts.Nano() / int64(time.Microsecond)
+
+	return Notify(daemon.SdNotifyReloading, "MONOTONIC_USEC="+strconv.FormatInt(usec, 10))
+}
+
+// Stopping tells systemd that the services are shutting down
+func Stopping() (bool, error) {
+	return Notify(daemon.SdNotifyStopping)
+}
+
+// Watchdog resets the watchdog timer of the service
+func Watchdog() (bool, error) {
+	return Notify(daemon.SdNotifyWatchdog)
+}
+
+// WatchdogInterval returns how often Watchdog must be called, half of
+// WatchdogSec as systemd recommends. It is zero when the watchdog is not
+// enabled
+func WatchdogInterval() (time.Duration, error) {
+	d, err := daemon.SdWatchdogEnabled(false)
+	if err != nil {
+		return 0, err
+	}
+
+	return d / 2, nil
+}
@@ -0,0 +1,156 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package systemd
+
+import (
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// socketFile returns a file of the socket as systemd would pass it, named
+// name
+func socketFile(t *testing.T, s interface{ File() (*os.File, error) }, name string) *os.File {
+	f, err := s.File()
+	require.NoError(t, err)
+	defer f.Close()
+
+	fd, err := syscall.Dup(int(f.Fd()))
+	require.NoError(t, err)
+
+	return os.NewFile(uintptr(fd), name)
+}
+
+func TestSockets(t *testing.T) {
+	l, err := net.ListenTCP("tcp", &net.TCPAddr{IP: net.IPv4(127, 0, 0, 1)})
+	require.NoError(t, err)
+	defer l.Close()
+	c, err := net.ListenUDP("udp", &net.UDPAddr{IP: net.IPv4(127, 0, 0, 1)})
+	require.NoError(t, err)
+	defer c.Close()
+	unused, err := net.ListenTCP("tcp", &net.TCPAddr{IP: net.IPv4(127, 0, 0, 1)})
+	require.NoError(t, err)
+	defer unused.Close()
+
+	sockets, err := fromFiles([]*os.File{
+		socketFile(t, l, "LISTEN_FD_3"),
+		socketFile(t, c, "tftp"),
+		socketFile(t, unused, "LISTEN_FD_5"),
+	})
+	require.NoError(t, err)
+	defer sockets.Close()
+	assert.Equal(t, 3, sockets.Len())
+
+	assert.True(t, sockets.Provides("provision", "tcp", l.Addr().String()))
+	assert.False(t, sockets.Provides("provision", "udp", l.Addr().String()))
+
+	// Matched by name
+	_, err = sockets.Listener("tftp", "0.0.0.0:69")
+	assert.ErrorContains(t, err, "socket tftp passed by systemd for tftp is a udp socket")
+	pc, err := sockets.PacketConn("tftp", "0.0.0.0:69")
+	require.NoError(t, err)
+	assert.Equal(t, c.LocalAddr().String(), pc.LocalAddr().String())
+
+	// Matched by address
+	listener, err := sockets.Listener("provision", l.Addr().String())
+	require.NoError(t, err)
+	assert.Equal(t, l.Addr().String(), listener.Addr().String())
+
+	// Taken sockets are not matched again
+	listener, err = sockets.Listener("api", l.Addr().String())
+	assert.NoError(t, err)
+	assert.Nil(t, listener)
+	assert.False(t, sockets.Provides("api", "tcp", l.Addr().String()))
+
+	assert.Equal(t, []string{"LISTEN_FD_5 (" + unused.Addr().String() + "/tcp)"}, sockets.Unused())
+
+	var none *Sockets
+	listener, err = none.Listener("provision", ":80")
+	assert.NoError(t, err)
+	assert.Nil(t, listener)
+	assert.Empty(t, none.Unused())
+}
+
+func TestMatchAddress(t *testing.T) {
+	tests := []struct {
+		addr    net.Addr
+		address string
+		want    bool
+	}{
+		{&net.TCPAddr{IP: net.IPv6unspecified, Port: 80}, "0.0.0.0:80", true},
+		{&net.TCPAddr{IP: net.IPv6unspecified, Port: 80}, ":80", true},
+		{&net.TCPAddr{IP: net.IPv4zero, Port: 80}, "[::]:80", true},
+		{&net.TCPAddr{IP: net.IPv4zero, Port: 80}, "0.0.0.0:8080", false},
+		{&net.TCPAddr{IP: net.IPv4zero, Port: 80}, "10.0.0.1:80", false},
+		{&net.UDPAddr{IP: net.IPv4(10, 0, 0, 1), Port: 67}, "10.0.0.1:67", true},
+		{&net.UDPAddr{IP: net.IPv4(10, 0, 0, 1), Port: 67}, "10.0.0.2:67", false},
+		{&net.UDPAddr{IP: net.IPv4(10, 0, 0, 1), Port: 67}, "0.0.0.0:67", false},
+		{&net.TCPAddr{IP: net.ParseIP("2001:db8::1"), Port: 443}, "[2001:db8::1]:443", true},
+		{&net.TCPAddr{IP: net.IPv4zero, Port: 80}, "localhost:80", false},
+		{&net.UnixAddr{Name: "/run/grendel/api.sock", Net: "unix"}, "/run/grendel/api.sock", true},
+		{&net.UnixAddr{Name: "/run/grendel/api.sock", Net: "unix"}, "/tmp/api.sock", false},
+	}
+
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, matchAddress(tt.addr, tt.address), "%s %s", tt.addr, tt.address)
+	}
+}
+
+func TestNotify(t *testing.T) {
+	t.Setenv("NOTIFY_SOCKET", "")
+	assert.False(t, Enabled())
+	sent, err := Ready("Serving dhcp")
+	assert.NoError(t, err)
+	assert.False(t, sent)
+
+	path := filepath.Join(t.TempDir(), "notify.sock")
+	conn, err := net.ListenUnixgram("unixgram", &net.UnixAddr{Name: path, Net: "unixgram"})
+	require.NoError(t, err)
+	defer conn.Close()
+	t.Setenv("NOTIFY_SOCKET", path)
+	assert.True(t, Enabled())
+
+	read := func() string {
+		buf := make([]byte, 1024)
+		conn.SetReadDeadline(time.Now().Add(time.Second))
+		n, err := conn.Read(buf)
+		require.NoError(t, err)
+		return string(buf[:n])
+	}
+
+	sent, err = Ready("Serving dhcp")
+	require.NoError(t, err)
+	assert.True(t, sent)
+	assert.Equal(t, "READY=1\nSTATUS=Serving dhcp", read())
+
+	_, err = Reloading()
+	require.NoError(t, err)
+	msg := read()
+	assert.True(t, strings.HasPrefix(msg, "RELOADING=1\nMONOTONIC_USEC="), msg)
+
+	_, err = Watchdog()
+	require.NoError(t, err)
+	assert.Equal(t, "WATCHDOG=1", read())
+}
+
+func TestWatchdogInterval(t *testing.T) {
+	t.Setenv("WATCHDOG_USEC", "")
+	interval, err := WatchdogInterval()
+	assert.NoError(t, err)
+	assert.Zero(t, interval)
+
+	t.Setenv("WATCHDOG_USEC", "30000000")
+	t.Setenv("WATCHDOG_PID", "")
+	interval, err = WatchdogInterval()
+	assert.NoError(t, err)
+	assert.Equal(t, 15*time.Second, interval)
+}
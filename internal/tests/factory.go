@@ -39,9 +39,7 @@ var NetInterfaceFactory = factory.NewFactory(
 
 var BondFactory = factory.NewFactory(
 	&model.Bond{},
-).Attr("Peers", func(args factory.Args) (interface{}, error) {
-	return []string{randomdata.MacAddress(), randomdata.MacAddress()}, nil
-})
+)
 
 var HostFactory = factory.NewFactory(
 	&model.Host{},
@@ -14,70 +14,112 @@ import (
 	"strings"
 
 	"github.com/pin/tftp/v3"
+	"github.com/sirupsen/logrus"
+	"github.com/ubccr/grendel/internal/firmware"
+	"github.com/ubccr/grendel/internal/imagegc"
+	"github.com/ubccr/grendel/internal/logger"
+	"github.com/ubccr/grendel/internal/store"
 	"github.com/ubccr/grendel/pkg/model"
 )
 
-func (s *Server) sendFile(fileName string, rf io.ReaderFrom) error {
+func (s *Server) sendFile(log *logrus.Entry, fileName string, rf io.ReaderFrom) (int64, error) {
+	defer imagegc.Serve(fileName)()
+
 	file, err := os.Open(fileName)
 	if err != nil {
 		log.Errorf("Failed to open %s: %s", fileName, err)
-		return err
+		return 0, err
 	}
+	defer file.Close()
+
 	n, err := rf.ReadFrom(file)
 	if err != nil {
 		log.Errorf("Failed to send %s via tftp: %s", fileName, err)
-		return err
+		return n, err
 	}
 
 	log.Infof("Sent %s via tftp: %d bytes sent", fileName, n)
-	return nil
+	return n, nil
 }
 
-func (s *Server) imageFileHandler(filePath string, rf io.ReaderFrom) error {
+// imageFileHandler sends the kernel or an initrd of a boot image and returns
+// the type of file requested with the number of bytes sent
+func (s *Server) imageFileHandler(log *logrus.Entry, db store.Store, filePath string, rf io.ReaderFrom) (string, int64, error) {
 	imageName, fileType := filepath.Split(filePath)
-	bootImage, err := s.DB.LoadBootImage(strings.TrimSuffix(imageName, "/"))
+	bootImage, err := model.ResolveBootImage(strings.TrimSuffix(imageName, "/"), db.LoadBootImage)
 	if err != nil {
 		log.Errorf("File not found: %s", filePath)
-		return err
+		return fileUnknown, 0, err
 	}
 
 	switch {
 	case fileType == "kernel":
-		return s.sendFile(bootImage.KernelPath, rf)
+		n, err := s.sendFile(log, bootImage.KernelPath, rf)
+		return fileKernel, n, err
 	case strings.HasPrefix(fileType, "initrd-"):
 		i, err := strconv.Atoi(fileType[7:])
 		if err != nil || i < 0 || i >= len(bootImage.InitrdPaths) {
-			return fmt.Errorf("no initrd with ID %q", i)
+			return fileInitrd, 0, fmt.Errorf("no initrd with ID %q", i)
 		}
 		initrd := bootImage.InitrdPaths[i]
-		return s.sendFile(initrd, rf)
+		n, err := s.sendFile(log, initrd, rf)
+		return fileInitrd, n, err
 	}
 
-	return fmt.Errorf("File not found: %s", filePath)
+	return fileUnknown, 0, fmt.Errorf("File not found: %s", filePath)
 }
 
 func (s *Server) ReadHandler(token string, rf io.ReaderFrom) error {
-	fwtype, err := model.ParseFirmwareToken(token)
+	file, n, err := s.read(token, rf)
+	observeTransfer(file, n, err)
+
+	return err
+}
+
+// read sends the firmware or boot image file of token and returns the type
+// of file requested with the number of bytes sent
+func (s *Server) read(token string, rf io.ReaderFrom) (string, int64, error) {
+	l := log
+	db := s.DB
+	if t, ok := rf.(tftp.OutgoingTransfer); ok {
+		l = l.WithField(logger.FieldIP, t.RemoteAddr().IP.String())
+		db = s.store(t.RemoteAddr().IP)
+	}
+
+	fwtype, bootID, err := model.ParseFirmwareToken(token)
 	if err != nil {
-		return s.imageFileHandler(token, rf)
+		// Firmware is also served by name, such as snponly-x86_64.efi, for
+		// other DHCP servers pointing clients at grendel
+		if fwtype = firmware.NewFromString(strings.TrimPrefix(token, "/")); !fwtype.IsNil() {
+			l.Infof("Got read request for firmware file: %s", fwtype)
+			return s.sendFirmware(l, fwtype, rf)
+		}
+		return s.imageFileHandler(l, db, token, rf)
 	}
 
-	log.Infof("Got read request for firmware type: %d", fwtype)
+	l = l.WithField(logger.FieldBootID, bootID)
+	l.Infof("Got read request for firmware type: %d", fwtype)
 
+	return s.sendFirmware(l, fwtype, rf)
+}
+
+func (s *Server) sendFirmware(l *logrus.Entry, fwtype firmware.Build, rf io.ReaderFrom) (string, int64, error) {
 	bs := fwtype.ToBytes()
 	if bs == nil {
-		log.Errorf("Failed to fetch firmware %d: %s", fwtype, err)
-		return fmt.Errorf("unknown firmware type %d", fwtype)
+		l.Errorf("Failed to fetch firmware %d", fwtype)
+		return fileFirmware, 0, fmt.Errorf("unknown firmware type %d", fwtype)
 	}
 
-	rf.(tftp.OutgoingTransfer).SetSize(int64(len(bs)))
+	if t, ok := rf.(tftp.OutgoingTransfer); ok {
+		t.SetSize(int64(len(bs)))
+	}
 	n, err := rf.ReadFrom(bytes.NewBuffer(bs))
 	if err != nil && !strings.Contains(err.Error(), "User aborted") {
-		log.Errorf("Failed to send firmware via tftp: %s", err)
-		return err
+		l.Errorf("Failed to send firmware via tftp: %s", err)
+		return fileFirmware, n, err
 	}
 
-	log.Infof("Sent firmware %d via tftp: %d bytes sent", fwtype, n)
+	l.Infof("Sent firmware %d via tftp: %d bytes sent", fwtype, n)
 
-	return nil
+	return fileFirmware, n, nil
 }
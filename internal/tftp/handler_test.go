@@ -0,0 +1,58 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package tftp
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/ubccr/grendel/internal/firmware"
+	"github.com/ubccr/grendel/internal/store/sqlstore"
+	"github.com/ubccr/grendel/pkg/model"
+)
+
+func TestTransferMetrics(t *testing.T) {
+	db, err := sqlstore.New(":memory:")
+	require.NoError(t, err)
+
+	kernel := filepath.Join(t.TempDir(), "vmlinuz")
+	require.NoError(t, os.WriteFile(kernel, []byte("kernel image"), 0644))
+	require.NoError(t, db.StoreBootImage(&model.BootImage{Name: "rocky", KernelPath: kernel}))
+
+	s, err := NewServer(db, "127.0.0.1:0")
+	require.NoError(t, err)
+
+	sent := testutil.ToFloat64(bytesSentTotal.WithLabelValues(fileKernel))
+	kernels := testutil.ToFloat64(transfersTotal.WithLabelValues(fileKernel, "success"))
+	missing := testutil.ToFloat64(transfersTotal.WithLabelValues(fileInitrd, "error"))
+
+	var buf bytes.Buffer
+	require.NoError(t, s.ReadHandler("rocky/kernel", &buf))
+	assert.Equal(t, "kernel image", buf.String())
+	assert.Equal(t, kernels+1, testutil.ToFloat64(transfersTotal.WithLabelValues(fileKernel, "success")))
+	assert.Equal(t, sent+float64(buf.Len()), testutil.ToFloat64(bytesSentTotal.WithLabelValues(fileKernel)))
+
+	assert.Error(t, s.ReadHandler("rocky/initrd-0", &buf))
+	assert.Equal(t, missing+1, testutil.ToFloat64(transfersTotal.WithLabelValues(fileInitrd, "error")))
+}
+
+func TestFirmwareByName(t *testing.T) {
+	db, err := sqlstore.New(":memory:")
+	require.NoError(t, err)
+
+	s, err := NewServer(db, "127.0.0.1:0")
+	require.NoError(t, err)
+
+	var buf bytes.Buffer
+	require.NoError(t, s.ReadHandler("/snponly-x86_64.efi", &buf))
+	assert.Equal(t, firmware.SNPONLYx86_64.ToBytes(), buf.Bytes())
+
+	assert.Error(t, s.ReadHandler("missing.efi", &buf))
+}
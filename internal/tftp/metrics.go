@@ -0,0 +1,42 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package tftp
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const (
+	fileFirmware = "firmware"
+	fileKernel   = "kernel"
+	fileInitrd   = "initrd"
+	fileUnknown  = "unknown"
+)
+
+var (
+	transfersTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "grendel_tftp_transfers_total",
+		Help: "TFTP read requests by file type and result",
+	}, []string{"file", "result"})
+	bytesSentTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "grendel_tftp_sent_bytes_total",
+		Help: "Bytes sent over TFTP by file type",
+	}, []string{"file"})
+)
+
+func init() {
+	prometheus.MustRegister(transfersTotal, bytesSentTotal)
+}
+
+// observeTransfer counts a transfer of a file type which sent n bytes
+func observeTransfer(file string, n int64, err error) {
+	result := "success"
+	if err != nil {
+		result = "error"
+	}
+
+	transfersTotal.WithLabelValues(file, result).Inc()
+	bytesSentTotal.WithLabelValues(file).Add(float64(n))
+}
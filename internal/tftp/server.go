@@ -6,11 +6,15 @@ package tftp
 
 import (
 	"context"
+	"net"
+	"net/netip"
 	"time"
 
 	"github.com/pin/tftp/v3"
+	"github.com/ubccr/grendel/internal/health"
 	"github.com/ubccr/grendel/internal/logger"
 	"github.com/ubccr/grendel/internal/store"
+	"github.com/ubccr/grendel/internal/store/namespace"
 )
 
 var log = logger.GetLogger("TFTP")
@@ -18,7 +22,29 @@ var log = logger.GetLogger("TFTP")
 type Server struct {
 	Address string
 	DB      store.Store
-	srv     *tftp.Server
+
+	// Namespaces are selected by the subnet of the client, only DB is
+	// used when nil
+	Namespaces *namespace.Registry
+
+	// PacketConn is a socket passed by systemd, used by Listen instead of
+	// binding Address
+	PacketConn net.PacketConn
+
+	srv  *tftp.Server
+	conn net.PacketConn
+}
+
+// store returns the store of the namespace of the client at ip
+func (s *Server) store(ip net.IP) store.Store {
+	if s.Namespaces == nil {
+		return s.DB
+	}
+
+	addr, _ := netip.AddrFromSlice(ip)
+	_, db := s.Namespaces.ForAddr(addr.Unmap())
+
+	return db
 }
 
 func NewServer(db store.Store, address string) (*Server, error) {
@@ -30,9 +56,46 @@ func NewServer(db store.Store, address string) (*Server, error) {
 	return s, nil
 }
 
+// Listen binds the UDP socket of the server. Serve binds it when Listen was
+// not called
+func (s *Server) Listen() error {
+	if s.PacketConn != nil {
+		s.conn = s.PacketConn
+		return nil
+	}
+
+	conn, err := net.ListenPacket("udp", s.Address)
+	if err != nil {
+		return err
+	}
+
+	s.conn = conn
+	return nil
+}
+
 func (s *Server) Serve() error {
+	if s.conn == nil {
+		if err := s.Listen(); err != nil {
+			return err
+		}
+	}
+
 	log.Infof("Server listening on: %s", s.Address)
-	return s.srv.ListenAndServe(s.Address)
+	return s.srv.Serve(s.conn)
+}
+
+// Addr returns the address the server is bound to, nil until Listen is
+// called
+func (s *Server) Addr() net.Addr {
+	if s.conn == nil {
+		return nil
+	}
+	return s.conn.LocalAddr()
+}
+
+// Check returns an error unless the socket of the server is open
+func (s *Server) Check(ctx context.Context) error {
+	return health.SocketOpen(s.conn)
 }
 
 func (s *Server) Shutdown(ctx context.Context) error {
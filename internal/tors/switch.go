@@ -6,8 +6,10 @@ package tors
 
 import (
 	"errors"
+	"strconv"
+	"strings"
 
-	"github.com/spf13/viper"
+	"github.com/ubccr/grendel/internal/config"
 	"github.com/ubccr/grendel/internal/logger"
 	"github.com/ubccr/grendel/pkg/model"
 )
@@ -21,8 +23,8 @@ type NetworkSwitch interface {
 }
 
 func NewNetworkSwitch(host *model.Host) (NetworkSwitch, error) {
-	username := viper.GetString("bmc.switch_admin_username")
-	password := viper.GetString("bmc.switch_admin_password")
+	username := config.Viper().GetString("bmc.switch_admin_username")
+	password := config.Viper().GetString("bmc.switch_admin_password")
 
 	if username == "" || password == "" {
 		log.Warn("Please set both bmc.switch_admin_username and bmc.switch_admin_password in your toml configuration file in order to query network switches")
@@ -50,3 +52,30 @@ func NewNetworkSwitch(host *model.Host) (NetworkSwitch, error) {
 
 	return sw, err
 }
+
+// PortNumber returns the port number of a switch interface name as stored on
+// node interfaces: Ethernet12 on Arista, Eth1/12 on SONiC and
+// ethernet1/1/12 on Dell OS10. Breakout and other interfaces return false
+func PortNumber(name string) (int, bool) {
+	lower := strings.ToLower(name)
+	parts := strings.Split(lower, "/")
+
+	var port string
+	switch {
+	case len(parts) == 1 && strings.HasPrefix(lower, "ethernet"):
+		port = strings.TrimPrefix(lower, "ethernet")
+	case len(parts) == 2 && parts[0] == "eth1":
+		port = parts[1]
+	case len(parts) == 3 && strings.HasPrefix(parts[0], "ethernet"):
+		port = parts[2]
+	default:
+		return 0, false
+	}
+
+	n, err := strconv.Atoi(port)
+	if err != nil || n <= 0 {
+		return 0, false
+	}
+
+	return n, true
+}
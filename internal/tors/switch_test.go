@@ -0,0 +1,27 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package tors
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPortNumber(t *testing.T) {
+	for name, want := range map[string]int{
+		"Ethernet12":     12,
+		"Eth1/16":        16,
+		"ethernet1/1/12": 12,
+		"Ethernet49/1":   0,
+		"Management1":    0,
+		"Ethernet":       0,
+		"Eth1/16/2":      0,
+	} {
+		port, ok := PortNumber(name)
+		assert.Equal(t, want, port, name)
+		assert.Equal(t, want != 0, ok, name)
+	}
+}
@@ -0,0 +1,34 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package util
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseDuration(t *testing.T) {
+	assert := assert.New(t)
+
+	tests := map[string]time.Duration{
+		"30d":   30 * 24 * time.Hour,
+		"1d12h": 36 * time.Hour,
+		"12h":   12 * time.Hour,
+		"0":     0,
+	}
+	for s, expected := range tests {
+		d, err := ParseDuration(s)
+		if assert.NoError(err, s) {
+			assert.Equal(expected, d, s)
+		}
+	}
+
+	for _, s := range []string{"", "d", "-1d", "1d-2h", "1dd", "week"} {
+		_, err := ParseDuration(s)
+		assert.Error(err, s)
+	}
+}
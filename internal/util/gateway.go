@@ -7,16 +7,16 @@ package util
 import (
 	"net"
 
-	"github.com/spf13/viper"
+	"github.com/ubccr/grendel/internal/config"
 )
 
 func DefaultGateway(ip net.IP) net.IP {
 	var router net.IP
-	if viper.IsSet("dhcp.router_octet4") {
+	if config.Viper().IsSet("dhcp.router_octet4") {
 		router = ip.Mask(net.CIDRMask(24, 32))
-		router[3] += byte(viper.GetInt("dhcp.router_octet4"))
-	} else if viper.IsSet("dhcp.router") {
-		router = net.ParseIP(viper.GetString("dhcp.router"))
+		router[3] += byte(config.Viper().GetInt("dhcp.router_octet4"))
+	} else if config.Viper().IsSet("dhcp.router") {
+		router = net.ParseIP(config.Viper().GetString("dhcp.router"))
 	}
 
 	return router
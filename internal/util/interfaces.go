@@ -8,6 +8,7 @@ import (
 	"errors"
 	"fmt"
 	"net"
+	"net/netip"
 
 	"github.com/insomniacslk/dhcp/dhcpv4"
 	"github.com/insomniacslk/dhcp/interfaces"
@@ -80,6 +81,39 @@ func GetInterfaceIPMap() (map[int]net.IP, error) {
 	return intfIps, nil
 }
 
+// GetInterfacePrefixes returns the external IPv4 addresses of the non
+// loopback interfaces along with the prefix length of their network
+func GetInterfacePrefixes() ([]netip.Prefix, error) {
+	intfs, err := interfaces.GetNonLoopbackInterfaces()
+	if err != nil {
+		return nil, err
+	}
+
+	prefixes := make([]netip.Prefix, 0)
+	for _, intf := range intfs {
+		addrs, err := intf.Addrs()
+		if err != nil {
+			return nil, err
+		}
+
+		for _, addr := range addrs {
+			ipnet, ok := addr.(*net.IPNet)
+			if !ok || ipnet.IP.To4() == nil || ipnet.IP.IsLoopback() || ipnet.IP.IsLinkLocalUnicast() {
+				continue
+			}
+
+			ip, _ := netip.AddrFromSlice(ipnet.IP.To4())
+			ones, bits := ipnet.Mask.Size()
+			if bits == 8*net.IPv6len {
+				ones -= 8 * (net.IPv6len - net.IPv4len)
+			}
+			prefixes = append(prefixes, netip.PrefixFrom(ip, ones))
+		}
+	}
+
+	return prefixes, nil
+}
+
 func GetInterfaceFromIP(ip net.IP) (string, net.IPMask, error) {
 	intfs, err := interfaces.GetNonLoopbackInterfaces()
 	if err != nil {
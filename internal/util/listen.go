@@ -0,0 +1,77 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package util
+
+import (
+	"fmt"
+	"net"
+	"strconv"
+)
+
+const (
+	FamilyIPv4      = "ipv4"
+	FamilyIPv6      = "ipv6"
+	FamilyDualStack = "ipv4+ipv6"
+	FamilyUnix      = "unix"
+)
+
+// ParseListenAddress returns the IP and port of a listen address such as
+// 0.0.0.0:80, [::]:80 or [2001:db8::1]:80. An empty host listens on all IPv4
+// addresses and an empty port is defaultPort
+func ParseListenAddress(address string, defaultPort int) (net.IP, int, error) {
+	shost, sport, err := net.SplitHostPort(address)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	port := defaultPort
+	if sport != "" {
+		port, err = strconv.Atoi(sport)
+		if err != nil {
+			return nil, 0, err
+		}
+	}
+
+	if shost == "" {
+		return net.IPv4zero, port, nil
+	}
+
+	ip := net.ParseIP(shost)
+	if ip == nil {
+		return nil, 0, fmt.Errorf("Invalid IP address: %s", shost)
+	}
+
+	if ip4 := ip.To4(); ip4 != nil {
+		ip = ip4
+	}
+
+	return ip, port, nil
+}
+
+// AddressFamily returns the clients accepted by a socket bound to addr. A
+// socket bound to the IPv6 wildcard address [::] is dual-stack, IPv4 clients
+// connect with IPv4-mapped addresses
+func AddressFamily(addr net.Addr) string {
+	var ip net.IP
+	switch a := addr.(type) {
+	case *net.TCPAddr:
+		ip = a.IP
+	case *net.UDPAddr:
+		ip = a.IP
+	case *net.UnixAddr:
+		return FamilyUnix
+	default:
+		return ""
+	}
+
+	switch {
+	case ip.To4() != nil:
+		return FamilyIPv4
+	case ip.Equal(net.IPv6unspecified):
+		return FamilyDualStack
+	}
+
+	return FamilyIPv6
+}
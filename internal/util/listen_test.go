@@ -0,0 +1,57 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package util
+
+import (
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseListenAddress(t *testing.T) {
+	assert := assert.New(t)
+
+	tests := map[string]struct {
+		ip   string
+		port int
+	}{
+		":":                  {"0.0.0.0", 80},
+		"0.0.0.0:8080":       {"0.0.0.0", 8080},
+		"10.0.0.1:":          {"10.0.0.1", 80},
+		"[::]:80":            {"::", 80},
+		"[2001:db8::1]:443":  {"2001:db8::1", 443},
+		"[::ffff:10.0.0.1]:": {"10.0.0.1", 80},
+	}
+	for address, expected := range tests {
+		ip, port, err := ParseListenAddress(address, 80)
+		if assert.NoError(err, address) {
+			assert.Equal(expected.ip, ip.String(), address)
+			assert.Equal(expected.port, port, address)
+		}
+	}
+
+	for _, address := range []string{"", "::", "2001:db8::1:80", "host:80", "10.0.0.1:http"} {
+		_, _, err := ParseListenAddress(address, 80)
+		assert.Error(err, address)
+	}
+}
+
+func TestAddressFamily(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.Equal(FamilyIPv4, AddressFamily(&net.TCPAddr{IP: net.IPv4zero, Port: 80}))
+	assert.Equal(FamilyIPv4, AddressFamily(&net.UDPAddr{IP: net.ParseIP("10.0.0.1"), Port: 53}))
+	assert.Equal(FamilyDualStack, AddressFamily(&net.TCPAddr{IP: net.IPv6unspecified, Port: 80}))
+	assert.Equal(FamilyIPv6, AddressFamily(&net.UDPAddr{IP: net.ParseIP("2001:db8::1"), Port: 53}))
+	assert.Equal(FamilyUnix, AddressFamily(&net.UnixAddr{Name: "/run/grendel/api.sock", Net: "unix"}))
+
+	l, err := net.Listen("tcp", "[::]:0")
+	if err != nil {
+		t.Skipf("IPv6 not available: %s", err)
+	}
+	defer l.Close()
+	assert.Equal(FamilyDualStack, AddressFamily(l.Addr()))
+}
@@ -0,0 +1,418 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+// Package webhook posts grendel events to the URLs configured in
+// webhook.hooks. Events are queued and delivered by background workers, so a
+// slow or failing webhook never blocks the services sending them. Failed
+// deliveries are retried with exponential backoff and written to the dead
+// letter log once every attempt failed
+package webhook
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/viper"
+	"github.com/ubccr/grendel/internal/logger"
+	"github.com/ubccr/grendel/pkg/model"
+	"github.com/ubccr/grendel/pkg/nodeset"
+)
+
+// Event types
+const (
+	HostCreated       = "host.created"
+	HostUpdated       = "host.updated"
+	HostDeleted       = "host.deleted"
+	ProvisionComplete = "provision.complete"
+	DHCPConflict      = "dhcp.conflict"
+	BMCPower          = "bmc.power"
+	ScheduleRun       = "schedule.run"
+)
+
+const (
+	// SignatureHeader holds the hex HMAC-SHA256 of the body keyed with the
+	// secret of the hook, as sha256=<hex>
+	SignatureHeader = "X-Grendel-Signature"
+	EventHeader     = "X-Grendel-Event"
+	DeliveryHeader  = "X-Grendel-Delivery"
+
+	DefaultQueueSize   = 1000
+	DefaultWorkers     = 4
+	DefaultMaxAttempts = 5
+	DefaultBackoff     = 2 * time.Second
+	DefaultTimeout     = 10 * time.Second
+
+	// maxBackoff caps the delay between two attempts
+	maxBackoff = 5 * time.Minute
+)
+
+// EventTypes are the event types sent to webhooks
+var EventTypes = []string{HostCreated, HostUpdated, HostDeleted, ProvisionComplete, DHCPConflict, BMCPower, ScheduleRun}
+
+var (
+	log = logger.GetLogger("WEBHOOK")
+
+	// Default is the dispatcher shared by the services of a grendel process
+	Default = New()
+
+	deliveriesTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "grendel_webhook_deliveries_total",
+		Help: "Webhook deliveries by result: delivered, failed after every attempt or dropped with a full queue",
+	}, []string{"result"})
+)
+
+func init() {
+	prometheus.MustRegister(deliveriesTotal)
+}
+
+// Hook is a webhook of the webhook.hooks config
+type Hook struct {
+	URL string `mapstructure:"url"`
+
+	// Events are the event types sent, all of them when empty. Types are
+	// glob patterns, host.* matches every host event
+	Events []string `mapstructure:"events"`
+
+	// Nodeset restricts the hook to events about these hosts
+	Nodeset string `mapstructure:"nodeset"`
+
+	// Secret signs the payloads in the X-Grendel-Signature header
+	Secret string `mapstructure:"secret"`
+
+	nodes map[string]bool
+}
+
+// Payload is the body posted to webhooks, the event as shown in the event
+// stream along with its type and hosts
+type Payload struct {
+	ID    string   `json:"id"`
+	Type  string   `json:"type"`
+	Hosts []string `json:"hosts"`
+	model.Event
+}
+
+// Config is the webhook config
+type Config struct {
+	Hooks       []*Hook
+	QueueSize   int
+	Workers     int
+	MaxAttempts int
+	Backoff     time.Duration
+	Timeout     time.Duration
+
+	// DeadLetter is the file the deliveries failing every attempt are
+	// appended to as JSON lines. They are only logged when empty
+	DeadLetter string
+}
+
+type delivery struct {
+	hook    *Hook
+	payload Payload
+}
+
+// Dispatcher queues events and posts them to the matching hooks
+type Dispatcher struct {
+	mu     sync.RWMutex
+	config Config
+	client *http.Client
+	queue  chan delivery
+
+	deadMu sync.Mutex
+}
+
+// New returns a dispatcher without hooks
+func New() *Dispatcher {
+	d := &Dispatcher{}
+	d.Configure(Config{})
+
+	return d
+}
+
+// Configure replaces the hooks and settings of d. The queue is only sized
+// once, before Run
+func (d *Dispatcher) Configure(c Config) {
+	if c.QueueSize <= 0 {
+		c.QueueSize = DefaultQueueSize
+	}
+	if c.Workers <= 0 {
+		c.Workers = DefaultWorkers
+	}
+	if c.MaxAttempts <= 0 {
+		c.MaxAttempts = DefaultMaxAttempts
+	}
+	if c.Backoff <= 0 {
+		c.Backoff = DefaultBackoff
+	}
+	if c.Timeout <= 0 {
+		c.Timeout = DefaultTimeout
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	d.config = c
+	d.client = &http.Client{Timeout: c.Timeout}
+	if d.queue == nil {
+		d.queue = make(chan delivery, c.QueueSize)
+	}
+}
+
+// Notify queues event for the hooks subscribed to eventType and at least one
+// of hosts. It never blocks: events are dropped and logged when the queue is
+// full
+func (d *Dispatcher) Notify(eventType string, hosts []string, event model.Event) {
+	d.mu.RLock()
+	hooks := d.config.Hooks
+	d.mu.RUnlock()
+
+	for _, hook := range hooks {
+		matched, ok := hook.match(eventType, hosts)
+		if !ok {
+			continue
+		}
+
+		p := Payload{
+			ID:    uuid.NewString(),
+			Type:  eventType,
+			Hosts: matched,
+			Event: event,
+		}
+
+		select {
+		case d.queue <- delivery{hook: hook, payload: p}:
+		default:
+			deliveriesTotal.WithLabelValues("dropped").Inc()
+			log.WithFields(logrus.Fields{"url": hook.URL, "type": eventType}).Error("Webhook queue is full, dropping event")
+		}
+	}
+}
+
+// Run delivers the queued events until stop is closed
+func (d *Dispatcher) Run(stop <-chan struct{}) {
+	d.mu.RLock()
+	workers := d.config.Workers
+	d.mu.RUnlock()
+
+	var wg sync.WaitGroup
+	for range workers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for {
+				select {
+				case <-stop:
+					return
+				case dl := <-d.queue:
+					d.deliver(dl, stop)
+				}
+			}
+		}()
+	}
+	wg.Wait()
+
+	if n := len(d.queue); n > 0 {
+		log.Warnf("Shutting down with %d webhook deliveries queued", n)
+	}
+}
+
+// deliver posts dl, retrying with exponential backoff
+func (d *Dispatcher) deliver(dl delivery, stop <-chan struct{}) {
+	d.mu.RLock()
+	client, maxAttempts, backoff := d.client, d.config.MaxAttempts, d.config.Backoff
+	d.mu.RUnlock()
+
+	body, err := json.Marshal(dl.payload)
+	if err != nil {
+		d.deadLetter(dl, 0, err)
+		return
+	}
+
+	fields := logrus.Fields{"url": dl.hook.URL, "type": dl.payload.Type, "delivery": dl.payload.ID}
+	for attempt := 1; ; attempt++ {
+		err = post(client, dl.hook, dl.payload, body)
+		if err == nil {
+			deliveriesTotal.WithLabelValues("delivered").Inc()
+			log.WithFields(fields).Debug("Delivered webhook")
+			return
+		}
+		if attempt >= maxAttempts {
+			d.deadLetter(dl, attempt, err)
+			return
+		}
+
+		log.WithFields(fields).WithField("err", err).Warnf("Webhook delivery attempt %d failed, retrying in %s", attempt, backoff)
+		select {
+		case <-stop:
+			d.deadLetter(dl, attempt, fmt.Errorf("shutting down: %w", err))
+			return
+		case <-time.After(backoff):
+		}
+		backoff = min(2*backoff, maxBackoff)
+	}
+}
+
+func post(client *http.Client, hook *Hook, p Payload, body []byte) error {
+	req, err := http.NewRequest(http.MethodPost, hook.URL, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "grendel-webhook")
+	req.Header.Set(EventHeader, p.Type)
+	req.Header.Set(DeliveryHeader, p.ID)
+	if hook.Secret != "" {
+		req.Header.Set(SignatureHeader, Sign(hook.Secret, body))
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	io.Copy(io.Discard, io.LimitReader(resp.Body, 1<<16))
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("unexpected status %s", resp.Status)
+	}
+
+	return nil
+}
+
+// deadLetter logs a delivery that failed every attempt and appends it to the
+// dead letter log
+func (d *Dispatcher) deadLetter(dl delivery, attempts int, err error) {
+	deliveriesTotal.WithLabelValues("failed").Inc()
+	log.WithFields(logrus.Fields{
+		"url":      dl.hook.URL,
+		"type":     dl.payload.Type,
+		"delivery": dl.payload.ID,
+		"attempts": attempts,
+		"err":      err,
+	}).Error("Webhook delivery failed")
+
+	d.mu.RLock()
+	file := d.config.DeadLetter
+	d.mu.RUnlock()
+	if file == "" {
+		return
+	}
+
+	line, merr := json.Marshal(struct {
+		Time     time.Time `json:"time"`
+		URL      string    `json:"url"`
+		Attempts int       `json:"attempts"`
+		Error    string    `json:"error"`
+		Payload  Payload   `json:"payload"`
+	}{time.Now().UTC(), dl.hook.URL, attempts, err.Error(), dl.payload})
+	if merr != nil {
+		log.WithField("err", merr).Error("Failed to encode dead letter")
+		return
+	}
+
+	d.deadMu.Lock()
+	defer d.deadMu.Unlock()
+
+	f, ferr := os.OpenFile(file, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0600)
+	if ferr == nil {
+		_, ferr = f.Write(append(line, '\n'))
+		if cerr := f.Close(); ferr == nil {
+			ferr = cerr
+		}
+	}
+	if ferr != nil {
+		log.WithField("err", ferr).Errorf("Failed to write dead letter log %s", file)
+	}
+}
+
+// match returns the hosts of an event of eventType the hook is subscribed to
+func (h *Hook) match(eventType string, hosts []string) ([]string, bool) {
+	if len(h.Events) > 0 && !slices.ContainsFunc(h.Events, func(pattern string) bool {
+		ok, _ := path.Match(pattern, eventType)
+		return ok
+	}) {
+		return nil, false
+	}
+
+	if h.nodes == nil {
+		return hosts, true
+	}
+
+	matched := make([]string, 0, len(hosts))
+	for _, host := range hosts {
+		if h.nodes[host] {
+			matched = append(matched, host)
+		}
+	}
+
+	return matched, len(matched) > 0
+}
+
+// Sign returns the X-Grendel-Signature header of body
+func Sign(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// LoadConfig reads the webhook config of v
+func LoadConfig(v *viper.Viper) (Config, error) {
+	c := Config{
+		QueueSize:   v.GetInt("webhook.queue_size"),
+		Workers:     v.GetInt("webhook.workers"),
+		MaxAttempts: v.GetInt("webhook.max_attempts"),
+		Backoff:     v.GetDuration("webhook.backoff"),
+		Timeout:     v.GetDuration("webhook.timeout"),
+		DeadLetter:  v.GetString("webhook.dead_letter"),
+	}
+
+	if err := v.UnmarshalKey("webhook.hooks", &c.Hooks); err != nil {
+		return c, fmt.Errorf("failed parsing webhook.hooks: %w", err)
+	}
+
+	for _, h := range c.Hooks {
+		if h.URL == "" {
+			return c, errors.New("failed parsing webhook.hooks: hook without a url")
+		}
+		for _, e := range h.Events {
+			if _, err := path.Match(e, ""); err != nil {
+				return c, fmt.Errorf("failed parsing webhook.hooks: %s: invalid event %q", h.URL, e)
+			}
+		}
+		if h.Nodeset == "" {
+			continue
+		}
+
+		ns, err := nodeset.NewNodeSet(h.Nodeset)
+		if err != nil {
+			return c, fmt.Errorf("failed parsing webhook.hooks: %s: invalid nodeset %q: %w", h.URL, h.Nodeset, err)
+		}
+		h.nodes = make(map[string]bool, ns.Len())
+		for _, name := range ns.Iterator().StringSlice() {
+			h.nodes[name] = true
+		}
+	}
+
+	return c, nil
+}
+
+// Notify queues event for the hooks of the default dispatcher
+func Notify(eventType string, hosts []string, event model.Event) {
+	Default.Notify(eventType, hosts, event)
+}
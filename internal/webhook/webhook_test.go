@@ -0,0 +1,168 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package webhook
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/ubccr/grendel/pkg/model"
+)
+
+func testConfig(t *testing.T, toml string) Config {
+	v := viper.New()
+	v.SetConfigType("toml")
+	require.NoError(t, v.ReadConfig(bytes.NewBufferString(toml)))
+
+	c, err := LoadConfig(v)
+	require.NoError(t, err)
+
+	return c
+}
+
+func TestLoadConfig(t *testing.T) {
+	c := testConfig(t, `
+[webhook]
+max_attempts = 3
+backoff = "1s"
+
+[[webhook.hooks]]
+url = "https://hooks.example.com/a"
+events = ["host.*", "provision.complete"]
+nodeset = "cpn-[01-02]"
+secret = "s3cret"
+`)
+	assert.Equal(t, 3, c.MaxAttempts)
+	assert.Equal(t, time.Second, c.Backoff)
+	require.Len(t, c.Hooks, 1)
+
+	h := c.Hooks[0]
+	assert.Equal(t, "s3cret", h.Secret)
+
+	hosts, ok := h.match(HostCreated, []string{"cpn-01", "cpn-03"})
+	assert.True(t, ok)
+	assert.Equal(t, []string{"cpn-01"}, hosts)
+
+	_, ok = h.match(HostCreated, []string{"cpn-03"})
+	assert.False(t, ok)
+	_, ok = h.match(DHCPConflict, []string{"cpn-01"})
+	assert.False(t, ok)
+	_, ok = h.match(ProvisionComplete, []string{"cpn-02"})
+	assert.True(t, ok)
+
+	for _, toml := range []string{
+		"[[webhook.hooks]]\nevents = [\"host.created\"]\n",
+		"[[webhook.hooks]]\nurl = \"https://hooks.example.com\"\nnodeset = \"cpn-[01\"\n",
+		"[[webhook.hooks]]\nurl = \"https://hooks.example.com\"\nevents = [\"host.[\"]\n",
+	} {
+		v := viper.New()
+		v.SetConfigType("toml")
+		require.NoError(t, v.ReadConfig(bytes.NewBufferString(toml)))
+		_, err := LoadConfig(v)
+		assert.Error(t, err, toml)
+	}
+}
+
+func TestDeliver(t *testing.T) {
+	received := make(chan *http.Request, 1)
+	bodies := make(chan []byte, 1)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		received <- r
+		bodies <- body
+	}))
+	defer srv.Close()
+
+	d := &Dispatcher{}
+	d.Configure(Config{Hooks: []*Hook{{URL: srv.URL, Events: []string{ProvisionComplete}, Secret: "s3cret"}}})
+
+	stop := make(chan struct{})
+	defer close(stop)
+	go d.Run(stop)
+
+	event := model.Event{Severity: "Success", User: "provision", Message: "Host cpn-01 finished provisioning", Time: time.Now().UTC()}
+	d.Notify(HostCreated, []string{"cpn-01"}, event)
+	d.Notify(ProvisionComplete, []string{"cpn-01"}, event)
+
+	select {
+	case r := <-received:
+		body := <-bodies
+		assert.Equal(t, ProvisionComplete, r.Header.Get(EventHeader))
+		assert.Equal(t, Sign("s3cret", body), r.Header.Get(SignatureHeader))
+
+		var p map[string]any
+		require.NoError(t, json.Unmarshal(body, &p))
+		assert.Equal(t, ProvisionComplete, p["type"])
+		assert.Equal(t, []any{"cpn-01"}, p["hosts"])
+		assert.Equal(t, "Host cpn-01 finished provisioning", p["Message"])
+		assert.Equal(t, r.Header.Get(DeliveryHeader), p["id"])
+	case <-time.After(5 * time.Second):
+		t.Fatal("webhook not delivered")
+	}
+
+	select {
+	case r := <-received:
+		t.Fatalf("unexpected delivery of %s", r.Header.Get(EventHeader))
+	case <-time.After(100 * time.Millisecond):
+	}
+}
+
+func TestDeadLetter(t *testing.T) {
+	attempts := make(chan struct{}, 10)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts <- struct{}{}
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	defer srv.Close()
+
+	file := filepath.Join(t.TempDir(), "dead-letter.jsonl")
+	d := &Dispatcher{}
+	d.Configure(Config{
+		Hooks:       []*Hook{{URL: srv.URL}},
+		MaxAttempts: 3,
+		Backoff:     time.Millisecond,
+		DeadLetter:  file,
+	})
+
+	d.Notify(DHCPConflict, []string{"cpn-01"}, model.Event{Message: "conflict"})
+	d.deliver(<-d.queue, make(chan struct{}))
+	assert.Len(t, attempts, 3)
+
+	data, err := os.ReadFile(file)
+	require.NoError(t, err)
+
+	var dead struct {
+		URL      string
+		Attempts int
+		Error    string
+		Payload  Payload
+	}
+	require.NoError(t, json.Unmarshal(data, &dead))
+	assert.Equal(t, srv.URL, dead.URL)
+	assert.Equal(t, 3, dead.Attempts)
+	assert.Equal(t, "unexpected status 502 Bad Gateway", dead.Error)
+	assert.Equal(t, DHCPConflict, dead.Payload.Type)
+	assert.Equal(t, "conflict", dead.Payload.Message)
+}
+
+func TestNotifyFullQueue(t *testing.T) {
+	d := &Dispatcher{}
+	d.Configure(Config{Hooks: []*Hook{{URL: "http://127.0.0.1:1"}}, QueueSize: 1})
+
+	// No workers run, the second event is dropped instead of blocking
+	d.Notify(BMCPower, []string{"cpn-01"}, model.Event{})
+	d.Notify(BMCPower, []string{"cpn-01"}, model.Event{})
+	assert.Len(t, d.queue, 1)
+}
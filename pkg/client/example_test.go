@@ -0,0 +1,53 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package client_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/ubccr/grendel/pkg/client"
+)
+
+func ExampleNew() {
+	c, err := client.New(client.Config{
+		Endpoint:   "https://grendel.example.com:8080",
+		APIKey:     "my-api-token",
+		CACert:     "/etc/grendel/ca.pem",
+		MaxRetries: 3,
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	hosts, err := c.HostList(context.Background(), client.HostFilter{Tags: []string{"gpu"}})
+	if errors.Is(err, client.ErrUnauthorized) {
+		log.Fatal("invalid API token")
+	} else if err != nil {
+		log.Fatal(err)
+	}
+
+	for _, h := range hosts {
+		fmt.Println(h.Name.Value, h.BootImage.Value)
+	}
+}
+
+func ExampleClient_EventStream() {
+	c, err := client.New(client.Config{Endpoint: "/var/lib/grendel/grendel-api.socket"})
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	err = c.EventStream(context.Background(), 5*time.Second, func(e client.Event) error {
+		fmt.Printf("%s %s: %s\n", e.Time.Value.Format(time.RFC3339), e.User.Value, e.Message.Value)
+		return nil
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+}
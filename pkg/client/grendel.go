@@ -0,0 +1,487 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+// Package client is a client for the Grendel REST API. The operation methods
+// are generated from api/openapi.json, New and the typed helpers such as
+// HostList and HostSave are the intended entry points for other tools.
+package client
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+)
+
+var (
+	// ErrNotFound is matched by errors.Is for API errors with status 404
+	ErrNotFound = errors.New("not found")
+
+	// ErrValidation is matched by errors.Is for API errors with status 400 or 422
+	ErrValidation = errors.New("validation failed")
+
+	// ErrUnauthorized is matched by errors.Is for API errors with status 401 or 403
+	ErrUnauthorized = errors.New("unauthorized")
+
+	// ErrConflict is matched by errors.Is for API errors with status 409
+	ErrConflict = errors.New("conflict")
+)
+
+const (
+	// consoleProtocol is the Upgrade protocol of the console stream
+	consoleProtocol = "grendel-console"
+
+	// namespaceHeader selects the namespace of a request
+	namespaceHeader = "X-Grendel-Namespace"
+)
+
+// Config configures a Client created with New
+type Config struct {
+	// Endpoint is the URL of the Grendel API or the path to its unix socket
+	Endpoint string
+
+	// APIKey is sent with every request
+	APIKey string
+
+	// Namespace selects the namespace of every request. Empty uses the
+	// namespace of a token limited to one, else the default namespace
+	Namespace string
+
+	// CACert is the path to a PEM encoded CA certificate used to verify the API server
+	CACert string
+
+	// Insecure skips verification of the API server certificate
+	Insecure bool
+
+	// TLSConfig overrides CACert and Insecure when set
+	TLSConfig *tls.Config
+
+	// Timeout is the timeout for each request. Defaults to one hour
+	Timeout time.Duration
+
+	// MaxRetries is the number of times a request is retried after a 5xx
+	// response or connection error. Zero disables retries
+	MaxRetries int
+
+	// RetryWaitMin and RetryWaitMax bound the exponential backoff between
+	// retries. They default to 500ms and 10s
+	RetryWaitMin time.Duration
+	RetryWaitMax time.Duration
+}
+
+// HostFilter selects hosts by nodeset, tags and the switch port their
+// interfaces are connected to. If Since is set only hosts added or updated at
+// or after it are selected. BIOSVersion, BMCFirmware and NICFirmware select
+// hosts by the versions in their firmware inventory and States by their
+// lifecycle state. An empty filter selects all hosts.
+type HostFilter struct {
+	Nodeset     string
+	Tags        []string
+	Switch      string
+	Port        int
+	Since       time.Time
+	BIOSVersion string
+	BMCFirmware string
+	NICFirmware string
+	States      []string
+}
+
+// Status summarizes the hosts and boot images known to the API server
+type Status struct {
+	Hosts       int
+	Provision   int
+	Unprovision int
+	Images      int
+}
+
+// APIError is returned for API responses with an error status code. Use
+// errors.Is with ErrNotFound, ErrValidation, ErrUnauthorized or ErrConflict to
+// check the kind of error.
+type APIError struct {
+	StatusCode int
+	Title      string
+	Detail     string
+	Errors     []HTTPErrorErrorsItem
+}
+
+func (e *APIError) Error() string {
+	return fmt.Sprintf("API Error: status=%d title=%s detail=%s", e.StatusCode, e.Title, e.Detail)
+}
+
+func (e *APIError) Is(target error) bool {
+	switch target {
+	case ErrNotFound:
+		return e.StatusCode == http.StatusNotFound
+	case ErrValidation:
+		return e.StatusCode == http.StatusBadRequest || e.StatusCode == http.StatusUnprocessableEntity
+	case ErrUnauthorized:
+		return e.StatusCode == http.StatusUnauthorized || e.StatusCode == http.StatusForbidden
+	case ErrConflict:
+		return e.StatusCode == http.StatusConflict
+	}
+
+	return false
+}
+
+// NewAPIError converts an error status code returned by a Client method into
+// an *APIError. Other errors are returned unchanged.
+func NewAPIError(err error) error {
+	var t *HTTPErrorStatusCode
+	if !errors.As(err, &t) {
+		return err
+	}
+
+	res := t.GetResponse()
+
+	return &APIError{
+		StatusCode: t.StatusCode,
+		Title:      res.GetTitle().Value,
+		Detail:     res.GetDetail().Value,
+		Errors:     res.GetErrors().Value,
+	}
+}
+
+// responseError returns an *APIError for the error response res of a request
+// made outside of the generated client
+func responseError(res *http.Response) error {
+	var body HTTPError
+	_ = json.NewDecoder(res.Body).Decode(&body)
+
+	return &APIError{
+		StatusCode: res.StatusCode,
+		Title:      body.Title.Value,
+		Detail:     body.Detail.Value,
+		Errors:     body.Errors.Value,
+	}
+}
+
+type apiKeyAuth string
+
+func (a apiKeyAuth) HeaderAuth(ctx context.Context, operationName string, c *Client) (HeaderAuth, error) {
+	return HeaderAuth{Token: string(a)}, nil
+}
+
+func (a apiKeyAuth) CookieAuth(ctx context.Context, operationName string, c *Client) (CookieAuth, error) {
+	return CookieAuth{Token: string(a)}, nil
+}
+
+// New returns a Client for the Grendel API configured with cfg
+func New(cfg Config) (*Client, error) {
+	endpoint, tr, err := transport(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	timeout := cfg.Timeout
+	if timeout == 0 {
+		timeout = time.Hour
+	}
+
+	var rt http.RoundTripper = tr
+	if cfg.MaxRetries > 0 {
+		rt = newRetryTransport(tr, cfg.MaxRetries, cfg.RetryWaitMin, cfg.RetryWaitMax)
+	}
+	if cfg.Namespace != "" {
+		rt = namespaceTransport{next: rt, namespace: cfg.Namespace}
+	}
+
+	return NewClient(endpoint, apiKeyAuth(cfg.APIKey), WithClient(&http.Client{Timeout: timeout, Transport: rt}))
+}
+
+// namespaceTransport sets the namespace header of every request
+type namespaceTransport struct {
+	next      http.RoundTripper
+	namespace string
+}
+
+func (t namespaceTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	// A RoundTripper must not modify the request
+	req = req.Clone(req.Context())
+	req.Header.Set(namespaceHeader, t.namespace)
+
+	return t.next.RoundTrip(req)
+}
+
+// transport returns the server URL and the transport connecting to the API
+// server of cfg. Unix socket endpoints are served as http://localhost
+func transport(cfg Config) (string, *http.Transport, error) {
+	tlsConfig := cfg.TLSConfig
+	if tlsConfig == nil {
+		tlsConfig = &tls.Config{InsecureSkipVerify: cfg.Insecure}
+		if cfg.CACert != "" {
+			pem, err := os.ReadFile(cfg.CACert)
+			if err != nil {
+				return "", nil, fmt.Errorf("failed to read cacert: %w", err)
+			}
+
+			certPool := x509.NewCertPool()
+			if !certPool.AppendCertsFromPEM(pem) {
+				return "", nil, fmt.Errorf("failed to parse cacert: %s", cfg.CACert)
+			}
+			tlsConfig = &tls.Config{RootCAs: certPool}
+		}
+	}
+
+	endpoint := cfg.Endpoint
+	tr := &http.Transport{TLSClientConfig: tlsConfig}
+	if !strings.HasPrefix(endpoint, "http") {
+		socket := endpoint
+		tr = &http.Transport{
+			DialContext: func(ctx context.Context, _, addr string) (net.Conn, error) {
+				dialer := net.Dialer{}
+				return dialer.DialContext(ctx, "unix", socket)
+			},
+		}
+		endpoint = "http://localhost"
+	}
+
+	return endpoint, tr, nil
+}
+
+// Console attaches to the serial over LAN console of host through the API
+// server. The returned connection carries the raw console in both directions
+// until closed. user names the session holder on unix socket endpoints, which
+// are not authenticated. A console in use fails with ErrConflict and the
+// current holder in the error detail.
+func Console(ctx context.Context, cfg Config, host, user string) (io.ReadWriteCloser, error) {
+	endpoint, tr, err := transport(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	query := url.Values{"host": {host}}
+	if user != "" {
+		query.Set("user", user)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint+"/v1/bmc/console?"+query.Encode(), nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Connection", "Upgrade")
+	req.Header.Set("Upgrade", consoleProtocol)
+	if cfg.APIKey != "" {
+		req.Header.Set("Authorization", "Bearer "+cfg.APIKey)
+	}
+	if cfg.Namespace != "" {
+		req.Header.Set(namespaceHeader, cfg.Namespace)
+	}
+
+	// The session has no timeout
+	res, err := (&http.Client{Transport: tr}).Do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if res.StatusCode != http.StatusSwitchingProtocols {
+		defer res.Body.Close()
+		return nil, responseError(res)
+	}
+
+	conn, ok := res.Body.(io.ReadWriteCloser)
+	if !ok {
+		res.Body.Close()
+		return nil, errors.New("API server did not upgrade the console connection")
+	}
+
+	return conn, nil
+}
+
+// UploadFirmware uploads the firmware image at path to the API server and
+// returns its name for the image of POST /v1/bmc/fwupdate. The request has no
+// timeout as images can be large.
+func UploadFirmware(ctx context.Context, cfg Config, path string) (string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	endpoint, tr, err := transport(cfg)
+	if err != nil {
+		return "", err
+	}
+
+	query := url.Values{"name": {filepath.Base(path)}}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint+"/v1/bmc/fwupdate/image?"+query.Encode(), f)
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Content-Type", "application/octet-stream")
+	if cfg.APIKey != "" {
+		req.Header.Set("Authorization", "Bearer "+cfg.APIKey)
+	}
+
+	res, err := (&http.Client{Transport: tr}).Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return "", responseError(res)
+	}
+
+	var body struct {
+		Image string `json:"image"`
+	}
+	err = json.NewDecoder(res.Body).Decode(&body)
+	if err != nil {
+		return "", err
+	}
+
+	return body.Image, nil
+}
+
+// HostList returns the hosts matching filter
+func (c *Client) HostList(ctx context.Context, filter HostFilter) ([]Host, error) {
+	var since OptString
+	if !filter.Since.IsZero() {
+		since = NewOptString(filter.Since.Format(time.RFC3339))
+	}
+
+	if filter.Nodeset == "" && len(filter.Tags) == 0 && filter.Switch == "" && filter.Port == 0 &&
+		filter.BIOSVersion == "" && filter.BMCFirmware == "" && filter.NICFirmware == "" && len(filter.States) == 0 {
+		hosts, err := c.GETV1Nodes(ctx, GETV1NodesParams{Since: since})
+		return hosts, NewAPIError(err)
+	}
+
+	params := GETV1NodesFindParams{
+		Nodeset: NewOptString(filter.Nodeset),
+		Tags:    NewOptString(strings.Join(filter.Tags, ",")),
+		Since:   since,
+	}
+	if filter.Switch != "" {
+		params.Switch = NewOptString(filter.Switch)
+	}
+	if filter.Port != 0 {
+		params.Port = NewOptInt(filter.Port)
+	}
+	if filter.BIOSVersion != "" {
+		params.BiosVersion = NewOptString(filter.BIOSVersion)
+	}
+	if filter.BMCFirmware != "" {
+		params.BmcFirmware = NewOptString(filter.BMCFirmware)
+	}
+	if filter.NICFirmware != "" {
+		params.NicFirmware = NewOptString(filter.NICFirmware)
+	}
+	if len(filter.States) > 0 {
+		params.State = NewOptString(strings.Join(filter.States, ","))
+	}
+
+	hosts, err := c.GETV1NodesFind(ctx, params)
+	return hosts, NewAPIError(err)
+}
+
+// HostSave adds or updates the given hosts
+func (c *Client) HostSave(ctx context.Context, hosts []Host) (*GenericResponse, error) {
+	// Host and the node list items share the same schema
+	data, err := json.Marshal(hosts)
+	if err != nil {
+		return nil, err
+	}
+
+	req := &NodeAddRequest{}
+	if err := json.Unmarshal(data, &req.NodeList); err != nil {
+		return nil, err
+	}
+
+	res, err := c.POSTV1Nodes(ctx, req, POSTV1NodesParams{})
+	return res, NewAPIError(err)
+}
+
+// ImageList returns all boot images
+func (c *Client) ImageList(ctx context.Context) ([]BootImage, error) {
+	images, err := c.GETV1Images(ctx, GETV1ImagesParams{})
+	return images, NewAPIError(err)
+}
+
+// Status returns a summary of the hosts and boot images
+func (c *Client) Status(ctx context.Context) (*Status, error) {
+	images, err := c.ImageList(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	hosts, err := c.HostList(ctx, HostFilter{})
+	if err != nil {
+		return nil, err
+	}
+
+	status := &Status{Hosts: len(hosts), Images: len(images)}
+	for _, h := range hosts {
+		if h.Provision.Value {
+			status.Provision++
+		} else {
+			status.Unprovision++
+		}
+	}
+
+	return status, nil
+}
+
+// EventStream polls the API server for events every interval and calls fn
+// with each new event in the order they occurred. Events which occurred
+// before EventStream was called are skipped. It returns when ctx is done or
+// fn returns an error.
+func (c *Client) EventStream(ctx context.Context, interval time.Duration, fn func(Event) error) error {
+	events, err := c.GETV1GrendelEvents(ctx, GETV1GrendelEventsParams{})
+	if err != nil {
+		return NewAPIError(err)
+	}
+
+	var last time.Time
+	for _, e := range events {
+		if e.Time.Value.After(last) {
+			last = e.Time.Value
+		}
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+
+		events, err := c.GETV1GrendelEvents(ctx, GETV1GrendelEventsParams{})
+		if err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			return NewAPIError(err)
+		}
+
+		newEvents := make([]Event, 0)
+		for _, e := range events {
+			if e.Time.Value.After(last) {
+				newEvents = append(newEvents, e)
+			}
+		}
+		slices.SortStableFunc(newEvents, func(a, b Event) int {
+			return a.Time.Value.Compare(b.Time.Value)
+		})
+
+		for _, e := range newEvents {
+			if err := fn(e); err != nil {
+				return err
+			}
+			last = e.Time.Value
+		}
+	}
+}
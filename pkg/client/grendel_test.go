@@ -0,0 +1,230 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const testHosts = `[{"name": "cpn-01", "provision": true, "interfaces": [{"mac": "de:ad:be:ef:00:01", "ip": "10.0.0.1/24"}]}, {"name": "cpn-02"}]`
+
+func newTestClient(t *testing.T, handler http.HandlerFunc, cfg Config) *Client {
+	ts := httptest.NewServer(handler)
+	t.Cleanup(ts.Close)
+
+	cfg.Endpoint = ts.URL
+	if cfg.APIKey == "" {
+		cfg.APIKey = "secret"
+	}
+	c, err := New(cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return c
+}
+
+func writeJSON(w http.ResponseWriter, status int, body string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	fmt.Fprint(w, body)
+}
+
+func TestHostList(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "Bearer secret", r.Header.Get("Authorization"))
+
+		switch r.URL.Path {
+		case "/v1/nodes":
+			writeJSON(w, http.StatusOK, testHosts)
+		case "/v1/nodes/find":
+			assert.Equal(t, "cpn-[01-02]", r.URL.Query().Get("nodeset"))
+			assert.Equal(t, "ib,gpu", r.URL.Query().Get("tags"))
+			assert.Equal(t, "12", r.URL.Query().Get("port"))
+			assert.Equal(t, "2026-10-01T00:00:00Z", r.URL.Query().Get("since"))
+			writeJSON(w, http.StatusOK, testHosts)
+		default:
+			writeJSON(w, http.StatusNotFound, `{"title": "Error", "detail": "not found"}`)
+		}
+	}, Config{})
+
+	hosts, err := c.HostList(context.Background(), HostFilter{})
+	if assert.NoError(t, err) && assert.Len(t, hosts, 2) {
+		assert.Equal(t, "cpn-01", hosts[0].Name.Value)
+	}
+
+	since := time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)
+	hosts, err = c.HostList(context.Background(), HostFilter{Nodeset: "cpn-[01-02]", Tags: []string{"ib", "gpu"}, Port: 12, Since: since})
+	if assert.NoError(t, err) {
+		assert.Len(t, hosts, 2)
+	}
+
+	status, err := c.Status(context.Background())
+	assert.Error(t, err)
+	assert.ErrorIs(t, err, ErrNotFound)
+	assert.Nil(t, status)
+}
+
+func TestHostSave(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/v1/nodes":
+			writeJSON(w, http.StatusOK, `{"title": "Success", "detail": "saved", "changed": 2}`)
+		default:
+			writeJSON(w, http.StatusOK, testHosts)
+		}
+	}, Config{})
+
+	hosts, err := c.HostList(context.Background(), HostFilter{})
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	res, err := c.HostSave(context.Background(), hosts)
+	if assert.NoError(t, err) {
+		assert.Equal(t, 2, res.Changed.Value)
+	}
+}
+
+func TestAPIError(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/v1/images":
+			writeJSON(w, http.StatusBadRequest, `{"title": "Error", "detail": "invalid request body", "status": 400, "errors": [{"name": "/boot_images/0/kernel", "reason": "invalid type"}]}`)
+		default:
+			writeJSON(w, http.StatusNotFound, `{"title": "Error", "detail": "failed to find nodes", "status": 404}`)
+		}
+	}, Config{})
+
+	_, err := c.HostList(context.Background(), HostFilter{Nodeset: "cpn-01"})
+	assert.ErrorIs(t, err, ErrNotFound)
+	assert.NotErrorIs(t, err, ErrValidation)
+	assert.EqualError(t, err, "API Error: status=404 title=Error detail=failed to find nodes")
+
+	_, err = c.ImageList(context.Background())
+	assert.ErrorIs(t, err, ErrValidation)
+	var apiErr *APIError
+	if assert.True(t, errors.As(err, &apiErr)) && assert.Len(t, apiErr.Errors, 1) {
+		assert.Equal(t, http.StatusBadRequest, apiErr.StatusCode)
+		assert.Equal(t, "/boot_images/0/kernel", apiErr.Errors[0].Name.Value)
+	}
+}
+
+func TestRetry(t *testing.T) {
+	var count atomic.Int32
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		n := count.Add(1)
+		switch {
+		case r.Method == http.MethodPost:
+			writeJSON(w, http.StatusInternalServerError, `{"title": "Error", "detail": "failed"}`)
+		case n < 3:
+			writeJSON(w, http.StatusServiceUnavailable, `{"title": "Error", "detail": "unavailable"}`)
+		default:
+			writeJSON(w, http.StatusOK, testHosts)
+		}
+	}, Config{MaxRetries: 3, RetryWaitMin: time.Millisecond})
+
+	hosts, err := c.HostList(context.Background(), HostFilter{})
+	if assert.NoError(t, err) {
+		assert.Len(t, hosts, 2)
+	}
+	assert.Equal(t, int32(3), count.Load())
+
+	// Non idempotent requests are not retried after a 500
+	count.Store(0)
+	_, err = c.HostSave(context.Background(), hosts)
+	assert.Error(t, err)
+	assert.Equal(t, int32(1), count.Load())
+}
+
+func TestEventStream(t *testing.T) {
+	start := time.Now().UTC()
+
+	var mu sync.Mutex
+	events := fmt.Sprintf(`[{"Message": "old", "Time": %q}]`, start.Format(time.RFC3339Nano))
+	polls := 0
+
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		polls++
+		if polls == 2 {
+			events = fmt.Sprintf(`[{"Message": "second", "Time": %q}, {"Message": "first", "Time": %q}, {"Message": "old", "Time": %q}]`,
+				start.Add(2*time.Second).Format(time.RFC3339Nano),
+				start.Add(time.Second).Format(time.RFC3339Nano),
+				start.Format(time.RFC3339Nano))
+		}
+		writeJSON(w, http.StatusOK, events)
+	}, Config{})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	received := make([]string, 0)
+	err := c.EventStream(ctx, 10*time.Millisecond, func(e Event) error {
+		received = append(received, e.Message.Value)
+		if len(received) == 2 {
+			cancel()
+		}
+		return nil
+	})
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.Equal(t, []string{"first", "second"}, received)
+}
+
+func TestConsole(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("host") != "cpn-01" {
+			writeJSON(w, http.StatusConflict, `{"title": "Error", "detail": "console of cpn-02 is in use by alice since 2026-10-15T09:00:00Z", "status": 409}`)
+			return
+		}
+		assert.Equal(t, "Bearer secret", r.Header.Get("Authorization"))
+		assert.Equal(t, consoleProtocol, r.Header.Get("Upgrade"))
+
+		conn, rw, err := http.NewResponseController(w).Hijack()
+		if !assert.NoError(t, err) {
+			return
+		}
+		defer conn.Close()
+		fmt.Fprintf(rw, "HTTP/1.1 101 Switching Protocols\r\nConnection: Upgrade\r\nUpgrade: %s\r\n\r\n", consoleProtocol)
+		rw.Flush()
+
+		// Echo the console input
+		buf := make([]byte, 5)
+		if _, err := io.ReadFull(rw, buf); assert.NoError(t, err) {
+			conn.Write(buf)
+		}
+	}))
+	defer ts.Close()
+
+	cfg := Config{Endpoint: ts.URL, APIKey: "secret"}
+
+	_, err := Console(context.Background(), cfg, "cpn-02", "")
+	assert.ErrorIs(t, err, ErrConflict)
+	assert.ErrorContains(t, err, "in use by alice")
+
+	conn, err := Console(context.Background(), cfg, "cpn-01", "")
+	if !assert.NoError(t, err) {
+		return
+	}
+	defer conn.Close()
+
+	_, err = conn.Write([]byte("hello"))
+	assert.NoError(t, err)
+	out, err := io.ReadAll(conn)
+	assert.NoError(t, err)
+	assert.Equal(t, "hello", string(out))
+}
@@ -28,6 +28,7 @@ type Invoker interface {
 	// `github.com/ubccr/grendel/internal/api.(*Handler).AuthSignout`
 	// #### Middlewares:
 	// - `github.com/go-fuego/fuego.defaultLogger.middleware`
+	// - `github.com/ubccr/grendel/internal/api.validateMiddleware.func1`
 	// ---
 	// Signout user.
 	//
@@ -40,6 +41,7 @@ type Invoker interface {
 	// #### Middlewares:
 	// - `github.com/go-fuego/fuego.defaultLogger.middleware`
 	// - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
+	// - `github.com/ubccr/grendel/internal/api.validateMiddleware.func1`
 	// ---
 	// Delete redfish jobs from many node(s).
 	//
@@ -52,6 +54,7 @@ type Invoker interface {
 	// #### Middlewares:
 	// - `github.com/go-fuego/fuego.defaultLogger.middleware`
 	// - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
+	// - `github.com/ubccr/grendel/internal/api.validateMiddleware.func1`
 	// ---
 	// Delete redfish jobs from node(s) by JID.
 	//
@@ -64,11 +67,51 @@ type Invoker interface {
 	// #### Middlewares:
 	// - `github.com/go-fuego/fuego.defaultLogger.middleware`
 	// - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
+	// - `github.com/ubccr/grendel/internal/api.validateMiddleware.func1`
 	// ---
 	// Clear system event log on node(s).
 	//
 	// DELETE /v1/bmc/sel
 	DELETEV1BmcSel(ctx context.Context, params DELETEV1BmcSelParams) ([]JobMessage, error)
+	// DELETEV1BmcVmedia invokes DELETE_/v1/bmc/vmedia operation.
+	//
+	// #### Controller:
+	// `github.com/ubccr/grendel/internal/api.(*Handler).BmcVirtualMediaUnmount`
+	// #### Middlewares:
+	// - `github.com/go-fuego/fuego.defaultLogger.middleware`
+	// - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
+	// - `github.com/ubccr/grendel/internal/api.validateMiddleware.func1`
+	// ---
+	// Eject the virtual CD of node(s).
+	//
+	// DELETE /v1/bmc/vmedia
+	DELETEV1BmcVmedia(ctx context.Context, params DELETEV1BmcVmediaParams) ([]JobMessage, error)
+	// DELETEV1DNSRecords invokes DELETE_/v1/dns/records operation.
+	//
+	// #### Controller:
+	// `github.com/ubccr/grendel/internal/api.(*Handler).DNSRecordDelete`
+	// #### Middlewares:
+	// - `github.com/go-fuego/fuego.defaultLogger.middleware`
+	// - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
+	// - `github.com/ubccr/grendel/internal/api.validateMiddleware.func1`
+	// ---
+	// Delete DNS only records by name.
+	//
+	// DELETE /v1/dns/records
+	DELETEV1DNSRecords(ctx context.Context, params DELETEV1DNSRecordsParams) (*GenericResponse, error)
+	// DELETEV1DiscoverBmc invokes DELETE_/v1/discover/bmc operation.
+	//
+	// #### Controller:
+	// `github.com/ubccr/grendel/internal/api.(*Handler).DiscoverBMCDelete`
+	// #### Middlewares:
+	// - `github.com/go-fuego/fuego.defaultLogger.middleware`
+	// - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
+	// - `github.com/ubccr/grendel/internal/api.validateMiddleware.func1`
+	// ---
+	// Delete a pending BMC. It is recorded again if it keeps requesting an address.
+	//
+	// DELETE /v1/discover/bmc
+	DELETEV1DiscoverBmc(ctx context.Context, params DELETEV1DiscoverBmcParams) (*GenericResponse, error)
 	// DELETEV1Images invokes DELETE_/v1/images operation.
 	//
 	// #### Controller:
@@ -76,6 +119,7 @@ type Invoker interface {
 	// #### Middlewares:
 	// - `github.com/go-fuego/fuego.defaultLogger.middleware`
 	// - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
+	// - `github.com/ubccr/grendel/internal/api.validateMiddleware.func1`
 	// ---
 	// Delete images by name.
 	//
@@ -88,11 +132,51 @@ type Invoker interface {
 	// #### Middlewares:
 	// - `github.com/go-fuego/fuego.defaultLogger.middleware`
 	// - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
+	// - `github.com/ubccr/grendel/internal/api.validateMiddleware.func1`
 	// ---
 	// Delete nodes by nodeset and/or tags.
 	//
 	// DELETE /v1/nodes
 	DELETEV1Nodes(ctx context.Context, params DELETEV1NodesParams) (*GenericResponse, error)
+	// DELETEV1NodesCredentials invokes DELETE_/v1/nodes/credentials operation.
+	//
+	// #### Controller:
+	// `github.com/ubccr/grendel/internal/api.(*Handler).NodeCredentialDelete`
+	// #### Middlewares:
+	// - `github.com/go-fuego/fuego.defaultLogger.middleware`
+	// - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
+	// - `github.com/ubccr/grendel/internal/api.validateMiddleware.func1`
+	// ---
+	// Delete the credentials of nodes by nodeset and/or tags.
+	//
+	// DELETE /v1/nodes/credentials
+	DELETEV1NodesCredentials(ctx context.Context, params DELETEV1NodesCredentialsParams) (*GenericResponse, error)
+	// DELETEV1NodesFiles invokes DELETE_/v1/nodes/files operation.
+	//
+	// #### Controller:
+	// `github.com/ubccr/grendel/internal/api.(*Handler).NodeFileDelete`
+	// #### Middlewares:
+	// - `github.com/go-fuego/fuego.defaultLogger.middleware`
+	// - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
+	// - `github.com/ubccr/grendel/internal/api.validateMiddleware.func1`
+	// ---
+	// Delete the files with the given name of nodes by nodeset and/or tags.
+	//
+	// DELETE /v1/nodes/files
+	DELETEV1NodesFiles(ctx context.Context, params DELETEV1NodesFilesParams) (*GenericResponse, error)
+	// DELETEV1NodesTrash invokes DELETE_/v1/nodes/trash operation.
+	//
+	// #### Controller:
+	// `github.com/ubccr/grendel/internal/api.(*Handler).NodeTrashPurge`
+	// #### Middlewares:
+	// - `github.com/go-fuego/fuego.defaultLogger.middleware`
+	// - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
+	// - `github.com/ubccr/grendel/internal/api.validateMiddleware.func1`
+	// ---
+	// Permanently delete nodes from the trash.
+	//
+	// DELETE /v1/nodes/trash
+	DELETEV1NodesTrash(ctx context.Context, params DELETEV1NodesTrashParams) (*GenericResponse, error)
 	// DELETEV1RolesNames invokes DELETE_/v1/roles/:names operation.
 	//
 	// #### Controller:
@@ -100,11 +184,38 @@ type Invoker interface {
 	// #### Middlewares:
 	// - `github.com/go-fuego/fuego.defaultLogger.middleware`
 	// - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
+	// - `github.com/ubccr/grendel/internal/api.validateMiddleware.func1`
 	// ---
 	// Delete roles.
 	//
 	// DELETE /v1/roles/{names}
 	DELETEV1RolesNames(ctx context.Context, params DELETEV1RolesNamesParams) (*GenericResponse, error)
+	// DELETEV1ScheduleID invokes DELETE_/v1/schedule/:id operation.
+	//
+	// #### Controller:
+	// `github.com/ubccr/grendel/internal/api.(*Handler).ScheduleCancel`
+	// #### Middlewares:
+	// - `github.com/go-fuego/fuego.defaultLogger.middleware`
+	// - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
+	// - `github.com/ubccr/grendel/internal/api.validateMiddleware.func1`
+	// ---
+	// Cancel a pending scheduled action.
+	//
+	// DELETE /v1/schedule/{id}
+	DELETEV1ScheduleID(ctx context.Context, params DELETEV1ScheduleIDParams) (*GenericResponse, error)
+	// DELETEV1SecretsName invokes DELETE_/v1/secrets/:name operation.
+	//
+	// #### Controller:
+	// `github.com/ubccr/grendel/internal/api.(*Handler).SecretDelete`
+	// #### Middlewares:
+	// - `github.com/go-fuego/fuego.defaultLogger.middleware`
+	// - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
+	// - `github.com/ubccr/grendel/internal/api.validateMiddleware.func1`
+	// ---
+	// Delete a secret.
+	//
+	// DELETE /v1/secrets/{name}
+	DELETEV1SecretsName(ctx context.Context, params DELETEV1SecretsNameParams) (*GenericResponse, error)
 	// DELETEV1UsersUsernames invokes DELETE_/v1/users/:usernames operation.
 	//
 	// #### Controller:
@@ -112,11 +223,26 @@ type Invoker interface {
 	// #### Middlewares:
 	// - `github.com/go-fuego/fuego.defaultLogger.middleware`
 	// - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
+	// - `github.com/ubccr/grendel/internal/api.validateMiddleware.func1`
 	// ---
 	// Delete users.
 	//
 	// DELETE /v1/users/{usernames}
 	DELETEV1UsersUsernames(ctx context.Context, params DELETEV1UsersUsernamesParams) (*GenericResponse, error)
+	// GETV1Artifacts invokes GET_/v1/artifacts operation.
+	//
+	// #### Controller:
+	// `github.com/ubccr/grendel/internal/api.(*Handler).ArtifactList`
+	// #### Middlewares:
+	// - `github.com/go-fuego/fuego.defaultLogger.middleware`
+	// - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
+	// - `github.com/ubccr/grendel/internal/api.validateMiddleware.func1`
+	// ---
+	// List the artifacts served at /v1/artifacts/{name}, files such as /etc/hosts or genders derived
+	// from the nodes, defined in api.artifacts.
+	//
+	// GET /v1/artifacts
+	GETV1Artifacts(ctx context.Context, params GETV1ArtifactsParams) ([]ArtifactResponse, error)
 	// GETV1Bmc invokes GET_/v1/bmc operation.
 	//
 	// #### Controller:
@@ -124,11 +250,38 @@ type Invoker interface {
 	// #### Middlewares:
 	// - `github.com/go-fuego/fuego.defaultLogger.middleware`
 	// - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
+	// - `github.com/ubccr/grendel/internal/api.validateMiddleware.func1`
 	// ---
 	// Get redfish info from node(s).
 	//
 	// GET /v1/bmc
 	GETV1Bmc(ctx context.Context, params GETV1BmcParams) ([]RedfishSystem, error)
+	// GETV1BmcBios invokes GET_/v1/bmc/bios operation.
+	//
+	// #### Controller:
+	// `github.com/ubccr/grendel/internal/api.(*Handler).BmcBiosGet`
+	// #### Middlewares:
+	// - `github.com/go-fuego/fuego.defaultLogger.middleware`
+	// - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
+	// - `github.com/ubccr/grendel/internal/api.validateMiddleware.func1`
+	// ---
+	// Get the current BIOS attributes of node(s).
+	//
+	// GET /v1/bmc/bios
+	GETV1BmcBios(ctx context.Context, params GETV1BmcBiosParams) ([]BiosReport, error)
+	// GETV1BmcBiosDiff invokes GET_/v1/bmc/bios/diff operation.
+	//
+	// #### Controller:
+	// `github.com/ubccr/grendel/internal/api.(*Handler).BmcBiosDiff`
+	// #### Middlewares:
+	// - `github.com/go-fuego/fuego.defaultLogger.middleware`
+	// - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
+	// - `github.com/ubccr/grendel/internal/api.validateMiddleware.func1`
+	// ---
+	// List the BIOS attributes of node(s) which differ from a BIOS profile in bmc.bios_profiles.
+	//
+	// GET /v1/bmc/bios/diff
+	GETV1BmcBiosDiff(ctx context.Context, params GETV1BmcBiosDiffParams) ([]BiosReport, error)
 	// GETV1BmcJobs invokes GET_/v1/bmc/jobs operation.
 	//
 	// #### Controller:
@@ -136,6 +289,7 @@ type Invoker interface {
 	// #### Middlewares:
 	// - `github.com/go-fuego/fuego.defaultLogger.middleware`
 	// - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
+	// - `github.com/ubccr/grendel/internal/api.validateMiddleware.func1`
 	// ---
 	// Get redfish jobs from node(s).
 	//
@@ -148,449 +302,10185 @@ type Invoker interface {
 	// #### Middlewares:
 	// - `github.com/go-fuego/fuego.defaultLogger.middleware`
 	// - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
+	// - `github.com/ubccr/grendel/internal/api.validateMiddleware.func1`
 	// ---
 	// Get metric reports by nodeset.
 	//
 	// GET /v1/bmc/metrics
 	GETV1BmcMetrics(ctx context.Context, params GETV1BmcMetricsParams) ([]RedfishMetricReport, error)
-	// GETV1BmcUpgradeDellRepo invokes GET_/v1/bmc/upgrade/dell/repo operation.
+	// GETV1BmcPower invokes GET_/v1/bmc/power operation.
 	//
 	// #### Controller:
-	// `github.com/ubccr/grendel/internal/api.(*Handler).BmcDellGetRepoUpdateList`
+	// `github.com/ubccr/grendel/internal/api.(*Handler).BmcPowerStatus`
 	// #### Middlewares:
 	// - `github.com/go-fuego/fuego.defaultLogger.middleware`
 	// - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
+	// - `github.com/ubccr/grendel/internal/api.validateMiddleware.func1`
 	// ---
-	// Fetch which packages can be upgraded.
+	// Get the power state of node(s) as on, off or unknown in data and the redfish power state in msg.
 	//
-	// GET /v1/bmc/upgrade/dell/repo
-	GETV1BmcUpgradeDellRepo(ctx context.Context, params GETV1BmcUpgradeDellRepoParams) ([]RedfishDellUpgradeFirmware, error)
-	// GETV1DbDump invokes GET_/v1/db/dump operation.
+	// GET /v1/bmc/power
+	GETV1BmcPower(ctx context.Context, params GETV1BmcPowerParams) ([]JobMessage, error)
+	// GETV1BmcSubscriptions invokes GET_/v1/bmc/subscriptions operation.
 	//
 	// #### Controller:
-	// `github.com/ubccr/grendel/internal/api.(*Handler).Dump`
+	// `github.com/ubccr/grendel/internal/api.(*Handler).BmcSubscriptionsStatus`
 	// #### Middlewares:
 	// - `github.com/go-fuego/fuego.defaultLogger.middleware`
 	// - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
+	// - `github.com/ubccr/grendel/internal/api.validateMiddleware.func1`
 	// ---
-	// Get a backup of the DB.
+	// Check the BMC of node(s) has an event subscription to the event receiver.
 	//
-	// GET /v1/db/dump
-	GETV1DbDump(ctx context.Context, params GETV1DbDumpParams) (*DataDump, error)
-	// GETV1GrendelEvents invokes GET_/v1/grendel/events operation.
+	// GET /v1/bmc/subscriptions
+	GETV1BmcSubscriptions(ctx context.Context, params GETV1BmcSubscriptionsParams) ([]EventSubscription, error)
+	// GETV1BmcUpgradeDellRepo invokes GET_/v1/bmc/upgrade/dell/repo operation.
 	//
 	// #### Controller:
-	// `github.com/ubccr/grendel/internal/api.(*Handler).GetEvents`
+	// `github.com/ubccr/grendel/internal/api.(*Handler).BmcDellGetRepoUpdateList`
 	// #### Middlewares:
 	// - `github.com/go-fuego/fuego.defaultLogger.middleware`
 	// - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
-	// ---.
+	// - `github.com/ubccr/grendel/internal/api.validateMiddleware.func1`
+	// ---
+	// Fetch which packages can be upgraded.
 	//
-	// GET /v1/grendel/events
-	GETV1GrendelEvents(ctx context.Context, params GETV1GrendelEventsParams) ([]Event, error)
-	// GETV1Images invokes GET_/v1/images operation.
+	// GET /v1/bmc/upgrade/dell/repo
+	GETV1BmcUpgradeDellRepo(ctx context.Context, params GETV1BmcUpgradeDellRepoParams) ([]RedfishDellUpgradeFirmware, error)
+	// GETV1BmcVmedia invokes GET_/v1/bmc/vmedia operation.
 	//
 	// #### Controller:
-	// `github.com/ubccr/grendel/internal/api.(*Handler).BootImageList`
+	// `github.com/ubccr/grendel/internal/api.(*Handler).BmcVirtualMediaStatus`
 	// #### Middlewares:
 	// - `github.com/go-fuego/fuego.defaultLogger.middleware`
 	// - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
+	// - `github.com/ubccr/grendel/internal/api.validateMiddleware.func1`
 	// ---
-	// List all images.
+	// Get the image inserted in the virtual CD of node(s) in msg and inserted or ejected in data.
 	//
-	// GET /v1/images
-	GETV1Images(ctx context.Context, params GETV1ImagesParams) ([]BootImage, error)
-	// GETV1ImagesFind invokes GET_/v1/images/find operation.
+	// GET /v1/bmc/vmedia
+	GETV1BmcVmedia(ctx context.Context, params GETV1BmcVmediaParams) ([]JobMessage, error)
+	// GETV1CertsRevoked invokes GET_/v1/certs/revoked operation.
 	//
 	// #### Controller:
-	// `github.com/ubccr/grendel/internal/api.(*Handler).BootImageFind`
+	// `github.com/ubccr/grendel/internal/api.(*Handler).CertRevokedList`
 	// #### Middlewares:
 	// - `github.com/go-fuego/fuego.defaultLogger.middleware`
 	// - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
+	// - `github.com/ubccr/grendel/internal/api.validateMiddleware.func1`
 	// ---
-	// Find images by name.
+	// List the revoked certificates.
 	//
-	// GET /v1/images/find
-	GETV1ImagesFind(ctx context.Context, params GETV1ImagesFindParams) ([]BootImage, error)
-	// GETV1Nodes invokes GET_/v1/nodes operation.
+	// GET /v1/certs/revoked
+	GETV1CertsRevoked(ctx context.Context, params GETV1CertsRevokedParams) ([]RevokedCert, error)
+	// GETV1Changes invokes GET_/v1/changes operation.
 	//
 	// #### Controller:
-	// `github.com/ubccr/grendel/internal/api.(*Handler).NodeList`
+	// `github.com/ubccr/grendel/internal/api.(*Handler).ChangeList`
 	// #### Middlewares:
 	// - `github.com/go-fuego/fuego.defaultLogger.middleware`
 	// - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
+	// - `github.com/ubccr/grendel/internal/api.validateMiddleware.func1`
 	// ---
-	// List all nodes.
+	// List the changes made to nodes, images and DNS records following a sequence number. Consumers
+	// store the seq of the last change processed and pass it as since_seq, a change may be received
+	// again if the consumer fails before storing it. Entries are kept for change_retention, truncated is
+	// set if changes following since_seq were purged and the consumer must resync from a full dump.
 	//
-	// GET /v1/nodes
-	GETV1Nodes(ctx context.Context, params GETV1NodesParams) ([]Host, error)
-	// GETV1NodesFind invokes GET_/v1/nodes/find operation.
+	// GET /v1/changes
+	GETV1Changes(ctx context.Context, params GETV1ChangesParams) (*ChangeFeed, error)
+	// GETV1DNSRecords invokes GET_/v1/dns/records operation.
 	//
 	// #### Controller:
-	// `github.com/ubccr/grendel/internal/api.(*Handler).NodeFind`
+	// `github.com/ubccr/grendel/internal/api.(*Handler).DNSRecordList`
 	// #### Middlewares:
 	// - `github.com/go-fuego/fuego.defaultLogger.middleware`
 	// - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
+	// - `github.com/ubccr/grendel/internal/api.validateMiddleware.func1`
 	// ---
-	// Find nodes by nodeset and/or tags.
+	// List DNS only records.
 	//
-	// GET /v1/nodes/find
-	GETV1NodesFind(ctx context.Context, params GETV1NodesFindParams) ([]Host, error)
-	// GETV1NodesTokenInterface invokes GET_/v1/nodes/token/:interface operation.
+	// GET /v1/dns/records
+	GETV1DNSRecords(ctx context.Context, params GETV1DNSRecordsParams) ([]Record, error)
+	// GETV1DbBackup invokes GET_/v1/db/backup operation.
 	//
 	// #### Controller:
-	// `github.com/ubccr/grendel/internal/api.(*Handler).NodeBootToken`
+	// `github.com/ubccr/grendel/internal/api.(*Handler).Backup`
 	// #### Middlewares:
 	// - `github.com/go-fuego/fuego.defaultLogger.middleware`
 	// - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
+	// - `github.com/ubccr/grendel/internal/api.validateMiddleware.func1`
 	// ---
-	// Create a boot token for the provision server. Used for debugging requests made by images.
+	// Stream a consistent point in time backup archive of the DB.
 	//
-	// GET /v1/nodes/token/{interface}
-	GETV1NodesTokenInterface(ctx context.Context, params GETV1NodesTokenInterfaceParams) (*NodeBootTokenResponse, error)
-	// GETV1Roles invokes GET_/v1/roles operation.
+	// GET /v1/db/backup
+	GETV1DbBackup(ctx context.Context, params GETV1DbBackupParams) (GETV1DbBackupOK, error)
+	// GETV1DbDump invokes GET_/v1/db/dump operation.
 	//
 	// #### Controller:
-	// `github.com/ubccr/grendel/internal/api.(*Handler).GetRoles`
+	// `github.com/ubccr/grendel/internal/api.(*Handler).Dump`
 	// #### Middlewares:
 	// - `github.com/go-fuego/fuego.defaultLogger.middleware`
 	// - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
+	// - `github.com/ubccr/grendel/internal/api.validateMiddleware.func1`
 	// ---
-	// Get roles and permissions.
+	// Get a backup of the DB.
 	//
-	// GET /v1/roles
-	GETV1Roles(ctx context.Context, params GETV1RolesParams) (*GetRolesResponse, error)
-	// GETV1SwitchNodesetLldp invokes GET_/v1/switch/:nodeset/lldp operation.
+	// GET /v1/db/dump
+	GETV1DbDump(ctx context.Context, params GETV1DbDumpParams) (*DataDump, error)
+	// GETV1DiscoverBmc invokes GET_/v1/discover/bmc operation.
 	//
 	// #### Controller:
-	// `github.com/ubccr/grendel/internal/api.(*Handler).SwitchGetLLDP`
+	// `github.com/ubccr/grendel/internal/api.(*Handler).DiscoverBMCList`
 	// #### Middlewares:
 	// - `github.com/go-fuego/fuego.defaultLogger.middleware`
 	// - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
+	// - `github.com/ubccr/grendel/internal/api.validateMiddleware.func1`
 	// ---
-	// Get switch LLDP info.
+	// List the BMCs seen on DHCP from MAC addresses not registered to any node, recorded when dhcp.
+	// bmc_discovery is enabled.
 	//
-	// GET /v1/switch/{nodeset}/lldp
-	GETV1SwitchNodesetLldp(ctx context.Context, params GETV1SwitchNodesetLldpParams) ([]LLDP, error)
-	// GETV1Users invokes GET_/v1/users operation.
+	// GET /v1/discover/bmc
+	GETV1DiscoverBmc(ctx context.Context, params GETV1DiscoverBmcParams) ([]PendingBMC, error)
+	// GETV1GrendelEvents invokes GET_/v1/grendel/events operation.
 	//
 	// #### Controller:
-	// `github.com/ubccr/grendel/internal/api.(*Handler).UserList`
+	// `github.com/ubccr/grendel/internal/api.(*Handler).GetEvents`
 	// #### Middlewares:
 	// - `github.com/go-fuego/fuego.defaultLogger.middleware`
 	// - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
-	// ---
-	// List all users.
+	// - `github.com/ubccr/grendel/internal/api.validateMiddleware.func1`
+	// ---.
 	//
-	// GET /v1/users
-	GETV1Users(ctx context.Context, params GETV1UsersParams) ([]User, error)
-	// PATCHV1AuthReset invokes PATCH_/v1/auth/reset operation.
+	// GET /v1/grendel/events
+	GETV1GrendelEvents(ctx context.Context, params GETV1GrendelEventsParams) ([]Event, error)
+	// GETV1GrendelHa invokes GET_/v1/grendel/ha operation.
 	//
 	// #### Controller:
-	// `github.com/ubccr/grendel/internal/api.(*Handler).AuthReset`
+	// `github.com/ubccr/grendel/internal/api.(*Handler).GrendelHA`
 	// #### Middlewares:
 	// - `github.com/go-fuego/fuego.defaultLogger.middleware`
 	// - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
+	// - `github.com/ubccr/grendel/internal/api.validateMiddleware.func1`
 	// ---
-	// Change password.
+	// List the grendel serve instances running in high availability mode and their DHCP state.
 	//
-	// PATCH /v1/auth/reset
-	PATCHV1AuthReset(ctx context.Context, request *AuthResetRequest, params PATCHV1AuthResetParams) (*GenericResponse, error)
-	// PATCHV1NodesImage invokes PATCH_/v1/nodes/image operation.
+	// GET /v1/grendel/ha
+	GETV1GrendelHa(ctx context.Context, params GETV1GrendelHaParams) ([]HAInstance, error)
+	// GETV1GrendelKeys invokes GET_/v1/grendel/keys operation.
 	//
 	// #### Controller:
-	// `github.com/ubccr/grendel/internal/api.(*Handler).NodeBootImage`
+	// `github.com/ubccr/grendel/internal/api.(*Handler).GrendelKeys`
 	// #### Middlewares:
 	// - `github.com/go-fuego/fuego.defaultLogger.middleware`
 	// - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
+	// - `github.com/ubccr/grendel/internal/api.validateMiddleware.func1`
 	// ---
-	// Update nodes boot image by nodeset and/or tags.
+	// List the keys verifying boot and API tokens, the primary key of each kind signs new tokens.
+	// Secrets are never returned.
 	//
-	// PATCH /v1/nodes/image
-	PATCHV1NodesImage(ctx context.Context, request *NodeBootImageRequest, params PATCHV1NodesImageParams) (*GenericResponse, error)
-	// PATCHV1NodesProvision invokes PATCH_/v1/nodes/provision operation.
+	// GET /v1/grendel/keys
+	GETV1GrendelKeys(ctx context.Context, params GETV1GrendelKeysParams) ([]SigningKey, error)
+	// GETV1GrendelListeners invokes GET_/v1/grendel/listeners operation.
 	//
 	// #### Controller:
-	// `github.com/ubccr/grendel/internal/api.(*Handler).NodeProvision`
+	// `github.com/ubccr/grendel/internal/api.(*Handler).GrendelListeners`
 	// #### Middlewares:
 	// - `github.com/go-fuego/fuego.defaultLogger.middleware`
 	// - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
+	// - `github.com/ubccr/grendel/internal/api.validateMiddleware.func1`
 	// ---
-	// Provision / Unprovision nodes by nodeset and/or tags.
+	// List the sockets bound by the services of the grendel serve process running the API and their
+	// address family.
 	//
-	// PATCH /v1/nodes/provision
-	PATCHV1NodesProvision(ctx context.Context, request *NodeProvisionRequest, params PATCHV1NodesProvisionParams) (*GenericResponse, error)
-	// PATCHV1NodesTagsAction invokes PATCH_/v1/nodes/tags/:action operation.
+	// GET /v1/grendel/listeners
+	GETV1GrendelListeners(ctx context.Context, params GETV1GrendelListenersParams) ([]Listener, error)
+	// GETV1GrendelMaintenance invokes GET_/v1/grendel/maintenance operation.
 	//
 	// #### Controller:
-	// `github.com/ubccr/grendel/internal/api.(*Handler).NodeTags`
+	// `github.com/ubccr/grendel/internal/api.(*Handler).GrendelMaintenance`
 	// #### Middlewares:
 	// - `github.com/go-fuego/fuego.defaultLogger.middleware`
 	// - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
+	// - `github.com/ubccr/grendel/internal/api.validateMiddleware.func1`
 	// ---
-	// Update nodes tags by nodeset and/or tags.
+	// Get the maintenance mode pausing provisioning.
 	//
-	// PATCH /v1/nodes/tags/{action}
-	PATCHV1NodesTagsAction(ctx context.Context, request *NodeTagsRequest, params PATCHV1NodesTagsActionParams) (*GenericResponse, error)
-	// PATCHV1Roles invokes PATCH_/v1/roles operation.
+	// GET /v1/grendel/maintenance
+	GETV1GrendelMaintenance(ctx context.Context, params GETV1GrendelMaintenanceParams) (*Maintenance, error)
+	// GETV1GrendelReadonly invokes GET_/v1/grendel/readonly operation.
 	//
 	// #### Controller:
-	// `github.com/ubccr/grendel/internal/api.(*Handler).PatchRoles`
+	// `github.com/ubccr/grendel/internal/api.(*Handler).GrendelReadOnly`
 	// #### Middlewares:
 	// - `github.com/go-fuego/fuego.defaultLogger.middleware`
 	// - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
+	// - `github.com/ubccr/grendel/internal/api.validateMiddleware.func1`
 	// ---
-	// Edit role permissions.
+	// Get whether the grendel serve process running the API is in read-only mode. Writes to the data
+	// store are then refused with 403 naming the primary instance.
 	//
-	// PATCH /v1/roles
-	PATCHV1Roles(ctx context.Context, request *PatchRolesRequest, params PATCHV1RolesParams) (*GenericResponse, error)
-	// PATCHV1UsersUsernamesEnable invokes PATCH_/v1/users/:usernames/enable operation.
+	// GET /v1/grendel/readonly
+	GETV1GrendelReadonly(ctx context.Context, params GETV1GrendelReadonlyParams) (*ReadOnlyResponse, error)
+	// GETV1GrendelStats invokes GET_/v1/grendel/stats operation.
 	//
 	// #### Controller:
-	// `github.com/ubccr/grendel/internal/api.(*Handler).UserEnable`
+	// `github.com/ubccr/grendel/internal/api.(*Handler).GrendelStats`
 	// #### Middlewares:
 	// - `github.com/go-fuego/fuego.defaultLogger.middleware`
 	// - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
+	// - `github.com/ubccr/grendel/internal/api.validateMiddleware.func1`
 	// ---
-	// Update users enable.
+	// Summary of the hosts by provision state, boot image and tag and of the recent DHCP, provision and
+	// DNS activity of the grendel serve process running the API. Cached for 10 seconds.
 	//
-	// PATCH /v1/users/{usernames}/enable
-	PATCHV1UsersUsernamesEnable(ctx context.Context, request *UserEnableRequest, params PATCHV1UsersUsernamesEnableParams) (*GenericResponse, error)
-	// PATCHV1UsersUsernamesRole invokes PATCH_/v1/users/:usernames/role operation.
+	// GET /v1/grendel/stats
+	GETV1GrendelStats(ctx context.Context, params GETV1GrendelStatsParams) (*Stats, error)
+	// GETV1Images invokes GET_/v1/images operation.
 	//
 	// #### Controller:
-	// `github.com/ubccr/grendel/internal/api.(*Handler).UserRole`
+	// `github.com/ubccr/grendel/internal/api.(*Handler).BootImageList`
 	// #### Middlewares:
 	// - `github.com/go-fuego/fuego.defaultLogger.middleware`
 	// - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
+	// - `github.com/ubccr/grendel/internal/api.validateMiddleware.func1`
 	// ---
-	// Update users role.
+	// List all images.
 	//
-	// PATCH /v1/users/{usernames}/role
-	PATCHV1UsersUsernamesRole(ctx context.Context, request *UserRoleRequest, params PATCHV1UsersUsernamesRoleParams) (*GenericResponse, error)
-	// POSTV1AuthSignin invokes POST_/v1/auth/signin operation.
+	// GET /v1/images
+	GETV1Images(ctx context.Context, params GETV1ImagesParams) ([]BootImage, error)
+	// GETV1ImagesDeleted invokes GET_/v1/images/deleted operation.
 	//
 	// #### Controller:
-	// `github.com/ubccr/grendel/internal/api.(*Handler).AuthSignin`
+	// `github.com/ubccr/grendel/internal/api.(*Handler).BootImageDeleted`
 	// #### Middlewares:
 	// - `github.com/go-fuego/fuego.defaultLogger.middleware`
+	// - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
+	// - `github.com/ubccr/grendel/internal/api.validateMiddleware.func1`
 	// ---
-	// signin user.
+	// List the names of deleted images. Entries are kept for tombstone_retention.
 	//
-	// POST /v1/auth/signin
-	POSTV1AuthSignin(ctx context.Context, request *AuthRequest, params POSTV1AuthSigninParams) (*AuthResponse, error)
-	// POSTV1AuthSignup invokes POST_/v1/auth/signup operation.
+	// GET /v1/images/deleted
+	GETV1ImagesDeleted(ctx context.Context, params GETV1ImagesDeletedParams) ([]Tombstone, error)
+	// GETV1ImagesDu invokes GET_/v1/images/du operation.
 	//
 	// #### Controller:
-	// `github.com/ubccr/grendel/internal/api.(*Handler).AuthSignup`
+	// `github.com/ubccr/grendel/internal/api.(*Handler).BootImageDiskUsage`
 	// #### Middlewares:
 	// - `github.com/go-fuego/fuego.defaultLogger.middleware`
+	// - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
+	// - `github.com/ubccr/grendel/internal/api.validateMiddleware.func1`
 	// ---
-	// Signup user.
+	// Report the disk space used by the kernel, initrds and signatures of each image. Files shared by
+	// images, through symlinks or hard links, are counted once in the total.
 	//
-	// POST /v1/auth/signup
-	POSTV1AuthSignup(ctx context.Context, request *AuthSignupRequest, params POSTV1AuthSignupParams) (*AuthResponse, error)
-	// POSTV1AuthToken invokes POST_/v1/auth/token operation.
+	// GET /v1/images/du
+	GETV1ImagesDu(ctx context.Context, params GETV1ImagesDuParams) (*ImageDiskUsage, error)
+	// GETV1ImagesFind invokes GET_/v1/images/find operation.
 	//
 	// #### Controller:
-	// `github.com/ubccr/grendel/internal/api.(*Handler).AuthToken`
+	// `github.com/ubccr/grendel/internal/api.(*Handler).BootImageFind`
 	// #### Middlewares:
 	// - `github.com/go-fuego/fuego.defaultLogger.middleware`
 	// - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
+	// - `github.com/ubccr/grendel/internal/api.validateMiddleware.func1`
 	// ---
-	// Create API token.
+	// Find images by name.
 	//
-	// POST /v1/auth/token
-	POSTV1AuthToken(ctx context.Context, request *AuthTokenRequest, params POSTV1AuthTokenParams) (*AuthTokenReponse, error)
-	// POSTV1BmcConfigureAuto invokes POST_/v1/bmc/configure/auto operation.
+	// GET /v1/images/find
+	GETV1ImagesFind(ctx context.Context, params GETV1ImagesFindParams) ([]BootImage, error)
+	// GETV1Nodes invokes GET_/v1/nodes operation.
 	//
 	// #### Controller:
-	// `github.com/ubccr/grendel/internal/api.(*Handler).BmcAutoConfigure`
+	// `github.com/ubccr/grendel/internal/api.(*Handler).NodeList`
 	// #### Middlewares:
 	// - `github.com/go-fuego/fuego.defaultLogger.middleware`
 	// - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
+	// - `github.com/ubccr/grendel/internal/api.validateMiddleware.func1`
 	// ---
-	// Set BMC to autoconfigure.
+	// List all nodes.
 	//
-	// POST /v1/bmc/configure/auto
-	POSTV1BmcConfigureAuto(ctx context.Context, params POSTV1BmcConfigureAutoParams) ([]JobMessage, error)
-	// POSTV1BmcConfigureImport invokes POST_/v1/bmc/configure/import operation.
+	// GET /v1/nodes
+	GETV1Nodes(ctx context.Context, params GETV1NodesParams) ([]Host, error)
+	// GETV1NodesCredentials invokes GET_/v1/nodes/credentials operation.
 	//
 	// #### Controller:
-	// `github.com/ubccr/grendel/internal/api.(*Handler).BmcImportConfiguration`
+	// `github.com/ubccr/grendel/internal/api.(*Handler).NodeCredentialList`
 	// #### Middlewares:
 	// - `github.com/go-fuego/fuego.defaultLogger.middleware`
 	// - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
+	// - `github.com/ubccr/grendel/internal/api.validateMiddleware.func1`
 	// ---
-	// Manually import system configuration to BMC.
+	// List the kinds of credentials stored for nodes by nodeset and/or tags. Secrets are never returned.
 	//
-	// POST /v1/bmc/configure/import
-	POSTV1BmcConfigureImport(ctx context.Context, request *BmcImportConfigurationRequest, params POSTV1BmcConfigureImportParams) ([]JobMessage, error)
-	// POSTV1BmcPowerBmc invokes POST_/v1/bmc/power/bmc operation.
+	// GET /v1/nodes/credentials
+	GETV1NodesCredentials(ctx context.Context, params GETV1NodesCredentialsParams) ([]Credential, error)
+	// GETV1NodesDeleted invokes GET_/v1/nodes/deleted operation.
 	//
 	// #### Controller:
-	// `github.com/ubccr/grendel/internal/api.(*Handler).BmcPower`
+	// `github.com/ubccr/grendel/internal/api.(*Handler).NodeDeleted`
 	// #### Middlewares:
 	// - `github.com/go-fuego/fuego.defaultLogger.middleware`
 	// - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
+	// - `github.com/ubccr/grendel/internal/api.validateMiddleware.func1`
 	// ---
-	// Reboot node(s) BMC.
+	// List the names of deleted nodes, including the old names of renamed nodes. Entries are kept for
+	// tombstone_retention.
 	//
-	// POST /v1/bmc/power/bmc
-	POSTV1BmcPowerBmc(ctx context.Context, params POSTV1BmcPowerBmcParams) ([]JobMessage, error)
-	// POSTV1BmcPowerOs invokes POST_/v1/bmc/power/os operation.
+	// GET /v1/nodes/deleted
+	GETV1NodesDeleted(ctx context.Context, params GETV1NodesDeletedParams) ([]Tombstone, error)
+	// GETV1NodesFiles invokes GET_/v1/nodes/files operation.
 	//
 	// #### Controller:
-	// `github.com/ubccr/grendel/internal/api.(*Handler).BmcOsPower`
+	// `github.com/ubccr/grendel/internal/api.(*Handler).NodeFileList`
 	// #### Middlewares:
 	// - `github.com/go-fuego/fuego.defaultLogger.middleware`
 	// - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
+	// - `github.com/ubccr/grendel/internal/api.validateMiddleware.func1`
 	// ---
-	// Change power status of node(s).
+	// List the files attached to nodes by nodeset and/or tags, without their content.
 	//
-	// POST /v1/bmc/power/os
-	POSTV1BmcPowerOs(ctx context.Context, request *BmcOsPowerBody, params POSTV1BmcPowerOsParams) ([]JobMessage, error)
-	// POSTV1BmcUpgradeDellInstallfromrepo invokes POST_/v1/bmc/upgrade/dell/installfromrepo operation.
+	// GET /v1/nodes/files
+	GETV1NodesFiles(ctx context.Context, params GETV1NodesFilesParams) ([]HostFile, error)
+	// GETV1NodesFind invokes GET_/v1/nodes/find operation.
 	//
 	// #### Controller:
-	// `github.com/ubccr/grendel/internal/api.(*Handler).BmcDellInstallFromRepo`
+	// `github.com/ubccr/grendel/internal/api.(*Handler).NodeFind`
 	// #### Middlewares:
 	// - `github.com/go-fuego/fuego.defaultLogger.middleware`
 	// - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
+	// - `github.com/ubccr/grendel/internal/api.validateMiddleware.func1`
 	// ---
-	// Request iDRAC to download the latest firmware catalog and compare firmware versions.
+	// Find nodes by nodeset and/or tags.
 	//
-	// POST /v1/bmc/upgrade/dell/installfromrepo
-	POSTV1BmcUpgradeDellInstallfromrepo(ctx context.Context, request *BmcDellInstallFromRepoRequest, params POSTV1BmcUpgradeDellInstallfromrepoParams) ([]JobMessage, error)
-	// POSTV1DbRestore invokes POST_/v1/db/restore operation.
+	// GET /v1/nodes/find
+	GETV1NodesFind(ctx context.Context, params GETV1NodesFindParams) ([]Host, error)
+	// GETV1NodesLog invokes GET_/v1/nodes/log operation.
 	//
 	// #### Controller:
-	// `github.com/ubccr/grendel/internal/api.(*Handler).Restore`
+	// `github.com/ubccr/grendel/internal/api.(*Handler).NodeLog`
 	// #### Middlewares:
 	// - `github.com/go-fuego/fuego.defaultLogger.middleware`
 	// - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
+	// - `github.com/ubccr/grendel/internal/api.validateMiddleware.func1`
 	// ---
-	// Restore a backup of the DB.
+	// List the log of node(s), oldest first. The log holds the critical events pushed by the BMCs, up to
+	// 200 entries per node.
 	//
-	// POST /v1/db/restore
-	POSTV1DbRestore(ctx context.Context, request *DataDump, params POSTV1DbRestoreParams) (*GenericResponse, error)
-	// POSTV1Images invokes POST_/v1/images operation.
+	// GET /v1/nodes/log
+	GETV1NodesLog(ctx context.Context, params GETV1NodesLogParams) ([]HostLogEntry, error)
+	// GETV1NodesPrometheusSd invokes GET_/v1/nodes/prometheus-sd operation.
 	//
 	// #### Controller:
-	// `github.com/ubccr/grendel/internal/api.(*Handler).BootImageAdd`
+	// `github.com/ubccr/grendel/internal/api.(*Handler).NodePrometheusSD`
 	// #### Middlewares:
 	// - `github.com/go-fuego/fuego.defaultLogger.middleware`
 	// - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
+	// - `github.com/ubccr/grendel/internal/api.validateMiddleware.func1`
 	// ---
-	// Add images.
+	// List nodes as Prometheus http_sd targets labeled with their name, boot image and tags. Key value
+	// tags such as rack=a01 become labels.
 	//
-	// POST /v1/images
-	POSTV1Images(ctx context.Context, request *BootImageAddRequest, params POSTV1ImagesParams) (*GenericResponse, error)
-	// POSTV1Nodes invokes POST_/v1/nodes operation.
+	// GET /v1/nodes/prometheus-sd
+	GETV1NodesPrometheusSd(ctx context.Context, params GETV1NodesPrometheusSdParams) ([]PrometheusTargetGroup, error)
+	// GETV1NodesReservations invokes GET_/v1/nodes/reservations operation.
 	//
 	// #### Controller:
-	// `github.com/ubccr/grendel/internal/api.(*Handler).NodeAdd`
+	// `github.com/ubccr/grendel/internal/api.(*Handler).NodeReservationList`
 	// #### Middlewares:
 	// - `github.com/go-fuego/fuego.defaultLogger.middleware`
 	// - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
+	// - `github.com/ubccr/grendel/internal/api.validateMiddleware.func1`
 	// ---
-	// Add nodes.
+	// List the address reservations and DHCP conflicts, expired ones included.
 	//
-	// POST /v1/nodes
-	POSTV1Nodes(ctx context.Context, request *NodeAddRequest, params POSTV1NodesParams) (*GenericResponse, error)
-	// POSTV1Roles invokes POST_/v1/roles operation.
+	// GET /v1/nodes/reservations
+	GETV1NodesReservations(ctx context.Context, params GETV1NodesReservationsParams) ([]IPReservation, error)
+	// GETV1NodesTokenInterface invokes GET_/v1/nodes/token/:interface operation.
 	//
 	// #### Controller:
-	// `github.com/ubccr/grendel/internal/api.(*Handler).PostRoles`
+	// `github.com/ubccr/grendel/internal/api.(*Handler).NodeBootToken`
 	// #### Middlewares:
 	// - `github.com/go-fuego/fuego.defaultLogger.middleware`
 	// - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
+	// - `github.com/ubccr/grendel/internal/api.validateMiddleware.func1`
 	// ---
-	// Add roles.
+	// Create a boot token for the provision server. Used for debugging requests made by images.
 	//
-	// POST /v1/roles
-	POSTV1Roles(ctx context.Context, request *PostRolesRequest, params POSTV1RolesParams) (*GenericResponse, error)
-	// POSTV1Users invokes POST_/v1/users operation.
+	// GET /v1/nodes/token/{interface}
+	GETV1NodesTokenInterface(ctx context.Context, params GETV1NodesTokenInterfaceParams) (*NodeBootTokenResponse, error)
+	// GETV1NodesTrash invokes GET_/v1/nodes/trash operation.
 	//
 	// #### Controller:
-	// `github.com/ubccr/grendel/internal/api.(*Handler).UserStore`
+	// `github.com/ubccr/grendel/internal/api.(*Handler).NodeTrashList`
 	// #### Middlewares:
 	// - `github.com/go-fuego/fuego.defaultLogger.middleware`
 	// - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
+	// - `github.com/ubccr/grendel/internal/api.validateMiddleware.func1`
 	// ---
-	// Add new user.
+	// List deleted nodes in the trash.
 	//
-	// POST /v1/users
-	POSTV1Users(ctx context.Context, request *UserStoreRequest, params POSTV1UsersParams) (*UserStoreResponse, error)
-}
-
-// Client implements OAS client.
-type Client struct {
-	serverURL *url.URL
-	sec       SecuritySource
-	baseClient
-}
-
-// NewClient initializes new Client defined by OAS.
-func NewClient(serverURL string, sec SecuritySource, opts ...ClientOption) (*Client, error) {
-	u, err := url.Parse(serverURL)
-	if err != nil {
-		return nil, err
-	}
-	trimTrailingSlashes(u)
-
-	c, err := newClientConfig(opts...).baseClient()
-	if err != nil {
-		return nil, err
-	}
-	return &Client{
-		serverURL:  u,
-		sec:        sec,
-		baseClient: c,
-	}, nil
-}
-
-type serverURLKey struct{}
-
-// WithServerURL sets context key to override server URL.
-func WithServerURL(ctx context.Context, u *url.URL) context.Context {
-	return context.WithValue(ctx, serverURLKey{}, u)
-}
+	// GET /v1/nodes/trash
+	GETV1NodesTrash(ctx context.Context, params GETV1NodesTrashParams) ([]TrashedHost, error)
+	// GETV1Roles invokes GET_/v1/roles operation.
+	//
+	// #### Controller:
+	// `github.com/ubccr/grendel/internal/api.(*Handler).GetRoles`
+	// #### Middlewares:
+	// - `github.com/go-fuego/fuego.defaultLogger.middleware`
+	// - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
+	// - `github.com/ubccr/grendel/internal/api.validateMiddleware.func1`
+	// ---
+	// Get roles and permissions.
+	//
+	// GET /v1/roles
+	GETV1Roles(ctx context.Context, params GETV1RolesParams) (*GetRolesResponse, error)
+	// GETV1Schedule invokes GET_/v1/schedule operation.
+	//
+	// #### Controller:
+	// `github.com/ubccr/grendel/internal/api.(*Handler).ScheduleList`
+	// #### Middlewares:
+	// - `github.com/go-fuego/fuego.defaultLogger.middleware`
+	// - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
+	// - `github.com/ubccr/grendel/internal/api.validateMiddleware.func1`
+	// ---
+	// List the actions scheduled to run on nodes at a later time.
+	//
+	// GET /v1/schedule
+	GETV1Schedule(ctx context.Context, params GETV1ScheduleParams) ([]ScheduledAction, error)
+	// GETV1ScheduleID invokes GET_/v1/schedule/:id operation.
+	//
+	// #### Controller:
+	// `github.com/ubccr/grendel/internal/api.(*Handler).ScheduleGet`
+	// #### Middlewares:
+	// - `github.com/go-fuego/fuego.defaultLogger.middleware`
+	// - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
+	// - `github.com/ubccr/grendel/internal/api.validateMiddleware.func1`
+	// ---
+	// Get a scheduled action and, once it ran, the result of each node.
+	//
+	// GET /v1/schedule/{id}
+	GETV1ScheduleID(ctx context.Context, params GETV1ScheduleIDParams) (*ScheduledAction, error)
+	// GETV1Secrets invokes GET_/v1/secrets operation.
+	//
+	// #### Controller:
+	// `github.com/ubccr/grendel/internal/api.(*Handler).SecretList`
+	// #### Middlewares:
+	// - `github.com/go-fuego/fuego.defaultLogger.middleware`
+	// - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
+	// - `github.com/ubccr/grendel/internal/api.validateMiddleware.func1`
+	// ---
+	// List the secrets read by provision templates with {{ secret "name" }}. Values are never returned.
+	//
+	// GET /v1/secrets
+	GETV1Secrets(ctx context.Context, params GETV1SecretsParams) ([]Secret, error)
+	// GETV1SwitchNodesetLldp invokes GET_/v1/switch/:nodeset/lldp operation.
+	//
+	// #### Controller:
+	// `github.com/ubccr/grendel/internal/api.(*Handler).SwitchGetLLDP`
+	// #### Middlewares:
+	// - `github.com/go-fuego/fuego.defaultLogger.middleware`
+	// - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
+	// - `github.com/ubccr/grendel/internal/api.validateMiddleware.func1`
+	// ---
+	// Get switch LLDP info.
+	//
+	// GET /v1/switch/{nodeset}/lldp
+	GETV1SwitchNodesetLldp(ctx context.Context, params GETV1SwitchNodesetLldpParams) ([]LLDP, error)
+	// GETV1SwitchNodesetVerify invokes GET_/v1/switch/:nodeset/verify operation.
+	//
+	// #### Controller:
+	// `github.com/ubccr/grendel/internal/api.(*Handler).SwitchVerify`
+	// #### Middlewares:
+	// - `github.com/go-fuego/fuego.defaultLogger.middleware`
+	// - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
+	// - `github.com/ubccr/grendel/internal/api.validateMiddleware.func1`
+	// ---
+	// Compare switch LLDP neighbors with the switch and port stored on node interfaces.
+	//
+	// GET /v1/switch/{nodeset}/verify
+	GETV1SwitchNodesetVerify(ctx context.Context, params GETV1SwitchNodesetVerifyParams) (*SwitchVerifyResponse, error)
+	// GETV1Users invokes GET_/v1/users operation.
+	//
+	// #### Controller:
+	// `github.com/ubccr/grendel/internal/api.(*Handler).UserList`
+	// #### Middlewares:
+	// - `github.com/go-fuego/fuego.defaultLogger.middleware`
+	// - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
+	// - `github.com/ubccr/grendel/internal/api.validateMiddleware.func1`
+	// ---
+	// List all users.
+	//
+	// GET /v1/users
+	GETV1Users(ctx context.Context, params GETV1UsersParams) ([]User, error)
+	// PATCHV1AuthReset invokes PATCH_/v1/auth/reset operation.
+	//
+	// #### Controller:
+	// `github.com/ubccr/grendel/internal/api.(*Handler).AuthReset`
+	// #### Middlewares:
+	// - `github.com/go-fuego/fuego.defaultLogger.middleware`
+	// - `github.com/ubccr/grendel/internal/api.validateMiddleware.func1`
+	// - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
+	// ---
+	// Change password.
+	//
+	// PATCH /v1/auth/reset
+	PATCHV1AuthReset(ctx context.Context, request *AuthResetRequest, params PATCHV1AuthResetParams) (*GenericResponse, error)
+	// PATCHV1NodesFirmware invokes PATCH_/v1/nodes/firmware operation.
+	//
+	// #### Controller:
+	// `github.com/ubccr/grendel/internal/api.(*Handler).NodeFirmware`
+	// #### Middlewares:
+	// - `github.com/go-fuego/fuego.defaultLogger.middleware`
+	// - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
+	// - `github.com/ubccr/grendel/internal/api.validateMiddleware.func1`
+	// ---
+	// Update nodes firmware by nodeset and/or tags. The firmware overrides the firmware of the client
+	// architecture, the firmware.tags and the firmware.images config. An empty firmware clears it.
+	//
+	// PATCH /v1/nodes/firmware
+	PATCHV1NodesFirmware(ctx context.Context, request *NodeFirmwareRequest, params PATCHV1NodesFirmwareParams) (*GenericResponse, error)
+	// PATCHV1NodesImage invokes PATCH_/v1/nodes/image operation.
+	//
+	// #### Controller:
+	// `github.com/ubccr/grendel/internal/api.(*Handler).NodeBootImage`
+	// #### Middlewares:
+	// - `github.com/go-fuego/fuego.defaultLogger.middleware`
+	// - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
+	// - `github.com/ubccr/grendel/internal/api.validateMiddleware.func1`
+	// ---
+	// Update nodes boot image by nodeset and/or tags. With only_if_current the nodes are changed only if
+	// each is assigned one of the images, else 409 Conflict is answered.
+	//
+	// PATCH /v1/nodes/image
+	PATCHV1NodesImage(ctx context.Context, request *NodeBootImageRequest, params PATCHV1NodesImageParams) (*GenericResponse, error)
+	// PATCHV1NodesProvision invokes PATCH_/v1/nodes/provision operation.
+	//
+	// #### Controller:
+	// `github.com/ubccr/grendel/internal/api.(*Handler).NodeProvision`
+	// #### Middlewares:
+	// - `github.com/go-fuego/fuego.defaultLogger.middleware`
+	// - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
+	// - `github.com/ubccr/grendel/internal/api.validateMiddleware.func1`
+	// ---
+	// Provision / Unprovision nodes by nodeset and/or tags.
+	//
+	// PATCH /v1/nodes/provision
+	PATCHV1NodesProvision(ctx context.Context, request *NodeProvisionRequest, params PATCHV1NodesProvisionParams) (*GenericResponse, error)
+	// PATCHV1NodesRename invokes PATCH_/v1/nodes/rename operation.
+	//
+	// #### Controller:
+	// `github.com/ubccr/grendel/internal/api.(*Handler).NodeRename`
+	// #### Middlewares:
+	// - `github.com/go-fuego/fuego.defaultLogger.middleware`
+	// - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
+	// - `github.com/ubccr/grendel/internal/api.validateMiddleware.func1`
+	// ---
+	// Rename a node and optionally rewrite its interface FQDNs.
+	//
+	// PATCH /v1/nodes/rename
+	PATCHV1NodesRename(ctx context.Context, request *NodeRenameRequest, params PATCHV1NodesRenameParams) (*GenericResponse, error)
+	// PATCHV1NodesState invokes PATCH_/v1/nodes/state operation.
+	//
+	// #### Controller:
+	// `github.com/ubccr/grendel/internal/api.(*Handler).NodeState`
+	// #### Middlewares:
+	// - `github.com/go-fuego/fuego.defaultLogger.middleware`
+	// - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
+	// - `github.com/ubccr/grendel/internal/api.validateMiddleware.func1`
+	// ---
+	// Set the lifecycle state of nodes by nodeset and/or tags. Returns 409 if a node may not move to the
+	// state.
+	//
+	// PATCH /v1/nodes/state
+	PATCHV1NodesState(ctx context.Context, request *NodeStateRequest, params PATCHV1NodesStateParams) (*GenericResponse, error)
+	// PATCHV1NodesTagsAction invokes PATCH_/v1/nodes/tags/:action operation.
+	//
+	// #### Controller:
+	// `github.com/ubccr/grendel/internal/api.(*Handler).NodeTags`
+	// #### Middlewares:
+	// - `github.com/go-fuego/fuego.defaultLogger.middleware`
+	// - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
+	// - `github.com/ubccr/grendel/internal/api.validateMiddleware.func1`
+	// ---
+	// Update nodes tags by nodeset and/or tags.
+	//
+	// PATCH /v1/nodes/tags/{action}
+	PATCHV1NodesTagsAction(ctx context.Context, request *NodeTagsRequest, params PATCHV1NodesTagsActionParams) (*GenericResponse, error)
+	// PATCHV1Roles invokes PATCH_/v1/roles operation.
+	//
+	// #### Controller:
+	// `github.com/ubccr/grendel/internal/api.(*Handler).PatchRoles`
+	// #### Middlewares:
+	// - `github.com/go-fuego/fuego.defaultLogger.middleware`
+	// - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
+	// - `github.com/ubccr/grendel/internal/api.validateMiddleware.func1`
+	// ---
+	// Edit role permissions.
+	//
+	// PATCH /v1/roles
+	PATCHV1Roles(ctx context.Context, request *PatchRolesRequest, params PATCHV1RolesParams) (*GenericResponse, error)
+	// PATCHV1UsersUsernamesEnable invokes PATCH_/v1/users/:usernames/enable operation.
+	//
+	// #### Controller:
+	// `github.com/ubccr/grendel/internal/api.(*Handler).UserEnable`
+	// #### Middlewares:
+	// - `github.com/go-fuego/fuego.defaultLogger.middleware`
+	// - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
+	// - `github.com/ubccr/grendel/internal/api.validateMiddleware.func1`
+	// ---
+	// Update users enable.
+	//
+	// PATCH /v1/users/{usernames}/enable
+	PATCHV1UsersUsernamesEnable(ctx context.Context, request *UserEnableRequest, params PATCHV1UsersUsernamesEnableParams) (*GenericResponse, error)
+	// PATCHV1UsersUsernamesRole invokes PATCH_/v1/users/:usernames/role operation.
+	//
+	// #### Controller:
+	// `github.com/ubccr/grendel/internal/api.(*Handler).UserRole`
+	// #### Middlewares:
+	// - `github.com/go-fuego/fuego.defaultLogger.middleware`
+	// - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
+	// - `github.com/ubccr/grendel/internal/api.validateMiddleware.func1`
+	// ---
+	// Update users role.
+	//
+	// PATCH /v1/users/{usernames}/role
+	PATCHV1UsersUsernamesRole(ctx context.Context, request *UserRoleRequest, params PATCHV1UsersUsernamesRoleParams) (*GenericResponse, error)
+	// POSTV1AuthSignin invokes POST_/v1/auth/signin operation.
+	//
+	// #### Controller:
+	// `github.com/ubccr/grendel/internal/api.(*Handler).AuthSignin`
+	// #### Middlewares:
+	// - `github.com/go-fuego/fuego.defaultLogger.middleware`
+	// - `github.com/ubccr/grendel/internal/api.validateMiddleware.func1`
+	// ---
+	// signin user.
+	//
+	// POST /v1/auth/signin
+	POSTV1AuthSignin(ctx context.Context, request *AuthRequest, params POSTV1AuthSigninParams) (*AuthResponse, error)
+	// POSTV1AuthSignup invokes POST_/v1/auth/signup operation.
+	//
+	// #### Controller:
+	// `github.com/ubccr/grendel/internal/api.(*Handler).AuthSignup`
+	// #### Middlewares:
+	// - `github.com/go-fuego/fuego.defaultLogger.middleware`
+	// - `github.com/ubccr/grendel/internal/api.validateMiddleware.func1`
+	// ---
+	// Signup user.
+	//
+	// POST /v1/auth/signup
+	POSTV1AuthSignup(ctx context.Context, request *AuthSignupRequest, params POSTV1AuthSignupParams) (*AuthResponse, error)
+	// POSTV1AuthToken invokes POST_/v1/auth/token operation.
+	//
+	// #### Controller:
+	// `github.com/ubccr/grendel/internal/api.(*Handler).AuthToken`
+	// #### Middlewares:
+	// - `github.com/go-fuego/fuego.defaultLogger.middleware`
+	// - `github.com/ubccr/grendel/internal/api.validateMiddleware.func1`
+	// - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
+	// ---
+	// Create API token.
+	//
+	// POST /v1/auth/token
+	POSTV1AuthToken(ctx context.Context, request *AuthTokenRequest, params POSTV1AuthTokenParams) (*AuthTokenReponse, error)
+	// POSTV1BmcBios invokes POST_/v1/bmc/bios operation.
+	//
+	// #### Controller:
+	// `github.com/ubccr/grendel/internal/api.(*Handler).BmcBiosApply`
+	// #### Middlewares:
+	// - `github.com/go-fuego/fuego.defaultLogger.middleware`
+	// - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
+	// - `github.com/ubccr/grendel/internal/api.validateMiddleware.func1`
+	// ---
+	// Set the BIOS attributes of node(s) which differ from a BIOS profile to apply on the next reboot
+	// and report the nodes requiring a reboot.
+	//
+	// POST /v1/bmc/bios
+	POSTV1BmcBios(ctx context.Context, request *BmcBiosApplyBody, params POSTV1BmcBiosParams) ([]BiosReport, error)
+	// POSTV1BmcBoot invokes POST_/v1/bmc/boot operation.
+	//
+	// #### Controller:
+	// `github.com/ubccr/grendel/internal/api.(*Handler).BmcBootOverride`
+	// #### Middlewares:
+	// - `github.com/go-fuego/fuego.defaultLogger.middleware`
+	// - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
+	// - `github.com/ubccr/grendel/internal/api.validateMiddleware.func1`
+	// ---
+	// Set the redfish boot source override of node(s) for the next boot, or every boot if persistent,
+	// and verify it was applied.
+	//
+	// POST /v1/bmc/boot
+	POSTV1BmcBoot(ctx context.Context, request *BmcBootOverrideBody, params POSTV1BmcBootParams) ([]JobMessage, error)
+	// POSTV1BmcConfigureAuto invokes POST_/v1/bmc/configure/auto operation.
+	//
+	// #### Controller:
+	// `github.com/ubccr/grendel/internal/api.(*Handler).BmcAutoConfigure`
+	// #### Middlewares:
+	// - `github.com/go-fuego/fuego.defaultLogger.middleware`
+	// - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
+	// - `github.com/ubccr/grendel/internal/api.validateMiddleware.func1`
+	// ---
+	// Set BMC to autoconfigure.
+	//
+	// POST /v1/bmc/configure/auto
+	POSTV1BmcConfigureAuto(ctx context.Context, params POSTV1BmcConfigureAutoParams) ([]JobMessage, error)
+	// POSTV1BmcConfigureImport invokes POST_/v1/bmc/configure/import operation.
+	//
+	// #### Controller:
+	// `github.com/ubccr/grendel/internal/api.(*Handler).BmcImportConfiguration`
+	// #### Middlewares:
+	// - `github.com/go-fuego/fuego.defaultLogger.middleware`
+	// - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
+	// - `github.com/ubccr/grendel/internal/api.validateMiddleware.func1`
+	// ---
+	// Manually import system configuration to BMC.
+	//
+	// POST /v1/bmc/configure/import
+	POSTV1BmcConfigureImport(ctx context.Context, request *BmcImportConfigurationRequest, params POSTV1BmcConfigureImportParams) ([]JobMessage, error)
+	// POSTV1BmcFwupdate invokes POST_/v1/bmc/fwupdate operation.
+	//
+	// #### Controller:
+	// `github.com/ubccr/grendel/internal/api.(*Handler).BmcFirmwareUpdate`
+	// #### Middlewares:
+	// - `github.com/go-fuego/fuego.defaultLogger.middleware`
+	// - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
+	// - `github.com/ubccr/grendel/internal/api.validateMiddleware.func1`
+	// ---
+	// Update the firmware of node(s) with the Redfish UpdateService, wait for the update task and verify
+	// the new version. Nodes already on the version are skipped.
+	//
+	// POST /v1/bmc/fwupdate
+	POSTV1BmcFwupdate(ctx context.Context, request *BmcFirmwareUpdateBody, params POSTV1BmcFwupdateParams) ([]FirmwareUpdateReport, error)
+	// POSTV1BmcInventory invokes POST_/v1/bmc/inventory operation.
+	//
+	// #### Controller:
+	// `github.com/ubccr/grendel/internal/api.(*Handler).BmcHardware`
+	// #### Middlewares:
+	// - `github.com/go-fuego/fuego.defaultLogger.middleware`
+	// - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
+	// - `github.com/ubccr/grendel/internal/api.validateMiddleware.func1`
+	// ---
+	// Collect the hardware of node(s) from their BMCs, store it on each node and report the changes from
+	// the previous collection.
+	//
+	// POST /v1/bmc/inventory
+	POSTV1BmcInventory(ctx context.Context, params POSTV1BmcInventoryParams) ([]HardwareReport, error)
+	// POSTV1BmcPowerBmc invokes POST_/v1/bmc/power/bmc operation.
+	//
+	// #### Controller:
+	// `github.com/ubccr/grendel/internal/api.(*Handler).BmcPower`
+	// #### Middlewares:
+	// - `github.com/go-fuego/fuego.defaultLogger.middleware`
+	// - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
+	// - `github.com/ubccr/grendel/internal/api.validateMiddleware.func1`
+	// ---
+	// Reboot node(s) BMC.
+	//
+	// POST /v1/bmc/power/bmc
+	POSTV1BmcPowerBmc(ctx context.Context, params POSTV1BmcPowerBmcParams) ([]JobMessage, error)
+	// POSTV1BmcPowerOs invokes POST_/v1/bmc/power/os operation.
+	//
+	// #### Controller:
+	// `github.com/ubccr/grendel/internal/api.(*Handler).BmcOsPower`
+	// #### Middlewares:
+	// - `github.com/go-fuego/fuego.defaultLogger.middleware`
+	// - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
+	// - `github.com/ubccr/grendel/internal/api.validateMiddleware.func1`
+	// ---
+	// Change power status of node(s).
+	//
+	// POST /v1/bmc/power/os
+	POSTV1BmcPowerOs(ctx context.Context, request *BmcOsPowerBody, params POSTV1BmcPowerOsParams) ([]JobMessage, error)
+	// POSTV1BmcRotatePassword invokes POST_/v1/bmc/rotate-password operation.
+	//
+	// #### Controller:
+	// `github.com/ubccr/grendel/internal/api.(*Handler).BmcRotatePassword`
+	// #### Middlewares:
+	// - `github.com/go-fuego/fuego.defaultLogger.middleware`
+	// - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
+	// - `github.com/ubccr/grendel/internal/api.validateMiddleware.func1`
+	// ---
+	// Set a new random password on the BMC of node(s), verify the BMC accepts it and store it as the BMC
+	// credentials of the node(s). The previous password is restored on failure and nodes flagged
+	// attention may no longer match their stored credentials.
+	//
+	// POST /v1/bmc/rotate-password
+	POSTV1BmcRotatePassword(ctx context.Context, request *BmcRotatePasswordBody, params POSTV1BmcRotatePasswordParams) ([]PasswordRotationReport, error)
+	// POSTV1BmcSubscriptions invokes POST_/v1/bmc/subscriptions operation.
+	//
+	// #### Controller:
+	// `github.com/ubccr/grendel/internal/api.(*Handler).BmcSubscriptionsCreate`
+	// #### Middlewares:
+	// - `github.com/go-fuego/fuego.defaultLogger.middleware`
+	// - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
+	// - `github.com/ubccr/grendel/internal/api.validateMiddleware.func1`
+	// ---
+	// Subscribe the BMC of node(s) to Redfish events pushed to the event receiver under bmc.event_url
+	// and tag them bmc-events. Stale subscriptions to the receiver are replaced.
+	//
+	// POST /v1/bmc/subscriptions
+	POSTV1BmcSubscriptions(ctx context.Context, params POSTV1BmcSubscriptionsParams) ([]EventSubscription, error)
+	// POSTV1BmcUpgradeDellInstallfromrepo invokes POST_/v1/bmc/upgrade/dell/installfromrepo operation.
+	//
+	// #### Controller:
+	// `github.com/ubccr/grendel/internal/api.(*Handler).BmcDellInstallFromRepo`
+	// #### Middlewares:
+	// - `github.com/go-fuego/fuego.defaultLogger.middleware`
+	// - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
+	// - `github.com/ubccr/grendel/internal/api.validateMiddleware.func1`
+	// ---
+	// Request iDRAC to download the latest firmware catalog and compare firmware versions.
+	//
+	// POST /v1/bmc/upgrade/dell/installfromrepo
+	POSTV1BmcUpgradeDellInstallfromrepo(ctx context.Context, request *BmcDellInstallFromRepoRequest, params POSTV1BmcUpgradeDellInstallfromrepoParams) ([]JobMessage, error)
+	// POSTV1BmcVmedia invokes POST_/v1/bmc/vmedia operation.
+	//
+	// #### Controller:
+	// `github.com/ubccr/grendel/internal/api.(*Handler).BmcVirtualMediaMount`
+	// #### Middlewares:
+	// - `github.com/go-fuego/fuego.defaultLogger.middleware`
+	// - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
+	// - `github.com/ubccr/grendel/internal/api.validateMiddleware.func1`
+	// ---
+	// Insert an ISO image in the virtual CD of node(s), optionally boot from it and power cycle.
+	//
+	// POST /v1/bmc/vmedia
+	POSTV1BmcVmedia(ctx context.Context, request *BmcVirtualMediaBody, params POSTV1BmcVmediaParams) ([]JobMessage, error)
+	// POSTV1CertsRevoke invokes POST_/v1/certs/revoke operation.
+	//
+	// #### Controller:
+	// `github.com/ubccr/grendel/internal/api.(*Handler).CertRevoke`
+	// #### Middlewares:
+	// - `github.com/go-fuego/fuego.defaultLogger.middleware`
+	// - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
+	// - `github.com/ubccr/grendel/internal/api.validateMiddleware.func1`
+	// ---
+	// Revoke certificates issued by the Grendel CA by serial number or the client certificates of nodes
+	// by nodeset. Revoked client certificates are rejected by the provision server and listed in the CRL
+	// generated by grendel certs crl.
+	//
+	// POST /v1/certs/revoke
+	POSTV1CertsRevoke(ctx context.Context, request *CertRevokeRequest, params POSTV1CertsRevokeParams) (*GenericResponse, error)
+	// POSTV1DNSRecords invokes POST_/v1/dns/records operation.
+	//
+	// #### Controller:
+	// `github.com/ubccr/grendel/internal/api.(*Handler).DNSRecordAdd`
+	// #### Middlewares:
+	// - `github.com/go-fuego/fuego.defaultLogger.middleware`
+	// - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
+	// - `github.com/ubccr/grendel/internal/api.validateMiddleware.func1`
+	// ---
+	// Add DNS only records. Records conflicting with node interfaces or other records are rejected.
+	//
+	// POST /v1/dns/records
+	POSTV1DNSRecords(ctx context.Context, request *DNSRecordAddRequest, params POSTV1DNSRecordsParams) (*GenericResponse, error)
+	// POSTV1DbLoad invokes POST_/v1/db/load operation.
+	//
+	// #### Controller:
+	// `github.com/ubccr/grendel/internal/api.(*Handler).Load`
+	// #### Middlewares:
+	// - `github.com/go-fuego/fuego.defaultLogger.middleware`
+	// - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
+	// - `github.com/ubccr/grendel/internal/api.validateMiddleware.func1`
+	// ---
+	// Make the hosts, images and DNS records in the DB match a dump.
+	//
+	// POST /v1/db/load
+	POSTV1DbLoad(ctx context.Context, request *DataLoadRequest, params POSTV1DbLoadParams) (*DataLoadResponse, error)
+	// POSTV1DbReindex invokes POST_/v1/db/reindex operation.
+	//
+	// #### Controller:
+	// `github.com/ubccr/grendel/internal/api.(*Handler).Reindex`
+	// #### Middlewares:
+	// - `github.com/go-fuego/fuego.defaultLogger.middleware`
+	// - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
+	// - `github.com/ubccr/grendel/internal/api.validateMiddleware.func1`
+	// ---
+	// Rebuild the DB indexes used for host lookups.
+	//
+	// POST /v1/db/reindex
+	POSTV1DbReindex(ctx context.Context, params POSTV1DbReindexParams) (*GenericResponse, error)
+	// POSTV1DbRestore invokes POST_/v1/db/restore operation.
+	//
+	// #### Controller:
+	// `github.com/ubccr/grendel/internal/api.(*Handler).Restore`
+	// #### Middlewares:
+	// - `github.com/go-fuego/fuego.defaultLogger.middleware`
+	// - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
+	// - `github.com/ubccr/grendel/internal/api.validateMiddleware.func1`
+	// ---
+	// Restore a backup of the DB.
+	//
+	// POST /v1/db/restore
+	POSTV1DbRestore(ctx context.Context, request *DataDump, params POSTV1DbRestoreParams) (*GenericResponse, error)
+	// POSTV1DiscoverBmcAdopt invokes POST_/v1/discover/bmc/adopt operation.
+	//
+	// #### Controller:
+	// `github.com/ubccr/grendel/internal/api.(*Handler).DiscoverBMCAdopt`
+	// #### Middlewares:
+	// - `github.com/go-fuego/fuego.defaultLogger.middleware`
+	// - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
+	// - `github.com/ubccr/grendel/internal/api.validateMiddleware.func1`
+	// ---
+	// Attach a pending BMC as the BMC interface of a node.
+	//
+	// POST /v1/discover/bmc/adopt
+	POSTV1DiscoverBmcAdopt(ctx context.Context, request *DiscoverBMCAdoptRequest, params POSTV1DiscoverBmcAdoptParams) (*GenericResponse, error)
+	// POSTV1GrendelKeysRotate invokes POST_/v1/grendel/keys/rotate operation.
+	//
+	// #### Controller:
+	// `github.com/ubccr/grendel/internal/api.(*Handler).GrendelKeysRotate`
+	// #### Middlewares:
+	// - `github.com/go-fuego/fuego.defaultLogger.middleware`
+	// - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
+	// - `github.com/ubccr/grendel/internal/api.validateMiddleware.func1`
+	// ---
+	// Generate new primary keys signing boot and API tokens. The previous keys keep verifying tokens for
+	// the grace period, then retire.
+	//
+	// POST /v1/grendel/keys/rotate
+	POSTV1GrendelKeysRotate(ctx context.Context, request *SigningKeyRotateRequest, params POSTV1GrendelKeysRotateParams) ([]SigningKey, error)
+	// POSTV1GrendelReload invokes POST_/v1/grendel/reload operation.
+	//
+	// #### Controller:
+	// `github.com/ubccr/grendel/internal/api.(*Handler).GrendelReload`
+	// #### Middlewares:
+	// - `github.com/go-fuego/fuego.defaultLogger.middleware`
+	// - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
+	// - `github.com/ubccr/grendel/internal/api.validateMiddleware.func1`
+	// ---
+	// Reload the configuration file. Settings such as listen addresses only change after a restart.
+	//
+	// POST /v1/grendel/reload
+	POSTV1GrendelReload(ctx context.Context, params POSTV1GrendelReloadParams) (*ReloadResponse, error)
+	// POSTV1Images invokes POST_/v1/images operation.
+	//
+	// #### Controller:
+	// `github.com/ubccr/grendel/internal/api.(*Handler).BootImageAdd`
+	// #### Middlewares:
+	// - `github.com/go-fuego/fuego.defaultLogger.middleware`
+	// - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
+	// - `github.com/ubccr/grendel/internal/api.validateMiddleware.func1`
+	// ---
+	// Add images.
+	//
+	// POST /v1/images
+	POSTV1Images(ctx context.Context, request *BootImageAddRequest, params POSTV1ImagesParams) (*GenericResponse, error)
+	// POSTV1ImagesGc invokes POST_/v1/images/gc operation.
+	//
+	// #### Controller:
+	// `github.com/ubccr/grendel/internal/api.(*Handler).BootImageGC`
+	// #### Middlewares:
+	// - `github.com/go-fuego/fuego.defaultLogger.middleware`
+	// - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
+	// - `github.com/ubccr/grendel/internal/api.validateMiddleware.func1`
+	// ---
+	// List the files of image_dirs used neither by an image nor by a version of one replaced within
+	// image_retention. With delete the files are removed, refused with 409 while the provision or TFTP
+	// server is sending a file.
+	//
+	// POST /v1/images/gc
+	POSTV1ImagesGc(ctx context.Context, params POSTV1ImagesGcParams) (*ImageGCResult, error)
+	// POSTV1Nodes invokes POST_/v1/nodes operation.
+	//
+	// #### Controller:
+	// `github.com/ubccr/grendel/internal/api.(*Handler).NodeAdd`
+	// #### Middlewares:
+	// - `github.com/go-fuego/fuego.defaultLogger.middleware`
+	// - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
+	// - `github.com/ubccr/grendel/internal/api.validateMiddleware.func1`
+	// ---
+	// Add nodes.
+	//
+	// POST /v1/nodes
+	POSTV1Nodes(ctx context.Context, request *NodeAddRequest, params POSTV1NodesParams) (*GenericResponse, error)
+	// POSTV1NodesNextip invokes POST_/v1/nodes/nextip operation.
+	//
+	// #### Controller:
+	// `github.com/ubccr/grendel/internal/api.(*Handler).NodeNextIP`
+	// #### Middlewares:
+	// - `github.com/go-fuego/fuego.defaultLogger.middleware`
+	// - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
+	// - `github.com/ubccr/grendel/internal/api.validateMiddleware.func1`
+	// ---
+	// Return the lowest free addresses of a subnet, skipping the addresses of nodes, nodes in the trash
+	// and DNS records, the network, broadcast and gateway addresses, unexpired reservations and DHCP
+	// conflicts. With reserve the addresses are reserved for the duration.
+	//
+	// POST /v1/nodes/nextip
+	POSTV1NodesNextip(ctx context.Context, request *NodeNextIPRequest, params POSTV1NodesNextipParams) (*NodeNextIPResponse, error)
+	// POSTV1NodesTokenInspect invokes POST_/v1/nodes/token/inspect operation.
+	//
+	// #### Controller:
+	// `github.com/ubccr/grendel/internal/api.(*Handler).NodeTokenInspect`
+	// #### Middlewares:
+	// - `github.com/go-fuego/fuego.defaultLogger.middleware`
+	// - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
+	// - `github.com/ubccr/grendel/internal/api.validateMiddleware.func1`
+	// ---
+	// Decode a boot token showing the host, MAC, issue and expiry times.
+	//
+	// POST /v1/nodes/token/inspect
+	POSTV1NodesTokenInspect(ctx context.Context, request *NodeTokenRequest, params POSTV1NodesTokenInspectParams) (*BootTokenInfo, error)
+	// POSTV1NodesTokenRevoke invokes POST_/v1/nodes/token/revoke operation.
+	//
+	// #### Controller:
+	// `github.com/ubccr/grendel/internal/api.(*Handler).NodeTokenRevoke`
+	// #### Middlewares:
+	// - `github.com/go-fuego/fuego.defaultLogger.middleware`
+	// - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
+	// - `github.com/ubccr/grendel/internal/api.validateMiddleware.func1`
+	// ---
+	// Revoke a boot token. Revoked tokens are rejected by the provision server until they expire.
+	//
+	// POST /v1/nodes/token/revoke
+	POSTV1NodesTokenRevoke(ctx context.Context, request *NodeTokenRequest, params POSTV1NodesTokenRevokeParams) (*GenericResponse, error)
+	// POSTV1NodesTrashRestore invokes POST_/v1/nodes/trash/restore operation.
+	//
+	// #### Controller:
+	// `github.com/ubccr/grendel/internal/api.(*Handler).NodeTrashRestore`
+	// #### Middlewares:
+	// - `github.com/go-fuego/fuego.defaultLogger.middleware`
+	// - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
+	// - `github.com/ubccr/grendel/internal/api.validateMiddleware.func1`
+	// ---
+	// Restore deleted nodes from the trash by nodeset.
+	//
+	// POST /v1/nodes/trash/restore
+	POSTV1NodesTrashRestore(ctx context.Context, params POSTV1NodesTrashRestoreParams) (*GenericResponse, error)
+	// POSTV1Roles invokes POST_/v1/roles operation.
+	//
+	// #### Controller:
+	// `github.com/ubccr/grendel/internal/api.(*Handler).PostRoles`
+	// #### Middlewares:
+	// - `github.com/go-fuego/fuego.defaultLogger.middleware`
+	// - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
+	// - `github.com/ubccr/grendel/internal/api.validateMiddleware.func1`
+	// ---
+	// Add roles.
+	//
+	// POST /v1/roles
+	POSTV1Roles(ctx context.Context, request *PostRolesRequest, params POSTV1RolesParams) (*GenericResponse, error)
+	// POSTV1Schedule invokes POST_/v1/schedule operation.
+	//
+	// #### Controller:
+	// `github.com/ubccr/grendel/internal/api.(*Handler).ScheduleAdd`
+	// #### Middlewares:
+	// - `github.com/go-fuego/fuego.defaultLogger.middleware`
+	// - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
+	// - `github.com/ubccr/grendel/internal/api.validateMiddleware.func1`
+	// ---
+	// Schedule an action to run on nodes at a later time: set the boot image, set provision, power cycle
+	// through the BMC or send the schedule.run webhook event. Actions due while grendel serve was down
+	// run on startup unless due for longer than schedule.grace_period.
+	//
+	// POST /v1/schedule
+	POSTV1Schedule(ctx context.Context, request *ScheduleRequest, params POSTV1ScheduleParams) (*ScheduledAction, error)
+	// POSTV1SwitchNodesetScan invokes POST_/v1/switch/:nodeset/scan operation.
+	//
+	// #### Controller:
+	// `github.com/ubccr/grendel/internal/api.(*Handler).SwitchScan`
+	// #### Middlewares:
+	// - `github.com/go-fuego/fuego.defaultLogger.middleware`
+	// - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
+	// - `github.com/ubccr/grendel/internal/api.validateMiddleware.func1`
+	// ---
+	// Scan switch MAC address tables and store the switch and port of matching node interfaces.
+	//
+	// POST /v1/switch/{nodeset}/scan
+	POSTV1SwitchNodesetScan(ctx context.Context, params POSTV1SwitchNodesetScanParams) (*SwitchScanResponse, error)
+	// POSTV1SwitchNodesetVerify invokes POST_/v1/switch/:nodeset/verify operation.
+	//
+	// #### Controller:
+	// `github.com/ubccr/grendel/internal/api.(*Handler).SwitchVerifyUpdate`
+	// #### Middlewares:
+	// - `github.com/go-fuego/fuego.defaultLogger.middleware`
+	// - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
+	// - `github.com/ubccr/grendel/internal/api.validateMiddleware.func1`
+	// ---
+	// Compare switch LLDP neighbors with the switch and port stored on node interfaces and store the
+	// observed switch and port of mismatched or unmapped interfaces.
+	//
+	// POST /v1/switch/{nodeset}/verify
+	POSTV1SwitchNodesetVerify(ctx context.Context, params POSTV1SwitchNodesetVerifyParams) (*SwitchVerifyResponse, error)
+	// POSTV1Users invokes POST_/v1/users operation.
+	//
+	// #### Controller:
+	// `github.com/ubccr/grendel/internal/api.(*Handler).UserStore`
+	// #### Middlewares:
+	// - `github.com/go-fuego/fuego.defaultLogger.middleware`
+	// - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
+	// - `github.com/ubccr/grendel/internal/api.validateMiddleware.func1`
+	// ---
+	// Add new user.
+	//
+	// POST /v1/users
+	POSTV1Users(ctx context.Context, request *UserStoreRequest, params POSTV1UsersParams) (*UserStoreResponse, error)
+	// PUTV1GrendelMaintenance invokes PUT_/v1/grendel/maintenance operation.
+	//
+	// #### Controller:
+	// `github.com/ubccr/grendel/internal/api.(*Handler).GrendelMaintenanceSet`
+	// #### Middlewares:
+	// - `github.com/go-fuego/fuego.defaultLogger.middleware`
+	// - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
+	// - `github.com/ubccr/grendel/internal/api.validateMiddleware.func1`
+	// ---
+	// Turn maintenance mode on or off. While on DHCP leaves out the boot file and zero touch
+	// provisioning options and the provision endpoints return 503, static address assignment and DNS
+	// continue normally.
+	//
+	// PUT /v1/grendel/maintenance
+	PUTV1GrendelMaintenance(ctx context.Context, request *MaintenanceRequest, params PUTV1GrendelMaintenanceParams) (*Maintenance, error)
+	// PUTV1NodesClientCert invokes PUT_/v1/nodes/client-cert operation.
+	//
+	// #### Controller:
+	// `github.com/ubccr/grendel/internal/api.(*Handler).NodeClientCertSet`
+	// #### Middlewares:
+	// - `github.com/go-fuego/fuego.defaultLogger.middleware`
+	// - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
+	// - `github.com/ubccr/grendel/internal/api.validateMiddleware.func1`
+	// ---
+	// Store the SHA-256 fingerprints of the client certificates of nodes, checked by the provision
+	// server against the client certificates nodes present. Keys sent along are held in memory until the
+	// node fetches them once from /boot/{token}/client-cert of the provision server or the ttl passes.
+	//
+	// PUT /v1/nodes/client-cert
+	PUTV1NodesClientCert(ctx context.Context, request *NodeClientCertRequest, params PUTV1NodesClientCertParams) (*GenericResponse, error)
+	// PUTV1NodesCredentials invokes PUT_/v1/nodes/credentials operation.
+	//
+	// #### Controller:
+	// `github.com/ubccr/grendel/internal/api.(*Handler).NodeCredentialSet`
+	// #### Middlewares:
+	// - `github.com/go-fuego/fuego.defaultLogger.middleware`
+	// - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
+	// - `github.com/ubccr/grendel/internal/api.validateMiddleware.func1`
+	// ---
+	// Set the encrypted credentials of nodes by nodeset and/or tags.
+	//
+	// PUT /v1/nodes/credentials
+	PUTV1NodesCredentials(ctx context.Context, request *NodeCredentialsRequest, params PUTV1NodesCredentialsParams) (*GenericResponse, error)
+	// PUTV1NodesFiles invokes PUT_/v1/nodes/files operation.
+	//
+	// #### Controller:
+	// `github.com/ubccr/grendel/internal/api.(*Handler).NodeFileSet`
+	// #### Middlewares:
+	// - `github.com/go-fuego/fuego.defaultLogger.middleware`
+	// - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
+	// - `github.com/ubccr/grendel/internal/api.validateMiddleware.func1`
+	// ---
+	// Attach a file to nodes by nodeset and/or tags, served to the nodes while provisioning at
+	// /boot/<token>/extra/<name>. Files are limited to 512 KiB.
+	//
+	// PUT /v1/nodes/files
+	PUTV1NodesFiles(ctx context.Context, request *NodeFileRequest, params PUTV1NodesFilesParams) (*GenericResponse, error)
+	// PUTV1SecretsName invokes PUT_/v1/secrets/:name operation.
+	//
+	// #### Controller:
+	// `github.com/ubccr/grendel/internal/api.(*Handler).SecretSet`
+	// #### Middlewares:
+	// - `github.com/go-fuego/fuego.defaultLogger.middleware`
+	// - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
+	// - `github.com/ubccr/grendel/internal/api.validateMiddleware.func1`
+	// ---
+	// Set a secret, encrypted with the credentials key. A one-time secret is rendered once per boot
+	// token, later requests made with the token get a placeholder.
+	//
+	// PUT /v1/secrets/{name}
+	PUTV1SecretsName(ctx context.Context, request *SecretRequest, params PUTV1SecretsNameParams) (*GenericResponse, error)
+}
+
+// Client implements OAS client.
+type Client struct {
+	serverURL *url.URL
+	sec       SecuritySource
+	baseClient
+}
+
+// NewClient initializes new Client defined by OAS.
+func NewClient(serverURL string, sec SecuritySource, opts ...ClientOption) (*Client, error) {
+	u, err := url.Parse(serverURL)
+	if err != nil {
+		return nil, err
+	}
+	trimTrailingSlashes(u)
+
+	c, err := newClientConfig(opts...).baseClient()
+	if err != nil {
+		return nil, err
+	}
+	return &Client{
+		serverURL:  u,
+		sec:        sec,
+		baseClient: c,
+	}, nil
+}
+
+type serverURLKey struct{}
+
+// WithServerURL sets context key to override server URL.
+func WithServerURL(ctx context.Context, u *url.URL) context.Context {
+	return context.WithValue(ctx, serverURLKey{}, u)
+}
+
+func (c *Client) requestURL(ctx context.Context) *url.URL {
+	u, ok := ctx.Value(serverURLKey{}).(*url.URL)
+	if !ok {
+		return c.serverURL
+	}
+	return u
+}
+
+// DELETEV1AuthSignout invokes DELETE_/v1/auth/signout operation.
+//
+// #### Controller:
+// `github.com/ubccr/grendel/internal/api.(*Handler).AuthSignout`
+// #### Middlewares:
+// - `github.com/go-fuego/fuego.defaultLogger.middleware`
+// - `github.com/ubccr/grendel/internal/api.validateMiddleware.func1`
+// ---
+// Signout user.
+//
+// DELETE /v1/auth/signout
+func (c *Client) DELETEV1AuthSignout(ctx context.Context, params DELETEV1AuthSignoutParams) (*GenericResponse, error) {
+	res, err := c.sendDELETEV1AuthSignout(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendDELETEV1AuthSignout(ctx context.Context, params DELETEV1AuthSignoutParams) (res *GenericResponse, err error) {
+
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/v1/auth/signout"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	r, err := ht.NewRequest(ctx, "DELETE", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	h := uri.NewHeaderEncoder(r.Header)
+	{
+		cfg := uri.HeaderParameterEncodingConfig{
+			Name:    "Accept",
+			Explode: false,
+		}
+		if err := h.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Accept.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode header")
+		}
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+
+			switch err := c.securityHeaderAuth(ctx, DELETEV1AuthSignoutOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"HeaderAuth\"")
+			}
+		}
+		{
+
+			switch err := c.securityCookieAuth(ctx, DELETEV1AuthSignoutOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 1
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"CookieAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+				{},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	result, err := decodeDELETEV1AuthSignoutResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// DELETEV1BmcJobs invokes DELETE_/v1/bmc/jobs operation.
+//
+// #### Controller:
+// `github.com/ubccr/grendel/internal/api.(*Handler).BmcJobDeleteMany`
+// #### Middlewares:
+// - `github.com/go-fuego/fuego.defaultLogger.middleware`
+// - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
+// - `github.com/ubccr/grendel/internal/api.validateMiddleware.func1`
+// ---
+// Delete redfish jobs from many node(s).
+//
+// DELETE /v1/bmc/jobs
+func (c *Client) DELETEV1BmcJobs(ctx context.Context, request *BmcJobDeleteRequest, params DELETEV1BmcJobsParams) ([]JobMessage, error) {
+	res, err := c.sendDELETEV1BmcJobs(ctx, request, params)
+	return res, err
+}
+
+func (c *Client) sendDELETEV1BmcJobs(ctx context.Context, request *BmcJobDeleteRequest, params DELETEV1BmcJobsParams) (res []JobMessage, err error) {
+	// Validate request before sending.
+	if err := func() error {
+		if err := request.Validate(); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return res, errors.Wrap(err, "validate")
+	}
+
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/v1/bmc/jobs"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "nodeset" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "nodeset",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Nodeset.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "tags" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "tags",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Tags.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
+	r, err := ht.NewRequest(ctx, "DELETE", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+	if err := encodeDELETEV1BmcJobsRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	h := uri.NewHeaderEncoder(r.Header)
+	{
+		cfg := uri.HeaderParameterEncodingConfig{
+			Name:    "Accept",
+			Explode: false,
+		}
+		if err := h.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Accept.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode header")
+		}
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+
+			switch err := c.securityHeaderAuth(ctx, DELETEV1BmcJobsOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"HeaderAuth\"")
+			}
+		}
+		{
+
+			switch err := c.securityCookieAuth(ctx, DELETEV1BmcJobsOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 1
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"CookieAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	result, err := decodeDELETEV1BmcJobsResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// DELETEV1BmcJobsJids invokes DELETE_/v1/bmc/jobs/:jids operation.
+//
+// #### Controller:
+// `github.com/ubccr/grendel/internal/api.(*Handler).BmcJobDelete`
+// #### Middlewares:
+// - `github.com/go-fuego/fuego.defaultLogger.middleware`
+// - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
+// - `github.com/ubccr/grendel/internal/api.validateMiddleware.func1`
+// ---
+// Delete redfish jobs from node(s) by JID.
+//
+// DELETE /v1/bmc/jobs/{jids}
+func (c *Client) DELETEV1BmcJobsJids(ctx context.Context, params DELETEV1BmcJobsJidsParams) ([]JobMessage, error) {
+	res, err := c.sendDELETEV1BmcJobsJids(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendDELETEV1BmcJobsJids(ctx context.Context, params DELETEV1BmcJobsJidsParams) (res []JobMessage, err error) {
+
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [2]string
+	pathParts[0] = "/v1/bmc/jobs/"
+	{
+		// Encode "jids" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "jids",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.Jids))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	uri.AddPathParts(u, pathParts[:]...)
+
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "nodeset" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "nodeset",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Nodeset.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "tags" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "tags",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Tags.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
+	r, err := ht.NewRequest(ctx, "DELETE", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	h := uri.NewHeaderEncoder(r.Header)
+	{
+		cfg := uri.HeaderParameterEncodingConfig{
+			Name:    "Accept",
+			Explode: false,
+		}
+		if err := h.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Accept.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode header")
+		}
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+
+			switch err := c.securityHeaderAuth(ctx, DELETEV1BmcJobsJidsOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"HeaderAuth\"")
+			}
+		}
+		{
+
+			switch err := c.securityCookieAuth(ctx, DELETEV1BmcJobsJidsOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 1
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"CookieAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	result, err := decodeDELETEV1BmcJobsJidsResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// DELETEV1BmcSel invokes DELETE_/v1/bmc/sel operation.
+//
+// #### Controller:
+// `github.com/ubccr/grendel/internal/api.(*Handler).BmcSelClear`
+// #### Middlewares:
+// - `github.com/go-fuego/fuego.defaultLogger.middleware`
+// - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
+// - `github.com/ubccr/grendel/internal/api.validateMiddleware.func1`
+// ---
+// Clear system event log on node(s).
+//
+// DELETE /v1/bmc/sel
+func (c *Client) DELETEV1BmcSel(ctx context.Context, params DELETEV1BmcSelParams) ([]JobMessage, error) {
+	res, err := c.sendDELETEV1BmcSel(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendDELETEV1BmcSel(ctx context.Context, params DELETEV1BmcSelParams) (res []JobMessage, err error) {
+
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/v1/bmc/sel"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "nodeset" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "nodeset",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Nodeset.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "tags" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "tags",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Tags.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
+	r, err := ht.NewRequest(ctx, "DELETE", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	h := uri.NewHeaderEncoder(r.Header)
+	{
+		cfg := uri.HeaderParameterEncodingConfig{
+			Name:    "Accept",
+			Explode: false,
+		}
+		if err := h.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Accept.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode header")
+		}
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+
+			switch err := c.securityHeaderAuth(ctx, DELETEV1BmcSelOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"HeaderAuth\"")
+			}
+		}
+		{
+
+			switch err := c.securityCookieAuth(ctx, DELETEV1BmcSelOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 1
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"CookieAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	result, err := decodeDELETEV1BmcSelResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// DELETEV1BmcVmedia invokes DELETE_/v1/bmc/vmedia operation.
+//
+// #### Controller:
+// `github.com/ubccr/grendel/internal/api.(*Handler).BmcVirtualMediaUnmount`
+// #### Middlewares:
+// - `github.com/go-fuego/fuego.defaultLogger.middleware`
+// - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
+// - `github.com/ubccr/grendel/internal/api.validateMiddleware.func1`
+// ---
+// Eject the virtual CD of node(s).
+//
+// DELETE /v1/bmc/vmedia
+func (c *Client) DELETEV1BmcVmedia(ctx context.Context, params DELETEV1BmcVmediaParams) ([]JobMessage, error) {
+	res, err := c.sendDELETEV1BmcVmedia(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendDELETEV1BmcVmedia(ctx context.Context, params DELETEV1BmcVmediaParams) (res []JobMessage, err error) {
+
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/v1/bmc/vmedia"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "nodeset" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "nodeset",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Nodeset.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "tags" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "tags",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Tags.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "fanout" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "fanout",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Fanout.Get(); ok {
+				return e.EncodeValue(conv.IntToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "timeout" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "timeout",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Timeout.Get(); ok {
+				return e.EncodeValue(conv.IntToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
+	r, err := ht.NewRequest(ctx, "DELETE", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	h := uri.NewHeaderEncoder(r.Header)
+	{
+		cfg := uri.HeaderParameterEncodingConfig{
+			Name:    "Accept",
+			Explode: false,
+		}
+		if err := h.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Accept.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode header")
+		}
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+
+			switch err := c.securityHeaderAuth(ctx, DELETEV1BmcVmediaOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"HeaderAuth\"")
+			}
+		}
+		{
+
+			switch err := c.securityCookieAuth(ctx, DELETEV1BmcVmediaOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 1
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"CookieAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	result, err := decodeDELETEV1BmcVmediaResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// DELETEV1DNSRecords invokes DELETE_/v1/dns/records operation.
+//
+// #### Controller:
+// `github.com/ubccr/grendel/internal/api.(*Handler).DNSRecordDelete`
+// #### Middlewares:
+// - `github.com/go-fuego/fuego.defaultLogger.middleware`
+// - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
+// - `github.com/ubccr/grendel/internal/api.validateMiddleware.func1`
+// ---
+// Delete DNS only records by name.
+//
+// DELETE /v1/dns/records
+func (c *Client) DELETEV1DNSRecords(ctx context.Context, params DELETEV1DNSRecordsParams) (*GenericResponse, error) {
+	res, err := c.sendDELETEV1DNSRecords(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendDELETEV1DNSRecords(ctx context.Context, params DELETEV1DNSRecordsParams) (res *GenericResponse, err error) {
+
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/v1/dns/records"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "names" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "names",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Names.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
+	r, err := ht.NewRequest(ctx, "DELETE", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	h := uri.NewHeaderEncoder(r.Header)
+	{
+		cfg := uri.HeaderParameterEncodingConfig{
+			Name:    "Accept",
+			Explode: false,
+		}
+		if err := h.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Accept.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode header")
+		}
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+
+			switch err := c.securityHeaderAuth(ctx, DELETEV1DNSRecordsOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"HeaderAuth\"")
+			}
+		}
+		{
+
+			switch err := c.securityCookieAuth(ctx, DELETEV1DNSRecordsOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 1
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"CookieAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	result, err := decodeDELETEV1DNSRecordsResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// DELETEV1DiscoverBmc invokes DELETE_/v1/discover/bmc operation.
+//
+// #### Controller:
+// `github.com/ubccr/grendel/internal/api.(*Handler).DiscoverBMCDelete`
+// #### Middlewares:
+// - `github.com/go-fuego/fuego.defaultLogger.middleware`
+// - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
+// - `github.com/ubccr/grendel/internal/api.validateMiddleware.func1`
+// ---
+// Delete a pending BMC. It is recorded again if it keeps requesting an address.
+//
+// DELETE /v1/discover/bmc
+func (c *Client) DELETEV1DiscoverBmc(ctx context.Context, params DELETEV1DiscoverBmcParams) (*GenericResponse, error) {
+	res, err := c.sendDELETEV1DiscoverBmc(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendDELETEV1DiscoverBmc(ctx context.Context, params DELETEV1DiscoverBmcParams) (res *GenericResponse, err error) {
+
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/v1/discover/bmc"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "mac" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "mac",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			return e.EncodeValue(conv.StringToString(params.MAC))
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
+	r, err := ht.NewRequest(ctx, "DELETE", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	h := uri.NewHeaderEncoder(r.Header)
+	{
+		cfg := uri.HeaderParameterEncodingConfig{
+			Name:    "Accept",
+			Explode: false,
+		}
+		if err := h.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Accept.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode header")
+		}
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+
+			switch err := c.securityHeaderAuth(ctx, DELETEV1DiscoverBmcOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"HeaderAuth\"")
+			}
+		}
+		{
+
+			switch err := c.securityCookieAuth(ctx, DELETEV1DiscoverBmcOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 1
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"CookieAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	result, err := decodeDELETEV1DiscoverBmcResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// DELETEV1Images invokes DELETE_/v1/images operation.
+//
+// #### Controller:
+// `github.com/ubccr/grendel/internal/api.(*Handler).BootImageDelete`
+// #### Middlewares:
+// - `github.com/go-fuego/fuego.defaultLogger.middleware`
+// - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
+// - `github.com/ubccr/grendel/internal/api.validateMiddleware.func1`
+// ---
+// Delete images by name.
+//
+// DELETE /v1/images
+func (c *Client) DELETEV1Images(ctx context.Context, params DELETEV1ImagesParams) (*GenericResponse, error) {
+	res, err := c.sendDELETEV1Images(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendDELETEV1Images(ctx context.Context, params DELETEV1ImagesParams) (res *GenericResponse, err error) {
+
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/v1/images"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "names" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "names",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Names.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
+	r, err := ht.NewRequest(ctx, "DELETE", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	h := uri.NewHeaderEncoder(r.Header)
+	{
+		cfg := uri.HeaderParameterEncodingConfig{
+			Name:    "Accept",
+			Explode: false,
+		}
+		if err := h.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Accept.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode header")
+		}
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+
+			switch err := c.securityHeaderAuth(ctx, DELETEV1ImagesOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"HeaderAuth\"")
+			}
+		}
+		{
+
+			switch err := c.securityCookieAuth(ctx, DELETEV1ImagesOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 1
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"CookieAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	result, err := decodeDELETEV1ImagesResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// DELETEV1Nodes invokes DELETE_/v1/nodes operation.
+//
+// #### Controller:
+// `github.com/ubccr/grendel/internal/api.(*Handler).NodeDelete`
+// #### Middlewares:
+// - `github.com/go-fuego/fuego.defaultLogger.middleware`
+// - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
+// - `github.com/ubccr/grendel/internal/api.validateMiddleware.func1`
+// ---
+// Delete nodes by nodeset and/or tags.
+//
+// DELETE /v1/nodes
+func (c *Client) DELETEV1Nodes(ctx context.Context, params DELETEV1NodesParams) (*GenericResponse, error) {
+	res, err := c.sendDELETEV1Nodes(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendDELETEV1Nodes(ctx context.Context, params DELETEV1NodesParams) (res *GenericResponse, err error) {
+
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/v1/nodes"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "nodeset" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "nodeset",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Nodeset.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "tags" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "tags",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Tags.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
+	r, err := ht.NewRequest(ctx, "DELETE", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	h := uri.NewHeaderEncoder(r.Header)
+	{
+		cfg := uri.HeaderParameterEncodingConfig{
+			Name:    "Accept",
+			Explode: false,
+		}
+		if err := h.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Accept.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode header")
+		}
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+
+			switch err := c.securityHeaderAuth(ctx, DELETEV1NodesOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"HeaderAuth\"")
+			}
+		}
+		{
+
+			switch err := c.securityCookieAuth(ctx, DELETEV1NodesOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 1
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"CookieAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	result, err := decodeDELETEV1NodesResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// DELETEV1NodesCredentials invokes DELETE_/v1/nodes/credentials operation.
+//
+// #### Controller:
+// `github.com/ubccr/grendel/internal/api.(*Handler).NodeCredentialDelete`
+// #### Middlewares:
+// - `github.com/go-fuego/fuego.defaultLogger.middleware`
+// - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
+// - `github.com/ubccr/grendel/internal/api.validateMiddleware.func1`
+// ---
+// Delete the credentials of nodes by nodeset and/or tags.
+//
+// DELETE /v1/nodes/credentials
+func (c *Client) DELETEV1NodesCredentials(ctx context.Context, params DELETEV1NodesCredentialsParams) (*GenericResponse, error) {
+	res, err := c.sendDELETEV1NodesCredentials(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendDELETEV1NodesCredentials(ctx context.Context, params DELETEV1NodesCredentialsParams) (res *GenericResponse, err error) {
+
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/v1/nodes/credentials"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "nodeset" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "nodeset",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Nodeset.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "tags" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "tags",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Tags.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "kind" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "kind",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Kind.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
+	r, err := ht.NewRequest(ctx, "DELETE", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	h := uri.NewHeaderEncoder(r.Header)
+	{
+		cfg := uri.HeaderParameterEncodingConfig{
+			Name:    "Accept",
+			Explode: false,
+		}
+		if err := h.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Accept.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode header")
+		}
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+
+			switch err := c.securityHeaderAuth(ctx, DELETEV1NodesCredentialsOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"HeaderAuth\"")
+			}
+		}
+		{
+
+			switch err := c.securityCookieAuth(ctx, DELETEV1NodesCredentialsOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 1
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"CookieAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	result, err := decodeDELETEV1NodesCredentialsResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// DELETEV1NodesFiles invokes DELETE_/v1/nodes/files operation.
+//
+// #### Controller:
+// `github.com/ubccr/grendel/internal/api.(*Handler).NodeFileDelete`
+// #### Middlewares:
+// - `github.com/go-fuego/fuego.defaultLogger.middleware`
+// - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
+// - `github.com/ubccr/grendel/internal/api.validateMiddleware.func1`
+// ---
+// Delete the files with the given name of nodes by nodeset and/or tags.
+//
+// DELETE /v1/nodes/files
+func (c *Client) DELETEV1NodesFiles(ctx context.Context, params DELETEV1NodesFilesParams) (*GenericResponse, error) {
+	res, err := c.sendDELETEV1NodesFiles(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendDELETEV1NodesFiles(ctx context.Context, params DELETEV1NodesFilesParams) (res *GenericResponse, err error) {
+
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/v1/nodes/files"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "nodeset" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "nodeset",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Nodeset.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "tags" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "tags",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Tags.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "name" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "name",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			return e.EncodeValue(conv.StringToString(params.Name))
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
+	r, err := ht.NewRequest(ctx, "DELETE", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	h := uri.NewHeaderEncoder(r.Header)
+	{
+		cfg := uri.HeaderParameterEncodingConfig{
+			Name:    "Accept",
+			Explode: false,
+		}
+		if err := h.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Accept.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode header")
+		}
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+
+			switch err := c.securityHeaderAuth(ctx, DELETEV1NodesFilesOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"HeaderAuth\"")
+			}
+		}
+		{
+
+			switch err := c.securityCookieAuth(ctx, DELETEV1NodesFilesOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 1
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"CookieAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	result, err := decodeDELETEV1NodesFilesResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// DELETEV1NodesTrash invokes DELETE_/v1/nodes/trash operation.
+//
+// #### Controller:
+// `github.com/ubccr/grendel/internal/api.(*Handler).NodeTrashPurge`
+// #### Middlewares:
+// - `github.com/go-fuego/fuego.defaultLogger.middleware`
+// - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
+// - `github.com/ubccr/grendel/internal/api.validateMiddleware.func1`
+// ---
+// Permanently delete nodes from the trash.
+//
+// DELETE /v1/nodes/trash
+func (c *Client) DELETEV1NodesTrash(ctx context.Context, params DELETEV1NodesTrashParams) (*GenericResponse, error) {
+	res, err := c.sendDELETEV1NodesTrash(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendDELETEV1NodesTrash(ctx context.Context, params DELETEV1NodesTrashParams) (res *GenericResponse, err error) {
+
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/v1/nodes/trash"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "older_than" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "older_than",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.OlderThan.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "all" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "all",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.All.Get(); ok {
+				return e.EncodeValue(conv.BoolToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
+	r, err := ht.NewRequest(ctx, "DELETE", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	h := uri.NewHeaderEncoder(r.Header)
+	{
+		cfg := uri.HeaderParameterEncodingConfig{
+			Name:    "Accept",
+			Explode: false,
+		}
+		if err := h.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Accept.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode header")
+		}
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+
+			switch err := c.securityHeaderAuth(ctx, DELETEV1NodesTrashOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"HeaderAuth\"")
+			}
+		}
+		{
+
+			switch err := c.securityCookieAuth(ctx, DELETEV1NodesTrashOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 1
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"CookieAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	result, err := decodeDELETEV1NodesTrashResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// DELETEV1RolesNames invokes DELETE_/v1/roles/:names operation.
+//
+// #### Controller:
+// `github.com/ubccr/grendel/internal/api.(*Handler).DeleteRoles`
+// #### Middlewares:
+// - `github.com/go-fuego/fuego.defaultLogger.middleware`
+// - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
+// - `github.com/ubccr/grendel/internal/api.validateMiddleware.func1`
+// ---
+// Delete roles.
+//
+// DELETE /v1/roles/{names}
+func (c *Client) DELETEV1RolesNames(ctx context.Context, params DELETEV1RolesNamesParams) (*GenericResponse, error) {
+	res, err := c.sendDELETEV1RolesNames(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendDELETEV1RolesNames(ctx context.Context, params DELETEV1RolesNamesParams) (res *GenericResponse, err error) {
+
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [2]string
+	pathParts[0] = "/v1/roles/"
+	{
+		// Encode "names" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "names",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.Names))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	uri.AddPathParts(u, pathParts[:]...)
+
+	r, err := ht.NewRequest(ctx, "DELETE", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	h := uri.NewHeaderEncoder(r.Header)
+	{
+		cfg := uri.HeaderParameterEncodingConfig{
+			Name:    "Accept",
+			Explode: false,
+		}
+		if err := h.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Accept.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode header")
+		}
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+
+			switch err := c.securityHeaderAuth(ctx, DELETEV1RolesNamesOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"HeaderAuth\"")
+			}
+		}
+		{
+
+			switch err := c.securityCookieAuth(ctx, DELETEV1RolesNamesOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 1
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"CookieAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	result, err := decodeDELETEV1RolesNamesResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// DELETEV1ScheduleID invokes DELETE_/v1/schedule/:id operation.
+//
+// #### Controller:
+// `github.com/ubccr/grendel/internal/api.(*Handler).ScheduleCancel`
+// #### Middlewares:
+// - `github.com/go-fuego/fuego.defaultLogger.middleware`
+// - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
+// - `github.com/ubccr/grendel/internal/api.validateMiddleware.func1`
+// ---
+// Cancel a pending scheduled action.
+//
+// DELETE /v1/schedule/{id}
+func (c *Client) DELETEV1ScheduleID(ctx context.Context, params DELETEV1ScheduleIDParams) (*GenericResponse, error) {
+	res, err := c.sendDELETEV1ScheduleID(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendDELETEV1ScheduleID(ctx context.Context, params DELETEV1ScheduleIDParams) (res *GenericResponse, err error) {
+
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [2]string
+	pathParts[0] = "/v1/schedule/"
+	{
+		// Encode "id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.ID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	uri.AddPathParts(u, pathParts[:]...)
+
+	r, err := ht.NewRequest(ctx, "DELETE", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	h := uri.NewHeaderEncoder(r.Header)
+	{
+		cfg := uri.HeaderParameterEncodingConfig{
+			Name:    "Accept",
+			Explode: false,
+		}
+		if err := h.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Accept.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode header")
+		}
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+
+			switch err := c.securityHeaderAuth(ctx, DELETEV1ScheduleIDOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"HeaderAuth\"")
+			}
+		}
+		{
+
+			switch err := c.securityCookieAuth(ctx, DELETEV1ScheduleIDOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 1
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"CookieAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	result, err := decodeDELETEV1ScheduleIDResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// DELETEV1SecretsName invokes DELETE_/v1/secrets/:name operation.
+//
+// #### Controller:
+// `github.com/ubccr/grendel/internal/api.(*Handler).SecretDelete`
+// #### Middlewares:
+// - `github.com/go-fuego/fuego.defaultLogger.middleware`
+// - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
+// - `github.com/ubccr/grendel/internal/api.validateMiddleware.func1`
+// ---
+// Delete a secret.
+//
+// DELETE /v1/secrets/{name}
+func (c *Client) DELETEV1SecretsName(ctx context.Context, params DELETEV1SecretsNameParams) (*GenericResponse, error) {
+	res, err := c.sendDELETEV1SecretsName(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendDELETEV1SecretsName(ctx context.Context, params DELETEV1SecretsNameParams) (res *GenericResponse, err error) {
+
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [2]string
+	pathParts[0] = "/v1/secrets/"
+	{
+		// Encode "name" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "name",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.Name))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	uri.AddPathParts(u, pathParts[:]...)
+
+	r, err := ht.NewRequest(ctx, "DELETE", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	h := uri.NewHeaderEncoder(r.Header)
+	{
+		cfg := uri.HeaderParameterEncodingConfig{
+			Name:    "Accept",
+			Explode: false,
+		}
+		if err := h.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Accept.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode header")
+		}
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+
+			switch err := c.securityHeaderAuth(ctx, DELETEV1SecretsNameOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"HeaderAuth\"")
+			}
+		}
+		{
+
+			switch err := c.securityCookieAuth(ctx, DELETEV1SecretsNameOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 1
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"CookieAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	result, err := decodeDELETEV1SecretsNameResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// DELETEV1UsersUsernames invokes DELETE_/v1/users/:usernames operation.
+//
+// #### Controller:
+// `github.com/ubccr/grendel/internal/api.(*Handler).UserDelete`
+// #### Middlewares:
+// - `github.com/go-fuego/fuego.defaultLogger.middleware`
+// - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
+// - `github.com/ubccr/grendel/internal/api.validateMiddleware.func1`
+// ---
+// Delete users.
+//
+// DELETE /v1/users/{usernames}
+func (c *Client) DELETEV1UsersUsernames(ctx context.Context, params DELETEV1UsersUsernamesParams) (*GenericResponse, error) {
+	res, err := c.sendDELETEV1UsersUsernames(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendDELETEV1UsersUsernames(ctx context.Context, params DELETEV1UsersUsernamesParams) (res *GenericResponse, err error) {
+
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [2]string
+	pathParts[0] = "/v1/users/"
+	{
+		// Encode "usernames" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "usernames",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.Usernames))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	uri.AddPathParts(u, pathParts[:]...)
+
+	r, err := ht.NewRequest(ctx, "DELETE", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	h := uri.NewHeaderEncoder(r.Header)
+	{
+		cfg := uri.HeaderParameterEncodingConfig{
+			Name:    "Accept",
+			Explode: false,
+		}
+		if err := h.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Accept.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode header")
+		}
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+
+			switch err := c.securityHeaderAuth(ctx, DELETEV1UsersUsernamesOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"HeaderAuth\"")
+			}
+		}
+		{
+
+			switch err := c.securityCookieAuth(ctx, DELETEV1UsersUsernamesOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 1
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"CookieAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	result, err := decodeDELETEV1UsersUsernamesResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// GETV1Artifacts invokes GET_/v1/artifacts operation.
+//
+// #### Controller:
+// `github.com/ubccr/grendel/internal/api.(*Handler).ArtifactList`
+// #### Middlewares:
+// - `github.com/go-fuego/fuego.defaultLogger.middleware`
+// - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
+// - `github.com/ubccr/grendel/internal/api.validateMiddleware.func1`
+// ---
+// List the artifacts served at /v1/artifacts/{name}, files such as /etc/hosts or genders derived
+// from the nodes, defined in api.artifacts.
+//
+// GET /v1/artifacts
+func (c *Client) GETV1Artifacts(ctx context.Context, params GETV1ArtifactsParams) ([]ArtifactResponse, error) {
+	res, err := c.sendGETV1Artifacts(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendGETV1Artifacts(ctx context.Context, params GETV1ArtifactsParams) (res []ArtifactResponse, err error) {
+
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/v1/artifacts"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	h := uri.NewHeaderEncoder(r.Header)
+	{
+		cfg := uri.HeaderParameterEncodingConfig{
+			Name:    "Accept",
+			Explode: false,
+		}
+		if err := h.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Accept.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode header")
+		}
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+
+			switch err := c.securityHeaderAuth(ctx, GETV1ArtifactsOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"HeaderAuth\"")
+			}
+		}
+		{
+
+			switch err := c.securityCookieAuth(ctx, GETV1ArtifactsOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 1
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"CookieAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	result, err := decodeGETV1ArtifactsResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// GETV1Bmc invokes GET_/v1/bmc operation.
+//
+// #### Controller:
+// `github.com/ubccr/grendel/internal/api.(*Handler).BmcQuery`
+// #### Middlewares:
+// - `github.com/go-fuego/fuego.defaultLogger.middleware`
+// - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
+// - `github.com/ubccr/grendel/internal/api.validateMiddleware.func1`
+// ---
+// Get redfish info from node(s).
+//
+// GET /v1/bmc
+func (c *Client) GETV1Bmc(ctx context.Context, params GETV1BmcParams) ([]RedfishSystem, error) {
+	res, err := c.sendGETV1Bmc(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendGETV1Bmc(ctx context.Context, params GETV1BmcParams) (res []RedfishSystem, err error) {
+
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/v1/bmc"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "nodeset" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "nodeset",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Nodeset.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "tags" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "tags",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Tags.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	h := uri.NewHeaderEncoder(r.Header)
+	{
+		cfg := uri.HeaderParameterEncodingConfig{
+			Name:    "Accept",
+			Explode: false,
+		}
+		if err := h.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Accept.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode header")
+		}
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+
+			switch err := c.securityHeaderAuth(ctx, GETV1BmcOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"HeaderAuth\"")
+			}
+		}
+		{
+
+			switch err := c.securityCookieAuth(ctx, GETV1BmcOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 1
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"CookieAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	result, err := decodeGETV1BmcResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// GETV1BmcBios invokes GET_/v1/bmc/bios operation.
+//
+// #### Controller:
+// `github.com/ubccr/grendel/internal/api.(*Handler).BmcBiosGet`
+// #### Middlewares:
+// - `github.com/go-fuego/fuego.defaultLogger.middleware`
+// - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
+// - `github.com/ubccr/grendel/internal/api.validateMiddleware.func1`
+// ---
+// Get the current BIOS attributes of node(s).
+//
+// GET /v1/bmc/bios
+func (c *Client) GETV1BmcBios(ctx context.Context, params GETV1BmcBiosParams) ([]BiosReport, error) {
+	res, err := c.sendGETV1BmcBios(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendGETV1BmcBios(ctx context.Context, params GETV1BmcBiosParams) (res []BiosReport, err error) {
+
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/v1/bmc/bios"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "nodeset" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "nodeset",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Nodeset.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "tags" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "tags",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Tags.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "fanout" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "fanout",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Fanout.Get(); ok {
+				return e.EncodeValue(conv.IntToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "timeout" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "timeout",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Timeout.Get(); ok {
+				return e.EncodeValue(conv.IntToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	h := uri.NewHeaderEncoder(r.Header)
+	{
+		cfg := uri.HeaderParameterEncodingConfig{
+			Name:    "Accept",
+			Explode: false,
+		}
+		if err := h.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Accept.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode header")
+		}
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+
+			switch err := c.securityHeaderAuth(ctx, GETV1BmcBiosOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"HeaderAuth\"")
+			}
+		}
+		{
+
+			switch err := c.securityCookieAuth(ctx, GETV1BmcBiosOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 1
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"CookieAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	result, err := decodeGETV1BmcBiosResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// GETV1BmcBiosDiff invokes GET_/v1/bmc/bios/diff operation.
+//
+// #### Controller:
+// `github.com/ubccr/grendel/internal/api.(*Handler).BmcBiosDiff`
+// #### Middlewares:
+// - `github.com/go-fuego/fuego.defaultLogger.middleware`
+// - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
+// - `github.com/ubccr/grendel/internal/api.validateMiddleware.func1`
+// ---
+// List the BIOS attributes of node(s) which differ from a BIOS profile in bmc.bios_profiles.
+//
+// GET /v1/bmc/bios/diff
+func (c *Client) GETV1BmcBiosDiff(ctx context.Context, params GETV1BmcBiosDiffParams) ([]BiosReport, error) {
+	res, err := c.sendGETV1BmcBiosDiff(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendGETV1BmcBiosDiff(ctx context.Context, params GETV1BmcBiosDiffParams) (res []BiosReport, err error) {
+
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/v1/bmc/bios/diff"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "nodeset" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "nodeset",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Nodeset.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "tags" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "tags",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Tags.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "profile" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "profile",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			return e.EncodeValue(conv.StringToString(params.Profile))
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "fanout" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "fanout",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Fanout.Get(); ok {
+				return e.EncodeValue(conv.IntToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "timeout" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "timeout",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Timeout.Get(); ok {
+				return e.EncodeValue(conv.IntToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	h := uri.NewHeaderEncoder(r.Header)
+	{
+		cfg := uri.HeaderParameterEncodingConfig{
+			Name:    "Accept",
+			Explode: false,
+		}
+		if err := h.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Accept.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode header")
+		}
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+
+			switch err := c.securityHeaderAuth(ctx, GETV1BmcBiosDiffOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"HeaderAuth\"")
+			}
+		}
+		{
+
+			switch err := c.securityCookieAuth(ctx, GETV1BmcBiosDiffOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 1
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"CookieAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	result, err := decodeGETV1BmcBiosDiffResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// GETV1BmcJobs invokes GET_/v1/bmc/jobs operation.
+//
+// #### Controller:
+// `github.com/ubccr/grendel/internal/api.(*Handler).BmcJobList`
+// #### Middlewares:
+// - `github.com/go-fuego/fuego.defaultLogger.middleware`
+// - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
+// - `github.com/ubccr/grendel/internal/api.validateMiddleware.func1`
+// ---
+// Get redfish jobs from node(s).
+//
+// GET /v1/bmc/jobs
+func (c *Client) GETV1BmcJobs(ctx context.Context, params GETV1BmcJobsParams) ([]RedfishJob, error) {
+	res, err := c.sendGETV1BmcJobs(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendGETV1BmcJobs(ctx context.Context, params GETV1BmcJobsParams) (res []RedfishJob, err error) {
+
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/v1/bmc/jobs"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "nodeset" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "nodeset",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Nodeset.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "tags" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "tags",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Tags.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	h := uri.NewHeaderEncoder(r.Header)
+	{
+		cfg := uri.HeaderParameterEncodingConfig{
+			Name:    "Accept",
+			Explode: false,
+		}
+		if err := h.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Accept.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode header")
+		}
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+
+			switch err := c.securityHeaderAuth(ctx, GETV1BmcJobsOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"HeaderAuth\"")
+			}
+		}
+		{
+
+			switch err := c.securityCookieAuth(ctx, GETV1BmcJobsOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 1
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"CookieAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	result, err := decodeGETV1BmcJobsResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// GETV1BmcMetrics invokes GET_/v1/bmc/metrics operation.
+//
+// #### Controller:
+// `github.com/ubccr/grendel/internal/api.(*Handler).BmcMetricReports`
+// #### Middlewares:
+// - `github.com/go-fuego/fuego.defaultLogger.middleware`
+// - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
+// - `github.com/ubccr/grendel/internal/api.validateMiddleware.func1`
+// ---
+// Get metric reports by nodeset.
+//
+// GET /v1/bmc/metrics
+func (c *Client) GETV1BmcMetrics(ctx context.Context, params GETV1BmcMetricsParams) ([]RedfishMetricReport, error) {
+	res, err := c.sendGETV1BmcMetrics(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendGETV1BmcMetrics(ctx context.Context, params GETV1BmcMetricsParams) (res []RedfishMetricReport, err error) {
+
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/v1/bmc/metrics"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "nodeset" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "nodeset",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Nodeset.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "tags" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "tags",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Tags.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	h := uri.NewHeaderEncoder(r.Header)
+	{
+		cfg := uri.HeaderParameterEncodingConfig{
+			Name:    "Accept",
+			Explode: false,
+		}
+		if err := h.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Accept.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode header")
+		}
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+
+			switch err := c.securityHeaderAuth(ctx, GETV1BmcMetricsOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"HeaderAuth\"")
+			}
+		}
+		{
+
+			switch err := c.securityCookieAuth(ctx, GETV1BmcMetricsOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 1
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"CookieAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	result, err := decodeGETV1BmcMetricsResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// GETV1BmcPower invokes GET_/v1/bmc/power operation.
+//
+// #### Controller:
+// `github.com/ubccr/grendel/internal/api.(*Handler).BmcPowerStatus`
+// #### Middlewares:
+// - `github.com/go-fuego/fuego.defaultLogger.middleware`
+// - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
+// - `github.com/ubccr/grendel/internal/api.validateMiddleware.func1`
+// ---
+// Get the power state of node(s) as on, off or unknown in data and the redfish power state in msg.
+//
+// GET /v1/bmc/power
+func (c *Client) GETV1BmcPower(ctx context.Context, params GETV1BmcPowerParams) ([]JobMessage, error) {
+	res, err := c.sendGETV1BmcPower(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendGETV1BmcPower(ctx context.Context, params GETV1BmcPowerParams) (res []JobMessage, err error) {
+
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/v1/bmc/power"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "nodeset" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "nodeset",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Nodeset.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "tags" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "tags",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Tags.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "fanout" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "fanout",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Fanout.Get(); ok {
+				return e.EncodeValue(conv.IntToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "timeout" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "timeout",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Timeout.Get(); ok {
+				return e.EncodeValue(conv.IntToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	h := uri.NewHeaderEncoder(r.Header)
+	{
+		cfg := uri.HeaderParameterEncodingConfig{
+			Name:    "Accept",
+			Explode: false,
+		}
+		if err := h.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Accept.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode header")
+		}
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+
+			switch err := c.securityHeaderAuth(ctx, GETV1BmcPowerOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"HeaderAuth\"")
+			}
+		}
+		{
+
+			switch err := c.securityCookieAuth(ctx, GETV1BmcPowerOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 1
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"CookieAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	result, err := decodeGETV1BmcPowerResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// GETV1BmcSubscriptions invokes GET_/v1/bmc/subscriptions operation.
+//
+// #### Controller:
+// `github.com/ubccr/grendel/internal/api.(*Handler).BmcSubscriptionsStatus`
+// #### Middlewares:
+// - `github.com/go-fuego/fuego.defaultLogger.middleware`
+// - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
+// - `github.com/ubccr/grendel/internal/api.validateMiddleware.func1`
+// ---
+// Check the BMC of node(s) has an event subscription to the event receiver.
+//
+// GET /v1/bmc/subscriptions
+func (c *Client) GETV1BmcSubscriptions(ctx context.Context, params GETV1BmcSubscriptionsParams) ([]EventSubscription, error) {
+	res, err := c.sendGETV1BmcSubscriptions(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendGETV1BmcSubscriptions(ctx context.Context, params GETV1BmcSubscriptionsParams) (res []EventSubscription, err error) {
+
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/v1/bmc/subscriptions"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "nodeset" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "nodeset",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Nodeset.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "tags" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "tags",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Tags.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "fanout" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "fanout",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Fanout.Get(); ok {
+				return e.EncodeValue(conv.IntToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "timeout" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "timeout",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Timeout.Get(); ok {
+				return e.EncodeValue(conv.IntToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	h := uri.NewHeaderEncoder(r.Header)
+	{
+		cfg := uri.HeaderParameterEncodingConfig{
+			Name:    "Accept",
+			Explode: false,
+		}
+		if err := h.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Accept.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode header")
+		}
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+
+			switch err := c.securityHeaderAuth(ctx, GETV1BmcSubscriptionsOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"HeaderAuth\"")
+			}
+		}
+		{
+
+			switch err := c.securityCookieAuth(ctx, GETV1BmcSubscriptionsOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 1
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"CookieAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	result, err := decodeGETV1BmcSubscriptionsResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// GETV1BmcUpgradeDellRepo invokes GET_/v1/bmc/upgrade/dell/repo operation.
+//
+// #### Controller:
+// `github.com/ubccr/grendel/internal/api.(*Handler).BmcDellGetRepoUpdateList`
+// #### Middlewares:
+// - `github.com/go-fuego/fuego.defaultLogger.middleware`
+// - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
+// - `github.com/ubccr/grendel/internal/api.validateMiddleware.func1`
+// ---
+// Fetch which packages can be upgraded.
+//
+// GET /v1/bmc/upgrade/dell/repo
+func (c *Client) GETV1BmcUpgradeDellRepo(ctx context.Context, params GETV1BmcUpgradeDellRepoParams) ([]RedfishDellUpgradeFirmware, error) {
+	res, err := c.sendGETV1BmcUpgradeDellRepo(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendGETV1BmcUpgradeDellRepo(ctx context.Context, params GETV1BmcUpgradeDellRepoParams) (res []RedfishDellUpgradeFirmware, err error) {
+
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/v1/bmc/upgrade/dell/repo"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "nodeset" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "nodeset",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Nodeset.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "tags" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "tags",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Tags.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	h := uri.NewHeaderEncoder(r.Header)
+	{
+		cfg := uri.HeaderParameterEncodingConfig{
+			Name:    "Accept",
+			Explode: false,
+		}
+		if err := h.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Accept.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode header")
+		}
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+
+			switch err := c.securityHeaderAuth(ctx, GETV1BmcUpgradeDellRepoOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"HeaderAuth\"")
+			}
+		}
+		{
+
+			switch err := c.securityCookieAuth(ctx, GETV1BmcUpgradeDellRepoOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 1
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"CookieAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	result, err := decodeGETV1BmcUpgradeDellRepoResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// GETV1BmcVmedia invokes GET_/v1/bmc/vmedia operation.
+//
+// #### Controller:
+// `github.com/ubccr/grendel/internal/api.(*Handler).BmcVirtualMediaStatus`
+// #### Middlewares:
+// - `github.com/go-fuego/fuego.defaultLogger.middleware`
+// - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
+// - `github.com/ubccr/grendel/internal/api.validateMiddleware.func1`
+// ---
+// Get the image inserted in the virtual CD of node(s) in msg and inserted or ejected in data.
+//
+// GET /v1/bmc/vmedia
+func (c *Client) GETV1BmcVmedia(ctx context.Context, params GETV1BmcVmediaParams) ([]JobMessage, error) {
+	res, err := c.sendGETV1BmcVmedia(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendGETV1BmcVmedia(ctx context.Context, params GETV1BmcVmediaParams) (res []JobMessage, err error) {
+
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/v1/bmc/vmedia"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "nodeset" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "nodeset",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Nodeset.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "tags" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "tags",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Tags.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "fanout" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "fanout",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Fanout.Get(); ok {
+				return e.EncodeValue(conv.IntToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "timeout" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "timeout",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Timeout.Get(); ok {
+				return e.EncodeValue(conv.IntToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	h := uri.NewHeaderEncoder(r.Header)
+	{
+		cfg := uri.HeaderParameterEncodingConfig{
+			Name:    "Accept",
+			Explode: false,
+		}
+		if err := h.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Accept.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode header")
+		}
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+
+			switch err := c.securityHeaderAuth(ctx, GETV1BmcVmediaOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"HeaderAuth\"")
+			}
+		}
+		{
+
+			switch err := c.securityCookieAuth(ctx, GETV1BmcVmediaOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 1
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"CookieAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	result, err := decodeGETV1BmcVmediaResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// GETV1CertsRevoked invokes GET_/v1/certs/revoked operation.
+//
+// #### Controller:
+// `github.com/ubccr/grendel/internal/api.(*Handler).CertRevokedList`
+// #### Middlewares:
+// - `github.com/go-fuego/fuego.defaultLogger.middleware`
+// - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
+// - `github.com/ubccr/grendel/internal/api.validateMiddleware.func1`
+// ---
+// List the revoked certificates.
+//
+// GET /v1/certs/revoked
+func (c *Client) GETV1CertsRevoked(ctx context.Context, params GETV1CertsRevokedParams) ([]RevokedCert, error) {
+	res, err := c.sendGETV1CertsRevoked(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendGETV1CertsRevoked(ctx context.Context, params GETV1CertsRevokedParams) (res []RevokedCert, err error) {
+
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/v1/certs/revoked"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	h := uri.NewHeaderEncoder(r.Header)
+	{
+		cfg := uri.HeaderParameterEncodingConfig{
+			Name:    "Accept",
+			Explode: false,
+		}
+		if err := h.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Accept.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode header")
+		}
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+
+			switch err := c.securityHeaderAuth(ctx, GETV1CertsRevokedOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"HeaderAuth\"")
+			}
+		}
+		{
+
+			switch err := c.securityCookieAuth(ctx, GETV1CertsRevokedOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 1
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"CookieAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	result, err := decodeGETV1CertsRevokedResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// GETV1Changes invokes GET_/v1/changes operation.
+//
+// #### Controller:
+// `github.com/ubccr/grendel/internal/api.(*Handler).ChangeList`
+// #### Middlewares:
+// - `github.com/go-fuego/fuego.defaultLogger.middleware`
+// - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
+// - `github.com/ubccr/grendel/internal/api.validateMiddleware.func1`
+// ---
+// List the changes made to nodes, images and DNS records following a sequence number. Consumers
+// store the seq of the last change processed and pass it as since_seq, a change may be received
+// again if the consumer fails before storing it. Entries are kept for change_retention, truncated is
+// set if changes following since_seq were purged and the consumer must resync from a full dump.
+//
+// GET /v1/changes
+func (c *Client) GETV1Changes(ctx context.Context, params GETV1ChangesParams) (*ChangeFeed, error) {
+	res, err := c.sendGETV1Changes(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendGETV1Changes(ctx context.Context, params GETV1ChangesParams) (res *ChangeFeed, err error) {
+
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/v1/changes"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "since_seq" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "since_seq",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.SinceSeq.Get(); ok {
+				return e.EncodeValue(conv.IntToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "limit" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "limit",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Limit.Get(); ok {
+				return e.EncodeValue(conv.IntToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "wait" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "wait",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Wait.Get(); ok {
+				return e.EncodeValue(conv.IntToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	h := uri.NewHeaderEncoder(r.Header)
+	{
+		cfg := uri.HeaderParameterEncodingConfig{
+			Name:    "Accept",
+			Explode: false,
+		}
+		if err := h.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Accept.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode header")
+		}
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+
+			switch err := c.securityHeaderAuth(ctx, GETV1ChangesOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"HeaderAuth\"")
+			}
+		}
+		{
+
+			switch err := c.securityCookieAuth(ctx, GETV1ChangesOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 1
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"CookieAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	result, err := decodeGETV1ChangesResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// GETV1DNSRecords invokes GET_/v1/dns/records operation.
+//
+// #### Controller:
+// `github.com/ubccr/grendel/internal/api.(*Handler).DNSRecordList`
+// #### Middlewares:
+// - `github.com/go-fuego/fuego.defaultLogger.middleware`
+// - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
+// - `github.com/ubccr/grendel/internal/api.validateMiddleware.func1`
+// ---
+// List DNS only records.
+//
+// GET /v1/dns/records
+func (c *Client) GETV1DNSRecords(ctx context.Context, params GETV1DNSRecordsParams) ([]Record, error) {
+	res, err := c.sendGETV1DNSRecords(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendGETV1DNSRecords(ctx context.Context, params GETV1DNSRecordsParams) (res []Record, err error) {
+
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/v1/dns/records"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "names" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "names",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Names.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	h := uri.NewHeaderEncoder(r.Header)
+	{
+		cfg := uri.HeaderParameterEncodingConfig{
+			Name:    "Accept",
+			Explode: false,
+		}
+		if err := h.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Accept.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode header")
+		}
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+
+			switch err := c.securityHeaderAuth(ctx, GETV1DNSRecordsOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"HeaderAuth\"")
+			}
+		}
+		{
+
+			switch err := c.securityCookieAuth(ctx, GETV1DNSRecordsOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 1
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"CookieAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	result, err := decodeGETV1DNSRecordsResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// GETV1DbBackup invokes GET_/v1/db/backup operation.
+//
+// #### Controller:
+// `github.com/ubccr/grendel/internal/api.(*Handler).Backup`
+// #### Middlewares:
+// - `github.com/go-fuego/fuego.defaultLogger.middleware`
+// - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
+// - `github.com/ubccr/grendel/internal/api.validateMiddleware.func1`
+// ---
+// Stream a consistent point in time backup archive of the DB.
+//
+// GET /v1/db/backup
+func (c *Client) GETV1DbBackup(ctx context.Context, params GETV1DbBackupParams) (GETV1DbBackupOK, error) {
+	res, err := c.sendGETV1DbBackup(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendGETV1DbBackup(ctx context.Context, params GETV1DbBackupParams) (res GETV1DbBackupOK, err error) {
+
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/v1/db/backup"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	h := uri.NewHeaderEncoder(r.Header)
+	{
+		cfg := uri.HeaderParameterEncodingConfig{
+			Name:    "Accept",
+			Explode: false,
+		}
+		if err := h.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Accept.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode header")
+		}
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+
+			switch err := c.securityHeaderAuth(ctx, GETV1DbBackupOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"HeaderAuth\"")
+			}
+		}
+		{
+
+			switch err := c.securityCookieAuth(ctx, GETV1DbBackupOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 1
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"CookieAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	result, err := decodeGETV1DbBackupResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// GETV1DbDump invokes GET_/v1/db/dump operation.
+//
+// #### Controller:
+// `github.com/ubccr/grendel/internal/api.(*Handler).Dump`
+// #### Middlewares:
+// - `github.com/go-fuego/fuego.defaultLogger.middleware`
+// - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
+// - `github.com/ubccr/grendel/internal/api.validateMiddleware.func1`
+// ---
+// Get a backup of the DB.
+//
+// GET /v1/db/dump
+func (c *Client) GETV1DbDump(ctx context.Context, params GETV1DbDumpParams) (*DataDump, error) {
+	res, err := c.sendGETV1DbDump(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendGETV1DbDump(ctx context.Context, params GETV1DbDumpParams) (res *DataDump, err error) {
+
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/v1/db/dump"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "include_secrets" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "include_secrets",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.IncludeSecrets.Get(); ok {
+				return e.EncodeValue(conv.BoolToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	h := uri.NewHeaderEncoder(r.Header)
+	{
+		cfg := uri.HeaderParameterEncodingConfig{
+			Name:    "Accept",
+			Explode: false,
+		}
+		if err := h.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Accept.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode header")
+		}
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+
+			switch err := c.securityHeaderAuth(ctx, GETV1DbDumpOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"HeaderAuth\"")
+			}
+		}
+		{
+
+			switch err := c.securityCookieAuth(ctx, GETV1DbDumpOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 1
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"CookieAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	result, err := decodeGETV1DbDumpResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// GETV1DiscoverBmc invokes GET_/v1/discover/bmc operation.
+//
+// #### Controller:
+// `github.com/ubccr/grendel/internal/api.(*Handler).DiscoverBMCList`
+// #### Middlewares:
+// - `github.com/go-fuego/fuego.defaultLogger.middleware`
+// - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
+// - `github.com/ubccr/grendel/internal/api.validateMiddleware.func1`
+// ---
+// List the BMCs seen on DHCP from MAC addresses not registered to any node, recorded when dhcp.
+// bmc_discovery is enabled.
+//
+// GET /v1/discover/bmc
+func (c *Client) GETV1DiscoverBmc(ctx context.Context, params GETV1DiscoverBmcParams) ([]PendingBMC, error) {
+	res, err := c.sendGETV1DiscoverBmc(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendGETV1DiscoverBmc(ctx context.Context, params GETV1DiscoverBmcParams) (res []PendingBMC, err error) {
+
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/v1/discover/bmc"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	h := uri.NewHeaderEncoder(r.Header)
+	{
+		cfg := uri.HeaderParameterEncodingConfig{
+			Name:    "Accept",
+			Explode: false,
+		}
+		if err := h.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Accept.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode header")
+		}
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+
+			switch err := c.securityHeaderAuth(ctx, GETV1DiscoverBmcOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"HeaderAuth\"")
+			}
+		}
+		{
+
+			switch err := c.securityCookieAuth(ctx, GETV1DiscoverBmcOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 1
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"CookieAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	result, err := decodeGETV1DiscoverBmcResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// GETV1GrendelEvents invokes GET_/v1/grendel/events operation.
+//
+// #### Controller:
+// `github.com/ubccr/grendel/internal/api.(*Handler).GetEvents`
+// #### Middlewares:
+// - `github.com/go-fuego/fuego.defaultLogger.middleware`
+// - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
+// - `github.com/ubccr/grendel/internal/api.validateMiddleware.func1`
+// ---.
+//
+// GET /v1/grendel/events
+func (c *Client) GETV1GrendelEvents(ctx context.Context, params GETV1GrendelEventsParams) ([]Event, error) {
+	res, err := c.sendGETV1GrendelEvents(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendGETV1GrendelEvents(ctx context.Context, params GETV1GrendelEventsParams) (res []Event, err error) {
+
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/v1/grendel/events"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	h := uri.NewHeaderEncoder(r.Header)
+	{
+		cfg := uri.HeaderParameterEncodingConfig{
+			Name:    "Accept",
+			Explode: false,
+		}
+		if err := h.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Accept.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode header")
+		}
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+
+			switch err := c.securityHeaderAuth(ctx, GETV1GrendelEventsOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"HeaderAuth\"")
+			}
+		}
+		{
+
+			switch err := c.securityCookieAuth(ctx, GETV1GrendelEventsOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 1
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"CookieAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	result, err := decodeGETV1GrendelEventsResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// GETV1GrendelHa invokes GET_/v1/grendel/ha operation.
+//
+// #### Controller:
+// `github.com/ubccr/grendel/internal/api.(*Handler).GrendelHA`
+// #### Middlewares:
+// - `github.com/go-fuego/fuego.defaultLogger.middleware`
+// - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
+// - `github.com/ubccr/grendel/internal/api.validateMiddleware.func1`
+// ---
+// List the grendel serve instances running in high availability mode and their DHCP state.
+//
+// GET /v1/grendel/ha
+func (c *Client) GETV1GrendelHa(ctx context.Context, params GETV1GrendelHaParams) ([]HAInstance, error) {
+	res, err := c.sendGETV1GrendelHa(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendGETV1GrendelHa(ctx context.Context, params GETV1GrendelHaParams) (res []HAInstance, err error) {
+
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/v1/grendel/ha"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	h := uri.NewHeaderEncoder(r.Header)
+	{
+		cfg := uri.HeaderParameterEncodingConfig{
+			Name:    "Accept",
+			Explode: false,
+		}
+		if err := h.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Accept.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode header")
+		}
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+
+			switch err := c.securityHeaderAuth(ctx, GETV1GrendelHaOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"HeaderAuth\"")
+			}
+		}
+		{
+
+			switch err := c.securityCookieAuth(ctx, GETV1GrendelHaOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 1
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"CookieAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	result, err := decodeGETV1GrendelHaResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// GETV1GrendelKeys invokes GET_/v1/grendel/keys operation.
+//
+// #### Controller:
+// `github.com/ubccr/grendel/internal/api.(*Handler).GrendelKeys`
+// #### Middlewares:
+// - `github.com/go-fuego/fuego.defaultLogger.middleware`
+// - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
+// - `github.com/ubccr/grendel/internal/api.validateMiddleware.func1`
+// ---
+// List the keys verifying boot and API tokens, the primary key of each kind signs new tokens.
+// Secrets are never returned.
+//
+// GET /v1/grendel/keys
+func (c *Client) GETV1GrendelKeys(ctx context.Context, params GETV1GrendelKeysParams) ([]SigningKey, error) {
+	res, err := c.sendGETV1GrendelKeys(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendGETV1GrendelKeys(ctx context.Context, params GETV1GrendelKeysParams) (res []SigningKey, err error) {
+
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/v1/grendel/keys"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	h := uri.NewHeaderEncoder(r.Header)
+	{
+		cfg := uri.HeaderParameterEncodingConfig{
+			Name:    "Accept",
+			Explode: false,
+		}
+		if err := h.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Accept.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode header")
+		}
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+
+			switch err := c.securityHeaderAuth(ctx, GETV1GrendelKeysOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"HeaderAuth\"")
+			}
+		}
+		{
+
+			switch err := c.securityCookieAuth(ctx, GETV1GrendelKeysOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 1
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"CookieAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	result, err := decodeGETV1GrendelKeysResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// GETV1GrendelListeners invokes GET_/v1/grendel/listeners operation.
+//
+// #### Controller:
+// `github.com/ubccr/grendel/internal/api.(*Handler).GrendelListeners`
+// #### Middlewares:
+// - `github.com/go-fuego/fuego.defaultLogger.middleware`
+// - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
+// - `github.com/ubccr/grendel/internal/api.validateMiddleware.func1`
+// ---
+// List the sockets bound by the services of the grendel serve process running the API and their
+// address family.
+//
+// GET /v1/grendel/listeners
+func (c *Client) GETV1GrendelListeners(ctx context.Context, params GETV1GrendelListenersParams) ([]Listener, error) {
+	res, err := c.sendGETV1GrendelListeners(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendGETV1GrendelListeners(ctx context.Context, params GETV1GrendelListenersParams) (res []Listener, err error) {
+
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/v1/grendel/listeners"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	h := uri.NewHeaderEncoder(r.Header)
+	{
+		cfg := uri.HeaderParameterEncodingConfig{
+			Name:    "Accept",
+			Explode: false,
+		}
+		if err := h.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Accept.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode header")
+		}
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+
+			switch err := c.securityHeaderAuth(ctx, GETV1GrendelListenersOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"HeaderAuth\"")
+			}
+		}
+		{
+
+			switch err := c.securityCookieAuth(ctx, GETV1GrendelListenersOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 1
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"CookieAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	result, err := decodeGETV1GrendelListenersResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// GETV1GrendelMaintenance invokes GET_/v1/grendel/maintenance operation.
+//
+// #### Controller:
+// `github.com/ubccr/grendel/internal/api.(*Handler).GrendelMaintenance`
+// #### Middlewares:
+// - `github.com/go-fuego/fuego.defaultLogger.middleware`
+// - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
+// - `github.com/ubccr/grendel/internal/api.validateMiddleware.func1`
+// ---
+// Get the maintenance mode pausing provisioning.
+//
+// GET /v1/grendel/maintenance
+func (c *Client) GETV1GrendelMaintenance(ctx context.Context, params GETV1GrendelMaintenanceParams) (*Maintenance, error) {
+	res, err := c.sendGETV1GrendelMaintenance(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendGETV1GrendelMaintenance(ctx context.Context, params GETV1GrendelMaintenanceParams) (res *Maintenance, err error) {
+
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/v1/grendel/maintenance"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	h := uri.NewHeaderEncoder(r.Header)
+	{
+		cfg := uri.HeaderParameterEncodingConfig{
+			Name:    "Accept",
+			Explode: false,
+		}
+		if err := h.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Accept.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode header")
+		}
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+
+			switch err := c.securityHeaderAuth(ctx, GETV1GrendelMaintenanceOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"HeaderAuth\"")
+			}
+		}
+		{
+
+			switch err := c.securityCookieAuth(ctx, GETV1GrendelMaintenanceOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 1
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"CookieAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	result, err := decodeGETV1GrendelMaintenanceResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// GETV1GrendelReadonly invokes GET_/v1/grendel/readonly operation.
+//
+// #### Controller:
+// `github.com/ubccr/grendel/internal/api.(*Handler).GrendelReadOnly`
+// #### Middlewares:
+// - `github.com/go-fuego/fuego.defaultLogger.middleware`
+// - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
+// - `github.com/ubccr/grendel/internal/api.validateMiddleware.func1`
+// ---
+// Get whether the grendel serve process running the API is in read-only mode. Writes to the data
+// store are then refused with 403 naming the primary instance.
+//
+// GET /v1/grendel/readonly
+func (c *Client) GETV1GrendelReadonly(ctx context.Context, params GETV1GrendelReadonlyParams) (*ReadOnlyResponse, error) {
+	res, err := c.sendGETV1GrendelReadonly(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendGETV1GrendelReadonly(ctx context.Context, params GETV1GrendelReadonlyParams) (res *ReadOnlyResponse, err error) {
+
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/v1/grendel/readonly"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	h := uri.NewHeaderEncoder(r.Header)
+	{
+		cfg := uri.HeaderParameterEncodingConfig{
+			Name:    "Accept",
+			Explode: false,
+		}
+		if err := h.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Accept.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode header")
+		}
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+
+			switch err := c.securityHeaderAuth(ctx, GETV1GrendelReadonlyOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"HeaderAuth\"")
+			}
+		}
+		{
+
+			switch err := c.securityCookieAuth(ctx, GETV1GrendelReadonlyOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 1
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"CookieAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	result, err := decodeGETV1GrendelReadonlyResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// GETV1GrendelStats invokes GET_/v1/grendel/stats operation.
+//
+// #### Controller:
+// `github.com/ubccr/grendel/internal/api.(*Handler).GrendelStats`
+// #### Middlewares:
+// - `github.com/go-fuego/fuego.defaultLogger.middleware`
+// - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
+// - `github.com/ubccr/grendel/internal/api.validateMiddleware.func1`
+// ---
+// Summary of the hosts by provision state, boot image and tag and of the recent DHCP, provision and
+// DNS activity of the grendel serve process running the API. Cached for 10 seconds.
+//
+// GET /v1/grendel/stats
+func (c *Client) GETV1GrendelStats(ctx context.Context, params GETV1GrendelStatsParams) (*Stats, error) {
+	res, err := c.sendGETV1GrendelStats(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendGETV1GrendelStats(ctx context.Context, params GETV1GrendelStatsParams) (res *Stats, err error) {
+
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/v1/grendel/stats"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	h := uri.NewHeaderEncoder(r.Header)
+	{
+		cfg := uri.HeaderParameterEncodingConfig{
+			Name:    "Accept",
+			Explode: false,
+		}
+		if err := h.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Accept.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode header")
+		}
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+
+			switch err := c.securityHeaderAuth(ctx, GETV1GrendelStatsOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"HeaderAuth\"")
+			}
+		}
+		{
+
+			switch err := c.securityCookieAuth(ctx, GETV1GrendelStatsOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 1
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"CookieAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	result, err := decodeGETV1GrendelStatsResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// GETV1Images invokes GET_/v1/images operation.
+//
+// #### Controller:
+// `github.com/ubccr/grendel/internal/api.(*Handler).BootImageList`
+// #### Middlewares:
+// - `github.com/go-fuego/fuego.defaultLogger.middleware`
+// - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
+// - `github.com/ubccr/grendel/internal/api.validateMiddleware.func1`
+// ---
+// List all images.
+//
+// GET /v1/images
+func (c *Client) GETV1Images(ctx context.Context, params GETV1ImagesParams) ([]BootImage, error) {
+	res, err := c.sendGETV1Images(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendGETV1Images(ctx context.Context, params GETV1ImagesParams) (res []BootImage, err error) {
+
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/v1/images"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "since" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "since",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Since.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "resolved" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "resolved",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Resolved.Get(); ok {
+				return e.EncodeValue(conv.BoolToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	h := uri.NewHeaderEncoder(r.Header)
+	{
+		cfg := uri.HeaderParameterEncodingConfig{
+			Name:    "Accept",
+			Explode: false,
+		}
+		if err := h.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Accept.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode header")
+		}
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+
+			switch err := c.securityHeaderAuth(ctx, GETV1ImagesOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"HeaderAuth\"")
+			}
+		}
+		{
+
+			switch err := c.securityCookieAuth(ctx, GETV1ImagesOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 1
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"CookieAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	result, err := decodeGETV1ImagesResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// GETV1ImagesDeleted invokes GET_/v1/images/deleted operation.
+//
+// #### Controller:
+// `github.com/ubccr/grendel/internal/api.(*Handler).BootImageDeleted`
+// #### Middlewares:
+// - `github.com/go-fuego/fuego.defaultLogger.middleware`
+// - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
+// - `github.com/ubccr/grendel/internal/api.validateMiddleware.func1`
+// ---
+// List the names of deleted images. Entries are kept for tombstone_retention.
+//
+// GET /v1/images/deleted
+func (c *Client) GETV1ImagesDeleted(ctx context.Context, params GETV1ImagesDeletedParams) ([]Tombstone, error) {
+	res, err := c.sendGETV1ImagesDeleted(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendGETV1ImagesDeleted(ctx context.Context, params GETV1ImagesDeletedParams) (res []Tombstone, err error) {
+
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/v1/images/deleted"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "since" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "since",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Since.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	h := uri.NewHeaderEncoder(r.Header)
+	{
+		cfg := uri.HeaderParameterEncodingConfig{
+			Name:    "Accept",
+			Explode: false,
+		}
+		if err := h.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Accept.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode header")
+		}
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+
+			switch err := c.securityHeaderAuth(ctx, GETV1ImagesDeletedOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"HeaderAuth\"")
+			}
+		}
+		{
+
+			switch err := c.securityCookieAuth(ctx, GETV1ImagesDeletedOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 1
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"CookieAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	result, err := decodeGETV1ImagesDeletedResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// GETV1ImagesDu invokes GET_/v1/images/du operation.
+//
+// #### Controller:
+// `github.com/ubccr/grendel/internal/api.(*Handler).BootImageDiskUsage`
+// #### Middlewares:
+// - `github.com/go-fuego/fuego.defaultLogger.middleware`
+// - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
+// - `github.com/ubccr/grendel/internal/api.validateMiddleware.func1`
+// ---
+// Report the disk space used by the kernel, initrds and signatures of each image. Files shared by
+// images, through symlinks or hard links, are counted once in the total.
+//
+// GET /v1/images/du
+func (c *Client) GETV1ImagesDu(ctx context.Context, params GETV1ImagesDuParams) (*ImageDiskUsage, error) {
+	res, err := c.sendGETV1ImagesDu(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendGETV1ImagesDu(ctx context.Context, params GETV1ImagesDuParams) (res *ImageDiskUsage, err error) {
+
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/v1/images/du"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	h := uri.NewHeaderEncoder(r.Header)
+	{
+		cfg := uri.HeaderParameterEncodingConfig{
+			Name:    "Accept",
+			Explode: false,
+		}
+		if err := h.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Accept.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode header")
+		}
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+
+			switch err := c.securityHeaderAuth(ctx, GETV1ImagesDuOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"HeaderAuth\"")
+			}
+		}
+		{
+
+			switch err := c.securityCookieAuth(ctx, GETV1ImagesDuOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 1
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"CookieAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	result, err := decodeGETV1ImagesDuResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// GETV1ImagesFind invokes GET_/v1/images/find operation.
+//
+// #### Controller:
+// `github.com/ubccr/grendel/internal/api.(*Handler).BootImageFind`
+// #### Middlewares:
+// - `github.com/go-fuego/fuego.defaultLogger.middleware`
+// - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
+// - `github.com/ubccr/grendel/internal/api.validateMiddleware.func1`
+// ---
+// Find images by name.
+//
+// GET /v1/images/find
+func (c *Client) GETV1ImagesFind(ctx context.Context, params GETV1ImagesFindParams) ([]BootImage, error) {
+	res, err := c.sendGETV1ImagesFind(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendGETV1ImagesFind(ctx context.Context, params GETV1ImagesFindParams) (res []BootImage, err error) {
+
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/v1/images/find"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "names" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "names",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Names.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "since" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "since",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Since.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "resolved" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "resolved",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Resolved.Get(); ok {
+				return e.EncodeValue(conv.BoolToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	h := uri.NewHeaderEncoder(r.Header)
+	{
+		cfg := uri.HeaderParameterEncodingConfig{
+			Name:    "Accept",
+			Explode: false,
+		}
+		if err := h.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Accept.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode header")
+		}
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+
+			switch err := c.securityHeaderAuth(ctx, GETV1ImagesFindOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"HeaderAuth\"")
+			}
+		}
+		{
+
+			switch err := c.securityCookieAuth(ctx, GETV1ImagesFindOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 1
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"CookieAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	result, err := decodeGETV1ImagesFindResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// GETV1Nodes invokes GET_/v1/nodes operation.
+//
+// #### Controller:
+// `github.com/ubccr/grendel/internal/api.(*Handler).NodeList`
+// #### Middlewares:
+// - `github.com/go-fuego/fuego.defaultLogger.middleware`
+// - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
+// - `github.com/ubccr/grendel/internal/api.validateMiddleware.func1`
+// ---
+// List all nodes.
+//
+// GET /v1/nodes
+func (c *Client) GETV1Nodes(ctx context.Context, params GETV1NodesParams) ([]Host, error) {
+	res, err := c.sendGETV1Nodes(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendGETV1Nodes(ctx context.Context, params GETV1NodesParams) (res []Host, err error) {
+
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/v1/nodes"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "since" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "since",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Since.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	h := uri.NewHeaderEncoder(r.Header)
+	{
+		cfg := uri.HeaderParameterEncodingConfig{
+			Name:    "Accept",
+			Explode: false,
+		}
+		if err := h.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Accept.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode header")
+		}
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+
+			switch err := c.securityHeaderAuth(ctx, GETV1NodesOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"HeaderAuth\"")
+			}
+		}
+		{
+
+			switch err := c.securityCookieAuth(ctx, GETV1NodesOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 1
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"CookieAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	result, err := decodeGETV1NodesResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// GETV1NodesCredentials invokes GET_/v1/nodes/credentials operation.
+//
+// #### Controller:
+// `github.com/ubccr/grendel/internal/api.(*Handler).NodeCredentialList`
+// #### Middlewares:
+// - `github.com/go-fuego/fuego.defaultLogger.middleware`
+// - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
+// - `github.com/ubccr/grendel/internal/api.validateMiddleware.func1`
+// ---
+// List the kinds of credentials stored for nodes by nodeset and/or tags. Secrets are never returned.
+//
+// GET /v1/nodes/credentials
+func (c *Client) GETV1NodesCredentials(ctx context.Context, params GETV1NodesCredentialsParams) ([]Credential, error) {
+	res, err := c.sendGETV1NodesCredentials(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendGETV1NodesCredentials(ctx context.Context, params GETV1NodesCredentialsParams) (res []Credential, err error) {
+
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/v1/nodes/credentials"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "nodeset" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "nodeset",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Nodeset.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "tags" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "tags",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Tags.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	h := uri.NewHeaderEncoder(r.Header)
+	{
+		cfg := uri.HeaderParameterEncodingConfig{
+			Name:    "Accept",
+			Explode: false,
+		}
+		if err := h.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Accept.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode header")
+		}
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+
+			switch err := c.securityHeaderAuth(ctx, GETV1NodesCredentialsOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"HeaderAuth\"")
+			}
+		}
+		{
+
+			switch err := c.securityCookieAuth(ctx, GETV1NodesCredentialsOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 1
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"CookieAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	result, err := decodeGETV1NodesCredentialsResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// GETV1NodesDeleted invokes GET_/v1/nodes/deleted operation.
+//
+// #### Controller:
+// `github.com/ubccr/grendel/internal/api.(*Handler).NodeDeleted`
+// #### Middlewares:
+// - `github.com/go-fuego/fuego.defaultLogger.middleware`
+// - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
+// - `github.com/ubccr/grendel/internal/api.validateMiddleware.func1`
+// ---
+// List the names of deleted nodes, including the old names of renamed nodes. Entries are kept for
+// tombstone_retention.
+//
+// GET /v1/nodes/deleted
+func (c *Client) GETV1NodesDeleted(ctx context.Context, params GETV1NodesDeletedParams) ([]Tombstone, error) {
+	res, err := c.sendGETV1NodesDeleted(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendGETV1NodesDeleted(ctx context.Context, params GETV1NodesDeletedParams) (res []Tombstone, err error) {
+
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/v1/nodes/deleted"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "since" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "since",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Since.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	h := uri.NewHeaderEncoder(r.Header)
+	{
+		cfg := uri.HeaderParameterEncodingConfig{
+			Name:    "Accept",
+			Explode: false,
+		}
+		if err := h.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Accept.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode header")
+		}
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+
+			switch err := c.securityHeaderAuth(ctx, GETV1NodesDeletedOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"HeaderAuth\"")
+			}
+		}
+		{
+
+			switch err := c.securityCookieAuth(ctx, GETV1NodesDeletedOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 1
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"CookieAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	result, err := decodeGETV1NodesDeletedResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// GETV1NodesFiles invokes GET_/v1/nodes/files operation.
+//
+// #### Controller:
+// `github.com/ubccr/grendel/internal/api.(*Handler).NodeFileList`
+// #### Middlewares:
+// - `github.com/go-fuego/fuego.defaultLogger.middleware`
+// - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
+// - `github.com/ubccr/grendel/internal/api.validateMiddleware.func1`
+// ---
+// List the files attached to nodes by nodeset and/or tags, without their content.
+//
+// GET /v1/nodes/files
+func (c *Client) GETV1NodesFiles(ctx context.Context, params GETV1NodesFilesParams) ([]HostFile, error) {
+	res, err := c.sendGETV1NodesFiles(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendGETV1NodesFiles(ctx context.Context, params GETV1NodesFilesParams) (res []HostFile, err error) {
+
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/v1/nodes/files"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "nodeset" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "nodeset",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Nodeset.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "tags" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "tags",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Tags.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	h := uri.NewHeaderEncoder(r.Header)
+	{
+		cfg := uri.HeaderParameterEncodingConfig{
+			Name:    "Accept",
+			Explode: false,
+		}
+		if err := h.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Accept.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode header")
+		}
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+
+			switch err := c.securityHeaderAuth(ctx, GETV1NodesFilesOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"HeaderAuth\"")
+			}
+		}
+		{
+
+			switch err := c.securityCookieAuth(ctx, GETV1NodesFilesOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 1
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"CookieAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	result, err := decodeGETV1NodesFilesResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// GETV1NodesFind invokes GET_/v1/nodes/find operation.
+//
+// #### Controller:
+// `github.com/ubccr/grendel/internal/api.(*Handler).NodeFind`
+// #### Middlewares:
+// - `github.com/go-fuego/fuego.defaultLogger.middleware`
+// - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
+// - `github.com/ubccr/grendel/internal/api.validateMiddleware.func1`
+// ---
+// Find nodes by nodeset and/or tags.
+//
+// GET /v1/nodes/find
+func (c *Client) GETV1NodesFind(ctx context.Context, params GETV1NodesFindParams) ([]Host, error) {
+	res, err := c.sendGETV1NodesFind(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendGETV1NodesFind(ctx context.Context, params GETV1NodesFindParams) (res []Host, err error) {
+
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/v1/nodes/find"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "nodeset" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "nodeset",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Nodeset.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "tags" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "tags",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Tags.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "switch" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "switch",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Switch.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "port" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "port",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Port.Get(); ok {
+				return e.EncodeValue(conv.IntToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "bios_version" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "bios_version",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.BiosVersion.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "bmc_firmware" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "bmc_firmware",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.BmcFirmware.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "nic_firmware" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "nic_firmware",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.NicFirmware.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "state" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "state",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.State.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "since" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "since",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Since.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	h := uri.NewHeaderEncoder(r.Header)
+	{
+		cfg := uri.HeaderParameterEncodingConfig{
+			Name:    "Accept",
+			Explode: false,
+		}
+		if err := h.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Accept.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode header")
+		}
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+
+			switch err := c.securityHeaderAuth(ctx, GETV1NodesFindOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"HeaderAuth\"")
+			}
+		}
+		{
+
+			switch err := c.securityCookieAuth(ctx, GETV1NodesFindOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 1
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"CookieAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	result, err := decodeGETV1NodesFindResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// GETV1NodesLog invokes GET_/v1/nodes/log operation.
+//
+// #### Controller:
+// `github.com/ubccr/grendel/internal/api.(*Handler).NodeLog`
+// #### Middlewares:
+// - `github.com/go-fuego/fuego.defaultLogger.middleware`
+// - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
+// - `github.com/ubccr/grendel/internal/api.validateMiddleware.func1`
+// ---
+// List the log of node(s), oldest first. The log holds the critical events pushed by the BMCs, up to
+// 200 entries per node.
+//
+// GET /v1/nodes/log
+func (c *Client) GETV1NodesLog(ctx context.Context, params GETV1NodesLogParams) ([]HostLogEntry, error) {
+	res, err := c.sendGETV1NodesLog(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendGETV1NodesLog(ctx context.Context, params GETV1NodesLogParams) (res []HostLogEntry, err error) {
+
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/v1/nodes/log"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "nodeset" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "nodeset",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Nodeset.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "tags" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "tags",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Tags.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	h := uri.NewHeaderEncoder(r.Header)
+	{
+		cfg := uri.HeaderParameterEncodingConfig{
+			Name:    "Accept",
+			Explode: false,
+		}
+		if err := h.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Accept.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode header")
+		}
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+
+			switch err := c.securityHeaderAuth(ctx, GETV1NodesLogOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"HeaderAuth\"")
+			}
+		}
+		{
+
+			switch err := c.securityCookieAuth(ctx, GETV1NodesLogOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 1
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"CookieAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	result, err := decodeGETV1NodesLogResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// GETV1NodesPrometheusSd invokes GET_/v1/nodes/prometheus-sd operation.
+//
+// #### Controller:
+// `github.com/ubccr/grendel/internal/api.(*Handler).NodePrometheusSD`
+// #### Middlewares:
+// - `github.com/go-fuego/fuego.defaultLogger.middleware`
+// - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
+// - `github.com/ubccr/grendel/internal/api.validateMiddleware.func1`
+// ---
+// List nodes as Prometheus http_sd targets labeled with their name, boot image and tags. Key value
+// tags such as rack=a01 become labels.
+//
+// GET /v1/nodes/prometheus-sd
+func (c *Client) GETV1NodesPrometheusSd(ctx context.Context, params GETV1NodesPrometheusSdParams) ([]PrometheusTargetGroup, error) {
+	res, err := c.sendGETV1NodesPrometheusSd(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendGETV1NodesPrometheusSd(ctx context.Context, params GETV1NodesPrometheusSdParams) (res []PrometheusTargetGroup, err error) {
+
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/v1/nodes/prometheus-sd"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "nodeset" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "nodeset",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Nodeset.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "tags" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "tags",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Tags.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "port" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "port",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Port.Get(); ok {
+				return e.EncodeValue(conv.IntToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "exclude_provision" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "exclude_provision",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.ExcludeProvision.Get(); ok {
+				return e.EncodeValue(conv.BoolToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	h := uri.NewHeaderEncoder(r.Header)
+	{
+		cfg := uri.HeaderParameterEncodingConfig{
+			Name:    "Accept",
+			Explode: false,
+		}
+		if err := h.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Accept.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode header")
+		}
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+
+			switch err := c.securityHeaderAuth(ctx, GETV1NodesPrometheusSdOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"HeaderAuth\"")
+			}
+		}
+		{
+
+			switch err := c.securityCookieAuth(ctx, GETV1NodesPrometheusSdOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 1
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"CookieAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	result, err := decodeGETV1NodesPrometheusSdResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// GETV1NodesReservations invokes GET_/v1/nodes/reservations operation.
+//
+// #### Controller:
+// `github.com/ubccr/grendel/internal/api.(*Handler).NodeReservationList`
+// #### Middlewares:
+// - `github.com/go-fuego/fuego.defaultLogger.middleware`
+// - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
+// - `github.com/ubccr/grendel/internal/api.validateMiddleware.func1`
+// ---
+// List the address reservations and DHCP conflicts, expired ones included.
+//
+// GET /v1/nodes/reservations
+func (c *Client) GETV1NodesReservations(ctx context.Context, params GETV1NodesReservationsParams) ([]IPReservation, error) {
+	res, err := c.sendGETV1NodesReservations(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendGETV1NodesReservations(ctx context.Context, params GETV1NodesReservationsParams) (res []IPReservation, err error) {
+
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/v1/nodes/reservations"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	h := uri.NewHeaderEncoder(r.Header)
+	{
+		cfg := uri.HeaderParameterEncodingConfig{
+			Name:    "Accept",
+			Explode: false,
+		}
+		if err := h.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Accept.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode header")
+		}
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+
+			switch err := c.securityHeaderAuth(ctx, GETV1NodesReservationsOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"HeaderAuth\"")
+			}
+		}
+		{
+
+			switch err := c.securityCookieAuth(ctx, GETV1NodesReservationsOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 1
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"CookieAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	result, err := decodeGETV1NodesReservationsResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// GETV1NodesTokenInterface invokes GET_/v1/nodes/token/:interface operation.
+//
+// #### Controller:
+// `github.com/ubccr/grendel/internal/api.(*Handler).NodeBootToken`
+// #### Middlewares:
+// - `github.com/go-fuego/fuego.defaultLogger.middleware`
+// - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
+// - `github.com/ubccr/grendel/internal/api.validateMiddleware.func1`
+// ---
+// Create a boot token for the provision server. Used for debugging requests made by images.
+//
+// GET /v1/nodes/token/{interface}
+func (c *Client) GETV1NodesTokenInterface(ctx context.Context, params GETV1NodesTokenInterfaceParams) (*NodeBootTokenResponse, error) {
+	res, err := c.sendGETV1NodesTokenInterface(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendGETV1NodesTokenInterface(ctx context.Context, params GETV1NodesTokenInterfaceParams) (res *NodeBootTokenResponse, err error) {
+
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [2]string
+	pathParts[0] = "/v1/nodes/token/"
+	{
+		// Encode "interface" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "interface",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.Interface))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	uri.AddPathParts(u, pathParts[:]...)
+
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "nodeset" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "nodeset",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Nodeset.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "tags" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "tags",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Tags.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	h := uri.NewHeaderEncoder(r.Header)
+	{
+		cfg := uri.HeaderParameterEncodingConfig{
+			Name:    "Accept",
+			Explode: false,
+		}
+		if err := h.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Accept.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode header")
+		}
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+
+			switch err := c.securityHeaderAuth(ctx, GETV1NodesTokenInterfaceOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"HeaderAuth\"")
+			}
+		}
+		{
+
+			switch err := c.securityCookieAuth(ctx, GETV1NodesTokenInterfaceOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 1
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"CookieAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	result, err := decodeGETV1NodesTokenInterfaceResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// GETV1NodesTrash invokes GET_/v1/nodes/trash operation.
+//
+// #### Controller:
+// `github.com/ubccr/grendel/internal/api.(*Handler).NodeTrashList`
+// #### Middlewares:
+// - `github.com/go-fuego/fuego.defaultLogger.middleware`
+// - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
+// - `github.com/ubccr/grendel/internal/api.validateMiddleware.func1`
+// ---
+// List deleted nodes in the trash.
+//
+// GET /v1/nodes/trash
+func (c *Client) GETV1NodesTrash(ctx context.Context, params GETV1NodesTrashParams) ([]TrashedHost, error) {
+	res, err := c.sendGETV1NodesTrash(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendGETV1NodesTrash(ctx context.Context, params GETV1NodesTrashParams) (res []TrashedHost, err error) {
+
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/v1/nodes/trash"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	h := uri.NewHeaderEncoder(r.Header)
+	{
+		cfg := uri.HeaderParameterEncodingConfig{
+			Name:    "Accept",
+			Explode: false,
+		}
+		if err := h.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Accept.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode header")
+		}
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+
+			switch err := c.securityHeaderAuth(ctx, GETV1NodesTrashOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"HeaderAuth\"")
+			}
+		}
+		{
+
+			switch err := c.securityCookieAuth(ctx, GETV1NodesTrashOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 1
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"CookieAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	result, err := decodeGETV1NodesTrashResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// GETV1Roles invokes GET_/v1/roles operation.
+//
+// #### Controller:
+// `github.com/ubccr/grendel/internal/api.(*Handler).GetRoles`
+// #### Middlewares:
+// - `github.com/go-fuego/fuego.defaultLogger.middleware`
+// - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
+// - `github.com/ubccr/grendel/internal/api.validateMiddleware.func1`
+// ---
+// Get roles and permissions.
+//
+// GET /v1/roles
+func (c *Client) GETV1Roles(ctx context.Context, params GETV1RolesParams) (*GetRolesResponse, error) {
+	res, err := c.sendGETV1Roles(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendGETV1Roles(ctx context.Context, params GETV1RolesParams) (res *GetRolesResponse, err error) {
+
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/v1/roles"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "name" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "name",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Name.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	h := uri.NewHeaderEncoder(r.Header)
+	{
+		cfg := uri.HeaderParameterEncodingConfig{
+			Name:    "Accept",
+			Explode: false,
+		}
+		if err := h.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Accept.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode header")
+		}
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+
+			switch err := c.securityHeaderAuth(ctx, GETV1RolesOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"HeaderAuth\"")
+			}
+		}
+		{
+
+			switch err := c.securityCookieAuth(ctx, GETV1RolesOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 1
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"CookieAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	result, err := decodeGETV1RolesResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// GETV1Schedule invokes GET_/v1/schedule operation.
+//
+// #### Controller:
+// `github.com/ubccr/grendel/internal/api.(*Handler).ScheduleList`
+// #### Middlewares:
+// - `github.com/go-fuego/fuego.defaultLogger.middleware`
+// - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
+// - `github.com/ubccr/grendel/internal/api.validateMiddleware.func1`
+// ---
+// List the actions scheduled to run on nodes at a later time.
+//
+// GET /v1/schedule
+func (c *Client) GETV1Schedule(ctx context.Context, params GETV1ScheduleParams) ([]ScheduledAction, error) {
+	res, err := c.sendGETV1Schedule(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendGETV1Schedule(ctx context.Context, params GETV1ScheduleParams) (res []ScheduledAction, err error) {
+
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/v1/schedule"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "status" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "status",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Status.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	h := uri.NewHeaderEncoder(r.Header)
+	{
+		cfg := uri.HeaderParameterEncodingConfig{
+			Name:    "Accept",
+			Explode: false,
+		}
+		if err := h.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Accept.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode header")
+		}
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+
+			switch err := c.securityHeaderAuth(ctx, GETV1ScheduleOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"HeaderAuth\"")
+			}
+		}
+		{
+
+			switch err := c.securityCookieAuth(ctx, GETV1ScheduleOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 1
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"CookieAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	result, err := decodeGETV1ScheduleResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// GETV1ScheduleID invokes GET_/v1/schedule/:id operation.
+//
+// #### Controller:
+// `github.com/ubccr/grendel/internal/api.(*Handler).ScheduleGet`
+// #### Middlewares:
+// - `github.com/go-fuego/fuego.defaultLogger.middleware`
+// - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
+// - `github.com/ubccr/grendel/internal/api.validateMiddleware.func1`
+// ---
+// Get a scheduled action and, once it ran, the result of each node.
+//
+// GET /v1/schedule/{id}
+func (c *Client) GETV1ScheduleID(ctx context.Context, params GETV1ScheduleIDParams) (*ScheduledAction, error) {
+	res, err := c.sendGETV1ScheduleID(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendGETV1ScheduleID(ctx context.Context, params GETV1ScheduleIDParams) (res *ScheduledAction, err error) {
+
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [2]string
+	pathParts[0] = "/v1/schedule/"
+	{
+		// Encode "id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.ID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	uri.AddPathParts(u, pathParts[:]...)
+
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	h := uri.NewHeaderEncoder(r.Header)
+	{
+		cfg := uri.HeaderParameterEncodingConfig{
+			Name:    "Accept",
+			Explode: false,
+		}
+		if err := h.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Accept.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode header")
+		}
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+
+			switch err := c.securityHeaderAuth(ctx, GETV1ScheduleIDOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"HeaderAuth\"")
+			}
+		}
+		{
+
+			switch err := c.securityCookieAuth(ctx, GETV1ScheduleIDOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 1
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"CookieAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	result, err := decodeGETV1ScheduleIDResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// GETV1Secrets invokes GET_/v1/secrets operation.
+//
+// #### Controller:
+// `github.com/ubccr/grendel/internal/api.(*Handler).SecretList`
+// #### Middlewares:
+// - `github.com/go-fuego/fuego.defaultLogger.middleware`
+// - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
+// - `github.com/ubccr/grendel/internal/api.validateMiddleware.func1`
+// ---
+// List the secrets read by provision templates with {{ secret "name" }}. Values are never returned.
+//
+// GET /v1/secrets
+func (c *Client) GETV1Secrets(ctx context.Context, params GETV1SecretsParams) ([]Secret, error) {
+	res, err := c.sendGETV1Secrets(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendGETV1Secrets(ctx context.Context, params GETV1SecretsParams) (res []Secret, err error) {
+
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/v1/secrets"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	h := uri.NewHeaderEncoder(r.Header)
+	{
+		cfg := uri.HeaderParameterEncodingConfig{
+			Name:    "Accept",
+			Explode: false,
+		}
+		if err := h.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Accept.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode header")
+		}
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+
+			switch err := c.securityHeaderAuth(ctx, GETV1SecretsOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"HeaderAuth\"")
+			}
+		}
+		{
+
+			switch err := c.securityCookieAuth(ctx, GETV1SecretsOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 1
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"CookieAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	result, err := decodeGETV1SecretsResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// GETV1SwitchNodesetLldp invokes GET_/v1/switch/:nodeset/lldp operation.
+//
+// #### Controller:
+// `github.com/ubccr/grendel/internal/api.(*Handler).SwitchGetLLDP`
+// #### Middlewares:
+// - `github.com/go-fuego/fuego.defaultLogger.middleware`
+// - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
+// - `github.com/ubccr/grendel/internal/api.validateMiddleware.func1`
+// ---
+// Get switch LLDP info.
+//
+// GET /v1/switch/{nodeset}/lldp
+func (c *Client) GETV1SwitchNodesetLldp(ctx context.Context, params GETV1SwitchNodesetLldpParams) ([]LLDP, error) {
+	res, err := c.sendGETV1SwitchNodesetLldp(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendGETV1SwitchNodesetLldp(ctx context.Context, params GETV1SwitchNodesetLldpParams) (res []LLDP, err error) {
+
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [3]string
+	pathParts[0] = "/v1/switch/"
+	{
+		// Encode "nodeset" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "nodeset",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.Nodeset))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/lldp"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "ports" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "ports",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Ports.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	h := uri.NewHeaderEncoder(r.Header)
+	{
+		cfg := uri.HeaderParameterEncodingConfig{
+			Name:    "Accept",
+			Explode: false,
+		}
+		if err := h.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Accept.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode header")
+		}
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+
+			switch err := c.securityHeaderAuth(ctx, GETV1SwitchNodesetLldpOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"HeaderAuth\"")
+			}
+		}
+		{
+
+			switch err := c.securityCookieAuth(ctx, GETV1SwitchNodesetLldpOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 1
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"CookieAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	result, err := decodeGETV1SwitchNodesetLldpResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// GETV1SwitchNodesetVerify invokes GET_/v1/switch/:nodeset/verify operation.
+//
+// #### Controller:
+// `github.com/ubccr/grendel/internal/api.(*Handler).SwitchVerify`
+// #### Middlewares:
+// - `github.com/go-fuego/fuego.defaultLogger.middleware`
+// - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
+// - `github.com/ubccr/grendel/internal/api.validateMiddleware.func1`
+// ---
+// Compare switch LLDP neighbors with the switch and port stored on node interfaces.
+//
+// GET /v1/switch/{nodeset}/verify
+func (c *Client) GETV1SwitchNodesetVerify(ctx context.Context, params GETV1SwitchNodesetVerifyParams) (*SwitchVerifyResponse, error) {
+	res, err := c.sendGETV1SwitchNodesetVerify(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendGETV1SwitchNodesetVerify(ctx context.Context, params GETV1SwitchNodesetVerifyParams) (res *SwitchVerifyResponse, err error) {
+
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [3]string
+	pathParts[0] = "/v1/switch/"
+	{
+		// Encode "nodeset" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "nodeset",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.Nodeset))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/verify"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	h := uri.NewHeaderEncoder(r.Header)
+	{
+		cfg := uri.HeaderParameterEncodingConfig{
+			Name:    "Accept",
+			Explode: false,
+		}
+		if err := h.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Accept.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode header")
+		}
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+
+			switch err := c.securityHeaderAuth(ctx, GETV1SwitchNodesetVerifyOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"HeaderAuth\"")
+			}
+		}
+		{
+
+			switch err := c.securityCookieAuth(ctx, GETV1SwitchNodesetVerifyOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 1
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"CookieAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	result, err := decodeGETV1SwitchNodesetVerifyResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// GETV1Users invokes GET_/v1/users operation.
+//
+// #### Controller:
+// `github.com/ubccr/grendel/internal/api.(*Handler).UserList`
+// #### Middlewares:
+// - `github.com/go-fuego/fuego.defaultLogger.middleware`
+// - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
+// - `github.com/ubccr/grendel/internal/api.validateMiddleware.func1`
+// ---
+// List all users.
+//
+// GET /v1/users
+func (c *Client) GETV1Users(ctx context.Context, params GETV1UsersParams) ([]User, error) {
+	res, err := c.sendGETV1Users(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendGETV1Users(ctx context.Context, params GETV1UsersParams) (res []User, err error) {
+
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/v1/users"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "usernames" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "usernames",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Usernames.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	h := uri.NewHeaderEncoder(r.Header)
+	{
+		cfg := uri.HeaderParameterEncodingConfig{
+			Name:    "Accept",
+			Explode: false,
+		}
+		if err := h.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Accept.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode header")
+		}
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+
+			switch err := c.securityHeaderAuth(ctx, GETV1UsersOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"HeaderAuth\"")
+			}
+		}
+		{
+
+			switch err := c.securityCookieAuth(ctx, GETV1UsersOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 1
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"CookieAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	result, err := decodeGETV1UsersResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// PATCHV1AuthReset invokes PATCH_/v1/auth/reset operation.
+//
+// #### Controller:
+// `github.com/ubccr/grendel/internal/api.(*Handler).AuthReset`
+// #### Middlewares:
+// - `github.com/go-fuego/fuego.defaultLogger.middleware`
+// - `github.com/ubccr/grendel/internal/api.validateMiddleware.func1`
+// - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
+// ---
+// Change password.
+//
+// PATCH /v1/auth/reset
+func (c *Client) PATCHV1AuthReset(ctx context.Context, request *AuthResetRequest, params PATCHV1AuthResetParams) (*GenericResponse, error) {
+	res, err := c.sendPATCHV1AuthReset(ctx, request, params)
+	return res, err
+}
+
+func (c *Client) sendPATCHV1AuthReset(ctx context.Context, request *AuthResetRequest, params PATCHV1AuthResetParams) (res *GenericResponse, err error) {
+	// Validate request before sending.
+	if err := func() error {
+		if err := request.Validate(); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return res, errors.Wrap(err, "validate")
+	}
+
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/v1/auth/reset"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	r, err := ht.NewRequest(ctx, "PATCH", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+	if err := encodePATCHV1AuthResetRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	h := uri.NewHeaderEncoder(r.Header)
+	{
+		cfg := uri.HeaderParameterEncodingConfig{
+			Name:    "Accept",
+			Explode: false,
+		}
+		if err := h.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Accept.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode header")
+		}
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+
+			switch err := c.securityHeaderAuth(ctx, PATCHV1AuthResetOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"HeaderAuth\"")
+			}
+		}
+		{
+
+			switch err := c.securityCookieAuth(ctx, PATCHV1AuthResetOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 1
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"CookieAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	result, err := decodePATCHV1AuthResetResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// PATCHV1NodesFirmware invokes PATCH_/v1/nodes/firmware operation.
+//
+// #### Controller:
+// `github.com/ubccr/grendel/internal/api.(*Handler).NodeFirmware`
+// #### Middlewares:
+// - `github.com/go-fuego/fuego.defaultLogger.middleware`
+// - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
+// - `github.com/ubccr/grendel/internal/api.validateMiddleware.func1`
+// ---
+// Update nodes firmware by nodeset and/or tags. The firmware overrides the firmware of the client
+// architecture, the firmware.tags and the firmware.images config. An empty firmware clears it.
+//
+// PATCH /v1/nodes/firmware
+func (c *Client) PATCHV1NodesFirmware(ctx context.Context, request *NodeFirmwareRequest, params PATCHV1NodesFirmwareParams) (*GenericResponse, error) {
+	res, err := c.sendPATCHV1NodesFirmware(ctx, request, params)
+	return res, err
+}
+
+func (c *Client) sendPATCHV1NodesFirmware(ctx context.Context, request *NodeFirmwareRequest, params PATCHV1NodesFirmwareParams) (res *GenericResponse, err error) {
+
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/v1/nodes/firmware"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "nodeset" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "nodeset",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Nodeset.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "tags" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "tags",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Tags.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
+	r, err := ht.NewRequest(ctx, "PATCH", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+	if err := encodePATCHV1NodesFirmwareRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	h := uri.NewHeaderEncoder(r.Header)
+	{
+		cfg := uri.HeaderParameterEncodingConfig{
+			Name:    "Accept",
+			Explode: false,
+		}
+		if err := h.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Accept.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode header")
+		}
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+
+			switch err := c.securityHeaderAuth(ctx, PATCHV1NodesFirmwareOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"HeaderAuth\"")
+			}
+		}
+		{
+
+			switch err := c.securityCookieAuth(ctx, PATCHV1NodesFirmwareOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 1
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"CookieAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	result, err := decodePATCHV1NodesFirmwareResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// PATCHV1NodesImage invokes PATCH_/v1/nodes/image operation.
+//
+// #### Controller:
+// `github.com/ubccr/grendel/internal/api.(*Handler).NodeBootImage`
+// #### Middlewares:
+// - `github.com/go-fuego/fuego.defaultLogger.middleware`
+// - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
+// - `github.com/ubccr/grendel/internal/api.validateMiddleware.func1`
+// ---
+// Update nodes boot image by nodeset and/or tags. With only_if_current the nodes are changed only if
+// each is assigned one of the images, else 409 Conflict is answered.
+//
+// PATCH /v1/nodes/image
+func (c *Client) PATCHV1NodesImage(ctx context.Context, request *NodeBootImageRequest, params PATCHV1NodesImageParams) (*GenericResponse, error) {
+	res, err := c.sendPATCHV1NodesImage(ctx, request, params)
+	return res, err
+}
+
+func (c *Client) sendPATCHV1NodesImage(ctx context.Context, request *NodeBootImageRequest, params PATCHV1NodesImageParams) (res *GenericResponse, err error) {
+
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/v1/nodes/image"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "nodeset" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "nodeset",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Nodeset.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "tags" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "tags",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Tags.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
+	r, err := ht.NewRequest(ctx, "PATCH", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+	if err := encodePATCHV1NodesImageRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	h := uri.NewHeaderEncoder(r.Header)
+	{
+		cfg := uri.HeaderParameterEncodingConfig{
+			Name:    "Accept",
+			Explode: false,
+		}
+		if err := h.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Accept.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode header")
+		}
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+
+			switch err := c.securityHeaderAuth(ctx, PATCHV1NodesImageOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"HeaderAuth\"")
+			}
+		}
+		{
+
+			switch err := c.securityCookieAuth(ctx, PATCHV1NodesImageOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 1
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"CookieAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	result, err := decodePATCHV1NodesImageResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// PATCHV1NodesProvision invokes PATCH_/v1/nodes/provision operation.
+//
+// #### Controller:
+// `github.com/ubccr/grendel/internal/api.(*Handler).NodeProvision`
+// #### Middlewares:
+// - `github.com/go-fuego/fuego.defaultLogger.middleware`
+// - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
+// - `github.com/ubccr/grendel/internal/api.validateMiddleware.func1`
+// ---
+// Provision / Unprovision nodes by nodeset and/or tags.
+//
+// PATCH /v1/nodes/provision
+func (c *Client) PATCHV1NodesProvision(ctx context.Context, request *NodeProvisionRequest, params PATCHV1NodesProvisionParams) (*GenericResponse, error) {
+	res, err := c.sendPATCHV1NodesProvision(ctx, request, params)
+	return res, err
+}
+
+func (c *Client) sendPATCHV1NodesProvision(ctx context.Context, request *NodeProvisionRequest, params PATCHV1NodesProvisionParams) (res *GenericResponse, err error) {
+
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/v1/nodes/provision"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "nodeset" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "nodeset",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Nodeset.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "tags" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "tags",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Tags.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
+	r, err := ht.NewRequest(ctx, "PATCH", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+	if err := encodePATCHV1NodesProvisionRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	h := uri.NewHeaderEncoder(r.Header)
+	{
+		cfg := uri.HeaderParameterEncodingConfig{
+			Name:    "Accept",
+			Explode: false,
+		}
+		if err := h.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Accept.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode header")
+		}
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+
+			switch err := c.securityHeaderAuth(ctx, PATCHV1NodesProvisionOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"HeaderAuth\"")
+			}
+		}
+		{
+
+			switch err := c.securityCookieAuth(ctx, PATCHV1NodesProvisionOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 1
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"CookieAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	result, err := decodePATCHV1NodesProvisionResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// PATCHV1NodesRename invokes PATCH_/v1/nodes/rename operation.
+//
+// #### Controller:
+// `github.com/ubccr/grendel/internal/api.(*Handler).NodeRename`
+// #### Middlewares:
+// - `github.com/go-fuego/fuego.defaultLogger.middleware`
+// - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
+// - `github.com/ubccr/grendel/internal/api.validateMiddleware.func1`
+// ---
+// Rename a node and optionally rewrite its interface FQDNs.
+//
+// PATCH /v1/nodes/rename
+func (c *Client) PATCHV1NodesRename(ctx context.Context, request *NodeRenameRequest, params PATCHV1NodesRenameParams) (*GenericResponse, error) {
+	res, err := c.sendPATCHV1NodesRename(ctx, request, params)
+	return res, err
+}
+
+func (c *Client) sendPATCHV1NodesRename(ctx context.Context, request *NodeRenameRequest, params PATCHV1NodesRenameParams) (res *GenericResponse, err error) {
+
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/v1/nodes/rename"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	r, err := ht.NewRequest(ctx, "PATCH", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+	if err := encodePATCHV1NodesRenameRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	h := uri.NewHeaderEncoder(r.Header)
+	{
+		cfg := uri.HeaderParameterEncodingConfig{
+			Name:    "Accept",
+			Explode: false,
+		}
+		if err := h.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Accept.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode header")
+		}
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+
+			switch err := c.securityHeaderAuth(ctx, PATCHV1NodesRenameOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"HeaderAuth\"")
+			}
+		}
+		{
+
+			switch err := c.securityCookieAuth(ctx, PATCHV1NodesRenameOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 1
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"CookieAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	result, err := decodePATCHV1NodesRenameResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// PATCHV1NodesState invokes PATCH_/v1/nodes/state operation.
+//
+// #### Controller:
+// `github.com/ubccr/grendel/internal/api.(*Handler).NodeState`
+// #### Middlewares:
+// - `github.com/go-fuego/fuego.defaultLogger.middleware`
+// - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
+// - `github.com/ubccr/grendel/internal/api.validateMiddleware.func1`
+// ---
+// Set the lifecycle state of nodes by nodeset and/or tags. Returns 409 if a node may not move to the
+// state.
+//
+// PATCH /v1/nodes/state
+func (c *Client) PATCHV1NodesState(ctx context.Context, request *NodeStateRequest, params PATCHV1NodesStateParams) (*GenericResponse, error) {
+	res, err := c.sendPATCHV1NodesState(ctx, request, params)
+	return res, err
+}
+
+func (c *Client) sendPATCHV1NodesState(ctx context.Context, request *NodeStateRequest, params PATCHV1NodesStateParams) (res *GenericResponse, err error) {
+
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/v1/nodes/state"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "nodeset" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "nodeset",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Nodeset.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "tags" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "tags",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Tags.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
+	r, err := ht.NewRequest(ctx, "PATCH", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+	if err := encodePATCHV1NodesStateRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	h := uri.NewHeaderEncoder(r.Header)
+	{
+		cfg := uri.HeaderParameterEncodingConfig{
+			Name:    "Accept",
+			Explode: false,
+		}
+		if err := h.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Accept.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode header")
+		}
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+
+			switch err := c.securityHeaderAuth(ctx, PATCHV1NodesStateOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"HeaderAuth\"")
+			}
+		}
+		{
+
+			switch err := c.securityCookieAuth(ctx, PATCHV1NodesStateOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 1
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"CookieAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
 
-func (c *Client) requestURL(ctx context.Context) *url.URL {
-	u, ok := ctx.Value(serverURLKey{}).(*url.URL)
-	if !ok {
-		return c.serverURL
+	result, err := decodePATCHV1NodesStateResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
 	}
-	return u
+
+	return result, nil
 }
 
-// DELETEV1AuthSignout invokes DELETE_/v1/auth/signout operation.
+// PATCHV1NodesTagsAction invokes PATCH_/v1/nodes/tags/:action operation.
 //
 // #### Controller:
-// `github.com/ubccr/grendel/internal/api.(*Handler).AuthSignout`
+// `github.com/ubccr/grendel/internal/api.(*Handler).NodeTags`
 // #### Middlewares:
 // - `github.com/go-fuego/fuego.defaultLogger.middleware`
+// - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
+// - `github.com/ubccr/grendel/internal/api.validateMiddleware.func1`
 // ---
-// Signout user.
+// Update nodes tags by nodeset and/or tags.
 //
-// DELETE /v1/auth/signout
-func (c *Client) DELETEV1AuthSignout(ctx context.Context, params DELETEV1AuthSignoutParams) (*GenericResponse, error) {
-	res, err := c.sendDELETEV1AuthSignout(ctx, params)
+// PATCH /v1/nodes/tags/{action}
+func (c *Client) PATCHV1NodesTagsAction(ctx context.Context, request *NodeTagsRequest, params PATCHV1NodesTagsActionParams) (*GenericResponse, error) {
+	res, err := c.sendPATCHV1NodesTagsAction(ctx, request, params)
 	return res, err
 }
 
-func (c *Client) sendDELETEV1AuthSignout(ctx context.Context, params DELETEV1AuthSignoutParams) (res *GenericResponse, err error) {
+func (c *Client) sendPATCHV1NodesTagsAction(ctx context.Context, request *NodeTagsRequest, params PATCHV1NodesTagsActionParams) (res *GenericResponse, err error) {
 
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [1]string
-	pathParts[0] = "/v1/auth/signout"
+	var pathParts [2]string
+	pathParts[0] = "/v1/nodes/tags/"
+	{
+		// Encode "action" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "action",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.Action))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
 	uri.AddPathParts(u, pathParts[:]...)
 
-	r, err := ht.NewRequest(ctx, "DELETE", u)
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "nodeset" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "nodeset",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Nodeset.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "tags" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "tags",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Tags.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
+	r, err := ht.NewRequest(ctx, "PATCH", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
+	if err := encodePATCHV1NodesTagsActionRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
 
 	h := uri.NewHeaderEncoder(r.Header)
 	{
@@ -613,7 +10503,7 @@ func (c *Client) sendDELETEV1AuthSignout(ctx context.Context, params DELETEV1Aut
 		var satisfied bitset
 		{
 
-			switch err := c.securityHeaderAuth(ctx, DELETEV1AuthSignoutOperation, r); {
+			switch err := c.securityHeaderAuth(ctx, PATCHV1NodesTagsActionOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -624,7 +10514,7 @@ func (c *Client) sendDELETEV1AuthSignout(ctx context.Context, params DELETEV1Aut
 		}
 		{
 
-			switch err := c.securityCookieAuth(ctx, DELETEV1AuthSignoutOperation, r); {
+			switch err := c.securityCookieAuth(ctx, PATCHV1NodesTagsActionOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -639,7 +10529,6 @@ func (c *Client) sendDELETEV1AuthSignout(ctx context.Context, params DELETEV1Aut
 			for _, requirement := range []bitset{
 				{0b00000001},
 				{0b00000010},
-				{},
 			} {
 				for i, mask := range requirement {
 					if satisfied[i]&mask != mask {
@@ -660,7 +10549,7 @@ func (c *Client) sendDELETEV1AuthSignout(ctx context.Context, params DELETEV1Aut
 	}
 	defer resp.Body.Close()
 
-	result, err := decodeDELETEV1AuthSignoutResponse(resp)
+	result, err := decodePATCHV1NodesTagsActionResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -668,80 +10557,161 @@ func (c *Client) sendDELETEV1AuthSignout(ctx context.Context, params DELETEV1Aut
 	return result, nil
 }
 
-// DELETEV1BmcJobs invokes DELETE_/v1/bmc/jobs operation.
+// PATCHV1Roles invokes PATCH_/v1/roles operation.
 //
 // #### Controller:
-// `github.com/ubccr/grendel/internal/api.(*Handler).BmcJobDeleteMany`
+// `github.com/ubccr/grendel/internal/api.(*Handler).PatchRoles`
 // #### Middlewares:
 // - `github.com/go-fuego/fuego.defaultLogger.middleware`
 // - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
+// - `github.com/ubccr/grendel/internal/api.validateMiddleware.func1`
 // ---
-// Delete redfish jobs from many node(s).
+// Edit role permissions.
 //
-// DELETE /v1/bmc/jobs
-func (c *Client) DELETEV1BmcJobs(ctx context.Context, request *BmcJobDeleteRequest, params DELETEV1BmcJobsParams) ([]JobMessage, error) {
-	res, err := c.sendDELETEV1BmcJobs(ctx, request, params)
+// PATCH /v1/roles
+func (c *Client) PATCHV1Roles(ctx context.Context, request *PatchRolesRequest, params PATCHV1RolesParams) (*GenericResponse, error) {
+	res, err := c.sendPATCHV1Roles(ctx, request, params)
 	return res, err
 }
 
-func (c *Client) sendDELETEV1BmcJobs(ctx context.Context, request *BmcJobDeleteRequest, params DELETEV1BmcJobsParams) (res []JobMessage, err error) {
-	// Validate request before sending.
-	if err := func() error {
-		if err := request.Validate(); err != nil {
-			return err
-		}
-		return nil
-	}(); err != nil {
-		return res, errors.Wrap(err, "validate")
-	}
+func (c *Client) sendPATCHV1Roles(ctx context.Context, request *PatchRolesRequest, params PATCHV1RolesParams) (res *GenericResponse, err error) {
 
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [1]string
-	pathParts[0] = "/v1/bmc/jobs"
+	pathParts[0] = "/v1/roles"
 	uri.AddPathParts(u, pathParts[:]...)
 
-	q := uri.NewQueryEncoder()
+	r, err := ht.NewRequest(ctx, "PATCH", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+	if err := encodePATCHV1RolesRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	h := uri.NewHeaderEncoder(r.Header)
 	{
-		// Encode "nodeset" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "nodeset",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
+		cfg := uri.HeaderParameterEncodingConfig{
+			Name:    "Accept",
+			Explode: false,
 		}
-
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.Nodeset.Get(); ok {
+		if err := h.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Accept.Get(); ok {
 				return e.EncodeValue(conv.StringToString(val))
 			}
 			return nil
 		}); err != nil {
-			return res, errors.Wrap(err, "encode query")
+			return res, errors.Wrap(err, "encode header")
 		}
 	}
+
 	{
-		// Encode "tags" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "tags",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+
+			switch err := c.securityHeaderAuth(ctx, PATCHV1RolesOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"HeaderAuth\"")
+			}
 		}
+		{
 
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.Tags.Get(); ok {
-				return e.EncodeValue(conv.StringToString(val))
+			switch err := c.securityCookieAuth(ctx, PATCHV1RolesOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 1
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"CookieAuth\"")
 			}
-			return nil
-		}); err != nil {
-			return res, errors.Wrap(err, "encode query")
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
 		}
 	}
-	u.RawQuery = q.Values().Encode()
 
-	r, err := ht.NewRequest(ctx, "DELETE", u)
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	result, err := decodePATCHV1RolesResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// PATCHV1UsersUsernamesEnable invokes PATCH_/v1/users/:usernames/enable operation.
+//
+// #### Controller:
+// `github.com/ubccr/grendel/internal/api.(*Handler).UserEnable`
+// #### Middlewares:
+// - `github.com/go-fuego/fuego.defaultLogger.middleware`
+// - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
+// - `github.com/ubccr/grendel/internal/api.validateMiddleware.func1`
+// ---
+// Update users enable.
+//
+// PATCH /v1/users/{usernames}/enable
+func (c *Client) PATCHV1UsersUsernamesEnable(ctx context.Context, request *UserEnableRequest, params PATCHV1UsersUsernamesEnableParams) (*GenericResponse, error) {
+	res, err := c.sendPATCHV1UsersUsernamesEnable(ctx, request, params)
+	return res, err
+}
+
+func (c *Client) sendPATCHV1UsersUsernamesEnable(ctx context.Context, request *UserEnableRequest, params PATCHV1UsersUsernamesEnableParams) (res *GenericResponse, err error) {
+
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [3]string
+	pathParts[0] = "/v1/users/"
+	{
+		// Encode "usernames" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "usernames",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.Usernames))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/enable"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	r, err := ht.NewRequest(ctx, "PATCH", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
-	if err := encodeDELETEV1BmcJobsRequest(request, r); err != nil {
+	if err := encodePATCHV1UsersUsernamesEnableRequest(request, r); err != nil {
 		return res, errors.Wrap(err, "encode request")
 	}
 
@@ -766,7 +10736,7 @@ func (c *Client) sendDELETEV1BmcJobs(ctx context.Context, request *BmcJobDeleteR
 		var satisfied bitset
 		{
 
-			switch err := c.securityHeaderAuth(ctx, DELETEV1BmcJobsOperation, r); {
+			switch err := c.securityHeaderAuth(ctx, PATCHV1UsersUsernamesEnableOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -777,7 +10747,7 @@ func (c *Client) sendDELETEV1BmcJobs(ctx context.Context, request *BmcJobDeleteR
 		}
 		{
 
-			switch err := c.securityCookieAuth(ctx, DELETEV1BmcJobsOperation, r); {
+			switch err := c.securityCookieAuth(ctx, PATCHV1UsersUsernamesEnableOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -812,7 +10782,7 @@ func (c *Client) sendDELETEV1BmcJobs(ctx context.Context, request *BmcJobDeleteR
 	}
 	defer resp.Body.Close()
 
-	result, err := decodeDELETEV1BmcJobsResponse(resp)
+	result, err := decodePATCHV1UsersUsernamesEnableResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -820,36 +10790,37 @@ func (c *Client) sendDELETEV1BmcJobs(ctx context.Context, request *BmcJobDeleteR
 	return result, nil
 }
 
-// DELETEV1BmcJobsJids invokes DELETE_/v1/bmc/jobs/:jids operation.
+// PATCHV1UsersUsernamesRole invokes PATCH_/v1/users/:usernames/role operation.
 //
 // #### Controller:
-// `github.com/ubccr/grendel/internal/api.(*Handler).BmcJobDelete`
+// `github.com/ubccr/grendel/internal/api.(*Handler).UserRole`
 // #### Middlewares:
 // - `github.com/go-fuego/fuego.defaultLogger.middleware`
 // - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
+// - `github.com/ubccr/grendel/internal/api.validateMiddleware.func1`
 // ---
-// Delete redfish jobs from node(s) by JID.
+// Update users role.
 //
-// DELETE /v1/bmc/jobs/{jids}
-func (c *Client) DELETEV1BmcJobsJids(ctx context.Context, params DELETEV1BmcJobsJidsParams) ([]JobMessage, error) {
-	res, err := c.sendDELETEV1BmcJobsJids(ctx, params)
+// PATCH /v1/users/{usernames}/role
+func (c *Client) PATCHV1UsersUsernamesRole(ctx context.Context, request *UserRoleRequest, params PATCHV1UsersUsernamesRoleParams) (*GenericResponse, error) {
+	res, err := c.sendPATCHV1UsersUsernamesRole(ctx, request, params)
 	return res, err
 }
 
-func (c *Client) sendDELETEV1BmcJobsJids(ctx context.Context, params DELETEV1BmcJobsJidsParams) (res []JobMessage, err error) {
+func (c *Client) sendPATCHV1UsersUsernamesRole(ctx context.Context, request *UserRoleRequest, params PATCHV1UsersUsernamesRoleParams) (res *GenericResponse, err error) {
 
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [2]string
-	pathParts[0] = "/v1/bmc/jobs/"
+	var pathParts [3]string
+	pathParts[0] = "/v1/users/"
 	{
-		// Encode "jids" parameter.
+		// Encode "usernames" parameter.
 		e := uri.NewPathEncoder(uri.PathEncoderConfig{
-			Param:   "jids",
+			Param:   "usernames",
 			Style:   uri.PathStyleSimple,
 			Explode: false,
 		})
 		if err := func() error {
-			return e.EncodeValue(conv.StringToString(params.Jids))
+			return e.EncodeValue(conv.StringToString(params.Usernames))
 		}(); err != nil {
 			return res, errors.Wrap(err, "encode path")
 		}
@@ -859,49 +10830,16 @@ func (c *Client) sendDELETEV1BmcJobsJids(ctx context.Context, params DELETEV1Bmc
 		}
 		pathParts[1] = encoded
 	}
+	pathParts[2] = "/role"
 	uri.AddPathParts(u, pathParts[:]...)
 
-	q := uri.NewQueryEncoder()
-	{
-		// Encode "nodeset" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "nodeset",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
-
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.Nodeset.Get(); ok {
-				return e.EncodeValue(conv.StringToString(val))
-			}
-			return nil
-		}); err != nil {
-			return res, errors.Wrap(err, "encode query")
-		}
-	}
-	{
-		// Encode "tags" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "tags",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
-
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.Tags.Get(); ok {
-				return e.EncodeValue(conv.StringToString(val))
-			}
-			return nil
-		}); err != nil {
-			return res, errors.Wrap(err, "encode query")
-		}
-	}
-	u.RawQuery = q.Values().Encode()
-
-	r, err := ht.NewRequest(ctx, "DELETE", u)
+	r, err := ht.NewRequest(ctx, "PATCH", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
+	if err := encodePATCHV1UsersUsernamesRoleRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
 
 	h := uri.NewHeaderEncoder(r.Header)
 	{
@@ -924,7 +10862,7 @@ func (c *Client) sendDELETEV1BmcJobsJids(ctx context.Context, params DELETEV1Bmc
 		var satisfied bitset
 		{
 
-			switch err := c.securityHeaderAuth(ctx, DELETEV1BmcJobsJidsOperation, r); {
+			switch err := c.securityHeaderAuth(ctx, PATCHV1UsersUsernamesRoleOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -935,7 +10873,7 @@ func (c *Client) sendDELETEV1BmcJobsJids(ctx context.Context, params DELETEV1Bmc
 		}
 		{
 
-			switch err := c.securityCookieAuth(ctx, DELETEV1BmcJobsJidsOperation, r); {
+			switch err := c.securityCookieAuth(ctx, PATCHV1UsersUsernamesRoleOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -970,7 +10908,7 @@ func (c *Client) sendDELETEV1BmcJobsJids(ctx context.Context, params DELETEV1Bmc
 	}
 	defer resp.Body.Close()
 
-	result, err := decodeDELETEV1BmcJobsJidsResponse(resp)
+	result, err := decodePATCHV1UsersUsernamesRoleResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -978,70 +10916,36 @@ func (c *Client) sendDELETEV1BmcJobsJids(ctx context.Context, params DELETEV1Bmc
 	return result, nil
 }
 
-// DELETEV1BmcSel invokes DELETE_/v1/bmc/sel operation.
+// POSTV1AuthSignin invokes POST_/v1/auth/signin operation.
 //
 // #### Controller:
-// `github.com/ubccr/grendel/internal/api.(*Handler).BmcSelClear`
+// `github.com/ubccr/grendel/internal/api.(*Handler).AuthSignin`
 // #### Middlewares:
 // - `github.com/go-fuego/fuego.defaultLogger.middleware`
-// - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
+// - `github.com/ubccr/grendel/internal/api.validateMiddleware.func1`
 // ---
-// Clear system event log on node(s).
+// signin user.
 //
-// DELETE /v1/bmc/sel
-func (c *Client) DELETEV1BmcSel(ctx context.Context, params DELETEV1BmcSelParams) ([]JobMessage, error) {
-	res, err := c.sendDELETEV1BmcSel(ctx, params)
+// POST /v1/auth/signin
+func (c *Client) POSTV1AuthSignin(ctx context.Context, request *AuthRequest, params POSTV1AuthSigninParams) (*AuthResponse, error) {
+	res, err := c.sendPOSTV1AuthSignin(ctx, request, params)
 	return res, err
 }
 
-func (c *Client) sendDELETEV1BmcSel(ctx context.Context, params DELETEV1BmcSelParams) (res []JobMessage, err error) {
+func (c *Client) sendPOSTV1AuthSignin(ctx context.Context, request *AuthRequest, params POSTV1AuthSigninParams) (res *AuthResponse, err error) {
 
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [1]string
-	pathParts[0] = "/v1/bmc/sel"
+	pathParts[0] = "/v1/auth/signin"
 	uri.AddPathParts(u, pathParts[:]...)
 
-	q := uri.NewQueryEncoder()
-	{
-		// Encode "nodeset" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "nodeset",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
-
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.Nodeset.Get(); ok {
-				return e.EncodeValue(conv.StringToString(val))
-			}
-			return nil
-		}); err != nil {
-			return res, errors.Wrap(err, "encode query")
-		}
-	}
-	{
-		// Encode "tags" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "tags",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
-
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.Tags.Get(); ok {
-				return e.EncodeValue(conv.StringToString(val))
-			}
-			return nil
-		}); err != nil {
-			return res, errors.Wrap(err, "encode query")
-		}
-	}
-	u.RawQuery = q.Values().Encode()
-
-	r, err := ht.NewRequest(ctx, "DELETE", u)
+	r, err := ht.NewRequest(ctx, "POST", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
+	if err := encodePOSTV1AuthSigninRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
 
 	h := uri.NewHeaderEncoder(r.Header)
 	{
@@ -1064,7 +10968,7 @@ func (c *Client) sendDELETEV1BmcSel(ctx context.Context, params DELETEV1BmcSelPa
 		var satisfied bitset
 		{
 
-			switch err := c.securityHeaderAuth(ctx, DELETEV1BmcSelOperation, r); {
+			switch err := c.securityHeaderAuth(ctx, POSTV1AuthSigninOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -1075,7 +10979,7 @@ func (c *Client) sendDELETEV1BmcSel(ctx context.Context, params DELETEV1BmcSelPa
 		}
 		{
 
-			switch err := c.securityCookieAuth(ctx, DELETEV1BmcSelOperation, r); {
+			switch err := c.securityCookieAuth(ctx, POSTV1AuthSigninOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -1090,6 +10994,7 @@ func (c *Client) sendDELETEV1BmcSel(ctx context.Context, params DELETEV1BmcSelPa
 			for _, requirement := range []bitset{
 				{0b00000001},
 				{0b00000010},
+				{},
 			} {
 				for i, mask := range requirement {
 					if satisfied[i]&mask != mask {
@@ -1110,7 +11015,7 @@ func (c *Client) sendDELETEV1BmcSel(ctx context.Context, params DELETEV1BmcSelPa
 	}
 	defer resp.Body.Close()
 
-	result, err := decodeDELETEV1BmcSelResponse(resp)
+	result, err := decodePOSTV1AuthSigninResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -1118,53 +11023,45 @@ func (c *Client) sendDELETEV1BmcSel(ctx context.Context, params DELETEV1BmcSelPa
 	return result, nil
 }
 
-// DELETEV1Images invokes DELETE_/v1/images operation.
+// POSTV1AuthSignup invokes POST_/v1/auth/signup operation.
 //
 // #### Controller:
-// `github.com/ubccr/grendel/internal/api.(*Handler).BootImageDelete`
+// `github.com/ubccr/grendel/internal/api.(*Handler).AuthSignup`
 // #### Middlewares:
 // - `github.com/go-fuego/fuego.defaultLogger.middleware`
-// - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
+// - `github.com/ubccr/grendel/internal/api.validateMiddleware.func1`
 // ---
-// Delete images by name.
+// Signup user.
 //
-// DELETE /v1/images
-func (c *Client) DELETEV1Images(ctx context.Context, params DELETEV1ImagesParams) (*GenericResponse, error) {
-	res, err := c.sendDELETEV1Images(ctx, params)
+// POST /v1/auth/signup
+func (c *Client) POSTV1AuthSignup(ctx context.Context, request *AuthSignupRequest, params POSTV1AuthSignupParams) (*AuthResponse, error) {
+	res, err := c.sendPOSTV1AuthSignup(ctx, request, params)
 	return res, err
 }
 
-func (c *Client) sendDELETEV1Images(ctx context.Context, params DELETEV1ImagesParams) (res *GenericResponse, err error) {
+func (c *Client) sendPOSTV1AuthSignup(ctx context.Context, request *AuthSignupRequest, params POSTV1AuthSignupParams) (res *AuthResponse, err error) {
+	// Validate request before sending.
+	if err := func() error {
+		if err := request.Validate(); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return res, errors.Wrap(err, "validate")
+	}
 
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [1]string
-	pathParts[0] = "/v1/images"
+	pathParts[0] = "/v1/auth/signup"
 	uri.AddPathParts(u, pathParts[:]...)
 
-	q := uri.NewQueryEncoder()
-	{
-		// Encode "names" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "names",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
-
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.Names.Get(); ok {
-				return e.EncodeValue(conv.StringToString(val))
-			}
-			return nil
-		}); err != nil {
-			return res, errors.Wrap(err, "encode query")
-		}
-	}
-	u.RawQuery = q.Values().Encode()
-
-	r, err := ht.NewRequest(ctx, "DELETE", u)
+	r, err := ht.NewRequest(ctx, "POST", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
+	if err := encodePOSTV1AuthSignupRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
 
 	h := uri.NewHeaderEncoder(r.Header)
 	{
@@ -1187,7 +11084,7 @@ func (c *Client) sendDELETEV1Images(ctx context.Context, params DELETEV1ImagesPa
 		var satisfied bitset
 		{
 
-			switch err := c.securityHeaderAuth(ctx, DELETEV1ImagesOperation, r); {
+			switch err := c.securityHeaderAuth(ctx, POSTV1AuthSignupOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -1198,7 +11095,7 @@ func (c *Client) sendDELETEV1Images(ctx context.Context, params DELETEV1ImagesPa
 		}
 		{
 
-			switch err := c.securityCookieAuth(ctx, DELETEV1ImagesOperation, r); {
+			switch err := c.securityCookieAuth(ctx, POSTV1AuthSignupOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -1213,6 +11110,7 @@ func (c *Client) sendDELETEV1Images(ctx context.Context, params DELETEV1ImagesPa
 			for _, requirement := range []bitset{
 				{0b00000001},
 				{0b00000010},
+				{},
 			} {
 				for i, mask := range requirement {
 					if satisfied[i]&mask != mask {
@@ -1233,7 +11131,7 @@ func (c *Client) sendDELETEV1Images(ctx context.Context, params DELETEV1ImagesPa
 	}
 	defer resp.Body.Close()
 
-	result, err := decodeDELETEV1ImagesResponse(resp)
+	result, err := decodePOSTV1AuthSignupResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -1241,70 +11139,46 @@ func (c *Client) sendDELETEV1Images(ctx context.Context, params DELETEV1ImagesPa
 	return result, nil
 }
 
-// DELETEV1Nodes invokes DELETE_/v1/nodes operation.
+// POSTV1AuthToken invokes POST_/v1/auth/token operation.
 //
 // #### Controller:
-// `github.com/ubccr/grendel/internal/api.(*Handler).NodeDelete`
+// `github.com/ubccr/grendel/internal/api.(*Handler).AuthToken`
 // #### Middlewares:
 // - `github.com/go-fuego/fuego.defaultLogger.middleware`
+// - `github.com/ubccr/grendel/internal/api.validateMiddleware.func1`
 // - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
 // ---
-// Delete nodes by nodeset and/or tags.
+// Create API token.
 //
-// DELETE /v1/nodes
-func (c *Client) DELETEV1Nodes(ctx context.Context, params DELETEV1NodesParams) (*GenericResponse, error) {
-	res, err := c.sendDELETEV1Nodes(ctx, params)
+// POST /v1/auth/token
+func (c *Client) POSTV1AuthToken(ctx context.Context, request *AuthTokenRequest, params POSTV1AuthTokenParams) (*AuthTokenReponse, error) {
+	res, err := c.sendPOSTV1AuthToken(ctx, request, params)
 	return res, err
 }
 
-func (c *Client) sendDELETEV1Nodes(ctx context.Context, params DELETEV1NodesParams) (res *GenericResponse, err error) {
-
-	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [1]string
-	pathParts[0] = "/v1/nodes"
-	uri.AddPathParts(u, pathParts[:]...)
-
-	q := uri.NewQueryEncoder()
-	{
-		// Encode "nodeset" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "nodeset",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
-
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.Nodeset.Get(); ok {
-				return e.EncodeValue(conv.StringToString(val))
-			}
-			return nil
-		}); err != nil {
-			return res, errors.Wrap(err, "encode query")
-		}
-	}
-	{
-		// Encode "tags" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "tags",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
-
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.Tags.Get(); ok {
-				return e.EncodeValue(conv.StringToString(val))
-			}
-			return nil
-		}); err != nil {
-			return res, errors.Wrap(err, "encode query")
+func (c *Client) sendPOSTV1AuthToken(ctx context.Context, request *AuthTokenRequest, params POSTV1AuthTokenParams) (res *AuthTokenReponse, err error) {
+	// Validate request before sending.
+	if err := func() error {
+		if err := request.Validate(); err != nil {
+			return err
 		}
+		return nil
+	}(); err != nil {
+		return res, errors.Wrap(err, "validate")
 	}
-	u.RawQuery = q.Values().Encode()
 
-	r, err := ht.NewRequest(ctx, "DELETE", u)
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/v1/auth/token"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	r, err := ht.NewRequest(ctx, "POST", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
+	if err := encodePOSTV1AuthTokenRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
 
 	h := uri.NewHeaderEncoder(r.Header)
 	{
@@ -1327,7 +11201,7 @@ func (c *Client) sendDELETEV1Nodes(ctx context.Context, params DELETEV1NodesPara
 		var satisfied bitset
 		{
 
-			switch err := c.securityHeaderAuth(ctx, DELETEV1NodesOperation, r); {
+			switch err := c.securityHeaderAuth(ctx, POSTV1AuthTokenOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -1338,7 +11212,7 @@ func (c *Client) sendDELETEV1Nodes(ctx context.Context, params DELETEV1NodesPara
 		}
 		{
 
-			switch err := c.securityCookieAuth(ctx, DELETEV1NodesOperation, r); {
+			switch err := c.securityCookieAuth(ctx, POSTV1AuthTokenOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -1373,7 +11247,7 @@ func (c *Client) sendDELETEV1Nodes(ctx context.Context, params DELETEV1NodesPara
 	}
 	defer resp.Body.Close()
 
-	result, err := decodeDELETEV1NodesResponse(resp)
+	result, err := decodePOSTV1AuthTokenResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -1381,51 +11255,75 @@ func (c *Client) sendDELETEV1Nodes(ctx context.Context, params DELETEV1NodesPara
 	return result, nil
 }
 
-// DELETEV1RolesNames invokes DELETE_/v1/roles/:names operation.
+// POSTV1BmcBios invokes POST_/v1/bmc/bios operation.
 //
 // #### Controller:
-// `github.com/ubccr/grendel/internal/api.(*Handler).DeleteRoles`
+// `github.com/ubccr/grendel/internal/api.(*Handler).BmcBiosApply`
 // #### Middlewares:
 // - `github.com/go-fuego/fuego.defaultLogger.middleware`
 // - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
+// - `github.com/ubccr/grendel/internal/api.validateMiddleware.func1`
 // ---
-// Delete roles.
+// Set the BIOS attributes of node(s) which differ from a BIOS profile to apply on the next reboot
+// and report the nodes requiring a reboot.
 //
-// DELETE /v1/roles/{names}
-func (c *Client) DELETEV1RolesNames(ctx context.Context, params DELETEV1RolesNamesParams) (*GenericResponse, error) {
-	res, err := c.sendDELETEV1RolesNames(ctx, params)
+// POST /v1/bmc/bios
+func (c *Client) POSTV1BmcBios(ctx context.Context, request *BmcBiosApplyBody, params POSTV1BmcBiosParams) ([]BiosReport, error) {
+	res, err := c.sendPOSTV1BmcBios(ctx, request, params)
 	return res, err
 }
 
-func (c *Client) sendDELETEV1RolesNames(ctx context.Context, params DELETEV1RolesNamesParams) (res *GenericResponse, err error) {
+func (c *Client) sendPOSTV1BmcBios(ctx context.Context, request *BmcBiosApplyBody, params POSTV1BmcBiosParams) (res []BiosReport, err error) {
 
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [2]string
-	pathParts[0] = "/v1/roles/"
+	var pathParts [1]string
+	pathParts[0] = "/v1/bmc/bios"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	q := uri.NewQueryEncoder()
 	{
-		// Encode "names" parameter.
-		e := uri.NewPathEncoder(uri.PathEncoderConfig{
-			Param:   "names",
-			Style:   uri.PathStyleSimple,
-			Explode: false,
-		})
-		if err := func() error {
-			return e.EncodeValue(conv.StringToString(params.Names))
-		}(); err != nil {
-			return res, errors.Wrap(err, "encode path")
+		// Encode "nodeset" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "nodeset",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
 		}
-		encoded, err := e.Result()
-		if err != nil {
-			return res, errors.Wrap(err, "encode path")
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Nodeset.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
 		}
-		pathParts[1] = encoded
 	}
-	uri.AddPathParts(u, pathParts[:]...)
+	{
+		// Encode "tags" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "tags",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
 
-	r, err := ht.NewRequest(ctx, "DELETE", u)
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Tags.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
+	r, err := ht.NewRequest(ctx, "POST", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
+	if err := encodePOSTV1BmcBiosRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
 
 	h := uri.NewHeaderEncoder(r.Header)
 	{
@@ -1448,7 +11346,7 @@ func (c *Client) sendDELETEV1RolesNames(ctx context.Context, params DELETEV1Role
 		var satisfied bitset
 		{
 
-			switch err := c.securityHeaderAuth(ctx, DELETEV1RolesNamesOperation, r); {
+			switch err := c.securityHeaderAuth(ctx, POSTV1BmcBiosOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -1459,7 +11357,7 @@ func (c *Client) sendDELETEV1RolesNames(ctx context.Context, params DELETEV1Role
 		}
 		{
 
-			switch err := c.securityCookieAuth(ctx, DELETEV1RolesNamesOperation, r); {
+			switch err := c.securityCookieAuth(ctx, POSTV1BmcBiosOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -1494,7 +11392,7 @@ func (c *Client) sendDELETEV1RolesNames(ctx context.Context, params DELETEV1Role
 	}
 	defer resp.Body.Close()
 
-	result, err := decodeDELETEV1RolesNamesResponse(resp)
+	result, err := decodePOSTV1BmcBiosResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -1502,51 +11400,75 @@ func (c *Client) sendDELETEV1RolesNames(ctx context.Context, params DELETEV1Role
 	return result, nil
 }
 
-// DELETEV1UsersUsernames invokes DELETE_/v1/users/:usernames operation.
+// POSTV1BmcBoot invokes POST_/v1/bmc/boot operation.
 //
 // #### Controller:
-// `github.com/ubccr/grendel/internal/api.(*Handler).UserDelete`
+// `github.com/ubccr/grendel/internal/api.(*Handler).BmcBootOverride`
 // #### Middlewares:
 // - `github.com/go-fuego/fuego.defaultLogger.middleware`
 // - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
+// - `github.com/ubccr/grendel/internal/api.validateMiddleware.func1`
 // ---
-// Delete users.
+// Set the redfish boot source override of node(s) for the next boot, or every boot if persistent,
+// and verify it was applied.
 //
-// DELETE /v1/users/{usernames}
-func (c *Client) DELETEV1UsersUsernames(ctx context.Context, params DELETEV1UsersUsernamesParams) (*GenericResponse, error) {
-	res, err := c.sendDELETEV1UsersUsernames(ctx, params)
+// POST /v1/bmc/boot
+func (c *Client) POSTV1BmcBoot(ctx context.Context, request *BmcBootOverrideBody, params POSTV1BmcBootParams) ([]JobMessage, error) {
+	res, err := c.sendPOSTV1BmcBoot(ctx, request, params)
 	return res, err
 }
 
-func (c *Client) sendDELETEV1UsersUsernames(ctx context.Context, params DELETEV1UsersUsernamesParams) (res *GenericResponse, err error) {
+func (c *Client) sendPOSTV1BmcBoot(ctx context.Context, request *BmcBootOverrideBody, params POSTV1BmcBootParams) (res []JobMessage, err error) {
 
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [2]string
-	pathParts[0] = "/v1/users/"
+	var pathParts [1]string
+	pathParts[0] = "/v1/bmc/boot"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	q := uri.NewQueryEncoder()
 	{
-		// Encode "usernames" parameter.
-		e := uri.NewPathEncoder(uri.PathEncoderConfig{
-			Param:   "usernames",
-			Style:   uri.PathStyleSimple,
-			Explode: false,
-		})
-		if err := func() error {
-			return e.EncodeValue(conv.StringToString(params.Usernames))
-		}(); err != nil {
-			return res, errors.Wrap(err, "encode path")
+		// Encode "nodeset" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "nodeset",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
 		}
-		encoded, err := e.Result()
-		if err != nil {
-			return res, errors.Wrap(err, "encode path")
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Nodeset.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
 		}
-		pathParts[1] = encoded
 	}
-	uri.AddPathParts(u, pathParts[:]...)
+	{
+		// Encode "tags" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "tags",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
 
-	r, err := ht.NewRequest(ctx, "DELETE", u)
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Tags.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
+	r, err := ht.NewRequest(ctx, "POST", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
+	if err := encodePOSTV1BmcBootRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
 
 	h := uri.NewHeaderEncoder(r.Header)
 	{
@@ -1569,7 +11491,7 @@ func (c *Client) sendDELETEV1UsersUsernames(ctx context.Context, params DELETEV1
 		var satisfied bitset
 		{
 
-			switch err := c.securityHeaderAuth(ctx, DELETEV1UsersUsernamesOperation, r); {
+			switch err := c.securityHeaderAuth(ctx, POSTV1BmcBootOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -1580,7 +11502,7 @@ func (c *Client) sendDELETEV1UsersUsernames(ctx context.Context, params DELETEV1
 		}
 		{
 
-			switch err := c.securityCookieAuth(ctx, DELETEV1UsersUsernamesOperation, r); {
+			switch err := c.securityCookieAuth(ctx, POSTV1BmcBootOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -1615,7 +11537,7 @@ func (c *Client) sendDELETEV1UsersUsernames(ctx context.Context, params DELETEV1
 	}
 	defer resp.Body.Close()
 
-	result, err := decodeDELETEV1UsersUsernamesResponse(resp)
+	result, err := decodePOSTV1BmcBootResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -1623,27 +11545,28 @@ func (c *Client) sendDELETEV1UsersUsernames(ctx context.Context, params DELETEV1
 	return result, nil
 }
 
-// GETV1Bmc invokes GET_/v1/bmc operation.
+// POSTV1BmcConfigureAuto invokes POST_/v1/bmc/configure/auto operation.
 //
 // #### Controller:
-// `github.com/ubccr/grendel/internal/api.(*Handler).BmcQuery`
+// `github.com/ubccr/grendel/internal/api.(*Handler).BmcAutoConfigure`
 // #### Middlewares:
 // - `github.com/go-fuego/fuego.defaultLogger.middleware`
 // - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
+// - `github.com/ubccr/grendel/internal/api.validateMiddleware.func1`
 // ---
-// Get redfish info from node(s).
+// Set BMC to autoconfigure.
 //
-// GET /v1/bmc
-func (c *Client) GETV1Bmc(ctx context.Context, params GETV1BmcParams) ([]RedfishSystem, error) {
-	res, err := c.sendGETV1Bmc(ctx, params)
+// POST /v1/bmc/configure/auto
+func (c *Client) POSTV1BmcConfigureAuto(ctx context.Context, params POSTV1BmcConfigureAutoParams) ([]JobMessage, error) {
+	res, err := c.sendPOSTV1BmcConfigureAuto(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendGETV1Bmc(ctx context.Context, params GETV1BmcParams) (res []RedfishSystem, err error) {
+func (c *Client) sendPOSTV1BmcConfigureAuto(ctx context.Context, params POSTV1BmcConfigureAutoParams) (res []JobMessage, err error) {
 
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [1]string
-	pathParts[0] = "/v1/bmc"
+	pathParts[0] = "/v1/bmc/configure/auto"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	q := uri.NewQueryEncoder()
@@ -1683,7 +11606,7 @@ func (c *Client) sendGETV1Bmc(ctx context.Context, params GETV1BmcParams) (res [
 	}
 	u.RawQuery = q.Values().Encode()
 
-	r, err := ht.NewRequest(ctx, "GET", u)
+	r, err := ht.NewRequest(ctx, "POST", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
@@ -1709,7 +11632,7 @@ func (c *Client) sendGETV1Bmc(ctx context.Context, params GETV1BmcParams) (res [
 		var satisfied bitset
 		{
 
-			switch err := c.securityHeaderAuth(ctx, GETV1BmcOperation, r); {
+			switch err := c.securityHeaderAuth(ctx, POSTV1BmcConfigureAutoOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -1720,7 +11643,7 @@ func (c *Client) sendGETV1Bmc(ctx context.Context, params GETV1BmcParams) (res [
 		}
 		{
 
-			switch err := c.securityCookieAuth(ctx, GETV1BmcOperation, r); {
+			switch err := c.securityCookieAuth(ctx, POSTV1BmcConfigureAutoOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -1755,7 +11678,7 @@ func (c *Client) sendGETV1Bmc(ctx context.Context, params GETV1BmcParams) (res [
 	}
 	defer resp.Body.Close()
 
-	result, err := decodeGETV1BmcResponse(resp)
+	result, err := decodePOSTV1BmcConfigureAutoResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -1763,27 +11686,28 @@ func (c *Client) sendGETV1Bmc(ctx context.Context, params GETV1BmcParams) (res [
 	return result, nil
 }
 
-// GETV1BmcJobs invokes GET_/v1/bmc/jobs operation.
+// POSTV1BmcConfigureImport invokes POST_/v1/bmc/configure/import operation.
 //
 // #### Controller:
-// `github.com/ubccr/grendel/internal/api.(*Handler).BmcJobList`
+// `github.com/ubccr/grendel/internal/api.(*Handler).BmcImportConfiguration`
 // #### Middlewares:
 // - `github.com/go-fuego/fuego.defaultLogger.middleware`
 // - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
+// - `github.com/ubccr/grendel/internal/api.validateMiddleware.func1`
 // ---
-// Get redfish jobs from node(s).
+// Manually import system configuration to BMC.
 //
-// GET /v1/bmc/jobs
-func (c *Client) GETV1BmcJobs(ctx context.Context, params GETV1BmcJobsParams) ([]RedfishJob, error) {
-	res, err := c.sendGETV1BmcJobs(ctx, params)
+// POST /v1/bmc/configure/import
+func (c *Client) POSTV1BmcConfigureImport(ctx context.Context, request *BmcImportConfigurationRequest, params POSTV1BmcConfigureImportParams) ([]JobMessage, error) {
+	res, err := c.sendPOSTV1BmcConfigureImport(ctx, request, params)
 	return res, err
 }
 
-func (c *Client) sendGETV1BmcJobs(ctx context.Context, params GETV1BmcJobsParams) (res []RedfishJob, err error) {
+func (c *Client) sendPOSTV1BmcConfigureImport(ctx context.Context, request *BmcImportConfigurationRequest, params POSTV1BmcConfigureImportParams) (res []JobMessage, err error) {
 
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [1]string
-	pathParts[0] = "/v1/bmc/jobs"
+	pathParts[0] = "/v1/bmc/configure/import"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	q := uri.NewQueryEncoder()
@@ -1823,10 +11747,13 @@ func (c *Client) sendGETV1BmcJobs(ctx context.Context, params GETV1BmcJobsParams
 	}
 	u.RawQuery = q.Values().Encode()
 
-	r, err := ht.NewRequest(ctx, "GET", u)
+	r, err := ht.NewRequest(ctx, "POST", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
+	if err := encodePOSTV1BmcConfigureImportRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
 
 	h := uri.NewHeaderEncoder(r.Header)
 	{
@@ -1849,7 +11776,7 @@ func (c *Client) sendGETV1BmcJobs(ctx context.Context, params GETV1BmcJobsParams
 		var satisfied bitset
 		{
 
-			switch err := c.securityHeaderAuth(ctx, GETV1BmcJobsOperation, r); {
+			switch err := c.securityHeaderAuth(ctx, POSTV1BmcConfigureImportOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -1860,7 +11787,7 @@ func (c *Client) sendGETV1BmcJobs(ctx context.Context, params GETV1BmcJobsParams
 		}
 		{
 
-			switch err := c.securityCookieAuth(ctx, GETV1BmcJobsOperation, r); {
+			switch err := c.securityCookieAuth(ctx, POSTV1BmcConfigureImportOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -1895,7 +11822,7 @@ func (c *Client) sendGETV1BmcJobs(ctx context.Context, params GETV1BmcJobsParams
 	}
 	defer resp.Body.Close()
 
-	result, err := decodeGETV1BmcJobsResponse(resp)
+	result, err := decodePOSTV1BmcConfigureImportResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -1903,27 +11830,29 @@ func (c *Client) sendGETV1BmcJobs(ctx context.Context, params GETV1BmcJobsParams
 	return result, nil
 }
 
-// GETV1BmcMetrics invokes GET_/v1/bmc/metrics operation.
+// POSTV1BmcFwupdate invokes POST_/v1/bmc/fwupdate operation.
 //
 // #### Controller:
-// `github.com/ubccr/grendel/internal/api.(*Handler).BmcMetricReports`
+// `github.com/ubccr/grendel/internal/api.(*Handler).BmcFirmwareUpdate`
 // #### Middlewares:
 // - `github.com/go-fuego/fuego.defaultLogger.middleware`
 // - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
+// - `github.com/ubccr/grendel/internal/api.validateMiddleware.func1`
 // ---
-// Get metric reports by nodeset.
+// Update the firmware of node(s) with the Redfish UpdateService, wait for the update task and verify
+// the new version. Nodes already on the version are skipped.
 //
-// GET /v1/bmc/metrics
-func (c *Client) GETV1BmcMetrics(ctx context.Context, params GETV1BmcMetricsParams) ([]RedfishMetricReport, error) {
-	res, err := c.sendGETV1BmcMetrics(ctx, params)
+// POST /v1/bmc/fwupdate
+func (c *Client) POSTV1BmcFwupdate(ctx context.Context, request *BmcFirmwareUpdateBody, params POSTV1BmcFwupdateParams) ([]FirmwareUpdateReport, error) {
+	res, err := c.sendPOSTV1BmcFwupdate(ctx, request, params)
 	return res, err
 }
 
-func (c *Client) sendGETV1BmcMetrics(ctx context.Context, params GETV1BmcMetricsParams) (res []RedfishMetricReport, err error) {
+func (c *Client) sendPOSTV1BmcFwupdate(ctx context.Context, request *BmcFirmwareUpdateBody, params POSTV1BmcFwupdateParams) (res []FirmwareUpdateReport, err error) {
 
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [1]string
-	pathParts[0] = "/v1/bmc/metrics"
+	pathParts[0] = "/v1/bmc/fwupdate"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	q := uri.NewQueryEncoder()
@@ -1963,10 +11892,13 @@ func (c *Client) sendGETV1BmcMetrics(ctx context.Context, params GETV1BmcMetrics
 	}
 	u.RawQuery = q.Values().Encode()
 
-	r, err := ht.NewRequest(ctx, "GET", u)
+	r, err := ht.NewRequest(ctx, "POST", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
+	if err := encodePOSTV1BmcFwupdateRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
 
 	h := uri.NewHeaderEncoder(r.Header)
 	{
@@ -1989,7 +11921,7 @@ func (c *Client) sendGETV1BmcMetrics(ctx context.Context, params GETV1BmcMetrics
 		var satisfied bitset
 		{
 
-			switch err := c.securityHeaderAuth(ctx, GETV1BmcMetricsOperation, r); {
+			switch err := c.securityHeaderAuth(ctx, POSTV1BmcFwupdateOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -2000,7 +11932,7 @@ func (c *Client) sendGETV1BmcMetrics(ctx context.Context, params GETV1BmcMetrics
 		}
 		{
 
-			switch err := c.securityCookieAuth(ctx, GETV1BmcMetricsOperation, r); {
+			switch err := c.securityCookieAuth(ctx, POSTV1BmcFwupdateOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -2035,7 +11967,7 @@ func (c *Client) sendGETV1BmcMetrics(ctx context.Context, params GETV1BmcMetrics
 	}
 	defer resp.Body.Close()
 
-	result, err := decodeGETV1BmcMetricsResponse(resp)
+	result, err := decodePOSTV1BmcFwupdateResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -2043,41 +11975,77 @@ func (c *Client) sendGETV1BmcMetrics(ctx context.Context, params GETV1BmcMetrics
 	return result, nil
 }
 
-// GETV1BmcUpgradeDellRepo invokes GET_/v1/bmc/upgrade/dell/repo operation.
+// POSTV1BmcInventory invokes POST_/v1/bmc/inventory operation.
 //
 // #### Controller:
-// `github.com/ubccr/grendel/internal/api.(*Handler).BmcDellGetRepoUpdateList`
+// `github.com/ubccr/grendel/internal/api.(*Handler).BmcHardware`
 // #### Middlewares:
 // - `github.com/go-fuego/fuego.defaultLogger.middleware`
 // - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
+// - `github.com/ubccr/grendel/internal/api.validateMiddleware.func1`
 // ---
-// Fetch which packages can be upgraded.
+// Collect the hardware of node(s) from their BMCs, store it on each node and report the changes from
+// the previous collection.
 //
-// GET /v1/bmc/upgrade/dell/repo
-func (c *Client) GETV1BmcUpgradeDellRepo(ctx context.Context, params GETV1BmcUpgradeDellRepoParams) ([]RedfishDellUpgradeFirmware, error) {
-	res, err := c.sendGETV1BmcUpgradeDellRepo(ctx, params)
+// POST /v1/bmc/inventory
+func (c *Client) POSTV1BmcInventory(ctx context.Context, params POSTV1BmcInventoryParams) ([]HardwareReport, error) {
+	res, err := c.sendPOSTV1BmcInventory(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendGETV1BmcUpgradeDellRepo(ctx context.Context, params GETV1BmcUpgradeDellRepoParams) (res []RedfishDellUpgradeFirmware, err error) {
+func (c *Client) sendPOSTV1BmcInventory(ctx context.Context, params POSTV1BmcInventoryParams) (res []HardwareReport, err error) {
 
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [1]string
-	pathParts[0] = "/v1/bmc/upgrade/dell/repo"
+	pathParts[0] = "/v1/bmc/inventory"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	q := uri.NewQueryEncoder()
 	{
-		// Encode "nodeset" parameter.
+		// Encode "nodeset" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "nodeset",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Nodeset.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "tags" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "tags",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Tags.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "fanout" parameter.
 		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "nodeset",
+			Name:    "fanout",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.Nodeset.Get(); ok {
-				return e.EncodeValue(conv.StringToString(val))
+			if val, ok := params.Fanout.Get(); ok {
+				return e.EncodeValue(conv.IntToString(val))
 			}
 			return nil
 		}); err != nil {
@@ -2085,16 +12053,16 @@ func (c *Client) sendGETV1BmcUpgradeDellRepo(ctx context.Context, params GETV1Bm
 		}
 	}
 	{
-		// Encode "tags" parameter.
+		// Encode "timeout" parameter.
 		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "tags",
+			Name:    "timeout",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.Tags.Get(); ok {
-				return e.EncodeValue(conv.StringToString(val))
+			if val, ok := params.Timeout.Get(); ok {
+				return e.EncodeValue(conv.IntToString(val))
 			}
 			return nil
 		}); err != nil {
@@ -2103,7 +12071,7 @@ func (c *Client) sendGETV1BmcUpgradeDellRepo(ctx context.Context, params GETV1Bm
 	}
 	u.RawQuery = q.Values().Encode()
 
-	r, err := ht.NewRequest(ctx, "GET", u)
+	r, err := ht.NewRequest(ctx, "POST", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
@@ -2129,7 +12097,7 @@ func (c *Client) sendGETV1BmcUpgradeDellRepo(ctx context.Context, params GETV1Bm
 		var satisfied bitset
 		{
 
-			switch err := c.securityHeaderAuth(ctx, GETV1BmcUpgradeDellRepoOperation, r); {
+			switch err := c.securityHeaderAuth(ctx, POSTV1BmcInventoryOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -2140,7 +12108,7 @@ func (c *Client) sendGETV1BmcUpgradeDellRepo(ctx context.Context, params GETV1Bm
 		}
 		{
 
-			switch err := c.securityCookieAuth(ctx, GETV1BmcUpgradeDellRepoOperation, r); {
+			switch err := c.securityCookieAuth(ctx, POSTV1BmcInventoryOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -2175,7 +12143,7 @@ func (c *Client) sendGETV1BmcUpgradeDellRepo(ctx context.Context, params GETV1Bm
 	}
 	defer resp.Body.Close()
 
-	result, err := decodeGETV1BmcUpgradeDellRepoResponse(resp)
+	result, err := decodePOSTV1BmcInventoryResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -2183,30 +12151,68 @@ func (c *Client) sendGETV1BmcUpgradeDellRepo(ctx context.Context, params GETV1Bm
 	return result, nil
 }
 
-// GETV1DbDump invokes GET_/v1/db/dump operation.
+// POSTV1BmcPowerBmc invokes POST_/v1/bmc/power/bmc operation.
 //
 // #### Controller:
-// `github.com/ubccr/grendel/internal/api.(*Handler).Dump`
+// `github.com/ubccr/grendel/internal/api.(*Handler).BmcPower`
 // #### Middlewares:
 // - `github.com/go-fuego/fuego.defaultLogger.middleware`
 // - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
+// - `github.com/ubccr/grendel/internal/api.validateMiddleware.func1`
 // ---
-// Get a backup of the DB.
+// Reboot node(s) BMC.
 //
-// GET /v1/db/dump
-func (c *Client) GETV1DbDump(ctx context.Context, params GETV1DbDumpParams) (*DataDump, error) {
-	res, err := c.sendGETV1DbDump(ctx, params)
+// POST /v1/bmc/power/bmc
+func (c *Client) POSTV1BmcPowerBmc(ctx context.Context, params POSTV1BmcPowerBmcParams) ([]JobMessage, error) {
+	res, err := c.sendPOSTV1BmcPowerBmc(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendGETV1DbDump(ctx context.Context, params GETV1DbDumpParams) (res *DataDump, err error) {
+func (c *Client) sendPOSTV1BmcPowerBmc(ctx context.Context, params POSTV1BmcPowerBmcParams) (res []JobMessage, err error) {
 
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [1]string
-	pathParts[0] = "/v1/db/dump"
+	pathParts[0] = "/v1/bmc/power/bmc"
 	uri.AddPathParts(u, pathParts[:]...)
 
-	r, err := ht.NewRequest(ctx, "GET", u)
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "nodeset" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "nodeset",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Nodeset.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "tags" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "tags",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Tags.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
+	r, err := ht.NewRequest(ctx, "POST", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
@@ -2232,7 +12238,7 @@ func (c *Client) sendGETV1DbDump(ctx context.Context, params GETV1DbDumpParams)
 		var satisfied bitset
 		{
 
-			switch err := c.securityHeaderAuth(ctx, GETV1DbDumpOperation, r); {
+			switch err := c.securityHeaderAuth(ctx, POSTV1BmcPowerBmcOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -2243,7 +12249,7 @@ func (c *Client) sendGETV1DbDump(ctx context.Context, params GETV1DbDumpParams)
 		}
 		{
 
-			switch err := c.securityCookieAuth(ctx, GETV1DbDumpOperation, r); {
+			switch err := c.securityCookieAuth(ctx, POSTV1BmcPowerBmcOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -2278,7 +12284,7 @@ func (c *Client) sendGETV1DbDump(ctx context.Context, params GETV1DbDumpParams)
 	}
 	defer resp.Body.Close()
 
-	result, err := decodeGETV1DbDumpResponse(resp)
+	result, err := decodePOSTV1BmcPowerBmcResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -2286,32 +12292,74 @@ func (c *Client) sendGETV1DbDump(ctx context.Context, params GETV1DbDumpParams)
 	return result, nil
 }
 
-// GETV1GrendelEvents invokes GET_/v1/grendel/events operation.
+// POSTV1BmcPowerOs invokes POST_/v1/bmc/power/os operation.
 //
 // #### Controller:
-// `github.com/ubccr/grendel/internal/api.(*Handler).GetEvents`
+// `github.com/ubccr/grendel/internal/api.(*Handler).BmcOsPower`
 // #### Middlewares:
 // - `github.com/go-fuego/fuego.defaultLogger.middleware`
 // - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
-// ---.
+// - `github.com/ubccr/grendel/internal/api.validateMiddleware.func1`
+// ---
+// Change power status of node(s).
 //
-// GET /v1/grendel/events
-func (c *Client) GETV1GrendelEvents(ctx context.Context, params GETV1GrendelEventsParams) ([]Event, error) {
-	res, err := c.sendGETV1GrendelEvents(ctx, params)
+// POST /v1/bmc/power/os
+func (c *Client) POSTV1BmcPowerOs(ctx context.Context, request *BmcOsPowerBody, params POSTV1BmcPowerOsParams) ([]JobMessage, error) {
+	res, err := c.sendPOSTV1BmcPowerOs(ctx, request, params)
 	return res, err
 }
 
-func (c *Client) sendGETV1GrendelEvents(ctx context.Context, params GETV1GrendelEventsParams) (res []Event, err error) {
+func (c *Client) sendPOSTV1BmcPowerOs(ctx context.Context, request *BmcOsPowerBody, params POSTV1BmcPowerOsParams) (res []JobMessage, err error) {
 
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [1]string
-	pathParts[0] = "/v1/grendel/events"
+	pathParts[0] = "/v1/bmc/power/os"
 	uri.AddPathParts(u, pathParts[:]...)
 
-	r, err := ht.NewRequest(ctx, "GET", u)
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "nodeset" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "nodeset",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Nodeset.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "tags" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "tags",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Tags.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
+	r, err := ht.NewRequest(ctx, "POST", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
+	if err := encodePOSTV1BmcPowerOsRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
 
 	h := uri.NewHeaderEncoder(r.Header)
 	{
@@ -2334,7 +12382,7 @@ func (c *Client) sendGETV1GrendelEvents(ctx context.Context, params GETV1Grendel
 		var satisfied bitset
 		{
 
-			switch err := c.securityHeaderAuth(ctx, GETV1GrendelEventsOperation, r); {
+			switch err := c.securityHeaderAuth(ctx, POSTV1BmcPowerOsOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -2345,7 +12393,7 @@ func (c *Client) sendGETV1GrendelEvents(ctx context.Context, params GETV1Grendel
 		}
 		{
 
-			switch err := c.securityCookieAuth(ctx, GETV1GrendelEventsOperation, r); {
+			switch err := c.securityCookieAuth(ctx, POSTV1BmcPowerOsOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -2380,7 +12428,7 @@ func (c *Client) sendGETV1GrendelEvents(ctx context.Context, params GETV1Grendel
 	}
 	defer resp.Body.Close()
 
-	result, err := decodeGETV1GrendelEventsResponse(resp)
+	result, err := decodePOSTV1BmcPowerOsResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -2388,33 +12436,76 @@ func (c *Client) sendGETV1GrendelEvents(ctx context.Context, params GETV1Grendel
 	return result, nil
 }
 
-// GETV1Images invokes GET_/v1/images operation.
+// POSTV1BmcRotatePassword invokes POST_/v1/bmc/rotate-password operation.
 //
 // #### Controller:
-// `github.com/ubccr/grendel/internal/api.(*Handler).BootImageList`
+// `github.com/ubccr/grendel/internal/api.(*Handler).BmcRotatePassword`
 // #### Middlewares:
 // - `github.com/go-fuego/fuego.defaultLogger.middleware`
 // - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
+// - `github.com/ubccr/grendel/internal/api.validateMiddleware.func1`
 // ---
-// List all images.
+// Set a new random password on the BMC of node(s), verify the BMC accepts it and store it as the BMC
+// credentials of the node(s). The previous password is restored on failure and nodes flagged
+// attention may no longer match their stored credentials.
 //
-// GET /v1/images
-func (c *Client) GETV1Images(ctx context.Context, params GETV1ImagesParams) ([]BootImage, error) {
-	res, err := c.sendGETV1Images(ctx, params)
+// POST /v1/bmc/rotate-password
+func (c *Client) POSTV1BmcRotatePassword(ctx context.Context, request *BmcRotatePasswordBody, params POSTV1BmcRotatePasswordParams) ([]PasswordRotationReport, error) {
+	res, err := c.sendPOSTV1BmcRotatePassword(ctx, request, params)
 	return res, err
 }
 
-func (c *Client) sendGETV1Images(ctx context.Context, params GETV1ImagesParams) (res []BootImage, err error) {
+func (c *Client) sendPOSTV1BmcRotatePassword(ctx context.Context, request *BmcRotatePasswordBody, params POSTV1BmcRotatePasswordParams) (res []PasswordRotationReport, err error) {
 
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [1]string
-	pathParts[0] = "/v1/images"
+	pathParts[0] = "/v1/bmc/rotate-password"
 	uri.AddPathParts(u, pathParts[:]...)
 
-	r, err := ht.NewRequest(ctx, "GET", u)
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "nodeset" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "nodeset",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Nodeset.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "tags" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "tags",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Tags.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
+	r, err := ht.NewRequest(ctx, "POST", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
+	if err := encodePOSTV1BmcRotatePasswordRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
 
 	h := uri.NewHeaderEncoder(r.Header)
 	{
@@ -2437,7 +12528,7 @@ func (c *Client) sendGETV1Images(ctx context.Context, params GETV1ImagesParams)
 		var satisfied bitset
 		{
 
-			switch err := c.securityHeaderAuth(ctx, GETV1ImagesOperation, r); {
+			switch err := c.securityHeaderAuth(ctx, POSTV1BmcRotatePasswordOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -2448,7 +12539,7 @@ func (c *Client) sendGETV1Images(ctx context.Context, params GETV1ImagesParams)
 		}
 		{
 
-			switch err := c.securityCookieAuth(ctx, GETV1ImagesOperation, r); {
+			switch err := c.securityCookieAuth(ctx, POSTV1BmcRotatePasswordOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -2483,7 +12574,7 @@ func (c *Client) sendGETV1Images(ctx context.Context, params GETV1ImagesParams)
 	}
 	defer resp.Body.Close()
 
-	result, err := decodeGETV1ImagesResponse(resp)
+	result, err := decodePOSTV1BmcRotatePasswordResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -2491,41 +12582,94 @@ func (c *Client) sendGETV1Images(ctx context.Context, params GETV1ImagesParams)
 	return result, nil
 }
 
-// GETV1ImagesFind invokes GET_/v1/images/find operation.
+// POSTV1BmcSubscriptions invokes POST_/v1/bmc/subscriptions operation.
 //
 // #### Controller:
-// `github.com/ubccr/grendel/internal/api.(*Handler).BootImageFind`
+// `github.com/ubccr/grendel/internal/api.(*Handler).BmcSubscriptionsCreate`
 // #### Middlewares:
 // - `github.com/go-fuego/fuego.defaultLogger.middleware`
 // - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
+// - `github.com/ubccr/grendel/internal/api.validateMiddleware.func1`
 // ---
-// Find images by name.
+// Subscribe the BMC of node(s) to Redfish events pushed to the event receiver under bmc.event_url
+// and tag them bmc-events. Stale subscriptions to the receiver are replaced.
 //
-// GET /v1/images/find
-func (c *Client) GETV1ImagesFind(ctx context.Context, params GETV1ImagesFindParams) ([]BootImage, error) {
-	res, err := c.sendGETV1ImagesFind(ctx, params)
+// POST /v1/bmc/subscriptions
+func (c *Client) POSTV1BmcSubscriptions(ctx context.Context, params POSTV1BmcSubscriptionsParams) ([]EventSubscription, error) {
+	res, err := c.sendPOSTV1BmcSubscriptions(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendGETV1ImagesFind(ctx context.Context, params GETV1ImagesFindParams) (res []BootImage, err error) {
+func (c *Client) sendPOSTV1BmcSubscriptions(ctx context.Context, params POSTV1BmcSubscriptionsParams) (res []EventSubscription, err error) {
 
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [1]string
-	pathParts[0] = "/v1/images/find"
+	pathParts[0] = "/v1/bmc/subscriptions"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	q := uri.NewQueryEncoder()
 	{
-		// Encode "names" parameter.
+		// Encode "nodeset" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "nodeset",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Nodeset.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "tags" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "tags",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Tags.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "fanout" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "fanout",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Fanout.Get(); ok {
+				return e.EncodeValue(conv.IntToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "timeout" parameter.
 		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "names",
+			Name:    "timeout",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.Names.Get(); ok {
-				return e.EncodeValue(conv.StringToString(val))
+			if val, ok := params.Timeout.Get(); ok {
+				return e.EncodeValue(conv.IntToString(val))
 			}
 			return nil
 		}); err != nil {
@@ -2534,7 +12678,7 @@ func (c *Client) sendGETV1ImagesFind(ctx context.Context, params GETV1ImagesFind
 	}
 	u.RawQuery = q.Values().Encode()
 
-	r, err := ht.NewRequest(ctx, "GET", u)
+	r, err := ht.NewRequest(ctx, "POST", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
@@ -2560,7 +12704,7 @@ func (c *Client) sendGETV1ImagesFind(ctx context.Context, params GETV1ImagesFind
 		var satisfied bitset
 		{
 
-			switch err := c.securityHeaderAuth(ctx, GETV1ImagesFindOperation, r); {
+			switch err := c.securityHeaderAuth(ctx, POSTV1BmcSubscriptionsOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -2571,7 +12715,7 @@ func (c *Client) sendGETV1ImagesFind(ctx context.Context, params GETV1ImagesFind
 		}
 		{
 
-			switch err := c.securityCookieAuth(ctx, GETV1ImagesFindOperation, r); {
+			switch err := c.securityCookieAuth(ctx, POSTV1BmcSubscriptionsOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -2606,7 +12750,7 @@ func (c *Client) sendGETV1ImagesFind(ctx context.Context, params GETV1ImagesFind
 	}
 	defer resp.Body.Close()
 
-	result, err := decodeGETV1ImagesFindResponse(resp)
+	result, err := decodePOSTV1BmcSubscriptionsResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -2614,33 +12758,74 @@ func (c *Client) sendGETV1ImagesFind(ctx context.Context, params GETV1ImagesFind
 	return result, nil
 }
 
-// GETV1Nodes invokes GET_/v1/nodes operation.
+// POSTV1BmcUpgradeDellInstallfromrepo invokes POST_/v1/bmc/upgrade/dell/installfromrepo operation.
 //
 // #### Controller:
-// `github.com/ubccr/grendel/internal/api.(*Handler).NodeList`
+// `github.com/ubccr/grendel/internal/api.(*Handler).BmcDellInstallFromRepo`
 // #### Middlewares:
 // - `github.com/go-fuego/fuego.defaultLogger.middleware`
 // - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
+// - `github.com/ubccr/grendel/internal/api.validateMiddleware.func1`
 // ---
-// List all nodes.
+// Request iDRAC to download the latest firmware catalog and compare firmware versions.
 //
-// GET /v1/nodes
-func (c *Client) GETV1Nodes(ctx context.Context, params GETV1NodesParams) ([]Host, error) {
-	res, err := c.sendGETV1Nodes(ctx, params)
+// POST /v1/bmc/upgrade/dell/installfromrepo
+func (c *Client) POSTV1BmcUpgradeDellInstallfromrepo(ctx context.Context, request *BmcDellInstallFromRepoRequest, params POSTV1BmcUpgradeDellInstallfromrepoParams) ([]JobMessage, error) {
+	res, err := c.sendPOSTV1BmcUpgradeDellInstallfromrepo(ctx, request, params)
 	return res, err
 }
 
-func (c *Client) sendGETV1Nodes(ctx context.Context, params GETV1NodesParams) (res []Host, err error) {
+func (c *Client) sendPOSTV1BmcUpgradeDellInstallfromrepo(ctx context.Context, request *BmcDellInstallFromRepoRequest, params POSTV1BmcUpgradeDellInstallfromrepoParams) (res []JobMessage, err error) {
 
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [1]string
-	pathParts[0] = "/v1/nodes"
+	pathParts[0] = "/v1/bmc/upgrade/dell/installfromrepo"
 	uri.AddPathParts(u, pathParts[:]...)
 
-	r, err := ht.NewRequest(ctx, "GET", u)
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "nodeset" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "nodeset",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Nodeset.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "tags" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "tags",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Tags.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
+	r, err := ht.NewRequest(ctx, "POST", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
+	if err := encodePOSTV1BmcUpgradeDellInstallfromrepoRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
 
 	h := uri.NewHeaderEncoder(r.Header)
 	{
@@ -2663,7 +12848,7 @@ func (c *Client) sendGETV1Nodes(ctx context.Context, params GETV1NodesParams) (r
 		var satisfied bitset
 		{
 
-			switch err := c.securityHeaderAuth(ctx, GETV1NodesOperation, r); {
+			switch err := c.securityHeaderAuth(ctx, POSTV1BmcUpgradeDellInstallfromrepoOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -2674,7 +12859,7 @@ func (c *Client) sendGETV1Nodes(ctx context.Context, params GETV1NodesParams) (r
 		}
 		{
 
-			switch err := c.securityCookieAuth(ctx, GETV1NodesOperation, r); {
+			switch err := c.securityCookieAuth(ctx, POSTV1BmcUpgradeDellInstallfromrepoOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -2709,7 +12894,7 @@ func (c *Client) sendGETV1Nodes(ctx context.Context, params GETV1NodesParams) (r
 	}
 	defer resp.Body.Close()
 
-	result, err := decodeGETV1NodesResponse(resp)
+	result, err := decodePOSTV1BmcUpgradeDellInstallfromrepoResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -2717,27 +12902,28 @@ func (c *Client) sendGETV1Nodes(ctx context.Context, params GETV1NodesParams) (r
 	return result, nil
 }
 
-// GETV1NodesFind invokes GET_/v1/nodes/find operation.
+// POSTV1BmcVmedia invokes POST_/v1/bmc/vmedia operation.
 //
 // #### Controller:
-// `github.com/ubccr/grendel/internal/api.(*Handler).NodeFind`
+// `github.com/ubccr/grendel/internal/api.(*Handler).BmcVirtualMediaMount`
 // #### Middlewares:
 // - `github.com/go-fuego/fuego.defaultLogger.middleware`
 // - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
+// - `github.com/ubccr/grendel/internal/api.validateMiddleware.func1`
 // ---
-// Find nodes by nodeset and/or tags.
+// Insert an ISO image in the virtual CD of node(s), optionally boot from it and power cycle.
 //
-// GET /v1/nodes/find
-func (c *Client) GETV1NodesFind(ctx context.Context, params GETV1NodesFindParams) ([]Host, error) {
-	res, err := c.sendGETV1NodesFind(ctx, params)
+// POST /v1/bmc/vmedia
+func (c *Client) POSTV1BmcVmedia(ctx context.Context, request *BmcVirtualMediaBody, params POSTV1BmcVmediaParams) ([]JobMessage, error) {
+	res, err := c.sendPOSTV1BmcVmedia(ctx, request, params)
 	return res, err
 }
 
-func (c *Client) sendGETV1NodesFind(ctx context.Context, params GETV1NodesFindParams) (res []Host, err error) {
+func (c *Client) sendPOSTV1BmcVmedia(ctx context.Context, request *BmcVirtualMediaBody, params POSTV1BmcVmediaParams) (res []JobMessage, err error) {
 
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [1]string
-	pathParts[0] = "/v1/nodes/find"
+	pathParts[0] = "/v1/bmc/vmedia"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	q := uri.NewQueryEncoder()
@@ -2777,10 +12963,13 @@ func (c *Client) sendGETV1NodesFind(ctx context.Context, params GETV1NodesFindPa
 	}
 	u.RawQuery = q.Values().Encode()
 
-	r, err := ht.NewRequest(ctx, "GET", u)
+	r, err := ht.NewRequest(ctx, "POST", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
+	if err := encodePOSTV1BmcVmediaRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
 
 	h := uri.NewHeaderEncoder(r.Header)
 	{
@@ -2803,7 +12992,7 @@ func (c *Client) sendGETV1NodesFind(ctx context.Context, params GETV1NodesFindPa
 		var satisfied bitset
 		{
 
-			switch err := c.securityHeaderAuth(ctx, GETV1NodesFindOperation, r); {
+			switch err := c.securityHeaderAuth(ctx, POSTV1BmcVmediaOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -2814,7 +13003,7 @@ func (c *Client) sendGETV1NodesFind(ctx context.Context, params GETV1NodesFindPa
 		}
 		{
 
-			switch err := c.securityCookieAuth(ctx, GETV1NodesFindOperation, r); {
+			switch err := c.securityCookieAuth(ctx, POSTV1BmcVmediaOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -2849,7 +13038,7 @@ func (c *Client) sendGETV1NodesFind(ctx context.Context, params GETV1NodesFindPa
 	}
 	defer resp.Body.Close()
 
-	result, err := decodeGETV1NodesFindResponse(resp)
+	result, err := decodePOSTV1BmcVmediaResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -2857,88 +13046,39 @@ func (c *Client) sendGETV1NodesFind(ctx context.Context, params GETV1NodesFindPa
 	return result, nil
 }
 
-// GETV1NodesTokenInterface invokes GET_/v1/nodes/token/:interface operation.
+// POSTV1CertsRevoke invokes POST_/v1/certs/revoke operation.
 //
 // #### Controller:
-// `github.com/ubccr/grendel/internal/api.(*Handler).NodeBootToken`
+// `github.com/ubccr/grendel/internal/api.(*Handler).CertRevoke`
 // #### Middlewares:
 // - `github.com/go-fuego/fuego.defaultLogger.middleware`
 // - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
+// - `github.com/ubccr/grendel/internal/api.validateMiddleware.func1`
 // ---
-// Create a boot token for the provision server. Used for debugging requests made by images.
+// Revoke certificates issued by the Grendel CA by serial number or the client certificates of nodes
+// by nodeset. Revoked client certificates are rejected by the provision server and listed in the CRL
+// generated by grendel certs crl.
 //
-// GET /v1/nodes/token/{interface}
-func (c *Client) GETV1NodesTokenInterface(ctx context.Context, params GETV1NodesTokenInterfaceParams) (*NodeBootTokenResponse, error) {
-	res, err := c.sendGETV1NodesTokenInterface(ctx, params)
+// POST /v1/certs/revoke
+func (c *Client) POSTV1CertsRevoke(ctx context.Context, request *CertRevokeRequest, params POSTV1CertsRevokeParams) (*GenericResponse, error) {
+	res, err := c.sendPOSTV1CertsRevoke(ctx, request, params)
 	return res, err
 }
 
-func (c *Client) sendGETV1NodesTokenInterface(ctx context.Context, params GETV1NodesTokenInterfaceParams) (res *NodeBootTokenResponse, err error) {
+func (c *Client) sendPOSTV1CertsRevoke(ctx context.Context, request *CertRevokeRequest, params POSTV1CertsRevokeParams) (res *GenericResponse, err error) {
 
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [2]string
-	pathParts[0] = "/v1/nodes/token/"
-	{
-		// Encode "interface" parameter.
-		e := uri.NewPathEncoder(uri.PathEncoderConfig{
-			Param:   "interface",
-			Style:   uri.PathStyleSimple,
-			Explode: false,
-		})
-		if err := func() error {
-			return e.EncodeValue(conv.StringToString(params.Interface))
-		}(); err != nil {
-			return res, errors.Wrap(err, "encode path")
-		}
-		encoded, err := e.Result()
-		if err != nil {
-			return res, errors.Wrap(err, "encode path")
-		}
-		pathParts[1] = encoded
-	}
+	var pathParts [1]string
+	pathParts[0] = "/v1/certs/revoke"
 	uri.AddPathParts(u, pathParts[:]...)
 
-	q := uri.NewQueryEncoder()
-	{
-		// Encode "nodeset" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "nodeset",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
-
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.Nodeset.Get(); ok {
-				return e.EncodeValue(conv.StringToString(val))
-			}
-			return nil
-		}); err != nil {
-			return res, errors.Wrap(err, "encode query")
-		}
-	}
-	{
-		// Encode "tags" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "tags",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
-
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.Tags.Get(); ok {
-				return e.EncodeValue(conv.StringToString(val))
-			}
-			return nil
-		}); err != nil {
-			return res, errors.Wrap(err, "encode query")
-		}
-	}
-	u.RawQuery = q.Values().Encode()
-
-	r, err := ht.NewRequest(ctx, "GET", u)
+	r, err := ht.NewRequest(ctx, "POST", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
+	if err := encodePOSTV1CertsRevokeRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
 
 	h := uri.NewHeaderEncoder(r.Header)
 	{
@@ -2961,7 +13101,7 @@ func (c *Client) sendGETV1NodesTokenInterface(ctx context.Context, params GETV1N
 		var satisfied bitset
 		{
 
-			switch err := c.securityHeaderAuth(ctx, GETV1NodesTokenInterfaceOperation, r); {
+			switch err := c.securityHeaderAuth(ctx, POSTV1CertsRevokeOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -2972,7 +13112,7 @@ func (c *Client) sendGETV1NodesTokenInterface(ctx context.Context, params GETV1N
 		}
 		{
 
-			switch err := c.securityCookieAuth(ctx, GETV1NodesTokenInterfaceOperation, r); {
+			switch err := c.securityCookieAuth(ctx, POSTV1CertsRevokeOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -3007,7 +13147,7 @@ func (c *Client) sendGETV1NodesTokenInterface(ctx context.Context, params GETV1N
 	}
 	defer resp.Body.Close()
 
-	result, err := decodeGETV1NodesTokenInterfaceResponse(resp)
+	result, err := decodePOSTV1CertsRevokeResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -3015,53 +13155,153 @@ func (c *Client) sendGETV1NodesTokenInterface(ctx context.Context, params GETV1N
 	return result, nil
 }
 
-// GETV1Roles invokes GET_/v1/roles operation.
+// POSTV1DNSRecords invokes POST_/v1/dns/records operation.
 //
 // #### Controller:
-// `github.com/ubccr/grendel/internal/api.(*Handler).GetRoles`
+// `github.com/ubccr/grendel/internal/api.(*Handler).DNSRecordAdd`
 // #### Middlewares:
 // - `github.com/go-fuego/fuego.defaultLogger.middleware`
 // - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
+// - `github.com/ubccr/grendel/internal/api.validateMiddleware.func1`
 // ---
-// Get roles and permissions.
+// Add DNS only records. Records conflicting with node interfaces or other records are rejected.
 //
-// GET /v1/roles
-func (c *Client) GETV1Roles(ctx context.Context, params GETV1RolesParams) (*GetRolesResponse, error) {
-	res, err := c.sendGETV1Roles(ctx, params)
+// POST /v1/dns/records
+func (c *Client) POSTV1DNSRecords(ctx context.Context, request *DNSRecordAddRequest, params POSTV1DNSRecordsParams) (*GenericResponse, error) {
+	res, err := c.sendPOSTV1DNSRecords(ctx, request, params)
 	return res, err
 }
 
-func (c *Client) sendGETV1Roles(ctx context.Context, params GETV1RolesParams) (res *GetRolesResponse, err error) {
+func (c *Client) sendPOSTV1DNSRecords(ctx context.Context, request *DNSRecordAddRequest, params POSTV1DNSRecordsParams) (res *GenericResponse, err error) {
 
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [1]string
-	pathParts[0] = "/v1/roles"
+	pathParts[0] = "/v1/dns/records"
 	uri.AddPathParts(u, pathParts[:]...)
 
-	q := uri.NewQueryEncoder()
+	r, err := ht.NewRequest(ctx, "POST", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+	if err := encodePOSTV1DNSRecordsRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	h := uri.NewHeaderEncoder(r.Header)
 	{
-		// Encode "name" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "name",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
+		cfg := uri.HeaderParameterEncodingConfig{
+			Name:    "Accept",
+			Explode: false,
 		}
-
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.Name.Get(); ok {
+		if err := h.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Accept.Get(); ok {
 				return e.EncodeValue(conv.StringToString(val))
 			}
 			return nil
 		}); err != nil {
-			return res, errors.Wrap(err, "encode query")
+			return res, errors.Wrap(err, "encode header")
+		}
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+
+			switch err := c.securityHeaderAuth(ctx, POSTV1DNSRecordsOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"HeaderAuth\"")
+			}
+		}
+		{
+
+			switch err := c.securityCookieAuth(ctx, POSTV1DNSRecordsOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 1
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"CookieAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	result, err := decodePOSTV1DNSRecordsResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// POSTV1DbLoad invokes POST_/v1/db/load operation.
+//
+// #### Controller:
+// `github.com/ubccr/grendel/internal/api.(*Handler).Load`
+// #### Middlewares:
+// - `github.com/go-fuego/fuego.defaultLogger.middleware`
+// - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
+// - `github.com/ubccr/grendel/internal/api.validateMiddleware.func1`
+// ---
+// Make the hosts, images and DNS records in the DB match a dump.
+//
+// POST /v1/db/load
+func (c *Client) POSTV1DbLoad(ctx context.Context, request *DataLoadRequest, params POSTV1DbLoadParams) (*DataLoadResponse, error) {
+	res, err := c.sendPOSTV1DbLoad(ctx, request, params)
+	return res, err
+}
+
+func (c *Client) sendPOSTV1DbLoad(ctx context.Context, request *DataLoadRequest, params POSTV1DbLoadParams) (res *DataLoadResponse, err error) {
+	// Validate request before sending.
+	if err := func() error {
+		if err := request.Validate(); err != nil {
+			return err
 		}
+		return nil
+	}(); err != nil {
+		return res, errors.Wrap(err, "validate")
 	}
-	u.RawQuery = q.Values().Encode()
 
-	r, err := ht.NewRequest(ctx, "GET", u)
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/v1/db/load"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	r, err := ht.NewRequest(ctx, "POST", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
+	if err := encodePOSTV1DbLoadRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
 
 	h := uri.NewHeaderEncoder(r.Header)
 	{
@@ -3084,7 +13324,7 @@ func (c *Client) sendGETV1Roles(ctx context.Context, params GETV1RolesParams) (r
 		var satisfied bitset
 		{
 
-			switch err := c.securityHeaderAuth(ctx, GETV1RolesOperation, r); {
+			switch err := c.securityHeaderAuth(ctx, POSTV1DbLoadOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -3095,7 +13335,7 @@ func (c *Client) sendGETV1Roles(ctx context.Context, params GETV1RolesParams) (r
 		}
 		{
 
-			switch err := c.securityCookieAuth(ctx, GETV1RolesOperation, r); {
+			switch err := c.securityCookieAuth(ctx, POSTV1DbLoadOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -3130,7 +13370,7 @@ func (c *Client) sendGETV1Roles(ctx context.Context, params GETV1RolesParams) (r
 	}
 	defer resp.Body.Close()
 
-	result, err := decodeGETV1RolesResponse(resp)
+	result, err := decodePOSTV1DbLoadResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -3138,69 +13378,31 @@ func (c *Client) sendGETV1Roles(ctx context.Context, params GETV1RolesParams) (r
 	return result, nil
 }
 
-// GETV1SwitchNodesetLldp invokes GET_/v1/switch/:nodeset/lldp operation.
+// POSTV1DbReindex invokes POST_/v1/db/reindex operation.
 //
 // #### Controller:
-// `github.com/ubccr/grendel/internal/api.(*Handler).SwitchGetLLDP`
+// `github.com/ubccr/grendel/internal/api.(*Handler).Reindex`
 // #### Middlewares:
 // - `github.com/go-fuego/fuego.defaultLogger.middleware`
 // - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
+// - `github.com/ubccr/grendel/internal/api.validateMiddleware.func1`
 // ---
-// Get switch LLDP info.
+// Rebuild the DB indexes used for host lookups.
 //
-// GET /v1/switch/{nodeset}/lldp
-func (c *Client) GETV1SwitchNodesetLldp(ctx context.Context, params GETV1SwitchNodesetLldpParams) ([]LLDP, error) {
-	res, err := c.sendGETV1SwitchNodesetLldp(ctx, params)
+// POST /v1/db/reindex
+func (c *Client) POSTV1DbReindex(ctx context.Context, params POSTV1DbReindexParams) (*GenericResponse, error) {
+	res, err := c.sendPOSTV1DbReindex(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendGETV1SwitchNodesetLldp(ctx context.Context, params GETV1SwitchNodesetLldpParams) (res []LLDP, err error) {
+func (c *Client) sendPOSTV1DbReindex(ctx context.Context, params POSTV1DbReindexParams) (res *GenericResponse, err error) {
 
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [3]string
-	pathParts[0] = "/v1/switch/"
-	{
-		// Encode "nodeset" parameter.
-		e := uri.NewPathEncoder(uri.PathEncoderConfig{
-			Param:   "nodeset",
-			Style:   uri.PathStyleSimple,
-			Explode: false,
-		})
-		if err := func() error {
-			return e.EncodeValue(conv.StringToString(params.Nodeset))
-		}(); err != nil {
-			return res, errors.Wrap(err, "encode path")
-		}
-		encoded, err := e.Result()
-		if err != nil {
-			return res, errors.Wrap(err, "encode path")
-		}
-		pathParts[1] = encoded
-	}
-	pathParts[2] = "/lldp"
+	var pathParts [1]string
+	pathParts[0] = "/v1/db/reindex"
 	uri.AddPathParts(u, pathParts[:]...)
 
-	q := uri.NewQueryEncoder()
-	{
-		// Encode "ports" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "ports",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
-
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.Ports.Get(); ok {
-				return e.EncodeValue(conv.StringToString(val))
-			}
-			return nil
-		}); err != nil {
-			return res, errors.Wrap(err, "encode query")
-		}
-	}
-	u.RawQuery = q.Values().Encode()
-
-	r, err := ht.NewRequest(ctx, "GET", u)
+	r, err := ht.NewRequest(ctx, "POST", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
@@ -3226,7 +13428,7 @@ func (c *Client) sendGETV1SwitchNodesetLldp(ctx context.Context, params GETV1Swi
 		var satisfied bitset
 		{
 
-			switch err := c.securityHeaderAuth(ctx, GETV1SwitchNodesetLldpOperation, r); {
+			switch err := c.securityHeaderAuth(ctx, POSTV1DbReindexOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -3237,7 +13439,7 @@ func (c *Client) sendGETV1SwitchNodesetLldp(ctx context.Context, params GETV1Swi
 		}
 		{
 
-			switch err := c.securityCookieAuth(ctx, GETV1SwitchNodesetLldpOperation, r); {
+			switch err := c.securityCookieAuth(ctx, POSTV1DbReindexOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -3272,7 +13474,7 @@ func (c *Client) sendGETV1SwitchNodesetLldp(ctx context.Context, params GETV1Swi
 	}
 	defer resp.Body.Close()
 
-	result, err := decodeGETV1SwitchNodesetLldpResponse(resp)
+	result, err := decodePOSTV1DbReindexResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -3280,53 +13482,46 @@ func (c *Client) sendGETV1SwitchNodesetLldp(ctx context.Context, params GETV1Swi
 	return result, nil
 }
 
-// GETV1Users invokes GET_/v1/users operation.
+// POSTV1DbRestore invokes POST_/v1/db/restore operation.
 //
 // #### Controller:
-// `github.com/ubccr/grendel/internal/api.(*Handler).UserList`
+// `github.com/ubccr/grendel/internal/api.(*Handler).Restore`
 // #### Middlewares:
 // - `github.com/go-fuego/fuego.defaultLogger.middleware`
 // - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
+// - `github.com/ubccr/grendel/internal/api.validateMiddleware.func1`
 // ---
-// List all users.
+// Restore a backup of the DB.
 //
-// GET /v1/users
-func (c *Client) GETV1Users(ctx context.Context, params GETV1UsersParams) ([]User, error) {
-	res, err := c.sendGETV1Users(ctx, params)
+// POST /v1/db/restore
+func (c *Client) POSTV1DbRestore(ctx context.Context, request *DataDump, params POSTV1DbRestoreParams) (*GenericResponse, error) {
+	res, err := c.sendPOSTV1DbRestore(ctx, request, params)
 	return res, err
 }
 
-func (c *Client) sendGETV1Users(ctx context.Context, params GETV1UsersParams) (res []User, err error) {
+func (c *Client) sendPOSTV1DbRestore(ctx context.Context, request *DataDump, params POSTV1DbRestoreParams) (res *GenericResponse, err error) {
+	// Validate request before sending.
+	if err := func() error {
+		if err := request.Validate(); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return res, errors.Wrap(err, "validate")
+	}
 
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [1]string
-	pathParts[0] = "/v1/users"
+	pathParts[0] = "/v1/db/restore"
 	uri.AddPathParts(u, pathParts[:]...)
 
-	q := uri.NewQueryEncoder()
-	{
-		// Encode "usernames" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "usernames",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
-
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.Usernames.Get(); ok {
-				return e.EncodeValue(conv.StringToString(val))
-			}
-			return nil
-		}); err != nil {
-			return res, errors.Wrap(err, "encode query")
-		}
-	}
-	u.RawQuery = q.Values().Encode()
-
-	r, err := ht.NewRequest(ctx, "GET", u)
+	r, err := ht.NewRequest(ctx, "POST", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
+	if err := encodePOSTV1DbRestoreRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
 
 	h := uri.NewHeaderEncoder(r.Header)
 	{
@@ -3349,7 +13544,7 @@ func (c *Client) sendGETV1Users(ctx context.Context, params GETV1UsersParams) (r
 		var satisfied bitset
 		{
 
-			switch err := c.securityHeaderAuth(ctx, GETV1UsersOperation, r); {
+			switch err := c.securityHeaderAuth(ctx, POSTV1DbRestoreOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -3360,7 +13555,7 @@ func (c *Client) sendGETV1Users(ctx context.Context, params GETV1UsersParams) (r
 		}
 		{
 
-			switch err := c.securityCookieAuth(ctx, GETV1UsersOperation, r); {
+			switch err := c.securityCookieAuth(ctx, POSTV1DbRestoreOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -3395,7 +13590,7 @@ func (c *Client) sendGETV1Users(ctx context.Context, params GETV1UsersParams) (r
 	}
 	defer resp.Body.Close()
 
-	result, err := decodeGETV1UsersResponse(resp)
+	result, err := decodePOSTV1DbRestoreResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -3403,43 +13598,35 @@ func (c *Client) sendGETV1Users(ctx context.Context, params GETV1UsersParams) (r
 	return result, nil
 }
 
-// PATCHV1AuthReset invokes PATCH_/v1/auth/reset operation.
+// POSTV1DiscoverBmcAdopt invokes POST_/v1/discover/bmc/adopt operation.
 //
 // #### Controller:
-// `github.com/ubccr/grendel/internal/api.(*Handler).AuthReset`
+// `github.com/ubccr/grendel/internal/api.(*Handler).DiscoverBMCAdopt`
 // #### Middlewares:
 // - `github.com/go-fuego/fuego.defaultLogger.middleware`
 // - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
+// - `github.com/ubccr/grendel/internal/api.validateMiddleware.func1`
 // ---
-// Change password.
+// Attach a pending BMC as the BMC interface of a node.
 //
-// PATCH /v1/auth/reset
-func (c *Client) PATCHV1AuthReset(ctx context.Context, request *AuthResetRequest, params PATCHV1AuthResetParams) (*GenericResponse, error) {
-	res, err := c.sendPATCHV1AuthReset(ctx, request, params)
+// POST /v1/discover/bmc/adopt
+func (c *Client) POSTV1DiscoverBmcAdopt(ctx context.Context, request *DiscoverBMCAdoptRequest, params POSTV1DiscoverBmcAdoptParams) (*GenericResponse, error) {
+	res, err := c.sendPOSTV1DiscoverBmcAdopt(ctx, request, params)
 	return res, err
 }
 
-func (c *Client) sendPATCHV1AuthReset(ctx context.Context, request *AuthResetRequest, params PATCHV1AuthResetParams) (res *GenericResponse, err error) {
-	// Validate request before sending.
-	if err := func() error {
-		if err := request.Validate(); err != nil {
-			return err
-		}
-		return nil
-	}(); err != nil {
-		return res, errors.Wrap(err, "validate")
-	}
+func (c *Client) sendPOSTV1DiscoverBmcAdopt(ctx context.Context, request *DiscoverBMCAdoptRequest, params POSTV1DiscoverBmcAdoptParams) (res *GenericResponse, err error) {
 
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [1]string
-	pathParts[0] = "/v1/auth/reset"
+	pathParts[0] = "/v1/discover/bmc/adopt"
 	uri.AddPathParts(u, pathParts[:]...)
 
-	r, err := ht.NewRequest(ctx, "PATCH", u)
+	r, err := ht.NewRequest(ctx, "POST", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
-	if err := encodePATCHV1AuthResetRequest(request, r); err != nil {
+	if err := encodePOSTV1DiscoverBmcAdoptRequest(request, r); err != nil {
 		return res, errors.Wrap(err, "encode request")
 	}
 
@@ -3464,7 +13651,7 @@ func (c *Client) sendPATCHV1AuthReset(ctx context.Context, request *AuthResetReq
 		var satisfied bitset
 		{
 
-			switch err := c.securityHeaderAuth(ctx, PATCHV1AuthResetOperation, r); {
+			switch err := c.securityHeaderAuth(ctx, POSTV1DiscoverBmcAdoptOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -3475,7 +13662,7 @@ func (c *Client) sendPATCHV1AuthReset(ctx context.Context, request *AuthResetReq
 		}
 		{
 
-			switch err := c.securityCookieAuth(ctx, PATCHV1AuthResetOperation, r); {
+			switch err := c.securityCookieAuth(ctx, POSTV1DiscoverBmcAdoptOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -3510,7 +13697,7 @@ func (c *Client) sendPATCHV1AuthReset(ctx context.Context, request *AuthResetReq
 	}
 	defer resp.Body.Close()
 
-	result, err := decodePATCHV1AuthResetResponse(resp)
+	result, err := decodePOSTV1DiscoverBmcAdoptResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -3518,71 +13705,36 @@ func (c *Client) sendPATCHV1AuthReset(ctx context.Context, request *AuthResetReq
 	return result, nil
 }
 
-// PATCHV1NodesImage invokes PATCH_/v1/nodes/image operation.
+// POSTV1GrendelKeysRotate invokes POST_/v1/grendel/keys/rotate operation.
 //
 // #### Controller:
-// `github.com/ubccr/grendel/internal/api.(*Handler).NodeBootImage`
+// `github.com/ubccr/grendel/internal/api.(*Handler).GrendelKeysRotate`
 // #### Middlewares:
 // - `github.com/go-fuego/fuego.defaultLogger.middleware`
 // - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
+// - `github.com/ubccr/grendel/internal/api.validateMiddleware.func1`
 // ---
-// Update nodes boot image by nodeset and/or tags.
+// Generate new primary keys signing boot and API tokens. The previous keys keep verifying tokens for
+// the grace period, then retire.
 //
-// PATCH /v1/nodes/image
-func (c *Client) PATCHV1NodesImage(ctx context.Context, request *NodeBootImageRequest, params PATCHV1NodesImageParams) (*GenericResponse, error) {
-	res, err := c.sendPATCHV1NodesImage(ctx, request, params)
+// POST /v1/grendel/keys/rotate
+func (c *Client) POSTV1GrendelKeysRotate(ctx context.Context, request *SigningKeyRotateRequest, params POSTV1GrendelKeysRotateParams) ([]SigningKey, error) {
+	res, err := c.sendPOSTV1GrendelKeysRotate(ctx, request, params)
 	return res, err
 }
 
-func (c *Client) sendPATCHV1NodesImage(ctx context.Context, request *NodeBootImageRequest, params PATCHV1NodesImageParams) (res *GenericResponse, err error) {
+func (c *Client) sendPOSTV1GrendelKeysRotate(ctx context.Context, request *SigningKeyRotateRequest, params POSTV1GrendelKeysRotateParams) (res []SigningKey, err error) {
 
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [1]string
-	pathParts[0] = "/v1/nodes/image"
+	pathParts[0] = "/v1/grendel/keys/rotate"
 	uri.AddPathParts(u, pathParts[:]...)
 
-	q := uri.NewQueryEncoder()
-	{
-		// Encode "nodeset" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "nodeset",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
-
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.Nodeset.Get(); ok {
-				return e.EncodeValue(conv.StringToString(val))
-			}
-			return nil
-		}); err != nil {
-			return res, errors.Wrap(err, "encode query")
-		}
-	}
-	{
-		// Encode "tags" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "tags",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
-
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.Tags.Get(); ok {
-				return e.EncodeValue(conv.StringToString(val))
-			}
-			return nil
-		}); err != nil {
-			return res, errors.Wrap(err, "encode query")
-		}
-	}
-	u.RawQuery = q.Values().Encode()
-
-	r, err := ht.NewRequest(ctx, "PATCH", u)
+	r, err := ht.NewRequest(ctx, "POST", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
-	if err := encodePATCHV1NodesImageRequest(request, r); err != nil {
+	if err := encodePOSTV1GrendelKeysRotateRequest(request, r); err != nil {
 		return res, errors.Wrap(err, "encode request")
 	}
 
@@ -3607,7 +13759,7 @@ func (c *Client) sendPATCHV1NodesImage(ctx context.Context, request *NodeBootIma
 		var satisfied bitset
 		{
 
-			switch err := c.securityHeaderAuth(ctx, PATCHV1NodesImageOperation, r); {
+			switch err := c.securityHeaderAuth(ctx, POSTV1GrendelKeysRotateOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -3618,7 +13770,7 @@ func (c *Client) sendPATCHV1NodesImage(ctx context.Context, request *NodeBootIma
 		}
 		{
 
-			switch err := c.securityCookieAuth(ctx, PATCHV1NodesImageOperation, r); {
+			switch err := c.securityCookieAuth(ctx, POSTV1GrendelKeysRotateOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -3653,7 +13805,7 @@ func (c *Client) sendPATCHV1NodesImage(ctx context.Context, request *NodeBootIma
 	}
 	defer resp.Body.Close()
 
-	result, err := decodePATCHV1NodesImageResponse(resp)
+	result, err := decodePOSTV1GrendelKeysRotateResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -3661,73 +13813,34 @@ func (c *Client) sendPATCHV1NodesImage(ctx context.Context, request *NodeBootIma
 	return result, nil
 }
 
-// PATCHV1NodesProvision invokes PATCH_/v1/nodes/provision operation.
+// POSTV1GrendelReload invokes POST_/v1/grendel/reload operation.
 //
 // #### Controller:
-// `github.com/ubccr/grendel/internal/api.(*Handler).NodeProvision`
+// `github.com/ubccr/grendel/internal/api.(*Handler).GrendelReload`
 // #### Middlewares:
 // - `github.com/go-fuego/fuego.defaultLogger.middleware`
 // - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
+// - `github.com/ubccr/grendel/internal/api.validateMiddleware.func1`
 // ---
-// Provision / Unprovision nodes by nodeset and/or tags.
+// Reload the configuration file. Settings such as listen addresses only change after a restart.
 //
-// PATCH /v1/nodes/provision
-func (c *Client) PATCHV1NodesProvision(ctx context.Context, request *NodeProvisionRequest, params PATCHV1NodesProvisionParams) (*GenericResponse, error) {
-	res, err := c.sendPATCHV1NodesProvision(ctx, request, params)
+// POST /v1/grendel/reload
+func (c *Client) POSTV1GrendelReload(ctx context.Context, params POSTV1GrendelReloadParams) (*ReloadResponse, error) {
+	res, err := c.sendPOSTV1GrendelReload(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendPATCHV1NodesProvision(ctx context.Context, request *NodeProvisionRequest, params PATCHV1NodesProvisionParams) (res *GenericResponse, err error) {
-
-	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [1]string
-	pathParts[0] = "/v1/nodes/provision"
-	uri.AddPathParts(u, pathParts[:]...)
-
-	q := uri.NewQueryEncoder()
-	{
-		// Encode "nodeset" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "nodeset",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
-
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.Nodeset.Get(); ok {
-				return e.EncodeValue(conv.StringToString(val))
-			}
-			return nil
-		}); err != nil {
-			return res, errors.Wrap(err, "encode query")
-		}
-	}
-	{
-		// Encode "tags" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "tags",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
-
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.Tags.Get(); ok {
-				return e.EncodeValue(conv.StringToString(val))
-			}
-			return nil
-		}); err != nil {
-			return res, errors.Wrap(err, "encode query")
-		}
-	}
-	u.RawQuery = q.Values().Encode()
-
-	r, err := ht.NewRequest(ctx, "PATCH", u)
+func (c *Client) sendPOSTV1GrendelReload(ctx context.Context, params POSTV1GrendelReloadParams) (res *ReloadResponse, err error) {
+
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/v1/grendel/reload"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	r, err := ht.NewRequest(ctx, "POST", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
-	if err := encodePATCHV1NodesProvisionRequest(request, r); err != nil {
-		return res, errors.Wrap(err, "encode request")
-	}
 
 	h := uri.NewHeaderEncoder(r.Header)
 	{
@@ -3750,7 +13863,7 @@ func (c *Client) sendPATCHV1NodesProvision(ctx context.Context, request *NodePro
 		var satisfied bitset
 		{
 
-			switch err := c.securityHeaderAuth(ctx, PATCHV1NodesProvisionOperation, r); {
+			switch err := c.securityHeaderAuth(ctx, POSTV1GrendelReloadOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -3761,7 +13874,7 @@ func (c *Client) sendPATCHV1NodesProvision(ctx context.Context, request *NodePro
 		}
 		{
 
-			switch err := c.securityCookieAuth(ctx, PATCHV1NodesProvisionOperation, r); {
+			switch err := c.securityCookieAuth(ctx, POSTV1GrendelReloadOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -3796,7 +13909,7 @@ func (c *Client) sendPATCHV1NodesProvision(ctx context.Context, request *NodePro
 	}
 	defer resp.Body.Close()
 
-	result, err := decodePATCHV1NodesProvisionResponse(resp)
+	result, err := decodePOSTV1GrendelReloadResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -3804,89 +13917,35 @@ func (c *Client) sendPATCHV1NodesProvision(ctx context.Context, request *NodePro
 	return result, nil
 }
 
-// PATCHV1NodesTagsAction invokes PATCH_/v1/nodes/tags/:action operation.
+// POSTV1Images invokes POST_/v1/images operation.
 //
 // #### Controller:
-// `github.com/ubccr/grendel/internal/api.(*Handler).NodeTags`
+// `github.com/ubccr/grendel/internal/api.(*Handler).BootImageAdd`
 // #### Middlewares:
 // - `github.com/go-fuego/fuego.defaultLogger.middleware`
 // - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
+// - `github.com/ubccr/grendel/internal/api.validateMiddleware.func1`
 // ---
-// Update nodes tags by nodeset and/or tags.
+// Add images.
 //
-// PATCH /v1/nodes/tags/{action}
-func (c *Client) PATCHV1NodesTagsAction(ctx context.Context, request *NodeTagsRequest, params PATCHV1NodesTagsActionParams) (*GenericResponse, error) {
-	res, err := c.sendPATCHV1NodesTagsAction(ctx, request, params)
+// POST /v1/images
+func (c *Client) POSTV1Images(ctx context.Context, request *BootImageAddRequest, params POSTV1ImagesParams) (*GenericResponse, error) {
+	res, err := c.sendPOSTV1Images(ctx, request, params)
 	return res, err
 }
 
-func (c *Client) sendPATCHV1NodesTagsAction(ctx context.Context, request *NodeTagsRequest, params PATCHV1NodesTagsActionParams) (res *GenericResponse, err error) {
+func (c *Client) sendPOSTV1Images(ctx context.Context, request *BootImageAddRequest, params POSTV1ImagesParams) (res *GenericResponse, err error) {
 
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [2]string
-	pathParts[0] = "/v1/nodes/tags/"
-	{
-		// Encode "action" parameter.
-		e := uri.NewPathEncoder(uri.PathEncoderConfig{
-			Param:   "action",
-			Style:   uri.PathStyleSimple,
-			Explode: false,
-		})
-		if err := func() error {
-			return e.EncodeValue(conv.StringToString(params.Action))
-		}(); err != nil {
-			return res, errors.Wrap(err, "encode path")
-		}
-		encoded, err := e.Result()
-		if err != nil {
-			return res, errors.Wrap(err, "encode path")
-		}
-		pathParts[1] = encoded
-	}
+	var pathParts [1]string
+	pathParts[0] = "/v1/images"
 	uri.AddPathParts(u, pathParts[:]...)
 
-	q := uri.NewQueryEncoder()
-	{
-		// Encode "nodeset" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "nodeset",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
-
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.Nodeset.Get(); ok {
-				return e.EncodeValue(conv.StringToString(val))
-			}
-			return nil
-		}); err != nil {
-			return res, errors.Wrap(err, "encode query")
-		}
-	}
-	{
-		// Encode "tags" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "tags",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
-
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.Tags.Get(); ok {
-				return e.EncodeValue(conv.StringToString(val))
-			}
-			return nil
-		}); err != nil {
-			return res, errors.Wrap(err, "encode query")
-		}
-	}
-	u.RawQuery = q.Values().Encode()
-
-	r, err := ht.NewRequest(ctx, "PATCH", u)
+	r, err := ht.NewRequest(ctx, "POST", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
-	if err := encodePATCHV1NodesTagsActionRequest(request, r); err != nil {
+	if err := encodePOSTV1ImagesRequest(request, r); err != nil {
 		return res, errors.Wrap(err, "encode request")
 	}
 
@@ -3911,7 +13970,7 @@ func (c *Client) sendPATCHV1NodesTagsAction(ctx context.Context, request *NodeTa
 		var satisfied bitset
 		{
 
-			switch err := c.securityHeaderAuth(ctx, PATCHV1NodesTagsActionOperation, r); {
+			switch err := c.securityHeaderAuth(ctx, POSTV1ImagesOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -3922,7 +13981,7 @@ func (c *Client) sendPATCHV1NodesTagsAction(ctx context.Context, request *NodeTa
 		}
 		{
 
-			switch err := c.securityCookieAuth(ctx, PATCHV1NodesTagsActionOperation, r); {
+			switch err := c.securityCookieAuth(ctx, POSTV1ImagesOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -3957,7 +14016,7 @@ func (c *Client) sendPATCHV1NodesTagsAction(ctx context.Context, request *NodeTa
 	}
 	defer resp.Body.Close()
 
-	result, err := decodePATCHV1NodesTagsActionResponse(resp)
+	result, err := decodePOSTV1ImagesResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -3965,36 +14024,56 @@ func (c *Client) sendPATCHV1NodesTagsAction(ctx context.Context, request *NodeTa
 	return result, nil
 }
 
-// PATCHV1Roles invokes PATCH_/v1/roles operation.
+// POSTV1ImagesGc invokes POST_/v1/images/gc operation.
 //
 // #### Controller:
-// `github.com/ubccr/grendel/internal/api.(*Handler).PatchRoles`
+// `github.com/ubccr/grendel/internal/api.(*Handler).BootImageGC`
 // #### Middlewares:
 // - `github.com/go-fuego/fuego.defaultLogger.middleware`
 // - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
+// - `github.com/ubccr/grendel/internal/api.validateMiddleware.func1`
 // ---
-// Edit role permissions.
+// List the files of image_dirs used neither by an image nor by a version of one replaced within
+// image_retention. With delete the files are removed, refused with 409 while the provision or TFTP
+// server is sending a file.
 //
-// PATCH /v1/roles
-func (c *Client) PATCHV1Roles(ctx context.Context, request *PatchRolesRequest, params PATCHV1RolesParams) (*GenericResponse, error) {
-	res, err := c.sendPATCHV1Roles(ctx, request, params)
+// POST /v1/images/gc
+func (c *Client) POSTV1ImagesGc(ctx context.Context, params POSTV1ImagesGcParams) (*ImageGCResult, error) {
+	res, err := c.sendPOSTV1ImagesGc(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendPATCHV1Roles(ctx context.Context, request *PatchRolesRequest, params PATCHV1RolesParams) (res *GenericResponse, err error) {
+func (c *Client) sendPOSTV1ImagesGc(ctx context.Context, params POSTV1ImagesGcParams) (res *ImageGCResult, err error) {
 
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [1]string
-	pathParts[0] = "/v1/roles"
+	pathParts[0] = "/v1/images/gc"
 	uri.AddPathParts(u, pathParts[:]...)
 
-	r, err := ht.NewRequest(ctx, "PATCH", u)
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "delete" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "delete",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Delete.Get(); ok {
+				return e.EncodeValue(conv.BoolToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
+	r, err := ht.NewRequest(ctx, "POST", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
-	if err := encodePATCHV1RolesRequest(request, r); err != nil {
-		return res, errors.Wrap(err, "encode request")
-	}
 
 	h := uri.NewHeaderEncoder(r.Header)
 	{
@@ -4017,7 +14096,7 @@ func (c *Client) sendPATCHV1Roles(ctx context.Context, request *PatchRolesReques
 		var satisfied bitset
 		{
 
-			switch err := c.securityHeaderAuth(ctx, PATCHV1RolesOperation, r); {
+			switch err := c.securityHeaderAuth(ctx, POSTV1ImagesGcOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -4028,7 +14107,7 @@ func (c *Client) sendPATCHV1Roles(ctx context.Context, request *PatchRolesReques
 		}
 		{
 
-			switch err := c.securityCookieAuth(ctx, PATCHV1RolesOperation, r); {
+			switch err := c.securityCookieAuth(ctx, POSTV1ImagesGcOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -4063,7 +14142,7 @@ func (c *Client) sendPATCHV1Roles(ctx context.Context, request *PatchRolesReques
 	}
 	defer resp.Body.Close()
 
-	result, err := decodePATCHV1RolesResponse(resp)
+	result, err := decodePOSTV1ImagesGcResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -4071,53 +14150,44 @@ func (c *Client) sendPATCHV1Roles(ctx context.Context, request *PatchRolesReques
 	return result, nil
 }
 
-// PATCHV1UsersUsernamesEnable invokes PATCH_/v1/users/:usernames/enable operation.
+// POSTV1Nodes invokes POST_/v1/nodes operation.
 //
 // #### Controller:
-// `github.com/ubccr/grendel/internal/api.(*Handler).UserEnable`
+// `github.com/ubccr/grendel/internal/api.(*Handler).NodeAdd`
 // #### Middlewares:
 // - `github.com/go-fuego/fuego.defaultLogger.middleware`
 // - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
+// - `github.com/ubccr/grendel/internal/api.validateMiddleware.func1`
 // ---
-// Update users enable.
+// Add nodes.
 //
-// PATCH /v1/users/{usernames}/enable
-func (c *Client) PATCHV1UsersUsernamesEnable(ctx context.Context, request *UserEnableRequest, params PATCHV1UsersUsernamesEnableParams) (*GenericResponse, error) {
-	res, err := c.sendPATCHV1UsersUsernamesEnable(ctx, request, params)
+// POST /v1/nodes
+func (c *Client) POSTV1Nodes(ctx context.Context, request *NodeAddRequest, params POSTV1NodesParams) (*GenericResponse, error) {
+	res, err := c.sendPOSTV1Nodes(ctx, request, params)
 	return res, err
 }
 
-func (c *Client) sendPATCHV1UsersUsernamesEnable(ctx context.Context, request *UserEnableRequest, params PATCHV1UsersUsernamesEnableParams) (res *GenericResponse, err error) {
-
-	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [3]string
-	pathParts[0] = "/v1/users/"
-	{
-		// Encode "usernames" parameter.
-		e := uri.NewPathEncoder(uri.PathEncoderConfig{
-			Param:   "usernames",
-			Style:   uri.PathStyleSimple,
-			Explode: false,
-		})
-		if err := func() error {
-			return e.EncodeValue(conv.StringToString(params.Usernames))
-		}(); err != nil {
-			return res, errors.Wrap(err, "encode path")
-		}
-		encoded, err := e.Result()
-		if err != nil {
-			return res, errors.Wrap(err, "encode path")
+func (c *Client) sendPOSTV1Nodes(ctx context.Context, request *NodeAddRequest, params POSTV1NodesParams) (res *GenericResponse, err error) {
+	// Validate request before sending.
+	if err := func() error {
+		if err := request.Validate(); err != nil {
+			return err
 		}
-		pathParts[1] = encoded
+		return nil
+	}(); err != nil {
+		return res, errors.Wrap(err, "validate")
 	}
-	pathParts[2] = "/enable"
+
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/v1/nodes"
 	uri.AddPathParts(u, pathParts[:]...)
 
-	r, err := ht.NewRequest(ctx, "PATCH", u)
+	r, err := ht.NewRequest(ctx, "POST", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
-	if err := encodePATCHV1UsersUsernamesEnableRequest(request, r); err != nil {
+	if err := encodePOSTV1NodesRequest(request, r); err != nil {
 		return res, errors.Wrap(err, "encode request")
 	}
 
@@ -4142,7 +14212,7 @@ func (c *Client) sendPATCHV1UsersUsernamesEnable(ctx context.Context, request *U
 		var satisfied bitset
 		{
 
-			switch err := c.securityHeaderAuth(ctx, PATCHV1UsersUsernamesEnableOperation, r); {
+			switch err := c.securityHeaderAuth(ctx, POSTV1NodesOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -4153,7 +14223,7 @@ func (c *Client) sendPATCHV1UsersUsernamesEnable(ctx context.Context, request *U
 		}
 		{
 
-			switch err := c.securityCookieAuth(ctx, PATCHV1UsersUsernamesEnableOperation, r); {
+			switch err := c.securityCookieAuth(ctx, POSTV1NodesOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -4188,7 +14258,7 @@ func (c *Client) sendPATCHV1UsersUsernamesEnable(ctx context.Context, request *U
 	}
 	defer resp.Body.Close()
 
-	result, err := decodePATCHV1UsersUsernamesEnableResponse(resp)
+	result, err := decodePOSTV1NodesResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -4196,53 +14266,37 @@ func (c *Client) sendPATCHV1UsersUsernamesEnable(ctx context.Context, request *U
 	return result, nil
 }
 
-// PATCHV1UsersUsernamesRole invokes PATCH_/v1/users/:usernames/role operation.
+// POSTV1NodesNextip invokes POST_/v1/nodes/nextip operation.
 //
 // #### Controller:
-// `github.com/ubccr/grendel/internal/api.(*Handler).UserRole`
+// `github.com/ubccr/grendel/internal/api.(*Handler).NodeNextIP`
 // #### Middlewares:
 // - `github.com/go-fuego/fuego.defaultLogger.middleware`
 // - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
+// - `github.com/ubccr/grendel/internal/api.validateMiddleware.func1`
 // ---
-// Update users role.
+// Return the lowest free addresses of a subnet, skipping the addresses of nodes, nodes in the trash
+// and DNS records, the network, broadcast and gateway addresses, unexpired reservations and DHCP
+// conflicts. With reserve the addresses are reserved for the duration.
 //
-// PATCH /v1/users/{usernames}/role
-func (c *Client) PATCHV1UsersUsernamesRole(ctx context.Context, request *UserRoleRequest, params PATCHV1UsersUsernamesRoleParams) (*GenericResponse, error) {
-	res, err := c.sendPATCHV1UsersUsernamesRole(ctx, request, params)
+// POST /v1/nodes/nextip
+func (c *Client) POSTV1NodesNextip(ctx context.Context, request *NodeNextIPRequest, params POSTV1NodesNextipParams) (*NodeNextIPResponse, error) {
+	res, err := c.sendPOSTV1NodesNextip(ctx, request, params)
 	return res, err
 }
 
-func (c *Client) sendPATCHV1UsersUsernamesRole(ctx context.Context, request *UserRoleRequest, params PATCHV1UsersUsernamesRoleParams) (res *GenericResponse, err error) {
+func (c *Client) sendPOSTV1NodesNextip(ctx context.Context, request *NodeNextIPRequest, params POSTV1NodesNextipParams) (res *NodeNextIPResponse, err error) {
 
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [3]string
-	pathParts[0] = "/v1/users/"
-	{
-		// Encode "usernames" parameter.
-		e := uri.NewPathEncoder(uri.PathEncoderConfig{
-			Param:   "usernames",
-			Style:   uri.PathStyleSimple,
-			Explode: false,
-		})
-		if err := func() error {
-			return e.EncodeValue(conv.StringToString(params.Usernames))
-		}(); err != nil {
-			return res, errors.Wrap(err, "encode path")
-		}
-		encoded, err := e.Result()
-		if err != nil {
-			return res, errors.Wrap(err, "encode path")
-		}
-		pathParts[1] = encoded
-	}
-	pathParts[2] = "/role"
+	var pathParts [1]string
+	pathParts[0] = "/v1/nodes/nextip"
 	uri.AddPathParts(u, pathParts[:]...)
 
-	r, err := ht.NewRequest(ctx, "PATCH", u)
+	r, err := ht.NewRequest(ctx, "POST", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
-	if err := encodePATCHV1UsersUsernamesRoleRequest(request, r); err != nil {
+	if err := encodePOSTV1NodesNextipRequest(request, r); err != nil {
 		return res, errors.Wrap(err, "encode request")
 	}
 
@@ -4267,7 +14321,7 @@ func (c *Client) sendPATCHV1UsersUsernamesRole(ctx context.Context, request *Use
 		var satisfied bitset
 		{
 
-			switch err := c.securityHeaderAuth(ctx, PATCHV1UsersUsernamesRoleOperation, r); {
+			switch err := c.securityHeaderAuth(ctx, POSTV1NodesNextipOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -4278,7 +14332,7 @@ func (c *Client) sendPATCHV1UsersUsernamesRole(ctx context.Context, request *Use
 		}
 		{
 
-			switch err := c.securityCookieAuth(ctx, PATCHV1UsersUsernamesRoleOperation, r); {
+			switch err := c.securityCookieAuth(ctx, POSTV1NodesNextipOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -4313,7 +14367,7 @@ func (c *Client) sendPATCHV1UsersUsernamesRole(ctx context.Context, request *Use
 	}
 	defer resp.Body.Close()
 
-	result, err := decodePATCHV1UsersUsernamesRoleResponse(resp)
+	result, err := decodePOSTV1NodesNextipResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -4321,33 +14375,35 @@ func (c *Client) sendPATCHV1UsersUsernamesRole(ctx context.Context, request *Use
 	return result, nil
 }
 
-// POSTV1AuthSignin invokes POST_/v1/auth/signin operation.
+// POSTV1NodesTokenInspect invokes POST_/v1/nodes/token/inspect operation.
 //
 // #### Controller:
-// `github.com/ubccr/grendel/internal/api.(*Handler).AuthSignin`
+// `github.com/ubccr/grendel/internal/api.(*Handler).NodeTokenInspect`
 // #### Middlewares:
 // - `github.com/go-fuego/fuego.defaultLogger.middleware`
+// - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
+// - `github.com/ubccr/grendel/internal/api.validateMiddleware.func1`
 // ---
-// signin user.
+// Decode a boot token showing the host, MAC, issue and expiry times.
 //
-// POST /v1/auth/signin
-func (c *Client) POSTV1AuthSignin(ctx context.Context, request *AuthRequest, params POSTV1AuthSigninParams) (*AuthResponse, error) {
-	res, err := c.sendPOSTV1AuthSignin(ctx, request, params)
+// POST /v1/nodes/token/inspect
+func (c *Client) POSTV1NodesTokenInspect(ctx context.Context, request *NodeTokenRequest, params POSTV1NodesTokenInspectParams) (*BootTokenInfo, error) {
+	res, err := c.sendPOSTV1NodesTokenInspect(ctx, request, params)
 	return res, err
 }
 
-func (c *Client) sendPOSTV1AuthSignin(ctx context.Context, request *AuthRequest, params POSTV1AuthSigninParams) (res *AuthResponse, err error) {
+func (c *Client) sendPOSTV1NodesTokenInspect(ctx context.Context, request *NodeTokenRequest, params POSTV1NodesTokenInspectParams) (res *BootTokenInfo, err error) {
 
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [1]string
-	pathParts[0] = "/v1/auth/signin"
+	pathParts[0] = "/v1/nodes/token/inspect"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	r, err := ht.NewRequest(ctx, "POST", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
-	if err := encodePOSTV1AuthSigninRequest(request, r); err != nil {
+	if err := encodePOSTV1NodesTokenInspectRequest(request, r); err != nil {
 		return res, errors.Wrap(err, "encode request")
 	}
 
@@ -4372,7 +14428,7 @@ func (c *Client) sendPOSTV1AuthSignin(ctx context.Context, request *AuthRequest,
 		var satisfied bitset
 		{
 
-			switch err := c.securityHeaderAuth(ctx, POSTV1AuthSigninOperation, r); {
+			switch err := c.securityHeaderAuth(ctx, POSTV1NodesTokenInspectOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -4383,7 +14439,7 @@ func (c *Client) sendPOSTV1AuthSignin(ctx context.Context, request *AuthRequest,
 		}
 		{
 
-			switch err := c.securityCookieAuth(ctx, POSTV1AuthSigninOperation, r); {
+			switch err := c.securityCookieAuth(ctx, POSTV1NodesTokenInspectOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -4398,7 +14454,6 @@ func (c *Client) sendPOSTV1AuthSignin(ctx context.Context, request *AuthRequest,
 			for _, requirement := range []bitset{
 				{0b00000001},
 				{0b00000010},
-				{},
 			} {
 				for i, mask := range requirement {
 					if satisfied[i]&mask != mask {
@@ -4419,7 +14474,7 @@ func (c *Client) sendPOSTV1AuthSignin(ctx context.Context, request *AuthRequest,
 	}
 	defer resp.Body.Close()
 
-	result, err := decodePOSTV1AuthSigninResponse(resp)
+	result, err := decodePOSTV1NodesTokenInspectResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -4427,42 +14482,35 @@ func (c *Client) sendPOSTV1AuthSignin(ctx context.Context, request *AuthRequest,
 	return result, nil
 }
 
-// POSTV1AuthSignup invokes POST_/v1/auth/signup operation.
+// POSTV1NodesTokenRevoke invokes POST_/v1/nodes/token/revoke operation.
 //
 // #### Controller:
-// `github.com/ubccr/grendel/internal/api.(*Handler).AuthSignup`
+// `github.com/ubccr/grendel/internal/api.(*Handler).NodeTokenRevoke`
 // #### Middlewares:
 // - `github.com/go-fuego/fuego.defaultLogger.middleware`
+// - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
+// - `github.com/ubccr/grendel/internal/api.validateMiddleware.func1`
 // ---
-// Signup user.
+// Revoke a boot token. Revoked tokens are rejected by the provision server until they expire.
 //
-// POST /v1/auth/signup
-func (c *Client) POSTV1AuthSignup(ctx context.Context, request *AuthSignupRequest, params POSTV1AuthSignupParams) (*AuthResponse, error) {
-	res, err := c.sendPOSTV1AuthSignup(ctx, request, params)
+// POST /v1/nodes/token/revoke
+func (c *Client) POSTV1NodesTokenRevoke(ctx context.Context, request *NodeTokenRequest, params POSTV1NodesTokenRevokeParams) (*GenericResponse, error) {
+	res, err := c.sendPOSTV1NodesTokenRevoke(ctx, request, params)
 	return res, err
 }
 
-func (c *Client) sendPOSTV1AuthSignup(ctx context.Context, request *AuthSignupRequest, params POSTV1AuthSignupParams) (res *AuthResponse, err error) {
-	// Validate request before sending.
-	if err := func() error {
-		if err := request.Validate(); err != nil {
-			return err
-		}
-		return nil
-	}(); err != nil {
-		return res, errors.Wrap(err, "validate")
-	}
+func (c *Client) sendPOSTV1NodesTokenRevoke(ctx context.Context, request *NodeTokenRequest, params POSTV1NodesTokenRevokeParams) (res *GenericResponse, err error) {
 
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [1]string
-	pathParts[0] = "/v1/auth/signup"
+	pathParts[0] = "/v1/nodes/token/revoke"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	r, err := ht.NewRequest(ctx, "POST", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
-	if err := encodePOSTV1AuthSignupRequest(request, r); err != nil {
+	if err := encodePOSTV1NodesTokenRevokeRequest(request, r); err != nil {
 		return res, errors.Wrap(err, "encode request")
 	}
 
@@ -4487,7 +14535,7 @@ func (c *Client) sendPOSTV1AuthSignup(ctx context.Context, request *AuthSignupRe
 		var satisfied bitset
 		{
 
-			switch err := c.securityHeaderAuth(ctx, POSTV1AuthSignupOperation, r); {
+			switch err := c.securityHeaderAuth(ctx, POSTV1NodesTokenRevokeOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -4498,7 +14546,7 @@ func (c *Client) sendPOSTV1AuthSignup(ctx context.Context, request *AuthSignupRe
 		}
 		{
 
-			switch err := c.securityCookieAuth(ctx, POSTV1AuthSignupOperation, r); {
+			switch err := c.securityCookieAuth(ctx, POSTV1NodesTokenRevokeOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -4513,7 +14561,6 @@ func (c *Client) sendPOSTV1AuthSignup(ctx context.Context, request *AuthSignupRe
 			for _, requirement := range []bitset{
 				{0b00000001},
 				{0b00000010},
-				{},
 			} {
 				for i, mask := range requirement {
 					if satisfied[i]&mask != mask {
@@ -4534,7 +14581,7 @@ func (c *Client) sendPOSTV1AuthSignup(ctx context.Context, request *AuthSignupRe
 	}
 	defer resp.Body.Close()
 
-	result, err := decodePOSTV1AuthSignupResponse(resp)
+	result, err := decodePOSTV1NodesTokenRevokeResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -4542,36 +14589,51 @@ func (c *Client) sendPOSTV1AuthSignup(ctx context.Context, request *AuthSignupRe
 	return result, nil
 }
 
-// POSTV1AuthToken invokes POST_/v1/auth/token operation.
+// POSTV1NodesTrashRestore invokes POST_/v1/nodes/trash/restore operation.
 //
 // #### Controller:
-// `github.com/ubccr/grendel/internal/api.(*Handler).AuthToken`
+// `github.com/ubccr/grendel/internal/api.(*Handler).NodeTrashRestore`
 // #### Middlewares:
 // - `github.com/go-fuego/fuego.defaultLogger.middleware`
 // - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
+// - `github.com/ubccr/grendel/internal/api.validateMiddleware.func1`
 // ---
-// Create API token.
+// Restore deleted nodes from the trash by nodeset.
 //
-// POST /v1/auth/token
-func (c *Client) POSTV1AuthToken(ctx context.Context, request *AuthTokenRequest, params POSTV1AuthTokenParams) (*AuthTokenReponse, error) {
-	res, err := c.sendPOSTV1AuthToken(ctx, request, params)
+// POST /v1/nodes/trash/restore
+func (c *Client) POSTV1NodesTrashRestore(ctx context.Context, params POSTV1NodesTrashRestoreParams) (*GenericResponse, error) {
+	res, err := c.sendPOSTV1NodesTrashRestore(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendPOSTV1AuthToken(ctx context.Context, request *AuthTokenRequest, params POSTV1AuthTokenParams) (res *AuthTokenReponse, err error) {
+func (c *Client) sendPOSTV1NodesTrashRestore(ctx context.Context, params POSTV1NodesTrashRestoreParams) (res *GenericResponse, err error) {
 
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [1]string
-	pathParts[0] = "/v1/auth/token"
+	pathParts[0] = "/v1/nodes/trash/restore"
 	uri.AddPathParts(u, pathParts[:]...)
 
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "nodeset" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "nodeset",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			return e.EncodeValue(conv.StringToString(params.Nodeset))
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
 	r, err := ht.NewRequest(ctx, "POST", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
-	if err := encodePOSTV1AuthTokenRequest(request, r); err != nil {
-		return res, errors.Wrap(err, "encode request")
-	}
 
 	h := uri.NewHeaderEncoder(r.Header)
 	{
@@ -4594,7 +14656,7 @@ func (c *Client) sendPOSTV1AuthToken(ctx context.Context, request *AuthTokenRequ
 		var satisfied bitset
 		{
 
-			switch err := c.securityHeaderAuth(ctx, POSTV1AuthTokenOperation, r); {
+			switch err := c.securityHeaderAuth(ctx, POSTV1NodesTrashRestoreOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -4605,7 +14667,7 @@ func (c *Client) sendPOSTV1AuthToken(ctx context.Context, request *AuthTokenRequ
 		}
 		{
 
-			switch err := c.securityCookieAuth(ctx, POSTV1AuthTokenOperation, r); {
+			switch err := c.securityCookieAuth(ctx, POSTV1NodesTrashRestoreOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -4640,7 +14702,7 @@ func (c *Client) sendPOSTV1AuthToken(ctx context.Context, request *AuthTokenRequ
 	}
 	defer resp.Body.Close()
 
-	result, err := decodePOSTV1AuthTokenResponse(resp)
+	result, err := decodePOSTV1NodesTrashRestoreResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -4648,70 +14710,37 @@ func (c *Client) sendPOSTV1AuthToken(ctx context.Context, request *AuthTokenRequ
 	return result, nil
 }
 
-// POSTV1BmcConfigureAuto invokes POST_/v1/bmc/configure/auto operation.
+// POSTV1Roles invokes POST_/v1/roles operation.
 //
 // #### Controller:
-// `github.com/ubccr/grendel/internal/api.(*Handler).BmcAutoConfigure`
+// `github.com/ubccr/grendel/internal/api.(*Handler).PostRoles`
 // #### Middlewares:
 // - `github.com/go-fuego/fuego.defaultLogger.middleware`
 // - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
+// - `github.com/ubccr/grendel/internal/api.validateMiddleware.func1`
 // ---
-// Set BMC to autoconfigure.
+// Add roles.
 //
-// POST /v1/bmc/configure/auto
-func (c *Client) POSTV1BmcConfigureAuto(ctx context.Context, params POSTV1BmcConfigureAutoParams) ([]JobMessage, error) {
-	res, err := c.sendPOSTV1BmcConfigureAuto(ctx, params)
+// POST /v1/roles
+func (c *Client) POSTV1Roles(ctx context.Context, request *PostRolesRequest, params POSTV1RolesParams) (*GenericResponse, error) {
+	res, err := c.sendPOSTV1Roles(ctx, request, params)
 	return res, err
 }
 
-func (c *Client) sendPOSTV1BmcConfigureAuto(ctx context.Context, params POSTV1BmcConfigureAutoParams) (res []JobMessage, err error) {
+func (c *Client) sendPOSTV1Roles(ctx context.Context, request *PostRolesRequest, params POSTV1RolesParams) (res *GenericResponse, err error) {
 
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [1]string
-	pathParts[0] = "/v1/bmc/configure/auto"
+	pathParts[0] = "/v1/roles"
 	uri.AddPathParts(u, pathParts[:]...)
 
-	q := uri.NewQueryEncoder()
-	{
-		// Encode "nodeset" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "nodeset",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
-
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.Nodeset.Get(); ok {
-				return e.EncodeValue(conv.StringToString(val))
-			}
-			return nil
-		}); err != nil {
-			return res, errors.Wrap(err, "encode query")
-		}
-	}
-	{
-		// Encode "tags" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "tags",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
-
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.Tags.Get(); ok {
-				return e.EncodeValue(conv.StringToString(val))
-			}
-			return nil
-		}); err != nil {
-			return res, errors.Wrap(err, "encode query")
-		}
-	}
-	u.RawQuery = q.Values().Encode()
-
 	r, err := ht.NewRequest(ctx, "POST", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
+	if err := encodePOSTV1RolesRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
 
 	h := uri.NewHeaderEncoder(r.Header)
 	{
@@ -4734,7 +14763,7 @@ func (c *Client) sendPOSTV1BmcConfigureAuto(ctx context.Context, params POSTV1Bm
 		var satisfied bitset
 		{
 
-			switch err := c.securityHeaderAuth(ctx, POSTV1BmcConfigureAutoOperation, r); {
+			switch err := c.securityHeaderAuth(ctx, POSTV1RolesOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -4745,7 +14774,7 @@ func (c *Client) sendPOSTV1BmcConfigureAuto(ctx context.Context, params POSTV1Bm
 		}
 		{
 
-			switch err := c.securityCookieAuth(ctx, POSTV1BmcConfigureAutoOperation, r); {
+			switch err := c.securityCookieAuth(ctx, POSTV1RolesOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -4780,7 +14809,7 @@ func (c *Client) sendPOSTV1BmcConfigureAuto(ctx context.Context, params POSTV1Bm
 	}
 	defer resp.Body.Close()
 
-	result, err := decodePOSTV1BmcConfigureAutoResponse(resp)
+	result, err := decodePOSTV1RolesResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -4788,71 +14817,37 @@ func (c *Client) sendPOSTV1BmcConfigureAuto(ctx context.Context, params POSTV1Bm
 	return result, nil
 }
 
-// POSTV1BmcConfigureImport invokes POST_/v1/bmc/configure/import operation.
+// POSTV1Schedule invokes POST_/v1/schedule operation.
 //
 // #### Controller:
-// `github.com/ubccr/grendel/internal/api.(*Handler).BmcImportConfiguration`
+// `github.com/ubccr/grendel/internal/api.(*Handler).ScheduleAdd`
 // #### Middlewares:
 // - `github.com/go-fuego/fuego.defaultLogger.middleware`
 // - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
+// - `github.com/ubccr/grendel/internal/api.validateMiddleware.func1`
 // ---
-// Manually import system configuration to BMC.
+// Schedule an action to run on nodes at a later time: set the boot image, set provision, power cycle
+// through the BMC or send the schedule.run webhook event. Actions due while grendel serve was down
+// run on startup unless due for longer than schedule.grace_period.
 //
-// POST /v1/bmc/configure/import
-func (c *Client) POSTV1BmcConfigureImport(ctx context.Context, request *BmcImportConfigurationRequest, params POSTV1BmcConfigureImportParams) ([]JobMessage, error) {
-	res, err := c.sendPOSTV1BmcConfigureImport(ctx, request, params)
+// POST /v1/schedule
+func (c *Client) POSTV1Schedule(ctx context.Context, request *ScheduleRequest, params POSTV1ScheduleParams) (*ScheduledAction, error) {
+	res, err := c.sendPOSTV1Schedule(ctx, request, params)
 	return res, err
 }
 
-func (c *Client) sendPOSTV1BmcConfigureImport(ctx context.Context, request *BmcImportConfigurationRequest, params POSTV1BmcConfigureImportParams) (res []JobMessage, err error) {
+func (c *Client) sendPOSTV1Schedule(ctx context.Context, request *ScheduleRequest, params POSTV1ScheduleParams) (res *ScheduledAction, err error) {
 
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [1]string
-	pathParts[0] = "/v1/bmc/configure/import"
+	pathParts[0] = "/v1/schedule"
 	uri.AddPathParts(u, pathParts[:]...)
 
-	q := uri.NewQueryEncoder()
-	{
-		// Encode "nodeset" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "nodeset",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
-
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.Nodeset.Get(); ok {
-				return e.EncodeValue(conv.StringToString(val))
-			}
-			return nil
-		}); err != nil {
-			return res, errors.Wrap(err, "encode query")
-		}
-	}
-	{
-		// Encode "tags" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "tags",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
-
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.Tags.Get(); ok {
-				return e.EncodeValue(conv.StringToString(val))
-			}
-			return nil
-		}); err != nil {
-			return res, errors.Wrap(err, "encode query")
-		}
-	}
-	u.RawQuery = q.Values().Encode()
-
 	r, err := ht.NewRequest(ctx, "POST", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
-	if err := encodePOSTV1BmcConfigureImportRequest(request, r); err != nil {
+	if err := encodePOSTV1ScheduleRequest(request, r); err != nil {
 		return res, errors.Wrap(err, "encode request")
 	}
 
@@ -4877,7 +14872,7 @@ func (c *Client) sendPOSTV1BmcConfigureImport(ctx context.Context, request *BmcI
 		var satisfied bitset
 		{
 
-			switch err := c.securityHeaderAuth(ctx, POSTV1BmcConfigureImportOperation, r); {
+			switch err := c.securityHeaderAuth(ctx, POSTV1ScheduleOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -4888,7 +14883,7 @@ func (c *Client) sendPOSTV1BmcConfigureImport(ctx context.Context, request *BmcI
 		}
 		{
 
-			switch err := c.securityCookieAuth(ctx, POSTV1BmcConfigureImportOperation, r); {
+			switch err := c.securityCookieAuth(ctx, POSTV1ScheduleOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -4923,7 +14918,7 @@ func (c *Client) sendPOSTV1BmcConfigureImport(ctx context.Context, request *BmcI
 	}
 	defer resp.Body.Close()
 
-	result, err := decodePOSTV1BmcConfigureImportResponse(resp)
+	result, err := decodePOSTV1ScheduleResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -4931,65 +14926,48 @@ func (c *Client) sendPOSTV1BmcConfigureImport(ctx context.Context, request *BmcI
 	return result, nil
 }
 
-// POSTV1BmcPowerBmc invokes POST_/v1/bmc/power/bmc operation.
+// POSTV1SwitchNodesetScan invokes POST_/v1/switch/:nodeset/scan operation.
 //
 // #### Controller:
-// `github.com/ubccr/grendel/internal/api.(*Handler).BmcPower`
+// `github.com/ubccr/grendel/internal/api.(*Handler).SwitchScan`
 // #### Middlewares:
 // - `github.com/go-fuego/fuego.defaultLogger.middleware`
 // - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
+// - `github.com/ubccr/grendel/internal/api.validateMiddleware.func1`
 // ---
-// Reboot node(s) BMC.
+// Scan switch MAC address tables and store the switch and port of matching node interfaces.
 //
-// POST /v1/bmc/power/bmc
-func (c *Client) POSTV1BmcPowerBmc(ctx context.Context, params POSTV1BmcPowerBmcParams) ([]JobMessage, error) {
-	res, err := c.sendPOSTV1BmcPowerBmc(ctx, params)
+// POST /v1/switch/{nodeset}/scan
+func (c *Client) POSTV1SwitchNodesetScan(ctx context.Context, params POSTV1SwitchNodesetScanParams) (*SwitchScanResponse, error) {
+	res, err := c.sendPOSTV1SwitchNodesetScan(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendPOSTV1BmcPowerBmc(ctx context.Context, params POSTV1BmcPowerBmcParams) (res []JobMessage, err error) {
+func (c *Client) sendPOSTV1SwitchNodesetScan(ctx context.Context, params POSTV1SwitchNodesetScanParams) (res *SwitchScanResponse, err error) {
 
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [1]string
-	pathParts[0] = "/v1/bmc/power/bmc"
-	uri.AddPathParts(u, pathParts[:]...)
-
-	q := uri.NewQueryEncoder()
+	var pathParts [3]string
+	pathParts[0] = "/v1/switch/"
 	{
 		// Encode "nodeset" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "nodeset",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
-
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.Nodeset.Get(); ok {
-				return e.EncodeValue(conv.StringToString(val))
-			}
-			return nil
-		}); err != nil {
-			return res, errors.Wrap(err, "encode query")
-		}
-	}
-	{
-		// Encode "tags" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "tags",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "nodeset",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.Nodeset))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
 		}
-
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.Tags.Get(); ok {
-				return e.EncodeValue(conv.StringToString(val))
-			}
-			return nil
-		}); err != nil {
-			return res, errors.Wrap(err, "encode query")
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
 		}
+		pathParts[1] = encoded
 	}
-	u.RawQuery = q.Values().Encode()
+	pathParts[2] = "/scan"
+	uri.AddPathParts(u, pathParts[:]...)
 
 	r, err := ht.NewRequest(ctx, "POST", u)
 	if err != nil {
@@ -5017,7 +14995,7 @@ func (c *Client) sendPOSTV1BmcPowerBmc(ctx context.Context, params POSTV1BmcPowe
 		var satisfied bitset
 		{
 
-			switch err := c.securityHeaderAuth(ctx, POSTV1BmcPowerBmcOperation, r); {
+			switch err := c.securityHeaderAuth(ctx, POSTV1SwitchNodesetScanOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -5028,7 +15006,7 @@ func (c *Client) sendPOSTV1BmcPowerBmc(ctx context.Context, params POSTV1BmcPowe
 		}
 		{
 
-			switch err := c.securityCookieAuth(ctx, POSTV1BmcPowerBmcOperation, r); {
+			switch err := c.securityCookieAuth(ctx, POSTV1SwitchNodesetScanOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -5063,7 +15041,7 @@ func (c *Client) sendPOSTV1BmcPowerBmc(ctx context.Context, params POSTV1BmcPowe
 	}
 	defer resp.Body.Close()
 
-	result, err := decodePOSTV1BmcPowerBmcResponse(resp)
+	result, err := decodePOSTV1SwitchNodesetScanResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -5071,73 +15049,54 @@ func (c *Client) sendPOSTV1BmcPowerBmc(ctx context.Context, params POSTV1BmcPowe
 	return result, nil
 }
 
-// POSTV1BmcPowerOs invokes POST_/v1/bmc/power/os operation.
+// POSTV1SwitchNodesetVerify invokes POST_/v1/switch/:nodeset/verify operation.
 //
 // #### Controller:
-// `github.com/ubccr/grendel/internal/api.(*Handler).BmcOsPower`
+// `github.com/ubccr/grendel/internal/api.(*Handler).SwitchVerifyUpdate`
 // #### Middlewares:
 // - `github.com/go-fuego/fuego.defaultLogger.middleware`
 // - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
+// - `github.com/ubccr/grendel/internal/api.validateMiddleware.func1`
 // ---
-// Change power status of node(s).
+// Compare switch LLDP neighbors with the switch and port stored on node interfaces and store the
+// observed switch and port of mismatched or unmapped interfaces.
 //
-// POST /v1/bmc/power/os
-func (c *Client) POSTV1BmcPowerOs(ctx context.Context, request *BmcOsPowerBody, params POSTV1BmcPowerOsParams) ([]JobMessage, error) {
-	res, err := c.sendPOSTV1BmcPowerOs(ctx, request, params)
+// POST /v1/switch/{nodeset}/verify
+func (c *Client) POSTV1SwitchNodesetVerify(ctx context.Context, params POSTV1SwitchNodesetVerifyParams) (*SwitchVerifyResponse, error) {
+	res, err := c.sendPOSTV1SwitchNodesetVerify(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendPOSTV1BmcPowerOs(ctx context.Context, request *BmcOsPowerBody, params POSTV1BmcPowerOsParams) (res []JobMessage, err error) {
+func (c *Client) sendPOSTV1SwitchNodesetVerify(ctx context.Context, params POSTV1SwitchNodesetVerifyParams) (res *SwitchVerifyResponse, err error) {
 
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [1]string
-	pathParts[0] = "/v1/bmc/power/os"
-	uri.AddPathParts(u, pathParts[:]...)
-
-	q := uri.NewQueryEncoder()
+	var pathParts [3]string
+	pathParts[0] = "/v1/switch/"
 	{
 		// Encode "nodeset" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "nodeset",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
-
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.Nodeset.Get(); ok {
-				return e.EncodeValue(conv.StringToString(val))
-			}
-			return nil
-		}); err != nil {
-			return res, errors.Wrap(err, "encode query")
-		}
-	}
-	{
-		// Encode "tags" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "tags",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "nodeset",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.Nodeset))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
 		}
-
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.Tags.Get(); ok {
-				return e.EncodeValue(conv.StringToString(val))
-			}
-			return nil
-		}); err != nil {
-			return res, errors.Wrap(err, "encode query")
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
 		}
+		pathParts[1] = encoded
 	}
-	u.RawQuery = q.Values().Encode()
+	pathParts[2] = "/verify"
+	uri.AddPathParts(u, pathParts[:]...)
 
 	r, err := ht.NewRequest(ctx, "POST", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
-	if err := encodePOSTV1BmcPowerOsRequest(request, r); err != nil {
-		return res, errors.Wrap(err, "encode request")
-	}
 
 	h := uri.NewHeaderEncoder(r.Header)
 	{
@@ -5160,7 +15119,7 @@ func (c *Client) sendPOSTV1BmcPowerOs(ctx context.Context, request *BmcOsPowerBo
 		var satisfied bitset
 		{
 
-			switch err := c.securityHeaderAuth(ctx, POSTV1BmcPowerOsOperation, r); {
+			switch err := c.securityHeaderAuth(ctx, POSTV1SwitchNodesetVerifyOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -5171,7 +15130,7 @@ func (c *Client) sendPOSTV1BmcPowerOs(ctx context.Context, request *BmcOsPowerBo
 		}
 		{
 
-			switch err := c.securityCookieAuth(ctx, POSTV1BmcPowerOsOperation, r); {
+			switch err := c.securityCookieAuth(ctx, POSTV1SwitchNodesetVerifyOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -5206,7 +15165,7 @@ func (c *Client) sendPOSTV1BmcPowerOs(ctx context.Context, request *BmcOsPowerBo
 	}
 	defer resp.Body.Close()
 
-	result, err := decodePOSTV1BmcPowerOsResponse(resp)
+	result, err := decodePOSTV1SwitchNodesetVerifyResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -5214,71 +15173,35 @@ func (c *Client) sendPOSTV1BmcPowerOs(ctx context.Context, request *BmcOsPowerBo
 	return result, nil
 }
 
-// POSTV1BmcUpgradeDellInstallfromrepo invokes POST_/v1/bmc/upgrade/dell/installfromrepo operation.
+// POSTV1Users invokes POST_/v1/users operation.
 //
 // #### Controller:
-// `github.com/ubccr/grendel/internal/api.(*Handler).BmcDellInstallFromRepo`
+// `github.com/ubccr/grendel/internal/api.(*Handler).UserStore`
 // #### Middlewares:
 // - `github.com/go-fuego/fuego.defaultLogger.middleware`
 // - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
+// - `github.com/ubccr/grendel/internal/api.validateMiddleware.func1`
 // ---
-// Request iDRAC to download the latest firmware catalog and compare firmware versions.
+// Add new user.
 //
-// POST /v1/bmc/upgrade/dell/installfromrepo
-func (c *Client) POSTV1BmcUpgradeDellInstallfromrepo(ctx context.Context, request *BmcDellInstallFromRepoRequest, params POSTV1BmcUpgradeDellInstallfromrepoParams) ([]JobMessage, error) {
-	res, err := c.sendPOSTV1BmcUpgradeDellInstallfromrepo(ctx, request, params)
+// POST /v1/users
+func (c *Client) POSTV1Users(ctx context.Context, request *UserStoreRequest, params POSTV1UsersParams) (*UserStoreResponse, error) {
+	res, err := c.sendPOSTV1Users(ctx, request, params)
 	return res, err
 }
 
-func (c *Client) sendPOSTV1BmcUpgradeDellInstallfromrepo(ctx context.Context, request *BmcDellInstallFromRepoRequest, params POSTV1BmcUpgradeDellInstallfromrepoParams) (res []JobMessage, err error) {
+func (c *Client) sendPOSTV1Users(ctx context.Context, request *UserStoreRequest, params POSTV1UsersParams) (res *UserStoreResponse, err error) {
 
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [1]string
-	pathParts[0] = "/v1/bmc/upgrade/dell/installfromrepo"
+	pathParts[0] = "/v1/users"
 	uri.AddPathParts(u, pathParts[:]...)
 
-	q := uri.NewQueryEncoder()
-	{
-		// Encode "nodeset" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "nodeset",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
-
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.Nodeset.Get(); ok {
-				return e.EncodeValue(conv.StringToString(val))
-			}
-			return nil
-		}); err != nil {
-			return res, errors.Wrap(err, "encode query")
-		}
-	}
-	{
-		// Encode "tags" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "tags",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
-
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.Tags.Get(); ok {
-				return e.EncodeValue(conv.StringToString(val))
-			}
-			return nil
-		}); err != nil {
-			return res, errors.Wrap(err, "encode query")
-		}
-	}
-	u.RawQuery = q.Values().Encode()
-
 	r, err := ht.NewRequest(ctx, "POST", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
-	if err := encodePOSTV1BmcUpgradeDellInstallfromrepoRequest(request, r); err != nil {
+	if err := encodePOSTV1UsersRequest(request, r); err != nil {
 		return res, errors.Wrap(err, "encode request")
 	}
 
@@ -5303,7 +15226,7 @@ func (c *Client) sendPOSTV1BmcUpgradeDellInstallfromrepo(ctx context.Context, re
 		var satisfied bitset
 		{
 
-			switch err := c.securityHeaderAuth(ctx, POSTV1BmcUpgradeDellInstallfromrepoOperation, r); {
+			switch err := c.securityHeaderAuth(ctx, POSTV1UsersOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -5314,7 +15237,7 @@ func (c *Client) sendPOSTV1BmcUpgradeDellInstallfromrepo(ctx context.Context, re
 		}
 		{
 
-			switch err := c.securityCookieAuth(ctx, POSTV1BmcUpgradeDellInstallfromrepoOperation, r); {
+			switch err := c.securityCookieAuth(ctx, POSTV1UsersOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -5349,7 +15272,7 @@ func (c *Client) sendPOSTV1BmcUpgradeDellInstallfromrepo(ctx context.Context, re
 	}
 	defer resp.Body.Close()
 
-	result, err := decodePOSTV1BmcUpgradeDellInstallfromrepoResponse(resp)
+	result, err := decodePOSTV1UsersResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -5357,43 +15280,37 @@ func (c *Client) sendPOSTV1BmcUpgradeDellInstallfromrepo(ctx context.Context, re
 	return result, nil
 }
 
-// POSTV1DbRestore invokes POST_/v1/db/restore operation.
+// PUTV1GrendelMaintenance invokes PUT_/v1/grendel/maintenance operation.
 //
 // #### Controller:
-// `github.com/ubccr/grendel/internal/api.(*Handler).Restore`
+// `github.com/ubccr/grendel/internal/api.(*Handler).GrendelMaintenanceSet`
 // #### Middlewares:
 // - `github.com/go-fuego/fuego.defaultLogger.middleware`
 // - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
+// - `github.com/ubccr/grendel/internal/api.validateMiddleware.func1`
 // ---
-// Restore a backup of the DB.
+// Turn maintenance mode on or off. While on DHCP leaves out the boot file and zero touch
+// provisioning options and the provision endpoints return 503, static address assignment and DNS
+// continue normally.
 //
-// POST /v1/db/restore
-func (c *Client) POSTV1DbRestore(ctx context.Context, request *DataDump, params POSTV1DbRestoreParams) (*GenericResponse, error) {
-	res, err := c.sendPOSTV1DbRestore(ctx, request, params)
+// PUT /v1/grendel/maintenance
+func (c *Client) PUTV1GrendelMaintenance(ctx context.Context, request *MaintenanceRequest, params PUTV1GrendelMaintenanceParams) (*Maintenance, error) {
+	res, err := c.sendPUTV1GrendelMaintenance(ctx, request, params)
 	return res, err
 }
 
-func (c *Client) sendPOSTV1DbRestore(ctx context.Context, request *DataDump, params POSTV1DbRestoreParams) (res *GenericResponse, err error) {
-	// Validate request before sending.
-	if err := func() error {
-		if err := request.Validate(); err != nil {
-			return err
-		}
-		return nil
-	}(); err != nil {
-		return res, errors.Wrap(err, "validate")
-	}
+func (c *Client) sendPUTV1GrendelMaintenance(ctx context.Context, request *MaintenanceRequest, params PUTV1GrendelMaintenanceParams) (res *Maintenance, err error) {
 
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [1]string
-	pathParts[0] = "/v1/db/restore"
+	pathParts[0] = "/v1/grendel/maintenance"
 	uri.AddPathParts(u, pathParts[:]...)
 
-	r, err := ht.NewRequest(ctx, "POST", u)
+	r, err := ht.NewRequest(ctx, "PUT", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
-	if err := encodePOSTV1DbRestoreRequest(request, r); err != nil {
+	if err := encodePUTV1GrendelMaintenanceRequest(request, r); err != nil {
 		return res, errors.Wrap(err, "encode request")
 	}
 
@@ -5418,7 +15335,7 @@ func (c *Client) sendPOSTV1DbRestore(ctx context.Context, request *DataDump, par
 		var satisfied bitset
 		{
 
-			switch err := c.securityHeaderAuth(ctx, POSTV1DbRestoreOperation, r); {
+			switch err := c.securityHeaderAuth(ctx, PUTV1GrendelMaintenanceOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -5429,7 +15346,7 @@ func (c *Client) sendPOSTV1DbRestore(ctx context.Context, request *DataDump, par
 		}
 		{
 
-			switch err := c.securityCookieAuth(ctx, POSTV1DbRestoreOperation, r); {
+			switch err := c.securityCookieAuth(ctx, PUTV1GrendelMaintenanceOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -5464,7 +15381,7 @@ func (c *Client) sendPOSTV1DbRestore(ctx context.Context, request *DataDump, par
 	}
 	defer resp.Body.Close()
 
-	result, err := decodePOSTV1DbRestoreResponse(resp)
+	result, err := decodePUTV1GrendelMaintenanceResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -5472,34 +15389,46 @@ func (c *Client) sendPOSTV1DbRestore(ctx context.Context, request *DataDump, par
 	return result, nil
 }
 
-// POSTV1Images invokes POST_/v1/images operation.
+// PUTV1NodesClientCert invokes PUT_/v1/nodes/client-cert operation.
 //
 // #### Controller:
-// `github.com/ubccr/grendel/internal/api.(*Handler).BootImageAdd`
+// `github.com/ubccr/grendel/internal/api.(*Handler).NodeClientCertSet`
 // #### Middlewares:
 // - `github.com/go-fuego/fuego.defaultLogger.middleware`
 // - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
+// - `github.com/ubccr/grendel/internal/api.validateMiddleware.func1`
 // ---
-// Add images.
+// Store the SHA-256 fingerprints of the client certificates of nodes, checked by the provision
+// server against the client certificates nodes present. Keys sent along are held in memory until the
+// node fetches them once from /boot/{token}/client-cert of the provision server or the ttl passes.
 //
-// POST /v1/images
-func (c *Client) POSTV1Images(ctx context.Context, request *BootImageAddRequest, params POSTV1ImagesParams) (*GenericResponse, error) {
-	res, err := c.sendPOSTV1Images(ctx, request, params)
+// PUT /v1/nodes/client-cert
+func (c *Client) PUTV1NodesClientCert(ctx context.Context, request *NodeClientCertRequest, params PUTV1NodesClientCertParams) (*GenericResponse, error) {
+	res, err := c.sendPUTV1NodesClientCert(ctx, request, params)
 	return res, err
 }
 
-func (c *Client) sendPOSTV1Images(ctx context.Context, request *BootImageAddRequest, params POSTV1ImagesParams) (res *GenericResponse, err error) {
+func (c *Client) sendPUTV1NodesClientCert(ctx context.Context, request *NodeClientCertRequest, params PUTV1NodesClientCertParams) (res *GenericResponse, err error) {
+	// Validate request before sending.
+	if err := func() error {
+		if err := request.Validate(); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return res, errors.Wrap(err, "validate")
+	}
 
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [1]string
-	pathParts[0] = "/v1/images"
+	pathParts[0] = "/v1/nodes/client-cert"
 	uri.AddPathParts(u, pathParts[:]...)
 
-	r, err := ht.NewRequest(ctx, "POST", u)
+	r, err := ht.NewRequest(ctx, "PUT", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
-	if err := encodePOSTV1ImagesRequest(request, r); err != nil {
+	if err := encodePUTV1NodesClientCertRequest(request, r); err != nil {
 		return res, errors.Wrap(err, "encode request")
 	}
 
@@ -5524,7 +15453,7 @@ func (c *Client) sendPOSTV1Images(ctx context.Context, request *BootImageAddRequ
 		var satisfied bitset
 		{
 
-			switch err := c.securityHeaderAuth(ctx, POSTV1ImagesOperation, r); {
+			switch err := c.securityHeaderAuth(ctx, PUTV1NodesClientCertOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -5535,7 +15464,7 @@ func (c *Client) sendPOSTV1Images(ctx context.Context, request *BootImageAddRequ
 		}
 		{
 
-			switch err := c.securityCookieAuth(ctx, POSTV1ImagesOperation, r); {
+			switch err := c.securityCookieAuth(ctx, PUTV1NodesClientCertOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -5570,7 +15499,7 @@ func (c *Client) sendPOSTV1Images(ctx context.Context, request *BootImageAddRequ
 	}
 	defer resp.Body.Close()
 
-	result, err := decodePOSTV1ImagesResponse(resp)
+	result, err := decodePUTV1NodesClientCertResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -5578,43 +15507,72 @@ func (c *Client) sendPOSTV1Images(ctx context.Context, request *BootImageAddRequ
 	return result, nil
 }
 
-// POSTV1Nodes invokes POST_/v1/nodes operation.
+// PUTV1NodesCredentials invokes PUT_/v1/nodes/credentials operation.
 //
 // #### Controller:
-// `github.com/ubccr/grendel/internal/api.(*Handler).NodeAdd`
+// `github.com/ubccr/grendel/internal/api.(*Handler).NodeCredentialSet`
 // #### Middlewares:
 // - `github.com/go-fuego/fuego.defaultLogger.middleware`
 // - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
+// - `github.com/ubccr/grendel/internal/api.validateMiddleware.func1`
 // ---
-// Add nodes.
+// Set the encrypted credentials of nodes by nodeset and/or tags.
 //
-// POST /v1/nodes
-func (c *Client) POSTV1Nodes(ctx context.Context, request *NodeAddRequest, params POSTV1NodesParams) (*GenericResponse, error) {
-	res, err := c.sendPOSTV1Nodes(ctx, request, params)
+// PUT /v1/nodes/credentials
+func (c *Client) PUTV1NodesCredentials(ctx context.Context, request *NodeCredentialsRequest, params PUTV1NodesCredentialsParams) (*GenericResponse, error) {
+	res, err := c.sendPUTV1NodesCredentials(ctx, request, params)
 	return res, err
 }
 
-func (c *Client) sendPOSTV1Nodes(ctx context.Context, request *NodeAddRequest, params POSTV1NodesParams) (res *GenericResponse, err error) {
-	// Validate request before sending.
-	if err := func() error {
-		if err := request.Validate(); err != nil {
-			return err
-		}
-		return nil
-	}(); err != nil {
-		return res, errors.Wrap(err, "validate")
-	}
+func (c *Client) sendPUTV1NodesCredentials(ctx context.Context, request *NodeCredentialsRequest, params PUTV1NodesCredentialsParams) (res *GenericResponse, err error) {
 
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [1]string
-	pathParts[0] = "/v1/nodes"
+	pathParts[0] = "/v1/nodes/credentials"
 	uri.AddPathParts(u, pathParts[:]...)
 
-	r, err := ht.NewRequest(ctx, "POST", u)
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "nodeset" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "nodeset",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Nodeset.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "tags" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "tags",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Tags.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
+	r, err := ht.NewRequest(ctx, "PUT", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
-	if err := encodePOSTV1NodesRequest(request, r); err != nil {
+	if err := encodePUTV1NodesCredentialsRequest(request, r); err != nil {
 		return res, errors.Wrap(err, "encode request")
 	}
 
@@ -5639,7 +15597,7 @@ func (c *Client) sendPOSTV1Nodes(ctx context.Context, request *NodeAddRequest, p
 		var satisfied bitset
 		{
 
-			switch err := c.securityHeaderAuth(ctx, POSTV1NodesOperation, r); {
+			switch err := c.securityHeaderAuth(ctx, PUTV1NodesCredentialsOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -5650,7 +15608,7 @@ func (c *Client) sendPOSTV1Nodes(ctx context.Context, request *NodeAddRequest, p
 		}
 		{
 
-			switch err := c.securityCookieAuth(ctx, POSTV1NodesOperation, r); {
+			switch err := c.securityCookieAuth(ctx, PUTV1NodesCredentialsOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -5685,7 +15643,7 @@ func (c *Client) sendPOSTV1Nodes(ctx context.Context, request *NodeAddRequest, p
 	}
 	defer resp.Body.Close()
 
-	result, err := decodePOSTV1NodesResponse(resp)
+	result, err := decodePUTV1NodesCredentialsResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -5693,34 +15651,73 @@ func (c *Client) sendPOSTV1Nodes(ctx context.Context, request *NodeAddRequest, p
 	return result, nil
 }
 
-// POSTV1Roles invokes POST_/v1/roles operation.
+// PUTV1NodesFiles invokes PUT_/v1/nodes/files operation.
 //
 // #### Controller:
-// `github.com/ubccr/grendel/internal/api.(*Handler).PostRoles`
+// `github.com/ubccr/grendel/internal/api.(*Handler).NodeFileSet`
 // #### Middlewares:
 // - `github.com/go-fuego/fuego.defaultLogger.middleware`
 // - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
+// - `github.com/ubccr/grendel/internal/api.validateMiddleware.func1`
 // ---
-// Add roles.
+// Attach a file to nodes by nodeset and/or tags, served to the nodes while provisioning at
+// /boot/<token>/extra/<name>. Files are limited to 512 KiB.
 //
-// POST /v1/roles
-func (c *Client) POSTV1Roles(ctx context.Context, request *PostRolesRequest, params POSTV1RolesParams) (*GenericResponse, error) {
-	res, err := c.sendPOSTV1Roles(ctx, request, params)
+// PUT /v1/nodes/files
+func (c *Client) PUTV1NodesFiles(ctx context.Context, request *NodeFileRequest, params PUTV1NodesFilesParams) (*GenericResponse, error) {
+	res, err := c.sendPUTV1NodesFiles(ctx, request, params)
 	return res, err
 }
 
-func (c *Client) sendPOSTV1Roles(ctx context.Context, request *PostRolesRequest, params POSTV1RolesParams) (res *GenericResponse, err error) {
+func (c *Client) sendPUTV1NodesFiles(ctx context.Context, request *NodeFileRequest, params PUTV1NodesFilesParams) (res *GenericResponse, err error) {
 
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [1]string
-	pathParts[0] = "/v1/roles"
+	pathParts[0] = "/v1/nodes/files"
 	uri.AddPathParts(u, pathParts[:]...)
 
-	r, err := ht.NewRequest(ctx, "POST", u)
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "nodeset" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "nodeset",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Nodeset.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "tags" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "tags",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Tags.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
+	r, err := ht.NewRequest(ctx, "PUT", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
-	if err := encodePOSTV1RolesRequest(request, r); err != nil {
+	if err := encodePUTV1NodesFilesRequest(request, r); err != nil {
 		return res, errors.Wrap(err, "encode request")
 	}
 
@@ -5745,7 +15742,7 @@ func (c *Client) sendPOSTV1Roles(ctx context.Context, request *PostRolesRequest,
 		var satisfied bitset
 		{
 
-			switch err := c.securityHeaderAuth(ctx, POSTV1RolesOperation, r); {
+			switch err := c.securityHeaderAuth(ctx, PUTV1NodesFilesOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -5756,7 +15753,7 @@ func (c *Client) sendPOSTV1Roles(ctx context.Context, request *PostRolesRequest,
 		}
 		{
 
-			switch err := c.securityCookieAuth(ctx, POSTV1RolesOperation, r); {
+			switch err := c.securityCookieAuth(ctx, PUTV1NodesFilesOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -5791,7 +15788,7 @@ func (c *Client) sendPOSTV1Roles(ctx context.Context, request *PostRolesRequest,
 	}
 	defer resp.Body.Close()
 
-	result, err := decodePOSTV1RolesResponse(resp)
+	result, err := decodePUTV1NodesFilesResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -5799,34 +15796,54 @@ func (c *Client) sendPOSTV1Roles(ctx context.Context, request *PostRolesRequest,
 	return result, nil
 }
 
-// POSTV1Users invokes POST_/v1/users operation.
+// PUTV1SecretsName invokes PUT_/v1/secrets/:name operation.
 //
 // #### Controller:
-// `github.com/ubccr/grendel/internal/api.(*Handler).UserStore`
+// `github.com/ubccr/grendel/internal/api.(*Handler).SecretSet`
 // #### Middlewares:
 // - `github.com/go-fuego/fuego.defaultLogger.middleware`
 // - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
+// - `github.com/ubccr/grendel/internal/api.validateMiddleware.func1`
 // ---
-// Add new user.
+// Set a secret, encrypted with the credentials key. A one-time secret is rendered once per boot
+// token, later requests made with the token get a placeholder.
 //
-// POST /v1/users
-func (c *Client) POSTV1Users(ctx context.Context, request *UserStoreRequest, params POSTV1UsersParams) (*UserStoreResponse, error) {
-	res, err := c.sendPOSTV1Users(ctx, request, params)
+// PUT /v1/secrets/{name}
+func (c *Client) PUTV1SecretsName(ctx context.Context, request *SecretRequest, params PUTV1SecretsNameParams) (*GenericResponse, error) {
+	res, err := c.sendPUTV1SecretsName(ctx, request, params)
 	return res, err
 }
 
-func (c *Client) sendPOSTV1Users(ctx context.Context, request *UserStoreRequest, params POSTV1UsersParams) (res *UserStoreResponse, err error) {
+func (c *Client) sendPUTV1SecretsName(ctx context.Context, request *SecretRequest, params PUTV1SecretsNameParams) (res *GenericResponse, err error) {
 
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [1]string
-	pathParts[0] = "/v1/users"
+	var pathParts [2]string
+	pathParts[0] = "/v1/secrets/"
+	{
+		// Encode "name" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "name",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.Name))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
 	uri.AddPathParts(u, pathParts[:]...)
 
-	r, err := ht.NewRequest(ctx, "POST", u)
+	r, err := ht.NewRequest(ctx, "PUT", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
-	if err := encodePOSTV1UsersRequest(request, r); err != nil {
+	if err := encodePUTV1SecretsNameRequest(request, r); err != nil {
 		return res, errors.Wrap(err, "encode request")
 	}
 
@@ -5851,7 +15868,7 @@ func (c *Client) sendPOSTV1Users(ctx context.Context, request *UserStoreRequest,
 		var satisfied bitset
 		{
 
-			switch err := c.securityHeaderAuth(ctx, POSTV1UsersOperation, r); {
+			switch err := c.securityHeaderAuth(ctx, PUTV1SecretsNameOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -5862,7 +15879,7 @@ func (c *Client) sendPOSTV1Users(ctx context.Context, request *UserStoreRequest,
 		}
 		{
 
-			switch err := c.securityCookieAuth(ctx, POSTV1UsersOperation, r); {
+			switch err := c.securityCookieAuth(ctx, PUTV1SecretsNameOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -5897,7 +15914,7 @@ func (c *Client) sendPOSTV1Users(ctx context.Context, request *UserStoreRequest,
 	}
 	defer resp.Body.Close()
 
-	result, err := decodePOSTV1UsersResponse(resp)
+	result, err := decodePUTV1SecretsNameResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
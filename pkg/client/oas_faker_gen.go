@@ -9,6 +9,40 @@ import (
 	"github.com/go-faster/jx"
 )
 
+// SetFake set fake values.
+func (s *ArtifactResponse) SetFake() {
+	{
+		{
+			s.Delta.SetFake()
+		}
+	}
+	{
+		{
+			s.ExcludeTags.SetFake()
+		}
+	}
+	{
+		{
+			s.Format.SetFake()
+		}
+	}
+	{
+		{
+			s.Name.SetFake()
+		}
+	}
+	{
+		{
+			s.Nodeset.SetFake()
+		}
+	}
+	{
+		{
+			s.Tags.SetFake()
+		}
+	}
+}
+
 // SetFake set fake values.
 func (s *AuthRequest) SetFake() {
 	{
@@ -91,6 +125,11 @@ func (s *AuthTokenRequest) SetFake() {
 			s.Expire.SetFake()
 		}
 	}
+	{
+		{
+			s.Namespaces.SetFake()
+		}
+	}
 	{
 		{
 			s.Role.SetFake()
@@ -103,6 +142,135 @@ func (s *AuthTokenRequest) SetFake() {
 	}
 }
 
+// SetFake set fake values.
+func (s *BiosReport) SetFake() {
+	{
+		{
+			s.Attributes.SetFake()
+		}
+	}
+	{
+		{
+			s.Deviations = nil
+			for i := 0; i < 0; i++ {
+				var elem BiosReportDeviationsItem
+				{
+					elem.SetFake()
+				}
+				s.Deviations = append(s.Deviations, elem)
+			}
+		}
+	}
+	{
+		{
+			s.Host.SetFake()
+		}
+	}
+	{
+		{
+			s.JobID.SetFake()
+		}
+	}
+	{
+		{
+			s.Msg.SetFake()
+		}
+	}
+	{
+		{
+			s.RebootRequired.SetFake()
+		}
+	}
+	{
+		{
+			s.Status.SetFake()
+		}
+	}
+}
+
+// SetFake set fake values.
+func (s *BiosReportAttributes) SetFake() {
+	var (
+		elem NilString
+		m    map[string]NilString = s.init()
+	)
+	for i := 0; i < 0; i++ {
+		m[fmt.Sprintf("fake%d", i)] = elem
+	}
+}
+
+// SetFake set fake values.
+func (s *BiosReportDeviationsItem) SetFake() {
+	{
+		{
+			s.Attribute.SetFake()
+		}
+	}
+	{
+		{
+			s.Current.SetFake()
+		}
+	}
+	{
+		{
+			s.Pending.SetFake()
+		}
+	}
+	{
+		{
+			s.Profile.SetFake()
+		}
+	}
+}
+
+// SetFake set fake values.
+func (s *BmcBiosApplyBody) SetFake() {
+	{
+		{
+			s.Fanout.SetFake()
+		}
+	}
+	{
+		{
+			s.Profile.SetFake()
+		}
+	}
+	{
+		{
+			s.Reboot.SetFake()
+		}
+	}
+	{
+		{
+			s.Timeout.SetFake()
+		}
+	}
+}
+
+// SetFake set fake values.
+func (s *BmcBootOverrideBody) SetFake() {
+	{
+		{
+			s.Fanout.SetFake()
+		}
+	}
+	{
+		{
+			s.Persistent.SetFake()
+		}
+	}
+	{
+		{
+			s.Target.SetFake()
+		}
+	}
+	{
+		{
+			s.Timeout.SetFake()
+		}
+	}
+}
+
 // SetFake set fake values.
 func (s *BmcDellInstallFromRepoRequest) SetFake() {
 	{
@@ -147,6 +315,35 @@ func (s *BmcDellInstallFromRepoRequest) SetFake() {
 	}
 }
 
+// SetFake set fake values.
+func (s *BmcFirmwareUpdateBody) SetFake() {
+	{
+		{
+			s.Component.SetFake()
+		}
+	}
+	{
+		{
+			s.Fanout.SetFake()
+		}
+	}
+	{
+		{
+			s.Image.SetFake()
+		}
+	}
+	{
+		{
+			s.Timeout.SetFake()
+		}
+	}
+	{
+		{
+			s.Version.SetFake()
+		}
+	}
+}
+
 // SetFake set fake values.
 func (s *BmcImportConfigurationRequest) SetFake() {
 	{
@@ -188,11 +385,69 @@ func (s *BmcOsPowerBody) SetFake() {
 			s.BootOption.SetFake()
 		}
 	}
+	{
+		{
+			s.Fanout.SetFake()
+		}
+	}
 	{
 		{
 			s.PowerOption.SetFake()
 		}
 	}
+	{
+		{
+			s.Timeout.SetFake()
+		}
+	}
+}
+
+// SetFake set fake values.
+func (s *BmcRotatePasswordBody) SetFake() {
+	{
+		{
+			s.Fanout.SetFake()
+		}
+	}
+	{
+		{
+			s.Length.SetFake()
+		}
+	}
+	{
+		{
+			s.Timeout.SetFake()
+		}
+	}
+}
+
+// SetFake set fake values.
+func (s *BmcVirtualMediaBody) SetFake() {
+	{
+		{
+			s.Boot.SetFake()
+		}
+	}
+	{
+		{
+			s.Fanout.SetFake()
+		}
+	}
+	{
+		{
+			s.Image.SetFake()
+		}
+	}
+	{
+		{
+			s.Reboot.SetFake()
+		}
+	}
+	{
+		{
+			s.Timeout.SetFake()
+		}
+	}
 }
 
 // SetFake set fake values.
@@ -202,11 +457,21 @@ func (s *BootImage) SetFake() {
 			s.Cmdline.SetFake()
 		}
 	}
+	{
+		{
+			s.CreatedAt.SetFake()
+		}
+	}
 	{
 		{
 			s.ID.SetFake()
 		}
 	}
+	{
+		{
+			s.Inherits.SetFake()
+		}
+	}
 	{
 		{
 			s.Initrd = nil
@@ -221,7 +486,7 @@ func (s *BootImage) SetFake() {
 	}
 	{
 		{
-			s.Kernel = "string"
+			s.Kernel.SetFake()
 		}
 	}
 	{
@@ -239,11 +504,21 @@ func (s *BootImage) SetFake() {
 			s.ProvisionTemplates.SetFake()
 		}
 	}
+	{
+		{
+			s.Revision.SetFake()
+		}
+	}
 	{
 		{
 			s.UID.SetFake()
 		}
 	}
+	{
+		{
+			s.UpdatedAt.SetFake()
+		}
+	}
 	{
 		{
 			s.Verify.SetFake()
@@ -274,11 +549,21 @@ func (s *BootImageAddRequestBootImagesItem) SetFake() {
 			s.Cmdline.SetFake()
 		}
 	}
+	{
+		{
+			s.CreatedAt.SetFake()
+		}
+	}
 	{
 		{
 			s.ID.SetFake()
 		}
 	}
+	{
+		{
+			s.Inherits.SetFake()
+		}
+	}
 	{
 		{
 			s.Initrd = nil
@@ -311,11 +596,21 @@ func (s *BootImageAddRequestBootImagesItem) SetFake() {
 			s.ProvisionTemplates.SetFake()
 		}
 	}
+	{
+		{
+			s.Revision.SetFake()
+		}
+	}
 	{
 		{
 			s.UID.SetFake()
 		}
 	}
+	{
+		{
+			s.UpdatedAt.SetFake()
+		}
+	}
 	{
 		{
 			s.Verify.SetFake()
@@ -346,607 +641,569 @@ func (s *BootImageProvisionTemplates) SetFake() {
 }
 
 // SetFake set fake values.
-func (s *DataDump) SetFake() {
+func (s *BootTokenInfo) SetFake() {
 	{
 		{
-			s.Hosts = nil
-			for i := 0; i < 0; i++ {
-				var elem NilDataDumpHostsItem
-				{
-					elem.SetFake()
-				}
-				s.Hosts = append(s.Hosts, elem)
-			}
+			s.Expired.SetFake()
 		}
 	}
 	{
 		{
-			s.Images = nil
-			for i := 0; i < 0; i++ {
-				var elem NilDataDumpImagesItem
-				{
-					elem.SetFake()
-				}
-				s.Images = append(s.Images, elem)
-			}
+			s.ExpiresAt.SetFake()
 		}
 	}
 	{
 		{
-			s.Users = nil
-			for i := 0; i < 0; i++ {
-				var elem DataDumpUsersItem
-				{
-					elem.SetFake()
-				}
-				s.Users = append(s.Users, elem)
-			}
+			s.HostID.SetFake()
 		}
 	}
-}
-
-// SetFake set fake values.
-func (s *DataDumpHostsItem) SetFake() {
 	{
 		{
-			s.Bonds = nil
-			for i := 0; i < 0; i++ {
-				var elem NilDataDumpHostsItemBondsItem
-				{
-					elem.SetFake()
-				}
-				s.Bonds = append(s.Bonds, elem)
-			}
+			s.HostName.SetFake()
 		}
 	}
 	{
 		{
-			s.BootImage.SetFake()
+			s.ID.SetFake()
 		}
 	}
 	{
 		{
-			s.Firmware.SetFake()
+			s.IssuedAt.SetFake()
 		}
 	}
 	{
 		{
-			s.ID.SetFake()
+			s.MAC.SetFake()
 		}
 	}
 	{
 		{
-			s.Interfaces = nil
+			s.Revoked.SetFake()
+		}
+	}
+}
+
+// SetFake set fake values.
+func (s *CertRevokeRequest) SetFake() {
+	{
+		{
+			s.Nodeset.SetFake()
+		}
+	}
+	{
+		{
+			s.Serials = nil
 			for i := 0; i < 0; i++ {
-				var elem NilDataDumpHostsItemInterfacesItem
+				var elem string
 				{
-					elem.SetFake()
+					elem = "string"
 				}
-				s.Interfaces = append(s.Interfaces, elem)
+				s.Serials = append(s.Serials, elem)
 			}
 		}
 	}
+}
+
+// SetFake set fake values.
+func (s *ChangeFeed) SetFake() {
 	{
 		{
-			s.Name.SetFake()
+			s.Changes = nil
+			for i := 0; i < 0; i++ {
+				var elem NilChangeFeedChangesItem
+				{
+					elem.SetFake()
+				}
+				s.Changes = append(s.Changes, elem)
+			}
 		}
 	}
 	{
 		{
-			s.Provision.SetFake()
+			s.LatestSeq.SetFake()
 		}
 	}
 	{
 		{
-			s.Tags.SetFake()
+			s.OldestSeq.SetFake()
 		}
 	}
 	{
 		{
-			s.UID.SetFake()
+			s.Truncated.SetFake()
 		}
 	}
 }
 
 // SetFake set fake values.
-func (s *DataDumpHostsItemBondsItem) SetFake() {
+func (s *ChangeFeedChangesItem) SetFake() {
 	{
 		{
-			s.Bmc.SetFake()
+			s.ChangedAt.SetFake()
 		}
 	}
 	{
 		{
-			s.Fqdn.SetFake()
+			s.Diff.SetFake()
 		}
 	}
 	{
 		{
-			s.ID.SetFake()
+			s.Kind.SetFake()
 		}
 	}
 	{
 		{
-			s.Ifname.SetFake()
+			s.Name.SetFake()
 		}
 	}
 	{
 		{
-			s.IP.SetFake()
+			s.Op.SetFake()
 		}
 	}
 	{
 		{
-			s.MAC.SetFake()
+			s.Seq.SetFake()
 		}
 	}
-	{
-		{
-			s.Mtu.SetFake()
-		}
+}
+
+// SetFake set fake values.
+func (s *ChangeFeedChangesItemDiff) SetFake() {
+	var (
+		elem NilChangeFeedChangesItemDiffItem
+		m    map[string]NilChangeFeedChangesItemDiffItem = s.init()
+	)
+	for i := 0; i < 0; i++ {
+		m[fmt.Sprintf("fake%d", i)] = elem
 	}
+}
+
+// SetFake set fake values.
+func (s *ChangeFeedChangesItemDiffItem) SetFake() {
 	{
 		{
-			s.Peers = nil
-			for i := 0; i < 0; i++ {
-				var elem string
-				{
-					elem = "string"
-				}
-				s.Peers = append(s.Peers, elem)
-			}
+			s.New.SetFake()
 		}
 	}
 	{
 		{
-			s.Vlan.SetFake()
+			s.Old.SetFake()
 		}
 	}
 }
 
 // SetFake set fake values.
-func (s *DataDumpHostsItemInterfacesItem) SetFake() {
+func (s *Credential) SetFake() {
 	{
 		{
-			s.Bmc.SetFake()
+			s.Kind = "string"
 		}
 	}
 	{
 		{
-			s.Fqdn.SetFake()
+			s.Name = "string"
 		}
 	}
 	{
 		{
-			s.ID.SetFake()
+			s.Secret.SetFake()
 		}
 	}
+}
+
+// SetFake set fake values.
+func (s *DNSRecordAddRequest) SetFake() {
 	{
 		{
-			s.Ifname.SetFake()
+			s.Records = nil
+			for i := 0; i < 0; i++ {
+				var elem NilDNSRecordAddRequestRecordsItem
+				{
+					elem.SetFake()
+				}
+				s.Records = append(s.Records, elem)
+			}
 		}
 	}
+}
+
+// SetFake set fake values.
+func (s *DNSRecordAddRequestRecordsItem) SetFake() {
 	{
 		{
-			s.IP.SetFake()
+			s.ID.SetFake()
 		}
 	}
 	{
 		{
-			s.MAC.SetFake()
+			s.Name.SetFake()
 		}
 	}
 	{
 		{
-			s.Mtu.SetFake()
+			s.Ptr.SetFake()
 		}
 	}
 	{
 		{
-			s.Vlan.SetFake()
+			s.TTL.SetFake()
 		}
 	}
-}
-
-// SetFake set fake values.
-func (s *DataDumpImagesItem) SetFake() {
 	{
 		{
-			s.Cmdline.SetFake()
+			s.Type.SetFake()
 		}
 	}
 	{
 		{
-			s.ID.SetFake()
+			s.Value.SetFake()
+		}
+	}
+}
+
+// SetFake set fake values.
+func (s *DataDump) SetFake() {
+	{
+		{
+			s.Credentials.SetFake()
 		}
 	}
 	{
 		{
-			s.Initrd = nil
+			s.DNSRecords = nil
 			for i := 0; i < 0; i++ {
-				var elem string
+				var elem NilDataDumpDNSRecordsItem
 				{
-					elem = "string"
+					elem.SetFake()
 				}
-				s.Initrd = append(s.Initrd, elem)
+				s.DNSRecords = append(s.DNSRecords, elem)
 			}
 		}
 	}
 	{
 		{
-			s.Kernel.SetFake()
+			s.Hosts = nil
+			for i := 0; i < 0; i++ {
+				var elem NilDataDumpHostsItem
+				{
+					elem.SetFake()
+				}
+				s.Hosts = append(s.Hosts, elem)
+			}
 		}
 	}
 	{
 		{
-			s.Liveimg.SetFake()
+			s.Images = nil
+			for i := 0; i < 0; i++ {
+				var elem NilDataDumpImagesItem
+				{
+					elem.SetFake()
+				}
+				s.Images = append(s.Images, elem)
+			}
 		}
 	}
 	{
 		{
-			s.Name.SetFake()
+			s.Users = nil
+			for i := 0; i < 0; i++ {
+				var elem DataDumpUsersItem
+				{
+					elem.SetFake()
+				}
+				s.Users = append(s.Users, elem)
+			}
 		}
 	}
+}
+
+// SetFake set fake values.
+func (s *DataDumpCredentialsItem) SetFake() {
 	{
 		{
-			s.ProvisionTemplates.SetFake()
+			s.Kind.SetFake()
 		}
 	}
 	{
 		{
-			s.UID.SetFake()
+			s.Name.SetFake()
 		}
 	}
 	{
 		{
-			s.Verify.SetFake()
+			s.Secret.SetFake()
 		}
 	}
 }
 
 // SetFake set fake values.
-func (s *DataDumpImagesItemProvisionTemplates) SetFake() {
-	var (
-		elem NilString
-		m    map[string]NilString = s.init()
-	)
-	for i := 0; i < 0; i++ {
-		m[fmt.Sprintf("fake%d", i)] = elem
-	}
-}
-
-// SetFake set fake values.
-func (s *DataDumpUsersItem) SetFake() {
-	{
-		{
-			s.CreatedAt.SetFake()
-		}
-	}
+func (s *DataDumpDNSRecordsItem) SetFake() {
 	{
 		{
-			s.Enabled.SetFake()
+			s.ID.SetFake()
 		}
 	}
 	{
 		{
-			s.Hash.SetFake()
+			s.Name.SetFake()
 		}
 	}
 	{
 		{
-			s.ID.SetFake()
+			s.Ptr.SetFake()
 		}
 	}
 	{
 		{
-			s.ModifiedAt.SetFake()
+			s.TTL.SetFake()
 		}
 	}
 	{
 		{
-			s.Role.SetFake()
+			s.Type.SetFake()
 		}
 	}
 	{
 		{
-			s.Username.SetFake()
+			s.Value.SetFake()
 		}
 	}
 }
 
 // SetFake set fake values.
-func (s *Event) SetFake() {
+func (s *DataDumpHostsItem) SetFake() {
 	{
 		{
-			s.JobMessages = nil
+			s.Bonds = nil
 			for i := 0; i < 0; i++ {
-				var elem EventJobMessagesItem
+				var elem NilDataDumpHostsItemBondsItem
 				{
 					elem.SetFake()
 				}
-				s.JobMessages = append(s.JobMessages, elem)
+				s.Bonds = append(s.Bonds, elem)
 			}
 		}
 	}
 	{
 		{
-			s.Message.SetFake()
+			s.BootImage.SetFake()
 		}
 	}
 	{
 		{
-			s.Severity.SetFake()
+			s.ClientCertFingerprint.SetFake()
 		}
 	}
 	{
 		{
-			s.Time.SetFake()
+			s.ClientCertSerial.SetFake()
 		}
 	}
 	{
 		{
-			s.User.SetFake()
+			s.CreatedAt.SetFake()
 		}
 	}
-}
-
-// SetFake set fake values.
-func (s *EventJobMessagesItem) SetFake() {
 	{
 		{
-			s.Data.SetFake()
+			s.Firmware.SetFake()
 		}
 	}
 	{
 		{
-			s.Host.SetFake()
+			s.Hardware.SetFake()
 		}
 	}
 	{
 		{
-			s.Msg.SetFake()
+			s.ID.SetFake()
 		}
 	}
 	{
 		{
-			s.RedfishError.SetFake()
+			s.Interfaces = nil
+			for i := 0; i < 0; i++ {
+				var elem NilDataDumpHostsItemInterfacesItem
+				{
+					elem.SetFake()
+				}
+				s.Interfaces = append(s.Interfaces, elem)
+			}
 		}
 	}
 	{
 		{
-			s.Status.SetFake()
+			s.Inventory.SetFake()
 		}
 	}
-}
-
-// SetFake set fake values.
-func (s *EventJobMessagesItemRedfishError) SetFake() {
 	{
 		{
-			s.Code.SetFake()
+			s.Name.SetFake()
 		}
 	}
 	{
 		{
-			s.Error.SetFake()
+			s.Provision.SetFake()
 		}
 	}
-}
-
-// SetFake set fake values.
-func (s *EventJobMessagesItemRedfishErrorError) SetFake() {
 	{
 		{
-			s.MessageDotExtendedInfo = nil
-			for i := 0; i < 0; i++ {
-				var elem EventJobMessagesItemRedfishErrorErrorMessageDotExtendedInfoItem
-				{
-					elem.SetFake()
-				}
-				s.MessageDotExtendedInfo = append(s.MessageDotExtendedInfo, elem)
-			}
+			s.Revision.SetFake()
 		}
 	}
 	{
 		{
-			s.Code.SetFake()
+			s.SmbiosUUID.SetFake()
 		}
 	}
 	{
 		{
-			s.Message.SetFake()
+			s.State.SetFake()
 		}
 	}
-}
-
-// SetFake set fake values.
-func (s *EventJobMessagesItemRedfishErrorErrorMessageDotExtendedInfoItem) SetFake() {
 	{
 		{
-			s.Message.SetFake()
-		}
-	}
-	{
-		{
-			s.MessageArgsDotOdataDotCount.SetFake()
-		}
-	}
-	{
-		{
-			s.MessageId.SetFake()
-		}
-	}
-	{
-		{
-			s.RelatedPropertiesDotOdataDotCount.SetFake()
+			s.Tags.SetFake()
 		}
 	}
 	{
 		{
-			s.Resolution.SetFake()
+			s.UID.SetFake()
 		}
 	}
 	{
 		{
-			s.Severity.SetFake()
+			s.UpdatedAt.SetFake()
 		}
 	}
 }
 
 // SetFake set fake values.
-func (s *GenericResponse) SetFake() {
-	{
-		{
-			s.Changed.SetFake()
-		}
-	}
+func (s *DataDumpHostsItemBondsItem) SetFake() {
 	{
 		{
-			s.Detail.SetFake()
+			s.Addresses = nil
+			for i := 0; i < 0; i++ {
+				var elem DataDumpHostsItemBondsItemAddressesItem
+				{
+					elem.SetFake()
+				}
+				s.Addresses = append(s.Addresses, elem)
+			}
 		}
 	}
 	{
 		{
-			s.Title.SetFake()
+			s.Bmc.SetFake()
 		}
 	}
-}
-
-// SetFake set fake values.
-func (s *GetRolesResponse) SetFake() {
 	{
 		{
-			s.Roles = nil
-			for i := 0; i < 0; i++ {
-				var elem GetRolesResponseRolesItem
-				{
-					elem.SetFake()
-				}
-				s.Roles = append(s.Roles, elem)
-			}
+			s.Fqdn.SetFake()
 		}
 	}
-}
-
-// SetFake set fake values.
-func (s *GetRolesResponseRolesItem) SetFake() {
 	{
 		{
-			s.Name.SetFake()
+			s.ID.SetFake()
 		}
 	}
 	{
 		{
-			s.PermissionList = nil
-			for i := 0; i < 0; i++ {
-				var elem GetRolesResponseRolesItemPermissionListItem
-				{
-					elem.SetFake()
-				}
-				s.PermissionList = append(s.PermissionList, elem)
-			}
+			s.Ifname.SetFake()
 		}
 	}
 	{
 		{
-			s.UnassignedPermissionList = nil
-			for i := 0; i < 0; i++ {
-				var elem GetRolesResponseRolesItemUnassignedPermissionListItem
-				{
-					elem.SetFake()
-				}
-				s.UnassignedPermissionList = append(s.UnassignedPermissionList, elem)
-			}
+			s.IP.SetFake()
 		}
 	}
-}
-
-// SetFake set fake values.
-func (s *GetRolesResponseRolesItemPermissionListItem) SetFake() {
 	{
 		{
-			s.Method.SetFake()
+			s.MAC.SetFake()
 		}
 	}
 	{
 		{
-			s.Path.SetFake()
+			s.Mode.SetFake()
 		}
 	}
-}
-
-// SetFake set fake values.
-func (s *GetRolesResponseRolesItemUnassignedPermissionListItem) SetFake() {
 	{
 		{
-			s.Method.SetFake()
+			s.Mtu.SetFake()
 		}
 	}
 	{
 		{
-			s.Path.SetFake()
+			s.Options.SetFake()
 		}
 	}
-}
-
-// SetFake set fake values.
-func (s *HTTPError) SetFake() {
 	{
 		{
-			s.Detail.SetFake()
+			s.Parent.SetFake()
 		}
 	}
 	{
 		{
-			s.Errors.SetFake()
+			s.Peers = nil
+			for i := 0; i < 0; i++ {
+				var elem string
+				{
+					elem = "string"
+				}
+				s.Peers = append(s.Peers, elem)
+			}
 		}
 	}
 	{
 		{
-			s.Instance.SetFake()
+			s.Port.SetFake()
 		}
 	}
 	{
 		{
-			s.Status.SetFake()
+			s.Switch.SetFake()
 		}
 	}
 	{
 		{
-			s.Title.SetFake()
+			s.Type.SetFake()
 		}
 	}
 	{
 		{
-			s.Type.SetFake()
+			s.Vlan.SetFake()
 		}
 	}
 }
 
 // SetFake set fake values.
-func (s *HTTPErrorErrorsItem) SetFake() {
+func (s *DataDumpHostsItemBondsItemAddressesItem) SetFake() {
 	{
 		{
-			s.More.SetFake()
+			s.Fqdn.SetFake()
 		}
 	}
 	{
 		{
-			s.Name.SetFake()
+			s.IP.SetFake()
 		}
 	}
 	{
 		{
-			s.Reason.SetFake()
+			s.Primary.SetFake()
 		}
 	}
 }
 
 // SetFake set fake values.
-func (s *HTTPErrorErrorsItemMore) SetFake() {
+func (s *DataDumpHostsItemBondsItemOptions) SetFake() {
 	var (
-		elem jx.Raw
-		m    map[string]jx.Raw = s.init()
+		elem string
+		m    map[string]string = s.init()
 	)
 	for i := 0; i < 0; i++ {
 		m[fmt.Sprintf("fake%d", i)] = elem
@@ -954,95 +1211,94 @@ func (s *HTTPErrorErrorsItemMore) SetFake() {
 }
 
 // SetFake set fake values.
-func (s *Host) SetFake() {
+func (s *DataDumpHostsItemHardware) SetFake() {
 	{
 		{
-			s.Bonds = nil
-			for i := 0; i < 0; i++ {
-				var elem NilHostBondsItem
-				{
-					elem.SetFake()
-				}
-				s.Bonds = append(s.Bonds, elem)
-			}
+			s.CollectedAt.SetFake()
 		}
 	}
 	{
 		{
-			s.BootImage.SetFake()
+			s.CoreCount.SetFake()
 		}
 	}
 	{
 		{
-			s.Firmware.SetFake()
+			s.CPUCount.SetFake()
 		}
 	}
 	{
 		{
-			s.ID.SetFake()
+			s.CPUModel.SetFake()
 		}
 	}
 	{
 		{
-			s.Interfaces = nil
-			for i := 0; i < 0; i++ {
-				var elem NilHostInterfacesItem
-				{
-					elem.SetFake()
-				}
-				s.Interfaces = append(s.Interfaces, elem)
-			}
+			s.Dimms.SetFake()
 		}
 	}
 	{
 		{
-			s.Name.SetFake()
+			s.Disks.SetFake()
 		}
 	}
 	{
 		{
-			s.Provision.SetFake()
+			s.MemoryMib.SetFake()
 		}
 	}
 	{
 		{
-			s.Tags.SetFake()
+			s.Missing.SetFake()
 		}
 	}
 	{
 		{
-			s.UID.SetFake()
+			s.Nics.SetFake()
 		}
 	}
 }
 
 // SetFake set fake values.
-func (s *HostBondsItem) SetFake() {
+func (s *DataDumpHostsItemHardwareDimmsItem) SetFake() {
 	{
 		{
-			s.Bmc.SetFake()
+			s.SizeMib.SetFake()
 		}
 	}
 	{
 		{
-			s.Fqdn.SetFake()
+			s.Slot.SetFake()
+		}
+	}
+}
+
+// SetFake set fake values.
+func (s *DataDumpHostsItemHardwareDisksItem) SetFake() {
+	{
+		{
+			s.Model.SetFake()
 		}
 	}
 	{
 		{
-			s.ID.SetFake()
+			s.Name.SetFake()
 		}
 	}
 	{
 		{
-			s.Ifname.SetFake()
+			s.Serial.SetFake()
 		}
 	}
 	{
 		{
-			s.IP.SetFake()
+			s.SizeBytes.SetFake()
 		}
 	}
+}
+
+// SetFake set fake values.
+func (s *DataDumpHostsItemHardwareNicsItem) SetFake() {
 	{
 		{
 			s.MAC.SetFake()
@@ -1050,30 +1306,25 @@ func (s *HostBondsItem) SetFake() {
 	}
 	{
 		{
-			s.Mtu.SetFake()
+			s.Name.SetFake()
 		}
 	}
+}
+
+// SetFake set fake values.
+func (s *DataDumpHostsItemInterfacesItem) SetFake() {
 	{
 		{
-			s.Peers = nil
+			s.Addresses = nil
 			for i := 0; i < 0; i++ {
-				var elem string
+				var elem DataDumpHostsItemInterfacesItemAddressesItem
 				{
-					elem = "string"
+					elem.SetFake()
 				}
-				s.Peers = append(s.Peers, elem)
+				s.Addresses = append(s.Addresses, elem)
 			}
 		}
 	}
-	{
-		{
-			s.Vlan.SetFake()
-		}
-	}
-}
-
-// SetFake set fake values.
-func (s *HostInterfacesItem) SetFake() {
 	{
 		{
 			s.Bmc.SetFake()
@@ -1111,281 +1362,306 @@ func (s *HostInterfacesItem) SetFake() {
 	}
 	{
 		{
-			s.Vlan.SetFake()
+			s.Parent.SetFake()
 		}
 	}
-}
-
-// SetFake set fake values.
-func (s *JobMessage) SetFake() {
 	{
 		{
-			s.Data.SetFake()
+			s.Port.SetFake()
 		}
 	}
 	{
 		{
-			s.Host.SetFake()
+			s.Switch.SetFake()
 		}
 	}
 	{
 		{
-			s.Msg.SetFake()
+			s.Vlan.SetFake()
 		}
 	}
+}
+
+// SetFake set fake values.
+func (s *DataDumpHostsItemInterfacesItemAddressesItem) SetFake() {
 	{
 		{
-			s.RedfishError.SetFake()
+			s.Fqdn.SetFake()
 		}
 	}
 	{
 		{
-			s.Status.SetFake()
+			s.IP.SetFake()
 		}
 	}
-}
-
-// SetFake set fake values.
-func (s *JobMessageRedfishError) SetFake() {
 	{
 		{
-			s.Code.SetFake()
+			s.Primary.SetFake()
 		}
 	}
+}
+
+// SetFake set fake values.
+func (s *DataDumpHostsItemInventory) SetFake() {
 	{
 		{
-			s.Error.SetFake()
+			s.BiosVersion.SetFake()
 		}
 	}
-}
-
-// SetFake set fake values.
-func (s *JobMessageRedfishErrorError) SetFake() {
 	{
 		{
-			s.MessageDotExtendedInfo.SetFake()
+			s.BmcFirmware.SetFake()
 		}
 	}
 	{
 		{
-			s.Code.SetFake()
+			s.CapturedAt.SetFake()
 		}
 	}
 	{
 		{
-			s.Message.SetFake()
+			s.NicFirmware.SetFake()
 		}
 	}
 }
 
 // SetFake set fake values.
-func (s *JobMessageRedfishErrorErrorMessageDotExtendedInfoItem) SetFake() {
+func (s *DataDumpHostsItemInventoryNicFirmware) SetFake() {
+	var (
+		elem string
+		m    map[string]string = s.init()
+	)
+	for i := 0; i < 0; i++ {
+		m[fmt.Sprintf("fake%d", i)] = elem
+	}
+}
+
+// SetFake set fake values.
+func (s *DataDumpImagesItem) SetFake() {
 	{
 		{
-			s.Message.SetFake()
+			s.Cmdline.SetFake()
 		}
 	}
 	{
 		{
-			s.MessageArgsDotOdataDotCount.SetFake()
+			s.CreatedAt.SetFake()
 		}
 	}
 	{
 		{
-			s.MessageId.SetFake()
+			s.ID.SetFake()
 		}
 	}
 	{
 		{
-			s.RelatedPropertiesDotOdataDotCount.SetFake()
+			s.Inherits.SetFake()
 		}
 	}
 	{
 		{
-			s.Resolution.SetFake()
+			s.Initrd = nil
+			for i := 0; i < 0; i++ {
+				var elem string
+				{
+					elem = "string"
+				}
+				s.Initrd = append(s.Initrd, elem)
+			}
 		}
 	}
 	{
 		{
-			s.Severity.SetFake()
+			s.Kernel.SetFake()
 		}
 	}
-}
-
-// SetFake set fake values.
-func (s *LLDP) SetFake() {
 	{
 		{
-			s.ChassisID.SetFake()
+			s.Liveimg.SetFake()
 		}
 	}
 	{
 		{
-			s.ChassisIDType.SetFake()
+			s.Name.SetFake()
 		}
 	}
 	{
 		{
-			s.ManagementAddress.SetFake()
+			s.ProvisionTemplates.SetFake()
 		}
 	}
 	{
 		{
-			s.PortDescription.SetFake()
+			s.Revision.SetFake()
 		}
 	}
 	{
 		{
-			s.PortID.SetFake()
+			s.UID.SetFake()
 		}
 	}
 	{
 		{
-			s.PortIDType.SetFake()
+			s.UpdatedAt.SetFake()
 		}
 	}
 	{
 		{
-			s.PortName.SetFake()
+			s.Verify.SetFake()
 		}
 	}
-	{
-		{
-			s.SystemDescription.SetFake()
-		}
-	}
-	{
-		{
-			s.SystemName.SetFake()
-		}
-	}
-}
-
-// SetFake set fake values.
-func (s *NilBootImageAddRequestBootImagesItem) SetFake() {
-	s.Null = true
-}
-
-// SetFake set fake values.
-func (s *NilDataDumpHostsItem) SetFake() {
-	s.Null = true
-}
-
-// SetFake set fake values.
-func (s *NilDataDumpHostsItemBondsItem) SetFake() {
-	s.Null = true
-}
-
-// SetFake set fake values.
-func (s *NilDataDumpHostsItemInterfacesItem) SetFake() {
-	s.Null = true
-}
-
-// SetFake set fake values.
-func (s *NilDataDumpImagesItem) SetFake() {
-	s.Null = true
-}
-
-// SetFake set fake values.
-func (s *NilHostBondsItem) SetFake() {
-	s.Null = true
-}
-
-// SetFake set fake values.
-func (s *NilHostInterfacesItem) SetFake() {
-	s.Null = true
-}
-
-// SetFake set fake values.
-func (s *NilInt) SetFake() {
-	s.Null = true
 }
 
 // SetFake set fake values.
-func (s *NilNodeAddRequestNodeListItem) SetFake() {
-	s.Null = true
-}
-
-// SetFake set fake values.
-func (s *NilNodeAddRequestNodeListItemBondsItem) SetFake() {
-	s.Null = true
-}
-
-// SetFake set fake values.
-func (s *NilNodeAddRequestNodeListItemInterfacesItem) SetFake() {
-	s.Null = true
-}
-
-// SetFake set fake values.
-func (s *NilRedfishJobJobsItem) SetFake() {
-	s.Null = true
+func (s *DataDumpImagesItemProvisionTemplates) SetFake() {
+	var (
+		elem NilString
+		m    map[string]NilString = s.init()
+	)
+	for i := 0; i < 0; i++ {
+		m[fmt.Sprintf("fake%d", i)] = elem
+	}
 }
 
 // SetFake set fake values.
-func (s *NilRedfishMetricReportReportsItem) SetFake() {
-	s.Null = true
+func (s *DataDumpUsersItem) SetFake() {
+	{
+		{
+			s.CreatedAt.SetFake()
+		}
+	}
+	{
+		{
+			s.Enabled.SetFake()
+		}
+	}
+	{
+		{
+			s.Hash.SetFake()
+		}
+	}
+	{
+		{
+			s.ID.SetFake()
+		}
+	}
+	{
+		{
+			s.ModifiedAt.SetFake()
+		}
+	}
+	{
+		{
+			s.Role.SetFake()
+		}
+	}
+	{
+		{
+			s.Username.SetFake()
+		}
+	}
 }
 
 // SetFake set fake values.
-func (s *NilString) SetFake() {
-	s.Null = true
+func (s *DataLoadRequest) SetFake() {
+	{
+		{
+			s.DryRun.SetFake()
+		}
+	}
+	{
+		{
+			s.Dump.SetFake()
+		}
+	}
+	{
+		{
+			s.Prune.SetFake()
+		}
+	}
 }
 
 // SetFake set fake values.
-func (s *NodeAddRequest) SetFake() {
+func (s *DataLoadRequestDump) SetFake() {
 	{
 		{
-			s.NodeList = nil
+			s.Credentials.SetFake()
+		}
+	}
+	{
+		{
+			s.DNSRecords = nil
 			for i := 0; i < 0; i++ {
-				var elem NilNodeAddRequestNodeListItem
+				var elem NilDataLoadRequestDumpDNSRecordsItem
 				{
 					elem.SetFake()
 				}
-				s.NodeList = append(s.NodeList, elem)
+				s.DNSRecords = append(s.DNSRecords, elem)
 			}
 		}
 	}
-}
-
-// SetFake set fake values.
-func (s *NodeAddRequestNodeListItem) SetFake() {
 	{
 		{
-			s.Bonds = nil
+			s.Hosts = nil
 			for i := 0; i < 0; i++ {
-				var elem NilNodeAddRequestNodeListItemBondsItem
+				var elem NilDataLoadRequestDumpHostsItem
 				{
 					elem.SetFake()
 				}
-				s.Bonds = append(s.Bonds, elem)
+				s.Hosts = append(s.Hosts, elem)
 			}
 		}
 	}
 	{
 		{
-			s.BootImage.SetFake()
+			s.Images = nil
+			for i := 0; i < 0; i++ {
+				var elem NilDataLoadRequestDumpImagesItem
+				{
+					elem.SetFake()
+				}
+				s.Images = append(s.Images, elem)
+			}
 		}
 	}
 	{
 		{
-			s.Firmware.SetFake()
+			s.Users = nil
+			for i := 0; i < 0; i++ {
+				var elem DataLoadRequestDumpUsersItem
+				{
+					elem.SetFake()
+				}
+				s.Users = append(s.Users, elem)
+			}
+		}
+	}
+}
+
+// SetFake set fake values.
+func (s *DataLoadRequestDumpCredentialsItem) SetFake() {
+	{
+		{
+			s.Kind.SetFake()
 		}
 	}
 	{
 		{
-			s.ID.SetFake()
+			s.Name.SetFake()
 		}
 	}
 	{
 		{
-			s.Interfaces = nil
-			for i := 0; i < 0; i++ {
-				var elem NilNodeAddRequestNodeListItemInterfacesItem
-				{
-					elem.SetFake()
-				}
-				s.Interfaces = append(s.Interfaces, elem)
-			}
+			s.Secret.SetFake()
+		}
+	}
+}
+
+// SetFake set fake values.
+func (s *DataLoadRequestDumpDNSRecordsItem) SetFake() {
+	{
+		{
+			s.ID.SetFake()
 		}
 	}
 	{
@@ -1395,746 +1671,5600 @@ func (s *NodeAddRequestNodeListItem) SetFake() {
 	}
 	{
 		{
-			s.Provision.SetFake()
+			s.Ptr.SetFake()
 		}
 	}
 	{
 		{
-			s.Tags.SetFake()
+			s.TTL.SetFake()
 		}
 	}
 	{
 		{
-			s.UID.SetFake()
+			s.Type.SetFake()
+		}
+	}
+	{
+		{
+			s.Value.SetFake()
 		}
 	}
 }
 
 // SetFake set fake values.
-func (s *NodeAddRequestNodeListItemBondsItem) SetFake() {
+func (s *DataLoadRequestDumpHostsItem) SetFake() {
 	{
 		{
-			s.Bmc.SetFake()
+			s.Bonds = nil
+			for i := 0; i < 0; i++ {
+				var elem NilDataLoadRequestDumpHostsItemBondsItem
+				{
+					elem.SetFake()
+				}
+				s.Bonds = append(s.Bonds, elem)
+			}
 		}
 	}
 	{
 		{
-			s.Fqdn.SetFake()
+			s.BootImage.SetFake()
 		}
 	}
 	{
 		{
-			s.ID.SetFake()
+			s.ClientCertFingerprint.SetFake()
 		}
 	}
 	{
 		{
-			s.Ifname.SetFake()
+			s.ClientCertSerial.SetFake()
 		}
 	}
 	{
 		{
-			s.IP.SetFake()
+			s.CreatedAt.SetFake()
 		}
 	}
 	{
 		{
-			s.MAC.SetFake()
+			s.Firmware.SetFake()
 		}
 	}
 	{
 		{
-			s.Mtu.SetFake()
+			s.Hardware.SetFake()
 		}
 	}
 	{
 		{
-			s.Peers = nil
-			for i := 0; i < 0; i++ {
-				var elem string
-				{
-					elem = "string"
-				}
-				s.Peers = append(s.Peers, elem)
-			}
+			s.ID.SetFake()
 		}
 	}
 	{
 		{
-			s.Vlan.SetFake()
+			s.Interfaces = nil
+			for i := 0; i < 0; i++ {
+				var elem NilDataLoadRequestDumpHostsItemInterfacesItem
+				{
+					elem.SetFake()
+				}
+				s.Interfaces = append(s.Interfaces, elem)
+			}
 		}
 	}
-}
-
-// SetFake set fake values.
-func (s *NodeAddRequestNodeListItemInterfacesItem) SetFake() {
 	{
 		{
-			s.Bmc.SetFake()
+			s.Inventory.SetFake()
 		}
 	}
 	{
 		{
-			s.Fqdn.SetFake()
+			s.Name.SetFake()
 		}
 	}
 	{
 		{
-			s.ID.SetFake()
+			s.Provision.SetFake()
 		}
 	}
 	{
 		{
-			s.Ifname.SetFake()
+			s.Revision.SetFake()
 		}
 	}
 	{
 		{
-			s.IP.SetFake()
+			s.SmbiosUUID.SetFake()
 		}
 	}
 	{
 		{
-			s.MAC.SetFake()
+			s.State.SetFake()
 		}
 	}
 	{
 		{
-			s.Mtu.SetFake()
+			s.Tags.SetFake()
 		}
 	}
 	{
 		{
-			s.Vlan.SetFake()
+			s.UID.SetFake()
 		}
 	}
-}
-
-// SetFake set fake values.
-func (s *NodeBootImageRequest) SetFake() {
 	{
 		{
-			s.Image.SetFake()
+			s.UpdatedAt.SetFake()
 		}
 	}
 }
 
 // SetFake set fake values.
-func (s *NodeBootTokenResponse) SetFake() {
+func (s *DataLoadRequestDumpHostsItemBondsItem) SetFake() {
 	{
 		{
-			s.Nodes = nil
+			s.Addresses = nil
 			for i := 0; i < 0; i++ {
-				var elem NodeBootTokenResponseNodesItem
+				var elem DataLoadRequestDumpHostsItemBondsItemAddressesItem
 				{
 					elem.SetFake()
 				}
-				s.Nodes = append(s.Nodes, elem)
+				s.Addresses = append(s.Addresses, elem)
 			}
 		}
 	}
-}
-
-// SetFake set fake values.
-func (s *NodeBootTokenResponseNodesItem) SetFake() {
 	{
 		{
-			s.Name.SetFake()
+			s.Bmc.SetFake()
 		}
 	}
 	{
 		{
-			s.Token.SetFake()
+			s.Fqdn.SetFake()
+		}
+	}
+	{
+		{
+			s.ID.SetFake()
+		}
+	}
+	{
+		{
+			s.Ifname.SetFake()
+		}
+	}
+	{
+		{
+			s.IP.SetFake()
+		}
+	}
+	{
+		{
+			s.MAC.SetFake()
+		}
+	}
+	{
+		{
+			s.Mode.SetFake()
+		}
+	}
+	{
+		{
+			s.Mtu.SetFake()
+		}
+	}
+	{
+		{
+			s.Options.SetFake()
+		}
+	}
+	{
+		{
+			s.Parent.SetFake()
+		}
+	}
+	{
+		{
+			s.Peers = nil
+			for i := 0; i < 0; i++ {
+				var elem string
+				{
+					elem = "string"
+				}
+				s.Peers = append(s.Peers, elem)
+			}
+		}
+	}
+	{
+		{
+			s.Port.SetFake()
+		}
+	}
+	{
+		{
+			s.Switch.SetFake()
+		}
+	}
+	{
+		{
+			s.Type.SetFake()
+		}
+	}
+	{
+		{
+			s.Vlan.SetFake()
 		}
 	}
 }
 
 // SetFake set fake values.
-func (s *NodeProvisionRequest) SetFake() {
+func (s *DataLoadRequestDumpHostsItemBondsItemAddressesItem) SetFake() {
 	{
 		{
-			s.Provision.SetFake()
+			s.Fqdn.SetFake()
+		}
+	}
+	{
+		{
+			s.IP.SetFake()
+		}
+	}
+	{
+		{
+			s.Primary.SetFake()
 		}
 	}
 }
 
 // SetFake set fake values.
-func (s *NodeTagsRequest) SetFake() {
+func (s *DataLoadRequestDumpHostsItemBondsItemOptions) SetFake() {
+	var (
+		elem string
+		m    map[string]string = s.init()
+	)
+	for i := 0; i < 0; i++ {
+		m[fmt.Sprintf("fake%d", i)] = elem
+	}
+}
+
+// SetFake set fake values.
+func (s *DataLoadRequestDumpHostsItemHardware) SetFake() {
 	{
 		{
-			s.Tags.SetFake()
+			s.CollectedAt.SetFake()
+		}
+	}
+	{
+		{
+			s.CoreCount.SetFake()
+		}
+	}
+	{
+		{
+			s.CPUCount.SetFake()
+		}
+	}
+	{
+		{
+			s.CPUModel.SetFake()
+		}
+	}
+	{
+		{
+			s.Dimms.SetFake()
+		}
+	}
+	{
+		{
+			s.Disks.SetFake()
+		}
+	}
+	{
+		{
+			s.MemoryMib.SetFake()
+		}
+	}
+	{
+		{
+			s.Missing.SetFake()
+		}
+	}
+	{
+		{
+			s.Nics.SetFake()
 		}
 	}
 }
 
 // SetFake set fake values.
-func (s *OptBmcJobDeleteRequestNodeJobList) SetFake() {
-	var elem BmcJobDeleteRequestNodeJobList
+func (s *DataLoadRequestDumpHostsItemHardwareDimmsItem) SetFake() {
 	{
-		elem.SetFake()
+		{
+			s.SizeMib.SetFake()
+		}
+	}
+	{
+		{
+			s.Slot.SetFake()
+		}
 	}
-	s.SetTo(elem)
 }
 
 // SetFake set fake values.
-func (s *OptBool) SetFake() {
-	var elem bool
+func (s *DataLoadRequestDumpHostsItemHardwareDisksItem) SetFake() {
 	{
-		elem = true
+		{
+			s.Model.SetFake()
+		}
+	}
+	{
+		{
+			s.Name.SetFake()
+		}
+	}
+	{
+		{
+			s.Serial.SetFake()
+		}
+	}
+	{
+		{
+			s.SizeBytes.SetFake()
+		}
 	}
-	s.SetTo(elem)
 }
 
 // SetFake set fake values.
-func (s *OptDateTime) SetFake() {
-	var elem time.Time
+func (s *DataLoadRequestDumpHostsItemHardwareNicsItem) SetFake() {
 	{
-		elem = time.Now()
+		{
+			s.MAC.SetFake()
+		}
+	}
+	{
+		{
+			s.Name.SetFake()
+		}
 	}
-	s.SetTo(elem)
 }
 
 // SetFake set fake values.
-func (s *OptEventJobMessagesItemRedfishError) SetFake() {
-	var elem EventJobMessagesItemRedfishError
+func (s *DataLoadRequestDumpHostsItemInterfacesItem) SetFake() {
 	{
-		elem.SetFake()
+		{
+			s.Addresses = nil
+			for i := 0; i < 0; i++ {
+				var elem DataLoadRequestDumpHostsItemInterfacesItemAddressesItem
+				{
+					elem.SetFake()
+				}
+				s.Addresses = append(s.Addresses, elem)
+			}
+		}
+	}
+	{
+		{
+			s.Bmc.SetFake()
+		}
+	}
+	{
+		{
+			s.Fqdn.SetFake()
+		}
+	}
+	{
+		{
+			s.ID.SetFake()
+		}
+	}
+	{
+		{
+			s.Ifname.SetFake()
+		}
+	}
+	{
+		{
+			s.IP.SetFake()
+		}
+	}
+	{
+		{
+			s.MAC.SetFake()
+		}
+	}
+	{
+		{
+			s.Mtu.SetFake()
+		}
+	}
+	{
+		{
+			s.Parent.SetFake()
+		}
+	}
+	{
+		{
+			s.Port.SetFake()
+		}
+	}
+	{
+		{
+			s.Switch.SetFake()
+		}
+	}
+	{
+		{
+			s.Vlan.SetFake()
+		}
 	}
-	s.SetTo(elem)
 }
 
 // SetFake set fake values.
-func (s *OptEventJobMessagesItemRedfishErrorError) SetFake() {
-	var elem EventJobMessagesItemRedfishErrorError
+func (s *DataLoadRequestDumpHostsItemInterfacesItemAddressesItem) SetFake() {
+	{
+		{
+			s.Fqdn.SetFake()
+		}
+	}
+	{
+		{
+			s.IP.SetFake()
+		}
+	}
+	{
+		{
+			s.Primary.SetFake()
+		}
+	}
+}
+
+// SetFake set fake values.
+func (s *DataLoadRequestDumpHostsItemInventory) SetFake() {
+	{
+		{
+			s.BiosVersion.SetFake()
+		}
+	}
+	{
+		{
+			s.BmcFirmware.SetFake()
+		}
+	}
+	{
+		{
+			s.CapturedAt.SetFake()
+		}
+	}
+	{
+		{
+			s.NicFirmware.SetFake()
+		}
+	}
+}
+
+// SetFake set fake values.
+func (s *DataLoadRequestDumpHostsItemInventoryNicFirmware) SetFake() {
+	var (
+		elem string
+		m    map[string]string = s.init()
+	)
+	for i := 0; i < 0; i++ {
+		m[fmt.Sprintf("fake%d", i)] = elem
+	}
+}
+
+// SetFake set fake values.
+func (s *DataLoadRequestDumpImagesItem) SetFake() {
+	{
+		{
+			s.Cmdline.SetFake()
+		}
+	}
+	{
+		{
+			s.CreatedAt.SetFake()
+		}
+	}
+	{
+		{
+			s.ID.SetFake()
+		}
+	}
+	{
+		{
+			s.Inherits.SetFake()
+		}
+	}
+	{
+		{
+			s.Initrd = nil
+			for i := 0; i < 0; i++ {
+				var elem string
+				{
+					elem = "string"
+				}
+				s.Initrd = append(s.Initrd, elem)
+			}
+		}
+	}
+	{
+		{
+			s.Kernel.SetFake()
+		}
+	}
+	{
+		{
+			s.Liveimg.SetFake()
+		}
+	}
+	{
+		{
+			s.Name.SetFake()
+		}
+	}
+	{
+		{
+			s.ProvisionTemplates.SetFake()
+		}
+	}
+	{
+		{
+			s.Revision.SetFake()
+		}
+	}
+	{
+		{
+			s.UID.SetFake()
+		}
+	}
+	{
+		{
+			s.UpdatedAt.SetFake()
+		}
+	}
+	{
+		{
+			s.Verify.SetFake()
+		}
+	}
+}
+
+// SetFake set fake values.
+func (s *DataLoadRequestDumpImagesItemProvisionTemplates) SetFake() {
+	var (
+		elem NilString
+		m    map[string]NilString = s.init()
+	)
+	for i := 0; i < 0; i++ {
+		m[fmt.Sprintf("fake%d", i)] = elem
+	}
+}
+
+// SetFake set fake values.
+func (s *DataLoadRequestDumpUsersItem) SetFake() {
+	{
+		{
+			s.CreatedAt.SetFake()
+		}
+	}
+	{
+		{
+			s.Enabled.SetFake()
+		}
+	}
+	{
+		{
+			s.Hash.SetFake()
+		}
+	}
+	{
+		{
+			s.ID.SetFake()
+		}
+	}
+	{
+		{
+			s.ModifiedAt.SetFake()
+		}
+	}
+	{
+		{
+			s.Role.SetFake()
+		}
+	}
+	{
+		{
+			s.Username.SetFake()
+		}
+	}
+}
+
+// SetFake set fake values.
+func (s *DataLoadResponse) SetFake() {
+	{
+		{
+			s.Changed.SetFake()
+		}
+	}
+	{
+		{
+			s.Detail.SetFake()
+		}
+	}
+	{
+		{
+			s.Diff.SetFake()
+		}
+	}
+	{
+		{
+			s.Title.SetFake()
+		}
+	}
+}
+
+// SetFake set fake values.
+func (s *DataLoadResponseDiff) SetFake() {
+	{
+		{
+			s.DNSRecords.SetFake()
+		}
+	}
+	{
+		{
+			s.Hosts.SetFake()
+		}
+	}
+	{
+		{
+			s.Images.SetFake()
+		}
+	}
+}
+
+// SetFake set fake values.
+func (s *DataLoadResponseDiffDNSRecords) SetFake() {
+	{
+		{
+			s.Added = nil
+			for i := 0; i < 0; i++ {
+				var elem string
+				{
+					elem = "string"
+				}
+				s.Added = append(s.Added, elem)
+			}
+		}
+	}
+	{
+		{
+			s.Removed = nil
+			for i := 0; i < 0; i++ {
+				var elem string
+				{
+					elem = "string"
+				}
+				s.Removed = append(s.Removed, elem)
+			}
+		}
+	}
+	{
+		{
+			s.Unchanged.SetFake()
+		}
+	}
+	{
+		{
+			s.Updated = nil
+			for i := 0; i < 0; i++ {
+				var elem string
+				{
+					elem = "string"
+				}
+				s.Updated = append(s.Updated, elem)
+			}
+		}
+	}
+}
+
+// SetFake set fake values.
+func (s *DataLoadResponseDiffHosts) SetFake() {
+	{
+		{
+			s.Added = nil
+			for i := 0; i < 0; i++ {
+				var elem string
+				{
+					elem = "string"
+				}
+				s.Added = append(s.Added, elem)
+			}
+		}
+	}
+	{
+		{
+			s.Removed = nil
+			for i := 0; i < 0; i++ {
+				var elem string
+				{
+					elem = "string"
+				}
+				s.Removed = append(s.Removed, elem)
+			}
+		}
+	}
+	{
+		{
+			s.Unchanged.SetFake()
+		}
+	}
+	{
+		{
+			s.Updated = nil
+			for i := 0; i < 0; i++ {
+				var elem string
+				{
+					elem = "string"
+				}
+				s.Updated = append(s.Updated, elem)
+			}
+		}
+	}
+}
+
+// SetFake set fake values.
+func (s *DataLoadResponseDiffImages) SetFake() {
+	{
+		{
+			s.Added = nil
+			for i := 0; i < 0; i++ {
+				var elem string
+				{
+					elem = "string"
+				}
+				s.Added = append(s.Added, elem)
+			}
+		}
+	}
+	{
+		{
+			s.Removed = nil
+			for i := 0; i < 0; i++ {
+				var elem string
+				{
+					elem = "string"
+				}
+				s.Removed = append(s.Removed, elem)
+			}
+		}
+	}
+	{
+		{
+			s.Unchanged.SetFake()
+		}
+	}
+	{
+		{
+			s.Updated = nil
+			for i := 0; i < 0; i++ {
+				var elem string
+				{
+					elem = "string"
+				}
+				s.Updated = append(s.Updated, elem)
+			}
+		}
+	}
+}
+
+// SetFake set fake values.
+func (s *DiscoverBMCAdoptRequest) SetFake() {
+	{
+		{
+			s.Fqdn.SetFake()
+		}
+	}
+	{
+		{
+			s.Host = "string"
+		}
+	}
+	{
+		{
+			s.Ifname.SetFake()
+		}
+	}
+	{
+		{
+			s.IP = "string"
+		}
+	}
+	{
+		{
+			s.MAC = "string"
+		}
+	}
+}
+
+// SetFake set fake values.
+func (s *Event) SetFake() {
+	{
+		{
+			s.JobMessages = nil
+			for i := 0; i < 0; i++ {
+				var elem EventJobMessagesItem
+				{
+					elem.SetFake()
+				}
+				s.JobMessages = append(s.JobMessages, elem)
+			}
+		}
+	}
+	{
+		{
+			s.Message.SetFake()
+		}
+	}
+	{
+		{
+			s.Namespace.SetFake()
+		}
+	}
+	{
+		{
+			s.Severity.SetFake()
+		}
+	}
+	{
+		{
+			s.Time.SetFake()
+		}
+	}
+	{
+		{
+			s.User.SetFake()
+		}
+	}
+}
+
+// SetFake set fake values.
+func (s *EventJobMessagesItem) SetFake() {
+	{
+		{
+			s.Data.SetFake()
+		}
+	}
+	{
+		{
+			s.Host.SetFake()
+		}
+	}
+	{
+		{
+			s.Msg.SetFake()
+		}
+	}
+	{
+		{
+			s.RedfishError.SetFake()
+		}
+	}
+	{
+		{
+			s.Status.SetFake()
+		}
+	}
+}
+
+// SetFake set fake values.
+func (s *EventJobMessagesItemRedfishError) SetFake() {
+	{
+		{
+			s.Code.SetFake()
+		}
+	}
+	{
+		{
+			s.Error.SetFake()
+		}
+	}
+}
+
+// SetFake set fake values.
+func (s *EventJobMessagesItemRedfishErrorError) SetFake() {
+	{
+		{
+			s.MessageDotExtendedInfo = nil
+			for i := 0; i < 0; i++ {
+				var elem EventJobMessagesItemRedfishErrorErrorMessageDotExtendedInfoItem
+				{
+					elem.SetFake()
+				}
+				s.MessageDotExtendedInfo = append(s.MessageDotExtendedInfo, elem)
+			}
+		}
+	}
+	{
+		{
+			s.Code.SetFake()
+		}
+	}
+	{
+		{
+			s.Message.SetFake()
+		}
+	}
+}
+
+// SetFake set fake values.
+func (s *EventJobMessagesItemRedfishErrorErrorMessageDotExtendedInfoItem) SetFake() {
+	{
+		{
+			s.Message.SetFake()
+		}
+	}
+	{
+		{
+			s.MessageArgsDotOdataDotCount.SetFake()
+		}
+	}
+	{
+		{
+			s.MessageId.SetFake()
+		}
+	}
+	{
+		{
+			s.RelatedPropertiesDotOdataDotCount.SetFake()
+		}
+	}
+	{
+		{
+			s.Resolution.SetFake()
+		}
+	}
+	{
+		{
+			s.Severity.SetFake()
+		}
+	}
+}
+
+// SetFake set fake values.
+func (s *EventSubscription) SetFake() {
+	{
+		{
+			s.Host.SetFake()
+		}
+	}
+	{
+		{
+			s.ID.SetFake()
+		}
+	}
+	{
+		{
+			s.Msg.SetFake()
+		}
+	}
+	{
+		{
+			s.State.SetFake()
+		}
+	}
+	{
+		{
+			s.Status.SetFake()
+		}
+	}
+}
+
+// SetFake set fake values.
+func (s *FirmwareUpdateReport) SetFake() {
+	{
+		{
+			s.Component.SetFake()
+		}
+	}
+	{
+		{
+			s.Host.SetFake()
+		}
+	}
+	{
+		{
+			s.Inventory.SetFake()
+		}
+	}
+	{
+		{
+			s.Msg.SetFake()
+		}
+	}
+	{
+		{
+			s.Previous.SetFake()
+		}
+	}
+	{
+		{
+			s.Skipped.SetFake()
+		}
+	}
+	{
+		{
+			s.Status.SetFake()
+		}
+	}
+	{
+		{
+			s.Version.SetFake()
+		}
+	}
+}
+
+// SetFake set fake values.
+func (s *FirmwareUpdateReportInventory) SetFake() {
+	{
+		{
+			s.BiosVersion.SetFake()
+		}
+	}
+	{
+		{
+			s.BmcFirmware.SetFake()
+		}
+	}
+	{
+		{
+			s.CapturedAt.SetFake()
+		}
+	}
+	{
+		{
+			s.NicFirmware.SetFake()
+		}
+	}
+}
+
+// SetFake set fake values.
+func (s *FirmwareUpdateReportInventoryNicFirmware) SetFake() {
+	var (
+		elem string
+		m    map[string]string = s.init()
+	)
+	for i := 0; i < 0; i++ {
+		m[fmt.Sprintf("fake%d", i)] = elem
+	}
+}
+
+// SetFake set fake values.
+func (s *GenericResponse) SetFake() {
+	{
+		{
+			s.Changed.SetFake()
+		}
+	}
+	{
+		{
+			s.Detail.SetFake()
+		}
+	}
+	{
+		{
+			s.Title.SetFake()
+		}
+	}
+}
+
+// SetFake set fake values.
+func (s *GetRolesResponse) SetFake() {
+	{
+		{
+			s.Roles = nil
+			for i := 0; i < 0; i++ {
+				var elem GetRolesResponseRolesItem
+				{
+					elem.SetFake()
+				}
+				s.Roles = append(s.Roles, elem)
+			}
+		}
+	}
+}
+
+// SetFake set fake values.
+func (s *GetRolesResponseRolesItem) SetFake() {
+	{
+		{
+			s.Name.SetFake()
+		}
+	}
+	{
+		{
+			s.PermissionList = nil
+			for i := 0; i < 0; i++ {
+				var elem GetRolesResponseRolesItemPermissionListItem
+				{
+					elem.SetFake()
+				}
+				s.PermissionList = append(s.PermissionList, elem)
+			}
+		}
+	}
+	{
+		{
+			s.UnassignedPermissionList = nil
+			for i := 0; i < 0; i++ {
+				var elem GetRolesResponseRolesItemUnassignedPermissionListItem
+				{
+					elem.SetFake()
+				}
+				s.UnassignedPermissionList = append(s.UnassignedPermissionList, elem)
+			}
+		}
+	}
+}
+
+// SetFake set fake values.
+func (s *GetRolesResponseRolesItemPermissionListItem) SetFake() {
+	{
+		{
+			s.Method.SetFake()
+		}
+	}
+	{
+		{
+			s.Path.SetFake()
+		}
+	}
+}
+
+// SetFake set fake values.
+func (s *GetRolesResponseRolesItemUnassignedPermissionListItem) SetFake() {
+	{
+		{
+			s.Method.SetFake()
+		}
+	}
+	{
+		{
+			s.Path.SetFake()
+		}
+	}
+}
+
+// SetFake set fake values.
+func (s *HAInstance) SetFake() {
+	{
+		{
+			s.Alive.SetFake()
+		}
+	}
+	{
+		{
+			s.Changed.SetFake()
+		}
+	}
+	{
+		{
+			s.Heartbeat.SetFake()
+		}
+	}
+	{
+		{
+			s.Name.SetFake()
+		}
+	}
+	{
+		{
+			s.Role.SetFake()
+		}
+	}
+	{
+		{
+			s.State.SetFake()
+		}
+	}
+}
+
+// SetFake set fake values.
+func (s *HTTPError) SetFake() {
+	{
+		{
+			s.Detail.SetFake()
+		}
+	}
+	{
+		{
+			s.Errors.SetFake()
+		}
+	}
+	{
+		{
+			s.Instance.SetFake()
+		}
+	}
+	{
+		{
+			s.Status.SetFake()
+		}
+	}
+	{
+		{
+			s.Title.SetFake()
+		}
+	}
+	{
+		{
+			s.Type.SetFake()
+		}
+	}
+}
+
+// SetFake set fake values.
+func (s *HTTPErrorErrorsItem) SetFake() {
+	{
+		{
+			s.More.SetFake()
+		}
+	}
+	{
+		{
+			s.Name.SetFake()
+		}
+	}
+	{
+		{
+			s.Reason.SetFake()
+		}
+	}
+}
+
+// SetFake set fake values.
+func (s *HTTPErrorErrorsItemMore) SetFake() {
+	var (
+		elem jx.Raw
+		m    map[string]jx.Raw = s.init()
+	)
+	for i := 0; i < 0; i++ {
+		m[fmt.Sprintf("fake%d", i)] = elem
+	}
+}
+
+// SetFake set fake values.
+func (s *HardwareReport) SetFake() {
+	{
+		{
+			s.Changes.SetFake()
+		}
+	}
+	{
+		{
+			s.Hardware.SetFake()
+		}
+	}
+	{
+		{
+			s.Host.SetFake()
+		}
+	}
+	{
+		{
+			s.Msg.SetFake()
+		}
+	}
+	{
+		{
+			s.Status.SetFake()
+		}
+	}
+}
+
+// SetFake set fake values.
+func (s *HardwareReportHardware) SetFake() {
+	{
+		{
+			s.CollectedAt.SetFake()
+		}
+	}
+	{
+		{
+			s.CoreCount.SetFake()
+		}
+	}
+	{
+		{
+			s.CPUCount.SetFake()
+		}
+	}
+	{
+		{
+			s.CPUModel.SetFake()
+		}
+	}
+	{
+		{
+			s.Dimms.SetFake()
+		}
+	}
+	{
+		{
+			s.Disks.SetFake()
+		}
+	}
+	{
+		{
+			s.MemoryMib.SetFake()
+		}
+	}
+	{
+		{
+			s.Missing.SetFake()
+		}
+	}
+	{
+		{
+			s.Nics.SetFake()
+		}
+	}
+}
+
+// SetFake set fake values.
+func (s *HardwareReportHardwareDimmsItem) SetFake() {
+	{
+		{
+			s.SizeMib.SetFake()
+		}
+	}
+	{
+		{
+			s.Slot.SetFake()
+		}
+	}
+}
+
+// SetFake set fake values.
+func (s *HardwareReportHardwareDisksItem) SetFake() {
+	{
+		{
+			s.Model.SetFake()
+		}
+	}
+	{
+		{
+			s.Name.SetFake()
+		}
+	}
+	{
+		{
+			s.Serial.SetFake()
+		}
+	}
+	{
+		{
+			s.SizeBytes.SetFake()
+		}
+	}
+}
+
+// SetFake set fake values.
+func (s *HardwareReportHardwareNicsItem) SetFake() {
+	{
+		{
+			s.MAC.SetFake()
+		}
+	}
+	{
+		{
+			s.Name.SetFake()
+		}
+	}
+}
+
+// SetFake set fake values.
+func (s *Host) SetFake() {
+	{
+		{
+			s.Bonds = nil
+			for i := 0; i < 0; i++ {
+				var elem NilHostBondsItem
+				{
+					elem.SetFake()
+				}
+				s.Bonds = append(s.Bonds, elem)
+			}
+		}
+	}
+	{
+		{
+			s.BootImage.SetFake()
+		}
+	}
+	{
+		{
+			s.ClientCertFingerprint.SetFake()
+		}
+	}
+	{
+		{
+			s.ClientCertSerial.SetFake()
+		}
+	}
+	{
+		{
+			s.CreatedAt.SetFake()
+		}
+	}
+	{
+		{
+			s.Firmware.SetFake()
+		}
+	}
+	{
+		{
+			s.Hardware.SetFake()
+		}
+	}
+	{
+		{
+			s.ID.SetFake()
+		}
+	}
+	{
+		{
+			s.Interfaces = nil
+			for i := 0; i < 0; i++ {
+				var elem NilHostInterfacesItem
+				{
+					elem.SetFake()
+				}
+				s.Interfaces = append(s.Interfaces, elem)
+			}
+		}
+	}
+	{
+		{
+			s.Inventory.SetFake()
+		}
+	}
+	{
+		{
+			s.Name.SetFake()
+		}
+	}
+	{
+		{
+			s.Provision.SetFake()
+		}
+	}
+	{
+		{
+			s.Revision.SetFake()
+		}
+	}
+	{
+		{
+			s.SmbiosUUID.SetFake()
+		}
+	}
+	{
+		{
+			s.State.SetFake()
+		}
+	}
+	{
+		{
+			s.Tags.SetFake()
+		}
+	}
+	{
+		{
+			s.UID.SetFake()
+		}
+	}
+	{
+		{
+			s.UpdatedAt.SetFake()
+		}
+	}
+}
+
+// SetFake set fake values.
+func (s *HostBondsItem) SetFake() {
+	{
+		{
+			s.Addresses = nil
+			for i := 0; i < 0; i++ {
+				var elem HostBondsItemAddressesItem
+				{
+					elem.SetFake()
+				}
+				s.Addresses = append(s.Addresses, elem)
+			}
+		}
+	}
+	{
+		{
+			s.Bmc.SetFake()
+		}
+	}
+	{
+		{
+			s.Fqdn.SetFake()
+		}
+	}
+	{
+		{
+			s.ID.SetFake()
+		}
+	}
+	{
+		{
+			s.Ifname.SetFake()
+		}
+	}
+	{
+		{
+			s.IP.SetFake()
+		}
+	}
+	{
+		{
+			s.MAC.SetFake()
+		}
+	}
+	{
+		{
+			s.Mode.SetFake()
+		}
+	}
+	{
+		{
+			s.Mtu.SetFake()
+		}
+	}
+	{
+		{
+			s.Options.SetFake()
+		}
+	}
+	{
+		{
+			s.Parent.SetFake()
+		}
+	}
+	{
+		{
+			s.Peers = nil
+			for i := 0; i < 0; i++ {
+				var elem string
+				{
+					elem = "string"
+				}
+				s.Peers = append(s.Peers, elem)
+			}
+		}
+	}
+	{
+		{
+			s.Port.SetFake()
+		}
+	}
+	{
+		{
+			s.Switch.SetFake()
+		}
+	}
+	{
+		{
+			s.Type.SetFake()
+		}
+	}
+	{
+		{
+			s.Vlan.SetFake()
+		}
+	}
+}
+
+// SetFake set fake values.
+func (s *HostBondsItemAddressesItem) SetFake() {
+	{
+		{
+			s.Fqdn.SetFake()
+		}
+	}
+	{
+		{
+			s.IP.SetFake()
+		}
+	}
+	{
+		{
+			s.Primary.SetFake()
+		}
+	}
+}
+
+// SetFake set fake values.
+func (s *HostBondsItemOptions) SetFake() {
+	var (
+		elem string
+		m    map[string]string = s.init()
+	)
+	for i := 0; i < 0; i++ {
+		m[fmt.Sprintf("fake%d", i)] = elem
+	}
+}
+
+// SetFake set fake values.
+func (s *HostFile) SetFake() {
+	{
+		{
+			s.Host.SetFake()
+		}
+	}
+	{
+		{
+			s.Name.SetFake()
+		}
+	}
+	{
+		{
+			s.Size.SetFake()
+		}
+	}
+	{
+		{
+			s.UpdatedAt.SetFake()
+		}
+	}
+}
+
+// SetFake set fake values.
+func (s *HostHardware) SetFake() {
+	{
+		{
+			s.CollectedAt.SetFake()
+		}
+	}
+	{
+		{
+			s.CoreCount.SetFake()
+		}
+	}
+	{
+		{
+			s.CPUCount.SetFake()
+		}
+	}
+	{
+		{
+			s.CPUModel.SetFake()
+		}
+	}
+	{
+		{
+			s.Dimms.SetFake()
+		}
+	}
+	{
+		{
+			s.Disks.SetFake()
+		}
+	}
+	{
+		{
+			s.MemoryMib.SetFake()
+		}
+	}
+	{
+		{
+			s.Missing.SetFake()
+		}
+	}
+	{
+		{
+			s.Nics.SetFake()
+		}
+	}
+}
+
+// SetFake set fake values.
+func (s *HostHardwareDimmsItem) SetFake() {
+	{
+		{
+			s.SizeMib.SetFake()
+		}
+	}
+	{
+		{
+			s.Slot.SetFake()
+		}
+	}
+}
+
+// SetFake set fake values.
+func (s *HostHardwareDisksItem) SetFake() {
+	{
+		{
+			s.Model.SetFake()
+		}
+	}
+	{
+		{
+			s.Name.SetFake()
+		}
+	}
+	{
+		{
+			s.Serial.SetFake()
+		}
+	}
+	{
+		{
+			s.SizeBytes.SetFake()
+		}
+	}
+}
+
+// SetFake set fake values.
+func (s *HostHardwareNicsItem) SetFake() {
+	{
+		{
+			s.MAC.SetFake()
+		}
+	}
+	{
+		{
+			s.Name.SetFake()
+		}
+	}
+}
+
+// SetFake set fake values.
+func (s *HostInterfacesItem) SetFake() {
+	{
+		{
+			s.Addresses = nil
+			for i := 0; i < 0; i++ {
+				var elem HostInterfacesItemAddressesItem
+				{
+					elem.SetFake()
+				}
+				s.Addresses = append(s.Addresses, elem)
+			}
+		}
+	}
+	{
+		{
+			s.Bmc.SetFake()
+		}
+	}
+	{
+		{
+			s.Fqdn.SetFake()
+		}
+	}
+	{
+		{
+			s.ID.SetFake()
+		}
+	}
+	{
+		{
+			s.Ifname.SetFake()
+		}
+	}
+	{
+		{
+			s.IP.SetFake()
+		}
+	}
+	{
+		{
+			s.MAC.SetFake()
+		}
+	}
+	{
+		{
+			s.Mtu.SetFake()
+		}
+	}
+	{
+		{
+			s.Parent.SetFake()
+		}
+	}
+	{
+		{
+			s.Port.SetFake()
+		}
+	}
+	{
+		{
+			s.Switch.SetFake()
+		}
+	}
+	{
+		{
+			s.Vlan.SetFake()
+		}
+	}
+}
+
+// SetFake set fake values.
+func (s *HostInterfacesItemAddressesItem) SetFake() {
+	{
+		{
+			s.Fqdn.SetFake()
+		}
+	}
+	{
+		{
+			s.IP.SetFake()
+		}
+	}
+	{
+		{
+			s.Primary.SetFake()
+		}
+	}
+}
+
+// SetFake set fake values.
+func (s *HostInventory) SetFake() {
+	{
+		{
+			s.BiosVersion.SetFake()
+		}
+	}
+	{
+		{
+			s.BmcFirmware.SetFake()
+		}
+	}
+	{
+		{
+			s.CapturedAt.SetFake()
+		}
+	}
+	{
+		{
+			s.NicFirmware.SetFake()
+		}
+	}
+}
+
+// SetFake set fake values.
+func (s *HostInventoryNicFirmware) SetFake() {
+	var (
+		elem string
+		m    map[string]string = s.init()
+	)
+	for i := 0; i < 0; i++ {
+		m[fmt.Sprintf("fake%d", i)] = elem
+	}
+}
+
+// SetFake set fake values.
+func (s *HostLogEntry) SetFake() {
+	{
+		{
+			s.Host.SetFake()
+		}
+	}
+	{
+		{
+			s.Message.SetFake()
+		}
+	}
+	{
+		{
+			s.MessageID.SetFake()
+		}
+	}
+	{
+		{
+			s.Severity.SetFake()
+		}
+	}
+	{
+		{
+			s.Time.SetFake()
+		}
+	}
+}
+
+// SetFake set fake values.
+func (s *IPReservation) SetFake() {
+	{
+		{
+			s.CreatedAt.SetFake()
+		}
+	}
+	{
+		{
+			s.ExpiresAt.SetFake()
+		}
+	}
+	{
+		{
+			s.Host.SetFake()
+		}
+	}
+	{
+		{
+			s.IP.SetFake()
+		}
+	}
+	{
+		{
+			s.Kind.SetFake()
+		}
+	}
+	{
+		{
+			s.User.SetFake()
+		}
+	}
+}
+
+// SetFake set fake values.
+func (s *ImageDiskUsage) SetFake() {
+	{
+		{
+			s.Images = nil
+			for i := 0; i < 0; i++ {
+				var elem NilImageDiskUsageImagesItem
+				{
+					elem.SetFake()
+				}
+				s.Images = append(s.Images, elem)
+			}
+		}
+	}
+	{
+		{
+			s.Total.SetFake()
+		}
+	}
+}
+
+// SetFake set fake values.
+func (s *ImageDiskUsageImagesItem) SetFake() {
+	{
+		{
+			s.Files.SetFake()
+		}
+	}
+	{
+		{
+			s.Missing = nil
+			for i := 0; i < 0; i++ {
+				var elem string
+				{
+					elem = "string"
+				}
+				s.Missing = append(s.Missing, elem)
+			}
+		}
+	}
+	{
+		{
+			s.Name.SetFake()
+		}
+	}
+	{
+		{
+			s.Shared.SetFake()
+		}
+	}
+	{
+		{
+			s.Size.SetFake()
+		}
+	}
+}
+
+// SetFake set fake values.
+func (s *ImageGCResult) SetFake() {
+	{
+		{
+			s.Deleted.SetFake()
+		}
+	}
+	{
+		{
+			s.Dirs = nil
+			for i := 0; i < 0; i++ {
+				var elem string
+				{
+					elem = "string"
+				}
+				s.Dirs = append(s.Dirs, elem)
+			}
+		}
+	}
+	{
+		{
+			s.Files = nil
+			for i := 0; i < 0; i++ {
+				var elem NilImageGCResultFilesItem
+				{
+					elem.SetFake()
+				}
+				s.Files = append(s.Files, elem)
+			}
+		}
+	}
+	{
+		{
+			s.Size.SetFake()
+		}
+	}
+}
+
+// SetFake set fake values.
+func (s *ImageGCResultFilesItem) SetFake() {
+	{
+		{
+			s.Path.SetFake()
+		}
+	}
+	{
+		{
+			s.Size.SetFake()
+		}
+	}
+}
+
+// SetFake set fake values.
+func (s *JobMessage) SetFake() {
+	{
+		{
+			s.Data.SetFake()
+		}
+	}
+	{
+		{
+			s.Host.SetFake()
+		}
+	}
+	{
+		{
+			s.Msg.SetFake()
+		}
+	}
+	{
+		{
+			s.RedfishError.SetFake()
+		}
+	}
+	{
+		{
+			s.Status.SetFake()
+		}
+	}
+}
+
+// SetFake set fake values.
+func (s *JobMessageRedfishError) SetFake() {
+	{
+		{
+			s.Code.SetFake()
+		}
+	}
+	{
+		{
+			s.Error.SetFake()
+		}
+	}
+}
+
+// SetFake set fake values.
+func (s *JobMessageRedfishErrorError) SetFake() {
+	{
+		{
+			s.MessageDotExtendedInfo.SetFake()
+		}
+	}
+	{
+		{
+			s.Code.SetFake()
+		}
+	}
+	{
+		{
+			s.Message.SetFake()
+		}
+	}
+}
+
+// SetFake set fake values.
+func (s *JobMessageRedfishErrorErrorMessageDotExtendedInfoItem) SetFake() {
+	{
+		{
+			s.Message.SetFake()
+		}
+	}
+	{
+		{
+			s.MessageArgsDotOdataDotCount.SetFake()
+		}
+	}
+	{
+		{
+			s.MessageId.SetFake()
+		}
+	}
+	{
+		{
+			s.RelatedPropertiesDotOdataDotCount.SetFake()
+		}
+	}
+	{
+		{
+			s.Resolution.SetFake()
+		}
+	}
+	{
+		{
+			s.Severity.SetFake()
+		}
+	}
+}
+
+// SetFake set fake values.
+func (s *LLDP) SetFake() {
+	{
+		{
+			s.ChassisID.SetFake()
+		}
+	}
+	{
+		{
+			s.ChassisIDType.SetFake()
+		}
+	}
+	{
+		{
+			s.ManagementAddress.SetFake()
+		}
+	}
+	{
+		{
+			s.PortDescription.SetFake()
+		}
+	}
+	{
+		{
+			s.PortID.SetFake()
+		}
+	}
+	{
+		{
+			s.PortIDType.SetFake()
+		}
+	}
+	{
+		{
+			s.PortName.SetFake()
+		}
+	}
+	{
+		{
+			s.SystemDescription.SetFake()
+		}
+	}
+	{
+		{
+			s.SystemName.SetFake()
+		}
+	}
+}
+
+// SetFake set fake values.
+func (s *Listener) SetFake() {
+	{
+		{
+			s.Address.SetFake()
+		}
+	}
+	{
+		{
+			s.Family.SetFake()
+		}
+	}
+	{
+		{
+			s.Network.SetFake()
+		}
+	}
+	{
+		{
+			s.Service.SetFake()
+		}
+	}
+}
+
+// SetFake set fake values.
+func (s *Maintenance) SetFake() {
+	{
+		{
+			s.Changed.SetFake()
+		}
+	}
+	{
+		{
+			s.ChangedBy.SetFake()
+		}
+	}
+	{
+		{
+			s.Enabled.SetFake()
+		}
+	}
+	{
+		{
+			s.Reason.SetFake()
+		}
+	}
+}
+
+// SetFake set fake values.
+func (s *MaintenanceRequest) SetFake() {
+	{
+		{
+			s.Enabled.SetFake()
+		}
+	}
+	{
+		{
+			s.Reason.SetFake()
+		}
+	}
+}
+
+// SetFake set fake values.
+func (s *NilBootImageAddRequestBootImagesItem) SetFake() {
+	s.Null = true
+}
+
+// SetFake set fake values.
+func (s *NilChangeFeedChangesItem) SetFake() {
+	s.Null = true
+}
+
+// SetFake set fake values.
+func (s *NilChangeFeedChangesItemDiffItem) SetFake() {
+	s.Null = true
+}
+
+// SetFake set fake values.
+func (s *NilDNSRecordAddRequestRecordsItem) SetFake() {
+	s.Null = true
+}
+
+// SetFake set fake values.
+func (s *NilDataDumpCredentialsItem) SetFake() {
+	s.Null = true
+}
+
+// SetFake set fake values.
+func (s *NilDataDumpDNSRecordsItem) SetFake() {
+	s.Null = true
+}
+
+// SetFake set fake values.
+func (s *NilDataDumpHostsItem) SetFake() {
+	s.Null = true
+}
+
+// SetFake set fake values.
+func (s *NilDataDumpHostsItemBondsItem) SetFake() {
+	s.Null = true
+}
+
+// SetFake set fake values.
+func (s *NilDataDumpHostsItemHardwareDimmsItem) SetFake() {
+	s.Null = true
+}
+
+// SetFake set fake values.
+func (s *NilDataDumpHostsItemHardwareDisksItem) SetFake() {
+	s.Null = true
+}
+
+// SetFake set fake values.
+func (s *NilDataDumpHostsItemHardwareNicsItem) SetFake() {
+	s.Null = true
+}
+
+// SetFake set fake values.
+func (s *NilDataDumpHostsItemInterfacesItem) SetFake() {
+	s.Null = true
+}
+
+// SetFake set fake values.
+func (s *NilDataDumpImagesItem) SetFake() {
+	s.Null = true
+}
+
+// SetFake set fake values.
+func (s *NilDataLoadRequestDumpCredentialsItem) SetFake() {
+	s.Null = true
+}
+
+// SetFake set fake values.
+func (s *NilDataLoadRequestDumpDNSRecordsItem) SetFake() {
+	s.Null = true
+}
+
+// SetFake set fake values.
+func (s *NilDataLoadRequestDumpHostsItem) SetFake() {
+	s.Null = true
+}
+
+// SetFake set fake values.
+func (s *NilDataLoadRequestDumpHostsItemBondsItem) SetFake() {
+	s.Null = true
+}
+
+// SetFake set fake values.
+func (s *NilDataLoadRequestDumpHostsItemHardwareDimmsItem) SetFake() {
+	s.Null = true
+}
+
+// SetFake set fake values.
+func (s *NilDataLoadRequestDumpHostsItemHardwareDisksItem) SetFake() {
+	s.Null = true
+}
+
+// SetFake set fake values.
+func (s *NilDataLoadRequestDumpHostsItemHardwareNicsItem) SetFake() {
+	s.Null = true
+}
+
+// SetFake set fake values.
+func (s *NilDataLoadRequestDumpHostsItemInterfacesItem) SetFake() {
+	s.Null = true
+}
+
+// SetFake set fake values.
+func (s *NilDataLoadRequestDumpImagesItem) SetFake() {
+	s.Null = true
+}
+
+// SetFake set fake values.
+func (s *NilHardwareReportHardwareDimmsItem) SetFake() {
+	s.Null = true
+}
+
+// SetFake set fake values.
+func (s *NilHardwareReportHardwareDisksItem) SetFake() {
+	s.Null = true
+}
+
+// SetFake set fake values.
+func (s *NilHardwareReportHardwareNicsItem) SetFake() {
+	s.Null = true
+}
+
+// SetFake set fake values.
+func (s *NilHostBondsItem) SetFake() {
+	s.Null = true
+}
+
+// SetFake set fake values.
+func (s *NilHostHardwareDimmsItem) SetFake() {
+	s.Null = true
+}
+
+// SetFake set fake values.
+func (s *NilHostHardwareDisksItem) SetFake() {
+	s.Null = true
+}
+
+// SetFake set fake values.
+func (s *NilHostHardwareNicsItem) SetFake() {
+	s.Null = true
+}
+
+// SetFake set fake values.
+func (s *NilHostInterfacesItem) SetFake() {
+	s.Null = true
+}
+
+// SetFake set fake values.
+func (s *NilImageDiskUsageImagesItem) SetFake() {
+	s.Null = true
+}
+
+// SetFake set fake values.
+func (s *NilImageGCResultFilesItem) SetFake() {
+	s.Null = true
+}
+
+// SetFake set fake values.
+func (s *NilInt) SetFake() {
+	s.Null = true
+}
+
+// SetFake set fake values.
+func (s *NilNodeAddRequestNodeListItem) SetFake() {
+	s.Null = true
+}
+
+// SetFake set fake values.
+func (s *NilNodeAddRequestNodeListItemBondsItem) SetFake() {
+	s.Null = true
+}
+
+// SetFake set fake values.
+func (s *NilNodeAddRequestNodeListItemHardwareDimmsItem) SetFake() {
+	s.Null = true
+}
+
+// SetFake set fake values.
+func (s *NilNodeAddRequestNodeListItemHardwareDisksItem) SetFake() {
+	s.Null = true
+}
+
+// SetFake set fake values.
+func (s *NilNodeAddRequestNodeListItemHardwareNicsItem) SetFake() {
+	s.Null = true
+}
+
+// SetFake set fake values.
+func (s *NilNodeAddRequestNodeListItemInterfacesItem) SetFake() {
+	s.Null = true
+}
+
+// SetFake set fake values.
+func (s *NilRedfishJobJobsItem) SetFake() {
+	s.Null = true
+}
+
+// SetFake set fake values.
+func (s *NilRedfishMetricReportReportsItem) SetFake() {
+	s.Null = true
+}
+
+// SetFake set fake values.
+func (s *NilScheduledActionResultsItem) SetFake() {
+	s.Null = true
+}
+
+// SetFake set fake values.
+func (s *NilString) SetFake() {
+	s.Null = true
+}
+
+// SetFake set fake values.
+func (s *NilTrashedHostHostBondsItem) SetFake() {
+	s.Null = true
+}
+
+// SetFake set fake values.
+func (s *NilTrashedHostHostHardwareDimmsItem) SetFake() {
+	s.Null = true
+}
+
+// SetFake set fake values.
+func (s *NilTrashedHostHostHardwareDisksItem) SetFake() {
+	s.Null = true
+}
+
+// SetFake set fake values.
+func (s *NilTrashedHostHostHardwareNicsItem) SetFake() {
+	s.Null = true
+}
+
+// SetFake set fake values.
+func (s *NilTrashedHostHostInterfacesItem) SetFake() {
+	s.Null = true
+}
+
+// SetFake set fake values.
+func (s *NodeAddRequest) SetFake() {
+	{
+		{
+			s.NodeList = nil
+			for i := 0; i < 0; i++ {
+				var elem NilNodeAddRequestNodeListItem
+				{
+					elem.SetFake()
+				}
+				s.NodeList = append(s.NodeList, elem)
+			}
+		}
+	}
+}
+
+// SetFake set fake values.
+func (s *NodeAddRequestNodeListItem) SetFake() {
+	{
+		{
+			s.Bonds = nil
+			for i := 0; i < 0; i++ {
+				var elem NilNodeAddRequestNodeListItemBondsItem
+				{
+					elem.SetFake()
+				}
+				s.Bonds = append(s.Bonds, elem)
+			}
+		}
+	}
+	{
+		{
+			s.BootImage.SetFake()
+		}
+	}
+	{
+		{
+			s.ClientCertFingerprint.SetFake()
+		}
+	}
+	{
+		{
+			s.ClientCertSerial.SetFake()
+		}
+	}
+	{
+		{
+			s.CreatedAt.SetFake()
+		}
+	}
+	{
+		{
+			s.Firmware.SetFake()
+		}
+	}
+	{
+		{
+			s.Hardware.SetFake()
+		}
+	}
+	{
+		{
+			s.ID.SetFake()
+		}
+	}
+	{
+		{
+			s.Interfaces = nil
+			for i := 0; i < 0; i++ {
+				var elem NilNodeAddRequestNodeListItemInterfacesItem
+				{
+					elem.SetFake()
+				}
+				s.Interfaces = append(s.Interfaces, elem)
+			}
+		}
+	}
+	{
+		{
+			s.Inventory.SetFake()
+		}
+	}
+	{
+		{
+			s.Name.SetFake()
+		}
+	}
+	{
+		{
+			s.Provision.SetFake()
+		}
+	}
+	{
+		{
+			s.Revision.SetFake()
+		}
+	}
+	{
+		{
+			s.SmbiosUUID.SetFake()
+		}
+	}
+	{
+		{
+			s.State.SetFake()
+		}
+	}
+	{
+		{
+			s.Tags.SetFake()
+		}
+	}
+	{
+		{
+			s.UID.SetFake()
+		}
+	}
+	{
+		{
+			s.UpdatedAt.SetFake()
+		}
+	}
+}
+
+// SetFake set fake values.
+func (s *NodeAddRequestNodeListItemBondsItem) SetFake() {
+	{
+		{
+			s.Addresses = nil
+			for i := 0; i < 0; i++ {
+				var elem NodeAddRequestNodeListItemBondsItemAddressesItem
+				{
+					elem.SetFake()
+				}
+				s.Addresses = append(s.Addresses, elem)
+			}
+		}
+	}
+	{
+		{
+			s.Bmc.SetFake()
+		}
+	}
+	{
+		{
+			s.Fqdn.SetFake()
+		}
+	}
+	{
+		{
+			s.ID.SetFake()
+		}
+	}
+	{
+		{
+			s.Ifname.SetFake()
+		}
+	}
+	{
+		{
+			s.IP.SetFake()
+		}
+	}
+	{
+		{
+			s.MAC.SetFake()
+		}
+	}
+	{
+		{
+			s.Mode.SetFake()
+		}
+	}
+	{
+		{
+			s.Mtu.SetFake()
+		}
+	}
+	{
+		{
+			s.Options.SetFake()
+		}
+	}
+	{
+		{
+			s.Parent.SetFake()
+		}
+	}
+	{
+		{
+			s.Peers = nil
+			for i := 0; i < 0; i++ {
+				var elem string
+				{
+					elem = "string"
+				}
+				s.Peers = append(s.Peers, elem)
+			}
+		}
+	}
+	{
+		{
+			s.Port.SetFake()
+		}
+	}
+	{
+		{
+			s.Switch.SetFake()
+		}
+	}
+	{
+		{
+			s.Type.SetFake()
+		}
+	}
+	{
+		{
+			s.Vlan.SetFake()
+		}
+	}
+}
+
+// SetFake set fake values.
+func (s *NodeAddRequestNodeListItemBondsItemAddressesItem) SetFake() {
+	{
+		{
+			s.Fqdn.SetFake()
+		}
+	}
+	{
+		{
+			s.IP.SetFake()
+		}
+	}
+	{
+		{
+			s.Primary.SetFake()
+		}
+	}
+}
+
+// SetFake set fake values.
+func (s *NodeAddRequestNodeListItemBondsItemOptions) SetFake() {
+	var (
+		elem string
+		m    map[string]string = s.init()
+	)
+	for i := 0; i < 0; i++ {
+		m[fmt.Sprintf("fake%d", i)] = elem
+	}
+}
+
+// SetFake set fake values.
+func (s *NodeAddRequestNodeListItemHardware) SetFake() {
+	{
+		{
+			s.CollectedAt.SetFake()
+		}
+	}
+	{
+		{
+			s.CoreCount.SetFake()
+		}
+	}
+	{
+		{
+			s.CPUCount.SetFake()
+		}
+	}
+	{
+		{
+			s.CPUModel.SetFake()
+		}
+	}
+	{
+		{
+			s.Dimms.SetFake()
+		}
+	}
+	{
+		{
+			s.Disks.SetFake()
+		}
+	}
+	{
+		{
+			s.MemoryMib.SetFake()
+		}
+	}
+	{
+		{
+			s.Missing.SetFake()
+		}
+	}
+	{
+		{
+			s.Nics.SetFake()
+		}
+	}
+}
+
+// SetFake set fake values.
+func (s *NodeAddRequestNodeListItemHardwareDimmsItem) SetFake() {
+	{
+		{
+			s.SizeMib.SetFake()
+		}
+	}
+	{
+		{
+			s.Slot.SetFake()
+		}
+	}
+}
+
+// SetFake set fake values.
+func (s *NodeAddRequestNodeListItemHardwareDisksItem) SetFake() {
+	{
+		{
+			s.Model.SetFake()
+		}
+	}
+	{
+		{
+			s.Name.SetFake()
+		}
+	}
+	{
+		{
+			s.Serial.SetFake()
+		}
+	}
+	{
+		{
+			s.SizeBytes.SetFake()
+		}
+	}
+}
+
+// SetFake set fake values.
+func (s *NodeAddRequestNodeListItemHardwareNicsItem) SetFake() {
+	{
+		{
+			s.MAC.SetFake()
+		}
+	}
+	{
+		{
+			s.Name.SetFake()
+		}
+	}
+}
+
+// SetFake set fake values.
+func (s *NodeAddRequestNodeListItemInterfacesItem) SetFake() {
+	{
+		{
+			s.Addresses = nil
+			for i := 0; i < 0; i++ {
+				var elem NodeAddRequestNodeListItemInterfacesItemAddressesItem
+				{
+					elem.SetFake()
+				}
+				s.Addresses = append(s.Addresses, elem)
+			}
+		}
+	}
+	{
+		{
+			s.Bmc.SetFake()
+		}
+	}
+	{
+		{
+			s.Fqdn.SetFake()
+		}
+	}
+	{
+		{
+			s.ID.SetFake()
+		}
+	}
+	{
+		{
+			s.Ifname.SetFake()
+		}
+	}
+	{
+		{
+			s.IP.SetFake()
+		}
+	}
+	{
+		{
+			s.MAC.SetFake()
+		}
+	}
+	{
+		{
+			s.Mtu.SetFake()
+		}
+	}
+	{
+		{
+			s.Parent.SetFake()
+		}
+	}
+	{
+		{
+			s.Port.SetFake()
+		}
+	}
+	{
+		{
+			s.Switch.SetFake()
+		}
+	}
+	{
+		{
+			s.Vlan.SetFake()
+		}
+	}
+}
+
+// SetFake set fake values.
+func (s *NodeAddRequestNodeListItemInterfacesItemAddressesItem) SetFake() {
+	{
+		{
+			s.Fqdn.SetFake()
+		}
+	}
+	{
+		{
+			s.IP.SetFake()
+		}
+	}
+	{
+		{
+			s.Primary.SetFake()
+		}
+	}
+}
+
+// SetFake set fake values.
+func (s *NodeAddRequestNodeListItemInventory) SetFake() {
+	{
+		{
+			s.BiosVersion.SetFake()
+		}
+	}
+	{
+		{
+			s.BmcFirmware.SetFake()
+		}
+	}
+	{
+		{
+			s.CapturedAt.SetFake()
+		}
+	}
+	{
+		{
+			s.NicFirmware.SetFake()
+		}
+	}
+}
+
+// SetFake set fake values.
+func (s *NodeAddRequestNodeListItemInventoryNicFirmware) SetFake() {
+	var (
+		elem string
+		m    map[string]string = s.init()
+	)
+	for i := 0; i < 0; i++ {
+		m[fmt.Sprintf("fake%d", i)] = elem
+	}
+}
+
+// SetFake set fake values.
+func (s *NodeBootImageRequest) SetFake() {
+	{
+		{
+			s.Image.SetFake()
+		}
+	}
+	{
+		{
+			s.OnlyIfCurrent = nil
+			for i := 0; i < 0; i++ {
+				var elem string
+				{
+					elem = "string"
+				}
+				s.OnlyIfCurrent = append(s.OnlyIfCurrent, elem)
+			}
+		}
+	}
+}
+
+// SetFake set fake values.
+func (s *NodeBootTokenResponse) SetFake() {
+	{
+		{
+			s.Nodes = nil
+			for i := 0; i < 0; i++ {
+				var elem NodeBootTokenResponseNodesItem
+				{
+					elem.SetFake()
+				}
+				s.Nodes = append(s.Nodes, elem)
+			}
+		}
+	}
+}
+
+// SetFake set fake values.
+func (s *NodeBootTokenResponseNodesItem) SetFake() {
+	{
+		{
+			s.Name.SetFake()
+		}
+	}
+	{
+		{
+			s.Token.SetFake()
+		}
+	}
+}
+
+// SetFake set fake values.
+func (s *NodeClientCertRequest) SetFake() {
+	{
+		{
+			s.Certs = nil
+			for i := 0; i < 0; i++ {
+				var elem NodeClientCertRequestCertsItem
+				{
+					elem.SetFake()
+				}
+				s.Certs = append(s.Certs, elem)
+			}
+		}
+	}
+	{
+		{
+			s.TTL.SetFake()
+		}
+	}
+}
+
+// SetFake set fake values.
+func (s *NodeClientCertRequestCertsItem) SetFake() {
+	{
+		{
+			s.Cert.SetFake()
+		}
+	}
+	{
+		{
+			s.Key.SetFake()
+		}
+	}
+	{
+		{
+			s.Name.SetFake()
+		}
+	}
+}
+
+// SetFake set fake values.
+func (s *NodeCredentialsRequest) SetFake() {
+	{
+		{
+			s.Kind.SetFake()
+		}
+	}
+	{
+		{
+			s.Password = "string"
+		}
+	}
+	{
+		{
+			s.Username = "string"
+		}
+	}
+}
+
+// SetFake set fake values.
+func (s *NodeFileRequest) SetFake() {
+	{
+		{
+			s.Content.SetFake()
+		}
+	}
+	{
+		{
+			s.Name = "string"
+		}
+	}
+}
+
+// SetFake set fake values.
+func (s *NodeFirmwareRequest) SetFake() {
+	{
+		{
+			s.Firmware.SetFake()
+		}
+	}
+}
+
+// SetFake set fake values.
+func (s *NodeNextIPRequest) SetFake() {
+	{
+		{
+			s.Count.SetFake()
+		}
+	}
+	{
+		{
+			s.Reserve.SetFake()
+		}
+	}
+	{
+		{
+			s.Subnet = "string"
+		}
+	}
+}
+
+// SetFake set fake values.
+func (s *NodeNextIPResponse) SetFake() {
+	{
+		{
+			s.Ips = nil
+			for i := 0; i < 0; i++ {
+				var elem string
+				{
+					elem = "string"
+				}
+				s.Ips = append(s.Ips, elem)
+			}
+		}
+	}
+	{
+		{
+			s.ReservedUntil.SetFake()
+		}
+	}
+	{
+		{
+			s.Subnet.SetFake()
+		}
+	}
+}
+
+// SetFake set fake values.
+func (s *NodeProvisionRequest) SetFake() {
+	{
+		{
+			s.Provision.SetFake()
+		}
+	}
+}
+
+// SetFake set fake values.
+func (s *NodeRenameRequest) SetFake() {
+	{
+		{
+			s.FqdnPattern.SetFake()
+		}
+	}
+	{
+		{
+			s.Name = "string"
+		}
+	}
+	{
+		{
+			s.NewName = "string"
+		}
+	}
+}
+
+// SetFake set fake values.
+func (s *NodeStateRequest) SetFake() {
+	{
+		{
+			s.State = "string"
+		}
+	}
+}
+
+// SetFake set fake values.
+func (s *NodeTagsRequest) SetFake() {
+	{
+		{
+			s.Tags.SetFake()
+		}
+	}
+}
+
+// SetFake set fake values.
+func (s *NodeTokenRequest) SetFake() {
+	{
+		{
+			s.Token = "string"
+		}
+	}
+}
+
+// SetFake set fake values.
+func (s *OptBmcJobDeleteRequestNodeJobList) SetFake() {
+	var elem BmcJobDeleteRequestNodeJobList
+	{
+		elem.SetFake()
+	}
+	s.SetTo(elem)
+}
+
+// SetFake set fake values.
+func (s *OptBool) SetFake() {
+	var elem bool
+	{
+		elem = true
+	}
+	s.SetTo(elem)
+}
+
+// SetFake set fake values.
+func (s *OptDataDumpHostsItemBondsItemOptions) SetFake() {
+	var elem DataDumpHostsItemBondsItemOptions
+	{
+		elem.SetFake()
+	}
+	s.SetTo(elem)
+}
+
+// SetFake set fake values.
+func (s *OptDataDumpHostsItemInventoryNicFirmware) SetFake() {
+	var elem DataDumpHostsItemInventoryNicFirmware
+	{
+		elem.SetFake()
+	}
+	s.SetTo(elem)
+}
+
+// SetFake set fake values.
+func (s *OptDataLoadRequestDump) SetFake() {
+	var elem DataLoadRequestDump
+	{
+		elem.SetFake()
+	}
+	s.SetTo(elem)
+}
+
+// SetFake set fake values.
+func (s *OptDataLoadRequestDumpHostsItemBondsItemOptions) SetFake() {
+	var elem DataLoadRequestDumpHostsItemBondsItemOptions
+	{
+		elem.SetFake()
+	}
+	s.SetTo(elem)
+}
+
+// SetFake set fake values.
+func (s *OptDataLoadRequestDumpHostsItemInventoryNicFirmware) SetFake() {
+	var elem DataLoadRequestDumpHostsItemInventoryNicFirmware
+	{
+		elem.SetFake()
+	}
+	s.SetTo(elem)
+}
+
+// SetFake set fake values.
+func (s *OptDataLoadResponseDiff) SetFake() {
+	var elem DataLoadResponseDiff
+	{
+		elem.SetFake()
+	}
+	s.SetTo(elem)
+}
+
+// SetFake set fake values.
+func (s *OptDataLoadResponseDiffDNSRecords) SetFake() {
+	var elem DataLoadResponseDiffDNSRecords
+	{
+		elem.SetFake()
+	}
+	s.SetTo(elem)
+}
+
+// SetFake set fake values.
+func (s *OptDataLoadResponseDiffHosts) SetFake() {
+	var elem DataLoadResponseDiffHosts
+	{
+		elem.SetFake()
+	}
+	s.SetTo(elem)
+}
+
+// SetFake set fake values.
+func (s *OptDataLoadResponseDiffImages) SetFake() {
+	var elem DataLoadResponseDiffImages
+	{
+		elem.SetFake()
+	}
+	s.SetTo(elem)
+}
+
+// SetFake set fake values.
+func (s *OptDateTime) SetFake() {
+	var elem time.Time
+	{
+		elem = time.Now()
+	}
+	s.SetTo(elem)
+}
+
+// SetFake set fake values.
+func (s *OptEventJobMessagesItemRedfishError) SetFake() {
+	var elem EventJobMessagesItemRedfishError
+	{
+		elem.SetFake()
+	}
+	s.SetTo(elem)
+}
+
+// SetFake set fake values.
+func (s *OptEventJobMessagesItemRedfishErrorError) SetFake() {
+	var elem EventJobMessagesItemRedfishErrorError
+	{
+		elem.SetFake()
+	}
+	s.SetTo(elem)
+}
+
+// SetFake set fake values.
+func (s *OptFirmwareUpdateReportInventoryNicFirmware) SetFake() {
+	var elem FirmwareUpdateReportInventoryNicFirmware
+	{
+		elem.SetFake()
+	}
+	s.SetTo(elem)
+}
+
+// SetFake set fake values.
+func (s *OptFloat32) SetFake() {
+	var elem float32
+	{
+		elem = float32(0)
+	}
+	s.SetTo(elem)
+}
+
+// SetFake set fake values.
+func (s *OptFloat64) SetFake() {
+	var elem float64
+	{
+		elem = float64(0)
+	}
+	s.SetTo(elem)
+}
+
+// SetFake set fake values.
+func (s *OptHTTPErrorErrorsItemMore) SetFake() {
+	var elem HTTPErrorErrorsItemMore
+	{
+		elem.SetFake()
+	}
+	s.SetTo(elem)
+}
+
+// SetFake set fake values.
+func (s *OptHostBondsItemOptions) SetFake() {
+	var elem HostBondsItemOptions
+	{
+		elem.SetFake()
+	}
+	s.SetTo(elem)
+}
+
+// SetFake set fake values.
+func (s *OptHostInventoryNicFirmware) SetFake() {
+	var elem HostInventoryNicFirmware
+	{
+		elem.SetFake()
+	}
+	s.SetTo(elem)
+}
+
+// SetFake set fake values.
+func (s *OptInt) SetFake() {
+	var elem int
+	{
+		elem = int(0)
+	}
+	s.SetTo(elem)
+}
+
+// SetFake set fake values.
+func (s *OptInt64) SetFake() {
+	var elem int64
+	{
+		elem = int64(0)
+	}
+	s.SetTo(elem)
+}
+
+// SetFake set fake values.
+func (s *OptJobMessageRedfishError) SetFake() {
+	var elem JobMessageRedfishError
+	{
+		elem.SetFake()
+	}
+	s.SetTo(elem)
+}
+
+// SetFake set fake values.
+func (s *OptJobMessageRedfishErrorError) SetFake() {
+	var elem JobMessageRedfishErrorError
+	{
+		elem.SetFake()
+	}
+	s.SetTo(elem)
+}
+
+// SetFake set fake values.
+func (s *OptNilBiosReportAttributes) SetFake() {
+	s.Null = true
+	s.Set = true
+}
+
+// SetFake set fake values.
+func (s *OptNilBootImageAddRequestBootImagesItemProvisionTemplates) SetFake() {
+	s.Null = true
+	s.Set = true
+}
+
+// SetFake set fake values.
+func (s *OptNilBootImageProvisionTemplates) SetFake() {
+	s.Null = true
+	s.Set = true
+}
+
+// SetFake set fake values.
+func (s *OptNilChangeFeedChangesItemDiff) SetFake() {
+	s.Null = true
+	s.Set = true
+}
+
+// SetFake set fake values.
+func (s *OptNilDataDumpHostsItemHardware) SetFake() {
+	s.Null = true
+	s.Set = true
+}
+
+// SetFake set fake values.
+func (s *OptNilDataDumpHostsItemInventory) SetFake() {
+	s.Null = true
+	s.Set = true
+}
+
+// SetFake set fake values.
+func (s *OptNilDataDumpImagesItemProvisionTemplates) SetFake() {
+	s.Null = true
+	s.Set = true
+}
+
+// SetFake set fake values.
+func (s *OptNilDataLoadRequestDumpHostsItemHardware) SetFake() {
+	s.Null = true
+	s.Set = true
+}
+
+// SetFake set fake values.
+func (s *OptNilDataLoadRequestDumpHostsItemInventory) SetFake() {
+	s.Null = true
+	s.Set = true
+}
+
+// SetFake set fake values.
+func (s *OptNilDataLoadRequestDumpImagesItemProvisionTemplates) SetFake() {
+	s.Null = true
+	s.Set = true
+}
+
+// SetFake set fake values.
+func (s *OptNilDateTime) SetFake() {
+	s.Null = true
+	s.Set = true
+}
+
+// SetFake set fake values.
+func (s *OptNilFirmwareUpdateReportInventory) SetFake() {
+	s.Null = true
+	s.Set = true
+}
+
+// SetFake set fake values.
+func (s *OptNilFloat64) SetFake() {
+	s.Null = true
+	s.Set = true
+}
+
+// SetFake set fake values.
+func (s *OptNilHTTPErrorErrorsItemArray) SetFake() {
+	s.Null = true
+	s.Set = true
+}
+
+// SetFake set fake values.
+func (s *OptNilHardwareReportHardware) SetFake() {
+	s.Null = true
+	s.Set = true
+}
+
+// SetFake set fake values.
+func (s *OptNilHostHardware) SetFake() {
+	s.Null = true
+	s.Set = true
+}
+
+// SetFake set fake values.
+func (s *OptNilHostInventory) SetFake() {
+	s.Null = true
+	s.Set = true
+}
+
+// SetFake set fake values.
+func (s *OptNilInt) SetFake() {
+	s.Null = true
+	s.Set = true
+}
+
+// SetFake set fake values.
+func (s *OptNilInt64) SetFake() {
+	s.Null = true
+	s.Set = true
+}
+
+// SetFake set fake values.
+func (s *OptNilJobMessageRedfishErrorErrorMessageDotExtendedInfoItemArray) SetFake() {
+	s.Null = true
+	s.Set = true
+}
+
+// SetFake set fake values.
+func (s *OptNilNilDataDumpCredentialsItemArray) SetFake() {
+	s.Null = true
+	s.Set = true
+}
+
+// SetFake set fake values.
+func (s *OptNilNilDataDumpHostsItemHardwareDimmsItemArray) SetFake() {
+	s.Null = true
+	s.Set = true
+}
+
+// SetFake set fake values.
+func (s *OptNilNilDataDumpHostsItemHardwareDisksItemArray) SetFake() {
+	s.Null = true
+	s.Set = true
+}
+
+// SetFake set fake values.
+func (s *OptNilNilDataDumpHostsItemHardwareNicsItemArray) SetFake() {
+	s.Null = true
+	s.Set = true
+}
+
+// SetFake set fake values.
+func (s *OptNilNilDataLoadRequestDumpCredentialsItemArray) SetFake() {
+	s.Null = true
+	s.Set = true
+}
+
+// SetFake set fake values.
+func (s *OptNilNilDataLoadRequestDumpHostsItemHardwareDimmsItemArray) SetFake() {
+	s.Null = true
+	s.Set = true
+}
+
+// SetFake set fake values.
+func (s *OptNilNilDataLoadRequestDumpHostsItemHardwareDisksItemArray) SetFake() {
+	s.Null = true
+	s.Set = true
+}
+
+// SetFake set fake values.
+func (s *OptNilNilDataLoadRequestDumpHostsItemHardwareNicsItemArray) SetFake() {
+	s.Null = true
+	s.Set = true
+}
+
+// SetFake set fake values.
+func (s *OptNilNilHardwareReportHardwareDimmsItemArray) SetFake() {
+	s.Null = true
+	s.Set = true
+}
+
+// SetFake set fake values.
+func (s *OptNilNilHardwareReportHardwareDisksItemArray) SetFake() {
+	s.Null = true
+	s.Set = true
+}
+
+// SetFake set fake values.
+func (s *OptNilNilHardwareReportHardwareNicsItemArray) SetFake() {
+	s.Null = true
+	s.Set = true
+}
+
+// SetFake set fake values.
+func (s *OptNilNilHostHardwareDimmsItemArray) SetFake() {
+	s.Null = true
+	s.Set = true
+}
+
+// SetFake set fake values.
+func (s *OptNilNilHostHardwareDisksItemArray) SetFake() {
+	s.Null = true
+	s.Set = true
+}
+
+// SetFake set fake values.
+func (s *OptNilNilHostHardwareNicsItemArray) SetFake() {
+	s.Null = true
+	s.Set = true
+}
+
+// SetFake set fake values.
+func (s *OptNilNilIntArray) SetFake() {
+	s.Null = true
+	s.Set = true
+}
+
+// SetFake set fake values.
+func (s *OptNilNilNodeAddRequestNodeListItemHardwareDimmsItemArray) SetFake() {
+	s.Null = true
+	s.Set = true
+}
+
+// SetFake set fake values.
+func (s *OptNilNilNodeAddRequestNodeListItemHardwareDisksItemArray) SetFake() {
+	s.Null = true
+	s.Set = true
+}
+
+// SetFake set fake values.
+func (s *OptNilNilNodeAddRequestNodeListItemHardwareNicsItemArray) SetFake() {
+	s.Null = true
+	s.Set = true
+}
+
+// SetFake set fake values.
+func (s *OptNilNilRedfishJobJobsItemArray) SetFake() {
+	s.Null = true
+	s.Set = true
+}
+
+// SetFake set fake values.
+func (s *OptNilNilScheduledActionResultsItemArray) SetFake() {
+	s.Null = true
+	s.Set = true
+}
+
+// SetFake set fake values.
+func (s *OptNilNilStringArray) SetFake() {
+	s.Null = true
+	s.Set = true
+}
+
+// SetFake set fake values.
+func (s *OptNilNilTrashedHostHostHardwareDimmsItemArray) SetFake() {
+	s.Null = true
+	s.Set = true
+}
+
+// SetFake set fake values.
+func (s *OptNilNilTrashedHostHostHardwareDisksItemArray) SetFake() {
+	s.Null = true
+	s.Set = true
+}
+
+// SetFake set fake values.
+func (s *OptNilNilTrashedHostHostHardwareNicsItemArray) SetFake() {
+	s.Null = true
+	s.Set = true
+}
+
+// SetFake set fake values.
+func (s *OptNilNodeAddRequestNodeListItemHardware) SetFake() {
+	s.Null = true
+	s.Set = true
+}
+
+// SetFake set fake values.
+func (s *OptNilNodeAddRequestNodeListItemInventory) SetFake() {
+	s.Null = true
+	s.Set = true
+}
+
+// SetFake set fake values.
+func (s *OptNilRedfishSystemNicFirmware) SetFake() {
+	s.Null = true
+	s.Set = true
+}
+
+// SetFake set fake values.
+func (s *OptNilRedfishSystemOemDell) SetFake() {
+	s.Null = true
+	s.Set = true
+}
+
+// SetFake set fake values.
+func (s *OptNilRedfishSystemOemDellMessageDotExtendedInfoItemArray) SetFake() {
+	s.Null = true
+	s.Set = true
+}
+
+// SetFake set fake values.
+func (s *OptNilString) SetFake() {
+	s.Null = true
+	s.Set = true
+}
+
+// SetFake set fake values.
+func (s *OptNilStringArray) SetFake() {
+	s.Null = true
+	s.Set = true
+}
+
+// SetFake set fake values.
+func (s *OptNilTrashedHostHost) SetFake() {
+	s.Null = true
+	s.Set = true
+}
+
+// SetFake set fake values.
+func (s *OptNilTrashedHostHostHardware) SetFake() {
+	s.Null = true
+	s.Set = true
+}
+
+// SetFake set fake values.
+func (s *OptNilTrashedHostHostInventory) SetFake() {
+	s.Null = true
+	s.Set = true
+}
+
+// SetFake set fake values.
+func (s *OptNodeAddRequestNodeListItemBondsItemOptions) SetFake() {
+	var elem NodeAddRequestNodeListItemBondsItemOptions
+	{
+		elem.SetFake()
+	}
+	s.SetTo(elem)
+}
+
+// SetFake set fake values.
+func (s *OptNodeAddRequestNodeListItemInventoryNicFirmware) SetFake() {
+	var elem NodeAddRequestNodeListItemInventoryNicFirmware
+	{
+		elem.SetFake()
+	}
+	s.SetTo(elem)
+}
+
+// SetFake set fake values.
+func (s *OptPrometheusTargetGroupLabels) SetFake() {
+	var elem PrometheusTargetGroupLabels
+	{
+		elem.SetFake()
+	}
+	s.SetTo(elem)
+}
+
+// SetFake set fake values.
+func (s *OptRedfishJobJobsItemParameters) SetFake() {
+	var elem RedfishJobJobsItemParameters
+	{
+		elem.SetFake()
+	}
+	s.SetTo(elem)
+}
+
+// SetFake set fake values.
+func (s *OptRedfishJobJobsItemPayload) SetFake() {
+	var elem RedfishJobJobsItemPayload
+	{
+		elem.SetFake()
+	}
+	s.SetTo(elem)
+}
+
+// SetFake set fake values.
+func (s *OptRedfishJobJobsItemSchedule) SetFake() {
+	var elem RedfishJobJobsItemSchedule
+	{
+		elem.SetFake()
+	}
+	s.SetTo(elem)
+}
+
+// SetFake set fake values.
+func (s *OptScheduledActionResultsItemRedfishError) SetFake() {
+	var elem ScheduledActionResultsItemRedfishError
+	{
+		elem.SetFake()
+	}
+	s.SetTo(elem)
+}
+
+// SetFake set fake values.
+func (s *OptScheduledActionResultsItemRedfishErrorError) SetFake() {
+	var elem ScheduledActionResultsItemRedfishErrorError
+	{
+		elem.SetFake()
+	}
+	s.SetTo(elem)
+}
+
+// SetFake set fake values.
+func (s *OptStatsHosts) SetFake() {
+	var elem StatsHosts
+	{
+		elem.SetFake()
+	}
+	s.SetTo(elem)
+}
+
+// SetFake set fake values.
+func (s *OptString) SetFake() {
+	var elem string
+	{
+		elem = "string"
+	}
+	s.SetTo(elem)
+}
+
+// SetFake set fake values.
+func (s *OptTrashedHostHostBondsItemOptions) SetFake() {
+	var elem TrashedHostHostBondsItemOptions
+	{
+		elem.SetFake()
+	}
+	s.SetTo(elem)
+}
+
+// SetFake set fake values.
+func (s *OptTrashedHostHostInventoryNicFirmware) SetFake() {
+	var elem TrashedHostHostInventoryNicFirmware
+	{
+		elem.SetFake()
+	}
+	s.SetTo(elem)
+}
+
+// SetFake set fake values.
+func (s *PasswordRotationReport) SetFake() {
+	{
+		{
+			s.Attention.SetFake()
+		}
+	}
+	{
+		{
+			s.Host.SetFake()
+		}
+	}
+	{
+		{
+			s.Msg.SetFake()
+		}
+	}
+	{
+		{
+			s.Status.SetFake()
+		}
+	}
+	{
+		{
+			s.Username.SetFake()
+		}
+	}
+}
+
+// SetFake set fake values.
+func (s *PatchRolesRequest) SetFake() {
+	{
+		{
+			s.PermissionList = nil
+			for i := 0; i < 0; i++ {
+				var elem PatchRolesRequestPermissionListItem
+				{
+					elem.SetFake()
+				}
+				s.PermissionList = append(s.PermissionList, elem)
+			}
+		}
+	}
+	{
+		{
+			s.Role.SetFake()
+		}
+	}
+}
+
+// SetFake set fake values.
+func (s *PatchRolesRequestPermissionListItem) SetFake() {
+	{
+		{
+			s.Method.SetFake()
+		}
+	}
+	{
+		{
+			s.Path.SetFake()
+		}
+	}
+}
+
+// SetFake set fake values.
+func (s *PendingBMC) SetFake() {
+	{
+		{
+			s.FirstSeen.SetFake()
+		}
+	}
+	{
+		{
+			s.LastSeen.SetFake()
+		}
+	}
+	{
+		{
+			s.MAC.SetFake()
+		}
+	}
+	{
+		{
+			s.Relay.SetFake()
+		}
+	}
+	{
+		{
+			s.ServerIP.SetFake()
+		}
+	}
+	{
+		{
+			s.VendorClass.SetFake()
+		}
+	}
+}
+
+// SetFake set fake values.
+func (s *PostRolesRequest) SetFake() {
+	{
+		{
+			s.InheritedRole.SetFake()
+		}
+	}
+	{
+		{
+			s.Role.SetFake()
+		}
+	}
+}
+
+// SetFake set fake values.
+func (s *PrometheusTargetGroup) SetFake() {
+	{
+		{
+			s.Labels.SetFake()
+		}
+	}
+	{
+		{
+			s.Targets = nil
+			for i := 0; i < 0; i++ {
+				var elem string
+				{
+					elem = "string"
+				}
+				s.Targets = append(s.Targets, elem)
+			}
+		}
+	}
+}
+
+// SetFake set fake values.
+func (s *PrometheusTargetGroupLabels) SetFake() {
+	var (
+		elem string
+		m    map[string]string = s.init()
+	)
+	for i := 0; i < 0; i++ {
+		m[fmt.Sprintf("fake%d", i)] = elem
+	}
+}
+
+// SetFake set fake values.
+func (s *ReadOnlyResponse) SetFake() {
+	{
+		{
+			s.Enabled.SetFake()
+		}
+	}
+	{
+		{
+			s.Primary.SetFake()
+		}
+	}
+}
+
+// SetFake set fake values.
+func (s *Record) SetFake() {
+	{
+		{
+			s.ID.SetFake()
+		}
+	}
+	{
+		{
+			s.Name = "string"
+		}
+	}
+	{
+		{
+			s.Ptr.SetFake()
+		}
+	}
+	{
+		{
+			s.TTL.SetFake()
+		}
+	}
+	{
+		{
+			s.Type = "string"
+		}
+	}
+	{
+		{
+			s.Value = "string"
+		}
+	}
+}
+
+// SetFake set fake values.
+func (s *RedfishDellUpgradeFirmware) SetFake() {
+	{
+		{
+			s.Message.SetFake()
+		}
+	}
+	{
+		{
+			s.Name.SetFake()
+		}
+	}
+	{
+		{
+			s.Status.SetFake()
+		}
+	}
+	{
+		{
+			s.UpdateCount.SetFake()
+		}
+	}
+	{
+		{
+			s.UpdateList = nil
+			for i := 0; i < 0; i++ {
+				var elem RedfishDellUpgradeFirmwareUpdateListItem
+				{
+					elem.SetFake()
+				}
+				s.UpdateList = append(s.UpdateList, elem)
+			}
+		}
+	}
+	{
+		{
+			s.UpdateRebootType.SetFake()
+		}
+	}
+}
+
+// SetFake set fake values.
+func (s *RedfishDellUpgradeFirmwareUpdateListItem) SetFake() {
+	{
+		{
+			s.BaseLocation.SetFake()
+		}
+	}
+	{
+		{
+			s.ComponentID.SetFake()
+		}
+	}
+	{
+		{
+			s.ComponentInfoName.SetFake()
+		}
+	}
+	{
+		{
+			s.ComponentInfoValue.SetFake()
+		}
+	}
+	{
+		{
+			s.ComponentType.SetFake()
+		}
+	}
+	{
+		{
+			s.Criticality.SetFake()
+		}
+	}
+	{
+		{
+			s.DisplayName.SetFake()
+		}
+	}
+	{
+		{
+			s.InstalledVersion.SetFake()
+		}
+	}
+	{
+		{
+			s.JobID.SetFake()
+		}
+	}
+	{
+		{
+			s.Name.SetFake()
+		}
+	}
+	{
+		{
+			s.PackageName.SetFake()
+		}
+	}
+	{
+		{
+			s.PackagePath.SetFake()
+		}
+	}
+	{
+		{
+			s.PackageVersion.SetFake()
+		}
+	}
+	{
+		{
+			s.RebootType.SetFake()
+		}
+	}
+	{
+		{
+			s.Target.SetFake()
+		}
+	}
+}
+
+// SetFake set fake values.
+func (s *RedfishJob) SetFake() {
+	{
+		{
+			s.Jobs.SetFake()
+		}
+	}
+	{
+		{
+			s.Name.SetFake()
+		}
+	}
+}
+
+// SetFake set fake values.
+func (s *RedfishJobJobsItem) SetFake() {
+	{
+		{
+			s.MessageDotExtendedInfo = nil
+			for i := 0; i < 0; i++ {
+				var elem RedfishJobJobsItemMessageDotExtendedInfoItem
+				{
+					elem.SetFake()
+				}
+				s.MessageDotExtendedInfo = append(s.MessageDotExtendedInfo, elem)
+			}
+		}
+	}
+	{
+		{
+			s.OdataDotContext.SetFake()
+		}
+	}
+	{
+		{
+			s.OdataDotEtag.SetFake()
+		}
+	}
+	{
+		{
+			s.OdataDotID.SetFake()
+		}
+	}
+	{
+		{
+			s.OdataDotType.SetFake()
+		}
+	}
+	{
+		{
+			s.CreatedBy.SetFake()
+		}
+	}
+	{
+		{
+			s.CreationTime.SetFake()
+		}
+	}
+	{
+		{
+			s.Description.SetFake()
+		}
+	}
+	{
+		{
+			s.EndTime.SetFake()
+		}
+	}
+	{
+		{
+			s.EstimatedCompletionTime.SetFake()
+		}
+	}
+	{
+		{
+			s.EstimatedDuration.SetFake()
+		}
+	}
+	{
+		{
+			s.HidePayload.SetFake()
+		}
+	}
+	{
+		{
+			s.ID.SetFake()
+		}
+	}
+	{
+		{
+			s.JobPriority.SetFake()
+		}
+	}
+	{
+		{
+			s.JobState.SetFake()
+		}
+	}
+	{
+		{
+			s.JobStatus.SetFake()
+		}
+	}
+	{
+		{
+			s.JobType.SetFake()
+		}
+	}
+	{
+		{
+			s.MaxExecutionTime.SetFake()
+		}
+	}
+	{
+		{
+			s.Messages = nil
+			for i := 0; i < 0; i++ {
+				var elem RedfishJobJobsItemMessagesItem
+				{
+					elem.SetFake()
+				}
+				s.Messages = append(s.Messages, elem)
+			}
+		}
+	}
+	{
+		{
+			s.Name.SetFake()
+		}
+	}
+	{
+		{
+			s.Oem = []byte("null")
+		}
+	}
+	{
+		{
+			s.Parameters.SetFake()
+		}
+	}
+	{
+		{
+			s.Payload.SetFake()
+		}
+	}
+	{
+		{
+			s.PercentComplete.SetFake()
+		}
+	}
+	{
+		{
+			s.RawData = []byte("[]byte")
+		}
+	}
+	{
+		{
+			s.Schedule.SetFake()
+		}
+	}
+	{
+		{
+			s.StartTime.SetFake()
+		}
+	}
+	{
+		{
+			s.StepOrder.SetFake()
+		}
+	}
+}
+
+// SetFake set fake values.
+func (s *RedfishJobJobsItemMessageDotExtendedInfoItem) SetFake() {
+	{
+		{
+			s.Message.SetFake()
+		}
+	}
+	{
+		{
+			s.MessageArgs = nil
+			for i := 0; i < 0; i++ {
+				var elem string
+				{
+					elem = "string"
+				}
+				s.MessageArgs = append(s.MessageArgs, elem)
+			}
+		}
+	}
+	{
+		{
+			s.MessageId.SetFake()
+		}
+	}
+	{
+		{
+			s.MessageSeverity.SetFake()
+		}
+	}
+	{
+		{
+			s.Oem = []byte("null")
+		}
+	}
+	{
+		{
+			s.RelatedProperties.SetFake()
+		}
+	}
+	{
+		{
+			s.Resolution.SetFake()
+		}
+	}
+	{
+		{
+			s.ResolutionSteps = nil
+			for i := 0; i < 0; i++ {
+				var elem RedfishJobJobsItemMessageDotExtendedInfoItemResolutionStepsItem
+				{
+					elem.SetFake()
+				}
+				s.ResolutionSteps = append(s.ResolutionSteps, elem)
+			}
+		}
+	}
+	{
+		{
+			s.Severity.SetFake()
+		}
+	}
+	{
+		{
+			s.UserAuthenticationSource.SetFake()
+		}
+	}
+	{
+		{
+			s.Username.SetFake()
+		}
+	}
+}
+
+// SetFake set fake values.
+func (s *RedfishJobJobsItemMessageDotExtendedInfoItemResolutionStepsItem) SetFake() {
+	{
+		{
+			s.ActionParameters = nil
+			for i := 0; i < 0; i++ {
+				var elem RedfishJobJobsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem
+				{
+					elem.SetFake()
+				}
+				s.ActionParameters = append(s.ActionParameters, elem)
+			}
+		}
+	}
+	{
+		{
+			s.ActionURI.SetFake()
+		}
+	}
+	{
+		{
+			s.Oem = []byte("null")
+		}
+	}
+	{
+		{
+			s.Priority.SetFake()
+		}
+	}
+	{
+		{
+			s.ResolutionType.SetFake()
+		}
+	}
+	{
+		{
+			s.RetryCount.SetFake()
+		}
+	}
+	{
+		{
+			s.RetryIntervalSeconds.SetFake()
+		}
+	}
+	{
+		{
+			s.TargetComponentURI.SetFake()
+		}
+	}
+}
+
+// SetFake set fake values.
+func (s *RedfishJobJobsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) SetFake() {
+	{
+		{
+			s.AllowableNumbers = nil
+			for i := 0; i < 0; i++ {
+				var elem string
+				{
+					elem = "string"
+				}
+				s.AllowableNumbers = append(s.AllowableNumbers, elem)
+			}
+		}
+	}
+	{
+		{
+			s.AllowablePattern.SetFake()
+		}
+	}
+	{
+		{
+			s.AllowableValueDescriptions = nil
+			for i := 0; i < 0; i++ {
+				var elem string
+				{
+					elem = "string"
+				}
+				s.AllowableValueDescriptions = append(s.AllowableValueDescriptions, elem)
+			}
+		}
+	}
+	{
+		{
+			s.AllowableValues = nil
+			for i := 0; i < 0; i++ {
+				var elem string
+				{
+					elem = "string"
+				}
+				s.AllowableValues = append(s.AllowableValues, elem)
+			}
+		}
+	}
+	{
+		{
+			s.ArraySizeMaximum.SetFake()
+		}
+	}
+	{
+		{
+			s.ArraySizeMinimum.SetFake()
+		}
+	}
+	{
+		{
+			s.DataType.SetFake()
+		}
+	}
+	{
+		{
+			s.DefaultValue.SetFake()
+		}
+	}
+	{
+		{
+			s.MaximumValue.SetFake()
+		}
+	}
+	{
+		{
+			s.MinimumValue.SetFake()
+		}
+	}
+	{
+		{
+			s.Name.SetFake()
+		}
+	}
+	{
+		{
+			s.NoDefaultValue.SetFake()
+		}
+	}
+	{
+		{
+			s.ObjectDataType.SetFake()
+		}
+	}
+	{
+		{
+			s.Required.SetFake()
+		}
+	}
+}
+
+// SetFake set fake values.
+func (s *RedfishJobJobsItemMessagesItem) SetFake() {
+	{
+		{
+			s.Message.SetFake()
+		}
+	}
+	{
+		{
+			s.MessageArgs = nil
+			for i := 0; i < 0; i++ {
+				var elem string
+				{
+					elem = "string"
+				}
+				s.MessageArgs = append(s.MessageArgs, elem)
+			}
+		}
+	}
+	{
+		{
+			s.MessageId.SetFake()
+		}
+	}
+	{
+		{
+			s.MessageSeverity.SetFake()
+		}
+	}
+	{
+		{
+			s.Oem = []byte("null")
+		}
+	}
+	{
+		{
+			s.RelatedProperties.SetFake()
+		}
+	}
+	{
+		{
+			s.Resolution.SetFake()
+		}
+	}
+	{
+		{
+			s.ResolutionSteps = nil
+			for i := 0; i < 0; i++ {
+				var elem RedfishJobJobsItemMessagesItemResolutionStepsItem
+				{
+					elem.SetFake()
+				}
+				s.ResolutionSteps = append(s.ResolutionSteps, elem)
+			}
+		}
+	}
+	{
+		{
+			s.Severity.SetFake()
+		}
+	}
+	{
+		{
+			s.UserAuthenticationSource.SetFake()
+		}
+	}
+	{
+		{
+			s.Username.SetFake()
+		}
+	}
+}
+
+// SetFake set fake values.
+func (s *RedfishJobJobsItemMessagesItemResolutionStepsItem) SetFake() {
+	{
+		{
+			s.ActionParameters = nil
+			for i := 0; i < 0; i++ {
+				var elem RedfishJobJobsItemMessagesItemResolutionStepsItemActionParametersItem
+				{
+					elem.SetFake()
+				}
+				s.ActionParameters = append(s.ActionParameters, elem)
+			}
+		}
+	}
+	{
+		{
+			s.ActionURI.SetFake()
+		}
+	}
+	{
+		{
+			s.Oem = []byte("null")
+		}
+	}
+	{
+		{
+			s.Priority.SetFake()
+		}
+	}
+	{
+		{
+			s.ResolutionType.SetFake()
+		}
+	}
+	{
+		{
+			s.RetryCount.SetFake()
+		}
+	}
+	{
+		{
+			s.RetryIntervalSeconds.SetFake()
+		}
+	}
+	{
+		{
+			s.TargetComponentURI.SetFake()
+		}
+	}
+}
+
+// SetFake set fake values.
+func (s *RedfishJobJobsItemMessagesItemResolutionStepsItemActionParametersItem) SetFake() {
+	{
+		{
+			s.AllowableNumbers = nil
+			for i := 0; i < 0; i++ {
+				var elem string
+				{
+					elem = "string"
+				}
+				s.AllowableNumbers = append(s.AllowableNumbers, elem)
+			}
+		}
+	}
+	{
+		{
+			s.AllowablePattern.SetFake()
+		}
+	}
+	{
+		{
+			s.AllowableValueDescriptions = nil
+			for i := 0; i < 0; i++ {
+				var elem string
+				{
+					elem = "string"
+				}
+				s.AllowableValueDescriptions = append(s.AllowableValueDescriptions, elem)
+			}
+		}
+	}
+	{
+		{
+			s.AllowableValues = nil
+			for i := 0; i < 0; i++ {
+				var elem string
+				{
+					elem = "string"
+				}
+				s.AllowableValues = append(s.AllowableValues, elem)
+			}
+		}
+	}
+	{
+		{
+			s.ArraySizeMaximum.SetFake()
+		}
+	}
+	{
+		{
+			s.ArraySizeMinimum.SetFake()
+		}
+	}
+	{
+		{
+			s.DataType.SetFake()
+		}
+	}
+	{
+		{
+			s.DefaultValue.SetFake()
+		}
+	}
+	{
+		{
+			s.MaximumValue.SetFake()
+		}
+	}
+	{
+		{
+			s.MinimumValue.SetFake()
+		}
+	}
+	{
+		{
+			s.Name.SetFake()
+		}
+	}
+	{
+		{
+			s.NoDefaultValue.SetFake()
+		}
+	}
+	{
+		{
+			s.ObjectDataType.SetFake()
+		}
+	}
+	{
+		{
+			s.Required.SetFake()
+		}
+	}
+}
+
+// SetFake set fake values.
+func (s *RedfishJobJobsItemParameters) SetFake() {
+	var (
+		elem jx.Raw
+		m    map[string]jx.Raw = s.init()
+	)
+	for i := 0; i < 0; i++ {
+		m[fmt.Sprintf("fake%d", i)] = elem
+	}
+}
+
+// SetFake set fake values.
+func (s *RedfishJobJobsItemPayload) SetFake() {
+	{
+		{
+			s.HttpHeaders.SetFake()
+		}
+	}
+	{
+		{
+			s.HttpOperation.SetFake()
+		}
+	}
+	{
+		{
+			s.JsonBody.SetFake()
+		}
+	}
+	{
+		{
+			s.TargetUri.SetFake()
+		}
+	}
+}
+
+// SetFake set fake values.
+func (s *RedfishJobJobsItemSchedule) SetFake() {
+	{
+		{
+			s.EnabledDaysOfMonth.SetFake()
+		}
+	}
+	{
+		{
+			s.EnabledDaysOfWeek.SetFake()
+		}
+	}
+	{
+		{
+			s.EnabledIntervals.SetFake()
+		}
+	}
+	{
+		{
+			s.EnabledMonthsOfYear.SetFake()
+		}
+	}
+	{
+		{
+			s.InitialStartTime.SetFake()
+		}
+	}
+	{
+		{
+			s.Lifetime.SetFake()
+		}
+	}
+	{
+		{
+			s.MaxOccurrences.SetFake()
+		}
+	}
+	{
+		{
+			s.Name.SetFake()
+		}
+	}
+	{
+		{
+			s.RecurrenceInterval.SetFake()
+		}
+	}
+}
+
+// SetFake set fake values.
+func (s *RedfishMetricReport) SetFake() {
+	{
+		{
+			s.Name.SetFake()
+		}
+	}
+	{
+		{
+			s.Reports = nil
+			for i := 0; i < 0; i++ {
+				var elem NilRedfishMetricReportReportsItem
+				{
+					elem.SetFake()
+				}
+				s.Reports = append(s.Reports, elem)
+			}
+		}
+	}
+}
+
+// SetFake set fake values.
+func (s *RedfishMetricReportReportsItem) SetFake() {
+	{
+		{
+			s.MessageDotExtendedInfo = nil
+			for i := 0; i < 0; i++ {
+				var elem RedfishMetricReportReportsItemMessageDotExtendedInfoItem
+				{
+					elem.SetFake()
+				}
+				s.MessageDotExtendedInfo = append(s.MessageDotExtendedInfo, elem)
+			}
+		}
+	}
+	{
+		{
+			s.OdataDotContext.SetFake()
+		}
+	}
+	{
+		{
+			s.OdataDotEtag.SetFake()
+		}
+	}
+	{
+		{
+			s.OdataDotID.SetFake()
+		}
+	}
+	{
+		{
+			s.OdataDotType.SetFake()
+		}
+	}
+	{
+		{
+			s.Context.SetFake()
+		}
+	}
+	{
+		{
+			s.Description.SetFake()
+		}
+	}
+	{
+		{
+			s.ID.SetFake()
+		}
+	}
+	{
+		{
+			s.MetricValues = nil
+			for i := 0; i < 0; i++ {
+				var elem RedfishMetricReportReportsItemMetricValuesItem
+				{
+					elem.SetFake()
+				}
+				s.MetricValues = append(s.MetricValues, elem)
+			}
+		}
+	}
+	{
+		{
+			s.Name.SetFake()
+		}
+	}
+	{
+		{
+			s.Oem = []byte("null")
+		}
+	}
+	{
+		{
+			s.ReportSequence.SetFake()
+		}
+	}
+	{
+		{
+			s.Timestamp.SetFake()
+		}
+	}
+}
+
+// SetFake set fake values.
+func (s *RedfishMetricReportReportsItemMessageDotExtendedInfoItem) SetFake() {
+	{
+		{
+			s.Message.SetFake()
+		}
+	}
+	{
+		{
+			s.MessageArgs = nil
+			for i := 0; i < 0; i++ {
+				var elem string
+				{
+					elem = "string"
+				}
+				s.MessageArgs = append(s.MessageArgs, elem)
+			}
+		}
+	}
+	{
+		{
+			s.MessageId.SetFake()
+		}
+	}
+	{
+		{
+			s.MessageSeverity.SetFake()
+		}
+	}
+	{
+		{
+			s.Oem = []byte("null")
+		}
+	}
+	{
+		{
+			s.RelatedProperties.SetFake()
+		}
+	}
+	{
+		{
+			s.Resolution.SetFake()
+		}
+	}
+	{
+		{
+			s.ResolutionSteps = nil
+			for i := 0; i < 0; i++ {
+				var elem RedfishMetricReportReportsItemMessageDotExtendedInfoItemResolutionStepsItem
+				{
+					elem.SetFake()
+				}
+				s.ResolutionSteps = append(s.ResolutionSteps, elem)
+			}
+		}
+	}
+	{
+		{
+			s.Severity.SetFake()
+		}
+	}
+	{
+		{
+			s.UserAuthenticationSource.SetFake()
+		}
+	}
+	{
+		{
+			s.Username.SetFake()
+		}
+	}
+}
+
+// SetFake set fake values.
+func (s *RedfishMetricReportReportsItemMessageDotExtendedInfoItemResolutionStepsItem) SetFake() {
+	{
+		{
+			s.ActionParameters = nil
+			for i := 0; i < 0; i++ {
+				var elem RedfishMetricReportReportsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem
+				{
+					elem.SetFake()
+				}
+				s.ActionParameters = append(s.ActionParameters, elem)
+			}
+		}
+	}
+	{
+		{
+			s.ActionURI.SetFake()
+		}
+	}
+	{
+		{
+			s.Oem = []byte("null")
+		}
+	}
+	{
+		{
+			s.Priority.SetFake()
+		}
+	}
+	{
+		{
+			s.ResolutionType.SetFake()
+		}
+	}
+	{
+		{
+			s.RetryCount.SetFake()
+		}
+	}
+	{
+		{
+			s.RetryIntervalSeconds.SetFake()
+		}
+	}
+	{
+		{
+			s.TargetComponentURI.SetFake()
+		}
+	}
+}
+
+// SetFake set fake values.
+func (s *RedfishMetricReportReportsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) SetFake() {
+	{
+		{
+			s.AllowableNumbers = nil
+			for i := 0; i < 0; i++ {
+				var elem string
+				{
+					elem = "string"
+				}
+				s.AllowableNumbers = append(s.AllowableNumbers, elem)
+			}
+		}
+	}
+	{
+		{
+			s.AllowablePattern.SetFake()
+		}
+	}
+	{
+		{
+			s.AllowableValueDescriptions = nil
+			for i := 0; i < 0; i++ {
+				var elem string
+				{
+					elem = "string"
+				}
+				s.AllowableValueDescriptions = append(s.AllowableValueDescriptions, elem)
+			}
+		}
+	}
+	{
+		{
+			s.AllowableValues = nil
+			for i := 0; i < 0; i++ {
+				var elem string
+				{
+					elem = "string"
+				}
+				s.AllowableValues = append(s.AllowableValues, elem)
+			}
+		}
+	}
+	{
+		{
+			s.ArraySizeMaximum.SetFake()
+		}
+	}
+	{
+		{
+			s.ArraySizeMinimum.SetFake()
+		}
+	}
+	{
+		{
+			s.DataType.SetFake()
+		}
+	}
+	{
+		{
+			s.DefaultValue.SetFake()
+		}
+	}
+	{
+		{
+			s.MaximumValue.SetFake()
+		}
+	}
+	{
+		{
+			s.MinimumValue.SetFake()
+		}
+	}
+	{
+		{
+			s.Name.SetFake()
+		}
+	}
+	{
+		{
+			s.NoDefaultValue.SetFake()
+		}
+	}
+	{
+		{
+			s.ObjectDataType.SetFake()
+		}
+	}
+	{
+		{
+			s.Required.SetFake()
+		}
+	}
+}
+
+// SetFake set fake values.
+func (s *RedfishMetricReportReportsItemMetricValuesItem) SetFake() {
+	{
+		{
+			s.MetricId.SetFake()
+		}
+	}
+	{
+		{
+			s.MetricProperty.SetFake()
+		}
+	}
+	{
+		{
+			s.MetricValue.SetFake()
+		}
+	}
+	{
+		{
+			s.Oem = []byte("null")
+		}
+	}
+	{
+		{
+			s.Timestamp.SetFake()
+		}
+	}
+}
+
+// SetFake set fake values.
+func (s *RedfishSystem) SetFake() {
+	{
+		{
+			s.BiosVersion.SetFake()
+		}
+	}
+	{
+		{
+			s.BmcFirmware.SetFake()
+		}
+	}
+	{
+		{
+			s.BootNext.SetFake()
+		}
+	}
+	{
+		{
+			s.BootOrder.SetFake()
+		}
+	}
+	{
+		{
+			s.Health.SetFake()
+		}
+	}
 	{
-		elem.SetFake()
+		{
+			s.HostName.SetFake()
+		}
 	}
-	s.SetTo(elem)
-}
-
-// SetFake set fake values.
-func (s *OptFloat32) SetFake() {
-	var elem float32
 	{
-		elem = float32(0)
+		{
+			s.Manufacturer.SetFake()
+		}
 	}
-	s.SetTo(elem)
-}
-
-// SetFake set fake values.
-func (s *OptHTTPErrorErrorsItemMore) SetFake() {
-	var elem HTTPErrorErrorsItemMore
 	{
-		elem.SetFake()
+		{
+			s.Model.SetFake()
+		}
 	}
-	s.SetTo(elem)
-}
-
-// SetFake set fake values.
-func (s *OptInt) SetFake() {
-	var elem int
 	{
-		elem = int(0)
+		{
+			s.Name.SetFake()
+		}
 	}
-	s.SetTo(elem)
-}
-
-// SetFake set fake values.
-func (s *OptInt64) SetFake() {
-	var elem int64
 	{
-		elem = int64(0)
+		{
+			s.NicFirmware.SetFake()
+		}
 	}
-	s.SetTo(elem)
-}
-
-// SetFake set fake values.
-func (s *OptJobMessageRedfishError) SetFake() {
-	var elem JobMessageRedfishError
 	{
-		elem.SetFake()
+		{
+			s.OemDell.SetFake()
+		}
 	}
-	s.SetTo(elem)
-}
-
-// SetFake set fake values.
-func (s *OptJobMessageRedfishErrorError) SetFake() {
-	var elem JobMessageRedfishErrorError
 	{
-		elem.SetFake()
+		{
+			s.PowerStatus.SetFake()
+		}
 	}
-	s.SetTo(elem)
-}
-
-// SetFake set fake values.
-func (s *OptNilBootImageAddRequestBootImagesItemProvisionTemplates) SetFake() {
-	s.Null = true
-	s.Set = true
-}
-
-// SetFake set fake values.
-func (s *OptNilBootImageProvisionTemplates) SetFake() {
-	s.Null = true
-	s.Set = true
-}
-
-// SetFake set fake values.
-func (s *OptNilDataDumpImagesItemProvisionTemplates) SetFake() {
-	s.Null = true
-	s.Set = true
-}
-
-// SetFake set fake values.
-func (s *OptNilFloat64) SetFake() {
-	s.Null = true
-	s.Set = true
-}
-
-// SetFake set fake values.
-func (s *OptNilHTTPErrorErrorsItemArray) SetFake() {
-	s.Null = true
-	s.Set = true
-}
-
-// SetFake set fake values.
-func (s *OptNilInt) SetFake() {
-	s.Null = true
-	s.Set = true
-}
-
-// SetFake set fake values.
-func (s *OptNilInt64) SetFake() {
-	s.Null = true
-	s.Set = true
-}
-
-// SetFake set fake values.
-func (s *OptNilJobMessageRedfishErrorErrorMessageDotExtendedInfoItemArray) SetFake() {
-	s.Null = true
-	s.Set = true
-}
-
-// SetFake set fake values.
-func (s *OptNilNilIntArray) SetFake() {
-	s.Null = true
-	s.Set = true
-}
-
-// SetFake set fake values.
-func (s *OptNilNilRedfishJobJobsItemArray) SetFake() {
-	s.Null = true
-	s.Set = true
-}
-
-// SetFake set fake values.
-func (s *OptNilNilStringArray) SetFake() {
-	s.Null = true
-	s.Set = true
-}
-
-// SetFake set fake values.
-func (s *OptNilRedfishSystemOemDell) SetFake() {
-	s.Null = true
-	s.Set = true
-}
-
-// SetFake set fake values.
-func (s *OptNilRedfishSystemOemDellMessageDotExtendedInfoItemArray) SetFake() {
-	s.Null = true
-	s.Set = true
-}
-
-// SetFake set fake values.
-func (s *OptNilString) SetFake() {
-	s.Null = true
-	s.Set = true
-}
-
-// SetFake set fake values.
-func (s *OptNilStringArray) SetFake() {
-	s.Null = true
-	s.Set = true
-}
-
-// SetFake set fake values.
-func (s *OptRedfishJobJobsItemParameters) SetFake() {
-	var elem RedfishJobJobsItemParameters
 	{
-		elem.SetFake()
+		{
+			s.ProcessorCount.SetFake()
+		}
 	}
-	s.SetTo(elem)
-}
-
-// SetFake set fake values.
-func (s *OptRedfishJobJobsItemPayload) SetFake() {
-	var elem RedfishJobJobsItemPayload
 	{
-		elem.SetFake()
+		{
+			s.SerialNumber.SetFake()
+		}
 	}
-	s.SetTo(elem)
-}
-
-// SetFake set fake values.
-func (s *OptRedfishJobJobsItemSchedule) SetFake() {
-	var elem RedfishJobJobsItemSchedule
 	{
-		elem.SetFake()
+		{
+			s.TotalMemory.SetFake()
+		}
 	}
-	s.SetTo(elem)
 }
 
 // SetFake set fake values.
-func (s *OptString) SetFake() {
-	var elem string
-	{
-		elem = "string"
+func (s *RedfishSystemNicFirmware) SetFake() {
+	var (
+		elem NilString
+		m    map[string]NilString = s.init()
+	)
+	for i := 0; i < 0; i++ {
+		m[fmt.Sprintf("fake%d", i)] = elem
 	}
-	s.SetTo(elem)
 }
 
 // SetFake set fake values.
-func (s *PatchRolesRequest) SetFake() {
+func (s *RedfishSystemOemDell) SetFake() {
 	{
 		{
-			s.PermissionList = nil
-			for i := 0; i < 0; i++ {
-				var elem PatchRolesRequestPermissionListItem
-				{
-					elem.SetFake()
-				}
-				s.PermissionList = append(s.PermissionList, elem)
-			}
+			s.MessageDotExtendedInfo.SetFake()
 		}
 	}
 	{
 		{
-			s.Role.SetFake()
+			s.OdataDotContext.SetFake()
 		}
 	}
-}
-
-// SetFake set fake values.
-func (s *PatchRolesRequestPermissionListItem) SetFake() {
 	{
 		{
-			s.Method.SetFake()
+			s.OdataDotEtag.SetFake()
 		}
 	}
 	{
 		{
-			s.Path.SetFake()
+			s.OdataDotID.SetFake()
 		}
 	}
-}
-
-// SetFake set fake values.
-func (s *PostRolesRequest) SetFake() {
 	{
 		{
-			s.InheritedRole.SetFake()
+			s.OdataDotType.SetFake()
 		}
 	}
 	{
 		{
-			s.Role.SetFake()
+			s.BIOSReleaseDate.SetFake()
 		}
 	}
-}
-
-// SetFake set fake values.
-func (s *RedfishDellUpgradeFirmware) SetFake() {
 	{
 		{
-			s.Message.SetFake()
+			s.BaseBoardChassisSlot.SetFake()
 		}
 	}
 	{
 		{
-			s.Name.SetFake()
+			s.BatteryRollupStatus.SetFake()
 		}
 	}
 	{
 		{
-			s.Status.SetFake()
+			s.BladeGeometry.SetFake()
 		}
 	}
 	{
 		{
-			s.UpdateCount.SetFake()
+			s.CMCIP.SetFake()
 		}
 	}
 	{
 		{
-			s.UpdateList = nil
-			for i := 0; i < 0; i++ {
-				var elem RedfishDellUpgradeFirmwareUpdateListItem
-				{
-					elem.SetFake()
-				}
-				s.UpdateList = append(s.UpdateList, elem)
-			}
+			s.CPURollupStatus.SetFake()
 		}
 	}
 	{
 		{
-			s.UpdateRebootType.SetFake()
+			s.ChassisModel.SetFake()
 		}
 	}
-}
-
-// SetFake set fake values.
-func (s *RedfishDellUpgradeFirmwareUpdateListItem) SetFake() {
 	{
 		{
-			s.BaseLocation.SetFake()
+			s.ChassisName.SetFake()
 		}
 	}
 	{
 		{
-			s.ComponentID.SetFake()
+			s.ChassisServiceTag.SetFake()
 		}
 	}
 	{
 		{
-			s.ComponentInfoName.SetFake()
+			s.ChassisSystemHeightUnit.SetFake()
 		}
 	}
 	{
 		{
-			s.ComponentInfoValue.SetFake()
+			s.CurrentRollupStatus.SetFake()
 		}
 	}
 	{
 		{
-			s.ComponentType.SetFake()
+			s.Description.SetFake()
 		}
 	}
 	{
 		{
-			s.Criticality.SetFake()
+			s.EstimatedExhaustTemperatureCelsius.SetFake()
 		}
 	}
 	{
 		{
-			s.DisplayName.SetFake()
+			s.EstimatedSystemAirflowCFM.SetFake()
 		}
 	}
 	{
 		{
-			s.InstalledVersion.SetFake()
+			s.ExpressServiceCode.SetFake()
 		}
 	}
 	{
 		{
-			s.JobID.SetFake()
+			s.FanRollupStatus.SetFake()
 		}
 	}
 	{
 		{
-			s.Name.SetFake()
+			s.IDSDMRollupStatus.SetFake()
 		}
 	}
 	{
 		{
-			s.PackageName.SetFake()
+			s.ID.SetFake()
 		}
 	}
 	{
 		{
-			s.PackagePath.SetFake()
+			s.IntrusionRollupStatus.SetFake()
 		}
 	}
 	{
 		{
-			s.PackageVersion.SetFake()
+			s.IsOEMBranded.SetFake()
 		}
 	}
 	{
 		{
-			s.RebootType.SetFake()
+			s.LastSystemInventoryTime.SetFake()
 		}
 	}
 	{
 		{
-			s.Target.SetFake()
+			s.LastUpdateTime.SetFake()
 		}
 	}
-}
-
-// SetFake set fake values.
-func (s *RedfishJob) SetFake() {
 	{
 		{
-			s.Jobs.SetFake()
+			s.LicensingRollupStatus.SetFake()
 		}
 	}
 	{
 		{
-			s.Name.SetFake()
+			s.ManagedSystemSize.SetFake()
 		}
 	}
-}
-
-// SetFake set fake values.
-func (s *RedfishJobJobsItem) SetFake() {
 	{
 		{
-			s.MessageDotExtendedInfo = nil
-			for i := 0; i < 0; i++ {
-				var elem RedfishJobJobsItemMessageDotExtendedInfoItem
-				{
-					elem.SetFake()
-				}
-				s.MessageDotExtendedInfo = append(s.MessageDotExtendedInfo, elem)
-			}
+			s.MaxCPUSockets.SetFake()
 		}
 	}
 	{
 		{
-			s.OdataDotContext.SetFake()
+			s.MaxDIMMSlots.SetFake()
 		}
 	}
 	{
 		{
-			s.OdataDotEtag.SetFake()
+			s.MaxPCIeSlots.SetFake()
 		}
 	}
 	{
 		{
-			s.OdataDotID.SetFake()
+			s.MemoryOperationMode.SetFake()
 		}
 	}
 	{
 		{
-			s.OdataDotType.SetFake()
+			s.Name.SetFake()
 		}
 	}
 	{
 		{
-			s.CreatedBy.SetFake()
+			s.NodeID.SetFake()
 		}
 	}
 	{
 		{
-			s.CreationTime.SetFake()
+			s.Oem = []byte("null")
 		}
 	}
 	{
 		{
-			s.Description.SetFake()
+			s.PSRollupStatus.SetFake()
 		}
 	}
 	{
 		{
-			s.EndTime.SetFake()
+			s.PlatformGUID.SetFake()
 		}
 	}
 	{
 		{
-			s.EstimatedCompletionTime.SetFake()
+			s.PopulatedDIMMSlots.SetFake()
 		}
 	}
 	{
 		{
-			s.EstimatedDuration.SetFake()
+			s.PopulatedPCIeSlots.SetFake()
 		}
 	}
 	{
 		{
-			s.HidePayload.SetFake()
+			s.PowerCapEnabledState.SetFake()
 		}
 	}
 	{
 		{
-			s.ID.SetFake()
+			s.SDCardRollupStatus.SetFake()
 		}
 	}
 	{
 		{
-			s.JobPriority.SetFake()
+			s.SELRollupStatus.SetFake()
 		}
 	}
 	{
 		{
-			s.JobState.SetFake()
+			s.ServerAllocationWatts.SetFake()
 		}
 	}
 	{
 		{
-			s.JobStatus.SetFake()
+			s.StorageRollupStatus.SetFake()
 		}
 	}
 	{
 		{
-			s.JobType.SetFake()
+			s.SysMemErrorMethodology.SetFake()
 		}
 	}
 	{
 		{
-			s.MaxExecutionTime.SetFake()
+			s.SysMemFailOverState.SetFake()
 		}
 	}
 	{
 		{
-			s.Messages = nil
-			for i := 0; i < 0; i++ {
-				var elem RedfishJobJobsItemMessagesItem
-				{
-					elem.SetFake()
-				}
-				s.Messages = append(s.Messages, elem)
-			}
+			s.SysMemLocation.SetFake()
 		}
 	}
 	{
 		{
-			s.Name.SetFake()
+			s.SysMemPrimaryStatus.SetFake()
 		}
 	}
 	{
 		{
-			s.Oem = []byte("null")
+			s.SystemGeneration.SetFake()
 		}
 	}
 	{
 		{
-			s.Parameters.SetFake()
+			s.SystemID.SetFake()
 		}
 	}
 	{
 		{
-			s.Payload.SetFake()
+			s.SystemRevision.SetFake()
 		}
 	}
 	{
 		{
-			s.PercentComplete.SetFake()
+			s.TempRollupStatus.SetFake()
 		}
 	}
 	{
 		{
-			s.RawData = []byte("[]byte")
+			s.TempStatisticsRollupStatus.SetFake()
 		}
 	}
 	{
 		{
-			s.Schedule.SetFake()
+			s.UUID.SetFake()
 		}
 	}
 	{
 		{
-			s.StartTime.SetFake()
+			s.VoltRollupStatus.SetFake()
 		}
 	}
 	{
 		{
-			s.StepOrder.SetFake()
+			s.SmbiosGUID.SetFake()
 		}
 	}
 }
 
 // SetFake set fake values.
-func (s *RedfishJobJobsItemMessageDotExtendedInfoItem) SetFake() {
+func (s *RedfishSystemOemDellMessageDotExtendedInfoItem) SetFake() {
 	{
 		{
 			s.Message.SetFake()
@@ -2181,7 +7311,7 @@ func (s *RedfishJobJobsItemMessageDotExtendedInfoItem) SetFake() {
 		{
 			s.ResolutionSteps = nil
 			for i := 0; i < 0; i++ {
-				var elem RedfishJobJobsItemMessageDotExtendedInfoItemResolutionStepsItem
+				var elem RedfishSystemOemDellMessageDotExtendedInfoItemResolutionStepsItem
 				{
 					elem.SetFake()
 				}
@@ -2207,12 +7337,12 @@ func (s *RedfishJobJobsItemMessageDotExtendedInfoItem) SetFake() {
 }
 
 // SetFake set fake values.
-func (s *RedfishJobJobsItemMessageDotExtendedInfoItemResolutionStepsItem) SetFake() {
+func (s *RedfishSystemOemDellMessageDotExtendedInfoItemResolutionStepsItem) SetFake() {
 	{
 		{
 			s.ActionParameters = nil
 			for i := 0; i < 0; i++ {
-				var elem RedfishJobJobsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem
+				var elem RedfishSystemOemDellMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem
 				{
 					elem.SetFake()
 				}
@@ -2258,7 +7388,7 @@ func (s *RedfishJobJobsItemMessageDotExtendedInfoItemResolutionStepsItem) SetFak
 }
 
 // SetFake set fake values.
-func (s *RedfishJobJobsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) SetFake() {
+func (s *RedfishSystemOemDellMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) SetFake() {
 	{
 		{
 			s.AllowableNumbers = nil
@@ -2353,1234 +7483,1290 @@ func (s *RedfishJobJobsItemMessageDotExtendedInfoItemResolutionStepsItemActionPa
 }
 
 // SetFake set fake values.
-func (s *RedfishJobJobsItemMessagesItem) SetFake() {
+func (s *ReloadResponse) SetFake() {
 	{
 		{
-			s.Message.SetFake()
+			s.Changed = nil
+			for i := 0; i < 0; i++ {
+				var elem string
+				{
+					elem = "string"
+				}
+				s.Changed = append(s.Changed, elem)
+			}
 		}
 	}
 	{
 		{
-			s.MessageArgs = nil
+			s.Detail.SetFake()
+		}
+	}
+	{
+		{
+			s.RestartRequired = nil
 			for i := 0; i < 0; i++ {
 				var elem string
 				{
 					elem = "string"
 				}
-				s.MessageArgs = append(s.MessageArgs, elem)
+				s.RestartRequired = append(s.RestartRequired, elem)
 			}
 		}
 	}
 	{
 		{
-			s.MessageId.SetFake()
+			s.Title.SetFake()
 		}
 	}
+}
+
+// SetFake set fake values.
+func (s *RevokedCert) SetFake() {
 	{
 		{
-			s.MessageSeverity.SetFake()
+			s.Host.SetFake()
 		}
 	}
 	{
 		{
-			s.Oem = []byte("null")
+			s.RevokedAt.SetFake()
 		}
 	}
 	{
 		{
-			s.RelatedProperties.SetFake()
+			s.Serial.SetFake()
 		}
 	}
+}
+
+// SetFake set fake values.
+func (s *ScheduleRequest) SetFake() {
 	{
 		{
-			s.Resolution.SetFake()
+			s.Action = "string"
 		}
 	}
 	{
 		{
-			s.ResolutionSteps = nil
-			for i := 0; i < 0; i++ {
-				var elem RedfishJobJobsItemMessagesItemResolutionStepsItem
-				{
-					elem.SetFake()
-				}
-				s.ResolutionSteps = append(s.ResolutionSteps, elem)
-			}
+			s.Arg.SetFake()
 		}
 	}
 	{
 		{
-			s.Severity.SetFake()
+			s.Nodeset.SetFake()
 		}
 	}
 	{
 		{
-			s.UserAuthenticationSource.SetFake()
+			s.RunAt = time.Now()
 		}
 	}
 	{
 		{
-			s.Username.SetFake()
+			s.Tags.SetFake()
 		}
 	}
 }
 
 // SetFake set fake values.
-func (s *RedfishJobJobsItemMessagesItemResolutionStepsItem) SetFake() {
+func (s *ScheduledAction) SetFake() {
 	{
 		{
-			s.ActionParameters = nil
-			for i := 0; i < 0; i++ {
-				var elem RedfishJobJobsItemMessagesItemResolutionStepsItemActionParametersItem
-				{
-					elem.SetFake()
-				}
-				s.ActionParameters = append(s.ActionParameters, elem)
-			}
+			s.Action = "string"
 		}
 	}
 	{
 		{
-			s.ActionURI.SetFake()
+			s.Arg.SetFake()
 		}
 	}
 	{
 		{
-			s.Oem = []byte("null")
+			s.CreatedAt.SetFake()
 		}
 	}
 	{
 		{
-			s.Priority.SetFake()
+			s.CreatedBy.SetFake()
 		}
 	}
 	{
 		{
-			s.ResolutionType.SetFake()
+			s.Error.SetFake()
 		}
 	}
 	{
 		{
-			s.RetryCount.SetFake()
+			s.FinishedAt.SetFake()
 		}
 	}
 	{
 		{
-			s.RetryIntervalSeconds.SetFake()
+			s.ID.SetFake()
 		}
 	}
 	{
 		{
-			s.TargetComponentURI.SetFake()
+			s.Nodeset = "string"
 		}
 	}
-}
-
-// SetFake set fake values.
-func (s *RedfishJobJobsItemMessagesItemResolutionStepsItemActionParametersItem) SetFake() {
 	{
 		{
-			s.AllowableNumbers = nil
-			for i := 0; i < 0; i++ {
-				var elem string
-				{
-					elem = "string"
-				}
-				s.AllowableNumbers = append(s.AllowableNumbers, elem)
-			}
+			s.Results.SetFake()
 		}
 	}
 	{
 		{
-			s.AllowablePattern.SetFake()
+			s.RunAt = time.Now()
 		}
 	}
 	{
 		{
-			s.AllowableValueDescriptions = nil
-			for i := 0; i < 0; i++ {
-				var elem string
-				{
-					elem = "string"
-				}
-				s.AllowableValueDescriptions = append(s.AllowableValueDescriptions, elem)
-			}
+			s.StartedAt.SetFake()
 		}
 	}
 	{
 		{
-			s.AllowableValues = nil
-			for i := 0; i < 0; i++ {
-				var elem string
-				{
-					elem = "string"
-				}
-				s.AllowableValues = append(s.AllowableValues, elem)
-			}
+			s.Status.SetFake()
 		}
 	}
+}
+
+// SetFake set fake values.
+func (s *ScheduledActionResultsItem) SetFake() {
 	{
 		{
-			s.ArraySizeMaximum.SetFake()
+			s.Data.SetFake()
 		}
 	}
 	{
 		{
-			s.ArraySizeMinimum.SetFake()
+			s.Host.SetFake()
 		}
 	}
 	{
 		{
-			s.DataType.SetFake()
+			s.Msg.SetFake()
 		}
 	}
 	{
 		{
-			s.DefaultValue.SetFake()
+			s.RedfishError.SetFake()
 		}
 	}
 	{
 		{
-			s.MaximumValue.SetFake()
+			s.Status.SetFake()
 		}
 	}
+}
+
+// SetFake set fake values.
+func (s *ScheduledActionResultsItemRedfishError) SetFake() {
 	{
 		{
-			s.MinimumValue.SetFake()
+			s.Code.SetFake()
 		}
 	}
 	{
 		{
-			s.Name.SetFake()
+			s.Error.SetFake()
 		}
 	}
+}
+
+// SetFake set fake values.
+func (s *ScheduledActionResultsItemRedfishErrorError) SetFake() {
 	{
 		{
-			s.NoDefaultValue.SetFake()
+			s.MessageDotExtendedInfo = nil
+			for i := 0; i < 0; i++ {
+				var elem ScheduledActionResultsItemRedfishErrorErrorMessageDotExtendedInfoItem
+				{
+					elem.SetFake()
+				}
+				s.MessageDotExtendedInfo = append(s.MessageDotExtendedInfo, elem)
+			}
 		}
 	}
 	{
 		{
-			s.ObjectDataType.SetFake()
+			s.Code.SetFake()
+		}
+	}
+	{
+		{
+			s.Message.SetFake()
+		}
+	}
+}
+
+// SetFake set fake values.
+func (s *ScheduledActionResultsItemRedfishErrorErrorMessageDotExtendedInfoItem) SetFake() {
+	{
+		{
+			s.Message.SetFake()
 		}
 	}
 	{
 		{
-			s.Required.SetFake()
+			s.MessageArgsDotOdataDotCount.SetFake()
 		}
 	}
-}
-
-// SetFake set fake values.
-func (s *RedfishJobJobsItemParameters) SetFake() {
-	var (
-		elem jx.Raw
-		m    map[string]jx.Raw = s.init()
-	)
-	for i := 0; i < 0; i++ {
-		m[fmt.Sprintf("fake%d", i)] = elem
-	}
-}
-
-// SetFake set fake values.
-func (s *RedfishJobJobsItemPayload) SetFake() {
 	{
 		{
-			s.HttpHeaders.SetFake()
+			s.MessageId.SetFake()
 		}
 	}
 	{
 		{
-			s.HttpOperation.SetFake()
+			s.RelatedPropertiesDotOdataDotCount.SetFake()
 		}
 	}
 	{
 		{
-			s.JsonBody.SetFake()
+			s.Resolution.SetFake()
 		}
 	}
 	{
 		{
-			s.TargetUri.SetFake()
+			s.Severity.SetFake()
 		}
 	}
 }
 
 // SetFake set fake values.
-func (s *RedfishJobJobsItemSchedule) SetFake() {
+func (s *Secret) SetFake() {
 	{
 		{
-			s.EnabledDaysOfMonth.SetFake()
+			s.Name = "string"
 		}
 	}
 	{
 		{
-			s.EnabledDaysOfWeek.SetFake()
+			s.OneTime.SetFake()
 		}
 	}
 	{
 		{
-			s.EnabledIntervals.SetFake()
+			s.UpdatedAt.SetFake()
+		}
+	}
+}
+
+// SetFake set fake values.
+func (s *SecretRequest) SetFake() {
+	{
+		{
+			s.OneTime.SetFake()
 		}
 	}
 	{
 		{
-			s.EnabledMonthsOfYear.SetFake()
+			s.Value = "string"
 		}
 	}
+}
+
+// SetFake set fake values.
+func (s *SigningKey) SetFake() {
 	{
 		{
-			s.InitialStartTime.SetFake()
+			s.CreatedAt.SetFake()
 		}
 	}
 	{
 		{
-			s.Lifetime.SetFake()
+			s.ID.SetFake()
 		}
 	}
 	{
 		{
-			s.MaxOccurrences.SetFake()
+			s.Kind.SetFake()
 		}
 	}
 	{
 		{
-			s.Name.SetFake()
+			s.Primary.SetFake()
 		}
 	}
 	{
 		{
-			s.RecurrenceInterval.SetFake()
+			s.RetireAt.SetFake()
 		}
 	}
 }
 
 // SetFake set fake values.
-func (s *RedfishMetricReport) SetFake() {
+func (s *SigningKeyRotateRequest) SetFake() {
 	{
 		{
-			s.Name.SetFake()
+			s.Grace.SetFake()
 		}
 	}
 	{
 		{
-			s.Reports = nil
-			for i := 0; i < 0; i++ {
-				var elem NilRedfishMetricReportReportsItem
-				{
-					elem.SetFake()
-				}
-				s.Reports = append(s.Reports, elem)
-			}
+			s.Kind.SetFake()
 		}
 	}
 }
 
 // SetFake set fake values.
-func (s *RedfishMetricReportReportsItem) SetFake() {
+func (s *Stats) SetFake() {
 	{
 		{
-			s.MessageDotExtendedInfo = nil
-			for i := 0; i < 0; i++ {
-				var elem RedfishMetricReportReportsItemMessageDotExtendedInfoItem
-				{
-					elem.SetFake()
-				}
-				s.MessageDotExtendedInfo = append(s.MessageDotExtendedInfo, elem)
-			}
+			s.DhcpAcksLastHour.SetFake()
 		}
 	}
 	{
 		{
-			s.OdataDotContext.SetFake()
+			s.DNSQueriesPerSecond.SetFake()
 		}
 	}
 	{
 		{
-			s.OdataDotEtag.SetFake()
+			s.Generated.SetFake()
 		}
 	}
 	{
 		{
-			s.OdataDotID.SetFake()
+			s.Hosts.SetFake()
 		}
 	}
 	{
 		{
-			s.OdataDotType.SetFake()
+			s.ProvisionCompletionsToday.SetFake()
 		}
 	}
 	{
 		{
-			s.Context.SetFake()
+			s.Since.SetFake()
 		}
 	}
+}
+
+// SetFake set fake values.
+func (s *StatsHosts) SetFake() {
 	{
 		{
-			s.Description.SetFake()
+			s.BootImages = nil
+			for i := 0; i < 0; i++ {
+				var elem StatsHostsBootImagesItem
+				{
+					elem.SetFake()
+				}
+				s.BootImages = append(s.BootImages, elem)
+			}
 		}
 	}
 	{
 		{
-			s.ID.SetFake()
+			s.Provisioning.SetFake()
 		}
 	}
 	{
 		{
-			s.MetricValues = nil
+			s.Tags = nil
 			for i := 0; i < 0; i++ {
-				var elem RedfishMetricReportReportsItemMetricValuesItem
+				var elem StatsHostsTagsItem
 				{
 					elem.SetFake()
 				}
-				s.MetricValues = append(s.MetricValues, elem)
+				s.Tags = append(s.Tags, elem)
 			}
 		}
 	}
 	{
 		{
-			s.Name.SetFake()
-		}
-	}
-	{
-		{
-			s.Oem = []byte("null")
+			s.Total.SetFake()
 		}
 	}
 	{
 		{
-			s.ReportSequence.SetFake()
+			s.Unprovisioned.SetFake()
 		}
 	}
 	{
 		{
-			s.Timestamp.SetFake()
+			s.WithoutBootImage.SetFake()
 		}
 	}
 }
 
 // SetFake set fake values.
-func (s *RedfishMetricReportReportsItemMessageDotExtendedInfoItem) SetFake() {
-	{
-		{
-			s.Message.SetFake()
-		}
-	}
+func (s *StatsHostsBootImagesItem) SetFake() {
 	{
 		{
-			s.MessageArgs = nil
-			for i := 0; i < 0; i++ {
-				var elem string
-				{
-					elem = "string"
-				}
-				s.MessageArgs = append(s.MessageArgs, elem)
-			}
+			s.Hosts.SetFake()
 		}
 	}
 	{
 		{
-			s.MessageId.SetFake()
+			s.Name.SetFake()
 		}
 	}
+}
+
+// SetFake set fake values.
+func (s *StatsHostsTagsItem) SetFake() {
 	{
 		{
-			s.MessageSeverity.SetFake()
+			s.Hosts.SetFake()
 		}
 	}
 	{
 		{
-			s.Oem = []byte("null")
+			s.Name.SetFake()
 		}
 	}
+}
+
+// SetFake set fake values.
+func (s *SwitchScanResponse) SetFake() {
 	{
 		{
-			s.RelatedProperties.SetFake()
+			s.Changed.SetFake()
 		}
 	}
 	{
 		{
-			s.Resolution.SetFake()
+			s.Matched = nil
+			for i := 0; i < 0; i++ {
+				var elem SwitchScanResponseMatchedItem
+				{
+					elem.SetFake()
+				}
+				s.Matched = append(s.Matched, elem)
+			}
 		}
 	}
 	{
 		{
-			s.ResolutionSteps = nil
+			s.Unmatched = nil
 			for i := 0; i < 0; i++ {
-				var elem RedfishMetricReportReportsItemMessageDotExtendedInfoItemResolutionStepsItem
+				var elem SwitchScanResponseUnmatchedItem
 				{
 					elem.SetFake()
 				}
-				s.ResolutionSteps = append(s.ResolutionSteps, elem)
+				s.Unmatched = append(s.Unmatched, elem)
 			}
 		}
 	}
+}
+
+// SetFake set fake values.
+func (s *SwitchScanResponseMatchedItem) SetFake() {
 	{
 		{
-			s.Severity.SetFake()
+			s.Host.SetFake()
 		}
 	}
 	{
 		{
-			s.UserAuthenticationSource.SetFake()
+			s.Interface.SetFake()
 		}
 	}
 	{
 		{
-			s.Username.SetFake()
+			s.MAC.SetFake()
 		}
 	}
-}
-
-// SetFake set fake values.
-func (s *RedfishMetricReportReportsItemMessageDotExtendedInfoItemResolutionStepsItem) SetFake() {
 	{
 		{
-			s.ActionParameters = nil
-			for i := 0; i < 0; i++ {
-				var elem RedfishMetricReportReportsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem
-				{
-					elem.SetFake()
-				}
-				s.ActionParameters = append(s.ActionParameters, elem)
-			}
+			s.Port.SetFake()
 		}
 	}
 	{
 		{
-			s.ActionURI.SetFake()
+			s.Switch.SetFake()
 		}
 	}
 	{
 		{
-			s.Oem = []byte("null")
+			s.Vlan.SetFake()
 		}
 	}
+}
+
+// SetFake set fake values.
+func (s *SwitchScanResponseUnmatchedItem) SetFake() {
 	{
 		{
-			s.Priority.SetFake()
+			s.Host.SetFake()
 		}
 	}
 	{
 		{
-			s.ResolutionType.SetFake()
+			s.Interface.SetFake()
 		}
 	}
 	{
 		{
-			s.RetryCount.SetFake()
+			s.MAC.SetFake()
 		}
 	}
 	{
 		{
-			s.RetryIntervalSeconds.SetFake()
+			s.Port.SetFake()
 		}
 	}
 	{
 		{
-			s.TargetComponentURI.SetFake()
+			s.Switch.SetFake()
+		}
+	}
+	{
+		{
+			s.Vlan.SetFake()
 		}
 	}
 }
 
 // SetFake set fake values.
-func (s *RedfishMetricReportReportsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) SetFake() {
+func (s *SwitchVerifyResponse) SetFake() {
 	{
 		{
-			s.AllowableNumbers = nil
-			for i := 0; i < 0; i++ {
-				var elem string
-				{
-					elem = "string"
-				}
-				s.AllowableNumbers = append(s.AllowableNumbers, elem)
-			}
+			s.Changed.SetFake()
 		}
 	}
 	{
 		{
-			s.AllowablePattern.SetFake()
+			s.Mismatched = nil
+			for i := 0; i < 0; i++ {
+				var elem SwitchVerifyResponseMismatchedItem
+				{
+					elem.SetFake()
+				}
+				s.Mismatched = append(s.Mismatched, elem)
+			}
 		}
 	}
 	{
 		{
-			s.AllowableValueDescriptions = nil
+			s.Missing = nil
 			for i := 0; i < 0; i++ {
-				var elem string
+				var elem SwitchVerifyResponseMissingItem
 				{
-					elem = "string"
+					elem.SetFake()
 				}
-				s.AllowableValueDescriptions = append(s.AllowableValueDescriptions, elem)
+				s.Missing = append(s.Missing, elem)
 			}
 		}
 	}
 	{
 		{
-			s.AllowableValues = nil
+			s.Unknown = nil
 			for i := 0; i < 0; i++ {
-				var elem string
+				var elem SwitchVerifyResponseUnknownItem
 				{
-					elem = "string"
+					elem.SetFake()
 				}
-				s.AllowableValues = append(s.AllowableValues, elem)
+				s.Unknown = append(s.Unknown, elem)
 			}
 		}
 	}
 	{
 		{
-			s.ArraySizeMaximum.SetFake()
+			s.Verified = nil
+			for i := 0; i < 0; i++ {
+				var elem SwitchVerifyResponseVerifiedItem
+				{
+					elem.SetFake()
+				}
+				s.Verified = append(s.Verified, elem)
+			}
 		}
 	}
+}
+
+// SetFake set fake values.
+func (s *SwitchVerifyResponseMismatchedItem) SetFake() {
 	{
 		{
-			s.ArraySizeMinimum.SetFake()
+			s.ExpectedPort.SetFake()
 		}
 	}
 	{
 		{
-			s.DataType.SetFake()
+			s.ExpectedSwitch.SetFake()
 		}
 	}
 	{
 		{
-			s.DefaultValue.SetFake()
+			s.Host.SetFake()
 		}
 	}
 	{
 		{
-			s.MaximumValue.SetFake()
+			s.Interface.SetFake()
 		}
 	}
 	{
 		{
-			s.MinimumValue.SetFake()
+			s.MAC.SetFake()
 		}
 	}
 	{
 		{
-			s.Name.SetFake()
+			s.Neighbor.SetFake()
 		}
 	}
 	{
 		{
-			s.NoDefaultValue.SetFake()
+			s.Port.SetFake()
 		}
 	}
 	{
 		{
-			s.ObjectDataType.SetFake()
+			s.PortName.SetFake()
 		}
 	}
 	{
 		{
-			s.Required.SetFake()
+			s.Switch.SetFake()
 		}
 	}
 }
 
 // SetFake set fake values.
-func (s *RedfishMetricReportReportsItemMetricValuesItem) SetFake() {
+func (s *SwitchVerifyResponseMissingItem) SetFake() {
 	{
 		{
-			s.MetricId.SetFake()
+			s.ExpectedPort.SetFake()
 		}
 	}
 	{
 		{
-			s.MetricProperty.SetFake()
+			s.ExpectedSwitch.SetFake()
 		}
 	}
 	{
 		{
-			s.MetricValue.SetFake()
+			s.Host.SetFake()
 		}
 	}
 	{
 		{
-			s.Oem = []byte("null")
+			s.Interface.SetFake()
 		}
 	}
 	{
 		{
-			s.Timestamp.SetFake()
+			s.MAC.SetFake()
 		}
 	}
-}
-
-// SetFake set fake values.
-func (s *RedfishSystem) SetFake() {
 	{
 		{
-			s.BiosVersion.SetFake()
+			s.Neighbor.SetFake()
 		}
 	}
 	{
 		{
-			s.BootNext.SetFake()
+			s.Port.SetFake()
 		}
 	}
 	{
 		{
-			s.BootOrder.SetFake()
+			s.PortName.SetFake()
 		}
 	}
 	{
 		{
-			s.Health.SetFake()
+			s.Switch.SetFake()
 		}
 	}
+}
+
+// SetFake set fake values.
+func (s *SwitchVerifyResponseUnknownItem) SetFake() {
 	{
 		{
-			s.HostName.SetFake()
+			s.ExpectedPort.SetFake()
 		}
 	}
 	{
 		{
-			s.Manufacturer.SetFake()
+			s.ExpectedSwitch.SetFake()
 		}
 	}
 	{
 		{
-			s.Model.SetFake()
+			s.Host.SetFake()
 		}
 	}
 	{
 		{
-			s.Name.SetFake()
+			s.Interface.SetFake()
 		}
 	}
 	{
 		{
-			s.OemDell.SetFake()
+			s.MAC.SetFake()
 		}
 	}
 	{
 		{
-			s.PowerStatus.SetFake()
+			s.Neighbor.SetFake()
 		}
 	}
 	{
 		{
-			s.ProcessorCount.SetFake()
+			s.Port.SetFake()
 		}
 	}
 	{
 		{
-			s.SerialNumber.SetFake()
+			s.PortName.SetFake()
 		}
 	}
 	{
 		{
-			s.TotalMemory.SetFake()
+			s.Switch.SetFake()
 		}
 	}
 }
 
 // SetFake set fake values.
-func (s *RedfishSystemOemDell) SetFake() {
+func (s *SwitchVerifyResponseVerifiedItem) SetFake() {
 	{
 		{
-			s.MessageDotExtendedInfo.SetFake()
+			s.ExpectedPort.SetFake()
 		}
 	}
 	{
 		{
-			s.OdataDotContext.SetFake()
+			s.ExpectedSwitch.SetFake()
 		}
 	}
 	{
 		{
-			s.OdataDotEtag.SetFake()
+			s.Host.SetFake()
 		}
 	}
 	{
 		{
-			s.OdataDotID.SetFake()
+			s.Interface.SetFake()
 		}
 	}
 	{
 		{
-			s.OdataDotType.SetFake()
+			s.MAC.SetFake()
 		}
 	}
 	{
 		{
-			s.BIOSReleaseDate.SetFake()
+			s.Neighbor.SetFake()
 		}
 	}
 	{
 		{
-			s.BaseBoardChassisSlot.SetFake()
+			s.Port.SetFake()
 		}
 	}
 	{
 		{
-			s.BatteryRollupStatus.SetFake()
+			s.PortName.SetFake()
 		}
 	}
 	{
 		{
-			s.BladeGeometry.SetFake()
+			s.Switch.SetFake()
 		}
 	}
+}
+
+// SetFake set fake values.
+func (s *Tombstone) SetFake() {
 	{
 		{
-			s.CMCIP.SetFake()
+			s.DeletedAt.SetFake()
 		}
 	}
 	{
 		{
-			s.CPURollupStatus.SetFake()
+			s.Name.SetFake()
 		}
 	}
+}
+
+// SetFake set fake values.
+func (s *TrashedHost) SetFake() {
 	{
 		{
-			s.ChassisModel.SetFake()
+			s.DeletedAt.SetFake()
 		}
 	}
 	{
 		{
-			s.ChassisName.SetFake()
+			s.Host.SetFake()
 		}
 	}
 	{
 		{
-			s.ChassisServiceTag.SetFake()
+			s.Name.SetFake()
 		}
 	}
+}
+
+// SetFake set fake values.
+func (s *TrashedHostHost) SetFake() {
 	{
 		{
-			s.ChassisSystemHeightUnit.SetFake()
+			s.Bonds = nil
+			for i := 0; i < 0; i++ {
+				var elem NilTrashedHostHostBondsItem
+				{
+					elem.SetFake()
+				}
+				s.Bonds = append(s.Bonds, elem)
+			}
 		}
 	}
 	{
 		{
-			s.CurrentRollupStatus.SetFake()
+			s.BootImage.SetFake()
 		}
 	}
 	{
 		{
-			s.Description.SetFake()
+			s.ClientCertFingerprint.SetFake()
 		}
 	}
 	{
 		{
-			s.EstimatedExhaustTemperatureCelsius.SetFake()
+			s.ClientCertSerial.SetFake()
 		}
 	}
 	{
 		{
-			s.EstimatedSystemAirflowCFM.SetFake()
+			s.CreatedAt.SetFake()
 		}
 	}
 	{
 		{
-			s.ExpressServiceCode.SetFake()
+			s.Firmware.SetFake()
 		}
 	}
 	{
 		{
-			s.FanRollupStatus.SetFake()
+			s.Hardware.SetFake()
 		}
 	}
 	{
 		{
-			s.IDSDMRollupStatus.SetFake()
+			s.ID.SetFake()
 		}
 	}
 	{
 		{
-			s.ID.SetFake()
+			s.Interfaces = nil
+			for i := 0; i < 0; i++ {
+				var elem NilTrashedHostHostInterfacesItem
+				{
+					elem.SetFake()
+				}
+				s.Interfaces = append(s.Interfaces, elem)
+			}
 		}
 	}
 	{
 		{
-			s.IntrusionRollupStatus.SetFake()
+			s.Inventory.SetFake()
 		}
 	}
 	{
 		{
-			s.IsOEMBranded.SetFake()
+			s.Name.SetFake()
 		}
 	}
 	{
 		{
-			s.LastSystemInventoryTime.SetFake()
+			s.Provision.SetFake()
 		}
 	}
 	{
 		{
-			s.LastUpdateTime.SetFake()
+			s.Revision.SetFake()
 		}
 	}
 	{
 		{
-			s.LicensingRollupStatus.SetFake()
+			s.SmbiosUUID.SetFake()
 		}
 	}
 	{
 		{
-			s.ManagedSystemSize.SetFake()
+			s.State.SetFake()
 		}
 	}
 	{
 		{
-			s.MaxCPUSockets.SetFake()
+			s.Tags.SetFake()
 		}
 	}
 	{
 		{
-			s.MaxDIMMSlots.SetFake()
+			s.UID.SetFake()
 		}
 	}
 	{
 		{
-			s.MaxPCIeSlots.SetFake()
+			s.UpdatedAt.SetFake()
 		}
 	}
+}
+
+// SetFake set fake values.
+func (s *TrashedHostHostBondsItem) SetFake() {
 	{
 		{
-			s.MemoryOperationMode.SetFake()
+			s.Addresses = nil
+			for i := 0; i < 0; i++ {
+				var elem TrashedHostHostBondsItemAddressesItem
+				{
+					elem.SetFake()
+				}
+				s.Addresses = append(s.Addresses, elem)
+			}
 		}
 	}
 	{
 		{
-			s.Name.SetFake()
+			s.Bmc.SetFake()
 		}
 	}
 	{
 		{
-			s.NodeID.SetFake()
+			s.Fqdn.SetFake()
 		}
 	}
 	{
 		{
-			s.Oem = []byte("null")
+			s.ID.SetFake()
 		}
 	}
 	{
 		{
-			s.PSRollupStatus.SetFake()
+			s.Ifname.SetFake()
 		}
 	}
 	{
 		{
-			s.PlatformGUID.SetFake()
+			s.IP.SetFake()
 		}
 	}
 	{
 		{
-			s.PopulatedDIMMSlots.SetFake()
+			s.MAC.SetFake()
 		}
 	}
 	{
 		{
-			s.PopulatedPCIeSlots.SetFake()
+			s.Mode.SetFake()
 		}
 	}
 	{
 		{
-			s.PowerCapEnabledState.SetFake()
+			s.Mtu.SetFake()
 		}
 	}
 	{
 		{
-			s.SDCardRollupStatus.SetFake()
+			s.Options.SetFake()
 		}
 	}
 	{
 		{
-			s.SELRollupStatus.SetFake()
+			s.Parent.SetFake()
 		}
 	}
 	{
 		{
-			s.ServerAllocationWatts.SetFake()
+			s.Peers = nil
+			for i := 0; i < 0; i++ {
+				var elem string
+				{
+					elem = "string"
+				}
+				s.Peers = append(s.Peers, elem)
+			}
 		}
 	}
 	{
 		{
-			s.StorageRollupStatus.SetFake()
+			s.Port.SetFake()
 		}
 	}
 	{
 		{
-			s.SysMemErrorMethodology.SetFake()
+			s.Switch.SetFake()
 		}
 	}
 	{
 		{
-			s.SysMemFailOverState.SetFake()
+			s.Type.SetFake()
 		}
 	}
 	{
 		{
-			s.SysMemLocation.SetFake()
+			s.Vlan.SetFake()
 		}
 	}
+}
+
+// SetFake set fake values.
+func (s *TrashedHostHostBondsItemAddressesItem) SetFake() {
 	{
 		{
-			s.SysMemPrimaryStatus.SetFake()
+			s.Fqdn.SetFake()
 		}
 	}
 	{
 		{
-			s.SystemGeneration.SetFake()
+			s.IP.SetFake()
 		}
 	}
 	{
 		{
-			s.SystemID.SetFake()
+			s.Primary.SetFake()
 		}
 	}
+}
+
+// SetFake set fake values.
+func (s *TrashedHostHostBondsItemOptions) SetFake() {
+	var (
+		elem string
+		m    map[string]string = s.init()
+	)
+	for i := 0; i < 0; i++ {
+		m[fmt.Sprintf("fake%d", i)] = elem
+	}
+}
+
+// SetFake set fake values.
+func (s *TrashedHostHostHardware) SetFake() {
 	{
 		{
-			s.SystemRevision.SetFake()
+			s.CollectedAt.SetFake()
 		}
 	}
 	{
 		{
-			s.TempRollupStatus.SetFake()
+			s.CoreCount.SetFake()
 		}
 	}
 	{
 		{
-			s.TempStatisticsRollupStatus.SetFake()
+			s.CPUCount.SetFake()
 		}
 	}
 	{
 		{
-			s.UUID.SetFake()
+			s.CPUModel.SetFake()
 		}
 	}
 	{
 		{
-			s.VoltRollupStatus.SetFake()
+			s.Dimms.SetFake()
 		}
 	}
 	{
 		{
-			s.SmbiosGUID.SetFake()
+			s.Disks.SetFake()
 		}
 	}
-}
-
-// SetFake set fake values.
-func (s *RedfishSystemOemDellMessageDotExtendedInfoItem) SetFake() {
 	{
 		{
-			s.Message.SetFake()
+			s.MemoryMib.SetFake()
 		}
 	}
 	{
 		{
-			s.MessageArgs = nil
-			for i := 0; i < 0; i++ {
-				var elem string
-				{
-					elem = "string"
-				}
-				s.MessageArgs = append(s.MessageArgs, elem)
-			}
+			s.Missing.SetFake()
 		}
 	}
 	{
 		{
-			s.MessageId.SetFake()
+			s.Nics.SetFake()
 		}
 	}
+}
+
+// SetFake set fake values.
+func (s *TrashedHostHostHardwareDimmsItem) SetFake() {
 	{
 		{
-			s.MessageSeverity.SetFake()
+			s.SizeMib.SetFake()
 		}
 	}
 	{
 		{
-			s.Oem = []byte("null")
+			s.Slot.SetFake()
 		}
 	}
+}
+
+// SetFake set fake values.
+func (s *TrashedHostHostHardwareDisksItem) SetFake() {
 	{
 		{
-			s.RelatedProperties.SetFake()
+			s.Model.SetFake()
 		}
 	}
 	{
 		{
-			s.Resolution.SetFake()
+			s.Name.SetFake()
 		}
 	}
 	{
 		{
-			s.ResolutionSteps = nil
-			for i := 0; i < 0; i++ {
-				var elem RedfishSystemOemDellMessageDotExtendedInfoItemResolutionStepsItem
-				{
-					elem.SetFake()
-				}
-				s.ResolutionSteps = append(s.ResolutionSteps, elem)
-			}
+			s.Serial.SetFake()
 		}
 	}
 	{
 		{
-			s.Severity.SetFake()
+			s.SizeBytes.SetFake()
 		}
 	}
+}
+
+// SetFake set fake values.
+func (s *TrashedHostHostHardwareNicsItem) SetFake() {
 	{
 		{
-			s.UserAuthenticationSource.SetFake()
+			s.MAC.SetFake()
 		}
 	}
 	{
 		{
-			s.Username.SetFake()
+			s.Name.SetFake()
 		}
 	}
 }
 
 // SetFake set fake values.
-func (s *RedfishSystemOemDellMessageDotExtendedInfoItemResolutionStepsItem) SetFake() {
+func (s *TrashedHostHostInterfacesItem) SetFake() {
 	{
 		{
-			s.ActionParameters = nil
+			s.Addresses = nil
 			for i := 0; i < 0; i++ {
-				var elem RedfishSystemOemDellMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem
+				var elem TrashedHostHostInterfacesItemAddressesItem
 				{
 					elem.SetFake()
 				}
-				s.ActionParameters = append(s.ActionParameters, elem)
+				s.Addresses = append(s.Addresses, elem)
 			}
 		}
 	}
 	{
 		{
-			s.ActionURI.SetFake()
-		}
-	}
-	{
-		{
-			s.Oem = []byte("null")
-		}
-	}
-	{
-		{
-			s.Priority.SetFake()
+			s.Bmc.SetFake()
 		}
 	}
 	{
 		{
-			s.ResolutionType.SetFake()
+			s.Fqdn.SetFake()
 		}
 	}
 	{
 		{
-			s.RetryCount.SetFake()
+			s.ID.SetFake()
 		}
 	}
 	{
 		{
-			s.RetryIntervalSeconds.SetFake()
+			s.Ifname.SetFake()
 		}
 	}
 	{
 		{
-			s.TargetComponentURI.SetFake()
+			s.IP.SetFake()
 		}
 	}
-}
-
-// SetFake set fake values.
-func (s *RedfishSystemOemDellMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) SetFake() {
 	{
 		{
-			s.AllowableNumbers = nil
-			for i := 0; i < 0; i++ {
-				var elem string
-				{
-					elem = "string"
-				}
-				s.AllowableNumbers = append(s.AllowableNumbers, elem)
-			}
+			s.MAC.SetFake()
 		}
 	}
 	{
 		{
-			s.AllowablePattern.SetFake()
+			s.Mtu.SetFake()
 		}
 	}
 	{
 		{
-			s.AllowableValueDescriptions = nil
-			for i := 0; i < 0; i++ {
-				var elem string
-				{
-					elem = "string"
-				}
-				s.AllowableValueDescriptions = append(s.AllowableValueDescriptions, elem)
-			}
+			s.Parent.SetFake()
 		}
 	}
 	{
 		{
-			s.AllowableValues = nil
-			for i := 0; i < 0; i++ {
-				var elem string
-				{
-					elem = "string"
-				}
-				s.AllowableValues = append(s.AllowableValues, elem)
-			}
+			s.Port.SetFake()
 		}
 	}
 	{
 		{
-			s.ArraySizeMaximum.SetFake()
+			s.Switch.SetFake()
 		}
 	}
 	{
 		{
-			s.ArraySizeMinimum.SetFake()
+			s.Vlan.SetFake()
 		}
 	}
+}
+
+// SetFake set fake values.
+func (s *TrashedHostHostInterfacesItemAddressesItem) SetFake() {
 	{
 		{
-			s.DataType.SetFake()
+			s.Fqdn.SetFake()
 		}
 	}
 	{
 		{
-			s.DefaultValue.SetFake()
+			s.IP.SetFake()
 		}
 	}
 	{
 		{
-			s.MaximumValue.SetFake()
+			s.Primary.SetFake()
 		}
 	}
+}
+
+// SetFake set fake values.
+func (s *TrashedHostHostInventory) SetFake() {
 	{
 		{
-			s.MinimumValue.SetFake()
+			s.BiosVersion.SetFake()
 		}
 	}
 	{
 		{
-			s.Name.SetFake()
+			s.BmcFirmware.SetFake()
 		}
 	}
 	{
 		{
-			s.NoDefaultValue.SetFake()
+			s.CapturedAt.SetFake()
 		}
 	}
 	{
 		{
-			s.ObjectDataType.SetFake()
+			s.NicFirmware.SetFake()
 		}
 	}
-	{
-		{
-			s.Required.SetFake()
-		}
+}
+
+// SetFake set fake values.
+func (s *TrashedHostHostInventoryNicFirmware) SetFake() {
+	var (
+		elem string
+		m    map[string]string = s.init()
+	)
+	for i := 0; i < 0; i++ {
+		m[fmt.Sprintf("fake%d", i)] = elem
 	}
 }
 
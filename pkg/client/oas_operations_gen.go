@@ -10,27 +10,68 @@ const (
 	DELETEV1BmcJobsOperation                     OperationName = "DELETEV1BmcJobs"
 	DELETEV1BmcJobsJidsOperation                 OperationName = "DELETEV1BmcJobsJids"
 	DELETEV1BmcSelOperation                      OperationName = "DELETEV1BmcSel"
+	DELETEV1BmcVmediaOperation                   OperationName = "DELETEV1BmcVmedia"
+	DELETEV1DNSRecordsOperation                  OperationName = "DELETEV1DNSRecords"
+	DELETEV1DiscoverBmcOperation                 OperationName = "DELETEV1DiscoverBmc"
 	DELETEV1ImagesOperation                      OperationName = "DELETEV1Images"
 	DELETEV1NodesOperation                       OperationName = "DELETEV1Nodes"
+	DELETEV1NodesCredentialsOperation            OperationName = "DELETEV1NodesCredentials"
+	DELETEV1NodesFilesOperation                  OperationName = "DELETEV1NodesFiles"
+	DELETEV1NodesTrashOperation                  OperationName = "DELETEV1NodesTrash"
 	DELETEV1RolesNamesOperation                  OperationName = "DELETEV1RolesNames"
+	DELETEV1ScheduleIDOperation                  OperationName = "DELETEV1ScheduleID"
+	DELETEV1SecretsNameOperation                 OperationName = "DELETEV1SecretsName"
 	DELETEV1UsersUsernamesOperation              OperationName = "DELETEV1UsersUsernames"
+	GETV1ArtifactsOperation                      OperationName = "GETV1Artifacts"
 	GETV1BmcOperation                            OperationName = "GETV1Bmc"
+	GETV1BmcBiosOperation                        OperationName = "GETV1BmcBios"
+	GETV1BmcBiosDiffOperation                    OperationName = "GETV1BmcBiosDiff"
 	GETV1BmcJobsOperation                        OperationName = "GETV1BmcJobs"
 	GETV1BmcMetricsOperation                     OperationName = "GETV1BmcMetrics"
+	GETV1BmcPowerOperation                       OperationName = "GETV1BmcPower"
+	GETV1BmcSubscriptionsOperation               OperationName = "GETV1BmcSubscriptions"
 	GETV1BmcUpgradeDellRepoOperation             OperationName = "GETV1BmcUpgradeDellRepo"
+	GETV1BmcVmediaOperation                      OperationName = "GETV1BmcVmedia"
+	GETV1CertsRevokedOperation                   OperationName = "GETV1CertsRevoked"
+	GETV1ChangesOperation                        OperationName = "GETV1Changes"
+	GETV1DNSRecordsOperation                     OperationName = "GETV1DNSRecords"
+	GETV1DbBackupOperation                       OperationName = "GETV1DbBackup"
 	GETV1DbDumpOperation                         OperationName = "GETV1DbDump"
+	GETV1DiscoverBmcOperation                    OperationName = "GETV1DiscoverBmc"
 	GETV1GrendelEventsOperation                  OperationName = "GETV1GrendelEvents"
+	GETV1GrendelHaOperation                      OperationName = "GETV1GrendelHa"
+	GETV1GrendelKeysOperation                    OperationName = "GETV1GrendelKeys"
+	GETV1GrendelListenersOperation               OperationName = "GETV1GrendelListeners"
+	GETV1GrendelMaintenanceOperation             OperationName = "GETV1GrendelMaintenance"
+	GETV1GrendelReadonlyOperation                OperationName = "GETV1GrendelReadonly"
+	GETV1GrendelStatsOperation                   OperationName = "GETV1GrendelStats"
 	GETV1ImagesOperation                         OperationName = "GETV1Images"
+	GETV1ImagesDeletedOperation                  OperationName = "GETV1ImagesDeleted"
+	GETV1ImagesDuOperation                       OperationName = "GETV1ImagesDu"
 	GETV1ImagesFindOperation                     OperationName = "GETV1ImagesFind"
 	GETV1NodesOperation                          OperationName = "GETV1Nodes"
+	GETV1NodesCredentialsOperation               OperationName = "GETV1NodesCredentials"
+	GETV1NodesDeletedOperation                   OperationName = "GETV1NodesDeleted"
+	GETV1NodesFilesOperation                     OperationName = "GETV1NodesFiles"
 	GETV1NodesFindOperation                      OperationName = "GETV1NodesFind"
+	GETV1NodesLogOperation                       OperationName = "GETV1NodesLog"
+	GETV1NodesPrometheusSdOperation              OperationName = "GETV1NodesPrometheusSd"
+	GETV1NodesReservationsOperation              OperationName = "GETV1NodesReservations"
 	GETV1NodesTokenInterfaceOperation            OperationName = "GETV1NodesTokenInterface"
+	GETV1NodesTrashOperation                     OperationName = "GETV1NodesTrash"
 	GETV1RolesOperation                          OperationName = "GETV1Roles"
+	GETV1ScheduleOperation                       OperationName = "GETV1Schedule"
+	GETV1ScheduleIDOperation                     OperationName = "GETV1ScheduleID"
+	GETV1SecretsOperation                        OperationName = "GETV1Secrets"
 	GETV1SwitchNodesetLldpOperation              OperationName = "GETV1SwitchNodesetLldp"
+	GETV1SwitchNodesetVerifyOperation            OperationName = "GETV1SwitchNodesetVerify"
 	GETV1UsersOperation                          OperationName = "GETV1Users"
 	PATCHV1AuthResetOperation                    OperationName = "PATCHV1AuthReset"
+	PATCHV1NodesFirmwareOperation                OperationName = "PATCHV1NodesFirmware"
 	PATCHV1NodesImageOperation                   OperationName = "PATCHV1NodesImage"
 	PATCHV1NodesProvisionOperation               OperationName = "PATCHV1NodesProvision"
+	PATCHV1NodesRenameOperation                  OperationName = "PATCHV1NodesRename"
+	PATCHV1NodesStateOperation                   OperationName = "PATCHV1NodesState"
 	PATCHV1NodesTagsActionOperation              OperationName = "PATCHV1NodesTagsAction"
 	PATCHV1RolesOperation                        OperationName = "PATCHV1Roles"
 	PATCHV1UsersUsernamesEnableOperation         OperationName = "PATCHV1UsersUsernamesEnable"
@@ -38,14 +79,41 @@ const (
 	POSTV1AuthSigninOperation                    OperationName = "POSTV1AuthSignin"
 	POSTV1AuthSignupOperation                    OperationName = "POSTV1AuthSignup"
 	POSTV1AuthTokenOperation                     OperationName = "POSTV1AuthToken"
+	POSTV1BmcBiosOperation                       OperationName = "POSTV1BmcBios"
+	POSTV1BmcBootOperation                       OperationName = "POSTV1BmcBoot"
 	POSTV1BmcConfigureAutoOperation              OperationName = "POSTV1BmcConfigureAuto"
 	POSTV1BmcConfigureImportOperation            OperationName = "POSTV1BmcConfigureImport"
+	POSTV1BmcFwupdateOperation                   OperationName = "POSTV1BmcFwupdate"
+	POSTV1BmcInventoryOperation                  OperationName = "POSTV1BmcInventory"
 	POSTV1BmcPowerBmcOperation                   OperationName = "POSTV1BmcPowerBmc"
 	POSTV1BmcPowerOsOperation                    OperationName = "POSTV1BmcPowerOs"
+	POSTV1BmcRotatePasswordOperation             OperationName = "POSTV1BmcRotatePassword"
+	POSTV1BmcSubscriptionsOperation              OperationName = "POSTV1BmcSubscriptions"
 	POSTV1BmcUpgradeDellInstallfromrepoOperation OperationName = "POSTV1BmcUpgradeDellInstallfromrepo"
+	POSTV1BmcVmediaOperation                     OperationName = "POSTV1BmcVmedia"
+	POSTV1CertsRevokeOperation                   OperationName = "POSTV1CertsRevoke"
+	POSTV1DNSRecordsOperation                    OperationName = "POSTV1DNSRecords"
+	POSTV1DbLoadOperation                        OperationName = "POSTV1DbLoad"
+	POSTV1DbReindexOperation                     OperationName = "POSTV1DbReindex"
 	POSTV1DbRestoreOperation                     OperationName = "POSTV1DbRestore"
+	POSTV1DiscoverBmcAdoptOperation              OperationName = "POSTV1DiscoverBmcAdopt"
+	POSTV1GrendelKeysRotateOperation             OperationName = "POSTV1GrendelKeysRotate"
+	POSTV1GrendelReloadOperation                 OperationName = "POSTV1GrendelReload"
 	POSTV1ImagesOperation                        OperationName = "POSTV1Images"
+	POSTV1ImagesGcOperation                      OperationName = "POSTV1ImagesGc"
 	POSTV1NodesOperation                         OperationName = "POSTV1Nodes"
+	POSTV1NodesNextipOperation                   OperationName = "POSTV1NodesNextip"
+	POSTV1NodesTokenInspectOperation             OperationName = "POSTV1NodesTokenInspect"
+	POSTV1NodesTokenRevokeOperation              OperationName = "POSTV1NodesTokenRevoke"
+	POSTV1NodesTrashRestoreOperation             OperationName = "POSTV1NodesTrashRestore"
 	POSTV1RolesOperation                         OperationName = "POSTV1Roles"
+	POSTV1ScheduleOperation                      OperationName = "POSTV1Schedule"
+	POSTV1SwitchNodesetScanOperation             OperationName = "POSTV1SwitchNodesetScan"
+	POSTV1SwitchNodesetVerifyOperation           OperationName = "POSTV1SwitchNodesetVerify"
 	POSTV1UsersOperation                         OperationName = "POSTV1Users"
+	PUTV1GrendelMaintenanceOperation             OperationName = "PUTV1GrendelMaintenance"
+	PUTV1NodesClientCertOperation                OperationName = "PUTV1NodesClientCert"
+	PUTV1NodesCredentialsOperation               OperationName = "PUTV1NodesCredentials"
+	PUTV1NodesFilesOperation                     OperationName = "PUTV1NodesFiles"
+	PUTV1SecretsNameOperation                    OperationName = "PUTV1SecretsName"
 )
@@ -36,6 +36,33 @@ type DELETEV1BmcSelParams struct {
 	Accept OptString
 }
 
+// DELETEV1BmcVmediaParams is parameters of DELETE_/v1/bmc/vmedia operation.
+type DELETEV1BmcVmediaParams struct {
+	// Filter by nodeset. Minimum of one query parameter is required.
+	Nodeset OptString
+	// Filter by tags. Minimum of one query parameter is required.
+	Tags OptString
+	// Number of BMCs queried at once, defaults to bmc.fanout.
+	Fanout OptInt
+	// Seconds allowed for each BMC, defaults to bmc.timeout.
+	Timeout OptInt
+	Accept  OptString
+}
+
+// DELETEV1DNSRecordsParams is parameters of DELETE_/v1/dns/records operation.
+type DELETEV1DNSRecordsParams struct {
+	// Filter by record name.
+	Names  OptString
+	Accept OptString
+}
+
+// DELETEV1DiscoverBmcParams is parameters of DELETE_/v1/discover/bmc operation.
+type DELETEV1DiscoverBmcParams struct {
+	// MAC address of the pending BMC.
+	MAC    string
+	Accept OptString
+}
+
 // DELETEV1ImagesParams is parameters of DELETE_/v1/images operation.
 type DELETEV1ImagesParams struct {
 	// Filter by name.
@@ -52,6 +79,37 @@ type DELETEV1NodesParams struct {
 	Accept OptString
 }
 
+// DELETEV1NodesCredentialsParams is parameters of DELETE_/v1/nodes/credentials operation.
+type DELETEV1NodesCredentialsParams struct {
+	// Filter by nodeset. Minimum of one query parameter is required.
+	Nodeset OptString
+	// Filter by tags. Minimum of one query parameter is required.
+	Tags OptString
+	// Kind of credentials, defaults to bmc.
+	Kind   OptString
+	Accept OptString
+}
+
+// DELETEV1NodesFilesParams is parameters of DELETE_/v1/nodes/files operation.
+type DELETEV1NodesFilesParams struct {
+	// Filter by nodeset. Minimum of one query parameter is required.
+	Nodeset OptString
+	// Filter by tags. Minimum of one query parameter is required.
+	Tags OptString
+	// Name of the file.
+	Name   string
+	Accept OptString
+}
+
+// DELETEV1NodesTrashParams is parameters of DELETE_/v1/nodes/trash operation.
+type DELETEV1NodesTrashParams struct {
+	// Purge nodes deleted longer ago than the duration.
+	OlderThan OptString
+	// Purge all nodes in the trash.
+	All    OptBool
+	Accept OptString
+}
+
 // DELETEV1RolesNamesParams is parameters of DELETE_/v1/roles/:names operation.
 type DELETEV1RolesNamesParams struct {
 	// Delete by name.
@@ -59,6 +117,20 @@ type DELETEV1RolesNamesParams struct {
 	Accept OptString
 }
 
+// DELETEV1ScheduleIDParams is parameters of DELETE_/v1/schedule/:id operation.
+type DELETEV1ScheduleIDParams struct {
+	// ID of the scheduled action.
+	ID     string
+	Accept OptString
+}
+
+// DELETEV1SecretsNameParams is parameters of DELETE_/v1/secrets/:name operation.
+type DELETEV1SecretsNameParams struct {
+	// Name of the secret.
+	Name   string
+	Accept OptString
+}
+
 // DELETEV1UsersUsernamesParams is parameters of DELETE_/v1/users/:usernames operation.
 type DELETEV1UsersUsernamesParams struct {
 	// Target usernames.
@@ -66,6 +138,11 @@ type DELETEV1UsersUsernamesParams struct {
 	Accept    OptString
 }
 
+// GETV1ArtifactsParams is parameters of GET_/v1/artifacts operation.
+type GETV1ArtifactsParams struct {
+	Accept OptString
+}
+
 // GETV1BmcParams is parameters of GET_/v1/bmc operation.
 type GETV1BmcParams struct {
 	// Filter by nodeset. Minimum of one query parameter is required.
@@ -75,6 +152,34 @@ type GETV1BmcParams struct {
 	Accept OptString
 }
 
+// GETV1BmcBiosParams is parameters of GET_/v1/bmc/bios operation.
+type GETV1BmcBiosParams struct {
+	// Filter by nodeset. Minimum of one query parameter is required.
+	Nodeset OptString
+	// Filter by tags. Minimum of one query parameter is required.
+	Tags OptString
+	// Number of BMCs queried at once, defaults to bmc.fanout.
+	Fanout OptInt
+	// Seconds allowed for each BMC, defaults to bmc.timeout.
+	Timeout OptInt
+	Accept  OptString
+}
+
+// GETV1BmcBiosDiffParams is parameters of GET_/v1/bmc/bios/diff operation.
+type GETV1BmcBiosDiffParams struct {
+	// Filter by nodeset. Minimum of one query parameter is required.
+	Nodeset OptString
+	// Filter by tags. Minimum of one query parameter is required.
+	Tags OptString
+	// Name of the BIOS profile.
+	Profile string
+	// Number of BMCs queried at once, defaults to bmc.fanout.
+	Fanout OptInt
+	// Seconds allowed for each BMC, defaults to bmc.timeout.
+	Timeout OptInt
+	Accept  OptString
+}
+
 // GETV1BmcJobsParams is parameters of GET_/v1/bmc/jobs operation.
 type GETV1BmcJobsParams struct {
 	// Filter by nodeset. Minimum of one query parameter is required.
@@ -93,6 +198,32 @@ type GETV1BmcMetricsParams struct {
 	Accept OptString
 }
 
+// GETV1BmcPowerParams is parameters of GET_/v1/bmc/power operation.
+type GETV1BmcPowerParams struct {
+	// Filter by nodeset. Minimum of one query parameter is required.
+	Nodeset OptString
+	// Filter by tags. Minimum of one query parameter is required.
+	Tags OptString
+	// Number of BMCs queried at once, defaults to bmc.fanout.
+	Fanout OptInt
+	// Seconds allowed for each BMC, defaults to bmc.timeout.
+	Timeout OptInt
+	Accept  OptString
+}
+
+// GETV1BmcSubscriptionsParams is parameters of GET_/v1/bmc/subscriptions operation.
+type GETV1BmcSubscriptionsParams struct {
+	// Filter by nodeset. Minimum of one query parameter is required.
+	Nodeset OptString
+	// Filter by tags. Minimum of one query parameter is required.
+	Tags OptString
+	// Number of BMCs queried at once, defaults to bmc.fanout.
+	Fanout OptInt
+	// Seconds allowed for each BMC, defaults to bmc.timeout.
+	Timeout OptInt
+	Accept  OptString
+}
+
 // GETV1BmcUpgradeDellRepoParams is parameters of GET_/v1/bmc/upgrade/dell/repo operation.
 type GETV1BmcUpgradeDellRepoParams struct {
 	// Filter by nodeset. Minimum of one query parameter is required.
@@ -102,8 +233,56 @@ type GETV1BmcUpgradeDellRepoParams struct {
 	Accept OptString
 }
 
+// GETV1BmcVmediaParams is parameters of GET_/v1/bmc/vmedia operation.
+type GETV1BmcVmediaParams struct {
+	// Filter by nodeset. Minimum of one query parameter is required.
+	Nodeset OptString
+	// Filter by tags. Minimum of one query parameter is required.
+	Tags OptString
+	// Number of BMCs queried at once, defaults to bmc.fanout.
+	Fanout OptInt
+	// Seconds allowed for each BMC, defaults to bmc.timeout.
+	Timeout OptInt
+	Accept  OptString
+}
+
+// GETV1CertsRevokedParams is parameters of GET_/v1/certs/revoked operation.
+type GETV1CertsRevokedParams struct {
+	Accept OptString
+}
+
+// GETV1ChangesParams is parameters of GET_/v1/changes operation.
+type GETV1ChangesParams struct {
+	// Only return changes with a greater sequence number.
+	SinceSeq OptInt
+	// Maximum number of changes to return, defaults to and is capped at 1000.
+	Limit OptInt
+	// Seconds to wait for a change if there are none, capped at 60.
+	Wait   OptInt
+	Accept OptString
+}
+
+// GETV1DNSRecordsParams is parameters of GET_/v1/dns/records operation.
+type GETV1DNSRecordsParams struct {
+	// Filter by record name.
+	Names  OptString
+	Accept OptString
+}
+
+// GETV1DbBackupParams is parameters of GET_/v1/db/backup operation.
+type GETV1DbBackupParams struct {
+	Accept OptString
+}
+
 // GETV1DbDumpParams is parameters of GET_/v1/db/dump operation.
 type GETV1DbDumpParams struct {
+	// Include the encrypted credentials of nodes.
+	IncludeSecrets OptBool
+	Accept         OptString
+}
+
+// GETV1DiscoverBmcParams is parameters of GET_/v1/discover/bmc operation.
+type GETV1DiscoverBmcParams struct {
 	Accept OptString
 }
 
@@ -112,25 +291,125 @@ type GETV1GrendelEventsParams struct {
 	Accept OptString
 }
 
+// GETV1GrendelHaParams is parameters of GET_/v1/grendel/ha operation.
+type GETV1GrendelHaParams struct {
+	Accept OptString
+}
+
+// GETV1GrendelKeysParams is parameters of GET_/v1/grendel/keys operation.
+type GETV1GrendelKeysParams struct {
+	Accept OptString
+}
+
+// GETV1GrendelListenersParams is parameters of GET_/v1/grendel/listeners operation.
+type GETV1GrendelListenersParams struct {
+	Accept OptString
+}
+
+// GETV1GrendelMaintenanceParams is parameters of GET_/v1/grendel/maintenance operation.
+type GETV1GrendelMaintenanceParams struct {
+	Accept OptString
+}
+
+// GETV1GrendelReadonlyParams is parameters of GET_/v1/grendel/readonly operation.
+type GETV1GrendelReadonlyParams struct {
+	Accept OptString
+}
+
+// GETV1GrendelStatsParams is parameters of GET_/v1/grendel/stats operation.
+type GETV1GrendelStatsParams struct {
+	Accept OptString
+}
+
 // GETV1ImagesParams is parameters of GET_/v1/images operation.
 type GETV1ImagesParams struct {
+	// Only return entries added or updated at or after the RFC3339 time.
+	Since OptString
+	// Return the images with the fields inherited from their parents.
+	Resolved OptBool
+	Accept   OptString
+}
+
+// GETV1ImagesDeletedParams is parameters of GET_/v1/images/deleted operation.
+type GETV1ImagesDeletedParams struct {
+	// Only return entries deleted at or after the RFC3339 time.
+	Since  OptString
+	Accept OptString
+}
+
+// GETV1ImagesDuParams is parameters of GET_/v1/images/du operation.
+type GETV1ImagesDuParams struct {
 	Accept OptString
 }
 
 // GETV1ImagesFindParams is parameters of GET_/v1/images/find operation.
 type GETV1ImagesFindParams struct {
 	// Filter by name.
-	Names  OptString
-	Accept OptString
+	Names OptString
+	// Only return entries added or updated at or after the RFC3339 time.
+	Since OptString
+	// Return the images with the fields inherited from their parents.
+	Resolved OptBool
+	Accept   OptString
 }
 
 // GETV1NodesParams is parameters of GET_/v1/nodes operation.
 type GETV1NodesParams struct {
+	// Only return entries added or updated at or after the RFC3339 time.
+	Since  OptString
+	Accept OptString
+}
+
+// GETV1NodesCredentialsParams is parameters of GET_/v1/nodes/credentials operation.
+type GETV1NodesCredentialsParams struct {
+	// Filter by nodeset. Minimum of one query parameter is required.
+	Nodeset OptString
+	// Filter by tags. Minimum of one query parameter is required.
+	Tags   OptString
+	Accept OptString
+}
+
+// GETV1NodesDeletedParams is parameters of GET_/v1/nodes/deleted operation.
+type GETV1NodesDeletedParams struct {
+	// Only return entries deleted at or after the RFC3339 time.
+	Since  OptString
+	Accept OptString
+}
+
+// GETV1NodesFilesParams is parameters of GET_/v1/nodes/files operation.
+type GETV1NodesFilesParams struct {
+	// Filter by nodeset. Minimum of one query parameter is required.
+	Nodeset OptString
+	// Filter by tags. Minimum of one query parameter is required.
+	Tags   OptString
 	Accept OptString
 }
 
 // GETV1NodesFindParams is parameters of GET_/v1/nodes/find operation.
 type GETV1NodesFindParams struct {
+	// Filter by nodeset. Minimum of one query parameter is required.
+	Nodeset OptString
+	// Filter by tags. Minimum of one query parameter is required.
+	Tags OptString
+	// Filter by switch the node interfaces are connected to.
+	Switch OptString
+	// Filter by switch port the node interfaces are connected to.
+	Port OptInt
+	// Filter by BIOS version in the firmware inventory.
+	BiosVersion OptString
+	// Filter by BMC firmware version in the firmware inventory.
+	BmcFirmware OptString
+	// Filter by firmware version of any network adapter in the firmware inventory.
+	NicFirmware OptString
+	// Filter by comma separated lifecycle states.
+	State OptString
+	// Only return entries added or updated at or after the RFC3339 time.
+	Since  OptString
+	Accept OptString
+}
+
+// GETV1NodesLogParams is parameters of GET_/v1/nodes/log operation.
+type GETV1NodesLogParams struct {
 	// Filter by nodeset. Minimum of one query parameter is required.
 	Nodeset OptString
 	// Filter by tags. Minimum of one query parameter is required.
@@ -138,6 +417,24 @@ type GETV1NodesFindParams struct {
 	Accept OptString
 }
 
+// GETV1NodesPrometheusSdParams is parameters of GET_/v1/nodes/prometheus-sd operation.
+type GETV1NodesPrometheusSdParams struct {
+	// Filter by nodeset. Minimum of one query parameter is required.
+	Nodeset OptString
+	// Filter by tags. Minimum of one query parameter is required.
+	Tags OptString
+	// Port of the targets, defaults to 9100.
+	Port OptInt
+	// Leave out nodes set to provision, which are being reinstalled.
+	ExcludeProvision OptBool
+	Accept           OptString
+}
+
+// GETV1NodesReservationsParams is parameters of GET_/v1/nodes/reservations operation.
+type GETV1NodesReservationsParams struct {
+	Accept OptString
+}
+
 // GETV1NodesTokenInterfaceParams is parameters of GET_/v1/nodes/token/:interface operation.
 type GETV1NodesTokenInterfaceParams struct {
 	// Interface token will be created for.
@@ -149,6 +446,11 @@ type GETV1NodesTokenInterfaceParams struct {
 	Accept OptString
 }
 
+// GETV1NodesTrashParams is parameters of GET_/v1/nodes/trash operation.
+type GETV1NodesTrashParams struct {
+	Accept OptString
+}
+
 // GETV1RolesParams is parameters of GET_/v1/roles operation.
 type GETV1RolesParams struct {
 	// Filter by name.
@@ -156,6 +458,25 @@ type GETV1RolesParams struct {
 	Accept OptString
 }
 
+// GETV1ScheduleParams is parameters of GET_/v1/schedule operation.
+type GETV1ScheduleParams struct {
+	// Filter by comma separated statuses: pending, running, done, partial, failed, cancelled or missed.
+	Status OptString
+	Accept OptString
+}
+
+// GETV1ScheduleIDParams is parameters of GET_/v1/schedule/:id operation.
+type GETV1ScheduleIDParams struct {
+	// ID of the scheduled action.
+	ID     string
+	Accept OptString
+}
+
+// GETV1SecretsParams is parameters of GET_/v1/secrets operation.
+type GETV1SecretsParams struct {
+	Accept OptString
+}
+
 // GETV1SwitchNodesetLldpParams is parameters of GET_/v1/switch/:nodeset/lldp operation.
 type GETV1SwitchNodesetLldpParams struct {
 	// Filter by port name.
@@ -164,6 +485,12 @@ type GETV1SwitchNodesetLldpParams struct {
 	Nodeset string
 }
 
+// GETV1SwitchNodesetVerifyParams is parameters of GET_/v1/switch/:nodeset/verify operation.
+type GETV1SwitchNodesetVerifyParams struct {
+	Accept  OptString
+	Nodeset string
+}
+
 // GETV1UsersParams is parameters of GET_/v1/users operation.
 type GETV1UsersParams struct {
 	// Filter by usernames.
@@ -176,6 +503,15 @@ type PATCHV1AuthResetParams struct {
 	Accept OptString
 }
 
+// PATCHV1NodesFirmwareParams is parameters of PATCH_/v1/nodes/firmware operation.
+type PATCHV1NodesFirmwareParams struct {
+	// Filter by nodeset. Minimum of one query parameter is required.
+	Nodeset OptString
+	// Filter by tags. Minimum of one query parameter is required.
+	Tags   OptString
+	Accept OptString
+}
+
 // PATCHV1NodesImageParams is parameters of PATCH_/v1/nodes/image operation.
 type PATCHV1NodesImageParams struct {
 	// Filter by nodeset. Minimum of one query parameter is required.
@@ -194,6 +530,20 @@ type PATCHV1NodesProvisionParams struct {
 	Accept OptString
 }
 
+// PATCHV1NodesRenameParams is parameters of PATCH_/v1/nodes/rename operation.
+type PATCHV1NodesRenameParams struct {
+	Accept OptString
+}
+
+// PATCHV1NodesStateParams is parameters of PATCH_/v1/nodes/state operation.
+type PATCHV1NodesStateParams struct {
+	// Filter by nodeset. Minimum of one query parameter is required.
+	Nodeset OptString
+	// Filter by tags. Minimum of one query parameter is required.
+	Tags   OptString
+	Accept OptString
+}
+
 // PATCHV1NodesTagsActionParams is parameters of PATCH_/v1/nodes/tags/:action operation.
 type PATCHV1NodesTagsActionParams struct {
 	// Option to add or remove tags.
@@ -239,6 +589,24 @@ type POSTV1AuthTokenParams struct {
 	Accept OptString
 }
 
+// POSTV1BmcBiosParams is parameters of POST_/v1/bmc/bios operation.
+type POSTV1BmcBiosParams struct {
+	// Filter by nodeset. Minimum of one query parameter is required.
+	Nodeset OptString
+	// Filter by tags. Minimum of one query parameter is required.
+	Tags   OptString
+	Accept OptString
+}
+
+// POSTV1BmcBootParams is parameters of POST_/v1/bmc/boot operation.
+type POSTV1BmcBootParams struct {
+	// Filter by nodeset. Minimum of one query parameter is required.
+	Nodeset OptString
+	// Filter by tags. Minimum of one query parameter is required.
+	Tags   OptString
+	Accept OptString
+}
+
 // POSTV1BmcConfigureAutoParams is parameters of POST_/v1/bmc/configure/auto operation.
 type POSTV1BmcConfigureAutoParams struct {
 	// Filter by nodeset. Minimum of one query parameter is required.
@@ -257,6 +625,28 @@ type POSTV1BmcConfigureImportParams struct {
 	Accept OptString
 }
 
+// POSTV1BmcFwupdateParams is parameters of POST_/v1/bmc/fwupdate operation.
+type POSTV1BmcFwupdateParams struct {
+	// Filter by nodeset. Minimum of one query parameter is required.
+	Nodeset OptString
+	// Filter by tags. Minimum of one query parameter is required.
+	Tags   OptString
+	Accept OptString
+}
+
+// POSTV1BmcInventoryParams is parameters of POST_/v1/bmc/inventory operation.
+type POSTV1BmcInventoryParams struct {
+	// Filter by nodeset. Minimum of one query parameter is required.
+	Nodeset OptString
+	// Filter by tags. Minimum of one query parameter is required.
+	Tags OptString
+	// Number of BMCs queried at once, defaults to bmc.fanout.
+	Fanout OptInt
+	// Seconds allowed for each BMC, defaults to bmc.timeout.
+	Timeout OptInt
+	Accept  OptString
+}
+
 // POSTV1BmcPowerBmcParams is parameters of POST_/v1/bmc/power/bmc operation.
 type POSTV1BmcPowerBmcParams struct {
 	// Filter by nodeset. Minimum of one query parameter is required.
@@ -275,6 +665,28 @@ type POSTV1BmcPowerOsParams struct {
 	Accept OptString
 }
 
+// POSTV1BmcRotatePasswordParams is parameters of POST_/v1/bmc/rotate-password operation.
+type POSTV1BmcRotatePasswordParams struct {
+	// Filter by nodeset. Minimum of one query parameter is required.
+	Nodeset OptString
+	// Filter by tags. Minimum of one query parameter is required.
+	Tags   OptString
+	Accept OptString
+}
+
+// POSTV1BmcSubscriptionsParams is parameters of POST_/v1/bmc/subscriptions operation.
+type POSTV1BmcSubscriptionsParams struct {
+	// Filter by nodeset. Minimum of one query parameter is required.
+	Nodeset OptString
+	// Filter by tags. Minimum of one query parameter is required.
+	Tags OptString
+	// Number of BMCs queried at once, defaults to bmc.fanout.
+	Fanout OptInt
+	// Seconds allowed for each BMC, defaults to bmc.timeout.
+	Timeout OptInt
+	Accept  OptString
+}
+
 // POSTV1BmcUpgradeDellInstallfromrepoParams is parameters of POST_/v1/bmc/upgrade/dell/installfromrepo operation.
 type POSTV1BmcUpgradeDellInstallfromrepoParams struct {
 	// Filter by nodeset. Minimum of one query parameter is required.
@@ -284,27 +696,152 @@ type POSTV1BmcUpgradeDellInstallfromrepoParams struct {
 	Accept OptString
 }
 
+// POSTV1BmcVmediaParams is parameters of POST_/v1/bmc/vmedia operation.
+type POSTV1BmcVmediaParams struct {
+	// Filter by nodeset. Minimum of one query parameter is required.
+	Nodeset OptString
+	// Filter by tags. Minimum of one query parameter is required.
+	Tags   OptString
+	Accept OptString
+}
+
+// POSTV1CertsRevokeParams is parameters of POST_/v1/certs/revoke operation.
+type POSTV1CertsRevokeParams struct {
+	Accept OptString
+}
+
+// POSTV1DNSRecordsParams is parameters of POST_/v1/dns/records operation.
+type POSTV1DNSRecordsParams struct {
+	Accept OptString
+}
+
+// POSTV1DbLoadParams is parameters of POST_/v1/db/load operation.
+type POSTV1DbLoadParams struct {
+	Accept OptString
+}
+
+// POSTV1DbReindexParams is parameters of POST_/v1/db/reindex operation.
+type POSTV1DbReindexParams struct {
+	Accept OptString
+}
+
 // POSTV1DbRestoreParams is parameters of POST_/v1/db/restore operation.
 type POSTV1DbRestoreParams struct {
 	Accept OptString
 }
 
+// POSTV1DiscoverBmcAdoptParams is parameters of POST_/v1/discover/bmc/adopt operation.
+type POSTV1DiscoverBmcAdoptParams struct {
+	Accept OptString
+}
+
+// POSTV1GrendelKeysRotateParams is parameters of POST_/v1/grendel/keys/rotate operation.
+type POSTV1GrendelKeysRotateParams struct {
+	Accept OptString
+}
+
+// POSTV1GrendelReloadParams is parameters of POST_/v1/grendel/reload operation.
+type POSTV1GrendelReloadParams struct {
+	Accept OptString
+}
+
 // POSTV1ImagesParams is parameters of POST_/v1/images operation.
 type POSTV1ImagesParams struct {
 	Accept OptString
 }
 
+// POSTV1ImagesGcParams is parameters of POST_/v1/images/gc operation.
+type POSTV1ImagesGcParams struct {
+	// Remove the files instead of listing them.
+	Delete OptBool
+	Accept OptString
+}
+
 // POSTV1NodesParams is parameters of POST_/v1/nodes operation.
 type POSTV1NodesParams struct {
 	Accept OptString
 }
 
+// POSTV1NodesNextipParams is parameters of POST_/v1/nodes/nextip operation.
+type POSTV1NodesNextipParams struct {
+	Accept OptString
+}
+
+// POSTV1NodesTokenInspectParams is parameters of POST_/v1/nodes/token/inspect operation.
+type POSTV1NodesTokenInspectParams struct {
+	Accept OptString
+}
+
+// POSTV1NodesTokenRevokeParams is parameters of POST_/v1/nodes/token/revoke operation.
+type POSTV1NodesTokenRevokeParams struct {
+	Accept OptString
+}
+
+// POSTV1NodesTrashRestoreParams is parameters of POST_/v1/nodes/trash/restore operation.
+type POSTV1NodesTrashRestoreParams struct {
+	// Nodes to restore.
+	Nodeset string
+	Accept  OptString
+}
+
 // POSTV1RolesParams is parameters of POST_/v1/roles operation.
 type POSTV1RolesParams struct {
 	Accept OptString
 }
 
+// POSTV1ScheduleParams is parameters of POST_/v1/schedule operation.
+type POSTV1ScheduleParams struct {
+	Accept OptString
+}
+
+// POSTV1SwitchNodesetScanParams is parameters of POST_/v1/switch/:nodeset/scan operation.
+type POSTV1SwitchNodesetScanParams struct {
+	Accept  OptString
+	Nodeset string
+}
+
+// POSTV1SwitchNodesetVerifyParams is parameters of POST_/v1/switch/:nodeset/verify operation.
+type POSTV1SwitchNodesetVerifyParams struct {
+	Accept  OptString
+	Nodeset string
+}
+
 // POSTV1UsersParams is parameters of POST_/v1/users operation.
 type POSTV1UsersParams struct {
 	Accept OptString
 }
+
+// PUTV1GrendelMaintenanceParams is parameters of PUT_/v1/grendel/maintenance operation.
+type PUTV1GrendelMaintenanceParams struct {
+	Accept OptString
+}
+
+// PUTV1NodesClientCertParams is parameters of PUT_/v1/nodes/client-cert operation.
+type PUTV1NodesClientCertParams struct {
+	Accept OptString
+}
+
+// PUTV1NodesCredentialsParams is parameters of PUT_/v1/nodes/credentials operation.
+type PUTV1NodesCredentialsParams struct {
+	// Filter by nodeset. Minimum of one query parameter is required.
+	Nodeset OptString
+	// Filter by tags. Minimum of one query parameter is required.
+	Tags   OptString
+	Accept OptString
+}
+
+// PUTV1NodesFilesParams is parameters of PUT_/v1/nodes/files operation.
+type PUTV1NodesFilesParams struct {
+	// Filter by nodeset. Minimum of one query parameter is required.
+	Nodeset OptString
+	// Filter by tags. Minimum of one query parameter is required.
+	Tags   OptString
+	Accept OptString
+}
+
+// PUTV1SecretsNameParams is parameters of PUT_/v1/secrets/:name operation.
+type PUTV1SecretsNameParams struct {
+	// Name of the secret.
+	Name   string
+	Accept OptString
+}
@@ -39,6 +39,20 @@ func encodePATCHV1AuthResetRequest(
 	return nil
 }
 
+func encodePATCHV1NodesFirmwareRequest(
+	req *NodeFirmwareRequest,
+	r *http.Request,
+) error {
+	const contentType = "application/json"
+	e := new(jx.Encoder)
+	{
+		req.Encode(e)
+	}
+	encoded := e.Bytes()
+	ht.SetBody(r, bytes.NewReader(encoded), contentType)
+	return nil
+}
+
 func encodePATCHV1NodesImageRequest(
 	req *NodeBootImageRequest,
 	r *http.Request,
@@ -67,6 +81,34 @@ func encodePATCHV1NodesProvisionRequest(
 	return nil
 }
 
+func encodePATCHV1NodesRenameRequest(
+	req *NodeRenameRequest,
+	r *http.Request,
+) error {
+	const contentType = "application/json"
+	e := new(jx.Encoder)
+	{
+		req.Encode(e)
+	}
+	encoded := e.Bytes()
+	ht.SetBody(r, bytes.NewReader(encoded), contentType)
+	return nil
+}
+
+func encodePATCHV1NodesStateRequest(
+	req *NodeStateRequest,
+	r *http.Request,
+) error {
+	const contentType = "application/json"
+	e := new(jx.Encoder)
+	{
+		req.Encode(e)
+	}
+	encoded := e.Bytes()
+	ht.SetBody(r, bytes.NewReader(encoded), contentType)
+	return nil
+}
+
 func encodePATCHV1NodesTagsActionRequest(
 	req *NodeTagsRequest,
 	r *http.Request,
@@ -165,6 +207,34 @@ func encodePOSTV1AuthTokenRequest(
 	return nil
 }
 
+func encodePOSTV1BmcBiosRequest(
+	req *BmcBiosApplyBody,
+	r *http.Request,
+) error {
+	const contentType = "application/json"
+	e := new(jx.Encoder)
+	{
+		req.Encode(e)
+	}
+	encoded := e.Bytes()
+	ht.SetBody(r, bytes.NewReader(encoded), contentType)
+	return nil
+}
+
+func encodePOSTV1BmcBootRequest(
+	req *BmcBootOverrideBody,
+	r *http.Request,
+) error {
+	const contentType = "application/json"
+	e := new(jx.Encoder)
+	{
+		req.Encode(e)
+	}
+	encoded := e.Bytes()
+	ht.SetBody(r, bytes.NewReader(encoded), contentType)
+	return nil
+}
+
 func encodePOSTV1BmcConfigureImportRequest(
 	req *BmcImportConfigurationRequest,
 	r *http.Request,
@@ -179,6 +249,20 @@ func encodePOSTV1BmcConfigureImportRequest(
 	return nil
 }
 
+func encodePOSTV1BmcFwupdateRequest(
+	req *BmcFirmwareUpdateBody,
+	r *http.Request,
+) error {
+	const contentType = "application/json"
+	e := new(jx.Encoder)
+	{
+		req.Encode(e)
+	}
+	encoded := e.Bytes()
+	ht.SetBody(r, bytes.NewReader(encoded), contentType)
+	return nil
+}
+
 func encodePOSTV1BmcPowerOsRequest(
 	req *BmcOsPowerBody,
 	r *http.Request,
@@ -193,6 +277,20 @@ func encodePOSTV1BmcPowerOsRequest(
 	return nil
 }
 
+func encodePOSTV1BmcRotatePasswordRequest(
+	req *BmcRotatePasswordBody,
+	r *http.Request,
+) error {
+	const contentType = "application/json"
+	e := new(jx.Encoder)
+	{
+		req.Encode(e)
+	}
+	encoded := e.Bytes()
+	ht.SetBody(r, bytes.NewReader(encoded), contentType)
+	return nil
+}
+
 func encodePOSTV1BmcUpgradeDellInstallfromrepoRequest(
 	req *BmcDellInstallFromRepoRequest,
 	r *http.Request,
@@ -207,6 +305,62 @@ func encodePOSTV1BmcUpgradeDellInstallfromrepoRequest(
 	return nil
 }
 
+func encodePOSTV1BmcVmediaRequest(
+	req *BmcVirtualMediaBody,
+	r *http.Request,
+) error {
+	const contentType = "application/json"
+	e := new(jx.Encoder)
+	{
+		req.Encode(e)
+	}
+	encoded := e.Bytes()
+	ht.SetBody(r, bytes.NewReader(encoded), contentType)
+	return nil
+}
+
+func encodePOSTV1CertsRevokeRequest(
+	req *CertRevokeRequest,
+	r *http.Request,
+) error {
+	const contentType = "application/json"
+	e := new(jx.Encoder)
+	{
+		req.Encode(e)
+	}
+	encoded := e.Bytes()
+	ht.SetBody(r, bytes.NewReader(encoded), contentType)
+	return nil
+}
+
+func encodePOSTV1DNSRecordsRequest(
+	req *DNSRecordAddRequest,
+	r *http.Request,
+) error {
+	const contentType = "application/json"
+	e := new(jx.Encoder)
+	{
+		req.Encode(e)
+	}
+	encoded := e.Bytes()
+	ht.SetBody(r, bytes.NewReader(encoded), contentType)
+	return nil
+}
+
+func encodePOSTV1DbLoadRequest(
+	req *DataLoadRequest,
+	r *http.Request,
+) error {
+	const contentType = "application/json"
+	e := new(jx.Encoder)
+	{
+		req.Encode(e)
+	}
+	encoded := e.Bytes()
+	ht.SetBody(r, bytes.NewReader(encoded), contentType)
+	return nil
+}
+
 func encodePOSTV1DbRestoreRequest(
 	req *DataDump,
 	r *http.Request,
@@ -221,6 +375,34 @@ func encodePOSTV1DbRestoreRequest(
 	return nil
 }
 
+func encodePOSTV1DiscoverBmcAdoptRequest(
+	req *DiscoverBMCAdoptRequest,
+	r *http.Request,
+) error {
+	const contentType = "application/json"
+	e := new(jx.Encoder)
+	{
+		req.Encode(e)
+	}
+	encoded := e.Bytes()
+	ht.SetBody(r, bytes.NewReader(encoded), contentType)
+	return nil
+}
+
+func encodePOSTV1GrendelKeysRotateRequest(
+	req *SigningKeyRotateRequest,
+	r *http.Request,
+) error {
+	const contentType = "application/json"
+	e := new(jx.Encoder)
+	{
+		req.Encode(e)
+	}
+	encoded := e.Bytes()
+	ht.SetBody(r, bytes.NewReader(encoded), contentType)
+	return nil
+}
+
 func encodePOSTV1ImagesRequest(
 	req *BootImageAddRequest,
 	r *http.Request,
@@ -249,6 +431,48 @@ func encodePOSTV1NodesRequest(
 	return nil
 }
 
+func encodePOSTV1NodesNextipRequest(
+	req *NodeNextIPRequest,
+	r *http.Request,
+) error {
+	const contentType = "application/json"
+	e := new(jx.Encoder)
+	{
+		req.Encode(e)
+	}
+	encoded := e.Bytes()
+	ht.SetBody(r, bytes.NewReader(encoded), contentType)
+	return nil
+}
+
+func encodePOSTV1NodesTokenInspectRequest(
+	req *NodeTokenRequest,
+	r *http.Request,
+) error {
+	const contentType = "application/json"
+	e := new(jx.Encoder)
+	{
+		req.Encode(e)
+	}
+	encoded := e.Bytes()
+	ht.SetBody(r, bytes.NewReader(encoded), contentType)
+	return nil
+}
+
+func encodePOSTV1NodesTokenRevokeRequest(
+	req *NodeTokenRequest,
+	r *http.Request,
+) error {
+	const contentType = "application/json"
+	e := new(jx.Encoder)
+	{
+		req.Encode(e)
+	}
+	encoded := e.Bytes()
+	ht.SetBody(r, bytes.NewReader(encoded), contentType)
+	return nil
+}
+
 func encodePOSTV1RolesRequest(
 	req *PostRolesRequest,
 	r *http.Request,
@@ -263,6 +487,20 @@ func encodePOSTV1RolesRequest(
 	return nil
 }
 
+func encodePOSTV1ScheduleRequest(
+	req *ScheduleRequest,
+	r *http.Request,
+) error {
+	const contentType = "application/json"
+	e := new(jx.Encoder)
+	{
+		req.Encode(e)
+	}
+	encoded := e.Bytes()
+	ht.SetBody(r, bytes.NewReader(encoded), contentType)
+	return nil
+}
+
 func encodePOSTV1UsersRequest(
 	req *UserStoreRequest,
 	r *http.Request,
@@ -276,3 +514,73 @@ func encodePOSTV1UsersRequest(
 	ht.SetBody(r, bytes.NewReader(encoded), contentType)
 	return nil
 }
+
+func encodePUTV1GrendelMaintenanceRequest(
+	req *MaintenanceRequest,
+	r *http.Request,
+) error {
+	const contentType = "application/json"
+	e := new(jx.Encoder)
+	{
+		req.Encode(e)
+	}
+	encoded := e.Bytes()
+	ht.SetBody(r, bytes.NewReader(encoded), contentType)
+	return nil
+}
+
+func encodePUTV1NodesClientCertRequest(
+	req *NodeClientCertRequest,
+	r *http.Request,
+) error {
+	const contentType = "application/json"
+	e := new(jx.Encoder)
+	{
+		req.Encode(e)
+	}
+	encoded := e.Bytes()
+	ht.SetBody(r, bytes.NewReader(encoded), contentType)
+	return nil
+}
+
+func encodePUTV1NodesCredentialsRequest(
+	req *NodeCredentialsRequest,
+	r *http.Request,
+) error {
+	const contentType = "application/json"
+	e := new(jx.Encoder)
+	{
+		req.Encode(e)
+	}
+	encoded := e.Bytes()
+	ht.SetBody(r, bytes.NewReader(encoded), contentType)
+	return nil
+}
+
+func encodePUTV1NodesFilesRequest(
+	req *NodeFileRequest,
+	r *http.Request,
+) error {
+	const contentType = "application/json"
+	e := new(jx.Encoder)
+	{
+		req.Encode(e)
+	}
+	encoded := e.Bytes()
+	ht.SetBody(r, bytes.NewReader(encoded), contentType)
+	return nil
+}
+
+func encodePUTV1SecretsNameRequest(
+	req *SecretRequest,
+	r *http.Request,
+) error {
+	const contentType = "application/json"
+	e := new(jx.Encoder)
+	{
+		req.Encode(e)
+	}
+	encoded := e.Bytes()
+	ht.SetBody(r, bytes.NewReader(encoded), contentType)
+	return nil
+}
@@ -4,6 +4,7 @@ package client
 
 import (
 	"fmt"
+	"io"
 	"time"
 
 	"github.com/go-faster/jx"
@@ -13,6 +14,79 @@ func (s *HTTPErrorStatusCode) Error() string {
 	return fmt.Sprintf("code %d: %+v", s.StatusCode, s.Response)
 }
 
+// ArtifactResponse schema.
+// Ref: #/components/schemas/ArtifactResponse
+type ArtifactResponse struct {
+	// Whether the artifact is served as the changes since a revision with since_rev.
+	Delta       OptBool           `json:"delta"`
+	ExcludeTags OptNilStringArray `json:"exclude_tags"`
+	// Export format: hosts, genders or known-hosts.
+	Format  OptString         `json:"format"`
+	Name    OptString         `json:"name"`
+	Nodeset OptNilString      `json:"nodeset"`
+	Tags    OptNilStringArray `json:"tags"`
+}
+
+// GetDelta returns the value of Delta.
+func (s *ArtifactResponse) GetDelta() OptBool {
+	return s.Delta
+}
+
+// GetExcludeTags returns the value of ExcludeTags.
+func (s *ArtifactResponse) GetExcludeTags() OptNilStringArray {
+	return s.ExcludeTags
+}
+
+// GetFormat returns the value of Format.
+func (s *ArtifactResponse) GetFormat() OptString {
+	return s.Format
+}
+
+// GetName returns the value of Name.
+func (s *ArtifactResponse) GetName() OptString {
+	return s.Name
+}
+
+// GetNodeset returns the value of Nodeset.
+func (s *ArtifactResponse) GetNodeset() OptNilString {
+	return s.Nodeset
+}
+
+// GetTags returns the value of Tags.
+func (s *ArtifactResponse) GetTags() OptNilStringArray {
+	return s.Tags
+}
+
+// SetDelta sets the value of Delta.
+func (s *ArtifactResponse) SetDelta(val OptBool) {
+	s.Delta = val
+}
+
+// SetExcludeTags sets the value of ExcludeTags.
+func (s *ArtifactResponse) SetExcludeTags(val OptNilStringArray) {
+	s.ExcludeTags = val
+}
+
+// SetFormat sets the value of Format.
+func (s *ArtifactResponse) SetFormat(val OptString) {
+	s.Format = val
+}
+
+// SetName sets the value of Name.
+func (s *ArtifactResponse) SetName(val OptString) {
+	s.Name = val
+}
+
+// SetNodeset sets the value of Nodeset.
+func (s *ArtifactResponse) SetNodeset(val OptNilString) {
+	s.Nodeset = val
+}
+
+// SetTags sets the value of Tags.
+func (s *ArtifactResponse) SetTags(val OptNilStringArray) {
+	s.Tags = val
+}
+
 // AuthRequest schema.
 // Ref: #/components/schemas/AuthRequest
 type AuthRequest struct {
@@ -164,6 +238,9 @@ func (s *AuthTokenReponse) SetToken(val OptString) {
 type AuthTokenRequest struct {
 	// String parsed by time.ParseDuration, examples include: infinite, 8h, 30m, 20s.
 	Expire OptString `json:"expire"`
+	// Namespaces the token is scoped to, every namespace when empty. Tokens scoped to namespaces only
+	// create tokens scoped to some of them.
+	Namespaces OptNilStringArray `json:"namespaces"`
 	// Type of model.Role, valid options: disabled, user, admin.
 	Role OptString `json:"role"`
 	// Username shown in logs, does not need to be a valid user in the DB.
@@ -175,6 +252,11 @@ func (s *AuthTokenRequest) GetExpire() OptString {
 	return s.Expire
 }
 
+// GetNamespaces returns the value of Namespaces.
+func (s *AuthTokenRequest) GetNamespaces() OptNilStringArray {
+	return s.Namespaces
+}
+
 // GetRole returns the value of Role.
 func (s *AuthTokenRequest) GetRole() OptString {
 	return s.Role
@@ -190,6 +272,11 @@ func (s *AuthTokenRequest) SetExpire(val OptString) {
 	s.Expire = val
 }
 
+// SetNamespaces sets the value of Namespaces.
+func (s *AuthTokenRequest) SetNamespaces(val OptNilStringArray) {
+	s.Namespaces = val
+}
+
 // SetRole sets the value of Role.
 func (s *AuthTokenRequest) SetRole(val OptString) {
 	s.Role = val
@@ -200,6 +287,252 @@ func (s *AuthTokenRequest) SetUsername(val OptString) {
 	s.Username = val
 }
 
+// BiosReport schema.
+// Ref: #/components/schemas/BiosReport
+type BiosReport struct {
+	Attributes     OptNilBiosReportAttributes `json:"attributes"`
+	Deviations     []BiosReportDeviationsItem `json:"deviations"`
+	Host           OptString                  `json:"host"`
+	JobID          OptNilString               `json:"job_id"`
+	Msg            OptString                  `json:"msg"`
+	RebootRequired OptBool                    `json:"reboot_required"`
+	Status         OptString                  `json:"status"`
+}
+
+// GetAttributes returns the value of Attributes.
+func (s *BiosReport) GetAttributes() OptNilBiosReportAttributes {
+	return s.Attributes
+}
+
+// GetDeviations returns the value of Deviations.
+func (s *BiosReport) GetDeviations() []BiosReportDeviationsItem {
+	return s.Deviations
+}
+
+// GetHost returns the value of Host.
+func (s *BiosReport) GetHost() OptString {
+	return s.Host
+}
+
+// GetJobID returns the value of JobID.
+func (s *BiosReport) GetJobID() OptNilString {
+	return s.JobID
+}
+
+// GetMsg returns the value of Msg.
+func (s *BiosReport) GetMsg() OptString {
+	return s.Msg
+}
+
+// GetRebootRequired returns the value of RebootRequired.
+func (s *BiosReport) GetRebootRequired() OptBool {
+	return s.RebootRequired
+}
+
+// GetStatus returns the value of Status.
+func (s *BiosReport) GetStatus() OptString {
+	return s.Status
+}
+
+// SetAttributes sets the value of Attributes.
+func (s *BiosReport) SetAttributes(val OptNilBiosReportAttributes) {
+	s.Attributes = val
+}
+
+// SetDeviations sets the value of Deviations.
+func (s *BiosReport) SetDeviations(val []BiosReportDeviationsItem) {
+	s.Deviations = val
+}
+
+// SetHost sets the value of Host.
+func (s *BiosReport) SetHost(val OptString) {
+	s.Host = val
+}
+
+// SetJobID sets the value of JobID.
+func (s *BiosReport) SetJobID(val OptNilString) {
+	s.JobID = val
+}
+
+// SetMsg sets the value of Msg.
+func (s *BiosReport) SetMsg(val OptString) {
+	s.Msg = val
+}
+
+// SetRebootRequired sets the value of RebootRequired.
+func (s *BiosReport) SetRebootRequired(val OptBool) {
+	s.RebootRequired = val
+}
+
+// SetStatus sets the value of Status.
+func (s *BiosReport) SetStatus(val OptString) {
+	s.Status = val
+}
+
+type BiosReportAttributes map[string]NilString
+
+func (s *BiosReportAttributes) init() BiosReportAttributes {
+	m := *s
+	if m == nil {
+		m = map[string]NilString{}
+		*s = m
+	}
+	return m
+}
+
+type BiosReportDeviationsItem struct {
+	Attribute OptString `json:"attribute"`
+	Current   OptString `json:"current"`
+	Pending   OptBool   `json:"pending"`
+	Profile   OptString `json:"profile"`
+}
+
+// GetAttribute returns the value of Attribute.
+func (s *BiosReportDeviationsItem) GetAttribute() OptString {
+	return s.Attribute
+}
+
+// GetCurrent returns the value of Current.
+func (s *BiosReportDeviationsItem) GetCurrent() OptString {
+	return s.Current
+}
+
+// GetPending returns the value of Pending.
+func (s *BiosReportDeviationsItem) GetPending() OptBool {
+	return s.Pending
+}
+
+// GetProfile returns the value of Profile.
+func (s *BiosReportDeviationsItem) GetProfile() OptString {
+	return s.Profile
+}
+
+// SetAttribute sets the value of Attribute.
+func (s *BiosReportDeviationsItem) SetAttribute(val OptString) {
+	s.Attribute = val
+}
+
+// SetCurrent sets the value of Current.
+func (s *BiosReportDeviationsItem) SetCurrent(val OptString) {
+	s.Current = val
+}
+
+// SetPending sets the value of Pending.
+func (s *BiosReportDeviationsItem) SetPending(val OptBool) {
+	s.Pending = val
+}
+
+// SetProfile sets the value of Profile.
+func (s *BiosReportDeviationsItem) SetProfile(val OptString) {
+	s.Profile = val
+}
+
+// BmcBiosApplyBody schema.
+// Ref: #/components/schemas/BmcBiosApplyBody
+type BmcBiosApplyBody struct {
+	// Number of BMCs contacted at once, defaults to bmc.fanout.
+	Fanout OptNilInt `json:"fanout"`
+	// Name of the BIOS profile in bmc.bios_profiles.
+	Profile OptString `json:"profile"`
+	// Power cycle the nodes requiring a reboot for the settings to take effect.
+	Reboot OptBool `json:"reboot"`
+	// Seconds allowed for each BMC, defaults to bmc.timeout.
+	Timeout OptNilInt `json:"timeout"`
+}
+
+// GetFanout returns the value of Fanout.
+func (s *BmcBiosApplyBody) GetFanout() OptNilInt {
+	return s.Fanout
+}
+
+// GetProfile returns the value of Profile.
+func (s *BmcBiosApplyBody) GetProfile() OptString {
+	return s.Profile
+}
+
+// GetReboot returns the value of Reboot.
+func (s *BmcBiosApplyBody) GetReboot() OptBool {
+	return s.Reboot
+}
+
+// GetTimeout returns the value of Timeout.
+func (s *BmcBiosApplyBody) GetTimeout() OptNilInt {
+	return s.Timeout
+}
+
+// SetFanout sets the value of Fanout.
+func (s *BmcBiosApplyBody) SetFanout(val OptNilInt) {
+	s.Fanout = val
+}
+
+// SetProfile sets the value of Profile.
+func (s *BmcBiosApplyBody) SetProfile(val OptString) {
+	s.Profile = val
+}
+
+// SetReboot sets the value of Reboot.
+func (s *BmcBiosApplyBody) SetReboot(val OptBool) {
+	s.Reboot = val
+}
+
+// SetTimeout sets the value of Timeout.
+func (s *BmcBiosApplyBody) SetTimeout(val OptNilInt) {
+	s.Timeout = val
+}
+
+// BmcBootOverrideBody schema.
+// Ref: #/components/schemas/BmcBootOverrideBody
+type BmcBootOverrideBody struct {
+	// Number of BMCs contacted at once, defaults to bmc.fanout.
+	Fanout OptNilInt `json:"fanout"`
+	// Boot from target on every boot instead of only the next boot.
+	Persistent OptBool `json:"persistent"`
+	// String of type schemas.BootSource. Common options include: Pxe, Hdd, BiosSetup.
+	Target OptString `json:"target"`
+	// Seconds allowed for each BMC, defaults to bmc.timeout.
+	Timeout OptNilInt `json:"timeout"`
+}
+
+// GetFanout returns the value of Fanout.
+func (s *BmcBootOverrideBody) GetFanout() OptNilInt {
+	return s.Fanout
+}
+
+// GetPersistent returns the value of Persistent.
+func (s *BmcBootOverrideBody) GetPersistent() OptBool {
+	return s.Persistent
+}
+
+// GetTarget returns the value of Target.
+func (s *BmcBootOverrideBody) GetTarget() OptString {
+	return s.Target
+}
+
+// GetTimeout returns the value of Timeout.
+func (s *BmcBootOverrideBody) GetTimeout() OptNilInt {
+	return s.Timeout
+}
+
+// SetFanout sets the value of Fanout.
+func (s *BmcBootOverrideBody) SetFanout(val OptNilInt) {
+	s.Fanout = val
+}
+
+// SetPersistent sets the value of Persistent.
+func (s *BmcBootOverrideBody) SetPersistent(val OptBool) {
+	s.Persistent = val
+}
+
+// SetTarget sets the value of Target.
+func (s *BmcBootOverrideBody) SetTarget(val OptString) {
+	s.Target = val
+}
+
+// SetTimeout sets the value of Timeout.
+func (s *BmcBootOverrideBody) SetTimeout(val OptNilInt) {
+	s.Timeout = val
+}
+
 // BmcDellInstallFromRepoRequest schema.
 // Ref: #/components/schemas/BmcDellInstallFromRepoRequest
 type BmcDellInstallFromRepoRequest struct {
@@ -301,6 +634,72 @@ func (s *BmcDellInstallFromRepoRequest) SetShareType(val OptString) {
 	s.ShareType = val
 }
 
+// BmcFirmwareUpdateBody schema.
+// Ref: #/components/schemas/BmcFirmwareUpdateBody
+type BmcFirmwareUpdateBody struct {
+	// Inventory field of the firmware verified after the update: bmc, bios or nic. Defaults to bmc.
+	Component OptString `json:"component"`
+	// Number of BMCs updated at once, defaults to bmc.fanout.
+	Fanout OptNilInt `json:"fanout"`
+	// URL of the firmware image fetched by the BMCs, or the name of an image uploaded to POST
+	// /v1/bmc/fwupdate/image.
+	Image OptString `json:"image"`
+	// Seconds allowed to update each BMC, defaults to bmc.fwupdate_timeout.
+	Timeout OptNilInt `json:"timeout"`
+	// Firmware version the nodes are updated to. Nodes already on it are skipped.
+	Version OptString `json:"version"`
+}
+
+// GetComponent returns the value of Component.
+func (s *BmcFirmwareUpdateBody) GetComponent() OptString {
+	return s.Component
+}
+
+// GetFanout returns the value of Fanout.
+func (s *BmcFirmwareUpdateBody) GetFanout() OptNilInt {
+	return s.Fanout
+}
+
+// GetImage returns the value of Image.
+func (s *BmcFirmwareUpdateBody) GetImage() OptString {
+	return s.Image
+}
+
+// GetTimeout returns the value of Timeout.
+func (s *BmcFirmwareUpdateBody) GetTimeout() OptNilInt {
+	return s.Timeout
+}
+
+// GetVersion returns the value of Version.
+func (s *BmcFirmwareUpdateBody) GetVersion() OptString {
+	return s.Version
+}
+
+// SetComponent sets the value of Component.
+func (s *BmcFirmwareUpdateBody) SetComponent(val OptString) {
+	s.Component = val
+}
+
+// SetFanout sets the value of Fanout.
+func (s *BmcFirmwareUpdateBody) SetFanout(val OptNilInt) {
+	s.Fanout = val
+}
+
+// SetImage sets the value of Image.
+func (s *BmcFirmwareUpdateBody) SetImage(val OptString) {
+	s.Image = val
+}
+
+// SetTimeout sets the value of Timeout.
+func (s *BmcFirmwareUpdateBody) SetTimeout(val OptNilInt) {
+	s.Timeout = val
+}
+
+// SetVersion sets the value of Version.
+func (s *BmcFirmwareUpdateBody) SetVersion(val OptString) {
+	s.Version = val
+}
+
 // BmcImportConfigurationRequest schema.
 // Ref: #/components/schemas/BmcImportConfigurationRequest
 type BmcImportConfigurationRequest struct {
@@ -365,9 +764,13 @@ type BmcOsPowerBody struct {
 	// String of type schemas.BootSourceOverrideTarget. Common options include: None, Pxe, BiosSetup,
 	// Utilities, Diags.
 	BootOption OptString `json:"boot_option"`
+	// Number of BMCs contacted at once, defaults to bmc.fanout.
+	Fanout OptNilInt `json:"fanout"`
 	// String of type schemas.ResetType. Common options include: On, ForceOn, ForceOff, ForceRestart,
 	// GracefulRestart, GracefulShutdown, PowerCycle.
 	PowerOption OptString `json:"power_option"`
+	// Seconds allowed for each BMC, defaults to bmc.timeout.
+	Timeout OptNilInt `json:"timeout"`
 }
 
 // GetBootOption returns the value of BootOption.
@@ -375,32 +778,163 @@ func (s *BmcOsPowerBody) GetBootOption() OptString {
 	return s.BootOption
 }
 
+// GetFanout returns the value of Fanout.
+func (s *BmcOsPowerBody) GetFanout() OptNilInt {
+	return s.Fanout
+}
+
 // GetPowerOption returns the value of PowerOption.
 func (s *BmcOsPowerBody) GetPowerOption() OptString {
 	return s.PowerOption
 }
 
+// GetTimeout returns the value of Timeout.
+func (s *BmcOsPowerBody) GetTimeout() OptNilInt {
+	return s.Timeout
+}
+
 // SetBootOption sets the value of BootOption.
 func (s *BmcOsPowerBody) SetBootOption(val OptString) {
 	s.BootOption = val
 }
 
+// SetFanout sets the value of Fanout.
+func (s *BmcOsPowerBody) SetFanout(val OptNilInt) {
+	s.Fanout = val
+}
+
 // SetPowerOption sets the value of PowerOption.
 func (s *BmcOsPowerBody) SetPowerOption(val OptString) {
 	s.PowerOption = val
 }
 
+// SetTimeout sets the value of Timeout.
+func (s *BmcOsPowerBody) SetTimeout(val OptNilInt) {
+	s.Timeout = val
+}
+
+// BmcRotatePasswordBody schema.
+// Ref: #/components/schemas/BmcRotatePasswordBody
+type BmcRotatePasswordBody struct {
+	// Number of BMCs contacted at once, defaults to bmc.fanout.
+	Fanout OptNilInt `json:"fanout"`
+	// Length of the generated passwords, defaults to 20.
+	Length OptNilInt `json:"length"`
+	// Seconds allowed for each BMC login, defaults to bmc.timeout.
+	Timeout OptNilInt `json:"timeout"`
+}
+
+// GetFanout returns the value of Fanout.
+func (s *BmcRotatePasswordBody) GetFanout() OptNilInt {
+	return s.Fanout
+}
+
+// GetLength returns the value of Length.
+func (s *BmcRotatePasswordBody) GetLength() OptNilInt {
+	return s.Length
+}
+
+// GetTimeout returns the value of Timeout.
+func (s *BmcRotatePasswordBody) GetTimeout() OptNilInt {
+	return s.Timeout
+}
+
+// SetFanout sets the value of Fanout.
+func (s *BmcRotatePasswordBody) SetFanout(val OptNilInt) {
+	s.Fanout = val
+}
+
+// SetLength sets the value of Length.
+func (s *BmcRotatePasswordBody) SetLength(val OptNilInt) {
+	s.Length = val
+}
+
+// SetTimeout sets the value of Timeout.
+func (s *BmcRotatePasswordBody) SetTimeout(val OptNilInt) {
+	s.Timeout = val
+}
+
+// BmcVirtualMediaBody schema.
+// Ref: #/components/schemas/BmcVirtualMediaBody
+type BmcVirtualMediaBody struct {
+	// Boot from the virtual CD on the next boot.
+	Boot OptBool `json:"boot"`
+	// Number of BMCs contacted at once, defaults to bmc.fanout.
+	Fanout OptNilInt `json:"fanout"`
+	// URI of the ISO image to insert.
+	Image OptString `json:"image"`
+	// Power cycle the node once the image is inserted.
+	Reboot OptBool `json:"reboot"`
+	// Seconds allowed for each BMC, defaults to bmc.timeout.
+	Timeout OptNilInt `json:"timeout"`
+}
+
+// GetBoot returns the value of Boot.
+func (s *BmcVirtualMediaBody) GetBoot() OptBool {
+	return s.Boot
+}
+
+// GetFanout returns the value of Fanout.
+func (s *BmcVirtualMediaBody) GetFanout() OptNilInt {
+	return s.Fanout
+}
+
+// GetImage returns the value of Image.
+func (s *BmcVirtualMediaBody) GetImage() OptString {
+	return s.Image
+}
+
+// GetReboot returns the value of Reboot.
+func (s *BmcVirtualMediaBody) GetReboot() OptBool {
+	return s.Reboot
+}
+
+// GetTimeout returns the value of Timeout.
+func (s *BmcVirtualMediaBody) GetTimeout() OptNilInt {
+	return s.Timeout
+}
+
+// SetBoot sets the value of Boot.
+func (s *BmcVirtualMediaBody) SetBoot(val OptBool) {
+	s.Boot = val
+}
+
+// SetFanout sets the value of Fanout.
+func (s *BmcVirtualMediaBody) SetFanout(val OptNilInt) {
+	s.Fanout = val
+}
+
+// SetImage sets the value of Image.
+func (s *BmcVirtualMediaBody) SetImage(val OptString) {
+	s.Image = val
+}
+
+// SetReboot sets the value of Reboot.
+func (s *BmcVirtualMediaBody) SetReboot(val OptBool) {
+	s.Reboot = val
+}
+
+// SetTimeout sets the value of Timeout.
+func (s *BmcVirtualMediaBody) SetTimeout(val OptNilInt) {
+	s.Timeout = val
+}
+
 // BootImage schema.
 // Ref: #/components/schemas/BootImage
 type BootImage struct {
-	Cmdline            OptString                         `json:"cmdline"`
-	ID                 OptNilInt64                       `json:"id"`
+	Cmdline   OptString      `json:"cmdline"`
+	CreatedAt OptNilDateTime `json:"created_at"`
+	ID        OptNilInt64    `json:"id"`
+	// Name of the parent image the unset fields are inherited from.
+	Inherits           OptNilString                      `json:"inherits"`
 	Initrd             []string                          `json:"initrd"`
-	Kernel             string                            `json:"kernel"`
+	Kernel             OptString                         `json:"kernel"`
 	Liveimg            OptString                         `json:"liveimg"`
 	Name               string                            `json:"name"`
 	ProvisionTemplates OptNilBootImageProvisionTemplates `json:"provision_templates"`
+	Revision           OptNilInt64                       `json:"revision"`
 	UID                OptNilString                      `json:"uid"`
+	UpdatedAt          OptNilDateTime                    `json:"updated_at"`
 	Verify             OptBool                           `json:"verify"`
 }
 
@@ -409,18 +943,28 @@ func (s *BootImage) GetCmdline() OptString {
 	return s.Cmdline
 }
 
+// GetCreatedAt returns the value of CreatedAt.
+func (s *BootImage) GetCreatedAt() OptNilDateTime {
+	return s.CreatedAt
+}
+
 // GetID returns the value of ID.
 func (s *BootImage) GetID() OptNilInt64 {
 	return s.ID
 }
 
+// GetInherits returns the value of Inherits.
+func (s *BootImage) GetInherits() OptNilString {
+	return s.Inherits
+}
+
 // GetInitrd returns the value of Initrd.
 func (s *BootImage) GetInitrd() []string {
 	return s.Initrd
 }
 
 // GetKernel returns the value of Kernel.
-func (s *BootImage) GetKernel() string {
+func (s *BootImage) GetKernel() OptString {
 	return s.Kernel
 }
 
@@ -439,11 +983,21 @@ func (s *BootImage) GetProvisionTemplates() OptNilBootImageProvisionTemplates {
 	return s.ProvisionTemplates
 }
 
+// GetRevision returns the value of Revision.
+func (s *BootImage) GetRevision() OptNilInt64 {
+	return s.Revision
+}
+
 // GetUID returns the value of UID.
 func (s *BootImage) GetUID() OptNilString {
 	return s.UID
 }
 
+// GetUpdatedAt returns the value of UpdatedAt.
+func (s *BootImage) GetUpdatedAt() OptNilDateTime {
+	return s.UpdatedAt
+}
+
 // GetVerify returns the value of Verify.
 func (s *BootImage) GetVerify() OptBool {
 	return s.Verify
@@ -454,18 +1008,28 @@ func (s *BootImage) SetCmdline(val OptString) {
 	s.Cmdline = val
 }
 
+// SetCreatedAt sets the value of CreatedAt.
+func (s *BootImage) SetCreatedAt(val OptNilDateTime) {
+	s.CreatedAt = val
+}
+
 // SetID sets the value of ID.
 func (s *BootImage) SetID(val OptNilInt64) {
 	s.ID = val
 }
 
+// SetInherits sets the value of Inherits.
+func (s *BootImage) SetInherits(val OptNilString) {
+	s.Inherits = val
+}
+
 // SetInitrd sets the value of Initrd.
 func (s *BootImage) SetInitrd(val []string) {
 	s.Initrd = val
 }
 
 // SetKernel sets the value of Kernel.
-func (s *BootImage) SetKernel(val string) {
+func (s *BootImage) SetKernel(val OptString) {
 	s.Kernel = val
 }
 
@@ -484,11 +1048,21 @@ func (s *BootImage) SetProvisionTemplates(val OptNilBootImageProvisionTemplates)
 	s.ProvisionTemplates = val
 }
 
+// SetRevision sets the value of Revision.
+func (s *BootImage) SetRevision(val OptNilInt64) {
+	s.Revision = val
+}
+
 // SetUID sets the value of UID.
 func (s *BootImage) SetUID(val OptNilString) {
 	s.UID = val
 }
 
+// SetUpdatedAt sets the value of UpdatedAt.
+func (s *BootImage) SetUpdatedAt(val OptNilDateTime) {
+	s.UpdatedAt = val
+}
+
 // SetVerify sets the value of Verify.
 func (s *BootImage) SetVerify(val OptBool) {
 	s.Verify = val
@@ -512,13 +1086,17 @@ func (s *BootImageAddRequest) SetBootImages(val []NilBootImageAddRequestBootImag
 
 type BootImageAddRequestBootImagesItem struct {
 	Cmdline            OptString                                                 `json:"cmdline"`
+	CreatedAt          OptNilDateTime                                            `json:"created_at"`
 	ID                 OptNilInt64                                               `json:"id"`
+	Inherits           OptString                                                 `json:"inherits"`
 	Initrd             []string                                                  `json:"initrd"`
 	Kernel             OptString                                                 `json:"kernel"`
 	Liveimg            OptString                                                 `json:"liveimg"`
 	Name               OptString                                                 `json:"name"`
 	ProvisionTemplates OptNilBootImageAddRequestBootImagesItemProvisionTemplates `json:"provision_templates"`
+	Revision           OptNilInt64                                               `json:"revision"`
 	UID                OptNilString                                              `json:"uid"`
+	UpdatedAt          OptNilDateTime                                            `json:"updated_at"`
 	Verify             OptBool                                                   `json:"verify"`
 }
 
@@ -527,11 +1105,21 @@ func (s *BootImageAddRequestBootImagesItem) GetCmdline() OptString {
 	return s.Cmdline
 }
 
+// GetCreatedAt returns the value of CreatedAt.
+func (s *BootImageAddRequestBootImagesItem) GetCreatedAt() OptNilDateTime {
+	return s.CreatedAt
+}
+
 // GetID returns the value of ID.
 func (s *BootImageAddRequestBootImagesItem) GetID() OptNilInt64 {
 	return s.ID
 }
 
+// GetInherits returns the value of Inherits.
+func (s *BootImageAddRequestBootImagesItem) GetInherits() OptString {
+	return s.Inherits
+}
+
 // GetInitrd returns the value of Initrd.
 func (s *BootImageAddRequestBootImagesItem) GetInitrd() []string {
 	return s.Initrd
@@ -557,12 +1145,22 @@ func (s *BootImageAddRequestBootImagesItem) GetProvisionTemplates() OptNilBootIm
 	return s.ProvisionTemplates
 }
 
+// GetRevision returns the value of Revision.
+func (s *BootImageAddRequestBootImagesItem) GetRevision() OptNilInt64 {
+	return s.Revision
+}
+
 // GetUID returns the value of UID.
 func (s *BootImageAddRequestBootImagesItem) GetUID() OptNilString {
 	return s.UID
 }
 
-// GetVerify returns the value of Verify.
+// GetUpdatedAt returns the value of UpdatedAt.
+func (s *BootImageAddRequestBootImagesItem) GetUpdatedAt() OptNilDateTime {
+	return s.UpdatedAt
+}
+
+// GetVerify returns the value of Verify.
 func (s *BootImageAddRequestBootImagesItem) GetVerify() OptBool {
 	return s.Verify
 }
@@ -572,11 +1170,21 @@ func (s *BootImageAddRequestBootImagesItem) SetCmdline(val OptString) {
 	s.Cmdline = val
 }
 
+// SetCreatedAt sets the value of CreatedAt.
+func (s *BootImageAddRequestBootImagesItem) SetCreatedAt(val OptNilDateTime) {
+	s.CreatedAt = val
+}
+
 // SetID sets the value of ID.
 func (s *BootImageAddRequestBootImagesItem) SetID(val OptNilInt64) {
 	s.ID = val
 }
 
+// SetInherits sets the value of Inherits.
+func (s *BootImageAddRequestBootImagesItem) SetInherits(val OptString) {
+	s.Inherits = val
+}
+
 // SetInitrd sets the value of Initrd.
 func (s *BootImageAddRequestBootImagesItem) SetInitrd(val []string) {
 	s.Initrd = val
@@ -602,11 +1210,21 @@ func (s *BootImageAddRequestBootImagesItem) SetProvisionTemplates(val OptNilBoot
 	s.ProvisionTemplates = val
 }
 
+// SetRevision sets the value of Revision.
+func (s *BootImageAddRequestBootImagesItem) SetRevision(val OptNilInt64) {
+	s.Revision = val
+}
+
 // SetUID sets the value of UID.
 func (s *BootImageAddRequestBootImagesItem) SetUID(val OptNilString) {
 	s.UID = val
 }
 
+// SetUpdatedAt sets the value of UpdatedAt.
+func (s *BootImageAddRequestBootImagesItem) SetUpdatedAt(val OptNilDateTime) {
+	s.UpdatedAt = val
+}
+
 // SetVerify sets the value of Verify.
 func (s *BootImageAddRequestBootImagesItem) SetVerify(val OptBool) {
 	s.Verify = val
@@ -634,5227 +1252,17949 @@ func (s *BootImageProvisionTemplates) init() BootImageProvisionTemplates {
 	return m
 }
 
-type CookieAuth struct {
-	Token string
+// BootTokenInfo schema.
+// Ref: #/components/schemas/BootTokenInfo
+type BootTokenInfo struct {
+	Expired   OptBool     `json:"expired"`
+	ExpiresAt OptDateTime `json:"expires_at"`
+	HostID    OptString   `json:"host_id"`
+	HostName  OptString   `json:"host_name"`
+	ID        OptString   `json:"id"`
+	IssuedAt  OptDateTime `json:"issued_at"`
+	MAC       OptString   `json:"mac"`
+	Revoked   OptBool     `json:"revoked"`
 }
 
-// GetToken returns the value of Token.
-func (s *CookieAuth) GetToken() string {
-	return s.Token
+// GetExpired returns the value of Expired.
+func (s *BootTokenInfo) GetExpired() OptBool {
+	return s.Expired
 }
 
-// SetToken sets the value of Token.
-func (s *CookieAuth) SetToken(val string) {
-	s.Token = val
+// GetExpiresAt returns the value of ExpiresAt.
+func (s *BootTokenInfo) GetExpiresAt() OptDateTime {
+	return s.ExpiresAt
 }
 
-// DataDump schema.
-// Ref: #/components/schemas/DataDump
-type DataDump struct {
-	Hosts  []NilDataDumpHostsItem  `json:"Hosts"`
-	Images []NilDataDumpImagesItem `json:"Images"`
-	Users  []DataDumpUsersItem     `json:"Users"`
+// GetHostID returns the value of HostID.
+func (s *BootTokenInfo) GetHostID() OptString {
+	return s.HostID
 }
 
-// GetHosts returns the value of Hosts.
-func (s *DataDump) GetHosts() []NilDataDumpHostsItem {
-	return s.Hosts
+// GetHostName returns the value of HostName.
+func (s *BootTokenInfo) GetHostName() OptString {
+	return s.HostName
 }
 
-// GetImages returns the value of Images.
-func (s *DataDump) GetImages() []NilDataDumpImagesItem {
-	return s.Images
+// GetID returns the value of ID.
+func (s *BootTokenInfo) GetID() OptString {
+	return s.ID
 }
 
-// GetUsers returns the value of Users.
-func (s *DataDump) GetUsers() []DataDumpUsersItem {
-	return s.Users
+// GetIssuedAt returns the value of IssuedAt.
+func (s *BootTokenInfo) GetIssuedAt() OptDateTime {
+	return s.IssuedAt
 }
 
-// SetHosts sets the value of Hosts.
-func (s *DataDump) SetHosts(val []NilDataDumpHostsItem) {
-	s.Hosts = val
+// GetMAC returns the value of MAC.
+func (s *BootTokenInfo) GetMAC() OptString {
+	return s.MAC
 }
 
-// SetImages sets the value of Images.
-func (s *DataDump) SetImages(val []NilDataDumpImagesItem) {
-	s.Images = val
+// GetRevoked returns the value of Revoked.
+func (s *BootTokenInfo) GetRevoked() OptBool {
+	return s.Revoked
 }
 
-// SetUsers sets the value of Users.
-func (s *DataDump) SetUsers(val []DataDumpUsersItem) {
-	s.Users = val
+// SetExpired sets the value of Expired.
+func (s *BootTokenInfo) SetExpired(val OptBool) {
+	s.Expired = val
 }
 
-type DataDumpHostsItem struct {
-	Bonds      []NilDataDumpHostsItemBondsItem      `json:"bonds"`
-	BootImage  OptString                            `json:"boot_image"`
-	Firmware   OptString                            `json:"firmware"`
-	ID         OptNilInt64                          `json:"id"`
-	Interfaces []NilDataDumpHostsItemInterfacesItem `json:"interfaces"`
-	Name       OptString                            `json:"name"`
-	Provision  OptBool                              `json:"provision"`
-	Tags       OptNilStringArray                    `json:"tags"`
-	UID        OptNilString                         `json:"uid"`
+// SetExpiresAt sets the value of ExpiresAt.
+func (s *BootTokenInfo) SetExpiresAt(val OptDateTime) {
+	s.ExpiresAt = val
 }
 
-// GetBonds returns the value of Bonds.
-func (s *DataDumpHostsItem) GetBonds() []NilDataDumpHostsItemBondsItem {
-	return s.Bonds
+// SetHostID sets the value of HostID.
+func (s *BootTokenInfo) SetHostID(val OptString) {
+	s.HostID = val
 }
 
-// GetBootImage returns the value of BootImage.
-func (s *DataDumpHostsItem) GetBootImage() OptString {
-	return s.BootImage
+// SetHostName sets the value of HostName.
+func (s *BootTokenInfo) SetHostName(val OptString) {
+	s.HostName = val
 }
 
-// GetFirmware returns the value of Firmware.
-func (s *DataDumpHostsItem) GetFirmware() OptString {
-	return s.Firmware
+// SetID sets the value of ID.
+func (s *BootTokenInfo) SetID(val OptString) {
+	s.ID = val
 }
 
-// GetID returns the value of ID.
-func (s *DataDumpHostsItem) GetID() OptNilInt64 {
-	return s.ID
+// SetIssuedAt sets the value of IssuedAt.
+func (s *BootTokenInfo) SetIssuedAt(val OptDateTime) {
+	s.IssuedAt = val
 }
 
-// GetInterfaces returns the value of Interfaces.
-func (s *DataDumpHostsItem) GetInterfaces() []NilDataDumpHostsItemInterfacesItem {
-	return s.Interfaces
+// SetMAC sets the value of MAC.
+func (s *BootTokenInfo) SetMAC(val OptString) {
+	s.MAC = val
 }
 
-// GetName returns the value of Name.
-func (s *DataDumpHostsItem) GetName() OptString {
-	return s.Name
+// SetRevoked sets the value of Revoked.
+func (s *BootTokenInfo) SetRevoked(val OptBool) {
+	s.Revoked = val
 }
 
-// GetProvision returns the value of Provision.
-func (s *DataDumpHostsItem) GetProvision() OptBool {
-	return s.Provision
+// CertRevokeRequest schema.
+// Ref: #/components/schemas/CertRevokeRequest
+type CertRevokeRequest struct {
+	// Revoke the client certificates of the nodes, nodes without one are skipped.
+	Nodeset OptString `json:"nodeset"`
+	// Serial numbers in hex of the certificates to revoke.
+	Serials []string `json:"serials"`
 }
 
-// GetTags returns the value of Tags.
-func (s *DataDumpHostsItem) GetTags() OptNilStringArray {
-	return s.Tags
+// GetNodeset returns the value of Nodeset.
+func (s *CertRevokeRequest) GetNodeset() OptString {
+	return s.Nodeset
 }
 
-// GetUID returns the value of UID.
-func (s *DataDumpHostsItem) GetUID() OptNilString {
-	return s.UID
+// GetSerials returns the value of Serials.
+func (s *CertRevokeRequest) GetSerials() []string {
+	return s.Serials
 }
 
-// SetBonds sets the value of Bonds.
-func (s *DataDumpHostsItem) SetBonds(val []NilDataDumpHostsItemBondsItem) {
-	s.Bonds = val
+// SetNodeset sets the value of Nodeset.
+func (s *CertRevokeRequest) SetNodeset(val OptString) {
+	s.Nodeset = val
 }
 
-// SetBootImage sets the value of BootImage.
-func (s *DataDumpHostsItem) SetBootImage(val OptString) {
-	s.BootImage = val
+// SetSerials sets the value of Serials.
+func (s *CertRevokeRequest) SetSerials(val []string) {
+	s.Serials = val
 }
 
-// SetFirmware sets the value of Firmware.
-func (s *DataDumpHostsItem) SetFirmware(val OptString) {
-	s.Firmware = val
+// ChangeFeed schema.
+// Ref: #/components/schemas/ChangeFeed
+type ChangeFeed struct {
+	Changes   []NilChangeFeedChangesItem `json:"changes"`
+	LatestSeq OptInt64                   `json:"latest_seq"`
+	OldestSeq OptInt64                   `json:"oldest_seq"`
+	Truncated OptBool                    `json:"truncated"`
 }
 
-// SetID sets the value of ID.
-func (s *DataDumpHostsItem) SetID(val OptNilInt64) {
-	s.ID = val
+// GetChanges returns the value of Changes.
+func (s *ChangeFeed) GetChanges() []NilChangeFeedChangesItem {
+	return s.Changes
 }
 
-// SetInterfaces sets the value of Interfaces.
-func (s *DataDumpHostsItem) SetInterfaces(val []NilDataDumpHostsItemInterfacesItem) {
-	s.Interfaces = val
+// GetLatestSeq returns the value of LatestSeq.
+func (s *ChangeFeed) GetLatestSeq() OptInt64 {
+	return s.LatestSeq
 }
 
-// SetName sets the value of Name.
-func (s *DataDumpHostsItem) SetName(val OptString) {
-	s.Name = val
+// GetOldestSeq returns the value of OldestSeq.
+func (s *ChangeFeed) GetOldestSeq() OptInt64 {
+	return s.OldestSeq
 }
 
-// SetProvision sets the value of Provision.
-func (s *DataDumpHostsItem) SetProvision(val OptBool) {
-	s.Provision = val
+// GetTruncated returns the value of Truncated.
+func (s *ChangeFeed) GetTruncated() OptBool {
+	return s.Truncated
 }
 
-// SetTags sets the value of Tags.
-func (s *DataDumpHostsItem) SetTags(val OptNilStringArray) {
-	s.Tags = val
+// SetChanges sets the value of Changes.
+func (s *ChangeFeed) SetChanges(val []NilChangeFeedChangesItem) {
+	s.Changes = val
 }
 
-// SetUID sets the value of UID.
-func (s *DataDumpHostsItem) SetUID(val OptNilString) {
-	s.UID = val
+// SetLatestSeq sets the value of LatestSeq.
+func (s *ChangeFeed) SetLatestSeq(val OptInt64) {
+	s.LatestSeq = val
 }
 
-type DataDumpHostsItemBondsItem struct {
-	Bmc    OptBool     `json:"bmc"`
-	Fqdn   OptString   `json:"fqdn"`
-	ID     OptNilInt64 `json:"id"`
-	Ifname OptString   `json:"ifname"`
-	IP     OptString   `json:"ip"`
-	MAC    OptString   `json:"mac"`
-	Mtu    OptInt      `json:"mtu"`
-	Peers  []string    `json:"peers"`
-	Vlan   OptString   `json:"vlan"`
+// SetOldestSeq sets the value of OldestSeq.
+func (s *ChangeFeed) SetOldestSeq(val OptInt64) {
+	s.OldestSeq = val
 }
 
-// GetBmc returns the value of Bmc.
-func (s *DataDumpHostsItemBondsItem) GetBmc() OptBool {
-	return s.Bmc
+// SetTruncated sets the value of Truncated.
+func (s *ChangeFeed) SetTruncated(val OptBool) {
+	s.Truncated = val
 }
 
-// GetFqdn returns the value of Fqdn.
-func (s *DataDumpHostsItemBondsItem) GetFqdn() OptString {
-	return s.Fqdn
+type ChangeFeedChangesItem struct {
+	ChangedAt OptDateTime                     `json:"changed_at"`
+	Diff      OptNilChangeFeedChangesItemDiff `json:"diff"`
+	Kind      OptString                       `json:"kind"`
+	Name      OptString                       `json:"name"`
+	Op        OptString                       `json:"op"`
+	Seq       OptInt64                        `json:"seq"`
 }
 
-// GetID returns the value of ID.
-func (s *DataDumpHostsItemBondsItem) GetID() OptNilInt64 {
-	return s.ID
+// GetChangedAt returns the value of ChangedAt.
+func (s *ChangeFeedChangesItem) GetChangedAt() OptDateTime {
+	return s.ChangedAt
 }
 
-// GetIfname returns the value of Ifname.
-func (s *DataDumpHostsItemBondsItem) GetIfname() OptString {
-	return s.Ifname
+// GetDiff returns the value of Diff.
+func (s *ChangeFeedChangesItem) GetDiff() OptNilChangeFeedChangesItemDiff {
+	return s.Diff
 }
 
-// GetIP returns the value of IP.
-func (s *DataDumpHostsItemBondsItem) GetIP() OptString {
-	return s.IP
+// GetKind returns the value of Kind.
+func (s *ChangeFeedChangesItem) GetKind() OptString {
+	return s.Kind
 }
 
-// GetMAC returns the value of MAC.
-func (s *DataDumpHostsItemBondsItem) GetMAC() OptString {
-	return s.MAC
+// GetName returns the value of Name.
+func (s *ChangeFeedChangesItem) GetName() OptString {
+	return s.Name
 }
 
-// GetMtu returns the value of Mtu.
-func (s *DataDumpHostsItemBondsItem) GetMtu() OptInt {
-	return s.Mtu
+// GetOp returns the value of Op.
+func (s *ChangeFeedChangesItem) GetOp() OptString {
+	return s.Op
 }
 
-// GetPeers returns the value of Peers.
-func (s *DataDumpHostsItemBondsItem) GetPeers() []string {
-	return s.Peers
+// GetSeq returns the value of Seq.
+func (s *ChangeFeedChangesItem) GetSeq() OptInt64 {
+	return s.Seq
 }
 
-// GetVlan returns the value of Vlan.
-func (s *DataDumpHostsItemBondsItem) GetVlan() OptString {
-	return s.Vlan
+// SetChangedAt sets the value of ChangedAt.
+func (s *ChangeFeedChangesItem) SetChangedAt(val OptDateTime) {
+	s.ChangedAt = val
 }
 
-// SetBmc sets the value of Bmc.
-func (s *DataDumpHostsItemBondsItem) SetBmc(val OptBool) {
-	s.Bmc = val
+// SetDiff sets the value of Diff.
+func (s *ChangeFeedChangesItem) SetDiff(val OptNilChangeFeedChangesItemDiff) {
+	s.Diff = val
 }
 
-// SetFqdn sets the value of Fqdn.
-func (s *DataDumpHostsItemBondsItem) SetFqdn(val OptString) {
-	s.Fqdn = val
+// SetKind sets the value of Kind.
+func (s *ChangeFeedChangesItem) SetKind(val OptString) {
+	s.Kind = val
 }
 
-// SetID sets the value of ID.
-func (s *DataDumpHostsItemBondsItem) SetID(val OptNilInt64) {
-	s.ID = val
+// SetName sets the value of Name.
+func (s *ChangeFeedChangesItem) SetName(val OptString) {
+	s.Name = val
 }
 
-// SetIfname sets the value of Ifname.
-func (s *DataDumpHostsItemBondsItem) SetIfname(val OptString) {
-	s.Ifname = val
+// SetOp sets the value of Op.
+func (s *ChangeFeedChangesItem) SetOp(val OptString) {
+	s.Op = val
 }
 
-// SetIP sets the value of IP.
-func (s *DataDumpHostsItemBondsItem) SetIP(val OptString) {
-	s.IP = val
+// SetSeq sets the value of Seq.
+func (s *ChangeFeedChangesItem) SetSeq(val OptInt64) {
+	s.Seq = val
 }
 
-// SetMAC sets the value of MAC.
-func (s *DataDumpHostsItemBondsItem) SetMAC(val OptString) {
-	s.MAC = val
+type ChangeFeedChangesItemDiff map[string]NilChangeFeedChangesItemDiffItem
+
+func (s *ChangeFeedChangesItemDiff) init() ChangeFeedChangesItemDiff {
+	m := *s
+	if m == nil {
+		m = map[string]NilChangeFeedChangesItemDiffItem{}
+		*s = m
+	}
+	return m
 }
 
-// SetMtu sets the value of Mtu.
-func (s *DataDumpHostsItemBondsItem) SetMtu(val OptInt) {
-	s.Mtu = val
+type ChangeFeedChangesItemDiffItem struct {
+	New OptString `json:"new"`
+	Old OptString `json:"old"`
 }
 
-// SetPeers sets the value of Peers.
-func (s *DataDumpHostsItemBondsItem) SetPeers(val []string) {
-	s.Peers = val
+// GetNew returns the value of New.
+func (s *ChangeFeedChangesItemDiffItem) GetNew() OptString {
+	return s.New
 }
 
-// SetVlan sets the value of Vlan.
-func (s *DataDumpHostsItemBondsItem) SetVlan(val OptString) {
-	s.Vlan = val
+// GetOld returns the value of Old.
+func (s *ChangeFeedChangesItemDiffItem) GetOld() OptString {
+	return s.Old
 }
 
-type DataDumpHostsItemInterfacesItem struct {
-	Bmc    OptBool     `json:"bmc"`
-	Fqdn   OptString   `json:"fqdn"`
-	ID     OptNilInt64 `json:"id"`
-	Ifname OptString   `json:"ifname"`
-	IP     OptString   `json:"ip"`
-	MAC    OptString   `json:"mac"`
-	Mtu    OptInt      `json:"mtu"`
-	Vlan   OptString   `json:"vlan"`
+// SetNew sets the value of New.
+func (s *ChangeFeedChangesItemDiffItem) SetNew(val OptString) {
+	s.New = val
 }
 
-// GetBmc returns the value of Bmc.
-func (s *DataDumpHostsItemInterfacesItem) GetBmc() OptBool {
-	return s.Bmc
+// SetOld sets the value of Old.
+func (s *ChangeFeedChangesItemDiffItem) SetOld(val OptString) {
+	s.Old = val
 }
 
-// GetFqdn returns the value of Fqdn.
-func (s *DataDumpHostsItemInterfacesItem) GetFqdn() OptString {
-	return s.Fqdn
+type CookieAuth struct {
+	Token string
 }
 
-// GetID returns the value of ID.
-func (s *DataDumpHostsItemInterfacesItem) GetID() OptNilInt64 {
-	return s.ID
+// GetToken returns the value of Token.
+func (s *CookieAuth) GetToken() string {
+	return s.Token
 }
 
-// GetIfname returns the value of Ifname.
-func (s *DataDumpHostsItemInterfacesItem) GetIfname() OptString {
-	return s.Ifname
+// SetToken sets the value of Token.
+func (s *CookieAuth) SetToken(val string) {
+	s.Token = val
 }
 
-// GetIP returns the value of IP.
-func (s *DataDumpHostsItemInterfacesItem) GetIP() OptString {
-	return s.IP
+// Credential schema.
+// Ref: #/components/schemas/Credential
+type Credential struct {
+	Kind   string       `json:"kind"`
+	Name   string       `json:"name"`
+	Secret OptNilString `json:"secret"`
 }
 
-// GetMAC returns the value of MAC.
-func (s *DataDumpHostsItemInterfacesItem) GetMAC() OptString {
-	return s.MAC
+// GetKind returns the value of Kind.
+func (s *Credential) GetKind() string {
+	return s.Kind
 }
 
-// GetMtu returns the value of Mtu.
-func (s *DataDumpHostsItemInterfacesItem) GetMtu() OptInt {
-	return s.Mtu
+// GetName returns the value of Name.
+func (s *Credential) GetName() string {
+	return s.Name
 }
 
-// GetVlan returns the value of Vlan.
-func (s *DataDumpHostsItemInterfacesItem) GetVlan() OptString {
-	return s.Vlan
+// GetSecret returns the value of Secret.
+func (s *Credential) GetSecret() OptNilString {
+	return s.Secret
 }
 
-// SetBmc sets the value of Bmc.
-func (s *DataDumpHostsItemInterfacesItem) SetBmc(val OptBool) {
-	s.Bmc = val
+// SetKind sets the value of Kind.
+func (s *Credential) SetKind(val string) {
+	s.Kind = val
 }
 
-// SetFqdn sets the value of Fqdn.
-func (s *DataDumpHostsItemInterfacesItem) SetFqdn(val OptString) {
-	s.Fqdn = val
+// SetName sets the value of Name.
+func (s *Credential) SetName(val string) {
+	s.Name = val
 }
 
-// SetID sets the value of ID.
-func (s *DataDumpHostsItemInterfacesItem) SetID(val OptNilInt64) {
-	s.ID = val
+// SetSecret sets the value of Secret.
+func (s *Credential) SetSecret(val OptNilString) {
+	s.Secret = val
 }
 
-// SetIfname sets the value of Ifname.
-func (s *DataDumpHostsItemInterfacesItem) SetIfname(val OptString) {
-	s.Ifname = val
+// DNSRecordAddRequest schema.
+// Ref: #/components/schemas/DNSRecordAddRequest
+type DNSRecordAddRequest struct {
+	Records []NilDNSRecordAddRequestRecordsItem `json:"records"`
 }
 
-// SetIP sets the value of IP.
-func (s *DataDumpHostsItemInterfacesItem) SetIP(val OptString) {
-	s.IP = val
+// GetRecords returns the value of Records.
+func (s *DNSRecordAddRequest) GetRecords() []NilDNSRecordAddRequestRecordsItem {
+	return s.Records
 }
 
-// SetMAC sets the value of MAC.
-func (s *DataDumpHostsItemInterfacesItem) SetMAC(val OptString) {
-	s.MAC = val
+// SetRecords sets the value of Records.
+func (s *DNSRecordAddRequest) SetRecords(val []NilDNSRecordAddRequestRecordsItem) {
+	s.Records = val
 }
 
-// SetMtu sets the value of Mtu.
-func (s *DataDumpHostsItemInterfacesItem) SetMtu(val OptInt) {
-	s.Mtu = val
+type DNSRecordAddRequestRecordsItem struct {
+	ID    OptNilInt64 `json:"id"`
+	Name  OptString   `json:"name"`
+	Ptr   OptBool     `json:"ptr"`
+	TTL   OptInt64    `json:"ttl"`
+	Type  OptString   `json:"type"`
+	Value OptString   `json:"value"`
 }
 
-// SetVlan sets the value of Vlan.
-func (s *DataDumpHostsItemInterfacesItem) SetVlan(val OptString) {
-	s.Vlan = val
+// GetID returns the value of ID.
+func (s *DNSRecordAddRequestRecordsItem) GetID() OptNilInt64 {
+	return s.ID
 }
 
-type DataDumpImagesItem struct {
-	Cmdline            OptString                                  `json:"cmdline"`
-	ID                 OptNilInt64                                `json:"id"`
-	Initrd             []string                                   `json:"initrd"`
-	Kernel             OptString                                  `json:"kernel"`
-	Liveimg            OptString                                  `json:"liveimg"`
-	Name               OptString                                  `json:"name"`
-	ProvisionTemplates OptNilDataDumpImagesItemProvisionTemplates `json:"provision_templates"`
-	UID                OptNilString                               `json:"uid"`
-	Verify             OptBool                                    `json:"verify"`
+// GetName returns the value of Name.
+func (s *DNSRecordAddRequestRecordsItem) GetName() OptString {
+	return s.Name
 }
 
-// GetCmdline returns the value of Cmdline.
-func (s *DataDumpImagesItem) GetCmdline() OptString {
-	return s.Cmdline
+// GetPtr returns the value of Ptr.
+func (s *DNSRecordAddRequestRecordsItem) GetPtr() OptBool {
+	return s.Ptr
 }
 
-// GetID returns the value of ID.
-func (s *DataDumpImagesItem) GetID() OptNilInt64 {
-	return s.ID
+// GetTTL returns the value of TTL.
+func (s *DNSRecordAddRequestRecordsItem) GetTTL() OptInt64 {
+	return s.TTL
 }
 
-// GetInitrd returns the value of Initrd.
-func (s *DataDumpImagesItem) GetInitrd() []string {
-	return s.Initrd
+// GetType returns the value of Type.
+func (s *DNSRecordAddRequestRecordsItem) GetType() OptString {
+	return s.Type
 }
 
-// GetKernel returns the value of Kernel.
-func (s *DataDumpImagesItem) GetKernel() OptString {
-	return s.Kernel
+// GetValue returns the value of Value.
+func (s *DNSRecordAddRequestRecordsItem) GetValue() OptString {
+	return s.Value
 }
 
-// GetLiveimg returns the value of Liveimg.
-func (s *DataDumpImagesItem) GetLiveimg() OptString {
-	return s.Liveimg
+// SetID sets the value of ID.
+func (s *DNSRecordAddRequestRecordsItem) SetID(val OptNilInt64) {
+	s.ID = val
 }
 
-// GetName returns the value of Name.
-func (s *DataDumpImagesItem) GetName() OptString {
-	return s.Name
+// SetName sets the value of Name.
+func (s *DNSRecordAddRequestRecordsItem) SetName(val OptString) {
+	s.Name = val
 }
 
-// GetProvisionTemplates returns the value of ProvisionTemplates.
-func (s *DataDumpImagesItem) GetProvisionTemplates() OptNilDataDumpImagesItemProvisionTemplates {
-	return s.ProvisionTemplates
+// SetPtr sets the value of Ptr.
+func (s *DNSRecordAddRequestRecordsItem) SetPtr(val OptBool) {
+	s.Ptr = val
 }
 
-// GetUID returns the value of UID.
-func (s *DataDumpImagesItem) GetUID() OptNilString {
-	return s.UID
+// SetTTL sets the value of TTL.
+func (s *DNSRecordAddRequestRecordsItem) SetTTL(val OptInt64) {
+	s.TTL = val
 }
 
-// GetVerify returns the value of Verify.
-func (s *DataDumpImagesItem) GetVerify() OptBool {
-	return s.Verify
+// SetType sets the value of Type.
+func (s *DNSRecordAddRequestRecordsItem) SetType(val OptString) {
+	s.Type = val
 }
 
-// SetCmdline sets the value of Cmdline.
-func (s *DataDumpImagesItem) SetCmdline(val OptString) {
-	s.Cmdline = val
+// SetValue sets the value of Value.
+func (s *DNSRecordAddRequestRecordsItem) SetValue(val OptString) {
+	s.Value = val
 }
 
-// SetID sets the value of ID.
-func (s *DataDumpImagesItem) SetID(val OptNilInt64) {
-	s.ID = val
+// DataDump schema.
+// Ref: #/components/schemas/DataDump
+type DataDump struct {
+	Credentials OptNilNilDataDumpCredentialsItemArray `json:"Credentials"`
+	DNSRecords  []NilDataDumpDNSRecordsItem           `json:"DNSRecords"`
+	Hosts       []NilDataDumpHostsItem                `json:"Hosts"`
+	Images      []NilDataDumpImagesItem               `json:"Images"`
+	Users       []DataDumpUsersItem                   `json:"Users"`
 }
 
-// SetInitrd sets the value of Initrd.
-func (s *DataDumpImagesItem) SetInitrd(val []string) {
-	s.Initrd = val
+// GetCredentials returns the value of Credentials.
+func (s *DataDump) GetCredentials() OptNilNilDataDumpCredentialsItemArray {
+	return s.Credentials
 }
 
-// SetKernel sets the value of Kernel.
-func (s *DataDumpImagesItem) SetKernel(val OptString) {
-	s.Kernel = val
+// GetDNSRecords returns the value of DNSRecords.
+func (s *DataDump) GetDNSRecords() []NilDataDumpDNSRecordsItem {
+	return s.DNSRecords
 }
 
-// SetLiveimg sets the value of Liveimg.
-func (s *DataDumpImagesItem) SetLiveimg(val OptString) {
-	s.Liveimg = val
+// GetHosts returns the value of Hosts.
+func (s *DataDump) GetHosts() []NilDataDumpHostsItem {
+	return s.Hosts
 }
 
-// SetName sets the value of Name.
-func (s *DataDumpImagesItem) SetName(val OptString) {
-	s.Name = val
+// GetImages returns the value of Images.
+func (s *DataDump) GetImages() []NilDataDumpImagesItem {
+	return s.Images
 }
 
-// SetProvisionTemplates sets the value of ProvisionTemplates.
-func (s *DataDumpImagesItem) SetProvisionTemplates(val OptNilDataDumpImagesItemProvisionTemplates) {
-	s.ProvisionTemplates = val
+// GetUsers returns the value of Users.
+func (s *DataDump) GetUsers() []DataDumpUsersItem {
+	return s.Users
 }
 
-// SetUID sets the value of UID.
-func (s *DataDumpImagesItem) SetUID(val OptNilString) {
-	s.UID = val
+// SetCredentials sets the value of Credentials.
+func (s *DataDump) SetCredentials(val OptNilNilDataDumpCredentialsItemArray) {
+	s.Credentials = val
 }
 
-// SetVerify sets the value of Verify.
-func (s *DataDumpImagesItem) SetVerify(val OptBool) {
-	s.Verify = val
+// SetDNSRecords sets the value of DNSRecords.
+func (s *DataDump) SetDNSRecords(val []NilDataDumpDNSRecordsItem) {
+	s.DNSRecords = val
 }
 
-type DataDumpImagesItemProvisionTemplates map[string]NilString
-
-func (s *DataDumpImagesItemProvisionTemplates) init() DataDumpImagesItemProvisionTemplates {
-	m := *s
-	if m == nil {
-		m = map[string]NilString{}
-		*s = m
-	}
-	return m
+// SetHosts sets the value of Hosts.
+func (s *DataDump) SetHosts(val []NilDataDumpHostsItem) {
+	s.Hosts = val
 }
 
-type DataDumpUsersItem struct {
-	CreatedAt  OptDateTime `json:"created_at"`
-	Enabled    OptBool     `json:"enabled"`
-	Hash       OptString   `json:"hash"`
-	ID         OptInt64    `json:"id"`
-	ModifiedAt OptDateTime `json:"modified_at"`
-	Role       OptString   `json:"role"`
-	Username   OptString   `json:"username"`
+// SetImages sets the value of Images.
+func (s *DataDump) SetImages(val []NilDataDumpImagesItem) {
+	s.Images = val
 }
 
-// GetCreatedAt returns the value of CreatedAt.
-func (s *DataDumpUsersItem) GetCreatedAt() OptDateTime {
-	return s.CreatedAt
+// SetUsers sets the value of Users.
+func (s *DataDump) SetUsers(val []DataDumpUsersItem) {
+	s.Users = val
 }
 
-// GetEnabled returns the value of Enabled.
-func (s *DataDumpUsersItem) GetEnabled() OptBool {
-	return s.Enabled
+type DataDumpCredentialsItem struct {
+	Kind   OptString    `json:"kind"`
+	Name   OptString    `json:"name"`
+	Secret OptNilString `json:"secret"`
 }
 
-// GetHash returns the value of Hash.
-func (s *DataDumpUsersItem) GetHash() OptString {
-	return s.Hash
+// GetKind returns the value of Kind.
+func (s *DataDumpCredentialsItem) GetKind() OptString {
+	return s.Kind
 }
 
-// GetID returns the value of ID.
-func (s *DataDumpUsersItem) GetID() OptInt64 {
-	return s.ID
+// GetName returns the value of Name.
+func (s *DataDumpCredentialsItem) GetName() OptString {
+	return s.Name
 }
 
-// GetModifiedAt returns the value of ModifiedAt.
-func (s *DataDumpUsersItem) GetModifiedAt() OptDateTime {
-	return s.ModifiedAt
+// GetSecret returns the value of Secret.
+func (s *DataDumpCredentialsItem) GetSecret() OptNilString {
+	return s.Secret
 }
 
-// GetRole returns the value of Role.
-func (s *DataDumpUsersItem) GetRole() OptString {
-	return s.Role
+// SetKind sets the value of Kind.
+func (s *DataDumpCredentialsItem) SetKind(val OptString) {
+	s.Kind = val
 }
 
-// GetUsername returns the value of Username.
-func (s *DataDumpUsersItem) GetUsername() OptString {
-	return s.Username
+// SetName sets the value of Name.
+func (s *DataDumpCredentialsItem) SetName(val OptString) {
+	s.Name = val
 }
 
-// SetCreatedAt sets the value of CreatedAt.
-func (s *DataDumpUsersItem) SetCreatedAt(val OptDateTime) {
-	s.CreatedAt = val
+// SetSecret sets the value of Secret.
+func (s *DataDumpCredentialsItem) SetSecret(val OptNilString) {
+	s.Secret = val
 }
 
-// SetEnabled sets the value of Enabled.
-func (s *DataDumpUsersItem) SetEnabled(val OptBool) {
-	s.Enabled = val
+type DataDumpDNSRecordsItem struct {
+	ID    OptNilInt64 `json:"id"`
+	Name  OptString   `json:"name"`
+	Ptr   OptBool     `json:"ptr"`
+	TTL   OptInt64    `json:"ttl"`
+	Type  OptString   `json:"type"`
+	Value OptString   `json:"value"`
 }
 
-// SetHash sets the value of Hash.
-func (s *DataDumpUsersItem) SetHash(val OptString) {
-	s.Hash = val
+// GetID returns the value of ID.
+func (s *DataDumpDNSRecordsItem) GetID() OptNilInt64 {
+	return s.ID
 }
 
-// SetID sets the value of ID.
-func (s *DataDumpUsersItem) SetID(val OptInt64) {
-	s.ID = val
+// GetName returns the value of Name.
+func (s *DataDumpDNSRecordsItem) GetName() OptString {
+	return s.Name
 }
 
-// SetModifiedAt sets the value of ModifiedAt.
-func (s *DataDumpUsersItem) SetModifiedAt(val OptDateTime) {
-	s.ModifiedAt = val
+// GetPtr returns the value of Ptr.
+func (s *DataDumpDNSRecordsItem) GetPtr() OptBool {
+	return s.Ptr
 }
 
-// SetRole sets the value of Role.
-func (s *DataDumpUsersItem) SetRole(val OptString) {
-	s.Role = val
+// GetTTL returns the value of TTL.
+func (s *DataDumpDNSRecordsItem) GetTTL() OptInt64 {
+	return s.TTL
 }
 
-// SetUsername sets the value of Username.
-func (s *DataDumpUsersItem) SetUsername(val OptString) {
-	s.Username = val
+// GetType returns the value of Type.
+func (s *DataDumpDNSRecordsItem) GetType() OptString {
+	return s.Type
 }
 
-// Event schema.
-// Ref: #/components/schemas/Event
-type Event struct {
-	JobMessages []EventJobMessagesItem `json:"JobMessages"`
-	Message     OptString              `json:"Message"`
-	Severity    OptString              `json:"Severity"`
-	Time        OptDateTime            `json:"Time"`
-	User        OptString              `json:"User"`
+// GetValue returns the value of Value.
+func (s *DataDumpDNSRecordsItem) GetValue() OptString {
+	return s.Value
 }
 
-// GetJobMessages returns the value of JobMessages.
-func (s *Event) GetJobMessages() []EventJobMessagesItem {
-	return s.JobMessages
+// SetID sets the value of ID.
+func (s *DataDumpDNSRecordsItem) SetID(val OptNilInt64) {
+	s.ID = val
 }
 
-// GetMessage returns the value of Message.
-func (s *Event) GetMessage() OptString {
-	return s.Message
+// SetName sets the value of Name.
+func (s *DataDumpDNSRecordsItem) SetName(val OptString) {
+	s.Name = val
 }
 
-// GetSeverity returns the value of Severity.
-func (s *Event) GetSeverity() OptString {
-	return s.Severity
+// SetPtr sets the value of Ptr.
+func (s *DataDumpDNSRecordsItem) SetPtr(val OptBool) {
+	s.Ptr = val
 }
 
-// GetTime returns the value of Time.
-func (s *Event) GetTime() OptDateTime {
-	return s.Time
+// SetTTL sets the value of TTL.
+func (s *DataDumpDNSRecordsItem) SetTTL(val OptInt64) {
+	s.TTL = val
 }
 
-// GetUser returns the value of User.
-func (s *Event) GetUser() OptString {
-	return s.User
+// SetType sets the value of Type.
+func (s *DataDumpDNSRecordsItem) SetType(val OptString) {
+	s.Type = val
 }
 
-// SetJobMessages sets the value of JobMessages.
-func (s *Event) SetJobMessages(val []EventJobMessagesItem) {
-	s.JobMessages = val
+// SetValue sets the value of Value.
+func (s *DataDumpDNSRecordsItem) SetValue(val OptString) {
+	s.Value = val
 }
 
-// SetMessage sets the value of Message.
-func (s *Event) SetMessage(val OptString) {
-	s.Message = val
+type DataDumpHostsItem struct {
+	Bonds                 []NilDataDumpHostsItemBondsItem      `json:"bonds"`
+	BootImage             OptString                            `json:"boot_image"`
+	ClientCertFingerprint OptString                            `json:"client_cert_fingerprint"`
+	ClientCertSerial      OptString                            `json:"client_cert_serial"`
+	CreatedAt             OptNilDateTime                       `json:"created_at"`
+	Firmware              OptString                            `json:"firmware"`
+	Hardware              OptNilDataDumpHostsItemHardware      `json:"hardware"`
+	ID                    OptNilInt64                          `json:"id"`
+	Interfaces            []NilDataDumpHostsItemInterfacesItem `json:"interfaces"`
+	Inventory             OptNilDataDumpHostsItemInventory     `json:"inventory"`
+	Name                  OptString                            `json:"name"`
+	Provision             OptBool                              `json:"provision"`
+	Revision              OptNilInt64                          `json:"revision"`
+	SmbiosUUID            OptString                            `json:"smbios_uuid"`
+	State                 OptString                            `json:"state"`
+	Tags                  OptNilStringArray                    `json:"tags"`
+	UID                   OptNilString                         `json:"uid"`
+	UpdatedAt             OptNilDateTime                       `json:"updated_at"`
 }
 
-// SetSeverity sets the value of Severity.
-func (s *Event) SetSeverity(val OptString) {
-	s.Severity = val
+// GetBonds returns the value of Bonds.
+func (s *DataDumpHostsItem) GetBonds() []NilDataDumpHostsItemBondsItem {
+	return s.Bonds
 }
 
-// SetTime sets the value of Time.
-func (s *Event) SetTime(val OptDateTime) {
-	s.Time = val
+// GetBootImage returns the value of BootImage.
+func (s *DataDumpHostsItem) GetBootImage() OptString {
+	return s.BootImage
 }
 
-// SetUser sets the value of User.
-func (s *Event) SetUser(val OptString) {
-	s.User = val
+// GetClientCertFingerprint returns the value of ClientCertFingerprint.
+func (s *DataDumpHostsItem) GetClientCertFingerprint() OptString {
+	return s.ClientCertFingerprint
 }
 
-type EventJobMessagesItem struct {
-	Data         OptString                           `json:"data"`
-	Host         OptString                           `json:"host"`
-	Msg          OptString                           `json:"msg"`
-	RedfishError OptEventJobMessagesItemRedfishError `json:"redfish_error"`
-	Status       OptString                           `json:"status"`
+// GetClientCertSerial returns the value of ClientCertSerial.
+func (s *DataDumpHostsItem) GetClientCertSerial() OptString {
+	return s.ClientCertSerial
 }
 
-// GetData returns the value of Data.
-func (s *EventJobMessagesItem) GetData() OptString {
-	return s.Data
+// GetCreatedAt returns the value of CreatedAt.
+func (s *DataDumpHostsItem) GetCreatedAt() OptNilDateTime {
+	return s.CreatedAt
 }
 
-// GetHost returns the value of Host.
-func (s *EventJobMessagesItem) GetHost() OptString {
-	return s.Host
+// GetFirmware returns the value of Firmware.
+func (s *DataDumpHostsItem) GetFirmware() OptString {
+	return s.Firmware
 }
 
-// GetMsg returns the value of Msg.
-func (s *EventJobMessagesItem) GetMsg() OptString {
-	return s.Msg
+// GetHardware returns the value of Hardware.
+func (s *DataDumpHostsItem) GetHardware() OptNilDataDumpHostsItemHardware {
+	return s.Hardware
 }
 
-// GetRedfishError returns the value of RedfishError.
-func (s *EventJobMessagesItem) GetRedfishError() OptEventJobMessagesItemRedfishError {
-	return s.RedfishError
+// GetID returns the value of ID.
+func (s *DataDumpHostsItem) GetID() OptNilInt64 {
+	return s.ID
 }
 
-// GetStatus returns the value of Status.
-func (s *EventJobMessagesItem) GetStatus() OptString {
-	return s.Status
+// GetInterfaces returns the value of Interfaces.
+func (s *DataDumpHostsItem) GetInterfaces() []NilDataDumpHostsItemInterfacesItem {
+	return s.Interfaces
 }
 
-// SetData sets the value of Data.
-func (s *EventJobMessagesItem) SetData(val OptString) {
-	s.Data = val
+// GetInventory returns the value of Inventory.
+func (s *DataDumpHostsItem) GetInventory() OptNilDataDumpHostsItemInventory {
+	return s.Inventory
 }
 
-// SetHost sets the value of Host.
-func (s *EventJobMessagesItem) SetHost(val OptString) {
-	s.Host = val
+// GetName returns the value of Name.
+func (s *DataDumpHostsItem) GetName() OptString {
+	return s.Name
 }
 
-// SetMsg sets the value of Msg.
-func (s *EventJobMessagesItem) SetMsg(val OptString) {
-	s.Msg = val
+// GetProvision returns the value of Provision.
+func (s *DataDumpHostsItem) GetProvision() OptBool {
+	return s.Provision
 }
 
-// SetRedfishError sets the value of RedfishError.
-func (s *EventJobMessagesItem) SetRedfishError(val OptEventJobMessagesItemRedfishError) {
-	s.RedfishError = val
+// GetRevision returns the value of Revision.
+func (s *DataDumpHostsItem) GetRevision() OptNilInt64 {
+	return s.Revision
 }
 
-// SetStatus sets the value of Status.
-func (s *EventJobMessagesItem) SetStatus(val OptString) {
-	s.Status = val
+// GetSmbiosUUID returns the value of SmbiosUUID.
+func (s *DataDumpHostsItem) GetSmbiosUUID() OptString {
+	return s.SmbiosUUID
 }
 
-type EventJobMessagesItemRedfishError struct {
-	Code  OptString                                `json:"code"`
-	Error OptEventJobMessagesItemRedfishErrorError `json:"error"`
+// GetState returns the value of State.
+func (s *DataDumpHostsItem) GetState() OptString {
+	return s.State
 }
 
-// GetCode returns the value of Code.
-func (s *EventJobMessagesItemRedfishError) GetCode() OptString {
-	return s.Code
+// GetTags returns the value of Tags.
+func (s *DataDumpHostsItem) GetTags() OptNilStringArray {
+	return s.Tags
 }
 
-// GetError returns the value of Error.
-func (s *EventJobMessagesItemRedfishError) GetError() OptEventJobMessagesItemRedfishErrorError {
-	return s.Error
+// GetUID returns the value of UID.
+func (s *DataDumpHostsItem) GetUID() OptNilString {
+	return s.UID
 }
 
-// SetCode sets the value of Code.
-func (s *EventJobMessagesItemRedfishError) SetCode(val OptString) {
-	s.Code = val
+// GetUpdatedAt returns the value of UpdatedAt.
+func (s *DataDumpHostsItem) GetUpdatedAt() OptNilDateTime {
+	return s.UpdatedAt
 }
 
-// SetError sets the value of Error.
-func (s *EventJobMessagesItemRedfishError) SetError(val OptEventJobMessagesItemRedfishErrorError) {
-	s.Error = val
+// SetBonds sets the value of Bonds.
+func (s *DataDumpHostsItem) SetBonds(val []NilDataDumpHostsItemBondsItem) {
+	s.Bonds = val
 }
 
-type EventJobMessagesItemRedfishErrorError struct {
-	MessageDotExtendedInfo []EventJobMessagesItemRedfishErrorErrorMessageDotExtendedInfoItem `json:"@Message.ExtendedInfo"`
-	Code                   OptString                                                         `json:"code"`
-	Message                OptString                                                         `json:"message"`
+// SetBootImage sets the value of BootImage.
+func (s *DataDumpHostsItem) SetBootImage(val OptString) {
+	s.BootImage = val
 }
 
-// GetMessageDotExtendedInfo returns the value of MessageDotExtendedInfo.
-func (s *EventJobMessagesItemRedfishErrorError) GetMessageDotExtendedInfo() []EventJobMessagesItemRedfishErrorErrorMessageDotExtendedInfoItem {
-	return s.MessageDotExtendedInfo
+// SetClientCertFingerprint sets the value of ClientCertFingerprint.
+func (s *DataDumpHostsItem) SetClientCertFingerprint(val OptString) {
+	s.ClientCertFingerprint = val
 }
 
-// GetCode returns the value of Code.
-func (s *EventJobMessagesItemRedfishErrorError) GetCode() OptString {
-	return s.Code
+// SetClientCertSerial sets the value of ClientCertSerial.
+func (s *DataDumpHostsItem) SetClientCertSerial(val OptString) {
+	s.ClientCertSerial = val
 }
 
-// GetMessage returns the value of Message.
-func (s *EventJobMessagesItemRedfishErrorError) GetMessage() OptString {
-	return s.Message
+// SetCreatedAt sets the value of CreatedAt.
+func (s *DataDumpHostsItem) SetCreatedAt(val OptNilDateTime) {
+	s.CreatedAt = val
 }
 
-// SetMessageDotExtendedInfo sets the value of MessageDotExtendedInfo.
-func (s *EventJobMessagesItemRedfishErrorError) SetMessageDotExtendedInfo(val []EventJobMessagesItemRedfishErrorErrorMessageDotExtendedInfoItem) {
-	s.MessageDotExtendedInfo = val
+// SetFirmware sets the value of Firmware.
+func (s *DataDumpHostsItem) SetFirmware(val OptString) {
+	s.Firmware = val
 }
 
-// SetCode sets the value of Code.
-func (s *EventJobMessagesItemRedfishErrorError) SetCode(val OptString) {
-	s.Code = val
+// SetHardware sets the value of Hardware.
+func (s *DataDumpHostsItem) SetHardware(val OptNilDataDumpHostsItemHardware) {
+	s.Hardware = val
 }
 
-// SetMessage sets the value of Message.
-func (s *EventJobMessagesItemRedfishErrorError) SetMessage(val OptString) {
-	s.Message = val
+// SetID sets the value of ID.
+func (s *DataDumpHostsItem) SetID(val OptNilInt64) {
+	s.ID = val
 }
 
-type EventJobMessagesItemRedfishErrorErrorMessageDotExtendedInfoItem struct {
-	Message                           OptString `json:"Message"`
-	MessageArgsDotOdataDotCount       OptInt    `json:"MessageArgs.@odata.count"`
-	MessageId                         OptString `json:"MessageId"`
-	RelatedPropertiesDotOdataDotCount OptInt    `json:"RelatedProperties.@odata.count"`
-	Resolution                        OptString `json:"Resolution"`
-	Severity                          OptString `json:"Severity"`
+// SetInterfaces sets the value of Interfaces.
+func (s *DataDumpHostsItem) SetInterfaces(val []NilDataDumpHostsItemInterfacesItem) {
+	s.Interfaces = val
 }
 
-// GetMessage returns the value of Message.
-func (s *EventJobMessagesItemRedfishErrorErrorMessageDotExtendedInfoItem) GetMessage() OptString {
-	return s.Message
+// SetInventory sets the value of Inventory.
+func (s *DataDumpHostsItem) SetInventory(val OptNilDataDumpHostsItemInventory) {
+	s.Inventory = val
 }
 
-// GetMessageArgsDotOdataDotCount returns the value of MessageArgsDotOdataDotCount.
-func (s *EventJobMessagesItemRedfishErrorErrorMessageDotExtendedInfoItem) GetMessageArgsDotOdataDotCount() OptInt {
-	return s.MessageArgsDotOdataDotCount
+// SetName sets the value of Name.
+func (s *DataDumpHostsItem) SetName(val OptString) {
+	s.Name = val
 }
 
-// GetMessageId returns the value of MessageId.
-func (s *EventJobMessagesItemRedfishErrorErrorMessageDotExtendedInfoItem) GetMessageId() OptString {
-	return s.MessageId
+// SetProvision sets the value of Provision.
+func (s *DataDumpHostsItem) SetProvision(val OptBool) {
+	s.Provision = val
 }
 
-// GetRelatedPropertiesDotOdataDotCount returns the value of RelatedPropertiesDotOdataDotCount.
-func (s *EventJobMessagesItemRedfishErrorErrorMessageDotExtendedInfoItem) GetRelatedPropertiesDotOdataDotCount() OptInt {
-	return s.RelatedPropertiesDotOdataDotCount
+// SetRevision sets the value of Revision.
+func (s *DataDumpHostsItem) SetRevision(val OptNilInt64) {
+	s.Revision = val
 }
 
-// GetResolution returns the value of Resolution.
-func (s *EventJobMessagesItemRedfishErrorErrorMessageDotExtendedInfoItem) GetResolution() OptString {
-	return s.Resolution
+// SetSmbiosUUID sets the value of SmbiosUUID.
+func (s *DataDumpHostsItem) SetSmbiosUUID(val OptString) {
+	s.SmbiosUUID = val
 }
 
-// GetSeverity returns the value of Severity.
-func (s *EventJobMessagesItemRedfishErrorErrorMessageDotExtendedInfoItem) GetSeverity() OptString {
-	return s.Severity
+// SetState sets the value of State.
+func (s *DataDumpHostsItem) SetState(val OptString) {
+	s.State = val
 }
 
-// SetMessage sets the value of Message.
-func (s *EventJobMessagesItemRedfishErrorErrorMessageDotExtendedInfoItem) SetMessage(val OptString) {
-	s.Message = val
+// SetTags sets the value of Tags.
+func (s *DataDumpHostsItem) SetTags(val OptNilStringArray) {
+	s.Tags = val
 }
 
-// SetMessageArgsDotOdataDotCount sets the value of MessageArgsDotOdataDotCount.
-func (s *EventJobMessagesItemRedfishErrorErrorMessageDotExtendedInfoItem) SetMessageArgsDotOdataDotCount(val OptInt) {
-	s.MessageArgsDotOdataDotCount = val
+// SetUID sets the value of UID.
+func (s *DataDumpHostsItem) SetUID(val OptNilString) {
+	s.UID = val
 }
 
-// SetMessageId sets the value of MessageId.
-func (s *EventJobMessagesItemRedfishErrorErrorMessageDotExtendedInfoItem) SetMessageId(val OptString) {
-	s.MessageId = val
+// SetUpdatedAt sets the value of UpdatedAt.
+func (s *DataDumpHostsItem) SetUpdatedAt(val OptNilDateTime) {
+	s.UpdatedAt = val
 }
 
-// SetRelatedPropertiesDotOdataDotCount sets the value of RelatedPropertiesDotOdataDotCount.
-func (s *EventJobMessagesItemRedfishErrorErrorMessageDotExtendedInfoItem) SetRelatedPropertiesDotOdataDotCount(val OptInt) {
-	s.RelatedPropertiesDotOdataDotCount = val
+type DataDumpHostsItemBondsItem struct {
+	Addresses []DataDumpHostsItemBondsItemAddressesItem `json:"addresses"`
+	Bmc       OptBool                                   `json:"bmc"`
+	Fqdn      OptString                                 `json:"fqdn"`
+	ID        OptNilInt64                               `json:"id"`
+	Ifname    OptString                                 `json:"ifname"`
+	IP        OptString                                 `json:"ip"`
+	MAC       OptString                                 `json:"mac"`
+	Mode      OptString                                 `json:"mode"`
+	Mtu       OptInt                                    `json:"mtu"`
+	Options   OptDataDumpHostsItemBondsItemOptions      `json:"options"`
+	Parent    OptString                                 `json:"parent"`
+	Peers     []string                                  `json:"peers"`
+	Port      OptInt                                    `json:"port"`
+	Switch    OptString                                 `json:"switch"`
+	Type      OptString                                 `json:"type"`
+	Vlan      OptInt                                    `json:"vlan"`
+}
+
+// GetAddresses returns the value of Addresses.
+func (s *DataDumpHostsItemBondsItem) GetAddresses() []DataDumpHostsItemBondsItemAddressesItem {
+	return s.Addresses
 }
 
-// SetResolution sets the value of Resolution.
-func (s *EventJobMessagesItemRedfishErrorErrorMessageDotExtendedInfoItem) SetResolution(val OptString) {
-	s.Resolution = val
+// GetBmc returns the value of Bmc.
+func (s *DataDumpHostsItemBondsItem) GetBmc() OptBool {
+	return s.Bmc
 }
 
-// SetSeverity sets the value of Severity.
-func (s *EventJobMessagesItemRedfishErrorErrorMessageDotExtendedInfoItem) SetSeverity(val OptString) {
-	s.Severity = val
+// GetFqdn returns the value of Fqdn.
+func (s *DataDumpHostsItemBondsItem) GetFqdn() OptString {
+	return s.Fqdn
 }
 
-// GenericResponse schema.
-// Ref: #/components/schemas/GenericResponse
-type GenericResponse struct {
-	Changed OptInt    `json:"changed"`
-	Detail  OptString `json:"detail"`
-	Title   OptString `json:"title"`
+// GetID returns the value of ID.
+func (s *DataDumpHostsItemBondsItem) GetID() OptNilInt64 {
+	return s.ID
 }
 
-// GetChanged returns the value of Changed.
-func (s *GenericResponse) GetChanged() OptInt {
-	return s.Changed
+// GetIfname returns the value of Ifname.
+func (s *DataDumpHostsItemBondsItem) GetIfname() OptString {
+	return s.Ifname
 }
 
-// GetDetail returns the value of Detail.
-func (s *GenericResponse) GetDetail() OptString {
-	return s.Detail
+// GetIP returns the value of IP.
+func (s *DataDumpHostsItemBondsItem) GetIP() OptString {
+	return s.IP
 }
 
-// GetTitle returns the value of Title.
-func (s *GenericResponse) GetTitle() OptString {
-	return s.Title
+// GetMAC returns the value of MAC.
+func (s *DataDumpHostsItemBondsItem) GetMAC() OptString {
+	return s.MAC
 }
 
-// SetChanged sets the value of Changed.
-func (s *GenericResponse) SetChanged(val OptInt) {
-	s.Changed = val
+// GetMode returns the value of Mode.
+func (s *DataDumpHostsItemBondsItem) GetMode() OptString {
+	return s.Mode
 }
 
-// SetDetail sets the value of Detail.
-func (s *GenericResponse) SetDetail(val OptString) {
-	s.Detail = val
+// GetMtu returns the value of Mtu.
+func (s *DataDumpHostsItemBondsItem) GetMtu() OptInt {
+	return s.Mtu
 }
 
-// SetTitle sets the value of Title.
-func (s *GenericResponse) SetTitle(val OptString) {
-	s.Title = val
+// GetOptions returns the value of Options.
+func (s *DataDumpHostsItemBondsItem) GetOptions() OptDataDumpHostsItemBondsItemOptions {
+	return s.Options
 }
 
-// GetRolesResponse schema.
-// Ref: #/components/schemas/GetRolesResponse
-type GetRolesResponse struct {
-	Roles []GetRolesResponseRolesItem `json:"roles"`
+// GetParent returns the value of Parent.
+func (s *DataDumpHostsItemBondsItem) GetParent() OptString {
+	return s.Parent
 }
 
-// GetRoles returns the value of Roles.
-func (s *GetRolesResponse) GetRoles() []GetRolesResponseRolesItem {
-	return s.Roles
+// GetPeers returns the value of Peers.
+func (s *DataDumpHostsItemBondsItem) GetPeers() []string {
+	return s.Peers
 }
 
-// SetRoles sets the value of Roles.
-func (s *GetRolesResponse) SetRoles(val []GetRolesResponseRolesItem) {
-	s.Roles = val
+// GetPort returns the value of Port.
+func (s *DataDumpHostsItemBondsItem) GetPort() OptInt {
+	return s.Port
 }
 
-type GetRolesResponseRolesItem struct {
-	Name                     OptString                                               `json:"name"`
-	PermissionList           []GetRolesResponseRolesItemPermissionListItem           `json:"permission_list"`
-	UnassignedPermissionList []GetRolesResponseRolesItemUnassignedPermissionListItem `json:"unassigned_permission_list"`
+// GetSwitch returns the value of Switch.
+func (s *DataDumpHostsItemBondsItem) GetSwitch() OptString {
+	return s.Switch
 }
 
-// GetName returns the value of Name.
-func (s *GetRolesResponseRolesItem) GetName() OptString {
-	return s.Name
+// GetType returns the value of Type.
+func (s *DataDumpHostsItemBondsItem) GetType() OptString {
+	return s.Type
 }
 
-// GetPermissionList returns the value of PermissionList.
-func (s *GetRolesResponseRolesItem) GetPermissionList() []GetRolesResponseRolesItemPermissionListItem {
-	return s.PermissionList
+// GetVlan returns the value of Vlan.
+func (s *DataDumpHostsItemBondsItem) GetVlan() OptInt {
+	return s.Vlan
 }
 
-// GetUnassignedPermissionList returns the value of UnassignedPermissionList.
-func (s *GetRolesResponseRolesItem) GetUnassignedPermissionList() []GetRolesResponseRolesItemUnassignedPermissionListItem {
-	return s.UnassignedPermissionList
+// SetAddresses sets the value of Addresses.
+func (s *DataDumpHostsItemBondsItem) SetAddresses(val []DataDumpHostsItemBondsItemAddressesItem) {
+	s.Addresses = val
 }
 
-// SetName sets the value of Name.
-func (s *GetRolesResponseRolesItem) SetName(val OptString) {
-	s.Name = val
+// SetBmc sets the value of Bmc.
+func (s *DataDumpHostsItemBondsItem) SetBmc(val OptBool) {
+	s.Bmc = val
 }
 
-// SetPermissionList sets the value of PermissionList.
-func (s *GetRolesResponseRolesItem) SetPermissionList(val []GetRolesResponseRolesItemPermissionListItem) {
-	s.PermissionList = val
+// SetFqdn sets the value of Fqdn.
+func (s *DataDumpHostsItemBondsItem) SetFqdn(val OptString) {
+	s.Fqdn = val
 }
 
-// SetUnassignedPermissionList sets the value of UnassignedPermissionList.
-func (s *GetRolesResponseRolesItem) SetUnassignedPermissionList(val []GetRolesResponseRolesItemUnassignedPermissionListItem) {
-	s.UnassignedPermissionList = val
+// SetID sets the value of ID.
+func (s *DataDumpHostsItemBondsItem) SetID(val OptNilInt64) {
+	s.ID = val
 }
 
-type GetRolesResponseRolesItemPermissionListItem struct {
-	Method OptString `json:"method"`
-	Path   OptString `json:"path"`
+// SetIfname sets the value of Ifname.
+func (s *DataDumpHostsItemBondsItem) SetIfname(val OptString) {
+	s.Ifname = val
 }
 
-// GetMethod returns the value of Method.
-func (s *GetRolesResponseRolesItemPermissionListItem) GetMethod() OptString {
-	return s.Method
+// SetIP sets the value of IP.
+func (s *DataDumpHostsItemBondsItem) SetIP(val OptString) {
+	s.IP = val
 }
 
-// GetPath returns the value of Path.
-func (s *GetRolesResponseRolesItemPermissionListItem) GetPath() OptString {
-	return s.Path
+// SetMAC sets the value of MAC.
+func (s *DataDumpHostsItemBondsItem) SetMAC(val OptString) {
+	s.MAC = val
 }
 
-// SetMethod sets the value of Method.
-func (s *GetRolesResponseRolesItemPermissionListItem) SetMethod(val OptString) {
-	s.Method = val
+// SetMode sets the value of Mode.
+func (s *DataDumpHostsItemBondsItem) SetMode(val OptString) {
+	s.Mode = val
 }
 
-// SetPath sets the value of Path.
-func (s *GetRolesResponseRolesItemPermissionListItem) SetPath(val OptString) {
-	s.Path = val
+// SetMtu sets the value of Mtu.
+func (s *DataDumpHostsItemBondsItem) SetMtu(val OptInt) {
+	s.Mtu = val
 }
 
-type GetRolesResponseRolesItemUnassignedPermissionListItem struct {
-	Method OptString `json:"method"`
-	Path   OptString `json:"path"`
+// SetOptions sets the value of Options.
+func (s *DataDumpHostsItemBondsItem) SetOptions(val OptDataDumpHostsItemBondsItemOptions) {
+	s.Options = val
 }
 
-// GetMethod returns the value of Method.
-func (s *GetRolesResponseRolesItemUnassignedPermissionListItem) GetMethod() OptString {
-	return s.Method
+// SetParent sets the value of Parent.
+func (s *DataDumpHostsItemBondsItem) SetParent(val OptString) {
+	s.Parent = val
 }
 
-// GetPath returns the value of Path.
-func (s *GetRolesResponseRolesItemUnassignedPermissionListItem) GetPath() OptString {
-	return s.Path
+// SetPeers sets the value of Peers.
+func (s *DataDumpHostsItemBondsItem) SetPeers(val []string) {
+	s.Peers = val
 }
 
-// SetMethod sets the value of Method.
-func (s *GetRolesResponseRolesItemUnassignedPermissionListItem) SetMethod(val OptString) {
-	s.Method = val
+// SetPort sets the value of Port.
+func (s *DataDumpHostsItemBondsItem) SetPort(val OptInt) {
+	s.Port = val
 }
 
-// SetPath sets the value of Path.
-func (s *GetRolesResponseRolesItemUnassignedPermissionListItem) SetPath(val OptString) {
-	s.Path = val
+// SetSwitch sets the value of Switch.
+func (s *DataDumpHostsItemBondsItem) SetSwitch(val OptString) {
+	s.Switch = val
 }
 
-// HTTPError schema.
-// Ref: #/components/schemas/HTTPError
-type HTTPError struct {
-	// Human readable error message.
-	Detail   OptNilString                   `json:"detail"`
-	Errors   OptNilHTTPErrorErrorsItemArray `json:"errors"`
-	Instance OptNilString                   `json:"instance"`
-	// HTTP status code.
-	Status OptNilInt `json:"status"`
-	// Short title of the error.
-	Title OptNilString `json:"title"`
-	// URL of the error type. Can be used to lookup the error in a documentation.
-	Type OptNilString `json:"type"`
+// SetType sets the value of Type.
+func (s *DataDumpHostsItemBondsItem) SetType(val OptString) {
+	s.Type = val
 }
 
-// GetDetail returns the value of Detail.
-func (s *HTTPError) GetDetail() OptNilString {
-	return s.Detail
+// SetVlan sets the value of Vlan.
+func (s *DataDumpHostsItemBondsItem) SetVlan(val OptInt) {
+	s.Vlan = val
 }
 
-// GetErrors returns the value of Errors.
-func (s *HTTPError) GetErrors() OptNilHTTPErrorErrorsItemArray {
-	return s.Errors
+type DataDumpHostsItemBondsItemAddressesItem struct {
+	Fqdn    OptString `json:"fqdn"`
+	IP      OptString `json:"ip"`
+	Primary OptBool   `json:"primary"`
 }
 
-// GetInstance returns the value of Instance.
-func (s *HTTPError) GetInstance() OptNilString {
-	return s.Instance
+// GetFqdn returns the value of Fqdn.
+func (s *DataDumpHostsItemBondsItemAddressesItem) GetFqdn() OptString {
+	return s.Fqdn
 }
 
-// GetStatus returns the value of Status.
-func (s *HTTPError) GetStatus() OptNilInt {
-	return s.Status
+// GetIP returns the value of IP.
+func (s *DataDumpHostsItemBondsItemAddressesItem) GetIP() OptString {
+	return s.IP
 }
 
-// GetTitle returns the value of Title.
-func (s *HTTPError) GetTitle() OptNilString {
-	return s.Title
+// GetPrimary returns the value of Primary.
+func (s *DataDumpHostsItemBondsItemAddressesItem) GetPrimary() OptBool {
+	return s.Primary
 }
 
-// GetType returns the value of Type.
-func (s *HTTPError) GetType() OptNilString {
-	return s.Type
+// SetFqdn sets the value of Fqdn.
+func (s *DataDumpHostsItemBondsItemAddressesItem) SetFqdn(val OptString) {
+	s.Fqdn = val
 }
 
-// SetDetail sets the value of Detail.
-func (s *HTTPError) SetDetail(val OptNilString) {
-	s.Detail = val
+// SetIP sets the value of IP.
+func (s *DataDumpHostsItemBondsItemAddressesItem) SetIP(val OptString) {
+	s.IP = val
 }
 
-// SetErrors sets the value of Errors.
-func (s *HTTPError) SetErrors(val OptNilHTTPErrorErrorsItemArray) {
-	s.Errors = val
+// SetPrimary sets the value of Primary.
+func (s *DataDumpHostsItemBondsItemAddressesItem) SetPrimary(val OptBool) {
+	s.Primary = val
 }
 
-// SetInstance sets the value of Instance.
-func (s *HTTPError) SetInstance(val OptNilString) {
-	s.Instance = val
+type DataDumpHostsItemBondsItemOptions map[string]string
+
+func (s *DataDumpHostsItemBondsItemOptions) init() DataDumpHostsItemBondsItemOptions {
+	m := *s
+	if m == nil {
+		m = map[string]string{}
+		*s = m
+	}
+	return m
 }
 
-// SetStatus sets the value of Status.
-func (s *HTTPError) SetStatus(val OptNilInt) {
-	s.Status = val
+type DataDumpHostsItemHardware struct {
+	CollectedAt OptNilDateTime                                   `json:"collected_at"`
+	CoreCount   OptInt                                           `json:"core_count"`
+	CPUCount    OptInt                                           `json:"cpu_count"`
+	CPUModel    OptString                                        `json:"cpu_model"`
+	Dimms       OptNilNilDataDumpHostsItemHardwareDimmsItemArray `json:"dimms"`
+	Disks       OptNilNilDataDumpHostsItemHardwareDisksItemArray `json:"disks"`
+	MemoryMib   OptInt                                           `json:"memory_mib"`
+	Missing     OptNilStringArray                                `json:"missing"`
+	Nics        OptNilNilDataDumpHostsItemHardwareNicsItemArray  `json:"nics"`
 }
 
-// SetTitle sets the value of Title.
-func (s *HTTPError) SetTitle(val OptNilString) {
-	s.Title = val
+// GetCollectedAt returns the value of CollectedAt.
+func (s *DataDumpHostsItemHardware) GetCollectedAt() OptNilDateTime {
+	return s.CollectedAt
 }
 
-// SetType sets the value of Type.
-func (s *HTTPError) SetType(val OptNilString) {
-	s.Type = val
+// GetCoreCount returns the value of CoreCount.
+func (s *DataDumpHostsItemHardware) GetCoreCount() OptInt {
+	return s.CoreCount
 }
 
-type HTTPErrorErrorsItem struct {
-	More   OptHTTPErrorErrorsItemMore `json:"more"`
-	Name   OptString                  `json:"name"`
-	Reason OptString                  `json:"reason"`
+// GetCPUCount returns the value of CPUCount.
+func (s *DataDumpHostsItemHardware) GetCPUCount() OptInt {
+	return s.CPUCount
 }
 
-// GetMore returns the value of More.
-func (s *HTTPErrorErrorsItem) GetMore() OptHTTPErrorErrorsItemMore {
-	return s.More
+// GetCPUModel returns the value of CPUModel.
+func (s *DataDumpHostsItemHardware) GetCPUModel() OptString {
+	return s.CPUModel
 }
 
-// GetName returns the value of Name.
-func (s *HTTPErrorErrorsItem) GetName() OptString {
-	return s.Name
+// GetDimms returns the value of Dimms.
+func (s *DataDumpHostsItemHardware) GetDimms() OptNilNilDataDumpHostsItemHardwareDimmsItemArray {
+	return s.Dimms
 }
 
-// GetReason returns the value of Reason.
-func (s *HTTPErrorErrorsItem) GetReason() OptString {
-	return s.Reason
+// GetDisks returns the value of Disks.
+func (s *DataDumpHostsItemHardware) GetDisks() OptNilNilDataDumpHostsItemHardwareDisksItemArray {
+	return s.Disks
 }
 
-// SetMore sets the value of More.
-func (s *HTTPErrorErrorsItem) SetMore(val OptHTTPErrorErrorsItemMore) {
-	s.More = val
+// GetMemoryMib returns the value of MemoryMib.
+func (s *DataDumpHostsItemHardware) GetMemoryMib() OptInt {
+	return s.MemoryMib
 }
 
-// SetName sets the value of Name.
-func (s *HTTPErrorErrorsItem) SetName(val OptString) {
-	s.Name = val
+// GetMissing returns the value of Missing.
+func (s *DataDumpHostsItemHardware) GetMissing() OptNilStringArray {
+	return s.Missing
 }
 
-// SetReason sets the value of Reason.
-func (s *HTTPErrorErrorsItem) SetReason(val OptString) {
-	s.Reason = val
+// GetNics returns the value of Nics.
+func (s *DataDumpHostsItemHardware) GetNics() OptNilNilDataDumpHostsItemHardwareNicsItemArray {
+	return s.Nics
 }
 
-type HTTPErrorErrorsItemMore map[string]jx.Raw
+// SetCollectedAt sets the value of CollectedAt.
+func (s *DataDumpHostsItemHardware) SetCollectedAt(val OptNilDateTime) {
+	s.CollectedAt = val
+}
 
-func (s *HTTPErrorErrorsItemMore) init() HTTPErrorErrorsItemMore {
-	m := *s
-	if m == nil {
-		m = map[string]jx.Raw{}
-		*s = m
-	}
-	return m
+// SetCoreCount sets the value of CoreCount.
+func (s *DataDumpHostsItemHardware) SetCoreCount(val OptInt) {
+	s.CoreCount = val
 }
 
-// HTTPErrorStatusCode wraps HTTPError with StatusCode.
-type HTTPErrorStatusCode struct {
-	StatusCode int
-	Response   HTTPError
+// SetCPUCount sets the value of CPUCount.
+func (s *DataDumpHostsItemHardware) SetCPUCount(val OptInt) {
+	s.CPUCount = val
 }
 
-// GetStatusCode returns the value of StatusCode.
-func (s *HTTPErrorStatusCode) GetStatusCode() int {
-	return s.StatusCode
+// SetCPUModel sets the value of CPUModel.
+func (s *DataDumpHostsItemHardware) SetCPUModel(val OptString) {
+	s.CPUModel = val
 }
 
-// GetResponse returns the value of Response.
-func (s *HTTPErrorStatusCode) GetResponse() HTTPError {
-	return s.Response
+// SetDimms sets the value of Dimms.
+func (s *DataDumpHostsItemHardware) SetDimms(val OptNilNilDataDumpHostsItemHardwareDimmsItemArray) {
+	s.Dimms = val
 }
 
-// SetStatusCode sets the value of StatusCode.
-func (s *HTTPErrorStatusCode) SetStatusCode(val int) {
-	s.StatusCode = val
+// SetDisks sets the value of Disks.
+func (s *DataDumpHostsItemHardware) SetDisks(val OptNilNilDataDumpHostsItemHardwareDisksItemArray) {
+	s.Disks = val
 }
 
-// SetResponse sets the value of Response.
-func (s *HTTPErrorStatusCode) SetResponse(val HTTPError) {
-	s.Response = val
+// SetMemoryMib sets the value of MemoryMib.
+func (s *DataDumpHostsItemHardware) SetMemoryMib(val OptInt) {
+	s.MemoryMib = val
 }
 
-type HeaderAuth struct {
-	Token string
+// SetMissing sets the value of Missing.
+func (s *DataDumpHostsItemHardware) SetMissing(val OptNilStringArray) {
+	s.Missing = val
 }
 
-// GetToken returns the value of Token.
-func (s *HeaderAuth) GetToken() string {
-	return s.Token
+// SetNics sets the value of Nics.
+func (s *DataDumpHostsItemHardware) SetNics(val OptNilNilDataDumpHostsItemHardwareNicsItemArray) {
+	s.Nics = val
 }
 
-// SetToken sets the value of Token.
-func (s *HeaderAuth) SetToken(val string) {
-	s.Token = val
+type DataDumpHostsItemHardwareDimmsItem struct {
+	SizeMib OptInt    `json:"size_mib"`
+	Slot    OptString `json:"slot"`
 }
 
-// Host schema.
-// Ref: #/components/schemas/Host
-type Host struct {
-	Bonds      []NilHostBondsItem      `json:"bonds"`
-	BootImage  OptString               `json:"boot_image"`
-	Firmware   OptString               `json:"firmware"`
-	ID         OptNilInt64             `json:"id"`
-	Interfaces []NilHostInterfacesItem `json:"interfaces"`
-	Name       OptString               `json:"name"`
-	Provision  OptBool                 `json:"provision"`
-	Tags       OptNilStringArray       `json:"tags"`
-	UID        OptNilString            `json:"uid"`
+// GetSizeMib returns the value of SizeMib.
+func (s *DataDumpHostsItemHardwareDimmsItem) GetSizeMib() OptInt {
+	return s.SizeMib
 }
 
-// GetBonds returns the value of Bonds.
-func (s *Host) GetBonds() []NilHostBondsItem {
-	return s.Bonds
+// GetSlot returns the value of Slot.
+func (s *DataDumpHostsItemHardwareDimmsItem) GetSlot() OptString {
+	return s.Slot
 }
 
-// GetBootImage returns the value of BootImage.
-func (s *Host) GetBootImage() OptString {
-	return s.BootImage
+// SetSizeMib sets the value of SizeMib.
+func (s *DataDumpHostsItemHardwareDimmsItem) SetSizeMib(val OptInt) {
+	s.SizeMib = val
 }
 
-// GetFirmware returns the value of Firmware.
-func (s *Host) GetFirmware() OptString {
-	return s.Firmware
+// SetSlot sets the value of Slot.
+func (s *DataDumpHostsItemHardwareDimmsItem) SetSlot(val OptString) {
+	s.Slot = val
 }
 
-// GetID returns the value of ID.
-func (s *Host) GetID() OptNilInt64 {
-	return s.ID
+type DataDumpHostsItemHardwareDisksItem struct {
+	Model     OptString `json:"model"`
+	Name      OptString `json:"name"`
+	Serial    OptString `json:"serial"`
+	SizeBytes OptInt64  `json:"size_bytes"`
 }
 
-// GetInterfaces returns the value of Interfaces.
-func (s *Host) GetInterfaces() []NilHostInterfacesItem {
-	return s.Interfaces
+// GetModel returns the value of Model.
+func (s *DataDumpHostsItemHardwareDisksItem) GetModel() OptString {
+	return s.Model
 }
 
 // GetName returns the value of Name.
-func (s *Host) GetName() OptString {
+func (s *DataDumpHostsItemHardwareDisksItem) GetName() OptString {
 	return s.Name
 }
 
-// GetProvision returns the value of Provision.
-func (s *Host) GetProvision() OptBool {
-	return s.Provision
+// GetSerial returns the value of Serial.
+func (s *DataDumpHostsItemHardwareDisksItem) GetSerial() OptString {
+	return s.Serial
 }
 
-// GetTags returns the value of Tags.
-func (s *Host) GetTags() OptNilStringArray {
-	return s.Tags
+// GetSizeBytes returns the value of SizeBytes.
+func (s *DataDumpHostsItemHardwareDisksItem) GetSizeBytes() OptInt64 {
+	return s.SizeBytes
 }
 
-// GetUID returns the value of UID.
-func (s *Host) GetUID() OptNilString {
-	return s.UID
+// SetModel sets the value of Model.
+func (s *DataDumpHostsItemHardwareDisksItem) SetModel(val OptString) {
+	s.Model = val
 }
 
-// SetBonds sets the value of Bonds.
-func (s *Host) SetBonds(val []NilHostBondsItem) {
-	s.Bonds = val
+// SetName sets the value of Name.
+func (s *DataDumpHostsItemHardwareDisksItem) SetName(val OptString) {
+	s.Name = val
 }
 
-// SetBootImage sets the value of BootImage.
-func (s *Host) SetBootImage(val OptString) {
-	s.BootImage = val
+// SetSerial sets the value of Serial.
+func (s *DataDumpHostsItemHardwareDisksItem) SetSerial(val OptString) {
+	s.Serial = val
 }
 
-// SetFirmware sets the value of Firmware.
-func (s *Host) SetFirmware(val OptString) {
-	s.Firmware = val
+// SetSizeBytes sets the value of SizeBytes.
+func (s *DataDumpHostsItemHardwareDisksItem) SetSizeBytes(val OptInt64) {
+	s.SizeBytes = val
 }
 
-// SetID sets the value of ID.
-func (s *Host) SetID(val OptNilInt64) {
-	s.ID = val
+type DataDumpHostsItemHardwareNicsItem struct {
+	MAC  OptString `json:"mac"`
+	Name OptString `json:"name"`
 }
 
-// SetInterfaces sets the value of Interfaces.
-func (s *Host) SetInterfaces(val []NilHostInterfacesItem) {
-	s.Interfaces = val
+// GetMAC returns the value of MAC.
+func (s *DataDumpHostsItemHardwareNicsItem) GetMAC() OptString {
+	return s.MAC
 }
 
-// SetName sets the value of Name.
-func (s *Host) SetName(val OptString) {
-	s.Name = val
+// GetName returns the value of Name.
+func (s *DataDumpHostsItemHardwareNicsItem) GetName() OptString {
+	return s.Name
 }
 
-// SetProvision sets the value of Provision.
-func (s *Host) SetProvision(val OptBool) {
-	s.Provision = val
+// SetMAC sets the value of MAC.
+func (s *DataDumpHostsItemHardwareNicsItem) SetMAC(val OptString) {
+	s.MAC = val
 }
 
-// SetTags sets the value of Tags.
-func (s *Host) SetTags(val OptNilStringArray) {
-	s.Tags = val
+// SetName sets the value of Name.
+func (s *DataDumpHostsItemHardwareNicsItem) SetName(val OptString) {
+	s.Name = val
 }
 
-// SetUID sets the value of UID.
-func (s *Host) SetUID(val OptNilString) {
-	s.UID = val
+type DataDumpHostsItemInterfacesItem struct {
+	Addresses []DataDumpHostsItemInterfacesItemAddressesItem `json:"addresses"`
+	Bmc       OptBool                                        `json:"bmc"`
+	Fqdn      OptString                                      `json:"fqdn"`
+	ID        OptNilInt64                                    `json:"id"`
+	Ifname    OptString                                      `json:"ifname"`
+	IP        OptString                                      `json:"ip"`
+	MAC       OptString                                      `json:"mac"`
+	Mtu       OptInt                                         `json:"mtu"`
+	Parent    OptString                                      `json:"parent"`
+	Port      OptInt                                         `json:"port"`
+	Switch    OptString                                      `json:"switch"`
+	Vlan      OptInt                                         `json:"vlan"`
 }
 
-type HostBondsItem struct {
-	Bmc    OptBool     `json:"bmc"`
-	Fqdn   OptString   `json:"fqdn"`
-	ID     OptNilInt64 `json:"id"`
-	Ifname OptString   `json:"ifname"`
-	IP     OptString   `json:"ip"`
-	MAC    OptString   `json:"mac"`
-	Mtu    OptInt      `json:"mtu"`
-	Peers  []string    `json:"peers"`
-	Vlan   OptString   `json:"vlan"`
+// GetAddresses returns the value of Addresses.
+func (s *DataDumpHostsItemInterfacesItem) GetAddresses() []DataDumpHostsItemInterfacesItemAddressesItem {
+	return s.Addresses
 }
 
 // GetBmc returns the value of Bmc.
-func (s *HostBondsItem) GetBmc() OptBool {
+func (s *DataDumpHostsItemInterfacesItem) GetBmc() OptBool {
 	return s.Bmc
 }
 
 // GetFqdn returns the value of Fqdn.
-func (s *HostBondsItem) GetFqdn() OptString {
+func (s *DataDumpHostsItemInterfacesItem) GetFqdn() OptString {
 	return s.Fqdn
 }
 
 // GetID returns the value of ID.
-func (s *HostBondsItem) GetID() OptNilInt64 {
+func (s *DataDumpHostsItemInterfacesItem) GetID() OptNilInt64 {
 	return s.ID
 }
 
 // GetIfname returns the value of Ifname.
-func (s *HostBondsItem) GetIfname() OptString {
+func (s *DataDumpHostsItemInterfacesItem) GetIfname() OptString {
 	return s.Ifname
 }
 
 // GetIP returns the value of IP.
-func (s *HostBondsItem) GetIP() OptString {
+func (s *DataDumpHostsItemInterfacesItem) GetIP() OptString {
 	return s.IP
 }
 
 // GetMAC returns the value of MAC.
-func (s *HostBondsItem) GetMAC() OptString {
+func (s *DataDumpHostsItemInterfacesItem) GetMAC() OptString {
 	return s.MAC
 }
 
 // GetMtu returns the value of Mtu.
-func (s *HostBondsItem) GetMtu() OptInt {
+func (s *DataDumpHostsItemInterfacesItem) GetMtu() OptInt {
 	return s.Mtu
 }
 
-// GetPeers returns the value of Peers.
-func (s *HostBondsItem) GetPeers() []string {
-	return s.Peers
+// GetParent returns the value of Parent.
+func (s *DataDumpHostsItemInterfacesItem) GetParent() OptString {
+	return s.Parent
+}
+
+// GetPort returns the value of Port.
+func (s *DataDumpHostsItemInterfacesItem) GetPort() OptInt {
+	return s.Port
+}
+
+// GetSwitch returns the value of Switch.
+func (s *DataDumpHostsItemInterfacesItem) GetSwitch() OptString {
+	return s.Switch
 }
 
 // GetVlan returns the value of Vlan.
-func (s *HostBondsItem) GetVlan() OptString {
+func (s *DataDumpHostsItemInterfacesItem) GetVlan() OptInt {
 	return s.Vlan
 }
 
+// SetAddresses sets the value of Addresses.
+func (s *DataDumpHostsItemInterfacesItem) SetAddresses(val []DataDumpHostsItemInterfacesItemAddressesItem) {
+	s.Addresses = val
+}
+
 // SetBmc sets the value of Bmc.
-func (s *HostBondsItem) SetBmc(val OptBool) {
+func (s *DataDumpHostsItemInterfacesItem) SetBmc(val OptBool) {
 	s.Bmc = val
 }
 
 // SetFqdn sets the value of Fqdn.
-func (s *HostBondsItem) SetFqdn(val OptString) {
+func (s *DataDumpHostsItemInterfacesItem) SetFqdn(val OptString) {
 	s.Fqdn = val
 }
 
 // SetID sets the value of ID.
-func (s *HostBondsItem) SetID(val OptNilInt64) {
+func (s *DataDumpHostsItemInterfacesItem) SetID(val OptNilInt64) {
 	s.ID = val
 }
 
 // SetIfname sets the value of Ifname.
-func (s *HostBondsItem) SetIfname(val OptString) {
+func (s *DataDumpHostsItemInterfacesItem) SetIfname(val OptString) {
 	s.Ifname = val
 }
 
 // SetIP sets the value of IP.
-func (s *HostBondsItem) SetIP(val OptString) {
+func (s *DataDumpHostsItemInterfacesItem) SetIP(val OptString) {
 	s.IP = val
 }
 
 // SetMAC sets the value of MAC.
-func (s *HostBondsItem) SetMAC(val OptString) {
+func (s *DataDumpHostsItemInterfacesItem) SetMAC(val OptString) {
 	s.MAC = val
 }
 
 // SetMtu sets the value of Mtu.
-func (s *HostBondsItem) SetMtu(val OptInt) {
+func (s *DataDumpHostsItemInterfacesItem) SetMtu(val OptInt) {
 	s.Mtu = val
 }
 
-// SetPeers sets the value of Peers.
-func (s *HostBondsItem) SetPeers(val []string) {
-	s.Peers = val
+// SetParent sets the value of Parent.
+func (s *DataDumpHostsItemInterfacesItem) SetParent(val OptString) {
+	s.Parent = val
 }
 
-// SetVlan sets the value of Vlan.
-func (s *HostBondsItem) SetVlan(val OptString) {
-	s.Vlan = val
+// SetPort sets the value of Port.
+func (s *DataDumpHostsItemInterfacesItem) SetPort(val OptInt) {
+	s.Port = val
 }
 
-type HostInterfacesItem struct {
-	Bmc    OptBool     `json:"bmc"`
-	Fqdn   OptString   `json:"fqdn"`
-	ID     OptNilInt64 `json:"id"`
-	Ifname OptString   `json:"ifname"`
-	IP     OptString   `json:"ip"`
-	MAC    OptString   `json:"mac"`
-	Mtu    OptInt      `json:"mtu"`
-	Vlan   OptString   `json:"vlan"`
+// SetSwitch sets the value of Switch.
+func (s *DataDumpHostsItemInterfacesItem) SetSwitch(val OptString) {
+	s.Switch = val
 }
 
-// GetBmc returns the value of Bmc.
-func (s *HostInterfacesItem) GetBmc() OptBool {
-	return s.Bmc
+// SetVlan sets the value of Vlan.
+func (s *DataDumpHostsItemInterfacesItem) SetVlan(val OptInt) {
+	s.Vlan = val
+}
+
+type DataDumpHostsItemInterfacesItemAddressesItem struct {
+	Fqdn    OptString `json:"fqdn"`
+	IP      OptString `json:"ip"`
+	Primary OptBool   `json:"primary"`
 }
 
 // GetFqdn returns the value of Fqdn.
-func (s *HostInterfacesItem) GetFqdn() OptString {
+func (s *DataDumpHostsItemInterfacesItemAddressesItem) GetFqdn() OptString {
 	return s.Fqdn
 }
 
-// GetID returns the value of ID.
-func (s *HostInterfacesItem) GetID() OptNilInt64 {
-	return s.ID
+// GetIP returns the value of IP.
+func (s *DataDumpHostsItemInterfacesItemAddressesItem) GetIP() OptString {
+	return s.IP
 }
 
-// GetIfname returns the value of Ifname.
-func (s *HostInterfacesItem) GetIfname() OptString {
-	return s.Ifname
+// GetPrimary returns the value of Primary.
+func (s *DataDumpHostsItemInterfacesItemAddressesItem) GetPrimary() OptBool {
+	return s.Primary
 }
 
-// GetIP returns the value of IP.
-func (s *HostInterfacesItem) GetIP() OptString {
-	return s.IP
+// SetFqdn sets the value of Fqdn.
+func (s *DataDumpHostsItemInterfacesItemAddressesItem) SetFqdn(val OptString) {
+	s.Fqdn = val
 }
 
-// GetMAC returns the value of MAC.
-func (s *HostInterfacesItem) GetMAC() OptString {
-	return s.MAC
+// SetIP sets the value of IP.
+func (s *DataDumpHostsItemInterfacesItemAddressesItem) SetIP(val OptString) {
+	s.IP = val
 }
 
-// GetMtu returns the value of Mtu.
-func (s *HostInterfacesItem) GetMtu() OptInt {
-	return s.Mtu
+// SetPrimary sets the value of Primary.
+func (s *DataDumpHostsItemInterfacesItemAddressesItem) SetPrimary(val OptBool) {
+	s.Primary = val
 }
 
-// GetVlan returns the value of Vlan.
-func (s *HostInterfacesItem) GetVlan() OptString {
-	return s.Vlan
+type DataDumpHostsItemInventory struct {
+	BiosVersion OptString                                `json:"bios_version"`
+	BmcFirmware OptString                                `json:"bmc_firmware"`
+	CapturedAt  OptNilDateTime                           `json:"captured_at"`
+	NicFirmware OptDataDumpHostsItemInventoryNicFirmware `json:"nic_firmware"`
 }
 
-// SetBmc sets the value of Bmc.
-func (s *HostInterfacesItem) SetBmc(val OptBool) {
-	s.Bmc = val
+// GetBiosVersion returns the value of BiosVersion.
+func (s *DataDumpHostsItemInventory) GetBiosVersion() OptString {
+	return s.BiosVersion
 }
 
-// SetFqdn sets the value of Fqdn.
-func (s *HostInterfacesItem) SetFqdn(val OptString) {
-	s.Fqdn = val
+// GetBmcFirmware returns the value of BmcFirmware.
+func (s *DataDumpHostsItemInventory) GetBmcFirmware() OptString {
+	return s.BmcFirmware
 }
 
-// SetID sets the value of ID.
-func (s *HostInterfacesItem) SetID(val OptNilInt64) {
-	s.ID = val
+// GetCapturedAt returns the value of CapturedAt.
+func (s *DataDumpHostsItemInventory) GetCapturedAt() OptNilDateTime {
+	return s.CapturedAt
 }
 
-// SetIfname sets the value of Ifname.
-func (s *HostInterfacesItem) SetIfname(val OptString) {
-	s.Ifname = val
+// GetNicFirmware returns the value of NicFirmware.
+func (s *DataDumpHostsItemInventory) GetNicFirmware() OptDataDumpHostsItemInventoryNicFirmware {
+	return s.NicFirmware
 }
 
-// SetIP sets the value of IP.
-func (s *HostInterfacesItem) SetIP(val OptString) {
-	s.IP = val
+// SetBiosVersion sets the value of BiosVersion.
+func (s *DataDumpHostsItemInventory) SetBiosVersion(val OptString) {
+	s.BiosVersion = val
 }
 
-// SetMAC sets the value of MAC.
-func (s *HostInterfacesItem) SetMAC(val OptString) {
-	s.MAC = val
+// SetBmcFirmware sets the value of BmcFirmware.
+func (s *DataDumpHostsItemInventory) SetBmcFirmware(val OptString) {
+	s.BmcFirmware = val
 }
 
-// SetMtu sets the value of Mtu.
-func (s *HostInterfacesItem) SetMtu(val OptInt) {
-	s.Mtu = val
+// SetCapturedAt sets the value of CapturedAt.
+func (s *DataDumpHostsItemInventory) SetCapturedAt(val OptNilDateTime) {
+	s.CapturedAt = val
 }
 
-// SetVlan sets the value of Vlan.
-func (s *HostInterfacesItem) SetVlan(val OptString) {
-	s.Vlan = val
+// SetNicFirmware sets the value of NicFirmware.
+func (s *DataDumpHostsItemInventory) SetNicFirmware(val OptDataDumpHostsItemInventoryNicFirmware) {
+	s.NicFirmware = val
 }
 
-// JobMessage schema.
-// Ref: #/components/schemas/JobMessage
-type JobMessage struct {
-	Data         OptString                 `json:"data"`
-	Host         OptString                 `json:"host"`
-	Msg          OptString                 `json:"msg"`
-	RedfishError OptJobMessageRedfishError `json:"redfish_error"`
-	Status       OptString                 `json:"status"`
+type DataDumpHostsItemInventoryNicFirmware map[string]string
+
+func (s *DataDumpHostsItemInventoryNicFirmware) init() DataDumpHostsItemInventoryNicFirmware {
+	m := *s
+	if m == nil {
+		m = map[string]string{}
+		*s = m
+	}
+	return m
 }
 
-// GetData returns the value of Data.
-func (s *JobMessage) GetData() OptString {
-	return s.Data
+type DataDumpImagesItem struct {
+	Cmdline            OptString                                  `json:"cmdline"`
+	CreatedAt          OptNilDateTime                             `json:"created_at"`
+	ID                 OptNilInt64                                `json:"id"`
+	Inherits           OptString                                  `json:"inherits"`
+	Initrd             []string                                   `json:"initrd"`
+	Kernel             OptString                                  `json:"kernel"`
+	Liveimg            OptString                                  `json:"liveimg"`
+	Name               OptString                                  `json:"name"`
+	ProvisionTemplates OptNilDataDumpImagesItemProvisionTemplates `json:"provision_templates"`
+	Revision           OptNilInt64                                `json:"revision"`
+	UID                OptNilString                               `json:"uid"`
+	UpdatedAt          OptNilDateTime                             `json:"updated_at"`
+	Verify             OptBool                                    `json:"verify"`
 }
 
-// GetHost returns the value of Host.
-func (s *JobMessage) GetHost() OptString {
-	return s.Host
+// GetCmdline returns the value of Cmdline.
+func (s *DataDumpImagesItem) GetCmdline() OptString {
+	return s.Cmdline
 }
 
-// GetMsg returns the value of Msg.
-func (s *JobMessage) GetMsg() OptString {
-	return s.Msg
+// GetCreatedAt returns the value of CreatedAt.
+func (s *DataDumpImagesItem) GetCreatedAt() OptNilDateTime {
+	return s.CreatedAt
 }
 
-// GetRedfishError returns the value of RedfishError.
-func (s *JobMessage) GetRedfishError() OptJobMessageRedfishError {
-	return s.RedfishError
+// GetID returns the value of ID.
+func (s *DataDumpImagesItem) GetID() OptNilInt64 {
+	return s.ID
 }
 
-// GetStatus returns the value of Status.
-func (s *JobMessage) GetStatus() OptString {
-	return s.Status
+// GetInherits returns the value of Inherits.
+func (s *DataDumpImagesItem) GetInherits() OptString {
+	return s.Inherits
 }
 
-// SetData sets the value of Data.
-func (s *JobMessage) SetData(val OptString) {
-	s.Data = val
+// GetInitrd returns the value of Initrd.
+func (s *DataDumpImagesItem) GetInitrd() []string {
+	return s.Initrd
 }
 
-// SetHost sets the value of Host.
-func (s *JobMessage) SetHost(val OptString) {
-	s.Host = val
+// GetKernel returns the value of Kernel.
+func (s *DataDumpImagesItem) GetKernel() OptString {
+	return s.Kernel
 }
 
-// SetMsg sets the value of Msg.
-func (s *JobMessage) SetMsg(val OptString) {
-	s.Msg = val
+// GetLiveimg returns the value of Liveimg.
+func (s *DataDumpImagesItem) GetLiveimg() OptString {
+	return s.Liveimg
 }
 
-// SetRedfishError sets the value of RedfishError.
-func (s *JobMessage) SetRedfishError(val OptJobMessageRedfishError) {
-	s.RedfishError = val
+// GetName returns the value of Name.
+func (s *DataDumpImagesItem) GetName() OptString {
+	return s.Name
 }
 
-// SetStatus sets the value of Status.
-func (s *JobMessage) SetStatus(val OptString) {
-	s.Status = val
+// GetProvisionTemplates returns the value of ProvisionTemplates.
+func (s *DataDumpImagesItem) GetProvisionTemplates() OptNilDataDumpImagesItemProvisionTemplates {
+	return s.ProvisionTemplates
 }
 
-type JobMessageRedfishError struct {
-	Code  OptString                      `json:"code"`
-	Error OptJobMessageRedfishErrorError `json:"error"`
+// GetRevision returns the value of Revision.
+func (s *DataDumpImagesItem) GetRevision() OptNilInt64 {
+	return s.Revision
 }
 
-// GetCode returns the value of Code.
-func (s *JobMessageRedfishError) GetCode() OptString {
-	return s.Code
+// GetUID returns the value of UID.
+func (s *DataDumpImagesItem) GetUID() OptNilString {
+	return s.UID
 }
 
-// GetError returns the value of Error.
-func (s *JobMessageRedfishError) GetError() OptJobMessageRedfishErrorError {
-	return s.Error
+// GetUpdatedAt returns the value of UpdatedAt.
+func (s *DataDumpImagesItem) GetUpdatedAt() OptNilDateTime {
+	return s.UpdatedAt
 }
 
-// SetCode sets the value of Code.
-func (s *JobMessageRedfishError) SetCode(val OptString) {
-	s.Code = val
+// GetVerify returns the value of Verify.
+func (s *DataDumpImagesItem) GetVerify() OptBool {
+	return s.Verify
 }
 
-// SetError sets the value of Error.
-func (s *JobMessageRedfishError) SetError(val OptJobMessageRedfishErrorError) {
-	s.Error = val
+// SetCmdline sets the value of Cmdline.
+func (s *DataDumpImagesItem) SetCmdline(val OptString) {
+	s.Cmdline = val
 }
 
-type JobMessageRedfishErrorError struct {
-	MessageDotExtendedInfo OptNilJobMessageRedfishErrorErrorMessageDotExtendedInfoItemArray `json:"@Message.ExtendedInfo"`
-	Code                   OptString                                                        `json:"code"`
-	Message                OptString                                                        `json:"message"`
+// SetCreatedAt sets the value of CreatedAt.
+func (s *DataDumpImagesItem) SetCreatedAt(val OptNilDateTime) {
+	s.CreatedAt = val
 }
 
-// GetMessageDotExtendedInfo returns the value of MessageDotExtendedInfo.
-func (s *JobMessageRedfishErrorError) GetMessageDotExtendedInfo() OptNilJobMessageRedfishErrorErrorMessageDotExtendedInfoItemArray {
-	return s.MessageDotExtendedInfo
+// SetID sets the value of ID.
+func (s *DataDumpImagesItem) SetID(val OptNilInt64) {
+	s.ID = val
 }
 
-// GetCode returns the value of Code.
-func (s *JobMessageRedfishErrorError) GetCode() OptString {
-	return s.Code
+// SetInherits sets the value of Inherits.
+func (s *DataDumpImagesItem) SetInherits(val OptString) {
+	s.Inherits = val
 }
 
-// GetMessage returns the value of Message.
-func (s *JobMessageRedfishErrorError) GetMessage() OptString {
-	return s.Message
+// SetInitrd sets the value of Initrd.
+func (s *DataDumpImagesItem) SetInitrd(val []string) {
+	s.Initrd = val
 }
 
-// SetMessageDotExtendedInfo sets the value of MessageDotExtendedInfo.
-func (s *JobMessageRedfishErrorError) SetMessageDotExtendedInfo(val OptNilJobMessageRedfishErrorErrorMessageDotExtendedInfoItemArray) {
-	s.MessageDotExtendedInfo = val
+// SetKernel sets the value of Kernel.
+func (s *DataDumpImagesItem) SetKernel(val OptString) {
+	s.Kernel = val
 }
 
-// SetCode sets the value of Code.
-func (s *JobMessageRedfishErrorError) SetCode(val OptString) {
-	s.Code = val
+// SetLiveimg sets the value of Liveimg.
+func (s *DataDumpImagesItem) SetLiveimg(val OptString) {
+	s.Liveimg = val
 }
 
-// SetMessage sets the value of Message.
-func (s *JobMessageRedfishErrorError) SetMessage(val OptString) {
-	s.Message = val
+// SetName sets the value of Name.
+func (s *DataDumpImagesItem) SetName(val OptString) {
+	s.Name = val
 }
 
-type JobMessageRedfishErrorErrorMessageDotExtendedInfoItem struct {
-	Message                           OptString `json:"Message"`
-	MessageArgsDotOdataDotCount       OptInt    `json:"MessageArgs.@odata.count"`
-	MessageId                         OptString `json:"MessageId"`
-	RelatedPropertiesDotOdataDotCount OptInt    `json:"RelatedProperties.@odata.count"`
-	Resolution                        OptString `json:"Resolution"`
-	Severity                          OptString `json:"Severity"`
+// SetProvisionTemplates sets the value of ProvisionTemplates.
+func (s *DataDumpImagesItem) SetProvisionTemplates(val OptNilDataDumpImagesItemProvisionTemplates) {
+	s.ProvisionTemplates = val
 }
 
-// GetMessage returns the value of Message.
-func (s *JobMessageRedfishErrorErrorMessageDotExtendedInfoItem) GetMessage() OptString {
-	return s.Message
+// SetRevision sets the value of Revision.
+func (s *DataDumpImagesItem) SetRevision(val OptNilInt64) {
+	s.Revision = val
 }
 
-// GetMessageArgsDotOdataDotCount returns the value of MessageArgsDotOdataDotCount.
-func (s *JobMessageRedfishErrorErrorMessageDotExtendedInfoItem) GetMessageArgsDotOdataDotCount() OptInt {
-	return s.MessageArgsDotOdataDotCount
+// SetUID sets the value of UID.
+func (s *DataDumpImagesItem) SetUID(val OptNilString) {
+	s.UID = val
 }
 
-// GetMessageId returns the value of MessageId.
-func (s *JobMessageRedfishErrorErrorMessageDotExtendedInfoItem) GetMessageId() OptString {
-	return s.MessageId
+// SetUpdatedAt sets the value of UpdatedAt.
+func (s *DataDumpImagesItem) SetUpdatedAt(val OptNilDateTime) {
+	s.UpdatedAt = val
 }
 
-// GetRelatedPropertiesDotOdataDotCount returns the value of RelatedPropertiesDotOdataDotCount.
-func (s *JobMessageRedfishErrorErrorMessageDotExtendedInfoItem) GetRelatedPropertiesDotOdataDotCount() OptInt {
-	return s.RelatedPropertiesDotOdataDotCount
+// SetVerify sets the value of Verify.
+func (s *DataDumpImagesItem) SetVerify(val OptBool) {
+	s.Verify = val
 }
 
-// GetResolution returns the value of Resolution.
-func (s *JobMessageRedfishErrorErrorMessageDotExtendedInfoItem) GetResolution() OptString {
-	return s.Resolution
+type DataDumpImagesItemProvisionTemplates map[string]NilString
+
+func (s *DataDumpImagesItemProvisionTemplates) init() DataDumpImagesItemProvisionTemplates {
+	m := *s
+	if m == nil {
+		m = map[string]NilString{}
+		*s = m
+	}
+	return m
 }
 
-// GetSeverity returns the value of Severity.
-func (s *JobMessageRedfishErrorErrorMessageDotExtendedInfoItem) GetSeverity() OptString {
-	return s.Severity
+type DataDumpUsersItem struct {
+	CreatedAt  OptDateTime `json:"created_at"`
+	Enabled    OptBool     `json:"enabled"`
+	Hash       OptString   `json:"hash"`
+	ID         OptInt64    `json:"id"`
+	ModifiedAt OptDateTime `json:"modified_at"`
+	Role       OptString   `json:"role"`
+	Username   OptString   `json:"username"`
 }
 
-// SetMessage sets the value of Message.
-func (s *JobMessageRedfishErrorErrorMessageDotExtendedInfoItem) SetMessage(val OptString) {
-	s.Message = val
+// GetCreatedAt returns the value of CreatedAt.
+func (s *DataDumpUsersItem) GetCreatedAt() OptDateTime {
+	return s.CreatedAt
 }
 
-// SetMessageArgsDotOdataDotCount sets the value of MessageArgsDotOdataDotCount.
-func (s *JobMessageRedfishErrorErrorMessageDotExtendedInfoItem) SetMessageArgsDotOdataDotCount(val OptInt) {
-	s.MessageArgsDotOdataDotCount = val
+// GetEnabled returns the value of Enabled.
+func (s *DataDumpUsersItem) GetEnabled() OptBool {
+	return s.Enabled
 }
 
-// SetMessageId sets the value of MessageId.
-func (s *JobMessageRedfishErrorErrorMessageDotExtendedInfoItem) SetMessageId(val OptString) {
-	s.MessageId = val
+// GetHash returns the value of Hash.
+func (s *DataDumpUsersItem) GetHash() OptString {
+	return s.Hash
 }
 
-// SetRelatedPropertiesDotOdataDotCount sets the value of RelatedPropertiesDotOdataDotCount.
-func (s *JobMessageRedfishErrorErrorMessageDotExtendedInfoItem) SetRelatedPropertiesDotOdataDotCount(val OptInt) {
-	s.RelatedPropertiesDotOdataDotCount = val
+// GetID returns the value of ID.
+func (s *DataDumpUsersItem) GetID() OptInt64 {
+	return s.ID
 }
 
-// SetResolution sets the value of Resolution.
-func (s *JobMessageRedfishErrorErrorMessageDotExtendedInfoItem) SetResolution(val OptString) {
-	s.Resolution = val
+// GetModifiedAt returns the value of ModifiedAt.
+func (s *DataDumpUsersItem) GetModifiedAt() OptDateTime {
+	return s.ModifiedAt
 }
 
-// SetSeverity sets the value of Severity.
-func (s *JobMessageRedfishErrorErrorMessageDotExtendedInfoItem) SetSeverity(val OptString) {
-	s.Severity = val
+// GetRole returns the value of Role.
+func (s *DataDumpUsersItem) GetRole() OptString {
+	return s.Role
 }
 
-// LLDP schema.
-// Ref: #/components/schemas/LLDP
-type LLDP struct {
-	ChassisID         OptString `json:"chassis_id"`
-	ChassisIDType     OptString `json:"chassis_id_type"`
-	ManagementAddress OptString `json:"management_address"`
-	PortDescription   OptString `json:"port_description"`
-	PortID            OptString `json:"port_id"`
-	PortIDType        OptString `json:"port_id_type"`
-	PortName          OptString `json:"port_name"`
-	SystemDescription OptString `json:"system_description"`
-	SystemName        OptString `json:"system_name"`
+// GetUsername returns the value of Username.
+func (s *DataDumpUsersItem) GetUsername() OptString {
+	return s.Username
 }
 
-// GetChassisID returns the value of ChassisID.
-func (s *LLDP) GetChassisID() OptString {
-	return s.ChassisID
+// SetCreatedAt sets the value of CreatedAt.
+func (s *DataDumpUsersItem) SetCreatedAt(val OptDateTime) {
+	s.CreatedAt = val
 }
 
-// GetChassisIDType returns the value of ChassisIDType.
-func (s *LLDP) GetChassisIDType() OptString {
-	return s.ChassisIDType
+// SetEnabled sets the value of Enabled.
+func (s *DataDumpUsersItem) SetEnabled(val OptBool) {
+	s.Enabled = val
 }
 
-// GetManagementAddress returns the value of ManagementAddress.
-func (s *LLDP) GetManagementAddress() OptString {
-	return s.ManagementAddress
+// SetHash sets the value of Hash.
+func (s *DataDumpUsersItem) SetHash(val OptString) {
+	s.Hash = val
 }
 
-// GetPortDescription returns the value of PortDescription.
-func (s *LLDP) GetPortDescription() OptString {
-	return s.PortDescription
+// SetID sets the value of ID.
+func (s *DataDumpUsersItem) SetID(val OptInt64) {
+	s.ID = val
 }
 
-// GetPortID returns the value of PortID.
-func (s *LLDP) GetPortID() OptString {
-	return s.PortID
+// SetModifiedAt sets the value of ModifiedAt.
+func (s *DataDumpUsersItem) SetModifiedAt(val OptDateTime) {
+	s.ModifiedAt = val
 }
 
-// GetPortIDType returns the value of PortIDType.
-func (s *LLDP) GetPortIDType() OptString {
-	return s.PortIDType
+// SetRole sets the value of Role.
+func (s *DataDumpUsersItem) SetRole(val OptString) {
+	s.Role = val
 }
 
-// GetPortName returns the value of PortName.
-func (s *LLDP) GetPortName() OptString {
-	return s.PortName
+// SetUsername sets the value of Username.
+func (s *DataDumpUsersItem) SetUsername(val OptString) {
+	s.Username = val
 }
 
-// GetSystemDescription returns the value of SystemDescription.
-func (s *LLDP) GetSystemDescription() OptString {
-	return s.SystemDescription
+// DataLoadRequest schema.
+// Ref: #/components/schemas/DataLoadRequest
+type DataLoadRequest struct {
+	DryRun OptBool                `json:"dry_run"`
+	Dump   OptDataLoadRequestDump `json:"dump"`
+	Prune  OptBool                `json:"prune"`
 }
 
-// GetSystemName returns the value of SystemName.
-func (s *LLDP) GetSystemName() OptString {
-	return s.SystemName
+// GetDryRun returns the value of DryRun.
+func (s *DataLoadRequest) GetDryRun() OptBool {
+	return s.DryRun
 }
 
-// SetChassisID sets the value of ChassisID.
-func (s *LLDP) SetChassisID(val OptString) {
-	s.ChassisID = val
+// GetDump returns the value of Dump.
+func (s *DataLoadRequest) GetDump() OptDataLoadRequestDump {
+	return s.Dump
 }
 
-// SetChassisIDType sets the value of ChassisIDType.
-func (s *LLDP) SetChassisIDType(val OptString) {
-	s.ChassisIDType = val
+// GetPrune returns the value of Prune.
+func (s *DataLoadRequest) GetPrune() OptBool {
+	return s.Prune
 }
 
-// SetManagementAddress sets the value of ManagementAddress.
-func (s *LLDP) SetManagementAddress(val OptString) {
-	s.ManagementAddress = val
+// SetDryRun sets the value of DryRun.
+func (s *DataLoadRequest) SetDryRun(val OptBool) {
+	s.DryRun = val
 }
 
-// SetPortDescription sets the value of PortDescription.
-func (s *LLDP) SetPortDescription(val OptString) {
-	s.PortDescription = val
+// SetDump sets the value of Dump.
+func (s *DataLoadRequest) SetDump(val OptDataLoadRequestDump) {
+	s.Dump = val
 }
 
-// SetPortID sets the value of PortID.
-func (s *LLDP) SetPortID(val OptString) {
-	s.PortID = val
+// SetPrune sets the value of Prune.
+func (s *DataLoadRequest) SetPrune(val OptBool) {
+	s.Prune = val
 }
 
-// SetPortIDType sets the value of PortIDType.
-func (s *LLDP) SetPortIDType(val OptString) {
-	s.PortIDType = val
+type DataLoadRequestDump struct {
+	Credentials OptNilNilDataLoadRequestDumpCredentialsItemArray `json:"Credentials"`
+	DNSRecords  []NilDataLoadRequestDumpDNSRecordsItem           `json:"DNSRecords"`
+	Hosts       []NilDataLoadRequestDumpHostsItem                `json:"Hosts"`
+	Images      []NilDataLoadRequestDumpImagesItem               `json:"Images"`
+	Users       []DataLoadRequestDumpUsersItem                   `json:"Users"`
 }
 
-// SetPortName sets the value of PortName.
-func (s *LLDP) SetPortName(val OptString) {
-	s.PortName = val
+// GetCredentials returns the value of Credentials.
+func (s *DataLoadRequestDump) GetCredentials() OptNilNilDataLoadRequestDumpCredentialsItemArray {
+	return s.Credentials
 }
 
-// SetSystemDescription sets the value of SystemDescription.
-func (s *LLDP) SetSystemDescription(val OptString) {
-	s.SystemDescription = val
+// GetDNSRecords returns the value of DNSRecords.
+func (s *DataLoadRequestDump) GetDNSRecords() []NilDataLoadRequestDumpDNSRecordsItem {
+	return s.DNSRecords
 }
 
-// SetSystemName sets the value of SystemName.
-func (s *LLDP) SetSystemName(val OptString) {
-	s.SystemName = val
+// GetHosts returns the value of Hosts.
+func (s *DataLoadRequestDump) GetHosts() []NilDataLoadRequestDumpHostsItem {
+	return s.Hosts
 }
 
-// NewNilBootImageAddRequestBootImagesItem returns new NilBootImageAddRequestBootImagesItem with value set to v.
-func NewNilBootImageAddRequestBootImagesItem(v BootImageAddRequestBootImagesItem) NilBootImageAddRequestBootImagesItem {
-	return NilBootImageAddRequestBootImagesItem{
-		Value: v,
-	}
+// GetImages returns the value of Images.
+func (s *DataLoadRequestDump) GetImages() []NilDataLoadRequestDumpImagesItem {
+	return s.Images
 }
 
-// NilBootImageAddRequestBootImagesItem is nullable BootImageAddRequestBootImagesItem.
-type NilBootImageAddRequestBootImagesItem struct {
-	Value BootImageAddRequestBootImagesItem
-	Null  bool
+// GetUsers returns the value of Users.
+func (s *DataLoadRequestDump) GetUsers() []DataLoadRequestDumpUsersItem {
+	return s.Users
 }
 
-// SetTo sets value to v.
-func (o *NilBootImageAddRequestBootImagesItem) SetTo(v BootImageAddRequestBootImagesItem) {
-	o.Null = false
-	o.Value = v
+// SetCredentials sets the value of Credentials.
+func (s *DataLoadRequestDump) SetCredentials(val OptNilNilDataLoadRequestDumpCredentialsItemArray) {
+	s.Credentials = val
 }
 
-// IsSet returns true if value is Null.
-func (o NilBootImageAddRequestBootImagesItem) IsNull() bool { return o.Null }
+// SetDNSRecords sets the value of DNSRecords.
+func (s *DataLoadRequestDump) SetDNSRecords(val []NilDataLoadRequestDumpDNSRecordsItem) {
+	s.DNSRecords = val
+}
 
-// SetNull sets value to null.
-func (o *NilBootImageAddRequestBootImagesItem) SetToNull() {
-	o.Null = true
-	var v BootImageAddRequestBootImagesItem
-	o.Value = v
+// SetHosts sets the value of Hosts.
+func (s *DataLoadRequestDump) SetHosts(val []NilDataLoadRequestDumpHostsItem) {
+	s.Hosts = val
 }
 
-// Get returns value and boolean that denotes whether value was set.
-func (o NilBootImageAddRequestBootImagesItem) Get() (v BootImageAddRequestBootImagesItem, ok bool) {
-	if o.Null {
-		return v, false
-	}
-	return o.Value, true
+// SetImages sets the value of Images.
+func (s *DataLoadRequestDump) SetImages(val []NilDataLoadRequestDumpImagesItem) {
+	s.Images = val
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o NilBootImageAddRequestBootImagesItem) Or(d BootImageAddRequestBootImagesItem) BootImageAddRequestBootImagesItem {
-	if v, ok := o.Get(); ok {
-		return v
-	}
-	return d
+// SetUsers sets the value of Users.
+func (s *DataLoadRequestDump) SetUsers(val []DataLoadRequestDumpUsersItem) {
+	s.Users = val
 }
 
-// NewNilDataDumpHostsItem returns new NilDataDumpHostsItem with value set to v.
-func NewNilDataDumpHostsItem(v DataDumpHostsItem) NilDataDumpHostsItem {
-	return NilDataDumpHostsItem{
-		Value: v,
-	}
+type DataLoadRequestDumpCredentialsItem struct {
+	Kind   OptString    `json:"kind"`
+	Name   OptString    `json:"name"`
+	Secret OptNilString `json:"secret"`
 }
 
-// NilDataDumpHostsItem is nullable DataDumpHostsItem.
-type NilDataDumpHostsItem struct {
-	Value DataDumpHostsItem
-	Null  bool
+// GetKind returns the value of Kind.
+func (s *DataLoadRequestDumpCredentialsItem) GetKind() OptString {
+	return s.Kind
 }
 
-// SetTo sets value to v.
-func (o *NilDataDumpHostsItem) SetTo(v DataDumpHostsItem) {
-	o.Null = false
-	o.Value = v
+// GetName returns the value of Name.
+func (s *DataLoadRequestDumpCredentialsItem) GetName() OptString {
+	return s.Name
 }
 
-// IsSet returns true if value is Null.
-func (o NilDataDumpHostsItem) IsNull() bool { return o.Null }
+// GetSecret returns the value of Secret.
+func (s *DataLoadRequestDumpCredentialsItem) GetSecret() OptNilString {
+	return s.Secret
+}
 
-// SetNull sets value to null.
-func (o *NilDataDumpHostsItem) SetToNull() {
-	o.Null = true
-	var v DataDumpHostsItem
-	o.Value = v
+// SetKind sets the value of Kind.
+func (s *DataLoadRequestDumpCredentialsItem) SetKind(val OptString) {
+	s.Kind = val
 }
 
-// Get returns value and boolean that denotes whether value was set.
-func (o NilDataDumpHostsItem) Get() (v DataDumpHostsItem, ok bool) {
-	if o.Null {
-		return v, false
-	}
-	return o.Value, true
+// SetName sets the value of Name.
+func (s *DataLoadRequestDumpCredentialsItem) SetName(val OptString) {
+	s.Name = val
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o NilDataDumpHostsItem) Or(d DataDumpHostsItem) DataDumpHostsItem {
-	if v, ok := o.Get(); ok {
-		return v
-	}
-	return d
+// SetSecret sets the value of Secret.
+func (s *DataLoadRequestDumpCredentialsItem) SetSecret(val OptNilString) {
+	s.Secret = val
 }
 
-// NewNilDataDumpHostsItemBondsItem returns new NilDataDumpHostsItemBondsItem with value set to v.
-func NewNilDataDumpHostsItemBondsItem(v DataDumpHostsItemBondsItem) NilDataDumpHostsItemBondsItem {
-	return NilDataDumpHostsItemBondsItem{
-		Value: v,
-	}
+type DataLoadRequestDumpDNSRecordsItem struct {
+	ID    OptNilInt64 `json:"id"`
+	Name  OptString   `json:"name"`
+	Ptr   OptBool     `json:"ptr"`
+	TTL   OptInt64    `json:"ttl"`
+	Type  OptString   `json:"type"`
+	Value OptString   `json:"value"`
 }
 
-// NilDataDumpHostsItemBondsItem is nullable DataDumpHostsItemBondsItem.
-type NilDataDumpHostsItemBondsItem struct {
-	Value DataDumpHostsItemBondsItem
-	Null  bool
+// GetID returns the value of ID.
+func (s *DataLoadRequestDumpDNSRecordsItem) GetID() OptNilInt64 {
+	return s.ID
 }
 
-// SetTo sets value to v.
-func (o *NilDataDumpHostsItemBondsItem) SetTo(v DataDumpHostsItemBondsItem) {
-	o.Null = false
-	o.Value = v
+// GetName returns the value of Name.
+func (s *DataLoadRequestDumpDNSRecordsItem) GetName() OptString {
+	return s.Name
 }
 
-// IsSet returns true if value is Null.
-func (o NilDataDumpHostsItemBondsItem) IsNull() bool { return o.Null }
+// GetPtr returns the value of Ptr.
+func (s *DataLoadRequestDumpDNSRecordsItem) GetPtr() OptBool {
+	return s.Ptr
+}
 
-// SetNull sets value to null.
-func (o *NilDataDumpHostsItemBondsItem) SetToNull() {
-	o.Null = true
-	var v DataDumpHostsItemBondsItem
-	o.Value = v
+// GetTTL returns the value of TTL.
+func (s *DataLoadRequestDumpDNSRecordsItem) GetTTL() OptInt64 {
+	return s.TTL
 }
 
-// Get returns value and boolean that denotes whether value was set.
-func (o NilDataDumpHostsItemBondsItem) Get() (v DataDumpHostsItemBondsItem, ok bool) {
-	if o.Null {
-		return v, false
-	}
-	return o.Value, true
+// GetType returns the value of Type.
+func (s *DataLoadRequestDumpDNSRecordsItem) GetType() OptString {
+	return s.Type
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o NilDataDumpHostsItemBondsItem) Or(d DataDumpHostsItemBondsItem) DataDumpHostsItemBondsItem {
-	if v, ok := o.Get(); ok {
-		return v
-	}
-	return d
+// GetValue returns the value of Value.
+func (s *DataLoadRequestDumpDNSRecordsItem) GetValue() OptString {
+	return s.Value
 }
 
-// NewNilDataDumpHostsItemInterfacesItem returns new NilDataDumpHostsItemInterfacesItem with value set to v.
-func NewNilDataDumpHostsItemInterfacesItem(v DataDumpHostsItemInterfacesItem) NilDataDumpHostsItemInterfacesItem {
-	return NilDataDumpHostsItemInterfacesItem{
-		Value: v,
-	}
+// SetID sets the value of ID.
+func (s *DataLoadRequestDumpDNSRecordsItem) SetID(val OptNilInt64) {
+	s.ID = val
 }
 
-// NilDataDumpHostsItemInterfacesItem is nullable DataDumpHostsItemInterfacesItem.
-type NilDataDumpHostsItemInterfacesItem struct {
-	Value DataDumpHostsItemInterfacesItem
-	Null  bool
+// SetName sets the value of Name.
+func (s *DataLoadRequestDumpDNSRecordsItem) SetName(val OptString) {
+	s.Name = val
 }
 
-// SetTo sets value to v.
-func (o *NilDataDumpHostsItemInterfacesItem) SetTo(v DataDumpHostsItemInterfacesItem) {
-	o.Null = false
-	o.Value = v
+// SetPtr sets the value of Ptr.
+func (s *DataLoadRequestDumpDNSRecordsItem) SetPtr(val OptBool) {
+	s.Ptr = val
 }
 
-// IsSet returns true if value is Null.
-func (o NilDataDumpHostsItemInterfacesItem) IsNull() bool { return o.Null }
+// SetTTL sets the value of TTL.
+func (s *DataLoadRequestDumpDNSRecordsItem) SetTTL(val OptInt64) {
+	s.TTL = val
+}
 
-// SetNull sets value to null.
-func (o *NilDataDumpHostsItemInterfacesItem) SetToNull() {
-	o.Null = true
-	var v DataDumpHostsItemInterfacesItem
-	o.Value = v
+// SetType sets the value of Type.
+func (s *DataLoadRequestDumpDNSRecordsItem) SetType(val OptString) {
+	s.Type = val
 }
 
-// Get returns value and boolean that denotes whether value was set.
-func (o NilDataDumpHostsItemInterfacesItem) Get() (v DataDumpHostsItemInterfacesItem, ok bool) {
-	if o.Null {
-		return v, false
-	}
-	return o.Value, true
+// SetValue sets the value of Value.
+func (s *DataLoadRequestDumpDNSRecordsItem) SetValue(val OptString) {
+	s.Value = val
+}
+
+type DataLoadRequestDumpHostsItem struct {
+	Bonds                 []NilDataLoadRequestDumpHostsItemBondsItem      `json:"bonds"`
+	BootImage             OptString                                       `json:"boot_image"`
+	ClientCertFingerprint OptString                                       `json:"client_cert_fingerprint"`
+	ClientCertSerial      OptString                                       `json:"client_cert_serial"`
+	CreatedAt             OptNilDateTime                                  `json:"created_at"`
+	Firmware              OptString                                       `json:"firmware"`
+	Hardware              OptNilDataLoadRequestDumpHostsItemHardware      `json:"hardware"`
+	ID                    OptNilInt64                                     `json:"id"`
+	Interfaces            []NilDataLoadRequestDumpHostsItemInterfacesItem `json:"interfaces"`
+	Inventory             OptNilDataLoadRequestDumpHostsItemInventory     `json:"inventory"`
+	Name                  OptString                                       `json:"name"`
+	Provision             OptBool                                         `json:"provision"`
+	Revision              OptNilInt64                                     `json:"revision"`
+	SmbiosUUID            OptString                                       `json:"smbios_uuid"`
+	State                 OptString                                       `json:"state"`
+	Tags                  OptNilStringArray                               `json:"tags"`
+	UID                   OptNilString                                    `json:"uid"`
+	UpdatedAt             OptNilDateTime                                  `json:"updated_at"`
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o NilDataDumpHostsItemInterfacesItem) Or(d DataDumpHostsItemInterfacesItem) DataDumpHostsItemInterfacesItem {
-	if v, ok := o.Get(); ok {
-		return v
-	}
-	return d
+// GetBonds returns the value of Bonds.
+func (s *DataLoadRequestDumpHostsItem) GetBonds() []NilDataLoadRequestDumpHostsItemBondsItem {
+	return s.Bonds
 }
 
-// NewNilDataDumpImagesItem returns new NilDataDumpImagesItem with value set to v.
-func NewNilDataDumpImagesItem(v DataDumpImagesItem) NilDataDumpImagesItem {
-	return NilDataDumpImagesItem{
-		Value: v,
-	}
+// GetBootImage returns the value of BootImage.
+func (s *DataLoadRequestDumpHostsItem) GetBootImage() OptString {
+	return s.BootImage
 }
 
-// NilDataDumpImagesItem is nullable DataDumpImagesItem.
-type NilDataDumpImagesItem struct {
-	Value DataDumpImagesItem
-	Null  bool
+// GetClientCertFingerprint returns the value of ClientCertFingerprint.
+func (s *DataLoadRequestDumpHostsItem) GetClientCertFingerprint() OptString {
+	return s.ClientCertFingerprint
 }
 
-// SetTo sets value to v.
-func (o *NilDataDumpImagesItem) SetTo(v DataDumpImagesItem) {
-	o.Null = false
-	o.Value = v
+// GetClientCertSerial returns the value of ClientCertSerial.
+func (s *DataLoadRequestDumpHostsItem) GetClientCertSerial() OptString {
+	return s.ClientCertSerial
 }
 
-// IsSet returns true if value is Null.
-func (o NilDataDumpImagesItem) IsNull() bool { return o.Null }
+// GetCreatedAt returns the value of CreatedAt.
+func (s *DataLoadRequestDumpHostsItem) GetCreatedAt() OptNilDateTime {
+	return s.CreatedAt
+}
 
-// SetNull sets value to null.
-func (o *NilDataDumpImagesItem) SetToNull() {
-	o.Null = true
-	var v DataDumpImagesItem
-	o.Value = v
+// GetFirmware returns the value of Firmware.
+func (s *DataLoadRequestDumpHostsItem) GetFirmware() OptString {
+	return s.Firmware
 }
 
-// Get returns value and boolean that denotes whether value was set.
-func (o NilDataDumpImagesItem) Get() (v DataDumpImagesItem, ok bool) {
-	if o.Null {
-		return v, false
-	}
-	return o.Value, true
+// GetHardware returns the value of Hardware.
+func (s *DataLoadRequestDumpHostsItem) GetHardware() OptNilDataLoadRequestDumpHostsItemHardware {
+	return s.Hardware
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o NilDataDumpImagesItem) Or(d DataDumpImagesItem) DataDumpImagesItem {
-	if v, ok := o.Get(); ok {
-		return v
-	}
-	return d
+// GetID returns the value of ID.
+func (s *DataLoadRequestDumpHostsItem) GetID() OptNilInt64 {
+	return s.ID
 }
 
-// NewNilHostBondsItem returns new NilHostBondsItem with value set to v.
-func NewNilHostBondsItem(v HostBondsItem) NilHostBondsItem {
-	return NilHostBondsItem{
-		Value: v,
-	}
+// GetInterfaces returns the value of Interfaces.
+func (s *DataLoadRequestDumpHostsItem) GetInterfaces() []NilDataLoadRequestDumpHostsItemInterfacesItem {
+	return s.Interfaces
 }
 
-// NilHostBondsItem is nullable HostBondsItem.
-type NilHostBondsItem struct {
-	Value HostBondsItem
-	Null  bool
+// GetInventory returns the value of Inventory.
+func (s *DataLoadRequestDumpHostsItem) GetInventory() OptNilDataLoadRequestDumpHostsItemInventory {
+	return s.Inventory
 }
 
-// SetTo sets value to v.
-func (o *NilHostBondsItem) SetTo(v HostBondsItem) {
-	o.Null = false
-	o.Value = v
+// GetName returns the value of Name.
+func (s *DataLoadRequestDumpHostsItem) GetName() OptString {
+	return s.Name
 }
 
-// IsSet returns true if value is Null.
-func (o NilHostBondsItem) IsNull() bool { return o.Null }
+// GetProvision returns the value of Provision.
+func (s *DataLoadRequestDumpHostsItem) GetProvision() OptBool {
+	return s.Provision
+}
 
-// SetNull sets value to null.
-func (o *NilHostBondsItem) SetToNull() {
-	o.Null = true
-	var v HostBondsItem
-	o.Value = v
+// GetRevision returns the value of Revision.
+func (s *DataLoadRequestDumpHostsItem) GetRevision() OptNilInt64 {
+	return s.Revision
 }
 
-// Get returns value and boolean that denotes whether value was set.
-func (o NilHostBondsItem) Get() (v HostBondsItem, ok bool) {
-	if o.Null {
-		return v, false
-	}
-	return o.Value, true
+// GetSmbiosUUID returns the value of SmbiosUUID.
+func (s *DataLoadRequestDumpHostsItem) GetSmbiosUUID() OptString {
+	return s.SmbiosUUID
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o NilHostBondsItem) Or(d HostBondsItem) HostBondsItem {
-	if v, ok := o.Get(); ok {
-		return v
-	}
-	return d
+// GetState returns the value of State.
+func (s *DataLoadRequestDumpHostsItem) GetState() OptString {
+	return s.State
 }
 
-// NewNilHostInterfacesItem returns new NilHostInterfacesItem with value set to v.
-func NewNilHostInterfacesItem(v HostInterfacesItem) NilHostInterfacesItem {
-	return NilHostInterfacesItem{
-		Value: v,
-	}
+// GetTags returns the value of Tags.
+func (s *DataLoadRequestDumpHostsItem) GetTags() OptNilStringArray {
+	return s.Tags
 }
 
-// NilHostInterfacesItem is nullable HostInterfacesItem.
-type NilHostInterfacesItem struct {
-	Value HostInterfacesItem
-	Null  bool
+// GetUID returns the value of UID.
+func (s *DataLoadRequestDumpHostsItem) GetUID() OptNilString {
+	return s.UID
 }
 
-// SetTo sets value to v.
-func (o *NilHostInterfacesItem) SetTo(v HostInterfacesItem) {
-	o.Null = false
-	o.Value = v
+// GetUpdatedAt returns the value of UpdatedAt.
+func (s *DataLoadRequestDumpHostsItem) GetUpdatedAt() OptNilDateTime {
+	return s.UpdatedAt
 }
 
-// IsSet returns true if value is Null.
-func (o NilHostInterfacesItem) IsNull() bool { return o.Null }
+// SetBonds sets the value of Bonds.
+func (s *DataLoadRequestDumpHostsItem) SetBonds(val []NilDataLoadRequestDumpHostsItemBondsItem) {
+	s.Bonds = val
+}
 
-// SetNull sets value to null.
-func (o *NilHostInterfacesItem) SetToNull() {
-	o.Null = true
-	var v HostInterfacesItem
-	o.Value = v
+// SetBootImage sets the value of BootImage.
+func (s *DataLoadRequestDumpHostsItem) SetBootImage(val OptString) {
+	s.BootImage = val
 }
 
-// Get returns value and boolean that denotes whether value was set.
-func (o NilHostInterfacesItem) Get() (v HostInterfacesItem, ok bool) {
-	if o.Null {
-		return v, false
-	}
-	return o.Value, true
+// SetClientCertFingerprint sets the value of ClientCertFingerprint.
+func (s *DataLoadRequestDumpHostsItem) SetClientCertFingerprint(val OptString) {
+	s.ClientCertFingerprint = val
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o NilHostInterfacesItem) Or(d HostInterfacesItem) HostInterfacesItem {
-	if v, ok := o.Get(); ok {
-		return v
-	}
-	return d
+// SetClientCertSerial sets the value of ClientCertSerial.
+func (s *DataLoadRequestDumpHostsItem) SetClientCertSerial(val OptString) {
+	s.ClientCertSerial = val
 }
 
-// NewNilInt returns new NilInt with value set to v.
-func NewNilInt(v int) NilInt {
-	return NilInt{
-		Value: v,
-	}
+// SetCreatedAt sets the value of CreatedAt.
+func (s *DataLoadRequestDumpHostsItem) SetCreatedAt(val OptNilDateTime) {
+	s.CreatedAt = val
 }
 
-// NilInt is nullable int.
-type NilInt struct {
-	Value int
-	Null  bool
+// SetFirmware sets the value of Firmware.
+func (s *DataLoadRequestDumpHostsItem) SetFirmware(val OptString) {
+	s.Firmware = val
 }
 
-// SetTo sets value to v.
-func (o *NilInt) SetTo(v int) {
-	o.Null = false
-	o.Value = v
+// SetHardware sets the value of Hardware.
+func (s *DataLoadRequestDumpHostsItem) SetHardware(val OptNilDataLoadRequestDumpHostsItemHardware) {
+	s.Hardware = val
 }
 
-// IsSet returns true if value is Null.
-func (o NilInt) IsNull() bool { return o.Null }
+// SetID sets the value of ID.
+func (s *DataLoadRequestDumpHostsItem) SetID(val OptNilInt64) {
+	s.ID = val
+}
 
-// SetNull sets value to null.
-func (o *NilInt) SetToNull() {
-	o.Null = true
-	var v int
-	o.Value = v
+// SetInterfaces sets the value of Interfaces.
+func (s *DataLoadRequestDumpHostsItem) SetInterfaces(val []NilDataLoadRequestDumpHostsItemInterfacesItem) {
+	s.Interfaces = val
 }
 
-// Get returns value and boolean that denotes whether value was set.
-func (o NilInt) Get() (v int, ok bool) {
-	if o.Null {
-		return v, false
-	}
-	return o.Value, true
+// SetInventory sets the value of Inventory.
+func (s *DataLoadRequestDumpHostsItem) SetInventory(val OptNilDataLoadRequestDumpHostsItemInventory) {
+	s.Inventory = val
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o NilInt) Or(d int) int {
-	if v, ok := o.Get(); ok {
-		return v
-	}
-	return d
+// SetName sets the value of Name.
+func (s *DataLoadRequestDumpHostsItem) SetName(val OptString) {
+	s.Name = val
 }
 
-// NewNilNodeAddRequestNodeListItem returns new NilNodeAddRequestNodeListItem with value set to v.
-func NewNilNodeAddRequestNodeListItem(v NodeAddRequestNodeListItem) NilNodeAddRequestNodeListItem {
-	return NilNodeAddRequestNodeListItem{
-		Value: v,
-	}
+// SetProvision sets the value of Provision.
+func (s *DataLoadRequestDumpHostsItem) SetProvision(val OptBool) {
+	s.Provision = val
 }
 
-// NilNodeAddRequestNodeListItem is nullable NodeAddRequestNodeListItem.
-type NilNodeAddRequestNodeListItem struct {
-	Value NodeAddRequestNodeListItem
-	Null  bool
+// SetRevision sets the value of Revision.
+func (s *DataLoadRequestDumpHostsItem) SetRevision(val OptNilInt64) {
+	s.Revision = val
 }
 
-// SetTo sets value to v.
-func (o *NilNodeAddRequestNodeListItem) SetTo(v NodeAddRequestNodeListItem) {
-	o.Null = false
-	o.Value = v
+// SetSmbiosUUID sets the value of SmbiosUUID.
+func (s *DataLoadRequestDumpHostsItem) SetSmbiosUUID(val OptString) {
+	s.SmbiosUUID = val
 }
 
-// IsSet returns true if value is Null.
-func (o NilNodeAddRequestNodeListItem) IsNull() bool { return o.Null }
+// SetState sets the value of State.
+func (s *DataLoadRequestDumpHostsItem) SetState(val OptString) {
+	s.State = val
+}
 
-// SetNull sets value to null.
-func (o *NilNodeAddRequestNodeListItem) SetToNull() {
-	o.Null = true
-	var v NodeAddRequestNodeListItem
-	o.Value = v
+// SetTags sets the value of Tags.
+func (s *DataLoadRequestDumpHostsItem) SetTags(val OptNilStringArray) {
+	s.Tags = val
 }
 
-// Get returns value and boolean that denotes whether value was set.
-func (o NilNodeAddRequestNodeListItem) Get() (v NodeAddRequestNodeListItem, ok bool) {
-	if o.Null {
-		return v, false
-	}
-	return o.Value, true
+// SetUID sets the value of UID.
+func (s *DataLoadRequestDumpHostsItem) SetUID(val OptNilString) {
+	s.UID = val
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o NilNodeAddRequestNodeListItem) Or(d NodeAddRequestNodeListItem) NodeAddRequestNodeListItem {
-	if v, ok := o.Get(); ok {
-		return v
-	}
-	return d
+// SetUpdatedAt sets the value of UpdatedAt.
+func (s *DataLoadRequestDumpHostsItem) SetUpdatedAt(val OptNilDateTime) {
+	s.UpdatedAt = val
 }
 
-// NewNilNodeAddRequestNodeListItemBondsItem returns new NilNodeAddRequestNodeListItemBondsItem with value set to v.
-func NewNilNodeAddRequestNodeListItemBondsItem(v NodeAddRequestNodeListItemBondsItem) NilNodeAddRequestNodeListItemBondsItem {
-	return NilNodeAddRequestNodeListItemBondsItem{
-		Value: v,
-	}
+type DataLoadRequestDumpHostsItemBondsItem struct {
+	Addresses []DataLoadRequestDumpHostsItemBondsItemAddressesItem `json:"addresses"`
+	Bmc       OptBool                                              `json:"bmc"`
+	Fqdn      OptString                                            `json:"fqdn"`
+	ID        OptNilInt64                                          `json:"id"`
+	Ifname    OptString                                            `json:"ifname"`
+	IP        OptString                                            `json:"ip"`
+	MAC       OptString                                            `json:"mac"`
+	Mode      OptString                                            `json:"mode"`
+	Mtu       OptInt                                               `json:"mtu"`
+	Options   OptDataLoadRequestDumpHostsItemBondsItemOptions      `json:"options"`
+	Parent    OptString                                            `json:"parent"`
+	Peers     []string                                             `json:"peers"`
+	Port      OptInt                                               `json:"port"`
+	Switch    OptString                                            `json:"switch"`
+	Type      OptString                                            `json:"type"`
+	Vlan      OptInt                                               `json:"vlan"`
 }
 
-// NilNodeAddRequestNodeListItemBondsItem is nullable NodeAddRequestNodeListItemBondsItem.
-type NilNodeAddRequestNodeListItemBondsItem struct {
-	Value NodeAddRequestNodeListItemBondsItem
-	Null  bool
+// GetAddresses returns the value of Addresses.
+func (s *DataLoadRequestDumpHostsItemBondsItem) GetAddresses() []DataLoadRequestDumpHostsItemBondsItemAddressesItem {
+	return s.Addresses
 }
 
-// SetTo sets value to v.
-func (o *NilNodeAddRequestNodeListItemBondsItem) SetTo(v NodeAddRequestNodeListItemBondsItem) {
-	o.Null = false
-	o.Value = v
+// GetBmc returns the value of Bmc.
+func (s *DataLoadRequestDumpHostsItemBondsItem) GetBmc() OptBool {
+	return s.Bmc
 }
 
-// IsSet returns true if value is Null.
-func (o NilNodeAddRequestNodeListItemBondsItem) IsNull() bool { return o.Null }
+// GetFqdn returns the value of Fqdn.
+func (s *DataLoadRequestDumpHostsItemBondsItem) GetFqdn() OptString {
+	return s.Fqdn
+}
 
-// SetNull sets value to null.
-func (o *NilNodeAddRequestNodeListItemBondsItem) SetToNull() {
-	o.Null = true
-	var v NodeAddRequestNodeListItemBondsItem
-	o.Value = v
+// GetID returns the value of ID.
+func (s *DataLoadRequestDumpHostsItemBondsItem) GetID() OptNilInt64 {
+	return s.ID
 }
 
-// Get returns value and boolean that denotes whether value was set.
-func (o NilNodeAddRequestNodeListItemBondsItem) Get() (v NodeAddRequestNodeListItemBondsItem, ok bool) {
-	if o.Null {
-		return v, false
-	}
-	return o.Value, true
+// GetIfname returns the value of Ifname.
+func (s *DataLoadRequestDumpHostsItemBondsItem) GetIfname() OptString {
+	return s.Ifname
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o NilNodeAddRequestNodeListItemBondsItem) Or(d NodeAddRequestNodeListItemBondsItem) NodeAddRequestNodeListItemBondsItem {
-	if v, ok := o.Get(); ok {
-		return v
-	}
-	return d
+// GetIP returns the value of IP.
+func (s *DataLoadRequestDumpHostsItemBondsItem) GetIP() OptString {
+	return s.IP
 }
 
-// NewNilNodeAddRequestNodeListItemInterfacesItem returns new NilNodeAddRequestNodeListItemInterfacesItem with value set to v.
-func NewNilNodeAddRequestNodeListItemInterfacesItem(v NodeAddRequestNodeListItemInterfacesItem) NilNodeAddRequestNodeListItemInterfacesItem {
-	return NilNodeAddRequestNodeListItemInterfacesItem{
-		Value: v,
-	}
+// GetMAC returns the value of MAC.
+func (s *DataLoadRequestDumpHostsItemBondsItem) GetMAC() OptString {
+	return s.MAC
 }
 
-// NilNodeAddRequestNodeListItemInterfacesItem is nullable NodeAddRequestNodeListItemInterfacesItem.
-type NilNodeAddRequestNodeListItemInterfacesItem struct {
-	Value NodeAddRequestNodeListItemInterfacesItem
-	Null  bool
+// GetMode returns the value of Mode.
+func (s *DataLoadRequestDumpHostsItemBondsItem) GetMode() OptString {
+	return s.Mode
 }
 
-// SetTo sets value to v.
-func (o *NilNodeAddRequestNodeListItemInterfacesItem) SetTo(v NodeAddRequestNodeListItemInterfacesItem) {
-	o.Null = false
-	o.Value = v
+// GetMtu returns the value of Mtu.
+func (s *DataLoadRequestDumpHostsItemBondsItem) GetMtu() OptInt {
+	return s.Mtu
 }
 
-// IsSet returns true if value is Null.
-func (o NilNodeAddRequestNodeListItemInterfacesItem) IsNull() bool { return o.Null }
-
-// SetNull sets value to null.
-func (o *NilNodeAddRequestNodeListItemInterfacesItem) SetToNull() {
-	o.Null = true
-	var v NodeAddRequestNodeListItemInterfacesItem
-	o.Value = v
+// GetOptions returns the value of Options.
+func (s *DataLoadRequestDumpHostsItemBondsItem) GetOptions() OptDataLoadRequestDumpHostsItemBondsItemOptions {
+	return s.Options
 }
 
-// Get returns value and boolean that denotes whether value was set.
-func (o NilNodeAddRequestNodeListItemInterfacesItem) Get() (v NodeAddRequestNodeListItemInterfacesItem, ok bool) {
-	if o.Null {
-		return v, false
-	}
-	return o.Value, true
+// GetParent returns the value of Parent.
+func (s *DataLoadRequestDumpHostsItemBondsItem) GetParent() OptString {
+	return s.Parent
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o NilNodeAddRequestNodeListItemInterfacesItem) Or(d NodeAddRequestNodeListItemInterfacesItem) NodeAddRequestNodeListItemInterfacesItem {
-	if v, ok := o.Get(); ok {
-		return v
-	}
-	return d
+// GetPeers returns the value of Peers.
+func (s *DataLoadRequestDumpHostsItemBondsItem) GetPeers() []string {
+	return s.Peers
 }
 
-// NewNilRedfishJobJobsItem returns new NilRedfishJobJobsItem with value set to v.
-func NewNilRedfishJobJobsItem(v RedfishJobJobsItem) NilRedfishJobJobsItem {
-	return NilRedfishJobJobsItem{
-		Value: v,
-	}
+// GetPort returns the value of Port.
+func (s *DataLoadRequestDumpHostsItemBondsItem) GetPort() OptInt {
+	return s.Port
 }
 
-// NilRedfishJobJobsItem is nullable RedfishJobJobsItem.
-type NilRedfishJobJobsItem struct {
-	Value RedfishJobJobsItem
-	Null  bool
+// GetSwitch returns the value of Switch.
+func (s *DataLoadRequestDumpHostsItemBondsItem) GetSwitch() OptString {
+	return s.Switch
 }
 
-// SetTo sets value to v.
-func (o *NilRedfishJobJobsItem) SetTo(v RedfishJobJobsItem) {
-	o.Null = false
-	o.Value = v
+// GetType returns the value of Type.
+func (s *DataLoadRequestDumpHostsItemBondsItem) GetType() OptString {
+	return s.Type
 }
 
-// IsSet returns true if value is Null.
-func (o NilRedfishJobJobsItem) IsNull() bool { return o.Null }
-
-// SetNull sets value to null.
-func (o *NilRedfishJobJobsItem) SetToNull() {
-	o.Null = true
-	var v RedfishJobJobsItem
-	o.Value = v
+// GetVlan returns the value of Vlan.
+func (s *DataLoadRequestDumpHostsItemBondsItem) GetVlan() OptInt {
+	return s.Vlan
 }
 
-// Get returns value and boolean that denotes whether value was set.
-func (o NilRedfishJobJobsItem) Get() (v RedfishJobJobsItem, ok bool) {
-	if o.Null {
-		return v, false
-	}
-	return o.Value, true
+// SetAddresses sets the value of Addresses.
+func (s *DataLoadRequestDumpHostsItemBondsItem) SetAddresses(val []DataLoadRequestDumpHostsItemBondsItemAddressesItem) {
+	s.Addresses = val
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o NilRedfishJobJobsItem) Or(d RedfishJobJobsItem) RedfishJobJobsItem {
-	if v, ok := o.Get(); ok {
-		return v
-	}
-	return d
+// SetBmc sets the value of Bmc.
+func (s *DataLoadRequestDumpHostsItemBondsItem) SetBmc(val OptBool) {
+	s.Bmc = val
 }
 
-// NewNilRedfishMetricReportReportsItem returns new NilRedfishMetricReportReportsItem with value set to v.
-func NewNilRedfishMetricReportReportsItem(v RedfishMetricReportReportsItem) NilRedfishMetricReportReportsItem {
-	return NilRedfishMetricReportReportsItem{
-		Value: v,
-	}
+// SetFqdn sets the value of Fqdn.
+func (s *DataLoadRequestDumpHostsItemBondsItem) SetFqdn(val OptString) {
+	s.Fqdn = val
 }
 
-// NilRedfishMetricReportReportsItem is nullable RedfishMetricReportReportsItem.
-type NilRedfishMetricReportReportsItem struct {
-	Value RedfishMetricReportReportsItem
-	Null  bool
+// SetID sets the value of ID.
+func (s *DataLoadRequestDumpHostsItemBondsItem) SetID(val OptNilInt64) {
+	s.ID = val
 }
 
-// SetTo sets value to v.
-func (o *NilRedfishMetricReportReportsItem) SetTo(v RedfishMetricReportReportsItem) {
-	o.Null = false
-	o.Value = v
+// SetIfname sets the value of Ifname.
+func (s *DataLoadRequestDumpHostsItemBondsItem) SetIfname(val OptString) {
+	s.Ifname = val
 }
 
-// IsSet returns true if value is Null.
-func (o NilRedfishMetricReportReportsItem) IsNull() bool { return o.Null }
-
-// SetNull sets value to null.
-func (o *NilRedfishMetricReportReportsItem) SetToNull() {
-	o.Null = true
-	var v RedfishMetricReportReportsItem
-	o.Value = v
+// SetIP sets the value of IP.
+func (s *DataLoadRequestDumpHostsItemBondsItem) SetIP(val OptString) {
+	s.IP = val
 }
 
-// Get returns value and boolean that denotes whether value was set.
-func (o NilRedfishMetricReportReportsItem) Get() (v RedfishMetricReportReportsItem, ok bool) {
-	if o.Null {
-		return v, false
-	}
-	return o.Value, true
+// SetMAC sets the value of MAC.
+func (s *DataLoadRequestDumpHostsItemBondsItem) SetMAC(val OptString) {
+	s.MAC = val
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o NilRedfishMetricReportReportsItem) Or(d RedfishMetricReportReportsItem) RedfishMetricReportReportsItem {
-	if v, ok := o.Get(); ok {
-		return v
-	}
-	return d
+// SetMode sets the value of Mode.
+func (s *DataLoadRequestDumpHostsItemBondsItem) SetMode(val OptString) {
+	s.Mode = val
 }
 
-// NewNilString returns new NilString with value set to v.
-func NewNilString(v string) NilString {
-	return NilString{
-		Value: v,
-	}
+// SetMtu sets the value of Mtu.
+func (s *DataLoadRequestDumpHostsItemBondsItem) SetMtu(val OptInt) {
+	s.Mtu = val
 }
 
-// NilString is nullable string.
-type NilString struct {
-	Value string
-	Null  bool
+// SetOptions sets the value of Options.
+func (s *DataLoadRequestDumpHostsItemBondsItem) SetOptions(val OptDataLoadRequestDumpHostsItemBondsItemOptions) {
+	s.Options = val
 }
 
-// SetTo sets value to v.
-func (o *NilString) SetTo(v string) {
-	o.Null = false
-	o.Value = v
+// SetParent sets the value of Parent.
+func (s *DataLoadRequestDumpHostsItemBondsItem) SetParent(val OptString) {
+	s.Parent = val
 }
 
-// IsSet returns true if value is Null.
-func (o NilString) IsNull() bool { return o.Null }
+// SetPeers sets the value of Peers.
+func (s *DataLoadRequestDumpHostsItemBondsItem) SetPeers(val []string) {
+	s.Peers = val
+}
 
-// SetNull sets value to null.
-func (o *NilString) SetToNull() {
-	o.Null = true
-	var v string
-	o.Value = v
+// SetPort sets the value of Port.
+func (s *DataLoadRequestDumpHostsItemBondsItem) SetPort(val OptInt) {
+	s.Port = val
 }
 
-// Get returns value and boolean that denotes whether value was set.
-func (o NilString) Get() (v string, ok bool) {
-	if o.Null {
-		return v, false
-	}
-	return o.Value, true
+// SetSwitch sets the value of Switch.
+func (s *DataLoadRequestDumpHostsItemBondsItem) SetSwitch(val OptString) {
+	s.Switch = val
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o NilString) Or(d string) string {
-	if v, ok := o.Get(); ok {
-		return v
-	}
-	return d
+// SetType sets the value of Type.
+func (s *DataLoadRequestDumpHostsItemBondsItem) SetType(val OptString) {
+	s.Type = val
 }
 
-// NodeAddRequest schema.
-// Ref: #/components/schemas/NodeAddRequest
-type NodeAddRequest struct {
-	NodeList []NilNodeAddRequestNodeListItem `json:"node_list"`
+// SetVlan sets the value of Vlan.
+func (s *DataLoadRequestDumpHostsItemBondsItem) SetVlan(val OptInt) {
+	s.Vlan = val
 }
 
-// GetNodeList returns the value of NodeList.
-func (s *NodeAddRequest) GetNodeList() []NilNodeAddRequestNodeListItem {
-	return s.NodeList
+type DataLoadRequestDumpHostsItemBondsItemAddressesItem struct {
+	Fqdn    OptString `json:"fqdn"`
+	IP      OptString `json:"ip"`
+	Primary OptBool   `json:"primary"`
 }
 
-// SetNodeList sets the value of NodeList.
-func (s *NodeAddRequest) SetNodeList(val []NilNodeAddRequestNodeListItem) {
-	s.NodeList = val
+// GetFqdn returns the value of Fqdn.
+func (s *DataLoadRequestDumpHostsItemBondsItemAddressesItem) GetFqdn() OptString {
+	return s.Fqdn
 }
 
-type NodeAddRequestNodeListItem struct {
-	Bonds      []NilNodeAddRequestNodeListItemBondsItem      `json:"bonds"`
-	BootImage  OptString                                     `json:"boot_image"`
-	Firmware   OptString                                     `json:"firmware"`
-	ID         OptNilInt64                                   `json:"id"`
-	Interfaces []NilNodeAddRequestNodeListItemInterfacesItem `json:"interfaces"`
-	Name       OptString                                     `json:"name"`
-	Provision  OptBool                                       `json:"provision"`
-	Tags       OptNilStringArray                             `json:"tags"`
-	UID        OptNilString                                  `json:"uid"`
+// GetIP returns the value of IP.
+func (s *DataLoadRequestDumpHostsItemBondsItemAddressesItem) GetIP() OptString {
+	return s.IP
 }
 
-// GetBonds returns the value of Bonds.
-func (s *NodeAddRequestNodeListItem) GetBonds() []NilNodeAddRequestNodeListItemBondsItem {
-	return s.Bonds
+// GetPrimary returns the value of Primary.
+func (s *DataLoadRequestDumpHostsItemBondsItemAddressesItem) GetPrimary() OptBool {
+	return s.Primary
 }
 
-// GetBootImage returns the value of BootImage.
-func (s *NodeAddRequestNodeListItem) GetBootImage() OptString {
-	return s.BootImage
+// SetFqdn sets the value of Fqdn.
+func (s *DataLoadRequestDumpHostsItemBondsItemAddressesItem) SetFqdn(val OptString) {
+	s.Fqdn = val
 }
 
-// GetFirmware returns the value of Firmware.
-func (s *NodeAddRequestNodeListItem) GetFirmware() OptString {
-	return s.Firmware
+// SetIP sets the value of IP.
+func (s *DataLoadRequestDumpHostsItemBondsItemAddressesItem) SetIP(val OptString) {
+	s.IP = val
 }
 
-// GetID returns the value of ID.
-func (s *NodeAddRequestNodeListItem) GetID() OptNilInt64 {
-	return s.ID
+// SetPrimary sets the value of Primary.
+func (s *DataLoadRequestDumpHostsItemBondsItemAddressesItem) SetPrimary(val OptBool) {
+	s.Primary = val
 }
 
-// GetInterfaces returns the value of Interfaces.
-func (s *NodeAddRequestNodeListItem) GetInterfaces() []NilNodeAddRequestNodeListItemInterfacesItem {
-	return s.Interfaces
+type DataLoadRequestDumpHostsItemBondsItemOptions map[string]string
+
+func (s *DataLoadRequestDumpHostsItemBondsItemOptions) init() DataLoadRequestDumpHostsItemBondsItemOptions {
+	m := *s
+	if m == nil {
+		m = map[string]string{}
+		*s = m
+	}
+	return m
 }
 
-// GetName returns the value of Name.
-func (s *NodeAddRequestNodeListItem) GetName() OptString {
-	return s.Name
+type DataLoadRequestDumpHostsItemHardware struct {
+	CollectedAt OptNilDateTime                                              `json:"collected_at"`
+	CoreCount   OptInt                                                      `json:"core_count"`
+	CPUCount    OptInt                                                      `json:"cpu_count"`
+	CPUModel    OptString                                                   `json:"cpu_model"`
+	Dimms       OptNilNilDataLoadRequestDumpHostsItemHardwareDimmsItemArray `json:"dimms"`
+	Disks       OptNilNilDataLoadRequestDumpHostsItemHardwareDisksItemArray `json:"disks"`
+	MemoryMib   OptInt                                                      `json:"memory_mib"`
+	Missing     OptNilStringArray                                           `json:"missing"`
+	Nics        OptNilNilDataLoadRequestDumpHostsItemHardwareNicsItemArray  `json:"nics"`
 }
 
-// GetProvision returns the value of Provision.
-func (s *NodeAddRequestNodeListItem) GetProvision() OptBool {
-	return s.Provision
+// GetCollectedAt returns the value of CollectedAt.
+func (s *DataLoadRequestDumpHostsItemHardware) GetCollectedAt() OptNilDateTime {
+	return s.CollectedAt
 }
 
-// GetTags returns the value of Tags.
-func (s *NodeAddRequestNodeListItem) GetTags() OptNilStringArray {
-	return s.Tags
+// GetCoreCount returns the value of CoreCount.
+func (s *DataLoadRequestDumpHostsItemHardware) GetCoreCount() OptInt {
+	return s.CoreCount
 }
 
-// GetUID returns the value of UID.
-func (s *NodeAddRequestNodeListItem) GetUID() OptNilString {
-	return s.UID
+// GetCPUCount returns the value of CPUCount.
+func (s *DataLoadRequestDumpHostsItemHardware) GetCPUCount() OptInt {
+	return s.CPUCount
 }
 
-// SetBonds sets the value of Bonds.
-func (s *NodeAddRequestNodeListItem) SetBonds(val []NilNodeAddRequestNodeListItemBondsItem) {
-	s.Bonds = val
+// GetCPUModel returns the value of CPUModel.
+func (s *DataLoadRequestDumpHostsItemHardware) GetCPUModel() OptString {
+	return s.CPUModel
 }
 
-// SetBootImage sets the value of BootImage.
-func (s *NodeAddRequestNodeListItem) SetBootImage(val OptString) {
-	s.BootImage = val
+// GetDimms returns the value of Dimms.
+func (s *DataLoadRequestDumpHostsItemHardware) GetDimms() OptNilNilDataLoadRequestDumpHostsItemHardwareDimmsItemArray {
+	return s.Dimms
 }
 
-// SetFirmware sets the value of Firmware.
-func (s *NodeAddRequestNodeListItem) SetFirmware(val OptString) {
-	s.Firmware = val
+// GetDisks returns the value of Disks.
+func (s *DataLoadRequestDumpHostsItemHardware) GetDisks() OptNilNilDataLoadRequestDumpHostsItemHardwareDisksItemArray {
+	return s.Disks
 }
 
-// SetID sets the value of ID.
-func (s *NodeAddRequestNodeListItem) SetID(val OptNilInt64) {
-	s.ID = val
+// GetMemoryMib returns the value of MemoryMib.
+func (s *DataLoadRequestDumpHostsItemHardware) GetMemoryMib() OptInt {
+	return s.MemoryMib
 }
 
-// SetInterfaces sets the value of Interfaces.
-func (s *NodeAddRequestNodeListItem) SetInterfaces(val []NilNodeAddRequestNodeListItemInterfacesItem) {
-	s.Interfaces = val
+// GetMissing returns the value of Missing.
+func (s *DataLoadRequestDumpHostsItemHardware) GetMissing() OptNilStringArray {
+	return s.Missing
 }
 
-// SetName sets the value of Name.
-func (s *NodeAddRequestNodeListItem) SetName(val OptString) {
-	s.Name = val
+// GetNics returns the value of Nics.
+func (s *DataLoadRequestDumpHostsItemHardware) GetNics() OptNilNilDataLoadRequestDumpHostsItemHardwareNicsItemArray {
+	return s.Nics
 }
 
-// SetProvision sets the value of Provision.
-func (s *NodeAddRequestNodeListItem) SetProvision(val OptBool) {
-	s.Provision = val
+// SetCollectedAt sets the value of CollectedAt.
+func (s *DataLoadRequestDumpHostsItemHardware) SetCollectedAt(val OptNilDateTime) {
+	s.CollectedAt = val
 }
 
-// SetTags sets the value of Tags.
-func (s *NodeAddRequestNodeListItem) SetTags(val OptNilStringArray) {
-	s.Tags = val
+// SetCoreCount sets the value of CoreCount.
+func (s *DataLoadRequestDumpHostsItemHardware) SetCoreCount(val OptInt) {
+	s.CoreCount = val
 }
 
-// SetUID sets the value of UID.
-func (s *NodeAddRequestNodeListItem) SetUID(val OptNilString) {
-	s.UID = val
+// SetCPUCount sets the value of CPUCount.
+func (s *DataLoadRequestDumpHostsItemHardware) SetCPUCount(val OptInt) {
+	s.CPUCount = val
 }
 
-type NodeAddRequestNodeListItemBondsItem struct {
-	Bmc    OptBool     `json:"bmc"`
-	Fqdn   OptString   `json:"fqdn"`
-	ID     OptNilInt64 `json:"id"`
-	Ifname OptString   `json:"ifname"`
-	IP     OptString   `json:"ip"`
-	MAC    OptString   `json:"mac"`
-	Mtu    OptInt      `json:"mtu"`
-	Peers  []string    `json:"peers"`
-	Vlan   OptString   `json:"vlan"`
+// SetCPUModel sets the value of CPUModel.
+func (s *DataLoadRequestDumpHostsItemHardware) SetCPUModel(val OptString) {
+	s.CPUModel = val
 }
 
-// GetBmc returns the value of Bmc.
-func (s *NodeAddRequestNodeListItemBondsItem) GetBmc() OptBool {
-	return s.Bmc
+// SetDimms sets the value of Dimms.
+func (s *DataLoadRequestDumpHostsItemHardware) SetDimms(val OptNilNilDataLoadRequestDumpHostsItemHardwareDimmsItemArray) {
+	s.Dimms = val
 }
 
-// GetFqdn returns the value of Fqdn.
-func (s *NodeAddRequestNodeListItemBondsItem) GetFqdn() OptString {
-	return s.Fqdn
+// SetDisks sets the value of Disks.
+func (s *DataLoadRequestDumpHostsItemHardware) SetDisks(val OptNilNilDataLoadRequestDumpHostsItemHardwareDisksItemArray) {
+	s.Disks = val
 }
 
-// GetID returns the value of ID.
-func (s *NodeAddRequestNodeListItemBondsItem) GetID() OptNilInt64 {
-	return s.ID
+// SetMemoryMib sets the value of MemoryMib.
+func (s *DataLoadRequestDumpHostsItemHardware) SetMemoryMib(val OptInt) {
+	s.MemoryMib = val
 }
 
-// GetIfname returns the value of Ifname.
-func (s *NodeAddRequestNodeListItemBondsItem) GetIfname() OptString {
-	return s.Ifname
+// SetMissing sets the value of Missing.
+func (s *DataLoadRequestDumpHostsItemHardware) SetMissing(val OptNilStringArray) {
+	s.Missing = val
 }
 
-// GetIP returns the value of IP.
-func (s *NodeAddRequestNodeListItemBondsItem) GetIP() OptString {
-	return s.IP
+// SetNics sets the value of Nics.
+func (s *DataLoadRequestDumpHostsItemHardware) SetNics(val OptNilNilDataLoadRequestDumpHostsItemHardwareNicsItemArray) {
+	s.Nics = val
 }
 
-// GetMAC returns the value of MAC.
-func (s *NodeAddRequestNodeListItemBondsItem) GetMAC() OptString {
-	return s.MAC
+type DataLoadRequestDumpHostsItemHardwareDimmsItem struct {
+	SizeMib OptInt    `json:"size_mib"`
+	Slot    OptString `json:"slot"`
 }
 
-// GetMtu returns the value of Mtu.
-func (s *NodeAddRequestNodeListItemBondsItem) GetMtu() OptInt {
-	return s.Mtu
+// GetSizeMib returns the value of SizeMib.
+func (s *DataLoadRequestDumpHostsItemHardwareDimmsItem) GetSizeMib() OptInt {
+	return s.SizeMib
 }
 
-// GetPeers returns the value of Peers.
-func (s *NodeAddRequestNodeListItemBondsItem) GetPeers() []string {
-	return s.Peers
+// GetSlot returns the value of Slot.
+func (s *DataLoadRequestDumpHostsItemHardwareDimmsItem) GetSlot() OptString {
+	return s.Slot
 }
 
-// GetVlan returns the value of Vlan.
-func (s *NodeAddRequestNodeListItemBondsItem) GetVlan() OptString {
-	return s.Vlan
+// SetSizeMib sets the value of SizeMib.
+func (s *DataLoadRequestDumpHostsItemHardwareDimmsItem) SetSizeMib(val OptInt) {
+	s.SizeMib = val
 }
 
-// SetBmc sets the value of Bmc.
-func (s *NodeAddRequestNodeListItemBondsItem) SetBmc(val OptBool) {
-	s.Bmc = val
+// SetSlot sets the value of Slot.
+func (s *DataLoadRequestDumpHostsItemHardwareDimmsItem) SetSlot(val OptString) {
+	s.Slot = val
 }
 
-// SetFqdn sets the value of Fqdn.
-func (s *NodeAddRequestNodeListItemBondsItem) SetFqdn(val OptString) {
-	s.Fqdn = val
+type DataLoadRequestDumpHostsItemHardwareDisksItem struct {
+	Model     OptString `json:"model"`
+	Name      OptString `json:"name"`
+	Serial    OptString `json:"serial"`
+	SizeBytes OptInt64  `json:"size_bytes"`
 }
 
-// SetID sets the value of ID.
-func (s *NodeAddRequestNodeListItemBondsItem) SetID(val OptNilInt64) {
-	s.ID = val
+// GetModel returns the value of Model.
+func (s *DataLoadRequestDumpHostsItemHardwareDisksItem) GetModel() OptString {
+	return s.Model
 }
 
-// SetIfname sets the value of Ifname.
-func (s *NodeAddRequestNodeListItemBondsItem) SetIfname(val OptString) {
-	s.Ifname = val
+// GetName returns the value of Name.
+func (s *DataLoadRequestDumpHostsItemHardwareDisksItem) GetName() OptString {
+	return s.Name
 }
 
-// SetIP sets the value of IP.
-func (s *NodeAddRequestNodeListItemBondsItem) SetIP(val OptString) {
-	s.IP = val
+// GetSerial returns the value of Serial.
+func (s *DataLoadRequestDumpHostsItemHardwareDisksItem) GetSerial() OptString {
+	return s.Serial
 }
 
-// SetMAC sets the value of MAC.
-func (s *NodeAddRequestNodeListItemBondsItem) SetMAC(val OptString) {
-	s.MAC = val
+// GetSizeBytes returns the value of SizeBytes.
+func (s *DataLoadRequestDumpHostsItemHardwareDisksItem) GetSizeBytes() OptInt64 {
+	return s.SizeBytes
 }
 
-// SetMtu sets the value of Mtu.
-func (s *NodeAddRequestNodeListItemBondsItem) SetMtu(val OptInt) {
-	s.Mtu = val
+// SetModel sets the value of Model.
+func (s *DataLoadRequestDumpHostsItemHardwareDisksItem) SetModel(val OptString) {
+	s.Model = val
 }
 
-// SetPeers sets the value of Peers.
-func (s *NodeAddRequestNodeListItemBondsItem) SetPeers(val []string) {
-	s.Peers = val
+// SetName sets the value of Name.
+func (s *DataLoadRequestDumpHostsItemHardwareDisksItem) SetName(val OptString) {
+	s.Name = val
 }
 
-// SetVlan sets the value of Vlan.
-func (s *NodeAddRequestNodeListItemBondsItem) SetVlan(val OptString) {
-	s.Vlan = val
+// SetSerial sets the value of Serial.
+func (s *DataLoadRequestDumpHostsItemHardwareDisksItem) SetSerial(val OptString) {
+	s.Serial = val
 }
 
-type NodeAddRequestNodeListItemInterfacesItem struct {
-	Bmc    OptBool     `json:"bmc"`
-	Fqdn   OptString   `json:"fqdn"`
-	ID     OptNilInt64 `json:"id"`
-	Ifname OptString   `json:"ifname"`
-	IP     OptString   `json:"ip"`
-	MAC    OptString   `json:"mac"`
-	Mtu    OptInt      `json:"mtu"`
-	Vlan   OptString   `json:"vlan"`
+// SetSizeBytes sets the value of SizeBytes.
+func (s *DataLoadRequestDumpHostsItemHardwareDisksItem) SetSizeBytes(val OptInt64) {
+	s.SizeBytes = val
+}
+
+type DataLoadRequestDumpHostsItemHardwareNicsItem struct {
+	MAC  OptString `json:"mac"`
+	Name OptString `json:"name"`
+}
+
+// GetMAC returns the value of MAC.
+func (s *DataLoadRequestDumpHostsItemHardwareNicsItem) GetMAC() OptString {
+	return s.MAC
+}
+
+// GetName returns the value of Name.
+func (s *DataLoadRequestDumpHostsItemHardwareNicsItem) GetName() OptString {
+	return s.Name
+}
+
+// SetMAC sets the value of MAC.
+func (s *DataLoadRequestDumpHostsItemHardwareNicsItem) SetMAC(val OptString) {
+	s.MAC = val
+}
+
+// SetName sets the value of Name.
+func (s *DataLoadRequestDumpHostsItemHardwareNicsItem) SetName(val OptString) {
+	s.Name = val
+}
+
+type DataLoadRequestDumpHostsItemInterfacesItem struct {
+	Addresses []DataLoadRequestDumpHostsItemInterfacesItemAddressesItem `json:"addresses"`
+	Bmc       OptBool                                                   `json:"bmc"`
+	Fqdn      OptString                                                 `json:"fqdn"`
+	ID        OptNilInt64                                               `json:"id"`
+	Ifname    OptString                                                 `json:"ifname"`
+	IP        OptString                                                 `json:"ip"`
+	MAC       OptString                                                 `json:"mac"`
+	Mtu       OptInt                                                    `json:"mtu"`
+	Parent    OptString                                                 `json:"parent"`
+	Port      OptInt                                                    `json:"port"`
+	Switch    OptString                                                 `json:"switch"`
+	Vlan      OptInt                                                    `json:"vlan"`
+}
+
+// GetAddresses returns the value of Addresses.
+func (s *DataLoadRequestDumpHostsItemInterfacesItem) GetAddresses() []DataLoadRequestDumpHostsItemInterfacesItemAddressesItem {
+	return s.Addresses
 }
 
 // GetBmc returns the value of Bmc.
-func (s *NodeAddRequestNodeListItemInterfacesItem) GetBmc() OptBool {
+func (s *DataLoadRequestDumpHostsItemInterfacesItem) GetBmc() OptBool {
 	return s.Bmc
 }
 
 // GetFqdn returns the value of Fqdn.
-func (s *NodeAddRequestNodeListItemInterfacesItem) GetFqdn() OptString {
+func (s *DataLoadRequestDumpHostsItemInterfacesItem) GetFqdn() OptString {
 	return s.Fqdn
 }
 
 // GetID returns the value of ID.
-func (s *NodeAddRequestNodeListItemInterfacesItem) GetID() OptNilInt64 {
+func (s *DataLoadRequestDumpHostsItemInterfacesItem) GetID() OptNilInt64 {
 	return s.ID
 }
 
 // GetIfname returns the value of Ifname.
-func (s *NodeAddRequestNodeListItemInterfacesItem) GetIfname() OptString {
+func (s *DataLoadRequestDumpHostsItemInterfacesItem) GetIfname() OptString {
 	return s.Ifname
 }
 
 // GetIP returns the value of IP.
-func (s *NodeAddRequestNodeListItemInterfacesItem) GetIP() OptString {
+func (s *DataLoadRequestDumpHostsItemInterfacesItem) GetIP() OptString {
 	return s.IP
 }
 
 // GetMAC returns the value of MAC.
-func (s *NodeAddRequestNodeListItemInterfacesItem) GetMAC() OptString {
+func (s *DataLoadRequestDumpHostsItemInterfacesItem) GetMAC() OptString {
 	return s.MAC
 }
 
 // GetMtu returns the value of Mtu.
-func (s *NodeAddRequestNodeListItemInterfacesItem) GetMtu() OptInt {
+func (s *DataLoadRequestDumpHostsItemInterfacesItem) GetMtu() OptInt {
 	return s.Mtu
 }
 
+// GetParent returns the value of Parent.
+func (s *DataLoadRequestDumpHostsItemInterfacesItem) GetParent() OptString {
+	return s.Parent
+}
+
+// GetPort returns the value of Port.
+func (s *DataLoadRequestDumpHostsItemInterfacesItem) GetPort() OptInt {
+	return s.Port
+}
+
+// GetSwitch returns the value of Switch.
+func (s *DataLoadRequestDumpHostsItemInterfacesItem) GetSwitch() OptString {
+	return s.Switch
+}
+
 // GetVlan returns the value of Vlan.
-func (s *NodeAddRequestNodeListItemInterfacesItem) GetVlan() OptString {
+func (s *DataLoadRequestDumpHostsItemInterfacesItem) GetVlan() OptInt {
 	return s.Vlan
 }
 
+// SetAddresses sets the value of Addresses.
+func (s *DataLoadRequestDumpHostsItemInterfacesItem) SetAddresses(val []DataLoadRequestDumpHostsItemInterfacesItemAddressesItem) {
+	s.Addresses = val
+}
+
 // SetBmc sets the value of Bmc.
-func (s *NodeAddRequestNodeListItemInterfacesItem) SetBmc(val OptBool) {
+func (s *DataLoadRequestDumpHostsItemInterfacesItem) SetBmc(val OptBool) {
 	s.Bmc = val
 }
 
 // SetFqdn sets the value of Fqdn.
-func (s *NodeAddRequestNodeListItemInterfacesItem) SetFqdn(val OptString) {
+func (s *DataLoadRequestDumpHostsItemInterfacesItem) SetFqdn(val OptString) {
 	s.Fqdn = val
 }
 
 // SetID sets the value of ID.
-func (s *NodeAddRequestNodeListItemInterfacesItem) SetID(val OptNilInt64) {
+func (s *DataLoadRequestDumpHostsItemInterfacesItem) SetID(val OptNilInt64) {
 	s.ID = val
 }
 
 // SetIfname sets the value of Ifname.
-func (s *NodeAddRequestNodeListItemInterfacesItem) SetIfname(val OptString) {
+func (s *DataLoadRequestDumpHostsItemInterfacesItem) SetIfname(val OptString) {
 	s.Ifname = val
 }
 
 // SetIP sets the value of IP.
-func (s *NodeAddRequestNodeListItemInterfacesItem) SetIP(val OptString) {
+func (s *DataLoadRequestDumpHostsItemInterfacesItem) SetIP(val OptString) {
 	s.IP = val
 }
 
 // SetMAC sets the value of MAC.
-func (s *NodeAddRequestNodeListItemInterfacesItem) SetMAC(val OptString) {
+func (s *DataLoadRequestDumpHostsItemInterfacesItem) SetMAC(val OptString) {
 	s.MAC = val
 }
 
 // SetMtu sets the value of Mtu.
-func (s *NodeAddRequestNodeListItemInterfacesItem) SetMtu(val OptInt) {
+func (s *DataLoadRequestDumpHostsItemInterfacesItem) SetMtu(val OptInt) {
 	s.Mtu = val
 }
 
-// SetVlan sets the value of Vlan.
-func (s *NodeAddRequestNodeListItemInterfacesItem) SetVlan(val OptString) {
-	s.Vlan = val
+// SetParent sets the value of Parent.
+func (s *DataLoadRequestDumpHostsItemInterfacesItem) SetParent(val OptString) {
+	s.Parent = val
 }
 
-// NodeBootImageRequest schema.
-// Ref: #/components/schemas/NodeBootImageRequest
-type NodeBootImageRequest struct {
-	Image OptString `json:"image"`
+// SetPort sets the value of Port.
+func (s *DataLoadRequestDumpHostsItemInterfacesItem) SetPort(val OptInt) {
+	s.Port = val
 }
 
-// GetImage returns the value of Image.
-func (s *NodeBootImageRequest) GetImage() OptString {
-	return s.Image
+// SetSwitch sets the value of Switch.
+func (s *DataLoadRequestDumpHostsItemInterfacesItem) SetSwitch(val OptString) {
+	s.Switch = val
 }
 
-// SetImage sets the value of Image.
-func (s *NodeBootImageRequest) SetImage(val OptString) {
-	s.Image = val
+// SetVlan sets the value of Vlan.
+func (s *DataLoadRequestDumpHostsItemInterfacesItem) SetVlan(val OptInt) {
+	s.Vlan = val
 }
 
-// NodeBootTokenResponse schema.
-// Ref: #/components/schemas/NodeBootTokenResponse
-type NodeBootTokenResponse struct {
-	Nodes []NodeBootTokenResponseNodesItem `json:"nodes"`
+type DataLoadRequestDumpHostsItemInterfacesItemAddressesItem struct {
+	Fqdn    OptString `json:"fqdn"`
+	IP      OptString `json:"ip"`
+	Primary OptBool   `json:"primary"`
 }
 
-// GetNodes returns the value of Nodes.
-func (s *NodeBootTokenResponse) GetNodes() []NodeBootTokenResponseNodesItem {
-	return s.Nodes
+// GetFqdn returns the value of Fqdn.
+func (s *DataLoadRequestDumpHostsItemInterfacesItemAddressesItem) GetFqdn() OptString {
+	return s.Fqdn
 }
 
-// SetNodes sets the value of Nodes.
-func (s *NodeBootTokenResponse) SetNodes(val []NodeBootTokenResponseNodesItem) {
-	s.Nodes = val
+// GetIP returns the value of IP.
+func (s *DataLoadRequestDumpHostsItemInterfacesItemAddressesItem) GetIP() OptString {
+	return s.IP
 }
 
-type NodeBootTokenResponseNodesItem struct {
-	Name  OptString `json:"name"`
-	Token OptString `json:"token"`
+// GetPrimary returns the value of Primary.
+func (s *DataLoadRequestDumpHostsItemInterfacesItemAddressesItem) GetPrimary() OptBool {
+	return s.Primary
 }
 
-// GetName returns the value of Name.
-func (s *NodeBootTokenResponseNodesItem) GetName() OptString {
-	return s.Name
+// SetFqdn sets the value of Fqdn.
+func (s *DataLoadRequestDumpHostsItemInterfacesItemAddressesItem) SetFqdn(val OptString) {
+	s.Fqdn = val
 }
 
-// GetToken returns the value of Token.
-func (s *NodeBootTokenResponseNodesItem) GetToken() OptString {
-	return s.Token
+// SetIP sets the value of IP.
+func (s *DataLoadRequestDumpHostsItemInterfacesItemAddressesItem) SetIP(val OptString) {
+	s.IP = val
 }
 
-// SetName sets the value of Name.
-func (s *NodeBootTokenResponseNodesItem) SetName(val OptString) {
-	s.Name = val
+// SetPrimary sets the value of Primary.
+func (s *DataLoadRequestDumpHostsItemInterfacesItemAddressesItem) SetPrimary(val OptBool) {
+	s.Primary = val
 }
 
-// SetToken sets the value of Token.
-func (s *NodeBootTokenResponseNodesItem) SetToken(val OptString) {
-	s.Token = val
+type DataLoadRequestDumpHostsItemInventory struct {
+	BiosVersion OptString                                           `json:"bios_version"`
+	BmcFirmware OptString                                           `json:"bmc_firmware"`
+	CapturedAt  OptNilDateTime                                      `json:"captured_at"`
+	NicFirmware OptDataLoadRequestDumpHostsItemInventoryNicFirmware `json:"nic_firmware"`
 }
 
-// NodeProvisionRequest schema.
-// Ref: #/components/schemas/NodeProvisionRequest
-type NodeProvisionRequest struct {
-	Provision OptBool `json:"provision"`
+// GetBiosVersion returns the value of BiosVersion.
+func (s *DataLoadRequestDumpHostsItemInventory) GetBiosVersion() OptString {
+	return s.BiosVersion
 }
 
-// GetProvision returns the value of Provision.
-func (s *NodeProvisionRequest) GetProvision() OptBool {
-	return s.Provision
+// GetBmcFirmware returns the value of BmcFirmware.
+func (s *DataLoadRequestDumpHostsItemInventory) GetBmcFirmware() OptString {
+	return s.BmcFirmware
 }
 
-// SetProvision sets the value of Provision.
-func (s *NodeProvisionRequest) SetProvision(val OptBool) {
-	s.Provision = val
+// GetCapturedAt returns the value of CapturedAt.
+func (s *DataLoadRequestDumpHostsItemInventory) GetCapturedAt() OptNilDateTime {
+	return s.CapturedAt
 }
 
-// NodeTagsRequest schema.
-// Ref: #/components/schemas/NodeTagsRequest
-type NodeTagsRequest struct {
-	// Comma separated list of tags.
-	Tags OptString `json:"tags"`
+// GetNicFirmware returns the value of NicFirmware.
+func (s *DataLoadRequestDumpHostsItemInventory) GetNicFirmware() OptDataLoadRequestDumpHostsItemInventoryNicFirmware {
+	return s.NicFirmware
 }
 
-// GetTags returns the value of Tags.
-func (s *NodeTagsRequest) GetTags() OptString {
-	return s.Tags
+// SetBiosVersion sets the value of BiosVersion.
+func (s *DataLoadRequestDumpHostsItemInventory) SetBiosVersion(val OptString) {
+	s.BiosVersion = val
 }
 
-// SetTags sets the value of Tags.
-func (s *NodeTagsRequest) SetTags(val OptString) {
-	s.Tags = val
+// SetBmcFirmware sets the value of BmcFirmware.
+func (s *DataLoadRequestDumpHostsItemInventory) SetBmcFirmware(val OptString) {
+	s.BmcFirmware = val
 }
 
-// NewOptBmcJobDeleteRequestNodeJobList returns new OptBmcJobDeleteRequestNodeJobList with value set to v.
-func NewOptBmcJobDeleteRequestNodeJobList(v BmcJobDeleteRequestNodeJobList) OptBmcJobDeleteRequestNodeJobList {
-	return OptBmcJobDeleteRequestNodeJobList{
-		Value: v,
-		Set:   true,
-	}
+// SetCapturedAt sets the value of CapturedAt.
+func (s *DataLoadRequestDumpHostsItemInventory) SetCapturedAt(val OptNilDateTime) {
+	s.CapturedAt = val
 }
 
-// OptBmcJobDeleteRequestNodeJobList is optional BmcJobDeleteRequestNodeJobList.
-type OptBmcJobDeleteRequestNodeJobList struct {
-	Value BmcJobDeleteRequestNodeJobList
-	Set   bool
+// SetNicFirmware sets the value of NicFirmware.
+func (s *DataLoadRequestDumpHostsItemInventory) SetNicFirmware(val OptDataLoadRequestDumpHostsItemInventoryNicFirmware) {
+	s.NicFirmware = val
 }
 
-// IsSet returns true if OptBmcJobDeleteRequestNodeJobList was set.
-func (o OptBmcJobDeleteRequestNodeJobList) IsSet() bool { return o.Set }
+type DataLoadRequestDumpHostsItemInventoryNicFirmware map[string]string
 
-// Reset unsets value.
-func (o *OptBmcJobDeleteRequestNodeJobList) Reset() {
-	var v BmcJobDeleteRequestNodeJobList
-	o.Value = v
-	o.Set = false
+func (s *DataLoadRequestDumpHostsItemInventoryNicFirmware) init() DataLoadRequestDumpHostsItemInventoryNicFirmware {
+	m := *s
+	if m == nil {
+		m = map[string]string{}
+		*s = m
+	}
+	return m
 }
 
-// SetTo sets value to v.
-func (o *OptBmcJobDeleteRequestNodeJobList) SetTo(v BmcJobDeleteRequestNodeJobList) {
-	o.Set = true
-	o.Value = v
+type DataLoadRequestDumpImagesItem struct {
+	Cmdline            OptString                                             `json:"cmdline"`
+	CreatedAt          OptNilDateTime                                        `json:"created_at"`
+	ID                 OptNilInt64                                           `json:"id"`
+	Inherits           OptString                                             `json:"inherits"`
+	Initrd             []string                                              `json:"initrd"`
+	Kernel             OptString                                             `json:"kernel"`
+	Liveimg            OptString                                             `json:"liveimg"`
+	Name               OptString                                             `json:"name"`
+	ProvisionTemplates OptNilDataLoadRequestDumpImagesItemProvisionTemplates `json:"provision_templates"`
+	Revision           OptNilInt64                                           `json:"revision"`
+	UID                OptNilString                                          `json:"uid"`
+	UpdatedAt          OptNilDateTime                                        `json:"updated_at"`
+	Verify             OptBool                                               `json:"verify"`
 }
 
-// Get returns value and boolean that denotes whether value was set.
-func (o OptBmcJobDeleteRequestNodeJobList) Get() (v BmcJobDeleteRequestNodeJobList, ok bool) {
-	if !o.Set {
-		return v, false
-	}
-	return o.Value, true
+// GetCmdline returns the value of Cmdline.
+func (s *DataLoadRequestDumpImagesItem) GetCmdline() OptString {
+	return s.Cmdline
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o OptBmcJobDeleteRequestNodeJobList) Or(d BmcJobDeleteRequestNodeJobList) BmcJobDeleteRequestNodeJobList {
-	if v, ok := o.Get(); ok {
-		return v
-	}
-	return d
+// GetCreatedAt returns the value of CreatedAt.
+func (s *DataLoadRequestDumpImagesItem) GetCreatedAt() OptNilDateTime {
+	return s.CreatedAt
 }
 
-// NewOptBool returns new OptBool with value set to v.
-func NewOptBool(v bool) OptBool {
-	return OptBool{
-		Value: v,
-		Set:   true,
-	}
+// GetID returns the value of ID.
+func (s *DataLoadRequestDumpImagesItem) GetID() OptNilInt64 {
+	return s.ID
 }
 
-// OptBool is optional bool.
-type OptBool struct {
-	Value bool
-	Set   bool
+// GetInherits returns the value of Inherits.
+func (s *DataLoadRequestDumpImagesItem) GetInherits() OptString {
+	return s.Inherits
 }
 
-// IsSet returns true if OptBool was set.
-func (o OptBool) IsSet() bool { return o.Set }
+// GetInitrd returns the value of Initrd.
+func (s *DataLoadRequestDumpImagesItem) GetInitrd() []string {
+	return s.Initrd
+}
 
-// Reset unsets value.
-func (o *OptBool) Reset() {
-	var v bool
-	o.Value = v
-	o.Set = false
+// GetKernel returns the value of Kernel.
+func (s *DataLoadRequestDumpImagesItem) GetKernel() OptString {
+	return s.Kernel
 }
 
-// SetTo sets value to v.
-func (o *OptBool) SetTo(v bool) {
-	o.Set = true
-	o.Value = v
+// GetLiveimg returns the value of Liveimg.
+func (s *DataLoadRequestDumpImagesItem) GetLiveimg() OptString {
+	return s.Liveimg
 }
 
-// Get returns value and boolean that denotes whether value was set.
-func (o OptBool) Get() (v bool, ok bool) {
-	if !o.Set {
-		return v, false
-	}
-	return o.Value, true
+// GetName returns the value of Name.
+func (s *DataLoadRequestDumpImagesItem) GetName() OptString {
+	return s.Name
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o OptBool) Or(d bool) bool {
-	if v, ok := o.Get(); ok {
-		return v
-	}
-	return d
+// GetProvisionTemplates returns the value of ProvisionTemplates.
+func (s *DataLoadRequestDumpImagesItem) GetProvisionTemplates() OptNilDataLoadRequestDumpImagesItemProvisionTemplates {
+	return s.ProvisionTemplates
 }
 
-// NewOptDateTime returns new OptDateTime with value set to v.
-func NewOptDateTime(v time.Time) OptDateTime {
-	return OptDateTime{
-		Value: v,
-		Set:   true,
-	}
+// GetRevision returns the value of Revision.
+func (s *DataLoadRequestDumpImagesItem) GetRevision() OptNilInt64 {
+	return s.Revision
 }
 
-// OptDateTime is optional time.Time.
-type OptDateTime struct {
-	Value time.Time
-	Set   bool
+// GetUID returns the value of UID.
+func (s *DataLoadRequestDumpImagesItem) GetUID() OptNilString {
+	return s.UID
 }
 
-// IsSet returns true if OptDateTime was set.
-func (o OptDateTime) IsSet() bool { return o.Set }
+// GetUpdatedAt returns the value of UpdatedAt.
+func (s *DataLoadRequestDumpImagesItem) GetUpdatedAt() OptNilDateTime {
+	return s.UpdatedAt
+}
 
-// Reset unsets value.
-func (o *OptDateTime) Reset() {
-	var v time.Time
-	o.Value = v
-	o.Set = false
+// GetVerify returns the value of Verify.
+func (s *DataLoadRequestDumpImagesItem) GetVerify() OptBool {
+	return s.Verify
 }
 
-// SetTo sets value to v.
-func (o *OptDateTime) SetTo(v time.Time) {
-	o.Set = true
-	o.Value = v
+// SetCmdline sets the value of Cmdline.
+func (s *DataLoadRequestDumpImagesItem) SetCmdline(val OptString) {
+	s.Cmdline = val
 }
 
-// Get returns value and boolean that denotes whether value was set.
-func (o OptDateTime) Get() (v time.Time, ok bool) {
-	if !o.Set {
-		return v, false
-	}
-	return o.Value, true
+// SetCreatedAt sets the value of CreatedAt.
+func (s *DataLoadRequestDumpImagesItem) SetCreatedAt(val OptNilDateTime) {
+	s.CreatedAt = val
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o OptDateTime) Or(d time.Time) time.Time {
-	if v, ok := o.Get(); ok {
-		return v
-	}
-	return d
+// SetID sets the value of ID.
+func (s *DataLoadRequestDumpImagesItem) SetID(val OptNilInt64) {
+	s.ID = val
 }
 
-// NewOptEventJobMessagesItemRedfishError returns new OptEventJobMessagesItemRedfishError with value set to v.
-func NewOptEventJobMessagesItemRedfishError(v EventJobMessagesItemRedfishError) OptEventJobMessagesItemRedfishError {
-	return OptEventJobMessagesItemRedfishError{
-		Value: v,
-		Set:   true,
-	}
+// SetInherits sets the value of Inherits.
+func (s *DataLoadRequestDumpImagesItem) SetInherits(val OptString) {
+	s.Inherits = val
 }
 
-// OptEventJobMessagesItemRedfishError is optional EventJobMessagesItemRedfishError.
-type OptEventJobMessagesItemRedfishError struct {
-	Value EventJobMessagesItemRedfishError
-	Set   bool
+// SetInitrd sets the value of Initrd.
+func (s *DataLoadRequestDumpImagesItem) SetInitrd(val []string) {
+	s.Initrd = val
 }
 
-// IsSet returns true if OptEventJobMessagesItemRedfishError was set.
-func (o OptEventJobMessagesItemRedfishError) IsSet() bool { return o.Set }
-
-// Reset unsets value.
-func (o *OptEventJobMessagesItemRedfishError) Reset() {
-	var v EventJobMessagesItemRedfishError
-	o.Value = v
-	o.Set = false
+// SetKernel sets the value of Kernel.
+func (s *DataLoadRequestDumpImagesItem) SetKernel(val OptString) {
+	s.Kernel = val
 }
 
-// SetTo sets value to v.
-func (o *OptEventJobMessagesItemRedfishError) SetTo(v EventJobMessagesItemRedfishError) {
-	o.Set = true
-	o.Value = v
+// SetLiveimg sets the value of Liveimg.
+func (s *DataLoadRequestDumpImagesItem) SetLiveimg(val OptString) {
+	s.Liveimg = val
 }
 
-// Get returns value and boolean that denotes whether value was set.
-func (o OptEventJobMessagesItemRedfishError) Get() (v EventJobMessagesItemRedfishError, ok bool) {
-	if !o.Set {
-		return v, false
-	}
-	return o.Value, true
+// SetName sets the value of Name.
+func (s *DataLoadRequestDumpImagesItem) SetName(val OptString) {
+	s.Name = val
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o OptEventJobMessagesItemRedfishError) Or(d EventJobMessagesItemRedfishError) EventJobMessagesItemRedfishError {
-	if v, ok := o.Get(); ok {
-		return v
-	}
-	return d
+// SetProvisionTemplates sets the value of ProvisionTemplates.
+func (s *DataLoadRequestDumpImagesItem) SetProvisionTemplates(val OptNilDataLoadRequestDumpImagesItemProvisionTemplates) {
+	s.ProvisionTemplates = val
 }
 
-// NewOptEventJobMessagesItemRedfishErrorError returns new OptEventJobMessagesItemRedfishErrorError with value set to v.
-func NewOptEventJobMessagesItemRedfishErrorError(v EventJobMessagesItemRedfishErrorError) OptEventJobMessagesItemRedfishErrorError {
-	return OptEventJobMessagesItemRedfishErrorError{
-		Value: v,
-		Set:   true,
-	}
+// SetRevision sets the value of Revision.
+func (s *DataLoadRequestDumpImagesItem) SetRevision(val OptNilInt64) {
+	s.Revision = val
 }
 
-// OptEventJobMessagesItemRedfishErrorError is optional EventJobMessagesItemRedfishErrorError.
-type OptEventJobMessagesItemRedfishErrorError struct {
-	Value EventJobMessagesItemRedfishErrorError
-	Set   bool
+// SetUID sets the value of UID.
+func (s *DataLoadRequestDumpImagesItem) SetUID(val OptNilString) {
+	s.UID = val
 }
 
-// IsSet returns true if OptEventJobMessagesItemRedfishErrorError was set.
-func (o OptEventJobMessagesItemRedfishErrorError) IsSet() bool { return o.Set }
-
-// Reset unsets value.
-func (o *OptEventJobMessagesItemRedfishErrorError) Reset() {
-	var v EventJobMessagesItemRedfishErrorError
-	o.Value = v
-	o.Set = false
+// SetUpdatedAt sets the value of UpdatedAt.
+func (s *DataLoadRequestDumpImagesItem) SetUpdatedAt(val OptNilDateTime) {
+	s.UpdatedAt = val
 }
 
-// SetTo sets value to v.
-func (o *OptEventJobMessagesItemRedfishErrorError) SetTo(v EventJobMessagesItemRedfishErrorError) {
-	o.Set = true
-	o.Value = v
+// SetVerify sets the value of Verify.
+func (s *DataLoadRequestDumpImagesItem) SetVerify(val OptBool) {
+	s.Verify = val
 }
 
-// Get returns value and boolean that denotes whether value was set.
-func (o OptEventJobMessagesItemRedfishErrorError) Get() (v EventJobMessagesItemRedfishErrorError, ok bool) {
-	if !o.Set {
-		return v, false
+type DataLoadRequestDumpImagesItemProvisionTemplates map[string]NilString
+
+func (s *DataLoadRequestDumpImagesItemProvisionTemplates) init() DataLoadRequestDumpImagesItemProvisionTemplates {
+	m := *s
+	if m == nil {
+		m = map[string]NilString{}
+		*s = m
 	}
-	return o.Value, true
+	return m
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o OptEventJobMessagesItemRedfishErrorError) Or(d EventJobMessagesItemRedfishErrorError) EventJobMessagesItemRedfishErrorError {
-	if v, ok := o.Get(); ok {
-		return v
-	}
-	return d
+type DataLoadRequestDumpUsersItem struct {
+	CreatedAt  OptDateTime `json:"created_at"`
+	Enabled    OptBool     `json:"enabled"`
+	Hash       OptString   `json:"hash"`
+	ID         OptInt64    `json:"id"`
+	ModifiedAt OptDateTime `json:"modified_at"`
+	Role       OptString   `json:"role"`
+	Username   OptString   `json:"username"`
 }
 
-// NewOptFloat32 returns new OptFloat32 with value set to v.
-func NewOptFloat32(v float32) OptFloat32 {
-	return OptFloat32{
-		Value: v,
-		Set:   true,
-	}
+// GetCreatedAt returns the value of CreatedAt.
+func (s *DataLoadRequestDumpUsersItem) GetCreatedAt() OptDateTime {
+	return s.CreatedAt
 }
 
-// OptFloat32 is optional float32.
-type OptFloat32 struct {
-	Value float32
-	Set   bool
+// GetEnabled returns the value of Enabled.
+func (s *DataLoadRequestDumpUsersItem) GetEnabled() OptBool {
+	return s.Enabled
 }
 
-// IsSet returns true if OptFloat32 was set.
-func (o OptFloat32) IsSet() bool { return o.Set }
+// GetHash returns the value of Hash.
+func (s *DataLoadRequestDumpUsersItem) GetHash() OptString {
+	return s.Hash
+}
 
-// Reset unsets value.
-func (o *OptFloat32) Reset() {
-	var v float32
-	o.Value = v
-	o.Set = false
+// GetID returns the value of ID.
+func (s *DataLoadRequestDumpUsersItem) GetID() OptInt64 {
+	return s.ID
 }
 
-// SetTo sets value to v.
-func (o *OptFloat32) SetTo(v float32) {
-	o.Set = true
-	o.Value = v
+// GetModifiedAt returns the value of ModifiedAt.
+func (s *DataLoadRequestDumpUsersItem) GetModifiedAt() OptDateTime {
+	return s.ModifiedAt
 }
 
-// Get returns value and boolean that denotes whether value was set.
-func (o OptFloat32) Get() (v float32, ok bool) {
-	if !o.Set {
-		return v, false
-	}
-	return o.Value, true
+// GetRole returns the value of Role.
+func (s *DataLoadRequestDumpUsersItem) GetRole() OptString {
+	return s.Role
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o OptFloat32) Or(d float32) float32 {
-	if v, ok := o.Get(); ok {
-		return v
-	}
-	return d
+// GetUsername returns the value of Username.
+func (s *DataLoadRequestDumpUsersItem) GetUsername() OptString {
+	return s.Username
 }
 
-// NewOptHTTPErrorErrorsItemMore returns new OptHTTPErrorErrorsItemMore with value set to v.
-func NewOptHTTPErrorErrorsItemMore(v HTTPErrorErrorsItemMore) OptHTTPErrorErrorsItemMore {
-	return OptHTTPErrorErrorsItemMore{
-		Value: v,
-		Set:   true,
-	}
+// SetCreatedAt sets the value of CreatedAt.
+func (s *DataLoadRequestDumpUsersItem) SetCreatedAt(val OptDateTime) {
+	s.CreatedAt = val
 }
 
-// OptHTTPErrorErrorsItemMore is optional HTTPErrorErrorsItemMore.
-type OptHTTPErrorErrorsItemMore struct {
-	Value HTTPErrorErrorsItemMore
-	Set   bool
+// SetEnabled sets the value of Enabled.
+func (s *DataLoadRequestDumpUsersItem) SetEnabled(val OptBool) {
+	s.Enabled = val
 }
 
-// IsSet returns true if OptHTTPErrorErrorsItemMore was set.
-func (o OptHTTPErrorErrorsItemMore) IsSet() bool { return o.Set }
+// SetHash sets the value of Hash.
+func (s *DataLoadRequestDumpUsersItem) SetHash(val OptString) {
+	s.Hash = val
+}
 
-// Reset unsets value.
-func (o *OptHTTPErrorErrorsItemMore) Reset() {
-	var v HTTPErrorErrorsItemMore
-	o.Value = v
-	o.Set = false
+// SetID sets the value of ID.
+func (s *DataLoadRequestDumpUsersItem) SetID(val OptInt64) {
+	s.ID = val
 }
 
-// SetTo sets value to v.
-func (o *OptHTTPErrorErrorsItemMore) SetTo(v HTTPErrorErrorsItemMore) {
-	o.Set = true
-	o.Value = v
+// SetModifiedAt sets the value of ModifiedAt.
+func (s *DataLoadRequestDumpUsersItem) SetModifiedAt(val OptDateTime) {
+	s.ModifiedAt = val
 }
 
-// Get returns value and boolean that denotes whether value was set.
-func (o OptHTTPErrorErrorsItemMore) Get() (v HTTPErrorErrorsItemMore, ok bool) {
-	if !o.Set {
-		return v, false
-	}
-	return o.Value, true
+// SetRole sets the value of Role.
+func (s *DataLoadRequestDumpUsersItem) SetRole(val OptString) {
+	s.Role = val
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o OptHTTPErrorErrorsItemMore) Or(d HTTPErrorErrorsItemMore) HTTPErrorErrorsItemMore {
-	if v, ok := o.Get(); ok {
-		return v
-	}
-	return d
+// SetUsername sets the value of Username.
+func (s *DataLoadRequestDumpUsersItem) SetUsername(val OptString) {
+	s.Username = val
 }
 
-// NewOptInt returns new OptInt with value set to v.
-func NewOptInt(v int) OptInt {
-	return OptInt{
-		Value: v,
-		Set:   true,
-	}
+// DataLoadResponse schema.
+// Ref: #/components/schemas/DataLoadResponse
+type DataLoadResponse struct {
+	Changed OptInt                  `json:"changed"`
+	Detail  OptString               `json:"detail"`
+	Diff    OptDataLoadResponseDiff `json:"diff"`
+	Title   OptString               `json:"title"`
 }
 
-// OptInt is optional int.
-type OptInt struct {
-	Value int
-	Set   bool
+// GetChanged returns the value of Changed.
+func (s *DataLoadResponse) GetChanged() OptInt {
+	return s.Changed
 }
 
-// IsSet returns true if OptInt was set.
-func (o OptInt) IsSet() bool { return o.Set }
+// GetDetail returns the value of Detail.
+func (s *DataLoadResponse) GetDetail() OptString {
+	return s.Detail
+}
 
-// Reset unsets value.
-func (o *OptInt) Reset() {
-	var v int
-	o.Value = v
-	o.Set = false
+// GetDiff returns the value of Diff.
+func (s *DataLoadResponse) GetDiff() OptDataLoadResponseDiff {
+	return s.Diff
 }
 
-// SetTo sets value to v.
-func (o *OptInt) SetTo(v int) {
-	o.Set = true
-	o.Value = v
+// GetTitle returns the value of Title.
+func (s *DataLoadResponse) GetTitle() OptString {
+	return s.Title
 }
 
-// Get returns value and boolean that denotes whether value was set.
-func (o OptInt) Get() (v int, ok bool) {
-	if !o.Set {
-		return v, false
-	}
-	return o.Value, true
+// SetChanged sets the value of Changed.
+func (s *DataLoadResponse) SetChanged(val OptInt) {
+	s.Changed = val
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o OptInt) Or(d int) int {
-	if v, ok := o.Get(); ok {
-		return v
-	}
-	return d
+// SetDetail sets the value of Detail.
+func (s *DataLoadResponse) SetDetail(val OptString) {
+	s.Detail = val
 }
 
-// NewOptInt64 returns new OptInt64 with value set to v.
-func NewOptInt64(v int64) OptInt64 {
-	return OptInt64{
-		Value: v,
-		Set:   true,
-	}
+// SetDiff sets the value of Diff.
+func (s *DataLoadResponse) SetDiff(val OptDataLoadResponseDiff) {
+	s.Diff = val
 }
 
-// OptInt64 is optional int64.
-type OptInt64 struct {
-	Value int64
-	Set   bool
+// SetTitle sets the value of Title.
+func (s *DataLoadResponse) SetTitle(val OptString) {
+	s.Title = val
 }
 
-// IsSet returns true if OptInt64 was set.
-func (o OptInt64) IsSet() bool { return o.Set }
+type DataLoadResponseDiff struct {
+	DNSRecords OptDataLoadResponseDiffDNSRecords `json:"dns_records"`
+	Hosts      OptDataLoadResponseDiffHosts      `json:"hosts"`
+	Images     OptDataLoadResponseDiffImages     `json:"images"`
+}
 
-// Reset unsets value.
-func (o *OptInt64) Reset() {
-	var v int64
-	o.Value = v
-	o.Set = false
+// GetDNSRecords returns the value of DNSRecords.
+func (s *DataLoadResponseDiff) GetDNSRecords() OptDataLoadResponseDiffDNSRecords {
+	return s.DNSRecords
 }
 
-// SetTo sets value to v.
-func (o *OptInt64) SetTo(v int64) {
-	o.Set = true
-	o.Value = v
+// GetHosts returns the value of Hosts.
+func (s *DataLoadResponseDiff) GetHosts() OptDataLoadResponseDiffHosts {
+	return s.Hosts
 }
 
-// Get returns value and boolean that denotes whether value was set.
-func (o OptInt64) Get() (v int64, ok bool) {
-	if !o.Set {
-		return v, false
-	}
-	return o.Value, true
+// GetImages returns the value of Images.
+func (s *DataLoadResponseDiff) GetImages() OptDataLoadResponseDiffImages {
+	return s.Images
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o OptInt64) Or(d int64) int64 {
-	if v, ok := o.Get(); ok {
-		return v
-	}
-	return d
+// SetDNSRecords sets the value of DNSRecords.
+func (s *DataLoadResponseDiff) SetDNSRecords(val OptDataLoadResponseDiffDNSRecords) {
+	s.DNSRecords = val
 }
 
-// NewOptJobMessageRedfishError returns new OptJobMessageRedfishError with value set to v.
-func NewOptJobMessageRedfishError(v JobMessageRedfishError) OptJobMessageRedfishError {
-	return OptJobMessageRedfishError{
-		Value: v,
-		Set:   true,
-	}
+// SetHosts sets the value of Hosts.
+func (s *DataLoadResponseDiff) SetHosts(val OptDataLoadResponseDiffHosts) {
+	s.Hosts = val
 }
 
-// OptJobMessageRedfishError is optional JobMessageRedfishError.
-type OptJobMessageRedfishError struct {
-	Value JobMessageRedfishError
-	Set   bool
+// SetImages sets the value of Images.
+func (s *DataLoadResponseDiff) SetImages(val OptDataLoadResponseDiffImages) {
+	s.Images = val
 }
 
-// IsSet returns true if OptJobMessageRedfishError was set.
-func (o OptJobMessageRedfishError) IsSet() bool { return o.Set }
+type DataLoadResponseDiffDNSRecords struct {
+	Added     []string `json:"added"`
+	Removed   []string `json:"removed"`
+	Unchanged OptInt   `json:"unchanged"`
+	Updated   []string `json:"updated"`
+}
 
-// Reset unsets value.
-func (o *OptJobMessageRedfishError) Reset() {
-	var v JobMessageRedfishError
-	o.Value = v
-	o.Set = false
+// GetAdded returns the value of Added.
+func (s *DataLoadResponseDiffDNSRecords) GetAdded() []string {
+	return s.Added
 }
 
-// SetTo sets value to v.
-func (o *OptJobMessageRedfishError) SetTo(v JobMessageRedfishError) {
-	o.Set = true
-	o.Value = v
+// GetRemoved returns the value of Removed.
+func (s *DataLoadResponseDiffDNSRecords) GetRemoved() []string {
+	return s.Removed
 }
 
-// Get returns value and boolean that denotes whether value was set.
-func (o OptJobMessageRedfishError) Get() (v JobMessageRedfishError, ok bool) {
-	if !o.Set {
-		return v, false
-	}
-	return o.Value, true
+// GetUnchanged returns the value of Unchanged.
+func (s *DataLoadResponseDiffDNSRecords) GetUnchanged() OptInt {
+	return s.Unchanged
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o OptJobMessageRedfishError) Or(d JobMessageRedfishError) JobMessageRedfishError {
-	if v, ok := o.Get(); ok {
-		return v
-	}
-	return d
+// GetUpdated returns the value of Updated.
+func (s *DataLoadResponseDiffDNSRecords) GetUpdated() []string {
+	return s.Updated
 }
 
-// NewOptJobMessageRedfishErrorError returns new OptJobMessageRedfishErrorError with value set to v.
-func NewOptJobMessageRedfishErrorError(v JobMessageRedfishErrorError) OptJobMessageRedfishErrorError {
-	return OptJobMessageRedfishErrorError{
-		Value: v,
-		Set:   true,
-	}
+// SetAdded sets the value of Added.
+func (s *DataLoadResponseDiffDNSRecords) SetAdded(val []string) {
+	s.Added = val
 }
 
-// OptJobMessageRedfishErrorError is optional JobMessageRedfishErrorError.
-type OptJobMessageRedfishErrorError struct {
-	Value JobMessageRedfishErrorError
-	Set   bool
+// SetRemoved sets the value of Removed.
+func (s *DataLoadResponseDiffDNSRecords) SetRemoved(val []string) {
+	s.Removed = val
 }
 
-// IsSet returns true if OptJobMessageRedfishErrorError was set.
-func (o OptJobMessageRedfishErrorError) IsSet() bool { return o.Set }
+// SetUnchanged sets the value of Unchanged.
+func (s *DataLoadResponseDiffDNSRecords) SetUnchanged(val OptInt) {
+	s.Unchanged = val
+}
 
-// Reset unsets value.
-func (o *OptJobMessageRedfishErrorError) Reset() {
-	var v JobMessageRedfishErrorError
-	o.Value = v
-	o.Set = false
+// SetUpdated sets the value of Updated.
+func (s *DataLoadResponseDiffDNSRecords) SetUpdated(val []string) {
+	s.Updated = val
 }
 
-// SetTo sets value to v.
-func (o *OptJobMessageRedfishErrorError) SetTo(v JobMessageRedfishErrorError) {
-	o.Set = true
-	o.Value = v
+type DataLoadResponseDiffHosts struct {
+	Added     []string `json:"added"`
+	Removed   []string `json:"removed"`
+	Unchanged OptInt   `json:"unchanged"`
+	Updated   []string `json:"updated"`
 }
 
-// Get returns value and boolean that denotes whether value was set.
-func (o OptJobMessageRedfishErrorError) Get() (v JobMessageRedfishErrorError, ok bool) {
-	if !o.Set {
-		return v, false
-	}
-	return o.Value, true
+// GetAdded returns the value of Added.
+func (s *DataLoadResponseDiffHosts) GetAdded() []string {
+	return s.Added
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o OptJobMessageRedfishErrorError) Or(d JobMessageRedfishErrorError) JobMessageRedfishErrorError {
-	if v, ok := o.Get(); ok {
-		return v
-	}
-	return d
+// GetRemoved returns the value of Removed.
+func (s *DataLoadResponseDiffHosts) GetRemoved() []string {
+	return s.Removed
 }
 
-// NewOptNilBootImageAddRequestBootImagesItemProvisionTemplates returns new OptNilBootImageAddRequestBootImagesItemProvisionTemplates with value set to v.
-func NewOptNilBootImageAddRequestBootImagesItemProvisionTemplates(v BootImageAddRequestBootImagesItemProvisionTemplates) OptNilBootImageAddRequestBootImagesItemProvisionTemplates {
-	return OptNilBootImageAddRequestBootImagesItemProvisionTemplates{
-		Value: v,
-		Set:   true,
-	}
+// GetUnchanged returns the value of Unchanged.
+func (s *DataLoadResponseDiffHosts) GetUnchanged() OptInt {
+	return s.Unchanged
 }
 
-// OptNilBootImageAddRequestBootImagesItemProvisionTemplates is optional nullable BootImageAddRequestBootImagesItemProvisionTemplates.
-type OptNilBootImageAddRequestBootImagesItemProvisionTemplates struct {
-	Value BootImageAddRequestBootImagesItemProvisionTemplates
-	Set   bool
-	Null  bool
+// GetUpdated returns the value of Updated.
+func (s *DataLoadResponseDiffHosts) GetUpdated() []string {
+	return s.Updated
 }
 
-// IsSet returns true if OptNilBootImageAddRequestBootImagesItemProvisionTemplates was set.
-func (o OptNilBootImageAddRequestBootImagesItemProvisionTemplates) IsSet() bool { return o.Set }
+// SetAdded sets the value of Added.
+func (s *DataLoadResponseDiffHosts) SetAdded(val []string) {
+	s.Added = val
+}
 
-// Reset unsets value.
-func (o *OptNilBootImageAddRequestBootImagesItemProvisionTemplates) Reset() {
-	var v BootImageAddRequestBootImagesItemProvisionTemplates
-	o.Value = v
-	o.Set = false
-	o.Null = false
+// SetRemoved sets the value of Removed.
+func (s *DataLoadResponseDiffHosts) SetRemoved(val []string) {
+	s.Removed = val
 }
 
-// SetTo sets value to v.
-func (o *OptNilBootImageAddRequestBootImagesItemProvisionTemplates) SetTo(v BootImageAddRequestBootImagesItemProvisionTemplates) {
-	o.Set = true
-	o.Null = false
-	o.Value = v
+// SetUnchanged sets the value of Unchanged.
+func (s *DataLoadResponseDiffHosts) SetUnchanged(val OptInt) {
+	s.Unchanged = val
 }
 
-// IsSet returns true if value is Null.
-func (o OptNilBootImageAddRequestBootImagesItemProvisionTemplates) IsNull() bool { return o.Null }
+// SetUpdated sets the value of Updated.
+func (s *DataLoadResponseDiffHosts) SetUpdated(val []string) {
+	s.Updated = val
+}
 
-// SetNull sets value to null.
-func (o *OptNilBootImageAddRequestBootImagesItemProvisionTemplates) SetToNull() {
-	o.Set = true
-	o.Null = true
-	var v BootImageAddRequestBootImagesItemProvisionTemplates
-	o.Value = v
+type DataLoadResponseDiffImages struct {
+	Added     []string `json:"added"`
+	Removed   []string `json:"removed"`
+	Unchanged OptInt   `json:"unchanged"`
+	Updated   []string `json:"updated"`
 }
 
-// Get returns value and boolean that denotes whether value was set.
-func (o OptNilBootImageAddRequestBootImagesItemProvisionTemplates) Get() (v BootImageAddRequestBootImagesItemProvisionTemplates, ok bool) {
-	if o.Null {
-		return v, false
-	}
-	if !o.Set {
-		return v, false
-	}
-	return o.Value, true
+// GetAdded returns the value of Added.
+func (s *DataLoadResponseDiffImages) GetAdded() []string {
+	return s.Added
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o OptNilBootImageAddRequestBootImagesItemProvisionTemplates) Or(d BootImageAddRequestBootImagesItemProvisionTemplates) BootImageAddRequestBootImagesItemProvisionTemplates {
-	if v, ok := o.Get(); ok {
-		return v
-	}
-	return d
+// GetRemoved returns the value of Removed.
+func (s *DataLoadResponseDiffImages) GetRemoved() []string {
+	return s.Removed
 }
 
-// NewOptNilBootImageProvisionTemplates returns new OptNilBootImageProvisionTemplates with value set to v.
-func NewOptNilBootImageProvisionTemplates(v BootImageProvisionTemplates) OptNilBootImageProvisionTemplates {
-	return OptNilBootImageProvisionTemplates{
-		Value: v,
-		Set:   true,
-	}
+// GetUnchanged returns the value of Unchanged.
+func (s *DataLoadResponseDiffImages) GetUnchanged() OptInt {
+	return s.Unchanged
 }
 
-// OptNilBootImageProvisionTemplates is optional nullable BootImageProvisionTemplates.
-type OptNilBootImageProvisionTemplates struct {
-	Value BootImageProvisionTemplates
-	Set   bool
-	Null  bool
+// GetUpdated returns the value of Updated.
+func (s *DataLoadResponseDiffImages) GetUpdated() []string {
+	return s.Updated
 }
 
-// IsSet returns true if OptNilBootImageProvisionTemplates was set.
-func (o OptNilBootImageProvisionTemplates) IsSet() bool { return o.Set }
+// SetAdded sets the value of Added.
+func (s *DataLoadResponseDiffImages) SetAdded(val []string) {
+	s.Added = val
+}
 
-// Reset unsets value.
-func (o *OptNilBootImageProvisionTemplates) Reset() {
-	var v BootImageProvisionTemplates
-	o.Value = v
-	o.Set = false
-	o.Null = false
+// SetRemoved sets the value of Removed.
+func (s *DataLoadResponseDiffImages) SetRemoved(val []string) {
+	s.Removed = val
 }
 
-// SetTo sets value to v.
-func (o *OptNilBootImageProvisionTemplates) SetTo(v BootImageProvisionTemplates) {
-	o.Set = true
-	o.Null = false
-	o.Value = v
+// SetUnchanged sets the value of Unchanged.
+func (s *DataLoadResponseDiffImages) SetUnchanged(val OptInt) {
+	s.Unchanged = val
 }
 
-// IsSet returns true if value is Null.
-func (o OptNilBootImageProvisionTemplates) IsNull() bool { return o.Null }
+// SetUpdated sets the value of Updated.
+func (s *DataLoadResponseDiffImages) SetUpdated(val []string) {
+	s.Updated = val
+}
 
-// SetNull sets value to null.
-func (o *OptNilBootImageProvisionTemplates) SetToNull() {
-	o.Set = true
-	o.Null = true
-	var v BootImageProvisionTemplates
-	o.Value = v
+// DiscoverBMCAdoptRequest schema.
+// Ref: #/components/schemas/DiscoverBMCAdoptRequest
+type DiscoverBMCAdoptRequest struct {
+	Fqdn   OptString `json:"fqdn"`
+	Host   string    `json:"host"`
+	Ifname OptString `json:"ifname"`
+	// Address of the BMC interface. The prefix length of the matching dhcp.subnets is used when omitted.
+	IP  string `json:"ip"`
+	MAC string `json:"mac"`
 }
 
-// Get returns value and boolean that denotes whether value was set.
-func (o OptNilBootImageProvisionTemplates) Get() (v BootImageProvisionTemplates, ok bool) {
-	if o.Null {
-		return v, false
-	}
-	if !o.Set {
-		return v, false
-	}
-	return o.Value, true
+// GetFqdn returns the value of Fqdn.
+func (s *DiscoverBMCAdoptRequest) GetFqdn() OptString {
+	return s.Fqdn
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o OptNilBootImageProvisionTemplates) Or(d BootImageProvisionTemplates) BootImageProvisionTemplates {
-	if v, ok := o.Get(); ok {
-		return v
-	}
-	return d
+// GetHost returns the value of Host.
+func (s *DiscoverBMCAdoptRequest) GetHost() string {
+	return s.Host
 }
 
-// NewOptNilDataDumpImagesItemProvisionTemplates returns new OptNilDataDumpImagesItemProvisionTemplates with value set to v.
-func NewOptNilDataDumpImagesItemProvisionTemplates(v DataDumpImagesItemProvisionTemplates) OptNilDataDumpImagesItemProvisionTemplates {
-	return OptNilDataDumpImagesItemProvisionTemplates{
-		Value: v,
-		Set:   true,
-	}
+// GetIfname returns the value of Ifname.
+func (s *DiscoverBMCAdoptRequest) GetIfname() OptString {
+	return s.Ifname
 }
 
-// OptNilDataDumpImagesItemProvisionTemplates is optional nullable DataDumpImagesItemProvisionTemplates.
-type OptNilDataDumpImagesItemProvisionTemplates struct {
-	Value DataDumpImagesItemProvisionTemplates
-	Set   bool
-	Null  bool
+// GetIP returns the value of IP.
+func (s *DiscoverBMCAdoptRequest) GetIP() string {
+	return s.IP
 }
 
-// IsSet returns true if OptNilDataDumpImagesItemProvisionTemplates was set.
-func (o OptNilDataDumpImagesItemProvisionTemplates) IsSet() bool { return o.Set }
+// GetMAC returns the value of MAC.
+func (s *DiscoverBMCAdoptRequest) GetMAC() string {
+	return s.MAC
+}
 
-// Reset unsets value.
-func (o *OptNilDataDumpImagesItemProvisionTemplates) Reset() {
-	var v DataDumpImagesItemProvisionTemplates
-	o.Value = v
-	o.Set = false
-	o.Null = false
+// SetFqdn sets the value of Fqdn.
+func (s *DiscoverBMCAdoptRequest) SetFqdn(val OptString) {
+	s.Fqdn = val
 }
 
-// SetTo sets value to v.
-func (o *OptNilDataDumpImagesItemProvisionTemplates) SetTo(v DataDumpImagesItemProvisionTemplates) {
-	o.Set = true
-	o.Null = false
-	o.Value = v
+// SetHost sets the value of Host.
+func (s *DiscoverBMCAdoptRequest) SetHost(val string) {
+	s.Host = val
 }
 
-// IsSet returns true if value is Null.
-func (o OptNilDataDumpImagesItemProvisionTemplates) IsNull() bool { return o.Null }
+// SetIfname sets the value of Ifname.
+func (s *DiscoverBMCAdoptRequest) SetIfname(val OptString) {
+	s.Ifname = val
+}
 
-// SetNull sets value to null.
-func (o *OptNilDataDumpImagesItemProvisionTemplates) SetToNull() {
-	o.Set = true
-	o.Null = true
-	var v DataDumpImagesItemProvisionTemplates
-	o.Value = v
+// SetIP sets the value of IP.
+func (s *DiscoverBMCAdoptRequest) SetIP(val string) {
+	s.IP = val
 }
 
-// Get returns value and boolean that denotes whether value was set.
-func (o OptNilDataDumpImagesItemProvisionTemplates) Get() (v DataDumpImagesItemProvisionTemplates, ok bool) {
-	if o.Null {
-		return v, false
-	}
-	if !o.Set {
-		return v, false
-	}
-	return o.Value, true
+// SetMAC sets the value of MAC.
+func (s *DiscoverBMCAdoptRequest) SetMAC(val string) {
+	s.MAC = val
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o OptNilDataDumpImagesItemProvisionTemplates) Or(d DataDumpImagesItemProvisionTemplates) DataDumpImagesItemProvisionTemplates {
-	if v, ok := o.Get(); ok {
-		return v
-	}
-	return d
+// Event schema.
+// Ref: #/components/schemas/Event
+type Event struct {
+	JobMessages []EventJobMessagesItem `json:"JobMessages"`
+	Message     OptString              `json:"Message"`
+	Namespace   OptString              `json:"Namespace"`
+	Severity    OptString              `json:"Severity"`
+	Time        OptDateTime            `json:"Time"`
+	User        OptString              `json:"User"`
 }
 
-// NewOptNilFloat64 returns new OptNilFloat64 with value set to v.
-func NewOptNilFloat64(v float64) OptNilFloat64 {
-	return OptNilFloat64{
-		Value: v,
-		Set:   true,
-	}
+// GetJobMessages returns the value of JobMessages.
+func (s *Event) GetJobMessages() []EventJobMessagesItem {
+	return s.JobMessages
 }
 
-// OptNilFloat64 is optional nullable float64.
-type OptNilFloat64 struct {
-	Value float64
-	Set   bool
-	Null  bool
+// GetMessage returns the value of Message.
+func (s *Event) GetMessage() OptString {
+	return s.Message
 }
 
-// IsSet returns true if OptNilFloat64 was set.
-func (o OptNilFloat64) IsSet() bool { return o.Set }
+// GetNamespace returns the value of Namespace.
+func (s *Event) GetNamespace() OptString {
+	return s.Namespace
+}
 
-// Reset unsets value.
-func (o *OptNilFloat64) Reset() {
-	var v float64
-	o.Value = v
-	o.Set = false
-	o.Null = false
+// GetSeverity returns the value of Severity.
+func (s *Event) GetSeverity() OptString {
+	return s.Severity
 }
 
-// SetTo sets value to v.
-func (o *OptNilFloat64) SetTo(v float64) {
-	o.Set = true
-	o.Null = false
-	o.Value = v
+// GetTime returns the value of Time.
+func (s *Event) GetTime() OptDateTime {
+	return s.Time
 }
 
-// IsSet returns true if value is Null.
-func (o OptNilFloat64) IsNull() bool { return o.Null }
+// GetUser returns the value of User.
+func (s *Event) GetUser() OptString {
+	return s.User
+}
 
-// SetNull sets value to null.
-func (o *OptNilFloat64) SetToNull() {
-	o.Set = true
-	o.Null = true
-	var v float64
-	o.Value = v
+// SetJobMessages sets the value of JobMessages.
+func (s *Event) SetJobMessages(val []EventJobMessagesItem) {
+	s.JobMessages = val
 }
 
-// Get returns value and boolean that denotes whether value was set.
-func (o OptNilFloat64) Get() (v float64, ok bool) {
-	if o.Null {
-		return v, false
-	}
-	if !o.Set {
-		return v, false
-	}
-	return o.Value, true
+// SetMessage sets the value of Message.
+func (s *Event) SetMessage(val OptString) {
+	s.Message = val
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o OptNilFloat64) Or(d float64) float64 {
-	if v, ok := o.Get(); ok {
-		return v
-	}
-	return d
+// SetNamespace sets the value of Namespace.
+func (s *Event) SetNamespace(val OptString) {
+	s.Namespace = val
 }
 
-// NewOptNilHTTPErrorErrorsItemArray returns new OptNilHTTPErrorErrorsItemArray with value set to v.
-func NewOptNilHTTPErrorErrorsItemArray(v []HTTPErrorErrorsItem) OptNilHTTPErrorErrorsItemArray {
-	return OptNilHTTPErrorErrorsItemArray{
-		Value: v,
-		Set:   true,
-	}
+// SetSeverity sets the value of Severity.
+func (s *Event) SetSeverity(val OptString) {
+	s.Severity = val
 }
 
-// OptNilHTTPErrorErrorsItemArray is optional nullable []HTTPErrorErrorsItem.
-type OptNilHTTPErrorErrorsItemArray struct {
-	Value []HTTPErrorErrorsItem
-	Set   bool
-	Null  bool
+// SetTime sets the value of Time.
+func (s *Event) SetTime(val OptDateTime) {
+	s.Time = val
 }
 
-// IsSet returns true if OptNilHTTPErrorErrorsItemArray was set.
-func (o OptNilHTTPErrorErrorsItemArray) IsSet() bool { return o.Set }
+// SetUser sets the value of User.
+func (s *Event) SetUser(val OptString) {
+	s.User = val
+}
 
-// Reset unsets value.
-func (o *OptNilHTTPErrorErrorsItemArray) Reset() {
-	var v []HTTPErrorErrorsItem
-	o.Value = v
-	o.Set = false
-	o.Null = false
+type EventJobMessagesItem struct {
+	Data         OptString                           `json:"data"`
+	Host         OptString                           `json:"host"`
+	Msg          OptString                           `json:"msg"`
+	RedfishError OptEventJobMessagesItemRedfishError `json:"redfish_error"`
+	Status       OptString                           `json:"status"`
 }
 
-// SetTo sets value to v.
-func (o *OptNilHTTPErrorErrorsItemArray) SetTo(v []HTTPErrorErrorsItem) {
-	o.Set = true
-	o.Null = false
-	o.Value = v
+// GetData returns the value of Data.
+func (s *EventJobMessagesItem) GetData() OptString {
+	return s.Data
 }
 
-// IsSet returns true if value is Null.
-func (o OptNilHTTPErrorErrorsItemArray) IsNull() bool { return o.Null }
+// GetHost returns the value of Host.
+func (s *EventJobMessagesItem) GetHost() OptString {
+	return s.Host
+}
 
-// SetNull sets value to null.
-func (o *OptNilHTTPErrorErrorsItemArray) SetToNull() {
-	o.Set = true
-	o.Null = true
-	var v []HTTPErrorErrorsItem
-	o.Value = v
+// GetMsg returns the value of Msg.
+func (s *EventJobMessagesItem) GetMsg() OptString {
+	return s.Msg
 }
 
-// Get returns value and boolean that denotes whether value was set.
-func (o OptNilHTTPErrorErrorsItemArray) Get() (v []HTTPErrorErrorsItem, ok bool) {
-	if o.Null {
-		return v, false
-	}
-	if !o.Set {
-		return v, false
-	}
-	return o.Value, true
+// GetRedfishError returns the value of RedfishError.
+func (s *EventJobMessagesItem) GetRedfishError() OptEventJobMessagesItemRedfishError {
+	return s.RedfishError
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o OptNilHTTPErrorErrorsItemArray) Or(d []HTTPErrorErrorsItem) []HTTPErrorErrorsItem {
-	if v, ok := o.Get(); ok {
-		return v
-	}
-	return d
+// GetStatus returns the value of Status.
+func (s *EventJobMessagesItem) GetStatus() OptString {
+	return s.Status
 }
 
-// NewOptNilInt returns new OptNilInt with value set to v.
-func NewOptNilInt(v int) OptNilInt {
-	return OptNilInt{
-		Value: v,
-		Set:   true,
-	}
+// SetData sets the value of Data.
+func (s *EventJobMessagesItem) SetData(val OptString) {
+	s.Data = val
 }
 
-// OptNilInt is optional nullable int.
-type OptNilInt struct {
-	Value int
-	Set   bool
-	Null  bool
+// SetHost sets the value of Host.
+func (s *EventJobMessagesItem) SetHost(val OptString) {
+	s.Host = val
 }
 
-// IsSet returns true if OptNilInt was set.
-func (o OptNilInt) IsSet() bool { return o.Set }
+// SetMsg sets the value of Msg.
+func (s *EventJobMessagesItem) SetMsg(val OptString) {
+	s.Msg = val
+}
 
-// Reset unsets value.
-func (o *OptNilInt) Reset() {
-	var v int
-	o.Value = v
-	o.Set = false
-	o.Null = false
+// SetRedfishError sets the value of RedfishError.
+func (s *EventJobMessagesItem) SetRedfishError(val OptEventJobMessagesItemRedfishError) {
+	s.RedfishError = val
 }
 
-// SetTo sets value to v.
-func (o *OptNilInt) SetTo(v int) {
-	o.Set = true
-	o.Null = false
-	o.Value = v
+// SetStatus sets the value of Status.
+func (s *EventJobMessagesItem) SetStatus(val OptString) {
+	s.Status = val
 }
 
-// IsSet returns true if value is Null.
-func (o OptNilInt) IsNull() bool { return o.Null }
+type EventJobMessagesItemRedfishError struct {
+	Code  OptString                                `json:"code"`
+	Error OptEventJobMessagesItemRedfishErrorError `json:"error"`
+}
 
-// SetNull sets value to null.
-func (o *OptNilInt) SetToNull() {
-	o.Set = true
-	o.Null = true
-	var v int
-	o.Value = v
+// GetCode returns the value of Code.
+func (s *EventJobMessagesItemRedfishError) GetCode() OptString {
+	return s.Code
 }
 
-// Get returns value and boolean that denotes whether value was set.
-func (o OptNilInt) Get() (v int, ok bool) {
-	if o.Null {
-		return v, false
-	}
-	if !o.Set {
-		return v, false
-	}
-	return o.Value, true
+// GetError returns the value of Error.
+func (s *EventJobMessagesItemRedfishError) GetError() OptEventJobMessagesItemRedfishErrorError {
+	return s.Error
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o OptNilInt) Or(d int) int {
-	if v, ok := o.Get(); ok {
-		return v
-	}
-	return d
+// SetCode sets the value of Code.
+func (s *EventJobMessagesItemRedfishError) SetCode(val OptString) {
+	s.Code = val
 }
 
-// NewOptNilInt64 returns new OptNilInt64 with value set to v.
-func NewOptNilInt64(v int64) OptNilInt64 {
-	return OptNilInt64{
-		Value: v,
-		Set:   true,
-	}
+// SetError sets the value of Error.
+func (s *EventJobMessagesItemRedfishError) SetError(val OptEventJobMessagesItemRedfishErrorError) {
+	s.Error = val
 }
 
-// OptNilInt64 is optional nullable int64.
-type OptNilInt64 struct {
-	Value int64
-	Set   bool
-	Null  bool
+type EventJobMessagesItemRedfishErrorError struct {
+	MessageDotExtendedInfo []EventJobMessagesItemRedfishErrorErrorMessageDotExtendedInfoItem `json:"@Message.ExtendedInfo"`
+	Code                   OptString                                                         `json:"code"`
+	Message                OptString                                                         `json:"message"`
 }
 
-// IsSet returns true if OptNilInt64 was set.
-func (o OptNilInt64) IsSet() bool { return o.Set }
+// GetMessageDotExtendedInfo returns the value of MessageDotExtendedInfo.
+func (s *EventJobMessagesItemRedfishErrorError) GetMessageDotExtendedInfo() []EventJobMessagesItemRedfishErrorErrorMessageDotExtendedInfoItem {
+	return s.MessageDotExtendedInfo
+}
 
-// Reset unsets value.
-func (o *OptNilInt64) Reset() {
-	var v int64
-	o.Value = v
-	o.Set = false
-	o.Null = false
+// GetCode returns the value of Code.
+func (s *EventJobMessagesItemRedfishErrorError) GetCode() OptString {
+	return s.Code
 }
 
-// SetTo sets value to v.
-func (o *OptNilInt64) SetTo(v int64) {
-	o.Set = true
-	o.Null = false
-	o.Value = v
+// GetMessage returns the value of Message.
+func (s *EventJobMessagesItemRedfishErrorError) GetMessage() OptString {
+	return s.Message
 }
 
-// IsSet returns true if value is Null.
-func (o OptNilInt64) IsNull() bool { return o.Null }
+// SetMessageDotExtendedInfo sets the value of MessageDotExtendedInfo.
+func (s *EventJobMessagesItemRedfishErrorError) SetMessageDotExtendedInfo(val []EventJobMessagesItemRedfishErrorErrorMessageDotExtendedInfoItem) {
+	s.MessageDotExtendedInfo = val
+}
 
-// SetNull sets value to null.
-func (o *OptNilInt64) SetToNull() {
-	o.Set = true
-	o.Null = true
-	var v int64
-	o.Value = v
+// SetCode sets the value of Code.
+func (s *EventJobMessagesItemRedfishErrorError) SetCode(val OptString) {
+	s.Code = val
 }
 
-// Get returns value and boolean that denotes whether value was set.
-func (o OptNilInt64) Get() (v int64, ok bool) {
-	if o.Null {
-		return v, false
-	}
-	if !o.Set {
-		return v, false
-	}
-	return o.Value, true
+// SetMessage sets the value of Message.
+func (s *EventJobMessagesItemRedfishErrorError) SetMessage(val OptString) {
+	s.Message = val
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o OptNilInt64) Or(d int64) int64 {
-	if v, ok := o.Get(); ok {
-		return v
-	}
-	return d
+type EventJobMessagesItemRedfishErrorErrorMessageDotExtendedInfoItem struct {
+	Message                           OptString `json:"Message"`
+	MessageArgsDotOdataDotCount       OptInt    `json:"MessageArgs.@odata.count"`
+	MessageId                         OptString `json:"MessageId"`
+	RelatedPropertiesDotOdataDotCount OptInt    `json:"RelatedProperties.@odata.count"`
+	Resolution                        OptString `json:"Resolution"`
+	Severity                          OptString `json:"Severity"`
 }
 
-// NewOptNilJobMessageRedfishErrorErrorMessageDotExtendedInfoItemArray returns new OptNilJobMessageRedfishErrorErrorMessageDotExtendedInfoItemArray with value set to v.
-func NewOptNilJobMessageRedfishErrorErrorMessageDotExtendedInfoItemArray(v []JobMessageRedfishErrorErrorMessageDotExtendedInfoItem) OptNilJobMessageRedfishErrorErrorMessageDotExtendedInfoItemArray {
-	return OptNilJobMessageRedfishErrorErrorMessageDotExtendedInfoItemArray{
-		Value: v,
-		Set:   true,
-	}
+// GetMessage returns the value of Message.
+func (s *EventJobMessagesItemRedfishErrorErrorMessageDotExtendedInfoItem) GetMessage() OptString {
+	return s.Message
 }
 
-// OptNilJobMessageRedfishErrorErrorMessageDotExtendedInfoItemArray is optional nullable []JobMessageRedfishErrorErrorMessageDotExtendedInfoItem.
-type OptNilJobMessageRedfishErrorErrorMessageDotExtendedInfoItemArray struct {
-	Value []JobMessageRedfishErrorErrorMessageDotExtendedInfoItem
-	Set   bool
-	Null  bool
+// GetMessageArgsDotOdataDotCount returns the value of MessageArgsDotOdataDotCount.
+func (s *EventJobMessagesItemRedfishErrorErrorMessageDotExtendedInfoItem) GetMessageArgsDotOdataDotCount() OptInt {
+	return s.MessageArgsDotOdataDotCount
 }
 
-// IsSet returns true if OptNilJobMessageRedfishErrorErrorMessageDotExtendedInfoItemArray was set.
-func (o OptNilJobMessageRedfishErrorErrorMessageDotExtendedInfoItemArray) IsSet() bool { return o.Set }
+// GetMessageId returns the value of MessageId.
+func (s *EventJobMessagesItemRedfishErrorErrorMessageDotExtendedInfoItem) GetMessageId() OptString {
+	return s.MessageId
+}
 
-// Reset unsets value.
-func (o *OptNilJobMessageRedfishErrorErrorMessageDotExtendedInfoItemArray) Reset() {
-	var v []JobMessageRedfishErrorErrorMessageDotExtendedInfoItem
-	o.Value = v
-	o.Set = false
-	o.Null = false
+// GetRelatedPropertiesDotOdataDotCount returns the value of RelatedPropertiesDotOdataDotCount.
+func (s *EventJobMessagesItemRedfishErrorErrorMessageDotExtendedInfoItem) GetRelatedPropertiesDotOdataDotCount() OptInt {
+	return s.RelatedPropertiesDotOdataDotCount
 }
 
-// SetTo sets value to v.
-func (o *OptNilJobMessageRedfishErrorErrorMessageDotExtendedInfoItemArray) SetTo(v []JobMessageRedfishErrorErrorMessageDotExtendedInfoItem) {
-	o.Set = true
-	o.Null = false
-	o.Value = v
+// GetResolution returns the value of Resolution.
+func (s *EventJobMessagesItemRedfishErrorErrorMessageDotExtendedInfoItem) GetResolution() OptString {
+	return s.Resolution
 }
 
-// IsSet returns true if value is Null.
-func (o OptNilJobMessageRedfishErrorErrorMessageDotExtendedInfoItemArray) IsNull() bool {
-	return o.Null
+// GetSeverity returns the value of Severity.
+func (s *EventJobMessagesItemRedfishErrorErrorMessageDotExtendedInfoItem) GetSeverity() OptString {
+	return s.Severity
 }
 
-// SetNull sets value to null.
-func (o *OptNilJobMessageRedfishErrorErrorMessageDotExtendedInfoItemArray) SetToNull() {
-	o.Set = true
-	o.Null = true
-	var v []JobMessageRedfishErrorErrorMessageDotExtendedInfoItem
-	o.Value = v
+// SetMessage sets the value of Message.
+func (s *EventJobMessagesItemRedfishErrorErrorMessageDotExtendedInfoItem) SetMessage(val OptString) {
+	s.Message = val
 }
 
-// Get returns value and boolean that denotes whether value was set.
-func (o OptNilJobMessageRedfishErrorErrorMessageDotExtendedInfoItemArray) Get() (v []JobMessageRedfishErrorErrorMessageDotExtendedInfoItem, ok bool) {
-	if o.Null {
-		return v, false
-	}
-	if !o.Set {
-		return v, false
-	}
-	return o.Value, true
+// SetMessageArgsDotOdataDotCount sets the value of MessageArgsDotOdataDotCount.
+func (s *EventJobMessagesItemRedfishErrorErrorMessageDotExtendedInfoItem) SetMessageArgsDotOdataDotCount(val OptInt) {
+	s.MessageArgsDotOdataDotCount = val
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o OptNilJobMessageRedfishErrorErrorMessageDotExtendedInfoItemArray) Or(d []JobMessageRedfishErrorErrorMessageDotExtendedInfoItem) []JobMessageRedfishErrorErrorMessageDotExtendedInfoItem {
-	if v, ok := o.Get(); ok {
-		return v
-	}
-	return d
+// SetMessageId sets the value of MessageId.
+func (s *EventJobMessagesItemRedfishErrorErrorMessageDotExtendedInfoItem) SetMessageId(val OptString) {
+	s.MessageId = val
 }
 
-// NewOptNilNilIntArray returns new OptNilNilIntArray with value set to v.
-func NewOptNilNilIntArray(v []NilInt) OptNilNilIntArray {
-	return OptNilNilIntArray{
-		Value: v,
-		Set:   true,
-	}
+// SetRelatedPropertiesDotOdataDotCount sets the value of RelatedPropertiesDotOdataDotCount.
+func (s *EventJobMessagesItemRedfishErrorErrorMessageDotExtendedInfoItem) SetRelatedPropertiesDotOdataDotCount(val OptInt) {
+	s.RelatedPropertiesDotOdataDotCount = val
 }
 
-// OptNilNilIntArray is optional nullable []NilInt.
-type OptNilNilIntArray struct {
-	Value []NilInt
-	Set   bool
-	Null  bool
+// SetResolution sets the value of Resolution.
+func (s *EventJobMessagesItemRedfishErrorErrorMessageDotExtendedInfoItem) SetResolution(val OptString) {
+	s.Resolution = val
 }
 
-// IsSet returns true if OptNilNilIntArray was set.
-func (o OptNilNilIntArray) IsSet() bool { return o.Set }
+// SetSeverity sets the value of Severity.
+func (s *EventJobMessagesItemRedfishErrorErrorMessageDotExtendedInfoItem) SetSeverity(val OptString) {
+	s.Severity = val
+}
 
-// Reset unsets value.
-func (o *OptNilNilIntArray) Reset() {
-	var v []NilInt
-	o.Value = v
-	o.Set = false
-	o.Null = false
+// EventSubscription schema.
+// Ref: #/components/schemas/EventSubscription
+type EventSubscription struct {
+	Host   OptString `json:"host"`
+	ID     OptString `json:"id"`
+	Msg    OptString `json:"msg"`
+	State  OptString `json:"state"`
+	Status OptString `json:"status"`
 }
 
-// SetTo sets value to v.
-func (o *OptNilNilIntArray) SetTo(v []NilInt) {
-	o.Set = true
-	o.Null = false
-	o.Value = v
+// GetHost returns the value of Host.
+func (s *EventSubscription) GetHost() OptString {
+	return s.Host
 }
 
-// IsSet returns true if value is Null.
-func (o OptNilNilIntArray) IsNull() bool { return o.Null }
+// GetID returns the value of ID.
+func (s *EventSubscription) GetID() OptString {
+	return s.ID
+}
 
-// SetNull sets value to null.
-func (o *OptNilNilIntArray) SetToNull() {
-	o.Set = true
-	o.Null = true
-	var v []NilInt
-	o.Value = v
+// GetMsg returns the value of Msg.
+func (s *EventSubscription) GetMsg() OptString {
+	return s.Msg
 }
 
-// Get returns value and boolean that denotes whether value was set.
-func (o OptNilNilIntArray) Get() (v []NilInt, ok bool) {
-	if o.Null {
-		return v, false
-	}
-	if !o.Set {
-		return v, false
-	}
-	return o.Value, true
+// GetState returns the value of State.
+func (s *EventSubscription) GetState() OptString {
+	return s.State
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o OptNilNilIntArray) Or(d []NilInt) []NilInt {
-	if v, ok := o.Get(); ok {
-		return v
-	}
-	return d
+// GetStatus returns the value of Status.
+func (s *EventSubscription) GetStatus() OptString {
+	return s.Status
 }
 
-// NewOptNilNilRedfishJobJobsItemArray returns new OptNilNilRedfishJobJobsItemArray with value set to v.
-func NewOptNilNilRedfishJobJobsItemArray(v []NilRedfishJobJobsItem) OptNilNilRedfishJobJobsItemArray {
-	return OptNilNilRedfishJobJobsItemArray{
-		Value: v,
-		Set:   true,
-	}
+// SetHost sets the value of Host.
+func (s *EventSubscription) SetHost(val OptString) {
+	s.Host = val
 }
 
-// OptNilNilRedfishJobJobsItemArray is optional nullable []NilRedfishJobJobsItem.
-type OptNilNilRedfishJobJobsItemArray struct {
-	Value []NilRedfishJobJobsItem
-	Set   bool
-	Null  bool
+// SetID sets the value of ID.
+func (s *EventSubscription) SetID(val OptString) {
+	s.ID = val
 }
 
-// IsSet returns true if OptNilNilRedfishJobJobsItemArray was set.
-func (o OptNilNilRedfishJobJobsItemArray) IsSet() bool { return o.Set }
+// SetMsg sets the value of Msg.
+func (s *EventSubscription) SetMsg(val OptString) {
+	s.Msg = val
+}
 
-// Reset unsets value.
-func (o *OptNilNilRedfishJobJobsItemArray) Reset() {
-	var v []NilRedfishJobJobsItem
-	o.Value = v
-	o.Set = false
-	o.Null = false
+// SetState sets the value of State.
+func (s *EventSubscription) SetState(val OptString) {
+	s.State = val
 }
 
-// SetTo sets value to v.
-func (o *OptNilNilRedfishJobJobsItemArray) SetTo(v []NilRedfishJobJobsItem) {
-	o.Set = true
-	o.Null = false
-	o.Value = v
+// SetStatus sets the value of Status.
+func (s *EventSubscription) SetStatus(val OptString) {
+	s.Status = val
 }
 
-// IsSet returns true if value is Null.
-func (o OptNilNilRedfishJobJobsItemArray) IsNull() bool { return o.Null }
+// FirmwareUpdateReport schema.
+// Ref: #/components/schemas/FirmwareUpdateReport
+type FirmwareUpdateReport struct {
+	Component OptString                           `json:"component"`
+	Host      OptString                           `json:"host"`
+	Inventory OptNilFirmwareUpdateReportInventory `json:"inventory"`
+	Msg       OptString                           `json:"msg"`
+	Previous  OptString                           `json:"previous"`
+	Skipped   OptBool                             `json:"skipped"`
+	Status    OptString                           `json:"status"`
+	Version   OptString                           `json:"version"`
+}
 
-// SetNull sets value to null.
-func (o *OptNilNilRedfishJobJobsItemArray) SetToNull() {
-	o.Set = true
-	o.Null = true
-	var v []NilRedfishJobJobsItem
-	o.Value = v
+// GetComponent returns the value of Component.
+func (s *FirmwareUpdateReport) GetComponent() OptString {
+	return s.Component
 }
 
-// Get returns value and boolean that denotes whether value was set.
-func (o OptNilNilRedfishJobJobsItemArray) Get() (v []NilRedfishJobJobsItem, ok bool) {
-	if o.Null {
-		return v, false
-	}
-	if !o.Set {
-		return v, false
-	}
-	return o.Value, true
+// GetHost returns the value of Host.
+func (s *FirmwareUpdateReport) GetHost() OptString {
+	return s.Host
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o OptNilNilRedfishJobJobsItemArray) Or(d []NilRedfishJobJobsItem) []NilRedfishJobJobsItem {
-	if v, ok := o.Get(); ok {
-		return v
-	}
-	return d
+// GetInventory returns the value of Inventory.
+func (s *FirmwareUpdateReport) GetInventory() OptNilFirmwareUpdateReportInventory {
+	return s.Inventory
 }
 
-// NewOptNilNilStringArray returns new OptNilNilStringArray with value set to v.
-func NewOptNilNilStringArray(v []NilString) OptNilNilStringArray {
-	return OptNilNilStringArray{
-		Value: v,
-		Set:   true,
-	}
+// GetMsg returns the value of Msg.
+func (s *FirmwareUpdateReport) GetMsg() OptString {
+	return s.Msg
 }
 
-// OptNilNilStringArray is optional nullable []NilString.
-type OptNilNilStringArray struct {
-	Value []NilString
-	Set   bool
-	Null  bool
+// GetPrevious returns the value of Previous.
+func (s *FirmwareUpdateReport) GetPrevious() OptString {
+	return s.Previous
 }
 
-// IsSet returns true if OptNilNilStringArray was set.
-func (o OptNilNilStringArray) IsSet() bool { return o.Set }
+// GetSkipped returns the value of Skipped.
+func (s *FirmwareUpdateReport) GetSkipped() OptBool {
+	return s.Skipped
+}
 
-// Reset unsets value.
-func (o *OptNilNilStringArray) Reset() {
-	var v []NilString
-	o.Value = v
-	o.Set = false
-	o.Null = false
+// GetStatus returns the value of Status.
+func (s *FirmwareUpdateReport) GetStatus() OptString {
+	return s.Status
 }
 
-// SetTo sets value to v.
-func (o *OptNilNilStringArray) SetTo(v []NilString) {
-	o.Set = true
-	o.Null = false
-	o.Value = v
+// GetVersion returns the value of Version.
+func (s *FirmwareUpdateReport) GetVersion() OptString {
+	return s.Version
 }
 
-// IsSet returns true if value is Null.
-func (o OptNilNilStringArray) IsNull() bool { return o.Null }
+// SetComponent sets the value of Component.
+func (s *FirmwareUpdateReport) SetComponent(val OptString) {
+	s.Component = val
+}
 
-// SetNull sets value to null.
-func (o *OptNilNilStringArray) SetToNull() {
-	o.Set = true
-	o.Null = true
-	var v []NilString
-	o.Value = v
+// SetHost sets the value of Host.
+func (s *FirmwareUpdateReport) SetHost(val OptString) {
+	s.Host = val
 }
 
-// Get returns value and boolean that denotes whether value was set.
-func (o OptNilNilStringArray) Get() (v []NilString, ok bool) {
-	if o.Null {
-		return v, false
-	}
-	if !o.Set {
-		return v, false
-	}
-	return o.Value, true
+// SetInventory sets the value of Inventory.
+func (s *FirmwareUpdateReport) SetInventory(val OptNilFirmwareUpdateReportInventory) {
+	s.Inventory = val
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o OptNilNilStringArray) Or(d []NilString) []NilString {
-	if v, ok := o.Get(); ok {
-		return v
-	}
-	return d
+// SetMsg sets the value of Msg.
+func (s *FirmwareUpdateReport) SetMsg(val OptString) {
+	s.Msg = val
 }
 
-// NewOptNilRedfishSystemOemDell returns new OptNilRedfishSystemOemDell with value set to v.
-func NewOptNilRedfishSystemOemDell(v RedfishSystemOemDell) OptNilRedfishSystemOemDell {
-	return OptNilRedfishSystemOemDell{
-		Value: v,
-		Set:   true,
-	}
+// SetPrevious sets the value of Previous.
+func (s *FirmwareUpdateReport) SetPrevious(val OptString) {
+	s.Previous = val
 }
 
-// OptNilRedfishSystemOemDell is optional nullable RedfishSystemOemDell.
-type OptNilRedfishSystemOemDell struct {
-	Value RedfishSystemOemDell
-	Set   bool
-	Null  bool
+// SetSkipped sets the value of Skipped.
+func (s *FirmwareUpdateReport) SetSkipped(val OptBool) {
+	s.Skipped = val
 }
 
-// IsSet returns true if OptNilRedfishSystemOemDell was set.
-func (o OptNilRedfishSystemOemDell) IsSet() bool { return o.Set }
+// SetStatus sets the value of Status.
+func (s *FirmwareUpdateReport) SetStatus(val OptString) {
+	s.Status = val
+}
 
-// Reset unsets value.
-func (o *OptNilRedfishSystemOemDell) Reset() {
-	var v RedfishSystemOemDell
-	o.Value = v
-	o.Set = false
-	o.Null = false
+// SetVersion sets the value of Version.
+func (s *FirmwareUpdateReport) SetVersion(val OptString) {
+	s.Version = val
 }
 
-// SetTo sets value to v.
-func (o *OptNilRedfishSystemOemDell) SetTo(v RedfishSystemOemDell) {
-	o.Set = true
-	o.Null = false
-	o.Value = v
+type FirmwareUpdateReportInventory struct {
+	BiosVersion OptString                                   `json:"bios_version"`
+	BmcFirmware OptString                                   `json:"bmc_firmware"`
+	CapturedAt  OptNilDateTime                              `json:"captured_at"`
+	NicFirmware OptFirmwareUpdateReportInventoryNicFirmware `json:"nic_firmware"`
 }
 
-// IsSet returns true if value is Null.
-func (o OptNilRedfishSystemOemDell) IsNull() bool { return o.Null }
+// GetBiosVersion returns the value of BiosVersion.
+func (s *FirmwareUpdateReportInventory) GetBiosVersion() OptString {
+	return s.BiosVersion
+}
 
-// SetNull sets value to null.
-func (o *OptNilRedfishSystemOemDell) SetToNull() {
-	o.Set = true
-	o.Null = true
-	var v RedfishSystemOemDell
-	o.Value = v
+// GetBmcFirmware returns the value of BmcFirmware.
+func (s *FirmwareUpdateReportInventory) GetBmcFirmware() OptString {
+	return s.BmcFirmware
 }
 
-// Get returns value and boolean that denotes whether value was set.
-func (o OptNilRedfishSystemOemDell) Get() (v RedfishSystemOemDell, ok bool) {
-	if o.Null {
-		return v, false
-	}
-	if !o.Set {
-		return v, false
+// GetCapturedAt returns the value of CapturedAt.
+func (s *FirmwareUpdateReportInventory) GetCapturedAt() OptNilDateTime {
+	return s.CapturedAt
+}
+
+// GetNicFirmware returns the value of NicFirmware.
+func (s *FirmwareUpdateReportInventory) GetNicFirmware() OptFirmwareUpdateReportInventoryNicFirmware {
+	return s.NicFirmware
+}
+
+// SetBiosVersion sets the value of BiosVersion.
+func (s *FirmwareUpdateReportInventory) SetBiosVersion(val OptString) {
+	s.BiosVersion = val
+}
+
+// SetBmcFirmware sets the value of BmcFirmware.
+func (s *FirmwareUpdateReportInventory) SetBmcFirmware(val OptString) {
+	s.BmcFirmware = val
+}
+
+// SetCapturedAt sets the value of CapturedAt.
+func (s *FirmwareUpdateReportInventory) SetCapturedAt(val OptNilDateTime) {
+	s.CapturedAt = val
+}
+
+// SetNicFirmware sets the value of NicFirmware.
+func (s *FirmwareUpdateReportInventory) SetNicFirmware(val OptFirmwareUpdateReportInventoryNicFirmware) {
+	s.NicFirmware = val
+}
+
+type FirmwareUpdateReportInventoryNicFirmware map[string]string
+
+func (s *FirmwareUpdateReportInventoryNicFirmware) init() FirmwareUpdateReportInventoryNicFirmware {
+	m := *s
+	if m == nil {
+		m = map[string]string{}
+		*s = m
 	}
-	return o.Value, true
+	return m
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o OptNilRedfishSystemOemDell) Or(d RedfishSystemOemDell) RedfishSystemOemDell {
-	if v, ok := o.Get(); ok {
-		return v
+type GETV1DbBackupOK struct {
+	Data io.Reader
+}
+
+// Read reads data from the Data reader.
+//
+// Kept to satisfy the io.Reader interface.
+func (s GETV1DbBackupOK) Read(p []byte) (n int, err error) {
+	if s.Data == nil {
+		return 0, io.EOF
 	}
-	return d
+	return s.Data.Read(p)
+}
+
+// GenericResponse schema.
+// Ref: #/components/schemas/GenericResponse
+type GenericResponse struct {
+	Changed OptInt    `json:"changed"`
+	Detail  OptString `json:"detail"`
+	Title   OptString `json:"title"`
+}
+
+// GetChanged returns the value of Changed.
+func (s *GenericResponse) GetChanged() OptInt {
+	return s.Changed
+}
+
+// GetDetail returns the value of Detail.
+func (s *GenericResponse) GetDetail() OptString {
+	return s.Detail
+}
+
+// GetTitle returns the value of Title.
+func (s *GenericResponse) GetTitle() OptString {
+	return s.Title
+}
+
+// SetChanged sets the value of Changed.
+func (s *GenericResponse) SetChanged(val OptInt) {
+	s.Changed = val
+}
+
+// SetDetail sets the value of Detail.
+func (s *GenericResponse) SetDetail(val OptString) {
+	s.Detail = val
+}
+
+// SetTitle sets the value of Title.
+func (s *GenericResponse) SetTitle(val OptString) {
+	s.Title = val
+}
+
+// GetRolesResponse schema.
+// Ref: #/components/schemas/GetRolesResponse
+type GetRolesResponse struct {
+	Roles []GetRolesResponseRolesItem `json:"roles"`
+}
+
+// GetRoles returns the value of Roles.
+func (s *GetRolesResponse) GetRoles() []GetRolesResponseRolesItem {
+	return s.Roles
+}
+
+// SetRoles sets the value of Roles.
+func (s *GetRolesResponse) SetRoles(val []GetRolesResponseRolesItem) {
+	s.Roles = val
+}
+
+type GetRolesResponseRolesItem struct {
+	Name                     OptString                                               `json:"name"`
+	PermissionList           []GetRolesResponseRolesItemPermissionListItem           `json:"permission_list"`
+	UnassignedPermissionList []GetRolesResponseRolesItemUnassignedPermissionListItem `json:"unassigned_permission_list"`
+}
+
+// GetName returns the value of Name.
+func (s *GetRolesResponseRolesItem) GetName() OptString {
+	return s.Name
+}
+
+// GetPermissionList returns the value of PermissionList.
+func (s *GetRolesResponseRolesItem) GetPermissionList() []GetRolesResponseRolesItemPermissionListItem {
+	return s.PermissionList
+}
+
+// GetUnassignedPermissionList returns the value of UnassignedPermissionList.
+func (s *GetRolesResponseRolesItem) GetUnassignedPermissionList() []GetRolesResponseRolesItemUnassignedPermissionListItem {
+	return s.UnassignedPermissionList
+}
+
+// SetName sets the value of Name.
+func (s *GetRolesResponseRolesItem) SetName(val OptString) {
+	s.Name = val
+}
+
+// SetPermissionList sets the value of PermissionList.
+func (s *GetRolesResponseRolesItem) SetPermissionList(val []GetRolesResponseRolesItemPermissionListItem) {
+	s.PermissionList = val
+}
+
+// SetUnassignedPermissionList sets the value of UnassignedPermissionList.
+func (s *GetRolesResponseRolesItem) SetUnassignedPermissionList(val []GetRolesResponseRolesItemUnassignedPermissionListItem) {
+	s.UnassignedPermissionList = val
+}
+
+type GetRolesResponseRolesItemPermissionListItem struct {
+	Method OptString `json:"method"`
+	Path   OptString `json:"path"`
+}
+
+// GetMethod returns the value of Method.
+func (s *GetRolesResponseRolesItemPermissionListItem) GetMethod() OptString {
+	return s.Method
+}
+
+// GetPath returns the value of Path.
+func (s *GetRolesResponseRolesItemPermissionListItem) GetPath() OptString {
+	return s.Path
+}
+
+// SetMethod sets the value of Method.
+func (s *GetRolesResponseRolesItemPermissionListItem) SetMethod(val OptString) {
+	s.Method = val
+}
+
+// SetPath sets the value of Path.
+func (s *GetRolesResponseRolesItemPermissionListItem) SetPath(val OptString) {
+	s.Path = val
+}
+
+type GetRolesResponseRolesItemUnassignedPermissionListItem struct {
+	Method OptString `json:"method"`
+	Path   OptString `json:"path"`
+}
+
+// GetMethod returns the value of Method.
+func (s *GetRolesResponseRolesItemUnassignedPermissionListItem) GetMethod() OptString {
+	return s.Method
+}
+
+// GetPath returns the value of Path.
+func (s *GetRolesResponseRolesItemUnassignedPermissionListItem) GetPath() OptString {
+	return s.Path
+}
+
+// SetMethod sets the value of Method.
+func (s *GetRolesResponseRolesItemUnassignedPermissionListItem) SetMethod(val OptString) {
+	s.Method = val
+}
+
+// SetPath sets the value of Path.
+func (s *GetRolesResponseRolesItemUnassignedPermissionListItem) SetPath(val OptString) {
+	s.Path = val
+}
+
+// HAInstance schema.
+// Ref: #/components/schemas/HAInstance
+type HAInstance struct {
+	// Heartbeat written within ha.failover_timeout of the API server.
+	Alive OptBool `json:"alive"`
+	// Time the instance last started or stopped answering DHCP.
+	Changed   OptDateTime `json:"changed"`
+	Heartbeat OptDateTime `json:"heartbeat"`
+	Name      OptString   `json:"name"`
+	Role      OptString   `json:"role"`
+	// Serving when answering DHCP, standby or stopped.
+	State OptString `json:"state"`
+}
+
+// GetAlive returns the value of Alive.
+func (s *HAInstance) GetAlive() OptBool {
+	return s.Alive
+}
+
+// GetChanged returns the value of Changed.
+func (s *HAInstance) GetChanged() OptDateTime {
+	return s.Changed
+}
+
+// GetHeartbeat returns the value of Heartbeat.
+func (s *HAInstance) GetHeartbeat() OptDateTime {
+	return s.Heartbeat
+}
+
+// GetName returns the value of Name.
+func (s *HAInstance) GetName() OptString {
+	return s.Name
+}
+
+// GetRole returns the value of Role.
+func (s *HAInstance) GetRole() OptString {
+	return s.Role
+}
+
+// GetState returns the value of State.
+func (s *HAInstance) GetState() OptString {
+	return s.State
+}
+
+// SetAlive sets the value of Alive.
+func (s *HAInstance) SetAlive(val OptBool) {
+	s.Alive = val
+}
+
+// SetChanged sets the value of Changed.
+func (s *HAInstance) SetChanged(val OptDateTime) {
+	s.Changed = val
+}
+
+// SetHeartbeat sets the value of Heartbeat.
+func (s *HAInstance) SetHeartbeat(val OptDateTime) {
+	s.Heartbeat = val
+}
+
+// SetName sets the value of Name.
+func (s *HAInstance) SetName(val OptString) {
+	s.Name = val
+}
+
+// SetRole sets the value of Role.
+func (s *HAInstance) SetRole(val OptString) {
+	s.Role = val
+}
+
+// SetState sets the value of State.
+func (s *HAInstance) SetState(val OptString) {
+	s.State = val
+}
+
+// HTTPError schema.
+// Ref: #/components/schemas/HTTPError
+type HTTPError struct {
+	// Human readable error message.
+	Detail   OptNilString                   `json:"detail"`
+	Errors   OptNilHTTPErrorErrorsItemArray `json:"errors"`
+	Instance OptNilString                   `json:"instance"`
+	// HTTP status code.
+	Status OptNilInt `json:"status"`
+	// Short title of the error.
+	Title OptNilString `json:"title"`
+	// URL of the error type. Can be used to lookup the error in a documentation.
+	Type OptNilString `json:"type"`
+}
+
+// GetDetail returns the value of Detail.
+func (s *HTTPError) GetDetail() OptNilString {
+	return s.Detail
+}
+
+// GetErrors returns the value of Errors.
+func (s *HTTPError) GetErrors() OptNilHTTPErrorErrorsItemArray {
+	return s.Errors
+}
+
+// GetInstance returns the value of Instance.
+func (s *HTTPError) GetInstance() OptNilString {
+	return s.Instance
+}
+
+// GetStatus returns the value of Status.
+func (s *HTTPError) GetStatus() OptNilInt {
+	return s.Status
+}
+
+// GetTitle returns the value of Title.
+func (s *HTTPError) GetTitle() OptNilString {
+	return s.Title
+}
+
+// GetType returns the value of Type.
+func (s *HTTPError) GetType() OptNilString {
+	return s.Type
+}
+
+// SetDetail sets the value of Detail.
+func (s *HTTPError) SetDetail(val OptNilString) {
+	s.Detail = val
+}
+
+// SetErrors sets the value of Errors.
+func (s *HTTPError) SetErrors(val OptNilHTTPErrorErrorsItemArray) {
+	s.Errors = val
+}
+
+// SetInstance sets the value of Instance.
+func (s *HTTPError) SetInstance(val OptNilString) {
+	s.Instance = val
+}
+
+// SetStatus sets the value of Status.
+func (s *HTTPError) SetStatus(val OptNilInt) {
+	s.Status = val
+}
+
+// SetTitle sets the value of Title.
+func (s *HTTPError) SetTitle(val OptNilString) {
+	s.Title = val
+}
+
+// SetType sets the value of Type.
+func (s *HTTPError) SetType(val OptNilString) {
+	s.Type = val
+}
+
+type HTTPErrorErrorsItem struct {
+	More   OptHTTPErrorErrorsItemMore `json:"more"`
+	Name   OptString                  `json:"name"`
+	Reason OptString                  `json:"reason"`
+}
+
+// GetMore returns the value of More.
+func (s *HTTPErrorErrorsItem) GetMore() OptHTTPErrorErrorsItemMore {
+	return s.More
+}
+
+// GetName returns the value of Name.
+func (s *HTTPErrorErrorsItem) GetName() OptString {
+	return s.Name
+}
+
+// GetReason returns the value of Reason.
+func (s *HTTPErrorErrorsItem) GetReason() OptString {
+	return s.Reason
+}
+
+// SetMore sets the value of More.
+func (s *HTTPErrorErrorsItem) SetMore(val OptHTTPErrorErrorsItemMore) {
+	s.More = val
+}
+
+// SetName sets the value of Name.
+func (s *HTTPErrorErrorsItem) SetName(val OptString) {
+	s.Name = val
+}
+
+// SetReason sets the value of Reason.
+func (s *HTTPErrorErrorsItem) SetReason(val OptString) {
+	s.Reason = val
+}
+
+type HTTPErrorErrorsItemMore map[string]jx.Raw
+
+func (s *HTTPErrorErrorsItemMore) init() HTTPErrorErrorsItemMore {
+	m := *s
+	if m == nil {
+		m = map[string]jx.Raw{}
+		*s = m
+	}
+	return m
+}
+
+// HTTPErrorStatusCode wraps HTTPError with StatusCode.
+type HTTPErrorStatusCode struct {
+	StatusCode int
+	Response   HTTPError
+}
+
+// GetStatusCode returns the value of StatusCode.
+func (s *HTTPErrorStatusCode) GetStatusCode() int {
+	return s.StatusCode
+}
+
+// GetResponse returns the value of Response.
+func (s *HTTPErrorStatusCode) GetResponse() HTTPError {
+	return s.Response
+}
+
+// SetStatusCode sets the value of StatusCode.
+func (s *HTTPErrorStatusCode) SetStatusCode(val int) {
+	s.StatusCode = val
+}
+
+// SetResponse sets the value of Response.
+func (s *HTTPErrorStatusCode) SetResponse(val HTTPError) {
+	s.Response = val
+}
+
+// HardwareReport schema.
+// Ref: #/components/schemas/HardwareReport
+type HardwareReport struct {
+	Changes  OptNilStringArray            `json:"changes"`
+	Hardware OptNilHardwareReportHardware `json:"hardware"`
+	Host     OptString                    `json:"host"`
+	Msg      OptString                    `json:"msg"`
+	Status   OptString                    `json:"status"`
+}
+
+// GetChanges returns the value of Changes.
+func (s *HardwareReport) GetChanges() OptNilStringArray {
+	return s.Changes
+}
+
+// GetHardware returns the value of Hardware.
+func (s *HardwareReport) GetHardware() OptNilHardwareReportHardware {
+	return s.Hardware
+}
+
+// GetHost returns the value of Host.
+func (s *HardwareReport) GetHost() OptString {
+	return s.Host
+}
+
+// GetMsg returns the value of Msg.
+func (s *HardwareReport) GetMsg() OptString {
+	return s.Msg
+}
+
+// GetStatus returns the value of Status.
+func (s *HardwareReport) GetStatus() OptString {
+	return s.Status
+}
+
+// SetChanges sets the value of Changes.
+func (s *HardwareReport) SetChanges(val OptNilStringArray) {
+	s.Changes = val
+}
+
+// SetHardware sets the value of Hardware.
+func (s *HardwareReport) SetHardware(val OptNilHardwareReportHardware) {
+	s.Hardware = val
+}
+
+// SetHost sets the value of Host.
+func (s *HardwareReport) SetHost(val OptString) {
+	s.Host = val
+}
+
+// SetMsg sets the value of Msg.
+func (s *HardwareReport) SetMsg(val OptString) {
+	s.Msg = val
+}
+
+// SetStatus sets the value of Status.
+func (s *HardwareReport) SetStatus(val OptString) {
+	s.Status = val
+}
+
+type HardwareReportHardware struct {
+	CollectedAt OptNilDateTime                                `json:"collected_at"`
+	CoreCount   OptInt                                        `json:"core_count"`
+	CPUCount    OptInt                                        `json:"cpu_count"`
+	CPUModel    OptString                                     `json:"cpu_model"`
+	Dimms       OptNilNilHardwareReportHardwareDimmsItemArray `json:"dimms"`
+	Disks       OptNilNilHardwareReportHardwareDisksItemArray `json:"disks"`
+	MemoryMib   OptInt                                        `json:"memory_mib"`
+	Missing     OptNilStringArray                             `json:"missing"`
+	Nics        OptNilNilHardwareReportHardwareNicsItemArray  `json:"nics"`
+}
+
+// GetCollectedAt returns the value of CollectedAt.
+func (s *HardwareReportHardware) GetCollectedAt() OptNilDateTime {
+	return s.CollectedAt
+}
+
+// GetCoreCount returns the value of CoreCount.
+func (s *HardwareReportHardware) GetCoreCount() OptInt {
+	return s.CoreCount
+}
+
+// GetCPUCount returns the value of CPUCount.
+func (s *HardwareReportHardware) GetCPUCount() OptInt {
+	return s.CPUCount
+}
+
+// GetCPUModel returns the value of CPUModel.
+func (s *HardwareReportHardware) GetCPUModel() OptString {
+	return s.CPUModel
+}
+
+// GetDimms returns the value of Dimms.
+func (s *HardwareReportHardware) GetDimms() OptNilNilHardwareReportHardwareDimmsItemArray {
+	return s.Dimms
+}
+
+// GetDisks returns the value of Disks.
+func (s *HardwareReportHardware) GetDisks() OptNilNilHardwareReportHardwareDisksItemArray {
+	return s.Disks
+}
+
+// GetMemoryMib returns the value of MemoryMib.
+func (s *HardwareReportHardware) GetMemoryMib() OptInt {
+	return s.MemoryMib
+}
+
+// GetMissing returns the value of Missing.
+func (s *HardwareReportHardware) GetMissing() OptNilStringArray {
+	return s.Missing
+}
+
+// GetNics returns the value of Nics.
+func (s *HardwareReportHardware) GetNics() OptNilNilHardwareReportHardwareNicsItemArray {
+	return s.Nics
+}
+
+// SetCollectedAt sets the value of CollectedAt.
+func (s *HardwareReportHardware) SetCollectedAt(val OptNilDateTime) {
+	s.CollectedAt = val
+}
+
+// SetCoreCount sets the value of CoreCount.
+func (s *HardwareReportHardware) SetCoreCount(val OptInt) {
+	s.CoreCount = val
+}
+
+// SetCPUCount sets the value of CPUCount.
+func (s *HardwareReportHardware) SetCPUCount(val OptInt) {
+	s.CPUCount = val
+}
+
+// SetCPUModel sets the value of CPUModel.
+func (s *HardwareReportHardware) SetCPUModel(val OptString) {
+	s.CPUModel = val
+}
+
+// SetDimms sets the value of Dimms.
+func (s *HardwareReportHardware) SetDimms(val OptNilNilHardwareReportHardwareDimmsItemArray) {
+	s.Dimms = val
+}
+
+// SetDisks sets the value of Disks.
+func (s *HardwareReportHardware) SetDisks(val OptNilNilHardwareReportHardwareDisksItemArray) {
+	s.Disks = val
+}
+
+// SetMemoryMib sets the value of MemoryMib.
+func (s *HardwareReportHardware) SetMemoryMib(val OptInt) {
+	s.MemoryMib = val
+}
+
+// SetMissing sets the value of Missing.
+func (s *HardwareReportHardware) SetMissing(val OptNilStringArray) {
+	s.Missing = val
+}
+
+// SetNics sets the value of Nics.
+func (s *HardwareReportHardware) SetNics(val OptNilNilHardwareReportHardwareNicsItemArray) {
+	s.Nics = val
+}
+
+type HardwareReportHardwareDimmsItem struct {
+	SizeMib OptInt    `json:"size_mib"`
+	Slot    OptString `json:"slot"`
+}
+
+// GetSizeMib returns the value of SizeMib.
+func (s *HardwareReportHardwareDimmsItem) GetSizeMib() OptInt {
+	return s.SizeMib
+}
+
+// GetSlot returns the value of Slot.
+func (s *HardwareReportHardwareDimmsItem) GetSlot() OptString {
+	return s.Slot
+}
+
+// SetSizeMib sets the value of SizeMib.
+func (s *HardwareReportHardwareDimmsItem) SetSizeMib(val OptInt) {
+	s.SizeMib = val
+}
+
+// SetSlot sets the value of Slot.
+func (s *HardwareReportHardwareDimmsItem) SetSlot(val OptString) {
+	s.Slot = val
+}
+
+type HardwareReportHardwareDisksItem struct {
+	Model     OptString `json:"model"`
+	Name      OptString `json:"name"`
+	Serial    OptString `json:"serial"`
+	SizeBytes OptInt64  `json:"size_bytes"`
+}
+
+// GetModel returns the value of Model.
+func (s *HardwareReportHardwareDisksItem) GetModel() OptString {
+	return s.Model
+}
+
+// GetName returns the value of Name.
+func (s *HardwareReportHardwareDisksItem) GetName() OptString {
+	return s.Name
+}
+
+// GetSerial returns the value of Serial.
+func (s *HardwareReportHardwareDisksItem) GetSerial() OptString {
+	return s.Serial
+}
+
+// GetSizeBytes returns the value of SizeBytes.
+func (s *HardwareReportHardwareDisksItem) GetSizeBytes() OptInt64 {
+	return s.SizeBytes
+}
+
+// SetModel sets the value of Model.
+func (s *HardwareReportHardwareDisksItem) SetModel(val OptString) {
+	s.Model = val
+}
+
+// SetName sets the value of Name.
+func (s *HardwareReportHardwareDisksItem) SetName(val OptString) {
+	s.Name = val
+}
+
+// SetSerial sets the value of Serial.
+func (s *HardwareReportHardwareDisksItem) SetSerial(val OptString) {
+	s.Serial = val
+}
+
+// SetSizeBytes sets the value of SizeBytes.
+func (s *HardwareReportHardwareDisksItem) SetSizeBytes(val OptInt64) {
+	s.SizeBytes = val
+}
+
+type HardwareReportHardwareNicsItem struct {
+	MAC  OptString `json:"mac"`
+	Name OptString `json:"name"`
+}
+
+// GetMAC returns the value of MAC.
+func (s *HardwareReportHardwareNicsItem) GetMAC() OptString {
+	return s.MAC
+}
+
+// GetName returns the value of Name.
+func (s *HardwareReportHardwareNicsItem) GetName() OptString {
+	return s.Name
+}
+
+// SetMAC sets the value of MAC.
+func (s *HardwareReportHardwareNicsItem) SetMAC(val OptString) {
+	s.MAC = val
+}
+
+// SetName sets the value of Name.
+func (s *HardwareReportHardwareNicsItem) SetName(val OptString) {
+	s.Name = val
+}
+
+type HeaderAuth struct {
+	Token string
+}
+
+// GetToken returns the value of Token.
+func (s *HeaderAuth) GetToken() string {
+	return s.Token
+}
+
+// SetToken sets the value of Token.
+func (s *HeaderAuth) SetToken(val string) {
+	s.Token = val
+}
+
+// Host schema.
+// Ref: #/components/schemas/Host
+type Host struct {
+	Bonds     []NilHostBondsItem `json:"bonds"`
+	BootImage OptString          `json:"boot_image"`
+	// SHA-256 fingerprint of the client certificate issued to the host, checked by the provision server.
+	ClientCertFingerprint OptNilString `json:"client_cert_fingerprint"`
+	// Serial number in hex of the client certificate issued to the host, revoked with the host.
+	ClientCertSerial OptNilString            `json:"client_cert_serial"`
+	CreatedAt        OptNilDateTime          `json:"created_at"`
+	Firmware         OptString               `json:"firmware"`
+	Hardware         OptNilHostHardware      `json:"hardware"`
+	ID               OptNilInt64             `json:"id"`
+	Interfaces       []NilHostInterfacesItem `json:"interfaces"`
+	Inventory        OptNilHostInventory     `json:"inventory"`
+	Name             OptString               `json:"name"`
+	Provision        OptBool                 `json:"provision"`
+	Revision         OptNilInt64             `json:"revision"`
+	SmbiosUUID       OptNilString            `json:"smbios_uuid"`
+	// Lifecycle state of the host: new, ready-to-provision, installing, production, drained or retired.
+	// Provision follows the state.
+	State     OptNilString      `json:"state"`
+	Tags      OptNilStringArray `json:"tags"`
+	UID       OptNilString      `json:"uid"`
+	UpdatedAt OptNilDateTime    `json:"updated_at"`
+}
+
+// GetBonds returns the value of Bonds.
+func (s *Host) GetBonds() []NilHostBondsItem {
+	return s.Bonds
+}
+
+// GetBootImage returns the value of BootImage.
+func (s *Host) GetBootImage() OptString {
+	return s.BootImage
+}
+
+// GetClientCertFingerprint returns the value of ClientCertFingerprint.
+func (s *Host) GetClientCertFingerprint() OptNilString {
+	return s.ClientCertFingerprint
+}
+
+// GetClientCertSerial returns the value of ClientCertSerial.
+func (s *Host) GetClientCertSerial() OptNilString {
+	return s.ClientCertSerial
+}
+
+// GetCreatedAt returns the value of CreatedAt.
+func (s *Host) GetCreatedAt() OptNilDateTime {
+	return s.CreatedAt
+}
+
+// GetFirmware returns the value of Firmware.
+func (s *Host) GetFirmware() OptString {
+	return s.Firmware
+}
+
+// GetHardware returns the value of Hardware.
+func (s *Host) GetHardware() OptNilHostHardware {
+	return s.Hardware
+}
+
+// GetID returns the value of ID.
+func (s *Host) GetID() OptNilInt64 {
+	return s.ID
+}
+
+// GetInterfaces returns the value of Interfaces.
+func (s *Host) GetInterfaces() []NilHostInterfacesItem {
+	return s.Interfaces
+}
+
+// GetInventory returns the value of Inventory.
+func (s *Host) GetInventory() OptNilHostInventory {
+	return s.Inventory
+}
+
+// GetName returns the value of Name.
+func (s *Host) GetName() OptString {
+	return s.Name
+}
+
+// GetProvision returns the value of Provision.
+func (s *Host) GetProvision() OptBool {
+	return s.Provision
+}
+
+// GetRevision returns the value of Revision.
+func (s *Host) GetRevision() OptNilInt64 {
+	return s.Revision
+}
+
+// GetSmbiosUUID returns the value of SmbiosUUID.
+func (s *Host) GetSmbiosUUID() OptNilString {
+	return s.SmbiosUUID
+}
+
+// GetState returns the value of State.
+func (s *Host) GetState() OptNilString {
+	return s.State
+}
+
+// GetTags returns the value of Tags.
+func (s *Host) GetTags() OptNilStringArray {
+	return s.Tags
+}
+
+// GetUID returns the value of UID.
+func (s *Host) GetUID() OptNilString {
+	return s.UID
+}
+
+// GetUpdatedAt returns the value of UpdatedAt.
+func (s *Host) GetUpdatedAt() OptNilDateTime {
+	return s.UpdatedAt
+}
+
+// SetBonds sets the value of Bonds.
+func (s *Host) SetBonds(val []NilHostBondsItem) {
+	s.Bonds = val
+}
+
+// SetBootImage sets the value of BootImage.
+func (s *Host) SetBootImage(val OptString) {
+	s.BootImage = val
+}
+
+// SetClientCertFingerprint sets the value of ClientCertFingerprint.
+func (s *Host) SetClientCertFingerprint(val OptNilString) {
+	s.ClientCertFingerprint = val
+}
+
+// SetClientCertSerial sets the value of ClientCertSerial.
+func (s *Host) SetClientCertSerial(val OptNilString) {
+	s.ClientCertSerial = val
+}
+
+// SetCreatedAt sets the value of CreatedAt.
+func (s *Host) SetCreatedAt(val OptNilDateTime) {
+	s.CreatedAt = val
+}
+
+// SetFirmware sets the value of Firmware.
+func (s *Host) SetFirmware(val OptString) {
+	s.Firmware = val
+}
+
+// SetHardware sets the value of Hardware.
+func (s *Host) SetHardware(val OptNilHostHardware) {
+	s.Hardware = val
+}
+
+// SetID sets the value of ID.
+func (s *Host) SetID(val OptNilInt64) {
+	s.ID = val
+}
+
+// SetInterfaces sets the value of Interfaces.
+func (s *Host) SetInterfaces(val []NilHostInterfacesItem) {
+	s.Interfaces = val
+}
+
+// SetInventory sets the value of Inventory.
+func (s *Host) SetInventory(val OptNilHostInventory) {
+	s.Inventory = val
+}
+
+// SetName sets the value of Name.
+func (s *Host) SetName(val OptString) {
+	s.Name = val
+}
+
+// SetProvision sets the value of Provision.
+func (s *Host) SetProvision(val OptBool) {
+	s.Provision = val
+}
+
+// SetRevision sets the value of Revision.
+func (s *Host) SetRevision(val OptNilInt64) {
+	s.Revision = val
+}
+
+// SetSmbiosUUID sets the value of SmbiosUUID.
+func (s *Host) SetSmbiosUUID(val OptNilString) {
+	s.SmbiosUUID = val
+}
+
+// SetState sets the value of State.
+func (s *Host) SetState(val OptNilString) {
+	s.State = val
+}
+
+// SetTags sets the value of Tags.
+func (s *Host) SetTags(val OptNilStringArray) {
+	s.Tags = val
+}
+
+// SetUID sets the value of UID.
+func (s *Host) SetUID(val OptNilString) {
+	s.UID = val
+}
+
+// SetUpdatedAt sets the value of UpdatedAt.
+func (s *Host) SetUpdatedAt(val OptNilDateTime) {
+	s.UpdatedAt = val
+}
+
+type HostBondsItem struct {
+	Addresses []HostBondsItemAddressesItem `json:"addresses"`
+	Bmc       OptBool                      `json:"bmc"`
+	Fqdn      OptString                    `json:"fqdn"`
+	ID        OptNilInt64                  `json:"id"`
+	Ifname    OptString                    `json:"ifname"`
+	IP        OptString                    `json:"ip"`
+	MAC       OptString                    `json:"mac"`
+	Mode      OptString                    `json:"mode"`
+	Mtu       OptInt                       `json:"mtu"`
+	Options   OptHostBondsItemOptions      `json:"options"`
+	Parent    OptString                    `json:"parent"`
+	Peers     []string                     `json:"peers"`
+	Port      OptInt                       `json:"port"`
+	Switch    OptString                    `json:"switch"`
+	Type      OptString                    `json:"type"`
+	Vlan      OptInt                       `json:"vlan"`
+}
+
+// GetAddresses returns the value of Addresses.
+func (s *HostBondsItem) GetAddresses() []HostBondsItemAddressesItem {
+	return s.Addresses
+}
+
+// GetBmc returns the value of Bmc.
+func (s *HostBondsItem) GetBmc() OptBool {
+	return s.Bmc
+}
+
+// GetFqdn returns the value of Fqdn.
+func (s *HostBondsItem) GetFqdn() OptString {
+	return s.Fqdn
+}
+
+// GetID returns the value of ID.
+func (s *HostBondsItem) GetID() OptNilInt64 {
+	return s.ID
+}
+
+// GetIfname returns the value of Ifname.
+func (s *HostBondsItem) GetIfname() OptString {
+	return s.Ifname
+}
+
+// GetIP returns the value of IP.
+func (s *HostBondsItem) GetIP() OptString {
+	return s.IP
+}
+
+// GetMAC returns the value of MAC.
+func (s *HostBondsItem) GetMAC() OptString {
+	return s.MAC
+}
+
+// GetMode returns the value of Mode.
+func (s *HostBondsItem) GetMode() OptString {
+	return s.Mode
+}
+
+// GetMtu returns the value of Mtu.
+func (s *HostBondsItem) GetMtu() OptInt {
+	return s.Mtu
+}
+
+// GetOptions returns the value of Options.
+func (s *HostBondsItem) GetOptions() OptHostBondsItemOptions {
+	return s.Options
+}
+
+// GetParent returns the value of Parent.
+func (s *HostBondsItem) GetParent() OptString {
+	return s.Parent
+}
+
+// GetPeers returns the value of Peers.
+func (s *HostBondsItem) GetPeers() []string {
+	return s.Peers
+}
+
+// GetPort returns the value of Port.
+func (s *HostBondsItem) GetPort() OptInt {
+	return s.Port
+}
+
+// GetSwitch returns the value of Switch.
+func (s *HostBondsItem) GetSwitch() OptString {
+	return s.Switch
+}
+
+// GetType returns the value of Type.
+func (s *HostBondsItem) GetType() OptString {
+	return s.Type
+}
+
+// GetVlan returns the value of Vlan.
+func (s *HostBondsItem) GetVlan() OptInt {
+	return s.Vlan
+}
+
+// SetAddresses sets the value of Addresses.
+func (s *HostBondsItem) SetAddresses(val []HostBondsItemAddressesItem) {
+	s.Addresses = val
+}
+
+// SetBmc sets the value of Bmc.
+func (s *HostBondsItem) SetBmc(val OptBool) {
+	s.Bmc = val
+}
+
+// SetFqdn sets the value of Fqdn.
+func (s *HostBondsItem) SetFqdn(val OptString) {
+	s.Fqdn = val
+}
+
+// SetID sets the value of ID.
+func (s *HostBondsItem) SetID(val OptNilInt64) {
+	s.ID = val
+}
+
+// SetIfname sets the value of Ifname.
+func (s *HostBondsItem) SetIfname(val OptString) {
+	s.Ifname = val
+}
+
+// SetIP sets the value of IP.
+func (s *HostBondsItem) SetIP(val OptString) {
+	s.IP = val
+}
+
+// SetMAC sets the value of MAC.
+func (s *HostBondsItem) SetMAC(val OptString) {
+	s.MAC = val
+}
+
+// SetMode sets the value of Mode.
+func (s *HostBondsItem) SetMode(val OptString) {
+	s.Mode = val
+}
+
+// SetMtu sets the value of Mtu.
+func (s *HostBondsItem) SetMtu(val OptInt) {
+	s.Mtu = val
+}
+
+// SetOptions sets the value of Options.
+func (s *HostBondsItem) SetOptions(val OptHostBondsItemOptions) {
+	s.Options = val
+}
+
+// SetParent sets the value of Parent.
+func (s *HostBondsItem) SetParent(val OptString) {
+	s.Parent = val
+}
+
+// SetPeers sets the value of Peers.
+func (s *HostBondsItem) SetPeers(val []string) {
+	s.Peers = val
+}
+
+// SetPort sets the value of Port.
+func (s *HostBondsItem) SetPort(val OptInt) {
+	s.Port = val
+}
+
+// SetSwitch sets the value of Switch.
+func (s *HostBondsItem) SetSwitch(val OptString) {
+	s.Switch = val
+}
+
+// SetType sets the value of Type.
+func (s *HostBondsItem) SetType(val OptString) {
+	s.Type = val
+}
+
+// SetVlan sets the value of Vlan.
+func (s *HostBondsItem) SetVlan(val OptInt) {
+	s.Vlan = val
+}
+
+type HostBondsItemAddressesItem struct {
+	Fqdn    OptString `json:"fqdn"`
+	IP      OptString `json:"ip"`
+	Primary OptBool   `json:"primary"`
+}
+
+// GetFqdn returns the value of Fqdn.
+func (s *HostBondsItemAddressesItem) GetFqdn() OptString {
+	return s.Fqdn
+}
+
+// GetIP returns the value of IP.
+func (s *HostBondsItemAddressesItem) GetIP() OptString {
+	return s.IP
+}
+
+// GetPrimary returns the value of Primary.
+func (s *HostBondsItemAddressesItem) GetPrimary() OptBool {
+	return s.Primary
+}
+
+// SetFqdn sets the value of Fqdn.
+func (s *HostBondsItemAddressesItem) SetFqdn(val OptString) {
+	s.Fqdn = val
+}
+
+// SetIP sets the value of IP.
+func (s *HostBondsItemAddressesItem) SetIP(val OptString) {
+	s.IP = val
+}
+
+// SetPrimary sets the value of Primary.
+func (s *HostBondsItemAddressesItem) SetPrimary(val OptBool) {
+	s.Primary = val
+}
+
+type HostBondsItemOptions map[string]string
+
+func (s *HostBondsItemOptions) init() HostBondsItemOptions {
+	m := *s
+	if m == nil {
+		m = map[string]string{}
+		*s = m
+	}
+	return m
+}
+
+// HostFile schema.
+// Ref: #/components/schemas/HostFile
+type HostFile struct {
+	Host      OptString   `json:"host"`
+	Name      OptString   `json:"name"`
+	Size      OptInt64    `json:"size"`
+	UpdatedAt OptDateTime `json:"updated_at"`
+}
+
+// GetHost returns the value of Host.
+func (s *HostFile) GetHost() OptString {
+	return s.Host
+}
+
+// GetName returns the value of Name.
+func (s *HostFile) GetName() OptString {
+	return s.Name
+}
+
+// GetSize returns the value of Size.
+func (s *HostFile) GetSize() OptInt64 {
+	return s.Size
+}
+
+// GetUpdatedAt returns the value of UpdatedAt.
+func (s *HostFile) GetUpdatedAt() OptDateTime {
+	return s.UpdatedAt
+}
+
+// SetHost sets the value of Host.
+func (s *HostFile) SetHost(val OptString) {
+	s.Host = val
+}
+
+// SetName sets the value of Name.
+func (s *HostFile) SetName(val OptString) {
+	s.Name = val
+}
+
+// SetSize sets the value of Size.
+func (s *HostFile) SetSize(val OptInt64) {
+	s.Size = val
+}
+
+// SetUpdatedAt sets the value of UpdatedAt.
+func (s *HostFile) SetUpdatedAt(val OptDateTime) {
+	s.UpdatedAt = val
+}
+
+type HostHardware struct {
+	CollectedAt OptNilDateTime                      `json:"collected_at"`
+	CoreCount   OptInt                              `json:"core_count"`
+	CPUCount    OptInt                              `json:"cpu_count"`
+	CPUModel    OptString                           `json:"cpu_model"`
+	Dimms       OptNilNilHostHardwareDimmsItemArray `json:"dimms"`
+	Disks       OptNilNilHostHardwareDisksItemArray `json:"disks"`
+	MemoryMib   OptInt                              `json:"memory_mib"`
+	Missing     OptNilStringArray                   `json:"missing"`
+	Nics        OptNilNilHostHardwareNicsItemArray  `json:"nics"`
+}
+
+// GetCollectedAt returns the value of CollectedAt.
+func (s *HostHardware) GetCollectedAt() OptNilDateTime {
+	return s.CollectedAt
+}
+
+// GetCoreCount returns the value of CoreCount.
+func (s *HostHardware) GetCoreCount() OptInt {
+	return s.CoreCount
+}
+
+// GetCPUCount returns the value of CPUCount.
+func (s *HostHardware) GetCPUCount() OptInt {
+	return s.CPUCount
+}
+
+// GetCPUModel returns the value of CPUModel.
+func (s *HostHardware) GetCPUModel() OptString {
+	return s.CPUModel
+}
+
+// GetDimms returns the value of Dimms.
+func (s *HostHardware) GetDimms() OptNilNilHostHardwareDimmsItemArray {
+	return s.Dimms
+}
+
+// GetDisks returns the value of Disks.
+func (s *HostHardware) GetDisks() OptNilNilHostHardwareDisksItemArray {
+	return s.Disks
+}
+
+// GetMemoryMib returns the value of MemoryMib.
+func (s *HostHardware) GetMemoryMib() OptInt {
+	return s.MemoryMib
+}
+
+// GetMissing returns the value of Missing.
+func (s *HostHardware) GetMissing() OptNilStringArray {
+	return s.Missing
+}
+
+// GetNics returns the value of Nics.
+func (s *HostHardware) GetNics() OptNilNilHostHardwareNicsItemArray {
+	return s.Nics
+}
+
+// SetCollectedAt sets the value of CollectedAt.
+func (s *HostHardware) SetCollectedAt(val OptNilDateTime) {
+	s.CollectedAt = val
+}
+
+// SetCoreCount sets the value of CoreCount.
+func (s *HostHardware) SetCoreCount(val OptInt) {
+	s.CoreCount = val
+}
+
+// SetCPUCount sets the value of CPUCount.
+func (s *HostHardware) SetCPUCount(val OptInt) {
+	s.CPUCount = val
+}
+
+// SetCPUModel sets the value of CPUModel.
+func (s *HostHardware) SetCPUModel(val OptString) {
+	s.CPUModel = val
+}
+
+// SetDimms sets the value of Dimms.
+func (s *HostHardware) SetDimms(val OptNilNilHostHardwareDimmsItemArray) {
+	s.Dimms = val
+}
+
+// SetDisks sets the value of Disks.
+func (s *HostHardware) SetDisks(val OptNilNilHostHardwareDisksItemArray) {
+	s.Disks = val
+}
+
+// SetMemoryMib sets the value of MemoryMib.
+func (s *HostHardware) SetMemoryMib(val OptInt) {
+	s.MemoryMib = val
+}
+
+// SetMissing sets the value of Missing.
+func (s *HostHardware) SetMissing(val OptNilStringArray) {
+	s.Missing = val
+}
+
+// SetNics sets the value of Nics.
+func (s *HostHardware) SetNics(val OptNilNilHostHardwareNicsItemArray) {
+	s.Nics = val
+}
+
+type HostHardwareDimmsItem struct {
+	SizeMib OptInt    `json:"size_mib"`
+	Slot    OptString `json:"slot"`
+}
+
+// GetSizeMib returns the value of SizeMib.
+func (s *HostHardwareDimmsItem) GetSizeMib() OptInt {
+	return s.SizeMib
+}
+
+// GetSlot returns the value of Slot.
+func (s *HostHardwareDimmsItem) GetSlot() OptString {
+	return s.Slot
+}
+
+// SetSizeMib sets the value of SizeMib.
+func (s *HostHardwareDimmsItem) SetSizeMib(val OptInt) {
+	s.SizeMib = val
+}
+
+// SetSlot sets the value of Slot.
+func (s *HostHardwareDimmsItem) SetSlot(val OptString) {
+	s.Slot = val
+}
+
+type HostHardwareDisksItem struct {
+	Model     OptString `json:"model"`
+	Name      OptString `json:"name"`
+	Serial    OptString `json:"serial"`
+	SizeBytes OptInt64  `json:"size_bytes"`
+}
+
+// GetModel returns the value of Model.
+func (s *HostHardwareDisksItem) GetModel() OptString {
+	return s.Model
+}
+
+// GetName returns the value of Name.
+func (s *HostHardwareDisksItem) GetName() OptString {
+	return s.Name
+}
+
+// GetSerial returns the value of Serial.
+func (s *HostHardwareDisksItem) GetSerial() OptString {
+	return s.Serial
+}
+
+// GetSizeBytes returns the value of SizeBytes.
+func (s *HostHardwareDisksItem) GetSizeBytes() OptInt64 {
+	return s.SizeBytes
+}
+
+// SetModel sets the value of Model.
+func (s *HostHardwareDisksItem) SetModel(val OptString) {
+	s.Model = val
+}
+
+// SetName sets the value of Name.
+func (s *HostHardwareDisksItem) SetName(val OptString) {
+	s.Name = val
+}
+
+// SetSerial sets the value of Serial.
+func (s *HostHardwareDisksItem) SetSerial(val OptString) {
+	s.Serial = val
+}
+
+// SetSizeBytes sets the value of SizeBytes.
+func (s *HostHardwareDisksItem) SetSizeBytes(val OptInt64) {
+	s.SizeBytes = val
+}
+
+type HostHardwareNicsItem struct {
+	MAC  OptString `json:"mac"`
+	Name OptString `json:"name"`
+}
+
+// GetMAC returns the value of MAC.
+func (s *HostHardwareNicsItem) GetMAC() OptString {
+	return s.MAC
+}
+
+// GetName returns the value of Name.
+func (s *HostHardwareNicsItem) GetName() OptString {
+	return s.Name
+}
+
+// SetMAC sets the value of MAC.
+func (s *HostHardwareNicsItem) SetMAC(val OptString) {
+	s.MAC = val
+}
+
+// SetName sets the value of Name.
+func (s *HostHardwareNicsItem) SetName(val OptString) {
+	s.Name = val
+}
+
+type HostInterfacesItem struct {
+	Addresses []HostInterfacesItemAddressesItem `json:"addresses"`
+	Bmc       OptBool                           `json:"bmc"`
+	Fqdn      OptString                         `json:"fqdn"`
+	ID        OptNilInt64                       `json:"id"`
+	Ifname    OptString                         `json:"ifname"`
+	IP        OptString                         `json:"ip"`
+	MAC       OptString                         `json:"mac"`
+	Mtu       OptInt                            `json:"mtu"`
+	Parent    OptString                         `json:"parent"`
+	Port      OptInt                            `json:"port"`
+	Switch    OptString                         `json:"switch"`
+	Vlan      OptInt                            `json:"vlan"`
+}
+
+// GetAddresses returns the value of Addresses.
+func (s *HostInterfacesItem) GetAddresses() []HostInterfacesItemAddressesItem {
+	return s.Addresses
+}
+
+// GetBmc returns the value of Bmc.
+func (s *HostInterfacesItem) GetBmc() OptBool {
+	return s.Bmc
+}
+
+// GetFqdn returns the value of Fqdn.
+func (s *HostInterfacesItem) GetFqdn() OptString {
+	return s.Fqdn
+}
+
+// GetID returns the value of ID.
+func (s *HostInterfacesItem) GetID() OptNilInt64 {
+	return s.ID
+}
+
+// GetIfname returns the value of Ifname.
+func (s *HostInterfacesItem) GetIfname() OptString {
+	return s.Ifname
+}
+
+// GetIP returns the value of IP.
+func (s *HostInterfacesItem) GetIP() OptString {
+	return s.IP
+}
+
+// GetMAC returns the value of MAC.
+func (s *HostInterfacesItem) GetMAC() OptString {
+	return s.MAC
+}
+
+// GetMtu returns the value of Mtu.
+func (s *HostInterfacesItem) GetMtu() OptInt {
+	return s.Mtu
+}
+
+// GetParent returns the value of Parent.
+func (s *HostInterfacesItem) GetParent() OptString {
+	return s.Parent
+}
+
+// GetPort returns the value of Port.
+func (s *HostInterfacesItem) GetPort() OptInt {
+	return s.Port
+}
+
+// GetSwitch returns the value of Switch.
+func (s *HostInterfacesItem) GetSwitch() OptString {
+	return s.Switch
+}
+
+// GetVlan returns the value of Vlan.
+func (s *HostInterfacesItem) GetVlan() OptInt {
+	return s.Vlan
+}
+
+// SetAddresses sets the value of Addresses.
+func (s *HostInterfacesItem) SetAddresses(val []HostInterfacesItemAddressesItem) {
+	s.Addresses = val
+}
+
+// SetBmc sets the value of Bmc.
+func (s *HostInterfacesItem) SetBmc(val OptBool) {
+	s.Bmc = val
+}
+
+// SetFqdn sets the value of Fqdn.
+func (s *HostInterfacesItem) SetFqdn(val OptString) {
+	s.Fqdn = val
+}
+
+// SetID sets the value of ID.
+func (s *HostInterfacesItem) SetID(val OptNilInt64) {
+	s.ID = val
+}
+
+// SetIfname sets the value of Ifname.
+func (s *HostInterfacesItem) SetIfname(val OptString) {
+	s.Ifname = val
+}
+
+// SetIP sets the value of IP.
+func (s *HostInterfacesItem) SetIP(val OptString) {
+	s.IP = val
+}
+
+// SetMAC sets the value of MAC.
+func (s *HostInterfacesItem) SetMAC(val OptString) {
+	s.MAC = val
+}
+
+// SetMtu sets the value of Mtu.
+func (s *HostInterfacesItem) SetMtu(val OptInt) {
+	s.Mtu = val
+}
+
+// SetParent sets the value of Parent.
+func (s *HostInterfacesItem) SetParent(val OptString) {
+	s.Parent = val
+}
+
+// SetPort sets the value of Port.
+func (s *HostInterfacesItem) SetPort(val OptInt) {
+	s.Port = val
+}
+
+// SetSwitch sets the value of Switch.
+func (s *HostInterfacesItem) SetSwitch(val OptString) {
+	s.Switch = val
+}
+
+// SetVlan sets the value of Vlan.
+func (s *HostInterfacesItem) SetVlan(val OptInt) {
+	s.Vlan = val
+}
+
+type HostInterfacesItemAddressesItem struct {
+	Fqdn    OptString `json:"fqdn"`
+	IP      OptString `json:"ip"`
+	Primary OptBool   `json:"primary"`
+}
+
+// GetFqdn returns the value of Fqdn.
+func (s *HostInterfacesItemAddressesItem) GetFqdn() OptString {
+	return s.Fqdn
+}
+
+// GetIP returns the value of IP.
+func (s *HostInterfacesItemAddressesItem) GetIP() OptString {
+	return s.IP
+}
+
+// GetPrimary returns the value of Primary.
+func (s *HostInterfacesItemAddressesItem) GetPrimary() OptBool {
+	return s.Primary
+}
+
+// SetFqdn sets the value of Fqdn.
+func (s *HostInterfacesItemAddressesItem) SetFqdn(val OptString) {
+	s.Fqdn = val
+}
+
+// SetIP sets the value of IP.
+func (s *HostInterfacesItemAddressesItem) SetIP(val OptString) {
+	s.IP = val
+}
+
+// SetPrimary sets the value of Primary.
+func (s *HostInterfacesItemAddressesItem) SetPrimary(val OptBool) {
+	s.Primary = val
+}
+
+type HostInventory struct {
+	BiosVersion OptString                   `json:"bios_version"`
+	BmcFirmware OptString                   `json:"bmc_firmware"`
+	CapturedAt  OptNilDateTime              `json:"captured_at"`
+	NicFirmware OptHostInventoryNicFirmware `json:"nic_firmware"`
+}
+
+// GetBiosVersion returns the value of BiosVersion.
+func (s *HostInventory) GetBiosVersion() OptString {
+	return s.BiosVersion
+}
+
+// GetBmcFirmware returns the value of BmcFirmware.
+func (s *HostInventory) GetBmcFirmware() OptString {
+	return s.BmcFirmware
+}
+
+// GetCapturedAt returns the value of CapturedAt.
+func (s *HostInventory) GetCapturedAt() OptNilDateTime {
+	return s.CapturedAt
+}
+
+// GetNicFirmware returns the value of NicFirmware.
+func (s *HostInventory) GetNicFirmware() OptHostInventoryNicFirmware {
+	return s.NicFirmware
+}
+
+// SetBiosVersion sets the value of BiosVersion.
+func (s *HostInventory) SetBiosVersion(val OptString) {
+	s.BiosVersion = val
+}
+
+// SetBmcFirmware sets the value of BmcFirmware.
+func (s *HostInventory) SetBmcFirmware(val OptString) {
+	s.BmcFirmware = val
+}
+
+// SetCapturedAt sets the value of CapturedAt.
+func (s *HostInventory) SetCapturedAt(val OptNilDateTime) {
+	s.CapturedAt = val
+}
+
+// SetNicFirmware sets the value of NicFirmware.
+func (s *HostInventory) SetNicFirmware(val OptHostInventoryNicFirmware) {
+	s.NicFirmware = val
+}
+
+type HostInventoryNicFirmware map[string]string
+
+func (s *HostInventoryNicFirmware) init() HostInventoryNicFirmware {
+	m := *s
+	if m == nil {
+		m = map[string]string{}
+		*s = m
+	}
+	return m
+}
+
+// HostLogEntry schema.
+// Ref: #/components/schemas/HostLogEntry
+type HostLogEntry struct {
+	Host      OptString   `json:"host"`
+	Message   OptString   `json:"message"`
+	MessageID OptString   `json:"message_id"`
+	Severity  OptString   `json:"severity"`
+	Time      OptDateTime `json:"time"`
+}
+
+// GetHost returns the value of Host.
+func (s *HostLogEntry) GetHost() OptString {
+	return s.Host
+}
+
+// GetMessage returns the value of Message.
+func (s *HostLogEntry) GetMessage() OptString {
+	return s.Message
+}
+
+// GetMessageID returns the value of MessageID.
+func (s *HostLogEntry) GetMessageID() OptString {
+	return s.MessageID
+}
+
+// GetSeverity returns the value of Severity.
+func (s *HostLogEntry) GetSeverity() OptString {
+	return s.Severity
+}
+
+// GetTime returns the value of Time.
+func (s *HostLogEntry) GetTime() OptDateTime {
+	return s.Time
+}
+
+// SetHost sets the value of Host.
+func (s *HostLogEntry) SetHost(val OptString) {
+	s.Host = val
+}
+
+// SetMessage sets the value of Message.
+func (s *HostLogEntry) SetMessage(val OptString) {
+	s.Message = val
+}
+
+// SetMessageID sets the value of MessageID.
+func (s *HostLogEntry) SetMessageID(val OptString) {
+	s.MessageID = val
+}
+
+// SetSeverity sets the value of Severity.
+func (s *HostLogEntry) SetSeverity(val OptString) {
+	s.Severity = val
+}
+
+// SetTime sets the value of Time.
+func (s *HostLogEntry) SetTime(val OptDateTime) {
+	s.Time = val
+}
+
+// IPReservation schema.
+// Ref: #/components/schemas/IPReservation
+type IPReservation struct {
+	CreatedAt OptDateTime  `json:"created_at"`
+	ExpiresAt OptDateTime  `json:"expires_at"`
+	Host      OptNilString `json:"host"`
+	IP        OptString    `json:"ip"`
+	Kind      OptString    `json:"kind"`
+	User      OptNilString `json:"user"`
+}
+
+// GetCreatedAt returns the value of CreatedAt.
+func (s *IPReservation) GetCreatedAt() OptDateTime {
+	return s.CreatedAt
+}
+
+// GetExpiresAt returns the value of ExpiresAt.
+func (s *IPReservation) GetExpiresAt() OptDateTime {
+	return s.ExpiresAt
+}
+
+// GetHost returns the value of Host.
+func (s *IPReservation) GetHost() OptNilString {
+	return s.Host
+}
+
+// GetIP returns the value of IP.
+func (s *IPReservation) GetIP() OptString {
+	return s.IP
+}
+
+// GetKind returns the value of Kind.
+func (s *IPReservation) GetKind() OptString {
+	return s.Kind
+}
+
+// GetUser returns the value of User.
+func (s *IPReservation) GetUser() OptNilString {
+	return s.User
+}
+
+// SetCreatedAt sets the value of CreatedAt.
+func (s *IPReservation) SetCreatedAt(val OptDateTime) {
+	s.CreatedAt = val
+}
+
+// SetExpiresAt sets the value of ExpiresAt.
+func (s *IPReservation) SetExpiresAt(val OptDateTime) {
+	s.ExpiresAt = val
+}
+
+// SetHost sets the value of Host.
+func (s *IPReservation) SetHost(val OptNilString) {
+	s.Host = val
+}
+
+// SetIP sets the value of IP.
+func (s *IPReservation) SetIP(val OptString) {
+	s.IP = val
+}
+
+// SetKind sets the value of Kind.
+func (s *IPReservation) SetKind(val OptString) {
+	s.Kind = val
+}
+
+// SetUser sets the value of User.
+func (s *IPReservation) SetUser(val OptNilString) {
+	s.User = val
+}
+
+// ImageDiskUsage schema.
+// Ref: #/components/schemas/ImageDiskUsage
+type ImageDiskUsage struct {
+	Images []NilImageDiskUsageImagesItem `json:"images"`
+	Total  OptInt64                      `json:"total"`
+}
+
+// GetImages returns the value of Images.
+func (s *ImageDiskUsage) GetImages() []NilImageDiskUsageImagesItem {
+	return s.Images
+}
+
+// GetTotal returns the value of Total.
+func (s *ImageDiskUsage) GetTotal() OptInt64 {
+	return s.Total
+}
+
+// SetImages sets the value of Images.
+func (s *ImageDiskUsage) SetImages(val []NilImageDiskUsageImagesItem) {
+	s.Images = val
+}
+
+// SetTotal sets the value of Total.
+func (s *ImageDiskUsage) SetTotal(val OptInt64) {
+	s.Total = val
+}
+
+type ImageDiskUsageImagesItem struct {
+	Files   OptInt    `json:"files"`
+	Missing []string  `json:"missing"`
+	Name    OptString `json:"name"`
+	Shared  OptInt64  `json:"shared"`
+	Size    OptInt64  `json:"size"`
+}
+
+// GetFiles returns the value of Files.
+func (s *ImageDiskUsageImagesItem) GetFiles() OptInt {
+	return s.Files
+}
+
+// GetMissing returns the value of Missing.
+func (s *ImageDiskUsageImagesItem) GetMissing() []string {
+	return s.Missing
+}
+
+// GetName returns the value of Name.
+func (s *ImageDiskUsageImagesItem) GetName() OptString {
+	return s.Name
+}
+
+// GetShared returns the value of Shared.
+func (s *ImageDiskUsageImagesItem) GetShared() OptInt64 {
+	return s.Shared
+}
+
+// GetSize returns the value of Size.
+func (s *ImageDiskUsageImagesItem) GetSize() OptInt64 {
+	return s.Size
+}
+
+// SetFiles sets the value of Files.
+func (s *ImageDiskUsageImagesItem) SetFiles(val OptInt) {
+	s.Files = val
+}
+
+// SetMissing sets the value of Missing.
+func (s *ImageDiskUsageImagesItem) SetMissing(val []string) {
+	s.Missing = val
+}
+
+// SetName sets the value of Name.
+func (s *ImageDiskUsageImagesItem) SetName(val OptString) {
+	s.Name = val
+}
+
+// SetShared sets the value of Shared.
+func (s *ImageDiskUsageImagesItem) SetShared(val OptInt64) {
+	s.Shared = val
+}
+
+// SetSize sets the value of Size.
+func (s *ImageDiskUsageImagesItem) SetSize(val OptInt64) {
+	s.Size = val
+}
+
+// ImageGCResult schema.
+// Ref: #/components/schemas/ImageGCResult
+type ImageGCResult struct {
+	Deleted OptBool                     `json:"deleted"`
+	Dirs    []string                    `json:"dirs"`
+	Files   []NilImageGCResultFilesItem `json:"files"`
+	Size    OptInt64                    `json:"size"`
+}
+
+// GetDeleted returns the value of Deleted.
+func (s *ImageGCResult) GetDeleted() OptBool {
+	return s.Deleted
+}
+
+// GetDirs returns the value of Dirs.
+func (s *ImageGCResult) GetDirs() []string {
+	return s.Dirs
+}
+
+// GetFiles returns the value of Files.
+func (s *ImageGCResult) GetFiles() []NilImageGCResultFilesItem {
+	return s.Files
+}
+
+// GetSize returns the value of Size.
+func (s *ImageGCResult) GetSize() OptInt64 {
+	return s.Size
+}
+
+// SetDeleted sets the value of Deleted.
+func (s *ImageGCResult) SetDeleted(val OptBool) {
+	s.Deleted = val
+}
+
+// SetDirs sets the value of Dirs.
+func (s *ImageGCResult) SetDirs(val []string) {
+	s.Dirs = val
+}
+
+// SetFiles sets the value of Files.
+func (s *ImageGCResult) SetFiles(val []NilImageGCResultFilesItem) {
+	s.Files = val
+}
+
+// SetSize sets the value of Size.
+func (s *ImageGCResult) SetSize(val OptInt64) {
+	s.Size = val
+}
+
+type ImageGCResultFilesItem struct {
+	Path OptString `json:"path"`
+	Size OptInt64  `json:"size"`
+}
+
+// GetPath returns the value of Path.
+func (s *ImageGCResultFilesItem) GetPath() OptString {
+	return s.Path
+}
+
+// GetSize returns the value of Size.
+func (s *ImageGCResultFilesItem) GetSize() OptInt64 {
+	return s.Size
+}
+
+// SetPath sets the value of Path.
+func (s *ImageGCResultFilesItem) SetPath(val OptString) {
+	s.Path = val
+}
+
+// SetSize sets the value of Size.
+func (s *ImageGCResultFilesItem) SetSize(val OptInt64) {
+	s.Size = val
+}
+
+// JobMessage schema.
+// Ref: #/components/schemas/JobMessage
+type JobMessage struct {
+	Data         OptString                 `json:"data"`
+	Host         OptString                 `json:"host"`
+	Msg          OptString                 `json:"msg"`
+	RedfishError OptJobMessageRedfishError `json:"redfish_error"`
+	Status       OptString                 `json:"status"`
+}
+
+// GetData returns the value of Data.
+func (s *JobMessage) GetData() OptString {
+	return s.Data
+}
+
+// GetHost returns the value of Host.
+func (s *JobMessage) GetHost() OptString {
+	return s.Host
+}
+
+// GetMsg returns the value of Msg.
+func (s *JobMessage) GetMsg() OptString {
+	return s.Msg
+}
+
+// GetRedfishError returns the value of RedfishError.
+func (s *JobMessage) GetRedfishError() OptJobMessageRedfishError {
+	return s.RedfishError
+}
+
+// GetStatus returns the value of Status.
+func (s *JobMessage) GetStatus() OptString {
+	return s.Status
+}
+
+// SetData sets the value of Data.
+func (s *JobMessage) SetData(val OptString) {
+	s.Data = val
+}
+
+// SetHost sets the value of Host.
+func (s *JobMessage) SetHost(val OptString) {
+	s.Host = val
+}
+
+// SetMsg sets the value of Msg.
+func (s *JobMessage) SetMsg(val OptString) {
+	s.Msg = val
+}
+
+// SetRedfishError sets the value of RedfishError.
+func (s *JobMessage) SetRedfishError(val OptJobMessageRedfishError) {
+	s.RedfishError = val
+}
+
+// SetStatus sets the value of Status.
+func (s *JobMessage) SetStatus(val OptString) {
+	s.Status = val
+}
+
+type JobMessageRedfishError struct {
+	Code  OptString                      `json:"code"`
+	Error OptJobMessageRedfishErrorError `json:"error"`
+}
+
+// GetCode returns the value of Code.
+func (s *JobMessageRedfishError) GetCode() OptString {
+	return s.Code
+}
+
+// GetError returns the value of Error.
+func (s *JobMessageRedfishError) GetError() OptJobMessageRedfishErrorError {
+	return s.Error
+}
+
+// SetCode sets the value of Code.
+func (s *JobMessageRedfishError) SetCode(val OptString) {
+	s.Code = val
+}
+
+// SetError sets the value of Error.
+func (s *JobMessageRedfishError) SetError(val OptJobMessageRedfishErrorError) {
+	s.Error = val
+}
+
+type JobMessageRedfishErrorError struct {
+	MessageDotExtendedInfo OptNilJobMessageRedfishErrorErrorMessageDotExtendedInfoItemArray `json:"@Message.ExtendedInfo"`
+	Code                   OptString                                                        `json:"code"`
+	Message                OptString                                                        `json:"message"`
+}
+
+// GetMessageDotExtendedInfo returns the value of MessageDotExtendedInfo.
+func (s *JobMessageRedfishErrorError) GetMessageDotExtendedInfo() OptNilJobMessageRedfishErrorErrorMessageDotExtendedInfoItemArray {
+	return s.MessageDotExtendedInfo
+}
+
+// GetCode returns the value of Code.
+func (s *JobMessageRedfishErrorError) GetCode() OptString {
+	return s.Code
+}
+
+// GetMessage returns the value of Message.
+func (s *JobMessageRedfishErrorError) GetMessage() OptString {
+	return s.Message
+}
+
+// SetMessageDotExtendedInfo sets the value of MessageDotExtendedInfo.
+func (s *JobMessageRedfishErrorError) SetMessageDotExtendedInfo(val OptNilJobMessageRedfishErrorErrorMessageDotExtendedInfoItemArray) {
+	s.MessageDotExtendedInfo = val
+}
+
+// SetCode sets the value of Code.
+func (s *JobMessageRedfishErrorError) SetCode(val OptString) {
+	s.Code = val
+}
+
+// SetMessage sets the value of Message.
+func (s *JobMessageRedfishErrorError) SetMessage(val OptString) {
+	s.Message = val
+}
+
+type JobMessageRedfishErrorErrorMessageDotExtendedInfoItem struct {
+	Message                           OptString `json:"Message"`
+	MessageArgsDotOdataDotCount       OptInt    `json:"MessageArgs.@odata.count"`
+	MessageId                         OptString `json:"MessageId"`
+	RelatedPropertiesDotOdataDotCount OptInt    `json:"RelatedProperties.@odata.count"`
+	Resolution                        OptString `json:"Resolution"`
+	Severity                          OptString `json:"Severity"`
+}
+
+// GetMessage returns the value of Message.
+func (s *JobMessageRedfishErrorErrorMessageDotExtendedInfoItem) GetMessage() OptString {
+	return s.Message
+}
+
+// GetMessageArgsDotOdataDotCount returns the value of MessageArgsDotOdataDotCount.
+func (s *JobMessageRedfishErrorErrorMessageDotExtendedInfoItem) GetMessageArgsDotOdataDotCount() OptInt {
+	return s.MessageArgsDotOdataDotCount
+}
+
+// GetMessageId returns the value of MessageId.
+func (s *JobMessageRedfishErrorErrorMessageDotExtendedInfoItem) GetMessageId() OptString {
+	return s.MessageId
+}
+
+// GetRelatedPropertiesDotOdataDotCount returns the value of RelatedPropertiesDotOdataDotCount.
+func (s *JobMessageRedfishErrorErrorMessageDotExtendedInfoItem) GetRelatedPropertiesDotOdataDotCount() OptInt {
+	return s.RelatedPropertiesDotOdataDotCount
+}
+
+// GetResolution returns the value of Resolution.
+func (s *JobMessageRedfishErrorErrorMessageDotExtendedInfoItem) GetResolution() OptString {
+	return s.Resolution
+}
+
+// GetSeverity returns the value of Severity.
+func (s *JobMessageRedfishErrorErrorMessageDotExtendedInfoItem) GetSeverity() OptString {
+	return s.Severity
+}
+
+// SetMessage sets the value of Message.
+func (s *JobMessageRedfishErrorErrorMessageDotExtendedInfoItem) SetMessage(val OptString) {
+	s.Message = val
+}
+
+// SetMessageArgsDotOdataDotCount sets the value of MessageArgsDotOdataDotCount.
+func (s *JobMessageRedfishErrorErrorMessageDotExtendedInfoItem) SetMessageArgsDotOdataDotCount(val OptInt) {
+	s.MessageArgsDotOdataDotCount = val
+}
+
+// SetMessageId sets the value of MessageId.
+func (s *JobMessageRedfishErrorErrorMessageDotExtendedInfoItem) SetMessageId(val OptString) {
+	s.MessageId = val
+}
+
+// SetRelatedPropertiesDotOdataDotCount sets the value of RelatedPropertiesDotOdataDotCount.
+func (s *JobMessageRedfishErrorErrorMessageDotExtendedInfoItem) SetRelatedPropertiesDotOdataDotCount(val OptInt) {
+	s.RelatedPropertiesDotOdataDotCount = val
+}
+
+// SetResolution sets the value of Resolution.
+func (s *JobMessageRedfishErrorErrorMessageDotExtendedInfoItem) SetResolution(val OptString) {
+	s.Resolution = val
+}
+
+// SetSeverity sets the value of Severity.
+func (s *JobMessageRedfishErrorErrorMessageDotExtendedInfoItem) SetSeverity(val OptString) {
+	s.Severity = val
+}
+
+// LLDP schema.
+// Ref: #/components/schemas/LLDP
+type LLDP struct {
+	ChassisID         OptString `json:"chassis_id"`
+	ChassisIDType     OptString `json:"chassis_id_type"`
+	ManagementAddress OptString `json:"management_address"`
+	PortDescription   OptString `json:"port_description"`
+	PortID            OptString `json:"port_id"`
+	PortIDType        OptString `json:"port_id_type"`
+	PortName          OptString `json:"port_name"`
+	SystemDescription OptString `json:"system_description"`
+	SystemName        OptString `json:"system_name"`
+}
+
+// GetChassisID returns the value of ChassisID.
+func (s *LLDP) GetChassisID() OptString {
+	return s.ChassisID
+}
+
+// GetChassisIDType returns the value of ChassisIDType.
+func (s *LLDP) GetChassisIDType() OptString {
+	return s.ChassisIDType
+}
+
+// GetManagementAddress returns the value of ManagementAddress.
+func (s *LLDP) GetManagementAddress() OptString {
+	return s.ManagementAddress
+}
+
+// GetPortDescription returns the value of PortDescription.
+func (s *LLDP) GetPortDescription() OptString {
+	return s.PortDescription
+}
+
+// GetPortID returns the value of PortID.
+func (s *LLDP) GetPortID() OptString {
+	return s.PortID
+}
+
+// GetPortIDType returns the value of PortIDType.
+func (s *LLDP) GetPortIDType() OptString {
+	return s.PortIDType
+}
+
+// GetPortName returns the value of PortName.
+func (s *LLDP) GetPortName() OptString {
+	return s.PortName
+}
+
+// GetSystemDescription returns the value of SystemDescription.
+func (s *LLDP) GetSystemDescription() OptString {
+	return s.SystemDescription
+}
+
+// GetSystemName returns the value of SystemName.
+func (s *LLDP) GetSystemName() OptString {
+	return s.SystemName
+}
+
+// SetChassisID sets the value of ChassisID.
+func (s *LLDP) SetChassisID(val OptString) {
+	s.ChassisID = val
+}
+
+// SetChassisIDType sets the value of ChassisIDType.
+func (s *LLDP) SetChassisIDType(val OptString) {
+	s.ChassisIDType = val
+}
+
+// SetManagementAddress sets the value of ManagementAddress.
+func (s *LLDP) SetManagementAddress(val OptString) {
+	s.ManagementAddress = val
+}
+
+// SetPortDescription sets the value of PortDescription.
+func (s *LLDP) SetPortDescription(val OptString) {
+	s.PortDescription = val
+}
+
+// SetPortID sets the value of PortID.
+func (s *LLDP) SetPortID(val OptString) {
+	s.PortID = val
+}
+
+// SetPortIDType sets the value of PortIDType.
+func (s *LLDP) SetPortIDType(val OptString) {
+	s.PortIDType = val
+}
+
+// SetPortName sets the value of PortName.
+func (s *LLDP) SetPortName(val OptString) {
+	s.PortName = val
+}
+
+// SetSystemDescription sets the value of SystemDescription.
+func (s *LLDP) SetSystemDescription(val OptString) {
+	s.SystemDescription = val
+}
+
+// SetSystemName sets the value of SystemName.
+func (s *LLDP) SetSystemName(val OptString) {
+	s.SystemName = val
+}
+
+// Listener schema.
+// Ref: #/components/schemas/Listener
+type Listener struct {
+	Address OptString `json:"address"`
+	// Clients accepted by the socket: ipv4, ipv6, ipv4+ipv6 for the dual-stack [::] wildcard or unix.
+	Family OptString `json:"family"`
+	// Network of the socket: tcp, udp or unix.
+	Network OptString `json:"network"`
+	Service OptString `json:"service"`
+}
+
+// GetAddress returns the value of Address.
+func (s *Listener) GetAddress() OptString {
+	return s.Address
+}
+
+// GetFamily returns the value of Family.
+func (s *Listener) GetFamily() OptString {
+	return s.Family
+}
+
+// GetNetwork returns the value of Network.
+func (s *Listener) GetNetwork() OptString {
+	return s.Network
+}
+
+// GetService returns the value of Service.
+func (s *Listener) GetService() OptString {
+	return s.Service
+}
+
+// SetAddress sets the value of Address.
+func (s *Listener) SetAddress(val OptString) {
+	s.Address = val
+}
+
+// SetFamily sets the value of Family.
+func (s *Listener) SetFamily(val OptString) {
+	s.Family = val
+}
+
+// SetNetwork sets the value of Network.
+func (s *Listener) SetNetwork(val OptString) {
+	s.Network = val
+}
+
+// SetService sets the value of Service.
+func (s *Listener) SetService(val OptString) {
+	s.Service = val
+}
+
+// Maintenance schema.
+// Ref: #/components/schemas/Maintenance
+type Maintenance struct {
+	// Time maintenance mode was last turned on or off.
+	Changed OptDateTime `json:"changed"`
+	// User who last turned maintenance mode on or off.
+	ChangedBy OptNilString `json:"changed_by"`
+	Enabled   OptBool      `json:"enabled"`
+	Reason    OptNilString `json:"reason"`
+}
+
+// GetChanged returns the value of Changed.
+func (s *Maintenance) GetChanged() OptDateTime {
+	return s.Changed
+}
+
+// GetChangedBy returns the value of ChangedBy.
+func (s *Maintenance) GetChangedBy() OptNilString {
+	return s.ChangedBy
+}
+
+// GetEnabled returns the value of Enabled.
+func (s *Maintenance) GetEnabled() OptBool {
+	return s.Enabled
+}
+
+// GetReason returns the value of Reason.
+func (s *Maintenance) GetReason() OptNilString {
+	return s.Reason
+}
+
+// SetChanged sets the value of Changed.
+func (s *Maintenance) SetChanged(val OptDateTime) {
+	s.Changed = val
+}
+
+// SetChangedBy sets the value of ChangedBy.
+func (s *Maintenance) SetChangedBy(val OptNilString) {
+	s.ChangedBy = val
+}
+
+// SetEnabled sets the value of Enabled.
+func (s *Maintenance) SetEnabled(val OptBool) {
+	s.Enabled = val
+}
+
+// SetReason sets the value of Reason.
+func (s *Maintenance) SetReason(val OptNilString) {
+	s.Reason = val
+}
+
+// MaintenanceRequest schema.
+// Ref: #/components/schemas/MaintenanceRequest
+type MaintenanceRequest struct {
+	Enabled OptBool `json:"enabled"`
+	// Shown by grendel status and logged with every suppressed provisioning request.
+	Reason OptNilString `json:"reason"`
+}
+
+// GetEnabled returns the value of Enabled.
+func (s *MaintenanceRequest) GetEnabled() OptBool {
+	return s.Enabled
+}
+
+// GetReason returns the value of Reason.
+func (s *MaintenanceRequest) GetReason() OptNilString {
+	return s.Reason
+}
+
+// SetEnabled sets the value of Enabled.
+func (s *MaintenanceRequest) SetEnabled(val OptBool) {
+	s.Enabled = val
+}
+
+// SetReason sets the value of Reason.
+func (s *MaintenanceRequest) SetReason(val OptNilString) {
+	s.Reason = val
+}
+
+// NewNilBootImageAddRequestBootImagesItem returns new NilBootImageAddRequestBootImagesItem with value set to v.
+func NewNilBootImageAddRequestBootImagesItem(v BootImageAddRequestBootImagesItem) NilBootImageAddRequestBootImagesItem {
+	return NilBootImageAddRequestBootImagesItem{
+		Value: v,
+	}
+}
+
+// NilBootImageAddRequestBootImagesItem is nullable BootImageAddRequestBootImagesItem.
+type NilBootImageAddRequestBootImagesItem struct {
+	Value BootImageAddRequestBootImagesItem
+	Null  bool
+}
+
+// SetTo sets value to v.
+func (o *NilBootImageAddRequestBootImagesItem) SetTo(v BootImageAddRequestBootImagesItem) {
+	o.Null = false
+	o.Value = v
+}
+
+// IsSet returns true if value is Null.
+func (o NilBootImageAddRequestBootImagesItem) IsNull() bool { return o.Null }
+
+// SetNull sets value to null.
+func (o *NilBootImageAddRequestBootImagesItem) SetToNull() {
+	o.Null = true
+	var v BootImageAddRequestBootImagesItem
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o NilBootImageAddRequestBootImagesItem) Get() (v BootImageAddRequestBootImagesItem, ok bool) {
+	if o.Null {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o NilBootImageAddRequestBootImagesItem) Or(d BootImageAddRequestBootImagesItem) BootImageAddRequestBootImagesItem {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewNilChangeFeedChangesItem returns new NilChangeFeedChangesItem with value set to v.
+func NewNilChangeFeedChangesItem(v ChangeFeedChangesItem) NilChangeFeedChangesItem {
+	return NilChangeFeedChangesItem{
+		Value: v,
+	}
+}
+
+// NilChangeFeedChangesItem is nullable ChangeFeedChangesItem.
+type NilChangeFeedChangesItem struct {
+	Value ChangeFeedChangesItem
+	Null  bool
+}
+
+// SetTo sets value to v.
+func (o *NilChangeFeedChangesItem) SetTo(v ChangeFeedChangesItem) {
+	o.Null = false
+	o.Value = v
+}
+
+// IsSet returns true if value is Null.
+func (o NilChangeFeedChangesItem) IsNull() bool { return o.Null }
+
+// SetNull sets value to null.
+func (o *NilChangeFeedChangesItem) SetToNull() {
+	o.Null = true
+	var v ChangeFeedChangesItem
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o NilChangeFeedChangesItem) Get() (v ChangeFeedChangesItem, ok bool) {
+	if o.Null {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o NilChangeFeedChangesItem) Or(d ChangeFeedChangesItem) ChangeFeedChangesItem {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewNilChangeFeedChangesItemDiffItem returns new NilChangeFeedChangesItemDiffItem with value set to v.
+func NewNilChangeFeedChangesItemDiffItem(v ChangeFeedChangesItemDiffItem) NilChangeFeedChangesItemDiffItem {
+	return NilChangeFeedChangesItemDiffItem{
+		Value: v,
+	}
+}
+
+// NilChangeFeedChangesItemDiffItem is nullable ChangeFeedChangesItemDiffItem.
+type NilChangeFeedChangesItemDiffItem struct {
+	Value ChangeFeedChangesItemDiffItem
+	Null  bool
+}
+
+// SetTo sets value to v.
+func (o *NilChangeFeedChangesItemDiffItem) SetTo(v ChangeFeedChangesItemDiffItem) {
+	o.Null = false
+	o.Value = v
+}
+
+// IsSet returns true if value is Null.
+func (o NilChangeFeedChangesItemDiffItem) IsNull() bool { return o.Null }
+
+// SetNull sets value to null.
+func (o *NilChangeFeedChangesItemDiffItem) SetToNull() {
+	o.Null = true
+	var v ChangeFeedChangesItemDiffItem
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o NilChangeFeedChangesItemDiffItem) Get() (v ChangeFeedChangesItemDiffItem, ok bool) {
+	if o.Null {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o NilChangeFeedChangesItemDiffItem) Or(d ChangeFeedChangesItemDiffItem) ChangeFeedChangesItemDiffItem {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewNilDNSRecordAddRequestRecordsItem returns new NilDNSRecordAddRequestRecordsItem with value set to v.
+func NewNilDNSRecordAddRequestRecordsItem(v DNSRecordAddRequestRecordsItem) NilDNSRecordAddRequestRecordsItem {
+	return NilDNSRecordAddRequestRecordsItem{
+		Value: v,
+	}
+}
+
+// NilDNSRecordAddRequestRecordsItem is nullable DNSRecordAddRequestRecordsItem.
+type NilDNSRecordAddRequestRecordsItem struct {
+	Value DNSRecordAddRequestRecordsItem
+	Null  bool
+}
+
+// SetTo sets value to v.
+func (o *NilDNSRecordAddRequestRecordsItem) SetTo(v DNSRecordAddRequestRecordsItem) {
+	o.Null = false
+	o.Value = v
+}
+
+// IsSet returns true if value is Null.
+func (o NilDNSRecordAddRequestRecordsItem) IsNull() bool { return o.Null }
+
+// SetNull sets value to null.
+func (o *NilDNSRecordAddRequestRecordsItem) SetToNull() {
+	o.Null = true
+	var v DNSRecordAddRequestRecordsItem
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o NilDNSRecordAddRequestRecordsItem) Get() (v DNSRecordAddRequestRecordsItem, ok bool) {
+	if o.Null {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o NilDNSRecordAddRequestRecordsItem) Or(d DNSRecordAddRequestRecordsItem) DNSRecordAddRequestRecordsItem {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewNilDataDumpCredentialsItem returns new NilDataDumpCredentialsItem with value set to v.
+func NewNilDataDumpCredentialsItem(v DataDumpCredentialsItem) NilDataDumpCredentialsItem {
+	return NilDataDumpCredentialsItem{
+		Value: v,
+	}
+}
+
+// NilDataDumpCredentialsItem is nullable DataDumpCredentialsItem.
+type NilDataDumpCredentialsItem struct {
+	Value DataDumpCredentialsItem
+	Null  bool
+}
+
+// SetTo sets value to v.
+func (o *NilDataDumpCredentialsItem) SetTo(v DataDumpCredentialsItem) {
+	o.Null = false
+	o.Value = v
+}
+
+// IsSet returns true if value is Null.
+func (o NilDataDumpCredentialsItem) IsNull() bool { return o.Null }
+
+// SetNull sets value to null.
+func (o *NilDataDumpCredentialsItem) SetToNull() {
+	o.Null = true
+	var v DataDumpCredentialsItem
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o NilDataDumpCredentialsItem) Get() (v DataDumpCredentialsItem, ok bool) {
+	if o.Null {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o NilDataDumpCredentialsItem) Or(d DataDumpCredentialsItem) DataDumpCredentialsItem {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewNilDataDumpDNSRecordsItem returns new NilDataDumpDNSRecordsItem with value set to v.
+func NewNilDataDumpDNSRecordsItem(v DataDumpDNSRecordsItem) NilDataDumpDNSRecordsItem {
+	return NilDataDumpDNSRecordsItem{
+		Value: v,
+	}
+}
+
+// NilDataDumpDNSRecordsItem is nullable DataDumpDNSRecordsItem.
+type NilDataDumpDNSRecordsItem struct {
+	Value DataDumpDNSRecordsItem
+	Null  bool
+}
+
+// SetTo sets value to v.
+func (o *NilDataDumpDNSRecordsItem) SetTo(v DataDumpDNSRecordsItem) {
+	o.Null = false
+	o.Value = v
+}
+
+// IsSet returns true if value is Null.
+func (o NilDataDumpDNSRecordsItem) IsNull() bool { return o.Null }
+
+// SetNull sets value to null.
+func (o *NilDataDumpDNSRecordsItem) SetToNull() {
+	o.Null = true
+	var v DataDumpDNSRecordsItem
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o NilDataDumpDNSRecordsItem) Get() (v DataDumpDNSRecordsItem, ok bool) {
+	if o.Null {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o NilDataDumpDNSRecordsItem) Or(d DataDumpDNSRecordsItem) DataDumpDNSRecordsItem {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewNilDataDumpHostsItem returns new NilDataDumpHostsItem with value set to v.
+func NewNilDataDumpHostsItem(v DataDumpHostsItem) NilDataDumpHostsItem {
+	return NilDataDumpHostsItem{
+		Value: v,
+	}
+}
+
+// NilDataDumpHostsItem is nullable DataDumpHostsItem.
+type NilDataDumpHostsItem struct {
+	Value DataDumpHostsItem
+	Null  bool
+}
+
+// SetTo sets value to v.
+func (o *NilDataDumpHostsItem) SetTo(v DataDumpHostsItem) {
+	o.Null = false
+	o.Value = v
+}
+
+// IsSet returns true if value is Null.
+func (o NilDataDumpHostsItem) IsNull() bool { return o.Null }
+
+// SetNull sets value to null.
+func (o *NilDataDumpHostsItem) SetToNull() {
+	o.Null = true
+	var v DataDumpHostsItem
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o NilDataDumpHostsItem) Get() (v DataDumpHostsItem, ok bool) {
+	if o.Null {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o NilDataDumpHostsItem) Or(d DataDumpHostsItem) DataDumpHostsItem {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewNilDataDumpHostsItemBondsItem returns new NilDataDumpHostsItemBondsItem with value set to v.
+func NewNilDataDumpHostsItemBondsItem(v DataDumpHostsItemBondsItem) NilDataDumpHostsItemBondsItem {
+	return NilDataDumpHostsItemBondsItem{
+		Value: v,
+	}
+}
+
+// NilDataDumpHostsItemBondsItem is nullable DataDumpHostsItemBondsItem.
+type NilDataDumpHostsItemBondsItem struct {
+	Value DataDumpHostsItemBondsItem
+	Null  bool
+}
+
+// SetTo sets value to v.
+func (o *NilDataDumpHostsItemBondsItem) SetTo(v DataDumpHostsItemBondsItem) {
+	o.Null = false
+	o.Value = v
+}
+
+// IsSet returns true if value is Null.
+func (o NilDataDumpHostsItemBondsItem) IsNull() bool { return o.Null }
+
+// SetNull sets value to null.
+func (o *NilDataDumpHostsItemBondsItem) SetToNull() {
+	o.Null = true
+	var v DataDumpHostsItemBondsItem
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o NilDataDumpHostsItemBondsItem) Get() (v DataDumpHostsItemBondsItem, ok bool) {
+	if o.Null {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o NilDataDumpHostsItemBondsItem) Or(d DataDumpHostsItemBondsItem) DataDumpHostsItemBondsItem {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewNilDataDumpHostsItemHardwareDimmsItem returns new NilDataDumpHostsItemHardwareDimmsItem with value set to v.
+func NewNilDataDumpHostsItemHardwareDimmsItem(v DataDumpHostsItemHardwareDimmsItem) NilDataDumpHostsItemHardwareDimmsItem {
+	return NilDataDumpHostsItemHardwareDimmsItem{
+		Value: v,
+	}
+}
+
+// NilDataDumpHostsItemHardwareDimmsItem is nullable DataDumpHostsItemHardwareDimmsItem.
+type NilDataDumpHostsItemHardwareDimmsItem struct {
+	Value DataDumpHostsItemHardwareDimmsItem
+	Null  bool
+}
+
+// SetTo sets value to v.
+func (o *NilDataDumpHostsItemHardwareDimmsItem) SetTo(v DataDumpHostsItemHardwareDimmsItem) {
+	o.Null = false
+	o.Value = v
+}
+
+// IsSet returns true if value is Null.
+func (o NilDataDumpHostsItemHardwareDimmsItem) IsNull() bool { return o.Null }
+
+// SetNull sets value to null.
+func (o *NilDataDumpHostsItemHardwareDimmsItem) SetToNull() {
+	o.Null = true
+	var v DataDumpHostsItemHardwareDimmsItem
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o NilDataDumpHostsItemHardwareDimmsItem) Get() (v DataDumpHostsItemHardwareDimmsItem, ok bool) {
+	if o.Null {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o NilDataDumpHostsItemHardwareDimmsItem) Or(d DataDumpHostsItemHardwareDimmsItem) DataDumpHostsItemHardwareDimmsItem {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewNilDataDumpHostsItemHardwareDisksItem returns new NilDataDumpHostsItemHardwareDisksItem with value set to v.
+func NewNilDataDumpHostsItemHardwareDisksItem(v DataDumpHostsItemHardwareDisksItem) NilDataDumpHostsItemHardwareDisksItem {
+	return NilDataDumpHostsItemHardwareDisksItem{
+		Value: v,
+	}
+}
+
+// NilDataDumpHostsItemHardwareDisksItem is nullable DataDumpHostsItemHardwareDisksItem.
+type NilDataDumpHostsItemHardwareDisksItem struct {
+	Value DataDumpHostsItemHardwareDisksItem
+	Null  bool
+}
+
+// SetTo sets value to v.
+func (o *NilDataDumpHostsItemHardwareDisksItem) SetTo(v DataDumpHostsItemHardwareDisksItem) {
+	o.Null = false
+	o.Value = v
+}
+
+// IsSet returns true if value is Null.
+func (o NilDataDumpHostsItemHardwareDisksItem) IsNull() bool { return o.Null }
+
+// SetNull sets value to null.
+func (o *NilDataDumpHostsItemHardwareDisksItem) SetToNull() {
+	o.Null = true
+	var v DataDumpHostsItemHardwareDisksItem
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o NilDataDumpHostsItemHardwareDisksItem) Get() (v DataDumpHostsItemHardwareDisksItem, ok bool) {
+	if o.Null {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o NilDataDumpHostsItemHardwareDisksItem) Or(d DataDumpHostsItemHardwareDisksItem) DataDumpHostsItemHardwareDisksItem {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewNilDataDumpHostsItemHardwareNicsItem returns new NilDataDumpHostsItemHardwareNicsItem with value set to v.
+func NewNilDataDumpHostsItemHardwareNicsItem(v DataDumpHostsItemHardwareNicsItem) NilDataDumpHostsItemHardwareNicsItem {
+	return NilDataDumpHostsItemHardwareNicsItem{
+		Value: v,
+	}
+}
+
+// NilDataDumpHostsItemHardwareNicsItem is nullable DataDumpHostsItemHardwareNicsItem.
+type NilDataDumpHostsItemHardwareNicsItem struct {
+	Value DataDumpHostsItemHardwareNicsItem
+	Null  bool
+}
+
+// SetTo sets value to v.
+func (o *NilDataDumpHostsItemHardwareNicsItem) SetTo(v DataDumpHostsItemHardwareNicsItem) {
+	o.Null = false
+	o.Value = v
+}
+
+// IsSet returns true if value is Null.
+func (o NilDataDumpHostsItemHardwareNicsItem) IsNull() bool { return o.Null }
+
+// SetNull sets value to null.
+func (o *NilDataDumpHostsItemHardwareNicsItem) SetToNull() {
+	o.Null = true
+	var v DataDumpHostsItemHardwareNicsItem
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o NilDataDumpHostsItemHardwareNicsItem) Get() (v DataDumpHostsItemHardwareNicsItem, ok bool) {
+	if o.Null {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o NilDataDumpHostsItemHardwareNicsItem) Or(d DataDumpHostsItemHardwareNicsItem) DataDumpHostsItemHardwareNicsItem {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewNilDataDumpHostsItemInterfacesItem returns new NilDataDumpHostsItemInterfacesItem with value set to v.
+func NewNilDataDumpHostsItemInterfacesItem(v DataDumpHostsItemInterfacesItem) NilDataDumpHostsItemInterfacesItem {
+	return NilDataDumpHostsItemInterfacesItem{
+		Value: v,
+	}
+}
+
+// NilDataDumpHostsItemInterfacesItem is nullable DataDumpHostsItemInterfacesItem.
+type NilDataDumpHostsItemInterfacesItem struct {
+	Value DataDumpHostsItemInterfacesItem
+	Null  bool
+}
+
+// SetTo sets value to v.
+func (o *NilDataDumpHostsItemInterfacesItem) SetTo(v DataDumpHostsItemInterfacesItem) {
+	o.Null = false
+	o.Value = v
+}
+
+// IsSet returns true if value is Null.
+func (o NilDataDumpHostsItemInterfacesItem) IsNull() bool { return o.Null }
+
+// SetNull sets value to null.
+func (o *NilDataDumpHostsItemInterfacesItem) SetToNull() {
+	o.Null = true
+	var v DataDumpHostsItemInterfacesItem
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o NilDataDumpHostsItemInterfacesItem) Get() (v DataDumpHostsItemInterfacesItem, ok bool) {
+	if o.Null {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o NilDataDumpHostsItemInterfacesItem) Or(d DataDumpHostsItemInterfacesItem) DataDumpHostsItemInterfacesItem {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewNilDataDumpImagesItem returns new NilDataDumpImagesItem with value set to v.
+func NewNilDataDumpImagesItem(v DataDumpImagesItem) NilDataDumpImagesItem {
+	return NilDataDumpImagesItem{
+		Value: v,
+	}
+}
+
+// NilDataDumpImagesItem is nullable DataDumpImagesItem.
+type NilDataDumpImagesItem struct {
+	Value DataDumpImagesItem
+	Null  bool
+}
+
+// SetTo sets value to v.
+func (o *NilDataDumpImagesItem) SetTo(v DataDumpImagesItem) {
+	o.Null = false
+	o.Value = v
+}
+
+// IsSet returns true if value is Null.
+func (o NilDataDumpImagesItem) IsNull() bool { return o.Null }
+
+// SetNull sets value to null.
+func (o *NilDataDumpImagesItem) SetToNull() {
+	o.Null = true
+	var v DataDumpImagesItem
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o NilDataDumpImagesItem) Get() (v DataDumpImagesItem, ok bool) {
+	if o.Null {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o NilDataDumpImagesItem) Or(d DataDumpImagesItem) DataDumpImagesItem {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewNilDataLoadRequestDumpCredentialsItem returns new NilDataLoadRequestDumpCredentialsItem with value set to v.
+func NewNilDataLoadRequestDumpCredentialsItem(v DataLoadRequestDumpCredentialsItem) NilDataLoadRequestDumpCredentialsItem {
+	return NilDataLoadRequestDumpCredentialsItem{
+		Value: v,
+	}
+}
+
+// NilDataLoadRequestDumpCredentialsItem is nullable DataLoadRequestDumpCredentialsItem.
+type NilDataLoadRequestDumpCredentialsItem struct {
+	Value DataLoadRequestDumpCredentialsItem
+	Null  bool
+}
+
+// SetTo sets value to v.
+func (o *NilDataLoadRequestDumpCredentialsItem) SetTo(v DataLoadRequestDumpCredentialsItem) {
+	o.Null = false
+	o.Value = v
+}
+
+// IsSet returns true if value is Null.
+func (o NilDataLoadRequestDumpCredentialsItem) IsNull() bool { return o.Null }
+
+// SetNull sets value to null.
+func (o *NilDataLoadRequestDumpCredentialsItem) SetToNull() {
+	o.Null = true
+	var v DataLoadRequestDumpCredentialsItem
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o NilDataLoadRequestDumpCredentialsItem) Get() (v DataLoadRequestDumpCredentialsItem, ok bool) {
+	if o.Null {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o NilDataLoadRequestDumpCredentialsItem) Or(d DataLoadRequestDumpCredentialsItem) DataLoadRequestDumpCredentialsItem {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewNilDataLoadRequestDumpDNSRecordsItem returns new NilDataLoadRequestDumpDNSRecordsItem with value set to v.
+func NewNilDataLoadRequestDumpDNSRecordsItem(v DataLoadRequestDumpDNSRecordsItem) NilDataLoadRequestDumpDNSRecordsItem {
+	return NilDataLoadRequestDumpDNSRecordsItem{
+		Value: v,
+	}
+}
+
+// NilDataLoadRequestDumpDNSRecordsItem is nullable DataLoadRequestDumpDNSRecordsItem.
+type NilDataLoadRequestDumpDNSRecordsItem struct {
+	Value DataLoadRequestDumpDNSRecordsItem
+	Null  bool
+}
+
+// SetTo sets value to v.
+func (o *NilDataLoadRequestDumpDNSRecordsItem) SetTo(v DataLoadRequestDumpDNSRecordsItem) {
+	o.Null = false
+	o.Value = v
+}
+
+// IsSet returns true if value is Null.
+func (o NilDataLoadRequestDumpDNSRecordsItem) IsNull() bool { return o.Null }
+
+// SetNull sets value to null.
+func (o *NilDataLoadRequestDumpDNSRecordsItem) SetToNull() {
+	o.Null = true
+	var v DataLoadRequestDumpDNSRecordsItem
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o NilDataLoadRequestDumpDNSRecordsItem) Get() (v DataLoadRequestDumpDNSRecordsItem, ok bool) {
+	if o.Null {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o NilDataLoadRequestDumpDNSRecordsItem) Or(d DataLoadRequestDumpDNSRecordsItem) DataLoadRequestDumpDNSRecordsItem {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewNilDataLoadRequestDumpHostsItem returns new NilDataLoadRequestDumpHostsItem with value set to v.
+func NewNilDataLoadRequestDumpHostsItem(v DataLoadRequestDumpHostsItem) NilDataLoadRequestDumpHostsItem {
+	return NilDataLoadRequestDumpHostsItem{
+		Value: v,
+	}
+}
+
+// NilDataLoadRequestDumpHostsItem is nullable DataLoadRequestDumpHostsItem.
+type NilDataLoadRequestDumpHostsItem struct {
+	Value DataLoadRequestDumpHostsItem
+	Null  bool
+}
+
+// SetTo sets value to v.
+func (o *NilDataLoadRequestDumpHostsItem) SetTo(v DataLoadRequestDumpHostsItem) {
+	o.Null = false
+	o.Value = v
+}
+
+// IsSet returns true if value is Null.
+func (o NilDataLoadRequestDumpHostsItem) IsNull() bool { return o.Null }
+
+// SetNull sets value to null.
+func (o *NilDataLoadRequestDumpHostsItem) SetToNull() {
+	o.Null = true
+	var v DataLoadRequestDumpHostsItem
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o NilDataLoadRequestDumpHostsItem) Get() (v DataLoadRequestDumpHostsItem, ok bool) {
+	if o.Null {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o NilDataLoadRequestDumpHostsItem) Or(d DataLoadRequestDumpHostsItem) DataLoadRequestDumpHostsItem {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewNilDataLoadRequestDumpHostsItemBondsItem returns new NilDataLoadRequestDumpHostsItemBondsItem with value set to v.
+func NewNilDataLoadRequestDumpHostsItemBondsItem(v DataLoadRequestDumpHostsItemBondsItem) NilDataLoadRequestDumpHostsItemBondsItem {
+	return NilDataLoadRequestDumpHostsItemBondsItem{
+		Value: v,
+	}
+}
+
+// NilDataLoadRequestDumpHostsItemBondsItem is nullable DataLoadRequestDumpHostsItemBondsItem.
+type NilDataLoadRequestDumpHostsItemBondsItem struct {
+	Value DataLoadRequestDumpHostsItemBondsItem
+	Null  bool
+}
+
+// SetTo sets value to v.
+func (o *NilDataLoadRequestDumpHostsItemBondsItem) SetTo(v DataLoadRequestDumpHostsItemBondsItem) {
+	o.Null = false
+	o.Value = v
+}
+
+// IsSet returns true if value is Null.
+func (o NilDataLoadRequestDumpHostsItemBondsItem) IsNull() bool { return o.Null }
+
+// SetNull sets value to null.
+func (o *NilDataLoadRequestDumpHostsItemBondsItem) SetToNull() {
+	o.Null = true
+	var v DataLoadRequestDumpHostsItemBondsItem
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o NilDataLoadRequestDumpHostsItemBondsItem) Get() (v DataLoadRequestDumpHostsItemBondsItem, ok bool) {
+	if o.Null {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o NilDataLoadRequestDumpHostsItemBondsItem) Or(d DataLoadRequestDumpHostsItemBondsItem) DataLoadRequestDumpHostsItemBondsItem {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewNilDataLoadRequestDumpHostsItemHardwareDimmsItem returns new NilDataLoadRequestDumpHostsItemHardwareDimmsItem with value set to v.
+func NewNilDataLoadRequestDumpHostsItemHardwareDimmsItem(v DataLoadRequestDumpHostsItemHardwareDimmsItem) NilDataLoadRequestDumpHostsItemHardwareDimmsItem {
+	return NilDataLoadRequestDumpHostsItemHardwareDimmsItem{
+		Value: v,
+	}
+}
+
+// NilDataLoadRequestDumpHostsItemHardwareDimmsItem is nullable DataLoadRequestDumpHostsItemHardwareDimmsItem.
+type NilDataLoadRequestDumpHostsItemHardwareDimmsItem struct {
+	Value DataLoadRequestDumpHostsItemHardwareDimmsItem
+	Null  bool
+}
+
+// SetTo sets value to v.
+func (o *NilDataLoadRequestDumpHostsItemHardwareDimmsItem) SetTo(v DataLoadRequestDumpHostsItemHardwareDimmsItem) {
+	o.Null = false
+	o.Value = v
+}
+
+// IsSet returns true if value is Null.
+func (o NilDataLoadRequestDumpHostsItemHardwareDimmsItem) IsNull() bool { return o.Null }
+
+// SetNull sets value to null.
+func (o *NilDataLoadRequestDumpHostsItemHardwareDimmsItem) SetToNull() {
+	o.Null = true
+	var v DataLoadRequestDumpHostsItemHardwareDimmsItem
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o NilDataLoadRequestDumpHostsItemHardwareDimmsItem) Get() (v DataLoadRequestDumpHostsItemHardwareDimmsItem, ok bool) {
+	if o.Null {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o NilDataLoadRequestDumpHostsItemHardwareDimmsItem) Or(d DataLoadRequestDumpHostsItemHardwareDimmsItem) DataLoadRequestDumpHostsItemHardwareDimmsItem {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewNilDataLoadRequestDumpHostsItemHardwareDisksItem returns new NilDataLoadRequestDumpHostsItemHardwareDisksItem with value set to v.
+func NewNilDataLoadRequestDumpHostsItemHardwareDisksItem(v DataLoadRequestDumpHostsItemHardwareDisksItem) NilDataLoadRequestDumpHostsItemHardwareDisksItem {
+	return NilDataLoadRequestDumpHostsItemHardwareDisksItem{
+		Value: v,
+	}
+}
+
+// NilDataLoadRequestDumpHostsItemHardwareDisksItem is nullable DataLoadRequestDumpHostsItemHardwareDisksItem.
+type NilDataLoadRequestDumpHostsItemHardwareDisksItem struct {
+	Value DataLoadRequestDumpHostsItemHardwareDisksItem
+	Null  bool
+}
+
+// SetTo sets value to v.
+func (o *NilDataLoadRequestDumpHostsItemHardwareDisksItem) SetTo(v DataLoadRequestDumpHostsItemHardwareDisksItem) {
+	o.Null = false
+	o.Value = v
+}
+
+// IsSet returns true if value is Null.
+func (o NilDataLoadRequestDumpHostsItemHardwareDisksItem) IsNull() bool { return o.Null }
+
+// SetNull sets value to null.
+func (o *NilDataLoadRequestDumpHostsItemHardwareDisksItem) SetToNull() {
+	o.Null = true
+	var v DataLoadRequestDumpHostsItemHardwareDisksItem
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o NilDataLoadRequestDumpHostsItemHardwareDisksItem) Get() (v DataLoadRequestDumpHostsItemHardwareDisksItem, ok bool) {
+	if o.Null {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o NilDataLoadRequestDumpHostsItemHardwareDisksItem) Or(d DataLoadRequestDumpHostsItemHardwareDisksItem) DataLoadRequestDumpHostsItemHardwareDisksItem {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewNilDataLoadRequestDumpHostsItemHardwareNicsItem returns new NilDataLoadRequestDumpHostsItemHardwareNicsItem with value set to v.
+func NewNilDataLoadRequestDumpHostsItemHardwareNicsItem(v DataLoadRequestDumpHostsItemHardwareNicsItem) NilDataLoadRequestDumpHostsItemHardwareNicsItem {
+	return NilDataLoadRequestDumpHostsItemHardwareNicsItem{
+		Value: v,
+	}
+}
+
+// NilDataLoadRequestDumpHostsItemHardwareNicsItem is nullable DataLoadRequestDumpHostsItemHardwareNicsItem.
+type NilDataLoadRequestDumpHostsItemHardwareNicsItem struct {
+	Value DataLoadRequestDumpHostsItemHardwareNicsItem
+	Null  bool
+}
+
+// SetTo sets value to v.
+func (o *NilDataLoadRequestDumpHostsItemHardwareNicsItem) SetTo(v DataLoadRequestDumpHostsItemHardwareNicsItem) {
+	o.Null = false
+	o.Value = v
+}
+
+// IsSet returns true if value is Null.
+func (o NilDataLoadRequestDumpHostsItemHardwareNicsItem) IsNull() bool { return o.Null }
+
+// SetNull sets value to null.
+func (o *NilDataLoadRequestDumpHostsItemHardwareNicsItem) SetToNull() {
+	o.Null = true
+	var v DataLoadRequestDumpHostsItemHardwareNicsItem
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o NilDataLoadRequestDumpHostsItemHardwareNicsItem) Get() (v DataLoadRequestDumpHostsItemHardwareNicsItem, ok bool) {
+	if o.Null {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o NilDataLoadRequestDumpHostsItemHardwareNicsItem) Or(d DataLoadRequestDumpHostsItemHardwareNicsItem) DataLoadRequestDumpHostsItemHardwareNicsItem {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewNilDataLoadRequestDumpHostsItemInterfacesItem returns new NilDataLoadRequestDumpHostsItemInterfacesItem with value set to v.
+func NewNilDataLoadRequestDumpHostsItemInterfacesItem(v DataLoadRequestDumpHostsItemInterfacesItem) NilDataLoadRequestDumpHostsItemInterfacesItem {
+	return NilDataLoadRequestDumpHostsItemInterfacesItem{
+		Value: v,
+	}
+}
+
+// NilDataLoadRequestDumpHostsItemInterfacesItem is nullable DataLoadRequestDumpHostsItemInterfacesItem.
+type NilDataLoadRequestDumpHostsItemInterfacesItem struct {
+	Value DataLoadRequestDumpHostsItemInterfacesItem
+	Null  bool
+}
+
+// SetTo sets value to v.
+func (o *NilDataLoadRequestDumpHostsItemInterfacesItem) SetTo(v DataLoadRequestDumpHostsItemInterfacesItem) {
+	o.Null = false
+	o.Value = v
+}
+
+// IsSet returns true if value is Null.
+func (o NilDataLoadRequestDumpHostsItemInterfacesItem) IsNull() bool { return o.Null }
+
+// SetNull sets value to null.
+func (o *NilDataLoadRequestDumpHostsItemInterfacesItem) SetToNull() {
+	o.Null = true
+	var v DataLoadRequestDumpHostsItemInterfacesItem
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o NilDataLoadRequestDumpHostsItemInterfacesItem) Get() (v DataLoadRequestDumpHostsItemInterfacesItem, ok bool) {
+	if o.Null {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o NilDataLoadRequestDumpHostsItemInterfacesItem) Or(d DataLoadRequestDumpHostsItemInterfacesItem) DataLoadRequestDumpHostsItemInterfacesItem {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewNilDataLoadRequestDumpImagesItem returns new NilDataLoadRequestDumpImagesItem with value set to v.
+func NewNilDataLoadRequestDumpImagesItem(v DataLoadRequestDumpImagesItem) NilDataLoadRequestDumpImagesItem {
+	return NilDataLoadRequestDumpImagesItem{
+		Value: v,
+	}
+}
+
+// NilDataLoadRequestDumpImagesItem is nullable DataLoadRequestDumpImagesItem.
+type NilDataLoadRequestDumpImagesItem struct {
+	Value DataLoadRequestDumpImagesItem
+	Null  bool
+}
+
+// SetTo sets value to v.
+func (o *NilDataLoadRequestDumpImagesItem) SetTo(v DataLoadRequestDumpImagesItem) {
+	o.Null = false
+	o.Value = v
+}
+
+// IsSet returns true if value is Null.
+func (o NilDataLoadRequestDumpImagesItem) IsNull() bool { return o.Null }
+
+// SetNull sets value to null.
+func (o *NilDataLoadRequestDumpImagesItem) SetToNull() {
+	o.Null = true
+	var v DataLoadRequestDumpImagesItem
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o NilDataLoadRequestDumpImagesItem) Get() (v DataLoadRequestDumpImagesItem, ok bool) {
+	if o.Null {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o NilDataLoadRequestDumpImagesItem) Or(d DataLoadRequestDumpImagesItem) DataLoadRequestDumpImagesItem {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewNilHardwareReportHardwareDimmsItem returns new NilHardwareReportHardwareDimmsItem with value set to v.
+func NewNilHardwareReportHardwareDimmsItem(v HardwareReportHardwareDimmsItem) NilHardwareReportHardwareDimmsItem {
+	return NilHardwareReportHardwareDimmsItem{
+		Value: v,
+	}
+}
+
+// NilHardwareReportHardwareDimmsItem is nullable HardwareReportHardwareDimmsItem.
+type NilHardwareReportHardwareDimmsItem struct {
+	Value HardwareReportHardwareDimmsItem
+	Null  bool
+}
+
+// SetTo sets value to v.
+func (o *NilHardwareReportHardwareDimmsItem) SetTo(v HardwareReportHardwareDimmsItem) {
+	o.Null = false
+	o.Value = v
+}
+
+// IsSet returns true if value is Null.
+func (o NilHardwareReportHardwareDimmsItem) IsNull() bool { return o.Null }
+
+// SetNull sets value to null.
+func (o *NilHardwareReportHardwareDimmsItem) SetToNull() {
+	o.Null = true
+	var v HardwareReportHardwareDimmsItem
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o NilHardwareReportHardwareDimmsItem) Get() (v HardwareReportHardwareDimmsItem, ok bool) {
+	if o.Null {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o NilHardwareReportHardwareDimmsItem) Or(d HardwareReportHardwareDimmsItem) HardwareReportHardwareDimmsItem {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewNilHardwareReportHardwareDisksItem returns new NilHardwareReportHardwareDisksItem with value set to v.
+func NewNilHardwareReportHardwareDisksItem(v HardwareReportHardwareDisksItem) NilHardwareReportHardwareDisksItem {
+	return NilHardwareReportHardwareDisksItem{
+		Value: v,
+	}
+}
+
+// NilHardwareReportHardwareDisksItem is nullable HardwareReportHardwareDisksItem.
+type NilHardwareReportHardwareDisksItem struct {
+	Value HardwareReportHardwareDisksItem
+	Null  bool
+}
+
+// SetTo sets value to v.
+func (o *NilHardwareReportHardwareDisksItem) SetTo(v HardwareReportHardwareDisksItem) {
+	o.Null = false
+	o.Value = v
+}
+
+// IsSet returns true if value is Null.
+func (o NilHardwareReportHardwareDisksItem) IsNull() bool { return o.Null }
+
+// SetNull sets value to null.
+func (o *NilHardwareReportHardwareDisksItem) SetToNull() {
+	o.Null = true
+	var v HardwareReportHardwareDisksItem
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o NilHardwareReportHardwareDisksItem) Get() (v HardwareReportHardwareDisksItem, ok bool) {
+	if o.Null {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o NilHardwareReportHardwareDisksItem) Or(d HardwareReportHardwareDisksItem) HardwareReportHardwareDisksItem {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewNilHardwareReportHardwareNicsItem returns new NilHardwareReportHardwareNicsItem with value set to v.
+func NewNilHardwareReportHardwareNicsItem(v HardwareReportHardwareNicsItem) NilHardwareReportHardwareNicsItem {
+	return NilHardwareReportHardwareNicsItem{
+		Value: v,
+	}
+}
+
+// NilHardwareReportHardwareNicsItem is nullable HardwareReportHardwareNicsItem.
+type NilHardwareReportHardwareNicsItem struct {
+	Value HardwareReportHardwareNicsItem
+	Null  bool
+}
+
+// SetTo sets value to v.
+func (o *NilHardwareReportHardwareNicsItem) SetTo(v HardwareReportHardwareNicsItem) {
+	o.Null = false
+	o.Value = v
+}
+
+// IsSet returns true if value is Null.
+func (o NilHardwareReportHardwareNicsItem) IsNull() bool { return o.Null }
+
+// SetNull sets value to null.
+func (o *NilHardwareReportHardwareNicsItem) SetToNull() {
+	o.Null = true
+	var v HardwareReportHardwareNicsItem
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o NilHardwareReportHardwareNicsItem) Get() (v HardwareReportHardwareNicsItem, ok bool) {
+	if o.Null {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o NilHardwareReportHardwareNicsItem) Or(d HardwareReportHardwareNicsItem) HardwareReportHardwareNicsItem {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewNilHostBondsItem returns new NilHostBondsItem with value set to v.
+func NewNilHostBondsItem(v HostBondsItem) NilHostBondsItem {
+	return NilHostBondsItem{
+		Value: v,
+	}
+}
+
+// NilHostBondsItem is nullable HostBondsItem.
+type NilHostBondsItem struct {
+	Value HostBondsItem
+	Null  bool
+}
+
+// SetTo sets value to v.
+func (o *NilHostBondsItem) SetTo(v HostBondsItem) {
+	o.Null = false
+	o.Value = v
+}
+
+// IsSet returns true if value is Null.
+func (o NilHostBondsItem) IsNull() bool { return o.Null }
+
+// SetNull sets value to null.
+func (o *NilHostBondsItem) SetToNull() {
+	o.Null = true
+	var v HostBondsItem
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o NilHostBondsItem) Get() (v HostBondsItem, ok bool) {
+	if o.Null {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o NilHostBondsItem) Or(d HostBondsItem) HostBondsItem {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewNilHostHardwareDimmsItem returns new NilHostHardwareDimmsItem with value set to v.
+func NewNilHostHardwareDimmsItem(v HostHardwareDimmsItem) NilHostHardwareDimmsItem {
+	return NilHostHardwareDimmsItem{
+		Value: v,
+	}
+}
+
+// NilHostHardwareDimmsItem is nullable HostHardwareDimmsItem.
+type NilHostHardwareDimmsItem struct {
+	Value HostHardwareDimmsItem
+	Null  bool
+}
+
+// SetTo sets value to v.
+func (o *NilHostHardwareDimmsItem) SetTo(v HostHardwareDimmsItem) {
+	o.Null = false
+	o.Value = v
+}
+
+// IsSet returns true if value is Null.
+func (o NilHostHardwareDimmsItem) IsNull() bool { return o.Null }
+
+// SetNull sets value to null.
+func (o *NilHostHardwareDimmsItem) SetToNull() {
+	o.Null = true
+	var v HostHardwareDimmsItem
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o NilHostHardwareDimmsItem) Get() (v HostHardwareDimmsItem, ok bool) {
+	if o.Null {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o NilHostHardwareDimmsItem) Or(d HostHardwareDimmsItem) HostHardwareDimmsItem {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewNilHostHardwareDisksItem returns new NilHostHardwareDisksItem with value set to v.
+func NewNilHostHardwareDisksItem(v HostHardwareDisksItem) NilHostHardwareDisksItem {
+	return NilHostHardwareDisksItem{
+		Value: v,
+	}
+}
+
+// NilHostHardwareDisksItem is nullable HostHardwareDisksItem.
+type NilHostHardwareDisksItem struct {
+	Value HostHardwareDisksItem
+	Null  bool
+}
+
+// SetTo sets value to v.
+func (o *NilHostHardwareDisksItem) SetTo(v HostHardwareDisksItem) {
+	o.Null = false
+	o.Value = v
+}
+
+// IsSet returns true if value is Null.
+func (o NilHostHardwareDisksItem) IsNull() bool { return o.Null }
+
+// SetNull sets value to null.
+func (o *NilHostHardwareDisksItem) SetToNull() {
+	o.Null = true
+	var v HostHardwareDisksItem
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o NilHostHardwareDisksItem) Get() (v HostHardwareDisksItem, ok bool) {
+	if o.Null {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o NilHostHardwareDisksItem) Or(d HostHardwareDisksItem) HostHardwareDisksItem {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewNilHostHardwareNicsItem returns new NilHostHardwareNicsItem with value set to v.
+func NewNilHostHardwareNicsItem(v HostHardwareNicsItem) NilHostHardwareNicsItem {
+	return NilHostHardwareNicsItem{
+		Value: v,
+	}
+}
+
+// NilHostHardwareNicsItem is nullable HostHardwareNicsItem.
+type NilHostHardwareNicsItem struct {
+	Value HostHardwareNicsItem
+	Null  bool
+}
+
+// SetTo sets value to v.
+func (o *NilHostHardwareNicsItem) SetTo(v HostHardwareNicsItem) {
+	o.Null = false
+	o.Value = v
+}
+
+// IsSet returns true if value is Null.
+func (o NilHostHardwareNicsItem) IsNull() bool { return o.Null }
+
+// SetNull sets value to null.
+func (o *NilHostHardwareNicsItem) SetToNull() {
+	o.Null = true
+	var v HostHardwareNicsItem
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o NilHostHardwareNicsItem) Get() (v HostHardwareNicsItem, ok bool) {
+	if o.Null {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o NilHostHardwareNicsItem) Or(d HostHardwareNicsItem) HostHardwareNicsItem {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewNilHostInterfacesItem returns new NilHostInterfacesItem with value set to v.
+func NewNilHostInterfacesItem(v HostInterfacesItem) NilHostInterfacesItem {
+	return NilHostInterfacesItem{
+		Value: v,
+	}
+}
+
+// NilHostInterfacesItem is nullable HostInterfacesItem.
+type NilHostInterfacesItem struct {
+	Value HostInterfacesItem
+	Null  bool
+}
+
+// SetTo sets value to v.
+func (o *NilHostInterfacesItem) SetTo(v HostInterfacesItem) {
+	o.Null = false
+	o.Value = v
+}
+
+// IsSet returns true if value is Null.
+func (o NilHostInterfacesItem) IsNull() bool { return o.Null }
+
+// SetNull sets value to null.
+func (o *NilHostInterfacesItem) SetToNull() {
+	o.Null = true
+	var v HostInterfacesItem
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o NilHostInterfacesItem) Get() (v HostInterfacesItem, ok bool) {
+	if o.Null {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o NilHostInterfacesItem) Or(d HostInterfacesItem) HostInterfacesItem {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewNilImageDiskUsageImagesItem returns new NilImageDiskUsageImagesItem with value set to v.
+func NewNilImageDiskUsageImagesItem(v ImageDiskUsageImagesItem) NilImageDiskUsageImagesItem {
+	return NilImageDiskUsageImagesItem{
+		Value: v,
+	}
+}
+
+// NilImageDiskUsageImagesItem is nullable ImageDiskUsageImagesItem.
+type NilImageDiskUsageImagesItem struct {
+	Value ImageDiskUsageImagesItem
+	Null  bool
+}
+
+// SetTo sets value to v.
+func (o *NilImageDiskUsageImagesItem) SetTo(v ImageDiskUsageImagesItem) {
+	o.Null = false
+	o.Value = v
+}
+
+// IsSet returns true if value is Null.
+func (o NilImageDiskUsageImagesItem) IsNull() bool { return o.Null }
+
+// SetNull sets value to null.
+func (o *NilImageDiskUsageImagesItem) SetToNull() {
+	o.Null = true
+	var v ImageDiskUsageImagesItem
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o NilImageDiskUsageImagesItem) Get() (v ImageDiskUsageImagesItem, ok bool) {
+	if o.Null {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o NilImageDiskUsageImagesItem) Or(d ImageDiskUsageImagesItem) ImageDiskUsageImagesItem {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewNilImageGCResultFilesItem returns new NilImageGCResultFilesItem with value set to v.
+func NewNilImageGCResultFilesItem(v ImageGCResultFilesItem) NilImageGCResultFilesItem {
+	return NilImageGCResultFilesItem{
+		Value: v,
+	}
+}
+
+// NilImageGCResultFilesItem is nullable ImageGCResultFilesItem.
+type NilImageGCResultFilesItem struct {
+	Value ImageGCResultFilesItem
+	Null  bool
+}
+
+// SetTo sets value to v.
+func (o *NilImageGCResultFilesItem) SetTo(v ImageGCResultFilesItem) {
+	o.Null = false
+	o.Value = v
+}
+
+// IsSet returns true if value is Null.
+func (o NilImageGCResultFilesItem) IsNull() bool { return o.Null }
+
+// SetNull sets value to null.
+func (o *NilImageGCResultFilesItem) SetToNull() {
+	o.Null = true
+	var v ImageGCResultFilesItem
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o NilImageGCResultFilesItem) Get() (v ImageGCResultFilesItem, ok bool) {
+	if o.Null {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o NilImageGCResultFilesItem) Or(d ImageGCResultFilesItem) ImageGCResultFilesItem {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewNilInt returns new NilInt with value set to v.
+func NewNilInt(v int) NilInt {
+	return NilInt{
+		Value: v,
+	}
+}
+
+// NilInt is nullable int.
+type NilInt struct {
+	Value int
+	Null  bool
+}
+
+// SetTo sets value to v.
+func (o *NilInt) SetTo(v int) {
+	o.Null = false
+	o.Value = v
+}
+
+// IsSet returns true if value is Null.
+func (o NilInt) IsNull() bool { return o.Null }
+
+// SetNull sets value to null.
+func (o *NilInt) SetToNull() {
+	o.Null = true
+	var v int
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o NilInt) Get() (v int, ok bool) {
+	if o.Null {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o NilInt) Or(d int) int {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewNilNodeAddRequestNodeListItem returns new NilNodeAddRequestNodeListItem with value set to v.
+func NewNilNodeAddRequestNodeListItem(v NodeAddRequestNodeListItem) NilNodeAddRequestNodeListItem {
+	return NilNodeAddRequestNodeListItem{
+		Value: v,
+	}
+}
+
+// NilNodeAddRequestNodeListItem is nullable NodeAddRequestNodeListItem.
+type NilNodeAddRequestNodeListItem struct {
+	Value NodeAddRequestNodeListItem
+	Null  bool
+}
+
+// SetTo sets value to v.
+func (o *NilNodeAddRequestNodeListItem) SetTo(v NodeAddRequestNodeListItem) {
+	o.Null = false
+	o.Value = v
+}
+
+// IsSet returns true if value is Null.
+func (o NilNodeAddRequestNodeListItem) IsNull() bool { return o.Null }
+
+// SetNull sets value to null.
+func (o *NilNodeAddRequestNodeListItem) SetToNull() {
+	o.Null = true
+	var v NodeAddRequestNodeListItem
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o NilNodeAddRequestNodeListItem) Get() (v NodeAddRequestNodeListItem, ok bool) {
+	if o.Null {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o NilNodeAddRequestNodeListItem) Or(d NodeAddRequestNodeListItem) NodeAddRequestNodeListItem {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewNilNodeAddRequestNodeListItemBondsItem returns new NilNodeAddRequestNodeListItemBondsItem with value set to v.
+func NewNilNodeAddRequestNodeListItemBondsItem(v NodeAddRequestNodeListItemBondsItem) NilNodeAddRequestNodeListItemBondsItem {
+	return NilNodeAddRequestNodeListItemBondsItem{
+		Value: v,
+	}
+}
+
+// NilNodeAddRequestNodeListItemBondsItem is nullable NodeAddRequestNodeListItemBondsItem.
+type NilNodeAddRequestNodeListItemBondsItem struct {
+	Value NodeAddRequestNodeListItemBondsItem
+	Null  bool
+}
+
+// SetTo sets value to v.
+func (o *NilNodeAddRequestNodeListItemBondsItem) SetTo(v NodeAddRequestNodeListItemBondsItem) {
+	o.Null = false
+	o.Value = v
+}
+
+// IsSet returns true if value is Null.
+func (o NilNodeAddRequestNodeListItemBondsItem) IsNull() bool { return o.Null }
+
+// SetNull sets value to null.
+func (o *NilNodeAddRequestNodeListItemBondsItem) SetToNull() {
+	o.Null = true
+	var v NodeAddRequestNodeListItemBondsItem
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o NilNodeAddRequestNodeListItemBondsItem) Get() (v NodeAddRequestNodeListItemBondsItem, ok bool) {
+	if o.Null {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o NilNodeAddRequestNodeListItemBondsItem) Or(d NodeAddRequestNodeListItemBondsItem) NodeAddRequestNodeListItemBondsItem {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewNilNodeAddRequestNodeListItemHardwareDimmsItem returns new NilNodeAddRequestNodeListItemHardwareDimmsItem with value set to v.
+func NewNilNodeAddRequestNodeListItemHardwareDimmsItem(v NodeAddRequestNodeListItemHardwareDimmsItem) NilNodeAddRequestNodeListItemHardwareDimmsItem {
+	return NilNodeAddRequestNodeListItemHardwareDimmsItem{
+		Value: v,
+	}
+}
+
+// NilNodeAddRequestNodeListItemHardwareDimmsItem is nullable NodeAddRequestNodeListItemHardwareDimmsItem.
+type NilNodeAddRequestNodeListItemHardwareDimmsItem struct {
+	Value NodeAddRequestNodeListItemHardwareDimmsItem
+	Null  bool
+}
+
+// SetTo sets value to v.
+func (o *NilNodeAddRequestNodeListItemHardwareDimmsItem) SetTo(v NodeAddRequestNodeListItemHardwareDimmsItem) {
+	o.Null = false
+	o.Value = v
+}
+
+// IsSet returns true if value is Null.
+func (o NilNodeAddRequestNodeListItemHardwareDimmsItem) IsNull() bool { return o.Null }
+
+// SetNull sets value to null.
+func (o *NilNodeAddRequestNodeListItemHardwareDimmsItem) SetToNull() {
+	o.Null = true
+	var v NodeAddRequestNodeListItemHardwareDimmsItem
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o NilNodeAddRequestNodeListItemHardwareDimmsItem) Get() (v NodeAddRequestNodeListItemHardwareDimmsItem, ok bool) {
+	if o.Null {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o NilNodeAddRequestNodeListItemHardwareDimmsItem) Or(d NodeAddRequestNodeListItemHardwareDimmsItem) NodeAddRequestNodeListItemHardwareDimmsItem {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewNilNodeAddRequestNodeListItemHardwareDisksItem returns new NilNodeAddRequestNodeListItemHardwareDisksItem with value set to v.
+func NewNilNodeAddRequestNodeListItemHardwareDisksItem(v NodeAddRequestNodeListItemHardwareDisksItem) NilNodeAddRequestNodeListItemHardwareDisksItem {
+	return NilNodeAddRequestNodeListItemHardwareDisksItem{
+		Value: v,
+	}
+}
+
+// NilNodeAddRequestNodeListItemHardwareDisksItem is nullable NodeAddRequestNodeListItemHardwareDisksItem.
+type NilNodeAddRequestNodeListItemHardwareDisksItem struct {
+	Value NodeAddRequestNodeListItemHardwareDisksItem
+	Null  bool
+}
+
+// SetTo sets value to v.
+func (o *NilNodeAddRequestNodeListItemHardwareDisksItem) SetTo(v NodeAddRequestNodeListItemHardwareDisksItem) {
+	o.Null = false
+	o.Value = v
+}
+
+// IsSet returns true if value is Null.
+func (o NilNodeAddRequestNodeListItemHardwareDisksItem) IsNull() bool { return o.Null }
+
+// SetNull sets value to null.
+func (o *NilNodeAddRequestNodeListItemHardwareDisksItem) SetToNull() {
+	o.Null = true
+	var v NodeAddRequestNodeListItemHardwareDisksItem
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o NilNodeAddRequestNodeListItemHardwareDisksItem) Get() (v NodeAddRequestNodeListItemHardwareDisksItem, ok bool) {
+	if o.Null {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o NilNodeAddRequestNodeListItemHardwareDisksItem) Or(d NodeAddRequestNodeListItemHardwareDisksItem) NodeAddRequestNodeListItemHardwareDisksItem {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewNilNodeAddRequestNodeListItemHardwareNicsItem returns new NilNodeAddRequestNodeListItemHardwareNicsItem with value set to v.
+func NewNilNodeAddRequestNodeListItemHardwareNicsItem(v NodeAddRequestNodeListItemHardwareNicsItem) NilNodeAddRequestNodeListItemHardwareNicsItem {
+	return NilNodeAddRequestNodeListItemHardwareNicsItem{
+		Value: v,
+	}
+}
+
+// NilNodeAddRequestNodeListItemHardwareNicsItem is nullable NodeAddRequestNodeListItemHardwareNicsItem.
+type NilNodeAddRequestNodeListItemHardwareNicsItem struct {
+	Value NodeAddRequestNodeListItemHardwareNicsItem
+	Null  bool
+}
+
+// SetTo sets value to v.
+func (o *NilNodeAddRequestNodeListItemHardwareNicsItem) SetTo(v NodeAddRequestNodeListItemHardwareNicsItem) {
+	o.Null = false
+	o.Value = v
+}
+
+// IsSet returns true if value is Null.
+func (o NilNodeAddRequestNodeListItemHardwareNicsItem) IsNull() bool { return o.Null }
+
+// SetNull sets value to null.
+func (o *NilNodeAddRequestNodeListItemHardwareNicsItem) SetToNull() {
+	o.Null = true
+	var v NodeAddRequestNodeListItemHardwareNicsItem
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o NilNodeAddRequestNodeListItemHardwareNicsItem) Get() (v NodeAddRequestNodeListItemHardwareNicsItem, ok bool) {
+	if o.Null {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o NilNodeAddRequestNodeListItemHardwareNicsItem) Or(d NodeAddRequestNodeListItemHardwareNicsItem) NodeAddRequestNodeListItemHardwareNicsItem {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewNilNodeAddRequestNodeListItemInterfacesItem returns new NilNodeAddRequestNodeListItemInterfacesItem with value set to v.
+func NewNilNodeAddRequestNodeListItemInterfacesItem(v NodeAddRequestNodeListItemInterfacesItem) NilNodeAddRequestNodeListItemInterfacesItem {
+	return NilNodeAddRequestNodeListItemInterfacesItem{
+		Value: v,
+	}
+}
+
+// NilNodeAddRequestNodeListItemInterfacesItem is nullable NodeAddRequestNodeListItemInterfacesItem.
+type NilNodeAddRequestNodeListItemInterfacesItem struct {
+	Value NodeAddRequestNodeListItemInterfacesItem
+	Null  bool
+}
+
+// SetTo sets value to v.
+func (o *NilNodeAddRequestNodeListItemInterfacesItem) SetTo(v NodeAddRequestNodeListItemInterfacesItem) {
+	o.Null = false
+	o.Value = v
+}
+
+// IsSet returns true if value is Null.
+func (o NilNodeAddRequestNodeListItemInterfacesItem) IsNull() bool { return o.Null }
+
+// SetNull sets value to null.
+func (o *NilNodeAddRequestNodeListItemInterfacesItem) SetToNull() {
+	o.Null = true
+	var v NodeAddRequestNodeListItemInterfacesItem
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o NilNodeAddRequestNodeListItemInterfacesItem) Get() (v NodeAddRequestNodeListItemInterfacesItem, ok bool) {
+	if o.Null {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o NilNodeAddRequestNodeListItemInterfacesItem) Or(d NodeAddRequestNodeListItemInterfacesItem) NodeAddRequestNodeListItemInterfacesItem {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewNilRedfishJobJobsItem returns new NilRedfishJobJobsItem with value set to v.
+func NewNilRedfishJobJobsItem(v RedfishJobJobsItem) NilRedfishJobJobsItem {
+	return NilRedfishJobJobsItem{
+		Value: v,
+	}
+}
+
+// NilRedfishJobJobsItem is nullable RedfishJobJobsItem.
+type NilRedfishJobJobsItem struct {
+	Value RedfishJobJobsItem
+	Null  bool
+}
+
+// SetTo sets value to v.
+func (o *NilRedfishJobJobsItem) SetTo(v RedfishJobJobsItem) {
+	o.Null = false
+	o.Value = v
+}
+
+// IsSet returns true if value is Null.
+func (o NilRedfishJobJobsItem) IsNull() bool { return o.Null }
+
+// SetNull sets value to null.
+func (o *NilRedfishJobJobsItem) SetToNull() {
+	o.Null = true
+	var v RedfishJobJobsItem
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o NilRedfishJobJobsItem) Get() (v RedfishJobJobsItem, ok bool) {
+	if o.Null {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o NilRedfishJobJobsItem) Or(d RedfishJobJobsItem) RedfishJobJobsItem {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewNilRedfishMetricReportReportsItem returns new NilRedfishMetricReportReportsItem with value set to v.
+func NewNilRedfishMetricReportReportsItem(v RedfishMetricReportReportsItem) NilRedfishMetricReportReportsItem {
+	return NilRedfishMetricReportReportsItem{
+		Value: v,
+	}
+}
+
+// NilRedfishMetricReportReportsItem is nullable RedfishMetricReportReportsItem.
+type NilRedfishMetricReportReportsItem struct {
+	Value RedfishMetricReportReportsItem
+	Null  bool
+}
+
+// SetTo sets value to v.
+func (o *NilRedfishMetricReportReportsItem) SetTo(v RedfishMetricReportReportsItem) {
+	o.Null = false
+	o.Value = v
+}
+
+// IsSet returns true if value is Null.
+func (o NilRedfishMetricReportReportsItem) IsNull() bool { return o.Null }
+
+// SetNull sets value to null.
+func (o *NilRedfishMetricReportReportsItem) SetToNull() {
+	o.Null = true
+	var v RedfishMetricReportReportsItem
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o NilRedfishMetricReportReportsItem) Get() (v RedfishMetricReportReportsItem, ok bool) {
+	if o.Null {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o NilRedfishMetricReportReportsItem) Or(d RedfishMetricReportReportsItem) RedfishMetricReportReportsItem {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewNilScheduledActionResultsItem returns new NilScheduledActionResultsItem with value set to v.
+func NewNilScheduledActionResultsItem(v ScheduledActionResultsItem) NilScheduledActionResultsItem {
+	return NilScheduledActionResultsItem{
+		Value: v,
+	}
+}
+
+// NilScheduledActionResultsItem is nullable ScheduledActionResultsItem.
+type NilScheduledActionResultsItem struct {
+	Value ScheduledActionResultsItem
+	Null  bool
+}
+
+// SetTo sets value to v.
+func (o *NilScheduledActionResultsItem) SetTo(v ScheduledActionResultsItem) {
+	o.Null = false
+	o.Value = v
+}
+
+// IsSet returns true if value is Null.
+func (o NilScheduledActionResultsItem) IsNull() bool { return o.Null }
+
+// SetNull sets value to null.
+func (o *NilScheduledActionResultsItem) SetToNull() {
+	o.Null = true
+	var v ScheduledActionResultsItem
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o NilScheduledActionResultsItem) Get() (v ScheduledActionResultsItem, ok bool) {
+	if o.Null {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o NilScheduledActionResultsItem) Or(d ScheduledActionResultsItem) ScheduledActionResultsItem {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewNilString returns new NilString with value set to v.
+func NewNilString(v string) NilString {
+	return NilString{
+		Value: v,
+	}
+}
+
+// NilString is nullable string.
+type NilString struct {
+	Value string
+	Null  bool
+}
+
+// SetTo sets value to v.
+func (o *NilString) SetTo(v string) {
+	o.Null = false
+	o.Value = v
+}
+
+// IsSet returns true if value is Null.
+func (o NilString) IsNull() bool { return o.Null }
+
+// SetNull sets value to null.
+func (o *NilString) SetToNull() {
+	o.Null = true
+	var v string
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o NilString) Get() (v string, ok bool) {
+	if o.Null {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o NilString) Or(d string) string {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewNilTrashedHostHostBondsItem returns new NilTrashedHostHostBondsItem with value set to v.
+func NewNilTrashedHostHostBondsItem(v TrashedHostHostBondsItem) NilTrashedHostHostBondsItem {
+	return NilTrashedHostHostBondsItem{
+		Value: v,
+	}
+}
+
+// NilTrashedHostHostBondsItem is nullable TrashedHostHostBondsItem.
+type NilTrashedHostHostBondsItem struct {
+	Value TrashedHostHostBondsItem
+	Null  bool
+}
+
+// SetTo sets value to v.
+func (o *NilTrashedHostHostBondsItem) SetTo(v TrashedHostHostBondsItem) {
+	o.Null = false
+	o.Value = v
+}
+
+// IsSet returns true if value is Null.
+func (o NilTrashedHostHostBondsItem) IsNull() bool { return o.Null }
+
+// SetNull sets value to null.
+func (o *NilTrashedHostHostBondsItem) SetToNull() {
+	o.Null = true
+	var v TrashedHostHostBondsItem
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o NilTrashedHostHostBondsItem) Get() (v TrashedHostHostBondsItem, ok bool) {
+	if o.Null {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o NilTrashedHostHostBondsItem) Or(d TrashedHostHostBondsItem) TrashedHostHostBondsItem {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewNilTrashedHostHostHardwareDimmsItem returns new NilTrashedHostHostHardwareDimmsItem with value set to v.
+func NewNilTrashedHostHostHardwareDimmsItem(v TrashedHostHostHardwareDimmsItem) NilTrashedHostHostHardwareDimmsItem {
+	return NilTrashedHostHostHardwareDimmsItem{
+		Value: v,
+	}
+}
+
+// NilTrashedHostHostHardwareDimmsItem is nullable TrashedHostHostHardwareDimmsItem.
+type NilTrashedHostHostHardwareDimmsItem struct {
+	Value TrashedHostHostHardwareDimmsItem
+	Null  bool
+}
+
+// SetTo sets value to v.
+func (o *NilTrashedHostHostHardwareDimmsItem) SetTo(v TrashedHostHostHardwareDimmsItem) {
+	o.Null = false
+	o.Value = v
+}
+
+// IsSet returns true if value is Null.
+func (o NilTrashedHostHostHardwareDimmsItem) IsNull() bool { return o.Null }
+
+// SetNull sets value to null.
+func (o *NilTrashedHostHostHardwareDimmsItem) SetToNull() {
+	o.Null = true
+	var v TrashedHostHostHardwareDimmsItem
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o NilTrashedHostHostHardwareDimmsItem) Get() (v TrashedHostHostHardwareDimmsItem, ok bool) {
+	if o.Null {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o NilTrashedHostHostHardwareDimmsItem) Or(d TrashedHostHostHardwareDimmsItem) TrashedHostHostHardwareDimmsItem {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewNilTrashedHostHostHardwareDisksItem returns new NilTrashedHostHostHardwareDisksItem with value set to v.
+func NewNilTrashedHostHostHardwareDisksItem(v TrashedHostHostHardwareDisksItem) NilTrashedHostHostHardwareDisksItem {
+	return NilTrashedHostHostHardwareDisksItem{
+		Value: v,
+	}
+}
+
+// NilTrashedHostHostHardwareDisksItem is nullable TrashedHostHostHardwareDisksItem.
+type NilTrashedHostHostHardwareDisksItem struct {
+	Value TrashedHostHostHardwareDisksItem
+	Null  bool
+}
+
+// SetTo sets value to v.
+func (o *NilTrashedHostHostHardwareDisksItem) SetTo(v TrashedHostHostHardwareDisksItem) {
+	o.Null = false
+	o.Value = v
+}
+
+// IsSet returns true if value is Null.
+func (o NilTrashedHostHostHardwareDisksItem) IsNull() bool { return o.Null }
+
+// SetNull sets value to null.
+func (o *NilTrashedHostHostHardwareDisksItem) SetToNull() {
+	o.Null = true
+	var v TrashedHostHostHardwareDisksItem
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o NilTrashedHostHostHardwareDisksItem) Get() (v TrashedHostHostHardwareDisksItem, ok bool) {
+	if o.Null {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o NilTrashedHostHostHardwareDisksItem) Or(d TrashedHostHostHardwareDisksItem) TrashedHostHostHardwareDisksItem {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewNilTrashedHostHostHardwareNicsItem returns new NilTrashedHostHostHardwareNicsItem with value set to v.
+func NewNilTrashedHostHostHardwareNicsItem(v TrashedHostHostHardwareNicsItem) NilTrashedHostHostHardwareNicsItem {
+	return NilTrashedHostHostHardwareNicsItem{
+		Value: v,
+	}
+}
+
+// NilTrashedHostHostHardwareNicsItem is nullable TrashedHostHostHardwareNicsItem.
+type NilTrashedHostHostHardwareNicsItem struct {
+	Value TrashedHostHostHardwareNicsItem
+	Null  bool
+}
+
+// SetTo sets value to v.
+func (o *NilTrashedHostHostHardwareNicsItem) SetTo(v TrashedHostHostHardwareNicsItem) {
+	o.Null = false
+	o.Value = v
+}
+
+// IsSet returns true if value is Null.
+func (o NilTrashedHostHostHardwareNicsItem) IsNull() bool { return o.Null }
+
+// SetNull sets value to null.
+func (o *NilTrashedHostHostHardwareNicsItem) SetToNull() {
+	o.Null = true
+	var v TrashedHostHostHardwareNicsItem
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o NilTrashedHostHostHardwareNicsItem) Get() (v TrashedHostHostHardwareNicsItem, ok bool) {
+	if o.Null {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o NilTrashedHostHostHardwareNicsItem) Or(d TrashedHostHostHardwareNicsItem) TrashedHostHostHardwareNicsItem {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewNilTrashedHostHostInterfacesItem returns new NilTrashedHostHostInterfacesItem with value set to v.
+func NewNilTrashedHostHostInterfacesItem(v TrashedHostHostInterfacesItem) NilTrashedHostHostInterfacesItem {
+	return NilTrashedHostHostInterfacesItem{
+		Value: v,
+	}
+}
+
+// NilTrashedHostHostInterfacesItem is nullable TrashedHostHostInterfacesItem.
+type NilTrashedHostHostInterfacesItem struct {
+	Value TrashedHostHostInterfacesItem
+	Null  bool
+}
+
+// SetTo sets value to v.
+func (o *NilTrashedHostHostInterfacesItem) SetTo(v TrashedHostHostInterfacesItem) {
+	o.Null = false
+	o.Value = v
+}
+
+// IsSet returns true if value is Null.
+func (o NilTrashedHostHostInterfacesItem) IsNull() bool { return o.Null }
+
+// SetNull sets value to null.
+func (o *NilTrashedHostHostInterfacesItem) SetToNull() {
+	o.Null = true
+	var v TrashedHostHostInterfacesItem
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o NilTrashedHostHostInterfacesItem) Get() (v TrashedHostHostInterfacesItem, ok bool) {
+	if o.Null {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o NilTrashedHostHostInterfacesItem) Or(d TrashedHostHostInterfacesItem) TrashedHostHostInterfacesItem {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NodeAddRequest schema.
+// Ref: #/components/schemas/NodeAddRequest
+type NodeAddRequest struct {
+	NodeList []NilNodeAddRequestNodeListItem `json:"node_list"`
+}
+
+// GetNodeList returns the value of NodeList.
+func (s *NodeAddRequest) GetNodeList() []NilNodeAddRequestNodeListItem {
+	return s.NodeList
+}
+
+// SetNodeList sets the value of NodeList.
+func (s *NodeAddRequest) SetNodeList(val []NilNodeAddRequestNodeListItem) {
+	s.NodeList = val
+}
+
+type NodeAddRequestNodeListItem struct {
+	Bonds                 []NilNodeAddRequestNodeListItemBondsItem      `json:"bonds"`
+	BootImage             OptString                                     `json:"boot_image"`
+	ClientCertFingerprint OptString                                     `json:"client_cert_fingerprint"`
+	ClientCertSerial      OptString                                     `json:"client_cert_serial"`
+	CreatedAt             OptNilDateTime                                `json:"created_at"`
+	Firmware              OptString                                     `json:"firmware"`
+	Hardware              OptNilNodeAddRequestNodeListItemHardware      `json:"hardware"`
+	ID                    OptNilInt64                                   `json:"id"`
+	Interfaces            []NilNodeAddRequestNodeListItemInterfacesItem `json:"interfaces"`
+	Inventory             OptNilNodeAddRequestNodeListItemInventory     `json:"inventory"`
+	Name                  OptString                                     `json:"name"`
+	Provision             OptBool                                       `json:"provision"`
+	Revision              OptNilInt64                                   `json:"revision"`
+	SmbiosUUID            OptString                                     `json:"smbios_uuid"`
+	State                 OptString                                     `json:"state"`
+	Tags                  OptNilStringArray                             `json:"tags"`
+	UID                   OptNilString                                  `json:"uid"`
+	UpdatedAt             OptNilDateTime                                `json:"updated_at"`
+}
+
+// GetBonds returns the value of Bonds.
+func (s *NodeAddRequestNodeListItem) GetBonds() []NilNodeAddRequestNodeListItemBondsItem {
+	return s.Bonds
+}
+
+// GetBootImage returns the value of BootImage.
+func (s *NodeAddRequestNodeListItem) GetBootImage() OptString {
+	return s.BootImage
+}
+
+// GetClientCertFingerprint returns the value of ClientCertFingerprint.
+func (s *NodeAddRequestNodeListItem) GetClientCertFingerprint() OptString {
+	return s.ClientCertFingerprint
+}
+
+// GetClientCertSerial returns the value of ClientCertSerial.
+func (s *NodeAddRequestNodeListItem) GetClientCertSerial() OptString {
+	return s.ClientCertSerial
+}
+
+// GetCreatedAt returns the value of CreatedAt.
+func (s *NodeAddRequestNodeListItem) GetCreatedAt() OptNilDateTime {
+	return s.CreatedAt
+}
+
+// GetFirmware returns the value of Firmware.
+func (s *NodeAddRequestNodeListItem) GetFirmware() OptString {
+	return s.Firmware
+}
+
+// GetHardware returns the value of Hardware.
+func (s *NodeAddRequestNodeListItem) GetHardware() OptNilNodeAddRequestNodeListItemHardware {
+	return s.Hardware
+}
+
+// GetID returns the value of ID.
+func (s *NodeAddRequestNodeListItem) GetID() OptNilInt64 {
+	return s.ID
+}
+
+// GetInterfaces returns the value of Interfaces.
+func (s *NodeAddRequestNodeListItem) GetInterfaces() []NilNodeAddRequestNodeListItemInterfacesItem {
+	return s.Interfaces
+}
+
+// GetInventory returns the value of Inventory.
+func (s *NodeAddRequestNodeListItem) GetInventory() OptNilNodeAddRequestNodeListItemInventory {
+	return s.Inventory
+}
+
+// GetName returns the value of Name.
+func (s *NodeAddRequestNodeListItem) GetName() OptString {
+	return s.Name
+}
+
+// GetProvision returns the value of Provision.
+func (s *NodeAddRequestNodeListItem) GetProvision() OptBool {
+	return s.Provision
+}
+
+// GetRevision returns the value of Revision.
+func (s *NodeAddRequestNodeListItem) GetRevision() OptNilInt64 {
+	return s.Revision
+}
+
+// GetSmbiosUUID returns the value of SmbiosUUID.
+func (s *NodeAddRequestNodeListItem) GetSmbiosUUID() OptString {
+	return s.SmbiosUUID
+}
+
+// GetState returns the value of State.
+func (s *NodeAddRequestNodeListItem) GetState() OptString {
+	return s.State
+}
+
+// GetTags returns the value of Tags.
+func (s *NodeAddRequestNodeListItem) GetTags() OptNilStringArray {
+	return s.Tags
+}
+
+// GetUID returns the value of UID.
+func (s *NodeAddRequestNodeListItem) GetUID() OptNilString {
+	return s.UID
+}
+
+// GetUpdatedAt returns the value of UpdatedAt.
+func (s *NodeAddRequestNodeListItem) GetUpdatedAt() OptNilDateTime {
+	return s.UpdatedAt
+}
+
+// SetBonds sets the value of Bonds.
+func (s *NodeAddRequestNodeListItem) SetBonds(val []NilNodeAddRequestNodeListItemBondsItem) {
+	s.Bonds = val
+}
+
+// SetBootImage sets the value of BootImage.
+func (s *NodeAddRequestNodeListItem) SetBootImage(val OptString) {
+	s.BootImage = val
+}
+
+// SetClientCertFingerprint sets the value of ClientCertFingerprint.
+func (s *NodeAddRequestNodeListItem) SetClientCertFingerprint(val OptString) {
+	s.ClientCertFingerprint = val
+}
+
+// SetClientCertSerial sets the value of ClientCertSerial.
+func (s *NodeAddRequestNodeListItem) SetClientCertSerial(val OptString) {
+	s.ClientCertSerial = val
+}
+
+// SetCreatedAt sets the value of CreatedAt.
+func (s *NodeAddRequestNodeListItem) SetCreatedAt(val OptNilDateTime) {
+	s.CreatedAt = val
+}
+
+// SetFirmware sets the value of Firmware.
+func (s *NodeAddRequestNodeListItem) SetFirmware(val OptString) {
+	s.Firmware = val
+}
+
+// SetHardware sets the value of Hardware.
+func (s *NodeAddRequestNodeListItem) SetHardware(val OptNilNodeAddRequestNodeListItemHardware) {
+	s.Hardware = val
+}
+
+// SetID sets the value of ID.
+func (s *NodeAddRequestNodeListItem) SetID(val OptNilInt64) {
+	s.ID = val
+}
+
+// SetInterfaces sets the value of Interfaces.
+func (s *NodeAddRequestNodeListItem) SetInterfaces(val []NilNodeAddRequestNodeListItemInterfacesItem) {
+	s.Interfaces = val
+}
+
+// SetInventory sets the value of Inventory.
+func (s *NodeAddRequestNodeListItem) SetInventory(val OptNilNodeAddRequestNodeListItemInventory) {
+	s.Inventory = val
+}
+
+// SetName sets the value of Name.
+func (s *NodeAddRequestNodeListItem) SetName(val OptString) {
+	s.Name = val
+}
+
+// SetProvision sets the value of Provision.
+func (s *NodeAddRequestNodeListItem) SetProvision(val OptBool) {
+	s.Provision = val
+}
+
+// SetRevision sets the value of Revision.
+func (s *NodeAddRequestNodeListItem) SetRevision(val OptNilInt64) {
+	s.Revision = val
+}
+
+// SetSmbiosUUID sets the value of SmbiosUUID.
+func (s *NodeAddRequestNodeListItem) SetSmbiosUUID(val OptString) {
+	s.SmbiosUUID = val
+}
+
+// SetState sets the value of State.
+func (s *NodeAddRequestNodeListItem) SetState(val OptString) {
+	s.State = val
+}
+
+// SetTags sets the value of Tags.
+func (s *NodeAddRequestNodeListItem) SetTags(val OptNilStringArray) {
+	s.Tags = val
+}
+
+// SetUID sets the value of UID.
+func (s *NodeAddRequestNodeListItem) SetUID(val OptNilString) {
+	s.UID = val
+}
+
+// SetUpdatedAt sets the value of UpdatedAt.
+func (s *NodeAddRequestNodeListItem) SetUpdatedAt(val OptNilDateTime) {
+	s.UpdatedAt = val
+}
+
+type NodeAddRequestNodeListItemBondsItem struct {
+	Addresses []NodeAddRequestNodeListItemBondsItemAddressesItem `json:"addresses"`
+	Bmc       OptBool                                            `json:"bmc"`
+	Fqdn      OptString                                          `json:"fqdn"`
+	ID        OptNilInt64                                        `json:"id"`
+	Ifname    OptString                                          `json:"ifname"`
+	IP        OptString                                          `json:"ip"`
+	MAC       OptString                                          `json:"mac"`
+	Mode      OptString                                          `json:"mode"`
+	Mtu       OptInt                                             `json:"mtu"`
+	Options   OptNodeAddRequestNodeListItemBondsItemOptions      `json:"options"`
+	Parent    OptString                                          `json:"parent"`
+	Peers     []string                                           `json:"peers"`
+	Port      OptInt                                             `json:"port"`
+	Switch    OptString                                          `json:"switch"`
+	Type      OptString                                          `json:"type"`
+	Vlan      OptInt                                             `json:"vlan"`
+}
+
+// GetAddresses returns the value of Addresses.
+func (s *NodeAddRequestNodeListItemBondsItem) GetAddresses() []NodeAddRequestNodeListItemBondsItemAddressesItem {
+	return s.Addresses
+}
+
+// GetBmc returns the value of Bmc.
+func (s *NodeAddRequestNodeListItemBondsItem) GetBmc() OptBool {
+	return s.Bmc
+}
+
+// GetFqdn returns the value of Fqdn.
+func (s *NodeAddRequestNodeListItemBondsItem) GetFqdn() OptString {
+	return s.Fqdn
+}
+
+// GetID returns the value of ID.
+func (s *NodeAddRequestNodeListItemBondsItem) GetID() OptNilInt64 {
+	return s.ID
+}
+
+// GetIfname returns the value of Ifname.
+func (s *NodeAddRequestNodeListItemBondsItem) GetIfname() OptString {
+	return s.Ifname
+}
+
+// GetIP returns the value of IP.
+func (s *NodeAddRequestNodeListItemBondsItem) GetIP() OptString {
+	return s.IP
+}
+
+// GetMAC returns the value of MAC.
+func (s *NodeAddRequestNodeListItemBondsItem) GetMAC() OptString {
+	return s.MAC
+}
+
+// GetMode returns the value of Mode.
+func (s *NodeAddRequestNodeListItemBondsItem) GetMode() OptString {
+	return s.Mode
+}
+
+// GetMtu returns the value of Mtu.
+func (s *NodeAddRequestNodeListItemBondsItem) GetMtu() OptInt {
+	return s.Mtu
+}
+
+// GetOptions returns the value of Options.
+func (s *NodeAddRequestNodeListItemBondsItem) GetOptions() OptNodeAddRequestNodeListItemBondsItemOptions {
+	return s.Options
+}
+
+// GetParent returns the value of Parent.
+func (s *NodeAddRequestNodeListItemBondsItem) GetParent() OptString {
+	return s.Parent
+}
+
+// GetPeers returns the value of Peers.
+func (s *NodeAddRequestNodeListItemBondsItem) GetPeers() []string {
+	return s.Peers
+}
+
+// GetPort returns the value of Port.
+func (s *NodeAddRequestNodeListItemBondsItem) GetPort() OptInt {
+	return s.Port
+}
+
+// GetSwitch returns the value of Switch.
+func (s *NodeAddRequestNodeListItemBondsItem) GetSwitch() OptString {
+	return s.Switch
+}
+
+// GetType returns the value of Type.
+func (s *NodeAddRequestNodeListItemBondsItem) GetType() OptString {
+	return s.Type
+}
+
+// GetVlan returns the value of Vlan.
+func (s *NodeAddRequestNodeListItemBondsItem) GetVlan() OptInt {
+	return s.Vlan
+}
+
+// SetAddresses sets the value of Addresses.
+func (s *NodeAddRequestNodeListItemBondsItem) SetAddresses(val []NodeAddRequestNodeListItemBondsItemAddressesItem) {
+	s.Addresses = val
+}
+
+// SetBmc sets the value of Bmc.
+func (s *NodeAddRequestNodeListItemBondsItem) SetBmc(val OptBool) {
+	s.Bmc = val
+}
+
+// SetFqdn sets the value of Fqdn.
+func (s *NodeAddRequestNodeListItemBondsItem) SetFqdn(val OptString) {
+	s.Fqdn = val
+}
+
+// SetID sets the value of ID.
+func (s *NodeAddRequestNodeListItemBondsItem) SetID(val OptNilInt64) {
+	s.ID = val
+}
+
+// SetIfname sets the value of Ifname.
+func (s *NodeAddRequestNodeListItemBondsItem) SetIfname(val OptString) {
+	s.Ifname = val
+}
+
+// SetIP sets the value of IP.
+func (s *NodeAddRequestNodeListItemBondsItem) SetIP(val OptString) {
+	s.IP = val
+}
+
+// SetMAC sets the value of MAC.
+func (s *NodeAddRequestNodeListItemBondsItem) SetMAC(val OptString) {
+	s.MAC = val
+}
+
+// SetMode sets the value of Mode.
+func (s *NodeAddRequestNodeListItemBondsItem) SetMode(val OptString) {
+	s.Mode = val
+}
+
+// SetMtu sets the value of Mtu.
+func (s *NodeAddRequestNodeListItemBondsItem) SetMtu(val OptInt) {
+	s.Mtu = val
+}
+
+// SetOptions sets the value of Options.
+func (s *NodeAddRequestNodeListItemBondsItem) SetOptions(val OptNodeAddRequestNodeListItemBondsItemOptions) {
+	s.Options = val
+}
+
+// SetParent sets the value of Parent.
+func (s *NodeAddRequestNodeListItemBondsItem) SetParent(val OptString) {
+	s.Parent = val
+}
+
+// SetPeers sets the value of Peers.
+func (s *NodeAddRequestNodeListItemBondsItem) SetPeers(val []string) {
+	s.Peers = val
+}
+
+// SetPort sets the value of Port.
+func (s *NodeAddRequestNodeListItemBondsItem) SetPort(val OptInt) {
+	s.Port = val
+}
+
+// SetSwitch sets the value of Switch.
+func (s *NodeAddRequestNodeListItemBondsItem) SetSwitch(val OptString) {
+	s.Switch = val
+}
+
+// SetType sets the value of Type.
+func (s *NodeAddRequestNodeListItemBondsItem) SetType(val OptString) {
+	s.Type = val
+}
+
+// SetVlan sets the value of Vlan.
+func (s *NodeAddRequestNodeListItemBondsItem) SetVlan(val OptInt) {
+	s.Vlan = val
+}
+
+type NodeAddRequestNodeListItemBondsItemAddressesItem struct {
+	Fqdn    OptString `json:"fqdn"`
+	IP      OptString `json:"ip"`
+	Primary OptBool   `json:"primary"`
+}
+
+// GetFqdn returns the value of Fqdn.
+func (s *NodeAddRequestNodeListItemBondsItemAddressesItem) GetFqdn() OptString {
+	return s.Fqdn
+}
+
+// GetIP returns the value of IP.
+func (s *NodeAddRequestNodeListItemBondsItemAddressesItem) GetIP() OptString {
+	return s.IP
+}
+
+// GetPrimary returns the value of Primary.
+func (s *NodeAddRequestNodeListItemBondsItemAddressesItem) GetPrimary() OptBool {
+	return s.Primary
+}
+
+// SetFqdn sets the value of Fqdn.
+func (s *NodeAddRequestNodeListItemBondsItemAddressesItem) SetFqdn(val OptString) {
+	s.Fqdn = val
+}
+
+// SetIP sets the value of IP.
+func (s *NodeAddRequestNodeListItemBondsItemAddressesItem) SetIP(val OptString) {
+	s.IP = val
+}
+
+// SetPrimary sets the value of Primary.
+func (s *NodeAddRequestNodeListItemBondsItemAddressesItem) SetPrimary(val OptBool) {
+	s.Primary = val
+}
+
+type NodeAddRequestNodeListItemBondsItemOptions map[string]string
+
+func (s *NodeAddRequestNodeListItemBondsItemOptions) init() NodeAddRequestNodeListItemBondsItemOptions {
+	m := *s
+	if m == nil {
+		m = map[string]string{}
+		*s = m
+	}
+	return m
+}
+
+type NodeAddRequestNodeListItemHardware struct {
+	CollectedAt OptNilDateTime                                            `json:"collected_at"`
+	CoreCount   OptInt                                                    `json:"core_count"`
+	CPUCount    OptInt                                                    `json:"cpu_count"`
+	CPUModel    OptString                                                 `json:"cpu_model"`
+	Dimms       OptNilNilNodeAddRequestNodeListItemHardwareDimmsItemArray `json:"dimms"`
+	Disks       OptNilNilNodeAddRequestNodeListItemHardwareDisksItemArray `json:"disks"`
+	MemoryMib   OptInt                                                    `json:"memory_mib"`
+	Missing     OptNilStringArray                                         `json:"missing"`
+	Nics        OptNilNilNodeAddRequestNodeListItemHardwareNicsItemArray  `json:"nics"`
+}
+
+// GetCollectedAt returns the value of CollectedAt.
+func (s *NodeAddRequestNodeListItemHardware) GetCollectedAt() OptNilDateTime {
+	return s.CollectedAt
+}
+
+// GetCoreCount returns the value of CoreCount.
+func (s *NodeAddRequestNodeListItemHardware) GetCoreCount() OptInt {
+	return s.CoreCount
+}
+
+// GetCPUCount returns the value of CPUCount.
+func (s *NodeAddRequestNodeListItemHardware) GetCPUCount() OptInt {
+	return s.CPUCount
+}
+
+// GetCPUModel returns the value of CPUModel.
+func (s *NodeAddRequestNodeListItemHardware) GetCPUModel() OptString {
+	return s.CPUModel
+}
+
+// GetDimms returns the value of Dimms.
+func (s *NodeAddRequestNodeListItemHardware) GetDimms() OptNilNilNodeAddRequestNodeListItemHardwareDimmsItemArray {
+	return s.Dimms
+}
+
+// GetDisks returns the value of Disks.
+func (s *NodeAddRequestNodeListItemHardware) GetDisks() OptNilNilNodeAddRequestNodeListItemHardwareDisksItemArray {
+	return s.Disks
+}
+
+// GetMemoryMib returns the value of MemoryMib.
+func (s *NodeAddRequestNodeListItemHardware) GetMemoryMib() OptInt {
+	return s.MemoryMib
+}
+
+// GetMissing returns the value of Missing.
+func (s *NodeAddRequestNodeListItemHardware) GetMissing() OptNilStringArray {
+	return s.Missing
+}
+
+// GetNics returns the value of Nics.
+func (s *NodeAddRequestNodeListItemHardware) GetNics() OptNilNilNodeAddRequestNodeListItemHardwareNicsItemArray {
+	return s.Nics
+}
+
+// SetCollectedAt sets the value of CollectedAt.
+func (s *NodeAddRequestNodeListItemHardware) SetCollectedAt(val OptNilDateTime) {
+	s.CollectedAt = val
+}
+
+// SetCoreCount sets the value of CoreCount.
+func (s *NodeAddRequestNodeListItemHardware) SetCoreCount(val OptInt) {
+	s.CoreCount = val
+}
+
+// SetCPUCount sets the value of CPUCount.
+func (s *NodeAddRequestNodeListItemHardware) SetCPUCount(val OptInt) {
+	s.CPUCount = val
+}
+
+// SetCPUModel sets the value of CPUModel.
+func (s *NodeAddRequestNodeListItemHardware) SetCPUModel(val OptString) {
+	s.CPUModel = val
+}
+
+// SetDimms sets the value of Dimms.
+func (s *NodeAddRequestNodeListItemHardware) SetDimms(val OptNilNilNodeAddRequestNodeListItemHardwareDimmsItemArray) {
+	s.Dimms = val
+}
+
+// SetDisks sets the value of Disks.
+func (s *NodeAddRequestNodeListItemHardware) SetDisks(val OptNilNilNodeAddRequestNodeListItemHardwareDisksItemArray) {
+	s.Disks = val
+}
+
+// SetMemoryMib sets the value of MemoryMib.
+func (s *NodeAddRequestNodeListItemHardware) SetMemoryMib(val OptInt) {
+	s.MemoryMib = val
+}
+
+// SetMissing sets the value of Missing.
+func (s *NodeAddRequestNodeListItemHardware) SetMissing(val OptNilStringArray) {
+	s.Missing = val
+}
+
+// SetNics sets the value of Nics.
+func (s *NodeAddRequestNodeListItemHardware) SetNics(val OptNilNilNodeAddRequestNodeListItemHardwareNicsItemArray) {
+	s.Nics = val
+}
+
+type NodeAddRequestNodeListItemHardwareDimmsItem struct {
+	SizeMib OptInt    `json:"size_mib"`
+	Slot    OptString `json:"slot"`
+}
+
+// GetSizeMib returns the value of SizeMib.
+func (s *NodeAddRequestNodeListItemHardwareDimmsItem) GetSizeMib() OptInt {
+	return s.SizeMib
+}
+
+// GetSlot returns the value of Slot.
+func (s *NodeAddRequestNodeListItemHardwareDimmsItem) GetSlot() OptString {
+	return s.Slot
+}
+
+// SetSizeMib sets the value of SizeMib.
+func (s *NodeAddRequestNodeListItemHardwareDimmsItem) SetSizeMib(val OptInt) {
+	s.SizeMib = val
+}
+
+// SetSlot sets the value of Slot.
+func (s *NodeAddRequestNodeListItemHardwareDimmsItem) SetSlot(val OptString) {
+	s.Slot = val
+}
+
+type NodeAddRequestNodeListItemHardwareDisksItem struct {
+	Model     OptString `json:"model"`
+	Name      OptString `json:"name"`
+	Serial    OptString `json:"serial"`
+	SizeBytes OptInt64  `json:"size_bytes"`
+}
+
+// GetModel returns the value of Model.
+func (s *NodeAddRequestNodeListItemHardwareDisksItem) GetModel() OptString {
+	return s.Model
+}
+
+// GetName returns the value of Name.
+func (s *NodeAddRequestNodeListItemHardwareDisksItem) GetName() OptString {
+	return s.Name
+}
+
+// GetSerial returns the value of Serial.
+func (s *NodeAddRequestNodeListItemHardwareDisksItem) GetSerial() OptString {
+	return s.Serial
+}
+
+// GetSizeBytes returns the value of SizeBytes.
+func (s *NodeAddRequestNodeListItemHardwareDisksItem) GetSizeBytes() OptInt64 {
+	return s.SizeBytes
+}
+
+// SetModel sets the value of Model.
+func (s *NodeAddRequestNodeListItemHardwareDisksItem) SetModel(val OptString) {
+	s.Model = val
+}
+
+// SetName sets the value of Name.
+func (s *NodeAddRequestNodeListItemHardwareDisksItem) SetName(val OptString) {
+	s.Name = val
+}
+
+// SetSerial sets the value of Serial.
+func (s *NodeAddRequestNodeListItemHardwareDisksItem) SetSerial(val OptString) {
+	s.Serial = val
+}
+
+// SetSizeBytes sets the value of SizeBytes.
+func (s *NodeAddRequestNodeListItemHardwareDisksItem) SetSizeBytes(val OptInt64) {
+	s.SizeBytes = val
+}
+
+type NodeAddRequestNodeListItemHardwareNicsItem struct {
+	MAC  OptString `json:"mac"`
+	Name OptString `json:"name"`
+}
+
+// GetMAC returns the value of MAC.
+func (s *NodeAddRequestNodeListItemHardwareNicsItem) GetMAC() OptString {
+	return s.MAC
+}
+
+// GetName returns the value of Name.
+func (s *NodeAddRequestNodeListItemHardwareNicsItem) GetName() OptString {
+	return s.Name
+}
+
+// SetMAC sets the value of MAC.
+func (s *NodeAddRequestNodeListItemHardwareNicsItem) SetMAC(val OptString) {
+	s.MAC = val
+}
+
+// SetName sets the value of Name.
+func (s *NodeAddRequestNodeListItemHardwareNicsItem) SetName(val OptString) {
+	s.Name = val
+}
+
+type NodeAddRequestNodeListItemInterfacesItem struct {
+	Addresses []NodeAddRequestNodeListItemInterfacesItemAddressesItem `json:"addresses"`
+	Bmc       OptBool                                                 `json:"bmc"`
+	Fqdn      OptString                                               `json:"fqdn"`
+	ID        OptNilInt64                                             `json:"id"`
+	Ifname    OptString                                               `json:"ifname"`
+	IP        OptString                                               `json:"ip"`
+	MAC       OptString                                               `json:"mac"`
+	Mtu       OptInt                                                  `json:"mtu"`
+	Parent    OptString                                               `json:"parent"`
+	Port      OptInt                                                  `json:"port"`
+	Switch    OptString                                               `json:"switch"`
+	Vlan      OptInt                                                  `json:"vlan"`
+}
+
+// GetAddresses returns the value of Addresses.
+func (s *NodeAddRequestNodeListItemInterfacesItem) GetAddresses() []NodeAddRequestNodeListItemInterfacesItemAddressesItem {
+	return s.Addresses
+}
+
+// GetBmc returns the value of Bmc.
+func (s *NodeAddRequestNodeListItemInterfacesItem) GetBmc() OptBool {
+	return s.Bmc
+}
+
+// GetFqdn returns the value of Fqdn.
+func (s *NodeAddRequestNodeListItemInterfacesItem) GetFqdn() OptString {
+	return s.Fqdn
+}
+
+// GetID returns the value of ID.
+func (s *NodeAddRequestNodeListItemInterfacesItem) GetID() OptNilInt64 {
+	return s.ID
+}
+
+// GetIfname returns the value of Ifname.
+func (s *NodeAddRequestNodeListItemInterfacesItem) GetIfname() OptString {
+	return s.Ifname
+}
+
+// GetIP returns the value of IP.
+func (s *NodeAddRequestNodeListItemInterfacesItem) GetIP() OptString {
+	return s.IP
+}
+
+// GetMAC returns the value of MAC.
+func (s *NodeAddRequestNodeListItemInterfacesItem) GetMAC() OptString {
+	return s.MAC
+}
+
+// GetMtu returns the value of Mtu.
+func (s *NodeAddRequestNodeListItemInterfacesItem) GetMtu() OptInt {
+	return s.Mtu
+}
+
+// GetParent returns the value of Parent.
+func (s *NodeAddRequestNodeListItemInterfacesItem) GetParent() OptString {
+	return s.Parent
+}
+
+// GetPort returns the value of Port.
+func (s *NodeAddRequestNodeListItemInterfacesItem) GetPort() OptInt {
+	return s.Port
+}
+
+// GetSwitch returns the value of Switch.
+func (s *NodeAddRequestNodeListItemInterfacesItem) GetSwitch() OptString {
+	return s.Switch
+}
+
+// GetVlan returns the value of Vlan.
+func (s *NodeAddRequestNodeListItemInterfacesItem) GetVlan() OptInt {
+	return s.Vlan
+}
+
+// SetAddresses sets the value of Addresses.
+func (s *NodeAddRequestNodeListItemInterfacesItem) SetAddresses(val []NodeAddRequestNodeListItemInterfacesItemAddressesItem) {
+	s.Addresses = val
+}
+
+// SetBmc sets the value of Bmc.
+func (s *NodeAddRequestNodeListItemInterfacesItem) SetBmc(val OptBool) {
+	s.Bmc = val
+}
+
+// SetFqdn sets the value of Fqdn.
+func (s *NodeAddRequestNodeListItemInterfacesItem) SetFqdn(val OptString) {
+	s.Fqdn = val
+}
+
+// SetID sets the value of ID.
+func (s *NodeAddRequestNodeListItemInterfacesItem) SetID(val OptNilInt64) {
+	s.ID = val
+}
+
+// SetIfname sets the value of Ifname.
+func (s *NodeAddRequestNodeListItemInterfacesItem) SetIfname(val OptString) {
+	s.Ifname = val
+}
+
+// SetIP sets the value of IP.
+func (s *NodeAddRequestNodeListItemInterfacesItem) SetIP(val OptString) {
+	s.IP = val
+}
+
+// SetMAC sets the value of MAC.
+func (s *NodeAddRequestNodeListItemInterfacesItem) SetMAC(val OptString) {
+	s.MAC = val
+}
+
+// SetMtu sets the value of Mtu.
+func (s *NodeAddRequestNodeListItemInterfacesItem) SetMtu(val OptInt) {
+	s.Mtu = val
+}
+
+// SetParent sets the value of Parent.
+func (s *NodeAddRequestNodeListItemInterfacesItem) SetParent(val OptString) {
+	s.Parent = val
+}
+
+// SetPort sets the value of Port.
+func (s *NodeAddRequestNodeListItemInterfacesItem) SetPort(val OptInt) {
+	s.Port = val
+}
+
+// SetSwitch sets the value of Switch.
+func (s *NodeAddRequestNodeListItemInterfacesItem) SetSwitch(val OptString) {
+	s.Switch = val
+}
+
+// SetVlan sets the value of Vlan.
+func (s *NodeAddRequestNodeListItemInterfacesItem) SetVlan(val OptInt) {
+	s.Vlan = val
+}
+
+type NodeAddRequestNodeListItemInterfacesItemAddressesItem struct {
+	Fqdn    OptString `json:"fqdn"`
+	IP      OptString `json:"ip"`
+	Primary OptBool   `json:"primary"`
+}
+
+// GetFqdn returns the value of Fqdn.
+func (s *NodeAddRequestNodeListItemInterfacesItemAddressesItem) GetFqdn() OptString {
+	return s.Fqdn
+}
+
+// GetIP returns the value of IP.
+func (s *NodeAddRequestNodeListItemInterfacesItemAddressesItem) GetIP() OptString {
+	return s.IP
+}
+
+// GetPrimary returns the value of Primary.
+func (s *NodeAddRequestNodeListItemInterfacesItemAddressesItem) GetPrimary() OptBool {
+	return s.Primary
+}
+
+// SetFqdn sets the value of Fqdn.
+func (s *NodeAddRequestNodeListItemInterfacesItemAddressesItem) SetFqdn(val OptString) {
+	s.Fqdn = val
+}
+
+// SetIP sets the value of IP.
+func (s *NodeAddRequestNodeListItemInterfacesItemAddressesItem) SetIP(val OptString) {
+	s.IP = val
+}
+
+// SetPrimary sets the value of Primary.
+func (s *NodeAddRequestNodeListItemInterfacesItemAddressesItem) SetPrimary(val OptBool) {
+	s.Primary = val
+}
+
+type NodeAddRequestNodeListItemInventory struct {
+	BiosVersion OptString                                         `json:"bios_version"`
+	BmcFirmware OptString                                         `json:"bmc_firmware"`
+	CapturedAt  OptNilDateTime                                    `json:"captured_at"`
+	NicFirmware OptNodeAddRequestNodeListItemInventoryNicFirmware `json:"nic_firmware"`
+}
+
+// GetBiosVersion returns the value of BiosVersion.
+func (s *NodeAddRequestNodeListItemInventory) GetBiosVersion() OptString {
+	return s.BiosVersion
+}
+
+// GetBmcFirmware returns the value of BmcFirmware.
+func (s *NodeAddRequestNodeListItemInventory) GetBmcFirmware() OptString {
+	return s.BmcFirmware
+}
+
+// GetCapturedAt returns the value of CapturedAt.
+func (s *NodeAddRequestNodeListItemInventory) GetCapturedAt() OptNilDateTime {
+	return s.CapturedAt
+}
+
+// GetNicFirmware returns the value of NicFirmware.
+func (s *NodeAddRequestNodeListItemInventory) GetNicFirmware() OptNodeAddRequestNodeListItemInventoryNicFirmware {
+	return s.NicFirmware
+}
+
+// SetBiosVersion sets the value of BiosVersion.
+func (s *NodeAddRequestNodeListItemInventory) SetBiosVersion(val OptString) {
+	s.BiosVersion = val
+}
+
+// SetBmcFirmware sets the value of BmcFirmware.
+func (s *NodeAddRequestNodeListItemInventory) SetBmcFirmware(val OptString) {
+	s.BmcFirmware = val
+}
+
+// SetCapturedAt sets the value of CapturedAt.
+func (s *NodeAddRequestNodeListItemInventory) SetCapturedAt(val OptNilDateTime) {
+	s.CapturedAt = val
+}
+
+// SetNicFirmware sets the value of NicFirmware.
+func (s *NodeAddRequestNodeListItemInventory) SetNicFirmware(val OptNodeAddRequestNodeListItemInventoryNicFirmware) {
+	s.NicFirmware = val
+}
+
+type NodeAddRequestNodeListItemInventoryNicFirmware map[string]string
+
+func (s *NodeAddRequestNodeListItemInventoryNicFirmware) init() NodeAddRequestNodeListItemInventoryNicFirmware {
+	m := *s
+	if m == nil {
+		m = map[string]string{}
+		*s = m
+	}
+	return m
+}
+
+// NodeBootImageRequest schema.
+// Ref: #/components/schemas/NodeBootImageRequest
+type NodeBootImageRequest struct {
+	Image OptString `json:"image"`
+	// Only change the nodes if each is assigned one of these boot images, an empty name matching nodes
+	// without one. Otherwise 409 Conflict is answered and no node is changed.
+	OnlyIfCurrent []string `json:"only_if_current"`
+}
+
+// GetImage returns the value of Image.
+func (s *NodeBootImageRequest) GetImage() OptString {
+	return s.Image
+}
+
+// GetOnlyIfCurrent returns the value of OnlyIfCurrent.
+func (s *NodeBootImageRequest) GetOnlyIfCurrent() []string {
+	return s.OnlyIfCurrent
+}
+
+// SetImage sets the value of Image.
+func (s *NodeBootImageRequest) SetImage(val OptString) {
+	s.Image = val
+}
+
+// SetOnlyIfCurrent sets the value of OnlyIfCurrent.
+func (s *NodeBootImageRequest) SetOnlyIfCurrent(val []string) {
+	s.OnlyIfCurrent = val
+}
+
+// NodeBootTokenResponse schema.
+// Ref: #/components/schemas/NodeBootTokenResponse
+type NodeBootTokenResponse struct {
+	Nodes []NodeBootTokenResponseNodesItem `json:"nodes"`
+}
+
+// GetNodes returns the value of Nodes.
+func (s *NodeBootTokenResponse) GetNodes() []NodeBootTokenResponseNodesItem {
+	return s.Nodes
+}
+
+// SetNodes sets the value of Nodes.
+func (s *NodeBootTokenResponse) SetNodes(val []NodeBootTokenResponseNodesItem) {
+	s.Nodes = val
+}
+
+type NodeBootTokenResponseNodesItem struct {
+	Name  OptString `json:"name"`
+	Token OptString `json:"token"`
+}
+
+// GetName returns the value of Name.
+func (s *NodeBootTokenResponseNodesItem) GetName() OptString {
+	return s.Name
+}
+
+// GetToken returns the value of Token.
+func (s *NodeBootTokenResponseNodesItem) GetToken() OptString {
+	return s.Token
+}
+
+// SetName sets the value of Name.
+func (s *NodeBootTokenResponseNodesItem) SetName(val OptString) {
+	s.Name = val
+}
+
+// SetToken sets the value of Token.
+func (s *NodeBootTokenResponseNodesItem) SetToken(val OptString) {
+	s.Token = val
+}
+
+// NodeClientCertRequest schema.
+// Ref: #/components/schemas/NodeClientCertRequest
+type NodeClientCertRequest struct {
+	Certs []NodeClientCertRequestCertsItem `json:"certs"`
+	// How long the keys are held for pickup, defaults to 1h.
+	TTL OptString `json:"ttl"`
+}
+
+// GetCerts returns the value of Certs.
+func (s *NodeClientCertRequest) GetCerts() []NodeClientCertRequestCertsItem {
+	return s.Certs
+}
+
+// GetTTL returns the value of TTL.
+func (s *NodeClientCertRequest) GetTTL() OptString {
+	return s.TTL
+}
+
+// SetCerts sets the value of Certs.
+func (s *NodeClientCertRequest) SetCerts(val []NodeClientCertRequestCertsItem) {
+	s.Certs = val
+}
+
+// SetTTL sets the value of TTL.
+func (s *NodeClientCertRequest) SetTTL(val OptString) {
+	s.TTL = val
+}
+
+type NodeClientCertRequestCertsItem struct {
+	Cert OptString `json:"cert"`
+	Key  OptString `json:"key"`
+	Name OptString `json:"name"`
+}
+
+// GetCert returns the value of Cert.
+func (s *NodeClientCertRequestCertsItem) GetCert() OptString {
+	return s.Cert
+}
+
+// GetKey returns the value of Key.
+func (s *NodeClientCertRequestCertsItem) GetKey() OptString {
+	return s.Key
+}
+
+// GetName returns the value of Name.
+func (s *NodeClientCertRequestCertsItem) GetName() OptString {
+	return s.Name
+}
+
+// SetCert sets the value of Cert.
+func (s *NodeClientCertRequestCertsItem) SetCert(val OptString) {
+	s.Cert = val
+}
+
+// SetKey sets the value of Key.
+func (s *NodeClientCertRequestCertsItem) SetKey(val OptString) {
+	s.Key = val
+}
+
+// SetName sets the value of Name.
+func (s *NodeClientCertRequestCertsItem) SetName(val OptString) {
+	s.Name = val
+}
+
+// NodeCredentialsRequest schema.
+// Ref: #/components/schemas/NodeCredentialsRequest
+type NodeCredentialsRequest struct {
+	// Kind of credentials, defaults to bmc.
+	Kind     OptString `json:"kind"`
+	Password string    `json:"password"`
+	Username string    `json:"username"`
+}
+
+// GetKind returns the value of Kind.
+func (s *NodeCredentialsRequest) GetKind() OptString {
+	return s.Kind
+}
+
+// GetPassword returns the value of Password.
+func (s *NodeCredentialsRequest) GetPassword() string {
+	return s.Password
+}
+
+// GetUsername returns the value of Username.
+func (s *NodeCredentialsRequest) GetUsername() string {
+	return s.Username
+}
+
+// SetKind sets the value of Kind.
+func (s *NodeCredentialsRequest) SetKind(val OptString) {
+	s.Kind = val
+}
+
+// SetPassword sets the value of Password.
+func (s *NodeCredentialsRequest) SetPassword(val string) {
+	s.Password = val
+}
+
+// SetUsername sets the value of Username.
+func (s *NodeCredentialsRequest) SetUsername(val string) {
+	s.Username = val
+}
+
+// NodeFileRequest schema.
+// Ref: #/components/schemas/NodeFileRequest
+type NodeFileRequest struct {
+	// Base64 encoded content of the file.
+	Content OptString `json:"content"`
+	Name    string    `json:"name"`
+}
+
+// GetContent returns the value of Content.
+func (s *NodeFileRequest) GetContent() OptString {
+	return s.Content
+}
+
+// GetName returns the value of Name.
+func (s *NodeFileRequest) GetName() string {
+	return s.Name
+}
+
+// SetContent sets the value of Content.
+func (s *NodeFileRequest) SetContent(val OptString) {
+	s.Content = val
+}
+
+// SetName sets the value of Name.
+func (s *NodeFileRequest) SetName(val string) {
+	s.Name = val
+}
+
+// NodeFirmwareRequest schema.
+// Ref: #/components/schemas/NodeFirmwareRequest
+type NodeFirmwareRequest struct {
+	// Firmware overriding the firmware of the client architecture, cleared when empty.
+	Firmware OptString `json:"firmware"`
+}
+
+// GetFirmware returns the value of Firmware.
+func (s *NodeFirmwareRequest) GetFirmware() OptString {
+	return s.Firmware
+}
+
+// SetFirmware sets the value of Firmware.
+func (s *NodeFirmwareRequest) SetFirmware(val OptString) {
+	s.Firmware = val
+}
+
+// NodeNextIPRequest schema.
+// Ref: #/components/schemas/NodeNextIPRequest
+type NodeNextIPRequest struct {
+	// Number of addresses, defaults to 1.
+	Count OptInt `json:"count"`
+	// Reserve the addresses for this long so other allocations skip them, not reserved when empty.
+	Reserve OptString `json:"reserve"`
+	Subnet  string    `json:"subnet"`
+}
+
+// GetCount returns the value of Count.
+func (s *NodeNextIPRequest) GetCount() OptInt {
+	return s.Count
+}
+
+// GetReserve returns the value of Reserve.
+func (s *NodeNextIPRequest) GetReserve() OptString {
+	return s.Reserve
+}
+
+// GetSubnet returns the value of Subnet.
+func (s *NodeNextIPRequest) GetSubnet() string {
+	return s.Subnet
+}
+
+// SetCount sets the value of Count.
+func (s *NodeNextIPRequest) SetCount(val OptInt) {
+	s.Count = val
+}
+
+// SetReserve sets the value of Reserve.
+func (s *NodeNextIPRequest) SetReserve(val OptString) {
+	s.Reserve = val
+}
+
+// SetSubnet sets the value of Subnet.
+func (s *NodeNextIPRequest) SetSubnet(val string) {
+	s.Subnet = val
+}
+
+// NodeNextIPResponse schema.
+// Ref: #/components/schemas/NodeNextIPResponse
+type NodeNextIPResponse struct {
+	Ips           []string       `json:"ips"`
+	ReservedUntil OptNilDateTime `json:"reserved_until"`
+	Subnet        OptString      `json:"subnet"`
+}
+
+// GetIps returns the value of Ips.
+func (s *NodeNextIPResponse) GetIps() []string {
+	return s.Ips
+}
+
+// GetReservedUntil returns the value of ReservedUntil.
+func (s *NodeNextIPResponse) GetReservedUntil() OptNilDateTime {
+	return s.ReservedUntil
+}
+
+// GetSubnet returns the value of Subnet.
+func (s *NodeNextIPResponse) GetSubnet() OptString {
+	return s.Subnet
+}
+
+// SetIps sets the value of Ips.
+func (s *NodeNextIPResponse) SetIps(val []string) {
+	s.Ips = val
+}
+
+// SetReservedUntil sets the value of ReservedUntil.
+func (s *NodeNextIPResponse) SetReservedUntil(val OptNilDateTime) {
+	s.ReservedUntil = val
+}
+
+// SetSubnet sets the value of Subnet.
+func (s *NodeNextIPResponse) SetSubnet(val OptString) {
+	s.Subnet = val
+}
+
+// NodeProvisionRequest schema.
+// Ref: #/components/schemas/NodeProvisionRequest
+type NodeProvisionRequest struct {
+	Provision OptBool `json:"provision"`
+}
+
+// GetProvision returns the value of Provision.
+func (s *NodeProvisionRequest) GetProvision() OptBool {
+	return s.Provision
+}
+
+// SetProvision sets the value of Provision.
+func (s *NodeProvisionRequest) SetProvision(val OptBool) {
+	s.Provision = val
+}
+
+// NodeRenameRequest schema.
+// Ref: #/components/schemas/NodeRenameRequest
+type NodeRenameRequest struct {
+	// Pattern for the boot interface FQDN. {name} is replaced with the new name.
+	FqdnPattern OptString `json:"fqdn_pattern"`
+	Name        string    `json:"name"`
+	NewName     string    `json:"new_name"`
+}
+
+// GetFqdnPattern returns the value of FqdnPattern.
+func (s *NodeRenameRequest) GetFqdnPattern() OptString {
+	return s.FqdnPattern
+}
+
+// GetName returns the value of Name.
+func (s *NodeRenameRequest) GetName() string {
+	return s.Name
+}
+
+// GetNewName returns the value of NewName.
+func (s *NodeRenameRequest) GetNewName() string {
+	return s.NewName
+}
+
+// SetFqdnPattern sets the value of FqdnPattern.
+func (s *NodeRenameRequest) SetFqdnPattern(val OptString) {
+	s.FqdnPattern = val
+}
+
+// SetName sets the value of Name.
+func (s *NodeRenameRequest) SetName(val string) {
+	s.Name = val
+}
+
+// SetNewName sets the value of NewName.
+func (s *NodeRenameRequest) SetNewName(val string) {
+	s.NewName = val
+}
+
+// NodeStateRequest schema.
+// Ref: #/components/schemas/NodeStateRequest
+type NodeStateRequest struct {
+	// New, ready-to-provision, installing, production, drained or retired.
+	State string `json:"state"`
+}
+
+// GetState returns the value of State.
+func (s *NodeStateRequest) GetState() string {
+	return s.State
+}
+
+// SetState sets the value of State.
+func (s *NodeStateRequest) SetState(val string) {
+	s.State = val
+}
+
+// NodeTagsRequest schema.
+// Ref: #/components/schemas/NodeTagsRequest
+type NodeTagsRequest struct {
+	// Comma separated list of tags.
+	Tags OptString `json:"tags"`
+}
+
+// GetTags returns the value of Tags.
+func (s *NodeTagsRequest) GetTags() OptString {
+	return s.Tags
+}
+
+// SetTags sets the value of Tags.
+func (s *NodeTagsRequest) SetTags(val OptString) {
+	s.Tags = val
+}
+
+// NodeTokenRequest schema.
+// Ref: #/components/schemas/NodeTokenRequest
+type NodeTokenRequest struct {
+	Token string `json:"token"`
+}
+
+// GetToken returns the value of Token.
+func (s *NodeTokenRequest) GetToken() string {
+	return s.Token
+}
+
+// SetToken sets the value of Token.
+func (s *NodeTokenRequest) SetToken(val string) {
+	s.Token = val
+}
+
+// NewOptBmcJobDeleteRequestNodeJobList returns new OptBmcJobDeleteRequestNodeJobList with value set to v.
+func NewOptBmcJobDeleteRequestNodeJobList(v BmcJobDeleteRequestNodeJobList) OptBmcJobDeleteRequestNodeJobList {
+	return OptBmcJobDeleteRequestNodeJobList{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptBmcJobDeleteRequestNodeJobList is optional BmcJobDeleteRequestNodeJobList.
+type OptBmcJobDeleteRequestNodeJobList struct {
+	Value BmcJobDeleteRequestNodeJobList
+	Set   bool
+}
+
+// IsSet returns true if OptBmcJobDeleteRequestNodeJobList was set.
+func (o OptBmcJobDeleteRequestNodeJobList) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptBmcJobDeleteRequestNodeJobList) Reset() {
+	var v BmcJobDeleteRequestNodeJobList
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptBmcJobDeleteRequestNodeJobList) SetTo(v BmcJobDeleteRequestNodeJobList) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptBmcJobDeleteRequestNodeJobList) Get() (v BmcJobDeleteRequestNodeJobList, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptBmcJobDeleteRequestNodeJobList) Or(d BmcJobDeleteRequestNodeJobList) BmcJobDeleteRequestNodeJobList {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptBool returns new OptBool with value set to v.
+func NewOptBool(v bool) OptBool {
+	return OptBool{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptBool is optional bool.
+type OptBool struct {
+	Value bool
+	Set   bool
+}
+
+// IsSet returns true if OptBool was set.
+func (o OptBool) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptBool) Reset() {
+	var v bool
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptBool) SetTo(v bool) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptBool) Get() (v bool, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptBool) Or(d bool) bool {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptDataDumpHostsItemBondsItemOptions returns new OptDataDumpHostsItemBondsItemOptions with value set to v.
+func NewOptDataDumpHostsItemBondsItemOptions(v DataDumpHostsItemBondsItemOptions) OptDataDumpHostsItemBondsItemOptions {
+	return OptDataDumpHostsItemBondsItemOptions{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptDataDumpHostsItemBondsItemOptions is optional DataDumpHostsItemBondsItemOptions.
+type OptDataDumpHostsItemBondsItemOptions struct {
+	Value DataDumpHostsItemBondsItemOptions
+	Set   bool
+}
+
+// IsSet returns true if OptDataDumpHostsItemBondsItemOptions was set.
+func (o OptDataDumpHostsItemBondsItemOptions) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptDataDumpHostsItemBondsItemOptions) Reset() {
+	var v DataDumpHostsItemBondsItemOptions
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptDataDumpHostsItemBondsItemOptions) SetTo(v DataDumpHostsItemBondsItemOptions) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptDataDumpHostsItemBondsItemOptions) Get() (v DataDumpHostsItemBondsItemOptions, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptDataDumpHostsItemBondsItemOptions) Or(d DataDumpHostsItemBondsItemOptions) DataDumpHostsItemBondsItemOptions {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptDataDumpHostsItemInventoryNicFirmware returns new OptDataDumpHostsItemInventoryNicFirmware with value set to v.
+func NewOptDataDumpHostsItemInventoryNicFirmware(v DataDumpHostsItemInventoryNicFirmware) OptDataDumpHostsItemInventoryNicFirmware {
+	return OptDataDumpHostsItemInventoryNicFirmware{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptDataDumpHostsItemInventoryNicFirmware is optional DataDumpHostsItemInventoryNicFirmware.
+type OptDataDumpHostsItemInventoryNicFirmware struct {
+	Value DataDumpHostsItemInventoryNicFirmware
+	Set   bool
+}
+
+// IsSet returns true if OptDataDumpHostsItemInventoryNicFirmware was set.
+func (o OptDataDumpHostsItemInventoryNicFirmware) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptDataDumpHostsItemInventoryNicFirmware) Reset() {
+	var v DataDumpHostsItemInventoryNicFirmware
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptDataDumpHostsItemInventoryNicFirmware) SetTo(v DataDumpHostsItemInventoryNicFirmware) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptDataDumpHostsItemInventoryNicFirmware) Get() (v DataDumpHostsItemInventoryNicFirmware, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptDataDumpHostsItemInventoryNicFirmware) Or(d DataDumpHostsItemInventoryNicFirmware) DataDumpHostsItemInventoryNicFirmware {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptDataLoadRequestDump returns new OptDataLoadRequestDump with value set to v.
+func NewOptDataLoadRequestDump(v DataLoadRequestDump) OptDataLoadRequestDump {
+	return OptDataLoadRequestDump{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptDataLoadRequestDump is optional DataLoadRequestDump.
+type OptDataLoadRequestDump struct {
+	Value DataLoadRequestDump
+	Set   bool
+}
+
+// IsSet returns true if OptDataLoadRequestDump was set.
+func (o OptDataLoadRequestDump) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptDataLoadRequestDump) Reset() {
+	var v DataLoadRequestDump
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptDataLoadRequestDump) SetTo(v DataLoadRequestDump) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptDataLoadRequestDump) Get() (v DataLoadRequestDump, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptDataLoadRequestDump) Or(d DataLoadRequestDump) DataLoadRequestDump {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptDataLoadRequestDumpHostsItemBondsItemOptions returns new OptDataLoadRequestDumpHostsItemBondsItemOptions with value set to v.
+func NewOptDataLoadRequestDumpHostsItemBondsItemOptions(v DataLoadRequestDumpHostsItemBondsItemOptions) OptDataLoadRequestDumpHostsItemBondsItemOptions {
+	return OptDataLoadRequestDumpHostsItemBondsItemOptions{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptDataLoadRequestDumpHostsItemBondsItemOptions is optional DataLoadRequestDumpHostsItemBondsItemOptions.
+type OptDataLoadRequestDumpHostsItemBondsItemOptions struct {
+	Value DataLoadRequestDumpHostsItemBondsItemOptions
+	Set   bool
+}
+
+// IsSet returns true if OptDataLoadRequestDumpHostsItemBondsItemOptions was set.
+func (o OptDataLoadRequestDumpHostsItemBondsItemOptions) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptDataLoadRequestDumpHostsItemBondsItemOptions) Reset() {
+	var v DataLoadRequestDumpHostsItemBondsItemOptions
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptDataLoadRequestDumpHostsItemBondsItemOptions) SetTo(v DataLoadRequestDumpHostsItemBondsItemOptions) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptDataLoadRequestDumpHostsItemBondsItemOptions) Get() (v DataLoadRequestDumpHostsItemBondsItemOptions, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptDataLoadRequestDumpHostsItemBondsItemOptions) Or(d DataLoadRequestDumpHostsItemBondsItemOptions) DataLoadRequestDumpHostsItemBondsItemOptions {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptDataLoadRequestDumpHostsItemInventoryNicFirmware returns new OptDataLoadRequestDumpHostsItemInventoryNicFirmware with value set to v.
+func NewOptDataLoadRequestDumpHostsItemInventoryNicFirmware(v DataLoadRequestDumpHostsItemInventoryNicFirmware) OptDataLoadRequestDumpHostsItemInventoryNicFirmware {
+	return OptDataLoadRequestDumpHostsItemInventoryNicFirmware{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptDataLoadRequestDumpHostsItemInventoryNicFirmware is optional DataLoadRequestDumpHostsItemInventoryNicFirmware.
+type OptDataLoadRequestDumpHostsItemInventoryNicFirmware struct {
+	Value DataLoadRequestDumpHostsItemInventoryNicFirmware
+	Set   bool
+}
+
+// IsSet returns true if OptDataLoadRequestDumpHostsItemInventoryNicFirmware was set.
+func (o OptDataLoadRequestDumpHostsItemInventoryNicFirmware) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptDataLoadRequestDumpHostsItemInventoryNicFirmware) Reset() {
+	var v DataLoadRequestDumpHostsItemInventoryNicFirmware
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptDataLoadRequestDumpHostsItemInventoryNicFirmware) SetTo(v DataLoadRequestDumpHostsItemInventoryNicFirmware) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptDataLoadRequestDumpHostsItemInventoryNicFirmware) Get() (v DataLoadRequestDumpHostsItemInventoryNicFirmware, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptDataLoadRequestDumpHostsItemInventoryNicFirmware) Or(d DataLoadRequestDumpHostsItemInventoryNicFirmware) DataLoadRequestDumpHostsItemInventoryNicFirmware {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptDataLoadResponseDiff returns new OptDataLoadResponseDiff with value set to v.
+func NewOptDataLoadResponseDiff(v DataLoadResponseDiff) OptDataLoadResponseDiff {
+	return OptDataLoadResponseDiff{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptDataLoadResponseDiff is optional DataLoadResponseDiff.
+type OptDataLoadResponseDiff struct {
+	Value DataLoadResponseDiff
+	Set   bool
+}
+
+// IsSet returns true if OptDataLoadResponseDiff was set.
+func (o OptDataLoadResponseDiff) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptDataLoadResponseDiff) Reset() {
+	var v DataLoadResponseDiff
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptDataLoadResponseDiff) SetTo(v DataLoadResponseDiff) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptDataLoadResponseDiff) Get() (v DataLoadResponseDiff, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptDataLoadResponseDiff) Or(d DataLoadResponseDiff) DataLoadResponseDiff {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptDataLoadResponseDiffDNSRecords returns new OptDataLoadResponseDiffDNSRecords with value set to v.
+func NewOptDataLoadResponseDiffDNSRecords(v DataLoadResponseDiffDNSRecords) OptDataLoadResponseDiffDNSRecords {
+	return OptDataLoadResponseDiffDNSRecords{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptDataLoadResponseDiffDNSRecords is optional DataLoadResponseDiffDNSRecords.
+type OptDataLoadResponseDiffDNSRecords struct {
+	Value DataLoadResponseDiffDNSRecords
+	Set   bool
+}
+
+// IsSet returns true if OptDataLoadResponseDiffDNSRecords was set.
+func (o OptDataLoadResponseDiffDNSRecords) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptDataLoadResponseDiffDNSRecords) Reset() {
+	var v DataLoadResponseDiffDNSRecords
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptDataLoadResponseDiffDNSRecords) SetTo(v DataLoadResponseDiffDNSRecords) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptDataLoadResponseDiffDNSRecords) Get() (v DataLoadResponseDiffDNSRecords, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptDataLoadResponseDiffDNSRecords) Or(d DataLoadResponseDiffDNSRecords) DataLoadResponseDiffDNSRecords {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptDataLoadResponseDiffHosts returns new OptDataLoadResponseDiffHosts with value set to v.
+func NewOptDataLoadResponseDiffHosts(v DataLoadResponseDiffHosts) OptDataLoadResponseDiffHosts {
+	return OptDataLoadResponseDiffHosts{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptDataLoadResponseDiffHosts is optional DataLoadResponseDiffHosts.
+type OptDataLoadResponseDiffHosts struct {
+	Value DataLoadResponseDiffHosts
+	Set   bool
+}
+
+// IsSet returns true if OptDataLoadResponseDiffHosts was set.
+func (o OptDataLoadResponseDiffHosts) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptDataLoadResponseDiffHosts) Reset() {
+	var v DataLoadResponseDiffHosts
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptDataLoadResponseDiffHosts) SetTo(v DataLoadResponseDiffHosts) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptDataLoadResponseDiffHosts) Get() (v DataLoadResponseDiffHosts, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptDataLoadResponseDiffHosts) Or(d DataLoadResponseDiffHosts) DataLoadResponseDiffHosts {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptDataLoadResponseDiffImages returns new OptDataLoadResponseDiffImages with value set to v.
+func NewOptDataLoadResponseDiffImages(v DataLoadResponseDiffImages) OptDataLoadResponseDiffImages {
+	return OptDataLoadResponseDiffImages{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptDataLoadResponseDiffImages is optional DataLoadResponseDiffImages.
+type OptDataLoadResponseDiffImages struct {
+	Value DataLoadResponseDiffImages
+	Set   bool
+}
+
+// IsSet returns true if OptDataLoadResponseDiffImages was set.
+func (o OptDataLoadResponseDiffImages) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptDataLoadResponseDiffImages) Reset() {
+	var v DataLoadResponseDiffImages
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptDataLoadResponseDiffImages) SetTo(v DataLoadResponseDiffImages) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptDataLoadResponseDiffImages) Get() (v DataLoadResponseDiffImages, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptDataLoadResponseDiffImages) Or(d DataLoadResponseDiffImages) DataLoadResponseDiffImages {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptDateTime returns new OptDateTime with value set to v.
+func NewOptDateTime(v time.Time) OptDateTime {
+	return OptDateTime{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptDateTime is optional time.Time.
+type OptDateTime struct {
+	Value time.Time
+	Set   bool
+}
+
+// IsSet returns true if OptDateTime was set.
+func (o OptDateTime) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptDateTime) Reset() {
+	var v time.Time
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptDateTime) SetTo(v time.Time) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptDateTime) Get() (v time.Time, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptDateTime) Or(d time.Time) time.Time {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptEventJobMessagesItemRedfishError returns new OptEventJobMessagesItemRedfishError with value set to v.
+func NewOptEventJobMessagesItemRedfishError(v EventJobMessagesItemRedfishError) OptEventJobMessagesItemRedfishError {
+	return OptEventJobMessagesItemRedfishError{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptEventJobMessagesItemRedfishError is optional EventJobMessagesItemRedfishError.
+type OptEventJobMessagesItemRedfishError struct {
+	Value EventJobMessagesItemRedfishError
+	Set   bool
+}
+
+// IsSet returns true if OptEventJobMessagesItemRedfishError was set.
+func (o OptEventJobMessagesItemRedfishError) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptEventJobMessagesItemRedfishError) Reset() {
+	var v EventJobMessagesItemRedfishError
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptEventJobMessagesItemRedfishError) SetTo(v EventJobMessagesItemRedfishError) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptEventJobMessagesItemRedfishError) Get() (v EventJobMessagesItemRedfishError, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptEventJobMessagesItemRedfishError) Or(d EventJobMessagesItemRedfishError) EventJobMessagesItemRedfishError {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptEventJobMessagesItemRedfishErrorError returns new OptEventJobMessagesItemRedfishErrorError with value set to v.
+func NewOptEventJobMessagesItemRedfishErrorError(v EventJobMessagesItemRedfishErrorError) OptEventJobMessagesItemRedfishErrorError {
+	return OptEventJobMessagesItemRedfishErrorError{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptEventJobMessagesItemRedfishErrorError is optional EventJobMessagesItemRedfishErrorError.
+type OptEventJobMessagesItemRedfishErrorError struct {
+	Value EventJobMessagesItemRedfishErrorError
+	Set   bool
+}
+
+// IsSet returns true if OptEventJobMessagesItemRedfishErrorError was set.
+func (o OptEventJobMessagesItemRedfishErrorError) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptEventJobMessagesItemRedfishErrorError) Reset() {
+	var v EventJobMessagesItemRedfishErrorError
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptEventJobMessagesItemRedfishErrorError) SetTo(v EventJobMessagesItemRedfishErrorError) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptEventJobMessagesItemRedfishErrorError) Get() (v EventJobMessagesItemRedfishErrorError, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptEventJobMessagesItemRedfishErrorError) Or(d EventJobMessagesItemRedfishErrorError) EventJobMessagesItemRedfishErrorError {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptFirmwareUpdateReportInventoryNicFirmware returns new OptFirmwareUpdateReportInventoryNicFirmware with value set to v.
+func NewOptFirmwareUpdateReportInventoryNicFirmware(v FirmwareUpdateReportInventoryNicFirmware) OptFirmwareUpdateReportInventoryNicFirmware {
+	return OptFirmwareUpdateReportInventoryNicFirmware{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptFirmwareUpdateReportInventoryNicFirmware is optional FirmwareUpdateReportInventoryNicFirmware.
+type OptFirmwareUpdateReportInventoryNicFirmware struct {
+	Value FirmwareUpdateReportInventoryNicFirmware
+	Set   bool
+}
+
+// IsSet returns true if OptFirmwareUpdateReportInventoryNicFirmware was set.
+func (o OptFirmwareUpdateReportInventoryNicFirmware) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptFirmwareUpdateReportInventoryNicFirmware) Reset() {
+	var v FirmwareUpdateReportInventoryNicFirmware
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptFirmwareUpdateReportInventoryNicFirmware) SetTo(v FirmwareUpdateReportInventoryNicFirmware) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptFirmwareUpdateReportInventoryNicFirmware) Get() (v FirmwareUpdateReportInventoryNicFirmware, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptFirmwareUpdateReportInventoryNicFirmware) Or(d FirmwareUpdateReportInventoryNicFirmware) FirmwareUpdateReportInventoryNicFirmware {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptFloat32 returns new OptFloat32 with value set to v.
+func NewOptFloat32(v float32) OptFloat32 {
+	return OptFloat32{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptFloat32 is optional float32.
+type OptFloat32 struct {
+	Value float32
+	Set   bool
+}
+
+// IsSet returns true if OptFloat32 was set.
+func (o OptFloat32) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptFloat32) Reset() {
+	var v float32
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptFloat32) SetTo(v float32) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptFloat32) Get() (v float32, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptFloat32) Or(d float32) float32 {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptFloat64 returns new OptFloat64 with value set to v.
+func NewOptFloat64(v float64) OptFloat64 {
+	return OptFloat64{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptFloat64 is optional float64.
+type OptFloat64 struct {
+	Value float64
+	Set   bool
+}
+
+// IsSet returns true if OptFloat64 was set.
+func (o OptFloat64) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptFloat64) Reset() {
+	var v float64
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptFloat64) SetTo(v float64) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptFloat64) Get() (v float64, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptFloat64) Or(d float64) float64 {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptHTTPErrorErrorsItemMore returns new OptHTTPErrorErrorsItemMore with value set to v.
+func NewOptHTTPErrorErrorsItemMore(v HTTPErrorErrorsItemMore) OptHTTPErrorErrorsItemMore {
+	return OptHTTPErrorErrorsItemMore{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptHTTPErrorErrorsItemMore is optional HTTPErrorErrorsItemMore.
+type OptHTTPErrorErrorsItemMore struct {
+	Value HTTPErrorErrorsItemMore
+	Set   bool
+}
+
+// IsSet returns true if OptHTTPErrorErrorsItemMore was set.
+func (o OptHTTPErrorErrorsItemMore) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptHTTPErrorErrorsItemMore) Reset() {
+	var v HTTPErrorErrorsItemMore
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptHTTPErrorErrorsItemMore) SetTo(v HTTPErrorErrorsItemMore) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptHTTPErrorErrorsItemMore) Get() (v HTTPErrorErrorsItemMore, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptHTTPErrorErrorsItemMore) Or(d HTTPErrorErrorsItemMore) HTTPErrorErrorsItemMore {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptHostBondsItemOptions returns new OptHostBondsItemOptions with value set to v.
+func NewOptHostBondsItemOptions(v HostBondsItemOptions) OptHostBondsItemOptions {
+	return OptHostBondsItemOptions{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptHostBondsItemOptions is optional HostBondsItemOptions.
+type OptHostBondsItemOptions struct {
+	Value HostBondsItemOptions
+	Set   bool
+}
+
+// IsSet returns true if OptHostBondsItemOptions was set.
+func (o OptHostBondsItemOptions) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptHostBondsItemOptions) Reset() {
+	var v HostBondsItemOptions
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptHostBondsItemOptions) SetTo(v HostBondsItemOptions) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptHostBondsItemOptions) Get() (v HostBondsItemOptions, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptHostBondsItemOptions) Or(d HostBondsItemOptions) HostBondsItemOptions {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptHostInventoryNicFirmware returns new OptHostInventoryNicFirmware with value set to v.
+func NewOptHostInventoryNicFirmware(v HostInventoryNicFirmware) OptHostInventoryNicFirmware {
+	return OptHostInventoryNicFirmware{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptHostInventoryNicFirmware is optional HostInventoryNicFirmware.
+type OptHostInventoryNicFirmware struct {
+	Value HostInventoryNicFirmware
+	Set   bool
+}
+
+// IsSet returns true if OptHostInventoryNicFirmware was set.
+func (o OptHostInventoryNicFirmware) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptHostInventoryNicFirmware) Reset() {
+	var v HostInventoryNicFirmware
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptHostInventoryNicFirmware) SetTo(v HostInventoryNicFirmware) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptHostInventoryNicFirmware) Get() (v HostInventoryNicFirmware, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptHostInventoryNicFirmware) Or(d HostInventoryNicFirmware) HostInventoryNicFirmware {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptInt returns new OptInt with value set to v.
+func NewOptInt(v int) OptInt {
+	return OptInt{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptInt is optional int.
+type OptInt struct {
+	Value int
+	Set   bool
+}
+
+// IsSet returns true if OptInt was set.
+func (o OptInt) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptInt) Reset() {
+	var v int
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptInt) SetTo(v int) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptInt) Get() (v int, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptInt) Or(d int) int {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptInt64 returns new OptInt64 with value set to v.
+func NewOptInt64(v int64) OptInt64 {
+	return OptInt64{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptInt64 is optional int64.
+type OptInt64 struct {
+	Value int64
+	Set   bool
+}
+
+// IsSet returns true if OptInt64 was set.
+func (o OptInt64) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptInt64) Reset() {
+	var v int64
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptInt64) SetTo(v int64) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptInt64) Get() (v int64, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptInt64) Or(d int64) int64 {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptJobMessageRedfishError returns new OptJobMessageRedfishError with value set to v.
+func NewOptJobMessageRedfishError(v JobMessageRedfishError) OptJobMessageRedfishError {
+	return OptJobMessageRedfishError{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptJobMessageRedfishError is optional JobMessageRedfishError.
+type OptJobMessageRedfishError struct {
+	Value JobMessageRedfishError
+	Set   bool
+}
+
+// IsSet returns true if OptJobMessageRedfishError was set.
+func (o OptJobMessageRedfishError) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptJobMessageRedfishError) Reset() {
+	var v JobMessageRedfishError
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptJobMessageRedfishError) SetTo(v JobMessageRedfishError) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptJobMessageRedfishError) Get() (v JobMessageRedfishError, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptJobMessageRedfishError) Or(d JobMessageRedfishError) JobMessageRedfishError {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptJobMessageRedfishErrorError returns new OptJobMessageRedfishErrorError with value set to v.
+func NewOptJobMessageRedfishErrorError(v JobMessageRedfishErrorError) OptJobMessageRedfishErrorError {
+	return OptJobMessageRedfishErrorError{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptJobMessageRedfishErrorError is optional JobMessageRedfishErrorError.
+type OptJobMessageRedfishErrorError struct {
+	Value JobMessageRedfishErrorError
+	Set   bool
+}
+
+// IsSet returns true if OptJobMessageRedfishErrorError was set.
+func (o OptJobMessageRedfishErrorError) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptJobMessageRedfishErrorError) Reset() {
+	var v JobMessageRedfishErrorError
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptJobMessageRedfishErrorError) SetTo(v JobMessageRedfishErrorError) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptJobMessageRedfishErrorError) Get() (v JobMessageRedfishErrorError, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptJobMessageRedfishErrorError) Or(d JobMessageRedfishErrorError) JobMessageRedfishErrorError {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptNilBiosReportAttributes returns new OptNilBiosReportAttributes with value set to v.
+func NewOptNilBiosReportAttributes(v BiosReportAttributes) OptNilBiosReportAttributes {
+	return OptNilBiosReportAttributes{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptNilBiosReportAttributes is optional nullable BiosReportAttributes.
+type OptNilBiosReportAttributes struct {
+	Value BiosReportAttributes
+	Set   bool
+	Null  bool
+}
+
+// IsSet returns true if OptNilBiosReportAttributes was set.
+func (o OptNilBiosReportAttributes) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptNilBiosReportAttributes) Reset() {
+	var v BiosReportAttributes
+	o.Value = v
+	o.Set = false
+	o.Null = false
+}
+
+// SetTo sets value to v.
+func (o *OptNilBiosReportAttributes) SetTo(v BiosReportAttributes) {
+	o.Set = true
+	o.Null = false
+	o.Value = v
+}
+
+// IsSet returns true if value is Null.
+func (o OptNilBiosReportAttributes) IsNull() bool { return o.Null }
+
+// SetNull sets value to null.
+func (o *OptNilBiosReportAttributes) SetToNull() {
+	o.Set = true
+	o.Null = true
+	var v BiosReportAttributes
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptNilBiosReportAttributes) Get() (v BiosReportAttributes, ok bool) {
+	if o.Null {
+		return v, false
+	}
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptNilBiosReportAttributes) Or(d BiosReportAttributes) BiosReportAttributes {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptNilBootImageAddRequestBootImagesItemProvisionTemplates returns new OptNilBootImageAddRequestBootImagesItemProvisionTemplates with value set to v.
+func NewOptNilBootImageAddRequestBootImagesItemProvisionTemplates(v BootImageAddRequestBootImagesItemProvisionTemplates) OptNilBootImageAddRequestBootImagesItemProvisionTemplates {
+	return OptNilBootImageAddRequestBootImagesItemProvisionTemplates{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptNilBootImageAddRequestBootImagesItemProvisionTemplates is optional nullable BootImageAddRequestBootImagesItemProvisionTemplates.
+type OptNilBootImageAddRequestBootImagesItemProvisionTemplates struct {
+	Value BootImageAddRequestBootImagesItemProvisionTemplates
+	Set   bool
+	Null  bool
+}
+
+// IsSet returns true if OptNilBootImageAddRequestBootImagesItemProvisionTemplates was set.
+func (o OptNilBootImageAddRequestBootImagesItemProvisionTemplates) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptNilBootImageAddRequestBootImagesItemProvisionTemplates) Reset() {
+	var v BootImageAddRequestBootImagesItemProvisionTemplates
+	o.Value = v
+	o.Set = false
+	o.Null = false
+}
+
+// SetTo sets value to v.
+func (o *OptNilBootImageAddRequestBootImagesItemProvisionTemplates) SetTo(v BootImageAddRequestBootImagesItemProvisionTemplates) {
+	o.Set = true
+	o.Null = false
+	o.Value = v
+}
+
+// IsSet returns true if value is Null.
+func (o OptNilBootImageAddRequestBootImagesItemProvisionTemplates) IsNull() bool { return o.Null }
+
+// SetNull sets value to null.
+func (o *OptNilBootImageAddRequestBootImagesItemProvisionTemplates) SetToNull() {
+	o.Set = true
+	o.Null = true
+	var v BootImageAddRequestBootImagesItemProvisionTemplates
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptNilBootImageAddRequestBootImagesItemProvisionTemplates) Get() (v BootImageAddRequestBootImagesItemProvisionTemplates, ok bool) {
+	if o.Null {
+		return v, false
+	}
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptNilBootImageAddRequestBootImagesItemProvisionTemplates) Or(d BootImageAddRequestBootImagesItemProvisionTemplates) BootImageAddRequestBootImagesItemProvisionTemplates {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptNilBootImageProvisionTemplates returns new OptNilBootImageProvisionTemplates with value set to v.
+func NewOptNilBootImageProvisionTemplates(v BootImageProvisionTemplates) OptNilBootImageProvisionTemplates {
+	return OptNilBootImageProvisionTemplates{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptNilBootImageProvisionTemplates is optional nullable BootImageProvisionTemplates.
+type OptNilBootImageProvisionTemplates struct {
+	Value BootImageProvisionTemplates
+	Set   bool
+	Null  bool
+}
+
+// IsSet returns true if OptNilBootImageProvisionTemplates was set.
+func (o OptNilBootImageProvisionTemplates) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptNilBootImageProvisionTemplates) Reset() {
+	var v BootImageProvisionTemplates
+	o.Value = v
+	o.Set = false
+	o.Null = false
+}
+
+// SetTo sets value to v.
+func (o *OptNilBootImageProvisionTemplates) SetTo(v BootImageProvisionTemplates) {
+	o.Set = true
+	o.Null = false
+	o.Value = v
+}
+
+// IsSet returns true if value is Null.
+func (o OptNilBootImageProvisionTemplates) IsNull() bool { return o.Null }
+
+// SetNull sets value to null.
+func (o *OptNilBootImageProvisionTemplates) SetToNull() {
+	o.Set = true
+	o.Null = true
+	var v BootImageProvisionTemplates
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptNilBootImageProvisionTemplates) Get() (v BootImageProvisionTemplates, ok bool) {
+	if o.Null {
+		return v, false
+	}
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptNilBootImageProvisionTemplates) Or(d BootImageProvisionTemplates) BootImageProvisionTemplates {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptNilChangeFeedChangesItemDiff returns new OptNilChangeFeedChangesItemDiff with value set to v.
+func NewOptNilChangeFeedChangesItemDiff(v ChangeFeedChangesItemDiff) OptNilChangeFeedChangesItemDiff {
+	return OptNilChangeFeedChangesItemDiff{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptNilChangeFeedChangesItemDiff is optional nullable ChangeFeedChangesItemDiff.
+type OptNilChangeFeedChangesItemDiff struct {
+	Value ChangeFeedChangesItemDiff
+	Set   bool
+	Null  bool
+}
+
+// IsSet returns true if OptNilChangeFeedChangesItemDiff was set.
+func (o OptNilChangeFeedChangesItemDiff) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptNilChangeFeedChangesItemDiff) Reset() {
+	var v ChangeFeedChangesItemDiff
+	o.Value = v
+	o.Set = false
+	o.Null = false
+}
+
+// SetTo sets value to v.
+func (o *OptNilChangeFeedChangesItemDiff) SetTo(v ChangeFeedChangesItemDiff) {
+	o.Set = true
+	o.Null = false
+	o.Value = v
+}
+
+// IsSet returns true if value is Null.
+func (o OptNilChangeFeedChangesItemDiff) IsNull() bool { return o.Null }
+
+// SetNull sets value to null.
+func (o *OptNilChangeFeedChangesItemDiff) SetToNull() {
+	o.Set = true
+	o.Null = true
+	var v ChangeFeedChangesItemDiff
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptNilChangeFeedChangesItemDiff) Get() (v ChangeFeedChangesItemDiff, ok bool) {
+	if o.Null {
+		return v, false
+	}
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptNilChangeFeedChangesItemDiff) Or(d ChangeFeedChangesItemDiff) ChangeFeedChangesItemDiff {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptNilDataDumpHostsItemHardware returns new OptNilDataDumpHostsItemHardware with value set to v.
+func NewOptNilDataDumpHostsItemHardware(v DataDumpHostsItemHardware) OptNilDataDumpHostsItemHardware {
+	return OptNilDataDumpHostsItemHardware{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptNilDataDumpHostsItemHardware is optional nullable DataDumpHostsItemHardware.
+type OptNilDataDumpHostsItemHardware struct {
+	Value DataDumpHostsItemHardware
+	Set   bool
+	Null  bool
+}
+
+// IsSet returns true if OptNilDataDumpHostsItemHardware was set.
+func (o OptNilDataDumpHostsItemHardware) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptNilDataDumpHostsItemHardware) Reset() {
+	var v DataDumpHostsItemHardware
+	o.Value = v
+	o.Set = false
+	o.Null = false
+}
+
+// SetTo sets value to v.
+func (o *OptNilDataDumpHostsItemHardware) SetTo(v DataDumpHostsItemHardware) {
+	o.Set = true
+	o.Null = false
+	o.Value = v
+}
+
+// IsSet returns true if value is Null.
+func (o OptNilDataDumpHostsItemHardware) IsNull() bool { return o.Null }
+
+// SetNull sets value to null.
+func (o *OptNilDataDumpHostsItemHardware) SetToNull() {
+	o.Set = true
+	o.Null = true
+	var v DataDumpHostsItemHardware
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptNilDataDumpHostsItemHardware) Get() (v DataDumpHostsItemHardware, ok bool) {
+	if o.Null {
+		return v, false
+	}
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptNilDataDumpHostsItemHardware) Or(d DataDumpHostsItemHardware) DataDumpHostsItemHardware {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptNilDataDumpHostsItemInventory returns new OptNilDataDumpHostsItemInventory with value set to v.
+func NewOptNilDataDumpHostsItemInventory(v DataDumpHostsItemInventory) OptNilDataDumpHostsItemInventory {
+	return OptNilDataDumpHostsItemInventory{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptNilDataDumpHostsItemInventory is optional nullable DataDumpHostsItemInventory.
+type OptNilDataDumpHostsItemInventory struct {
+	Value DataDumpHostsItemInventory
+	Set   bool
+	Null  bool
+}
+
+// IsSet returns true if OptNilDataDumpHostsItemInventory was set.
+func (o OptNilDataDumpHostsItemInventory) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptNilDataDumpHostsItemInventory) Reset() {
+	var v DataDumpHostsItemInventory
+	o.Value = v
+	o.Set = false
+	o.Null = false
+}
+
+// SetTo sets value to v.
+func (o *OptNilDataDumpHostsItemInventory) SetTo(v DataDumpHostsItemInventory) {
+	o.Set = true
+	o.Null = false
+	o.Value = v
+}
+
+// IsSet returns true if value is Null.
+func (o OptNilDataDumpHostsItemInventory) IsNull() bool { return o.Null }
+
+// SetNull sets value to null.
+func (o *OptNilDataDumpHostsItemInventory) SetToNull() {
+	o.Set = true
+	o.Null = true
+	var v DataDumpHostsItemInventory
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptNilDataDumpHostsItemInventory) Get() (v DataDumpHostsItemInventory, ok bool) {
+	if o.Null {
+		return v, false
+	}
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptNilDataDumpHostsItemInventory) Or(d DataDumpHostsItemInventory) DataDumpHostsItemInventory {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptNilDataDumpImagesItemProvisionTemplates returns new OptNilDataDumpImagesItemProvisionTemplates with value set to v.
+func NewOptNilDataDumpImagesItemProvisionTemplates(v DataDumpImagesItemProvisionTemplates) OptNilDataDumpImagesItemProvisionTemplates {
+	return OptNilDataDumpImagesItemProvisionTemplates{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptNilDataDumpImagesItemProvisionTemplates is optional nullable DataDumpImagesItemProvisionTemplates.
+type OptNilDataDumpImagesItemProvisionTemplates struct {
+	Value DataDumpImagesItemProvisionTemplates
+	Set   bool
+	Null  bool
+}
+
+// IsSet returns true if OptNilDataDumpImagesItemProvisionTemplates was set.
+func (o OptNilDataDumpImagesItemProvisionTemplates) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptNilDataDumpImagesItemProvisionTemplates) Reset() {
+	var v DataDumpImagesItemProvisionTemplates
+	o.Value = v
+	o.Set = false
+	o.Null = false
+}
+
+// SetTo sets value to v.
+func (o *OptNilDataDumpImagesItemProvisionTemplates) SetTo(v DataDumpImagesItemProvisionTemplates) {
+	o.Set = true
+	o.Null = false
+	o.Value = v
+}
+
+// IsSet returns true if value is Null.
+func (o OptNilDataDumpImagesItemProvisionTemplates) IsNull() bool { return o.Null }
+
+// SetNull sets value to null.
+func (o *OptNilDataDumpImagesItemProvisionTemplates) SetToNull() {
+	o.Set = true
+	o.Null = true
+	var v DataDumpImagesItemProvisionTemplates
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptNilDataDumpImagesItemProvisionTemplates) Get() (v DataDumpImagesItemProvisionTemplates, ok bool) {
+	if o.Null {
+		return v, false
+	}
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptNilDataDumpImagesItemProvisionTemplates) Or(d DataDumpImagesItemProvisionTemplates) DataDumpImagesItemProvisionTemplates {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptNilDataLoadRequestDumpHostsItemHardware returns new OptNilDataLoadRequestDumpHostsItemHardware with value set to v.
+func NewOptNilDataLoadRequestDumpHostsItemHardware(v DataLoadRequestDumpHostsItemHardware) OptNilDataLoadRequestDumpHostsItemHardware {
+	return OptNilDataLoadRequestDumpHostsItemHardware{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptNilDataLoadRequestDumpHostsItemHardware is optional nullable DataLoadRequestDumpHostsItemHardware.
+type OptNilDataLoadRequestDumpHostsItemHardware struct {
+	Value DataLoadRequestDumpHostsItemHardware
+	Set   bool
+	Null  bool
+}
+
+// IsSet returns true if OptNilDataLoadRequestDumpHostsItemHardware was set.
+func (o OptNilDataLoadRequestDumpHostsItemHardware) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptNilDataLoadRequestDumpHostsItemHardware) Reset() {
+	var v DataLoadRequestDumpHostsItemHardware
+	o.Value = v
+	o.Set = false
+	o.Null = false
+}
+
+// SetTo sets value to v.
+func (o *OptNilDataLoadRequestDumpHostsItemHardware) SetTo(v DataLoadRequestDumpHostsItemHardware) {
+	o.Set = true
+	o.Null = false
+	o.Value = v
+}
+
+// IsSet returns true if value is Null.
+func (o OptNilDataLoadRequestDumpHostsItemHardware) IsNull() bool { return o.Null }
+
+// SetNull sets value to null.
+func (o *OptNilDataLoadRequestDumpHostsItemHardware) SetToNull() {
+	o.Set = true
+	o.Null = true
+	var v DataLoadRequestDumpHostsItemHardware
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptNilDataLoadRequestDumpHostsItemHardware) Get() (v DataLoadRequestDumpHostsItemHardware, ok bool) {
+	if o.Null {
+		return v, false
+	}
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptNilDataLoadRequestDumpHostsItemHardware) Or(d DataLoadRequestDumpHostsItemHardware) DataLoadRequestDumpHostsItemHardware {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptNilDataLoadRequestDumpHostsItemInventory returns new OptNilDataLoadRequestDumpHostsItemInventory with value set to v.
+func NewOptNilDataLoadRequestDumpHostsItemInventory(v DataLoadRequestDumpHostsItemInventory) OptNilDataLoadRequestDumpHostsItemInventory {
+	return OptNilDataLoadRequestDumpHostsItemInventory{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptNilDataLoadRequestDumpHostsItemInventory is optional nullable DataLoadRequestDumpHostsItemInventory.
+type OptNilDataLoadRequestDumpHostsItemInventory struct {
+	Value DataLoadRequestDumpHostsItemInventory
+	Set   bool
+	Null  bool
+}
+
+// IsSet returns true if OptNilDataLoadRequestDumpHostsItemInventory was set.
+func (o OptNilDataLoadRequestDumpHostsItemInventory) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptNilDataLoadRequestDumpHostsItemInventory) Reset() {
+	var v DataLoadRequestDumpHostsItemInventory
+	o.Value = v
+	o.Set = false
+	o.Null = false
+}
+
+// SetTo sets value to v.
+func (o *OptNilDataLoadRequestDumpHostsItemInventory) SetTo(v DataLoadRequestDumpHostsItemInventory) {
+	o.Set = true
+	o.Null = false
+	o.Value = v
+}
+
+// IsSet returns true if value is Null.
+func (o OptNilDataLoadRequestDumpHostsItemInventory) IsNull() bool { return o.Null }
+
+// SetNull sets value to null.
+func (o *OptNilDataLoadRequestDumpHostsItemInventory) SetToNull() {
+	o.Set = true
+	o.Null = true
+	var v DataLoadRequestDumpHostsItemInventory
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptNilDataLoadRequestDumpHostsItemInventory) Get() (v DataLoadRequestDumpHostsItemInventory, ok bool) {
+	if o.Null {
+		return v, false
+	}
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptNilDataLoadRequestDumpHostsItemInventory) Or(d DataLoadRequestDumpHostsItemInventory) DataLoadRequestDumpHostsItemInventory {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptNilDataLoadRequestDumpImagesItemProvisionTemplates returns new OptNilDataLoadRequestDumpImagesItemProvisionTemplates with value set to v.
+func NewOptNilDataLoadRequestDumpImagesItemProvisionTemplates(v DataLoadRequestDumpImagesItemProvisionTemplates) OptNilDataLoadRequestDumpImagesItemProvisionTemplates {
+	return OptNilDataLoadRequestDumpImagesItemProvisionTemplates{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptNilDataLoadRequestDumpImagesItemProvisionTemplates is optional nullable DataLoadRequestDumpImagesItemProvisionTemplates.
+type OptNilDataLoadRequestDumpImagesItemProvisionTemplates struct {
+	Value DataLoadRequestDumpImagesItemProvisionTemplates
+	Set   bool
+	Null  bool
+}
+
+// IsSet returns true if OptNilDataLoadRequestDumpImagesItemProvisionTemplates was set.
+func (o OptNilDataLoadRequestDumpImagesItemProvisionTemplates) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptNilDataLoadRequestDumpImagesItemProvisionTemplates) Reset() {
+	var v DataLoadRequestDumpImagesItemProvisionTemplates
+	o.Value = v
+	o.Set = false
+	o.Null = false
+}
+
+// SetTo sets value to v.
+func (o *OptNilDataLoadRequestDumpImagesItemProvisionTemplates) SetTo(v DataLoadRequestDumpImagesItemProvisionTemplates) {
+	o.Set = true
+	o.Null = false
+	o.Value = v
+}
+
+// IsSet returns true if value is Null.
+func (o OptNilDataLoadRequestDumpImagesItemProvisionTemplates) IsNull() bool { return o.Null }
+
+// SetNull sets value to null.
+func (o *OptNilDataLoadRequestDumpImagesItemProvisionTemplates) SetToNull() {
+	o.Set = true
+	o.Null = true
+	var v DataLoadRequestDumpImagesItemProvisionTemplates
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptNilDataLoadRequestDumpImagesItemProvisionTemplates) Get() (v DataLoadRequestDumpImagesItemProvisionTemplates, ok bool) {
+	if o.Null {
+		return v, false
+	}
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptNilDataLoadRequestDumpImagesItemProvisionTemplates) Or(d DataLoadRequestDumpImagesItemProvisionTemplates) DataLoadRequestDumpImagesItemProvisionTemplates {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptNilDateTime returns new OptNilDateTime with value set to v.
+func NewOptNilDateTime(v time.Time) OptNilDateTime {
+	return OptNilDateTime{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptNilDateTime is optional nullable time.Time.
+type OptNilDateTime struct {
+	Value time.Time
+	Set   bool
+	Null  bool
+}
+
+// IsSet returns true if OptNilDateTime was set.
+func (o OptNilDateTime) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptNilDateTime) Reset() {
+	var v time.Time
+	o.Value = v
+	o.Set = false
+	o.Null = false
+}
+
+// SetTo sets value to v.
+func (o *OptNilDateTime) SetTo(v time.Time) {
+	o.Set = true
+	o.Null = false
+	o.Value = v
+}
+
+// IsSet returns true if value is Null.
+func (o OptNilDateTime) IsNull() bool { return o.Null }
+
+// SetNull sets value to null.
+func (o *OptNilDateTime) SetToNull() {
+	o.Set = true
+	o.Null = true
+	var v time.Time
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptNilDateTime) Get() (v time.Time, ok bool) {
+	if o.Null {
+		return v, false
+	}
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptNilDateTime) Or(d time.Time) time.Time {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptNilFirmwareUpdateReportInventory returns new OptNilFirmwareUpdateReportInventory with value set to v.
+func NewOptNilFirmwareUpdateReportInventory(v FirmwareUpdateReportInventory) OptNilFirmwareUpdateReportInventory {
+	return OptNilFirmwareUpdateReportInventory{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptNilFirmwareUpdateReportInventory is optional nullable FirmwareUpdateReportInventory.
+type OptNilFirmwareUpdateReportInventory struct {
+	Value FirmwareUpdateReportInventory
+	Set   bool
+	Null  bool
+}
+
+// IsSet returns true if OptNilFirmwareUpdateReportInventory was set.
+func (o OptNilFirmwareUpdateReportInventory) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptNilFirmwareUpdateReportInventory) Reset() {
+	var v FirmwareUpdateReportInventory
+	o.Value = v
+	o.Set = false
+	o.Null = false
+}
+
+// SetTo sets value to v.
+func (o *OptNilFirmwareUpdateReportInventory) SetTo(v FirmwareUpdateReportInventory) {
+	o.Set = true
+	o.Null = false
+	o.Value = v
+}
+
+// IsSet returns true if value is Null.
+func (o OptNilFirmwareUpdateReportInventory) IsNull() bool { return o.Null }
+
+// SetNull sets value to null.
+func (o *OptNilFirmwareUpdateReportInventory) SetToNull() {
+	o.Set = true
+	o.Null = true
+	var v FirmwareUpdateReportInventory
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptNilFirmwareUpdateReportInventory) Get() (v FirmwareUpdateReportInventory, ok bool) {
+	if o.Null {
+		return v, false
+	}
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptNilFirmwareUpdateReportInventory) Or(d FirmwareUpdateReportInventory) FirmwareUpdateReportInventory {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptNilFloat64 returns new OptNilFloat64 with value set to v.
+func NewOptNilFloat64(v float64) OptNilFloat64 {
+	return OptNilFloat64{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptNilFloat64 is optional nullable float64.
+type OptNilFloat64 struct {
+	Value float64
+	Set   bool
+	Null  bool
+}
+
+// IsSet returns true if OptNilFloat64 was set.
+func (o OptNilFloat64) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptNilFloat64) Reset() {
+	var v float64
+	o.Value = v
+	o.Set = false
+	o.Null = false
+}
+
+// SetTo sets value to v.
+func (o *OptNilFloat64) SetTo(v float64) {
+	o.Set = true
+	o.Null = false
+	o.Value = v
+}
+
+// IsSet returns true if value is Null.
+func (o OptNilFloat64) IsNull() bool { return o.Null }
+
+// SetNull sets value to null.
+func (o *OptNilFloat64) SetToNull() {
+	o.Set = true
+	o.Null = true
+	var v float64
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptNilFloat64) Get() (v float64, ok bool) {
+	if o.Null {
+		return v, false
+	}
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptNilFloat64) Or(d float64) float64 {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptNilHTTPErrorErrorsItemArray returns new OptNilHTTPErrorErrorsItemArray with value set to v.
+func NewOptNilHTTPErrorErrorsItemArray(v []HTTPErrorErrorsItem) OptNilHTTPErrorErrorsItemArray {
+	return OptNilHTTPErrorErrorsItemArray{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptNilHTTPErrorErrorsItemArray is optional nullable []HTTPErrorErrorsItem.
+type OptNilHTTPErrorErrorsItemArray struct {
+	Value []HTTPErrorErrorsItem
+	Set   bool
+	Null  bool
+}
+
+// IsSet returns true if OptNilHTTPErrorErrorsItemArray was set.
+func (o OptNilHTTPErrorErrorsItemArray) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptNilHTTPErrorErrorsItemArray) Reset() {
+	var v []HTTPErrorErrorsItem
+	o.Value = v
+	o.Set = false
+	o.Null = false
+}
+
+// SetTo sets value to v.
+func (o *OptNilHTTPErrorErrorsItemArray) SetTo(v []HTTPErrorErrorsItem) {
+	o.Set = true
+	o.Null = false
+	o.Value = v
+}
+
+// IsSet returns true if value is Null.
+func (o OptNilHTTPErrorErrorsItemArray) IsNull() bool { return o.Null }
+
+// SetNull sets value to null.
+func (o *OptNilHTTPErrorErrorsItemArray) SetToNull() {
+	o.Set = true
+	o.Null = true
+	var v []HTTPErrorErrorsItem
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptNilHTTPErrorErrorsItemArray) Get() (v []HTTPErrorErrorsItem, ok bool) {
+	if o.Null {
+		return v, false
+	}
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptNilHTTPErrorErrorsItemArray) Or(d []HTTPErrorErrorsItem) []HTTPErrorErrorsItem {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptNilHardwareReportHardware returns new OptNilHardwareReportHardware with value set to v.
+func NewOptNilHardwareReportHardware(v HardwareReportHardware) OptNilHardwareReportHardware {
+	return OptNilHardwareReportHardware{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptNilHardwareReportHardware is optional nullable HardwareReportHardware.
+type OptNilHardwareReportHardware struct {
+	Value HardwareReportHardware
+	Set   bool
+	Null  bool
+}
+
+// IsSet returns true if OptNilHardwareReportHardware was set.
+func (o OptNilHardwareReportHardware) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptNilHardwareReportHardware) Reset() {
+	var v HardwareReportHardware
+	o.Value = v
+	o.Set = false
+	o.Null = false
+}
+
+// SetTo sets value to v.
+func (o *OptNilHardwareReportHardware) SetTo(v HardwareReportHardware) {
+	o.Set = true
+	o.Null = false
+	o.Value = v
+}
+
+// IsSet returns true if value is Null.
+func (o OptNilHardwareReportHardware) IsNull() bool { return o.Null }
+
+// SetNull sets value to null.
+func (o *OptNilHardwareReportHardware) SetToNull() {
+	o.Set = true
+	o.Null = true
+	var v HardwareReportHardware
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptNilHardwareReportHardware) Get() (v HardwareReportHardware, ok bool) {
+	if o.Null {
+		return v, false
+	}
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptNilHardwareReportHardware) Or(d HardwareReportHardware) HardwareReportHardware {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptNilHostHardware returns new OptNilHostHardware with value set to v.
+func NewOptNilHostHardware(v HostHardware) OptNilHostHardware {
+	return OptNilHostHardware{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptNilHostHardware is optional nullable HostHardware.
+type OptNilHostHardware struct {
+	Value HostHardware
+	Set   bool
+	Null  bool
+}
+
+// IsSet returns true if OptNilHostHardware was set.
+func (o OptNilHostHardware) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptNilHostHardware) Reset() {
+	var v HostHardware
+	o.Value = v
+	o.Set = false
+	o.Null = false
+}
+
+// SetTo sets value to v.
+func (o *OptNilHostHardware) SetTo(v HostHardware) {
+	o.Set = true
+	o.Null = false
+	o.Value = v
+}
+
+// IsSet returns true if value is Null.
+func (o OptNilHostHardware) IsNull() bool { return o.Null }
+
+// SetNull sets value to null.
+func (o *OptNilHostHardware) SetToNull() {
+	o.Set = true
+	o.Null = true
+	var v HostHardware
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptNilHostHardware) Get() (v HostHardware, ok bool) {
+	if o.Null {
+		return v, false
+	}
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptNilHostHardware) Or(d HostHardware) HostHardware {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptNilHostInventory returns new OptNilHostInventory with value set to v.
+func NewOptNilHostInventory(v HostInventory) OptNilHostInventory {
+	return OptNilHostInventory{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptNilHostInventory is optional nullable HostInventory.
+type OptNilHostInventory struct {
+	Value HostInventory
+	Set   bool
+	Null  bool
+}
+
+// IsSet returns true if OptNilHostInventory was set.
+func (o OptNilHostInventory) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptNilHostInventory) Reset() {
+	var v HostInventory
+	o.Value = v
+	o.Set = false
+	o.Null = false
+}
+
+// SetTo sets value to v.
+func (o *OptNilHostInventory) SetTo(v HostInventory) {
+	o.Set = true
+	o.Null = false
+	o.Value = v
+}
+
+// IsSet returns true if value is Null.
+func (o OptNilHostInventory) IsNull() bool { return o.Null }
+
+// SetNull sets value to null.
+func (o *OptNilHostInventory) SetToNull() {
+	o.Set = true
+	o.Null = true
+	var v HostInventory
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptNilHostInventory) Get() (v HostInventory, ok bool) {
+	if o.Null {
+		return v, false
+	}
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptNilHostInventory) Or(d HostInventory) HostInventory {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptNilInt returns new OptNilInt with value set to v.
+func NewOptNilInt(v int) OptNilInt {
+	return OptNilInt{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptNilInt is optional nullable int.
+type OptNilInt struct {
+	Value int
+	Set   bool
+	Null  bool
+}
+
+// IsSet returns true if OptNilInt was set.
+func (o OptNilInt) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptNilInt) Reset() {
+	var v int
+	o.Value = v
+	o.Set = false
+	o.Null = false
+}
+
+// SetTo sets value to v.
+func (o *OptNilInt) SetTo(v int) {
+	o.Set = true
+	o.Null = false
+	o.Value = v
+}
+
+// IsSet returns true if value is Null.
+func (o OptNilInt) IsNull() bool { return o.Null }
+
+// SetNull sets value to null.
+func (o *OptNilInt) SetToNull() {
+	o.Set = true
+	o.Null = true
+	var v int
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptNilInt) Get() (v int, ok bool) {
+	if o.Null {
+		return v, false
+	}
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptNilInt) Or(d int) int {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptNilInt64 returns new OptNilInt64 with value set to v.
+func NewOptNilInt64(v int64) OptNilInt64 {
+	return OptNilInt64{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptNilInt64 is optional nullable int64.
+type OptNilInt64 struct {
+	Value int64
+	Set   bool
+	Null  bool
+}
+
+// IsSet returns true if OptNilInt64 was set.
+func (o OptNilInt64) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptNilInt64) Reset() {
+	var v int64
+	o.Value = v
+	o.Set = false
+	o.Null = false
+}
+
+// SetTo sets value to v.
+func (o *OptNilInt64) SetTo(v int64) {
+	o.Set = true
+	o.Null = false
+	o.Value = v
+}
+
+// IsSet returns true if value is Null.
+func (o OptNilInt64) IsNull() bool { return o.Null }
+
+// SetNull sets value to null.
+func (o *OptNilInt64) SetToNull() {
+	o.Set = true
+	o.Null = true
+	var v int64
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptNilInt64) Get() (v int64, ok bool) {
+	if o.Null {
+		return v, false
+	}
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptNilInt64) Or(d int64) int64 {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptNilJobMessageRedfishErrorErrorMessageDotExtendedInfoItemArray returns new OptNilJobMessageRedfishErrorErrorMessageDotExtendedInfoItemArray with value set to v.
+func NewOptNilJobMessageRedfishErrorErrorMessageDotExtendedInfoItemArray(v []JobMessageRedfishErrorErrorMessageDotExtendedInfoItem) OptNilJobMessageRedfishErrorErrorMessageDotExtendedInfoItemArray {
+	return OptNilJobMessageRedfishErrorErrorMessageDotExtendedInfoItemArray{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptNilJobMessageRedfishErrorErrorMessageDotExtendedInfoItemArray is optional nullable []JobMessageRedfishErrorErrorMessageDotExtendedInfoItem.
+type OptNilJobMessageRedfishErrorErrorMessageDotExtendedInfoItemArray struct {
+	Value []JobMessageRedfishErrorErrorMessageDotExtendedInfoItem
+	Set   bool
+	Null  bool
+}
+
+// IsSet returns true if OptNilJobMessageRedfishErrorErrorMessageDotExtendedInfoItemArray was set.
+func (o OptNilJobMessageRedfishErrorErrorMessageDotExtendedInfoItemArray) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptNilJobMessageRedfishErrorErrorMessageDotExtendedInfoItemArray) Reset() {
+	var v []JobMessageRedfishErrorErrorMessageDotExtendedInfoItem
+	o.Value = v
+	o.Set = false
+	o.Null = false
+}
+
+// SetTo sets value to v.
+func (o *OptNilJobMessageRedfishErrorErrorMessageDotExtendedInfoItemArray) SetTo(v []JobMessageRedfishErrorErrorMessageDotExtendedInfoItem) {
+	o.Set = true
+	o.Null = false
+	o.Value = v
+}
+
+// IsSet returns true if value is Null.
+func (o OptNilJobMessageRedfishErrorErrorMessageDotExtendedInfoItemArray) IsNull() bool {
+	return o.Null
+}
+
+// SetNull sets value to null.
+func (o *OptNilJobMessageRedfishErrorErrorMessageDotExtendedInfoItemArray) SetToNull() {
+	o.Set = true
+	o.Null = true
+	var v []JobMessageRedfishErrorErrorMessageDotExtendedInfoItem
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptNilJobMessageRedfishErrorErrorMessageDotExtendedInfoItemArray) Get() (v []JobMessageRedfishErrorErrorMessageDotExtendedInfoItem, ok bool) {
+	if o.Null {
+		return v, false
+	}
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptNilJobMessageRedfishErrorErrorMessageDotExtendedInfoItemArray) Or(d []JobMessageRedfishErrorErrorMessageDotExtendedInfoItem) []JobMessageRedfishErrorErrorMessageDotExtendedInfoItem {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptNilNilDataDumpCredentialsItemArray returns new OptNilNilDataDumpCredentialsItemArray with value set to v.
+func NewOptNilNilDataDumpCredentialsItemArray(v []NilDataDumpCredentialsItem) OptNilNilDataDumpCredentialsItemArray {
+	return OptNilNilDataDumpCredentialsItemArray{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptNilNilDataDumpCredentialsItemArray is optional nullable []NilDataDumpCredentialsItem.
+type OptNilNilDataDumpCredentialsItemArray struct {
+	Value []NilDataDumpCredentialsItem
+	Set   bool
+	Null  bool
+}
+
+// IsSet returns true if OptNilNilDataDumpCredentialsItemArray was set.
+func (o OptNilNilDataDumpCredentialsItemArray) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptNilNilDataDumpCredentialsItemArray) Reset() {
+	var v []NilDataDumpCredentialsItem
+	o.Value = v
+	o.Set = false
+	o.Null = false
+}
+
+// SetTo sets value to v.
+func (o *OptNilNilDataDumpCredentialsItemArray) SetTo(v []NilDataDumpCredentialsItem) {
+	o.Set = true
+	o.Null = false
+	o.Value = v
+}
+
+// IsSet returns true if value is Null.
+func (o OptNilNilDataDumpCredentialsItemArray) IsNull() bool { return o.Null }
+
+// SetNull sets value to null.
+func (o *OptNilNilDataDumpCredentialsItemArray) SetToNull() {
+	o.Set = true
+	o.Null = true
+	var v []NilDataDumpCredentialsItem
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptNilNilDataDumpCredentialsItemArray) Get() (v []NilDataDumpCredentialsItem, ok bool) {
+	if o.Null {
+		return v, false
+	}
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptNilNilDataDumpCredentialsItemArray) Or(d []NilDataDumpCredentialsItem) []NilDataDumpCredentialsItem {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptNilNilDataDumpHostsItemHardwareDimmsItemArray returns new OptNilNilDataDumpHostsItemHardwareDimmsItemArray with value set to v.
+func NewOptNilNilDataDumpHostsItemHardwareDimmsItemArray(v []NilDataDumpHostsItemHardwareDimmsItem) OptNilNilDataDumpHostsItemHardwareDimmsItemArray {
+	return OptNilNilDataDumpHostsItemHardwareDimmsItemArray{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptNilNilDataDumpHostsItemHardwareDimmsItemArray is optional nullable []NilDataDumpHostsItemHardwareDimmsItem.
+type OptNilNilDataDumpHostsItemHardwareDimmsItemArray struct {
+	Value []NilDataDumpHostsItemHardwareDimmsItem
+	Set   bool
+	Null  bool
+}
+
+// IsSet returns true if OptNilNilDataDumpHostsItemHardwareDimmsItemArray was set.
+func (o OptNilNilDataDumpHostsItemHardwareDimmsItemArray) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptNilNilDataDumpHostsItemHardwareDimmsItemArray) Reset() {
+	var v []NilDataDumpHostsItemHardwareDimmsItem
+	o.Value = v
+	o.Set = false
+	o.Null = false
+}
+
+// SetTo sets value to v.
+func (o *OptNilNilDataDumpHostsItemHardwareDimmsItemArray) SetTo(v []NilDataDumpHostsItemHardwareDimmsItem) {
+	o.Set = true
+	o.Null = false
+	o.Value = v
+}
+
+// IsSet returns true if value is Null.
+func (o OptNilNilDataDumpHostsItemHardwareDimmsItemArray) IsNull() bool { return o.Null }
+
+// SetNull sets value to null.
+func (o *OptNilNilDataDumpHostsItemHardwareDimmsItemArray) SetToNull() {
+	o.Set = true
+	o.Null = true
+	var v []NilDataDumpHostsItemHardwareDimmsItem
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptNilNilDataDumpHostsItemHardwareDimmsItemArray) Get() (v []NilDataDumpHostsItemHardwareDimmsItem, ok bool) {
+	if o.Null {
+		return v, false
+	}
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptNilNilDataDumpHostsItemHardwareDimmsItemArray) Or(d []NilDataDumpHostsItemHardwareDimmsItem) []NilDataDumpHostsItemHardwareDimmsItem {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptNilNilDataDumpHostsItemHardwareDisksItemArray returns new OptNilNilDataDumpHostsItemHardwareDisksItemArray with value set to v.
+func NewOptNilNilDataDumpHostsItemHardwareDisksItemArray(v []NilDataDumpHostsItemHardwareDisksItem) OptNilNilDataDumpHostsItemHardwareDisksItemArray {
+	return OptNilNilDataDumpHostsItemHardwareDisksItemArray{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptNilNilDataDumpHostsItemHardwareDisksItemArray is optional nullable []NilDataDumpHostsItemHardwareDisksItem.
+type OptNilNilDataDumpHostsItemHardwareDisksItemArray struct {
+	Value []NilDataDumpHostsItemHardwareDisksItem
+	Set   bool
+	Null  bool
+}
+
+// IsSet returns true if OptNilNilDataDumpHostsItemHardwareDisksItemArray was set.
+func (o OptNilNilDataDumpHostsItemHardwareDisksItemArray) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptNilNilDataDumpHostsItemHardwareDisksItemArray) Reset() {
+	var v []NilDataDumpHostsItemHardwareDisksItem
+	o.Value = v
+	o.Set = false
+	o.Null = false
+}
+
+// SetTo sets value to v.
+func (o *OptNilNilDataDumpHostsItemHardwareDisksItemArray) SetTo(v []NilDataDumpHostsItemHardwareDisksItem) {
+	o.Set = true
+	o.Null = false
+	o.Value = v
+}
+
+// IsSet returns true if value is Null.
+func (o OptNilNilDataDumpHostsItemHardwareDisksItemArray) IsNull() bool { return o.Null }
+
+// SetNull sets value to null.
+func (o *OptNilNilDataDumpHostsItemHardwareDisksItemArray) SetToNull() {
+	o.Set = true
+	o.Null = true
+	var v []NilDataDumpHostsItemHardwareDisksItem
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptNilNilDataDumpHostsItemHardwareDisksItemArray) Get() (v []NilDataDumpHostsItemHardwareDisksItem, ok bool) {
+	if o.Null {
+		return v, false
+	}
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptNilNilDataDumpHostsItemHardwareDisksItemArray) Or(d []NilDataDumpHostsItemHardwareDisksItem) []NilDataDumpHostsItemHardwareDisksItem {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptNilNilDataDumpHostsItemHardwareNicsItemArray returns new OptNilNilDataDumpHostsItemHardwareNicsItemArray with value set to v.
+func NewOptNilNilDataDumpHostsItemHardwareNicsItemArray(v []NilDataDumpHostsItemHardwareNicsItem) OptNilNilDataDumpHostsItemHardwareNicsItemArray {
+	return OptNilNilDataDumpHostsItemHardwareNicsItemArray{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptNilNilDataDumpHostsItemHardwareNicsItemArray is optional nullable []NilDataDumpHostsItemHardwareNicsItem.
+type OptNilNilDataDumpHostsItemHardwareNicsItemArray struct {
+	Value []NilDataDumpHostsItemHardwareNicsItem
+	Set   bool
+	Null  bool
+}
+
+// IsSet returns true if OptNilNilDataDumpHostsItemHardwareNicsItemArray was set.
+func (o OptNilNilDataDumpHostsItemHardwareNicsItemArray) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptNilNilDataDumpHostsItemHardwareNicsItemArray) Reset() {
+	var v []NilDataDumpHostsItemHardwareNicsItem
+	o.Value = v
+	o.Set = false
+	o.Null = false
+}
+
+// SetTo sets value to v.
+func (o *OptNilNilDataDumpHostsItemHardwareNicsItemArray) SetTo(v []NilDataDumpHostsItemHardwareNicsItem) {
+	o.Set = true
+	o.Null = false
+	o.Value = v
+}
+
+// IsSet returns true if value is Null.
+func (o OptNilNilDataDumpHostsItemHardwareNicsItemArray) IsNull() bool { return o.Null }
+
+// SetNull sets value to null.
+func (o *OptNilNilDataDumpHostsItemHardwareNicsItemArray) SetToNull() {
+	o.Set = true
+	o.Null = true
+	var v []NilDataDumpHostsItemHardwareNicsItem
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptNilNilDataDumpHostsItemHardwareNicsItemArray) Get() (v []NilDataDumpHostsItemHardwareNicsItem, ok bool) {
+	if o.Null {
+		return v, false
+	}
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptNilNilDataDumpHostsItemHardwareNicsItemArray) Or(d []NilDataDumpHostsItemHardwareNicsItem) []NilDataDumpHostsItemHardwareNicsItem {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptNilNilDataLoadRequestDumpCredentialsItemArray returns new OptNilNilDataLoadRequestDumpCredentialsItemArray with value set to v.
+func NewOptNilNilDataLoadRequestDumpCredentialsItemArray(v []NilDataLoadRequestDumpCredentialsItem) OptNilNilDataLoadRequestDumpCredentialsItemArray {
+	return OptNilNilDataLoadRequestDumpCredentialsItemArray{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptNilNilDataLoadRequestDumpCredentialsItemArray is optional nullable []NilDataLoadRequestDumpCredentialsItem.
+type OptNilNilDataLoadRequestDumpCredentialsItemArray struct {
+	Value []NilDataLoadRequestDumpCredentialsItem
+	Set   bool
+	Null  bool
+}
+
+// IsSet returns true if OptNilNilDataLoadRequestDumpCredentialsItemArray was set.
+func (o OptNilNilDataLoadRequestDumpCredentialsItemArray) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptNilNilDataLoadRequestDumpCredentialsItemArray) Reset() {
+	var v []NilDataLoadRequestDumpCredentialsItem
+	o.Value = v
+	o.Set = false
+	o.Null = false
+}
+
+// SetTo sets value to v.
+func (o *OptNilNilDataLoadRequestDumpCredentialsItemArray) SetTo(v []NilDataLoadRequestDumpCredentialsItem) {
+	o.Set = true
+	o.Null = false
+	o.Value = v
+}
+
+// IsSet returns true if value is Null.
+func (o OptNilNilDataLoadRequestDumpCredentialsItemArray) IsNull() bool { return o.Null }
+
+// SetNull sets value to null.
+func (o *OptNilNilDataLoadRequestDumpCredentialsItemArray) SetToNull() {
+	o.Set = true
+	o.Null = true
+	var v []NilDataLoadRequestDumpCredentialsItem
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptNilNilDataLoadRequestDumpCredentialsItemArray) Get() (v []NilDataLoadRequestDumpCredentialsItem, ok bool) {
+	if o.Null {
+		return v, false
+	}
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptNilNilDataLoadRequestDumpCredentialsItemArray) Or(d []NilDataLoadRequestDumpCredentialsItem) []NilDataLoadRequestDumpCredentialsItem {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptNilNilDataLoadRequestDumpHostsItemHardwareDimmsItemArray returns new OptNilNilDataLoadRequestDumpHostsItemHardwareDimmsItemArray with value set to v.
+func NewOptNilNilDataLoadRequestDumpHostsItemHardwareDimmsItemArray(v []NilDataLoadRequestDumpHostsItemHardwareDimmsItem) OptNilNilDataLoadRequestDumpHostsItemHardwareDimmsItemArray {
+	return OptNilNilDataLoadRequestDumpHostsItemHardwareDimmsItemArray{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptNilNilDataLoadRequestDumpHostsItemHardwareDimmsItemArray is optional nullable []NilDataLoadRequestDumpHostsItemHardwareDimmsItem.
+type OptNilNilDataLoadRequestDumpHostsItemHardwareDimmsItemArray struct {
+	Value []NilDataLoadRequestDumpHostsItemHardwareDimmsItem
+	Set   bool
+	Null  bool
+}
+
+// IsSet returns true if OptNilNilDataLoadRequestDumpHostsItemHardwareDimmsItemArray was set.
+func (o OptNilNilDataLoadRequestDumpHostsItemHardwareDimmsItemArray) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptNilNilDataLoadRequestDumpHostsItemHardwareDimmsItemArray) Reset() {
+	var v []NilDataLoadRequestDumpHostsItemHardwareDimmsItem
+	o.Value = v
+	o.Set = false
+	o.Null = false
+}
+
+// SetTo sets value to v.
+func (o *OptNilNilDataLoadRequestDumpHostsItemHardwareDimmsItemArray) SetTo(v []NilDataLoadRequestDumpHostsItemHardwareDimmsItem) {
+	o.Set = true
+	o.Null = false
+	o.Value = v
+}
+
+// IsSet returns true if value is Null.
+func (o OptNilNilDataLoadRequestDumpHostsItemHardwareDimmsItemArray) IsNull() bool { return o.Null }
+
+// SetNull sets value to null.
+func (o *OptNilNilDataLoadRequestDumpHostsItemHardwareDimmsItemArray) SetToNull() {
+	o.Set = true
+	o.Null = true
+	var v []NilDataLoadRequestDumpHostsItemHardwareDimmsItem
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptNilNilDataLoadRequestDumpHostsItemHardwareDimmsItemArray) Get() (v []NilDataLoadRequestDumpHostsItemHardwareDimmsItem, ok bool) {
+	if o.Null {
+		return v, false
+	}
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptNilNilDataLoadRequestDumpHostsItemHardwareDimmsItemArray) Or(d []NilDataLoadRequestDumpHostsItemHardwareDimmsItem) []NilDataLoadRequestDumpHostsItemHardwareDimmsItem {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptNilNilDataLoadRequestDumpHostsItemHardwareDisksItemArray returns new OptNilNilDataLoadRequestDumpHostsItemHardwareDisksItemArray with value set to v.
+func NewOptNilNilDataLoadRequestDumpHostsItemHardwareDisksItemArray(v []NilDataLoadRequestDumpHostsItemHardwareDisksItem) OptNilNilDataLoadRequestDumpHostsItemHardwareDisksItemArray {
+	return OptNilNilDataLoadRequestDumpHostsItemHardwareDisksItemArray{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptNilNilDataLoadRequestDumpHostsItemHardwareDisksItemArray is optional nullable []NilDataLoadRequestDumpHostsItemHardwareDisksItem.
+type OptNilNilDataLoadRequestDumpHostsItemHardwareDisksItemArray struct {
+	Value []NilDataLoadRequestDumpHostsItemHardwareDisksItem
+	Set   bool
+	Null  bool
+}
+
+// IsSet returns true if OptNilNilDataLoadRequestDumpHostsItemHardwareDisksItemArray was set.
+func (o OptNilNilDataLoadRequestDumpHostsItemHardwareDisksItemArray) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptNilNilDataLoadRequestDumpHostsItemHardwareDisksItemArray) Reset() {
+	var v []NilDataLoadRequestDumpHostsItemHardwareDisksItem
+	o.Value = v
+	o.Set = false
+	o.Null = false
+}
+
+// SetTo sets value to v.
+func (o *OptNilNilDataLoadRequestDumpHostsItemHardwareDisksItemArray) SetTo(v []NilDataLoadRequestDumpHostsItemHardwareDisksItem) {
+	o.Set = true
+	o.Null = false
+	o.Value = v
+}
+
+// IsSet returns true if value is Null.
+func (o OptNilNilDataLoadRequestDumpHostsItemHardwareDisksItemArray) IsNull() bool { return o.Null }
+
+// SetNull sets value to null.
+func (o *OptNilNilDataLoadRequestDumpHostsItemHardwareDisksItemArray) SetToNull() {
+	o.Set = true
+	o.Null = true
+	var v []NilDataLoadRequestDumpHostsItemHardwareDisksItem
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptNilNilDataLoadRequestDumpHostsItemHardwareDisksItemArray) Get() (v []NilDataLoadRequestDumpHostsItemHardwareDisksItem, ok bool) {
+	if o.Null {
+		return v, false
+	}
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptNilNilDataLoadRequestDumpHostsItemHardwareDisksItemArray) Or(d []NilDataLoadRequestDumpHostsItemHardwareDisksItem) []NilDataLoadRequestDumpHostsItemHardwareDisksItem {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptNilNilDataLoadRequestDumpHostsItemHardwareNicsItemArray returns new OptNilNilDataLoadRequestDumpHostsItemHardwareNicsItemArray with value set to v.
+func NewOptNilNilDataLoadRequestDumpHostsItemHardwareNicsItemArray(v []NilDataLoadRequestDumpHostsItemHardwareNicsItem) OptNilNilDataLoadRequestDumpHostsItemHardwareNicsItemArray {
+	return OptNilNilDataLoadRequestDumpHostsItemHardwareNicsItemArray{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptNilNilDataLoadRequestDumpHostsItemHardwareNicsItemArray is optional nullable []NilDataLoadRequestDumpHostsItemHardwareNicsItem.
+type OptNilNilDataLoadRequestDumpHostsItemHardwareNicsItemArray struct {
+	Value []NilDataLoadRequestDumpHostsItemHardwareNicsItem
+	Set   bool
+	Null  bool
+}
+
+// IsSet returns true if OptNilNilDataLoadRequestDumpHostsItemHardwareNicsItemArray was set.
+func (o OptNilNilDataLoadRequestDumpHostsItemHardwareNicsItemArray) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptNilNilDataLoadRequestDumpHostsItemHardwareNicsItemArray) Reset() {
+	var v []NilDataLoadRequestDumpHostsItemHardwareNicsItem
+	o.Value = v
+	o.Set = false
+	o.Null = false
+}
+
+// SetTo sets value to v.
+func (o *OptNilNilDataLoadRequestDumpHostsItemHardwareNicsItemArray) SetTo(v []NilDataLoadRequestDumpHostsItemHardwareNicsItem) {
+	o.Set = true
+	o.Null = false
+	o.Value = v
+}
+
+// IsSet returns true if value is Null.
+func (o OptNilNilDataLoadRequestDumpHostsItemHardwareNicsItemArray) IsNull() bool { return o.Null }
+
+// SetNull sets value to null.
+func (o *OptNilNilDataLoadRequestDumpHostsItemHardwareNicsItemArray) SetToNull() {
+	o.Set = true
+	o.Null = true
+	var v []NilDataLoadRequestDumpHostsItemHardwareNicsItem
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptNilNilDataLoadRequestDumpHostsItemHardwareNicsItemArray) Get() (v []NilDataLoadRequestDumpHostsItemHardwareNicsItem, ok bool) {
+	if o.Null {
+		return v, false
+	}
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptNilNilDataLoadRequestDumpHostsItemHardwareNicsItemArray) Or(d []NilDataLoadRequestDumpHostsItemHardwareNicsItem) []NilDataLoadRequestDumpHostsItemHardwareNicsItem {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptNilNilHardwareReportHardwareDimmsItemArray returns new OptNilNilHardwareReportHardwareDimmsItemArray with value set to v.
+func NewOptNilNilHardwareReportHardwareDimmsItemArray(v []NilHardwareReportHardwareDimmsItem) OptNilNilHardwareReportHardwareDimmsItemArray {
+	return OptNilNilHardwareReportHardwareDimmsItemArray{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptNilNilHardwareReportHardwareDimmsItemArray is optional nullable []NilHardwareReportHardwareDimmsItem.
+type OptNilNilHardwareReportHardwareDimmsItemArray struct {
+	Value []NilHardwareReportHardwareDimmsItem
+	Set   bool
+	Null  bool
+}
+
+// IsSet returns true if OptNilNilHardwareReportHardwareDimmsItemArray was set.
+func (o OptNilNilHardwareReportHardwareDimmsItemArray) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptNilNilHardwareReportHardwareDimmsItemArray) Reset() {
+	var v []NilHardwareReportHardwareDimmsItem
+	o.Value = v
+	o.Set = false
+	o.Null = false
+}
+
+// SetTo sets value to v.
+func (o *OptNilNilHardwareReportHardwareDimmsItemArray) SetTo(v []NilHardwareReportHardwareDimmsItem) {
+	o.Set = true
+	o.Null = false
+	o.Value = v
+}
+
+// IsSet returns true if value is Null.
+func (o OptNilNilHardwareReportHardwareDimmsItemArray) IsNull() bool { return o.Null }
+
+// SetNull sets value to null.
+func (o *OptNilNilHardwareReportHardwareDimmsItemArray) SetToNull() {
+	o.Set = true
+	o.Null = true
+	var v []NilHardwareReportHardwareDimmsItem
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptNilNilHardwareReportHardwareDimmsItemArray) Get() (v []NilHardwareReportHardwareDimmsItem, ok bool) {
+	if o.Null {
+		return v, false
+	}
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptNilNilHardwareReportHardwareDimmsItemArray) Or(d []NilHardwareReportHardwareDimmsItem) []NilHardwareReportHardwareDimmsItem {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptNilNilHardwareReportHardwareDisksItemArray returns new OptNilNilHardwareReportHardwareDisksItemArray with value set to v.
+func NewOptNilNilHardwareReportHardwareDisksItemArray(v []NilHardwareReportHardwareDisksItem) OptNilNilHardwareReportHardwareDisksItemArray {
+	return OptNilNilHardwareReportHardwareDisksItemArray{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptNilNilHardwareReportHardwareDisksItemArray is optional nullable []NilHardwareReportHardwareDisksItem.
+type OptNilNilHardwareReportHardwareDisksItemArray struct {
+	Value []NilHardwareReportHardwareDisksItem
+	Set   bool
+	Null  bool
+}
+
+// IsSet returns true if OptNilNilHardwareReportHardwareDisksItemArray was set.
+func (o OptNilNilHardwareReportHardwareDisksItemArray) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptNilNilHardwareReportHardwareDisksItemArray) Reset() {
+	var v []NilHardwareReportHardwareDisksItem
+	o.Value = v
+	o.Set = false
+	o.Null = false
+}
+
+// SetTo sets value to v.
+func (o *OptNilNilHardwareReportHardwareDisksItemArray) SetTo(v []NilHardwareReportHardwareDisksItem) {
+	o.Set = true
+	o.Null = false
+	o.Value = v
+}
+
+// IsSet returns true if value is Null.
+func (o OptNilNilHardwareReportHardwareDisksItemArray) IsNull() bool { return o.Null }
+
+// SetNull sets value to null.
+func (o *OptNilNilHardwareReportHardwareDisksItemArray) SetToNull() {
+	o.Set = true
+	o.Null = true
+	var v []NilHardwareReportHardwareDisksItem
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptNilNilHardwareReportHardwareDisksItemArray) Get() (v []NilHardwareReportHardwareDisksItem, ok bool) {
+	if o.Null {
+		return v, false
+	}
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptNilNilHardwareReportHardwareDisksItemArray) Or(d []NilHardwareReportHardwareDisksItem) []NilHardwareReportHardwareDisksItem {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptNilNilHardwareReportHardwareNicsItemArray returns new OptNilNilHardwareReportHardwareNicsItemArray with value set to v.
+func NewOptNilNilHardwareReportHardwareNicsItemArray(v []NilHardwareReportHardwareNicsItem) OptNilNilHardwareReportHardwareNicsItemArray {
+	return OptNilNilHardwareReportHardwareNicsItemArray{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptNilNilHardwareReportHardwareNicsItemArray is optional nullable []NilHardwareReportHardwareNicsItem.
+type OptNilNilHardwareReportHardwareNicsItemArray struct {
+	Value []NilHardwareReportHardwareNicsItem
+	Set   bool
+	Null  bool
+}
+
+// IsSet returns true if OptNilNilHardwareReportHardwareNicsItemArray was set.
+func (o OptNilNilHardwareReportHardwareNicsItemArray) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptNilNilHardwareReportHardwareNicsItemArray) Reset() {
+	var v []NilHardwareReportHardwareNicsItem
+	o.Value = v
+	o.Set = false
+	o.Null = false
+}
+
+// SetTo sets value to v.
+func (o *OptNilNilHardwareReportHardwareNicsItemArray) SetTo(v []NilHardwareReportHardwareNicsItem) {
+	o.Set = true
+	o.Null = false
+	o.Value = v
+}
+
+// IsSet returns true if value is Null.
+func (o OptNilNilHardwareReportHardwareNicsItemArray) IsNull() bool { return o.Null }
+
+// SetNull sets value to null.
+func (o *OptNilNilHardwareReportHardwareNicsItemArray) SetToNull() {
+	o.Set = true
+	o.Null = true
+	var v []NilHardwareReportHardwareNicsItem
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptNilNilHardwareReportHardwareNicsItemArray) Get() (v []NilHardwareReportHardwareNicsItem, ok bool) {
+	if o.Null {
+		return v, false
+	}
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptNilNilHardwareReportHardwareNicsItemArray) Or(d []NilHardwareReportHardwareNicsItem) []NilHardwareReportHardwareNicsItem {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptNilNilHostHardwareDimmsItemArray returns new OptNilNilHostHardwareDimmsItemArray with value set to v.
+func NewOptNilNilHostHardwareDimmsItemArray(v []NilHostHardwareDimmsItem) OptNilNilHostHardwareDimmsItemArray {
+	return OptNilNilHostHardwareDimmsItemArray{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptNilNilHostHardwareDimmsItemArray is optional nullable []NilHostHardwareDimmsItem.
+type OptNilNilHostHardwareDimmsItemArray struct {
+	Value []NilHostHardwareDimmsItem
+	Set   bool
+	Null  bool
+}
+
+// IsSet returns true if OptNilNilHostHardwareDimmsItemArray was set.
+func (o OptNilNilHostHardwareDimmsItemArray) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptNilNilHostHardwareDimmsItemArray) Reset() {
+	var v []NilHostHardwareDimmsItem
+	o.Value = v
+	o.Set = false
+	o.Null = false
+}
+
+// SetTo sets value to v.
+func (o *OptNilNilHostHardwareDimmsItemArray) SetTo(v []NilHostHardwareDimmsItem) {
+	o.Set = true
+	o.Null = false
+	o.Value = v
+}
+
+// IsSet returns true if value is Null.
+func (o OptNilNilHostHardwareDimmsItemArray) IsNull() bool { return o.Null }
+
+// SetNull sets value to null.
+func (o *OptNilNilHostHardwareDimmsItemArray) SetToNull() {
+	o.Set = true
+	o.Null = true
+	var v []NilHostHardwareDimmsItem
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptNilNilHostHardwareDimmsItemArray) Get() (v []NilHostHardwareDimmsItem, ok bool) {
+	if o.Null {
+		return v, false
+	}
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptNilNilHostHardwareDimmsItemArray) Or(d []NilHostHardwareDimmsItem) []NilHostHardwareDimmsItem {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptNilNilHostHardwareDisksItemArray returns new OptNilNilHostHardwareDisksItemArray with value set to v.
+func NewOptNilNilHostHardwareDisksItemArray(v []NilHostHardwareDisksItem) OptNilNilHostHardwareDisksItemArray {
+	return OptNilNilHostHardwareDisksItemArray{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptNilNilHostHardwareDisksItemArray is optional nullable []NilHostHardwareDisksItem.
+type OptNilNilHostHardwareDisksItemArray struct {
+	Value []NilHostHardwareDisksItem
+	Set   bool
+	Null  bool
+}
+
+// IsSet returns true if OptNilNilHostHardwareDisksItemArray was set.
+func (o OptNilNilHostHardwareDisksItemArray) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptNilNilHostHardwareDisksItemArray) Reset() {
+	var v []NilHostHardwareDisksItem
+	o.Value = v
+	o.Set = false
+	o.Null = false
+}
+
+// SetTo sets value to v.
+func (o *OptNilNilHostHardwareDisksItemArray) SetTo(v []NilHostHardwareDisksItem) {
+	o.Set = true
+	o.Null = false
+	o.Value = v
+}
+
+// IsSet returns true if value is Null.
+func (o OptNilNilHostHardwareDisksItemArray) IsNull() bool { return o.Null }
+
+// SetNull sets value to null.
+func (o *OptNilNilHostHardwareDisksItemArray) SetToNull() {
+	o.Set = true
+	o.Null = true
+	var v []NilHostHardwareDisksItem
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptNilNilHostHardwareDisksItemArray) Get() (v []NilHostHardwareDisksItem, ok bool) {
+	if o.Null {
+		return v, false
+	}
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptNilNilHostHardwareDisksItemArray) Or(d []NilHostHardwareDisksItem) []NilHostHardwareDisksItem {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptNilNilHostHardwareNicsItemArray returns new OptNilNilHostHardwareNicsItemArray with value set to v.
+func NewOptNilNilHostHardwareNicsItemArray(v []NilHostHardwareNicsItem) OptNilNilHostHardwareNicsItemArray {
+	return OptNilNilHostHardwareNicsItemArray{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptNilNilHostHardwareNicsItemArray is optional nullable []NilHostHardwareNicsItem.
+type OptNilNilHostHardwareNicsItemArray struct {
+	Value []NilHostHardwareNicsItem
+	Set   bool
+	Null  bool
+}
+
+// IsSet returns true if OptNilNilHostHardwareNicsItemArray was set.
+func (o OptNilNilHostHardwareNicsItemArray) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptNilNilHostHardwareNicsItemArray) Reset() {
+	var v []NilHostHardwareNicsItem
+	o.Value = v
+	o.Set = false
+	o.Null = false
+}
+
+// SetTo sets value to v.
+func (o *OptNilNilHostHardwareNicsItemArray) SetTo(v []NilHostHardwareNicsItem) {
+	o.Set = true
+	o.Null = false
+	o.Value = v
+}
+
+// IsSet returns true if value is Null.
+func (o OptNilNilHostHardwareNicsItemArray) IsNull() bool { return o.Null }
+
+// SetNull sets value to null.
+func (o *OptNilNilHostHardwareNicsItemArray) SetToNull() {
+	o.Set = true
+	o.Null = true
+	var v []NilHostHardwareNicsItem
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptNilNilHostHardwareNicsItemArray) Get() (v []NilHostHardwareNicsItem, ok bool) {
+	if o.Null {
+		return v, false
+	}
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptNilNilHostHardwareNicsItemArray) Or(d []NilHostHardwareNicsItem) []NilHostHardwareNicsItem {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptNilNilIntArray returns new OptNilNilIntArray with value set to v.
+func NewOptNilNilIntArray(v []NilInt) OptNilNilIntArray {
+	return OptNilNilIntArray{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptNilNilIntArray is optional nullable []NilInt.
+type OptNilNilIntArray struct {
+	Value []NilInt
+	Set   bool
+	Null  bool
+}
+
+// IsSet returns true if OptNilNilIntArray was set.
+func (o OptNilNilIntArray) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptNilNilIntArray) Reset() {
+	var v []NilInt
+	o.Value = v
+	o.Set = false
+	o.Null = false
+}
+
+// SetTo sets value to v.
+func (o *OptNilNilIntArray) SetTo(v []NilInt) {
+	o.Set = true
+	o.Null = false
+	o.Value = v
+}
+
+// IsSet returns true if value is Null.
+func (o OptNilNilIntArray) IsNull() bool { return o.Null }
+
+// SetNull sets value to null.
+func (o *OptNilNilIntArray) SetToNull() {
+	o.Set = true
+	o.Null = true
+	var v []NilInt
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptNilNilIntArray) Get() (v []NilInt, ok bool) {
+	if o.Null {
+		return v, false
+	}
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptNilNilIntArray) Or(d []NilInt) []NilInt {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptNilNilNodeAddRequestNodeListItemHardwareDimmsItemArray returns new OptNilNilNodeAddRequestNodeListItemHardwareDimmsItemArray with value set to v.
+func NewOptNilNilNodeAddRequestNodeListItemHardwareDimmsItemArray(v []NilNodeAddRequestNodeListItemHardwareDimmsItem) OptNilNilNodeAddRequestNodeListItemHardwareDimmsItemArray {
+	return OptNilNilNodeAddRequestNodeListItemHardwareDimmsItemArray{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptNilNilNodeAddRequestNodeListItemHardwareDimmsItemArray is optional nullable []NilNodeAddRequestNodeListItemHardwareDimmsItem.
+type OptNilNilNodeAddRequestNodeListItemHardwareDimmsItemArray struct {
+	Value []NilNodeAddRequestNodeListItemHardwareDimmsItem
+	Set   bool
+	Null  bool
+}
+
+// IsSet returns true if OptNilNilNodeAddRequestNodeListItemHardwareDimmsItemArray was set.
+func (o OptNilNilNodeAddRequestNodeListItemHardwareDimmsItemArray) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptNilNilNodeAddRequestNodeListItemHardwareDimmsItemArray) Reset() {
+	var v []NilNodeAddRequestNodeListItemHardwareDimmsItem
+	o.Value = v
+	o.Set = false
+	o.Null = false
+}
+
+// SetTo sets value to v.
+func (o *OptNilNilNodeAddRequestNodeListItemHardwareDimmsItemArray) SetTo(v []NilNodeAddRequestNodeListItemHardwareDimmsItem) {
+	o.Set = true
+	o.Null = false
+	o.Value = v
+}
+
+// IsSet returns true if value is Null.
+func (o OptNilNilNodeAddRequestNodeListItemHardwareDimmsItemArray) IsNull() bool { return o.Null }
+
+// SetNull sets value to null.
+func (o *OptNilNilNodeAddRequestNodeListItemHardwareDimmsItemArray) SetToNull() {
+	o.Set = true
+	o.Null = true
+	var v []NilNodeAddRequestNodeListItemHardwareDimmsItem
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptNilNilNodeAddRequestNodeListItemHardwareDimmsItemArray) Get() (v []NilNodeAddRequestNodeListItemHardwareDimmsItem, ok bool) {
+	if o.Null {
+		return v, false
+	}
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptNilNilNodeAddRequestNodeListItemHardwareDimmsItemArray) Or(d []NilNodeAddRequestNodeListItemHardwareDimmsItem) []NilNodeAddRequestNodeListItemHardwareDimmsItem {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptNilNilNodeAddRequestNodeListItemHardwareDisksItemArray returns new OptNilNilNodeAddRequestNodeListItemHardwareDisksItemArray with value set to v.
+func NewOptNilNilNodeAddRequestNodeListItemHardwareDisksItemArray(v []NilNodeAddRequestNodeListItemHardwareDisksItem) OptNilNilNodeAddRequestNodeListItemHardwareDisksItemArray {
+	return OptNilNilNodeAddRequestNodeListItemHardwareDisksItemArray{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptNilNilNodeAddRequestNodeListItemHardwareDisksItemArray is optional nullable []NilNodeAddRequestNodeListItemHardwareDisksItem.
+type OptNilNilNodeAddRequestNodeListItemHardwareDisksItemArray struct {
+	Value []NilNodeAddRequestNodeListItemHardwareDisksItem
+	Set   bool
+	Null  bool
+}
+
+// IsSet returns true if OptNilNilNodeAddRequestNodeListItemHardwareDisksItemArray was set.
+func (o OptNilNilNodeAddRequestNodeListItemHardwareDisksItemArray) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptNilNilNodeAddRequestNodeListItemHardwareDisksItemArray) Reset() {
+	var v []NilNodeAddRequestNodeListItemHardwareDisksItem
+	o.Value = v
+	o.Set = false
+	o.Null = false
+}
+
+// SetTo sets value to v.
+func (o *OptNilNilNodeAddRequestNodeListItemHardwareDisksItemArray) SetTo(v []NilNodeAddRequestNodeListItemHardwareDisksItem) {
+	o.Set = true
+	o.Null = false
+	o.Value = v
+}
+
+// IsSet returns true if value is Null.
+func (o OptNilNilNodeAddRequestNodeListItemHardwareDisksItemArray) IsNull() bool { return o.Null }
+
+// SetNull sets value to null.
+func (o *OptNilNilNodeAddRequestNodeListItemHardwareDisksItemArray) SetToNull() {
+	o.Set = true
+	o.Null = true
+	var v []NilNodeAddRequestNodeListItemHardwareDisksItem
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptNilNilNodeAddRequestNodeListItemHardwareDisksItemArray) Get() (v []NilNodeAddRequestNodeListItemHardwareDisksItem, ok bool) {
+	if o.Null {
+		return v, false
+	}
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptNilNilNodeAddRequestNodeListItemHardwareDisksItemArray) Or(d []NilNodeAddRequestNodeListItemHardwareDisksItem) []NilNodeAddRequestNodeListItemHardwareDisksItem {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptNilNilNodeAddRequestNodeListItemHardwareNicsItemArray returns new OptNilNilNodeAddRequestNodeListItemHardwareNicsItemArray with value set to v.
+func NewOptNilNilNodeAddRequestNodeListItemHardwareNicsItemArray(v []NilNodeAddRequestNodeListItemHardwareNicsItem) OptNilNilNodeAddRequestNodeListItemHardwareNicsItemArray {
+	return OptNilNilNodeAddRequestNodeListItemHardwareNicsItemArray{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptNilNilNodeAddRequestNodeListItemHardwareNicsItemArray is optional nullable []NilNodeAddRequestNodeListItemHardwareNicsItem.
+type OptNilNilNodeAddRequestNodeListItemHardwareNicsItemArray struct {
+	Value []NilNodeAddRequestNodeListItemHardwareNicsItem
+	Set   bool
+	Null  bool
+}
+
+// IsSet returns true if OptNilNilNodeAddRequestNodeListItemHardwareNicsItemArray was set.
+func (o OptNilNilNodeAddRequestNodeListItemHardwareNicsItemArray) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptNilNilNodeAddRequestNodeListItemHardwareNicsItemArray) Reset() {
+	var v []NilNodeAddRequestNodeListItemHardwareNicsItem
+	o.Value = v
+	o.Set = false
+	o.Null = false
+}
+
+// SetTo sets value to v.
+func (o *OptNilNilNodeAddRequestNodeListItemHardwareNicsItemArray) SetTo(v []NilNodeAddRequestNodeListItemHardwareNicsItem) {
+	o.Set = true
+	o.Null = false
+	o.Value = v
+}
+
+// IsSet returns true if value is Null.
+func (o OptNilNilNodeAddRequestNodeListItemHardwareNicsItemArray) IsNull() bool { return o.Null }
+
+// SetNull sets value to null.
+func (o *OptNilNilNodeAddRequestNodeListItemHardwareNicsItemArray) SetToNull() {
+	o.Set = true
+	o.Null = true
+	var v []NilNodeAddRequestNodeListItemHardwareNicsItem
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptNilNilNodeAddRequestNodeListItemHardwareNicsItemArray) Get() (v []NilNodeAddRequestNodeListItemHardwareNicsItem, ok bool) {
+	if o.Null {
+		return v, false
+	}
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptNilNilNodeAddRequestNodeListItemHardwareNicsItemArray) Or(d []NilNodeAddRequestNodeListItemHardwareNicsItem) []NilNodeAddRequestNodeListItemHardwareNicsItem {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptNilNilRedfishJobJobsItemArray returns new OptNilNilRedfishJobJobsItemArray with value set to v.
+func NewOptNilNilRedfishJobJobsItemArray(v []NilRedfishJobJobsItem) OptNilNilRedfishJobJobsItemArray {
+	return OptNilNilRedfishJobJobsItemArray{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptNilNilRedfishJobJobsItemArray is optional nullable []NilRedfishJobJobsItem.
+type OptNilNilRedfishJobJobsItemArray struct {
+	Value []NilRedfishJobJobsItem
+	Set   bool
+	Null  bool
+}
+
+// IsSet returns true if OptNilNilRedfishJobJobsItemArray was set.
+func (o OptNilNilRedfishJobJobsItemArray) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptNilNilRedfishJobJobsItemArray) Reset() {
+	var v []NilRedfishJobJobsItem
+	o.Value = v
+	o.Set = false
+	o.Null = false
+}
+
+// SetTo sets value to v.
+func (o *OptNilNilRedfishJobJobsItemArray) SetTo(v []NilRedfishJobJobsItem) {
+	o.Set = true
+	o.Null = false
+	o.Value = v
+}
+
+// IsSet returns true if value is Null.
+func (o OptNilNilRedfishJobJobsItemArray) IsNull() bool { return o.Null }
+
+// SetNull sets value to null.
+func (o *OptNilNilRedfishJobJobsItemArray) SetToNull() {
+	o.Set = true
+	o.Null = true
+	var v []NilRedfishJobJobsItem
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptNilNilRedfishJobJobsItemArray) Get() (v []NilRedfishJobJobsItem, ok bool) {
+	if o.Null {
+		return v, false
+	}
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptNilNilRedfishJobJobsItemArray) Or(d []NilRedfishJobJobsItem) []NilRedfishJobJobsItem {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptNilNilScheduledActionResultsItemArray returns new OptNilNilScheduledActionResultsItemArray with value set to v.
+func NewOptNilNilScheduledActionResultsItemArray(v []NilScheduledActionResultsItem) OptNilNilScheduledActionResultsItemArray {
+	return OptNilNilScheduledActionResultsItemArray{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptNilNilScheduledActionResultsItemArray is optional nullable []NilScheduledActionResultsItem.
+type OptNilNilScheduledActionResultsItemArray struct {
+	Value []NilScheduledActionResultsItem
+	Set   bool
+	Null  bool
+}
+
+// IsSet returns true if OptNilNilScheduledActionResultsItemArray was set.
+func (o OptNilNilScheduledActionResultsItemArray) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptNilNilScheduledActionResultsItemArray) Reset() {
+	var v []NilScheduledActionResultsItem
+	o.Value = v
+	o.Set = false
+	o.Null = false
+}
+
+// SetTo sets value to v.
+func (o *OptNilNilScheduledActionResultsItemArray) SetTo(v []NilScheduledActionResultsItem) {
+	o.Set = true
+	o.Null = false
+	o.Value = v
+}
+
+// IsSet returns true if value is Null.
+func (o OptNilNilScheduledActionResultsItemArray) IsNull() bool { return o.Null }
+
+// SetNull sets value to null.
+func (o *OptNilNilScheduledActionResultsItemArray) SetToNull() {
+	o.Set = true
+	o.Null = true
+	var v []NilScheduledActionResultsItem
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptNilNilScheduledActionResultsItemArray) Get() (v []NilScheduledActionResultsItem, ok bool) {
+	if o.Null {
+		return v, false
+	}
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptNilNilScheduledActionResultsItemArray) Or(d []NilScheduledActionResultsItem) []NilScheduledActionResultsItem {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptNilNilStringArray returns new OptNilNilStringArray with value set to v.
+func NewOptNilNilStringArray(v []NilString) OptNilNilStringArray {
+	return OptNilNilStringArray{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptNilNilStringArray is optional nullable []NilString.
+type OptNilNilStringArray struct {
+	Value []NilString
+	Set   bool
+	Null  bool
+}
+
+// IsSet returns true if OptNilNilStringArray was set.
+func (o OptNilNilStringArray) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptNilNilStringArray) Reset() {
+	var v []NilString
+	o.Value = v
+	o.Set = false
+	o.Null = false
+}
+
+// SetTo sets value to v.
+func (o *OptNilNilStringArray) SetTo(v []NilString) {
+	o.Set = true
+	o.Null = false
+	o.Value = v
+}
+
+// IsSet returns true if value is Null.
+func (o OptNilNilStringArray) IsNull() bool { return o.Null }
+
+// SetNull sets value to null.
+func (o *OptNilNilStringArray) SetToNull() {
+	o.Set = true
+	o.Null = true
+	var v []NilString
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptNilNilStringArray) Get() (v []NilString, ok bool) {
+	if o.Null {
+		return v, false
+	}
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptNilNilStringArray) Or(d []NilString) []NilString {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptNilNilTrashedHostHostHardwareDimmsItemArray returns new OptNilNilTrashedHostHostHardwareDimmsItemArray with value set to v.
+func NewOptNilNilTrashedHostHostHardwareDimmsItemArray(v []NilTrashedHostHostHardwareDimmsItem) OptNilNilTrashedHostHostHardwareDimmsItemArray {
+	return OptNilNilTrashedHostHostHardwareDimmsItemArray{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptNilNilTrashedHostHostHardwareDimmsItemArray is optional nullable []NilTrashedHostHostHardwareDimmsItem.
+type OptNilNilTrashedHostHostHardwareDimmsItemArray struct {
+	Value []NilTrashedHostHostHardwareDimmsItem
+	Set   bool
+	Null  bool
+}
+
+// IsSet returns true if OptNilNilTrashedHostHostHardwareDimmsItemArray was set.
+func (o OptNilNilTrashedHostHostHardwareDimmsItemArray) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptNilNilTrashedHostHostHardwareDimmsItemArray) Reset() {
+	var v []NilTrashedHostHostHardwareDimmsItem
+	o.Value = v
+	o.Set = false
+	o.Null = false
+}
+
+// SetTo sets value to v.
+func (o *OptNilNilTrashedHostHostHardwareDimmsItemArray) SetTo(v []NilTrashedHostHostHardwareDimmsItem) {
+	o.Set = true
+	o.Null = false
+	o.Value = v
+}
+
+// IsSet returns true if value is Null.
+func (o OptNilNilTrashedHostHostHardwareDimmsItemArray) IsNull() bool { return o.Null }
+
+// SetNull sets value to null.
+func (o *OptNilNilTrashedHostHostHardwareDimmsItemArray) SetToNull() {
+	o.Set = true
+	o.Null = true
+	var v []NilTrashedHostHostHardwareDimmsItem
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptNilNilTrashedHostHostHardwareDimmsItemArray) Get() (v []NilTrashedHostHostHardwareDimmsItem, ok bool) {
+	if o.Null {
+		return v, false
+	}
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptNilNilTrashedHostHostHardwareDimmsItemArray) Or(d []NilTrashedHostHostHardwareDimmsItem) []NilTrashedHostHostHardwareDimmsItem {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptNilNilTrashedHostHostHardwareDisksItemArray returns new OptNilNilTrashedHostHostHardwareDisksItemArray with value set to v.
+func NewOptNilNilTrashedHostHostHardwareDisksItemArray(v []NilTrashedHostHostHardwareDisksItem) OptNilNilTrashedHostHostHardwareDisksItemArray {
+	return OptNilNilTrashedHostHostHardwareDisksItemArray{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptNilNilTrashedHostHostHardwareDisksItemArray is optional nullable []NilTrashedHostHostHardwareDisksItem.
+type OptNilNilTrashedHostHostHardwareDisksItemArray struct {
+	Value []NilTrashedHostHostHardwareDisksItem
+	Set   bool
+	Null  bool
+}
+
+// IsSet returns true if OptNilNilTrashedHostHostHardwareDisksItemArray was set.
+func (o OptNilNilTrashedHostHostHardwareDisksItemArray) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptNilNilTrashedHostHostHardwareDisksItemArray) Reset() {
+	var v []NilTrashedHostHostHardwareDisksItem
+	o.Value = v
+	o.Set = false
+	o.Null = false
+}
+
+// SetTo sets value to v.
+func (o *OptNilNilTrashedHostHostHardwareDisksItemArray) SetTo(v []NilTrashedHostHostHardwareDisksItem) {
+	o.Set = true
+	o.Null = false
+	o.Value = v
+}
+
+// IsSet returns true if value is Null.
+func (o OptNilNilTrashedHostHostHardwareDisksItemArray) IsNull() bool { return o.Null }
+
+// SetNull sets value to null.
+func (o *OptNilNilTrashedHostHostHardwareDisksItemArray) SetToNull() {
+	o.Set = true
+	o.Null = true
+	var v []NilTrashedHostHostHardwareDisksItem
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptNilNilTrashedHostHostHardwareDisksItemArray) Get() (v []NilTrashedHostHostHardwareDisksItem, ok bool) {
+	if o.Null {
+		return v, false
+	}
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptNilNilTrashedHostHostHardwareDisksItemArray) Or(d []NilTrashedHostHostHardwareDisksItem) []NilTrashedHostHostHardwareDisksItem {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptNilNilTrashedHostHostHardwareNicsItemArray returns new OptNilNilTrashedHostHostHardwareNicsItemArray with value set to v.
+func NewOptNilNilTrashedHostHostHardwareNicsItemArray(v []NilTrashedHostHostHardwareNicsItem) OptNilNilTrashedHostHostHardwareNicsItemArray {
+	return OptNilNilTrashedHostHostHardwareNicsItemArray{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptNilNilTrashedHostHostHardwareNicsItemArray is optional nullable []NilTrashedHostHostHardwareNicsItem.
+type OptNilNilTrashedHostHostHardwareNicsItemArray struct {
+	Value []NilTrashedHostHostHardwareNicsItem
+	Set   bool
+	Null  bool
+}
+
+// IsSet returns true if OptNilNilTrashedHostHostHardwareNicsItemArray was set.
+func (o OptNilNilTrashedHostHostHardwareNicsItemArray) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptNilNilTrashedHostHostHardwareNicsItemArray) Reset() {
+	var v []NilTrashedHostHostHardwareNicsItem
+	o.Value = v
+	o.Set = false
+	o.Null = false
+}
+
+// SetTo sets value to v.
+func (o *OptNilNilTrashedHostHostHardwareNicsItemArray) SetTo(v []NilTrashedHostHostHardwareNicsItem) {
+	o.Set = true
+	o.Null = false
+	o.Value = v
+}
+
+// IsSet returns true if value is Null.
+func (o OptNilNilTrashedHostHostHardwareNicsItemArray) IsNull() bool { return o.Null }
+
+// SetNull sets value to null.
+func (o *OptNilNilTrashedHostHostHardwareNicsItemArray) SetToNull() {
+	o.Set = true
+	o.Null = true
+	var v []NilTrashedHostHostHardwareNicsItem
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptNilNilTrashedHostHostHardwareNicsItemArray) Get() (v []NilTrashedHostHostHardwareNicsItem, ok bool) {
+	if o.Null {
+		return v, false
+	}
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptNilNilTrashedHostHostHardwareNicsItemArray) Or(d []NilTrashedHostHostHardwareNicsItem) []NilTrashedHostHostHardwareNicsItem {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptNilNodeAddRequestNodeListItemHardware returns new OptNilNodeAddRequestNodeListItemHardware with value set to v.
+func NewOptNilNodeAddRequestNodeListItemHardware(v NodeAddRequestNodeListItemHardware) OptNilNodeAddRequestNodeListItemHardware {
+	return OptNilNodeAddRequestNodeListItemHardware{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptNilNodeAddRequestNodeListItemHardware is optional nullable NodeAddRequestNodeListItemHardware.
+type OptNilNodeAddRequestNodeListItemHardware struct {
+	Value NodeAddRequestNodeListItemHardware
+	Set   bool
+	Null  bool
+}
+
+// IsSet returns true if OptNilNodeAddRequestNodeListItemHardware was set.
+func (o OptNilNodeAddRequestNodeListItemHardware) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptNilNodeAddRequestNodeListItemHardware) Reset() {
+	var v NodeAddRequestNodeListItemHardware
+	o.Value = v
+	o.Set = false
+	o.Null = false
+}
+
+// SetTo sets value to v.
+func (o *OptNilNodeAddRequestNodeListItemHardware) SetTo(v NodeAddRequestNodeListItemHardware) {
+	o.Set = true
+	o.Null = false
+	o.Value = v
+}
+
+// IsSet returns true if value is Null.
+func (o OptNilNodeAddRequestNodeListItemHardware) IsNull() bool { return o.Null }
+
+// SetNull sets value to null.
+func (o *OptNilNodeAddRequestNodeListItemHardware) SetToNull() {
+	o.Set = true
+	o.Null = true
+	var v NodeAddRequestNodeListItemHardware
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptNilNodeAddRequestNodeListItemHardware) Get() (v NodeAddRequestNodeListItemHardware, ok bool) {
+	if o.Null {
+		return v, false
+	}
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptNilNodeAddRequestNodeListItemHardware) Or(d NodeAddRequestNodeListItemHardware) NodeAddRequestNodeListItemHardware {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptNilNodeAddRequestNodeListItemInventory returns new OptNilNodeAddRequestNodeListItemInventory with value set to v.
+func NewOptNilNodeAddRequestNodeListItemInventory(v NodeAddRequestNodeListItemInventory) OptNilNodeAddRequestNodeListItemInventory {
+	return OptNilNodeAddRequestNodeListItemInventory{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptNilNodeAddRequestNodeListItemInventory is optional nullable NodeAddRequestNodeListItemInventory.
+type OptNilNodeAddRequestNodeListItemInventory struct {
+	Value NodeAddRequestNodeListItemInventory
+	Set   bool
+	Null  bool
+}
+
+// IsSet returns true if OptNilNodeAddRequestNodeListItemInventory was set.
+func (o OptNilNodeAddRequestNodeListItemInventory) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptNilNodeAddRequestNodeListItemInventory) Reset() {
+	var v NodeAddRequestNodeListItemInventory
+	o.Value = v
+	o.Set = false
+	o.Null = false
+}
+
+// SetTo sets value to v.
+func (o *OptNilNodeAddRequestNodeListItemInventory) SetTo(v NodeAddRequestNodeListItemInventory) {
+	o.Set = true
+	o.Null = false
+	o.Value = v
+}
+
+// IsSet returns true if value is Null.
+func (o OptNilNodeAddRequestNodeListItemInventory) IsNull() bool { return o.Null }
+
+// SetNull sets value to null.
+func (o *OptNilNodeAddRequestNodeListItemInventory) SetToNull() {
+	o.Set = true
+	o.Null = true
+	var v NodeAddRequestNodeListItemInventory
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptNilNodeAddRequestNodeListItemInventory) Get() (v NodeAddRequestNodeListItemInventory, ok bool) {
+	if o.Null {
+		return v, false
+	}
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptNilNodeAddRequestNodeListItemInventory) Or(d NodeAddRequestNodeListItemInventory) NodeAddRequestNodeListItemInventory {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptNilRedfishSystemNicFirmware returns new OptNilRedfishSystemNicFirmware with value set to v.
+func NewOptNilRedfishSystemNicFirmware(v RedfishSystemNicFirmware) OptNilRedfishSystemNicFirmware {
+	return OptNilRedfishSystemNicFirmware{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptNilRedfishSystemNicFirmware is optional nullable RedfishSystemNicFirmware.
+type OptNilRedfishSystemNicFirmware struct {
+	Value RedfishSystemNicFirmware
+	Set   bool
+	Null  bool
+}
+
+// IsSet returns true if OptNilRedfishSystemNicFirmware was set.
+func (o OptNilRedfishSystemNicFirmware) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptNilRedfishSystemNicFirmware) Reset() {
+	var v RedfishSystemNicFirmware
+	o.Value = v
+	o.Set = false
+	o.Null = false
+}
+
+// SetTo sets value to v.
+func (o *OptNilRedfishSystemNicFirmware) SetTo(v RedfishSystemNicFirmware) {
+	o.Set = true
+	o.Null = false
+	o.Value = v
+}
+
+// IsSet returns true if value is Null.
+func (o OptNilRedfishSystemNicFirmware) IsNull() bool { return o.Null }
+
+// SetNull sets value to null.
+func (o *OptNilRedfishSystemNicFirmware) SetToNull() {
+	o.Set = true
+	o.Null = true
+	var v RedfishSystemNicFirmware
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptNilRedfishSystemNicFirmware) Get() (v RedfishSystemNicFirmware, ok bool) {
+	if o.Null {
+		return v, false
+	}
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptNilRedfishSystemNicFirmware) Or(d RedfishSystemNicFirmware) RedfishSystemNicFirmware {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptNilRedfishSystemOemDell returns new OptNilRedfishSystemOemDell with value set to v.
+func NewOptNilRedfishSystemOemDell(v RedfishSystemOemDell) OptNilRedfishSystemOemDell {
+	return OptNilRedfishSystemOemDell{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptNilRedfishSystemOemDell is optional nullable RedfishSystemOemDell.
+type OptNilRedfishSystemOemDell struct {
+	Value RedfishSystemOemDell
+	Set   bool
+	Null  bool
+}
+
+// IsSet returns true if OptNilRedfishSystemOemDell was set.
+func (o OptNilRedfishSystemOemDell) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptNilRedfishSystemOemDell) Reset() {
+	var v RedfishSystemOemDell
+	o.Value = v
+	o.Set = false
+	o.Null = false
+}
+
+// SetTo sets value to v.
+func (o *OptNilRedfishSystemOemDell) SetTo(v RedfishSystemOemDell) {
+	o.Set = true
+	o.Null = false
+	o.Value = v
+}
+
+// IsSet returns true if value is Null.
+func (o OptNilRedfishSystemOemDell) IsNull() bool { return o.Null }
+
+// SetNull sets value to null.
+func (o *OptNilRedfishSystemOemDell) SetToNull() {
+	o.Set = true
+	o.Null = true
+	var v RedfishSystemOemDell
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptNilRedfishSystemOemDell) Get() (v RedfishSystemOemDell, ok bool) {
+	if o.Null {
+		return v, false
+	}
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptNilRedfishSystemOemDell) Or(d RedfishSystemOemDell) RedfishSystemOemDell {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptNilRedfishSystemOemDellMessageDotExtendedInfoItemArray returns new OptNilRedfishSystemOemDellMessageDotExtendedInfoItemArray with value set to v.
+func NewOptNilRedfishSystemOemDellMessageDotExtendedInfoItemArray(v []RedfishSystemOemDellMessageDotExtendedInfoItem) OptNilRedfishSystemOemDellMessageDotExtendedInfoItemArray {
+	return OptNilRedfishSystemOemDellMessageDotExtendedInfoItemArray{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptNilRedfishSystemOemDellMessageDotExtendedInfoItemArray is optional nullable []RedfishSystemOemDellMessageDotExtendedInfoItem.
+type OptNilRedfishSystemOemDellMessageDotExtendedInfoItemArray struct {
+	Value []RedfishSystemOemDellMessageDotExtendedInfoItem
+	Set   bool
+	Null  bool
+}
+
+// IsSet returns true if OptNilRedfishSystemOemDellMessageDotExtendedInfoItemArray was set.
+func (o OptNilRedfishSystemOemDellMessageDotExtendedInfoItemArray) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptNilRedfishSystemOemDellMessageDotExtendedInfoItemArray) Reset() {
+	var v []RedfishSystemOemDellMessageDotExtendedInfoItem
+	o.Value = v
+	o.Set = false
+	o.Null = false
+}
+
+// SetTo sets value to v.
+func (o *OptNilRedfishSystemOemDellMessageDotExtendedInfoItemArray) SetTo(v []RedfishSystemOemDellMessageDotExtendedInfoItem) {
+	o.Set = true
+	o.Null = false
+	o.Value = v
+}
+
+// IsSet returns true if value is Null.
+func (o OptNilRedfishSystemOemDellMessageDotExtendedInfoItemArray) IsNull() bool { return o.Null }
+
+// SetNull sets value to null.
+func (o *OptNilRedfishSystemOemDellMessageDotExtendedInfoItemArray) SetToNull() {
+	o.Set = true
+	o.Null = true
+	var v []RedfishSystemOemDellMessageDotExtendedInfoItem
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptNilRedfishSystemOemDellMessageDotExtendedInfoItemArray) Get() (v []RedfishSystemOemDellMessageDotExtendedInfoItem, ok bool) {
+	if o.Null {
+		return v, false
+	}
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptNilRedfishSystemOemDellMessageDotExtendedInfoItemArray) Or(d []RedfishSystemOemDellMessageDotExtendedInfoItem) []RedfishSystemOemDellMessageDotExtendedInfoItem {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptNilString returns new OptNilString with value set to v.
+func NewOptNilString(v string) OptNilString {
+	return OptNilString{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptNilString is optional nullable string.
+type OptNilString struct {
+	Value string
+	Set   bool
+	Null  bool
+}
+
+// IsSet returns true if OptNilString was set.
+func (o OptNilString) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptNilString) Reset() {
+	var v string
+	o.Value = v
+	o.Set = false
+	o.Null = false
+}
+
+// SetTo sets value to v.
+func (o *OptNilString) SetTo(v string) {
+	o.Set = true
+	o.Null = false
+	o.Value = v
+}
+
+// IsSet returns true if value is Null.
+func (o OptNilString) IsNull() bool { return o.Null }
+
+// SetNull sets value to null.
+func (o *OptNilString) SetToNull() {
+	o.Set = true
+	o.Null = true
+	var v string
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptNilString) Get() (v string, ok bool) {
+	if o.Null {
+		return v, false
+	}
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptNilString) Or(d string) string {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptNilStringArray returns new OptNilStringArray with value set to v.
+func NewOptNilStringArray(v []string) OptNilStringArray {
+	return OptNilStringArray{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptNilStringArray is optional nullable []string.
+type OptNilStringArray struct {
+	Value []string
+	Set   bool
+	Null  bool
+}
+
+// IsSet returns true if OptNilStringArray was set.
+func (o OptNilStringArray) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptNilStringArray) Reset() {
+	var v []string
+	o.Value = v
+	o.Set = false
+	o.Null = false
+}
+
+// SetTo sets value to v.
+func (o *OptNilStringArray) SetTo(v []string) {
+	o.Set = true
+	o.Null = false
+	o.Value = v
+}
+
+// IsSet returns true if value is Null.
+func (o OptNilStringArray) IsNull() bool { return o.Null }
+
+// SetNull sets value to null.
+func (o *OptNilStringArray) SetToNull() {
+	o.Set = true
+	o.Null = true
+	var v []string
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptNilStringArray) Get() (v []string, ok bool) {
+	if o.Null {
+		return v, false
+	}
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptNilStringArray) Or(d []string) []string {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptNilTrashedHostHost returns new OptNilTrashedHostHost with value set to v.
+func NewOptNilTrashedHostHost(v TrashedHostHost) OptNilTrashedHostHost {
+	return OptNilTrashedHostHost{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptNilTrashedHostHost is optional nullable TrashedHostHost.
+type OptNilTrashedHostHost struct {
+	Value TrashedHostHost
+	Set   bool
+	Null  bool
+}
+
+// IsSet returns true if OptNilTrashedHostHost was set.
+func (o OptNilTrashedHostHost) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptNilTrashedHostHost) Reset() {
+	var v TrashedHostHost
+	o.Value = v
+	o.Set = false
+	o.Null = false
+}
+
+// SetTo sets value to v.
+func (o *OptNilTrashedHostHost) SetTo(v TrashedHostHost) {
+	o.Set = true
+	o.Null = false
+	o.Value = v
+}
+
+// IsSet returns true if value is Null.
+func (o OptNilTrashedHostHost) IsNull() bool { return o.Null }
+
+// SetNull sets value to null.
+func (o *OptNilTrashedHostHost) SetToNull() {
+	o.Set = true
+	o.Null = true
+	var v TrashedHostHost
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptNilTrashedHostHost) Get() (v TrashedHostHost, ok bool) {
+	if o.Null {
+		return v, false
+	}
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptNilTrashedHostHost) Or(d TrashedHostHost) TrashedHostHost {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptNilTrashedHostHostHardware returns new OptNilTrashedHostHostHardware with value set to v.
+func NewOptNilTrashedHostHostHardware(v TrashedHostHostHardware) OptNilTrashedHostHostHardware {
+	return OptNilTrashedHostHostHardware{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptNilTrashedHostHostHardware is optional nullable TrashedHostHostHardware.
+type OptNilTrashedHostHostHardware struct {
+	Value TrashedHostHostHardware
+	Set   bool
+	Null  bool
+}
+
+// IsSet returns true if OptNilTrashedHostHostHardware was set.
+func (o OptNilTrashedHostHostHardware) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptNilTrashedHostHostHardware) Reset() {
+	var v TrashedHostHostHardware
+	o.Value = v
+	o.Set = false
+	o.Null = false
+}
+
+// SetTo sets value to v.
+func (o *OptNilTrashedHostHostHardware) SetTo(v TrashedHostHostHardware) {
+	o.Set = true
+	o.Null = false
+	o.Value = v
+}
+
+// IsSet returns true if value is Null.
+func (o OptNilTrashedHostHostHardware) IsNull() bool { return o.Null }
+
+// SetNull sets value to null.
+func (o *OptNilTrashedHostHostHardware) SetToNull() {
+	o.Set = true
+	o.Null = true
+	var v TrashedHostHostHardware
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptNilTrashedHostHostHardware) Get() (v TrashedHostHostHardware, ok bool) {
+	if o.Null {
+		return v, false
+	}
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptNilTrashedHostHostHardware) Or(d TrashedHostHostHardware) TrashedHostHostHardware {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptNilTrashedHostHostInventory returns new OptNilTrashedHostHostInventory with value set to v.
+func NewOptNilTrashedHostHostInventory(v TrashedHostHostInventory) OptNilTrashedHostHostInventory {
+	return OptNilTrashedHostHostInventory{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptNilTrashedHostHostInventory is optional nullable TrashedHostHostInventory.
+type OptNilTrashedHostHostInventory struct {
+	Value TrashedHostHostInventory
+	Set   bool
+	Null  bool
+}
+
+// IsSet returns true if OptNilTrashedHostHostInventory was set.
+func (o OptNilTrashedHostHostInventory) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptNilTrashedHostHostInventory) Reset() {
+	var v TrashedHostHostInventory
+	o.Value = v
+	o.Set = false
+	o.Null = false
+}
+
+// SetTo sets value to v.
+func (o *OptNilTrashedHostHostInventory) SetTo(v TrashedHostHostInventory) {
+	o.Set = true
+	o.Null = false
+	o.Value = v
+}
+
+// IsSet returns true if value is Null.
+func (o OptNilTrashedHostHostInventory) IsNull() bool { return o.Null }
+
+// SetNull sets value to null.
+func (o *OptNilTrashedHostHostInventory) SetToNull() {
+	o.Set = true
+	o.Null = true
+	var v TrashedHostHostInventory
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptNilTrashedHostHostInventory) Get() (v TrashedHostHostInventory, ok bool) {
+	if o.Null {
+		return v, false
+	}
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptNilTrashedHostHostInventory) Or(d TrashedHostHostInventory) TrashedHostHostInventory {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptNodeAddRequestNodeListItemBondsItemOptions returns new OptNodeAddRequestNodeListItemBondsItemOptions with value set to v.
+func NewOptNodeAddRequestNodeListItemBondsItemOptions(v NodeAddRequestNodeListItemBondsItemOptions) OptNodeAddRequestNodeListItemBondsItemOptions {
+	return OptNodeAddRequestNodeListItemBondsItemOptions{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptNodeAddRequestNodeListItemBondsItemOptions is optional NodeAddRequestNodeListItemBondsItemOptions.
+type OptNodeAddRequestNodeListItemBondsItemOptions struct {
+	Value NodeAddRequestNodeListItemBondsItemOptions
+	Set   bool
+}
+
+// IsSet returns true if OptNodeAddRequestNodeListItemBondsItemOptions was set.
+func (o OptNodeAddRequestNodeListItemBondsItemOptions) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptNodeAddRequestNodeListItemBondsItemOptions) Reset() {
+	var v NodeAddRequestNodeListItemBondsItemOptions
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptNodeAddRequestNodeListItemBondsItemOptions) SetTo(v NodeAddRequestNodeListItemBondsItemOptions) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptNodeAddRequestNodeListItemBondsItemOptions) Get() (v NodeAddRequestNodeListItemBondsItemOptions, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptNodeAddRequestNodeListItemBondsItemOptions) Or(d NodeAddRequestNodeListItemBondsItemOptions) NodeAddRequestNodeListItemBondsItemOptions {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptNodeAddRequestNodeListItemInventoryNicFirmware returns new OptNodeAddRequestNodeListItemInventoryNicFirmware with value set to v.
+func NewOptNodeAddRequestNodeListItemInventoryNicFirmware(v NodeAddRequestNodeListItemInventoryNicFirmware) OptNodeAddRequestNodeListItemInventoryNicFirmware {
+	return OptNodeAddRequestNodeListItemInventoryNicFirmware{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptNodeAddRequestNodeListItemInventoryNicFirmware is optional NodeAddRequestNodeListItemInventoryNicFirmware.
+type OptNodeAddRequestNodeListItemInventoryNicFirmware struct {
+	Value NodeAddRequestNodeListItemInventoryNicFirmware
+	Set   bool
+}
+
+// IsSet returns true if OptNodeAddRequestNodeListItemInventoryNicFirmware was set.
+func (o OptNodeAddRequestNodeListItemInventoryNicFirmware) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptNodeAddRequestNodeListItemInventoryNicFirmware) Reset() {
+	var v NodeAddRequestNodeListItemInventoryNicFirmware
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptNodeAddRequestNodeListItemInventoryNicFirmware) SetTo(v NodeAddRequestNodeListItemInventoryNicFirmware) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptNodeAddRequestNodeListItemInventoryNicFirmware) Get() (v NodeAddRequestNodeListItemInventoryNicFirmware, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptNodeAddRequestNodeListItemInventoryNicFirmware) Or(d NodeAddRequestNodeListItemInventoryNicFirmware) NodeAddRequestNodeListItemInventoryNicFirmware {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptPrometheusTargetGroupLabels returns new OptPrometheusTargetGroupLabels with value set to v.
+func NewOptPrometheusTargetGroupLabels(v PrometheusTargetGroupLabels) OptPrometheusTargetGroupLabels {
+	return OptPrometheusTargetGroupLabels{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptPrometheusTargetGroupLabels is optional PrometheusTargetGroupLabels.
+type OptPrometheusTargetGroupLabels struct {
+	Value PrometheusTargetGroupLabels
+	Set   bool
+}
+
+// IsSet returns true if OptPrometheusTargetGroupLabels was set.
+func (o OptPrometheusTargetGroupLabels) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptPrometheusTargetGroupLabels) Reset() {
+	var v PrometheusTargetGroupLabels
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptPrometheusTargetGroupLabels) SetTo(v PrometheusTargetGroupLabels) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptPrometheusTargetGroupLabels) Get() (v PrometheusTargetGroupLabels, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptPrometheusTargetGroupLabels) Or(d PrometheusTargetGroupLabels) PrometheusTargetGroupLabels {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptRedfishJobJobsItemParameters returns new OptRedfishJobJobsItemParameters with value set to v.
+func NewOptRedfishJobJobsItemParameters(v RedfishJobJobsItemParameters) OptRedfishJobJobsItemParameters {
+	return OptRedfishJobJobsItemParameters{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptRedfishJobJobsItemParameters is optional RedfishJobJobsItemParameters.
+type OptRedfishJobJobsItemParameters struct {
+	Value RedfishJobJobsItemParameters
+	Set   bool
+}
+
+// IsSet returns true if OptRedfishJobJobsItemParameters was set.
+func (o OptRedfishJobJobsItemParameters) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptRedfishJobJobsItemParameters) Reset() {
+	var v RedfishJobJobsItemParameters
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptRedfishJobJobsItemParameters) SetTo(v RedfishJobJobsItemParameters) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptRedfishJobJobsItemParameters) Get() (v RedfishJobJobsItemParameters, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptRedfishJobJobsItemParameters) Or(d RedfishJobJobsItemParameters) RedfishJobJobsItemParameters {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptRedfishJobJobsItemPayload returns new OptRedfishJobJobsItemPayload with value set to v.
+func NewOptRedfishJobJobsItemPayload(v RedfishJobJobsItemPayload) OptRedfishJobJobsItemPayload {
+	return OptRedfishJobJobsItemPayload{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptRedfishJobJobsItemPayload is optional RedfishJobJobsItemPayload.
+type OptRedfishJobJobsItemPayload struct {
+	Value RedfishJobJobsItemPayload
+	Set   bool
+}
+
+// IsSet returns true if OptRedfishJobJobsItemPayload was set.
+func (o OptRedfishJobJobsItemPayload) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptRedfishJobJobsItemPayload) Reset() {
+	var v RedfishJobJobsItemPayload
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptRedfishJobJobsItemPayload) SetTo(v RedfishJobJobsItemPayload) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptRedfishJobJobsItemPayload) Get() (v RedfishJobJobsItemPayload, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptRedfishJobJobsItemPayload) Or(d RedfishJobJobsItemPayload) RedfishJobJobsItemPayload {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptRedfishJobJobsItemSchedule returns new OptRedfishJobJobsItemSchedule with value set to v.
+func NewOptRedfishJobJobsItemSchedule(v RedfishJobJobsItemSchedule) OptRedfishJobJobsItemSchedule {
+	return OptRedfishJobJobsItemSchedule{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptRedfishJobJobsItemSchedule is optional RedfishJobJobsItemSchedule.
+type OptRedfishJobJobsItemSchedule struct {
+	Value RedfishJobJobsItemSchedule
+	Set   bool
+}
+
+// IsSet returns true if OptRedfishJobJobsItemSchedule was set.
+func (o OptRedfishJobJobsItemSchedule) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptRedfishJobJobsItemSchedule) Reset() {
+	var v RedfishJobJobsItemSchedule
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptRedfishJobJobsItemSchedule) SetTo(v RedfishJobJobsItemSchedule) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptRedfishJobJobsItemSchedule) Get() (v RedfishJobJobsItemSchedule, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptRedfishJobJobsItemSchedule) Or(d RedfishJobJobsItemSchedule) RedfishJobJobsItemSchedule {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptScheduledActionResultsItemRedfishError returns new OptScheduledActionResultsItemRedfishError with value set to v.
+func NewOptScheduledActionResultsItemRedfishError(v ScheduledActionResultsItemRedfishError) OptScheduledActionResultsItemRedfishError {
+	return OptScheduledActionResultsItemRedfishError{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptScheduledActionResultsItemRedfishError is optional ScheduledActionResultsItemRedfishError.
+type OptScheduledActionResultsItemRedfishError struct {
+	Value ScheduledActionResultsItemRedfishError
+	Set   bool
+}
+
+// IsSet returns true if OptScheduledActionResultsItemRedfishError was set.
+func (o OptScheduledActionResultsItemRedfishError) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptScheduledActionResultsItemRedfishError) Reset() {
+	var v ScheduledActionResultsItemRedfishError
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptScheduledActionResultsItemRedfishError) SetTo(v ScheduledActionResultsItemRedfishError) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptScheduledActionResultsItemRedfishError) Get() (v ScheduledActionResultsItemRedfishError, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptScheduledActionResultsItemRedfishError) Or(d ScheduledActionResultsItemRedfishError) ScheduledActionResultsItemRedfishError {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptScheduledActionResultsItemRedfishErrorError returns new OptScheduledActionResultsItemRedfishErrorError with value set to v.
+func NewOptScheduledActionResultsItemRedfishErrorError(v ScheduledActionResultsItemRedfishErrorError) OptScheduledActionResultsItemRedfishErrorError {
+	return OptScheduledActionResultsItemRedfishErrorError{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptScheduledActionResultsItemRedfishErrorError is optional ScheduledActionResultsItemRedfishErrorError.
+type OptScheduledActionResultsItemRedfishErrorError struct {
+	Value ScheduledActionResultsItemRedfishErrorError
+	Set   bool
+}
+
+// IsSet returns true if OptScheduledActionResultsItemRedfishErrorError was set.
+func (o OptScheduledActionResultsItemRedfishErrorError) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptScheduledActionResultsItemRedfishErrorError) Reset() {
+	var v ScheduledActionResultsItemRedfishErrorError
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptScheduledActionResultsItemRedfishErrorError) SetTo(v ScheduledActionResultsItemRedfishErrorError) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptScheduledActionResultsItemRedfishErrorError) Get() (v ScheduledActionResultsItemRedfishErrorError, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptScheduledActionResultsItemRedfishErrorError) Or(d ScheduledActionResultsItemRedfishErrorError) ScheduledActionResultsItemRedfishErrorError {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptStatsHosts returns new OptStatsHosts with value set to v.
+func NewOptStatsHosts(v StatsHosts) OptStatsHosts {
+	return OptStatsHosts{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptStatsHosts is optional StatsHosts.
+type OptStatsHosts struct {
+	Value StatsHosts
+	Set   bool
+}
+
+// IsSet returns true if OptStatsHosts was set.
+func (o OptStatsHosts) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptStatsHosts) Reset() {
+	var v StatsHosts
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptStatsHosts) SetTo(v StatsHosts) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptStatsHosts) Get() (v StatsHosts, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptStatsHosts) Or(d StatsHosts) StatsHosts {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptString returns new OptString with value set to v.
+func NewOptString(v string) OptString {
+	return OptString{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptString is optional string.
+type OptString struct {
+	Value string
+	Set   bool
+}
+
+// IsSet returns true if OptString was set.
+func (o OptString) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptString) Reset() {
+	var v string
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptString) SetTo(v string) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptString) Get() (v string, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptString) Or(d string) string {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptTrashedHostHostBondsItemOptions returns new OptTrashedHostHostBondsItemOptions with value set to v.
+func NewOptTrashedHostHostBondsItemOptions(v TrashedHostHostBondsItemOptions) OptTrashedHostHostBondsItemOptions {
+	return OptTrashedHostHostBondsItemOptions{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptTrashedHostHostBondsItemOptions is optional TrashedHostHostBondsItemOptions.
+type OptTrashedHostHostBondsItemOptions struct {
+	Value TrashedHostHostBondsItemOptions
+	Set   bool
+}
+
+// IsSet returns true if OptTrashedHostHostBondsItemOptions was set.
+func (o OptTrashedHostHostBondsItemOptions) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptTrashedHostHostBondsItemOptions) Reset() {
+	var v TrashedHostHostBondsItemOptions
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptTrashedHostHostBondsItemOptions) SetTo(v TrashedHostHostBondsItemOptions) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptTrashedHostHostBondsItemOptions) Get() (v TrashedHostHostBondsItemOptions, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptTrashedHostHostBondsItemOptions) Or(d TrashedHostHostBondsItemOptions) TrashedHostHostBondsItemOptions {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptTrashedHostHostInventoryNicFirmware returns new OptTrashedHostHostInventoryNicFirmware with value set to v.
+func NewOptTrashedHostHostInventoryNicFirmware(v TrashedHostHostInventoryNicFirmware) OptTrashedHostHostInventoryNicFirmware {
+	return OptTrashedHostHostInventoryNicFirmware{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptTrashedHostHostInventoryNicFirmware is optional TrashedHostHostInventoryNicFirmware.
+type OptTrashedHostHostInventoryNicFirmware struct {
+	Value TrashedHostHostInventoryNicFirmware
+	Set   bool
+}
+
+// IsSet returns true if OptTrashedHostHostInventoryNicFirmware was set.
+func (o OptTrashedHostHostInventoryNicFirmware) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptTrashedHostHostInventoryNicFirmware) Reset() {
+	var v TrashedHostHostInventoryNicFirmware
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptTrashedHostHostInventoryNicFirmware) SetTo(v TrashedHostHostInventoryNicFirmware) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptTrashedHostHostInventoryNicFirmware) Get() (v TrashedHostHostInventoryNicFirmware, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptTrashedHostHostInventoryNicFirmware) Or(d TrashedHostHostInventoryNicFirmware) TrashedHostHostInventoryNicFirmware {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// PasswordRotationReport schema.
+// Ref: #/components/schemas/PasswordRotationReport
+type PasswordRotationReport struct {
+	Attention OptBool   `json:"attention"`
+	Host      OptString `json:"host"`
+	Msg       OptString `json:"msg"`
+	Status    OptString `json:"status"`
+	Username  OptString `json:"username"`
+}
+
+// GetAttention returns the value of Attention.
+func (s *PasswordRotationReport) GetAttention() OptBool {
+	return s.Attention
+}
+
+// GetHost returns the value of Host.
+func (s *PasswordRotationReport) GetHost() OptString {
+	return s.Host
+}
+
+// GetMsg returns the value of Msg.
+func (s *PasswordRotationReport) GetMsg() OptString {
+	return s.Msg
+}
+
+// GetStatus returns the value of Status.
+func (s *PasswordRotationReport) GetStatus() OptString {
+	return s.Status
+}
+
+// GetUsername returns the value of Username.
+func (s *PasswordRotationReport) GetUsername() OptString {
+	return s.Username
+}
+
+// SetAttention sets the value of Attention.
+func (s *PasswordRotationReport) SetAttention(val OptBool) {
+	s.Attention = val
+}
+
+// SetHost sets the value of Host.
+func (s *PasswordRotationReport) SetHost(val OptString) {
+	s.Host = val
+}
+
+// SetMsg sets the value of Msg.
+func (s *PasswordRotationReport) SetMsg(val OptString) {
+	s.Msg = val
+}
+
+// SetStatus sets the value of Status.
+func (s *PasswordRotationReport) SetStatus(val OptString) {
+	s.Status = val
+}
+
+// SetUsername sets the value of Username.
+func (s *PasswordRotationReport) SetUsername(val OptString) {
+	s.Username = val
+}
+
+// PatchRolesRequest schema.
+// Ref: #/components/schemas/PatchRolesRequest
+type PatchRolesRequest struct {
+	PermissionList []PatchRolesRequestPermissionListItem `json:"permission_list"`
+	Role           OptString                             `json:"role"`
+}
+
+// GetPermissionList returns the value of PermissionList.
+func (s *PatchRolesRequest) GetPermissionList() []PatchRolesRequestPermissionListItem {
+	return s.PermissionList
+}
+
+// GetRole returns the value of Role.
+func (s *PatchRolesRequest) GetRole() OptString {
+	return s.Role
+}
+
+// SetPermissionList sets the value of PermissionList.
+func (s *PatchRolesRequest) SetPermissionList(val []PatchRolesRequestPermissionListItem) {
+	s.PermissionList = val
+}
+
+// SetRole sets the value of Role.
+func (s *PatchRolesRequest) SetRole(val OptString) {
+	s.Role = val
+}
+
+type PatchRolesRequestPermissionListItem struct {
+	Method OptString `json:"method"`
+	Path   OptString `json:"path"`
+}
+
+// GetMethod returns the value of Method.
+func (s *PatchRolesRequestPermissionListItem) GetMethod() OptString {
+	return s.Method
+}
+
+// GetPath returns the value of Path.
+func (s *PatchRolesRequestPermissionListItem) GetPath() OptString {
+	return s.Path
+}
+
+// SetMethod sets the value of Method.
+func (s *PatchRolesRequestPermissionListItem) SetMethod(val OptString) {
+	s.Method = val
+}
+
+// SetPath sets the value of Path.
+func (s *PatchRolesRequestPermissionListItem) SetPath(val OptString) {
+	s.Path = val
+}
+
+// PendingBMC schema.
+// Ref: #/components/schemas/PendingBMC
+type PendingBMC struct {
+	FirstSeen   OptDateTime `json:"first_seen"`
+	LastSeen    OptDateTime `json:"last_seen"`
+	MAC         OptString   `json:"mac"`
+	Relay       OptString   `json:"relay"`
+	ServerIP    OptString   `json:"server_ip"`
+	VendorClass OptString   `json:"vendor_class"`
+}
+
+// GetFirstSeen returns the value of FirstSeen.
+func (s *PendingBMC) GetFirstSeen() OptDateTime {
+	return s.FirstSeen
+}
+
+// GetLastSeen returns the value of LastSeen.
+func (s *PendingBMC) GetLastSeen() OptDateTime {
+	return s.LastSeen
+}
+
+// GetMAC returns the value of MAC.
+func (s *PendingBMC) GetMAC() OptString {
+	return s.MAC
+}
+
+// GetRelay returns the value of Relay.
+func (s *PendingBMC) GetRelay() OptString {
+	return s.Relay
+}
+
+// GetServerIP returns the value of ServerIP.
+func (s *PendingBMC) GetServerIP() OptString {
+	return s.ServerIP
+}
+
+// GetVendorClass returns the value of VendorClass.
+func (s *PendingBMC) GetVendorClass() OptString {
+	return s.VendorClass
+}
+
+// SetFirstSeen sets the value of FirstSeen.
+func (s *PendingBMC) SetFirstSeen(val OptDateTime) {
+	s.FirstSeen = val
+}
+
+// SetLastSeen sets the value of LastSeen.
+func (s *PendingBMC) SetLastSeen(val OptDateTime) {
+	s.LastSeen = val
+}
+
+// SetMAC sets the value of MAC.
+func (s *PendingBMC) SetMAC(val OptString) {
+	s.MAC = val
+}
+
+// SetRelay sets the value of Relay.
+func (s *PendingBMC) SetRelay(val OptString) {
+	s.Relay = val
+}
+
+// SetServerIP sets the value of ServerIP.
+func (s *PendingBMC) SetServerIP(val OptString) {
+	s.ServerIP = val
+}
+
+// SetVendorClass sets the value of VendorClass.
+func (s *PendingBMC) SetVendorClass(val OptString) {
+	s.VendorClass = val
+}
+
+// PostRolesRequest schema.
+// Ref: #/components/schemas/PostRolesRequest
+type PostRolesRequest struct {
+	InheritedRole OptString `json:"inherited_role"`
+	Role          OptString `json:"role"`
+}
+
+// GetInheritedRole returns the value of InheritedRole.
+func (s *PostRolesRequest) GetInheritedRole() OptString {
+	return s.InheritedRole
+}
+
+// GetRole returns the value of Role.
+func (s *PostRolesRequest) GetRole() OptString {
+	return s.Role
+}
+
+// SetInheritedRole sets the value of InheritedRole.
+func (s *PostRolesRequest) SetInheritedRole(val OptString) {
+	s.InheritedRole = val
+}
+
+// SetRole sets the value of Role.
+func (s *PostRolesRequest) SetRole(val OptString) {
+	s.Role = val
+}
+
+// PrometheusTargetGroup schema.
+// Ref: #/components/schemas/PrometheusTargetGroup
+type PrometheusTargetGroup struct {
+	Labels  OptPrometheusTargetGroupLabels `json:"labels"`
+	Targets []string                       `json:"targets"`
+}
+
+// GetLabels returns the value of Labels.
+func (s *PrometheusTargetGroup) GetLabels() OptPrometheusTargetGroupLabels {
+	return s.Labels
+}
+
+// GetTargets returns the value of Targets.
+func (s *PrometheusTargetGroup) GetTargets() []string {
+	return s.Targets
+}
+
+// SetLabels sets the value of Labels.
+func (s *PrometheusTargetGroup) SetLabels(val OptPrometheusTargetGroupLabels) {
+	s.Labels = val
+}
+
+// SetTargets sets the value of Targets.
+func (s *PrometheusTargetGroup) SetTargets(val []string) {
+	s.Targets = val
+}
+
+type PrometheusTargetGroupLabels map[string]string
+
+func (s *PrometheusTargetGroupLabels) init() PrometheusTargetGroupLabels {
+	m := *s
+	if m == nil {
+		m = map[string]string{}
+		*s = m
+	}
+	return m
+}
+
+// ReadOnlyResponse schema.
+// Ref: #/components/schemas/ReadOnlyResponse
+type ReadOnlyResponse struct {
+	Enabled OptBool `json:"enabled"`
+	// Grendel instance accepting the writes refused by this one.
+	Primary OptNilString `json:"primary"`
+}
+
+// GetEnabled returns the value of Enabled.
+func (s *ReadOnlyResponse) GetEnabled() OptBool {
+	return s.Enabled
+}
+
+// GetPrimary returns the value of Primary.
+func (s *ReadOnlyResponse) GetPrimary() OptNilString {
+	return s.Primary
+}
+
+// SetEnabled sets the value of Enabled.
+func (s *ReadOnlyResponse) SetEnabled(val OptBool) {
+	s.Enabled = val
+}
+
+// SetPrimary sets the value of Primary.
+func (s *ReadOnlyResponse) SetPrimary(val OptNilString) {
+	s.Primary = val
+}
+
+// Record schema.
+// Ref: #/components/schemas/Record
+type Record struct {
+	ID    OptNilInt64 `json:"id"`
+	Name  string      `json:"name"`
+	Ptr   OptBool     `json:"ptr"`
+	TTL   OptInt64    `json:"ttl"`
+	Type  string      `json:"type"`
+	Value string      `json:"value"`
+}
+
+// GetID returns the value of ID.
+func (s *Record) GetID() OptNilInt64 {
+	return s.ID
+}
+
+// GetName returns the value of Name.
+func (s *Record) GetName() string {
+	return s.Name
+}
+
+// GetPtr returns the value of Ptr.
+func (s *Record) GetPtr() OptBool {
+	return s.Ptr
+}
+
+// GetTTL returns the value of TTL.
+func (s *Record) GetTTL() OptInt64 {
+	return s.TTL
+}
+
+// GetType returns the value of Type.
+func (s *Record) GetType() string {
+	return s.Type
+}
+
+// GetValue returns the value of Value.
+func (s *Record) GetValue() string {
+	return s.Value
+}
+
+// SetID sets the value of ID.
+func (s *Record) SetID(val OptNilInt64) {
+	s.ID = val
+}
+
+// SetName sets the value of Name.
+func (s *Record) SetName(val string) {
+	s.Name = val
+}
+
+// SetPtr sets the value of Ptr.
+func (s *Record) SetPtr(val OptBool) {
+	s.Ptr = val
+}
+
+// SetTTL sets the value of TTL.
+func (s *Record) SetTTL(val OptInt64) {
+	s.TTL = val
+}
+
+// SetType sets the value of Type.
+func (s *Record) SetType(val string) {
+	s.Type = val
+}
+
+// SetValue sets the value of Value.
+func (s *Record) SetValue(val string) {
+	s.Value = val
+}
+
+// RedfishDellUpgradeFirmware schema.
+// Ref: #/components/schemas/RedfishDellUpgradeFirmware
+type RedfishDellUpgradeFirmware struct {
+	Message          OptString                                  `json:"Message"`
+	Name             OptString                                  `json:"Name"`
+	Status           OptString                                  `json:"Status"`
+	UpdateCount      OptInt                                     `json:"UpdateCount"`
+	UpdateList       []RedfishDellUpgradeFirmwareUpdateListItem `json:"UpdateList"`
+	UpdateRebootType OptString                                  `json:"UpdateRebootType"`
+}
+
+// GetMessage returns the value of Message.
+func (s *RedfishDellUpgradeFirmware) GetMessage() OptString {
+	return s.Message
+}
+
+// GetName returns the value of Name.
+func (s *RedfishDellUpgradeFirmware) GetName() OptString {
+	return s.Name
+}
+
+// GetStatus returns the value of Status.
+func (s *RedfishDellUpgradeFirmware) GetStatus() OptString {
+	return s.Status
+}
+
+// GetUpdateCount returns the value of UpdateCount.
+func (s *RedfishDellUpgradeFirmware) GetUpdateCount() OptInt {
+	return s.UpdateCount
+}
+
+// GetUpdateList returns the value of UpdateList.
+func (s *RedfishDellUpgradeFirmware) GetUpdateList() []RedfishDellUpgradeFirmwareUpdateListItem {
+	return s.UpdateList
+}
+
+// GetUpdateRebootType returns the value of UpdateRebootType.
+func (s *RedfishDellUpgradeFirmware) GetUpdateRebootType() OptString {
+	return s.UpdateRebootType
+}
+
+// SetMessage sets the value of Message.
+func (s *RedfishDellUpgradeFirmware) SetMessage(val OptString) {
+	s.Message = val
+}
+
+// SetName sets the value of Name.
+func (s *RedfishDellUpgradeFirmware) SetName(val OptString) {
+	s.Name = val
+}
+
+// SetStatus sets the value of Status.
+func (s *RedfishDellUpgradeFirmware) SetStatus(val OptString) {
+	s.Status = val
+}
+
+// SetUpdateCount sets the value of UpdateCount.
+func (s *RedfishDellUpgradeFirmware) SetUpdateCount(val OptInt) {
+	s.UpdateCount = val
+}
+
+// SetUpdateList sets the value of UpdateList.
+func (s *RedfishDellUpgradeFirmware) SetUpdateList(val []RedfishDellUpgradeFirmwareUpdateListItem) {
+	s.UpdateList = val
+}
+
+// SetUpdateRebootType sets the value of UpdateRebootType.
+func (s *RedfishDellUpgradeFirmware) SetUpdateRebootType(val OptString) {
+	s.UpdateRebootType = val
+}
+
+type RedfishDellUpgradeFirmwareUpdateListItem struct {
+	BaseLocation       OptString `json:"BaseLocation"`
+	ComponentID        OptString `json:"ComponentID"`
+	ComponentInfoName  OptString `json:"ComponentInfoName"`
+	ComponentInfoValue OptString `json:"ComponentInfoValue"`
+	ComponentType      OptString `json:"ComponentType"`
+	Criticality        OptString `json:"Criticality"`
+	DisplayName        OptString `json:"DisplayName"`
+	InstalledVersion   OptString `json:"InstalledVersion"`
+	JobID              OptString `json:"JobID"`
+	Name               OptString `json:"Name"`
+	PackageName        OptString `json:"PackageName"`
+	PackagePath        OptString `json:"PackagePath"`
+	PackageVersion     OptString `json:"PackageVersion"`
+	RebootType         OptString `json:"RebootType"`
+	Target             OptString `json:"Target"`
+}
+
+// GetBaseLocation returns the value of BaseLocation.
+func (s *RedfishDellUpgradeFirmwareUpdateListItem) GetBaseLocation() OptString {
+	return s.BaseLocation
+}
+
+// GetComponentID returns the value of ComponentID.
+func (s *RedfishDellUpgradeFirmwareUpdateListItem) GetComponentID() OptString {
+	return s.ComponentID
+}
+
+// GetComponentInfoName returns the value of ComponentInfoName.
+func (s *RedfishDellUpgradeFirmwareUpdateListItem) GetComponentInfoName() OptString {
+	return s.ComponentInfoName
+}
+
+// GetComponentInfoValue returns the value of ComponentInfoValue.
+func (s *RedfishDellUpgradeFirmwareUpdateListItem) GetComponentInfoValue() OptString {
+	return s.ComponentInfoValue
+}
+
+// GetComponentType returns the value of ComponentType.
+func (s *RedfishDellUpgradeFirmwareUpdateListItem) GetComponentType() OptString {
+	return s.ComponentType
+}
+
+// GetCriticality returns the value of Criticality.
+func (s *RedfishDellUpgradeFirmwareUpdateListItem) GetCriticality() OptString {
+	return s.Criticality
+}
+
+// GetDisplayName returns the value of DisplayName.
+func (s *RedfishDellUpgradeFirmwareUpdateListItem) GetDisplayName() OptString {
+	return s.DisplayName
+}
+
+// GetInstalledVersion returns the value of InstalledVersion.
+func (s *RedfishDellUpgradeFirmwareUpdateListItem) GetInstalledVersion() OptString {
+	return s.InstalledVersion
+}
+
+// GetJobID returns the value of JobID.
+func (s *RedfishDellUpgradeFirmwareUpdateListItem) GetJobID() OptString {
+	return s.JobID
+}
+
+// GetName returns the value of Name.
+func (s *RedfishDellUpgradeFirmwareUpdateListItem) GetName() OptString {
+	return s.Name
+}
+
+// GetPackageName returns the value of PackageName.
+func (s *RedfishDellUpgradeFirmwareUpdateListItem) GetPackageName() OptString {
+	return s.PackageName
+}
+
+// GetPackagePath returns the value of PackagePath.
+func (s *RedfishDellUpgradeFirmwareUpdateListItem) GetPackagePath() OptString {
+	return s.PackagePath
+}
+
+// GetPackageVersion returns the value of PackageVersion.
+func (s *RedfishDellUpgradeFirmwareUpdateListItem) GetPackageVersion() OptString {
+	return s.PackageVersion
+}
+
+// GetRebootType returns the value of RebootType.
+func (s *RedfishDellUpgradeFirmwareUpdateListItem) GetRebootType() OptString {
+	return s.RebootType
+}
+
+// GetTarget returns the value of Target.
+func (s *RedfishDellUpgradeFirmwareUpdateListItem) GetTarget() OptString {
+	return s.Target
+}
+
+// SetBaseLocation sets the value of BaseLocation.
+func (s *RedfishDellUpgradeFirmwareUpdateListItem) SetBaseLocation(val OptString) {
+	s.BaseLocation = val
+}
+
+// SetComponentID sets the value of ComponentID.
+func (s *RedfishDellUpgradeFirmwareUpdateListItem) SetComponentID(val OptString) {
+	s.ComponentID = val
+}
+
+// SetComponentInfoName sets the value of ComponentInfoName.
+func (s *RedfishDellUpgradeFirmwareUpdateListItem) SetComponentInfoName(val OptString) {
+	s.ComponentInfoName = val
+}
+
+// SetComponentInfoValue sets the value of ComponentInfoValue.
+func (s *RedfishDellUpgradeFirmwareUpdateListItem) SetComponentInfoValue(val OptString) {
+	s.ComponentInfoValue = val
+}
+
+// SetComponentType sets the value of ComponentType.
+func (s *RedfishDellUpgradeFirmwareUpdateListItem) SetComponentType(val OptString) {
+	s.ComponentType = val
+}
+
+// SetCriticality sets the value of Criticality.
+func (s *RedfishDellUpgradeFirmwareUpdateListItem) SetCriticality(val OptString) {
+	s.Criticality = val
+}
+
+// SetDisplayName sets the value of DisplayName.
+func (s *RedfishDellUpgradeFirmwareUpdateListItem) SetDisplayName(val OptString) {
+	s.DisplayName = val
+}
+
+// SetInstalledVersion sets the value of InstalledVersion.
+func (s *RedfishDellUpgradeFirmwareUpdateListItem) SetInstalledVersion(val OptString) {
+	s.InstalledVersion = val
+}
+
+// SetJobID sets the value of JobID.
+func (s *RedfishDellUpgradeFirmwareUpdateListItem) SetJobID(val OptString) {
+	s.JobID = val
+}
+
+// SetName sets the value of Name.
+func (s *RedfishDellUpgradeFirmwareUpdateListItem) SetName(val OptString) {
+	s.Name = val
+}
+
+// SetPackageName sets the value of PackageName.
+func (s *RedfishDellUpgradeFirmwareUpdateListItem) SetPackageName(val OptString) {
+	s.PackageName = val
+}
+
+// SetPackagePath sets the value of PackagePath.
+func (s *RedfishDellUpgradeFirmwareUpdateListItem) SetPackagePath(val OptString) {
+	s.PackagePath = val
+}
+
+// SetPackageVersion sets the value of PackageVersion.
+func (s *RedfishDellUpgradeFirmwareUpdateListItem) SetPackageVersion(val OptString) {
+	s.PackageVersion = val
+}
+
+// SetRebootType sets the value of RebootType.
+func (s *RedfishDellUpgradeFirmwareUpdateListItem) SetRebootType(val OptString) {
+	s.RebootType = val
+}
+
+// SetTarget sets the value of Target.
+func (s *RedfishDellUpgradeFirmwareUpdateListItem) SetTarget(val OptString) {
+	s.Target = val
+}
+
+// RedfishJob schema.
+// Ref: #/components/schemas/RedfishJob
+type RedfishJob struct {
+	Jobs OptNilNilRedfishJobJobsItemArray `json:"jobs"`
+	Name OptString                        `json:"name"`
+}
+
+// GetJobs returns the value of Jobs.
+func (s *RedfishJob) GetJobs() OptNilNilRedfishJobJobsItemArray {
+	return s.Jobs
+}
+
+// GetName returns the value of Name.
+func (s *RedfishJob) GetName() OptString {
+	return s.Name
+}
+
+// SetJobs sets the value of Jobs.
+func (s *RedfishJob) SetJobs(val OptNilNilRedfishJobJobsItemArray) {
+	s.Jobs = val
+}
+
+// SetName sets the value of Name.
+func (s *RedfishJob) SetName(val OptString) {
+	s.Name = val
+}
+
+type RedfishJobJobsItem struct {
+	MessageDotExtendedInfo  []RedfishJobJobsItemMessageDotExtendedInfoItem `json:"@Message.ExtendedInfo"`
+	OdataDotContext         OptString                                      `json:"@odata.context"`
+	OdataDotEtag            OptString                                      `json:"@odata.etag"`
+	OdataDotID              OptString                                      `json:"@odata.id"`
+	OdataDotType            OptString                                      `json:"@odata.type"`
+	CreatedBy               OptString                                      `json:"CreatedBy"`
+	CreationTime            OptString                                      `json:"CreationTime"`
+	Description             OptString                                      `json:"Description"`
+	EndTime                 OptString                                      `json:"EndTime"`
+	EstimatedCompletionTime OptString                                      `json:"EstimatedCompletionTime"`
+	EstimatedDuration       OptString                                      `json:"EstimatedDuration"`
+	HidePayload             OptBool                                        `json:"HidePayload"`
+	ID                      OptString                                      `json:"Id"`
+	JobPriority             OptNilInt                                      `json:"JobPriority"`
+	JobState                OptString                                      `json:"JobState"`
+	JobStatus               OptString                                      `json:"JobStatus"`
+	JobType                 OptString                                      `json:"JobType"`
+	MaxExecutionTime        OptString                                      `json:"MaxExecutionTime"`
+	Messages                []RedfishJobJobsItemMessagesItem               `json:"Messages"`
+	Name                    OptString                                      `json:"Name"`
+	Oem                     jx.Raw                                         `json:"Oem"`
+	Parameters              OptRedfishJobJobsItemParameters                `json:"Parameters"`
+	Payload                 OptRedfishJobJobsItemPayload                   `json:"Payload"`
+	PercentComplete         OptNilInt                                      `json:"PercentComplete"`
+	RawData                 []byte                                         `json:"RawData"`
+	Schedule                OptRedfishJobJobsItemSchedule                  `json:"Schedule"`
+	StartTime               OptString                                      `json:"StartTime"`
+	StepOrder               OptNilNilStringArray                           `json:"StepOrder"`
+}
+
+// GetMessageDotExtendedInfo returns the value of MessageDotExtendedInfo.
+func (s *RedfishJobJobsItem) GetMessageDotExtendedInfo() []RedfishJobJobsItemMessageDotExtendedInfoItem {
+	return s.MessageDotExtendedInfo
+}
+
+// GetOdataDotContext returns the value of OdataDotContext.
+func (s *RedfishJobJobsItem) GetOdataDotContext() OptString {
+	return s.OdataDotContext
+}
+
+// GetOdataDotEtag returns the value of OdataDotEtag.
+func (s *RedfishJobJobsItem) GetOdataDotEtag() OptString {
+	return s.OdataDotEtag
+}
+
+// GetOdataDotID returns the value of OdataDotID.
+func (s *RedfishJobJobsItem) GetOdataDotID() OptString {
+	return s.OdataDotID
+}
+
+// GetOdataDotType returns the value of OdataDotType.
+func (s *RedfishJobJobsItem) GetOdataDotType() OptString {
+	return s.OdataDotType
+}
+
+// GetCreatedBy returns the value of CreatedBy.
+func (s *RedfishJobJobsItem) GetCreatedBy() OptString {
+	return s.CreatedBy
+}
+
+// GetCreationTime returns the value of CreationTime.
+func (s *RedfishJobJobsItem) GetCreationTime() OptString {
+	return s.CreationTime
+}
+
+// GetDescription returns the value of Description.
+func (s *RedfishJobJobsItem) GetDescription() OptString {
+	return s.Description
+}
+
+// GetEndTime returns the value of EndTime.
+func (s *RedfishJobJobsItem) GetEndTime() OptString {
+	return s.EndTime
+}
+
+// GetEstimatedCompletionTime returns the value of EstimatedCompletionTime.
+func (s *RedfishJobJobsItem) GetEstimatedCompletionTime() OptString {
+	return s.EstimatedCompletionTime
+}
+
+// GetEstimatedDuration returns the value of EstimatedDuration.
+func (s *RedfishJobJobsItem) GetEstimatedDuration() OptString {
+	return s.EstimatedDuration
+}
+
+// GetHidePayload returns the value of HidePayload.
+func (s *RedfishJobJobsItem) GetHidePayload() OptBool {
+	return s.HidePayload
+}
+
+// GetID returns the value of ID.
+func (s *RedfishJobJobsItem) GetID() OptString {
+	return s.ID
+}
+
+// GetJobPriority returns the value of JobPriority.
+func (s *RedfishJobJobsItem) GetJobPriority() OptNilInt {
+	return s.JobPriority
+}
+
+// GetJobState returns the value of JobState.
+func (s *RedfishJobJobsItem) GetJobState() OptString {
+	return s.JobState
+}
+
+// GetJobStatus returns the value of JobStatus.
+func (s *RedfishJobJobsItem) GetJobStatus() OptString {
+	return s.JobStatus
+}
+
+// GetJobType returns the value of JobType.
+func (s *RedfishJobJobsItem) GetJobType() OptString {
+	return s.JobType
+}
+
+// GetMaxExecutionTime returns the value of MaxExecutionTime.
+func (s *RedfishJobJobsItem) GetMaxExecutionTime() OptString {
+	return s.MaxExecutionTime
+}
+
+// GetMessages returns the value of Messages.
+func (s *RedfishJobJobsItem) GetMessages() []RedfishJobJobsItemMessagesItem {
+	return s.Messages
+}
+
+// GetName returns the value of Name.
+func (s *RedfishJobJobsItem) GetName() OptString {
+	return s.Name
+}
+
+// GetOem returns the value of Oem.
+func (s *RedfishJobJobsItem) GetOem() jx.Raw {
+	return s.Oem
+}
+
+// GetParameters returns the value of Parameters.
+func (s *RedfishJobJobsItem) GetParameters() OptRedfishJobJobsItemParameters {
+	return s.Parameters
+}
+
+// GetPayload returns the value of Payload.
+func (s *RedfishJobJobsItem) GetPayload() OptRedfishJobJobsItemPayload {
+	return s.Payload
+}
+
+// GetPercentComplete returns the value of PercentComplete.
+func (s *RedfishJobJobsItem) GetPercentComplete() OptNilInt {
+	return s.PercentComplete
+}
+
+// GetRawData returns the value of RawData.
+func (s *RedfishJobJobsItem) GetRawData() []byte {
+	return s.RawData
+}
+
+// GetSchedule returns the value of Schedule.
+func (s *RedfishJobJobsItem) GetSchedule() OptRedfishJobJobsItemSchedule {
+	return s.Schedule
+}
+
+// GetStartTime returns the value of StartTime.
+func (s *RedfishJobJobsItem) GetStartTime() OptString {
+	return s.StartTime
+}
+
+// GetStepOrder returns the value of StepOrder.
+func (s *RedfishJobJobsItem) GetStepOrder() OptNilNilStringArray {
+	return s.StepOrder
+}
+
+// SetMessageDotExtendedInfo sets the value of MessageDotExtendedInfo.
+func (s *RedfishJobJobsItem) SetMessageDotExtendedInfo(val []RedfishJobJobsItemMessageDotExtendedInfoItem) {
+	s.MessageDotExtendedInfo = val
+}
+
+// SetOdataDotContext sets the value of OdataDotContext.
+func (s *RedfishJobJobsItem) SetOdataDotContext(val OptString) {
+	s.OdataDotContext = val
+}
+
+// SetOdataDotEtag sets the value of OdataDotEtag.
+func (s *RedfishJobJobsItem) SetOdataDotEtag(val OptString) {
+	s.OdataDotEtag = val
+}
+
+// SetOdataDotID sets the value of OdataDotID.
+func (s *RedfishJobJobsItem) SetOdataDotID(val OptString) {
+	s.OdataDotID = val
+}
+
+// SetOdataDotType sets the value of OdataDotType.
+func (s *RedfishJobJobsItem) SetOdataDotType(val OptString) {
+	s.OdataDotType = val
+}
+
+// SetCreatedBy sets the value of CreatedBy.
+func (s *RedfishJobJobsItem) SetCreatedBy(val OptString) {
+	s.CreatedBy = val
+}
+
+// SetCreationTime sets the value of CreationTime.
+func (s *RedfishJobJobsItem) SetCreationTime(val OptString) {
+	s.CreationTime = val
+}
+
+// SetDescription sets the value of Description.
+func (s *RedfishJobJobsItem) SetDescription(val OptString) {
+	s.Description = val
+}
+
+// SetEndTime sets the value of EndTime.
+func (s *RedfishJobJobsItem) SetEndTime(val OptString) {
+	s.EndTime = val
+}
+
+// SetEstimatedCompletionTime sets the value of EstimatedCompletionTime.
+func (s *RedfishJobJobsItem) SetEstimatedCompletionTime(val OptString) {
+	s.EstimatedCompletionTime = val
+}
+
+// SetEstimatedDuration sets the value of EstimatedDuration.
+func (s *RedfishJobJobsItem) SetEstimatedDuration(val OptString) {
+	s.EstimatedDuration = val
+}
+
+// SetHidePayload sets the value of HidePayload.
+func (s *RedfishJobJobsItem) SetHidePayload(val OptBool) {
+	s.HidePayload = val
+}
+
+// SetID sets the value of ID.
+func (s *RedfishJobJobsItem) SetID(val OptString) {
+	s.ID = val
+}
+
+// SetJobPriority sets the value of JobPriority.
+func (s *RedfishJobJobsItem) SetJobPriority(val OptNilInt) {
+	s.JobPriority = val
+}
+
+// SetJobState sets the value of JobState.
+func (s *RedfishJobJobsItem) SetJobState(val OptString) {
+	s.JobState = val
+}
+
+// SetJobStatus sets the value of JobStatus.
+func (s *RedfishJobJobsItem) SetJobStatus(val OptString) {
+	s.JobStatus = val
+}
+
+// SetJobType sets the value of JobType.
+func (s *RedfishJobJobsItem) SetJobType(val OptString) {
+	s.JobType = val
+}
+
+// SetMaxExecutionTime sets the value of MaxExecutionTime.
+func (s *RedfishJobJobsItem) SetMaxExecutionTime(val OptString) {
+	s.MaxExecutionTime = val
+}
+
+// SetMessages sets the value of Messages.
+func (s *RedfishJobJobsItem) SetMessages(val []RedfishJobJobsItemMessagesItem) {
+	s.Messages = val
+}
+
+// SetName sets the value of Name.
+func (s *RedfishJobJobsItem) SetName(val OptString) {
+	s.Name = val
+}
+
+// SetOem sets the value of Oem.
+func (s *RedfishJobJobsItem) SetOem(val jx.Raw) {
+	s.Oem = val
+}
+
+// SetParameters sets the value of Parameters.
+func (s *RedfishJobJobsItem) SetParameters(val OptRedfishJobJobsItemParameters) {
+	s.Parameters = val
+}
+
+// SetPayload sets the value of Payload.
+func (s *RedfishJobJobsItem) SetPayload(val OptRedfishJobJobsItemPayload) {
+	s.Payload = val
+}
+
+// SetPercentComplete sets the value of PercentComplete.
+func (s *RedfishJobJobsItem) SetPercentComplete(val OptNilInt) {
+	s.PercentComplete = val
+}
+
+// SetRawData sets the value of RawData.
+func (s *RedfishJobJobsItem) SetRawData(val []byte) {
+	s.RawData = val
+}
+
+// SetSchedule sets the value of Schedule.
+func (s *RedfishJobJobsItem) SetSchedule(val OptRedfishJobJobsItemSchedule) {
+	s.Schedule = val
+}
+
+// SetStartTime sets the value of StartTime.
+func (s *RedfishJobJobsItem) SetStartTime(val OptString) {
+	s.StartTime = val
+}
+
+// SetStepOrder sets the value of StepOrder.
+func (s *RedfishJobJobsItem) SetStepOrder(val OptNilNilStringArray) {
+	s.StepOrder = val
+}
+
+type RedfishJobJobsItemMessageDotExtendedInfoItem struct {
+	Message                  OptString                                                         `json:"Message"`
+	MessageArgs              []string                                                          `json:"MessageArgs"`
+	MessageId                OptString                                                         `json:"MessageId"`
+	MessageSeverity          OptString                                                         `json:"MessageSeverity"`
+	Oem                      jx.Raw                                                            `json:"Oem"`
+	RelatedProperties        OptNilNilStringArray                                              `json:"RelatedProperties"`
+	Resolution               OptString                                                         `json:"Resolution"`
+	ResolutionSteps          []RedfishJobJobsItemMessageDotExtendedInfoItemResolutionStepsItem `json:"ResolutionSteps"`
+	Severity                 OptString                                                         `json:"Severity"`
+	UserAuthenticationSource OptString                                                         `json:"UserAuthenticationSource"`
+	Username                 OptString                                                         `json:"Username"`
+}
+
+// GetMessage returns the value of Message.
+func (s *RedfishJobJobsItemMessageDotExtendedInfoItem) GetMessage() OptString {
+	return s.Message
+}
+
+// GetMessageArgs returns the value of MessageArgs.
+func (s *RedfishJobJobsItemMessageDotExtendedInfoItem) GetMessageArgs() []string {
+	return s.MessageArgs
+}
+
+// GetMessageId returns the value of MessageId.
+func (s *RedfishJobJobsItemMessageDotExtendedInfoItem) GetMessageId() OptString {
+	return s.MessageId
+}
+
+// GetMessageSeverity returns the value of MessageSeverity.
+func (s *RedfishJobJobsItemMessageDotExtendedInfoItem) GetMessageSeverity() OptString {
+	return s.MessageSeverity
+}
+
+// GetOem returns the value of Oem.
+func (s *RedfishJobJobsItemMessageDotExtendedInfoItem) GetOem() jx.Raw {
+	return s.Oem
+}
+
+// GetRelatedProperties returns the value of RelatedProperties.
+func (s *RedfishJobJobsItemMessageDotExtendedInfoItem) GetRelatedProperties() OptNilNilStringArray {
+	return s.RelatedProperties
+}
+
+// GetResolution returns the value of Resolution.
+func (s *RedfishJobJobsItemMessageDotExtendedInfoItem) GetResolution() OptString {
+	return s.Resolution
+}
+
+// GetResolutionSteps returns the value of ResolutionSteps.
+func (s *RedfishJobJobsItemMessageDotExtendedInfoItem) GetResolutionSteps() []RedfishJobJobsItemMessageDotExtendedInfoItemResolutionStepsItem {
+	return s.ResolutionSteps
+}
+
+// GetSeverity returns the value of Severity.
+func (s *RedfishJobJobsItemMessageDotExtendedInfoItem) GetSeverity() OptString {
+	return s.Severity
+}
+
+// GetUserAuthenticationSource returns the value of UserAuthenticationSource.
+func (s *RedfishJobJobsItemMessageDotExtendedInfoItem) GetUserAuthenticationSource() OptString {
+	return s.UserAuthenticationSource
+}
+
+// GetUsername returns the value of Username.
+func (s *RedfishJobJobsItemMessageDotExtendedInfoItem) GetUsername() OptString {
+	return s.Username
+}
+
+// SetMessage sets the value of Message.
+func (s *RedfishJobJobsItemMessageDotExtendedInfoItem) SetMessage(val OptString) {
+	s.Message = val
+}
+
+// SetMessageArgs sets the value of MessageArgs.
+func (s *RedfishJobJobsItemMessageDotExtendedInfoItem) SetMessageArgs(val []string) {
+	s.MessageArgs = val
+}
+
+// SetMessageId sets the value of MessageId.
+func (s *RedfishJobJobsItemMessageDotExtendedInfoItem) SetMessageId(val OptString) {
+	s.MessageId = val
+}
+
+// SetMessageSeverity sets the value of MessageSeverity.
+func (s *RedfishJobJobsItemMessageDotExtendedInfoItem) SetMessageSeverity(val OptString) {
+	s.MessageSeverity = val
+}
+
+// SetOem sets the value of Oem.
+func (s *RedfishJobJobsItemMessageDotExtendedInfoItem) SetOem(val jx.Raw) {
+	s.Oem = val
+}
+
+// SetRelatedProperties sets the value of RelatedProperties.
+func (s *RedfishJobJobsItemMessageDotExtendedInfoItem) SetRelatedProperties(val OptNilNilStringArray) {
+	s.RelatedProperties = val
+}
+
+// SetResolution sets the value of Resolution.
+func (s *RedfishJobJobsItemMessageDotExtendedInfoItem) SetResolution(val OptString) {
+	s.Resolution = val
+}
+
+// SetResolutionSteps sets the value of ResolutionSteps.
+func (s *RedfishJobJobsItemMessageDotExtendedInfoItem) SetResolutionSteps(val []RedfishJobJobsItemMessageDotExtendedInfoItemResolutionStepsItem) {
+	s.ResolutionSteps = val
+}
+
+// SetSeverity sets the value of Severity.
+func (s *RedfishJobJobsItemMessageDotExtendedInfoItem) SetSeverity(val OptString) {
+	s.Severity = val
+}
+
+// SetUserAuthenticationSource sets the value of UserAuthenticationSource.
+func (s *RedfishJobJobsItemMessageDotExtendedInfoItem) SetUserAuthenticationSource(val OptString) {
+	s.UserAuthenticationSource = val
+}
+
+// SetUsername sets the value of Username.
+func (s *RedfishJobJobsItemMessageDotExtendedInfoItem) SetUsername(val OptString) {
+	s.Username = val
+}
+
+type RedfishJobJobsItemMessageDotExtendedInfoItemResolutionStepsItem struct {
+	ActionParameters     []RedfishJobJobsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem `json:"ActionParameters"`
+	ActionURI            OptString                                                                             `json:"ActionURI"`
+	Oem                  jx.Raw                                                                                `json:"Oem"`
+	Priority             OptNilInt                                                                             `json:"Priority"`
+	ResolutionType       OptString                                                                             `json:"ResolutionType"`
+	RetryCount           OptNilInt                                                                             `json:"RetryCount"`
+	RetryIntervalSeconds OptNilInt                                                                             `json:"RetryIntervalSeconds"`
+	TargetComponentURI   OptString                                                                             `json:"TargetComponentURI"`
+}
+
+// GetActionParameters returns the value of ActionParameters.
+func (s *RedfishJobJobsItemMessageDotExtendedInfoItemResolutionStepsItem) GetActionParameters() []RedfishJobJobsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem {
+	return s.ActionParameters
+}
+
+// GetActionURI returns the value of ActionURI.
+func (s *RedfishJobJobsItemMessageDotExtendedInfoItemResolutionStepsItem) GetActionURI() OptString {
+	return s.ActionURI
+}
+
+// GetOem returns the value of Oem.
+func (s *RedfishJobJobsItemMessageDotExtendedInfoItemResolutionStepsItem) GetOem() jx.Raw {
+	return s.Oem
+}
+
+// GetPriority returns the value of Priority.
+func (s *RedfishJobJobsItemMessageDotExtendedInfoItemResolutionStepsItem) GetPriority() OptNilInt {
+	return s.Priority
+}
+
+// GetResolutionType returns the value of ResolutionType.
+func (s *RedfishJobJobsItemMessageDotExtendedInfoItemResolutionStepsItem) GetResolutionType() OptString {
+	return s.ResolutionType
+}
+
+// GetRetryCount returns the value of RetryCount.
+func (s *RedfishJobJobsItemMessageDotExtendedInfoItemResolutionStepsItem) GetRetryCount() OptNilInt {
+	return s.RetryCount
+}
+
+// GetRetryIntervalSeconds returns the value of RetryIntervalSeconds.
+func (s *RedfishJobJobsItemMessageDotExtendedInfoItemResolutionStepsItem) GetRetryIntervalSeconds() OptNilInt {
+	return s.RetryIntervalSeconds
+}
+
+// GetTargetComponentURI returns the value of TargetComponentURI.
+func (s *RedfishJobJobsItemMessageDotExtendedInfoItemResolutionStepsItem) GetTargetComponentURI() OptString {
+	return s.TargetComponentURI
+}
+
+// SetActionParameters sets the value of ActionParameters.
+func (s *RedfishJobJobsItemMessageDotExtendedInfoItemResolutionStepsItem) SetActionParameters(val []RedfishJobJobsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) {
+	s.ActionParameters = val
+}
+
+// SetActionURI sets the value of ActionURI.
+func (s *RedfishJobJobsItemMessageDotExtendedInfoItemResolutionStepsItem) SetActionURI(val OptString) {
+	s.ActionURI = val
+}
+
+// SetOem sets the value of Oem.
+func (s *RedfishJobJobsItemMessageDotExtendedInfoItemResolutionStepsItem) SetOem(val jx.Raw) {
+	s.Oem = val
+}
+
+// SetPriority sets the value of Priority.
+func (s *RedfishJobJobsItemMessageDotExtendedInfoItemResolutionStepsItem) SetPriority(val OptNilInt) {
+	s.Priority = val
+}
+
+// SetResolutionType sets the value of ResolutionType.
+func (s *RedfishJobJobsItemMessageDotExtendedInfoItemResolutionStepsItem) SetResolutionType(val OptString) {
+	s.ResolutionType = val
+}
+
+// SetRetryCount sets the value of RetryCount.
+func (s *RedfishJobJobsItemMessageDotExtendedInfoItemResolutionStepsItem) SetRetryCount(val OptNilInt) {
+	s.RetryCount = val
+}
+
+// SetRetryIntervalSeconds sets the value of RetryIntervalSeconds.
+func (s *RedfishJobJobsItemMessageDotExtendedInfoItemResolutionStepsItem) SetRetryIntervalSeconds(val OptNilInt) {
+	s.RetryIntervalSeconds = val
+}
+
+// SetTargetComponentURI sets the value of TargetComponentURI.
+func (s *RedfishJobJobsItemMessageDotExtendedInfoItemResolutionStepsItem) SetTargetComponentURI(val OptString) {
+	s.TargetComponentURI = val
+}
+
+type RedfishJobJobsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem struct {
+	AllowableNumbers           []string      `json:"AllowableNumbers"`
+	AllowablePattern           OptString     `json:"AllowablePattern"`
+	AllowableValueDescriptions []string      `json:"AllowableValueDescriptions"`
+	AllowableValues            []string      `json:"AllowableValues"`
+	ArraySizeMaximum           OptNilInt     `json:"ArraySizeMaximum"`
+	ArraySizeMinimum           OptNilInt     `json:"ArraySizeMinimum"`
+	DataType                   OptString     `json:"DataType"`
+	DefaultValue               OptString     `json:"DefaultValue"`
+	MaximumValue               OptNilFloat64 `json:"MaximumValue"`
+	MinimumValue               OptNilFloat64 `json:"MinimumValue"`
+	Name                       OptString     `json:"Name"`
+	NoDefaultValue             OptBool       `json:"NoDefaultValue"`
+	ObjectDataType             OptString     `json:"ObjectDataType"`
+	Required                   OptBool       `json:"Required"`
+}
+
+// GetAllowableNumbers returns the value of AllowableNumbers.
+func (s *RedfishJobJobsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) GetAllowableNumbers() []string {
+	return s.AllowableNumbers
+}
+
+// GetAllowablePattern returns the value of AllowablePattern.
+func (s *RedfishJobJobsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) GetAllowablePattern() OptString {
+	return s.AllowablePattern
+}
+
+// GetAllowableValueDescriptions returns the value of AllowableValueDescriptions.
+func (s *RedfishJobJobsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) GetAllowableValueDescriptions() []string {
+	return s.AllowableValueDescriptions
+}
+
+// GetAllowableValues returns the value of AllowableValues.
+func (s *RedfishJobJobsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) GetAllowableValues() []string {
+	return s.AllowableValues
+}
+
+// GetArraySizeMaximum returns the value of ArraySizeMaximum.
+func (s *RedfishJobJobsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) GetArraySizeMaximum() OptNilInt {
+	return s.ArraySizeMaximum
+}
+
+// GetArraySizeMinimum returns the value of ArraySizeMinimum.
+func (s *RedfishJobJobsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) GetArraySizeMinimum() OptNilInt {
+	return s.ArraySizeMinimum
+}
+
+// GetDataType returns the value of DataType.
+func (s *RedfishJobJobsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) GetDataType() OptString {
+	return s.DataType
+}
+
+// GetDefaultValue returns the value of DefaultValue.
+func (s *RedfishJobJobsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) GetDefaultValue() OptString {
+	return s.DefaultValue
+}
+
+// GetMaximumValue returns the value of MaximumValue.
+func (s *RedfishJobJobsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) GetMaximumValue() OptNilFloat64 {
+	return s.MaximumValue
+}
+
+// GetMinimumValue returns the value of MinimumValue.
+func (s *RedfishJobJobsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) GetMinimumValue() OptNilFloat64 {
+	return s.MinimumValue
+}
+
+// GetName returns the value of Name.
+func (s *RedfishJobJobsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) GetName() OptString {
+	return s.Name
+}
+
+// GetNoDefaultValue returns the value of NoDefaultValue.
+func (s *RedfishJobJobsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) GetNoDefaultValue() OptBool {
+	return s.NoDefaultValue
+}
+
+// GetObjectDataType returns the value of ObjectDataType.
+func (s *RedfishJobJobsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) GetObjectDataType() OptString {
+	return s.ObjectDataType
+}
+
+// GetRequired returns the value of Required.
+func (s *RedfishJobJobsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) GetRequired() OptBool {
+	return s.Required
+}
+
+// SetAllowableNumbers sets the value of AllowableNumbers.
+func (s *RedfishJobJobsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) SetAllowableNumbers(val []string) {
+	s.AllowableNumbers = val
+}
+
+// SetAllowablePattern sets the value of AllowablePattern.
+func (s *RedfishJobJobsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) SetAllowablePattern(val OptString) {
+	s.AllowablePattern = val
+}
+
+// SetAllowableValueDescriptions sets the value of AllowableValueDescriptions.
+func (s *RedfishJobJobsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) SetAllowableValueDescriptions(val []string) {
+	s.AllowableValueDescriptions = val
+}
+
+// SetAllowableValues sets the value of AllowableValues.
+func (s *RedfishJobJobsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) SetAllowableValues(val []string) {
+	s.AllowableValues = val
+}
+
+// SetArraySizeMaximum sets the value of ArraySizeMaximum.
+func (s *RedfishJobJobsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) SetArraySizeMaximum(val OptNilInt) {
+	s.ArraySizeMaximum = val
+}
+
+// SetArraySizeMinimum sets the value of ArraySizeMinimum.
+func (s *RedfishJobJobsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) SetArraySizeMinimum(val OptNilInt) {
+	s.ArraySizeMinimum = val
+}
+
+// SetDataType sets the value of DataType.
+func (s *RedfishJobJobsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) SetDataType(val OptString) {
+	s.DataType = val
+}
+
+// SetDefaultValue sets the value of DefaultValue.
+func (s *RedfishJobJobsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) SetDefaultValue(val OptString) {
+	s.DefaultValue = val
+}
+
+// SetMaximumValue sets the value of MaximumValue.
+func (s *RedfishJobJobsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) SetMaximumValue(val OptNilFloat64) {
+	s.MaximumValue = val
+}
+
+// SetMinimumValue sets the value of MinimumValue.
+func (s *RedfishJobJobsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) SetMinimumValue(val OptNilFloat64) {
+	s.MinimumValue = val
+}
+
+// SetName sets the value of Name.
+func (s *RedfishJobJobsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) SetName(val OptString) {
+	s.Name = val
+}
+
+// SetNoDefaultValue sets the value of NoDefaultValue.
+func (s *RedfishJobJobsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) SetNoDefaultValue(val OptBool) {
+	s.NoDefaultValue = val
+}
+
+// SetObjectDataType sets the value of ObjectDataType.
+func (s *RedfishJobJobsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) SetObjectDataType(val OptString) {
+	s.ObjectDataType = val
+}
+
+// SetRequired sets the value of Required.
+func (s *RedfishJobJobsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) SetRequired(val OptBool) {
+	s.Required = val
+}
+
+type RedfishJobJobsItemMessagesItem struct {
+	Message                  OptString                                           `json:"Message"`
+	MessageArgs              []string                                            `json:"MessageArgs"`
+	MessageId                OptString                                           `json:"MessageId"`
+	MessageSeverity          OptString                                           `json:"MessageSeverity"`
+	Oem                      jx.Raw                                              `json:"Oem"`
+	RelatedProperties        OptNilNilStringArray                                `json:"RelatedProperties"`
+	Resolution               OptString                                           `json:"Resolution"`
+	ResolutionSteps          []RedfishJobJobsItemMessagesItemResolutionStepsItem `json:"ResolutionSteps"`
+	Severity                 OptString                                           `json:"Severity"`
+	UserAuthenticationSource OptString                                           `json:"UserAuthenticationSource"`
+	Username                 OptString                                           `json:"Username"`
+}
+
+// GetMessage returns the value of Message.
+func (s *RedfishJobJobsItemMessagesItem) GetMessage() OptString {
+	return s.Message
+}
+
+// GetMessageArgs returns the value of MessageArgs.
+func (s *RedfishJobJobsItemMessagesItem) GetMessageArgs() []string {
+	return s.MessageArgs
+}
+
+// GetMessageId returns the value of MessageId.
+func (s *RedfishJobJobsItemMessagesItem) GetMessageId() OptString {
+	return s.MessageId
+}
+
+// GetMessageSeverity returns the value of MessageSeverity.
+func (s *RedfishJobJobsItemMessagesItem) GetMessageSeverity() OptString {
+	return s.MessageSeverity
+}
+
+// GetOem returns the value of Oem.
+func (s *RedfishJobJobsItemMessagesItem) GetOem() jx.Raw {
+	return s.Oem
+}
+
+// GetRelatedProperties returns the value of RelatedProperties.
+func (s *RedfishJobJobsItemMessagesItem) GetRelatedProperties() OptNilNilStringArray {
+	return s.RelatedProperties
+}
+
+// GetResolution returns the value of Resolution.
+func (s *RedfishJobJobsItemMessagesItem) GetResolution() OptString {
+	return s.Resolution
+}
+
+// GetResolutionSteps returns the value of ResolutionSteps.
+func (s *RedfishJobJobsItemMessagesItem) GetResolutionSteps() []RedfishJobJobsItemMessagesItemResolutionStepsItem {
+	return s.ResolutionSteps
+}
+
+// GetSeverity returns the value of Severity.
+func (s *RedfishJobJobsItemMessagesItem) GetSeverity() OptString {
+	return s.Severity
+}
+
+// GetUserAuthenticationSource returns the value of UserAuthenticationSource.
+func (s *RedfishJobJobsItemMessagesItem) GetUserAuthenticationSource() OptString {
+	return s.UserAuthenticationSource
+}
+
+// GetUsername returns the value of Username.
+func (s *RedfishJobJobsItemMessagesItem) GetUsername() OptString {
+	return s.Username
+}
+
+// SetMessage sets the value of Message.
+func (s *RedfishJobJobsItemMessagesItem) SetMessage(val OptString) {
+	s.Message = val
+}
+
+// SetMessageArgs sets the value of MessageArgs.
+func (s *RedfishJobJobsItemMessagesItem) SetMessageArgs(val []string) {
+	s.MessageArgs = val
+}
+
+// SetMessageId sets the value of MessageId.
+func (s *RedfishJobJobsItemMessagesItem) SetMessageId(val OptString) {
+	s.MessageId = val
+}
+
+// SetMessageSeverity sets the value of MessageSeverity.
+func (s *RedfishJobJobsItemMessagesItem) SetMessageSeverity(val OptString) {
+	s.MessageSeverity = val
+}
+
+// SetOem sets the value of Oem.
+func (s *RedfishJobJobsItemMessagesItem) SetOem(val jx.Raw) {
+	s.Oem = val
+}
+
+// SetRelatedProperties sets the value of RelatedProperties.
+func (s *RedfishJobJobsItemMessagesItem) SetRelatedProperties(val OptNilNilStringArray) {
+	s.RelatedProperties = val
+}
+
+// SetResolution sets the value of Resolution.
+func (s *RedfishJobJobsItemMessagesItem) SetResolution(val OptString) {
+	s.Resolution = val
+}
+
+// SetResolutionSteps sets the value of ResolutionSteps.
+func (s *RedfishJobJobsItemMessagesItem) SetResolutionSteps(val []RedfishJobJobsItemMessagesItemResolutionStepsItem) {
+	s.ResolutionSteps = val
+}
+
+// SetSeverity sets the value of Severity.
+func (s *RedfishJobJobsItemMessagesItem) SetSeverity(val OptString) {
+	s.Severity = val
+}
+
+// SetUserAuthenticationSource sets the value of UserAuthenticationSource.
+func (s *RedfishJobJobsItemMessagesItem) SetUserAuthenticationSource(val OptString) {
+	s.UserAuthenticationSource = val
+}
+
+// SetUsername sets the value of Username.
+func (s *RedfishJobJobsItemMessagesItem) SetUsername(val OptString) {
+	s.Username = val
+}
+
+type RedfishJobJobsItemMessagesItemResolutionStepsItem struct {
+	ActionParameters     []RedfishJobJobsItemMessagesItemResolutionStepsItemActionParametersItem `json:"ActionParameters"`
+	ActionURI            OptString                                                               `json:"ActionURI"`
+	Oem                  jx.Raw                                                                  `json:"Oem"`
+	Priority             OptNilInt                                                               `json:"Priority"`
+	ResolutionType       OptString                                                               `json:"ResolutionType"`
+	RetryCount           OptNilInt                                                               `json:"RetryCount"`
+	RetryIntervalSeconds OptNilInt                                                               `json:"RetryIntervalSeconds"`
+	TargetComponentURI   OptString                                                               `json:"TargetComponentURI"`
+}
+
+// GetActionParameters returns the value of ActionParameters.
+func (s *RedfishJobJobsItemMessagesItemResolutionStepsItem) GetActionParameters() []RedfishJobJobsItemMessagesItemResolutionStepsItemActionParametersItem {
+	return s.ActionParameters
+}
+
+// GetActionURI returns the value of ActionURI.
+func (s *RedfishJobJobsItemMessagesItemResolutionStepsItem) GetActionURI() OptString {
+	return s.ActionURI
+}
+
+// GetOem returns the value of Oem.
+func (s *RedfishJobJobsItemMessagesItemResolutionStepsItem) GetOem() jx.Raw {
+	return s.Oem
+}
+
+// GetPriority returns the value of Priority.
+func (s *RedfishJobJobsItemMessagesItemResolutionStepsItem) GetPriority() OptNilInt {
+	return s.Priority
+}
+
+// GetResolutionType returns the value of ResolutionType.
+func (s *RedfishJobJobsItemMessagesItemResolutionStepsItem) GetResolutionType() OptString {
+	return s.ResolutionType
+}
+
+// GetRetryCount returns the value of RetryCount.
+func (s *RedfishJobJobsItemMessagesItemResolutionStepsItem) GetRetryCount() OptNilInt {
+	return s.RetryCount
+}
+
+// GetRetryIntervalSeconds returns the value of RetryIntervalSeconds.
+func (s *RedfishJobJobsItemMessagesItemResolutionStepsItem) GetRetryIntervalSeconds() OptNilInt {
+	return s.RetryIntervalSeconds
+}
+
+// GetTargetComponentURI returns the value of TargetComponentURI.
+func (s *RedfishJobJobsItemMessagesItemResolutionStepsItem) GetTargetComponentURI() OptString {
+	return s.TargetComponentURI
+}
+
+// SetActionParameters sets the value of ActionParameters.
+func (s *RedfishJobJobsItemMessagesItemResolutionStepsItem) SetActionParameters(val []RedfishJobJobsItemMessagesItemResolutionStepsItemActionParametersItem) {
+	s.ActionParameters = val
+}
+
+// SetActionURI sets the value of ActionURI.
+func (s *RedfishJobJobsItemMessagesItemResolutionStepsItem) SetActionURI(val OptString) {
+	s.ActionURI = val
+}
+
+// SetOem sets the value of Oem.
+func (s *RedfishJobJobsItemMessagesItemResolutionStepsItem) SetOem(val jx.Raw) {
+	s.Oem = val
+}
+
+// SetPriority sets the value of Priority.
+func (s *RedfishJobJobsItemMessagesItemResolutionStepsItem) SetPriority(val OptNilInt) {
+	s.Priority = val
+}
+
+// SetResolutionType sets the value of ResolutionType.
+func (s *RedfishJobJobsItemMessagesItemResolutionStepsItem) SetResolutionType(val OptString) {
+	s.ResolutionType = val
+}
+
+// SetRetryCount sets the value of RetryCount.
+func (s *RedfishJobJobsItemMessagesItemResolutionStepsItem) SetRetryCount(val OptNilInt) {
+	s.RetryCount = val
+}
+
+// SetRetryIntervalSeconds sets the value of RetryIntervalSeconds.
+func (s *RedfishJobJobsItemMessagesItemResolutionStepsItem) SetRetryIntervalSeconds(val OptNilInt) {
+	s.RetryIntervalSeconds = val
+}
+
+// SetTargetComponentURI sets the value of TargetComponentURI.
+func (s *RedfishJobJobsItemMessagesItemResolutionStepsItem) SetTargetComponentURI(val OptString) {
+	s.TargetComponentURI = val
+}
+
+type RedfishJobJobsItemMessagesItemResolutionStepsItemActionParametersItem struct {
+	AllowableNumbers           []string      `json:"AllowableNumbers"`
+	AllowablePattern           OptString     `json:"AllowablePattern"`
+	AllowableValueDescriptions []string      `json:"AllowableValueDescriptions"`
+	AllowableValues            []string      `json:"AllowableValues"`
+	ArraySizeMaximum           OptNilInt     `json:"ArraySizeMaximum"`
+	ArraySizeMinimum           OptNilInt     `json:"ArraySizeMinimum"`
+	DataType                   OptString     `json:"DataType"`
+	DefaultValue               OptString     `json:"DefaultValue"`
+	MaximumValue               OptNilFloat64 `json:"MaximumValue"`
+	MinimumValue               OptNilFloat64 `json:"MinimumValue"`
+	Name                       OptString     `json:"Name"`
+	NoDefaultValue             OptBool       `json:"NoDefaultValue"`
+	ObjectDataType             OptString     `json:"ObjectDataType"`
+	Required                   OptBool       `json:"Required"`
+}
+
+// GetAllowableNumbers returns the value of AllowableNumbers.
+func (s *RedfishJobJobsItemMessagesItemResolutionStepsItemActionParametersItem) GetAllowableNumbers() []string {
+	return s.AllowableNumbers
+}
+
+// GetAllowablePattern returns the value of AllowablePattern.
+func (s *RedfishJobJobsItemMessagesItemResolutionStepsItemActionParametersItem) GetAllowablePattern() OptString {
+	return s.AllowablePattern
+}
+
+// GetAllowableValueDescriptions returns the value of AllowableValueDescriptions.
+func (s *RedfishJobJobsItemMessagesItemResolutionStepsItemActionParametersItem) GetAllowableValueDescriptions() []string {
+	return s.AllowableValueDescriptions
+}
+
+// GetAllowableValues returns the value of AllowableValues.
+func (s *RedfishJobJobsItemMessagesItemResolutionStepsItemActionParametersItem) GetAllowableValues() []string {
+	return s.AllowableValues
+}
+
+// GetArraySizeMaximum returns the value of ArraySizeMaximum.
+func (s *RedfishJobJobsItemMessagesItemResolutionStepsItemActionParametersItem) GetArraySizeMaximum() OptNilInt {
+	return s.ArraySizeMaximum
+}
+
+// GetArraySizeMinimum returns the value of ArraySizeMinimum.
+func (s *RedfishJobJobsItemMessagesItemResolutionStepsItemActionParametersItem) GetArraySizeMinimum() OptNilInt {
+	return s.ArraySizeMinimum
+}
+
+// GetDataType returns the value of DataType.
+func (s *RedfishJobJobsItemMessagesItemResolutionStepsItemActionParametersItem) GetDataType() OptString {
+	return s.DataType
+}
+
+// GetDefaultValue returns the value of DefaultValue.
+func (s *RedfishJobJobsItemMessagesItemResolutionStepsItemActionParametersItem) GetDefaultValue() OptString {
+	return s.DefaultValue
+}
+
+// GetMaximumValue returns the value of MaximumValue.
+func (s *RedfishJobJobsItemMessagesItemResolutionStepsItemActionParametersItem) GetMaximumValue() OptNilFloat64 {
+	return s.MaximumValue
+}
+
+// GetMinimumValue returns the value of MinimumValue.
+func (s *RedfishJobJobsItemMessagesItemResolutionStepsItemActionParametersItem) GetMinimumValue() OptNilFloat64 {
+	return s.MinimumValue
+}
+
+// GetName returns the value of Name.
+func (s *RedfishJobJobsItemMessagesItemResolutionStepsItemActionParametersItem) GetName() OptString {
+	return s.Name
+}
+
+// GetNoDefaultValue returns the value of NoDefaultValue.
+func (s *RedfishJobJobsItemMessagesItemResolutionStepsItemActionParametersItem) GetNoDefaultValue() OptBool {
+	return s.NoDefaultValue
+}
+
+// GetObjectDataType returns the value of ObjectDataType.
+func (s *RedfishJobJobsItemMessagesItemResolutionStepsItemActionParametersItem) GetObjectDataType() OptString {
+	return s.ObjectDataType
+}
+
+// GetRequired returns the value of Required.
+func (s *RedfishJobJobsItemMessagesItemResolutionStepsItemActionParametersItem) GetRequired() OptBool {
+	return s.Required
+}
+
+// SetAllowableNumbers sets the value of AllowableNumbers.
+func (s *RedfishJobJobsItemMessagesItemResolutionStepsItemActionParametersItem) SetAllowableNumbers(val []string) {
+	s.AllowableNumbers = val
+}
+
+// SetAllowablePattern sets the value of AllowablePattern.
+func (s *RedfishJobJobsItemMessagesItemResolutionStepsItemActionParametersItem) SetAllowablePattern(val OptString) {
+	s.AllowablePattern = val
+}
+
+// SetAllowableValueDescriptions sets the value of AllowableValueDescriptions.
+func (s *RedfishJobJobsItemMessagesItemResolutionStepsItemActionParametersItem) SetAllowableValueDescriptions(val []string) {
+	s.AllowableValueDescriptions = val
+}
+
+// SetAllowableValues sets the value of AllowableValues.
+func (s *RedfishJobJobsItemMessagesItemResolutionStepsItemActionParametersItem) SetAllowableValues(val []string) {
+	s.AllowableValues = val
+}
+
+// SetArraySizeMaximum sets the value of ArraySizeMaximum.
+func (s *RedfishJobJobsItemMessagesItemResolutionStepsItemActionParametersItem) SetArraySizeMaximum(val OptNilInt) {
+	s.ArraySizeMaximum = val
+}
+
+// SetArraySizeMinimum sets the value of ArraySizeMinimum.
+func (s *RedfishJobJobsItemMessagesItemResolutionStepsItemActionParametersItem) SetArraySizeMinimum(val OptNilInt) {
+	s.ArraySizeMinimum = val
+}
+
+// SetDataType sets the value of DataType.
+func (s *RedfishJobJobsItemMessagesItemResolutionStepsItemActionParametersItem) SetDataType(val OptString) {
+	s.DataType = val
+}
+
+// SetDefaultValue sets the value of DefaultValue.
+func (s *RedfishJobJobsItemMessagesItemResolutionStepsItemActionParametersItem) SetDefaultValue(val OptString) {
+	s.DefaultValue = val
+}
+
+// SetMaximumValue sets the value of MaximumValue.
+func (s *RedfishJobJobsItemMessagesItemResolutionStepsItemActionParametersItem) SetMaximumValue(val OptNilFloat64) {
+	s.MaximumValue = val
+}
+
+// SetMinimumValue sets the value of MinimumValue.
+func (s *RedfishJobJobsItemMessagesItemResolutionStepsItemActionParametersItem) SetMinimumValue(val OptNilFloat64) {
+	s.MinimumValue = val
+}
+
+// SetName sets the value of Name.
+func (s *RedfishJobJobsItemMessagesItemResolutionStepsItemActionParametersItem) SetName(val OptString) {
+	s.Name = val
+}
+
+// SetNoDefaultValue sets the value of NoDefaultValue.
+func (s *RedfishJobJobsItemMessagesItemResolutionStepsItemActionParametersItem) SetNoDefaultValue(val OptBool) {
+	s.NoDefaultValue = val
+}
+
+// SetObjectDataType sets the value of ObjectDataType.
+func (s *RedfishJobJobsItemMessagesItemResolutionStepsItemActionParametersItem) SetObjectDataType(val OptString) {
+	s.ObjectDataType = val
+}
+
+// SetRequired sets the value of Required.
+func (s *RedfishJobJobsItemMessagesItemResolutionStepsItemActionParametersItem) SetRequired(val OptBool) {
+	s.Required = val
+}
+
+type RedfishJobJobsItemParameters map[string]jx.Raw
+
+func (s *RedfishJobJobsItemParameters) init() RedfishJobJobsItemParameters {
+	m := *s
+	if m == nil {
+		m = map[string]jx.Raw{}
+		*s = m
+	}
+	return m
+}
+
+type RedfishJobJobsItemPayload struct {
+	HttpHeaders   OptNilNilStringArray `json:"HttpHeaders"`
+	HttpOperation OptString            `json:"HttpOperation"`
+	JsonBody      OptString            `json:"JsonBody"`
+	TargetUri     OptString            `json:"TargetUri"`
+}
+
+// GetHttpHeaders returns the value of HttpHeaders.
+func (s *RedfishJobJobsItemPayload) GetHttpHeaders() OptNilNilStringArray {
+	return s.HttpHeaders
+}
+
+// GetHttpOperation returns the value of HttpOperation.
+func (s *RedfishJobJobsItemPayload) GetHttpOperation() OptString {
+	return s.HttpOperation
+}
+
+// GetJsonBody returns the value of JsonBody.
+func (s *RedfishJobJobsItemPayload) GetJsonBody() OptString {
+	return s.JsonBody
+}
+
+// GetTargetUri returns the value of TargetUri.
+func (s *RedfishJobJobsItemPayload) GetTargetUri() OptString {
+	return s.TargetUri
+}
+
+// SetHttpHeaders sets the value of HttpHeaders.
+func (s *RedfishJobJobsItemPayload) SetHttpHeaders(val OptNilNilStringArray) {
+	s.HttpHeaders = val
+}
+
+// SetHttpOperation sets the value of HttpOperation.
+func (s *RedfishJobJobsItemPayload) SetHttpOperation(val OptString) {
+	s.HttpOperation = val
+}
+
+// SetJsonBody sets the value of JsonBody.
+func (s *RedfishJobJobsItemPayload) SetJsonBody(val OptString) {
+	s.JsonBody = val
+}
+
+// SetTargetUri sets the value of TargetUri.
+func (s *RedfishJobJobsItemPayload) SetTargetUri(val OptString) {
+	s.TargetUri = val
+}
+
+type RedfishJobJobsItemSchedule struct {
+	EnabledDaysOfMonth  OptNilNilIntArray    `json:"EnabledDaysOfMonth"`
+	EnabledDaysOfWeek   OptNilNilStringArray `json:"EnabledDaysOfWeek"`
+	EnabledIntervals    OptNilNilStringArray `json:"EnabledIntervals"`
+	EnabledMonthsOfYear OptNilNilStringArray `json:"EnabledMonthsOfYear"`
+	InitialStartTime    OptString            `json:"InitialStartTime"`
+	Lifetime            OptString            `json:"Lifetime"`
+	MaxOccurrences      OptNilInt            `json:"MaxOccurrences"`
+	Name                OptString            `json:"Name"`
+	RecurrenceInterval  OptString            `json:"RecurrenceInterval"`
+}
+
+// GetEnabledDaysOfMonth returns the value of EnabledDaysOfMonth.
+func (s *RedfishJobJobsItemSchedule) GetEnabledDaysOfMonth() OptNilNilIntArray {
+	return s.EnabledDaysOfMonth
+}
+
+// GetEnabledDaysOfWeek returns the value of EnabledDaysOfWeek.
+func (s *RedfishJobJobsItemSchedule) GetEnabledDaysOfWeek() OptNilNilStringArray {
+	return s.EnabledDaysOfWeek
+}
+
+// GetEnabledIntervals returns the value of EnabledIntervals.
+func (s *RedfishJobJobsItemSchedule) GetEnabledIntervals() OptNilNilStringArray {
+	return s.EnabledIntervals
+}
+
+// GetEnabledMonthsOfYear returns the value of EnabledMonthsOfYear.
+func (s *RedfishJobJobsItemSchedule) GetEnabledMonthsOfYear() OptNilNilStringArray {
+	return s.EnabledMonthsOfYear
+}
+
+// GetInitialStartTime returns the value of InitialStartTime.
+func (s *RedfishJobJobsItemSchedule) GetInitialStartTime() OptString {
+	return s.InitialStartTime
+}
+
+// GetLifetime returns the value of Lifetime.
+func (s *RedfishJobJobsItemSchedule) GetLifetime() OptString {
+	return s.Lifetime
+}
+
+// GetMaxOccurrences returns the value of MaxOccurrences.
+func (s *RedfishJobJobsItemSchedule) GetMaxOccurrences() OptNilInt {
+	return s.MaxOccurrences
+}
+
+// GetName returns the value of Name.
+func (s *RedfishJobJobsItemSchedule) GetName() OptString {
+	return s.Name
+}
+
+// GetRecurrenceInterval returns the value of RecurrenceInterval.
+func (s *RedfishJobJobsItemSchedule) GetRecurrenceInterval() OptString {
+	return s.RecurrenceInterval
+}
+
+// SetEnabledDaysOfMonth sets the value of EnabledDaysOfMonth.
+func (s *RedfishJobJobsItemSchedule) SetEnabledDaysOfMonth(val OptNilNilIntArray) {
+	s.EnabledDaysOfMonth = val
+}
+
+// SetEnabledDaysOfWeek sets the value of EnabledDaysOfWeek.
+func (s *RedfishJobJobsItemSchedule) SetEnabledDaysOfWeek(val OptNilNilStringArray) {
+	s.EnabledDaysOfWeek = val
+}
+
+// SetEnabledIntervals sets the value of EnabledIntervals.
+func (s *RedfishJobJobsItemSchedule) SetEnabledIntervals(val OptNilNilStringArray) {
+	s.EnabledIntervals = val
+}
+
+// SetEnabledMonthsOfYear sets the value of EnabledMonthsOfYear.
+func (s *RedfishJobJobsItemSchedule) SetEnabledMonthsOfYear(val OptNilNilStringArray) {
+	s.EnabledMonthsOfYear = val
+}
+
+// SetInitialStartTime sets the value of InitialStartTime.
+func (s *RedfishJobJobsItemSchedule) SetInitialStartTime(val OptString) {
+	s.InitialStartTime = val
+}
+
+// SetLifetime sets the value of Lifetime.
+func (s *RedfishJobJobsItemSchedule) SetLifetime(val OptString) {
+	s.Lifetime = val
+}
+
+// SetMaxOccurrences sets the value of MaxOccurrences.
+func (s *RedfishJobJobsItemSchedule) SetMaxOccurrences(val OptNilInt) {
+	s.MaxOccurrences = val
+}
+
+// SetName sets the value of Name.
+func (s *RedfishJobJobsItemSchedule) SetName(val OptString) {
+	s.Name = val
+}
+
+// SetRecurrenceInterval sets the value of RecurrenceInterval.
+func (s *RedfishJobJobsItemSchedule) SetRecurrenceInterval(val OptString) {
+	s.RecurrenceInterval = val
+}
+
+// RedfishMetricReport schema.
+// Ref: #/components/schemas/RedfishMetricReport
+type RedfishMetricReport struct {
+	Name    OptString                           `json:"name"`
+	Reports []NilRedfishMetricReportReportsItem `json:"reports"`
+}
+
+// GetName returns the value of Name.
+func (s *RedfishMetricReport) GetName() OptString {
+	return s.Name
+}
+
+// GetReports returns the value of Reports.
+func (s *RedfishMetricReport) GetReports() []NilRedfishMetricReportReportsItem {
+	return s.Reports
+}
+
+// SetName sets the value of Name.
+func (s *RedfishMetricReport) SetName(val OptString) {
+	s.Name = val
+}
+
+// SetReports sets the value of Reports.
+func (s *RedfishMetricReport) SetReports(val []NilRedfishMetricReportReportsItem) {
+	s.Reports = val
+}
+
+type RedfishMetricReportReportsItem struct {
+	MessageDotExtendedInfo []RedfishMetricReportReportsItemMessageDotExtendedInfoItem `json:"@Message.ExtendedInfo"`
+	OdataDotContext        OptString                                                  `json:"@odata.context"`
+	OdataDotEtag           OptString                                                  `json:"@odata.etag"`
+	OdataDotID             OptString                                                  `json:"@odata.id"`
+	OdataDotType           OptString                                                  `json:"@odata.type"`
+	Context                OptString                                                  `json:"Context"`
+	Description            OptString                                                  `json:"Description"`
+	ID                     OptString                                                  `json:"Id"`
+	MetricValues           []RedfishMetricReportReportsItemMetricValuesItem           `json:"MetricValues"`
+	Name                   OptString                                                  `json:"Name"`
+	Oem                    jx.Raw                                                     `json:"Oem"`
+	ReportSequence         OptString                                                  `json:"ReportSequence"`
+	Timestamp              OptString                                                  `json:"Timestamp"`
+}
+
+// GetMessageDotExtendedInfo returns the value of MessageDotExtendedInfo.
+func (s *RedfishMetricReportReportsItem) GetMessageDotExtendedInfo() []RedfishMetricReportReportsItemMessageDotExtendedInfoItem {
+	return s.MessageDotExtendedInfo
+}
+
+// GetOdataDotContext returns the value of OdataDotContext.
+func (s *RedfishMetricReportReportsItem) GetOdataDotContext() OptString {
+	return s.OdataDotContext
+}
+
+// GetOdataDotEtag returns the value of OdataDotEtag.
+func (s *RedfishMetricReportReportsItem) GetOdataDotEtag() OptString {
+	return s.OdataDotEtag
+}
+
+// GetOdataDotID returns the value of OdataDotID.
+func (s *RedfishMetricReportReportsItem) GetOdataDotID() OptString {
+	return s.OdataDotID
+}
+
+// GetOdataDotType returns the value of OdataDotType.
+func (s *RedfishMetricReportReportsItem) GetOdataDotType() OptString {
+	return s.OdataDotType
+}
+
+// GetContext returns the value of Context.
+func (s *RedfishMetricReportReportsItem) GetContext() OptString {
+	return s.Context
+}
+
+// GetDescription returns the value of Description.
+func (s *RedfishMetricReportReportsItem) GetDescription() OptString {
+	return s.Description
+}
+
+// GetID returns the value of ID.
+func (s *RedfishMetricReportReportsItem) GetID() OptString {
+	return s.ID
+}
+
+// GetMetricValues returns the value of MetricValues.
+func (s *RedfishMetricReportReportsItem) GetMetricValues() []RedfishMetricReportReportsItemMetricValuesItem {
+	return s.MetricValues
+}
+
+// GetName returns the value of Name.
+func (s *RedfishMetricReportReportsItem) GetName() OptString {
+	return s.Name
+}
+
+// GetOem returns the value of Oem.
+func (s *RedfishMetricReportReportsItem) GetOem() jx.Raw {
+	return s.Oem
+}
+
+// GetReportSequence returns the value of ReportSequence.
+func (s *RedfishMetricReportReportsItem) GetReportSequence() OptString {
+	return s.ReportSequence
+}
+
+// GetTimestamp returns the value of Timestamp.
+func (s *RedfishMetricReportReportsItem) GetTimestamp() OptString {
+	return s.Timestamp
+}
+
+// SetMessageDotExtendedInfo sets the value of MessageDotExtendedInfo.
+func (s *RedfishMetricReportReportsItem) SetMessageDotExtendedInfo(val []RedfishMetricReportReportsItemMessageDotExtendedInfoItem) {
+	s.MessageDotExtendedInfo = val
+}
+
+// SetOdataDotContext sets the value of OdataDotContext.
+func (s *RedfishMetricReportReportsItem) SetOdataDotContext(val OptString) {
+	s.OdataDotContext = val
+}
+
+// SetOdataDotEtag sets the value of OdataDotEtag.
+func (s *RedfishMetricReportReportsItem) SetOdataDotEtag(val OptString) {
+	s.OdataDotEtag = val
+}
+
+// SetOdataDotID sets the value of OdataDotID.
+func (s *RedfishMetricReportReportsItem) SetOdataDotID(val OptString) {
+	s.OdataDotID = val
+}
+
+// SetOdataDotType sets the value of OdataDotType.
+func (s *RedfishMetricReportReportsItem) SetOdataDotType(val OptString) {
+	s.OdataDotType = val
+}
+
+// SetContext sets the value of Context.
+func (s *RedfishMetricReportReportsItem) SetContext(val OptString) {
+	s.Context = val
+}
+
+// SetDescription sets the value of Description.
+func (s *RedfishMetricReportReportsItem) SetDescription(val OptString) {
+	s.Description = val
+}
+
+// SetID sets the value of ID.
+func (s *RedfishMetricReportReportsItem) SetID(val OptString) {
+	s.ID = val
+}
+
+// SetMetricValues sets the value of MetricValues.
+func (s *RedfishMetricReportReportsItem) SetMetricValues(val []RedfishMetricReportReportsItemMetricValuesItem) {
+	s.MetricValues = val
+}
+
+// SetName sets the value of Name.
+func (s *RedfishMetricReportReportsItem) SetName(val OptString) {
+	s.Name = val
+}
+
+// SetOem sets the value of Oem.
+func (s *RedfishMetricReportReportsItem) SetOem(val jx.Raw) {
+	s.Oem = val
+}
+
+// SetReportSequence sets the value of ReportSequence.
+func (s *RedfishMetricReportReportsItem) SetReportSequence(val OptString) {
+	s.ReportSequence = val
+}
+
+// SetTimestamp sets the value of Timestamp.
+func (s *RedfishMetricReportReportsItem) SetTimestamp(val OptString) {
+	s.Timestamp = val
+}
+
+type RedfishMetricReportReportsItemMessageDotExtendedInfoItem struct {
+	Message                  OptString                                                                     `json:"Message"`
+	MessageArgs              []string                                                                      `json:"MessageArgs"`
+	MessageId                OptString                                                                     `json:"MessageId"`
+	MessageSeverity          OptString                                                                     `json:"MessageSeverity"`
+	Oem                      jx.Raw                                                                        `json:"Oem"`
+	RelatedProperties        OptNilNilStringArray                                                          `json:"RelatedProperties"`
+	Resolution               OptString                                                                     `json:"Resolution"`
+	ResolutionSteps          []RedfishMetricReportReportsItemMessageDotExtendedInfoItemResolutionStepsItem `json:"ResolutionSteps"`
+	Severity                 OptString                                                                     `json:"Severity"`
+	UserAuthenticationSource OptString                                                                     `json:"UserAuthenticationSource"`
+	Username                 OptString                                                                     `json:"Username"`
+}
+
+// GetMessage returns the value of Message.
+func (s *RedfishMetricReportReportsItemMessageDotExtendedInfoItem) GetMessage() OptString {
+	return s.Message
+}
+
+// GetMessageArgs returns the value of MessageArgs.
+func (s *RedfishMetricReportReportsItemMessageDotExtendedInfoItem) GetMessageArgs() []string {
+	return s.MessageArgs
+}
+
+// GetMessageId returns the value of MessageId.
+func (s *RedfishMetricReportReportsItemMessageDotExtendedInfoItem) GetMessageId() OptString {
+	return s.MessageId
+}
+
+// GetMessageSeverity returns the value of MessageSeverity.
+func (s *RedfishMetricReportReportsItemMessageDotExtendedInfoItem) GetMessageSeverity() OptString {
+	return s.MessageSeverity
+}
+
+// GetOem returns the value of Oem.
+func (s *RedfishMetricReportReportsItemMessageDotExtendedInfoItem) GetOem() jx.Raw {
+	return s.Oem
+}
+
+// GetRelatedProperties returns the value of RelatedProperties.
+func (s *RedfishMetricReportReportsItemMessageDotExtendedInfoItem) GetRelatedProperties() OptNilNilStringArray {
+	return s.RelatedProperties
+}
+
+// GetResolution returns the value of Resolution.
+func (s *RedfishMetricReportReportsItemMessageDotExtendedInfoItem) GetResolution() OptString {
+	return s.Resolution
+}
+
+// GetResolutionSteps returns the value of ResolutionSteps.
+func (s *RedfishMetricReportReportsItemMessageDotExtendedInfoItem) GetResolutionSteps() []RedfishMetricReportReportsItemMessageDotExtendedInfoItemResolutionStepsItem {
+	return s.ResolutionSteps
+}
+
+// GetSeverity returns the value of Severity.
+func (s *RedfishMetricReportReportsItemMessageDotExtendedInfoItem) GetSeverity() OptString {
+	return s.Severity
+}
+
+// GetUserAuthenticationSource returns the value of UserAuthenticationSource.
+func (s *RedfishMetricReportReportsItemMessageDotExtendedInfoItem) GetUserAuthenticationSource() OptString {
+	return s.UserAuthenticationSource
+}
+
+// GetUsername returns the value of Username.
+func (s *RedfishMetricReportReportsItemMessageDotExtendedInfoItem) GetUsername() OptString {
+	return s.Username
+}
+
+// SetMessage sets the value of Message.
+func (s *RedfishMetricReportReportsItemMessageDotExtendedInfoItem) SetMessage(val OptString) {
+	s.Message = val
+}
+
+// SetMessageArgs sets the value of MessageArgs.
+func (s *RedfishMetricReportReportsItemMessageDotExtendedInfoItem) SetMessageArgs(val []string) {
+	s.MessageArgs = val
+}
+
+// SetMessageId sets the value of MessageId.
+func (s *RedfishMetricReportReportsItemMessageDotExtendedInfoItem) SetMessageId(val OptString) {
+	s.MessageId = val
+}
+
+// SetMessageSeverity sets the value of MessageSeverity.
+func (s *RedfishMetricReportReportsItemMessageDotExtendedInfoItem) SetMessageSeverity(val OptString) {
+	s.MessageSeverity = val
+}
+
+// SetOem sets the value of Oem.
+func (s *RedfishMetricReportReportsItemMessageDotExtendedInfoItem) SetOem(val jx.Raw) {
+	s.Oem = val
+}
+
+// SetRelatedProperties sets the value of RelatedProperties.
+func (s *RedfishMetricReportReportsItemMessageDotExtendedInfoItem) SetRelatedProperties(val OptNilNilStringArray) {
+	s.RelatedProperties = val
+}
+
+// SetResolution sets the value of Resolution.
+func (s *RedfishMetricReportReportsItemMessageDotExtendedInfoItem) SetResolution(val OptString) {
+	s.Resolution = val
+}
+
+// SetResolutionSteps sets the value of ResolutionSteps.
+func (s *RedfishMetricReportReportsItemMessageDotExtendedInfoItem) SetResolutionSteps(val []RedfishMetricReportReportsItemMessageDotExtendedInfoItemResolutionStepsItem) {
+	s.ResolutionSteps = val
+}
+
+// SetSeverity sets the value of Severity.
+func (s *RedfishMetricReportReportsItemMessageDotExtendedInfoItem) SetSeverity(val OptString) {
+	s.Severity = val
+}
+
+// SetUserAuthenticationSource sets the value of UserAuthenticationSource.
+func (s *RedfishMetricReportReportsItemMessageDotExtendedInfoItem) SetUserAuthenticationSource(val OptString) {
+	s.UserAuthenticationSource = val
+}
+
+// SetUsername sets the value of Username.
+func (s *RedfishMetricReportReportsItemMessageDotExtendedInfoItem) SetUsername(val OptString) {
+	s.Username = val
+}
+
+type RedfishMetricReportReportsItemMessageDotExtendedInfoItemResolutionStepsItem struct {
+	ActionParameters     []RedfishMetricReportReportsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem `json:"ActionParameters"`
+	ActionURI            OptString                                                                                         `json:"ActionURI"`
+	Oem                  jx.Raw                                                                                            `json:"Oem"`
+	Priority             OptNilInt                                                                                         `json:"Priority"`
+	ResolutionType       OptString                                                                                         `json:"ResolutionType"`
+	RetryCount           OptNilInt                                                                                         `json:"RetryCount"`
+	RetryIntervalSeconds OptNilInt                                                                                         `json:"RetryIntervalSeconds"`
+	TargetComponentURI   OptString                                                                                         `json:"TargetComponentURI"`
+}
+
+// GetActionParameters returns the value of ActionParameters.
+func (s *RedfishMetricReportReportsItemMessageDotExtendedInfoItemResolutionStepsItem) GetActionParameters() []RedfishMetricReportReportsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem {
+	return s.ActionParameters
+}
+
+// GetActionURI returns the value of ActionURI.
+func (s *RedfishMetricReportReportsItemMessageDotExtendedInfoItemResolutionStepsItem) GetActionURI() OptString {
+	return s.ActionURI
+}
+
+// GetOem returns the value of Oem.
+func (s *RedfishMetricReportReportsItemMessageDotExtendedInfoItemResolutionStepsItem) GetOem() jx.Raw {
+	return s.Oem
+}
+
+// GetPriority returns the value of Priority.
+func (s *RedfishMetricReportReportsItemMessageDotExtendedInfoItemResolutionStepsItem) GetPriority() OptNilInt {
+	return s.Priority
+}
+
+// GetResolutionType returns the value of ResolutionType.
+func (s *RedfishMetricReportReportsItemMessageDotExtendedInfoItemResolutionStepsItem) GetResolutionType() OptString {
+	return s.ResolutionType
+}
+
+// GetRetryCount returns the value of RetryCount.
+func (s *RedfishMetricReportReportsItemMessageDotExtendedInfoItemResolutionStepsItem) GetRetryCount() OptNilInt {
+	return s.RetryCount
+}
+
+// GetRetryIntervalSeconds returns the value of RetryIntervalSeconds.
+func (s *RedfishMetricReportReportsItemMessageDotExtendedInfoItemResolutionStepsItem) GetRetryIntervalSeconds() OptNilInt {
+	return s.RetryIntervalSeconds
+}
+
+// GetTargetComponentURI returns the value of TargetComponentURI.
+func (s *RedfishMetricReportReportsItemMessageDotExtendedInfoItemResolutionStepsItem) GetTargetComponentURI() OptString {
+	return s.TargetComponentURI
+}
+
+// SetActionParameters sets the value of ActionParameters.
+func (s *RedfishMetricReportReportsItemMessageDotExtendedInfoItemResolutionStepsItem) SetActionParameters(val []RedfishMetricReportReportsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) {
+	s.ActionParameters = val
+}
+
+// SetActionURI sets the value of ActionURI.
+func (s *RedfishMetricReportReportsItemMessageDotExtendedInfoItemResolutionStepsItem) SetActionURI(val OptString) {
+	s.ActionURI = val
+}
+
+// SetOem sets the value of Oem.
+func (s *RedfishMetricReportReportsItemMessageDotExtendedInfoItemResolutionStepsItem) SetOem(val jx.Raw) {
+	s.Oem = val
+}
+
+// SetPriority sets the value of Priority.
+func (s *RedfishMetricReportReportsItemMessageDotExtendedInfoItemResolutionStepsItem) SetPriority(val OptNilInt) {
+	s.Priority = val
+}
+
+// SetResolutionType sets the value of ResolutionType.
+func (s *RedfishMetricReportReportsItemMessageDotExtendedInfoItemResolutionStepsItem) SetResolutionType(val OptString) {
+	s.ResolutionType = val
+}
+
+// SetRetryCount sets the value of RetryCount.
+func (s *RedfishMetricReportReportsItemMessageDotExtendedInfoItemResolutionStepsItem) SetRetryCount(val OptNilInt) {
+	s.RetryCount = val
+}
+
+// SetRetryIntervalSeconds sets the value of RetryIntervalSeconds.
+func (s *RedfishMetricReportReportsItemMessageDotExtendedInfoItemResolutionStepsItem) SetRetryIntervalSeconds(val OptNilInt) {
+	s.RetryIntervalSeconds = val
+}
+
+// SetTargetComponentURI sets the value of TargetComponentURI.
+func (s *RedfishMetricReportReportsItemMessageDotExtendedInfoItemResolutionStepsItem) SetTargetComponentURI(val OptString) {
+	s.TargetComponentURI = val
+}
+
+type RedfishMetricReportReportsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem struct {
+	AllowableNumbers           []string      `json:"AllowableNumbers"`
+	AllowablePattern           OptString     `json:"AllowablePattern"`
+	AllowableValueDescriptions []string      `json:"AllowableValueDescriptions"`
+	AllowableValues            []string      `json:"AllowableValues"`
+	ArraySizeMaximum           OptNilInt     `json:"ArraySizeMaximum"`
+	ArraySizeMinimum           OptNilInt     `json:"ArraySizeMinimum"`
+	DataType                   OptString     `json:"DataType"`
+	DefaultValue               OptString     `json:"DefaultValue"`
+	MaximumValue               OptNilFloat64 `json:"MaximumValue"`
+	MinimumValue               OptNilFloat64 `json:"MinimumValue"`
+	Name                       OptString     `json:"Name"`
+	NoDefaultValue             OptBool       `json:"NoDefaultValue"`
+	ObjectDataType             OptString     `json:"ObjectDataType"`
+	Required                   OptBool       `json:"Required"`
+}
+
+// GetAllowableNumbers returns the value of AllowableNumbers.
+func (s *RedfishMetricReportReportsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) GetAllowableNumbers() []string {
+	return s.AllowableNumbers
+}
+
+// GetAllowablePattern returns the value of AllowablePattern.
+func (s *RedfishMetricReportReportsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) GetAllowablePattern() OptString {
+	return s.AllowablePattern
+}
+
+// GetAllowableValueDescriptions returns the value of AllowableValueDescriptions.
+func (s *RedfishMetricReportReportsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) GetAllowableValueDescriptions() []string {
+	return s.AllowableValueDescriptions
+}
+
+// GetAllowableValues returns the value of AllowableValues.
+func (s *RedfishMetricReportReportsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) GetAllowableValues() []string {
+	return s.AllowableValues
+}
+
+// GetArraySizeMaximum returns the value of ArraySizeMaximum.
+func (s *RedfishMetricReportReportsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) GetArraySizeMaximum() OptNilInt {
+	return s.ArraySizeMaximum
+}
+
+// GetArraySizeMinimum returns the value of ArraySizeMinimum.
+func (s *RedfishMetricReportReportsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) GetArraySizeMinimum() OptNilInt {
+	return s.ArraySizeMinimum
+}
+
+// GetDataType returns the value of DataType.
+func (s *RedfishMetricReportReportsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) GetDataType() OptString {
+	return s.DataType
+}
+
+// GetDefaultValue returns the value of DefaultValue.
+func (s *RedfishMetricReportReportsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) GetDefaultValue() OptString {
+	return s.DefaultValue
+}
+
+// GetMaximumValue returns the value of MaximumValue.
+func (s *RedfishMetricReportReportsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) GetMaximumValue() OptNilFloat64 {
+	return s.MaximumValue
+}
+
+// GetMinimumValue returns the value of MinimumValue.
+func (s *RedfishMetricReportReportsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) GetMinimumValue() OptNilFloat64 {
+	return s.MinimumValue
+}
+
+// GetName returns the value of Name.
+func (s *RedfishMetricReportReportsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) GetName() OptString {
+	return s.Name
+}
+
+// GetNoDefaultValue returns the value of NoDefaultValue.
+func (s *RedfishMetricReportReportsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) GetNoDefaultValue() OptBool {
+	return s.NoDefaultValue
+}
+
+// GetObjectDataType returns the value of ObjectDataType.
+func (s *RedfishMetricReportReportsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) GetObjectDataType() OptString {
+	return s.ObjectDataType
 }
 
-// NewOptNilRedfishSystemOemDellMessageDotExtendedInfoItemArray returns new OptNilRedfishSystemOemDellMessageDotExtendedInfoItemArray with value set to v.
-func NewOptNilRedfishSystemOemDellMessageDotExtendedInfoItemArray(v []RedfishSystemOemDellMessageDotExtendedInfoItem) OptNilRedfishSystemOemDellMessageDotExtendedInfoItemArray {
-	return OptNilRedfishSystemOemDellMessageDotExtendedInfoItemArray{
-		Value: v,
-		Set:   true,
-	}
+// GetRequired returns the value of Required.
+func (s *RedfishMetricReportReportsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) GetRequired() OptBool {
+	return s.Required
 }
 
-// OptNilRedfishSystemOemDellMessageDotExtendedInfoItemArray is optional nullable []RedfishSystemOemDellMessageDotExtendedInfoItem.
-type OptNilRedfishSystemOemDellMessageDotExtendedInfoItemArray struct {
-	Value []RedfishSystemOemDellMessageDotExtendedInfoItem
-	Set   bool
-	Null  bool
+// SetAllowableNumbers sets the value of AllowableNumbers.
+func (s *RedfishMetricReportReportsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) SetAllowableNumbers(val []string) {
+	s.AllowableNumbers = val
 }
 
-// IsSet returns true if OptNilRedfishSystemOemDellMessageDotExtendedInfoItemArray was set.
-func (o OptNilRedfishSystemOemDellMessageDotExtendedInfoItemArray) IsSet() bool { return o.Set }
+// SetAllowablePattern sets the value of AllowablePattern.
+func (s *RedfishMetricReportReportsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) SetAllowablePattern(val OptString) {
+	s.AllowablePattern = val
+}
 
-// Reset unsets value.
-func (o *OptNilRedfishSystemOemDellMessageDotExtendedInfoItemArray) Reset() {
-	var v []RedfishSystemOemDellMessageDotExtendedInfoItem
-	o.Value = v
-	o.Set = false
-	o.Null = false
+// SetAllowableValueDescriptions sets the value of AllowableValueDescriptions.
+func (s *RedfishMetricReportReportsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) SetAllowableValueDescriptions(val []string) {
+	s.AllowableValueDescriptions = val
 }
 
-// SetTo sets value to v.
-func (o *OptNilRedfishSystemOemDellMessageDotExtendedInfoItemArray) SetTo(v []RedfishSystemOemDellMessageDotExtendedInfoItem) {
-	o.Set = true
-	o.Null = false
-	o.Value = v
+// SetAllowableValues sets the value of AllowableValues.
+func (s *RedfishMetricReportReportsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) SetAllowableValues(val []string) {
+	s.AllowableValues = val
 }
 
-// IsSet returns true if value is Null.
-func (o OptNilRedfishSystemOemDellMessageDotExtendedInfoItemArray) IsNull() bool { return o.Null }
+// SetArraySizeMaximum sets the value of ArraySizeMaximum.
+func (s *RedfishMetricReportReportsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) SetArraySizeMaximum(val OptNilInt) {
+	s.ArraySizeMaximum = val
+}
 
-// SetNull sets value to null.
-func (o *OptNilRedfishSystemOemDellMessageDotExtendedInfoItemArray) SetToNull() {
-	o.Set = true
-	o.Null = true
-	var v []RedfishSystemOemDellMessageDotExtendedInfoItem
-	o.Value = v
+// SetArraySizeMinimum sets the value of ArraySizeMinimum.
+func (s *RedfishMetricReportReportsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) SetArraySizeMinimum(val OptNilInt) {
+	s.ArraySizeMinimum = val
 }
 
-// Get returns value and boolean that denotes whether value was set.
-func (o OptNilRedfishSystemOemDellMessageDotExtendedInfoItemArray) Get() (v []RedfishSystemOemDellMessageDotExtendedInfoItem, ok bool) {
-	if o.Null {
-		return v, false
-	}
-	if !o.Set {
-		return v, false
-	}
-	return o.Value, true
+// SetDataType sets the value of DataType.
+func (s *RedfishMetricReportReportsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) SetDataType(val OptString) {
+	s.DataType = val
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o OptNilRedfishSystemOemDellMessageDotExtendedInfoItemArray) Or(d []RedfishSystemOemDellMessageDotExtendedInfoItem) []RedfishSystemOemDellMessageDotExtendedInfoItem {
-	if v, ok := o.Get(); ok {
-		return v
-	}
-	return d
+// SetDefaultValue sets the value of DefaultValue.
+func (s *RedfishMetricReportReportsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) SetDefaultValue(val OptString) {
+	s.DefaultValue = val
 }
 
-// NewOptNilString returns new OptNilString with value set to v.
-func NewOptNilString(v string) OptNilString {
-	return OptNilString{
-		Value: v,
-		Set:   true,
-	}
+// SetMaximumValue sets the value of MaximumValue.
+func (s *RedfishMetricReportReportsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) SetMaximumValue(val OptNilFloat64) {
+	s.MaximumValue = val
 }
 
-// OptNilString is optional nullable string.
-type OptNilString struct {
-	Value string
-	Set   bool
-	Null  bool
+// SetMinimumValue sets the value of MinimumValue.
+func (s *RedfishMetricReportReportsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) SetMinimumValue(val OptNilFloat64) {
+	s.MinimumValue = val
 }
 
-// IsSet returns true if OptNilString was set.
-func (o OptNilString) IsSet() bool { return o.Set }
+// SetName sets the value of Name.
+func (s *RedfishMetricReportReportsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) SetName(val OptString) {
+	s.Name = val
+}
 
-// Reset unsets value.
-func (o *OptNilString) Reset() {
-	var v string
-	o.Value = v
-	o.Set = false
-	o.Null = false
+// SetNoDefaultValue sets the value of NoDefaultValue.
+func (s *RedfishMetricReportReportsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) SetNoDefaultValue(val OptBool) {
+	s.NoDefaultValue = val
 }
 
-// SetTo sets value to v.
-func (o *OptNilString) SetTo(v string) {
-	o.Set = true
-	o.Null = false
-	o.Value = v
+// SetObjectDataType sets the value of ObjectDataType.
+func (s *RedfishMetricReportReportsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) SetObjectDataType(val OptString) {
+	s.ObjectDataType = val
 }
 
-// IsSet returns true if value is Null.
-func (o OptNilString) IsNull() bool { return o.Null }
+// SetRequired sets the value of Required.
+func (s *RedfishMetricReportReportsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) SetRequired(val OptBool) {
+	s.Required = val
+}
 
-// SetNull sets value to null.
-func (o *OptNilString) SetToNull() {
-	o.Set = true
-	o.Null = true
-	var v string
-	o.Value = v
+type RedfishMetricReportReportsItemMetricValuesItem struct {
+	MetricId       OptString `json:"MetricId"`
+	MetricProperty OptString `json:"MetricProperty"`
+	MetricValue    OptString `json:"MetricValue"`
+	Oem            jx.Raw    `json:"Oem"`
+	Timestamp      OptString `json:"Timestamp"`
 }
 
-// Get returns value and boolean that denotes whether value was set.
-func (o OptNilString) Get() (v string, ok bool) {
-	if o.Null {
-		return v, false
-	}
-	if !o.Set {
-		return v, false
-	}
-	return o.Value, true
+// GetMetricId returns the value of MetricId.
+func (s *RedfishMetricReportReportsItemMetricValuesItem) GetMetricId() OptString {
+	return s.MetricId
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o OptNilString) Or(d string) string {
-	if v, ok := o.Get(); ok {
-		return v
-	}
-	return d
+// GetMetricProperty returns the value of MetricProperty.
+func (s *RedfishMetricReportReportsItemMetricValuesItem) GetMetricProperty() OptString {
+	return s.MetricProperty
 }
 
-// NewOptNilStringArray returns new OptNilStringArray with value set to v.
-func NewOptNilStringArray(v []string) OptNilStringArray {
-	return OptNilStringArray{
-		Value: v,
-		Set:   true,
-	}
+// GetMetricValue returns the value of MetricValue.
+func (s *RedfishMetricReportReportsItemMetricValuesItem) GetMetricValue() OptString {
+	return s.MetricValue
 }
 
-// OptNilStringArray is optional nullable []string.
-type OptNilStringArray struct {
-	Value []string
-	Set   bool
-	Null  bool
+// GetOem returns the value of Oem.
+func (s *RedfishMetricReportReportsItemMetricValuesItem) GetOem() jx.Raw {
+	return s.Oem
 }
 
-// IsSet returns true if OptNilStringArray was set.
-func (o OptNilStringArray) IsSet() bool { return o.Set }
+// GetTimestamp returns the value of Timestamp.
+func (s *RedfishMetricReportReportsItemMetricValuesItem) GetTimestamp() OptString {
+	return s.Timestamp
+}
 
-// Reset unsets value.
-func (o *OptNilStringArray) Reset() {
-	var v []string
-	o.Value = v
-	o.Set = false
-	o.Null = false
+// SetMetricId sets the value of MetricId.
+func (s *RedfishMetricReportReportsItemMetricValuesItem) SetMetricId(val OptString) {
+	s.MetricId = val
 }
 
-// SetTo sets value to v.
-func (o *OptNilStringArray) SetTo(v []string) {
-	o.Set = true
-	o.Null = false
-	o.Value = v
+// SetMetricProperty sets the value of MetricProperty.
+func (s *RedfishMetricReportReportsItemMetricValuesItem) SetMetricProperty(val OptString) {
+	s.MetricProperty = val
 }
 
-// IsSet returns true if value is Null.
-func (o OptNilStringArray) IsNull() bool { return o.Null }
+// SetMetricValue sets the value of MetricValue.
+func (s *RedfishMetricReportReportsItemMetricValuesItem) SetMetricValue(val OptString) {
+	s.MetricValue = val
+}
 
-// SetNull sets value to null.
-func (o *OptNilStringArray) SetToNull() {
-	o.Set = true
-	o.Null = true
-	var v []string
-	o.Value = v
+// SetOem sets the value of Oem.
+func (s *RedfishMetricReportReportsItemMetricValuesItem) SetOem(val jx.Raw) {
+	s.Oem = val
 }
 
-// Get returns value and boolean that denotes whether value was set.
-func (o OptNilStringArray) Get() (v []string, ok bool) {
-	if o.Null {
-		return v, false
-	}
-	if !o.Set {
-		return v, false
-	}
-	return o.Value, true
+// SetTimestamp sets the value of Timestamp.
+func (s *RedfishMetricReportReportsItemMetricValuesItem) SetTimestamp(val OptString) {
+	s.Timestamp = val
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o OptNilStringArray) Or(d []string) []string {
-	if v, ok := o.Get(); ok {
-		return v
-	}
-	return d
+// RedfishSystem schema.
+// Ref: #/components/schemas/RedfishSystem
+type RedfishSystem struct {
+	BiosVersion    OptString                      `json:"bios_version"`
+	BmcFirmware    OptString                      `json:"bmc_firmware"`
+	BootNext       OptString                      `json:"boot_next"`
+	BootOrder      OptNilNilStringArray           `json:"boot_order"`
+	Health         OptString                      `json:"health"`
+	HostName       OptString                      `json:"host_name"`
+	Manufacturer   OptString                      `json:"manufacturer"`
+	Model          OptString                      `json:"model"`
+	Name           OptString                      `json:"name"`
+	NicFirmware    OptNilRedfishSystemNicFirmware `json:"nic_firmware"`
+	OemDell        OptNilRedfishSystemOemDell     `json:"oem_dell"`
+	PowerStatus    OptString                      `json:"power_status"`
+	ProcessorCount OptInt                         `json:"processor_count"`
+	SerialNumber   OptString                      `json:"serial_number"`
+	TotalMemory    OptFloat32                     `json:"total_memory"`
 }
 
-// NewOptRedfishJobJobsItemParameters returns new OptRedfishJobJobsItemParameters with value set to v.
-func NewOptRedfishJobJobsItemParameters(v RedfishJobJobsItemParameters) OptRedfishJobJobsItemParameters {
-	return OptRedfishJobJobsItemParameters{
-		Value: v,
-		Set:   true,
-	}
+// GetBiosVersion returns the value of BiosVersion.
+func (s *RedfishSystem) GetBiosVersion() OptString {
+	return s.BiosVersion
 }
 
-// OptRedfishJobJobsItemParameters is optional RedfishJobJobsItemParameters.
-type OptRedfishJobJobsItemParameters struct {
-	Value RedfishJobJobsItemParameters
-	Set   bool
+// GetBmcFirmware returns the value of BmcFirmware.
+func (s *RedfishSystem) GetBmcFirmware() OptString {
+	return s.BmcFirmware
 }
 
-// IsSet returns true if OptRedfishJobJobsItemParameters was set.
-func (o OptRedfishJobJobsItemParameters) IsSet() bool { return o.Set }
+// GetBootNext returns the value of BootNext.
+func (s *RedfishSystem) GetBootNext() OptString {
+	return s.BootNext
+}
 
-// Reset unsets value.
-func (o *OptRedfishJobJobsItemParameters) Reset() {
-	var v RedfishJobJobsItemParameters
-	o.Value = v
-	o.Set = false
+// GetBootOrder returns the value of BootOrder.
+func (s *RedfishSystem) GetBootOrder() OptNilNilStringArray {
+	return s.BootOrder
 }
 
-// SetTo sets value to v.
-func (o *OptRedfishJobJobsItemParameters) SetTo(v RedfishJobJobsItemParameters) {
-	o.Set = true
-	o.Value = v
+// GetHealth returns the value of Health.
+func (s *RedfishSystem) GetHealth() OptString {
+	return s.Health
 }
 
-// Get returns value and boolean that denotes whether value was set.
-func (o OptRedfishJobJobsItemParameters) Get() (v RedfishJobJobsItemParameters, ok bool) {
-	if !o.Set {
-		return v, false
-	}
-	return o.Value, true
+// GetHostName returns the value of HostName.
+func (s *RedfishSystem) GetHostName() OptString {
+	return s.HostName
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o OptRedfishJobJobsItemParameters) Or(d RedfishJobJobsItemParameters) RedfishJobJobsItemParameters {
-	if v, ok := o.Get(); ok {
-		return v
-	}
-	return d
+// GetManufacturer returns the value of Manufacturer.
+func (s *RedfishSystem) GetManufacturer() OptString {
+	return s.Manufacturer
 }
 
-// NewOptRedfishJobJobsItemPayload returns new OptRedfishJobJobsItemPayload with value set to v.
-func NewOptRedfishJobJobsItemPayload(v RedfishJobJobsItemPayload) OptRedfishJobJobsItemPayload {
-	return OptRedfishJobJobsItemPayload{
-		Value: v,
-		Set:   true,
-	}
+// GetModel returns the value of Model.
+func (s *RedfishSystem) GetModel() OptString {
+	return s.Model
 }
 
-// OptRedfishJobJobsItemPayload is optional RedfishJobJobsItemPayload.
-type OptRedfishJobJobsItemPayload struct {
-	Value RedfishJobJobsItemPayload
-	Set   bool
+// GetName returns the value of Name.
+func (s *RedfishSystem) GetName() OptString {
+	return s.Name
 }
 
-// IsSet returns true if OptRedfishJobJobsItemPayload was set.
-func (o OptRedfishJobJobsItemPayload) IsSet() bool { return o.Set }
-
-// Reset unsets value.
-func (o *OptRedfishJobJobsItemPayload) Reset() {
-	var v RedfishJobJobsItemPayload
-	o.Value = v
-	o.Set = false
+// GetNicFirmware returns the value of NicFirmware.
+func (s *RedfishSystem) GetNicFirmware() OptNilRedfishSystemNicFirmware {
+	return s.NicFirmware
 }
 
-// SetTo sets value to v.
-func (o *OptRedfishJobJobsItemPayload) SetTo(v RedfishJobJobsItemPayload) {
-	o.Set = true
-	o.Value = v
+// GetOemDell returns the value of OemDell.
+func (s *RedfishSystem) GetOemDell() OptNilRedfishSystemOemDell {
+	return s.OemDell
 }
 
-// Get returns value and boolean that denotes whether value was set.
-func (o OptRedfishJobJobsItemPayload) Get() (v RedfishJobJobsItemPayload, ok bool) {
-	if !o.Set {
-		return v, false
-	}
-	return o.Value, true
+// GetPowerStatus returns the value of PowerStatus.
+func (s *RedfishSystem) GetPowerStatus() OptString {
+	return s.PowerStatus
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o OptRedfishJobJobsItemPayload) Or(d RedfishJobJobsItemPayload) RedfishJobJobsItemPayload {
-	if v, ok := o.Get(); ok {
-		return v
-	}
-	return d
+// GetProcessorCount returns the value of ProcessorCount.
+func (s *RedfishSystem) GetProcessorCount() OptInt {
+	return s.ProcessorCount
 }
 
-// NewOptRedfishJobJobsItemSchedule returns new OptRedfishJobJobsItemSchedule with value set to v.
-func NewOptRedfishJobJobsItemSchedule(v RedfishJobJobsItemSchedule) OptRedfishJobJobsItemSchedule {
-	return OptRedfishJobJobsItemSchedule{
-		Value: v,
-		Set:   true,
-	}
+// GetSerialNumber returns the value of SerialNumber.
+func (s *RedfishSystem) GetSerialNumber() OptString {
+	return s.SerialNumber
 }
 
-// OptRedfishJobJobsItemSchedule is optional RedfishJobJobsItemSchedule.
-type OptRedfishJobJobsItemSchedule struct {
-	Value RedfishJobJobsItemSchedule
-	Set   bool
+// GetTotalMemory returns the value of TotalMemory.
+func (s *RedfishSystem) GetTotalMemory() OptFloat32 {
+	return s.TotalMemory
 }
 
-// IsSet returns true if OptRedfishJobJobsItemSchedule was set.
-func (o OptRedfishJobJobsItemSchedule) IsSet() bool { return o.Set }
+// SetBiosVersion sets the value of BiosVersion.
+func (s *RedfishSystem) SetBiosVersion(val OptString) {
+	s.BiosVersion = val
+}
 
-// Reset unsets value.
-func (o *OptRedfishJobJobsItemSchedule) Reset() {
-	var v RedfishJobJobsItemSchedule
-	o.Value = v
-	o.Set = false
+// SetBmcFirmware sets the value of BmcFirmware.
+func (s *RedfishSystem) SetBmcFirmware(val OptString) {
+	s.BmcFirmware = val
 }
 
-// SetTo sets value to v.
-func (o *OptRedfishJobJobsItemSchedule) SetTo(v RedfishJobJobsItemSchedule) {
-	o.Set = true
-	o.Value = v
+// SetBootNext sets the value of BootNext.
+func (s *RedfishSystem) SetBootNext(val OptString) {
+	s.BootNext = val
 }
 
-// Get returns value and boolean that denotes whether value was set.
-func (o OptRedfishJobJobsItemSchedule) Get() (v RedfishJobJobsItemSchedule, ok bool) {
-	if !o.Set {
-		return v, false
-	}
-	return o.Value, true
+// SetBootOrder sets the value of BootOrder.
+func (s *RedfishSystem) SetBootOrder(val OptNilNilStringArray) {
+	s.BootOrder = val
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o OptRedfishJobJobsItemSchedule) Or(d RedfishJobJobsItemSchedule) RedfishJobJobsItemSchedule {
-	if v, ok := o.Get(); ok {
-		return v
-	}
-	return d
+// SetHealth sets the value of Health.
+func (s *RedfishSystem) SetHealth(val OptString) {
+	s.Health = val
 }
 
-// NewOptString returns new OptString with value set to v.
-func NewOptString(v string) OptString {
-	return OptString{
-		Value: v,
-		Set:   true,
-	}
+// SetHostName sets the value of HostName.
+func (s *RedfishSystem) SetHostName(val OptString) {
+	s.HostName = val
 }
 
-// OptString is optional string.
-type OptString struct {
-	Value string
-	Set   bool
+// SetManufacturer sets the value of Manufacturer.
+func (s *RedfishSystem) SetManufacturer(val OptString) {
+	s.Manufacturer = val
 }
 
-// IsSet returns true if OptString was set.
-func (o OptString) IsSet() bool { return o.Set }
+// SetModel sets the value of Model.
+func (s *RedfishSystem) SetModel(val OptString) {
+	s.Model = val
+}
 
-// Reset unsets value.
-func (o *OptString) Reset() {
-	var v string
-	o.Value = v
-	o.Set = false
+// SetName sets the value of Name.
+func (s *RedfishSystem) SetName(val OptString) {
+	s.Name = val
 }
 
-// SetTo sets value to v.
-func (o *OptString) SetTo(v string) {
-	o.Set = true
-	o.Value = v
+// SetNicFirmware sets the value of NicFirmware.
+func (s *RedfishSystem) SetNicFirmware(val OptNilRedfishSystemNicFirmware) {
+	s.NicFirmware = val
 }
 
-// Get returns value and boolean that denotes whether value was set.
-func (o OptString) Get() (v string, ok bool) {
-	if !o.Set {
-		return v, false
-	}
-	return o.Value, true
+// SetOemDell sets the value of OemDell.
+func (s *RedfishSystem) SetOemDell(val OptNilRedfishSystemOemDell) {
+	s.OemDell = val
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o OptString) Or(d string) string {
-	if v, ok := o.Get(); ok {
-		return v
-	}
-	return d
+// SetPowerStatus sets the value of PowerStatus.
+func (s *RedfishSystem) SetPowerStatus(val OptString) {
+	s.PowerStatus = val
 }
 
-// PatchRolesRequest schema.
-// Ref: #/components/schemas/PatchRolesRequest
-type PatchRolesRequest struct {
-	PermissionList []PatchRolesRequestPermissionListItem `json:"permission_list"`
-	Role           OptString                             `json:"role"`
+// SetProcessorCount sets the value of ProcessorCount.
+func (s *RedfishSystem) SetProcessorCount(val OptInt) {
+	s.ProcessorCount = val
 }
 
-// GetPermissionList returns the value of PermissionList.
-func (s *PatchRolesRequest) GetPermissionList() []PatchRolesRequestPermissionListItem {
-	return s.PermissionList
+// SetSerialNumber sets the value of SerialNumber.
+func (s *RedfishSystem) SetSerialNumber(val OptString) {
+	s.SerialNumber = val
 }
 
-// GetRole returns the value of Role.
-func (s *PatchRolesRequest) GetRole() OptString {
-	return s.Role
+// SetTotalMemory sets the value of TotalMemory.
+func (s *RedfishSystem) SetTotalMemory(val OptFloat32) {
+	s.TotalMemory = val
 }
 
-// SetPermissionList sets the value of PermissionList.
-func (s *PatchRolesRequest) SetPermissionList(val []PatchRolesRequestPermissionListItem) {
-	s.PermissionList = val
+type RedfishSystemNicFirmware map[string]NilString
+
+func (s *RedfishSystemNicFirmware) init() RedfishSystemNicFirmware {
+	m := *s
+	if m == nil {
+		m = map[string]NilString{}
+		*s = m
+	}
+	return m
 }
 
-// SetRole sets the value of Role.
-func (s *PatchRolesRequest) SetRole(val OptString) {
-	s.Role = val
+type RedfishSystemOemDell struct {
+	MessageDotExtendedInfo             OptNilRedfishSystemOemDellMessageDotExtendedInfoItemArray `json:"@Message.ExtendedInfo"`
+	OdataDotContext                    OptString                                                 `json:"@odata.context"`
+	OdataDotEtag                       OptNilString                                              `json:"@odata.etag"`
+	OdataDotID                         OptString                                                 `json:"@odata.id"`
+	OdataDotType                       OptString                                                 `json:"@odata.type"`
+	BIOSReleaseDate                    OptString                                                 `json:"BIOSReleaseDate"`
+	BaseBoardChassisSlot               OptString                                                 `json:"BaseBoardChassisSlot"`
+	BatteryRollupStatus                OptString                                                 `json:"BatteryRollupStatus"`
+	BladeGeometry                      OptString                                                 `json:"BladeGeometry"`
+	CMCIP                              OptString                                                 `json:"CMCIP"`
+	CPURollupStatus                    OptString                                                 `json:"CPURollupStatus"`
+	ChassisModel                       OptString                                                 `json:"ChassisModel"`
+	ChassisName                        OptString                                                 `json:"ChassisName"`
+	ChassisServiceTag                  OptString                                                 `json:"ChassisServiceTag"`
+	ChassisSystemHeightUnit            OptInt                                                    `json:"ChassisSystemHeightUnit"`
+	CurrentRollupStatus                OptString                                                 `json:"CurrentRollupStatus"`
+	Description                        OptString                                                 `json:"Description"`
+	EstimatedExhaustTemperatureCelsius OptInt                                                    `json:"EstimatedExhaustTemperatureCelsius"`
+	EstimatedSystemAirflowCFM          OptInt                                                    `json:"EstimatedSystemAirflowCFM"`
+	ExpressServiceCode                 OptString                                                 `json:"ExpressServiceCode"`
+	FanRollupStatus                    OptString                                                 `json:"FanRollupStatus"`
+	IDSDMRollupStatus                  OptString                                                 `json:"IDSDMRollupStatus"`
+	ID                                 OptString                                                 `json:"Id"`
+	IntrusionRollupStatus              OptString                                                 `json:"IntrusionRollupStatus"`
+	IsOEMBranded                       OptString                                                 `json:"IsOEMBranded"`
+	LastSystemInventoryTime            OptString                                                 `json:"LastSystemInventoryTime"`
+	LastUpdateTime                     OptString                                                 `json:"LastUpdateTime"`
+	LicensingRollupStatus              OptString                                                 `json:"LicensingRollupStatus"`
+	ManagedSystemSize                  OptString                                                 `json:"ManagedSystemSize"`
+	MaxCPUSockets                      OptInt                                                    `json:"MaxCPUSockets"`
+	MaxDIMMSlots                       OptInt                                                    `json:"MaxDIMMSlots"`
+	MaxPCIeSlots                       OptInt                                                    `json:"MaxPCIeSlots"`
+	MemoryOperationMode                OptString                                                 `json:"MemoryOperationMode"`
+	Name                               OptString                                                 `json:"Name"`
+	NodeID                             OptString                                                 `json:"NodeID"`
+	Oem                                jx.Raw                                                    `json:"Oem"`
+	PSRollupStatus                     OptString                                                 `json:"PSRollupStatus"`
+	PlatformGUID                       OptString                                                 `json:"PlatformGUID"`
+	PopulatedDIMMSlots                 OptInt                                                    `json:"PopulatedDIMMSlots"`
+	PopulatedPCIeSlots                 OptInt                                                    `json:"PopulatedPCIeSlots"`
+	PowerCapEnabledState               OptString                                                 `json:"PowerCapEnabledState"`
+	SDCardRollupStatus                 OptString                                                 `json:"SDCardRollupStatus"`
+	SELRollupStatus                    OptString                                                 `json:"SELRollupStatus"`
+	ServerAllocationWatts              OptInt                                                    `json:"ServerAllocationWatts"`
+	StorageRollupStatus                OptString                                                 `json:"StorageRollupStatus"`
+	SysMemErrorMethodology             OptString                                                 `json:"SysMemErrorMethodology"`
+	SysMemFailOverState                OptString                                                 `json:"SysMemFailOverState"`
+	SysMemLocation                     OptString                                                 `json:"SysMemLocation"`
+	SysMemPrimaryStatus                OptString                                                 `json:"SysMemPrimaryStatus"`
+	SystemGeneration                   OptString                                                 `json:"SystemGeneration"`
+	SystemID                           OptInt                                                    `json:"SystemID"`
+	SystemRevision                     OptString                                                 `json:"SystemRevision"`
+	TempRollupStatus                   OptString                                                 `json:"TempRollupStatus"`
+	TempStatisticsRollupStatus         OptString                                                 `json:"TempStatisticsRollupStatus"`
+	UUID                               OptString                                                 `json:"UUID"`
+	VoltRollupStatus                   OptString                                                 `json:"VoltRollupStatus"`
+	SmbiosGUID                         OptString                                                 `json:"smbiosGUID"`
 }
 
-type PatchRolesRequestPermissionListItem struct {
-	Method OptString `json:"method"`
-	Path   OptString `json:"path"`
+// GetMessageDotExtendedInfo returns the value of MessageDotExtendedInfo.
+func (s *RedfishSystemOemDell) GetMessageDotExtendedInfo() OptNilRedfishSystemOemDellMessageDotExtendedInfoItemArray {
+	return s.MessageDotExtendedInfo
 }
 
-// GetMethod returns the value of Method.
-func (s *PatchRolesRequestPermissionListItem) GetMethod() OptString {
-	return s.Method
+// GetOdataDotContext returns the value of OdataDotContext.
+func (s *RedfishSystemOemDell) GetOdataDotContext() OptString {
+	return s.OdataDotContext
 }
 
-// GetPath returns the value of Path.
-func (s *PatchRolesRequestPermissionListItem) GetPath() OptString {
-	return s.Path
+// GetOdataDotEtag returns the value of OdataDotEtag.
+func (s *RedfishSystemOemDell) GetOdataDotEtag() OptNilString {
+	return s.OdataDotEtag
 }
 
-// SetMethod sets the value of Method.
-func (s *PatchRolesRequestPermissionListItem) SetMethod(val OptString) {
-	s.Method = val
+// GetOdataDotID returns the value of OdataDotID.
+func (s *RedfishSystemOemDell) GetOdataDotID() OptString {
+	return s.OdataDotID
 }
 
-// SetPath sets the value of Path.
-func (s *PatchRolesRequestPermissionListItem) SetPath(val OptString) {
-	s.Path = val
+// GetOdataDotType returns the value of OdataDotType.
+func (s *RedfishSystemOemDell) GetOdataDotType() OptString {
+	return s.OdataDotType
 }
 
-// PostRolesRequest schema.
-// Ref: #/components/schemas/PostRolesRequest
-type PostRolesRequest struct {
-	InheritedRole OptString `json:"inherited_role"`
-	Role          OptString `json:"role"`
+// GetBIOSReleaseDate returns the value of BIOSReleaseDate.
+func (s *RedfishSystemOemDell) GetBIOSReleaseDate() OptString {
+	return s.BIOSReleaseDate
 }
 
-// GetInheritedRole returns the value of InheritedRole.
-func (s *PostRolesRequest) GetInheritedRole() OptString {
-	return s.InheritedRole
+// GetBaseBoardChassisSlot returns the value of BaseBoardChassisSlot.
+func (s *RedfishSystemOemDell) GetBaseBoardChassisSlot() OptString {
+	return s.BaseBoardChassisSlot
 }
 
-// GetRole returns the value of Role.
-func (s *PostRolesRequest) GetRole() OptString {
-	return s.Role
+// GetBatteryRollupStatus returns the value of BatteryRollupStatus.
+func (s *RedfishSystemOemDell) GetBatteryRollupStatus() OptString {
+	return s.BatteryRollupStatus
 }
 
-// SetInheritedRole sets the value of InheritedRole.
-func (s *PostRolesRequest) SetInheritedRole(val OptString) {
-	s.InheritedRole = val
+// GetBladeGeometry returns the value of BladeGeometry.
+func (s *RedfishSystemOemDell) GetBladeGeometry() OptString {
+	return s.BladeGeometry
 }
 
-// SetRole sets the value of Role.
-func (s *PostRolesRequest) SetRole(val OptString) {
-	s.Role = val
+// GetCMCIP returns the value of CMCIP.
+func (s *RedfishSystemOemDell) GetCMCIP() OptString {
+	return s.CMCIP
 }
 
-// RedfishDellUpgradeFirmware schema.
-// Ref: #/components/schemas/RedfishDellUpgradeFirmware
-type RedfishDellUpgradeFirmware struct {
-	Message          OptString                                  `json:"Message"`
-	Name             OptString                                  `json:"Name"`
-	Status           OptString                                  `json:"Status"`
-	UpdateCount      OptInt                                     `json:"UpdateCount"`
-	UpdateList       []RedfishDellUpgradeFirmwareUpdateListItem `json:"UpdateList"`
-	UpdateRebootType OptString                                  `json:"UpdateRebootType"`
+// GetCPURollupStatus returns the value of CPURollupStatus.
+func (s *RedfishSystemOemDell) GetCPURollupStatus() OptString {
+	return s.CPURollupStatus
 }
 
-// GetMessage returns the value of Message.
-func (s *RedfishDellUpgradeFirmware) GetMessage() OptString {
-	return s.Message
+// GetChassisModel returns the value of ChassisModel.
+func (s *RedfishSystemOemDell) GetChassisModel() OptString {
+	return s.ChassisModel
 }
 
-// GetName returns the value of Name.
-func (s *RedfishDellUpgradeFirmware) GetName() OptString {
-	return s.Name
+// GetChassisName returns the value of ChassisName.
+func (s *RedfishSystemOemDell) GetChassisName() OptString {
+	return s.ChassisName
 }
 
-// GetStatus returns the value of Status.
-func (s *RedfishDellUpgradeFirmware) GetStatus() OptString {
-	return s.Status
+// GetChassisServiceTag returns the value of ChassisServiceTag.
+func (s *RedfishSystemOemDell) GetChassisServiceTag() OptString {
+	return s.ChassisServiceTag
 }
 
-// GetUpdateCount returns the value of UpdateCount.
-func (s *RedfishDellUpgradeFirmware) GetUpdateCount() OptInt {
-	return s.UpdateCount
+// GetChassisSystemHeightUnit returns the value of ChassisSystemHeightUnit.
+func (s *RedfishSystemOemDell) GetChassisSystemHeightUnit() OptInt {
+	return s.ChassisSystemHeightUnit
 }
 
-// GetUpdateList returns the value of UpdateList.
-func (s *RedfishDellUpgradeFirmware) GetUpdateList() []RedfishDellUpgradeFirmwareUpdateListItem {
-	return s.UpdateList
+// GetCurrentRollupStatus returns the value of CurrentRollupStatus.
+func (s *RedfishSystemOemDell) GetCurrentRollupStatus() OptString {
+	return s.CurrentRollupStatus
 }
 
-// GetUpdateRebootType returns the value of UpdateRebootType.
-func (s *RedfishDellUpgradeFirmware) GetUpdateRebootType() OptString {
-	return s.UpdateRebootType
+// GetDescription returns the value of Description.
+func (s *RedfishSystemOemDell) GetDescription() OptString {
+	return s.Description
 }
 
-// SetMessage sets the value of Message.
-func (s *RedfishDellUpgradeFirmware) SetMessage(val OptString) {
-	s.Message = val
+// GetEstimatedExhaustTemperatureCelsius returns the value of EstimatedExhaustTemperatureCelsius.
+func (s *RedfishSystemOemDell) GetEstimatedExhaustTemperatureCelsius() OptInt {
+	return s.EstimatedExhaustTemperatureCelsius
 }
 
-// SetName sets the value of Name.
-func (s *RedfishDellUpgradeFirmware) SetName(val OptString) {
-	s.Name = val
+// GetEstimatedSystemAirflowCFM returns the value of EstimatedSystemAirflowCFM.
+func (s *RedfishSystemOemDell) GetEstimatedSystemAirflowCFM() OptInt {
+	return s.EstimatedSystemAirflowCFM
 }
 
-// SetStatus sets the value of Status.
-func (s *RedfishDellUpgradeFirmware) SetStatus(val OptString) {
-	s.Status = val
+// GetExpressServiceCode returns the value of ExpressServiceCode.
+func (s *RedfishSystemOemDell) GetExpressServiceCode() OptString {
+	return s.ExpressServiceCode
 }
 
-// SetUpdateCount sets the value of UpdateCount.
-func (s *RedfishDellUpgradeFirmware) SetUpdateCount(val OptInt) {
-	s.UpdateCount = val
+// GetFanRollupStatus returns the value of FanRollupStatus.
+func (s *RedfishSystemOemDell) GetFanRollupStatus() OptString {
+	return s.FanRollupStatus
 }
 
-// SetUpdateList sets the value of UpdateList.
-func (s *RedfishDellUpgradeFirmware) SetUpdateList(val []RedfishDellUpgradeFirmwareUpdateListItem) {
-	s.UpdateList = val
+// GetIDSDMRollupStatus returns the value of IDSDMRollupStatus.
+func (s *RedfishSystemOemDell) GetIDSDMRollupStatus() OptString {
+	return s.IDSDMRollupStatus
 }
 
-// SetUpdateRebootType sets the value of UpdateRebootType.
-func (s *RedfishDellUpgradeFirmware) SetUpdateRebootType(val OptString) {
-	s.UpdateRebootType = val
+// GetID returns the value of ID.
+func (s *RedfishSystemOemDell) GetID() OptString {
+	return s.ID
 }
 
-type RedfishDellUpgradeFirmwareUpdateListItem struct {
-	BaseLocation       OptString `json:"BaseLocation"`
-	ComponentID        OptString `json:"ComponentID"`
-	ComponentInfoName  OptString `json:"ComponentInfoName"`
-	ComponentInfoValue OptString `json:"ComponentInfoValue"`
-	ComponentType      OptString `json:"ComponentType"`
-	Criticality        OptString `json:"Criticality"`
-	DisplayName        OptString `json:"DisplayName"`
-	InstalledVersion   OptString `json:"InstalledVersion"`
-	JobID              OptString `json:"JobID"`
-	Name               OptString `json:"Name"`
-	PackageName        OptString `json:"PackageName"`
-	PackagePath        OptString `json:"PackagePath"`
-	PackageVersion     OptString `json:"PackageVersion"`
-	RebootType         OptString `json:"RebootType"`
-	Target             OptString `json:"Target"`
+// GetIntrusionRollupStatus returns the value of IntrusionRollupStatus.
+func (s *RedfishSystemOemDell) GetIntrusionRollupStatus() OptString {
+	return s.IntrusionRollupStatus
 }
 
-// GetBaseLocation returns the value of BaseLocation.
-func (s *RedfishDellUpgradeFirmwareUpdateListItem) GetBaseLocation() OptString {
-	return s.BaseLocation
+// GetIsOEMBranded returns the value of IsOEMBranded.
+func (s *RedfishSystemOemDell) GetIsOEMBranded() OptString {
+	return s.IsOEMBranded
 }
 
-// GetComponentID returns the value of ComponentID.
-func (s *RedfishDellUpgradeFirmwareUpdateListItem) GetComponentID() OptString {
-	return s.ComponentID
+// GetLastSystemInventoryTime returns the value of LastSystemInventoryTime.
+func (s *RedfishSystemOemDell) GetLastSystemInventoryTime() OptString {
+	return s.LastSystemInventoryTime
 }
 
-// GetComponentInfoName returns the value of ComponentInfoName.
-func (s *RedfishDellUpgradeFirmwareUpdateListItem) GetComponentInfoName() OptString {
-	return s.ComponentInfoName
+// GetLastUpdateTime returns the value of LastUpdateTime.
+func (s *RedfishSystemOemDell) GetLastUpdateTime() OptString {
+	return s.LastUpdateTime
 }
 
-// GetComponentInfoValue returns the value of ComponentInfoValue.
-func (s *RedfishDellUpgradeFirmwareUpdateListItem) GetComponentInfoValue() OptString {
-	return s.ComponentInfoValue
+// GetLicensingRollupStatus returns the value of LicensingRollupStatus.
+func (s *RedfishSystemOemDell) GetLicensingRollupStatus() OptString {
+	return s.LicensingRollupStatus
 }
 
-// GetComponentType returns the value of ComponentType.
-func (s *RedfishDellUpgradeFirmwareUpdateListItem) GetComponentType() OptString {
-	return s.ComponentType
+// GetManagedSystemSize returns the value of ManagedSystemSize.
+func (s *RedfishSystemOemDell) GetManagedSystemSize() OptString {
+	return s.ManagedSystemSize
 }
 
-// GetCriticality returns the value of Criticality.
-func (s *RedfishDellUpgradeFirmwareUpdateListItem) GetCriticality() OptString {
-	return s.Criticality
+// GetMaxCPUSockets returns the value of MaxCPUSockets.
+func (s *RedfishSystemOemDell) GetMaxCPUSockets() OptInt {
+	return s.MaxCPUSockets
 }
 
-// GetDisplayName returns the value of DisplayName.
-func (s *RedfishDellUpgradeFirmwareUpdateListItem) GetDisplayName() OptString {
-	return s.DisplayName
+// GetMaxDIMMSlots returns the value of MaxDIMMSlots.
+func (s *RedfishSystemOemDell) GetMaxDIMMSlots() OptInt {
+	return s.MaxDIMMSlots
 }
 
-// GetInstalledVersion returns the value of InstalledVersion.
-func (s *RedfishDellUpgradeFirmwareUpdateListItem) GetInstalledVersion() OptString {
-	return s.InstalledVersion
+// GetMaxPCIeSlots returns the value of MaxPCIeSlots.
+func (s *RedfishSystemOemDell) GetMaxPCIeSlots() OptInt {
+	return s.MaxPCIeSlots
 }
 
-// GetJobID returns the value of JobID.
-func (s *RedfishDellUpgradeFirmwareUpdateListItem) GetJobID() OptString {
-	return s.JobID
+// GetMemoryOperationMode returns the value of MemoryOperationMode.
+func (s *RedfishSystemOemDell) GetMemoryOperationMode() OptString {
+	return s.MemoryOperationMode
 }
 
 // GetName returns the value of Name.
-func (s *RedfishDellUpgradeFirmwareUpdateListItem) GetName() OptString {
+func (s *RedfishSystemOemDell) GetName() OptString {
 	return s.Name
 }
 
-// GetPackageName returns the value of PackageName.
-func (s *RedfishDellUpgradeFirmwareUpdateListItem) GetPackageName() OptString {
-	return s.PackageName
-}
-
-// GetPackagePath returns the value of PackagePath.
-func (s *RedfishDellUpgradeFirmwareUpdateListItem) GetPackagePath() OptString {
-	return s.PackagePath
-}
-
-// GetPackageVersion returns the value of PackageVersion.
-func (s *RedfishDellUpgradeFirmwareUpdateListItem) GetPackageVersion() OptString {
-	return s.PackageVersion
-}
-
-// GetRebootType returns the value of RebootType.
-func (s *RedfishDellUpgradeFirmwareUpdateListItem) GetRebootType() OptString {
-	return s.RebootType
-}
-
-// GetTarget returns the value of Target.
-func (s *RedfishDellUpgradeFirmwareUpdateListItem) GetTarget() OptString {
-	return s.Target
+// GetNodeID returns the value of NodeID.
+func (s *RedfishSystemOemDell) GetNodeID() OptString {
+	return s.NodeID
 }
 
-// SetBaseLocation sets the value of BaseLocation.
-func (s *RedfishDellUpgradeFirmwareUpdateListItem) SetBaseLocation(val OptString) {
-	s.BaseLocation = val
+// GetOem returns the value of Oem.
+func (s *RedfishSystemOemDell) GetOem() jx.Raw {
+	return s.Oem
 }
 
-// SetComponentID sets the value of ComponentID.
-func (s *RedfishDellUpgradeFirmwareUpdateListItem) SetComponentID(val OptString) {
-	s.ComponentID = val
+// GetPSRollupStatus returns the value of PSRollupStatus.
+func (s *RedfishSystemOemDell) GetPSRollupStatus() OptString {
+	return s.PSRollupStatus
 }
 
-// SetComponentInfoName sets the value of ComponentInfoName.
-func (s *RedfishDellUpgradeFirmwareUpdateListItem) SetComponentInfoName(val OptString) {
-	s.ComponentInfoName = val
+// GetPlatformGUID returns the value of PlatformGUID.
+func (s *RedfishSystemOemDell) GetPlatformGUID() OptString {
+	return s.PlatformGUID
 }
 
-// SetComponentInfoValue sets the value of ComponentInfoValue.
-func (s *RedfishDellUpgradeFirmwareUpdateListItem) SetComponentInfoValue(val OptString) {
-	s.ComponentInfoValue = val
+// GetPopulatedDIMMSlots returns the value of PopulatedDIMMSlots.
+func (s *RedfishSystemOemDell) GetPopulatedDIMMSlots() OptInt {
+	return s.PopulatedDIMMSlots
 }
 
-// SetComponentType sets the value of ComponentType.
-func (s *RedfishDellUpgradeFirmwareUpdateListItem) SetComponentType(val OptString) {
-	s.ComponentType = val
+// GetPopulatedPCIeSlots returns the value of PopulatedPCIeSlots.
+func (s *RedfishSystemOemDell) GetPopulatedPCIeSlots() OptInt {
+	return s.PopulatedPCIeSlots
 }
 
-// SetCriticality sets the value of Criticality.
-func (s *RedfishDellUpgradeFirmwareUpdateListItem) SetCriticality(val OptString) {
-	s.Criticality = val
+// GetPowerCapEnabledState returns the value of PowerCapEnabledState.
+func (s *RedfishSystemOemDell) GetPowerCapEnabledState() OptString {
+	return s.PowerCapEnabledState
 }
 
-// SetDisplayName sets the value of DisplayName.
-func (s *RedfishDellUpgradeFirmwareUpdateListItem) SetDisplayName(val OptString) {
-	s.DisplayName = val
+// GetSDCardRollupStatus returns the value of SDCardRollupStatus.
+func (s *RedfishSystemOemDell) GetSDCardRollupStatus() OptString {
+	return s.SDCardRollupStatus
 }
 
-// SetInstalledVersion sets the value of InstalledVersion.
-func (s *RedfishDellUpgradeFirmwareUpdateListItem) SetInstalledVersion(val OptString) {
-	s.InstalledVersion = val
+// GetSELRollupStatus returns the value of SELRollupStatus.
+func (s *RedfishSystemOemDell) GetSELRollupStatus() OptString {
+	return s.SELRollupStatus
 }
 
-// SetJobID sets the value of JobID.
-func (s *RedfishDellUpgradeFirmwareUpdateListItem) SetJobID(val OptString) {
-	s.JobID = val
+// GetServerAllocationWatts returns the value of ServerAllocationWatts.
+func (s *RedfishSystemOemDell) GetServerAllocationWatts() OptInt {
+	return s.ServerAllocationWatts
 }
 
-// SetName sets the value of Name.
-func (s *RedfishDellUpgradeFirmwareUpdateListItem) SetName(val OptString) {
-	s.Name = val
+// GetStorageRollupStatus returns the value of StorageRollupStatus.
+func (s *RedfishSystemOemDell) GetStorageRollupStatus() OptString {
+	return s.StorageRollupStatus
 }
 
-// SetPackageName sets the value of PackageName.
-func (s *RedfishDellUpgradeFirmwareUpdateListItem) SetPackageName(val OptString) {
-	s.PackageName = val
+// GetSysMemErrorMethodology returns the value of SysMemErrorMethodology.
+func (s *RedfishSystemOemDell) GetSysMemErrorMethodology() OptString {
+	return s.SysMemErrorMethodology
 }
 
-// SetPackagePath sets the value of PackagePath.
-func (s *RedfishDellUpgradeFirmwareUpdateListItem) SetPackagePath(val OptString) {
-	s.PackagePath = val
+// GetSysMemFailOverState returns the value of SysMemFailOverState.
+func (s *RedfishSystemOemDell) GetSysMemFailOverState() OptString {
+	return s.SysMemFailOverState
 }
 
-// SetPackageVersion sets the value of PackageVersion.
-func (s *RedfishDellUpgradeFirmwareUpdateListItem) SetPackageVersion(val OptString) {
-	s.PackageVersion = val
+// GetSysMemLocation returns the value of SysMemLocation.
+func (s *RedfishSystemOemDell) GetSysMemLocation() OptString {
+	return s.SysMemLocation
 }
 
-// SetRebootType sets the value of RebootType.
-func (s *RedfishDellUpgradeFirmwareUpdateListItem) SetRebootType(val OptString) {
-	s.RebootType = val
+// GetSysMemPrimaryStatus returns the value of SysMemPrimaryStatus.
+func (s *RedfishSystemOemDell) GetSysMemPrimaryStatus() OptString {
+	return s.SysMemPrimaryStatus
 }
 
-// SetTarget sets the value of Target.
-func (s *RedfishDellUpgradeFirmwareUpdateListItem) SetTarget(val OptString) {
-	s.Target = val
+// GetSystemGeneration returns the value of SystemGeneration.
+func (s *RedfishSystemOemDell) GetSystemGeneration() OptString {
+	return s.SystemGeneration
 }
 
-// RedfishJob schema.
-// Ref: #/components/schemas/RedfishJob
-type RedfishJob struct {
-	Jobs OptNilNilRedfishJobJobsItemArray `json:"jobs"`
-	Name OptString                        `json:"name"`
+// GetSystemID returns the value of SystemID.
+func (s *RedfishSystemOemDell) GetSystemID() OptInt {
+	return s.SystemID
 }
 
-// GetJobs returns the value of Jobs.
-func (s *RedfishJob) GetJobs() OptNilNilRedfishJobJobsItemArray {
-	return s.Jobs
+// GetSystemRevision returns the value of SystemRevision.
+func (s *RedfishSystemOemDell) GetSystemRevision() OptString {
+	return s.SystemRevision
 }
 
-// GetName returns the value of Name.
-func (s *RedfishJob) GetName() OptString {
-	return s.Name
+// GetTempRollupStatus returns the value of TempRollupStatus.
+func (s *RedfishSystemOemDell) GetTempRollupStatus() OptString {
+	return s.TempRollupStatus
 }
 
-// SetJobs sets the value of Jobs.
-func (s *RedfishJob) SetJobs(val OptNilNilRedfishJobJobsItemArray) {
-	s.Jobs = val
+// GetTempStatisticsRollupStatus returns the value of TempStatisticsRollupStatus.
+func (s *RedfishSystemOemDell) GetTempStatisticsRollupStatus() OptString {
+	return s.TempStatisticsRollupStatus
 }
 
-// SetName sets the value of Name.
-func (s *RedfishJob) SetName(val OptString) {
-	s.Name = val
+// GetUUID returns the value of UUID.
+func (s *RedfishSystemOemDell) GetUUID() OptString {
+	return s.UUID
 }
 
-type RedfishJobJobsItem struct {
-	MessageDotExtendedInfo  []RedfishJobJobsItemMessageDotExtendedInfoItem `json:"@Message.ExtendedInfo"`
-	OdataDotContext         OptString                                      `json:"@odata.context"`
-	OdataDotEtag            OptString                                      `json:"@odata.etag"`
-	OdataDotID              OptString                                      `json:"@odata.id"`
-	OdataDotType            OptString                                      `json:"@odata.type"`
-	CreatedBy               OptString                                      `json:"CreatedBy"`
-	CreationTime            OptString                                      `json:"CreationTime"`
-	Description             OptString                                      `json:"Description"`
-	EndTime                 OptString                                      `json:"EndTime"`
-	EstimatedCompletionTime OptString                                      `json:"EstimatedCompletionTime"`
-	EstimatedDuration       OptString                                      `json:"EstimatedDuration"`
-	HidePayload             OptBool                                        `json:"HidePayload"`
-	ID                      OptString                                      `json:"Id"`
-	JobPriority             OptNilInt                                      `json:"JobPriority"`
-	JobState                OptString                                      `json:"JobState"`
-	JobStatus               OptString                                      `json:"JobStatus"`
-	JobType                 OptString                                      `json:"JobType"`
-	MaxExecutionTime        OptString                                      `json:"MaxExecutionTime"`
-	Messages                []RedfishJobJobsItemMessagesItem               `json:"Messages"`
-	Name                    OptString                                      `json:"Name"`
-	Oem                     jx.Raw                                         `json:"Oem"`
-	Parameters              OptRedfishJobJobsItemParameters                `json:"Parameters"`
-	Payload                 OptRedfishJobJobsItemPayload                   `json:"Payload"`
-	PercentComplete         OptNilInt                                      `json:"PercentComplete"`
-	RawData                 []byte                                         `json:"RawData"`
-	Schedule                OptRedfishJobJobsItemSchedule                  `json:"Schedule"`
-	StartTime               OptString                                      `json:"StartTime"`
-	StepOrder               OptNilNilStringArray                           `json:"StepOrder"`
+// GetVoltRollupStatus returns the value of VoltRollupStatus.
+func (s *RedfishSystemOemDell) GetVoltRollupStatus() OptString {
+	return s.VoltRollupStatus
 }
 
-// GetMessageDotExtendedInfo returns the value of MessageDotExtendedInfo.
-func (s *RedfishJobJobsItem) GetMessageDotExtendedInfo() []RedfishJobJobsItemMessageDotExtendedInfoItem {
-	return s.MessageDotExtendedInfo
+// GetSmbiosGUID returns the value of SmbiosGUID.
+func (s *RedfishSystemOemDell) GetSmbiosGUID() OptString {
+	return s.SmbiosGUID
 }
 
-// GetOdataDotContext returns the value of OdataDotContext.
-func (s *RedfishJobJobsItem) GetOdataDotContext() OptString {
-	return s.OdataDotContext
+// SetMessageDotExtendedInfo sets the value of MessageDotExtendedInfo.
+func (s *RedfishSystemOemDell) SetMessageDotExtendedInfo(val OptNilRedfishSystemOemDellMessageDotExtendedInfoItemArray) {
+	s.MessageDotExtendedInfo = val
 }
 
-// GetOdataDotEtag returns the value of OdataDotEtag.
-func (s *RedfishJobJobsItem) GetOdataDotEtag() OptString {
-	return s.OdataDotEtag
+// SetOdataDotContext sets the value of OdataDotContext.
+func (s *RedfishSystemOemDell) SetOdataDotContext(val OptString) {
+	s.OdataDotContext = val
 }
 
-// GetOdataDotID returns the value of OdataDotID.
-func (s *RedfishJobJobsItem) GetOdataDotID() OptString {
-	return s.OdataDotID
+// SetOdataDotEtag sets the value of OdataDotEtag.
+func (s *RedfishSystemOemDell) SetOdataDotEtag(val OptNilString) {
+	s.OdataDotEtag = val
 }
 
-// GetOdataDotType returns the value of OdataDotType.
-func (s *RedfishJobJobsItem) GetOdataDotType() OptString {
-	return s.OdataDotType
+// SetOdataDotID sets the value of OdataDotID.
+func (s *RedfishSystemOemDell) SetOdataDotID(val OptString) {
+	s.OdataDotID = val
 }
 
-// GetCreatedBy returns the value of CreatedBy.
-func (s *RedfishJobJobsItem) GetCreatedBy() OptString {
-	return s.CreatedBy
+// SetOdataDotType sets the value of OdataDotType.
+func (s *RedfishSystemOemDell) SetOdataDotType(val OptString) {
+	s.OdataDotType = val
 }
 
-// GetCreationTime returns the value of CreationTime.
-func (s *RedfishJobJobsItem) GetCreationTime() OptString {
-	return s.CreationTime
+// SetBIOSReleaseDate sets the value of BIOSReleaseDate.
+func (s *RedfishSystemOemDell) SetBIOSReleaseDate(val OptString) {
+	s.BIOSReleaseDate = val
 }
 
-// GetDescription returns the value of Description.
-func (s *RedfishJobJobsItem) GetDescription() OptString {
-	return s.Description
+// SetBaseBoardChassisSlot sets the value of BaseBoardChassisSlot.
+func (s *RedfishSystemOemDell) SetBaseBoardChassisSlot(val OptString) {
+	s.BaseBoardChassisSlot = val
 }
 
-// GetEndTime returns the value of EndTime.
-func (s *RedfishJobJobsItem) GetEndTime() OptString {
-	return s.EndTime
+// SetBatteryRollupStatus sets the value of BatteryRollupStatus.
+func (s *RedfishSystemOemDell) SetBatteryRollupStatus(val OptString) {
+	s.BatteryRollupStatus = val
 }
 
-// GetEstimatedCompletionTime returns the value of EstimatedCompletionTime.
-func (s *RedfishJobJobsItem) GetEstimatedCompletionTime() OptString {
-	return s.EstimatedCompletionTime
+// SetBladeGeometry sets the value of BladeGeometry.
+func (s *RedfishSystemOemDell) SetBladeGeometry(val OptString) {
+	s.BladeGeometry = val
 }
 
-// GetEstimatedDuration returns the value of EstimatedDuration.
-func (s *RedfishJobJobsItem) GetEstimatedDuration() OptString {
-	return s.EstimatedDuration
+// SetCMCIP sets the value of CMCIP.
+func (s *RedfishSystemOemDell) SetCMCIP(val OptString) {
+	s.CMCIP = val
 }
 
-// GetHidePayload returns the value of HidePayload.
-func (s *RedfishJobJobsItem) GetHidePayload() OptBool {
-	return s.HidePayload
+// SetCPURollupStatus sets the value of CPURollupStatus.
+func (s *RedfishSystemOemDell) SetCPURollupStatus(val OptString) {
+	s.CPURollupStatus = val
 }
 
-// GetID returns the value of ID.
-func (s *RedfishJobJobsItem) GetID() OptString {
-	return s.ID
+// SetChassisModel sets the value of ChassisModel.
+func (s *RedfishSystemOemDell) SetChassisModel(val OptString) {
+	s.ChassisModel = val
 }
 
-// GetJobPriority returns the value of JobPriority.
-func (s *RedfishJobJobsItem) GetJobPriority() OptNilInt {
-	return s.JobPriority
+// SetChassisName sets the value of ChassisName.
+func (s *RedfishSystemOemDell) SetChassisName(val OptString) {
+	s.ChassisName = val
 }
 
-// GetJobState returns the value of JobState.
-func (s *RedfishJobJobsItem) GetJobState() OptString {
-	return s.JobState
+// SetChassisServiceTag sets the value of ChassisServiceTag.
+func (s *RedfishSystemOemDell) SetChassisServiceTag(val OptString) {
+	s.ChassisServiceTag = val
 }
 
-// GetJobStatus returns the value of JobStatus.
-func (s *RedfishJobJobsItem) GetJobStatus() OptString {
-	return s.JobStatus
+// SetChassisSystemHeightUnit sets the value of ChassisSystemHeightUnit.
+func (s *RedfishSystemOemDell) SetChassisSystemHeightUnit(val OptInt) {
+	s.ChassisSystemHeightUnit = val
 }
 
-// GetJobType returns the value of JobType.
-func (s *RedfishJobJobsItem) GetJobType() OptString {
-	return s.JobType
+// SetCurrentRollupStatus sets the value of CurrentRollupStatus.
+func (s *RedfishSystemOemDell) SetCurrentRollupStatus(val OptString) {
+	s.CurrentRollupStatus = val
 }
 
-// GetMaxExecutionTime returns the value of MaxExecutionTime.
-func (s *RedfishJobJobsItem) GetMaxExecutionTime() OptString {
-	return s.MaxExecutionTime
+// SetDescription sets the value of Description.
+func (s *RedfishSystemOemDell) SetDescription(val OptString) {
+	s.Description = val
 }
 
-// GetMessages returns the value of Messages.
-func (s *RedfishJobJobsItem) GetMessages() []RedfishJobJobsItemMessagesItem {
-	return s.Messages
+// SetEstimatedExhaustTemperatureCelsius sets the value of EstimatedExhaustTemperatureCelsius.
+func (s *RedfishSystemOemDell) SetEstimatedExhaustTemperatureCelsius(val OptInt) {
+	s.EstimatedExhaustTemperatureCelsius = val
 }
 
-// GetName returns the value of Name.
-func (s *RedfishJobJobsItem) GetName() OptString {
-	return s.Name
+// SetEstimatedSystemAirflowCFM sets the value of EstimatedSystemAirflowCFM.
+func (s *RedfishSystemOemDell) SetEstimatedSystemAirflowCFM(val OptInt) {
+	s.EstimatedSystemAirflowCFM = val
 }
 
-// GetOem returns the value of Oem.
-func (s *RedfishJobJobsItem) GetOem() jx.Raw {
-	return s.Oem
+// SetExpressServiceCode sets the value of ExpressServiceCode.
+func (s *RedfishSystemOemDell) SetExpressServiceCode(val OptString) {
+	s.ExpressServiceCode = val
 }
 
-// GetParameters returns the value of Parameters.
-func (s *RedfishJobJobsItem) GetParameters() OptRedfishJobJobsItemParameters {
-	return s.Parameters
+// SetFanRollupStatus sets the value of FanRollupStatus.
+func (s *RedfishSystemOemDell) SetFanRollupStatus(val OptString) {
+	s.FanRollupStatus = val
 }
 
-// GetPayload returns the value of Payload.
-func (s *RedfishJobJobsItem) GetPayload() OptRedfishJobJobsItemPayload {
-	return s.Payload
+// SetIDSDMRollupStatus sets the value of IDSDMRollupStatus.
+func (s *RedfishSystemOemDell) SetIDSDMRollupStatus(val OptString) {
+	s.IDSDMRollupStatus = val
 }
 
-// GetPercentComplete returns the value of PercentComplete.
-func (s *RedfishJobJobsItem) GetPercentComplete() OptNilInt {
-	return s.PercentComplete
+// SetID sets the value of ID.
+func (s *RedfishSystemOemDell) SetID(val OptString) {
+	s.ID = val
 }
 
-// GetRawData returns the value of RawData.
-func (s *RedfishJobJobsItem) GetRawData() []byte {
-	return s.RawData
+// SetIntrusionRollupStatus sets the value of IntrusionRollupStatus.
+func (s *RedfishSystemOemDell) SetIntrusionRollupStatus(val OptString) {
+	s.IntrusionRollupStatus = val
 }
 
-// GetSchedule returns the value of Schedule.
-func (s *RedfishJobJobsItem) GetSchedule() OptRedfishJobJobsItemSchedule {
-	return s.Schedule
+// SetIsOEMBranded sets the value of IsOEMBranded.
+func (s *RedfishSystemOemDell) SetIsOEMBranded(val OptString) {
+	s.IsOEMBranded = val
 }
 
-// GetStartTime returns the value of StartTime.
-func (s *RedfishJobJobsItem) GetStartTime() OptString {
-	return s.StartTime
+// SetLastSystemInventoryTime sets the value of LastSystemInventoryTime.
+func (s *RedfishSystemOemDell) SetLastSystemInventoryTime(val OptString) {
+	s.LastSystemInventoryTime = val
 }
 
-// GetStepOrder returns the value of StepOrder.
-func (s *RedfishJobJobsItem) GetStepOrder() OptNilNilStringArray {
-	return s.StepOrder
+// SetLastUpdateTime sets the value of LastUpdateTime.
+func (s *RedfishSystemOemDell) SetLastUpdateTime(val OptString) {
+	s.LastUpdateTime = val
 }
 
-// SetMessageDotExtendedInfo sets the value of MessageDotExtendedInfo.
-func (s *RedfishJobJobsItem) SetMessageDotExtendedInfo(val []RedfishJobJobsItemMessageDotExtendedInfoItem) {
-	s.MessageDotExtendedInfo = val
+// SetLicensingRollupStatus sets the value of LicensingRollupStatus.
+func (s *RedfishSystemOemDell) SetLicensingRollupStatus(val OptString) {
+	s.LicensingRollupStatus = val
 }
 
-// SetOdataDotContext sets the value of OdataDotContext.
-func (s *RedfishJobJobsItem) SetOdataDotContext(val OptString) {
-	s.OdataDotContext = val
+// SetManagedSystemSize sets the value of ManagedSystemSize.
+func (s *RedfishSystemOemDell) SetManagedSystemSize(val OptString) {
+	s.ManagedSystemSize = val
 }
 
-// SetOdataDotEtag sets the value of OdataDotEtag.
-func (s *RedfishJobJobsItem) SetOdataDotEtag(val OptString) {
-	s.OdataDotEtag = val
+// SetMaxCPUSockets sets the value of MaxCPUSockets.
+func (s *RedfishSystemOemDell) SetMaxCPUSockets(val OptInt) {
+	s.MaxCPUSockets = val
 }
 
-// SetOdataDotID sets the value of OdataDotID.
-func (s *RedfishJobJobsItem) SetOdataDotID(val OptString) {
-	s.OdataDotID = val
+// SetMaxDIMMSlots sets the value of MaxDIMMSlots.
+func (s *RedfishSystemOemDell) SetMaxDIMMSlots(val OptInt) {
+	s.MaxDIMMSlots = val
 }
 
-// SetOdataDotType sets the value of OdataDotType.
-func (s *RedfishJobJobsItem) SetOdataDotType(val OptString) {
-	s.OdataDotType = val
+// SetMaxPCIeSlots sets the value of MaxPCIeSlots.
+func (s *RedfishSystemOemDell) SetMaxPCIeSlots(val OptInt) {
+	s.MaxPCIeSlots = val
 }
 
-// SetCreatedBy sets the value of CreatedBy.
-func (s *RedfishJobJobsItem) SetCreatedBy(val OptString) {
-	s.CreatedBy = val
+// SetMemoryOperationMode sets the value of MemoryOperationMode.
+func (s *RedfishSystemOemDell) SetMemoryOperationMode(val OptString) {
+	s.MemoryOperationMode = val
 }
 
-// SetCreationTime sets the value of CreationTime.
-func (s *RedfishJobJobsItem) SetCreationTime(val OptString) {
-	s.CreationTime = val
+// SetName sets the value of Name.
+func (s *RedfishSystemOemDell) SetName(val OptString) {
+	s.Name = val
 }
 
-// SetDescription sets the value of Description.
-func (s *RedfishJobJobsItem) SetDescription(val OptString) {
-	s.Description = val
+// SetNodeID sets the value of NodeID.
+func (s *RedfishSystemOemDell) SetNodeID(val OptString) {
+	s.NodeID = val
 }
 
-// SetEndTime sets the value of EndTime.
-func (s *RedfishJobJobsItem) SetEndTime(val OptString) {
-	s.EndTime = val
+// SetOem sets the value of Oem.
+func (s *RedfishSystemOemDell) SetOem(val jx.Raw) {
+	s.Oem = val
 }
 
-// SetEstimatedCompletionTime sets the value of EstimatedCompletionTime.
-func (s *RedfishJobJobsItem) SetEstimatedCompletionTime(val OptString) {
-	s.EstimatedCompletionTime = val
+// SetPSRollupStatus sets the value of PSRollupStatus.
+func (s *RedfishSystemOemDell) SetPSRollupStatus(val OptString) {
+	s.PSRollupStatus = val
 }
 
-// SetEstimatedDuration sets the value of EstimatedDuration.
-func (s *RedfishJobJobsItem) SetEstimatedDuration(val OptString) {
-	s.EstimatedDuration = val
+// SetPlatformGUID sets the value of PlatformGUID.
+func (s *RedfishSystemOemDell) SetPlatformGUID(val OptString) {
+	s.PlatformGUID = val
 }
 
-// SetHidePayload sets the value of HidePayload.
-func (s *RedfishJobJobsItem) SetHidePayload(val OptBool) {
-	s.HidePayload = val
+// SetPopulatedDIMMSlots sets the value of PopulatedDIMMSlots.
+func (s *RedfishSystemOemDell) SetPopulatedDIMMSlots(val OptInt) {
+	s.PopulatedDIMMSlots = val
 }
 
-// SetID sets the value of ID.
-func (s *RedfishJobJobsItem) SetID(val OptString) {
-	s.ID = val
+// SetPopulatedPCIeSlots sets the value of PopulatedPCIeSlots.
+func (s *RedfishSystemOemDell) SetPopulatedPCIeSlots(val OptInt) {
+	s.PopulatedPCIeSlots = val
 }
 
-// SetJobPriority sets the value of JobPriority.
-func (s *RedfishJobJobsItem) SetJobPriority(val OptNilInt) {
-	s.JobPriority = val
+// SetPowerCapEnabledState sets the value of PowerCapEnabledState.
+func (s *RedfishSystemOemDell) SetPowerCapEnabledState(val OptString) {
+	s.PowerCapEnabledState = val
 }
 
-// SetJobState sets the value of JobState.
-func (s *RedfishJobJobsItem) SetJobState(val OptString) {
-	s.JobState = val
+// SetSDCardRollupStatus sets the value of SDCardRollupStatus.
+func (s *RedfishSystemOemDell) SetSDCardRollupStatus(val OptString) {
+	s.SDCardRollupStatus = val
 }
 
-// SetJobStatus sets the value of JobStatus.
-func (s *RedfishJobJobsItem) SetJobStatus(val OptString) {
-	s.JobStatus = val
+// SetSELRollupStatus sets the value of SELRollupStatus.
+func (s *RedfishSystemOemDell) SetSELRollupStatus(val OptString) {
+	s.SELRollupStatus = val
 }
 
-// SetJobType sets the value of JobType.
-func (s *RedfishJobJobsItem) SetJobType(val OptString) {
-	s.JobType = val
+// SetServerAllocationWatts sets the value of ServerAllocationWatts.
+func (s *RedfishSystemOemDell) SetServerAllocationWatts(val OptInt) {
+	s.ServerAllocationWatts = val
 }
 
-// SetMaxExecutionTime sets the value of MaxExecutionTime.
-func (s *RedfishJobJobsItem) SetMaxExecutionTime(val OptString) {
-	s.MaxExecutionTime = val
+// SetStorageRollupStatus sets the value of StorageRollupStatus.
+func (s *RedfishSystemOemDell) SetStorageRollupStatus(val OptString) {
+	s.StorageRollupStatus = val
 }
 
-// SetMessages sets the value of Messages.
-func (s *RedfishJobJobsItem) SetMessages(val []RedfishJobJobsItemMessagesItem) {
-	s.Messages = val
+// SetSysMemErrorMethodology sets the value of SysMemErrorMethodology.
+func (s *RedfishSystemOemDell) SetSysMemErrorMethodology(val OptString) {
+	s.SysMemErrorMethodology = val
 }
 
-// SetName sets the value of Name.
-func (s *RedfishJobJobsItem) SetName(val OptString) {
-	s.Name = val
+// SetSysMemFailOverState sets the value of SysMemFailOverState.
+func (s *RedfishSystemOemDell) SetSysMemFailOverState(val OptString) {
+	s.SysMemFailOverState = val
 }
 
-// SetOem sets the value of Oem.
-func (s *RedfishJobJobsItem) SetOem(val jx.Raw) {
-	s.Oem = val
+// SetSysMemLocation sets the value of SysMemLocation.
+func (s *RedfishSystemOemDell) SetSysMemLocation(val OptString) {
+	s.SysMemLocation = val
 }
 
-// SetParameters sets the value of Parameters.
-func (s *RedfishJobJobsItem) SetParameters(val OptRedfishJobJobsItemParameters) {
-	s.Parameters = val
+// SetSysMemPrimaryStatus sets the value of SysMemPrimaryStatus.
+func (s *RedfishSystemOemDell) SetSysMemPrimaryStatus(val OptString) {
+	s.SysMemPrimaryStatus = val
 }
 
-// SetPayload sets the value of Payload.
-func (s *RedfishJobJobsItem) SetPayload(val OptRedfishJobJobsItemPayload) {
-	s.Payload = val
+// SetSystemGeneration sets the value of SystemGeneration.
+func (s *RedfishSystemOemDell) SetSystemGeneration(val OptString) {
+	s.SystemGeneration = val
 }
 
-// SetPercentComplete sets the value of PercentComplete.
-func (s *RedfishJobJobsItem) SetPercentComplete(val OptNilInt) {
-	s.PercentComplete = val
+// SetSystemID sets the value of SystemID.
+func (s *RedfishSystemOemDell) SetSystemID(val OptInt) {
+	s.SystemID = val
 }
 
-// SetRawData sets the value of RawData.
-func (s *RedfishJobJobsItem) SetRawData(val []byte) {
-	s.RawData = val
+// SetSystemRevision sets the value of SystemRevision.
+func (s *RedfishSystemOemDell) SetSystemRevision(val OptString) {
+	s.SystemRevision = val
 }
 
-// SetSchedule sets the value of Schedule.
-func (s *RedfishJobJobsItem) SetSchedule(val OptRedfishJobJobsItemSchedule) {
-	s.Schedule = val
+// SetTempRollupStatus sets the value of TempRollupStatus.
+func (s *RedfishSystemOemDell) SetTempRollupStatus(val OptString) {
+	s.TempRollupStatus = val
 }
 
-// SetStartTime sets the value of StartTime.
-func (s *RedfishJobJobsItem) SetStartTime(val OptString) {
-	s.StartTime = val
+// SetTempStatisticsRollupStatus sets the value of TempStatisticsRollupStatus.
+func (s *RedfishSystemOemDell) SetTempStatisticsRollupStatus(val OptString) {
+	s.TempStatisticsRollupStatus = val
 }
 
-// SetStepOrder sets the value of StepOrder.
-func (s *RedfishJobJobsItem) SetStepOrder(val OptNilNilStringArray) {
-	s.StepOrder = val
+// SetUUID sets the value of UUID.
+func (s *RedfishSystemOemDell) SetUUID(val OptString) {
+	s.UUID = val
 }
 
-type RedfishJobJobsItemMessageDotExtendedInfoItem struct {
-	Message                  OptString                                                         `json:"Message"`
-	MessageArgs              []string                                                          `json:"MessageArgs"`
-	MessageId                OptString                                                         `json:"MessageId"`
-	MessageSeverity          OptString                                                         `json:"MessageSeverity"`
-	Oem                      jx.Raw                                                            `json:"Oem"`
-	RelatedProperties        OptNilNilStringArray                                              `json:"RelatedProperties"`
-	Resolution               OptString                                                         `json:"Resolution"`
-	ResolutionSteps          []RedfishJobJobsItemMessageDotExtendedInfoItemResolutionStepsItem `json:"ResolutionSteps"`
-	Severity                 OptString                                                         `json:"Severity"`
-	UserAuthenticationSource OptString                                                         `json:"UserAuthenticationSource"`
-	Username                 OptString                                                         `json:"Username"`
+// SetVoltRollupStatus sets the value of VoltRollupStatus.
+func (s *RedfishSystemOemDell) SetVoltRollupStatus(val OptString) {
+	s.VoltRollupStatus = val
+}
+
+// SetSmbiosGUID sets the value of SmbiosGUID.
+func (s *RedfishSystemOemDell) SetSmbiosGUID(val OptString) {
+	s.SmbiosGUID = val
+}
+
+type RedfishSystemOemDellMessageDotExtendedInfoItem struct {
+	Message                  OptString                                                           `json:"Message"`
+	MessageArgs              []string                                                            `json:"MessageArgs"`
+	MessageId                OptString                                                           `json:"MessageId"`
+	MessageSeverity          OptString                                                           `json:"MessageSeverity"`
+	Oem                      jx.Raw                                                              `json:"Oem"`
+	RelatedProperties        OptNilNilStringArray                                                `json:"RelatedProperties"`
+	Resolution               OptString                                                           `json:"Resolution"`
+	ResolutionSteps          []RedfishSystemOemDellMessageDotExtendedInfoItemResolutionStepsItem `json:"ResolutionSteps"`
+	Severity                 OptString                                                           `json:"Severity"`
+	UserAuthenticationSource OptString                                                           `json:"UserAuthenticationSource"`
+	Username                 OptString                                                           `json:"Username"`
 }
 
 // GetMessage returns the value of Message.
-func (s *RedfishJobJobsItemMessageDotExtendedInfoItem) GetMessage() OptString {
+func (s *RedfishSystemOemDellMessageDotExtendedInfoItem) GetMessage() OptString {
 	return s.Message
 }
 
 // GetMessageArgs returns the value of MessageArgs.
-func (s *RedfishJobJobsItemMessageDotExtendedInfoItem) GetMessageArgs() []string {
+func (s *RedfishSystemOemDellMessageDotExtendedInfoItem) GetMessageArgs() []string {
 	return s.MessageArgs
 }
 
 // GetMessageId returns the value of MessageId.
-func (s *RedfishJobJobsItemMessageDotExtendedInfoItem) GetMessageId() OptString {
+func (s *RedfishSystemOemDellMessageDotExtendedInfoItem) GetMessageId() OptString {
 	return s.MessageId
 }
 
 // GetMessageSeverity returns the value of MessageSeverity.
-func (s *RedfishJobJobsItemMessageDotExtendedInfoItem) GetMessageSeverity() OptString {
+func (s *RedfishSystemOemDellMessageDotExtendedInfoItem) GetMessageSeverity() OptString {
 	return s.MessageSeverity
 }
 
 // GetOem returns the value of Oem.
-func (s *RedfishJobJobsItemMessageDotExtendedInfoItem) GetOem() jx.Raw {
+func (s *RedfishSystemOemDellMessageDotExtendedInfoItem) GetOem() jx.Raw {
 	return s.Oem
 }
 
 // GetRelatedProperties returns the value of RelatedProperties.
-func (s *RedfishJobJobsItemMessageDotExtendedInfoItem) GetRelatedProperties() OptNilNilStringArray {
+func (s *RedfishSystemOemDellMessageDotExtendedInfoItem) GetRelatedProperties() OptNilNilStringArray {
 	return s.RelatedProperties
 }
 
 // GetResolution returns the value of Resolution.
-func (s *RedfishJobJobsItemMessageDotExtendedInfoItem) GetResolution() OptString {
+func (s *RedfishSystemOemDellMessageDotExtendedInfoItem) GetResolution() OptString {
 	return s.Resolution
 }
 
 // GetResolutionSteps returns the value of ResolutionSteps.
-func (s *RedfishJobJobsItemMessageDotExtendedInfoItem) GetResolutionSteps() []RedfishJobJobsItemMessageDotExtendedInfoItemResolutionStepsItem {
+func (s *RedfishSystemOemDellMessageDotExtendedInfoItem) GetResolutionSteps() []RedfishSystemOemDellMessageDotExtendedInfoItemResolutionStepsItem {
 	return s.ResolutionSteps
 }
 
 // GetSeverity returns the value of Severity.
-func (s *RedfishJobJobsItemMessageDotExtendedInfoItem) GetSeverity() OptString {
+func (s *RedfishSystemOemDellMessageDotExtendedInfoItem) GetSeverity() OptString {
 	return s.Severity
 }
 
 // GetUserAuthenticationSource returns the value of UserAuthenticationSource.
-func (s *RedfishJobJobsItemMessageDotExtendedInfoItem) GetUserAuthenticationSource() OptString {
+func (s *RedfishSystemOemDellMessageDotExtendedInfoItem) GetUserAuthenticationSource() OptString {
 	return s.UserAuthenticationSource
 }
 
 // GetUsername returns the value of Username.
-func (s *RedfishJobJobsItemMessageDotExtendedInfoItem) GetUsername() OptString {
+func (s *RedfishSystemOemDellMessageDotExtendedInfoItem) GetUsername() OptString {
 	return s.Username
 }
 
 // SetMessage sets the value of Message.
-func (s *RedfishJobJobsItemMessageDotExtendedInfoItem) SetMessage(val OptString) {
+func (s *RedfishSystemOemDellMessageDotExtendedInfoItem) SetMessage(val OptString) {
 	s.Message = val
 }
 
 // SetMessageArgs sets the value of MessageArgs.
-func (s *RedfishJobJobsItemMessageDotExtendedInfoItem) SetMessageArgs(val []string) {
+func (s *RedfishSystemOemDellMessageDotExtendedInfoItem) SetMessageArgs(val []string) {
 	s.MessageArgs = val
 }
 
 // SetMessageId sets the value of MessageId.
-func (s *RedfishJobJobsItemMessageDotExtendedInfoItem) SetMessageId(val OptString) {
+func (s *RedfishSystemOemDellMessageDotExtendedInfoItem) SetMessageId(val OptString) {
 	s.MessageId = val
 }
 
 // SetMessageSeverity sets the value of MessageSeverity.
-func (s *RedfishJobJobsItemMessageDotExtendedInfoItem) SetMessageSeverity(val OptString) {
+func (s *RedfishSystemOemDellMessageDotExtendedInfoItem) SetMessageSeverity(val OptString) {
 	s.MessageSeverity = val
 }
 
 // SetOem sets the value of Oem.
-func (s *RedfishJobJobsItemMessageDotExtendedInfoItem) SetOem(val jx.Raw) {
+func (s *RedfishSystemOemDellMessageDotExtendedInfoItem) SetOem(val jx.Raw) {
 	s.Oem = val
 }
 
 // SetRelatedProperties sets the value of RelatedProperties.
-func (s *RedfishJobJobsItemMessageDotExtendedInfoItem) SetRelatedProperties(val OptNilNilStringArray) {
+func (s *RedfishSystemOemDellMessageDotExtendedInfoItem) SetRelatedProperties(val OptNilNilStringArray) {
 	s.RelatedProperties = val
 }
 
 // SetResolution sets the value of Resolution.
-func (s *RedfishJobJobsItemMessageDotExtendedInfoItem) SetResolution(val OptString) {
+func (s *RedfishSystemOemDellMessageDotExtendedInfoItem) SetResolution(val OptString) {
 	s.Resolution = val
 }
 
 // SetResolutionSteps sets the value of ResolutionSteps.
-func (s *RedfishJobJobsItemMessageDotExtendedInfoItem) SetResolutionSteps(val []RedfishJobJobsItemMessageDotExtendedInfoItemResolutionStepsItem) {
+func (s *RedfishSystemOemDellMessageDotExtendedInfoItem) SetResolutionSteps(val []RedfishSystemOemDellMessageDotExtendedInfoItemResolutionStepsItem) {
 	s.ResolutionSteps = val
 }
 
 // SetSeverity sets the value of Severity.
-func (s *RedfishJobJobsItemMessageDotExtendedInfoItem) SetSeverity(val OptString) {
+func (s *RedfishSystemOemDellMessageDotExtendedInfoItem) SetSeverity(val OptString) {
 	s.Severity = val
 }
 
 // SetUserAuthenticationSource sets the value of UserAuthenticationSource.
-func (s *RedfishJobJobsItemMessageDotExtendedInfoItem) SetUserAuthenticationSource(val OptString) {
+func (s *RedfishSystemOemDellMessageDotExtendedInfoItem) SetUserAuthenticationSource(val OptString) {
 	s.UserAuthenticationSource = val
 }
 
 // SetUsername sets the value of Username.
-func (s *RedfishJobJobsItemMessageDotExtendedInfoItem) SetUsername(val OptString) {
+func (s *RedfishSystemOemDellMessageDotExtendedInfoItem) SetUsername(val OptString) {
 	s.Username = val
 }
 
-type RedfishJobJobsItemMessageDotExtendedInfoItemResolutionStepsItem struct {
-	ActionParameters     []RedfishJobJobsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem `json:"ActionParameters"`
-	ActionURI            OptString                                                                             `json:"ActionURI"`
-	Oem                  jx.Raw                                                                                `json:"Oem"`
-	Priority             OptNilInt                                                                             `json:"Priority"`
-	ResolutionType       OptString                                                                             `json:"ResolutionType"`
-	RetryCount           OptNilInt                                                                             `json:"RetryCount"`
-	RetryIntervalSeconds OptNilInt                                                                             `json:"RetryIntervalSeconds"`
-	TargetComponentURI   OptString                                                                             `json:"TargetComponentURI"`
+type RedfishSystemOemDellMessageDotExtendedInfoItemResolutionStepsItem struct {
+	ActionParameters     []RedfishSystemOemDellMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem `json:"ActionParameters"`
+	ActionURI            OptString                                                                               `json:"ActionURI"`
+	Oem                  jx.Raw                                                                                  `json:"Oem"`
+	Priority             OptNilInt                                                                               `json:"Priority"`
+	ResolutionType       OptString                                                                               `json:"ResolutionType"`
+	RetryCount           OptNilInt                                                                               `json:"RetryCount"`
+	RetryIntervalSeconds OptNilInt                                                                               `json:"RetryIntervalSeconds"`
+	TargetComponentURI   OptString                                                                               `json:"TargetComponentURI"`
 }
 
 // GetActionParameters returns the value of ActionParameters.
-func (s *RedfishJobJobsItemMessageDotExtendedInfoItemResolutionStepsItem) GetActionParameters() []RedfishJobJobsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem {
+func (s *RedfishSystemOemDellMessageDotExtendedInfoItemResolutionStepsItem) GetActionParameters() []RedfishSystemOemDellMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem {
 	return s.ActionParameters
 }
 
 // GetActionURI returns the value of ActionURI.
-func (s *RedfishJobJobsItemMessageDotExtendedInfoItemResolutionStepsItem) GetActionURI() OptString {
+func (s *RedfishSystemOemDellMessageDotExtendedInfoItemResolutionStepsItem) GetActionURI() OptString {
 	return s.ActionURI
 }
 
 // GetOem returns the value of Oem.
-func (s *RedfishJobJobsItemMessageDotExtendedInfoItemResolutionStepsItem) GetOem() jx.Raw {
+func (s *RedfishSystemOemDellMessageDotExtendedInfoItemResolutionStepsItem) GetOem() jx.Raw {
 	return s.Oem
 }
 
 // GetPriority returns the value of Priority.
-func (s *RedfishJobJobsItemMessageDotExtendedInfoItemResolutionStepsItem) GetPriority() OptNilInt {
+func (s *RedfishSystemOemDellMessageDotExtendedInfoItemResolutionStepsItem) GetPriority() OptNilInt {
 	return s.Priority
 }
 
 // GetResolutionType returns the value of ResolutionType.
-func (s *RedfishJobJobsItemMessageDotExtendedInfoItemResolutionStepsItem) GetResolutionType() OptString {
+func (s *RedfishSystemOemDellMessageDotExtendedInfoItemResolutionStepsItem) GetResolutionType() OptString {
 	return s.ResolutionType
 }
 
 // GetRetryCount returns the value of RetryCount.
-func (s *RedfishJobJobsItemMessageDotExtendedInfoItemResolutionStepsItem) GetRetryCount() OptNilInt {
+func (s *RedfishSystemOemDellMessageDotExtendedInfoItemResolutionStepsItem) GetRetryCount() OptNilInt {
 	return s.RetryCount
 }
 
 // GetRetryIntervalSeconds returns the value of RetryIntervalSeconds.
-func (s *RedfishJobJobsItemMessageDotExtendedInfoItemResolutionStepsItem) GetRetryIntervalSeconds() OptNilInt {
+func (s *RedfishSystemOemDellMessageDotExtendedInfoItemResolutionStepsItem) GetRetryIntervalSeconds() OptNilInt {
 	return s.RetryIntervalSeconds
 }
 
 // GetTargetComponentURI returns the value of TargetComponentURI.
-func (s *RedfishJobJobsItemMessageDotExtendedInfoItemResolutionStepsItem) GetTargetComponentURI() OptString {
+func (s *RedfishSystemOemDellMessageDotExtendedInfoItemResolutionStepsItem) GetTargetComponentURI() OptString {
 	return s.TargetComponentURI
 }
 
 // SetActionParameters sets the value of ActionParameters.
-func (s *RedfishJobJobsItemMessageDotExtendedInfoItemResolutionStepsItem) SetActionParameters(val []RedfishJobJobsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) {
+func (s *RedfishSystemOemDellMessageDotExtendedInfoItemResolutionStepsItem) SetActionParameters(val []RedfishSystemOemDellMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) {
 	s.ActionParameters = val
 }
 
 // SetActionURI sets the value of ActionURI.
-func (s *RedfishJobJobsItemMessageDotExtendedInfoItemResolutionStepsItem) SetActionURI(val OptString) {
+func (s *RedfishSystemOemDellMessageDotExtendedInfoItemResolutionStepsItem) SetActionURI(val OptString) {
 	s.ActionURI = val
 }
 
 // SetOem sets the value of Oem.
-func (s *RedfishJobJobsItemMessageDotExtendedInfoItemResolutionStepsItem) SetOem(val jx.Raw) {
+func (s *RedfishSystemOemDellMessageDotExtendedInfoItemResolutionStepsItem) SetOem(val jx.Raw) {
 	s.Oem = val
 }
 
 // SetPriority sets the value of Priority.
-func (s *RedfishJobJobsItemMessageDotExtendedInfoItemResolutionStepsItem) SetPriority(val OptNilInt) {
+func (s *RedfishSystemOemDellMessageDotExtendedInfoItemResolutionStepsItem) SetPriority(val OptNilInt) {
 	s.Priority = val
 }
 
 // SetResolutionType sets the value of ResolutionType.
-func (s *RedfishJobJobsItemMessageDotExtendedInfoItemResolutionStepsItem) SetResolutionType(val OptString) {
+func (s *RedfishSystemOemDellMessageDotExtendedInfoItemResolutionStepsItem) SetResolutionType(val OptString) {
 	s.ResolutionType = val
 }
 
 // SetRetryCount sets the value of RetryCount.
-func (s *RedfishJobJobsItemMessageDotExtendedInfoItemResolutionStepsItem) SetRetryCount(val OptNilInt) {
+func (s *RedfishSystemOemDellMessageDotExtendedInfoItemResolutionStepsItem) SetRetryCount(val OptNilInt) {
 	s.RetryCount = val
 }
 
 // SetRetryIntervalSeconds sets the value of RetryIntervalSeconds.
-func (s *RedfishJobJobsItemMessageDotExtendedInfoItemResolutionStepsItem) SetRetryIntervalSeconds(val OptNilInt) {
+func (s *RedfishSystemOemDellMessageDotExtendedInfoItemResolutionStepsItem) SetRetryIntervalSeconds(val OptNilInt) {
 	s.RetryIntervalSeconds = val
 }
 
 // SetTargetComponentURI sets the value of TargetComponentURI.
-func (s *RedfishJobJobsItemMessageDotExtendedInfoItemResolutionStepsItem) SetTargetComponentURI(val OptString) {
+func (s *RedfishSystemOemDellMessageDotExtendedInfoItemResolutionStepsItem) SetTargetComponentURI(val OptString) {
 	s.TargetComponentURI = val
 }
 
-type RedfishJobJobsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem struct {
+type RedfishSystemOemDellMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem struct {
 	AllowableNumbers           []string      `json:"AllowableNumbers"`
 	AllowablePattern           OptString     `json:"AllowablePattern"`
 	AllowableValueDescriptions []string      `json:"AllowableValueDescriptions"`
@@ -5872,2428 +19212,2537 @@ type RedfishJobJobsItemMessageDotExtendedInfoItemResolutionStepsItemActionParame
 }
 
 // GetAllowableNumbers returns the value of AllowableNumbers.
-func (s *RedfishJobJobsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) GetAllowableNumbers() []string {
+func (s *RedfishSystemOemDellMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) GetAllowableNumbers() []string {
 	return s.AllowableNumbers
 }
 
 // GetAllowablePattern returns the value of AllowablePattern.
-func (s *RedfishJobJobsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) GetAllowablePattern() OptString {
+func (s *RedfishSystemOemDellMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) GetAllowablePattern() OptString {
 	return s.AllowablePattern
 }
 
 // GetAllowableValueDescriptions returns the value of AllowableValueDescriptions.
-func (s *RedfishJobJobsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) GetAllowableValueDescriptions() []string {
+func (s *RedfishSystemOemDellMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) GetAllowableValueDescriptions() []string {
 	return s.AllowableValueDescriptions
 }
 
 // GetAllowableValues returns the value of AllowableValues.
-func (s *RedfishJobJobsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) GetAllowableValues() []string {
+func (s *RedfishSystemOemDellMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) GetAllowableValues() []string {
 	return s.AllowableValues
 }
 
 // GetArraySizeMaximum returns the value of ArraySizeMaximum.
-func (s *RedfishJobJobsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) GetArraySizeMaximum() OptNilInt {
+func (s *RedfishSystemOemDellMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) GetArraySizeMaximum() OptNilInt {
 	return s.ArraySizeMaximum
 }
 
 // GetArraySizeMinimum returns the value of ArraySizeMinimum.
-func (s *RedfishJobJobsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) GetArraySizeMinimum() OptNilInt {
+func (s *RedfishSystemOemDellMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) GetArraySizeMinimum() OptNilInt {
 	return s.ArraySizeMinimum
 }
 
 // GetDataType returns the value of DataType.
-func (s *RedfishJobJobsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) GetDataType() OptString {
+func (s *RedfishSystemOemDellMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) GetDataType() OptString {
 	return s.DataType
 }
 
 // GetDefaultValue returns the value of DefaultValue.
-func (s *RedfishJobJobsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) GetDefaultValue() OptString {
+func (s *RedfishSystemOemDellMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) GetDefaultValue() OptString {
 	return s.DefaultValue
 }
 
 // GetMaximumValue returns the value of MaximumValue.
-func (s *RedfishJobJobsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) GetMaximumValue() OptNilFloat64 {
+func (s *RedfishSystemOemDellMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) GetMaximumValue() OptNilFloat64 {
 	return s.MaximumValue
 }
 
 // GetMinimumValue returns the value of MinimumValue.
-func (s *RedfishJobJobsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) GetMinimumValue() OptNilFloat64 {
+func (s *RedfishSystemOemDellMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) GetMinimumValue() OptNilFloat64 {
 	return s.MinimumValue
 }
 
 // GetName returns the value of Name.
-func (s *RedfishJobJobsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) GetName() OptString {
+func (s *RedfishSystemOemDellMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) GetName() OptString {
 	return s.Name
 }
 
 // GetNoDefaultValue returns the value of NoDefaultValue.
-func (s *RedfishJobJobsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) GetNoDefaultValue() OptBool {
+func (s *RedfishSystemOemDellMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) GetNoDefaultValue() OptBool {
 	return s.NoDefaultValue
 }
 
 // GetObjectDataType returns the value of ObjectDataType.
-func (s *RedfishJobJobsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) GetObjectDataType() OptString {
+func (s *RedfishSystemOemDellMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) GetObjectDataType() OptString {
 	return s.ObjectDataType
 }
 
 // GetRequired returns the value of Required.
-func (s *RedfishJobJobsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) GetRequired() OptBool {
+func (s *RedfishSystemOemDellMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) GetRequired() OptBool {
 	return s.Required
 }
 
 // SetAllowableNumbers sets the value of AllowableNumbers.
-func (s *RedfishJobJobsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) SetAllowableNumbers(val []string) {
+func (s *RedfishSystemOemDellMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) SetAllowableNumbers(val []string) {
 	s.AllowableNumbers = val
 }
 
 // SetAllowablePattern sets the value of AllowablePattern.
-func (s *RedfishJobJobsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) SetAllowablePattern(val OptString) {
+func (s *RedfishSystemOemDellMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) SetAllowablePattern(val OptString) {
 	s.AllowablePattern = val
 }
 
 // SetAllowableValueDescriptions sets the value of AllowableValueDescriptions.
-func (s *RedfishJobJobsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) SetAllowableValueDescriptions(val []string) {
+func (s *RedfishSystemOemDellMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) SetAllowableValueDescriptions(val []string) {
 	s.AllowableValueDescriptions = val
 }
 
 // SetAllowableValues sets the value of AllowableValues.
-func (s *RedfishJobJobsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) SetAllowableValues(val []string) {
+func (s *RedfishSystemOemDellMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) SetAllowableValues(val []string) {
 	s.AllowableValues = val
 }
 
 // SetArraySizeMaximum sets the value of ArraySizeMaximum.
-func (s *RedfishJobJobsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) SetArraySizeMaximum(val OptNilInt) {
+func (s *RedfishSystemOemDellMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) SetArraySizeMaximum(val OptNilInt) {
 	s.ArraySizeMaximum = val
 }
 
 // SetArraySizeMinimum sets the value of ArraySizeMinimum.
-func (s *RedfishJobJobsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) SetArraySizeMinimum(val OptNilInt) {
+func (s *RedfishSystemOemDellMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) SetArraySizeMinimum(val OptNilInt) {
 	s.ArraySizeMinimum = val
 }
 
 // SetDataType sets the value of DataType.
-func (s *RedfishJobJobsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) SetDataType(val OptString) {
+func (s *RedfishSystemOemDellMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) SetDataType(val OptString) {
 	s.DataType = val
 }
 
 // SetDefaultValue sets the value of DefaultValue.
-func (s *RedfishJobJobsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) SetDefaultValue(val OptString) {
+func (s *RedfishSystemOemDellMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) SetDefaultValue(val OptString) {
 	s.DefaultValue = val
 }
 
 // SetMaximumValue sets the value of MaximumValue.
-func (s *RedfishJobJobsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) SetMaximumValue(val OptNilFloat64) {
+func (s *RedfishSystemOemDellMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) SetMaximumValue(val OptNilFloat64) {
 	s.MaximumValue = val
 }
 
 // SetMinimumValue sets the value of MinimumValue.
-func (s *RedfishJobJobsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) SetMinimumValue(val OptNilFloat64) {
+func (s *RedfishSystemOemDellMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) SetMinimumValue(val OptNilFloat64) {
 	s.MinimumValue = val
 }
 
 // SetName sets the value of Name.
-func (s *RedfishJobJobsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) SetName(val OptString) {
+func (s *RedfishSystemOemDellMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) SetName(val OptString) {
 	s.Name = val
 }
 
 // SetNoDefaultValue sets the value of NoDefaultValue.
-func (s *RedfishJobJobsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) SetNoDefaultValue(val OptBool) {
+func (s *RedfishSystemOemDellMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) SetNoDefaultValue(val OptBool) {
 	s.NoDefaultValue = val
 }
 
 // SetObjectDataType sets the value of ObjectDataType.
-func (s *RedfishJobJobsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) SetObjectDataType(val OptString) {
+func (s *RedfishSystemOemDellMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) SetObjectDataType(val OptString) {
 	s.ObjectDataType = val
 }
 
 // SetRequired sets the value of Required.
-func (s *RedfishJobJobsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) SetRequired(val OptBool) {
+func (s *RedfishSystemOemDellMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) SetRequired(val OptBool) {
 	s.Required = val
 }
 
-type RedfishJobJobsItemMessagesItem struct {
-	Message                  OptString                                           `json:"Message"`
-	MessageArgs              []string                                            `json:"MessageArgs"`
-	MessageId                OptString                                           `json:"MessageId"`
-	MessageSeverity          OptString                                           `json:"MessageSeverity"`
-	Oem                      jx.Raw                                              `json:"Oem"`
-	RelatedProperties        OptNilNilStringArray                                `json:"RelatedProperties"`
-	Resolution               OptString                                           `json:"Resolution"`
-	ResolutionSteps          []RedfishJobJobsItemMessagesItemResolutionStepsItem `json:"ResolutionSteps"`
-	Severity                 OptString                                           `json:"Severity"`
-	UserAuthenticationSource OptString                                           `json:"UserAuthenticationSource"`
-	Username                 OptString                                           `json:"Username"`
+// ReloadResponse schema.
+// Ref: #/components/schemas/ReloadResponse
+type ReloadResponse struct {
+	// Settings changed by the reload.
+	Changed []string  `json:"changed"`
+	Detail  OptString `json:"detail"`
+	// Changed settings which only take effect after a restart.
+	RestartRequired []string  `json:"restart_required"`
+	Title           OptString `json:"title"`
 }
 
-// GetMessage returns the value of Message.
-func (s *RedfishJobJobsItemMessagesItem) GetMessage() OptString {
-	return s.Message
+// GetChanged returns the value of Changed.
+func (s *ReloadResponse) GetChanged() []string {
+	return s.Changed
 }
 
-// GetMessageArgs returns the value of MessageArgs.
-func (s *RedfishJobJobsItemMessagesItem) GetMessageArgs() []string {
-	return s.MessageArgs
+// GetDetail returns the value of Detail.
+func (s *ReloadResponse) GetDetail() OptString {
+	return s.Detail
 }
 
-// GetMessageId returns the value of MessageId.
-func (s *RedfishJobJobsItemMessagesItem) GetMessageId() OptString {
-	return s.MessageId
+// GetRestartRequired returns the value of RestartRequired.
+func (s *ReloadResponse) GetRestartRequired() []string {
+	return s.RestartRequired
 }
 
-// GetMessageSeverity returns the value of MessageSeverity.
-func (s *RedfishJobJobsItemMessagesItem) GetMessageSeverity() OptString {
-	return s.MessageSeverity
+// GetTitle returns the value of Title.
+func (s *ReloadResponse) GetTitle() OptString {
+	return s.Title
 }
 
-// GetOem returns the value of Oem.
-func (s *RedfishJobJobsItemMessagesItem) GetOem() jx.Raw {
-	return s.Oem
+// SetChanged sets the value of Changed.
+func (s *ReloadResponse) SetChanged(val []string) {
+	s.Changed = val
 }
 
-// GetRelatedProperties returns the value of RelatedProperties.
-func (s *RedfishJobJobsItemMessagesItem) GetRelatedProperties() OptNilNilStringArray {
-	return s.RelatedProperties
+// SetDetail sets the value of Detail.
+func (s *ReloadResponse) SetDetail(val OptString) {
+	s.Detail = val
 }
 
-// GetResolution returns the value of Resolution.
-func (s *RedfishJobJobsItemMessagesItem) GetResolution() OptString {
-	return s.Resolution
+// SetRestartRequired sets the value of RestartRequired.
+func (s *ReloadResponse) SetRestartRequired(val []string) {
+	s.RestartRequired = val
 }
 
-// GetResolutionSteps returns the value of ResolutionSteps.
-func (s *RedfishJobJobsItemMessagesItem) GetResolutionSteps() []RedfishJobJobsItemMessagesItemResolutionStepsItem {
-	return s.ResolutionSteps
+// SetTitle sets the value of Title.
+func (s *ReloadResponse) SetTitle(val OptString) {
+	s.Title = val
+}
+
+// RevokedCert schema.
+// Ref: #/components/schemas/RevokedCert
+type RevokedCert struct {
+	Host      OptNilString `json:"host"`
+	RevokedAt OptDateTime  `json:"revoked_at"`
+	Serial    OptString    `json:"serial"`
+}
+
+// GetHost returns the value of Host.
+func (s *RevokedCert) GetHost() OptNilString {
+	return s.Host
+}
+
+// GetRevokedAt returns the value of RevokedAt.
+func (s *RevokedCert) GetRevokedAt() OptDateTime {
+	return s.RevokedAt
+}
+
+// GetSerial returns the value of Serial.
+func (s *RevokedCert) GetSerial() OptString {
+	return s.Serial
+}
+
+// SetHost sets the value of Host.
+func (s *RevokedCert) SetHost(val OptNilString) {
+	s.Host = val
+}
+
+// SetRevokedAt sets the value of RevokedAt.
+func (s *RevokedCert) SetRevokedAt(val OptDateTime) {
+	s.RevokedAt = val
+}
+
+// SetSerial sets the value of Serial.
+func (s *RevokedCert) SetSerial(val OptString) {
+	s.Serial = val
+}
+
+// ScheduleRequest schema.
+// Ref: #/components/schemas/ScheduleRequest
+type ScheduleRequest struct {
+	// Image, provision, power-cycle or webhook.
+	Action string `json:"action"`
+	// Boot image name, provision true or false, redfish boot override of the power cycle or webhook
+	// message.
+	Arg OptString `json:"arg"`
+	// Nodes to run the action on, every node when empty with no tags.
+	Nodeset OptString `json:"nodeset"`
+	RunAt   time.Time `json:"run_at"`
+	// Comma separated tags of the nodes to run the action on.
+	Tags OptString `json:"tags"`
+}
+
+// GetAction returns the value of Action.
+func (s *ScheduleRequest) GetAction() string {
+	return s.Action
+}
+
+// GetArg returns the value of Arg.
+func (s *ScheduleRequest) GetArg() OptString {
+	return s.Arg
+}
+
+// GetNodeset returns the value of Nodeset.
+func (s *ScheduleRequest) GetNodeset() OptString {
+	return s.Nodeset
+}
+
+// GetRunAt returns the value of RunAt.
+func (s *ScheduleRequest) GetRunAt() time.Time {
+	return s.RunAt
+}
+
+// GetTags returns the value of Tags.
+func (s *ScheduleRequest) GetTags() OptString {
+	return s.Tags
+}
+
+// SetAction sets the value of Action.
+func (s *ScheduleRequest) SetAction(val string) {
+	s.Action = val
+}
+
+// SetArg sets the value of Arg.
+func (s *ScheduleRequest) SetArg(val OptString) {
+	s.Arg = val
 }
 
-// GetSeverity returns the value of Severity.
-func (s *RedfishJobJobsItemMessagesItem) GetSeverity() OptString {
-	return s.Severity
+// SetNodeset sets the value of Nodeset.
+func (s *ScheduleRequest) SetNodeset(val OptString) {
+	s.Nodeset = val
 }
 
-// GetUserAuthenticationSource returns the value of UserAuthenticationSource.
-func (s *RedfishJobJobsItemMessagesItem) GetUserAuthenticationSource() OptString {
-	return s.UserAuthenticationSource
+// SetRunAt sets the value of RunAt.
+func (s *ScheduleRequest) SetRunAt(val time.Time) {
+	s.RunAt = val
 }
 
-// GetUsername returns the value of Username.
-func (s *RedfishJobJobsItemMessagesItem) GetUsername() OptString {
-	return s.Username
+// SetTags sets the value of Tags.
+func (s *ScheduleRequest) SetTags(val OptString) {
+	s.Tags = val
 }
 
-// SetMessage sets the value of Message.
-func (s *RedfishJobJobsItemMessagesItem) SetMessage(val OptString) {
-	s.Message = val
+// ScheduledAction schema.
+// Ref: #/components/schemas/ScheduledAction
+type ScheduledAction struct {
+	Action     string                                   `json:"action"`
+	Arg        OptNilString                             `json:"arg"`
+	CreatedAt  OptNilDateTime                           `json:"created_at"`
+	CreatedBy  OptNilString                             `json:"created_by"`
+	Error      OptNilString                             `json:"error"`
+	FinishedAt OptNilDateTime                           `json:"finished_at"`
+	ID         OptInt64                                 `json:"id"`
+	Nodeset    string                                   `json:"nodeset"`
+	Results    OptNilNilScheduledActionResultsItemArray `json:"results"`
+	RunAt      time.Time                                `json:"run_at"`
+	StartedAt  OptNilDateTime                           `json:"started_at"`
+	Status     OptNilString                             `json:"status"`
 }
 
-// SetMessageArgs sets the value of MessageArgs.
-func (s *RedfishJobJobsItemMessagesItem) SetMessageArgs(val []string) {
-	s.MessageArgs = val
+// GetAction returns the value of Action.
+func (s *ScheduledAction) GetAction() string {
+	return s.Action
 }
 
-// SetMessageId sets the value of MessageId.
-func (s *RedfishJobJobsItemMessagesItem) SetMessageId(val OptString) {
-	s.MessageId = val
+// GetArg returns the value of Arg.
+func (s *ScheduledAction) GetArg() OptNilString {
+	return s.Arg
 }
 
-// SetMessageSeverity sets the value of MessageSeverity.
-func (s *RedfishJobJobsItemMessagesItem) SetMessageSeverity(val OptString) {
-	s.MessageSeverity = val
+// GetCreatedAt returns the value of CreatedAt.
+func (s *ScheduledAction) GetCreatedAt() OptNilDateTime {
+	return s.CreatedAt
 }
 
-// SetOem sets the value of Oem.
-func (s *RedfishJobJobsItemMessagesItem) SetOem(val jx.Raw) {
-	s.Oem = val
+// GetCreatedBy returns the value of CreatedBy.
+func (s *ScheduledAction) GetCreatedBy() OptNilString {
+	return s.CreatedBy
 }
 
-// SetRelatedProperties sets the value of RelatedProperties.
-func (s *RedfishJobJobsItemMessagesItem) SetRelatedProperties(val OptNilNilStringArray) {
-	s.RelatedProperties = val
+// GetError returns the value of Error.
+func (s *ScheduledAction) GetError() OptNilString {
+	return s.Error
 }
 
-// SetResolution sets the value of Resolution.
-func (s *RedfishJobJobsItemMessagesItem) SetResolution(val OptString) {
-	s.Resolution = val
+// GetFinishedAt returns the value of FinishedAt.
+func (s *ScheduledAction) GetFinishedAt() OptNilDateTime {
+	return s.FinishedAt
 }
 
-// SetResolutionSteps sets the value of ResolutionSteps.
-func (s *RedfishJobJobsItemMessagesItem) SetResolutionSteps(val []RedfishJobJobsItemMessagesItemResolutionStepsItem) {
-	s.ResolutionSteps = val
+// GetID returns the value of ID.
+func (s *ScheduledAction) GetID() OptInt64 {
+	return s.ID
 }
 
-// SetSeverity sets the value of Severity.
-func (s *RedfishJobJobsItemMessagesItem) SetSeverity(val OptString) {
-	s.Severity = val
+// GetNodeset returns the value of Nodeset.
+func (s *ScheduledAction) GetNodeset() string {
+	return s.Nodeset
 }
 
-// SetUserAuthenticationSource sets the value of UserAuthenticationSource.
-func (s *RedfishJobJobsItemMessagesItem) SetUserAuthenticationSource(val OptString) {
-	s.UserAuthenticationSource = val
+// GetResults returns the value of Results.
+func (s *ScheduledAction) GetResults() OptNilNilScheduledActionResultsItemArray {
+	return s.Results
 }
 
-// SetUsername sets the value of Username.
-func (s *RedfishJobJobsItemMessagesItem) SetUsername(val OptString) {
-	s.Username = val
+// GetRunAt returns the value of RunAt.
+func (s *ScheduledAction) GetRunAt() time.Time {
+	return s.RunAt
 }
 
-type RedfishJobJobsItemMessagesItemResolutionStepsItem struct {
-	ActionParameters     []RedfishJobJobsItemMessagesItemResolutionStepsItemActionParametersItem `json:"ActionParameters"`
-	ActionURI            OptString                                                               `json:"ActionURI"`
-	Oem                  jx.Raw                                                                  `json:"Oem"`
-	Priority             OptNilInt                                                               `json:"Priority"`
-	ResolutionType       OptString                                                               `json:"ResolutionType"`
-	RetryCount           OptNilInt                                                               `json:"RetryCount"`
-	RetryIntervalSeconds OptNilInt                                                               `json:"RetryIntervalSeconds"`
-	TargetComponentURI   OptString                                                               `json:"TargetComponentURI"`
+// GetStartedAt returns the value of StartedAt.
+func (s *ScheduledAction) GetStartedAt() OptNilDateTime {
+	return s.StartedAt
 }
 
-// GetActionParameters returns the value of ActionParameters.
-func (s *RedfishJobJobsItemMessagesItemResolutionStepsItem) GetActionParameters() []RedfishJobJobsItemMessagesItemResolutionStepsItemActionParametersItem {
-	return s.ActionParameters
+// GetStatus returns the value of Status.
+func (s *ScheduledAction) GetStatus() OptNilString {
+	return s.Status
 }
 
-// GetActionURI returns the value of ActionURI.
-func (s *RedfishJobJobsItemMessagesItemResolutionStepsItem) GetActionURI() OptString {
-	return s.ActionURI
+// SetAction sets the value of Action.
+func (s *ScheduledAction) SetAction(val string) {
+	s.Action = val
 }
 
-// GetOem returns the value of Oem.
-func (s *RedfishJobJobsItemMessagesItemResolutionStepsItem) GetOem() jx.Raw {
-	return s.Oem
+// SetArg sets the value of Arg.
+func (s *ScheduledAction) SetArg(val OptNilString) {
+	s.Arg = val
 }
 
-// GetPriority returns the value of Priority.
-func (s *RedfishJobJobsItemMessagesItemResolutionStepsItem) GetPriority() OptNilInt {
-	return s.Priority
+// SetCreatedAt sets the value of CreatedAt.
+func (s *ScheduledAction) SetCreatedAt(val OptNilDateTime) {
+	s.CreatedAt = val
 }
 
-// GetResolutionType returns the value of ResolutionType.
-func (s *RedfishJobJobsItemMessagesItemResolutionStepsItem) GetResolutionType() OptString {
-	return s.ResolutionType
+// SetCreatedBy sets the value of CreatedBy.
+func (s *ScheduledAction) SetCreatedBy(val OptNilString) {
+	s.CreatedBy = val
 }
 
-// GetRetryCount returns the value of RetryCount.
-func (s *RedfishJobJobsItemMessagesItemResolutionStepsItem) GetRetryCount() OptNilInt {
-	return s.RetryCount
+// SetError sets the value of Error.
+func (s *ScheduledAction) SetError(val OptNilString) {
+	s.Error = val
 }
 
-// GetRetryIntervalSeconds returns the value of RetryIntervalSeconds.
-func (s *RedfishJobJobsItemMessagesItemResolutionStepsItem) GetRetryIntervalSeconds() OptNilInt {
-	return s.RetryIntervalSeconds
+// SetFinishedAt sets the value of FinishedAt.
+func (s *ScheduledAction) SetFinishedAt(val OptNilDateTime) {
+	s.FinishedAt = val
 }
 
-// GetTargetComponentURI returns the value of TargetComponentURI.
-func (s *RedfishJobJobsItemMessagesItemResolutionStepsItem) GetTargetComponentURI() OptString {
-	return s.TargetComponentURI
+// SetID sets the value of ID.
+func (s *ScheduledAction) SetID(val OptInt64) {
+	s.ID = val
 }
 
-// SetActionParameters sets the value of ActionParameters.
-func (s *RedfishJobJobsItemMessagesItemResolutionStepsItem) SetActionParameters(val []RedfishJobJobsItemMessagesItemResolutionStepsItemActionParametersItem) {
-	s.ActionParameters = val
+// SetNodeset sets the value of Nodeset.
+func (s *ScheduledAction) SetNodeset(val string) {
+	s.Nodeset = val
 }
 
-// SetActionURI sets the value of ActionURI.
-func (s *RedfishJobJobsItemMessagesItemResolutionStepsItem) SetActionURI(val OptString) {
-	s.ActionURI = val
+// SetResults sets the value of Results.
+func (s *ScheduledAction) SetResults(val OptNilNilScheduledActionResultsItemArray) {
+	s.Results = val
 }
 
-// SetOem sets the value of Oem.
-func (s *RedfishJobJobsItemMessagesItemResolutionStepsItem) SetOem(val jx.Raw) {
-	s.Oem = val
+// SetRunAt sets the value of RunAt.
+func (s *ScheduledAction) SetRunAt(val time.Time) {
+	s.RunAt = val
 }
 
-// SetPriority sets the value of Priority.
-func (s *RedfishJobJobsItemMessagesItemResolutionStepsItem) SetPriority(val OptNilInt) {
-	s.Priority = val
+// SetStartedAt sets the value of StartedAt.
+func (s *ScheduledAction) SetStartedAt(val OptNilDateTime) {
+	s.StartedAt = val
 }
 
-// SetResolutionType sets the value of ResolutionType.
-func (s *RedfishJobJobsItemMessagesItemResolutionStepsItem) SetResolutionType(val OptString) {
-	s.ResolutionType = val
+// SetStatus sets the value of Status.
+func (s *ScheduledAction) SetStatus(val OptNilString) {
+	s.Status = val
 }
 
-// SetRetryCount sets the value of RetryCount.
-func (s *RedfishJobJobsItemMessagesItemResolutionStepsItem) SetRetryCount(val OptNilInt) {
-	s.RetryCount = val
+type ScheduledActionResultsItem struct {
+	Data         OptString                                 `json:"data"`
+	Host         OptString                                 `json:"host"`
+	Msg          OptString                                 `json:"msg"`
+	RedfishError OptScheduledActionResultsItemRedfishError `json:"redfish_error"`
+	Status       OptString                                 `json:"status"`
 }
 
-// SetRetryIntervalSeconds sets the value of RetryIntervalSeconds.
-func (s *RedfishJobJobsItemMessagesItemResolutionStepsItem) SetRetryIntervalSeconds(val OptNilInt) {
-	s.RetryIntervalSeconds = val
+// GetData returns the value of Data.
+func (s *ScheduledActionResultsItem) GetData() OptString {
+	return s.Data
 }
 
-// SetTargetComponentURI sets the value of TargetComponentURI.
-func (s *RedfishJobJobsItemMessagesItemResolutionStepsItem) SetTargetComponentURI(val OptString) {
-	s.TargetComponentURI = val
+// GetHost returns the value of Host.
+func (s *ScheduledActionResultsItem) GetHost() OptString {
+	return s.Host
 }
 
-type RedfishJobJobsItemMessagesItemResolutionStepsItemActionParametersItem struct {
-	AllowableNumbers           []string      `json:"AllowableNumbers"`
-	AllowablePattern           OptString     `json:"AllowablePattern"`
-	AllowableValueDescriptions []string      `json:"AllowableValueDescriptions"`
-	AllowableValues            []string      `json:"AllowableValues"`
-	ArraySizeMaximum           OptNilInt     `json:"ArraySizeMaximum"`
-	ArraySizeMinimum           OptNilInt     `json:"ArraySizeMinimum"`
-	DataType                   OptString     `json:"DataType"`
-	DefaultValue               OptString     `json:"DefaultValue"`
-	MaximumValue               OptNilFloat64 `json:"MaximumValue"`
-	MinimumValue               OptNilFloat64 `json:"MinimumValue"`
-	Name                       OptString     `json:"Name"`
-	NoDefaultValue             OptBool       `json:"NoDefaultValue"`
-	ObjectDataType             OptString     `json:"ObjectDataType"`
-	Required                   OptBool       `json:"Required"`
+// GetMsg returns the value of Msg.
+func (s *ScheduledActionResultsItem) GetMsg() OptString {
+	return s.Msg
 }
 
-// GetAllowableNumbers returns the value of AllowableNumbers.
-func (s *RedfishJobJobsItemMessagesItemResolutionStepsItemActionParametersItem) GetAllowableNumbers() []string {
-	return s.AllowableNumbers
+// GetRedfishError returns the value of RedfishError.
+func (s *ScheduledActionResultsItem) GetRedfishError() OptScheduledActionResultsItemRedfishError {
+	return s.RedfishError
 }
 
-// GetAllowablePattern returns the value of AllowablePattern.
-func (s *RedfishJobJobsItemMessagesItemResolutionStepsItemActionParametersItem) GetAllowablePattern() OptString {
-	return s.AllowablePattern
+// GetStatus returns the value of Status.
+func (s *ScheduledActionResultsItem) GetStatus() OptString {
+	return s.Status
 }
 
-// GetAllowableValueDescriptions returns the value of AllowableValueDescriptions.
-func (s *RedfishJobJobsItemMessagesItemResolutionStepsItemActionParametersItem) GetAllowableValueDescriptions() []string {
-	return s.AllowableValueDescriptions
+// SetData sets the value of Data.
+func (s *ScheduledActionResultsItem) SetData(val OptString) {
+	s.Data = val
 }
 
-// GetAllowableValues returns the value of AllowableValues.
-func (s *RedfishJobJobsItemMessagesItemResolutionStepsItemActionParametersItem) GetAllowableValues() []string {
-	return s.AllowableValues
+// SetHost sets the value of Host.
+func (s *ScheduledActionResultsItem) SetHost(val OptString) {
+	s.Host = val
 }
 
-// GetArraySizeMaximum returns the value of ArraySizeMaximum.
-func (s *RedfishJobJobsItemMessagesItemResolutionStepsItemActionParametersItem) GetArraySizeMaximum() OptNilInt {
-	return s.ArraySizeMaximum
+// SetMsg sets the value of Msg.
+func (s *ScheduledActionResultsItem) SetMsg(val OptString) {
+	s.Msg = val
 }
 
-// GetArraySizeMinimum returns the value of ArraySizeMinimum.
-func (s *RedfishJobJobsItemMessagesItemResolutionStepsItemActionParametersItem) GetArraySizeMinimum() OptNilInt {
-	return s.ArraySizeMinimum
+// SetRedfishError sets the value of RedfishError.
+func (s *ScheduledActionResultsItem) SetRedfishError(val OptScheduledActionResultsItemRedfishError) {
+	s.RedfishError = val
 }
 
-// GetDataType returns the value of DataType.
-func (s *RedfishJobJobsItemMessagesItemResolutionStepsItemActionParametersItem) GetDataType() OptString {
-	return s.DataType
+// SetStatus sets the value of Status.
+func (s *ScheduledActionResultsItem) SetStatus(val OptString) {
+	s.Status = val
 }
 
-// GetDefaultValue returns the value of DefaultValue.
-func (s *RedfishJobJobsItemMessagesItemResolutionStepsItemActionParametersItem) GetDefaultValue() OptString {
-	return s.DefaultValue
+type ScheduledActionResultsItemRedfishError struct {
+	Code  OptString                                      `json:"code"`
+	Error OptScheduledActionResultsItemRedfishErrorError `json:"error"`
 }
 
-// GetMaximumValue returns the value of MaximumValue.
-func (s *RedfishJobJobsItemMessagesItemResolutionStepsItemActionParametersItem) GetMaximumValue() OptNilFloat64 {
-	return s.MaximumValue
+// GetCode returns the value of Code.
+func (s *ScheduledActionResultsItemRedfishError) GetCode() OptString {
+	return s.Code
 }
 
-// GetMinimumValue returns the value of MinimumValue.
-func (s *RedfishJobJobsItemMessagesItemResolutionStepsItemActionParametersItem) GetMinimumValue() OptNilFloat64 {
-	return s.MinimumValue
+// GetError returns the value of Error.
+func (s *ScheduledActionResultsItemRedfishError) GetError() OptScheduledActionResultsItemRedfishErrorError {
+	return s.Error
 }
 
-// GetName returns the value of Name.
-func (s *RedfishJobJobsItemMessagesItemResolutionStepsItemActionParametersItem) GetName() OptString {
-	return s.Name
+// SetCode sets the value of Code.
+func (s *ScheduledActionResultsItemRedfishError) SetCode(val OptString) {
+	s.Code = val
 }
 
-// GetNoDefaultValue returns the value of NoDefaultValue.
-func (s *RedfishJobJobsItemMessagesItemResolutionStepsItemActionParametersItem) GetNoDefaultValue() OptBool {
-	return s.NoDefaultValue
+// SetError sets the value of Error.
+func (s *ScheduledActionResultsItemRedfishError) SetError(val OptScheduledActionResultsItemRedfishErrorError) {
+	s.Error = val
 }
 
-// GetObjectDataType returns the value of ObjectDataType.
-func (s *RedfishJobJobsItemMessagesItemResolutionStepsItemActionParametersItem) GetObjectDataType() OptString {
-	return s.ObjectDataType
+type ScheduledActionResultsItemRedfishErrorError struct {
+	MessageDotExtendedInfo []ScheduledActionResultsItemRedfishErrorErrorMessageDotExtendedInfoItem `json:"@Message.ExtendedInfo"`
+	Code                   OptString                                                               `json:"code"`
+	Message                OptString                                                               `json:"message"`
 }
 
-// GetRequired returns the value of Required.
-func (s *RedfishJobJobsItemMessagesItemResolutionStepsItemActionParametersItem) GetRequired() OptBool {
-	return s.Required
+// GetMessageDotExtendedInfo returns the value of MessageDotExtendedInfo.
+func (s *ScheduledActionResultsItemRedfishErrorError) GetMessageDotExtendedInfo() []ScheduledActionResultsItemRedfishErrorErrorMessageDotExtendedInfoItem {
+	return s.MessageDotExtendedInfo
 }
 
-// SetAllowableNumbers sets the value of AllowableNumbers.
-func (s *RedfishJobJobsItemMessagesItemResolutionStepsItemActionParametersItem) SetAllowableNumbers(val []string) {
-	s.AllowableNumbers = val
+// GetCode returns the value of Code.
+func (s *ScheduledActionResultsItemRedfishErrorError) GetCode() OptString {
+	return s.Code
 }
 
-// SetAllowablePattern sets the value of AllowablePattern.
-func (s *RedfishJobJobsItemMessagesItemResolutionStepsItemActionParametersItem) SetAllowablePattern(val OptString) {
-	s.AllowablePattern = val
+// GetMessage returns the value of Message.
+func (s *ScheduledActionResultsItemRedfishErrorError) GetMessage() OptString {
+	return s.Message
 }
 
-// SetAllowableValueDescriptions sets the value of AllowableValueDescriptions.
-func (s *RedfishJobJobsItemMessagesItemResolutionStepsItemActionParametersItem) SetAllowableValueDescriptions(val []string) {
-	s.AllowableValueDescriptions = val
+// SetMessageDotExtendedInfo sets the value of MessageDotExtendedInfo.
+func (s *ScheduledActionResultsItemRedfishErrorError) SetMessageDotExtendedInfo(val []ScheduledActionResultsItemRedfishErrorErrorMessageDotExtendedInfoItem) {
+	s.MessageDotExtendedInfo = val
 }
 
-// SetAllowableValues sets the value of AllowableValues.
-func (s *RedfishJobJobsItemMessagesItemResolutionStepsItemActionParametersItem) SetAllowableValues(val []string) {
-	s.AllowableValues = val
+// SetCode sets the value of Code.
+func (s *ScheduledActionResultsItemRedfishErrorError) SetCode(val OptString) {
+	s.Code = val
 }
 
-// SetArraySizeMaximum sets the value of ArraySizeMaximum.
-func (s *RedfishJobJobsItemMessagesItemResolutionStepsItemActionParametersItem) SetArraySizeMaximum(val OptNilInt) {
-	s.ArraySizeMaximum = val
+// SetMessage sets the value of Message.
+func (s *ScheduledActionResultsItemRedfishErrorError) SetMessage(val OptString) {
+	s.Message = val
 }
 
-// SetArraySizeMinimum sets the value of ArraySizeMinimum.
-func (s *RedfishJobJobsItemMessagesItemResolutionStepsItemActionParametersItem) SetArraySizeMinimum(val OptNilInt) {
-	s.ArraySizeMinimum = val
+type ScheduledActionResultsItemRedfishErrorErrorMessageDotExtendedInfoItem struct {
+	Message                           OptString `json:"Message"`
+	MessageArgsDotOdataDotCount       OptInt    `json:"MessageArgs.@odata.count"`
+	MessageId                         OptString `json:"MessageId"`
+	RelatedPropertiesDotOdataDotCount OptInt    `json:"RelatedProperties.@odata.count"`
+	Resolution                        OptString `json:"Resolution"`
+	Severity                          OptString `json:"Severity"`
 }
 
-// SetDataType sets the value of DataType.
-func (s *RedfishJobJobsItemMessagesItemResolutionStepsItemActionParametersItem) SetDataType(val OptString) {
-	s.DataType = val
+// GetMessage returns the value of Message.
+func (s *ScheduledActionResultsItemRedfishErrorErrorMessageDotExtendedInfoItem) GetMessage() OptString {
+	return s.Message
 }
 
-// SetDefaultValue sets the value of DefaultValue.
-func (s *RedfishJobJobsItemMessagesItemResolutionStepsItemActionParametersItem) SetDefaultValue(val OptString) {
-	s.DefaultValue = val
+// GetMessageArgsDotOdataDotCount returns the value of MessageArgsDotOdataDotCount.
+func (s *ScheduledActionResultsItemRedfishErrorErrorMessageDotExtendedInfoItem) GetMessageArgsDotOdataDotCount() OptInt {
+	return s.MessageArgsDotOdataDotCount
+}
+
+// GetMessageId returns the value of MessageId.
+func (s *ScheduledActionResultsItemRedfishErrorErrorMessageDotExtendedInfoItem) GetMessageId() OptString {
+	return s.MessageId
 }
 
-// SetMaximumValue sets the value of MaximumValue.
-func (s *RedfishJobJobsItemMessagesItemResolutionStepsItemActionParametersItem) SetMaximumValue(val OptNilFloat64) {
-	s.MaximumValue = val
+// GetRelatedPropertiesDotOdataDotCount returns the value of RelatedPropertiesDotOdataDotCount.
+func (s *ScheduledActionResultsItemRedfishErrorErrorMessageDotExtendedInfoItem) GetRelatedPropertiesDotOdataDotCount() OptInt {
+	return s.RelatedPropertiesDotOdataDotCount
 }
 
-// SetMinimumValue sets the value of MinimumValue.
-func (s *RedfishJobJobsItemMessagesItemResolutionStepsItemActionParametersItem) SetMinimumValue(val OptNilFloat64) {
-	s.MinimumValue = val
+// GetResolution returns the value of Resolution.
+func (s *ScheduledActionResultsItemRedfishErrorErrorMessageDotExtendedInfoItem) GetResolution() OptString {
+	return s.Resolution
 }
 
-// SetName sets the value of Name.
-func (s *RedfishJobJobsItemMessagesItemResolutionStepsItemActionParametersItem) SetName(val OptString) {
-	s.Name = val
+// GetSeverity returns the value of Severity.
+func (s *ScheduledActionResultsItemRedfishErrorErrorMessageDotExtendedInfoItem) GetSeverity() OptString {
+	return s.Severity
 }
 
-// SetNoDefaultValue sets the value of NoDefaultValue.
-func (s *RedfishJobJobsItemMessagesItemResolutionStepsItemActionParametersItem) SetNoDefaultValue(val OptBool) {
-	s.NoDefaultValue = val
+// SetMessage sets the value of Message.
+func (s *ScheduledActionResultsItemRedfishErrorErrorMessageDotExtendedInfoItem) SetMessage(val OptString) {
+	s.Message = val
 }
 
-// SetObjectDataType sets the value of ObjectDataType.
-func (s *RedfishJobJobsItemMessagesItemResolutionStepsItemActionParametersItem) SetObjectDataType(val OptString) {
-	s.ObjectDataType = val
+// SetMessageArgsDotOdataDotCount sets the value of MessageArgsDotOdataDotCount.
+func (s *ScheduledActionResultsItemRedfishErrorErrorMessageDotExtendedInfoItem) SetMessageArgsDotOdataDotCount(val OptInt) {
+	s.MessageArgsDotOdataDotCount = val
 }
 
-// SetRequired sets the value of Required.
-func (s *RedfishJobJobsItemMessagesItemResolutionStepsItemActionParametersItem) SetRequired(val OptBool) {
-	s.Required = val
+// SetMessageId sets the value of MessageId.
+func (s *ScheduledActionResultsItemRedfishErrorErrorMessageDotExtendedInfoItem) SetMessageId(val OptString) {
+	s.MessageId = val
 }
 
-type RedfishJobJobsItemParameters map[string]jx.Raw
-
-func (s *RedfishJobJobsItemParameters) init() RedfishJobJobsItemParameters {
-	m := *s
-	if m == nil {
-		m = map[string]jx.Raw{}
-		*s = m
-	}
-	return m
+// SetRelatedPropertiesDotOdataDotCount sets the value of RelatedPropertiesDotOdataDotCount.
+func (s *ScheduledActionResultsItemRedfishErrorErrorMessageDotExtendedInfoItem) SetRelatedPropertiesDotOdataDotCount(val OptInt) {
+	s.RelatedPropertiesDotOdataDotCount = val
 }
 
-type RedfishJobJobsItemPayload struct {
-	HttpHeaders   OptNilNilStringArray `json:"HttpHeaders"`
-	HttpOperation OptString            `json:"HttpOperation"`
-	JsonBody      OptString            `json:"JsonBody"`
-	TargetUri     OptString            `json:"TargetUri"`
+// SetResolution sets the value of Resolution.
+func (s *ScheduledActionResultsItemRedfishErrorErrorMessageDotExtendedInfoItem) SetResolution(val OptString) {
+	s.Resolution = val
 }
 
-// GetHttpHeaders returns the value of HttpHeaders.
-func (s *RedfishJobJobsItemPayload) GetHttpHeaders() OptNilNilStringArray {
-	return s.HttpHeaders
+// SetSeverity sets the value of Severity.
+func (s *ScheduledActionResultsItemRedfishErrorErrorMessageDotExtendedInfoItem) SetSeverity(val OptString) {
+	s.Severity = val
 }
 
-// GetHttpOperation returns the value of HttpOperation.
-func (s *RedfishJobJobsItemPayload) GetHttpOperation() OptString {
-	return s.HttpOperation
+// Secret schema.
+// Ref: #/components/schemas/Secret
+type Secret struct {
+	Name      string         `json:"name"`
+	OneTime   OptBool        `json:"one_time"`
+	UpdatedAt OptNilDateTime `json:"updated_at"`
 }
 
-// GetJsonBody returns the value of JsonBody.
-func (s *RedfishJobJobsItemPayload) GetJsonBody() OptString {
-	return s.JsonBody
+// GetName returns the value of Name.
+func (s *Secret) GetName() string {
+	return s.Name
 }
 
-// GetTargetUri returns the value of TargetUri.
-func (s *RedfishJobJobsItemPayload) GetTargetUri() OptString {
-	return s.TargetUri
+// GetOneTime returns the value of OneTime.
+func (s *Secret) GetOneTime() OptBool {
+	return s.OneTime
 }
 
-// SetHttpHeaders sets the value of HttpHeaders.
-func (s *RedfishJobJobsItemPayload) SetHttpHeaders(val OptNilNilStringArray) {
-	s.HttpHeaders = val
+// GetUpdatedAt returns the value of UpdatedAt.
+func (s *Secret) GetUpdatedAt() OptNilDateTime {
+	return s.UpdatedAt
 }
 
-// SetHttpOperation sets the value of HttpOperation.
-func (s *RedfishJobJobsItemPayload) SetHttpOperation(val OptString) {
-	s.HttpOperation = val
+// SetName sets the value of Name.
+func (s *Secret) SetName(val string) {
+	s.Name = val
 }
 
-// SetJsonBody sets the value of JsonBody.
-func (s *RedfishJobJobsItemPayload) SetJsonBody(val OptString) {
-	s.JsonBody = val
+// SetOneTime sets the value of OneTime.
+func (s *Secret) SetOneTime(val OptBool) {
+	s.OneTime = val
 }
 
-// SetTargetUri sets the value of TargetUri.
-func (s *RedfishJobJobsItemPayload) SetTargetUri(val OptString) {
-	s.TargetUri = val
+// SetUpdatedAt sets the value of UpdatedAt.
+func (s *Secret) SetUpdatedAt(val OptNilDateTime) {
+	s.UpdatedAt = val
 }
 
-type RedfishJobJobsItemSchedule struct {
-	EnabledDaysOfMonth  OptNilNilIntArray    `json:"EnabledDaysOfMonth"`
-	EnabledDaysOfWeek   OptNilNilStringArray `json:"EnabledDaysOfWeek"`
-	EnabledIntervals    OptNilNilStringArray `json:"EnabledIntervals"`
-	EnabledMonthsOfYear OptNilNilStringArray `json:"EnabledMonthsOfYear"`
-	InitialStartTime    OptString            `json:"InitialStartTime"`
-	Lifetime            OptString            `json:"Lifetime"`
-	MaxOccurrences      OptNilInt            `json:"MaxOccurrences"`
-	Name                OptString            `json:"Name"`
-	RecurrenceInterval  OptString            `json:"RecurrenceInterval"`
+// SecretRequest schema.
+// Ref: #/components/schemas/SecretRequest
+type SecretRequest struct {
+	// Render the secret once per boot token, later requests with the token get a placeholder.
+	OneTime OptBool `json:"one_time"`
+	Value   string  `json:"value"`
 }
 
-// GetEnabledDaysOfMonth returns the value of EnabledDaysOfMonth.
-func (s *RedfishJobJobsItemSchedule) GetEnabledDaysOfMonth() OptNilNilIntArray {
-	return s.EnabledDaysOfMonth
+// GetOneTime returns the value of OneTime.
+func (s *SecretRequest) GetOneTime() OptBool {
+	return s.OneTime
 }
 
-// GetEnabledDaysOfWeek returns the value of EnabledDaysOfWeek.
-func (s *RedfishJobJobsItemSchedule) GetEnabledDaysOfWeek() OptNilNilStringArray {
-	return s.EnabledDaysOfWeek
+// GetValue returns the value of Value.
+func (s *SecretRequest) GetValue() string {
+	return s.Value
 }
 
-// GetEnabledIntervals returns the value of EnabledIntervals.
-func (s *RedfishJobJobsItemSchedule) GetEnabledIntervals() OptNilNilStringArray {
-	return s.EnabledIntervals
+// SetOneTime sets the value of OneTime.
+func (s *SecretRequest) SetOneTime(val OptBool) {
+	s.OneTime = val
 }
 
-// GetEnabledMonthsOfYear returns the value of EnabledMonthsOfYear.
-func (s *RedfishJobJobsItemSchedule) GetEnabledMonthsOfYear() OptNilNilStringArray {
-	return s.EnabledMonthsOfYear
+// SetValue sets the value of Value.
+func (s *SecretRequest) SetValue(val string) {
+	s.Value = val
 }
 
-// GetInitialStartTime returns the value of InitialStartTime.
-func (s *RedfishJobJobsItemSchedule) GetInitialStartTime() OptString {
-	return s.InitialStartTime
+// SigningKey schema.
+// Ref: #/components/schemas/SigningKey
+type SigningKey struct {
+	CreatedAt OptDateTime `json:"created_at"`
+	ID        OptString   `json:"id"`
+	Kind      OptString   `json:"kind"`
+	Primary   OptBool     `json:"primary"`
+	RetireAt  OptDateTime `json:"retire_at"`
 }
 
-// GetLifetime returns the value of Lifetime.
-func (s *RedfishJobJobsItemSchedule) GetLifetime() OptString {
-	return s.Lifetime
+// GetCreatedAt returns the value of CreatedAt.
+func (s *SigningKey) GetCreatedAt() OptDateTime {
+	return s.CreatedAt
 }
 
-// GetMaxOccurrences returns the value of MaxOccurrences.
-func (s *RedfishJobJobsItemSchedule) GetMaxOccurrences() OptNilInt {
-	return s.MaxOccurrences
+// GetID returns the value of ID.
+func (s *SigningKey) GetID() OptString {
+	return s.ID
 }
 
-// GetName returns the value of Name.
-func (s *RedfishJobJobsItemSchedule) GetName() OptString {
-	return s.Name
+// GetKind returns the value of Kind.
+func (s *SigningKey) GetKind() OptString {
+	return s.Kind
 }
 
-// GetRecurrenceInterval returns the value of RecurrenceInterval.
-func (s *RedfishJobJobsItemSchedule) GetRecurrenceInterval() OptString {
-	return s.RecurrenceInterval
+// GetPrimary returns the value of Primary.
+func (s *SigningKey) GetPrimary() OptBool {
+	return s.Primary
 }
 
-// SetEnabledDaysOfMonth sets the value of EnabledDaysOfMonth.
-func (s *RedfishJobJobsItemSchedule) SetEnabledDaysOfMonth(val OptNilNilIntArray) {
-	s.EnabledDaysOfMonth = val
+// GetRetireAt returns the value of RetireAt.
+func (s *SigningKey) GetRetireAt() OptDateTime {
+	return s.RetireAt
 }
 
-// SetEnabledDaysOfWeek sets the value of EnabledDaysOfWeek.
-func (s *RedfishJobJobsItemSchedule) SetEnabledDaysOfWeek(val OptNilNilStringArray) {
-	s.EnabledDaysOfWeek = val
+// SetCreatedAt sets the value of CreatedAt.
+func (s *SigningKey) SetCreatedAt(val OptDateTime) {
+	s.CreatedAt = val
 }
 
-// SetEnabledIntervals sets the value of EnabledIntervals.
-func (s *RedfishJobJobsItemSchedule) SetEnabledIntervals(val OptNilNilStringArray) {
-	s.EnabledIntervals = val
+// SetID sets the value of ID.
+func (s *SigningKey) SetID(val OptString) {
+	s.ID = val
 }
 
-// SetEnabledMonthsOfYear sets the value of EnabledMonthsOfYear.
-func (s *RedfishJobJobsItemSchedule) SetEnabledMonthsOfYear(val OptNilNilStringArray) {
-	s.EnabledMonthsOfYear = val
+// SetKind sets the value of Kind.
+func (s *SigningKey) SetKind(val OptString) {
+	s.Kind = val
 }
 
-// SetInitialStartTime sets the value of InitialStartTime.
-func (s *RedfishJobJobsItemSchedule) SetInitialStartTime(val OptString) {
-	s.InitialStartTime = val
+// SetPrimary sets the value of Primary.
+func (s *SigningKey) SetPrimary(val OptBool) {
+	s.Primary = val
 }
 
-// SetLifetime sets the value of Lifetime.
-func (s *RedfishJobJobsItemSchedule) SetLifetime(val OptString) {
-	s.Lifetime = val
+// SetRetireAt sets the value of RetireAt.
+func (s *SigningKey) SetRetireAt(val OptDateTime) {
+	s.RetireAt = val
 }
 
-// SetMaxOccurrences sets the value of MaxOccurrences.
-func (s *RedfishJobJobsItemSchedule) SetMaxOccurrences(val OptNilInt) {
-	s.MaxOccurrences = val
+// SigningKeyRotateRequest schema.
+// Ref: #/components/schemas/SigningKeyRotateRequest
+type SigningKeyRotateRequest struct {
+	// How long the previous keys keep verifying tokens, defaults to provision.token_ttl for provision
+	// keys and 8h for api keys.
+	Grace OptString `json:"grace"`
+	// Kind of keys to rotate: provision for boot tokens, api for API tokens, both when empty.
+	Kind OptString `json:"kind"`
 }
 
-// SetName sets the value of Name.
-func (s *RedfishJobJobsItemSchedule) SetName(val OptString) {
-	s.Name = val
+// GetGrace returns the value of Grace.
+func (s *SigningKeyRotateRequest) GetGrace() OptString {
+	return s.Grace
 }
 
-// SetRecurrenceInterval sets the value of RecurrenceInterval.
-func (s *RedfishJobJobsItemSchedule) SetRecurrenceInterval(val OptString) {
-	s.RecurrenceInterval = val
+// GetKind returns the value of Kind.
+func (s *SigningKeyRotateRequest) GetKind() OptString {
+	return s.Kind
 }
 
-// RedfishMetricReport schema.
-// Ref: #/components/schemas/RedfishMetricReport
-type RedfishMetricReport struct {
-	Name    OptString                           `json:"name"`
-	Reports []NilRedfishMetricReportReportsItem `json:"reports"`
+// SetGrace sets the value of Grace.
+func (s *SigningKeyRotateRequest) SetGrace(val OptString) {
+	s.Grace = val
 }
 
-// GetName returns the value of Name.
-func (s *RedfishMetricReport) GetName() OptString {
-	return s.Name
+// SetKind sets the value of Kind.
+func (s *SigningKeyRotateRequest) SetKind(val OptString) {
+	s.Kind = val
 }
 
-// GetReports returns the value of Reports.
-func (s *RedfishMetricReport) GetReports() []NilRedfishMetricReportReportsItem {
-	return s.Reports
+// Stats schema.
+// Ref: #/components/schemas/Stats
+type Stats struct {
+	// DHCP ACKs sent in the last hour.
+	DhcpAcksLastHour OptInt `json:"dhcp_acks_last_hour"`
+	// DNS queries per second over the last 5 minutes.
+	DNSQueriesPerSecond OptFloat64 `json:"dns_queries_per_second"`
+	// Time the summary was computed, it is cached for a few seconds.
+	Generated OptDateTime   `json:"generated"`
+	Hosts     OptStatsHosts `json:"hosts"`
+	// Provision completions since midnight local time.
+	ProvisionCompletionsToday OptInt      `json:"provision_completions_today"`
+	Since                     OptDateTime `json:"since"`
 }
 
-// SetName sets the value of Name.
-func (s *RedfishMetricReport) SetName(val OptString) {
-	s.Name = val
+// GetDhcpAcksLastHour returns the value of DhcpAcksLastHour.
+func (s *Stats) GetDhcpAcksLastHour() OptInt {
+	return s.DhcpAcksLastHour
 }
 
-// SetReports sets the value of Reports.
-func (s *RedfishMetricReport) SetReports(val []NilRedfishMetricReportReportsItem) {
-	s.Reports = val
+// GetDNSQueriesPerSecond returns the value of DNSQueriesPerSecond.
+func (s *Stats) GetDNSQueriesPerSecond() OptFloat64 {
+	return s.DNSQueriesPerSecond
 }
 
-type RedfishMetricReportReportsItem struct {
-	MessageDotExtendedInfo []RedfishMetricReportReportsItemMessageDotExtendedInfoItem `json:"@Message.ExtendedInfo"`
-	OdataDotContext        OptString                                                  `json:"@odata.context"`
-	OdataDotEtag           OptString                                                  `json:"@odata.etag"`
-	OdataDotID             OptString                                                  `json:"@odata.id"`
-	OdataDotType           OptString                                                  `json:"@odata.type"`
-	Context                OptString                                                  `json:"Context"`
-	Description            OptString                                                  `json:"Description"`
-	ID                     OptString                                                  `json:"Id"`
-	MetricValues           []RedfishMetricReportReportsItemMetricValuesItem           `json:"MetricValues"`
-	Name                   OptString                                                  `json:"Name"`
-	Oem                    jx.Raw                                                     `json:"Oem"`
-	ReportSequence         OptString                                                  `json:"ReportSequence"`
-	Timestamp              OptString                                                  `json:"Timestamp"`
+// GetGenerated returns the value of Generated.
+func (s *Stats) GetGenerated() OptDateTime {
+	return s.Generated
 }
 
-// GetMessageDotExtendedInfo returns the value of MessageDotExtendedInfo.
-func (s *RedfishMetricReportReportsItem) GetMessageDotExtendedInfo() []RedfishMetricReportReportsItemMessageDotExtendedInfoItem {
-	return s.MessageDotExtendedInfo
+// GetHosts returns the value of Hosts.
+func (s *Stats) GetHosts() OptStatsHosts {
+	return s.Hosts
 }
 
-// GetOdataDotContext returns the value of OdataDotContext.
-func (s *RedfishMetricReportReportsItem) GetOdataDotContext() OptString {
-	return s.OdataDotContext
+// GetProvisionCompletionsToday returns the value of ProvisionCompletionsToday.
+func (s *Stats) GetProvisionCompletionsToday() OptInt {
+	return s.ProvisionCompletionsToday
 }
 
-// GetOdataDotEtag returns the value of OdataDotEtag.
-func (s *RedfishMetricReportReportsItem) GetOdataDotEtag() OptString {
-	return s.OdataDotEtag
+// GetSince returns the value of Since.
+func (s *Stats) GetSince() OptDateTime {
+	return s.Since
 }
 
-// GetOdataDotID returns the value of OdataDotID.
-func (s *RedfishMetricReportReportsItem) GetOdataDotID() OptString {
-	return s.OdataDotID
+// SetDhcpAcksLastHour sets the value of DhcpAcksLastHour.
+func (s *Stats) SetDhcpAcksLastHour(val OptInt) {
+	s.DhcpAcksLastHour = val
 }
 
-// GetOdataDotType returns the value of OdataDotType.
-func (s *RedfishMetricReportReportsItem) GetOdataDotType() OptString {
-	return s.OdataDotType
+// SetDNSQueriesPerSecond sets the value of DNSQueriesPerSecond.
+func (s *Stats) SetDNSQueriesPerSecond(val OptFloat64) {
+	s.DNSQueriesPerSecond = val
 }
 
-// GetContext returns the value of Context.
-func (s *RedfishMetricReportReportsItem) GetContext() OptString {
-	return s.Context
+// SetGenerated sets the value of Generated.
+func (s *Stats) SetGenerated(val OptDateTime) {
+	s.Generated = val
 }
 
-// GetDescription returns the value of Description.
-func (s *RedfishMetricReportReportsItem) GetDescription() OptString {
-	return s.Description
+// SetHosts sets the value of Hosts.
+func (s *Stats) SetHosts(val OptStatsHosts) {
+	s.Hosts = val
 }
 
-// GetID returns the value of ID.
-func (s *RedfishMetricReportReportsItem) GetID() OptString {
-	return s.ID
+// SetProvisionCompletionsToday sets the value of ProvisionCompletionsToday.
+func (s *Stats) SetProvisionCompletionsToday(val OptInt) {
+	s.ProvisionCompletionsToday = val
 }
 
-// GetMetricValues returns the value of MetricValues.
-func (s *RedfishMetricReportReportsItem) GetMetricValues() []RedfishMetricReportReportsItemMetricValuesItem {
-	return s.MetricValues
+// SetSince sets the value of Since.
+func (s *Stats) SetSince(val OptDateTime) {
+	s.Since = val
 }
 
-// GetName returns the value of Name.
-func (s *RedfishMetricReportReportsItem) GetName() OptString {
-	return s.Name
+type StatsHosts struct {
+	BootImages []StatsHostsBootImagesItem `json:"boot_images"`
+	// Hosts set to provision on their next boot.
+	Provisioning     OptInt               `json:"provisioning"`
+	Tags             []StatsHostsTagsItem `json:"tags"`
+	Total            OptInt               `json:"total"`
+	Unprovisioned    OptInt               `json:"unprovisioned"`
+	WithoutBootImage OptInt               `json:"without_boot_image"`
 }
 
-// GetOem returns the value of Oem.
-func (s *RedfishMetricReportReportsItem) GetOem() jx.Raw {
-	return s.Oem
+// GetBootImages returns the value of BootImages.
+func (s *StatsHosts) GetBootImages() []StatsHostsBootImagesItem {
+	return s.BootImages
 }
 
-// GetReportSequence returns the value of ReportSequence.
-func (s *RedfishMetricReportReportsItem) GetReportSequence() OptString {
-	return s.ReportSequence
+// GetProvisioning returns the value of Provisioning.
+func (s *StatsHosts) GetProvisioning() OptInt {
+	return s.Provisioning
 }
 
-// GetTimestamp returns the value of Timestamp.
-func (s *RedfishMetricReportReportsItem) GetTimestamp() OptString {
-	return s.Timestamp
+// GetTags returns the value of Tags.
+func (s *StatsHosts) GetTags() []StatsHostsTagsItem {
+	return s.Tags
 }
 
-// SetMessageDotExtendedInfo sets the value of MessageDotExtendedInfo.
-func (s *RedfishMetricReportReportsItem) SetMessageDotExtendedInfo(val []RedfishMetricReportReportsItemMessageDotExtendedInfoItem) {
-	s.MessageDotExtendedInfo = val
+// GetTotal returns the value of Total.
+func (s *StatsHosts) GetTotal() OptInt {
+	return s.Total
 }
 
-// SetOdataDotContext sets the value of OdataDotContext.
-func (s *RedfishMetricReportReportsItem) SetOdataDotContext(val OptString) {
-	s.OdataDotContext = val
+// GetUnprovisioned returns the value of Unprovisioned.
+func (s *StatsHosts) GetUnprovisioned() OptInt {
+	return s.Unprovisioned
 }
 
-// SetOdataDotEtag sets the value of OdataDotEtag.
-func (s *RedfishMetricReportReportsItem) SetOdataDotEtag(val OptString) {
-	s.OdataDotEtag = val
+// GetWithoutBootImage returns the value of WithoutBootImage.
+func (s *StatsHosts) GetWithoutBootImage() OptInt {
+	return s.WithoutBootImage
 }
 
-// SetOdataDotID sets the value of OdataDotID.
-func (s *RedfishMetricReportReportsItem) SetOdataDotID(val OptString) {
-	s.OdataDotID = val
+// SetBootImages sets the value of BootImages.
+func (s *StatsHosts) SetBootImages(val []StatsHostsBootImagesItem) {
+	s.BootImages = val
 }
 
-// SetOdataDotType sets the value of OdataDotType.
-func (s *RedfishMetricReportReportsItem) SetOdataDotType(val OptString) {
-	s.OdataDotType = val
+// SetProvisioning sets the value of Provisioning.
+func (s *StatsHosts) SetProvisioning(val OptInt) {
+	s.Provisioning = val
 }
 
-// SetContext sets the value of Context.
-func (s *RedfishMetricReportReportsItem) SetContext(val OptString) {
-	s.Context = val
+// SetTags sets the value of Tags.
+func (s *StatsHosts) SetTags(val []StatsHostsTagsItem) {
+	s.Tags = val
 }
 
-// SetDescription sets the value of Description.
-func (s *RedfishMetricReportReportsItem) SetDescription(val OptString) {
-	s.Description = val
+// SetTotal sets the value of Total.
+func (s *StatsHosts) SetTotal(val OptInt) {
+	s.Total = val
 }
 
-// SetID sets the value of ID.
-func (s *RedfishMetricReportReportsItem) SetID(val OptString) {
-	s.ID = val
+// SetUnprovisioned sets the value of Unprovisioned.
+func (s *StatsHosts) SetUnprovisioned(val OptInt) {
+	s.Unprovisioned = val
 }
 
-// SetMetricValues sets the value of MetricValues.
-func (s *RedfishMetricReportReportsItem) SetMetricValues(val []RedfishMetricReportReportsItemMetricValuesItem) {
-	s.MetricValues = val
+// SetWithoutBootImage sets the value of WithoutBootImage.
+func (s *StatsHosts) SetWithoutBootImage(val OptInt) {
+	s.WithoutBootImage = val
 }
 
-// SetName sets the value of Name.
-func (s *RedfishMetricReportReportsItem) SetName(val OptString) {
-	s.Name = val
+type StatsHostsBootImagesItem struct {
+	Hosts OptInt    `json:"hosts"`
+	Name  OptString `json:"name"`
 }
 
-// SetOem sets the value of Oem.
-func (s *RedfishMetricReportReportsItem) SetOem(val jx.Raw) {
-	s.Oem = val
+// GetHosts returns the value of Hosts.
+func (s *StatsHostsBootImagesItem) GetHosts() OptInt {
+	return s.Hosts
 }
 
-// SetReportSequence sets the value of ReportSequence.
-func (s *RedfishMetricReportReportsItem) SetReportSequence(val OptString) {
-	s.ReportSequence = val
+// GetName returns the value of Name.
+func (s *StatsHostsBootImagesItem) GetName() OptString {
+	return s.Name
 }
 
-// SetTimestamp sets the value of Timestamp.
-func (s *RedfishMetricReportReportsItem) SetTimestamp(val OptString) {
-	s.Timestamp = val
+// SetHosts sets the value of Hosts.
+func (s *StatsHostsBootImagesItem) SetHosts(val OptInt) {
+	s.Hosts = val
 }
 
-type RedfishMetricReportReportsItemMessageDotExtendedInfoItem struct {
-	Message                  OptString                                                                     `json:"Message"`
-	MessageArgs              []string                                                                      `json:"MessageArgs"`
-	MessageId                OptString                                                                     `json:"MessageId"`
-	MessageSeverity          OptString                                                                     `json:"MessageSeverity"`
-	Oem                      jx.Raw                                                                        `json:"Oem"`
-	RelatedProperties        OptNilNilStringArray                                                          `json:"RelatedProperties"`
-	Resolution               OptString                                                                     `json:"Resolution"`
-	ResolutionSteps          []RedfishMetricReportReportsItemMessageDotExtendedInfoItemResolutionStepsItem `json:"ResolutionSteps"`
-	Severity                 OptString                                                                     `json:"Severity"`
-	UserAuthenticationSource OptString                                                                     `json:"UserAuthenticationSource"`
-	Username                 OptString                                                                     `json:"Username"`
+// SetName sets the value of Name.
+func (s *StatsHostsBootImagesItem) SetName(val OptString) {
+	s.Name = val
 }
 
-// GetMessage returns the value of Message.
-func (s *RedfishMetricReportReportsItemMessageDotExtendedInfoItem) GetMessage() OptString {
-	return s.Message
+type StatsHostsTagsItem struct {
+	Hosts OptInt    `json:"hosts"`
+	Name  OptString `json:"name"`
 }
 
-// GetMessageArgs returns the value of MessageArgs.
-func (s *RedfishMetricReportReportsItemMessageDotExtendedInfoItem) GetMessageArgs() []string {
-	return s.MessageArgs
+// GetHosts returns the value of Hosts.
+func (s *StatsHostsTagsItem) GetHosts() OptInt {
+	return s.Hosts
 }
 
-// GetMessageId returns the value of MessageId.
-func (s *RedfishMetricReportReportsItemMessageDotExtendedInfoItem) GetMessageId() OptString {
-	return s.MessageId
+// GetName returns the value of Name.
+func (s *StatsHostsTagsItem) GetName() OptString {
+	return s.Name
 }
 
-// GetMessageSeverity returns the value of MessageSeverity.
-func (s *RedfishMetricReportReportsItemMessageDotExtendedInfoItem) GetMessageSeverity() OptString {
-	return s.MessageSeverity
+// SetHosts sets the value of Hosts.
+func (s *StatsHostsTagsItem) SetHosts(val OptInt) {
+	s.Hosts = val
 }
 
-// GetOem returns the value of Oem.
-func (s *RedfishMetricReportReportsItemMessageDotExtendedInfoItem) GetOem() jx.Raw {
-	return s.Oem
+// SetName sets the value of Name.
+func (s *StatsHostsTagsItem) SetName(val OptString) {
+	s.Name = val
 }
 
-// GetRelatedProperties returns the value of RelatedProperties.
-func (s *RedfishMetricReportReportsItemMessageDotExtendedInfoItem) GetRelatedProperties() OptNilNilStringArray {
-	return s.RelatedProperties
+// SwitchScanResponse schema.
+// Ref: #/components/schemas/SwitchScanResponse
+type SwitchScanResponse struct {
+	Changed   OptInt                            `json:"changed"`
+	Matched   []SwitchScanResponseMatchedItem   `json:"matched"`
+	Unmatched []SwitchScanResponseUnmatchedItem `json:"unmatched"`
 }
 
-// GetResolution returns the value of Resolution.
-func (s *RedfishMetricReportReportsItemMessageDotExtendedInfoItem) GetResolution() OptString {
-	return s.Resolution
+// GetChanged returns the value of Changed.
+func (s *SwitchScanResponse) GetChanged() OptInt {
+	return s.Changed
 }
 
-// GetResolutionSteps returns the value of ResolutionSteps.
-func (s *RedfishMetricReportReportsItemMessageDotExtendedInfoItem) GetResolutionSteps() []RedfishMetricReportReportsItemMessageDotExtendedInfoItemResolutionStepsItem {
-	return s.ResolutionSteps
+// GetMatched returns the value of Matched.
+func (s *SwitchScanResponse) GetMatched() []SwitchScanResponseMatchedItem {
+	return s.Matched
 }
 
-// GetSeverity returns the value of Severity.
-func (s *RedfishMetricReportReportsItemMessageDotExtendedInfoItem) GetSeverity() OptString {
-	return s.Severity
+// GetUnmatched returns the value of Unmatched.
+func (s *SwitchScanResponse) GetUnmatched() []SwitchScanResponseUnmatchedItem {
+	return s.Unmatched
 }
 
-// GetUserAuthenticationSource returns the value of UserAuthenticationSource.
-func (s *RedfishMetricReportReportsItemMessageDotExtendedInfoItem) GetUserAuthenticationSource() OptString {
-	return s.UserAuthenticationSource
+// SetChanged sets the value of Changed.
+func (s *SwitchScanResponse) SetChanged(val OptInt) {
+	s.Changed = val
 }
 
-// GetUsername returns the value of Username.
-func (s *RedfishMetricReportReportsItemMessageDotExtendedInfoItem) GetUsername() OptString {
-	return s.Username
+// SetMatched sets the value of Matched.
+func (s *SwitchScanResponse) SetMatched(val []SwitchScanResponseMatchedItem) {
+	s.Matched = val
 }
 
-// SetMessage sets the value of Message.
-func (s *RedfishMetricReportReportsItemMessageDotExtendedInfoItem) SetMessage(val OptString) {
-	s.Message = val
+// SetUnmatched sets the value of Unmatched.
+func (s *SwitchScanResponse) SetUnmatched(val []SwitchScanResponseUnmatchedItem) {
+	s.Unmatched = val
 }
 
-// SetMessageArgs sets the value of MessageArgs.
-func (s *RedfishMetricReportReportsItemMessageDotExtendedInfoItem) SetMessageArgs(val []string) {
-	s.MessageArgs = val
+type SwitchScanResponseMatchedItem struct {
+	Host      OptString `json:"host"`
+	Interface OptString `json:"interface"`
+	MAC       OptString `json:"mac"`
+	Port      OptInt    `json:"port"`
+	Switch    OptString `json:"switch"`
+	Vlan      OptString `json:"vlan"`
 }
 
-// SetMessageId sets the value of MessageId.
-func (s *RedfishMetricReportReportsItemMessageDotExtendedInfoItem) SetMessageId(val OptString) {
-	s.MessageId = val
+// GetHost returns the value of Host.
+func (s *SwitchScanResponseMatchedItem) GetHost() OptString {
+	return s.Host
 }
 
-// SetMessageSeverity sets the value of MessageSeverity.
-func (s *RedfishMetricReportReportsItemMessageDotExtendedInfoItem) SetMessageSeverity(val OptString) {
-	s.MessageSeverity = val
+// GetInterface returns the value of Interface.
+func (s *SwitchScanResponseMatchedItem) GetInterface() OptString {
+	return s.Interface
 }
 
-// SetOem sets the value of Oem.
-func (s *RedfishMetricReportReportsItemMessageDotExtendedInfoItem) SetOem(val jx.Raw) {
-	s.Oem = val
+// GetMAC returns the value of MAC.
+func (s *SwitchScanResponseMatchedItem) GetMAC() OptString {
+	return s.MAC
 }
 
-// SetRelatedProperties sets the value of RelatedProperties.
-func (s *RedfishMetricReportReportsItemMessageDotExtendedInfoItem) SetRelatedProperties(val OptNilNilStringArray) {
-	s.RelatedProperties = val
+// GetPort returns the value of Port.
+func (s *SwitchScanResponseMatchedItem) GetPort() OptInt {
+	return s.Port
 }
 
-// SetResolution sets the value of Resolution.
-func (s *RedfishMetricReportReportsItemMessageDotExtendedInfoItem) SetResolution(val OptString) {
-	s.Resolution = val
+// GetSwitch returns the value of Switch.
+func (s *SwitchScanResponseMatchedItem) GetSwitch() OptString {
+	return s.Switch
 }
 
-// SetResolutionSteps sets the value of ResolutionSteps.
-func (s *RedfishMetricReportReportsItemMessageDotExtendedInfoItem) SetResolutionSteps(val []RedfishMetricReportReportsItemMessageDotExtendedInfoItemResolutionStepsItem) {
-	s.ResolutionSteps = val
+// GetVlan returns the value of Vlan.
+func (s *SwitchScanResponseMatchedItem) GetVlan() OptString {
+	return s.Vlan
 }
 
-// SetSeverity sets the value of Severity.
-func (s *RedfishMetricReportReportsItemMessageDotExtendedInfoItem) SetSeverity(val OptString) {
-	s.Severity = val
+// SetHost sets the value of Host.
+func (s *SwitchScanResponseMatchedItem) SetHost(val OptString) {
+	s.Host = val
 }
 
-// SetUserAuthenticationSource sets the value of UserAuthenticationSource.
-func (s *RedfishMetricReportReportsItemMessageDotExtendedInfoItem) SetUserAuthenticationSource(val OptString) {
-	s.UserAuthenticationSource = val
+// SetInterface sets the value of Interface.
+func (s *SwitchScanResponseMatchedItem) SetInterface(val OptString) {
+	s.Interface = val
 }
 
-// SetUsername sets the value of Username.
-func (s *RedfishMetricReportReportsItemMessageDotExtendedInfoItem) SetUsername(val OptString) {
-	s.Username = val
+// SetMAC sets the value of MAC.
+func (s *SwitchScanResponseMatchedItem) SetMAC(val OptString) {
+	s.MAC = val
 }
 
-type RedfishMetricReportReportsItemMessageDotExtendedInfoItemResolutionStepsItem struct {
-	ActionParameters     []RedfishMetricReportReportsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem `json:"ActionParameters"`
-	ActionURI            OptString                                                                                         `json:"ActionURI"`
-	Oem                  jx.Raw                                                                                            `json:"Oem"`
-	Priority             OptNilInt                                                                                         `json:"Priority"`
-	ResolutionType       OptString                                                                                         `json:"ResolutionType"`
-	RetryCount           OptNilInt                                                                                         `json:"RetryCount"`
-	RetryIntervalSeconds OptNilInt                                                                                         `json:"RetryIntervalSeconds"`
-	TargetComponentURI   OptString                                                                                         `json:"TargetComponentURI"`
+// SetPort sets the value of Port.
+func (s *SwitchScanResponseMatchedItem) SetPort(val OptInt) {
+	s.Port = val
 }
 
-// GetActionParameters returns the value of ActionParameters.
-func (s *RedfishMetricReportReportsItemMessageDotExtendedInfoItemResolutionStepsItem) GetActionParameters() []RedfishMetricReportReportsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem {
-	return s.ActionParameters
+// SetSwitch sets the value of Switch.
+func (s *SwitchScanResponseMatchedItem) SetSwitch(val OptString) {
+	s.Switch = val
 }
 
-// GetActionURI returns the value of ActionURI.
-func (s *RedfishMetricReportReportsItemMessageDotExtendedInfoItemResolutionStepsItem) GetActionURI() OptString {
-	return s.ActionURI
+// SetVlan sets the value of Vlan.
+func (s *SwitchScanResponseMatchedItem) SetVlan(val OptString) {
+	s.Vlan = val
 }
 
-// GetOem returns the value of Oem.
-func (s *RedfishMetricReportReportsItemMessageDotExtendedInfoItemResolutionStepsItem) GetOem() jx.Raw {
-	return s.Oem
+type SwitchScanResponseUnmatchedItem struct {
+	Host      OptString `json:"host"`
+	Interface OptString `json:"interface"`
+	MAC       OptString `json:"mac"`
+	Port      OptInt    `json:"port"`
+	Switch    OptString `json:"switch"`
+	Vlan      OptString `json:"vlan"`
 }
 
-// GetPriority returns the value of Priority.
-func (s *RedfishMetricReportReportsItemMessageDotExtendedInfoItemResolutionStepsItem) GetPriority() OptNilInt {
-	return s.Priority
+// GetHost returns the value of Host.
+func (s *SwitchScanResponseUnmatchedItem) GetHost() OptString {
+	return s.Host
 }
 
-// GetResolutionType returns the value of ResolutionType.
-func (s *RedfishMetricReportReportsItemMessageDotExtendedInfoItemResolutionStepsItem) GetResolutionType() OptString {
-	return s.ResolutionType
+// GetInterface returns the value of Interface.
+func (s *SwitchScanResponseUnmatchedItem) GetInterface() OptString {
+	return s.Interface
 }
 
-// GetRetryCount returns the value of RetryCount.
-func (s *RedfishMetricReportReportsItemMessageDotExtendedInfoItemResolutionStepsItem) GetRetryCount() OptNilInt {
-	return s.RetryCount
+// GetMAC returns the value of MAC.
+func (s *SwitchScanResponseUnmatchedItem) GetMAC() OptString {
+	return s.MAC
 }
 
-// GetRetryIntervalSeconds returns the value of RetryIntervalSeconds.
-func (s *RedfishMetricReportReportsItemMessageDotExtendedInfoItemResolutionStepsItem) GetRetryIntervalSeconds() OptNilInt {
-	return s.RetryIntervalSeconds
+// GetPort returns the value of Port.
+func (s *SwitchScanResponseUnmatchedItem) GetPort() OptInt {
+	return s.Port
 }
 
-// GetTargetComponentURI returns the value of TargetComponentURI.
-func (s *RedfishMetricReportReportsItemMessageDotExtendedInfoItemResolutionStepsItem) GetTargetComponentURI() OptString {
-	return s.TargetComponentURI
+// GetSwitch returns the value of Switch.
+func (s *SwitchScanResponseUnmatchedItem) GetSwitch() OptString {
+	return s.Switch
 }
 
-// SetActionParameters sets the value of ActionParameters.
-func (s *RedfishMetricReportReportsItemMessageDotExtendedInfoItemResolutionStepsItem) SetActionParameters(val []RedfishMetricReportReportsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) {
-	s.ActionParameters = val
+// GetVlan returns the value of Vlan.
+func (s *SwitchScanResponseUnmatchedItem) GetVlan() OptString {
+	return s.Vlan
 }
 
-// SetActionURI sets the value of ActionURI.
-func (s *RedfishMetricReportReportsItemMessageDotExtendedInfoItemResolutionStepsItem) SetActionURI(val OptString) {
-	s.ActionURI = val
+// SetHost sets the value of Host.
+func (s *SwitchScanResponseUnmatchedItem) SetHost(val OptString) {
+	s.Host = val
 }
 
-// SetOem sets the value of Oem.
-func (s *RedfishMetricReportReportsItemMessageDotExtendedInfoItemResolutionStepsItem) SetOem(val jx.Raw) {
-	s.Oem = val
+// SetInterface sets the value of Interface.
+func (s *SwitchScanResponseUnmatchedItem) SetInterface(val OptString) {
+	s.Interface = val
 }
 
-// SetPriority sets the value of Priority.
-func (s *RedfishMetricReportReportsItemMessageDotExtendedInfoItemResolutionStepsItem) SetPriority(val OptNilInt) {
-	s.Priority = val
+// SetMAC sets the value of MAC.
+func (s *SwitchScanResponseUnmatchedItem) SetMAC(val OptString) {
+	s.MAC = val
 }
 
-// SetResolutionType sets the value of ResolutionType.
-func (s *RedfishMetricReportReportsItemMessageDotExtendedInfoItemResolutionStepsItem) SetResolutionType(val OptString) {
-	s.ResolutionType = val
+// SetPort sets the value of Port.
+func (s *SwitchScanResponseUnmatchedItem) SetPort(val OptInt) {
+	s.Port = val
 }
 
-// SetRetryCount sets the value of RetryCount.
-func (s *RedfishMetricReportReportsItemMessageDotExtendedInfoItemResolutionStepsItem) SetRetryCount(val OptNilInt) {
-	s.RetryCount = val
+// SetSwitch sets the value of Switch.
+func (s *SwitchScanResponseUnmatchedItem) SetSwitch(val OptString) {
+	s.Switch = val
 }
 
-// SetRetryIntervalSeconds sets the value of RetryIntervalSeconds.
-func (s *RedfishMetricReportReportsItemMessageDotExtendedInfoItemResolutionStepsItem) SetRetryIntervalSeconds(val OptNilInt) {
-	s.RetryIntervalSeconds = val
+// SetVlan sets the value of Vlan.
+func (s *SwitchScanResponseUnmatchedItem) SetVlan(val OptString) {
+	s.Vlan = val
 }
 
-// SetTargetComponentURI sets the value of TargetComponentURI.
-func (s *RedfishMetricReportReportsItemMessageDotExtendedInfoItemResolutionStepsItem) SetTargetComponentURI(val OptString) {
-	s.TargetComponentURI = val
+// SwitchVerifyResponse schema.
+// Ref: #/components/schemas/SwitchVerifyResponse
+type SwitchVerifyResponse struct {
+	Changed    OptInt                               `json:"changed"`
+	Mismatched []SwitchVerifyResponseMismatchedItem `json:"mismatched"`
+	Missing    []SwitchVerifyResponseMissingItem    `json:"missing"`
+	Unknown    []SwitchVerifyResponseUnknownItem    `json:"unknown"`
+	Verified   []SwitchVerifyResponseVerifiedItem   `json:"verified"`
 }
 
-type RedfishMetricReportReportsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem struct {
-	AllowableNumbers           []string      `json:"AllowableNumbers"`
-	AllowablePattern           OptString     `json:"AllowablePattern"`
-	AllowableValueDescriptions []string      `json:"AllowableValueDescriptions"`
-	AllowableValues            []string      `json:"AllowableValues"`
-	ArraySizeMaximum           OptNilInt     `json:"ArraySizeMaximum"`
-	ArraySizeMinimum           OptNilInt     `json:"ArraySizeMinimum"`
-	DataType                   OptString     `json:"DataType"`
-	DefaultValue               OptString     `json:"DefaultValue"`
-	MaximumValue               OptNilFloat64 `json:"MaximumValue"`
-	MinimumValue               OptNilFloat64 `json:"MinimumValue"`
-	Name                       OptString     `json:"Name"`
-	NoDefaultValue             OptBool       `json:"NoDefaultValue"`
-	ObjectDataType             OptString     `json:"ObjectDataType"`
-	Required                   OptBool       `json:"Required"`
+// GetChanged returns the value of Changed.
+func (s *SwitchVerifyResponse) GetChanged() OptInt {
+	return s.Changed
 }
 
-// GetAllowableNumbers returns the value of AllowableNumbers.
-func (s *RedfishMetricReportReportsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) GetAllowableNumbers() []string {
-	return s.AllowableNumbers
+// GetMismatched returns the value of Mismatched.
+func (s *SwitchVerifyResponse) GetMismatched() []SwitchVerifyResponseMismatchedItem {
+	return s.Mismatched
 }
 
-// GetAllowablePattern returns the value of AllowablePattern.
-func (s *RedfishMetricReportReportsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) GetAllowablePattern() OptString {
-	return s.AllowablePattern
+// GetMissing returns the value of Missing.
+func (s *SwitchVerifyResponse) GetMissing() []SwitchVerifyResponseMissingItem {
+	return s.Missing
 }
 
-// GetAllowableValueDescriptions returns the value of AllowableValueDescriptions.
-func (s *RedfishMetricReportReportsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) GetAllowableValueDescriptions() []string {
-	return s.AllowableValueDescriptions
+// GetUnknown returns the value of Unknown.
+func (s *SwitchVerifyResponse) GetUnknown() []SwitchVerifyResponseUnknownItem {
+	return s.Unknown
 }
 
-// GetAllowableValues returns the value of AllowableValues.
-func (s *RedfishMetricReportReportsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) GetAllowableValues() []string {
-	return s.AllowableValues
+// GetVerified returns the value of Verified.
+func (s *SwitchVerifyResponse) GetVerified() []SwitchVerifyResponseVerifiedItem {
+	return s.Verified
 }
 
-// GetArraySizeMaximum returns the value of ArraySizeMaximum.
-func (s *RedfishMetricReportReportsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) GetArraySizeMaximum() OptNilInt {
-	return s.ArraySizeMaximum
+// SetChanged sets the value of Changed.
+func (s *SwitchVerifyResponse) SetChanged(val OptInt) {
+	s.Changed = val
 }
 
-// GetArraySizeMinimum returns the value of ArraySizeMinimum.
-func (s *RedfishMetricReportReportsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) GetArraySizeMinimum() OptNilInt {
-	return s.ArraySizeMinimum
+// SetMismatched sets the value of Mismatched.
+func (s *SwitchVerifyResponse) SetMismatched(val []SwitchVerifyResponseMismatchedItem) {
+	s.Mismatched = val
 }
 
-// GetDataType returns the value of DataType.
-func (s *RedfishMetricReportReportsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) GetDataType() OptString {
-	return s.DataType
+// SetMissing sets the value of Missing.
+func (s *SwitchVerifyResponse) SetMissing(val []SwitchVerifyResponseMissingItem) {
+	s.Missing = val
 }
 
-// GetDefaultValue returns the value of DefaultValue.
-func (s *RedfishMetricReportReportsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) GetDefaultValue() OptString {
-	return s.DefaultValue
+// SetUnknown sets the value of Unknown.
+func (s *SwitchVerifyResponse) SetUnknown(val []SwitchVerifyResponseUnknownItem) {
+	s.Unknown = val
 }
 
-// GetMaximumValue returns the value of MaximumValue.
-func (s *RedfishMetricReportReportsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) GetMaximumValue() OptNilFloat64 {
-	return s.MaximumValue
+// SetVerified sets the value of Verified.
+func (s *SwitchVerifyResponse) SetVerified(val []SwitchVerifyResponseVerifiedItem) {
+	s.Verified = val
 }
 
-// GetMinimumValue returns the value of MinimumValue.
-func (s *RedfishMetricReportReportsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) GetMinimumValue() OptNilFloat64 {
-	return s.MinimumValue
+type SwitchVerifyResponseMismatchedItem struct {
+	ExpectedPort   OptInt    `json:"expected_port"`
+	ExpectedSwitch OptString `json:"expected_switch"`
+	Host           OptString `json:"host"`
+	Interface      OptString `json:"interface"`
+	MAC            OptString `json:"mac"`
+	Neighbor       OptString `json:"neighbor"`
+	Port           OptInt    `json:"port"`
+	PortName       OptString `json:"port_name"`
+	Switch         OptString `json:"switch"`
 }
 
-// GetName returns the value of Name.
-func (s *RedfishMetricReportReportsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) GetName() OptString {
-	return s.Name
+// GetExpectedPort returns the value of ExpectedPort.
+func (s *SwitchVerifyResponseMismatchedItem) GetExpectedPort() OptInt {
+	return s.ExpectedPort
 }
 
-// GetNoDefaultValue returns the value of NoDefaultValue.
-func (s *RedfishMetricReportReportsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) GetNoDefaultValue() OptBool {
-	return s.NoDefaultValue
+// GetExpectedSwitch returns the value of ExpectedSwitch.
+func (s *SwitchVerifyResponseMismatchedItem) GetExpectedSwitch() OptString {
+	return s.ExpectedSwitch
 }
 
-// GetObjectDataType returns the value of ObjectDataType.
-func (s *RedfishMetricReportReportsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) GetObjectDataType() OptString {
-	return s.ObjectDataType
+// GetHost returns the value of Host.
+func (s *SwitchVerifyResponseMismatchedItem) GetHost() OptString {
+	return s.Host
 }
 
-// GetRequired returns the value of Required.
-func (s *RedfishMetricReportReportsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) GetRequired() OptBool {
-	return s.Required
+// GetInterface returns the value of Interface.
+func (s *SwitchVerifyResponseMismatchedItem) GetInterface() OptString {
+	return s.Interface
 }
 
-// SetAllowableNumbers sets the value of AllowableNumbers.
-func (s *RedfishMetricReportReportsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) SetAllowableNumbers(val []string) {
-	s.AllowableNumbers = val
+// GetMAC returns the value of MAC.
+func (s *SwitchVerifyResponseMismatchedItem) GetMAC() OptString {
+	return s.MAC
 }
 
-// SetAllowablePattern sets the value of AllowablePattern.
-func (s *RedfishMetricReportReportsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) SetAllowablePattern(val OptString) {
-	s.AllowablePattern = val
+// GetNeighbor returns the value of Neighbor.
+func (s *SwitchVerifyResponseMismatchedItem) GetNeighbor() OptString {
+	return s.Neighbor
 }
 
-// SetAllowableValueDescriptions sets the value of AllowableValueDescriptions.
-func (s *RedfishMetricReportReportsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) SetAllowableValueDescriptions(val []string) {
-	s.AllowableValueDescriptions = val
+// GetPort returns the value of Port.
+func (s *SwitchVerifyResponseMismatchedItem) GetPort() OptInt {
+	return s.Port
 }
 
-// SetAllowableValues sets the value of AllowableValues.
-func (s *RedfishMetricReportReportsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) SetAllowableValues(val []string) {
-	s.AllowableValues = val
+// GetPortName returns the value of PortName.
+func (s *SwitchVerifyResponseMismatchedItem) GetPortName() OptString {
+	return s.PortName
 }
 
-// SetArraySizeMaximum sets the value of ArraySizeMaximum.
-func (s *RedfishMetricReportReportsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) SetArraySizeMaximum(val OptNilInt) {
-	s.ArraySizeMaximum = val
+// GetSwitch returns the value of Switch.
+func (s *SwitchVerifyResponseMismatchedItem) GetSwitch() OptString {
+	return s.Switch
 }
 
-// SetArraySizeMinimum sets the value of ArraySizeMinimum.
-func (s *RedfishMetricReportReportsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) SetArraySizeMinimum(val OptNilInt) {
-	s.ArraySizeMinimum = val
+// SetExpectedPort sets the value of ExpectedPort.
+func (s *SwitchVerifyResponseMismatchedItem) SetExpectedPort(val OptInt) {
+	s.ExpectedPort = val
 }
 
-// SetDataType sets the value of DataType.
-func (s *RedfishMetricReportReportsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) SetDataType(val OptString) {
-	s.DataType = val
+// SetExpectedSwitch sets the value of ExpectedSwitch.
+func (s *SwitchVerifyResponseMismatchedItem) SetExpectedSwitch(val OptString) {
+	s.ExpectedSwitch = val
 }
 
-// SetDefaultValue sets the value of DefaultValue.
-func (s *RedfishMetricReportReportsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) SetDefaultValue(val OptString) {
-	s.DefaultValue = val
+// SetHost sets the value of Host.
+func (s *SwitchVerifyResponseMismatchedItem) SetHost(val OptString) {
+	s.Host = val
 }
 
-// SetMaximumValue sets the value of MaximumValue.
-func (s *RedfishMetricReportReportsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) SetMaximumValue(val OptNilFloat64) {
-	s.MaximumValue = val
+// SetInterface sets the value of Interface.
+func (s *SwitchVerifyResponseMismatchedItem) SetInterface(val OptString) {
+	s.Interface = val
 }
 
-// SetMinimumValue sets the value of MinimumValue.
-func (s *RedfishMetricReportReportsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) SetMinimumValue(val OptNilFloat64) {
-	s.MinimumValue = val
+// SetMAC sets the value of MAC.
+func (s *SwitchVerifyResponseMismatchedItem) SetMAC(val OptString) {
+	s.MAC = val
 }
 
-// SetName sets the value of Name.
-func (s *RedfishMetricReportReportsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) SetName(val OptString) {
-	s.Name = val
+// SetNeighbor sets the value of Neighbor.
+func (s *SwitchVerifyResponseMismatchedItem) SetNeighbor(val OptString) {
+	s.Neighbor = val
 }
 
-// SetNoDefaultValue sets the value of NoDefaultValue.
-func (s *RedfishMetricReportReportsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) SetNoDefaultValue(val OptBool) {
-	s.NoDefaultValue = val
+// SetPort sets the value of Port.
+func (s *SwitchVerifyResponseMismatchedItem) SetPort(val OptInt) {
+	s.Port = val
 }
 
-// SetObjectDataType sets the value of ObjectDataType.
-func (s *RedfishMetricReportReportsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) SetObjectDataType(val OptString) {
-	s.ObjectDataType = val
+// SetPortName sets the value of PortName.
+func (s *SwitchVerifyResponseMismatchedItem) SetPortName(val OptString) {
+	s.PortName = val
 }
 
-// SetRequired sets the value of Required.
-func (s *RedfishMetricReportReportsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) SetRequired(val OptBool) {
-	s.Required = val
+// SetSwitch sets the value of Switch.
+func (s *SwitchVerifyResponseMismatchedItem) SetSwitch(val OptString) {
+	s.Switch = val
 }
 
-type RedfishMetricReportReportsItemMetricValuesItem struct {
-	MetricId       OptString `json:"MetricId"`
-	MetricProperty OptString `json:"MetricProperty"`
-	MetricValue    OptString `json:"MetricValue"`
-	Oem            jx.Raw    `json:"Oem"`
-	Timestamp      OptString `json:"Timestamp"`
+type SwitchVerifyResponseMissingItem struct {
+	ExpectedPort   OptInt    `json:"expected_port"`
+	ExpectedSwitch OptString `json:"expected_switch"`
+	Host           OptString `json:"host"`
+	Interface      OptString `json:"interface"`
+	MAC            OptString `json:"mac"`
+	Neighbor       OptString `json:"neighbor"`
+	Port           OptInt    `json:"port"`
+	PortName       OptString `json:"port_name"`
+	Switch         OptString `json:"switch"`
 }
 
-// GetMetricId returns the value of MetricId.
-func (s *RedfishMetricReportReportsItemMetricValuesItem) GetMetricId() OptString {
-	return s.MetricId
+// GetExpectedPort returns the value of ExpectedPort.
+func (s *SwitchVerifyResponseMissingItem) GetExpectedPort() OptInt {
+	return s.ExpectedPort
 }
 
-// GetMetricProperty returns the value of MetricProperty.
-func (s *RedfishMetricReportReportsItemMetricValuesItem) GetMetricProperty() OptString {
-	return s.MetricProperty
+// GetExpectedSwitch returns the value of ExpectedSwitch.
+func (s *SwitchVerifyResponseMissingItem) GetExpectedSwitch() OptString {
+	return s.ExpectedSwitch
 }
 
-// GetMetricValue returns the value of MetricValue.
-func (s *RedfishMetricReportReportsItemMetricValuesItem) GetMetricValue() OptString {
-	return s.MetricValue
+// GetHost returns the value of Host.
+func (s *SwitchVerifyResponseMissingItem) GetHost() OptString {
+	return s.Host
 }
 
-// GetOem returns the value of Oem.
-func (s *RedfishMetricReportReportsItemMetricValuesItem) GetOem() jx.Raw {
-	return s.Oem
+// GetInterface returns the value of Interface.
+func (s *SwitchVerifyResponseMissingItem) GetInterface() OptString {
+	return s.Interface
 }
 
-// GetTimestamp returns the value of Timestamp.
-func (s *RedfishMetricReportReportsItemMetricValuesItem) GetTimestamp() OptString {
-	return s.Timestamp
+// GetMAC returns the value of MAC.
+func (s *SwitchVerifyResponseMissingItem) GetMAC() OptString {
+	return s.MAC
 }
 
-// SetMetricId sets the value of MetricId.
-func (s *RedfishMetricReportReportsItemMetricValuesItem) SetMetricId(val OptString) {
-	s.MetricId = val
+// GetNeighbor returns the value of Neighbor.
+func (s *SwitchVerifyResponseMissingItem) GetNeighbor() OptString {
+	return s.Neighbor
 }
 
-// SetMetricProperty sets the value of MetricProperty.
-func (s *RedfishMetricReportReportsItemMetricValuesItem) SetMetricProperty(val OptString) {
-	s.MetricProperty = val
+// GetPort returns the value of Port.
+func (s *SwitchVerifyResponseMissingItem) GetPort() OptInt {
+	return s.Port
 }
 
-// SetMetricValue sets the value of MetricValue.
-func (s *RedfishMetricReportReportsItemMetricValuesItem) SetMetricValue(val OptString) {
-	s.MetricValue = val
+// GetPortName returns the value of PortName.
+func (s *SwitchVerifyResponseMissingItem) GetPortName() OptString {
+	return s.PortName
 }
 
-// SetOem sets the value of Oem.
-func (s *RedfishMetricReportReportsItemMetricValuesItem) SetOem(val jx.Raw) {
-	s.Oem = val
+// GetSwitch returns the value of Switch.
+func (s *SwitchVerifyResponseMissingItem) GetSwitch() OptString {
+	return s.Switch
 }
 
-// SetTimestamp sets the value of Timestamp.
-func (s *RedfishMetricReportReportsItemMetricValuesItem) SetTimestamp(val OptString) {
-	s.Timestamp = val
+// SetExpectedPort sets the value of ExpectedPort.
+func (s *SwitchVerifyResponseMissingItem) SetExpectedPort(val OptInt) {
+	s.ExpectedPort = val
 }
 
-// RedfishSystem schema.
-// Ref: #/components/schemas/RedfishSystem
-type RedfishSystem struct {
-	BiosVersion    OptString                  `json:"bios_version"`
-	BootNext       OptString                  `json:"boot_next"`
-	BootOrder      OptNilNilStringArray       `json:"boot_order"`
-	Health         OptString                  `json:"health"`
-	HostName       OptString                  `json:"host_name"`
-	Manufacturer   OptString                  `json:"manufacturer"`
-	Model          OptString                  `json:"model"`
-	Name           OptString                  `json:"name"`
-	OemDell        OptNilRedfishSystemOemDell `json:"oem_dell"`
-	PowerStatus    OptString                  `json:"power_status"`
-	ProcessorCount OptInt                     `json:"processor_count"`
-	SerialNumber   OptString                  `json:"serial_number"`
-	TotalMemory    OptFloat32                 `json:"total_memory"`
+// SetExpectedSwitch sets the value of ExpectedSwitch.
+func (s *SwitchVerifyResponseMissingItem) SetExpectedSwitch(val OptString) {
+	s.ExpectedSwitch = val
 }
 
-// GetBiosVersion returns the value of BiosVersion.
-func (s *RedfishSystem) GetBiosVersion() OptString {
-	return s.BiosVersion
+// SetHost sets the value of Host.
+func (s *SwitchVerifyResponseMissingItem) SetHost(val OptString) {
+	s.Host = val
 }
 
-// GetBootNext returns the value of BootNext.
-func (s *RedfishSystem) GetBootNext() OptString {
-	return s.BootNext
+// SetInterface sets the value of Interface.
+func (s *SwitchVerifyResponseMissingItem) SetInterface(val OptString) {
+	s.Interface = val
 }
 
-// GetBootOrder returns the value of BootOrder.
-func (s *RedfishSystem) GetBootOrder() OptNilNilStringArray {
-	return s.BootOrder
+// SetMAC sets the value of MAC.
+func (s *SwitchVerifyResponseMissingItem) SetMAC(val OptString) {
+	s.MAC = val
 }
 
-// GetHealth returns the value of Health.
-func (s *RedfishSystem) GetHealth() OptString {
-	return s.Health
+// SetNeighbor sets the value of Neighbor.
+func (s *SwitchVerifyResponseMissingItem) SetNeighbor(val OptString) {
+	s.Neighbor = val
 }
 
-// GetHostName returns the value of HostName.
-func (s *RedfishSystem) GetHostName() OptString {
-	return s.HostName
+// SetPort sets the value of Port.
+func (s *SwitchVerifyResponseMissingItem) SetPort(val OptInt) {
+	s.Port = val
 }
 
-// GetManufacturer returns the value of Manufacturer.
-func (s *RedfishSystem) GetManufacturer() OptString {
-	return s.Manufacturer
+// SetPortName sets the value of PortName.
+func (s *SwitchVerifyResponseMissingItem) SetPortName(val OptString) {
+	s.PortName = val
 }
 
-// GetModel returns the value of Model.
-func (s *RedfishSystem) GetModel() OptString {
-	return s.Model
+// SetSwitch sets the value of Switch.
+func (s *SwitchVerifyResponseMissingItem) SetSwitch(val OptString) {
+	s.Switch = val
 }
 
-// GetName returns the value of Name.
-func (s *RedfishSystem) GetName() OptString {
-	return s.Name
+type SwitchVerifyResponseUnknownItem struct {
+	ExpectedPort   OptInt    `json:"expected_port"`
+	ExpectedSwitch OptString `json:"expected_switch"`
+	Host           OptString `json:"host"`
+	Interface      OptString `json:"interface"`
+	MAC            OptString `json:"mac"`
+	Neighbor       OptString `json:"neighbor"`
+	Port           OptInt    `json:"port"`
+	PortName       OptString `json:"port_name"`
+	Switch         OptString `json:"switch"`
 }
 
-// GetOemDell returns the value of OemDell.
-func (s *RedfishSystem) GetOemDell() OptNilRedfishSystemOemDell {
-	return s.OemDell
+// GetExpectedPort returns the value of ExpectedPort.
+func (s *SwitchVerifyResponseUnknownItem) GetExpectedPort() OptInt {
+	return s.ExpectedPort
 }
 
-// GetPowerStatus returns the value of PowerStatus.
-func (s *RedfishSystem) GetPowerStatus() OptString {
-	return s.PowerStatus
+// GetExpectedSwitch returns the value of ExpectedSwitch.
+func (s *SwitchVerifyResponseUnknownItem) GetExpectedSwitch() OptString {
+	return s.ExpectedSwitch
 }
 
-// GetProcessorCount returns the value of ProcessorCount.
-func (s *RedfishSystem) GetProcessorCount() OptInt {
-	return s.ProcessorCount
+// GetHost returns the value of Host.
+func (s *SwitchVerifyResponseUnknownItem) GetHost() OptString {
+	return s.Host
 }
 
-// GetSerialNumber returns the value of SerialNumber.
-func (s *RedfishSystem) GetSerialNumber() OptString {
-	return s.SerialNumber
+// GetInterface returns the value of Interface.
+func (s *SwitchVerifyResponseUnknownItem) GetInterface() OptString {
+	return s.Interface
 }
 
-// GetTotalMemory returns the value of TotalMemory.
-func (s *RedfishSystem) GetTotalMemory() OptFloat32 {
-	return s.TotalMemory
+// GetMAC returns the value of MAC.
+func (s *SwitchVerifyResponseUnknownItem) GetMAC() OptString {
+	return s.MAC
 }
 
-// SetBiosVersion sets the value of BiosVersion.
-func (s *RedfishSystem) SetBiosVersion(val OptString) {
-	s.BiosVersion = val
+// GetNeighbor returns the value of Neighbor.
+func (s *SwitchVerifyResponseUnknownItem) GetNeighbor() OptString {
+	return s.Neighbor
 }
 
-// SetBootNext sets the value of BootNext.
-func (s *RedfishSystem) SetBootNext(val OptString) {
-	s.BootNext = val
+// GetPort returns the value of Port.
+func (s *SwitchVerifyResponseUnknownItem) GetPort() OptInt {
+	return s.Port
 }
 
-// SetBootOrder sets the value of BootOrder.
-func (s *RedfishSystem) SetBootOrder(val OptNilNilStringArray) {
-	s.BootOrder = val
+// GetPortName returns the value of PortName.
+func (s *SwitchVerifyResponseUnknownItem) GetPortName() OptString {
+	return s.PortName
 }
 
-// SetHealth sets the value of Health.
-func (s *RedfishSystem) SetHealth(val OptString) {
-	s.Health = val
+// GetSwitch returns the value of Switch.
+func (s *SwitchVerifyResponseUnknownItem) GetSwitch() OptString {
+	return s.Switch
 }
 
-// SetHostName sets the value of HostName.
-func (s *RedfishSystem) SetHostName(val OptString) {
-	s.HostName = val
+// SetExpectedPort sets the value of ExpectedPort.
+func (s *SwitchVerifyResponseUnknownItem) SetExpectedPort(val OptInt) {
+	s.ExpectedPort = val
 }
 
-// SetManufacturer sets the value of Manufacturer.
-func (s *RedfishSystem) SetManufacturer(val OptString) {
-	s.Manufacturer = val
+// SetExpectedSwitch sets the value of ExpectedSwitch.
+func (s *SwitchVerifyResponseUnknownItem) SetExpectedSwitch(val OptString) {
+	s.ExpectedSwitch = val
 }
 
-// SetModel sets the value of Model.
-func (s *RedfishSystem) SetModel(val OptString) {
-	s.Model = val
+// SetHost sets the value of Host.
+func (s *SwitchVerifyResponseUnknownItem) SetHost(val OptString) {
+	s.Host = val
 }
 
-// SetName sets the value of Name.
-func (s *RedfishSystem) SetName(val OptString) {
-	s.Name = val
+// SetInterface sets the value of Interface.
+func (s *SwitchVerifyResponseUnknownItem) SetInterface(val OptString) {
+	s.Interface = val
 }
 
-// SetOemDell sets the value of OemDell.
-func (s *RedfishSystem) SetOemDell(val OptNilRedfishSystemOemDell) {
-	s.OemDell = val
+// SetMAC sets the value of MAC.
+func (s *SwitchVerifyResponseUnknownItem) SetMAC(val OptString) {
+	s.MAC = val
 }
 
-// SetPowerStatus sets the value of PowerStatus.
-func (s *RedfishSystem) SetPowerStatus(val OptString) {
-	s.PowerStatus = val
+// SetNeighbor sets the value of Neighbor.
+func (s *SwitchVerifyResponseUnknownItem) SetNeighbor(val OptString) {
+	s.Neighbor = val
 }
 
-// SetProcessorCount sets the value of ProcessorCount.
-func (s *RedfishSystem) SetProcessorCount(val OptInt) {
-	s.ProcessorCount = val
+// SetPort sets the value of Port.
+func (s *SwitchVerifyResponseUnknownItem) SetPort(val OptInt) {
+	s.Port = val
 }
 
-// SetSerialNumber sets the value of SerialNumber.
-func (s *RedfishSystem) SetSerialNumber(val OptString) {
-	s.SerialNumber = val
+// SetPortName sets the value of PortName.
+func (s *SwitchVerifyResponseUnknownItem) SetPortName(val OptString) {
+	s.PortName = val
 }
 
-// SetTotalMemory sets the value of TotalMemory.
-func (s *RedfishSystem) SetTotalMemory(val OptFloat32) {
-	s.TotalMemory = val
+// SetSwitch sets the value of Switch.
+func (s *SwitchVerifyResponseUnknownItem) SetSwitch(val OptString) {
+	s.Switch = val
 }
 
-type RedfishSystemOemDell struct {
-	MessageDotExtendedInfo             OptNilRedfishSystemOemDellMessageDotExtendedInfoItemArray `json:"@Message.ExtendedInfo"`
-	OdataDotContext                    OptString                                                 `json:"@odata.context"`
-	OdataDotEtag                       OptNilString                                              `json:"@odata.etag"`
-	OdataDotID                         OptString                                                 `json:"@odata.id"`
-	OdataDotType                       OptString                                                 `json:"@odata.type"`
-	BIOSReleaseDate                    OptString                                                 `json:"BIOSReleaseDate"`
-	BaseBoardChassisSlot               OptString                                                 `json:"BaseBoardChassisSlot"`
-	BatteryRollupStatus                OptString                                                 `json:"BatteryRollupStatus"`
-	BladeGeometry                      OptString                                                 `json:"BladeGeometry"`
-	CMCIP                              OptString                                                 `json:"CMCIP"`
-	CPURollupStatus                    OptString                                                 `json:"CPURollupStatus"`
-	ChassisModel                       OptString                                                 `json:"ChassisModel"`
-	ChassisName                        OptString                                                 `json:"ChassisName"`
-	ChassisServiceTag                  OptString                                                 `json:"ChassisServiceTag"`
-	ChassisSystemHeightUnit            OptInt                                                    `json:"ChassisSystemHeightUnit"`
-	CurrentRollupStatus                OptString                                                 `json:"CurrentRollupStatus"`
-	Description                        OptString                                                 `json:"Description"`
-	EstimatedExhaustTemperatureCelsius OptInt                                                    `json:"EstimatedExhaustTemperatureCelsius"`
-	EstimatedSystemAirflowCFM          OptInt                                                    `json:"EstimatedSystemAirflowCFM"`
-	ExpressServiceCode                 OptString                                                 `json:"ExpressServiceCode"`
-	FanRollupStatus                    OptString                                                 `json:"FanRollupStatus"`
-	IDSDMRollupStatus                  OptString                                                 `json:"IDSDMRollupStatus"`
-	ID                                 OptString                                                 `json:"Id"`
-	IntrusionRollupStatus              OptString                                                 `json:"IntrusionRollupStatus"`
-	IsOEMBranded                       OptString                                                 `json:"IsOEMBranded"`
-	LastSystemInventoryTime            OptString                                                 `json:"LastSystemInventoryTime"`
-	LastUpdateTime                     OptString                                                 `json:"LastUpdateTime"`
-	LicensingRollupStatus              OptString                                                 `json:"LicensingRollupStatus"`
-	ManagedSystemSize                  OptString                                                 `json:"ManagedSystemSize"`
-	MaxCPUSockets                      OptInt                                                    `json:"MaxCPUSockets"`
-	MaxDIMMSlots                       OptInt                                                    `json:"MaxDIMMSlots"`
-	MaxPCIeSlots                       OptInt                                                    `json:"MaxPCIeSlots"`
-	MemoryOperationMode                OptString                                                 `json:"MemoryOperationMode"`
-	Name                               OptString                                                 `json:"Name"`
-	NodeID                             OptString                                                 `json:"NodeID"`
-	Oem                                jx.Raw                                                    `json:"Oem"`
-	PSRollupStatus                     OptString                                                 `json:"PSRollupStatus"`
-	PlatformGUID                       OptString                                                 `json:"PlatformGUID"`
-	PopulatedDIMMSlots                 OptInt                                                    `json:"PopulatedDIMMSlots"`
-	PopulatedPCIeSlots                 OptInt                                                    `json:"PopulatedPCIeSlots"`
-	PowerCapEnabledState               OptString                                                 `json:"PowerCapEnabledState"`
-	SDCardRollupStatus                 OptString                                                 `json:"SDCardRollupStatus"`
-	SELRollupStatus                    OptString                                                 `json:"SELRollupStatus"`
-	ServerAllocationWatts              OptInt                                                    `json:"ServerAllocationWatts"`
-	StorageRollupStatus                OptString                                                 `json:"StorageRollupStatus"`
-	SysMemErrorMethodology             OptString                                                 `json:"SysMemErrorMethodology"`
-	SysMemFailOverState                OptString                                                 `json:"SysMemFailOverState"`
-	SysMemLocation                     OptString                                                 `json:"SysMemLocation"`
-	SysMemPrimaryStatus                OptString                                                 `json:"SysMemPrimaryStatus"`
-	SystemGeneration                   OptString                                                 `json:"SystemGeneration"`
-	SystemID                           OptInt                                                    `json:"SystemID"`
-	SystemRevision                     OptString                                                 `json:"SystemRevision"`
-	TempRollupStatus                   OptString                                                 `json:"TempRollupStatus"`
-	TempStatisticsRollupStatus         OptString                                                 `json:"TempStatisticsRollupStatus"`
-	UUID                               OptString                                                 `json:"UUID"`
-	VoltRollupStatus                   OptString                                                 `json:"VoltRollupStatus"`
-	SmbiosGUID                         OptString                                                 `json:"smbiosGUID"`
+type SwitchVerifyResponseVerifiedItem struct {
+	ExpectedPort   OptInt    `json:"expected_port"`
+	ExpectedSwitch OptString `json:"expected_switch"`
+	Host           OptString `json:"host"`
+	Interface      OptString `json:"interface"`
+	MAC            OptString `json:"mac"`
+	Neighbor       OptString `json:"neighbor"`
+	Port           OptInt    `json:"port"`
+	PortName       OptString `json:"port_name"`
+	Switch         OptString `json:"switch"`
+}
+
+// GetExpectedPort returns the value of ExpectedPort.
+func (s *SwitchVerifyResponseVerifiedItem) GetExpectedPort() OptInt {
+	return s.ExpectedPort
 }
 
-// GetMessageDotExtendedInfo returns the value of MessageDotExtendedInfo.
-func (s *RedfishSystemOemDell) GetMessageDotExtendedInfo() OptNilRedfishSystemOemDellMessageDotExtendedInfoItemArray {
-	return s.MessageDotExtendedInfo
+// GetExpectedSwitch returns the value of ExpectedSwitch.
+func (s *SwitchVerifyResponseVerifiedItem) GetExpectedSwitch() OptString {
+	return s.ExpectedSwitch
 }
 
-// GetOdataDotContext returns the value of OdataDotContext.
-func (s *RedfishSystemOemDell) GetOdataDotContext() OptString {
-	return s.OdataDotContext
+// GetHost returns the value of Host.
+func (s *SwitchVerifyResponseVerifiedItem) GetHost() OptString {
+	return s.Host
 }
 
-// GetOdataDotEtag returns the value of OdataDotEtag.
-func (s *RedfishSystemOemDell) GetOdataDotEtag() OptNilString {
-	return s.OdataDotEtag
+// GetInterface returns the value of Interface.
+func (s *SwitchVerifyResponseVerifiedItem) GetInterface() OptString {
+	return s.Interface
 }
 
-// GetOdataDotID returns the value of OdataDotID.
-func (s *RedfishSystemOemDell) GetOdataDotID() OptString {
-	return s.OdataDotID
+// GetMAC returns the value of MAC.
+func (s *SwitchVerifyResponseVerifiedItem) GetMAC() OptString {
+	return s.MAC
 }
 
-// GetOdataDotType returns the value of OdataDotType.
-func (s *RedfishSystemOemDell) GetOdataDotType() OptString {
-	return s.OdataDotType
+// GetNeighbor returns the value of Neighbor.
+func (s *SwitchVerifyResponseVerifiedItem) GetNeighbor() OptString {
+	return s.Neighbor
 }
 
-// GetBIOSReleaseDate returns the value of BIOSReleaseDate.
-func (s *RedfishSystemOemDell) GetBIOSReleaseDate() OptString {
-	return s.BIOSReleaseDate
+// GetPort returns the value of Port.
+func (s *SwitchVerifyResponseVerifiedItem) GetPort() OptInt {
+	return s.Port
 }
 
-// GetBaseBoardChassisSlot returns the value of BaseBoardChassisSlot.
-func (s *RedfishSystemOemDell) GetBaseBoardChassisSlot() OptString {
-	return s.BaseBoardChassisSlot
+// GetPortName returns the value of PortName.
+func (s *SwitchVerifyResponseVerifiedItem) GetPortName() OptString {
+	return s.PortName
 }
 
-// GetBatteryRollupStatus returns the value of BatteryRollupStatus.
-func (s *RedfishSystemOemDell) GetBatteryRollupStatus() OptString {
-	return s.BatteryRollupStatus
+// GetSwitch returns the value of Switch.
+func (s *SwitchVerifyResponseVerifiedItem) GetSwitch() OptString {
+	return s.Switch
 }
 
-// GetBladeGeometry returns the value of BladeGeometry.
-func (s *RedfishSystemOemDell) GetBladeGeometry() OptString {
-	return s.BladeGeometry
+// SetExpectedPort sets the value of ExpectedPort.
+func (s *SwitchVerifyResponseVerifiedItem) SetExpectedPort(val OptInt) {
+	s.ExpectedPort = val
 }
 
-// GetCMCIP returns the value of CMCIP.
-func (s *RedfishSystemOemDell) GetCMCIP() OptString {
-	return s.CMCIP
+// SetExpectedSwitch sets the value of ExpectedSwitch.
+func (s *SwitchVerifyResponseVerifiedItem) SetExpectedSwitch(val OptString) {
+	s.ExpectedSwitch = val
 }
 
-// GetCPURollupStatus returns the value of CPURollupStatus.
-func (s *RedfishSystemOemDell) GetCPURollupStatus() OptString {
-	return s.CPURollupStatus
+// SetHost sets the value of Host.
+func (s *SwitchVerifyResponseVerifiedItem) SetHost(val OptString) {
+	s.Host = val
 }
 
-// GetChassisModel returns the value of ChassisModel.
-func (s *RedfishSystemOemDell) GetChassisModel() OptString {
-	return s.ChassisModel
+// SetInterface sets the value of Interface.
+func (s *SwitchVerifyResponseVerifiedItem) SetInterface(val OptString) {
+	s.Interface = val
 }
 
-// GetChassisName returns the value of ChassisName.
-func (s *RedfishSystemOemDell) GetChassisName() OptString {
-	return s.ChassisName
+// SetMAC sets the value of MAC.
+func (s *SwitchVerifyResponseVerifiedItem) SetMAC(val OptString) {
+	s.MAC = val
 }
 
-// GetChassisServiceTag returns the value of ChassisServiceTag.
-func (s *RedfishSystemOemDell) GetChassisServiceTag() OptString {
-	return s.ChassisServiceTag
+// SetNeighbor sets the value of Neighbor.
+func (s *SwitchVerifyResponseVerifiedItem) SetNeighbor(val OptString) {
+	s.Neighbor = val
 }
 
-// GetChassisSystemHeightUnit returns the value of ChassisSystemHeightUnit.
-func (s *RedfishSystemOemDell) GetChassisSystemHeightUnit() OptInt {
-	return s.ChassisSystemHeightUnit
+// SetPort sets the value of Port.
+func (s *SwitchVerifyResponseVerifiedItem) SetPort(val OptInt) {
+	s.Port = val
 }
 
-// GetCurrentRollupStatus returns the value of CurrentRollupStatus.
-func (s *RedfishSystemOemDell) GetCurrentRollupStatus() OptString {
-	return s.CurrentRollupStatus
+// SetPortName sets the value of PortName.
+func (s *SwitchVerifyResponseVerifiedItem) SetPortName(val OptString) {
+	s.PortName = val
 }
 
-// GetDescription returns the value of Description.
-func (s *RedfishSystemOemDell) GetDescription() OptString {
-	return s.Description
+// SetSwitch sets the value of Switch.
+func (s *SwitchVerifyResponseVerifiedItem) SetSwitch(val OptString) {
+	s.Switch = val
 }
 
-// GetEstimatedExhaustTemperatureCelsius returns the value of EstimatedExhaustTemperatureCelsius.
-func (s *RedfishSystemOemDell) GetEstimatedExhaustTemperatureCelsius() OptInt {
-	return s.EstimatedExhaustTemperatureCelsius
+// Tombstone schema.
+// Ref: #/components/schemas/Tombstone
+type Tombstone struct {
+	DeletedAt OptDateTime `json:"deleted_at"`
+	Name      OptString   `json:"name"`
 }
 
-// GetEstimatedSystemAirflowCFM returns the value of EstimatedSystemAirflowCFM.
-func (s *RedfishSystemOemDell) GetEstimatedSystemAirflowCFM() OptInt {
-	return s.EstimatedSystemAirflowCFM
+// GetDeletedAt returns the value of DeletedAt.
+func (s *Tombstone) GetDeletedAt() OptDateTime {
+	return s.DeletedAt
 }
 
-// GetExpressServiceCode returns the value of ExpressServiceCode.
-func (s *RedfishSystemOemDell) GetExpressServiceCode() OptString {
-	return s.ExpressServiceCode
+// GetName returns the value of Name.
+func (s *Tombstone) GetName() OptString {
+	return s.Name
 }
 
-// GetFanRollupStatus returns the value of FanRollupStatus.
-func (s *RedfishSystemOemDell) GetFanRollupStatus() OptString {
-	return s.FanRollupStatus
+// SetDeletedAt sets the value of DeletedAt.
+func (s *Tombstone) SetDeletedAt(val OptDateTime) {
+	s.DeletedAt = val
 }
 
-// GetIDSDMRollupStatus returns the value of IDSDMRollupStatus.
-func (s *RedfishSystemOemDell) GetIDSDMRollupStatus() OptString {
-	return s.IDSDMRollupStatus
+// SetName sets the value of Name.
+func (s *Tombstone) SetName(val OptString) {
+	s.Name = val
 }
 
-// GetID returns the value of ID.
-func (s *RedfishSystemOemDell) GetID() OptString {
-	return s.ID
+// TrashedHost schema.
+// Ref: #/components/schemas/TrashedHost
+type TrashedHost struct {
+	DeletedAt OptDateTime           `json:"deleted_at"`
+	Host      OptNilTrashedHostHost `json:"host"`
+	Name      OptString             `json:"name"`
 }
 
-// GetIntrusionRollupStatus returns the value of IntrusionRollupStatus.
-func (s *RedfishSystemOemDell) GetIntrusionRollupStatus() OptString {
-	return s.IntrusionRollupStatus
+// GetDeletedAt returns the value of DeletedAt.
+func (s *TrashedHost) GetDeletedAt() OptDateTime {
+	return s.DeletedAt
 }
 
-// GetIsOEMBranded returns the value of IsOEMBranded.
-func (s *RedfishSystemOemDell) GetIsOEMBranded() OptString {
-	return s.IsOEMBranded
+// GetHost returns the value of Host.
+func (s *TrashedHost) GetHost() OptNilTrashedHostHost {
+	return s.Host
 }
 
-// GetLastSystemInventoryTime returns the value of LastSystemInventoryTime.
-func (s *RedfishSystemOemDell) GetLastSystemInventoryTime() OptString {
-	return s.LastSystemInventoryTime
+// GetName returns the value of Name.
+func (s *TrashedHost) GetName() OptString {
+	return s.Name
 }
 
-// GetLastUpdateTime returns the value of LastUpdateTime.
-func (s *RedfishSystemOemDell) GetLastUpdateTime() OptString {
-	return s.LastUpdateTime
+// SetDeletedAt sets the value of DeletedAt.
+func (s *TrashedHost) SetDeletedAt(val OptDateTime) {
+	s.DeletedAt = val
 }
 
-// GetLicensingRollupStatus returns the value of LicensingRollupStatus.
-func (s *RedfishSystemOemDell) GetLicensingRollupStatus() OptString {
-	return s.LicensingRollupStatus
+// SetHost sets the value of Host.
+func (s *TrashedHost) SetHost(val OptNilTrashedHostHost) {
+	s.Host = val
 }
 
-// GetManagedSystemSize returns the value of ManagedSystemSize.
-func (s *RedfishSystemOemDell) GetManagedSystemSize() OptString {
-	return s.ManagedSystemSize
+// SetName sets the value of Name.
+func (s *TrashedHost) SetName(val OptString) {
+	s.Name = val
 }
 
-// GetMaxCPUSockets returns the value of MaxCPUSockets.
-func (s *RedfishSystemOemDell) GetMaxCPUSockets() OptInt {
-	return s.MaxCPUSockets
+type TrashedHostHost struct {
+	Bonds                 []NilTrashedHostHostBondsItem      `json:"bonds"`
+	BootImage             OptString                          `json:"boot_image"`
+	ClientCertFingerprint OptString                          `json:"client_cert_fingerprint"`
+	ClientCertSerial      OptString                          `json:"client_cert_serial"`
+	CreatedAt             OptNilDateTime                     `json:"created_at"`
+	Firmware              OptString                          `json:"firmware"`
+	Hardware              OptNilTrashedHostHostHardware      `json:"hardware"`
+	ID                    OptNilInt64                        `json:"id"`
+	Interfaces            []NilTrashedHostHostInterfacesItem `json:"interfaces"`
+	Inventory             OptNilTrashedHostHostInventory     `json:"inventory"`
+	Name                  OptString                          `json:"name"`
+	Provision             OptBool                            `json:"provision"`
+	Revision              OptNilInt64                        `json:"revision"`
+	SmbiosUUID            OptString                          `json:"smbios_uuid"`
+	State                 OptString                          `json:"state"`
+	Tags                  OptNilStringArray                  `json:"tags"`
+	UID                   OptNilString                       `json:"uid"`
+	UpdatedAt             OptNilDateTime                     `json:"updated_at"`
 }
 
-// GetMaxDIMMSlots returns the value of MaxDIMMSlots.
-func (s *RedfishSystemOemDell) GetMaxDIMMSlots() OptInt {
-	return s.MaxDIMMSlots
+// GetBonds returns the value of Bonds.
+func (s *TrashedHostHost) GetBonds() []NilTrashedHostHostBondsItem {
+	return s.Bonds
 }
 
-// GetMaxPCIeSlots returns the value of MaxPCIeSlots.
-func (s *RedfishSystemOemDell) GetMaxPCIeSlots() OptInt {
-	return s.MaxPCIeSlots
+// GetBootImage returns the value of BootImage.
+func (s *TrashedHostHost) GetBootImage() OptString {
+	return s.BootImage
 }
 
-// GetMemoryOperationMode returns the value of MemoryOperationMode.
-func (s *RedfishSystemOemDell) GetMemoryOperationMode() OptString {
-	return s.MemoryOperationMode
+// GetClientCertFingerprint returns the value of ClientCertFingerprint.
+func (s *TrashedHostHost) GetClientCertFingerprint() OptString {
+	return s.ClientCertFingerprint
 }
 
-// GetName returns the value of Name.
-func (s *RedfishSystemOemDell) GetName() OptString {
-	return s.Name
+// GetClientCertSerial returns the value of ClientCertSerial.
+func (s *TrashedHostHost) GetClientCertSerial() OptString {
+	return s.ClientCertSerial
 }
 
-// GetNodeID returns the value of NodeID.
-func (s *RedfishSystemOemDell) GetNodeID() OptString {
-	return s.NodeID
+// GetCreatedAt returns the value of CreatedAt.
+func (s *TrashedHostHost) GetCreatedAt() OptNilDateTime {
+	return s.CreatedAt
 }
 
-// GetOem returns the value of Oem.
-func (s *RedfishSystemOemDell) GetOem() jx.Raw {
-	return s.Oem
+// GetFirmware returns the value of Firmware.
+func (s *TrashedHostHost) GetFirmware() OptString {
+	return s.Firmware
 }
 
-// GetPSRollupStatus returns the value of PSRollupStatus.
-func (s *RedfishSystemOemDell) GetPSRollupStatus() OptString {
-	return s.PSRollupStatus
+// GetHardware returns the value of Hardware.
+func (s *TrashedHostHost) GetHardware() OptNilTrashedHostHostHardware {
+	return s.Hardware
 }
 
-// GetPlatformGUID returns the value of PlatformGUID.
-func (s *RedfishSystemOemDell) GetPlatformGUID() OptString {
-	return s.PlatformGUID
+// GetID returns the value of ID.
+func (s *TrashedHostHost) GetID() OptNilInt64 {
+	return s.ID
 }
 
-// GetPopulatedDIMMSlots returns the value of PopulatedDIMMSlots.
-func (s *RedfishSystemOemDell) GetPopulatedDIMMSlots() OptInt {
-	return s.PopulatedDIMMSlots
+// GetInterfaces returns the value of Interfaces.
+func (s *TrashedHostHost) GetInterfaces() []NilTrashedHostHostInterfacesItem {
+	return s.Interfaces
 }
 
-// GetPopulatedPCIeSlots returns the value of PopulatedPCIeSlots.
-func (s *RedfishSystemOemDell) GetPopulatedPCIeSlots() OptInt {
-	return s.PopulatedPCIeSlots
+// GetInventory returns the value of Inventory.
+func (s *TrashedHostHost) GetInventory() OptNilTrashedHostHostInventory {
+	return s.Inventory
 }
 
-// GetPowerCapEnabledState returns the value of PowerCapEnabledState.
-func (s *RedfishSystemOemDell) GetPowerCapEnabledState() OptString {
-	return s.PowerCapEnabledState
+// GetName returns the value of Name.
+func (s *TrashedHostHost) GetName() OptString {
+	return s.Name
 }
 
-// GetSDCardRollupStatus returns the value of SDCardRollupStatus.
-func (s *RedfishSystemOemDell) GetSDCardRollupStatus() OptString {
-	return s.SDCardRollupStatus
+// GetProvision returns the value of Provision.
+func (s *TrashedHostHost) GetProvision() OptBool {
+	return s.Provision
 }
 
-// GetSELRollupStatus returns the value of SELRollupStatus.
-func (s *RedfishSystemOemDell) GetSELRollupStatus() OptString {
-	return s.SELRollupStatus
+// GetRevision returns the value of Revision.
+func (s *TrashedHostHost) GetRevision() OptNilInt64 {
+	return s.Revision
 }
 
-// GetServerAllocationWatts returns the value of ServerAllocationWatts.
-func (s *RedfishSystemOemDell) GetServerAllocationWatts() OptInt {
-	return s.ServerAllocationWatts
+// GetSmbiosUUID returns the value of SmbiosUUID.
+func (s *TrashedHostHost) GetSmbiosUUID() OptString {
+	return s.SmbiosUUID
 }
 
-// GetStorageRollupStatus returns the value of StorageRollupStatus.
-func (s *RedfishSystemOemDell) GetStorageRollupStatus() OptString {
-	return s.StorageRollupStatus
+// GetState returns the value of State.
+func (s *TrashedHostHost) GetState() OptString {
+	return s.State
 }
 
-// GetSysMemErrorMethodology returns the value of SysMemErrorMethodology.
-func (s *RedfishSystemOemDell) GetSysMemErrorMethodology() OptString {
-	return s.SysMemErrorMethodology
+// GetTags returns the value of Tags.
+func (s *TrashedHostHost) GetTags() OptNilStringArray {
+	return s.Tags
 }
 
-// GetSysMemFailOverState returns the value of SysMemFailOverState.
-func (s *RedfishSystemOemDell) GetSysMemFailOverState() OptString {
-	return s.SysMemFailOverState
+// GetUID returns the value of UID.
+func (s *TrashedHostHost) GetUID() OptNilString {
+	return s.UID
 }
 
-// GetSysMemLocation returns the value of SysMemLocation.
-func (s *RedfishSystemOemDell) GetSysMemLocation() OptString {
-	return s.SysMemLocation
+// GetUpdatedAt returns the value of UpdatedAt.
+func (s *TrashedHostHost) GetUpdatedAt() OptNilDateTime {
+	return s.UpdatedAt
 }
 
-// GetSysMemPrimaryStatus returns the value of SysMemPrimaryStatus.
-func (s *RedfishSystemOemDell) GetSysMemPrimaryStatus() OptString {
-	return s.SysMemPrimaryStatus
+// SetBonds sets the value of Bonds.
+func (s *TrashedHostHost) SetBonds(val []NilTrashedHostHostBondsItem) {
+	s.Bonds = val
 }
 
-// GetSystemGeneration returns the value of SystemGeneration.
-func (s *RedfishSystemOemDell) GetSystemGeneration() OptString {
-	return s.SystemGeneration
+// SetBootImage sets the value of BootImage.
+func (s *TrashedHostHost) SetBootImage(val OptString) {
+	s.BootImage = val
 }
 
-// GetSystemID returns the value of SystemID.
-func (s *RedfishSystemOemDell) GetSystemID() OptInt {
-	return s.SystemID
+// SetClientCertFingerprint sets the value of ClientCertFingerprint.
+func (s *TrashedHostHost) SetClientCertFingerprint(val OptString) {
+	s.ClientCertFingerprint = val
 }
 
-// GetSystemRevision returns the value of SystemRevision.
-func (s *RedfishSystemOemDell) GetSystemRevision() OptString {
-	return s.SystemRevision
+// SetClientCertSerial sets the value of ClientCertSerial.
+func (s *TrashedHostHost) SetClientCertSerial(val OptString) {
+	s.ClientCertSerial = val
 }
 
-// GetTempRollupStatus returns the value of TempRollupStatus.
-func (s *RedfishSystemOemDell) GetTempRollupStatus() OptString {
-	return s.TempRollupStatus
+// SetCreatedAt sets the value of CreatedAt.
+func (s *TrashedHostHost) SetCreatedAt(val OptNilDateTime) {
+	s.CreatedAt = val
 }
 
-// GetTempStatisticsRollupStatus returns the value of TempStatisticsRollupStatus.
-func (s *RedfishSystemOemDell) GetTempStatisticsRollupStatus() OptString {
-	return s.TempStatisticsRollupStatus
+// SetFirmware sets the value of Firmware.
+func (s *TrashedHostHost) SetFirmware(val OptString) {
+	s.Firmware = val
 }
 
-// GetUUID returns the value of UUID.
-func (s *RedfishSystemOemDell) GetUUID() OptString {
-	return s.UUID
+// SetHardware sets the value of Hardware.
+func (s *TrashedHostHost) SetHardware(val OptNilTrashedHostHostHardware) {
+	s.Hardware = val
 }
 
-// GetVoltRollupStatus returns the value of VoltRollupStatus.
-func (s *RedfishSystemOemDell) GetVoltRollupStatus() OptString {
-	return s.VoltRollupStatus
+// SetID sets the value of ID.
+func (s *TrashedHostHost) SetID(val OptNilInt64) {
+	s.ID = val
 }
 
-// GetSmbiosGUID returns the value of SmbiosGUID.
-func (s *RedfishSystemOemDell) GetSmbiosGUID() OptString {
-	return s.SmbiosGUID
+// SetInterfaces sets the value of Interfaces.
+func (s *TrashedHostHost) SetInterfaces(val []NilTrashedHostHostInterfacesItem) {
+	s.Interfaces = val
 }
 
-// SetMessageDotExtendedInfo sets the value of MessageDotExtendedInfo.
-func (s *RedfishSystemOemDell) SetMessageDotExtendedInfo(val OptNilRedfishSystemOemDellMessageDotExtendedInfoItemArray) {
-	s.MessageDotExtendedInfo = val
+// SetInventory sets the value of Inventory.
+func (s *TrashedHostHost) SetInventory(val OptNilTrashedHostHostInventory) {
+	s.Inventory = val
 }
 
-// SetOdataDotContext sets the value of OdataDotContext.
-func (s *RedfishSystemOemDell) SetOdataDotContext(val OptString) {
-	s.OdataDotContext = val
+// SetName sets the value of Name.
+func (s *TrashedHostHost) SetName(val OptString) {
+	s.Name = val
 }
 
-// SetOdataDotEtag sets the value of OdataDotEtag.
-func (s *RedfishSystemOemDell) SetOdataDotEtag(val OptNilString) {
-	s.OdataDotEtag = val
+// SetProvision sets the value of Provision.
+func (s *TrashedHostHost) SetProvision(val OptBool) {
+	s.Provision = val
 }
 
-// SetOdataDotID sets the value of OdataDotID.
-func (s *RedfishSystemOemDell) SetOdataDotID(val OptString) {
-	s.OdataDotID = val
+// SetRevision sets the value of Revision.
+func (s *TrashedHostHost) SetRevision(val OptNilInt64) {
+	s.Revision = val
 }
 
-// SetOdataDotType sets the value of OdataDotType.
-func (s *RedfishSystemOemDell) SetOdataDotType(val OptString) {
-	s.OdataDotType = val
+// SetSmbiosUUID sets the value of SmbiosUUID.
+func (s *TrashedHostHost) SetSmbiosUUID(val OptString) {
+	s.SmbiosUUID = val
+}
+
+// SetState sets the value of State.
+func (s *TrashedHostHost) SetState(val OptString) {
+	s.State = val
+}
+
+// SetTags sets the value of Tags.
+func (s *TrashedHostHost) SetTags(val OptNilStringArray) {
+	s.Tags = val
+}
+
+// SetUID sets the value of UID.
+func (s *TrashedHostHost) SetUID(val OptNilString) {
+	s.UID = val
 }
 
-// SetBIOSReleaseDate sets the value of BIOSReleaseDate.
-func (s *RedfishSystemOemDell) SetBIOSReleaseDate(val OptString) {
-	s.BIOSReleaseDate = val
+// SetUpdatedAt sets the value of UpdatedAt.
+func (s *TrashedHostHost) SetUpdatedAt(val OptNilDateTime) {
+	s.UpdatedAt = val
 }
 
-// SetBaseBoardChassisSlot sets the value of BaseBoardChassisSlot.
-func (s *RedfishSystemOemDell) SetBaseBoardChassisSlot(val OptString) {
-	s.BaseBoardChassisSlot = val
+type TrashedHostHostBondsItem struct {
+	Addresses []TrashedHostHostBondsItemAddressesItem `json:"addresses"`
+	Bmc       OptBool                                 `json:"bmc"`
+	Fqdn      OptString                               `json:"fqdn"`
+	ID        OptNilInt64                             `json:"id"`
+	Ifname    OptString                               `json:"ifname"`
+	IP        OptString                               `json:"ip"`
+	MAC       OptString                               `json:"mac"`
+	Mode      OptString                               `json:"mode"`
+	Mtu       OptInt                                  `json:"mtu"`
+	Options   OptTrashedHostHostBondsItemOptions      `json:"options"`
+	Parent    OptString                               `json:"parent"`
+	Peers     []string                                `json:"peers"`
+	Port      OptInt                                  `json:"port"`
+	Switch    OptString                               `json:"switch"`
+	Type      OptString                               `json:"type"`
+	Vlan      OptInt                                  `json:"vlan"`
 }
 
-// SetBatteryRollupStatus sets the value of BatteryRollupStatus.
-func (s *RedfishSystemOemDell) SetBatteryRollupStatus(val OptString) {
-	s.BatteryRollupStatus = val
+// GetAddresses returns the value of Addresses.
+func (s *TrashedHostHostBondsItem) GetAddresses() []TrashedHostHostBondsItemAddressesItem {
+	return s.Addresses
 }
 
-// SetBladeGeometry sets the value of BladeGeometry.
-func (s *RedfishSystemOemDell) SetBladeGeometry(val OptString) {
-	s.BladeGeometry = val
+// GetBmc returns the value of Bmc.
+func (s *TrashedHostHostBondsItem) GetBmc() OptBool {
+	return s.Bmc
 }
 
-// SetCMCIP sets the value of CMCIP.
-func (s *RedfishSystemOemDell) SetCMCIP(val OptString) {
-	s.CMCIP = val
+// GetFqdn returns the value of Fqdn.
+func (s *TrashedHostHostBondsItem) GetFqdn() OptString {
+	return s.Fqdn
 }
 
-// SetCPURollupStatus sets the value of CPURollupStatus.
-func (s *RedfishSystemOemDell) SetCPURollupStatus(val OptString) {
-	s.CPURollupStatus = val
+// GetID returns the value of ID.
+func (s *TrashedHostHostBondsItem) GetID() OptNilInt64 {
+	return s.ID
 }
 
-// SetChassisModel sets the value of ChassisModel.
-func (s *RedfishSystemOemDell) SetChassisModel(val OptString) {
-	s.ChassisModel = val
+// GetIfname returns the value of Ifname.
+func (s *TrashedHostHostBondsItem) GetIfname() OptString {
+	return s.Ifname
 }
 
-// SetChassisName sets the value of ChassisName.
-func (s *RedfishSystemOemDell) SetChassisName(val OptString) {
-	s.ChassisName = val
+// GetIP returns the value of IP.
+func (s *TrashedHostHostBondsItem) GetIP() OptString {
+	return s.IP
 }
 
-// SetChassisServiceTag sets the value of ChassisServiceTag.
-func (s *RedfishSystemOemDell) SetChassisServiceTag(val OptString) {
-	s.ChassisServiceTag = val
+// GetMAC returns the value of MAC.
+func (s *TrashedHostHostBondsItem) GetMAC() OptString {
+	return s.MAC
 }
 
-// SetChassisSystemHeightUnit sets the value of ChassisSystemHeightUnit.
-func (s *RedfishSystemOemDell) SetChassisSystemHeightUnit(val OptInt) {
-	s.ChassisSystemHeightUnit = val
+// GetMode returns the value of Mode.
+func (s *TrashedHostHostBondsItem) GetMode() OptString {
+	return s.Mode
 }
 
-// SetCurrentRollupStatus sets the value of CurrentRollupStatus.
-func (s *RedfishSystemOemDell) SetCurrentRollupStatus(val OptString) {
-	s.CurrentRollupStatus = val
+// GetMtu returns the value of Mtu.
+func (s *TrashedHostHostBondsItem) GetMtu() OptInt {
+	return s.Mtu
 }
 
-// SetDescription sets the value of Description.
-func (s *RedfishSystemOemDell) SetDescription(val OptString) {
-	s.Description = val
+// GetOptions returns the value of Options.
+func (s *TrashedHostHostBondsItem) GetOptions() OptTrashedHostHostBondsItemOptions {
+	return s.Options
 }
 
-// SetEstimatedExhaustTemperatureCelsius sets the value of EstimatedExhaustTemperatureCelsius.
-func (s *RedfishSystemOemDell) SetEstimatedExhaustTemperatureCelsius(val OptInt) {
-	s.EstimatedExhaustTemperatureCelsius = val
+// GetParent returns the value of Parent.
+func (s *TrashedHostHostBondsItem) GetParent() OptString {
+	return s.Parent
 }
 
-// SetEstimatedSystemAirflowCFM sets the value of EstimatedSystemAirflowCFM.
-func (s *RedfishSystemOemDell) SetEstimatedSystemAirflowCFM(val OptInt) {
-	s.EstimatedSystemAirflowCFM = val
+// GetPeers returns the value of Peers.
+func (s *TrashedHostHostBondsItem) GetPeers() []string {
+	return s.Peers
 }
 
-// SetExpressServiceCode sets the value of ExpressServiceCode.
-func (s *RedfishSystemOemDell) SetExpressServiceCode(val OptString) {
-	s.ExpressServiceCode = val
+// GetPort returns the value of Port.
+func (s *TrashedHostHostBondsItem) GetPort() OptInt {
+	return s.Port
 }
 
-// SetFanRollupStatus sets the value of FanRollupStatus.
-func (s *RedfishSystemOemDell) SetFanRollupStatus(val OptString) {
-	s.FanRollupStatus = val
+// GetSwitch returns the value of Switch.
+func (s *TrashedHostHostBondsItem) GetSwitch() OptString {
+	return s.Switch
 }
 
-// SetIDSDMRollupStatus sets the value of IDSDMRollupStatus.
-func (s *RedfishSystemOemDell) SetIDSDMRollupStatus(val OptString) {
-	s.IDSDMRollupStatus = val
+// GetType returns the value of Type.
+func (s *TrashedHostHostBondsItem) GetType() OptString {
+	return s.Type
 }
 
-// SetID sets the value of ID.
-func (s *RedfishSystemOemDell) SetID(val OptString) {
-	s.ID = val
+// GetVlan returns the value of Vlan.
+func (s *TrashedHostHostBondsItem) GetVlan() OptInt {
+	return s.Vlan
 }
 
-// SetIntrusionRollupStatus sets the value of IntrusionRollupStatus.
-func (s *RedfishSystemOemDell) SetIntrusionRollupStatus(val OptString) {
-	s.IntrusionRollupStatus = val
+// SetAddresses sets the value of Addresses.
+func (s *TrashedHostHostBondsItem) SetAddresses(val []TrashedHostHostBondsItemAddressesItem) {
+	s.Addresses = val
 }
 
-// SetIsOEMBranded sets the value of IsOEMBranded.
-func (s *RedfishSystemOemDell) SetIsOEMBranded(val OptString) {
-	s.IsOEMBranded = val
+// SetBmc sets the value of Bmc.
+func (s *TrashedHostHostBondsItem) SetBmc(val OptBool) {
+	s.Bmc = val
 }
 
-// SetLastSystemInventoryTime sets the value of LastSystemInventoryTime.
-func (s *RedfishSystemOemDell) SetLastSystemInventoryTime(val OptString) {
-	s.LastSystemInventoryTime = val
+// SetFqdn sets the value of Fqdn.
+func (s *TrashedHostHostBondsItem) SetFqdn(val OptString) {
+	s.Fqdn = val
 }
 
-// SetLastUpdateTime sets the value of LastUpdateTime.
-func (s *RedfishSystemOemDell) SetLastUpdateTime(val OptString) {
-	s.LastUpdateTime = val
+// SetID sets the value of ID.
+func (s *TrashedHostHostBondsItem) SetID(val OptNilInt64) {
+	s.ID = val
 }
 
-// SetLicensingRollupStatus sets the value of LicensingRollupStatus.
-func (s *RedfishSystemOemDell) SetLicensingRollupStatus(val OptString) {
-	s.LicensingRollupStatus = val
+// SetIfname sets the value of Ifname.
+func (s *TrashedHostHostBondsItem) SetIfname(val OptString) {
+	s.Ifname = val
 }
 
-// SetManagedSystemSize sets the value of ManagedSystemSize.
-func (s *RedfishSystemOemDell) SetManagedSystemSize(val OptString) {
-	s.ManagedSystemSize = val
+// SetIP sets the value of IP.
+func (s *TrashedHostHostBondsItem) SetIP(val OptString) {
+	s.IP = val
 }
 
-// SetMaxCPUSockets sets the value of MaxCPUSockets.
-func (s *RedfishSystemOemDell) SetMaxCPUSockets(val OptInt) {
-	s.MaxCPUSockets = val
+// SetMAC sets the value of MAC.
+func (s *TrashedHostHostBondsItem) SetMAC(val OptString) {
+	s.MAC = val
 }
 
-// SetMaxDIMMSlots sets the value of MaxDIMMSlots.
-func (s *RedfishSystemOemDell) SetMaxDIMMSlots(val OptInt) {
-	s.MaxDIMMSlots = val
+// SetMode sets the value of Mode.
+func (s *TrashedHostHostBondsItem) SetMode(val OptString) {
+	s.Mode = val
 }
 
-// SetMaxPCIeSlots sets the value of MaxPCIeSlots.
-func (s *RedfishSystemOemDell) SetMaxPCIeSlots(val OptInt) {
-	s.MaxPCIeSlots = val
+// SetMtu sets the value of Mtu.
+func (s *TrashedHostHostBondsItem) SetMtu(val OptInt) {
+	s.Mtu = val
 }
 
-// SetMemoryOperationMode sets the value of MemoryOperationMode.
-func (s *RedfishSystemOemDell) SetMemoryOperationMode(val OptString) {
-	s.MemoryOperationMode = val
+// SetOptions sets the value of Options.
+func (s *TrashedHostHostBondsItem) SetOptions(val OptTrashedHostHostBondsItemOptions) {
+	s.Options = val
 }
 
-// SetName sets the value of Name.
-func (s *RedfishSystemOemDell) SetName(val OptString) {
-	s.Name = val
+// SetParent sets the value of Parent.
+func (s *TrashedHostHostBondsItem) SetParent(val OptString) {
+	s.Parent = val
 }
 
-// SetNodeID sets the value of NodeID.
-func (s *RedfishSystemOemDell) SetNodeID(val OptString) {
-	s.NodeID = val
+// SetPeers sets the value of Peers.
+func (s *TrashedHostHostBondsItem) SetPeers(val []string) {
+	s.Peers = val
 }
 
-// SetOem sets the value of Oem.
-func (s *RedfishSystemOemDell) SetOem(val jx.Raw) {
-	s.Oem = val
+// SetPort sets the value of Port.
+func (s *TrashedHostHostBondsItem) SetPort(val OptInt) {
+	s.Port = val
 }
 
-// SetPSRollupStatus sets the value of PSRollupStatus.
-func (s *RedfishSystemOemDell) SetPSRollupStatus(val OptString) {
-	s.PSRollupStatus = val
+// SetSwitch sets the value of Switch.
+func (s *TrashedHostHostBondsItem) SetSwitch(val OptString) {
+	s.Switch = val
 }
 
-// SetPlatformGUID sets the value of PlatformGUID.
-func (s *RedfishSystemOemDell) SetPlatformGUID(val OptString) {
-	s.PlatformGUID = val
+// SetType sets the value of Type.
+func (s *TrashedHostHostBondsItem) SetType(val OptString) {
+	s.Type = val
 }
 
-// SetPopulatedDIMMSlots sets the value of PopulatedDIMMSlots.
-func (s *RedfishSystemOemDell) SetPopulatedDIMMSlots(val OptInt) {
-	s.PopulatedDIMMSlots = val
+// SetVlan sets the value of Vlan.
+func (s *TrashedHostHostBondsItem) SetVlan(val OptInt) {
+	s.Vlan = val
 }
 
-// SetPopulatedPCIeSlots sets the value of PopulatedPCIeSlots.
-func (s *RedfishSystemOemDell) SetPopulatedPCIeSlots(val OptInt) {
-	s.PopulatedPCIeSlots = val
+type TrashedHostHostBondsItemAddressesItem struct {
+	Fqdn    OptString `json:"fqdn"`
+	IP      OptString `json:"ip"`
+	Primary OptBool   `json:"primary"`
 }
 
-// SetPowerCapEnabledState sets the value of PowerCapEnabledState.
-func (s *RedfishSystemOemDell) SetPowerCapEnabledState(val OptString) {
-	s.PowerCapEnabledState = val
+// GetFqdn returns the value of Fqdn.
+func (s *TrashedHostHostBondsItemAddressesItem) GetFqdn() OptString {
+	return s.Fqdn
 }
 
-// SetSDCardRollupStatus sets the value of SDCardRollupStatus.
-func (s *RedfishSystemOemDell) SetSDCardRollupStatus(val OptString) {
-	s.SDCardRollupStatus = val
+// GetIP returns the value of IP.
+func (s *TrashedHostHostBondsItemAddressesItem) GetIP() OptString {
+	return s.IP
 }
 
-// SetSELRollupStatus sets the value of SELRollupStatus.
-func (s *RedfishSystemOemDell) SetSELRollupStatus(val OptString) {
-	s.SELRollupStatus = val
+// GetPrimary returns the value of Primary.
+func (s *TrashedHostHostBondsItemAddressesItem) GetPrimary() OptBool {
+	return s.Primary
 }
 
-// SetServerAllocationWatts sets the value of ServerAllocationWatts.
-func (s *RedfishSystemOemDell) SetServerAllocationWatts(val OptInt) {
-	s.ServerAllocationWatts = val
+// SetFqdn sets the value of Fqdn.
+func (s *TrashedHostHostBondsItemAddressesItem) SetFqdn(val OptString) {
+	s.Fqdn = val
 }
 
-// SetStorageRollupStatus sets the value of StorageRollupStatus.
-func (s *RedfishSystemOemDell) SetStorageRollupStatus(val OptString) {
-	s.StorageRollupStatus = val
+// SetIP sets the value of IP.
+func (s *TrashedHostHostBondsItemAddressesItem) SetIP(val OptString) {
+	s.IP = val
 }
 
-// SetSysMemErrorMethodology sets the value of SysMemErrorMethodology.
-func (s *RedfishSystemOemDell) SetSysMemErrorMethodology(val OptString) {
-	s.SysMemErrorMethodology = val
+// SetPrimary sets the value of Primary.
+func (s *TrashedHostHostBondsItemAddressesItem) SetPrimary(val OptBool) {
+	s.Primary = val
 }
 
-// SetSysMemFailOverState sets the value of SysMemFailOverState.
-func (s *RedfishSystemOemDell) SetSysMemFailOverState(val OptString) {
-	s.SysMemFailOverState = val
+type TrashedHostHostBondsItemOptions map[string]string
+
+func (s *TrashedHostHostBondsItemOptions) init() TrashedHostHostBondsItemOptions {
+	m := *s
+	if m == nil {
+		m = map[string]string{}
+		*s = m
+	}
+	return m
 }
 
-// SetSysMemLocation sets the value of SysMemLocation.
-func (s *RedfishSystemOemDell) SetSysMemLocation(val OptString) {
-	s.SysMemLocation = val
+type TrashedHostHostHardware struct {
+	CollectedAt OptNilDateTime                                 `json:"collected_at"`
+	CoreCount   OptInt                                         `json:"core_count"`
+	CPUCount    OptInt                                         `json:"cpu_count"`
+	CPUModel    OptString                                      `json:"cpu_model"`
+	Dimms       OptNilNilTrashedHostHostHardwareDimmsItemArray `json:"dimms"`
+	Disks       OptNilNilTrashedHostHostHardwareDisksItemArray `json:"disks"`
+	MemoryMib   OptInt                                         `json:"memory_mib"`
+	Missing     OptNilStringArray                              `json:"missing"`
+	Nics        OptNilNilTrashedHostHostHardwareNicsItemArray  `json:"nics"`
 }
 
-// SetSysMemPrimaryStatus sets the value of SysMemPrimaryStatus.
-func (s *RedfishSystemOemDell) SetSysMemPrimaryStatus(val OptString) {
-	s.SysMemPrimaryStatus = val
+// GetCollectedAt returns the value of CollectedAt.
+func (s *TrashedHostHostHardware) GetCollectedAt() OptNilDateTime {
+	return s.CollectedAt
 }
 
-// SetSystemGeneration sets the value of SystemGeneration.
-func (s *RedfishSystemOemDell) SetSystemGeneration(val OptString) {
-	s.SystemGeneration = val
+// GetCoreCount returns the value of CoreCount.
+func (s *TrashedHostHostHardware) GetCoreCount() OptInt {
+	return s.CoreCount
 }
 
-// SetSystemID sets the value of SystemID.
-func (s *RedfishSystemOemDell) SetSystemID(val OptInt) {
-	s.SystemID = val
+// GetCPUCount returns the value of CPUCount.
+func (s *TrashedHostHostHardware) GetCPUCount() OptInt {
+	return s.CPUCount
 }
 
-// SetSystemRevision sets the value of SystemRevision.
-func (s *RedfishSystemOemDell) SetSystemRevision(val OptString) {
-	s.SystemRevision = val
+// GetCPUModel returns the value of CPUModel.
+func (s *TrashedHostHostHardware) GetCPUModel() OptString {
+	return s.CPUModel
 }
 
-// SetTempRollupStatus sets the value of TempRollupStatus.
-func (s *RedfishSystemOemDell) SetTempRollupStatus(val OptString) {
-	s.TempRollupStatus = val
+// GetDimms returns the value of Dimms.
+func (s *TrashedHostHostHardware) GetDimms() OptNilNilTrashedHostHostHardwareDimmsItemArray {
+	return s.Dimms
 }
 
-// SetTempStatisticsRollupStatus sets the value of TempStatisticsRollupStatus.
-func (s *RedfishSystemOemDell) SetTempStatisticsRollupStatus(val OptString) {
-	s.TempStatisticsRollupStatus = val
+// GetDisks returns the value of Disks.
+func (s *TrashedHostHostHardware) GetDisks() OptNilNilTrashedHostHostHardwareDisksItemArray {
+	return s.Disks
 }
 
-// SetUUID sets the value of UUID.
-func (s *RedfishSystemOemDell) SetUUID(val OptString) {
-	s.UUID = val
+// GetMemoryMib returns the value of MemoryMib.
+func (s *TrashedHostHostHardware) GetMemoryMib() OptInt {
+	return s.MemoryMib
 }
 
-// SetVoltRollupStatus sets the value of VoltRollupStatus.
-func (s *RedfishSystemOemDell) SetVoltRollupStatus(val OptString) {
-	s.VoltRollupStatus = val
+// GetMissing returns the value of Missing.
+func (s *TrashedHostHostHardware) GetMissing() OptNilStringArray {
+	return s.Missing
 }
 
-// SetSmbiosGUID sets the value of SmbiosGUID.
-func (s *RedfishSystemOemDell) SetSmbiosGUID(val OptString) {
-	s.SmbiosGUID = val
+// GetNics returns the value of Nics.
+func (s *TrashedHostHostHardware) GetNics() OptNilNilTrashedHostHostHardwareNicsItemArray {
+	return s.Nics
 }
 
-type RedfishSystemOemDellMessageDotExtendedInfoItem struct {
-	Message                  OptString                                                           `json:"Message"`
-	MessageArgs              []string                                                            `json:"MessageArgs"`
-	MessageId                OptString                                                           `json:"MessageId"`
-	MessageSeverity          OptString                                                           `json:"MessageSeverity"`
-	Oem                      jx.Raw                                                              `json:"Oem"`
-	RelatedProperties        OptNilNilStringArray                                                `json:"RelatedProperties"`
-	Resolution               OptString                                                           `json:"Resolution"`
-	ResolutionSteps          []RedfishSystemOemDellMessageDotExtendedInfoItemResolutionStepsItem `json:"ResolutionSteps"`
-	Severity                 OptString                                                           `json:"Severity"`
-	UserAuthenticationSource OptString                                                           `json:"UserAuthenticationSource"`
-	Username                 OptString                                                           `json:"Username"`
+// SetCollectedAt sets the value of CollectedAt.
+func (s *TrashedHostHostHardware) SetCollectedAt(val OptNilDateTime) {
+	s.CollectedAt = val
 }
 
-// GetMessage returns the value of Message.
-func (s *RedfishSystemOemDellMessageDotExtendedInfoItem) GetMessage() OptString {
-	return s.Message
+// SetCoreCount sets the value of CoreCount.
+func (s *TrashedHostHostHardware) SetCoreCount(val OptInt) {
+	s.CoreCount = val
 }
 
-// GetMessageArgs returns the value of MessageArgs.
-func (s *RedfishSystemOemDellMessageDotExtendedInfoItem) GetMessageArgs() []string {
-	return s.MessageArgs
+// SetCPUCount sets the value of CPUCount.
+func (s *TrashedHostHostHardware) SetCPUCount(val OptInt) {
+	s.CPUCount = val
 }
 
-// GetMessageId returns the value of MessageId.
-func (s *RedfishSystemOemDellMessageDotExtendedInfoItem) GetMessageId() OptString {
-	return s.MessageId
+// SetCPUModel sets the value of CPUModel.
+func (s *TrashedHostHostHardware) SetCPUModel(val OptString) {
+	s.CPUModel = val
 }
 
-// GetMessageSeverity returns the value of MessageSeverity.
-func (s *RedfishSystemOemDellMessageDotExtendedInfoItem) GetMessageSeverity() OptString {
-	return s.MessageSeverity
+// SetDimms sets the value of Dimms.
+func (s *TrashedHostHostHardware) SetDimms(val OptNilNilTrashedHostHostHardwareDimmsItemArray) {
+	s.Dimms = val
 }
 
-// GetOem returns the value of Oem.
-func (s *RedfishSystemOemDellMessageDotExtendedInfoItem) GetOem() jx.Raw {
-	return s.Oem
+// SetDisks sets the value of Disks.
+func (s *TrashedHostHostHardware) SetDisks(val OptNilNilTrashedHostHostHardwareDisksItemArray) {
+	s.Disks = val
 }
 
-// GetRelatedProperties returns the value of RelatedProperties.
-func (s *RedfishSystemOemDellMessageDotExtendedInfoItem) GetRelatedProperties() OptNilNilStringArray {
-	return s.RelatedProperties
+// SetMemoryMib sets the value of MemoryMib.
+func (s *TrashedHostHostHardware) SetMemoryMib(val OptInt) {
+	s.MemoryMib = val
 }
 
-// GetResolution returns the value of Resolution.
-func (s *RedfishSystemOemDellMessageDotExtendedInfoItem) GetResolution() OptString {
-	return s.Resolution
+// SetMissing sets the value of Missing.
+func (s *TrashedHostHostHardware) SetMissing(val OptNilStringArray) {
+	s.Missing = val
 }
 
-// GetResolutionSteps returns the value of ResolutionSteps.
-func (s *RedfishSystemOemDellMessageDotExtendedInfoItem) GetResolutionSteps() []RedfishSystemOemDellMessageDotExtendedInfoItemResolutionStepsItem {
-	return s.ResolutionSteps
+// SetNics sets the value of Nics.
+func (s *TrashedHostHostHardware) SetNics(val OptNilNilTrashedHostHostHardwareNicsItemArray) {
+	s.Nics = val
 }
 
-// GetSeverity returns the value of Severity.
-func (s *RedfishSystemOemDellMessageDotExtendedInfoItem) GetSeverity() OptString {
-	return s.Severity
+type TrashedHostHostHardwareDimmsItem struct {
+	SizeMib OptInt    `json:"size_mib"`
+	Slot    OptString `json:"slot"`
 }
 
-// GetUserAuthenticationSource returns the value of UserAuthenticationSource.
-func (s *RedfishSystemOemDellMessageDotExtendedInfoItem) GetUserAuthenticationSource() OptString {
-	return s.UserAuthenticationSource
+// GetSizeMib returns the value of SizeMib.
+func (s *TrashedHostHostHardwareDimmsItem) GetSizeMib() OptInt {
+	return s.SizeMib
 }
 
-// GetUsername returns the value of Username.
-func (s *RedfishSystemOemDellMessageDotExtendedInfoItem) GetUsername() OptString {
-	return s.Username
+// GetSlot returns the value of Slot.
+func (s *TrashedHostHostHardwareDimmsItem) GetSlot() OptString {
+	return s.Slot
+}
+
+// SetSizeMib sets the value of SizeMib.
+func (s *TrashedHostHostHardwareDimmsItem) SetSizeMib(val OptInt) {
+	s.SizeMib = val
 }
 
-// SetMessage sets the value of Message.
-func (s *RedfishSystemOemDellMessageDotExtendedInfoItem) SetMessage(val OptString) {
-	s.Message = val
+// SetSlot sets the value of Slot.
+func (s *TrashedHostHostHardwareDimmsItem) SetSlot(val OptString) {
+	s.Slot = val
 }
 
-// SetMessageArgs sets the value of MessageArgs.
-func (s *RedfishSystemOemDellMessageDotExtendedInfoItem) SetMessageArgs(val []string) {
-	s.MessageArgs = val
+type TrashedHostHostHardwareDisksItem struct {
+	Model     OptString `json:"model"`
+	Name      OptString `json:"name"`
+	Serial    OptString `json:"serial"`
+	SizeBytes OptInt64  `json:"size_bytes"`
 }
 
-// SetMessageId sets the value of MessageId.
-func (s *RedfishSystemOemDellMessageDotExtendedInfoItem) SetMessageId(val OptString) {
-	s.MessageId = val
+// GetModel returns the value of Model.
+func (s *TrashedHostHostHardwareDisksItem) GetModel() OptString {
+	return s.Model
 }
 
-// SetMessageSeverity sets the value of MessageSeverity.
-func (s *RedfishSystemOemDellMessageDotExtendedInfoItem) SetMessageSeverity(val OptString) {
-	s.MessageSeverity = val
+// GetName returns the value of Name.
+func (s *TrashedHostHostHardwareDisksItem) GetName() OptString {
+	return s.Name
 }
 
-// SetOem sets the value of Oem.
-func (s *RedfishSystemOemDellMessageDotExtendedInfoItem) SetOem(val jx.Raw) {
-	s.Oem = val
+// GetSerial returns the value of Serial.
+func (s *TrashedHostHostHardwareDisksItem) GetSerial() OptString {
+	return s.Serial
 }
 
-// SetRelatedProperties sets the value of RelatedProperties.
-func (s *RedfishSystemOemDellMessageDotExtendedInfoItem) SetRelatedProperties(val OptNilNilStringArray) {
-	s.RelatedProperties = val
+// GetSizeBytes returns the value of SizeBytes.
+func (s *TrashedHostHostHardwareDisksItem) GetSizeBytes() OptInt64 {
+	return s.SizeBytes
 }
 
-// SetResolution sets the value of Resolution.
-func (s *RedfishSystemOemDellMessageDotExtendedInfoItem) SetResolution(val OptString) {
-	s.Resolution = val
+// SetModel sets the value of Model.
+func (s *TrashedHostHostHardwareDisksItem) SetModel(val OptString) {
+	s.Model = val
 }
 
-// SetResolutionSteps sets the value of ResolutionSteps.
-func (s *RedfishSystemOemDellMessageDotExtendedInfoItem) SetResolutionSteps(val []RedfishSystemOemDellMessageDotExtendedInfoItemResolutionStepsItem) {
-	s.ResolutionSteps = val
+// SetName sets the value of Name.
+func (s *TrashedHostHostHardwareDisksItem) SetName(val OptString) {
+	s.Name = val
 }
 
-// SetSeverity sets the value of Severity.
-func (s *RedfishSystemOemDellMessageDotExtendedInfoItem) SetSeverity(val OptString) {
-	s.Severity = val
+// SetSerial sets the value of Serial.
+func (s *TrashedHostHostHardwareDisksItem) SetSerial(val OptString) {
+	s.Serial = val
 }
 
-// SetUserAuthenticationSource sets the value of UserAuthenticationSource.
-func (s *RedfishSystemOemDellMessageDotExtendedInfoItem) SetUserAuthenticationSource(val OptString) {
-	s.UserAuthenticationSource = val
+// SetSizeBytes sets the value of SizeBytes.
+func (s *TrashedHostHostHardwareDisksItem) SetSizeBytes(val OptInt64) {
+	s.SizeBytes = val
 }
 
-// SetUsername sets the value of Username.
-func (s *RedfishSystemOemDellMessageDotExtendedInfoItem) SetUsername(val OptString) {
-	s.Username = val
+type TrashedHostHostHardwareNicsItem struct {
+	MAC  OptString `json:"mac"`
+	Name OptString `json:"name"`
 }
 
-type RedfishSystemOemDellMessageDotExtendedInfoItemResolutionStepsItem struct {
-	ActionParameters     []RedfishSystemOemDellMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem `json:"ActionParameters"`
-	ActionURI            OptString                                                                               `json:"ActionURI"`
-	Oem                  jx.Raw                                                                                  `json:"Oem"`
-	Priority             OptNilInt                                                                               `json:"Priority"`
-	ResolutionType       OptString                                                                               `json:"ResolutionType"`
-	RetryCount           OptNilInt                                                                               `json:"RetryCount"`
-	RetryIntervalSeconds OptNilInt                                                                               `json:"RetryIntervalSeconds"`
-	TargetComponentURI   OptString                                                                               `json:"TargetComponentURI"`
+// GetMAC returns the value of MAC.
+func (s *TrashedHostHostHardwareNicsItem) GetMAC() OptString {
+	return s.MAC
 }
 
-// GetActionParameters returns the value of ActionParameters.
-func (s *RedfishSystemOemDellMessageDotExtendedInfoItemResolutionStepsItem) GetActionParameters() []RedfishSystemOemDellMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem {
-	return s.ActionParameters
+// GetName returns the value of Name.
+func (s *TrashedHostHostHardwareNicsItem) GetName() OptString {
+	return s.Name
 }
 
-// GetActionURI returns the value of ActionURI.
-func (s *RedfishSystemOemDellMessageDotExtendedInfoItemResolutionStepsItem) GetActionURI() OptString {
-	return s.ActionURI
+// SetMAC sets the value of MAC.
+func (s *TrashedHostHostHardwareNicsItem) SetMAC(val OptString) {
+	s.MAC = val
 }
 
-// GetOem returns the value of Oem.
-func (s *RedfishSystemOemDellMessageDotExtendedInfoItemResolutionStepsItem) GetOem() jx.Raw {
-	return s.Oem
+// SetName sets the value of Name.
+func (s *TrashedHostHostHardwareNicsItem) SetName(val OptString) {
+	s.Name = val
 }
 
-// GetPriority returns the value of Priority.
-func (s *RedfishSystemOemDellMessageDotExtendedInfoItemResolutionStepsItem) GetPriority() OptNilInt {
-	return s.Priority
+type TrashedHostHostInterfacesItem struct {
+	Addresses []TrashedHostHostInterfacesItemAddressesItem `json:"addresses"`
+	Bmc       OptBool                                      `json:"bmc"`
+	Fqdn      OptString                                    `json:"fqdn"`
+	ID        OptNilInt64                                  `json:"id"`
+	Ifname    OptString                                    `json:"ifname"`
+	IP        OptString                                    `json:"ip"`
+	MAC       OptString                                    `json:"mac"`
+	Mtu       OptInt                                       `json:"mtu"`
+	Parent    OptString                                    `json:"parent"`
+	Port      OptInt                                       `json:"port"`
+	Switch    OptString                                    `json:"switch"`
+	Vlan      OptInt                                       `json:"vlan"`
 }
 
-// GetResolutionType returns the value of ResolutionType.
-func (s *RedfishSystemOemDellMessageDotExtendedInfoItemResolutionStepsItem) GetResolutionType() OptString {
-	return s.ResolutionType
+// GetAddresses returns the value of Addresses.
+func (s *TrashedHostHostInterfacesItem) GetAddresses() []TrashedHostHostInterfacesItemAddressesItem {
+	return s.Addresses
 }
 
-// GetRetryCount returns the value of RetryCount.
-func (s *RedfishSystemOemDellMessageDotExtendedInfoItemResolutionStepsItem) GetRetryCount() OptNilInt {
-	return s.RetryCount
+// GetBmc returns the value of Bmc.
+func (s *TrashedHostHostInterfacesItem) GetBmc() OptBool {
+	return s.Bmc
 }
 
-// GetRetryIntervalSeconds returns the value of RetryIntervalSeconds.
-func (s *RedfishSystemOemDellMessageDotExtendedInfoItemResolutionStepsItem) GetRetryIntervalSeconds() OptNilInt {
-	return s.RetryIntervalSeconds
+// GetFqdn returns the value of Fqdn.
+func (s *TrashedHostHostInterfacesItem) GetFqdn() OptString {
+	return s.Fqdn
 }
 
-// GetTargetComponentURI returns the value of TargetComponentURI.
-func (s *RedfishSystemOemDellMessageDotExtendedInfoItemResolutionStepsItem) GetTargetComponentURI() OptString {
-	return s.TargetComponentURI
+// GetID returns the value of ID.
+func (s *TrashedHostHostInterfacesItem) GetID() OptNilInt64 {
+	return s.ID
 }
 
-// SetActionParameters sets the value of ActionParameters.
-func (s *RedfishSystemOemDellMessageDotExtendedInfoItemResolutionStepsItem) SetActionParameters(val []RedfishSystemOemDellMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) {
-	s.ActionParameters = val
+// GetIfname returns the value of Ifname.
+func (s *TrashedHostHostInterfacesItem) GetIfname() OptString {
+	return s.Ifname
 }
 
-// SetActionURI sets the value of ActionURI.
-func (s *RedfishSystemOemDellMessageDotExtendedInfoItemResolutionStepsItem) SetActionURI(val OptString) {
-	s.ActionURI = val
+// GetIP returns the value of IP.
+func (s *TrashedHostHostInterfacesItem) GetIP() OptString {
+	return s.IP
 }
 
-// SetOem sets the value of Oem.
-func (s *RedfishSystemOemDellMessageDotExtendedInfoItemResolutionStepsItem) SetOem(val jx.Raw) {
-	s.Oem = val
+// GetMAC returns the value of MAC.
+func (s *TrashedHostHostInterfacesItem) GetMAC() OptString {
+	return s.MAC
 }
 
-// SetPriority sets the value of Priority.
-func (s *RedfishSystemOemDellMessageDotExtendedInfoItemResolutionStepsItem) SetPriority(val OptNilInt) {
-	s.Priority = val
+// GetMtu returns the value of Mtu.
+func (s *TrashedHostHostInterfacesItem) GetMtu() OptInt {
+	return s.Mtu
 }
 
-// SetResolutionType sets the value of ResolutionType.
-func (s *RedfishSystemOemDellMessageDotExtendedInfoItemResolutionStepsItem) SetResolutionType(val OptString) {
-	s.ResolutionType = val
+// GetParent returns the value of Parent.
+func (s *TrashedHostHostInterfacesItem) GetParent() OptString {
+	return s.Parent
 }
 
-// SetRetryCount sets the value of RetryCount.
-func (s *RedfishSystemOemDellMessageDotExtendedInfoItemResolutionStepsItem) SetRetryCount(val OptNilInt) {
-	s.RetryCount = val
+// GetPort returns the value of Port.
+func (s *TrashedHostHostInterfacesItem) GetPort() OptInt {
+	return s.Port
 }
 
-// SetRetryIntervalSeconds sets the value of RetryIntervalSeconds.
-func (s *RedfishSystemOemDellMessageDotExtendedInfoItemResolutionStepsItem) SetRetryIntervalSeconds(val OptNilInt) {
-	s.RetryIntervalSeconds = val
+// GetSwitch returns the value of Switch.
+func (s *TrashedHostHostInterfacesItem) GetSwitch() OptString {
+	return s.Switch
 }
 
-// SetTargetComponentURI sets the value of TargetComponentURI.
-func (s *RedfishSystemOemDellMessageDotExtendedInfoItemResolutionStepsItem) SetTargetComponentURI(val OptString) {
-	s.TargetComponentURI = val
+// GetVlan returns the value of Vlan.
+func (s *TrashedHostHostInterfacesItem) GetVlan() OptInt {
+	return s.Vlan
 }
 
-type RedfishSystemOemDellMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem struct {
-	AllowableNumbers           []string      `json:"AllowableNumbers"`
-	AllowablePattern           OptString     `json:"AllowablePattern"`
-	AllowableValueDescriptions []string      `json:"AllowableValueDescriptions"`
-	AllowableValues            []string      `json:"AllowableValues"`
-	ArraySizeMaximum           OptNilInt     `json:"ArraySizeMaximum"`
-	ArraySizeMinimum           OptNilInt     `json:"ArraySizeMinimum"`
-	DataType                   OptString     `json:"DataType"`
-	DefaultValue               OptString     `json:"DefaultValue"`
-	MaximumValue               OptNilFloat64 `json:"MaximumValue"`
-	MinimumValue               OptNilFloat64 `json:"MinimumValue"`
-	Name                       OptString     `json:"Name"`
-	NoDefaultValue             OptBool       `json:"NoDefaultValue"`
-	ObjectDataType             OptString     `json:"ObjectDataType"`
-	Required                   OptBool       `json:"Required"`
+// SetAddresses sets the value of Addresses.
+func (s *TrashedHostHostInterfacesItem) SetAddresses(val []TrashedHostHostInterfacesItemAddressesItem) {
+	s.Addresses = val
 }
 
-// GetAllowableNumbers returns the value of AllowableNumbers.
-func (s *RedfishSystemOemDellMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) GetAllowableNumbers() []string {
-	return s.AllowableNumbers
+// SetBmc sets the value of Bmc.
+func (s *TrashedHostHostInterfacesItem) SetBmc(val OptBool) {
+	s.Bmc = val
 }
 
-// GetAllowablePattern returns the value of AllowablePattern.
-func (s *RedfishSystemOemDellMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) GetAllowablePattern() OptString {
-	return s.AllowablePattern
+// SetFqdn sets the value of Fqdn.
+func (s *TrashedHostHostInterfacesItem) SetFqdn(val OptString) {
+	s.Fqdn = val
 }
 
-// GetAllowableValueDescriptions returns the value of AllowableValueDescriptions.
-func (s *RedfishSystemOemDellMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) GetAllowableValueDescriptions() []string {
-	return s.AllowableValueDescriptions
+// SetID sets the value of ID.
+func (s *TrashedHostHostInterfacesItem) SetID(val OptNilInt64) {
+	s.ID = val
 }
 
-// GetAllowableValues returns the value of AllowableValues.
-func (s *RedfishSystemOemDellMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) GetAllowableValues() []string {
-	return s.AllowableValues
+// SetIfname sets the value of Ifname.
+func (s *TrashedHostHostInterfacesItem) SetIfname(val OptString) {
+	s.Ifname = val
 }
 
-// GetArraySizeMaximum returns the value of ArraySizeMaximum.
-func (s *RedfishSystemOemDellMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) GetArraySizeMaximum() OptNilInt {
-	return s.ArraySizeMaximum
+// SetIP sets the value of IP.
+func (s *TrashedHostHostInterfacesItem) SetIP(val OptString) {
+	s.IP = val
 }
 
-// GetArraySizeMinimum returns the value of ArraySizeMinimum.
-func (s *RedfishSystemOemDellMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) GetArraySizeMinimum() OptNilInt {
-	return s.ArraySizeMinimum
+// SetMAC sets the value of MAC.
+func (s *TrashedHostHostInterfacesItem) SetMAC(val OptString) {
+	s.MAC = val
 }
 
-// GetDataType returns the value of DataType.
-func (s *RedfishSystemOemDellMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) GetDataType() OptString {
-	return s.DataType
+// SetMtu sets the value of Mtu.
+func (s *TrashedHostHostInterfacesItem) SetMtu(val OptInt) {
+	s.Mtu = val
 }
 
-// GetDefaultValue returns the value of DefaultValue.
-func (s *RedfishSystemOemDellMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) GetDefaultValue() OptString {
-	return s.DefaultValue
+// SetParent sets the value of Parent.
+func (s *TrashedHostHostInterfacesItem) SetParent(val OptString) {
+	s.Parent = val
 }
 
-// GetMaximumValue returns the value of MaximumValue.
-func (s *RedfishSystemOemDellMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) GetMaximumValue() OptNilFloat64 {
-	return s.MaximumValue
+// SetPort sets the value of Port.
+func (s *TrashedHostHostInterfacesItem) SetPort(val OptInt) {
+	s.Port = val
 }
 
-// GetMinimumValue returns the value of MinimumValue.
-func (s *RedfishSystemOemDellMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) GetMinimumValue() OptNilFloat64 {
-	return s.MinimumValue
+// SetSwitch sets the value of Switch.
+func (s *TrashedHostHostInterfacesItem) SetSwitch(val OptString) {
+	s.Switch = val
 }
 
-// GetName returns the value of Name.
-func (s *RedfishSystemOemDellMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) GetName() OptString {
-	return s.Name
+// SetVlan sets the value of Vlan.
+func (s *TrashedHostHostInterfacesItem) SetVlan(val OptInt) {
+	s.Vlan = val
 }
 
-// GetNoDefaultValue returns the value of NoDefaultValue.
-func (s *RedfishSystemOemDellMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) GetNoDefaultValue() OptBool {
-	return s.NoDefaultValue
+type TrashedHostHostInterfacesItemAddressesItem struct {
+	Fqdn    OptString `json:"fqdn"`
+	IP      OptString `json:"ip"`
+	Primary OptBool   `json:"primary"`
 }
 
-// GetObjectDataType returns the value of ObjectDataType.
-func (s *RedfishSystemOemDellMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) GetObjectDataType() OptString {
-	return s.ObjectDataType
+// GetFqdn returns the value of Fqdn.
+func (s *TrashedHostHostInterfacesItemAddressesItem) GetFqdn() OptString {
+	return s.Fqdn
 }
 
-// GetRequired returns the value of Required.
-func (s *RedfishSystemOemDellMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) GetRequired() OptBool {
-	return s.Required
+// GetIP returns the value of IP.
+func (s *TrashedHostHostInterfacesItemAddressesItem) GetIP() OptString {
+	return s.IP
 }
 
-// SetAllowableNumbers sets the value of AllowableNumbers.
-func (s *RedfishSystemOemDellMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) SetAllowableNumbers(val []string) {
-	s.AllowableNumbers = val
+// GetPrimary returns the value of Primary.
+func (s *TrashedHostHostInterfacesItemAddressesItem) GetPrimary() OptBool {
+	return s.Primary
 }
 
-// SetAllowablePattern sets the value of AllowablePattern.
-func (s *RedfishSystemOemDellMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) SetAllowablePattern(val OptString) {
-	s.AllowablePattern = val
+// SetFqdn sets the value of Fqdn.
+func (s *TrashedHostHostInterfacesItemAddressesItem) SetFqdn(val OptString) {
+	s.Fqdn = val
 }
 
-// SetAllowableValueDescriptions sets the value of AllowableValueDescriptions.
-func (s *RedfishSystemOemDellMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) SetAllowableValueDescriptions(val []string) {
-	s.AllowableValueDescriptions = val
+// SetIP sets the value of IP.
+func (s *TrashedHostHostInterfacesItemAddressesItem) SetIP(val OptString) {
+	s.IP = val
 }
 
-// SetAllowableValues sets the value of AllowableValues.
-func (s *RedfishSystemOemDellMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) SetAllowableValues(val []string) {
-	s.AllowableValues = val
+// SetPrimary sets the value of Primary.
+func (s *TrashedHostHostInterfacesItemAddressesItem) SetPrimary(val OptBool) {
+	s.Primary = val
 }
 
-// SetArraySizeMaximum sets the value of ArraySizeMaximum.
-func (s *RedfishSystemOemDellMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) SetArraySizeMaximum(val OptNilInt) {
-	s.ArraySizeMaximum = val
+type TrashedHostHostInventory struct {
+	BiosVersion OptString                              `json:"bios_version"`
+	BmcFirmware OptString                              `json:"bmc_firmware"`
+	CapturedAt  OptNilDateTime                         `json:"captured_at"`
+	NicFirmware OptTrashedHostHostInventoryNicFirmware `json:"nic_firmware"`
 }
 
-// SetArraySizeMinimum sets the value of ArraySizeMinimum.
-func (s *RedfishSystemOemDellMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) SetArraySizeMinimum(val OptNilInt) {
-	s.ArraySizeMinimum = val
+// GetBiosVersion returns the value of BiosVersion.
+func (s *TrashedHostHostInventory) GetBiosVersion() OptString {
+	return s.BiosVersion
 }
 
-// SetDataType sets the value of DataType.
-func (s *RedfishSystemOemDellMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) SetDataType(val OptString) {
-	s.DataType = val
+// GetBmcFirmware returns the value of BmcFirmware.
+func (s *TrashedHostHostInventory) GetBmcFirmware() OptString {
+	return s.BmcFirmware
 }
 
-// SetDefaultValue sets the value of DefaultValue.
-func (s *RedfishSystemOemDellMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) SetDefaultValue(val OptString) {
-	s.DefaultValue = val
+// GetCapturedAt returns the value of CapturedAt.
+func (s *TrashedHostHostInventory) GetCapturedAt() OptNilDateTime {
+	return s.CapturedAt
 }
 
-// SetMaximumValue sets the value of MaximumValue.
-func (s *RedfishSystemOemDellMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) SetMaximumValue(val OptNilFloat64) {
-	s.MaximumValue = val
+// GetNicFirmware returns the value of NicFirmware.
+func (s *TrashedHostHostInventory) GetNicFirmware() OptTrashedHostHostInventoryNicFirmware {
+	return s.NicFirmware
 }
 
-// SetMinimumValue sets the value of MinimumValue.
-func (s *RedfishSystemOemDellMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) SetMinimumValue(val OptNilFloat64) {
-	s.MinimumValue = val
+// SetBiosVersion sets the value of BiosVersion.
+func (s *TrashedHostHostInventory) SetBiosVersion(val OptString) {
+	s.BiosVersion = val
 }
 
-// SetName sets the value of Name.
-func (s *RedfishSystemOemDellMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) SetName(val OptString) {
-	s.Name = val
+// SetBmcFirmware sets the value of BmcFirmware.
+func (s *TrashedHostHostInventory) SetBmcFirmware(val OptString) {
+	s.BmcFirmware = val
 }
 
-// SetNoDefaultValue sets the value of NoDefaultValue.
-func (s *RedfishSystemOemDellMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) SetNoDefaultValue(val OptBool) {
-	s.NoDefaultValue = val
+// SetCapturedAt sets the value of CapturedAt.
+func (s *TrashedHostHostInventory) SetCapturedAt(val OptNilDateTime) {
+	s.CapturedAt = val
 }
 
-// SetObjectDataType sets the value of ObjectDataType.
-func (s *RedfishSystemOemDellMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) SetObjectDataType(val OptString) {
-	s.ObjectDataType = val
+// SetNicFirmware sets the value of NicFirmware.
+func (s *TrashedHostHostInventory) SetNicFirmware(val OptTrashedHostHostInventoryNicFirmware) {
+	s.NicFirmware = val
 }
 
-// SetRequired sets the value of Required.
-func (s *RedfishSystemOemDellMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) SetRequired(val OptBool) {
-	s.Required = val
+type TrashedHostHostInventoryNicFirmware map[string]string
+
+func (s *TrashedHostHostInventoryNicFirmware) init() TrashedHostHostInventoryNicFirmware {
+	m := *s
+	if m == nil {
+		m = map[string]string{}
+		*s = m
+	}
+	return m
 }
 
 // User schema.
@@ -11,6 +11,18 @@ import (
 	"github.com/stretchr/testify/require"
 )
 
+func TestArtifactResponse_EncodeDecode(t *testing.T) {
+	var typ ArtifactResponse
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 ArtifactResponse
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
 func TestAuthRequest_EncodeDecode(t *testing.T) {
 	var typ AuthRequest
 	typ.SetFake()
@@ -83,6 +95,68 @@ func TestAuthTokenRequest_EncodeDecode(t *testing.T) {
 	var typ2 AuthTokenRequest
 	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
 }
+func TestBiosReport_EncodeDecode(t *testing.T) {
+	var typ BiosReport
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 BiosReport
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
+func TestBiosReportAttributes_EncodeDecode(t *testing.T) {
+	var typ BiosReportAttributes
+	typ = make(BiosReportAttributes)
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 BiosReportAttributes
+	typ2 = make(BiosReportAttributes)
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
+func TestBiosReportDeviationsItem_EncodeDecode(t *testing.T) {
+	var typ BiosReportDeviationsItem
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 BiosReportDeviationsItem
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
+func TestBmcBiosApplyBody_EncodeDecode(t *testing.T) {
+	var typ BmcBiosApplyBody
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 BmcBiosApplyBody
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
+func TestBmcBootOverrideBody_EncodeDecode(t *testing.T) {
+	var typ BmcBootOverrideBody
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 BmcBootOverrideBody
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
 func TestBmcDellInstallFromRepoRequest_EncodeDecode(t *testing.T) {
 	var typ BmcDellInstallFromRepoRequest
 	typ.SetFake()
@@ -95,6 +169,18 @@ func TestBmcDellInstallFromRepoRequest_EncodeDecode(t *testing.T) {
 	var typ2 BmcDellInstallFromRepoRequest
 	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
 }
+func TestBmcFirmwareUpdateBody_EncodeDecode(t *testing.T) {
+	var typ BmcFirmwareUpdateBody
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 BmcFirmwareUpdateBody
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
 func TestBmcImportConfigurationRequest_EncodeDecode(t *testing.T) {
 	var typ BmcImportConfigurationRequest
 	typ.SetFake()
@@ -145,6 +231,30 @@ func TestBmcOsPowerBody_EncodeDecode(t *testing.T) {
 	var typ2 BmcOsPowerBody
 	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
 }
+func TestBmcRotatePasswordBody_EncodeDecode(t *testing.T) {
+	var typ BmcRotatePasswordBody
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 BmcRotatePasswordBody
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
+func TestBmcVirtualMediaBody_EncodeDecode(t *testing.T) {
+	var typ BmcVirtualMediaBody
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 BmcVirtualMediaBody
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
 func TestBootImage_EncodeDecode(t *testing.T) {
 	var typ BootImage
 	typ.SetFake()
@@ -209,8 +319,8 @@ func TestBootImageProvisionTemplates_EncodeDecode(t *testing.T) {
 	typ2 = make(BootImageProvisionTemplates)
 	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
 }
-func TestDataDump_EncodeDecode(t *testing.T) {
-	var typ DataDump
+func TestBootTokenInfo_EncodeDecode(t *testing.T) {
+	var typ BootTokenInfo
 	typ.SetFake()
 
 	e := jx.Encoder{}
@@ -218,11 +328,11 @@ func TestDataDump_EncodeDecode(t *testing.T) {
 	data := e.Bytes()
 	require.True(t, std.Valid(data), "Encoded: %s", data)
 
-	var typ2 DataDump
+	var typ2 BootTokenInfo
 	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
 }
-func TestDataDumpHostsItem_EncodeDecode(t *testing.T) {
-	var typ DataDumpHostsItem
+func TestCertRevokeRequest_EncodeDecode(t *testing.T) {
+	var typ CertRevokeRequest
 	typ.SetFake()
 
 	e := jx.Encoder{}
@@ -230,11 +340,11 @@ func TestDataDumpHostsItem_EncodeDecode(t *testing.T) {
 	data := e.Bytes()
 	require.True(t, std.Valid(data), "Encoded: %s", data)
 
-	var typ2 DataDumpHostsItem
+	var typ2 CertRevokeRequest
 	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
 }
-func TestDataDumpHostsItemBondsItem_EncodeDecode(t *testing.T) {
-	var typ DataDumpHostsItemBondsItem
+func TestChangeFeed_EncodeDecode(t *testing.T) {
+	var typ ChangeFeed
 	typ.SetFake()
 
 	e := jx.Encoder{}
@@ -242,11 +352,11 @@ func TestDataDumpHostsItemBondsItem_EncodeDecode(t *testing.T) {
 	data := e.Bytes()
 	require.True(t, std.Valid(data), "Encoded: %s", data)
 
-	var typ2 DataDumpHostsItemBondsItem
+	var typ2 ChangeFeed
 	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
 }
-func TestDataDumpHostsItemInterfacesItem_EncodeDecode(t *testing.T) {
-	var typ DataDumpHostsItemInterfacesItem
+func TestChangeFeedChangesItem_EncodeDecode(t *testing.T) {
+	var typ ChangeFeedChangesItem
 	typ.SetFake()
 
 	e := jx.Encoder{}
@@ -254,11 +364,12 @@ func TestDataDumpHostsItemInterfacesItem_EncodeDecode(t *testing.T) {
 	data := e.Bytes()
 	require.True(t, std.Valid(data), "Encoded: %s", data)
 
-	var typ2 DataDumpHostsItemInterfacesItem
+	var typ2 ChangeFeedChangesItem
 	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
 }
-func TestDataDumpImagesItem_EncodeDecode(t *testing.T) {
-	var typ DataDumpImagesItem
+func TestChangeFeedChangesItemDiff_EncodeDecode(t *testing.T) {
+	var typ ChangeFeedChangesItemDiff
+	typ = make(ChangeFeedChangesItemDiff)
 	typ.SetFake()
 
 	e := jx.Encoder{}
@@ -266,12 +377,12 @@ func TestDataDumpImagesItem_EncodeDecode(t *testing.T) {
 	data := e.Bytes()
 	require.True(t, std.Valid(data), "Encoded: %s", data)
 
-	var typ2 DataDumpImagesItem
+	var typ2 ChangeFeedChangesItemDiff
+	typ2 = make(ChangeFeedChangesItemDiff)
 	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
 }
-func TestDataDumpImagesItemProvisionTemplates_EncodeDecode(t *testing.T) {
-	var typ DataDumpImagesItemProvisionTemplates
-	typ = make(DataDumpImagesItemProvisionTemplates)
+func TestChangeFeedChangesItemDiffItem_EncodeDecode(t *testing.T) {
+	var typ ChangeFeedChangesItemDiffItem
 	typ.SetFake()
 
 	e := jx.Encoder{}
@@ -279,12 +390,11 @@ func TestDataDumpImagesItemProvisionTemplates_EncodeDecode(t *testing.T) {
 	data := e.Bytes()
 	require.True(t, std.Valid(data), "Encoded: %s", data)
 
-	var typ2 DataDumpImagesItemProvisionTemplates
-	typ2 = make(DataDumpImagesItemProvisionTemplates)
+	var typ2 ChangeFeedChangesItemDiffItem
 	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
 }
-func TestDataDumpUsersItem_EncodeDecode(t *testing.T) {
-	var typ DataDumpUsersItem
+func TestCredential_EncodeDecode(t *testing.T) {
+	var typ Credential
 	typ.SetFake()
 
 	e := jx.Encoder{}
@@ -292,11 +402,11 @@ func TestDataDumpUsersItem_EncodeDecode(t *testing.T) {
 	data := e.Bytes()
 	require.True(t, std.Valid(data), "Encoded: %s", data)
 
-	var typ2 DataDumpUsersItem
+	var typ2 Credential
 	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
 }
-func TestEvent_EncodeDecode(t *testing.T) {
-	var typ Event
+func TestDNSRecordAddRequest_EncodeDecode(t *testing.T) {
+	var typ DNSRecordAddRequest
 	typ.SetFake()
 
 	e := jx.Encoder{}
@@ -304,11 +414,11 @@ func TestEvent_EncodeDecode(t *testing.T) {
 	data := e.Bytes()
 	require.True(t, std.Valid(data), "Encoded: %s", data)
 
-	var typ2 Event
+	var typ2 DNSRecordAddRequest
 	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
 }
-func TestEventJobMessagesItem_EncodeDecode(t *testing.T) {
-	var typ EventJobMessagesItem
+func TestDNSRecordAddRequestRecordsItem_EncodeDecode(t *testing.T) {
+	var typ DNSRecordAddRequestRecordsItem
 	typ.SetFake()
 
 	e := jx.Encoder{}
@@ -316,11 +426,11 @@ func TestEventJobMessagesItem_EncodeDecode(t *testing.T) {
 	data := e.Bytes()
 	require.True(t, std.Valid(data), "Encoded: %s", data)
 
-	var typ2 EventJobMessagesItem
+	var typ2 DNSRecordAddRequestRecordsItem
 	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
 }
-func TestEventJobMessagesItemRedfishError_EncodeDecode(t *testing.T) {
-	var typ EventJobMessagesItemRedfishError
+func TestDataDump_EncodeDecode(t *testing.T) {
+	var typ DataDump
 	typ.SetFake()
 
 	e := jx.Encoder{}
@@ -328,11 +438,11 @@ func TestEventJobMessagesItemRedfishError_EncodeDecode(t *testing.T) {
 	data := e.Bytes()
 	require.True(t, std.Valid(data), "Encoded: %s", data)
 
-	var typ2 EventJobMessagesItemRedfishError
+	var typ2 DataDump
 	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
 }
-func TestEventJobMessagesItemRedfishErrorError_EncodeDecode(t *testing.T) {
-	var typ EventJobMessagesItemRedfishErrorError
+func TestDataDumpCredentialsItem_EncodeDecode(t *testing.T) {
+	var typ DataDumpCredentialsItem
 	typ.SetFake()
 
 	e := jx.Encoder{}
@@ -340,11 +450,11 @@ func TestEventJobMessagesItemRedfishErrorError_EncodeDecode(t *testing.T) {
 	data := e.Bytes()
 	require.True(t, std.Valid(data), "Encoded: %s", data)
 
-	var typ2 EventJobMessagesItemRedfishErrorError
+	var typ2 DataDumpCredentialsItem
 	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
 }
-func TestEventJobMessagesItemRedfishErrorErrorMessageDotExtendedInfoItem_EncodeDecode(t *testing.T) {
-	var typ EventJobMessagesItemRedfishErrorErrorMessageDotExtendedInfoItem
+func TestDataDumpDNSRecordsItem_EncodeDecode(t *testing.T) {
+	var typ DataDumpDNSRecordsItem
 	typ.SetFake()
 
 	e := jx.Encoder{}
@@ -352,11 +462,11 @@ func TestEventJobMessagesItemRedfishErrorErrorMessageDotExtendedInfoItem_EncodeD
 	data := e.Bytes()
 	require.True(t, std.Valid(data), "Encoded: %s", data)
 
-	var typ2 EventJobMessagesItemRedfishErrorErrorMessageDotExtendedInfoItem
+	var typ2 DataDumpDNSRecordsItem
 	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
 }
-func TestGenericResponse_EncodeDecode(t *testing.T) {
-	var typ GenericResponse
+func TestDataDumpHostsItem_EncodeDecode(t *testing.T) {
+	var typ DataDumpHostsItem
 	typ.SetFake()
 
 	e := jx.Encoder{}
@@ -364,11 +474,11 @@ func TestGenericResponse_EncodeDecode(t *testing.T) {
 	data := e.Bytes()
 	require.True(t, std.Valid(data), "Encoded: %s", data)
 
-	var typ2 GenericResponse
+	var typ2 DataDumpHostsItem
 	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
 }
-func TestGetRolesResponse_EncodeDecode(t *testing.T) {
-	var typ GetRolesResponse
+func TestDataDumpHostsItemBondsItem_EncodeDecode(t *testing.T) {
+	var typ DataDumpHostsItemBondsItem
 	typ.SetFake()
 
 	e := jx.Encoder{}
@@ -376,11 +486,11 @@ func TestGetRolesResponse_EncodeDecode(t *testing.T) {
 	data := e.Bytes()
 	require.True(t, std.Valid(data), "Encoded: %s", data)
 
-	var typ2 GetRolesResponse
+	var typ2 DataDumpHostsItemBondsItem
 	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
 }
-func TestGetRolesResponseRolesItem_EncodeDecode(t *testing.T) {
-	var typ GetRolesResponseRolesItem
+func TestDataDumpHostsItemBondsItemAddressesItem_EncodeDecode(t *testing.T) {
+	var typ DataDumpHostsItemBondsItemAddressesItem
 	typ.SetFake()
 
 	e := jx.Encoder{}
@@ -388,11 +498,12 @@ func TestGetRolesResponseRolesItem_EncodeDecode(t *testing.T) {
 	data := e.Bytes()
 	require.True(t, std.Valid(data), "Encoded: %s", data)
 
-	var typ2 GetRolesResponseRolesItem
+	var typ2 DataDumpHostsItemBondsItemAddressesItem
 	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
 }
-func TestGetRolesResponseRolesItemPermissionListItem_EncodeDecode(t *testing.T) {
-	var typ GetRolesResponseRolesItemPermissionListItem
+func TestDataDumpHostsItemBondsItemOptions_EncodeDecode(t *testing.T) {
+	var typ DataDumpHostsItemBondsItemOptions
+	typ = make(DataDumpHostsItemBondsItemOptions)
 	typ.SetFake()
 
 	e := jx.Encoder{}
@@ -400,11 +511,12 @@ func TestGetRolesResponseRolesItemPermissionListItem_EncodeDecode(t *testing.T)
 	data := e.Bytes()
 	require.True(t, std.Valid(data), "Encoded: %s", data)
 
-	var typ2 GetRolesResponseRolesItemPermissionListItem
+	var typ2 DataDumpHostsItemBondsItemOptions
+	typ2 = make(DataDumpHostsItemBondsItemOptions)
 	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
 }
-func TestGetRolesResponseRolesItemUnassignedPermissionListItem_EncodeDecode(t *testing.T) {
-	var typ GetRolesResponseRolesItemUnassignedPermissionListItem
+func TestDataDumpHostsItemHardware_EncodeDecode(t *testing.T) {
+	var typ DataDumpHostsItemHardware
 	typ.SetFake()
 
 	e := jx.Encoder{}
@@ -412,11 +524,11 @@ func TestGetRolesResponseRolesItemUnassignedPermissionListItem_EncodeDecode(t *t
 	data := e.Bytes()
 	require.True(t, std.Valid(data), "Encoded: %s", data)
 
-	var typ2 GetRolesResponseRolesItemUnassignedPermissionListItem
+	var typ2 DataDumpHostsItemHardware
 	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
 }
-func TestHTTPError_EncodeDecode(t *testing.T) {
-	var typ HTTPError
+func TestDataDumpHostsItemHardwareDimmsItem_EncodeDecode(t *testing.T) {
+	var typ DataDumpHostsItemHardwareDimmsItem
 	typ.SetFake()
 
 	e := jx.Encoder{}
@@ -424,11 +536,11 @@ func TestHTTPError_EncodeDecode(t *testing.T) {
 	data := e.Bytes()
 	require.True(t, std.Valid(data), "Encoded: %s", data)
 
-	var typ2 HTTPError
+	var typ2 DataDumpHostsItemHardwareDimmsItem
 	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
 }
-func TestHTTPErrorErrorsItem_EncodeDecode(t *testing.T) {
-	var typ HTTPErrorErrorsItem
+func TestDataDumpHostsItemHardwareDisksItem_EncodeDecode(t *testing.T) {
+	var typ DataDumpHostsItemHardwareDisksItem
 	typ.SetFake()
 
 	e := jx.Encoder{}
@@ -436,12 +548,11 @@ func TestHTTPErrorErrorsItem_EncodeDecode(t *testing.T) {
 	data := e.Bytes()
 	require.True(t, std.Valid(data), "Encoded: %s", data)
 
-	var typ2 HTTPErrorErrorsItem
+	var typ2 DataDumpHostsItemHardwareDisksItem
 	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
 }
-func TestHTTPErrorErrorsItemMore_EncodeDecode(t *testing.T) {
-	var typ HTTPErrorErrorsItemMore
-	typ = make(HTTPErrorErrorsItemMore)
+func TestDataDumpHostsItemHardwareNicsItem_EncodeDecode(t *testing.T) {
+	var typ DataDumpHostsItemHardwareNicsItem
 	typ.SetFake()
 
 	e := jx.Encoder{}
@@ -449,12 +560,11 @@ func TestHTTPErrorErrorsItemMore_EncodeDecode(t *testing.T) {
 	data := e.Bytes()
 	require.True(t, std.Valid(data), "Encoded: %s", data)
 
-	var typ2 HTTPErrorErrorsItemMore
-	typ2 = make(HTTPErrorErrorsItemMore)
+	var typ2 DataDumpHostsItemHardwareNicsItem
 	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
 }
-func TestHost_EncodeDecode(t *testing.T) {
-	var typ Host
+func TestDataDumpHostsItemInterfacesItem_EncodeDecode(t *testing.T) {
+	var typ DataDumpHostsItemInterfacesItem
 	typ.SetFake()
 
 	e := jx.Encoder{}
@@ -462,11 +572,11 @@ func TestHost_EncodeDecode(t *testing.T) {
 	data := e.Bytes()
 	require.True(t, std.Valid(data), "Encoded: %s", data)
 
-	var typ2 Host
+	var typ2 DataDumpHostsItemInterfacesItem
 	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
 }
-func TestHostBondsItem_EncodeDecode(t *testing.T) {
-	var typ HostBondsItem
+func TestDataDumpHostsItemInterfacesItemAddressesItem_EncodeDecode(t *testing.T) {
+	var typ DataDumpHostsItemInterfacesItemAddressesItem
 	typ.SetFake()
 
 	e := jx.Encoder{}
@@ -474,11 +584,11 @@ func TestHostBondsItem_EncodeDecode(t *testing.T) {
 	data := e.Bytes()
 	require.True(t, std.Valid(data), "Encoded: %s", data)
 
-	var typ2 HostBondsItem
+	var typ2 DataDumpHostsItemInterfacesItemAddressesItem
 	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
 }
-func TestHostInterfacesItem_EncodeDecode(t *testing.T) {
-	var typ HostInterfacesItem
+func TestDataDumpHostsItemInventory_EncodeDecode(t *testing.T) {
+	var typ DataDumpHostsItemInventory
 	typ.SetFake()
 
 	e := jx.Encoder{}
@@ -486,11 +596,12 @@ func TestHostInterfacesItem_EncodeDecode(t *testing.T) {
 	data := e.Bytes()
 	require.True(t, std.Valid(data), "Encoded: %s", data)
 
-	var typ2 HostInterfacesItem
+	var typ2 DataDumpHostsItemInventory
 	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
 }
-func TestJobMessage_EncodeDecode(t *testing.T) {
-	var typ JobMessage
+func TestDataDumpHostsItemInventoryNicFirmware_EncodeDecode(t *testing.T) {
+	var typ DataDumpHostsItemInventoryNicFirmware
+	typ = make(DataDumpHostsItemInventoryNicFirmware)
 	typ.SetFake()
 
 	e := jx.Encoder{}
@@ -498,11 +609,12 @@ func TestJobMessage_EncodeDecode(t *testing.T) {
 	data := e.Bytes()
 	require.True(t, std.Valid(data), "Encoded: %s", data)
 
-	var typ2 JobMessage
+	var typ2 DataDumpHostsItemInventoryNicFirmware
+	typ2 = make(DataDumpHostsItemInventoryNicFirmware)
 	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
 }
-func TestJobMessageRedfishError_EncodeDecode(t *testing.T) {
-	var typ JobMessageRedfishError
+func TestDataDumpImagesItem_EncodeDecode(t *testing.T) {
+	var typ DataDumpImagesItem
 	typ.SetFake()
 
 	e := jx.Encoder{}
@@ -510,11 +622,12 @@ func TestJobMessageRedfishError_EncodeDecode(t *testing.T) {
 	data := e.Bytes()
 	require.True(t, std.Valid(data), "Encoded: %s", data)
 
-	var typ2 JobMessageRedfishError
+	var typ2 DataDumpImagesItem
 	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
 }
-func TestJobMessageRedfishErrorError_EncodeDecode(t *testing.T) {
-	var typ JobMessageRedfishErrorError
+func TestDataDumpImagesItemProvisionTemplates_EncodeDecode(t *testing.T) {
+	var typ DataDumpImagesItemProvisionTemplates
+	typ = make(DataDumpImagesItemProvisionTemplates)
 	typ.SetFake()
 
 	e := jx.Encoder{}
@@ -522,11 +635,12 @@ func TestJobMessageRedfishErrorError_EncodeDecode(t *testing.T) {
 	data := e.Bytes()
 	require.True(t, std.Valid(data), "Encoded: %s", data)
 
-	var typ2 JobMessageRedfishErrorError
+	var typ2 DataDumpImagesItemProvisionTemplates
+	typ2 = make(DataDumpImagesItemProvisionTemplates)
 	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
 }
-func TestJobMessageRedfishErrorErrorMessageDotExtendedInfoItem_EncodeDecode(t *testing.T) {
-	var typ JobMessageRedfishErrorErrorMessageDotExtendedInfoItem
+func TestDataDumpUsersItem_EncodeDecode(t *testing.T) {
+	var typ DataDumpUsersItem
 	typ.SetFake()
 
 	e := jx.Encoder{}
@@ -534,11 +648,11 @@ func TestJobMessageRedfishErrorErrorMessageDotExtendedInfoItem_EncodeDecode(t *t
 	data := e.Bytes()
 	require.True(t, std.Valid(data), "Encoded: %s", data)
 
-	var typ2 JobMessageRedfishErrorErrorMessageDotExtendedInfoItem
+	var typ2 DataDumpUsersItem
 	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
 }
-func TestLLDP_EncodeDecode(t *testing.T) {
-	var typ LLDP
+func TestDataLoadRequest_EncodeDecode(t *testing.T) {
+	var typ DataLoadRequest
 	typ.SetFake()
 
 	e := jx.Encoder{}
@@ -546,11 +660,11 @@ func TestLLDP_EncodeDecode(t *testing.T) {
 	data := e.Bytes()
 	require.True(t, std.Valid(data), "Encoded: %s", data)
 
-	var typ2 LLDP
+	var typ2 DataLoadRequest
 	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
 }
-func TestNodeAddRequest_EncodeDecode(t *testing.T) {
-	var typ NodeAddRequest
+func TestDataLoadRequestDump_EncodeDecode(t *testing.T) {
+	var typ DataLoadRequestDump
 	typ.SetFake()
 
 	e := jx.Encoder{}
@@ -558,11 +672,11 @@ func TestNodeAddRequest_EncodeDecode(t *testing.T) {
 	data := e.Bytes()
 	require.True(t, std.Valid(data), "Encoded: %s", data)
 
-	var typ2 NodeAddRequest
+	var typ2 DataLoadRequestDump
 	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
 }
-func TestNodeAddRequestNodeListItem_EncodeDecode(t *testing.T) {
-	var typ NodeAddRequestNodeListItem
+func TestDataLoadRequestDumpCredentialsItem_EncodeDecode(t *testing.T) {
+	var typ DataLoadRequestDumpCredentialsItem
 	typ.SetFake()
 
 	e := jx.Encoder{}
@@ -570,11 +684,11 @@ func TestNodeAddRequestNodeListItem_EncodeDecode(t *testing.T) {
 	data := e.Bytes()
 	require.True(t, std.Valid(data), "Encoded: %s", data)
 
-	var typ2 NodeAddRequestNodeListItem
+	var typ2 DataLoadRequestDumpCredentialsItem
 	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
 }
-func TestNodeAddRequestNodeListItemBondsItem_EncodeDecode(t *testing.T) {
-	var typ NodeAddRequestNodeListItemBondsItem
+func TestDataLoadRequestDumpDNSRecordsItem_EncodeDecode(t *testing.T) {
+	var typ DataLoadRequestDumpDNSRecordsItem
 	typ.SetFake()
 
 	e := jx.Encoder{}
@@ -582,11 +696,11 @@ func TestNodeAddRequestNodeListItemBondsItem_EncodeDecode(t *testing.T) {
 	data := e.Bytes()
 	require.True(t, std.Valid(data), "Encoded: %s", data)
 
-	var typ2 NodeAddRequestNodeListItemBondsItem
+	var typ2 DataLoadRequestDumpDNSRecordsItem
 	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
 }
-func TestNodeAddRequestNodeListItemInterfacesItem_EncodeDecode(t *testing.T) {
-	var typ NodeAddRequestNodeListItemInterfacesItem
+func TestDataLoadRequestDumpHostsItem_EncodeDecode(t *testing.T) {
+	var typ DataLoadRequestDumpHostsItem
 	typ.SetFake()
 
 	e := jx.Encoder{}
@@ -594,11 +708,11 @@ func TestNodeAddRequestNodeListItemInterfacesItem_EncodeDecode(t *testing.T) {
 	data := e.Bytes()
 	require.True(t, std.Valid(data), "Encoded: %s", data)
 
-	var typ2 NodeAddRequestNodeListItemInterfacesItem
+	var typ2 DataLoadRequestDumpHostsItem
 	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
 }
-func TestNodeBootImageRequest_EncodeDecode(t *testing.T) {
-	var typ NodeBootImageRequest
+func TestDataLoadRequestDumpHostsItemBondsItem_EncodeDecode(t *testing.T) {
+	var typ DataLoadRequestDumpHostsItemBondsItem
 	typ.SetFake()
 
 	e := jx.Encoder{}
@@ -606,11 +720,11 @@ func TestNodeBootImageRequest_EncodeDecode(t *testing.T) {
 	data := e.Bytes()
 	require.True(t, std.Valid(data), "Encoded: %s", data)
 
-	var typ2 NodeBootImageRequest
+	var typ2 DataLoadRequestDumpHostsItemBondsItem
 	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
 }
-func TestNodeBootTokenResponse_EncodeDecode(t *testing.T) {
-	var typ NodeBootTokenResponse
+func TestDataLoadRequestDumpHostsItemBondsItemAddressesItem_EncodeDecode(t *testing.T) {
+	var typ DataLoadRequestDumpHostsItemBondsItemAddressesItem
 	typ.SetFake()
 
 	e := jx.Encoder{}
@@ -618,11 +732,12 @@ func TestNodeBootTokenResponse_EncodeDecode(t *testing.T) {
 	data := e.Bytes()
 	require.True(t, std.Valid(data), "Encoded: %s", data)
 
-	var typ2 NodeBootTokenResponse
+	var typ2 DataLoadRequestDumpHostsItemBondsItemAddressesItem
 	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
 }
-func TestNodeBootTokenResponseNodesItem_EncodeDecode(t *testing.T) {
-	var typ NodeBootTokenResponseNodesItem
+func TestDataLoadRequestDumpHostsItemBondsItemOptions_EncodeDecode(t *testing.T) {
+	var typ DataLoadRequestDumpHostsItemBondsItemOptions
+	typ = make(DataLoadRequestDumpHostsItemBondsItemOptions)
 	typ.SetFake()
 
 	e := jx.Encoder{}
@@ -630,11 +745,12 @@ func TestNodeBootTokenResponseNodesItem_EncodeDecode(t *testing.T) {
 	data := e.Bytes()
 	require.True(t, std.Valid(data), "Encoded: %s", data)
 
-	var typ2 NodeBootTokenResponseNodesItem
+	var typ2 DataLoadRequestDumpHostsItemBondsItemOptions
+	typ2 = make(DataLoadRequestDumpHostsItemBondsItemOptions)
 	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
 }
-func TestNodeProvisionRequest_EncodeDecode(t *testing.T) {
-	var typ NodeProvisionRequest
+func TestDataLoadRequestDumpHostsItemHardware_EncodeDecode(t *testing.T) {
+	var typ DataLoadRequestDumpHostsItemHardware
 	typ.SetFake()
 
 	e := jx.Encoder{}
@@ -642,11 +758,11 @@ func TestNodeProvisionRequest_EncodeDecode(t *testing.T) {
 	data := e.Bytes()
 	require.True(t, std.Valid(data), "Encoded: %s", data)
 
-	var typ2 NodeProvisionRequest
+	var typ2 DataLoadRequestDumpHostsItemHardware
 	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
 }
-func TestNodeTagsRequest_EncodeDecode(t *testing.T) {
-	var typ NodeTagsRequest
+func TestDataLoadRequestDumpHostsItemHardwareDimmsItem_EncodeDecode(t *testing.T) {
+	var typ DataLoadRequestDumpHostsItemHardwareDimmsItem
 	typ.SetFake()
 
 	e := jx.Encoder{}
@@ -654,11 +770,11 @@ func TestNodeTagsRequest_EncodeDecode(t *testing.T) {
 	data := e.Bytes()
 	require.True(t, std.Valid(data), "Encoded: %s", data)
 
-	var typ2 NodeTagsRequest
+	var typ2 DataLoadRequestDumpHostsItemHardwareDimmsItem
 	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
 }
-func TestPatchRolesRequest_EncodeDecode(t *testing.T) {
-	var typ PatchRolesRequest
+func TestDataLoadRequestDumpHostsItemHardwareDisksItem_EncodeDecode(t *testing.T) {
+	var typ DataLoadRequestDumpHostsItemHardwareDisksItem
 	typ.SetFake()
 
 	e := jx.Encoder{}
@@ -666,11 +782,11 @@ func TestPatchRolesRequest_EncodeDecode(t *testing.T) {
 	data := e.Bytes()
 	require.True(t, std.Valid(data), "Encoded: %s", data)
 
-	var typ2 PatchRolesRequest
+	var typ2 DataLoadRequestDumpHostsItemHardwareDisksItem
 	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
 }
-func TestPatchRolesRequestPermissionListItem_EncodeDecode(t *testing.T) {
-	var typ PatchRolesRequestPermissionListItem
+func TestDataLoadRequestDumpHostsItemHardwareNicsItem_EncodeDecode(t *testing.T) {
+	var typ DataLoadRequestDumpHostsItemHardwareNicsItem
 	typ.SetFake()
 
 	e := jx.Encoder{}
@@ -678,11 +794,11 @@ func TestPatchRolesRequestPermissionListItem_EncodeDecode(t *testing.T) {
 	data := e.Bytes()
 	require.True(t, std.Valid(data), "Encoded: %s", data)
 
-	var typ2 PatchRolesRequestPermissionListItem
+	var typ2 DataLoadRequestDumpHostsItemHardwareNicsItem
 	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
 }
-func TestPostRolesRequest_EncodeDecode(t *testing.T) {
-	var typ PostRolesRequest
+func TestDataLoadRequestDumpHostsItemInterfacesItem_EncodeDecode(t *testing.T) {
+	var typ DataLoadRequestDumpHostsItemInterfacesItem
 	typ.SetFake()
 
 	e := jx.Encoder{}
@@ -690,11 +806,11 @@ func TestPostRolesRequest_EncodeDecode(t *testing.T) {
 	data := e.Bytes()
 	require.True(t, std.Valid(data), "Encoded: %s", data)
 
-	var typ2 PostRolesRequest
+	var typ2 DataLoadRequestDumpHostsItemInterfacesItem
 	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
 }
-func TestRedfishDellUpgradeFirmware_EncodeDecode(t *testing.T) {
-	var typ RedfishDellUpgradeFirmware
+func TestDataLoadRequestDumpHostsItemInterfacesItemAddressesItem_EncodeDecode(t *testing.T) {
+	var typ DataLoadRequestDumpHostsItemInterfacesItemAddressesItem
 	typ.SetFake()
 
 	e := jx.Encoder{}
@@ -702,11 +818,11 @@ func TestRedfishDellUpgradeFirmware_EncodeDecode(t *testing.T) {
 	data := e.Bytes()
 	require.True(t, std.Valid(data), "Encoded: %s", data)
 
-	var typ2 RedfishDellUpgradeFirmware
+	var typ2 DataLoadRequestDumpHostsItemInterfacesItemAddressesItem
 	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
 }
-func TestRedfishDellUpgradeFirmwareUpdateListItem_EncodeDecode(t *testing.T) {
-	var typ RedfishDellUpgradeFirmwareUpdateListItem
+func TestDataLoadRequestDumpHostsItemInventory_EncodeDecode(t *testing.T) {
+	var typ DataLoadRequestDumpHostsItemInventory
 	typ.SetFake()
 
 	e := jx.Encoder{}
@@ -714,11 +830,12 @@ func TestRedfishDellUpgradeFirmwareUpdateListItem_EncodeDecode(t *testing.T) {
 	data := e.Bytes()
 	require.True(t, std.Valid(data), "Encoded: %s", data)
 
-	var typ2 RedfishDellUpgradeFirmwareUpdateListItem
+	var typ2 DataLoadRequestDumpHostsItemInventory
 	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
 }
-func TestRedfishJob_EncodeDecode(t *testing.T) {
-	var typ RedfishJob
+func TestDataLoadRequestDumpHostsItemInventoryNicFirmware_EncodeDecode(t *testing.T) {
+	var typ DataLoadRequestDumpHostsItemInventoryNicFirmware
+	typ = make(DataLoadRequestDumpHostsItemInventoryNicFirmware)
 	typ.SetFake()
 
 	e := jx.Encoder{}
@@ -726,11 +843,12 @@ func TestRedfishJob_EncodeDecode(t *testing.T) {
 	data := e.Bytes()
 	require.True(t, std.Valid(data), "Encoded: %s", data)
 
-	var typ2 RedfishJob
+	var typ2 DataLoadRequestDumpHostsItemInventoryNicFirmware
+	typ2 = make(DataLoadRequestDumpHostsItemInventoryNicFirmware)
 	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
 }
-func TestRedfishJobJobsItem_EncodeDecode(t *testing.T) {
-	var typ RedfishJobJobsItem
+func TestDataLoadRequestDumpImagesItem_EncodeDecode(t *testing.T) {
+	var typ DataLoadRequestDumpImagesItem
 	typ.SetFake()
 
 	e := jx.Encoder{}
@@ -738,11 +856,12 @@ func TestRedfishJobJobsItem_EncodeDecode(t *testing.T) {
 	data := e.Bytes()
 	require.True(t, std.Valid(data), "Encoded: %s", data)
 
-	var typ2 RedfishJobJobsItem
+	var typ2 DataLoadRequestDumpImagesItem
 	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
 }
-func TestRedfishJobJobsItemMessageDotExtendedInfoItem_EncodeDecode(t *testing.T) {
-	var typ RedfishJobJobsItemMessageDotExtendedInfoItem
+func TestDataLoadRequestDumpImagesItemProvisionTemplates_EncodeDecode(t *testing.T) {
+	var typ DataLoadRequestDumpImagesItemProvisionTemplates
+	typ = make(DataLoadRequestDumpImagesItemProvisionTemplates)
 	typ.SetFake()
 
 	e := jx.Encoder{}
@@ -750,11 +869,12 @@ func TestRedfishJobJobsItemMessageDotExtendedInfoItem_EncodeDecode(t *testing.T)
 	data := e.Bytes()
 	require.True(t, std.Valid(data), "Encoded: %s", data)
 
-	var typ2 RedfishJobJobsItemMessageDotExtendedInfoItem
+	var typ2 DataLoadRequestDumpImagesItemProvisionTemplates
+	typ2 = make(DataLoadRequestDumpImagesItemProvisionTemplates)
 	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
 }
-func TestRedfishJobJobsItemMessageDotExtendedInfoItemResolutionStepsItem_EncodeDecode(t *testing.T) {
-	var typ RedfishJobJobsItemMessageDotExtendedInfoItemResolutionStepsItem
+func TestDataLoadRequestDumpUsersItem_EncodeDecode(t *testing.T) {
+	var typ DataLoadRequestDumpUsersItem
 	typ.SetFake()
 
 	e := jx.Encoder{}
@@ -762,11 +882,11 @@ func TestRedfishJobJobsItemMessageDotExtendedInfoItemResolutionStepsItem_EncodeD
 	data := e.Bytes()
 	require.True(t, std.Valid(data), "Encoded: %s", data)
 
-	var typ2 RedfishJobJobsItemMessageDotExtendedInfoItemResolutionStepsItem
+	var typ2 DataLoadRequestDumpUsersItem
 	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
 }
-func TestRedfishJobJobsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem_EncodeDecode(t *testing.T) {
-	var typ RedfishJobJobsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem
+func TestDataLoadResponse_EncodeDecode(t *testing.T) {
+	var typ DataLoadResponse
 	typ.SetFake()
 
 	e := jx.Encoder{}
@@ -774,11 +894,11 @@ func TestRedfishJobJobsItemMessageDotExtendedInfoItemResolutionStepsItemActionPa
 	data := e.Bytes()
 	require.True(t, std.Valid(data), "Encoded: %s", data)
 
-	var typ2 RedfishJobJobsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem
+	var typ2 DataLoadResponse
 	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
 }
-func TestRedfishJobJobsItemMessagesItem_EncodeDecode(t *testing.T) {
-	var typ RedfishJobJobsItemMessagesItem
+func TestDataLoadResponseDiff_EncodeDecode(t *testing.T) {
+	var typ DataLoadResponseDiff
 	typ.SetFake()
 
 	e := jx.Encoder{}
@@ -786,11 +906,11 @@ func TestRedfishJobJobsItemMessagesItem_EncodeDecode(t *testing.T) {
 	data := e.Bytes()
 	require.True(t, std.Valid(data), "Encoded: %s", data)
 
-	var typ2 RedfishJobJobsItemMessagesItem
+	var typ2 DataLoadResponseDiff
 	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
 }
-func TestRedfishJobJobsItemMessagesItemResolutionStepsItem_EncodeDecode(t *testing.T) {
-	var typ RedfishJobJobsItemMessagesItemResolutionStepsItem
+func TestDataLoadResponseDiffDNSRecords_EncodeDecode(t *testing.T) {
+	var typ DataLoadResponseDiffDNSRecords
 	typ.SetFake()
 
 	e := jx.Encoder{}
@@ -798,10 +918,1212 @@ func TestRedfishJobJobsItemMessagesItemResolutionStepsItem_EncodeDecode(t *testi
 	data := e.Bytes()
 	require.True(t, std.Valid(data), "Encoded: %s", data)
 
-	var typ2 RedfishJobJobsItemMessagesItemResolutionStepsItem
+	var typ2 DataLoadResponseDiffDNSRecords
 	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
 }
-func TestRedfishJobJobsItemMessagesItemResolutionStepsItemActionParametersItem_EncodeDecode(t *testing.T) {
+func TestDataLoadResponseDiffHosts_EncodeDecode(t *testing.T) {
+	var typ DataLoadResponseDiffHosts
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 DataLoadResponseDiffHosts
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
+func TestDataLoadResponseDiffImages_EncodeDecode(t *testing.T) {
+	var typ DataLoadResponseDiffImages
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 DataLoadResponseDiffImages
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
+func TestDiscoverBMCAdoptRequest_EncodeDecode(t *testing.T) {
+	var typ DiscoverBMCAdoptRequest
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 DiscoverBMCAdoptRequest
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
+func TestEvent_EncodeDecode(t *testing.T) {
+	var typ Event
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 Event
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
+func TestEventJobMessagesItem_EncodeDecode(t *testing.T) {
+	var typ EventJobMessagesItem
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 EventJobMessagesItem
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
+func TestEventJobMessagesItemRedfishError_EncodeDecode(t *testing.T) {
+	var typ EventJobMessagesItemRedfishError
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 EventJobMessagesItemRedfishError
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
+func TestEventJobMessagesItemRedfishErrorError_EncodeDecode(t *testing.T) {
+	var typ EventJobMessagesItemRedfishErrorError
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 EventJobMessagesItemRedfishErrorError
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
+func TestEventJobMessagesItemRedfishErrorErrorMessageDotExtendedInfoItem_EncodeDecode(t *testing.T) {
+	var typ EventJobMessagesItemRedfishErrorErrorMessageDotExtendedInfoItem
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 EventJobMessagesItemRedfishErrorErrorMessageDotExtendedInfoItem
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
+func TestEventSubscription_EncodeDecode(t *testing.T) {
+	var typ EventSubscription
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 EventSubscription
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
+func TestFirmwareUpdateReport_EncodeDecode(t *testing.T) {
+	var typ FirmwareUpdateReport
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 FirmwareUpdateReport
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
+func TestFirmwareUpdateReportInventory_EncodeDecode(t *testing.T) {
+	var typ FirmwareUpdateReportInventory
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 FirmwareUpdateReportInventory
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
+func TestFirmwareUpdateReportInventoryNicFirmware_EncodeDecode(t *testing.T) {
+	var typ FirmwareUpdateReportInventoryNicFirmware
+	typ = make(FirmwareUpdateReportInventoryNicFirmware)
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 FirmwareUpdateReportInventoryNicFirmware
+	typ2 = make(FirmwareUpdateReportInventoryNicFirmware)
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
+func TestGenericResponse_EncodeDecode(t *testing.T) {
+	var typ GenericResponse
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 GenericResponse
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
+func TestGetRolesResponse_EncodeDecode(t *testing.T) {
+	var typ GetRolesResponse
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 GetRolesResponse
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
+func TestGetRolesResponseRolesItem_EncodeDecode(t *testing.T) {
+	var typ GetRolesResponseRolesItem
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 GetRolesResponseRolesItem
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
+func TestGetRolesResponseRolesItemPermissionListItem_EncodeDecode(t *testing.T) {
+	var typ GetRolesResponseRolesItemPermissionListItem
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 GetRolesResponseRolesItemPermissionListItem
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
+func TestGetRolesResponseRolesItemUnassignedPermissionListItem_EncodeDecode(t *testing.T) {
+	var typ GetRolesResponseRolesItemUnassignedPermissionListItem
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 GetRolesResponseRolesItemUnassignedPermissionListItem
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
+func TestHAInstance_EncodeDecode(t *testing.T) {
+	var typ HAInstance
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 HAInstance
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
+func TestHTTPError_EncodeDecode(t *testing.T) {
+	var typ HTTPError
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 HTTPError
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
+func TestHTTPErrorErrorsItem_EncodeDecode(t *testing.T) {
+	var typ HTTPErrorErrorsItem
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 HTTPErrorErrorsItem
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
+func TestHTTPErrorErrorsItemMore_EncodeDecode(t *testing.T) {
+	var typ HTTPErrorErrorsItemMore
+	typ = make(HTTPErrorErrorsItemMore)
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 HTTPErrorErrorsItemMore
+	typ2 = make(HTTPErrorErrorsItemMore)
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
+func TestHardwareReport_EncodeDecode(t *testing.T) {
+	var typ HardwareReport
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 HardwareReport
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
+func TestHardwareReportHardware_EncodeDecode(t *testing.T) {
+	var typ HardwareReportHardware
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 HardwareReportHardware
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
+func TestHardwareReportHardwareDimmsItem_EncodeDecode(t *testing.T) {
+	var typ HardwareReportHardwareDimmsItem
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 HardwareReportHardwareDimmsItem
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
+func TestHardwareReportHardwareDisksItem_EncodeDecode(t *testing.T) {
+	var typ HardwareReportHardwareDisksItem
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 HardwareReportHardwareDisksItem
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
+func TestHardwareReportHardwareNicsItem_EncodeDecode(t *testing.T) {
+	var typ HardwareReportHardwareNicsItem
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 HardwareReportHardwareNicsItem
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
+func TestHost_EncodeDecode(t *testing.T) {
+	var typ Host
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 Host
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
+func TestHostBondsItem_EncodeDecode(t *testing.T) {
+	var typ HostBondsItem
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 HostBondsItem
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
+func TestHostBondsItemAddressesItem_EncodeDecode(t *testing.T) {
+	var typ HostBondsItemAddressesItem
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 HostBondsItemAddressesItem
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
+func TestHostBondsItemOptions_EncodeDecode(t *testing.T) {
+	var typ HostBondsItemOptions
+	typ = make(HostBondsItemOptions)
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 HostBondsItemOptions
+	typ2 = make(HostBondsItemOptions)
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
+func TestHostFile_EncodeDecode(t *testing.T) {
+	var typ HostFile
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 HostFile
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
+func TestHostHardware_EncodeDecode(t *testing.T) {
+	var typ HostHardware
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 HostHardware
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
+func TestHostHardwareDimmsItem_EncodeDecode(t *testing.T) {
+	var typ HostHardwareDimmsItem
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 HostHardwareDimmsItem
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
+func TestHostHardwareDisksItem_EncodeDecode(t *testing.T) {
+	var typ HostHardwareDisksItem
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 HostHardwareDisksItem
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
+func TestHostHardwareNicsItem_EncodeDecode(t *testing.T) {
+	var typ HostHardwareNicsItem
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 HostHardwareNicsItem
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
+func TestHostInterfacesItem_EncodeDecode(t *testing.T) {
+	var typ HostInterfacesItem
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 HostInterfacesItem
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
+func TestHostInterfacesItemAddressesItem_EncodeDecode(t *testing.T) {
+	var typ HostInterfacesItemAddressesItem
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 HostInterfacesItemAddressesItem
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
+func TestHostInventory_EncodeDecode(t *testing.T) {
+	var typ HostInventory
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 HostInventory
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
+func TestHostInventoryNicFirmware_EncodeDecode(t *testing.T) {
+	var typ HostInventoryNicFirmware
+	typ = make(HostInventoryNicFirmware)
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 HostInventoryNicFirmware
+	typ2 = make(HostInventoryNicFirmware)
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
+func TestHostLogEntry_EncodeDecode(t *testing.T) {
+	var typ HostLogEntry
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 HostLogEntry
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
+func TestIPReservation_EncodeDecode(t *testing.T) {
+	var typ IPReservation
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 IPReservation
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
+func TestImageDiskUsage_EncodeDecode(t *testing.T) {
+	var typ ImageDiskUsage
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 ImageDiskUsage
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
+func TestImageDiskUsageImagesItem_EncodeDecode(t *testing.T) {
+	var typ ImageDiskUsageImagesItem
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 ImageDiskUsageImagesItem
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
+func TestImageGCResult_EncodeDecode(t *testing.T) {
+	var typ ImageGCResult
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 ImageGCResult
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
+func TestImageGCResultFilesItem_EncodeDecode(t *testing.T) {
+	var typ ImageGCResultFilesItem
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 ImageGCResultFilesItem
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
+func TestJobMessage_EncodeDecode(t *testing.T) {
+	var typ JobMessage
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 JobMessage
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
+func TestJobMessageRedfishError_EncodeDecode(t *testing.T) {
+	var typ JobMessageRedfishError
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 JobMessageRedfishError
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
+func TestJobMessageRedfishErrorError_EncodeDecode(t *testing.T) {
+	var typ JobMessageRedfishErrorError
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 JobMessageRedfishErrorError
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
+func TestJobMessageRedfishErrorErrorMessageDotExtendedInfoItem_EncodeDecode(t *testing.T) {
+	var typ JobMessageRedfishErrorErrorMessageDotExtendedInfoItem
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 JobMessageRedfishErrorErrorMessageDotExtendedInfoItem
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
+func TestLLDP_EncodeDecode(t *testing.T) {
+	var typ LLDP
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 LLDP
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
+func TestListener_EncodeDecode(t *testing.T) {
+	var typ Listener
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 Listener
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
+func TestMaintenance_EncodeDecode(t *testing.T) {
+	var typ Maintenance
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 Maintenance
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
+func TestMaintenanceRequest_EncodeDecode(t *testing.T) {
+	var typ MaintenanceRequest
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 MaintenanceRequest
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
+func TestNodeAddRequest_EncodeDecode(t *testing.T) {
+	var typ NodeAddRequest
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 NodeAddRequest
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
+func TestNodeAddRequestNodeListItem_EncodeDecode(t *testing.T) {
+	var typ NodeAddRequestNodeListItem
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 NodeAddRequestNodeListItem
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
+func TestNodeAddRequestNodeListItemBondsItem_EncodeDecode(t *testing.T) {
+	var typ NodeAddRequestNodeListItemBondsItem
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 NodeAddRequestNodeListItemBondsItem
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
+func TestNodeAddRequestNodeListItemBondsItemAddressesItem_EncodeDecode(t *testing.T) {
+	var typ NodeAddRequestNodeListItemBondsItemAddressesItem
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 NodeAddRequestNodeListItemBondsItemAddressesItem
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
+func TestNodeAddRequestNodeListItemBondsItemOptions_EncodeDecode(t *testing.T) {
+	var typ NodeAddRequestNodeListItemBondsItemOptions
+	typ = make(NodeAddRequestNodeListItemBondsItemOptions)
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 NodeAddRequestNodeListItemBondsItemOptions
+	typ2 = make(NodeAddRequestNodeListItemBondsItemOptions)
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
+func TestNodeAddRequestNodeListItemHardware_EncodeDecode(t *testing.T) {
+	var typ NodeAddRequestNodeListItemHardware
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 NodeAddRequestNodeListItemHardware
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
+func TestNodeAddRequestNodeListItemHardwareDimmsItem_EncodeDecode(t *testing.T) {
+	var typ NodeAddRequestNodeListItemHardwareDimmsItem
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 NodeAddRequestNodeListItemHardwareDimmsItem
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
+func TestNodeAddRequestNodeListItemHardwareDisksItem_EncodeDecode(t *testing.T) {
+	var typ NodeAddRequestNodeListItemHardwareDisksItem
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 NodeAddRequestNodeListItemHardwareDisksItem
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
+func TestNodeAddRequestNodeListItemHardwareNicsItem_EncodeDecode(t *testing.T) {
+	var typ NodeAddRequestNodeListItemHardwareNicsItem
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 NodeAddRequestNodeListItemHardwareNicsItem
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
+func TestNodeAddRequestNodeListItemInterfacesItem_EncodeDecode(t *testing.T) {
+	var typ NodeAddRequestNodeListItemInterfacesItem
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 NodeAddRequestNodeListItemInterfacesItem
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
+func TestNodeAddRequestNodeListItemInterfacesItemAddressesItem_EncodeDecode(t *testing.T) {
+	var typ NodeAddRequestNodeListItemInterfacesItemAddressesItem
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 NodeAddRequestNodeListItemInterfacesItemAddressesItem
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
+func TestNodeAddRequestNodeListItemInventory_EncodeDecode(t *testing.T) {
+	var typ NodeAddRequestNodeListItemInventory
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 NodeAddRequestNodeListItemInventory
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
+func TestNodeAddRequestNodeListItemInventoryNicFirmware_EncodeDecode(t *testing.T) {
+	var typ NodeAddRequestNodeListItemInventoryNicFirmware
+	typ = make(NodeAddRequestNodeListItemInventoryNicFirmware)
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 NodeAddRequestNodeListItemInventoryNicFirmware
+	typ2 = make(NodeAddRequestNodeListItemInventoryNicFirmware)
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
+func TestNodeBootImageRequest_EncodeDecode(t *testing.T) {
+	var typ NodeBootImageRequest
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 NodeBootImageRequest
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
+func TestNodeBootTokenResponse_EncodeDecode(t *testing.T) {
+	var typ NodeBootTokenResponse
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 NodeBootTokenResponse
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
+func TestNodeBootTokenResponseNodesItem_EncodeDecode(t *testing.T) {
+	var typ NodeBootTokenResponseNodesItem
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 NodeBootTokenResponseNodesItem
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
+func TestNodeClientCertRequest_EncodeDecode(t *testing.T) {
+	var typ NodeClientCertRequest
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 NodeClientCertRequest
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
+func TestNodeClientCertRequestCertsItem_EncodeDecode(t *testing.T) {
+	var typ NodeClientCertRequestCertsItem
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 NodeClientCertRequestCertsItem
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
+func TestNodeCredentialsRequest_EncodeDecode(t *testing.T) {
+	var typ NodeCredentialsRequest
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 NodeCredentialsRequest
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
+func TestNodeFileRequest_EncodeDecode(t *testing.T) {
+	var typ NodeFileRequest
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 NodeFileRequest
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
+func TestNodeFirmwareRequest_EncodeDecode(t *testing.T) {
+	var typ NodeFirmwareRequest
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 NodeFirmwareRequest
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
+func TestNodeNextIPRequest_EncodeDecode(t *testing.T) {
+	var typ NodeNextIPRequest
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 NodeNextIPRequest
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
+func TestNodeNextIPResponse_EncodeDecode(t *testing.T) {
+	var typ NodeNextIPResponse
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 NodeNextIPResponse
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
+func TestNodeProvisionRequest_EncodeDecode(t *testing.T) {
+	var typ NodeProvisionRequest
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 NodeProvisionRequest
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
+func TestNodeRenameRequest_EncodeDecode(t *testing.T) {
+	var typ NodeRenameRequest
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 NodeRenameRequest
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
+func TestNodeStateRequest_EncodeDecode(t *testing.T) {
+	var typ NodeStateRequest
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 NodeStateRequest
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
+func TestNodeTagsRequest_EncodeDecode(t *testing.T) {
+	var typ NodeTagsRequest
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 NodeTagsRequest
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
+func TestNodeTokenRequest_EncodeDecode(t *testing.T) {
+	var typ NodeTokenRequest
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 NodeTokenRequest
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
+func TestPasswordRotationReport_EncodeDecode(t *testing.T) {
+	var typ PasswordRotationReport
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 PasswordRotationReport
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
+func TestPatchRolesRequest_EncodeDecode(t *testing.T) {
+	var typ PatchRolesRequest
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 PatchRolesRequest
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
+func TestPatchRolesRequestPermissionListItem_EncodeDecode(t *testing.T) {
+	var typ PatchRolesRequestPermissionListItem
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 PatchRolesRequestPermissionListItem
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
+func TestPendingBMC_EncodeDecode(t *testing.T) {
+	var typ PendingBMC
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 PendingBMC
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
+func TestPostRolesRequest_EncodeDecode(t *testing.T) {
+	var typ PostRolesRequest
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 PostRolesRequest
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
+func TestPrometheusTargetGroup_EncodeDecode(t *testing.T) {
+	var typ PrometheusTargetGroup
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 PrometheusTargetGroup
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
+func TestPrometheusTargetGroupLabels_EncodeDecode(t *testing.T) {
+	var typ PrometheusTargetGroupLabels
+	typ = make(PrometheusTargetGroupLabels)
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 PrometheusTargetGroupLabels
+	typ2 = make(PrometheusTargetGroupLabels)
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
+func TestReadOnlyResponse_EncodeDecode(t *testing.T) {
+	var typ ReadOnlyResponse
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 ReadOnlyResponse
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
+func TestRecord_EncodeDecode(t *testing.T) {
+	var typ Record
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 Record
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
+func TestRedfishDellUpgradeFirmware_EncodeDecode(t *testing.T) {
+	var typ RedfishDellUpgradeFirmware
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 RedfishDellUpgradeFirmware
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
+func TestRedfishDellUpgradeFirmwareUpdateListItem_EncodeDecode(t *testing.T) {
+	var typ RedfishDellUpgradeFirmwareUpdateListItem
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 RedfishDellUpgradeFirmwareUpdateListItem
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
+func TestRedfishJob_EncodeDecode(t *testing.T) {
+	var typ RedfishJob
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 RedfishJob
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
+func TestRedfishJobJobsItem_EncodeDecode(t *testing.T) {
+	var typ RedfishJobJobsItem
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 RedfishJobJobsItem
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
+func TestRedfishJobJobsItemMessageDotExtendedInfoItem_EncodeDecode(t *testing.T) {
+	var typ RedfishJobJobsItemMessageDotExtendedInfoItem
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 RedfishJobJobsItemMessageDotExtendedInfoItem
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
+func TestRedfishJobJobsItemMessageDotExtendedInfoItemResolutionStepsItem_EncodeDecode(t *testing.T) {
+	var typ RedfishJobJobsItemMessageDotExtendedInfoItemResolutionStepsItem
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 RedfishJobJobsItemMessageDotExtendedInfoItemResolutionStepsItem
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
+func TestRedfishJobJobsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem_EncodeDecode(t *testing.T) {
+	var typ RedfishJobJobsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 RedfishJobJobsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
+func TestRedfishJobJobsItemMessagesItem_EncodeDecode(t *testing.T) {
+	var typ RedfishJobJobsItemMessagesItem
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 RedfishJobJobsItemMessagesItem
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
+func TestRedfishJobJobsItemMessagesItemResolutionStepsItem_EncodeDecode(t *testing.T) {
+	var typ RedfishJobJobsItemMessagesItemResolutionStepsItem
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 RedfishJobJobsItemMessagesItemResolutionStepsItem
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
+func TestRedfishJobJobsItemMessagesItemResolutionStepsItemActionParametersItem_EncodeDecode(t *testing.T) {
 	var typ RedfishJobJobsItemMessagesItemResolutionStepsItemActionParametersItem
 	typ.SetFake()
 
@@ -810,12 +2132,207 @@ func TestRedfishJobJobsItemMessagesItemResolutionStepsItemActionParametersItem_E
 	data := e.Bytes()
 	require.True(t, std.Valid(data), "Encoded: %s", data)
 
-	var typ2 RedfishJobJobsItemMessagesItemResolutionStepsItemActionParametersItem
+	var typ2 RedfishJobJobsItemMessagesItemResolutionStepsItemActionParametersItem
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
+func TestRedfishJobJobsItemParameters_EncodeDecode(t *testing.T) {
+	var typ RedfishJobJobsItemParameters
+	typ = make(RedfishJobJobsItemParameters)
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 RedfishJobJobsItemParameters
+	typ2 = make(RedfishJobJobsItemParameters)
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
+func TestRedfishJobJobsItemPayload_EncodeDecode(t *testing.T) {
+	var typ RedfishJobJobsItemPayload
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 RedfishJobJobsItemPayload
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
+func TestRedfishJobJobsItemSchedule_EncodeDecode(t *testing.T) {
+	var typ RedfishJobJobsItemSchedule
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 RedfishJobJobsItemSchedule
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
+func TestRedfishMetricReport_EncodeDecode(t *testing.T) {
+	var typ RedfishMetricReport
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 RedfishMetricReport
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
+func TestRedfishMetricReportReportsItem_EncodeDecode(t *testing.T) {
+	var typ RedfishMetricReportReportsItem
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 RedfishMetricReportReportsItem
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
+func TestRedfishMetricReportReportsItemMessageDotExtendedInfoItem_EncodeDecode(t *testing.T) {
+	var typ RedfishMetricReportReportsItemMessageDotExtendedInfoItem
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 RedfishMetricReportReportsItemMessageDotExtendedInfoItem
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
+func TestRedfishMetricReportReportsItemMessageDotExtendedInfoItemResolutionStepsItem_EncodeDecode(t *testing.T) {
+	var typ RedfishMetricReportReportsItemMessageDotExtendedInfoItemResolutionStepsItem
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 RedfishMetricReportReportsItemMessageDotExtendedInfoItemResolutionStepsItem
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
+func TestRedfishMetricReportReportsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem_EncodeDecode(t *testing.T) {
+	var typ RedfishMetricReportReportsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 RedfishMetricReportReportsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
+func TestRedfishMetricReportReportsItemMetricValuesItem_EncodeDecode(t *testing.T) {
+	var typ RedfishMetricReportReportsItemMetricValuesItem
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 RedfishMetricReportReportsItemMetricValuesItem
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
+func TestRedfishSystem_EncodeDecode(t *testing.T) {
+	var typ RedfishSystem
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 RedfishSystem
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
+func TestRedfishSystemNicFirmware_EncodeDecode(t *testing.T) {
+	var typ RedfishSystemNicFirmware
+	typ = make(RedfishSystemNicFirmware)
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 RedfishSystemNicFirmware
+	typ2 = make(RedfishSystemNicFirmware)
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
+func TestRedfishSystemOemDell_EncodeDecode(t *testing.T) {
+	var typ RedfishSystemOemDell
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 RedfishSystemOemDell
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
+func TestRedfishSystemOemDellMessageDotExtendedInfoItem_EncodeDecode(t *testing.T) {
+	var typ RedfishSystemOemDellMessageDotExtendedInfoItem
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 RedfishSystemOemDellMessageDotExtendedInfoItem
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
+func TestRedfishSystemOemDellMessageDotExtendedInfoItemResolutionStepsItem_EncodeDecode(t *testing.T) {
+	var typ RedfishSystemOemDellMessageDotExtendedInfoItemResolutionStepsItem
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 RedfishSystemOemDellMessageDotExtendedInfoItemResolutionStepsItem
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
+func TestRedfishSystemOemDellMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem_EncodeDecode(t *testing.T) {
+	var typ RedfishSystemOemDellMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 RedfishSystemOemDellMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
+func TestReloadResponse_EncodeDecode(t *testing.T) {
+	var typ ReloadResponse
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 ReloadResponse
 	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
 }
-func TestRedfishJobJobsItemParameters_EncodeDecode(t *testing.T) {
-	var typ RedfishJobJobsItemParameters
-	typ = make(RedfishJobJobsItemParameters)
+func TestRevokedCert_EncodeDecode(t *testing.T) {
+	var typ RevokedCert
 	typ.SetFake()
 
 	e := jx.Encoder{}
@@ -823,12 +2340,11 @@ func TestRedfishJobJobsItemParameters_EncodeDecode(t *testing.T) {
 	data := e.Bytes()
 	require.True(t, std.Valid(data), "Encoded: %s", data)
 
-	var typ2 RedfishJobJobsItemParameters
-	typ2 = make(RedfishJobJobsItemParameters)
+	var typ2 RevokedCert
 	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
 }
-func TestRedfishJobJobsItemPayload_EncodeDecode(t *testing.T) {
-	var typ RedfishJobJobsItemPayload
+func TestScheduleRequest_EncodeDecode(t *testing.T) {
+	var typ ScheduleRequest
 	typ.SetFake()
 
 	e := jx.Encoder{}
@@ -836,11 +2352,11 @@ func TestRedfishJobJobsItemPayload_EncodeDecode(t *testing.T) {
 	data := e.Bytes()
 	require.True(t, std.Valid(data), "Encoded: %s", data)
 
-	var typ2 RedfishJobJobsItemPayload
+	var typ2 ScheduleRequest
 	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
 }
-func TestRedfishJobJobsItemSchedule_EncodeDecode(t *testing.T) {
-	var typ RedfishJobJobsItemSchedule
+func TestScheduledAction_EncodeDecode(t *testing.T) {
+	var typ ScheduledAction
 	typ.SetFake()
 
 	e := jx.Encoder{}
@@ -848,11 +2364,11 @@ func TestRedfishJobJobsItemSchedule_EncodeDecode(t *testing.T) {
 	data := e.Bytes()
 	require.True(t, std.Valid(data), "Encoded: %s", data)
 
-	var typ2 RedfishJobJobsItemSchedule
+	var typ2 ScheduledAction
 	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
 }
-func TestRedfishMetricReport_EncodeDecode(t *testing.T) {
-	var typ RedfishMetricReport
+func TestScheduledActionResultsItem_EncodeDecode(t *testing.T) {
+	var typ ScheduledActionResultsItem
 	typ.SetFake()
 
 	e := jx.Encoder{}
@@ -860,11 +2376,11 @@ func TestRedfishMetricReport_EncodeDecode(t *testing.T) {
 	data := e.Bytes()
 	require.True(t, std.Valid(data), "Encoded: %s", data)
 
-	var typ2 RedfishMetricReport
+	var typ2 ScheduledActionResultsItem
 	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
 }
-func TestRedfishMetricReportReportsItem_EncodeDecode(t *testing.T) {
-	var typ RedfishMetricReportReportsItem
+func TestScheduledActionResultsItemRedfishError_EncodeDecode(t *testing.T) {
+	var typ ScheduledActionResultsItemRedfishError
 	typ.SetFake()
 
 	e := jx.Encoder{}
@@ -872,11 +2388,11 @@ func TestRedfishMetricReportReportsItem_EncodeDecode(t *testing.T) {
 	data := e.Bytes()
 	require.True(t, std.Valid(data), "Encoded: %s", data)
 
-	var typ2 RedfishMetricReportReportsItem
+	var typ2 ScheduledActionResultsItemRedfishError
 	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
 }
-func TestRedfishMetricReportReportsItemMessageDotExtendedInfoItem_EncodeDecode(t *testing.T) {
-	var typ RedfishMetricReportReportsItemMessageDotExtendedInfoItem
+func TestScheduledActionResultsItemRedfishErrorError_EncodeDecode(t *testing.T) {
+	var typ ScheduledActionResultsItemRedfishErrorError
 	typ.SetFake()
 
 	e := jx.Encoder{}
@@ -884,11 +2400,11 @@ func TestRedfishMetricReportReportsItemMessageDotExtendedInfoItem_EncodeDecode(t
 	data := e.Bytes()
 	require.True(t, std.Valid(data), "Encoded: %s", data)
 
-	var typ2 RedfishMetricReportReportsItemMessageDotExtendedInfoItem
+	var typ2 ScheduledActionResultsItemRedfishErrorError
 	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
 }
-func TestRedfishMetricReportReportsItemMessageDotExtendedInfoItemResolutionStepsItem_EncodeDecode(t *testing.T) {
-	var typ RedfishMetricReportReportsItemMessageDotExtendedInfoItemResolutionStepsItem
+func TestScheduledActionResultsItemRedfishErrorErrorMessageDotExtendedInfoItem_EncodeDecode(t *testing.T) {
+	var typ ScheduledActionResultsItemRedfishErrorErrorMessageDotExtendedInfoItem
 	typ.SetFake()
 
 	e := jx.Encoder{}
@@ -896,11 +2412,11 @@ func TestRedfishMetricReportReportsItemMessageDotExtendedInfoItemResolutionSteps
 	data := e.Bytes()
 	require.True(t, std.Valid(data), "Encoded: %s", data)
 
-	var typ2 RedfishMetricReportReportsItemMessageDotExtendedInfoItemResolutionStepsItem
+	var typ2 ScheduledActionResultsItemRedfishErrorErrorMessageDotExtendedInfoItem
 	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
 }
-func TestRedfishMetricReportReportsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem_EncodeDecode(t *testing.T) {
-	var typ RedfishMetricReportReportsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem
+func TestSecret_EncodeDecode(t *testing.T) {
+	var typ Secret
 	typ.SetFake()
 
 	e := jx.Encoder{}
@@ -908,11 +2424,11 @@ func TestRedfishMetricReportReportsItemMessageDotExtendedInfoItemResolutionSteps
 	data := e.Bytes()
 	require.True(t, std.Valid(data), "Encoded: %s", data)
 
-	var typ2 RedfishMetricReportReportsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem
+	var typ2 Secret
 	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
 }
-func TestRedfishMetricReportReportsItemMetricValuesItem_EncodeDecode(t *testing.T) {
-	var typ RedfishMetricReportReportsItemMetricValuesItem
+func TestSecretRequest_EncodeDecode(t *testing.T) {
+	var typ SecretRequest
 	typ.SetFake()
 
 	e := jx.Encoder{}
@@ -920,11 +2436,11 @@ func TestRedfishMetricReportReportsItemMetricValuesItem_EncodeDecode(t *testing.
 	data := e.Bytes()
 	require.True(t, std.Valid(data), "Encoded: %s", data)
 
-	var typ2 RedfishMetricReportReportsItemMetricValuesItem
+	var typ2 SecretRequest
 	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
 }
-func TestRedfishSystem_EncodeDecode(t *testing.T) {
-	var typ RedfishSystem
+func TestSigningKey_EncodeDecode(t *testing.T) {
+	var typ SigningKey
 	typ.SetFake()
 
 	e := jx.Encoder{}
@@ -932,11 +2448,11 @@ func TestRedfishSystem_EncodeDecode(t *testing.T) {
 	data := e.Bytes()
 	require.True(t, std.Valid(data), "Encoded: %s", data)
 
-	var typ2 RedfishSystem
+	var typ2 SigningKey
 	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
 }
-func TestRedfishSystemOemDell_EncodeDecode(t *testing.T) {
-	var typ RedfishSystemOemDell
+func TestSigningKeyRotateRequest_EncodeDecode(t *testing.T) {
+	var typ SigningKeyRotateRequest
 	typ.SetFake()
 
 	e := jx.Encoder{}
@@ -944,11 +2460,11 @@ func TestRedfishSystemOemDell_EncodeDecode(t *testing.T) {
 	data := e.Bytes()
 	require.True(t, std.Valid(data), "Encoded: %s", data)
 
-	var typ2 RedfishSystemOemDell
+	var typ2 SigningKeyRotateRequest
 	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
 }
-func TestRedfishSystemOemDellMessageDotExtendedInfoItem_EncodeDecode(t *testing.T) {
-	var typ RedfishSystemOemDellMessageDotExtendedInfoItem
+func TestStats_EncodeDecode(t *testing.T) {
+	var typ Stats
 	typ.SetFake()
 
 	e := jx.Encoder{}
@@ -956,11 +2472,11 @@ func TestRedfishSystemOemDellMessageDotExtendedInfoItem_EncodeDecode(t *testing.
 	data := e.Bytes()
 	require.True(t, std.Valid(data), "Encoded: %s", data)
 
-	var typ2 RedfishSystemOemDellMessageDotExtendedInfoItem
+	var typ2 Stats
 	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
 }
-func TestRedfishSystemOemDellMessageDotExtendedInfoItemResolutionStepsItem_EncodeDecode(t *testing.T) {
-	var typ RedfishSystemOemDellMessageDotExtendedInfoItemResolutionStepsItem
+func TestStatsHosts_EncodeDecode(t *testing.T) {
+	var typ StatsHosts
 	typ.SetFake()
 
 	e := jx.Encoder{}
@@ -968,11 +2484,11 @@ func TestRedfishSystemOemDellMessageDotExtendedInfoItemResolutionStepsItem_Encod
 	data := e.Bytes()
 	require.True(t, std.Valid(data), "Encoded: %s", data)
 
-	var typ2 RedfishSystemOemDellMessageDotExtendedInfoItemResolutionStepsItem
+	var typ2 StatsHosts
 	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
 }
-func TestRedfishSystemOemDellMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem_EncodeDecode(t *testing.T) {
-	var typ RedfishSystemOemDellMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem
+func TestStatsHostsBootImagesItem_EncodeDecode(t *testing.T) {
+	var typ StatsHostsBootImagesItem
 	typ.SetFake()
 
 	e := jx.Encoder{}
@@ -980,7 +2496,287 @@ func TestRedfishSystemOemDellMessageDotExtendedInfoItemResolutionStepsItemAction
 	data := e.Bytes()
 	require.True(t, std.Valid(data), "Encoded: %s", data)
 
-	var typ2 RedfishSystemOemDellMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem
+	var typ2 StatsHostsBootImagesItem
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
+func TestStatsHostsTagsItem_EncodeDecode(t *testing.T) {
+	var typ StatsHostsTagsItem
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 StatsHostsTagsItem
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
+func TestSwitchScanResponse_EncodeDecode(t *testing.T) {
+	var typ SwitchScanResponse
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 SwitchScanResponse
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
+func TestSwitchScanResponseMatchedItem_EncodeDecode(t *testing.T) {
+	var typ SwitchScanResponseMatchedItem
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 SwitchScanResponseMatchedItem
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
+func TestSwitchScanResponseUnmatchedItem_EncodeDecode(t *testing.T) {
+	var typ SwitchScanResponseUnmatchedItem
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 SwitchScanResponseUnmatchedItem
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
+func TestSwitchVerifyResponse_EncodeDecode(t *testing.T) {
+	var typ SwitchVerifyResponse
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 SwitchVerifyResponse
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
+func TestSwitchVerifyResponseMismatchedItem_EncodeDecode(t *testing.T) {
+	var typ SwitchVerifyResponseMismatchedItem
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 SwitchVerifyResponseMismatchedItem
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
+func TestSwitchVerifyResponseMissingItem_EncodeDecode(t *testing.T) {
+	var typ SwitchVerifyResponseMissingItem
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 SwitchVerifyResponseMissingItem
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
+func TestSwitchVerifyResponseUnknownItem_EncodeDecode(t *testing.T) {
+	var typ SwitchVerifyResponseUnknownItem
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 SwitchVerifyResponseUnknownItem
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
+func TestSwitchVerifyResponseVerifiedItem_EncodeDecode(t *testing.T) {
+	var typ SwitchVerifyResponseVerifiedItem
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 SwitchVerifyResponseVerifiedItem
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
+func TestTombstone_EncodeDecode(t *testing.T) {
+	var typ Tombstone
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 Tombstone
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
+func TestTrashedHost_EncodeDecode(t *testing.T) {
+	var typ TrashedHost
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 TrashedHost
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
+func TestTrashedHostHost_EncodeDecode(t *testing.T) {
+	var typ TrashedHostHost
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 TrashedHostHost
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
+func TestTrashedHostHostBondsItem_EncodeDecode(t *testing.T) {
+	var typ TrashedHostHostBondsItem
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 TrashedHostHostBondsItem
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
+func TestTrashedHostHostBondsItemAddressesItem_EncodeDecode(t *testing.T) {
+	var typ TrashedHostHostBondsItemAddressesItem
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 TrashedHostHostBondsItemAddressesItem
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
+func TestTrashedHostHostBondsItemOptions_EncodeDecode(t *testing.T) {
+	var typ TrashedHostHostBondsItemOptions
+	typ = make(TrashedHostHostBondsItemOptions)
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 TrashedHostHostBondsItemOptions
+	typ2 = make(TrashedHostHostBondsItemOptions)
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
+func TestTrashedHostHostHardware_EncodeDecode(t *testing.T) {
+	var typ TrashedHostHostHardware
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 TrashedHostHostHardware
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
+func TestTrashedHostHostHardwareDimmsItem_EncodeDecode(t *testing.T) {
+	var typ TrashedHostHostHardwareDimmsItem
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 TrashedHostHostHardwareDimmsItem
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
+func TestTrashedHostHostHardwareDisksItem_EncodeDecode(t *testing.T) {
+	var typ TrashedHostHostHardwareDisksItem
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 TrashedHostHostHardwareDisksItem
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
+func TestTrashedHostHostHardwareNicsItem_EncodeDecode(t *testing.T) {
+	var typ TrashedHostHostHardwareNicsItem
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 TrashedHostHostHardwareNicsItem
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
+func TestTrashedHostHostInterfacesItem_EncodeDecode(t *testing.T) {
+	var typ TrashedHostHostInterfacesItem
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 TrashedHostHostInterfacesItem
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
+func TestTrashedHostHostInterfacesItemAddressesItem_EncodeDecode(t *testing.T) {
+	var typ TrashedHostHostInterfacesItemAddressesItem
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 TrashedHostHostInterfacesItemAddressesItem
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
+func TestTrashedHostHostInventory_EncodeDecode(t *testing.T) {
+	var typ TrashedHostHostInventory
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 TrashedHostHostInventory
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
+func TestTrashedHostHostInventoryNicFirmware_EncodeDecode(t *testing.T) {
+	var typ TrashedHostHostInventoryNicFirmware
+	typ = make(TrashedHostHostInventoryNicFirmware)
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 TrashedHostHostInventoryNicFirmware
+	typ2 = make(TrashedHostHostInventoryNicFirmware)
 	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
 }
 func TestUser_EncodeDecode(t *testing.T) {
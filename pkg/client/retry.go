@@ -0,0 +1,102 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package client
+
+import (
+	"io"
+	"net/http"
+	"time"
+)
+
+// retryTransport retries requests after a connection error or 5xx response
+// with exponential backoff. Requests which are not idempotent are only
+// retried when the server was unavailable and the request was not processed.
+type retryTransport struct {
+	next    http.RoundTripper
+	retries int
+	waitMin time.Duration
+	waitMax time.Duration
+}
+
+func newRetryTransport(next http.RoundTripper, retries int, waitMin, waitMax time.Duration) *retryTransport {
+	if waitMin == 0 {
+		waitMin = 500 * time.Millisecond
+	}
+	if waitMax == 0 {
+		waitMax = 10 * time.Second
+	}
+
+	return &retryTransport{
+		next:    next,
+		retries: retries,
+		waitMin: waitMin,
+		waitMax: waitMax,
+	}
+}
+
+func (t *retryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	// Requests with a body which can not be replayed are sent once
+	if req.Body != nil && req.Body != http.NoBody && req.GetBody == nil {
+		return t.next.RoundTrip(req)
+	}
+
+	wait := t.waitMin
+	for attempt := 0; ; attempt++ {
+		r := req
+		if attempt > 0 && req.GetBody != nil {
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, err
+			}
+			r = req.Clone(req.Context())
+			r.Body = body
+		}
+
+		res, err := t.next.RoundTrip(r)
+		if attempt >= t.retries || !shouldRetry(req, res, err) {
+			return res, err
+		}
+
+		if res != nil {
+			io.Copy(io.Discard, res.Body)
+			res.Body.Close()
+		}
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-req.Context().Done():
+			timer.Stop()
+			return nil, req.Context().Err()
+		case <-timer.C:
+		}
+
+		wait = min(wait*2, t.waitMax)
+	}
+}
+
+func shouldRetry(req *http.Request, res *http.Response, err error) bool {
+	if req.Context().Err() != nil {
+		return false
+	}
+
+	idempotent := false
+	switch req.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		idempotent = true
+	}
+
+	if err != nil {
+		return idempotent
+	}
+
+	switch {
+	case res.StatusCode == http.StatusBadGateway, res.StatusCode == http.StatusServiceUnavailable:
+		return true
+	case res.StatusCode >= 500:
+		return idempotent
+	}
+
+	return false
+}
@@ -0,0 +1,30 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package model
+
+// BiosReport is the result of reading, comparing or applying the BIOS
+// attributes of one host. Attributes is only set when reading the attributes
+// and Deviations lists the attributes which differ from a profile.
+type BiosReport struct {
+	Host           string            `json:"host"`
+	Status         string            `json:"status"`
+	Msg            string            `json:"msg"`
+	Attributes     map[string]string `json:"attributes,omitempty" oai3:"nullable"`
+	Deviations     []BiosDeviation   `json:"deviations"`
+	RebootRequired bool              `json:"reboot_required"`
+	JobID          string            `json:"job_id,omitempty"`
+}
+
+type BiosReportList []BiosReport
+
+// BiosDeviation is a BIOS attribute whose current value differs from the
+// value in a profile. Pending is set when the profile value is already set to
+// apply on the next reboot.
+type BiosDeviation struct {
+	Attribute string `json:"attribute"`
+	Current   string `json:"current"`
+	Profile   string `json:"profile"`
+	Pending   bool   `json:"pending"`
+}
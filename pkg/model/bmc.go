@@ -15,6 +15,19 @@ type OSPower struct {
 	BootOption  schemas.BootSource `json:"boot_option"`
 }
 
+// Simplified power states of a host reported by bmc power status
+const (
+	PowerStateOn      = "on"
+	PowerStateOff     = "off"
+	PowerStateUnknown = "unknown"
+)
+
+// Virtual media states of a host reported by bmc vmedia status
+const (
+	VirtualMediaInserted = "inserted"
+	VirtualMediaEjected  = "ejected"
+)
+
 type JobMessageList []JobMessage
 
 type JobMessage struct {
@@ -25,6 +38,27 @@ type JobMessage struct {
 	Data         string       `json:"data"`
 }
 
+// States of the Redfish event subscription of a host
+const (
+	SubscriptionActive  = "active"
+	SubscriptionCreated = "created"
+	SubscriptionMissing = "missing"
+)
+
+type EventSubscriptionList []EventSubscription
+
+// EventSubscription is the state of the Redfish event subscription of a host
+// pointing at the event receiver of the server. ID is the URI of the
+// subscription on the BMC. The destination is left out as it holds the event
+// token of the host.
+type EventSubscription struct {
+	Host   string `json:"host"`
+	Status string `json:"status"`
+	Msg    string `json:"msg"`
+	State  string `json:"state"`
+	ID     string `json:"id"`
+}
+
 type RedfishJobList []RedfishJob
 type RedfishJob struct {
 	Host string         `json:"name"`
@@ -39,19 +73,21 @@ type RedfishMetricReport struct {
 
 type RedfishSystemList []RedfishSystem
 type RedfishSystem struct {
-	Name           string         `json:"name"`
-	HostName       string         `json:"host_name"`
-	BIOSVersion    string         `json:"bios_version"`
-	SerialNumber   string         `json:"serial_number"`
-	Manufacturer   string         `json:"manufacturer"`
-	Model          string         `json:"model"`
-	PowerStatus    string         `json:"power_status"`
-	Health         string         `json:"health"`
-	TotalMemory    float32        `json:"total_memory"`
-	ProcessorCount int            `json:"processor_count"`
-	BootNext       string         `json:"boot_next"`
-	BootOrder      []string       `json:"boot_order" oai3:"nullable"`
-	OEMDell        dell.OEMSystem `json:"oem_dell" oai3:"nullable"`
+	Name           string            `json:"name"`
+	HostName       string            `json:"host_name"`
+	BIOSVersion    string            `json:"bios_version"`
+	BMCFirmware    string            `json:"bmc_firmware"`
+	NICFirmware    map[string]string `json:"nic_firmware" oai3:"nullable"`
+	SerialNumber   string            `json:"serial_number"`
+	Manufacturer   string            `json:"manufacturer"`
+	Model          string            `json:"model"`
+	PowerStatus    string            `json:"power_status"`
+	Health         string            `json:"health"`
+	TotalMemory    float32           `json:"total_memory"`
+	ProcessorCount int               `json:"processor_count"`
+	BootNext       string            `json:"boot_next"`
+	BootOrder      []string          `json:"boot_order" oai3:"nullable"`
+	OEMDell        dell.OEMSystem    `json:"oem_dell" oai3:"nullable"`
 }
 
 type RedfishDellUpgradeFirmwareList []RedfishDellUpgradeFirmware
@@ -4,35 +4,138 @@
 
 package model
 
-import "encoding/json"
+import (
+	"encoding/json"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+)
 
+// BondModes are the valid Linux bonding driver modes
+var BondModes = []string{"balance-rr", "active-backup", "balance-xor", "broadcast", "802.3ad", "balance-tlb", "balance-alb"}
+
+// Bond is a logical interface of a host, either a bond or a bridge, made up
+// of member interfaces. Peers are the names or MAC addresses of the members
 type Bond struct {
 	NetInterface
-	Peers []string `json:"peers"`
+	Type    string            `json:"type,omitempty"`
+	Peers   []string          `json:"peers"`
+	Mode    string            `json:"mode,omitempty"`
+	Options map[string]string `json:"options,omitempty"`
 }
 
 func (b *Bond) MarshalJSON() ([]byte, error) {
 	type Alias NetInterface
 	return json.Marshal(&struct {
-		Peers *[]string `json:"peers"`
+		Type    string            `json:"type,omitempty"`
+		Peers   *[]string         `json:"peers"`
+		Mode    string            `json:"mode,omitempty"`
+		Options map[string]string `json:"options,omitempty"`
 		*Alias
 	}{
-		Peers: &b.Peers,
-		Alias: (*Alias)(&b.NetInterface),
+		Type:    b.Type,
+		Peers:   &b.Peers,
+		Mode:    b.Mode,
+		Options: b.Options,
+		Alias:   (*Alias)(&b.NetInterface),
 	})
 }
 
 func (b *Bond) UnmarshalJSON(data []byte) error {
 	type Alias NetInterface
 	aux := &struct {
-		Peers *[]string `json:"peers"`
+		Type    *string            `json:"type"`
+		Peers   *[]string          `json:"peers"`
+		Mode    *string            `json:"mode"`
+		Options *map[string]string `json:"options"`
+		VLAN    json.RawMessage    `json:"vlan"`
 		*Alias
 	}{
-		Peers: &b.Peers,
-		Alias: (*Alias)(&b.NetInterface),
+		Type:    &b.Type,
+		Peers:   &b.Peers,
+		Mode:    &b.Mode,
+		Options: &b.Options,
+		Alias:   (*Alias)(&b.NetInterface),
 	}
 	if err := json.Unmarshal(data, &aux); err != nil {
 		return err
 	}
-	return nil
+
+	vlan, err := unmarshalVLAN(aux.VLAN)
+	if err != nil {
+		return err
+	}
+	b.VLAN = vlan
+
+	return b.normalizeAddresses()
+}
+
+// NicType returns NicTypeBridge if the interface is a bridge and
+// NicTypeBond otherwise
+func (b *Bond) NicType() NicType {
+	if b.Type == NicTypeBridge.String() {
+		return NicTypeBridge
+	}
+
+	return NicTypeBond
+}
+
+// IsBridge returns true if the interface is a bridge
+func (b *Bond) IsBridge() bool {
+	return b.NicType() == NicTypeBridge
+}
+
+// Validate checks the type of the interface, that the mode is a valid
+// bonding mode and is only set on bonds, and the VLAN of the interface
+func (b *Bond) Validate() error {
+	if b.Type != "" && b.Type != NicTypeBond.String() && b.Type != NicTypeBridge.String() {
+		return fmt.Errorf("invalid type %s for interface %s: must be bond or bridge", b.Type, b.displayName())
+	}
+
+	if b.Mode != "" {
+		if b.IsBridge() {
+			return fmt.Errorf("mode not supported for bridge %s", b.displayName())
+		}
+		if !slices.Contains(BondModes, b.Mode) {
+			return fmt.Errorf("invalid mode %s for bond %s: must be one of %s", b.Mode, b.displayName(), strings.Join(BondModes, ", "))
+		}
+	}
+
+	return b.NetInterface.Validate()
+}
+
+// HasPeer returns true if the given interface is a member of the bond,
+// matching the peers by interface name or MAC address
+func (b *Bond) HasPeer(nic *NetInterface) bool {
+	for _, p := range b.Peers {
+		if nic.isPeer(p) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// isPeer returns true if peer is the name or MAC address of the interface
+func (n *NetInterface) isPeer(peer string) bool {
+	if peer == "" {
+		return false
+	}
+
+	return peer == n.Name || (n.MAC != nil && strings.EqualFold(peer, n.MAC.String()))
+}
+
+// Parameters returns the bonding mode and options of the interface sorted by
+// name, such as mode=802.3ad and miimon=100
+func (b *Bond) Parameters() []string {
+	params := make([]string, 0, len(b.Options)+1)
+	if b.Mode != "" {
+		params = append(params, "mode="+b.Mode)
+	}
+	for _, k := range slices.Sorted(maps.Keys(b.Options)) {
+		params = append(params, k+"="+b.Options[k])
+	}
+
+	return params
 }
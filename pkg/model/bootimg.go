@@ -10,6 +10,8 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"strings"
+	"time"
 
 	"github.com/segmentio/ksuid"
 )
@@ -20,18 +22,145 @@ type BootImage struct {
 	ID                 int64             `json:"id" oai3:"nullable"`
 	UID                ksuid.KSUID       `json:"uid" oai3:"typeStr,nullable"`
 	Name               string            `json:"name" validate:"required"`
-	KernelPath         string            `json:"kernel" validate:"required"`
+	KernelPath         string            `json:"kernel"`
+	Inherits           string            `json:"inherits,omitempty" description:"name of the parent image the unset fields are inherited from"`
 	InitrdPaths        []string          `json:"initrd"`
 	LiveImage          string            `json:"liveimg"`
 	CommandLine        string            `json:"cmdline"`
 	Verify             bool              `json:"verify"`
 	ProvisionTemplates map[string]string `json:"provision_templates" oai3:"nullable"`
+	Revision           int64             `json:"revision,omitempty" oai3:"nullable"`
+	CreatedAt          time.Time         `json:"created_at,omitzero" oai3:"nullable"`
+	UpdatedAt          time.Time         `json:"updated_at,omitzero" oai3:"nullable"`
 }
 
+// AppendPrefix marks the initrds and command line of an image appended to the
+// ones of the image it inherits instead of replacing them
+const AppendPrefix = "+"
+
+// MaxInheritDepth is the number of parents an image may have
+const MaxInheritDepth = 8
+
+var ErrBootImageCycle = errors.New("boot image inherits itself")
+
 func NewBootImageList() BootImageList {
 	return make(BootImageList, 0)
 }
 
+// Resolve returns the image named name with the fields it inherits from the
+// images of the list
+func (bl BootImageList) Resolve(name string) (*BootImage, error) {
+	return ResolveBootImage(name, func(n string) (*BootImage, error) {
+		for _, image := range bl {
+			if image.Name == n {
+				return image, nil
+			}
+		}
+		return nil, fmt.Errorf("boot image %s not found", n)
+	})
+}
+
+// ResolveBootImage returns the image named name, loaded with load, with the
+// unset fields inherited from its parents
+func ResolveBootImage(name string, load func(string) (*BootImage, error)) (*BootImage, error) {
+	chain := make([]*BootImage, 0, 1)
+	seen := make(map[string]bool)
+	for next := name; ; {
+		if seen[next] {
+			return nil, fmt.Errorf("%s: %w", name, ErrBootImageCycle)
+		}
+		if len(chain) > MaxInheritDepth {
+			return nil, fmt.Errorf("%s: image has more than %d parents", name, MaxInheritDepth)
+		}
+		seen[next] = true
+
+		image, err := load(next)
+		if err != nil {
+			return nil, err
+		}
+		chain = append(chain, image)
+		if next = image.Inherits; next == "" {
+			break
+		}
+	}
+
+	resolved := chain[len(chain)-1].Inherit(nil)
+	for i := len(chain) - 2; i >= 0; i-- {
+		resolved = chain[i].Inherit(resolved)
+	}
+
+	return resolved, nil
+}
+
+// Inherit returns a copy of b with the fields it does not set taken from
+// parent, which is already resolved. Initrds and the command line starting
+// with AppendPrefix are appended to the ones of parent, and templates are
+// merged with the ones of parent. The copy inherits nothing
+func (b *BootImage) Inherit(parent *BootImage) *BootImage {
+	image := *b
+	image.Inherits = ""
+	image.InitrdPaths = make([]string, 0, len(b.InitrdPaths))
+	image.ProvisionTemplates = make(map[string]string)
+	if parent == nil {
+		parent = &BootImage{}
+	}
+
+	if image.KernelPath == "" {
+		image.KernelPath = parent.KernelPath
+	}
+	if image.LiveImage == "" {
+		image.LiveImage = parent.LiveImage
+	}
+	image.Verify = b.Verify || parent.Verify
+
+	appended := make([]string, 0)
+	for _, rd := range b.InitrdPaths {
+		if path, ok := strings.CutPrefix(rd, AppendPrefix); ok {
+			appended = append(appended, path)
+		} else {
+			image.InitrdPaths = append(image.InitrdPaths, rd)
+		}
+	}
+	if len(image.InitrdPaths) == 0 {
+		image.InitrdPaths = append(image.InitrdPaths, parent.InitrdPaths...)
+	}
+	image.InitrdPaths = append(image.InitrdPaths, appended...)
+
+	if cmdline, ok := strings.CutPrefix(b.CommandLine, AppendPrefix); ok {
+		image.CommandLine = strings.TrimSpace(parent.CommandLine + " " + strings.TrimSpace(cmdline))
+	} else if b.CommandLine == "" {
+		image.CommandLine = parent.CommandLine
+	}
+
+	for ttype, tmpl := range parent.ProvisionTemplates {
+		image.ProvisionTemplates[ttype] = tmpl
+	}
+	for ttype, tmpl := range b.ProvisionTemplates {
+		image.ProvisionTemplates[ttype] = tmpl
+	}
+
+	return &image
+}
+
+// UpdatedSince returns the images added or updated at or after t. Image
+// timestamps have a resolution of one second so t is truncated. The zero time
+// returns all images
+func (bl BootImageList) UpdatedSince(t time.Time) BootImageList {
+	if t.IsZero() {
+		return bl
+	}
+
+	t = t.Truncate(time.Second)
+	updated := make(BootImageList, 0)
+	for _, image := range bl {
+		if !image.UpdatedAt.Before(t) {
+			updated = append(updated, image)
+		}
+	}
+
+	return updated
+}
+
 func (b *BootImage) Scan(value interface{}) error {
 	data, ok := value.(string)
 	if !ok {
@@ -47,13 +176,32 @@ func (b *BootImage) Scan(value interface{}) error {
 	return nil
 }
 
+// Files returns the paths of the kernel, initrds and live image set by the
+// image, without the ones it inherits
+func (b *BootImage) Files() []string {
+	files := make([]string, 0, len(b.InitrdPaths)+2)
+	if b.KernelPath != "" {
+		files = append(files, b.KernelPath)
+	}
+	for _, rd := range b.InitrdPaths {
+		files = append(files, strings.TrimPrefix(rd, AppendPrefix))
+	}
+	if b.LiveImage != "" {
+		files = append(files, b.LiveImage)
+	}
+
+	return files
+}
+
 func (b *BootImage) CheckPathsExist() error {
-	if _, err := os.Stat(b.KernelPath); err != nil {
-		return err
+	if b.KernelPath != "" || b.Inherits == "" {
+		if _, err := os.Stat(b.KernelPath); err != nil {
+			return err
+		}
 	}
 
 	for _, i := range b.InitrdPaths {
-		if _, err := os.Stat(i); err != nil {
+		if _, err := os.Stat(strings.TrimPrefix(i, AppendPrefix)); err != nil {
 			return err
 		}
 	}
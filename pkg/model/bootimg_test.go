@@ -0,0 +1,67 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package model_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/ubccr/grendel/pkg/model"
+)
+
+func TestBootImageResolve(t *testing.T) {
+	images := model.BootImageList{
+		{
+			Name:               "rocky9",
+			KernelPath:         "/images/rocky9/vmlinuz",
+			InitrdPaths:        []string{"/images/rocky9/initrd.img"},
+			CommandLine:        "console=ttyS0",
+			ProvisionTemplates: map[string]string{"kickstart": "rocky9.tmpl", "post-install": "post.tmpl"},
+		},
+		{
+			Name:               "rocky9-gpu",
+			Inherits:           "rocky9",
+			InitrdPaths:        []string{"+/images/nvidia.img"},
+			CommandLine:        "+rd.driver.blacklist=nouveau",
+			ProvisionTemplates: map[string]string{"kickstart": "gpu.tmpl"},
+		},
+		{
+			Name:        "rocky9-gpu-debug",
+			Inherits:    "rocky9-gpu",
+			InitrdPaths: []string{"/images/debug.img"},
+			CommandLine: "rd.break",
+			Verify:      true,
+		},
+	}
+
+	gpu, err := images.Resolve("rocky9-gpu")
+	require.NoError(t, err)
+	assert.Equal(t, "", gpu.Inherits)
+	assert.Equal(t, "/images/rocky9/vmlinuz", gpu.KernelPath)
+	assert.Equal(t, []string{"/images/rocky9/initrd.img", "/images/nvidia.img"}, gpu.InitrdPaths)
+	assert.Equal(t, "console=ttyS0 rd.driver.blacklist=nouveau", gpu.CommandLine)
+	assert.Equal(t, map[string]string{"kickstart": "gpu.tmpl", "post-install": "post.tmpl"}, gpu.ProvisionTemplates)
+
+	// Initrds and a command line without the prefix replace the inherited ones
+	debug, err := images.Resolve("rocky9-gpu-debug")
+	require.NoError(t, err)
+	assert.Equal(t, "/images/rocky9/vmlinuz", debug.KernelPath)
+	assert.Equal(t, []string{"/images/debug.img"}, debug.InitrdPaths)
+	assert.Equal(t, "rd.break", debug.CommandLine)
+	assert.True(t, debug.Verify)
+
+	// The images of the list are unchanged
+	assert.Equal(t, "", images[1].KernelPath)
+	assert.Equal(t, map[string]string{"kickstart": "gpu.tmpl"}, images[1].ProvisionTemplates)
+	assert.Equal(t, []string{"/images/nvidia.img"}, images[1].Files())
+
+	images[0].Inherits = "rocky9-gpu-debug"
+	_, err = images.Resolve("rocky9-gpu")
+	assert.ErrorIs(t, err, model.ErrBootImageCycle)
+
+	_, err = images.Resolve("notfound")
+	assert.Error(t, err)
+}
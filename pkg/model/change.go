@@ -0,0 +1,51 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package model
+
+import "time"
+
+const (
+	ChangeKindHost      = "host"
+	ChangeKindImage     = "image"
+	ChangeKindDNSRecord = "dns_record"
+	ChangeKindSchedule  = "schedule"
+
+	ChangeOpCreate = "create"
+	ChangeOpUpdate = "update"
+	ChangeOpDelete = "delete"
+)
+
+type ChangeList []*Change
+
+// Change is an entry of the change journal recording one change to a host,
+// boot image, DNS record or scheduled action. DNS records are named by
+// Record.Key and scheduled actions by their ID. Seq
+// increases with every change and is never reused.
+type Change struct {
+	Seq       int64                  `json:"seq"`
+	ChangedAt time.Time              `json:"changed_at"`
+	Kind      string                 `json:"kind"`
+	Name      string                 `json:"name"`
+	Op        string                 `json:"op"`
+	Diff      map[string]FieldChange `json:"diff,omitempty" oai3:"nullable"`
+}
+
+// FieldChange is the old and new value of a changed field. Values are
+// formatted as strings, an empty string is an unset field
+type FieldChange struct {
+	Old string `json:"old"`
+	New string `json:"new"`
+}
+
+// ChangeFeed is a page of the change journal following a sequence number.
+// OldestSeq is the oldest entry kept, entries older than change_retention are
+// purged. Truncated is set when entries following the requested sequence
+// number were purged and the consumer must resync from a full dump.
+type ChangeFeed struct {
+	Changes   ChangeList `json:"changes"`
+	OldestSeq int64      `json:"oldest_seq"`
+	LatestSeq int64      `json:"latest_seq"`
+	Truncated bool       `json:"truncated"`
+}
@@ -0,0 +1,51 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package model
+
+import (
+	"fmt"
+	"regexp"
+)
+
+// CredentialKindBMC is the kind of the credentials used to log in to the BMC
+// of a host
+const CredentialKindBMC = "bmc"
+
+var validCredentialKind = regexp.MustCompile(`^[a-z0-9][a-z0-9_-]*$`)
+
+type CredentialList []*Credential
+
+// Credential is a secret of a host, such as the username and password of its
+// BMC, keyed by host name and kind. Secret is encrypted with the credentials
+// key of the server and is never returned by the API or written to a dump
+// unless secrets are explicitly included.
+type Credential struct {
+	Name   string `json:"name" validate:"required"`
+	Kind   string `json:"kind" validate:"required"`
+	Secret string `json:"secret,omitempty" oai3:"nullable"`
+}
+
+// Validate checks the credential kind is valid and the secret is set
+func (c *Credential) Validate() error {
+	if !validCredentialKind.MatchString(c.Kind) {
+		return fmt.Errorf("invalid credential kind for %s: %q", c.Name, c.Kind)
+	}
+
+	if c.Secret == "" {
+		return fmt.Errorf("missing secret for %s credential of %s", c.Kind, c.Name)
+	}
+
+	return nil
+}
+
+// Redacted returns a copy of the list without the secrets
+func (cl CredentialList) Redacted() CredentialList {
+	redacted := make(CredentialList, 0, len(cl))
+	for _, c := range cl {
+		redacted = append(redacted, &Credential{Name: c.Name, Kind: c.Kind})
+	}
+
+	return redacted
+}
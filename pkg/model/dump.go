@@ -4,8 +4,229 @@
 
 package model
 
+import (
+	"bytes"
+	"cmp"
+	"encoding/json"
+	"slices"
+	"time"
+
+	"github.com/segmentio/ksuid"
+)
+
 type DataDump struct {
-	Users  []User        `json:"Users"`
-	Hosts  HostList      `json:"Hosts"`
-	Images BootImageList `json:"Images"`
+	Users       []User         `json:"Users"`
+	Hosts       HostList       `json:"Hosts"`
+	Images      BootImageList  `json:"Images"`
+	DNSRecords  RecordList     `json:"DNSRecords"`
+	Credentials CredentialList `json:"Credentials,omitempty"`
+}
+
+// DataDumpDiff lists the changes needed to make a data store match a DataDump
+type DataDumpDiff struct {
+	Hosts      DiffSummary `json:"hosts"`
+	Images     DiffSummary `json:"images"`
+	DNSRecords DiffSummary `json:"dns_records"`
+}
+
+// DiffSummary lists the names of the added, updated and removed entries of
+// one kind. DNS records are listed by Record.Key
+type DiffSummary struct {
+	Added     []string `json:"added"`
+	Updated   []string `json:"updated"`
+	Removed   []string `json:"removed"`
+	Unchanged int      `json:"unchanged"`
+}
+
+func newDiffSummary() DiffSummary {
+	return DiffSummary{Added: []string{}, Updated: []string{}, Removed: []string{}}
+}
+
+// Changed returns the number of added, updated and removed entries
+func (s DiffSummary) Changed() int {
+	return len(s.Added) + len(s.Updated) + len(s.Removed)
+}
+
+// Changed returns the number of added, updated and removed entries
+func (d *DataDumpDiff) Changed() int {
+	return d.Hosts.Changed() + d.Images.Changed() + d.DNSRecords.Changed()
+}
+
+// Sort orders users, hosts, images, DNS records and credentials by name so
+// repeated dumps of the same data are identical
+func (d *DataDump) Sort() {
+	slices.SortFunc(d.Users, func(a, b User) int { return cmp.Compare(a.Username, b.Username) })
+	slices.SortFunc(d.Hosts, func(a, b *Host) int { return cmp.Compare(a.Name, b.Name) })
+	slices.SortFunc(d.Images, func(a, b *BootImage) int { return cmp.Compare(a.Name, b.Name) })
+	slices.SortFunc(d.DNSRecords, func(a, b *Record) int { return cmp.Compare(a.Key(), b.Key()) })
+	slices.SortFunc(d.Credentials, func(a, b *Credential) int {
+		return cmp.Or(cmp.Compare(a.Name, b.Name), cmp.Compare(a.Kind, b.Kind))
+	})
+}
+
+// Diff returns the changes needed to make current match d. Entries are
+// matched by name, database IDs, UIDs and revisions are ignored. Entries missing from d are only
+// removed if prune is true. Users and credentials are not compared.
+func (d *DataDump) Diff(current *DataDump, prune bool) *DataDumpDiff {
+	diff := &DataDumpDiff{
+		Hosts:      newDiffSummary(),
+		Images:     newDiffSummary(),
+		DNSRecords: newDiffSummary(),
+	}
+
+	hosts := make(map[string]*Host, len(current.Hosts))
+	for _, h := range current.Hosts {
+		hosts[h.Name] = h
+	}
+	names := make(map[string]bool, len(d.Hosts))
+	for _, h := range d.Hosts {
+		names[h.Name] = true
+		old, ok := hosts[h.Name]
+		switch {
+		case !ok:
+			diff.Hosts.Added = append(diff.Hosts.Added, h.Name)
+		case !equalHost(h, old):
+			diff.Hosts.Updated = append(diff.Hosts.Updated, h.Name)
+		default:
+			diff.Hosts.Unchanged++
+		}
+	}
+
+	images := make(map[string]*BootImage, len(current.Images))
+	for _, i := range current.Images {
+		images[i.Name] = i
+	}
+	imageNames := make(map[string]bool, len(d.Images))
+	for _, i := range d.Images {
+		imageNames[i.Name] = true
+		old, ok := images[i.Name]
+		switch {
+		case !ok:
+			diff.Images.Added = append(diff.Images.Added, i.Name)
+		case !equalImage(i, old):
+			diff.Images.Updated = append(diff.Images.Updated, i.Name)
+		default:
+			diff.Images.Unchanged++
+		}
+	}
+
+	records := make(map[string]*Record, len(current.DNSRecords))
+	for _, r := range current.DNSRecords {
+		records[r.Key()] = r
+	}
+	keys := make(map[string]bool, len(d.DNSRecords))
+	for _, r := range d.DNSRecords {
+		keys[r.Key()] = true
+		old, ok := records[r.Key()]
+		switch {
+		case !ok:
+			diff.DNSRecords.Added = append(diff.DNSRecords.Added, r.Key())
+		case r.TTL != old.TTL || r.PTR != old.PTR:
+			diff.DNSRecords.Updated = append(diff.DNSRecords.Updated, r.Key())
+		default:
+			diff.DNSRecords.Unchanged++
+		}
+	}
+
+	if prune {
+		for _, h := range current.Hosts {
+			if !names[h.Name] {
+				diff.Hosts.Removed = append(diff.Hosts.Removed, h.Name)
+			}
+		}
+		for _, i := range current.Images {
+			if !imageNames[i.Name] {
+				diff.Images.Removed = append(diff.Images.Removed, i.Name)
+			}
+		}
+		for _, r := range current.DNSRecords {
+			if !keys[r.Key()] {
+				diff.DNSRecords.Removed = append(diff.DNSRecords.Removed, r.Key())
+			}
+		}
+	}
+
+	return diff
+}
+
+// equalHost compares two hosts ignoring the UID, revision, timestamps and the
+// host and interface IDs. Empty and missing lists are equal and bonds without
+// a type are equal to bonds of type bond. The state is ignored when either
+// host has none, as in dumps made before host states.
+func equalHost(a, b *Host) bool {
+	ignoreState := a.State == "" || b.State == ""
+	return equalJSON(a, b, func(h *Host) {
+		if ignoreState {
+			h.State = ""
+		}
+		h.ID = 0
+		h.UID = ksuid.Nil
+		h.Revision = 0
+		h.CreatedAt = time.Time{}
+		h.UpdatedAt = time.Time{}
+		if len(h.Tags) == 0 {
+			h.Tags = nil
+		}
+		if len(h.Interfaces) == 0 {
+			h.Interfaces = nil
+		}
+		if len(h.Bonds) == 0 {
+			h.Bonds = nil
+		}
+		for _, n := range h.Interfaces {
+			n.ID = 0
+		}
+		for _, n := range h.Bonds {
+			n.ID = 0
+			n.Type = n.NicType().String()
+		}
+	})
+}
+
+// equalImage compares two boot images ignoring the ID, UID, revision and
+// timestamps. Empty and missing lists are equal.
+func equalImage(a, b *BootImage) bool {
+	return equalJSON(a, b, func(i *BootImage) {
+		i.ID = 0
+		i.UID = ksuid.Nil
+		i.Revision = 0
+		i.CreatedAt = time.Time{}
+		i.UpdatedAt = time.Time{}
+		if len(i.InitrdPaths) == 0 {
+			i.InitrdPaths = nil
+		}
+		if len(i.ProvisionTemplates) == 0 {
+			i.ProvisionTemplates = nil
+		}
+	})
+}
+
+// equalJSON compares the JSON encoding of copies of a and b after clear has
+// been applied to each
+func equalJSON[T any](a, b *T, clear func(*T)) bool {
+	ja, err := clearedJSON(a, clear)
+	if err != nil {
+		return false
+	}
+	jb, err := clearedJSON(b, clear)
+	if err != nil {
+		return false
+	}
+
+	return bytes.Equal(ja, jb)
+}
+
+func clearedJSON[T any](v *T, clear func(*T)) ([]byte, error) {
+	// Round trip through JSON for a deep copy
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	c := new(T)
+	if err := json.Unmarshal(data, c); err != nil {
+		return nil, err
+	}
+	clear(c)
+
+	return json.Marshal(c)
 }
@@ -14,6 +14,10 @@ type Event struct {
 	User        string
 	Message     string
 	JobMessages JobMessageList
+
+	// Namespace is the namespace of the hosts of the event, empty for the
+	// default namespace
+	Namespace string
 }
 
 type Severity string
@@ -0,0 +1,129 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package model
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+var (
+	// invalidGendersNameChars are replaced in genders attribute names. Genders
+	// splits on whitespace, commas, = and :
+	invalidGendersNameChars = regexp.MustCompile(`[^a-zA-Z0-9_.+-]`)
+
+	// invalidGendersValueChars are replaced in genders attribute values
+	invalidGendersValueChars = regexp.MustCompile(`[\s,#=\\]`)
+)
+
+// HostsFileLines returns the /etc/hosts lines of the interfaces and bonds of
+// the host with an address and FQDN: the address, the FQDNs and the short
+// name
+func (h *Host) HostsFileLines() []string {
+	nics := make([]*NetInterface, 0, len(h.Interfaces)+len(h.Bonds))
+	nics = append(nics, h.Interfaces...)
+	for _, bond := range h.Bonds {
+		nics = append(nics, &bond.NetInterface)
+	}
+
+	lines := make([]string, 0, len(nics))
+	for _, nic := range nics {
+		if !nic.IP.IsValid() || nic.FQDN == "" {
+			continue
+		}
+
+		names := strings.Split(nic.FQDN, ",")
+		if short := nic.ShortName(); short != names[0] {
+			names = append(names, short)
+		}
+		lines = append(lines, fmt.Sprintf("%s %s", nic.AddrString(), strings.Join(names, " ")))
+	}
+
+	return lines
+}
+
+// GendersLine returns the genders line of the host: its name and attributes.
+// The attributes are the tags, key=value and key:value tags as key=value, the
+// rack and the boot image, sorted so the file diffs cleanly. Genders rejects
+// an attribute given twice, the first value of a repeated key is kept
+func (h *Host) GendersLine() string {
+	values := make(map[string]string)
+	add := func(name, value string) {
+		name = GendersName(name)
+		if name == "" {
+			return
+		}
+		if _, ok := values[name]; !ok {
+			values[name] = invalidGendersValueChars.ReplaceAllString(value, "_")
+		}
+	}
+
+	for _, t := range h.Tags {
+		if key, value, ok := SplitTag(t); ok {
+			add(key, value)
+		} else {
+			add(t, "")
+		}
+	}
+	if rack := RackTag(h.Tags); rack != "" {
+		add("rack", rack)
+	}
+	if h.BootImage != "" {
+		add("bootimage", h.BootImage)
+	}
+
+	if len(values) == 0 {
+		return h.Name
+	}
+
+	attrs := make([]string, 0, len(values))
+	for name, value := range values {
+		if value == "" {
+			attrs = append(attrs, name)
+		} else {
+			attrs = append(attrs, name+"="+value)
+		}
+	}
+	sort.Strings(attrs)
+
+	return h.Name + " " + strings.Join(attrs, ",")
+}
+
+// GendersName replaces the characters not allowed in genders attribute and
+// ClusterShell group names with _
+func GendersName(name string) string {
+	return invalidGendersNameChars.ReplaceAllString(strings.TrimSpace(name), "_")
+}
+
+// SplitTag splits a key=value or key:value tag
+func SplitTag(tag string) (string, string, bool) {
+	if key, value, ok := strings.Cut(tag, "="); ok {
+		return key, value, true
+	}
+
+	return strings.Cut(tag, ":")
+}
+
+// RackTag returns the rack of a host from its tags: the value of a rack=<name>
+// or rack:<name> tag, or the first tag starting with rack
+func RackTag(tags []string) string {
+	for _, t := range tags {
+		for _, prefix := range []string{"rack=", "rack:"} {
+			if v, ok := strings.CutPrefix(t, prefix); ok {
+				return v
+			}
+		}
+	}
+
+	for _, t := range tags {
+		if strings.HasPrefix(t, "rack") {
+			return t
+		}
+	}
+
+	return ""
+}
@@ -0,0 +1,35 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package model_test
+
+import (
+	"net/netip"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/ubccr/grendel/pkg/model"
+)
+
+func TestRackTag(t *testing.T) {
+	assert.Equal(t, "a01", model.RackTag([]string{"gpu", "rack:a01"}))
+	assert.Equal(t, "rack10", model.RackTag([]string{"rack10", "hpc"}))
+	assert.Equal(t, "", model.RackTag([]string{"hpc"}))
+}
+
+func TestHostExportLines(t *testing.T) {
+	host := &model.Host{
+		Name:      "gpu-01",
+		BootImage: "rocky9-gpu",
+		Tags:      []string{"gpu", "rack:b02", "gres=gpu:a100:4", "gres=gpu:a100:8", "owner=chem lab"},
+		Interfaces: []*model.NetInterface{
+			{Name: "eno1", IP: netip.MustParsePrefix("10.0.0.1/24"), FQDN: "gpu-01.example.com,gpu01.example.com"},
+			{Name: "idrac", IP: netip.MustParsePrefix("10.0.1.1/24")},
+		},
+	}
+
+	assert.Equal(t, []string{"10.0.0.1 gpu-01.example.com gpu01.example.com gpu-01"}, host.HostsFileLines())
+	assert.Equal(t, "gpu-01 bootimage=rocky9-gpu,gpu,gres=gpu:a100:4,owner=chem_lab,rack=b02", host.GendersLine())
+	assert.Equal(t, "srv-01", (&model.Host{Name: "srv-01"}).GendersLine())
+}
@@ -0,0 +1,21 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package model
+
+// FirmwareUpdateReport is the result of updating the firmware of a component
+// of one host. Component is an inventory field and Previous its version before
+// the update. Skipped is set for hosts which were already on Version.
+type FirmwareUpdateReport struct {
+	Host      string     `json:"host"`
+	Status    string     `json:"status"`
+	Msg       string     `json:"msg"`
+	Component string     `json:"component"`
+	Previous  string     `json:"previous"`
+	Version   string     `json:"version"`
+	Skipped   bool       `json:"skipped"`
+	Inventory *Inventory `json:"inventory,omitempty" oai3:"nullable"`
+}
+
+type FirmwareUpdateReportList []FirmwareUpdateReport
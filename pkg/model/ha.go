@@ -0,0 +1,31 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package model
+
+import "time"
+
+const (
+	HARolePrimary   = "primary"
+	HARoleSecondary = "secondary"
+
+	HAStateServing = "serving"
+	HAStateStandby = "standby"
+	HAStateStopped = "stopped"
+)
+
+type HAInstanceList []*HAInstance
+
+// HAInstance is a grendel serve instance sharing the data store with others
+// in high availability mode. Heartbeats are written to the data store, a
+// secondary answers DHCP once the primary has been silent for
+// ha.failover_timeout
+type HAInstance struct {
+	Name      string    `json:"name"`
+	Role      string    `json:"role"`
+	State     string    `json:"state" description:"serving when answering DHCP, standby or stopped"`
+	Heartbeat time.Time `json:"heartbeat"`
+	Changed   time.Time `json:"changed" description:"Time the instance last started or stopped answering DHCP"`
+	Alive     bool      `json:"alive" description:"Heartbeat written within ha.failover_timeout of the API server"`
+}
@@ -0,0 +1,161 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package model
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+)
+
+const (
+	HardwareResourceProcessors = "processors"
+	HardwareResourceMemory     = "memory"
+	HardwareResourceStorage    = "storage"
+	HardwareResourceNICs       = "ethernet_interfaces"
+)
+
+// Hardware is the hardware of a host as last collected from its BMC over
+// Redfish. Missing lists the resources the BMC failed to report, which are
+// left empty and not compared between collections.
+type Hardware struct {
+	CPUModel    string         `json:"cpu_model,omitempty"`
+	CPUCount    int            `json:"cpu_count,omitempty"`
+	CoreCount   int            `json:"core_count,omitempty"`
+	MemoryMiB   int            `json:"memory_mib,omitempty"`
+	DIMMs       []HardwareDIMM `json:"dimms,omitempty" oai3:"nullable"`
+	Disks       []HardwareDisk `json:"disks,omitempty" oai3:"nullable"`
+	NICs        []HardwareNIC  `json:"nics,omitempty" oai3:"nullable"`
+	Missing     []string       `json:"missing,omitempty" oai3:"nullable,typeStrArr"`
+	CollectedAt time.Time      `json:"collected_at,omitzero" oai3:"nullable"`
+}
+
+// HardwareDIMM is a populated memory slot
+type HardwareDIMM struct {
+	Slot    string `json:"slot"`
+	SizeMiB int    `json:"size_mib"`
+}
+
+// HardwareDisk is a drive attached to a storage controller
+type HardwareDisk struct {
+	Name      string `json:"name"`
+	Model     string `json:"model,omitempty"`
+	Serial    string `json:"serial,omitempty"`
+	SizeBytes int64  `json:"size_bytes,omitempty"`
+}
+
+// HardwareNIC is a network interface of the system
+type HardwareNIC struct {
+	Name string `json:"name"`
+	MAC  string `json:"mac"`
+}
+
+// HardwareReport is the result of collecting the hardware of one host.
+// Changes lists the differences from the previous collection.
+type HardwareReport struct {
+	Host     string    `json:"host"`
+	Status   string    `json:"status"`
+	Msg      string    `json:"msg"`
+	Hardware *Hardware `json:"hardware,omitempty" oai3:"nullable"`
+	Changes  []string  `json:"changes" oai3:"nullable,typeStrArr"`
+}
+
+type HardwareReportList []HardwareReport
+
+// IsEmpty returns true if no hardware was collected
+func (h *Hardware) IsEmpty() bool {
+	return h == nil || (h.CPUModel == "" && h.CPUCount == 0 && h.MemoryMiB == 0 &&
+		len(h.DIMMs) == 0 && len(h.Disks) == 0 && len(h.NICs) == 0)
+}
+
+// collected returns true if resource was reported by the BMC
+func (h *Hardware) collected(resource string) bool {
+	return !slices.Contains(h.Missing, resource)
+}
+
+// Changes returns the differences from the previous collection prev, such as
+// a memory DIMM disappearing or the disk count changing. Resources missing
+// from either collection are not compared. A nil prev has no changes.
+func (h *Hardware) Changes(prev *Hardware) []string {
+	changes := make([]string, 0)
+	if h == nil || prev == nil {
+		return changes
+	}
+
+	both := func(resource string) bool {
+		return h.collected(resource) && prev.collected(resource)
+	}
+
+	if both(HardwareResourceProcessors) {
+		if prev.CPUModel != h.CPUModel {
+			changes = append(changes, fmt.Sprintf("cpu model changed from %q to %q", prev.CPUModel, h.CPUModel))
+		}
+		if prev.CPUCount != h.CPUCount {
+			changes = append(changes, fmt.Sprintf("cpu count changed from %d to %d", prev.CPUCount, h.CPUCount))
+		}
+		if prev.CoreCount != h.CoreCount {
+			changes = append(changes, fmt.Sprintf("core count changed from %d to %d", prev.CoreCount, h.CoreCount))
+		}
+	}
+
+	if both(HardwareResourceMemory) {
+		if prev.MemoryMiB != h.MemoryMiB {
+			changes = append(changes, fmt.Sprintf("memory changed from %d MiB to %d MiB", prev.MemoryMiB, h.MemoryMiB))
+		}
+		changes = append(changes, diffByKey("memory DIMM", prev.DIMMs, h.DIMMs,
+			func(d HardwareDIMM) string { return d.Slot },
+			func(d HardwareDIMM) string { return fmt.Sprintf("%d MiB", d.SizeMiB) })...)
+	}
+
+	if both(HardwareResourceStorage) {
+		if len(prev.Disks) != len(h.Disks) {
+			changes = append(changes, fmt.Sprintf("disk count changed from %d to %d", len(prev.Disks), len(h.Disks)))
+		}
+		changes = append(changes, diffByKey("disk", prev.Disks, h.Disks,
+			func(d HardwareDisk) string { return d.Name },
+			func(d HardwareDisk) string { return strings.TrimSpace(d.Model + " serial " + d.Serial) })...)
+	}
+
+	if both(HardwareResourceNICs) {
+		changes = append(changes, diffByKey("nic", prev.NICs, h.NICs,
+			func(n HardwareNIC) string { return n.Name },
+			func(n HardwareNIC) string { return n.MAC })...)
+	}
+
+	return changes
+}
+
+// diffByKey returns the items of kind that disappeared, appeared or changed
+// between prev and cur, matching items by key and comparing them by desc
+func diffByKey[T any](kind string, prev, cur []T, key, desc func(T) string) []string {
+	changes := make([]string, 0)
+
+	curByKey := make(map[string]T, len(cur))
+	for _, c := range cur {
+		curByKey[key(c)] = c
+	}
+	prevByKey := make(map[string]T, len(prev))
+	for _, p := range prev {
+		prevByKey[key(p)] = p
+
+		c, ok := curByKey[key(p)]
+		if !ok {
+			changes = append(changes, fmt.Sprintf("%s %s (%s) disappeared", kind, key(p), desc(p)))
+			continue
+		}
+		if desc(c) != desc(p) {
+			changes = append(changes, fmt.Sprintf("%s %s changed from %s to %s", kind, key(p), desc(p), desc(c)))
+		}
+	}
+
+	for _, c := range cur {
+		if _, ok := prevByKey[key(c)]; !ok {
+			changes = append(changes, fmt.Sprintf("%s %s (%s) appeared", kind, key(c), desc(c)))
+		}
+	}
+
+	return changes
+}
@@ -11,24 +11,37 @@ import (
 	"fmt"
 	"net"
 	"net/netip"
+	"slices"
 	"strings"
+	"time"
 
+	"github.com/google/uuid"
 	"github.com/segmentio/ksuid"
 	"github.com/tidwall/gjson"
 	"github.com/tidwall/sjson"
+	"github.com/ubccr/grendel/internal/config"
 	"github.com/ubccr/grendel/internal/firmware"
 )
 
 type Host struct {
-	ID         int64           `json:"id,omitempty" oai3:"nullable"`
-	UID        ksuid.KSUID     `json:"uid,omitempty" oai3:"typeStr,nullable"`
-	Name       string          `json:"name"`
-	Interfaces []*NetInterface `json:"interfaces"`
-	Bonds      []*Bond         `json:"bonds"`
-	Provision  bool            `json:"provision"`
-	Firmware   firmware.Build  `json:"firmware" oai3:"typeStr"`
-	BootImage  string          `json:"boot_image"`
-	Tags       []string        `json:"tags" oai3:"nullable,typeStrArr"`
+	ID                    int64           `json:"id,omitempty" oai3:"nullable"`
+	UID                   ksuid.KSUID     `json:"uid,omitempty" oai3:"typeStr,nullable"`
+	Name                  string          `json:"name"`
+	Interfaces            []*NetInterface `json:"interfaces"`
+	Bonds                 []*Bond         `json:"bonds"`
+	Provision             bool            `json:"provision"`
+	State                 string          `json:"state,omitempty" description:"lifecycle state of the host: new, ready-to-provision, installing, production, drained or retired. Provision follows the state"`
+	Firmware              firmware.Build  `json:"firmware" oai3:"typeStr"`
+	BootImage             string          `json:"boot_image"`
+	SMBIOSUUID            string          `json:"smbios_uuid,omitempty"`
+	Inventory             *Inventory      `json:"inventory,omitempty" oai3:"nullable"`
+	Hardware              *Hardware       `json:"hardware,omitempty" oai3:"nullable"`
+	ClientCertFingerprint string          `json:"client_cert_fingerprint,omitempty" description:"SHA-256 fingerprint of the client certificate issued to the host, checked by the provision server"`
+	ClientCertSerial      string          `json:"client_cert_serial,omitempty" description:"serial number in hex of the client certificate issued to the host, revoked with the host"`
+	Tags                  []string        `json:"tags" oai3:"nullable,typeStrArr"`
+	Revision              int64           `json:"revision,omitempty" oai3:"nullable"`
+	CreatedAt             time.Time       `json:"created_at,omitzero" oai3:"nullable"`
+	UpdatedAt             time.Time       `json:"updated_at,omitzero" oai3:"nullable"`
 }
 
 func (h *Host) Scan(value interface{}) error {
@@ -94,6 +107,140 @@ func (h *Host) InterfaceBonded(peer string) bool {
 	return false
 }
 
+// Bond returns the bond or bridge of the host with the given name
+func (h *Host) Bond(name string) *Bond {
+	for _, bond := range h.Bonds {
+		if bond.Name == name {
+			return bond
+		}
+	}
+
+	return nil
+}
+
+// BondOf returns the bond or bridge the given interface is a member of
+func (h *Host) BondOf(nic *NetInterface) *Bond {
+	for _, bond := range h.Bonds {
+		if bond.HasPeer(nic) {
+			return bond
+		}
+	}
+
+	return nil
+}
+
+// BondMembers returns the network interfaces of the host that are members
+// of a bond or bridge
+func (h *Host) BondMembers() []*NetInterface {
+	members := make([]*NetInterface, 0)
+	for _, nic := range h.Interfaces {
+		if h.BondOf(nic) != nil {
+			members = append(members, nic)
+		}
+	}
+
+	return members
+}
+
+// BondMemberNames returns the interface names of the members of the given
+// bond or bridge. Peers given by MAC address are resolved to the name of the
+// matching interface.
+func (h *Host) BondMemberNames(bond *Bond) []string {
+	names := make([]string, 0, len(bond.Peers))
+	for _, p := range bond.Peers {
+		name := p
+		for _, nic := range h.Interfaces {
+			if nic.Name != "" && nic.isPeer(p) {
+				name = nic.Name
+				break
+			}
+		}
+		names = append(names, name)
+	}
+
+	return names
+}
+
+// ValidateBonds checks each bond and bridge of the host is valid and that
+// every member is an interface of the host. Bond members must be network
+// interfaces and bridge members can also be bonds. An interface can be a
+// member of at most one bond or bridge.
+func (h *Host) ValidateBonds() error {
+	members := make(map[*NetInterface]string)
+	for _, bond := range h.Bonds {
+		if err := bond.Validate(); err != nil {
+			return err
+		}
+
+		for _, p := range bond.Peers {
+			var member *NetInterface
+			for _, nic := range h.Interfaces {
+				if nic.isPeer(p) {
+					member = nic
+					break
+				}
+			}
+			if member == nil && bond.IsBridge() {
+				if b := h.Bond(p); b != nil && b != bond {
+					member = &b.NetInterface
+				}
+			}
+			if member == nil {
+				return fmt.Errorf("member %s of %s %s is not an interface of host %s", p, bond.NicType(), bond.displayName(), h.Name)
+			}
+
+			if other, ok := members[member]; ok && other != bond.Name {
+				return fmt.Errorf("interface %s is a member of both %s and %s", p, other, bond.Name)
+			}
+			members[member] = bond.Name
+		}
+	}
+
+	return nil
+}
+
+// ValidateGateways checks the network of every interface and bond of the
+// host contains the router configured for it
+func (h *Host) ValidateGateways() error {
+	errs := make([]error, 0)
+	for _, nic := range h.nics() {
+		if err := nic.ValidateGateway(); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// DHCPInterface returns the interface to answer DHCP requests from the given
+// MAC address. If the matching interface has no IP address and is a member
+// of a bond or bridge with one, the bond or bridge is returned with the MAC
+// address of the member.
+func (h *Host) DHCPInterface(mac net.HardwareAddr) *NetInterface {
+	nic := h.Interface(mac)
+	if nic == nil {
+		return nil
+	}
+
+	logical := nic
+	for i := 0; i < len(h.Bonds) && !logical.IP.IsValid(); i++ {
+		bond := h.BondOf(logical)
+		if bond == nil {
+			break
+		}
+		logical = &bond.NetInterface
+	}
+
+	if logical == nic || !logical.IP.IsValid() {
+		return nic
+	}
+
+	dhcpNic := *logical
+	dhcpNic.MAC = nic.MAC
+
+	return &dhcpNic
+}
+
 func (h *Host) Interface(mac net.HardwareAddr) *NetInterface {
 	for _, nic := range h.Interfaces {
 		if bytes.Compare(nic.MAC, mac) == 0 {
@@ -124,13 +271,186 @@ func (h *Host) BootInterface() *NetInterface {
 	return nil
 }
 
+// FirmwareOverride returns the firmware overriding the firmware detected
+// from the client architecture and where it is set: the firmware of the host,
+// of the first host tag in the firmware.tags config or of the boot image in
+// the firmware.images config. The build is nil without an override
+func (h *Host) FirmwareOverride() (firmware.Build, string) {
+	if !h.Firmware.IsNil() {
+		return h.Firmware, "host"
+	}
+
+	cfg := config.Current()
+	for _, tag := range h.Tags {
+		if fw, ok := cfg.FirmwareTags[strings.ToLower(tag)]; ok {
+			return fw, "tag " + tag
+		}
+	}
+
+	if fw, ok := cfg.FirmwareImages[strings.ToLower(h.BootImage)]; ok && h.BootImage != "" {
+		return fw, "image " + h.BootImage
+	}
+
+	return firmware.Build(0), ""
+}
+
+// Rename sets the host name to name and rewrites interface FQDNs. If
+// fqdnPattern is set the FQDN of the boot interface is set to fqdnPattern
+// with {name} replaced by the new name. For all other interfaces and bonds
+// the FQDNs are rewritten with RenameFQDN.
+func (h *Host) Rename(name, fqdnPattern string) {
+	oldName := h.Name
+	h.Name = name
+	boot := h.BootInterface()
+
+	rewrite := func(nic *NetInterface) {
+		if fqdnPattern != "" && nic == boot {
+			nic.FQDN = strings.ReplaceAll(fqdnPattern, "{name}", name)
+			return
+		}
+
+		nic.FQDN = RenameFQDN(nic.FQDN, oldName, name)
+	}
+
+	for _, nic := range h.Interfaces {
+		rewrite(nic)
+	}
+	for _, bond := range h.Bonds {
+		rewrite(&bond.NetInterface)
+	}
+}
+
+// RenameFQDN replaces oldName with newName in the host label, the first
+// label, of each comma separated FQDN. Only the first occurrence of oldName
+// not part of a longer name is replaced, so bmc-cpn-01 is renamed along with
+// cpn-01 but cpn-010 is not
+func RenameFQDN(fqdn, oldName, newName string) string {
+	if fqdn == "" || oldName == "" {
+		return fqdn
+	}
+
+	names := strings.Split(fqdn, ",")
+	for i, name := range names {
+		label, domain, found := strings.Cut(name, ".")
+		if at := nameIndex(label, oldName); at >= 0 {
+			label = label[:at] + newName + label[at+len(oldName):]
+		}
+		if found {
+			label += "." + domain
+		}
+		names[i] = label
+	}
+
+	return strings.Join(names, ",")
+}
+
+// nameIndex returns the index of the first occurrence of name in label not
+// preceded or followed by a letter or digit, or -1
+func nameIndex(label, name string) int {
+	alnum := func(b byte) bool {
+		return b >= 'a' && b <= 'z' || b >= 'A' && b <= 'Z' || b >= '0' && b <= '9'
+	}
+
+	for start := 0; start+len(name) <= len(label); start++ {
+		at := strings.Index(label[start:], name)
+		if at < 0 {
+			return -1
+		}
+		at += start
+		end := at + len(name)
+		if (at == 0 || !alnum(label[at-1])) && (end == len(label) || !alnum(label[end])) {
+			return at
+		}
+		start = at
+	}
+
+	return -1
+}
+
+// FQDNs returns the lower case FQDNs of the interfaces, bonds and secondary
+// addresses of the host
+func (h *Host) FQDNs() []string {
+	fqdns := make([]string, 0)
+	for _, nic := range h.nics() {
+		names := []string{nic.FQDN}
+		for _, addr := range nic.Addresses {
+			names = append(names, addr.FQDN)
+		}
+		for _, name := range strings.Split(strings.Join(names, ","), ",") {
+			if name != "" {
+				fqdns = append(fqdns, strings.TrimSuffix(strings.ToLower(name), "."))
+			}
+		}
+	}
+
+	return fqdns
+}
+
+// VLANInterfaces returns the interfaces and bonds of the host that are VLAN
+// subinterfaces of a parent interface
+func (h *Host) VLANInterfaces() []*NetInterface {
+	vlans := make([]*NetInterface, 0)
+	for _, nic := range h.nics() {
+		if nic.IsVLAN() {
+			vlans = append(vlans, nic)
+		}
+	}
+
+	return vlans
+}
+
+// VLANParents returns the unique parent interfaces of the VLAN subinterfaces
+// of the host
+func (h *Host) VLANParents() []string {
+	parents := make([]string, 0)
+	for _, nic := range h.VLANInterfaces() {
+		if !slices.Contains(parents, nic.Parent) {
+			parents = append(parents, nic.Parent)
+		}
+	}
+
+	return parents
+}
+
+// BondInterfaces returns the bonds of the host that are not VLAN
+// subinterfaces
+func (h *Host) BondInterfaces() []*Bond {
+	return h.logicalInterfaces(NicTypeBond)
+}
+
+// BridgeInterfaces returns the bridges of the host that are not VLAN
+// subinterfaces
+func (h *Host) BridgeInterfaces() []*Bond {
+	return h.logicalInterfaces(NicTypeBridge)
+}
+
+func (h *Host) logicalInterfaces(t NicType) []*Bond {
+	bonds := make([]*Bond, 0)
+	for _, bond := range h.Bonds {
+		if bond.NicType() == t && !bond.IsVLAN() {
+			bonds = append(bonds, bond)
+		}
+	}
+
+	return bonds
+}
+
 func (h *Host) FromJSON(hostJSON string) {
 	h.Name = gjson.Get(hostJSON, "name").String()
 	h.BootImage = gjson.Get(hostJSON, "boot_image").String()
 	h.Provision = gjson.Get(hostJSON, "provision").Bool()
+	h.State = gjson.Get(hostJSON, "state").String()
 	h.ID = int64(gjson.Get(hostJSON, "id").Int())
 	h.UID, _ = ksuid.Parse(gjson.Get(hostJSON, "uid").String())
+	h.Revision = gjson.Get(hostJSON, "revision").Int()
+	h.CreatedAt, _ = time.Parse(time.RFC3339, gjson.Get(hostJSON, "created_at").String())
+	h.UpdatedAt, _ = time.Parse(time.RFC3339, gjson.Get(hostJSON, "updated_at").String())
 	h.Firmware = firmware.NewFromString(gjson.Get(hostJSON, "firmware").String())
+	h.SMBIOSUUID = gjson.Get(hostJSON, "smbios_uuid").String()
+	h.Inventory = inventoryFromJSON(gjson.Get(hostJSON, "inventory"))
+	h.Hardware = hardwareFromJSON(gjson.Get(hostJSON, "hardware"))
+	h.ClientCertFingerprint = gjson.Get(hostJSON, "client_cert_fingerprint").String()
+	h.ClientCertSerial = gjson.Get(hostJSON, "client_cert_serial").String()
 
 	h.Interfaces = make([]*NetInterface, 0)
 	res := gjson.Get(hostJSON, "interfaces")
@@ -140,10 +460,14 @@ func (h *Host) FromJSON(hostJSON string) {
 		nic.Name = i.Get("ifname").String()
 		nic.FQDN = i.Get("fqdn").String()
 		nic.BMC = i.Get("bmc").Bool()
-		nic.VLAN = i.Get("vlan").String()
+		nic.VLAN = uint16(i.Get("vlan").Uint())
+		nic.Parent = i.Get("parent").String()
 		nic.MTU = uint16(i.Get("mtu").Int())
+		nic.Switch = i.Get("switch").String()
+		nic.Port = int(i.Get("port").Int())
 		nic.IP, _ = netip.ParsePrefix(i.Get("ip").String())
 		nic.MAC, _ = net.ParseMAC(i.Get("mac").String())
+		nic.Addresses = addressesFromJSON(i.Get("addresses"))
 		h.Interfaces = append(h.Interfaces, nic)
 	}
 
@@ -155,13 +479,23 @@ func (h *Host) FromJSON(hostJSON string) {
 		bond.Name = i.Get("ifname").String()
 		bond.FQDN = i.Get("fqdn").String()
 		bond.BMC = i.Get("bmc").Bool()
-		bond.VLAN = i.Get("vlan").String()
+		bond.VLAN = uint16(i.Get("vlan").Uint())
+		bond.Parent = i.Get("parent").String()
 		bond.MTU = uint16(i.Get("mtu").Int())
 		bond.IP, _ = netip.ParsePrefix(i.Get("ip").String())
 		bond.MAC, _ = net.ParseMAC(i.Get("mac").String())
+		bond.Addresses = addressesFromJSON(i.Get("addresses"))
+		bond.Type = i.Get("type").String()
+		bond.Mode = i.Get("mode").String()
 		for _, p := range i.Get("peers").Array() {
 			bond.Peers = append(bond.Peers, p.String())
 		}
+		if opts := i.Get("options").Map(); len(opts) > 0 {
+			bond.Options = make(map[string]string, len(opts))
+			for k, v := range opts {
+				bond.Options[k] = v.String()
+			}
+		}
 		h.Bonds = append(h.Bonds, bond)
 	}
 
@@ -172,6 +506,70 @@ func (h *Host) FromJSON(hostJSON string) {
 
 }
 
+// inventoryFromJSON returns the firmware inventory of a host or nil if the
+// host has none
+func inventoryFromJSON(res gjson.Result) *Inventory {
+	if !res.IsObject() {
+		return nil
+	}
+
+	inv := &Inventory{
+		BIOSVersion: res.Get("bios_version").String(),
+		BMCFirmware: res.Get("bmc_firmware").String(),
+	}
+	inv.CapturedAt, _ = time.Parse(time.RFC3339, res.Get("captured_at").String())
+	res.Get("nic_firmware").ForEach(func(k, v gjson.Result) bool {
+		if inv.NICFirmware == nil {
+			inv.NICFirmware = make(map[string]string)
+		}
+		inv.NICFirmware[k.String()] = v.String()
+		return true
+	})
+
+	return inv
+}
+
+// hardwareFromJSON returns the hardware of a host or nil if the host has none
+func hardwareFromJSON(res gjson.Result) *Hardware {
+	if !res.IsObject() {
+		return nil
+	}
+
+	hw := &Hardware{}
+	if err := json.Unmarshal([]byte(res.Raw), hw); err != nil {
+		return nil
+	}
+
+	return hw
+}
+
+// addressesFromJSON returns the secondary addresses of an interface
+func addressesFromJSON(res gjson.Result) []NetAddress {
+	var addrs []NetAddress
+	for _, a := range res.Array() {
+		ip, err := netip.ParsePrefix(a.Get("ip").String())
+		if err != nil {
+			continue
+		}
+		addrs = append(addrs, NetAddress{IP: ip, FQDN: a.Get("fqdn").String()})
+	}
+
+	return addrs
+}
+
+// addressesToJSON returns the secondary addresses of an interface for ToJSON
+func addressesToJSON(addrs []NetAddress) []map[string]interface{} {
+	list := make([]map[string]interface{}, len(addrs))
+	for i, a := range addrs {
+		list[i] = map[string]interface{}{
+			"ip":   a.IP.String(),
+			"fqdn": a.FQDN,
+		}
+	}
+
+	return list
+}
+
 func (h *Host) ToJSON() string {
 	hostJSON := `{"firmware": "", "interfaces": [], "bonds": [], "name": "", "provision": false, "kickstart": false, "boot_image": "", "tags": []}`
 
@@ -181,10 +579,37 @@ func (h *Host) ToJSON() string {
 	if h.ID != 0 {
 		hostJSON, _ = sjson.Set(hostJSON, "id", h.ID)
 	}
+	if h.Revision != 0 {
+		hostJSON, _ = sjson.Set(hostJSON, "revision", h.Revision)
+	}
+	if !h.CreatedAt.IsZero() {
+		hostJSON, _ = sjson.Set(hostJSON, "created_at", h.CreatedAt.Format(time.RFC3339))
+	}
+	if !h.UpdatedAt.IsZero() {
+		hostJSON, _ = sjson.Set(hostJSON, "updated_at", h.UpdatedAt.Format(time.RFC3339))
+	}
 	hostJSON, _ = sjson.Set(hostJSON, "name", h.Name)
 	hostJSON, _ = sjson.Set(hostJSON, "boot_image", h.BootImage)
 	hostJSON, _ = sjson.Set(hostJSON, "firmware", h.Firmware.String())
 	hostJSON, _ = sjson.Set(hostJSON, "provision", h.Provision)
+	if h.State != "" {
+		hostJSON, _ = sjson.Set(hostJSON, "state", h.State)
+	}
+	if h.SMBIOSUUID != "" {
+		hostJSON, _ = sjson.Set(hostJSON, "smbios_uuid", h.SMBIOSUUID)
+	}
+	if !h.Inventory.IsEmpty() {
+		hostJSON, _ = sjson.Set(hostJSON, "inventory", h.Inventory)
+	}
+	if !h.Hardware.IsEmpty() {
+		hostJSON, _ = sjson.Set(hostJSON, "hardware", h.Hardware)
+	}
+	if h.ClientCertFingerprint != "" {
+		hostJSON, _ = sjson.Set(hostJSON, "client_cert_fingerprint", h.ClientCertFingerprint)
+	}
+	if h.ClientCertSerial != "" {
+		hostJSON, _ = sjson.Set(hostJSON, "client_cert_serial", h.ClientCertSerial)
+	}
 
 	for _, nic := range h.Interfaces {
 		n := map[string]interface{}{
@@ -193,12 +618,24 @@ func (h *Host) ToJSON() string {
 			"ifname": nic.Name,
 			"fqdn":   nic.FQDN,
 			"bmc":    nic.BMC,
-			"vlan":   nic.VLAN,
 			"mtu":    nic.MTU,
 		}
 		if nic.ID != 0 {
 			n["id"] = nic.ID
 		}
+		if nic.VLAN != 0 {
+			n["vlan"] = nic.VLAN
+		}
+		if nic.Parent != "" {
+			n["parent"] = nic.Parent
+		}
+		if nic.Switch != "" {
+			n["switch"] = nic.Switch
+			n["port"] = nic.Port
+		}
+		if len(nic.Addresses) > 0 {
+			n["addresses"] = addressesToJSON(nic.Addresses)
+		}
 		hostJSON, _ = sjson.Set(hostJSON, "interfaces.-1", n)
 	}
 
@@ -210,12 +647,29 @@ func (h *Host) ToJSON() string {
 			"ifname": bond.Name,
 			"fqdn":   bond.FQDN,
 			"bmc":    bond.BMC,
-			"vlan":   bond.VLAN,
 			"mtu":    bond.MTU,
 		}
 		if bond.ID != 0 {
 			b["id"] = bond.ID
 		}
+		if bond.VLAN != 0 {
+			b["vlan"] = bond.VLAN
+		}
+		if bond.Parent != "" {
+			b["parent"] = bond.Parent
+		}
+		if bond.Type != "" {
+			b["type"] = bond.Type
+		}
+		if bond.Mode != "" {
+			b["mode"] = bond.Mode
+		}
+		if len(bond.Options) > 0 {
+			b["options"] = bond.Options
+		}
+		if len(bond.Addresses) > 0 {
+			b["addresses"] = addressesToJSON(bond.Addresses)
+		}
 		hostJSON, _ = sjson.Set(hostJSON, "bonds.-1", b)
 	}
 
@@ -263,14 +717,51 @@ func (h *Host) UnmarshalJSON(data []byte) error {
 		return err
 	}
 
-	h.Firmware = firmware.NewFromString(aux.Firmware)
-	if len(aux.Firmware) != 0 && h.Firmware.IsNil() {
-		return fmt.Errorf("Invalid firmware build: %s", aux.Firmware)
+	h.Firmware = firmware.Build(0)
+	if len(aux.Firmware) != 0 {
+		fw, err := firmware.Parse(aux.Firmware)
+		if err != nil {
+			return err
+		}
+		h.Firmware = fw
 	}
 
 	return nil
 }
 
+// NormalizeSMBIOSUUID returns the SMBIOS UUID in lower case in the canonical
+// 8-4-4-4-12 form, as printed by dmidecode -s system-uuid
+func NormalizeSMBIOSUUID(id string) (string, error) {
+	u, err := uuid.Parse(strings.TrimSpace(id))
+	if err != nil {
+		return "", fmt.Errorf("invalid smbios uuid %s: %w", id, err)
+	}
+
+	return u.String(), nil
+}
+
+// SMBIOSUUIDFromGUID returns the SMBIOS UUID of the 16 byte client machine
+// GUID sent by PXE clients in DHCP option 97. The first three fields of the
+// GUID are little endian as in the SMBIOS table and are swapped to match the
+// UUID printed by dmidecode.
+func SMBIOSUUIDFromGUID(guid []byte) (string, error) {
+	if len(guid) != 16 {
+		return "", fmt.Errorf("invalid client machine guid length %d", len(guid))
+	}
+
+	b := slices.Clone(guid)
+	slices.Reverse(b[0:4])
+	slices.Reverse(b[4:6])
+	slices.Reverse(b[6:8])
+
+	u, err := uuid.FromBytes(b)
+	if err != nil {
+		return "", err
+	}
+
+	return u.String(), nil
+}
+
 func (h *Host) TagList() TagList {
 	list := make(TagList, len(h.Tags))
 
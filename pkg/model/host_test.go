@@ -6,9 +6,14 @@ package model_test
 
 import (
 	"encoding/json"
+	"net"
+	"net/netip"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/ubccr/grendel/internal/config"
+	"github.com/ubccr/grendel/internal/firmware"
 	"github.com/ubccr/grendel/internal/tests"
 	"github.com/ubccr/grendel/pkg/model"
 )
@@ -36,6 +41,244 @@ func TestHostBonds(t *testing.T) {
 	assert.Equal(host.Bonds[0].AddrString(), host.Bonds[0].IP.Addr().String())
 }
 
+func TestHostRename(t *testing.T) {
+	assert := assert.New(t)
+
+	host := &model.Host{
+		Name: "cpn-01",
+		Interfaces: []*model.NetInterface{
+			{FQDN: "cpn-01.example.com,cpn-01-alias.example.com"},
+			{FQDN: "bmc-cpn-01.example.com", BMC: true},
+		},
+		Bonds: []*model.Bond{
+			{NetInterface: model.NetInterface{FQDN: "cpn-01-ib.example.com"}},
+		},
+	}
+
+	host.Rename("cpn-02", "")
+	assert.Equal("cpn-02", host.Name)
+	assert.Equal("cpn-02.example.com,cpn-02-alias.example.com", host.Interfaces[0].FQDN)
+	assert.Equal("bmc-cpn-02.example.com", host.Interfaces[1].FQDN)
+	assert.Equal("cpn-02-ib.example.com", host.Bonds[0].FQDN)
+
+	host.Rename("cpn-03", "{name}.mgmt.cluster")
+	assert.Equal("cpn-03.mgmt.cluster", host.Interfaces[0].FQDN)
+	assert.Equal("bmc-cpn-03.example.com", host.Interfaces[1].FQDN)
+	assert.Equal("cpn-03-ib.example.com", host.Bonds[0].FQDN)
+}
+
+func TestRenameFQDN(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.Equal("cpn-02.example.com,bmc-cpn-02.example.com", model.RenameFQDN("cpn-01.example.com,bmc-cpn-01.example.com", "cpn-01", "cpn-02"))
+	assert.Equal("cpn-010.example.com", model.RenameFQDN("cpn-010.example.com", "cpn-01", "cpn-02"))
+	assert.Equal("cpn-02-ib-cpn-01.example.com", model.RenameFQDN("cpn-01-ib-cpn-01.example.com", "cpn-01", "cpn-02"))
+	assert.Equal("b-data.example.com", model.RenameFQDN("a-data.example.com", "a", "b"))
+	assert.Equal("cpn-01.a.example.com", model.RenameFQDN("cpn-01.a.example.com", "a", "b"))
+	assert.Equal("", model.RenameFQDN("", "a", "b"))
+}
+
+func TestHostFirmwareOverride(t *testing.T) {
+	assert := assert.New(t)
+
+	cfg := *config.Current()
+	cfg.FirmwareTags = map[string]firmware.Build{"r650": firmware.SNPONLYx86_64}
+	cfg.FirmwareImages = map[string]firmware.Build{"rocky9": firmware.EFI64}
+	defer config.Set(config.Set(&cfg))
+
+	host := &model.Host{Name: "cpn-01", BootImage: "rocky9", Tags: []string{"k11", "R650"}}
+	fw, from := host.FirmwareOverride()
+	assert.Equal(firmware.SNPONLYx86_64, fw)
+	assert.Equal("tag R650", from)
+
+	host.Firmware = firmware.EFI386
+	fw, from = host.FirmwareOverride()
+	assert.Equal(firmware.EFI386, fw)
+	assert.Equal("host", from)
+
+	host.Firmware = firmware.Build(0)
+	host.Tags = nil
+	fw, from = host.FirmwareOverride()
+	assert.Equal(firmware.EFI64, fw)
+	assert.Equal("image rocky9", from)
+
+	host.BootImage = "ubuntu"
+	fw, _ = host.FirmwareOverride()
+	assert.True(fw.IsNil())
+
+	var h model.Host
+	assert.NoError(json.Unmarshal([]byte(`{"name": "cpn-01", "firmware": "snponly-x86_64.efi"}`), &h))
+	assert.Equal(firmware.SNPONLYx86_64, h.Firmware)
+	data, err := json.Marshal(&h)
+	assert.NoError(err)
+	assert.Contains(string(data), `"firmware":"snponly-x86_64.efi"`)
+
+	err = json.Unmarshal([]byte(`{"name": "cpn-01", "firmware": "snponly.efi"}`), &h)
+	assert.ErrorContains(err, "valid names: ipxe-i386.efi")
+}
+
+func TestNetInterfaceVLAN(t *testing.T) {
+	assert := assert.New(t)
+
+	var nic model.NetInterface
+	assert.NoError(json.Unmarshal([]byte(`{"ifname": "ens1f0.2010", "parent": "ens1f0", "vlan": 2010}`), &nic))
+	assert.Equal(uint16(2010), nic.VLAN)
+	assert.Equal("ens1f0", nic.Parent)
+	assert.True(nic.IsVLAN())
+	assert.NoError(nic.Validate())
+
+	// VLANs were strings in older versions
+	nic = model.NetInterface{}
+	assert.NoError(json.Unmarshal([]byte(`{"vlan": "100"}`), &nic))
+	assert.Equal(uint16(100), nic.VLAN)
+	assert.False(nic.IsVLAN())
+	nic = model.NetInterface{}
+	assert.NoError(json.Unmarshal([]byte(`{"vlan": ""}`), &nic))
+	assert.Equal(uint16(0), nic.VLAN)
+	assert.Error(json.Unmarshal([]byte(`{"vlan": "vlan100"}`), &nic))
+
+	var bond model.Bond
+	assert.NoError(json.Unmarshal([]byte(`{"ifname": "bond0.20", "parent": "bond0", "vlan": "20", "peers": ["eno1"]}`), &bond))
+	assert.Equal(uint16(20), bond.VLAN)
+	assert.Equal([]string{"eno1"}, bond.Peers)
+
+	assert.Error((&model.NetInterface{Name: "ens1f0.5000", Parent: "ens1f0", VLAN: 5000}).Validate())
+	assert.Error((&model.NetInterface{Name: "ens1f0.10", Parent: "ens1f0"}).Validate())
+	assert.NoError((&model.NetInterface{Name: "ens1f0", VLAN: 10}).Validate())
+	assert.Equal("ens1f0.10", (&model.NetInterface{Parent: "ens1f0", VLAN: 10}).VLANName())
+
+	host := &model.Host{
+		Name: "cpn-01",
+		Interfaces: []*model.NetInterface{
+			{Name: "eno1"},
+			{Name: "ens1f0.2010", Parent: "ens1f0", VLAN: 2010},
+			{Name: "ens1f0.2020", Parent: "ens1f0", VLAN: 2020},
+		},
+		Bonds: []*model.Bond{
+			{NetInterface: model.NetInterface{Name: "bond0.30", Parent: "bond0", VLAN: 30}},
+		},
+	}
+	assert.Len(host.VLANInterfaces(), 3)
+	assert.Equal([]string{"ens1f0", "bond0"}, host.VLANParents())
+
+	clone := &model.Host{}
+	clone.FromJSON(host.ToJSON())
+	assert.Equal(uint16(2010), clone.Interfaces[1].VLAN)
+	assert.Equal("ens1f0", clone.Interfaces[1].Parent)
+	assert.Equal(uint16(30), clone.Bonds[0].VLAN)
+	assert.Equal("bond0", clone.Bonds[0].Parent)
+}
+
+func TestHostLogicalInterfaces(t *testing.T) {
+	assert := assert.New(t)
+
+	mac0, _ := net.ParseMAC("d0:93:ae:e1:b5:2e")
+	mac1, _ := net.ParseMAC("d0:93:ae:e1:b5:2f")
+	host := &model.Host{
+		Name: "cpn-01",
+		Interfaces: []*model.NetInterface{
+			{Name: "ens1f0", MAC: mac0},
+			{Name: "ens1f1", MAC: mac1},
+		},
+		Bonds: []*model.Bond{
+			{
+				NetInterface: model.NetInterface{Name: "bond0"},
+				Peers:        []string{"ens1f0", "D0:93:AE:E1:B5:2F"},
+				Mode:         "802.3ad",
+				Options:      map[string]string{"miimon": "100", "lacp_rate": "fast"},
+			},
+			{
+				NetInterface: model.NetInterface{Name: "br0", IP: netip.MustParsePrefix("10.64.8.21/22")},
+				Type:         "bridge",
+				Peers:        []string{"bond0"},
+			},
+		},
+	}
+
+	assert.NoError(host.ValidateBonds())
+	assert.Equal([]string{"ens1f0", "ens1f1"}, host.BondMemberNames(host.Bonds[0]))
+	assert.Equal([]string{"mode=802.3ad", "lacp_rate=fast", "miimon=100"}, host.Bonds[0].Parameters())
+	assert.Len(host.BondMembers(), 2)
+	assert.Len(host.BondInterfaces(), 1)
+	assert.Len(host.BridgeInterfaces(), 1)
+	assert.Equal(model.NicTypeBridge, host.Bonds[1].NicType())
+
+	// DHCP answers for the member MACs with the address of the bridge
+	nic := host.DHCPInterface(mac1)
+	if assert.NotNil(nic) {
+		assert.Equal("br0", nic.Name)
+		assert.Equal("10.64.8.21", nic.AddrString())
+		assert.Equal(mac1, nic.MAC)
+	}
+	host.Interfaces[0].IP = netip.MustParsePrefix("10.64.8.22/22")
+	assert.Equal("ens1f0", host.DHCPInterface(mac0).Name)
+
+	clone := &model.Host{}
+	clone.FromJSON(host.ToJSON())
+	assert.Equal("802.3ad", clone.Bonds[0].Mode)
+	assert.Equal(host.Bonds[0].Options, clone.Bonds[0].Options)
+	assert.Equal("bridge", clone.Bonds[1].Type)
+
+	var bond model.Bond
+	assert.NoError(json.Unmarshal([]byte(`{"ifname": "bond1", "type": "bond", "mode": "active-backup", "options": {"primary": "ens1f0"}, "peers": ["ens1f0"]}`), &bond))
+	assert.Equal("active-backup", bond.Mode)
+	assert.Equal("ens1f0", bond.Options["primary"])
+	data, err := json.Marshal(&bond)
+	if assert.NoError(err) {
+		assert.Contains(string(data), `"mode":"active-backup"`)
+		assert.Contains(string(data), `"options":{"primary":"ens1f0"}`)
+	}
+
+	host.Bonds[0].Peers = []string{"ens1f0", "ens1f9"}
+	assert.Error(host.ValidateBonds())
+	host.Bonds[0].Peers = []string{"ens1f0", "br0"}
+	assert.Error(host.ValidateBonds())
+	host.Bonds[0].Peers = []string{"ens1f0"}
+	host.Bonds[1].Peers = []string{"bond0", "ens1f0"}
+	assert.Error(host.ValidateBonds())
+	host.Bonds[1].Peers = []string{"bond0"}
+	host.Bonds[0].Mode = "lacp"
+	assert.Error(host.ValidateBonds())
+	host.Bonds[0].Mode = "802.3ad"
+	host.Bonds[1].Mode = "802.3ad"
+	assert.Error(host.ValidateBonds())
+	host.Bonds[1].Mode = ""
+	host.Bonds[1].Type = "team"
+	assert.Error(host.ValidateBonds())
+}
+
+func TestNetInterfacePrefix(t *testing.T) {
+	assert := assert.New(t)
+
+	nic := &model.NetInterface{Name: "eno1", IP: netip.MustParsePrefix("10.64.8.21/22")}
+	assert.Equal(22, nic.PrefixLen())
+	assert.Equal("255.255.252.0", nic.NetmaskString())
+	assert.Equal("10.64.8.0", nic.NetworkString())
+	assert.Equal("10.64.11.255", nic.BroadcastString())
+
+	bmc := &model.NetInterface{Name: "bmc", IP: netip.MustParsePrefix("10.64.12.70/26")}
+	assert.Equal("255.255.255.192", bmc.NetmaskString())
+	assert.Equal("10.64.12.64", bmc.NetworkString())
+	assert.Equal("10.64.12.127", bmc.BroadcastString())
+
+	empty := &model.NetInterface{}
+	assert.Equal(-1, empty.PrefixLen())
+	assert.Equal("", empty.NetmaskString())
+	assert.Equal("", empty.BroadcastString())
+
+	cfg := *config.Current()
+	cfg.DefaultGateway = netip.MustParseAddr("10.64.8.1")
+	cfg.Subnets = []config.Subnet{{Gateway: netip.MustParsePrefix("10.64.12.65/26")}}
+	defer config.Set(config.Set(&cfg))
+
+	host := &model.Host{Name: "cpn-01", Interfaces: []*model.NetInterface{nic, bmc}}
+	assert.NoError(host.ValidateGateways())
+
+	nic.IP = netip.MustParsePrefix("10.64.9.21/24")
+	assert.ErrorContains(host.ValidateGateways(), "router 10.64.8.1 is not in network 10.64.9.0/24 of interface eno1")
+	assert.NoError(empty.ValidateGateway())
+}
+
 func BenchmarkGJSONUnmarshall(b *testing.B) {
 	jsonStr := string(tests.TestHostJSON)
 	b.ResetTimer()
@@ -79,3 +322,236 @@ func BenchmarkEncodeMarshall(b *testing.B) {
 		}
 	}
 }
+
+func TestNetInterfaceAddresses(t *testing.T) {
+	assert := assert.New(t)
+
+	// A bare ip is the single primary address
+	var nic model.NetInterface
+	assert.NoError(json.Unmarshal([]byte(`{"ifname": "eno1", "ip": "10.64.8.21/22", "fqdn": "cpn-01.example.com"}`), &nic))
+	assert.Equal("10.64.8.21/22", nic.CIDR())
+	assert.Empty(nic.Addresses)
+	assert.Len(nic.AllAddresses(), 1)
+
+	// The primary address in the list is moved to ip and fqdn
+	nic = model.NetInterface{}
+	assert.NoError(json.Unmarshal([]byte(`{"ifname": "eno1", "addresses": [
+		{"ip": "10.64.8.100/22", "fqdn": "vip.example.com"},
+		{"ip": "10.64.8.21/22", "fqdn": "cpn-01.example.com", "primary": true},
+		{"ip": "fd00::21/64"}
+	]}`), &nic))
+	assert.Equal("10.64.8.21/22", nic.CIDR())
+	assert.Equal("cpn-01.example.com", nic.FQDN)
+	if assert.Len(nic.Addresses, 2) {
+		assert.Equal("vip.example.com", nic.Addresses[0].FQDN)
+		assert.Equal("fd00::21/64", nic.Addresses[1].IP.String())
+	}
+	addrs := nic.AllAddresses()
+	if assert.Len(addrs, 3) {
+		assert.True(addrs[0].Primary)
+		assert.Equal("10.64.8.21/22", addrs[0].IP.String())
+	}
+	assert.NoError(nic.Validate())
+
+	nic = model.NetInterface{}
+	assert.Error(json.Unmarshal([]byte(`{"ip": "10.64.8.21/22", "addresses": [{"ip": "10.64.8.22/22", "primary": true}]}`), &nic))
+
+	var bond model.Bond
+	assert.NoError(json.Unmarshal([]byte(`{"ifname": "bond0", "peers": ["eno1"], "addresses": [{"ip": "10.64.8.30/22", "primary": true}]}`), &bond))
+	assert.Equal("10.64.8.30/22", bond.CIDR())
+	assert.Empty(bond.Addresses)
+
+	dup := &model.NetInterface{
+		IP:        netip.MustParsePrefix("10.64.8.21/22"),
+		Addresses: []model.NetAddress{{IP: netip.MustParsePrefix("10.64.8.21/24")}},
+	}
+	assert.Error(dup.Validate())
+
+	host := &model.Host{
+		Name: "cpn-01",
+		Interfaces: []*model.NetInterface{{
+			Name:      "eno1",
+			IP:        netip.MustParsePrefix("10.64.8.21/22"),
+			Addresses: []model.NetAddress{{IP: netip.MustParsePrefix("fd00::21/64"), FQDN: "cpn-01.example.com"}},
+		}},
+	}
+	clone := &model.Host{}
+	clone.FromJSON(host.ToJSON())
+	assert.Equal(host.Interfaces[0].Addresses, clone.Interfaces[0].Addresses)
+}
+
+func TestSMBIOSUUID(t *testing.T) {
+	assert := assert.New(t)
+
+	id, err := model.NormalizeSMBIOSUUID(" 4C4C4544-0042-3510-8052-B4C04F4B4B32 ")
+	if assert.NoError(err) {
+		assert.Equal("4c4c4544-0042-3510-8052-b4c04f4b4b32", id)
+	}
+	_, err = model.NormalizeSMBIOSUUID("not-a-uuid")
+	assert.Error(err)
+
+	// The GUID in DHCP option 97 has the first three fields little endian
+	guid := []byte{0x44, 0x45, 0x4c, 0x4c, 0x42, 0x00, 0x10, 0x35, 0x80, 0x52, 0xb4, 0xc0, 0x4f, 0x4b, 0x4b, 0x32}
+	id, err = model.SMBIOSUUIDFromGUID(guid)
+	if assert.NoError(err) {
+		assert.Equal("4c4c4544-0042-3510-8052-b4c04f4b4b32", id)
+	}
+	assert.Equal(byte(0x44), guid[0])
+	_, err = model.SMBIOSUUIDFromGUID(guid[:8])
+	assert.Error(err)
+
+	host := &model.Host{Name: "cpn-01", SMBIOSUUID: id}
+	clone := &model.Host{}
+	clone.FromJSON(host.ToJSON())
+	assert.Equal(id, clone.SMBIOSUUID)
+}
+
+func TestInventory(t *testing.T) {
+	assert := assert.New(t)
+
+	inv := &model.Inventory{
+		BIOSVersion: "2.19.0",
+		NICFirmware: map[string]string{"NIC.Slot.1": "22.31.6", "NIC.Embedded.1": "22.31.6"},
+		CapturedAt:  time.Date(2026, 10, 15, 7, 0, 0, 0, time.UTC),
+	}
+
+	ok, err := inv.Complies(model.InventoryFieldBIOS, "2.19.0")
+	if assert.NoError(err) {
+		assert.True(ok)
+	}
+	ok, _ = inv.Complies(model.InventoryFieldBIOS, "2.20.1")
+	assert.False(ok)
+	ok, _ = inv.Complies(model.InventoryFieldNIC, "22.31.6")
+	assert.True(ok)
+
+	// Missing versions do not comply
+	ok, _ = inv.Complies(model.InventoryFieldBMC, "7.00.00.171")
+	assert.False(ok)
+	var none *model.Inventory
+	ok, _ = none.Complies(model.InventoryFieldBIOS, "2.19.0")
+	assert.False(ok)
+
+	inv.NICFirmware["NIC.Slot.2"] = "20.8.4"
+	ok, _ = inv.Complies(model.InventoryFieldNIC, "22.31.6")
+	assert.False(ok)
+	assert.True(inv.Matches(model.InventoryFieldNIC, "20.8.4"))
+
+	_, err = inv.Complies("cpu", "1")
+	assert.Error(err)
+
+	host := &model.Host{Name: "cpn-01", Inventory: inv}
+	clone := &model.Host{}
+	clone.FromJSON(host.ToJSON())
+	assert.Equal(inv, clone.Inventory)
+
+	clone.FromJSON((&model.Host{Name: "cpn-01"}).ToJSON())
+	assert.Nil(clone.Inventory)
+
+	hosts := model.HostList{host, {Name: "cpn-02"}}
+	assert.Len(hosts.WithFirmware(model.InventoryFieldBIOS, "2.19.0"), 1)
+	assert.Len(hosts.WithFirmware(model.InventoryFieldBIOS, ""), 2)
+}
+
+func TestHardware(t *testing.T) {
+	assert := assert.New(t)
+
+	prev := &model.Hardware{
+		CPUModel:  "AMD EPYC 7543 32-Core Processor",
+		CPUCount:  2,
+		CoreCount: 64,
+		MemoryMiB: 65536,
+		DIMMs:     []model.HardwareDIMM{{Slot: "A1", SizeMiB: 32768}, {Slot: "B1", SizeMiB: 32768}},
+		Disks:     []model.HardwareDisk{{Name: "Disk.Bay.0", Serial: "S6KM01"}, {Name: "Disk.Bay.1", Serial: "S6KM02"}},
+		NICs:      []model.HardwareNIC{{Name: "NIC.Embedded.1-1-1", MAC: "b0:7b:25:de:9a:10"}},
+	}
+
+	assert.Empty(prev.Changes(prev))
+	assert.Empty(prev.Changes(nil))
+
+	cur := *prev
+	cur.MemoryMiB = 32768
+	cur.DIMMs = []model.HardwareDIMM{{Slot: "A1", SizeMiB: 32768}}
+	cur.Disks = []model.HardwareDisk{{Name: "Disk.Bay.0", Serial: "S6KM01"}}
+	cur.NICs = []model.HardwareNIC{{Name: "NIC.Embedded.1-1-1", MAC: "b0:7b:25:de:9a:11"}}
+	assert.Equal([]string{
+		"memory changed from 65536 MiB to 32768 MiB",
+		"memory DIMM B1 (32768 MiB) disappeared",
+		"disk count changed from 2 to 1",
+		"disk Disk.Bay.1 (serial S6KM02) disappeared",
+		"nic NIC.Embedded.1-1-1 changed from b0:7b:25:de:9a:10 to b0:7b:25:de:9a:11",
+	}, cur.Changes(prev))
+
+	// Resources the BMC did not report are not compared
+	cur = *prev
+	cur.Disks = nil
+	cur.Missing = []string{model.HardwareResourceStorage}
+	assert.Empty(cur.Changes(prev))
+
+	host := &model.Host{Name: "cpn-01", Hardware: prev}
+	clone := &model.Host{}
+	clone.FromJSON(host.ToJSON())
+	assert.Equal(prev, clone.Hardware)
+
+	clone.FromJSON((&model.Host{Name: "cpn-01"}).ToJSON())
+	assert.Nil(clone.Hardware)
+}
+
+func TestHostState(t *testing.T) {
+	assert := assert.New(t)
+
+	host := &model.Host{Name: "cpn-01"}
+	state, err := host.ResolveState("")
+	assert.NoError(err)
+	assert.Equal(model.HostStateNew, state)
+
+	host.Provision = true
+	state, err = host.ResolveState("")
+	assert.NoError(err)
+	assert.Equal(model.HostStateReady, state)
+
+	// Clients predating states only change the provision flag
+	host.State = model.HostStateInstalling
+	host.Provision = false
+	state, err = host.ResolveState(model.HostStateInstalling)
+	assert.NoError(err)
+	assert.Equal(model.HostStateProduction, state)
+
+	host.State = ""
+	host.Provision = true
+	state, err = host.ResolveState(model.HostStateDrained)
+	assert.NoError(err)
+	assert.Equal(model.HostStateReady, state)
+
+	host.Provision = false
+	state, err = host.ResolveState(model.HostStateDrained)
+	assert.NoError(err)
+	assert.Equal(model.HostStateDrained, state)
+
+	// The state wins over the provision flag
+	host.State = model.HostStateRetired
+	host.Provision = true
+	state, err = host.ResolveState(model.HostStateProduction)
+	assert.NoError(err)
+	assert.Equal(model.HostStateRetired, state)
+
+	host.State = "broken"
+	_, err = host.ResolveState(model.HostStateProduction)
+	assert.ErrorIs(err, model.ErrInvalidHostState)
+
+	assert.True(model.HostStateTransitionAllowed(model.HostStateProduction, model.HostStateDrained))
+	assert.True(model.HostStateTransitionAllowed(model.HostStateRetired, model.HostStateRetired))
+	assert.True(model.HostStateTransitionAllowed(model.HostStateRetired, model.HostStateNew))
+	assert.False(model.HostStateTransitionAllowed(model.HostStateRetired, model.HostStateProduction))
+	assert.False(model.HostStateTransitionAllowed(model.HostStateNew, model.HostStateInstalling))
+
+	// Hosts without a state are in the state of their provision flag
+	hosts := model.HostList{
+		{Name: "cpn-01", Provision: true},
+		{Name: "cpn-02"},
+		{Name: "cpn-03", State: model.HostStateRetired},
+	}
+	assert.Len(hosts.InStates(), 3)
+	assert.Equal("cpn-01", hosts.InStates(model.HostStateReady)[0].Name)
+	assert.Equal("cpn-02", hosts.InStates(model.HostStateProduction)[0].Name)
+	assert.Len(hosts.InStates(model.HostStateRetired, model.HostStateReady), 2)
+}
@@ -0,0 +1,44 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package model
+
+import (
+	"fmt"
+	"regexp"
+	"time"
+)
+
+// HostFileMaxSize is the largest file attached to a host. Files are sent to
+// the API in a JSON body, which is limited to 1 MiB
+const HostFileMaxSize = 512 * 1024
+
+var validHostFileName = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9._-]*$`)
+
+type HostFileList []*HostFile
+
+// HostFile is a small file attached to a host, such as a license or an
+// Infiniband partition config, served to the host while provisioning at
+// /boot/<token>/extra/<name>. Data is only loaded when the file is served
+type HostFile struct {
+	Host      string    `json:"host"`
+	Name      string    `json:"name"`
+	Size      int64     `json:"size"`
+	UpdatedAt time.Time `json:"updated_at"`
+	Data      []byte    `json:"-"`
+}
+
+// Validate checks the file name is valid and the file is not larger than
+// HostFileMaxSize
+func (f *HostFile) Validate() error {
+	if !validHostFileName.MatchString(f.Name) {
+		return fmt.Errorf("invalid file name for %s: %q", f.Host, f.Name)
+	}
+
+	if len(f.Data) > HostFileMaxSize {
+		return fmt.Errorf("file %s of %s is %d bytes, larger than %d", f.Name, f.Host, len(f.Data), HostFileMaxSize)
+	}
+
+	return nil
+}
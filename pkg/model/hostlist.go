@@ -9,6 +9,7 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/ubccr/grendel/pkg/nodeset"
 )
@@ -42,6 +43,59 @@ func (hl HostList) FilterPrefix(prefix string) HostList {
 	return hl[:n]
 }
 
+// UpdatedSince returns the hosts added or updated at or after t. Host
+// timestamps have a resolution of one second so t is truncated. The zero time
+// returns all hosts
+func (hl HostList) UpdatedSince(t time.Time) HostList {
+	if t.IsZero() {
+		return hl
+	}
+
+	t = t.Truncate(time.Second)
+	updated := make(HostList, 0)
+	for _, host := range hl {
+		if !host.UpdatedAt.Before(t) {
+			updated = append(updated, host)
+		}
+	}
+
+	return updated
+}
+
+// WithFirmware returns the hosts with the given firmware version in the given
+// inventory field. An empty version returns all hosts
+func (hl HostList) WithFirmware(field, version string) HostList {
+	if version == "" {
+		return hl
+	}
+
+	matched := make(HostList, 0)
+	for _, host := range hl {
+		if host.Inventory.Matches(field, version) {
+			matched = append(matched, host)
+		}
+	}
+
+	return matched
+}
+
+// InStates returns the hosts in one of the given states. No states returns
+// all hosts
+func (hl HostList) InStates(states ...string) HostList {
+	if len(states) == 0 {
+		return hl
+	}
+
+	matched := make(HostList, 0)
+	for _, host := range hl {
+		if host.InState(states) {
+			matched = append(matched, host)
+		}
+	}
+
+	return matched
+}
+
 func (hl HostList) ToNodeSet() (*nodeset.NodeSet, error) {
 	ns, err := nodeset.NewNodeSet("")
 	if err != nil {
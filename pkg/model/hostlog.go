@@ -0,0 +1,24 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package model
+
+import "time"
+
+// HostLogMaxEntries is the number of log entries kept per host, older entries
+// are dropped as new ones are logged
+const HostLogMaxEntries = 200
+
+type HostLogList []*HostLogEntry
+
+// HostLogEntry is an entry of the log of a host, such as a critical event
+// pushed by its BMC. MessageID is the Redfish message registry ID of the
+// event, if any.
+type HostLogEntry struct {
+	Host      string    `json:"host"`
+	Time      time.Time `json:"time"`
+	Severity  string    `json:"severity"`
+	MessageID string    `json:"message_id"`
+	Message   string    `json:"message"`
+}
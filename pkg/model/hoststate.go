@@ -0,0 +1,117 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package model
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+)
+
+// Lifecycle states of a host
+const (
+	// HostStateNew is a host added but not yet installed
+	HostStateNew = "new"
+
+	// HostStateReady is a host waiting to be installed on its next boot
+	HostStateReady = "ready-to-provision"
+
+	// HostStateInstalling is a host which fetched its boot script and is
+	// being installed
+	HostStateInstalling = "installing"
+
+	// HostStateProduction is an installed host in service
+	HostStateProduction = "production"
+
+	// HostStateDrained is an installed host taken out of service
+	HostStateDrained = "drained"
+
+	// HostStateRetired is a host decommissioned
+	HostStateRetired = "retired"
+)
+
+// HostStates are the lifecycle states in order
+var HostStates = []string{HostStateNew, HostStateReady, HostStateInstalling, HostStateProduction, HostStateDrained, HostStateRetired}
+
+// ErrInvalidHostState is returned for an unknown host state
+var ErrInvalidHostState = errors.New("invalid host state")
+
+// hostStateTransitions are the states a host may move to from each state.
+// A host may always stay in its state
+var hostStateTransitions = map[string][]string{
+	HostStateNew:        {HostStateReady, HostStateProduction, HostStateRetired},
+	HostStateReady:      {HostStateNew, HostStateInstalling, HostStateProduction, HostStateDrained, HostStateRetired},
+	HostStateInstalling: {HostStateReady, HostStateProduction, HostStateDrained, HostStateRetired},
+	HostStateProduction: {HostStateReady, HostStateDrained, HostStateRetired},
+	HostStateDrained:    {HostStateReady, HostStateProduction, HostStateRetired},
+	HostStateRetired:    {HostStateNew},
+}
+
+// ValidateHostState returns ErrInvalidHostState unless state is a host state
+func ValidateHostState(state string) error {
+	if !slices.Contains(HostStates, state) {
+		return fmt.Errorf("%w: %q, expected one of %v", ErrInvalidHostState, state, HostStates)
+	}
+
+	return nil
+}
+
+// HostStateTransitionAllowed returns whether a host may move from state from
+// to state to
+func HostStateTransitionAllowed(from, to string) bool {
+	return from == to || slices.Contains(hostStateTransitions[from], to)
+}
+
+// HostStateProvisions returns whether hosts in state are set to provision.
+// Host.Provision follows the state
+func HostStateProvisions(state string) bool {
+	return state == HostStateReady || state == HostStateInstalling
+}
+
+// ResolveState returns the state of the host once stored, its state being
+// current, or "" for a new host. Hosts written without a state, or with their
+// current state and a changed Provision flag, by clients predating states
+// move to the state matching the flag: ready-to-provision when set,
+// production when cleared while provisioning, else new for a new host.
+// ErrInvalidHostState is returned for an unknown state
+func (h *Host) ResolveState(current string) (string, error) {
+	state := h.State
+	if state == "" || state == current {
+		switch {
+		case current == "" && state == "":
+			state = HostStateNew
+			if h.Provision {
+				state = HostStateReady
+			}
+		case current != "" && h.Provision != HostStateProvisions(current):
+			state = HostStateProduction
+			if h.Provision {
+				state = HostStateReady
+			}
+		case current != "":
+			state = current
+		}
+	}
+
+	if err := ValidateHostState(state); err != nil {
+		return "", err
+	}
+
+	return state, nil
+}
+
+// InState returns whether the host is in one of states. Hosts without a state
+// are in the state matching their Provision flag
+func (h *Host) InState(states []string) bool {
+	state := h.State
+	if state == "" {
+		state = HostStateProduction
+		if h.Provision {
+			state = HostStateReady
+		}
+	}
+
+	return slices.Contains(states, state)
+}
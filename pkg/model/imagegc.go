@@ -0,0 +1,41 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package model
+
+type ImageUsageList []*ImageUsage
+
+// ImageUsage is the disk space used by the files of a boot image. Files
+// listed more than once, or hard links and symlinks to the same file, are
+// counted once. Shared is the part of Size also used by other images
+type ImageUsage struct {
+	Name    string   `json:"name"`
+	Files   int      `json:"files"`
+	Size    int64    `json:"size"`
+	Shared  int64    `json:"shared"`
+	Missing []string `json:"missing"`
+}
+
+// ImageDiskUsage is the disk space used by the files of each boot image.
+// Total counts the files shared by images once
+type ImageDiskUsage struct {
+	Images ImageUsageList `json:"images"`
+	Total  int64          `json:"total"`
+}
+
+// ImageGCFile is a file of the image directories no boot image uses
+type ImageGCFile struct {
+	Path string `json:"path"`
+	Size int64  `json:"size"`
+}
+
+// ImageGCResult lists the files of the image directories used neither by a
+// boot image nor by a version of one replaced within image_retention, and
+// whether they were deleted
+type ImageGCResult struct {
+	Dirs    []string       `json:"dirs"`
+	Files   []*ImageGCFile `json:"files"`
+	Size    int64          `json:"size"`
+	Deleted bool           `json:"deleted"`
+}
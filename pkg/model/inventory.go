@@ -0,0 +1,91 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package model
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"time"
+)
+
+const (
+	InventoryFieldBIOS = "bios"
+	InventoryFieldBMC  = "bmc"
+	InventoryFieldNIC  = "nic"
+)
+
+// InventoryFields are the valid firmware fields of an Inventory
+var InventoryFields = []string{InventoryFieldBIOS, InventoryFieldBMC, InventoryFieldNIC}
+
+// Inventory is the firmware of a host as last reported by its BMC or by the
+// host itself at provision time. NICFirmware is keyed by network adapter.
+type Inventory struct {
+	BIOSVersion string            `json:"bios_version,omitempty"`
+	BMCFirmware string            `json:"bmc_firmware,omitempty"`
+	NICFirmware map[string]string `json:"nic_firmware,omitempty"`
+	CapturedAt  time.Time         `json:"captured_at,omitzero" oai3:"nullable"`
+}
+
+// IsEmpty returns true if no firmware versions are set
+func (i *Inventory) IsEmpty() bool {
+	return i == nil || (i.BIOSVersion == "" && i.BMCFirmware == "" && len(i.NICFirmware) == 0)
+}
+
+// Versions returns the firmware versions of the given field. The versions of
+// NIC firmware are sorted by adapter
+func (i *Inventory) Versions(field string) ([]string, error) {
+	if !slices.Contains(InventoryFields, field) {
+		return nil, fmt.Errorf("invalid inventory field %s: must be one of %v", field, InventoryFields)
+	}
+	if i == nil {
+		return nil, nil
+	}
+
+	switch field {
+	case InventoryFieldBIOS:
+		if i.BIOSVersion != "" {
+			return []string{i.BIOSVersion}, nil
+		}
+	case InventoryFieldBMC:
+		if i.BMCFirmware != "" {
+			return []string{i.BMCFirmware}, nil
+		}
+	case InventoryFieldNIC:
+		versions := make([]string, 0, len(i.NICFirmware))
+		for _, k := range slices.Sorted(maps.Keys(i.NICFirmware)) {
+			versions = append(versions, i.NICFirmware[k])
+		}
+		return versions, nil
+	}
+
+	return nil, nil
+}
+
+// Complies returns true if all firmware versions of the given field are want.
+// An inventory without versions for the field does not comply
+func (i *Inventory) Complies(field, want string) (bool, error) {
+	versions, err := i.Versions(field)
+	if err != nil {
+		return false, err
+	}
+	if len(versions) == 0 {
+		return false, nil
+	}
+
+	for _, v := range versions {
+		if v != want {
+			return false, nil
+		}
+	}
+
+	return true, nil
+}
+
+// Matches returns true if any firmware version of the given field is version
+func (i *Inventory) Matches(field, version string) bool {
+	versions, _ := i.Versions(field)
+	return slices.Contains(versions, version)
+}
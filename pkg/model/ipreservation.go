@@ -0,0 +1,36 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package model
+
+import "time"
+
+const (
+	// IPReservationReserved holds an address handed out by node nextip until
+	// it is assigned to a host
+	IPReservationReserved = "reserved"
+
+	// IPReservationConflict holds an address a host declined over DHCP as
+	// already in use on the network
+	IPReservationConflict = "conflict"
+)
+
+type IPReservationList []*IPReservation
+
+// IPReservation keeps an address from being allocated until ExpiresAt. Host
+// is the host which declined a conflicting address, User the user who
+// reserved it.
+type IPReservation struct {
+	IP        string    `json:"ip"`
+	Kind      string    `json:"kind"`
+	Host      string    `json:"host,omitempty"`
+	User      string    `json:"user,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+// Expired returns whether the reservation stopped holding the address at now
+func (r *IPReservation) Expired(now time.Time) bool {
+	return !now.Before(r.ExpiresAt)
+}
@@ -0,0 +1,15 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package model
+
+type ListenerList []*Listener
+
+// Listener is a socket bound by a service of grendel serve
+type Listener struct {
+	Service string `json:"service"`
+	Network string `json:"network" description:"Network of the socket: tcp, udp or unix"`
+	Address string `json:"address"`
+	Family  string `json:"family" description:"Clients accepted by the socket: ipv4, ipv6, ipv4+ipv6 for the dual-stack [::] wildcard or unix"`
+}
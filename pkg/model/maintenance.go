@@ -0,0 +1,17 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package model
+
+import "time"
+
+// Maintenance is the global maintenance mode. While enabled DHCP skips the
+// PXE and zero touch provisioning answers and the provision endpoints return
+// 503, static address assignment and DNS continue normally
+type Maintenance struct {
+	Enabled   bool      `json:"enabled"`
+	Reason    string    `json:"reason,omitempty"`
+	ChangedBy string    `json:"changed_by,omitempty" description:"User who last turned maintenance mode on or off"`
+	Changed   time.Time `json:"changed" description:"Time maintenance mode was last turned on or off"`
+}
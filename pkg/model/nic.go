@@ -8,13 +8,12 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
-	"math/rand"
+	"math/rand/v2"
 	"net"
 	"net/netip"
+	"strconv"
 	"strings"
-	"time"
 
-	"github.com/spf13/viper"
 	"github.com/ubccr/grendel/internal/config"
 	"go4.org/netipx"
 )
@@ -28,19 +27,38 @@ const (
 	NicTypeEthernet NicType = iota + 1
 	NicTypeBMC
 	NicTypeBond
+	NicTypeBridge
 )
 
+// MaxVLAN is the highest valid 802.1Q VLAN ID
+const MaxVLAN = 4094
+
 type NetInterfaceList []NetInterface
 
 type NetInterface struct {
-	ID   int64            `json:"id" oai3:"nullable"`
-	MAC  net.HardwareAddr `json:"mac" oai3:"typeStr,formatNone"`
-	Name string           `json:"ifname"`
-	IP   netip.Prefix     `json:"ip" oai3:"typeStr"`
-	FQDN string           `json:"fqdn"`
-	BMC  bool             `json:"bmc"`
-	VLAN string           `json:"vlan"`
-	MTU  uint16           `json:"mtu,omitempty"`
+	ID     int64            `json:"id" oai3:"nullable"`
+	MAC    net.HardwareAddr `json:"mac" oai3:"typeStr,formatNone"`
+	Name   string           `json:"ifname"`
+	IP     netip.Prefix     `json:"ip" oai3:"typeStr"`
+	FQDN   string           `json:"fqdn"`
+	BMC    bool             `json:"bmc"`
+	VLAN   uint16           `json:"vlan,omitempty"`
+	Parent string           `json:"parent,omitempty"`
+	MTU    uint16           `json:"mtu,omitempty"`
+	Switch string           `json:"switch,omitempty"`
+	Port   int              `json:"port,omitempty"`
+
+	// Addresses are the secondary addresses of the interface. The primary
+	// address is IP and FQDN
+	Addresses []NetAddress `json:"addresses,omitempty"`
+}
+
+// NetAddress is an address of a network interface with an optional FQDN.
+// Only the primary address of an interface is handed out by DHCP
+type NetAddress struct {
+	IP      netip.Prefix `json:"ip" oai3:"typeStr"`
+	FQDN    string       `json:"fqdn,omitempty"`
+	Primary bool         `json:"primary,omitempty"`
 }
 
 // Return the string of a NicType
@@ -52,6 +70,8 @@ func (n NicType) String() string {
 		return "bmc"
 	case NicTypeBond:
 		return "bond"
+	case NicTypeBridge:
+		return "bridge"
 	default:
 		return "Unknown type"
 	}
@@ -66,6 +86,8 @@ func NicTypeFromString(name string) (NicType, error) {
 		return NicTypeBMC, nil
 	case "bond":
 		return NicTypeBond, nil
+	case "bridge":
+		return NicTypeBridge, nil
 	default:
 		return NicTypeEthernet, ErrInvalidNicType
 	}
@@ -102,8 +124,9 @@ func (n *NetInterface) MarshalJSON() ([]byte, error) {
 func (n *NetInterface) UnmarshalJSON(data []byte) error {
 	type Alias NetInterface
 	aux := &struct {
-		MAC string `json:"mac"`
-		IP  string `json:"ip"`
+		MAC  string          `json:"mac"`
+		IP   string          `json:"ip"`
+		VLAN json.RawMessage `json:"vlan"`
 		*Alias
 	}{
 		Alias: (*Alias)(n),
@@ -111,6 +134,13 @@ func (n *NetInterface) UnmarshalJSON(data []byte) error {
 	if err := json.Unmarshal(data, &aux); err != nil {
 		return err
 	}
+
+	vlan, err := unmarshalVLAN(aux.VLAN)
+	if err != nil {
+		return err
+	}
+	n.VLAN = vlan
+
 	if aux.MAC != "" {
 		mac, err := net.ParseMAC(aux.MAC)
 		if err != nil {
@@ -128,9 +158,142 @@ func (n *NetInterface) UnmarshalJSON(data []byte) error {
 
 		n.IP = ip
 	}
+
+	return n.normalizeAddresses()
+}
+
+// normalizeAddresses moves an address flagged as primary in the address list
+// into IP and FQDN, so a bare ip continues to mean the single primary address
+// and Addresses only holds secondary addresses
+func (n *NetInterface) normalizeAddresses() error {
+	if len(n.Addresses) == 0 {
+		return nil
+	}
+
+	secondary := make([]NetAddress, 0, len(n.Addresses))
+	for _, a := range n.Addresses {
+		if !a.Primary {
+			secondary = append(secondary, a)
+			continue
+		}
+
+		if !n.IP.IsValid() {
+			n.IP = a.IP
+			if n.FQDN == "" {
+				n.FQDN = a.FQDN
+			}
+			continue
+		}
+
+		if a.IP != n.IP {
+			return fmt.Errorf("multiple primary addresses for interface %s: %s and %s", n.displayName(), n.IP, a.IP)
+		}
+	}
+
+	n.Addresses = secondary
+
 	return nil
 }
 
+// AllAddresses returns the primary address of the interface, if set,
+// followed by the secondary addresses
+func (n *NetInterface) AllAddresses() []NetAddress {
+	addrs := make([]NetAddress, 0, len(n.Addresses)+1)
+	if n.IP.IsValid() {
+		addrs = append(addrs, NetAddress{IP: n.IP, FQDN: n.FQDN, Primary: true})
+	}
+	for _, a := range n.Addresses {
+		a.Primary = false
+		addrs = append(addrs, a)
+	}
+
+	return addrs
+}
+
+// validateAddresses checks every secondary address of the interface is set
+// and not a duplicate of another address of the interface
+func (n *NetInterface) validateAddresses() error {
+	seen := make(map[netip.Addr]bool, len(n.Addresses)+1)
+	for _, a := range n.AllAddresses() {
+		if !a.IP.IsValid() {
+			return fmt.Errorf("invalid address for interface %s: ip required", n.displayName())
+		}
+		if seen[a.IP.Addr()] {
+			return fmt.Errorf("duplicate address %s for interface %s", a.IP.Addr(), n.displayName())
+		}
+		seen[a.IP.Addr()] = true
+	}
+
+	return nil
+}
+
+// unmarshalVLAN decodes a VLAN ID from either a JSON number or a string, as
+// VLANs were stored as strings in older versions
+func unmarshalVLAN(data json.RawMessage) (uint16, error) {
+	if len(data) == 0 || string(data) == "null" {
+		return 0, nil
+	}
+
+	var vlan string
+	if err := json.Unmarshal(data, &vlan); err != nil {
+		vlan = string(data)
+	}
+	if vlan == "" {
+		return 0, nil
+	}
+
+	id, err := strconv.ParseUint(vlan, 10, 16)
+	if err != nil {
+		return 0, fmt.Errorf("Invalid vlan %s: %w", data, err)
+	}
+
+	return uint16(id), nil
+}
+
+// Validate checks the VLAN ID of the interface is between 1 and MaxVLAN,
+// that a VLAN is set if the interface has a parent and that the secondary
+// addresses are valid
+func (n *NetInterface) Validate() error {
+	if n.VLAN > MaxVLAN {
+		return fmt.Errorf("invalid vlan %d for interface %s: must be between 1 and %d", n.VLAN, n.displayName(), MaxVLAN)
+	}
+
+	if n.Parent != "" && n.VLAN == 0 {
+		return fmt.Errorf("vlan required for interface %s with parent %s", n.displayName(), n.Parent)
+	}
+
+	return n.validateAddresses()
+}
+
+// IsVLAN returns true if the interface is a VLAN subinterface of a parent
+// interface, such as ens1f0.2010
+func (n *NetInterface) IsVLAN() bool {
+	return n.VLAN != 0 && n.Parent != ""
+}
+
+// VLANName returns the name of a VLAN subinterface. It defaults to the
+// parent and VLAN ID, such as ens1f0.2010, if the interface has no name
+func (n *NetInterface) VLANName() string {
+	if n.Name != "" {
+		return n.Name
+	}
+
+	return fmt.Sprintf("%s.%d", n.Parent, n.VLAN)
+}
+
+// displayName returns the name, FQDN or IP address of the interface for
+// error messages
+func (n *NetInterface) displayName() string {
+	switch {
+	case n.Name != "":
+		return n.Name
+	case n.FQDN != "":
+		return n.HostName()
+	default:
+		return n.AddrString()
+	}
+}
+
 func (n *NetInterface) CIDR() string {
 	if !n.IP.IsValid() {
 		return ""
@@ -170,11 +333,71 @@ func (n *NetInterface) ToStdAddr() net.IP {
 }
 
 func (n *NetInterface) Netmask() net.IPMask {
-	return net.CIDRMask(n.IP.Bits(), 32)
+	if !n.IP.IsValid() {
+		return nil
+	}
+
+	return net.CIDRMask(n.IP.Bits(), n.IP.Addr().BitLen())
 }
 
 func (n *NetInterface) NetmaskString() string {
-	return net.IP(net.CIDRMask(n.IP.Bits(), 32)).String()
+	mask := n.Netmask()
+	if mask == nil {
+		return ""
+	}
+
+	return net.IP(mask).String()
+}
+
+// PrefixLen returns the prefix length of the interface address, or -1 if
+// the interface has no address
+func (n *NetInterface) PrefixLen() int {
+	return n.IP.Bits()
+}
+
+// Network returns the network address of the interface
+func (n *NetInterface) Network() netip.Addr {
+	return n.IP.Masked().Addr()
+}
+
+func (n *NetInterface) NetworkString() string {
+	if !n.IP.IsValid() {
+		return ""
+	}
+
+	return n.Network().String()
+}
+
+// Broadcast returns the broadcast address of the network of the interface
+func (n *NetInterface) Broadcast() netip.Addr {
+	return netipx.PrefixLastIP(n.IP.Masked())
+}
+
+func (n *NetInterface) BroadcastString() string {
+	if !n.IP.IsValid() {
+		return ""
+	}
+
+	return n.Broadcast().String()
+}
+
+// ValidateGateway checks the network of the interface contains the router
+// configured for it. Interfaces without an address or router are valid.
+func (n *NetInterface) ValidateGateway() error {
+	if !n.IP.IsValid() {
+		return nil
+	}
+
+	gw := n.Gateway()
+	if !gw.IsValid() {
+		return nil
+	}
+
+	if !n.IP.Masked().Contains(gw) {
+		return fmt.Errorf("router %s is not in network %s of interface %s", gw, n.IP.Masked(), n.displayName())
+	}
+
+	return nil
 }
 
 func (n *NetInterface) InterfaceMTU() uint16 {
@@ -182,7 +405,8 @@ func (n *NetInterface) InterfaceMTU() uint16 {
 		return n.MTU
 	}
 
-	for _, subnet := range config.Subnets {
+	cfg := config.Current()
+	for _, subnet := range cfg.Subnets {
 		if subnet.MTU == 0 {
 			continue
 		}
@@ -192,30 +416,50 @@ func (n *NetInterface) InterfaceMTU() uint16 {
 		}
 	}
 
-	return config.DefaultMTU
+	return cfg.DefaultMTU
 }
 
 func (n *NetInterface) Gateway() netip.Addr {
-	for _, subnet := range config.Subnets {
+	cfg := config.Current()
+	for _, subnet := range cfg.Subnets {
 		if subnet.Gateway.Contains(n.IP.Addr()) {
 			return subnet.Gateway.Addr()
 		}
 	}
 
-	if viper.IsSet("dhcp.router_octet4") {
+	if cfg.RouterOctet4 >= 0 && n.IP.Addr().Is4() {
 		lastIP := netipx.PrefixLastIP(n.IP)
 		ip4 := lastIP.As4()
-		ip4[3] = uint8(viper.GetInt("dhcp.router_octet4"))
+		ip4[3] = uint8(cfg.RouterOctet4)
 		return netip.AddrFrom4(ip4)
 	}
 
-	return config.DefaultGateway
+	return cfg.DefaultGateway
+}
+
+// OutsideSubnets returns true when dhcp.subnets is set and none of the
+// subnets contains the address of the interface, so its DHCP replies lack the
+// router, DNS servers and MTU of its subnet
+func (n *NetInterface) OutsideSubnets() bool {
+	cfg := config.Current()
+	if len(cfg.Subnets) == 0 || !n.IP.IsValid() {
+		return false
+	}
+
+	for _, subnet := range cfg.Subnets {
+		if subnet.Gateway.Contains(n.IP.Addr()) {
+			return false
+		}
+	}
+
+	return true
 }
 
 func (n *NetInterface) DNS() []net.IP {
 	dnsServers := make([]net.IP, 0)
 
-	for _, subnet := range config.Subnets {
+	cfg := config.Current()
+	for _, subnet := range cfg.Subnets {
 		if len(subnet.DNS) == 0 {
 			continue
 		}
@@ -227,13 +471,12 @@ func (n *NetInterface) DNS() []net.IP {
 	}
 
 	if len(dnsServers) == 0 {
-		dnsServers = append(dnsServers, config.DefaultDNS...)
+		dnsServers = append(dnsServers, cfg.DefaultDNS...)
 	}
 
 	if len(dnsServers) > 1 {
 		// Randomize DNS servers to distribute load
 		// TODO: add option to turn this off
-		rand.Seed(time.Now().UnixNano())
 		rand.Shuffle(len(dnsServers), func(i, j int) { dnsServers[i], dnsServers[j] = dnsServers[j], dnsServers[i] })
 	}
 
@@ -241,7 +484,8 @@ func (n *NetInterface) DNS() []net.IP {
 }
 
 func (n *NetInterface) DomainSearch() []string {
-	for _, subnet := range config.Subnets {
+	cfg := config.Current()
+	for _, subnet := range cfg.Subnets {
 		if len(subnet.DomainSearch) == 0 {
 			continue
 		}
@@ -251,7 +495,7 @@ func (n *NetInterface) DomainSearch() []string {
 		}
 	}
 
-	return config.DefaultDomainSearch
+	return cfg.DefaultDomainSearch
 }
 
 func (n *NetInterface) DNSList() []string {
@@ -0,0 +1,19 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package model
+
+// PasswordRotationReport is the result of rotating the BMC password of one
+// host. Attention is set when the password on the BMC may no longer match
+// the stored credentials, because the new password could neither be
+// committed nor rolled back.
+type PasswordRotationReport struct {
+	Host      string `json:"host"`
+	Status    string `json:"status"`
+	Msg       string `json:"msg"`
+	Username  string `json:"username"`
+	Attention bool   `json:"attention"`
+}
+
+type PasswordRotationReportList []PasswordRotationReport
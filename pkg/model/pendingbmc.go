@@ -0,0 +1,22 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package model
+
+import "time"
+
+type PendingBMCList []*PendingBMC
+
+// PendingBMC is a BMC seen on DHCP from a MAC address not registered to any
+// host, matched by its vendor class or OUI. Relay is the DHCP relay agent the
+// request was forwarded by, empty for BMCs on a segment local to the server,
+// and ServerIP the server address the request was received on.
+type PendingBMC struct {
+	MAC         string    `json:"mac"`
+	VendorClass string    `json:"vendor_class"`
+	Relay       string    `json:"relay"`
+	ServerIP    string    `json:"server_ip"`
+	FirstSeen   time.Time `json:"first_seen"`
+	LastSeen    time.Time `json:"last_seen"`
+}
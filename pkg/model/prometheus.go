@@ -0,0 +1,98 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package model
+
+import (
+	"net"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// DefaultPrometheusPort is the port of node_exporter
+const DefaultPrometheusPort = 9100
+
+var invalidLabelChars = regexp.MustCompile(`[^a-zA-Z0-9_]`)
+
+type PrometheusTargetGroupList []PrometheusTargetGroup
+
+// PrometheusTargetGroup is a group of targets of the Prometheus file_sd and
+// http_sd service discovery formats
+type PrometheusTargetGroup struct {
+	Targets []string          `json:"targets"`
+	Labels  map[string]string `json:"labels"`
+}
+
+// PrometheusTargets returns a target group per host, scraped on port of the
+// FQDN or address of its boot interface. Hosts set to provision are left out
+// with excludeProvision, they are being reinstalled. Each group is labeled
+// with the node name, its boot image, its tags joined as ,tag1,tag2, and a
+// label for each key=value or key:value tag such as rack=a01
+func (hl HostList) PrometheusTargets(port int, excludeProvision bool) PrometheusTargetGroupList {
+	groups := make(PrometheusTargetGroupList, 0, len(hl))
+	for _, host := range hl {
+		if excludeProvision && host.Provision {
+			continue
+		}
+
+		address := host.Name
+		if nic := host.BootInterface(); nic != nil {
+			if name := nic.HostName(); name != "" {
+				address = name
+			} else if nic.IP.IsValid() {
+				address = nic.AddrString()
+			}
+		}
+
+		labels := map[string]string{"node": host.Name}
+		if host.BootImage != "" {
+			labels["bootimage"] = host.BootImage
+		}
+
+		plain := make([]string, 0)
+		for _, tag := range host.Tags {
+			key, value, ok := strings.Cut(tag, "=")
+			if !ok {
+				key, value, ok = strings.Cut(tag, ":")
+			}
+			if !ok {
+				plain = append(plain, tag)
+				continue
+			}
+
+			key = prometheusLabelName(key)
+			if _, exists := labels[key]; !exists && key != "" {
+				labels[key] = value
+			}
+		}
+		if len(plain) > 0 {
+			sort.Strings(plain)
+			labels["tags"] = "," + strings.Join(plain, ",") + ","
+		}
+
+		groups = append(groups, PrometheusTargetGroup{
+			Targets: []string{net.JoinHostPort(address, strconv.Itoa(port))},
+			Labels:  labels,
+		})
+	}
+
+	return groups
+}
+
+// prometheusLabelName returns name with the characters not allowed in
+// Prometheus label names replaced by _. Names starting with __ are reserved
+// for internal use and are not returned
+func prometheusLabelName(name string) string {
+	name = invalidLabelChars.ReplaceAllString(name, "_")
+	if name == "" || strings.HasPrefix(name, "__") {
+		return ""
+	}
+	if name[0] >= '0' && name[0] <= '9' {
+		name = "_" + name
+	}
+
+	return name
+}
@@ -0,0 +1,57 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package model_test
+
+import (
+	"net/netip"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/ubccr/grendel/pkg/model"
+)
+
+func TestPrometheusTargets(t *testing.T) {
+	hosts := model.HostList{
+		{
+			Name:      "cpn-01",
+			BootImage: "rocky9",
+			Tags:      []string{"compute", "rack=a01", "row:3", "ib", "gres=gpu:a100:4", "__meta=x", "cpu-vendor=amd"},
+			Interfaces: []*model.NetInterface{
+				{Name: "idrac", IP: netip.MustParsePrefix("10.0.1.1/24"), FQDN: "bmc-cpn-01.example.com", BMC: true},
+				{Name: "eno1", IP: netip.MustParsePrefix("10.0.0.1/24"), FQDN: "cpn-01.example.com,cpn-01"},
+			},
+		},
+		{
+			Name:       "cpn-02",
+			Provision:  true,
+			Interfaces: []*model.NetInterface{{Name: "eno1", IP: netip.MustParsePrefix("fd00::2/64")}},
+		},
+		{Name: "cpn-03"},
+	}
+
+	groups := hosts.PrometheusTargets(9100, false)
+	assert.Equal(t, model.PrometheusTargetGroupList{
+		{
+			Targets: []string{"cpn-01.example.com:9100"},
+			Labels: map[string]string{
+				"node":       "cpn-01",
+				"bootimage":  "rocky9",
+				"rack":       "a01",
+				"row":        "3",
+				"gres":       "gpu:a100:4",
+				"cpu_vendor": "amd",
+				"tags":       ",compute,ib,",
+			},
+		},
+		{Targets: []string{"[fd00::2]:9100"}, Labels: map[string]string{"node": "cpn-02"}},
+		{Targets: []string{"cpn-03:9100"}, Labels: map[string]string{"node": "cpn-03"}},
+	}, groups)
+
+	groups = hosts.PrometheusTargets(9256, true)
+	if assert.Len(t, groups, 2) {
+		assert.Equal(t, []string{"cpn-01.example.com:9256"}, groups[0].Targets)
+		assert.Equal(t, "cpn-03", groups[1].Labels["node"])
+	}
+}
@@ -0,0 +1,165 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package model
+
+import (
+	"fmt"
+	"net/netip"
+	"strings"
+)
+
+const (
+	RecordTypeA     = "A"
+	RecordTypeCNAME = "CNAME"
+)
+
+type RecordList []*Record
+
+// Record is a DNS only record for names which are not provisionable hosts
+// such as VIPs, printers or appliances. A records with PTR set also answer
+// reverse lookups for their address. A TTL of 0 uses the dns.ttl default.
+type Record struct {
+	ID    int64  `json:"id" oai3:"nullable"`
+	Name  string `json:"name" validate:"required"`
+	Type  string `json:"type" validate:"required"`
+	Value string `json:"value" validate:"required"`
+	TTL   int64  `json:"ttl"`
+	PTR   bool   `json:"ptr"`
+}
+
+// Normalize lower cases the record name, type and CNAME target and strips
+// trailing dots
+func (r *Record) Normalize() {
+	r.Name = strings.TrimSuffix(strings.ToLower(strings.TrimSpace(r.Name)), ".")
+	r.Type = strings.ToUpper(strings.TrimSpace(r.Type))
+	r.Value = strings.TrimSpace(r.Value)
+	if r.Type == RecordTypeCNAME {
+		r.Value = strings.TrimSuffix(strings.ToLower(r.Value), ".")
+	}
+}
+
+// Validate checks the record name, type and value are valid
+func (r *Record) Validate() error {
+	if !validDomainName(r.Name) {
+		return fmt.Errorf("invalid record name: %q", r.Name)
+	}
+
+	if r.TTL < 0 {
+		return fmt.Errorf("invalid ttl for record %s: %d", r.Name, r.TTL)
+	}
+
+	switch r.Type {
+	case RecordTypeA:
+		addr, err := netip.ParseAddr(r.Value)
+		if err != nil || !addr.Is4() {
+			return fmt.Errorf("invalid IPv4 address for A record %s: %q", r.Name, r.Value)
+		}
+	case RecordTypeCNAME:
+		if !validDomainName(r.Value) {
+			return fmt.Errorf("invalid target for CNAME record %s: %q", r.Name, r.Value)
+		}
+		if r.Value == r.Name {
+			return fmt.Errorf("CNAME record %s must not point to itself", r.Name)
+		}
+		if r.PTR {
+			return fmt.Errorf("CNAME record %s can not have a PTR record", r.Name)
+		}
+	default:
+		return fmt.Errorf("invalid type for record %s: %q. Valid types: %s, %s", r.Name, r.Type, RecordTypeA, RecordTypeCNAME)
+	}
+
+	return nil
+}
+
+// String returns the record in zone file format
+func (r *Record) String() string {
+	return fmt.Sprintf("%s.\t%d\tIN\t%s\t%s", r.Name, r.TTL, r.Type, r.Value)
+}
+
+// Key returns the name, type and value which identify a record
+func (r *Record) Key() string {
+	return r.Name + "/" + r.Type + "/" + r.Value
+}
+
+// CheckConflicts returns an error if any record in rl conflicts with a host
+// interface FQDN or another record. existing is the list of records already
+// stored, records in rl with the same name, type and value replace them. A
+// CNAME can not share its name with any other record and an A record with PTR
+// set can not use the address of a host interface.
+func (rl RecordList) CheckConflicts(hosts HostList, existing RecordList) error {
+	hostNames := make(map[string]string)
+	hostIPs := make(map[netip.Addr]string)
+	for _, host := range hosts {
+		for _, nic := range host.nics() {
+			if nic.IP.IsValid() {
+				hostIPs[nic.IP.Addr()] = host.Name
+			}
+			for _, addr := range nic.Addresses {
+				hostIPs[addr.IP.Addr()] = host.Name
+			}
+		}
+		for _, name := range host.FQDNs() {
+			hostNames[name] = host.Name
+		}
+	}
+
+	combined := make(map[string]*Record)
+	for _, r := range existing {
+		combined[r.Key()] = r
+	}
+	for _, r := range rl {
+		combined[r.Key()] = r
+	}
+
+	byName := make(map[string][]*Record)
+	for _, r := range combined {
+		byName[r.Name] = append(byName[r.Name], r)
+	}
+
+	for _, r := range rl {
+		if host, ok := hostNames[r.Name]; ok {
+			return fmt.Errorf("record %s conflicts with an interface of host %s", r.Name, host)
+		}
+
+		for _, other := range byName[r.Name] {
+			if other.Key() == r.Key() {
+				continue
+			}
+			if r.Type == RecordTypeCNAME || other.Type == RecordTypeCNAME {
+				return fmt.Errorf("record %s %s conflicts with existing %s record. A CNAME can not share its name with other records", r.Name, r.Type, other.Type)
+			}
+		}
+
+		if r.Type == RecordTypeA && r.PTR {
+			addr, _ := netip.ParseAddr(r.Value)
+			if host, ok := hostIPs[addr]; ok {
+				return fmt.Errorf("PTR for record %s conflicts with an interface of host %s using address %s", r.Name, host, r.Value)
+			}
+		}
+	}
+
+	return nil
+}
+
+func validDomainName(name string) bool {
+	if name == "" || len(name) > 253 {
+		return false
+	}
+
+	for _, label := range strings.Split(name, ".") {
+		if label == "" || len(label) > 63 {
+			return false
+		}
+		for _, c := range label {
+			switch {
+			case c >= 'a' && c <= 'z', c >= 'A' && c <= 'Z', c >= '0' && c <= '9', c == '-', c == '_':
+			default:
+				return false
+			}
+		}
+	}
+
+	return true
+}
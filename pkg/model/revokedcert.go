@@ -0,0 +1,18 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package model
+
+import "time"
+
+type RevokedCertList []*RevokedCert
+
+// RevokedCert records the serial number of a revoked certificate issued by
+// the Grendel CA, in lower case hex. Host is the host the certificate was
+// issued to, empty when revoked by serial number.
+type RevokedCert struct {
+	Serial    string    `json:"serial"`
+	Host      string    `json:"host,omitempty"`
+	RevokedAt time.Time `json:"revoked_at"`
+}
@@ -0,0 +1,109 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package model
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/stmcginnis/gofish/schemas"
+	"github.com/ubccr/grendel/pkg/nodeset"
+)
+
+// Actions run by the scheduler
+const (
+	// ScheduleActionImage sets the boot image named by Arg
+	ScheduleActionImage = "image"
+
+	// ScheduleActionProvision sets the hosts to provision, or unprovision
+	// when Arg is false
+	ScheduleActionProvision = "provision"
+
+	// ScheduleActionPowerCycle restarts the hosts through their BMC, with
+	// the redfish boot override named by Arg
+	ScheduleActionPowerCycle = "power-cycle"
+
+	// ScheduleActionWebhook sends the schedule.run webhook event with the
+	// message Arg
+	ScheduleActionWebhook = "webhook"
+)
+
+// Statuses of a scheduled action
+const (
+	ScheduleStatusPending   = "pending"
+	ScheduleStatusRunning   = "running"
+	ScheduleStatusDone      = "done"
+	ScheduleStatusPartial   = "partial"
+	ScheduleStatusFailed    = "failed"
+	ScheduleStatusCancelled = "cancelled"
+
+	// ScheduleStatusMissed is an action which was due while grendel serve
+	// was down for longer than the grace period
+	ScheduleStatusMissed = "missed"
+)
+
+// ScheduleActions are the actions run by the scheduler
+var ScheduleActions = []string{ScheduleActionImage, ScheduleActionProvision, ScheduleActionPowerCycle, ScheduleActionWebhook}
+
+type ScheduledActionList []*ScheduledAction
+
+// ScheduledAction is an action run by grendel serve on the hosts of Nodeset
+// at RunAt. Results has the outcome of the action on each host once it ran
+type ScheduledAction struct {
+	ID         int64          `json:"id"`
+	Action     string         `json:"action" validate:"required"`
+	Nodeset    string         `json:"nodeset" validate:"required"`
+	Arg        string         `json:"arg,omitempty"`
+	RunAt      time.Time      `json:"run_at" validate:"required"`
+	CreatedBy  string         `json:"created_by,omitempty"`
+	CreatedAt  time.Time      `json:"created_at,omitzero" oai3:"nullable"`
+	Status     string         `json:"status,omitempty"`
+	StartedAt  time.Time      `json:"started_at,omitzero" oai3:"nullable"`
+	FinishedAt time.Time      `json:"finished_at,omitzero" oai3:"nullable"`
+	Results    JobMessageList `json:"results,omitempty" oai3:"nullable"`
+	Error      string         `json:"error,omitempty"`
+}
+
+// Validate checks the action, its argument and nodeset are valid
+func (a *ScheduledAction) Validate() error {
+	if _, err := nodeset.NewNodeSet(a.Nodeset); err != nil || a.Nodeset == "" {
+		return fmt.Errorf("invalid nodeset: %q", a.Nodeset)
+	}
+
+	if a.RunAt.IsZero() {
+		return fmt.Errorf("missing run at time")
+	}
+
+	switch a.Action {
+	case ScheduleActionImage:
+		if a.Arg == "" {
+			return fmt.Errorf("missing boot image name")
+		}
+	case ScheduleActionProvision:
+		if _, err := strconv.ParseBool(a.Arg); err != nil {
+			return fmt.Errorf("invalid provision value %q, expected true or false", a.Arg)
+		}
+	case ScheduleActionPowerCycle:
+		if a.Arg != "" && !slices.Contains(bootOverrides, schemas.BootSource(a.Arg)) {
+			return fmt.Errorf("invalid boot override %q, expected one of %v", a.Arg, bootOverrides)
+		}
+	case ScheduleActionWebhook:
+	default:
+		return fmt.Errorf("invalid action %q, expected one of %v", a.Action, ScheduleActions)
+	}
+
+	return nil
+}
+
+// bootOverrides are the boot overrides of the power-cycle action
+var bootOverrides = []schemas.BootSource{
+	schemas.NoneBootSource,
+	schemas.PxeBootSource,
+	schemas.BiosSetupBootSource,
+	schemas.UtilitiesBootSource,
+	schemas.DiagsBootSource,
+}
@@ -0,0 +1,40 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package model
+
+import (
+	"fmt"
+	"regexp"
+	"time"
+)
+
+var validSecretName = regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9_.-]*$`)
+
+type SecretList []*Secret
+
+// Secret is a named value such as a LUKS passphrase or a cluster join token
+// read by provision templates with {{ secret "name" }}. Value is encrypted
+// with the credentials key of the server and is never marshalled, so it is
+// left out of API responses, dumps and logs. A OneTime secret is rendered
+// once per boot token
+type Secret struct {
+	Name      string    `json:"name" validate:"required"`
+	Value     string    `json:"-"`
+	OneTime   bool      `json:"one_time"`
+	UpdatedAt time.Time `json:"updated_at,omitzero" oai3:"nullable"`
+}
+
+// Validate checks the secret name is valid and the value is set
+func (s *Secret) Validate() error {
+	if !validSecretName.MatchString(s.Name) {
+		return fmt.Errorf("invalid secret name: %q", s.Name)
+	}
+
+	if s.Value == "" {
+		return fmt.Errorf("missing value for secret %s", s.Name)
+	}
+
+	return nil
+}
@@ -0,0 +1,118 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package model
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/ubccr/grendel/internal/config"
+)
+
+const (
+	// SigningKeyProvision keys sign the boot and firmware tokens
+	SigningKeyProvision = "provision"
+
+	// SigningKeyAPI keys sign the API tokens
+	SigningKeyAPI = "api"
+)
+
+type SigningKeyList []*SigningKey
+
+// SigningKey is a secret signing tokens of a kind. The first key of a kind
+// is the primary key signing new tokens, all keys verify them. RetireAt is
+// when a rotated key stops verifying tokens, zero for the primary key.
+// CreatedAt is zero for the secrets of the config. Secret is never
+// serialized.
+type SigningKey struct {
+	ID        string    `json:"id"`
+	Kind      string    `json:"kind"`
+	Secret    string    `json:"-"`
+	Primary   bool      `json:"primary"`
+	CreatedAt time.Time `json:"created_at"`
+	RetireAt  time.Time `json:"retire_at"`
+}
+
+// Retired returns whether the key stopped verifying tokens at now
+func (k *SigningKey) Retired(now time.Time) bool {
+	return !k.RetireAt.IsZero() && !now.Before(k.RetireAt)
+}
+
+// SigningKeyID returns the ID of secret, the first 8 hex digits of its
+// SHA-256 hash
+func SigningKeyID(secret string) string {
+	sum := sha256.Sum256([]byte(secret))
+	return hex.EncodeToString(sum[:4])
+}
+
+// signingKeyConfig maps a kind to the setting holding its secrets
+var signingKeyConfig = map[string]string{
+	SigningKeyProvision: "provision.secret",
+	SigningKeyAPI:       "api.secret",
+}
+
+// stored are the rotated keys loaded from the data store
+var stored = struct {
+	sync.RWMutex
+	keys SigningKeyList
+}{}
+
+// SetSigningKeys replaces the rotated keys of all kinds
+func SetSigningKeys(keys SigningKeyList) {
+	stored.Lock()
+	defer stored.Unlock()
+
+	stored.keys = slices.Clone(keys)
+}
+
+// SigningKeys returns the keys of kind not retired, the primary first. Once
+// a kind was rotated its keys are the ones in the data store, else the
+// secrets of the provision.secret or api.secret setting, a single secret or
+// a list
+func SigningKeys(kind string) SigningKeyList {
+	now := time.Now()
+
+	stored.RLock()
+	keys := make(SigningKeyList, 0)
+	for _, k := range stored.keys {
+		if k.Kind == kind && !k.Retired(now) {
+			keys = append(keys, k)
+		}
+	}
+	stored.RUnlock()
+
+	if len(keys) > 0 {
+		slices.SortStableFunc(keys, func(a, b *SigningKey) int {
+			return b.CreatedAt.Compare(a.CreatedAt)
+		})
+		return keys
+	}
+
+	for _, secret := range config.Viper().GetStringSlice(signingKeyConfig[kind]) {
+		if secret == "" {
+			continue
+		}
+		keys = append(keys, &SigningKey{
+			ID:     SigningKeyID(secret),
+			Kind:   kind,
+			Secret: secret,
+		})
+	}
+
+	return keys
+}
+
+// PrimarySigningKey returns the key of kind signing new tokens
+func PrimarySigningKey(kind string) *SigningKey {
+	keys := SigningKeys(kind)
+	if len(keys) == 0 {
+		return &SigningKey{Kind: kind}
+	}
+
+	return keys[0]
+}
@@ -0,0 +1,41 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package model
+
+import "time"
+
+// HostStats are aggregate counts of the hosts in the datastore
+type HostStats struct {
+	Total         int `json:"total"`
+	Provisioning  int `json:"provisioning" description:"Hosts set to provision on their next boot"`
+	Unprovisioned int `json:"unprovisioned"`
+
+	// BootImages and Tags count the hosts of every boot image and tag,
+	// sorted by name and including those without hosts
+	BootImages       []NamedCount `json:"boot_images"`
+	WithoutBootImage int          `json:"without_boot_image"`
+	Tags             []NamedCount `json:"tags"`
+}
+
+// NamedCount is the number of hosts of a boot image or tag
+type NamedCount struct {
+	Name  string `json:"name"`
+	Hosts int    `json:"hosts"`
+}
+
+// Stats is the summary of the hosts and the recent activity of the services
+// of a grendel serve process
+type Stats struct {
+	Hosts HostStats `json:"hosts"`
+
+	DHCPAcksLastHour          int     `json:"dhcp_acks_last_hour" description:"DHCP ACKs sent in the last hour"`
+	ProvisionCompletionsToday int     `json:"provision_completions_today" description:"Provision completions since midnight local time"`
+	DNSQueriesPerSecond       float64 `json:"dns_queries_per_second" description:"DNS queries per second over the last 5 minutes"`
+
+	// Since is when counting of the activity started, the start of the
+	// process. Activity before is not included
+	Since     time.Time `json:"since"`
+	Generated time.Time `json:"generated" description:"Time the summary was computed, it is cached for a few seconds"`
+}
@@ -5,23 +5,49 @@
 package model
 
 import (
+	"encoding/binary"
+	"encoding/hex"
 	"encoding/json"
+	"errors"
+	"strings"
+	"time"
 
+	"github.com/eknkc/basex"
 	"github.com/hako/branca"
 	"github.com/spf13/viper"
+	"github.com/ubccr/grendel/internal/config"
 	"github.com/ubccr/grendel/internal/firmware"
 	"github.com/ubccr/grendel/internal/util"
 )
 
+const brancaBase62 = "0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz"
+
 type BootClaims struct {
 	ID  string `json:"id"`
 	MAC string `json:"mac"`
+
+	// BootID correlates the DHCP, TFTP and provision requests of a boot
+	BootID string `json:"bid,omitempty"`
+}
+
+// BootTokenInfo describes a boot token. ID is the hex encoded token nonce
+// which uniquely identifies the token. ExpiresAt is zero if tokens do not
+// expire.
+type BootTokenInfo struct {
+	ID        string    `json:"id"`
+	HostID    string    `json:"host_id"`
+	HostName  string    `json:"host_name"`
+	MAC       string    `json:"mac"`
+	IssuedAt  time.Time `json:"issued_at"`
+	ExpiresAt time.Time `json:"expires_at"`
+	Expired   bool      `json:"expired"`
+	Revoked   bool      `json:"revoked"`
 }
 
 func init() {
 	viper.SetDefault("provision.token_ttl", 60*60)
 
-	if !viper.IsSet("provision.secret") {
+	if !config.Viper().IsSet("provision.secret") {
 		secret, err := util.GenerateSecret(16)
 		if err != nil {
 			panic(err)
@@ -31,9 +57,16 @@ func init() {
 }
 
 func NewBootToken(id, mac string) (string, error) {
+	return NewTracedBootToken(id, mac, "")
+}
+
+// NewTracedBootToken returns a boot token carrying the boot ID assigned by the
+// DHCP server, so every request made with the token can be logged with it
+func NewTracedBootToken(id, mac, bootID string) (string, error) {
 	claims := &BootClaims{
-		ID:  id,
-		MAC: mac,
+		ID:     id,
+		MAC:    mac,
+		BootID: bootID,
 	}
 
 	jsonBytes, err := json.Marshal(claims)
@@ -41,8 +74,8 @@ func NewBootToken(id, mac string) (string, error) {
 		return "", err
 	}
 
-	b := branca.NewBranca(viper.GetString("provision.secret"))
-	b.SetTTL(viper.GetUint32("provision.token_ttl"))
+	b := branca.NewBranca(PrimarySigningKey(SigningKeyProvision).Secret)
+	b.SetTTL(config.Viper().GetUint32("provision.token_ttl"))
 
 	token, err := b.EncodeToString(string(jsonBytes))
 	if err != nil {
@@ -53,10 +86,7 @@ func NewBootToken(id, mac string) (string, error) {
 }
 
 func ParseBootToken(token string) (*BootClaims, error) {
-	b := branca.NewBranca(viper.GetString("provision.secret"))
-	b.SetTTL(viper.GetUint32("provision.token_ttl"))
-
-	message, err := b.DecodeToString(token)
+	message, err := decodeToken(token, config.Viper().GetUint32("provision.token_ttl"))
 	if err != nil {
 		return nil, err
 	}
@@ -70,11 +100,92 @@ func ParseBootToken(token string) (*BootClaims, error) {
 	return &claims, nil
 }
 
-func NewFirmwareToken(mac string, fwtype firmware.Build) (string, error) {
-	b := branca.NewBranca(viper.GetString("provision.secret"))
-	b.SetTTL(viper.GetUint32("provision.token_ttl"))
+// InspectBootToken decodes the boot token and returns its claims along with
+// the token ID, issue and expiry times. Expired tokens are decoded and
+// returned with Expired set.
+func InspectBootToken(token string) (*BootTokenInfo, error) {
+	message, err := decodeToken(token, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	var claims BootClaims
+	err = json.Unmarshal([]byte(message), &claims)
+	if err != nil {
+		return nil, err
+	}
+
+	id, timestamp, err := bootTokenHeader(token)
+	if err != nil {
+		return nil, err
+	}
+
+	info := &BootTokenInfo{
+		ID:       id,
+		HostID:   claims.ID,
+		MAC:      claims.MAC,
+		IssuedAt: time.Unix(int64(timestamp), 0).UTC(),
+	}
+
+	if ttl := config.Viper().GetUint32("provision.token_ttl"); ttl != 0 {
+		info.ExpiresAt = info.IssuedAt.Add(time.Duration(ttl) * time.Second)
+		info.Expired = time.Now().After(info.ExpiresAt)
+	}
+
+	return info, nil
+}
+
+// decodeToken returns the message of a token signed with any provision
+// signing key, checking it did not expire after ttl seconds when ttl is not 0
+func decodeToken(token string, ttl uint32) (string, error) {
+	err := errors.New("no provision signing key")
+	for _, key := range SigningKeys(SigningKeyProvision) {
+		b := branca.NewBranca(key.Secret)
+		if _, err = b.DecodeToString(token); err != nil {
+			continue
+		}
+
+		b.SetTTL(ttl)
+		return b.DecodeToString(token)
+	}
+
+	return "", err
+}
+
+// BootTokenID returns the ID of the boot token without verifying it
+func BootTokenID(token string) (string, error) {
+	id, _, err := bootTokenHeader(token)
+	return id, err
+}
 
-	token, err := b.EncodeToString(fwtype.String())
+// bootTokenHeader returns the hex encoded nonce and timestamp from the
+// header of a branca token: Version (byte) || Timestamp ([4]byte) || Nonce ([24]byte)
+func bootTokenHeader(token string) (string, uint32, error) {
+	base62, err := basex.NewEncoding(brancaBase62)
+	if err != nil {
+		return "", 0, err
+	}
+
+	data, err := base62.Decode(token)
+	if err != nil || len(data) < 29 {
+		return "", 0, errors.New("invalid boot token")
+	}
+
+	return hex.EncodeToString(data[5:29]), binary.BigEndian.Uint32(data[1:5]), nil
+}
+
+// NewFirmwareToken returns the token naming the firmware sent to a host over
+// TFTP along with the boot ID of the DHCP request
+func NewFirmwareToken(mac string, fwtype firmware.Build, bootID string) (string, error) {
+	b := branca.NewBranca(PrimarySigningKey(SigningKeyProvision).Secret)
+	b.SetTTL(config.Viper().GetUint32("provision.token_ttl"))
+
+	message := fwtype.String()
+	if bootID != "" {
+		message += " " + bootID
+	}
+
+	token, err := b.EncodeToString(message)
 	if err != nil {
 		return "", err
 	}
@@ -82,14 +193,14 @@ func NewFirmwareToken(mac string, fwtype firmware.Build) (string, error) {
 	return token, nil
 }
 
-func ParseFirmwareToken(token string) (firmware.Build, error) {
-	b := branca.NewBranca(viper.GetString("provision.secret"))
-	b.SetTTL(viper.GetUint32("provision.token_ttl"))
-
-	message, err := b.DecodeToString(token)
+// ParseFirmwareToken returns the firmware and boot ID of a firmware token
+func ParseFirmwareToken(token string) (firmware.Build, string, error) {
+	message, err := decodeToken(token, config.Viper().GetUint32("provision.token_ttl"))
 	if err != nil {
-		return 0, err
+		return 0, "", err
 	}
 
-	return firmware.NewFromString(message), nil
+	fw, bootID, _ := strings.Cut(message, " ")
+
+	return firmware.NewFromString(fw), bootID, nil
 }
@@ -6,7 +6,9 @@ package model_test
 
 import (
 	"testing"
+	"time"
 
+	"github.com/spf13/viper"
 	"github.com/stretchr/testify/assert"
 	"github.com/ubccr/grendel/internal/firmware"
 	"github.com/ubccr/grendel/internal/tests"
@@ -17,15 +19,16 @@ func TestToken(t *testing.T) {
 	assert := assert.New(t)
 
 	host := tests.HostFactory.MustCreate().(*model.Host)
-	token, err := model.NewFirmwareToken(host.Interfaces[0].MAC.String(), firmware.SNPONLYx86_64)
+	token, err := model.NewFirmwareToken(host.Interfaces[0].MAC.String(), firmware.SNPONLYx86_64, "b1d")
 	if assert.NoError(err) {
 		assert.Less(len(token), 128)
 		assert.Greater(len(token), 0)
 	}
 
-	build, err := model.ParseFirmwareToken(token)
+	build, bootID, err := model.ParseFirmwareToken(token)
 	if assert.NoError(err) {
 		assert.Equal(build, firmware.SNPONLYx86_64)
+		assert.Equal("b1d", bootID)
 	}
 
 	token, err = model.NewBootToken(host.UID.String(), host.Interfaces[0].MAC.String())
@@ -37,5 +40,54 @@ func TestToken(t *testing.T) {
 	if assert.NoError(err) {
 		assert.Equal(claims.ID, host.UID.String())
 		assert.Equal(claims.MAC, host.Interfaces[0].MAC.String())
+		assert.Empty(claims.BootID)
 	}
+
+	traced, err := model.NewTracedBootToken(host.UID.String(), host.Interfaces[0].MAC.String(), "b1d")
+	if assert.NoError(err) {
+		claims, err := model.ParseBootToken(traced)
+		if assert.NoError(err) {
+			assert.Equal("b1d", claims.BootID)
+		}
+	}
+
+	info, err := model.InspectBootToken(token)
+	if assert.NoError(err) {
+		assert.Len(info.ID, 48)
+		assert.Equal(host.UID.String(), info.HostID)
+		assert.Equal(host.Interfaces[0].MAC.String(), info.MAC)
+		assert.WithinDuration(time.Now(), info.IssuedAt, time.Minute)
+		assert.Equal(info.IssuedAt.Add(time.Hour), info.ExpiresAt)
+		assert.False(info.Expired)
+	}
+
+	id, err := model.BootTokenID(token)
+	if assert.NoError(err) {
+		assert.Equal(info.ID, id)
+	}
+
+	_, err = model.InspectBootToken("bad token")
+	assert.Error(err)
+}
+
+func TestTokenSecretList(t *testing.T) {
+	assert := assert.New(t)
+
+	secret := viper.GetString("provision.secret")
+	defer viper.Set("provision.secret", secret)
+
+	old := "0123456789abcdef0123456789abcdef"
+	viper.Set("provision.secret", old)
+	token, err := model.NewBootToken("1", "00:00:00:00:00:01")
+	assert.NoError(err)
+
+	// The first secret signs new tokens, all of them verify tokens
+	viper.Set("provision.secret", []string{"fedcba9876543210fedcba9876543210", old})
+	assert.Equal(model.SigningKeyID("fedcba9876543210fedcba9876543210"), model.PrimarySigningKey(model.SigningKeyProvision).ID)
+	_, err = model.ParseBootToken(token)
+	assert.NoError(err)
+
+	viper.Set("provision.secret", []string{"fedcba9876543210fedcba9876543210"})
+	_, err = model.ParseBootToken(token)
+	assert.Error(err)
 }
@@ -0,0 +1,22 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package model
+
+import "time"
+
+const (
+	TombstoneKindHost  = "host"
+	TombstoneKindImage = "image"
+)
+
+type TombstoneList []*Tombstone
+
+// Tombstone records the name of a deleted host or boot image so clients
+// syncing changes can remove it. A renamed entry leaves a tombstone for its
+// old name.
+type Tombstone struct {
+	Name      string    `json:"name"`
+	DeletedAt time.Time `json:"deleted_at"`
+}
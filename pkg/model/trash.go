@@ -0,0 +1,60 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package model
+
+import (
+	"fmt"
+	"time"
+)
+
+type TrashedHostList []*TrashedHost
+
+// TrashedHost is a deleted host. It is no longer served and can be restored
+// until it is purged from the trash.
+type TrashedHost struct {
+	Name      string    `json:"name"`
+	DeletedAt time.Time `json:"deleted_at"`
+	Host      *Host     `json:"host"`
+}
+
+// Collisions returns a description of every name, MAC or IP address of host
+// that is also used by a host in the trash. Restoring those hosts would
+// conflict with host.
+func (tl TrashedHostList) Collisions(host *Host) []string {
+	collisions := make([]string, 0)
+	for _, t := range tl {
+		if t.Name == host.Name {
+			collisions = append(collisions, fmt.Sprintf("name %s of deleted host %s", host.Name, t.Name))
+		}
+
+		for _, nic := range host.nics() {
+			for _, tnic := range t.Host.nics() {
+				if len(nic.MAC) > 0 && nic.MAC.String() == tnic.MAC.String() {
+					collisions = append(collisions, fmt.Sprintf("mac %s of deleted host %s", nic.MAC, t.Name))
+				}
+				for _, addr := range nic.AllAddresses() {
+					for _, taddr := range tnic.AllAddresses() {
+						if addr.IP.Addr() == taddr.IP.Addr() {
+							collisions = append(collisions, fmt.Sprintf("ip %s of deleted host %s", addr.IP.Addr(), t.Name))
+						}
+					}
+				}
+			}
+		}
+	}
+
+	return collisions
+}
+
+// nics returns the network and bond interfaces of the host
+func (h *Host) nics() []*NetInterface {
+	nics := make([]*NetInterface, 0, len(h.Interfaces)+len(h.Bonds))
+	nics = append(nics, h.Interfaces...)
+	for _, b := range h.Bonds {
+		nics = append(nics, &b.NetInterface)
+	}
+
+	return nics
+}
@@ -0,0 +1,215 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package netbox
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// pageSize is the number of objects requested per page, NetBox caps it
+	// with MAX_PAGE_SIZE
+	pageSize = 1000
+
+	// batchSize is the number of device ids filtered on per request for
+	// interfaces and IP addresses, keeping the URL short
+	batchSize = 100
+)
+
+// RESTClient is a client for the NetBox REST API
+type RESTClient struct {
+	url        string
+	token      string
+	httpClient *http.Client
+}
+
+// NestedObject is a related object as NetBox nests it in another
+type NestedObject struct {
+	ID   int    `json:"id"`
+	Name string `json:"name"`
+	Slug string `json:"slug"`
+}
+
+// Tag is a NetBox tag
+type Tag struct {
+	Name string `json:"name"`
+	Slug string `json:"slug"`
+}
+
+// Device is a NetBox DCIM device
+type Device struct {
+	ID           int            `json:"id"`
+	Name         string         `json:"name"`
+	Serial       string         `json:"serial"`
+	Site         *NestedObject  `json:"site"`
+	Rack         *NestedObject  `json:"rack"`
+	Tags         []Tag          `json:"tags"`
+	CustomFields map[string]any `json:"custom_fields"`
+	LastUpdated  time.Time      `json:"last_updated"`
+}
+
+// Interface is a NetBox DCIM interface
+type Interface struct {
+	ID          int          `json:"id"`
+	Device      NestedObject `json:"device"`
+	Name        string       `json:"name"`
+	MACAddress  string       `json:"mac_address"`
+	MTU         int          `json:"mtu"`
+	MgmtOnly    bool         `json:"mgmt_only"`
+	LastUpdated time.Time    `json:"last_updated"`
+}
+
+// IPAddress is a NetBox IPAM address with the object it is assigned to
+type IPAddress struct {
+	ID                 int       `json:"id"`
+	Address            string    `json:"address"`
+	DNSName            string    `json:"dns_name"`
+	AssignedObjectType string    `json:"assigned_object_type"`
+	AssignedObjectID   int       `json:"assigned_object_id"`
+	LastUpdated        time.Time `json:"last_updated"`
+}
+
+// page is a page of a NetBox list endpoint
+type page[T any] struct {
+	Count   int    `json:"count"`
+	Next    string `json:"next"`
+	Results []T    `json:"results"`
+}
+
+// NewRESTClient returns a client for the NetBox at url authenticating with
+// the API token
+func NewRESTClient(url, token string, insecure bool) *RESTClient {
+	return &RESTClient{
+		url:   strings.TrimRight(url, "/"),
+		token: token,
+		httpClient: &http.Client{
+			Timeout: 5 * time.Minute,
+			Transport: &http.Transport{
+				TLSClientConfig: &tls.Config{InsecureSkipVerify: insecure},
+			},
+		},
+	}
+}
+
+// Devices returns the devices matching the NetBox filters of query, such as
+// site=hpc or role=compute
+func (c *RESTClient) Devices(ctx context.Context, query url.Values) ([]Device, error) {
+	return list[Device](ctx, c, "/api/dcim/devices/", query)
+}
+
+// Interfaces returns the interfaces of the devices
+func (c *RESTClient) Interfaces(ctx context.Context, deviceIDs []int) ([]Interface, error) {
+	return listByDevice[Interface](ctx, c, "/api/dcim/interfaces/", deviceIDs)
+}
+
+// IPAddresses returns the IP addresses assigned to interfaces of the devices
+func (c *RESTClient) IPAddresses(ctx context.Context, deviceIDs []int) ([]IPAddress, error) {
+	return listByDevice[IPAddress](ctx, c, "/api/ipam/ip-addresses/", deviceIDs)
+}
+
+// UpdateDevice changes fields of the device with id
+func (c *RESTClient) UpdateDevice(ctx context.Context, id int, fields map[string]any) error {
+	return c.patch(ctx, "/api/dcim/devices/"+strconv.Itoa(id)+"/", fields)
+}
+
+// UpdateInterface changes fields of the interface with id
+func (c *RESTClient) UpdateInterface(ctx context.Context, id int, fields map[string]any) error {
+	return c.patch(ctx, "/api/dcim/interfaces/"+strconv.Itoa(id)+"/", fields)
+}
+
+// listByDevice returns the objects of path filtered on the device ids,
+// requesting batchSize devices at a time
+func listByDevice[T any](ctx context.Context, c *RESTClient, path string, deviceIDs []int) ([]T, error) {
+	objects := make([]T, 0)
+	for start := 0; start < len(deviceIDs); start += batchSize {
+		query := url.Values{}
+		for _, id := range deviceIDs[start:min(start+batchSize, len(deviceIDs))] {
+			query.Add("device_id", strconv.Itoa(id))
+		}
+
+		batch, err := list[T](ctx, c, path, query)
+		if err != nil {
+			return nil, err
+		}
+		objects = append(objects, batch...)
+	}
+
+	return objects, nil
+}
+
+// list returns every object of the list endpoint at path, following the next
+// link of each page
+func list[T any](ctx context.Context, c *RESTClient, path string, query url.Values) ([]T, error) {
+	q := url.Values{}
+	for k, v := range query {
+		q[k] = v
+	}
+	if q.Get("limit") == "" {
+		q.Set("limit", strconv.Itoa(pageSize))
+	}
+
+	objects := make([]T, 0)
+	next := c.url + path + "?" + q.Encode()
+	for next != "" {
+		var p page[T]
+		if err := c.do(ctx, http.MethodGet, next, nil, &p); err != nil {
+			return nil, err
+		}
+
+		objects = append(objects, p.Results...)
+		next = p.Next
+	}
+
+	return objects, nil
+}
+
+func (c *RESTClient) patch(ctx context.Context, path string, fields map[string]any) error {
+	data, err := json.Marshal(fields)
+	if err != nil {
+		return err
+	}
+
+	return c.do(ctx, http.MethodPatch, c.url+path, bytes.NewReader(data), nil)
+}
+
+// do sends a request to NetBox and decodes the JSON response into out
+func (c *RESTClient) do(ctx context.Context, method, u string, body io.Reader, out any) error {
+	req, err := http.NewRequestWithContext(ctx, method, u, body)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("Authorization", "Token "+c.token)
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	res, err := c.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		detail, _ := io.ReadAll(io.LimitReader(res.Body, 1024))
+		return fmt.Errorf("%w: %s %s returned %d: %s", ErrBadHttpStatus, method, req.URL.Path, res.StatusCode, strings.TrimSpace(string(detail)))
+	}
+
+	if out == nil {
+		return nil
+	}
+
+	return json.NewDecoder(res.Body).Decode(out)
+}
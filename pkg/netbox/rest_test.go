@@ -0,0 +1,95 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package netbox
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRESTClient(t *testing.T) {
+	var patched map[string]any
+	var srv *httptest.Server
+	srv = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Token secret" {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+
+		switch {
+		case r.Method == http.MethodPatch && r.URL.Path == "/api/dcim/devices/7/":
+			body, _ := io.ReadAll(r.Body)
+			json.Unmarshal(body, &patched)
+			fmt.Fprint(w, `{"id": 7}`)
+		case r.URL.Path == "/api/dcim/devices/":
+			// Two devices per page whatever the limit
+			offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
+			assert.Equal(t, "compute", r.URL.Query().Get("role"))
+			next := ""
+			if offset == 0 {
+				next = srv.URL + "/api/dcim/devices/?role=compute&limit=2&offset=2"
+			}
+			fmt.Fprintf(w, `{"count": 3, "next": %q, "results": [{"id": %d, "name": "cpn-%02d"}`, next, offset+1, offset+1)
+			if offset == 0 {
+				fmt.Fprint(w, `, {"id": 2, "name": "cpn-02", "rack": {"id": 1, "name": "a01"}, "custom_fields": {"slot": 4}}`)
+			}
+			fmt.Fprint(w, `]}`)
+		case r.URL.Path == "/api/dcim/interfaces/":
+			ids := r.URL.Query()["device_id"]
+			results := make([]map[string]any, 0)
+			for _, id := range ids {
+				n, _ := strconv.Atoi(id)
+				results = append(results, map[string]any{"id": n * 10, "name": "eno1", "device": map[string]any{"id": n}, "mac_address": nil})
+			}
+			json.NewEncoder(w).Encode(map[string]any{"count": len(results), "next": nil, "results": results})
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			fmt.Fprint(w, `{"detail": "Not found."}`)
+		}
+	}))
+	defer srv.Close()
+
+	c := NewRESTClient(srv.URL+"/", "secret", false)
+	ctx := context.Background()
+
+	devices, err := c.Devices(ctx, url.Values{"role": {"compute"}})
+	require.NoError(t, err)
+	if assert.Len(t, devices, 3) {
+		assert.Equal(t, "cpn-03", devices[2].Name)
+		assert.Equal(t, "a01", devices[1].Rack.Name)
+		assert.Equal(t, float64(4), devices[1].CustomFields["slot"])
+	}
+
+	ids := make([]int, 0)
+	for i := 1; i <= 250; i++ {
+		ids = append(ids, i)
+	}
+	interfaces, err := c.Interfaces(ctx, ids)
+	require.NoError(t, err)
+	if assert.Len(t, interfaces, 250) {
+		assert.Equal(t, 250, interfaces[249].Device.ID)
+		assert.Empty(t, interfaces[0].MACAddress)
+	}
+
+	require.NoError(t, c.UpdateDevice(ctx, 7, map[string]any{"serial": "ABC123"}))
+	assert.Equal(t, map[string]any{"serial": "ABC123"}, patched)
+
+	err = c.UpdateInterface(ctx, 8, map[string]any{"mac_address": "de:ad:be:ef:00:01"})
+	assert.ErrorIs(t, err, ErrBadHttpStatus)
+	assert.ErrorContains(t, err, "returned 404")
+
+	_, err = NewRESTClient(srv.URL, "wrong", false).Devices(ctx, nil)
+	assert.ErrorIs(t, err, ErrBadHttpStatus)
+}
@@ -133,7 +133,10 @@ func (ns *NodeSet) toStringList() []string {
 		if items[j].rangeSet != nil {
 			jlen = items[j].rangeSet.Len()
 		}
-		return ilen > jlen
+		if ilen != jlen {
+			return ilen > jlen
+		}
+		return items[i].format < items[j].format
 	})
 
 	for _, pattern := range items {
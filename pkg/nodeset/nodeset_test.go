@@ -102,3 +102,73 @@ func TestNodeSetJSON(t *testing.T) {
 		assert.Equal(t, l2, l1)
 	}
 }
+
+func TestNodeSetExpandFold(t *testing.T) {
+	names, err := Expand("cpn-[098-101]")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"cpn-098", "cpn-099", "cpn-100", "cpn-101"}, names)
+
+	names, err = Expand("cpn-[8-11]")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"cpn-8", "cpn-9", "cpn-10", "cpn-11"}, names)
+
+	folded, err := Fold([]string{"cpn-d13-02", "cpn-d13-01", "cpn-d14-01", "cpn-d14-02", "login"})
+	assert.NoError(t, err)
+	assert.Equal(t, "cpn-d[13-14]-[01-02],login", folded)
+
+	folded, err = Fold([]string{"cpn-0998", "cpn-0999", "cpn-1000"})
+	assert.NoError(t, err)
+	assert.Equal(t, "cpn-[0998-1000]", folded)
+
+	folded, err = Fold(nil)
+	assert.NoError(t, err)
+	assert.Equal(t, "", folded)
+
+	count, err := Count("cpn-k[08-09]-[02-24/2]-[01-02]")
+	assert.NoError(t, err)
+	assert.Equal(t, 48, count)
+
+	_, err = Expand("cpn-[01-02")
+	assert.ErrorIs(t, err, ErrParseNodeSet)
+}
+
+func TestNodeSetOperations(t *testing.T) {
+	tests := []struct {
+		a, b         string
+		union        string
+		intersection string
+		difference   string
+	}{
+		// Overlapping ranges
+		{"cpn-[01-10]", "cpn-[05-15]", "cpn-[01-15]", "cpn-[05-10]", "cpn-[01-04]"},
+		// Padding widths are kept
+		{"cpn-[098-101]", "cpn-[100-102]", "cpn-[098-102]", "cpn-[100-101]", "cpn-[098-099]"},
+		{"cpn-[8-11]", "cpn-[10-12]", "cpn-[8-12]", "cpn-[10-11]", "cpn-[8-9]"},
+		// Multiple bracket groups
+		{"cpn-d[13-14]-[01-04]", "cpn-d14-[03-06]", "cpn-d14-[01-06],cpn-d13-[01-04]", "cpn-d14-[03-04]", "cpn-d13-[01-04],cpn-d14-[01-02]"},
+		// Names without ranges
+		{"login,cpn-[01-02]", "login,dtn", "cpn-[01-02],dtn,login", "login", "cpn-[01-02]"},
+		// Disjoint sets
+		{"cpn-[01-02]", "gpu-[01-02]", "cpn-[01-02],gpu-[01-02]", "", "cpn-[01-02]"},
+		{"cpn-[01-02]", "", "cpn-[01-02]", "", "cpn-[01-02]"},
+	}
+
+	for _, test := range tests {
+		a, err := NewNodeSet(test.a)
+		assert.NoError(t, err)
+		b, err := NewNodeSet(test.b)
+		assert.NoError(t, err)
+
+		assert.Equal(t, test.union, a.Union(b).String(), "%s | %s", test.a, test.b)
+		assert.Equal(t, test.intersection, a.Intersection(b).String(), "%s & %s", test.a, test.b)
+		assert.Equal(t, test.difference, a.Difference(b).String(), "%s - %s", test.a, test.b)
+	}
+
+	// The operands are not changed
+	a, _ := NewNodeSet("cpn-[01-04]")
+	b, _ := NewNodeSet("cpn-[03-06]")
+	a.Union(b)
+	a.Difference(b)
+	assert.Equal(t, "cpn-[01-04]", a.String())
+	assert.Equal(t, "cpn-[03-06]", b.String())
+}
@@ -0,0 +1,110 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package nodeset
+
+import (
+	"strings"
+)
+
+// Expand returns the node names of the nodeset nodestr
+func Expand(nodestr string) ([]string, error) {
+	ns, err := NewNodeSet(nodestr)
+	if err != nil {
+		return nil, err
+	}
+
+	return ns.Iterator().StringSlice(), nil
+}
+
+// Fold returns the node names collapsed into a nodeset, such as cpn-[01-04]
+func Fold(names []string) (string, error) {
+	ns := EmptyNodeSet()
+	if len(names) == 0 {
+		return "", nil
+	}
+
+	if err := ns.Add(strings.Join(names, ",")); err != nil {
+		return "", err
+	}
+
+	return ns.String(), nil
+}
+
+// Count returns the number of nodes in the nodeset nodestr
+func Count(nodestr string) (int, error) {
+	ns, err := NewNodeSet(nodestr)
+	if err != nil {
+		return 0, err
+	}
+
+	return ns.Len(), nil
+}
+
+// Union returns a new NodeSet with the nodes of ns and other
+func (ns *NodeSet) Union(other *NodeSet) *NodeSet {
+	names := ns.Iterator().StringSlice()
+	names = append(names, other.Iterator().StringSlice()...)
+
+	return fromNames(names)
+}
+
+// Intersection returns a new NodeSet with the nodes of ns also in other.
+// Nodes match by name, cpn-1 and cpn-01 are different nodes
+func (ns *NodeSet) Intersection(other *NodeSet) *NodeSet {
+	in := other.names()
+	names := make([]string, 0)
+	for _, name := range ns.Iterator().StringSlice() {
+		if in[name] {
+			names = append(names, name)
+		}
+	}
+
+	return fromNames(names)
+}
+
+// Difference returns a new NodeSet with the nodes of ns not in other. Nodes
+// match by name, cpn-1 and cpn-01 are different nodes
+func (ns *NodeSet) Difference(other *NodeSet) *NodeSet {
+	in := other.names()
+	names := make([]string, 0)
+	for _, name := range ns.Iterator().StringSlice() {
+		if !in[name] {
+			names = append(names, name)
+		}
+	}
+
+	return fromNames(names)
+}
+
+// names returns the set of node names of ns
+func (ns *NodeSet) names() map[string]bool {
+	names := make(map[string]bool, ns.Len())
+	for _, name := range ns.Iterator().StringSlice() {
+		names[name] = true
+	}
+
+	return names
+}
+
+// fromNames returns the NodeSet of names expanded from a NodeSet. Such names
+// always parse, empty names are skipped
+func fromNames(names []string) *NodeSet {
+	ns := EmptyNodeSet()
+
+	nonEmpty := make([]string, 0, len(names))
+	for _, name := range names {
+		if name != "" {
+			nonEmpty = append(nonEmpty, name)
+		}
+	}
+	if len(nonEmpty) == 0 {
+		return ns
+	}
+
+	// The names of a NodeSet contain no brackets or commas, so they parse
+	_ = ns.Add(strings.Join(nonEmpty, ","))
+
+	return ns
+}
@@ -0,0 +1,75 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package storetest
+
+import (
+	"database/sql"
+	"os"
+	"testing"
+
+	_ "github.com/lib/pq"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"github.com/ubccr/grendel/internal/store"
+	"github.com/ubccr/grendel/internal/store/sqlstore"
+)
+
+// postgresDSN is the database the postgres tests run against. Its public
+// schema is dropped before each test
+var postgresDSN = os.Getenv("GRENDEL_TEST_POSTGRES_DSN")
+
+type PostgresStoreTestSuite struct {
+	StoreTestSuite
+}
+
+func TestPostgresStoreTestSuite(t *testing.T) {
+	if postgresDSN == "" {
+		t.Skip("GRENDEL_TEST_POSTGRES_DSN is not set")
+	}
+
+	store.Log.Logger.SetLevel(logrus.ErrorLevel)
+	suite.Run(t, new(PostgresStoreTestSuite))
+}
+
+// SetFile is a no-op, the database is always postgresDSN
+func (s *PostgresStoreTestSuite) SetFile(file string) {}
+
+func (s *PostgresStoreTestSuite) SetupTest() {
+	resetPostgres(s.T())
+
+	ds, err := sqlstore.New(postgresDSN, sqlstore.Config{Driver: sqlstore.PostgresDriver})
+	s.Require().NoError(err)
+	s.SetStore(ds)
+}
+
+func (s *PostgresStoreTestSuite) TearDownTest() {
+	s.Assert().NoError(s.db.Close())
+}
+
+// resetPostgres empties the database of postgresDSN
+func resetPostgres(t *testing.T) {
+	db, err := sql.Open(sqlstore.PostgresDriver, postgresDSN)
+	require.NoError(t, err)
+	defer db.Close()
+
+	_, err = db.Exec("drop schema public cascade; create schema public")
+	require.NoError(t, err)
+}
+
+func TestPostgresStoreMigrations(t *testing.T) {
+	if postgresDSN == "" {
+		t.Skip("GRENDEL_TEST_POSTGRES_DSN is not set")
+	}
+
+	resetPostgres(t)
+	for range 3 {
+		db, err := sqlstore.New(postgresDSN, sqlstore.Config{Driver: sqlstore.PostgresDriver})
+		if assert.NoError(t, err) {
+			assert.NoError(t, db.Close())
+		}
+	}
+}
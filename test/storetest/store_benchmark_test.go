@@ -6,8 +6,7 @@ import (
 	"testing"
 )
 
-// TODO: benchmark different batch sizes
-var batchSizes []int = []int{5000}
+var batchSizes []int = []int{1000, 10000}
 
 type BenchTestSuite interface {
 	SetFile(string)
@@ -21,6 +20,8 @@ type BenchTestSuite interface {
 	BenchmarkRandomWrites(size int, b *testing.B)
 	BenchmarkResolveIP(size int, b *testing.B)
 	BenchmarkReverseResolve(size int, b *testing.B)
+	BenchmarkFindMAC(size int, b *testing.B)
+	BenchmarkStoreDNSRecords(size int, b *testing.B)
 }
 
 func tempfile(b *testing.B) string {
@@ -78,6 +79,20 @@ func Run(bt BenchTestSuite, b *testing.B) {
 			bt.SetupTest()
 			bt.BenchmarkReverseResolve(size, b)
 		})
+
+		b.Run(fmt.Sprintf("test=FindMAC/size=%d", size), func(b *testing.B) {
+			file := tempfile(b)
+			bt.SetFile(file)
+			bt.SetupTest()
+			bt.BenchmarkFindMAC(size, b)
+		})
+
+		b.Run(fmt.Sprintf("test=StoreDNSRecords/size=%d", size), func(b *testing.B) {
+			file := tempfile(b)
+			bt.SetFile(file)
+			bt.SetupTest()
+			bt.BenchmarkStoreDNSRecords(size, b)
+		})
 	}
 }
 
@@ -85,6 +100,9 @@ func BenchmarkStores(b *testing.B) {
 	tests := map[string]BenchTestSuite{
 		"Sqlstore": new(SqlStoreTestSuite),
 	}
+	if postgresDSN != "" {
+		tests["Postgres"] = new(PostgresStoreTestSuite)
+	}
 
 	for name, ts := range tests {
 		b.Run("store="+name, func(b *testing.B) {
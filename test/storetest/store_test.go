@@ -5,12 +5,16 @@
 package storetest
 
 import (
+	"encoding/json"
 	"errors"
 	"fmt"
 	"math/rand"
 	"net/netip"
+	"slices"
+	"strconv"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/suite"
 	"github.com/ubccr/grendel/internal/store"
@@ -171,66 +175,1191 @@ func (s *StoreTestSuite) TestResolveIPv4() {
 	}
 }
 
+func (s *StoreTestSuite) TestResolveStates() {
+	host := tests.HostFactory.MustCreate().(*model.Host)
+	host.Interfaces[0].FQDN = "test1.example.com"
+
+	err := s.db.StoreHost(host)
+	s.Assert().NoError(err)
+	stored, err := s.db.LoadHostFromID(host.UID.String())
+	s.Require().NoError(err)
+
+	// Only the hosts in the given states resolve
+	testIPs, err := s.db.ResolveIPv4("test1.example.com", stored.State)
+	if s.Assert().NoError(err) {
+		s.Assert().Len(testIPs, 1)
+	}
+	testIPs, err = s.db.ResolveIPv4("test1.example.com", model.HostStateRetired)
+	if s.Assert().NoError(err) {
+		s.Assert().Empty(testIPs)
+	}
+
+	names, err := s.db.ReverseResolve(host.Interfaces[0].AddrString(), stored.State)
+	if s.Assert().NoError(err) {
+		s.Assert().Equal([]string{"test1.example.com"}, names)
+	}
+	names, err = s.db.ReverseResolve(host.Interfaces[0].AddrString(), model.HostStateRetired)
+	if s.Assert().NoError(err) {
+		s.Assert().Empty(names)
+	}
+}
+
 func (s *StoreTestSuite) TestResolveIPv4ExactMatch() {
 	host := tests.HostFactory.MustCreate().(*model.Host)
 	host.Interfaces[0].FQDN = "test1.example.com"
 	host.Interfaces[1].FQDN = "xxx-test1.example.com"
 
-	err := s.db.StoreHost(host)
+	err := s.db.StoreHost(host)
+	s.Assert().NoError(err)
+
+	testIPs, err := s.db.ResolveIPv4("test1.example.com")
+	if s.Assert().NoError(err) {
+		if s.Assert().Equal(1, len(testIPs)) {
+			s.Assert().Equal(host.Interfaces[0].AddrString(), testIPs[0].String())
+		}
+	}
+
+	names, err := s.db.ReverseResolve(host.Interfaces[0].AddrString())
+	if s.Assert().NoError(err) {
+		if s.Assert().Equal(1, len(names)) {
+			s.Assert().Equal("test1.example.com", names[0])
+		}
+	}
+}
+
+func (s *StoreTestSuite) TestReverseResolveIPv4() {
+	hostA := tests.HostFactory.MustCreate().(*model.Host)
+	hostA.Interfaces[0].IP = netip.MustParsePrefix("10.1.1.17/24")
+
+	hostB := tests.HostFactory.MustCreate().(*model.Host)
+	hostB.Interfaces[0].IP = netip.MustParsePrefix("10.1.1.174/24")
+
+	err := s.db.StoreHost(hostA)
+	s.Assert().NoError(err)
+
+	err = s.db.StoreHost(hostB)
+	s.Assert().NoError(err)
+
+	testNames, err := s.db.ReverseResolve(hostA.Interfaces[0].AddrString())
+	if s.Assert().NoError(err) {
+		if s.Assert().Equal(1, len(testNames)) {
+			s.Assert().Equal(hostA.Interfaces[0].FQDN, testNames[0])
+		}
+	}
+}
+
+func (s *StoreTestSuite) TestSecondaryAddresses() {
+	host := tests.HostFactory.MustCreate().(*model.Host)
+	host.Interfaces[0].FQDN = "svc1.example.com"
+	host.Interfaces[0].IP = netip.MustParsePrefix("10.1.2.10/24")
+	host.Interfaces[0].Addresses = []model.NetAddress{
+		{IP: netip.MustParsePrefix("10.1.2.100/24"), FQDN: "vip.example.com,VIP-alias.example.com"},
+		{IP: netip.MustParsePrefix("fd00::10/64"), FQDN: "svc1.example.com"},
+	}
+
+	err := s.db.StoreHost(host)
+	s.Assert().NoError(err)
+
+	testHost, err := s.db.LoadHostFromName(host.Name)
+	if s.Assert().NoError(err) {
+		s.Assert().Equal(host.Interfaces[0].Addresses, testHost.Interfaces[0].Addresses)
+	}
+
+	for _, nm := range []string{"vip.example.com", "vip-alias.example.com"} {
+		testIPs, err := s.db.ResolveIPv4(nm)
+		if s.Assert().NoError(err) && s.Assert().Equal(1, len(testIPs)) {
+			s.Assert().Equal("10.1.2.100", testIPs[0].String())
+		}
+	}
+
+	testIPs, err := s.db.ResolveIPv4("svc1.example.com")
+	if s.Assert().NoError(err) && s.Assert().Equal(1, len(testIPs)) {
+		s.Assert().Equal("10.1.2.10", testIPs[0].String())
+	}
+
+	testIPs, err = s.db.ResolveIPv6("svc1.example.com")
+	if s.Assert().NoError(err) && s.Assert().Equal(1, len(testIPs)) {
+		s.Assert().Equal("fd00::10", testIPs[0].String())
+	}
+
+	names, err := s.db.ReverseResolve("10.1.2.100")
+	if s.Assert().NoError(err) && s.Assert().Equal(1, len(names)) {
+		s.Assert().Equal("vip.example.com", names[0])
+	}
+
+	err = s.db.StoreDNSRecords(model.RecordList{
+		{Name: "other.example.com", Type: model.RecordTypeA, Value: "10.1.2.100", PTR: true},
+	})
+	s.Assert().ErrorIs(err, store.ErrConflict)
+
+	host.Interfaces[0].Addresses = host.Interfaces[0].Addresses[1:]
+	err = s.db.StoreHost(host)
+	s.Assert().NoError(err)
+
+	testIPs, err = s.db.ResolveIPv4("vip.example.com")
+	if s.Assert().NoError(err) {
+		s.Assert().Empty(testIPs)
+	}
+
+	err = s.db.StoreDNSRecords(model.RecordList{
+		{Name: "vip.example.com", Type: model.RecordTypeA, Value: "10.1.2.200"},
+	})
+	s.Assert().NoError(err)
+}
+
+func (s *StoreTestSuite) TestSMBIOSUUID() {
+	hostA := tests.HostFactory.MustCreate().(*model.Host)
+	hostA.SMBIOSUUID = "4C4C4544-0042-3510-8052-B4C04F4B4B32"
+	hostB := tests.HostFactory.MustCreate().(*model.Host)
+
+	err := s.db.StoreHosts(model.HostList{hostA, hostB})
+	s.Assert().NoError(err)
+
+	testHost, err := s.db.LoadHostFromSMBIOSUUID("4c4c4544-0042-3510-8052-b4c04f4b4b32")
+	if s.Assert().NoError(err) {
+		s.Assert().Equal(hostA.Name, testHost.Name)
+		s.Assert().Equal("4c4c4544-0042-3510-8052-b4c04f4b4b32", testHost.SMBIOSUUID)
+	}
+
+	_, err = s.db.LoadHostFromSMBIOSUUID("00000000-0000-0000-0000-000000000001")
+	s.Assert().ErrorIs(err, store.ErrNotFound)
+
+	_, err = s.db.LoadHostFromSMBIOSUUID("bogus")
+	s.Assert().ErrorIs(err, store.ErrInvalidData)
+
+	hostB.SMBIOSUUID = hostA.SMBIOSUUID
+	err = s.db.StoreHost(hostB)
+	s.Assert().ErrorIs(err, store.ErrConflict)
+
+	hostB.SMBIOSUUID = "bogus"
+	err = s.db.StoreHost(hostB)
+	s.Assert().ErrorIs(err, store.ErrInvalidData)
+}
+
+func (s *StoreTestSuite) TestHostInventory() {
+	host := tests.HostFactory.MustCreate().(*model.Host)
+	err := s.db.StoreHost(host)
+	s.Assert().NoError(err)
+
+	testHost, err := s.db.LoadHostFromName(host.Name)
+	if s.Assert().NoError(err) {
+		s.Assert().Nil(testHost.Inventory)
+	}
+	revision := testHost.Revision
+
+	inv := &model.Inventory{
+		BIOSVersion: "2.19.0",
+		BMCFirmware: "7.00.00.171",
+		NICFirmware: map[string]string{"NIC.Slot.1": "22.31.6"},
+		CapturedAt:  time.Date(2026, 10, 15, 7, 0, 0, 0, time.UTC),
+	}
+	err = s.db.StoreHostInventory(host.Name, inv)
+	s.Assert().NoError(err)
+
+	testHost, err = s.db.LoadHostFromName(host.Name)
+	if s.Assert().NoError(err) {
+		s.Assert().Equal(inv, testHost.Inventory)
+		s.Assert().Equal(revision, testHost.Revision)
+	}
+
+	// Storing the host without an inventory keeps the reported one
+	testHost.Inventory = nil
+	err = s.db.StoreHost(testHost)
+	s.Assert().NoError(err)
+
+	testHost, err = s.db.LoadHostFromName(host.Name)
+	if s.Assert().NoError(err) {
+		s.Assert().Equal(inv, testHost.Inventory)
+	}
+
+	err = s.db.StoreHostInventory("missing", inv)
+	s.Assert().ErrorIs(err, store.ErrNotFound)
+}
+
+func (s *StoreTestSuite) TestHostHardware() {
+	host := tests.HostFactory.MustCreate().(*model.Host)
+	err := s.db.StoreHost(host)
+	s.Assert().NoError(err)
+
+	testHost, err := s.db.LoadHostFromName(host.Name)
+	if s.Assert().NoError(err) {
+		s.Assert().Nil(testHost.Hardware)
+	}
+	revision := testHost.Revision
+
+	hw := &model.Hardware{
+		CPUModel:    "Intel(R) Xeon(R) Gold 6326 CPU @ 2.90GHz",
+		CPUCount:    2,
+		CoreCount:   32,
+		MemoryMiB:   65536,
+		DIMMs:       []model.HardwareDIMM{{Slot: "A1", SizeMiB: 32768}, {Slot: "B1", SizeMiB: 32768}},
+		Disks:       []model.HardwareDisk{{Name: "Disk.Bay.0", Model: "MZ7L3480", Serial: "S6KM", SizeBytes: 480103981056}},
+		NICs:        []model.HardwareNIC{{Name: "NIC.Embedded.1-1-1", MAC: "b0:7b:25:de:9a:10"}},
+		Missing:     []string{model.HardwareResourceStorage},
+		CollectedAt: time.Date(2026, 10, 15, 11, 0, 0, 0, time.UTC),
+	}
+	err = s.db.StoreHostHardware(host.Name, hw)
+	s.Assert().NoError(err)
+
+	testHost, err = s.db.LoadHostFromName(host.Name)
+	if s.Assert().NoError(err) {
+		s.Assert().Equal(hw, testHost.Hardware)
+		s.Assert().Equal(revision, testHost.Revision)
+	}
+
+	// Storing the host without hardware keeps the collected hardware
+	testHost.Hardware = nil
+	err = s.db.StoreHost(testHost)
+	s.Assert().NoError(err)
+
+	testHost, err = s.db.LoadHostFromName(host.Name)
+	if s.Assert().NoError(err) {
+		s.Assert().Equal(hw, testHost.Hardware)
+	}
+
+	err = s.db.StoreHostHardware("missing", hw)
+	s.Assert().ErrorIs(err, store.ErrNotFound)
+}
+
+func (s *StoreTestSuite) TestChanges() {
+	feed, err := s.db.Changes(0, 100)
+	if s.Assert().NoError(err) {
+		s.Assert().Len(feed.Changes, 0)
+		s.Assert().False(feed.Truncated)
+	}
+	start := feed.LatestSeq
+
+	image := tests.BootImageFactory.MustCreate().(*model.BootImage)
+	err = s.db.StoreBootImage(image)
+	s.Assert().NoError(err)
+	host := tests.HostFactory.MustCreate().(*model.Host)
+	err = s.db.StoreHost(host)
+	s.Assert().NoError(err)
+
+	ns, err := nodeset.NewNodeSet(host.Name)
+	s.Assert().NoError(err)
+	err = s.db.ProvisionHosts(ns, true)
+	s.Assert().NoError(err)
+	err = s.db.SetBootImage(ns, image.Name)
+	s.Assert().NoError(err)
+	err = s.db.TagHosts(ns, []string{"ib"})
+	s.Assert().NoError(err)
+
+	record := &model.Record{Name: "www.example.com", Type: "CNAME", Value: "web.example.com"}
+	err = s.db.StoreDNSRecords(model.RecordList{record})
+	s.Assert().NoError(err)
+	err = s.db.DeleteHosts(ns)
+	s.Assert().NoError(err)
+
+	feed, err = s.db.Changes(start, 100)
+	s.Assert().NoError(err)
+	if !s.Assert().Len(feed.Changes, 7) {
+		return
+	}
+	for i, c := range feed.Changes {
+		s.Assert().Equal(start+int64(i)+1, c.Seq)
+	}
+	s.Assert().Equal(feed.Changes[6].Seq, feed.LatestSeq)
+
+	expected := []struct{ kind, name, op string }{
+		{model.ChangeKindImage, image.Name, model.ChangeOpCreate},
+		{model.ChangeKindHost, host.Name, model.ChangeOpCreate},
+		{model.ChangeKindHost, host.Name, model.ChangeOpUpdate},
+		{model.ChangeKindHost, host.Name, model.ChangeOpUpdate},
+		{model.ChangeKindHost, host.Name, model.ChangeOpUpdate},
+		{model.ChangeKindDNSRecord, record.Key(), model.ChangeOpCreate},
+		{model.ChangeKindHost, host.Name, model.ChangeOpDelete},
+	}
+	for i, e := range expected {
+		s.Assert().Equal(e.kind, feed.Changes[i].Kind, i)
+		s.Assert().Equal(e.name, feed.Changes[i].Name, i)
+		s.Assert().Equal(e.op, feed.Changes[i].Op, i)
+	}
+	s.Assert().Equal(map[string]model.FieldChange{
+		"provision": {Old: "false", New: "true"},
+		"state":     {Old: model.HostStateNew, New: model.HostStateReady},
+	}, feed.Changes[2].Diff)
+	s.Assert().Equal(map[string]model.FieldChange{"boot_image": {Old: "", New: image.Name}}, feed.Changes[3].Diff)
+	s.Assert().Nil(feed.Changes[4].Diff)
+
+	// Paging continues from the last sequence number
+	feed, err = s.db.Changes(start+5, 1)
+	if s.Assert().NoError(err) && s.Assert().Len(feed.Changes, 1) {
+		s.Assert().Equal(start+6, feed.Changes[0].Seq)
+	}
+
+	// Consumers behind the purged entries are told to resync, sequence
+	// numbers are not reused
+	n, err := s.db.PurgeChanges(time.Now().Add(time.Minute))
+	s.Assert().NoError(err)
+	s.Assert().Equal(7, n)
+
+	feed, err = s.db.Changes(start+3, 100)
+	if s.Assert().NoError(err) {
+		s.Assert().True(feed.Truncated)
+		s.Assert().Equal(start+8, feed.OldestSeq)
+	}
+	feed, err = s.db.Changes(start+7, 100)
+	if s.Assert().NoError(err) {
+		s.Assert().False(feed.Truncated)
+		s.Assert().Len(feed.Changes, 0)
+	}
+
+	err = s.db.DeleteBootImages([]string{image.Name})
+	s.Assert().NoError(err)
+	feed, err = s.db.Changes(start+7, 100)
+	if s.Assert().NoError(err) && s.Assert().Len(feed.Changes, 1) {
+		s.Assert().Equal(start+8, feed.Changes[0].Seq)
+		s.Assert().Equal(model.ChangeOpDelete, feed.Changes[0].Op)
+	}
+}
+
+func (s *StoreTestSuite) TestScheduledActions() {
+	now := time.Now()
+	action := &model.ScheduledAction{
+		Action:    model.ScheduleActionProvision,
+		Nodeset:   "cpn-[01-02]",
+		Arg:       "true",
+		RunAt:     now.Add(-time.Minute),
+		CreatedBy: "admin",
+	}
+	err := s.db.StoreScheduledAction(action)
+	s.Assert().NoError(err)
+	s.Assert().NotZero(action.ID)
+	s.Assert().Equal(model.ScheduleStatusPending, action.Status)
+
+	later := &model.ScheduledAction{Action: model.ScheduleActionWebhook, Nodeset: "cpn-01", RunAt: now.Add(time.Hour)}
+	err = s.db.StoreScheduledAction(later)
+	s.Assert().NoError(err)
+
+	err = s.db.StoreScheduledAction(&model.ScheduledAction{Action: "reboot", Nodeset: "cpn-01", RunAt: now})
+	s.Assert().ErrorIs(err, store.ErrInvalidData)
+	err = s.db.StoreScheduledAction(&model.ScheduledAction{Action: model.ScheduleActionProvision, Arg: "maybe", Nodeset: "cpn-01", RunAt: now})
+	s.Assert().ErrorIs(err, store.ErrInvalidData)
+
+	actions, err := s.db.ScheduledActions()
+	if s.Assert().NoError(err) && s.Assert().Len(actions, 2) {
+		s.Assert().Equal(action.ID, actions[0].ID)
+		s.Assert().Equal(later.ID, actions[1].ID)
+	}
+
+	due, err := s.db.DueScheduledActions(now)
+	if s.Assert().NoError(err) && s.Assert().Len(due, 1) {
+		s.Assert().Equal(action.ID, due[0].ID)
+		s.Assert().Equal("admin", due[0].CreatedBy)
+		s.Assert().Equal(action.RunAt.UnixMilli(), due[0].RunAt.UnixMilli())
+	}
+
+	// An action is started once
+	ok, err := s.db.StartScheduledAction(action.ID, model.ScheduleStatusRunning)
+	s.Assert().NoError(err)
+	s.Assert().True(ok)
+	ok, err = s.db.StartScheduledAction(action.ID, model.ScheduleStatusRunning)
+	s.Assert().NoError(err)
+	s.Assert().False(ok)
+
+	err = s.db.CancelScheduledAction(action.ID)
+	s.Assert().ErrorIs(err, store.ErrConflict)
+	err = s.db.CancelScheduledAction(1000)
+	s.Assert().ErrorIs(err, store.ErrNotFound)
+
+	action.Status = model.ScheduleStatusPartial
+	action.FinishedAt = now
+	action.Results = model.JobMessageList{
+		{Status: "success", Host: "cpn-01", Msg: "provision set to true"},
+		{Status: "error", Host: "cpn-02", Msg: "host not found"},
+	}
+	err = s.db.FinishScheduledAction(action)
+	s.Assert().NoError(err)
+
+	loaded, err := s.db.LoadScheduledAction(action.ID)
+	if s.Assert().NoError(err) {
+		s.Assert().Equal(model.ScheduleStatusPartial, loaded.Status)
+		s.Assert().False(loaded.StartedAt.IsZero())
+		s.Assert().Equal(action.Results, loaded.Results)
+	}
+
+	err = s.db.CancelScheduledAction(later.ID)
+	s.Assert().NoError(err)
+	loaded, err = s.db.LoadScheduledAction(later.ID)
+	if s.Assert().NoError(err) {
+		s.Assert().Equal(model.ScheduleStatusCancelled, loaded.Status)
+	}
+
+	feed, err := s.db.Changes(0, 1000)
+	if s.Assert().NoError(err) {
+		var ops []string
+		for _, c := range feed.Changes {
+			if c.Kind == model.ChangeKindSchedule && c.Name == strconv.FormatInt(action.ID, 10) {
+				ops = append(ops, c.Op)
+			}
+		}
+		s.Assert().Equal([]string{model.ChangeOpCreate, model.ChangeOpUpdate, model.ChangeOpUpdate}, ops)
+	}
+
+	n, err := s.db.PurgeScheduledActions(now.Add(time.Minute))
+	s.Assert().NoError(err)
+	s.Assert().Equal(2, n)
+
+	_, err = s.db.LoadScheduledAction(action.ID)
+	s.Assert().ErrorIs(err, store.ErrNotFound)
+}
+
+func (s *StoreTestSuite) TestCredentials() {
+	hostA := tests.HostFactory.MustCreate().(*model.Host)
+	hostB := tests.HostFactory.MustCreate().(*model.Host)
+	err := s.db.StoreHosts(model.HostList{hostA, hostB})
+	s.Assert().NoError(err)
+
+	err = s.db.StoreCredentials(model.CredentialList{
+		{Name: hostA.Name, Kind: model.CredentialKindBMC, Secret: "v1:a"},
+		{Name: hostB.Name, Kind: model.CredentialKindBMC, Secret: "v1:b"},
+	})
+	s.Assert().NoError(err)
+
+	// Overwrites the existing credential
+	err = s.db.StoreCredentials(model.CredentialList{{Name: hostA.Name, Kind: model.CredentialKindBMC, Secret: "v1:c"}})
+	s.Assert().NoError(err)
+
+	cred, err := s.db.LoadCredential(hostA.Name, model.CredentialKindBMC)
+	if s.Assert().NoError(err) {
+		s.Assert().Equal("v1:c", cred.Secret)
+	}
+
+	_, err = s.db.LoadCredential(hostA.Name, "switch")
+	s.Assert().ErrorIs(err, store.ErrNotFound)
+
+	err = s.db.StoreCredentials(model.CredentialList{{Name: "missing", Kind: model.CredentialKindBMC, Secret: "v1:a"}})
+	s.Assert().ErrorIs(err, store.ErrNotFound)
+	err = s.db.StoreCredentials(model.CredentialList{{Name: hostA.Name, Kind: "BMC!", Secret: "v1:a"}})
+	s.Assert().ErrorIs(err, store.ErrInvalidData)
+
+	// Credentials are not part of the host
+	host, err := s.db.LoadHostFromName(hostA.Name)
+	if s.Assert().NoError(err) {
+		data, err := json.Marshal(host)
+		s.Assert().NoError(err)
+		s.Assert().NotContains(string(data), "v1:c")
+	}
+
+	ns, err := nodeset.NewNodeSet(hostA.Name)
+	s.Assert().NoError(err)
+	creds, err := s.db.FindCredentials(ns)
+	if s.Assert().NoError(err) {
+		s.Assert().Len(creds, 1)
+	}
+
+	deleted, err := s.db.DeleteCredentials(ns, model.CredentialKindBMC)
+	s.Assert().NoError(err)
+	s.Assert().Equal(1, deleted)
+
+	// Deleting a host deletes its credentials
+	ns, err = nodeset.NewNodeSet(hostB.Name)
+	s.Assert().NoError(err)
+	err = s.db.DeleteHosts(ns)
+	s.Assert().NoError(err)
+
+	creds, err = s.db.Credentials()
+	if s.Assert().NoError(err) {
+		s.Assert().Len(creds, 0)
+	}
+}
+
+func (s *StoreTestSuite) TestSecrets() {
+	err := s.db.StoreSecret(&model.Secret{Name: "luks-passphrase", Value: "v1:a", OneTime: true})
+	s.Assert().NoError(err)
+	err = s.db.StoreSecret(&model.Secret{Name: "join-token", Value: "v1:b"})
+	s.Assert().NoError(err)
+
+	secret, err := s.db.LoadSecret("luks-passphrase")
+	if s.Assert().NoError(err) {
+		s.Assert().Equal("v1:a", secret.Value)
+		s.Assert().True(secret.OneTime)
+	}
+
+	// Values are never marshalled
+	secrets, err := s.db.Secrets()
+	if s.Assert().NoError(err) && s.Assert().Len(secrets, 2) {
+		s.Assert().Equal("join-token", secrets[0].Name)
+		s.Assert().Empty(secrets[0].Value)
+		data, err := json.Marshal(secret)
+		s.Assert().NoError(err)
+		s.Assert().NotContains(string(data), "v1:a")
+	}
+
+	// A one-time secret is claimed once per boot token
+	claimed, err := s.db.ClaimSecret("luks-passphrase", "token-a", "cpn-01")
+	s.Assert().NoError(err)
+	s.Assert().True(claimed)
+	claimed, err = s.db.ClaimSecret("luks-passphrase", "token-a", "cpn-01")
+	s.Assert().NoError(err)
+	s.Assert().False(claimed)
+	claimed, err = s.db.ClaimSecret("luks-passphrase", "token-b", "cpn-01")
+	s.Assert().NoError(err)
+	s.Assert().True(claimed)
+
+	// Setting a new value renders it again
+	err = s.db.StoreSecret(&model.Secret{Name: "luks-passphrase", Value: "v1:c", OneTime: true})
+	s.Assert().NoError(err)
+	claimed, err = s.db.ClaimSecret("luks-passphrase", "token-a", "cpn-01")
+	s.Assert().NoError(err)
+	s.Assert().True(claimed)
+
+	// Only claims made before the given time are purged
+	n, err := s.db.PurgeSecretClaims(time.Now().Add(-time.Hour))
+	s.Assert().NoError(err)
+	s.Assert().Equal(0, n)
+	n, err = s.db.PurgeSecretClaims(time.Now().Add(time.Minute))
+	s.Assert().NoError(err)
+	s.Assert().Equal(1, n)
+	claimed, err = s.db.ClaimSecret("luks-passphrase", "token-a", "cpn-01")
+	s.Assert().NoError(err)
+	s.Assert().True(claimed)
+
+	err = s.db.StoreSecret(&model.Secret{Name: "bad name", Value: "v1:a"})
+	s.Assert().ErrorIs(err, store.ErrInvalidData)
+	err = s.db.StoreSecret(&model.Secret{Name: "empty"})
+	s.Assert().ErrorIs(err, store.ErrInvalidData)
+
+	s.Assert().NoError(s.db.DeleteSecret("luks-passphrase"))
+	s.Assert().ErrorIs(s.db.DeleteSecret("luks-passphrase"), store.ErrNotFound)
+	_, err = s.db.LoadSecret("luks-passphrase")
+	s.Assert().ErrorIs(err, store.ErrNotFound)
+	_, err = s.db.ClaimSecret("luks-passphrase", "token-a", "cpn-01")
+	s.Assert().Error(err)
+}
+
+func (s *StoreTestSuite) TestHostFiles() {
+	hostA := tests.HostFactory.MustCreate().(*model.Host)
+	hostB := tests.HostFactory.MustCreate().(*model.Host)
+	err := s.db.StoreHosts(model.HostList{hostA, hostB})
+	s.Assert().NoError(err)
+
+	err = s.db.StoreHostFiles(model.HostFileList{
+		{Host: hostA.Name, Name: "opa.conf", Data: []byte("a")},
+		{Host: hostB.Name, Name: "opa.conf", Data: []byte("b")},
+		{Host: hostB.Name, Name: "machine-id", Data: []byte("0123")},
+	})
+	s.Assert().NoError(err)
+
+	// Overwrites the existing file
+	err = s.db.StoreHostFiles(model.HostFileList{{Host: hostA.Name, Name: "opa.conf", Data: []byte("abc")}})
+	s.Assert().NoError(err)
+
+	file, err := s.db.LoadHostFile(hostA.Name, "opa.conf")
+	if s.Assert().NoError(err) {
+		s.Assert().Equal([]byte("abc"), file.Data)
+		s.Assert().EqualValues(3, file.Size)
+	}
+
+	_, err = s.db.LoadHostFile(hostA.Name, "machine-id")
+	s.Assert().ErrorIs(err, store.ErrNotFound)
+
+	err = s.db.StoreHostFiles(model.HostFileList{{Host: "missing", Name: "opa.conf"}})
+	s.Assert().ErrorIs(err, store.ErrNotFound)
+	err = s.db.StoreHostFiles(model.HostFileList{{Host: hostA.Name, Name: "../opa.conf"}})
+	s.Assert().ErrorIs(err, store.ErrInvalidData)
+	err = s.db.StoreHostFiles(model.HostFileList{{Host: hostA.Name, Name: "big", Data: make([]byte, model.HostFileMaxSize+1)}})
+	s.Assert().ErrorIs(err, store.ErrInvalidData)
+
+	ns, err := nodeset.NewNodeSet(hostB.Name)
+	s.Assert().NoError(err)
+	files, err := s.db.FindHostFiles(ns)
+	if s.Assert().NoError(err) && s.Assert().Len(files, 2) {
+		s.Assert().Equal("machine-id", files[0].Name)
+		s.Assert().EqualValues(4, files[0].Size)
+		s.Assert().Nil(files[0].Data)
+	}
+
+	deleted, err := s.db.DeleteHostFiles(ns, "opa.conf")
+	s.Assert().NoError(err)
+	s.Assert().Equal(1, deleted)
+
+	// Deleting a host deletes its files
+	err = s.db.DeleteHosts(ns)
+	s.Assert().NoError(err)
+
+	files, err = s.db.HostFiles()
+	if s.Assert().NoError(err) && s.Assert().Len(files, 1) {
+		s.Assert().Equal(hostA.Name, files[0].Host)
+	}
+}
+
+func (s *StoreTestSuite) TestPendingBMCs() {
+	first := time.Unix(1760000000, 0)
+	err := s.db.StorePendingBMC(&model.PendingBMC{
+		MAC:         "D0:94:66:12:34:56",
+		VendorClass: "iDRAC",
+		Relay:       "10.65.8.1",
+		ServerIP:    "10.65.0.1",
+		LastSeen:    first,
+	})
+	s.Assert().NoError(err)
+
+	// Updates keep the first seen time
+	err = s.db.StorePendingBMC(&model.PendingBMC{
+		MAC:         "d0:94:66:12:34:56",
+		VendorClass: "iDRAC",
+		Relay:       "10.65.8.1",
+		ServerIP:    "10.65.0.1",
+		LastSeen:    first.Add(time.Hour),
+	})
+	s.Assert().NoError(err)
+
+	bmc, err := s.db.LoadPendingBMC("d0:94:66:12:34:56")
+	if s.Assert().NoError(err) {
+		s.Assert().Equal("d0:94:66:12:34:56", bmc.MAC)
+		s.Assert().Equal("10.65.8.1", bmc.Relay)
+		s.Assert().True(first.Equal(bmc.FirstSeen))
+		s.Assert().True(first.Add(time.Hour).Equal(bmc.LastSeen))
+	}
+
+	bmcs, err := s.db.PendingBMCs()
+	if s.Assert().NoError(err) {
+		s.Assert().Len(bmcs, 1)
+	}
+
+	err = s.db.StorePendingBMC(&model.PendingBMC{MAC: "bad"})
+	s.Assert().ErrorIs(err, store.ErrInvalidData)
+
+	err = s.db.DeletePendingBMC("d0:94:66:12:34:56")
+	s.Assert().NoError(err)
+	err = s.db.DeletePendingBMC("d0:94:66:12:34:56")
+	s.Assert().ErrorIs(err, store.ErrNotFound)
+	_, err = s.db.LoadPendingBMC("d0:94:66:12:34:56")
+	s.Assert().ErrorIs(err, store.ErrNotFound)
+}
+
+func (s *StoreTestSuite) TestHostLog() {
+	host := tests.HostFactory.MustCreate().(*model.Host)
+	err := s.db.StoreHost(host)
+	s.Assert().NoError(err)
+
+	start := time.Unix(1760000000, 0)
+	entries := make(model.HostLogList, 0, model.HostLogMaxEntries+5)
+	for i := range model.HostLogMaxEntries + 5 {
+		entries = append(entries, &model.HostLogEntry{
+			Host:      host.Name,
+			Time:      start.Add(time.Duration(i) * time.Minute),
+			Severity:  "Critical",
+			MessageID: "PSU0003",
+			Message:   fmt.Sprintf("Power supply lost input %d", i),
+		})
+	}
+	err = s.db.StoreHostLog(entries)
+	s.Assert().NoError(err)
+
+	// Only the newest entries are kept, oldest first
+	ns, err := nodeset.NewNodeSet(host.Name)
+	s.Assert().NoError(err)
+	log, err := s.db.FindHostLog(ns)
+	if s.Assert().NoError(err) && s.Assert().Len(log, model.HostLogMaxEntries) {
+		s.Assert().Equal("Power supply lost input 5", log[0].Message)
+		s.Assert().True(start.Add(5 * time.Minute).Equal(log[0].Time))
+		s.Assert().Equal("PSU0003", log[0].MessageID)
+		s.Assert().Equal(host.Name, log[0].Host)
+	}
+
+	err = s.db.StoreHostLog(model.HostLogList{{Host: "missing", Severity: "Critical", Message: "lost"}})
+	s.Assert().ErrorIs(err, store.ErrNotFound)
+
+	// Deleting a host deletes its log
+	err = s.db.DeleteHosts(ns)
+	s.Assert().NoError(err)
+	log, err = s.db.FindHostLog(ns)
+	if s.Assert().NoError(err) {
+		s.Assert().Len(log, 0)
+	}
+}
+
+func (s *StoreTestSuite) TestHostIndexes() {
+	host := tests.HostFactory.MustCreate().(*model.Host)
+	host.Interfaces[0].FQDN = "Index1.Example.com.,alias-index1.example.com"
+
+	err := s.db.StoreHost(host)
+	s.Assert().NoError(err)
+
+	resolves := func(name string, expected int) {
+		ips, err := s.db.ResolveIPv4(name)
+		if s.Assert().NoError(err) {
+			s.Assert().Len(ips, expected, name)
+		}
+	}
+
+	resolves("index1.example.com", 1)
+	resolves("INDEX1.example.com.", 1)
+	resolves("alias-index1.example.com", 1)
+
+	testHost, err := s.db.LoadHostFromMAC(host.Interfaces[0].MAC.String())
+	if s.Assert().NoError(err) {
+		s.Assert().Equal(host.Name, testHost.Name)
+	}
+
+	// Changing the FQDN drops the old names from the index
+	host.Interfaces[0].FQDN = "index2.example.com"
+	err = s.db.StoreHost(host)
+	s.Assert().NoError(err)
+	resolves("index1.example.com", 0)
+	resolves("alias-index1.example.com", 0)
+	resolves("index2.example.com", 1)
+
+	err = s.db.Reindex()
+	s.Assert().NoError(err)
+	resolves("index2.example.com", 1)
+
+	names, err := s.db.ReverseResolve(host.Interfaces[0].AddrString())
+	if s.Assert().NoError(err) {
+		s.Assert().Equal([]string{"index2.example.com"}, names)
+	}
+
+	ns, err := nodeset.NewNodeSet(host.Name)
+	s.Assert().NoError(err)
+	err = s.db.DeleteHosts(ns)
+	s.Assert().NoError(err)
+	resolves("index2.example.com", 0)
+
+	_, err = s.db.LoadHostFromMAC(host.Interfaces[0].MAC.String())
+	s.Assert().ErrorIs(err, store.ErrNotFound)
+}
+
+func (s *StoreTestSuite) TestIfname() {
+	host := tests.HostFactory.MustCreate().(*model.Host)
+
+	err := s.db.StoreHost(host)
+	s.Assert().NoError(err)
+
+	testHost, err := s.db.LoadHostFromName(host.Name)
+	if s.Assert().NoError(err) {
+		s.Assert().Equal(host.Interfaces[0].Name, testHost.Interfaces[0].Name)
+	}
+}
+
+func (s *StoreTestSuite) TestSwitchPort() {
+	host := tests.HostFactory.MustCreate().(*model.Host)
+	host.Interfaces[0].Switch = "swd13"
+	host.Interfaces[0].Port = 12
+
+	err := s.db.StoreHost(host)
+	s.Assert().NoError(err)
+
+	testHost, err := s.db.LoadHostFromName(host.Name)
+	if s.Assert().NoError(err) {
+		s.Assert().Equal("swd13", testHost.Interfaces[0].Switch)
+		s.Assert().Equal(12, testHost.Interfaces[0].Port)
+		s.Assert().Equal("", testHost.Interfaces[1].Switch)
+		s.Assert().Equal(0, testHost.Interfaces[1].Port)
+	}
+}
+
+func (s *StoreTestSuite) TestVLAN() {
+	host := tests.HostFactory.MustCreate().(*model.Host)
+	host.Interfaces = append(host.Interfaces, &model.NetInterface{
+		Name:   "ens1f0.2010",
+		Parent: "ens1f0",
+		VLAN:   2010,
+		IP:     netip.MustParsePrefix("10.20.10.5/24"),
+		FQDN:   host.Name + "-data.example.com",
+	})
+
+	err := s.db.StoreHost(host)
+	s.Assert().NoError(err)
+
+	testHost, err := s.db.LoadHostFromName(host.Name)
+	if s.Assert().NoError(err) && s.Assert().Len(testHost.Interfaces, 3) {
+		s.Assert().Equal(uint16(2010), testHost.Interfaces[2].VLAN)
+		s.Assert().Equal("ens1f0", testHost.Interfaces[2].Parent)
+		s.Assert().Equal(uint16(0), testHost.Interfaces[0].VLAN)
+		s.Assert().Equal("", testHost.Interfaces[0].Parent)
+	}
+
+	// VLAN interfaces are still served by DNS
+	testIPs, err := s.db.ResolveIPv4(host.Name + "-data.example.com")
+	if s.Assert().NoError(err) && s.Assert().Len(testIPs, 1) {
+		s.Assert().Equal("10.20.10.5", testIPs[0].String())
+	}
+	testNames, err := s.db.ReverseResolve("10.20.10.5")
+	if s.Assert().NoError(err) && s.Assert().Len(testNames, 1) {
+		s.Assert().Equal(host.Name+"-data.example.com", testNames[0])
+	}
+
+	bad := tests.HostFactory.MustCreate().(*model.Host)
+	bad.Interfaces[0].VLAN = 4095
+	err = s.db.StoreHost(bad)
+	s.Assert().ErrorIs(err, store.ErrInvalidData)
+
+	bad.Interfaces[0].VLAN = 0
+	bad.Interfaces[0].Parent = "ens1f0"
+	err = s.db.StoreHost(bad)
+	s.Assert().ErrorIs(err, store.ErrInvalidData)
+}
+
+func (s *StoreTestSuite) TestDNSRecords() {
+	host := tests.HostFactory.MustCreate().(*model.Host)
+	host.Interfaces[0].FQDN = "cpn-01.example.com"
+	host.Interfaces[0].IP = netip.MustParsePrefix("10.1.1.10/24")
+
+	err := s.db.StoreHost(host)
+	s.Assert().NoError(err)
+
+	records := model.RecordList{
+		{Name: "VIP.example.com.", Type: "a", Value: "10.1.1.200", PTR: true},
+		{Name: "www.example.com", Type: model.RecordTypeCNAME, Value: "vip.example.com", TTL: 60},
+	}
+	err = s.db.StoreDNSRecords(records)
+	s.Assert().NoError(err)
+
+	testRecords, err := s.db.DNSRecords()
+	if s.Assert().NoError(err) && s.Assert().Len(testRecords, 2) {
+		s.Assert().Equal("vip.example.com", testRecords[0].Name)
+		s.Assert().Equal(model.RecordTypeA, testRecords[0].Type)
+		s.Assert().Equal(int64(60), testRecords[1].TTL)
+	}
+
+	found, err := s.db.FindDNSRecords("www.example.com.")
+	if s.Assert().NoError(err) && s.Assert().Len(found, 1) {
+		s.Assert().Equal("vip.example.com", found[0].Value)
+	}
+
+	ptr, err := s.db.ReverseResolveDNSRecords("10.1.1.200")
+	if s.Assert().NoError(err) && s.Assert().Len(ptr, 1) {
+		s.Assert().Equal("vip.example.com", ptr[0].Name)
+	}
+
+	// hosts may not use the name of a record or the address of a PTR record
+	err = s.db.StoreHost(host)
+	s.Assert().NoError(err)
+	for fqdn, ip := range map[string]string{"WWW.example.com.": "10.1.1.11/24", "cpn-02.example.com": "10.1.1.200/24"} {
+		other := tests.HostFactory.MustCreate().(*model.Host)
+		other.Interfaces[0].FQDN = fqdn
+		other.Interfaces[0].IP = netip.MustParsePrefix(ip)
+		err = s.db.StoreHost(other)
+		s.Assert().ErrorIs(err, store.ErrConflict, fqdn)
+	}
+
+	// storing the same record again updates it
+	err = s.db.StoreDNSRecords(model.RecordList{{Name: "vip.example.com", Type: model.RecordTypeA, Value: "10.1.1.200", TTL: 30}})
+	s.Assert().NoError(err)
+
+	conflicts := model.RecordList{
+		{Name: "cpn-01.example.com", Type: model.RecordTypeA, Value: "10.1.1.11"},
+		{Name: "www.example.com", Type: model.RecordTypeA, Value: "10.1.1.201"},
+		{Name: "vip.example.com", Type: model.RecordTypeCNAME, Value: "cpn-01.example.com"},
+		{Name: "alias.example.com", Type: model.RecordTypeA, Value: "10.1.1.10", PTR: true},
+	}
+	for _, r := range conflicts {
+		err = s.db.StoreDNSRecords(model.RecordList{r})
+		s.Assert().ErrorIs(err, store.ErrConflict, r.Name)
+	}
+
+	err = s.db.StoreDNSRecords(model.RecordList{{Name: "bad name", Type: model.RecordTypeA, Value: "10.1.1.12"}})
+	s.Assert().ErrorIs(err, store.ErrInvalidData)
+
+	err = s.db.DeleteDNSRecords([]string{"www.example.com"})
+	s.Assert().NoError(err)
+
+	testRecords, err = s.db.DNSRecords()
+	if s.Assert().NoError(err) && s.Assert().Len(testRecords, 1) {
+		s.Assert().Equal(int64(30), testRecords[0].TTL)
+	}
+}
+
+func (s *StoreTestSuite) TestHostRename() {
+	host := tests.HostFactory.MustCreate().(*model.Host)
+	host.Name = "cpn-01"
+	host.Interfaces[0].FQDN = "cpn-01.example.com"
+
+	err := s.db.StoreHost(host)
+	s.Assert().NoError(err)
+
+	testHost, err := s.db.LoadHostFromName("cpn-01")
+	s.Assert().NoError(err)
+
+	testHost.Rename("cpn-101", "{name}.mgmt.example.com")
+	err = s.db.StoreHost(testHost)
+	s.Assert().NoError(err)
+
+	_, err = s.db.LoadHostFromName("cpn-01")
+	s.Assert().ErrorIs(err, store.ErrNotFound)
+
+	renamed, err := s.db.LoadHostFromName("cpn-101")
+	if s.Assert().NoError(err) {
+		s.Assert().Equal(host.ID, renamed.ID)
+		s.Assert().Equal(host.UID, renamed.UID)
+		s.Assert().Equal("cpn-101.mgmt.example.com", renamed.Interfaces[0].FQDN)
+	}
+
+	hostList, err := s.db.Hosts()
+	s.Assert().NoError(err)
+	s.Assert().Len(hostList, 1)
+}
+
+func (s *StoreTestSuite) TestRevision() {
+	host := tests.HostFactory.MustCreate().(*model.Host)
+	err := s.db.StoreHost(host)
+	s.Assert().NoError(err)
+	s.Assert().Equal(int64(1), host.Revision)
+
+	stale, err := s.db.LoadHostFromName(host.Name)
+	if s.Assert().NoError(err) {
+		s.Assert().Equal(int64(1), stale.Revision)
+	}
+
+	host.Provision = !host.Provision
+	err = s.db.StoreHost(host)
+	s.Assert().NoError(err)
+	s.Assert().Equal(int64(2), host.Revision)
+
+	// Saving a copy read before the last save fails
+	stale.BootImage = ""
+	err = s.db.StoreHost(stale)
+	s.Assert().ErrorIs(err, store.ErrConflict)
+	var revErr *store.RevisionError
+	if s.Assert().ErrorAs(err, &revErr) {
+		s.Assert().Equal(int64(1), revErr.Expected)
+		s.Assert().Equal(int64(2), revErr.Current)
+	}
+
+	// Tags bump the revision
+	ns, err := nodeset.NewNodeSet(host.Name)
+	s.Assert().NoError(err)
+	err = s.db.TagHosts(ns, []string{"revision"})
+	s.Assert().NoError(err)
+	err = s.db.StoreHost(host)
+	s.Assert().ErrorIs(err, store.ErrConflict)
+
+	// A zero revision always saves
+	host.Revision = 0
+	err = s.db.StoreHost(host)
+	s.Assert().NoError(err)
+	s.Assert().Equal(int64(4), host.Revision)
+
+	image := tests.BootImageFactory.MustCreate().(*model.BootImage)
+	err = s.db.StoreBootImage(image)
+	s.Assert().NoError(err)
+	s.Assert().Equal(int64(1), image.Revision)
+
+	staleImage, err := s.db.LoadBootImage(image.Name)
+	s.Assert().NoError(err)
+	err = s.db.StoreBootImage(image)
+	s.Assert().NoError(err)
+	err = s.db.StoreBootImage(staleImage)
+	s.Assert().ErrorIs(err, store.ErrConflict)
+}
+
+func (s *StoreTestSuite) TestLoadFrom() {
+	image := tests.BootImageFactory.MustCreate().(*model.BootImage)
+	err := s.db.StoreBootImage(image)
+	s.Assert().NoError(err)
+
+	hosts := model.HostList{}
+	for i := range 3 {
+		host := tests.HostFactory.MustCreate().(*model.Host)
+		host.Name = fmt.Sprintf("cpn-0%d", i+1)
+		host.BootImage = image.Name
+		hosts = append(hosts, host)
+	}
+	err = s.db.StoreHosts(hosts)
+	s.Assert().NoError(err)
+
+	records := model.RecordList{{Name: "vip.example.com", Type: model.RecordTypeA, Value: "10.99.0.1"}}
+	err = s.db.StoreDNSRecords(records)
+	s.Assert().NoError(err)
+
+	dump := func() model.DataDump {
+		var d model.DataDump
+		d.Hosts, err = s.db.Hosts()
+		s.Assert().NoError(err)
+		d.Images, err = s.db.BootImages()
+		s.Assert().NoError(err)
+		d.DNSRecords, err = s.db.DNSRecords()
+		s.Assert().NoError(err)
+		d.Sort()
+
+		// Round trip through JSON as the dump would be read from a file
+		data, err := json.Marshal(d)
+		s.Assert().NoError(err)
+		var loaded model.DataDump
+		s.Assert().NoError(json.Unmarshal(data, &loaded))
+		return loaded
+	}
+
+	// Loading an unchanged dump changes nothing
+	diff, err := s.db.LoadFrom(dump(), true, false)
+	if s.Assert().NoError(err) {
+		s.Assert().Equal(0, diff.Changed())
+		s.Assert().Equal(3, diff.Hosts.Unchanged)
+		s.Assert().Equal(1, diff.Images.Unchanged)
+		s.Assert().Equal(1, diff.DNSRecords.Unchanged)
+	}
+
+	data := dump()
+	data.Hosts[0].Provision = !data.Hosts[0].Provision
+	added := tests.HostFactory.MustCreate().(*model.Host)
+	added.Name = "cpn-04"
+	added.ID = data.Hosts[1].ID
+	data.Hosts = append(data.Hosts[:2], added)
+	data.DNSRecords = model.RecordList{{Name: "printer.example.com", Type: model.RecordTypeA, Value: "10.99.0.2"}}
+
+	diff, err = s.db.LoadFrom(data, true, true)
+	if s.Assert().NoError(err) {
+		s.Assert().Equal([]string{"cpn-04"}, diff.Hosts.Added)
+		s.Assert().Equal([]string{"cpn-01"}, diff.Hosts.Updated)
+		s.Assert().Equal([]string{"cpn-03"}, diff.Hosts.Removed)
+		s.Assert().Equal([]string{"printer.example.com/A/10.99.0.2"}, diff.DNSRecords.Added)
+		s.Assert().Equal([]string{"vip.example.com/A/10.99.0.1"}, diff.DNSRecords.Removed)
+	}
+
+	// Dry run leaves the store unchanged
+	_, err = s.db.LoadHostFromName("cpn-04")
+	s.Assert().ErrorIs(err, store.ErrNotFound)
+
+	// Without prune missing entries are kept
+	diff, err = s.db.LoadFrom(data, false, false)
+	if s.Assert().NoError(err) {
+		s.Assert().Empty(diff.Hosts.Removed)
+		s.Assert().Equal(3, diff.Changed())
+	}
+
+	hostList, err := s.db.Hosts()
 	s.Assert().NoError(err)
+	s.Assert().Len(hostList, 4)
 
-	testIPs, err := s.db.ResolveIPv4("test1.example.com")
+	cpn02, err := s.db.LoadHostFromName("cpn-02")
 	if s.Assert().NoError(err) {
-		if s.Assert().Equal(1, len(testIPs)) {
-			s.Assert().Equal(host.Interfaces[0].AddrString(), testIPs[0].String())
-		}
+		s.Assert().Equal(hosts[1].ID, cpn02.ID)
 	}
 
-	names, err := s.db.ReverseResolve(host.Interfaces[0].AddrString())
+	diff, err = s.db.LoadFrom(data, true, false)
 	if s.Assert().NoError(err) {
-		if s.Assert().Equal(1, len(names)) {
-			s.Assert().Equal("test1.example.com", names[0])
-		}
+		s.Assert().Equal([]string{"cpn-03"}, diff.Hosts.Removed)
+		s.Assert().Equal([]string{"vip.example.com/A/10.99.0.1"}, diff.DNSRecords.Removed)
+	}
+
+	hostList, err = s.db.Hosts()
+	s.Assert().NoError(err)
+	s.Assert().Len(hostList, 3)
+
+	recordList, err := s.db.DNSRecords()
+	if s.Assert().NoError(err) && s.Assert().Len(recordList, 1) {
+		s.Assert().Equal("printer.example.com", recordList[0].Name)
 	}
+
+	// Hosts can not use an image removed by prune
+	data = dump()
+	data.Images = model.BootImageList{}
+	_, err = s.db.LoadFrom(data, true, false)
+	s.Assert().ErrorIs(err, store.ErrInvalidData)
 }
 
-func (s *StoreTestSuite) TestReverseResolveIPv4() {
-	hostA := tests.HostFactory.MustCreate().(*model.Host)
-	hostA.Interfaces[0].IP = netip.MustParsePrefix("10.1.1.17/24")
+func (s *StoreTestSuite) TestRevokeBootToken() {
+	info := &model.BootTokenInfo{
+		ID:        "expired",
+		HostID:    "host",
+		MAC:       "de:ad:be:ef:00:01",
+		ExpiresAt: time.Now().Add(-time.Minute),
+	}
+	err := s.db.RevokeBootToken(info)
+	s.Assert().NoError(err)
 
-	hostB := tests.HostFactory.MustCreate().(*model.Host)
-	hostB.Interfaces[0].IP = netip.MustParsePrefix("10.1.1.174/24")
+	revoked, err := s.db.BootTokenRevoked("expired")
+	s.Assert().NoError(err)
+	s.Assert().False(revoked)
 
-	err := s.db.StoreHost(hostA)
+	info.ID = "active"
+	info.ExpiresAt = time.Now().Add(time.Hour)
+	err = s.db.RevokeBootToken(info)
+	s.Assert().NoError(err)
+	err = s.db.RevokeBootToken(info)
 	s.Assert().NoError(err)
 
-	err = s.db.StoreHost(hostB)
+	revoked, err = s.db.BootTokenRevoked("active")
 	s.Assert().NoError(err)
+	s.Assert().True(revoked)
 
-	testNames, err := s.db.ReverseResolve(hostA.Interfaces[0].AddrString())
-	if s.Assert().NoError(err) {
-		if s.Assert().Equal(1, len(testNames)) {
-			s.Assert().Equal(hostA.Interfaces[0].FQDN, testNames[0])
-		}
-	}
+	revoked, err = s.db.BootTokenRevoked("missing")
+	s.Assert().NoError(err)
+	s.Assert().False(revoked)
 }
 
-func (s *StoreTestSuite) TestIfname() {
-	host := tests.HostFactory.MustCreate().(*model.Host)
+func (s *StoreTestSuite) TestHAInstances() {
+	now := time.Now().Truncate(time.Millisecond)
+	err := s.db.StoreHAInstance(&model.HAInstance{
+		Name:      "grendel-b",
+		Role:      model.HARoleSecondary,
+		State:     model.HAStateStandby,
+		Heartbeat: now,
+		Changed:   now.Add(-time.Hour),
+	})
+	s.Assert().NoError(err)
 
-	err := s.db.StoreHost(host)
+	err = s.db.StoreHAInstance(&model.HAInstance{
+		Name:      "grendel-a",
+		Role:      model.HARolePrimary,
+		State:     model.HAStateServing,
+		Heartbeat: now.Add(-time.Second),
+		Changed:   now.Add(-time.Hour),
+	})
 	s.Assert().NoError(err)
 
-	testHost, err := s.db.LoadHostFromName(host.Name)
-	if s.Assert().NoError(err) {
-		s.Assert().Equal(host.Interfaces[0].Name, testHost.Interfaces[0].Name)
+	// Heartbeats update the row of the instance
+	err = s.db.StoreHAInstance(&model.HAInstance{
+		Name:      "grendel-b",
+		Role:      model.HARoleSecondary,
+		State:     model.HAStateServing,
+		Heartbeat: now.Add(time.Second),
+		Changed:   now,
+	})
+	s.Assert().NoError(err)
+
+	instances, err := s.db.HAInstances()
+	s.Assert().NoError(err)
+	if s.Assert().Len(instances, 2) {
+		s.Assert().Equal("grendel-a", instances[0].Name)
+		s.Assert().Equal(model.HARolePrimary, instances[0].Role)
+		s.Assert().Equal("grendel-b", instances[1].Name)
+		s.Assert().Equal(model.HAStateServing, instances[1].State)
+		s.Assert().True(now.Add(time.Second).Equal(instances[1].Heartbeat))
+		s.Assert().True(now.Equal(instances[1].Changed))
 	}
 }
 
+func (s *StoreTestSuite) TestMaintenance() {
+	m, err := s.db.LoadMaintenance()
+	s.Assert().NoError(err)
+	s.Assert().False(m.Enabled)
+
+	now := time.Now().Truncate(time.Millisecond)
+	err = s.db.StoreMaintenance(&model.Maintenance{
+		Enabled:   true,
+		Reason:    "firmware upgrade",
+		ChangedBy: "admin",
+		Changed:   now,
+	})
+	s.Assert().NoError(err)
+
+	m, err = s.db.LoadMaintenance()
+	s.Assert().NoError(err)
+	s.Assert().True(m.Enabled)
+	s.Assert().Equal("firmware upgrade", m.Reason)
+	s.Assert().Equal("admin", m.ChangedBy)
+	s.Assert().True(now.Equal(m.Changed))
+
+	err = s.db.StoreMaintenance(&model.Maintenance{Enabled: false, ChangedBy: "admin", Changed: now.Add(time.Minute)})
+	s.Assert().NoError(err)
+
+	m, err = s.db.LoadMaintenance()
+	s.Assert().NoError(err)
+	s.Assert().False(m.Enabled)
+	s.Assert().Empty(m.Reason)
+}
+
+func (s *StoreTestSuite) TestPing() {
+	s.Assert().NoError(s.db.Ping())
+}
+
 func (s *StoreTestSuite) TestBonds() {
 	host := tests.HostFactory.MustCreate().(*model.Host)
-	host.Bonds[0].Peers[0] = host.Interfaces[0].MAC.String()
-	host.Bonds[0].Peers[1] = host.Interfaces[1].Name
+	host.Bonds[0].Peers = []string{host.Interfaces[0].MAC.String(), host.Interfaces[1].Name}
 
 	err := s.db.StoreHost(host)
 	s.Assert().NoError(err)
@@ -246,6 +1375,51 @@ func (s *StoreTestSuite) TestBonds() {
 	}
 }
 
+func (s *StoreTestSuite) TestBondModeAndBridge() {
+	host := tests.HostFactory.MustCreate().(*model.Host)
+	host.Bonds[0].Peers = []string{host.Interfaces[0].Name}
+	host.Bonds[0].Mode = "802.3ad"
+	host.Bonds[0].Options = map[string]string{"miimon": "100"}
+	host.Bonds = append(host.Bonds, &model.Bond{
+		NetInterface: model.NetInterface{
+			Name: "br0",
+			IP:   netip.MustParsePrefix("10.65.0.5/24"),
+			FQDN: host.Name + "-br0.example.com",
+		},
+		Type:  "bridge",
+		Peers: []string{"bond0"},
+	})
+
+	err := s.db.StoreHost(host)
+	s.Assert().NoError(err)
+
+	testHost, err := s.db.LoadHostFromName(host.Name)
+	if s.Assert().NoError(err) && s.Assert().Len(testHost.Bonds, 2) {
+		s.Assert().Len(testHost.Interfaces, 2)
+		s.Assert().Equal("bond", testHost.Bonds[0].Type)
+		s.Assert().Equal("802.3ad", testHost.Bonds[0].Mode)
+		s.Assert().Equal(map[string]string{"miimon": "100"}, testHost.Bonds[0].Options)
+		s.Assert().Equal("bridge", testHost.Bonds[1].Type)
+		s.Assert().Equal([]string{"bond0"}, testHost.Bonds[1].Peers)
+		s.Assert().Nil(testHost.Bonds[1].Options)
+	}
+
+	testIPs, err := s.db.ResolveIPv4(host.Name + "-br0.example.com")
+	if s.Assert().NoError(err) && s.Assert().Len(testIPs, 1) {
+		s.Assert().Equal("10.65.0.5", testIPs[0].String())
+	}
+
+	bad := tests.HostFactory.MustCreate().(*model.Host)
+	bad.Bonds[0].Peers = []string{"missing0"}
+	err = s.db.StoreHost(bad)
+	s.Assert().ErrorIs(err, store.ErrInvalidData)
+
+	bad.Bonds[0].Peers = []string{bad.Interfaces[0].MAC.String()}
+	bad.Bonds[0].Mode = "lacp"
+	err = s.db.StoreHost(bad)
+	s.Assert().ErrorIs(err, store.ErrInvalidData)
+}
+
 func (s *StoreTestSuite) TestHostList() {
 	size := 10
 	for i := 0; i < size; i++ {
@@ -446,6 +1620,76 @@ func (s *StoreTestSuite) TestProvision() {
 	}
 }
 
+func (s *StoreTestSuite) TestHostState() {
+	for i := 0; i < 4; i++ {
+		host := tests.HostFactory.MustCreate().(*model.Host)
+		host.Name = fmt.Sprintf("tux-%02d", i)
+		s.Require().NoError(s.db.StoreHost(host))
+	}
+
+	host, err := s.db.LoadHostFromName("tux-00")
+	s.Require().NoError(err)
+	s.Assert().Equal(model.HostStateNew, host.State)
+	s.Assert().False(host.Provision)
+
+	// Provisioning moves hosts to ready-to-provision and back to production
+	ns, err := nodeset.NewNodeSet("tux-[00-01]")
+	s.Require().NoError(err)
+	s.Require().NoError(s.db.ProvisionHosts(ns, true))
+	host, err = s.db.LoadHostFromName("tux-01")
+	s.Require().NoError(err)
+	s.Assert().Equal(model.HostStateReady, host.State)
+	s.Assert().True(host.Provision)
+
+	host.State = model.HostStateInstalling
+	s.Require().NoError(s.db.StoreHost(host))
+
+	// Clients predating states clear the provision flag
+	host, err = s.db.LoadHostFromName("tux-01")
+	s.Require().NoError(err)
+	host.State = ""
+	host.Provision = false
+	s.Require().NoError(s.db.StoreHost(host))
+	host, err = s.db.LoadHostFromName("tux-01")
+	s.Require().NoError(err)
+	s.Assert().Equal(model.HostStateProduction, host.State)
+
+	s.Require().NoError(s.db.SetHostsState(ns, model.HostStateRetired))
+	hosts, err := s.db.FindHosts(ns)
+	s.Require().NoError(err)
+	for _, h := range hosts {
+		s.Assert().Equal(model.HostStateRetired, h.State)
+		s.Assert().False(h.Provision)
+	}
+
+	// Retired hosts go back to new before they are provisioned
+	err = s.db.SetHostsState(ns, model.HostStateProduction)
+	s.Assert().ErrorIs(err, store.ErrConflict)
+	err = s.db.ProvisionHosts(ns, true)
+	s.Assert().ErrorIs(err, store.ErrConflict)
+
+	host, err = s.db.LoadHostFromName("tux-00")
+	s.Require().NoError(err)
+	host.State = model.HostStateProduction
+	s.Assert().ErrorIs(s.db.StoreHost(host), store.ErrConflict)
+
+	err = s.db.SetHostsState(ns, "broken")
+	s.Assert().ErrorIs(err, store.ErrInvalidData)
+
+	s.Require().NoError(s.db.SetHostsState(ns, model.HostStateNew))
+	s.Require().NoError(s.db.ProvisionHosts(ns, true))
+
+	feed, err := s.db.Changes(0, 1000)
+	s.Require().NoError(err)
+	found := false
+	for _, c := range feed.Changes {
+		if _, ok := c.Diff["state"]; ok && c.Name == "tux-01" {
+			found = true
+		}
+	}
+	s.Assert().True(found)
+}
+
 func (s *StoreTestSuite) TestSetBootImage() {
 	image := tests.BootImageFactory.MustCreate().(*model.BootImage)
 	image.Name = "centos7"
@@ -481,6 +1725,39 @@ func (s *StoreTestSuite) TestSetBootImage() {
 	}
 }
 
+func (s *StoreTestSuite) TestSetBootImageIfCurrent() {
+	for _, name := range []string{"centos7", "rocky9"} {
+		image := tests.BootImageFactory.MustCreate().(*model.BootImage)
+		image.Name = name
+		s.Require().NoError(s.db.StoreBootImage(image))
+	}
+	for i := 0; i < 2; i++ {
+		host := tests.HostFactory.MustCreate().(*model.Host)
+		host.Name = fmt.Sprintf("tux-%02d", i)
+		s.Require().NoError(s.db.StoreHost(host))
+	}
+	first, err := nodeset.NewNodeSet("tux-00")
+	s.Require().NoError(err)
+	s.Require().NoError(s.db.SetBootImage(first, "centos7"))
+
+	// No host changes unless all are assigned one of the current images
+	ns, err := nodeset.NewNodeSet("tux-[00-01]")
+	s.Require().NoError(err)
+	err = s.db.SetBootImageIfCurrent(ns, "rocky9", "centos7")
+	s.Assert().ErrorIs(err, store.ErrConflict)
+	hosts, err := s.db.FindHosts(ns)
+	s.Require().NoError(err)
+	s.Assert().Equal("centos7", hosts[0].BootImage)
+	s.Assert().Equal("", hosts[1].BootImage)
+
+	s.Assert().NoError(s.db.SetBootImageIfCurrent(ns, "rocky9", "centos7", ""))
+	hosts, err = s.db.FindHosts(ns)
+	s.Require().NoError(err)
+	for _, host := range hosts {
+		s.Assert().Equal("rocky9", host.BootImage)
+	}
+}
+
 func (s *StoreTestSuite) TestBootImage() {
 	image := tests.BootImageFactory.MustCreate().(*model.BootImage)
 
@@ -542,6 +1819,61 @@ func (s *StoreTestSuite) TestBootImageDelete() {
 	}
 }
 
+func (s *StoreTestSuite) TestBootImageInherits() {
+	base := &model.BootImage{
+		Name:        "inherit-base",
+		KernelPath:  "/images/vmlinuz",
+		InitrdPaths: []string{"/images/initrd.img"},
+		CommandLine: "console=ttyS0",
+	}
+	child := &model.BootImage{
+		Name:        "inherit-child",
+		Inherits:    base.Name,
+		InitrdPaths: []string{"+/images/extra.img"},
+		CommandLine: "+rd.debug",
+	}
+
+	// The child is stored before its parent in the same list
+	err := s.db.StoreBootImages(model.BootImageList{child, base})
+	s.Require().NoError(err)
+
+	stored, err := s.db.LoadBootImage(child.Name)
+	if s.Assert().NoError(err) {
+		s.Assert().Equal(base.Name, stored.Inherits)
+		s.Assert().Equal("", stored.KernelPath)
+	}
+
+	resolved, err := model.ResolveBootImage(child.Name, s.db.LoadBootImage)
+	if s.Assert().NoError(err) {
+		s.Assert().Equal("/images/vmlinuz", resolved.KernelPath)
+		s.Assert().Equal([]string{"/images/initrd.img", "/images/extra.img"}, resolved.InitrdPaths)
+		s.Assert().Equal("console=ttyS0 rd.debug", resolved.CommandLine)
+	}
+
+	// A change to the parent is resolved in the child
+	base.KernelPath = "/images/vmlinuz-2"
+	s.Require().NoError(s.db.StoreBootImage(base))
+	resolved, err = model.ResolveBootImage(child.Name, s.db.LoadBootImage)
+	if s.Assert().NoError(err) {
+		s.Assert().Equal("/images/vmlinuz-2", resolved.KernelPath)
+	}
+
+	base.Inherits = child.Name
+	err = s.db.StoreBootImage(base)
+	s.Assert().ErrorIs(err, model.ErrBootImageCycle)
+	s.Assert().ErrorIs(err, store.ErrInvalidData)
+	base.Inherits = ""
+
+	err = s.db.StoreBootImage(&model.BootImage{Name: "inherit-orphan", Inherits: "notfound"})
+	s.Assert().ErrorIs(err, store.ErrInvalidData)
+
+	err = s.db.DeleteBootImages([]string{base.Name})
+	s.Assert().ErrorIs(err, store.ErrConflict)
+
+	err = s.db.DeleteBootImages([]string{base.Name, child.Name})
+	s.Assert().NoError(err)
+}
+
 func (s *StoreTestSuite) TestBootImageUpdate() {
 	image := tests.BootImageFactory.MustCreate().(*model.BootImage)
 
@@ -588,6 +1920,56 @@ func (s *StoreTestSuite) TestBootImageUpdate() {
 	}
 }
 
+func (s *StoreTestSuite) TestImageFiles() {
+	start := time.Now().Add(-time.Second)
+	image := tests.BootImageFactory.MustCreate().(*model.BootImage)
+	image.KernelPath = "/images/" + image.Name + "/vmlinuz-1"
+	image.InitrdPaths = []string{"/images/" + image.Name + "/initrd-1", "/images/" + image.Name + "/extra.img"}
+	err := s.db.StoreBootImage(image)
+	s.Assert().NoError(err)
+
+	files, err := s.db.ImageFiles(start)
+	if s.Assert().NoError(err) {
+		s.Assert().Subset(files, append([]string{image.KernelPath}, image.InitrdPaths...))
+	}
+
+	// The files of the previous version are kept until the retention
+	updated, err := s.db.LoadBootImage(image.Name)
+	s.Assert().NoError(err)
+	updated.KernelPath = "/images/" + image.Name + "/vmlinuz-2"
+	updated.InitrdPaths = []string{"/images/" + image.Name + "/initrd-2"}
+	err = s.db.StoreBootImage(updated)
+	s.Assert().NoError(err)
+
+	files, err = s.db.ImageFiles(start)
+	if s.Assert().NoError(err) {
+		s.Assert().Subset(files, []string{image.KernelPath, image.InitrdPaths[0], image.InitrdPaths[1], updated.KernelPath, updated.InitrdPaths[0]})
+	}
+	files, err = s.db.ImageFiles(time.Now().Add(time.Minute))
+	if s.Assert().NoError(err) {
+		s.Assert().Contains(files, updated.KernelPath)
+		s.Assert().NotContains(files, image.KernelPath)
+		s.Assert().NotContains(files, image.InitrdPaths[1])
+	}
+
+	// So are the files of deleted images
+	err = s.db.DeleteBootImages([]string{image.Name})
+	s.Assert().NoError(err)
+	files, err = s.db.ImageFiles(start)
+	if s.Assert().NoError(err) {
+		s.Assert().Subset(files, []string{updated.KernelPath, updated.InitrdPaths[0]})
+	}
+
+	n, err := s.db.PurgeImageFiles(time.Now())
+	s.Assert().NoError(err)
+	s.Assert().GreaterOrEqual(n, 4)
+	files, err = s.db.ImageFiles(start)
+	if s.Assert().NoError(err) {
+		s.Assert().NotContains(files, updated.KernelPath)
+		s.Assert().NotContains(files, image.InitrdPaths[0])
+	}
+}
+
 func (s *StoreTestSuite) TestHostUpdate() {
 	host := tests.HostFactory.MustCreate().(*model.Host)
 
@@ -706,6 +2088,173 @@ func (s *StoreTestSuite) TestHostDelete() {
 	}
 }
 
+func (s *StoreTestSuite) TestHostTrash() {
+	host := tests.HostFactory.MustCreate().(*model.Host)
+
+	err := s.db.StoreHost(host)
+	s.Assert().NoError(err)
+	err = s.db.StoreCredentials(model.CredentialList{{Name: host.Name, Kind: model.CredentialKindBMC, Secret: "v1:trash"}})
+	s.Assert().NoError(err)
+
+	ns, err := nodeset.NewNodeSet(host.Name)
+	s.Assert().NoError(err)
+	err = s.db.DeleteHosts(ns)
+	s.Assert().NoError(err)
+
+	// Trashed hosts are no longer served
+	_, err = s.db.LoadHostFromMAC(host.Interfaces[0].MAC.String())
+	s.Assert().ErrorIs(err, store.ErrNotFound)
+	ips, err := s.db.ResolveIPv4(host.Interfaces[0].FQDN)
+	if s.Assert().NoError(err) {
+		s.Assert().Len(ips, 0)
+	}
+
+	trash, err := s.db.TrashedHosts()
+	if s.Assert().NoError(err) {
+		idx := slices.IndexFunc(trash, func(t *model.TrashedHost) bool { return t.Name == host.Name })
+		if s.Assert().NotEqual(-1, idx) {
+			s.Assert().Equal(host.UID, trash[idx].Host.UID)
+			s.Assert().WithinDuration(time.Now(), trash[idx].DeletedAt, time.Minute)
+		}
+
+		s.Assert().NotEmpty(trash.Collisions(host))
+	}
+
+	// A new host with the same name blocks the restore
+	other := tests.HostFactory.MustCreate().(*model.Host)
+	other.Name = host.Name
+	err = s.db.StoreHost(other)
+	s.Assert().NoError(err)
+	_, err = s.db.RestoreHosts(ns)
+	s.Assert().ErrorIs(err, store.ErrConflict)
+	err = s.db.DeleteHosts(ns)
+	s.Assert().NoError(err)
+
+	// Deleting again replaces the trashed host
+	_, err = s.db.RestoreHosts(ns)
+	s.Assert().NoError(err)
+	testHost, err := s.db.LoadHostFromName(host.Name)
+	if s.Assert().NoError(err) {
+		s.Assert().Equal(other.UID, testHost.UID)
+	}
+	err = s.db.DeleteHosts(ns)
+	s.Assert().NoError(err)
+
+	// Purge keeps hosts deleted after the given time
+	n, err := s.db.PurgeTrash(time.Now().Add(-time.Hour))
+	s.Assert().NoError(err)
+	s.Assert().Equal(0, n)
+
+	n, err = s.db.PurgeTrash(time.Now())
+	s.Assert().NoError(err)
+	s.Assert().GreaterOrEqual(n, 1)
+
+	n, err = s.db.RestoreHosts(ns)
+	s.Assert().NoError(err)
+	s.Assert().Equal(0, n)
+
+	// Credentials are restored with the host
+	host = tests.HostFactory.MustCreate().(*model.Host)
+	err = s.db.StoreHost(host)
+	s.Assert().NoError(err)
+	err = s.db.StoreCredentials(model.CredentialList{{Name: host.Name, Kind: model.CredentialKindBMC, Secret: "v1:trash"}})
+	s.Assert().NoError(err)
+	ns, err = nodeset.NewNodeSet(host.Name)
+	s.Assert().NoError(err)
+	err = s.db.DeleteHosts(ns)
+	s.Assert().NoError(err)
+
+	n, err = s.db.RestoreHosts(ns)
+	s.Assert().NoError(err)
+	s.Assert().Equal(1, n)
+
+	testHost, err = s.db.LoadHostFromMAC(host.Interfaces[0].MAC.String())
+	if s.Assert().NoError(err) {
+		s.Assert().Equal(host.UID, testHost.UID)
+		s.Assert().Len(testHost.Interfaces, len(host.Interfaces))
+	}
+	cred, err := s.db.LoadCredential(host.Name, model.CredentialKindBMC)
+	if s.Assert().NoError(err) {
+		s.Assert().Equal("v1:trash", cred.Secret)
+	}
+
+	err = s.db.DeleteHosts(ns)
+	s.Assert().NoError(err)
+	_, err = s.db.PurgeTrash(time.Now())
+	s.Assert().NoError(err)
+}
+
+func (s *StoreTestSuite) TestTimestamps() {
+	start := time.Now().Add(-time.Second)
+	host := tests.HostFactory.MustCreate().(*model.Host)
+	image := tests.BootImageFactory.MustCreate().(*model.BootImage)
+
+	err := s.db.StoreBootImage(image)
+	s.Assert().NoError(err)
+	err = s.db.StoreHost(host)
+	s.Assert().NoError(err)
+
+	testHost, err := s.db.LoadHostFromName(host.Name)
+	if s.Assert().NoError(err) {
+		s.Assert().WithinRange(testHost.CreatedAt, start, time.Now())
+		s.Assert().False(testHost.UpdatedAt.Before(testHost.CreatedAt))
+	}
+	testImage, err := s.db.LoadBootImage(image.Name)
+	if s.Assert().NoError(err) {
+		s.Assert().WithinRange(testImage.UpdatedAt, start, time.Now())
+	}
+
+	hosts, err := s.db.Hosts()
+	if s.Assert().NoError(err) {
+		s.Assert().Len(hosts.UpdatedSince(start), len(hosts))
+		s.Assert().Len(hosts.UpdatedSince(time.Now().Add(time.Minute)), 0)
+	}
+
+	ns, err := nodeset.NewNodeSet(host.Name)
+	s.Assert().NoError(err)
+	err = s.db.DeleteHosts(ns)
+	s.Assert().NoError(err)
+	err = s.db.DeleteBootImages([]string{image.Name})
+	s.Assert().NoError(err)
+
+	deleted, err := s.db.Tombstones(model.TombstoneKindHost, start)
+	if s.Assert().NoError(err) && s.Assert().Len(deleted, 1) {
+		s.Assert().Equal(host.Name, deleted[0].Name)
+		s.Assert().WithinRange(deleted[0].DeletedAt, start, time.Now())
+	}
+	deleted, err = s.db.Tombstones(model.TombstoneKindImage, start)
+	if s.Assert().NoError(err) && s.Assert().Len(deleted, 1) {
+		s.Assert().Equal(image.Name, deleted[0].Name)
+	}
+	deleted, err = s.db.Tombstones(model.TombstoneKindHost, time.Now().Add(time.Minute))
+	if s.Assert().NoError(err) {
+		s.Assert().Len(deleted, 0)
+	}
+
+	// Restoring a host removes its tombstone and renaming leaves one for
+	// the old name
+	_, err = s.db.RestoreHosts(ns)
+	s.Assert().NoError(err)
+	deleted, err = s.db.Tombstones(model.TombstoneKindHost, start)
+	if s.Assert().NoError(err) {
+		s.Assert().Len(deleted, 0)
+	}
+
+	renamed, err := s.db.LoadHostFromName(host.Name)
+	s.Assert().NoError(err)
+	renamed.Name = host.Name + "-new"
+	err = s.db.StoreHost(renamed)
+	s.Assert().NoError(err)
+	deleted, err = s.db.Tombstones(model.TombstoneKindHost, start)
+	if s.Assert().NoError(err) && s.Assert().Len(deleted, 1) {
+		s.Assert().Equal(host.Name, deleted[0].Name)
+	}
+
+	n, err := s.db.PurgeTombstones(time.Now())
+	s.Assert().NoError(err)
+	s.Assert().Equal(2, n)
+}
+
 func (s *StoreTestSuite) TestRestore() {
 	size := 10
 	adminUsername := "admin"
@@ -974,3 +2523,57 @@ func (s *StoreTestSuite) BenchmarkReverseResolve(size int, b *testing.B) {
 		}
 	})
 }
+
+func (s *StoreTestSuite) BenchmarkFindMAC(size int, b *testing.B) {
+	hosts := make(model.HostList, size)
+	for i := 0; i < size; i++ {
+		host := tests.HostFactory.MustCreate().(*model.Host)
+		hosts[i] = host
+	}
+
+	err := s.db.StoreHosts(hosts)
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	b.ResetTimer()
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			pick := hosts[rand.Intn(size)]
+			host, err := s.db.LoadHostFromMAC(pick.Interfaces[0].MAC.String())
+			if err != nil {
+				b.Fatal(err)
+			}
+			if host.Name != pick.Name {
+				b.Fatalf("wrong host expected %s got %s", pick.Name, host.Name)
+			}
+		}
+	})
+}
+
+func (s *StoreTestSuite) BenchmarkStoreDNSRecords(size int, b *testing.B) {
+	hosts := make(model.HostList, size)
+	for i := 0; i < size; i++ {
+		host := tests.HostFactory.MustCreate().(*model.Host)
+		hosts[i] = host
+	}
+
+	err := s.db.StoreHosts(hosts)
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		// Each record is checked for conflicts with the interfaces of all hosts
+		records := model.RecordList{{
+			Name:  fmt.Sprintf("vip-%d.example.com", i),
+			Type:  model.RecordTypeA,
+			Value: "192.0.2.1",
+			PTR:   true,
+		}}
+		if err := s.db.StoreDNSRecords(records); err != nil {
+			b.Fatal(err)
+		}
+	}
+}